@@ -5,11 +5,16 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/logs"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/gin-gonic/gin"
 )
 
@@ -20,6 +25,19 @@ const (
 	getKeyPath      = "/:address/key/:key"
 	getESDTTokens   = "/:address/esdt"
 	getESDTBalance  = "/:address/esdt/:tokenIdentifier"
+	getESDTsPath    = "/:address/esdts"
+	getLogsPath     = "/:address/logs"
+	getDelegations  = "/:address/delegations"
+)
+
+const (
+	queryParamRootHash = "rootHash"
+	queryParamCursor   = "cursor"
+	queryParamToken    = "token"
+	queryParamSize     = "size"
+
+	defaultESDTsPageSize = 100
+	maxESDTsPageSize     = 1000
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
@@ -31,10 +49,14 @@ type FacadeHandler interface {
 	GetCode(account state.UserAccountHandler) []byte
 	GetESDTBalance(address string, key string) (string, string, error)
 	GetAllESDTTokens(address string) ([]string, error)
+	GetESDTTokensPage(address string, options esdt.TokensPageOptions) (*esdt.ApiESDTTokensPage, error)
+	GetLogs(filter transaction.LogsFilter) ([]*transaction.ApiLogEvent, error)
+	GetDelegationPositions(address string) (*external.DelegatorPositions, error)
 	IsInterfaceNil() bool
 }
 
-type accountResponse struct {
+// AccountResponse represents the account information returned by the API
+type AccountResponse struct {
 	Address  string `json:"address"`
 	Nonce    uint64 `json:"nonce"`
 	Balance  string `json:"balance"`
@@ -44,7 +66,8 @@ type accountResponse struct {
 	RootHash []byte `json:"rootHash"`
 }
 
-type esdtTokenData struct {
+// ESDTTokenData represents the ESDT balance of an account returned by the API
+type ESDTTokenData struct {
 	TokenIdentifier string `json:"tokenIdentifier"`
 	Balance         string `json:"balance"`
 	Properties      string `json:"properties"`
@@ -58,6 +81,9 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getKeyPath, GetValueForKey)
 	router.RegisterHandler(http.MethodGet, getESDTBalance, GetESDTBalance)
 	router.RegisterHandler(http.MethodGet, getESDTTokens, GetESDTTokens)
+	router.RegisterHandler(http.MethodGet, getESDTsPath, GetESDTTokensPage)
+	router.RegisterHandler(http.MethodGet, getLogsPath, GetLogs)
+	router.RegisterHandler(http.MethodGet, getDelegations, GetDelegations)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -309,7 +335,7 @@ func GetESDTBalance(c *gin.Context) {
 		return
 	}
 
-	tokenData := esdtTokenData{
+	tokenData := ESDTTokenData{
 		TokenIdentifier: tokenIdentifier,
 		Balance:         balance,
 		Properties:      freeze,
@@ -368,8 +394,165 @@ func GetESDTTokens(c *gin.Context) {
 	)
 }
 
-func accountResponseFromBaseAccount(address string, code []byte, account state.UserAccountHandler) accountResponse {
-	return accountResponse{
+// GetESDTTokensPage returns a page of the esdt balances of this account, with the NFT entries expanded by nonce.
+// The rootHash and nextCursor values of the response should be provided back for fetching the next page
+func GetESDTTokensPage(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTTokens.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	options, err := getESDTTokensPageQueryParams(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	page, err := facade.GetESDTTokensPage(addr, options)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTTokens.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data: gin.H{
+				"tokens":     page.Tokens,
+				"rootHash":   page.RootHash,
+				"nextCursor": page.NextCursor,
+			},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetLogs returns the log events generated by this account, optionally filtered by the identifier provided in the
+// query parameters, emitted between the fromBlock and toBlock nonces
+func GetLogs(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrEmptyAddress.Error()),
+		)
+		return
+	}
+
+	filter, err := logs.ParseLogsFilter(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+	filter.Address = addr
+
+	logs.RespondWithLogs(c, facade, filter)
+}
+
+// GetDelegations returns, for each delegation contract this account delegated to, its active stake, its undelegated
+// amounts with the number of blocks left until they can be withdrawn and its claimable rewards, together with the totals
+func GetDelegations(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrGetDelegations.Error(), errors.ErrEmptyAddress.Error()),
+		)
+		return
+	}
+
+	positions, err := facade.GetDelegationPositions(addr)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetDelegations.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{
+			"delegations":           positions.Delegations,
+			"totalActiveStake":      positions.TotalActiveStake,
+			"totalUnDelegated":      positions.TotalUnDelegated,
+			"totalClaimableRewards": positions.TotalClaimableRewards,
+		},
+		"",
+		shared.ReturnCodeSuccess,
+	)
+}
+
+func getESDTTokensPageQueryParams(c *gin.Context) (esdt.TokensPageOptions, error) {
+	query := c.Request.URL.Query()
+	options := esdt.TokensPageOptions{
+		RootHash:        query.Get(queryParamRootHash),
+		Cursor:          query.Get(queryParamCursor),
+		TokenIdentifier: query.Get(queryParamToken),
+		PageSize:        defaultESDTsPageSize,
+	}
+
+	sizeStr := query.Get(queryParamSize)
+	if sizeStr != "" {
+		size, err := strconv.ParseUint(sizeStr, 10, 32)
+		if err != nil {
+			return esdt.TokensPageOptions{}, fmt.Errorf("invalid %s parameter: %w", queryParamSize, err)
+		}
+		if size == 0 || size > maxESDTsPageSize {
+			return esdt.TokensPageOptions{}, fmt.Errorf("invalid %s parameter: should be between 1 and %d",
+				queryParamSize, maxESDTsPageSize)
+		}
+
+		options.PageSize = uint32(size)
+	}
+
+	return options, nil
+}
+
+func accountResponseFromBaseAccount(address string, code []byte, account state.UserAccountHandler) AccountResponse {
+	return AccountResponse{
 		Address:  address,
 		Nonce:    account.GetNonce(),
 		Balance:  account.GetBalance().String(),
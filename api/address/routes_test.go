@@ -18,7 +18,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -75,6 +78,18 @@ type esdtTokensResponse struct {
 	Code  string
 }
 
+type esdtTokensPageResponseData struct {
+	Tokens     []*esdt.ApiESDTToken `json:"tokens"`
+	RootHash   string               `json:"rootHash"`
+	NextCursor string               `json:"nextCursor"`
+}
+
+type esdtTokensPageResponse struct {
+	Data  esdtTokensPageResponseData `json:"data"`
+	Error string                     `json:"error"`
+	Code  string
+}
+
 type usernameResponseData struct {
 	Username string `json:"username"`
 }
@@ -595,6 +610,215 @@ func TestGetESDTTokens_ShouldWork(t *testing.T) {
 	assert.Equal(t, []string{testValue1, testValue2}, esdtTokenResponseObj.Data.Tokens)
 }
 
+func TestGetESDTTokensPage_InvalidSizeShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetESDTTokensPageCalled: func(_ string, _ esdt.TokensPageOptions) (*esdt.ApiESDTTokensPage, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	for _, size := range []string{"0", "1001", "abc"} {
+		req, _ := http.NewRequest("GET", "/address/address/esdts?size="+size, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := esdtTokensPageResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	}
+}
+
+func TestGetESDTTokensPage_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetESDTTokensPageCalled: func(_ string, _ esdt.TokensPageOptions) (*esdt.ApiESDTTokensPage, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/esdts", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := esdtTokensPageResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetESDTTokensPage_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	expectedOptions := esdt.TokensPageOptions{
+		RootHash:        "aabb",
+		Cursor:          "ccdd",
+		TokenIdentifier: "NFT-123456",
+		PageSize:        50,
+	}
+	expectedPage := &esdt.ApiESDTTokensPage{
+		Tokens: []*esdt.ApiESDTToken{
+			{TokenIdentifier: "NFT-123456", Nonce: 7, Balance: "1", Properties: "00"},
+		},
+		RootHash:   "aabb",
+		NextCursor: "eeff",
+	}
+	facade := mock.Facade{
+		GetESDTTokensPageCalled: func(address string, options esdt.TokensPageOptions) (*esdt.ApiESDTTokensPage, error) {
+			assert.Equal(t, testAddress, address)
+			assert.Equal(t, expectedOptions, options)
+			return expectedPage, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	url := fmt.Sprintf("/address/%s/esdts?rootHash=aabb&cursor=ccdd&token=NFT-123456&size=50", testAddress)
+	req, _ := http.NewRequest("GET", url, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := esdtTokensPageResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedPage.Tokens, response.Data.Tokens)
+	assert.Equal(t, expectedPage.RootHash, response.Data.RootHash)
+	assert.Equal(t, expectedPage.NextCursor, response.Data.NextCursor)
+}
+
+func TestGetLogs_InvalidBlockNonceShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetLogsCalled: func(_ transaction.LogsFilter) ([]*transaction.ApiLogEvent, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/logs?fromBlock=abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+}
+
+func TestGetLogs_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedEvents := []*transaction.ApiLogEvent{
+		{TxHash: "aabb", BlockNonce: 7, LogAddress: "address", Address: "address", Identifier: "transfer"},
+	}
+	fromBlock, toBlock := uint64(5), uint64(9)
+	facade := mock.Facade{
+		GetLogsCalled: func(filter transaction.LogsFilter) ([]*transaction.ApiLogEvent, error) {
+			assert.Equal(t, transaction.LogsFilter{
+				Address:    "address",
+				Identifier: "transfer",
+				FromBlock:  &fromBlock,
+				ToBlock:    &toBlock,
+			}, filter)
+			return expectedEvents, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/logs?identifier=transfer&fromBlock=5&toBlock=9", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Logs []*transaction.ApiLogEvent `json:"logs"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedEvents, response.Data.Logs)
+}
+
+func TestGetDelegations_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetDelegationPositionsCalled: func(_ string) (*external.DelegatorPositions, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/erd1delegator/delegations", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetDelegations.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetDelegations_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	positions := &external.DelegatorPositions{
+		Delegations: []*external.DelegationPosition{
+			{
+				Contract:    "erd1contract",
+				ActiveStake: "1000",
+				UnDelegated: []*external.DelegationUnDelegatedFund{
+					{Value: "200", RemainingNonces: 40},
+				},
+				TotalUnDelegated: "200",
+				ClaimableRewards: "10",
+			},
+		},
+		TotalActiveStake:      "1000",
+		TotalUnDelegated:      "200",
+		TotalClaimableRewards: "10",
+	}
+	facade := mock.Facade{
+		GetDelegationPositionsCalled: func(address string) (*external.DelegatorPositions, error) {
+			assert.Equal(t, "erd1delegator", address)
+			return positions, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/erd1delegator/delegations", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	type delegationsResponse struct {
+		Data external.DelegatorPositions `json:"data"`
+		Code string                      `json:"code"`
+	}
+	response := delegationsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, *positions, response.Data)
+}
+
 func getRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -606,6 +830,9 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/key/:key", Open: true},
 					{Name: "/:address/esdt", Open: true},
 					{Name: "/:address/esdt/:tokenIdentifier", Open: true},
+					{Name: "/:address/esdts", Open: true},
+					{Name: "/:address/logs", Open: true},
+					{Name: "/:address/delegations", Open: true},
 				},
 			},
 		},
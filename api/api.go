@@ -8,11 +8,17 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/address"
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/graphql"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
 	"github.com/ElrondNetwork/elrond-go/api/logs"
+	"github.com/ElrondNetwork/elrond-go/api/management"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/network"
 	"github.com/ElrondNetwork/elrond-go/api/node"
+	"github.com/ElrondNetwork/elrond-go/api/openapi"
+	"github.com/ElrondNetwork/elrond-go/api/proof"
+	"github.com/ElrondNetwork/elrond-go/api/push"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/transaction"
 	valStats "github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
@@ -20,7 +26,6 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/marshal"
-	"github.com/gin-contrib/cors"
 	"github.com/gin-contrib/pprof"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
@@ -69,8 +74,24 @@ func (gev *ginErrorWriter) Write(p []byte) (n int, err error) {
 	return len(p), nil
 }
 
-// Start will boot up the api and appropriate routes, handlers and validators
-func Start(elrondFacade MainApiHandler, routesConfig config.ApiRoutesConfig, processors ...MiddlewareProcessor) error {
+// Start will boot up the api and appropriate routes, handlers and validators. The routes configuration updater is
+// used afterwards to open or close the routes without restarting the web server
+func Start(
+	elrondFacade MainApiHandler,
+	routesConfig config.ApiRoutesConfig,
+	webServerConfig config.WebServerConfig,
+	routesConfigUpdater *RoutesConfigUpdater,
+	processors ...MiddlewareProcessor,
+) error {
+	if check.IfNil(routesConfigUpdater) {
+		routesConfigUpdater = NewRoutesConfigUpdater()
+	}
+
+	corsHandler, err := createCORSHandler(webServerConfig.CORS)
+	if err != nil {
+		return err
+	}
+
 	var ws *gin.Engine
 	if !elrondFacade.RestAPIServerDebugMode() {
 		gin.DefaultWriter = &ginWriter{}
@@ -79,8 +100,20 @@ func Start(elrondFacade MainApiHandler, routesConfig config.ApiRoutesConfig, pro
 		gin.SetMode(gin.ReleaseMode)
 	}
 	ws = gin.Default()
-	ws.Use(cors.Default())
+	ws.Use(corsHandler)
 	ws.Use(middleware.WithFacade(elrondFacade))
+
+	// the response compressor is the outermost processor, as it alters the responses after being adapted to the
+	// version 2 envelope, while the version 2 adapter also converts the responses written by the other processors
+	responseCompressor, err := createResponseCompressor(routesConfig.ResponseCompression)
+	if err != nil {
+		return err
+	}
+	if !check.IfNil(responseCompressor) {
+		ws.Use(responseCompressor.MiddlewareHandlerFunc())
+	}
+	ws.Use(middleware.NewV2ResponseAdapter().MiddlewareHandlerFunc())
+
 	for _, proc := range processors {
 		if check.IfNil(proc) {
 			continue
@@ -89,63 +122,80 @@ func Start(elrondFacade MainApiHandler, routesConfig config.ApiRoutesConfig, pro
 		ws.Use(proc.MiddlewareHandlerFunc())
 	}
 
-	err := registerValidators()
+	managementAuthenticator, err := createManagementAuthenticator(routesConfig.ManagementAuth)
 	if err != nil {
 		return err
 	}
-
-	registerRoutes(ws, routesConfig, elrondFacade)
-
-	return ws.Run(elrondFacade.RestApiInterface())
-}
-
-func registerRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondFacade middleware.Handler) {
-	nodeRoutes := ws.Group("/node")
-	wrappedNodeRouter, err := wrapper.NewRouterWrapper("node", nodeRoutes, routesConfig)
-	if err == nil {
-		node.Routes(wrappedNodeRouter)
+	if !check.IfNil(managementAuthenticator) {
+		ws.Use(managementAuthenticator.MiddlewareHandlerFunc())
 	}
 
-	addressRoutes := ws.Group("/address")
-	wrappedAddressRouter, err := wrapper.NewRouterWrapper("address", addressRoutes, routesConfig)
-	if err == nil {
-		address.Routes(wrappedAddressRouter)
+	err = registerValidators()
+	if err != nil {
+		return err
 	}
 
-	networkRoutes := ws.Group("/network")
-	wrappedNetworkRoutes, err := wrapper.NewRouterWrapper("network", networkRoutes, routesConfig)
-	if err == nil {
-		network.Routes(wrappedNetworkRoutes)
-	}
+	registerRoutes(ws, routesConfig, elrondFacade, routesConfigUpdater)
 
-	txRoutes := ws.Group("/transaction")
-	wrappedTransactionRouter, err := wrapper.NewRouterWrapper("transaction", txRoutes, routesConfig)
-	if err == nil {
-		transaction.Routes(wrappedTransactionRouter)
-	}
+	return runServer(ws, elrondFacade.RestApiInterface(), webServerConfig.TLS, routesConfig.ManagementAuth)
+}
 
-	vmValuesRoutes := ws.Group("/vm-values")
-	wrappedVmValuesRouter, err := wrapper.NewRouterWrapper("vm-values", vmValuesRoutes, routesConfig)
-	if err == nil {
-		vmValues.Routes(wrappedVmValuesRouter)
-	}
+// apiPackage holds the routes of an API package. The version 2 routes are registered before the original ones, so
+// they replace the original handlers of the same method and path in the version 2 route group
+type apiPackage struct {
+	name     string
+	routes   func(router *wrapper.RouterWrapper)
+	routesV2 func(router *wrapper.RouterWrapper)
+}
 
-	validatorRoutes := ws.Group("/validator")
-	wrappedValidatorsRouter, err := wrapper.NewRouterWrapper("validator", validatorRoutes, routesConfig)
-	if err == nil {
-		valStats.Routes(wrappedValidatorsRouter)
+func registerRoutes(
+	ws *gin.Engine,
+	routesConfig config.ApiRoutesConfig,
+	elrondFacade middleware.Handler,
+	routesConfigUpdater *RoutesConfigUpdater,
+) {
+	apiPackages := []apiPackage{
+		{name: "node", routes: node.Routes},
+		{name: "address", routes: address.Routes},
+		{name: "network", routes: network.Routes, routesV2: network.RoutesV2},
+		{name: "transaction", routes: transaction.Routes},
+		{name: "vm-values", routes: vmValues.Routes},
+		{name: "validator", routes: valStats.Routes, routesV2: valStats.RoutesV2},
+		{name: "hardfork", routes: hardfork.Routes},
+		{name: "block", routes: block.Routes},
+		{name: "graphql", routes: graphql.Routes},
+		{name: "logs", routes: logs.Routes},
+		{name: "management", routes: management.Routes},
+		{name: "proof", routes: proof.Routes},
+		{name: "push", routes: push.Routes},
 	}
 
-	hardforkRoutes := ws.Group("/hardfork")
-	wrappedHardforkRouter, err := wrapper.NewRouterWrapper("hardfork", hardforkRoutes, routesConfig)
-	if err == nil {
-		hardfork.Routes(wrappedHardforkRouter)
+	v2Routes := ws.Group(shared.V2RoutesPrefix)
+	for _, pkg := range apiPackages {
+		wrappedRouter, err := wrapper.NewRouterWrapper(pkg.name, ws.Group("/"+pkg.name), routesConfig)
+		if err == nil {
+			pkg.routes(wrappedRouter)
+			routesConfigUpdater.addRouter(wrappedRouter)
+		}
+
+		wrappedV2Router, err := wrapper.NewRouterWrapper(pkg.name, v2Routes.Group("/"+pkg.name), routesConfig)
+		if err != nil {
+			continue
+		}
+		routesConfigUpdater.addRouter(wrappedV2Router)
+		if pkg.routesV2 != nil {
+			pkg.routesV2(wrappedV2Router)
+		}
+		pkg.routes(wrappedV2Router)
 	}
 
-	blockRoutes := ws.Group("/block")
-	wrappedBlockRouter, err := wrapper.NewRouterWrapper("block", blockRoutes, routesConfig)
+	openapiRoutes := ws.Group("/openapi")
+	wrappedOpenapiRouter, err := wrapper.NewRouterWrapper("openapi", openapiRoutes, routesConfig)
 	if err == nil {
-		block.Routes(wrappedBlockRouter)
+		openapi.Routes(wrappedOpenapiRouter, func() gin.RoutesInfo {
+			return activeRoutes(unversionedRoutes(ws.Routes()), routesConfigUpdater)
+		})
+		routesConfigUpdater.addRouter(wrappedOpenapiRouter)
 	}
 
 	apiHandler, ok := elrondFacade.(MainApiHandler)
@@ -159,6 +209,36 @@ func registerRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondF
 	}
 }
 
+// unversionedRoutes returns the provided routes without the version 2 ones, which are not described by the OpenAPI
+// document as they adapt the original routes
+func unversionedRoutes(routes gin.RoutesInfo) gin.RoutesInfo {
+	result := make(gin.RoutesInfo, 0, len(routes))
+	for _, route := range routes {
+		if shared.IsV2Route(route.Path) {
+			continue
+		}
+
+		result = append(result, route)
+	}
+
+	return result
+}
+
+// activeRoutes returns the provided routes without the closed ones, which are registered on the web server so they
+// can be opened without restarting it
+func activeRoutes(routes gin.RoutesInfo, routesConfigUpdater *RoutesConfigUpdater) gin.RoutesInfo {
+	result := make(gin.RoutesInfo, 0, len(routes))
+	for _, route := range routes {
+		if !routesConfigUpdater.isRouteActive(route.Method, route.Path) {
+			continue
+		}
+
+		result = append(result, route)
+	}
+
+	return result
+}
+
 func isLogRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
 	logConfig, ok := routesConfig.APIPackages["log"]
 	if !ok {
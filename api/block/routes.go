@@ -17,14 +17,19 @@ import (
 const (
 	getBlockByNoncePath = "/by-nonce/:nonce"
 	getBlockByHashPath  = "/by-hash/:hash"
+
+	getRandomnessByNoncePath = "/randomness/by-nonce/:nonce"
+	getRandomnessByEpochPath = "/randomness/by-epoch/:epoch"
 )
 
 var log = logger.GetOrCreate("api/block")
 
 // BlockService interface defines methods that can be used from `elrondFacade` context variable
 type BlockService interface {
-	GetBlockByHash(hash string, withTxs bool) (*APIBlock, error)
-	GetBlockByNonce(nonce uint64, withTxs bool) (*APIBlock, error)
+	GetBlockByHash(hash string, withTxs bool, withResults bool) (*APIBlock, error)
+	GetBlockByNonce(nonce uint64, withTxs bool, withResults bool) (*APIBlock, error)
+	GetRandomnessByNonce(nonce uint64) (*APIRandomness, error)
+	GetRandomnessByEpoch(epoch uint32) (*APIRandomness, error)
 }
 
 // APIBlock represents the structure for block that is returned by api routes
@@ -56,10 +61,24 @@ type APIMiniBlock struct {
 	Transactions     []*transaction.ApiTransactionResult `json:"transactions,omitempty"`
 }
 
+// APIRandomness represents the randomness source of a block, as it is returned by api routes. The random seed of a
+// block is the leader's signature on the previous random seed, so it can not be biased by the block proposer
+type APIRandomness struct {
+	Nonce        uint64 `json:"nonce"`
+	Round        uint64 `json:"round"`
+	Epoch        uint32 `json:"epoch"`
+	Shard        uint32 `json:"shard"`
+	Hash         string `json:"hash"`
+	RandSeed     string `json:"randSeed"`
+	PrevRandSeed string `json:"prevRandSeed"`
+}
+
 // Routes defines block related routes
 func Routes(routes *wrapper.RouterWrapper) {
 	routes.RegisterHandler(http.MethodGet, getBlockByNoncePath, getBlockByNonce)
 	routes.RegisterHandler(http.MethodGet, getBlockByHashPath, getBlockByHash)
+	routes.RegisterHandler(http.MethodGet, getRandomnessByNoncePath, getRandomnessByNonce)
+	routes.RegisterHandler(http.MethodGet, getRandomnessByEpochPath, getRandomnessByEpoch)
 }
 
 func getBlockByNonce(c *gin.Context) {
@@ -76,7 +95,7 @@ func getBlockByNonce(c *gin.Context) {
 		return
 	}
 
-	withTxs, err := getQueryParamWithTxs(c)
+	withTxs, withResults, err := getQueryParamsWithTxsAndResults(c)
 	if err != nil {
 		shared.RespondWithValidationError(
 			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
@@ -85,7 +104,7 @@ func getBlockByNonce(c *gin.Context) {
 	}
 
 	start := time.Now()
-	block, err := ef.GetBlockByNonce(nonce, withTxs)
+	block, err := ef.GetBlockByNonce(nonce, withTxs, withResults)
 	log.Debug(fmt.Sprintf("GetBlockByNonce took %s", time.Since(start)))
 	if err != nil {
 		shared.RespondWith(
@@ -116,7 +135,7 @@ func getBlockByHash(c *gin.Context) {
 		return
 	}
 
-	withTxs, err := getQueryParamWithTxs(c)
+	withTxs, withResults, err := getQueryParamsWithTxsAndResults(c)
 	if err != nil {
 		shared.RespondWithValidationError(
 			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockNonce.Error()),
@@ -125,7 +144,7 @@ func getBlockByHash(c *gin.Context) {
 	}
 
 	start := time.Now()
-	block, err := ef.GetBlockByHash(hash, withTxs)
+	block, err := ef.GetBlockByHash(hash, withTxs, withResults)
 	log.Debug(fmt.Sprintf("GetBlockByHash took %s", time.Since(start)))
 	if err != nil {
 		shared.RespondWith(
@@ -141,13 +160,87 @@ func getBlockByHash(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"block": block}, "", shared.ReturnCodeSuccess)
 }
 
-func getQueryParamWithTxs(c *gin.Context) (bool, error) {
-	withTxsStr := c.Request.URL.Query().Get("withTxs")
-	if withTxsStr == "" {
+func getRandomnessByNonce(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	nonce, err := getQueryParamNonce(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockNonce.Error()),
+		)
+		return
+	}
+
+	randomness, err := ef.GetRandomnessByNonce(nonce)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetRandomness.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"randomness": randomness}, "", shared.ReturnCodeSuccess)
+}
+
+func getRandomnessByEpoch(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	epoch, err := getQueryParamEpoch(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidEpoch.Error()),
+		)
+		return
+	}
+
+	randomness, err := ef.GetRandomnessByEpoch(epoch)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetRandomness.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"randomness": randomness}, "", shared.ReturnCodeSuccess)
+}
+
+// getQueryParamsWithTxsAndResults returns the withTxs and withResults query parameters. The smart contract results,
+// receipts and logs can be requested only together with the transactions
+func getQueryParamsWithTxsAndResults(c *gin.Context) (bool, bool, error) {
+	withTxs, err := getQueryParamBool(c, "withTxs")
+	if err != nil {
+		return false, false, err
+	}
+
+	withResults, err := getQueryParamBool(c, "withResults")
+	if err != nil {
+		return false, false, err
+	}
+
+	return withTxs, withTxs && withResults, nil
+}
+
+func getQueryParamBool(c *gin.Context, name string) (bool, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
 		return false, nil
 	}
 
-	return strconv.ParseBool(withTxsStr)
+	return strconv.ParseBool(valueStr)
 }
 
 func getQueryParamNonce(c *gin.Context) (uint64, error) {
@@ -159,6 +252,17 @@ func getQueryParamNonce(c *gin.Context) (uint64, error) {
 	return strconv.ParseUint(nonceStr, 10, 64)
 }
 
+func getQueryParamEpoch(c *gin.Context) (uint32, error) {
+	epochStr := c.Param("epoch")
+	if epochStr == "" {
+		return 0, errors.ErrInvalidEpoch
+	}
+
+	epoch, err := strconv.ParseUint(epochStr, 10, 32)
+
+	return uint32(epoch), err
+}
+
 func getFacade(c *gin.Context) (BlockService, bool) {
 	facadeObj, ok := c.Get("facade")
 	if !ok {
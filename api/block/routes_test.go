@@ -65,7 +65,7 @@ func TestGetBlockByNonce_EmptyNonceUrlParameterShouldErr(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.Facade{
-		GetBlockByNonceCalled: func(_ uint64, _ bool) (*block.APIBlock, error) {
+		GetBlockByNonceCalled: func(_ uint64, _ bool, _ bool) (*block.APIBlock, error) {
 			return &block.APIBlock{}, nil
 		},
 	}
@@ -85,7 +85,7 @@ func TestGetBlockByNonce_InvalidNonceShouldErr(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.Facade{
-		GetBlockByNonceCalled: func(_ uint64, _ bool) (*block.APIBlock, error) {
+		GetBlockByNonceCalled: func(_ uint64, _ bool, _ bool) (*block.APIBlock, error) {
 			return &block.APIBlock{}, nil
 		},
 	}
@@ -108,7 +108,7 @@ func TestGetBlockByNonce_FacadeErrorShouldErr(t *testing.T) {
 
 	expectedErr := errors.New("local err")
 	facade := mock.Facade{
-		GetBlockByNonceCalled: func(_ uint64, _ bool) (*block.APIBlock, error) {
+		GetBlockByNonceCalled: func(_ uint64, _ bool, _ bool) (*block.APIBlock, error) {
 			return nil, expectedErr
 		},
 	}
@@ -134,7 +134,7 @@ func TestGetBlockByNonce_ShouldWork(t *testing.T) {
 		Round: 39,
 	}
 	facade := mock.Facade{
-		GetBlockByNonceCalled: func(_ uint64, _ bool) (*block.APIBlock, error) {
+		GetBlockByNonceCalled: func(_ uint64, _ bool, _ bool) (*block.APIBlock, error) {
 			return &expectedBlock, nil
 		},
 	}
@@ -152,6 +152,39 @@ func TestGetBlockByNonce_ShouldWork(t *testing.T) {
 	assert.Equal(t, expectedBlock, response.Data.Block)
 }
 
+func TestGetBlockByNonce_WithResultsShouldRequireTxs(t *testing.T) {
+	t.Parallel()
+
+	providedWithTxs, providedWithResults := false, false
+	facade := mock.Facade{
+		GetBlockByNonceCalled: func(_ uint64, withTxs bool, withResults bool) (*block.APIBlock, error) {
+			providedWithTxs, providedWithResults = withTxs, withResults
+			return &block.APIBlock{}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/37?withResults=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.False(t, providedWithTxs)
+	assert.False(t, providedWithResults)
+
+	req, _ = http.NewRequest("GET", "/block/by-nonce/37?withTxs=true&withResults=true", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, providedWithTxs)
+	assert.True(t, providedWithResults)
+
+	req, _ = http.NewRequest("GET", "/block/by-nonce/37?withTxs=true&withResults=invalid", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
 // ---- by hash
 
 func TestGetBlockByHash_NilContextShouldError(t *testing.T) {
@@ -187,7 +220,7 @@ func TestGetBlockByHash_NoHashUrlParameterShouldErr(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.Facade{
-		GetBlockByNonceCalled: func(_ uint64, _ bool) (*block.APIBlock, error) {
+		GetBlockByNonceCalled: func(_ uint64, _ bool, _ bool) (*block.APIBlock, error) {
 			return &block.APIBlock{}, nil
 		},
 	}
@@ -208,7 +241,7 @@ func TestGetBlockByHash_FacadeErrorShouldErr(t *testing.T) {
 
 	expectedErr := errors.New("local err")
 	facade := mock.Facade{
-		GetBlockByHashCalled: func(_ string, _ bool) (*block.APIBlock, error) {
+		GetBlockByHashCalled: func(_ string, _ bool, _ bool) (*block.APIBlock, error) {
 			return nil, expectedErr
 		},
 	}
@@ -234,7 +267,7 @@ func TestGetBlockByHash_ShouldWork(t *testing.T) {
 		Round: 39,
 	}
 	facade := mock.Facade{
-		GetBlockByHashCalled: func(_ string, _ bool) (*block.APIBlock, error) {
+		GetBlockByHashCalled: func(_ string, _ bool, _ bool) (*block.APIBlock, error) {
 			return &expectedBlock, nil
 		},
 	}
@@ -252,6 +285,129 @@ func TestGetBlockByHash_ShouldWork(t *testing.T) {
 	assert.Equal(t, expectedBlock, response.Data.Block)
 }
 
+// ---- randomness
+
+type randomnessResponseData struct {
+	Randomness block.APIRandomness `json:"randomness"`
+}
+
+type randomnessResponse struct {
+	Data  randomnessResponseData `json:"data"`
+	Error string                 `json:"error"`
+	Code  string                 `json:"code"`
+}
+
+func TestGetRandomnessByNonce_InvalidNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/randomness/by-nonce/invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := randomnessResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockNonce.Error()))
+}
+
+func TestGetRandomnessByNonce_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.Facade{
+		GetRandomnessByNonceCalled: func(_ uint64) (*block.APIRandomness, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/randomness/by-nonce/37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := randomnessResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetRandomness.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetRandomnessByNonce_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedRandomness := block.APIRandomness{
+		Nonce:    37,
+		Round:    39,
+		RandSeed: "72616e64",
+	}
+	facade := mock.Facade{
+		GetRandomnessByNonceCalled: func(nonce uint64) (*block.APIRandomness, error) {
+			assert.Equal(t, uint64(37), nonce)
+			return &expectedRandomness, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/randomness/by-nonce/37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := randomnessResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedRandomness, response.Data.Randomness)
+}
+
+func TestGetRandomnessByEpoch_InvalidEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/randomness/by-epoch/5000000000", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := randomnessResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidEpoch.Error()))
+}
+
+func TestGetRandomnessByEpoch_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedRandomness := block.APIRandomness{
+		Nonce:    100,
+		Epoch:    4,
+		RandSeed: "72616e64",
+	}
+	facade := mock.Facade{
+		GetRandomnessByEpochCalled: func(epoch uint32) (*block.APIRandomness, error) {
+			assert.Equal(t, uint32(4), epoch)
+			return &expectedRandomness, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/randomness/by-epoch/4", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := randomnessResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedRandomness, response.Data.Randomness)
+}
+
 func startNodeServer(handler block.BlockService) *gin.Engine {
 	ws := gin.New()
 	ws.Use(cors.Default())
@@ -271,6 +427,8 @@ func getRoutesConfig() config.ApiRoutesConfig {
 				[]config.RouteConfig{
 					{Name: "/by-nonce/:nonce", Open: true},
 					{Name: "/by-hash/:hash", Open: true},
+					{Name: "/randomness/by-nonce/:nonce", Open: true},
+					{Name: "/randomness/by-epoch/:epoch", Open: true},
 				},
 			},
 		},
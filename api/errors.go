@@ -0,0 +1,9 @@
+package api
+
+import "errors"
+
+// ErrInvalidCORSConfig signals that an invalid CORS policy was configured
+var ErrInvalidCORSConfig = errors.New("invalid CORS configuration")
+
+// ErrNoApiRoutesConfig signals that no configuration was found for API routes
+var ErrNoApiRoutesConfig = errors.New("no configuration found for API routes")
@@ -61,6 +61,68 @@ var ErrGetTransaction = errors.New("getting transaction failed")
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
+// ErrInvalidEpoch signals an invalid epoch was provided
+var ErrInvalidEpoch = errors.New("invalid epoch")
+
+// ErrGetRandomness signals an error happening when trying to fetch the randomness of a block
+var ErrGetRandomness = errors.New("getting randomness failed")
+
+// ErrInvalidShardID signals an invalid shard ID was provided
+var ErrInvalidShardID = errors.New("invalid shard ID")
+
+// ErrGetRewardsAudit signals an error happening when trying to fetch the rewards audit record of an epoch
+var ErrGetRewardsAudit = errors.New("getting rewards audit failed")
+
+// ErrGetRewardsProof signals an error happening when trying to create the rewards proof of an address
+var ErrGetRewardsProof = errors.New("getting rewards proof failed")
+
+// ErrGetEpochAssignment signals an error happening when trying to fetch the validators assignment of an epoch
+var ErrGetEpochAssignment = errors.New("getting epoch validators assignment failed")
+
+// ErrGetValidatorEligibility signals an error happening when trying to estimate the eligible epoch of a validator
+var ErrGetValidatorEligibility = errors.New("getting validator eligibility estimate failed")
+
+// ErrGetNetworkAPR signals an error happening when trying to compute the network annual percentage rates
+var ErrGetNetworkAPR = errors.New("getting network APR failed")
+
+// ErrGetProjectedRewards signals an error happening when trying to compute the rewards projected for an owner
+var ErrGetProjectedRewards = errors.New("getting projected rewards failed")
+
+// ErrGetSupplyAccounting signals an error happening when trying to fetch the supply accounting record of an epoch
+var ErrGetSupplyAccounting = errors.New("getting supply accounting failed")
+
+// ErrGetEpochEconomics signals an error happening when trying to fetch the economics aggregates of an epoch
+var ErrGetEpochEconomics = errors.New("getting epoch economics failed")
+
+// ErrEmptyIdentifier signals that an empty event identifier was provided
+var ErrEmptyIdentifier = errors.New("identifier is empty")
+
+// ErrGetLogs signals an error happening when trying to fetch the log events
+var ErrGetLogs = errors.New("getting logs failed")
+
+// ErrGetESDTTokensList signals an error happening when trying to fetch the list of the ESDT tokens issued in the network
+var ErrGetESDTTokensList = errors.New("getting esdt tokens list failed")
+
+// ErrGetStakingQueue signals an error happening when trying to fetch the nodes waiting in the staking queue
+var ErrGetStakingQueue = errors.New("getting staking queue failed")
+
+// ErrPushSubscription signals an error happening when trying to subscribe to the push notifications
+var ErrPushSubscription = errors.New("push notifications subscription failed")
+
+// ErrGetTransactionsPool signals an error happening when trying to fetch the transactions pool
+var ErrGetTransactionsPool = errors.New("getting transactions pool failed")
+
+// ErrDuplicatedTransaction signals that the same transaction was provided more than once in a batch
+var ErrDuplicatedTransaction = errors.New("duplicated transaction in batch")
+
+// ErrTxNonceNotSequenced signals that the transactions of a sender in an all-or-nothing batch do not have
+// consecutive nonces
+var ErrTxNonceNotSequenced = errors.New("transaction nonce does not follow the previous nonce of the same sender in batch")
+
+// ErrBatchRejected signals that a valid transaction was not sent because another transaction of its all-or-nothing
+// batch was rejected
+var ErrBatchRejected = errors.New("batch rejected as it contains invalid transactions")
+
 // ErrQueryError signals a general query error
 var ErrQueryError = errors.New("query error")
 
@@ -69,3 +131,36 @@ var ErrGetPidInfo = errors.New("error getting peer id info")
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrSetLogLevel signals that the log level pattern could not be applied
+var ErrSetLogLevel = errors.New("error setting the log level")
+
+// ErrPeerManagement signals that a peer management operation failed
+var ErrPeerManagement = errors.New("peer management operation failed")
+
+// ErrStateSnapshot signals that the state snapshot could not be triggered
+var ErrStateSnapshot = errors.New("error triggering the state snapshot")
+
+// ErrStorageCompaction signals that the storage compaction failed
+var ErrStorageCompaction = errors.New("error compacting the storage")
+
+// ErrConfigReload signals that the configuration could not be reloaded
+var ErrConfigReload = errors.New("error reloading the configuration")
+
+// ErrUnknownProfile signals that an unknown runtime profile was requested
+var ErrUnknownProfile = errors.New("unknown profile")
+
+// ErrGetProof signals an error in computing a merkle proof
+var ErrGetProof = errors.New("get proof error")
+
+// ErrVerifyProof signals an error in verifying a merkle proof
+var ErrVerifyProof = errors.New("verify proof error")
+
+// ErrGetDelegations signals an error happening when trying to fetch the delegation positions of an address
+var ErrGetDelegations = errors.New("getting delegations for account failed")
+
+// ErrNodeNotHealthy signals that at least one of the subsystems checked by the health probe is failing
+var ErrNodeNotHealthy = errors.New("node is not healthy")
+
+// ErrNodeNotReady signals that at least one of the subsystems checked by the readiness probe is failing
+var ErrNodeNotReady = errors.New("node is not ready")
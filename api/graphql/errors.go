@@ -0,0 +1,21 @@
+package graphql
+
+import "errors"
+
+// ErrNilFacadeHandler signals that a nil facade handler has been provided
+var ErrNilFacadeHandler = errors.New("nil facade handler")
+
+// ErrInvalidQuery signals that the provided query could not be parsed
+var ErrInvalidQuery = errors.New("invalid query")
+
+// ErrEmptyQuery signals that no query has been provided
+var ErrEmptyQuery = errors.New("empty query")
+
+// ErrUnknownField signals that an unknown root field has been requested
+var ErrUnknownField = errors.New("unknown field")
+
+// ErrMissingSelection signals that no sub-field has been selected for a root field
+var ErrMissingSelection = errors.New("a selection of sub-fields is required")
+
+// ErrInvalidArgument signals that an invalid argument has been provided for a field
+var ErrInvalidArgument = errors.New("invalid argument")
@@ -0,0 +1,370 @@
+package graphql
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+// Error is a GraphQL error entry of the response
+type Error struct {
+	Message string   `json:"message"`
+	Path    []string `json:"path,omitempty"`
+}
+
+// Response is the GraphQL response
+type Response struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []*Error               `json:"errors,omitempty"`
+}
+
+type resolverFunc func(facade FacadeHandler, f *field) (interface{}, error)
+
+// executor resolves the root fields of a query against the node facade
+type executor struct {
+	facade    FacadeHandler
+	resolvers map[string]resolverFunc
+}
+
+// newExecutor creates a new query executor over the provided facade
+func newExecutor(facade FacadeHandler) (*executor, error) {
+	if check.IfNil(facade) {
+		return nil, ErrNilFacadeHandler
+	}
+
+	return &executor{
+		facade: facade,
+		resolvers: map[string]resolverFunc{
+			"account":             resolveAccount,
+			"block":               resolveBlock,
+			"transaction":         resolveTransaction,
+			"esdtToken":           resolveESDTToken,
+			"validatorStatistics": resolveValidatorStatistics,
+		},
+	}, nil
+}
+
+// execute runs the query and returns the response. A failing root field is returned as null and its error is
+// reported in the errors list, so the other root fields are still resolved
+func (e *executor) execute(query string, variables map[string]interface{}) *Response {
+	response := &Response{
+		Data: make(map[string]interface{}),
+	}
+
+	selections, err := parseQuery(query, variables)
+	if err != nil {
+		response.Data = nil
+		response.Errors = []*Error{{Message: err.Error()}}
+		return response
+	}
+
+	for _, f := range selections {
+		value, errResolve := e.resolveRootField(f)
+		if errResolve != nil {
+			response.Data[f.responseKey()] = nil
+			response.Errors = append(response.Errors, &Error{
+				Message: errResolve.Error(),
+				Path:    []string{f.responseKey()},
+			})
+			continue
+		}
+
+		response.Data[f.responseKey()] = value
+	}
+
+	return response
+}
+
+func (e *executor) resolveRootField(f *field) (interface{}, error) {
+	resolver, ok := e.resolvers[f.name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownField, f.name)
+	}
+	if len(f.selections) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrMissingSelection, f.name)
+	}
+
+	value, err := resolver(e.facade, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return project(value, f.selections)
+}
+
+// project keeps only the selected fields of the provided value. The value is converted to its JSON representation
+// first, so the field names are the ones used by the REST API responses
+func project(value interface{}, selections []*field) (interface{}, error) {
+	buff, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var generic interface{}
+	err = json.Unmarshal(buff, &generic)
+	if err != nil {
+		return nil, err
+	}
+
+	return projectGeneric(generic, selections), nil
+}
+
+func projectGeneric(value interface{}, selections []*field) interface{} {
+	switch typedValue := value.(type) {
+	case []interface{}:
+		result := make([]interface{}, 0, len(typedValue))
+		for _, element := range typedValue {
+			result = append(result, projectGeneric(element, selections))
+		}
+		return result
+	case map[string]interface{}:
+		if len(selections) == 0 {
+			return typedValue
+		}
+		result := make(map[string]interface{}, len(selections))
+		for _, selection := range selections {
+			result[selection.responseKey()] = projectGeneric(typedValue[selection.name], selection.selections)
+		}
+		return result
+	default:
+		return typedValue
+	}
+}
+
+type accountResponse struct {
+	Address    string               `json:"address"`
+	Nonce      uint64               `json:"nonce"`
+	Balance    string               `json:"balance"`
+	Username   string               `json:"username"`
+	Code       string               `json:"code"`
+	CodeHash   []byte               `json:"codeHash"`
+	RootHash   []byte               `json:"rootHash"`
+	ESDTTokens []*esdtTokenResponse `json:"esdtTokens,omitempty"`
+}
+
+type esdtTokenResponse struct {
+	TokenIdentifier string `json:"tokenIdentifier"`
+	Balance         string `json:"balance"`
+	Properties      string `json:"properties"`
+}
+
+type validatorStatisticsResponse struct {
+	PublicKey                     string  `json:"publicKey"`
+	TempRating                    float32 `json:"tempRating"`
+	Rating                        float32 `json:"rating"`
+	RatingModifier                float32 `json:"ratingModifier"`
+	NumLeaderSuccess              uint32  `json:"numLeaderSuccess"`
+	NumLeaderFailure              uint32  `json:"numLeaderFailure"`
+	NumValidatorSuccess           uint32  `json:"numValidatorSuccess"`
+	NumValidatorFailure           uint32  `json:"numValidatorFailure"`
+	NumValidatorIgnoredSignatures uint32  `json:"numValidatorIgnoredSignatures"`
+	ShardID                       uint32  `json:"shardId"`
+	ValidatorStatus               string  `json:"validatorStatus"`
+	InProbation                   bool    `json:"inProbation"`
+}
+
+func resolveAccount(facade FacadeHandler, f *field) (interface{}, error) {
+	address, err := requiredStringArg(f, "address")
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := facade.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &accountResponse{
+		Address:  address,
+		Nonce:    account.GetNonce(),
+		Balance:  account.GetBalance().String(),
+		Username: string(account.GetUserName()),
+		Code:     hex.EncodeToString(facade.GetCode(account)),
+		CodeHash: account.GetCodeHash(),
+		RootHash: account.GetRootHash(),
+	}
+	if !f.isSelected("esdtTokens") {
+		return response, nil
+	}
+
+	tokens, err := facade.GetAllESDTTokens(address)
+	if err != nil {
+		return nil, err
+	}
+	response.ESDTTokens = make([]*esdtTokenResponse, 0, len(tokens))
+	for _, tokenIdentifier := range tokens {
+		token, errGet := getESDTToken(facade, address, tokenIdentifier)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		response.ESDTTokens = append(response.ESDTTokens, token)
+	}
+
+	return response, nil
+}
+
+func resolveESDTToken(facade FacadeHandler, f *field) (interface{}, error) {
+	address, err := requiredStringArg(f, "address")
+	if err != nil {
+		return nil, err
+	}
+	tokenIdentifier, err := requiredStringArg(f, "tokenIdentifier")
+	if err != nil {
+		return nil, err
+	}
+
+	return getESDTToken(facade, address, tokenIdentifier)
+}
+
+func getESDTToken(facade FacadeHandler, address string, tokenIdentifier string) (*esdtTokenResponse, error) {
+	balance, properties, err := facade.GetESDTBalance(address, tokenIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &esdtTokenResponse{
+		TokenIdentifier: tokenIdentifier,
+		Balance:         balance,
+		Properties:      properties,
+	}, nil
+}
+
+func resolveBlock(facade FacadeHandler, f *field) (interface{}, error) {
+	withTxs := f.isSelected("miniBlocks")
+
+	hash, hasHash := f.args["hash"]
+	if hasHash {
+		hashStr, ok := hash.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: hash should be a string", ErrInvalidArgument)
+		}
+		return facade.GetBlockByHash(hashStr, withTxs, false)
+	}
+
+	nonce, err := uint64Arg(f, "nonce")
+	if err != nil {
+		return nil, err
+	}
+
+	return facade.GetBlockByNonce(nonce, withTxs, false)
+}
+
+func resolveTransaction(facade FacadeHandler, f *field) (interface{}, error) {
+	hash, err := requiredStringArg(f, "hash")
+	if err != nil {
+		return nil, err
+	}
+
+	return facade.GetTransaction(hash, f.isSelected("smartContractResults") || f.isSelected("receipt"))
+}
+
+func resolveValidatorStatistics(facade FacadeHandler, f *field) (interface{}, error) {
+	statistics, err := facade.ValidatorStatisticsApi()
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := stringListArg(f, "publicKeys")
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeys := make([]string, 0, len(statistics))
+	for publicKey := range statistics {
+		_, found := filter[publicKey]
+		if len(filter) > 0 && !found {
+			continue
+		}
+
+		publicKeys = append(publicKeys, publicKey)
+	}
+	sort.Strings(publicKeys)
+
+	response := make([]*validatorStatisticsResponse, 0, len(publicKeys))
+	for _, publicKey := range publicKeys {
+		validatorStatistics := statistics[publicKey]
+		if validatorStatistics == nil {
+			continue
+		}
+
+		response = append(response, &validatorStatisticsResponse{
+			PublicKey:                     publicKey,
+			TempRating:                    validatorStatistics.TempRating,
+			Rating:                        validatorStatistics.Rating,
+			RatingModifier:                validatorStatistics.RatingModifier,
+			NumLeaderSuccess:              validatorStatistics.NumLeaderSuccess,
+			NumLeaderFailure:              validatorStatistics.NumLeaderFailure,
+			NumValidatorSuccess:           validatorStatistics.NumValidatorSuccess,
+			NumValidatorFailure:           validatorStatistics.NumValidatorFailure,
+			NumValidatorIgnoredSignatures: validatorStatistics.NumValidatorIgnoredSignatures,
+			ShardID:                       validatorStatistics.ShardId,
+			ValidatorStatus:               validatorStatistics.ValidatorStatus,
+			InProbation:                   validatorStatistics.InProbation,
+		})
+	}
+
+	return response, nil
+}
+
+func requiredStringArg(f *field, name string) (string, error) {
+	value, ok := f.args[name]
+	if !ok {
+		return "", fmt.Errorf("%w: %s is required for %s", ErrInvalidArgument, name, f.name)
+	}
+
+	valueStr, ok := value.(string)
+	if !ok || len(valueStr) == 0 {
+		return "", fmt.Errorf("%w: %s should be a non empty string", ErrInvalidArgument, name)
+	}
+
+	return valueStr, nil
+}
+
+func uint64Arg(f *field, name string) (uint64, error) {
+	value, ok := f.args[name]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s is required for %s", ErrInvalidArgument, name, f.name)
+	}
+
+	switch typedValue := value.(type) {
+	case int64:
+		if typedValue >= 0 {
+			return uint64(typedValue), nil
+		}
+	case float64:
+		// the variables are decoded from JSON, so the integers are received as float64
+		if typedValue >= 0 && typedValue == math.Trunc(typedValue) {
+			return uint64(typedValue), nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %s should be a positive integer", ErrInvalidArgument, name)
+}
+
+func stringListArg(f *field, name string) (map[string]struct{}, error) {
+	result := make(map[string]struct{})
+	value, ok := f.args[name]
+	if !ok || value == nil {
+		return result, nil
+	}
+
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: %s should be a list of strings", ErrInvalidArgument, name)
+	}
+	for _, element := range list {
+		elementStr, isString := element.(string)
+		if !isString {
+			return nil, fmt.Errorf("%w: %s should be a list of strings", ErrInvalidArgument, name)
+		}
+
+		result[elementStr] = struct{}{}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,438 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// field is a parsed selection of a GraphQL query
+type field struct {
+	alias      string
+	name       string
+	args       map[string]interface{}
+	selections []*field
+}
+
+// responseKey returns the key under which the field value is written in the response
+func (f *field) responseKey() string {
+	if len(f.alias) > 0 {
+		return f.alias
+	}
+
+	return f.name
+}
+
+// isSelected returns true if the provided name is part of the field selections
+func (f *field) isSelected(name string) bool {
+	for _, selection := range f.selections {
+		if selection.name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+const (
+	tokenEOF = iota
+	tokenName
+	tokenString
+	tokenNumber
+	tokenPunctuator
+	tokenVariable
+)
+
+type token struct {
+	kind  int
+	value string
+}
+
+type lexer struct {
+	input []rune
+	pos   int
+}
+
+func (l *lexer) next() (token, error) {
+	l.skipIgnored()
+	if l.pos >= len(l.input) {
+		return token{kind: tokenEOF}, nil
+	}
+
+	c := l.input[l.pos]
+	switch {
+	case strings.ContainsRune("{}():[]=!", c):
+		l.pos++
+		return token{kind: tokenPunctuator, value: string(c)}, nil
+	case c == '$':
+		l.pos++
+		name := l.readName()
+		if len(name) == 0 {
+			return token{}, fmt.Errorf("%w: invalid variable at position %d", ErrInvalidQuery, l.pos)
+		}
+		return token{kind: tokenVariable, value: name}, nil
+	case c == '"':
+		return l.readString()
+	case c == '-' || (c >= '0' && c <= '9'):
+		return l.readNumber(), nil
+	case isNameStart(c):
+		return token{kind: tokenName, value: l.readName()}, nil
+	case c == '.':
+		return token{}, fmt.Errorf("%w: fragments are not supported", ErrInvalidQuery)
+	}
+
+	return token{}, fmt.Errorf("%w: unexpected character %q at position %d", ErrInvalidQuery, c, l.pos)
+}
+
+func (l *lexer) skipIgnored() {
+	for l.pos < len(l.input) {
+		c := l.input[l.pos]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			l.pos++
+		case c == '#':
+			for l.pos < len(l.input) && l.input[l.pos] != '\n' {
+				l.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (l *lexer) readName() string {
+	start := l.pos
+	for l.pos < len(l.input) && (isNameStart(l.input[l.pos]) || (l.input[l.pos] >= '0' && l.input[l.pos] <= '9')) {
+		l.pos++
+	}
+
+	return string(l.input[start:l.pos])
+}
+
+func (l *lexer) readNumber() token {
+	start := l.pos
+	l.pos++
+	for l.pos < len(l.input) && strings.ContainsRune("0123456789.eE+-", l.input[l.pos]) {
+		l.pos++
+	}
+
+	return token{kind: tokenNumber, value: string(l.input[start:l.pos])}
+}
+
+func (l *lexer) readString() (token, error) {
+	start := l.pos
+	l.pos++
+	for l.pos < len(l.input) {
+		switch l.input[l.pos] {
+		case '\\':
+			l.pos += 2
+		case '"':
+			l.pos++
+			value, err := strconv.Unquote(string(l.input[start:l.pos]))
+			if err != nil {
+				return token{}, fmt.Errorf("%w: invalid string at position %d", ErrInvalidQuery, start)
+			}
+			return token{kind: tokenString, value: value}, nil
+		case '\n':
+			return token{}, fmt.Errorf("%w: unterminated string at position %d", ErrInvalidQuery, start)
+		default:
+			l.pos++
+		}
+	}
+
+	return token{}, fmt.Errorf("%w: unterminated string at position %d", ErrInvalidQuery, start)
+}
+
+func isNameStart(c rune) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+type parser struct {
+	lexer     *lexer
+	current   token
+	variables map[string]interface{}
+}
+
+// parseQuery parses a single query operation and returns its root selections. Only the query operation type
+// is supported, without fragments or directives
+func parseQuery(query string, variables map[string]interface{}) ([]*field, error) {
+	p := &parser{
+		lexer:     &lexer{input: []rune(query)},
+		variables: variables,
+	}
+	err := p.advance()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.current.kind == tokenName {
+		if p.current.value != "query" {
+			return nil, fmt.Errorf("%w: operation %s is not supported", ErrInvalidQuery, p.current.value)
+		}
+		err = p.parseOperationHeader()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	selections, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	if p.current.kind != tokenEOF {
+		return nil, fmt.Errorf("%w: only one operation per request is supported", ErrInvalidQuery)
+	}
+
+	return selections, nil
+}
+
+func (p *parser) advance() error {
+	var err error
+	p.current, err = p.lexer.next()
+	return err
+}
+
+func (p *parser) isPunctuator(value string) bool {
+	return p.current.kind == tokenPunctuator && p.current.value == value
+}
+
+func (p *parser) expectPunctuator(value string) error {
+	if !p.isPunctuator(value) {
+		return fmt.Errorf("%w: expected %s, got %q", ErrInvalidQuery, value, p.current.value)
+	}
+
+	return p.advance()
+}
+
+// parseOperationHeader skips the query keyword, the optional operation name and the variable definitions.
+// The variable values are provided separately, so the definitions are only used for validating the syntax
+func (p *parser) parseOperationHeader() error {
+	err := p.advance()
+	if err != nil {
+		return err
+	}
+	if p.current.kind == tokenName {
+		err = p.advance()
+		if err != nil {
+			return err
+		}
+	}
+	if !p.isPunctuator("(") {
+		return nil
+	}
+
+	err = p.advance()
+	if err != nil {
+		return err
+	}
+	for !p.isPunctuator(")") {
+		if p.current.kind != tokenVariable {
+			return fmt.Errorf("%w: expected a variable definition, got %q", ErrInvalidQuery, p.current.value)
+		}
+		err = p.advance()
+		if err != nil {
+			return err
+		}
+		err = p.expectPunctuator(":")
+		if err != nil {
+			return err
+		}
+		err = p.skipType()
+		if err != nil {
+			return err
+		}
+		if p.isPunctuator("=") {
+			return fmt.Errorf("%w: default variable values are not supported", ErrInvalidQuery)
+		}
+	}
+
+	return p.advance()
+}
+
+func (p *parser) skipType() error {
+	var err error
+	if p.isPunctuator("[") {
+		err = p.advance()
+		if err != nil {
+			return err
+		}
+		err = p.skipType()
+		if err != nil {
+			return err
+		}
+		err = p.expectPunctuator("]")
+	} else if p.current.kind == tokenName {
+		err = p.advance()
+	} else {
+		return fmt.Errorf("%w: expected a type, got %q", ErrInvalidQuery, p.current.value)
+	}
+	if err != nil {
+		return err
+	}
+
+	if p.isPunctuator("!") {
+		return p.advance()
+	}
+
+	return nil
+}
+
+func (p *parser) parseSelectionSet() ([]*field, error) {
+	err := p.expectPunctuator("{")
+	if err != nil {
+		return nil, err
+	}
+
+	selections := make([]*field, 0)
+	for !p.isPunctuator("}") {
+		f, errParse := p.parseField()
+		if errParse != nil {
+			return nil, errParse
+		}
+
+		selections = append(selections, f)
+	}
+	if len(selections) == 0 {
+		return nil, fmt.Errorf("%w: empty selection set", ErrInvalidQuery)
+	}
+
+	return selections, p.advance()
+}
+
+func (p *parser) parseField() (*field, error) {
+	if p.current.kind != tokenName {
+		return nil, fmt.Errorf("%w: expected a field name, got %q", ErrInvalidQuery, p.current.value)
+	}
+
+	f := &field{
+		name: p.current.value,
+		args: make(map[string]interface{}),
+	}
+	err := p.advance()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.isPunctuator(":") {
+		err = p.advance()
+		if err != nil {
+			return nil, err
+		}
+		if p.current.kind != tokenName {
+			return nil, fmt.Errorf("%w: expected a field name after alias %s", ErrInvalidQuery, f.name)
+		}
+		f.alias = f.name
+		f.name = p.current.value
+		err = p.advance()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if p.isPunctuator("(") {
+		err = p.parseArguments(f)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if p.isPunctuator("{") {
+		f.selections, err = p.parseSelectionSet()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
+
+func (p *parser) parseArguments(f *field) error {
+	err := p.advance()
+	if err != nil {
+		return err
+	}
+
+	for !p.isPunctuator(")") {
+		if p.current.kind != tokenName {
+			return fmt.Errorf("%w: expected an argument name, got %q", ErrInvalidQuery, p.current.value)
+		}
+		name := p.current.value
+		err = p.advance()
+		if err != nil {
+			return err
+		}
+		err = p.expectPunctuator(":")
+		if err != nil {
+			return err
+		}
+
+		f.args[name], err = p.parseValue()
+		if err != nil {
+			return err
+		}
+	}
+
+	return p.advance()
+}
+
+func (p *parser) parseValue() (interface{}, error) {
+	current := p.current
+	if current.kind == tokenPunctuator && current.value == "[" {
+		return p.parseList()
+	}
+
+	err := p.advance()
+	if err != nil {
+		return nil, err
+	}
+
+	switch current.kind {
+	case tokenString:
+		return current.value, nil
+	case tokenNumber:
+		if strings.ContainsAny(current.value, ".eE") {
+			return strconv.ParseFloat(current.value, 64)
+		}
+		return strconv.ParseInt(current.value, 10, 64)
+	case tokenVariable:
+		value, ok := p.variables[current.value]
+		if !ok {
+			return nil, fmt.Errorf("%w: variable $%s was not provided", ErrInvalidQuery, current.value)
+		}
+		return value, nil
+	case tokenName:
+		switch current.value {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		}
+		return current.value, nil
+	}
+
+	return nil, fmt.Errorf("%w: unexpected value %q", ErrInvalidQuery, current.value)
+}
+
+func (p *parser) parseList() (interface{}, error) {
+	err := p.advance()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]interface{}, 0)
+	for !p.isPunctuator("]") {
+		if p.current.kind == tokenEOF {
+			return nil, fmt.Errorf("%w: unterminated list", ErrInvalidQuery)
+		}
+
+		value, errParse := p.parseValue()
+		if errParse != nil {
+			return nil, errParse
+		}
+		values = append(values, value)
+	}
+
+	return values, p.advance()
+}
@@ -0,0 +1,95 @@
+package graphql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuery_ShouldParseFieldsAliasesAndArguments(t *testing.T) {
+	t.Parallel()
+
+	query := `
+	query Dashboard($address: String!, $keys: [String!]) {
+		# the account of the dashboard owner
+		owner: account(address: $address) { nonce balance esdtTokens { tokenIdentifier } }
+		block(nonce: 42, withTxs: true) { hash }
+		validatorStatistics(publicKeys: $keys, rating: 1.5) { rating }
+	}`
+	variables := map[string]interface{}{
+		"address": "erd1",
+		"keys":    []interface{}{"pk1", "pk2"},
+	}
+
+	selections, err := parseQuery(query, variables)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(selections))
+
+	assert.Equal(t, "owner", selections[0].responseKey())
+	assert.Equal(t, "account", selections[0].name)
+	assert.Equal(t, "erd1", selections[0].args["address"])
+	require.Equal(t, 3, len(selections[0].selections))
+	assert.True(t, selections[0].isSelected("esdtTokens"))
+	assert.Equal(t, "tokenIdentifier", selections[0].selections[2].selections[0].name)
+
+	assert.Equal(t, "block", selections[1].responseKey())
+	assert.Equal(t, int64(42), selections[1].args["nonce"])
+	assert.Equal(t, true, selections[1].args["withTxs"])
+
+	assert.Equal(t, []interface{}{"pk1", "pk2"}, selections[2].args["publicKeys"])
+	assert.Equal(t, 1.5, selections[2].args["rating"])
+}
+
+func TestParseQuery_InvalidQueriesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	queries := []string{
+		"",
+		"{}",
+		"{ account(address: \"erd1\") { nonce }",
+		"mutation { account { nonce } }",
+		"{ account { ...accountFields } }",
+		"{ account(address: $missing) { nonce } }",
+		"{ a { b } } { c { d } }",
+		"query ($a: String = \"x\") { account(address: $a) { nonce } }",
+		"{ account(address: \"unterminated) { nonce } }",
+	}
+
+	for _, query := range queries {
+		selections, err := parseQuery(query, nil)
+		assert.Nil(t, selections, query)
+		assert.True(t, errors.Is(err, ErrInvalidQuery), query)
+	}
+}
+
+func TestProject_ShouldKeepOnlySelectedFields(t *testing.T) {
+	t.Parallel()
+
+	value := []*accountResponse{
+		{
+			Address: "erd1",
+			Nonce:   3,
+			ESDTTokens: []*esdtTokenResponse{
+				{TokenIdentifier: "TKN-01", Balance: "10"},
+			},
+		},
+	}
+	selections, err := parseQuery("{ a { n: nonce missing esdtTokens { balance } } }", nil)
+	require.Nil(t, err)
+
+	projected, err := project(value, selections[0].selections)
+	require.Nil(t, err)
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"n":       float64(3),
+			"missing": nil,
+			"esdtTokens": []interface{}{
+				map[string]interface{}{"balance": "10"},
+			},
+		},
+	}
+	assert.Equal(t, expected, projected)
+}
@@ -0,0 +1,123 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gin-gonic/gin"
+)
+
+const queryPath = "/query"
+
+// FacadeHandler interface defines methods that can be used by the gin webserver
+type FacadeHandler interface {
+	GetAccount(address string) (state.UserAccountHandler, error)
+	GetCode(account state.UserAccountHandler) []byte
+	GetESDTBalance(address string, key string) (string, string, error)
+	GetAllESDTTokens(address string) ([]string, error)
+	GetBlockByHash(hash string, withTxs bool, withResults bool) (*apiBlock.APIBlock, error)
+	GetBlockByNonce(nonce uint64, withTxs bool, withResults bool) (*apiBlock.APIBlock, error)
+	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	IsInterfaceNil() bool
+}
+
+// QueryRequest represents the structure of a GraphQL request
+type QueryRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Routes defines GraphQL related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodPost, queryPath, Query)
+	router.RegisterHandler(http.MethodGet, queryPath, Query)
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	return facade, true
+}
+
+// Query executes the GraphQL query provided either as a JSON body or, for GET requests, in the query and
+// variables URL parameters. The response follows the GraphQL format rather than the generic API response
+func Query(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	request, err := parseRequest(c)
+	if err != nil {
+		respondWithErrors(c, http.StatusBadRequest, fmt.Errorf("%s: %w", errors.ErrValidation.Error(), err))
+		return
+	}
+
+	queryExecutor, err := newExecutor(facade)
+	if err != nil {
+		respondWithErrors(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	response := queryExecutor.execute(request.Query, request.Variables)
+	if response.Data == nil {
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func parseRequest(c *gin.Context) (*QueryRequest, error) {
+	request := &QueryRequest{}
+	if c.Request.Method == http.MethodGet {
+		request.Query = c.Query("query")
+		request.OperationName = c.Query("operationName")
+		variables := c.Query("variables")
+		if len(variables) > 0 {
+			err := json.Unmarshal([]byte(variables), &request.Variables)
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		err := c.ShouldBindJSON(request)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(request.Query) == 0 {
+		return nil, ErrEmptyQuery
+	}
+
+	return request, nil
+}
+
+func respondWithErrors(c *gin.Context, status int, err error) {
+	c.JSON(
+		status,
+		&Response{
+			Errors: []*Error{{Message: err.Error()}},
+		},
+	)
+}
@@ -0,0 +1,227 @@
+package graphql_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/graphql"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var log = logger.GetOrCreate("api/graphql_test")
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func startNodeServer(handler graphql.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ginGraphqlRoute := ws.Group("/graphql")
+	if handler != nil {
+		ginGraphqlRoute.Use(middleware.WithFacade(handler))
+	}
+	graphqlRoute, _ := wrapper.NewRouterWrapper("graphql", ginGraphqlRoute, getRoutesConfig())
+	graphql.Routes(graphqlRoute)
+	return ws
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	log.LogIfError(err)
+}
+
+func postQuery(ws *gin.Engine, request graphql.QueryRequest) (*httptest.ResponseRecorder, *graphql.Response) {
+	buff, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/graphql/query", bytes.NewBuffer(buff))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &graphql.Response{}
+	loadResponse(resp.Body, response)
+
+	return resp, response
+}
+
+func TestQuery_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+	req, _ := http.NewRequest("POST", "/graphql/query", bytes.NewBuffer([]byte("{}")))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+}
+
+func TestQuery_EmptyQueryShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+	resp, response := postQuery(ws, graphql.QueryRequest{})
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	require.Equal(t, 1, len(response.Errors))
+	assert.True(t, strings.Contains(response.Errors[0].Message, apiErrors.ErrValidation.Error()))
+	assert.True(t, strings.Contains(response.Errors[0].Message, graphql.ErrEmptyQuery.Error()))
+}
+
+func TestQuery_InvalidQueryShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+	resp, response := postQuery(ws, graphql.QueryRequest{Query: "{ account("})
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Nil(t, response.Data)
+	require.Equal(t, 1, len(response.Errors))
+	assert.True(t, strings.Contains(response.Errors[0].Message, graphql.ErrInvalidQuery.Error()))
+}
+
+func TestQuery_ShouldResolveSelectedFields(t *testing.T) {
+	t.Parallel()
+
+	withTxsRequested := false
+	facade := &mock.Facade{
+		GetAccountHandler: func(address string) (state.UserAccountHandler, error) {
+			acc, _ := state.NewUserAccount([]byte("1234"))
+			_ = acc.AddToBalance(big.NewInt(100))
+			acc.IncreaseNonce(7)
+			return acc, nil
+		},
+		GetAllESDTTokensCalled: func(address string) ([]string, error) {
+			return []string{"TKN-01"}, nil
+		},
+		GetESDTBalanceCalled: func(address string, key string) (string, string, error) {
+			return "25", "00", nil
+		},
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool, _ bool) (*apiBlock.APIBlock, error) {
+			withTxsRequested = withTxs
+			return &apiBlock.APIBlock{Nonce: nonce, Hash: "blockHash", Shard: 1}, nil
+		},
+		GetTransactionHandler: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			return nil, errors.New("transaction not found")
+		},
+		ValidatorStatisticsHandler: func() (map[string]*state.ValidatorApiResponse, error) {
+			return map[string]*state.ValidatorApiResponse{
+				"pk2": {Rating: 50},
+				"pk1": {Rating: 70},
+				"pk3": {Rating: 90},
+			}, nil
+		},
+	}
+	ws := startNodeServer(facade)
+
+	request := graphql.QueryRequest{
+		Query: `query ($address: String!, $nonce: Int!) {
+			account(address: $address) { balance nonce esdtTokens { tokenIdentifier balance } }
+			block(nonce: $nonce) { hash shard }
+			tx: transaction(hash: "abc") { status }
+			validatorStatistics(publicKeys: ["pk1", "pk2"]) { publicKey rating }
+		}`,
+		Variables: map[string]interface{}{
+			"address": "erd1",
+			"nonce":   42,
+		},
+	}
+	resp, response := postQuery(ws, request)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.False(t, withTxsRequested)
+
+	expectedData := map[string]interface{}{
+		"account": map[string]interface{}{
+			"balance": "100",
+			"nonce":   float64(7),
+			"esdtTokens": []interface{}{
+				map[string]interface{}{"tokenIdentifier": "TKN-01", "balance": "25"},
+			},
+		},
+		"block": map[string]interface{}{
+			"hash":  "blockHash",
+			"shard": float64(1),
+		},
+		"tx": nil,
+		"validatorStatistics": []interface{}{
+			map[string]interface{}{"publicKey": "pk1", "rating": float64(70)},
+			map[string]interface{}{"publicKey": "pk2", "rating": float64(50)},
+		},
+	}
+	assert.Equal(t, expectedData, response.Data)
+	require.Equal(t, 1, len(response.Errors))
+	assert.Equal(t, []string{"tx"}, response.Errors[0].Path)
+	assert.Equal(t, "transaction not found", response.Errors[0].Message)
+}
+
+func TestQuery_GetRequestShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetBlockByHashCalled: func(hash string, withTxs bool, _ bool) (*apiBlock.APIBlock, error) {
+			assert.True(t, withTxs)
+			return &apiBlock.APIBlock{Hash: hash, MiniBlocks: []*apiBlock.APIMiniBlock{}}, nil
+		},
+	}
+	ws := startNodeServer(facade)
+
+	params := url.Values{}
+	params.Set("query", "query ($hash: String!) { block(hash: $hash) { hash miniBlocks { hash } } }")
+	params.Set("variables", `{"hash": "aabb"}`)
+	req, _ := http.NewRequest("GET", "/graphql/query?"+params.Encode(), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := &graphql.Response{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, 0, len(response.Errors))
+	assert.Equal(t, map[string]interface{}{"hash": "aabb", "miniBlocks": nil}, response.Data["block"])
+}
+
+func TestQuery_UnknownFieldShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+	resp, response := postQuery(ws, graphql.QueryRequest{Query: "{ unknown { field } }"})
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, 1, len(response.Errors))
+	assert.True(t, strings.Contains(response.Errors[0].Message, graphql.ErrUnknownField.Error()))
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"graphql": {
+				Routes: []config.RouteConfig{
+					{Name: "/query", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,125 @@
+package logs
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getLogsPath = ""
+
+	queryParamAddress    = "address"
+	queryParamIdentifier = "identifier"
+	queryParamFromBlock  = "fromBlock"
+	queryParamToBlock    = "toBlock"
+)
+
+// FacadeHandler interface defines methods that can be used by the gin webserver
+type FacadeHandler interface {
+	GetLogs(filter transaction.LogsFilter) ([]*transaction.ApiLogEvent, error)
+	IsInterfaceNil() bool
+}
+
+// Routes defines logs related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, getLogsPath, GetLogs)
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	return facade, true
+}
+
+// GetLogs returns the log events having the identifier provided in the query parameters, optionally filtered by the
+// address that generated them, emitted between the fromBlock and toBlock nonces
+func GetLogs(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	filter, err := ParseLogsFilter(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+	filter.Address = c.Request.URL.Query().Get(queryParamAddress)
+	if len(filter.Identifier) == 0 {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrEmptyIdentifier.Error()),
+		)
+		return
+	}
+
+	RespondWithLogs(c, facade, filter)
+}
+
+// ParseLogsFilter returns the logs filter built from the identifier, fromBlock and toBlock query parameters
+func ParseLogsFilter(c *gin.Context) (transaction.LogsFilter, error) {
+	query := c.Request.URL.Query()
+	filter := transaction.LogsFilter{
+		Identifier: query.Get(queryParamIdentifier),
+	}
+
+	var err error
+	filter.FromBlock, err = parseBlockNonce(query.Get(queryParamFromBlock), queryParamFromBlock)
+	if err != nil {
+		return transaction.LogsFilter{}, err
+	}
+	filter.ToBlock, err = parseBlockNonce(query.Get(queryParamToBlock), queryParamToBlock)
+	if err != nil {
+		return transaction.LogsFilter{}, err
+	}
+
+	return filter, nil
+}
+
+func parseBlockNonce(nonceStr string, paramName string) (*uint64, error) {
+	if nonceStr == "" {
+		return nil, nil
+	}
+
+	nonce, err := strconv.ParseUint(nonceStr, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s parameter: %w", paramName, err)
+	}
+
+	return &nonce, nil
+}
+
+// RespondWithLogs fetches the log events matching the provided filter and writes them in the response
+func RespondWithLogs(c *gin.Context, facade FacadeHandler, filter transaction.LogsFilter) {
+	events, err := facade.GetLogs(filter)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetLogs.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"logs": events}, "", shared.ReturnCodeSuccess)
+}
@@ -0,0 +1,140 @@
+package logs_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/logs"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+var log = logger.GetOrCreate("api/logs_test")
+
+type logsResponse struct {
+	Data struct {
+		Logs []*transaction.ApiLogEvent `json:"logs"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func startNodeServer(handler logs.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	logsRoutes := ws.Group("/logs")
+	if handler != nil {
+		logsRoutes.Use(middleware.WithFacade(handler))
+	}
+	logsRoute, _ := wrapper.NewRouterWrapper("logs", logsRoutes, getRoutesConfig())
+	logs.Routes(logsRoute)
+	return ws
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	log.LogIfError(err)
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"logs": {
+				Routes: []config.RouteConfig{
+					{Name: "", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func TestGetLogs_EmptyIdentifierShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetLogsCalled: func(_ transaction.LogsFilter) ([]*transaction.ApiLogEvent, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/logs?fromBlock=1&toBlock=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrEmptyIdentifier.Error()))
+}
+
+func TestGetLogs_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetLogsCalled: func(_ transaction.LogsFilter) ([]*transaction.ApiLogEvent, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/logs?identifier=transfer", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetLogs.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetLogs_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedEvents := []*transaction.ApiLogEvent{
+		{TxHash: "aabb", BlockNonce: 3, Identifier: "transfer", Topics: [][]byte{[]byte("topic")}},
+	}
+	toBlock := uint64(10)
+	facade := mock.Facade{
+		GetLogsCalled: func(filter transaction.LogsFilter) ([]*transaction.ApiLogEvent, error) {
+			assert.Equal(t, transaction.LogsFilter{
+				Address:    "erd1address",
+				Identifier: "transfer",
+				ToBlock:    &toBlock,
+			}, filter)
+			return expectedEvents, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/logs?identifier=transfer&address=erd1address&toBlock=10", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := logsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedEvents, response.Data.Logs)
+}
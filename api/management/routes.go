@@ -0,0 +1,264 @@
+package management
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"runtime/pprof"
+	"strconv"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	logLevelPath       = "/log-level"
+	peersPath          = "/peers"
+	disconnectPeerPath = "/peers/:pid/disconnect"
+	blacklistPeerPath  = "/peers/:pid/blacklist"
+	stateSnapshotPath  = "/state/snapshot"
+	storageCompactPath = "/storage/compact"
+	profilePath        = "/profile/:name"
+	configReloadPath   = "/config/reload"
+
+	queryParamDebug = "debug"
+)
+
+var log = logger.GetOrCreate("api/management")
+
+// FacadeHandler interface defines methods that can be used by the gin webserver
+type FacadeHandler interface {
+	GetConnectedPeers() []core.QueryP2PPeerInfo
+	DisconnectPeer(pid string) error
+	BlacklistPeer(pid string, duration time.Duration) error
+	TriggerStateSnapshot() (string, error)
+	CompactStorage() error
+	ReloadConfig() ([]string, error)
+	IsInterfaceNil() bool
+}
+
+// LogLevelRequest represents the structure on which user input for changing the log level will validate against
+type LogLevelRequest struct {
+	Pattern string `json:"pattern"`
+}
+
+// BlacklistRequest represents the structure on which user input for blacklisting a peer will validate against
+type BlacklistRequest struct {
+	DurationInSec uint32 `json:"durationInSec"`
+}
+
+// Routes defines node management related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, logLevelPath, GetLogLevel)
+	router.RegisterHandler(http.MethodPost, logLevelPath, SetLogLevel)
+	router.RegisterHandler(http.MethodGet, peersPath, GetPeers)
+	router.RegisterHandler(http.MethodPost, disconnectPeerPath, DisconnectPeer)
+	router.RegisterHandler(http.MethodPost, blacklistPeerPath, BlacklistPeer)
+	router.RegisterHandler(http.MethodPost, stateSnapshotPath, TriggerStateSnapshot)
+	router.RegisterHandler(http.MethodPost, storageCompactPath, CompactStorage)
+	router.RegisterHandler(http.MethodGet, profilePath, GetProfile)
+	router.RegisterHandler(http.MethodPost, configReloadPath, ReloadConfig)
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	return facade, true
+}
+
+// GetLogLevel returns the log level pattern currently in use
+func GetLogLevel(c *gin.Context) {
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{"pattern": logger.GetLogLevelPattern()},
+		"",
+		shared.ReturnCodeSuccess,
+	)
+}
+
+// SetLogLevel changes at runtime the log levels of the subsystems, as in "*:INFO,process:DEBUG"
+func SetLogLevel(c *gin.Context) {
+	var request = LogLevelRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil || len(request.Pattern) == 0 {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: a log level pattern is required", errors.ErrValidation.Error()))
+		return
+	}
+
+	err = logger.SetLogLevel(request.Pattern)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrSetLogLevel.Error(), err.Error()))
+		return
+	}
+
+	log.Info("log level changed on management request", "pattern", request.Pattern)
+	shared.RespondWith(c, http.StatusOK, gin.H{"pattern": logger.GetLogLevelPattern()}, "", shared.ReturnCodeSuccess)
+}
+
+// GetPeers returns the peers the node is connected to
+func GetPeers(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"peers": facade.GetConnectedPeers()}, "", shared.ReturnCodeSuccess)
+}
+
+// DisconnectPeer closes the connections with the peer provided in the path
+func DisconnectPeer(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	err := facade.DisconnectPeer(c.Param("pid"))
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrPeerManagement.Error(), err.Error()))
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"status": "disconnected"}, "", shared.ReturnCodeSuccess)
+}
+
+// BlacklistPeer denies the peer provided in the path for the duration provided in the request body
+func BlacklistPeer(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	var request = BlacklistRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil || request.DurationInSec == 0 {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: a positive durationInSec is required", errors.ErrValidation.Error()))
+		return
+	}
+
+	err = facade.BlacklistPeer(c.Param("pid"), time.Duration(request.DurationInSec)*time.Second)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrPeerManagement.Error(), err.Error()))
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"status": "blacklisted"}, "", shared.ReturnCodeSuccess)
+}
+
+// TriggerStateSnapshot starts a snapshot of the current accounts state
+func TriggerStateSnapshot(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	rootHash, err := facade.TriggerStateSnapshot()
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrStateSnapshot.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"rootHash": rootHash}, "", shared.ReturnCodeSuccess)
+}
+
+// CompactStorage compacts the storage units of the node. The call returns after the compaction ends
+func CompactStorage(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	err := facade.CompactStorage()
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrStorageCompaction.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"status": "compacted"}, "", shared.ReturnCodeSuccess)
+}
+
+// ReloadConfig reloads the selected configurations from their files and applies them without restarting the node.
+// Either all the reloaded configurations are applied or, if any of them is invalid, none of them
+func ReloadConfig(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	applied, err := facade.ReloadConfig()
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrConfigReload.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	log.Info("configuration reloaded on management request", "applied", applied)
+	shared.RespondWith(c, http.StatusOK, gin.H{"applied": applied}, "", shared.ReturnCodeSuccess)
+}
+
+// GetProfile dumps the requested runtime profile (goroutine, heap, allocs, threadcreate, block or mutex). The debug
+// query parameter has the same meaning as for the pprof handlers: 0 for the binary format, 1 or 2 for text formats
+func GetProfile(c *gin.Context) {
+	profile := pprof.Lookup(c.Param("name"))
+	if profile == nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrUnknownProfile.Error(), c.Param("name")))
+		return
+	}
+
+	debug := 0
+	debugStr := c.Request.URL.Query().Get(queryParamDebug)
+	if len(debugStr) > 0 {
+		var err error
+		debug, err = strconv.Atoi(debugStr)
+		if err != nil {
+			shared.RespondWithValidationError(c, fmt.Sprintf("%s: invalid %s parameter", errors.ErrValidation.Error(), queryParamDebug))
+			return
+		}
+	}
+
+	buff := bytes.NewBuffer(make([]byte, 0))
+	err := profile.WriteTo(buff, debug)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), shared.ReturnCodeInternalError)
+		return
+	}
+
+	contentType := "application/octet-stream"
+	if debug > 0 {
+		contentType = "text/plain; charset=utf-8"
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", profile.Name()))
+	c.Data(http.StatusOK, contentType, buff.Bytes())
+}
@@ -0,0 +1,238 @@
+package management_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/management"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+var log = logger.GetOrCreate("api/management_test")
+
+type peersResponse struct {
+	Data struct {
+		Peers []core.QueryP2PPeerInfo `json:"peers"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func startNodeServer(handler management.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	managementRoutes := ws.Group("/management")
+	if handler != nil {
+		managementRoutes.Use(middleware.WithFacade(handler))
+	}
+	managementRoute, _ := wrapper.NewRouterWrapper("management", managementRoutes, getRoutesConfig())
+	management.Routes(managementRoute)
+	return ws
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	log.LogIfError(err)
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"management": {
+				Routes: []config.RouteConfig{
+					{Name: "/log-level", Open: true},
+					{Name: "/peers", Open: true},
+					{Name: "/peers/:pid/disconnect", Open: true},
+					{Name: "/peers/:pid/blacklist", Open: true},
+					{Name: "/state/snapshot", Open: true},
+					{Name: "/storage/compact", Open: true},
+					{Name: "/profile/:name", Open: true},
+					{Name: "/config/reload", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func TestSetLogLevel_EmptyPatternShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	req, _ := http.NewRequest(http.MethodPost, "/management/log-level", bytes.NewBufferString(`{"pattern":""}`))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+}
+
+func TestGetPeers_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	peers := []core.QueryP2PPeerInfo{
+		{Pid: "pid1", Addresses: []string{"/ip4/127.0.0.1/tcp/10000"}},
+		{Pid: "pid2", IsBlacklisted: true},
+	}
+	facade := mock.Facade{
+		GetConnectedPeersCalled: func() []core.QueryP2PPeerInfo {
+			return peers
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest(http.MethodGet, "/management/peers", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := peersResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, peers, response.Data.Peers)
+}
+
+func TestBlacklistPeer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	blacklistedPid := ""
+	blacklistDuration := time.Duration(0)
+	facade := mock.Facade{
+		BlacklistPeerCalled: func(pid string, duration time.Duration) error {
+			blacklistedPid = pid
+			blacklistDuration = duration
+			return nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest(http.MethodPost, "/management/peers/pid1/blacklist", bytes.NewBufferString(`{"durationInSec":120}`))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "pid1", blacklistedPid)
+	assert.Equal(t, 2*time.Minute, blacklistDuration)
+}
+
+func TestBlacklistPeer_MissingDurationShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		BlacklistPeerCalled: func(pid string, duration time.Duration) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest(http.MethodPost, "/management/peers/pid1/blacklist", bytes.NewBufferString(`{}`))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestCompactStorage_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		CompactStorageCalled: func() error {
+			return expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest(http.MethodPost, "/management/storage/compact", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetProfile(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	req, _ := http.NewRequest(http.MethodGet, "/management/profile/goroutine?debug=1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, strings.Contains(resp.Body.String(), "goroutine profile"))
+
+	req, _ = http.NewRequest(http.MethodGet, "/management/profile/unknown", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestReloadConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		facade := mock.Facade{
+			ReloadConfigCalled: func() ([]string, error) {
+				return nil, expectedErr
+			},
+		}
+		ws := startNodeServer(&facade)
+
+		req, _ := http.NewRequest(http.MethodPost, "/management/config/reload", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		facade := mock.Facade{
+			ReloadConfigCalled: func() ([]string, error) {
+				return []string{"log level", "api"}, nil
+			},
+		}
+		ws := startNodeServer(&facade)
+
+		req, _ := http.NewRequest(http.MethodPost, "/management/config/reload", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := struct {
+			Data struct {
+				Applied []string `json:"applied"`
+			} `json:"data"`
+		}{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, []string{"log level", "api"}, response.Data.Applied)
+	})
+}
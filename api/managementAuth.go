@@ -0,0 +1,96 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+)
+
+func createManagementAuthenticator(cfg config.ManagementAuthConfig) (MiddlewareProcessor, error) {
+	if !cfg.Enabled {
+		log.Debug("management authentication is disabled, all the open routes are public")
+		return nil, nil
+	}
+
+	var jwtSecret []byte
+	if cfg.Mode == middleware.ManagementAuthModeJWT {
+		secret, err := ioutil.ReadFile(cfg.JWTSecretFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w while reading the JWT secret file", err)
+		}
+		jwtSecret = []byte(strings.TrimSpace(string(secret)))
+	}
+
+	return middleware.NewManagementAuthenticator(cfg, jwtSecret)
+}
+
+func createResponseCompressor(cfg config.ResponseCompressionConfig) (MiddlewareProcessor, error) {
+	if !cfg.Enabled {
+		log.Debug("response compression and conditional requests are disabled")
+		return nil, nil
+	}
+
+	return middleware.NewResponseCompressor(cfg)
+}
+
+// runServer starts the web server, serving HTTPS if the web server TLS is enabled or if a TLS certificate is
+// configured for the management authentication. In the mutual TLS management authentication mode the client
+// certificates are optional at handshake, as they are required only by the protected routes, but, when provided, they
+// should be issued by the configured client CA
+func runServer(ws *gin.Engine, address string, tlsCfg config.WebServerTLSConfig, cfg config.ManagementAuthConfig) error {
+	isTLSConfigured := len(cfg.TLSCertificateFile) > 0 && len(cfg.TLSKeyFile) > 0
+	if !tlsCfg.Enabled && (!cfg.Enabled || !isTLSConfigured) {
+		return ws.Run(address)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	certificateFile, keyFile := cfg.TLSCertificateFile, cfg.TLSKeyFile
+	if tlsCfg.Enabled {
+		var err error
+		tlsConfig, certificateFile, keyFile, err = createServerTLSConfig(tlsCfg)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cfg.Enabled && cfg.Mode == middleware.ManagementAuthModeMTLS {
+		clientCAs, err := loadClientCAs(cfg.ClientCAFile)
+		if err != nil {
+			return err
+		}
+
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		tlsConfig.ClientCAs = clientCAs
+	}
+
+	server := &http.Server{
+		Addr:      address,
+		Handler:   ws,
+		TLSConfig: tlsConfig,
+	}
+
+	return server.ListenAndServeTLS(certificateFile, keyFile)
+}
+
+func loadClientCAs(clientCAFile string) (*x509.CertPool, error) {
+	buff, err := ioutil.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the client CA file", err)
+	}
+
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(buff) {
+		return nil, fmt.Errorf("%w, no certificate found in %s", middleware.ErrMissingTLSConfig, clientCAFile)
+	}
+
+	return clientCAs, nil
+}
@@ -7,3 +7,48 @@ var ErrInvalidMaxNumRequests = errors.New("max number of requests value is inval
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrInvalidRateLimit signals that a rate limit with a zero burst or sustained rate was provided
+var ErrInvalidRateLimit = errors.New("invalid rate limit")
+
+// ErrEmptyAPIKeyHeader signals that API keys were configured without the header that carries them
+var ErrEmptyAPIKeyHeader = errors.New("empty API key header")
+
+// ErrInvalidAPIKey signals that an API key was configured without a key or a name
+var ErrInvalidAPIKey = errors.New("invalid API key")
+
+// ErrDuplicatedAPIKey signals that an API key or its name was configured more than once
+var ErrDuplicatedAPIKey = errors.New("duplicated API key")
+
+// ErrInvalidManagementAuthMode signals that an unknown management authentication mode was configured
+var ErrInvalidManagementAuthMode = errors.New("invalid management authentication mode")
+
+// ErrNoProtectedRoutes signals that the management authentication was enabled without any protected route
+var ErrNoProtectedRoutes = errors.New("no protected routes")
+
+// ErrInvalidJWTSecret signals that the secret used to check the JWT signatures is missing or too short
+var ErrInvalidJWTSecret = errors.New("invalid JWT secret")
+
+// ErrMissingTLSConfig signals that the mutual TLS authentication was enabled without the TLS files
+var ErrMissingTLSConfig = errors.New("missing TLS configuration")
+
+// ErrUnauthorized signals that a request for a protected route did not provide valid credentials
+var ErrUnauthorized = errors.New("unauthorized")
+
+// ErrForbidden signals that the client certificate of a request for a protected route is not allowed
+var ErrForbidden = errors.New("forbidden")
+
+// ErrInvalidJWT signals that a provided JWT is malformed, not properly signed or its claims are not valid
+var ErrInvalidJWT = errors.New("invalid JWT")
+
+// ErrNoCompressedRoutes signals that the response compression was enabled without any route
+var ErrNoCompressedRoutes = errors.New("no compressed routes")
+
+// ErrInvalidCompressionLevel signals that an invalid compression level was configured
+var ErrInvalidCompressionLevel = errors.New("invalid compression level")
+
+// ErrInvalidConcurrencyLimit signals that a zero maximum number of requests processed at once was configured
+var ErrInvalidConcurrencyLimit = errors.New("invalid concurrency limit")
+
+// ErrNodeOverloaded signals that a request waited too long in the work queue to be processed
+var ErrNodeOverloaded = errors.New("node overloaded")
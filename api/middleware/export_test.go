@@ -0,0 +1,13 @@
+package middleware
+
+import "time"
+
+// SetTimeFunc -
+func (rl *rateLimiter) SetTimeFunc(getTimeFunc func() time.Time) {
+	rl.getTimeFunc = getTimeFunc
+}
+
+// SetTimeFunc -
+func (ma *managementAuthenticator) SetTimeFunc(getTimeFunc func() time.Time) {
+	ma.getTimeFunc = getTimeFunc
+}
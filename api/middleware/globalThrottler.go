@@ -15,9 +15,10 @@ var log = logger.GetOrCreate("api/middleware")
 
 // globalThrottler is a middleware global limiter used to limit total number of simultaneous requests
 type globalThrottler struct {
-	queue            chan struct{}
-	mutDebugRequests sync.Mutex
-	debugRequests    map[string]int
+	mutRequests    sync.Mutex
+	numRequests    uint32
+	maxConnections uint32
+	debugRequests  map[string]int
 }
 
 // NewGlobalThrottler creates a new instance of a globalThrottler
@@ -27,22 +28,39 @@ func NewGlobalThrottler(maxConnections uint32) (*globalThrottler, error) {
 	}
 
 	return &globalThrottler{
-		queue:         make(chan struct{}, maxConnections),
-		debugRequests: make(map[string]int),
+		maxConnections: maxConnections,
+		debugRequests:  make(map[string]int),
 	}, nil
 }
 
+// SetMaxNumRequests changes the maximum number of simultaneous requests. The requests already in process are not
+// affected, even if they exceed the new maximum
+func (gt *globalThrottler) SetMaxNumRequests(maxConnections uint32) error {
+	if maxConnections == 0 {
+		return ErrInvalidMaxNumRequests
+	}
+
+	gt.mutRequests.Lock()
+	gt.maxConnections = maxConnections
+	gt.mutRequests.Unlock()
+
+	return nil
+}
+
 // MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
 func (gt *globalThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		path := c.Request.URL.Path
 
-		select {
-		case gt.queue <- struct{}{}:
-			gt.mutDebugRequests.Lock()
+		gt.mutRequests.Lock()
+		isQuotaReached := gt.numRequests >= gt.maxConnections
+		if !isQuotaReached {
+			gt.numRequests++
 			gt.debugRequests[path]++
-			gt.mutDebugRequests.Unlock()
-		default:
+		}
+		gt.mutRequests.Unlock()
+
+		if isQuotaReached {
 			c.AbortWithStatusJSON(
 				http.StatusTooManyRequests,
 				shared.GenericAPIResponse{
@@ -64,23 +82,22 @@ func (gt *globalThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 }
 
 func (gt *globalThrottler) finish(path string) {
-	gt.mutDebugRequests.Lock()
+	gt.mutRequests.Lock()
+	gt.numRequests--
 	gt.debugRequests[path]--
 	if gt.debugRequests[path] < 1 {
 		delete(gt.debugRequests, path)
 	}
-	gt.mutDebugRequests.Unlock()
-
-	<-gt.queue
+	gt.mutRequests.Unlock()
 }
 
 func (gt *globalThrottler) printDebugInfo() {
-	gt.mutDebugRequests.Lock()
+	gt.mutRequests.Lock()
 	infoLines := make([]string, 0, len(gt.debugRequests))
 	for requestPath, counter := range gt.debugRequests {
 		infoLines = append(infoLines, fmt.Sprintf("%s: %d", requestPath, counter))
 	}
-	gt.mutDebugRequests.Unlock()
+	gt.mutRequests.Unlock()
 
 	log.Debug(fmt.Sprintf("API engine stuck: \n%s", strings.Join(infoLines, "\n")))
 }
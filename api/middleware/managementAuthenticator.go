@@ -0,0 +1,258 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// ManagementAuthModeJWT is the management authentication mode requiring a HS256 signed bearer token
+	ManagementAuthModeJWT = "jwt"
+	// ManagementAuthModeMTLS is the management authentication mode requiring a verified client certificate
+	ManagementAuthModeMTLS = "mtls"
+
+	// MinJWTSecretLength is the minimum length of the secret used to check the JWT signatures
+	MinJWTSecretLength = 32
+
+	headerAuthorization = "Authorization"
+	bearerPrefix        = "Bearer "
+	jwtAlgorithm        = "HS256"
+	routesWildcard      = "*"
+)
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+}
+
+type jwtClaims struct {
+	Exp *int64      `json:"exp"`
+	Nbf *int64      `json:"nbf"`
+	Iss string      `json:"iss"`
+	Aud interface{} `json:"aud"`
+}
+
+// managementAuthenticator is a middleware requiring the requests for the protected routes to be authenticated either
+// by a JWT or by a client certificate. The requests for the other routes are not checked
+type managementAuthenticator struct {
+	mode                 string
+	protectedRoutes      map[string]struct{}
+	protectedRoutePrefix []string
+	jwtSecret            []byte
+	jwtIssuer            string
+	jwtAudience          string
+	jwtLeeway            int64
+	allowedClientNames   map[string]struct{}
+	getTimeFunc          func() time.Time
+}
+
+// NewManagementAuthenticator creates a new instance of a managementAuthenticator. The jwtSecret is only used in the
+// "jwt" mode
+func NewManagementAuthenticator(cfg config.ManagementAuthConfig, jwtSecret []byte) (*managementAuthenticator, error) {
+	if len(cfg.ProtectedRoutes) == 0 {
+		return nil, ErrNoProtectedRoutes
+	}
+
+	switch cfg.Mode {
+	case ManagementAuthModeJWT:
+		if len(jwtSecret) < MinJWTSecretLength {
+			return nil, fmt.Errorf("%w, it should have at least %d bytes", ErrInvalidJWTSecret, MinJWTSecretLength)
+		}
+	case ManagementAuthModeMTLS:
+		if len(cfg.TLSCertificateFile) == 0 || len(cfg.TLSKeyFile) == 0 || len(cfg.ClientCAFile) == 0 {
+			return nil, fmt.Errorf("%w, the TLS certificate, key and client CA files are mandatory", ErrMissingTLSConfig)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidManagementAuthMode, cfg.Mode)
+	}
+
+	ma := &managementAuthenticator{
+		mode:                 cfg.Mode,
+		protectedRoutes:      make(map[string]struct{}),
+		protectedRoutePrefix: make([]string, 0),
+		jwtSecret:            jwtSecret,
+		jwtIssuer:            cfg.JWTIssuer,
+		jwtAudience:          cfg.JWTAudience,
+		jwtLeeway:            int64(cfg.JWTLeewayInSec),
+		allowedClientNames:   make(map[string]struct{}),
+		getTimeFunc:          time.Now,
+	}
+
+	for _, route := range cfg.ProtectedRoutes {
+		if strings.HasSuffix(route, routesWildcard) {
+			ma.protectedRoutePrefix = append(ma.protectedRoutePrefix, strings.TrimSuffix(route, routesWildcard))
+			continue
+		}
+
+		ma.protectedRoutes[route] = struct{}{}
+	}
+	for _, name := range cfg.AllowedClientNames {
+		ma.allowedClientNames[name] = struct{}{}
+	}
+
+	return ma, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (ma *managementAuthenticator) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !ma.isProtectedRoute(shared.UnversionedRoute(c.FullPath())) {
+			c.Next()
+			return
+		}
+
+		var err error
+		if ma.mode == ManagementAuthModeMTLS {
+			err = ma.checkClientCertificate(c.Request)
+		} else {
+			err = ma.checkJWT(c.GetHeader(headerAuthorization))
+		}
+		if err != nil {
+			status := http.StatusUnauthorized
+			if errors.Is(err, ErrForbidden) {
+				status = http.StatusForbidden
+			}
+
+			c.AbortWithStatusJSON(
+				status,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: err.Error(),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func (ma *managementAuthenticator) isProtectedRoute(route string) bool {
+	if len(route) == 0 {
+		return false
+	}
+
+	_, isProtected := ma.protectedRoutes[route]
+	if isProtected {
+		return true
+	}
+
+	for _, prefix := range ma.protectedRoutePrefix {
+		if strings.HasPrefix(route, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (ma *managementAuthenticator) checkClientCertificate(request *http.Request) error {
+	if request.TLS == nil || len(request.TLS.VerifiedChains) == 0 || len(request.TLS.VerifiedChains[0]) == 0 {
+		return fmt.Errorf("%w: a verified client certificate is required", ErrUnauthorized)
+	}
+	if len(ma.allowedClientNames) == 0 {
+		return nil
+	}
+
+	clientName := request.TLS.VerifiedChains[0][0].Subject.CommonName
+	_, isAllowed := ma.allowedClientNames[clientName]
+	if !isAllowed {
+		return fmt.Errorf("%w: client %s is not allowed", ErrForbidden, clientName)
+	}
+
+	return nil
+}
+
+func (ma *managementAuthenticator) checkJWT(authorization string) error {
+	if !strings.HasPrefix(authorization, bearerPrefix) {
+		return fmt.Errorf("%w: a bearer token is required", ErrUnauthorized)
+	}
+
+	parts := strings.Split(strings.TrimSpace(strings.TrimPrefix(authorization, bearerPrefix)), ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("%w: %s", ErrUnauthorized, ErrInvalidJWT.Error())
+	}
+
+	header := &jwtHeader{}
+	err := decodeJWTPart(parts[0], header)
+	if err != nil || header.Alg != jwtAlgorithm {
+		return fmt.Errorf("%w: %s, the %s algorithm is required", ErrUnauthorized, ErrInvalidJWT.Error(), jwtAlgorithm)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrUnauthorized, ErrInvalidJWT.Error())
+	}
+	mac := hmac.New(sha256.New, ma.jwtSecret)
+	_, _ = mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return fmt.Errorf("%w: %s, bad signature", ErrUnauthorized, ErrInvalidJWT.Error())
+	}
+
+	claims := &jwtClaims{}
+	err = decodeJWTPart(parts[1], claims)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrUnauthorized, ErrInvalidJWT.Error())
+	}
+
+	return ma.checkJWTClaims(claims)
+}
+
+func (ma *managementAuthenticator) checkJWTClaims(claims *jwtClaims) error {
+	now := ma.getTimeFunc().Unix()
+	if claims.Exp == nil || now > *claims.Exp+ma.jwtLeeway {
+		return fmt.Errorf("%w: %s, missing or expired exp claim", ErrUnauthorized, ErrInvalidJWT.Error())
+	}
+	if claims.Nbf != nil && now+ma.jwtLeeway < *claims.Nbf {
+		return fmt.Errorf("%w: %s, token not valid yet", ErrUnauthorized, ErrInvalidJWT.Error())
+	}
+	if len(ma.jwtIssuer) > 0 && claims.Iss != ma.jwtIssuer {
+		return fmt.Errorf("%w: %s, wrong iss claim", ErrUnauthorized, ErrInvalidJWT.Error())
+	}
+	if len(ma.jwtAudience) > 0 && !audienceContains(claims.Aud, ma.jwtAudience) {
+		return fmt.Errorf("%w: %s, wrong aud claim", ErrUnauthorized, ErrInvalidJWT.Error())
+	}
+
+	return nil
+}
+
+func decodeJWTPart(part string, destination interface{}) error {
+	buff, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(buff, destination)
+}
+
+// audienceContains checks the aud claim, which can be either a string or an array of strings
+func audienceContains(audience interface{}, expected string) bool {
+	switch aud := audience.(type) {
+	case string:
+		return aud == expected
+	case []interface{}:
+		for _, value := range aud {
+			if value == expected {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ma *managementAuthenticator) IsInterfaceNil() bool {
+	return ma == nil
+}
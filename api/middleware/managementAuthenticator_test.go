@@ -0,0 +1,174 @@
+package middleware_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var jwtSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func createManagementAuthConfig(mode string) config.ManagementAuthConfig {
+	return config.ManagementAuthConfig{
+		Enabled:            true,
+		Mode:               mode,
+		ProtectedRoutes:    []string{"/hardfork/trigger", "/debug/pprof/*"},
+		JWTIssuer:          "operator",
+		JWTAudience:        "node",
+		JWTLeewayInSec:     10,
+		TLSCertificateFile: "cert.pem",
+		TLSKeyFile:         "key.pem",
+		ClientCAFile:       "ca.pem",
+		AllowedClientNames: []string{"admin"},
+	}
+}
+
+func startNodeServerManagementAuth(t *testing.T, mode string) *gin.Engine {
+	authenticator, err := middleware.NewManagementAuthenticator(createManagementAuthConfig(mode), jwtSecret)
+	require.Nil(t, err)
+	authenticator.SetTimeFunc(func() time.Time {
+		return time.Unix(1000, 0)
+	})
+
+	okHandler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{})
+	}
+	ws := gin.New()
+	ws.Use(authenticator.MiddlewareHandlerFunc())
+	ws.POST("/hardfork/trigger", okHandler)
+	ws.GET("/debug/pprof/heap", okHandler)
+	ws.GET("/node/status", okHandler)
+
+	return ws
+}
+
+func createJWT(header string, claims string, secret []byte) string {
+	encodedHeader := base64.RawURLEncoding.EncodeToString([]byte(header))
+	encodedClaims := base64.RawURLEncoding.EncodeToString([]byte(claims))
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(encodedHeader + "." + encodedClaims))
+
+	return encodedHeader + "." + encodedClaims + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func doManagementRequest(ws *gin.Engine, method string, path string, token string, clientName string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, path, nil)
+	if len(token) > 0 {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if len(clientName) > 0 {
+		clientCert := &x509.Certificate{Subject: pkix.Name{CommonName: clientName}}
+		req.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{clientCert}}}
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewManagementAuthenticator_InvalidConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := createManagementAuthConfig(middleware.ManagementAuthModeJWT)
+	cfg.ProtectedRoutes = nil
+	ma, err := middleware.NewManagementAuthenticator(cfg, jwtSecret)
+	assert.True(t, check.IfNil(ma))
+	assert.Equal(t, middleware.ErrNoProtectedRoutes, err)
+
+	cfg = createManagementAuthConfig("basic")
+	ma, err = middleware.NewManagementAuthenticator(cfg, jwtSecret)
+	assert.True(t, check.IfNil(ma))
+	assert.True(t, errors.Is(err, middleware.ErrInvalidManagementAuthMode))
+
+	cfg = createManagementAuthConfig(middleware.ManagementAuthModeJWT)
+	ma, err = middleware.NewManagementAuthenticator(cfg, jwtSecret[:middleware.MinJWTSecretLength-1])
+	assert.True(t, check.IfNil(ma))
+	assert.True(t, errors.Is(err, middleware.ErrInvalidJWTSecret))
+
+	cfg = createManagementAuthConfig(middleware.ManagementAuthModeMTLS)
+	cfg.ClientCAFile = ""
+	ma, err = middleware.NewManagementAuthenticator(cfg, nil)
+	assert.True(t, check.IfNil(ma))
+	assert.True(t, errors.Is(err, middleware.ErrMissingTLSConfig))
+
+	ma, err = middleware.NewManagementAuthenticator(createManagementAuthConfig(middleware.ManagementAuthModeMTLS), nil)
+	assert.False(t, check.IfNil(ma))
+	assert.Nil(t, err)
+}
+
+func TestManagementAuthenticator_JWTMode(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerManagementAuth(t, middleware.ManagementAuthModeJWT)
+	header := `{"alg":"HS256","typ":"JWT"}`
+	validClaims := `{"iss":"operator","aud":["explorer","node"],"exp":1005}`
+
+	resp := doManagementRequest(ws, http.MethodGet, "/node/status", "", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp = doManagementRequest(ws, http.MethodPost, "/hardfork/trigger", "", "")
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	resp = doManagementRequest(ws, http.MethodPost, "/hardfork/trigger", createJWT(header, validClaims, jwtSecret), "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp = doManagementRequest(ws, http.MethodGet, "/debug/pprof/heap", createJWT(header, validClaims, jwtSecret), "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	otherSecret := []byte("other secret other secret other secret")
+	resp = doManagementRequest(ws, http.MethodPost, "/hardfork/trigger", createJWT(header, validClaims, otherSecret), "")
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	noneHeader := `{"alg":"none"}`
+	resp = doManagementRequest(ws, http.MethodPost, "/hardfork/trigger", createJWT(noneHeader, validClaims, jwtSecret), "")
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	expiredClaims := `{"iss":"operator","aud":"node","exp":985}`
+	resp = doManagementRequest(ws, http.MethodPost, "/hardfork/trigger", createJWT(header, expiredClaims, jwtSecret), "")
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	withinLeewayClaims := `{"iss":"operator","aud":"node","exp":995}`
+	resp = doManagementRequest(ws, http.MethodPost, "/hardfork/trigger", createJWT(header, withinLeewayClaims, jwtSecret), "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	wrongAudienceClaims := `{"iss":"operator","aud":"explorer","exp":1005}`
+	resp = doManagementRequest(ws, http.MethodPost, "/hardfork/trigger", createJWT(header, wrongAudienceClaims, jwtSecret), "")
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	notYetValidClaims := `{"iss":"operator","aud":"node","exp":2000,"nbf":1500}`
+	resp = doManagementRequest(ws, http.MethodPost, "/hardfork/trigger", createJWT(header, notYetValidClaims, jwtSecret), "")
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+}
+
+func TestManagementAuthenticator_MTLSMode(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerManagementAuth(t, middleware.ManagementAuthModeMTLS)
+
+	resp := doManagementRequest(ws, http.MethodGet, "/node/status", "", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp = doManagementRequest(ws, http.MethodPost, "/hardfork/trigger", "", "")
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	resp = doManagementRequest(ws, http.MethodPost, "/hardfork/trigger", "", "monitoring")
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+
+	resp = doManagementRequest(ws, http.MethodPost, "/hardfork/trigger", "", "admin")
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
@@ -0,0 +1,377 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// HeaderRateLimitLimit is the response header holding the burst of the most restrictive limit of the request
+	HeaderRateLimitLimit = "X-RateLimit-Limit"
+	// HeaderRateLimitRemaining is the response header holding the remaining budget of the most restrictive limit
+	HeaderRateLimitRemaining = "X-RateLimit-Remaining"
+	// HeaderRetryAfter is the response header holding the number of seconds after which a rejected request can be retried
+	HeaderRetryAfter = "Retry-After"
+
+	// AnonymousConsumer is the name under which the metrics of the consumers without an API key are aggregated
+	AnonymousConsumer = "anonymous"
+
+	apiKeyConsumerPrefix = "key:"
+	globalLimitRoute     = ""
+)
+
+// ConsumerMetrics holds the number of accepted and rejected requests of a consumer
+type ConsumerMetrics struct {
+	Consumer      string `json:"consumer"`
+	NumAccepted   uint64 `json:"numAccepted"`
+	NumRejected   uint64 `json:"numRejected"`
+	NumBans       uint64 `json:"numBans"`
+	NumBannedNow  uint32 `json:"numBannedNow"`
+	LastRequestAt int64  `json:"lastRequestAt"`
+}
+
+type rateLimit struct {
+	burst           float64
+	sustainedPerSec float64
+	banDuration     time.Duration
+}
+
+type apiKeyLimit struct {
+	name  string
+	limit rateLimit
+}
+
+type tokenBucket struct {
+	metricsName string
+	tokens      float64
+	lastRefill  time.Time
+	bannedUntil time.Time
+}
+
+// rateLimiter is a middleware limiter applying token bucket limits to each consumer, globally and per route
+type rateLimiter struct {
+	apiKeyHeader string
+	defaultLimit rateLimit
+	routeLimits  map[string]rateLimit
+	apiKeyLimits map[string]apiKeyLimit
+	getTimeFunc  func() time.Time
+
+	mutState sync.Mutex
+	buckets  map[string]*tokenBucket
+	metrics  map[string]*ConsumerMetrics
+}
+
+// NewRateLimiter creates a new instance of a rateLimiter
+func NewRateLimiter(cfg config.RateLimiterConfig) (*rateLimiter, error) {
+	defaultLimit, err := newRateLimit(cfg.DefaultLimit.Burst, cfg.DefaultLimit.SustainedRequestsPerSec, cfg.DefaultLimit.BanDurationInSec)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the default limit", err)
+	}
+
+	rl := &rateLimiter{
+		apiKeyHeader: cfg.APIKeyHeader,
+		defaultLimit: defaultLimit,
+		routeLimits:  make(map[string]rateLimit),
+		apiKeyLimits: make(map[string]apiKeyLimit),
+		getTimeFunc:  time.Now,
+		buckets:      make(map[string]*tokenBucket),
+		metrics:      make(map[string]*ConsumerMetrics),
+	}
+
+	for _, routeCfg := range cfg.Routes {
+		rl.routeLimits[routeCfg.Route], err = newRateLimit(routeCfg.Burst, routeCfg.SustainedRequestsPerSec, routeCfg.BanDurationInSec)
+		if err != nil {
+			return nil, fmt.Errorf("%w for route %s", err, routeCfg.Route)
+		}
+	}
+
+	if len(cfg.APIKeys) > 0 && len(cfg.APIKeyHeader) == 0 {
+		return nil, ErrEmptyAPIKeyHeader
+	}
+	for _, keyCfg := range cfg.APIKeys {
+		if len(keyCfg.Key) == 0 || len(keyCfg.Name) == 0 {
+			return nil, ErrInvalidAPIKey
+		}
+		_, exists := rl.apiKeyLimits[keyCfg.Key]
+		if exists || keyCfg.Name == AnonymousConsumer {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedAPIKey, keyCfg.Name)
+		}
+
+		limit, errLimit := newRateLimit(keyCfg.Burst, keyCfg.SustainedRequestsPerSec, keyCfg.BanDurationInSec)
+		if errLimit != nil {
+			return nil, fmt.Errorf("%w for API key %s", errLimit, keyCfg.Name)
+		}
+		rl.apiKeyLimits[keyCfg.Key] = apiKeyLimit{
+			name:  keyCfg.Name,
+			limit: limit,
+		}
+	}
+
+	return rl, nil
+}
+
+func newRateLimit(burst uint32, sustainedRequestsPerSec uint32, banDurationInSec uint32) (rateLimit, error) {
+	if burst == 0 || sustainedRequestsPerSec == 0 {
+		return rateLimit{}, ErrInvalidRateLimit
+	}
+
+	return rateLimit{
+		burst:           float64(burst),
+		sustainedPerSec: float64(sustainedRequestsPerSec),
+		banDuration:     time.Duration(banDurationInSec) * time.Second,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (rl *rateLimiter) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		consumer, metricsName, consumerLimit, err := rl.identifyConsumer(c)
+		if err != nil {
+			c.AbortWithStatusJSON(
+				http.StatusInternalServerError,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: err.Error(),
+					Code:  shared.ReturnCodeInternalError,
+				},
+			)
+			return
+		}
+
+		limits := map[string]rateLimit{globalLimitRoute: consumerLimit}
+		route := shared.UnversionedRoute(c.FullPath())
+		routeLimit, hasRouteLimit := rl.routeLimits[route]
+		if hasRouteLimit {
+			limits[route] = routeLimit
+		}
+
+		isAccepted, limit, remaining, retryAfter := rl.consume(consumer, metricsName, limits)
+		c.Header(HeaderRateLimitLimit, strconv.Itoa(int(limit)))
+		c.Header(HeaderRateLimitRemaining, strconv.Itoa(int(remaining)))
+
+		if !isAccepted {
+			c.Header(HeaderRetryAfter, strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			c.AbortWithStatusJSON(
+				http.StatusTooManyRequests,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s for consumer %s", ErrTooManyRequests.Error(), metricsName),
+					Code:  shared.ReturnCodeSystemBusy,
+				},
+			)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func (rl *rateLimiter) identifyConsumer(c *gin.Context) (string, string, rateLimit, error) {
+	if len(rl.apiKeyLimits) > 0 {
+		keyLimit, found := rl.apiKeyLimits[c.GetHeader(rl.apiKeyHeader)]
+		if found {
+			return apiKeyConsumerPrefix + keyLimit.name, keyLimit.name, keyLimit.limit, nil
+		}
+	}
+
+	remoteAddr, _, err := net.SplitHostPort(c.Request.RemoteAddr)
+	if err != nil {
+		return "", "", rateLimit{}, err
+	}
+
+	return remoteAddr, AnonymousConsumer, rl.defaultLimit, nil
+}
+
+// consume takes a token from each of the consumer buckets of the provided limits. The request is accepted only if
+// all the buckets have budget and the consumer is not banned. Besides the acceptance, it returns the burst and the
+// remaining budget of the most restrictive limit and, for a rejected request, the duration until it can be retried
+func (rl *rateLimiter) consume(
+	consumer string,
+	metricsName string,
+	limits map[string]rateLimit,
+) (bool, float64, float64, time.Duration) {
+	rl.mutState.Lock()
+	defer rl.mutState.Unlock()
+
+	now := rl.getTimeFunc()
+	consumerMetrics := rl.getConsumerMetrics(metricsName)
+	consumerMetrics.LastRequestAt = now.Unix()
+
+	buckets := make(map[string]*tokenBucket, len(limits))
+	retryAfter := time.Duration(0)
+	isAccepted := true
+	for route, limit := range limits {
+		bucket := rl.getRefilledBucket(consumer, metricsName, route, limit, now)
+		buckets[route] = bucket
+
+		if now.Before(bucket.bannedUntil) {
+			isAccepted = false
+			retryAfter = maxDuration(retryAfter, bucket.bannedUntil.Sub(now))
+			continue
+		}
+		if bucket.tokens < 1 {
+			isAccepted = false
+			retryAfter = maxDuration(retryAfter, durationUntilNextToken(bucket, limit))
+		}
+	}
+	limit, remaining := float64(0), math.MaxFloat64
+	for route, bucket := range buckets {
+		routeLimit := limits[route]
+		if isAccepted {
+			bucket.tokens--
+		} else if bucket.tokens < 1 && routeLimit.banDuration > 0 && !now.Before(bucket.bannedUntil) {
+			bucket.bannedUntil = now.Add(routeLimit.banDuration)
+			retryAfter = maxDuration(retryAfter, routeLimit.banDuration)
+			consumerMetrics.NumBans++
+			log.Debug("rateLimiter: consumer banned", "consumer", consumer, "route", route,
+				"duration", routeLimit.banDuration)
+		}
+
+		if bucket.tokens < remaining {
+			limit, remaining = routeLimit.burst, math.Max(0, math.Floor(bucket.tokens))
+		}
+	}
+
+	if isAccepted {
+		consumerMetrics.NumAccepted++
+	} else {
+		consumerMetrics.NumRejected++
+	}
+
+	return isAccepted, limit, remaining, retryAfter
+}
+
+func (rl *rateLimiter) getRefilledBucket(
+	consumer string,
+	metricsName string,
+	route string,
+	limit rateLimit,
+	now time.Time,
+) *tokenBucket {
+	bucketKey := consumer + "|" + route
+	bucket, found := rl.buckets[bucketKey]
+	if !found {
+		bucket = &tokenBucket{
+			metricsName: metricsName,
+			tokens:      limit.burst,
+			lastRefill:  now,
+		}
+		rl.buckets[bucketKey] = bucket
+		return bucket
+	}
+
+	elapsed := now.Sub(bucket.lastRefill).Seconds()
+	if elapsed > 0 {
+		bucket.tokens = math.Min(limit.burst, bucket.tokens+elapsed*limit.sustainedPerSec)
+		bucket.lastRefill = now
+	}
+
+	return bucket
+}
+
+func (rl *rateLimiter) getConsumerMetrics(metricsName string) *ConsumerMetrics {
+	consumerMetrics, found := rl.metrics[metricsName]
+	if !found {
+		consumerMetrics = &ConsumerMetrics{Consumer: metricsName}
+		rl.metrics[metricsName] = consumerMetrics
+	}
+
+	return consumerMetrics
+}
+
+func durationUntilNextToken(bucket *tokenBucket, limit rateLimit) time.Duration {
+	return time.Duration((1 - bucket.tokens) / limit.sustainedPerSec * float64(time.Second))
+}
+
+func maxDuration(a time.Duration, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+// RemoveIdleConsumers removes the state of the consumers that are not banned and have refilled their budget
+func (rl *rateLimiter) RemoveIdleConsumers() {
+	rl.mutState.Lock()
+	defer rl.mutState.Unlock()
+
+	now := rl.getTimeFunc()
+	for bucketKey, bucket := range rl.buckets {
+		if now.Before(bucket.bannedUntil) {
+			continue
+		}
+
+		// the bucket is removed only after the time needed by the largest configured limit to be fully refilled
+		elapsed := now.Sub(bucket.lastRefill).Seconds()
+		if bucket.tokens+elapsed*rl.minSustainedPerSec() >= rl.maxBurst() {
+			delete(rl.buckets, bucketKey)
+		}
+	}
+}
+
+func (rl *rateLimiter) maxBurst() float64 {
+	maxBurst := rl.defaultLimit.burst
+	for _, limit := range rl.routeLimits {
+		maxBurst = math.Max(maxBurst, limit.burst)
+	}
+	for _, keyLimit := range rl.apiKeyLimits {
+		maxBurst = math.Max(maxBurst, keyLimit.limit.burst)
+	}
+
+	return maxBurst
+}
+
+func (rl *rateLimiter) minSustainedPerSec() float64 {
+	minSustained := rl.defaultLimit.sustainedPerSec
+	for _, limit := range rl.routeLimits {
+		minSustained = math.Min(minSustained, limit.sustainedPerSec)
+	}
+	for _, keyLimit := range rl.apiKeyLimits {
+		minSustained = math.Min(minSustained, keyLimit.limit.sustainedPerSec)
+	}
+
+	return minSustained
+}
+
+// ConsumersMetrics returns the metrics of each API key consumer and the aggregated metrics of the anonymous ones,
+// sorted by consumer name
+func (rl *rateLimiter) ConsumersMetrics() []*ConsumerMetrics {
+	rl.mutState.Lock()
+	defer rl.mutState.Unlock()
+
+	now := rl.getTimeFunc()
+	numBannedNow := make(map[string]uint32)
+	for _, bucket := range rl.buckets {
+		if now.Before(bucket.bannedUntil) {
+			numBannedNow[bucket.metricsName]++
+		}
+	}
+
+	result := make([]*ConsumerMetrics, 0, len(rl.metrics))
+	for name, consumerMetrics := range rl.metrics {
+		metricsCopy := *consumerMetrics
+		metricsCopy.NumBannedNow = numBannedNow[name]
+		result = append(result, &metricsCopy)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Consumer < result[j].Consumer
+	})
+
+	return result
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rl *rateLimiter) IsInterfaceNil() bool {
+	return rl == nil
+}
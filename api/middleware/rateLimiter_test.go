@@ -0,0 +1,209 @@
+package middleware_test
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type consumersMetricsHandler interface {
+	ConsumersMetrics() []*middleware.ConsumerMetrics
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (fc *fakeClock) getTime() time.Time {
+	return fc.now
+}
+
+func createRateLimiterConfig() config.RateLimiterConfig {
+	return config.RateLimiterConfig{
+		Enabled:                      true,
+		APIKeyHeader:                 "X-Api-Key",
+		IdleConsumersCleanupInterval: 60,
+		DefaultLimit:                 config.RateLimitConfig{Burst: 3, SustainedRequestsPerSec: 2},
+		Routes: []config.RouteRateLimitConfig{
+			{Route: "/address/:address/balance", Burst: 1, SustainedRequestsPerSec: 1, BanDurationInSec: 10},
+		},
+		APIKeys: []config.APIKeyRateLimitConfig{
+			{Name: "explorer", Key: "secret", Burst: 10, SustainedRequestsPerSec: 10},
+		},
+	}
+}
+
+func startNodeServerRateLimiter(t *testing.T, clock *fakeClock) (*gin.Engine, consumersMetricsHandler) {
+	rateLimiter, err := middleware.NewRateLimiter(createRateLimiterConfig())
+	require.Nil(t, err)
+	rateLimiter.SetTimeFunc(clock.getTime)
+
+	facade := &mock.Facade{
+		BalanceHandler: func(_ string) (*big.Int, error) {
+			return big.NewInt(10), nil
+		},
+		GetAccountHandler: func(address string) (state.UserAccountHandler, error) {
+			return state.NewUserAccount([]byte(address))
+		},
+	}
+
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(rateLimiter.MiddlewareHandlerFunc())
+	ginAddressRoutes := ws.Group("/address")
+	ginAddressRoutes.Use(middleware.WithFacade(facade))
+	addressRoutes, _ := wrapper.NewRouterWrapper("address", ginAddressRoutes, getRoutesConfig())
+	address.Routes(addressRoutes)
+
+	return ws, rateLimiter
+}
+
+func doRateLimitedRequest(ws *gin.Engine, path string, remoteAddr string, apiKey string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("GET", path, nil)
+	req.RemoteAddr = remoteAddr
+	if len(apiKey) > 0 {
+		req.Header.Set("X-Api-Key", apiKey)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewRateLimiter_InvalidConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := createRateLimiterConfig()
+	cfg.DefaultLimit.Burst = 0
+	rl, err := middleware.NewRateLimiter(cfg)
+	assert.True(t, check.IfNil(rl))
+	assert.True(t, errors.Is(err, middleware.ErrInvalidRateLimit))
+
+	cfg = createRateLimiterConfig()
+	cfg.Routes[0].SustainedRequestsPerSec = 0
+	rl, err = middleware.NewRateLimiter(cfg)
+	assert.True(t, check.IfNil(rl))
+	assert.True(t, errors.Is(err, middleware.ErrInvalidRateLimit))
+
+	cfg = createRateLimiterConfig()
+	cfg.APIKeyHeader = ""
+	rl, err = middleware.NewRateLimiter(cfg)
+	assert.True(t, check.IfNil(rl))
+	assert.Equal(t, middleware.ErrEmptyAPIKeyHeader, err)
+
+	cfg = createRateLimiterConfig()
+	cfg.APIKeys[0].Key = ""
+	rl, err = middleware.NewRateLimiter(cfg)
+	assert.True(t, check.IfNil(rl))
+	assert.Equal(t, middleware.ErrInvalidAPIKey, err)
+
+	cfg = createRateLimiterConfig()
+	cfg.APIKeys = append(cfg.APIKeys, cfg.APIKeys[0])
+	rl, err = middleware.NewRateLimiter(cfg)
+	assert.True(t, check.IfNil(rl))
+	assert.True(t, errors.Is(err, middleware.ErrDuplicatedAPIKey))
+
+	rl, err = middleware.NewRateLimiter(createRateLimiterConfig())
+	assert.False(t, check.IfNil(rl))
+	assert.Nil(t, err)
+}
+
+func TestRateLimiter_BurstExceededShouldRejectUntilRefilled(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	ws, _ := startNodeServerRateLimiter(t, clock)
+
+	for i := 0; i < 3; i++ {
+		resp := doRateLimitedRequest(ws, "/address/addr", "127.0.0.1:8080", "")
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "3", resp.Header().Get(middleware.HeaderRateLimitLimit))
+		assert.Equal(t, string(rune('2'-i)), resp.Header().Get(middleware.HeaderRateLimitRemaining))
+	}
+
+	resp := doRateLimitedRequest(ws, "/address/addr", "127.0.0.1:8080", "")
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Equal(t, "1", resp.Header().Get(middleware.HeaderRetryAfter))
+
+	resp = doRateLimitedRequest(ws, "/address/addr", "127.0.0.2:8080", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	clock.now = clock.now.Add(time.Second)
+	for i := 0; i < 2; i++ {
+		resp = doRateLimitedRequest(ws, "/address/addr", "127.0.0.1:8080", "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+	}
+	resp = doRateLimitedRequest(ws, "/address/addr", "127.0.0.1:8080", "")
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+}
+
+func TestRateLimiter_RouteLimitExceededShouldBanTheConsumerOnTheRoute(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	ws, _ := startNodeServerRateLimiter(t, clock)
+
+	resp := doRateLimitedRequest(ws, "/address/addr/balance", "127.0.0.1:8080", "")
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "1", resp.Header().Get(middleware.HeaderRateLimitLimit))
+	assert.Equal(t, "0", resp.Header().Get(middleware.HeaderRateLimitRemaining))
+
+	resp = doRateLimitedRequest(ws, "/address/addr/balance", "127.0.0.1:8080", "")
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Equal(t, "10", resp.Header().Get(middleware.HeaderRetryAfter))
+
+	resp = doRateLimitedRequest(ws, "/address/addr", "127.0.0.1:8080", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	clock.now = clock.now.Add(5 * time.Second)
+	resp = doRateLimitedRequest(ws, "/address/addr/balance", "127.0.0.1:8080", "")
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Equal(t, "5", resp.Header().Get(middleware.HeaderRetryAfter))
+
+	clock.now = clock.now.Add(6 * time.Second)
+	resp = doRateLimitedRequest(ws, "/address/addr/balance", "127.0.0.1:8080", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestRateLimiter_APIKeyConsumerShouldUseItsOwnLimitAndMetrics(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	ws, rateLimiter := startNodeServerRateLimiter(t, clock)
+
+	for i := 0; i < 10; i++ {
+		resp := doRateLimitedRequest(ws, "/address/addr", "127.0.0.1:8080", "secret")
+		require.Equal(t, http.StatusOK, resp.Code)
+	}
+	resp := doRateLimitedRequest(ws, "/address/addr", "127.0.0.1:8080", "secret")
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+
+	for i := 0; i < 4; i++ {
+		_ = doRateLimitedRequest(ws, "/address/addr", "127.0.0.1:8080", "unknown key")
+	}
+
+	metrics := rateLimiter.ConsumersMetrics()
+	require.Equal(t, 2, len(metrics))
+	assert.Equal(t, middleware.AnonymousConsumer, metrics[0].Consumer)
+	assert.Equal(t, uint64(3), metrics[0].NumAccepted)
+	assert.Equal(t, uint64(1), metrics[0].NumRejected)
+	assert.Equal(t, "explorer", metrics[1].Consumer)
+	assert.Equal(t, uint64(10), metrics[1].NumAccepted)
+	assert.Equal(t, uint64(1), metrics[1].NumRejected)
+	assert.Equal(t, clock.now.Unix(), metrics[1].LastRequestAt)
+}
@@ -0,0 +1,313 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// EncodingGzip is the gzip content encoding
+	EncodingGzip = "gzip"
+	// EncodingDeflate is the deflate (zlib format) content encoding
+	EncodingDeflate = "deflate"
+
+	headerAcceptEncoding  = "Accept-Encoding"
+	headerContentEncoding = "Content-Encoding"
+	headerContentLength   = "Content-Length"
+	headerETag            = "ETag"
+	headerIfNoneMatch     = "If-None-Match"
+	headerVary            = "Vary"
+	weakETagPrefix        = "W/"
+)
+
+// responseCompressor is a middleware buffering the successful GET responses of the configured routes in order to
+// compute their ETag, answering with 304 Not Modified when the client already holds the same representation, and to
+// compress them using the encoding accepted by the client. The responses of the other routes are not altered
+type responseCompressor struct {
+	routes           map[string]struct{}
+	routePrefixes    []string
+	minSizeInBytes   int
+	compressionLevel int
+}
+
+// NewResponseCompressor creates a new instance of a responseCompressor
+func NewResponseCompressor(cfg config.ResponseCompressionConfig) (*responseCompressor, error) {
+	if len(cfg.Routes) == 0 {
+		return nil, ErrNoCompressedRoutes
+	}
+	if cfg.CompressionLevel < flate.DefaultCompression || cfg.CompressionLevel > flate.BestCompression {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidCompressionLevel, cfg.CompressionLevel)
+	}
+
+	rc := &responseCompressor{
+		routes:           make(map[string]struct{}),
+		routePrefixes:    make([]string, 0),
+		minSizeInBytes:   int(cfg.MinSizeInBytes),
+		compressionLevel: cfg.CompressionLevel,
+	}
+
+	for _, route := range cfg.Routes {
+		if strings.HasSuffix(route, routesWildcard) {
+			rc.routePrefixes = append(rc.routePrefixes, strings.TrimSuffix(route, routesWildcard))
+			continue
+		}
+
+		rc.routes[route] = struct{}{}
+	}
+
+	return rc, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (rc *responseCompressor) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet || !rc.isCompressedRoute(shared.UnversionedRoute(c.FullPath())) {
+			c.Next()
+			return
+		}
+
+		originalWriter := c.Writer
+		bufferedWriter := newBufferedResponseWriter(originalWriter)
+		c.Writer = bufferedWriter
+		c.Next()
+		c.Writer = originalWriter
+
+		rc.writeResponse(c, bufferedWriter)
+	}
+}
+
+func (rc *responseCompressor) writeResponse(c *gin.Context, bufferedWriter *bufferedResponseWriter) {
+	writer := c.Writer
+	body := bufferedWriter.buffer.Bytes()
+	status := bufferedWriter.Status()
+	if status != http.StatusOK {
+		writeBody(writer, status, body)
+		return
+	}
+
+	header := writer.Header()
+	etag := computeETag(body)
+	header.Set(headerETag, etag)
+	header.Add(headerVary, headerAcceptEncoding)
+
+	if ifNoneMatchContains(c.GetHeader(headerIfNoneMatch), etag) {
+		header.Del(headerContentLength)
+		writer.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	encoding := selectEncoding(c.GetHeader(headerAcceptEncoding))
+	if len(encoding) == 0 || len(body) < rc.minSizeInBytes {
+		writeBody(writer, status, body)
+		return
+	}
+
+	compressed, err := compress(body, encoding, rc.compressionLevel)
+	if err != nil {
+		log.Debug("responseCompressor.writeResponse: compress", "route", c.FullPath(), "error", err.Error())
+		writeBody(writer, status, body)
+		return
+	}
+
+	header.Set(headerContentEncoding, encoding)
+	header.Del(headerContentLength)
+	writeBody(writer, status, compressed)
+}
+
+func (rc *responseCompressor) isCompressedRoute(route string) bool {
+	if len(route) == 0 {
+		return false
+	}
+
+	_, isCompressed := rc.routes[route]
+	if isCompressed {
+		return true
+	}
+
+	for _, prefix := range rc.routePrefixes {
+		if strings.HasPrefix(route, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func writeBody(writer gin.ResponseWriter, status int, body []byte) {
+	writer.WriteHeader(status)
+	_, err := writer.Write(body)
+	if err != nil {
+		log.Debug("write buffered response", "error", err.Error())
+	}
+}
+
+// computeETag returns a weak entity tag of the uncompressed body, as the compressed representations are semantically
+// equivalent with the uncompressed one
+func computeETag(body []byte) string {
+	hash := sha256.Sum256(body)
+	return weakETagPrefix + strconv.Quote(hex.EncodeToString(hash[:]))
+}
+
+// ifNoneMatchContains checks, using the weak comparison, if the If-None-Match header value holds the provided ETag
+func ifNoneMatchContains(ifNoneMatch string, etag string) bool {
+	if len(ifNoneMatch) == 0 {
+		return false
+	}
+
+	opaqueTag := strings.TrimPrefix(etag, weakETagPrefix)
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, weakETagPrefix) == opaqueTag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// selectEncoding returns the supported encoding accepted by the client, gzip being preferred when both are accepted.
+// An empty string is returned when no supported encoding is accepted
+func selectEncoding(acceptEncoding string) string {
+	acceptsGzip := false
+	acceptsDeflate := false
+	for _, entry := range strings.Split(acceptEncoding, ",") {
+		coding, quality := parseAcceptEncodingEntry(entry)
+		if quality <= 0 {
+			continue
+		}
+
+		switch coding {
+		case EncodingGzip, "*":
+			acceptsGzip = true
+		case EncodingDeflate:
+			acceptsDeflate = true
+		}
+	}
+
+	if acceptsGzip {
+		return EncodingGzip
+	}
+	if acceptsDeflate {
+		return EncodingDeflate
+	}
+
+	return ""
+}
+
+func parseAcceptEncodingEntry(entry string) (string, float64) {
+	parts := strings.Split(entry, ";")
+	coding := strings.ToLower(strings.TrimSpace(parts[0]))
+	quality := 1.0
+	for _, param := range parts[1:] {
+		param = strings.TrimSpace(param)
+		if !strings.HasPrefix(param, "q=") {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64)
+		if err != nil {
+			return coding, 0
+		}
+		quality = value
+	}
+
+	return coding, quality
+}
+
+func compress(body []byte, encoding string, level int) ([]byte, error) {
+	buff := &bytes.Buffer{}
+
+	var compressor io.WriteCloser
+	var err error
+	if encoding == EncodingGzip {
+		compressor, err = gzip.NewWriterLevel(buff, level)
+	} else {
+		compressor, err = zlib.NewWriterLevel(buff, level)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = compressor.Write(body)
+	if err != nil {
+		return nil, err
+	}
+	err = compressor.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *responseCompressor) IsInterfaceNil() bool {
+	return rc == nil
+}
+
+// bufferedResponseWriter holds the response written by the handlers, so it can be altered before being sent
+type bufferedResponseWriter struct {
+	gin.ResponseWriter
+	buffer bytes.Buffer
+	status int
+}
+
+func newBufferedResponseWriter(writer gin.ResponseWriter) *bufferedResponseWriter {
+	return &bufferedResponseWriter{
+		ResponseWriter: writer,
+		status:         writer.Status(),
+	}
+}
+
+// WriteHeader records the response status code
+func (brw *bufferedResponseWriter) WriteHeader(code int) {
+	if code > 0 {
+		brw.status = code
+	}
+}
+
+// WriteHeaderNow does nothing as the headers are sent after the response is processed
+func (brw *bufferedResponseWriter) WriteHeaderNow() {
+}
+
+// Write buffers the provided data
+func (brw *bufferedResponseWriter) Write(data []byte) (int, error) {
+	return brw.buffer.Write(data)
+}
+
+// WriteString buffers the provided string
+func (brw *bufferedResponseWriter) WriteString(s string) (int, error) {
+	return brw.buffer.WriteString(s)
+}
+
+// Status returns the recorded response status code
+func (brw *bufferedResponseWriter) Status() int {
+	return brw.status
+}
+
+// Size returns the number of buffered bytes
+func (brw *bufferedResponseWriter) Size() int {
+	return brw.buffer.Len()
+}
+
+// Written returns true if any data was buffered
+func (brw *bufferedResponseWriter) Written() bool {
+	return brw.buffer.Len() > 0
+}
+
+// Flush does nothing as the response is sent after it is processed
+func (brw *bufferedResponseWriter) Flush() {
+}
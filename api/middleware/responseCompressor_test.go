@@ -0,0 +1,157 @@
+package middleware_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var largeBody = strings.Repeat("block data ", 200)
+
+func createResponseCompressionConfig() config.ResponseCompressionConfig {
+	return config.ResponseCompressionConfig{
+		Enabled:          true,
+		Routes:           []string{"/block/*", "/network/esdts"},
+		MinSizeInBytes:   100,
+		CompressionLevel: 5,
+	}
+}
+
+func startNodeServerResponseCompressor(t *testing.T) *gin.Engine {
+	compressor, err := middleware.NewResponseCompressor(createResponseCompressionConfig())
+	require.Nil(t, err)
+
+	largeHandler := func(c *gin.Context) {
+		c.String(http.StatusOK, largeBody)
+	}
+	ws := gin.New()
+	ws.Use(compressor.MiddlewareHandlerFunc())
+	ws.GET("/block/by-nonce/:nonce", largeHandler)
+	ws.GET("/network/esdts", func(c *gin.Context) {
+		c.String(http.StatusOK, "small")
+	})
+	ws.GET("/block/by-hash/:hash", func(c *gin.Context) {
+		c.String(http.StatusBadRequest, largeBody)
+	})
+	ws.GET("/node/status", largeHandler)
+
+	return ws
+}
+
+func doRequest(ws *gin.Engine, path string, headers map[string]string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewResponseCompressor_InvalidConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := createResponseCompressionConfig()
+	cfg.Routes = nil
+	rc, err := middleware.NewResponseCompressor(cfg)
+	assert.True(t, check.IfNil(rc))
+	assert.Equal(t, middleware.ErrNoCompressedRoutes, err)
+
+	cfg = createResponseCompressionConfig()
+	cfg.CompressionLevel = 10
+	rc, err = middleware.NewResponseCompressor(cfg)
+	assert.True(t, check.IfNil(rc))
+	assert.True(t, errors.Is(err, middleware.ErrInvalidCompressionLevel))
+
+	rc, err = middleware.NewResponseCompressor(createResponseCompressionConfig())
+	assert.False(t, check.IfNil(rc))
+	assert.Nil(t, err)
+}
+
+func TestResponseCompressor_Compression(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerResponseCompressor(t)
+
+	t.Run("gzip is preferred", func(t *testing.T) {
+		resp := doRequest(ws, "/block/by-nonce/1", map[string]string{"Accept-Encoding": "deflate, gzip"})
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, middleware.EncodingGzip, resp.Header().Get("Content-Encoding"))
+
+		reader, err := gzip.NewReader(resp.Body)
+		require.Nil(t, err)
+		body, _ := ioutil.ReadAll(reader)
+		assert.Equal(t, largeBody, string(body))
+	})
+	t.Run("deflate", func(t *testing.T) {
+		resp := doRequest(ws, "/block/by-nonce/1", map[string]string{"Accept-Encoding": "gzip;q=0, deflate"})
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, middleware.EncodingDeflate, resp.Header().Get("Content-Encoding"))
+
+		reader, err := zlib.NewReader(resp.Body)
+		require.Nil(t, err)
+		body, _ := ioutil.ReadAll(reader)
+		assert.Equal(t, largeBody, string(body))
+	})
+	t.Run("no accepted encoding", func(t *testing.T) {
+		resp := doRequest(ws, "/block/by-nonce/1", map[string]string{"Accept-Encoding": "br"})
+		assert.Equal(t, "", resp.Header().Get("Content-Encoding"))
+		assert.Equal(t, largeBody, resp.Body.String())
+	})
+	t.Run("small responses are not compressed", func(t *testing.T) {
+		resp := doRequest(ws, "/network/esdts", map[string]string{"Accept-Encoding": "gzip"})
+		assert.Equal(t, "", resp.Header().Get("Content-Encoding"))
+		assert.Equal(t, "small", resp.Body.String())
+		assert.NotEmpty(t, resp.Header().Get("ETag"))
+	})
+	t.Run("failed responses are not altered", func(t *testing.T) {
+		resp := doRequest(ws, "/block/by-hash/aa", map[string]string{"Accept-Encoding": "gzip"})
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, "", resp.Header().Get("Content-Encoding"))
+		assert.Equal(t, "", resp.Header().Get("ETag"))
+		assert.Equal(t, largeBody, resp.Body.String())
+	})
+	t.Run("other routes are not altered", func(t *testing.T) {
+		resp := doRequest(ws, "/node/status", map[string]string{"Accept-Encoding": "gzip"})
+		assert.Equal(t, "", resp.Header().Get("Content-Encoding"))
+		assert.Equal(t, "", resp.Header().Get("ETag"))
+		assert.Equal(t, largeBody, resp.Body.String())
+	})
+}
+
+func TestResponseCompressor_ConditionalRequests(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerResponseCompressor(t)
+
+	resp := doRequest(ws, "/block/by-nonce/1", nil)
+	require.Equal(t, http.StatusOK, resp.Code)
+	etag := resp.Header().Get("ETag")
+	require.True(t, strings.HasPrefix(etag, `W/"`))
+
+	resp = doRequest(ws, "/block/by-nonce/1", map[string]string{"If-None-Match": etag, "Accept-Encoding": "gzip"})
+	assert.Equal(t, http.StatusNotModified, resp.Code)
+	assert.Equal(t, 0, resp.Body.Len())
+	assert.Equal(t, etag, resp.Header().Get("ETag"))
+
+	resp = doRequest(ws, "/block/by-nonce/1", map[string]string{"If-None-Match": `"other", ` + strings.TrimPrefix(etag, "W/")})
+	assert.Equal(t, http.StatusNotModified, resp.Code)
+
+	resp = doRequest(ws, "/block/by-nonce/1", map[string]string{"If-None-Match": `"other"`})
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, bytes.Equal([]byte(largeBody), resp.Body.Bytes()))
+}
@@ -68,6 +68,19 @@ func (st *sourceThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	}
 }
 
+// SetMaxNumRequests changes the maximum number of requests originating from the same source between two resets
+func (st *sourceThrottler) SetMaxNumRequests(maxNumRequests uint32) error {
+	if maxNumRequests == 0 {
+		return ErrInvalidMaxNumRequests
+	}
+
+	st.mutRequests.Lock()
+	st.maxNumRequests = maxNumRequests
+	st.mutRequests.Unlock()
+
+	return nil
+}
+
 // Reset resets all accumulated counters
 func (st *sourceThrottler) Reset() {
 	st.mutRequests.Lock()
@@ -0,0 +1,109 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/gin-gonic/gin"
+)
+
+// originalAPIResponse is the shared.GenericAPIResponse envelope, holding the data as raw JSON so that the numbers
+// are not altered while being adapted
+type originalAPIResponse struct {
+	Data  json.RawMessage   `json:"data"`
+	Error string            `json:"error"`
+	Code  shared.ReturnCode `json:"code"`
+}
+
+// v2ResponseAdapter is a middleware converting the responses of the version 2 API routes served by the original
+// handlers, including the ones written by the other middlewares, to the version 2 envelope. The responses of the
+// handlers already using the version 2 envelope and the successful non-JSON responses are not altered
+type v2ResponseAdapter struct {
+}
+
+// NewV2ResponseAdapter creates a new instance of a v2ResponseAdapter
+func NewV2ResponseAdapter() *v2ResponseAdapter {
+	return &v2ResponseAdapter{}
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (adapter *v2ResponseAdapter) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !shared.IsV2Route(c.Request.URL.Path) {
+			c.Next()
+			return
+		}
+
+		originalWriter := c.Writer
+		bufferedWriter := newBufferedResponseWriter(originalWriter)
+		c.Writer = bufferedWriter
+		c.Next()
+		c.Writer = originalWriter
+
+		status := bufferedWriter.Status()
+		body := bufferedWriter.buffer.Bytes()
+		if c.GetBool(shared.V2ResponseKey) {
+			writeBody(originalWriter, status, body)
+			return
+		}
+
+		response, ok := convertToV2Response(status, body)
+		if !ok {
+			writeBody(originalWriter, status, body)
+			return
+		}
+
+		c.JSON(status, response)
+	}
+}
+
+// convertToV2Response returns the version 2 envelope of the provided response, or false if the response should be
+// sent unchanged
+func convertToV2Response(status int, body []byte) (*shared.ResponseV2, bool) {
+	isFailed := status >= http.StatusBadRequest
+
+	original := &originalAPIResponse{}
+	err := json.Unmarshal(body, original)
+	if err != nil || len(original.Code) == 0 {
+		if !isFailed {
+			return nil, false
+		}
+
+		message := strings.TrimSpace(string(body))
+		if len(message) == 0 {
+			message = http.StatusText(status)
+		}
+
+		return &shared.ResponseV2{
+			Error: &shared.ErrorV2{
+				Code:    shared.ErrorCodeFromStatus(status),
+				Message: message,
+			},
+		}, true
+	}
+
+	response := &shared.ResponseV2{
+		Data: original.Data,
+	}
+	switch {
+	case isFailed:
+		response.Error = &shared.ErrorV2{
+			Code:    shared.ErrorCodeFromStatus(status),
+			Message: original.Error,
+		}
+	case original.Code != shared.ReturnCodeSuccess || len(original.Error) > 0:
+		response.Error = &shared.ErrorV2{
+			Code:    shared.ErrorCodeFromReturnCode(original.Code),
+			Message: original.Error,
+		}
+	}
+
+	return response, true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (adapter *v2ResponseAdapter) IsInterfaceNil() bool {
+	return adapter == nil
+}
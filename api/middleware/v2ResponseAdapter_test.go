@@ -0,0 +1,121 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func startNodeServerV2ResponseAdapter() *gin.Engine {
+	ws := gin.New()
+	ws.Use(middleware.NewV2ResponseAdapter().MiddlewareHandlerFunc())
+	ws.Use(func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "rejected" {
+			c.AbortWithStatusJSON(
+				http.StatusTooManyRequests,
+				shared.GenericAPIResponse{Error: "too many requests", Code: shared.ReturnCodeSystemBusy},
+			)
+			return
+		}
+		c.Next()
+	})
+
+	for _, prefix := range []string{"", shared.V2RoutesPrefix} {
+		group := ws.Group(prefix)
+		group.GET("/network/status", func(c *gin.Context) {
+			shared.RespondWith(c, http.StatusOK, gin.H{"nonce": uint64(18446744073709551615)}, "", shared.ReturnCodeSuccess)
+		})
+		group.GET("/network/failing", func(c *gin.Context) {
+			shared.RespondWith(c, http.StatusInternalServerError, nil, "failing", shared.ReturnCodeInternalError)
+		})
+		group.GET("/network/paginated", func(c *gin.Context) {
+			shared.RespondWithV2Page(c, gin.H{"nodes": []string{"a"}}, shared.PaginationV2{From: 0, Size: 1, Total: 2, NextFrom: 1})
+		})
+		group.GET("/node/metrics", func(c *gin.Context) {
+			c.String(http.StatusOK, "metric 10")
+		})
+	}
+
+	return ws
+}
+
+func loadResponseV2(t *testing.T, body []byte) *shared.ResponseV2 {
+	response := &shared.ResponseV2{}
+	err := json.Unmarshal(body, response)
+	require.Nil(t, err)
+
+	return response
+}
+
+func TestNewV2ResponseAdapter(t *testing.T) {
+	t.Parallel()
+
+	adapter := middleware.NewV2ResponseAdapter()
+	assert.False(t, check.IfNil(adapter))
+}
+
+func TestV2ResponseAdapter_OriginalRoutesAreNotAltered(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerV2ResponseAdapter()
+
+	resp := doRequest(ws, "/network/status", nil)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, `{"data":{"nonce":18446744073709551615},"error":"","code":"successful"}`, resp.Body.String())
+
+	resp = doRequest(ws, "/network/status", map[string]string{"Authorization": "rejected"})
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Contains(t, resp.Body.String(), string(shared.ReturnCodeSystemBusy))
+}
+
+func TestV2ResponseAdapter_AdaptsResponses(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerV2ResponseAdapter()
+
+	t.Run("successful response keeps the data unaltered", func(t *testing.T) {
+		resp := doRequest(ws, "/v2/network/status", nil)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, `{"data":{"nonce":18446744073709551615},"error":null}`, resp.Body.String())
+	})
+	t.Run("failed response", func(t *testing.T) {
+		resp := doRequest(ws, "/v2/network/failing", nil)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		response := loadResponseV2(t, resp.Body.Bytes())
+		require.NotNil(t, response.Error)
+		assert.Equal(t, shared.ErrorCodeInternalError, response.Error.Code)
+		assert.Equal(t, "failing", response.Error.Message)
+	})
+	t.Run("response written by a middleware", func(t *testing.T) {
+		resp := doRequest(ws, "/v2/network/status", map[string]string{"Authorization": "rejected"})
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+		response := loadResponseV2(t, resp.Body.Bytes())
+		require.NotNil(t, response.Error)
+		assert.Equal(t, shared.ErrorCodeTooManyRequests, response.Error.Code)
+		assert.Equal(t, "too many requests", response.Error.Message)
+	})
+	t.Run("unknown route", func(t *testing.T) {
+		resp := doRequest(ws, "/v2/network/missing", nil)
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		response := loadResponseV2(t, resp.Body.Bytes())
+		require.NotNil(t, response.Error)
+		assert.Equal(t, shared.ErrorCodeNotFound, response.Error.Code)
+	})
+	t.Run("version 2 responses are not altered", func(t *testing.T) {
+		resp := doRequest(ws, "/v2/network/paginated", nil)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, `{"data":{"nodes":["a"]},"error":null,"pagination":{"from":0,"size":1,"total":2,"nextFrom":1}}`, resp.Body.String())
+	})
+	t.Run("non JSON responses are not altered", func(t *testing.T) {
+		resp := doRequest(ws, "/v2/node/metrics", nil)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "metric 10", resp.Body.String())
+	})
+}
@@ -0,0 +1,175 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+)
+
+const retryAfterOverloadInSec = "1"
+
+// workQueue is a middleware bounding the number of requests processed at once, globally and for each configured
+// route, so the heavy API traffic does not starve the block processing of the node. The requests exceeding the
+// limits wait in a bounded queue: they are rejected with 429 when the queue is full and with 503 when they wait
+// longer than the configured duration
+type workQueue struct {
+	globalSlots chan struct{}
+	routesSlots map[string]chan struct{}
+	maxQueued   int64
+	numQueued   int64
+	maxWait     time.Duration
+	numRejected uint64
+	numTimedOut uint64
+}
+
+// NewWorkQueue creates a new instance of a workQueue
+func NewWorkQueue(cfg config.WorkQueueConfig) (*workQueue, error) {
+	if cfg.MaxConcurrentRequests == 0 {
+		return nil, fmt.Errorf("%w, MaxConcurrentRequests should not be 0", ErrInvalidConcurrencyLimit)
+	}
+	if cfg.MaxQueueWaitInMs == 0 {
+		return nil, fmt.Errorf("%w, MaxQueueWaitInMs should not be 0", ErrInvalidConcurrencyLimit)
+	}
+
+	wq := &workQueue{
+		globalSlots: make(chan struct{}, cfg.MaxConcurrentRequests),
+		routesSlots: make(map[string]chan struct{}),
+		maxQueued:   int64(cfg.MaxQueuedRequests),
+		maxWait:     time.Duration(cfg.MaxQueueWaitInMs) * time.Millisecond,
+	}
+	for _, routeCfg := range cfg.Routes {
+		if routeCfg.MaxConcurrentRequests == 0 {
+			return nil, fmt.Errorf("%w for route %s", ErrInvalidConcurrencyLimit, routeCfg.Route)
+		}
+
+		wq.routesSlots[routeCfg.Route] = make(chan struct{}, routeCfg.MaxConcurrentRequests)
+	}
+
+	return wq, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (wq *workQueue) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		routeSlots := wq.routesSlots[shared.UnversionedRoute(c.FullPath())]
+
+		err := wq.acquire(c.Request.Context(), routeSlots)
+		if err != nil {
+			wq.reject(c, err)
+			return
+		}
+		defer wq.release(routeSlots)
+
+		c.Next()
+	}
+}
+
+func (wq *workQueue) acquire(ctx context.Context, routeSlots chan struct{}) error {
+	if wq.tryAcquire(routeSlots) {
+		return nil
+	}
+
+	numQueued := atomic.AddInt64(&wq.numQueued, 1)
+	defer atomic.AddInt64(&wq.numQueued, -1)
+	if numQueued > wq.maxQueued {
+		atomic.AddUint64(&wq.numRejected, 1)
+		return ErrTooManyRequests
+	}
+
+	timer := time.NewTimer(wq.maxWait)
+	defer timer.Stop()
+
+	if routeSlots != nil {
+		select {
+		case routeSlots <- struct{}{}:
+		case <-timer.C:
+			atomic.AddUint64(&wq.numTimedOut, 1)
+			return ErrNodeOverloaded
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	select {
+	case wq.globalSlots <- struct{}{}:
+		return nil
+	case <-timer.C:
+		atomic.AddUint64(&wq.numTimedOut, 1)
+		releaseSlot(routeSlots)
+		return ErrNodeOverloaded
+	case <-ctx.Done():
+		releaseSlot(routeSlots)
+		return ctx.Err()
+	}
+}
+
+func (wq *workQueue) tryAcquire(routeSlots chan struct{}) bool {
+	if routeSlots != nil {
+		select {
+		case routeSlots <- struct{}{}:
+		default:
+			return false
+		}
+	}
+
+	select {
+	case wq.globalSlots <- struct{}{}:
+		return true
+	default:
+		releaseSlot(routeSlots)
+		return false
+	}
+}
+
+func (wq *workQueue) release(routeSlots chan struct{}) {
+	<-wq.globalSlots
+	releaseSlot(routeSlots)
+}
+
+func releaseSlot(slots chan struct{}) {
+	if slots != nil {
+		<-slots
+	}
+}
+
+func (wq *workQueue) reject(c *gin.Context, err error) {
+	if err != ErrTooManyRequests && err != ErrNodeOverloaded {
+		// the client gave up on the request, so there is no one to respond to
+		c.Abort()
+		return
+	}
+
+	status := http.StatusServiceUnavailable
+	if err == ErrTooManyRequests {
+		status = http.StatusTooManyRequests
+	}
+
+	log.Debug("workQueue: request rejected",
+		"route", c.FullPath(),
+		"error", err.Error(),
+		"num queued", atomic.LoadInt64(&wq.numQueued),
+		"num rejected", atomic.LoadUint64(&wq.numRejected),
+		"num timed out", atomic.LoadUint64(&wq.numTimedOut),
+	)
+
+	c.Header(HeaderRetryAfter, retryAfterOverloadInSec)
+	c.AbortWithStatusJSON(
+		status,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: err.Error(),
+			Code:  shared.ReturnCodeSystemBusy,
+		},
+	)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wq *workQueue) IsInterfaceNil() bool {
+	return wq == nil
+}
@@ -0,0 +1,125 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createWorkQueueConfig() config.WorkQueueConfig {
+	return config.WorkQueueConfig{
+		Enabled:               true,
+		MaxConcurrentRequests: 2,
+		MaxQueuedRequests:     1,
+		MaxQueueWaitInMs:      200,
+		Routes: []config.RouteConcurrencyConfig{
+			{Route: "/block/by-nonce/:nonce", MaxConcurrentRequests: 1},
+		},
+	}
+}
+
+func startNodeServerWorkQueue(t *testing.T, release chan struct{}) *gin.Engine {
+	wq, err := middleware.NewWorkQueue(createWorkQueueConfig())
+	require.Nil(t, err)
+
+	blockingHandler := func(c *gin.Context) {
+		<-release
+		c.String(http.StatusOK, "done")
+	}
+	ws := gin.New()
+	ws.Use(wq.MiddlewareHandlerFunc())
+	ws.GET("/block/by-nonce/:nonce", blockingHandler)
+	ws.GET("/node/status", blockingHandler)
+
+	return ws
+}
+
+func doConcurrentRequests(ws *gin.Engine, path string, numRequests int) (*sync.WaitGroup, chan int) {
+	codes := make(chan int, numRequests)
+	wg := &sync.WaitGroup{}
+	wg.Add(numRequests)
+	for i := 0; i < numRequests; i++ {
+		go func() {
+			defer wg.Done()
+			codes <- doRequest(ws, path, nil).Code
+		}()
+	}
+
+	return wg, codes
+}
+
+func TestNewWorkQueue_InvalidConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := createWorkQueueConfig()
+	cfg.MaxConcurrentRequests = 0
+	wq, err := middleware.NewWorkQueue(cfg)
+	assert.True(t, check.IfNil(wq))
+	assert.True(t, errors.Is(err, middleware.ErrInvalidConcurrencyLimit))
+
+	cfg = createWorkQueueConfig()
+	cfg.MaxQueueWaitInMs = 0
+	wq, err = middleware.NewWorkQueue(cfg)
+	assert.True(t, check.IfNil(wq))
+	assert.True(t, errors.Is(err, middleware.ErrInvalidConcurrencyLimit))
+
+	cfg = createWorkQueueConfig()
+	cfg.Routes[0].MaxConcurrentRequests = 0
+	wq, err = middleware.NewWorkQueue(cfg)
+	assert.True(t, check.IfNil(wq))
+	assert.True(t, errors.Is(err, middleware.ErrInvalidConcurrencyLimit))
+
+	wq, err = middleware.NewWorkQueue(createWorkQueueConfig())
+	assert.False(t, check.IfNil(wq))
+	assert.Nil(t, err)
+}
+
+func TestWorkQueue_FullQueueShouldRespondTooManyRequests(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	ws := startNodeServerWorkQueue(t, release)
+
+	// the route limit allows one request to be processed and the queue holds another one
+	wg, codes := doConcurrentRequests(ws, "/block/by-nonce/1", 3)
+	rejectedCode := <-codes
+	assert.Equal(t, http.StatusTooManyRequests, rejectedCode)
+
+	close(release)
+	wg.Wait()
+	close(codes)
+	for code := range codes {
+		assert.Equal(t, http.StatusOK, code)
+	}
+}
+
+func TestWorkQueue_QueueTimeoutShouldRespondServiceUnavailable(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	ws := startNodeServerWorkQueue(t, release)
+
+	wg, codes := doConcurrentRequests(ws, "/node/status", 2)
+	time.Sleep(time.Millisecond * 50)
+
+	resp := doRequest(ws, "/node/status", nil)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, "1", resp.Header().Get(middleware.HeaderRetryAfter))
+	assert.Contains(t, resp.Body.String(), middleware.ErrNodeOverloaded.Error())
+
+	close(release)
+	wg.Wait()
+	close(codes)
+	for code := range codes {
+		assert.Equal(t, http.StatusOK, code)
+	}
+}
@@ -3,31 +3,42 @@ package mock
 import (
 	"encoding/hex"
 	"math/big"
+	"time"
 
 	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/push"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/vm"
 	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 // Facade is the mock implementation of a node router handler
 type Facade struct {
-	ShouldErrorStart           bool
-	ShouldErrorStop            bool
-	TpsBenchmarkHandler        func() *statistics.TpsBenchmark
-	GetHeartbeatsHandler       func() ([]data.PubKeyHeartbeat, error)
-	BalanceHandler             func(string) (*big.Int, error)
-	GetAccountHandler          func(address string) (state.UserAccountHandler, error)
-	GetCodeCalled              func(state.AccountHandler) []byte
-	GenerateTransactionHandler func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
-	GetTransactionHandler      func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	CreateTransactionHandler   func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
+	ShouldErrorStart               bool
+	ShouldErrorStop                bool
+	TpsBenchmarkHandler            func() *statistics.TpsBenchmark
+	GetHeartbeatsHandler           func() ([]data.PubKeyHeartbeat, error)
+	GetHeartbeatsPageCalled        func(query data.HeartbeatsQuery) (*data.HeartbeatsPage, error)
+	GetHeartbeatsPerIdentityCalled func() ([]*data.IdentityHeartbeats, error)
+	GetManagedKeysPerNodeCalled    func() ([]data.NodeManagedKeys, error)
+	BalanceHandler                 func(string) (*big.Int, error)
+	GetAccountHandler              func(address string) (state.UserAccountHandler, error)
+	GetCodeCalled                  func(state.AccountHandler) []byte
+	GenerateTransactionHandler     func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
+	GetTransactionHandler          func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	CreateTransactionHandler       func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
 	ValidateTransactionHandler              func(tx *transaction.Transaction) error
 	ValidateTransactionForSimulationHandler func(tx *transaction.Transaction) error
@@ -35,21 +46,56 @@ type Facade struct {
 	ExecuteSCQueryHandler                   func(query *process.SCQuery) (*vm.VMOutputApi, error)
 	StatusMetricsHandler                    func() external.StatusMetricsHandler
 	ValidatorStatisticsHandler              func() (map[string]*state.ValidatorApiResponse, error)
+	GetValidatorStatisticsPageCalled        func(query state.ValidatorStatisticsQuery) (*state.ApiValidatorStatisticsPage, error)
 	ComputeTransactionGasLimitHandler       func(tx *transaction.Transaction) (uint64, error)
 	NodeConfigCalled                        func() map[string]interface{}
 	GetQueryHandlerCalled                   func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                    func(address string, key string) (string, error)
 	GetPeerInfoCalled                       func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConsensusParticipationCalled         func() []*consensus.EpochParticipation
+	GetCrossShardStatisticsCalled           func() []statistics.CrossShardPathStatistics
 	GetThrottlerForEndpointCalled           func(endpoint string) (core.Throttler, bool)
 	GetUsernameCalled                       func(address string) (string, error)
 	SimulateTransactionExecutionHandler     func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
 	GetNumCheckpointsFromAccountStateCalled func() uint32
 	GetNumCheckpointsFromPeerStateCalled    func() uint32
+	GetHealthCalled                         func() *external.HealthReport
+	GetReadinessCalled                      func() *external.HealthReport
+	GetOutportDriversStatusesCalled         func() []indexer.DriverStatus
 	GetESDTBalanceCalled                    func(address string, key string) (string, string, error)
 	GetAllESDTTokensCalled                  func(address string) ([]string, error)
-	GetBlockByHashCalled                    func(hash string, withTxs bool) (*apiBlock.APIBlock, error)
-	GetBlockByNonceCalled                   func(nonce uint64, withTxs bool) (*apiBlock.APIBlock, error)
+	GetBlockByHashCalled                    func(hash string, withTxs bool, withResults bool) (*apiBlock.APIBlock, error)
+	GetBlockByNonceCalled                   func(nonce uint64, withTxs bool, withResults bool) (*apiBlock.APIBlock, error)
+	GetRandomnessByNonceCalled              func(nonce uint64) (*apiBlock.APIRandomness, error)
+	GetRandomnessByEpochCalled              func(epoch uint32) (*apiBlock.APIRandomness, error)
+	GetRewardsAuditCalled                   func(epoch uint32) (*epochStart.RewardsAudit, error)
+	GetRewardsProofCalled                   func(epoch uint32, address string) (*epochStart.RewardsProof, error)
+	GetEpochValidatorsAssignmentCalled      func(epoch uint32) (*sharding.EpochValidatorsAssignment, error)
+	GetValidatorEligibilityEstimateCalled   func(blsKey string) (*sharding.ValidatorEligibilityEstimate, error)
+	GetSupplyAccountingCalled               func(epoch uint32) (*epochStart.SupplyAccounting, error)
+	GetEpochEconomicsCalled                 func(epoch uint32) (*epochStart.EpochEconomics, error)
+	GetESDTTokensListCalled                 func(from uint32, size uint32) (*external.ESDTTokensList, error)
+	GetStakingQueueCalled                   func(from uint32, size uint32) (*external.StakingQueue, error)
+	GetAPIConsumersMetricsCalled            func() []*middleware.ConsumerMetrics
+	GetESDTTokensPageCalled                 func(address string, options esdt.TokensPageOptions) (*esdt.ApiESDTTokensPage, error)
+	GetTransactionsPoolCalled               func(filter transaction.PoolFilter) ([]*transaction.ApiPoolTransaction, error)
+	GetTransactionsPoolSummaryCalled        func(filter transaction.PoolFilter) ([]*transaction.ApiPoolSenderSummary, error)
+	GetLogsCalled                           func(filter transaction.LogsFilter) ([]*transaction.ApiLogEvent, error)
+	GetDelegationPositionsCalled            func(address string) (*external.DelegatorPositions, error)
+	SubscribeToPushNotificationsCalled      func(filter push.Filter) (*push.Subscription, error)
+	UnsubscribeFromPushNotificationsCalled  func(subscription *push.Subscription)
 	GetTotalStakedValueHandler              func() (*big.Int, error)
+	GetNetworkAPRCalled                     func() (*external.NetworkAPR, error)
+	GetOwnerRewardsProjectionCalled         func(address string) (*external.OwnerRewardsProjection, error)
+	GetConnectedPeersCalled                 func() []core.QueryP2PPeerInfo
+	DisconnectPeerCalled                    func(pid string) error
+	BlacklistPeerCalled                     func(pid string, duration time.Duration) error
+	TriggerStateSnapshotCalled              func() (string, error)
+	CompactStorageCalled                    func() error
+	ReloadConfigCalled                      func() ([]string, error)
+	GetProofCalled                          func(rootHash string, address string) (*state.ApiProof, error)
+	GetProofDataTrieCalled                  func(rootHash string, address string, key string) (*state.ApiProof, error)
+	VerifyProofCalled                       func(rootHash string, key string, proof []string) (bool, string, error)
 }
 
 // GetUsername -
@@ -75,6 +121,11 @@ func (f *Facade) RestApiInterface() string {
 	return "localhost:8080"
 }
 
+// AppVersion -
+func (f *Facade) AppVersion() string {
+	return "v1.0.0"
+}
+
 // RestAPIServerDebugMode -
 func (f *Facade) RestAPIServerDebugMode() bool {
 	return false
@@ -98,6 +149,21 @@ func (f *Facade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 	return f.GetHeartbeatsHandler()
 }
 
+// GetHeartbeatsPage -
+func (f *Facade) GetHeartbeatsPage(query data.HeartbeatsQuery) (*data.HeartbeatsPage, error) {
+	return f.GetHeartbeatsPageCalled(query)
+}
+
+// GetHeartbeatsPerIdentity -
+func (f *Facade) GetHeartbeatsPerIdentity() ([]*data.IdentityHeartbeats, error) {
+	return f.GetHeartbeatsPerIdentityCalled()
+}
+
+// GetManagedKeysPerNode -
+func (f *Facade) GetManagedKeysPerNode() ([]data.NodeManagedKeys, error) {
+	return f.GetManagedKeysPerNodeCalled()
+}
+
 // GetBalance is the mock implementation of a handler's GetBalance method
 func (f *Facade) GetBalance(address string) (*big.Int, error) {
 	return f.BalanceHandler(address)
@@ -121,6 +187,15 @@ func (f *Facade) GetESDTBalance(address string, key string) (string, string, err
 	return "", "", nil
 }
 
+// GetESDTTokensPage -
+func (f *Facade) GetESDTTokensPage(address string, options esdt.TokensPageOptions) (*esdt.ApiESDTTokensPage, error) {
+	if f.GetESDTTokensPageCalled != nil {
+		return f.GetESDTTokensPageCalled(address, options)
+	}
+
+	return &esdt.ApiESDTTokensPage{}, nil
+}
+
 // GetAllESDTTokens -
 func (f *Facade) GetAllESDTTokens(address string) ([]string, error) {
 	if f.GetAllESDTTokensCalled != nil {
@@ -168,6 +243,34 @@ func (f *Facade) GetTransaction(hash string, withResults bool) (*transaction.Api
 	return f.GetTransactionHandler(hash, withResults)
 }
 
+// GetTransactionsPool -
+func (f *Facade) GetTransactionsPool(filter transaction.PoolFilter) ([]*transaction.ApiPoolTransaction, error) {
+	return f.GetTransactionsPoolCalled(filter)
+}
+
+// GetTransactionsPoolSummary -
+func (f *Facade) GetTransactionsPoolSummary(filter transaction.PoolFilter) ([]*transaction.ApiPoolSenderSummary, error) {
+	return f.GetTransactionsPoolSummaryCalled(filter)
+}
+
+// GetLogs -
+func (f *Facade) GetLogs(filter transaction.LogsFilter) ([]*transaction.ApiLogEvent, error) {
+	if f.GetLogsCalled != nil {
+		return f.GetLogsCalled(filter)
+	}
+
+	return make([]*transaction.ApiLogEvent, 0), nil
+}
+
+// GetDelegationPositions -
+func (f *Facade) GetDelegationPositions(address string) (*external.DelegatorPositions, error) {
+	if f.GetDelegationPositionsCalled != nil {
+		return f.GetDelegationPositionsCalled(address)
+	}
+
+	return &external.DelegatorPositions{}, nil
+}
+
 // SimulateTransactionExecution is the mock implementation of a handler's SimulateTransactionExecution method
 func (f *Facade) SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
 	return f.SimulateTransactionExecutionHandler(tx)
@@ -178,7 +281,7 @@ func (f *Facade) SendBulkTransactions(txs []*transaction.Transaction) (uint64, e
 	return f.SendBulkTransactionsHandler(txs)
 }
 
-//ValidateTransaction --
+// ValidateTransaction --
 func (f *Facade) ValidateTransaction(tx *transaction.Transaction) error {
 	return f.ValidateTransactionHandler(tx)
 }
@@ -193,6 +296,11 @@ func (f *Facade) ValidatorStatisticsApi() (map[string]*state.ValidatorApiRespons
 	return f.ValidatorStatisticsHandler()
 }
 
+// GetValidatorStatisticsPage -
+func (f *Facade) GetValidatorStatisticsPage(query state.ValidatorStatisticsQuery) (*state.ApiValidatorStatisticsPage, error) {
+	return f.GetValidatorStatisticsPageCalled(query)
+}
+
 // ExecuteSCQuery is a mock implementation.
 func (f *Facade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error) {
 	return f.ExecuteSCQueryHandler(query)
@@ -208,6 +316,34 @@ func (f *Facade) GetTotalStakedValue() (*big.Int, error) {
 	return f.GetTotalStakedValueHandler()
 }
 
+// GetNetworkAPR -
+func (f *Facade) GetNetworkAPR() (*external.NetworkAPR, error) {
+	return f.GetNetworkAPRCalled()
+}
+
+// GetOwnerRewardsProjection -
+func (f *Facade) GetOwnerRewardsProjection(address string) (*external.OwnerRewardsProjection, error) {
+	return f.GetOwnerRewardsProjectionCalled(address)
+}
+
+// GetESDTTokensList -
+func (f *Facade) GetESDTTokensList(from uint32, size uint32) (*external.ESDTTokensList, error) {
+	if f.GetESDTTokensListCalled != nil {
+		return f.GetESDTTokensListCalled(from, size)
+	}
+
+	return &external.ESDTTokensList{}, nil
+}
+
+// GetStakingQueue -
+func (f *Facade) GetStakingQueue(from uint32, size uint32) (*external.StakingQueue, error) {
+	if f.GetStakingQueueCalled != nil {
+		return f.GetStakingQueueCalled(from, size)
+	}
+
+	return &external.StakingQueue{}, nil
+}
+
 // ComputeTransactionGasLimit --
 func (f *Facade) ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error) {
 	return f.ComputeTransactionGasLimitHandler(tx)
@@ -238,6 +374,33 @@ func (f *Facade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return f.GetPeerInfoCalled(pid)
 }
 
+// GetConsensusParticipation -
+func (f *Facade) GetConsensusParticipation() []*consensus.EpochParticipation {
+	if f.GetConsensusParticipationCalled != nil {
+		return f.GetConsensusParticipationCalled()
+	}
+
+	return make([]*consensus.EpochParticipation, 0)
+}
+
+// GetCrossShardStatistics -
+func (f *Facade) GetCrossShardStatistics() []statistics.CrossShardPathStatistics {
+	if f.GetCrossShardStatisticsCalled != nil {
+		return f.GetCrossShardStatisticsCalled()
+	}
+
+	return make([]statistics.CrossShardPathStatistics, 0)
+}
+
+// GetAPIConsumersMetrics -
+func (f *Facade) GetAPIConsumersMetrics() []*middleware.ConsumerMetrics {
+	if f.GetAPIConsumersMetricsCalled != nil {
+		return f.GetAPIConsumersMetricsCalled()
+	}
+
+	return make([]*middleware.ConsumerMetrics, 0)
+}
+
 // GetNumCheckpointsFromAccountState -
 func (f *Facade) GetNumCheckpointsFromAccountState() uint32 {
 	if f.GetNumCheckpointsFromAccountStateCalled != nil {
@@ -256,14 +419,138 @@ func (f *Facade) GetNumCheckpointsFromPeerState() uint32 {
 	return 0
 }
 
+// GetHealth -
+func (f *Facade) GetHealth() *external.HealthReport {
+	if f.GetHealthCalled != nil {
+		return f.GetHealthCalled()
+	}
+
+	return &external.HealthReport{Status: external.HealthStatusOk}
+}
+
+// GetReadiness -
+func (f *Facade) GetReadiness() *external.HealthReport {
+	if f.GetReadinessCalled != nil {
+		return f.GetReadinessCalled()
+	}
+
+	return &external.HealthReport{Status: external.HealthStatusOk}
+}
+
+// GetOutportDriversStatuses -
+func (f *Facade) GetOutportDriversStatuses() []indexer.DriverStatus {
+	if f.GetOutportDriversStatusesCalled != nil {
+		return f.GetOutportDriversStatusesCalled()
+	}
+
+	return make([]indexer.DriverStatus, 0)
+}
+
 // GetBlockByNonce -
-func (f *Facade) GetBlockByNonce(nonce uint64, withTxs bool) (*apiBlock.APIBlock, error) {
-	return f.GetBlockByNonceCalled(nonce, withTxs)
+func (f *Facade) GetBlockByNonce(nonce uint64, withTxs bool, withResults bool) (*apiBlock.APIBlock, error) {
+	return f.GetBlockByNonceCalled(nonce, withTxs, withResults)
 }
 
 // GetBlockByHash -
-func (f *Facade) GetBlockByHash(hash string, withTxs bool) (*apiBlock.APIBlock, error) {
-	return f.GetBlockByHashCalled(hash, withTxs)
+func (f *Facade) GetBlockByHash(hash string, withTxs bool, withResults bool) (*apiBlock.APIBlock, error) {
+	return f.GetBlockByHashCalled(hash, withTxs, withResults)
+}
+
+// GetRandomnessByNonce -
+func (f *Facade) GetRandomnessByNonce(nonce uint64) (*apiBlock.APIRandomness, error) {
+	return f.GetRandomnessByNonceCalled(nonce)
+}
+
+// GetRandomnessByEpoch -
+func (f *Facade) GetRandomnessByEpoch(epoch uint32) (*apiBlock.APIRandomness, error) {
+	return f.GetRandomnessByEpochCalled(epoch)
+}
+
+// GetRewardsAudit -
+func (f *Facade) GetRewardsAudit(epoch uint32) (*epochStart.RewardsAudit, error) {
+	return f.GetRewardsAuditCalled(epoch)
+}
+
+// GetRewardsProof -
+func (f *Facade) GetRewardsProof(epoch uint32, address string) (*epochStart.RewardsProof, error) {
+	return f.GetRewardsProofCalled(epoch, address)
+}
+
+// GetEpochValidatorsAssignment -
+func (f *Facade) GetEpochValidatorsAssignment(epoch uint32) (*sharding.EpochValidatorsAssignment, error) {
+	return f.GetEpochValidatorsAssignmentCalled(epoch)
+}
+
+// GetValidatorEligibilityEstimate -
+func (f *Facade) GetValidatorEligibilityEstimate(blsKey string) (*sharding.ValidatorEligibilityEstimate, error) {
+	return f.GetValidatorEligibilityEstimateCalled(blsKey)
+}
+
+// GetSupplyAccounting -
+func (f *Facade) GetSupplyAccounting(epoch uint32) (*epochStart.SupplyAccounting, error) {
+	return f.GetSupplyAccountingCalled(epoch)
+}
+
+// GetEpochEconomics -
+func (f *Facade) GetEpochEconomics(epoch uint32) (*epochStart.EpochEconomics, error) {
+	return f.GetEpochEconomicsCalled(epoch)
+}
+
+// SubscribeToPushNotifications -
+func (f *Facade) SubscribeToPushNotifications(filter push.Filter) (*push.Subscription, error) {
+	return f.SubscribeToPushNotificationsCalled(filter)
+}
+
+// UnsubscribeFromPushNotifications -
+func (f *Facade) UnsubscribeFromPushNotifications(subscription *push.Subscription) {
+	if f.UnsubscribeFromPushNotificationsCalled != nil {
+		f.UnsubscribeFromPushNotificationsCalled(subscription)
+	}
+}
+
+// GetConnectedPeers -
+func (f *Facade) GetConnectedPeers() []core.QueryP2PPeerInfo {
+	return f.GetConnectedPeersCalled()
+}
+
+// DisconnectPeer -
+func (f *Facade) DisconnectPeer(pid string) error {
+	return f.DisconnectPeerCalled(pid)
+}
+
+// BlacklistPeer -
+func (f *Facade) BlacklistPeer(pid string, duration time.Duration) error {
+	return f.BlacklistPeerCalled(pid, duration)
+}
+
+// TriggerStateSnapshot -
+func (f *Facade) TriggerStateSnapshot() (string, error) {
+	return f.TriggerStateSnapshotCalled()
+}
+
+// CompactStorage -
+func (f *Facade) CompactStorage() error {
+	return f.CompactStorageCalled()
+}
+
+// ReloadConfig -
+func (f *Facade) ReloadConfig() ([]string, error) {
+	return f.ReloadConfigCalled()
+}
+
+// GetProof -
+func (f *Facade) GetProof(rootHash string, address string) (*state.ApiProof, error) {
+	return f.GetProofCalled(rootHash, address)
+}
+
+// GetProofDataTrie -
+func (f *Facade) GetProofDataTrie(rootHash string, address string, key string) (*state.ApiProof, error) {
+	return f.GetProofDataTrieCalled(rootHash, address, key)
+}
+
+// VerifyProof -
+func (f *Facade) VerifyProof(rootHash string, key string, proof []string) (bool, string, error) {
+	return f.VerifyProofCalled(rootHash, key, proof)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -1,26 +1,48 @@
 package network
 
 import (
+	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/gin-gonic/gin"
 )
 
 const (
-	getConfigPath   = "/config"
-	getStatusPath   = "/status"
-	economicsPath   = "/economics"
-	totalStakedPath = "/total-staked"
+	getConfigPath    = "/config"
+	getStatusPath    = "/status"
+	economicsPath    = "/economics"
+	totalStakedPath  = "/total-staked"
+	aprPath          = "/apr"
+	projectionPath   = "/projected-rewards/:address"
+	supplyPath       = "/supply/:epoch"
+	epochEconPath    = "/epoch-economics/:epoch"
+	esdtsPath        = "/esdts"
+	stakingQueuePath = "/staking-queue"
+)
+
+const (
+	queryParamFrom  = "from"
+	queryParamSize  = "size"
+	defaultPageSize = 100
+	maxPageSize     = 1000
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	GetTotalStakedValue() (*big.Int, error)
+	GetNetworkAPR() (*external.NetworkAPR, error)
+	GetOwnerRewardsProjection(address string) (*external.OwnerRewardsProjection, error)
+	GetSupplyAccounting(epoch uint32) (*epochStart.SupplyAccounting, error)
+	GetEpochEconomics(epoch uint32) (*epochStart.EpochEconomics, error)
+	GetESDTTokensList(from uint32, size uint32) (*external.ESDTTokensList, error)
+	GetStakingQueue(from uint32, size uint32) (*external.StakingQueue, error)
 	StatusMetrics() external.StatusMetricsHandler
 	IsInterfaceNil() bool
 }
@@ -31,6 +53,18 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getStatusPath, GetNetworkStatus)
 	router.RegisterHandler(http.MethodGet, economicsPath, EconomicsMetrics)
 	router.RegisterHandler(http.MethodGet, totalStakedPath, GetTotalStaked)
+	router.RegisterHandler(http.MethodGet, aprPath, GetNetworkAPR)
+	router.RegisterHandler(http.MethodGet, projectionPath, GetProjectedRewards)
+	router.RegisterHandler(http.MethodGet, supplyPath, GetSupplyAccounting)
+	router.RegisterHandler(http.MethodGet, epochEconPath, GetEpochEconomics)
+	router.RegisterHandler(http.MethodGet, esdtsPath, GetESDTTokensList)
+	router.RegisterHandler(http.MethodGet, stakingQueuePath, GetStakingQueue)
+}
+
+// RoutesV2 defines the network related routes changed in the version 2 API
+func RoutesV2(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, esdtsPath, GetESDTTokensListV2)
+	router.RegisterHandler(http.MethodGet, stakingQueuePath, GetStakingQueueV2)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -152,3 +186,298 @@ func GetTotalStaked(c *gin.Context) {
 	)
 	return
 }
+
+// GetNetworkAPR is the endpoint that will return the annual percentage rates of the base stake and of the top-up stake
+func GetNetworkAPR(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	networkAPR, err := facade.GetNetworkAPR()
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetNetworkAPR.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"apr": networkAPR}, "", shared.ReturnCodeSuccess)
+}
+
+// GetProjectedRewards is the endpoint that will return the rewards projected for the nodes of the provided owner
+func GetProjectedRewards(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	address := c.Param("address")
+	if len(address) == 0 {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrEmptyAddress.Error()),
+		)
+		return
+	}
+
+	projection, err := facade.GetOwnerRewardsProjection(address)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetProjectedRewards.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"projectedRewards": projection}, "", shared.ReturnCodeSuccess)
+}
+
+// GetSupplyAccounting will return the record of the tokens minted, the fees burned and the treasury inflows of the
+// provided epoch, together with the cumulative values
+func GetSupplyAccounting(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	epoch, err := strconv.ParseUint(c.Param("epoch"), 10, 32)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidEpoch.Error()),
+		)
+		return
+	}
+
+	supplyAccounting, err := facade.GetSupplyAccounting(uint32(epoch))
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetSupplyAccounting.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"supply": supplyAccounting}, "", shared.ReturnCodeSuccess)
+}
+
+// GetEpochEconomics will return the aggregated fees, burns, mints and distributed rewards of the provided epoch
+func GetEpochEconomics(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	epoch, err := strconv.ParseUint(c.Param("epoch"), 10, 32)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidEpoch.Error()),
+		)
+		return
+	}
+
+	epochEconomics, err := facade.GetEpochEconomics(uint32(epoch))
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetEpochEconomics.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"economics": epochEconomics}, "", shared.ReturnCodeSuccess)
+}
+
+// GetESDTTokensList returns a page of the ESDT tokens issued in the network, together with their properties. The
+// nextFrom value of the response should be provided back as the from parameter for fetching the next page
+func GetESDTTokensList(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	from, size, err := getPageQueryParams(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	tokensList, err := facade.GetESDTTokensList(from, size)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetESDTTokensList.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{
+			"tokens":    tokensList.Tokens,
+			"numTokens": tokensList.NumTokens,
+			"nextFrom":  tokensList.NextFrom,
+		},
+		"",
+		shared.ReturnCodeSuccess,
+	)
+}
+
+// GetStakingQueue returns a page of the nodes waiting in the staking queue, together with their position, owner and
+// register nonce. The nextFrom value of the response should be provided back as the from parameter for fetching the
+// next page
+func GetStakingQueue(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	from, size, err := getPageQueryParams(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	stakingQueue, err := facade.GetStakingQueue(from, size)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetStakingQueue.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{
+			"nodes":    stakingQueue.Nodes,
+			"numNodes": stakingQueue.NumNodes,
+			"nextFrom": stakingQueue.NextFrom,
+		},
+		"",
+		shared.ReturnCodeSuccess,
+	)
+}
+
+// GetESDTTokensListV2 returns a page of the ESDT tokens issued in the network, with the version 2 pagination
+func GetESDTTokensListV2(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	from, size, err := getPageQueryParams(c)
+	if err != nil {
+		shared.RespondWithV2Error(c, http.StatusBadRequest, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()))
+		return
+	}
+
+	tokensList, err := facade.GetESDTTokensList(from, size)
+	if err != nil {
+		shared.RespondWithV2Error(
+			c,
+			http.StatusInternalServerError,
+			fmt.Sprintf("%s: %s", errors.ErrGetESDTTokensList.Error(), err.Error()),
+		)
+		return
+	}
+
+	shared.RespondWithV2Page(
+		c,
+		gin.H{"tokens": tokensList.Tokens},
+		shared.PaginationV2{
+			From:     from,
+			Size:     size,
+			Total:    tokensList.NumTokens,
+			NextFrom: tokensList.NextFrom,
+		},
+	)
+}
+
+// GetStakingQueueV2 returns a page of the nodes waiting in the staking queue, with the version 2 pagination
+func GetStakingQueueV2(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	from, size, err := getPageQueryParams(c)
+	if err != nil {
+		shared.RespondWithV2Error(c, http.StatusBadRequest, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()))
+		return
+	}
+
+	stakingQueue, err := facade.GetStakingQueue(from, size)
+	if err != nil {
+		shared.RespondWithV2Error(
+			c,
+			http.StatusInternalServerError,
+			fmt.Sprintf("%s: %s", errors.ErrGetStakingQueue.Error(), err.Error()),
+		)
+		return
+	}
+
+	shared.RespondWithV2Page(
+		c,
+		gin.H{"nodes": stakingQueue.Nodes},
+		shared.PaginationV2{
+			From:     from,
+			Size:     size,
+			Total:    stakingQueue.NumNodes,
+			NextFrom: stakingQueue.NextFrom,
+		},
+	)
+}
+
+func getPageQueryParams(c *gin.Context) (uint32, uint32, error) {
+	query := c.Request.URL.Query()
+
+	from := uint64(0)
+	fromStr := query.Get(queryParamFrom)
+	if fromStr != "" {
+		var err error
+		from, err = strconv.ParseUint(fromStr, 10, 32)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid %s parameter: %w", queryParamFrom, err)
+		}
+	}
+
+	size := uint64(defaultPageSize)
+	sizeStr := query.Get(queryParamSize)
+	if sizeStr != "" {
+		var err error
+		size, err = strconv.ParseUint(sizeStr, 10, 32)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid %s parameter: %w", queryParamSize, err)
+		}
+		if size == 0 || size > maxPageSize {
+			return 0, 0, fmt.Errorf("invalid %s parameter: should be between 1 and %d", queryParamSize, maxPageSize)
+		}
+	}
+
+	return uint32(from), uint32(size), nil
+}
@@ -19,11 +19,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNetworkConfigMetrics_NilContextShouldError(t *testing.T) {
@@ -210,6 +212,458 @@ func TestTotalStaked_ShouldWork(t *testing.T) {
 	assert.True(t, keyAndValueFoundInResponse)
 }
 
+func TestGetNetworkAPR_ErrorShouldErr(t *testing.T) {
+	expectedErr := fmt.Errorf("expected error")
+	facade := &mock.Facade{
+		GetNetworkAPRCalled: func() (*external.NetworkAPR, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/apr", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetNetworkAPR.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetNetworkAPR_ShouldWork(t *testing.T) {
+	networkAPR := &external.NetworkAPR{
+		Epoch:    7,
+		BaseAPR:  0.1,
+		TopUpAPR: 0.05,
+	}
+	facade := &mock.Facade{
+		GetNetworkAPRCalled: func() (*external.NetworkAPR, error) {
+			return networkAPR, nil
+		},
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/apr", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			APR *external.NetworkAPR `json:"apr"`
+		} `json:"data"`
+		Code string `json:"code"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, networkAPR, response.Data.APR)
+}
+
+func TestGetProjectedRewards_ErrorShouldErr(t *testing.T) {
+	expectedErr := fmt.Errorf("expected error")
+	facade := &mock.Facade{
+		GetOwnerRewardsProjectionCalled: func(address string) (*external.OwnerRewardsProjection, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/projected-rewards/erd1owner", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetProjectedRewards.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetProjectedRewards_ShouldWork(t *testing.T) {
+	projection := &external.OwnerRewardsProjection{
+		Epoch:           7,
+		NumStakedNodes:  2,
+		RewardsPerEpoch: "1000",
+		APR:             0.09,
+	}
+	providedAddress := ""
+	facade := &mock.Facade{
+		GetOwnerRewardsProjectionCalled: func(address string) (*external.OwnerRewardsProjection, error) {
+			providedAddress = address
+			return projection, nil
+		},
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/projected-rewards/erd1owner", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			ProjectedRewards *external.OwnerRewardsProjection `json:"projectedRewards"`
+		} `json:"data"`
+		Code string `json:"code"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "erd1owner", providedAddress)
+	assert.Equal(t, projection, response.Data.ProjectedRewards)
+}
+
+func TestGetSupplyAccounting_InvalidEpochShouldErr(t *testing.T) {
+	facade := &mock.Facade{}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/supply/invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrInvalidEpoch.Error()))
+}
+
+func TestGetSupplyAccounting_ErrorShouldErr(t *testing.T) {
+	expectedErr := fmt.Errorf("expected error")
+	facade := &mock.Facade{
+		GetSupplyAccountingCalled: func(epoch uint32) (*epochStart.SupplyAccounting, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/supply/3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetSupplyAccounting.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetSupplyAccounting_ShouldWork(t *testing.T) {
+	supplyAccounting := &epochStart.SupplyAccounting{
+		Epoch:                     3,
+		TotalSupply:               "1000",
+		InflationMinted:           "10",
+		BurnedFees:                "1",
+		TreasuryInflows:           "2",
+		CumulativeInflationMinted: "30",
+		CumulativeBurnedFees:      "3",
+		CumulativeTreasuryInflows: "6",
+	}
+	providedEpoch := uint32(0)
+	facade := &mock.Facade{
+		GetSupplyAccountingCalled: func(epoch uint32) (*epochStart.SupplyAccounting, error) {
+			providedEpoch = epoch
+			return supplyAccounting, nil
+		},
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/supply/3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Supply *epochStart.SupplyAccounting `json:"supply"`
+		} `json:"data"`
+		Code string `json:"code"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint32(3), providedEpoch)
+	assert.Equal(t, supplyAccounting, response.Data.Supply)
+}
+
+func TestGetEpochEconomics_InvalidEpochShouldErr(t *testing.T) {
+	facade := &mock.Facade{}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/epoch-economics/invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrInvalidEpoch.Error()))
+}
+
+func TestGetEpochEconomics_ErrorShouldErr(t *testing.T) {
+	expectedErr := fmt.Errorf("expected error")
+	facade := &mock.Facade{
+		GetEpochEconomicsCalled: func(epoch uint32) (*epochStart.EpochEconomics, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/epoch-economics/3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetEpochEconomics.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetEpochEconomics_ShouldWork(t *testing.T) {
+	epochEconomics := &epochStart.EpochEconomics{
+		Epoch:              3,
+		TotalFees:          "100",
+		BurnedFees:         "5",
+		DeveloperRewards:   "30",
+		InflationMinted:    "1000",
+		RewardsDistributed: "1065",
+	}
+	providedEpoch := uint32(0)
+	facade := &mock.Facade{
+		GetEpochEconomicsCalled: func(epoch uint32) (*epochStart.EpochEconomics, error) {
+			providedEpoch = epoch
+			return epochEconomics, nil
+		},
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/epoch-economics/3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Economics *epochStart.EpochEconomics `json:"economics"`
+		} `json:"data"`
+		Code string `json:"code"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint32(3), providedEpoch)
+	assert.Equal(t, epochEconomics, response.Data.Economics)
+}
+
+func TestGetESDTTokensList_InvalidSizeShouldErr(t *testing.T) {
+	facade := &mock.Facade{}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/esdts?size=1001", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrValidation.Error()))
+}
+
+func TestGetESDTTokensList_ErrorShouldErr(t *testing.T) {
+	expectedErr := fmt.Errorf("expected error")
+	facade := &mock.Facade{
+		GetESDTTokensListCalled: func(from uint32, size uint32) (*external.ESDTTokensList, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/esdts", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetESDTTokensList.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetESDTTokensList_ShouldWork(t *testing.T) {
+	tokensList := &external.ESDTTokensList{
+		Tokens: []*external.ESDTTokenMetadata{
+			{
+				TokenIdentifier: "TKN-abcdef",
+				Name:            "Token",
+				Type:            "FungibleESDT",
+				Owner:           "erd1owner",
+				NumDecimals:     6,
+				Supply:          "900",
+				Minted:          "1000",
+				Burnt:           "100",
+			},
+		},
+		NumTokens: 12,
+		NextFrom:  11,
+	}
+	providedFrom, providedSize := uint32(0), uint32(0)
+	facade := &mock.Facade{
+		GetESDTTokensListCalled: func(from uint32, size uint32) (*external.ESDTTokensList, error) {
+			providedFrom, providedSize = from, size
+			return tokensList, nil
+		},
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/esdts?from=10&size=1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Tokens    []*external.ESDTTokenMetadata `json:"tokens"`
+			NumTokens uint32                        `json:"numTokens"`
+			NextFrom  uint32                        `json:"nextFrom"`
+		} `json:"data"`
+		Code string `json:"code"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint32(10), providedFrom)
+	assert.Equal(t, uint32(1), providedSize)
+	assert.Equal(t, tokensList.Tokens, response.Data.Tokens)
+	assert.Equal(t, uint32(12), response.Data.NumTokens)
+	assert.Equal(t, uint32(11), response.Data.NextFrom)
+}
+
+func TestGetStakingQueue_ErrorShouldErr(t *testing.T) {
+	expectedErr := fmt.Errorf("expected error")
+	facade := &mock.Facade{
+		GetStakingQueueCalled: func(from uint32, size uint32) (*external.StakingQueue, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/staking-queue", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetStakingQueue.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetStakingQueue_ShouldWork(t *testing.T) {
+	stakingQueue := &external.StakingQueue{
+		Nodes: []*external.StakingQueueNode{
+			{
+				Position:      6,
+				BLSKey:        "bls",
+				Owner:         "erd1owner",
+				RegisterNonce: 37,
+			},
+		},
+		NumNodes: 8,
+		NextFrom: 6,
+	}
+	providedFrom, providedSize := uint32(0), uint32(0)
+	facade := &mock.Facade{
+		GetStakingQueueCalled: func(from uint32, size uint32) (*external.StakingQueue, error) {
+			providedFrom, providedSize = from, size
+			return stakingQueue, nil
+		},
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/staking-queue?from=5&size=1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Nodes    []*external.StakingQueueNode `json:"nodes"`
+			NumNodes uint32                       `json:"numNodes"`
+			NextFrom uint32                       `json:"nextFrom"`
+		} `json:"data"`
+		Code string `json:"code"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint32(5), providedFrom)
+	assert.Equal(t, uint32(1), providedSize)
+	assert.Equal(t, stakingQueue.Nodes, response.Data.Nodes)
+	assert.Equal(t, uint32(8), response.Data.NumNodes)
+	assert.Equal(t, uint32(6), response.Data.NextFrom)
+}
+
+func TestGetStakingQueueV2_ShouldWork(t *testing.T) {
+	stakingQueue := &external.StakingQueue{
+		Nodes: []*external.StakingQueueNode{
+			{
+				Position:      6,
+				BLSKey:        "bls",
+				Owner:         "erd1owner",
+				RegisterNonce: 37,
+			},
+		},
+		NumNodes: 8,
+		NextFrom: 6,
+	}
+	facade := &mock.Facade{
+		GetStakingQueueCalled: func(from uint32, size uint32) (*external.StakingQueue, error) {
+			return stakingQueue, nil
+		},
+	}
+
+	ws := startNodeServerV2(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/v2/network/staking-queue?from=5&size=1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Nodes []*external.StakingQueueNode `json:"nodes"`
+		} `json:"data"`
+		Error      *shared.ErrorV2      `json:"error"`
+		Pagination *shared.PaginationV2 `json:"pagination"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Nil(t, response.Error)
+	assert.Equal(t, stakingQueue.Nodes, response.Data.Nodes)
+	assert.Equal(t, &shared.PaginationV2{From: 5, Size: 1, Total: 8, NextFrom: 6}, response.Pagination)
+}
+
+func TestGetESDTTokensListV2_InvalidPageShouldErr(t *testing.T) {
+	ws := startNodeServerV2(&mock.Facade{})
+	req, _ := http.NewRequest(http.MethodGet, "/v2/network/esdts?size=0", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.ResponseV2{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	require.NotNil(t, response.Error)
+	assert.Equal(t, shared.ErrorCodeBadRequest, response.Error.Code)
+	assert.True(t, strings.Contains(response.Error.Message, errors.ErrValidation.Error()))
+	assert.Nil(t, response.Pagination)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -234,6 +688,16 @@ func startNodeServer(handler network.FacadeHandler) *gin.Engine {
 	return ws
 }
 
+func startNodeServerV2(handler network.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	networkRoutes := ws.Group(shared.V2RoutesPrefix + "/network")
+	networkRoutes.Use(middleware.WithFacade(handler))
+	networkRouteWrapper, _ := wrapper.NewRouterWrapper("network", networkRoutes, getRoutesConfig())
+	network.RoutesV2(networkRouteWrapper)
+	network.Routes(networkRouteWrapper)
+	return ws
+}
+
 func startNodeServerWrongFacade() *gin.Engine {
 	ws := gin.New()
 	ws.Use(cors.Default())
@@ -260,6 +724,12 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/status", Open: true},
 					{Name: "/economics", Open: true},
 					{Name: "/total-staked", Open: true},
+					{Name: "/apr", Open: true},
+					{Name: "/projected-rewards/:address", Open: true},
+					{Name: "/supply/:epoch", Open: true},
+					{Name: "/epoch-economics/:epoch", Open: true},
+					{Name: "/esdts", Open: true},
+					{Name: "/staking-queue", Open: true},
 				},
 			},
 		},
@@ -4,11 +4,15 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
@@ -17,16 +21,47 @@ import (
 )
 
 const (
-	pidQueryParam       = "pid"
-	debugPath           = "/debug"
-	heartbeatStatusPath = "/heartbeatstatus"
-	metricsPath         = "/metrics"
-	p2pStatusPath       = "/p2pstatus"
-	peerInfoPath        = "/peerinfo"
-	statisticsPath      = "/statistics"
-	statusPath          = "/status"
+	pidQueryParam              = "pid"
+	debugPath                  = "/debug"
+	heartbeatStatusPath        = "/heartbeatstatus"
+	heartbeatIdentitiesPath    = "/heartbeatstatus/identities"
+	heartbeatManagedKeysPath   = "/heartbeatstatus/managedkeys"
+	metricsPath                = "/metrics"
+	p2pStatusPath              = "/p2pstatus"
+	peerInfoPath               = "/peerinfo"
+	statisticsPath             = "/statistics"
+	statusPath                 = "/status"
+	consensusParticipationPath = "/consensusparticipation"
+	crossShardStatisticsPath   = "/crossshardstatistics"
+	apiConsumersPath           = "/apiconsumers"
+	healthPath                 = "/health"
+	readyPath                  = "/ready"
+	outportPath                = "/outport"
 )
 
+const (
+	queryParamShard    = "shard"
+	queryParamIdentity = "identity"
+	queryParamStatus   = "status"
+	queryParamPeerType = "peerType"
+	queryParamFrom     = "from"
+	queryParamSize     = "size"
+	statusOnline       = "online"
+	statusOffline      = "offline"
+
+	defaultHeartbeatsPageSize = 100
+	maxHeartbeatsPageSize     = 1000
+)
+
+var heartbeatsQueryParams = []string{
+	queryParamShard,
+	queryParamIdentity,
+	queryParamStatus,
+	queryParamPeerType,
+	queryParamFrom,
+	queryParamSize,
+}
+
 // AccStateCheckpointsKey is used as a key for the number of account state checkpoints in the api response
 const AccStateCheckpointsKey = "erd_num_accounts_state_checkpoints"
 
@@ -36,12 +71,21 @@ const PeerStateCheckpointsKey = "erd_num_peer_state_checkpoints"
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
+	GetHeartbeatsPage(query data.HeartbeatsQuery) (*data.HeartbeatsPage, error)
+	GetHeartbeatsPerIdentity() ([]*data.IdentityHeartbeats, error)
+	GetManagedKeysPerNode() ([]data.NodeManagedKeys, error)
 	TpsBenchmark() *statistics.TpsBenchmark
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConsensusParticipation() []*consensus.EpochParticipation
+	GetCrossShardStatistics() []statistics.CrossShardPathStatistics
+	GetAPIConsumersMetrics() []*middleware.ConsumerMetrics
 	GetNumCheckpointsFromAccountState() uint32
 	GetNumCheckpointsFromPeerState() uint32
+	GetHealth() *external.HealthReport
+	GetReadiness() *external.HealthReport
+	GetOutportDriversStatuses() []indexer.DriverStatus
 	IsInterfaceNil() bool
 }
 
@@ -51,7 +95,8 @@ type QueryDebugRequest struct {
 	Search string `form:"search" json:"search"`
 }
 
-type statisticsResponse struct {
+// StatisticsResponse represents the network statistics returned by the API
+type StatisticsResponse struct {
 	LiveTPS               float64                   `json:"liveTPS"`
 	PeakTPS               float64                   `json:"peakTPS"`
 	BlockNumber           uint64                    `json:"blockNumber"`
@@ -59,12 +104,13 @@ type statisticsResponse struct {
 	RoundTime             uint64                    `json:"roundTime"`
 	AverageBlockTxCount   *big.Int                  `json:"averageBlockTxCount"`
 	TotalProcessedTxCount *big.Int                  `json:"totalProcessedTxCount"`
-	ShardStatistics       []shardStatisticsResponse `json:"shardStatistics"`
+	ShardStatistics       []ShardStatisticsResponse `json:"shardStatistics"`
 	LastBlockTxCount      uint32                    `json:"lastBlockTxCount"`
 	NrOfShards            uint32                    `json:"nrOfShards"`
 }
 
-type shardStatisticsResponse struct {
+// ShardStatisticsResponse represents the statistics of a shard returned by the API
+type ShardStatisticsResponse struct {
 	LiveTPS               float64  `json:"liveTPS"`
 	AverageTPS            *big.Int `json:"averageTPS"`
 	PeakTPS               float64  `json:"peakTPS"`
@@ -75,15 +121,36 @@ type shardStatisticsResponse struct {
 	LastBlockTxCount      uint32   `json:"lastBlockTxCount"`
 }
 
+// CrossShardPathStatisticsResponse represents the routing statistics of the miniblocks sent from a shard to another
+// shard, returned by the API
+type CrossShardPathStatisticsResponse struct {
+	SenderShardID                uint32 `json:"senderShardID"`
+	ReceiverShardID              uint32 `json:"receiverShardID"`
+	NumMiniBlocks                uint64 `json:"numMiniBlocks"`
+	NumTxs                       uint64 `json:"numTxs"`
+	NumExecutedMiniBlocks        uint64 `json:"numExecutedMiniBlocks"`
+	AverageNotarizationDelayInMs uint64 `json:"averageNotarizationDelayMs"`
+	NumPendingMiniBlocks         uint64 `json:"numPendingMiniBlocks"`
+	NumPendingTxs                uint64 `json:"numPendingTxs"`
+}
+
 // Routes defines node related routes
 func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, heartbeatStatusPath, HeartbeatStatus)
+	router.RegisterHandler(http.MethodGet, heartbeatIdentitiesPath, HeartbeatIdentities)
+	router.RegisterHandler(http.MethodGet, heartbeatManagedKeysPath, HeartbeatManagedKeys)
 	router.RegisterHandler(http.MethodGet, statisticsPath, Statistics)
 	router.RegisterHandler(http.MethodGet, statusPath, StatusMetrics)
 	router.RegisterHandler(http.MethodGet, p2pStatusPath, P2pStatusMetrics)
 	router.RegisterHandler(http.MethodGet, metricsPath, PrometheusMetrics)
 	router.RegisterHandler(http.MethodPost, debugPath, QueryDebug)
 	router.RegisterHandler(http.MethodGet, peerInfoPath, PeerInfo)
+	router.RegisterHandler(http.MethodGet, consensusParticipationPath, ConsensusParticipation)
+	router.RegisterHandler(http.MethodGet, crossShardStatisticsPath, CrossShardStatistics)
+	router.RegisterHandler(http.MethodGet, apiConsumersPath, APIConsumers)
+	router.RegisterHandler(http.MethodGet, healthPath, Health)
+	router.RegisterHandler(http.MethodGet, readyPath, Ready)
+	router.RegisterHandler(http.MethodGet, outportPath, OutportDrivers)
 	// placeholder for custom routes
 }
 
@@ -117,13 +184,19 @@ func getFacade(c *gin.Context) (FacadeHandler, bool) {
 	return facade, true
 }
 
-// HeartbeatStatus respond with the heartbeat status of the node
+// HeartbeatStatus respond with the heartbeat status of the node. When any of the filtering or pagination query
+// parameters is provided, a page of the matching heartbeats is returned instead
 func HeartbeatStatus(c *gin.Context) {
 	facade, ok := getFacade(c)
 	if !ok {
 		return
 	}
 
+	if hasHeartbeatsQueryParams(c) {
+		heartbeatsPage(c, facade)
+		return
+	}
+
 	hbStatus, err := facade.GetHeartbeats()
 	if err != nil {
 		c.JSON(
@@ -147,6 +220,130 @@ func HeartbeatStatus(c *gin.Context) {
 	)
 }
 
+func heartbeatsPage(c *gin.Context, facade FacadeHandler) {
+	query, err := getHeartbeatsQuery(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()))
+		return
+	}
+
+	page, err := facade.GetHeartbeatsPage(query)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), shared.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{
+			"heartbeats":    page.Heartbeats,
+			"numHeartbeats": page.NumHeartbeats,
+			"nextFrom":      page.NextFrom,
+		},
+		"",
+		shared.ReturnCodeSuccess,
+	)
+}
+
+func hasHeartbeatsQueryParams(c *gin.Context) bool {
+	query := c.Request.URL.Query()
+	for _, param := range heartbeatsQueryParams {
+		_, exists := query[param]
+		if exists {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getHeartbeatsQuery(c *gin.Context) (data.HeartbeatsQuery, error) {
+	query := c.Request.URL.Query()
+	result := data.HeartbeatsQuery{
+		Identity: query.Get(queryParamIdentity),
+		PeerType: query.Get(queryParamPeerType),
+		Size:     defaultHeartbeatsPageSize,
+	}
+
+	shardStr := query.Get(queryParamShard)
+	if shardStr != "" {
+		shardID, err := strconv.ParseUint(shardStr, 10, 32)
+		if err != nil {
+			return result, errors.ErrInvalidShardID
+		}
+		shard := uint32(shardID)
+		result.ShardID = &shard
+	}
+
+	switch query.Get(queryParamStatus) {
+	case "":
+	case statusOnline:
+		isActive := true
+		result.IsActive = &isActive
+	case statusOffline:
+		isActive := false
+		result.IsActive = &isActive
+	default:
+		return result, fmt.Errorf("invalid %s parameter: should be %s or %s", queryParamStatus, statusOnline, statusOffline)
+	}
+
+	fromStr := query.Get(queryParamFrom)
+	if fromStr != "" {
+		from, err := strconv.ParseUint(fromStr, 10, 32)
+		if err != nil {
+			return result, fmt.Errorf("invalid %s parameter: %w", queryParamFrom, err)
+		}
+		result.From = uint32(from)
+	}
+
+	sizeStr := query.Get(queryParamSize)
+	if sizeStr != "" {
+		size, err := strconv.ParseUint(sizeStr, 10, 32)
+		if err != nil {
+			return result, fmt.Errorf("invalid %s parameter: %w", queryParamSize, err)
+		}
+		if size == 0 || size > maxHeartbeatsPageSize {
+			return result, fmt.Errorf("invalid %s parameter: should be between 1 and %d", queryParamSize, maxHeartbeatsPageSize)
+		}
+		result.Size = uint32(size)
+	}
+
+	return result, nil
+}
+
+// HeartbeatIdentities returns the heartbeat status aggregated by the identity declared by the nodes
+func HeartbeatIdentities(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	identities, err := facade.GetHeartbeatsPerIdentity()
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), shared.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"identities": identities}, "", shared.ReturnCodeSuccess)
+}
+
+// HeartbeatManagedKeys returns the validator keys managed by each of the nodes running in multikey mode
+func HeartbeatManagedKeys(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	nodes, err := facade.GetManagedKeysPerNode()
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), shared.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"nodes": nodes}, "", shared.ReturnCodeSuccess)
+}
+
 // Statistics returns the blockchain statistics
 func Statistics(c *gin.Context) {
 	facade, ok := getFacade(c)
@@ -202,8 +399,8 @@ func P2pStatusMetrics(c *gin.Context) {
 	)
 }
 
-func statsFromTpsBenchmark(tpsBenchmark *statistics.TpsBenchmark) statisticsResponse {
-	sr := statisticsResponse{}
+func statsFromTpsBenchmark(tpsBenchmark *statistics.TpsBenchmark) StatisticsResponse {
+	sr := StatisticsResponse{}
 	sr.LiveTPS = tpsBenchmark.LiveTPS()
 	sr.PeakTPS = tpsBenchmark.PeakTPS()
 	sr.NrOfShards = tpsBenchmark.NrOfShards()
@@ -213,11 +410,11 @@ func statsFromTpsBenchmark(tpsBenchmark *statistics.TpsBenchmark) statisticsResp
 	sr.AverageBlockTxCount = tpsBenchmark.AverageBlockTxCount()
 	sr.LastBlockTxCount = tpsBenchmark.LastBlockTxCount()
 	sr.TotalProcessedTxCount = tpsBenchmark.TotalProcessedTxCount()
-	sr.ShardStatistics = make([]shardStatisticsResponse, tpsBenchmark.NrOfShards())
+	sr.ShardStatistics = make([]ShardStatisticsResponse, tpsBenchmark.NrOfShards())
 
 	for i := 0; i < int(tpsBenchmark.NrOfShards()); i++ {
 		ss := tpsBenchmark.ShardStatistic(uint32(i))
-		sr.ShardStatistics[i] = shardStatisticsResponse{
+		sr.ShardStatistics[i] = ShardStatisticsResponse{
 			ShardID:               ss.ShardID(),
 			LiveTPS:               ss.LiveTPS(),
 			PeakTPS:               ss.PeakTPS(),
@@ -313,6 +510,137 @@ func PeerInfo(c *gin.Context) {
 	)
 }
 
+// ConsensusParticipation returns the node's own participation in consensus during each of the last epochs
+func ConsensusParticipation(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"participation": facade.GetConsensusParticipation()},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// CrossShardStatistics returns the volumes, the average notarization delay and the pending backlog of each shard
+// pair, as computed from the notarized meta blocks
+func CrossShardStatistics(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	pathsStatistics := facade.GetCrossShardStatistics()
+	response := make([]CrossShardPathStatisticsResponse, 0, len(pathsStatistics))
+	for _, pathStatistics := range pathsStatistics {
+		response = append(response, CrossShardPathStatisticsResponse{
+			SenderShardID:                pathStatistics.SenderShardID,
+			ReceiverShardID:              pathStatistics.ReceiverShardID,
+			NumMiniBlocks:                pathStatistics.NumMiniBlocks,
+			NumTxs:                       pathStatistics.NumTxs,
+			NumExecutedMiniBlocks:        pathStatistics.NumExecutedMiniBlocks,
+			AverageNotarizationDelayInMs: pathStatistics.AverageNotarizationDelayInMs,
+			NumPendingMiniBlocks:         pathStatistics.NumPendingMiniBlocks,
+			NumPendingTxs:                pathStatistics.NumPendingTxs,
+		})
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"statistics": response},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// APIConsumers returns the accepted and rejected requests of the rate limited web server consumers
+func APIConsumers(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"consumers": facade.GetAPIConsumersMetrics()},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// Health returns the liveness report of the node. The response has the 503 status code if any of the checked
+// subsystems is failing, so it can be used as a liveness probe
+func Health(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	respondWithHealthReport(c, facade.GetHealth(), errors.ErrNodeNotHealthy)
+}
+
+// Ready returns the readiness report of the node. The response has the 503 status code if any of the checked
+// subsystems is failing, so it can be used as a readiness probe or as a load-balancer health check
+func Ready(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	respondWithHealthReport(c, facade.GetReadiness(), errors.ErrNodeNotReady)
+}
+
+// OutportDrivers returns the health of the outport drivers that deliver the data to an external endpoint: their state,
+// the items waiting for their delivery and the last delivery error
+func OutportDrivers(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"drivers": facade.GetOutportDriversStatuses()},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func respondWithHealthReport(c *gin.Context, report *external.HealthReport, failingErr error) {
+	data := gin.H{"status": report.Status, "checks": report.Checks}
+	if report.Status == external.HealthStatusFailing {
+		c.JSON(
+			http.StatusServiceUnavailable,
+			shared.GenericAPIResponse{
+				Data:  data,
+				Error: failingErr.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  data,
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // PrometheusMetrics is the endpoint which will return the data in the way that prometheus expects them
 func PrometheusMetrics(c *gin.Context) {
 	facade, ok := getFacade(c)
@@ -15,12 +15,15 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/node"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
@@ -124,7 +127,6 @@ func TestHeartbeatstatus(t *testing.T) {
 		{
 			PublicKey:       "pk1",
 			TimeStamp:       time.Now(),
-			MaxInactiveTime: data.Duration{Duration: 0},
 			IsActive:        true,
 			ReceivedShardID: uint32(0),
 		},
@@ -146,6 +148,189 @@ func TestHeartbeatstatus(t *testing.T) {
 	assert.NotEqual(t, "", statusRsp.Message)
 }
 
+func TestHeartbeatstatus_PageInvalidQueryParamsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	paths := []string{
+		"/node/heartbeatstatus?shard=a",
+		"/node/heartbeatstatus?status=jailed",
+		"/node/heartbeatstatus?from=-1",
+		"/node/heartbeatstatus?size=0",
+		"/node/heartbeatstatus?size=1001",
+	}
+	for _, path := range paths {
+		req, _ := http.NewRequest("GET", path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, path)
+		assert.True(t, strings.Contains(response.Error, errors.ErrValidation.Error()), path)
+	}
+}
+
+func TestHeartbeatstatus_PageShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var receivedQuery data.HeartbeatsQuery
+	facade := mock.Facade{
+		GetHeartbeatsPageCalled: func(query data.HeartbeatsQuery) (*data.HeartbeatsPage, error) {
+			receivedQuery = query
+			return &data.HeartbeatsPage{
+				Heartbeats:    []data.PubKeyHeartbeat{{PublicKey: "pk1", Identity: "provider"}},
+				NumHeartbeats: 5,
+				NextFrom:      3,
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	path := "/node/heartbeatstatus?shard=1&identity=provider&status=offline&peerType=eligible&from=2&size=1"
+	req, _ := http.NewRequest("GET", path, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	require.NotNil(t, receivedQuery.ShardID)
+	assert.Equal(t, uint32(1), *receivedQuery.ShardID)
+	require.NotNil(t, receivedQuery.IsActive)
+	assert.False(t, *receivedQuery.IsActive)
+	assert.Equal(t, "provider", receivedQuery.Identity)
+	assert.Equal(t, "eligible", receivedQuery.PeerType)
+	assert.Equal(t, uint32(2), receivedQuery.From)
+	assert.Equal(t, uint32(1), receivedQuery.Size)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, float64(5), responseData["numHeartbeats"])
+	assert.Equal(t, float64(3), responseData["nextFrom"])
+	heartbeats, ok := responseData["heartbeats"].([]interface{})
+	require.True(t, ok)
+	assert.Equal(t, 1, len(heartbeats))
+}
+
+func TestHeartbeatIdentities_FromFacadeErrors(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errs.New("expected error")
+	facade := mock.Facade{
+		GetHeartbeatsPerIdentityCalled: func() ([]*data.IdentityHeartbeats, error) {
+			return nil, errExpected
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/heartbeatstatus/identities", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errExpected.Error(), response.Error)
+}
+
+func TestHeartbeatIdentities_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetHeartbeatsPerIdentityCalled: func() ([]*data.IdentityHeartbeats, error) {
+			return []*data.IdentityHeartbeats{
+				{Identity: "provider", NumNodes: 2, NumActive: 1, NumInactive: 1, NumNodesPerShard: map[uint32]uint32{0: 2}},
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/heartbeatstatus/identities", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	identities, ok := responseData["identities"].([]interface{})
+	require.True(t, ok)
+	require.Equal(t, 1, len(identities))
+	identity, ok := identities[0].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "provider", identity["identity"])
+	assert.Equal(t, float64(2), identity["numNodes"])
+}
+
+func TestHeartbeatManagedKeys_FromFacadeErrors(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errs.New("expected error")
+	facade := mock.Facade{
+		GetManagedKeysPerNodeCalled: func() ([]data.NodeManagedKeys, error) {
+			return nil, errExpected
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/heartbeatstatus/managedkeys", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errExpected.Error(), response.Error)
+}
+
+func TestHeartbeatManagedKeys_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetManagedKeysPerNodeCalled: func() ([]data.NodeManagedKeys, error) {
+			return []data.NodeManagedKeys{
+				{
+					Pid:            "pid",
+					Identity:       "provider",
+					IsActive:       true,
+					NumManagedKeys: 2,
+					NumActiveKeys:  1,
+					ManagedKeys: []data.ManagedKeyHeartbeat{
+						{PublicKey: "aa", IsActive: true},
+						{PublicKey: "bb", IsActive: false},
+					},
+				},
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/heartbeatstatus/managedkeys", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	nodes, ok := responseData["nodes"].([]interface{})
+	require.True(t, ok)
+	require.Equal(t, 1, len(nodes))
+	node, ok := nodes[0].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "provider", node["identity"])
+	assert.Equal(t, float64(2), node["numManagedKeys"])
+	managedKeys, ok := node["managedKeys"].([]interface{})
+	require.True(t, ok)
+	assert.Equal(t, 2, len(managedKeys))
+}
+
 func TestStatistics_NilContextShouldError(t *testing.T) {
 	t.Parallel()
 	ws := startNodeServer(nil)
@@ -461,6 +646,168 @@ func TestPeerInfo_PeerInfoShouldWork(t *testing.T) {
 	assert.NotNil(t, responseInfo["info"])
 }
 
+func TestConsensusParticipation_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetConsensusParticipationCalled: func() []*consensus.EpochParticipation {
+			return []*consensus.EpochParticipation{
+				{
+					Epoch:                     3,
+					NumRoundsInConsensusGroup: 10,
+					NumRoundsAsLeader:         2,
+					NumProposedBlocks:         1,
+					NumMissedProposals:        1,
+				},
+			}
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/consensusparticipation", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	participation, ok := responseData["participation"].([]interface{})
+	require.True(t, ok)
+	require.Equal(t, 1, len(participation))
+
+	epochParticipation, ok := participation[0].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, float64(3), epochParticipation["epoch"])
+	assert.Equal(t, float64(1), epochParticipation["numMissedProposals"])
+}
+
+func TestCrossShardStatistics_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetCrossShardStatisticsCalled: func() []statistics.CrossShardPathStatistics {
+			return []statistics.CrossShardPathStatistics{
+				{
+					SenderShardID:                0,
+					ReceiverShardID:              1,
+					NumMiniBlocks:                4,
+					NumTxs:                       40,
+					NumExecutedMiniBlocks:        3,
+					AverageNotarizationDelayInMs: 12000,
+					NumPendingMiniBlocks:         1,
+					NumPendingTxs:                10,
+				},
+			}
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/crossshardstatistics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	pathsStatistics, ok := responseData["statistics"].([]interface{})
+	require.True(t, ok)
+	require.Equal(t, 1, len(pathsStatistics))
+
+	pathStatistics, ok := pathsStatistics[0].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, float64(1), pathStatistics["receiverShardID"])
+	assert.Equal(t, float64(12000), pathStatistics["averageNotarizationDelayMs"])
+	assert.Equal(t, float64(10), pathStatistics["numPendingTxs"])
+}
+
+func TestOutportDrivers_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetOutportDriversStatusesCalled: func() []indexer.DriverStatus {
+			return []indexer.DriverStatus{
+				{
+					Name:                "elasticsearch",
+					State:               indexer.DriverStateBuffering,
+					PendingItems:        12,
+					ConsecutiveFailures: 4,
+					LastError:           "connection refused",
+				},
+			}
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/outport", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	drivers, ok := responseData["drivers"].([]interface{})
+	require.True(t, ok)
+	require.Equal(t, 1, len(drivers))
+
+	driver, ok := drivers[0].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "elasticsearch", driver["name"])
+	assert.Equal(t, string(indexer.DriverStateBuffering), driver["state"])
+	assert.Equal(t, float64(12), driver["pendingItems"])
+	assert.Equal(t, "connection refused", driver["lastError"])
+}
+
+func TestAPIConsumers_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetAPIConsumersMetricsCalled: func() []*middleware.ConsumerMetrics {
+			return []*middleware.ConsumerMetrics{
+				{
+					Consumer:    middleware.AnonymousConsumer,
+					NumAccepted: 7,
+					NumRejected: 2,
+					NumBans:     1,
+				},
+			}
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/apiconsumers", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	consumers, ok := responseData["consumers"].([]interface{})
+	require.True(t, ok)
+	require.Equal(t, 1, len(consumers))
+
+	consumerMetrics, ok := consumers[0].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, middleware.AnonymousConsumer, consumerMetrics["consumer"])
+	assert.Equal(t, float64(7), consumerMetrics["numAccepted"])
+	assert.Equal(t, float64(2), consumerMetrics["numRejected"])
+}
+
 func TestPrometheusMetrics_NilContextShouldErr(t *testing.T) {
 	ws := startNodeServer(nil)
 	req, _ := http.NewRequest("GET", "/node/metrics", nil)
@@ -547,6 +894,79 @@ func startNodeServerWithFacade(facade interface{}) *gin.Engine {
 	return ws
 }
 
+func TestHealth_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetHealthCalled: func() *external.HealthReport {
+			return &external.HealthReport{
+				Status: external.HealthStatusDegraded,
+				Checks: []*external.HealthCheck{
+					{
+						Subsystem: external.HealthSubsystemP2P,
+						Status:    external.HealthStatusDegraded,
+						Reason:    external.HealthReasonFewConnectedPeers,
+					},
+				},
+			}
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/health", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, string(external.HealthStatusDegraded), responseData["status"])
+	checks, ok := responseData["checks"].([]interface{})
+	require.True(t, ok)
+	require.Equal(t, 1, len(checks))
+	check, ok := checks[0].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, external.HealthReasonFewConnectedPeers, check["reason"])
+}
+
+func TestReady_FailingShouldRespondServiceUnavailable(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetReadinessCalled: func() *external.HealthReport {
+			return &external.HealthReport{
+				Status: external.HealthStatusFailing,
+				Checks: []*external.HealthCheck{
+					{
+						Subsystem: external.HealthSubsystemSync,
+						Status:    external.HealthStatusFailing,
+						Reason:    external.HealthReasonSyncDistanceExceeded,
+					},
+				},
+			}
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/ready", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, errors.ErrNodeNotReady.Error(), response.Error)
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, string(external.HealthStatusFailing), responseData["status"])
+}
+
 func getRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -556,9 +976,17 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/metrics", Open: true},
 					{Name: "/statistics", Open: true},
 					{Name: "/heartbeatstatus", Open: true},
+					{Name: "/heartbeatstatus/identities", Open: true},
+					{Name: "/heartbeatstatus/managedkeys", Open: true},
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
+					{Name: "/consensusparticipation", Open: true},
+					{Name: "/crossshardstatistics", Open: true},
+					{Name: "/apiconsumers", Open: true},
+					{Name: "/health", Open: true},
+					{Name: "/ready", Open: true},
+					{Name: "/outport", Open: true},
 				},
 			},
 		},
@@ -0,0 +1,392 @@
+package openapi
+
+import (
+	"github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/graphql"
+	"github.com/ElrondNetwork/elrond-go/api/hardfork"
+	"github.com/ElrondNetwork/elrond-go/api/management"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/node"
+	"github.com/ElrondNetwork/elrond-go/api/proof"
+	"github.com/ElrondNetwork/elrond-go/api/transaction"
+	"github.com/ElrondNetwork/elrond-go/api/validator"
+	"github.com/ElrondNetwork/elrond-go/api/vmValues"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	dataTransaction "github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/vm"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+// endpointDoc holds the models of an endpoint. The data fields are the fields of the data object from the generic API
+// response, provided as values of the types they hold. The endpoints not responding with JSON have a raw media type
+type endpointDoc struct {
+	summary      string
+	queryParams  []string
+	request      interface{}
+	data         map[string]interface{}
+	rawMediaType string
+}
+
+var endpointsDocs = map[string]endpointDoc{
+	"GET /address/:address": {
+		summary: "returns the account of the address",
+		data:    map[string]interface{}{"account": address.AccountResponse{}},
+	},
+	"GET /address/:address/balance": {
+		summary: "returns the balance of the address",
+		data:    map[string]interface{}{"balance": ""},
+	},
+	"GET /address/:address/username": {
+		summary: "returns the username of the address",
+		data:    map[string]interface{}{"username": ""},
+	},
+	"GET /address/:address/key/:key": {
+		summary: "returns the hex encoded value of the hex encoded key from the account storage",
+		data:    map[string]interface{}{"value": ""},
+	},
+	"GET /address/:address/esdt": {
+		summary: "returns the ESDT tokens held by the address",
+		data:    map[string]interface{}{"tokens": []string{}},
+	},
+	"GET /address/:address/esdt/:tokenIdentifier": {
+		summary: "returns the balance of the ESDT token held by the address",
+		data:    map[string]interface{}{"tokenData": address.ESDTTokenData{}},
+	},
+	"GET /address/:address/esdts": {
+		summary:     "returns a page of the ESDT tokens held by the address, pinned to a state root hash",
+		queryParams: []string{"rootHash", "cursor", "token", "size"},
+		data: map[string]interface{}{
+			"tokens":     []*esdt.ApiESDTToken{},
+			"rootHash":   "",
+			"nextCursor": "",
+		},
+	},
+	"GET /address/:address/logs": {
+		summary:     "returns the log events generated by the address",
+		queryParams: []string{"identifier", "fromBlock", "toBlock"},
+		data:        map[string]interface{}{"logs": []*dataTransaction.ApiLogEvent{}},
+	},
+	"GET /address/:address/delegations": {
+		summary: "returns the funds of the address in all the delegation contracts",
+		data: map[string]interface{}{
+			"delegations":           external.DelegatorPositions{}.Delegations,
+			"totalActiveStake":      "",
+			"totalUnDelegated":      "",
+			"totalClaimableRewards": "",
+		},
+	},
+
+	"GET /block/by-nonce/:nonce": {
+		summary:     "returns the block with the provided nonce",
+		queryParams: []string{"withTxs", "withResults"},
+		data:        map[string]interface{}{"block": block.APIBlock{}},
+	},
+	"GET /block/by-hash/:hash": {
+		summary:     "returns the block with the provided hash",
+		queryParams: []string{"withTxs", "withResults"},
+		data:        map[string]interface{}{"block": block.APIBlock{}},
+	},
+	"GET /block/randomness/by-nonce/:nonce": {
+		summary: "returns the randomness source of the block with the provided nonce",
+		data:    map[string]interface{}{"randomness": block.APIRandomness{}},
+	},
+	"GET /block/randomness/by-epoch/:epoch": {
+		summary: "returns the randomness source of the start of epoch block",
+		data:    map[string]interface{}{"randomness": block.APIRandomness{}},
+	},
+
+	"GET /graphql/query": {
+		summary:     "executes a GraphQL query",
+		queryParams: []string{"query", "operationName", "variables"},
+		data:        map[string]interface{}{"result": graphql.Response{}},
+	},
+	"POST /graphql/query": {
+		summary: "executes a GraphQL query",
+		request: graphql.QueryRequest{},
+		data:    map[string]interface{}{"result": graphql.Response{}},
+	},
+
+	"POST /hardfork/trigger": {
+		summary: "triggers the hardfork process",
+		request: hardfork.HarforkRequest{},
+		data:    map[string]interface{}{"status": ""},
+	},
+
+	"GET /logs": {
+		summary:     "returns the log events having the provided identifier",
+		queryParams: []string{"identifier", "address", "fromBlock", "toBlock"},
+		data:        map[string]interface{}{"logs": []*dataTransaction.ApiLogEvent{}},
+	},
+
+	"GET /management/log-level": {
+		summary: "returns the log level pattern in use",
+		data:    map[string]interface{}{"pattern": ""},
+	},
+	"POST /management/log-level": {
+		summary: "changes the log level pattern",
+		request: management.LogLevelRequest{},
+		data:    map[string]interface{}{"pattern": ""},
+	},
+	"GET /management/peers": {
+		summary: "returns the peers the node is connected to",
+		data:    map[string]interface{}{"peers": []core.QueryP2PPeerInfo{}},
+	},
+	"POST /management/peers/:pid/disconnect": {
+		summary: "closes the connections with the peer",
+		data:    map[string]interface{}{"status": ""},
+	},
+	"POST /management/peers/:pid/blacklist": {
+		summary: "denies the peer for the provided duration",
+		request: management.BlacklistRequest{},
+		data:    map[string]interface{}{"status": ""},
+	},
+	"POST /management/state/snapshot": {
+		summary: "starts a snapshot of the current accounts state",
+		data:    map[string]interface{}{"rootHash": ""},
+	},
+	"POST /management/storage/compact": {
+		summary: "compacts the storage units of the node",
+		data:    map[string]interface{}{"status": ""},
+	},
+	"GET /management/profile/:name": {
+		summary:      "dumps the requested runtime profile",
+		queryParams:  []string{"debug"},
+		rawMediaType: "application/octet-stream",
+	},
+	"POST /management/config/reload": {
+		summary: "reloads the configurations that can be changed without restarting the node",
+		data:    map[string]interface{}{"applied": []string{}},
+	},
+
+	"GET /network/config": {
+		summary: "returns the network configuration metrics",
+		data:    map[string]interface{}{"config": map[string]interface{}{}},
+	},
+	"GET /network/status": {
+		summary: "returns the network status metrics",
+		data:    map[string]interface{}{"status": map[string]interface{}{}},
+	},
+	"GET /network/economics": {
+		summary: "returns the network economics metrics",
+		data:    map[string]interface{}{"metrics": map[string]interface{}{}},
+	},
+	"GET /network/total-staked": {
+		summary: "returns the total staked value",
+		data:    map[string]interface{}{"totalStakedValue": ""},
+	},
+	"GET /network/apr": {
+		summary: "returns the network annual percentage rates",
+		data:    map[string]interface{}{"apr": external.NetworkAPR{}},
+	},
+	"GET /network/projected-rewards/:address": {
+		summary: "returns the projected rewards of the staking owner",
+		data:    map[string]interface{}{"projectedRewards": external.OwnerRewardsProjection{}},
+	},
+	"GET /network/supply/:epoch": {
+		summary: "returns the supply accounting of the epoch",
+		data:    map[string]interface{}{"supply": epochStart.SupplyAccounting{}},
+	},
+	"GET /network/epoch-economics/:epoch": {
+		summary: "returns the economics of the epoch",
+		data:    map[string]interface{}{"economics": epochStart.EpochEconomics{}},
+	},
+	"GET /network/esdts": {
+		summary:     "returns a page of the issued ESDT tokens",
+		queryParams: []string{"from", "size"},
+		data: map[string]interface{}{
+			"tokens":    external.ESDTTokensList{}.Tokens,
+			"numTokens": uint32(0),
+			"nextFrom":  uint32(0),
+		},
+	},
+	"GET /network/staking-queue": {
+		summary:     "returns a page of the nodes waiting in the staking queue",
+		queryParams: []string{"from", "size"},
+		data: map[string]interface{}{
+			"nodes":    external.StakingQueue{}.Nodes,
+			"numNodes": uint32(0),
+			"nextFrom": uint32(0),
+		},
+	},
+
+	"GET /node/heartbeatstatus": {
+		summary:     "returns the heartbeat status of the known validators and observers, paginated if any query parameter is provided",
+		queryParams: []string{"shard", "identity", "status", "peerType", "from", "size"},
+		data: map[string]interface{}{
+			"heartbeats":    []heartbeatData.PubKeyHeartbeat{},
+			"numHeartbeats": uint32(0),
+			"nextFrom":      uint32(0),
+		},
+	},
+	"GET /node/heartbeatstatus/identities": {
+		summary: "returns the heartbeat status of the known nodes aggregated by identity",
+		data:    map[string]interface{}{"identities": []*heartbeatData.IdentityHeartbeats{}},
+	},
+	"GET /node/heartbeatstatus/managedkeys": {
+		summary: "returns the validator keys managed by each of the known nodes running in multikey mode",
+		data:    map[string]interface{}{"nodes": []heartbeatData.NodeManagedKeys{}},
+	},
+	"GET /node/statistics": {
+		summary: "returns the transactions processing statistics",
+		data:    map[string]interface{}{"statistics": node.StatisticsResponse{}},
+	},
+	"GET /node/status": {
+		summary: "returns the status metrics of the node",
+		data:    map[string]interface{}{"metrics": map[string]interface{}{}},
+	},
+	"GET /node/p2pstatus": {
+		summary: "returns the p2p metrics of the node",
+		data:    map[string]interface{}{"metrics": map[string]interface{}{}},
+	},
+	"GET /node/metrics": {
+		summary:      "returns the status metrics of the node in the prometheus format",
+		rawMediaType: "text/plain",
+	},
+	"POST /node/debug": {
+		summary: "queries a debug handler",
+		request: node.QueryDebugRequest{},
+		data:    map[string]interface{}{"result": []string{}},
+	},
+	"GET /node/peerinfo": {
+		summary:     "returns the p2p info of the peer",
+		queryParams: []string{"pid"},
+		data:        map[string]interface{}{"info": []core.QueryP2PPeerInfo{}},
+	},
+	"GET /node/consensusparticipation": {
+		summary: "returns the consensus participation of the node during the last epochs",
+		data:    map[string]interface{}{"participation": []*consensus.EpochParticipation{}},
+	},
+	"GET /node/crossshardstatistics": {
+		summary: "returns the cross shard routing statistics of each shard pair",
+		data:    map[string]interface{}{"statistics": []node.CrossShardPathStatisticsResponse{}},
+	},
+	"GET /node/apiconsumers": {
+		summary: "returns the metrics of the API consumers",
+		data:    map[string]interface{}{"consumers": []*middleware.ConsumerMetrics{}},
+	},
+	"GET /node/health": {
+		summary: "returns the liveness report of the node, with the 503 status code if any checked subsystem is failing",
+		data:    map[string]interface{}{"status": external.HealthStatusOk, "checks": []*external.HealthCheck{}},
+	},
+	"GET /node/ready": {
+		summary: "returns the readiness report of the node, with the 503 status code if any checked subsystem is failing",
+		data:    map[string]interface{}{"status": external.HealthStatusOk, "checks": []*external.HealthCheck{}},
+	},
+	"GET /node/outport": {
+		summary: "returns the state, the pending items and the last error of each outport driver",
+		data:    map[string]interface{}{"drivers": []indexer.DriverStatus{}},
+	},
+
+	"GET /proof/address/:address": {
+		summary:     "returns the merkle proof of the account",
+		queryParams: []string{"rootHash"},
+		data:        map[string]interface{}{"proof": state.ApiProof{}},
+	},
+	"GET /proof/storage/:address/:key": {
+		summary:     "returns the merkle proof of the key from the account storage",
+		queryParams: []string{"rootHash"},
+		data:        map[string]interface{}{"proof": state.ApiProof{}},
+	},
+	"POST /proof/verify": {
+		summary: "verifies a merkle proof",
+		request: proof.VerifyProofRequest{},
+		data:    map[string]interface{}{"ok": false, "value": ""},
+	},
+
+	"GET /push/ws": {
+		summary:      "upgrades the connection to a websocket streaming the subscribed notifications",
+		queryParams:  []string{"channels", "shard", "address", "identifier"},
+		rawMediaType: "application/octet-stream",
+	},
+
+	"POST /transaction/send": {
+		summary: "sends a signed transaction",
+		request: transaction.SendTxRequest{},
+		data:    map[string]interface{}{"txHash": ""},
+	},
+	"POST /transaction/simulate": {
+		summary: "simulates the execution of a signed transaction",
+		request: transaction.SendTxRequest{},
+		data:    map[string]interface{}{"result": dataTransaction.SimulationResults{}},
+	},
+	"POST /transaction/cost": {
+		summary: "estimates the gas units needed by a transaction",
+		request: transaction.SendTxRequest{},
+		data:    map[string]interface{}{"txGasUnits": uint64(0)},
+	},
+	"POST /transaction/send-multiple": {
+		summary:     "sends a batch of signed transactions",
+		queryParams: []string{"allOrNothing"},
+		request:     []transaction.SendTxRequest{},
+		data: map[string]interface{}{
+			"txsSent":   uint64(0),
+			"txsHashes": map[int]string{},
+			"results":   []transaction.SendMultipleTxResult{},
+		},
+	},
+	"GET /transaction/:txhash": {
+		summary:     "returns the transaction with the provided hash",
+		queryParams: []string{"withResults"},
+		data:        map[string]interface{}{"transaction": dataTransaction.ApiTransactionResult{}},
+	},
+	"GET /transaction/pool": {
+		summary:     "returns the pending transactions from the pool",
+		queryParams: []string{"sender", "receiver", "shard", "summary"},
+		data: map[string]interface{}{
+			"transactions": []*dataTransaction.ApiPoolTransaction{},
+			"senders":      []*dataTransaction.ApiPoolSenderSummary{},
+		},
+	},
+
+	"GET /validator/statistics": {
+		summary:     "returns the statistics of the validators, as a page of the matching validators when any query parameter is provided",
+		queryParams: []string{"shard", "identity", "status", "minRating", "maxRating", "sortBy", "order", "from", "size"},
+		data:        map[string]interface{}{"statistics": map[string]*state.ValidatorApiResponse{}},
+	},
+	"GET /validator/rewards/:epoch": {
+		summary:     "returns the rewards audit of the epoch",
+		queryParams: []string{"shard"},
+		data:        map[string]interface{}{"rewardsAudit": epochStart.RewardsAudit{}},
+	},
+	"GET /validator/rewards/:epoch/proof/:address": {
+		summary: "returns the merkle proof of the rewards earned by the address in the epoch",
+		data:    map[string]interface{}{"rewardsProof": validator.RewardsProofResponse{}},
+	},
+	"GET /validator/assignment/:epoch": {
+		summary:     "returns the validators lists of each shard in the epoch and the consensus group selection inputs",
+		queryParams: []string{"shard"},
+		data:        map[string]interface{}{"assignment": validator.EpochValidatorsAssignmentResponse{}},
+	},
+	"GET /validator/eligibility/:key": {
+		summary: "returns the status of the BLS key and the estimated epoch at which it becomes eligible",
+		data:    map[string]interface{}{"eligibility": validator.ValidatorEligibilityResponse{}},
+	},
+
+	"POST /vm-values/hex": {
+		summary: "executes a smart contract query returning the first value hex encoded",
+		request: vmValues.VMValueRequest{},
+		data:    map[string]interface{}{"data": ""},
+	},
+	"POST /vm-values/string": {
+		summary: "executes a smart contract query returning the first value as string",
+		request: vmValues.VMValueRequest{},
+		data:    map[string]interface{}{"data": ""},
+	},
+	"POST /vm-values/int": {
+		summary: "executes a smart contract query returning the first value as a big integer string",
+		request: vmValues.VMValueRequest{},
+		data:    map[string]interface{}{"data": ""},
+	},
+	"POST /vm-values/query": {
+		summary: "executes a smart contract query returning the whole VM output",
+		request: vmValues.VMValueRequest{},
+		data:    map[string]interface{}{"data": vm.VMOutputApi{}},
+	},
+}
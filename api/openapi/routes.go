@@ -0,0 +1,54 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/gin-gonic/gin"
+)
+
+const getSpecificationPath = ""
+
+// FacadeHandler interface defines methods that can be used by the gin webserver
+type FacadeHandler interface {
+	AppVersion() string
+	IsInterfaceNil() bool
+}
+
+// RoutesProvider returns all the routes registered on the web server
+type RoutesProvider func() gin.RoutesInfo
+
+// Routes defines the OpenAPI specification related routes. The routes provider is called on each request, so the
+// document always describes the routes actually registered on the web server
+func Routes(router *wrapper.RouterWrapper, routesProvider RoutesProvider) {
+	router.RegisterHandler(http.MethodGet, getSpecificationPath, func(c *gin.Context) {
+		GetSpecification(c, routesProvider)
+	})
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	return facade, true
+}
+
+// GetSpecification returns the OpenAPI document of the routes served by the node, versioned by the node version
+func GetSpecification(c *gin.Context, routesProvider RoutesProvider) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, NewSpecification(routesProvider(), facade.AppVersion()))
+}
@@ -0,0 +1,78 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/openapi"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func startNodeServer(handler openapi.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	if handler != nil {
+		ws.Use(middleware.WithFacade(handler))
+	}
+	ws.GET("/node/status", func(c *gin.Context) {})
+	openapiRoutes := ws.Group("/openapi")
+	openapiRoute, _ := wrapper.NewRouterWrapper("openapi", openapiRoutes, getRoutesConfig())
+	openapi.Routes(openapiRoute, ws.Routes)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"openapi": {
+				Routes: []config.RouteConfig{
+					{Name: "", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func TestGetSpecification_ShouldDescribeTheRegisteredRoutes(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	req, _ := http.NewRequest(http.MethodGet, "/openapi", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	spec := openapi.Specification{}
+	err := json.Unmarshal(resp.Body.Bytes(), &spec)
+	require.Nil(t, err)
+	assert.Equal(t, "v1.0.0", spec.Info.Version)
+	_, ok := spec.Paths["/node/status"]["get"]
+	assert.True(t, ok)
+	_, ok = spec.Paths["/openapi"]["get"]
+	assert.True(t, ok)
+}
+
+func TestGetSpecification_WithoutFacadeShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/openapi", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
@@ -0,0 +1,166 @@
+package openapi
+
+import (
+	"math/big"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const componentsSchemasPrefix = "#/components/schemas/"
+
+var (
+	bigIntType = reflect.TypeOf(big.Int{})
+	timeType   = reflect.TypeOf(time.Time{})
+	bytesType  = reflect.TypeOf([]byte{})
+)
+
+// Schema is the OpenAPI schema object describing a JSON value
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+// schemaGenerator builds the schemas of the Go types by reflection, following their JSON encoding. The named structs
+// are added once in the components section and referenced afterwards
+type schemaGenerator struct {
+	components map[string]*Schema
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{
+		components: make(map[string]*Schema),
+	}
+}
+
+func (sg *schemaGenerator) schemaOf(value interface{}) *Schema {
+	if value == nil {
+		return &Schema{}
+	}
+
+	return sg.schemaOfType(reflect.TypeOf(value))
+}
+
+func (sg *schemaGenerator) schemaOfType(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t {
+	case bigIntType:
+		return &Schema{Type: "integer", Format: "big-integer"}
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case bytesType:
+		return &Schema{Type: "string", Format: "byte"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: sg.schemaOfType(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: sg.schemaOfType(t.Elem())}
+	case reflect.Struct:
+		return sg.schemaOfStruct(t)
+	default:
+		return &Schema{}
+	}
+}
+
+func (sg *schemaGenerator) schemaOfStruct(t reflect.Type) *Schema {
+	if t.Name() == "" {
+		return sg.createStructSchema(t)
+	}
+
+	name := componentName(t)
+	_, exists := sg.components[name]
+	if !exists {
+		// the placeholder stops the recursion on self referencing types
+		sg.components[name] = &Schema{}
+		sg.components[name] = sg.createStructSchema(t)
+	}
+
+	return &Schema{Ref: componentsSchemasPrefix + name}
+}
+
+func (sg *schemaGenerator) createStructSchema(t reflect.Type) *Schema {
+	schema := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema),
+	}
+	sg.addStructFields(schema, t)
+
+	return schema
+}
+
+func (sg *schemaGenerator) addStructFields(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldName, isEmbedded, ok := jsonFieldName(field)
+		if !ok {
+			continue
+		}
+
+		if isEmbedded {
+			embeddedType := field.Type
+			for embeddedType.Kind() == reflect.Ptr {
+				embeddedType = embeddedType.Elem()
+			}
+			sg.addStructFields(schema, embeddedType)
+			continue
+		}
+
+		schema.Properties[fieldName] = sg.schemaOfType(field.Type)
+	}
+}
+
+// jsonFieldName returns the name of the field as encoded by the json package and whether the field is an embedded
+// struct whose fields are promoted
+func jsonFieldName(field reflect.StructField) (string, bool, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false, false
+	}
+
+	name := strings.Split(tag, ",")[0]
+	if field.Anonymous && len(name) == 0 {
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Struct {
+			return "", true, true
+		}
+	}
+
+	if len(field.PkgPath) > 0 {
+		return "", false, false
+	}
+	if len(name) == 0 {
+		name = field.Name
+	}
+
+	return name, false, true
+}
+
+func componentName(t reflect.Type) string {
+	return path.Base(t.PkgPath()) + "." + t.Name()
+}
@@ -0,0 +1,229 @@
+package openapi
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	openAPIVersion = "3.0.3"
+	specTitle      = "Elrond node REST API"
+	jsonMediaType  = "application/json"
+)
+
+// Specification is the OpenAPI document describing the routes served by the node
+type Specification struct {
+	OpenAPI    string                          `json:"openapi"`
+	Info       Info                            `json:"info"`
+	Paths      map[string]map[string]Operation `json:"paths"`
+	Components Components                      `json:"components"`
+}
+
+// Info holds the metadata of the API
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// Components holds the reusable schemas referenced from the operations
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// Operation describes a single API operation on a path
+type Operation struct {
+	Tags        []string            `json:"tags"`
+	Summary     string              `json:"summary,omitempty"`
+	OperationID string              `json:"operationId"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+// Parameter describes a path or a query parameter of an operation
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+// RequestBody describes the body of a request
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response describes a response of an operation
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType holds the schema of a request or a response body
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// NewSpecification creates the OpenAPI document of the provided routes. The request and response models of the
+// known endpoints are generated from their Go types, while the unknown endpoints are described by the generic
+// API response
+func NewSpecification(routes gin.RoutesInfo, appVersion string) *Specification {
+	generator := newSchemaGenerator()
+	spec := &Specification{
+		OpenAPI: openAPIVersion,
+		Info: Info{
+			Title:   specTitle,
+			Version: appVersion,
+		},
+		Paths: make(map[string]map[string]Operation),
+	}
+
+	sortedRoutes := make(gin.RoutesInfo, len(routes))
+	copy(sortedRoutes, routes)
+	sort.Slice(sortedRoutes, func(i, j int) bool {
+		if sortedRoutes[i].Path == sortedRoutes[j].Path {
+			return sortedRoutes[i].Method < sortedRoutes[j].Method
+		}
+		return sortedRoutes[i].Path < sortedRoutes[j].Path
+	})
+
+	for _, route := range sortedRoutes {
+		specPath, pathParams := convertPath(route.Path)
+		operations, ok := spec.Paths[specPath]
+		if !ok {
+			operations = make(map[string]Operation)
+			spec.Paths[specPath] = operations
+		}
+
+		operations[strings.ToLower(route.Method)] = createOperation(generator, route, pathParams)
+	}
+
+	spec.Components = Components{
+		Schemas: generator.components,
+	}
+
+	return spec
+}
+
+func createOperation(generator *schemaGenerator, route gin.RouteInfo, pathParams []string) Operation {
+	doc := endpointsDocs[route.Method+" "+route.Path]
+	operation := Operation{
+		Tags:        []string{routeTag(route.Path)},
+		Summary:     doc.summary,
+		OperationID: operationID(route.Method, route.Path),
+		Parameters:  make([]Parameter, 0, len(pathParams)+len(doc.queryParams)),
+		Responses:   make(map[string]Response),
+	}
+
+	for _, name := range pathParams {
+		operation.Parameters = append(operation.Parameters, Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+	for _, name := range doc.queryParams {
+		operation.Parameters = append(operation.Parameters, Parameter{
+			Name:   name,
+			In:     "query",
+			Schema: &Schema{Type: "string"},
+		})
+	}
+
+	if doc.request != nil {
+		operation.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]MediaType{
+				jsonMediaType: {Schema: generator.schemaOf(doc.request)},
+			},
+		}
+	}
+
+	if len(doc.rawMediaType) > 0 {
+		operation.Responses["200"] = Response{
+			Description: "successful operation",
+			Content: map[string]MediaType{
+				doc.rawMediaType: {Schema: &Schema{Type: "string"}},
+			},
+		}
+		return operation
+	}
+
+	operation.Responses["200"] = Response{
+		Description: "successful operation",
+		Content: map[string]MediaType{
+			jsonMediaType: {Schema: genericResponseSchema(generator, doc.data)},
+		},
+	}
+	operation.Responses["default"] = Response{
+		Description: "failed operation, the error and the code fields describe the failure",
+		Content: map[string]MediaType{
+			jsonMediaType: {Schema: genericResponseSchema(generator, nil)},
+		},
+	}
+
+	return operation
+}
+
+// genericResponseSchema describes the shared.GenericAPIResponse envelope, holding the provided data fields
+func genericResponseSchema(generator *schemaGenerator, dataFields map[string]interface{}) *Schema {
+	dataSchema := &Schema{Type: "object"}
+	if len(dataFields) > 0 {
+		dataSchema.Properties = make(map[string]*Schema, len(dataFields))
+		for name, value := range dataFields {
+			dataSchema.Properties[name] = generator.schemaOf(value)
+		}
+	}
+
+	return &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"data":  dataSchema,
+			"error": {Type: "string"},
+			"code": {
+				Type: "string",
+				Description: strings.Join([]string{
+					string(shared.ReturnCodeSuccess),
+					string(shared.ReturnCodeRequestError),
+					string(shared.ReturnCodeInternalError),
+				}, ", "),
+			},
+		},
+	}
+}
+
+// convertPath converts a gin path as /address/:address/key/:key to the OpenAPI format /address/{address}/key/{key},
+// returning also the names of the path parameters
+func convertPath(ginPath string) (string, []string) {
+	segments := strings.Split(ginPath, "/")
+	params := make([]string, 0)
+	for i, segment := range segments {
+		if len(segment) < 2 || (segment[0] != ':' && segment[0] != '*') {
+			continue
+		}
+
+		params = append(params, segment[1:])
+		segments[i] = "{" + segment[1:] + "}"
+	}
+
+	return strings.Join(segments, "/"), params
+}
+
+func routeTag(ginPath string) string {
+	segments := strings.Split(strings.TrimPrefix(ginPath, "/"), "/")
+	if len(segments[0]) == 0 {
+		return "root"
+	}
+
+	return segments[0]
+}
+
+func operationID(method string, ginPath string) string {
+	replacer := strings.NewReplacer("/", "_", ":", "", "*", "", "-", "_")
+	return strings.ToLower(method) + replacer.Replace(ginPath)
+}
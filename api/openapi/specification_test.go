@@ -0,0 +1,57 @@
+package openapi_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/openapi"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSpecification_ShouldDescribeTheKnownEndpoints(t *testing.T) {
+	t.Parallel()
+
+	routes := gin.RoutesInfo{
+		gin.RouteInfo{Method: http.MethodPost, Path: "/transaction/send"},
+		gin.RouteInfo{Method: http.MethodGet, Path: "/address/:address/key/:key"},
+	}
+	spec := openapi.NewSpecification(routes, "v1.1.0")
+
+	assert.Equal(t, "v1.1.0", spec.Info.Version)
+	require.Equal(t, 2, len(spec.Paths))
+
+	getKey := spec.Paths["/address/{address}/key/{key}"]["get"]
+	require.Equal(t, 2, len(getKey.Parameters))
+	assert.Equal(t, "address", getKey.Parameters[0].Name)
+	assert.Equal(t, "key", getKey.Parameters[1].Name)
+	assert.Equal(t, "path", getKey.Parameters[1].In)
+	assert.Equal(t, []string{"address"}, getKey.Tags)
+
+	sendTx := spec.Paths["/transaction/send"]["post"]
+	require.NotNil(t, sendTx.RequestBody)
+	requestSchema := sendTx.RequestBody.Content["application/json"].Schema
+	assert.Equal(t, "#/components/schemas/transaction.SendTxRequest", requestSchema.Ref)
+
+	txSchema, ok := spec.Components.Schemas["transaction.SendTxRequest"]
+	require.True(t, ok)
+	assert.Equal(t, "integer", txSchema.Properties["nonce"].Type)
+	assert.Equal(t, "byte", txSchema.Properties["data"].Format)
+
+	responseSchema := sendTx.Responses["200"].Content["application/json"].Schema
+	assert.Equal(t, "string", responseSchema.Properties["data"].Properties["txHash"].Type)
+}
+
+func TestNewSpecification_UnknownEndpointShouldHaveTheGenericResponse(t *testing.T) {
+	t.Parallel()
+
+	spec := openapi.NewSpecification(gin.RoutesInfo{gin.RouteInfo{Method: http.MethodGet, Path: "/custom/*path"}}, "")
+
+	operation, ok := spec.Paths["/custom/{path}"]["get"]
+	require.True(t, ok)
+	assert.Nil(t, operation.RequestBody)
+	responseSchema := operation.Responses["200"].Content["application/json"].Schema
+	assert.Equal(t, "object", responseSchema.Properties["data"].Type)
+	assert.Equal(t, "string", responseSchema.Properties["code"].Type)
+}
@@ -0,0 +1,146 @@
+package proof
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getProofPath         = "/address/:address"
+	getProofDataTriePath = "/storage/:address/:key"
+	verifyProofPath      = "/verify"
+
+	queryParamRootHash = "rootHash"
+)
+
+// FacadeHandler interface defines methods that can be used by the gin webserver
+type FacadeHandler interface {
+	GetProof(rootHash string, address string) (*state.ApiProof, error)
+	GetProofDataTrie(rootHash string, address string, key string) (*state.ApiProof, error)
+	VerifyProof(rootHash string, key string, proof []string) (bool, string, error)
+	IsInterfaceNil() bool
+}
+
+// VerifyProofRequest represents the structure on which user input for verifying a proof will validate against
+type VerifyProofRequest struct {
+	RootHash string   `json:"rootHash"`
+	Key      string   `json:"key"`
+	Proof    []string `json:"proof"`
+}
+
+// Routes defines merkle proofs related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, getProofPath, GetProof)
+	router.RegisterHandler(http.MethodGet, getProofDataTriePath, GetProofDataTrie)
+	router.RegisterHandler(http.MethodPost, verifyProofPath, VerifyProof)
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	return facade, true
+}
+
+// GetProof returns the merkle proof of the address provided in the path. The proof is computed against the rootHash
+// query parameter or, if missing, against the current accounts trie root hash
+func GetProof(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyAddress.Error()))
+		return
+	}
+
+	proof, err := facade.GetProof(c.Request.URL.Query().Get(queryParamRootHash), address)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"proof": proof}, "", shared.ReturnCodeSuccess)
+}
+
+// GetProofDataTrie returns the merkle proof of the hex encoded key from the data trie of the address provided in the
+// path, together with the proof of the account. The proofs are computed against the rootHash query parameter or, if
+// missing, against the current accounts trie root hash
+func GetProofDataTrie(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyAddress.Error()))
+		return
+	}
+
+	key := c.Param("key")
+	if key == "" {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyKey.Error()))
+		return
+	}
+
+	proof, err := facade.GetProofDataTrie(c.Request.URL.Query().Get(queryParamRootHash), address, key)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"proof": proof}, "", shared.ReturnCodeSuccess)
+}
+
+// VerifyProof checks that the proof provided in the request body proves the key against the given root hash
+func VerifyProof(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	var request = VerifyProofRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil || len(request.RootHash) == 0 || len(request.Key) == 0 || len(request.Proof) == 0 {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: rootHash, key and proof are required", errors.ErrValidation.Error()))
+		return
+	}
+
+	isValid, value, err := facade.VerifyProof(request.RootHash, request.Key, request.Proof)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrVerifyProof.Error(), err.Error()))
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"ok": isValid, "value": value}, "", shared.ReturnCodeSuccess)
+}
@@ -0,0 +1,175 @@
+package proof_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/proof"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+var log = logger.GetOrCreate("api/proof_test")
+
+type proofResponse struct {
+	Data struct {
+		Proof *state.ApiProof `json:"proof"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type verifyProofResponse struct {
+	Data struct {
+		Ok    bool   `json:"ok"`
+		Value string `json:"value"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func startNodeServer(handler proof.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	proofRoutes := ws.Group("/proof")
+	if handler != nil {
+		proofRoutes.Use(middleware.WithFacade(handler))
+	}
+	proofRoute, _ := wrapper.NewRouterWrapper("proof", proofRoutes, getRoutesConfig())
+	proof.Routes(proofRoute)
+	return ws
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	log.LogIfError(err)
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"proof": {
+				Routes: []config.RouteConfig{
+					{Name: "/address/:address", Open: true},
+					{Name: "/storage/:address/:key", Open: true},
+					{Name: "/verify", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func TestGetProof_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedProof := &state.ApiProof{
+		RootHash: "aabb",
+		Address:  "erd1",
+		Key:      "0011",
+		Value:    "2233",
+		Proof:    []string{"4455", "6677"},
+	}
+	facade := mock.Facade{
+		GetProofCalled: func(rootHash string, address string) (*state.ApiProof, error) {
+			assert.Equal(t, "aabb", rootHash)
+			assert.Equal(t, "erd1", address)
+			return expectedProof, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest(http.MethodGet, "/proof/address/erd1?rootHash=aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedProof, response.Data.Proof)
+}
+
+func TestGetProofDataTrie_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetProofDataTrieCalled: func(rootHash string, address string, key string) (*state.ApiProof, error) {
+			assert.Equal(t, "", rootHash)
+			assert.Equal(t, "erd1", address)
+			assert.Equal(t, "0011", key)
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest(http.MethodGet, "/proof/storage/erd1/0011", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestVerifyProof_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		VerifyProofCalled: func(rootHash string, key string, proof []string) (bool, string, error) {
+			assert.Equal(t, "aabb", rootHash)
+			assert.Equal(t, "0011", key)
+			assert.Equal(t, []string{"4455"}, proof)
+			return true, "2233", nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	body := `{"rootHash":"aabb","key":"0011","proof":["4455"]}`
+	req, _ := http.NewRequest(http.MethodPost, "/proof/verify", bytes.NewBufferString(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := verifyProofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, response.Data.Ok)
+	assert.Equal(t, "2233", response.Data.Value)
+}
+
+func TestVerifyProof_MissingProofShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		VerifyProofCalled: func(rootHash string, key string, proof []string) (bool, string, error) {
+			assert.Fail(t, "should have not been called")
+			return false, "", nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest(http.MethodPost, "/proof/verify", bytes.NewBufferString(`{"rootHash":"aabb","key":"0011"}`))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
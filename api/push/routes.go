@@ -0,0 +1,152 @@
+package push
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/push"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	wsPath          = "/ws"
+	channelsParam   = "channels"
+	shardParam      = "shard"
+	addressParam    = "address"
+	identifierParam = "identifier"
+	writeTimeout    = 10 * time.Second
+)
+
+var log = logger.GetOrCreate("api/push")
+
+// FacadeHandler interface defines methods that can be used by the gin webserver
+type FacadeHandler interface {
+	SubscribeToPushNotifications(filter push.Filter) (*push.Subscription, error)
+	UnsubscribeFromPushNotifications(subscription *push.Subscription)
+	IsInterfaceNil() bool
+}
+
+// Routes defines push notifications related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, wsPath, Subscribe)
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	return facade, true
+}
+
+// Subscribe upgrades the connection to a websocket and streams the push notifications matching the filter
+// provided in the query parameters until the client disconnects
+func Subscribe(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	filter, err := parseFilter(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()))
+		return
+	}
+
+	subscription, err := facade.SubscribeToPushNotifications(filter)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrPushSubscription.Error(), err.Error()))
+		return
+	}
+	defer facade.UnsubscribeFromPushNotifications(subscription)
+
+	upgrader := websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool {
+			return true
+		},
+	}
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("push notifications: websocket upgrade failed", "error", err.Error())
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	sendEvents(conn, subscription)
+}
+
+func sendEvents(conn *websocket.Conn, subscription *push.Subscription) {
+	chClientClosed := make(chan struct{})
+	go func() {
+		defer close(chClientClosed)
+		for {
+			_, _, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-chClientClosed:
+			return
+		case event, ok := <-subscription.Events():
+			if !ok {
+				return
+			}
+
+			_ = conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			err := conn.WriteJSON(event)
+			if err != nil {
+				log.Debug("push notifications: websocket write failed", "error", err.Error())
+				return
+			}
+		}
+	}
+}
+
+func parseFilter(c *gin.Context) (push.Filter, error) {
+	filter := push.Filter{
+		Address:    c.Query(addressParam),
+		Identifier: c.Query(identifierParam),
+	}
+
+	for _, channel := range strings.Split(c.Query(channelsParam), ",") {
+		channel = strings.TrimSpace(channel)
+		if len(channel) > 0 {
+			filter.Channels = append(filter.Channels, channel)
+		}
+	}
+
+	shardStr := c.Query(shardParam)
+	if len(shardStr) > 0 {
+		shardID, err := strconv.ParseUint(shardStr, 10, 32)
+		if err != nil {
+			return push.Filter{}, fmt.Errorf("invalid %s parameter: %w", shardParam, err)
+		}
+
+		shard := uint32(shardID)
+		filter.ShardID = &shard
+	}
+
+	return filter, nil
+}
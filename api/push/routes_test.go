@@ -0,0 +1,174 @@
+package push_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	pushApi "github.com/ElrondNetwork/elrond-go/api/push"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/push"
+	coreMock "github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var log = logger.GetOrCreate("api/push_test")
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func startNodeServer(handler pushApi.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ginPushRoute := ws.Group("/push")
+	if handler != nil {
+		ginPushRoute.Use(middleware.WithFacade(handler))
+	}
+	pushRoute, _ := wrapper.NewRouterWrapper("push", ginPushRoute, getRoutesConfig())
+	pushApi.Routes(pushRoute)
+	return ws
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	log.LogIfError(err)
+}
+
+func TestSubscribe_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/push/ws?channels=blocks", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+}
+
+func TestSubscribe_InvalidShardShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		SubscribeToPushNotificationsCalled: func(filter push.Filter) (*push.Subscription, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/push/ws?channels=blocks&shard=abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+}
+
+func TestSubscribe_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	var receivedFilter push.Filter
+	facade := mock.Facade{
+		SubscribeToPushNotificationsCalled: func(filter push.Filter) (*push.Subscription, error) {
+			receivedFilter = filter
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/push/ws?channels=blocks,%20events&shard=2&address=addr&identifier=transfer", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrPushSubscription.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+
+	require.NotNil(t, receivedFilter.ShardID)
+	assert.Equal(t, uint32(2), *receivedFilter.ShardID)
+	assert.Equal(t, []string{push.ChannelBlocks, push.ChannelEvents}, receivedFilter.Channels)
+	assert.Equal(t, "addr", receivedFilter.Address)
+	assert.Equal(t, "transfer", receivedFilter.Identifier)
+}
+
+func TestSubscribe_ShouldStreamEvents(t *testing.T) {
+	t.Parallel()
+
+	pushNotifier, _ := push.NewPushNotifier(push.ArgsPushNotifier{
+		PubkeyConverter:      coreMock.NewPubkeyConverterMock(32),
+		MaxSubscribers:       1,
+		SubscriberBufferSize: 10,
+	})
+	chUnsubscribed := make(chan struct{})
+	facade := mock.Facade{
+		SubscribeToPushNotificationsCalled: pushNotifier.Subscribe,
+		UnsubscribeFromPushNotificationsCalled: func(subscription *push.Subscription) {
+			pushNotifier.Unsubscribe(subscription)
+			close(chUnsubscribed)
+		},
+	}
+	server := httptest.NewServer(startNodeServer(&facade))
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/push/ws?channels=blocks"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.Nil(t, err)
+
+	pushNotifier.SaveBlock(&block.Body{}, &block.Header{Nonce: 37}, nil, nil, nil, []byte("hash"))
+
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second * 5))
+	event := struct {
+		Channel string                 `json:"channel"`
+		Data    push.BlockNotification `json:"data"`
+	}{}
+	err = conn.ReadJSON(&event)
+	require.Nil(t, err)
+	assert.Equal(t, push.ChannelBlocks, event.Channel)
+	assert.Equal(t, uint64(37), event.Data.Nonce)
+
+	_ = conn.Close()
+	select {
+	case <-chUnsubscribed:
+	case <-time.After(time.Second * 5):
+		assert.Fail(t, "subscription was not removed after the client disconnected")
+	}
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"push": {
+				Routes: []config.RouteConfig{
+					{Name: "/ws", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,88 @@
+package api
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+// RoutesConfigUpdater applies a new routes configuration on the routers of the started web server, opening or closing
+// their routes without restarting it
+type RoutesConfigUpdater struct {
+	mutRouters sync.RWMutex
+	routers    []*wrapper.RouterWrapper
+}
+
+// NewRoutesConfigUpdater creates a new instance of a RoutesConfigUpdater
+func NewRoutesConfigUpdater() *RoutesConfigUpdater {
+	return &RoutesConfigUpdater{
+		routers: make([]*wrapper.RouterWrapper, 0),
+	}
+}
+
+func (rcu *RoutesConfigUpdater) addRouter(router *wrapper.RouterWrapper) {
+	rcu.mutRouters.Lock()
+	rcu.routers = append(rcu.routers, router)
+	rcu.mutRouters.Unlock()
+}
+
+// ValidateRoutesConfig checks that the provided routes configuration can be applied on all the routers
+func (rcu *RoutesConfigUpdater) ValidateRoutesConfig(routesConfig config.ApiRoutesConfig) error {
+	if len(routesConfig.APIPackages) == 0 {
+		return ErrNoApiRoutesConfig
+	}
+
+	rcu.mutRouters.RLock()
+	defer rcu.mutRouters.RUnlock()
+
+	for _, router := range rcu.routers {
+		err := router.ValidateRoutesConfig(routesConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateRoutesConfig applies the provided routes configuration on all the routers, after validating it, so either all
+// the routers or none of them use the new configuration
+func (rcu *RoutesConfigUpdater) UpdateRoutesConfig(routesConfig config.ApiRoutesConfig) error {
+	err := rcu.ValidateRoutesConfig(routesConfig)
+	if err != nil {
+		return err
+	}
+
+	rcu.mutRouters.RLock()
+	defer rcu.mutRouters.RUnlock()
+
+	for _, router := range rcu.routers {
+		err = router.SetRoutesConfig(routesConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isRouteActive returns false if the provided route is registered through one of the routers and it is closed
+func (rcu *RoutesConfigUpdater) isRouteActive(method string, fullPath string) bool {
+	rcu.mutRouters.RLock()
+	defer rcu.mutRouters.RUnlock()
+
+	for _, router := range rcu.routers {
+		isActive, isRegistered := router.IsRouteActive(method, fullPath)
+		if isRegistered {
+			return isActive
+		}
+	}
+
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rcu *RoutesConfigUpdater) IsInterfaceNil() bool {
+	return rcu == nil
+}
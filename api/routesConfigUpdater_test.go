@@ -0,0 +1,76 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createRoutesConfig(isStatusOpen bool) config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"node": {
+				Routes: []config.RouteConfig{
+					{Name: "/status", Open: isStatusOpen},
+					{Name: "/metrics", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func doGetRequest(ws *gin.Engine, path string) int {
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp.Code
+}
+
+func TestRoutesConfigUpdater_UpdateRoutesConfig(t *testing.T) {
+	t.Parallel()
+
+	ws := gin.New()
+	router, err := wrapper.NewRouterWrapper("node", ws.Group("/node"), createRoutesConfig(false))
+	require.Nil(t, err)
+	okHandler := func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	}
+	router.RegisterHandler(http.MethodGet, "/status", okHandler)
+	router.RegisterHandler(http.MethodGet, "/metrics", okHandler)
+
+	updater := NewRoutesConfigUpdater()
+	updater.addRouter(router)
+
+	assert.Equal(t, http.StatusNotFound, doGetRequest(ws, "/node/status"))
+	assert.Equal(t, http.StatusOK, doGetRequest(ws, "/node/metrics"))
+	assert.False(t, updater.isRouteActive(http.MethodGet, "/node/status"))
+	assert.True(t, updater.isRouteActive(http.MethodGet, "/node/metrics"))
+	assert.True(t, updater.isRouteActive(http.MethodGet, "/unknown"))
+
+	err = updater.UpdateRoutesConfig(config.ApiRoutesConfig{})
+	assert.Equal(t, ErrNoApiRoutesConfig, err)
+
+	missingPackageConfig := config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{"address": {}},
+	}
+	err = updater.UpdateRoutesConfig(missingPackageConfig)
+	assert.True(t, errors.Is(err, wrapper.ErrConfigNotFound))
+	assert.Equal(t, http.StatusNotFound, doGetRequest(ws, "/node/status"))
+
+	err = updater.UpdateRoutesConfig(createRoutesConfig(true))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, doGetRequest(ws, "/node/status"))
+	assert.True(t, updater.isRouteActive(http.MethodGet, "/node/status"))
+
+	err = updater.UpdateRoutesConfig(createRoutesConfig(false))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, doGetRequest(ws, "/node/status"))
+}
@@ -0,0 +1,136 @@
+package shared
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// V2RoutesPrefix is the prefix of the version 2 API routes. Each version 2 route shares the configuration of the
+// original route having the same path without the prefix
+const V2RoutesPrefix = "/v2"
+
+// V2ResponseKey is the gin context key set by the handlers responding directly with the version 2 envelope, whose
+// responses should not be adapted
+const V2ResponseKey = "v2Response"
+
+// ErrorCode defines the machine-readable codes of the failed version 2 API requests
+type ErrorCode string
+
+const (
+	// ErrorCodeBadRequest defines a request which hasn't been executed due to invalid parameters
+	ErrorCodeBadRequest ErrorCode = "bad_request"
+	// ErrorCodeUnauthorized defines a request which hasn't been executed due to missing or invalid credentials
+	ErrorCodeUnauthorized ErrorCode = "unauthorized"
+	// ErrorCodeForbidden defines a request which hasn't been executed as the client is not allowed to call the route
+	ErrorCodeForbidden ErrorCode = "forbidden"
+	// ErrorCodeNotFound defines a request for an unknown route or resource
+	ErrorCodeNotFound ErrorCode = "not_found"
+	// ErrorCodeTooManyRequests defines a request which hasn't been executed due to rate limiting
+	ErrorCodeTooManyRequests ErrorCode = "too_many_requests"
+	// ErrorCodeInternalError defines a request which hasn't been executed successfully due to an internal error
+	ErrorCodeInternalError ErrorCode = "internal_error"
+	// ErrorCodeServiceUnavailable defines a request which can not be served by the node in its current state
+	ErrorCodeServiceUnavailable ErrorCode = "service_unavailable"
+)
+
+// ResponseV2 defines the structure of all the responses of the version 2 API endpoints. The error is null for the
+// successful requests, while the pagination is provided only by the endpoints returning a page of a list
+type ResponseV2 struct {
+	Data       interface{}   `json:"data"`
+	Error      *ErrorV2      `json:"error"`
+	Pagination *PaginationV2 `json:"pagination,omitempty"`
+}
+
+// ErrorV2 describes why a version 2 API request failed
+type ErrorV2 struct {
+	Code    ErrorCode `json:"code"`
+	Message string    `json:"message"`
+}
+
+// PaginationV2 describes the returned page of a list. All the paginated endpoints accept the from and size query
+// parameters, the nextFrom value being provided back as the from parameter for fetching the next page. NextFrom is
+// omitted on the last page
+type PaginationV2 struct {
+	From     uint32 `json:"from"`
+	Size     uint32 `json:"size"`
+	Total    uint32 `json:"total"`
+	NextFrom uint32 `json:"nextFrom,omitempty"`
+}
+
+// RespondWithV2Data will respond with a successful version 2 API response
+func RespondWithV2Data(c *gin.Context, data interface{}) {
+	respondWithV2(c, http.StatusOK, ResponseV2{Data: data})
+}
+
+// RespondWithV2Page will respond with a successful version 2 API response holding a page of a list
+func RespondWithV2Page(c *gin.Context, data interface{}, pagination PaginationV2) {
+	respondWithV2(c, http.StatusOK, ResponseV2{Data: data, Pagination: &pagination})
+}
+
+// RespondWithV2Error will respond with a failed version 2 API response, the error code being derived from the
+// provided HTTP status
+func RespondWithV2Error(c *gin.Context, status int, message string) {
+	respondWithV2(c, status, ResponseV2{
+		Error: &ErrorV2{
+			Code:    ErrorCodeFromStatus(status),
+			Message: message,
+		},
+	})
+}
+
+func respondWithV2(c *gin.Context, status int, response ResponseV2) {
+	c.Set(V2ResponseKey, true)
+	c.JSON(status, response)
+}
+
+// ErrorCodeFromStatus returns the error code matching the provided HTTP status of a failed request
+func ErrorCodeFromStatus(status int) ErrorCode {
+	switch status {
+	case http.StatusBadRequest:
+		return ErrorCodeBadRequest
+	case http.StatusUnauthorized:
+		return ErrorCodeUnauthorized
+	case http.StatusForbidden:
+		return ErrorCodeForbidden
+	case http.StatusNotFound:
+		return ErrorCodeNotFound
+	case http.StatusTooManyRequests:
+		return ErrorCodeTooManyRequests
+	case http.StatusServiceUnavailable:
+		return ErrorCodeServiceUnavailable
+	}
+
+	if status >= http.StatusBadRequest && status < http.StatusInternalServerError {
+		return ErrorCodeBadRequest
+	}
+
+	return ErrorCodeInternalError
+}
+
+// ErrorCodeFromReturnCode returns the error code matching the return code of a failed original API response
+func ErrorCodeFromReturnCode(code ReturnCode) ErrorCode {
+	switch code {
+	case ReturnCodeRequestError:
+		return ErrorCodeBadRequest
+	case ReturnCodeSystemBusy:
+		return ErrorCodeTooManyRequests
+	default:
+		return ErrorCodeInternalError
+	}
+}
+
+// IsV2Route returns true if the provided path belongs to the version 2 API
+func IsV2Route(path string) bool {
+	return strings.HasPrefix(path, V2RoutesPrefix+"/")
+}
+
+// UnversionedRoute returns the original route of a version 2 API route, the other routes being returned unchanged
+func UnversionedRoute(route string) string {
+	if !IsV2Route(route) {
+		return route
+	}
+
+	return strings.TrimPrefix(route, V2RoutesPrefix)
+}
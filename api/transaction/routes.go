@@ -26,6 +26,18 @@ const (
 	costPath                         = "/cost"
 	sendMultiplePath                 = "/send-multiple"
 	getTransactionPath               = "/:txhash"
+	getTransactionsPoolPath          = "/pool"
+	transactionsPoolSegment          = "pool"
+	queryParamSender                 = "sender"
+	queryParamReceiver               = "receiver"
+	queryParamShard                  = "shard"
+	queryParamSummary                = "summary"
+	queryParamAllOrNothing           = "allOrNothing"
+)
+
+const (
+	txStatusAccepted = "accepted"
+	txStatusRejected = "rejected"
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
@@ -37,6 +49,8 @@ type FacadeHandler interface {
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionsPool(filter transaction.PoolFilter) ([]*transaction.ApiPoolTransaction, error)
+	GetTransactionsPoolSummary(filter transaction.PoolFilter) ([]*transaction.ApiPoolSenderSummary, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
@@ -75,6 +89,14 @@ type SendTxRequest struct {
 	Options          uint32 `json:"options,omitempty"`
 }
 
+// SendMultipleTxResult holds the admission result of a transaction provided in a send-multiple request
+type SendMultipleTxResult struct {
+	Index  int    `json:"index"`
+	Status string `json:"status"`
+	TxHash string `json:"txHash,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
 //TxResponse represents the structure on which the response will be validated against
 type TxResponse struct {
 	SendTxRequest
@@ -106,14 +128,33 @@ func Routes(router *wrapper.RouterWrapper) {
 		middleware.CreateEndpointThrottler(sendMultipleTransactionsEndpoint),
 		SendMultipleTransactions,
 	)
-	router.RegisterHandler(
+
+	// the /pool route can not be registered next to the /:txhash wildcard, so the transactions pool is served by the
+	// get transaction handler, which checks which of the two routes is open when processing the request
+	router.RegisterHandlerWithoutGuard(
 		http.MethodGet,
 		getTransactionPath,
 		middleware.CreateEndpointThrottler(getTransactionEndpoint),
-		GetTransaction,
+		getTransactionOrTransactionsPool(router),
 	)
 }
 
+func getTransactionOrTransactionsPool(router *wrapper.RouterWrapper) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		isPoolRequest := c.Param("txhash") == transactionsPoolSegment
+		if isPoolRequest && router.IsEndpointActive(getTransactionsPoolPath) {
+			GetTransactionsPool(c)
+			return
+		}
+		if !router.IsEndpointActive(getTransactionPath) {
+			wrapper.RespondWithRouteNotFound(c)
+			return
+		}
+
+		GetTransaction(c)
+	}
+}
+
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
 	facadeObj, ok := c.Get("facade")
 	if !ok {
@@ -314,7 +355,9 @@ func SendTransaction(c *gin.Context) {
 	)
 }
 
-// SendMultipleTransactions will receive a number of transactions and will propagate them for processing
+// SendMultipleTransactions will receive a number of transactions and will propagate them for processing. Each
+// transaction is validated independently and its result is returned by index. When the allOrNothing query parameter
+// is set, the transactions are sent only if all of them are valid and nonce-sequenced for each sender
 func SendMultipleTransactions(c *gin.Context) {
 	facade, ok := getFacade(c)
 	if !ok {
@@ -335,40 +378,41 @@ func SendMultipleTransactions(c *gin.Context) {
 		return
 	}
 
-	var (
-		txs    []*transaction.Transaction
-		tx     *transaction.Transaction
-		txHash []byte
-	)
-
-	txsHashes := make(map[int]string)
-	for idx, receivedTx := range gtx {
-		tx, txHash, err = facade.CreateTransaction(
-			receivedTx.Nonce,
-			receivedTx.Value,
-			receivedTx.Receiver,
-			receivedTx.ReceiverUsername,
-			receivedTx.Sender,
-			receivedTx.SenderUsername,
-			receivedTx.GasPrice,
-			receivedTx.GasLimit,
-			receivedTx.Data,
-			receivedTx.Signature,
-			receivedTx.ChainID,
-			receivedTx.Version,
-			receivedTx.Options,
+	allOrNothing, err := getQueryParamAllOrNothing(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
 		)
-		if err != nil {
-			continue
-		}
+		return
+	}
 
-		err = facade.ValidateTransaction(tx)
-		if err != nil {
-			continue
+	txs, results := validateMultipleTransactions(facade, gtx, allOrNothing)
+	txsHashes := make(map[int]string)
+	for _, result := range results {
+		if result.Status == txStatusAccepted {
+			txsHashes[result.Index] = result.TxHash
 		}
+	}
 
-		txs = append(txs, tx)
-		txsHashes[idx] = hex.EncodeToString(txHash)
+	if allOrNothing && len(txs) != len(gtx) {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data: gin.H{
+					"txsSent":   0,
+					"txsHashes": map[int]string{},
+					"results":   results,
+				},
+				Error: errors.ErrBatchRejected.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
 	}
 
 	numOfSentTxs, err := facade.SendBulkTransactions(txs)
@@ -376,7 +420,9 @@ func SendMultipleTransactions(c *gin.Context) {
 		c.JSON(
 			http.StatusInternalServerError,
 			shared.GenericAPIResponse{
-				Data:  nil,
+				Data: gin.H{
+					"results": results,
+				},
 				Error: err.Error(),
 				Code:  shared.ReturnCodeInternalError,
 			},
@@ -390,6 +436,7 @@ func SendMultipleTransactions(c *gin.Context) {
 			Data: gin.H{
 				"txsSent":   numOfSentTxs,
 				"txsHashes": txsHashes,
+				"results":   results,
 			},
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
@@ -397,6 +444,93 @@ func SendMultipleTransactions(c *gin.Context) {
 	)
 }
 
+// validateMultipleTransactions creates and validates each of the received transactions independently and returns
+// the accepted ones together with the result of each index. In all-or-nothing mode, the transactions of each sender
+// should also have consecutive nonces and a single rejection makes the whole batch rejected
+func validateMultipleTransactions(
+	facade FacadeHandler,
+	receivedTxs []SendTxRequest,
+	allOrNothing bool,
+) ([]*transaction.Transaction, []*SendMultipleTxResult) {
+	txs := make([]*transaction.Transaction, 0, len(receivedTxs))
+	results := make([]*SendMultipleTxResult, 0, len(receivedTxs))
+	seenHashes := make(map[string]struct{})
+	lastNonces := make(map[string]uint64)
+	for idx, receivedTx := range receivedTxs {
+		tx, txHash, err := createAndValidateTransaction(facade, receivedTx)
+		if err == nil {
+			_, isDuplicated := seenHashes[string(txHash)]
+			if isDuplicated {
+				err = errors.ErrDuplicatedTransaction
+			}
+		}
+		if err == nil && allOrNothing {
+			lastNonce, found := lastNonces[string(tx.SndAddr)]
+			if found && tx.Nonce != lastNonce+1 {
+				err = fmt.Errorf("%w: expected nonce %d, got %d", errors.ErrTxNonceNotSequenced, lastNonce+1, tx.Nonce)
+			}
+		}
+		if err != nil {
+			results = append(results, &SendMultipleTxResult{
+				Index:  idx,
+				Status: txStatusRejected,
+				Error:  err.Error(),
+			})
+			continue
+		}
+
+		seenHashes[string(txHash)] = struct{}{}
+		lastNonces[string(tx.SndAddr)] = tx.Nonce
+		txs = append(txs, tx)
+		results = append(results, &SendMultipleTxResult{
+			Index:  idx,
+			Status: txStatusAccepted,
+			TxHash: hex.EncodeToString(txHash),
+		})
+	}
+
+	if !allOrNothing || len(txs) == len(receivedTxs) {
+		return txs, results
+	}
+
+	for _, result := range results {
+		if result.Status == txStatusAccepted {
+			result.Status = txStatusRejected
+			result.Error = errors.ErrBatchRejected.Error()
+		}
+	}
+
+	return nil, results
+}
+
+func createAndValidateTransaction(facade FacadeHandler, receivedTx SendTxRequest) (*transaction.Transaction, []byte, error) {
+	tx, txHash, err := facade.CreateTransaction(
+		receivedTx.Nonce,
+		receivedTx.Value,
+		receivedTx.Receiver,
+		receivedTx.ReceiverUsername,
+		receivedTx.Sender,
+		receivedTx.SenderUsername,
+		receivedTx.GasPrice,
+		receivedTx.GasLimit,
+		receivedTx.Data,
+		receivedTx.Signature,
+		receivedTx.ChainID,
+		receivedTx.Version,
+		receivedTx.Options,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = facade.ValidateTransaction(tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tx, txHash, nil
+}
+
 // GetTransaction returns transaction details for a given txhash
 func GetTransaction(c *gin.Context) {
 	facade, ok := getFacade(c)
@@ -453,6 +587,104 @@ func GetTransaction(c *gin.Context) {
 	)
 }
 
+// GetTransactionsPool returns the pending transactions of the pool, optionally filtered by sender, receiver and
+// sender shard. When the summary query parameter is set, only the counts and the nonce range of each sender are returned
+func GetTransactionsPool(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	filter, isSummary, err := getTransactionsPoolQueryParams(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	var response gin.H
+	if isSummary {
+		summary, errGet := facade.GetTransactionsPoolSummary(filter)
+		err = errGet
+		response = gin.H{"senders": summary}
+	} else {
+		txs, errGet := facade.GetTransactionsPool(filter)
+		err = errGet
+		response = gin.H{"transactions": txs}
+	}
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  response,
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func getQueryParamAllOrNothing(c *gin.Context) (bool, error) {
+	allOrNothingStr := c.Request.URL.Query().Get(queryParamAllOrNothing)
+	if allOrNothingStr == "" {
+		return false, nil
+	}
+
+	allOrNothing, err := strconv.ParseBool(allOrNothingStr)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s parameter: %w", queryParamAllOrNothing, err)
+	}
+
+	return allOrNothing, nil
+}
+
+func getTransactionsPoolQueryParams(c *gin.Context) (transaction.PoolFilter, bool, error) {
+	query := c.Request.URL.Query()
+	filter := transaction.PoolFilter{
+		Sender:   query.Get(queryParamSender),
+		Receiver: query.Get(queryParamReceiver),
+	}
+
+	shardStr := query.Get(queryParamShard)
+	if shardStr != "" {
+		shardID, err := strconv.ParseUint(shardStr, 10, 32)
+		if err != nil {
+			return transaction.PoolFilter{}, false, fmt.Errorf("invalid %s parameter: %w", queryParamShard, err)
+		}
+
+		shard := uint32(shardID)
+		filter.ShardID = &shard
+	}
+
+	isSummary := false
+	summaryStr := query.Get(queryParamSummary)
+	if summaryStr != "" {
+		var err error
+		isSummary, err = strconv.ParseBool(summaryStr)
+		if err != nil {
+			return transaction.PoolFilter{}, false, fmt.Errorf("invalid %s parameter: %w", queryParamSummary, err)
+		}
+	}
+
+	return filter, isSummary, nil
+}
+
 // ComputeTransactionGasLimit returns how many gas units a transaction wil consume
 func ComputeTransactionGasLimit(c *gin.Context) {
 	facade, ok := getFacade(c)
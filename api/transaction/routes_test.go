@@ -25,6 +25,7 @@ import (
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type transactionResponseData struct {
@@ -38,8 +39,9 @@ type transactionResponse struct {
 }
 
 type sendMultipleTxsResponseData struct {
-	TxsSent   int      `json:"txsSent"`
-	TxsHashes []string `json:"txsHashes"`
+	TxsSent   int                                 `json:"txsSent"`
+	TxsHashes map[int]string                      `json:"txsHashes"`
+	Results   []*transaction.SendMultipleTxResult `json:"results"`
 }
 
 type sendMultipleTxsResponse struct {
@@ -64,6 +66,17 @@ type sendSingleTxResponse struct {
 	Code  string                   `json:"code"`
 }
 
+type transactionsPoolResponseData struct {
+	Transactions []*tr.ApiPoolTransaction   `json:"transactions"`
+	Senders      []*tr.ApiPoolSenderSummary `json:"senders"`
+}
+
+type transactionsPoolResponse struct {
+	Data  transactionsPoolResponseData `json:"data"`
+	Error string                       `json:"error"`
+	Code  string                       `json:"code"`
+}
+
 type transactionCostResponseData struct {
 	Cost uint64 `json:"txGasUnits"`
 }
@@ -197,6 +210,134 @@ func TestGetTransaction_ErrorWithExceededNumGoRoutines(t *testing.T) {
 	assert.Empty(t, txResp.Data)
 }
 
+func TestGetTransactionsPool_ShouldReturnTransactions(t *testing.T) {
+	t.Parallel()
+
+	var receivedFilter tr.PoolFilter
+	facade := mock.Facade{
+		GetTransactionsPoolCalled: func(filter tr.PoolFilter) ([]*tr.ApiPoolTransaction, error) {
+			receivedFilter = filter
+			return []*tr.ApiPoolTransaction{{Hash: "aa", Nonce: 5, NonceGap: true}}, nil
+		},
+		GetTransactionHandler: func(hash string, withResults bool) (*tr.ApiTransactionResult, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?sender=alice&receiver=bob&shard=1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsPoolResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "alice", receivedFilter.Sender)
+	assert.Equal(t, "bob", receivedFilter.Receiver)
+	assert.Equal(t, uint32(1), *receivedFilter.ShardID)
+	assert.Equal(t, []*tr.ApiPoolTransaction{{Hash: "aa", Nonce: 5, NonceGap: true}}, response.Data.Transactions)
+}
+
+func TestGetTransactionsPool_SummaryShouldReturnSenders(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetTransactionsPoolSummaryCalled: func(filter tr.PoolFilter) ([]*tr.ApiPoolSenderSummary, error) {
+			assert.Nil(t, filter.ShardID)
+			return []*tr.ApiPoolSenderSummary{{Sender: "alice", NumTxs: 2, HasNonceGap: true}}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?summary=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsPoolResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []*tr.ApiPoolSenderSummary{{Sender: "alice", NumTxs: 2, HasNonceGap: true}}, response.Data.Senders)
+}
+
+func TestGetTransactionsPool_InvalidParamsShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	for _, query := range []string{"shard=abc", "summary=maybe"} {
+		req, _ := http.NewRequest("GET", "/transaction/pool?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionsPoolResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	}
+}
+
+func TestGetTransactionsPool_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionsPoolCalled: func(filter tr.PoolFilter) ([]*tr.ApiPoolTransaction, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction/pool", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsPoolResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsPool.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetTransactionsPool_WithGetTransactionRouteClosedShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetTransactionsPoolCalled: func(filter tr.PoolFilter) ([]*tr.ApiPoolTransaction, error) {
+			return make([]*tr.ApiPoolTransaction, 0), nil
+		},
+	}
+	ws := gin.New()
+	ginTransactionRoute := ws.Group("/transaction")
+	ginTransactionRoute.Use(middleware.WithFacade(&facade))
+	routesConfig := config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"transaction": {
+				[]config.RouteConfig{
+					{Name: "/:txhash", Open: false},
+					{Name: "/pool", Open: true},
+				},
+			},
+		},
+	}
+	transactionRoute, _ := wrapper.NewRouterWrapper("transaction", ginTransactionRoute, routesConfig)
+	transaction.Routes(transactionRoute)
+
+	req, _ := http.NewRequest("GET", "/transaction/pool", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	req, _ = http.NewRequest("GET", "/transaction/aabb", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
+
 func TestSendTransaction_NilContextShouldError(t *testing.T) {
 	t.Parallel()
 	ws := startNodeServer(nil)
@@ -503,6 +644,118 @@ func TestSendMultipleTransactions_OkPayloadShouldWork(t *testing.T) {
 	assert.True(t, sendBulkTxsWasCalled)
 }
 
+func createSendMultipleFacade(sentTxs *[]*tr.Transaction) *mock.Facade {
+	return &mock.Facade{
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*tr.Transaction, []byte, error) {
+			if sender == "invalid" {
+				return nil, nil, errors.New("invalid sender")
+			}
+			tx := &tr.Transaction{Nonce: nonce, SndAddr: []byte(sender), Value: big.NewInt(0)}
+			return tx, []byte(fmt.Sprintf("%s-%d-%s", sender, nonce, value)), nil
+		},
+		ValidateTransactionHandler: func(tx *tr.Transaction) error {
+			if tx.Nonce > 100 {
+				return errors.New("nonce too high")
+			}
+			return nil
+		},
+		SendBulkTransactionsHandler: func(txs []*tr.Transaction) (uint64, error) {
+			*sentTxs = append(*sentTxs, txs...)
+			return uint64(len(txs)), nil
+		},
+	}
+}
+
+func sendMultipleTransactions(t *testing.T, facade *mock.Facade, url string, txs []*transaction.SendTxRequest) (int, sendMultipleTxsResponse) {
+	ws := startNodeServer(facade)
+
+	jsonBytes, _ := json.Marshal(txs)
+	req, _ := http.NewRequest("POST", url, bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	txsResp := sendMultipleTxsResponse{}
+	loadResponse(resp.Body, &txsResp)
+	require.Equal(t, len(txs), len(txsResp.Data.Results))
+
+	return resp.Code, txsResp
+}
+
+func TestSendMultipleTransactions_ShouldReturnPerTransactionResults(t *testing.T) {
+	t.Parallel()
+
+	sentTxs := make([]*tr.Transaction, 0)
+	facade := createSendMultipleFacade(&sentTxs)
+	txs := []*transaction.SendTxRequest{
+		{Sender: "alice", Nonce: 1, Value: "1"},
+		{Sender: "invalid", Nonce: 1, Value: "1"},
+		{Sender: "alice", Nonce: 101, Value: "1"},
+		{Sender: "alice", Nonce: 1, Value: "1"},
+		{Sender: "alice", Nonce: 3, Value: "1"},
+	}
+
+	code, txsResp := sendMultipleTransactions(t, facade, "/transaction/send-multiple", txs)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, 2, txsResp.Data.TxsSent)
+	assert.Equal(t, 2, len(sentTxs))
+
+	results := txsResp.Data.Results
+	assert.Equal(t, "accepted", results[0].Status)
+	assert.Equal(t, hex.EncodeToString([]byte("alice-1-1")), results[0].TxHash)
+	assert.Equal(t, "rejected", results[1].Status)
+	assert.Equal(t, "invalid sender", results[1].Error)
+	assert.Equal(t, "nonce too high", results[2].Error)
+	assert.Equal(t, apiErrors.ErrDuplicatedTransaction.Error(), results[3].Error)
+	assert.Equal(t, "accepted", results[4].Status)
+	assert.Equal(t, map[int]string{0: results[0].TxHash, 4: results[4].TxHash}, txsResp.Data.TxsHashes)
+}
+
+func TestSendMultipleTransactions_AllOrNothingShouldRejectTheWholeBatch(t *testing.T) {
+	t.Parallel()
+
+	sentTxs := make([]*tr.Transaction, 0)
+	facade := createSendMultipleFacade(&sentTxs)
+	txs := []*transaction.SendTxRequest{
+		{Sender: "alice", Nonce: 1, Value: "1"},
+		{Sender: "bob", Nonce: 7, Value: "1"},
+		{Sender: "alice", Nonce: 3, Value: "1"},
+	}
+
+	code, txsResp := sendMultipleTransactions(t, facade, "/transaction/send-multiple?allOrNothing=true", txs)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, apiErrors.ErrBatchRejected.Error(), txsResp.Error)
+	assert.Equal(t, 0, txsResp.Data.TxsSent)
+	assert.Equal(t, 0, len(sentTxs))
+
+	results := txsResp.Data.Results
+	assert.Equal(t, apiErrors.ErrBatchRejected.Error(), results[0].Error)
+	assert.Equal(t, apiErrors.ErrBatchRejected.Error(), results[1].Error)
+	assert.Contains(t, results[2].Error, apiErrors.ErrTxNonceNotSequenced.Error())
+	for _, result := range results {
+		assert.Equal(t, "rejected", result.Status)
+	}
+}
+
+func TestSendMultipleTransactions_AllOrNothingValidBatchShouldSendAll(t *testing.T) {
+	t.Parallel()
+
+	sentTxs := make([]*tr.Transaction, 0)
+	facade := createSendMultipleFacade(&sentTxs)
+	txs := []*transaction.SendTxRequest{
+		{Sender: "alice", Nonce: 1, Value: "1"},
+		{Sender: "bob", Nonce: 7, Value: "1"},
+		{Sender: "alice", Nonce: 2, Value: "1"},
+	}
+
+	code, txsResp := sendMultipleTransactions(t, facade, "/transaction/send-multiple?allOrNothing=true", txs)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, 3, txsResp.Data.TxsSent)
+	assert.Equal(t, 3, len(sentTxs))
+	for _, result := range txsResp.Data.Results {
+		assert.Equal(t, "accepted", result.Status)
+	}
+}
+
 func TestComputeTransactionGasLimit_NilContextShouldError(t *testing.T) {
 	t.Parallel()
 	ws := startNodeServer(nil)
@@ -815,6 +1068,7 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/cost", Open: true},
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
+					{Name: "/pool", Open: true},
 					{Name: "/simulate", Open: true},
 				},
 			},
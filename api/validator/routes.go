@@ -1,26 +1,133 @@
 package validator
 
 import (
+	"encoding/hex"
+	"fmt"
 	"net/http"
+	"sort"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/gin-gonic/gin"
 )
 
-const statisticsPath = "/statistics"
+const (
+	statisticsPath   = "/statistics"
+	rewardsAuditPath = "/rewards/:epoch"
+	rewardsProofPath = "/rewards/:epoch/proof/:address"
+	assignmentPath   = "/assignment/:epoch"
+	eligibilityPath  = "/eligibility/:key"
+)
+
+const (
+	queryParamShard     = "shard"
+	queryParamIdentity  = "identity"
+	queryParamStatus    = "status"
+	queryParamMinRating = "minRating"
+	queryParamMaxRating = "maxRating"
+	queryParamSortBy    = "sortBy"
+	queryParamOrder     = "order"
+	queryParamFrom      = "from"
+	queryParamSize      = "size"
+	orderAscending      = "asc"
+	orderDescending     = "desc"
+
+	defaultStatisticsPageSize = 100
+	maxStatisticsPageSize     = 1000
+)
+
+var statisticsQueryParams = []string{
+	queryParamShard,
+	queryParamIdentity,
+	queryParamStatus,
+	queryParamMinRating,
+	queryParamMaxRating,
+	queryParamSortBy,
+	queryParamOrder,
+	queryParamFrom,
+	queryParamSize,
+}
+
+// RewardsProofResponse holds the merkle proof of the rewards earned by an address in an epoch, with the hashes hex
+// encoded
+type RewardsProofResponse struct {
+	Epoch     uint32   `json:"epoch"`
+	RootHash  string   `json:"rootHash"`
+	Address   string   `json:"address"`
+	Value     string   `json:"value"`
+	LeafIndex uint32   `json:"leafIndex"`
+	NumLeaves uint32   `json:"numLeaves"`
+	Siblings  []string `json:"siblings"`
+}
+
+// EpochValidatorsAssignmentResponse holds the validators assignment of an epoch and the inputs of the consensus group
+// selection, with the public keys and the randomness hex encoded
+type EpochValidatorsAssignmentResponse struct {
+	Epoch                   uint32                               `json:"epoch"`
+	NumShards               uint32                               `json:"numShards"`
+	ShardConsensusGroupSize uint32                               `json:"shardConsensusGroupSize"`
+	MetaConsensusGroupSize  uint32                               `json:"metaConsensusGroupSize"`
+	Randomness              string                               `json:"randomness"`
+	Shards                  []*ShardValidatorsAssignmentResponse `json:"shards"`
+}
+
+// ShardValidatorsAssignmentResponse holds the validators lists of a shard, in the order used by the nodes coordinator
+type ShardValidatorsAssignmentResponse struct {
+	ShardID  uint32                       `json:"shardID"`
+	Eligible []*AssignedValidatorResponse `json:"eligible"`
+	Waiting  []*AssignedValidatorResponse `json:"waiting"`
+	Leaving  []*AssignedValidatorResponse `json:"leaving"`
+}
+
+// AssignedValidatorResponse holds a validator of an epoch assignment. The selection weight is only set for the
+// eligible validators
+type AssignedValidatorResponse struct {
+	PublicKey       string `json:"publicKey"`
+	Chances         uint32 `json:"chances"`
+	Index           uint32 `json:"index"`
+	SelectionWeight uint32 `json:"selectionWeight,omitempty"`
+}
+
+// ValidatorEligibilityResponse holds the status of a validator in the current epoch and, when it can be computed, the
+// estimated epoch at which the validator becomes eligible. The shard is set for the validators found in the nodes lists
+// and the position is the index in the list of the shard or the position in the staking queue
+type ValidatorEligibilityResponse struct {
+	PublicKey              string  `json:"publicKey"`
+	Epoch                  uint32  `json:"epoch"`
+	Status                 string  `json:"status"`
+	ShardID                *uint32 `json:"shardID,omitempty"`
+	Position               *uint32 `json:"position,omitempty"`
+	EstimatedEligibleEpoch *uint32 `json:"estimatedEligibleEpoch,omitempty"`
+}
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	GetValidatorStatisticsPage(query state.ValidatorStatisticsQuery) (*state.ApiValidatorStatisticsPage, error)
+	GetRewardsAudit(epoch uint32) (*epochStart.RewardsAudit, error)
+	GetRewardsProof(epoch uint32, address string) (*epochStart.RewardsProof, error)
+	GetEpochValidatorsAssignment(epoch uint32) (*sharding.EpochValidatorsAssignment, error)
+	GetValidatorEligibilityEstimate(blsKey string) (*sharding.ValidatorEligibilityEstimate, error)
 	IsInterfaceNil() bool
 }
 
 // Routes defines validators' related routes
 func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, statisticsPath, Statistics)
+	router.RegisterHandler(http.MethodGet, rewardsAuditPath, RewardsAudit)
+	router.RegisterHandler(http.MethodGet, rewardsProofPath, RewardsProof)
+	router.RegisterHandler(http.MethodGet, assignmentPath, EpochAssignment)
+	router.RegisterHandler(http.MethodGet, eligibilityPath, Eligibility)
+}
+
+// RoutesV2 defines the validators' related routes changed in the version 2 API
+func RoutesV2(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, statisticsPath, StatisticsV2)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -53,13 +160,19 @@ func getFacade(c *gin.Context) (FacadeHandler, bool) {
 	return facade, true
 }
 
-// Statistics will return the validation statistics for all validators
+// Statistics will return the validation statistics for all validators. When any of the filtering, sorting or
+// pagination query parameters is provided, a page of the matching validators is returned as a list instead
 func Statistics(c *gin.Context) {
 	facade, ok := getFacade(c)
 	if !ok {
 		return
 	}
 
+	if hasStatisticsQueryParams(c) {
+		statisticsPage(c, facade)
+		return
+	}
+
 	valStats, err := facade.ValidatorStatisticsApi()
 	if err != nil {
 		c.JSON(
@@ -82,3 +195,401 @@ func Statistics(c *gin.Context) {
 		},
 	)
 }
+
+func statisticsPage(c *gin.Context, facade FacadeHandler) {
+	query, err := getValidatorStatisticsQuery(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()))
+		return
+	}
+
+	page, err := facade.GetValidatorStatisticsPage(query)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), shared.ReturnCodeRequestError)
+		return
+	}
+
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{
+			"statistics":    page.Statistics,
+			"numValidators": page.NumValidators,
+			"nextFrom":      page.NextFrom,
+		},
+		"",
+		shared.ReturnCodeSuccess,
+	)
+}
+
+// StatisticsV2 will return a page of the validators matching the filtering query parameters, in the requested order.
+// Unlike the original endpoint, the response is always paginated and the list is returned as the validators field
+func StatisticsV2(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	query, err := getValidatorStatisticsQuery(c)
+	if err != nil {
+		shared.RespondWithV2Error(c, http.StatusBadRequest, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()))
+		return
+	}
+
+	page, err := facade.GetValidatorStatisticsPage(query)
+	if err != nil {
+		shared.RespondWithV2Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	shared.RespondWithV2Page(
+		c,
+		gin.H{"validators": page.Statistics},
+		shared.PaginationV2{
+			From:     query.From,
+			Size:     query.Size,
+			Total:    page.NumValidators,
+			NextFrom: page.NextFrom,
+		},
+	)
+}
+
+func hasStatisticsQueryParams(c *gin.Context) bool {
+	query := c.Request.URL.Query()
+	for _, param := range statisticsQueryParams {
+		_, exists := query[param]
+		if exists {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getValidatorStatisticsQuery(c *gin.Context) (state.ValidatorStatisticsQuery, error) {
+	query := c.Request.URL.Query()
+	result := state.ValidatorStatisticsQuery{
+		Identity: query.Get(queryParamIdentity),
+		Status:   query.Get(queryParamStatus),
+		SortBy:   query.Get(queryParamSortBy),
+		Size:     defaultStatisticsPageSize,
+	}
+
+	shardID, hasShardFilter, err := getQueryParamShard(c)
+	if err != nil {
+		return result, errors.ErrInvalidShardID
+	}
+	if hasShardFilter {
+		result.ShardID = &shardID
+	}
+
+	result.MinRating, err = parseRatingQueryParam(query.Get(queryParamMinRating), queryParamMinRating)
+	if err != nil {
+		return result, err
+	}
+	result.MaxRating, err = parseRatingQueryParam(query.Get(queryParamMaxRating), queryParamMaxRating)
+	if err != nil {
+		return result, err
+	}
+
+	switch query.Get(queryParamOrder) {
+	case "", orderAscending:
+	case orderDescending:
+		result.SortDescending = true
+	default:
+		return result, fmt.Errorf("invalid %s parameter: should be %s or %s", queryParamOrder, orderAscending, orderDescending)
+	}
+
+	fromStr := query.Get(queryParamFrom)
+	if fromStr != "" {
+		from, errParse := strconv.ParseUint(fromStr, 10, 32)
+		if errParse != nil {
+			return result, fmt.Errorf("invalid %s parameter: %w", queryParamFrom, errParse)
+		}
+		result.From = uint32(from)
+	}
+
+	sizeStr := query.Get(queryParamSize)
+	if sizeStr != "" {
+		size, errParse := strconv.ParseUint(sizeStr, 10, 32)
+		if errParse != nil {
+			return result, fmt.Errorf("invalid %s parameter: %w", queryParamSize, errParse)
+		}
+		if size == 0 || size > maxStatisticsPageSize {
+			return result, fmt.Errorf("invalid %s parameter: should be between 1 and %d", queryParamSize, maxStatisticsPageSize)
+		}
+		result.Size = uint32(size)
+	}
+
+	return result, nil
+}
+
+func parseRatingQueryParam(value string, name string) (*float32, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	rating, err := strconv.ParseFloat(value, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s parameter: %w", name, err)
+	}
+	result := float32(rating)
+
+	return &result, nil
+}
+
+// RewardsAudit will return the rewards audit record of the provided epoch. The optional shard query parameter
+// restricts the returned records to the validators of that shard
+func RewardsAudit(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	epoch, err := strconv.ParseUint(c.Param("epoch"), 10, 32)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidEpoch.Error()),
+		)
+		return
+	}
+
+	shardID, hasShardFilter, err := getQueryParamShard(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidShardID.Error()),
+		)
+		return
+	}
+
+	rewardsAudit, err := facade.GetRewardsAudit(uint32(epoch))
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetRewardsAudit.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	if hasShardFilter {
+		rewardsAudit = filterRewardsAuditByShard(rewardsAudit, shardID)
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"rewardsAudit": rewardsAudit}, "", shared.ReturnCodeSuccess)
+}
+
+// RewardsProof will return the merkle proof of the rewards earned by the provided address in the provided epoch, which
+// can be verified against the rewards root hash committed in the start of epoch meta block
+func RewardsProof(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	epoch, err := strconv.ParseUint(c.Param("epoch"), 10, 32)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidEpoch.Error()),
+		)
+		return
+	}
+
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrEmptyAddress.Error()),
+		)
+		return
+	}
+
+	rewardsProof, err := facade.GetRewardsProof(uint32(epoch), address)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetRewardsProof.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	response := &RewardsProofResponse{
+		Epoch:     rewardsProof.Epoch,
+		RootHash:  hex.EncodeToString(rewardsProof.RootHash),
+		Address:   address,
+		Value:     rewardsProof.Value.String(),
+		LeafIndex: rewardsProof.LeafIndex,
+		NumLeaves: rewardsProof.NumLeaves,
+		Siblings:  make([]string, 0, len(rewardsProof.Siblings)),
+	}
+	for _, sibling := range rewardsProof.Siblings {
+		response.Siblings = append(response.Siblings, hex.EncodeToString(sibling))
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"rewardsProof": response}, "", shared.ReturnCodeSuccess)
+}
+
+// EpochAssignment will return the eligible, waiting and leaving validators of each shard in the provided epoch, together
+// with the inputs needed for recomputing the consensus groups selection. The optional shard query parameter restricts
+// the returned lists to that shard
+func EpochAssignment(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	epoch, err := strconv.ParseUint(c.Param("epoch"), 10, 32)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidEpoch.Error()),
+		)
+		return
+	}
+
+	shardID, hasShardFilter, err := getQueryParamShard(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidShardID.Error()),
+		)
+		return
+	}
+
+	assignment, err := facade.GetEpochValidatorsAssignment(uint32(epoch))
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetEpochAssignment.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	response := &EpochValidatorsAssignmentResponse{
+		Epoch:                   assignment.Epoch,
+		NumShards:               assignment.NumShards,
+		ShardConsensusGroupSize: assignment.ShardConsensusGroupSize,
+		MetaConsensusGroupSize:  assignment.MetaConsensusGroupSize,
+		Randomness:              hex.EncodeToString(assignment.Randomness),
+		Shards:                  make([]*ShardValidatorsAssignmentResponse, 0, len(assignment.Eligible)),
+	}
+	for _, shard := range sortedAssignmentShards(assignment) {
+		if hasShardFilter && shard != shardID {
+			continue
+		}
+
+		response.Shards = append(response.Shards, &ShardValidatorsAssignmentResponse{
+			ShardID:  shard,
+			Eligible: toAssignedValidators(assignment.Eligible[shard], assignment.SelectionWeights[shard]),
+			Waiting:  toAssignedValidators(assignment.Waiting[shard], nil),
+			Leaving:  toAssignedValidators(assignment.Leaving[shard], nil),
+		})
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"assignment": response}, "", shared.ReturnCodeSuccess)
+}
+
+// Eligibility will return the status of the provided BLS key in the current epoch, eligible, waiting, leaving, queued
+// or inactive, together with the estimated epoch at which it becomes eligible based on the current churn parameters
+func Eligibility(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	blsKey := c.Param("key")
+	if blsKey == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrEmptyKey.Error()),
+		)
+		return
+	}
+
+	estimate, err := facade.GetValidatorEligibilityEstimate(blsKey)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetValidatorEligibility.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	response := &ValidatorEligibilityResponse{
+		PublicKey: blsKey,
+		Epoch:     estimate.Epoch,
+		Status:    estimate.Status,
+	}
+	switch estimate.Status {
+	case sharding.ValidatorStatusEligible, sharding.ValidatorStatusWaiting, sharding.ValidatorStatusLeaving:
+		response.ShardID = &estimate.ShardID
+		response.Position = &estimate.Position
+	case sharding.ValidatorStatusQueued:
+		response.Position = &estimate.Position
+	}
+	if estimate.HasEstimate {
+		response.EstimatedEligibleEpoch = &estimate.EstimatedEligibleEpoch
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"eligibility": response}, "", shared.ReturnCodeSuccess)
+}
+
+func sortedAssignmentShards(assignment *sharding.EpochValidatorsAssignment) []uint32 {
+	shards := make([]uint32, 0, len(assignment.Eligible))
+	for shard := range assignment.Eligible {
+		shards = append(shards, shard)
+	}
+	sort.Slice(shards, func(i, j int) bool {
+		return shards[i] < shards[j]
+	})
+
+	return shards
+}
+
+func toAssignedValidators(validators []*sharding.SerializableValidator, weights []uint32) []*AssignedValidatorResponse {
+	result := make([]*AssignedValidatorResponse, 0, len(validators))
+	for i, v := range validators {
+		assigned := &AssignedValidatorResponse{
+			PublicKey: hex.EncodeToString(v.PubKey),
+			Chances:   v.Chances,
+			Index:     v.Index,
+		}
+		if i < len(weights) {
+			assigned.SelectionWeight = weights[i]
+		}
+
+		result = append(result, assigned)
+	}
+
+	return result
+}
+
+func getQueryParamShard(c *gin.Context) (uint32, bool, error) {
+	shardStr := c.Request.URL.Query().Get(queryParamShard)
+	if shardStr == "" {
+		return 0, false, nil
+	}
+
+	shardID, err := strconv.ParseUint(shardStr, 10, 32)
+
+	return uint32(shardID), true, err
+}
+
+func filterRewardsAuditByShard(rewardsAudit *epochStart.RewardsAudit, shardID uint32) *epochStart.RewardsAudit {
+	filtered := *rewardsAudit
+	filtered.Shards = make([]*epochStart.ShardRewardsAudit, 0, 1)
+	for _, shardAudit := range rewardsAudit.Shards {
+		if shardAudit.ShardID == shardID {
+			filtered.Shards = append(filtered.Shards, shardAudit)
+		}
+	}
+
+	return &filtered
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -17,10 +18,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type ValidatorStatisticsResponse struct {
@@ -28,6 +33,56 @@ type ValidatorStatisticsResponse struct {
 	Error  string                                 `json:"error"`
 }
 
+type rewardsAuditResponseData struct {
+	RewardsAudit *epochStart.RewardsAudit `json:"rewardsAudit"`
+}
+
+type rewardsAuditResponse struct {
+	Data  rewardsAuditResponseData `json:"data"`
+	Error string                   `json:"error"`
+	Code  string                   `json:"code"`
+}
+
+type rewardsProofData struct {
+	Epoch     uint32   `json:"epoch"`
+	RootHash  string   `json:"rootHash"`
+	Address   string   `json:"address"`
+	Value     string   `json:"value"`
+	LeafIndex uint32   `json:"leafIndex"`
+	NumLeaves uint32   `json:"numLeaves"`
+	Siblings  []string `json:"siblings"`
+}
+
+type rewardsProofResponseData struct {
+	RewardsProof *rewardsProofData `json:"rewardsProof"`
+}
+
+type epochAssignmentResponseData struct {
+	Assignment *validator.EpochValidatorsAssignmentResponse `json:"assignment"`
+}
+
+type eligibilityResponseData struct {
+	Eligibility *validator.ValidatorEligibilityResponse `json:"eligibility"`
+}
+
+type eligibilityResponse struct {
+	Data  eligibilityResponseData `json:"data"`
+	Error string                  `json:"error"`
+	Code  string                  `json:"code"`
+}
+
+type epochAssignmentResponse struct {
+	Data  epochAssignmentResponseData `json:"data"`
+	Error string                      `json:"error"`
+	Code  string                      `json:"code"`
+}
+
+type rewardsProofResponse struct {
+	Data  rewardsProofResponseData `json:"data"`
+	Error string                   `json:"error"`
+	Code  string                   `json:"code"`
+}
+
 func TestValidatorStatistics_NilContextShouldError(t *testing.T) {
 	t.Parallel()
 	ws := startNodeServer(nil)
@@ -113,6 +168,409 @@ func TestValidatorStatistics_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, validatorStatistics.Result, mapToReturn)
 }
 
+func TestValidatorStatistics_PageInvalidQueryParamsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	paths := []string{
+		"/validator/statistics?shard=a",
+		"/validator/statistics?minRating=a",
+		"/validator/statistics?order=random",
+		"/validator/statistics?from=-1",
+		"/validator/statistics?size=0",
+	}
+	for _, path := range paths {
+		req, _ := http.NewRequest("GET", path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, path)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()), path)
+	}
+}
+
+func TestValidatorStatistics_PageFacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetValidatorStatisticsPageCalled: func(query state.ValidatorStatisticsQuery) (*state.ApiValidatorStatisticsPage, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/statistics?sortBy=rating", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, expectedErr.Error(), response.Error)
+}
+
+func TestValidatorStatistics_PageShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var receivedQuery state.ValidatorStatisticsQuery
+	facade := mock.Facade{
+		GetValidatorStatisticsPageCalled: func(query state.ValidatorStatisticsQuery) (*state.ApiValidatorStatisticsPage, error) {
+			receivedQuery = query
+			return &state.ApiValidatorStatisticsPage{
+				Statistics: []*state.ApiValidatorStatistics{
+					{
+						PublicKey:            "aa",
+						Identity:             "provider",
+						ValidatorApiResponse: &state.ValidatorApiResponse{Rating: 80, ShardId: 1},
+					},
+				},
+				NumValidators: 5,
+				NextFrom:      3,
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	path := "/validator/statistics?shard=1&identity=provider&status=eligible&minRating=50.5&maxRating=90" +
+		"&sortBy=rating&order=desc&from=2&size=1"
+	req, _ := http.NewRequest("GET", path, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	require.NotNil(t, receivedQuery.ShardID)
+	assert.Equal(t, uint32(1), *receivedQuery.ShardID)
+	require.NotNil(t, receivedQuery.MinRating)
+	assert.Equal(t, float32(50.5), *receivedQuery.MinRating)
+	require.NotNil(t, receivedQuery.MaxRating)
+	assert.Equal(t, float32(90), *receivedQuery.MaxRating)
+	assert.Equal(t, "provider", receivedQuery.Identity)
+	assert.Equal(t, "eligible", receivedQuery.Status)
+	assert.Equal(t, "rating", receivedQuery.SortBy)
+	assert.True(t, receivedQuery.SortDescending)
+	assert.Equal(t, uint32(2), receivedQuery.From)
+	assert.Equal(t, uint32(1), receivedQuery.Size)
+
+	responseData := response.Data.(map[string]interface{})
+	assert.Equal(t, float64(5), responseData["numValidators"])
+	assert.Equal(t, float64(3), responseData["nextFrom"])
+	statistics := responseData["statistics"].([]interface{})
+	require.Equal(t, 1, len(statistics))
+	entry := statistics[0].(map[string]interface{})
+	assert.Equal(t, "aa", entry["publicKey"])
+	assert.Equal(t, "provider", entry["identity"])
+	assert.Equal(t, float64(80), entry["rating"])
+}
+
+func createDummyRewardsAudit() *epochStart.RewardsAudit {
+	return &epochStart.RewardsAudit{
+		Epoch:             3,
+		TotalToDistribute: "1000",
+		RewardsPerBlock:   "10",
+		Shards: []*epochStart.ShardRewardsAudit{
+			{
+				ShardID: 0,
+				Validators: []*epochStart.ValidatorRewardsAudit{
+					{PublicKey: "aa", BaseReward: "5", IsRewarded: true},
+				},
+			},
+			{
+				ShardID: 1,
+				Validators: []*epochStart.ValidatorRewardsAudit{
+					{PublicKey: "bb", BaseReward: "7", IsRewarded: true},
+				},
+			},
+		},
+	}
+}
+
+func TestRewardsAudit_InvalidEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetRewardsAuditCalled: func(epoch uint32) (*epochStart.RewardsAudit, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/rewards/invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := rewardsAuditResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrInvalidEpoch.Error())
+}
+
+func TestRewardsAudit_InvalidShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetRewardsAuditCalled: func(epoch uint32) (*epochStart.RewardsAudit, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/rewards/3?shard=invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := rewardsAuditResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrInvalidShardID.Error())
+}
+
+func TestRewardsAudit_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetRewardsAuditCalled: func(epoch uint32) (*epochStart.RewardsAudit, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/rewards/3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := rewardsAuditResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrGetRewardsAudit.Error())
+	assert.Contains(t, response.Error, expectedErr.Error())
+}
+
+func TestRewardsAudit_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rewardsAudit := createDummyRewardsAudit()
+	facade := mock.Facade{
+		GetRewardsAuditCalled: func(epoch uint32) (*epochStart.RewardsAudit, error) {
+			assert.Equal(t, uint32(3), epoch)
+			return rewardsAudit, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/rewards/3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := rewardsAuditResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, rewardsAudit, response.Data.RewardsAudit)
+}
+
+func TestRewardsAudit_WithShardFilterShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rewardsAudit := createDummyRewardsAudit()
+	facade := mock.Facade{
+		GetRewardsAuditCalled: func(epoch uint32) (*epochStart.RewardsAudit, error) {
+			return rewardsAudit, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/rewards/3?shard=1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := rewardsAuditResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.NotNil(t, response.Data.RewardsAudit)
+	require.Equal(t, 1, len(response.Data.RewardsAudit.Shards))
+	assert.Equal(t, uint32(1), response.Data.RewardsAudit.Shards[0].ShardID)
+	assert.Equal(t, rewardsAudit.TotalToDistribute, response.Data.RewardsAudit.TotalToDistribute)
+	assert.Equal(t, 2, len(rewardsAudit.Shards))
+}
+
+func TestRewardsProof_InvalidEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/rewards/abc/proof/erd1addr", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := rewardsProofResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrInvalidEpoch.Error())
+}
+
+func TestRewardsProof_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetRewardsProofCalled: func(epoch uint32, address string) (*epochStart.RewardsProof, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/rewards/3/proof/erd1addr", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := rewardsProofResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrGetRewardsProof.Error())
+	assert.Contains(t, response.Error, expectedErr.Error())
+}
+
+func TestRewardsProof_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetRewardsProofCalled: func(epoch uint32, address string) (*epochStart.RewardsProof, error) {
+			assert.Equal(t, uint32(3), epoch)
+			assert.Equal(t, "erd1addr", address)
+			return &epochStart.RewardsProof{
+				Epoch:     3,
+				RootHash:  []byte{1, 2},
+				Address:   []byte("addr"),
+				Value:     big.NewInt(100),
+				LeafIndex: 1,
+				NumLeaves: 2,
+				Siblings:  [][]byte{{3, 4}},
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/rewards/3/proof/erd1addr", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := rewardsProofResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.NotNil(t, response.Data.RewardsProof)
+	expectedProof := &rewardsProofData{
+		Epoch:     3,
+		RootHash:  "0102",
+		Address:   "erd1addr",
+		Value:     "100",
+		LeafIndex: 1,
+		NumLeaves: 2,
+		Siblings:  []string{"0304"},
+	}
+	assert.Equal(t, expectedProof, response.Data.RewardsProof)
+}
+
+func TestEpochAssignment_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetEpochValidatorsAssignmentCalled: func(epoch uint32) (*sharding.EpochValidatorsAssignment, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/assignment/3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := epochAssignmentResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrGetEpochAssignment.Error())
+	assert.Contains(t, response.Error, expectedErr.Error())
+}
+
+func TestEpochAssignment_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetEpochValidatorsAssignmentCalled: func(epoch uint32) (*sharding.EpochValidatorsAssignment, error) {
+			assert.Equal(t, uint32(3), epoch)
+			return &sharding.EpochValidatorsAssignment{
+				Epoch:                   3,
+				NumShards:               1,
+				ShardConsensusGroupSize: 1,
+				MetaConsensusGroupSize:  1,
+				Randomness:              []byte{1, 2},
+				Eligible: map[uint32][]*sharding.SerializableValidator{
+					core.MetachainShardId: {{PubKey: []byte{3}, Chances: 1, Index: 0}},
+					0:                     {{PubKey: []byte{4}, Chances: 2, Index: 1}},
+				},
+				Waiting: map[uint32][]*sharding.SerializableValidator{
+					0: {{PubKey: []byte{5}, Chances: 1, Index: 2}},
+				},
+				SelectionWeights: map[uint32][]uint32{
+					core.MetachainShardId: {1},
+					0:                     {2},
+				},
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/assignment/3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := epochAssignmentResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.NotNil(t, response.Data.Assignment)
+	assert.Equal(t, "0102", response.Data.Assignment.Randomness)
+	require.Equal(t, 2, len(response.Data.Assignment.Shards))
+	shard := response.Data.Assignment.Shards[0]
+	assert.Equal(t, uint32(0), shard.ShardID)
+	assert.Equal(t, []*validator.AssignedValidatorResponse{{PublicKey: "04", Chances: 2, Index: 1, SelectionWeight: 2}}, shard.Eligible)
+	assert.Equal(t, []*validator.AssignedValidatorResponse{{PublicKey: "05", Chances: 1, Index: 2}}, shard.Waiting)
+	assert.Equal(t, 0, len(shard.Leaving))
+	assert.Equal(t, core.MetachainShardId, response.Data.Assignment.Shards[1].ShardID)
+
+	req, _ = http.NewRequest("GET", fmt.Sprintf("/validator/assignment/3?shard=%d", core.MetachainShardId), nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response = epochAssignmentResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, 1, len(response.Data.Assignment.Shards))
+	assert.Equal(t, core.MetachainShardId, response.Data.Assignment.Shards[0].ShardID)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -139,6 +597,53 @@ func startNodeServer(handler validator.FacadeHandler) *gin.Engine {
 	return ws
 }
 
+func TestValidatorStatisticsV2_ShouldAlwaysPaginate(t *testing.T) {
+	t.Parallel()
+
+	var receivedQuery state.ValidatorStatisticsQuery
+	facade := mock.Facade{
+		GetValidatorStatisticsPageCalled: func(query state.ValidatorStatisticsQuery) (*state.ApiValidatorStatisticsPage, error) {
+			receivedQuery = query
+			return &state.ApiValidatorStatisticsPage{
+				Statistics: []*state.ApiValidatorStatistics{
+					{
+						PublicKey:            "aa",
+						ValidatorApiResponse: &state.ValidatorApiResponse{Rating: 80},
+					},
+				},
+				NumValidators: 1,
+			}, nil
+		},
+	}
+	ws := gin.New()
+	ginValidatorRoute := ws.Group(shared.V2RoutesPrefix + "/validator")
+	ginValidatorRoute.Use(middleware.WithFacade(&facade))
+	validatorRoute, _ := wrapper.NewRouterWrapper("validator", ginValidatorRoute, getRoutesConfig())
+	validator.RoutesV2(validatorRoute)
+	validator.Routes(validatorRoute)
+
+	req, _ := http.NewRequest("GET", "/v2/validator/statistics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Validators []map[string]interface{} `json:"validators"`
+		} `json:"data"`
+		Error      *shared.ErrorV2      `json:"error"`
+		Pagination *shared.PaginationV2 `json:"pagination"`
+	}{}
+	loadResponse(resp.Body, &response)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	assert.Nil(t, response.Error)
+	assert.Equal(t, uint32(0), receivedQuery.From)
+	assert.Equal(t, uint32(100), receivedQuery.Size)
+	require.Equal(t, 1, len(response.Data.Validators))
+	assert.Equal(t, "aa", response.Data.Validators[0]["publicKey"])
+	assert.Equal(t, &shared.PaginationV2{From: 0, Size: 100, Total: 1}, response.Pagination)
+}
+
 func startNodeServerWrongFacade() *gin.Engine {
 	ws := gin.New()
 	ws.Use(cors.Default())
@@ -157,8 +662,88 @@ func getRoutesConfig() config.ApiRoutesConfig {
 			"validator": {
 				[]config.RouteConfig{
 					{Name: "/statistics", Open: true},
+					{Name: "/rewards/:epoch", Open: true},
+					{Name: "/rewards/:epoch/proof/:address", Open: true},
+					{Name: "/assignment/:epoch", Open: true},
+					{Name: "/eligibility/:key", Open: true},
 				},
 			},
 		},
 	}
 }
+
+func TestEligibility_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetValidatorEligibilityEstimateCalled: func(blsKey string) (*sharding.ValidatorEligibilityEstimate, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/eligibility/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := eligibilityResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrGetValidatorEligibility.Error())
+	assert.Contains(t, response.Error, expectedErr.Error())
+}
+
+func TestEligibility_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	estimates := map[string]*sharding.ValidatorEligibilityEstimate{
+		"aa": {Epoch: 3, Status: sharding.ValidatorStatusWaiting, ShardID: 1, Position: 4, HasEstimate: true, EstimatedEligibleEpoch: 5},
+		"bb": {Epoch: 3, Status: sharding.ValidatorStatusQueued, Position: 7},
+		"cc": {Epoch: 3, Status: sharding.ValidatorStatusInactive},
+	}
+	facade := mock.Facade{
+		GetValidatorEligibilityEstimateCalled: func(blsKey string) (*sharding.ValidatorEligibilityEstimate, error) {
+			return estimates[blsKey], nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	getEligibility := func(blsKey string) *validator.ValidatorEligibilityResponse {
+		req, _ := http.NewRequest("GET", "/validator/eligibility/"+blsKey, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := eligibilityResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.NotNil(t, response.Data.Eligibility)
+
+		return response.Data.Eligibility
+	}
+
+	shardID, position, estimatedEpoch := uint32(1), uint32(4), uint32(5)
+	assert.Equal(t, &validator.ValidatorEligibilityResponse{
+		PublicKey:              "aa",
+		Epoch:                  3,
+		Status:                 sharding.ValidatorStatusWaiting,
+		ShardID:                &shardID,
+		Position:               &position,
+		EstimatedEligibleEpoch: &estimatedEpoch,
+	}, getEligibility("aa"))
+
+	queuePosition := uint32(7)
+	assert.Equal(t, &validator.ValidatorEligibilityResponse{
+		PublicKey: "bb",
+		Epoch:     3,
+		Status:    sharding.ValidatorStatusQueued,
+		Position:  &queuePosition,
+	}, getEligibility("bb"))
+
+	assert.Equal(t, &validator.ValidatorEligibilityResponse{
+		PublicKey: "cc",
+		Epoch:     3,
+		Status:    sharding.ValidatorStatusInactive,
+	}, getEligibility("cc"))
+}
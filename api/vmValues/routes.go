@@ -36,6 +36,8 @@ type VMValueRequest struct {
 	CallerAddr string   `form:"caller" json:"caller"`
 	CallValue  string   `form:"value" json:"value"`
 	Args       []string `form:"args"  json:"args"`
+	BlockNonce *uint64  `form:"blockNonce" json:"blockNonce"`
+	BlockHash  string   `form:"blockHash" json:"blockHash"`
 }
 
 // Routes defines address related routes
@@ -154,6 +156,14 @@ func createSCQuery(fh FacadeHandler, request *VMValueRequest) (*process.SCQuery,
 		scQuery.CallValue = callValue
 	}
 
+	scQuery.BlockNonce = request.BlockNonce
+	if len(request.BlockHash) > 0 {
+		scQuery.BlockHash, err = hex.DecodeString(request.BlockHash)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid block hash: %s", request.BlockHash, err.Error())
+		}
+	}
+
 	return scQuery, nil
 }
 
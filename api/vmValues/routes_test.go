@@ -150,6 +150,51 @@ func TestQuery_ShouldWork(t *testing.T) {
 	require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
 }
 
+func TestQuery_WithBlockNonceAndHashShouldWork(t *testing.T) {
+	t.Parallel()
+
+	blockNonce := uint64(37)
+	blockHash := []byte("block hash")
+	facade := mock.Facade{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+			require.NotNil(t, query.BlockNonce)
+			require.Equal(t, blockNonce, *query.BlockNonce)
+			require.Equal(t, blockHash, query.BlockHash)
+
+			return &vm.VMOutputApi{
+				ReturnData: [][]byte{big.NewInt(42).Bytes()},
+			}, nil
+		},
+	}
+
+	request := VMValueRequest{
+		ScAddress:  DummyScAddress,
+		FuncName:   "function",
+		Args:       []string{},
+		BlockNonce: &blockNonce,
+		BlockHash:  hex.EncodeToString(blockHash),
+	}
+
+	response := vmOutputResponse{}
+	statusCode := doPost(&facade, "/vm-values/query", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "", response.Error)
+	require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
+}
+
+func TestCreateSCQuery_BlockHashIsNotHexShouldErr(t *testing.T) {
+	request := VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		BlockHash: "bad hash",
+	}
+
+	_, err := createSCQuery(&mock.Facade{}, &request)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "'bad hash' is not a valid block hash")
+}
+
 func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 	request := VMValueRequest{
 		ScAddress: DummyScAddress,
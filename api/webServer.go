@@ -0,0 +1,103 @@
+package api
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+const allOrigins = "*"
+
+// createCORSHandler returns the handler applying the configured CORS policy, the default policy being used when no
+// allowed origin is configured
+func createCORSHandler(cfg config.CORSConfig) (gin.HandlerFunc, error) {
+	if len(cfg.AllowedOrigins) == 0 {
+		return cors.Default(), nil
+	}
+
+	corsConfig := cors.DefaultConfig()
+	corsConfig.AllowCredentials = cfg.AllowCredentials
+	corsConfig.ExposeHeaders = cfg.ExposedHeaders
+	if len(cfg.AllowedMethods) > 0 {
+		corsConfig.AllowMethods = cfg.AllowedMethods
+	}
+	if len(cfg.AllowedHeaders) > 0 {
+		corsConfig.AllowHeaders = cfg.AllowedHeaders
+	}
+	if cfg.MaxAgeInSec > 0 {
+		corsConfig.MaxAge = time.Duration(cfg.MaxAgeInSec) * time.Second
+	}
+
+	for _, origin := range cfg.AllowedOrigins {
+		if origin == allOrigins {
+			corsConfig.AllowAllOrigins = true
+			break
+		}
+	}
+	if !corsConfig.AllowAllOrigins {
+		corsConfig.AllowOrigins = cfg.AllowedOrigins
+		corsConfig.AllowWildcard = true
+	}
+	if corsConfig.AllowAllOrigins && corsConfig.AllowCredentials {
+		return nil, fmt.Errorf("%w, the credentials can not be allowed for all the origins", ErrInvalidCORSConfig)
+	}
+
+	err := corsConfig.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCORSConfig, err.Error())
+	}
+
+	return cors.New(corsConfig), nil
+}
+
+// createServerTLSConfig returns the TLS configuration of the web server, together with the certificate and key files
+// to be loaded, which are empty when the certificates are obtained through ACME
+func createServerTLSConfig(cfg config.WebServerTLSConfig) (*tls.Config, string, string, error) {
+	if !cfg.ACME.Enabled {
+		if len(cfg.CertificateFile) == 0 || len(cfg.KeyFile) == 0 {
+			return nil, "", "", fmt.Errorf("%w, the certificate and key files are mandatory when ACME is disabled",
+				middleware.ErrMissingTLSConfig)
+		}
+
+		return &tls.Config{MinVersion: tls.VersionTLS12}, cfg.CertificateFile, cfg.KeyFile, nil
+	}
+
+	if len(cfg.ACME.Domains) == 0 {
+		return nil, "", "", fmt.Errorf("%w, at least one ACME domain should be provided", middleware.ErrMissingTLSConfig)
+	}
+
+	manager := &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		HostPolicy: autocert.HostWhitelist(cfg.ACME.Domains...),
+		Cache:      autocert.DirCache(cfg.ACME.CacheDir),
+		Email:      cfg.ACME.Email,
+	}
+	if len(cfg.ACME.DirectoryURL) > 0 {
+		manager.Client = &acme.Client{DirectoryURL: cfg.ACME.DirectoryURL}
+	}
+	if len(cfg.ACME.HTTPChallengeInterface) > 0 {
+		go serveHTTPChallenges(cfg.ACME.HTTPChallengeInterface, manager)
+	}
+
+	tlsConfig := manager.TLSConfig()
+	tlsConfig.MinVersion = tls.VersionTLS12
+
+	return tlsConfig, "", "", nil
+}
+
+func serveHTTPChallenges(address string, manager *autocert.Manager) {
+	log.Debug("starting the ACME http-01 challenges server", "interface", address)
+
+	err := http.ListenAndServe(address, manager.HTTPHandler(nil))
+	if err != nil {
+		log.Error("ACME http-01 challenges server stopped", "error", err.Error())
+	}
+}
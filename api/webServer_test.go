@@ -0,0 +1,98 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func doCORSRequest(t *testing.T, cfg config.CORSConfig, origin string) *httptest.ResponseRecorder {
+	corsHandler, err := createCORSHandler(cfg)
+	require.Nil(t, err)
+
+	ws := gin.New()
+	ws.Use(corsHandler)
+	ws.GET("/node/status", func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/node/status", nil)
+	req.Header.Set("Origin", origin)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestCreateCORSHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default policy", func(t *testing.T) {
+		resp := doCORSRequest(t, config.CORSConfig{}, "https://any.example.com")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "*", resp.Header().Get("Access-Control-Allow-Origin"))
+	})
+	t.Run("allowed origins", func(t *testing.T) {
+		cfg := config.CORSConfig{
+			AllowedOrigins:   []string{"https://*.example.com"},
+			ExposedHeaders:   []string{"ETag"},
+			AllowCredentials: true,
+		}
+		resp := doCORSRequest(t, cfg, "https://explorer.example.com")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "https://explorer.example.com", resp.Header().Get("Access-Control-Allow-Origin"))
+		assert.Equal(t, "true", resp.Header().Get("Access-Control-Allow-Credentials"))
+
+		resp = doCORSRequest(t, cfg, "https://other.org")
+		assert.Equal(t, http.StatusForbidden, resp.Code)
+	})
+	t.Run("credentials for all the origins should error", func(t *testing.T) {
+		corsHandler, err := createCORSHandler(config.CORSConfig{
+			AllowedOrigins:   []string{allOrigins},
+			AllowCredentials: true,
+		})
+		assert.Nil(t, corsHandler)
+		assert.True(t, errors.Is(err, ErrInvalidCORSConfig))
+	})
+}
+
+func TestCreateServerTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	_, _, _, err := createServerTLSConfig(config.WebServerTLSConfig{Enabled: true, CertificateFile: "cert.pem"})
+	assert.True(t, errors.Is(err, middleware.ErrMissingTLSConfig))
+
+	tlsConfig, certificateFile, keyFile, err := createServerTLSConfig(config.WebServerTLSConfig{
+		Enabled:         true,
+		CertificateFile: "cert.pem",
+		KeyFile:         "key.pem",
+	})
+	require.Nil(t, err)
+	assert.NotNil(t, tlsConfig)
+	assert.Equal(t, "cert.pem", certificateFile)
+	assert.Equal(t, "key.pem", keyFile)
+
+	acmeConfig := config.WebServerTLSConfig{
+		Enabled: true,
+		ACME: config.ACMEConfig{
+			Enabled:  true,
+			CacheDir: t.Name(),
+		},
+	}
+	_, _, _, err = createServerTLSConfig(acmeConfig)
+	assert.True(t, errors.Is(err, middleware.ErrMissingTLSConfig))
+
+	acmeConfig.ACME.Domains = []string{"observer.example.com"}
+	tlsConfig, certificateFile, keyFile, err = createServerTLSConfig(acmeConfig)
+	require.Nil(t, err)
+	assert.NotNil(t, tlsConfig.GetCertificate)
+	assert.Empty(t, certificateFile)
+	assert.Empty(t, keyFile)
+}
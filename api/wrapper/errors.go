@@ -4,3 +4,6 @@ import "errors"
 
 // ErrNilRouter signals that a nil router has been provided
 var ErrNilRouter = errors.New("nil router")
+
+// ErrConfigNotFound signals that the routes configuration of a package was not found
+var ErrConfigNotFound = errors.New("config not found")
@@ -1,18 +1,24 @@
 package wrapper
 
 import (
-	"errors"
+	"fmt"
+	"net/http"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/gin-gonic/gin"
 )
 
-// RouterWrapper is a wrapper over the gin RouterGroup in order to handle the logic of enabling or disabling routes
+// RouterWrapper is a wrapper over the gin RouterGroup in order to handle the logic of enabling or disabling routes.
+// All the routes are registered on the router, the closed ones answering as unknown routes, so they can be opened
+// or closed by applying a new routes configuration while the web server is running
 type RouterWrapper struct {
-	router          *gin.RouterGroup
-	routesConfig    config.APIPackageConfig
-	mutRoutesConfig sync.RWMutex
+	packageName      string
+	router           *gin.RouterGroup
+	routesConfig     config.APIPackageConfig
+	mutRoutesConfig  sync.RWMutex
+	registeredRoutes map[string]struct{}
+	fullPathRoutes   map[string]string
 }
 
 // NewRouterWrapper will return a new instance of RouterWrapper
@@ -23,23 +29,62 @@ func NewRouterWrapper(packageName string, router *gin.RouterGroup, routesConfig
 
 	configForPackage, ok := routesConfig.APIPackages[packageName]
 	if !ok {
-		return nil, errors.New("config not found")
+		return nil, ErrConfigNotFound
 	}
 
 	return &RouterWrapper{
-		router:       router,
-		routesConfig: configForPackage,
+		packageName:      packageName,
+		router:           router,
+		routesConfig:     configForPackage,
+		registeredRoutes: make(map[string]struct{}),
+		fullPathRoutes:   make(map[string]string),
 	}, nil
 }
 
-// RegisterHandler will register the handler for the given method and path
+// RegisterHandler will register the handler for the given method and path. The first registered handler of a method
+// and path is kept, so the routes changed by a newer API version can be registered before the original ones
 func (rw *RouterWrapper) RegisterHandler(method string, path string, handlers ...gin.HandlerFunc) {
-	if rw.isEndpointActive(path) {
-		rw.router.Handle(method, path, handlers...)
+	guardedHandlers := append([]gin.HandlerFunc{rw.closedRouteGuard(path)}, handlers...)
+	rw.registerHandler(method, path, guardedHandlers...)
+}
+
+// RegisterHandlerWithoutGuard will register the handler for the given method and path without checking if the path is
+// open, the handlers being responsible for checking if the routes they serve are open
+func (rw *RouterWrapper) RegisterHandlerWithoutGuard(method string, path string, handlers ...gin.HandlerFunc) {
+	rw.registerHandler(method, path, handlers...)
+}
+
+func (rw *RouterWrapper) registerHandler(method string, path string, handlers ...gin.HandlerFunc) {
+	route := method + " " + path
+	_, isRegistered := rw.registeredRoutes[route]
+	if isRegistered {
+		return
 	}
+
+	rw.registeredRoutes[route] = struct{}{}
+	rw.fullPathRoutes[method+" "+rw.router.BasePath()+path] = path
+	rw.router.Handle(method, path, handlers...)
 }
 
-func (rw *RouterWrapper) isEndpointActive(endpointToCheck string) bool {
+func (rw *RouterWrapper) closedRouteGuard(path string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if rw.IsEndpointActive(path) {
+			c.Next()
+			return
+		}
+
+		RespondWithRouteNotFound(c)
+	}
+}
+
+// RespondWithRouteNotFound answers the request the same way the web server answers the unknown routes
+func RespondWithRouteNotFound(c *gin.Context) {
+	c.String(http.StatusNotFound, "404 page not found")
+	c.Abort()
+}
+
+// IsEndpointActive returns true if the provided path is configured as open
+func (rw *RouterWrapper) IsEndpointActive(endpointToCheck string) bool {
 	rw.mutRoutesConfig.RLock()
 	routesConfig := rw.routesConfig
 	rw.mutRoutesConfig.RUnlock()
@@ -51,3 +96,39 @@ func (rw *RouterWrapper) isEndpointActive(endpointToCheck string) bool {
 
 	return false
 }
+
+// IsRouteActive returns true if the provided method and full path, as reported by the web server, belong to a route
+// registered through this wrapper and configured as open. The second returned value is false if the route was not
+// registered through this wrapper
+func (rw *RouterWrapper) IsRouteActive(method string, fullPath string) (bool, bool) {
+	path, isRegistered := rw.fullPathRoutes[method+" "+fullPath]
+	if !isRegistered {
+		return false, false
+	}
+
+	return rw.IsEndpointActive(path), true
+}
+
+// ValidateRoutesConfig checks that the provided routes configuration holds the configuration of the wrapped package
+func (rw *RouterWrapper) ValidateRoutesConfig(routesConfig config.ApiRoutesConfig) error {
+	_, ok := routesConfig.APIPackages[rw.packageName]
+	if !ok {
+		return fmt.Errorf("%w for package %s", ErrConfigNotFound, rw.packageName)
+	}
+
+	return nil
+}
+
+// SetRoutesConfig applies the provided routes configuration, opening or closing the registered routes
+func (rw *RouterWrapper) SetRoutesConfig(routesConfig config.ApiRoutesConfig) error {
+	err := rw.ValidateRoutesConfig(routesConfig)
+	if err != nil {
+		return err
+	}
+
+	rw.mutRoutesConfig.Lock()
+	rw.routesConfig = routesConfig.APIPackages[rw.packageName]
+	rw.mutRoutesConfig.Unlock()
+
+	return nil
+}
@@ -6,6 +6,9 @@ generate() {
     generateForTermUi
     generateForLogViewer
     generateForSeedNode
+    generateForOutportFilePlugin
+    generateForBlsSigner
+    generateForKeystore
 }
 
 generateForNode() {
@@ -78,6 +81,48 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForOutportFilePlugin() {
+    HELP="
+# Outport file plugin CLI
+
+The **Outport file plugin** exposes the following Command Line Interface:
+$(code)
+\$ outportfileplugin --help
+
+$(./outportfileplugin/outportfileplugin --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./outportfileplugin/CLI.md
+}
+
+generateForBlsSigner() {
+    HELP="
+# BLS remote signer CLI
+
+The **BLS remote signer** exposes the following Command Line Interface:
+$(code)
+\$ blssigner --help
+
+$(./blssigner/blssigner --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./blssigner/CLI.md
+}
+
+generateForKeystore() {
+    HELP="
+# Keystore Tool CLI
+
+The **Keystore Tool** exposes the following Command Line Interface:
+$(code)
+\$ keystore --help
+
+$(./keystore/keystore --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./keystore/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/keystore"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/remoteSigner"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/urfave/cli"
+)
+
+const shutdownTimeout = time.Second * 5
+
+var (
+	log = logger.GetOrCreate("blssigner")
+
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// keysFile defines a flag for the file holding the BLS keys the signer signs with
+	keysFile = cli.StringFlag{
+		Name:  "keys-file",
+		Usage: "The `filepath` for the PEM file holding the BLS keys, in the validatorKey.pem or allValidatorsKeys.pem format, " +
+			"or for the encrypted keystore holding them",
+		Value: "./validatorKey.pem",
+	}
+	// passphraseFile defines a flag for the file holding the passphrase of the encrypted keystore
+	passphraseFile = cli.StringFlag{
+		Name: "passphrase-file",
+		Usage: "The `filepath` for the file holding the passphrase of the encrypted keystore. When not provided, the " +
+			"passphrase is read from the " + keystore.PassphraseEnvVariable + " environment variable or, if not set, from a prompt",
+		Value: "",
+	}
+	// listenAddress defines a flag for the address the signer listens on
+	listenAddress = cli.StringFlag{
+		Name:  "listen",
+		Usage: "The address the signer listens on for the nodes' requests",
+		Value: ":9443",
+	}
+	// certificateFile defines a flag for the certificate the signer presents to the nodes
+	certificateFile = cli.StringFlag{
+		Name:  "certificate",
+		Usage: "The `filepath` for the PEM encoded certificate the signer presents to the nodes",
+		Value: "./signer.crt",
+	}
+	// keyFile defines a flag for the key of the signer's certificate
+	keyFile = cli.StringFlag{
+		Name:  "key",
+		Usage: "The `filepath` for the PEM encoded key of the signer's certificate",
+		Value: "./signer.key",
+	}
+	// clientCAFile defines a flag for the certificate authority of the nodes' certificates
+	clientCAFile = cli.StringFlag{
+		Name:  "client-ca",
+		Usage: "The `filepath` for the PEM encoded certificate authority the nodes' certificates must be issued by",
+		Value: "./ca.crt",
+	}
+	// signingHistoryFile defines a flag for the file holding the last consensus slots the keys signed for
+	signingHistoryFile = cli.StringFlag{
+		Name: "signing-history-file",
+		Usage: "The `filepath` for the file holding, for each key, the last consensus slot it signed for. The signer " +
+			"refuses to sign a different block header for a slot already signed, also after a restart, so the file " +
+			"must be kept between runs",
+		Value: "./signingHistory.json",
+	}
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "BLS remote signer"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary holds the BLS keys of the validators and signs on behalf of the nodes configured with a remote signer"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		keysFile,
+		passphraseFile,
+		listenAddress,
+		certificateFile,
+		keyFile,
+		clientCAFile,
+		signingHistoryFile,
+	}
+
+	app.Action = startSigner
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func startSigner(ctx *cli.Context) error {
+	passphraseProvider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+		PassphraseFile: ctx.GlobalString(passphraseFile.Name),
+		EnvVariable:    keystore.PassphraseEnvVariable,
+	})
+	localBackend, err := createSigningBackend(ctx.GlobalString(keysFile.Name), passphraseProvider)
+	if err != nil {
+		return err
+	}
+
+	backend, err := remoteSigner.NewDoubleSignProtectedBackend(remoteSigner.ArgsDoubleSignProtectedBackend{
+		Backend:                localBackend,
+		SigningHistoryFilePath: ctx.GlobalString(signingHistoryFile.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	handler, err := remoteSigner.NewSigningHandler(backend)
+	if err != nil {
+		return err
+	}
+
+	tlsConfig, err := remoteSigner.NewServerTLSConfig(
+		ctx.GlobalString(certificateFile.Name),
+		ctx.GlobalString(keyFile.Name),
+		ctx.GlobalString(clientCAFile.Name),
+	)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Addr:      ctx.GlobalString(listenAddress.Name),
+		Handler:   handler,
+		TLSConfig: tlsConfig,
+	}
+
+	chanErr := make(chan error, 1)
+	go func() {
+		log.Info("BLS remote signer is listening", "address", server.Addr)
+		chanErr <- server.ListenAndServeTLS("", "")
+	}()
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case err = <-chanErr:
+		return err
+	case <-sigs:
+	}
+
+	log.Info("terminating at user's signal...")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	return server.Shutdown(shutdownCtx)
+}
+
+func createSigningBackend(keysFileName string, passphraseProvider keystore.PassphraseProvider) (crypto.SigningBackend, error) {
+	encodedSks, pks, err := keystore.LoadAllKeysFromFile(keysFileName, passphraseProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeys := make([][]byte, 0, len(encodedSks))
+	for i, encodedSk := range encodedSks {
+		skBytes, errDecode := hex.DecodeString(string(encodedSk))
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for encoded secret key of public key %s", errDecode, pks[i])
+		}
+
+		privateKeys = append(privateKeys, skBytes)
+		log.Info("loaded BLS key", "public key", pks[i])
+	}
+
+	args := remoteSigner.ArgsLocalSigningBackend{
+		KeyGenerator: signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		SingleSigner: &singlesig.BlsSingleSigner{},
+		PrivateKeys:  privateKeys,
+	}
+
+	return remoteSigner.NewLocalSigningBackend(args)
+}
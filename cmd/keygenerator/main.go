@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/thresholdsig"
 	"github.com/urfave/cli"
 )
 
@@ -27,6 +28,8 @@ type cfg struct {
 	keyType    string
 	consoleOut bool
 	noSplit    bool
+	threshold  uint
+	numShares  uint
 }
 
 const validatorType = "validator"
@@ -39,6 +42,7 @@ type key struct {
 }
 
 const keysFolderPattern = "node-%d"
+const sharesFolderPattern = "share-%d"
 const blsPubkeyLen = 96
 const txSignPubkeyLen = 32
 
@@ -89,6 +93,21 @@ VERSION:
 		Usage:       "Boolean option that will make each generated key added in the same file",
 		Destination: &argsConfig.noSplit,
 	}
+	// numShares defines a flag for splitting each validator key in shares held by separate remote signers
+	numShares = cli.UintFlag{
+		Name: "num-shares",
+		Usage: "If greater than 0, each generated validator key is also split in this number of shares, to be held by " +
+			"separate remote signers. The shares with the same ID are saved in the same share-<ID> folder",
+		Value:       0,
+		Destination: &argsConfig.numShares,
+	}
+	// threshold defines a flag for setting how many shares are needed to sign with a split validator key
+	threshold = cli.UintFlag{
+		Name:        "threshold",
+		Usage:       "How many shares of a validator key split with the num-shares flag are needed to sign with the key",
+		Value:       2,
+		Destination: &argsConfig.threshold,
+	}
 
 	argsConfig = &cfg{}
 
@@ -118,6 +137,8 @@ func main() {
 		keyType,
 		consoleOut,
 		noSplit,
+		numShares,
+		threshold,
 	}
 
 	app.Action = func(_ *cli.Context) error {
@@ -133,12 +154,33 @@ func main() {
 }
 
 func process() error {
+	shouldSplitKeys := argsConfig.numShares > 0
+	if shouldSplitKeys && argsConfig.keyType == walletType {
+		return fmt.Errorf("only the validator keys can be split in shares")
+	}
+	if shouldSplitKeys && (argsConfig.threshold < 2 || argsConfig.threshold > argsConfig.numShares) {
+		return fmt.Errorf("the threshold should be between 2 and the number of shares")
+	}
+
 	validatorKeys, walletKeys, err := generateKeys(argsConfig.keyType, argsConfig.numKeys)
 	if err != nil {
 		return err
 	}
 
-	return outputKeys(validatorKeys, walletKeys, argsConfig.consoleOut, argsConfig.noSplit)
+	err = outputKeys(validatorKeys, walletKeys, argsConfig.consoleOut, argsConfig.noSplit)
+	if err != nil {
+		return err
+	}
+	if !shouldSplitKeys {
+		return nil
+	}
+
+	shareKeys, err := splitKeys(validatorKeys, uint32(argsConfig.threshold), uint32(argsConfig.numShares))
+	if err != nil {
+		return err
+	}
+
+	return outputShareKeys(shareKeys, argsConfig.consoleOut)
 }
 
 func generateKeys(typeKey string, numKeys int) ([]key, []key, error) {
@@ -206,6 +248,86 @@ func generateKey(keyGen crypto.KeyGenerator, list []key) ([]key, error) {
 	return list, nil
 }
 
+// splitKeys splits each validator key in shares and groups the shares by their IDs, so that all the shares with the
+// same ID can be loaded in the same remote signer
+func splitKeys(validatorKeys []key, threshold uint32, numShares uint32) ([][]key, error) {
+	shareKeys := make([][]key, numShares)
+	for _, validatorKey := range validatorKeys {
+		shares, err := thresholdsig.SplitPrivateKey(validatorKey.skBytes, threshold, numShares)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Info("split validator key",
+			"public key", validatorPubKeyConverter.Encode(validatorKey.pkBytes),
+			"threshold", threshold,
+			"num shares", numShares)
+		for i, share := range shares {
+			log.Info("validator key share",
+				"ID", share.ID,
+				"public key", validatorPubKeyConverter.Encode(share.PublicKey))
+
+			shareKeys[i] = append(shareKeys[i], key{
+				skBytes: share.PrivateKey,
+				pkBytes: share.PublicKey,
+			})
+		}
+	}
+
+	return shareKeys, nil
+}
+
+func outputShareKeys(shareKeys [][]key, consoleOut bool) error {
+	for i, keys := range shareKeys {
+		shareID := i + 1
+		if consoleOut {
+			err := printSliceKeys(fmt.Sprintf("Validator key shares with ID %d:", shareID), keys, validatorPubKeyConverter)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err := saveShareKeys(shareID, keys)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func saveShareKeys(shareID int, keys []key) error {
+	absPath, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	folder := filepath.Join(absPath, fmt.Sprintf(sharesFolderPattern, shareID))
+	log.Info("generating files in", "folder", folder)
+	err = os.MkdirAll(folder, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	filename := filepath.Join(folder, validatorKeyFilenameTemplate)
+	backupFileIfExists(filename)
+	file, err := os.OpenFile(fmt.Sprintf(filename, ""), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, core.FileModeUserReadWrite)
+	if err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		err = writeKeyToStream(file, k, validatorPubKeyConverter)
+		if err != nil {
+			_ = file.Close()
+			return err
+		}
+	}
+
+	return file.Close()
+}
+
 func outputKeys(
 	validatorKeys []key,
 	walletKeys []key,
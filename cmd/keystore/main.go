@@ -0,0 +1,218 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/keystore"
+	"github.com/urfave/cli"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+const minPassphraseLength = 8
+
+var (
+	log = logger.GetOrCreate("keystore")
+
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// pemFile defines a flag for the plaintext PEM file to be encrypted
+	pemFile = cli.StringFlag{
+		Name:  "pem-file",
+		Usage: "The `filepath` for the plaintext PEM file holding the keys to be encrypted, e.g. validatorKey.pem",
+		Value: "./validatorKey.pem",
+	}
+	// outputFile defines a flag for the keystore file to be created
+	outputFile = cli.StringFlag{
+		Name:  "output",
+		Usage: "The `filepath` for the keystore to be created. Defaults to the PEM file path with the .json extension",
+		Value: "",
+	}
+	// kdf defines a flag for the key derivation function of the keystore
+	kdf = cli.StringFlag{
+		Name:  "kdf",
+		Usage: "The key derivation function deriving the encryption key from the passphrase: scrypt or argon2id",
+		Value: keystore.KdfScrypt,
+	}
+	// passphraseFile defines a flag for the file holding the passphrase
+	passphraseFile = cli.StringFlag{
+		Name: "passphrase-file",
+		Usage: "The `filepath` for the file holding the passphrase. When not provided, the passphrase is read from the " +
+			keystore.PassphraseEnvVariable + " environment variable or, if not set, from a prompt",
+		Value: "",
+	}
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Keystore Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary encrypts a plaintext PEM key file, as validatorKey.pem or allValidatorsKeys.pem, in a " +
+		"keystore the node and the BLS remote signer can load"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		pemFile,
+		outputFile,
+		kdf,
+		passphraseFile,
+	}
+
+	app.Action = migrate
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error creating the keystore", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func migrate(ctx *cli.Context) error {
+	pemFileName := ctx.GlobalString(pemFile.Name)
+	keystoreFileName := ctx.GlobalString(outputFile.Name)
+	if len(keystoreFileName) == 0 {
+		keystoreFileName = strings.TrimSuffix(pemFileName, ".pem") + ".json"
+	}
+
+	encodedSks, pks, err := core.LoadAllKeysFromPemFile(pemFileName)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]keystore.KeyEntry, 0, len(encodedSks))
+	for i := range encodedSks {
+		entries = append(entries, keystore.KeyEntry{
+			Identifier: pks[i],
+			Secret:     encodedSks[i],
+		})
+	}
+
+	kdfParams, err := keystore.NewKdfParams(ctx.GlobalString(kdf.Name))
+	if err != nil {
+		return err
+	}
+
+	passphrase, err := readNewPassphrase(ctx.GlobalString(passphraseFile.Name))
+	if err != nil {
+		return err
+	}
+
+	ks, err := keystore.Encrypt(entries, passphrase, kdfParams)
+	if err != nil {
+		return err
+	}
+
+	err = ks.Save(keystoreFileName)
+	if err != nil {
+		return err
+	}
+
+	// the keystore is loaded back, as the node will, before the operator removes the plaintext file
+	_, loadedPks, err := keystore.LoadAllKeysFromFile(keystoreFileName, &staticPassphrase{passphrase: passphrase})
+	if err != nil {
+		return fmt.Errorf("%w while verifying the created keystore", err)
+	}
+
+	log.Info("keystore created",
+		"keystore", keystoreFileName,
+		"num keys", len(loadedPks),
+		"kdf", kdfParams.Function)
+	log.Warn("the plaintext PEM file is left untouched, securely delete it once the node is configured with the keystore",
+		"file", pemFileName)
+
+	return nil
+}
+
+func readNewPassphrase(passphraseFileName string) ([]byte, error) {
+	var passphrase []byte
+	if len(passphraseFileName) > 0 {
+		buff, err := ioutil.ReadFile(passphraseFileName)
+		if err != nil {
+			return nil, err
+		}
+		passphrase = bytes.TrimRight(buff, "\r\n")
+	} else if envPassphrase, found := os.LookupEnv(keystore.PassphraseEnvVariable); found {
+		passphrase = []byte(envPassphrase)
+	} else {
+		var err error
+		passphrase, err = promptNewPassphrase()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(passphrase) < minPassphraseLength {
+		return nil, fmt.Errorf("the passphrase must have at least %d characters", minPassphraseLength)
+	}
+
+	return passphrase, nil
+}
+
+func promptNewPassphrase() ([]byte, error) {
+	stdin := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(stdin) {
+		return nil, fmt.Errorf("%w: provide a passphrase file or the %s environment variable",
+			keystore.ErrNoPassphraseSource, keystore.PassphraseEnvVariable)
+	}
+
+	_, _ = fmt.Fprint(os.Stderr, "New passphrase: ")
+	passphrase, err := terminal.ReadPassword(stdin)
+	_, _ = fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _ = fmt.Fprint(os.Stderr, "Repeat the passphrase: ")
+	repeated, err := terminal.ReadPassword(stdin)
+	_, _ = fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(passphrase, repeated) {
+		return nil, errors.New("the passphrases do not match")
+	}
+
+	return passphrase, nil
+}
+
+// staticPassphrase provides the passphrase the keystore was just encrypted with
+type staticPassphrase struct {
+	passphrase []byte
+}
+
+// Passphrase returns the passphrase
+func (sp *staticPassphrase) Passphrase(_ string) ([]byte, error) {
+	return sp.passphrase, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *staticPassphrase) IsInterfaceNil() bool {
+	return sp == nil
+}
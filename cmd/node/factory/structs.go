@@ -50,6 +50,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/postprocess"
 	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
+	processEconomics "github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/interceptorscontainer"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
@@ -158,6 +159,7 @@ type processComponentsFactoryArgs struct {
 	startEpochNum             uint32
 	sizeCheckDelta            uint32
 	stateCheckpointModulus    uint
+	epochStartProcessingLag   uint64
 	maxComputableRounds       uint64
 	numConcurrentResolverJobs int32
 	minSizeInBytes            uint32
@@ -172,6 +174,7 @@ type processComponentsFactoryArgs struct {
 	indexer                   indexer.Indexer
 	uint64Converter           typeConverters.Uint64ByteSliceConverter
 	tpsBenchmark              statistics.TPSBenchmark
+	crossShardStatistics      statistics.CrossShardStatisticsHandler
 	historyRepo               dblookupext.HistoryRepository
 	epochNotifier             process.EpochNotifier
 	txSimulatorProcessorArgs  *txsimulator.ArgsTxSimulator
@@ -206,6 +209,7 @@ func NewProcessComponentsFactoryArgs(
 	rater sharding.PeerAccountListAndRatingHandler,
 	sizeCheckDelta uint32,
 	stateCheckpointModulus uint,
+	epochStartProcessingLag uint64,
 	maxComputableRounds uint64,
 	numConcurrentResolverJobs int32,
 	minSizeInBytes uint32,
@@ -220,6 +224,7 @@ func NewProcessComponentsFactoryArgs(
 	workingDir string,
 	indexer indexer.Indexer,
 	tpsBenchmark statistics.TPSBenchmark,
+	crossShardStatistics statistics.CrossShardStatisticsHandler,
 	historyRepo dblookupext.HistoryRepository,
 	epochNotifier process.EpochNotifier,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
@@ -254,6 +259,7 @@ func NewProcessComponentsFactoryArgs(
 		ratingsData:               ratingsData,
 		sizeCheckDelta:            sizeCheckDelta,
 		stateCheckpointModulus:    stateCheckpointModulus,
+		epochStartProcessingLag:   epochStartProcessingLag,
 		maxComputableRounds:       maxComputableRounds,
 		numConcurrentResolverJobs: numConcurrentResolverJobs,
 		minSizeInBytes:            minSizeInBytes,
@@ -267,6 +273,7 @@ func NewProcessComponentsFactoryArgs(
 		workingDir:                workingDir,
 		indexer:                   indexer,
 		tpsBenchmark:              tpsBenchmark,
+		crossShardStatistics:      crossShardStatistics,
 		historyRepo:               historyRepo,
 		epochNotifier:             epochNotifier,
 		txSimulatorProcessorArgs:  txSimulatorProcessorArgs,
@@ -279,13 +286,14 @@ func NewProcessComponentsFactoryArgs(
 // ProcessComponentsFactory creates the process components
 func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, error) {
 	argsHeaderSig := &headerCheck.ArgsHeaderSigVerifier{
-		Marshalizer:             args.coreData.InternalMarshalizer,
-		Hasher:                  args.coreData.Hasher,
-		NodesCoordinator:        args.nodesCoordinator,
-		MultiSigVerifier:        args.crypto.MultiSigner,
-		SingleSigVerifier:       args.crypto.SingleSigner,
-		KeyGen:                  args.crypto.BlockSignKeyGen,
-		FallbackHeaderValidator: args.fallbackHeaderValidator,
+		Marshalizer:               args.coreData.InternalMarshalizer,
+		Hasher:                    args.coreData.Hasher,
+		NodesCoordinator:          args.nodesCoordinator,
+		MultiSigVerifier:          args.crypto.MultiSigner,
+		SingleSigVerifier:         args.crypto.SingleSigner,
+		KeyGen:                    args.crypto.BlockSignKeyGen,
+		FallbackHeaderValidator:   args.fallbackHeaderValidator,
+		FallbackLeaderEnableEpoch: args.mainConfig.GeneralSettings.FallbackLeaderEnableEpoch,
 	}
 	headerSigVerifier, err := headerCheck.NewHeaderSigVerifier(argsHeaderSig)
 	if err != nil {
@@ -377,7 +385,7 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		return nil, err
 	}
 
-	validatorStatisticsProcessor, err := newValidatorStatisticsProcessor(args)
+	validatorStatisticsProcessor, err := newValidatorStatisticsProcessor(args, headerSigVerifier)
 	if err != nil {
 		return nil, err
 	}
@@ -1432,6 +1440,7 @@ func newBlockProcessor(
 			bootStorer,
 			processArgs.gasSchedule,
 			processArgs.stateCheckpointModulus,
+			processArgs.epochStartProcessingLag,
 			headerValidator,
 			blockTracker,
 			processArgs.minSizeInBytes,
@@ -1446,6 +1455,7 @@ func newBlockProcessor(
 			txSimulatorProcessorArgs,
 			processArgs.mainConfig,
 			workingDir,
+			processArgs.accountsParser.HasVestingSchedules(),
 		)
 	}
 	if shardCoordinator.SelfId() == core.MetachainShardId {
@@ -1466,6 +1476,7 @@ func newBlockProcessor(
 			blockTracker,
 			pendingMiniBlocksHandler,
 			processArgs.stateCheckpointModulus,
+			processArgs.epochStartProcessingLag,
 			processArgs.crypto.MessageSignVerifier,
 			processArgs.gasSchedule,
 			processArgs.minSizeInBytes,
@@ -1476,6 +1487,7 @@ func newBlockProcessor(
 			processArgs.systemSCConfig,
 			processArgs.indexer,
 			processArgs.tpsBenchmark,
+			processArgs.crossShardStatistics,
 			headerIntegrityVerifier,
 			processArgs.historyRepo,
 			processArgs.epochNotifier,
@@ -1483,6 +1495,7 @@ func newBlockProcessor(
 			processArgs.mainConfig,
 			workingDir,
 			processArgs.rater,
+			processArgs.accountsParser.HasVestingSchedules(),
 		)
 	}
 
@@ -1504,6 +1517,7 @@ func newShardBlockProcessor(
 	bootStorer process.BootStorer,
 	gasSchedule core.GasScheduleNotifier,
 	stateCheckpointModulus uint,
+	epochStartProcessingLag uint64,
 	headerValidator process.HeaderConstructionValidator,
 	blockTracker process.BlockTracker,
 	minSizeInBytes uint32,
@@ -1518,6 +1532,7 @@ func newShardBlockProcessor(
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	generalConfig config.Config,
 	workingDir string,
+	hasVestingSchedules bool,
 ) (process.BlockProcessor, error) {
 	argsParser := smartContract.NewArgumentParser()
 
@@ -1527,10 +1542,12 @@ func newShardBlockProcessor(
 	}
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasSchedule,
-		MapDNSAddresses: mapDNSAddresses,
-		Marshalizer:     core.InternalMarshalizer,
-		Accounts:        stateComponents.AccountsAdapter,
+		GasSchedule:                         gasSchedule,
+		MapDNSAddresses:                     mapDNSAddresses,
+		Marshalizer:                         core.InternalMarshalizer,
+		Accounts:                            stateComponents.AccountsAdapter,
+		EpochNotifier:                       epochNotifier,
+		DeveloperRewardsOverrideEnableEpoch: generalConfig.GeneralSettings.DeveloperRewardsOverrideEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -1578,6 +1595,14 @@ func newShardBlockProcessor(
 		return nil, err
 	}
 
+	chargingEpochHandler, err := processEconomics.NewChargingEpochHolder(
+		generalConfig.GeneralSettings.ChargingEpochInMiniBlocksEnableEpoch,
+		epochNotifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	interimProcFactory, err := shard.NewIntermediateProcessorsContainerFactory(
 		shardCoordinator,
 		core.InternalMarshalizer,
@@ -1585,6 +1610,7 @@ func newShardBlockProcessor(
 		stateComponents.AddressPubkeyConverter,
 		data.Store,
 		data.Datapool,
+		chargingEpochHandler,
 	)
 	if err != nil {
 		return nil, err
@@ -1656,7 +1682,9 @@ func newShardBlockProcessor(
 		DeployEnableEpoch:              config.GeneralSettings.SCDeployEnableEpoch,
 		BuiltinEnableEpoch:             config.GeneralSettings.BuiltInFunctionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: config.GeneralSettings.PenalizedTooMuchGasEnableEpoch,
+		DevRewardsOverrideEnableEpoch:  config.GeneralSettings.DeveloperRewardsOverrideEnableEpoch,
 		BadTxForwarder:                 badTxInterim,
+		ChargingEpochHandler:           chargingEpochHandler,
 		EpochNotifier:                  epochNotifier,
 	}
 	scProcessor, err := smartContract.NewSmartContractProcessor(argsNewScProcessor)
@@ -1692,13 +1720,23 @@ func newShardBlockProcessor(
 		PenalizedTooMuchGasEnableEpoch: config.GeneralSettings.PenalizedTooMuchGasEnableEpoch,
 		MetaProtectionEnableEpoch:      config.GeneralSettings.MetaProtectionEnableEpoch,
 		EpochNotifier:                  epochNotifier,
+		HasVestingSchedules:            hasVestingSchedules,
 	}
 	transactionProcessor, err := transaction.NewTxProcessor(argsNewTxProcessor)
 	if err != nil {
 		return nil, errors.New("could not create transaction statisticsProcessor: " + err.Error())
 	}
 
-	err = createShardTxSimulatorProcessor(argsNewScProcessor, argsNewTxProcessor, shardCoordinator, data, core, stateComponents, txSimulatorProcessorArgs)
+	err = createShardTxSimulatorProcessor(
+		argsNewScProcessor,
+		argsNewTxProcessor,
+		shardCoordinator,
+		data,
+		core,
+		stateComponents,
+		txSimulatorProcessorArgs,
+		generalConfig.GeneralSettings.ChargingEpochInMiniBlocksEnableEpoch,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1736,6 +1774,7 @@ func newShardBlockProcessor(
 		blockTracker,
 		blockSizeComputationHandler,
 		balanceComputationHandler,
+		chargingEpochHandler,
 	)
 	if err != nil {
 		return nil, err
@@ -1788,6 +1827,7 @@ func newShardBlockProcessor(
 		FeeHandler:              txFeeHandler,
 		BlockChain:              data.Blkc,
 		StateCheckpointModulus:  stateCheckpointModulus,
+		EpochStartProcessingLag: epochStartProcessingLag,
 		BlockSizeThrottler:      blockSizeThrottler,
 		Indexer:                 indexer,
 		TpsBenchmark:            tpsBenchmark,
@@ -1829,6 +1869,7 @@ func newMetaBlockProcessor(
 	blockTracker process.BlockTracker,
 	pendingMiniBlocksHandler process.PendingMiniBlocksHandler,
 	stateCheckpointModulus uint,
+	epochStartProcessingLag uint64,
 	messageSignVerifier vm.MessageSignVerifier,
 	gasSchedule core.GasScheduleNotifier,
 	minSizeInBytes uint32,
@@ -1839,6 +1880,7 @@ func newMetaBlockProcessor(
 	systemSCConfig *config.SystemSmartContractsConfig,
 	indexer indexer.Indexer,
 	tpsBenchmark statistics.TPSBenchmark,
+	crossShardStatistics statistics.CrossShardStatisticsHandler,
 	headerIntegrityVerifier HeaderIntegrityVerifierHandler,
 	historyRepository dblookupext.HistoryRepository,
 	epochNotifier process.EpochNotifier,
@@ -1846,13 +1888,16 @@ func newMetaBlockProcessor(
 	generalConfig config.Config,
 	workingDir string,
 	rater sharding.PeerAccountListAndRatingHandler,
+	hasVestingSchedules bool,
 ) (process.BlockProcessor, error) {
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasSchedule,
-		MapDNSAddresses: make(map[string]struct{}), // no dns for meta
-		Marshalizer:     core.InternalMarshalizer,
-		Accounts:        stateComponents.AccountsAdapter,
+		GasSchedule:                         gasSchedule,
+		MapDNSAddresses:                     make(map[string]struct{}), // no dns for meta
+		Marshalizer:                         core.InternalMarshalizer,
+		Accounts:                            stateComponents.AccountsAdapter,
+		EpochNotifier:                       epochNotifier,
+		DeveloperRewardsOverrideEnableEpoch: generalConfig.GeneralSettings.DeveloperRewardsOverrideEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -1883,12 +1928,18 @@ func newMetaBlockProcessor(
 		MessageSignVerifier: messageSignVerifier,
 		GasSchedule:         gasSchedule,
 		NodesConfigProvider: nodesSetup,
+		NodesCoordinator:    nodesCoordinator,
 		Hasher:              core.Hasher,
 		Marshalizer:         core.InternalMarshalizer,
 		SystemSCConfig:      systemSCConfig,
 		ValidatorAccountsDB: stateComponents.PeerAccounts,
 		ChanceComputer:      rater,
 		EpochNotifier:       epochNotifier,
+		RoundDurationChange: vm.RoundDurationChange{
+			EnableEpoch:                    generalConfig.GeneralSettings.RoundDurationChangeEnableEpoch,
+			RoundDurationInMilliseconds:    nodesSetup.GetRoundDuration(),
+			NewRoundDurationInMilliseconds: generalConfig.GeneralSettings.NewRoundDurationInMilliseconds,
+		},
 	}
 	vmFactory, err := metachain.NewVMContainerFactory(argsNewVMContainer)
 	if err != nil {
@@ -1902,6 +1953,14 @@ func newMetaBlockProcessor(
 		return nil, err
 	}
 
+	chargingEpochHandler, err := processEconomics.NewChargingEpochHolder(
+		generalConfig.GeneralSettings.ChargingEpochInMiniBlocksEnableEpoch,
+		epochNotifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	interimProcFactory, err := metachain.NewIntermediateProcessorsContainerFactory(
 		shardCoordinator,
 		core.InternalMarshalizer,
@@ -1909,6 +1968,7 @@ func newMetaBlockProcessor(
 		stateComponents.AddressPubkeyConverter,
 		data.Store,
 		data.Datapool,
+		chargingEpochHandler,
 	)
 	if err != nil {
 		return nil, err
@@ -1975,7 +2035,9 @@ func newMetaBlockProcessor(
 		DeployEnableEpoch:              generalConfig.GeneralSettings.SCDeployEnableEpoch,
 		BuiltinEnableEpoch:             generalConfig.GeneralSettings.BuiltInFunctionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: generalConfig.GeneralSettings.PenalizedTooMuchGasEnableEpoch,
+		DevRewardsOverrideEnableEpoch:  generalConfig.GeneralSettings.DeveloperRewardsOverrideEnableEpoch,
 		BadTxForwarder:                 badTxForwarder,
+		ChargingEpochHandler:           chargingEpochHandler,
 		EpochNotifier:                  epochNotifier,
 	}
 	scProcessor, err := smartContract.NewSmartContractProcessor(argsNewScProcessor)
@@ -1984,16 +2046,17 @@ func newMetaBlockProcessor(
 	}
 
 	argsNewMetaTxProcessor := transaction.ArgsNewMetaTxProcessor{
-		Hasher:           core.Hasher,
-		Marshalizer:      core.InternalMarshalizer,
-		Accounts:         stateComponents.AccountsAdapter,
-		PubkeyConv:       stateComponents.AddressPubkeyConverter,
-		ShardCoordinator: shardCoordinator,
-		ScProcessor:      scProcessor,
-		TxTypeHandler:    txTypeHandler,
-		EconomicsFee:     economicsData,
-		ESDTEnableEpoch:  systemSCConfig.ESDTSystemSCConfig.EnabledEpoch,
-		EpochNotifier:    epochNotifier,
+		Hasher:              core.Hasher,
+		Marshalizer:         core.InternalMarshalizer,
+		Accounts:            stateComponents.AccountsAdapter,
+		PubkeyConv:          stateComponents.AddressPubkeyConverter,
+		ShardCoordinator:    shardCoordinator,
+		ScProcessor:         scProcessor,
+		TxTypeHandler:       txTypeHandler,
+		EconomicsFee:        economicsData,
+		ESDTEnableEpoch:     systemSCConfig.ESDTSystemSCConfig.EnabledEpoch,
+		EpochNotifier:       epochNotifier,
+		HasVestingSchedules: hasVestingSchedules,
 	}
 	transactionProcessor, err := transaction.NewMetaTxProcessor(argsNewMetaTxProcessor)
 	if err != nil {
@@ -2010,6 +2073,8 @@ func newMetaBlockProcessor(
 		txSimulatorProcessorArgs,
 		epochNotifier,
 		systemSCConfig,
+		generalConfig.GeneralSettings.ChargingEpochInMiniBlocksEnableEpoch,
+		hasVestingSchedules,
 	)
 	if err != nil {
 		return nil, err
@@ -2046,6 +2111,7 @@ func newMetaBlockProcessor(
 		stateComponents.AddressPubkeyConverter,
 		blockSizeComputationHandler,
 		balanceComputationHandler,
+		chargingEpochHandler,
 	)
 	if err != nil {
 		return nil, err
@@ -2142,23 +2208,26 @@ func newMetaBlockProcessor(
 	miniBlockStorage := data.Store.GetStorer(dataRetriever.MiniBlockUnit)
 	argsEpochRewards := metachainEpochStart.RewardsCreatorProxyArgs{
 		BaseRewardsCreatorArgs: metachainEpochStart.BaseRewardsCreatorArgs{
-			ShardCoordinator:              shardCoordinator,
-			PubkeyConverter:               stateComponents.AddressPubkeyConverter,
-			RewardsStorage:                rewardsStorage,
-			MiniBlockStorage:              miniBlockStorage,
-			Hasher:                        core.Hasher,
-			Marshalizer:                   core.InternalMarshalizer,
-			DataPool:                      data.Datapool,
-			ProtocolSustainabilityAddress: economicsData.ProtocolSustainabilityAddress(),
-			NodesConfigProvider:           nodesCoordinator,
-			UserAccountsDB:                stateComponents.AccountsAdapter,
-			RewardsFix1EpochEnable:        generalConfig.GeneralSettings.SwitchJailWaitingEnableEpoch,
-			DelegationSystemSCEnableEpoch: systemSCConfig.DelegationSystemSCConfig.EnabledEpoch,
+			ShardCoordinator:                shardCoordinator,
+			PubkeyConverter:                 stateComponents.AddressPubkeyConverter,
+			RewardsStorage:                  rewardsStorage,
+			MiniBlockStorage:                miniBlockStorage,
+			Hasher:                          core.Hasher,
+			Marshalizer:                     core.InternalMarshalizer,
+			DataPool:                        data.Datapool,
+			ProtocolSustainabilityAddress:   economicsData.ProtocolSustainabilityAddress(),
+			ProtocolSustainabilityAddresses: economicsData.ProtocolSustainabilityAddresses(),
+			ProtocolSustainabilityAddressesEnableEpoch: generalConfig.GeneralSettings.ProtocolSustainabilityAddressesEnableEpoch,
+			NodesConfigProvider:                        nodesCoordinator,
+			UserAccountsDB:                             stateComponents.AccountsAdapter,
+			RewardsFix1EpochEnable:                     generalConfig.GeneralSettings.SwitchJailWaitingEnableEpoch,
+			DelegationSystemSCEnableEpoch:              systemSCConfig.DelegationSystemSCConfig.EnabledEpoch,
+			InsuranceFundPercentage:                    systemSCConfig.InsuranceFundSystemSCConfig.RewardsPercentage,
+			InsuranceFundEnableEpoch:                   systemSCConfig.InsuranceFundSystemSCConfig.EnabledEpoch,
 		},
 
 		StakingDataProvider:   stakingDataProvider,
-		TopUpRewardFactor:     economicsData.RewardsTopUpFactor(),
-		TopUpGradientPoint:    economicsData.RewardsTopUpGradientPoint(),
+		TopUpRewardsSettings:  economicsData,
 		EconomicsDataProvider: economicsDataProvider,
 		EpochEnableV2:         systemSCConfig.StakingSystemSCConfig.StakingV2Epoch,
 	}
@@ -2206,6 +2275,7 @@ func newMetaBlockProcessor(
 		FeeHandler:              txFeeHandler,
 		BlockChain:              data.Blkc,
 		StateCheckpointModulus:  stateCheckpointModulus,
+		EpochStartProcessingLag: epochStartProcessingLag,
 		BlockSizeThrottler:      blockSizeThrottler,
 		Indexer:                 indexer,
 		TpsBenchmark:            tpsBenchmark,
@@ -2228,6 +2298,7 @@ func newMetaBlockProcessor(
 		SwitchHysteresisForMinNodesEnableEpoch: generalConfig.GeneralSettings.SwitchHysteresisForMinNodesEnableEpoch,
 		DelegationEnableEpoch:                  systemSCConfig.DelegationManagerSystemSCConfig.EnabledEpoch,
 		StakingV2EnableEpoch:                   systemSCConfig.StakingSystemSCConfig.StakingV2Epoch,
+		SwapNodeEnableEpoch:                    systemSCConfig.StakingSystemSCConfig.SwapNodeEnableEpoch,
 		GenesisNodesConfig:                     nodesSetup,
 		MaxNodesEnableConfig:                   generalConfig.GeneralSettings.MaxNodesChangeEnableEpoch,
 		StakingDataProvider:                    stakingDataProvider,
@@ -2239,6 +2310,11 @@ func newMetaBlockProcessor(
 		return nil, err
 	}
 
+	err = epochStartSystemSCProcessor.SetAppStatusHandler(core.StatusHandler)
+	if err != nil {
+		return nil, err
+	}
+
 	arguments := block.ArgMetaProcessor{
 		ArgBaseProcessor:             argumentsBaseProcessor,
 		SCToProtocol:                 smartContractToProtocol,
@@ -2249,7 +2325,9 @@ func newMetaBlockProcessor(
 		EpochValidatorInfoCreator:    validatorInfoCreator,
 		ValidatorStatisticsProcessor: validatorStatisticsProcessor,
 		EpochSystemSCProcessor:       epochStartSystemSCProcessor,
+		CrossShardStatistics:         crossShardStatistics,
 		RewardsV2EnableEpoch:         systemSCConfig.StakingSystemSCConfig.StakingV2Epoch,
+		RewardsCheckpointEnableEpoch: generalConfig.GeneralSettings.RewardsCheckpointEnableEpoch,
 	}
 
 	metaProcessor, err := block.NewMetaProcessor(arguments)
@@ -2273,12 +2351,19 @@ func createShardTxSimulatorProcessor(
 	core *mainFactory.CoreComponents,
 	stateComponents *mainFactory.StateComponents,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
+	chargingEpochEnableEpoch uint32,
 ) error {
 	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(stateComponents.AccountsAdapter)
 	if err != nil {
 		return err
 	}
 
+	chargingEpochHandler, err := processEconomics.NewChargingEpochHolder(chargingEpochEnableEpoch, scProcArgs.EpochNotifier)
+	if err != nil {
+		return err
+	}
+	scProcArgs.ChargingEpochHandler = chargingEpochHandler
+
 	interimProcFactory, err := shard.NewIntermediateProcessorsContainerFactory(
 		shardCoordinator,
 		core.InternalMarshalizer,
@@ -2286,6 +2371,7 @@ func createShardTxSimulatorProcessor(
 		stateComponents.AddressPubkeyConverter,
 		disabled.NewChainStorer(),
 		data.Datapool,
+		chargingEpochHandler,
 	)
 	if err != nil {
 		return err
@@ -2334,6 +2420,8 @@ func createShardTxSimulatorProcessor(
 	}
 
 	txSimulatorProcessorArgs.IntermmediateProcContainer = interimProcContainer
+	txSimulatorProcessorArgs.Accounts = readOnlyAccountsDB
+	txSimulatorProcessorArgs.Marshalizer = core.InternalMarshalizer
 
 	return nil
 }
@@ -2348,7 +2436,15 @@ func createMetaTxSimulatorProcessor(
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	epochNotifier process.EpochNotifier,
 	systemSCConfig *config.SystemSmartContractsConfig,
+	chargingEpochEnableEpoch uint32,
+	hasVestingSchedules bool,
 ) error {
+	chargingEpochHandler, err := processEconomics.NewChargingEpochHolder(chargingEpochEnableEpoch, scProcArgs.EpochNotifier)
+	if err != nil {
+		return err
+	}
+	scProcArgs.ChargingEpochHandler = chargingEpochHandler
+
 	interimProcFactory, err := shard.NewIntermediateProcessorsContainerFactory(
 		shardCoordinator,
 		core.InternalMarshalizer,
@@ -2356,6 +2452,7 @@ func createMetaTxSimulatorProcessor(
 		stateComponents.AddressPubkeyConverter,
 		disabled.NewChainStorer(),
 		data.Datapool,
+		chargingEpochHandler,
 	)
 	if err != nil {
 		return err
@@ -2380,27 +2477,29 @@ func createMetaTxSimulatorProcessor(
 
 	scProcArgs.TxFeeHandler = &processDisabled.FeeHandler{}
 
-	scProcessor, err := smartContract.NewSmartContractProcessor(scProcArgs)
+	accountsWrapper, err := txsimulator.NewReadOnlyAccountsDB(stateComponents.AccountsAdapter)
 	if err != nil {
 		return err
 	}
+	scProcArgs.AccountsDB = accountsWrapper
 
-	accountsWrapper, err := txsimulator.NewReadOnlyAccountsDB(stateComponents.AccountsAdapter)
+	scProcessor, err := smartContract.NewSmartContractProcessor(scProcArgs)
 	if err != nil {
 		return err
 	}
 
 	argsNewMetaTx := transaction.ArgsNewMetaTxProcessor{
-		Hasher:           core.Hasher,
-		Marshalizer:      core.InternalMarshalizer,
-		Accounts:         accountsWrapper,
-		PubkeyConv:       stateComponents.AddressPubkeyConverter,
-		ShardCoordinator: shardCoordinator,
-		ScProcessor:      scProcessor,
-		TxTypeHandler:    txTypeHandler,
-		EconomicsFee:     &processDisabled.FeeHandler{},
-		ESDTEnableEpoch:  systemSCConfig.ESDTSystemSCConfig.EnabledEpoch,
-		EpochNotifier:    epochNotifier,
+		Hasher:              core.Hasher,
+		Marshalizer:         core.InternalMarshalizer,
+		Accounts:            accountsWrapper,
+		PubkeyConv:          stateComponents.AddressPubkeyConverter,
+		ShardCoordinator:    shardCoordinator,
+		ScProcessor:         scProcessor,
+		TxTypeHandler:       txTypeHandler,
+		EconomicsFee:        &processDisabled.FeeHandler{},
+		ESDTEnableEpoch:     systemSCConfig.ESDTSystemSCConfig.EnabledEpoch,
+		EpochNotifier:       epochNotifier,
+		HasVestingSchedules: hasVestingSchedules,
 	}
 	txSimulatorProcessorArgs.TransactionProcessor, err = transaction.NewMetaTxProcessor(argsNewMetaTx)
 	if err != nil {
@@ -2408,12 +2507,15 @@ func createMetaTxSimulatorProcessor(
 	}
 
 	txSimulatorProcessorArgs.IntermmediateProcContainer = interimProcContainer
+	txSimulatorProcessorArgs.Accounts = accountsWrapper
+	txSimulatorProcessorArgs.Marshalizer = core.InternalMarshalizer
 
 	return nil
 }
 
 func newValidatorStatisticsProcessor(
 	processComponents *processComponentsFactoryArgs,
+	proposerIdentifier process.ProposerIdentifier,
 ) (process.ValidatorStatisticsProcessor, error) {
 
 	storageService := processComponents.data.Store
@@ -2440,12 +2542,14 @@ func newValidatorStatisticsProcessor(
 		Rater:                           processComponents.rater,
 		MaxComputableRounds:             processComponents.maxComputableRounds,
 		RewardsHandler:                  processComponents.economicsData,
+		ProposerIdentifier:              proposerIdentifier,
 		NodesSetup:                      processComponents.nodesConfig,
 		RatingEnableEpoch:               ratingEnabledEpoch,
 		GenesisNonce:                    processComponents.data.Blkc.GetGenesisHeader().GetNonce(),
 		EpochNotifier:                   processComponents.epochNotifier,
 		SwitchJailWaitingEnableEpoch:    processComponents.mainConfig.GeneralSettings.SwitchJailWaitingEnableEpoch,
 		BelowSignedThresholdEnableEpoch: processComponents.mainConfig.GeneralSettings.BelowSignedThresholdEnableEpoch,
+		RatingsV2EnableEpoch:            processComponents.mainConfig.GeneralSettings.RatingsV2EnableEpoch,
 	}
 
 	validatorStatisticsProcessor, err := peer.NewValidatorStatisticsProcessor(arguments)
@@ -2464,12 +2568,12 @@ func PrepareOpenTopics(
 ) {
 	selfID := shardCoordinator.SelfId()
 	if selfID == core.MetachainShardId {
-		antiflood.SetTopicsForAll(core.HeartbeatTopic)
+		antiflood.SetTopicsForAll(core.PeerAuthenticationTopic, core.HeartbeatV2Topic)
 		return
 	}
 
 	selfShardTxTopic := factory.TransactionTopic + core.CommunicationIdentifierBetweenShards(selfID, selfID)
-	antiflood.SetTopicsForAll(core.HeartbeatTopic, selfShardTxTopic)
+	antiflood.SetTopicsForAll(core.PeerAuthenticationTopic, core.HeartbeatV2Topic, selfShardTxTopic)
 }
 
 // PrepareNetworkShardingCollector will create the network sharding collector and apply it to
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"bytes"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -19,9 +21,16 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
 	"github.com/ElrondNetwork/elrond-go/cmd/node/metrics"
+	"github.com/ElrondNetwork/elrond-go/cmd/node/reload"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/equivocation"
+	equivocationDisabled "github.com/ElrondNetwork/elrond-go/consensus/equivocation/disabled"
+	"github.com/ElrondNetwork/elrond-go/consensus/participation"
+	"github.com/ElrondNetwork/elrond-go/consensus/redundancy"
 	"github.com/ElrondNetwork/elrond-go/consensus/round"
+	"github.com/ElrondNetwork/elrond-go/consensus/timing"
+	timingDisabled "github.com/ElrondNetwork/elrond-go/consensus/timing/disabled"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/accumulator"
 	"github.com/ElrondNetwork/elrond-go/core/alarm"
@@ -32,36 +41,56 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/forking"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	indexerFactory "github.com/ElrondNetwork/elrond-go/core/indexer/factory"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/lightTopics"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/push"
+	"github.com/ElrondNetwork/elrond-go/core/keystore"
 	"github.com/ElrondNetwork/elrond-go/core/logging"
 	"github.com/ElrondNetwork/elrond-go/core/parsers"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/versioning"
 	"github.com/ElrondNetwork/elrond-go/core/watchdog"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/remoteSigner"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/thresholdsig"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	trieFactory "github.com/ElrondNetwork/elrond-go/data/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	debugFactory "github.com/ElrondNetwork/elrond-go/debug/factory"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap"
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	mainFactory "github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/fallback"
+	"github.com/ElrondNetwork/elrond-go/genesis/checking"
 	"github.com/ElrondNetwork/elrond-go/genesis/parsing"
 	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
 	"github.com/ElrondNetwork/elrond-go/health"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/delegationAPI"
+	"github.com/ElrondNetwork/elrond-go/node/esdtTokensAPI"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/nodeDebugFactory"
+	"github.com/ElrondNetwork/elrond-go/node/stakingQueueAPI"
+	"github.com/ElrondNetwork/elrond-go/node/stakingRewardsAPI"
 	"github.com/ElrondNetwork/elrond-go/node/totalStakedAPI"
 	"github.com/ElrondNetwork/elrond-go/node/txsimulator"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/partition"
+	partitionDisabled "github.com/ElrondNetwork/elrond-go/partition/disabled"
 	"github.com/ElrondNetwork/elrond-go/process"
+	blockDisabled "github.com/ElrondNetwork/elrond-go/process/block/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
@@ -96,10 +125,13 @@ const (
 	defaultLogsPath              = "logs"
 	logFilePrefix                = "elrond-go"
 	notSetDestinationShardID     = "disabled"
+	autoDestinationShardID       = "auto"
+	observerShardDecisionFile    = "observerShard.json"
 	metachainShardName           = "metachain"
 	secondsToWaitForP2PBootstrap = 20
 	maxTimeToClose               = 10 * time.Second
 	maxMachineIDLen              = 10
+	numEpochsOfParticipation     = 10
 )
 
 var (
@@ -111,6 +143,9 @@ USAGE:
 AUTHOR:
    {{range .Authors}}{{ . }}{{end}}
    {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .VisibleCommands}}{{join .Names ", "}}{{"\t"}}{{.Usage}}
+   {{end}}
 GLOBAL OPTIONS:
    {{range .VisibleFlags}}{{.}}
    {{end}}
@@ -123,7 +158,8 @@ VERSION:
 	genesisFile = cli.StringFlag{
 		Name: "genesis-file",
 		Usage: "The `" + filePathPlaceholder + "` for the genesis file. This JSON file contains initial data to " +
-			"bootstrap from, such as initial balances for accounts.",
+			"bootstrap from, such as initial balances for accounts. Large genesis files can be provided as CSV (.csv) " +
+			"or newline-delimited JSON (.ndjson, .jsonl) files, holding one account on each line.",
 		Value: "./config/genesis.json",
 	}
 	// smartContractsFile defines a flag for the path of the file containing initial smart contracts.
@@ -288,9 +324,25 @@ VERSION:
 	// validatorKeyPemFile defines a flag for the path to the validator key used in block signing
 	validatorKeyPemFile = cli.StringFlag{
 		Name:  "validator-key-pem-file",
-		Usage: "The `filepath` for the PEM file which contains the secret keys for the validator key.",
+		Usage: "The `filepath` for the PEM file which contains the secret keys for the validator key. The file can " +
+			"also be an encrypted keystore, created with the keystore tool.",
 		Value: "./config/validatorKey.pem",
 	}
+	// allValidatorsKeysPemFile defines a flag for the path to the validator keys managed by the node, besides its own key
+	allValidatorsKeysPemFile = cli.StringFlag{
+		Name: "all-validator-keys-pem-file",
+		Usage: "The `filepath` for the PEM file which contains the secret keys of all the validator keys managed " +
+			"by the node, besides its own validator key. A missing file means that the node only operates its own key.",
+		Value: "./config/allValidatorsKeys.pem",
+	}
+	// keystorePassphraseFile defines a flag for the path to the file holding the passphrase of the encrypted keystores
+	keystorePassphraseFile = cli.StringFlag{
+		Name: "keystore-passphrase-file",
+		Usage: "The `filepath` for the file holding the passphrase of the encrypted validator keys. When not provided, " +
+			"the passphrase is read from the " + keystore.PassphraseEnvVariable + " environment variable or, " +
+			"if not set, from a prompt.",
+		Value: "",
+	}
 	// elasticSearchTemplates defines a flag for the path to the elasticsearch templates
 	elasticSearchTemplates = cli.StringFlag{
 		Name:  "elasticsearch-templates-path",
@@ -354,6 +406,14 @@ VERSION:
 			"and will have a full history over epochs.",
 	}
 
+	// forceObserverShardReselection defines a flag for selecting again the least loaded shard of an observer started
+	// with the automatic destination shard, ignoring the persisted decision
+	forceObserverShardReselection = cli.BoolFlag{
+		Name: "force-observer-shard-reselection",
+		Usage: "Boolean option for probing the network again and selecting the least loaded shard when the destination " +
+			"shard as observer is \"auto\", ignoring the shard selected on a previous start.",
+	}
+
 	startInEpoch = cli.BoolFlag{
 		Name: "start-in-epoch",
 		Usage: "Boolean option for enabling a node the fast bootstrap mechanism from the network." +
@@ -416,6 +476,9 @@ func main() {
 		gasScheduleConfigurationDirectory,
 		validatorKeyIndex,
 		validatorKeyPemFile,
+		allValidatorsKeysPemFile,
+		keystorePassphraseFile,
+		rotatedValidatorKeyFile,
 		port,
 		profileMode,
 		useHealthService,
@@ -435,6 +498,7 @@ func main() {
 		bootstrapRoundIndex,
 		workingDirectory,
 		destinationShardAsObserver,
+		forceObserverShardReselection,
 		keepOldEpochsData,
 		startInEpoch,
 		importDbDirectory,
@@ -450,6 +514,9 @@ func main() {
 	app.Action = func(c *cli.Context) error {
 		return startNode(c, log, app.Version)
 	}
+	app.Commands = []cli.Command{
+		rotateKeyCommand,
+	}
 
 	err = app.Run(os.Args)
 	if err != nil {
@@ -616,6 +683,12 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 		nodesSetupPath = exportFolderNodesSetupPath
 	}
+
+	err = checkGenesisFiles(ctx, generalConfig, systemSCConfig, nodesSetupPath, totalSupply, addressPubkeyConverter, validatorPubkeyConverter)
+	if err != nil {
+		return err
+	}
+
 	genesisNodesConfig, err := sharding.NewNodesSetup(
 		nodesSetupPath,
 		addressPubkeyConverter,
@@ -667,21 +740,35 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
-	validatorKeyPemFileName := ctx.GlobalString(validatorKeyPemFile.Name)
-	cryptoParamsLoader, err := mainFactory.NewCryptoSigningParamsLoader(
-		validatorPubkeyConverter,
-		ctx.GlobalInt(validatorKeyIndex.Name),
-		validatorKeyPemFileName,
-		suite,
-		isInImportMode,
-	)
-	if err != nil {
-		return err
-	}
+	passphraseProvider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+		PassphraseFile: ctx.GlobalString(keystorePassphraseFile.Name),
+		EnvVariable:    keystore.PassphraseEnvVariable,
+	})
 
-	cryptoParams, err := cryptoParamsLoader.Get()
-	if err != nil {
-		return fmt.Errorf("%w: consider regenerating your keys", err)
+	var cryptoParams *mainFactory.CryptoParams
+	if generalConfig.RemoteSigner.Enabled && !isInImportMode {
+		cryptoParams, err = loadRemoteSignerCryptoParams(generalConfig.RemoteSigner, validatorPubkeyConverter, suite, log)
+		if err != nil {
+			return err
+		}
+	} else {
+		validatorKeyPemFileName := ctx.GlobalString(validatorKeyPemFile.Name)
+		cryptoParamsLoader, errLoader := mainFactory.NewCryptoSigningParamsLoader(
+			validatorPubkeyConverter,
+			ctx.GlobalInt(validatorKeyIndex.Name),
+			validatorKeyPemFileName,
+			passphraseProvider,
+			suite,
+			isInImportMode,
+		)
+		if errLoader != nil {
+			return errLoader
+		}
+
+		cryptoParams, err = cryptoParamsLoader.Get()
+		if err != nil {
+			return fmt.Errorf("%w: consider regenerating your keys", err)
+		}
 	}
 
 	log.Debug("block sign pubkey", "value", cryptoParams.PublicKeyString)
@@ -731,6 +818,16 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	}
 	var shardId = core.GetShardIDString(genesisShardCoordinator.SelfId())
 
+	managedPrivateKeys, err := loadManagedPrivateKeys(ctx.GlobalString(allValidatorsKeysPemFile.Name), passphraseProvider, isInImportMode, log)
+	if err != nil {
+		return err
+	}
+
+	managedRemoteKeys, err := loadThresholdKeys(generalConfig.ThresholdSigner, validatorPubkeyConverter, cryptoParams.KeyGenerator, isInImportMode, log)
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating crypto components")
 	cryptoArgs := mainFactory.CryptoComponentsFactoryArgs{
 		Config:                               *generalConfig,
@@ -739,6 +836,8 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		KeyGen:                               cryptoParams.KeyGenerator,
 		PrivKey:                              cryptoParams.PrivateKey,
 		ActivateBLSPubKeyMessageVerification: systemSCConfig.StakingSystemSCConfig.ActivateBLSPubKeyMessageVerification,
+		ManagedPrivateKeys:                   managedPrivateKeys,
+		ManagedRemoteKeys:                    managedRemoteKeys,
 	}
 	cryptoComponentsFactory, err := mainFactory.NewCryptoComponentsFactory(cryptoArgs, importDbNoSigCheckFlag)
 	if err != nil {
@@ -862,6 +961,14 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	pinnedValidators, err := sharding.DecodePinnedValidators(
+		generalConfig.GeneralSettings.PinnedShards,
+		validatorPubkeyConverter,
+	)
+	if err != nil {
+		return err
+	}
+
 	argsNodesShuffler := &sharding.NodesShufflerArgs{
 		NodesShard:           genesisNodesConfig.MinNodesPerShard,
 		NodesMeta:            genesisNodesConfig.MetaChainMinNodes,
@@ -869,6 +976,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		Adaptivity:           genesisNodesConfig.Adaptivity,
 		ShuffleBetweenShards: true,
 		MaxNodesEnableConfig: generalConfig.GeneralSettings.MaxNodesChangeEnableEpoch,
+		PinnedValidators:     pinnedValidators,
 	}
 
 	nodesShuffler, err := sharding.NewHashValidatorsShuffler(argsNodesShuffler)
@@ -880,6 +988,19 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	if err != nil {
 		return err
 	}
+	isAutoDestinationShard := strings.ToLower(preferencesConfig.Preferences.DestinationShardAsObserver) == autoDestinationShardID
+	if nodeType == core.NodeTypeObserver && isAutoDestinationShard {
+		destShardIdAsObserver, err = selectObserverShard(
+			networkComponents.NetMessenger,
+			genesisNodesConfig.NumberOfShards(),
+			preferencesConfig.Preferences,
+			filepath.Join(workingDir, observerShardDecisionFile),
+			ctx.GlobalBool(forceObserverShardReselection.Name),
+		)
+		if err != nil {
+			return err
+		}
+	}
 
 	startRound := int64(0)
 	if generalConfig.Hardfork.AfterHardFork {
@@ -1047,6 +1168,20 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	err = registerRoundDurationChangeHandler(
+		generalConfig,
+		genesisNodesConfig,
+		rounder,
+		dataComponents,
+		coreComponents,
+		epochStartNotifier,
+		startRound,
+		currentEpoch,
+	)
+	if err != nil {
+		return err
+	}
+
 	healthService.RegisterComponent(dataComponents.Datapool.Transactions())
 	healthService.RegisterComponent(dataComponents.Datapool.UnsignedTransactions())
 	healthService.RegisterComponent(dataComponents.Datapool.RewardTransactions())
@@ -1095,6 +1230,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		dataComponents.Store.GetStorer(dataRetriever.BootstrapUnit),
 		nodesShuffler,
 		generalConfig.EpochStartConfig,
+		generalConfig.ConsensusGroupCache,
 		shardCoordinator.SelfId(),
 		chanStopNodeProcess,
 		bootstrapParameters,
@@ -1104,6 +1240,19 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	err = registerRotatedKeyWatcher(
+		ctx.GlobalString(rotatedValidatorKeyFile.Name),
+		cryptoParams.PublicKeyBytes,
+		passphraseProvider,
+		cryptoComponents.ManagedPeersHolder,
+		nodesCoordinator,
+		epochStartNotifier,
+		isInImportMode,
+	)
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating state components")
 	stateArgs := mainFactory.StateComponentsFactoryArgs{
 		Config:           *generalConfig,
@@ -1186,7 +1335,15 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
-	elasticIndexer, err := createElasticIndexer(
+	crossShardStatistics, err := statistics.NewCrossShardStatistics(
+		statusHandlersInfo.StatusHandler,
+		genesisNodesConfig.RoundDuration,
+	)
+	if err != nil {
+		return err
+	}
+
+	dbIndexer, err := createElasticIndexer(
 		externalConfig.ElasticSearchConnector,
 		coreComponents.InternalMarshalizer,
 		coreComponents.Hasher,
@@ -1200,11 +1357,108 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		economicsData,
 		isInImportMode,
 		ctx.GlobalString(elasticSearchTemplates.Name),
+		workingDir,
+	)
+	if err != nil {
+		return err
+	}
+
+	postgresDriver, err := createPostgresDriver(
+		externalConfig.PostgreSQLConnector,
+		coreComponents.InternalMarshalizer,
+		coreComponents.Hasher,
+		addressPubkeyConverter,
+		shardCoordinator,
+		stateComponents.AccountsAdapter,
+		workingDir,
+		dbIndexer.IsNilIndexer(),
+	)
+	if err != nil {
+		return err
+	}
+
+	kafkaDriver, err := createKafkaDriver(
+		externalConfig.KafkaConnector,
+		coreComponents.InternalMarshalizer,
+		coreComponents.Hasher,
+		addressPubkeyConverter,
+		shardCoordinator,
+		workingDir,
+		dbIndexer.IsNilIndexer() && postgresDriver.IsNilIndexer(),
+	)
+	if err != nil {
+		return err
+	}
+
+	outportPlugins, err := createOutportPlugins(
+		externalConfig.OutportPlugins,
+		coreComponents.InternalMarshalizer,
+		coreComponents.Hasher,
+		addressPubkeyConverter,
+		shardCoordinator,
+		workingDir,
+		dbIndexer.IsNilIndexer() && postgresDriver.IsNilIndexer() && kafkaDriver.IsNilIndexer(),
+	)
+	if err != nil {
+		return err
+	}
+	noOutportPlugins := len(outportPlugins) == 0
+
+	grpcDriver, err := createGRPCDriver(
+		externalConfig.GRPCConnector,
+		coreComponents.InternalMarshalizer,
+		coreComponents.Hasher,
+		addressPubkeyConverter,
+		shardCoordinator,
+		workingDir,
+		dbIndexer.IsNilIndexer() && postgresDriver.IsNilIndexer() && kafkaDriver.IsNilIndexer() && noOutportPlugins,
+	)
+	if err != nil {
+		return err
+	}
+
+	pushNotifier, err := createPushNotifier(
+		externalConfig.PushNotifier,
+		addressPubkeyConverter,
+		workingDir,
+		dbIndexer.IsNilIndexer() && postgresDriver.IsNilIndexer() && kafkaDriver.IsNilIndexer() && noOutportPlugins &&
+			grpcDriver.IsNilIndexer(),
+	)
+	if err != nil {
+		return err
+	}
+
+	lightNotifier, err := createLightTopicsNotifier(
+		externalConfig.LightTopicsNotifier,
+		networkComponents.NetMessenger,
+		nodeType,
+		dbIndexer.IsNilIndexer() && postgresDriver.IsNilIndexer() && kafkaDriver.IsNilIndexer() && noOutportPlugins &&
+			grpcDriver.IsNilIndexer() && pushNotifier.IsNilIndexer(),
+		log,
 	)
 	if err != nil {
 		return err
 	}
 
+	// the notifiers are placed first so they can read the transaction logs before the database indexer cleans them
+	outportDrivers := []indexer.Indexer{lightNotifier, pushNotifier, grpcDriver}
+	outportDrivers = append(outportDrivers, outportPlugins...)
+	outportDrivers = append(outportDrivers, kafkaDriver, postgresDriver, dbIndexer)
+	elasticIndexer, err := indexer.NewMultiIndexer(outportDrivers...)
+	if err != nil {
+		return err
+	}
+
+	argsDriversMonitor := indexer.ArgsDriversMonitor{
+		Drivers:          elasticIndexer,
+		AppStatusHandler: statusHandlersInfo.StatusHandler,
+		CheckInterval:    time.Second * time.Duration(externalConfig.OutportMonitor.CheckIntervalInSeconds),
+	}
+	outportMonitor, err := indexer.NewDriversMonitor(argsDriversMonitor)
+	if err != nil {
+		return err
+	}
+
 	gasScheduleConfigurationFolderName := ctx.GlobalString(gasScheduleConfigurationDirectory.Name)
 	argsGasScheduleNotifier := forking.ArgsNewGasScheduleNotifier{
 		GasScheduleConfig: generalConfig.GasSchedule,
@@ -1264,6 +1518,65 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	partitionDetector, err := createPartitionDetector(
+		generalConfig.PartitionDetector,
+		networkComponents,
+		dataComponents,
+		nodesCoordinator,
+		shardCoordinator,
+		coreComponents.StatusHandler,
+	)
+	if err != nil {
+		return err
+	}
+
+	equivocationDetector, proofForwarder, err := createEquivocationDetector(
+		generalConfig.EquivocationDetector,
+		pathManager,
+		shardCoordinator,
+		coreComponents,
+		stateComponents,
+		cryptoComponents,
+		economicsData,
+	)
+	if err != nil {
+		return err
+	}
+
+	subroundsTimingHandler, err := createSubroundsTimingHandler(generalConfig.ConsensusTiming)
+	if err != nil {
+		return err
+	}
+
+	consensusStateDebugger, err := debugFactory.NewConsensusStateDebuggerFactory(generalConfig.Debug.Consensus, workingDir)
+	if err != nil {
+		return err
+	}
+
+	argsNodeRedundancy := redundancy.ArgsNodeRedundancy{
+		RedundancyLevel:       preferencesConfig.Preferences.RedundancyLevel,
+		MaxRoundsOfInactivity: generalConfig.Redundancy.MaxRoundsOfInactivityAccepted,
+		Messenger:             networkComponents.NetMessenger,
+		ManagedPeersHolder:    cryptoComponents.ManagedPeersHolder,
+		FenceFilePath:         filepath.Join(workingDir, generalConfig.Redundancy.FenceFilePath),
+	}
+	nodeRedundancyHandler, err := redundancy.NewNodeRedundancy(argsNodeRedundancy)
+	if err != nil {
+		return err
+	}
+	if nodeRedundancyHandler.IsRedundancyNode() {
+		log.Info("the node is started as a backup node", "redundancy level", argsNodeRedundancy.RedundancyLevel)
+	}
+
+	argsParticipationTracker := participation.ArgsParticipationTracker{
+		AppStatusHandler: coreComponents.StatusHandler,
+		NumEpochsToKeep:  numEpochsOfParticipation,
+	}
+	participationTracker, err := participation.NewParticipationTracker(argsParticipationTracker)
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating process components")
 	processArgs := factory.NewProcessComponentsFactoryArgs(
 		&coreArgs,
@@ -1290,6 +1603,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		rater,
 		generalConfig.Marshalizer.SizeCheckDelta,
 		generalConfig.StateTriesConfig.CheckpointRoundsModulus,
+		computeEpochStartProcessingLag(generalConfig.EpochStartConfig, nodeType),
 		generalConfig.GeneralSettings.MaxComputableRounds,
 		generalConfig.Antiflood.NumConcurrentResolverJobs,
 		generalConfig.BlockSizeThrottleConfig.MinSizeInBytes,
@@ -1304,6 +1618,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		workingDir,
 		elasticIndexer,
 		tpsBenchmark,
+		crossShardStatistics,
 		historyRepository,
 		epochNotifier,
 		txSimulatorProcessorArgs,
@@ -1321,6 +1636,12 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	genesisNodePrice, ok := big.NewInt(0).SetString(systemSCConfig.StakingSystemSCConfig.GenesisNodePrice, 10)
+	if !ok {
+		return fmt.Errorf("can not parse genesis node price from systemSmartContractsConfig.toml, %s is not a valid value",
+			systemSCConfig.StakingSystemSCConfig.GenesisNodePrice)
+	}
+
 	hardForkTrigger, err := createHardForkTrigger(
 		generalConfig,
 		cryptoParams.KeyGenerator,
@@ -1341,6 +1662,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		genesisNodesConfig,
 		workingDir,
 		epochNotifier,
+		genesisNodePrice,
 	)
 	if err != nil {
 		return err
@@ -1377,7 +1699,9 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		networkComponents,
 		ctx.GlobalUint64(bootstrapRoundIndex.Name),
 		version,
+		workingDir,
 		elasticIndexer,
+		pushNotifier,
 		requestedItemsHandler,
 		epochStartNotifier,
 		whiteListRequest,
@@ -1386,12 +1710,23 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		hardForkTrigger,
 		historyRepository,
 		fallbackHeaderValidator,
+		partitionDetector,
+		equivocationDetector,
+		subroundsTimingHandler,
+		consensusStateDebugger,
+		nodeRedundancyHandler,
+		participationTracker,
 		isInImportMode,
 	)
 	if err != nil {
 		return err
 	}
 
+	err = proofForwarder.SetTransactionSender(currentNode)
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating software checker structure")
 	softwareVersionChecker, err := factory.CreateSoftwareVersionChecker(coreComponents.StatusHandler, generalConfig.SoftwareVersionConfig)
 	if err != nil {
@@ -1410,8 +1745,10 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	apiResolver, err := createApiResolver(
 		generalConfig,
 		stateComponents.AccountsAdapter,
+		triesComponents.TriesContainer.Get([]byte(trieFactory.UserAccountTrie)),
 		stateComponents.PeerAccounts,
 		stateComponents.AddressPubkeyConverter,
+		stateComponents.ValidatorPubkeyConverter,
 		dataComponents.Store,
 		dataComponents.Datapool,
 		dataComponents.Blkc,
@@ -1424,9 +1761,11 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		economicsData,
 		cryptoComponents.MessageSignVerifier,
 		genesisNodesConfig,
+		nodesCoordinator,
 		systemSCConfig,
 		rater,
 		epochNotifier,
+		transactionSimulator,
 		apiWorkingDir,
 	)
 	if err != nil {
@@ -1452,8 +1791,43 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	log.Trace("creating config reloader")
+	configReloader, err := reload.NewConfigReloader(reload.ArgsConfigReloader{
+		ConfigsLoader: func() (*reload.Configs, error) {
+			return loadReloadableConfigs(configurationFileName, configurationApiFileName, configurationPreferencesFileName)
+		},
+		InitialConfigs: &reload.Configs{
+			GeneralConfig:     generalConfig,
+			ApiRoutesConfig:   apiRoutesConfig,
+			PreferencesConfig: preferencesConfig,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	antifloodReloadable, err := reload.NewAntifloodReloadable(networkComponents.InputAntifloodHandler, generalConfig.Antiflood.Topic)
+	if err != nil {
+		return err
+	}
+	preferredConnectionsReloadable, err := reload.NewPreferredConnectionsReloadable(networkComponents.NetMessenger)
+	if err != nil {
+		return err
+	}
+	for _, reloadable := range []reload.Reloadable{reload.NewLogLevelReloadable(), antifloodReloadable, preferredConnectionsReloadable} {
+		err = configReloader.AddReloadable(reloadable)
+		if err != nil {
+			return err
+		}
+	}
+	err = preferredConnectionsReloadable.Apply(&reload.Configs{PreferencesConfig: preferencesConfig})
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating elrond node facade")
 	restAPIServerDebugMode := ctx.GlobalBool(restApiDebug.Name)
+	webServerConfig := generalConfig.WebServer
+	webServerConfig.TLS.ACME.CacheDir = filepath.Join(workingDir, webServerConfig.TLS.ACME.CacheDir)
 
 	argNodeFacade := facade.ArgNodeFacade{
 		Node:                   currentNode,
@@ -1464,10 +1838,17 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		FacadeConfig: config.FacadeConfig{
 			RestApiInterface: ctx.GlobalString(restApiInterface.Name),
 			PprofEnabled:     ctx.GlobalBool(profileMode.Name),
+			AppVersion:       version,
+			WorkingDir:       workingDir,
 		},
-		ApiRoutesConfig: *apiRoutesConfig,
-		AccountsState:   stateComponents.AccountsAdapter,
-		PeerState:       stateComponents.PeerAccounts,
+		ApiRoutesConfig:      *apiRoutesConfig,
+		WebServerConfig:      webServerConfig,
+		AccountsState:        stateComponents.AccountsAdapter,
+		PeerState:            stateComponents.PeerAccounts,
+		PushNotifier:         pushNotifier,
+		CrossShardStatistics: crossShardStatistics,
+		ConfigReloader:       configReloader,
+		OutportStatus:        elasticIndexer,
 	}
 
 	ef, err := facade.NewNodeFacade(argNodeFacade)
@@ -1475,6 +1856,15 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return fmt.Errorf("%w while creating NodeFacade", err)
 	}
 
+	apiReloadable, err := reload.NewApiReloadable(ef)
+	if err != nil {
+		return err
+	}
+	err = configReloader.AddReloadable(apiReloadable)
+	if err != nil {
+		return err
+	}
+
 	ef.SetSyncer(syncer)
 	ef.SetTpsBenchmark(tpsBenchmark)
 
@@ -1489,6 +1879,10 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	}
 
 	log.Info("application is now running")
+	reloadSigs := make(chan os.Signal, 1)
+	signal.Notify(reloadSigs, syscall.SIGHUP)
+	go reloadConfigsOnSignal(log, reloadSigs, configReloader)
+
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 	var sig endProcess.ArgEndProcess
@@ -1498,10 +1892,23 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	case sig = <-chanStopNodeProcess:
 		log.Info("terminating at internal stop signal", "reason", sig.Reason, "description", sig.Description)
 	}
+	signal.Stop(reloadSigs)
+	close(reloadSigs)
 
 	chanCloseComponents := make(chan struct{})
 	go func() {
-		closeAllComponents(log, healthService, dataComponents, triesComponents, networkComponents, chanCloseComponents)
+		closeAllComponents(
+			log,
+			healthService,
+			partitionDetector,
+			equivocationDetector,
+			outportMonitor,
+			cryptoComponents,
+			dataComponents,
+			triesComponents,
+			networkComponents,
+			chanCloseComponents,
+		)
 	}()
 
 	select {
@@ -1539,6 +1946,8 @@ func applyCompatibleConfigs(isInImportMode bool, importDbNoSigCheckFlag bool, lo
 		config.Heartbeat.DurationToConsiderUnresponsiveInSec = math.MaxInt32
 		config.Heartbeat.MinTimeToWaitBetweenBroadcastsInSec = math.MaxInt32 - 2
 		config.Heartbeat.MaxTimeToWaitBetweenBroadcastsInSec = math.MaxInt32 - 1
+		config.Heartbeat.PeerAuthenticationTimeBetweenSendsInSec = math.MaxInt32
+		config.Heartbeat.PeerAuthenticationTimeThresholdBetweenSendsInSec = 0
 
 		alterStorageConfigsForDBImport(config)
 	}
@@ -1563,6 +1972,10 @@ func changeStorageConfigForDBImport(storageConfig *config.StorageConfig) {
 func closeAllComponents(
 	log logger.Logger,
 	healthService io.Closer,
+	partitionDetector io.Closer,
+	equivocationDetector io.Closer,
+	outportMonitor io.Closer,
+	cryptoComponents *mainFactory.CryptoComponents,
 	dataComponents *mainFactory.DataComponents,
 	triesComponents *mainFactory.TriesComponents,
 	networkComponents *mainFactory.NetworkComponents,
@@ -1572,6 +1985,22 @@ func closeAllComponents(
 	err := healthService.Close()
 	log.LogIfError(err)
 
+	log.Debug("closing partition detector...")
+	err = partitionDetector.Close()
+	log.LogIfError(err)
+
+	log.Debug("closing equivocation detector...")
+	err = equivocationDetector.Close()
+	log.LogIfError(err)
+
+	log.Debug("closing outport monitor...")
+	err = outportMonitor.Close()
+	log.LogIfError(err)
+
+	log.Debug("closing crypto components...")
+	err = cryptoComponents.Close()
+	log.LogIfError(err)
+
 	log.Debug("closing all store units....")
 	err = dataComponents.Store.CloseAll()
 	log.LogIfError(err)
@@ -1744,38 +2173,41 @@ func loadApiConfig(filepath string) (*config.ApiRoutesConfig, error) {
 	return cfg, nil
 }
 
-func loadEconomicsConfig(filepath string) (*config.EconomicsConfig, error) {
-	cfg := &config.EconomicsConfig{}
-	err := core.LoadTomlFile(cfg, filepath)
+// loadReloadableConfigs reads the configurations which can be applied without restarting the node
+func loadReloadableConfigs(configFileName string, apiFileName string, preferencesFileName string) (*reload.Configs, error) {
+	generalConfig, err := loadMainConfig(configFileName)
 	if err != nil {
 		return nil, err
 	}
-
-	return cfg, nil
-}
-
-func loadSystemSmartContractsConfig(filepath string) (*config.SystemSmartContractsConfig, error) {
-	cfg := &config.SystemSmartContractsConfig{}
-	err := core.LoadTomlFile(cfg, filepath)
+	apiRoutesConfig, err := loadApiConfig(apiFileName)
+	if err != nil {
+		return nil, err
+	}
+	preferencesConfig, err := loadPreferencesConfig(preferencesFileName)
 	if err != nil {
 		return nil, err
 	}
 
-	return cfg, nil
+	return &reload.Configs{
+		GeneralConfig:     generalConfig,
+		ApiRoutesConfig:   apiRoutesConfig,
+		PreferencesConfig: preferencesConfig,
+	}, nil
 }
 
-func loadRatingsConfig(filepath string) (config.RatingsConfig, error) {
-	cfg := &config.RatingsConfig{}
-	err := core.LoadTomlFile(cfg, filepath)
-	if err != nil {
-		return config.RatingsConfig{}, err
+// reloadConfigsOnSignal reloads the configurations each time the SIGHUP signal is received, until the channel is closed
+func reloadConfigsOnSignal(log logger.Logger, reloadSigs chan os.Signal, configReloader facade.ConfigReloader) {
+	for range reloadSigs {
+		log.Info("reloading the configuration at user's signal...")
+		_, err := configReloader.Reload()
+		if err != nil {
+			log.Error("the configuration was not reloaded", "error", err.Error())
+		}
 	}
-
-	return *cfg, nil
 }
 
-func loadPreferencesConfig(filepath string) (*config.Preferences, error) {
-	cfg := &config.Preferences{}
+func loadEconomicsConfig(filepath string) (*config.EconomicsConfig, error) {
+	cfg := &config.EconomicsConfig{}
 	err := core.LoadTomlFile(cfg, filepath)
 	if err != nil {
 		return nil, err
@@ -1784,13 +2216,84 @@ func loadPreferencesConfig(filepath string) (*config.Preferences, error) {
 	return cfg, nil
 }
 
-func loadExternalConfig(filepath string) (*config.ExternalConfig, error) {
-	cfg := &config.ExternalConfig{}
-	err := core.LoadTomlFile(cfg, filepath)
-	if err != nil {
-		return nil, fmt.Errorf("cannot load external config: %w", err)
-	}
-
+// checkGenesisFiles validates the genesis files together, so all the problems are reported at once, before they are
+// loaded by their parsers
+func checkGenesisFiles(
+	ctx *cli.Context,
+	generalConfig *config.Config,
+	systemSCConfig *config.SystemSmartContractsConfig,
+	nodesSetupPath string,
+	totalSupply *big.Int,
+	addressPubkeyConverter core.PubkeyConverter,
+	validatorPubkeyConverter core.PubkeyConverter,
+) error {
+	genesisNodePrice, ok := big.NewInt(0).SetString(systemSCConfig.StakingSystemSCConfig.GenesisNodePrice, 10)
+	if !ok {
+		return fmt.Errorf("can not parse genesis node price from systemSmartContractsConfig.toml, %s is not a valid value",
+			systemSCConfig.StakingSystemSCConfig.GenesisNodePrice)
+	}
+
+	suite, err := getSuite(generalConfig)
+	if err != nil {
+		return err
+	}
+
+	argsChecker := checking.ArgsGenesisFilesChecker{
+		GenesisFilePath:          ctx.GlobalString(genesisFile.Name),
+		SmartContractsFilePath:   ctx.GlobalString(smartContractsFile.Name),
+		NodesSetupFilePath:       nodesSetupPath,
+		EntireSupply:             totalSupply,
+		InitialNodePrice:         genesisNodePrice,
+		AddressPubkeyConverter:   addressPubkeyConverter,
+		ValidatorPubkeyConverter: validatorPubkeyConverter,
+		ValidatorKeyGenerator:    signing.NewKeyGenerator(suite),
+		Hasher:                   keccak.Keccak{},
+	}
+	genesisFilesChecker, err := checking.NewGenesisFilesChecker(argsChecker)
+	if err != nil {
+		return err
+	}
+
+	return genesisFilesChecker.Check()
+}
+
+func loadSystemSmartContractsConfig(filepath string) (*config.SystemSmartContractsConfig, error) {
+	cfg := &config.SystemSmartContractsConfig{}
+	err := core.LoadTomlFile(cfg, filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func loadRatingsConfig(filepath string) (config.RatingsConfig, error) {
+	cfg := &config.RatingsConfig{}
+	err := core.LoadTomlFile(cfg, filepath)
+	if err != nil {
+		return config.RatingsConfig{}, err
+	}
+
+	return *cfg, nil
+}
+
+func loadPreferencesConfig(filepath string) (*config.Preferences, error) {
+	cfg := &config.Preferences{}
+	err := core.LoadTomlFile(cfg, filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func loadExternalConfig(filepath string) (*config.ExternalConfig, error) {
+	cfg := &config.ExternalConfig{}
+	err := core.LoadTomlFile(cfg, filepath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load external config: %w", err)
+	}
+
 	return cfg, nil
 }
 
@@ -1812,6 +2315,16 @@ func getShardIdFromNodePubKey(pubKey crypto.PublicKey, nodesConfig *sharding.Nod
 	return selfShardId, err
 }
 
+// computeEpochStartProcessingLag returns the number of rounds the epoch start work is delayed with, only observers being
+// allowed to lag behind
+func computeEpochStartProcessingLag(epochStartConfig config.EpochStartConfig, nodeType core.NodeType) uint64 {
+	if nodeType != core.NodeTypeObserver {
+		return 0
+	}
+
+	return epochStartConfig.ObserverProcessingLagInRounds
+}
+
 func createShardCoordinator(
 	nodesConfig *sharding.NodesSetup,
 	pubKey crypto.PublicKey,
@@ -1865,6 +2378,7 @@ func createNodesCoordinator(
 	bootStorer storage.Storer,
 	nodeShuffler sharding.NodesShuffler,
 	epochConfig config.EpochStartConfig,
+	consensusGroupCacheConfig config.CacheConfig,
 	currentShardID uint32,
 	chanStopNodeProcess chan endProcess.ArgEndProcess,
 	bootstrapParameters bootstrap.Parameters,
@@ -1915,7 +2429,10 @@ func createNodesCoordinator(
 		return nil, nil, err
 	}
 
-	consensusGroupCache, err := lrucache.NewCache(25000)
+	consensusGroupCache, err := lrucache.NewCacheWithSizeInBytes(
+		int(consensusGroupCacheConfig.Capacity),
+		int64(consensusGroupCacheConfig.SizeInBytes),
+	)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1992,7 +2509,7 @@ func processDestinationShardAsObserver(prefsConfig config.PreferencesConfig) (ui
 		return 0, errors.New("option DestinationShardAsObserver is not set in prefs.toml")
 	}
 
-	if destShard == notSetDestinationShardID {
+	if destShard == notSetDestinationShardID || destShard == autoDestinationShardID {
 		return core.DisabledShardIDAsObserver, nil
 	}
 
@@ -2008,6 +2525,182 @@ func processDestinationShardAsObserver(prefsConfig config.PreferencesConfig) (ui
 	return uint32(val), err
 }
 
+func selectObserverShard(
+	messenger p2p.Messenger,
+	numOfShards uint32,
+	prefsConfig config.PreferencesConfig,
+	decisionFilePath string,
+	forceReselection bool,
+) (uint32, error) {
+	argsSelector := bootstrap.ArgsObserverShardSelector{
+		Messenger:        messenger,
+		NumOfShards:      numOfShards,
+		ProbeDuration:    time.Second * time.Duration(prefsConfig.AutoObserverShardProbeDurationInSec),
+		DecisionFilePath: decisionFilePath,
+		ForceReselection: forceReselection,
+	}
+	selector, err := bootstrap.NewObserverShardSelector(argsSelector)
+	if err != nil {
+		return 0, err
+	}
+
+	return selector.SelectShard()
+}
+
+func createLightTopicsNotifier(
+	lightTopicsConfig config.LightTopicsNotifierConfig,
+	messenger p2p.Messenger,
+	nodeType core.NodeType,
+	isSoleTxLogsConsumer bool,
+	log logger.Logger,
+) (indexer.Indexer, error) {
+	if !lightTopicsConfig.Enabled {
+		return indexer.NewNilIndexer(), nil
+	}
+	if nodeType != core.NodeTypeObserver {
+		log.Warn("light topics notifier can only be enabled on observer nodes, skipping")
+		return indexer.NewNilIndexer(), nil
+	}
+
+	prefixes := make([][]byte, 0, len(lightTopicsConfig.AddressPrefixes))
+	for _, prefixHex := range lightTopicsConfig.AddressPrefixes {
+		prefix, err := hex.DecodeString(prefixHex)
+		if err != nil {
+			return nil, fmt.Errorf("%w while decoding light topics address prefix %s", err, prefixHex)
+		}
+
+		prefixes = append(prefixes, prefix)
+	}
+
+	argsLightNotifier := lightTopics.ArgsLightNotifier{
+		Messenger:       messenger,
+		Marshalizer:     &marshal.JsonMarshalizer{},
+		AddressPrefixes: prefixes,
+		CleanTxLogs:     isSoleTxLogsConsumer,
+	}
+
+	return lightTopics.NewLightNotifier(argsLightNotifier)
+}
+
+func createPushNotifier(
+	pushNotifierConfig config.PushNotifierConfig,
+	addressPubkeyConverter core.PubkeyConverter,
+	workingDir string,
+	isLastTxLogsConsumer bool,
+) (push.Notifier, error) {
+	argsOutportDriverFactory := &indexerFactory.ArgsOutportDriverFactory{
+		AddressPubkeyConverter: addressPubkeyConverter,
+		WorkingDir:             workingDir,
+		CleanTxLogs:            isLastTxLogsConsumer,
+	}
+
+	return indexerFactory.NewPushNotifier(pushNotifierConfig, argsOutportDriverFactory)
+}
+
+func createKafkaDriver(
+	kafkaConfig config.KafkaConnectorConfig,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	addressPubkeyConverter core.PubkeyConverter,
+	shardCoordinator sharding.Coordinator,
+	workingDir string,
+	isLastTxLogsConsumer bool,
+) (indexer.Indexer, error) {
+	argsOutportDriverFactory := &indexerFactory.ArgsOutportDriverFactory{
+		Marshalizer:            marshalizer,
+		Hasher:                 hasher,
+		AddressPubkeyConverter: addressPubkeyConverter,
+		ShardCoordinator:       shardCoordinator,
+		WorkingDir:             workingDir,
+		CleanTxLogs:            isLastTxLogsConsumer,
+	}
+
+	return indexerFactory.NewKafkaDriver(kafkaConfig, argsOutportDriverFactory)
+}
+
+// createOutportPlugins starts the enabled outport plugins and returns their drivers, in the configuration order. The
+// transaction logs are cleaned by the last plugin, if none of the drivers placed after the plugins consumes them
+func createOutportPlugins(
+	pluginsConfig []config.OutportPluginConfig,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	addressPubkeyConverter core.PubkeyConverter,
+	shardCoordinator sharding.Coordinator,
+	workingDir string,
+	isLastTxLogsConsumer bool,
+) ([]indexer.Indexer, error) {
+	pluginDrivers := make([]indexer.Indexer, 0, len(pluginsConfig))
+	for i := len(pluginsConfig) - 1; i >= 0; i-- {
+		argsOutportDriverFactory := &indexerFactory.ArgsOutportDriverFactory{
+			Marshalizer:            marshalizer,
+			Hasher:                 hasher,
+			AddressPubkeyConverter: addressPubkeyConverter,
+			ShardCoordinator:       shardCoordinator,
+			WorkingDir:             workingDir,
+			CleanTxLogs:            isLastTxLogsConsumer && len(pluginDrivers) == 0,
+		}
+
+		pluginDriver, err := indexerFactory.NewPluginDriver(pluginsConfig[i], argsOutportDriverFactory)
+		if err != nil {
+			for _, startedDriver := range pluginDrivers {
+				_ = startedDriver.Close()
+			}
+
+			return nil, fmt.Errorf("%w while starting the outport plugin %s", err, pluginsConfig[i].Name)
+		}
+		if pluginDriver.IsNilIndexer() {
+			continue
+		}
+
+		pluginDrivers = append([]indexer.Indexer{pluginDriver}, pluginDrivers...)
+	}
+
+	return pluginDrivers, nil
+}
+
+func createGRPCDriver(
+	grpcConfig config.GRPCConnectorConfig,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	addressPubkeyConverter core.PubkeyConverter,
+	shardCoordinator sharding.Coordinator,
+	workingDir string,
+	isLastTxLogsConsumer bool,
+) (indexer.Indexer, error) {
+	argsOutportDriverFactory := &indexerFactory.ArgsOutportDriverFactory{
+		Marshalizer:            marshalizer,
+		Hasher:                 hasher,
+		AddressPubkeyConverter: addressPubkeyConverter,
+		ShardCoordinator:       shardCoordinator,
+		WorkingDir:             workingDir,
+		CleanTxLogs:            isLastTxLogsConsumer,
+	}
+
+	return indexerFactory.NewGRPCDriver(grpcConfig, argsOutportDriverFactory)
+}
+
+func createPostgresDriver(
+	postgresConfig config.PostgreSQLConnectorConfig,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	addressPubkeyConverter core.PubkeyConverter,
+	shardCoordinator sharding.Coordinator,
+	accounts state.AccountsAdapter,
+	workingDir string,
+	isLastTxLogsConsumer bool,
+) (indexer.Indexer, error) {
+	argsOutportDriverFactory := &indexerFactory.ArgsOutportDriverFactory{
+		Marshalizer:            marshalizer,
+		Hasher:                 hasher,
+		AddressPubkeyConverter: addressPubkeyConverter,
+		ShardCoordinator:       shardCoordinator,
+		WorkingDir:             workingDir,
+		CleanTxLogs:            isLastTxLogsConsumer,
+	}
+
+	return indexerFactory.NewPostgresDriver(postgresConfig, accounts, argsOutportDriverFactory)
+}
+
 // createElasticIndexer creates a new elasticIndexer where the server listens on the url,
 // authentication for the server is using the username and password
 func createElasticIndexer(
@@ -2024,11 +2717,14 @@ func createElasticIndexer(
 	economicsHandler process.TransactionFeeCalculator,
 	isInImportDBMode bool,
 	elasticSearchTemplatesPath string,
+	workingDir string,
 ) (indexer.Indexer, error) {
 
 	indexerFactoryArgs := &indexerFactory.ArgsIndexerFactory{
 		Enabled:                  elasticSearchConfig.Enabled,
 		IndexerCacheSize:         elasticSearchConfig.IndexerCacheSize,
+		LagThreshold:             elasticSearchConfig.LagThresholdInItems,
+		MaxRetryInterval:         time.Second * time.Duration(elasticSearchConfig.MaxRetryIntervalInSeconds),
 		ShardCoordinator:         shardCoordinator,
 		Url:                      elasticSearchConfig.URL,
 		UserName:                 elasticSearchConfig.Username,
@@ -2048,6 +2744,8 @@ func createElasticIndexer(
 			UseKibana: elasticSearchConfig.UseKibana,
 		},
 		IsInImportDBMode: isInImportDBMode,
+		WriteAheadLog:    elasticSearchConfig.WriteAheadLog,
+		WorkingDir:       workingDir,
 	}
 
 	return indexerFactory.NewIndexer(indexerFactoryArgs)
@@ -2083,6 +2781,7 @@ func createHardForkTrigger(
 	nodesSetup update.GenesisNodesSetupHandler,
 	workingDir string,
 	epochNotifier process.EpochNotifier,
+	genesisNodePrice *big.Int,
 ) (node.HardforkTrigger, error) {
 
 	selfPubKeyBytes, err := pubKey.ToByteArray()
@@ -2141,6 +2840,8 @@ func createHardForkTrigger(
 		EnableSignTxWithHashEpoch: config.GeneralSettings.TransactionSignedWithTxHashEnableEpoch,
 		TxSignHasher:              coreData.TxSignHasher,
 		EpochNotifier:             epochNotifier,
+		ExportGenesisFiles:        hardForkConfig.ExportGenesisFiles,
+		GenesisNodePrice:          genesisNodePrice,
 	}
 	hardForkExportFactory, err := exportFactory.NewExportHandlerFactory(argsExporter)
 	if err != nil {
@@ -2190,7 +2891,9 @@ func createNode(
 	network *mainFactory.NetworkComponents,
 	bootstrapRoundIndex uint64,
 	version string,
+	workingDir string,
 	indexer indexer.Indexer,
+	pushNotifier push.Notifier,
 	requestedItemsHandler dataRetriever.RequestedItemsHandler,
 	epochStartRegistrationHandler epochStart.RegistrationHandler,
 	whiteListRequest process.WhiteListHandler,
@@ -2199,6 +2902,12 @@ func createNode(
 	hardForkTrigger node.HardforkTrigger,
 	historyRepository dblookupext.HistoryRepository,
 	fallbackHeaderValidator consensus.FallbackHeaderValidator,
+	partitionStatusHandler consensus.PartitionStatusHandler,
+	equivocationDetector consensus.EquivocationDetector,
+	subroundsTimingHandler consensus.SubroundsTimingHandler,
+	consensusStateDebugger debugFactory.ConsensusStateDebugHandler,
+	nodeRedundancyHandler consensus.NodeRedundancyHandler,
+	participationTracker consensus.ParticipationTracker,
 	isInImportDbMode bool,
 ) (*node.Node, error) {
 	var err error
@@ -2259,6 +2968,11 @@ func createNode(
 
 	txVersionCheckerHandler := versioning.NewTxVersionChecker(coreData.MinTransactionVersion)
 
+	proposalPreparer, err := createProposalPreparer(config.PipelinedProposal, process.BlockProcessor)
+	if err != nil {
+		return nil, err
+	}
+
 	var nd *node.Node
 	nd, err = node.NewNode(
 		node.WithMessenger(network.NetMessenger),
@@ -2322,13 +3036,26 @@ func createNode(
 		node.WithNodeStopChannel(chanStopNodeProcess),
 		node.WithPeerHonestyHandler(peerHonestyHandler),
 		node.WithFallbackHeaderValidator(fallbackHeaderValidator),
+		node.WithPartitionStatusHandler(partitionStatusHandler),
+		node.WithEquivocationDetector(equivocationDetector),
+		node.WithSubroundsTimingHandler(subroundsTimingHandler),
+		node.WithProposalPreparer(proposalPreparer),
+		node.WithFallbackLeaderTimeoutPercent(config.FallbackLeader.ProposalTimeoutPercent),
+		node.WithFallbackLeaderEnableEpoch(config.GeneralSettings.FallbackLeaderEnableEpoch),
+		node.WithConsensusStateDebugger(consensusStateDebugger),
 		node.WithWatchdogTimer(watchdogTimer),
 		node.WithPeerSignatureHandler(crypto.PeerSignatureHandler),
+		node.WithManagedPeersHolder(crypto.ManagedPeersHolder),
+		node.WithNodeRedundancyHandler(nodeRedundancyHandler),
+		node.WithParticipationTracker(participationTracker),
+		node.WithSigningSlotHandler(crypto.SigningSlotHandler),
 		node.WithHistoryRepository(historyRepository),
 		node.WithEnableSignTxWithHashEpoch(config.GeneralSettings.TransactionSignedWithTxHashEnableEpoch),
 		node.WithTxSignHasher(coreData.TxSignHasher),
 		node.WithTxVersionChecker(txVersionCheckerHandler),
 		node.WithImportMode(isInImportDbMode),
+		node.WithWorkingDir(workingDir),
+		node.WithPushNotifier(pushNotifier),
 	)
 	if err != nil {
 		return nil, errors.New("error creating node: " + err.Error())
@@ -2367,9 +3094,447 @@ func createNode(
 		return nil, err
 	}
 
+	err = nd.AddQueryHandler(nodeDebugFactory.ConsensusStateDebugger, consensusStateDebugger)
+	if err != nil {
+		return nil, err
+	}
+
+	err = nd.AddQueryHandler(nodeDebugFactory.ManagedKeys, crypto.ManagedPeersHolder)
+	if err != nil {
+		return nil, err
+	}
+
 	return nd, nil
 }
 
+// loadRemoteSignerCryptoParams creates the node's BLS key when its secret key is held by a remote signer. The signer
+// must hold the secret key of the configured public key
+func loadRemoteSignerCryptoParams(
+	cfg config.RemoteSignerConfig,
+	pubkeyConverter core.PubkeyConverter,
+	suite crypto.Suite,
+	log logger.Logger,
+) (*mainFactory.CryptoParams, error) {
+	cryptoParams := &mainFactory.CryptoParams{
+		KeyGenerator: signing.NewKeyGenerator(suite),
+	}
+
+	var err error
+	cryptoParams.PublicKeyBytes, err = pubkeyConverter.Decode(cfg.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the remote signer public key %s", err, cfg.PublicKey)
+	}
+	cryptoParams.PublicKey, err = cryptoParams.KeyGenerator.PublicKeyFromByteArray(cryptoParams.PublicKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the remote signer public key %s", err, cfg.PublicKey)
+	}
+	cryptoParams.PublicKeyString = pubkeyConverter.Encode(cryptoParams.PublicKeyBytes)
+
+	argsClient := remoteSigner.ArgsHTTPSigningClient{
+		URL:             cfg.URL,
+		CertificateFile: cfg.CertificateFile,
+		KeyFile:         cfg.KeyFile,
+		CAFile:          cfg.CAFile,
+		RequestTimeout:  time.Duration(cfg.RequestTimeoutInMillisec) * time.Millisecond,
+	}
+	signingClient, err := remoteSigner.NewHTTPSigningClient(argsClient)
+	if err != nil {
+		return nil, err
+	}
+
+	heldPublicKeys, err := signingClient.PublicKeys()
+	if err != nil {
+		return nil, err
+	}
+	isHeld := false
+	for _, publicKey := range heldPublicKeys {
+		if bytes.Equal(publicKey, cryptoParams.PublicKeyBytes) {
+			isHeld = true
+			break
+		}
+	}
+	if !isHeld {
+		return nil, fmt.Errorf("%w, the remote signer does not hold the key of %s", crypto.ErrUnknownSigningKey, cfg.PublicKey)
+	}
+
+	cryptoParams.PrivateKey, err = remoteSigner.NewRemotePrivateKey(cryptoParams.PublicKey, signingClient)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("the BLS key is held by the remote signer", "URL", cfg.URL, "public key", cryptoParams.PublicKeyString)
+
+	return cryptoParams, nil
+}
+
+func loadManagedPrivateKeys(
+	pemFileName string,
+	passphraseProvider keystore.PassphraseProvider,
+	isInImportMode bool,
+	log logger.Logger,
+) ([][]byte, error) {
+	if isInImportMode {
+		return make([][]byte, 0), nil
+	}
+	if !core.DoesFileExist(pemFileName) {
+		log.Info("no managed validator keys file found, the node will only operate its own key", "file", pemFileName)
+		return make([][]byte, 0), nil
+	}
+
+	encodedSks, pks, err := keystore.LoadAllKeysFromFile(pemFileName, passphraseProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	managedPrivateKeys := make([][]byte, 0, len(encodedSks))
+	for i, encodedSk := range encodedSks {
+		skBytes, errDecode := hex.DecodeString(string(encodedSk))
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for encoded secret key of public key %s", errDecode, pks[i])
+		}
+
+		managedPrivateKeys = append(managedPrivateKeys, skBytes)
+	}
+
+	log.Info("loaded managed validator keys", "file", pemFileName, "num keys", len(managedPrivateKeys))
+
+	return managedPrivateKeys, nil
+}
+
+// loadThresholdKeys creates the managed keys split in shares held by separate remote signers. The signers are not
+// required to be reachable at startup, the key signs as long as threshold signers answer
+func loadThresholdKeys(
+	cfg config.ThresholdSignerConfig,
+	pubkeyConverter core.PubkeyConverter,
+	keyGenerator crypto.KeyGenerator,
+	isInImportMode bool,
+	log logger.Logger,
+) ([]crypto.PrivateKey, error) {
+	if !cfg.Enabled || isInImportMode {
+		return make([]crypto.PrivateKey, 0), nil
+	}
+
+	signingClients := make(map[string]crypto.SigningBackend)
+	thresholdKeys := make([]thresholdsig.ThresholdKey, 0, len(cfg.Keys))
+	for _, keyConfig := range cfg.Keys {
+		publicKey, err := pubkeyConverter.Decode(keyConfig.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the threshold key %s", err, keyConfig.PublicKey)
+		}
+
+		thresholdKey := thresholdsig.ThresholdKey{
+			PublicKey: publicKey,
+			Threshold: keyConfig.Threshold,
+			Shares:    make([]thresholdsig.ShareSigner, 0, len(keyConfig.Shares)),
+		}
+		for _, shareConfig := range keyConfig.Shares {
+			sharePublicKey, errDecode := pubkeyConverter.Decode(shareConfig.PublicKey)
+			if errDecode != nil {
+				return nil, fmt.Errorf("%w for share %d of the threshold key %s", errDecode, shareConfig.ID, keyConfig.PublicKey)
+			}
+
+			signingClient, found := signingClients[shareConfig.URL]
+			if !found {
+				signingClient, err = remoteSigner.NewHTTPSigningClient(remoteSigner.ArgsHTTPSigningClient{
+					URL:             shareConfig.URL,
+					CertificateFile: cfg.CertificateFile,
+					KeyFile:         cfg.KeyFile,
+					CAFile:          cfg.CAFile,
+					RequestTimeout:  time.Duration(cfg.RequestTimeoutInMillisec) * time.Millisecond,
+				})
+				if err != nil {
+					return nil, err
+				}
+				signingClients[shareConfig.URL] = signingClient
+			}
+
+			thresholdKey.Shares = append(thresholdKey.Shares, thresholdsig.ShareSigner{
+				ID:        shareConfig.ID,
+				PublicKey: sharePublicKey,
+				Backend:   signingClient,
+			})
+		}
+
+		thresholdKeys = append(thresholdKeys, thresholdKey)
+	}
+
+	backend, err := thresholdsig.NewThresholdSigningBackend(thresholdsig.ArgsThresholdSigningBackend{
+		KeyGenerator: keyGenerator,
+		SingleSigner: &singlesig.BlsSingleSigner{},
+		Keys:         thresholdKeys,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	checkThresholdSigners(thresholdKeys, log)
+
+	remoteKeys := make([]crypto.PrivateKey, 0, len(thresholdKeys))
+	for _, thresholdKey := range thresholdKeys {
+		publicKey, errPublicKey := keyGenerator.PublicKeyFromByteArray(thresholdKey.PublicKey)
+		if errPublicKey != nil {
+			return nil, errPublicKey
+		}
+
+		remoteKey, errRemoteKey := remoteSigner.NewRemotePrivateKey(publicKey, backend)
+		if errRemoteKey != nil {
+			return nil, errRemoteKey
+		}
+		remoteKeys = append(remoteKeys, remoteKey)
+	}
+
+	log.Info("loaded threshold keys", "num keys", len(remoteKeys), "num signers", len(signingClients))
+
+	return remoteKeys, nil
+}
+
+// checkThresholdSigners warns about the shares whose signers are unreachable or do not hold them
+func checkThresholdSigners(thresholdKeys []thresholdsig.ThresholdKey, log logger.Logger) {
+	heldPublicKeys := make(map[crypto.SigningBackend]map[string]struct{})
+	for _, thresholdKey := range thresholdKeys {
+		for _, share := range thresholdKey.Shares {
+			held, checked := heldPublicKeys[share.Backend]
+			if !checked {
+				held = getHeldPublicKeys(share.Backend, log)
+				heldPublicKeys[share.Backend] = held
+			}
+			if held == nil {
+				// the signer is not reachable, it was already reported
+				continue
+			}
+
+			_, isHeld := held[string(share.PublicKey)]
+			if !isHeld {
+				log.Warn("the share of the threshold key is not available on its signer",
+					"public key", thresholdKey.PublicKey,
+					"share", share.ID)
+			}
+		}
+	}
+}
+
+func getHeldPublicKeys(backend crypto.SigningBackend, log logger.Logger) map[string]struct{} {
+	publicKeys, err := backend.PublicKeys()
+	if err != nil {
+		log.Warn("threshold signer not reachable", "error", err.Error())
+		return nil
+	}
+
+	held := make(map[string]struct{}, len(publicKeys))
+	for _, publicKey := range publicKeys {
+		held[string(publicKey)] = struct{}{}
+	}
+
+	return held
+}
+
+func createPartitionDetector(
+	cfg config.PartitionDetectorConfig,
+	network *mainFactory.NetworkComponents,
+	data *mainFactory.DataComponents,
+	nodesCoordinator sharding.NodesCoordinator,
+	shardCoordinator sharding.Coordinator,
+	statusHandler core.AppStatusHandler,
+) (partition.Detector, error) {
+	if !cfg.Enabled {
+		return &partitionDisabled.PartitionDetector{}, nil
+	}
+
+	args := partition.ArgsPartitionDetector{
+		Config:           cfg,
+		Messenger:        network.NetMessenger,
+		HeadersPool:      data.Datapool.Headers(),
+		NodesCoordinator: nodesCoordinator,
+		ShardCoordinator: shardCoordinator,
+		AppStatusHandler: statusHandler,
+	}
+
+	return partition.NewPartitionDetector(args)
+}
+
+func createEquivocationDetector(
+	cfg config.EquivocationDetectorConfig,
+	pathManager storage.PathManagerHandler,
+	shardCoordinator sharding.Coordinator,
+	coreData *mainFactory.CoreComponents,
+	stateComponents *mainFactory.StateComponents,
+	cryptoComponents *mainFactory.CryptoComponents,
+	economicsData process.FeeHandler,
+) (equivocation.Detector, equivocation.ProofForwarderHandler, error) {
+	if !cfg.Enabled {
+		return &equivocationDisabled.EquivocationDetector{}, &equivocationDisabled.ProofForwarder{}, nil
+	}
+
+	var proofForwarder equivocation.ProofForwarderHandler = &equivocationDisabled.ProofForwarder{}
+	if cfg.ForwardProofs {
+		reporterSkBytes, _, err := core.LoadSkPkFromPemFile(cfg.ReporterPemFile, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		reporterSk, err := hex.DecodeString(string(reporterSkBytes))
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w for encoded reporter secret key", err)
+		}
+		reporterPrivateKey, err := cryptoComponents.TxSignKeyGen.PrivateKeyFromByteArray(reporterSk)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		argsForwarder := equivocation.ArgsSlashingSCForwarder{
+			PrivateKey:             reporterPrivateKey,
+			SingleSigner:           cryptoComponents.TxSingleSigner,
+			AddressPubkeyConverter: stateComponents.AddressPubkeyConverter,
+			TxSignMarshalizer:      coreData.TxSignMarshalizer,
+			Accounts:               stateComponents.AccountsAdapter,
+			ChainID:                coreData.ChainID,
+			MinTxVersion:           coreData.MinTransactionVersion,
+			GasPrice:               economicsData.MinGasPrice(),
+			GasLimit:               cfg.GasLimit,
+		}
+		proofForwarder, err = equivocation.NewSlashingSCForwarder(argsForwarder)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	shardId := core.GetShardIDString(shardCoordinator.SelfId())
+	dbConfig := storageFactory.GetDBFromConfig(cfg.ProofsStorage.DB)
+	dbConfig.FilePath = pathManager.PathForStatic(shardId, cfg.ProofsStorage.DB.FilePath)
+	proofsStorer, err := storageUnit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(cfg.ProofsStorage.Cache),
+		dbConfig,
+		storageFactory.GetBloomFromConfig(cfg.ProofsStorage.Bloom),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	args := equivocation.ArgsEquivocationDetector{
+		Hasher:          coreData.Hasher,
+		Marshalizer:     &marshal.JsonMarshalizer{},
+		ProofsStorer:    proofsStorer,
+		Forwarder:       proofForwarder,
+		ShardID:         shardCoordinator.SelfId(),
+		NumRoundsToKeep: cfg.NumRoundsToKeep,
+	}
+	detector, err := equivocation.NewEquivocationDetector(args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return detector, proofForwarder, nil
+}
+
+func createSubroundsTimingHandler(cfg config.ConsensusTimingConfig) (consensus.SubroundsTimingHandler, error) {
+	if !cfg.Adaptive {
+		return &timingDisabled.SubroundsTimer{}, nil
+	}
+
+	args := timing.ArgsAdaptiveSubroundsTimer{
+		NumRoundsWindow:            cfg.NumRoundsWindow,
+		MinObservations:            cfg.MinObservations,
+		SafetyFactor:               cfg.SafetyFactor,
+		MinProposalDeadlinePercent: cfg.MinProposalDeadlinePercent,
+		MaxProposalDeadlinePercent: cfg.MaxProposalDeadlinePercent,
+	}
+
+	return timing.NewAdaptiveSubroundsTimer(args)
+}
+
+func registerRoundDurationChangeHandler(
+	generalConfig *config.Config,
+	genesisNodesConfig *sharding.NodesSetup,
+	rounder round.RoundDurationChanger,
+	dataComponents *mainFactory.DataComponents,
+	coreComponents *mainFactory.CoreComponents,
+	epochStartNotifier notifier.EpochStartNotifier,
+	startRound int64,
+	currentEpoch uint32,
+) error {
+	settings := generalConfig.GeneralSettings
+	if settings.NewRoundDurationInMilliseconds == 0 {
+		return nil
+	}
+	if settings.RoundDurationChangeDelayInRounds >= uint64(generalConfig.EpochStartConfig.RoundsPerEpoch) {
+		return errors.New("invalid RoundDurationChangeDelayInRounds in section [GeneralSettings]. " +
+			"Should be lower than RoundsPerEpoch")
+	}
+
+	metaBlockStorer := dataComponents.Store.GetStorer(dataRetriever.MetaBlockUnit)
+	args := round.ArgsRoundDurationChangeHandler{
+		Rounder:          rounder,
+		MetaBlockStorer:  metaBlockStorer,
+		Marshalizer:      coreComponents.InternalMarshalizer,
+		GenesisTime:      time.Unix(genesisNodesConfig.StartTime, 0),
+		StartRound:       startRound,
+		RoundDuration:    time.Millisecond * time.Duration(genesisNodesConfig.RoundDuration),
+		NewRoundDuration: time.Millisecond * time.Duration(settings.NewRoundDurationInMilliseconds),
+		EnableEpoch:      settings.RoundDurationChangeEnableEpoch,
+		DelayInRounds:    settings.RoundDurationChangeDelayInRounds,
+		CurrentEpoch:     currentEpoch,
+	}
+	roundDurationChangeHandler, err := round.NewRoundDurationChangeHandler(args)
+	if err != nil {
+		return err
+	}
+
+	epochStartNotifier.RegisterHandler(roundDurationChangeHandler)
+
+	return nil
+}
+
+func registerRotatedKeyWatcher(
+	rotatedKeyFileName string,
+	ownPublicKey []byte,
+	passphraseProvider keystore.PassphraseProvider,
+	managedKeysAdder keysManagement.ManagedKeysAdder,
+	nodesCoordinator sharding.NodesCoordinator,
+	epochStartNotifier notifier.EpochStartNotifier,
+	isInImportMode bool,
+) error {
+	if isInImportMode {
+		return nil
+	}
+
+	args := keysManagement.ArgsRotatedKeyWatcher{
+		KeyFile:            rotatedKeyFileName,
+		OwnPublicKey:       ownPublicKey,
+		PassphraseProvider: passphraseProvider,
+		ManagedKeysAdder:   managedKeysAdder,
+		NodesCoordinator:   nodesCoordinator,
+	}
+	rotatedKeyWatcher, err := keysManagement.NewRotatedKeyWatcher(args)
+	if err != nil {
+		return err
+	}
+
+	// a key rotated while the node was stopped is operated from the start
+	err = rotatedKeyWatcher.LoadRotatedKey()
+	if err != nil {
+		return err
+	}
+
+	epochStartNotifier.RegisterHandler(rotatedKeyWatcher)
+
+	return nil
+}
+
+func createProposalPreparer(
+	cfg config.PipelinedProposalConfig,
+	blockProcessor process.BlockProcessor,
+) (process.ProposalPreparer, error) {
+	if !cfg.Enabled {
+		return &blockDisabled.ProposalPreparer{}, nil
+	}
+
+	proposalPreparer, ok := blockProcessor.(process.ProposalPreparer)
+	if !ok {
+		return nil, fmt.Errorf("%w when creating the proposal preparer", process.ErrWrongTypeAssertion)
+	}
+
+	return proposalPreparer, nil
+}
+
 func createPeerHonestyHandler(
 	config *config.Config,
 	ratingConfig config.RatingsConfig,
@@ -2425,8 +3590,10 @@ func startStatisticsMonitor(
 func createApiResolver(
 	generalConfig *config.Config,
 	accnts state.AccountsAdapter,
+	userAccountsTrie data.Trie,
 	validatorAccounts state.AccountsAdapter,
 	pubkeyConv core.PubkeyConverter,
+	validatorPubkeyConverter core.PubkeyConverter,
 	storageService dataRetriever.StorageService,
 	dataPool dataRetriever.PoolsHolder,
 	blockChain data.ChainHandler,
@@ -2439,14 +3606,17 @@ func createApiResolver(
 	economics process.EconomicsDataHandler,
 	messageSigVerifier vm.MessageSignVerifier,
 	nodesSetup sharding.GenesisNodesSetupHandler,
+	nodesCoordinator sharding.NodesCoordinator,
 	systemSCConfig *config.SystemSmartContractsConfig,
 	rater sharding.PeerAccountListAndRatingHandler,
 	epochNotifier process.EpochNotifier,
+	txSimulator process.TransactionSimulator,
 	workingDir string,
 ) (facade.ApiResolver, error) {
 	scQueryService, err := createScQueryService(
 		generalConfig,
 		accnts,
+		userAccountsTrie,
 		validatorAccounts,
 		pubkeyConv,
 		storageService,
@@ -2460,6 +3630,7 @@ func createApiResolver(
 		economics,
 		messageSigVerifier,
 		nodesSetup,
+		nodesCoordinator,
 		systemSCConfig,
 		rater,
 		epochNotifier,
@@ -2473,6 +3644,8 @@ func createApiResolver(
 		gasScheduleNotifier,
 		marshalizer,
 		accnts,
+		epochNotifier,
+		generalConfig.GeneralSettings.DeveloperRewardsOverrideEnableEpoch,
 	)
 	if err != nil {
 		return nil, err
@@ -2489,7 +3662,17 @@ func createApiResolver(
 		return nil, err
 	}
 
-	txCostHandler, err := transaction.NewTransactionCostEstimator(txTypeHandler, economics, scQueryService, gasScheduleNotifier)
+	argsTxCostEstimator := transaction.ArgsTransactionCostEstimator{
+		TxTypeHandler:          txTypeHandler,
+		FeeHandler:             economics,
+		TxSimulator:            txSimulator,
+		Accounts:               accnts,
+		ShardCoordinator:       shardCoordinator,
+		AddressPubkeyConverter: pubkeyConv,
+		SafetyMarginPercent:    generalConfig.GasEstimation.SafetyMarginPercent,
+		CrossShardCallGasLimit: generalConfig.GasEstimation.CrossShardCallGasLimit,
+	}
+	txCostHandler, err := transaction.NewTransactionCostEstimator(argsTxCostEstimator)
 	if err != nil {
 		return nil, err
 	}
@@ -2505,13 +3688,72 @@ func createApiResolver(
 		return nil, err
 	}
 
-	return external.NewNodeApiResolver(scQueryService, statusMetrics, txCostHandler, totalStakedValueHandler)
+	argsStakingRewards := &stakingRewardsAPI.ArgsStakingRewardsHandler{
+		ShardID:                     shardCoordinator.SelfId(),
+		RoundDurationInMilliseconds: nodesSetup.GetRoundDuration(),
+		InternalMarshalizer:         marshalizer,
+		Accounts:                    accnts,
+		PeerAccounts:                validatorAccounts,
+		BlockChain:                  blockChain,
+		MetaBlockStorer:             storageService.GetStorer(dataRetriever.MetaBlockUnit),
+		TopUpRewardsSettings:        economics,
+	}
+	stakingRewardsHandler, err := stakingRewardsAPI.CreateStakingRewardsHandler(argsStakingRewards)
+	if err != nil {
+		return nil, err
+	}
+
+	argsESDTTokensList := &esdtTokensAPI.ArgsESDTTokensListHandler{
+		ShardID:                     shardCoordinator.SelfId(),
+		RoundDurationInMilliseconds: nodesSetup.GetRoundDuration(),
+		SCQueryService:              scQueryService,
+		AddressPubkeyConverter:      pubkeyConv,
+	}
+	esdtTokensListHandler, err := esdtTokensAPI.CreateESDTTokensListHandler(argsESDTTokensList)
+	if err != nil {
+		return nil, err
+	}
+
+	argsStakingQueue := &stakingQueueAPI.ArgsStakingQueueHandler{
+		ShardID:                     shardCoordinator.SelfId(),
+		RoundDurationInMilliseconds: nodesSetup.GetRoundDuration(),
+		SCQueryService:              scQueryService,
+		AddressPubkeyConverter:      pubkeyConv,
+		ValidatorPubkeyConverter:    validatorPubkeyConverter,
+	}
+	stakingQueueHandler, err := stakingQueueAPI.CreateStakingQueueHandler(argsStakingQueue)
+	if err != nil {
+		return nil, err
+	}
+
+	argsDelegationPositions := &delegationAPI.ArgsDelegationPositionsHandler{
+		ShardID:                     shardCoordinator.SelfId(),
+		RoundDurationInMilliseconds: nodesSetup.GetRoundDuration(),
+		SCQueryService:              scQueryService,
+		AddressPubkeyConverter:      pubkeyConv,
+	}
+	delegationsHandler, err := delegationAPI.CreateDelegationPositionsHandler(argsDelegationPositions)
+	if err != nil {
+		return nil, err
+	}
+
+	return external.NewNodeApiResolver(
+		scQueryService,
+		statusMetrics,
+		txCostHandler,
+		totalStakedValueHandler,
+		stakingRewardsHandler,
+		esdtTokensListHandler,
+		stakingQueueHandler,
+		delegationsHandler,
+	)
 }
 
 //TODO refactor this code when moving into feat/soft-restart. Maybe use arguments instead of endless parameter lists
 func createScQueryService(
 	generalConfig *config.Config,
 	accnts state.AccountsAdapter,
+	userAccountsTrie data.Trie,
 	validatorAccounts state.AccountsAdapter,
 	pubkeyConv core.PubkeyConverter,
 	storageService dataRetriever.StorageService,
@@ -2525,6 +3767,7 @@ func createScQueryService(
 	economics process.EconomicsDataHandler,
 	messageSigVerifier vm.MessageSignVerifier,
 	nodesSetup sharding.GenesisNodesSetupHandler,
+	nodesCoordinator sharding.NodesCoordinator,
 	systemSCConfig *config.SystemSmartContractsConfig,
 	rater sharding.PeerAccountListAndRatingHandler,
 	epochNotifier process.EpochNotifier,
@@ -2540,6 +3783,7 @@ func createScQueryService(
 		scQueryService, err := createScQueryElement(
 			generalConfig,
 			accnts,
+			userAccountsTrie,
 			validatorAccounts,
 			pubkeyConv,
 			storageService,
@@ -2553,6 +3797,7 @@ func createScQueryService(
 			economics,
 			messageSigVerifier,
 			nodesSetup,
+			nodesCoordinator,
 			systemSCConfig,
 			rater,
 			epochNotifier,
@@ -2578,6 +3823,7 @@ func createScQueryService(
 func createScQueryElement(
 	generalConfig *config.Config,
 	accnts state.AccountsAdapter,
+	userAccountsTrie data.Trie,
 	validatorAccounts state.AccountsAdapter,
 	pubkeyConv core.PubkeyConverter,
 	storageService dataRetriever.StorageService,
@@ -2591,6 +3837,7 @@ func createScQueryElement(
 	economics process.EconomicsDataHandler,
 	messageSigVerifier vm.MessageSignVerifier,
 	nodesSetup sharding.GenesisNodesSetupHandler,
+	nodesCoordinator sharding.NodesCoordinator,
 	systemSCConfig *config.SystemSmartContractsConfig,
 	rater sharding.PeerAccountListAndRatingHandler,
 	epochNotifier process.EpochNotifier,
@@ -2600,10 +3847,21 @@ func createScQueryElement(
 	var vmFactory process.VirtualMachinesContainerFactory
 	var err error
 
+	historicalQueriesEnabled := generalConfig.VirtualMachine.Querying.HistoricalQueriesEnabled
+	if historicalQueriesEnabled {
+		// each query service needs its own accounts adapter as its trie is recreated on each query
+		accnts, err = createHistoricalQueryAccounts(userAccountsTrie, hasher, marshalizer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	builtInFuncs, err := createBuiltinFuncs(
 		gasScheduleNotifier,
 		marshalizer,
 		accnts,
+		epochNotifier,
+		generalConfig.GeneralSettings.DeveloperRewardsOverrideEnableEpoch,
 	)
 	if err != nil {
 		return nil, err
@@ -2640,12 +3898,18 @@ func createScQueryElement(
 			MessageSignVerifier: messageSigVerifier,
 			GasSchedule:         gasScheduleNotifier,
 			NodesConfigProvider: nodesSetup,
+			NodesCoordinator:    nodesCoordinator,
 			Hasher:              hasher,
 			Marshalizer:         marshalizer,
 			SystemSCConfig:      systemSCConfig,
 			ValidatorAccountsDB: validatorAccounts,
 			ChanceComputer:      rater,
 			EpochNotifier:       epochNotifier,
+			RoundDurationChange: vm.RoundDurationChange{
+				EnableEpoch:                    generalConfig.GeneralSettings.RoundDurationChangeEnableEpoch,
+				RoundDurationInMilliseconds:    nodesSetup.GetRoundDuration(),
+				NewRoundDurationInMilliseconds: generalConfig.GeneralSettings.NewRoundDurationInMilliseconds,
+			},
 		}
 		vmFactory, err = metachain.NewVMContainerFactory(argsNewVmFactory)
 		if err != nil {
@@ -2677,19 +3941,54 @@ func createScQueryElement(
 		return nil, err
 	}
 
-	return smartContract.NewSCQueryService(vmContainer, economics, vmFactory.BlockChainHookImpl(), blockChain)
+	argsNewSCQueryService := smartContract.ArgsNewSCQueryService{
+		VmContainer:              vmContainer,
+		EconomicsFee:             economics,
+		BlockChainHook:           vmFactory.BlockChainHookImpl(),
+		BlockChain:               blockChain,
+		HistoricalQueriesEnabled: historicalQueriesEnabled,
+		Accounts:                 accnts,
+		StorageService:           storageService,
+		Marshalizer:              marshalizer,
+		Uint64Converter:          uint64Converter,
+		ShardID:                  shardCoordinator.SelfId(),
+	}
+
+	return smartContract.NewSCQueryService(argsNewSCQueryService)
+}
+
+func createHistoricalQueryAccounts(
+	userAccountsTrie data.Trie,
+	hasher hashing.Hasher,
+	marshalizer marshal.Marshalizer,
+) (state.AccountsAdapter, error) {
+	if check.IfNil(userAccountsTrie) {
+		return nil, fmt.Errorf("%w for historical queries", state.ErrNilTrie)
+	}
+
+	// the new trie shares the storage of the accounts trie, it is recreated at the queried block before each query
+	queryTrie, err := userAccountsTrie.Recreate(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.NewAccountsDB(queryTrie, hasher, marshalizer, stateFactory.NewAccountCreator())
 }
 
 func createBuiltinFuncs(
 	gasScheduleNotifier core.GasScheduleNotifier,
 	marshalizer marshal.Marshalizer,
 	accnts state.AccountsAdapter,
+	epochNotifier process.EpochNotifier,
+	developerRewardsOverrideEnableEpoch uint32,
 ) (process.BuiltInFunctionContainer, error) {
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasScheduleNotifier,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     marshalizer,
-		Accounts:        accnts,
+		GasSchedule:                         gasScheduleNotifier,
+		MapDNSAddresses:                     make(map[string]struct{}),
+		Marshalizer:                         marshalizer,
+		Accounts:                            accnts,
+		EpochNotifier:                       epochNotifier,
+		DeveloperRewardsOverrideEnableEpoch: developerRewardsOverrideEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -61,6 +61,12 @@ func InitMetrics(
 	appStatusHandler.SetUInt64Value(core.MetricCountConsensus, initUint)
 	appStatusHandler.SetUInt64Value(core.MetricCountLeader, initUint)
 	appStatusHandler.SetUInt64Value(core.MetricCountAcceptedBlocks, initUint)
+	appStatusHandler.SetUInt64Value(core.MetricEpochCountConsensus, initUint)
+	appStatusHandler.SetUInt64Value(core.MetricEpochCountLeader, initUint)
+	appStatusHandler.SetUInt64Value(core.MetricEpochCountProposedBlocks, initUint)
+	appStatusHandler.SetUInt64Value(core.MetricEpochCountMissedProposals, initUint)
+	appStatusHandler.SetUInt64Value(core.MetricEpochCountSignatureSharesSent, initUint)
+	appStatusHandler.SetStringValue(core.MetricEpochAverageSubroundDurations, initString)
 	appStatusHandler.SetUInt64Value(core.MetricNumTxInBlock, initUint)
 	appStatusHandler.SetUInt64Value(core.MetricNumMiniBlocks, initUint)
 	appStatusHandler.SetStringValue(core.MetricConsensusState, initString)
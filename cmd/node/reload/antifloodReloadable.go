@@ -0,0 +1,101 @@
+package reload
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+const antifloodReloadableName = "antiflood"
+
+// antifloodReloadable applies the p2p topics antiflood limits. Only the limits of the topics configured at startup can
+// be changed, as the counters of the other topics are not periodically reset
+type antifloodReloadable struct {
+	antifloodHandler         TopicAntifloodHandler
+	mutTopics                sync.Mutex
+	defaultMaxMessagesPerSec uint32
+	topics                   map[string]uint32
+}
+
+// NewAntifloodReloadable creates a new antiflood reloadable
+func NewAntifloodReloadable(
+	antifloodHandler TopicAntifloodHandler,
+	initialConfig config.TopicAntifloodConfig,
+) (*antifloodReloadable, error) {
+	if check.IfNil(antifloodHandler) {
+		return nil, ErrNilTopicAntifloodHandler
+	}
+
+	return &antifloodReloadable{
+		antifloodHandler:         antifloodHandler,
+		defaultMaxMessagesPerSec: initialConfig.DefaultMaxMessagesPerSec,
+		topics:                   topicsMaxMessages(initialConfig),
+	}, nil
+}
+
+func topicsMaxMessages(topicConfig config.TopicAntifloodConfig) map[string]uint32 {
+	topics := make(map[string]uint32, len(topicConfig.MaxMessages))
+	for _, topicMaxMessages := range topicConfig.MaxMessages {
+		topics[topicMaxMessages.Topic] = topicMaxMessages.NumMessagesPerSec
+	}
+
+	return topics
+}
+
+// Name returns the name of the reloaded configuration
+func (ar *antifloodReloadable) Name() string {
+	return antifloodReloadableName
+}
+
+// Validate checks that only the limits of the topics configured at startup were changed
+func (ar *antifloodReloadable) Validate(configs *Configs) error {
+	topicConfig := configs.GeneralConfig.Antiflood.Topic
+	if topicConfig.DefaultMaxMessagesPerSec != ar.defaultMaxMessagesPerSec {
+		return errors.New("DefaultMaxMessagesPerSec can not be changed without restarting the node")
+	}
+
+	newTopics := topicsMaxMessages(topicConfig)
+	if len(newTopics) != len(topicConfig.MaxMessages) {
+		return errors.New("duplicated topic in MaxMessages")
+	}
+
+	ar.mutTopics.Lock()
+	defer ar.mutTopics.Unlock()
+
+	if len(newTopics) != len(ar.topics) {
+		return errors.New("the MaxMessages topics can not be changed without restarting the node")
+	}
+	for topic := range newTopics {
+		_, exists := ar.topics[topic]
+		if !exists {
+			return fmt.Errorf("topic %s can not be added without restarting the node", topic)
+		}
+	}
+
+	return nil
+}
+
+// Apply sets the new limits of the topics
+func (ar *antifloodReloadable) Apply(configs *Configs) error {
+	ar.mutTopics.Lock()
+	defer ar.mutTopics.Unlock()
+
+	for _, topicMaxMessages := range configs.GeneralConfig.Antiflood.Topic.MaxMessages {
+		if ar.topics[topicMaxMessages.Topic] == topicMaxMessages.NumMessagesPerSec {
+			continue
+		}
+
+		ar.antifloodHandler.SetMaxMessagesForTopic(topicMaxMessages.Topic, topicMaxMessages.NumMessagesPerSec)
+		ar.topics[topicMaxMessages.Topic] = topicMaxMessages.NumMessagesPerSec
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ar *antifloodReloadable) IsInterfaceNil() bool {
+	return ar == nil
+}
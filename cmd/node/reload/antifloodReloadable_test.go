@@ -0,0 +1,80 @@
+package reload_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/node/reload"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	processMock "github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTopicAntifloodConfig(heartbeatMaxMessages uint32) config.TopicAntifloodConfig {
+	return config.TopicAntifloodConfig{
+		DefaultMaxMessagesPerSec: 100,
+		MaxMessages: []config.TopicMaxMessagesConfig{
+			{Topic: "heartbeat", NumMessagesPerSec: heartbeatMaxMessages},
+			{Topic: "metachainBlocks", NumMessagesPerSec: 30},
+		},
+	}
+}
+
+func createConfigsWithTopicAntiflood(topicConfig config.TopicAntifloodConfig) *reload.Configs {
+	configs := createConfigs("")
+	configs.GeneralConfig.Antiflood.Topic = topicConfig
+
+	return configs
+}
+
+func TestNewAntifloodReloadable(t *testing.T) {
+	t.Parallel()
+
+	ar, err := reload.NewAntifloodReloadable(nil, createTopicAntifloodConfig(30))
+	assert.True(t, check.IfNil(ar))
+	assert.Equal(t, reload.ErrNilTopicAntifloodHandler, err)
+
+	ar, err = reload.NewAntifloodReloadable(&processMock.TopicAntiFloodStub{}, createTopicAntifloodConfig(30))
+	assert.False(t, check.IfNil(ar))
+	assert.Nil(t, err)
+}
+
+func TestAntifloodReloadable_Validate(t *testing.T) {
+	t.Parallel()
+
+	ar, _ := reload.NewAntifloodReloadable(&processMock.TopicAntiFloodStub{}, createTopicAntifloodConfig(30))
+
+	topicConfig := createTopicAntifloodConfig(30)
+	topicConfig.DefaultMaxMessagesPerSec = 200
+	assert.NotNil(t, ar.Validate(createConfigsWithTopicAntiflood(topicConfig)))
+
+	topicConfig = createTopicAntifloodConfig(30)
+	topicConfig.MaxMessages[1].Topic = "shardBlocks*"
+	assert.NotNil(t, ar.Validate(createConfigsWithTopicAntiflood(topicConfig)))
+
+	topicConfig = createTopicAntifloodConfig(30)
+	topicConfig.MaxMessages = topicConfig.MaxMessages[:1]
+	assert.NotNil(t, ar.Validate(createConfigsWithTopicAntiflood(topicConfig)))
+
+	topicConfig = createTopicAntifloodConfig(30)
+	topicConfig.MaxMessages[1].Topic = "heartbeat"
+	assert.NotNil(t, ar.Validate(createConfigsWithTopicAntiflood(topicConfig)))
+
+	assert.Nil(t, ar.Validate(createConfigsWithTopicAntiflood(createTopicAntifloodConfig(60))))
+}
+
+func TestAntifloodReloadable_ApplyShouldSetTheChangedLimits(t *testing.T) {
+	t.Parallel()
+
+	setLimits := make(map[string]uint32)
+	antifloodHandler := &processMock.TopicAntiFloodStub{
+		SetMaxMessagesForTopicCalled: func(topic string, num uint32) {
+			setLimits[topic] = num
+		},
+	}
+	ar, _ := reload.NewAntifloodReloadable(antifloodHandler, createTopicAntifloodConfig(30))
+
+	err := ar.Apply(createConfigsWithTopicAntiflood(createTopicAntifloodConfig(60)))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]uint32{"heartbeat": 60}, setLimits)
+}
@@ -0,0 +1,43 @@
+package reload
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+const apiReloadableName = "api"
+
+// apiReloadable applies the API routes configuration and the web server limits
+type apiReloadable struct {
+	apiConfigHandler ApiConfigHandler
+}
+
+// NewApiReloadable creates a new API reloadable
+func NewApiReloadable(apiConfigHandler ApiConfigHandler) (*apiReloadable, error) {
+	if check.IfNil(apiConfigHandler) {
+		return nil, ErrNilApiConfigHandler
+	}
+
+	return &apiReloadable{
+		apiConfigHandler: apiConfigHandler,
+	}, nil
+}
+
+// Name returns the name of the reloaded configuration
+func (ar *apiReloadable) Name() string {
+	return apiReloadableName
+}
+
+// Validate checks that the API configuration can be applied on the running web server
+func (ar *apiReloadable) Validate(configs *Configs) error {
+	return ar.apiConfigHandler.ValidateApiConfig(*configs.ApiRoutesConfig, configs.GeneralConfig.Antiflood.WebServer)
+}
+
+// Apply applies the API configuration on the running web server
+func (ar *apiReloadable) Apply(configs *Configs) error {
+	return ar.apiConfigHandler.ApplyApiConfig(*configs.ApiRoutesConfig, configs.GeneralConfig.Antiflood.WebServer)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ar *apiReloadable) IsInterfaceNil() bool {
+	return ar == nil
+}
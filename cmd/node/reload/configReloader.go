@@ -0,0 +1,129 @@
+package reload
+
+import (
+	"fmt"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+var log = logger.GetOrCreate("main/reload")
+
+// Configs holds the configurations read when reloading, from which each reloadable component applies its own settings
+type Configs struct {
+	GeneralConfig     *config.Config
+	ApiRoutesConfig   *config.ApiRoutesConfig
+	PreferencesConfig *config.Preferences
+}
+
+// ConfigsLoader reads the configurations from their files
+type ConfigsLoader func() (*Configs, error)
+
+// ArgsConfigReloader is the DTO used to create a new instance of a config reloader
+type ArgsConfigReloader struct {
+	ConfigsLoader  ConfigsLoader
+	InitialConfigs *Configs
+}
+
+// configReloader reloads the configurations and applies them on the registered reloadable components. All the
+// components validate the new configurations before any of them applies them, and the components which already
+// applied them are restored to the previous configurations if a component fails, so either all the components or none
+// of them use the new configurations
+type configReloader struct {
+	mutReload      sync.Mutex
+	configsLoader  ConfigsLoader
+	currentConfigs *Configs
+	reloadables    []Reloadable
+}
+
+// NewConfigReloader creates a new config reloader
+func NewConfigReloader(args ArgsConfigReloader) (*configReloader, error) {
+	if args.ConfigsLoader == nil {
+		return nil, ErrNilConfigsLoader
+	}
+	err := checkConfigs(args.InitialConfigs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &configReloader{
+		configsLoader:  args.ConfigsLoader,
+		currentConfigs: args.InitialConfigs,
+		reloadables:    make([]Reloadable, 0),
+	}, nil
+}
+
+func checkConfigs(configs *Configs) error {
+	if configs == nil || configs.GeneralConfig == nil || configs.ApiRoutesConfig == nil || configs.PreferencesConfig == nil {
+		return ErrNilConfigs
+	}
+
+	return nil
+}
+
+// AddReloadable registers a component to which the reloaded configurations are applied
+func (cr *configReloader) AddReloadable(reloadable Reloadable) error {
+	if check.IfNil(reloadable) {
+		return ErrNilReloadable
+	}
+
+	cr.mutReload.Lock()
+	cr.reloadables = append(cr.reloadables, reloadable)
+	cr.mutReload.Unlock()
+
+	return nil
+}
+
+// Reload reads the configurations and applies them on all the registered components, returning their names
+func (cr *configReloader) Reload() ([]string, error) {
+	cr.mutReload.Lock()
+	defer cr.mutReload.Unlock()
+
+	configs, err := cr.configsLoader()
+	if err != nil {
+		return nil, err
+	}
+	err = checkConfigs(configs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, reloadable := range cr.reloadables {
+		err = reloadable.Validate(configs)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s: %v", ErrInvalidConfig, reloadable.Name(), err)
+		}
+	}
+
+	applied := make([]string, 0, len(cr.reloadables))
+	for index, reloadable := range cr.reloadables {
+		err = reloadable.Apply(configs)
+		if err != nil {
+			cr.restore(cr.reloadables[:index])
+			return nil, fmt.Errorf("%w for %s, the previous configuration was restored: %v", ErrApplyConfig, reloadable.Name(), err)
+		}
+
+		applied = append(applied, reloadable.Name())
+	}
+
+	cr.currentConfigs = configs
+	log.Info("configuration reloaded", "applied", applied)
+
+	return applied, nil
+}
+
+func (cr *configReloader) restore(reloadables []Reloadable) {
+	for i := len(reloadables) - 1; i >= 0; i-- {
+		err := reloadables[i].Apply(cr.currentConfigs)
+		if err != nil {
+			log.Error("configReloader.restore", "reloadable", reloadables[i].Name(), "error", err.Error())
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cr *configReloader) IsInterfaceNil() bool {
+	return cr == nil
+}
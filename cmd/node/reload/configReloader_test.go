@@ -0,0 +1,140 @@
+package reload_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/node/reload"
+	"github.com/ElrondNetwork/elrond-go/cmd/node/reload/mock"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createConfigs(logLevel string) *reload.Configs {
+	return &reload.Configs{
+		GeneralConfig: &config.Config{
+			Logs: config.LogsConfig{LogLevel: logLevel},
+		},
+		ApiRoutesConfig:   &config.ApiRoutesConfig{},
+		PreferencesConfig: &config.Preferences{},
+	}
+}
+
+func createMockArgsConfigReloader(loadedConfigs *reload.Configs) reload.ArgsConfigReloader {
+	return reload.ArgsConfigReloader{
+		ConfigsLoader: func() (*reload.Configs, error) {
+			return loadedConfigs, nil
+		},
+		InitialConfigs: createConfigs("initial"),
+	}
+}
+
+func TestNewConfigReloader(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsConfigReloader(createConfigs("new"))
+	args.ConfigsLoader = nil
+	cr, err := reload.NewConfigReloader(args)
+	assert.True(t, check.IfNil(cr))
+	assert.Equal(t, reload.ErrNilConfigsLoader, err)
+
+	args = createMockArgsConfigReloader(createConfigs("new"))
+	args.InitialConfigs.PreferencesConfig = nil
+	cr, err = reload.NewConfigReloader(args)
+	assert.True(t, check.IfNil(cr))
+	assert.Equal(t, reload.ErrNilConfigs, err)
+
+	cr, err = reload.NewConfigReloader(createMockArgsConfigReloader(createConfigs("new")))
+	assert.False(t, check.IfNil(cr))
+	assert.Nil(t, err)
+	assert.Equal(t, reload.ErrNilReloadable, cr.AddReloadable(nil))
+}
+
+func TestConfigReloader_ReloadLoaderErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsConfigReloader(nil)
+	args.ConfigsLoader = func() (*reload.Configs, error) {
+		return nil, expectedErr
+	}
+	cr, _ := reload.NewConfigReloader(args)
+
+	applied, err := cr.Reload()
+	assert.Nil(t, applied)
+	assert.Equal(t, expectedErr, err)
+
+	cr, _ = reload.NewConfigReloader(createMockArgsConfigReloader(&reload.Configs{}))
+	applied, err = cr.Reload()
+	assert.Nil(t, applied)
+	assert.Equal(t, reload.ErrNilConfigs, err)
+}
+
+func TestConfigReloader_ReloadInvalidConfigShouldNotApply(t *testing.T) {
+	t.Parallel()
+
+	cr, _ := reload.NewConfigReloader(createMockArgsConfigReloader(createConfigs("new")))
+	numApplied := 0
+	apply := func(configs *reload.Configs) error {
+		numApplied++
+		return nil
+	}
+	_ = cr.AddReloadable(&mock.ReloadableStub{NameValue: "first", ApplyCalled: apply})
+	_ = cr.AddReloadable(&mock.ReloadableStub{
+		NameValue: "second",
+		ValidateCalled: func(configs *reload.Configs) error {
+			return errors.New("invalid")
+		},
+		ApplyCalled: apply,
+	})
+
+	applied, err := cr.Reload()
+	assert.Nil(t, applied)
+	assert.True(t, errors.Is(err, reload.ErrInvalidConfig))
+	assert.Equal(t, 0, numApplied)
+}
+
+func TestConfigReloader_ReloadApplyErrorShouldRestore(t *testing.T) {
+	t.Parallel()
+
+	cr, _ := reload.NewConfigReloader(createMockArgsConfigReloader(createConfigs("new")))
+	firstLevels := make([]string, 0)
+	_ = cr.AddReloadable(&mock.ReloadableStub{
+		NameValue: "first",
+		ApplyCalled: func(configs *reload.Configs) error {
+			firstLevels = append(firstLevels, configs.GeneralConfig.Logs.LogLevel)
+			return nil
+		},
+	})
+	_ = cr.AddReloadable(&mock.ReloadableStub{
+		NameValue: "second",
+		ApplyCalled: func(configs *reload.Configs) error {
+			return errors.New("apply error")
+		},
+	})
+
+	applied, err := cr.Reload()
+	assert.Nil(t, applied)
+	assert.True(t, errors.Is(err, reload.ErrApplyConfig))
+	assert.Equal(t, []string{"new", "initial"}, firstLevels)
+}
+
+func TestConfigReloader_ReloadShouldWork(t *testing.T) {
+	t.Parallel()
+
+	cr, _ := reload.NewConfigReloader(createMockArgsConfigReloader(createConfigs("new")))
+	appliedLevels := make([]string, 0)
+	apply := func(configs *reload.Configs) error {
+		appliedLevels = append(appliedLevels, configs.GeneralConfig.Logs.LogLevel)
+		return nil
+	}
+	_ = cr.AddReloadable(&mock.ReloadableStub{NameValue: "first", ApplyCalled: apply})
+	_ = cr.AddReloadable(&mock.ReloadableStub{NameValue: "second", ApplyCalled: apply})
+
+	applied, err := cr.Reload()
+	require.Nil(t, err)
+	assert.Equal(t, []string{"first", "second"}, applied)
+	assert.Equal(t, []string{"new", "new"}, appliedLevels)
+}
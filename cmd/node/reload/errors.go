@@ -0,0 +1,27 @@
+package reload
+
+import "errors"
+
+// ErrNilConfigsLoader signals that a nil configs loader has been provided
+var ErrNilConfigsLoader = errors.New("nil configs loader")
+
+// ErrNilConfigs signals that nil or incomplete configurations have been provided
+var ErrNilConfigs = errors.New("nil configs")
+
+// ErrNilReloadable signals that a nil reloadable component has been provided
+var ErrNilReloadable = errors.New("nil reloadable")
+
+// ErrNilApiConfigHandler signals that a nil API config handler has been provided
+var ErrNilApiConfigHandler = errors.New("nil API config handler")
+
+// ErrNilTopicAntifloodHandler signals that a nil topic antiflood handler has been provided
+var ErrNilTopicAntifloodHandler = errors.New("nil topic antiflood handler")
+
+// ErrNilPeerConnector signals that a nil peer connector has been provided
+var ErrNilPeerConnector = errors.New("nil peer connector")
+
+// ErrInvalidConfig signals that a reloaded configuration is invalid
+var ErrInvalidConfig = errors.New("invalid reloaded configuration")
+
+// ErrApplyConfig signals that a reloaded configuration could not be applied
+var ErrApplyConfig = errors.New("error applying the reloaded configuration")
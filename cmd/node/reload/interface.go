@@ -0,0 +1,32 @@
+package reload
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+// Reloadable defines a component able to apply a new configuration without restarting the node
+type Reloadable interface {
+	Name() string
+	Validate(configs *Configs) error
+	Apply(configs *Configs) error
+	IsInterfaceNil() bool
+}
+
+// ApiConfigHandler defines the component applying the API routes and the web server limits on the running web server
+type ApiConfigHandler interface {
+	ValidateApiConfig(routesConfig config.ApiRoutesConfig, antifloodConfig config.WebServerAntifloodConfig) error
+	ApplyApiConfig(routesConfig config.ApiRoutesConfig, antifloodConfig config.WebServerAntifloodConfig) error
+	IsInterfaceNil() bool
+}
+
+// TopicAntifloodHandler defines the p2p antiflood component able to change the limits of a topic
+type TopicAntifloodHandler interface {
+	SetMaxMessagesForTopic(topic string, maxNum uint32)
+	IsInterfaceNil() bool
+}
+
+// PeerConnector defines the component able to connect to a peer
+type PeerConnector interface {
+	ConnectToPeer(address string) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,49 @@
+package reload
+
+import (
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+const logLevelReloadableName = "log level"
+
+// logLevelReloadable applies the log level pattern of the logs configuration. An empty pattern keeps the current log
+// levels, which might have been changed on management request
+type logLevelReloadable struct {
+}
+
+// NewLogLevelReloadable creates a new log level reloadable
+func NewLogLevelReloadable() *logLevelReloadable {
+	return &logLevelReloadable{}
+}
+
+// Name returns the name of the reloaded configuration
+func (llr *logLevelReloadable) Name() string {
+	return logLevelReloadableName
+}
+
+// Validate checks that the log level pattern can be parsed
+func (llr *logLevelReloadable) Validate(configs *Configs) error {
+	pattern := configs.GeneralConfig.Logs.LogLevel
+	if len(pattern) == 0 {
+		return nil
+	}
+
+	_, _, err := logger.ParseLogLevelAndMatchingString(pattern)
+
+	return err
+}
+
+// Apply sets the log level pattern
+func (llr *logLevelReloadable) Apply(configs *Configs) error {
+	pattern := configs.GeneralConfig.Logs.LogLevel
+	if len(pattern) == 0 {
+		return nil
+	}
+
+	return logger.SetLogLevel(pattern)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (llr *logLevelReloadable) IsInterfaceNil() bool {
+	return llr == nil
+}
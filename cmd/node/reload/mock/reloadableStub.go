@@ -0,0 +1,40 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/cmd/node/reload"
+)
+
+// ReloadableStub -
+type ReloadableStub struct {
+	NameValue      string
+	ValidateCalled func(configs *reload.Configs) error
+	ApplyCalled    func(configs *reload.Configs) error
+}
+
+// Name -
+func (rs *ReloadableStub) Name() string {
+	return rs.NameValue
+}
+
+// Validate -
+func (rs *ReloadableStub) Validate(configs *reload.Configs) error {
+	if rs.ValidateCalled != nil {
+		return rs.ValidateCalled(configs)
+	}
+
+	return nil
+}
+
+// Apply -
+func (rs *ReloadableStub) Apply(configs *reload.Configs) error {
+	if rs.ApplyCalled != nil {
+		return rs.ApplyCalled(configs)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (rs *ReloadableStub) IsInterfaceNil() bool {
+	return rs == nil
+}
@@ -0,0 +1,81 @@
+package reload
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+const preferredConnectionsReloadableName = "preferred connections"
+
+// preferredConnectionsReloadable connects to the preferred peers of the preferences configuration. The peers which
+// were successfully connected when the configuration was previously applied are not connected again
+type preferredConnectionsReloadable struct {
+	peerConnector PeerConnector
+	mutAddresses  sync.Mutex
+	addresses     map[string]struct{}
+}
+
+// NewPreferredConnectionsReloadable creates a new preferred connections reloadable
+func NewPreferredConnectionsReloadable(peerConnector PeerConnector) (*preferredConnectionsReloadable, error) {
+	if check.IfNil(peerConnector) {
+		return nil, ErrNilPeerConnector
+	}
+
+	return &preferredConnectionsReloadable{
+		peerConnector: peerConnector,
+		addresses:     make(map[string]struct{}),
+	}, nil
+}
+
+// Name returns the name of the reloaded configuration
+func (pcr *preferredConnectionsReloadable) Name() string {
+	return preferredConnectionsReloadableName
+}
+
+// Validate checks that no empty address was provided
+func (pcr *preferredConnectionsReloadable) Validate(configs *Configs) error {
+	for index, address := range configs.PreferencesConfig.Preferences.PreferredConnections {
+		if len(strings.TrimSpace(address)) == 0 {
+			return fmt.Errorf("empty preferred connection address at index %d", index)
+		}
+	}
+
+	return nil
+}
+
+// Apply connects to the newly added preferred peers. The connection errors are only logged, as the peers might not
+// be reachable at the moment, so they do not cause the configuration to be rejected
+func (pcr *preferredConnectionsReloadable) Apply(configs *Configs) error {
+	pcr.mutAddresses.Lock()
+	defer pcr.mutAddresses.Unlock()
+
+	addresses := make(map[string]struct{})
+	for _, address := range configs.PreferencesConfig.Preferences.PreferredConnections {
+		address = strings.TrimSpace(address)
+		_, isConnected := pcr.addresses[address]
+		if isConnected {
+			addresses[address] = struct{}{}
+			continue
+		}
+
+		err := pcr.peerConnector.ConnectToPeer(address)
+		if err != nil {
+			log.Warn("can not connect to the preferred peer", "address", address, "error", err.Error())
+			continue
+		}
+
+		log.Debug("connected to the preferred peer", "address", address)
+		addresses[address] = struct{}{}
+	}
+	pcr.addresses = addresses
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pcr *preferredConnectionsReloadable) IsInterfaceNil() bool {
+	return pcr == nil
+}
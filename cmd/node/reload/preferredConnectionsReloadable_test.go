@@ -0,0 +1,65 @@
+package reload_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/node/reload"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	processMock "github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createConfigsWithPreferredConnections(addresses ...string) *reload.Configs {
+	configs := createConfigs("")
+	configs.PreferencesConfig.Preferences.PreferredConnections = addresses
+
+	return configs
+}
+
+func TestNewPreferredConnectionsReloadable(t *testing.T) {
+	t.Parallel()
+
+	pcr, err := reload.NewPreferredConnectionsReloadable(nil)
+	assert.True(t, check.IfNil(pcr))
+	assert.Equal(t, reload.ErrNilPeerConnector, err)
+
+	pcr, err = reload.NewPreferredConnectionsReloadable(&processMock.MessengerStub{})
+	assert.False(t, check.IfNil(pcr))
+	assert.Nil(t, err)
+}
+
+func TestPreferredConnectionsReloadable_Validate(t *testing.T) {
+	t.Parallel()
+
+	pcr, _ := reload.NewPreferredConnectionsReloadable(&processMock.MessengerStub{})
+
+	assert.NotNil(t, pcr.Validate(createConfigsWithPreferredConnections("/ip4/127.0.0.1/tcp/10000", " ")))
+	assert.Nil(t, pcr.Validate(createConfigsWithPreferredConnections("/ip4/127.0.0.1/tcp/10000")))
+}
+
+func TestPreferredConnectionsReloadable_ApplyShouldConnectToTheNewPeers(t *testing.T) {
+	t.Parallel()
+
+	connected := make([]string, 0)
+	messenger := &processMock.MessengerStub{
+		ConnectToPeerCalled: func(address string) error {
+			connected = append(connected, address)
+			if address == "unreachable" {
+				return errors.New("unreachable")
+			}
+
+			return nil
+		},
+	}
+	pcr, _ := reload.NewPreferredConnectionsReloadable(messenger)
+
+	err := pcr.Apply(createConfigsWithPreferredConnections("peer1", "unreachable"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"peer1", "unreachable"}, connected)
+
+	connected = make([]string, 0)
+	err = pcr.Apply(createConfigsWithPreferredConnections("peer1", "unreachable", "peer2"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"unreachable", "peer2"}, connected)
+}
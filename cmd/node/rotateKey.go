@@ -0,0 +1,246 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/node/rotation"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/keystore"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519/singlesig"
+	mclSig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	factoryMarshalizer "github.com/ElrondNetwork/elrond-go/marshal/factory"
+	"github.com/urfave/cli"
+)
+
+const nodeRestAPIRequestTimeout = 10 * time.Second
+
+var (
+	// rotatedValidatorKeyFile defines a flag for the path to the validator key generated by the rotate-key command
+	rotatedValidatorKeyFile = cli.StringFlag{
+		Name: "rotated-validator-key-file",
+		Usage: "The `filepath` for the validator key generated by the rotate-key command. The node loads it as a " +
+			"managed key, so it keeps validating once the new key takes the place of the old one.",
+		Value: "./config/rotatedValidatorKey.pem",
+	}
+	// walletKeyPemFile defines a flag for the path to the wallet key of the validator owner
+	walletKeyPemFile = cli.StringFlag{
+		Name:  "wallet-key-pem-file",
+		Usage: "The `filepath` for the PEM file holding the wallet key of the validator owner, signing the swapNode transaction",
+		Value: "./config/walletKey.pem",
+	}
+	// nodeRestAPI defines a flag for the REST API of a node in the owner's shard
+	nodeRestAPI = cli.StringFlag{
+		Name:  "node-rest-api",
+		Usage: "The `URL` of the REST API of a node in the owner's shard, used to send the swapNode transaction",
+		Value: "http://localhost:8080",
+	}
+	// swapNodeGasLimit defines a flag for the gas limit of the swapNode transaction
+	swapNodeGasLimit = cli.Uint64Flag{
+		Name:  "gas-limit",
+		Usage: "The gas limit of the swapNode transaction",
+		Value: 6000000,
+	}
+
+	rotateKeyCommand = cli.Command{
+		Name:  "rotate-key",
+		Usage: "Replaces the validator BLS key of the node with a newly generated one",
+		Description: "Generates a new BLS key for the validator key of the node and asks the validator system SC, with a " +
+			"swapNode transaction signed by the owner, to replace the old key with it at the next end of epoch. The " +
+			"running node, started with the same rotated-validator-key-file, switches to the new key by itself",
+		Flags: []cli.Flag{
+			walletKeyPemFile,
+			nodeRestAPI,
+			swapNodeGasLimit,
+		},
+		Action: rotateKey,
+	}
+)
+
+func rotateKey(ctx *cli.Context) error {
+	log := logger.GetOrCreate("main")
+
+	generalConfig, err := loadMainConfig(ctx.GlobalString(configurationFile.Name))
+	if err != nil {
+		return err
+	}
+
+	rotatedKeyFileName := ctx.GlobalString(rotatedValidatorKeyFile.Name)
+	if core.DoesFileExist(rotatedKeyFileName) {
+		return fmt.Errorf("the rotated key file %s already exists: a rotation is in progress or the file was not "+
+			"made the validator key file after the previous one", rotatedKeyFileName)
+	}
+
+	passphraseProvider := keystore.NewPassphraseProvider(keystore.ArgsPassphraseProvider{
+		PassphraseFile: ctx.GlobalString(keystorePassphraseFile.Name),
+		EnvVariable:    keystore.PassphraseEnvVariable,
+	})
+	oldBlsKey, err := loadOldBlsKey(ctx, generalConfig, passphraseProvider)
+	if err != nil {
+		return err
+	}
+
+	requester, err := createSwapNodeRequester(ctx, generalConfig)
+	if err != nil {
+		return err
+	}
+
+	newPrivateKey, newPublicKey := requester.GenerateBlsKey()
+	newBlsKey, err := newPublicKey.ToByteArray()
+	if err != nil {
+		return err
+	}
+
+	// the new key is saved before the swap is requested, so it can not get lost
+	err = saveRotatedKey(ctx, rotatedKeyFileName, newPrivateKey, newBlsKey, passphraseProvider)
+	if err != nil {
+		return err
+	}
+
+	txHash, err := requester.RequestSwapNode(oldBlsKey, newPrivateKey)
+	if err != nil {
+		_ = os.Remove(rotatedKeyFileName)
+		return fmt.Errorf("%w while sending the swapNode transaction, the rotated key file was removed", err)
+	}
+
+	log.Info("swapNode transaction sent, the new key takes the place of the old one at the next end of epoch",
+		"tx hash", txHash,
+		"old key", hex.EncodeToString(oldBlsKey),
+		"new key", hex.EncodeToString(newBlsKey),
+		"rotated key file", rotatedKeyFileName)
+	if generalConfig.RemoteSigner.Enabled {
+		log.Warn("the node operates the rotated key locally, load it in the remote signer and make it the remote " +
+			"signer key at the next restart")
+	}
+
+	return nil
+}
+
+func loadOldBlsKey(
+	ctx *cli.Context,
+	generalConfig *config.Config,
+	passphraseProvider keystore.PassphraseProvider,
+) ([]byte, error) {
+	if generalConfig.RemoteSigner.Enabled {
+		return hex.DecodeString(generalConfig.RemoteSigner.PublicKey)
+	}
+
+	_, encodedPk, err := keystore.LoadSkPkFromFile(
+		ctx.GlobalString(validatorKeyPemFile.Name),
+		ctx.GlobalInt(validatorKeyIndex.Name),
+		passphraseProvider,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return hex.DecodeString(encodedPk)
+}
+
+func createSwapNodeRequester(ctx *cli.Context, generalConfig *config.Config) (rotation.SwapNodeRequester, error) {
+	suite, err := getSuite(generalConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	walletSkBytes, _, err := core.LoadSkPkFromPemFile(ctx.String(walletKeyPemFile.Name), 0)
+	if err != nil {
+		return nil, err
+	}
+	walletSk, err := hex.DecodeString(string(walletSkBytes))
+	if err != nil {
+		return nil, fmt.Errorf("%w for the encoded wallet secret key", err)
+	}
+	walletPrivateKey, err := signing.NewKeyGenerator(ed25519.NewEd25519()).PrivateKeyFromByteArray(walletSk)
+	if err != nil {
+		return nil, err
+	}
+
+	addressPubkeyConverter, err := stateFactory.NewPubkeyConverter(generalConfig.AddressPubkeyConverter)
+	if err != nil {
+		return nil, err
+	}
+	txSignMarshalizer, err := factoryMarshalizer.NewMarshalizer(generalConfig.TxSignMarshalizer.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeClient, err := rotation.NewNodeRestClient(rotation.ArgsNodeRestClient{
+		URL:            ctx.String(nodeRestAPI.Name),
+		RequestTimeout: nodeRestAPIRequestTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rotation.NewSwapNodeRequester(rotation.ArgsSwapNodeRequester{
+		BlsKeyGenerator:        signing.NewKeyGenerator(suite),
+		BlsSigner:              &mclSig.BlsSingleSigner{},
+		TxSigner:               &singlesig.Ed25519Signer{},
+		WalletPrivateKey:       walletPrivateKey,
+		AddressPubkeyConverter: addressPubkeyConverter,
+		TxSignMarshalizer:      txSignMarshalizer,
+		NodeClient:             nodeClient,
+		GasLimit:               ctx.Uint64(swapNodeGasLimit.Name),
+	})
+}
+
+// saveRotatedKey saves the new key as the validator key is saved: in a keystore encrypted with the same passphrase or
+// in a plaintext PEM file
+func saveRotatedKey(
+	ctx *cli.Context,
+	fileName string,
+	privateKey crypto.PrivateKey,
+	publicKey []byte,
+	passphraseProvider keystore.PassphraseProvider,
+) error {
+	skBytes, err := privateKey.ToByteArray()
+	if err != nil {
+		return err
+	}
+	encodedSk := []byte(hex.EncodeToString(skBytes))
+	encodedPk := hex.EncodeToString(publicKey)
+
+	validatorKeyFileName := ctx.GlobalString(validatorKeyPemFile.Name)
+	isKeystore, err := keystore.IsKeystoreFile(validatorKeyFileName)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if !isKeystore {
+		file, errOpen := os.OpenFile(fileName, os.O_CREATE|os.O_EXCL|os.O_WRONLY, core.FileModeUserReadWrite)
+		if errOpen != nil {
+			return errOpen
+		}
+
+		err = core.SaveSkToPemFile(file, encodedPk, encodedSk)
+		errClose := file.Close()
+		if err != nil {
+			return err
+		}
+
+		return errClose
+	}
+
+	passphrase, err := passphraseProvider.Passphrase(validatorKeyFileName)
+	if err != nil {
+		return err
+	}
+	kdfParams, err := keystore.NewKdfParams(keystore.KdfScrypt)
+	if err != nil {
+		return err
+	}
+	ks, err := keystore.Encrypt([]keystore.KeyEntry{{Identifier: encodedPk, Secret: encodedSk}}, passphrase, kdfParams)
+	if err != nil {
+		return err
+	}
+
+	return ks.Save(fileName)
+}
@@ -0,0 +1,33 @@
+package rotation
+
+import "errors"
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilPrivateKey signals that a nil private key has been provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilNodeClient signals that a nil node client has been provided
+var ErrNilNodeClient = errors.New("nil node client")
+
+// ErrInvalidGasLimit signals that an invalid gas limit has been provided
+var ErrInvalidGasLimit = errors.New("invalid gas limit")
+
+// ErrInvalidNodeURL signals that an invalid URL of the node REST API has been provided
+var ErrInvalidNodeURL = errors.New("invalid node URL")
+
+// ErrInvalidRequestTimeout signals that an invalid request timeout has been provided
+var ErrInvalidRequestTimeout = errors.New("invalid request timeout")
+
+// ErrNodeRequestFailed signals that a request to the node REST API failed
+var ErrNodeRequestFailed = errors.New("node request failed")
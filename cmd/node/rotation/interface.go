@@ -0,0 +1,22 @@
+package rotation
+
+import (
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+// NodeClient defines the node REST API endpoints needed to send a transaction on behalf of the validator owner
+type NodeClient interface {
+	GetAccountNonce(address string) (uint64, error)
+	GetNetworkConfig() (*NetworkConfig, error)
+	SendTransaction(tx *transaction.FrontendTransaction) (string, error)
+	IsInterfaceNil() bool
+}
+
+// SwapNodeRequester defines the component generating the new BLS key of a validator and requesting the swap of the
+// old key with it
+type SwapNodeRequester interface {
+	GenerateBlsKey() (crypto.PrivateKey, crypto.PublicKey)
+	RequestSwapNode(oldBlsKey []byte, newBlsPrivateKey crypto.PrivateKey) (string, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,43 @@
+package rotation
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+type nodeClientStub struct {
+	GetAccountNonceCalled  func(address string) (uint64, error)
+	GetNetworkConfigCalled func() (*NetworkConfig, error)
+	SendTransactionCalled  func(tx *transaction.FrontendTransaction) (string, error)
+}
+
+// GetAccountNonce -
+func (stub *nodeClientStub) GetAccountNonce(address string) (uint64, error) {
+	if stub.GetAccountNonceCalled != nil {
+		return stub.GetAccountNonceCalled(address)
+	}
+
+	return 0, nil
+}
+
+// GetNetworkConfig -
+func (stub *nodeClientStub) GetNetworkConfig() (*NetworkConfig, error) {
+	if stub.GetNetworkConfigCalled != nil {
+		return stub.GetNetworkConfigCalled()
+	}
+
+	return &NetworkConfig{}, nil
+}
+
+// SendTransaction -
+func (stub *nodeClientStub) SendTransaction(tx *transaction.FrontendTransaction) (string, error) {
+	if stub.SendTransactionCalled != nil {
+		return stub.SendTransactionCalled(tx)
+	}
+
+	return "", nil
+}
+
+// IsInterfaceNil -
+func (stub *nodeClientStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,167 @@
+package rotation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+var _ NodeClient = (*nodeRestClient)(nil)
+
+const (
+	accountPathTemplate = "/address/%s"
+	networkConfigPath   = "/network/config"
+	sendTransactionPath = "/transaction/send"
+	maxResponseSize     = 1024 * 1024
+)
+
+// NetworkConfig holds the network parameters needed to create a valid transaction
+type NetworkConfig struct {
+	ChainID               string `json:"erd_chain_id"`
+	MinGasPrice           uint64 `json:"erd_min_gas_price"`
+	MinTransactionVersion uint32 `json:"erd_min_transaction_version"`
+}
+
+type genericResponse struct {
+	Data  json.RawMessage `json:"data"`
+	Error string          `json:"error"`
+	Code  string          `json:"code"`
+}
+
+type accountResponse struct {
+	Account struct {
+		Nonce uint64 `json:"nonce"`
+	} `json:"account"`
+}
+
+type networkConfigResponse struct {
+	Config *NetworkConfig `json:"config"`
+}
+
+type sendTransactionResponse struct {
+	TxHash string `json:"txHash"`
+}
+
+// ArgsNodeRestClient is the DTO used to create a new instance of nodeRestClient
+type ArgsNodeRestClient struct {
+	URL            string
+	RequestTimeout time.Duration
+}
+
+// nodeRestClient calls the REST API of a node of the owner's shard
+type nodeRestClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewNodeRestClient creates a new client of the node REST API
+func NewNodeRestClient(args ArgsNodeRestClient) (*nodeRestClient, error) {
+	parsedURL, err := url.Parse(args.URL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidNodeURL, err.Error())
+	}
+	if (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || len(parsedURL.Host) == 0 {
+		return nil, fmt.Errorf("%w, an http or https URL is expected, provided %s", ErrInvalidNodeURL, args.URL)
+	}
+	if args.RequestTimeout <= 0 {
+		return nil, ErrInvalidRequestTimeout
+	}
+
+	return &nodeRestClient{
+		baseURL: strings.TrimRight(args.URL, "/"),
+		httpClient: &http.Client{
+			Timeout: args.RequestTimeout,
+		},
+	}, nil
+}
+
+// GetAccountNonce returns the current nonce of the provided bech32 address
+func (nrc *nodeRestClient) GetAccountNonce(address string) (uint64, error) {
+	response := &accountResponse{}
+	err := nrc.call(http.MethodGet, fmt.Sprintf(accountPathTemplate, address), nil, response)
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Account.Nonce, nil
+}
+
+// GetNetworkConfig returns the chain ID, the minimum gas price and the minimum transaction version of the network
+func (nrc *nodeRestClient) GetNetworkConfig() (*NetworkConfig, error) {
+	response := &networkConfigResponse{}
+	err := nrc.call(http.MethodGet, networkConfigPath, nil, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Config == nil || len(response.Config.ChainID) == 0 {
+		return nil, fmt.Errorf("%w: the network config does not contain the chain ID", ErrNodeRequestFailed)
+	}
+
+	return response.Config, nil
+}
+
+// SendTransaction sends the signed transaction and returns its hash
+func (nrc *nodeRestClient) SendTransaction(tx *transaction.FrontendTransaction) (string, error) {
+	buff, err := json.Marshal(tx)
+	if err != nil {
+		return "", err
+	}
+
+	response := &sendTransactionResponse{}
+	err = nrc.call(http.MethodPost, sendTransactionPath, bytes.NewReader(buff), response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.TxHash, nil
+}
+
+func (nrc *nodeRestClient) call(method string, path string, body io.Reader, data interface{}) error {
+	request, err := http.NewRequest(method, nrc.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	httpResponse, err := nrc.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrNodeRequestFailed, err.Error())
+	}
+	defer func() {
+		_ = httpResponse.Body.Close()
+	}()
+
+	buff, err := ioutil.ReadAll(io.LimitReader(httpResponse.Body, maxResponseSize))
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrNodeRequestFailed, err.Error())
+	}
+
+	response := &genericResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return fmt.Errorf("%w: status %d, %s", ErrNodeRequestFailed, httpResponse.StatusCode, err.Error())
+	}
+	if httpResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: status %d, %s", ErrNodeRequestFailed, httpResponse.StatusCode, response.Error)
+	}
+
+	err = json.Unmarshal(response.Data, data)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrNodeRequestFailed, err.Error())
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nrc *nodeRestClient) IsInterfaceNil() bool {
+	return nrc == nil
+}
@@ -0,0 +1,115 @@
+package rotation
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeResponse(w http.ResponseWriter, status int, data interface{}, errMessage string) {
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"data":  data,
+		"error": errMessage,
+		"code":  "successful",
+	})
+}
+
+func createNodeServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/address/erd1owner", func(w http.ResponseWriter, r *http.Request) {
+		writeResponse(w, http.StatusOK, map[string]interface{}{
+			"account": map[string]interface{}{"address": "erd1owner", "nonce": 37},
+		}, "")
+	})
+	mux.HandleFunc("/network/config", func(w http.ResponseWriter, r *http.Request) {
+		writeResponse(w, http.StatusOK, map[string]interface{}{
+			"config": map[string]interface{}{
+				"erd_chain_id":                "T",
+				"erd_min_gas_price":           1000000000,
+				"erd_min_transaction_version": 1,
+				"erd_round_duration":          6000,
+			},
+		}, "")
+	})
+	mux.HandleFunc("/transaction/send", func(w http.ResponseWriter, r *http.Request) {
+		tx := &transaction.FrontendTransaction{}
+		err := json.NewDecoder(r.Body).Decode(tx)
+		require.Nil(t, err)
+		if len(tx.Signature) == 0 {
+			writeResponse(w, http.StatusBadRequest, nil, "invalid signature")
+			return
+		}
+
+		writeResponse(w, http.StatusOK, map[string]interface{}{"txHash": "0a0b"}, "")
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestNewNodeRestClient_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nrc, err := NewNodeRestClient(ArgsNodeRestClient{URL: "localhost:8080", RequestTimeout: time.Second})
+	assert.True(t, check.IfNil(nrc))
+	assert.True(t, errors.Is(err, ErrInvalidNodeURL))
+
+	nrc, err = NewNodeRestClient(ArgsNodeRestClient{URL: "ftp://localhost:8080", RequestTimeout: time.Second})
+	assert.True(t, check.IfNil(nrc))
+	assert.True(t, errors.Is(err, ErrInvalidNodeURL))
+
+	nrc, err = NewNodeRestClient(ArgsNodeRestClient{URL: "http://localhost:8080"})
+	assert.True(t, check.IfNil(nrc))
+	assert.Equal(t, ErrInvalidRequestTimeout, err)
+
+	nrc, err = NewNodeRestClient(ArgsNodeRestClient{URL: "http://localhost:8080/", RequestTimeout: time.Second})
+	assert.False(t, check.IfNil(nrc))
+	assert.Nil(t, err)
+}
+
+func TestNodeRestClient_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	server := createNodeServer(t)
+	defer server.Close()
+
+	nrc, err := NewNodeRestClient(ArgsNodeRestClient{URL: server.URL, RequestTimeout: time.Second})
+	require.Nil(t, err)
+
+	nonce, err := nrc.GetAccountNonce("erd1owner")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(37), nonce)
+
+	networkConfig, err := nrc.GetNetworkConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, &NetworkConfig{ChainID: "T", MinGasPrice: 1000000000, MinTransactionVersion: 1}, networkConfig)
+
+	txHash, err := nrc.SendTransaction(&transaction.FrontendTransaction{Signature: "aa"})
+	assert.Nil(t, err)
+	assert.Equal(t, "0a0b", txHash)
+}
+
+func TestNodeRestClient_RequestErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	server := createNodeServer(t)
+	defer server.Close()
+
+	nrc, _ := NewNodeRestClient(ArgsNodeRestClient{URL: server.URL, RequestTimeout: time.Second})
+
+	txHash, err := nrc.SendTransaction(&transaction.FrontendTransaction{})
+	assert.True(t, errors.Is(err, ErrNodeRequestFailed))
+	assert.Contains(t, err.Error(), "invalid signature")
+	assert.Empty(t, txHash)
+
+	_, err = nrc.GetAccountNonce("erd1unknown")
+	assert.True(t, errors.Is(err, ErrNodeRequestFailed))
+}
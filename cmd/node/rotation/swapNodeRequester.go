@@ -0,0 +1,197 @@
+package rotation
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+var log = logger.GetOrCreate("cmd/node/rotation")
+
+const swapNodeFunction = "swapNode"
+
+// ArgsSwapNodeRequester is the DTO used to create a new instance of swapNodeRequester
+type ArgsSwapNodeRequester struct {
+	BlsKeyGenerator        crypto.KeyGenerator
+	BlsSigner              crypto.SingleSigner
+	TxSigner               crypto.SingleSigner
+	WalletPrivateKey       crypto.PrivateKey
+	AddressPubkeyConverter core.PubkeyConverter
+	TxSignMarshalizer      marshal.Marshalizer
+	NodeClient             NodeClient
+	GasLimit               uint64
+}
+
+// swapNodeRequester generates the new BLS key of a validator and asks the validator system SC, on behalf of the
+// owner, to replace the old key with it at the next end of epoch
+type swapNodeRequester struct {
+	blsKeyGenerator        crypto.KeyGenerator
+	blsSigner              crypto.SingleSigner
+	txSigner               crypto.SingleSigner
+	walletPrivateKey       crypto.PrivateKey
+	walletAddress          []byte
+	addressPubkeyConverter core.PubkeyConverter
+	txSignMarshalizer      marshal.Marshalizer
+	nodeClient             NodeClient
+	gasLimit               uint64
+}
+
+// NewSwapNodeRequester creates a new swap node requester
+func NewSwapNodeRequester(args ArgsSwapNodeRequester) (*swapNodeRequester, error) {
+	if check.IfNil(args.BlsKeyGenerator) {
+		return nil, ErrNilKeyGenerator
+	}
+	if check.IfNil(args.BlsSigner) {
+		return nil, fmt.Errorf("%w for the BLS signer", ErrNilSingleSigner)
+	}
+	if check.IfNil(args.TxSigner) {
+		return nil, fmt.Errorf("%w for the transaction signer", ErrNilSingleSigner)
+	}
+	if check.IfNil(args.WalletPrivateKey) {
+		return nil, ErrNilPrivateKey
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.TxSignMarshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.NodeClient) {
+		return nil, ErrNilNodeClient
+	}
+	if args.GasLimit == 0 {
+		return nil, ErrInvalidGasLimit
+	}
+
+	walletAddress, err := args.WalletPrivateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return &swapNodeRequester{
+		blsKeyGenerator:        args.BlsKeyGenerator,
+		blsSigner:              args.BlsSigner,
+		txSigner:               args.TxSigner,
+		walletPrivateKey:       args.WalletPrivateKey,
+		walletAddress:          walletAddress,
+		addressPubkeyConverter: args.AddressPubkeyConverter,
+		txSignMarshalizer:      args.TxSignMarshalizer,
+		nodeClient:             args.NodeClient,
+		gasLimit:               args.GasLimit,
+	}, nil
+}
+
+// GenerateBlsKey generates a new BLS key pair. The private key should be saved before requesting the swap
+func (snr *swapNodeRequester) GenerateBlsKey() (crypto.PrivateKey, crypto.PublicKey) {
+	return snr.blsKeyGenerator.GeneratePair()
+}
+
+// RequestSwapNode creates the swapNode transaction replacing the old BLS key with the new one, signs it with the
+// owner's wallet key and sends it through the node. It returns the hash of the sent transaction
+func (snr *swapNodeRequester) RequestSwapNode(oldBlsKey []byte, newBlsPrivateKey crypto.PrivateKey) (string, error) {
+	if check.IfNil(newBlsPrivateKey) {
+		return "", ErrNilPrivateKey
+	}
+
+	newBlsKey, err := newBlsPrivateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return "", err
+	}
+
+	// the new key proves its possession by signing the owner address, as the stake does
+	registrationSignature, err := snr.blsSigner.Sign(newBlsPrivateKey, snr.walletAddress)
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := snr.createTransaction(createSwapNodeTxData(oldBlsKey, newBlsKey, registrationSignature))
+	if err != nil {
+		return "", err
+	}
+
+	txHash, err := snr.nodeClient.SendTransaction(tx)
+	if err != nil {
+		return "", err
+	}
+
+	log.Debug("swapNode transaction sent",
+		"owner", tx.Sender,
+		"nonce", tx.Nonce,
+		"old key", oldBlsKey,
+		"new key", newBlsKey,
+		"tx hash", txHash)
+
+	return txHash, nil
+}
+
+func (snr *swapNodeRequester) createTransaction(data string) (*transaction.FrontendTransaction, error) {
+	sender := snr.addressPubkeyConverter.Encode(snr.walletAddress)
+	nonce, err := snr.nodeClient.GetAccountNonce(sender)
+	if err != nil {
+		return nil, fmt.Errorf("%w while getting the nonce of %s", err, sender)
+	}
+
+	networkConfig, err := snr.nodeClient.GetNetworkConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &transaction.Transaction{
+		Nonce:    nonce,
+		Value:    big.NewInt(0),
+		RcvAddr:  vm.ValidatorSCAddress,
+		SndAddr:  snr.walletAddress,
+		GasPrice: networkConfig.MinGasPrice,
+		GasLimit: snr.gasLimit,
+		Data:     []byte(data),
+		ChainID:  []byte(networkConfig.ChainID),
+		Version:  networkConfig.MinTransactionVersion,
+	}
+
+	buffToSign, err := tx.GetDataForSigning(snr.addressPubkeyConverter, snr.txSignMarshalizer)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := snr.txSigner.Sign(snr.walletPrivateKey, buffToSign)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction.FrontendTransaction{
+		Nonce:     tx.Nonce,
+		Value:     tx.Value.String(),
+		Receiver:  snr.addressPubkeyConverter.Encode(tx.RcvAddr),
+		Sender:    sender,
+		GasPrice:  tx.GasPrice,
+		GasLimit:  tx.GasLimit,
+		Data:      tx.Data,
+		Signature: hex.EncodeToString(signature),
+		ChainID:   networkConfig.ChainID,
+		Version:   tx.Version,
+	}, nil
+}
+
+func createSwapNodeTxData(oldBlsKey []byte, newBlsKey []byte, registrationSignature []byte) string {
+	arguments := []string{
+		swapNodeFunction,
+		hex.EncodeToString(oldBlsKey),
+		hex.EncodeToString(newBlsKey),
+		hex.EncodeToString(registrationSignature),
+	}
+
+	return strings.Join(arguments, "@")
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (snr *swapNodeRequester) IsInterfaceNil() bool {
+	return snr == nil
+}
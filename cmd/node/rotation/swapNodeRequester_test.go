@@ -0,0 +1,175 @@
+package rotation
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519/singlesig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	mclSig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSwapNodeRequester() ArgsSwapNodeRequester {
+	walletPrivateKey, _ := signing.NewKeyGenerator(ed25519.NewEd25519()).GeneratePair()
+	addressPubkeyConverter, _ := pubkeyConverter.NewBech32PubkeyConverter(32)
+
+	return ArgsSwapNodeRequester{
+		BlsKeyGenerator:        signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		BlsSigner:              &mclSig.BlsSingleSigner{},
+		TxSigner:               &singlesig.Ed25519Signer{},
+		WalletPrivateKey:       walletPrivateKey,
+		AddressPubkeyConverter: addressPubkeyConverter,
+		TxSignMarshalizer:      &marshal.JsonMarshalizer{},
+		NodeClient:             &nodeClientStub{},
+		GasLimit:               6000000,
+	}
+}
+
+func TestNewSwapNodeRequester_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSwapNodeRequester()
+	args.BlsKeyGenerator = nil
+	snr, err := NewSwapNodeRequester(args)
+	assert.True(t, check.IfNil(snr))
+	assert.Equal(t, ErrNilKeyGenerator, err)
+
+	args = createMockArgsSwapNodeRequester()
+	args.BlsSigner = nil
+	snr, err = NewSwapNodeRequester(args)
+	assert.True(t, check.IfNil(snr))
+	assert.True(t, errors.Is(err, ErrNilSingleSigner))
+
+	args = createMockArgsSwapNodeRequester()
+	args.TxSigner = nil
+	snr, err = NewSwapNodeRequester(args)
+	assert.True(t, check.IfNil(snr))
+	assert.True(t, errors.Is(err, ErrNilSingleSigner))
+
+	args = createMockArgsSwapNodeRequester()
+	args.WalletPrivateKey = nil
+	snr, err = NewSwapNodeRequester(args)
+	assert.True(t, check.IfNil(snr))
+	assert.Equal(t, ErrNilPrivateKey, err)
+
+	args = createMockArgsSwapNodeRequester()
+	args.AddressPubkeyConverter = nil
+	snr, err = NewSwapNodeRequester(args)
+	assert.True(t, check.IfNil(snr))
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+
+	args = createMockArgsSwapNodeRequester()
+	args.TxSignMarshalizer = nil
+	snr, err = NewSwapNodeRequester(args)
+	assert.True(t, check.IfNil(snr))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	args = createMockArgsSwapNodeRequester()
+	args.NodeClient = nil
+	snr, err = NewSwapNodeRequester(args)
+	assert.True(t, check.IfNil(snr))
+	assert.Equal(t, ErrNilNodeClient, err)
+
+	args = createMockArgsSwapNodeRequester()
+	args.GasLimit = 0
+	snr, err = NewSwapNodeRequester(args)
+	assert.True(t, check.IfNil(snr))
+	assert.Equal(t, ErrInvalidGasLimit, err)
+
+	args = createMockArgsSwapNodeRequester()
+	snr, err = NewSwapNodeRequester(args)
+	assert.False(t, check.IfNil(snr))
+	assert.Nil(t, err)
+}
+
+func TestSwapNodeRequester_RequestSwapNodeShouldSendSignedTransaction(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSwapNodeRequester()
+	walletAddress, _ := args.WalletPrivateKey.GeneratePublic().ToByteArray()
+	oldBlsKey := []byte("old bls key")
+
+	var sentTx *transaction.FrontendTransaction
+	args.NodeClient = &nodeClientStub{
+		GetAccountNonceCalled: func(address string) (uint64, error) {
+			assert.Equal(t, args.AddressPubkeyConverter.Encode(walletAddress), address)
+			return 7, nil
+		},
+		GetNetworkConfigCalled: func() (*NetworkConfig, error) {
+			return &NetworkConfig{
+				ChainID:               "T",
+				MinGasPrice:           1000000000,
+				MinTransactionVersion: 1,
+			}, nil
+		},
+		SendTransactionCalled: func(tx *transaction.FrontendTransaction) (string, error) {
+			sentTx = tx
+			return "tx hash", nil
+		},
+	}
+	snr, _ := NewSwapNodeRequester(args)
+
+	newBlsPrivateKey, newBlsPublicKey := snr.GenerateBlsKey()
+	txHash, err := snr.RequestSwapNode(oldBlsKey, newBlsPrivateKey)
+	require.Nil(t, err)
+	assert.Equal(t, "tx hash", txHash)
+
+	require.NotNil(t, sentTx)
+	assert.Equal(t, uint64(7), sentTx.Nonce)
+	assert.Equal(t, "0", sentTx.Value)
+	assert.Equal(t, args.AddressPubkeyConverter.Encode(vm.ValidatorSCAddress), sentTx.Receiver)
+	assert.Equal(t, uint64(1000000000), sentTx.GasPrice)
+	assert.Equal(t, args.GasLimit, sentTx.GasLimit)
+	assert.Equal(t, "T", sentTx.ChainID)
+	assert.Equal(t, uint32(1), sentTx.Version)
+
+	arguments := strings.Split(string(sentTx.Data), "@")
+	require.Equal(t, 4, len(arguments))
+	assert.Equal(t, swapNodeFunction, arguments[0])
+	assert.Equal(t, hex.EncodeToString(oldBlsKey), arguments[1])
+	newBlsKey, _ := newBlsPublicKey.ToByteArray()
+	assert.Equal(t, hex.EncodeToString(newBlsKey), arguments[2])
+	registrationSignature, _ := hex.DecodeString(arguments[3])
+	err = args.BlsSigner.Verify(newBlsPublicKey, walletAddress, registrationSignature)
+	assert.Nil(t, err)
+
+	txSignature, _ := hex.DecodeString(sentTx.Signature)
+	unsignedTx := *sentTx
+	unsignedTx.Signature = ""
+	buffToSign, _ := args.TxSignMarshalizer.Marshal(&unsignedTx)
+	err = args.TxSigner.Verify(args.WalletPrivateKey.GeneratePublic(), buffToSign, txSignature)
+	assert.Nil(t, err)
+}
+
+func TestSwapNodeRequester_RequestSwapNodeNodeClientErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsSwapNodeRequester()
+	args.NodeClient = &nodeClientStub{
+		GetNetworkConfigCalled: func() (*NetworkConfig, error) {
+			return nil, expectedErr
+		},
+		SendTransactionCalled: func(tx *transaction.FrontendTransaction) (string, error) {
+			assert.Fail(t, "should not have sent the transaction")
+			return "", nil
+		},
+	}
+	snr, _ := NewSwapNodeRequester(args)
+
+	newBlsPrivateKey, _ := snr.GenerateBlsKey()
+	txHash, err := snr.RequestSwapNode([]byte("old bls key"), newBlsPrivateKey)
+	assert.Equal(t, expectedErr, err)
+	assert.Empty(t, txHash)
+}
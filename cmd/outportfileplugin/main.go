@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go/core/indexer/grpcStream"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/plugins"
+	"github.com/urfave/cli"
+)
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// outputFile defines a flag for the file the outport items are appended to
+	outputFile = cli.StringFlag{
+		Name:  "output",
+		Usage: "The file the outport items are appended to, one JSON encoded item per line",
+		Value: "outport.jsonl",
+	}
+)
+
+// fileDriver appends the outport items delivered by the node to a file, one JSON encoded item per line. It is the
+// reference implementation of an outport plugin
+type fileDriver struct {
+	file    *os.File
+	encoder *json.Encoder
+}
+
+// Deliver appends the provided item to the file and syncs it on disk, as the node does not deliver an acknowledged
+// item again
+func (fd *fileDriver) Deliver(item *grpcStream.StreamItem) error {
+	err := fd.encoder.Encode(item)
+	if err != nil {
+		return err
+	}
+
+	return fd.file.Sync()
+}
+
+// Close closes the file
+func (fd *fileDriver) Close() error {
+	return fd.file.Close()
+}
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Outport file plugin"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary is an outport plugin started by the node, appending the outport items to a file"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		outputFile,
+	}
+
+	app.Action = func(ctx *cli.Context) error {
+		return serve(ctx.GlobalString(outputFile.Name))
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		// the standard output is read by the node, so the errors are written on the standard error
+		_, _ = fmt.Fprintln(os.Stderr, "outport file plugin error:", err.Error())
+
+		os.Exit(1)
+	}
+}
+
+func serve(outputPath string) error {
+	if os.Getenv(plugins.MagicCookieKey) != plugins.MagicCookieValue {
+		return plugins.ErrNotStartedByNode
+	}
+
+	file, err := os.OpenFile(outputPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	driver := &fileDriver{
+		file:    file,
+		encoder: json.NewEncoder(file),
+	}
+
+	return plugins.Serve(driver)
+}
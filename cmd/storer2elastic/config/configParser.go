@@ -4,6 +4,7 @@ package config
 type Config struct {
 	General       GeneralConfig       `toml:"general"`
 	ElasticSearch ElasticSearchConfig `toml:"elasticSearch"`
+	Outport       OutportConfig       `toml:"outport"`
 }
 
 // GeneralConfig holds basic configuration
@@ -15,9 +16,20 @@ type GeneralConfig struct {
 
 // ElasticSearchConfig holds the elastic search configuration
 type ElasticSearchConfig struct {
-	URL      string `toml:"url"`
-	Username string `toml:"username"`
-	Password string `toml:"password"`
+	Enabled       bool   `toml:"enabled"`
+	URL           string `toml:"url"`
+	Username      string `toml:"username"`
+	Password      string `toml:"password"`
+	TemplatesPath string `toml:"templatesPath"`
+}
+
+// OutportConfig holds the configuration of the outport drivers that receive the replayed blocks besides Elastic Search
+// and of the replay progress checkpoints
+type OutportConfig struct {
+	ExternalConfigPath         string `toml:"externalConfigPath"`
+	CheckpointFilePath         string `toml:"checkpointFilePath"`
+	CheckpointIntervalInRounds uint32 `toml:"checkpointIntervalInRounds"`
+	FlushTimeoutInSeconds      uint32 `toml:"flushTimeoutInSeconds"`
 }
 
 // DBConfig will map the db configuration
@@ -17,3 +17,10 @@ type HeaderData struct {
 	Body             *block.Body
 	BodyTransactions map[string]data.TransactionHandler
 }
+
+// Checkpoint holds the last meta block whose round was delivered to all the outport drivers
+type Checkpoint struct {
+	Epoch     uint32 `json:"epoch"`
+	MetaNonce uint64 `json:"metaNonce"`
+	MetaHash  string `json:"metaHash"`
+}
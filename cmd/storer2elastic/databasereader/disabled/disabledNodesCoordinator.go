@@ -96,6 +96,11 @@ func (d *disabledNodesCoordinator) GetConsensusWhitelistedNodes(_ uint32) (map[s
 	return nil, errNotImplemented
 }
 
+// GetEpochValidatorsAssignment will return an error that indicates that the function is not implemented
+func (d *disabledNodesCoordinator) GetEpochValidatorsAssignment(_ uint32) (*sharding.EpochValidatorsAssignment, error) {
+	return nil, errNotImplemented
+}
+
 // ConsensusGroupSize will return a zero value
 func (d *disabledNodesCoordinator) ConsensusGroupSize(uint32) int {
 	return 0
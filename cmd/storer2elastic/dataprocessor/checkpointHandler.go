@@ -0,0 +1,154 @@
+package dataprocessor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	storer2ElasticData "github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/data"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+// ArgsCheckpointHandler holds the arguments needed for creating a new checkpointHandler
+type ArgsCheckpointHandler struct {
+	FilePath         string
+	IntervalInRounds uint32
+	FlushTimeout     time.Duration
+	Outport          OutportFlusher
+}
+
+// checkpointHandler saves the replay progress in a file so an interrupted replay can be resumed. The progress is
+// saved only after the outport delivered all the queued data, so the rounds up to the checkpoint are never lost
+type checkpointHandler struct {
+	filePath         string
+	intervalInRounds uint32
+	flushTimeout     time.Duration
+	outport          OutportFlusher
+	mutProgress      sync.Mutex
+	lastCheckpoint   *storer2ElasticData.Checkpoint
+	progress         *storer2ElasticData.Checkpoint
+	numRounds        uint32
+}
+
+// NewCheckpointHandler returns a new instance of checkpointHandler, loading the checkpoint file if it exists
+func NewCheckpointHandler(args ArgsCheckpointHandler) (*checkpointHandler, error) {
+	if len(args.FilePath) == 0 {
+		return nil, ErrEmptyCheckpointFilePath
+	}
+	if args.IntervalInRounds == 0 {
+		return nil, ErrInvalidCheckpointInterval
+	}
+	if args.FlushTimeout <= 0 {
+		return nil, ErrInvalidFlushTimeout
+	}
+	if check.IfNil(args.Outport) {
+		return nil, ErrNilOutportFlusher
+	}
+
+	ch := &checkpointHandler{
+		filePath:         args.FilePath,
+		intervalInRounds: args.IntervalInRounds,
+		flushTimeout:     args.FlushTimeout,
+		outport:          args.Outport,
+	}
+
+	lastCheckpoint, err := loadCheckpoint(args.FilePath)
+	if err != nil {
+		return nil, err
+	}
+	ch.lastCheckpoint = lastCheckpoint
+
+	return ch, nil
+}
+
+func loadCheckpoint(filePath string) (*storer2ElasticData.Checkpoint, error) {
+	buff, err := ioutil.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint := &storer2ElasticData.Checkpoint{}
+	err = json.Unmarshal(buff, checkpoint)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the checkpoint file %s", err, filePath)
+	}
+
+	return checkpoint, nil
+}
+
+// LastCheckpoint returns the checkpoint loaded at start or nil if the replay starts from scratch
+func (ch *checkpointHandler) LastCheckpoint() *storer2ElasticData.Checkpoint {
+	return ch.lastCheckpoint
+}
+
+// SaveProgress records a replayed round and commits the progress once every configured number of rounds
+func (ch *checkpointHandler) SaveProgress(checkpoint storer2ElasticData.Checkpoint) error {
+	ch.mutProgress.Lock()
+	ch.progress = &checkpoint
+	ch.numRounds++
+	shouldCommit := ch.numRounds >= ch.intervalInRounds
+	ch.mutProgress.Unlock()
+
+	if !shouldCommit {
+		return nil
+	}
+
+	return ch.Commit()
+}
+
+// Commit waits for the outport to deliver the queued data and writes the last recorded round in the checkpoint file
+func (ch *checkpointHandler) Commit() error {
+	ch.mutProgress.Lock()
+	defer ch.mutProgress.Unlock()
+
+	if ch.progress == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ch.flushTimeout)
+	defer cancel()
+
+	err := ch.outport.Flush(ctx)
+	if err != nil {
+		return fmt.Errorf("%w while flushing the outport", err)
+	}
+
+	err = ch.writeCheckpoint(ch.progress)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("checkpoint saved", "epoch", ch.progress.Epoch, "meta nonce", ch.progress.MetaNonce)
+	ch.numRounds = 0
+	ch.progress = nil
+
+	return nil
+}
+
+// writeCheckpoint writes a temporary file and renames it so the checkpoint file is never left half written
+func (ch *checkpointHandler) writeCheckpoint(checkpoint *storer2ElasticData.Checkpoint) error {
+	buff, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	tempFilePath := ch.filePath + ".tmp"
+	err = ioutil.WriteFile(tempFilePath, buff, 0644)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFilePath, ch.filePath)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ch *checkpointHandler) IsInterfaceNil() bool {
+	return ch == nil
+}
@@ -0,0 +1,189 @@
+package dataprocessor_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	storer2ElasticData "github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/data"
+	"github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/dataprocessor"
+	"github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/mock"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/require"
+)
+
+func createTempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "checkpoint")
+	require.NoError(t, err)
+
+	return dir
+}
+
+func createCheckpointHandlerArgs(dir string) dataprocessor.ArgsCheckpointHandler {
+	return dataprocessor.ArgsCheckpointHandler{
+		FilePath:         filepath.Join(dir, "checkpoint.json"),
+		IntervalInRounds: 2,
+		FlushTimeout:     time.Second,
+		Outport:          &mock.OutportFlusherStub{},
+	}
+}
+
+func TestNewCheckpointHandler(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	tests := []struct {
+		name     string
+		argsFunc func() dataprocessor.ArgsCheckpointHandler
+		exError  error
+	}{
+		{
+			name: "EmptyFilePath",
+			argsFunc: func() dataprocessor.ArgsCheckpointHandler {
+				args := createCheckpointHandlerArgs(dir)
+				args.FilePath = ""
+				return args
+			},
+			exError: dataprocessor.ErrEmptyCheckpointFilePath,
+		},
+		{
+			name: "InvalidInterval",
+			argsFunc: func() dataprocessor.ArgsCheckpointHandler {
+				args := createCheckpointHandlerArgs(dir)
+				args.IntervalInRounds = 0
+				return args
+			},
+			exError: dataprocessor.ErrInvalidCheckpointInterval,
+		},
+		{
+			name: "InvalidFlushTimeout",
+			argsFunc: func() dataprocessor.ArgsCheckpointHandler {
+				args := createCheckpointHandlerArgs(dir)
+				args.FlushTimeout = 0
+				return args
+			},
+			exError: dataprocessor.ErrInvalidFlushTimeout,
+		},
+		{
+			name: "NilOutport",
+			argsFunc: func() dataprocessor.ArgsCheckpointHandler {
+				args := createCheckpointHandlerArgs(dir)
+				args.Outport = nil
+				return args
+			},
+			exError: dataprocessor.ErrNilOutportFlusher,
+		},
+		{
+			name: "All arguments ok",
+			argsFunc: func() dataprocessor.ArgsCheckpointHandler {
+				return createCheckpointHandlerArgs(dir)
+			},
+			exError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ch, err := dataprocessor.NewCheckpointHandler(tt.argsFunc())
+			require.Equal(t, tt.exError, err)
+			require.Equal(t, tt.exError != nil, check.IfNil(ch))
+		})
+	}
+}
+
+func TestCheckpointHandler_SaveProgressShouldCommitAfterTheInterval(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	numFlushes := 0
+	args := createCheckpointHandlerArgs(dir)
+	args.Outport = &mock.OutportFlusherStub{
+		FlushCalled: func(_ context.Context) error {
+			numFlushes++
+			return nil
+		},
+	}
+	ch, _ := dataprocessor.NewCheckpointHandler(args)
+	require.Nil(t, ch.LastCheckpoint())
+
+	err := ch.SaveProgress(storer2ElasticData.Checkpoint{Epoch: 1, MetaNonce: 10, MetaHash: "aa"})
+	require.NoError(t, err)
+	require.Equal(t, 0, numFlushes)
+
+	err = ch.SaveProgress(storer2ElasticData.Checkpoint{Epoch: 1, MetaNonce: 11, MetaHash: "bb"})
+	require.NoError(t, err)
+	require.Equal(t, 1, numFlushes)
+
+	err = ch.SaveProgress(storer2ElasticData.Checkpoint{Epoch: 2, MetaNonce: 12, MetaHash: "cc"})
+	require.NoError(t, err)
+	require.Equal(t, 1, numFlushes)
+
+	reloaded, _ := dataprocessor.NewCheckpointHandler(args)
+	require.Equal(t, &storer2ElasticData.Checkpoint{Epoch: 1, MetaNonce: 11, MetaHash: "bb"}, reloaded.LastCheckpoint())
+
+	err = ch.Commit()
+	require.NoError(t, err)
+	require.Equal(t, 2, numFlushes)
+
+	reloaded, _ = dataprocessor.NewCheckpointHandler(args)
+	require.Equal(t, &storer2ElasticData.Checkpoint{Epoch: 2, MetaNonce: 12, MetaHash: "cc"}, reloaded.LastCheckpoint())
+
+	err = ch.Commit()
+	require.NoError(t, err)
+	require.Equal(t, 2, numFlushes)
+}
+
+func TestCheckpointHandler_FailedFlushShouldNotWriteTheCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	expectedErr := errors.New("expected error")
+	args := createCheckpointHandlerArgs(dir)
+	args.Outport = &mock.OutportFlusherStub{
+		FlushCalled: func(_ context.Context) error {
+			return expectedErr
+		},
+	}
+	ch, _ := dataprocessor.NewCheckpointHandler(args)
+
+	err := ch.SaveProgress(storer2ElasticData.Checkpoint{Epoch: 1, MetaNonce: 10})
+	require.NoError(t, err)
+	err = ch.Commit()
+	require.True(t, errors.Is(err, expectedErr))
+
+	reloaded, _ := dataprocessor.NewCheckpointHandler(args)
+	require.Nil(t, reloaded.LastCheckpoint())
+}
+
+func TestNewCheckpointHandler_InvalidFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createCheckpointHandlerArgs(dir)
+	err := ioutil.WriteFile(args.FilePath, []byte("not a checkpoint"), 0644)
+	require.NoError(t, err)
+
+	ch, err := dataprocessor.NewCheckpointHandler(args)
+	require.Error(t, err)
+	require.True(t, check.IfNil(ch))
+}
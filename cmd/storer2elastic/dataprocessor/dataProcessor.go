@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"sort"
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -41,6 +42,7 @@ type ArgsDataProcessor struct {
 	TPSBenchmarkUpdater TPSBenchmarkUpdaterHandler
 	RatingsProcessor    RatingProcessorHandler
 	RatingConfig        config.RatingsConfig
+	CheckpointHandler   CheckpointHandler
 	StartingEpoch       uint32
 }
 
@@ -56,6 +58,7 @@ type dataProcessor struct {
 	nodesCoordinators   map[uint32]NodesCoordinator
 	tpsBenchmarkUpdater TPSBenchmarkUpdaterHandler
 	ratingsProcessor    RatingProcessorHandler
+	checkpointHandler   CheckpointHandler
 	startingEpoch       uint32
 }
 
@@ -85,6 +88,9 @@ func NewDataProcessor(args ArgsDataProcessor) (*dataProcessor, error) {
 	if check.IfNil(args.RatingsProcessor) {
 		return nil, ErrNilRatingProcessor
 	}
+	if check.IfNil(args.CheckpointHandler) {
+		return nil, ErrNilCheckpointHandler
+	}
 
 	dp := &dataProcessor{
 		elasticIndexer:      args.ElasticIndexer,
@@ -96,6 +102,7 @@ func NewDataProcessor(args ArgsDataProcessor) (*dataProcessor, error) {
 		ratingsProcessor:    args.RatingsProcessor,
 		tpsBenchmarkUpdater: args.TPSBenchmarkUpdater,
 		ratingConfig:        args.RatingConfig,
+		checkpointHandler:   args.CheckpointHandler,
 		startingEpoch:       args.StartingEpoch,
 		startTime:           time.Now(),
 	}
@@ -110,9 +117,17 @@ func NewDataProcessor(args ArgsDataProcessor) (*dataProcessor, error) {
 	return dp, nil
 }
 
-// Index will range over data from storage and will index it
+// Index will range over data from storage and will index it. The rounds up to the last checkpoint are skipped and
+// the progress is committed when the range ends, even if it was interrupted
 func (dp *dataProcessor) Index() error {
-	return dp.dataReplayer.Range(dp.processData)
+	errRange := dp.dataReplayer.Range(dp.processData)
+
+	err := dp.checkpointHandler.Commit()
+	if err != nil {
+		log.Error("cannot save the checkpoint", "error", err)
+	}
+
+	return errRange
 }
 
 func (dp *dataProcessor) processData(persistedData storer2ElasticData.RoundPersistedData) bool {
@@ -127,7 +142,22 @@ func (dp *dataProcessor) processData(persistedData storer2ElasticData.RoundPersi
 		}
 	}
 
-	err := dp.indexData(metaPersistedData)
+	metaHash, err := core.CalculateHash(dp.marshalizer, dp.hasher, metaPersistedData.Header)
+	if err != nil {
+		log.Warn("error while calculating the hash of a meta header", "error", err)
+		return false
+	}
+
+	isAlreadyIndexed, err := dp.isAlreadyIndexed(metaPersistedData.Header, metaHash)
+	if err != nil {
+		log.Error("cannot resume from the checkpoint", "error", err)
+		return false
+	}
+	if isAlreadyIndexed {
+		return true
+	}
+
+	err = dp.indexData(metaPersistedData)
 	if err != nil {
 		log.Warn("error indexing header", "error", err)
 		return false
@@ -135,8 +165,17 @@ func (dp *dataProcessor) processData(persistedData storer2ElasticData.RoundPersi
 	metaBlock, _ := metaPersistedData.Header.(*block.MetaBlock)
 	dp.tpsBenchmarkUpdater.IndexTPSForMetaBlock(metaBlock)
 
-	for _, shardDataForShard := range persistedData.ShardHeaders {
-		for _, shardData := range shardDataForShard {
+	// the shards are indexed in ascending order so every replay delivers the round data in the same order
+	shardIDs := make([]uint32, 0, len(persistedData.ShardHeaders))
+	for shardID := range persistedData.ShardHeaders {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	for _, shardID := range shardIDs {
+		for _, shardData := range persistedData.ShardHeaders[shardID] {
 			err = dp.indexData(shardData)
 			if err != nil {
 				log.Warn("error indexing shard header",
@@ -148,9 +187,35 @@ func (dp *dataProcessor) processData(persistedData storer2ElasticData.RoundPersi
 		}
 	}
 
+	err = dp.checkpointHandler.SaveProgress(storer2ElasticData.Checkpoint{
+		Epoch:     metaPersistedData.Header.GetEpoch(),
+		MetaNonce: metaPersistedData.Header.GetNonce(),
+		MetaHash:  hex.EncodeToString(metaHash),
+	})
+	if err != nil {
+		log.Error("cannot save the checkpoint", "error", err)
+		return false
+	}
+
 	return true
 }
 
+// isAlreadyIndexed returns true if the round of the provided meta header was delivered before the last checkpoint
+func (dp *dataProcessor) isAlreadyIndexed(metaHeader data.HeaderHandler, metaHash []byte) (bool, error) {
+	lastCheckpoint := dp.checkpointHandler.LastCheckpoint()
+	if lastCheckpoint == nil || metaHeader.GetNonce() > lastCheckpoint.MetaNonce {
+		return false, nil
+	}
+
+	isCheckpointMetaBlock := metaHeader.GetNonce() == lastCheckpoint.MetaNonce
+	if isCheckpointMetaBlock && hex.EncodeToString(metaHash) != lastCheckpoint.MetaHash {
+		return false, fmt.Errorf("%w: nonce %d, stored hash %s, checkpoint hash %s",
+			ErrCheckpointMismatch, metaHeader.GetNonce(), hex.EncodeToString(metaHash), lastCheckpoint.MetaHash)
+	}
+
+	return true, nil
+}
+
 func (dp *dataProcessor) indexData(data *storer2ElasticData.HeaderData) error {
 	signersIndexes, err := dp.computeSignersIndexes(data.Header)
 	if err != nil {
@@ -1,6 +1,8 @@
 package dataprocessor_test
 
 import (
+	"encoding/hex"
+	"fmt"
 	"testing"
 
 	storer2ElasticData "github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/data"
@@ -103,6 +105,15 @@ func TestNewDataProcessor(t *testing.T) {
 			},
 			exError: dataprocessor.ErrNilRatingProcessor,
 		},
+		{
+			name: "NilCheckpointHandler",
+			argsFunc: func() dataprocessor.ArgsDataProcessor {
+				args := getDataProcessorArgs()
+				args.CheckpointHandler = nil
+				return args
+			},
+			exError: dataprocessor.ErrNilCheckpointHandler,
+		},
 		{
 			name: "All arguments ok",
 			argsFunc: func() dataprocessor.ArgsDataProcessor {
@@ -148,6 +159,156 @@ func TestDataProcessor_Index(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func createGenesisNodesSetupWithShards(numShards uint32) *mock.GenesisNodesSetupHandlerStub {
+	return &mock.GenesisNodesSetupHandlerStub{
+		InitialNodesInfoCalled: func() (map[uint32][]sharding.GenesisNodeInfoHandler, map[uint32][]sharding.GenesisNodeInfoHandler) {
+			eligible := make(map[uint32][]sharding.GenesisNodeInfoHandler)
+			shardIDs := []uint32{core.MetachainShardId}
+			for shardID := uint32(0); shardID < numShards; shardID++ {
+				shardIDs = append(shardIDs, shardID)
+			}
+			for _, shardID := range shardIDs {
+				pubKey := []byte(fmt.Sprintf("pubKey%d", shardID))
+				eligible[shardID] = []sharding.GenesisNodeInfoHandler{mock.NewNodeInfo([]byte("addr"), pubKey, shardID, 10)}
+			}
+			return eligible, nil
+		},
+		NumberOfShardsCalled: func() uint32 {
+			return numShards
+		},
+		GetShardConsensusGroupSizeCalled: func() uint32 {
+			return 1
+		},
+		GetMetaConsensusGroupSizeCalled: func() uint32 {
+			return 1
+		},
+	}
+}
+
+func createRoundPersistedData(nonce uint64) storer2ElasticData.RoundPersistedData {
+	return storer2ElasticData.RoundPersistedData{
+		MetaBlockData: &storer2ElasticData.HeaderData{
+			Header:           &block.MetaBlock{Nonce: nonce, PrevRandSeed: []byte("seed")},
+			Body:             &block.Body{},
+			BodyTransactions: map[string]data.TransactionHandler{},
+		},
+		ShardHeaders: map[uint32][]*storer2ElasticData.HeaderData{
+			2: {{Header: &block.Header{ShardID: 2, Nonce: nonce, PrevRandSeed: []byte("seed")}, Body: &block.Body{}}},
+			0: {{Header: &block.Header{ShardID: 0, Nonce: nonce, PrevRandSeed: []byte("seed")}, Body: &block.Body{}}},
+			1: {{Header: &block.Header{ShardID: 1, Nonce: nonce, PrevRandSeed: []byte("seed")}, Body: &block.Body{}}},
+		},
+	}
+}
+
+func TestDataProcessor_IndexShouldIndexTheShardsInOrderAndSaveTheProgress(t *testing.T) {
+	t.Parallel()
+
+	indexedShards := make([]uint32, 0)
+	savedProgress := make([]storer2ElasticData.Checkpoint, 0)
+	commitCalled := false
+	args := getDataProcessorArgs()
+	args.ShardCoordinator = &mock.ShardCoordinatorMock{NumOfShards: 3}
+	args.GenesisNodesSetup = createGenesisNodesSetupWithShards(3)
+	args.ElasticIndexer = &mock.ElasticIndexerStub{
+		SaveBlockCalled: func(_ data.BodyHandler, header data.HeaderHandler, _ map[string]data.TransactionHandler, _ []uint64, _ []string, _ []byte) {
+			indexedShards = append(indexedShards, header.GetShardID())
+		},
+	}
+	args.CheckpointHandler = &mock.CheckpointHandlerStub{
+		SaveProgressCalled: func(checkpoint storer2ElasticData.Checkpoint) error {
+			savedProgress = append(savedProgress, checkpoint)
+			return nil
+		},
+		CommitCalled: func() error {
+			commitCalled = true
+			return nil
+		},
+	}
+	args.DataReplayer = &mock.DataReplayerStub{
+		RangeCalled: func(handler func(persistedData storer2ElasticData.RoundPersistedData) bool) error {
+			require.True(t, handler(createRoundPersistedData(5)))
+			return nil
+		},
+	}
+	dp, err := dataprocessor.NewDataProcessor(args)
+	require.NoError(t, err)
+
+	err = dp.Index()
+	require.NoError(t, err)
+	require.Equal(t, []uint32{core.MetachainShardId, 0, 1, 2}, indexedShards)
+	require.Equal(t, 1, len(savedProgress))
+	require.Equal(t, uint32(0), savedProgress[0].Epoch)
+	require.Equal(t, uint64(5), savedProgress[0].MetaNonce)
+	require.True(t, commitCalled)
+}
+
+func TestDataProcessor_IndexShouldSkipTheRoundsBeforeTheCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	hasher := &mock.HasherMock{}
+	checkpointMetaHash, _ := core.CalculateHash(marshalizer, hasher, createRoundPersistedData(5).MetaBlockData.Header)
+
+	indexedNonces := make([]uint64, 0)
+	args := getDataProcessorArgs()
+	args.ShardCoordinator = &mock.ShardCoordinatorMock{NumOfShards: 3}
+	args.GenesisNodesSetup = createGenesisNodesSetupWithShards(3)
+	args.ElasticIndexer = &mock.ElasticIndexerStub{
+		SaveBlockCalled: func(_ data.BodyHandler, header data.HeaderHandler, _ map[string]data.TransactionHandler, _ []uint64, _ []string, _ []byte) {
+			if header.GetShardID() == core.MetachainShardId {
+				indexedNonces = append(indexedNonces, header.GetNonce())
+			}
+		},
+	}
+	args.CheckpointHandler = &mock.CheckpointHandlerStub{
+		LastCheckpointCalled: func() *storer2ElasticData.Checkpoint {
+			return &storer2ElasticData.Checkpoint{MetaNonce: 5, MetaHash: hex.EncodeToString(checkpointMetaHash)}
+		},
+	}
+	args.DataReplayer = &mock.DataReplayerStub{
+		RangeCalled: func(handler func(persistedData storer2ElasticData.RoundPersistedData) bool) error {
+			for nonce := uint64(4); nonce <= 6; nonce++ {
+				require.True(t, handler(createRoundPersistedData(nonce)))
+			}
+			return nil
+		},
+	}
+	dp, _ := dataprocessor.NewDataProcessor(args)
+	require.NotNil(t, dp)
+
+	err := dp.Index()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{6}, indexedNonces)
+}
+
+func TestDataProcessor_IndexCheckpointMismatchShouldStop(t *testing.T) {
+	t.Parallel()
+
+	numIndexed := 0
+	args := getDataProcessorArgs()
+	args.ElasticIndexer = &mock.ElasticIndexerStub{
+		SaveBlockCalled: func(_ data.BodyHandler, _ data.HeaderHandler, _ map[string]data.TransactionHandler, _ []uint64, _ []string, _ []byte) {
+			numIndexed++
+		},
+	}
+	args.CheckpointHandler = &mock.CheckpointHandlerStub{
+		LastCheckpointCalled: func() *storer2ElasticData.Checkpoint {
+			return &storer2ElasticData.Checkpoint{Epoch: 1, MetaNonce: 5, MetaHash: "another hash"}
+		},
+	}
+	args.DataReplayer = &mock.DataReplayerStub{
+		RangeCalled: func(handler func(persistedData storer2ElasticData.RoundPersistedData) bool) error {
+			require.False(t, handler(createRoundPersistedData(5)))
+			return nil
+		},
+	}
+	dp, _ := dataprocessor.NewDataProcessor(args)
+	require.NotNil(t, dp)
+
+	_ = dp.Index()
+	require.Equal(t, 0, numIndexed)
+}
+
 func getDataProcessorArgs() dataprocessor.ArgsDataProcessor {
 	return dataprocessor.ArgsDataProcessor{
 		ElasticIndexer: &mock.ElasticIndexerStub{},
@@ -165,25 +326,33 @@ func getDataProcessorArgs() dataprocessor.ArgsDataProcessor {
 		Hasher:              &mock.HasherMock{},
 		TPSBenchmarkUpdater: &mock.TPSBenchmarkUpdaterStub{},
 		RatingsProcessor:    &mock.RatingsProcessorStub{},
+		CheckpointHandler:   &mock.CheckpointHandlerStub{},
 		RatingConfig: config.RatingsConfig{
 			ShardChain: config.ShardChain{
 				RatingSteps: config.RatingSteps{
-					HoursToMaxRatingFromStartRating: 50,
-					ProposerValidatorImportance:     1,
-					ProposerDecreaseFactor:          -4,
-					ValidatorDecreaseFactor:         -4,
-					ConsecutiveMissedBlocksPenalty:  1.1,
+					HoursToMaxRatingFromStartRating:    50,
+					ProposerValidatorImportance:        1,
+					ProposerDecreaseFactor:             -4,
+					ValidatorDecreaseFactor:            -4,
+					ConsecutiveMissedBlocksPenalty:     1.1,
+					ConsecutiveMissedSignaturesPenalty: 1.1,
 				},
 			},
 			MetaChain: config.MetaChain{
 				RatingSteps: config.RatingSteps{
-					HoursToMaxRatingFromStartRating: 50,
-					ProposerValidatorImportance:     1,
-					ProposerDecreaseFactor:          -4,
-					ValidatorDecreaseFactor:         -4,
-					ConsecutiveMissedBlocksPenalty:  1.1,
+					HoursToMaxRatingFromStartRating:    50,
+					ProposerValidatorImportance:        1,
+					ProposerDecreaseFactor:             -4,
+					ValidatorDecreaseFactor:            -4,
+					ConsecutiveMissedBlocksPenalty:     1.1,
+					ConsecutiveMissedSignaturesPenalty: 1.1,
 				},
 			},
+			Probation: config.ProbationConfig{
+				ConsecutiveMissesThreshold: 10,
+				DurationInEpochs:           2,
+				PenaltyMultiplier:          2,
+			},
 			General: config.General{
 				StartRating:           500000,
 				MaxRating:             1000000,
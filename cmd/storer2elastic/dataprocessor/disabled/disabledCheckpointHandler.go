@@ -0,0 +1,33 @@
+package disabled
+
+import (
+	storer2ElasticData "github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/data"
+)
+
+type disabledCheckpointHandler struct {
+}
+
+// NewCheckpointHandler will return a checkpoint handler that does not save the progress
+func NewCheckpointHandler() *disabledCheckpointHandler {
+	return &disabledCheckpointHandler{}
+}
+
+// LastCheckpoint returns nil as no progress is saved
+func (d *disabledCheckpointHandler) LastCheckpoint() *storer2ElasticData.Checkpoint {
+	return nil
+}
+
+// SaveProgress won't do anything
+func (d *disabledCheckpointHandler) SaveProgress(_ storer2ElasticData.Checkpoint) error {
+	return nil
+}
+
+// Commit won't do anything
+func (d *disabledCheckpointHandler) Commit() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledCheckpointHandler) IsInterfaceNil() bool {
+	return d == nil
+}
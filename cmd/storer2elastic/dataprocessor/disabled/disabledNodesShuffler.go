@@ -24,6 +24,11 @@ func (d *disabledNodesShuffler) UpdateNodeLists(args sharding.ArgsUpdateNodes) (
 	}, nil
 }
 
+// NodesToShuffle returns 0 validators to be shuffled out of each shard and of the metachain
+func (d *disabledNodesShuffler) NodesToShuffle(_ uint32) (uint32, uint32) {
+	return 0, 0
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (d *disabledNodesShuffler) IsInterfaceNil() bool {
 	return d == nil
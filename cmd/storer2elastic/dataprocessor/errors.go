@@ -52,3 +52,21 @@ var ErrOsSignalIntercepted = errors.New("os signal intercepted")
 
 // ErrNilHandlerFunc signals that a nil handler function for raning has been provided
 var ErrNilHandlerFunc = errors.New("nil handler function for ranging")
+
+// ErrNilCheckpointHandler signals that a nil checkpoint handler has been provided
+var ErrNilCheckpointHandler = errors.New("nil checkpoint handler")
+
+// ErrNilOutportFlusher signals that a nil outport flusher has been provided
+var ErrNilOutportFlusher = errors.New("nil outport flusher")
+
+// ErrEmptyCheckpointFilePath signals that an empty checkpoint file path has been provided
+var ErrEmptyCheckpointFilePath = errors.New("empty checkpoint file path")
+
+// ErrInvalidCheckpointInterval signals that an invalid checkpoint interval has been provided
+var ErrInvalidCheckpointInterval = errors.New("invalid checkpoint interval")
+
+// ErrInvalidFlushTimeout signals that an invalid flush timeout has been provided
+var ErrInvalidFlushTimeout = errors.New("invalid flush timeout")
+
+// ErrCheckpointMismatch signals that the stored meta block does not match the one saved in the checkpoint
+var ErrCheckpointMismatch = errors.New("the stored meta block does not match the checkpoint")
@@ -1,6 +1,8 @@
 package dataprocessor
 
 import (
+	"context"
+
 	storer2ElasticData "github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/data"
 	"github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/databasereader"
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -49,3 +51,17 @@ type RatingProcessorHandler interface {
 	IndexRatingsForEpochStartMetaBlock(metaBlock *block.MetaBlock) error
 	IsInterfaceNil() bool
 }
+
+// CheckpointHandler defines the actions that a replay progress checkpoint handler has to do
+type CheckpointHandler interface {
+	LastCheckpoint() *storer2ElasticData.Checkpoint
+	SaveProgress(checkpoint storer2ElasticData.Checkpoint) error
+	Commit() error
+	IsInterfaceNil() bool
+}
+
+// OutportFlusher defines the outport that can wait until all the queued data is delivered
+type OutportFlusher interface {
+	Flush(ctx context.Context) error
+	IsInterfaceNil() bool
+}
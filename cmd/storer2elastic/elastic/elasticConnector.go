@@ -58,10 +58,12 @@ func NewConnectorFactory(args ConnectorFactoryArgs) (*elasticSearchConnectorFact
 // Create will create and return a new indexer database handler
 func (escf *elasticSearchConnectorFactory) Create() (indexer.Indexer, error) {
 	indexerFactoryArgs := &factory.ArgsIndexerFactory{
+		Enabled:                  escf.elasticConfig.Enabled,
 		Url:                      escf.elasticConfig.URL,
 		IndexerCacheSize:         100,
 		UserName:                 escf.elasticConfig.Username,
 		Password:                 escf.elasticConfig.Password,
+		TemplatesPath:            escf.elasticConfig.TemplatesPath,
 		Marshalizer:              escf.marshalizer,
 		Hasher:                   escf.hasher,
 		AddressPubkeyConverter:   escf.addressPubKeyConverter,
@@ -5,14 +5,19 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/config"
 	"github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/databasereader"
 	"github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/dataprocessor"
+	dataProcessorDisabled "github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/dataprocessor/disabled"
 	"github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/elastic"
 	nodeConfigPackage "github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	indexerDisabled "github.com/ElrondNetwork/elrond-go/core/indexer/disabled"
+	indexerFactory "github.com/ElrondNetwork/elrond-go/core/indexer/factory"
 	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
@@ -26,6 +31,11 @@ import (
 	"github.com/urfave/cli"
 )
 
+type outportHandler interface {
+	indexer.Indexer
+	indexer.Flusher
+}
+
 type flags struct {
 	dbPath               string
 	configFilePath       string
@@ -234,6 +244,29 @@ func startStorer2Elastic(ctx *cli.Context) error {
 		return fmt.Errorf("error connecting to elastic: %w", err)
 	}
 
+	outport, err := createOutport(configuration.Outport, elasticIndexer)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(outport.Close())
+	}()
+
+	checkpointHandler, err := createCheckpointHandler(configuration.Outport, outport)
+	if err != nil {
+		return err
+	}
+
+	startingEpoch := uint32(flagsValues.startingEpoch)
+	lastCheckpoint := checkpointHandler.LastCheckpoint()
+	if lastCheckpoint != nil && lastCheckpoint.Epoch > startingEpoch {
+		startingEpoch = lastCheckpoint.Epoch
+	}
+	if lastCheckpoint != nil {
+		log.Info("resuming the replay from the checkpoint",
+			"epoch", lastCheckpoint.Epoch, "meta nonce", lastCheckpoint.MetaNonce, "starting epoch", startingEpoch)
+	}
+
 	// TODO: maybe use custom configs from node config instead of a general configuration
 	generalDBConfig := config.DBConfig{
 		Type:              string(storageUnit.LvlDBSerial),
@@ -263,7 +296,7 @@ func startStorer2Elastic(ctx *cli.Context) error {
 			GeneralConfig:            nodeConfig,
 			Marshalizer:              marshalizer,
 			Hasher:                   hasher,
-			ElasticIndexer:           elasticIndexer,
+			ElasticIndexer:           outport,
 			GenesisNodesConfig:       genesisNodesConfig,
 			RatingsConfig:            ratingsConfig,
 		},
@@ -285,7 +318,7 @@ func startStorer2Elastic(ctx *cli.Context) error {
 		Hasher:                   hasher,
 		Uint64ByteSliceConverter: uint64ByteSliceConverter,
 		HeaderMarshalizer:        headerMarshalizer,
-		StartingEpoch:            uint32(flagsValues.startingEpoch),
+		StartingEpoch:            startingEpoch,
 	}
 
 	dataReplayer, err := dataprocessor.NewDataReplayer(dataReplayerArgs)
@@ -293,14 +326,14 @@ func startStorer2Elastic(ctx *cli.Context) error {
 		return err
 	}
 
-	tpsBenchmarkUpdater, err := dataprocessor.NewTPSBenchmarkUpdater(genesisNodesConfig, elasticIndexer)
+	tpsBenchmarkUpdater, err := dataprocessor.NewTPSBenchmarkUpdater(genesisNodesConfig, outport)
 	if err != nil {
 		return err
 	}
 
 	dataProcessor, err := dataprocessor.NewDataProcessor(
 		dataprocessor.ArgsDataProcessor{
-			ElasticIndexer:      elasticIndexer,
+			ElasticIndexer:      outport,
 			DataReplayer:        dataReplayer,
 			GenesisNodesSetup:   genesisNodesConfig,
 			Marshalizer:         marshalizer,
@@ -309,7 +342,8 @@ func startStorer2Elastic(ctx *cli.Context) error {
 			TPSBenchmarkUpdater: tpsBenchmarkUpdater,
 			RatingsProcessor:    ratingsProcessor,
 			RatingConfig:        ratingsConfig,
-			StartingEpoch:       uint32(flagsValues.startingEpoch),
+			CheckpointHandler:   checkpointHandler,
+			StartingEpoch:       startingEpoch,
 		})
 	if err != nil {
 		return err
@@ -323,3 +357,56 @@ func startStorer2Elastic(ctx *cli.Context) error {
 
 	return nil
 }
+
+// createOutport combines the elastic indexer with the outport drivers enabled in the node's external configuration
+func createOutport(outportConfig config.OutportConfig, elasticIndexer indexer.Indexer) (outportHandler, error) {
+	if len(outportConfig.ExternalConfigPath) == 0 {
+		return indexer.NewMultiIndexer(elasticIndexer)
+	}
+
+	externalConfig := nodeConfigPackage.ExternalConfig{}
+	err := core.LoadTomlFile(&externalConfig, outportConfig.ExternalConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// the replayed blocks do not have the cached transaction logs so none of the drivers cleans them
+	argsOutportDriverFactory := &indexerFactory.ArgsOutportDriverFactory{
+		Marshalizer:            marshalizer,
+		Hasher:                 hasher,
+		AddressPubkeyConverter: addressPubKeyConverter,
+		ShardCoordinator:       shardCoordinator,
+	}
+
+	kafkaDriver, err := indexerFactory.NewKafkaDriver(externalConfig.KafkaConnector, argsOutportDriverFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	// the historical accounts state is not available so the balances are not tracked
+	postgresDriver, err := indexerFactory.NewPostgresDriver(
+		externalConfig.PostgreSQLConnector,
+		indexerDisabled.NewAccountsLoader(),
+		argsOutportDriverFactory,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return indexer.NewMultiIndexer(kafkaDriver, postgresDriver, elasticIndexer)
+}
+
+func createCheckpointHandler(outportConfig config.OutportConfig, outport outportHandler) (dataprocessor.CheckpointHandler, error) {
+	if len(outportConfig.CheckpointFilePath) == 0 {
+		return dataProcessorDisabled.NewCheckpointHandler(), nil
+	}
+
+	argsCheckpointHandler := dataprocessor.ArgsCheckpointHandler{
+		FilePath:         outportConfig.CheckpointFilePath,
+		IntervalInRounds: outportConfig.CheckpointIntervalInRounds,
+		FlushTimeout:     time.Second * time.Duration(outportConfig.FlushTimeoutInSeconds),
+		Outport:          outport,
+	}
+
+	return dataprocessor.NewCheckpointHandler(argsCheckpointHandler)
+}
@@ -27,6 +27,7 @@ type AccountsStub struct {
 	IsPruningEnabledCalled   func() bool
 	GetAllLeavesCalled       func(rootHash []byte) (chan core.KeyValueHolder, error)
 	RecreateAllTriesCalled   func(rootHash []byte) (map[string]data.Trie, error)
+	GetTrieCalled            func(rootHash []byte) (data.Trie, error)
 	GetNumCheckpointsCalled  func() uint32
 	GetCodeCalled            func([]byte) []byte
 }
@@ -47,6 +48,14 @@ func (as *AccountsStub) RecreateAllTries(rootHash []byte, _ context.Context) (ma
 	return nil, nil
 }
 
+// GetTrie -
+func (as *AccountsStub) GetTrie(rootHash []byte) (data.Trie, error) {
+	if as.GetTrieCalled != nil {
+		return as.GetTrieCalled(rootHash)
+	}
+	return nil, nil
+}
+
 // LoadAccount -
 func (as *AccountsStub) LoadAccount(address []byte) (state.AccountHandler, error) {
 	if as.LoadAccountCalled != nil {
@@ -180,6 +189,11 @@ func (as *AccountsStub) GetNumCheckpoints() uint32 {
 	return 0
 }
 
+// IsSnapshotInProgress -
+func (as *AccountsStub) IsSnapshotInProgress() bool {
+	return false
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (as *AccountsStub) IsInterfaceNil() bool {
 	return as == nil
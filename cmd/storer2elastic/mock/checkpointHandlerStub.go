@@ -0,0 +1,44 @@
+package mock
+
+import (
+	storer2ElasticData "github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/data"
+)
+
+// CheckpointHandlerStub -
+type CheckpointHandlerStub struct {
+	LastCheckpointCalled func() *storer2ElasticData.Checkpoint
+	SaveProgressCalled   func(checkpoint storer2ElasticData.Checkpoint) error
+	CommitCalled         func() error
+}
+
+// LastCheckpoint -
+func (c *CheckpointHandlerStub) LastCheckpoint() *storer2ElasticData.Checkpoint {
+	if c.LastCheckpointCalled != nil {
+		return c.LastCheckpointCalled()
+	}
+
+	return nil
+}
+
+// SaveProgress -
+func (c *CheckpointHandlerStub) SaveProgress(checkpoint storer2ElasticData.Checkpoint) error {
+	if c.SaveProgressCalled != nil {
+		return c.SaveProgressCalled(checkpoint)
+	}
+
+	return nil
+}
+
+// Commit -
+func (c *CheckpointHandlerStub) Commit() error {
+	if c.CommitCalled != nil {
+		return c.CommitCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (c *CheckpointHandlerStub) IsInterfaceNil() bool {
+	return c == nil
+}
@@ -0,0 +1,22 @@
+package mock
+
+import "context"
+
+// OutportFlusherStub -
+type OutportFlusherStub struct {
+	FlushCalled func(ctx context.Context) error
+}
+
+// Flush -
+func (o *OutportFlusherStub) Flush(ctx context.Context) error {
+	if o.FlushCalled != nil {
+		return o.FlushCalled(ctx)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (o *OutportFlusherStub) IsInterfaceNil() bool {
+	return o == nil
+}
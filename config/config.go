@@ -81,6 +81,7 @@ type EpochStartConfig struct {
 	MaxShuffledOutRestartThreshold    float64
 	MinNumConnectedPeersToStart       int
 	MinNumOfPeersToConsiderBlockValid int
+	ObserverProcessingLagInRounds     uint64
 }
 
 // BlockSizeThrottleConfig will hold the configuration for adaptive block size throttle
@@ -145,6 +146,18 @@ type Config struct {
 	PeerIdShardId         CacheConfig
 	PublicKeyPIDSignature CacheConfig
 	PeerHonesty           CacheConfig
+	ConsensusGroupCache   CacheConfig
+
+	BatchSignatureVerifier  BatchSignatureVerifierConfig
+	VerifiedSignaturesCache VerifiedSignaturesCacheConfig
+	RemoteSigner            RemoteSignerConfig
+	ThresholdSigner         ThresholdSignerConfig
+	PartitionDetector       PartitionDetectorConfig
+	EquivocationDetector    EquivocationDetectorConfig
+	ConsensusTiming         ConsensusTimingConfig
+	PipelinedProposal       PipelinedProposalConfig
+	FallbackLeader          FallbackLeaderConfig
+	Redundancy              RedundancyConfig
 
 	Antiflood           AntifloodConfig
 	ResourceStats       ResourceStatsConfig
@@ -168,12 +181,57 @@ type Config struct {
 	DbLookupExtensions    DbLookupExtensionsConfig
 	Versions              VersionsConfig
 	GasSchedule           GasScheduleConfig
+	GasEstimation         GasEstimationConfig
 	Logs                  LogsConfig
+	WebServer             WebServerConfig
+}
+
+// WebServerConfig holds the transport settings of the REST API web server
+type WebServerConfig struct {
+	TLS  WebServerTLSConfig
+	CORS CORSConfig
+}
+
+// WebServerTLSConfig holds the settings for serving the REST API over HTTPS, using either the provided certificate
+// and key files or certificates automatically obtained and renewed through the ACME protocol
+type WebServerTLSConfig struct {
+	Enabled         bool
+	CertificateFile string
+	KeyFile         string
+	ACME            ACMEConfig
+}
+
+// ACMEConfig holds the settings for automatically obtaining and renewing the web server certificates from an ACME
+// certificate authority, such as Let's Encrypt
+type ACMEConfig struct {
+	Enabled                bool
+	Domains                []string
+	Email                  string
+	CacheDir               string
+	DirectoryURL           string
+	HTTPChallengeInterface string
+}
+
+// CORSConfig holds the cross-origin resource sharing policy of the web server
+type CORSConfig struct {
+	AllowedOrigins   []string
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	ExposedHeaders   []string
+	AllowCredentials bool
+	MaxAgeInSec      uint32
+}
+
+// GasEstimationConfig will hold the settings used when estimating the gas needed by a transaction
+type GasEstimationConfig struct {
+	SafetyMarginPercent    uint32
+	CrossShardCallGasLimit uint64
 }
 
 // LogsConfig will hold settings related to the logging sub-system
 type LogsConfig struct {
 	LogFileLifeSpanInSec int
+	LogLevel             string
 }
 
 // StoragePruningConfig will hold settings related to storage pruning
@@ -192,12 +250,81 @@ type ResourceStatsConfig struct {
 
 // HeartbeatConfig will hold all heartbeat settings
 type HeartbeatConfig struct {
-	MinTimeToWaitBetweenBroadcastsInSec int
-	MaxTimeToWaitBetweenBroadcastsInSec int
-	DurationToConsiderUnresponsiveInSec int
-	HeartbeatRefreshIntervalInSec       uint32
-	HideInactiveValidatorIntervalInSec  uint32
-	HeartbeatStorage                    StorageConfig
+	MinTimeToWaitBetweenBroadcastsInSec              int
+	MaxTimeToWaitBetweenBroadcastsInSec              int
+	DurationToConsiderUnresponsiveInSec              int
+	HideInactiveValidatorIntervalInSec               uint32
+	PeerAuthenticationTimeBetweenSendsInSec          int
+	PeerAuthenticationTimeThresholdBetweenSendsInSec int
+	MaxTimeDifferenceInSec                           int
+	PeerAuthenticationCache                          CacheConfig
+	VerifiedPayloadsCache                            CacheConfig
+	HeartbeatCache                                   CacheConfig
+	Alerts                                           HeartbeatAlertsConfig
+}
+
+// HeartbeatAlertsConfig will hold the settings of the alerts fired when the tracked public keys go offline or miss
+// consecutive heartbeats
+type HeartbeatAlertsConfig struct {
+	Enabled                 bool
+	TrackedPublicKeys       []string
+	TrackedIdentities       []string
+	NumMissedHeartbeats     uint32
+	CheckIntervalInSec      int
+	WebhookURLs             []string
+	WebhookTimeoutInSec     int
+	WebhookBufferSize       uint32
+	PushNotificationEnabled bool
+}
+
+// BatchSignatureVerifierConfig will hold the settings used when verifying the intercepted signatures in batches
+type BatchSignatureVerifierConfig struct {
+	Enabled                 bool
+	NumWorkers              int
+	MaxBatchSize            int
+	FlushIntervalInMillisec int
+}
+
+// VerifiedSignaturesCacheConfig will hold the settings of the cache holding the already verified BLS signatures
+type VerifiedSignaturesCacheConfig struct {
+	Enabled bool
+	Cache   CacheConfig
+}
+
+// RemoteSignerConfig will hold the settings of the remote signer holding the node's BLS secret key
+type RemoteSignerConfig struct {
+	Enabled                  bool
+	URL                      string
+	PublicKey                string
+	CertificateFile          string
+	KeyFile                  string
+	CAFile                   string
+	RequestTimeoutInMillisec int
+}
+
+// ThresholdSignerConfig will hold the settings of the managed validator keys split in shares held by separate remote
+// signers, all of them being reached with the same client certificate
+type ThresholdSignerConfig struct {
+	Enabled                  bool
+	CertificateFile          string
+	KeyFile                  string
+	CAFile                   string
+	RequestTimeoutInMillisec int
+	Keys                     []ThresholdKeyConfig
+}
+
+// ThresholdKeyConfig will hold the public key of a threshold key, the number of shares needed to sign and its shares
+type ThresholdKeyConfig struct {
+	PublicKey string
+	Threshold uint32
+	Shares    []KeyShareConfig
+}
+
+// KeyShareConfig will hold the ID and the public key of a share of a threshold key and the URL of the signer holding it
+type KeyShareConfig struct {
+	ID        uint32
+	PublicKey string
+	URL       string
 }
 
 // ValidatorStatisticsConfig will hold validator statistics specific settings
@@ -205,38 +332,60 @@ type ValidatorStatisticsConfig struct {
 	CacheRefreshIntervalInSec uint32
 }
 
-// MaxNodesChangeConfig defines a config change tuple, with a maximum number enabled in a certain epoch number
+// MaxNodesChangeConfig defines a config change tuple, with a maximum number enabled in a certain epoch number.
+// NodesToShuffleInMeta defaults to NodesToShufflePerShard when 0 and an empty ShuffledOutReEntry keeps the
+// node's default re-entry behavior for the shuffled out validators
 type MaxNodesChangeConfig struct {
 	EpochEnable            uint32
 	MaxNumNodes            uint32
 	NodesToShufflePerShard uint32
+	NodesToShuffleInMeta   uint32
+	ShuffledOutReEntry     string
+}
+
+// PinnedShardConfig defines a group of validators, identified by their BLS public keys, dedicated to a shard
+type PinnedShardConfig struct {
+	ShardID    uint32
+	PublicKeys []string
 }
 
 // GeneralSettingsConfig will hold the general settings for a node
 type GeneralSettingsConfig struct {
-	StatusPollingIntervalSec               int
-	MaxComputableRounds                    uint64
-	StartInEpochEnabled                    bool
-	SCDeployEnableEpoch                    uint32
-	BuiltInFunctionsEnableEpoch            uint32
-	RelayedTransactionsEnableEpoch         uint32
-	PenalizedTooMuchGasEnableEpoch         uint32
-	SwitchJailWaitingEnableEpoch           uint32
-	SwitchHysteresisForMinNodesEnableEpoch uint32
-	BelowSignedThresholdEnableEpoch        uint32
-	TransactionSignedWithTxHashEnableEpoch uint32
-	MetaProtectionEnableEpoch              uint32
-	AheadOfTimeGasUsageEnableEpoch         uint32
-	GasPriceModifierEnableEpoch            uint32
-	MaxNodesChangeEnableEpoch              []MaxNodesChangeConfig
-	GenesisString                          string
-	GenesisMaxNumberOfShards               uint32
+	StatusPollingIntervalSec                   int
+	MaxComputableRounds                        uint64
+	StartInEpochEnabled                        bool
+	SCDeployEnableEpoch                        uint32
+	BuiltInFunctionsEnableEpoch                uint32
+	RelayedTransactionsEnableEpoch             uint32
+	PenalizedTooMuchGasEnableEpoch             uint32
+	SwitchJailWaitingEnableEpoch               uint32
+	SwitchHysteresisForMinNodesEnableEpoch     uint32
+	BelowSignedThresholdEnableEpoch            uint32
+	RatingsV2EnableEpoch                       uint32
+	RewardsCheckpointEnableEpoch               uint32
+	TransactionSignedWithTxHashEnableEpoch     uint32
+	MetaProtectionEnableEpoch                  uint32
+	AheadOfTimeGasUsageEnableEpoch             uint32
+	GasPriceModifierEnableEpoch                uint32
+	ChargingEpochInMiniBlocksEnableEpoch       uint32
+	ProtocolSustainabilityAddressesEnableEpoch uint32
+	RoundDurationChangeEnableEpoch             uint32
+	FallbackLeaderEnableEpoch                  uint32
+	DeveloperRewardsOverrideEnableEpoch        uint32
+	NewRoundDurationInMilliseconds             uint64
+	RoundDurationChangeDelayInRounds           uint64
+	MaxNodesChangeEnableEpoch                  []MaxNodesChangeConfig
+	PinnedShards                               []PinnedShardConfig
+	GenesisString                              string
+	GenesisMaxNumberOfShards                   uint32
 }
 
 // FacadeConfig will hold different configuration option that will be passed to the main ElrondFacade
 type FacadeConfig struct {
 	RestApiInterface string
 	PprofEnabled     bool
+	AppVersion       string
+	WorkingDir       string
 }
 
 // StateTriesConfig will hold information about state tries
@@ -267,6 +416,59 @@ type WebServerAntifloodConfig struct {
 	SameSourceRequests           uint32
 	SameSourceResetIntervalInSec uint32
 	EndpointsThrottlers          []EndpointsThrottlersConfig
+	RateLimiter                  RateLimiterConfig
+	WorkQueue                    WorkQueueConfig
+}
+
+// RouteConcurrencyConfig holds the maximum number of requests of a route processed at once
+type RouteConcurrencyConfig struct {
+	Route                 string
+	MaxConcurrentRequests uint32
+}
+
+// WorkQueueConfig holds the limits of the requests processed at once by the web server. The requests exceeding the
+// limits wait in a bounded queue for at most MaxQueueWaitInMs milliseconds
+type WorkQueueConfig struct {
+	Enabled               bool
+	MaxConcurrentRequests uint32
+	MaxQueuedRequests     uint32
+	MaxQueueWaitInMs      uint32
+	Routes                []RouteConcurrencyConfig
+}
+
+// RateLimitConfig holds the token bucket parameters of a rate limit and the ban applied when it is exceeded
+type RateLimitConfig struct {
+	Burst                   uint32
+	SustainedRequestsPerSec uint32
+	BanDurationInSec        uint32
+}
+
+// RouteRateLimitConfig holds the rate limit applied to each consumer of a route
+type RouteRateLimitConfig struct {
+	Route                   string
+	Burst                   uint32
+	SustainedRequestsPerSec uint32
+	BanDurationInSec        uint32
+}
+
+// APIKeyRateLimitConfig holds the rate limit of the consumer identified by an API key
+type APIKeyRateLimitConfig struct {
+	Name                    string
+	Key                     string
+	Burst                   uint32
+	SustainedRequestsPerSec uint32
+	BanDurationInSec        uint32
+}
+
+// RateLimiterConfig holds the per consumer rate limits of the web server. The consumers are identified by their API
+// key, when provided, or by their source address
+type RateLimiterConfig struct {
+	Enabled                      bool
+	APIKeyHeader                 string
+	IdleConsumersCleanupInterval uint32
+	DefaultLimit                 RateLimitConfig
+	Routes                       []RouteRateLimitConfig
+	APIKeys                      []APIKeyRateLimitConfig
 }
 
 // BlackListConfig will hold the p2p peer black list threshold values
@@ -302,6 +504,7 @@ type AntifloodConfig struct {
 	OutOfSpecs                FloodPreventerConfig
 	FastReacting              FloodPreventerConfig
 	SlowReacting              FloodPreventerConfig
+	WebSocketPeers            WebSocketPeersAntifloodConfig
 	PeerMaxOutput             AntifloodLimitsConfig
 	Cache                     CacheConfig
 	WebServer                 WebServerAntifloodConfig
@@ -309,6 +512,62 @@ type AntifloodConfig struct {
 	TxAccumulator             TxAccumulatorConfig
 }
 
+// WebSocketPeersAntifloodConfig will hold the flood preventer parameters applied to the peers connected through the
+// web socket listener, instead of the regular ones
+type WebSocketPeersAntifloodConfig struct {
+	Enabled bool
+	FloodPreventerConfig
+}
+
+// PartitionDetectorConfig will hold the settings used to flag probable network partitions or eclipse conditions
+type PartitionDetectorConfig struct {
+	Enabled                          bool
+	CheckIntervalInSeconds           uint32
+	MinConnectedPeers                uint32
+	MaxHeaderGapInSeconds            uint32
+	MinConsensusParticipationPercent uint32
+	NumSignalsForPartition           uint32
+	ProtectiveModeEnabled            bool
+}
+
+// EquivocationDetectorConfig will hold the settings used to capture and report the conflicting consensus messages
+type EquivocationDetectorConfig struct {
+	Enabled         bool
+	NumRoundsToKeep uint32
+	ProofsStorage   StorageConfig
+	ForwardProofs   bool
+	ReporterPemFile string
+	GasLimit        uint64
+}
+
+// ConsensusTimingConfig will hold the settings used to adapt the consensus subrounds deadlines to the observed block
+// sizes and network latencies
+type ConsensusTimingConfig struct {
+	Adaptive                   bool
+	NumRoundsWindow            uint32
+	MinObservations            uint32
+	SafetyFactor               float64
+	MinProposalDeadlinePercent uint32
+	MaxProposalDeadlinePercent uint32
+}
+
+// PipelinedProposalConfig will hold the settings used to prepare the next block proposal while the current block is
+// still being finalized
+type PipelinedProposalConfig struct {
+	Enabled bool
+}
+
+// FallbackLeaderConfig will hold the settings of the backup proposer used when the leader of a round fails to propose
+type FallbackLeaderConfig struct {
+	ProposalTimeoutPercent uint32
+}
+
+// RedundancyConfig will hold the settings used when a backup node is running besides the main node of a validator
+type RedundancyConfig struct {
+	MaxRoundsOfInactivityAccepted uint64
+	FenceFilePath                 string
+}
+
 // FloodPreventerConfig will hold all flood preventer parameters
 type FloodPreventerConfig struct {
 	IntervalInSeconds uint32
@@ -346,7 +605,8 @@ type VirtualMachineConfig struct {
 // QueryVirtualMachineConfig holds the configuration for the virtual machine(s) used in query process
 type QueryVirtualMachineConfig struct {
 	VirtualMachineConfig
-	NumConcurrentVMs int
+	NumConcurrentVMs         int
+	HistoricalQueriesEnabled bool
 }
 
 // VirtualMachineOutOfProcessConfig holds configuration for out-of-process virtual machine(s)
@@ -375,6 +635,7 @@ type HardforkConfig struct {
 	EnableTriggerFromP2P         bool
 	MustImport                   bool
 	AfterHardFork                bool
+	ExportGenesisFiles           bool
 }
 
 // DbLookupExtensionsConfig holds the configuration for the db lookup extensions
@@ -384,12 +645,14 @@ type DbLookupExtensionsConfig struct {
 	MiniblockHashByTxHashStorageConfig StorageConfig
 	EpochByHashStorageConfig           StorageConfig
 	ResultsHashesByTxHashStorageConfig StorageConfig
+	LogsIndexStorageConfig             StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
 type DebugConfig struct {
 	InterceptorResolver InterceptorResolverDebugConfig
 	Antiflood           AntifloodDebugConfig
+	Consensus           ConsensusDebugConfig
 }
 
 // HealthServiceConfig will hold health service (monitoring) configuration
@@ -420,9 +683,55 @@ type AntifloodDebugConfig struct {
 	IntervalAutoPrintInSeconds int
 }
 
+// ConsensusDebugConfig will hold the consensus state debug configuration
+type ConsensusDebugConfig struct {
+	Enabled               bool
+	NumRoundsToKeep       int
+	NumMissedRoundsToDump int
+	DumpFolderPath        string
+}
+
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
-	APIPackages map[string]APIPackageConfig
+	APIPackages         map[string]APIPackageConfig
+	ManagementAuth      ManagementAuthConfig
+	ResponseCompression ResponseCompressionConfig
+	HealthProbes        HealthProbesConfig
+}
+
+// HealthProbesConfig holds the thresholds used by the health and readiness probes of the node when evaluating its
+// subsystems
+type HealthProbesConfig struct {
+	MinConnectedPeers         uint32
+	MaxSyncDistanceInBlocks   uint64
+	MaxMissedProposalsInEpoch uint64
+}
+
+// ResponseCompressionConfig holds the configuration of the response compression and of the conditional requests
+// support for the heavy read routes of the web server. The routes are provided as full paths, a trailing * matching
+// all the routes having that prefix
+type ResponseCompressionConfig struct {
+	Enabled          bool
+	Routes           []string
+	MinSizeInBytes   uint32
+	CompressionLevel int
+}
+
+// ManagementAuthConfig holds the configuration of the authentication required by the management routes of the web
+// server. The authentication Mode can be "jwt", requiring a HS256 signed bearer token, or "mtls", requiring a client
+// certificate issued by the configured CA. The routes not listed as protected remain public
+type ManagementAuthConfig struct {
+	Enabled            bool
+	Mode               string
+	ProtectedRoutes    []string
+	JWTSecretFile      string
+	JWTIssuer          string
+	JWTAudience        string
+	JWTLeewayInSec     uint32
+	TLSCertificateFile string
+	TLSKeyFile         string
+	ClientCAFile       string
+	AllowedClientNames []string
 }
 
 // APIPackageConfig holds the configuration for the routes of each package
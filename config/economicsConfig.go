@@ -5,9 +5,17 @@ type GlobalSettings struct {
 	GenesisTotalSupply string
 	MinimumInflation   float64
 	YearSettings       []*YearSetting
+	InflationSchedule  []InflationSetting
 	Denomination       int
 }
 
+// InflationSetting will hold the maximum inflation rate and the total supply cap used starting with the provided epoch
+type InflationSetting struct {
+	StartEpoch       uint32
+	MaximumInflation float64
+	TotalSupplyCap   string
+}
+
 // YearSetting will hold the maximum inflation rate for year
 type YearSetting struct {
 	Year             uint32
@@ -18,20 +26,46 @@ type YearSetting struct {
 type RewardsSettings struct {
 	LeaderPercentage                 float64
 	DeveloperPercentage              float64
+	DeveloperPercentageOverrideMin   float64
+	DeveloperPercentageOverrideMax   float64
 	ProtocolSustainabilityPercentage float64
 	ProtocolSustainabilityAddress    string
+	ProtocolSustainabilityAddresses  []ProtocolSustainabilityAddressConfig
 	TopUpGradientPoint               string
 	TopUpFactor                      float64
+	TopUpSettings                    []TopUpSetting
+}
+
+// TopUpSetting will hold the top-up rewards curve parameters used starting with the provided epoch
+type TopUpSetting struct {
+	EpochEnable         uint32
+	TopUpFactor         float64
+	TopUpGradientPoint  string
+	TopUpCurveSteepness float64
+}
+
+// ProtocolSustainabilityAddressConfig will hold a beneficiary of the protocol sustainability rewards and its weight
+type ProtocolSustainabilityAddressConfig struct {
+	Address string
+	Weight  uint32
 }
 
 // FeeSettings will hold economics fee settings
 type FeeSettings struct {
-	MaxGasLimitPerBlock     string
-	MaxGasLimitPerMetaBlock string
-	GasPerDataByte          string
-	MinGasPrice             string
-	MinGasLimit             string
-	GasPriceModifier        float64
+	MaxGasLimitPerBlock      string
+	MaxGasLimitPerMetaBlock  string
+	GasPerDataByte           string
+	MinGasPrice              string
+	MinGasLimit              string
+	GasPriceModifier         float64
+	GasPriceModifierSettings []GasPriceModifierSetting
+}
+
+// GasPriceModifierSetting will hold the gas price modifier applied to the smart contract execution gas starting with
+// the provided epoch
+type GasPriceModifierSetting struct {
+	EpochEnable      uint32
+	GasPriceModifier float64
 }
 
 // EconomicsConfig will hold economics config
@@ -3,15 +3,112 @@ package config
 // ExternalConfig will hold the configurations for external tools, such as Explorer or Elastic Search
 type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
+	LightTopicsNotifier    LightTopicsNotifierConfig
+	PushNotifier           PushNotifierConfig
+	KafkaConnector         KafkaConnectorConfig
+	PostgreSQLConnector    PostgreSQLConnectorConfig
+	GRPCConnector          GRPCConnectorConfig
+	OutportPlugins         []OutportPluginConfig
+	OutportMonitor         OutportMonitorConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
 type ElasticSearchConfig struct {
-	Enabled          bool
-	IndexerCacheSize int
-	URL              string
-	UseKibana        bool
-	Username         string
-	Password         string
-	EnabledIndexes   []string
+	Enabled                   bool
+	IndexerCacheSize          int
+	URL                       string
+	UseKibana                 bool
+	Username                  string
+	Password                  string
+	EnabledIndexes            []string
+	MaxRetryIntervalInSeconds uint32
+	LagThresholdInItems       uint32
+	WriteAheadLog             WriteAheadLogConfig
+}
+
+// LightTopicsNotifierConfig will hold the configuration for the light topics notifier
+type LightTopicsNotifierConfig struct {
+	Enabled         bool
+	AddressPrefixes []string
+}
+
+// PushNotifierConfig will hold the configuration for the websocket push notifications
+type PushNotifierConfig struct {
+	Enabled              bool
+	MaxSubscribers       uint32
+	SubscriberBufferSize uint32
+	WriteAheadLog        WriteAheadLogConfig
+}
+
+// KafkaConnectorConfig will hold the configuration for the Kafka driver
+type KafkaConnectorConfig struct {
+	Enabled                   bool
+	Brokers                   []string
+	TopicPrefix               string
+	PartitionBy               string
+	BufferSize                uint32
+	RetryIntervalInSeconds    uint32
+	MaxRetryIntervalInSeconds uint32
+	LagThresholdInItems       uint32
+	WriteTimeoutInSeconds     uint32
+	WriteAheadLog             WriteAheadLogConfig
+	Filter                    OutportFilterConfig
+}
+
+// PostgreSQLConnectorConfig will hold the configuration for the PostgreSQL driver
+type PostgreSQLConnectorConfig struct {
+	Enabled                   bool
+	ConnectionString          string
+	MaxOpenConnections        int
+	BufferSize                uint32
+	RetryIntervalInSeconds    uint32
+	MaxRetryIntervalInSeconds uint32
+	LagThresholdInItems       uint32
+	WriteAheadLog             WriteAheadLogConfig
+	Filter                    OutportFilterConfig
+}
+
+// GRPCConnectorConfig will hold the configuration for the gRPC driver
+type GRPCConnectorConfig struct {
+	Enabled       bool
+	ListenAddress string
+	BufferSize    uint32
+	MaxConsumers  uint32
+	WriteAheadLog WriteAheadLogConfig
+	Filter        OutportFilterConfig
+}
+
+// OutportPluginConfig will hold the configuration of an outport driver shipped as a separate binary
+type OutportPluginConfig struct {
+	Enabled                   bool
+	Name                      string
+	Path                      string
+	Arguments                 []string
+	HandshakeTimeoutInSeconds uint32
+	BufferSize                uint32
+	RetryIntervalInSeconds    uint32
+	MaxRetryIntervalInSeconds uint32
+	LagThresholdInItems       uint32
+	WriteAheadLog             WriteAheadLogConfig
+	Filter                    OutportFilterConfig
+}
+
+// OutportMonitorConfig will hold the configuration of the health checks of the outport drivers
+type OutportMonitorConfig struct {
+	CheckIntervalInSeconds uint32
+}
+
+// WriteAheadLogConfig will hold the configuration of the disk-backed buffer of an outport driver
+type WriteAheadLogConfig struct {
+	Enabled     bool
+	Path        string
+	MaxSizeInMB uint32
+}
+
+// OutportFilterConfig will hold the filters applied on the data before it is handed to an outport driver
+type OutportFilterConfig struct {
+	Shards          []string
+	LogIdentifiers  []string
+	LogAddresses    []string
+	ExcludeAccounts bool
 }
@@ -5,6 +5,7 @@ type P2PConfig struct {
 	Node                NodeConfig
 	KadDhtPeerDiscovery KadDhtPeerDiscoveryConfig
 	Sharding            ShardingConfig
+	WebSocket           WebSocketConfig
 }
 
 // NodeConfig will hold basic p2p settings
@@ -34,3 +35,9 @@ type ShardingConfig struct {
 	MaxCrossShardObservers  uint32
 	Type                    string
 }
+
+// WebSocketConfig will hold the settings for the additional web socket listener used by the browser light clients
+type WebSocketConfig struct {
+	Enabled bool
+	Port    string
+}
@@ -7,7 +7,10 @@ type Preferences struct {
 
 // PreferencesConfig will hold the fields which are node specific such as the display name
 type PreferencesConfig struct {
-	DestinationShardAsObserver string
-	NodeDisplayName            string
-	Identity                   string
+	DestinationShardAsObserver          string
+	AutoObserverShardProbeDurationInSec uint32
+	NodeDisplayName                     string
+	Identity                            string
+	RedundancyLevel                     int64
+	PreferredConnections                []string
 }
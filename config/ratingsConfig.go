@@ -6,6 +6,7 @@ type RatingsConfig struct {
 	ShardChain  ShardChain
 	MetaChain   MetaChain
 	PeerHonesty PeerHonestyConfig
+	Probation   ProbationConfig
 }
 
 // General will hold ratings settings both for metachain and shardChain
@@ -41,11 +42,20 @@ type SelectionChance struct {
 
 // RatingSteps holds the necessary increases and decreases of the rating steps
 type RatingSteps struct {
-	HoursToMaxRatingFromStartRating uint32
-	ProposerValidatorImportance     float32
-	ProposerDecreaseFactor          float32
-	ValidatorDecreaseFactor         float32
-	ConsecutiveMissedBlocksPenalty  float32
+	HoursToMaxRatingFromStartRating    uint32
+	ProposerValidatorImportance        float32
+	ProposerDecreaseFactor             float32
+	ValidatorDecreaseFactor            float32
+	ConsecutiveMissedBlocksPenalty     float32
+	ConsecutiveMissedSignaturesPenalty float32
+}
+
+// ProbationConfig holds the settings of the probation state in which a validator enters after consecutively missing
+// its proposals or signatures
+type ProbationConfig struct {
+	ConsecutiveMissesThreshold uint32
+	DurationInEpochs           uint32
+	PenaltyMultiplier          float32
 }
 
 // PeerHonestyConfig holds the parameters for the peer honesty handler
@@ -7,6 +7,10 @@ type SystemSmartContractsConfig struct {
 	StakingSystemSCConfig           StakingSystemSCConfig
 	DelegationManagerSystemSCConfig DelegationManagerSystemSCConfig
 	DelegationSystemSCConfig        DelegationSystemSCConfig
+	SlashingSystemSCConfig          SlashingSystemSCConfig
+	RandomnessSystemSCConfig        RandomnessSystemSCConfig
+	InsuranceFundSystemSCConfig     InsuranceFundSystemSCConfig
+	MintingSystemSCConfig           MintingSystemSCConfig
 }
 
 // StakingSystemSCConfig will hold the staking system smart contract settings
@@ -25,13 +29,21 @@ type StakingSystemSCConfig struct {
 	StakeEnableEpoch                     uint32
 	DoubleKeyProtectionEnableEpoch       uint32
 	ActivateBLSPubKeyMessageVerification bool
+	DynamicUnJailPriceEnableEpoch        uint32
+	UnJailPriceJailWindowEpochs          uint32
+	UnJailPriceIncreasePercentage        float64
+	MaxUnJailPriceMultiplier             uint32
+	RewardAddressValidationEnableEpoch   uint32
+	QueueViewEnableEpoch                 uint32
+	SwapNodeEnableEpoch                  uint32
 }
 
 // ESDTSystemSCConfig defines a set of constant to initialize the esdt system smart contract
 type ESDTSystemSCConfig struct {
-	BaseIssuingCost string
-	OwnerAddress    string
-	EnabledEpoch    uint32
+	BaseIssuingCost       string
+	OwnerAddress          string
+	EnabledEpoch          uint32
+	TokensPageEnableEpoch uint32
 }
 
 // GovernanceSystemSCConfig defines the set of constants to initialize the governance system smart contract
@@ -58,3 +70,27 @@ type DelegationSystemSCConfig struct {
 	MinServiceFee  uint64
 	MaxServiceFee  uint64
 }
+
+// SlashingSystemSCConfig defines a set of constants to initialize the slashing system smart contract
+type SlashingSystemSCConfig struct {
+	EnabledEpoch uint32
+}
+
+// RandomnessSystemSCConfig defines a set of constants to initialize the randomness system smart contract
+type RandomnessSystemSCConfig struct {
+	EnabledEpoch uint32
+}
+
+// InsuranceFundSystemSCConfig defines a set of constants to initialize the insurance fund system smart contract
+type InsuranceFundSystemSCConfig struct {
+	EnabledEpoch      uint32
+	RewardsPercentage float64
+}
+
+// MintingSystemSCConfig defines a set of constants to initialize the minting system smart contract, used by the
+// private networks to top up the genesis accounts flagged as mintable
+type MintingSystemSCConfig struct {
+	Enabled              bool
+	MintingReserve       string
+	MaxInflationPerEpoch float64
+}
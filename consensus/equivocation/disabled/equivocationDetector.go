@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// EquivocationDetector is the disabled equivocation detector implementation
+type EquivocationDetector struct {
+}
+
+// ProcessProposal does nothing
+func (ed *EquivocationDetector) ProcessProposal(_ *consensus.Message) {
+}
+
+// ProcessSignature does nothing
+func (ed *EquivocationDetector) ProcessSignature(_ *consensus.Message) {
+}
+
+// Close returns nil
+func (ed *EquivocationDetector) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ed *EquivocationDetector) IsInterfaceNil() bool {
+	return ed == nil
+}
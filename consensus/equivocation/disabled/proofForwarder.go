@@ -0,0 +1,24 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus/equivocation"
+)
+
+// ProofForwarder is the disabled proof forwarder implementation, used when the proofs are only persisted locally
+type ProofForwarder struct {
+}
+
+// ForwardProof returns nil
+func (pf *ProofForwarder) ForwardProof(_ *equivocation.Proof) error {
+	return nil
+}
+
+// SetTransactionSender returns nil
+func (pf *ProofForwarder) SetTransactionSender(_ equivocation.TransactionSender) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pf *ProofForwarder) IsInterfaceNil() bool {
+	return pf == nil
+}
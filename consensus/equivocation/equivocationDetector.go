@@ -0,0 +1,255 @@
+package equivocation
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("consensus/equivocation")
+
+// ArgsEquivocationDetector is the DTO used to create a new instance of equivocationDetector
+type ArgsEquivocationDetector struct {
+	Hasher          hashing.Hasher
+	Marshalizer     marshal.Marshalizer
+	ProofsStorer    storage.Storer
+	Forwarder       ProofForwarder
+	ShardID         uint32
+	NumRoundsToKeep uint32
+}
+
+type messageRecord struct {
+	headerHash []byte
+	signature  []byte
+}
+
+type roundRecords struct {
+	proposals  map[string]*messageRecord
+	signatures map[string]*messageRecord
+	headers    map[string][]byte
+	reported   map[string]struct{}
+}
+
+// equivocationDetector keeps track of the proposals and the signature shares received on the consensus topic during
+// the last rounds. Whenever a validator is seen sending conflicting messages in the same round, a proof is built,
+// persisted and forwarded to the slashing system SC
+type equivocationDetector struct {
+	hasher          hashing.Hasher
+	marshalizer     marshal.Marshalizer
+	proofsStorer    storage.Storer
+	forwarder       ProofForwarder
+	shardID         uint32
+	numRoundsToKeep int64
+
+	mutRecords sync.Mutex
+	rounds     map[int64]*roundRecords
+	lastRound  int64
+}
+
+// NewEquivocationDetector creates a new equivocation detector
+func NewEquivocationDetector(args ArgsEquivocationDetector) (*equivocationDetector, error) {
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.ProofsStorer) {
+		return nil, ErrNilProofsStorer
+	}
+	if check.IfNil(args.Forwarder) {
+		return nil, ErrNilProofForwarder
+	}
+	if args.NumRoundsToKeep == 0 {
+		return nil, ErrInvalidNumRoundsToKeep
+	}
+
+	return &equivocationDetector{
+		hasher:          args.Hasher,
+		marshalizer:     args.Marshalizer,
+		proofsStorer:    args.ProofsStorer,
+		forwarder:       args.Forwarder,
+		shardID:         args.ShardID,
+		numRoundsToKeep: int64(args.NumRoundsToKeep),
+		rounds:          make(map[int64]*roundRecords),
+	}, nil
+}
+
+// ProcessProposal records the header proposed in the provided consensus message and checks it against the
+// previous proposal of the same leader in the same round
+func (ed *equivocationDetector) ProcessProposal(cnsMsg *consensus.Message) {
+	if cnsMsg == nil || len(cnsMsg.Header) == 0 || len(cnsMsg.BlockHeaderHash) == 0 {
+		return
+	}
+	// only the headers matching their hash are kept so that the proofs can be verified by anyone
+	if !bytes.Equal(ed.hasher.Compute(string(cnsMsg.Header)), cnsMsg.BlockHeaderHash) {
+		return
+	}
+
+	ed.mutRecords.Lock()
+	records := ed.getRoundRecords(cnsMsg.RoundIndex)
+	if records == nil {
+		ed.mutRecords.Unlock()
+		return
+	}
+
+	records.headers[string(cnsMsg.BlockHeaderHash)] = cnsMsg.Header
+	proof := ed.checkConflict(records, records.proposals, ProposalEquivocation, cnsMsg)
+	ed.mutRecords.Unlock()
+
+	ed.handleProof(proof)
+}
+
+// ProcessSignature records the signature share from the provided consensus message and checks it against the
+// previous signature share given by the same validator in the same round
+func (ed *equivocationDetector) ProcessSignature(cnsMsg *consensus.Message) {
+	if cnsMsg == nil || len(cnsMsg.SignatureShare) == 0 || len(cnsMsg.BlockHeaderHash) == 0 {
+		return
+	}
+
+	ed.mutRecords.Lock()
+	records := ed.getRoundRecords(cnsMsg.RoundIndex)
+	if records == nil {
+		ed.mutRecords.Unlock()
+		return
+	}
+
+	proof := ed.checkConflict(records, records.signatures, SignatureEquivocation, cnsMsg)
+	ed.mutRecords.Unlock()
+
+	ed.handleProof(proof)
+}
+
+// getRoundRecords returns the records of the provided round, removing the records that became too old.
+// Returns nil if the provided round is itself too old. Should be called under mutex protection
+func (ed *equivocationDetector) getRoundRecords(round int64) *roundRecords {
+	if round > ed.lastRound {
+		ed.lastRound = round
+		for r := range ed.rounds {
+			if r <= ed.lastRound-ed.numRoundsToKeep {
+				delete(ed.rounds, r)
+			}
+		}
+	}
+	if round <= ed.lastRound-ed.numRoundsToKeep {
+		return nil
+	}
+
+	records, ok := ed.rounds[round]
+	if !ok {
+		records = &roundRecords{
+			proposals:  make(map[string]*messageRecord),
+			signatures: make(map[string]*messageRecord),
+			headers:    make(map[string][]byte),
+			reported:   make(map[string]struct{}),
+		}
+		ed.rounds[round] = records
+	}
+
+	return records
+}
+
+// checkConflict should be called under mutex protection
+func (ed *equivocationDetector) checkConflict(
+	records *roundRecords,
+	messages map[string]*messageRecord,
+	proofType string,
+	cnsMsg *consensus.Message,
+) *Proof {
+	pubKey := string(cnsMsg.PubKey)
+	first, found := messages[pubKey]
+	if !found {
+		messages[pubKey] = &messageRecord{
+			headerHash: cnsMsg.BlockHeaderHash,
+			signature:  cnsMsg.SignatureShare,
+		}
+		return nil
+	}
+	if bytes.Equal(first.headerHash, cnsMsg.BlockHeaderHash) {
+		return nil
+	}
+
+	reportKey := proofType + pubKey
+	_, alreadyReported := records.reported[reportKey]
+	if alreadyReported {
+		return nil
+	}
+	records.reported[reportKey] = struct{}{}
+
+	return &Proof{
+		Type:             proofType,
+		PublicKey:        cnsMsg.PubKey,
+		ShardID:          ed.shardID,
+		Round:            cnsMsg.RoundIndex,
+		FirstHeaderHash:  first.headerHash,
+		FirstHeader:      records.headers[string(first.headerHash)],
+		FirstSignature:   first.signature,
+		SecondHeaderHash: cnsMsg.BlockHeaderHash,
+		SecondHeader:     records.headers[string(cnsMsg.BlockHeaderHash)],
+		SecondSignature:  cnsMsg.SignatureShare,
+	}
+}
+
+func (ed *equivocationDetector) handleProof(proof *Proof) {
+	if proof == nil {
+		return
+	}
+
+	proofHash, err := ed.saveProof(proof)
+	if err != nil {
+		log.Warn("equivocationDetector: can not persist equivocation proof", "error", err.Error())
+	}
+
+	log.Warn("equivocation detected",
+		"type", proof.Type,
+		"public key", proof.PublicKey,
+		"round", proof.Round,
+		"first header hash", proof.FirstHeaderHash,
+		"second header hash", proof.SecondHeaderHash,
+		"proof hash", proofHash,
+	)
+
+	if !proof.IsVerifiable() {
+		log.Debug("equivocationDetector: proof not forwarded", "error", ErrProofNotVerifiable.Error())
+		return
+	}
+
+	err = ed.forwarder.ForwardProof(proof)
+	if err != nil {
+		log.Warn("equivocationDetector: can not forward equivocation proof",
+			"proof hash", proofHash, "error", err.Error())
+	}
+}
+
+func (ed *equivocationDetector) saveProof(proof *Proof) ([]byte, error) {
+	buff, err := ed.marshalizer.Marshal(proof)
+	if err != nil {
+		return nil, err
+	}
+
+	proofHash := ed.hasher.Compute(string(buff))
+	err = ed.proofsStorer.Put(proofHash, buff)
+	if err != nil {
+		return nil, fmt.Errorf("%w for proof hash %s", err, hex.EncodeToString(proofHash))
+	}
+
+	return proofHash, nil
+}
+
+// Close closes the proofs storer
+func (ed *equivocationDetector) Close() error {
+	return ed.proofsStorer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ed *equivocationDetector) IsInterfaceNil() bool {
+	return ed == nil
+}
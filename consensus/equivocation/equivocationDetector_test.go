@@ -0,0 +1,185 @@
+package equivocation_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/equivocation"
+	"github.com/ElrondNetwork/elrond-go/consensus/equivocation/mock"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsEquivocationDetector() equivocation.ArgsEquivocationDetector {
+	return equivocation.ArgsEquivocationDetector{
+		Hasher:          sha256.Sha256{},
+		Marshalizer:     &marshal.JsonMarshalizer{},
+		ProofsStorer:    genericmocks.NewStorerMock("proofs", 0),
+		Forwarder:       &mock.ProofForwarderStub{},
+		ShardID:         1,
+		NumRoundsToKeep: 3,
+	}
+}
+
+func createProposal(round int64, pubKey string, header string) *consensus.Message {
+	return &consensus.Message{
+		Header:          []byte(header),
+		BlockHeaderHash: sha256.Sha256{}.Compute(header),
+		PubKey:          []byte(pubKey),
+		RoundIndex:      round,
+	}
+}
+
+func createSignature(round int64, pubKey string, header string, signature string) *consensus.Message {
+	return &consensus.Message{
+		BlockHeaderHash: sha256.Sha256{}.Compute(header),
+		SignatureShare:  []byte(signature),
+		PubKey:          []byte(pubKey),
+		RoundIndex:      round,
+	}
+}
+
+func TestNewEquivocationDetector_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.Hasher = nil
+	ed, err := equivocation.NewEquivocationDetector(args)
+	assert.True(t, check.IfNil(ed))
+	assert.Equal(t, equivocation.ErrNilHasher, err)
+
+	args = createMockArgsEquivocationDetector()
+	args.Marshalizer = nil
+	ed, err = equivocation.NewEquivocationDetector(args)
+	assert.True(t, check.IfNil(ed))
+	assert.Equal(t, equivocation.ErrNilMarshalizer, err)
+
+	args = createMockArgsEquivocationDetector()
+	args.ProofsStorer = nil
+	ed, err = equivocation.NewEquivocationDetector(args)
+	assert.True(t, check.IfNil(ed))
+	assert.Equal(t, equivocation.ErrNilProofsStorer, err)
+
+	args = createMockArgsEquivocationDetector()
+	args.Forwarder = nil
+	ed, err = equivocation.NewEquivocationDetector(args)
+	assert.True(t, check.IfNil(ed))
+	assert.Equal(t, equivocation.ErrNilProofForwarder, err)
+
+	args = createMockArgsEquivocationDetector()
+	args.NumRoundsToKeep = 0
+	ed, err = equivocation.NewEquivocationDetector(args)
+	assert.True(t, check.IfNil(ed))
+	assert.Equal(t, equivocation.ErrInvalidNumRoundsToKeep, err)
+}
+
+func TestNewEquivocationDetector_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ed, err := equivocation.NewEquivocationDetector(createMockArgsEquivocationDetector())
+	assert.False(t, check.IfNil(ed))
+	assert.Nil(t, err)
+}
+
+func TestEquivocationDetector_ProcessProposalConflictShouldPersistButNotForward(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	storer := genericmocks.NewStorerMock("proofs", 0)
+	args.ProofsStorer = storer
+	args.Forwarder = &mock.ProofForwarderStub{
+		ForwardProofCalled: func(proof *equivocation.Proof) error {
+			assert.Fail(t, "proposal equivocations are not verifiable and should not be forwarded")
+			return nil
+		},
+	}
+	ed, _ := equivocation.NewEquivocationDetector(args)
+
+	ed.ProcessProposal(createProposal(10, "leader", "header1"))
+	ed.ProcessProposal(createProposal(10, "leader", "header1"))
+	assert.Equal(t, 0, storer.GetCurrentEpochData().Len())
+
+	ed.ProcessProposal(createProposal(10, "leader", "header2"))
+	ed.ProcessProposal(createProposal(10, "leader", "header3"))
+	require.Equal(t, 1, storer.GetCurrentEpochData().Len())
+
+	buff, _ := storer.GetCurrentEpochData().Get(storer.GetCurrentEpochData().Keys()[0])
+	proof := &equivocation.Proof{}
+	err := args.Marshalizer.Unmarshal(proof, buff.([]byte))
+	assert.Nil(t, err)
+	assert.Equal(t, equivocation.ProposalEquivocation, proof.Type)
+	assert.Equal(t, []byte("leader"), proof.PublicKey)
+	assert.Equal(t, uint32(1), proof.ShardID)
+	assert.Equal(t, int64(10), proof.Round)
+	assert.Equal(t, []byte("header1"), proof.FirstHeader)
+	assert.Equal(t, []byte("header2"), proof.SecondHeader)
+}
+
+func TestEquivocationDetector_ProcessProposalWithMismatchedHashShouldIgnore(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	storer := genericmocks.NewStorerMock("proofs", 0)
+	args.ProofsStorer = storer
+	ed, _ := equivocation.NewEquivocationDetector(args)
+
+	ed.ProcessProposal(createProposal(10, "leader", "header1"))
+	cnsMsg := createProposal(10, "leader", "header2")
+	cnsMsg.BlockHeaderHash = []byte("another hash")
+	ed.ProcessProposal(cnsMsg)
+
+	assert.Equal(t, 0, storer.GetCurrentEpochData().Len())
+}
+
+func TestEquivocationDetector_ProcessSignatureConflictShouldForwardVerifiableProof(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	storer := genericmocks.NewStorerMock("proofs", 0)
+	args.ProofsStorer = storer
+	forwardedProofs := make([]*equivocation.Proof, 0)
+	args.Forwarder = &mock.ProofForwarderStub{
+		ForwardProofCalled: func(proof *equivocation.Proof) error {
+			forwardedProofs = append(forwardedProofs, proof)
+			return errors.New("forwarding errors should only be logged")
+		},
+	}
+	ed, _ := equivocation.NewEquivocationDetector(args)
+
+	ed.ProcessProposal(createProposal(10, "leader", "header1"))
+	ed.ProcessProposal(createProposal(10, "leader", "header2"))
+	ed.ProcessSignature(createSignature(10, "validator", "header1", "sig1"))
+	ed.ProcessSignature(createSignature(10, "validator", "header2", "sig2"))
+	ed.ProcessSignature(createSignature(10, "validator", "header2", "sig2"))
+
+	assert.Equal(t, 2, storer.GetCurrentEpochData().Len())
+	require.Equal(t, 1, len(forwardedProofs))
+	proof := forwardedProofs[0]
+	assert.True(t, proof.IsVerifiable())
+	assert.Equal(t, equivocation.SignatureEquivocation, proof.Type)
+	assert.Equal(t, []byte("validator"), proof.PublicKey)
+	assert.Equal(t, []byte("header1"), proof.FirstHeader)
+	assert.Equal(t, []byte("sig1"), proof.FirstSignature)
+	assert.Equal(t, []byte("header2"), proof.SecondHeader)
+	assert.Equal(t, []byte("sig2"), proof.SecondSignature)
+}
+
+func TestEquivocationDetector_ProcessSignatureOldRoundsShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	storer := genericmocks.NewStorerMock("proofs", 0)
+	args.ProofsStorer = storer
+	ed, _ := equivocation.NewEquivocationDetector(args)
+
+	ed.ProcessSignature(createSignature(10, "validator", "header1", "sig1"))
+	ed.ProcessSignature(createSignature(13, "validator", "header3", "sig3"))
+	ed.ProcessSignature(createSignature(10, "validator", "header2", "sig2"))
+
+	assert.Equal(t, 0, storer.GetCurrentEpochData().Len())
+}
@@ -0,0 +1,42 @@
+package equivocation
+
+import "errors"
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilProofsStorer signals that a nil proofs storer has been provided
+var ErrNilProofsStorer = errors.New("nil proofs storer")
+
+// ErrNilProofForwarder signals that a nil proof forwarder has been provided
+var ErrNilProofForwarder = errors.New("nil proof forwarder")
+
+// ErrInvalidNumRoundsToKeep signals that an invalid number of rounds to keep has been provided
+var ErrInvalidNumRoundsToKeep = errors.New("invalid number of rounds to keep")
+
+// ErrNilPrivateKey signals that a nil private key has been provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrInvalidChainID signals that an invalid chain ID has been provided
+var ErrInvalidChainID = errors.New("invalid chain ID")
+
+// ErrInvalidGasLimit signals that an invalid gas limit has been provided
+var ErrInvalidGasLimit = errors.New("invalid gas limit")
+
+// ErrNilTransactionSender signals that a nil transaction sender has been provided
+var ErrNilTransactionSender = errors.New("nil transaction sender")
+
+// ErrProofNotVerifiable signals that the proof can not be verified by the slashing system SC
+var ErrProofNotVerifiable = errors.New("equivocation proof is not verifiable")
@@ -0,0 +1,31 @@
+package equivocation
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+// Detector defines the behaviour of the equivocation detector as seen by its owner
+type Detector interface {
+	consensus.EquivocationDetector
+	Close() error
+}
+
+// ProofForwarder defines the behaviour of a component able to forward an equivocation proof to the slashing system SC
+type ProofForwarder interface {
+	ForwardProof(proof *Proof) error
+	IsInterfaceNil() bool
+}
+
+// ProofForwarderHandler defines the behaviour of a proof forwarder that receives the transaction sender after
+// its creation
+type ProofForwarderHandler interface {
+	ProofForwarder
+	SetTransactionSender(txSender TransactionSender) error
+}
+
+// TransactionSender defines the behaviour of a component able to send transactions on the network
+type TransactionSender interface {
+	SendBulkTransactions(txs []*transaction.Transaction) (uint64, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus/equivocation"
+)
+
+// ProofForwarderStub -
+type ProofForwarderStub struct {
+	ForwardProofCalled func(proof *equivocation.Proof) error
+}
+
+// ForwardProof -
+func (pfs *ProofForwarderStub) ForwardProof(proof *equivocation.Proof) error {
+	if pfs.ForwardProofCalled != nil {
+		return pfs.ForwardProofCalled(proof)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (pfs *ProofForwarderStub) IsInterfaceNil() bool {
+	return pfs == nil
+}
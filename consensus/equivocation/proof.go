@@ -0,0 +1,32 @@
+package equivocation
+
+const (
+	// ProposalEquivocation is the type of the proof made of two different blocks proposed by the same leader
+	ProposalEquivocation = "proposal"
+	// SignatureEquivocation is the type of the proof made of two signature shares given by the same validator
+	// on different blocks
+	SignatureEquivocation = "signature"
+)
+
+// Proof holds the canonical evidence of an equivocation: the conflicting messages sent by the same validator in
+// the same round
+type Proof struct {
+	Type             string `json:"type"`
+	PublicKey        []byte `json:"publicKey"`
+	ShardID          uint32 `json:"shardID"`
+	Round            int64  `json:"round"`
+	FirstHeaderHash  []byte `json:"firstHeaderHash"`
+	FirstHeader      []byte `json:"firstHeader,omitempty"`
+	FirstSignature   []byte `json:"firstSignature,omitempty"`
+	SecondHeaderHash []byte `json:"secondHeaderHash"`
+	SecondHeader     []byte `json:"secondHeader,omitempty"`
+	SecondSignature  []byte `json:"secondSignature,omitempty"`
+}
+
+// IsVerifiable returns true if the proof can be verified by the slashing system SC. Only the signature shares
+// bind the validator's key to the headers, so a proposal equivocation is only kept as local evidence
+func (p *Proof) IsVerifiable() bool {
+	return p.Type == SignatureEquivocation &&
+		len(p.FirstHeader) > 0 && len(p.FirstSignature) > 0 &&
+		len(p.SecondHeader) > 0 && len(p.SecondSignature) > 0
+}
@@ -0,0 +1,185 @@
+package equivocation
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+const submitEquivocationProofFunction = "submitEquivocationProof"
+
+// ArgsSlashingSCForwarder is the DTO used to create a new instance of slashingSCForwarder
+type ArgsSlashingSCForwarder struct {
+	PrivateKey             crypto.PrivateKey
+	SingleSigner           crypto.SingleSigner
+	AddressPubkeyConverter core.PubkeyConverter
+	TxSignMarshalizer      marshal.Marshalizer
+	Accounts               state.AccountsAdapter
+	ChainID                []byte
+	MinTxVersion           uint32
+	GasPrice               uint64
+	GasLimit               uint64
+}
+
+// slashingSCForwarder sends the equivocation proofs to the slashing system SC as transactions signed with the
+// reporter's key. The reporter's account should reside in the node's shard
+type slashingSCForwarder struct {
+	privateKey             crypto.PrivateKey
+	singleSigner           crypto.SingleSigner
+	addressPubkeyConverter core.PubkeyConverter
+	txSignMarshalizer      marshal.Marshalizer
+	accounts               state.AccountsAdapter
+	address                []byte
+	chainID                []byte
+	minTxVersion           uint32
+	gasPrice               uint64
+	gasLimit               uint64
+
+	mutForwarder sync.Mutex
+	txSender     TransactionSender
+	nextNonce    uint64
+}
+
+// NewSlashingSCForwarder creates a new slashing SC forwarder
+func NewSlashingSCForwarder(args ArgsSlashingSCForwarder) (*slashingSCForwarder, error) {
+	if check.IfNil(args.PrivateKey) {
+		return nil, ErrNilPrivateKey
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.TxSignMarshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if len(args.ChainID) == 0 {
+		return nil, ErrInvalidChainID
+	}
+	if args.GasLimit == 0 {
+		return nil, ErrInvalidGasLimit
+	}
+
+	publicKey := args.PrivateKey.GeneratePublic()
+	address, err := publicKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return &slashingSCForwarder{
+		privateKey:             args.PrivateKey,
+		singleSigner:           args.SingleSigner,
+		addressPubkeyConverter: args.AddressPubkeyConverter,
+		txSignMarshalizer:      args.TxSignMarshalizer,
+		accounts:               args.Accounts,
+		address:                address,
+		chainID:                args.ChainID,
+		minTxVersion:           args.MinTxVersion,
+		gasPrice:               args.GasPrice,
+		gasLimit:               args.GasLimit,
+	}, nil
+}
+
+// SetTransactionSender sets the component used to send the created transactions
+func (f *slashingSCForwarder) SetTransactionSender(txSender TransactionSender) error {
+	if check.IfNil(txSender) {
+		return ErrNilTransactionSender
+	}
+
+	f.mutForwarder.Lock()
+	f.txSender = txSender
+	f.mutForwarder.Unlock()
+
+	return nil
+}
+
+// ForwardProof creates, signs and sends the transaction calling the slashing system SC with the provided proof
+func (f *slashingSCForwarder) ForwardProof(proof *Proof) error {
+	if proof == nil || !proof.IsVerifiable() {
+		return ErrProofNotVerifiable
+	}
+
+	f.mutForwarder.Lock()
+	defer f.mutForwarder.Unlock()
+
+	if check.IfNil(f.txSender) {
+		return ErrNilTransactionSender
+	}
+
+	account, err := f.accounts.GetExistingAccount(f.address)
+	if err != nil {
+		return fmt.Errorf("%w for reporter address %s", err, f.addressPubkeyConverter.Encode(f.address))
+	}
+	nonce := account.GetNonce()
+	if nonce < f.nextNonce {
+		nonce = f.nextNonce
+	}
+
+	tx := &transaction.Transaction{
+		Nonce:    nonce,
+		Value:    big.NewInt(0),
+		RcvAddr:  vm.SlashingSCAddress,
+		SndAddr:  f.address,
+		GasPrice: f.gasPrice,
+		GasLimit: f.gasLimit,
+		Data:     []byte(createProofTxData(proof)),
+		ChainID:  f.chainID,
+		Version:  f.minTxVersion,
+	}
+
+	buffToSign, err := tx.GetDataForSigning(f.addressPubkeyConverter, f.txSignMarshalizer)
+	if err != nil {
+		return err
+	}
+	tx.Signature, err = f.singleSigner.Sign(f.privateKey, buffToSign)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.txSender.SendBulkTransactions([]*transaction.Transaction{tx})
+	if err != nil {
+		return err
+	}
+	f.nextNonce = nonce + 1
+
+	log.Info("equivocation proof forwarded to the slashing system SC",
+		"public key", proof.PublicKey,
+		"round", proof.Round,
+		"reporter nonce", nonce,
+	)
+
+	return nil
+}
+
+func createProofTxData(proof *Proof) string {
+	arguments := []string{
+		submitEquivocationProofFunction,
+		hex.EncodeToString(proof.PublicKey),
+		hex.EncodeToString(big.NewInt(0).SetUint64(uint64(proof.ShardID)).Bytes()),
+		hex.EncodeToString(proof.FirstHeader),
+		hex.EncodeToString(proof.FirstSignature),
+		hex.EncodeToString(proof.SecondHeader),
+		hex.EncodeToString(proof.SecondSignature),
+	}
+
+	return strings.Join(arguments, "@")
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (f *slashingSCForwarder) IsInterfaceNil() bool {
+	return f == nil
+}
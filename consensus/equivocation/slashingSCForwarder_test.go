@@ -0,0 +1,174 @@
+package equivocation_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/equivocation"
+	"github.com/ElrondNetwork/elrond-go/consensus/equivocation/mock"
+	consensusMock "github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// the public key mock always returns the same bytes
+var reporterAddress = []byte("publicKeyMock")
+
+func createMockArgsSlashingSCForwarder() equivocation.ArgsSlashingSCForwarder {
+	return equivocation.ArgsSlashingSCForwarder{
+		PrivateKey: &consensusMock.PrivateKeyMock{
+			GeneratePublicMock: func() crypto.PublicKey {
+				return &consensusMock.PublicKeyMock{}
+			},
+		},
+		SingleSigner: &consensusMock.SingleSignerMock{
+			SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+				return []byte("tx signature"), nil
+			},
+		},
+		AddressPubkeyConverter: mock.NewPubkeyConverterMock(len(reporterAddress)),
+		TxSignMarshalizer:      &marshal.JsonMarshalizer{},
+		Accounts: &mock.AccountsStub{
+			GetExistingAccountCalled: func(addressContainer []byte) (state.AccountHandler, error) {
+				account, _ := state.NewUserAccount(addressContainer)
+				account.IncreaseNonce(5)
+				return account, nil
+			},
+		},
+		ChainID:      []byte("chain ID"),
+		MinTxVersion: 1,
+		GasPrice:     1000000000,
+		GasLimit:     50000000,
+	}
+}
+
+func createVerifiableProof() *equivocation.Proof {
+	return &equivocation.Proof{
+		Type:            equivocation.SignatureEquivocation,
+		PublicKey:       []byte("validator"),
+		ShardID:         1,
+		Round:           10,
+		FirstHeader:     []byte("header1"),
+		FirstSignature:  []byte("sig1"),
+		SecondHeader:    []byte("header2"),
+		SecondSignature: []byte("sig2"),
+	}
+}
+
+func TestNewSlashingSCForwarder_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashingSCForwarder()
+	args.PrivateKey = nil
+	f, err := equivocation.NewSlashingSCForwarder(args)
+	assert.True(t, check.IfNil(f))
+	assert.Equal(t, equivocation.ErrNilPrivateKey, err)
+
+	args = createMockArgsSlashingSCForwarder()
+	args.SingleSigner = nil
+	f, err = equivocation.NewSlashingSCForwarder(args)
+	assert.True(t, check.IfNil(f))
+	assert.Equal(t, equivocation.ErrNilSingleSigner, err)
+
+	args = createMockArgsSlashingSCForwarder()
+	args.AddressPubkeyConverter = nil
+	f, err = equivocation.NewSlashingSCForwarder(args)
+	assert.True(t, check.IfNil(f))
+	assert.Equal(t, equivocation.ErrNilPubkeyConverter, err)
+
+	args = createMockArgsSlashingSCForwarder()
+	args.TxSignMarshalizer = nil
+	f, err = equivocation.NewSlashingSCForwarder(args)
+	assert.True(t, check.IfNil(f))
+	assert.Equal(t, equivocation.ErrNilMarshalizer, err)
+
+	args = createMockArgsSlashingSCForwarder()
+	args.Accounts = nil
+	f, err = equivocation.NewSlashingSCForwarder(args)
+	assert.True(t, check.IfNil(f))
+	assert.Equal(t, equivocation.ErrNilAccountsAdapter, err)
+
+	args = createMockArgsSlashingSCForwarder()
+	args.ChainID = nil
+	f, err = equivocation.NewSlashingSCForwarder(args)
+	assert.True(t, check.IfNil(f))
+	assert.Equal(t, equivocation.ErrInvalidChainID, err)
+
+	args = createMockArgsSlashingSCForwarder()
+	args.GasLimit = 0
+	f, err = equivocation.NewSlashingSCForwarder(args)
+	assert.True(t, check.IfNil(f))
+	assert.Equal(t, equivocation.ErrInvalidGasLimit, err)
+}
+
+func TestSlashingSCForwarder_SetTransactionSenderNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	f, _ := equivocation.NewSlashingSCForwarder(createMockArgsSlashingSCForwarder())
+	err := f.SetTransactionSender(nil)
+	assert.Equal(t, equivocation.ErrNilTransactionSender, err)
+}
+
+func TestSlashingSCForwarder_ForwardProofShouldErrIfNotPossible(t *testing.T) {
+	t.Parallel()
+
+	f, _ := equivocation.NewSlashingSCForwarder(createMockArgsSlashingSCForwarder())
+	proof := createVerifiableProof()
+	proof.SecondSignature = nil
+	assert.Equal(t, equivocation.ErrProofNotVerifiable, f.ForwardProof(proof))
+
+	assert.Equal(t, equivocation.ErrNilTransactionSender, f.ForwardProof(createVerifiableProof()))
+
+	expectedErr := errors.New("expected error")
+	_ = f.SetTransactionSender(&mock.TransactionSenderStub{
+		SendBulkTransactionsCalled: func(txs []*transaction.Transaction) (uint64, error) {
+			return 0, expectedErr
+		},
+	})
+	assert.Equal(t, expectedErr, f.ForwardProof(createVerifiableProof()))
+}
+
+func TestSlashingSCForwarder_ForwardProofShouldSendTransactionsWithIncreasingNonces(t *testing.T) {
+	t.Parallel()
+
+	f, _ := equivocation.NewSlashingSCForwarder(createMockArgsSlashingSCForwarder())
+	sentTxs := make([]*transaction.Transaction, 0)
+	_ = f.SetTransactionSender(&mock.TransactionSenderStub{
+		SendBulkTransactionsCalled: func(txs []*transaction.Transaction) (uint64, error) {
+			sentTxs = append(sentTxs, txs...)
+			return uint64(len(txs)), nil
+		},
+	})
+
+	assert.Nil(t, f.ForwardProof(createVerifiableProof()))
+	assert.Nil(t, f.ForwardProof(createVerifiableProof()))
+
+	require.Equal(t, 2, len(sentTxs))
+	tx := sentTxs[0]
+	assert.Equal(t, uint64(5), tx.Nonce)
+	assert.Equal(t, uint64(6), sentTxs[1].Nonce)
+	assert.Equal(t, vm.SlashingSCAddress, tx.RcvAddr)
+	assert.Equal(t, reporterAddress, tx.SndAddr)
+	assert.Equal(t, []byte("tx signature"), tx.Signature)
+	assert.Equal(t, []byte("chain ID"), tx.ChainID)
+	assert.Equal(t, uint64(50000000), tx.GasLimit)
+
+	expectedData := strings.Join([]string{
+		"submitEquivocationProof",
+		hex.EncodeToString([]byte("validator")),
+		"01",
+		hex.EncodeToString([]byte("header1")),
+		hex.EncodeToString([]byte("sig1")),
+		hex.EncodeToString([]byte("header2")),
+		hex.EncodeToString([]byte("sig2")),
+	}, "@")
+	assert.Equal(t, expectedData, string(tx.Data))
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
@@ -19,6 +20,8 @@ type Rounder interface {
 	UpdateRound(time.Time, time.Time)
 	TimeStamp() time.Time
 	TimeDuration() time.Duration
+	// TimeDurationBetweenRounds returns the time elapsed from the start of the first round until the start of the second one
+	TimeDurationBetweenRounds(fromRound int64, toRound int64) time.Duration
 	RemainingTime(startTime time.Time, maxTime time.Duration) time.Duration
 	IsInterfaceNil() bool
 }
@@ -127,3 +130,81 @@ type FallbackHeaderValidator interface {
 	ShouldApplyFallbackValidation(headerHandler data.HeaderHandler) bool
 	IsInterfaceNil() bool
 }
+
+// PartitionStatusHandler defines the behaviour of a component able to signal if the node should refuse to finalize
+// blocks because a probable network partition was detected
+type PartitionStatusHandler interface {
+	IsInProtectiveMode() bool
+	IsInterfaceNil() bool
+}
+
+// EquivocationDetector defines the behaviour of a component able to detect the conflicting proposals and signatures
+// sent by the same validator in the same round
+type EquivocationDetector interface {
+	ProcessProposal(cnsMsg *Message)
+	ProcessSignature(cnsMsg *Message)
+	IsInterfaceNil() bool
+}
+
+// StateDebugger defines the behaviour of a component able to record the consensus activity of the last rounds so
+// that a stuck consensus can be diagnosed
+type StateDebugger interface {
+	StartRound(round int64, consensusGroup []string, leader string)
+	SetSubround(round int64, subround string)
+	AddReceivedMessage(round int64, pubKey []byte, messageType string)
+	AddSubroundTimeout(round int64, subround string)
+	AddCollectedSignature(round int64, pubKey string)
+	SetBlockCommitted(round int64)
+	IsInterfaceNil() bool
+}
+
+// ParticipationTracker defines the behaviour of a component able to record, for each epoch, the node's own
+// participation in consensus: the rounds spent in the consensus group, the proposals and the signature shares sent
+type ParticipationTracker interface {
+	StartRound(epoch uint32, round int64, isLeader bool, isInConsensusGroup bool)
+	AddProposedBlock(round int64)
+	AddSignatureShareSent(round int64)
+	AddSubroundDuration(subround string, duration time.Duration)
+	GetParticipation() []*EpochParticipation
+	IsInterfaceNil() bool
+}
+
+// ManagedPeersHolder defines the behaviour of a component holding the validator keys operated by the current node,
+// besides its own key
+type ManagedPeersHolder interface {
+	IsKeyManagedByCurrentNode(pkBytes []byte) bool
+	GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error)
+	GetManagedKeysByCurrentNode() map[string]crypto.PrivateKey
+	CreateSignatureShare(pkBytes []byte, message []byte) ([]byte, error)
+	IncrementRoundsInConsensus(pkBytes []byte)
+	IncrementRoundsAsLeader(pkBytes []byte)
+	IncrementSignaturesSent(pkBytes []byte)
+	IsInterfaceNil() bool
+}
+
+// SubroundsTimingHandler defines the behaviour of a component able to adapt the consensus subrounds deadlines to the
+// recently observed block sizes and network latencies
+type SubroundsTimingHandler interface {
+	ComputeProposalDeadline(
+		round int64,
+		roundDuration time.Duration,
+		defaultDeadline time.Duration,
+		signaturesDeadline time.Duration,
+	) time.Duration
+	AddBlockObservation(round int64, blockSize int, receivedAfter time.Duration, processingTime time.Duration)
+	EstimatedLatency() time.Duration
+	IsInterfaceNil() bool
+}
+
+// NodeRedundancyHandler defines the behaviour of a component deciding if the node can act in consensus when a main
+// node and its backups are started with the same validator keys
+type NodeRedundancyHandler interface {
+	IsRedundancyNode() bool
+	IsMainMachineActive(pubKey string) bool
+	IsFenced(pubKey string) bool
+	IsObserving(pubKey string) bool
+	ShouldActInConsensus(pubKey string) bool
+	AdjustInactivityIfNeeded(selfPubKey string, consensusPubKeys []string, roundIndex int64)
+	ResetInactivityIfNeeded(selfPubKey string, consensusMsgPubKey string, consensusMsgPeerID core.PeerID)
+	IsInterfaceNil() bool
+}
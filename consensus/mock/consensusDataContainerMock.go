@@ -34,6 +34,14 @@ type ConsensusCoreMock struct {
 	peerHonestyHandler      consensus.PeerHonestyHandler
 	headerSigVerifier       consensus.HeaderSigVerifier
 	fallbackHeaderValidator consensus.FallbackHeaderValidator
+	partitionStatusHandler  consensus.PartitionStatusHandler
+	subroundsTimingHandler  consensus.SubroundsTimingHandler
+	proposalPreparer        process.ProposalPreparer
+	stateDebugger           consensus.StateDebugger
+	managedPeersHolder      consensus.ManagedPeersHolder
+	nodeRedundancyHandler   consensus.NodeRedundancyHandler
+	participationTracker    consensus.ParticipationTracker
+	signingSlotHandler      crypto.SigningSlotHandler
 }
 
 // GetAntiFloodHandler -
@@ -191,6 +199,11 @@ func (ccm *ConsensusCoreMock) PeerHonestyHandler() consensus.PeerHonestyHandler
 	return ccm.peerHonestyHandler
 }
 
+// SetPeerHonestyHandler -
+func (ccm *ConsensusCoreMock) SetPeerHonestyHandler(peerHonestyHandler consensus.PeerHonestyHandler) {
+	ccm.peerHonestyHandler = peerHonestyHandler
+}
+
 // HeaderSigVerifier -
 func (ccm *ConsensusCoreMock) HeaderSigVerifier() consensus.HeaderSigVerifier {
 	return ccm.headerSigVerifier
@@ -211,6 +224,86 @@ func (ccm *ConsensusCoreMock) SetFallbackHeaderValidator(fallbackHeaderValidator
 	ccm.fallbackHeaderValidator = fallbackHeaderValidator
 }
 
+// PartitionStatusHandler -
+func (ccm *ConsensusCoreMock) PartitionStatusHandler() consensus.PartitionStatusHandler {
+	return ccm.partitionStatusHandler
+}
+
+// SetPartitionStatusHandler -
+func (ccm *ConsensusCoreMock) SetPartitionStatusHandler(partitionStatusHandler consensus.PartitionStatusHandler) {
+	ccm.partitionStatusHandler = partitionStatusHandler
+}
+
+// SubroundsTimingHandler -
+func (ccm *ConsensusCoreMock) SubroundsTimingHandler() consensus.SubroundsTimingHandler {
+	return ccm.subroundsTimingHandler
+}
+
+// SetSubroundsTimingHandler -
+func (ccm *ConsensusCoreMock) SetSubroundsTimingHandler(subroundsTimingHandler consensus.SubroundsTimingHandler) {
+	ccm.subroundsTimingHandler = subroundsTimingHandler
+}
+
+// ProposalPreparer -
+func (ccm *ConsensusCoreMock) ProposalPreparer() process.ProposalPreparer {
+	return ccm.proposalPreparer
+}
+
+// SetProposalPreparer -
+func (ccm *ConsensusCoreMock) SetProposalPreparer(proposalPreparer process.ProposalPreparer) {
+	ccm.proposalPreparer = proposalPreparer
+}
+
+// StateDebugger -
+func (ccm *ConsensusCoreMock) StateDebugger() consensus.StateDebugger {
+	return ccm.stateDebugger
+}
+
+// SetStateDebugger -
+func (ccm *ConsensusCoreMock) SetStateDebugger(stateDebugger consensus.StateDebugger) {
+	ccm.stateDebugger = stateDebugger
+}
+
+// ManagedPeersHolder -
+func (ccm *ConsensusCoreMock) ManagedPeersHolder() consensus.ManagedPeersHolder {
+	return ccm.managedPeersHolder
+}
+
+// SetManagedPeersHolder -
+func (ccm *ConsensusCoreMock) SetManagedPeersHolder(managedPeersHolder consensus.ManagedPeersHolder) {
+	ccm.managedPeersHolder = managedPeersHolder
+}
+
+// NodeRedundancyHandler -
+func (ccm *ConsensusCoreMock) NodeRedundancyHandler() consensus.NodeRedundancyHandler {
+	return ccm.nodeRedundancyHandler
+}
+
+// SetNodeRedundancyHandler -
+func (ccm *ConsensusCoreMock) SetNodeRedundancyHandler(nodeRedundancyHandler consensus.NodeRedundancyHandler) {
+	ccm.nodeRedundancyHandler = nodeRedundancyHandler
+}
+
+// ParticipationTracker -
+func (ccm *ConsensusCoreMock) ParticipationTracker() consensus.ParticipationTracker {
+	return ccm.participationTracker
+}
+
+// SetParticipationTracker -
+func (ccm *ConsensusCoreMock) SetParticipationTracker(participationTracker consensus.ParticipationTracker) {
+	ccm.participationTracker = participationTracker
+}
+
+// SigningSlotHandler -
+func (ccm *ConsensusCoreMock) SigningSlotHandler() crypto.SigningSlotHandler {
+	return ccm.signingSlotHandler
+}
+
+// SetSigningSlotHandler -
+func (ccm *ConsensusCoreMock) SetSigningSlotHandler(signingSlotHandler crypto.SigningSlotHandler) {
+	ccm.signingSlotHandler = signingSlotHandler
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ccm *ConsensusCoreMock) IsInterfaceNil() bool {
 	return ccm == nil
@@ -20,6 +20,8 @@ type HeaderHandlerStub struct {
 	GetChainIDCalled                       func() []byte
 	CheckChainIDCalled                     func(reference []byte) error
 	GetReservedCalled                      func() []byte
+	GetShardIDCalled                       func() uint32
+	GetRoundCalled                         func() uint64
 }
 
 // GetAccumulatedFees -
@@ -61,6 +63,10 @@ func (hhs *HeaderHandlerStub) Clone() data.HeaderHandler {
 
 // GetShardID -
 func (hhs *HeaderHandlerStub) GetShardID() uint32 {
+	if hhs.GetShardIDCalled != nil {
+		return hhs.GetShardIDCalled()
+	}
+
 	return 1
 }
 
@@ -76,6 +82,10 @@ func (hhs *HeaderHandlerStub) GetEpoch() uint32 {
 
 // GetRound -
 func (hhs *HeaderHandlerStub) GetRound() uint64 {
+	if hhs.GetRoundCalled != nil {
+		return hhs.GetRoundCalled()
+	}
+
 	return 1
 }
 
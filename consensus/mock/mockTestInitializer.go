@@ -134,6 +134,14 @@ func InitConsensusCore() *ConsensusCoreMock {
 	peerHonestyHandler := &testscommon.PeerHonestyHandlerStub{}
 	headerSigVerifier := &HeaderSigVerifierStub{}
 	fallbackHeaderValidator := &testscommon.FallBackHeaderValidatorStub{}
+	partitionStatusHandler := &testscommon.PartitionStatusHandlerStub{}
+	subroundsTimingHandler := &testscommon.SubroundsTimingHandlerStub{}
+	proposalPreparer := &testscommon.ProposalPreparerStub{}
+	stateDebugger := &testscommon.StateDebuggerStub{}
+	managedPeersHolder := &testscommon.ManagedPeersHolderStub{}
+	nodeRedundancyHandler := &testscommon.NodeRedundancyHandlerStub{}
+	participationTracker := &testscommon.ParticipationTrackerStub{}
+	signingSlotHandler := &testscommon.SigningSlotHandlerStub{}
 
 	container := &ConsensusCoreMock{
 		blockChain:              blockChain,
@@ -156,6 +164,14 @@ func InitConsensusCore() *ConsensusCoreMock {
 		peerHonestyHandler:      peerHonestyHandler,
 		headerSigVerifier:       headerSigVerifier,
 		fallbackHeaderValidator: fallbackHeaderValidator,
+		partitionStatusHandler:  partitionStatusHandler,
+		subroundsTimingHandler:  subroundsTimingHandler,
+		proposalPreparer:        proposalPreparer,
+		stateDebugger:           stateDebugger,
+		managedPeersHolder:      managedPeersHolder,
+		nodeRedundancyHandler:   nodeRedundancyHandler,
+		participationTracker:    participationTracker,
+		signingSlotHandler:      signingSlotHandler,
 	}
 
 	return container
@@ -134,6 +134,11 @@ func (ncm *NodesCoordinatorMock) GetConsensusWhitelistedNodes(
 	panic("not implemented")
 }
 
+// GetEpochValidatorsAssignment -
+func (ncm *NodesCoordinatorMock) GetEpochValidatorsAssignment(_ uint32) (*sharding.EpochValidatorsAssignment, error) {
+	return nil, nil
+}
+
 // ComputeAdditionalLeaving -
 func (ncm *NodesCoordinatorMock) ComputeAdditionalLeaving([]*state.ShardValidatorInfo) (map[uint32][]sharding.Validator, error) {
 	return make(map[uint32][]sharding.Validator), nil
@@ -0,0 +1,12 @@
+package consensus
+
+// EpochParticipation holds the node's own participation in consensus during an epoch
+type EpochParticipation struct {
+	Epoch                        uint32            `json:"epoch"`
+	NumRoundsInConsensusGroup    uint64            `json:"numRoundsInConsensusGroup"`
+	NumRoundsAsLeader            uint64            `json:"numRoundsAsLeader"`
+	NumProposedBlocks            uint64            `json:"numProposedBlocks"`
+	NumMissedProposals           uint64            `json:"numMissedProposals"`
+	NumSignatureSharesSent       uint64            `json:"numSignatureSharesSent"`
+	AverageSubroundDurationsInMs map[string]uint64 `json:"averageSubroundDurationsInMs"`
+}
@@ -0,0 +1,9 @@
+package participation
+
+import "errors"
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrInvalidNumEpochsToKeep signals that an invalid number of epochs to keep has been provided
+var ErrInvalidNumEpochsToKeep = errors.New("invalid number of epochs to keep")
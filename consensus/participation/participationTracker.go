@@ -0,0 +1,237 @@
+package participation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+var _ consensus.ParticipationTracker = (*participationTracker)(nil)
+
+// ArgsParticipationTracker is the DTO used to create a new instance of participationTracker
+type ArgsParticipationTracker struct {
+	AppStatusHandler core.AppStatusHandler
+	NumEpochsToKeep  uint32
+}
+
+type subroundDurations struct {
+	total time.Duration
+	num   uint64
+}
+
+type epochParticipation struct {
+	numRoundsInConsensusGroup uint64
+	numRoundsAsLeader         uint64
+	numProposedBlocks         uint64
+	numSignatureSharesSent    uint64
+	subrounds                 map[string]*subroundDurations
+	subroundsOrder            []string
+}
+
+// participationTracker records, for each of the last epochs, the node's own participation in consensus: the rounds in
+// which it was in the consensus group or leader, the blocks it proposed, the signature shares it sent and the average
+// time spent in each subround. The values of the current epoch are also exported as metrics
+type participationTracker struct {
+	appStatusHandler core.AppStatusHandler
+	numEpochsToKeep  uint32
+
+	mutData      sync.RWMutex
+	epochs       map[uint32]*epochParticipation
+	currentEpoch uint32
+	currentRound int64
+}
+
+// NewParticipationTracker creates a new participation tracker
+func NewParticipationTracker(args ArgsParticipationTracker) (*participationTracker, error) {
+	if check.IfNil(args.AppStatusHandler) {
+		return nil, ErrNilAppStatusHandler
+	}
+	if args.NumEpochsToKeep == 0 {
+		return nil, ErrInvalidNumEpochsToKeep
+	}
+
+	return &participationTracker{
+		appStatusHandler: args.AppStatusHandler,
+		numEpochsToKeep:  args.NumEpochsToKeep,
+		epochs:           make(map[uint32]*epochParticipation),
+		currentRound:     -1,
+	}, nil
+}
+
+// StartRound records the start of a new round in the provided epoch. A round is counted only once
+func (pt *participationTracker) StartRound(epoch uint32, round int64, isLeader bool, isInConsensusGroup bool) {
+	pt.mutData.Lock()
+	defer pt.mutData.Unlock()
+
+	if round <= pt.currentRound {
+		return
+	}
+	pt.currentRound = round
+	pt.currentEpoch = epoch
+
+	ep := pt.getOrCreateEpochParticipation(epoch)
+	if isInConsensusGroup {
+		ep.numRoundsInConsensusGroup++
+	}
+	if isLeader {
+		ep.numRoundsAsLeader++
+	}
+
+	pt.updateMetrics(ep)
+}
+
+// AddProposedBlock records that the node proposed a block in the provided round
+func (pt *participationTracker) AddProposedBlock(round int64) {
+	pt.mutData.Lock()
+	defer pt.mutData.Unlock()
+
+	if round != pt.currentRound {
+		return
+	}
+
+	ep := pt.getOrCreateEpochParticipation(pt.currentEpoch)
+	ep.numProposedBlocks++
+
+	pt.updateMetrics(ep)
+}
+
+// AddSignatureShareSent records that the node sent a signature share in the provided round
+func (pt *participationTracker) AddSignatureShareSent(round int64) {
+	pt.mutData.Lock()
+	defer pt.mutData.Unlock()
+
+	if round != pt.currentRound {
+		return
+	}
+
+	ep := pt.getOrCreateEpochParticipation(pt.currentEpoch)
+	ep.numSignatureSharesSent++
+
+	pt.updateMetrics(ep)
+}
+
+// AddSubroundDuration records the time spent by the node in the provided subround of the current round
+func (pt *participationTracker) AddSubroundDuration(subround string, duration time.Duration) {
+	pt.mutData.Lock()
+	defer pt.mutData.Unlock()
+
+	if pt.currentRound < 0 {
+		return
+	}
+
+	ep := pt.getOrCreateEpochParticipation(pt.currentEpoch)
+	sd, found := ep.subrounds[subround]
+	if !found {
+		sd = &subroundDurations{}
+		ep.subrounds[subround] = sd
+		ep.subroundsOrder = append(ep.subroundsOrder, subround)
+	}
+	sd.total += duration
+	sd.num++
+
+	pt.updateMetrics(ep)
+}
+
+// getOrCreateEpochParticipation returns the participation of the provided epoch, removing the epochs that became too
+// old. Should be called under mutex protection
+func (pt *participationTracker) getOrCreateEpochParticipation(epoch uint32) *epochParticipation {
+	ep, found := pt.epochs[epoch]
+	if found {
+		return ep
+	}
+
+	ep = &epochParticipation{
+		subrounds:      make(map[string]*subroundDurations),
+		subroundsOrder: make([]string, 0),
+	}
+	pt.epochs[epoch] = ep
+
+	for e := range pt.epochs {
+		if e+pt.numEpochsToKeep <= epoch {
+			delete(pt.epochs, e)
+		}
+	}
+
+	return ep
+}
+
+// updateMetrics exports the participation of the current epoch. Should be called under mutex protection
+func (pt *participationTracker) updateMetrics(ep *epochParticipation) {
+	pt.appStatusHandler.SetUInt64Value(core.MetricEpochCountConsensus, ep.numRoundsInConsensusGroup)
+	pt.appStatusHandler.SetUInt64Value(core.MetricEpochCountLeader, ep.numRoundsAsLeader)
+	pt.appStatusHandler.SetUInt64Value(core.MetricEpochCountProposedBlocks, ep.numProposedBlocks)
+	pt.appStatusHandler.SetUInt64Value(core.MetricEpochCountMissedProposals, ep.numMissedProposals())
+	pt.appStatusHandler.SetUInt64Value(core.MetricEpochCountSignatureSharesSent, ep.numSignatureSharesSent)
+	pt.appStatusHandler.SetStringValue(core.MetricEpochAverageSubroundDurations, ep.averageSubroundDurationsString())
+}
+
+// GetParticipation returns the participation of the node in each of the kept epochs, in ascending order of the epochs
+func (pt *participationTracker) GetParticipation() []*consensus.EpochParticipation {
+	pt.mutData.RLock()
+	defer pt.mutData.RUnlock()
+
+	participation := make([]*consensus.EpochParticipation, 0, len(pt.epochs))
+	for epoch, ep := range pt.epochs {
+		participation = append(participation, ep.toEpochParticipation(epoch))
+	}
+
+	sort.Slice(participation, func(i, j int) bool {
+		return participation[i].Epoch < participation[j].Epoch
+	})
+
+	return participation
+}
+
+func (ep *epochParticipation) numMissedProposals() uint64 {
+	if ep.numProposedBlocks >= ep.numRoundsAsLeader {
+		return 0
+	}
+
+	return ep.numRoundsAsLeader - ep.numProposedBlocks
+}
+
+func (ep *epochParticipation) averageSubroundDuration(subround string) time.Duration {
+	sd := ep.subrounds[subround]
+	if sd.num == 0 {
+		return 0
+	}
+
+	return sd.total / time.Duration(sd.num)
+}
+
+func (ep *epochParticipation) averageSubroundDurationsString() string {
+	durations := make([]string, 0, len(ep.subroundsOrder))
+	for _, subround := range ep.subroundsOrder {
+		durations = append(durations, fmt.Sprintf("%s: %v", subround, ep.averageSubroundDuration(subround)))
+	}
+
+	return strings.Join(durations, ", ")
+}
+
+func (ep *epochParticipation) toEpochParticipation(epoch uint32) *consensus.EpochParticipation {
+	averageDurations := make(map[string]uint64, len(ep.subrounds))
+	for subround := range ep.subrounds {
+		averageDurations[subround] = uint64(ep.averageSubroundDuration(subround) / time.Millisecond)
+	}
+
+	return &consensus.EpochParticipation{
+		Epoch:                        epoch,
+		NumRoundsInConsensusGroup:    ep.numRoundsInConsensusGroup,
+		NumRoundsAsLeader:            ep.numRoundsAsLeader,
+		NumProposedBlocks:            ep.numProposedBlocks,
+		NumMissedProposals:           ep.numMissedProposals(),
+		NumSignatureSharesSent:       ep.numSignatureSharesSent,
+		AverageSubroundDurationsInMs: averageDurations,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pt *participationTracker) IsInterfaceNil() bool {
+	return pt == nil
+}
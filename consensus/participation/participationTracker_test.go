@@ -0,0 +1,152 @@
+package participation_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/participation"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const startRoundSubround = "(START_ROUND)"
+const blockSubround = "(BLOCK)"
+
+type metricsHolder struct {
+	mut    sync.Mutex
+	values map[string]interface{}
+}
+
+func (mh *metricsHolder) get(key string) interface{} {
+	mh.mut.Lock()
+	defer mh.mut.Unlock()
+
+	return mh.values[key]
+}
+
+func createAppStatusHandler() (*mock.AppStatusHandlerStub, *metricsHolder) {
+	holder := &metricsHolder{
+		values: make(map[string]interface{}),
+	}
+	set := func(key string, value interface{}) {
+		holder.mut.Lock()
+		holder.values[key] = value
+		holder.mut.Unlock()
+	}
+
+	appStatusHandler := &mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			set(key, value)
+		},
+		SetStringValueHandler: func(key string, value string) {
+			set(key, value)
+		},
+	}
+
+	return appStatusHandler, holder
+}
+
+func createMockArgsParticipationTracker() participation.ArgsParticipationTracker {
+	appStatusHandler, _ := createAppStatusHandler()
+
+	return participation.ArgsParticipationTracker{
+		AppStatusHandler: appStatusHandler,
+		NumEpochsToKeep:  2,
+	}
+}
+
+func TestNewParticipationTracker_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsParticipationTracker()
+	args.AppStatusHandler = nil
+	pt, err := participation.NewParticipationTracker(args)
+	assert.True(t, check.IfNil(pt))
+	assert.Equal(t, participation.ErrNilAppStatusHandler, err)
+
+	args = createMockArgsParticipationTracker()
+	args.NumEpochsToKeep = 0
+	pt, err = participation.NewParticipationTracker(args)
+	assert.True(t, check.IfNil(pt))
+	assert.Equal(t, participation.ErrInvalidNumEpochsToKeep, err)
+}
+
+func TestNewParticipationTracker_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pt, err := participation.NewParticipationTracker(createMockArgsParticipationTracker())
+	assert.False(t, check.IfNil(pt))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(pt.GetParticipation()))
+}
+
+func TestParticipationTracker_ShouldRecordTheParticipationAndExportTheMetrics(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsParticipationTracker()
+	appStatusHandler, metrics := createAppStatusHandler()
+	args.AppStatusHandler = appStatusHandler
+	pt, _ := participation.NewParticipationTracker(args)
+
+	pt.StartRound(1, 10, true, true)
+	pt.AddSubroundDuration(startRoundSubround, 10*time.Millisecond)
+	pt.AddProposedBlock(10)
+	pt.AddSubroundDuration(blockSubround, 100*time.Millisecond)
+
+	pt.StartRound(1, 11, false, true)
+	pt.AddSubroundDuration(startRoundSubround, 30*time.Millisecond)
+	pt.AddSignatureShareSent(11)
+	pt.AddProposedBlock(10)
+
+	pt.StartRound(1, 12, true, true)
+	pt.StartRound(1, 12, true, true)
+	pt.StartRound(1, 13, false, false)
+
+	result := pt.GetParticipation()
+	require.Equal(t, 1, len(result))
+	assert.Equal(t, uint32(1), result[0].Epoch)
+	assert.Equal(t, uint64(3), result[0].NumRoundsInConsensusGroup)
+	assert.Equal(t, uint64(2), result[0].NumRoundsAsLeader)
+	assert.Equal(t, uint64(1), result[0].NumProposedBlocks)
+	assert.Equal(t, uint64(1), result[0].NumMissedProposals)
+	assert.Equal(t, uint64(1), result[0].NumSignatureSharesSent)
+	assert.Equal(t, uint64(20), result[0].AverageSubroundDurationsInMs[startRoundSubround])
+	assert.Equal(t, uint64(100), result[0].AverageSubroundDurationsInMs[blockSubround])
+
+	assert.Equal(t, uint64(3), metrics.get(core.MetricEpochCountConsensus))
+	assert.Equal(t, uint64(2), metrics.get(core.MetricEpochCountLeader))
+	assert.Equal(t, uint64(1), metrics.get(core.MetricEpochCountProposedBlocks))
+	assert.Equal(t, uint64(1), metrics.get(core.MetricEpochCountMissedProposals))
+	assert.Equal(t, uint64(1), metrics.get(core.MetricEpochCountSignatureSharesSent))
+	assert.Equal(t, "(START_ROUND): 20ms, (BLOCK): 100ms", metrics.get(core.MetricEpochAverageSubroundDurations))
+}
+
+func TestParticipationTracker_NewEpochShouldResetTheMetricsAndKeepTheLastEpochs(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsParticipationTracker()
+	appStatusHandler, metrics := createAppStatusHandler()
+	args.AppStatusHandler = appStatusHandler
+	pt, _ := participation.NewParticipationTracker(args)
+
+	pt.StartRound(1, 10, false, true)
+	pt.AddSignatureShareSent(10)
+	pt.StartRound(2, 20, false, true)
+	pt.StartRound(2, 21, false, true)
+	pt.StartRound(3, 30, true, true)
+
+	assert.Equal(t, uint64(1), metrics.get(core.MetricEpochCountConsensus))
+	assert.Equal(t, uint64(1), metrics.get(core.MetricEpochCountLeader))
+	assert.Equal(t, uint64(0), metrics.get(core.MetricEpochCountSignatureSharesSent))
+
+	result := pt.GetParticipation()
+	require.Equal(t, 2, len(result))
+	assert.Equal(t, uint32(2), result[0].Epoch)
+	assert.Equal(t, uint64(2), result[0].NumRoundsInConsensusGroup)
+	assert.Equal(t, uint32(3), result[1].Epoch)
+	assert.Equal(t, uint64(1), result[1].NumMissedProposals)
+}
@@ -0,0 +1,24 @@
+package redundancy
+
+import "errors"
+
+// ErrInvalidRedundancyLevel signals that an invalid redundancy level has been provided
+var ErrInvalidRedundancyLevel = errors.New("invalid redundancy level")
+
+// ErrInvalidMaxRoundsOfInactivity signals that an invalid maximum number of rounds of inactivity has been provided
+var ErrInvalidMaxRoundsOfInactivity = errors.New("invalid maximum number of rounds of inactivity")
+
+// ErrNilMessenger signals that a nil messenger has been provided
+var ErrNilMessenger = errors.New("nil messenger")
+
+// ErrNilManagedPeersHolder signals that a nil managed peers holder has been provided
+var ErrNilManagedPeersHolder = errors.New("nil managed peers holder")
+
+// ErrInvalidFenceFile signals that the fence file can not be parsed
+var ErrInvalidFenceFile = errors.New("invalid fence file")
+
+// ErrFenceFileNotWritable signals that the fence file can not be written
+var ErrFenceFileNotWritable = errors.New("fence file not writable")
+
+// ErrEmptyFenceFilePath signals that an empty fence file path has been provided
+var ErrEmptyFenceFilePath = errors.New("empty fence file path")
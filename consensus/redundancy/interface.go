@@ -0,0 +1,15 @@
+package redundancy
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// P2PMessenger defines a subset of the p2p.Messenger interface
+type P2PMessenger interface {
+	ID() core.PeerID
+	IsInterfaceNil() bool
+}
+
+// ManagedPeersHolder defines a subset of the managed peers holder, able to tell if a key is managed by the current node
+type ManagedPeersHolder interface {
+	IsKeyManagedByCurrentNode(pkBytes []byte) bool
+	IsInterfaceNil() bool
+}
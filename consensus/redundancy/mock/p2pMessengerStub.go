@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// P2PMessengerStub -
+type P2PMessengerStub struct {
+	IDCalled func() core.PeerID
+}
+
+// ID -
+func (stub *P2PMessengerStub) ID() core.PeerID {
+	if stub.IDCalled != nil {
+		return stub.IDCalled()
+	}
+
+	return ""
+}
+
+// IsInterfaceNil -
+func (stub *P2PMessengerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,466 @@
+package redundancy
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+var _ consensus.NodeRedundancyHandler = (*nodeRedundancy)(nil)
+
+var log = logger.GetOrCreate("consensus/redundancy")
+
+const fenceFilePermissions = 0644
+
+// ArgsNodeRedundancy is the DTO used to create a new instance of nodeRedundancy
+type ArgsNodeRedundancy struct {
+	RedundancyLevel       int64
+	MaxRoundsOfInactivity uint64
+	Messenger             P2PMessenger
+	ManagedPeersHolder    ManagedPeersHolder
+	FenceFilePath         string
+}
+
+// keyRedundancy holds the redundancy state of one of the validator keys operated by the node
+type keyRedundancy struct {
+	roundsOfInactivity  uint64
+	hasTakenOver        bool
+	roundsOfObservation uint64
+	isObserving         bool
+	isFenced            bool
+	isFencePersisted    bool
+	fenceReason         string
+}
+
+// nodeRedundancy decides, for each validator key operated by the node, its own key and its managed keys, if the node
+// can act in consensus with that key when several nodes are started with the same validator keys.
+// A backup node (redundancy level N > 0) counts, for each key, the rounds in which the key was in the consensus group
+// without any consensus message from another peer using the key and takes over the signing with that key after N
+// multiplied by the maximum accepted rounds of inactivity. The main node (redundancy level 0) does not sign with a key
+// after a startup or after a gap in the observed rounds until it observes, for more than the maximum accepted rounds
+// of inactivity, the key in the consensus group without any consensus message from another peer using the key, so a
+// backup of level 1 that counts the same rounds always takes over first. The main node, or a backup node that took
+// over a key, seeing a consensus message signed with that key by another peer fences the key: it stops signing with it
+// and appends the key to the fence file. The fence file is checked at startup, so the node can only resume signing with
+// the fenced keys after the operator removes it. A fence file that can not be parsed fences all the keys. A backup that
+// took over steps down after a gap in the observed rounds and counts the rounds of inactivity again.
+type nodeRedundancy struct {
+	redundancyLevel       int64
+	maxRoundsOfInactivity uint64
+	messenger             P2PMessenger
+	managedPeersHolder    ManagedPeersHolder
+	fenceFilePath         string
+
+	mutNodeRedundancy   sync.RWMutex
+	lastRoundIndexCheck int64
+	keys                map[string]*keyRedundancy
+	areAllKeysFenced    bool
+}
+
+// NewNodeRedundancy creates a new node redundancy instance
+func NewNodeRedundancy(args ArgsNodeRedundancy) (*nodeRedundancy, error) {
+	if args.RedundancyLevel < 0 {
+		return nil, fmt.Errorf("%w, provided %d", ErrInvalidRedundancyLevel, args.RedundancyLevel)
+	}
+	if args.MaxRoundsOfInactivity == 0 {
+		return nil, ErrInvalidMaxRoundsOfInactivity
+	}
+	if check.IfNil(args.Messenger) {
+		return nil, ErrNilMessenger
+	}
+	if check.IfNil(args.ManagedPeersHolder) {
+		return nil, ErrNilManagedPeersHolder
+	}
+	if len(args.FenceFilePath) == 0 {
+		return nil, ErrEmptyFenceFilePath
+	}
+
+	nr := &nodeRedundancy{
+		redundancyLevel:       args.RedundancyLevel,
+		maxRoundsOfInactivity: args.MaxRoundsOfInactivity,
+		messenger:             args.Messenger,
+		managedPeersHolder:    args.ManagedPeersHolder,
+		fenceFilePath:         args.FenceFilePath,
+		lastRoundIndexCheck:   -1,
+		keys:                  make(map[string]*keyRedundancy),
+	}
+
+	err := checkFenceFileDirectory(args.FenceFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("%w, fence file %s: %s", ErrFenceFileNotWritable, args.FenceFilePath, err.Error())
+	}
+
+	nr.loadFencedKeys()
+
+	return nr, nil
+}
+
+// checkFenceFileDirectory creates the directory of the fence file and checks that files can be written in it, so the
+// fence can be persisted when needed
+func checkFenceFileDirectory(fenceFilePath string) error {
+	dir := filepath.Dir(fenceFilePath)
+	err := os.MkdirAll(dir, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	file, err := ioutil.TempFile(dir, filepath.Base(fenceFilePath))
+	if err != nil {
+		return err
+	}
+
+	_ = file.Close()
+
+	return os.Remove(file.Name())
+}
+
+// loadFencedKeys reads the keys from the fence file, one hex encoded key at the beginning of each line. All the keys
+// are fenced if the file can not be read or parsed
+func (nr *nodeRedundancy) loadFencedKeys() {
+	if !core.DoesFileExist(nr.fenceFilePath) {
+		return
+	}
+
+	fencedKeys, err := readFencedKeys(nr.fenceFilePath)
+	if err != nil {
+		log.Error("all the validator keys are fenced and the node will not sign until the fence file is removed",
+			"fence file", nr.fenceFilePath,
+			"error", err.Error())
+		nr.areAllKeysFenced = true
+		return
+	}
+
+	for _, pubKey := range fencedKeys {
+		log.Error("the validator key is fenced and the node will not sign with it until the fence file is removed",
+			"pk", trimmedPubKey(pubKey),
+			"fence file", nr.fenceFilePath)
+
+		key := nr.newKeyRedundancy()
+		key.isFenced = true
+		key.isFencePersisted = true
+		nr.keys[pubKey] = key
+	}
+}
+
+func readFencedKeys(fenceFilePath string) ([]string, error) {
+	content, err := ioutil.ReadFile(fenceFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	fencedKeys := make([]string, 0)
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		pubKey, errDecode := hex.DecodeString(fields[0])
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w, line: %s", ErrInvalidFenceFile, line)
+		}
+
+		fencedKeys = append(fencedKeys, string(pubKey))
+	}
+	if len(fencedKeys) == 0 {
+		return nil, fmt.Errorf("%w, no fenced key", ErrInvalidFenceFile)
+	}
+
+	return fencedKeys, nil
+}
+
+func (nr *nodeRedundancy) newKeyRedundancy() *keyRedundancy {
+	return &keyRedundancy{
+		isObserving: !nr.IsRedundancyNode(),
+	}
+}
+
+// getKey returns the redundancy state of the provided key, or the initial state if the key was not seen yet
+func (nr *nodeRedundancy) getKey(pubKey string) *keyRedundancy {
+	key, ok := nr.keys[pubKey]
+	if !ok {
+		return nr.newKeyRedundancy()
+	}
+
+	return key
+}
+
+func (nr *nodeRedundancy) getOrCreateKey(pubKey string) *keyRedundancy {
+	key, ok := nr.keys[pubKey]
+	if !ok {
+		key = nr.newKeyRedundancy()
+		nr.keys[pubKey] = key
+	}
+
+	return key
+}
+
+// IsRedundancyNode returns true if the current node is a backup node
+func (nr *nodeRedundancy) IsRedundancyNode() bool {
+	return nr.redundancyLevel > 0
+}
+
+// IsMainMachineActive returns true if the signing with the provided key is done by another node. Always false for the
+// main node
+func (nr *nodeRedundancy) IsMainMachineActive(pubKey string) bool {
+	if !nr.IsRedundancyNode() {
+		return false
+	}
+
+	nr.mutNodeRedundancy.RLock()
+	defer nr.mutNodeRedundancy.RUnlock()
+
+	return !nr.getKey(pubKey).hasTakenOver
+}
+
+// IsFenced returns true if the node stopped signing with the provided key because another node signs with it
+func (nr *nodeRedundancy) IsFenced(pubKey string) bool {
+	nr.mutNodeRedundancy.RLock()
+	defer nr.mutNodeRedundancy.RUnlock()
+
+	return nr.areAllKeysFenced || nr.getKey(pubKey).isFenced
+}
+
+// IsObserving returns true if the main node does not sign yet with the provided key as it checks that no other node
+// signs with it
+func (nr *nodeRedundancy) IsObserving(pubKey string) bool {
+	nr.mutNodeRedundancy.RLock()
+	defer nr.mutNodeRedundancy.RUnlock()
+
+	return nr.getKey(pubKey).isObserving
+}
+
+// ShouldActInConsensus returns true if the current node is allowed to sign consensus messages with the provided key
+func (nr *nodeRedundancy) ShouldActInConsensus(pubKey string) bool {
+	return !nr.IsMainMachineActive(pubKey) && !nr.IsFenced(pubKey) && !nr.IsObserving(pubKey)
+}
+
+// AdjustInactivityIfNeeded is called once per round in which the node is synchronized. For each key of the node, its
+// own key and its managed keys, found in the provided consensus group, it increments the rounds of inactivity of the
+// main node, on a backup node, or the rounds of observation, on the main node. A gap in the rounds restarts the
+// observation of all the keys on the main node and makes a backup node that took over keys step down
+func (nr *nodeRedundancy) AdjustInactivityIfNeeded(selfPubKey string, consensusPubKeys []string, roundIndex int64) {
+	nr.mutNodeRedundancy.Lock()
+	defer nr.mutNodeRedundancy.Unlock()
+
+	if roundIndex <= nr.lastRoundIndexCheck {
+		return
+	}
+
+	hasGap := nr.lastRoundIndexCheck >= 0 && roundIndex > nr.lastRoundIndexCheck+1
+	nr.lastRoundIndexCheck = roundIndex
+
+	if nr.areAllKeysFenced {
+		return
+	}
+
+	nr.persistFencesIfNeeded()
+	if hasGap {
+		for pubKey, key := range nr.keys {
+			nr.restartAfterGap(pubKey, key, roundIndex)
+		}
+	}
+
+	for _, pubKey := range consensusPubKeys {
+		if !nr.isOwnKey(selfPubKey, pubKey) {
+			continue
+		}
+
+		key := nr.getOrCreateKey(pubKey)
+		if key.isFenced {
+			continue
+		}
+
+		if nr.IsRedundancyNode() {
+			nr.adjustInactivity(pubKey, key, roundIndex)
+			continue
+		}
+
+		nr.adjustObservation(pubKey, key, roundIndex)
+	}
+}
+
+func (nr *nodeRedundancy) isOwnKey(selfPubKey string, pubKey string) bool {
+	return pubKey == selfPubKey || nr.managedPeersHolder.IsKeyManagedByCurrentNode([]byte(pubKey))
+}
+
+func (nr *nodeRedundancy) restartAfterGap(pubKey string, key *keyRedundancy, roundIndex int64) {
+	if key.isFenced {
+		return
+	}
+
+	if nr.IsRedundancyNode() {
+		if key.hasTakenOver {
+			log.Warn("the backup node steps down after a gap in the observed rounds",
+				"pk", trimmedPubKey(pubKey),
+				"round", roundIndex)
+		}
+
+		key.hasTakenOver = false
+		key.roundsOfInactivity = 0
+		return
+	}
+
+	if !key.isObserving {
+		log.Warn("the main node stops signing after a gap in the observed rounds",
+			"pk", trimmedPubKey(pubKey),
+			"round", roundIndex)
+	}
+
+	key.isObserving = true
+	key.roundsOfObservation = 0
+}
+
+func (nr *nodeRedundancy) adjustInactivity(pubKey string, key *keyRedundancy, roundIndex int64) {
+	if key.hasTakenOver {
+		return
+	}
+
+	key.roundsOfInactivity++
+	maxRoundsOfInactivity := uint64(nr.redundancyLevel) * nr.maxRoundsOfInactivity
+	log.Debug("main node did not act in consensus",
+		"pk", trimmedPubKey(pubKey),
+		"round", roundIndex,
+		"rounds of inactivity", key.roundsOfInactivity,
+		"max rounds of inactivity", maxRoundsOfInactivity,
+	)
+	if key.roundsOfInactivity < maxRoundsOfInactivity {
+		return
+	}
+
+	key.hasTakenOver = true
+	log.Warn("the backup node takes over the signing as the main node is inactive",
+		"pk", trimmedPubKey(pubKey),
+		"round", roundIndex,
+		"rounds of inactivity", key.roundsOfInactivity,
+	)
+}
+
+func (nr *nodeRedundancy) adjustObservation(pubKey string, key *keyRedundancy, roundIndex int64) {
+	if !key.isObserving {
+		return
+	}
+
+	key.roundsOfObservation++
+	log.Debug("main node observes the consensus before signing",
+		"pk", trimmedPubKey(pubKey),
+		"round", roundIndex,
+		"rounds of observation", key.roundsOfObservation,
+		"max rounds of inactivity", nr.maxRoundsOfInactivity,
+	)
+	if key.roundsOfObservation <= nr.maxRoundsOfInactivity {
+		return
+	}
+
+	key.isObserving = false
+	log.Info("the main node starts signing as no other node signs with the validator key",
+		"pk", trimmedPubKey(pubKey),
+		"round", roundIndex)
+}
+
+// ResetInactivityIfNeeded is called for each valid consensus message received. A message signed by another peer with
+// one of the node's keys, its own key or a managed key, resets the rounds of inactivity of that key on a backup node
+// that did not take it over and fences the key on any other node
+func (nr *nodeRedundancy) ResetInactivityIfNeeded(selfPubKey string, consensusMsgPubKey string, consensusMsgPeerID core.PeerID) {
+	if consensusMsgPeerID == nr.messenger.ID() || !nr.isOwnKey(selfPubKey, consensusMsgPubKey) {
+		return
+	}
+
+	nr.mutNodeRedundancy.Lock()
+	defer nr.mutNodeRedundancy.Unlock()
+
+	key := nr.getOrCreateKey(consensusMsgPubKey)
+	if nr.areAllKeysFenced || key.isFenced {
+		return
+	}
+
+	if nr.IsRedundancyNode() && !key.hasTakenOver {
+		key.roundsOfInactivity = 0
+		return
+	}
+
+	key.hasTakenOver = false
+	key.isFenced = true
+	log.Error("the validator key is fenced and the node stops signing with it as another peer signs with it, "+
+		"the signing can be resumed only after the fence file is removed",
+		"pk", trimmedPubKey(consensusMsgPubKey),
+		"is redundancy node", nr.IsRedundancyNode(),
+		"pid", consensusMsgPeerID.Pretty(),
+		"fence file", nr.fenceFilePath,
+	)
+
+	key.fenceReason = fmt.Sprintf("consensus messages signed with the validator key by pid %s", consensusMsgPeerID.Pretty())
+	nr.persistFencesIfNeeded()
+}
+
+// persistFencesIfNeeded appends the fenced keys not yet written to the fence file. A failed write is retried each
+// round while the keys are kept fenced in memory
+func (nr *nodeRedundancy) persistFencesIfNeeded() {
+	content := ""
+	for pubKey, key := range nr.keys {
+		if key.isFenced && !key.isFencePersisted {
+			content += fmt.Sprintf("%s fenced at %s: %s\n",
+				hex.EncodeToString([]byte(pubKey)), time.Now().Format(time.RFC3339), key.fenceReason)
+		}
+	}
+	if len(content) == 0 {
+		return
+	}
+
+	err := nr.appendToFenceFile(content)
+	if err != nil {
+		log.Error("nodeRedundancy: can not write the fence file, retrying next round", "error", err.Error())
+		return
+	}
+
+	for _, key := range nr.keys {
+		if key.isFenced {
+			key.isFencePersisted = true
+		}
+	}
+}
+
+func (nr *nodeRedundancy) appendToFenceFile(content string) error {
+	err := os.MkdirAll(filepath.Dir(nr.fenceFilePath), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(nr.fenceFilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, fenceFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.WriteString(content)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	err = file.Sync()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func trimmedPubKey(pubKey string) string {
+	return core.GetTrimmedPk(hex.EncodeToString([]byte(pubKey)))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nr *nodeRedundancy) IsInterfaceNil() bool {
+	return nr == nil
+}
@@ -0,0 +1,473 @@
+package redundancy
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/redundancy/mock"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+const selfPid = core.PeerID("self pid")
+const otherPid = core.PeerID("other pid")
+const selfPubKey = "self pub key"
+
+func createMockArgsNodeRedundancy(fenceFilePath string) ArgsNodeRedundancy {
+	return ArgsNodeRedundancy{
+		RedundancyLevel:       0,
+		MaxRoundsOfInactivity: 2,
+		Messenger: &mock.P2PMessengerStub{
+			IDCalled: func() core.PeerID {
+				return selfPid
+			},
+		},
+		ManagedPeersHolder: &testscommon.ManagedPeersHolderStub{},
+		FenceFilePath:      fenceFilePath,
+	}
+}
+
+func createTempFenceFilePath(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "redundancy")
+	assert.Nil(t, err)
+
+	return filepath.Join(dir, "fence", "main.fence"), func() {
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func TestNewNodeRedundancy_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsNodeRedundancy("main.fence")
+	args.RedundancyLevel = -1
+	nr, err := NewNodeRedundancy(args)
+	assert.True(t, check.IfNil(nr))
+	assert.True(t, errors.Is(err, ErrInvalidRedundancyLevel))
+
+	args = createMockArgsNodeRedundancy("main.fence")
+	args.MaxRoundsOfInactivity = 0
+	nr, err = NewNodeRedundancy(args)
+	assert.True(t, check.IfNil(nr))
+	assert.Equal(t, ErrInvalidMaxRoundsOfInactivity, err)
+
+	args = createMockArgsNodeRedundancy("main.fence")
+	args.Messenger = nil
+	nr, err = NewNodeRedundancy(args)
+	assert.True(t, check.IfNil(nr))
+	assert.Equal(t, ErrNilMessenger, err)
+
+	args = createMockArgsNodeRedundancy("main.fence")
+	args.ManagedPeersHolder = nil
+	nr, err = NewNodeRedundancy(args)
+	assert.True(t, check.IfNil(nr))
+	assert.Equal(t, ErrNilManagedPeersHolder, err)
+
+	args = createMockArgsNodeRedundancy("")
+	nr, err = NewNodeRedundancy(args)
+	assert.True(t, check.IfNil(nr))
+	assert.Equal(t, ErrEmptyFenceFilePath, err)
+}
+
+func TestNewNodeRedundancy_NotWritableFenceFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fenceFilePath, cleanup := createTempFenceFilePath(t)
+	defer cleanup()
+
+	fileInsteadOfDirectory := filepath.Dir(fenceFilePath)
+	_ = os.MkdirAll(filepath.Dir(fileInsteadOfDirectory), os.ModePerm)
+	_ = ioutil.WriteFile(fileInsteadOfDirectory, []byte("file"), fenceFilePermissions)
+
+	nr, err := NewNodeRedundancy(createMockArgsNodeRedundancy(fenceFilePath))
+	assert.True(t, check.IfNil(nr))
+	assert.True(t, errors.Is(err, ErrFenceFileNotWritable))
+}
+
+func TestNewNodeRedundancy_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	fenceFilePath, cleanup := createTempFenceFilePath(t)
+	defer cleanup()
+
+	nr, err := NewNodeRedundancy(createMockArgsNodeRedundancy(fenceFilePath))
+	assert.False(t, check.IfNil(nr))
+	assert.Nil(t, err)
+	assert.False(t, nr.IsRedundancyNode())
+	assert.False(t, nr.IsMainMachineActive(selfPubKey))
+	assert.False(t, nr.IsFenced(selfPubKey))
+	assert.True(t, nr.IsObserving(selfPubKey))
+	assert.False(t, nr.ShouldActInConsensus(selfPubKey))
+}
+
+func observeRounds(nr *nodeRedundancy, fromRound int64, toRound int64) {
+	for round := fromRound; round <= toRound; round++ {
+		nr.AdjustInactivityIfNeeded(selfPubKey, []string{selfPubKey}, round)
+	}
+}
+
+func TestNodeRedundancy_BackupShouldTakeOverAfterTheMaxRoundsOfInactivity(t *testing.T) {
+	t.Parallel()
+
+	fenceFilePath, cleanup := createTempFenceFilePath(t)
+	defer cleanup()
+
+	args := createMockArgsNodeRedundancy(fenceFilePath)
+	args.RedundancyLevel = 2
+	nr, _ := NewNodeRedundancy(args)
+	consensusGroup := []string{"other pub key", selfPubKey}
+
+	assert.True(t, nr.IsRedundancyNode())
+	assert.False(t, nr.ShouldActInConsensus(selfPubKey))
+
+	nr.AdjustInactivityIfNeeded(selfPubKey, consensusGroup, 1)
+	nr.AdjustInactivityIfNeeded(selfPubKey, consensusGroup, 2)
+	nr.AdjustInactivityIfNeeded(selfPubKey, consensusGroup, 3)
+	// the same round is counted only once while the rounds without the key in the consensus group are not counted
+	nr.AdjustInactivityIfNeeded(selfPubKey, consensusGroup, 3)
+	nr.AdjustInactivityIfNeeded(selfPubKey, []string{"other pub key"}, 4)
+	assert.True(t, nr.IsMainMachineActive(selfPubKey))
+	assert.False(t, nr.ShouldActInConsensus(selfPubKey))
+
+	nr.AdjustInactivityIfNeeded(selfPubKey, consensusGroup, 5)
+	assert.False(t, nr.IsMainMachineActive(selfPubKey))
+	assert.True(t, nr.ShouldActInConsensus(selfPubKey))
+
+	// once taken over, the backup steps down and fences itself if another peer signs with the key
+	nr.ResetInactivityIfNeeded(selfPubKey, selfPubKey, otherPid)
+	assert.True(t, nr.IsMainMachineActive(selfPubKey))
+	assert.True(t, nr.IsFenced(selfPubKey))
+	assert.False(t, nr.ShouldActInConsensus(selfPubKey))
+	assert.True(t, core.DoesFileExist(fenceFilePath))
+
+	nr, _ = NewNodeRedundancy(args)
+	assert.True(t, nr.IsFenced(selfPubKey))
+	observeRounds(nr, 6, 20)
+	assert.False(t, nr.ShouldActInConsensus(selfPubKey))
+}
+
+func TestNodeRedundancy_BackupShouldStepDownAfterAGap(t *testing.T) {
+	t.Parallel()
+
+	fenceFilePath, cleanup := createTempFenceFilePath(t)
+	defer cleanup()
+
+	args := createMockArgsNodeRedundancy(fenceFilePath)
+	args.RedundancyLevel = 1
+	nr, _ := NewNodeRedundancy(args)
+
+	observeRounds(nr, 1, 2)
+	assert.True(t, nr.ShouldActInConsensus(selfPubKey))
+
+	// the backup was not synchronized in the rounds 3 and 4
+	nr.AdjustInactivityIfNeeded(selfPubKey, []string{selfPubKey}, 5)
+	assert.True(t, nr.IsMainMachineActive(selfPubKey))
+	assert.False(t, nr.ShouldActInConsensus(selfPubKey))
+
+	nr.AdjustInactivityIfNeeded(selfPubKey, []string{selfPubKey}, 6)
+	assert.True(t, nr.ShouldActInConsensus(selfPubKey))
+	assert.False(t, core.DoesFileExist(fenceFilePath))
+}
+
+func TestNodeRedundancy_BackupShouldNotTakeOverIfTheMainNodeIsActive(t *testing.T) {
+	t.Parallel()
+
+	fenceFilePath, cleanup := createTempFenceFilePath(t)
+	defer cleanup()
+
+	args := createMockArgsNodeRedundancy(fenceFilePath)
+	args.RedundancyLevel = 1
+	nr, _ := NewNodeRedundancy(args)
+	consensusGroup := []string{selfPubKey}
+
+	nr.AdjustInactivityIfNeeded(selfPubKey, consensusGroup, 1)
+	nr.ResetInactivityIfNeeded(selfPubKey, selfPubKey, otherPid)
+	nr.AdjustInactivityIfNeeded(selfPubKey, consensusGroup, 2)
+	assert.True(t, nr.IsMainMachineActive(selfPubKey))
+
+	// messages from other keys or from itself do not reset the rounds of inactivity
+	nr.ResetInactivityIfNeeded(selfPubKey, "other pub key", otherPid)
+	nr.ResetInactivityIfNeeded(selfPubKey, selfPubKey, selfPid)
+	nr.AdjustInactivityIfNeeded(selfPubKey, consensusGroup, 3)
+	assert.False(t, nr.IsMainMachineActive(selfPubKey))
+}
+
+func TestNodeRedundancy_MainNodeShouldFenceItselfWhenAnotherPeerSigns(t *testing.T) {
+	t.Parallel()
+
+	fenceFilePath, cleanup := createTempFenceFilePath(t)
+	defer cleanup()
+
+	nr, _ := NewNodeRedundancy(createMockArgsNodeRedundancy(fenceFilePath))
+
+	observeRounds(nr, 1, 3)
+	nr.ResetInactivityIfNeeded(selfPubKey, "other pub key", otherPid)
+	nr.ResetInactivityIfNeeded(selfPubKey, selfPubKey, selfPid)
+	assert.True(t, nr.ShouldActInConsensus(selfPubKey))
+	assert.False(t, core.DoesFileExist(fenceFilePath))
+
+	nr.ResetInactivityIfNeeded(selfPubKey, selfPubKey, otherPid)
+	assert.True(t, nr.IsFenced(selfPubKey))
+	assert.False(t, nr.ShouldActInConsensus(selfPubKey))
+	assert.True(t, core.DoesFileExist(fenceFilePath))
+
+	// the fence is kept after a restart, until the operator removes the fence file
+	nr, _ = NewNodeRedundancy(createMockArgsNodeRedundancy(fenceFilePath))
+	assert.True(t, nr.IsFenced(selfPubKey))
+	assert.False(t, nr.ShouldActInConsensus(selfPubKey))
+
+	_ = os.Remove(fenceFilePath)
+	nr, _ = NewNodeRedundancy(createMockArgsNodeRedundancy(fenceFilePath))
+	assert.False(t, nr.IsFenced(selfPubKey))
+	observeRounds(nr, 4, 6)
+	assert.True(t, nr.ShouldActInConsensus(selfPubKey))
+}
+
+func TestNodeRedundancy_MainNodeShouldSignOnlyAfterObservingMoreThanTheMaxRoundsOfInactivity(t *testing.T) {
+	t.Parallel()
+
+	fenceFilePath, cleanup := createTempFenceFilePath(t)
+	defer cleanup()
+
+	nr, _ := NewNodeRedundancy(createMockArgsNodeRedundancy(fenceFilePath))
+
+	// the rounds without the key in the consensus group are not counted
+	nr.AdjustInactivityIfNeeded(selfPubKey, []string{"other pub key"}, 1)
+	observeRounds(nr, 2, 3)
+	assert.True(t, nr.IsObserving(selfPubKey))
+	assert.False(t, nr.ShouldActInConsensus(selfPubKey))
+
+	observeRounds(nr, 4, 4)
+	assert.False(t, nr.IsObserving(selfPubKey))
+	assert.True(t, nr.ShouldActInConsensus(selfPubKey))
+}
+
+func TestNodeRedundancy_MainNodeRestartedAfterTheBackupTookOverShouldFenceItselfWithoutSigning(t *testing.T) {
+	t.Parallel()
+
+	mainFenceFilePath, cleanupMain := createTempFenceFilePath(t)
+	defer cleanupMain()
+	backupFenceFilePath, cleanupBackup := createTempFenceFilePath(t)
+	defer cleanupBackup()
+
+	argsBackup := createMockArgsNodeRedundancy(backupFenceFilePath)
+	argsBackup.RedundancyLevel = 1
+	argsBackup.Messenger = &mock.P2PMessengerStub{
+		IDCalled: func() core.PeerID {
+			return otherPid
+		},
+	}
+	backup, _ := NewNodeRedundancy(argsBackup)
+	main, _ := NewNodeRedundancy(createMockArgsNodeRedundancy(mainFenceFilePath))
+
+	// the main node is down, the backup takes over
+	observeRounds(backup, 1, 2)
+	assert.True(t, backup.ShouldActInConsensus(selfPubKey))
+
+	// the main node is restarted and sees the messages of the backup before it can sign
+	for round := int64(3); round <= 10; round++ {
+		observeRounds(backup, round, round)
+		observeRounds(main, round, round)
+		assert.False(t, main.ShouldActInConsensus(selfPubKey))
+		if backup.ShouldActInConsensus(selfPubKey) {
+			main.ResetInactivityIfNeeded(selfPubKey, selfPubKey, otherPid)
+		}
+	}
+
+	assert.True(t, main.IsFenced(selfPubKey))
+	assert.True(t, core.DoesFileExist(mainFenceFilePath))
+	assert.True(t, backup.ShouldActInConsensus(selfPubKey))
+}
+
+func TestNodeRedundancy_MainNodeRestartedBeforeTheBackupTookOverShouldLetTheBackupTakeOverFirst(t *testing.T) {
+	t.Parallel()
+
+	mainFenceFilePath, cleanupMain := createTempFenceFilePath(t)
+	defer cleanupMain()
+	backupFenceFilePath, cleanupBackup := createTempFenceFilePath(t)
+	defer cleanupBackup()
+
+	argsBackup := createMockArgsNodeRedundancy(backupFenceFilePath)
+	argsBackup.RedundancyLevel = 1
+	argsBackup.Messenger = &mock.P2PMessengerStub{
+		IDCalled: func() core.PeerID {
+			return otherPid
+		},
+	}
+	backup, _ := NewNodeRedundancy(argsBackup)
+	main, _ := NewNodeRedundancy(createMockArgsNodeRedundancy(mainFenceFilePath))
+
+	// both nodes count the same rounds, the main node never signs together with the backup
+	for round := int64(1); round <= 10; round++ {
+		observeRounds(backup, round, round)
+		observeRounds(main, round, round)
+		assert.False(t, main.ShouldActInConsensus(selfPubKey) && backup.ShouldActInConsensus(selfPubKey))
+		if backup.ShouldActInConsensus(selfPubKey) {
+			main.ResetInactivityIfNeeded(selfPubKey, selfPubKey, otherPid)
+		}
+		if main.ShouldActInConsensus(selfPubKey) {
+			backup.ResetInactivityIfNeeded(selfPubKey, selfPubKey, selfPid)
+		}
+	}
+
+	assert.True(t, main.IsFenced(selfPubKey))
+	assert.True(t, backup.ShouldActInConsensus(selfPubKey))
+}
+
+func TestNodeRedundancy_MainNodeShouldStopSigningAfterAPartition(t *testing.T) {
+	t.Parallel()
+
+	fenceFilePath, cleanup := createTempFenceFilePath(t)
+	defer cleanup()
+
+	nr, _ := NewNodeRedundancy(createMockArgsNodeRedundancy(fenceFilePath))
+	observeRounds(nr, 1, 3)
+	assert.True(t, nr.ShouldActInConsensus(selfPubKey))
+
+	// the main node was partitioned, so not synchronized, in the rounds 4 to 9 while the backup took over
+	nr.AdjustInactivityIfNeeded(selfPubKey, []string{selfPubKey}, 10)
+	assert.True(t, nr.IsObserving(selfPubKey))
+	assert.False(t, nr.ShouldActInConsensus(selfPubKey))
+
+	nr.ResetInactivityIfNeeded(selfPubKey, selfPubKey, otherPid)
+	observeRounds(nr, 11, 20)
+	assert.True(t, nr.IsFenced(selfPubKey))
+	assert.False(t, nr.ShouldActInConsensus(selfPubKey))
+	assert.True(t, core.DoesFileExist(fenceFilePath))
+}
+
+func TestNodeRedundancy_FenceShouldBePersistedWhenTheFirstWriteFails(t *testing.T) {
+	t.Parallel()
+
+	fenceFilePath, cleanup := createTempFenceFilePath(t)
+	defer cleanup()
+
+	nr, _ := NewNodeRedundancy(createMockArgsNodeRedundancy(fenceFilePath))
+	observeRounds(nr, 1, 3)
+
+	fenceDirectory := filepath.Dir(fenceFilePath)
+	_ = os.RemoveAll(fenceDirectory)
+	_ = ioutil.WriteFile(fenceDirectory, []byte("file"), fenceFilePermissions)
+
+	nr.ResetInactivityIfNeeded(selfPubKey, selfPubKey, otherPid)
+	assert.True(t, nr.IsFenced(selfPubKey))
+	assert.False(t, nr.ShouldActInConsensus(selfPubKey))
+	assert.False(t, nr.keys[selfPubKey].isFencePersisted)
+
+	_ = os.Remove(fenceDirectory)
+	observeRounds(nr, 4, 4)
+	assert.True(t, nr.IsFenced(selfPubKey))
+	assert.True(t, nr.keys[selfPubKey].isFencePersisted)
+	assert.True(t, core.DoesFileExist(fenceFilePath))
+}
+
+const managedPubKeyA = "managed pub key A"
+const managedPubKeyB = "managed pub key B"
+
+func createMockArgsNodeRedundancyWithManagedKeys(fenceFilePath string) ArgsNodeRedundancy {
+	args := createMockArgsNodeRedundancy(fenceFilePath)
+	args.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			return string(pkBytes) == managedPubKeyA || string(pkBytes) == managedPubKeyB
+		},
+	}
+
+	return args
+}
+
+func TestNodeRedundancy_ManagedKeysShouldBeHandledIndependently(t *testing.T) {
+	t.Parallel()
+
+	fenceFilePath, cleanup := createTempFenceFilePath(t)
+	defer cleanup()
+
+	args := createMockArgsNodeRedundancyWithManagedKeys(fenceFilePath)
+	args.RedundancyLevel = 1
+	nr, _ := NewNodeRedundancy(args)
+
+	// the main node still signs with the managed key A but not with the managed key B
+	consensusGroup := []string{managedPubKeyA, "other pub key", managedPubKeyB}
+	for round := int64(1); round <= 2; round++ {
+		nr.AdjustInactivityIfNeeded(selfPubKey, consensusGroup, round)
+		nr.ResetInactivityIfNeeded(selfPubKey, managedPubKeyA, otherPid)
+	}
+	assert.False(t, nr.ShouldActInConsensus(managedPubKeyA))
+	assert.True(t, nr.IsMainMachineActive(managedPubKeyA))
+	assert.True(t, nr.ShouldActInConsensus(managedPubKeyB))
+	assert.False(t, nr.ShouldActInConsensus(selfPubKey))
+
+	// the main node signs again with the managed key B, only that key is fenced
+	nr.ResetInactivityIfNeeded(selfPubKey, managedPubKeyB, otherPid)
+	assert.True(t, nr.IsFenced(managedPubKeyB))
+	assert.False(t, nr.IsFenced(managedPubKeyA))
+	assert.False(t, nr.IsFenced(selfPubKey))
+	assert.True(t, core.DoesFileExist(fenceFilePath))
+
+	// only the managed key B is fenced after a restart
+	nr, _ = NewNodeRedundancy(args)
+	assert.True(t, nr.IsFenced(managedPubKeyB))
+	assert.False(t, nr.IsFenced(managedPubKeyA))
+	assert.False(t, nr.IsFenced(selfPubKey))
+}
+
+func TestNodeRedundancy_MultiKeyMainAndBackupShouldNeverSignWithTheSameKey(t *testing.T) {
+	t.Parallel()
+
+	mainFenceFilePath, cleanupMain := createTempFenceFilePath(t)
+	defer cleanupMain()
+	backupFenceFilePath, cleanupBackup := createTempFenceFilePath(t)
+	defer cleanupBackup()
+
+	argsBackup := createMockArgsNodeRedundancyWithManagedKeys(backupFenceFilePath)
+	argsBackup.RedundancyLevel = 1
+	argsBackup.Messenger = &mock.P2PMessengerStub{
+		IDCalled: func() core.PeerID {
+			return otherPid
+		},
+	}
+	backup, _ := NewNodeRedundancy(argsBackup)
+	main, _ := NewNodeRedundancy(createMockArgsNodeRedundancyWithManagedKeys(mainFenceFilePath))
+
+	// the keys are alternately in the consensus group, both nodes count the same rounds
+	keys := []string{selfPubKey, managedPubKeyA, managedPubKeyB}
+	for round := int64(1); round <= 20; round++ {
+		consensusGroup := []string{keys[round%3], "other pub key", keys[(round+1)%3]}
+		backup.AdjustInactivityIfNeeded(selfPubKey, consensusGroup, round)
+		main.AdjustInactivityIfNeeded(selfPubKey, consensusGroup, round)
+		for _, pubKey := range consensusGroup {
+			assert.False(t, main.ShouldActInConsensus(pubKey) && backup.ShouldActInConsensus(pubKey))
+			if backup.ShouldActInConsensus(pubKey) {
+				main.ResetInactivityIfNeeded(selfPubKey, pubKey, otherPid)
+			}
+			if main.ShouldActInConsensus(pubKey) {
+				backup.ResetInactivityIfNeeded(selfPubKey, pubKey, selfPid)
+			}
+		}
+	}
+
+	for _, pubKey := range keys {
+		assert.True(t, main.IsFenced(pubKey))
+		assert.True(t, backup.ShouldActInConsensus(pubKey))
+	}
+}
+
+func TestNodeRedundancy_UnparsableFenceFileShouldFenceAllTheKeys(t *testing.T) {
+	t.Parallel()
+
+	fenceFilePath, cleanup := createTempFenceFilePath(t)
+	defer cleanup()
+
+	_ = os.MkdirAll(filepath.Dir(fenceFilePath), os.ModePerm)
+	_ = ioutil.WriteFile(fenceFilePath, []byte("fenced at 2021-01-01T00:00:00Z: reason\n"), fenceFilePermissions)
+
+	nr, _ := NewNodeRedundancy(createMockArgsNodeRedundancyWithManagedKeys(fenceFilePath))
+	for _, pubKey := range []string{selfPubKey, managedPubKeyA, managedPubKeyB} {
+		assert.True(t, nr.IsFenced(pubKey))
+		assert.False(t, nr.ShouldActInConsensus(pubKey))
+	}
+}
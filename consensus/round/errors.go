@@ -4,3 +4,19 @@ import "errors"
 
 // ErrNilSyncTimer is raised when a valid sync timer is expected but nil used
 var ErrNilSyncTimer = errors.New("sync timer is nil")
+
+// ErrInvalidRoundDuration signals that an invalid round duration was provided
+var ErrInvalidRoundDuration = errors.New("invalid round duration")
+
+// ErrNilRoundDurationChanger signals that a nil round duration changer has been provided
+var ErrNilRoundDurationChanger = errors.New("nil round duration changer")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrMissingEpochStartMetaBlock signals that the epoch start meta block needed to find the round duration change was
+// not found in storage
+var ErrMissingEpochStartMetaBlock = errors.New("missing epoch start meta block")
@@ -0,0 +1,9 @@
+package round
+
+import "time"
+
+// RoundDurationChanger defines a rounder able to change its round duration starting with a provided round
+type RoundDurationChanger interface {
+	SetRoundDurationChange(changeRound int64, newTimeDuration time.Duration) error
+	IsInterfaceNil() bool
+}
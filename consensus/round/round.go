@@ -2,6 +2,7 @@ package round
 
 import (
 	"math"
+	"sync"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
@@ -18,6 +19,10 @@ type round struct {
 	timeDuration time.Duration // represents the duration of the round in current chronology
 	syncTimer    ntp.SyncTimer
 	startRound   int64
+
+	mutRound        sync.RWMutex
+	changeRound     int64         // represents the first round having the new round duration
+	newTimeDuration time.Duration // represents the duration of the rounds starting with the change round, 0 if not scheduled
 }
 
 // NewRound defines a new round object
@@ -43,38 +48,115 @@ func NewRound(
 	return &rnd, nil
 }
 
+// SetRoundDurationChange schedules the change of the round duration: the rounds starting with the provided change
+// round will last the provided new duration. A change round before the start round means that all the rounds have the
+// new duration
+func (rnd *round) SetRoundDurationChange(changeRound int64, newTimeDuration time.Duration) error {
+	if newTimeDuration <= 0 {
+		return ErrInvalidRoundDuration
+	}
+	if changeRound < rnd.startRound {
+		changeRound = rnd.startRound
+	}
+
+	rnd.mutRound.Lock()
+	rnd.changeRound = changeRound
+	rnd.newTimeDuration = newTimeDuration
+	rnd.mutRound.Unlock()
+
+	return nil
+}
+
 // UpdateRound updates the index and the time stamp of the round depending of the genesis time and the current time given
 func (rnd *round) UpdateRound(genesisTimeStamp time.Time, currentTimeStamp time.Time) {
+	rnd.mutRound.Lock()
+	defer rnd.mutRound.Unlock()
+
 	delta := currentTimeStamp.Sub(genesisTimeStamp).Nanoseconds()
 
 	index := int64(math.Floor(float64(delta)/float64(rnd.timeDuration.Nanoseconds()))) + rnd.startRound
+	if rnd.isChangeScheduled() && index >= rnd.changeRound {
+		changeDelta := rnd.timeDurationBetweenRounds(rnd.startRound, rnd.changeRound).Nanoseconds()
+		index = int64(math.Floor(float64(delta-changeDelta)/float64(rnd.newTimeDuration.Nanoseconds()))) + rnd.changeRound
+	}
 
 	if rnd.index != index {
 		rnd.index = index
-		rnd.timeStamp = genesisTimeStamp.Add(time.Duration((index - rnd.startRound) * rnd.timeDuration.Nanoseconds()))
+		rnd.timeStamp = genesisTimeStamp.Add(rnd.timeDurationBetweenRounds(rnd.startRound, index))
 	}
 }
 
 // Index returns the index of the round in current epoch
 func (rnd *round) Index() int64 {
+	rnd.mutRound.RLock()
+	defer rnd.mutRound.RUnlock()
+
 	return rnd.index
 }
 
 // BeforeGenesis returns true if round index is before start round
 func (rnd *round) BeforeGenesis() bool {
+	rnd.mutRound.RLock()
+	defer rnd.mutRound.RUnlock()
+
 	return rnd.index <= rnd.startRound
 }
 
 // TimeStamp returns the time stamp of the round
 func (rnd *round) TimeStamp() time.Time {
+	rnd.mutRound.RLock()
+	defer rnd.mutRound.RUnlock()
+
 	return rnd.timeStamp
 }
 
-// TimeDuration returns the duration of the round
+// TimeDuration returns the duration of the current round
 func (rnd *round) TimeDuration() time.Duration {
+	rnd.mutRound.RLock()
+	defer rnd.mutRound.RUnlock()
+
+	return rnd.timeDurationOfRound(rnd.index)
+}
+
+// TimeDurationBetweenRounds returns the time elapsed from the start of the first provided round until the start of the
+// second provided round, taking into account the round duration change, if scheduled
+func (rnd *round) TimeDurationBetweenRounds(fromRound int64, toRound int64) time.Duration {
+	rnd.mutRound.RLock()
+	defer rnd.mutRound.RUnlock()
+
+	return rnd.timeDurationBetweenRounds(fromRound, toRound)
+}
+
+// timeDurationBetweenRounds should be called under mutex protection
+func (rnd *round) timeDurationBetweenRounds(fromRound int64, toRound int64) time.Duration {
+	if fromRound > toRound {
+		return -rnd.timeDurationBetweenRounds(toRound, fromRound)
+	}
+	if !rnd.isChangeScheduled() || toRound <= rnd.changeRound {
+		return time.Duration(toRound-fromRound) * rnd.timeDuration
+	}
+	if fromRound >= rnd.changeRound {
+		return time.Duration(toRound-fromRound) * rnd.newTimeDuration
+	}
+
+	return time.Duration(rnd.changeRound-fromRound)*rnd.timeDuration +
+		time.Duration(toRound-rnd.changeRound)*rnd.newTimeDuration
+}
+
+// timeDurationOfRound should be called under mutex protection
+func (rnd *round) timeDurationOfRound(roundIndex int64) time.Duration {
+	if rnd.isChangeScheduled() && roundIndex >= rnd.changeRound {
+		return rnd.newTimeDuration
+	}
+
 	return rnd.timeDuration
 }
 
+// isChangeScheduled should be called under mutex protection
+func (rnd *round) isChangeScheduled() bool {
+	return rnd.newTimeDuration > 0
+}
+
 // RemainingTime returns the remaining time in the current round given by the current time, round start time and
 // safe threshold percent
 func (rnd *round) RemainingTime(startTime time.Time, maxTime time.Duration) time.Duration {
@@ -0,0 +1,200 @@
+package round
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ epochStart.ActionHandler = (*roundDurationChangeHandler)(nil)
+
+var log = logger.GetOrCreate("consensus/round")
+
+// ArgsRoundDurationChangeHandler is the DTO used to create a new instance of roundDurationChangeHandler
+type ArgsRoundDurationChangeHandler struct {
+	Rounder          RoundDurationChanger
+	MetaBlockStorer  storage.Storer
+	Marshalizer      marshal.Marshalizer
+	GenesisTime      time.Time
+	StartRound       int64
+	RoundDuration    time.Duration
+	NewRoundDuration time.Duration
+	EnableEpoch      uint32
+	DelayInRounds    uint64
+	CurrentEpoch     uint32
+}
+
+// roundDurationChangeHandler schedules the round duration change on the rounder. The new round duration is used
+// starting with the round found by adding the configured delay to the round of the start of epoch meta block of the
+// enable epoch, so that all the nodes switch at the same round, regardless of the moment they process that block.
+// When the node starts in or after the enable epoch, the change round is recovered from the stored meta blocks
+type roundDurationChangeHandler struct {
+	rounder          RoundDurationChanger
+	genesisTime      time.Time
+	startRound       int64
+	roundDuration    time.Duration
+	newRoundDuration time.Duration
+	enableEpoch      uint32
+	delayInRounds    int64
+}
+
+// NewRoundDurationChangeHandler creates a new round duration change handler and schedules the change on the rounder
+// if the current epoch is not lower than the enable epoch
+func NewRoundDurationChangeHandler(args ArgsRoundDurationChangeHandler) (*roundDurationChangeHandler, error) {
+	if check.IfNil(args.Rounder) {
+		return nil, ErrNilRoundDurationChanger
+	}
+	if check.IfNil(args.MetaBlockStorer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if args.RoundDuration <= 0 || args.NewRoundDuration <= 0 || args.RoundDuration == args.NewRoundDuration {
+		return nil, fmt.Errorf("%w, round duration %v, new round duration %v",
+			ErrInvalidRoundDuration, args.RoundDuration, args.NewRoundDuration)
+	}
+
+	rdch := &roundDurationChangeHandler{
+		rounder:          args.Rounder,
+		genesisTime:      args.GenesisTime,
+		startRound:       args.StartRound,
+		roundDuration:    args.RoundDuration,
+		newRoundDuration: args.NewRoundDuration,
+		enableEpoch:      args.EnableEpoch,
+		delayInRounds:    int64(args.DelayInRounds),
+	}
+
+	err := rdch.loadFromStorage(args.MetaBlockStorer, args.Marshalizer, args.CurrentEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return rdch, nil
+}
+
+func (rdch *roundDurationChangeHandler) loadFromStorage(
+	storer storage.Storer,
+	marshalizer marshal.Marshalizer,
+	currentEpoch uint32,
+) error {
+	if currentEpoch < rdch.enableEpoch {
+		return nil
+	}
+
+	metaBlock, err := getEpochStartMetaBlock(storer, marshalizer, rdch.enableEpoch)
+	if err == nil {
+		return rdch.scheduleChange(metaBlock.GetRound())
+	}
+
+	log.Debug("roundDurationChangeHandler: epoch start meta block of the enable epoch not found in storage",
+		"enable epoch", rdch.enableEpoch, "error", err.Error())
+	if currentEpoch == rdch.enableEpoch {
+		return fmt.Errorf("%w for epoch %d", ErrMissingEpochStartMetaBlock, rdch.enableEpoch)
+	}
+
+	// the epoch start meta block of the current epoch was created after the change round and its time stamp
+	// determines the change round
+	metaBlock, err = getEpochStartMetaBlock(storer, marshalizer, currentEpoch)
+	if err != nil {
+		return fmt.Errorf("%w for epochs %d and %d", ErrMissingEpochStartMetaBlock, rdch.enableEpoch, currentEpoch)
+	}
+
+	changeRound, err := rdch.computeChangeRoundFromHeader(metaBlock)
+	if err != nil {
+		return err
+	}
+
+	return rdch.setChangeRound(changeRound)
+}
+
+func getEpochStartMetaBlock(storer storage.Storer, marshalizer marshal.Marshalizer, epoch uint32) (*block.MetaBlock, error) {
+	buff, err := storer.SearchFirst([]byte(core.EpochStartIdentifier(epoch)))
+	if err != nil {
+		return nil, err
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = marshalizer.Unmarshal(metaBlock, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return metaBlock, nil
+}
+
+// computeChangeRoundFromHeader finds the change round from the round and the time stamp of a header proposed after
+// the change. As the time stamps are expressed in seconds, the result is exact only if the two round durations differ
+// by at least one second
+func (rdch *roundDurationChangeHandler) computeChangeRoundFromHeader(header data.HeaderHandler) (int64, error) {
+	durationsDifference := rdch.roundDuration - rdch.newRoundDuration
+	if durationsDifference > -time.Second && durationsDifference < time.Second {
+		return 0, fmt.Errorf("%w, the round durations should differ by at least one second to compute the change "+
+			"round from the header time stamp", ErrInvalidRoundDuration)
+	}
+
+	timeSinceGenesis := time.Unix(int64(header.GetTimeStamp()), 0).Sub(rdch.genesisTime)
+	roundsSinceStart := int64(header.GetRound()) - rdch.startRound
+	timeSinceGenesisWithNewDuration := time.Duration(roundsSinceStart) * rdch.newRoundDuration
+
+	// timeSinceGenesis = roundsUntilChange * roundDuration + (roundsSinceStart - roundsUntilChange) * newRoundDuration,
+	// less than a second being lost by the time stamp truncation
+	ratio := float64(timeSinceGenesis-timeSinceGenesisWithNewDuration) / float64(durationsDifference)
+	roundsUntilChange := int64(math.Ceil(ratio))
+	if durationsDifference < 0 {
+		roundsUntilChange = int64(math.Floor(ratio))
+	}
+
+	return rdch.startRound + roundsUntilChange, nil
+}
+
+func (rdch *roundDurationChangeHandler) scheduleChange(epochStartRound uint64) error {
+	return rdch.setChangeRound(int64(epochStartRound) + rdch.delayInRounds)
+}
+
+func (rdch *roundDurationChangeHandler) setChangeRound(changeRound int64) error {
+	log.Info("round duration change scheduled",
+		"epoch", rdch.enableEpoch,
+		"change round", changeRound,
+		"round duration", rdch.roundDuration,
+		"new round duration", rdch.newRoundDuration,
+	)
+
+	return rdch.rounder.SetRoundDurationChange(changeRound, rdch.newRoundDuration)
+}
+
+// EpochStartAction is called when a new epoch is confirmed, the change is scheduled on the epoch start prepare event
+func (rdch *roundDurationChangeHandler) EpochStartAction(_ data.HeaderHandler) {
+}
+
+// EpochStartPrepare schedules the round duration change when the start of epoch meta block of the enable epoch is
+// processed
+func (rdch *roundDurationChangeHandler) EpochStartPrepare(metaHdr data.HeaderHandler, _ data.BodyHandler) {
+	if check.IfNil(metaHdr) || metaHdr.GetEpoch() != rdch.enableEpoch {
+		return
+	}
+
+	err := rdch.scheduleChange(metaHdr.GetRound())
+	if err != nil {
+		log.Error("roundDurationChangeHandler.EpochStartPrepare", "error", err.Error())
+	}
+}
+
+// NotifyOrder returns the notification order of the round duration change handler
+func (rdch *roundDurationChangeHandler) NotifyOrder() uint32 {
+	return core.RoundDurationChangeOrder
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rdch *roundDurationChangeHandler) IsInterfaceNil() bool {
+	return rdch == nil
+}
@@ -0,0 +1,167 @@
+package round_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/round"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const oldRoundDuration = 6 * time.Second
+const newRoundDuration = 4 * time.Second
+
+func createMockArgsRoundDurationChangeHandler() round.ArgsRoundDurationChangeHandler {
+	genesisTime := time.Unix(0, 0)
+	rnd, _ := round.NewRound(genesisTime, genesisTime, oldRoundDuration, &mock.SyncTimerMock{}, 0)
+
+	return round.ArgsRoundDurationChangeHandler{
+		Rounder:          rnd,
+		MetaBlockStorer:  genericmocks.NewStorerMock("meta", 0),
+		Marshalizer:      &mock.MarshalizerMock{},
+		GenesisTime:      genesisTime,
+		StartRound:       0,
+		RoundDuration:    oldRoundDuration,
+		NewRoundDuration: newRoundDuration,
+		EnableEpoch:      2,
+		DelayInRounds:    5,
+		CurrentEpoch:     0,
+	}
+}
+
+func putEpochStartMetaBlock(t *testing.T, args round.ArgsRoundDurationChangeHandler, metaBlock *block.MetaBlock) {
+	buff, err := args.Marshalizer.Marshal(metaBlock)
+	require.Nil(t, err)
+
+	err = args.MetaBlockStorer.Put([]byte(core.EpochStartIdentifier(metaBlock.Epoch)), buff)
+	require.Nil(t, err)
+}
+
+func checkChangeRound(t *testing.T, rounder consensus.Rounder, changeRound int64, roundDuration time.Duration, newRoundDuration time.Duration) {
+	assert.Equal(t, roundDuration, rounder.TimeDurationBetweenRounds(changeRound-1, changeRound))
+	assert.Equal(t, newRoundDuration, rounder.TimeDurationBetweenRounds(changeRound, changeRound+1))
+}
+
+func TestNewRoundDurationChangeHandler_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRoundDurationChangeHandler()
+	args.Rounder = nil
+	rdch, err := round.NewRoundDurationChangeHandler(args)
+	assert.True(t, check.IfNil(rdch))
+	assert.Equal(t, round.ErrNilRoundDurationChanger, err)
+
+	args = createMockArgsRoundDurationChangeHandler()
+	args.MetaBlockStorer = nil
+	rdch, err = round.NewRoundDurationChangeHandler(args)
+	assert.True(t, check.IfNil(rdch))
+	assert.Equal(t, round.ErrNilStorer, err)
+
+	args = createMockArgsRoundDurationChangeHandler()
+	args.Marshalizer = nil
+	rdch, err = round.NewRoundDurationChangeHandler(args)
+	assert.True(t, check.IfNil(rdch))
+	assert.Equal(t, round.ErrNilMarshalizer, err)
+
+	args = createMockArgsRoundDurationChangeHandler()
+	args.NewRoundDuration = args.RoundDuration
+	rdch, err = round.NewRoundDurationChangeHandler(args)
+	assert.True(t, check.IfNil(rdch))
+	assert.True(t, errors.Is(err, round.ErrInvalidRoundDuration))
+}
+
+func TestNewRoundDurationChangeHandler_BeforeTheEnableEpochShouldNotScheduleTheChange(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRoundDurationChangeHandler()
+	rdch, err := round.NewRoundDurationChangeHandler(args)
+	assert.False(t, check.IfNil(rdch))
+	assert.Nil(t, err)
+
+	rounder := args.Rounder.(consensus.Rounder)
+	assert.Equal(t, 1000*oldRoundDuration, rounder.TimeDurationBetweenRounds(0, 1000))
+}
+
+func TestRoundDurationChangeHandler_EpochStartPrepareShouldScheduleTheChange(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRoundDurationChangeHandler()
+	rdch, _ := round.NewRoundDurationChangeHandler(args)
+	rounder := args.Rounder.(consensus.Rounder)
+
+	rdch.EpochStartPrepare(&block.MetaBlock{Epoch: 1, Round: 50}, &block.Body{})
+	assert.Equal(t, 1000*oldRoundDuration, rounder.TimeDurationBetweenRounds(0, 1000))
+
+	rdch.EpochStartPrepare(&block.MetaBlock{Epoch: 2, Round: 100}, &block.Body{})
+	checkChangeRound(t, rounder, 105, oldRoundDuration, newRoundDuration)
+}
+
+func TestNewRoundDurationChangeHandler_ShouldLoadTheChangeFromTheEnableEpochMetaBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRoundDurationChangeHandler()
+	args.CurrentEpoch = 3
+	putEpochStartMetaBlock(t, args, &block.MetaBlock{Epoch: 2, Round: 100})
+
+	rdch, err := round.NewRoundDurationChangeHandler(args)
+	require.Nil(t, err)
+	require.False(t, check.IfNil(rdch))
+
+	checkChangeRound(t, args.Rounder.(consensus.Rounder), 105, oldRoundDuration, newRoundDuration)
+}
+
+func TestNewRoundDurationChangeHandler_ShouldComputeTheChangeFromTheCurrentEpochMetaBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("shorter rounds", func(t *testing.T) {
+		args := createMockArgsRoundDurationChangeHandler()
+		args.CurrentEpoch = 3
+		// change round 105, the header of round 200 was proposed at 105 * 6s + 95 * 4s
+		putEpochStartMetaBlock(t, args, &block.MetaBlock{Epoch: 3, Round: 200, TimeStamp: 1010})
+
+		rdch, err := round.NewRoundDurationChangeHandler(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(rdch))
+
+		checkChangeRound(t, args.Rounder.(consensus.Rounder), 105, oldRoundDuration, newRoundDuration)
+	})
+	t.Run("longer rounds", func(t *testing.T) {
+		args := createMockArgsRoundDurationChangeHandler()
+		genesisTime := time.Unix(0, 0)
+		args.Rounder, _ = round.NewRound(genesisTime, genesisTime, newRoundDuration, &mock.SyncTimerMock{}, 0)
+		args.RoundDuration = newRoundDuration
+		args.NewRoundDuration = oldRoundDuration
+		args.CurrentEpoch = 3
+		// change round 105, the header of round 200 was proposed at 105 * 4s + 95 * 6s
+		putEpochStartMetaBlock(t, args, &block.MetaBlock{Epoch: 3, Round: 200, TimeStamp: 990})
+
+		rdch, err := round.NewRoundDurationChangeHandler(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(rdch))
+
+		checkChangeRound(t, args.Rounder.(consensus.Rounder), 105, newRoundDuration, oldRoundDuration)
+	})
+}
+
+func TestNewRoundDurationChangeHandler_MissingMetaBlocksShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRoundDurationChangeHandler()
+	args.CurrentEpoch = 2
+	rdch, err := round.NewRoundDurationChangeHandler(args)
+	assert.True(t, check.IfNil(rdch))
+	assert.True(t, errors.Is(err, round.ErrMissingEpochStartMetaBlock))
+
+	args.CurrentEpoch = 3
+	rdch, err = round.NewRoundDurationChangeHandler(args)
+	assert.True(t, check.IfNil(rdch))
+	assert.True(t, errors.Is(err, round.ErrMissingEpochStartMetaBlock))
+}
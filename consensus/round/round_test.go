@@ -153,3 +153,55 @@ func TestRound_RemainingTimeInCurrentRoundShouldReturnNegativeValue(t *testing.T
 	assert.Equal(t, time.Duration(int64(rnd.TimeDuration())-timeElapsed), remainingTime)
 	assert.True(t, remainingTime < 0)
 }
+
+func TestRound_SetRoundDurationChangeInvalidDurationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	genesisTime := time.Now()
+	rnd, _ := round.NewRound(genesisTime, genesisTime, roundTimeDuration, &mock.SyncTimerMock{}, 0)
+
+	err := rnd.SetRoundDurationChange(10, 0)
+	assert.Equal(t, round.ErrInvalidRoundDuration, err)
+}
+
+func TestRound_UpdateRoundShouldUseTheNewDurationAfterTheChangeRound(t *testing.T) {
+	t.Parallel()
+
+	genesisTime := time.Unix(0, 0)
+	newRoundTimeDuration := roundTimeDuration / 2
+	rnd, _ := round.NewRound(genesisTime, genesisTime, roundTimeDuration, &mock.SyncTimerMock{}, 0)
+
+	err := rnd.SetRoundDurationChange(10, newRoundTimeDuration)
+	assert.Nil(t, err)
+
+	rnd.UpdateRound(genesisTime, genesisTime.Add(9*roundTimeDuration))
+	assert.Equal(t, int64(9), rnd.Index())
+	assert.Equal(t, roundTimeDuration, rnd.TimeDuration())
+
+	changeTime := genesisTime.Add(10 * roundTimeDuration)
+	rnd.UpdateRound(genesisTime, changeTime)
+	assert.Equal(t, int64(10), rnd.Index())
+	assert.Equal(t, changeTime, rnd.TimeStamp())
+	assert.Equal(t, newRoundTimeDuration, rnd.TimeDuration())
+
+	rnd.UpdateRound(genesisTime, changeTime.Add(5*newRoundTimeDuration+1))
+	assert.Equal(t, int64(15), rnd.Index())
+	assert.Equal(t, changeTime.Add(5*newRoundTimeDuration), rnd.TimeStamp())
+}
+
+func TestRound_TimeDurationBetweenRoundsShouldConsiderTheRoundDurationChange(t *testing.T) {
+	t.Parallel()
+
+	genesisTime := time.Now()
+	newRoundTimeDuration := roundTimeDuration * 2
+	rnd, _ := round.NewRound(genesisTime, genesisTime, roundTimeDuration, &mock.SyncTimerMock{}, 0)
+
+	assert.Equal(t, 20*roundTimeDuration, rnd.TimeDurationBetweenRounds(0, 20))
+
+	_ = rnd.SetRoundDurationChange(10, newRoundTimeDuration)
+
+	assert.Equal(t, 5*roundTimeDuration, rnd.TimeDurationBetweenRounds(5, 10))
+	assert.Equal(t, 5*newRoundTimeDuration, rnd.TimeDurationBetweenRounds(10, 15))
+	assert.Equal(t, 10*roundTimeDuration+10*newRoundTimeDuration, rnd.TimeDurationBetweenRounds(0, 20))
+	assert.Equal(t, -(10*roundTimeDuration + 10*newRoundTimeDuration), rnd.TimeDurationBetweenRounds(20, 0))
+}
@@ -1,6 +1,7 @@
 package bls
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
@@ -21,6 +22,16 @@ type factory struct {
 	indexer          indexer.Indexer
 	chainID          []byte
 	currentPid       core.PeerID
+
+	startRoundSubround *spos.Subround
+	blockSubround      *spos.Subround
+	signatureSubround  *spos.Subround
+	endRoundSubround   *spos.Subround
+	subroundBlock      *subroundBlock
+	roundDuration      time.Duration
+
+	fallbackLeaderTimeoutPercent uint32
+	fallbackLeaderEnableEpoch    uint32
 }
 
 // NewSubroundsFactory creates a new consensusState object
@@ -91,11 +102,31 @@ func (fct *factory) SetIndexer(indexer indexer.Indexer) {
 	fct.indexer = indexer
 }
 
+// SetFallbackLeader sets the percent of the round after which, if no proposal was received, the next validator in the
+// consensus group may propose in the same round, starting with the provided epoch. The value 0 disables the fallback
+// leader
+func (fct *factory) SetFallbackLeader(percent uint32, enableEpoch uint32) error {
+	proposalDurationPercent := (srBlockEndTime - srBlockStartTime) * 100
+	minPercent := srBlockEndTime * 100
+	maxPercent := srSignatureEndTime*100 - proposalDurationPercent
+	isValidPercent := percent == 0 || (float64(percent) > minPercent && float64(percent) <= maxPercent)
+	if !isValidPercent {
+		return fmt.Errorf("%w, provided %d, should be 0 or in the interval (%.0f, %.0f]",
+			spos.ErrInvalidFallbackLeaderTimeoutPercent, percent, minPercent, maxPercent)
+	}
+
+	fct.fallbackLeaderTimeoutPercent = percent
+	fct.fallbackLeaderEnableEpoch = enableEpoch
+
+	return nil
+}
+
 // GenerateSubrounds will generate the subrounds used in BLS Cns
 func (fct *factory) GenerateSubrounds() error {
 	fct.initConsensusThreshold()
 	fct.consensusCore.Chronology().RemoveAllSubrounds()
 	fct.worker.RemoveAllReceivedMessagesCalls()
+	fct.roundDuration = fct.getTimeDuration()
 
 	err := fct.generateStartRoundSubround()
 	if err != nil {
@@ -160,6 +191,9 @@ func (fct *factory) generateStartRoundSubround() error {
 	}
 
 	subroundStartRound.SetIndexer(fct.indexer)
+	subroundStartRound.SetSubroundsDeadlinesAdjuster(fct.adjustSubroundsDeadlines)
+
+	fct.startRoundSubround = subround
 
 	fct.consensusCore.Chronology().AddSubround(subroundStartRound)
 
@@ -199,6 +233,10 @@ func (fct *factory) generateBlockSubround() error {
 		return err
 	}
 
+	fct.blockSubround = subround
+	fct.subroundBlock = subroundBlock
+	fct.setFallbackLeader(fct.getTimeDuration())
+
 	fct.worker.AddReceivedMessageCall(MtBlockBodyAndHeader, subroundBlock.receivedBlockBodyAndHeader)
 	fct.worker.AddReceivedMessageCall(MtBlockBody, subroundBlock.receivedBlockBody)
 	fct.worker.AddReceivedMessageCall(MtBlockHeader, subroundBlock.receivedBlockHeader)
@@ -239,6 +277,7 @@ func (fct *factory) generateSignatureSubround() error {
 		return err
 	}
 
+	fct.signatureSubround = subround
 	fct.worker.AddReceivedMessageCall(MtSignature, subroundSignatureObject.receivedSignature)
 	fct.consensusCore.Chronology().AddSubround(subroundSignatureObject)
 
@@ -279,6 +318,7 @@ func (fct *factory) generateEndRoundSubround() error {
 		return err
 	}
 
+	fct.endRoundSubround = subround
 	fct.worker.AddReceivedMessageCall(MtBlockHeaderFinalInfo, subroundEndRoundObject.receivedBlockHeaderFinalInfo)
 	fct.worker.AddReceivedHeaderHandler(subroundEndRoundObject.receivedHeader)
 	fct.consensusCore.Chronology().AddSubround(subroundEndRoundObject)
@@ -286,6 +326,87 @@ func (fct *factory) generateEndRoundSubround() error {
 	return nil
 }
 
+func (fct *factory) setFallbackLeader(roundDuration time.Duration) {
+	if fct.fallbackLeaderTimeoutPercent == 0 || fct.subroundBlock == nil {
+		return
+	}
+
+	proposalTimeout := roundDuration * time.Duration(fct.fallbackLeaderTimeoutPercent) / 100
+	proposalDuration := time.Duration(float64(roundDuration) * (srBlockEndTime - srBlockStartTime))
+	fct.subroundBlock.setFallbackLeader(proposalTimeout, proposalDuration, fct.fallbackLeaderEnableEpoch, fct.setProposalDeadline)
+}
+
+// adjustSubroundsDeadlines moves the boundary between the block and the signature subrounds as computed by the
+// subrounds timing handler, for the provided round
+func (fct *factory) adjustSubroundsDeadlines(round int64) {
+	if fct.blockSubround == nil || fct.signatureSubround == nil {
+		return
+	}
+
+	roundDuration := fct.getTimeDuration()
+	fct.adjustSubroundsToRoundDuration(roundDuration)
+
+	defaultDeadline := time.Duration(float64(roundDuration) * srBlockEndTime)
+	signaturesDeadline := time.Duration(float64(roundDuration) * srSignatureEndTime)
+	subroundsTimingHandler := fct.consensusCore.SubroundsTimingHandler()
+	proposalDeadline := subroundsTimingHandler.ComputeProposalDeadline(round, roundDuration, defaultDeadline, signaturesDeadline)
+	if fct.subroundBlock != nil && fct.subroundBlock.isFallbackLeaderEnabled() {
+		fallbackTime := time.Duration(fct.subroundBlock.FallbackTime())
+		if proposalDeadline > fallbackTime {
+			proposalDeadline = fallbackTime
+		}
+	}
+
+	fct.setProposalDeadline(proposalDeadline)
+
+	waitAllSignaturesDeadline := proposalDeadline + time.Duration(float64(signaturesDeadline-proposalDeadline)*waitingAllSigsMaxTimeThreshold)
+	fct.appStatusHandler.SetUInt64Value(core.MetricConsensusProposalDeadline, uint64(proposalDeadline/time.Millisecond))
+	fct.appStatusHandler.SetUInt64Value(core.MetricConsensusWaitAllSignaturesDeadline, uint64(waitAllSignaturesDeadline/time.Millisecond))
+	fct.appStatusHandler.SetUInt64Value(core.MetricConsensusEstimatedLatency, uint64(subroundsTimingHandler.EstimatedLatency()/time.Millisecond))
+}
+
+// adjustSubroundsToRoundDuration recomputes the start and the end of all the subrounds, and the fallback leader
+// timeouts, when the round duration changed since the subrounds were created
+func (fct *factory) adjustSubroundsToRoundDuration(roundDuration time.Duration) {
+	if fct.roundDuration == roundDuration {
+		return
+	}
+
+	log.Debug("adjusting the subrounds to the round duration", "old", fct.roundDuration, "new", roundDuration)
+	fct.roundDuration = roundDuration
+
+	setSubroundTimes(fct.startRoundSubround, roundDuration, srStartStartTime, srStartEndTime)
+	setSubroundTimes(fct.blockSubround, roundDuration, srBlockStartTime, srBlockEndTime)
+	setSubroundTimes(fct.signatureSubround, roundDuration, srSignatureStartTime, srSignatureEndTime)
+	setSubroundTimes(fct.endRoundSubround, roundDuration, srEndStartTime, srEndEndTime)
+	fct.setFallbackLeader(roundDuration)
+}
+
+func setSubroundTimes(subround *spos.Subround, roundDuration time.Duration, startTime float64, endTime float64) {
+	if subround == nil {
+		return
+	}
+
+	subround.SetStartTime(int64(float64(roundDuration) * startTime))
+	subround.SetEndTime(int64(float64(roundDuration) * endTime))
+}
+
+// setProposalDeadline moves the end of the block subround, and the start of the signature subround, to the provided
+// moment, measured from the round start. The deadline can not exceed the end of the signature subround
+func (fct *factory) setProposalDeadline(deadline time.Duration) {
+	if fct.blockSubround == nil || fct.signatureSubround == nil {
+		return
+	}
+
+	signaturesDeadline := time.Duration(float64(fct.getTimeDuration()) * srSignatureEndTime)
+	if deadline > signaturesDeadline {
+		deadline = signaturesDeadline
+	}
+
+	fct.blockSubround.SetEndTime(int64(deadline))
+	fct.signatureSubround.SetStartTime(int64(deadline))
+}
+
 func (fct *factory) initConsensusThreshold() {
 	pBFTThreshold := core.GetPBFTThreshold(fct.consensusState.ConsensusGroupSize())
 	pBFTFallbackThreshold := core.GetPBFTFallbackThreshold(fct.consensusState.ConsensusGroupSize())
@@ -11,7 +11,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var chainID = []byte("chain ID")
@@ -558,3 +560,86 @@ func TestFactory_SetIndexerShouldWork(t *testing.T) {
 
 	assert.Equal(t, indexer, fct.Indexer())
 }
+
+func TestFactory_AdjustSubroundsDeadlinesShouldMoveTheBlockSubroundEnd(t *testing.T) {
+	t.Parallel()
+
+	subroundHandlers := make([]consensus.SubroundHandler, 0)
+	chrm := &mock.ChronologyHandlerMock{}
+	chrm.AddSubroundCalled = func(subroundHandler consensus.SubroundHandler) {
+		subroundHandlers = append(subroundHandlers, subroundHandler)
+	}
+	proposalDeadline := 2 * time.Second
+	container := mock.InitConsensusCore()
+	container.SetChronology(chrm)
+	container.SetSubroundsTimingHandler(&testscommon.SubroundsTimingHandlerStub{
+		ComputeProposalDeadlineCalled: func(round int64, roundDuration time.Duration, defaultDeadline time.Duration, signaturesDeadline time.Duration) time.Duration {
+			assert.Equal(t, int64(7), round)
+			assert.Equal(t, container.Rounder().TimeDuration(), roundDuration)
+			assert.Equal(t, roundDuration/4, defaultDeadline)
+			return proposalDeadline
+		},
+		EstimatedLatencyCalled: func() time.Duration {
+			return 500 * time.Millisecond
+		},
+	})
+	metrics := make(map[string]uint64)
+	fct := *initFactoryWithContainer(container)
+	_ = fct.SetAppStatusHandler(&mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			metrics[key] = value
+		},
+	})
+	err := fct.GenerateSubrounds()
+	require.Nil(t, err)
+	require.Equal(t, 4, len(subroundHandlers))
+
+	fct.AdjustSubroundsDeadlines(7)
+
+	assert.Equal(t, int64(proposalDeadline), subroundHandlers[1].EndTime())
+	assert.Equal(t, int64(proposalDeadline), subroundHandlers[2].StartTime())
+	assert.Equal(t, uint64(2000), metrics[core.MetricConsensusProposalDeadline])
+	assert.Equal(t, uint64(500), metrics[core.MetricConsensusEstimatedLatency])
+	assert.True(t, metrics[core.MetricConsensusWaitAllSignaturesDeadline] > 2000)
+}
+
+func TestFactory_AdjustSubroundsDeadlinesShouldRescaleTheSubroundsWhenTheRoundDurationChanges(t *testing.T) {
+	t.Parallel()
+
+	subroundHandlers := make([]consensus.SubroundHandler, 0)
+	chrm := &mock.ChronologyHandlerMock{}
+	chrm.AddSubroundCalled = func(subroundHandler consensus.SubroundHandler) {
+		subroundHandlers = append(subroundHandlers, subroundHandler)
+	}
+	roundDuration := 4 * time.Second
+	rounder := &mock.RounderMock{
+		TimeDurationCalled: func() time.Duration {
+			return roundDuration
+		},
+	}
+	container := mock.InitConsensusCore()
+	container.SetChronology(chrm)
+	container.SetRounder(rounder)
+	container.SetSubroundsTimingHandler(&testscommon.SubroundsTimingHandlerStub{
+		ComputeProposalDeadlineCalled: func(round int64, roundDuration time.Duration, defaultDeadline time.Duration, signaturesDeadline time.Duration) time.Duration {
+			return defaultDeadline
+		},
+	})
+	fct := *initFactoryWithContainer(container)
+	err := fct.GenerateSubrounds()
+	require.Nil(t, err)
+	require.Equal(t, 4, len(subroundHandlers))
+	assert.Equal(t, int64(3800*time.Millisecond), subroundHandlers[3].EndTime())
+
+	roundDuration = 2 * time.Second
+	fct.AdjustSubroundsDeadlines(8)
+
+	assert.Equal(t, int64(0), subroundHandlers[0].StartTime())
+	assert.Equal(t, int64(100*time.Millisecond), subroundHandlers[0].EndTime())
+	assert.Equal(t, int64(100*time.Millisecond), subroundHandlers[1].StartTime())
+	assert.Equal(t, int64(500*time.Millisecond), subroundHandlers[1].EndTime())
+	assert.Equal(t, int64(500*time.Millisecond), subroundHandlers[2].StartTime())
+	assert.Equal(t, int64(1700*time.Millisecond), subroundHandlers[2].EndTime())
+	assert.Equal(t, int64(1700*time.Millisecond), subroundHandlers[3].StartTime())
+	assert.Equal(t, int64(1900*time.Millisecond), subroundHandlers[3].EndTime())
+}
@@ -123,6 +123,11 @@ func (fct *factory) Indexer() indexer.Indexer {
 	return fct.indexer
 }
 
+// AdjustSubroundsDeadlines -
+func (fct *factory) AdjustSubroundsDeadlines(round int64) {
+	fct.adjustSubroundsDeadlines(round)
+}
+
 // subroundStartRound
 
 // SubroundStartRound defines a type for the subroundStartRound structure
@@ -213,6 +218,21 @@ func (sr *subroundBlock) ReceivedBlockHeader(cnsDta *consensus.Message) bool {
 	return sr.receivedBlockHeader(cnsDta)
 }
 
+// SetFallbackLeader enables the fallback leader mechanism in the subround Block
+func (sr *subroundBlock) SetFallbackLeader(
+	proposalTimeout time.Duration,
+	proposalDuration time.Duration,
+	enableEpoch uint32,
+	setProposalDeadline func(deadline time.Duration),
+) {
+	sr.setFallbackLeader(proposalTimeout, proposalDuration, enableEpoch, setProposalDeadline)
+}
+
+// DoFallbackLeaderJob lets the fallback leader take over the round if no proposal was received
+func (sr *subroundBlock) DoFallbackLeaderJob() {
+	sr.doFallbackLeaderJob()
+}
+
 // subroundSignature
 
 // SubroundSignature defines a type for the subroundSignature structure
@@ -258,6 +278,11 @@ func (sr *subroundEndRound) CheckSignaturesValidity(bitmap []byte) error {
 	return sr.checkSignaturesValidity(bitmap)
 }
 
+// AggregateSigsAndVerify -
+func (sr *subroundEndRound) AggregateSigsAndVerify(bitmap []byte) ([]byte, []byte, error) {
+	return sr.aggregateSigsAndVerify(bitmap)
+}
+
 func (sr *subroundEndRound) DoEndRoundJobByParticipant(cnsDta *consensus.Message) bool {
 	return sr.doEndRoundJobByParticipant(cnsDta)
 }
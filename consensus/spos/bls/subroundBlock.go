@@ -1,6 +1,7 @@
 package bls
 
 import (
+	"encoding/hex"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
@@ -18,6 +19,10 @@ type subroundBlock struct {
 	*spos.Subround
 
 	processingThresholdPercentage int
+
+	fallbackProposalDuration    time.Duration
+	fallbackLeaderEnableEpoch   uint32
+	setFallbackProposalDeadline func(deadline time.Duration)
 }
 
 // NewSubroundBlock creates a subroundBlock object
@@ -35,10 +40,14 @@ func NewSubroundBlock(
 		Subround:                      baseSubround,
 		processingThresholdPercentage: processingThresholdPercentage,
 	}
+	srBlock.setFallbackProposalDeadline = func(deadline time.Duration) {
+		srBlock.SetEndTime(int64(deadline))
+	}
 
 	srBlock.Job = srBlock.doBlockJob
 	srBlock.Check = srBlock.doBlockConsensusCheck
 	srBlock.Extend = extend
+	srBlock.Fallback = srBlock.doFallbackLeaderJob
 
 	return &srBlock, nil
 }
@@ -65,6 +74,10 @@ func (sr *subroundBlock) doBlockJob() bool {
 		return false
 	}
 
+	if sr.isFallbackTimeReached() {
+		return false
+	}
+
 	if sr.Rounder().Index() <= sr.getRoundInLastCommittedBlock() {
 		return false
 	}
@@ -103,9 +116,83 @@ func (sr *subroundBlock) doBlockJob() bool {
 		return false
 	}
 
+	sr.ParticipationTracker().AddProposedBlock(sr.Rounder().Index())
+
 	return true
 }
 
+// setFallbackLeader enables the fallback leader mechanism, starting with the provided epoch: if no proposal is received
+// until the provided timeout, measured from the round start, the next validator in the consensus group becomes the
+// leader and is given the provided duration to propose its block. The deadline setter is used to move the end of the
+// proposal accordingly
+func (sr *subroundBlock) setFallbackLeader(
+	proposalTimeout time.Duration,
+	proposalDuration time.Duration,
+	enableEpoch uint32,
+	setProposalDeadline func(deadline time.Duration),
+) {
+	sr.SetFallbackTime(int64(proposalTimeout))
+	sr.fallbackProposalDuration = proposalDuration
+	sr.fallbackLeaderEnableEpoch = enableEpoch
+	if setProposalDeadline != nil {
+		sr.setFallbackProposalDeadline = setProposalDeadline
+	}
+}
+
+// isFallbackLeaderEnabled returns true if the fallback leader may propose in the rounds of the current epoch
+func (sr *subroundBlock) isFallbackLeaderEnabled() bool {
+	if sr.FallbackTime() == 0 {
+		return false
+	}
+
+	epoch := uint32(0)
+	currentHeader := sr.Blockchain().GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		currentHeader = sr.Blockchain().GetGenesisHeader()
+	}
+	if !check.IfNil(currentHeader) {
+		epoch = currentHeader.GetEpoch()
+	}
+
+	return epoch >= sr.fallbackLeaderEnableEpoch
+}
+
+// isFallbackTimeReached returns true if the leader of the round should not propose anymore because the fallback leader
+// may have taken over the round
+func (sr *subroundBlock) isFallbackTimeReached() bool {
+	if sr.IsFallbackLeaderActive() || !sr.isFallbackLeaderEnabled() {
+		return false
+	}
+
+	return sr.Rounder().RemainingTime(sr.RoundTimeStamp, time.Duration(sr.FallbackTime())) <= 0
+}
+
+// doFallbackLeaderJob is called, on the subround goroutine, when the fallback time of the round is reached. If no
+// proposal was received until then, the fallback leader takes over the round and proposes, if it is this node
+func (sr *subroundBlock) doFallbackLeaderJob() {
+	if !sr.isFallbackLeaderEnabled() {
+		return
+	}
+	if sr.RoundCanceled || sr.IsConsensusDataSet() || sr.IsSubroundFinished(sr.Current()) {
+		return
+	}
+	if !sr.ActivateFallbackLeader() {
+		return
+	}
+
+	leader, _ := sr.GetLeader()
+	log.Debug("no proposal received in time, the fallback leader takes over",
+		"round", sr.Rounder().Index(),
+		"fallback leader", core.GetTrimmedPk(hex.EncodeToString([]byte(leader))))
+
+	if !sr.IsSelfLeaderInCurrentRound() {
+		return
+	}
+
+	sr.setFallbackProposalDeadline(time.Duration(sr.FallbackTime()) + sr.fallbackProposalDuration)
+	sr.doBlockJob()
+}
+
 func (sr *subroundBlock) sendBlock(body data.BodyHandler, header data.HeaderHandler) bool {
 	marshalizedBody, err := sr.Marshalizer().Marshal(body)
 	if err != nil {
@@ -287,7 +374,12 @@ func (sr *subroundBlock) createHeader() (data.HeaderHandler, error) {
 	hdr := sr.BlockProcessor().CreateNewHeader(round, nonce)
 	hdr.SetPrevHash(prevHash)
 
-	randSeed, err := sr.SingleSigner().Sign(sr.PrivateKey(), prevRandSeed)
+	privateKey, err := sr.SelfPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	randSeed, err := sr.SingleSigner().Sign(privateKey, prevRandSeed)
 	if err != nil {
 		return nil, err
 	}
@@ -489,6 +581,7 @@ func (sr *subroundBlock) processReceivedBlock(cnsDta *consensus.Message) bool {
 
 	metricStatTime := time.Now()
 	defer sr.computeSubroundProcessingMetric(metricStatTime, core.MetricProcessedProposedBlock)
+	receivedAfter := metricStatTime.Sub(startTime)
 
 	err := sr.BlockProcessor().ProcessBlock(
 		sr.Header,
@@ -525,9 +618,29 @@ func (sr *subroundBlock) processReceivedBlock(cnsDta *consensus.Message) bool {
 		return false
 	}
 
+	sr.SubroundsTimingHandler().AddBlockObservation(
+		cnsDta.RoundIndex,
+		sr.computeBlockSize(),
+		receivedAfter,
+		time.Since(metricStatTime),
+	)
+
 	return true
 }
 
+func (sr *subroundBlock) computeBlockSize() int {
+	marshalizedBody, err := sr.Marshalizer().Marshal(sr.Body)
+	if err != nil {
+		return 0
+	}
+	marshalizedHeader, err := sr.Marshalizer().Marshal(sr.Header)
+	if err != nil {
+		return 0
+	}
+
+	return len(marshalizedBody) + len(marshalizedHeader)
+}
+
 func (sr *subroundBlock) computeSubroundProcessingMetric(startTime time.Time, metric string) {
 	subRoundDuration := sr.EndTime() - sr.StartTime()
 	if subRoundDuration == 0 {
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -520,6 +521,48 @@ func TestSubroundBlock_ProcessReceivedBlockShouldReturnTrue(t *testing.T) {
 	assert.True(t, sr.ProcessReceivedBlock(cnsMsg))
 }
 
+func TestSubroundBlock_ProcessReceivedBlockShouldAddBlockObservation(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	observedRound := int64(-1)
+	observedBlockSize := 0
+	container.SetSubroundsTimingHandler(&testscommon.SubroundsTimingHandlerStub{
+		AddBlockObservationCalled: func(round int64, blockSize int, receivedAfter time.Duration, processingTime time.Duration) {
+			observedRound = round
+			observedBlockSize = blockSize
+		},
+	})
+	sr := *initSubroundBlock(nil, container)
+	hdr := &block.Header{Nonce: 1}
+	blkBody := &block.Body{
+		MiniBlocks: []*block.MiniBlock{},
+	}
+	blkBodyStr, _ := mock.MarshalizerMock{}.Marshal(blkBody)
+	hdrStr, _ := mock.MarshalizerMock{}.Marshal(hdr)
+	cnsMsg := consensus.NewConsensusMessage(
+		nil,
+		nil,
+		blkBodyStr,
+		hdrStr,
+		[]byte(sr.ConsensusGroup()[0]),
+		[]byte("sig"),
+		int(bls.MtBlockBodyAndHeader),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	sr.Header = hdr
+	sr.Body = blkBody
+
+	assert.True(t, sr.ProcessReceivedBlock(cnsMsg))
+	assert.Equal(t, int64(0), observedRound)
+	assert.Equal(t, len(blkBodyStr)+len(hdrStr), observedBlockSize)
+}
+
 func TestSubroundBlock_RemainingTimeShouldReturnNegativeValue(t *testing.T) {
 	t.Parallel()
 	container := mock.InitConsensusCore()
@@ -919,3 +962,98 @@ func TestSubroundBlock_ReceivedBlockComputeProcessDurationWithZeroDurationShould
 
 	srBlock.ComputeSubroundProcessingMetric(time.Now(), "dummy")
 }
+
+func TestSubroundBlock_DoFallbackLeaderJobNoProposalShouldLetTheFallbackLeaderPropose(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetBlockProcessor(mock.InitBlockProcessorMock())
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			return nil
+		},
+	})
+	container.SetRounder(&mock.RounderMock{
+		RoundIndex: 1,
+		RemainingTimeCalled: func(startTime time.Time, maxTime time.Duration) time.Duration {
+			return 0
+		},
+	})
+	sr := *initSubroundBlock(nil, container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[1])
+	sr.Data = nil
+
+	proposalTimeout := 40 * roundTimeDuration / 100
+	proposalDuration := 20 * roundTimeDuration / 100
+	var proposalDeadline time.Duration
+	sr.SetFallbackLeader(proposalTimeout, proposalDuration, 0, func(deadline time.Duration) {
+		proposalDeadline = deadline
+	})
+
+	sr.DoFallbackLeaderJob()
+
+	assert.True(t, sr.IsFallbackLeaderActive())
+	assert.True(t, sr.IsSelfLeaderInCurrentRound())
+	assert.Equal(t, proposalTimeout+proposalDuration, proposalDeadline)
+	assert.True(t, sr.IsConsensusDataSet())
+	assert.True(t, sr.DoBlockConsensusCheck())
+}
+
+func TestSubroundBlock_DoFallbackLeaderJobProposalReceivedShouldNotActivateTheFallbackLeader(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(nil, container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[1])
+	sr.Data = []byte("X")
+
+	deadlineSet := false
+	sr.SetFallbackLeader(40*roundTimeDuration/100, 20*roundTimeDuration/100, 0, func(deadline time.Duration) {
+		deadlineSet = true
+	})
+
+	sr.DoFallbackLeaderJob()
+
+	assert.False(t, sr.IsFallbackLeaderActive())
+	assert.False(t, sr.IsSelfLeaderInCurrentRound())
+	assert.False(t, deadlineSet)
+}
+
+func TestSubroundBlock_DoFallbackLeaderJobBeforeEnableEpochShouldNotActivateTheFallbackLeader(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(nil, container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[1])
+	sr.Data = nil
+
+	deadlineSet := false
+	sr.SetFallbackLeader(40*roundTimeDuration/100, 20*roundTimeDuration/100, 1, func(deadline time.Duration) {
+		deadlineSet = true
+	})
+
+	sr.DoFallbackLeaderJob()
+
+	assert.False(t, sr.IsFallbackLeaderActive())
+	assert.False(t, sr.IsSelfLeaderInCurrentRound())
+	assert.False(t, deadlineSet)
+}
+
+func TestSubroundBlock_DoBlockJobAfterTheFallbackTimeShouldNotPropose(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetRounder(&mock.RounderMock{
+		RoundIndex: 1,
+		RemainingTimeCalled: func(startTime time.Time, maxTime time.Duration) time.Duration {
+			return 0
+		},
+	})
+	sr := *initSubroundBlock(nil, container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[0])
+	sr.Data = nil
+	sr.SetFallbackLeader(40*roundTimeDuration/100, 20*roundTimeDuration/100, 0, nil)
+
+	assert.False(t, sr.DoBlockJob())
+	assert.False(t, sr.IsConsensusDataSet())
+}
@@ -177,6 +177,10 @@ func (sr *subroundEndRound) doEndRoundJob() bool {
 }
 
 func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
+	if sr.isInProtectiveMode() {
+		return false
+	}
+
 	bitmap := sr.GenerateBitmap(SrSignature)
 	err := sr.checkSignaturesValidity(bitmap)
 	if err != nil {
@@ -184,10 +188,10 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 		return false
 	}
 
-	// Aggregate sig and add it to the block
-	sig, err := sr.MultiSigner().AggregateSigs(bitmap)
+	// Aggregate sig, verify it and add it to the block
+	bitmap, sig, err := sr.aggregateSigsAndVerify(bitmap)
 	if err != nil {
-		log.Debug("doEndRoundJob.AggregateSigs", "error", err.Error())
+		log.Debug("doEndRoundJob.aggregateSigsAndVerify", "error", err.Error())
 		return false
 	}
 
@@ -229,6 +233,7 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 	}
 
 	sr.SetStatus(sr.Current(), spos.SsFinished)
+	sr.StateDebugger().SetBlockCommitted(int64(sr.Header.GetRound()))
 
 	sr.displayStatistics()
 
@@ -276,6 +281,20 @@ func (sr *subroundEndRound) createAndBroadcastHeaderFinalInfo() {
 		"LeaderSignature", sr.Header.GetLeaderSignature())
 }
 
+// isInProtectiveMode returns true if the block should not be finalized because a probable network partition was detected
+func (sr *subroundEndRound) isInProtectiveMode() bool {
+	if !sr.PartitionStatusHandler().IsInProtectiveMode() {
+		return false
+	}
+
+	log.Warn("subroundEndRound: refusing to finalize the block as the node is in protective mode",
+		"round", sr.Rounder().Index(),
+		"subround", sr.Name(),
+	)
+
+	return true
+}
+
 func (sr *subroundEndRound) doEndRoundJobByParticipant(cnsDta *consensus.Message) bool {
 	sr.mutProcessingEndRound.Lock()
 	defer sr.mutProcessingEndRound.Unlock()
@@ -318,6 +337,9 @@ func (sr *subroundEndRound) doEndRoundJobByParticipant(cnsDta *consensus.Message
 	if sr.isOutOfTime() {
 		return false
 	}
+	if sr.isInProtectiveMode() {
+		return false
+	}
 
 	startTime := time.Now()
 	err := sr.BlockProcessor().CommitBlock(header, sr.Body)
@@ -335,6 +357,7 @@ func (sr *subroundEndRound) doEndRoundJobByParticipant(cnsDta *consensus.Message
 	}
 
 	sr.SetStatus(sr.Current(), spos.SsFinished)
+	sr.StateDebugger().SetBlockCommitted(int64(header.GetRound()))
 
 	if sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
 		err = sr.setHeaderForValidator(header)
@@ -417,7 +440,12 @@ func (sr *subroundEndRound) signBlockHeader() ([]byte, error) {
 		return nil, err
 	}
 
-	return sr.SingleSigner().Sign(sr.PrivateKey(), marshalizedHdr)
+	privateKey, err := sr.SelfPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return sr.SingleSigner().Sign(privateKey, marshalizedHdr)
 }
 
 func (sr *subroundEndRound) updateMetricsForLeader() {
@@ -481,23 +509,12 @@ func (sr *subroundEndRound) doEndRoundConsensusCheck() bool {
 	return false
 }
 
+// checkSignaturesValidity checks that all the signers from the bitmap have sent their signature shares. The shares
+// are not verified one by one, as the aggregated signature built over them is verified in a single pass
 func (sr *subroundEndRound) checkSignaturesValidity(bitmap []byte) error {
-	nbBitsBitmap := len(bitmap) * 8
 	consensusGroup := sr.ConsensusGroup()
-	consensusGroupSize := len(consensusGroup)
-	size := consensusGroupSize
-
-	if consensusGroupSize > nbBitsBitmap {
-		size = nbBitsBitmap
-	}
-
-	for i := 0; i < size; i++ {
-		indexRequired := (bitmap[i/8] & (1 << uint16(i%8))) > 0
-		if !indexRequired {
-			continue
-		}
-
-		pubKey := consensusGroup[i]
+	for _, index := range signersIndexes(bitmap, len(consensusGroup)) {
+		pubKey := consensusGroup[index]
 		isSigJobDone, err := sr.JobDone(pubKey, SrSignature)
 		if err != nil {
 			return err
@@ -507,20 +524,150 @@ func (sr *subroundEndRound) checkSignaturesValidity(bitmap []byte) error {
 			return spos.ErrNilSignature
 		}
 
-		signature, err := sr.MultiSigner().SignatureShare(uint16(i))
+		_, err = sr.MultiSigner().SignatureShare(uint16(index))
 		if err != nil {
 			return err
 		}
+	}
 
-		err = sr.MultiSigner().VerifySignatureShare(uint16(i), signature, sr.GetData(), bitmap)
-		if err != nil {
-			return err
-		}
+	return nil
+}
+
+// aggregateSigsAndVerify aggregates the collected signature shares and verifies the result with a single check.
+// Only if this check fails, the invalid shares are isolated through batched checks over halves of the signers set,
+// removed from the bitmap and the aggregation is redone over the remaining valid shares
+func (sr *subroundEndRound) aggregateSigsAndVerify(bitmap []byte) ([]byte, []byte, error) {
+	sig, err := sr.aggregateAndVerifySigs(bitmap)
+	if err == nil {
+		return bitmap, sig, nil
+	}
+
+	log.Debug("aggregateSigsAndVerify: aggregated signature is not valid, searching the invalid shares",
+		"error", err.Error())
+
+	consensusGroup := sr.ConsensusGroup()
+	indexes := signersIndexes(bitmap, len(consensusGroup))
+	invalidIndexes := sr.searchInvalidSigShares(indexes, len(bitmap))
+	if len(invalidIndexes) == 0 {
+		return nil, nil, err
+	}
+
+	validBitmap := make([]byte, len(bitmap))
+	copy(validBitmap, bitmap)
+	for _, index := range invalidIndexes {
+		validBitmap[index/8] &^= 1 << uint16(index%8)
+
+		pubKey := consensusGroup[index]
+		log.Debug("aggregateSigsAndVerify: invalid signature share", "pk", []byte(pubKey))
+		_ = sr.SetJobDone(pubKey, SrSignature, false)
+		sr.PeerHonestyHandler().ChangeScore(
+			pubKey,
+			spos.GetConsensusTopicID(sr.ShardCoordinator()),
+			spos.ValidatorPeerHonestyDecreaseFactor,
+		)
+	}
+
+	threshold := sr.Threshold(SrSignature)
+	if sr.FallbackHeaderValidator().ShouldApplyFallbackValidation(sr.Header) {
+		threshold = sr.FallbackThreshold(SrSignature)
+	}
+	numValidSigs := len(indexes) - len(invalidIndexes)
+	if numValidSigs < threshold {
+		return nil, nil, fmt.Errorf("%w: %d valid signature shares, threshold %d",
+			spos.ErrNotEnoughValidSignatureShares, numValidSigs, threshold)
+	}
+
+	sig, err = sr.aggregateAndVerifySigs(validBitmap)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return validBitmap, sig, nil
+}
+
+func (sr *subroundEndRound) aggregateAndVerifySigs(bitmap []byte) ([]byte, error) {
+	sig, err := sr.MultiSigner().AggregateSigs(bitmap)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sr.MultiSigner().SetAggregatedSig(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sr.MultiSigner().Verify(sr.GetData(), bitmap)
+	if err != nil {
+		return nil, err
+	}
+
+	return sig, nil
+}
+
+// searchInvalidSigShares splits the provided signers set in halves and returns the indexes of the invalid shares.
+// A half is checked through its aggregated signature so a valid half is accepted with a single check
+func (sr *subroundEndRound) searchInvalidSigShares(indexes []int, bitmapSize int) []int {
+	if len(indexes) == 0 {
+		return nil
+	}
+	if len(indexes) == 1 {
+		return sr.checkSigShare(indexes[0], bitmapSize)
+	}
+
+	middle := len(indexes) / 2
+	invalidIndexes := sr.checkSigSharesSubset(indexes[:middle], bitmapSize)
+
+	return append(invalidIndexes, sr.checkSigSharesSubset(indexes[middle:], bitmapSize)...)
+}
+
+func (sr *subroundEndRound) checkSigSharesSubset(indexes []int, bitmapSize int) []int {
+	if len(indexes) == 1 {
+		return sr.checkSigShare(indexes[0], bitmapSize)
+	}
+
+	subsetBitmap := make([]byte, bitmapSize)
+	for _, index := range indexes {
+		subsetBitmap[index/8] |= 1 << uint16(index%8)
+	}
+
+	_, err := sr.aggregateAndVerifySigs(subsetBitmap)
+	if err == nil {
+		return nil
+	}
+
+	return sr.searchInvalidSigShares(indexes, bitmapSize)
+}
+
+func (sr *subroundEndRound) checkSigShare(index int, bitmapSize int) []int {
+	sigShare, err := sr.MultiSigner().SignatureShare(uint16(index))
+	if err == nil {
+		err = sr.MultiSigner().VerifySignatureShare(uint16(index), sigShare, sr.GetData(), make([]byte, bitmapSize))
+	}
+	if err != nil {
+		return []int{index}
 	}
 
 	return nil
 }
 
+// signersIndexes returns the consensus group indexes that are set in the provided bitmap
+func signersIndexes(bitmap []byte, consensusGroupSize int) []int {
+	size := consensusGroupSize
+	nbBitsBitmap := len(bitmap) * 8
+	if consensusGroupSize > nbBitsBitmap {
+		size = nbBitsBitmap
+	}
+
+	indexes := make([]int, 0, size)
+	for i := 0; i < size; i++ {
+		if (bitmap[i/8] & (1 << uint16(i%8))) > 0 {
+			indexes = append(indexes, i)
+		}
+	}
+
+	return indexes
+}
+
 func (sr *subroundEndRound) isOutOfTime() bool {
 	startTime := sr.RoundTimeStamp
 	maxTime := sr.Rounder().TimeDuration() * time.Duration(sr.processingThresholdPercentage) / 100
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -499,6 +500,32 @@ func TestSubroundEndRound_DoEndRoundJobAllOK(t *testing.T) {
 	assert.True(t, r)
 }
 
+func TestSubroundEndRound_DoEndRoundJobInProtectiveModeShouldNotCommit(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetPartitionStatusHandler(&testscommon.PartitionStatusHandlerStub{
+		IsInProtectiveModeCalled: func() bool {
+			return true
+		},
+	})
+	commitCalled := false
+	blProcMock := mock.InitBlockProcessorMock()
+	blProcMock.CommitBlockCalled = func(header data.HeaderHandler, body data.BodyHandler) error {
+		commitCalled = true
+		return nil
+	}
+	container.SetBlockProcessor(blProcMock)
+	sr := *initSubroundEndRoundWithContainer(container)
+	sr.SetSelfPubKey("A")
+
+	sr.Header = &block.Header{}
+
+	r := sr.DoEndRoundJob()
+	assert.False(t, r)
+	assert.False(t, commitCalled)
+}
+
 func TestSubroundEndRound_CheckIfSignatureIsFilled(t *testing.T) {
 	t.Parallel()
 
@@ -584,21 +611,115 @@ func TestSubroundEndRound_CheckSignaturesValidityShouldErrIndexOutOfBounds(t *te
 	assert.Equal(t, crypto.ErrIndexOutOfBounds, err)
 }
 
-func TestSubroundEndRound_CheckSignaturesValidityShouldErrInvalidSignatureShare(t *testing.T) {
+func TestSubroundEndRound_CheckSignaturesValidityShouldNotVerifySignatureShares(t *testing.T) {
 	t.Parallel()
 	container := mock.InitConsensusCore()
 	sr := *initSubroundEndRoundWithContainer(container)
 	multiSignerMock := mock.InitMultiSignerMock()
-	err := errors.New("invalid signature share")
 	multiSignerMock.VerifySignatureShareMock = func(index uint16, sig []byte, msg []byte, bitmap []byte) error {
-		return err
+		assert.Fail(t, "should have not verified the signature share")
+		return nil
 	}
 	container.SetMultiSigner(multiSignerMock)
 
 	_ = sr.SetJobDone(sr.ConsensusGroup()[0], bls.SrSignature, true)
 
-	err2 := sr.CheckSignaturesValidity([]byte{1})
-	assert.Equal(t, err, err2)
+	err := sr.CheckSignaturesValidity([]byte{1})
+	assert.Nil(t, err)
+}
+
+func createMultiSignerWithInvalidShares(invalidIndexes map[int]struct{}, numVerifyCalls *int) *mock.BelNevMock {
+	isIndexInvalid := func(bitmap []byte) bool {
+		for index := range invalidIndexes {
+			if bitmap[index/8]&(1<<uint16(index%8)) > 0 {
+				return true
+			}
+		}
+		return false
+	}
+
+	multiSignerMock := mock.InitMultiSignerMock()
+	multiSignerMock.VerifyMock = func(msg []byte, bitmap []byte) error {
+		*numVerifyCalls++
+		if isIndexInvalid(bitmap) {
+			return crypto.ErrAggSigNotValid
+		}
+		return nil
+	}
+	multiSignerMock.VerifySignatureShareMock = func(index uint16, sig []byte, msg []byte, bitmap []byte) error {
+		_, isInvalid := invalidIndexes[int(index)]
+		if isInvalid {
+			return crypto.ErrSigNotValid
+		}
+		return nil
+	}
+	multiSignerMock.SignatureShareMock = func(index uint16) ([]byte, error) {
+		return []byte("sig share"), nil
+	}
+
+	return multiSignerMock
+}
+
+func TestSubroundEndRound_AggregateSigsAndVerifyAllValidShouldVerifyOnce(t *testing.T) {
+	t.Parallel()
+
+	numVerifyCalls := 0
+	container := mock.InitConsensusCore()
+	multiSignerMock := createMultiSignerWithInvalidShares(make(map[int]struct{}), &numVerifyCalls)
+	multiSignerMock.VerifySignatureShareMock = func(index uint16, sig []byte, msg []byte, bitmap []byte) error {
+		assert.Fail(t, "should have not verified the signature share")
+		return nil
+	}
+	container.SetMultiSigner(multiSignerMock)
+	sr := *initSubroundEndRoundWithContainer(container)
+
+	bitmap := []byte{0xFF, 0x01}
+	resultedBitmap, sig, err := sr.AggregateSigsAndVerify(bitmap)
+	assert.Nil(t, err)
+	assert.Equal(t, bitmap, resultedBitmap)
+	assert.Equal(t, []byte("aggregatedSig"), sig)
+	assert.Equal(t, 1, numVerifyCalls)
+}
+
+func TestSubroundEndRound_AggregateSigsAndVerifyShouldRemoveInvalidShares(t *testing.T) {
+	t.Parallel()
+
+	numVerifyCalls := 0
+	container := mock.InitConsensusCore()
+	invalidIndexes := map[int]struct{}{3: {}, 8: {}}
+	container.SetMultiSigner(createMultiSignerWithInvalidShares(invalidIndexes, &numVerifyCalls))
+	decreasedPeers := make([]string, 0)
+	container.SetPeerHonestyHandler(&testscommon.PeerHonestyHandlerStub{
+		ChangeScoreCalled: func(pk string, topic string, units int) {
+			decreasedPeers = append(decreasedPeers, pk)
+		},
+	})
+	sr := *initSubroundEndRoundWithContainer(container)
+	for _, pk := range sr.ConsensusGroup() {
+		_ = sr.SetJobDone(pk, bls.SrSignature, true)
+	}
+
+	resultedBitmap, _, err := sr.AggregateSigsAndVerify([]byte{0xFF, 0x01})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0xF7, 0x00}, resultedBitmap)
+	assert.Equal(t, []string{sr.ConsensusGroup()[3], sr.ConsensusGroup()[8]}, decreasedPeers)
+	isJobDone, _ := sr.JobDone(sr.ConsensusGroup()[3], bls.SrSignature)
+	assert.False(t, isJobDone)
+}
+
+func TestSubroundEndRound_AggregateSigsAndVerifyNotEnoughValidSharesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	numVerifyCalls := 0
+	container := mock.InitConsensusCore()
+	invalidIndexes := map[int]struct{}{0: {}, 4: {}, 7: {}}
+	container.SetMultiSigner(createMultiSignerWithInvalidShares(invalidIndexes, &numVerifyCalls))
+	sr := *initSubroundEndRoundWithContainer(container)
+
+	resultedBitmap, sig, err := sr.AggregateSigsAndVerify([]byte{0xFF, 0x01})
+	assert.True(t, errors.Is(err, spos.ErrNotEnoughValidSignatureShares))
+	assert.Nil(t, resultedBitmap)
+	assert.Nil(t, sig)
 }
 
 func TestSubroundEndRound_CheckSignaturesValidityShouldReturnNil(t *testing.T) {
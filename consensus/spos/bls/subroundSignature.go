@@ -6,13 +6,15 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
 type subroundSignature struct {
 	*spos.Subround
 
-	appStatusHandler core.AppStatusHandler
+	appStatusHandler  core.AppStatusHandler
+	lastPreparedRound int64
 }
 
 // NewSubroundSignature creates a subroundSignature object
@@ -28,8 +30,9 @@ func NewSubroundSignature(
 	}
 
 	srSignature := subroundSignature{
-		Subround:         baseSubround,
-		appStatusHandler: statusHandler.NewNilStatusHandler(),
+		Subround:          baseSubround,
+		appStatusHandler:  statusHandler.NewNilStatusHandler(),
+		lastPreparedRound: -1,
 	}
 	srSignature.Job = srSignature.doSignatureJob
 	srSignature.Check = srSignature.doSignatureConsensusCheck
@@ -65,6 +68,8 @@ func checkNewSubroundSignatureParams(
 
 // doSignatureJob method does the job of the subround Signature
 func (sr *subroundSignature) doSignatureJob() bool {
+	sr.prepareNextProposalIfNextLeader()
+
 	if !sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
 		return true
 	}
@@ -72,7 +77,7 @@ func (sr *subroundSignature) doSignatureJob() bool {
 		return false
 	}
 
-	signatureShare, err := sr.MultiSigner().CreateSignatureShare(sr.GetData(), nil)
+	signatureShare, err := sr.CreateSignatureShareForKey(sr.SelfPubKey(), sr.GetData())
 	if err != nil {
 		log.Debug("doSignatureJob.CreateSignatureShare", "error", err.Error())
 		return false
@@ -82,29 +87,15 @@ func (sr *subroundSignature) doSignatureJob() bool {
 
 	if !isSelfLeader {
 		//TODO: Analyze it is possible to send message only to leader with O(1) instead of O(n)
-		cnsMsg := consensus.NewConsensusMessage(
-			sr.GetData(),
-			signatureShare,
-			nil,
-			nil,
-			[]byte(sr.SelfPubKey()),
-			nil,
-			int(MtSignature),
-			sr.Rounder().Index(),
-			sr.ChainID(),
-			nil,
-			nil,
-			nil,
-			sr.CurrentPid(),
-		)
-
-		err = sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+		err = sr.broadcastSignatureShare(sr.SelfPubKey(), signatureShare)
 		if err != nil {
 			log.Debug("doSignatureJob.BroadcastConsensusMessage", "error", err.Error())
 			return false
 		}
 
 		log.Debug("step 2: signature has been sent")
+		sr.ManagedPeersHolder().IncrementSignaturesSent([]byte(sr.SelfPubKey()))
+		sr.ParticipationTracker().AddSignatureShareSent(sr.Rounder().Index())
 	}
 
 	err = sr.SetSelfJobDone(sr.Current(), true)
@@ -115,6 +106,8 @@ func (sr *subroundSignature) doSignatureJob() bool {
 		return false
 	}
 
+	sr.doSignatureJobForOtherKeys(isSelfLeader)
+
 	if isSelfLeader {
 		go sr.waitAllSignatures()
 	}
@@ -122,6 +115,98 @@ func (sr *subroundSignature) doSignatureJob() bool {
 	return true
 }
 
+// doSignatureJobForOtherKeys creates the signature shares of the node's keys, other than the one acting in the
+// current round, that were also selected in the consensus group and that the node redundancy handler allows to sign
+// with. The shares are only broadcast if the leader is not one of the node's keys
+func (sr *subroundSignature) doSignatureJobForOtherKeys(isSelfLeader bool) {
+	for _, pubKey := range sr.ConsensusGroup() {
+		isNodeKey := pubKey == sr.NodePubKey()
+		isManagedKey := sr.ManagedPeersHolder().IsKeyManagedByCurrentNode([]byte(pubKey))
+		if pubKey == sr.SelfPubKey() || (!isNodeKey && !isManagedKey) {
+			continue
+		}
+		if !sr.NodeRedundancyHandler().ShouldActInConsensus(pubKey) {
+			continue
+		}
+
+		signatureShare, err := sr.CreateSignatureShareForKey(pubKey, sr.GetData())
+		if err != nil {
+			log.Debug("doSignatureJobForOtherKeys.CreateSignatureShareForKey", "error", err.Error())
+			continue
+		}
+
+		if !isSelfLeader {
+			err = sr.broadcastSignatureShare(pubKey, signatureShare)
+			if err != nil {
+				log.Debug("doSignatureJobForOtherKeys.BroadcastConsensusMessage", "error", err.Error())
+				continue
+			}
+			sr.ManagedPeersHolder().IncrementSignaturesSent([]byte(pubKey))
+			sr.ParticipationTracker().AddSignatureShareSent(sr.Rounder().Index())
+		}
+
+		err = sr.SetJobDone(pubKey, sr.Current(), true)
+		if err != nil {
+			log.Debug("doSignatureJobForOtherKeys.SetJobDone",
+				"subround", sr.Name(),
+				"error", err.Error())
+		}
+	}
+}
+
+func (sr *subroundSignature) broadcastSignatureShare(pubKey string, signatureShare []byte) error {
+	cnsMsg := consensus.NewConsensusMessage(
+		sr.GetData(),
+		signatureShare,
+		nil,
+		nil,
+		[]byte(pubKey),
+		nil,
+		int(MtSignature),
+		sr.Rounder().Index(),
+		sr.ChainID(),
+		nil,
+		nil,
+		nil,
+		sr.CurrentPid(),
+	)
+
+	return sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+}
+
+// prepareNextProposalIfNextLeader computes the consensus group of the next round, assuming the block of the current
+// round will be committed, and, if this node is going to be the next leader, starts preparing its proposal while the
+// current block is still collecting signatures
+func (sr *subroundSignature) prepareNextProposalIfNextLeader() {
+	if check.IfNil(sr.Header) {
+		return
+	}
+
+	currentRound := sr.Rounder().Index()
+	if sr.lastPreparedRound == currentRound {
+		return
+	}
+	sr.lastPreparedRound = currentRound
+
+	nextConsensusGroup, err := sr.GetNextConsensusGroup(
+		sr.Header.GetRandSeed(),
+		uint64(currentRound+1),
+		sr.ShardCoordinator().SelfId(),
+		sr.NodesCoordinator(),
+		sr.Header.GetEpoch(),
+	)
+	if err != nil {
+		log.Debug("prepareNextProposalIfNextLeader.GetNextConsensusGroup", "error", err.Error())
+		return
+	}
+	if len(nextConsensusGroup) == 0 || nextConsensusGroup[0] != sr.SelfPubKey() {
+		return
+	}
+
+	log.Debug("preparing the proposal for the next round", "round", currentRound+1)
+	go sr.ProposalPreparer().PrepareNextProposal()
+}
+
 // receivedSignature method is called when a signature is received through the signature channel.
 // If the signature is valid, than the jobDone map corresponding to the node which sent it,
 // is set on true for the subround Signature
@@ -179,6 +264,7 @@ func (sr *subroundSignature) receivedSignature(cnsDta *consensus.Message) bool {
 			"error", err.Error())
 		return false
 	}
+	sr.StateDebugger().AddCollectedSignature(cnsDta.RoundIndex, node)
 
 	sr.PeerHonestyHandler().ChangeScore(
 		node,
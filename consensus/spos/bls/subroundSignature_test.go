@@ -2,12 +2,15 @@ package bls_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -287,6 +290,147 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 	assert.False(t, sr.RoundCanceled)
 }
 
+func TestSubroundSignature_DoSignatureJobShouldSignWithAllTheKeysOfTheNode(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	consensusGroup := initConsensusState().ConsensusGroup()
+	managedKeys := map[string]struct{}{
+		consensusGroup[3]: {},
+		consensusGroup[5]: {},
+	}
+	signaturesSent := make(map[string]int)
+	container.SetManagedPeersHolder(&testscommon.ManagedPeersHolderStub{
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			_, found := managedKeys[string(pkBytes)]
+			return found
+		},
+		CreateSignatureShareCalled: func(pkBytes []byte, message []byte) ([]byte, error) {
+			return append([]byte("SIG "), pkBytes...), nil
+		},
+		IncrementSignaturesSentCalled: func(pkBytes []byte) {
+			signaturesSent[string(pkBytes)]++
+		},
+	})
+	broadcastPubKeys := make([]string, 0)
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			broadcastPubKeys = append(broadcastPubKeys, string(message.PubKey))
+			return nil
+		},
+	})
+	sr := *initSubroundSignatureWithContainer(container)
+	sr.Data = []byte("X")
+
+	r := sr.DoSignatureJob()
+	assert.True(t, r)
+	assert.Equal(t, []string{consensusGroup[1], consensusGroup[3], consensusGroup[5]}, broadcastPubKeys)
+	assert.Equal(t, map[string]int{consensusGroup[1]: 1, consensusGroup[3]: 1, consensusGroup[5]: 1}, signaturesSent)
+	for _, pubKey := range []string{consensusGroup[1], consensusGroup[3], consensusGroup[5]} {
+		isJobDone, _ := sr.JobDone(pubKey, bls.SrSignature)
+		assert.True(t, isJobDone)
+	}
+	isJobDone, _ := sr.JobDone(consensusGroup[2], bls.SrSignature)
+	assert.False(t, isJobDone)
+}
+
+func TestSubroundSignature_DoSignatureJobShouldNotSignWithTheKeysHeldBackByRedundancy(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	consensusGroup := initConsensusState().ConsensusGroup()
+	container.SetManagedPeersHolder(&testscommon.ManagedPeersHolderStub{
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			return string(pkBytes) == consensusGroup[3] || string(pkBytes) == consensusGroup[5]
+		},
+		CreateSignatureShareCalled: func(pkBytes []byte, message []byte) ([]byte, error) {
+			return append([]byte("SIG "), pkBytes...), nil
+		},
+	})
+	container.SetNodeRedundancyHandler(&testscommon.NodeRedundancyHandlerStub{
+		ShouldActInConsensusCalled: func(pubKey string) bool {
+			return pubKey != consensusGroup[3]
+		},
+	})
+	broadcastPubKeys := make([]string, 0)
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			broadcastPubKeys = append(broadcastPubKeys, string(message.PubKey))
+			return nil
+		},
+	})
+	sr := *initSubroundSignatureWithContainer(container)
+	sr.Data = []byte("X")
+
+	r := sr.DoSignatureJob()
+	assert.True(t, r)
+	assert.Equal(t, []string{consensusGroup[1], consensusGroup[5]}, broadcastPubKeys)
+	isJobDone, _ := sr.JobDone(consensusGroup[3], bls.SrSignature)
+	assert.False(t, isJobDone)
+}
+
+func TestSubroundSignature_DoSignatureJobShouldPrepareTheNextProposalOnlyForTheNextLeader(t *testing.T) {
+	t.Parallel()
+
+	chPrepared := make(chan struct{}, 10)
+	container := mock.InitConsensusCore()
+	container.SetProposalPreparer(&testscommon.ProposalPreparerStub{
+		PrepareNextProposalCalled: func() {
+			chPrepared <- struct{}{}
+		},
+	})
+	container.SetValidatorGroupSelector(&mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]sharding.Validator, error) {
+			assert.Equal(t, []byte("rand seed"), randomness)
+			assert.Equal(t, uint64(container.Rounder().Index()+1), round)
+			assert.Equal(t, uint32(2), epoch)
+
+			return []sharding.Validator{mock.NewValidator([]byte("B"), 1, 1)}, nil
+		},
+	})
+	sr := *initSubroundSignatureWithContainer(container)
+
+	sr.Header = nil
+	_ = sr.DoSignatureJob()
+	assert.Equal(t, 0, len(chPrepared))
+
+	sr.Header = &block.Header{RandSeed: []byte("rand seed"), Epoch: 2}
+	sr.SetSelfPubKey("A")
+	_ = sr.DoSignatureJob()
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, 0, len(chPrepared))
+
+	sr = *initSubroundSignatureWithContainer(container)
+	sr.Header = &block.Header{RandSeed: []byte("rand seed"), Epoch: 2}
+	sr.SetSelfPubKey("B")
+	_ = sr.DoSignatureJob()
+	select {
+	case <-chPrepared:
+	case <-time.After(time.Second):
+		assert.Fail(t, "the next proposal should have been prepared")
+	}
+}
+
+func TestSubroundSignature_DoSignatureJobShouldPrepareTheNextProposalOncePerRound(t *testing.T) {
+	t.Parallel()
+
+	chPrepared := make(chan struct{}, 10)
+	container := mock.InitConsensusCore()
+	container.SetProposalPreparer(&testscommon.ProposalPreparerStub{
+		PrepareNextProposalCalled: func() {
+			chPrepared <- struct{}{}
+		},
+	})
+	sr := *initSubroundSignatureWithContainer(container)
+	sr.Header = &block.Header{}
+	sr.SetSelfPubKey("A")
+
+	_ = sr.DoSignatureJob()
+	_ = sr.DoSignatureJob()
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, 1, len(chPrepared))
+}
+
 func TestSubroundSignature_ReceivedSignature(t *testing.T) {
 	t.Parallel()
 
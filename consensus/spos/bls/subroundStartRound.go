@@ -19,6 +19,7 @@ type subroundStartRound struct {
 	processingThresholdPercentage int
 	executeStoredMessages         func()
 	resetConsensusMessages        func()
+	adjustSubroundsDeadlines      func(round int64)
 
 	indexer indexer.Indexer
 }
@@ -43,6 +44,7 @@ func NewSubroundStartRound(
 		processingThresholdPercentage: processingThresholdPercentage,
 		executeStoredMessages:         executeStoredMessages,
 		resetConsensusMessages:        resetConsensusMessages,
+		adjustSubroundsDeadlines:      func(_ int64) {},
 		indexer:                       indexer.NewNilIndexer(),
 	}
 	srStartRound.Job = srStartRound.doStartRoundJob
@@ -73,11 +75,22 @@ func (sr *subroundStartRound) SetIndexer(indexer indexer.Indexer) {
 	sr.indexer = indexer
 }
 
+// SetSubroundsDeadlinesAdjuster method sets the function called at the start of each round in order to adjust the
+// deadlines of the following subrounds
+func (sr *subroundStartRound) SetSubroundsDeadlinesAdjuster(adjustSubroundsDeadlines func(round int64)) {
+	if adjustSubroundsDeadlines == nil {
+		return
+	}
+
+	sr.adjustSubroundsDeadlines = adjustSubroundsDeadlines
+}
+
 // doStartRoundJob method does the job of the subround StartRound
 func (sr *subroundStartRound) doStartRoundJob() bool {
 	sr.ResetConsensusState()
 	sr.RoundIndex = sr.Rounder().Index()
 	sr.RoundTimeStamp = sr.Rounder().TimeStamp()
+	sr.adjustSubroundsDeadlines(sr.RoundIndex)
 	topic := spos.GetConsensusTopicID(sr.ShardCoordinator())
 	sr.GetAntiFloodHandler().ResetForTopic(topic)
 	sr.resetConsensusMessages()
@@ -129,6 +142,24 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 		return false
 	}
 
+	nodeRedundancyHandler := sr.NodeRedundancyHandler()
+	nodeRedundancyHandler.AdjustInactivityIfNeeded(sr.NodePubKey(), sr.ConsensusGroup(), sr.Rounder().Index())
+	selfPubKey, canActInConsensus := sr.selectSelfPubKeyForRound(leader)
+	if !canActInConsensus {
+		log.Debug("not acting in consensus",
+			"is redundancy node", nodeRedundancyHandler.IsRedundancyNode(),
+			"is main machine active", nodeRedundancyHandler.IsMainMachineActive(sr.NodePubKey()),
+			"is fenced", nodeRedundancyHandler.IsFenced(sr.NodePubKey()),
+			"is observing", nodeRedundancyHandler.IsObserving(sr.NodePubKey()))
+		sr.AppStatusHandler().SetStringValue(core.MetricConsensusState, "redundancy standby")
+
+		return false
+	}
+
+	sr.SetSelfPubKeyForRound(selfPubKey)
+	// binds the signature shares of this round to its slot, so a remote signer refuses a second header for it
+	sr.SigningSlotHandler().SetSigningSlot(sr.getCurrentEpoch(), uint64(sr.Rounder().Index()))
+
 	msg := ""
 	if leader == sr.SelfPubKey() {
 		sr.AppStatusHandler().Increment(core.MetricCountLeader)
@@ -142,11 +173,17 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 		"messsage", msg)
 
 	pubKeys := sr.ConsensusGroup()
+	sr.StateDebugger().StartRound(sr.Rounder().Index(), pubKeys, leader)
+	sr.ParticipationTracker().StartRound(
+		sr.getCurrentEpoch(),
+		sr.Rounder().Index(),
+		leader == sr.SelfPubKey(),
+		sr.IsNodeInConsensusGroup(sr.SelfPubKey()),
+	)
 
 	sr.indexRoundIfNeeded(pubKeys)
 
-	selfIndex, err := sr.SelfConsensusGroupIndex()
-	if err != nil {
+	if !sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
 		log.Debug("not in consensus group")
 		sr.AppStatusHandler().SetStringValue(core.MetricConsensusState, "not in consensus group")
 	} else {
@@ -156,7 +193,9 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 		sr.AppStatusHandler().SetStringValue(core.MetricConsensusState, "participant")
 	}
 
-	err = sr.MultiSigner().Reset(pubKeys, uint16(selfIndex))
+	// the multi signer creates the signature shares of the node's own key while the managed keys' shares are stored in it
+	nodeIndex, _ := sr.ConsensusGroupIndex(sr.NodePubKey())
+	err = sr.MultiSigner().Reset(pubKeys, uint16(nodeIndex))
 	if err != nil {
 		log.Debug("initCurrentRound.Reset", "error", err.Error())
 
@@ -185,6 +224,50 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 	return true
 }
 
+// selectSelfPubKeyForRound returns the key which acts in consensus in the current round, among the node's keys the
+// node redundancy handler allows to sign with: the leader's key if managed by this node, then the node's own key if
+// it is in the consensus group, then the first managed key from the consensus group. The node's own key is returned
+// if none of its keys are in the consensus group. The other keys of the node found in the consensus group only send
+// their signature shares. It returns false if the node should not act in the round, as none of its keys may sign
+func (sr *subroundStartRound) selectSelfPubKeyForRound(leader string) (string, bool) {
+	managedPeersHolder := sr.ManagedPeersHolder()
+	nodeRedundancyHandler := sr.NodeRedundancyHandler()
+	selfPubKey := ""
+	isAnyManagedKeyInConsensusGroup := false
+	for _, pubKey := range sr.ConsensusGroup() {
+		if !managedPeersHolder.IsKeyManagedByCurrentNode([]byte(pubKey)) {
+			continue
+		}
+
+		isAnyManagedKeyInConsensusGroup = true
+		managedPeersHolder.IncrementRoundsInConsensus([]byte(pubKey))
+		if len(selfPubKey) == 0 && nodeRedundancyHandler.ShouldActInConsensus(pubKey) {
+			selfPubKey = pubKey
+		}
+	}
+
+	if managedPeersHolder.IsKeyManagedByCurrentNode([]byte(leader)) {
+		managedPeersHolder.IncrementRoundsAsLeader([]byte(leader))
+		if nodeRedundancyHandler.ShouldActInConsensus(leader) {
+			return leader, true
+		}
+	}
+
+	canNodeKeyAct := nodeRedundancyHandler.ShouldActInConsensus(sr.NodePubKey())
+	isNodeKeyInConsensusGroup := sr.IsNodeInConsensusGroup(sr.NodePubKey())
+	if isNodeKeyInConsensusGroup && canNodeKeyAct {
+		return sr.NodePubKey(), true
+	}
+	if len(selfPubKey) > 0 {
+		return selfPubKey, true
+	}
+	if isNodeKeyInConsensusGroup || isAnyManagedKeyInConsensusGroup {
+		return "", false
+	}
+
+	return sr.NodePubKey(), canNodeKeyAct
+}
+
 func (sr *subroundStartRound) indexRoundIfNeeded(pubKeys []string) {
 	if check.IfNil(sr.indexer) {
 		return
@@ -232,6 +315,18 @@ func (sr *subroundStartRound) indexRoundIfNeeded(pubKeys []string) {
 	sr.indexer.SaveRoundsInfo([]workItems.RoundInfo{roundInfo})
 }
 
+func (sr *subroundStartRound) getCurrentEpoch() uint32 {
+	currentHeader := sr.Blockchain().GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		currentHeader = sr.Blockchain().GetGenesisHeader()
+		if check.IfNil(currentHeader) {
+			return 0
+		}
+	}
+
+	return currentHeader.GetEpoch()
+}
+
 func (sr *subroundStartRound) generateNextConsensusGroup(roundIndex int64) error {
 	currentHeader := sr.Blockchain().GetCurrentBlockHeader()
 	if check.IfNil(currentHeader) {
@@ -267,6 +362,7 @@ func (sr *subroundStartRound) generateNextConsensusGroup(roundIndex int64) error
 	}
 
 	sr.SetConsensusGroup(nextConsensusGroup)
+	sr.SetConsensusGroupRound(sr.RoundIndex)
 
 	return nil
 }
@@ -9,7 +9,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -238,6 +241,23 @@ func TestSubroundStartRound_DoStartRoundShouldReturnTrue(t *testing.T) {
 	assert.True(t, r)
 }
 
+func TestSubroundStartRound_DoStartRoundJobShouldAdjustSubroundsDeadlines(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetRounder(&mock.RounderMock{RoundIndex: 5})
+	srStartRound := *initSubroundStartRoundWithContainer(container)
+
+	adjustedRound := int64(-1)
+	srStartRound.SetSubroundsDeadlinesAdjuster(func(round int64) {
+		adjustedRound = round
+	})
+
+	r := srStartRound.DoStartRoundJob()
+	assert.True(t, r)
+	assert.Equal(t, int64(5), adjustedRound)
+}
+
 func TestSubroundStartRound_DoStartRoundConsensusCheckShouldReturnFalseWhenRoundIsCanceled(t *testing.T) {
 	t.Parallel()
 
@@ -383,6 +403,36 @@ func TestSubroundStartRound_InitCurrentRoundShouldReturnFalseWhenCreateErr(t *te
 	assert.False(t, r)
 }
 
+func TestSubroundStartRound_InitCurrentRoundShouldReturnFalseWhenTheNodeShouldNotActInConsensus(t *testing.T) {
+	t.Parallel()
+
+	adjustedRound := int64(-1)
+	multiSignerResetCalled := false
+	multiSignerMock := mock.InitMultiSignerMock()
+	multiSignerMock.ResetCalled = func(pubKeys []string, index uint16) error {
+		multiSignerResetCalled = true
+		return nil
+	}
+
+	container := mock.InitConsensusCore()
+	container.SetMultiSigner(multiSignerMock)
+	container.SetNodeRedundancyHandler(&testscommon.NodeRedundancyHandlerStub{
+		AdjustInactivityIfNeededCalled: func(selfPubKey string, consensusPubKeys []string, roundIndex int64) {
+			adjustedRound = roundIndex
+		},
+		ShouldActInConsensusCalled: func(pubKey string) bool {
+			return false
+		},
+	})
+
+	srStartRound := *initSubroundStartRoundWithContainer(container)
+
+	r := srStartRound.InitCurrentRound()
+	assert.False(t, r)
+	assert.Equal(t, container.Rounder().Index(), adjustedRound)
+	assert.False(t, multiSignerResetCalled)
+}
+
 func TestSubroundStartRound_InitCurrentRoundShouldReturnFalseWhenTimeIsOut(t *testing.T) {
 	t.Parallel()
 
@@ -419,6 +469,141 @@ func TestSubroundStartRound_InitCurrentRoundShouldReturnTrue(t *testing.T) {
 	assert.True(t, r)
 }
 
+func TestSubroundStartRound_InitCurrentRoundShouldSetTheSigningSlot(t *testing.T) {
+	t.Parallel()
+
+	setEpoch := uint32(0)
+	setRound := uint64(0)
+	container := mock.InitConsensusCore()
+	container.SetBlockchain(&mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Epoch: 7}
+		},
+	})
+	container.SetSigningSlotHandler(&testscommon.SigningSlotHandlerStub{
+		SetSigningSlotCalled: func(epoch uint32, round uint64) {
+			setEpoch = epoch
+			setRound = round
+		},
+	})
+
+	srStartRound := *initSubroundStartRoundWithContainer(container)
+
+	r := srStartRound.InitCurrentRound()
+	assert.True(t, r)
+	assert.Equal(t, uint32(7), setEpoch)
+	assert.Equal(t, uint64(container.Rounder().Index()), setRound)
+}
+
+func TestSubroundStartRound_InitCurrentRoundShouldSelectTheKeyActingInTheRound(t *testing.T) {
+	t.Parallel()
+
+	testSelectedKey := func(nodePubKey string, managedKeys []string, expectedSelfPubKey string, expectedLeaderKeys []string) {
+		managed := make(map[string]struct{})
+		for _, pubKey := range managedKeys {
+			managed[pubKey] = struct{}{}
+		}
+		roundsInConsensus := make([]string, 0)
+		roundsAsLeader := make([]string, 0)
+
+		container := mock.InitConsensusCore()
+		container.SetValidatorGroupSelector(&mock.NodesCoordinatorMock{
+			ComputeValidatorsGroupCalled: func(_ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+				return []sharding.Validator{
+					mock.NewValidator([]byte("A"), 1, 1),
+					mock.NewValidator([]byte("B"), 1, 1),
+					mock.NewValidator([]byte("C"), 1, 1),
+					mock.NewValidator([]byte("D"), 1, 1),
+				}, nil
+			},
+		})
+		container.SetManagedPeersHolder(&testscommon.ManagedPeersHolderStub{
+			IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+				_, found := managed[string(pkBytes)]
+				return found
+			},
+			IncrementRoundsInConsensusCalled: func(pkBytes []byte) {
+				roundsInConsensus = append(roundsInConsensus, string(pkBytes))
+			},
+			IncrementRoundsAsLeaderCalled: func(pkBytes []byte) {
+				roundsAsLeader = append(roundsAsLeader, string(pkBytes))
+			},
+		})
+		consensusState := initConsensusState()
+		consensusState.SetSelfPubKey(nodePubKey)
+		sr, _ := defaultSubround(consensusState, make(chan bool, 1), container)
+		srStartRound := *defaultWithoutErrorSubroundStartRoundFromSubround(sr)
+
+		r := srStartRound.InitCurrentRound()
+		assert.True(t, r)
+		assert.Equal(t, expectedSelfPubKey, srStartRound.SelfPubKey())
+		assert.Equal(t, nodePubKey, srStartRound.NodePubKey())
+		assert.Equal(t, managedKeys, roundsInConsensus)
+		assert.Equal(t, expectedLeaderKeys, roundsAsLeader)
+	}
+
+	// no managed keys, the node's own key acts in the round
+	testSelectedKey("B", []string{}, "B", []string{})
+	testSelectedKey("Z", []string{}, "Z", []string{})
+	// the leader's key is preferred
+	testSelectedKey("B", []string{"A", "C"}, "A", []string{"A"})
+	// then the node's own key
+	testSelectedKey("B", []string{"C", "D"}, "B", []string{})
+	// then the first managed key
+	testSelectedKey("Z", []string{"C", "D"}, "C", []string{})
+}
+
+func TestSubroundStartRound_InitCurrentRoundShouldSelectOnlyTheKeysAllowedToSign(t *testing.T) {
+	t.Parallel()
+
+	testSelectedKey := func(heldBackKeys []string, expectedSelfPubKey string, expectedCanAct bool) {
+		heldBack := make(map[string]struct{})
+		for _, pubKey := range heldBackKeys {
+			heldBack[pubKey] = struct{}{}
+		}
+
+		container := mock.InitConsensusCore()
+		container.SetValidatorGroupSelector(&mock.NodesCoordinatorMock{
+			ComputeValidatorsGroupCalled: func(_ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+				return []sharding.Validator{
+					mock.NewValidator([]byte("A"), 1, 1),
+					mock.NewValidator([]byte("B"), 1, 1),
+					mock.NewValidator([]byte("C"), 1, 1),
+					mock.NewValidator([]byte("D"), 1, 1),
+				}, nil
+			},
+		})
+		container.SetManagedPeersHolder(&testscommon.ManagedPeersHolderStub{
+			IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+				return string(pkBytes) == "A" || string(pkBytes) == "C"
+			},
+		})
+		container.SetNodeRedundancyHandler(&testscommon.NodeRedundancyHandlerStub{
+			ShouldActInConsensusCalled: func(pubKey string) bool {
+				_, isHeldBack := heldBack[pubKey]
+				return !isHeldBack
+			},
+		})
+		consensusState := initConsensusState()
+		consensusState.SetSelfPubKey("B")
+		sr, _ := defaultSubround(consensusState, make(chan bool, 1), container)
+		srStartRound := *defaultWithoutErrorSubroundStartRoundFromSubround(sr)
+
+		r := srStartRound.InitCurrentRound()
+		assert.Equal(t, expectedCanAct, r)
+		if expectedCanAct {
+			assert.Equal(t, expectedSelfPubKey, srStartRound.SelfPubKey())
+		}
+	}
+
+	// the managed leader key is held back, the node's own key acts
+	testSelectedKey([]string{"A"}, "B", true)
+	// the node's own key is also held back, the next managed key acts
+	testSelectedKey([]string{"A", "B"}, "C", true)
+	// all the node's keys are held back, the node does not act in the round
+	testSelectedKey([]string{"A", "B", "C"}, "", false)
+}
+
 func TestSubroundStartRound_GenerateNextConsensusGroupShouldReturnErr(t *testing.T) {
 	t.Parallel()
 
@@ -34,6 +34,14 @@ type ConsensusCore struct {
 	peerHonestyHandler            consensus.PeerHonestyHandler
 	headerSigVerifier             consensus.HeaderSigVerifier
 	fallbackHeaderValidator       consensus.FallbackHeaderValidator
+	partitionStatusHandler        consensus.PartitionStatusHandler
+	subroundsTimingHandler        consensus.SubroundsTimingHandler
+	proposalPreparer              process.ProposalPreparer
+	stateDebugger                 consensus.StateDebugger
+	managedPeersHolder            consensus.ManagedPeersHolder
+	nodeRedundancyHandler         consensus.NodeRedundancyHandler
+	participationTracker          consensus.ParticipationTracker
+	signingSlotHandler            crypto.SigningSlotHandler
 }
 
 // ConsensusCoreArgs store all arguments that are needed to create a ConsensusCore object
@@ -57,6 +65,14 @@ type ConsensusCoreArgs struct {
 	PeerHonestyHandler            consensus.PeerHonestyHandler
 	HeaderSigVerifier             consensus.HeaderSigVerifier
 	FallbackHeaderValidator       consensus.FallbackHeaderValidator
+	PartitionStatusHandler        consensus.PartitionStatusHandler
+	SubroundsTimingHandler        consensus.SubroundsTimingHandler
+	ProposalPreparer              process.ProposalPreparer
+	StateDebugger                 consensus.StateDebugger
+	ManagedPeersHolder            consensus.ManagedPeersHolder
+	NodeRedundancyHandler         consensus.NodeRedundancyHandler
+	ParticipationTracker          consensus.ParticipationTracker
+	SigningSlotHandler            crypto.SigningSlotHandler
 }
 
 // NewConsensusCore creates a new ConsensusCore instance
@@ -83,6 +99,14 @@ func NewConsensusCore(
 		peerHonestyHandler:            args.PeerHonestyHandler,
 		headerSigVerifier:             args.HeaderSigVerifier,
 		fallbackHeaderValidator:       args.FallbackHeaderValidator,
+		partitionStatusHandler:        args.PartitionStatusHandler,
+		subroundsTimingHandler:        args.SubroundsTimingHandler,
+		proposalPreparer:              args.ProposalPreparer,
+		stateDebugger:                 args.StateDebugger,
+		managedPeersHolder:            args.ManagedPeersHolder,
+		nodeRedundancyHandler:         args.NodeRedundancyHandler,
+		participationTracker:          args.ParticipationTracker,
+		signingSlotHandler:            args.SigningSlotHandler,
 	}
 
 	err := ValidateConsensusCore(consensusCore)
@@ -188,6 +212,46 @@ func (cc *ConsensusCore) FallbackHeaderValidator() consensus.FallbackHeaderValid
 	return cc.fallbackHeaderValidator
 }
 
+// PartitionStatusHandler will return the partition status handler which will be used in subrounds
+func (cc *ConsensusCore) PartitionStatusHandler() consensus.PartitionStatusHandler {
+	return cc.partitionStatusHandler
+}
+
+// SubroundsTimingHandler will return the subrounds timing handler which will be used in subrounds
+func (cc *ConsensusCore) SubroundsTimingHandler() consensus.SubroundsTimingHandler {
+	return cc.subroundsTimingHandler
+}
+
+// ProposalPreparer will return the component able to prepare ahead of time the next block proposal
+func (cc *ConsensusCore) ProposalPreparer() process.ProposalPreparer {
+	return cc.proposalPreparer
+}
+
+// StateDebugger will return the component recording the consensus activity of the last rounds
+func (cc *ConsensusCore) StateDebugger() consensus.StateDebugger {
+	return cc.stateDebugger
+}
+
+// ManagedPeersHolder will return the component holding the validator keys operated by the current node
+func (cc *ConsensusCore) ManagedPeersHolder() consensus.ManagedPeersHolder {
+	return cc.managedPeersHolder
+}
+
+// NodeRedundancyHandler will return the component deciding if the node can act in consensus when running with backups
+func (cc *ConsensusCore) NodeRedundancyHandler() consensus.NodeRedundancyHandler {
+	return cc.nodeRedundancyHandler
+}
+
+// ParticipationTracker will return the component recording the node's own participation in consensus
+func (cc *ConsensusCore) ParticipationTracker() consensus.ParticipationTracker {
+	return cc.participationTracker
+}
+
+// SigningSlotHandler will return the component holding the consensus slot the node currently signs for
+func (cc *ConsensusCore) SigningSlotHandler() crypto.SigningSlotHandler {
+	return cc.signingSlotHandler
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (cc *ConsensusCore) IsInterfaceNil() bool {
 	return cc == nil
@@ -61,6 +61,30 @@ func ValidateConsensusCore(container ConsensusCoreHandler) error {
 	if check.IfNil(container.FallbackHeaderValidator()) {
 		return ErrNilFallbackHeaderValidator
 	}
+	if check.IfNil(container.PartitionStatusHandler()) {
+		return ErrNilPartitionStatusHandler
+	}
+	if check.IfNil(container.SubroundsTimingHandler()) {
+		return ErrNilSubroundsTimingHandler
+	}
+	if check.IfNil(container.ProposalPreparer()) {
+		return ErrNilProposalPreparer
+	}
+	if check.IfNil(container.StateDebugger()) {
+		return ErrNilStateDebugger
+	}
+	if check.IfNil(container.ManagedPeersHolder()) {
+		return ErrNilManagedPeersHolder
+	}
+	if check.IfNil(container.NodeRedundancyHandler()) {
+		return ErrNilNodeRedundancyHandler
+	}
+	if check.IfNil(container.ParticipationTracker()) {
+		return ErrNilParticipationTracker
+	}
+	if check.IfNil(container.SigningSlotHandler()) {
+		return ErrNilSigningSlotHandler
+	}
 
 	return nil
 }
@@ -31,6 +31,14 @@ func createDefaultConsensusCoreArgs() *spos.ConsensusCoreArgs {
 		PeerHonestyHandler:            consensusCoreMock.PeerHonestyHandler(),
 		HeaderSigVerifier:             consensusCoreMock.HeaderSigVerifier(),
 		FallbackHeaderValidator:       consensusCoreMock.FallbackHeaderValidator(),
+		PartitionStatusHandler:        consensusCoreMock.PartitionStatusHandler(),
+		SubroundsTimingHandler:        consensusCoreMock.SubroundsTimingHandler(),
+		ProposalPreparer:              consensusCoreMock.ProposalPreparer(),
+		StateDebugger:                 consensusCoreMock.StateDebugger(),
+		ManagedPeersHolder:            consensusCoreMock.ManagedPeersHolder(),
+		NodeRedundancyHandler:         consensusCoreMock.NodeRedundancyHandler(),
+		ParticipationTracker:          consensusCoreMock.ParticipationTracker(),
+		SigningSlotHandler:            consensusCoreMock.SigningSlotHandler(),
 	}
 	return args
 }
@@ -285,6 +293,118 @@ func TestConsensusCore_WithNilFallbackHeaderValidatorShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilFallbackHeaderValidator, err)
 }
 
+func TestConsensusCore_WithNilPartitionStatusHandlerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.PartitionStatusHandler = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilPartitionStatusHandler, err)
+}
+
+func TestConsensusCore_WithNilSubroundsTimingHandlerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.SubroundsTimingHandler = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilSubroundsTimingHandler, err)
+}
+
+func TestConsensusCore_WithNilProposalPreparerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.ProposalPreparer = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilProposalPreparer, err)
+}
+
+func TestConsensusCore_WithNilStateDebuggerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.StateDebugger = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilStateDebugger, err)
+}
+
+func TestConsensusCore_WithNilManagedPeersHolderShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.ManagedPeersHolder = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilManagedPeersHolder, err)
+}
+
+func TestConsensusCore_WithNilNodeRedundancyHandlerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.NodeRedundancyHandler = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilNodeRedundancyHandler, err)
+}
+
+func TestConsensusCore_WithNilParticipationTrackerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.ParticipationTracker = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilParticipationTracker, err)
+}
+
+func TestConsensusCore_WithNilSigningSlotHandlerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.SigningSlotHandler = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilSigningSlotHandler, err)
+}
+
 func TestConsensusCore_CreateConsensusCoreShouldWork(t *testing.T) {
 	t.Parallel()
 
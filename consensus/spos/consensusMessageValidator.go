@@ -12,6 +12,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
+// maxProposerIndex is the highest index in the consensus group of a node allowed to send the proposer's messages, as
+// the next validator in the consensus group can act as the fallback leader of the round
+const maxProposerIndex = 1
+
 type consensusMessageValidator struct {
 	consensusState       *ConsensusState
 	consensusService     ConsensusService
@@ -133,6 +137,11 @@ func (cmv *consensusMessageValidator) checkConsensusMessageValidity(cnsMsg *cons
 			cnsMsg.RoundIndex)
 	}
 
+	err = cmv.checkMessageSender(cnsMsg)
+	if err != nil {
+		return err
+	}
+
 	if cmv.isMessageTypeLimitReached(cnsMsg.PubKey, cnsMsg.RoundIndex, msgType) {
 		log.Trace("received message type from consensus topic reached the limit",
 			"msg type", cmv.consensusService.GetStringValue(msgType),
@@ -163,6 +172,36 @@ func (cmv *consensusMessageValidator) checkConsensusMessageValidity(cnsMsg *cons
 	return nil
 }
 
+// checkMessageSender rejects, before any signature verification, the messages sent by nodes outside the consensus group
+// of the message round and the proposer's messages sent by nodes that can not be leaders in that round. The check is
+// done only if the consensus group of the message round was already computed
+func (cmv *consensusMessageValidator) checkMessageSender(cnsMsg *consensus.Message) error {
+	index, isConsensusGroupKnown := cmv.consensusState.ConsensusGroupIndexForRound(string(cnsMsg.PubKey), cnsMsg.RoundIndex)
+	if !isConsensusGroupKnown {
+		return nil
+	}
+
+	if index < 0 {
+		return fmt.Errorf("%w : received message from consensus topic has a public key outside the consensus group: %s",
+			ErrNodeIsNotInConsensusGroup,
+			logger.DisplayByteSlice(cnsMsg.PubKey))
+	}
+
+	msgType := consensus.MessageType(cnsMsg.MsgType)
+	isProposerMessage := cmv.consensusService.IsMessageWithBlockBodyAndHeader(msgType) ||
+		cmv.consensusService.IsMessageWithBlockBody(msgType) ||
+		cmv.consensusService.IsMessageWithBlockHeader(msgType) ||
+		cmv.consensusService.IsMessageWithFinalInfo(msgType)
+	if isProposerMessage && index > maxProposerIndex {
+		return fmt.Errorf("%w : received message type %s from consensus topic has the public key: %s",
+			ErrNodeIsNotProposer,
+			cmv.consensusService.GetStringValue(msgType),
+			logger.DisplayByteSlice(cnsMsg.PubKey))
+	}
+
+	return nil
+}
+
 func (cmv *consensusMessageValidator) isBlockHeaderHashSizeValid(cnsMsg *consensus.Message) bool {
 	msgType := consensus.MessageType(cnsMsg.MsgType)
 	isMessageWithBlockBody := cmv.consensusService.IsMessageWithBlockBody(msgType)
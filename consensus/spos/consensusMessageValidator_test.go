@@ -592,6 +592,86 @@ func TestCheckConsensusMessageValidity_Ok(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestCheckConsensusMessageValidity_NodeIsNotInConsensusGroupShouldErr(t *testing.T) {
+	t.Parallel()
+
+	consensusMessageValidatorArgs := createDefaultConsensusMessageValidatorArgs()
+	consensusState := consensusMessageValidatorArgs.ConsensusState
+	consensusState.RoundIndex = 10
+	pubKey := []byte(consensusState.ConsensusGroup()[3])
+	consensusState.SetConsensusGroup(consensusState.ConsensusGroup()[:3])
+	consensusState.SetConsensusGroupRound(10)
+	cmv, _ := spos.NewConsensusMessageValidator(consensusMessageValidatorArgs)
+
+	headerBytes := make([]byte, 100)
+	_, _ = rand.Read(headerBytes)
+	headerHash := make([]byte, consensusMessageValidatorArgs.HasherSize)
+	_, _ = rand.Read(headerHash)
+	sig := make([]byte, SignatureSize)
+	_, _ = rand.Read(sig)
+
+	cnsMsg := &consensus.Message{
+		ChainID: chainID, MsgType: int64(bls.MtBlockBodyAndHeader),
+		Header: headerBytes, BlockHeaderHash: headerHash, PubKey: pubKey, Signature: sig, RoundIndex: 10,
+	}
+	err := cmv.CheckConsensusMessageValidity(cnsMsg, "")
+	assert.True(t, errors.Is(err, spos.ErrNodeIsNotInConsensusGroup))
+}
+
+func TestCheckConsensusMessageValidity_ProposerMessageFromNodeThatCanNotLeadShouldErr(t *testing.T) {
+	t.Parallel()
+
+	consensusMessageValidatorArgs := createDefaultConsensusMessageValidatorArgs()
+	consensusState := consensusMessageValidatorArgs.ConsensusState
+	consensusState.RoundIndex = 10
+	consensusState.SetConsensusGroupRound(10)
+	cmv, _ := spos.NewConsensusMessageValidator(consensusMessageValidatorArgs)
+
+	headerBytes := make([]byte, 100)
+	_, _ = rand.Read(headerBytes)
+	headerHash := make([]byte, consensusMessageValidatorArgs.HasherSize)
+	_, _ = rand.Read(headerHash)
+	pubKey := []byte(consensusState.ConsensusGroup()[2])
+	sig := make([]byte, SignatureSize)
+	_, _ = rand.Read(sig)
+
+	cnsMsg := &consensus.Message{
+		ChainID: chainID, MsgType: int64(bls.MtBlockBodyAndHeader),
+		Header: headerBytes, BlockHeaderHash: headerHash, PubKey: pubKey, Signature: sig, RoundIndex: 10,
+	}
+	err := cmv.CheckConsensusMessageValidity(cnsMsg, "")
+	assert.True(t, errors.Is(err, spos.ErrNodeIsNotProposer))
+
+	cnsMsg.PubKey = []byte(consensusState.ConsensusGroup()[1])
+	err = cmv.CheckConsensusMessageValidity(cnsMsg, "")
+	assert.Nil(t, err)
+}
+
+func TestCheckConsensusMessageValidity_UnknownConsensusGroupShouldNotCheckTheSender(t *testing.T) {
+	t.Parallel()
+
+	consensusMessageValidatorArgs := createDefaultConsensusMessageValidatorArgs()
+	consensusState := consensusMessageValidatorArgs.ConsensusState
+	consensusState.RoundIndex = 10
+	consensusState.SetConsensusGroupRound(9)
+	cmv, _ := spos.NewConsensusMessageValidator(consensusMessageValidatorArgs)
+
+	headerBytes := make([]byte, 100)
+	_, _ = rand.Read(headerBytes)
+	headerHash := make([]byte, consensusMessageValidatorArgs.HasherSize)
+	_, _ = rand.Read(headerHash)
+	pubKey := []byte(consensusState.ConsensusGroup()[2])
+	sig := make([]byte, SignatureSize)
+	_, _ = rand.Read(sig)
+
+	cnsMsg := &consensus.Message{
+		ChainID: chainID, MsgType: int64(bls.MtBlockBodyAndHeader),
+		Header: headerBytes, BlockHeaderHash: headerHash, PubKey: pubKey, Signature: sig, RoundIndex: 10,
+	}
+	err := cmv.CheckConsensusMessageValidity(cnsMsg, "")
+	assert.Nil(t, err)
+}
+
 func TestIsMessageTypeLimitReached_ShouldWork(t *testing.T) {
 	t.Parallel()
 
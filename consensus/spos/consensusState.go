@@ -7,6 +7,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
@@ -33,6 +34,8 @@ type ConsensusState struct {
 	processingBlock    bool
 	mutProcessingBlock sync.RWMutex
 
+	fallbackLeaderActive atomic.Flag
+
 	*roundConsensus
 	*roundThreshold
 	*roundStatus
@@ -67,6 +70,7 @@ func (cns *ConsensusState) ResetConsensusState() {
 	cns.RoundCanceled = false
 	cns.ExtendedCalled = false
 	cns.WaitingAllSignaturesTimeOut = false
+	cns.fallbackLeaderActive.Unset()
 
 	cns.ResetRoundStatus()
 	cns.ResetRoundState()
@@ -120,9 +124,30 @@ func (cns *ConsensusState) GetLeader() (string, error) {
 		return "", ErrEmptyConsensusGroup
 	}
 
+	if cns.fallbackLeaderActive.IsSet() && len(cns.consensusGroup) > 1 {
+		return cns.consensusGroup[1], nil
+	}
+
 	return cns.consensusGroup[0], nil
 }
 
+// ActivateFallbackLeader makes the next validator in the consensus group the leader of the current round. Returns
+// false if the consensus group does not contain a fallback leader
+func (cns *ConsensusState) ActivateFallbackLeader() bool {
+	if len(cns.consensusGroup) < 2 {
+		return false
+	}
+
+	cns.fallbackLeaderActive.Set()
+
+	return true
+}
+
+// IsFallbackLeaderActive returns true if the fallback leader took over the current round
+func (cns *ConsensusState) IsFallbackLeaderActive() bool {
+	return cns.fallbackLeaderActive.IsSet()
+}
+
 // GetNextConsensusGroup gets the new consensus group for the current round based on current eligible list and a random
 // source for the new selection
 func (cns *ConsensusState) GetNextConsensusGroup(
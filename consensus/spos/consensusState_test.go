@@ -141,6 +141,36 @@ func TestConsensusState_GetLeaderShouldWork(t *testing.T) {
 	assert.Equal(t, cns.ConsensusGroup()[0], leader)
 }
 
+func TestConsensusState_GetLeaderWithFallbackLeaderActiveShouldReturnTheSecondValidator(t *testing.T) {
+	t.Parallel()
+
+	cns := internalInitConsensusState()
+
+	assert.False(t, cns.IsFallbackLeaderActive())
+	assert.True(t, cns.ActivateFallbackLeader())
+	assert.True(t, cns.IsFallbackLeaderActive())
+
+	leader, err := cns.GetLeader()
+	assert.Nil(t, err)
+	assert.Equal(t, cns.ConsensusGroup()[1], leader)
+	assert.True(t, cns.IsSelfLeaderInCurrentRound())
+
+	cns.ResetConsensusState()
+	assert.False(t, cns.IsFallbackLeaderActive())
+	leader, _ = cns.GetLeader()
+	assert.Equal(t, cns.ConsensusGroup()[0], leader)
+}
+
+func TestConsensusState_ActivateFallbackLeaderWithoutSecondValidatorShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	cns := internalInitConsensusState()
+	cns.SetConsensusGroup([]string{"1"})
+
+	assert.False(t, cns.ActivateFallbackLeader())
+	assert.False(t, cns.IsFallbackLeaderActive())
+}
+
 func TestConsensusState_GetNextConsensusGroupShouldFailWhenComputeValidatorsGroupErr(t *testing.T) {
 	t.Parallel()
 
@@ -213,3 +213,42 @@ var ErrMessageTypeLimitReached = errors.New("consensus message type limit has be
 
 // ErrNilFallbackHeaderValidator signals that a nil fallback header validator has been provided
 var ErrNilFallbackHeaderValidator = errors.New("nil fallback header validator")
+
+// ErrNilPartitionStatusHandler signals that a nil partition status handler has been provided
+var ErrNilPartitionStatusHandler = errors.New("nil partition status handler")
+
+// ErrNilSubroundsTimingHandler signals that a nil subrounds timing handler has been provided
+var ErrNilSubroundsTimingHandler = errors.New("nil subrounds timing handler")
+
+// ErrInvalidFallbackLeaderTimeoutPercent signals that an invalid fallback leader timeout percent has been provided
+var ErrInvalidFallbackLeaderTimeoutPercent = errors.New("invalid fallback leader timeout percent")
+
+// ErrNilProposalPreparer signals that a nil proposal preparer has been provided
+var ErrNilProposalPreparer = errors.New("nil proposal preparer")
+
+// ErrNilStateDebugger signals that a nil consensus state debugger has been provided
+var ErrNilStateDebugger = errors.New("nil consensus state debugger")
+
+// ErrNilManagedPeersHolder signals that a nil managed peers holder has been provided
+var ErrNilManagedPeersHolder = errors.New("nil managed peers holder")
+
+// ErrNotEnoughValidSignatureShares signals that the number of valid signature shares is under the threshold
+var ErrNotEnoughValidSignatureShares = errors.New("not enough valid signature shares")
+
+// ErrNilEquivocationDetector signals that a nil equivocation detector has been provided
+var ErrNilEquivocationDetector = errors.New("nil equivocation detector")
+
+// ErrNilNodeRedundancyHandler signals that a nil node redundancy handler has been provided
+var ErrNilNodeRedundancyHandler = errors.New("nil node redundancy handler")
+
+// ErrNilParticipationTracker signals that a nil participation tracker has been provided
+var ErrNilParticipationTracker = errors.New("nil participation tracker")
+
+// ErrNilSigningSlotHandler signals that a nil signing slot handler has been provided
+var ErrNilSigningSlotHandler = errors.New("nil signing slot handler")
+
+// ErrNodeIsNotInConsensusGroup is raised when a node is not in the consensus group of the message round
+var ErrNodeIsNotInConsensusGroup = errors.New("node is not in the consensus group")
+
+// ErrNodeIsNotProposer is raised when a message that only the leader can send is received from another node
+var ErrNodeIsNotProposer = errors.New("node is not the proposer of the round")
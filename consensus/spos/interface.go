@@ -54,6 +54,22 @@ type ConsensusCoreHandler interface {
 	HeaderSigVerifier() consensus.HeaderSigVerifier
 	// FallbackHeaderValidator returns the fallback header validator handler which will be used in subrounds
 	FallbackHeaderValidator() consensus.FallbackHeaderValidator
+	// PartitionStatusHandler returns the partition status handler which will be used in subrounds
+	PartitionStatusHandler() consensus.PartitionStatusHandler
+	// SubroundsTimingHandler returns the subrounds timing handler which will be used in subrounds
+	SubroundsTimingHandler() consensus.SubroundsTimingHandler
+	// ProposalPreparer returns the component able to prepare ahead of time the next block proposal
+	ProposalPreparer() process.ProposalPreparer
+	// StateDebugger returns the component recording the consensus activity of the last rounds
+	StateDebugger() consensus.StateDebugger
+	// ManagedPeersHolder returns the component holding the validator keys operated by the current node
+	ManagedPeersHolder() consensus.ManagedPeersHolder
+	// NodeRedundancyHandler returns the component deciding if the node can act in consensus when running with backups
+	NodeRedundancyHandler() consensus.NodeRedundancyHandler
+	// ParticipationTracker returns the component recording the node's own participation in consensus
+	ParticipationTracker() consensus.ParticipationTracker
+	// SigningSlotHandler returns the component holding the consensus slot the node currently signs for
+	SigningSlotHandler() crypto.SigningSlotHandler
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
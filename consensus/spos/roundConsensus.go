@@ -9,8 +9,10 @@ type roundConsensus struct {
 	eligibleNodes        map[string]struct{}
 	mutEligible          sync.RWMutex
 	consensusGroup       []string
+	consensusGroupRound  int64
 	consensusGroupSize   int
 	selfPubKey           string
+	nodePubKey           string
 	validatorRoundStates map[string]*roundState
 	mut                  sync.RWMutex
 }
@@ -23,10 +25,12 @@ func NewRoundConsensus(
 ) *roundConsensus {
 
 	rcns := roundConsensus{
-		eligibleNodes:      eligibleNodes,
-		consensusGroupSize: consensusGroupSize,
-		selfPubKey:         selfId,
-		mutEligible:        sync.RWMutex{},
+		eligibleNodes:       eligibleNodes,
+		consensusGroupSize:  consensusGroupSize,
+		consensusGroupRound: -1,
+		selfPubKey:          selfId,
+		nodePubKey:          selfId,
+		mutEligible:         sync.RWMutex{},
 	}
 
 	rcns.validatorRoundStates = make(map[string]*roundState)
@@ -63,10 +67,11 @@ func (rcns *roundConsensus) ConsensusGroup() []string {
 
 // SetConsensusGroup sets the consensus group ID's
 func (rcns *roundConsensus) SetConsensusGroup(consensusGroup []string) {
-	rcns.consensusGroup = consensusGroup
-
 	rcns.mut.Lock()
 
+	rcns.consensusGroup = consensusGroup
+	rcns.consensusGroupRound = -1
+
 	rcns.validatorRoundStates = make(map[string]*roundState)
 
 	for i := 0; i < len(consensusGroup); i++ {
@@ -76,6 +81,33 @@ func (rcns *roundConsensus) SetConsensusGroup(consensusGroup []string) {
 	rcns.mut.Unlock()
 }
 
+// SetConsensusGroupRound marks the current consensus group as the one computed for the provided round
+func (rcns *roundConsensus) SetConsensusGroupRound(round int64) {
+	rcns.mut.Lock()
+	rcns.consensusGroupRound = round
+	rcns.mut.Unlock()
+}
+
+// ConsensusGroupIndexForRound returns the index of the given public key in the consensus group computed for the
+// provided round or -1 if the public key is not in the group. The returned flag is false if the consensus group of
+// the provided round is not known yet
+func (rcns *roundConsensus) ConsensusGroupIndexForRound(pubKey string, round int64) (int, bool) {
+	rcns.mut.RLock()
+	defer rcns.mut.RUnlock()
+
+	if round != rcns.consensusGroupRound {
+		return -1, false
+	}
+
+	for i, pk := range rcns.consensusGroup {
+		if pk == pubKey {
+			return i, true
+		}
+	}
+
+	return -1, true
+}
+
 // ConsensusGroupSize returns the consensus group size
 func (rcns *roundConsensus) ConsensusGroupSize() int {
 	return rcns.consensusGroupSize
@@ -91,9 +123,22 @@ func (rcns *roundConsensus) SelfPubKey() string {
 	return rcns.selfPubKey
 }
 
-// SetSelfPubKey sets selfPubKey ID
+// SetSelfPubKey sets selfPubKey ID, which also becomes the node's own key
 func (rcns *roundConsensus) SetSelfPubKey(selfPubKey string) {
 	rcns.selfPubKey = selfPubKey
+	rcns.nodePubKey = selfPubKey
+}
+
+// NodePubKey returns the node's own key, which might differ from selfPubKey in the rounds where the node acts in
+// consensus with one of its managed keys
+func (rcns *roundConsensus) NodePubKey() string {
+	return rcns.nodePubKey
+}
+
+// SetSelfPubKeyForRound sets the key acting in consensus in the current round, either the node's own key or one of
+// its managed keys
+func (rcns *roundConsensus) SetSelfPubKeyForRound(selfPubKey string) {
+	rcns.selfPubKey = selfPubKey
 }
 
 // JobDone returns the state of the action done, by the node represented by the key parameter,
@@ -144,6 +144,31 @@ func TestRoundConsensus_SetConsensusGroupShouldChangeTheConsensusGroup(t *testin
 	assert.Equal(t, "6", rcns.ConsensusGroup()[2])
 }
 
+func TestRoundConsensus_ConsensusGroupIndexForRound(t *testing.T) {
+	t.Parallel()
+
+	rcns := *initRoundConsensus()
+
+	_, isConsensusGroupKnown := rcns.ConsensusGroupIndexForRound("3", 5)
+	assert.False(t, isConsensusGroupKnown)
+
+	rcns.SetConsensusGroupRound(5)
+	index, isConsensusGroupKnown := rcns.ConsensusGroupIndexForRound("3", 5)
+	assert.True(t, isConsensusGroupKnown)
+	assert.Equal(t, 2, index)
+
+	index, isConsensusGroupKnown = rcns.ConsensusGroupIndexForRound("4", 5)
+	assert.True(t, isConsensusGroupKnown)
+	assert.Equal(t, -1, index)
+
+	_, isConsensusGroupKnown = rcns.ConsensusGroupIndexForRound("3", 6)
+	assert.False(t, isConsensusGroupKnown)
+
+	rcns.SetConsensusGroup([]string{"4", "5", "6"})
+	_, isConsensusGroupKnown = rcns.ConsensusGroupIndexForRound("4", 5)
+	assert.False(t, isConsensusGroupKnown)
+}
+
 func TestRoundConsensus_SetConsensusGroupSizeShouldChangeTheConsensusGroupSize(t *testing.T) {
 	t.Parallel()
 
@@ -163,6 +188,17 @@ func TestRoundConsensus_SetSelfPubKeyShouldChangeTheSelfPubKey(t *testing.T) {
 	assert.Equal(t, "X", rcns.SelfPubKey())
 }
 
+func TestRoundConsensus_SetSelfPubKeyForRoundShouldNotChangeTheNodePubKey(t *testing.T) {
+	t.Parallel()
+
+	rcns := *initRoundConsensus()
+
+	rcns.SetSelfPubKey("X")
+	rcns.SetSelfPubKeyForRound("Y")
+	assert.Equal(t, "Y", rcns.SelfPubKey())
+	assert.Equal(t, "X", rcns.NodePubKey())
+}
+
 func TestRoundConsensus_GetJobDoneShouldReturnsFalseWhenValidatorIsNotInTheConsensusGroup(t *testing.T) {
 	t.Parallel()
 
@@ -24,6 +24,8 @@ func GetSubroundsFactory(
 	indexer indexer.Indexer,
 	chainID []byte,
 	currentPid core.PeerID,
+	fallbackLeaderTimeoutPercent uint32,
+	fallbackLeaderEnableEpoch uint32,
 ) (spos.SubroundsFactory, error) {
 	switch consensusType {
 	case blsConsensusType:
@@ -45,6 +47,11 @@ func GetSubroundsFactory(
 
 		subRoundFactoryBls.SetIndexer(indexer)
 
+		err = subRoundFactoryBls.SetFallbackLeader(fallbackLeaderTimeoutPercent, fallbackLeaderEnableEpoch)
+		if err != nil {
+			return nil, err
+		}
+
 		return subRoundFactoryBls, nil
 	default:
 		return nil, ErrInvalidConsensusType
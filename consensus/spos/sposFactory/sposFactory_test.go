@@ -1,6 +1,7 @@
 package sposFactory_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
@@ -49,6 +50,8 @@ func TestGetSubroundsFactory_BlsNilConsensusCoreShouldErr(t *testing.T) {
 		indexer,
 		chainID,
 		currentPid,
+		0,
+		0,
 	)
 
 	assert.Nil(t, sf)
@@ -72,6 +75,8 @@ func TestGetSubroundsFactory_BlsNilStatusHandlerShouldErr(t *testing.T) {
 		indexer,
 		chainID,
 		currentPid,
+		0,
+		0,
 	)
 
 	assert.Nil(t, sf)
@@ -96,11 +101,39 @@ func TestGetSubroundsFactory_BlsShouldWork(t *testing.T) {
 		indexer,
 		chainID,
 		currentPid,
+		0,
+		0,
 	)
 	assert.Nil(t, err)
 	assert.False(t, check.IfNil(sf))
 }
 
+func TestGetSubroundsFactory_BlsInvalidFallbackLeaderTimeoutPercentShouldErr(t *testing.T) {
+	t.Parallel()
+
+	consensusCore := mock.InitConsensusCore()
+	worker := &mock.SposWorkerMock{}
+	consensusType := consensus.BlsConsensusType
+	statusHandler := &mock.AppStatusHandlerMock{}
+	chainID := []byte("chain-id")
+	indexer := &mock.IndexerMock{}
+	sf, err := sposFactory.GetSubroundsFactory(
+		consensusCore,
+		&spos.ConsensusState{},
+		worker,
+		consensusType,
+		statusHandler,
+		indexer,
+		chainID,
+		currentPid,
+		90,
+		0,
+	)
+
+	assert.Nil(t, sf)
+	assert.True(t, errors.Is(err, spos.ErrInvalidFallbackLeaderTimeoutPercent))
+}
+
 func TestGetSubroundsFactory_InvalidConsensusTypeShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -114,6 +147,8 @@ func TestGetSubroundsFactory_InvalidConsensusTypeShouldErr(t *testing.T) {
 		nil,
 		nil,
 		currentPid,
+		0,
+		0,
 	)
 
 	assert.Nil(t, sf)
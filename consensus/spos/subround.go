@@ -5,7 +5,9 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
@@ -19,22 +21,24 @@ type Subround struct {
 	ConsensusCoreHandler
 	*ConsensusState
 
-	previous   int
-	current    int
-	next       int
-	startTime  int64
-	endTime    int64
-	name       string
-	chainID    []byte
-	currentPid core.PeerID
+	previous     int
+	current      int
+	next         int
+	startTime    atomic.Int64
+	endTime      atomic.Int64
+	fallbackTime atomic.Int64
+	name         string
+	chainID      []byte
+	currentPid   core.PeerID
 
 	consensusStateChangedChannel chan bool
 	executeStoredMessages        func()
 	appStatusHandler             core.AppStatusHandler
 
-	Job    func() bool          // method does the Subround Job and send the result to the peers
-	Check  func() bool          // method checks if the consensus of the Subround is done
-	Extend func(subroundId int) // method is called when round time is out
+	Job      func() bool          // method does the Subround Job and send the result to the peers
+	Check    func() bool          // method checks if the consensus of the Subround is done
+	Extend   func(subroundId int) // method is called when round time is out
+	Fallback func()               // method is called when the fallback time is reached and the consensus is not done
 }
 
 // NewSubround creates a new SubroundId object
@@ -69,8 +73,6 @@ func NewSubround(
 		previous:                     previous,
 		current:                      current,
 		next:                         next,
-		startTime:                    startTime,
-		endTime:                      endTime,
 		name:                         name,
 		chainID:                      chainID,
 		consensusStateChangedChannel: consensusStateChangedChannel,
@@ -78,10 +80,14 @@ func NewSubround(
 		Job:                          nil,
 		Check:                        nil,
 		Extend:                       nil,
+		Fallback:                     nil,
 		appStatusHandler:             statusHandler.NewNilStatusHandler(),
 		currentPid:                   currentPid,
 	}
 
+	sr.startTime.Set(startTime)
+	sr.endTime.Set(endTime)
+
 	return &sr, nil
 }
 
@@ -113,8 +119,9 @@ func checkNewSubroundParams(
 }
 
 // DoWork method actually does the work of this Subround. First it tries to do the Job of the Subround then it will
-// Check the consensus. If the upper time limit of this Subround is reached, the Extend method will be called before
-// returning. If this method returns true the chronology will advance to the next Subround.
+// Check the consensus. If the fallback time of this Subround is reached, the Fallback method will be called once before
+// checking the consensus again. If the upper time limit of this Subround is reached, the Extend method will be called
+// before returning. If this method returns true the chronology will advance to the next Subround.
 func (sr *Subround) DoWork(rounder consensus.Rounder) bool {
 	if sr.Job == nil || sr.Check == nil {
 		return false
@@ -125,6 +132,19 @@ func (sr *Subround) DoWork(rounder consensus.Rounder) bool {
 
 	startTime := rounder.TimeStamp()
 	maxTime := rounder.TimeDuration() * MaxThresholdPercent / 100
+	roundIndex := rounder.Index()
+	sr.StateDebugger().SetSubround(roundIndex, sr.name)
+
+	subroundStartTime := time.Now()
+	defer func() {
+		sr.ParticipationTracker().AddSubroundDuration(sr.name, time.Since(subroundStartTime))
+	}()
+
+	var fallbackChannel <-chan time.Time
+	fallbackTime := time.Duration(sr.fallbackTime.Get())
+	if sr.Fallback != nil && fallbackTime > 0 {
+		fallbackChannel = time.After(rounder.RemainingTime(startTime, fallbackTime))
+	}
 
 	sr.Job()
 	if sr.Check() {
@@ -137,7 +157,14 @@ func (sr *Subround) DoWork(rounder consensus.Rounder) bool {
 			if sr.Check() {
 				return true
 			}
+		case <-fallbackChannel:
+			fallbackChannel = nil
+			sr.Fallback()
+			if sr.Check() {
+				return true
+			}
 		case <-time.After(rounder.RemainingTime(startTime, maxTime)):
+			sr.StateDebugger().AddSubroundTimeout(roundIndex, sr.name)
 			if sr.Extend != nil {
 				sr.RoundCanceled = true
 				sr.Extend(sr.current)
@@ -165,12 +192,33 @@ func (sr *Subround) Next() int {
 
 // StartTime method returns the start time of the Subround
 func (sr *Subround) StartTime() int64 {
-	return sr.startTime
+	return sr.startTime.Get()
+}
+
+// SetStartTime method sets the start time of the Subround
+func (sr *Subround) SetStartTime(startTime int64) {
+	sr.startTime.Set(startTime)
 }
 
 // EndTime method returns the upper time limit of the Subround
 func (sr *Subround) EndTime() int64 {
-	return sr.endTime
+	return sr.endTime.Get()
+}
+
+// SetEndTime method sets the upper time limit of the Subround
+func (sr *Subround) SetEndTime(endTime int64) {
+	sr.endTime.Set(endTime)
+}
+
+// FallbackTime method returns the moment, measured from the round start, when the Fallback method is called. The
+// value 0 means that the Fallback method is never called
+func (sr *Subround) FallbackTime() int64 {
+	return sr.fallbackTime.Get()
+}
+
+// SetFallbackTime method sets the moment, measured from the round start, when the Fallback method is called
+func (sr *Subround) SetFallbackTime(fallbackTime int64) {
+	sr.fallbackTime.Set(fallbackTime)
 }
 
 // Name method returns the name of the Subround
@@ -208,6 +256,41 @@ func (sr *Subround) ConsensusChannel() chan bool {
 	return sr.consensusStateChangedChannel
 }
 
+// SelfPrivateKey returns the private key of the key acting in consensus in the current round, which is either the
+// node's own key or one of its managed keys
+func (sr *Subround) SelfPrivateKey() (crypto.PrivateKey, error) {
+	if sr.SelfPubKey() == sr.NodePubKey() {
+		return sr.PrivateKey(), nil
+	}
+
+	return sr.ManagedPeersHolder().GetPrivateKey([]byte(sr.SelfPubKey()))
+}
+
+// CreateSignatureShareForKey creates the signature share of the provided key, either the node's own key or one of
+// its managed keys, over the provided message and stores it in the multi signer
+func (sr *Subround) CreateSignatureShareForKey(pubKey string, message []byte) ([]byte, error) {
+	if pubKey == sr.NodePubKey() {
+		return sr.MultiSigner().CreateSignatureShare(message, nil)
+	}
+
+	index, err := sr.ConsensusGroupIndex(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureShare, err := sr.ManagedPeersHolder().CreateSignatureShare([]byte(pubKey), message)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sr.MultiSigner().StoreSignatureShare(uint16(index), signatureShare)
+	if err != nil {
+		return nil, err
+	}
+
+	return signatureShare, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sr *Subround) IsInterfaceNil() bool {
 	return sr == nil
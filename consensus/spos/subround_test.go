@@ -9,6 +9,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -659,6 +661,160 @@ func testDoWork(t *testing.T, checkDone bool, shouldWork bool) {
 	assert.Equal(t, shouldWork, r)
 }
 
+func TestSubround_DoWorkShouldRecordTheSubroundAndTheTimeoutInTheStateDebugger(t *testing.T) {
+	t.Parallel()
+
+	consensusState := initConsensusState()
+	ch := make(chan bool, 1)
+	container := mock.InitConsensusCore()
+	subrounds := make([]string, 0)
+	timeouts := make([]string, 0)
+	container.SetStateDebugger(&testscommon.StateDebuggerStub{
+		SetSubroundCalled: func(round int64, subround string) {
+			assert.Equal(t, int64(7), round)
+			subrounds = append(subrounds, subround)
+		},
+		AddSubroundTimeoutCalled: func(round int64, subround string) {
+			assert.Equal(t, int64(7), round)
+			timeouts = append(timeouts, subround)
+		},
+	})
+
+	sr, _ := spos.NewSubround(
+		-1,
+		bls.SrStartRound,
+		bls.SrBlock,
+		int64(0*roundTimeDuration/100),
+		int64(5*roundTimeDuration/100),
+		"(START_ROUND)",
+		consensusState,
+		ch,
+		executeStoredMessages,
+		container,
+		chainID,
+		currentPid,
+	)
+	sr.Job = func() bool {
+		return true
+	}
+	sr.Check = func() bool {
+		return false
+	}
+
+	rounderMock := &mock.RounderMock{RoundIndex: 7}
+	rounderMock.RemainingTimeCalled = func(time.Time, time.Duration) time.Duration {
+		return 0
+	}
+
+	r := sr.DoWork(rounderMock)
+	assert.False(t, r)
+	assert.Equal(t, []string{"(START_ROUND)"}, subrounds)
+	assert.Equal(t, []string{"(START_ROUND)"}, timeouts)
+}
+
+func TestSubround_DoWorkShouldRecordTheSubroundDurationInTheParticipationTracker(t *testing.T) {
+	t.Parallel()
+
+	consensusState := initConsensusState()
+	ch := make(chan bool, 1)
+	container := mock.InitConsensusCore()
+	subrounds := make([]string, 0)
+	container.SetParticipationTracker(&testscommon.ParticipationTrackerStub{
+		AddSubroundDurationCalled: func(subround string, duration time.Duration) {
+			assert.True(t, duration >= 0)
+			subrounds = append(subrounds, subround)
+		},
+	})
+
+	sr, _ := spos.NewSubround(
+		-1,
+		bls.SrStartRound,
+		bls.SrBlock,
+		int64(0*roundTimeDuration/100),
+		int64(5*roundTimeDuration/100),
+		"(START_ROUND)",
+		consensusState,
+		ch,
+		executeStoredMessages,
+		container,
+		chainID,
+		currentPid,
+	)
+	sr.Job = func() bool {
+		return true
+	}
+	sr.Check = func() bool {
+		return true
+	}
+
+	r := sr.DoWork(&mock.RounderMock{RoundIndex: 7})
+	assert.True(t, r)
+	assert.Equal(t, []string{"(START_ROUND)"}, subrounds)
+}
+
+func createSubroundForManagedKeys(container *mock.ConsensusCoreMock) *spos.Subround {
+	sr, _ := spos.NewSubround(
+		bls.SrBlock,
+		bls.SrSignature,
+		bls.SrEndRound,
+		int64(40*roundTimeDuration/100),
+		int64(85*roundTimeDuration/100),
+		"(SIGNATURE)",
+		initConsensusState(),
+		make(chan bool, 1),
+		executeStoredMessages,
+		container,
+		chainID,
+		currentPid,
+	)
+
+	return sr
+}
+
+func TestSubround_SelfPrivateKeyShouldReturnTheKeyActingInTheRound(t *testing.T) {
+	t.Parallel()
+
+	managedPrivateKey := &mock.PrivateKeyMock{}
+	container := mock.InitConsensusCore()
+	container.SetManagedPeersHolder(&testscommon.ManagedPeersHolderStub{
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			return managedPrivateKey, nil
+		},
+	})
+	sr := createSubroundForManagedKeys(container)
+
+	privateKey, err := sr.SelfPrivateKey()
+	assert.Nil(t, err)
+	assert.True(t, privateKey == container.PrivateKey())
+
+	sr.SetSelfPubKeyForRound(sr.ConsensusGroup()[2])
+	privateKey, err = sr.SelfPrivateKey()
+	assert.Nil(t, err)
+	assert.True(t, privateKey == managedPrivateKey)
+}
+
+func TestSubround_CreateSignatureShareForManagedKeyShouldStoreItInTheMultiSigner(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	managedShare := []byte("managed share")
+	container.SetManagedPeersHolder(&testscommon.ManagedPeersHolderStub{
+		CreateSignatureShareCalled: func(pkBytes []byte, message []byte) ([]byte, error) {
+			return managedShare, nil
+		},
+	})
+	multiSigner := mock.InitMultiSignerMock()
+	container.SetMultiSigner(multiSigner)
+	sr := createSubroundForManagedKeys(container)
+
+	share, err := sr.CreateSignatureShareForKey(sr.ConsensusGroup()[2], []byte("message"))
+	assert.Nil(t, err)
+	assert.Equal(t, managedShare, share)
+
+	storedShare, _ := multiSigner.SignatureShare(2)
+	assert.Equal(t, managedShare, storedShare)
+}
+
 func TestSubround_DoWorkShouldReturnTrueWhenJobIsDoneAndConsensusIsDoneAfterAWhile(t *testing.T) {
 	t.Parallel()
 
@@ -716,6 +872,102 @@ func TestSubround_DoWorkShouldReturnTrueWhenJobIsDoneAndConsensusIsDoneAfterAWhi
 	assert.True(t, r)
 }
 
+func TestSubround_DoWorkShouldCallFallbackAtTheFallbackTime(t *testing.T) {
+	t.Parallel()
+
+	consensusState := initConsensusState()
+	ch := make(chan bool, 1)
+	container := mock.InitConsensusCore()
+
+	sr, _ := spos.NewSubround(
+		bls.SrStartRound,
+		bls.SrBlock,
+		bls.SrSignature,
+		int64(5*roundTimeDuration/100),
+		int64(25*roundTimeDuration/100),
+		"(BLOCK)",
+		consensusState,
+		ch,
+		executeStoredMessages,
+		container,
+		chainID,
+		currentPid,
+	)
+	sr.SetFallbackTime(int64(40 * roundTimeDuration / 100))
+
+	numFallbackCalls := 0
+	sr.Job = func() bool {
+		return true
+	}
+	sr.Check = func() bool {
+		return numFallbackCalls > 0
+	}
+	sr.Fallback = func() {
+		numFallbackCalls++
+	}
+
+	fallbackTime := time.Now().Add(100 * time.Millisecond)
+	maxTime := time.Now().Add(2000 * time.Millisecond)
+	rounderMock := &mock.RounderMock{}
+	rounderMock.RemainingTimeCalled = func(_ time.Time, duration time.Duration) time.Duration {
+		if duration == 40*roundTimeDuration/100 {
+			return time.Until(fallbackTime)
+		}
+		return time.Until(maxTime)
+	}
+
+	r := sr.DoWork(rounderMock)
+
+	assert.True(t, r)
+	assert.Equal(t, 1, numFallbackCalls)
+	assert.True(t, time.Now().Before(maxTime))
+}
+
+func TestSubround_DoWorkWithoutFallbackTimeShouldNotCallFallback(t *testing.T) {
+	t.Parallel()
+
+	consensusState := initConsensusState()
+	ch := make(chan bool, 1)
+	container := mock.InitConsensusCore()
+
+	sr, _ := spos.NewSubround(
+		bls.SrStartRound,
+		bls.SrBlock,
+		bls.SrSignature,
+		int64(5*roundTimeDuration/100),
+		int64(25*roundTimeDuration/100),
+		"(BLOCK)",
+		consensusState,
+		ch,
+		executeStoredMessages,
+		container,
+		chainID,
+		currentPid,
+	)
+
+	fallbackCalled := false
+	sr.Job = func() bool {
+		return true
+	}
+	sr.Check = func() bool {
+		return false
+	}
+	sr.Fallback = func() {
+		fallbackCalled = true
+	}
+
+	maxTime := time.Now().Add(100 * time.Millisecond)
+	rounderMock := &mock.RounderMock{}
+	rounderMock.RemainingTimeCalled = func(time.Time, time.Duration) time.Duration {
+		return time.Until(maxTime)
+	}
+
+	r := sr.DoWork(rounderMock)
+
+	assert.False(t, r)
+	assert.False(t, fallbackCalled)
+}
+
 func TestSubround_Previous(t *testing.T) {
 	t.Parallel()
 
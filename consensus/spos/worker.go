@@ -65,8 +65,12 @@ type Worker struct {
 	receivedHeadersHandlers   []func(headerHandler data.HeaderHandler)
 	mutReceivedHeadersHandler sync.RWMutex
 
-	antifloodHandler consensus.P2PAntifloodHandler
-	poolAdder        PoolAdder
+	antifloodHandler     consensus.P2PAntifloodHandler
+	poolAdder            PoolAdder
+	equivocationDetector consensus.EquivocationDetector
+	stateDebugger        consensus.StateDebugger
+	managedPeersHolder   consensus.ManagedPeersHolder
+	nodeRedundancy       consensus.NodeRedundancyHandler
 
 	cancelFunc                func()
 	consensusMessageValidator *consensusMessageValidator
@@ -93,6 +97,10 @@ type WorkerArgs struct {
 	NetworkShardingCollector consensus.NetworkShardingCollector
 	AntifloodHandler         consensus.P2PAntifloodHandler
 	PoolAdder                PoolAdder
+	EquivocationDetector     consensus.EquivocationDetector
+	StateDebugger            consensus.StateDebugger
+	ManagedPeersHolder       consensus.ManagedPeersHolder
+	NodeRedundancyHandler    consensus.NodeRedundancyHandler
 	SignatureSize            int
 	PublicKeySize            int
 }
@@ -139,6 +147,10 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 		networkShardingCollector: args.NetworkShardingCollector,
 		antifloodHandler:         args.AntifloodHandler,
 		poolAdder:                args.PoolAdder,
+		equivocationDetector:     args.EquivocationDetector,
+		stateDebugger:            args.StateDebugger,
+		managedPeersHolder:       args.ManagedPeersHolder,
+		nodeRedundancy:           args.NodeRedundancyHandler,
 	}
 
 	wrk.consensusMessageValidator = consensusMessageValidatorObj
@@ -227,6 +239,18 @@ func checkNewWorkerParams(args *WorkerArgs) error {
 	if check.IfNil(args.PoolAdder) {
 		return ErrNilPoolAdder
 	}
+	if check.IfNil(args.EquivocationDetector) {
+		return ErrNilEquivocationDetector
+	}
+	if check.IfNil(args.StateDebugger) {
+		return ErrNilStateDebugger
+	}
+	if check.IfNil(args.ManagedPeersHolder) {
+		return ErrNilManagedPeersHolder
+	}
+	if check.IfNil(args.NodeRedundancyHandler) {
+		return ErrNilNodeRedundancyHandler
+	}
 
 	return nil
 }
@@ -354,6 +378,8 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 	}
 
 	wrk.updateNetworkShardingVals(message, cnsMsg)
+	wrk.nodeRedundancy.ResetInactivityIfNeeded(wrk.consensusState.NodePubKey(), string(cnsMsg.PubKey), message.Peer())
+	wrk.stateDebugger.AddReceivedMessage(cnsMsg.RoundIndex, cnsMsg.PubKey, wrk.consensusService.GetStringValue(msgType))
 
 	isMessageWithBlockBody := wrk.consensusService.IsMessageWithBlockBody(msgType)
 	isMessageWithBlockHeader := wrk.consensusService.IsMessageWithBlockHeader(msgType)
@@ -368,10 +394,13 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 		if err != nil {
 			return err
 		}
+
+		wrk.equivocationDetector.ProcessProposal(cnsMsg)
 	}
 
 	if wrk.consensusService.IsMessageWithSignature(msgType) {
 		wrk.doJobOnMessageWithSignature(cnsMsg)
+		wrk.equivocationDetector.ProcessSignature(cnsMsg)
 	}
 
 	errNotCritical := wrk.checkSelfState(cnsMsg)
@@ -392,6 +421,8 @@ func (wrk *Worker) shouldBlacklistPeer(err error) bool {
 		errors.Is(err, ErrMessageForPastRound) ||
 		errors.Is(err, ErrMessageForFutureRound) ||
 		errors.Is(err, ErrNodeIsNotInEligibleList) ||
+		errors.Is(err, ErrNodeIsNotInConsensusGroup) ||
+		errors.Is(err, ErrNodeIsNotProposer) ||
 		errors.Is(err, crypto.ErrPIDMismatch) ||
 		errors.Is(err, crypto.ErrSignatureMismatch) ||
 		errors.Is(err, sharding.ErrEpochNodesConfigDoesNotExist) ||
@@ -430,6 +461,11 @@ func (wrk *Worker) doJobOnMessageWithHeader(cnsMsg *consensus.Message) error {
 		return fmt.Errorf("%w : verify header integrity from consensus topic failed", err)
 	}
 
+	err = wrk.checkHeaderStructure(header, cnsMsg)
+	if err != nil {
+		return err
+	}
+
 	err = wrk.headerSigVerifier.VerifyRandSeed(header)
 	if err != nil {
 		return fmt.Errorf("%w : verify rand seed for received header from consensus topic failed",
@@ -449,6 +485,24 @@ func (wrk *Worker) doJobOnMessageWithHeader(cnsMsg *consensus.Message) error {
 	return nil
 }
 
+// checkHeaderStructure does the cheap checks on the proposed header, before its random seed is verified
+func (wrk *Worker) checkHeaderStructure(header data.HeaderHandler, cnsMsg *consensus.Message) error {
+	if header.GetShardID() != wrk.shardCoordinator.SelfId() {
+		return fmt.Errorf("%w : received header from consensus topic is for shard %d",
+			ErrInvalidHeader,
+			header.GetShardID())
+	}
+
+	if header.GetRound() != uint64(cnsMsg.RoundIndex) {
+		return fmt.Errorf("%w : received header from consensus topic has round %d while the message round is %d",
+			ErrInvalidHeader,
+			header.GetRound(),
+			cnsMsg.RoundIndex)
+	}
+
+	return nil
+}
+
 func (wrk *Worker) doJobOnMessageWithSignature(cnsMsg *consensus.Message) {
 	wrk.mutDisplayHashConsensusMessage.Lock()
 	defer wrk.mutDisplayHashConsensusMessage.Unlock()
@@ -492,6 +546,12 @@ func (wrk *Worker) checkSelfState(cnsDta *consensus.Message) error {
 	if wrk.consensusState.SelfPubKey() == string(cnsDta.PubKey) {
 		return ErrMessageFromItself
 	}
+	if wrk.consensusState.NodePubKey() == string(cnsDta.PubKey) {
+		return ErrMessageFromItself
+	}
+	if wrk.managedPeersHolder.IsKeyManagedByCurrentNode(cnsDta.PubKey) {
+		return ErrMessageFromItself
+	}
 
 	if wrk.consensusState.RoundCanceled && wrk.consensusState.RoundIndex == cnsDta.RoundIndex {
 		return ErrRoundCanceled
@@ -93,6 +93,10 @@ func createDefaultWorkerArgs() *spos.WorkerArgs {
 		NetworkShardingCollector: createMockNetworkShardingCollector(),
 		AntifloodHandler:         createMockP2PAntifloodHandler(),
 		PoolAdder:                poolAdder,
+		EquivocationDetector:     &testscommon.EquivocationDetectorStub{},
+		StateDebugger:            &testscommon.StateDebuggerStub{},
+		ManagedPeersHolder:       &testscommon.ManagedPeersHolderStub{},
+		NodeRedundancyHandler:    &testscommon.NodeRedundancyHandlerStub{},
 		SignatureSize:            SignatureSize,
 		PublicKeySize:            PublicKeySize,
 	}
@@ -346,6 +350,50 @@ func TestWorker_NewWorkerPoolAdderNilShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilPoolAdder, err)
 }
 
+func TestWorker_NewWorkerEquivocationDetectorNilShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.EquivocationDetector = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilEquivocationDetector, err)
+}
+
+func TestWorker_NewWorkerStateDebuggerNilShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.StateDebugger = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilStateDebugger, err)
+}
+
+func TestWorker_NewWorkerManagedPeersHolderNilShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.ManagedPeersHolder = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilManagedPeersHolder, err)
+}
+
+func TestWorker_NewWorkerNodeRedundancyHandlerNilShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.NodeRedundancyHandler = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilNodeRedundancyHandler, err)
+}
+
 func TestWorker_NewWorkerShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -922,6 +970,64 @@ func TestWorker_ProcessReceivedMessageWrongChainIDInProposedBlockShouldError(t *
 	assert.True(t, errors.Is(err, spos.ErrInvalidChainID))
 }
 
+func TestWorker_ProcessReceivedMessageHeaderWithWrongRoundShouldErr(t *testing.T) {
+	t.Parallel()
+	wrk := *initWorker()
+	wrk.SetBlockProcessor(
+		&mock.BlockProcessorMock{
+			DecodeBlockHeaderCalled: func(dta []byte) data.HeaderHandler {
+				return &mock.HeaderHandlerStub{
+					CheckChainIDCalled: func(reference []byte) error {
+						return nil
+					},
+					GetPrevHashCalled: func() []byte {
+						return make([]byte, 0)
+					},
+					GetShardIDCalled: func() uint32 {
+						return 0
+					},
+					GetRoundCalled: func() uint64 {
+						return 1
+					},
+				}
+			},
+			RevertAccountStateCalled: func(header data.HeaderHandler) {
+			},
+			DecodeBlockBodyCalled: func(dta []byte) data.BodyHandler {
+				return nil
+			},
+		},
+	)
+
+	hdr := &block.Header{ChainID: chainID}
+	hdrHash, _ := core.CalculateHash(mock.MarshalizerMock{}, mock.HasherMock{}, hdr)
+	hdrStr, _ := mock.MarshalizerMock{}.Marshal(hdr)
+	cnsMsg := consensus.NewConsensusMessage(
+		hdrHash,
+		nil,
+		nil,
+		hdrStr,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		signature,
+		int(bls.MtBlockHeader),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	msg := &mock.P2PMessageMock{
+		DataField: buff,
+		PeerField: currentPid,
+	}
+	err := wrk.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+	assert.Equal(t, 0, len(wrk.ReceivedMessages()[bls.MtBlockHeader]))
+	assert.True(t, errors.Is(err, spos.ErrInvalidHeader))
+}
+
 func TestWorker_ProcessReceivedMessageWithABadOriginatorShouldErr(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
@@ -935,6 +1041,12 @@ func TestWorker_ProcessReceivedMessageWithABadOriginatorShouldErr(t *testing.T)
 					GetPrevHashCalled: func() []byte {
 						return make([]byte, 0)
 					},
+					GetShardIDCalled: func() uint32 {
+						return 0
+					},
+					GetRoundCalled: func() uint64 {
+						return 0
+					},
 				}
 			},
 			RevertAccountStateCalled: func(header data.HeaderHandler) {
@@ -988,6 +1100,12 @@ func TestWorker_ProcessReceivedMessageOkValsShouldWork(t *testing.T) {
 					GetPrevHashCalled: func() []byte {
 						return make([]byte, 0)
 					},
+					GetShardIDCalled: func() uint32 {
+						return 0
+					},
+					GetRoundCalled: func() uint64 {
+						return 0
+					},
 				}
 			},
 			RevertAccountStateCalled: func(header data.HeaderHandler) {
@@ -1028,6 +1146,142 @@ func TestWorker_ProcessReceivedMessageOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWorker_ProcessReceivedMessageShouldRecordTheMessageInTheStateDebugger(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	var recordedPubKey []byte
+	recordedMessageType := ""
+	workerArgs.StateDebugger = &testscommon.StateDebuggerStub{
+		AddReceivedMessageCalled: func(round int64, pubKey []byte, messageType string) {
+			recordedPubKey = pubKey
+			recordedMessageType = messageType
+		},
+	}
+	workerArgs.BlockProcessor = &mock.BlockProcessorMock{
+		DecodeBlockHeaderCalled: func(dta []byte) data.HeaderHandler {
+			return &mock.HeaderHandlerStub{
+				CheckChainIDCalled: func(reference []byte) error {
+					return nil
+				},
+				GetPrevHashCalled: func() []byte {
+					return make([]byte, 0)
+				},
+				GetShardIDCalled: func() uint32 {
+					return 0
+				},
+				GetRoundCalled: func() uint64 {
+					return 0
+				},
+			}
+		},
+		RevertAccountStateCalled: func(header data.HeaderHandler) {
+		},
+		DecodeBlockBodyCalled: func(dta []byte) data.BodyHandler {
+			return nil
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	hdr := &block.Header{ChainID: chainID}
+	hdrHash, _ := core.CalculateHash(mock.MarshalizerMock{}, mock.HasherMock{}, hdr)
+	hdrStr, _ := mock.MarshalizerMock{}.Marshal(hdr)
+	cnsMsg := consensus.NewConsensusMessage(
+		hdrHash,
+		nil,
+		nil,
+		hdrStr,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		signature,
+		int(bls.MtBlockHeader),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	msg := &mock.P2PMessageMock{
+		DataField: buff,
+		PeerField: currentPid,
+	}
+	err := wrk.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(wrk.ConsensusState().ConsensusGroup()[0]), recordedPubKey)
+	assert.Equal(t, bls.BlockHeaderStringValue, recordedMessageType)
+}
+
+func TestWorker_ProcessReceivedMessageShouldNotifyTheNodeRedundancyHandler(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	var notifiedSelfPubKey, notifiedMsgPubKey string
+	var notifiedPid core.PeerID
+	workerArgs.NodeRedundancyHandler = &testscommon.NodeRedundancyHandlerStub{
+		ResetInactivityIfNeededCalled: func(selfPubKey string, consensusMsgPubKey string, consensusMsgPeerID core.PeerID) {
+			notifiedSelfPubKey = selfPubKey
+			notifiedMsgPubKey = consensusMsgPubKey
+			notifiedPid = consensusMsgPeerID
+		},
+	}
+	workerArgs.BlockProcessor = &mock.BlockProcessorMock{
+		DecodeBlockHeaderCalled: func(dta []byte) data.HeaderHandler {
+			return &mock.HeaderHandlerStub{
+				CheckChainIDCalled: func(reference []byte) error {
+					return nil
+				},
+				GetPrevHashCalled: func() []byte {
+					return make([]byte, 0)
+				},
+				GetShardIDCalled: func() uint32 {
+					return 0
+				},
+				GetRoundCalled: func() uint64 {
+					return 0
+				},
+			}
+		},
+		RevertAccountStateCalled: func(header data.HeaderHandler) {
+		},
+		DecodeBlockBodyCalled: func(dta []byte) data.BodyHandler {
+			return nil
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	hdr := &block.Header{ChainID: chainID}
+	hdrHash, _ := core.CalculateHash(mock.MarshalizerMock{}, mock.HasherMock{}, hdr)
+	hdrStr, _ := mock.MarshalizerMock{}.Marshal(hdr)
+	cnsMsg := consensus.NewConsensusMessage(
+		hdrHash,
+		nil,
+		nil,
+		hdrStr,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		signature,
+		int(bls.MtBlockHeader),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	msg := &mock.P2PMessageMock{
+		DataField: buff,
+		PeerField: currentPid,
+	}
+	err := wrk.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wrk.ConsensusState().NodePubKey(), notifiedSelfPubKey)
+	assert.Equal(t, wrk.ConsensusState().ConsensusGroup()[0], notifiedMsgPubKey)
+	assert.Equal(t, currentPid, notifiedPid)
+}
+
 func TestWorker_CheckSelfStateShouldErrMessageFromItself(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
@@ -1050,6 +1304,36 @@ func TestWorker_CheckSelfStateShouldErrMessageFromItself(t *testing.T) {
 	assert.Equal(t, spos.ErrMessageFromItself, err)
 }
 
+func TestWorker_CheckSelfStateFromManagedKeyShouldErrMessageFromItself(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	managedPubKey := workerArgs.ConsensusState.ConsensusGroup()[2]
+	workerArgs.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			return string(pkBytes) == managedPubKey
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+	cnsMsg := consensus.NewConsensusMessage(
+		nil,
+		nil,
+		nil,
+		nil,
+		[]byte(managedPubKey),
+		nil,
+		0,
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	err := wrk.CheckSelfState(cnsMsg)
+	assert.Equal(t, spos.ErrMessageFromItself, err)
+}
+
 func TestWorker_CheckSelfStateShouldErrRoundCanceled(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
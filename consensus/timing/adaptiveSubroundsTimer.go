@@ -0,0 +1,232 @@
+package timing
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+var _ consensus.SubroundsTimingHandler = (*adaptiveSubroundsTimer)(nil)
+
+var log = logger.GetOrCreate("consensus/timing")
+
+const minSafetyFactor = 1.0
+
+// ArgsAdaptiveSubroundsTimer is the DTO used to create a new instance of adaptiveSubroundsTimer
+type ArgsAdaptiveSubroundsTimer struct {
+	NumRoundsWindow            uint32
+	MinObservations            uint32
+	SafetyFactor               float64
+	MinProposalDeadlinePercent uint32
+	MaxProposalDeadlinePercent uint32
+}
+
+type blockObservation struct {
+	round     int64
+	blockSize int
+	latency   time.Duration
+}
+
+// adaptiveSubroundsTimer computes, for each round, the moment until which the leader can spend time creating the block.
+// The time needed after that moment for the block to reach the validators and be processed by them is estimated as a
+// linear function of the block size, fitted on the observations made during the last rounds. The proposal deadline is
+// then placed so that the estimated latency, multiplied by a safety factor, fits before the signatures deadline.
+// The result is always bounded by the configured minimum and maximum percents of the round duration.
+type adaptiveSubroundsTimer struct {
+	numRoundsWindow            int64
+	minObservations            int
+	safetyFactor               float64
+	minProposalDeadlinePercent uint32
+	maxProposalDeadlinePercent uint32
+
+	mutTimer         sync.RWMutex
+	deadlines        map[int64]time.Duration
+	observations     []*blockObservation
+	estimatedLatency time.Duration
+}
+
+// NewAdaptiveSubroundsTimer creates a new adaptive subrounds timer
+func NewAdaptiveSubroundsTimer(args ArgsAdaptiveSubroundsTimer) (*adaptiveSubroundsTimer, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adaptiveSubroundsTimer{
+		numRoundsWindow:            int64(args.NumRoundsWindow),
+		minObservations:            int(args.MinObservations),
+		safetyFactor:               args.SafetyFactor,
+		minProposalDeadlinePercent: args.MinProposalDeadlinePercent,
+		maxProposalDeadlinePercent: args.MaxProposalDeadlinePercent,
+		deadlines:                  make(map[int64]time.Duration),
+		observations:               make([]*blockObservation, 0, args.NumRoundsWindow),
+	}, nil
+}
+
+func checkArgs(args ArgsAdaptiveSubroundsTimer) error {
+	if args.NumRoundsWindow == 0 {
+		return ErrInvalidNumRoundsWindow
+	}
+	if args.MinObservations == 0 || args.MinObservations > args.NumRoundsWindow {
+		return fmt.Errorf("%w, provided %d, should be between 1 and %d",
+			ErrInvalidMinObservations, args.MinObservations, args.NumRoundsWindow)
+	}
+	if args.SafetyFactor < minSafetyFactor {
+		return fmt.Errorf("%w, provided %v, minimum %v", ErrInvalidSafetyFactor, args.SafetyFactor, minSafetyFactor)
+	}
+	if args.MinProposalDeadlinePercent == 0 ||
+		args.MinProposalDeadlinePercent >= args.MaxProposalDeadlinePercent ||
+		args.MaxProposalDeadlinePercent >= 100 {
+		return fmt.Errorf("%w, provided min %d, max %d", ErrInvalidProposalDeadlineBounds,
+			args.MinProposalDeadlinePercent, args.MaxProposalDeadlinePercent)
+	}
+
+	return nil
+}
+
+// ComputeProposalDeadline returns the moment, measured from the round start, when the block subround should end in
+// the provided round. The default deadline is used while there are not enough observations
+func (ast *adaptiveSubroundsTimer) ComputeProposalDeadline(
+	round int64,
+	roundDuration time.Duration,
+	defaultDeadline time.Duration,
+	signaturesDeadline time.Duration,
+) time.Duration {
+	ast.mutTimer.Lock()
+	defer ast.mutTimer.Unlock()
+
+	ast.removeOldData(round)
+
+	deadline := defaultDeadline
+	ast.estimatedLatency = 0
+	if len(ast.observations) >= ast.minObservations {
+		ast.estimatedLatency = ast.estimateLatency()
+		deadline = signaturesDeadline - time.Duration(float64(ast.estimatedLatency)*ast.safetyFactor)
+	}
+
+	minDeadline := roundDuration * time.Duration(ast.minProposalDeadlinePercent) / 100
+	maxDeadline := roundDuration * time.Duration(ast.maxProposalDeadlinePercent) / 100
+	if maxDeadline > signaturesDeadline {
+		maxDeadline = signaturesDeadline
+	}
+	if deadline > maxDeadline {
+		deadline = maxDeadline
+	}
+	if deadline < minDeadline {
+		deadline = minDeadline
+	}
+
+	ast.deadlines[round] = deadline
+
+	log.Trace("adaptiveSubroundsTimer.ComputeProposalDeadline",
+		"round", round,
+		"num observations", len(ast.observations),
+		"estimated latency", ast.estimatedLatency,
+		"proposal deadline", deadline,
+	)
+
+	return deadline
+}
+
+// AddBlockObservation records the moment when the proposed block of the provided round was received, together with
+// its size and the time needed to process it
+func (ast *adaptiveSubroundsTimer) AddBlockObservation(
+	round int64,
+	blockSize int,
+	receivedAfter time.Duration,
+	processingTime time.Duration,
+) {
+	ast.mutTimer.Lock()
+	defer ast.mutTimer.Unlock()
+
+	deadline, ok := ast.deadlines[round]
+	if !ok {
+		return
+	}
+
+	// the leader might finish the block creation before the deadline, in which case the propagation latency can not
+	// be measured and is considered 0
+	propagationLatency := receivedAfter - deadline
+	if propagationLatency < 0 {
+		propagationLatency = 0
+	}
+
+	ast.observations = append(ast.observations, &blockObservation{
+		round:     round,
+		blockSize: blockSize,
+		latency:   propagationLatency + processingTime,
+	})
+	if int64(len(ast.observations)) > ast.numRoundsWindow {
+		ast.observations = ast.observations[1:]
+	}
+}
+
+// EstimatedLatency returns the latency estimated when the last proposal deadline was computed
+func (ast *adaptiveSubroundsTimer) EstimatedLatency() time.Duration {
+	ast.mutTimer.RLock()
+	defer ast.mutTimer.RUnlock()
+
+	return ast.estimatedLatency
+}
+
+// removeOldData should be called under mutex protection
+func (ast *adaptiveSubroundsTimer) removeOldData(round int64) {
+	oldestRound := round - ast.numRoundsWindow
+
+	for r := range ast.deadlines {
+		if r <= oldestRound {
+			delete(ast.deadlines, r)
+		}
+	}
+
+	index := 0
+	for index < len(ast.observations) && ast.observations[index].round <= oldestRound {
+		index++
+	}
+	ast.observations = ast.observations[index:]
+}
+
+// estimateLatency fits a linear function between the block size and the observed latency using the least squares
+// method and returns its value for the largest block seen in the window. Should be called under mutex protection
+func (ast *adaptiveSubroundsTimer) estimateLatency() time.Duration {
+	n := float64(len(ast.observations))
+	sumSizes, sumLatencies, sumSizesLatencies, sumSquaredSizes := 0.0, 0.0, 0.0, 0.0
+	maxSize := 0
+	for _, obs := range ast.observations {
+		size := float64(obs.blockSize)
+		latency := float64(obs.latency)
+		sumSizes += size
+		sumLatencies += latency
+		sumSizesLatencies += size * latency
+		sumSquaredSizes += size * size
+		if obs.blockSize > maxSize {
+			maxSize = obs.blockSize
+		}
+	}
+
+	meanLatency := sumLatencies / n
+	slope := 0.0
+	denominator := n*sumSquaredSizes - sumSizes*sumSizes
+	if denominator > 0 {
+		slope = (n*sumSizesLatencies - sumSizes*sumLatencies) / denominator
+	}
+	if slope <= 0 {
+		// the latency does not grow with the block size, the mean value is used
+		return time.Duration(meanLatency)
+	}
+
+	intercept := (sumLatencies - slope*sumSizes) / n
+	if intercept < 0 {
+		intercept = 0
+	}
+
+	return time.Duration(intercept + slope*float64(maxSize))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ast *adaptiveSubroundsTimer) IsInterfaceNil() bool {
+	return ast == nil
+}
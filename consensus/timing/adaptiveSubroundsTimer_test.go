@@ -0,0 +1,177 @@
+package timing
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+const roundDuration = time.Second * 6
+const defaultDeadline = roundDuration / 4
+const signaturesDeadline = roundDuration * 85 / 100
+
+func createMockArgsAdaptiveSubroundsTimer() ArgsAdaptiveSubroundsTimer {
+	return ArgsAdaptiveSubroundsTimer{
+		NumRoundsWindow:            10,
+		MinObservations:            3,
+		SafetyFactor:               2,
+		MinProposalDeadlinePercent: 10,
+		MaxProposalDeadlinePercent: 50,
+	}
+}
+
+func TestNewAdaptiveSubroundsTimer_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAdaptiveSubroundsTimer()
+	args.NumRoundsWindow = 0
+	ast, err := NewAdaptiveSubroundsTimer(args)
+	assert.True(t, check.IfNil(ast))
+	assert.Equal(t, ErrInvalidNumRoundsWindow, err)
+
+	args = createMockArgsAdaptiveSubroundsTimer()
+	args.MinObservations = 0
+	ast, err = NewAdaptiveSubroundsTimer(args)
+	assert.True(t, check.IfNil(ast))
+	assert.True(t, errors.Is(err, ErrInvalidMinObservations))
+
+	args = createMockArgsAdaptiveSubroundsTimer()
+	args.MinObservations = args.NumRoundsWindow + 1
+	ast, err = NewAdaptiveSubroundsTimer(args)
+	assert.True(t, check.IfNil(ast))
+	assert.True(t, errors.Is(err, ErrInvalidMinObservations))
+
+	args = createMockArgsAdaptiveSubroundsTimer()
+	args.SafetyFactor = 0.9
+	ast, err = NewAdaptiveSubroundsTimer(args)
+	assert.True(t, check.IfNil(ast))
+	assert.True(t, errors.Is(err, ErrInvalidSafetyFactor))
+
+	args = createMockArgsAdaptiveSubroundsTimer()
+	args.MinProposalDeadlinePercent = 0
+	ast, err = NewAdaptiveSubroundsTimer(args)
+	assert.True(t, check.IfNil(ast))
+	assert.True(t, errors.Is(err, ErrInvalidProposalDeadlineBounds))
+
+	args = createMockArgsAdaptiveSubroundsTimer()
+	args.MinProposalDeadlinePercent = args.MaxProposalDeadlinePercent
+	ast, err = NewAdaptiveSubroundsTimer(args)
+	assert.True(t, check.IfNil(ast))
+	assert.True(t, errors.Is(err, ErrInvalidProposalDeadlineBounds))
+
+	args = createMockArgsAdaptiveSubroundsTimer()
+	args.MaxProposalDeadlinePercent = 100
+	ast, err = NewAdaptiveSubroundsTimer(args)
+	assert.True(t, check.IfNil(ast))
+	assert.True(t, errors.Is(err, ErrInvalidProposalDeadlineBounds))
+}
+
+func TestNewAdaptiveSubroundsTimer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ast, err := NewAdaptiveSubroundsTimer(createMockArgsAdaptiveSubroundsTimer())
+	assert.False(t, check.IfNil(ast))
+	assert.Nil(t, err)
+}
+
+func TestAdaptiveSubroundsTimer_ComputeProposalDeadlineNotEnoughObservationsShouldReturnDefault(t *testing.T) {
+	t.Parallel()
+
+	ast, _ := NewAdaptiveSubroundsTimer(createMockArgsAdaptiveSubroundsTimer())
+
+	deadline := ast.ComputeProposalDeadline(1, roundDuration, defaultDeadline, signaturesDeadline)
+	assert.Equal(t, defaultDeadline, deadline)
+	ast.AddBlockObservation(1, 1000, deadline+time.Millisecond*100, time.Millisecond*100)
+
+	deadline = ast.ComputeProposalDeadline(2, roundDuration, defaultDeadline, signaturesDeadline)
+	assert.Equal(t, defaultDeadline, deadline)
+	assert.Equal(t, time.Duration(0), ast.EstimatedLatency())
+}
+
+func TestAdaptiveSubroundsTimer_AddBlockObservationForUnknownRoundShouldIgnore(t *testing.T) {
+	t.Parallel()
+
+	ast, _ := NewAdaptiveSubroundsTimer(createMockArgsAdaptiveSubroundsTimer())
+	ast.AddBlockObservation(1, 1000, time.Second, time.Second)
+
+	assert.Equal(t, 0, len(ast.observations))
+}
+
+func TestAdaptiveSubroundsTimer_FastNetworkShouldExtendTheProposalDeadline(t *testing.T) {
+	t.Parallel()
+
+	ast, _ := NewAdaptiveSubroundsTimer(createMockArgsAdaptiveSubroundsTimer())
+
+	for round := int64(1); round <= 3; round++ {
+		deadline := ast.ComputeProposalDeadline(round, roundDuration, defaultDeadline, signaturesDeadline)
+		ast.AddBlockObservation(round, 1000, deadline+time.Millisecond*200, time.Millisecond*300)
+	}
+
+	deadline := ast.ComputeProposalDeadline(4, roundDuration, defaultDeadline, signaturesDeadline)
+	assert.Equal(t, time.Millisecond*500, ast.EstimatedLatency())
+	// bounded by the max proposal deadline: 50% of the round
+	assert.Equal(t, roundDuration/2, deadline)
+}
+
+func TestAdaptiveSubroundsTimer_SlowNetworkShouldShrinkTheProposalDeadline(t *testing.T) {
+	t.Parallel()
+
+	ast, _ := NewAdaptiveSubroundsTimer(createMockArgsAdaptiveSubroundsTimer())
+
+	for round := int64(1); round <= 3; round++ {
+		deadline := ast.ComputeProposalDeadline(round, roundDuration, defaultDeadline, signaturesDeadline)
+		ast.AddBlockObservation(round, 1000, deadline+time.Millisecond*1000, time.Millisecond*500)
+	}
+
+	deadline := ast.ComputeProposalDeadline(4, roundDuration, defaultDeadline, signaturesDeadline)
+	assert.Equal(t, time.Millisecond*1500, ast.EstimatedLatency())
+	assert.Equal(t, signaturesDeadline-time.Millisecond*3000, deadline)
+
+	for round := int64(5); round <= 7; round++ {
+		deadline = ast.ComputeProposalDeadline(round, roundDuration, defaultDeadline, signaturesDeadline)
+		ast.AddBlockObservation(round, 1000, deadline+time.Millisecond*4000, time.Millisecond*500)
+	}
+
+	deadline = ast.ComputeProposalDeadline(8, roundDuration, defaultDeadline, signaturesDeadline)
+	// bounded by the min proposal deadline: 10% of the round
+	assert.Equal(t, roundDuration/10, deadline)
+}
+
+func TestAdaptiveSubroundsTimer_EstimatedLatencyShouldDependOnTheBlockSize(t *testing.T) {
+	t.Parallel()
+
+	ast, _ := NewAdaptiveSubroundsTimer(createMockArgsAdaptiveSubroundsTimer())
+
+	// latency = 100ms + 1ms for each 100 bytes
+	blockSizes := []int{10000, 20000, 40000}
+	for i, blockSize := range blockSizes {
+		round := int64(i + 1)
+		deadline := ast.ComputeProposalDeadline(round, roundDuration, defaultDeadline, signaturesDeadline)
+		latency := time.Millisecond*100 + time.Millisecond*time.Duration(blockSize/100)
+		ast.AddBlockObservation(round, blockSize, deadline+latency, 0)
+	}
+
+	_ = ast.ComputeProposalDeadline(4, roundDuration, defaultDeadline, signaturesDeadline)
+	// the estimation is done for the largest block seen
+	assert.Equal(t, time.Millisecond*500, ast.EstimatedLatency())
+}
+
+func TestAdaptiveSubroundsTimer_OldObservationsShouldBeRemoved(t *testing.T) {
+	t.Parallel()
+
+	ast, _ := NewAdaptiveSubroundsTimer(createMockArgsAdaptiveSubroundsTimer())
+
+	for round := int64(1); round <= 3; round++ {
+		deadline := ast.ComputeProposalDeadline(round, roundDuration, defaultDeadline, signaturesDeadline)
+		ast.AddBlockObservation(round, 1000, deadline, time.Millisecond*100)
+	}
+	assert.Equal(t, 3, len(ast.observations))
+
+	deadline := ast.ComputeProposalDeadline(13, roundDuration, defaultDeadline, signaturesDeadline)
+	assert.Equal(t, defaultDeadline, deadline)
+	assert.Equal(t, 0, len(ast.observations))
+	assert.Equal(t, 1, len(ast.deadlines))
+}
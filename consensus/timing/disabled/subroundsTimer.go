@@ -0,0 +1,26 @@
+package disabled
+
+import "time"
+
+// SubroundsTimer is the disabled subrounds timer implementation which keeps the fixed subrounds deadlines
+type SubroundsTimer struct {
+}
+
+// ComputeProposalDeadline returns the provided default deadline
+func (st *SubroundsTimer) ComputeProposalDeadline(_ int64, _ time.Duration, defaultDeadline time.Duration, _ time.Duration) time.Duration {
+	return defaultDeadline
+}
+
+// AddBlockObservation does nothing
+func (st *SubroundsTimer) AddBlockObservation(_ int64, _ int, _ time.Duration, _ time.Duration) {
+}
+
+// EstimatedLatency returns 0
+func (st *SubroundsTimer) EstimatedLatency() time.Duration {
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (st *SubroundsTimer) IsInterfaceNil() bool {
+	return st == nil
+}
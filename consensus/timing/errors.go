@@ -0,0 +1,15 @@
+package timing
+
+import "errors"
+
+// ErrInvalidNumRoundsWindow signals that an invalid number of rounds for the observation window has been provided
+var ErrInvalidNumRoundsWindow = errors.New("invalid number of rounds window")
+
+// ErrInvalidMinObservations signals that an invalid minimum number of observations has been provided
+var ErrInvalidMinObservations = errors.New("invalid minimum number of observations")
+
+// ErrInvalidSafetyFactor signals that an invalid safety factor has been provided
+var ErrInvalidSafetyFactor = errors.New("invalid safety factor")
+
+// ErrInvalidProposalDeadlineBounds signals that invalid proposal deadline bounds have been provided
+var ErrInvalidProposalDeadlineBounds = errors.New("invalid proposal deadline bounds")
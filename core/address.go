@@ -2,23 +2,22 @@ package core
 
 import (
 	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core/addressRouting"
 )
 
 // SystemAccountAddress is the hard-coded address in which we save global settings on all shards
 var SystemAccountAddress = bytes.Repeat([]byte{255}, 32)
 
 // NumInitCharactersForScAddress numbers of characters for smart contract address identifier
-const NumInitCharactersForScAddress = 10
+const NumInitCharactersForScAddress = addressRouting.NumInitCharactersForScAddress
 
 // VMTypeLen number of characters with VMType identifier in an address, these are the last 2 characters from the
 // initial identifier
-const VMTypeLen = 2
+const VMTypeLen = addressRouting.VMTypeLen
 
 // ShardIdentiferLen number of characters for shard identifier in an address
-const ShardIdentiferLen = 2
-
-const metaChainShardIdentifier uint8 = 255
-const numInitCharactersForOnMetachainSC = 15
+const ShardIdentiferLen = addressRouting.ShardIdentiferLen
 
 const numInitCharactersForSystemAccountAddress = 30
 
@@ -32,56 +31,20 @@ func IsSystemAccountAddress(address []byte) bool {
 
 // IsSmartContractAddress verifies if a set address is of type smart contract
 func IsSmartContractAddress(rcvAddress []byte) bool {
-	if len(rcvAddress) <= NumInitCharactersForScAddress {
-		return false
-	}
-
-	if IsEmptyAddress(rcvAddress) {
-		return true
-	}
-
-	numOfZeros := NumInitCharactersForScAddress - VMTypeLen
-	isSCAddress := bytes.Equal(rcvAddress[:numOfZeros], make([]byte, numOfZeros))
-	return isSCAddress
+	return addressRouting.IsSmartContractAddress(rcvAddress)
 }
 
 // IsEmptyAddress returns whether an address is empty
 func IsEmptyAddress(address []byte) bool {
-	isEmptyAddress := bytes.Equal(address, make([]byte, len(address)))
-	return isEmptyAddress
+	return addressRouting.IsEmptyAddress(address)
 }
 
 // IsMetachainIdentifier verifies if the identifier is of type metachain
 func IsMetachainIdentifier(identifier []byte) bool {
-	if len(identifier) == 0 {
-		return false
-	}
-
-	for i := 0; i < len(identifier); i++ {
-		if identifier[i] != metaChainShardIdentifier {
-			return false
-		}
-	}
-
-	return true
+	return addressRouting.IsMetachainIdentifier(identifier)
 }
 
 // IsSmartContractOnMetachain verifies if an address is smart contract on metachain
 func IsSmartContractOnMetachain(identifier []byte, rcvAddress []byte) bool {
-	if len(rcvAddress) <= NumInitCharactersForScAddress+numInitCharactersForOnMetachainSC {
-		return false
-	}
-
-	if !IsMetachainIdentifier(identifier) {
-		return false
-	}
-
-	if !IsSmartContractAddress(rcvAddress) {
-		return false
-	}
-
-	leftSide := rcvAddress[NumInitCharactersForScAddress:(NumInitCharactersForScAddress + numInitCharactersForOnMetachainSC)]
-	isOnMetaChainSCAddress := bytes.Equal(leftSide,
-		make([]byte, numInitCharactersForOnMetachainSC))
-	return isOnMetaChainSCAddress
+	return addressRouting.IsSmartContractOnMetachain(identifier, rcvAddress)
 }
@@ -0,0 +1,21 @@
+package addressRouting
+
+import "errors"
+
+// ErrInvalidNumberOfShards signals that an invalid number of shards was provided
+var ErrInvalidNumberOfShards = errors.New("the number of shards must be greater than zero")
+
+// ErrInvalidShardId signals that an invalid shard id was provided
+var ErrInvalidShardId = errors.New("shard id must be smaller than the total number of shards")
+
+// ErrInvalidAddressLength signals that address length is invalid
+var ErrInvalidAddressLength = errors.New("invalid address length")
+
+// ErrWrongSize signals that a wrong size occurred
+var ErrWrongSize = errors.New("wrong size")
+
+// ErrInvalidErdAddress signals that the provided address is not an ERD address
+var ErrInvalidErdAddress = errors.New("invalid ERD address")
+
+// ErrBech32ConvertError signals that conversion the 5bit alphabet to 8bit failed
+var ErrBech32ConvertError = errors.New("can't convert bech32 string")
@@ -0,0 +1,6 @@
+package addressRouting
+
+// Masks -
+func (sc *ShardCoordinator) Masks() (uint32, uint32) {
+	return sc.maskHigh, sc.maskLow
+}
@@ -0,0 +1,106 @@
+package addressRouting
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcutil/bech32"
+)
+
+// Bech32Prefix is the human readable part of the bech32 addresses
+const Bech32Prefix = "erd"
+
+// AddressLen is the length of the addresses, in bytes
+const AddressLen = 32
+
+const (
+	bech32FromBits = byte(8)
+	bech32ToBits   = byte(5)
+	bech32Pad      = true
+)
+
+// CheckAddressLength checks that the provided length can be used by the public keys converters
+func CheckAddressLength(addressLen int) error {
+	if addressLen < 1 {
+		return fmt.Errorf("%w, addressLen should have been greater than 0", ErrInvalidAddressLength)
+	}
+	if addressLen%2 == 1 {
+		return fmt.Errorf("%w, addressLen should have been an even number", ErrInvalidAddressLength)
+	}
+
+	return nil
+}
+
+// EncodeBech32 converts the provided public key, having the provided length, in its bech32 form
+func EncodeBech32(pkBytes []byte, addressLen int) (string, error) {
+	if len(pkBytes) != addressLen {
+		return "", fmt.Errorf("%w when encoding address, expected length %d, received %d",
+			ErrWrongSize, addressLen, len(pkBytes))
+	}
+
+	conv, err := bech32.ConvertBits(pkBytes, bech32FromBits, bech32ToBits, bech32Pad)
+	if err != nil {
+		return "", err
+	}
+
+	return bech32.Encode(Bech32Prefix, conv)
+}
+
+// DecodeBech32 converts the provided bech32 address in the public key bytes, checking the resulted length
+func DecodeBech32(humanReadable string, addressLen int) ([]byte, error) {
+	decodedPrefix, buff, err := bech32.Decode(humanReadable)
+	if err != nil {
+		return nil, err
+	}
+	if decodedPrefix != Bech32Prefix {
+		return nil, ErrInvalidErdAddress
+	}
+
+	// warning: mind the order of the parameters, those should be inverted
+	decodedBytes, err := bech32.ConvertBits(buff, bech32ToBits, bech32FromBits, !bech32Pad)
+	if err != nil {
+		return nil, ErrBech32ConvertError
+	}
+
+	if len(decodedBytes) != addressLen {
+		return nil, fmt.Errorf("%w when decoding address, expected length %d, received %d",
+			ErrWrongSize, addressLen, len(decodedBytes))
+	}
+
+	return decodedBytes, nil
+}
+
+// EncodeHex converts the provided public key in its hex form
+func EncodeHex(pkBytes []byte) string {
+	return hex.EncodeToString(pkBytes)
+}
+
+// DecodeHex converts the provided hex address in the public key bytes, checking the resulted length
+func DecodeHex(humanReadable string, addressLen int) ([]byte, error) {
+	buff, err := hex.DecodeString(humanReadable)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(buff) != addressLen {
+		return nil, fmt.Errorf("%w when converting to address, expected length %d, received %d",
+			ErrWrongSize, addressLen, len(buff))
+	}
+
+	return buff, nil
+}
+
+// ComputeShardIDOfBech32Address returns the shard of the provided bech32 address when the network has the provided
+// number of shards
+func ComputeShardIDOfBech32Address(humanReadable string, numberOfShards uint32) (uint32, error) {
+	if numberOfShards < 1 {
+		return 0, ErrInvalidNumberOfShards
+	}
+
+	address, err := DecodeBech32(humanReadable, AddressLen)
+	if err != nil {
+		return 0, err
+	}
+
+	return ComputeShardID(address, numberOfShards), nil
+}
@@ -0,0 +1,70 @@
+package addressRouting_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/addressRouting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAddressLength(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, errors.Is(addressRouting.CheckAddressLength(0), addressRouting.ErrInvalidAddressLength))
+	assert.True(t, errors.Is(addressRouting.CheckAddressLength(3), addressRouting.ErrInvalidAddressLength))
+	assert.Nil(t, addressRouting.CheckAddressLength(addressRouting.AddressLen))
+}
+
+func TestBech32_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	pkBytes := bytes.Repeat([]byte{1}, addressRouting.AddressLen)
+	address, err := addressRouting.EncodeBech32(pkBytes, addressRouting.AddressLen)
+	require.Nil(t, err)
+	assert.Equal(t, "erd1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqsl6e0p7", address)
+
+	decoded, err := addressRouting.DecodeBech32(address, addressRouting.AddressLen)
+	require.Nil(t, err)
+	assert.Equal(t, pkBytes, decoded)
+
+	_, err = addressRouting.EncodeBech32(pkBytes[1:], addressRouting.AddressLen)
+	assert.True(t, errors.Is(err, addressRouting.ErrWrongSize))
+
+	_, err = addressRouting.DecodeBech32(address, 20)
+	assert.True(t, errors.Is(err, addressRouting.ErrWrongSize))
+
+	_, err = addressRouting.DecodeBech32("not a bech32 address", addressRouting.AddressLen)
+	assert.NotNil(t, err)
+}
+
+func TestHex_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	pkBytes := bytes.Repeat([]byte{1}, 4)
+	address := addressRouting.EncodeHex(pkBytes)
+	assert.Equal(t, "01010101", address)
+
+	decoded, err := addressRouting.DecodeHex(address, 4)
+	require.Nil(t, err)
+	assert.Equal(t, pkBytes, decoded)
+
+	_, err = addressRouting.DecodeHex(address, 8)
+	assert.True(t, errors.Is(err, addressRouting.ErrWrongSize))
+}
+
+func TestComputeShardIDOfBech32Address(t *testing.T) {
+	t.Parallel()
+
+	pkBytes := bytes.Repeat([]byte{1}, addressRouting.AddressLen)
+	address, _ := addressRouting.EncodeBech32(pkBytes, addressRouting.AddressLen)
+
+	_, err := addressRouting.ComputeShardIDOfBech32Address(address, 0)
+	assert.Equal(t, addressRouting.ErrInvalidNumberOfShards, err)
+
+	shardID, err := addressRouting.ComputeShardIDOfBech32Address(address, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), shardID)
+}
@@ -0,0 +1,104 @@
+package addressRouting
+
+import (
+	"bytes"
+	"math"
+)
+
+// ShardCoordinator computes the shards of the addresses for a fixed number of shards
+type ShardCoordinator struct {
+	maskHigh       uint32
+	maskLow        uint32
+	selfId         uint32
+	numberOfShards uint32
+}
+
+// NewShardCoordinator returns a new shard coordinator, the self id being the shard of the caller, if any, or
+// MetachainShardId
+func NewShardCoordinator(numberOfShards uint32, selfId uint32) (*ShardCoordinator, error) {
+	if numberOfShards < 1 {
+		return nil, ErrInvalidNumberOfShards
+	}
+	if selfId >= numberOfShards && selfId != MetachainShardId {
+		return nil, ErrInvalidShardId
+	}
+
+	maskHigh, maskLow := calculateMasks(numberOfShards)
+
+	return &ShardCoordinator{
+		maskHigh:       maskHigh,
+		maskLow:        maskLow,
+		selfId:         selfId,
+		numberOfShards: numberOfShards,
+	}, nil
+}
+
+// calculateMasks will create two numbers who's binary form is composed from as many ones needed to be taken into
+// consideration for the shard assignment. The result of a bitwise AND operation of an address with this mask will
+// result in the shard id where a transaction from that address will be dispatched
+func calculateMasks(numberOfShards uint32) (uint32, uint32) {
+	n := math.Ceil(math.Log2(float64(numberOfShards)))
+	return (1 << uint(n)) - 1, (1 << uint(n-1)) - 1
+}
+
+// ComputeShardID returns the shard of the provided address when the network has the provided number of shards. The
+// system smart contracts addresses belong to the metachain. It returns MetachainShardId for an invalid number of shards
+func ComputeShardID(address []byte, numberOfShards uint32) uint32 {
+	coordinator, err := NewShardCoordinator(numberOfShards, 0)
+	if err != nil {
+		return MetachainShardId
+	}
+
+	return coordinator.ComputeId(address)
+}
+
+// ComputeId calculates the shard for a given address. Only the last bytes of the address are used, so the addresses
+// with the same suffix belong to the same shard
+func (sc *ShardCoordinator) ComputeId(address []byte) uint32 {
+	bytesNeed := int(sc.numberOfShards/MaxNumShards) + 1
+	startingIndex := 0
+	if len(address) > bytesNeed {
+		startingIndex = len(address) - bytesNeed
+	}
+
+	buffNeeded := address[startingIndex:]
+	if IsSmartContractOnMetachain(buffNeeded, address) {
+		return MetachainShardId
+	}
+
+	addr := uint32(0)
+	for i := 0; i < len(buffNeeded); i++ {
+		addr = addr<<8 + uint32(buffNeeded[i])
+	}
+
+	shard := addr & sc.maskHigh
+	if shard > sc.numberOfShards-1 {
+		shard = addr & sc.maskLow
+	}
+
+	return shard
+}
+
+// NumberOfShards returns the number of shards
+func (sc *ShardCoordinator) NumberOfShards() uint32 {
+	return sc.numberOfShards
+}
+
+// SelfId returns the shard of the caller
+func (sc *ShardCoordinator) SelfId() uint32 {
+	return sc.selfId
+}
+
+// SameShard returns true if the two addresses belong to the same shard
+func (sc *ShardCoordinator) SameShard(firstAddress, secondAddress []byte) bool {
+	if bytes.Equal(firstAddress, secondAddress) {
+		return true
+	}
+
+	return sc.ComputeId(firstAddress) == sc.ComputeId(secondAddress)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sc *ShardCoordinator) IsInterfaceNil() bool {
+	return sc == nil
+}
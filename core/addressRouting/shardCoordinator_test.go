@@ -0,0 +1,94 @@
+package addressRouting_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/addressRouting"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// getAddressFromUint32 returns a user address, the prefix not being the one of the smart contracts
+func getAddressFromUint32(address uint32) []byte {
+	buff := bytes.Repeat([]byte{1}, 32)
+	binary.BigEndian.PutUint32(buff[28:], address)
+
+	return buff
+}
+
+func TestNewShardCoordinator(t *testing.T) {
+	t.Parallel()
+
+	sc, err := addressRouting.NewShardCoordinator(0, 0)
+	assert.True(t, check.IfNil(sc))
+	assert.Equal(t, addressRouting.ErrInvalidNumberOfShards, err)
+
+	sc, err = addressRouting.NewShardCoordinator(2, 2)
+	assert.True(t, check.IfNil(sc))
+	assert.Equal(t, addressRouting.ErrInvalidShardId, err)
+
+	sc, err = addressRouting.NewShardCoordinator(2, addressRouting.MetachainShardId)
+	require.Nil(t, err)
+	assert.Equal(t, uint32(2), sc.NumberOfShards())
+	assert.Equal(t, addressRouting.MetachainShardId, sc.SelfId())
+}
+
+func TestShardCoordinator_ComputeId(t *testing.T) {
+	t.Parallel()
+
+	sc, _ := addressRouting.NewShardCoordinator(3, 0)
+
+	// the last 2 bits are used, the values greater than the last shard falling back on the last bit
+	assert.Equal(t, uint32(0), sc.ComputeId(getAddressFromUint32(0)))
+	assert.Equal(t, uint32(1), sc.ComputeId(getAddressFromUint32(1)))
+	assert.Equal(t, uint32(2), sc.ComputeId(getAddressFromUint32(2)))
+	assert.Equal(t, uint32(1), sc.ComputeId(getAddressFromUint32(3)))
+	assert.Equal(t, uint32(0), sc.ComputeId(getAddressFromUint32(4)))
+
+	for i := uint32(0); i < 1000; i++ {
+		address := getAddressFromUint32(i)
+		assert.Equal(t, sc.ComputeId(address), addressRouting.ComputeShardID(address, 3))
+		assert.True(t, sc.ComputeId(address) < 3)
+	}
+
+	assert.True(t, sc.SameShard(getAddressFromUint32(1), getAddressFromUint32(3)))
+	assert.False(t, sc.SameShard(getAddressFromUint32(1), getAddressFromUint32(2)))
+}
+
+func TestShardCoordinator_Masks(t *testing.T) {
+	t.Parallel()
+
+	dataSet := []struct {
+		numShards uint32
+		maskHigh  uint32
+		maskLow   uint32
+	}{
+		{numShards: 2, maskHigh: 1, maskLow: 0},
+		{numShards: 3, maskHigh: 3, maskLow: 1},
+		{numShards: 4, maskHigh: 3, maskLow: 1},
+		{numShards: 5, maskHigh: 7, maskLow: 3},
+		{numShards: 10, maskHigh: 15, maskLow: 7},
+	}
+	for _, data := range dataSet {
+		sc, err := addressRouting.NewShardCoordinator(data.numShards, 0)
+		require.Nil(t, err)
+
+		maskHigh, maskLow := sc.Masks()
+		assert.Equal(t, data.maskHigh, maskHigh, "mask high for %d shards", data.numShards)
+		assert.Equal(t, data.maskLow, maskLow, "mask low for %d shards", data.numShards)
+	}
+}
+
+func TestShardCoordinator_ComputeIdSystemSmartContractShouldBeMetachain(t *testing.T) {
+	t.Parallel()
+
+	systemSCAddress := make([]byte, 32)
+	systemSCAddress[30] = 255
+	systemSCAddress[31] = 255
+
+	assert.Equal(t, addressRouting.MetachainShardId, addressRouting.ComputeShardID(systemSCAddress, 3))
+	assert.Equal(t, addressRouting.MetachainShardId, addressRouting.ComputeShardID(getAddressFromUint32(1), 0))
+}
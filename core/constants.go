@@ -3,6 +3,8 @@ package core
 import (
 	"math"
 	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/addressRouting"
 )
 
 // PeerType represents the type of a peer
@@ -69,8 +71,15 @@ const NodesSetupJsonFileName = "nodesSetup.json"
 // ConsensusTopic is the topic used in consensus algorithm
 const ConsensusTopic = "consensus"
 
-// HeartbeatTopic is the topic used for heartbeat signaling
-const HeartbeatTopic = "heartbeat"
+// PeerAuthenticationTopic is the topic used by the validators to bind their public keys to their peer IDs
+const PeerAuthenticationTopic = "peerAuthentication"
+
+// HeartbeatV2Topic is the topic used for heartbeat signaling
+const HeartbeatV2Topic = "heartbeatV2"
+
+// LightAddressTopicPrefix is the prefix of the topics on which the observers publish compact notifications about
+// the activity of the addresses matching a configured prefix
+const LightAddressTopicPrefix = "lightAddress_"
 
 // PathShardPlaceholder represents the placeholder for the shard ID in paths
 const PathShardPlaceholder = "[S]"
@@ -126,6 +135,28 @@ const MetricCountConsensus = "erd_count_consensus"
 // MetricCountAcceptedBlocks is the metric for monitoring number of blocks that was accepted proposed by a node
 const MetricCountAcceptedBlocks = "erd_count_accepted_blocks"
 
+// MetricEpochCountConsensus is the metric for monitoring number of rounds of the current epoch when a node was in
+// consensus group
+const MetricEpochCountConsensus = "erd_epoch_count_consensus"
+
+// MetricEpochCountLeader is the metric for monitoring number of rounds of the current epoch when a node was leader
+const MetricEpochCountLeader = "erd_epoch_count_leader"
+
+// MetricEpochCountProposedBlocks is the metric for monitoring number of blocks proposed by a node in the current epoch
+const MetricEpochCountProposedBlocks = "erd_epoch_count_proposed_blocks"
+
+// MetricEpochCountMissedProposals is the metric for monitoring number of rounds of the current epoch when a node was
+// leader but did not propose a block
+const MetricEpochCountMissedProposals = "erd_epoch_count_missed_proposals"
+
+// MetricEpochCountSignatureSharesSent is the metric for monitoring number of signature shares sent by a node in the
+// current epoch
+const MetricEpochCountSignatureSharesSent = "erd_epoch_count_signature_shares_sent"
+
+// MetricEpochAverageSubroundDurations is the metric for monitoring the average time spent by a node in each consensus
+// subround during the current epoch
+const MetricEpochAverageSubroundDurations = "erd_epoch_average_subround_durations"
+
 // MetricNodeType is the metric for monitoring the type of the node
 const MetricNodeType = "erd_node_type"
 
@@ -201,6 +232,18 @@ const MetricNumMiniBlocks = "erd_num_mini_blocks"
 // MetricConsensusRoundState is the metric for consensus round state for a block
 const MetricConsensusRoundState = "erd_consensus_round_state"
 
+// MetricPartitionState is the metric for the network partition state as seen by the partition detector
+const MetricPartitionState = "erd_partition_state"
+
+// MetricPartitionNumSignals is the metric for the number of partition signals raised in the last check
+const MetricPartitionNumSignals = "erd_partition_num_signals"
+
+// MetricPartitionNumStaleShards is the metric for the number of shards that have not delivered headers in the allowed gap
+const MetricPartitionNumStaleShards = "erd_partition_num_stale_shards"
+
+// MetricPartitionConsensusParticipation is the metric for the average consensus participation percent seen in headers
+const MetricPartitionConsensusParticipation = "erd_partition_consensus_participation"
+
 // MetricCrossCheckBlockHeight is the metric that store cross block height
 const MetricCrossCheckBlockHeight = "erd_cross_check_block_height"
 
@@ -303,6 +346,18 @@ const MetricCreatedProposedBlock = "erd_consensus_created_proposed_block"
 //subround spare duration)
 const MetricProcessedProposedBlock = "erd_consensus_processed_proposed_block"
 
+// MetricConsensusProposalDeadline is the metric that specifies the effective end of the block subround, in milliseconds
+// from the round start. Until this moment the leader is allowed to create the proposed block
+const MetricConsensusProposalDeadline = "erd_consensus_proposal_deadline"
+
+// MetricConsensusWaitAllSignaturesDeadline is the metric that specifies the effective moment, in milliseconds from the
+// round start, until which the leader waits for all the signatures
+const MetricConsensusWaitAllSignaturesDeadline = "erd_consensus_wait_all_signatures_deadline"
+
+// MetricConsensusEstimatedLatency is the metric that specifies the estimated time, in milliseconds, needed for a
+// proposed block to reach the validators and be processed by them
+const MetricConsensusEstimatedLatency = "erd_consensus_estimated_latency"
+
 // MetricMinGasPrice is the metric that specifies min gas price
 const MetricMinGasPrice = "erd_min_gas_price"
 
@@ -361,7 +416,7 @@ const MetricEpochForEconomicsData = "erd_epoch_for_economics_data"
 const LastNonceKeyMetricsStorage = "lastNonce"
 
 // MetachainShardId will be used to identify a shard ID as metachain
-const MetachainShardId = uint32(0xFFFFFFFF)
+const MetachainShardId = addressRouting.MetachainShardId
 
 // AllShardId will be used to identify that a message is for all shards
 const AllShardId = uint32(0xFFFFFFF0)
@@ -401,6 +456,10 @@ const (
 	IndexerOrder
 	// NetStatisticsOrder defines the order in which netStatistic component is notified of a start of epoch event
 	NetStatisticsOrder
+	// RoundDurationChangeOrder defines the order in which the round duration change handler is notified of a start of epoch event
+	RoundDurationChangeOrder
+	// KeyRotationOrder defines the order in which the rotated validator key watcher is notified of a start of epoch event
+	KeyRotationOrder
 )
 
 // NodeState specifies what type of state a node could have
@@ -436,6 +495,73 @@ const MetricP2PUnknownPeers = "erd_p2p_unknown_shard_peers"
 // MetricP2PNumConnectedPeersClassification is the metric for monitoring the number of connected peers split on the connection type
 const MetricP2PNumConnectedPeersClassification = "erd_p2p_num_connected_peers_classification"
 
+// MetricSystemSCProcessingDuration is the metric that outputs the duration in milliseconds of the last end of epoch
+// system smart contracts processing
+const MetricSystemSCProcessingDuration = "erd_system_sc_processing_duration_ms"
+
+// MetricSystemSCValidatorUpdatesDuration is the metric that outputs the duration in milliseconds of the validator
+// updates phase of the last end of epoch system smart contracts processing
+const MetricSystemSCValidatorUpdatesDuration = "erd_system_sc_validator_updates_duration_ms"
+
+// MetricSystemSCUnJailSweepDuration is the metric that outputs the duration in milliseconds of the jailed nodes
+// swapping phase of the last end of epoch system smart contracts processing
+const MetricSystemSCUnJailSweepDuration = "erd_system_sc_unjail_sweep_duration_ms"
+
+// MetricSystemSCAuctionSelectionDuration is the metric that outputs the duration in milliseconds of the nodes unStake
+// and stake from queue phase of the last end of epoch system smart contracts processing
+const MetricSystemSCAuctionSelectionDuration = "erd_system_sc_auction_selection_duration_ms"
+
+// MetricSystemSCDelegationUpdatesDuration is the metric that outputs the duration in milliseconds spent updating the
+// delegation contracts during the last end of epoch system smart contracts processing
+const MetricSystemSCDelegationUpdatesDuration = "erd_system_sc_delegation_updates_duration_ms"
+
+// MetricSystemSCNumJailedSwapped is the metric that outputs the number of jailed nodes swapped with waiting nodes
+// during the last end of epoch system smart contracts processing
+const MetricSystemSCNumJailedSwapped = "erd_system_sc_num_jailed_swapped"
+
+// MetricSystemSCNumUnStaked is the metric that outputs the number of nodes unStaked for not having enough funds
+// during the last end of epoch system smart contracts processing
+const MetricSystemSCNumUnStaked = "erd_system_sc_num_unstaked"
+
+// MetricSystemSCNumStakedFromQueue is the metric that outputs the number of nodes staked from the queue during the
+// last end of epoch system smart contracts processing
+const MetricSystemSCNumStakedFromQueue = "erd_system_sc_num_staked_from_queue"
+
+// MetricSystemSCNumDelegationUpdates is the metric that outputs the number of delegation contracts updated during
+// the last end of epoch system smart contracts processing
+const MetricSystemSCNumDelegationUpdates = "erd_system_sc_num_delegation_updates"
+
+// MetricSystemSCNumNodesSwapped is the metric that outputs the number of BLS keys replaced by their owners through
+// swapNode during the last end of epoch system smart contracts processing
+const MetricSystemSCNumNodesSwapped = "erd_system_sc_num_nodes_swapped"
+
+// MetricCrossShardPendingMiniBlocks is the metric that outputs the number of notarized cross shard miniblocks not yet
+// executed in their destination shard
+const MetricCrossShardPendingMiniBlocks = "erd_cross_shard_pending_miniblocks"
+
+// MetricCrossShardPendingTxs is the metric that outputs the number of transactions from the notarized cross shard
+// miniblocks not yet executed in their destination shard
+const MetricCrossShardPendingTxs = "erd_cross_shard_pending_txs"
+
+// MetricCrossShardAverageNotarizationDelay is the metric that outputs the average duration in milliseconds between the
+// notarization of a cross shard miniblock in its source shard and the notarization of its execution in the destination
+const MetricCrossShardAverageNotarizationDelay = "erd_cross_shard_average_notarization_delay_ms"
+
+// MetricOutportUnhealthyDrivers is the metric that outputs the number of outport drivers that are lagging or buffering
+const MetricOutportUnhealthyDrivers = "erd_outport_unhealthy_drivers"
+
+// MetricOutportDriverStatePrefix is the prefix of the metrics that output the health state of each outport driver,
+// followed by the driver name
+const MetricOutportDriverStatePrefix = "erd_outport_driver_state_"
+
+// MetricOutportDriverPendingItemsPrefix is the prefix of the metrics that output the number of items waiting for their
+// delivery by each outport driver, followed by the driver name
+const MetricOutportDriverPendingItemsPrefix = "erd_outport_driver_pending_items_"
+
+// MetricOutportDriverDroppedItemsPrefix is the prefix of the metrics that output the number of items dropped by each
+// outport driver because its write-ahead log was full, followed by the driver name
+const MetricOutportDriverDroppedItemsPrefix = "erd_outport_driver_dropped_items_"
+
 // HighestRoundFromBootStorage is the key for the highest round that is saved in storage
 const HighestRoundFromBootStorage = "highestRoundFromBootStorage"
 
@@ -481,6 +607,9 @@ const BuiltInFunctionESDTPause = "ESDTPause"
 // BuiltInFunctionESDTUnPause is the key for the elrond standard digital token unpause built-in function
 const BuiltInFunctionESDTUnPause = "ESDTUnPause"
 
+// BuiltInFunctionSetDeveloperRewardsPercentage is the key for the set developer rewards percentage built-in function
+const BuiltInFunctionSetDeveloperRewardsPercentage = "SetDeveloperRewardsPercentage"
+
 // RelayedTransaction is the key for the elrond meta/gassless/relayed transaction standard
 const RelayedTransaction = "relayedTx"
 
@@ -508,6 +637,16 @@ const DelegationSystemSCKey = "delegation"
 // ESDTKeyIdentifier is the key prefix for esdt tokens
 const ESDTKeyIdentifier = "esdt"
 
+// VestingScheduleKey is the protected key under which an account keeps the vesting schedule of its genesis balance
+const VestingScheduleKey = ElrondProtectedKeyPrefix + "vestingSchedule"
+
+// DeveloperRewardsPercentageKey is the key under which a contract keeps its developer rewards percentage override
+const DeveloperRewardsPercentageKey = ElrondProtectedKeyPrefix + "developerRewardsPercentage"
+
+// DeveloperRewardsPercentageDenominator is the denominator of the developer rewards percentage override, which is
+// expressed in basis points
+const DeveloperRewardsPercentageDenominator = 10000
+
 // MaxSoftwareVersionLengthInBytes represents the maximum length for the software version to be saved in block header
 const MaxSoftwareVersionLengthInBytes = 10
 
@@ -537,7 +676,7 @@ const DefaultUnstakedEpoch = math.MaxUint32
 const InvalidMessageBlacklistDuration = time.Second * 3600
 
 // MaxNumShards represents the maximum number of shards possible in the system
-const MaxNumShards = 256
+const MaxNumShards = addressRouting.MaxNumShards
 
 // PublicKeyBlacklistDuration represents the time to keep a public key in the black list if it will degrade its
 // rating to a minimum threshold due to improper messages
@@ -110,6 +110,26 @@ func EpochStartIdentifier(epoch uint32) string {
 	return fmt.Sprintf("epochStartBlock_%d", epoch)
 }
 
+// RewardsAuditIdentifier returns the storage key of the rewards audit record of the provided epoch
+func RewardsAuditIdentifier(epoch uint32) string {
+	return fmt.Sprintf("rewardsAudit_%d", epoch)
+}
+
+// RewardsCheckpointIdentifier returns the storage key of the rewards checkpoint of the provided epoch
+func RewardsCheckpointIdentifier(epoch uint32) string {
+	return fmt.Sprintf("rewardsCheckpoint_%d", epoch)
+}
+
+// SupplyAccountingIdentifier returns the storage key of the supply accounting record of the provided epoch
+func SupplyAccountingIdentifier(epoch uint32) string {
+	return fmt.Sprintf("supplyAccounting_%d", epoch)
+}
+
+// EpochEconomicsIdentifier returns the storage key of the economics aggregates of the provided epoch
+func EpochEconomicsIdentifier(epoch uint32) string {
+	return fmt.Sprintf("epochEconomics_%d", epoch)
+}
+
 // IsUnknownEpochIdentifier return if the epoch identifier represents unknown epoch
 func IsUnknownEpochIdentifier(identifier []byte) (bool, error) {
 	splitString := strings.Split(string(identifier), "_")
@@ -8,6 +8,8 @@ import (
 
 var errCannotCastToBlockBody = errors.New("cannot cast to block body")
 
+var errInvalidLogsIndexRecord = errors.New("invalid logs index record")
+
 func newErrCannotSaveEpochByHash(what string, hash []byte, originalErr error) error {
 	return fmt.Errorf("cannot save epoch num for [%s] hash [%s]: %w", what, hex.EncodeToString(hash), originalErr)
 }
@@ -63,6 +63,8 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		EpochByHashStorer:           hpf.store.GetStorer(dataRetriever.EpochByHashUnit),
 		MiniblockHashByTxHashStorer: hpf.store.GetStorer(dataRetriever.MiniblockHashByTxHashUnit),
 		EventsHashesByTxHashStorer:  hpf.store.GetStorer(dataRetriever.ResultsHashesByTxHashUnit),
+		LogsIndexStorer:             hpf.store.GetStorer(dataRetriever.LogsIndexUnit),
+		TxLogsStorer:                hpf.store.GetStorer(dataRetriever.TxLogsUnit),
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
@@ -29,6 +29,8 @@ type HistoryRepositoryArguments struct {
 	MiniblockHashByTxHashStorer storage.Storer
 	EpochByHashStorer           storage.Storer
 	EventsHashesByTxHashStorer  storage.Storer
+	LogsIndexStorer             storage.Storer
+	TxLogsStorer                storage.Storer
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 }
@@ -39,6 +41,7 @@ type historyRepository struct {
 	miniblockHashByTxHashIndex storage.Storer
 	epochByHashIndex           *epochByHashIndex
 	eventsHashesByTxHashIndex  *eventsHashesByTxHash
+	logsIndex                  *logsIndex
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 
@@ -81,6 +84,12 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.EventsHashesByTxHashStorer) {
 		return nil, core.ErrNilStore
 	}
+	if check.IfNil(arguments.LogsIndexStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(arguments.TxLogsStorer) {
+		return nil, core.ErrNilStore
+	}
 
 	hashToEpochIndex := newHashToEpochIndex(arguments.EpochByHashStorer, arguments.Marshalizer)
 	deduplicationCacheForInsertMiniblockMetadata, _ := lrucache.NewCache(sizeOfDeduplicationCache)
@@ -99,6 +108,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		pendingNotarizedAtBothNotifications:          container.NewMutexMap(),
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
+		logsIndex:                                    newLogsIndex(arguments.LogsIndexStorer, arguments.TxLogsStorer, arguments.Marshalizer),
 	}, nil
 }
 
@@ -144,6 +154,8 @@ func (hr *historyRepository) RecordBlock(
 		return err
 	}
 
+	hr.logsIndex.recordBlockLogs(blockHeader, body)
+
 	return nil
 }
 
@@ -427,6 +439,18 @@ func (hr *historyRepository) GetResultsHashesByTxHash(txHash []byte, epoch uint3
 	return hr.eventsHashesByTxHashIndex.getEventsHashesByTxHash(txHash, epoch)
 }
 
+// GetLogsLocationsByAddress will return the locations of the transaction logs containing events of the provided
+// address, emitted in blocks with nonces between fromNonce and toNonce (inclusive)
+func (hr *historyRepository) GetLogsLocationsByAddress(address []byte, fromNonce uint64, toNonce uint64) ([]*LogsLocation, error) {
+	return hr.logsIndex.getLogsLocations(addressIndexPrefix, address, fromNonce, toNonce)
+}
+
+// GetLogsLocationsByIdentifier will return the locations of the transaction logs containing events with the provided
+// identifier, emitted in blocks with nonces between fromNonce and toNonce (inclusive)
+func (hr *historyRepository) GetLogsLocationsByIdentifier(identifier []byte, fromNonce uint64, toNonce uint64) ([]*LogsLocation, error) {
+	return hr.logsIndex.getLogsLocations(identifierIndexPrefix, identifier, fromNonce, toNonce)
+}
+
 // IsEnabled will always returns true
 func (hr *historyRepository) IsEnabled() bool {
 	return true
@@ -19,6 +19,8 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 		MiniblockHashByTxHashStorer: genericmocks.NewStorerMock("MiniblockHashByTxHash", epoch),
 		EpochByHashStorer:           genericmocks.NewStorerMock("EpochByHash", epoch),
 		EventsHashesByTxHashStorer:  genericmocks.NewStorerMock("EventsHashesByTxHash", epoch),
+		LogsIndexStorer:             genericmocks.NewStorerMock("LogsIndex", epoch),
+		TxLogsStorer:                genericmocks.NewStorerMock("TxLogs", epoch),
 		Marshalizer:                 &mock.MarshalizerMock{},
 		Hasher:                      &mock.HasherMock{},
 	}
@@ -53,6 +55,18 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, core.ErrNilStore, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.LogsIndexStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.TxLogsStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
 	args = createMockHistoryRepoArgs(0)
 	args.Hasher = nil
 	repo, err = NewHistoryRepository(args)
@@ -23,6 +23,8 @@ type HistoryRepository interface {
 	GetMiniblockMetadataByTxHash(hash []byte) (*MiniblockMetadata, error)
 	GetEpochByHash(hash []byte) (uint32, error)
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
+	GetLogsLocationsByAddress(address []byte, fromNonce uint64, toNonce uint64) ([]*LogsLocation, error)
+	GetLogsLocationsByIdentifier(identifier []byte, fromNonce uint64, toNonce uint64) ([]*LogsLocation, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
@@ -0,0 +1,222 @@
+package dblookupext
+
+import (
+	"encoding/binary"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// LogsIndexBlocksPerBucket is the number of consecutive block nonces whose logs locations are grouped under the same
+// index record
+const LogsIndexBlocksPerBucket = 100
+
+const (
+	addressIndexPrefix    = byte('a')
+	identifierIndexPrefix = byte('i')
+	lenNonce              = 8
+	lenEpoch              = 4
+	lenHashLen            = 1
+)
+
+// LogsLocation holds the coordinates of a transaction log that contains events of an indexed address or identifier
+type LogsLocation struct {
+	TxHash     []byte
+	BlockNonce uint64
+	Epoch      uint32
+}
+
+// logsIndex keeps, for each address and for each event identifier, the locations of the transaction logs that
+// contain them. The locations are grouped in buckets of LogsIndexBlocksPerBucket block nonces so that the logs
+// emitted in a range of blocks can be fetched without scanning the whole logs storage
+type logsIndex struct {
+	storer       storage.Storer
+	txLogsStorer storage.Storer
+	marshalizer  marshal.Marshalizer
+}
+
+func newLogsIndex(storer storage.Storer, txLogsStorer storage.Storer, marshalizer marshal.Marshalizer) *logsIndex {
+	return &logsIndex{
+		storer:       storer,
+		txLogsStorer: txLogsStorer,
+		marshalizer:  marshalizer,
+	}
+}
+
+func (li *logsIndex) recordBlockLogs(blockHeader data.HeaderHandler, body *block.Body) {
+	bucket := blockHeader.GetNonce() / LogsIndexBlocksPerBucket
+	locationsByKey := make(map[string][]*LogsLocation)
+	keysOrder := make([]string, 0)
+	addLocation := func(prefix byte, indexedValue []byte, location *LogsLocation) {
+		if len(indexedValue) == 0 {
+			return
+		}
+
+		key := string(createLogsIndexKey(prefix, indexedValue, bucket))
+		locations, found := locationsByKey[key]
+		if !found {
+			keysOrder = append(keysOrder, key)
+		}
+		if len(locations) > 0 && locations[len(locations)-1] == location {
+			return
+		}
+		locationsByKey[key] = append(locations, location)
+	}
+
+	for _, miniblock := range body.MiniBlocks {
+		if miniblock.Type == block.PeerBlock {
+			continue
+		}
+
+		for _, txHash := range miniblock.TxHashes {
+			txLog, err := li.getTxLog(txHash, blockHeader.GetEpoch())
+			if err != nil {
+				continue
+			}
+
+			location := &LogsLocation{
+				TxHash:     txHash,
+				BlockNonce: blockHeader.GetNonce(),
+				Epoch:      blockHeader.GetEpoch(),
+			}
+			addLocation(addressIndexPrefix, txLog.Address, location)
+			for _, event := range txLog.Events {
+				if event == nil {
+					continue
+				}
+				addLocation(addressIndexPrefix, event.Address, location)
+				addLocation(identifierIndexPrefix, event.Identifier, location)
+			}
+		}
+	}
+
+	for _, key := range keysOrder {
+		li.appendLocations([]byte(key), locationsByKey[key])
+	}
+}
+
+func (li *logsIndex) getTxLog(txHash []byte, epoch uint32) (*transaction.Log, error) {
+	txLogBytes, err := li.txLogsStorer.GetFromEpoch(txHash, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	txLog := &transaction.Log{}
+	err = li.marshalizer.Unmarshal(txLog, txLogBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return txLog, nil
+}
+
+func (li *logsIndex) appendLocations(key []byte, locations []*LogsLocation) {
+	buff, err := li.storer.Get(key)
+	if err != nil {
+		buff = make([]byte, 0)
+	}
+
+	for _, location := range locations {
+		buff = append(buff, encodeLogsLocation(location)...)
+	}
+
+	err = li.storer.Put(key, buff)
+	if err != nil {
+		log.Warn("logsIndex.appendLocations() cannot save logs locations", "error", err.Error())
+	}
+}
+
+func (li *logsIndex) getLogsLocations(prefix byte, indexedValue []byte, fromNonce uint64, toNonce uint64) ([]*LogsLocation, error) {
+	locations := make([]*LogsLocation, 0)
+	positionByTxHash := make(map[string]int)
+	for bucket := fromNonce / LogsIndexBlocksPerBucket; bucket <= toNonce/LogsIndexBlocksPerBucket; bucket++ {
+		buff, err := li.storer.Get(createLogsIndexKey(prefix, indexedValue, bucket))
+		if err != nil {
+			continue
+		}
+
+		bucketLocations, err := decodeLogsLocations(buff)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, location := range bucketLocations {
+			if location.BlockNonce < fromNonce || location.BlockNonce > toNonce {
+				continue
+			}
+
+			// a transaction recorded more than once (e.g. on a fork) keeps only its last known location
+			position, found := positionByTxHash[string(location.TxHash)]
+			if found {
+				locations[position] = location
+				continue
+			}
+
+			positionByTxHash[string(location.TxHash)] = len(locations)
+			locations = append(locations, location)
+		}
+	}
+
+	return locations, nil
+}
+
+func createLogsIndexKey(prefix byte, indexedValue []byte, bucket uint64) []byte {
+	key := make([]byte, 0, 1+4+len(indexedValue)+8)
+	key = append(key, prefix)
+	key = append(key, uint32ToBytes(uint32(len(indexedValue)))...)
+	key = append(key, indexedValue...)
+	key = append(key, uint64ToBytes(bucket)...)
+
+	return key
+}
+
+func encodeLogsLocation(location *LogsLocation) []byte {
+	buff := make([]byte, 0, lenNonce+lenEpoch+lenHashLen+len(location.TxHash))
+	buff = append(buff, uint64ToBytes(location.BlockNonce)...)
+	buff = append(buff, uint32ToBytes(location.Epoch)...)
+	buff = append(buff, byte(len(location.TxHash)))
+	buff = append(buff, location.TxHash...)
+
+	return buff
+}
+
+func decodeLogsLocations(buff []byte) ([]*LogsLocation, error) {
+	locations := make([]*LogsLocation, 0)
+	for len(buff) > 0 {
+		if len(buff) < lenNonce+lenEpoch+lenHashLen {
+			return nil, errInvalidLogsIndexRecord
+		}
+
+		hashLen := int(buff[lenNonce+lenEpoch])
+		recordLen := lenNonce + lenEpoch + lenHashLen + hashLen
+		if len(buff) < recordLen {
+			return nil, errInvalidLogsIndexRecord
+		}
+
+		locations = append(locations, &LogsLocation{
+			BlockNonce: binary.BigEndian.Uint64(buff[:lenNonce]),
+			Epoch:      binary.BigEndian.Uint32(buff[lenNonce : lenNonce+lenEpoch]),
+			TxHash:     buff[lenNonce+lenEpoch+lenHashLen : recordLen],
+		})
+		buff = buff[recordLen:]
+	}
+
+	return locations, nil
+}
+
+func uint64ToBytes(value uint64) []byte {
+	buff := make([]byte, lenNonce)
+	binary.BigEndian.PutUint64(buff, value)
+
+	return buff
+}
+
+func uint32ToBytes(value uint32) []byte {
+	buff := make([]byte, lenEpoch)
+	binary.BigEndian.PutUint32(buff, value)
+
+	return buff
+}
@@ -0,0 +1,87 @@
+package dblookupext
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/require"
+)
+
+func saveTxLog(t *testing.T, storer *genericmocks.StorerMock, txHash []byte, txLog *transaction.Log) {
+	buff, err := (&mock.MarshalizerMock{}).Marshal(txLog)
+	require.Nil(t, err)
+
+	err = storer.Put(txHash, buff)
+	require.Nil(t, err)
+}
+
+func TestLogsIndex_RecordBlockLogsAndGetLocations(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(2)
+	txLogsStorer := genericmocks.NewStorerMock("TxLogs", epoch)
+	index := newLogsIndex(genericmocks.NewStorerMock("LogsIndex", epoch), txLogsStorer, &mock.MarshalizerMock{})
+
+	saveTxLog(t, txLogsStorer, []byte("txA"), &transaction.Log{
+		Address: []byte("contract"),
+		Events: []*transaction.Event{
+			{Address: []byte("contract"), Identifier: []byte("transfer")},
+			{Address: []byte("token"), Identifier: []byte("transfer")},
+		},
+	})
+	saveTxLog(t, txLogsStorer, []byte("txB"), &transaction.Log{
+		Address: []byte("other"),
+		Events: []*transaction.Event{
+			{Address: []byte("other"), Identifier: []byte("mint")},
+		},
+	})
+
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("txA"), []byte("txWithoutLogs")}},
+			{TxHashes: [][]byte{[]byte("txB")}},
+			{TxHashes: [][]byte{[]byte("peerTx")}, Type: block.PeerBlock},
+		},
+	}
+	index.recordBlockLogs(&block.Header{Nonce: 199, Epoch: epoch}, body)
+	index.recordBlockLogs(&block.Header{Nonce: 200, Epoch: epoch}, &block.Body{
+		MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte("txB")}}},
+	})
+
+	locations, err := index.getLogsLocations(addressIndexPrefix, []byte("contract"), 0, 1000)
+	require.Nil(t, err)
+	require.Equal(t, []*LogsLocation{{TxHash: []byte("txA"), BlockNonce: 199, Epoch: epoch}}, locations)
+
+	locations, err = index.getLogsLocations(identifierIndexPrefix, []byte("transfer"), 199, 199)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(locations))
+
+	locations, err = index.getLogsLocations(identifierIndexPrefix, []byte("transfer"), 200, 1000)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(locations))
+
+	// txB recorded twice (e.g. on a fork) should be returned only once, with its last location
+	locations, err = index.getLogsLocations(identifierIndexPrefix, []byte("mint"), 0, 1000)
+	require.Nil(t, err)
+	require.Equal(t, []*LogsLocation{{TxHash: []byte("txB"), BlockNonce: 200, Epoch: epoch}}, locations)
+
+	locations, err = index.getLogsLocations(addressIndexPrefix, []byte("peerTx"), 0, 1000)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(locations))
+}
+
+func TestLogsIndex_DecodeInvalidRecordShouldErr(t *testing.T) {
+	t.Parallel()
+
+	buff := encodeLogsLocation(&LogsLocation{TxHash: []byte("txHash"), BlockNonce: 7, Epoch: 1})
+	locations, err := decodeLogsLocations(append(buff, buff[:len(buff)-1]...))
+	require.Nil(t, locations)
+	require.Equal(t, errInvalidLogsIndexRecord, err)
+
+	locations, err = decodeLogsLocations(append(buff, buff...))
+	require.Nil(t, err)
+	require.Equal(t, 2, len(locations))
+}
@@ -41,6 +41,16 @@ func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*
 	return nil, nil
 }
 
+// GetLogsLocationsByAddress -
+func (nhr *nilHistoryRepository) GetLogsLocationsByAddress(_ []byte, _ uint64, _ uint64) ([]*LogsLocation, error) {
+	return nil, nil
+}
+
+// GetLogsLocationsByIdentifier -
+func (nhr *nilHistoryRepository) GetLogsLocationsByIdentifier(_ []byte, _ uint64, _ uint64) ([]*LogsLocation, error) {
+	return nil, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nhr *nilHistoryRepository) IsInterfaceNil() bool {
 	return nhr == nil
@@ -139,24 +139,11 @@ func LoadSkPkFromPemFile(relativePath string, skIndex int) ([]byte, string, erro
 		return nil, "", ErrInvalidIndex
 	}
 
-	file, err := OpenFile(relativePath)
+	buff, err := readPemFile(relativePath)
 	if err != nil {
 		return nil, "", err
 	}
 
-	defer func() {
-		cerr := file.Close()
-		log.LogIfError(cerr)
-	}()
-
-	buff, err := ioutil.ReadAll(file)
-	if err != nil {
-		return nil, "", fmt.Errorf("%w while reading %s file", err, relativePath)
-	}
-	if len(buff) == 0 {
-		return nil, "", fmt.Errorf("%w while reading %s file", ErrEmptyFile, relativePath)
-	}
-
 	var blkRecovered *pem.Block
 
 	for i := 0; i <= skIndex; i++ {
@@ -175,6 +162,61 @@ func LoadSkPkFromPemFile(relativePath string, skIndex int) ([]byte, string, erro
 		return nil, "", ErrNilPemBLock
 	}
 
+	return getSkPkFromPemBlock(blkRecovered)
+}
+
+// LoadAllKeysFromPemFile loads all the secret keys and existing public keys stored in the file
+func LoadAllKeysFromPemFile(relativePath string) ([][]byte, []string, error) {
+	buff, err := readPemFile(relativePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var blkRecovered *pem.Block
+	secretKeys := make([][]byte, 0)
+	publicKeys := make([]string, 0)
+	for len(buff) > 0 {
+		blkRecovered, buff = pem.Decode(buff)
+		if blkRecovered == nil {
+			return nil, nil, fmt.Errorf("%w while reading %s file, error decoding key with index %d",
+				ErrPemFileIsInvalid, relativePath, len(secretKeys))
+		}
+
+		sk, pk, errBlock := getSkPkFromPemBlock(blkRecovered)
+		if errBlock != nil {
+			return nil, nil, errBlock
+		}
+
+		secretKeys = append(secretKeys, sk)
+		publicKeys = append(publicKeys, pk)
+	}
+
+	return secretKeys, publicKeys, nil
+}
+
+func readPemFile(relativePath string) ([]byte, error) {
+	file, err := OpenFile(relativePath)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		cerr := file.Close()
+		log.LogIfError(cerr)
+	}()
+
+	buff, err := ioutil.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading %s file", err, relativePath)
+	}
+	if len(buff) == 0 {
+		return nil, fmt.Errorf("%w while reading %s file", ErrEmptyFile, relativePath)
+	}
+
+	return buff, nil
+}
+
+func getSkPkFromPemBlock(blkRecovered *pem.Block) ([]byte, string, error) {
 	blockType := blkRecovered.Type
 	header := "PRIVATE KEY for "
 	if strings.Index(blockType, header) != 0 {
@@ -0,0 +1,226 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+type bufferedItemKind byte
+
+const (
+	bufferedBlock bufferedItemKind = iota
+	bufferedRevertedBlock
+	bufferedRounds
+	bufferedRating
+	bufferedValidators
+	bufferedTps
+	bufferedAccounts
+)
+
+type bufferedTxType byte
+
+const (
+	bufferedTransaction bufferedTxType = iota
+	bufferedSmartContractResult
+	bufferedRewardTx
+	bufferedReceipt
+)
+
+// bufferedTx is a transaction of the pool, marshalled with the node marshalizer
+type bufferedTx struct {
+	Hash string
+	Type bufferedTxType
+	Data []byte
+}
+
+// bufferedAccount holds the fields of a user account the elasticsearch indexes are built from
+type bufferedAccount struct {
+	Address []byte
+	Nonce   uint64
+	Balance *big.Int
+}
+
+// bufferedItem is a call of the data indexer serialized in its delivery buffer, so its fields are exported. The
+// work item is built from it only when it is delivered
+type bufferedItem struct {
+	Kind                   bufferedItemKind
+	IsMetaHeader           bool
+	Header                 []byte
+	Body                   []byte
+	Txs                    []bufferedTx
+	SignersIndexes         []uint64
+	NotarizedHeadersHashes []string
+	HeaderHash             []byte
+	RoundsInfo             []workItems.RoundInfo
+	IndexID                string
+	RatingInfo             []workItems.ValidatorRatingInfo
+	ValidatorsPubKeys      map[uint32][][]byte
+	Epoch                  uint32
+	Accounts               []bufferedAccount
+}
+
+func encodeBufferedItem(item *bufferedItem) ([]byte, error) {
+	buff := &bytes.Buffer{}
+	err := gob.NewEncoder(buff).Encode(item)
+	if err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+func decodeBufferedItem(buff []byte) (*bufferedItem, error) {
+	item := &bufferedItem{}
+	err := gob.NewDecoder(bytes.NewReader(buff)).Decode(item)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (item *bufferedItem) setBlock(
+	marshalizer marshal.Marshalizer,
+	bodyHandler data.BodyHandler,
+	headerHandler data.HeaderHandler,
+) error {
+	if check.IfNil(headerHandler) || check.IfNil(bodyHandler) {
+		return ErrNilHeaderHandler
+	}
+
+	var err error
+	_, item.IsMetaHeader = headerHandler.(*block.MetaBlock)
+	item.Header, err = marshalizer.Marshal(headerHandler)
+	if err != nil {
+		return err
+	}
+
+	item.Body, err = marshalizer.Marshal(bodyHandler)
+
+	return err
+}
+
+func (item *bufferedItem) getBlock(marshalizer marshal.Marshalizer) (data.BodyHandler, data.HeaderHandler, error) {
+	var header data.HeaderHandler = &block.Header{}
+	if item.IsMetaHeader {
+		header = &block.MetaBlock{}
+	}
+	err := marshalizer.Unmarshal(header, item.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := &block.Body{}
+	err = marshalizer.Unmarshal(body, item.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body, header, nil
+}
+
+func (item *bufferedItem) setTxPool(marshalizer marshal.Marshalizer, txPool map[string]data.TransactionHandler) error {
+	item.Txs = make([]bufferedTx, 0, len(txPool))
+	for hash, tx := range txPool {
+		var txType bufferedTxType
+		switch tx.(type) {
+		case *transaction.Transaction:
+			txType = bufferedTransaction
+		case *smartContractResult.SmartContractResult:
+			txType = bufferedSmartContractResult
+		case *rewardTx.RewardTx:
+			txType = bufferedRewardTx
+		case *receipt.Receipt:
+			txType = bufferedReceipt
+		default:
+			return fmt.Errorf("%w: %T", ErrUnknownTransactionType, tx)
+		}
+
+		buff, err := marshalizer.Marshal(tx)
+		if err != nil {
+			return err
+		}
+
+		item.Txs = append(item.Txs, bufferedTx{
+			Hash: hash,
+			Type: txType,
+			Data: buff,
+		})
+	}
+
+	return nil
+}
+
+func (item *bufferedItem) getTxPool(marshalizer marshal.Marshalizer) (map[string]data.TransactionHandler, error) {
+	txPool := make(map[string]data.TransactionHandler, len(item.Txs))
+	for _, bufferedTx := range item.Txs {
+		var tx data.TransactionHandler
+		switch bufferedTx.Type {
+		case bufferedTransaction:
+			tx = &transaction.Transaction{}
+		case bufferedSmartContractResult:
+			tx = &smartContractResult.SmartContractResult{}
+		case bufferedRewardTx:
+			tx = &rewardTx.RewardTx{}
+		case bufferedReceipt:
+			tx = &receipt.Receipt{}
+		default:
+			return nil, fmt.Errorf("%w: %d", ErrUnknownTransactionType, bufferedTx.Type)
+		}
+
+		err := marshalizer.Unmarshal(tx, bufferedTx.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		txPool[bufferedTx.Hash] = tx
+	}
+
+	return txPool, nil
+}
+
+func (item *bufferedItem) setAccounts(accounts []state.UserAccountHandler) {
+	item.Accounts = make([]bufferedAccount, 0, len(accounts))
+	for _, account := range accounts {
+		item.Accounts = append(item.Accounts, bufferedAccount{
+			Address: account.AddressBytes(),
+			Nonce:   account.GetNonce(),
+			Balance: account.GetBalance(),
+		})
+	}
+}
+
+func (item *bufferedItem) getAccounts() ([]state.UserAccountHandler, error) {
+	accounts := make([]state.UserAccountHandler, 0, len(item.Accounts))
+	for _, bufferedAccount := range item.Accounts {
+		account, err := state.NewUserAccount(bufferedAccount.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		account.IncreaseNonce(bufferedAccount.Nonce)
+		if bufferedAccount.Balance != nil {
+			err = account.AddToBalance(bufferedAccount.Balance)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
@@ -105,7 +105,7 @@ func (cm *commonProcessor) buildTransaction(
 	txStatus string,
 ) *Transaction {
 	gasUsed := cm.txFeeCalculator.ComputeGasLimit(tx)
-	fee := cm.txFeeCalculator.ComputeTxFeeBasedOnGasUsed(tx, gasUsed)
+	fee := cm.txFeeCalculator.ComputeTxFeeBasedOnGasUsedInEpoch(tx, gasUsed, header.GetEpoch())
 
 	return &Transaction{
 		Hash:             hex.EncodeToString(txHash),
@@ -128,6 +128,7 @@ func (cm *commonProcessor) buildTransaction(
 		ReceiverUserName: tx.RcvUserName,
 		SenderUserName:   tx.SndUserName,
 		rcvAddrBytes:     tx.RcvAddr,
+		epoch:            header.GetEpoch(),
 	}
 }
 
@@ -487,6 +488,100 @@ func prepareSerializedAccountBalanceHistory(address string, account *AccountBala
 	return meta, serializedData, nil
 }
 
+func serializeAccountsESDT(accountsESDT map[string]*AccountESDT) ([]bytes.Buffer, error) {
+	var buff bytes.Buffer
+	buffSlice := make([]bytes.Buffer, 0)
+	for id, accESDT := range accountsESDT {
+		meta := []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s" } }%s`, id, "\n"))
+		serializedData, err := json.Marshal(accESDT)
+		if err != nil {
+			log.Warn("cannot serialize account esdt", "id", id, "error", err)
+			return nil, err
+		}
+
+		// append a newline for each element
+		serializedData = append(serializedData, "\n"...)
+
+		buffLenWithCurrentAccountESDT := buff.Len() + len(meta) + len(serializedData)
+		if buffLenWithCurrentAccountESDT > bulkSizeThreshold && buff.Len() != 0 {
+			buffSlice = append(buffSlice, buff)
+			buff = bytes.Buffer{}
+		}
+
+		buff.Grow(len(meta) + len(serializedData))
+		_, err = buff.Write(meta)
+		if err != nil {
+			log.Warn("elastic search: serialize bulk accounts esdt, write meta", "error", err.Error())
+			return nil, err
+		}
+		_, err = buff.Write(serializedData)
+		if err != nil {
+			log.Warn("elastic search: serialize bulk accounts esdt, write serialized account esdt", "error", err.Error())
+			return nil, err
+		}
+	}
+
+	// check if the last buffer contains data
+	if buff.Len() != 0 {
+		buffSlice = append(buffSlice, buff)
+	}
+
+	return buffSlice, nil
+}
+
+func isESDTBalanceFunction(function string) bool {
+	switch function {
+	case core.BuiltInFunctionESDTTransfer, core.BuiltInFunctionESDTBurn, core.BuiltInFunctionESDTWipe:
+		return true
+	default:
+		return false
+	}
+}
+
+func getAccountESDTDocID(address string, token string) string {
+	return fmt.Sprintf("%s_%s", address, token)
+}
+
+func getBalanceHistoryDocID(entry *AccountBalanceHistory) string {
+	if len(entry.Token) == 0 {
+		return fmt.Sprintf("%s_%s", entry.Address, entry.BlockHash)
+	}
+
+	return fmt.Sprintf("%s_%s_%s", entry.Address, entry.Token, entry.BlockHash)
+}
+
+// createBalanceHistoryEntry returns the history entry of the provided balance, or nil if the balance did not change
+// since it was last indexed. A balance not indexed before is considered to have been 0
+func createBalanceHistoryEntry(
+	template AccountBalanceHistory,
+	address string,
+	token string,
+	balance string,
+	previousBalance string,
+) *AccountBalanceHistory {
+	current, ok := big.NewInt(0).SetString(balance, 10)
+	if !ok {
+		return nil
+	}
+	previous, ok := big.NewInt(0).SetString(previousBalance, 10)
+	if !ok {
+		previous = big.NewInt(0)
+	}
+
+	delta := big.NewInt(0).Sub(current, previous)
+	if delta.Sign() == 0 {
+		return nil
+	}
+
+	entry := template
+	entry.Address = address
+	entry.Token = token
+	entry.Balance = balance
+	entry.Delta = delta.String()
+
+	return &entry
+}
+
 func isCrossShardDstMe(tx *Transaction, selfShardID uint32) bool {
 	return tx.SenderShard != tx.ReceiverShard && tx.ReceiverShard == selfShardID
 }
@@ -495,6 +590,38 @@ func isIntraShardOrInvalid(tx *Transaction, selfShardID uint32) bool {
 	return (tx.SenderShard == tx.ReceiverShard && tx.ReceiverShard == selfShardID) || tx.Status == transaction.TxStatusInvalid.String()
 }
 
+// getDecodedBalancesMultiGet returns the balances of the found documents, keyed by their IDs
+func getDecodedBalancesMultiGet(response objectsMap) map[string]string {
+	balances := make(map[string]string)
+	interfaceSlice, ok := response["docs"].([]interface{})
+	if !ok {
+		return balances
+	}
+
+	for _, element := range interfaceSlice {
+		obj, isMap := element.(objectsMap)
+		if !isMap {
+			continue
+		}
+		id, isString := obj["_id"].(string)
+		if !isString {
+			continue
+		}
+		source, isMap := obj["_source"].(objectsMap)
+		if !isMap {
+			continue
+		}
+		balance, isString := source["balance"].(string)
+		if !isString {
+			continue
+		}
+
+		balances[id] = balance
+	}
+
+	return balances
+}
+
 func getDecodedResponseMultiGet(response objectsMap) map[string]bool {
 	founded := make(map[string]bool)
 	interfaceSlice, ok := response["docs"].([]interface{})
@@ -520,7 +647,7 @@ func GetElasticTemplatesAndPolicies(path string, useKibana bool) (map[string]*by
 	indexPolicies := make(map[string]*bytes.Buffer)
 	var err error
 
-	indexes := []string{"opendistro", txIndex, blockIndex, miniblocksIndex, tpsIndex, ratingIndex, roundIndex, validatorsIndex, accountsIndex, accountsHistoryIndex}
+	indexes := []string{"opendistro", txIndex, blockIndex, miniblocksIndex, tpsIndex, ratingIndex, roundIndex, validatorsIndex, accountsIndex, accountsHistoryIndex, accountsESDTIndex}
 	for _, index := range indexes {
 		indexTemplates[index], err = getTemplateByIndex(path, index)
 		if err != nil {
@@ -25,7 +25,7 @@ func createCommonProcessor() commonProcessor {
 		addressPubkeyConverter:   mock.NewPubkeyConverterMock(32),
 		validatorPubkeyConverter: mock.NewPubkeyConverterMock(32),
 		txFeeCalculator: &economicsmocks.EconomicsHandlerStub{
-			ComputeTxFeeBasedOnGasUsedCalled: func(tx process.TransactionWithFeeHandler, gasUsed uint64) *big.Int {
+			ComputeTxFeeBasedOnGasUsedInEpochCalled: func(tx process.TransactionWithFeeHandler, gasUsed uint64, epoch uint32) *big.Int {
 				return big.NewInt(100)
 			},
 			ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
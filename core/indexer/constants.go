@@ -16,6 +16,7 @@ const (
 	ratingIndex          = "rating"
 	accountsIndex        = "accounts"
 	accountsHistoryIndex = "accountshistory"
+	accountsESDTIndex    = "accountsesdt"
 
 	txPolicy              = "transactions_policy"
 	blockPolicy           = "blocks_policy"
@@ -35,6 +35,7 @@ type Transaction struct {
 	ReceiverUserName     []byte        `json:"receiverUsername,omitempty"`
 	Log                  TxLog         `json:"-"`
 	rcvAddrBytes         []byte
+	epoch                uint32
 }
 
 // GetGasLimit will return transaction gas limit
@@ -136,11 +137,27 @@ type AccountInfo struct {
 	BalanceNum float64 `json:"balanceNum"`
 }
 
-// AccountBalanceHistory represents an entry in the user accounts balances history
+// AccountBalanceHistory represents an entry in the user accounts balances history. The entries written for a block
+// hold the EGLD balance, or the ESDT token balance, after the block together with the change made by the block
 type AccountBalanceHistory struct {
-	Address   string `json:"address"`
-	Timestamp int64  `json:"timestamp"`
-	Balance   string `json:"balance"`
+	Address    string `json:"address"`
+	Timestamp  int64  `json:"timestamp"`
+	Balance    string `json:"balance"`
+	Token      string `json:"token,omitempty"`
+	Delta      string `json:"delta,omitempty"`
+	BlockHash  string `json:"blockHash,omitempty"`
+	BlockNonce uint64 `json:"blockNonce,omitempty"`
+	ShardID    uint32 `json:"shardId"`
+}
+
+// AccountESDT holds (serializable) data about the balance of a holder of an ESDT token. BalanceNum is the balance
+// without the token decimals applied, used to sort the holders, and Timestamp is the time of the last balance update
+type AccountESDT struct {
+	Address    string  `json:"address"`
+	Token      string  `json:"token"`
+	Balance    string  `json:"balance"`
+	BalanceNum float64 `json:"balanceNum"`
+	Timestamp  int64   `json:"timestamp"`
 }
 
 // ValidatorsRatingInfo is a structure containing validators information
@@ -12,7 +12,10 @@ import (
 
 var log = logger.GetOrCreate("core/indexer")
 
-const durationBetweenErrorRetry = time.Second * 3
+const (
+	durationBetweenErrorRetry = time.Second * 3
+	elasticSearchDriverName   = "elasticsearch"
+)
 
 // Options structure holds the indexer's configuration options
 type Options struct {
@@ -25,20 +28,34 @@ const (
 	maxBackOff  = time.Minute * 5
 )
 
+// ArgsDataDispatcher is the DTO used to create a new instance of dataDispatcher
+type ArgsDataDispatcher struct {
+	CacheSize int
+	// LagThreshold is the number of queued items above which the dispatcher is reported as lagging
+	LagThreshold uint32
+	// MaxRetryInterval caps the exponentially growing wait between the retries of a failed save
+	MaxRetryInterval time.Duration
+}
+
 type dataDispatcher struct {
 	backOffTime   time.Duration
+	errorBackOff  *ExponentialBackOff
+	health        *DriverHealth
 	chanWorkItems chan workItems.WorkItemHandler
+	pendingItems  PendingItems
 	cancelFunc    func()
 }
 
 // NewDataDispatcher creates a new dataDispatcher instance, capable of saving sequentially data in elasticsearch database
-func NewDataDispatcher(cacheSize int) (*dataDispatcher, error) {
-	if cacheSize < 0 {
+func NewDataDispatcher(args ArgsDataDispatcher) (*dataDispatcher, error) {
+	if args.CacheSize < 0 {
 		return nil, ErrNegativeCacheSize
 	}
 
 	dd := &dataDispatcher{
-		chanWorkItems: make(chan workItems.WorkItemHandler, cacheSize),
+		errorBackOff:  NewExponentialBackOff(durationBetweenErrorRetry, args.MaxRetryInterval),
+		health:        NewDriverHealth(elasticSearchDriverName, args.LagThreshold),
+		chanWorkItems: make(chan workItems.WorkItemHandler, args.CacheSize),
 	}
 
 	return dd, nil
@@ -60,6 +77,7 @@ func (d *dataDispatcher) startWorker(ctx context.Context) {
 			return
 		case wi := <-d.chanWorkItems:
 			d.doWork(wi)
+			d.pendingItems.Done()
 		}
 	}
 }
@@ -80,9 +98,20 @@ func (d *dataDispatcher) Add(item workItems.WorkItemHandler) {
 		return
 	}
 
+	d.pendingItems.Add()
 	d.chanWorkItems <- item
 }
 
+// Flush blocks until all the added items are saved or the context is done
+func (d *dataDispatcher) Flush(ctx context.Context) error {
+	return d.pendingItems.Wait(ctx)
+}
+
+// DriverStatus returns the health of the deliveries to the elasticsearch database
+func (d *dataDispatcher) DriverStatus() DriverStatus {
+	return d.health.Status(d.pendingItems.Len())
+}
+
 func (d *dataDispatcher) doWork(wi workItems.WorkItemHandler) {
 	for {
 		err := wi.Save()
@@ -90,6 +119,7 @@ func (d *dataDispatcher) doWork(wi workItems.WorkItemHandler) {
 			log.Warn("dataDispatcher.doWork could not index item",
 				"received back off:", err.Error())
 
+			d.health.DeliveryFailed(err)
 			d.increaseBackOffTime()
 			time.Sleep(d.backOffTime)
 
@@ -98,12 +128,19 @@ func (d *dataDispatcher) doWork(wi workItems.WorkItemHandler) {
 
 		d.backOffTime = 0
 		if err != nil {
-			log.Warn("dataDispatcher.doWork could not index item (will retry)", "error", err.Error())
-			time.Sleep(durationBetweenErrorRetry)
+			retryInterval := d.errorBackOff.NextInterval()
+			log.Warn("dataDispatcher.doWork could not index item (will retry)",
+				"error", err.Error(), "retry in", retryInterval)
+
+			d.health.DeliveryFailed(err)
+			time.Sleep(retryInterval)
 
 			continue
 		}
 
+		d.errorBackOff.Reset()
+		d.health.DeliverySucceeded()
+
 		return
 	}
 
@@ -1,6 +1,7 @@
 package indexer
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -16,7 +17,7 @@ import (
 func TestNewDataDispatcher_InvalidCacheSize(t *testing.T) {
 	t.Parallel()
 
-	dataDist, err := NewDataDispatcher(-1)
+	dataDist, err := NewDataDispatcher(ArgsDataDispatcher{CacheSize: -1})
 
 	require.Nil(t, dataDist)
 	require.Equal(t, ErrNegativeCacheSize, err)
@@ -25,7 +26,7 @@ func TestNewDataDispatcher_InvalidCacheSize(t *testing.T) {
 func TestNewDataDispatcher(t *testing.T) {
 	t.Parallel()
 
-	dispatcher, err := NewDataDispatcher(100)
+	dispatcher, err := NewDataDispatcher(ArgsDataDispatcher{CacheSize: 100})
 	require.NoError(t, err)
 	require.NotNil(t, dispatcher)
 }
@@ -33,7 +34,7 @@ func TestNewDataDispatcher(t *testing.T) {
 func TestDataDispatcher_StartIndexDataClose(t *testing.T) {
 	t.Parallel()
 
-	dispatcher, err := NewDataDispatcher(100)
+	dispatcher, err := NewDataDispatcher(ArgsDataDispatcher{CacheSize: 100})
 	require.NoError(t, err)
 	dispatcher.StartIndexData()
 
@@ -59,7 +60,7 @@ func TestDataDispatcher_StartIndexDataClose(t *testing.T) {
 func TestDataDispatcher_Add(t *testing.T) {
 	t.Parallel()
 
-	dispatcher, err := NewDataDispatcher(100)
+	dispatcher, err := NewDataDispatcher(ArgsDataDispatcher{CacheSize: 100})
 	require.NoError(t, err)
 	dispatcher.StartIndexData()
 
@@ -95,7 +96,7 @@ func TestDataDispatcher_Add(t *testing.T) {
 func TestDataDispatcher_AddWithErrorShouldRetryTheReprocessing(t *testing.T) {
 	t.Parallel()
 
-	dispatcher, err := NewDataDispatcher(100)
+	dispatcher, err := NewDataDispatcher(ArgsDataDispatcher{CacheSize: 100})
 	require.NoError(t, err)
 	dispatcher.StartIndexData()
 
@@ -127,3 +128,30 @@ func TestDataDispatcher_AddWithErrorShouldRetryTheReprocessing(t *testing.T) {
 	err = dispatcher.Close()
 	require.NoError(t, err)
 }
+
+func TestDataDispatcher_FlushShouldWaitForTheAddedItems(t *testing.T) {
+	t.Parallel()
+
+	dispatcher, err := NewDataDispatcher(ArgsDataDispatcher{CacheSize: 100})
+	require.NoError(t, err)
+	dispatcher.StartIndexData()
+
+	savedCount := uint32(0)
+	elasticProc := &mock.ElasticProcessorStub{
+		SaveRoundsInfoCalled: func(infos []workItems.RoundInfo) error {
+			time.Sleep(time.Millisecond * 10)
+			atomic.AddUint32(&savedCount, 1)
+			return nil
+		},
+	}
+
+	dispatcher.Add(workItems.NewItemRounds(elasticProc, []workItems.RoundInfo{}))
+	dispatcher.Add(workItems.NewItemRounds(elasticProc, []workItems.RoundInfo{}))
+
+	err = dispatcher.Flush(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), atomic.LoadUint32(&savedCount))
+
+	err = dispatcher.Close()
+	require.NoError(t, err)
+}
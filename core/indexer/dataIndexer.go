@@ -1,8 +1,13 @@
 package indexer
 
 import (
+	"context"
+	"sync"
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/deliveryBuffer"
 	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -14,6 +19,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
+// dataIndexer hands the data to the dispatcher as work items. If a delivery buffer is provided, each call is first
+// serialized in the buffer and the work item is built and dispatched, one at a time, on a separate go routine, so a
+// write-ahead log keeps the data not yet indexed across restarts
 type dataIndexer struct {
 	isNilIndexer     bool
 	dispatcher       DispatcherHandler
@@ -21,6 +29,11 @@ type dataIndexer struct {
 	elasticProcessor ElasticProcessor
 	options          *Options
 	marshalizer      marshal.Marshalizer
+	buffer           DeliveryBuffer
+	pendingItems     PendingItems
+	mutTpsBenchmark  sync.RWMutex
+	tpsBenchmark     statistics.TPSBenchmark
+	cancelFunc       func()
 }
 
 // NewDataIndexer will create a new data indexer
@@ -37,6 +50,18 @@ func NewDataIndexer(arguments ArgDataIndexer) (Indexer, error) {
 		elasticProcessor: arguments.ElasticProcessor,
 		marshalizer:      arguments.Marshalizer,
 		options:          arguments.Options,
+		cancelFunc:       func() {},
+	}
+
+	if !check.IfNil(arguments.Buffer) {
+		dataIndexerObj.buffer = arguments.Buffer
+		for i := 0; i < arguments.Buffer.Len(); i++ {
+			dataIndexerObj.pendingItems.Add()
+		}
+
+		var ctx context.Context
+		ctx, dataIndexerObj.cancelFunc = context.WithCancel(context.Background())
+		go dataIndexerObj.deliverLoop(ctx)
 	}
 
 	if arguments.ShardCoordinator.SelfId() == core.MetachainShardId {
@@ -95,6 +120,22 @@ func (di *dataIndexer) SaveBlock(
 	notarizedHeadersHashes []string,
 	headerHash []byte,
 ) {
+	if di.isBuffered() {
+		item := &bufferedItem{
+			Kind:                   bufferedBlock,
+			SignersIndexes:         signersIndexes,
+			NotarizedHeadersHashes: notarizedHeadersHashes,
+			HeaderHash:             headerHash,
+		}
+		err := item.setBlock(di.marshalizer, bodyHandler, headerHandler)
+		if err == nil {
+			err = item.setTxPool(di.marshalizer, txPool)
+		}
+		di.appendToBuffer(item, err)
+
+		return
+	}
+
 	wi := workItems.NewItemBlock(
 		di.elasticProcessor,
 		di.marshalizer,
@@ -108,13 +149,182 @@ func (di *dataIndexer) SaveBlock(
 	di.dispatcher.Add(wi)
 }
 
+func (di *dataIndexer) isBuffered() bool {
+	return di.buffer != nil
+}
+
+func (di *dataIndexer) appendToBuffer(item *bufferedItem, err error) {
+	var buff []byte
+	if err == nil {
+		buff, err = encodeBufferedItem(item)
+	}
+	if err != nil {
+		log.Warn("dataIndexer: can not serialize the item", "kind", item.Kind, "error", err.Error())
+		return
+	}
+
+	di.pendingItems.Add()
+	err = di.buffer.Append(buff)
+	if err != nil {
+		di.pendingItems.Done()
+	}
+	if err != nil && err != deliveryBuffer.ErrBufferFull {
+		log.Warn("dataIndexer: can not buffer the item", "kind", item.Kind, "error", err.Error())
+	}
+}
+
+func (di *dataIndexer) deliverLoop(ctx context.Context) {
+	for {
+		buff, err := di.buffer.Peek(ctx)
+		if ctx.Err() != nil || err == deliveryBuffer.ErrBufferClosed {
+			log.Debug("dataIndexer's go routine is stopping...")
+			return
+		}
+		if err != nil {
+			log.Warn("dataIndexer could not read the delivery buffer (will retry)", "error", err.Error())
+			select {
+			case <-ctx.Done():
+			case <-time.After(durationBetweenErrorRetry):
+			}
+			continue
+		}
+
+		wi, err := di.createWorkItem(buff)
+		if err != nil {
+			log.Error("dataIndexer: dropping a corrupted buffered item", "error", err.Error())
+		}
+		if wi != nil {
+			di.dispatcher.Add(wi)
+			err = di.dispatcher.Flush(ctx)
+			if err != nil {
+				// the item was not indexed, it stays in the buffer
+				log.Debug("dataIndexer's go routine is stopping...")
+				return
+			}
+		}
+
+		err = di.buffer.Pop()
+		if err != nil {
+			log.Warn("dataIndexer could not remove an indexed item from the delivery buffer", "error", err.Error())
+		}
+		di.pendingItems.Done()
+	}
+}
+
+func (di *dataIndexer) createWorkItem(buff []byte) (workItems.WorkItemHandler, error) {
+	item, err := decodeBufferedItem(buff)
+	if err != nil {
+		return nil, err
+	}
+
+	switch item.Kind {
+	case bufferedBlock:
+		body, header, errBlock := item.getBlock(di.marshalizer)
+		if errBlock != nil {
+			return nil, errBlock
+		}
+		txPool, errTxs := item.getTxPool(di.marshalizer)
+		if errTxs != nil {
+			return nil, errTxs
+		}
+
+		return workItems.NewItemBlock(
+			di.elasticProcessor,
+			di.marshalizer,
+			body,
+			header,
+			txPool,
+			item.SignersIndexes,
+			item.NotarizedHeadersHashes,
+			item.HeaderHash,
+		), nil
+	case bufferedRevertedBlock:
+		body, header, errBlock := item.getBlock(di.marshalizer)
+		if errBlock != nil {
+			return nil, errBlock
+		}
+
+		return workItems.NewItemRemoveBlock(di.elasticProcessor, body, header), nil
+	case bufferedRounds:
+		return workItems.NewItemRounds(di.elasticProcessor, item.RoundsInfo), nil
+	case bufferedRating:
+		return workItems.NewItemRating(di.elasticProcessor, item.IndexID, item.RatingInfo), nil
+	case bufferedValidators:
+		return workItems.NewItemValidators(di.elasticProcessor, item.Epoch, item.ValidatorsPubKeys), nil
+	case bufferedTps:
+		// the statistics are not serialized, the latest ones are indexed instead
+		di.mutTpsBenchmark.RLock()
+		tpsBenchmark := di.tpsBenchmark
+		di.mutTpsBenchmark.RUnlock()
+		if tpsBenchmark == nil {
+			return nil, nil
+		}
+
+		return workItems.NewItemTpsBenchmark(di.elasticProcessor, tpsBenchmark), nil
+	case bufferedAccounts:
+		accounts, errAccounts := item.getAccounts()
+		if errAccounts != nil {
+			return nil, errAccounts
+		}
+
+		return workItems.NewItemAccounts(di.elasticProcessor, accounts), nil
+	default:
+		return nil, ErrUnknownBufferedItemKind
+	}
+}
+
 // Close will stop goroutine that index data in database
 func (di *dataIndexer) Close() error {
-	return di.dispatcher.Close()
+	di.cancelFunc()
+
+	errDispatcher := di.dispatcher.Close()
+	if di.isBuffered() {
+		errBuffer := di.buffer.Close()
+		if errBuffer != nil {
+			return errBuffer
+		}
+	}
+
+	return errDispatcher
+}
+
+// Flush blocks until all the queued data is saved in the database or the context is done
+func (di *dataIndexer) Flush(ctx context.Context) error {
+	err := di.pendingItems.Wait(ctx)
+	if err != nil {
+		return err
+	}
+
+	return di.dispatcher.Flush(ctx)
+}
+
+// DriverStatus returns the health of the deliveries to the elasticsearch database
+func (di *dataIndexer) DriverStatus() DriverStatus {
+	status := DriverStatus{
+		Name:  elasticSearchDriverName,
+		State: DriverStateConnected,
+	}
+	statusHandler, ok := di.dispatcher.(DriverStatusHandler)
+	if ok {
+		status = statusHandler.DriverStatus()
+	}
+	if di.isBuffered() {
+		// a dispatched item stays in the buffer until it is indexed
+		status.PendingItems = di.pendingItems.Len()
+		status.DroppedItems = di.buffer.NumDroppedItems()
+	}
+
+	return status
 }
 
 // RevertIndexedBlock will remove from database block and miniblocks
 func (di *dataIndexer) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) {
+	if di.isBuffered() {
+		item := &bufferedItem{Kind: bufferedRevertedBlock}
+		di.appendToBuffer(item, item.setBlock(di.marshalizer, body, header))
+		return
+	}
+
 	wi := workItems.NewItemRemoveBlock(
 		di.elasticProcessor,
 		body,
@@ -125,12 +335,22 @@ func (di *dataIndexer) RevertIndexedBlock(header data.HeaderHandler, body data.B
 
 // SaveRoundsInfo will save data about a slice of rounds in elasticsearch
 func (di *dataIndexer) SaveRoundsInfo(roundsInfo []workItems.RoundInfo) {
+	if di.isBuffered() {
+		di.appendToBuffer(&bufferedItem{Kind: bufferedRounds, RoundsInfo: roundsInfo}, nil)
+		return
+	}
+
 	wi := workItems.NewItemRounds(di.elasticProcessor, roundsInfo)
 	di.dispatcher.Add(wi)
 }
 
 // SaveValidatorsRating will save all validators rating info to elasticsearch
 func (di *dataIndexer) SaveValidatorsRating(indexID string, validatorsRatingInfo []workItems.ValidatorRatingInfo) {
+	if di.isBuffered() {
+		di.appendToBuffer(&bufferedItem{Kind: bufferedRating, IndexID: indexID, RatingInfo: validatorsRatingInfo}, nil)
+		return
+	}
+
 	wi := workItems.NewItemRating(
 		di.elasticProcessor,
 		indexID,
@@ -141,6 +361,11 @@ func (di *dataIndexer) SaveValidatorsRating(indexID string, validatorsRatingInfo
 
 // SaveValidatorsPubKeys will save all validators public keys to elasticsearch
 func (di *dataIndexer) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) {
+	if di.isBuffered() {
+		di.appendToBuffer(&bufferedItem{Kind: bufferedValidators, ValidatorsPubKeys: validatorsPubKeys, Epoch: epoch}, nil)
+		return
+	}
+
 	wi := workItems.NewItemValidators(
 		di.elasticProcessor,
 		epoch,
@@ -155,6 +380,14 @@ func (di *dataIndexer) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
 		log.Debug("indexer: update tps called, but the tpsBenchmark is nil")
 		return
 	}
+	if di.isBuffered() {
+		di.mutTpsBenchmark.Lock()
+		di.tpsBenchmark = tpsBenchmark
+		di.mutTpsBenchmark.Unlock()
+
+		di.appendToBuffer(&bufferedItem{Kind: bufferedTps}, nil)
+		return
+	}
 
 	wi := workItems.NewItemTpsBenchmark(di.elasticProcessor, tpsBenchmark)
 	di.dispatcher.Add(wi)
@@ -162,6 +395,13 @@ func (di *dataIndexer) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
 
 // SaveAccounts will save the provided accounts
 func (di *dataIndexer) SaveAccounts(accounts []state.UserAccountHandler) {
+	if di.isBuffered() {
+		item := &bufferedItem{Kind: bufferedAccounts}
+		item.setAccounts(accounts)
+		di.appendToBuffer(item, nil)
+		return
+	}
+
 	wi := workItems.NewItemAccounts(di.elasticProcessor, accounts)
 	di.dispatcher.Add(wi)
 }
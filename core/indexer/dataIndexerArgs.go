@@ -20,6 +20,9 @@ type ArgDataIndexer struct {
 	Options            *Options
 	DataDispatcher     DispatcherHandler
 	ElasticProcessor   ElasticProcessor
+	// Buffer, if set, holds the serialized calls until they are indexed, so a write-ahead log keeps them across
+	// restarts. The work items are then handed to the dispatcher one at a time
+	Buffer DeliveryBuffer
 }
 
 //ArgElasticProcessor is struct that is used to store all components that are needed to an elastic indexer
@@ -2,6 +2,7 @@ package indexer
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"fmt"
 	"math/big"
@@ -12,15 +13,20 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/deliveryBuffer"
 	"github.com/ElrondNetwork/elrond-go/core/indexer/disabled"
 	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
 	"github.com/ElrondNetwork/elrond-go/core/mock"
 	"github.com/ElrondNetwork/elrond-go/data"
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/elastic/go-elasticsearch/v7"
 	"github.com/stretchr/testify/assert"
@@ -338,6 +344,129 @@ func TestDataIndexer_EpochChangeValidators(t *testing.T) {
 	assert.True(t, secondEpochCalled)
 }
 
+func createBufferedDataIndexerArguments(persister storage.Persister, elasticProcessor ElasticProcessor) ArgDataIndexer {
+	arguments := NewDataIndexerArguments()
+	arguments.Marshalizer = &marshal.GogoProtoMarshalizer{}
+	arguments.ElasticProcessor = elasticProcessor
+	arguments.DataDispatcher = &mock.DispatcherMock{
+		AddCalled: func(item workItems.WorkItemHandler) {
+			_ = item.Save()
+		},
+	}
+	arguments.Buffer, _ = deliveryBuffer.NewWriteAheadLog(deliveryBuffer.ArgsWriteAheadLog{
+		Persister:      persister,
+		MaxSizeInBytes: 1 << 20,
+	})
+
+	return arguments
+}
+
+func TestDataIndexer_BufferedSaveBlockShouldIndexTheDeserializedBlock(t *testing.T) {
+	t.Parallel()
+
+	header := newTestMetaBlock()
+	body := &dataBlock.Body{MiniBlocks: []*dataBlock.MiniBlock{{TxHashes: [][]byte{[]byte("tx"), []byte("scr")}}}}
+	txPool := map[string]data.TransactionHandler{
+		"tx":  &transaction.Transaction{Nonce: 1, Value: big.NewInt(10)},
+		"scr": &smartContractResult.SmartContractResult{Nonce: 2, Value: big.NewInt(20)},
+	}
+
+	chanSaved := make(chan struct{}, 1)
+	var savedHeader data.HeaderHandler
+	var savedTxPool map[string]data.TransactionHandler
+	elasticProcessor := &mock.ElasticProcessorStub{
+		SaveHeaderCalled: func(header data.HeaderHandler, _ []uint64, _ *dataBlock.Body, _ []string, _ int) error {
+			savedHeader = header
+			return nil
+		},
+		SaveTransactionsCalled: func(_ *dataBlock.Body, _ data.HeaderHandler, txPool map[string]data.TransactionHandler, _ uint32, _ map[string]bool) error {
+			savedTxPool = txPool
+			chanSaved <- struct{}{}
+			return nil
+		},
+	}
+	arguments := createBufferedDataIndexerArguments(memorydb.New(), elasticProcessor)
+	di, _ := NewDataIndexer(arguments)
+
+	di.SaveBlock(body, header, txPool, []uint64{1}, nil, []byte("hash"))
+	select {
+	case <-chanSaved:
+	case <-time.After(time.Second):
+		require.Fail(t, "timeout while waiting for the block to be indexed")
+	}
+
+	assert.Equal(t, header.GetNonce(), savedHeader.GetNonce())
+	assert.Equal(t, header.GetTxCount(), savedHeader.GetTxCount())
+	assert.IsType(t, &dataBlock.MetaBlock{}, savedHeader)
+	assert.Equal(t, txPool, savedTxPool)
+	assert.Nil(t, di.(Flusher).Flush(context.Background()))
+	_ = di.Close()
+}
+
+func TestDataIndexer_BufferedSaveAccountsShouldIndexTheDeserializedAccounts(t *testing.T) {
+	t.Parallel()
+
+	account, _ := state.NewUserAccount([]byte("address"))
+	account.IncreaseNonce(3)
+	_ = account.AddToBalance(big.NewInt(100))
+
+	chanSaved := make(chan []state.UserAccountHandler, 1)
+	elasticProcessor := &mock.ElasticProcessorStub{
+		SaveAccountsCalled: func(accounts []state.UserAccountHandler) error {
+			chanSaved <- accounts
+			return nil
+		},
+	}
+	arguments := createBufferedDataIndexerArguments(memorydb.New(), elasticProcessor)
+	di, _ := NewDataIndexer(arguments)
+
+	di.SaveAccounts([]state.UserAccountHandler{account})
+	select {
+	case accounts := <-chanSaved:
+		require.Equal(t, 1, len(accounts))
+		assert.Equal(t, []byte("address"), accounts[0].AddressBytes())
+		assert.Equal(t, uint64(3), accounts[0].GetNonce())
+		assert.Equal(t, big.NewInt(100), accounts[0].GetBalance())
+	case <-time.After(time.Second):
+		require.Fail(t, "timeout while waiting for the accounts to be indexed")
+	}
+	_ = di.Close()
+}
+
+func TestDataIndexer_BufferedItemsNotIndexedShouldBeIndexedAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	persister := memorydb.New()
+	arguments := createBufferedDataIndexerArguments(persister, &mock.ElasticProcessorStub{})
+	arguments.DataDispatcher = &mock.DispatcherMock{
+		FlushCalled: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}
+	di, _ := NewDataIndexer(arguments)
+	di.SaveRoundsInfo([]workItems.RoundInfo{{Index: 7}})
+	assert.Equal(t, int64(1), di.(DriverStatusHandler).DriverStatus().PendingItems)
+	_ = di.Close()
+
+	chanSaved := make(chan []workItems.RoundInfo, 1)
+	elasticProcessor := &mock.ElasticProcessorStub{
+		SaveRoundsInfoCalled: func(infos []workItems.RoundInfo) error {
+			chanSaved <- infos
+			return nil
+		},
+	}
+	arguments = createBufferedDataIndexerArguments(persister, elasticProcessor)
+	di, _ = NewDataIndexer(arguments)
+	select {
+	case infos := <-chanSaved:
+		assert.Equal(t, []workItems.RoundInfo{{Index: 7}}, infos)
+	case <-time.After(time.Second):
+		require.Fail(t, "timeout while waiting for the rounds to be indexed")
+	}
+	_ = di.Close()
+}
+
 func TestDataIndexer(t *testing.T) {
 	t.Skip("this is not a short test")
 
@@ -347,7 +476,7 @@ func TestDataIndexer(t *testing.T) {
 func testCreateIndexer(t *testing.T) {
 	indexTemplates, indexPolicies := getIndexTemplateAndPolicies()
 
-	dispatcher, _ := NewDataDispatcher(100)
+	dispatcher, _ := NewDataDispatcher(ArgsDataDispatcher{CacheSize: 100})
 	dbClient, _ := NewElasticClient(elasticsearch.Config{
 		Addresses: []string{"http://localhost:9200"},
 		Username:  "",
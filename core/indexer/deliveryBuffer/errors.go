@@ -0,0 +1,21 @@
+package deliveryBuffer
+
+import "errors"
+
+// ErrNilPersister signals that a nil persister has been provided
+var ErrNilPersister = errors.New("nil persister")
+
+// ErrInvalidMaxSize signals that an invalid maximum size has been provided
+var ErrInvalidMaxSize = errors.New("invalid maximum size")
+
+// ErrInvalidBufferSize signals that an invalid buffer size has been provided
+var ErrInvalidBufferSize = errors.New("invalid buffer size")
+
+// ErrItemTooLarge signals that an item larger than the maximum size of the buffer has been provided
+var ErrItemTooLarge = errors.New("item is larger than the maximum size of the buffer")
+
+// ErrBufferClosed signals that the buffer has been closed
+var ErrBufferClosed = errors.New("buffer is closed")
+
+// ErrBufferFull signals that the buffer is full and the item has been dropped
+var ErrBufferFull = errors.New("buffer is full, the item has been dropped")
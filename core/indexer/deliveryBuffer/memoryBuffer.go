@@ -0,0 +1,110 @@
+package deliveryBuffer
+
+import (
+	"context"
+	"sync"
+)
+
+// memoryBuffer keeps a bounded number of items in memory. Append waits while the buffer is full and the buffered items
+// are lost when the node stops
+type memoryBuffer struct {
+	chanItems  chan []byte
+	mutHead    sync.Mutex
+	head       []byte
+	closeOnce  sync.Once
+	chanClosed chan struct{}
+}
+
+// NewMemoryBuffer creates a new in memory buffer holding at most the provided number of items
+func NewMemoryBuffer(size uint32) (*memoryBuffer, error) {
+	if size == 0 {
+		return nil, ErrInvalidBufferSize
+	}
+
+	return &memoryBuffer{
+		chanItems:  make(chan []byte, size),
+		chanClosed: make(chan struct{}),
+	}, nil
+}
+
+// Append adds the provided item, waiting while the buffer is full
+func (mb *memoryBuffer) Append(item []byte) error {
+	select {
+	case <-mb.chanClosed:
+		return ErrBufferClosed
+	default:
+	}
+
+	select {
+	case mb.chanItems <- item:
+		return nil
+	case <-mb.chanClosed:
+		return ErrBufferClosed
+	}
+}
+
+// Peek returns the oldest item, waiting for one to be appended if the buffer is empty. It must be called from a single
+// go routine
+func (mb *memoryBuffer) Peek(ctx context.Context) ([]byte, error) {
+	mb.mutHead.Lock()
+	head := mb.head
+	mb.mutHead.Unlock()
+
+	if head != nil {
+		return head, nil
+	}
+
+	select {
+	case item := <-mb.chanItems:
+		mb.mutHead.Lock()
+		mb.head = item
+		mb.mutHead.Unlock()
+
+		return item, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-mb.chanClosed:
+		return nil, ErrBufferClosed
+	}
+}
+
+// Pop removes the item returned by the last Peek call
+func (mb *memoryBuffer) Pop() error {
+	mb.mutHead.Lock()
+	mb.head = nil
+	mb.mutHead.Unlock()
+
+	return nil
+}
+
+// Len returns the number of buffered items
+func (mb *memoryBuffer) Len() int {
+	mb.mutHead.Lock()
+	defer mb.mutHead.Unlock()
+
+	numItems := len(mb.chanItems)
+	if mb.head != nil {
+		numItems++
+	}
+
+	return numItems
+}
+
+// NumDroppedItems returns 0 as the memory buffer waits instead of dropping items
+func (mb *memoryBuffer) NumDroppedItems() uint64 {
+	return 0
+}
+
+// Close releases the go routines waiting on the buffer
+func (mb *memoryBuffer) Close() error {
+	mb.closeOnce.Do(func() {
+		close(mb.chanClosed)
+	})
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mb *memoryBuffer) IsInterfaceNil() bool {
+	return mb == nil
+}
@@ -0,0 +1,107 @@
+package deliveryBuffer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const waitTimeout = time.Second
+
+func TestNewMemoryBuffer_InvalidSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mb, err := NewMemoryBuffer(0)
+	assert.True(t, check.IfNil(mb))
+	assert.Equal(t, ErrInvalidBufferSize, err)
+}
+
+func TestMemoryBuffer_PeekShouldReturnTheOldestItemUntilPopped(t *testing.T) {
+	t.Parallel()
+
+	mb, _ := NewMemoryBuffer(2)
+	_ = mb.Append([]byte("a"))
+	_ = mb.Append([]byte("b"))
+	assert.Equal(t, 2, mb.Len())
+
+	item, err := mb.Peek(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, []byte("a"), item)
+
+	item, _ = mb.Peek(context.Background())
+	assert.Equal(t, []byte("a"), item)
+	assert.Equal(t, 2, mb.Len())
+
+	_ = mb.Pop()
+	item, _ = mb.Peek(context.Background())
+	assert.Equal(t, []byte("b"), item)
+	_ = mb.Pop()
+	assert.Equal(t, 0, mb.Len())
+}
+
+func TestMemoryBuffer_AppendShouldWaitWhileFull(t *testing.T) {
+	t.Parallel()
+
+	mb, _ := NewMemoryBuffer(1)
+	_ = mb.Append([]byte("a"))
+
+	chanAppended := make(chan error)
+	go func() {
+		chanAppended <- mb.Append([]byte("b"))
+	}()
+
+	select {
+	case <-chanAppended:
+		require.Fail(t, "should have waited")
+	case <-time.After(time.Millisecond * 50):
+	}
+
+	_, _ = mb.Peek(context.Background())
+	_ = mb.Pop()
+
+	select {
+	case err := <-chanAppended:
+		assert.Nil(t, err)
+	case <-time.After(waitTimeout):
+		require.Fail(t, "timeout while waiting for the append")
+	}
+}
+
+func TestMemoryBuffer_PeekShouldReturnWhenTheContextIsDone(t *testing.T) {
+	t.Parallel()
+
+	mb, _ := NewMemoryBuffer(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	item, err := mb.Peek(ctx)
+	assert.Nil(t, item)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestMemoryBuffer_CloseShouldReleaseTheWaitingCalls(t *testing.T) {
+	t.Parallel()
+
+	mb, _ := NewMemoryBuffer(1)
+	_ = mb.Append([]byte("a"))
+
+	chanAppended := make(chan error)
+	go func() {
+		chanAppended <- mb.Append([]byte("b"))
+	}()
+
+	_ = mb.Close()
+	select {
+	case err := <-chanAppended:
+		assert.Equal(t, ErrBufferClosed, err)
+	case <-time.After(waitTimeout):
+		require.Fail(t, "timeout while waiting for the append")
+	}
+
+	err := mb.Append([]byte("c"))
+	assert.Equal(t, ErrBufferClosed, err)
+}
@@ -0,0 +1,32 @@
+package deliveryBuffer
+
+import (
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+)
+
+// persisterStub overrides the writes of an in memory persister
+type persisterStub struct {
+	storage.Persister
+	PutCalled func(key, val []byte) error
+}
+
+func newPersisterStub() *persisterStub {
+	return &persisterStub{
+		Persister: memorydb.New(),
+	}
+}
+
+// Put -
+func (stub *persisterStub) Put(key, val []byte) error {
+	if stub.PutCalled != nil {
+		return stub.PutCalled(key, val)
+	}
+
+	return stub.Persister.Put(key, val)
+}
+
+// IsInterfaceNil -
+func (stub *persisterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,315 @@
+package deliveryBuffer
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("core/indexer/deliveryBuffer")
+
+const sequenceKeyLength = 8
+
+const (
+	itemEntryKind byte = iota
+	gapEntryKind
+)
+
+const gapEntryLength = 1 + 8
+
+// ArgsWriteAheadLog is the DTO used to create a new instance of writeAheadLog
+type ArgsWriteAheadLog struct {
+	Persister      storage.Persister
+	MaxSizeInBytes uint64
+}
+
+type walEntry struct {
+	sequence   uint64
+	size       uint64
+	numDropped uint64
+}
+
+func (entry *walEntry) isGap() bool {
+	return entry.numDropped > 0
+}
+
+// writeAheadLog keeps the items in a persister, keyed by a sequence number, until they are delivered. The items not
+// delivered before the node stops are loaded and delivered, in order, when the node starts again. Append never waits:
+// once the total size of the items reaches the configured maximum, the new items are dropped and a gap marker holding
+// the number of dropped items is persisted in their place, so the block processing is not held back by a driver
+// endpoint which is down for a long time and the consumer learns exactly where the delivered stream has a hole
+type writeAheadLog struct {
+	persister       storage.Persister
+	maxSizeInBytes  uint64
+	mut             sync.Mutex
+	entries         []walEntry
+	numItems        int
+	sizeInBytes     uint64
+	nextSequence    uint64
+	numDroppedItems uint64
+	chanAppended    chan struct{}
+	closeOnce       sync.Once
+	chanClosed      chan struct{}
+}
+
+// NewWriteAheadLog creates a new write-ahead log, loading the items found in the provided persister
+func NewWriteAheadLog(args ArgsWriteAheadLog) (*writeAheadLog, error) {
+	if check.IfNil(args.Persister) {
+		return nil, ErrNilPersister
+	}
+	if args.MaxSizeInBytes == 0 {
+		return nil, ErrInvalidMaxSize
+	}
+
+	wal := &writeAheadLog{
+		persister:      args.Persister,
+		maxSizeInBytes: args.MaxSizeInBytes,
+		entries:        make([]walEntry, 0),
+		nextSequence:   1,
+		chanAppended:   make(chan struct{}, 1),
+		chanClosed:     make(chan struct{}),
+	}
+	wal.loadEntries()
+
+	return wal, nil
+}
+
+func (wal *writeAheadLog) loadEntries() {
+	wal.persister.RangeKeys(func(key []byte, val []byte) bool {
+		if len(key) != sequenceKeyLength || len(val) == 0 {
+			return true
+		}
+
+		entry := walEntry{
+			sequence: binary.BigEndian.Uint64(key),
+		}
+		if val[0] == gapEntryKind && len(val) == gapEntryLength {
+			entry.numDropped = binary.BigEndian.Uint64(val[1:])
+		} else {
+			entry.size = uint64(len(val) - 1)
+			wal.numItems++
+		}
+
+		wal.entries = append(wal.entries, entry)
+		wal.sizeInBytes += entry.size
+
+		return true
+	})
+
+	sort.Slice(wal.entries, func(i, j int) bool {
+		return wal.entries[i].sequence < wal.entries[j].sequence
+	})
+	if len(wal.entries) > 0 {
+		wal.nextSequence = wal.entries[len(wal.entries)-1].sequence + 1
+		log.Info("write-ahead log loaded the undelivered items",
+			"num items", wal.numItems, "size in bytes", wal.sizeInBytes)
+	}
+}
+
+func sequenceToKey(sequence uint64) []byte {
+	key := make([]byte, sequenceKeyLength)
+	binary.BigEndian.PutUint64(key, sequence)
+
+	return key
+}
+
+// Append persists the provided item. If the maximum size would be exceeded, the item is dropped, the gap is recorded
+// in the log and ErrBufferFull is returned
+func (wal *writeAheadLog) Append(item []byte) error {
+	select {
+	case <-wal.chanClosed:
+		return ErrBufferClosed
+	default:
+	}
+
+	wal.mut.Lock()
+	defer wal.mut.Unlock()
+
+	size := uint64(len(item))
+	if size > wal.maxSizeInBytes {
+		wal.recordDroppedItemUnprotected()
+		return ErrItemTooLarge
+	}
+	if wal.sizeInBytes+size > wal.maxSizeInBytes {
+		wal.recordDroppedItemUnprotected()
+		return ErrBufferFull
+	}
+
+	value := make([]byte, 0, len(item)+1)
+	value = append(value, itemEntryKind)
+	value = append(value, item...)
+	err := wal.persister.Put(sequenceToKey(wal.nextSequence), value)
+	if err != nil {
+		return err
+	}
+
+	wal.entries = append(wal.entries, walEntry{
+		sequence: wal.nextSequence,
+		size:     size,
+	})
+	wal.numItems++
+	wal.sizeInBytes += size
+	wal.nextSequence++
+
+	notify(wal.chanAppended)
+
+	return nil
+}
+
+// recordDroppedItemUnprotected extends the gap marker at the end of the log or, if the last entry is an item, starts a
+// new one. The consecutive dropped items share a single marker
+func (wal *writeAheadLog) recordDroppedItemUnprotected() {
+	atomic.AddUint64(&wal.numDroppedItems, 1)
+
+	numEntries := len(wal.entries)
+	isGapOpen := numEntries > 0 && wal.entries[numEntries-1].isGap()
+	if !isGapOpen {
+		log.Warn("write-ahead log is full, dropping the new items until the pending ones are delivered",
+			"max size in bytes", wal.maxSizeInBytes, "num pending items", wal.numItems)
+
+		wal.entries = append(wal.entries, walEntry{sequence: wal.nextSequence})
+		wal.nextSequence++
+		numEntries++
+	}
+
+	gap := &wal.entries[numEntries-1]
+	gap.numDropped++
+	err := wal.persister.Put(sequenceToKey(gap.sequence), gapToValue(gap.numDropped))
+	if err != nil {
+		log.Error("write-ahead log could not persist the gap marker", "error", err, "num dropped items", gap.numDropped)
+	}
+}
+
+func gapToValue(numDropped uint64) []byte {
+	value := make([]byte, gapEntryLength)
+	value[0] = gapEntryKind
+	binary.BigEndian.PutUint64(value[1:], numDropped)
+
+	return value
+}
+
+func notify(chanSignal chan struct{}) {
+	select {
+	case chanSignal <- struct{}{}:
+	default:
+	}
+}
+
+// Peek returns the oldest item, waiting for one to be appended if the log is empty. The gap markers found on the way
+// are reported and removed. It must be called from a single go routine
+func (wal *writeAheadLog) Peek(ctx context.Context) ([]byte, error) {
+	for {
+		wal.mut.Lock()
+		isEmpty := len(wal.entries) == 0
+		var head walEntry
+		if !isEmpty {
+			head = wal.entries[0]
+		}
+		wal.mut.Unlock()
+
+		if isEmpty {
+			select {
+			case <-wal.chanAppended:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-wal.chanClosed:
+				return nil, ErrBufferClosed
+			}
+
+			continue
+		}
+
+		if head.isGap() {
+			err := wal.removeGap()
+			if err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		value, err := wal.persister.Get(sequenceToKey(head.sequence))
+		if err != nil {
+			return nil, err
+		}
+
+		return value[1:], nil
+	}
+}
+
+func (wal *writeAheadLog) removeGap() error {
+	wal.mut.Lock()
+	defer wal.mut.Unlock()
+
+	gap := wal.entries[0]
+	err := wal.persister.Remove(sequenceToKey(gap.sequence))
+	if err != nil {
+		return err
+	}
+
+	wal.entries = wal.entries[1:]
+	log.Error("write-ahead log reached a gap, the items dropped while the log was full are not delivered",
+		"num dropped items", gap.numDropped)
+
+	return nil
+}
+
+// Pop removes the oldest item, once it has been delivered
+func (wal *writeAheadLog) Pop() error {
+	wal.mut.Lock()
+	defer wal.mut.Unlock()
+
+	if len(wal.entries) == 0 || wal.entries[0].isGap() {
+		return nil
+	}
+
+	entry := wal.entries[0]
+	err := wal.persister.Remove(sequenceToKey(entry.sequence))
+	if err != nil {
+		return err
+	}
+
+	wal.entries = wal.entries[1:]
+	wal.numItems--
+	wal.sizeInBytes -= entry.size
+
+	return nil
+}
+
+// Len returns the number of items not yet delivered, without the gap markers
+func (wal *writeAheadLog) Len() int {
+	wal.mut.Lock()
+	defer wal.mut.Unlock()
+
+	return wal.numItems
+}
+
+// NumDroppedItems returns the number of items dropped since the log was created because the log was full
+func (wal *writeAheadLog) NumDroppedItems() uint64 {
+	return atomic.LoadUint64(&wal.numDroppedItems)
+}
+
+// Close releases the go routine waiting on the log and closes the persister. The items not yet delivered are kept
+func (wal *writeAheadLog) Close() error {
+	var err error
+	wal.closeOnce.Do(func() {
+		close(wal.chanClosed)
+
+		wal.mut.Lock()
+		err = wal.persister.Close()
+		wal.mut.Unlock()
+	})
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wal *writeAheadLog) IsInterfaceNil() bool {
+	return wal == nil
+}
@@ -0,0 +1,253 @@
+package deliveryBuffer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsWriteAheadLog() ArgsWriteAheadLog {
+	return ArgsWriteAheadLog{
+		Persister:      memorydb.New(),
+		MaxSizeInBytes: 4,
+	}
+}
+
+func TestNewWriteAheadLog_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsWriteAheadLog()
+	args.Persister = nil
+	wal, err := NewWriteAheadLog(args)
+	assert.True(t, check.IfNil(wal))
+	assert.Equal(t, ErrNilPersister, err)
+
+	args = createMockArgsWriteAheadLog()
+	args.MaxSizeInBytes = 0
+	wal, err = NewWriteAheadLog(args)
+	assert.True(t, check.IfNil(wal))
+	assert.Equal(t, ErrInvalidMaxSize, err)
+
+	args = createMockArgsWriteAheadLog()
+	wal, err = NewWriteAheadLog(args)
+	assert.False(t, check.IfNil(wal))
+	assert.Nil(t, err)
+}
+
+func TestWriteAheadLog_ItemsShouldBeDeliveredInOrder(t *testing.T) {
+	t.Parallel()
+
+	wal, _ := NewWriteAheadLog(createMockArgsWriteAheadLog())
+	_ = wal.Append([]byte("a"))
+	_ = wal.Append([]byte("b"))
+	assert.Equal(t, 2, wal.Len())
+
+	item, err := wal.Peek(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, []byte("a"), item)
+
+	_ = wal.Pop()
+	item, _ = wal.Peek(context.Background())
+	assert.Equal(t, []byte("b"), item)
+
+	_ = wal.Pop()
+	assert.Equal(t, 0, wal.Len())
+}
+
+func TestWriteAheadLog_UndeliveredItemsShouldBeLoadedAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsWriteAheadLog()
+	args.MaxSizeInBytes = 1024
+	wal, _ := NewWriteAheadLog(args)
+	for i := 0; i < 20; i++ {
+		_ = wal.Append([]byte{byte(i)})
+	}
+	for i := 0; i < 5; i++ {
+		_, _ = wal.Peek(context.Background())
+		_ = wal.Pop()
+	}
+	_ = wal.Close()
+
+	wal, _ = NewWriteAheadLog(args)
+	require.Equal(t, 15, wal.Len())
+	_ = wal.Append([]byte{20})
+
+	for i := 5; i <= 20; i++ {
+		item, err := wal.Peek(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, []byte{byte(i)}, item)
+		_ = wal.Pop()
+	}
+}
+
+func TestWriteAheadLog_AppendShouldDropTheItemsWhileTheMaxSizeIsReached(t *testing.T) {
+	t.Parallel()
+
+	wal, _ := NewWriteAheadLog(createMockArgsWriteAheadLog())
+	_ = wal.Append([]byte("abc"))
+
+	chanAppended := make(chan error)
+	go func() {
+		chanAppended <- wal.Append([]byte("de"))
+	}()
+
+	select {
+	case err := <-chanAppended:
+		assert.Equal(t, ErrBufferFull, err)
+	case <-time.After(waitTimeout):
+		require.Fail(t, "should not have waited")
+	}
+
+	err := wal.Append([]byte("fg"))
+	assert.Equal(t, ErrBufferFull, err)
+	assert.Equal(t, 1, wal.Len())
+	assert.Equal(t, uint64(2), wal.NumDroppedItems())
+
+	_, _ = wal.Peek(context.Background())
+	_ = wal.Pop()
+
+	err = wal.Append([]byte("hi"))
+	assert.Nil(t, err)
+
+	item, _ := wal.Peek(context.Background())
+	assert.Equal(t, []byte("hi"), item)
+	assert.Equal(t, 1, wal.Len())
+}
+
+func TestWriteAheadLog_ConsecutiveDropsShouldShareOneGapMarker(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsWriteAheadLog()
+	wal, _ := NewWriteAheadLog(args)
+	_ = wal.Append([]byte("abc"))
+	_ = wal.Append([]byte("de"))
+	_ = wal.Append([]byte("fg"))
+	_ = wal.Append([]byte("hi"))
+
+	numKeys := 0
+	args.Persister.RangeKeys(func(_ []byte, _ []byte) bool {
+		numKeys++
+		return true
+	})
+	assert.Equal(t, 2, numKeys)
+	assert.Equal(t, 2, len(wal.entries))
+	assert.Equal(t, uint64(3), wal.entries[1].numDropped)
+}
+
+func TestWriteAheadLog_GapShouldBeKeptAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsWriteAheadLog()
+	wal, _ := NewWriteAheadLog(args)
+	_ = wal.Append([]byte("abc"))
+	_ = wal.Append([]byte("de"))
+	_ = wal.Append([]byte("fg"))
+	_ = wal.Close()
+
+	wal, _ = NewWriteAheadLog(args)
+	require.Equal(t, 1, wal.Len())
+	require.Equal(t, 2, len(wal.entries))
+	assert.Equal(t, uint64(2), wal.entries[1].numDropped)
+
+	item, _ := wal.Peek(context.Background())
+	assert.Equal(t, []byte("abc"), item)
+	_ = wal.Pop()
+
+	_ = wal.Append([]byte("h"))
+	item, _ = wal.Peek(context.Background())
+	assert.Equal(t, []byte("h"), item)
+	assert.Equal(t, 1, len(wal.entries))
+}
+
+func TestWriteAheadLog_AppendTooLargeItemShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wal, _ := NewWriteAheadLog(createMockArgsWriteAheadLog())
+
+	err := wal.Append([]byte("abcde"))
+	assert.Equal(t, ErrItemTooLarge, err)
+	assert.Equal(t, 0, wal.Len())
+}
+
+func TestWriteAheadLog_PeekShouldWaitForAnAppend(t *testing.T) {
+	t.Parallel()
+
+	wal, _ := NewWriteAheadLog(createMockArgsWriteAheadLog())
+
+	chanPeeked := make(chan []byte)
+	go func() {
+		item, _ := wal.Peek(context.Background())
+		chanPeeked <- item
+	}()
+
+	time.Sleep(time.Millisecond * 10)
+	_ = wal.Append([]byte("a"))
+
+	select {
+	case item := <-chanPeeked:
+		assert.Equal(t, []byte("a"), item)
+	case <-time.After(waitTimeout):
+		require.Fail(t, "timeout while waiting for the peek")
+	}
+}
+
+func TestWriteAheadLog_PersisterErrorShouldNotAppend(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsWriteAheadLog()
+	persister := newPersisterStub()
+	persister.PutCalled = func(_, _ []byte) error {
+		return expectedErr
+	}
+	args.Persister = persister
+	wal, _ := NewWriteAheadLog(args)
+
+	err := wal.Append([]byte("a"))
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 0, wal.Len())
+}
+
+func TestWriteAheadLog_CloseShouldReleaseTheWaitingPeek(t *testing.T) {
+	t.Parallel()
+
+	wal, _ := NewWriteAheadLog(createMockArgsWriteAheadLog())
+
+	chanPeeked := make(chan error)
+	go func() {
+		_, err := wal.Peek(context.Background())
+		chanPeeked <- err
+	}()
+
+	time.Sleep(time.Millisecond * 10)
+	_ = wal.Close()
+	select {
+	case err := <-chanPeeked:
+		assert.Equal(t, ErrBufferClosed, err)
+	case <-time.After(waitTimeout):
+		require.Fail(t, "timeout while waiting for the peek")
+	}
+
+	err := wal.Append([]byte("i"))
+	assert.Equal(t, ErrBufferClosed, err)
+}
+
+func TestWriteAheadLog_CloseShouldKeepTheItems(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsWriteAheadLog()
+	wal, _ := NewWriteAheadLog(args)
+	_ = wal.Append([]byte("abcd"))
+	_ = wal.Close()
+
+	wal, _ = NewWriteAheadLog(args)
+	item, _ := wal.Peek(context.Background())
+	assert.Equal(t, []byte("abcd"), item)
+}
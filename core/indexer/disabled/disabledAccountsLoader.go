@@ -0,0 +1,24 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+type accountsLoader struct {
+}
+
+// NewAccountsLoader returns an accounts loader that does not load any account. It is used when the accounts state
+// is not available, as when replaying the historical blocks
+func NewAccountsLoader() *accountsLoader {
+	return new(accountsLoader)
+}
+
+// LoadAccount returns a nil account
+func (al *accountsLoader) LoadAccount(_ []byte) (state.AccountHandler, error) {
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (al *accountsLoader) IsInterfaceNil() bool {
+	return al == nil
+}
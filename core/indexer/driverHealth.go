@@ -0,0 +1,92 @@
+package indexer
+
+import (
+	"sync"
+	"time"
+)
+
+// DriverState is the health state of an outport driver delivering the data to an external endpoint
+type DriverState string
+
+const (
+	// DriverStateConnected signals that the driver delivers the data as it is handed to the outport
+	DriverStateConnected DriverState = "connected"
+	// DriverStateLagging signals that the driver delivers the data, but more items than the lag threshold wait for
+	// their delivery
+	DriverStateLagging DriverState = "lagging"
+	// DriverStateBuffering signals that the last delivery failed, so the driver buffers the data until its endpoint is
+	// reachable again
+	DriverStateBuffering DriverState = "buffering"
+)
+
+// DriverStatus holds the health of an outport driver
+type DriverStatus struct {
+	Name                  string      `json:"name"`
+	State                 DriverState `json:"state"`
+	PendingItems          int64       `json:"pendingItems"`
+	ConsecutiveFailures   uint32      `json:"consecutiveFailures"`
+	LastError             string      `json:"lastError,omitempty"`
+	LastDeliveryTimestamp int64       `json:"lastDeliveryTimestamp"`
+	DroppedItems          uint64      `json:"droppedItems"`
+}
+
+// DriverHealth records the outcome of the deliveries of an outport driver and computes its health state
+type DriverHealth struct {
+	name                  string
+	lagThreshold          int64
+	mut                   sync.RWMutex
+	consecutiveFailures   uint32
+	lastError             string
+	lastDeliveryTimestamp int64
+}
+
+// NewDriverHealth creates a new DriverHealth instance. A driver with more pending items than the lag threshold is
+// lagging, while a 0 lag threshold disables the lag detection
+func NewDriverHealth(name string, lagThreshold uint32) *DriverHealth {
+	return &DriverHealth{
+		name:         name,
+		lagThreshold: int64(lagThreshold),
+	}
+}
+
+// DeliverySucceeded must be called after the driver delivered an item
+func (dh *DriverHealth) DeliverySucceeded() {
+	dh.mut.Lock()
+	dh.consecutiveFailures = 0
+	dh.lastError = ""
+	dh.lastDeliveryTimestamp = time.Now().Unix()
+	dh.mut.Unlock()
+}
+
+// DeliveryFailed must be called after the driver failed to deliver an item
+func (dh *DriverHealth) DeliveryFailed(err error) {
+	dh.mut.Lock()
+	dh.consecutiveFailures++
+	if err != nil {
+		dh.lastError = err.Error()
+	}
+	dh.mut.Unlock()
+}
+
+// Status returns the health of the driver, given the number of items waiting for their delivery
+func (dh *DriverHealth) Status(pendingItems int64) DriverStatus {
+	dh.mut.RLock()
+	defer dh.mut.RUnlock()
+
+	state := DriverStateConnected
+	if dh.lagThreshold > 0 && pendingItems > dh.lagThreshold {
+		state = DriverStateLagging
+	}
+	if dh.consecutiveFailures > 0 {
+		state = DriverStateBuffering
+	}
+
+	return DriverStatus{
+		Name:                  dh.name,
+		State:                 state,
+		PendingItems:          pendingItems,
+		ConsecutiveFailures:   dh.consecutiveFailures,
+		LastError:             dh.lastError,
+		LastDeliveryTimestamp: dh.lastDeliveryTimestamp,
+	}
+}
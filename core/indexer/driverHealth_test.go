@@ -0,0 +1,41 @@
+package indexer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDriverHealth_StatusShouldComputeTheState(t *testing.T) {
+	t.Parallel()
+
+	dh := NewDriverHealth("kafka", 10)
+	status := dh.Status(10)
+	assert.Equal(t, DriverStatus{Name: "kafka", State: DriverStateConnected, PendingItems: 10}, status)
+
+	status = dh.Status(11)
+	assert.Equal(t, DriverStateLagging, status.State)
+
+	expectedErr := errors.New("expected error")
+	dh.DeliveryFailed(expectedErr)
+	dh.DeliveryFailed(expectedErr)
+	status = dh.Status(0)
+	assert.Equal(t, DriverStateBuffering, status.State)
+	assert.Equal(t, uint32(2), status.ConsecutiveFailures)
+	assert.Equal(t, expectedErr.Error(), status.LastError)
+
+	dh.DeliverySucceeded()
+	status = dh.Status(1)
+	assert.Equal(t, DriverStateConnected, status.State)
+	assert.Equal(t, uint32(0), status.ConsecutiveFailures)
+	assert.Empty(t, status.LastError)
+	assert.True(t, status.LastDeliveryTimestamp > 0)
+}
+
+func TestDriverHealth_ZeroLagThresholdShouldDisableTheLagDetection(t *testing.T) {
+	t.Parallel()
+
+	dh := NewDriverHealth("postgresql", 0)
+	assert.Equal(t, DriverStateConnected, dh.Status(1000).State)
+}
@@ -0,0 +1,133 @@
+package indexer
+
+import (
+	"context"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+// ArgsDriversMonitor is the DTO used to create a new instance of driversMonitor
+type ArgsDriversMonitor struct {
+	Drivers          DriversStatusesHandler
+	AppStatusHandler core.AppStatusHandler
+	CheckInterval    time.Duration
+}
+
+// driversMonitor periodically checks the health of the outport drivers, exposing it as node metrics and raising an
+// alert, as a warning log, each time a driver starts lagging or buffering, so an unavailable endpoint does not silently
+// create gaps in the delivered data. An error is logged each time a driver drops items because its write-ahead log is
+// full
+type driversMonitor struct {
+	drivers          DriversStatusesHandler
+	appStatusHandler core.AppStatusHandler
+	states           map[string]DriverState
+	droppedItems     map[string]uint64
+	cancelFunc       func()
+}
+
+// NewDriversMonitor creates a new drivers monitor and starts checking the drivers
+func NewDriversMonitor(args ArgsDriversMonitor) (*driversMonitor, error) {
+	if check.IfNil(args.Drivers) {
+		return nil, ErrNilDriversStatusesHandler
+	}
+	if check.IfNil(args.AppStatusHandler) {
+		return nil, ErrNilAppStatusHandler
+	}
+	if args.CheckInterval <= 0 {
+		return nil, ErrInvalidCheckInterval
+	}
+
+	dm := &driversMonitor{
+		drivers:          args.Drivers,
+		appStatusHandler: args.AppStatusHandler,
+		states:           make(map[string]DriverState),
+		droppedItems:     make(map[string]uint64),
+	}
+
+	var ctx context.Context
+	ctx, dm.cancelFunc = context.WithCancel(context.Background())
+	go dm.monitorLoop(ctx, args.CheckInterval)
+
+	return dm, nil
+}
+
+func (dm *driversMonitor) monitorLoop(ctx context.Context, checkInterval time.Duration) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("driversMonitor's go routine is stopping...")
+			return
+		case <-time.After(checkInterval):
+			dm.checkDrivers()
+		}
+	}
+}
+
+func (dm *driversMonitor) checkDrivers() {
+	numUnhealthy := uint64(0)
+	for _, status := range dm.drivers.DriversStatuses() {
+		if status.State != DriverStateConnected {
+			numUnhealthy++
+		}
+
+		dm.appStatusHandler.SetStringValue(core.MetricOutportDriverStatePrefix+status.Name, string(status.State))
+		dm.appStatusHandler.SetUInt64Value(core.MetricOutportDriverPendingItemsPrefix+status.Name, uint64(status.PendingItems))
+		dm.appStatusHandler.SetUInt64Value(core.MetricOutportDriverDroppedItemsPrefix+status.Name, status.DroppedItems)
+
+		dm.alertOnStateChange(status)
+		dm.alertOnDroppedItems(status)
+	}
+
+	dm.appStatusHandler.SetUInt64Value(core.MetricOutportUnhealthyDrivers, numUnhealthy)
+}
+
+func (dm *driversMonitor) alertOnStateChange(status DriverStatus) {
+	previousState, found := dm.states[status.Name]
+	dm.states[status.Name] = status.State
+	if !found {
+		previousState = DriverStateConnected
+	}
+	if previousState == status.State {
+		return
+	}
+
+	if status.State == DriverStateConnected {
+		log.Info("outport driver recovered", "driver", status.Name, "pending items", status.PendingItems)
+		return
+	}
+
+	log.Warn("outport driver is "+string(status.State),
+		"driver", status.Name,
+		"pending items", status.PendingItems,
+		"consecutive failures", status.ConsecutiveFailures,
+		"last error", status.LastError,
+	)
+}
+
+func (dm *driversMonitor) alertOnDroppedItems(status DriverStatus) {
+	previousDroppedItems := dm.droppedItems[status.Name]
+	dm.droppedItems[status.Name] = status.DroppedItems
+	if status.DroppedItems <= previousDroppedItems {
+		return
+	}
+
+	log.Error("outport driver dropped items because its write-ahead log is full",
+		"driver", status.Name,
+		"newly dropped items", status.DroppedItems-previousDroppedItems,
+		"total dropped items", status.DroppedItems,
+	)
+}
+
+// Close stops checking the drivers
+func (dm *driversMonitor) Close() error {
+	dm.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dm *driversMonitor) IsInterfaceNil() bool {
+	return dm == nil
+}
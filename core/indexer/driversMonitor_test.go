@@ -0,0 +1,95 @@
+package indexer
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type driversStatusesHandlerStub struct {
+	statuses []DriverStatus
+}
+
+func (stub *driversStatusesHandlerStub) DriversStatuses() []DriverStatus {
+	return stub.statuses
+}
+
+func (stub *driversStatusesHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func TestNewDriversMonitor_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := ArgsDriversMonitor{
+		AppStatusHandler: &mock.AppStatusHandlerStub{},
+		CheckInterval:    time.Second,
+	}
+	dm, err := NewDriversMonitor(args)
+	assert.True(t, check.IfNil(dm))
+	assert.Equal(t, ErrNilDriversStatusesHandler, err)
+
+	args = ArgsDriversMonitor{
+		Drivers:       &driversStatusesHandlerStub{},
+		CheckInterval: time.Second,
+	}
+	dm, err = NewDriversMonitor(args)
+	assert.True(t, check.IfNil(dm))
+	assert.Equal(t, ErrNilAppStatusHandler, err)
+
+	args = ArgsDriversMonitor{
+		Drivers:          &driversStatusesHandlerStub{},
+		AppStatusHandler: &mock.AppStatusHandlerStub{},
+	}
+	dm, err = NewDriversMonitor(args)
+	assert.True(t, check.IfNil(dm))
+	assert.Equal(t, ErrInvalidCheckInterval, err)
+}
+
+func TestDriversMonitor_ShouldSetTheDriversMetrics(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	stringMetrics := make(map[string]string)
+	uint64Metrics := make(map[string]uint64)
+	args := ArgsDriversMonitor{
+		Drivers: &driversStatusesHandlerStub{
+			statuses: []DriverStatus{
+				{Name: "elasticsearch", State: DriverStateBuffering, PendingItems: 7, ConsecutiveFailures: 2},
+				{Name: "kafka", State: DriverStateConnected, DroppedItems: 3},
+			},
+		},
+		AppStatusHandler: &mock.AppStatusHandlerStub{
+			SetStringValueHandler: func(key string, value string) {
+				mut.Lock()
+				stringMetrics[key] = value
+				mut.Unlock()
+			},
+			SetUInt64ValueHandler: func(key string, value uint64) {
+				mut.Lock()
+				uint64Metrics[key] = value
+				mut.Unlock()
+			},
+		},
+		CheckInterval: time.Millisecond,
+	}
+	dm, _ := NewDriversMonitor(args)
+	time.Sleep(time.Millisecond * 50)
+	_ = dm.Close()
+
+	mut.Lock()
+	defer mut.Unlock()
+
+	assert.Equal(t, string(DriverStateBuffering), stringMetrics[core.MetricOutportDriverStatePrefix+"elasticsearch"])
+	assert.Equal(t, string(DriverStateConnected), stringMetrics[core.MetricOutportDriverStatePrefix+"kafka"])
+	assert.Equal(t, uint64(7), uint64Metrics[core.MetricOutportDriverPendingItemsPrefix+"elasticsearch"])
+	assert.Equal(t, uint64(0), uint64Metrics[core.MetricOutportDriverPendingItemsPrefix+"kafka"])
+	assert.Equal(t, uint64(0), uint64Metrics[core.MetricOutportDriverDroppedItemsPrefix+"elasticsearch"])
+	assert.Equal(t, uint64(3), uint64Metrics[core.MetricOutportDriverDroppedItemsPrefix+"kafka"])
+	assert.Equal(t, uint64(1), uint64Metrics[core.MetricOutportUnhealthyDrivers])
+}
@@ -130,8 +130,12 @@ func (ec *elasticClient) DoMultiGet(obj objectsMap, index string) (objectsMap, e
 
 // DoBulkRemove will do a bulk remove to elasticsearch server
 func (ec *elasticClient) DoBulkRemove(index string, hashes []string) error {
-	obj := prepareHashesForBulkRemove(hashes)
-	body, err := encode(obj)
+	return ec.DoDeleteByQuery(index, prepareHashesForBulkRemove(hashes))
+}
+
+// DoDeleteByQuery will remove from elasticsearch server the documents matching the provided query
+func (ec *elasticClient) DoDeleteByQuery(index string, query objectsMap) error {
+	body, err := encode(query)
 	if err != nil {
 		return err
 	}
@@ -143,15 +147,15 @@ func (ec *elasticClient) DoBulkRemove(index string, hashes []string) error {
 	)
 
 	if err != nil {
-		log.Warn("elasticClient.DoBulkRemove",
-			"cannot do bulk remove", err.Error())
+		log.Warn("elasticClient.DoDeleteByQuery",
+			"cannot do delete by query", err.Error())
 		return err
 	}
 
 	var decodedBody objectsMap
 	err = parseResponse(res, &decodedBody, elasticDefaultErrorResponseHandler)
 	if err != nil {
-		log.Warn("elasticClient.DoBulkRemove",
+		log.Warn("elasticClient.DoDeleteByQuery",
 			"error parsing response", err.Error())
 		return err
 	}
@@ -12,9 +12,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/parsers"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/elastic/go-elasticsearch/v7/esapi"
@@ -29,6 +31,7 @@ type elasticProcessor struct {
 	parser                 *dataParser
 	enabledIndexes         map[string]struct{}
 	accountsDB             state.AccountsAdapter
+	argsParser             process.CallArgumentsParser
 	dividerForDenomination float64
 	balancePrecision       float64
 }
@@ -48,6 +51,7 @@ func NewElasticProcessor(arguments ArgElasticProcessor) (ElasticProcessor, error
 		},
 		enabledIndexes:         arguments.EnabledIndexes,
 		accountsDB:             arguments.AccountsDB,
+		argsParser:             parsers.NewCallArgsParser(),
 		balancePrecision:       math.Pow(10, float64(numDecimalsInFloatBalance)),
 		dividerForDenomination: math.Pow(10, float64(core.MaxInt(arguments.Denomination, 0))),
 	}
@@ -178,7 +182,7 @@ func (ei *elasticProcessor) createOpenDistroTemplates(indexTemplates map[string]
 }
 
 func (ei *elasticProcessor) createIndexTemplates(indexTemplates map[string]*bytes.Buffer) error {
-	indexes := []string{txIndex, blockIndex, miniblocksIndex, tpsIndex, ratingIndex, roundIndex, validatorsIndex, accountsIndex, accountsHistoryIndex}
+	indexes := []string{txIndex, blockIndex, miniblocksIndex, tpsIndex, ratingIndex, roundIndex, validatorsIndex, accountsIndex, accountsHistoryIndex, accountsESDTIndex}
 	for _, index := range indexes {
 		indexTemplate := getTemplateByName(index, indexTemplates)
 		if indexTemplate != nil {
@@ -193,7 +197,7 @@ func (ei *elasticProcessor) createIndexTemplates(indexTemplates map[string]*byte
 }
 
 func (ei *elasticProcessor) createIndexes() error {
-	indexes := []string{txIndex, blockIndex, miniblocksIndex, tpsIndex, ratingIndex, roundIndex, validatorsIndex, accountsIndex, accountsHistoryIndex}
+	indexes := []string{txIndex, blockIndex, miniblocksIndex, tpsIndex, ratingIndex, roundIndex, validatorsIndex, accountsIndex, accountsHistoryIndex, accountsESDTIndex}
 	for _, index := range indexes {
 		indexName := fmt.Sprintf("%s-000001", index)
 		err := ei.elasticClient.CheckAndCreateIndex(indexName)
@@ -206,7 +210,7 @@ func (ei *elasticProcessor) createIndexes() error {
 }
 
 func (ei *elasticProcessor) createAliases() error {
-	indexes := []string{txIndex, blockIndex, miniblocksIndex, tpsIndex, ratingIndex, roundIndex, validatorsIndex, accountsIndex, accountsHistoryIndex}
+	indexes := []string{txIndex, blockIndex, miniblocksIndex, tpsIndex, ratingIndex, roundIndex, validatorsIndex, accountsIndex, accountsHistoryIndex, accountsESDTIndex}
 	for _, index := range indexes {
 		indexName := fmt.Sprintf("%s-000001", index)
 		err := ei.elasticClient.CheckAndCreateAlias(index, indexName)
@@ -283,7 +287,22 @@ func (ei *elasticProcessor) RemoveHeader(header data.HeaderHandler) error {
 		return err
 	}
 
-	return ei.elasticClient.DoBulkRemove(blockIndex, []string{hex.EncodeToString(headerHash)})
+	err = ei.elasticClient.DoBulkRemove(blockIndex, []string{hex.EncodeToString(headerHash)})
+	if err != nil {
+		return err
+	}
+
+	return ei.removeBalanceHistory(hex.EncodeToString(headerHash))
+}
+
+// removeBalanceHistory removes the balance history entries written for the provided block. The accounts and the ESDT
+// balances indexes keep the balances of the removed block until the accounts are modified again
+func (ei *elasticProcessor) removeBalanceHistory(blockHash string) error {
+	if !ei.isIndexEnabled(accountsHistoryIndex) {
+		return nil
+	}
+
+	return ei.elasticClient.DoDeleteByQuery(accountsHistoryIndex, prepareBlockHashQueryForRemove(blockHash))
 }
 
 // RemoveMiniblocks will remove all miniblocks that are in header from elasticsearch server
@@ -365,7 +384,9 @@ func (ei *elasticProcessor) SaveTransactions(
 		}
 	}
 
-	return ei.indexAlteredAccounts(alteredAccounts)
+	alteredESDTTokens := ei.getAlteredESDTTokens(txPool)
+
+	return ei.indexAlteredAccounts(header, alteredAccounts, alteredESDTTokens)
 }
 
 // SaveShardStatistics will prepare and save information about a shard statistics in elasticsearch server
@@ -492,13 +513,65 @@ func (ei *elasticProcessor) SaveRoundsInfo(infos []workItems.RoundInfo) error {
 	return ei.elasticClient.DoBulkRequest(&buff, roundIndex)
 }
 
-func (ei *elasticProcessor) indexAlteredAccounts(accounts map[string]struct{}) error {
-	if !ei.isIndexEnabled(accountsIndex) {
+// getAlteredESDTTokens returns the ESDT tokens transferred, minted, burned or wiped by the provided transactions,
+// grouped by the encoded addresses of the self shard accounts. The minted tokens are transferred to the token owner by
+// the ESDT system smart contract, so they are found among the transfers
+func (ei *elasticProcessor) getAlteredESDTTokens(txPool map[string]data.TransactionHandler) map[string]map[string]struct{} {
+	alteredTokens := make(map[string]map[string]struct{})
+	if !ei.isIndexEnabled(accountsESDTIndex) {
+		return alteredTokens
+	}
+
+	for _, tx := range txPool {
+		if check.IfNil(tx) || len(tx.GetData()) == 0 {
+			continue
+		}
+
+		function, args, err := ei.argsParser.ParseData(string(tx.GetData()))
+		if err != nil || !isESDTBalanceFunction(function) || len(args) == 0 {
+			continue
+		}
+
+		token := string(args[0])
+		for _, address := range [][]byte{tx.GetSndAddr(), tx.GetRcvAddr()} {
+			if len(address) == 0 || ei.shardCoordinator.ComputeId(address) != ei.shardCoordinator.SelfId() {
+				continue
+			}
+
+			encodedAddress := ei.addressPubkeyConverter.Encode(address)
+			if _, ok := alteredTokens[encodedAddress]; !ok {
+				alteredTokens[encodedAddress] = make(map[string]struct{})
+			}
+			alteredTokens[encodedAddress][token] = struct{}{}
+		}
+	}
+
+	return alteredTokens
+}
+
+func (ei *elasticProcessor) indexAlteredAccounts(
+	header data.HeaderHandler,
+	accounts map[string]struct{},
+	esdtTokens map[string]map[string]struct{},
+) error {
+	if !ei.isIndexEnabled(accountsIndex) && !ei.isIndexEnabled(accountsESDTIndex) {
 		return nil
 	}
 
+	addresses := make(map[string]struct{}, len(accounts))
+	if ei.isIndexEnabled(accountsIndex) {
+		for address := range accounts {
+			addresses[address] = struct{}{}
+		}
+	}
+	if ei.isIndexEnabled(accountsESDTIndex) {
+		for address := range esdtTokens {
+			addresses[address] = struct{}{}
+		}
+	}
+
 	accountsToIndex := make([]state.UserAccountHandler, 0)
-	for address := range accounts {
+	for address := range addresses {
 		addressBytes, err := ei.addressPubkeyConverter.Decode(address)
 		if err != nil {
 			log.Warn("cannot decode address", "address", address, "error", err)
@@ -529,7 +602,218 @@ func (ei *elasticProcessor) indexAlteredAccounts(accounts map[string]struct{}) e
 		return nil
 	}
 
-	return ei.SaveAccounts(accountsToIndex)
+	headerHash, err := core.CalculateHash(ei.marshalizer, ei.hasher, header)
+	if err != nil {
+		return err
+	}
+	historyTemplate := AccountBalanceHistory{
+		Timestamp:  int64(header.GetTimeStamp()),
+		BlockHash:  hex.EncodeToString(headerHash),
+		BlockNonce: header.GetNonce(),
+		ShardID:    header.GetShardID(),
+	}
+
+	egldHistory, err := ei.indexAccountsBalances(accountsToIndex, accounts, historyTemplate)
+	if err != nil {
+		return err
+	}
+
+	esdtHistory, err := ei.indexAccountsESDTBalances(accountsToIndex, esdtTokens, historyTemplate)
+	if err != nil {
+		return err
+	}
+
+	return ei.indexBalanceHistory(append(egldHistory, esdtHistory...))
+}
+
+// indexAccountsBalances saves the EGLD balances of the provided accounts and returns the history entries of the
+// balances changed since they were last indexed
+func (ei *elasticProcessor) indexAccountsBalances(
+	accounts []state.UserAccountHandler,
+	alteredAddresses map[string]struct{},
+	historyTemplate AccountBalanceHistory,
+) ([]*AccountBalanceHistory, error) {
+	if !ei.isIndexEnabled(accountsIndex) {
+		return nil, nil
+	}
+
+	accountsMap := make(map[string]*AccountInfo)
+	for _, userAccount := range accounts {
+		address := ei.addressPubkeyConverter.Encode(userAccount.AddressBytes())
+		if _, ok := alteredAddresses[address]; !ok {
+			continue
+		}
+
+		accountsMap[address] = ei.prepareAccountInfo(userAccount)
+	}
+	if len(accountsMap) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(accountsMap))
+	for address := range accountsMap {
+		ids = append(ids, address)
+	}
+	previousBalances, err := ei.getIndexedBalances(ids, accountsIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ei.indexAccounts(accountsMap)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]*AccountBalanceHistory, 0, len(accountsMap))
+	for address, acc := range accountsMap {
+		entry := createBalanceHistoryEntry(historyTemplate, address, "", acc.Balance, previousBalances[address])
+		if entry != nil {
+			history = append(history, entry)
+		}
+	}
+
+	return history, nil
+}
+
+// indexAccountsESDTBalances saves the balances of the provided accounts in the ESDT tokens transferred by the block
+// and returns the history entries of the balances changed since they were last indexed
+func (ei *elasticProcessor) indexAccountsESDTBalances(
+	accounts []state.UserAccountHandler,
+	esdtTokens map[string]map[string]struct{},
+	historyTemplate AccountBalanceHistory,
+) ([]*AccountBalanceHistory, error) {
+	if !ei.isIndexEnabled(accountsESDTIndex) {
+		return nil, nil
+	}
+
+	accountsESDTMap := make(map[string]*AccountESDT)
+	for _, userAccount := range accounts {
+		address := ei.addressPubkeyConverter.Encode(userAccount.AddressBytes())
+		for token := range esdtTokens[address] {
+			balance, err := ei.getESDTBalance(userAccount, token)
+			if err != nil {
+				log.Warn("cannot load the esdt balance", "address", address, "token", token, "error", err)
+				continue
+			}
+
+			balanceNum, _ := big.NewFloat(0).SetInt(balance).Float64()
+			accountsESDTMap[getAccountESDTDocID(address, token)] = &AccountESDT{
+				Address:    address,
+				Token:      token,
+				Balance:    balance.String(),
+				BalanceNum: balanceNum,
+				Timestamp:  historyTemplate.Timestamp,
+			}
+		}
+	}
+	if len(accountsESDTMap) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(accountsESDTMap))
+	for id := range accountsESDTMap {
+		ids = append(ids, id)
+	}
+	previousBalances, err := ei.getIndexedBalances(ids, accountsESDTIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ei.indexTokenHolders(accountsESDTMap)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]*AccountBalanceHistory, 0, len(accountsESDTMap))
+	for id, accESDT := range accountsESDTMap {
+		entry := createBalanceHistoryEntry(historyTemplate, accESDT.Address, accESDT.Token, accESDT.Balance, previousBalances[id])
+		if entry != nil {
+			history = append(history, entry)
+		}
+	}
+
+	return history, nil
+}
+
+// indexTokenHolders saves the provided ESDT balances so the index holds only the current holders of each token, the
+// accounts whose balances reached 0 being removed
+func (ei *elasticProcessor) indexTokenHolders(accountsESDTMap map[string]*AccountESDT) error {
+	holders := make(map[string]*AccountESDT, len(accountsESDTMap))
+	formerHolders := make([]string, 0)
+	for id, accESDT := range accountsESDTMap {
+		if accESDT.Balance == "0" {
+			formerHolders = append(formerHolders, id)
+			continue
+		}
+
+		holders[id] = accESDT
+	}
+
+	if len(formerHolders) > 0 {
+		err := ei.elasticClient.DoBulkRemove(accountsESDTIndex, formerHolders)
+		if err != nil {
+			log.Warn("indexer: removing the former token holders",
+				"error", err.Error())
+			return err
+		}
+	}
+
+	buffSlice, err := serializeAccountsESDT(holders)
+	if err != nil {
+		return err
+	}
+	for idx := range buffSlice {
+		err = ei.elasticClient.DoBulkRequest(&buffSlice[idx], accountsESDTIndex)
+		if err != nil {
+			log.Warn("indexer: indexing bulk of accounts esdt",
+				"error", err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ei *elasticProcessor) getESDTBalance(userAccount state.UserAccountHandler, token string) (*big.Int, error) {
+	tokenKey := core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + token
+	valueBytes, err := userAccount.DataTrieTracker().RetrieveValue([]byte(tokenKey))
+	if err != nil || len(valueBytes) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	esdtToken := esdt.New()
+	err = ei.marshalizer.Unmarshal(esdtToken, valueBytes)
+	if err != nil {
+		return nil, err
+	}
+	if esdtToken.Value == nil {
+		return big.NewInt(0), nil
+	}
+
+	return esdtToken.Value, nil
+}
+
+// getIndexedBalances returns the balances of the provided documents, as they were last indexed
+func (ei *elasticProcessor) getIndexedBalances(ids []string, index string) (map[string]string, error) {
+	response, err := ei.elasticClient.DoMultiGet(getDocumentsSourcesByIDsQuery(ids), index)
+	if err != nil {
+		return nil, err
+	}
+
+	return getDecodedBalancesMultiGet(response), nil
+}
+
+func (ei *elasticProcessor) indexBalanceHistory(history []*AccountBalanceHistory) error {
+	if !ei.isIndexEnabled(accountsHistoryIndex) || len(history) == 0 {
+		return nil
+	}
+
+	historyMap := make(map[string]*AccountBalanceHistory, len(history))
+	for _, entry := range history {
+		historyMap[getBalanceHistoryDocID(entry)] = entry
+	}
+
+	return ei.serializeAndIndexAccountsHistory(historyMap)
 }
 
 // SaveAccounts will prepare and save information about provided accounts in elasticsearch server
@@ -540,16 +824,27 @@ func (ei *elasticProcessor) SaveAccounts(accounts []state.UserAccountHandler) er
 
 	accountsMap := make(map[string]*AccountInfo)
 	for _, userAccount := range accounts {
-		balanceAsFloat := ei.computeBalanceAsFloat(userAccount.GetBalance())
-		acc := &AccountInfo{
-			Nonce:      userAccount.GetNonce(),
-			Balance:    userAccount.GetBalance().String(),
-			BalanceNum: balanceAsFloat,
-		}
 		address := ei.addressPubkeyConverter.Encode(userAccount.AddressBytes())
-		accountsMap[address] = acc
+		accountsMap[address] = ei.prepareAccountInfo(userAccount)
+	}
+
+	err := ei.indexAccounts(accountsMap)
+	if err != nil {
+		return err
 	}
 
+	return ei.saveAccountsHistory(accountsMap)
+}
+
+func (ei *elasticProcessor) prepareAccountInfo(userAccount state.UserAccountHandler) *AccountInfo {
+	return &AccountInfo{
+		Nonce:      userAccount.GetNonce(),
+		Balance:    userAccount.GetBalance().String(),
+		BalanceNum: ei.computeBalanceAsFloat(userAccount.GetBalance()),
+	}
+}
+
+func (ei *elasticProcessor) indexAccounts(accountsMap map[string]*AccountInfo) error {
 	buffSlice, err := serializeAccounts(accountsMap)
 	if err != nil {
 		return err
@@ -563,7 +858,7 @@ func (ei *elasticProcessor) SaveAccounts(accounts []state.UserAccountHandler) er
 		}
 	}
 
-	return ei.saveAccountsHistory(accountsMap)
+	return nil
 }
 
 func (ei *elasticProcessor) saveAccountsHistory(accountsInfoMap map[string]*AccountInfo) error {
@@ -578,11 +873,16 @@ func (ei *elasticProcessor) saveAccountsHistory(accountsInfoMap map[string]*Acco
 			Address:   address,
 			Balance:   userAccount.Balance,
 			Timestamp: currentTimestamp,
+			ShardID:   ei.shardCoordinator.SelfId(),
 		}
 		addressKey := fmt.Sprintf("%s_%d", address, currentTimestamp)
 		accountsMap[addressKey] = acc
 	}
 
+	return ei.serializeAndIndexAccountsHistory(accountsMap)
+}
+
+func (ei *elasticProcessor) serializeAndIndexAccountsHistory(accountsMap map[string]*AccountBalanceHistory) error {
 	buffSlice, err := serializeAccountsHistory(accountsMap)
 	if err != nil {
 		return err
@@ -14,11 +14,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
 	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/core/parsers"
 	"github.com/ElrondNetwork/elrond-go/data"
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
@@ -46,6 +49,7 @@ func newTestElasticSearchDatabase(elasticsearchWriter DatabaseClientHandler, arg
 		},
 		enabledIndexes: arguments.EnabledIndexes,
 		accountsDB:     arguments.AccountsDB,
+		argsParser:     parsers.NewCallArgsParser(),
 	}
 }
 
@@ -807,6 +811,197 @@ func TestDoBulkRequestLimit(t *testing.T) {
 	}
 }
 
+func TestElasticProcessor_RemoveHeaderShouldRemoveTheBalanceHistory(t *testing.T) {
+	t.Parallel()
+
+	header := &dataBlock.Header{Nonce: 1}
+	args := createMockElasticProcessorArgs()
+	headerHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, header)
+	var removeQuery map[string]interface{}
+	args.DBClient = &mock.DatabaseWriterStub{
+		DoDeleteByQueryCalled: func(index string, query map[string]interface{}) error {
+			require.Equal(t, accountsHistoryIndex, index)
+			removeQuery = query
+			return nil
+		},
+	}
+	elasticProc, _ := NewElasticProcessor(args)
+
+	err := elasticProc.RemoveHeader(header)
+	require.Nil(t, err)
+	require.Equal(t, prepareBlockHashQueryForRemove(hex.EncodeToString(headerHash)), removeQuery)
+}
+
+func TestElasticProcessor_GetAlteredESDTTokens(t *testing.T) {
+	t.Parallel()
+
+	sender := bytes.Repeat([]byte{1}, 32)
+	receiver := bytes.Repeat([]byte{2}, 32)
+	args := createMockElasticProcessorArgs()
+	args.EnabledIndexes[accountsESDTIndex] = struct{}{}
+	args.ShardCoordinator = &mock.ShardCoordinatorMock{
+		ComputeIdCalled: func(address []byte) uint32 {
+			return uint32(address[0] % 2)
+		},
+	}
+	elasticProc := newTestElasticSearchDatabase(&mock.DatabaseWriterStub{}, args)
+
+	txPool := map[string]data.TransactionHandler{
+		"tx1": &transaction.Transaction{SndAddr: sender, RcvAddr: receiver, Data: []byte("ESDTTransfer@544b4e@05")},
+		"tx2": &transaction.Transaction{SndAddr: receiver, RcvAddr: sender, Data: []byte("ESDTTransfer@4f5448@01")},
+		"tx3": &transaction.Transaction{SndAddr: receiver, RcvAddr: sender, Data: []byte("claim")},
+		"tx4": &transaction.Transaction{SndAddr: receiver, RcvAddr: sender, Data: []byte("ESDTBurn@425552@01")},
+		"tx5": &transaction.Transaction{SndAddr: sender, RcvAddr: receiver, Data: []byte("ESDTWipe@575054")},
+	}
+
+	alteredTokens := elasticProc.getAlteredESDTTokens(txPool)
+	require.Equal(t, map[string]map[string]struct{}{
+		hex.EncodeToString(receiver): {"TKN": {}, "OTH": {}, "BUR": {}, "WPT": {}},
+	}, alteredTokens)
+}
+
+func TestElasticProcessor_IndexAlteredAccountsShouldIndexTheBalanceDeltas(t *testing.T) {
+	t.Parallel()
+
+	addressA := bytes.Repeat([]byte{1}, 32)
+	addressB := bytes.Repeat([]byte{2}, 32)
+	encodedA := hex.EncodeToString(addressA)
+	encodedB := hex.EncodeToString(addressB)
+	args := createMockElasticProcessorArgs()
+	args.EnabledIndexes[accountsESDTIndex] = struct{}{}
+
+	createAccount := func(address []byte, balance int64, esdtBalance int64) state.UserAccountHandler {
+		account, _ := state.NewUserAccount(address)
+		_ = account.AddToBalance(big.NewInt(balance))
+		esdtToken := esdt.New()
+		esdtToken.Value = big.NewInt(esdtBalance)
+		esdtTokenBytes, _ := args.Marshalizer.Marshal(esdtToken)
+		_ = account.DataTrieTracker().SaveKeyValue([]byte(core.ElrondProtectedKeyPrefix+core.ESDTKeyIdentifier+"TKN"), esdtTokenBytes)
+
+		return account
+	}
+	accounts := map[string]state.UserAccountHandler{
+		string(addressA): createAccount(addressA, 90, 15),
+		string(addressB): createAccount(addressB, 10, 5),
+	}
+	args.AccountsDB = &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return accounts[string(address)], nil
+		},
+	}
+
+	indexedHistory := make(map[string]*AccountBalanceHistory)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoMultiGetCalled: func(_ map[string]interface{}, index string) (map[string]interface{}, error) {
+			previousBalances := map[string]map[string]interface{}{
+				accountsIndex:     {"_id": encodedA, "_source": map[string]interface{}{"balance": "100"}},
+				accountsESDTIndex: {"_id": encodedA + "_TKN", "_source": map[string]interface{}{"balance": "20"}},
+			}
+
+			return map[string]interface{}{"docs": []interface{}{previousBalances[index]}}, nil
+		},
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			if index != accountsHistoryIndex {
+				return nil
+			}
+
+			lines := bytes.Split(bytes.TrimSpace(buff.Bytes()), []byte("\n"))
+			for i := 1; i < len(lines); i += 2 {
+				entry := &AccountBalanceHistory{}
+				_ = json.Unmarshal(lines[i], entry)
+				indexedHistory[entry.Address+"_"+entry.Token] = entry
+			}
+			return nil
+		},
+	}
+	elasticProc := newTestElasticSearchDatabase(dbWriter, args)
+
+	header := &dataBlock.Header{Nonce: 7, ShardID: 0, TimeStamp: 1000}
+	headerHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, header)
+	alteredAccounts := map[string]struct{}{encodedA: {}, encodedB: {}}
+	esdtTokens := map[string]map[string]struct{}{encodedA: {"TKN": {}}, encodedB: {"TKN": {}}}
+	err := elasticProc.indexAlteredAccounts(header, alteredAccounts, esdtTokens)
+	require.Nil(t, err)
+
+	template := AccountBalanceHistory{
+		Timestamp:  1000,
+		BlockHash:  hex.EncodeToString(headerHash),
+		BlockNonce: 7,
+	}
+	expectedEntry := func(address string, token string, balance string, delta string) *AccountBalanceHistory {
+		entry := template
+		entry.Address = address
+		entry.Token = token
+		entry.Balance = balance
+		entry.Delta = delta
+
+		return &entry
+	}
+	require.Equal(t, map[string]*AccountBalanceHistory{
+		encodedA + "_":    expectedEntry(encodedA, "", "90", "-10"),
+		encodedB + "_":    expectedEntry(encodedB, "", "10", "10"),
+		encodedA + "_TKN": expectedEntry(encodedA, "TKN", "15", "-5"),
+		encodedB + "_TKN": expectedEntry(encodedB, "TKN", "5", "5"),
+	}, indexedHistory)
+}
+
+func TestElasticProcessor_IndexAlteredAccountsShouldKeepOnlyTheCurrentTokenHolders(t *testing.T) {
+	t.Parallel()
+
+	formerHolder := bytes.Repeat([]byte{1}, 32)
+	holder := bytes.Repeat([]byte{2}, 32)
+	args := createMockElasticProcessorArgs()
+	args.EnabledIndexes = map[string]struct{}{accountsESDTIndex: {}}
+	tokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + "TKN")
+	args.AccountsDB = &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			account, _ := state.NewUserAccount(address)
+			if bytes.Equal(address, holder) {
+				esdtTokenBytes, _ := args.Marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(25)})
+				_ = account.DataTrieTracker().SaveKeyValue(tokenKey, esdtTokenBytes)
+			}
+
+			return account, nil
+		},
+	}
+
+	var removedIDs []string
+	indexedHolders := make([]*AccountESDT, 0)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRemoveCalled: func(index string, ids []string) error {
+			require.Equal(t, accountsESDTIndex, index)
+			removedIDs = ids
+			return nil
+		},
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Equal(t, accountsESDTIndex, index)
+			lines := bytes.Split(bytes.TrimSpace(buff.Bytes()), []byte("\n"))
+			for i := 1; i < len(lines); i += 2 {
+				accESDT := &AccountESDT{}
+				_ = json.Unmarshal(lines[i], accESDT)
+				indexedHolders = append(indexedHolders, accESDT)
+			}
+			return nil
+		},
+	}
+	elasticProc := newTestElasticSearchDatabase(dbWriter, args)
+
+	encodedFormerHolder := hex.EncodeToString(formerHolder)
+	encodedHolder := hex.EncodeToString(holder)
+	esdtTokens := map[string]map[string]struct{}{encodedFormerHolder: {"TKN": {}}, encodedHolder: {"TKN": {}}}
+	err := elasticProc.indexAlteredAccounts(&dataBlock.Header{TimeStamp: 1000}, map[string]struct{}{}, esdtTokens)
+	require.Nil(t, err)
+
+	require.Equal(t, []string{encodedFormerHolder + "_TKN"}, removedIDs)
+	require.Equal(t, []*AccountESDT{{
+		Address:    encodedHolder,
+		Token:      "TKN",
+		Balance:    "25",
+		BalanceNum: 25,
+		Timestamp:  1000,
+	}}, indexedHolders)
+}
+
 func TestElasticProcessor_ComputeBalanceAsFloat(t *testing.T) {
 	t.Parallel()
 
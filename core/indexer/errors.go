@@ -48,3 +48,24 @@ var ErrReadPolicyFile = errors.New("error while reading policy file")
 
 // ErrWriteToBuffer signals that a write error occurred
 var ErrWriteToBuffer = errors.New("error while writing to buffer")
+
+// ErrNilIndexer signals that a nil indexer has been provided
+var ErrNilIndexer = errors.New("nil indexer")
+
+// ErrNilDriversStatusesHandler signals that a nil drivers statuses handler has been provided
+var ErrNilDriversStatusesHandler = errors.New("nil drivers statuses handler")
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrInvalidCheckInterval signals that an invalid check interval has been provided
+var ErrInvalidCheckInterval = errors.New("invalid check interval")
+
+// ErrNilHeaderHandler signals that a nil header handler has been provided
+var ErrNilHeaderHandler = errors.New("nil header handler")
+
+// ErrUnknownTransactionType signals that a transaction of an unknown type has been provided
+var ErrUnknownTransactionType = errors.New("unknown transaction type")
+
+// ErrUnknownBufferedItemKind signals that a buffered item of an unknown kind has been read
+var ErrUnknownBufferedItemKind = errors.New("unknown buffered item kind")
@@ -0,0 +1,44 @@
+package indexer
+
+import "time"
+
+// ExponentialBackOff computes the waiting times between the retries of a failing delivery: the first wait lasts the
+// initial interval and each of the following ones doubles, up to the max interval. It is not concurrent safe
+type ExponentialBackOff struct {
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	currentInterval time.Duration
+}
+
+// NewExponentialBackOff creates a new ExponentialBackOff instance. A max interval lower than the initial interval
+// disables the growth of the waiting times
+func NewExponentialBackOff(initialInterval time.Duration, maxInterval time.Duration) *ExponentialBackOff {
+	if maxInterval < initialInterval {
+		maxInterval = initialInterval
+	}
+
+	return &ExponentialBackOff{
+		initialInterval: initialInterval,
+		maxInterval:     maxInterval,
+	}
+}
+
+// NextInterval returns the time to wait before the next retry
+func (eb *ExponentialBackOff) NextInterval() time.Duration {
+	if eb.currentInterval == 0 {
+		eb.currentInterval = eb.initialInterval
+		return eb.currentInterval
+	}
+
+	eb.currentInterval *= 2
+	if eb.currentInterval > eb.maxInterval {
+		eb.currentInterval = eb.maxInterval
+	}
+
+	return eb.currentInterval
+}
+
+// Reset must be called after a successful delivery, so the next failure waits the initial interval again
+func (eb *ExponentialBackOff) Reset() {
+	eb.currentInterval = 0
+}
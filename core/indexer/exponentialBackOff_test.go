@@ -0,0 +1,30 @@
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExponentialBackOff_NextIntervalShouldDoubleUpToTheMaxInterval(t *testing.T) {
+	t.Parallel()
+
+	eb := NewExponentialBackOff(time.Second, time.Second*5)
+	assert.Equal(t, time.Second, eb.NextInterval())
+	assert.Equal(t, time.Second*2, eb.NextInterval())
+	assert.Equal(t, time.Second*4, eb.NextInterval())
+	assert.Equal(t, time.Second*5, eb.NextInterval())
+	assert.Equal(t, time.Second*5, eb.NextInterval())
+
+	eb.Reset()
+	assert.Equal(t, time.Second, eb.NextInterval())
+}
+
+func TestExponentialBackOff_MaxIntervalLowerThanTheInitialIntervalShouldNotGrow(t *testing.T) {
+	t.Parallel()
+
+	eb := NewExponentialBackOff(time.Second, 0)
+	assert.Equal(t, time.Second, eb.NextInterval())
+	assert.Equal(t, time.Second, eb.NextInterval())
+}
@@ -3,7 +3,9 @@ package factory
 import (
 	"fmt"
 	"path"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
@@ -25,6 +27,8 @@ const (
 type ArgsIndexerFactory struct {
 	Enabled                  bool
 	IndexerCacheSize         int
+	LagThreshold             uint32
+	MaxRetryInterval         time.Duration
 	ShardCoordinator         sharding.Coordinator
 	Url                      string
 	UserName                 string
@@ -42,6 +46,10 @@ type ArgsIndexerFactory struct {
 	AccountsDB               state.AccountsAdapter
 	TransactionFeeCalculator process.TransactionFeeCalculator
 	IsInImportDBMode         bool
+	// WriteAheadLog, if enabled, keeps the data not yet indexed on disk, across restarts
+	WriteAheadLog config.WriteAheadLogConfig
+	// WorkingDir is the directory the write-ahead log path is relative to
+	WorkingDir string
 }
 
 // NewIndexer will create a new instance of Indexer
@@ -60,11 +68,24 @@ func NewIndexer(args *ArgsIndexerFactory) (indexer.Indexer, error) {
 		return nil, err
 	}
 
-	dispatcher, err := indexer.NewDataDispatcher(args.IndexerCacheSize)
+	argsDataDispatcher := indexer.ArgsDataDispatcher{
+		CacheSize:        args.IndexerCacheSize,
+		LagThreshold:     args.LagThreshold,
+		MaxRetryInterval: args.MaxRetryInterval,
+	}
+	dispatcher, err := indexer.NewDataDispatcher(argsDataDispatcher)
 	if err != nil {
 		return nil, err
 	}
 
+	var buffer indexer.DeliveryBuffer
+	if args.WriteAheadLog.Enabled {
+		buffer, err = createWriteAheadLog(args.WriteAheadLog, args.WorkingDir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	dispatcher.StartIndexData()
 
 	arguments := indexer.ArgDataIndexer{
@@ -75,9 +96,19 @@ func NewIndexer(args *ArgsIndexerFactory) (indexer.Indexer, error) {
 		ShardCoordinator:   args.ShardCoordinator,
 		ElasticProcessor:   elasticProcessor,
 		DataDispatcher:     dispatcher,
+		Buffer:             buffer,
+	}
+
+	dataIndexer, err := indexer.NewDataIndexer(arguments)
+	if err != nil {
+		_ = dispatcher.Close()
+		if buffer != nil {
+			_ = buffer.Close()
+		}
+		return nil, err
 	}
 
-	return indexer.NewDataIndexer(arguments)
+	return dataIndexer, nil
 }
 
 func createDatabaseClient(url, userName, password string) (indexer.DatabaseClientHandler, error) {
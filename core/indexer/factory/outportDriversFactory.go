@@ -0,0 +1,323 @@
+package factory
+
+import (
+	"net"
+	"path/filepath"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/deliveryBuffer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/disabled"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/filters"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/grpcStream"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/kafka"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/plugins"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/postgres"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/push"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+const bytesInMB = 1024 * 1024
+
+// ArgsOutportDriverFactory holds the dependencies shared by the outport drivers
+type ArgsOutportDriverFactory struct {
+	Marshalizer            marshal.Marshalizer
+	Hasher                 hashing.Hasher
+	AddressPubkeyConverter core.PubkeyConverter
+	ShardCoordinator       sharding.Coordinator
+	// WorkingDir is the directory the write-ahead logs paths are relative to
+	WorkingDir string
+	// CleanTxLogs should be set when the created driver is the last consumer of the cached transaction logs
+	CleanTxLogs bool
+}
+
+// NewKafkaDriver creates the Kafka driver if it is enabled in the provided configuration, a nil indexer otherwise
+func NewKafkaDriver(kafkaConfig config.KafkaConnectorConfig, args *ArgsOutportDriverFactory) (indexer.Indexer, error) {
+	if !kafkaConfig.Enabled {
+		return indexer.NewNilIndexer(), nil
+	}
+
+	argsKafkaProducer := kafka.ArgsKafkaProducer{
+		Brokers:      kafkaConfig.Brokers,
+		WriteTimeout: time.Second * time.Duration(kafkaConfig.WriteTimeoutInSeconds),
+	}
+	producer, err := kafka.NewKafkaProducer(argsKafkaProducer)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer, err := createDeliveryBuffer(kafkaConfig.WriteAheadLog, kafkaConfig.BufferSize, args.WorkingDir)
+	if err != nil {
+		_ = producer.Close()
+		return nil, err
+	}
+
+	argsKafkaDriver := kafka.ArgsKafkaDriver{
+		Producer:         producer,
+		Marshalizer:      args.Marshalizer,
+		Hasher:           args.Hasher,
+		PubkeyConverter:  args.AddressPubkeyConverter,
+		ShardCoordinator: args.ShardCoordinator,
+		TopicPrefix:      kafkaConfig.TopicPrefix,
+		PartitionBy:      kafkaConfig.PartitionBy,
+		Buffer:           buffer,
+		RetryInterval:    time.Second * time.Duration(kafkaConfig.RetryIntervalInSeconds),
+		MaxRetryInterval: time.Second * time.Duration(kafkaConfig.MaxRetryIntervalInSeconds),
+		LagThreshold:     kafkaConfig.LagThresholdInItems,
+		CleanTxLogs:      args.CleanTxLogs,
+	}
+
+	kafkaDriver, err := kafka.NewKafkaDriver(argsKafkaDriver)
+	if err != nil {
+		_ = buffer.Close()
+		_ = producer.Close()
+		return nil, err
+	}
+
+	return applyFilter(kafkaDriver, kafkaConfig.Filter, args)
+}
+
+// NewPostgresDriver creates the PostgreSQL driver if it is enabled in the provided configuration, a nil indexer otherwise
+func NewPostgresDriver(
+	postgresConfig config.PostgreSQLConnectorConfig,
+	accounts postgres.AccountsLoader,
+	args *ArgsOutportDriverFactory,
+) (indexer.Indexer, error) {
+	if !postgresConfig.Enabled {
+		return indexer.NewNilIndexer(), nil
+	}
+
+	if postgresConfig.Filter.ExcludeAccounts {
+		accounts = disabled.NewAccountsLoader()
+	}
+
+	argsPostgresDatabase := postgres.ArgsPostgresDatabase{
+		ConnectionString:   postgresConfig.ConnectionString,
+		MaxOpenConnections: postgresConfig.MaxOpenConnections,
+	}
+	database, err := postgres.NewPostgresDatabase(argsPostgresDatabase)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer, err := createDeliveryBuffer(postgresConfig.WriteAheadLog, postgresConfig.BufferSize, args.WorkingDir)
+	if err != nil {
+		_ = database.Close()
+		return nil, err
+	}
+
+	argsPostgresDriver := postgres.ArgsPostgresDriver{
+		Database:         database,
+		Marshalizer:      args.Marshalizer,
+		Hasher:           args.Hasher,
+		PubkeyConverter:  args.AddressPubkeyConverter,
+		ShardCoordinator: args.ShardCoordinator,
+		Accounts:         accounts,
+		Buffer:           buffer,
+		RetryInterval:    time.Second * time.Duration(postgresConfig.RetryIntervalInSeconds),
+		MaxRetryInterval: time.Second * time.Duration(postgresConfig.MaxRetryIntervalInSeconds),
+		LagThreshold:     postgresConfig.LagThresholdInItems,
+		CleanTxLogs:      args.CleanTxLogs,
+	}
+
+	postgresDriver, err := postgres.NewPostgresDriver(argsPostgresDriver)
+	if err != nil {
+		_ = buffer.Close()
+		_ = database.Close()
+		return nil, err
+	}
+
+	return applyFilter(postgresDriver, postgresConfig.Filter, args)
+}
+
+// NewGRPCDriver creates the gRPC driver if it is enabled in the provided configuration, a nil indexer otherwise
+func NewGRPCDriver(grpcConfig config.GRPCConnectorConfig, args *ArgsOutportDriverFactory) (indexer.Indexer, error) {
+	if !grpcConfig.Enabled {
+		return indexer.NewNilIndexer(), nil
+	}
+
+	listener, err := net.Listen("tcp", grpcConfig.ListenAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	var buffer indexer.DeliveryBuffer
+	if grpcConfig.WriteAheadLog.Enabled {
+		buffer, err = createWriteAheadLog(grpcConfig.WriteAheadLog, args.WorkingDir)
+		if err != nil {
+			_ = listener.Close()
+			return nil, err
+		}
+	}
+
+	argsGRPCDriver := grpcStream.ArgsGRPCDriver{
+		Listener:         listener,
+		Marshalizer:      args.Marshalizer,
+		Hasher:           args.Hasher,
+		PubkeyConverter:  args.AddressPubkeyConverter,
+		ShardCoordinator: args.ShardCoordinator,
+		BufferSize:       grpcConfig.BufferSize,
+		MaxConsumers:     grpcConfig.MaxConsumers,
+		CleanTxLogs:      args.CleanTxLogs,
+		DeliveryBuffer:   buffer,
+	}
+
+	grpcDriver, err := grpcStream.NewGRPCDriver(argsGRPCDriver)
+	if err != nil {
+		if buffer != nil {
+			_ = buffer.Close()
+		}
+		_ = listener.Close()
+		return nil, err
+	}
+
+	return applyFilter(grpcDriver, grpcConfig.Filter, args)
+}
+
+// NewPushNotifier creates the push notifier if it is enabled in the provided configuration, a disabled one otherwise
+func NewPushNotifier(pushConfig config.PushNotifierConfig, args *ArgsOutportDriverFactory) (push.Notifier, error) {
+	if !pushConfig.Enabled {
+		return push.NewDisabledPushNotifier(), nil
+	}
+
+	argsPushNotifier := push.ArgsPushNotifier{
+		PubkeyConverter:      args.AddressPubkeyConverter,
+		MaxSubscribers:       pushConfig.MaxSubscribers,
+		SubscriberBufferSize: pushConfig.SubscriberBufferSize,
+		CleanTxLogs:          args.CleanTxLogs,
+	}
+	if pushConfig.WriteAheadLog.Enabled {
+		buffer, err := createWriteAheadLog(pushConfig.WriteAheadLog, args.WorkingDir)
+		if err != nil {
+			return nil, err
+		}
+
+		argsPushNotifier.Buffer = buffer
+	}
+
+	pushNotifier, err := push.NewPushNotifier(argsPushNotifier)
+	if err != nil && argsPushNotifier.Buffer != nil {
+		_ = argsPushNotifier.Buffer.Close()
+	}
+
+	return pushNotifier, err
+}
+
+// NewPluginDriver starts the outport plugin if it is enabled in the provided configuration and returns the driver
+// delivering to it, a nil indexer otherwise
+func NewPluginDriver(pluginConfig config.OutportPluginConfig, args *ArgsOutportDriverFactory) (indexer.Indexer, error) {
+	if !pluginConfig.Enabled {
+		return indexer.NewNilIndexer(), nil
+	}
+
+	path := pluginConfig.Path
+	if len(path) > 0 && !filepath.IsAbs(path) {
+		path = filepath.Join(args.WorkingDir, path)
+	}
+
+	argsPluginClient := plugins.ArgsPluginClient{
+		Name:             pluginConfig.Name,
+		Path:             path,
+		Arguments:        pluginConfig.Arguments,
+		HandshakeTimeout: time.Second * time.Duration(pluginConfig.HandshakeTimeoutInSeconds),
+	}
+	client, err := plugins.NewPluginClient(argsPluginClient)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer, err := createDeliveryBuffer(pluginConfig.WriteAheadLog, pluginConfig.BufferSize, args.WorkingDir)
+	if err != nil {
+		_ = client.Close()
+		return nil, err
+	}
+
+	argsPluginDriver := plugins.ArgsPluginDriver{
+		Name:             pluginConfig.Name,
+		Client:           client,
+		Marshalizer:      args.Marshalizer,
+		Hasher:           args.Hasher,
+		PubkeyConverter:  args.AddressPubkeyConverter,
+		ShardCoordinator: args.ShardCoordinator,
+		Buffer:           buffer,
+		RetryInterval:    time.Second * time.Duration(pluginConfig.RetryIntervalInSeconds),
+		MaxRetryInterval: time.Second * time.Duration(pluginConfig.MaxRetryIntervalInSeconds),
+		LagThreshold:     pluginConfig.LagThresholdInItems,
+		CleanTxLogs:      args.CleanTxLogs,
+	}
+
+	pluginDriver, err := plugins.NewPluginDriver(argsPluginDriver)
+	if err != nil {
+		_ = buffer.Close()
+		_ = client.Close()
+		return nil, err
+	}
+
+	return applyFilter(pluginDriver, pluginConfig.Filter, args)
+}
+
+func createDeliveryBuffer(
+	walConfig config.WriteAheadLogConfig,
+	bufferSize uint32,
+	workingDir string,
+) (indexer.DeliveryBuffer, error) {
+	if !walConfig.Enabled {
+		return deliveryBuffer.NewMemoryBuffer(bufferSize)
+	}
+
+	return createWriteAheadLog(walConfig, workingDir)
+}
+
+// createWriteAheadLog creates the write-ahead log of a driver. A full log drops the new items instead of waiting, so the
+// block processing is never held back by an unavailable endpoint
+func createWriteAheadLog(walConfig config.WriteAheadLogConfig, workingDir string) (indexer.DeliveryBuffer, error) {
+	argDB := storageUnit.ArgDB{
+		DBType:            storageUnit.LvlDBSerial,
+		Path:              filepath.Join(workingDir, walConfig.Path),
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	}
+	persister, err := storageUnit.NewDB(argDB)
+	if err != nil {
+		return nil, err
+	}
+
+	argsWriteAheadLog := deliveryBuffer.ArgsWriteAheadLog{
+		Persister:      persister,
+		MaxSizeInBytes: uint64(walConfig.MaxSizeInMB) * bytesInMB,
+	}
+	wal, err := deliveryBuffer.NewWriteAheadLog(argsWriteAheadLog)
+	if err != nil {
+		_ = persister.Close()
+		return nil, err
+	}
+
+	return wal, nil
+}
+
+func applyFilter(driver indexer.Indexer, filterConfig config.OutportFilterConfig, args *ArgsOutportDriverFactory) (indexer.Indexer, error) {
+	if filters.IsFilterEmpty(filterConfig) {
+		return driver, nil
+	}
+
+	argsFilteredIndexer := filters.ArgsFilteredIndexer{
+		Indexer:          driver,
+		Config:           filterConfig,
+		PubkeyConverter:  args.AddressPubkeyConverter,
+		ShardCoordinator: args.ShardCoordinator,
+		CleanTxLogs:      args.CleanTxLogs,
+	}
+	filteredDriver, err := filters.NewFilteredIndexer(argsFilteredIndexer)
+	if err != nil {
+		_ = driver.Close()
+		return nil, err
+	}
+
+	return filteredDriver, nil
+}
@@ -0,0 +1,18 @@
+package filters
+
+import "errors"
+
+// ErrNilIndexer signals that a nil indexer has been provided
+var ErrNilIndexer = errors.New("nil indexer")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrInvalidShardID signals that an invalid shard ID has been provided in the filter
+var ErrInvalidShardID = errors.New("invalid shard ID")
+
+// ErrInvalidLogAddress signals that an invalid log address has been provided in the filter
+var ErrInvalidLogAddress = errors.New("invalid log address")
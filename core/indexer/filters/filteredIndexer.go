@@ -0,0 +1,273 @@
+package filters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var _ indexer.Indexer = (*filteredIndexer)(nil)
+
+// ArgsFilteredIndexer is the DTO used to create a new instance of filteredIndexer
+type ArgsFilteredIndexer struct {
+	Indexer          indexer.Indexer
+	Config           config.OutportFilterConfig
+	PubkeyConverter  core.PubkeyConverter
+	ShardCoordinator sharding.Coordinator
+	// CleanTxLogs should be set when the contained indexer is the last consumer of the cached transaction logs, so
+	// the logs of the dropped blocks are cleaned as well
+	CleanTxLogs bool
+}
+
+// filteredIndexer drops the data rejected by the configured filter before handing the rest to the contained indexer,
+// so the driver does not marshal and send data its consumers are not interested in
+type filteredIndexer struct {
+	indexer          indexer.Indexer
+	shardCoordinator sharding.Coordinator
+	shards           map[uint32]struct{}
+	events           *eventsFilter
+	excludeAccounts  bool
+	cleanTxLogs      bool
+	mutTxLogsProc    sync.RWMutex
+	txLogsProc       process.TransactionLogProcessorDatabase
+}
+
+// NewFilteredIndexer creates a new filteredIndexer instance
+func NewFilteredIndexer(args ArgsFilteredIndexer) (*filteredIndexer, error) {
+	if check.IfNil(args.Indexer) {
+		return nil, ErrNilIndexer
+	}
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+
+	shards := make(map[uint32]struct{}, len(args.Config.Shards))
+	for _, shardIDStr := range args.Config.Shards {
+		shardID, err := core.ConvertShardIDToUint32(shardIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidShardID, shardIDStr)
+		}
+
+		shards[shardID] = struct{}{}
+	}
+
+	events := &eventsFilter{
+		identifiers: make(map[string]struct{}, len(args.Config.LogIdentifiers)),
+		addresses:   make(map[string]struct{}, len(args.Config.LogAddresses)),
+	}
+	for _, identifier := range args.Config.LogIdentifiers {
+		events.identifiers[identifier] = struct{}{}
+	}
+	for _, encodedAddress := range args.Config.LogAddresses {
+		address, err := args.PubkeyConverter.Decode(encodedAddress)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s, %s", ErrInvalidLogAddress, encodedAddress, err.Error())
+		}
+
+		events.addresses[string(address)] = struct{}{}
+	}
+
+	return &filteredIndexer{
+		indexer:          args.Indexer,
+		shardCoordinator: args.ShardCoordinator,
+		shards:           shards,
+		events:           events,
+		excludeAccounts:  args.Config.ExcludeAccounts,
+		cleanTxLogs:      args.CleanTxLogs,
+	}, nil
+}
+
+// IsFilterEmpty returns true if the provided filter configuration does not filter anything
+func IsFilterEmpty(filterConfig config.OutportFilterConfig) bool {
+	return len(filterConfig.Shards) == 0 &&
+		len(filterConfig.LogIdentifiers) == 0 &&
+		len(filterConfig.LogAddresses) == 0 &&
+		!filterConfig.ExcludeAccounts
+}
+
+func (fi *filteredIndexer) isShardAccepted(shardID uint32) bool {
+	if len(fi.shards) == 0 {
+		return true
+	}
+
+	_, ok := fi.shards[shardID]
+	return ok
+}
+
+// SetTxLogsProcessor sets a logs processor returning only the accepted events of the cached logs
+func (fi *filteredIndexer) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	fi.mutTxLogsProc.Lock()
+	fi.txLogsProc = txLogsProc
+	fi.mutTxLogsProc.Unlock()
+
+	if check.IfNil(txLogsProc) || fi.events.isEmpty() {
+		fi.indexer.SetTxLogsProcessor(txLogsProc)
+		return
+	}
+
+	fi.indexer.SetTxLogsProcessor(&filteredTxLogsProcessor{
+		TransactionLogProcessorDatabase: txLogsProc,
+		filter:                          fi.events,
+	})
+}
+
+// SaveBlock hands the block to the contained indexer if its shard is accepted. The cached logs of a dropped block are
+// cleaned if the contained indexer is their last consumer
+func (fi *filteredIndexer) SaveBlock(
+	body data.BodyHandler,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+	notarizedHeadersHashes []string,
+	headerHash []byte,
+) {
+	if check.IfNil(header) || !fi.isShardAccepted(header.GetShardID()) {
+		fi.cleanDroppedTxLogs()
+		return
+	}
+
+	fi.indexer.SaveBlock(body, header, txPool, signersIndexes, notarizedHeadersHashes, headerHash)
+}
+
+func (fi *filteredIndexer) cleanDroppedTxLogs() {
+	if !fi.cleanTxLogs {
+		return
+	}
+
+	fi.mutTxLogsProc.RLock()
+	txLogsProc := fi.txLogsProc
+	fi.mutTxLogsProc.RUnlock()
+
+	if !check.IfNil(txLogsProc) {
+		txLogsProc.Clean()
+	}
+}
+
+// RevertIndexedBlock hands the reverted block to the contained indexer if its shard is accepted
+func (fi *filteredIndexer) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) {
+	if check.IfNil(header) || !fi.isShardAccepted(header.GetShardID()) {
+		return
+	}
+
+	fi.indexer.RevertIndexedBlock(header, body)
+}
+
+// SaveRoundsInfo hands the rounds of the accepted shards to the contained indexer
+func (fi *filteredIndexer) SaveRoundsInfo(roundsInfos []workItems.RoundInfo) {
+	filteredRounds := make([]workItems.RoundInfo, 0, len(roundsInfos))
+	for _, roundInfo := range roundsInfos {
+		if fi.isShardAccepted(roundInfo.ShardId) {
+			filteredRounds = append(filteredRounds, roundInfo)
+		}
+	}
+	if len(filteredRounds) == 0 {
+		return
+	}
+
+	fi.indexer.SaveRoundsInfo(filteredRounds)
+}
+
+// UpdateTPS hands the TPS benchmark to the contained indexer
+func (fi *filteredIndexer) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
+	fi.indexer.UpdateTPS(tpsBenchmark)
+}
+
+// SaveValidatorsPubKeys hands the validators of the accepted shards to the contained indexer
+func (fi *filteredIndexer) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) {
+	filteredPubKeys := make(map[uint32][][]byte, len(validatorsPubKeys))
+	for shardID, pubKeys := range validatorsPubKeys {
+		if fi.isShardAccepted(shardID) {
+			filteredPubKeys[shardID] = pubKeys
+		}
+	}
+	if len(filteredPubKeys) == 0 {
+		return
+	}
+
+	fi.indexer.SaveValidatorsPubKeys(filteredPubKeys, epoch)
+}
+
+// SaveValidatorsRating hands the ratings to the contained indexer if their shard is accepted. The index ID is
+// formatted as shardID_epoch
+func (fi *filteredIndexer) SaveValidatorsRating(indexID string, infoRating []workItems.ValidatorRatingInfo) {
+	shardIDStr := strings.Split(indexID, "_")[0]
+	shardID, err := core.ConvertShardIDToUint32(shardIDStr)
+	if err == nil && !fi.isShardAccepted(shardID) {
+		return
+	}
+
+	fi.indexer.SaveValidatorsRating(indexID, infoRating)
+}
+
+// SaveAccounts hands the accounts of the accepted shards to the contained indexer, unless the accounts are excluded
+func (fi *filteredIndexer) SaveAccounts(accounts []state.UserAccountHandler) {
+	if fi.excludeAccounts {
+		return
+	}
+
+	filteredAccounts := make([]state.UserAccountHandler, 0, len(accounts))
+	for _, account := range accounts {
+		if check.IfNil(account) {
+			continue
+		}
+		if fi.isShardAccepted(fi.shardCoordinator.ComputeId(account.AddressBytes())) {
+			filteredAccounts = append(filteredAccounts, account)
+		}
+	}
+	if len(filteredAccounts) == 0 {
+		return
+	}
+
+	fi.indexer.SaveAccounts(filteredAccounts)
+}
+
+// Flush waits for the contained indexer to deliver the queued data, if it delivers the data asynchronously
+func (fi *filteredIndexer) Flush(ctx context.Context) error {
+	flusher, ok := fi.indexer.(indexer.Flusher)
+	if !ok {
+		return nil
+	}
+
+	return flusher.Flush(ctx)
+}
+
+// DriverStatus returns the health of the contained indexer, if it delivers the data to an external endpoint, or an
+// empty status otherwise
+func (fi *filteredIndexer) DriverStatus() indexer.DriverStatus {
+	statusHandler, ok := fi.indexer.(indexer.DriverStatusHandler)
+	if !ok {
+		return indexer.DriverStatus{}
+	}
+
+	return statusHandler.DriverStatus()
+}
+
+// Close closes the contained indexer
+func (fi *filteredIndexer) Close() error {
+	return fi.indexer.Close()
+}
+
+// IsNilIndexer returns true if the contained indexer is a nil indexer
+func (fi *filteredIndexer) IsNilIndexer() bool {
+	return fi.indexer.IsNilIndexer()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fi *filteredIndexer) IsInterfaceNil() bool {
+	return fi == nil
+}
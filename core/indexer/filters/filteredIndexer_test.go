@@ -0,0 +1,314 @@
+package filters
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsFilteredIndexer() ArgsFilteredIndexer {
+	return ArgsFilteredIndexer{
+		Indexer:          &mock.IndexerStub{},
+		Config:           config.OutportFilterConfig{},
+		PubkeyConverter:  mock.NewPubkeyConverterMock(4),
+		ShardCoordinator: &mock.ShardCoordinatorMock{},
+	}
+}
+
+func TestNewFilteredIndexer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		argsFunc func() ArgsFilteredIndexer
+		exError  error
+	}{
+		{
+			name: "NilIndexer",
+			argsFunc: func() ArgsFilteredIndexer {
+				args := createArgsFilteredIndexer()
+				args.Indexer = nil
+				return args
+			},
+			exError: ErrNilIndexer,
+		},
+		{
+			name: "NilPubkeyConverter",
+			argsFunc: func() ArgsFilteredIndexer {
+				args := createArgsFilteredIndexer()
+				args.PubkeyConverter = nil
+				return args
+			},
+			exError: ErrNilPubkeyConverter,
+		},
+		{
+			name: "NilShardCoordinator",
+			argsFunc: func() ArgsFilteredIndexer {
+				args := createArgsFilteredIndexer()
+				args.ShardCoordinator = nil
+				return args
+			},
+			exError: ErrNilShardCoordinator,
+		},
+		{
+			name: "InvalidShardID",
+			argsFunc: func() ArgsFilteredIndexer {
+				args := createArgsFilteredIndexer()
+				args.Config.Shards = []string{"x"}
+				return args
+			},
+			exError: ErrInvalidShardID,
+		},
+		{
+			name: "InvalidLogAddress",
+			argsFunc: func() ArgsFilteredIndexer {
+				args := createArgsFilteredIndexer()
+				args.Config.LogAddresses = []string{"not hex"}
+				return args
+			},
+			exError: ErrInvalidLogAddress,
+		},
+		{
+			name: "All arguments ok",
+			argsFunc: func() ArgsFilteredIndexer {
+				args := createArgsFilteredIndexer()
+				args.Config.Shards = []string{"0", "metachain"}
+				args.Config.LogAddresses = []string{"aabbccdd"}
+				return args
+			},
+			exError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fi, err := NewFilteredIndexer(tt.argsFunc())
+			require.True(t, errors.Is(err, tt.exError))
+			require.Equal(t, tt.exError != nil, check.IfNil(fi))
+		})
+	}
+}
+
+func TestIsFilterEmpty(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, IsFilterEmpty(config.OutportFilterConfig{}))
+	require.False(t, IsFilterEmpty(config.OutportFilterConfig{Shards: []string{"0"}}))
+	require.False(t, IsFilterEmpty(config.OutportFilterConfig{LogIdentifiers: []string{"transfer"}}))
+	require.False(t, IsFilterEmpty(config.OutportFilterConfig{LogAddresses: []string{"aa"}}))
+	require.False(t, IsFilterEmpty(config.OutportFilterConfig{ExcludeAccounts: true}))
+}
+
+func TestFilteredIndexer_SaveBlockShouldFilterByShard(t *testing.T) {
+	t.Parallel()
+
+	savedShards := make([]uint32, 0)
+	numCleans := 0
+	args := createArgsFilteredIndexer()
+	args.Config.Shards = []string{"metachain"}
+	args.CleanTxLogs = true
+	args.Indexer = &mock.IndexerStub{
+		SaveBlockCalled: func(_ data.BodyHandler, header data.HeaderHandler, _ map[string]data.TransactionHandler, _ []uint64, _ []string, _ []byte) {
+			savedShards = append(savedShards, header.GetShardID())
+		},
+	}
+	fi, _ := NewFilteredIndexer(args)
+	fi.SetTxLogsProcessor(&mock.TxLogsProcessorDatabaseStub{
+		CleanCalled: func() {
+			numCleans++
+		},
+	})
+
+	fi.SaveBlock(&block.Body{}, &block.Header{ShardID: 0}, nil, nil, nil, nil)
+	fi.SaveBlock(&block.Body{}, &block.MetaBlock{}, nil, nil, nil, nil)
+
+	require.Equal(t, []uint32{core.MetachainShardId}, savedShards)
+	require.Equal(t, 1, numCleans)
+}
+
+func TestFilteredIndexer_SaveBlockDroppedShouldNotCleanIfNotLastConsumer(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsFilteredIndexer()
+	args.Config.Shards = []string{"1"}
+	fi, _ := NewFilteredIndexer(args)
+	fi.SetTxLogsProcessor(&mock.TxLogsProcessorDatabaseStub{
+		CleanCalled: func() {
+			require.Fail(t, "should have not been called")
+		},
+	})
+
+	fi.SaveBlock(&block.Body{}, &block.Header{ShardID: 0}, nil, nil, nil, nil)
+}
+
+func TestFilteredIndexer_RevertIndexedBlockShouldFilterByShard(t *testing.T) {
+	t.Parallel()
+
+	numReverts := 0
+	args := createArgsFilteredIndexer()
+	args.Config.Shards = []string{"1"}
+	args.Indexer = &mock.IndexerStub{
+		RevertIndexedBlockCalled: func(_ data.HeaderHandler, _ data.BodyHandler) {
+			numReverts++
+		},
+	}
+	fi, _ := NewFilteredIndexer(args)
+
+	fi.RevertIndexedBlock(&block.Header{ShardID: 0}, &block.Body{})
+	require.Equal(t, 0, numReverts)
+
+	fi.RevertIndexedBlock(&block.Header{ShardID: 1}, &block.Body{})
+	require.Equal(t, 1, numReverts)
+}
+
+func TestFilteredIndexer_SaveRoundsInfoAndValidatorsShouldFilterByShard(t *testing.T) {
+	t.Parallel()
+
+	var savedRounds []workItems.RoundInfo
+	var savedPubKeys map[uint32][][]byte
+	savedRatings := make([]string, 0)
+	args := createArgsFilteredIndexer()
+	args.Config.Shards = []string{"0"}
+	args.Indexer = &mock.IndexerStub{
+		SaveRoundsInfoCalled: func(roundsInfos []workItems.RoundInfo) {
+			savedRounds = roundsInfos
+		},
+		SaveValidatorsPubKeysCalled: func(validatorsPubKeys map[uint32][][]byte, _ uint32) {
+			savedPubKeys = validatorsPubKeys
+		},
+		SaveValidatorsRatingCalled: func(indexID string, _ []workItems.ValidatorRatingInfo) {
+			savedRatings = append(savedRatings, indexID)
+		},
+	}
+	fi, _ := NewFilteredIndexer(args)
+
+	fi.SaveRoundsInfo([]workItems.RoundInfo{{Index: 1, ShardId: 0}, {Index: 1, ShardId: core.MetachainShardId}})
+	require.Equal(t, []workItems.RoundInfo{{Index: 1, ShardId: 0}}, savedRounds)
+
+	fi.SaveValidatorsPubKeys(map[uint32][][]byte{0: {[]byte("pk0")}, 1: {[]byte("pk1")}}, 2)
+	require.Equal(t, map[uint32][][]byte{0: {[]byte("pk0")}}, savedPubKeys)
+
+	fi.SaveValidatorsRating("0_2", nil)
+	fi.SaveValidatorsRating("4294967295_2", nil)
+	require.Equal(t, []string{"0_2"}, savedRatings)
+}
+
+func TestFilteredIndexer_SaveAccounts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("accounts excluded", func(t *testing.T) {
+		args := createArgsFilteredIndexer()
+		args.Config.ExcludeAccounts = true
+		args.Indexer = &mock.IndexerStub{
+			SaveAccountsCalled: func(_ []state.UserAccountHandler) {
+				require.Fail(t, "should have not been called")
+			},
+		}
+		fi, _ := NewFilteredIndexer(args)
+
+		acc, _ := state.NewUserAccount([]byte("addr"))
+		fi.SaveAccounts([]state.UserAccountHandler{acc})
+	})
+	t.Run("accounts filtered by shard", func(t *testing.T) {
+		var savedAccounts []state.UserAccountHandler
+		args := createArgsFilteredIndexer()
+		args.Config.Shards = []string{"1"}
+		args.ShardCoordinator = &mock.ShardCoordinatorMock{
+			ComputeIdCalled: func(address []byte) uint32 {
+				return uint32(address[len(address)-1] - '0')
+			},
+		}
+		args.Indexer = &mock.IndexerStub{
+			SaveAccountsCalled: func(accounts []state.UserAccountHandler) {
+				savedAccounts = accounts
+			},
+		}
+		fi, _ := NewFilteredIndexer(args)
+
+		acc0, _ := state.NewUserAccount([]byte("addr0"))
+		acc1, _ := state.NewUserAccount([]byte("addr1"))
+		fi.SaveAccounts([]state.UserAccountHandler{acc0, acc1})
+		require.Equal(t, []state.UserAccountHandler{acc1}, savedAccounts)
+	})
+}
+
+func TestFilteredIndexer_SetTxLogsProcessorShouldFilterTheEvents(t *testing.T) {
+	t.Parallel()
+
+	contract := []byte("contract")
+	other := []byte("other")
+	txLog := &transaction.Log{
+		Address: contract,
+		Events: []*transaction.Event{
+			{Address: contract, Identifier: []byte("transfer")},
+			{Address: other, Identifier: []byte("transfer")},
+			{Address: contract, Identifier: []byte("burn")},
+		},
+	}
+
+	var innerTxLogsProc process.TransactionLogProcessorDatabase
+	args := createArgsFilteredIndexer()
+	args.Config.LogIdentifiers = []string{"transfer"}
+	args.Config.LogAddresses = []string{hex.EncodeToString(contract)}
+	args.Indexer = &mock.IndexerStub{
+		SetTxLogsProcessorCalled: func(txLogsProc process.TransactionLogProcessorDatabase) {
+			innerTxLogsProc = txLogsProc
+		},
+	}
+	fi, _ := NewFilteredIndexer(args)
+	fi.SetTxLogsProcessor(&mock.TxLogsProcessorDatabaseStub{
+		GetLogFromCacheCalled: func(txHash []byte) (data.LogHandler, bool) {
+			if string(txHash) == "tx" {
+				return txLog, true
+			}
+
+			return &transaction.Log{
+				Address: contract,
+				Events:  []*transaction.Event{{Address: other, Identifier: []byte("burn")}},
+			}, true
+		},
+	})
+
+	filteredLog, found := innerTxLogsProc.GetLogFromCache([]byte("tx"))
+	require.True(t, found)
+	require.Equal(t, contract, filteredLog.GetAddress())
+	events := filteredLog.GetLogEvents()
+	require.Len(t, events, 3)
+	require.Equal(t, txLog.Events[0], events[0])
+	require.Nil(t, events[1])
+	require.Nil(t, events[2])
+
+	filteredLog, found = innerTxLogsProc.GetLogFromCache([]byte("rejected"))
+	require.False(t, found)
+	require.Nil(t, filteredLog)
+}
+
+func TestFilteredIndexer_SetTxLogsProcessorWithoutEventsFilterShouldNotWrap(t *testing.T) {
+	t.Parallel()
+
+	txLogsProc := &mock.TxLogsProcessorDatabaseStub{}
+	var innerTxLogsProc process.TransactionLogProcessorDatabase
+	args := createArgsFilteredIndexer()
+	args.Config.ExcludeAccounts = true
+	args.Indexer = &mock.IndexerStub{
+		SetTxLogsProcessorCalled: func(txLogsProc process.TransactionLogProcessorDatabase) {
+			innerTxLogsProc = txLogsProc
+		},
+	}
+	fi, _ := NewFilteredIndexer(args)
+
+	fi.SetTxLogsProcessor(txLogsProc)
+	require.True(t, innerTxLogsProc == txLogsProc)
+}
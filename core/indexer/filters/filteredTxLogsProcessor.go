@@ -0,0 +1,94 @@
+package filters
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// filteredTxLogsProcessor returns the cached transaction logs keeping only the events accepted by the filter
+type filteredTxLogsProcessor struct {
+	process.TransactionLogProcessorDatabase
+	filter *eventsFilter
+}
+
+// GetLogFromCache returns the cached log of the provided transaction. The rejected events are replaced with nil so
+// the accepted ones keep their positions in the log. A log without accepted events is reported as not found
+func (ftlp *filteredTxLogsProcessor) GetLogFromCache(txHash []byte) (data.LogHandler, bool) {
+	txLog, found := ftlp.TransactionLogProcessorDatabase.GetLogFromCache(txHash)
+	if !found || check.IfNil(txLog) {
+		return txLog, found
+	}
+
+	events := txLog.GetLogEvents()
+	filteredEvents := make([]data.EventHandler, len(events))
+	numAccepted := 0
+	for i, event := range events {
+		if check.IfNil(event) || !ftlp.filter.isAccepted(event) {
+			continue
+		}
+
+		filteredEvents[i] = event
+		numAccepted++
+	}
+	if numAccepted == 0 {
+		return nil, false
+	}
+
+	return &filteredLog{
+		address: txLog.GetAddress(),
+		events:  filteredEvents,
+	}, true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ftlp *filteredTxLogsProcessor) IsInterfaceNil() bool {
+	return ftlp == nil
+}
+
+type filteredLog struct {
+	address []byte
+	events  []data.EventHandler
+}
+
+// GetAddress returns the address of the contract that was originally called
+func (fl *filteredLog) GetAddress() []byte {
+	return fl.address
+}
+
+// GetLogEvents returns the events of the log, the rejected ones being nil
+func (fl *filteredLog) GetLogEvents() []data.EventHandler {
+	return fl.events
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fl *filteredLog) IsInterfaceNil() bool {
+	return fl == nil
+}
+
+// eventsFilter accepts the events matching all the configured criteria
+type eventsFilter struct {
+	identifiers map[string]struct{}
+	addresses   map[string]struct{}
+}
+
+func (ef *eventsFilter) isEmpty() bool {
+	return len(ef.identifiers) == 0 && len(ef.addresses) == 0
+}
+
+func (ef *eventsFilter) isAccepted(event data.EventHandler) bool {
+	if len(ef.identifiers) > 0 {
+		_, ok := ef.identifiers[string(event.GetIdentifier())]
+		if !ok {
+			return false
+		}
+	}
+	if len(ef.addresses) > 0 {
+		_, ok := ef.addresses[string(event.GetAddress())]
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
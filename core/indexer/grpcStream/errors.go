@@ -0,0 +1,42 @@
+package grpcStream
+
+import "errors"
+
+// ErrNilListener signals that a nil network listener has been provided
+var ErrNilListener = errors.New("nil listener")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrInvalidBufferSize signals that an invalid buffer size has been provided
+var ErrInvalidBufferSize = errors.New("invalid buffer size")
+
+// ErrInvalidMaxConsumers signals that an invalid maximum number of consumers has been provided
+var ErrInvalidMaxConsumers = errors.New("invalid maximum number of consumers")
+
+// ErrTooManyConsumers signals that the maximum number of connected consumers has been reached
+var ErrTooManyConsumers = errors.New("too many consumers")
+
+// ErrConsumerAlreadyConnected signals that a consumer with the same ID is already connected
+var ErrConsumerAlreadyConnected = errors.New("consumer already connected")
+
+// ErrInvalidResumeToken signals that a malformed resume token has been provided
+var ErrInvalidResumeToken = errors.New("invalid resume token")
+
+// ErrResumeTokenFromOtherSession signals that the provided resume token was issued before the node restarted
+var ErrResumeTokenFromOtherSession = errors.New("resume token issued by another session")
+
+// ErrOffsetNotBuffered signals that the requested offset has already been evicted from the buffer
+var ErrOffsetNotBuffered = errors.New("offset is no longer buffered")
+
+// ErrNilItemHandler signals that a nil item handler has been provided
+var ErrNilItemHandler = errors.New("nil item handler")
@@ -0,0 +1,389 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=plugins=grpc:. stream.proto
+package grpcStream
+
+import (
+	"context"
+	"crypto/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/deliveryBuffer"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var log = logger.GetOrCreate("core/indexer/grpcStream")
+
+const (
+	driverName          = "grpc"
+	bufferRetryInterval = time.Second
+)
+
+// ArgsGRPCDriver is the DTO used to create a new instance of grpcDriver
+type ArgsGRPCDriver struct {
+	Listener         net.Listener
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	PubkeyConverter  core.PubkeyConverter
+	ShardCoordinator sharding.Coordinator
+	BufferSize       uint32
+	MaxConsumers     uint32
+	// CleanTxLogs should be set when the gRPC driver is the last consumer of the cached transaction logs
+	CleanTxLogs bool
+	// DeliveryBuffer, if set, holds the items until a consumer receives them, so a write-ahead log keeps them across
+	// restarts
+	DeliveryBuffer indexer.DeliveryBuffer
+}
+
+type consumer struct {
+	id         string
+	lastOffset uint64
+	active     bool
+}
+
+// grpcDriver serves the Outport gRPC service, streaming the blocks, rounds, validators and accounts, protobuf encoded,
+// to the connected consumers. The items are kept in a fixed size buffer, so saving a block never waits for the
+// consumers. A consumer resumes either from a resume token or from the last item sent to its consumer ID, as long as
+// the item is still buffered. The offsets and the resume tokens do not survive a node restart. If a delivery buffer is
+// provided, the items are appended to it and moved to the stream buffer one at a time, each only after a consumer
+// received the previous one, so the items not yet streamed are kept across restarts by a write-ahead log
+type grpcDriver struct {
+	*ItemsProducer
+	server         *grpc.Server
+	sessionID      []byte
+	buffer         *streamBuffer
+	maxConsumers   uint32
+	mutConsumers   sync.Mutex
+	numActive      uint32
+	consumers      map[string]*consumer
+	deliveryBuffer indexer.DeliveryBuffer
+	pendingItems   indexer.PendingItems
+	health         *indexer.DriverHealth
+	sentOffset     uint64
+	chanSent       chan struct{}
+	cancelFunc     func()
+}
+
+// NewGRPCDriver creates a new gRPC driver and starts serving on the provided listener
+func NewGRPCDriver(args ArgsGRPCDriver) (*grpcDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionID := make([]byte, sessionIDLength)
+	_, err = rand.Read(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	gd := &grpcDriver{
+		server:       grpc.NewServer(),
+		sessionID:    sessionID,
+		buffer:       newStreamBuffer(args.BufferSize, sessionID),
+		maxConsumers: args.MaxConsumers,
+		consumers:    make(map[string]*consumer),
+		health:       indexer.NewDriverHealth(driverName, 0),
+		chanSent:     make(chan struct{}, 1),
+		cancelFunc:   func() {},
+	}
+
+	var handler ItemHandler = gd.buffer
+	if !check.IfNil(args.DeliveryBuffer) {
+		gd.deliveryBuffer = args.DeliveryBuffer
+		handler = gd
+	}
+
+	argsItemsProducer := ArgsItemsProducer{
+		Marshalizer:      args.Marshalizer,
+		Hasher:           args.Hasher,
+		PubkeyConverter:  args.PubkeyConverter,
+		ShardCoordinator: args.ShardCoordinator,
+		Handler:          handler,
+		CleanTxLogs:      args.CleanTxLogs,
+	}
+	gd.ItemsProducer, err = NewItemsProducer(argsItemsProducer)
+	if err != nil {
+		return nil, err
+	}
+
+	if gd.isBuffered() {
+		for i := 0; i < gd.deliveryBuffer.Len(); i++ {
+			gd.pendingItems.Add()
+		}
+
+		var ctx context.Context
+		ctx, gd.cancelFunc = context.WithCancel(context.Background())
+		go gd.feedLoop(ctx)
+	}
+
+	RegisterOutportServer(gd.server, gd)
+	go gd.serve(args.Listener)
+
+	return gd, nil
+}
+
+func checkArgs(args ArgsGRPCDriver) error {
+	if args.Listener == nil {
+		return ErrNilListener
+	}
+	if args.BufferSize == 0 {
+		return ErrInvalidBufferSize
+	}
+	if args.MaxConsumers == 0 {
+		return ErrInvalidMaxConsumers
+	}
+
+	return nil
+}
+
+func (gd *grpcDriver) serve(listener net.Listener) {
+	log.Debug("gRPC driver is serving", "address", listener.Addr().String())
+
+	err := gd.server.Serve(listener)
+	if err != nil {
+		log.Error("gRPC driver stopped serving", "error", err.Error())
+	}
+}
+
+// Stream sends the buffered items to a consumer, starting from the requested point, and then the new items as they
+// are handed to the outport
+func (gd *grpcDriver) Stream(request *StreamRequest, stream Outport_StreamServer) error {
+	c, err := gd.connectConsumer(request.ConsumerID)
+	if err != nil {
+		return err
+	}
+	defer gd.disconnectConsumer(c)
+
+	offset, err := gd.startOffset(request, c)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("gRPC consumer connected", "consumer", request.ConsumerID, "offset", offset)
+
+	ctx := stream.Context()
+	for {
+		item, chanNewItem, errGet := gd.buffer.get(offset)
+		if errGet != nil {
+			return status.Error(codes.OutOfRange, errGet.Error())
+		}
+		if item == nil {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-chanNewItem:
+				continue
+			}
+		}
+
+		err = stream.Send(item)
+		if err != nil {
+			return err
+		}
+
+		gd.mutConsumers.Lock()
+		c.lastOffset = offset
+		if offset > gd.sentOffset {
+			gd.sentOffset = offset
+			select {
+			case gd.chanSent <- struct{}{}:
+			default:
+			}
+		}
+		gd.mutConsumers.Unlock()
+
+		offset++
+	}
+}
+
+func (gd *grpcDriver) isBuffered() bool {
+	return gd.deliveryBuffer != nil
+}
+
+// HandleItem appends the provided item, protobuf encoded, to the delivery buffer
+func (gd *grpcDriver) HandleItem(item *StreamItem) {
+	buff, err := item.Marshal()
+	if err != nil {
+		log.Warn("grpcDriver: can not marshal the item", "error", err.Error())
+		return
+	}
+
+	gd.pendingItems.Add()
+	err = gd.deliveryBuffer.Append(buff)
+	if err != nil {
+		gd.pendingItems.Done()
+	}
+	if err != nil && err != deliveryBuffer.ErrBufferFull {
+		log.Warn("grpcDriver: can not buffer the item", "error", err.Error())
+	}
+}
+
+func (gd *grpcDriver) feedLoop(ctx context.Context) {
+	for {
+		buff, err := gd.deliveryBuffer.Peek(ctx)
+		if ctx.Err() != nil || err == deliveryBuffer.ErrBufferClosed {
+			log.Debug("grpcDriver's go routine is stopping...")
+			return
+		}
+		if err != nil {
+			log.Warn("grpcDriver could not read the delivery buffer (will retry)", "error", err.Error())
+			gd.health.DeliveryFailed(err)
+			select {
+			case <-ctx.Done():
+			case <-time.After(bufferRetryInterval):
+			}
+			continue
+		}
+
+		item := &StreamItem{}
+		err = item.Unmarshal(buff)
+		if err != nil {
+			log.Error("grpcDriver: dropping a corrupted buffered item", "error", err.Error())
+		} else {
+			gd.buffer.add(item)
+			if !gd.waitSent(ctx, item.Offset) {
+				// the item was not received by any consumer, it stays in the delivery buffer
+				log.Debug("grpcDriver's go routine is stopping...")
+				return
+			}
+			gd.health.DeliverySucceeded()
+		}
+
+		err = gd.deliveryBuffer.Pop()
+		if err != nil {
+			log.Warn("grpcDriver could not remove a streamed item from the delivery buffer", "error", err.Error())
+		}
+		gd.pendingItems.Done()
+	}
+}
+
+// waitSent blocks until a consumer received the item with the provided offset. It returns false if the context is
+// done first
+func (gd *grpcDriver) waitSent(ctx context.Context, offset uint64) bool {
+	for {
+		gd.mutConsumers.Lock()
+		isSent := gd.sentOffset >= offset
+		gd.mutConsumers.Unlock()
+
+		if isSent {
+			return true
+		}
+
+		select {
+		case <-gd.chanSent:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+func (gd *grpcDriver) connectConsumer(consumerID string) (*consumer, error) {
+	gd.mutConsumers.Lock()
+	defer gd.mutConsumers.Unlock()
+
+	if gd.numActive >= gd.maxConsumers {
+		return nil, status.Error(codes.ResourceExhausted, ErrTooManyConsumers.Error())
+	}
+
+	c := &consumer{id: consumerID}
+	if len(consumerID) > 0 {
+		existing, found := gd.consumers[consumerID]
+		if found && existing.active {
+			return nil, status.Errorf(codes.AlreadyExists, "%s: %s", ErrConsumerAlreadyConnected.Error(), consumerID)
+		}
+		if found {
+			c = existing
+		}
+
+		gd.consumers[consumerID] = c
+	}
+
+	c.active = true
+	gd.numActive++
+
+	return c, nil
+}
+
+func (gd *grpcDriver) disconnectConsumer(c *consumer) {
+	gd.mutConsumers.Lock()
+	c.active = false
+	gd.numActive--
+	gd.mutConsumers.Unlock()
+
+	log.Debug("gRPC consumer disconnected", "consumer", c.id, "last offset", c.lastOffset)
+}
+
+// startOffset returns the offset of the first item to be sent: the one after the resume token, the one after the last
+// item sent to the consumer or the next item handed to the outport, in this order. With a delivery buffer, the next
+// item is the one after the last item sent to any consumer
+func (gd *grpcDriver) startOffset(request *StreamRequest, c *consumer) (uint64, error) {
+	if len(request.ResumeToken) > 0 {
+		sessionID, offset, err := decodeResumeToken(request.ResumeToken)
+		if err != nil {
+			return 0, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if string(sessionID) != string(gd.sessionID) {
+			return 0, status.Error(codes.FailedPrecondition, ErrResumeTokenFromOtherSession.Error())
+		}
+
+		return offset + 1, nil
+	}
+
+	gd.mutConsumers.Lock()
+	lastOffset := c.lastOffset
+	sentOffset := gd.sentOffset
+	gd.mutConsumers.Unlock()
+
+	if lastOffset > 0 {
+		return lastOffset + 1, nil
+	}
+	if gd.isBuffered() {
+		// the item waiting in the stream buffer was not received yet by any consumer
+		return sentOffset + 1, nil
+	}
+
+	return gd.buffer.next(), nil
+}
+
+// DriverStatus returns the health of the deliveries to the consumers
+func (gd *grpcDriver) DriverStatus() indexer.DriverStatus {
+	status := gd.health.Status(gd.pendingItems.Len())
+	if gd.isBuffered() {
+		status.DroppedItems = gd.deliveryBuffer.NumDroppedItems()
+	}
+
+	return status
+}
+
+// Close stops the gRPC server, closing the listener and all the streams. The items not yet streamed are kept only by a
+// write-ahead log
+func (gd *grpcDriver) Close() error {
+	gd.cancelFunc()
+	gd.server.Stop()
+
+	if gd.isBuffered() {
+		return gd.deliveryBuffer.Close()
+	}
+
+	return nil
+}
+
+// IsNilIndexer returns false as the gRPC driver is a real indexer implementation
+func (gd *grpcDriver) IsNilIndexer() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gd *grpcDriver) IsInterfaceNil() bool {
+	return gd == nil
+}
@@ -0,0 +1,512 @@
+package grpcStream
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/deliveryBuffer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const waitStream = time.Second
+
+func createMockArgs(t *testing.T) ArgsGRPCDriver {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	return ArgsGRPCDriver{
+		Listener:         listener,
+		Marshalizer:      &mock.MarshalizerMock{},
+		Hasher:           &mock.HasherMock{},
+		PubkeyConverter:  mock.NewPubkeyConverterMock(4),
+		ShardCoordinator: &mock.ShardCoordinatorMock{},
+		BufferSize:       10,
+		MaxConsumers:     2,
+	}
+}
+
+type streamClient struct {
+	conn   *grpc.ClientConn
+	stream Outport_StreamClient
+	cancel func()
+}
+
+func openStream(t *testing.T, address string, request *StreamRequest) *streamClient {
+	conn, err := grpc.Dial(address, grpc.WithInsecure())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := NewOutportClient(conn).Stream(ctx, request)
+	require.NoError(t, err)
+
+	return &streamClient{
+		conn:   conn,
+		stream: stream,
+		cancel: cancel,
+	}
+}
+
+func (sc *streamClient) receive(t *testing.T) *StreamItem {
+	chanItem := make(chan *StreamItem, 1)
+	chanErr := make(chan error, 1)
+	go func() {
+		item, err := sc.stream.Recv()
+		if err != nil {
+			chanErr <- err
+			return
+		}
+		chanItem <- item
+	}()
+
+	select {
+	case item := <-chanItem:
+		return item
+	case err := <-chanErr:
+		require.NoError(t, err)
+		return nil
+	case <-time.After(waitStream):
+		require.Fail(t, "timeout while waiting for the streamed item")
+		return nil
+	}
+}
+
+func (sc *streamClient) receiveError(t *testing.T) error {
+	_, err := sc.stream.Recv()
+	require.Error(t, err)
+
+	return err
+}
+
+func (sc *streamClient) close() {
+	sc.cancel()
+	_ = sc.conn.Close()
+}
+
+// waitConsumers waits for the stream handlers to register the expected number of connected consumers, as opening a
+// stream on the client side does not wait for the server
+func waitConsumers(t *testing.T, gd *grpcDriver, numActive uint32) {
+	deadline := time.Now().Add(waitStream)
+	for time.Now().Before(deadline) {
+		gd.mutConsumers.Lock()
+		currentNumActive := gd.numActive
+		gd.mutConsumers.Unlock()
+
+		if currentNumActive == numActive {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	require.Fail(t, "timeout while waiting for the consumers")
+}
+
+func saveRounds(gd *grpcDriver, indexes ...uint64) {
+	for _, index := range indexes {
+		gd.SaveRoundsInfo([]workItems.RoundInfo{{Index: index}})
+	}
+}
+
+func roundIndex(item *StreamItem) uint64 {
+	return item.GetRounds().RoundsInfo[0].Index
+}
+
+func TestNewGRPCDriver(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		argsFunc func() ArgsGRPCDriver
+		exError  error
+	}{
+		{
+			name: "NilListener",
+			argsFunc: func() ArgsGRPCDriver {
+				args := createMockArgs(t)
+				_ = args.Listener.Close()
+				args.Listener = nil
+				return args
+			},
+			exError: ErrNilListener,
+		},
+		{
+			name: "NilMarshalizer",
+			argsFunc: func() ArgsGRPCDriver {
+				args := createMockArgs(t)
+				args.Marshalizer = nil
+				return args
+			},
+			exError: ErrNilMarshalizer,
+		},
+		{
+			name: "NilHasher",
+			argsFunc: func() ArgsGRPCDriver {
+				args := createMockArgs(t)
+				args.Hasher = nil
+				return args
+			},
+			exError: ErrNilHasher,
+		},
+		{
+			name: "NilPubkeyConverter",
+			argsFunc: func() ArgsGRPCDriver {
+				args := createMockArgs(t)
+				args.PubkeyConverter = nil
+				return args
+			},
+			exError: ErrNilPubkeyConverter,
+		},
+		{
+			name: "NilShardCoordinator",
+			argsFunc: func() ArgsGRPCDriver {
+				args := createMockArgs(t)
+				args.ShardCoordinator = nil
+				return args
+			},
+			exError: ErrNilShardCoordinator,
+		},
+		{
+			name: "InvalidBufferSize",
+			argsFunc: func() ArgsGRPCDriver {
+				args := createMockArgs(t)
+				args.BufferSize = 0
+				return args
+			},
+			exError: ErrInvalidBufferSize,
+		},
+		{
+			name: "InvalidMaxConsumers",
+			argsFunc: func() ArgsGRPCDriver {
+				args := createMockArgs(t)
+				args.MaxConsumers = 0
+				return args
+			},
+			exError: ErrInvalidMaxConsumers,
+		},
+		{
+			name: "All arguments ok",
+			argsFunc: func() ArgsGRPCDriver {
+				return createMockArgs(t)
+			},
+			exError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := tt.argsFunc()
+			gd, err := NewGRPCDriver(args)
+			require.Equal(t, tt.exError, err)
+			require.Equal(t, tt.exError != nil, check.IfNil(gd))
+
+			if err == nil {
+				_ = gd.Close()
+			} else if args.Listener != nil {
+				_ = args.Listener.Close()
+			}
+		})
+	}
+}
+
+func TestGRPCDriver_SaveBlockShouldStreamTheBlockWithTransactionsAndEvents(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.CleanTxLogs = true
+	gd, _ := NewGRPCDriver(args)
+	defer func() {
+		_ = gd.Close()
+	}()
+
+	numCleans := 0
+	txLog := &transaction.Log{
+		Address: []byte("addr"),
+		Events:  []*transaction.Event{{Address: []byte("addr"), Identifier: []byte("transfer"), Topics: [][]byte{[]byte("topic")}}},
+	}
+	gd.SetTxLogsProcessor(&mock.TxLogsProcessorDatabaseStub{
+		GetLogFromCacheCalled: func(txHash []byte) (data.LogHandler, bool) {
+			return txLog, string(txHash) == "tx1"
+		},
+		CleanCalled: func() {
+			numCleans++
+		},
+	})
+
+	client := openStream(t, args.Listener.Addr().String(), &StreamRequest{})
+	defer client.close()
+	waitConsumers(t, gd, 1)
+
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte("tx1"), []byte("tx2")}, ReceiverShardID: 1}},
+	}
+	header := &block.Header{Nonce: 5, Round: 6, Epoch: 1, ShardID: 0, TimeStamp: 100, PrevHash: []byte("prev")}
+	txPool := map[string]data.TransactionHandler{
+		"tx1": &transaction.Transaction{Nonce: 1, Value: big.NewInt(10), SndAddr: []byte("sndr"), RcvAddr: []byte("rcvr")},
+		"tx2": &transaction.Transaction{Nonce: 2, SndAddr: []byte("sndr"), RcvAddr: []byte("rcvr")},
+	}
+	gd.SaveBlock(body, header, txPool, nil, []string{"notarized"}, []byte("hash"))
+	require.Equal(t, 1, numCleans)
+
+	item := client.receive(t)
+	require.Equal(t, uint64(1), item.Offset)
+	require.NotEmpty(t, item.ResumeToken)
+
+	streamBlock := item.GetBlock()
+	require.NotNil(t, streamBlock)
+	require.Equal(t, []byte("hash"), streamBlock.Hash)
+	require.Equal(t, []byte("prev"), streamBlock.PrevHash)
+	require.Equal(t, uint64(5), streamBlock.Nonce)
+	require.Equal(t, uint64(6), streamBlock.Round)
+	require.Equal(t, uint32(1), streamBlock.Epoch)
+	require.Equal(t, uint64(100), streamBlock.TimeStamp)
+	require.Equal(t, []string{"notarized"}, streamBlock.NotarizedBlocks)
+	require.False(t, streamBlock.Reverted)
+
+	require.Len(t, streamBlock.Transactions, 2)
+	require.Equal(t, []byte("tx1"), streamBlock.Transactions[0].Hash)
+	require.Equal(t, "10", streamBlock.Transactions[0].Value)
+	require.Equal(t, "736e6472", streamBlock.Transactions[0].Sender)
+	require.Equal(t, uint32(1), streamBlock.Transactions[0].ReceiverShard)
+	require.Equal(t, []byte("tx2"), streamBlock.Transactions[1].Hash)
+	require.Equal(t, "0", streamBlock.Transactions[1].Value)
+
+	require.Len(t, streamBlock.Events, 1)
+	require.Equal(t, []byte("tx1"), streamBlock.Events[0].TxHash)
+	require.Equal(t, []byte("transfer"), streamBlock.Events[0].Identifier)
+	require.Equal(t, [][]byte{[]byte("topic")}, streamBlock.Events[0].Topics)
+}
+
+func TestGRPCDriver_ShouldStreamTheOtherItems(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.ShardCoordinator = &mock.ShardCoordinatorMock{SelfID: 1}
+	gd, _ := NewGRPCDriver(args)
+	defer func() {
+		_ = gd.Close()
+	}()
+
+	client := openStream(t, args.Listener.Addr().String(), &StreamRequest{})
+	defer client.close()
+	waitConsumers(t, gd, 1)
+
+	gd.RevertIndexedBlock(&block.Header{Nonce: 7}, nil)
+	item := client.receive(t)
+	require.True(t, item.GetBlock().Reverted)
+	require.Equal(t, uint64(7), item.GetBlock().Nonce)
+
+	gd.SaveValidatorsPubKeys(map[uint32][][]byte{1: {[]byte("pk1")}, 0: {[]byte("pk0")}}, 3)
+	item = client.receive(t)
+	require.Equal(t, &Validators{
+		Epoch: 3,
+		Shards: []*ShardValidators{
+			{ShardID: 0, PublicKeys: [][]byte{[]byte("pk0")}},
+			{ShardID: 1, PublicKeys: [][]byte{[]byte("pk1")}},
+		},
+	}, item.GetValidators())
+
+	gd.SaveValidatorsRating("0_3", []workItems.ValidatorRatingInfo{{PublicKey: "pk0", Rating: 50}})
+	item = client.receive(t)
+	require.Equal(t, &Ratings{
+		IndexID:          "0_3",
+		ValidatorsRating: []*ValidatorRating{{PublicKey: "pk0", Rating: 50}},
+	}, item.GetRatings())
+
+	acc, _ := state.NewUserAccount([]byte("addr"))
+	_ = acc.AddToBalance(big.NewInt(15))
+	gd.SaveAccounts([]state.UserAccountHandler{acc})
+	item = client.receive(t)
+	require.Equal(t, &Accounts{
+		ShardID:  1,
+		Accounts: []*Account{{Address: "61646472", Nonce: 0, Balance: "15"}},
+	}, item.GetAccounts())
+	require.Equal(t, uint64(4), item.Offset)
+}
+
+func TestGRPCDriver_ConsumerShouldResumeFromItsLastOffset(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	gd, _ := NewGRPCDriver(args)
+	defer func() {
+		_ = gd.Close()
+	}()
+
+	client := openStream(t, args.Listener.Addr().String(), &StreamRequest{ConsumerID: "consumer"})
+	waitConsumers(t, gd, 1)
+
+	saveRounds(gd, 1, 2)
+	require.Equal(t, uint64(1), roundIndex(client.receive(t)))
+	require.Equal(t, uint64(2), roundIndex(client.receive(t)))
+	client.close()
+	waitConsumers(t, gd, 0)
+
+	saveRounds(gd, 3, 4)
+
+	client = openStream(t, args.Listener.Addr().String(), &StreamRequest{ConsumerID: "consumer"})
+	defer client.close()
+	require.Equal(t, uint64(3), roundIndex(client.receive(t)))
+	require.Equal(t, uint64(4), roundIndex(client.receive(t)))
+}
+
+func TestGRPCDriver_BufferedItemsShouldWaitForAConsumer(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.DeliveryBuffer, _ = deliveryBuffer.NewWriteAheadLog(deliveryBuffer.ArgsWriteAheadLog{
+		Persister:      memorydb.New(),
+		MaxSizeInBytes: 1 << 20,
+	})
+	gd, _ := NewGRPCDriver(args)
+	defer func() {
+		_ = gd.Close()
+	}()
+
+	saveRounds(gd, 1, 2)
+	time.Sleep(time.Millisecond * 10)
+	require.Equal(t, int64(2), gd.DriverStatus().PendingItems)
+
+	client := openStream(t, args.Listener.Addr().String(), &StreamRequest{})
+	defer client.close()
+	require.Equal(t, uint64(1), roundIndex(client.receive(t)))
+	require.Equal(t, uint64(2), roundIndex(client.receive(t)))
+}
+
+func TestGRPCDriver_BufferedItemsShouldBeStreamedAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	persister := memorydb.New()
+	args := createMockArgs(t)
+	args.DeliveryBuffer, _ = deliveryBuffer.NewWriteAheadLog(deliveryBuffer.ArgsWriteAheadLog{
+		Persister:      persister,
+		MaxSizeInBytes: 1 << 20,
+	})
+	gd, _ := NewGRPCDriver(args)
+	saveRounds(gd, 1, 2)
+	_ = gd.Close()
+
+	args = createMockArgs(t)
+	args.DeliveryBuffer, _ = deliveryBuffer.NewWriteAheadLog(deliveryBuffer.ArgsWriteAheadLog{
+		Persister:      persister,
+		MaxSizeInBytes: 1 << 20,
+	})
+	gd, _ = NewGRPCDriver(args)
+	defer func() {
+		_ = gd.Close()
+	}()
+	require.Equal(t, int64(2), gd.DriverStatus().PendingItems)
+
+	client := openStream(t, args.Listener.Addr().String(), &StreamRequest{})
+	defer client.close()
+	require.Equal(t, uint64(1), roundIndex(client.receive(t)))
+	require.Equal(t, uint64(2), roundIndex(client.receive(t)))
+}
+
+func TestGRPCDriver_ConsumerShouldResumeFromTheResumeToken(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	gd, _ := NewGRPCDriver(args)
+	defer func() {
+		_ = gd.Close()
+	}()
+
+	client := openStream(t, args.Listener.Addr().String(), &StreamRequest{})
+	waitConsumers(t, gd, 1)
+
+	saveRounds(gd, 1, 2, 3)
+	item := client.receive(t)
+	require.Equal(t, uint64(1), roundIndex(item))
+	client.close()
+
+	client = openStream(t, args.Listener.Addr().String(), &StreamRequest{ResumeToken: item.ResumeToken})
+	defer client.close()
+	item = client.receive(t)
+	require.Equal(t, uint64(2), roundIndex(item))
+	require.Equal(t, uint64(2), item.Offset)
+	require.Equal(t, uint64(3), roundIndex(client.receive(t)))
+}
+
+func TestGRPCDriver_StreamErrors(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.BufferSize = 2
+	gd, _ := NewGRPCDriver(args)
+	defer func() {
+		_ = gd.Close()
+	}()
+	address := args.Listener.Addr().String()
+
+	t.Run("invalid resume token", func(t *testing.T) {
+		client := openStream(t, address, &StreamRequest{ResumeToken: "invalid"})
+		defer client.close()
+
+		require.Equal(t, codes.InvalidArgument, status.Code(client.receiveError(t)))
+	})
+	t.Run("resume token from another session", func(t *testing.T) {
+		client := openStream(t, address, &StreamRequest{ResumeToken: encodeResumeToken([]byte("session0"), 1)})
+		defer client.close()
+
+		require.Equal(t, codes.FailedPrecondition, status.Code(client.receiveError(t)))
+	})
+	t.Run("offset no longer buffered", func(t *testing.T) {
+		saveRounds(gd, 1, 2, 3)
+		client := openStream(t, address, &StreamRequest{ResumeToken: encodeResumeToken(gd.sessionID, 0)})
+		defer client.close()
+
+		require.Equal(t, codes.OutOfRange, status.Code(client.receiveError(t)))
+	})
+}
+
+func TestGRPCDriver_ConnectConsumerErrors(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	gd, _ := NewGRPCDriver(args)
+	defer func() {
+		_ = gd.Close()
+	}()
+
+	_, err := gd.connectConsumer("consumer")
+	require.NoError(t, err)
+
+	_, err = gd.connectConsumer("consumer")
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = gd.connectConsumer("")
+	require.NoError(t, err)
+
+	_, err = gd.connectConsumer("other")
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestGRPCDriver_CloseShouldEndTheStreams(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	gd, _ := NewGRPCDriver(args)
+
+	client := openStream(t, args.Listener.Addr().String(), &StreamRequest{})
+	defer client.close()
+	waitConsumers(t, gd, 1)
+
+	err := gd.Close()
+	require.NoError(t, err)
+
+	err = client.receiveError(t)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
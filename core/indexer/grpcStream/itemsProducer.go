@@ -0,0 +1,336 @@
+package grpcStream
+
+import (
+	"encoding/hex"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ItemHandler defines the component receiving the items created by an ItemsProducer
+type ItemHandler interface {
+	HandleItem(item *StreamItem)
+	IsInterfaceNil() bool
+}
+
+// ArgsItemsProducer is the DTO used to create a new instance of ItemsProducer
+type ArgsItemsProducer struct {
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	PubkeyConverter  core.PubkeyConverter
+	ShardCoordinator sharding.Coordinator
+	Handler          ItemHandler
+	// CleanTxLogs should be set when the driver owning the producer is the last consumer of the cached transaction logs
+	CleanTxLogs bool
+}
+
+// ItemsProducer converts the data handed to the outport in the protobuf items defined in stream.proto and passes them,
+// in order, to its item handler. It is shared by the drivers delivering these items: the gRPC driver and the external
+// outport plugins
+type ItemsProducer struct {
+	*indexer.NilIndexer
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	pubkeyConverter  core.PubkeyConverter
+	shardCoordinator sharding.Coordinator
+	handler          ItemHandler
+	cleanTxLogs      bool
+	mutTxLogsProc    sync.RWMutex
+	txLogsProc       process.TransactionLogProcessorDatabase
+}
+
+// NewItemsProducer creates a new ItemsProducer instance
+func NewItemsProducer(args ArgsItemsProducer) (*ItemsProducer, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if check.IfNil(args.Handler) {
+		return nil, ErrNilItemHandler
+	}
+
+	return &ItemsProducer{
+		NilIndexer:       indexer.NewNilIndexer(),
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		pubkeyConverter:  args.PubkeyConverter,
+		shardCoordinator: args.ShardCoordinator,
+		handler:          args.Handler,
+		cleanTxLogs:      args.CleanTxLogs,
+	}, nil
+}
+
+// SetTxLogsProcessor sets the logs processor used to fetch the events generated by the transactions
+func (ip *ItemsProducer) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	ip.mutTxLogsProc.Lock()
+	ip.txLogsProc = txLogsProc
+	ip.mutTxLogsProc.Unlock()
+}
+
+// SaveBlock creates the item holding the provided block together with its transactions and log events
+func (ip *ItemsProducer) SaveBlock(
+	body data.BodyHandler,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	_ []uint64,
+	notarizedHeadersHashes []string,
+	headerHash []byte,
+) {
+	ip.mutTxLogsProc.RLock()
+	txLogsProc := ip.txLogsProc
+	ip.mutTxLogsProc.RUnlock()
+
+	defer func() {
+		if ip.cleanTxLogs && !check.IfNil(txLogsProc) {
+			txLogsProc.Clean()
+		}
+	}()
+
+	if check.IfNil(header) {
+		return
+	}
+
+	streamBlock := createBlock(header, headerHash)
+	streamBlock.NotarizedBlocks = notarizedHeadersHashes
+	ip.addTransactionsAndEvents(streamBlock, body, txPool, txLogsProc)
+
+	ip.handler.HandleItem(&StreamItem{Payload: &StreamItem_Block{Block: streamBlock}})
+}
+
+// RevertIndexedBlock creates the item holding the provided block marked as reverted
+func (ip *ItemsProducer) RevertIndexedBlock(header data.HeaderHandler, _ data.BodyHandler) {
+	if check.IfNil(header) {
+		return
+	}
+
+	headerHash, err := core.CalculateHash(ip.marshalizer, ip.hasher, header)
+	if err != nil {
+		log.Warn("ItemsProducer.RevertIndexedBlock: can not compute the header hash", "error", err.Error())
+		return
+	}
+
+	streamBlock := createBlock(header, headerHash)
+	streamBlock.Reverted = true
+
+	ip.handler.HandleItem(&StreamItem{Payload: &StreamItem_Block{Block: streamBlock}})
+}
+
+func createBlock(header data.HeaderHandler, headerHash []byte) *Block {
+	return &Block{
+		Hash:      headerHash,
+		PrevHash:  header.GetPrevHash(),
+		Nonce:     header.GetNonce(),
+		Round:     header.GetRound(),
+		Epoch:     header.GetEpoch(),
+		ShardID:   header.GetShardID(),
+		TimeStamp: header.GetTimeStamp(),
+	}
+}
+
+// addTransactionsAndEvents adds the transactions and their log events in the order they appear in the miniblocks
+func (ip *ItemsProducer) addTransactionsAndEvents(
+	streamBlock *Block,
+	body data.BodyHandler,
+	txPool map[string]data.TransactionHandler,
+	txLogsProc process.TransactionLogProcessorDatabase,
+) {
+	blockBody, ok := body.(*block.Body)
+	if !ok || len(txPool) == 0 {
+		return
+	}
+
+	streamBlock.Transactions = make([]*Transaction, 0, len(txPool))
+	for _, miniBlock := range blockBody.MiniBlocks {
+		miniBlockHash, err := core.CalculateHash(ip.marshalizer, ip.hasher, miniBlock)
+		if err != nil {
+			log.Warn("ItemsProducer: can not compute the miniblock hash", "error", err.Error())
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			tx, found := txPool[string(txHash)]
+			if !found || check.IfNil(tx) {
+				continue
+			}
+
+			streamBlock.Transactions = append(streamBlock.Transactions, &Transaction{
+				Hash:          txHash,
+				MiniBlockHash: miniBlockHash,
+				Type:          miniBlock.Type.String(),
+				Nonce:         tx.GetNonce(),
+				Value:         valueToString(tx),
+				Sender:        ip.encodeAddress(tx.GetSndAddr()),
+				Receiver:      ip.encodeAddress(tx.GetRcvAddr()),
+				SenderShard:   miniBlock.SenderShardID,
+				ReceiverShard: miniBlock.ReceiverShardID,
+				GasPrice:      tx.GetGasPrice(),
+				GasLimit:      tx.GetGasLimit(),
+				Data:          tx.GetData(),
+			})
+			streamBlock.Events = append(streamBlock.Events, ip.createEvents(txHash, txLogsProc)...)
+		}
+	}
+}
+
+func (ip *ItemsProducer) createEvents(txHash []byte, txLogsProc process.TransactionLogProcessorDatabase) []*Event {
+	if check.IfNil(txLogsProc) {
+		return nil
+	}
+
+	txLog, found := txLogsProc.GetLogFromCache(txHash)
+	if !found || check.IfNil(txLog) {
+		return nil
+	}
+
+	events := make([]*Event, 0, len(txLog.GetLogEvents()))
+	for _, event := range txLog.GetLogEvents() {
+		if check.IfNil(event) {
+			continue
+		}
+
+		events = append(events, &Event{
+			TxHash:     txHash,
+			Address:    ip.encodeAddress(event.GetAddress()),
+			Identifier: event.GetIdentifier(),
+			Topics:     event.GetTopics(),
+			Data:       event.GetData(),
+		})
+	}
+
+	return events
+}
+
+func valueToString(tx data.TransactionHandler) string {
+	value := tx.GetValue()
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+// SaveRoundsInfo creates the item holding the provided rounds information
+func (ip *ItemsProducer) SaveRoundsInfo(roundsInfos []workItems.RoundInfo) {
+	if len(roundsInfos) == 0 {
+		return
+	}
+
+	rounds := &Rounds{
+		RoundsInfo: make([]*RoundInfo, 0, len(roundsInfos)),
+	}
+	for _, roundInfo := range roundsInfos {
+		rounds.RoundsInfo = append(rounds.RoundsInfo, &RoundInfo{
+			Index:            roundInfo.Index,
+			SignersIndexes:   roundInfo.SignersIndexes,
+			BlockWasProposed: roundInfo.BlockWasProposed,
+			ShardID:          roundInfo.ShardId,
+			TimeStamp:        uint64(roundInfo.Timestamp),
+		})
+	}
+
+	ip.handler.HandleItem(&StreamItem{Payload: &StreamItem_Rounds{Rounds: rounds}})
+}
+
+// SaveValidatorsPubKeys creates the item holding the validators public keys of each shard for the provided epoch
+func (ip *ItemsProducer) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) {
+	if len(validatorsPubKeys) == 0 {
+		return
+	}
+
+	validators := &Validators{
+		Epoch:  epoch,
+		Shards: make([]*ShardValidators, 0, len(validatorsPubKeys)),
+	}
+	for shardID, pubKeys := range validatorsPubKeys {
+		validators.Shards = append(validators.Shards, &ShardValidators{
+			ShardID:    shardID,
+			PublicKeys: pubKeys,
+		})
+	}
+	sort.Slice(validators.Shards, func(i, j int) bool {
+		return validators.Shards[i].ShardID < validators.Shards[j].ShardID
+	})
+
+	ip.handler.HandleItem(&StreamItem{Payload: &StreamItem_Validators{Validators: validators}})
+}
+
+// SaveValidatorsRating creates the item holding the provided validators ratings
+func (ip *ItemsProducer) SaveValidatorsRating(indexID string, infoRating []workItems.ValidatorRatingInfo) {
+	if len(infoRating) == 0 {
+		return
+	}
+
+	ratings := &Ratings{
+		IndexID:          indexID,
+		ValidatorsRating: make([]*ValidatorRating, 0, len(infoRating)),
+	}
+	for _, info := range infoRating {
+		ratings.ValidatorsRating = append(ratings.ValidatorsRating, &ValidatorRating{
+			PublicKey: info.PublicKey,
+			Rating:    info.Rating,
+		})
+	}
+
+	ip.handler.HandleItem(&StreamItem{Payload: &StreamItem_Ratings{Ratings: ratings}})
+}
+
+// SaveAccounts creates the item holding the state of the provided accounts
+func (ip *ItemsProducer) SaveAccounts(accounts []state.UserAccountHandler) {
+	streamAccounts := &Accounts{
+		ShardID:  ip.shardCoordinator.SelfId(),
+		Accounts: make([]*Account, 0, len(accounts)),
+	}
+	for _, account := range accounts {
+		if check.IfNil(account) {
+			continue
+		}
+
+		balance := "0"
+		if account.GetBalance() != nil {
+			balance = account.GetBalance().String()
+		}
+
+		streamAccounts.Accounts = append(streamAccounts.Accounts, &Account{
+			Address: ip.encodeAddress(account.AddressBytes()),
+			Nonce:   account.GetNonce(),
+			Balance: balance,
+		})
+	}
+	if len(streamAccounts.Accounts) == 0 {
+		return
+	}
+
+	ip.handler.HandleItem(&StreamItem{Payload: &StreamItem_Accounts{Accounts: streamAccounts}})
+}
+
+func (ip *ItemsProducer) encodeAddress(address []byte) string {
+	if len(address) != ip.pubkeyConverter.Len() {
+		return hex.EncodeToString(address)
+	}
+
+	return ip.pubkeyConverter.Encode(address)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ip *ItemsProducer) IsInterfaceNil() bool {
+	return ip == nil
+}
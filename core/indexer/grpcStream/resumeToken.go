@@ -0,0 +1,31 @@
+package grpcStream
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+)
+
+const sessionIDLength = 8
+
+// encodeResumeToken creates the token identifying the item with the provided offset, sent in the provided session
+func encodeResumeToken(sessionID []byte, offset uint64) string {
+	buff := make([]byte, sessionIDLength+8)
+	copy(buff, sessionID)
+	binary.BigEndian.PutUint64(buff[sessionIDLength:], offset)
+
+	return hex.EncodeToString(buff)
+}
+
+// decodeResumeToken returns the session ID and the offset of the item identified by the provided token
+func decodeResumeToken(token string) ([]byte, uint64, error) {
+	buff, err := hex.DecodeString(token)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %s", ErrInvalidResumeToken, err.Error())
+	}
+	if len(buff) != sessionIDLength+8 {
+		return nil, 0, fmt.Errorf("%w: invalid length", ErrInvalidResumeToken)
+	}
+
+	return buff[:sessionIDLength], binary.BigEndian.Uint64(buff[sessionIDLength:]), nil
+}
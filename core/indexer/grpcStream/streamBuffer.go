@@ -0,0 +1,81 @@
+package grpcStream
+
+import (
+	"sync"
+)
+
+// streamBuffer keeps the last items handed to the outport so the consumers can read them at their own pace and resume
+// after a disconnection. The offsets start from 1 and the oldest items are evicted when the buffer is full
+type streamBuffer struct {
+	mut         sync.RWMutex
+	sessionID   []byte
+	items       []*StreamItem
+	nextOffset  uint64
+	chanNewItem chan struct{}
+}
+
+func newStreamBuffer(size uint32, sessionID []byte) *streamBuffer {
+	return &streamBuffer{
+		sessionID:   sessionID,
+		items:       make([]*StreamItem, size),
+		nextOffset:  1,
+		chanNewItem: make(chan struct{}),
+	}
+}
+
+// add assigns the next offset to the provided item, stores it and wakes up the waiting consumers
+func (sb *streamBuffer) add(item *StreamItem) {
+	sb.mut.Lock()
+	defer sb.mut.Unlock()
+
+	item.Offset = sb.nextOffset
+	item.ResumeToken = encodeResumeToken(sb.sessionID, item.Offset)
+	sb.items[item.Offset%uint64(len(sb.items))] = item
+	sb.nextOffset++
+
+	close(sb.chanNewItem)
+	sb.chanNewItem = make(chan struct{})
+}
+
+// HandleItem adds the provided item to the buffer
+func (sb *streamBuffer) HandleItem(item *StreamItem) {
+	sb.add(item)
+}
+
+// get returns the item with the provided offset. If the item was not added yet, it returns a channel closed when the
+// next item is added
+func (sb *streamBuffer) get(offset uint64) (*StreamItem, <-chan struct{}, error) {
+	sb.mut.RLock()
+	defer sb.mut.RUnlock()
+
+	if offset < sb.oldestOffset() {
+		return nil, nil, ErrOffsetNotBuffered
+	}
+	if offset >= sb.nextOffset {
+		return nil, sb.chanNewItem, nil
+	}
+
+	return sb.items[offset%uint64(len(sb.items))], nil, nil
+}
+
+func (sb *streamBuffer) oldestOffset() uint64 {
+	size := uint64(len(sb.items))
+	if sb.nextOffset <= size {
+		return 1
+	}
+
+	return sb.nextOffset - size
+}
+
+// next returns the offset the next added item will have
+func (sb *streamBuffer) next() uint64 {
+	sb.mut.RLock()
+	defer sb.mut.RUnlock()
+
+	return sb.nextOffset
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sb *streamBuffer) IsInterfaceNil() bool {
+	return sb == nil
+}
@@ -0,0 +1,65 @@
+package grpcStream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamBuffer_AddAndGet(t *testing.T) {
+	t.Parallel()
+
+	sessionID := []byte("session0")
+	sb := newStreamBuffer(2, sessionID)
+	require.Equal(t, uint64(1), sb.next())
+
+	item, chanNewItem, err := sb.get(1)
+	require.NoError(t, err)
+	require.Nil(t, item)
+	require.NotNil(t, chanNewItem)
+
+	sb.add(&StreamItem{})
+	select {
+	case <-chanNewItem:
+	default:
+		require.Fail(t, "the waiting consumers should have been woken up")
+	}
+
+	item, _, err = sb.get(1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), item.Offset)
+	require.Equal(t, encodeResumeToken(sessionID, 1), item.ResumeToken)
+
+	sb.add(&StreamItem{})
+	sb.add(&StreamItem{})
+	require.Equal(t, uint64(4), sb.next())
+
+	_, _, err = sb.get(1)
+	require.Equal(t, ErrOffsetNotBuffered, err)
+
+	item, _, err = sb.get(2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), item.Offset)
+
+	item, _, err = sb.get(3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), item.Offset)
+}
+
+func TestResumeToken_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	sessionID := []byte("session0")
+	token := encodeResumeToken(sessionID, 1234)
+
+	decodedSessionID, offset, err := decodeResumeToken(token)
+	require.NoError(t, err)
+	require.Equal(t, sessionID, decodedSessionID)
+	require.Equal(t, uint64(1234), offset)
+
+	_, _, err = decodeResumeToken("not hex")
+	require.Error(t, err)
+
+	_, _, err = decodeResumeToken("aabb")
+	require.Error(t, err)
+}
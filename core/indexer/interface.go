@@ -2,6 +2,7 @@ package indexer
 
 import (
 	"bytes"
+	"context"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
@@ -37,11 +38,42 @@ type Indexer interface {
 	IsNilIndexer() bool
 }
 
+// DeliveryBuffer defines the queue holding the serialized items of an outport driver until they are delivered. The
+// items are consumed in order by a single go routine: Peek returns the oldest item and Pop removes it once delivered.
+// A buffer which drops the items instead of waiting when it is full reports them through NumDroppedItems
+type DeliveryBuffer interface {
+	Append(item []byte) error
+	Peek(ctx context.Context) ([]byte, error)
+	Pop() error
+	Len() int
+	NumDroppedItems() uint64
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// Flusher defines the indexers that deliver the data asynchronously and can wait until all the queued data is delivered
+type Flusher interface {
+	Flush(ctx context.Context) error
+}
+
+// DriverStatusHandler defines the outport drivers that deliver the data to an external endpoint and report the health
+// of their deliveries
+type DriverStatusHandler interface {
+	DriverStatus() DriverStatus
+}
+
+// DriversStatusesHandler defines the component reporting the health of all the outport drivers
+type DriversStatusesHandler interface {
+	DriversStatuses() []DriverStatus
+	IsInterfaceNil() bool
+}
+
 // DispatcherHandler defines the interface for the dispatcher that will manage when items are saved in elasticsearch database
 type DispatcherHandler interface {
 	StartIndexData()
 	Close() error
 	Add(item workItems.WorkItemHandler)
+	Flush(ctx context.Context) error
 	IsInterfaceNil() bool
 }
 
@@ -66,6 +98,7 @@ type DatabaseClientHandler interface {
 	DoRequest(req *esapi.IndexRequest) error
 	DoBulkRequest(buff *bytes.Buffer, index string) error
 	DoBulkRemove(index string, hashes []string) error
+	DoDeleteByQuery(index string, query objectsMap) error
 	DoMultiGet(query objectsMap, index string) (objectsMap, error)
 
 	CheckAndCreateIndex(index string) error
@@ -0,0 +1,36 @@
+package kafka
+
+import "errors"
+
+// ErrNilProducer signals that a nil producer has been provided
+var ErrNilProducer = errors.New("nil producer")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrUnknownPartitioning signals that an unknown partitioning mode has been provided
+var ErrUnknownPartitioning = errors.New("unknown partitioning")
+
+// ErrNilBuffer signals that a nil delivery buffer has been provided
+var ErrNilBuffer = errors.New("nil delivery buffer")
+
+// ErrInvalidRetryInterval signals that an invalid retry interval has been provided
+var ErrInvalidRetryInterval = errors.New("invalid retry interval")
+
+// ErrNoBrokers signals that no Kafka broker address has been provided
+var ErrNoBrokers = errors.New("no brokers provided")
+
+// ErrInvalidWriteTimeout signals that an invalid write timeout has been provided
+var ErrInvalidWriteTimeout = errors.New("invalid write timeout")
+
+// ErrProducerClosed signals that a publish was attempted after the producer has been closed
+var ErrProducerClosed = errors.New("producer is closed")
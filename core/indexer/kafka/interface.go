@@ -0,0 +1,11 @@
+package kafka
+
+import "context"
+
+// Producer defines a component able to publish messages on a Kafka topic. Publish must return nil only after all the
+// provided messages have been acknowledged by the brokers
+type Producer interface {
+	Publish(ctx context.Context, topic string, messages []*Message) error
+	Close() error
+	IsInterfaceNil() bool
+}
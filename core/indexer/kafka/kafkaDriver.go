@@ -0,0 +1,534 @@
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/deliveryBuffer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("core/indexer/kafka")
+
+const driverName = "kafka"
+
+// ArgsKafkaDriver is the DTO used to create a new instance of kafkaDriver
+type ArgsKafkaDriver struct {
+	Producer         Producer
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	PubkeyConverter  core.PubkeyConverter
+	ShardCoordinator sharding.Coordinator
+	TopicPrefix      string
+	PartitionBy      string
+	Buffer           indexer.DeliveryBuffer
+	RetryInterval    time.Duration
+	// MaxRetryInterval caps the exponentially growing wait between the retries of a failed publish
+	MaxRetryInterval time.Duration
+	// LagThreshold is the number of buffered publishes above which the driver is reported as lagging
+	LagThreshold uint32
+	// CleanTxLogs should be set when the Kafka driver is the last consumer of the cached transaction logs
+	CleanTxLogs bool
+}
+
+// publishItem is serialized in the delivery buffer, so its fields are exported
+type publishItem struct {
+	Topic    string
+	Messages []*Message
+}
+
+// kafkaDriver publishes the blocks, transactions, logs, accounts and validators information, JSON encoded, on Kafka
+// topics. The messages are created while the block is saved, appended to the delivery buffer and published in order on
+// a separate go routine. A publish is retried, with an exponentially growing wait, until the brokers acknowledge it and
+// only then it is removed from the buffer, so every message is delivered at least once, across restarts if the buffer is a write-ahead log. When the
+// in-memory buffer is full the indexing pipeline waits for the brokers to catch up, while a full write-ahead log drops
+// the new messages and reports the gap
+type kafkaDriver struct {
+	*indexer.NilIndexer
+	producer         Producer
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	pubkeyConverter  core.PubkeyConverter
+	shardCoordinator sharding.Coordinator
+	topicPrefix      string
+	partitionBy      string
+	retryBackOff     *indexer.ExponentialBackOff
+	health           *indexer.DriverHealth
+	cleanTxLogs      bool
+	mutTxLogsProc    sync.RWMutex
+	txLogsProc       process.TransactionLogProcessorDatabase
+	buffer           indexer.DeliveryBuffer
+	pendingItems     indexer.PendingItems
+	cancelFunc       func()
+}
+
+// NewKafkaDriver creates a new Kafka driver and starts publishing
+func NewKafkaDriver(args ArgsKafkaDriver) (*kafkaDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	kd := &kafkaDriver{
+		NilIndexer:       indexer.NewNilIndexer(),
+		producer:         args.Producer,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		pubkeyConverter:  args.PubkeyConverter,
+		shardCoordinator: args.ShardCoordinator,
+		topicPrefix:      args.TopicPrefix,
+		partitionBy:      args.PartitionBy,
+		retryBackOff:     indexer.NewExponentialBackOff(args.RetryInterval, args.MaxRetryInterval),
+		health:           indexer.NewDriverHealth(driverName, args.LagThreshold),
+		cleanTxLogs:      args.CleanTxLogs,
+		buffer:           args.Buffer,
+	}
+	for i := 0; i < args.Buffer.Len(); i++ {
+		kd.pendingItems.Add()
+	}
+
+	var ctx context.Context
+	ctx, kd.cancelFunc = context.WithCancel(context.Background())
+	go kd.publishLoop(ctx)
+
+	return kd, nil
+}
+
+func checkArgs(args ArgsKafkaDriver) error {
+	if check.IfNil(args.Producer) {
+		return ErrNilProducer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+	if check.IfNil(args.PubkeyConverter) {
+		return ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if args.PartitionBy != PartitionByShard && args.PartitionBy != PartitionByAddress {
+		return fmt.Errorf("%w: %s", ErrUnknownPartitioning, args.PartitionBy)
+	}
+	if check.IfNil(args.Buffer) {
+		return ErrNilBuffer
+	}
+	if args.RetryInterval <= 0 || args.MaxRetryInterval < args.RetryInterval {
+		return ErrInvalidRetryInterval
+	}
+
+	return nil
+}
+
+// SetTxLogsProcessor sets the logs processor used to fetch the events generated by the transactions
+func (kd *kafkaDriver) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	kd.mutTxLogsProc.Lock()
+	kd.txLogsProc = txLogsProc
+	kd.mutTxLogsProc.Unlock()
+}
+
+// SaveBlock publishes the provided block together with its transactions and log events
+func (kd *kafkaDriver) SaveBlock(
+	body data.BodyHandler,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	_ []uint64,
+	notarizedHeadersHashes []string,
+	headerHash []byte,
+) {
+	kd.mutTxLogsProc.RLock()
+	txLogsProc := kd.txLogsProc
+	kd.mutTxLogsProc.RUnlock()
+
+	defer func() {
+		if kd.cleanTxLogs && !check.IfNil(txLogsProc) {
+			txLogsProc.Clean()
+		}
+	}()
+
+	if check.IfNil(header) {
+		return
+	}
+
+	blockMessage := createBlockMessage(header, headerHash)
+	blockMessage.NotarizedBlocks = notarizedHeadersHashes
+	kd.publish(TopicBlocks, kd.createMessage(kd.shardKey(header.GetShardID()), blockMessage)...)
+
+	kd.publish(TopicTransactions, kd.createTransactionsMessages(body, header, txPool, headerHash)...)
+	kd.publish(TopicLogs, kd.createLogsMessages(header, txPool, txLogsProc, headerHash)...)
+}
+
+// RevertIndexedBlock publishes the provided block marked as reverted
+func (kd *kafkaDriver) RevertIndexedBlock(header data.HeaderHandler, _ data.BodyHandler) {
+	if check.IfNil(header) {
+		return
+	}
+
+	headerHash, err := core.CalculateHash(kd.marshalizer, kd.hasher, header)
+	if err != nil {
+		log.Warn("kafkaDriver.RevertIndexedBlock: can not compute the header hash", "error", err.Error())
+		return
+	}
+
+	blockMessage := createBlockMessage(header, headerHash)
+	blockMessage.Reverted = true
+	kd.publish(TopicBlocks, kd.createMessage(kd.shardKey(header.GetShardID()), blockMessage)...)
+}
+
+func createBlockMessage(header data.HeaderHandler, headerHash []byte) *BlockMessage {
+	return &BlockMessage{
+		Hash:      hex.EncodeToString(headerHash),
+		PrevHash:  hex.EncodeToString(header.GetPrevHash()),
+		Nonce:     header.GetNonce(),
+		Round:     header.GetRound(),
+		Epoch:     header.GetEpoch(),
+		ShardID:   header.GetShardID(),
+		NumTxs:    header.GetTxCount(),
+		Timestamp: header.GetTimeStamp(),
+	}
+}
+
+func (kd *kafkaDriver) createTransactionsMessages(
+	body data.BodyHandler,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	headerHash []byte,
+) []*Message {
+	blockBody, ok := body.(*block.Body)
+	if !ok || len(txPool) == 0 {
+		return nil
+	}
+
+	messages := make([]*Message, 0, len(txPool))
+	for _, miniBlock := range blockBody.MiniBlocks {
+		miniBlockHash, err := core.CalculateHash(kd.marshalizer, kd.hasher, miniBlock)
+		if err != nil {
+			log.Warn("kafkaDriver: can not compute the miniblock hash", "error", err.Error())
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			tx, found := txPool[string(txHash)]
+			if !found || check.IfNil(tx) {
+				continue
+			}
+
+			txMessage := &TransactionMessage{
+				Hash:          hex.EncodeToString(txHash),
+				BlockHash:     hex.EncodeToString(headerHash),
+				MiniBlockHash: hex.EncodeToString(miniBlockHash),
+				Type:          miniBlock.Type.String(),
+				Nonce:         tx.GetNonce(),
+				Value:         valueToString(tx),
+				Sender:        kd.encodeAddress(tx.GetSndAddr()),
+				Receiver:      kd.encodeAddress(tx.GetRcvAddr()),
+				SenderShard:   miniBlock.SenderShardID,
+				ReceiverShard: miniBlock.ReceiverShardID,
+				GasPrice:      tx.GetGasPrice(),
+				GasLimit:      tx.GetGasLimit(),
+				Data:          tx.GetData(),
+				Epoch:         header.GetEpoch(),
+				Timestamp:     header.GetTimeStamp(),
+			}
+
+			key := kd.addressKey(tx.GetSndAddr(), header.GetShardID())
+			messages = append(messages, kd.createMessage(key, txMessage)...)
+		}
+	}
+
+	return messages
+}
+
+func valueToString(tx data.TransactionHandler) string {
+	value := tx.GetValue()
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+func (kd *kafkaDriver) createLogsMessages(
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	txLogsProc process.TransactionLogProcessorDatabase,
+	headerHash []byte,
+) []*Message {
+	if check.IfNil(txLogsProc) {
+		return nil
+	}
+
+	messages := make([]*Message, 0)
+	for txHashStr := range txPool {
+		txLog, found := txLogsProc.GetLogFromCache([]byte(txHashStr))
+		if !found || check.IfNil(txLog) {
+			continue
+		}
+
+		for _, event := range txLog.GetLogEvents() {
+			if check.IfNil(event) {
+				continue
+			}
+
+			logMessage := &LogMessage{
+				TxHash:     hex.EncodeToString([]byte(txHashStr)),
+				BlockHash:  hex.EncodeToString(headerHash),
+				ShardID:    header.GetShardID(),
+				Address:    kd.encodeAddress(event.GetAddress()),
+				Identifier: string(event.GetIdentifier()),
+				Topics:     event.GetTopics(),
+				Data:       event.GetData(),
+			}
+
+			key := kd.addressKey(event.GetAddress(), header.GetShardID())
+			messages = append(messages, kd.createMessage(key, logMessage)...)
+		}
+	}
+
+	return messages
+}
+
+// SaveAccounts publishes the state of the provided accounts
+func (kd *kafkaDriver) SaveAccounts(accounts []state.UserAccountHandler) {
+	selfShardID := kd.shardCoordinator.SelfId()
+	messages := make([]*Message, 0, len(accounts))
+	for _, account := range accounts {
+		if check.IfNil(account) {
+			continue
+		}
+
+		balance := "0"
+		if account.GetBalance() != nil {
+			balance = account.GetBalance().String()
+		}
+
+		accountMessage := &AccountMessage{
+			Address: kd.encodeAddress(account.AddressBytes()),
+			Nonce:   account.GetNonce(),
+			Balance: balance,
+			ShardID: selfShardID,
+		}
+
+		key := kd.addressKey(account.AddressBytes(), selfShardID)
+		messages = append(messages, kd.createMessage(key, accountMessage)...)
+	}
+
+	kd.publish(TopicAccounts, messages...)
+}
+
+// SaveValidatorsPubKeys publishes the validators public keys of each shard for the provided epoch
+func (kd *kafkaDriver) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) {
+	messages := make([]*Message, 0, len(validatorsPubKeys))
+	for shardID, pubKeys := range validatorsPubKeys {
+		validatorsMessage := &ValidatorsMessage{
+			Epoch:      epoch,
+			ShardID:    shardID,
+			PublicKeys: make([]string, 0, len(pubKeys)),
+		}
+		for _, pubKey := range pubKeys {
+			validatorsMessage.PublicKeys = append(validatorsMessage.PublicKeys, hex.EncodeToString(pubKey))
+		}
+
+		messages = append(messages, kd.createMessage(kd.shardKey(shardID), validatorsMessage)...)
+	}
+
+	kd.publish(TopicValidators, messages...)
+}
+
+// SaveValidatorsRating publishes the provided validators ratings
+func (kd *kafkaDriver) SaveValidatorsRating(indexID string, infoRating []workItems.ValidatorRatingInfo) {
+	messages := make([]*Message, 0, len(infoRating))
+	for _, info := range infoRating {
+		ratingMessage := &RatingMessage{
+			IndexID:   indexID,
+			PublicKey: info.PublicKey,
+			Rating:    info.Rating,
+		}
+
+		key := []byte(indexID)
+		if kd.partitionBy == PartitionByAddress {
+			key = []byte(info.PublicKey)
+		}
+		messages = append(messages, kd.createMessage(key, ratingMessage)...)
+	}
+
+	kd.publish(TopicRatings, messages...)
+}
+
+func (kd *kafkaDriver) shardKey(shardID uint32) []byte {
+	return []byte(strconv.FormatUint(uint64(shardID), 10))
+}
+
+func (kd *kafkaDriver) addressKey(address []byte, shardID uint32) []byte {
+	if kd.partitionBy == PartitionByAddress && len(address) > 0 {
+		return []byte(kd.encodeAddress(address))
+	}
+
+	return kd.shardKey(shardID)
+}
+
+func (kd *kafkaDriver) encodeAddress(address []byte) string {
+	if len(address) != kd.pubkeyConverter.Len() {
+		return hex.EncodeToString(address)
+	}
+
+	return kd.pubkeyConverter.Encode(address)
+}
+
+func (kd *kafkaDriver) createMessage(key []byte, value interface{}) []*Message {
+	buff, err := json.Marshal(value)
+	if err != nil {
+		log.Warn("kafkaDriver: can not marshal message", "error", err.Error())
+		return nil
+	}
+
+	return []*Message{{
+		Key:   key,
+		Value: buff,
+	}}
+}
+
+func (kd *kafkaDriver) publish(topic string, messages ...*Message) {
+	if len(messages) == 0 {
+		return
+	}
+
+	item := &publishItem{
+		Topic:    kd.topicPrefix + topic,
+		Messages: messages,
+	}
+	buff := &bytes.Buffer{}
+	err := gob.NewEncoder(buff).Encode(item)
+	if err != nil {
+		log.Warn("kafkaDriver: can not serialize the messages", "topic", item.Topic, "error", err.Error())
+		return
+	}
+
+	kd.pendingItems.Add()
+	err = kd.buffer.Append(buff.Bytes())
+	if err != nil {
+		kd.pendingItems.Done()
+	}
+	if err != nil && err != deliveryBuffer.ErrBufferFull {
+		log.Warn("kafkaDriver: can not buffer the messages", "topic", item.Topic, "error", err.Error())
+	}
+}
+
+func (kd *kafkaDriver) publishLoop(ctx context.Context) {
+	for {
+		buff, err := kd.buffer.Peek(ctx)
+		if ctx.Err() != nil || err == deliveryBuffer.ErrBufferClosed {
+			log.Debug("kafkaDriver's go routine is stopping...")
+			return
+		}
+		if err != nil {
+			log.Warn("kafkaDriver could not read the delivery buffer (will retry)", "error", err.Error())
+			kd.waitRetry(ctx)
+			continue
+		}
+
+		item := &publishItem{}
+		err = gob.NewDecoder(bytes.NewReader(buff)).Decode(item)
+		if err != nil {
+			log.Error("kafkaDriver: dropping a corrupted buffered item", "error", err.Error())
+		} else {
+			kd.publishItem(ctx, item)
+		}
+		if ctx.Err() != nil {
+			// the item was not published, it stays in the buffer
+			log.Debug("kafkaDriver's go routine is stopping...")
+			return
+		}
+
+		err = kd.buffer.Pop()
+		if err != nil {
+			log.Warn("kafkaDriver could not remove a published item from the delivery buffer", "error", err.Error())
+		}
+		kd.pendingItems.Done()
+	}
+}
+
+func (kd *kafkaDriver) publishItem(ctx context.Context, item *publishItem) {
+	for {
+		err := kd.producer.Publish(ctx, item.Topic, item.Messages)
+		if err == nil {
+			kd.retryBackOff.Reset()
+			kd.health.DeliverySucceeded()
+			return
+		}
+
+		log.Warn("kafkaDriver could not publish messages (will retry)",
+			"topic", item.Topic, "num messages", len(item.Messages), "error", err.Error())
+
+		kd.health.DeliveryFailed(err)
+		kd.waitRetry(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+func (kd *kafkaDriver) waitRetry(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(kd.retryBackOff.NextInterval()):
+	}
+}
+
+// DriverStatus returns the health of the publishes on the Kafka brokers
+func (kd *kafkaDriver) DriverStatus() indexer.DriverStatus {
+	status := kd.health.Status(kd.pendingItems.Len())
+	status.DroppedItems = kd.buffer.NumDroppedItems()
+
+	return status
+}
+
+// Flush blocks until all the queued messages are published or the context is done
+func (kd *kafkaDriver) Flush(ctx context.Context) error {
+	return kd.pendingItems.Wait(ctx)
+}
+
+// Close stops publishing and closes the delivery buffer and the producer. The messages not yet published are kept only
+// by a write-ahead log
+func (kd *kafkaDriver) Close() error {
+	kd.cancelFunc()
+
+	errBuffer := kd.buffer.Close()
+	errProducer := kd.producer.Close()
+	if errBuffer != nil {
+		return errBuffer
+	}
+
+	return errProducer
+}
+
+// IsNilIndexer returns false as the Kafka driver is a real indexer implementation
+func (kd *kafkaDriver) IsNilIndexer() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (kd *kafkaDriver) IsInterfaceNil() bool {
+	return kd == nil
+}
@@ -0,0 +1,474 @@
+package kafka
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/deliveryBuffer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const waitPublish = time.Second
+
+type publishedMessages struct {
+	mut      sync.Mutex
+	messages map[string][]*Message
+	chanDone chan struct{}
+}
+
+func newPublishedMessages() *publishedMessages {
+	return &publishedMessages{
+		messages: make(map[string][]*Message),
+		chanDone: make(chan struct{}, 100),
+	}
+}
+
+func (pm *publishedMessages) publish(_ context.Context, topic string, messages []*Message) error {
+	pm.mut.Lock()
+	pm.messages[topic] = append(pm.messages[topic], messages...)
+	pm.mut.Unlock()
+
+	pm.chanDone <- struct{}{}
+
+	return nil
+}
+
+func (pm *publishedMessages) waitPublishes(t *testing.T, numPublishes int) {
+	for i := 0; i < numPublishes; i++ {
+		select {
+		case <-pm.chanDone:
+		case <-time.After(waitPublish):
+			require.Fail(t, "timeout while waiting for the publishes")
+		}
+	}
+}
+
+func (pm *publishedMessages) get(topic string) []*Message {
+	pm.mut.Lock()
+	defer pm.mut.Unlock()
+
+	return pm.messages[topic]
+}
+
+func createMockArgs() ArgsKafkaDriver {
+	buffer, _ := deliveryBuffer.NewMemoryBuffer(10)
+
+	return ArgsKafkaDriver{
+		Producer:         &producerStub{},
+		Marshalizer:      &mock.MarshalizerMock{},
+		Hasher:           &mock.HasherMock{},
+		PubkeyConverter:  mock.NewPubkeyConverterMock(4),
+		ShardCoordinator: &mock.ShardCoordinatorMock{},
+		TopicPrefix:      "elrond.",
+		PartitionBy:      PartitionByShard,
+		Buffer:           buffer,
+		RetryInterval:    time.Millisecond,
+		MaxRetryInterval: time.Millisecond * 4,
+	}
+}
+
+func TestNewKafkaDriver_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Producer = nil
+	kd, err := NewKafkaDriver(args)
+	assert.True(t, check.IfNil(kd))
+	assert.Equal(t, ErrNilProducer, err)
+
+	args = createMockArgs()
+	args.Marshalizer = nil
+	kd, err = NewKafkaDriver(args)
+	assert.True(t, check.IfNil(kd))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	args = createMockArgs()
+	args.Hasher = nil
+	kd, err = NewKafkaDriver(args)
+	assert.True(t, check.IfNil(kd))
+	assert.Equal(t, ErrNilHasher, err)
+
+	args = createMockArgs()
+	args.PubkeyConverter = nil
+	kd, err = NewKafkaDriver(args)
+	assert.True(t, check.IfNil(kd))
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+
+	args = createMockArgs()
+	args.ShardCoordinator = nil
+	kd, err = NewKafkaDriver(args)
+	assert.True(t, check.IfNil(kd))
+	assert.Equal(t, ErrNilShardCoordinator, err)
+
+	args = createMockArgs()
+	args.PartitionBy = "round"
+	kd, err = NewKafkaDriver(args)
+	assert.True(t, check.IfNil(kd))
+	assert.True(t, errors.Is(err, ErrUnknownPartitioning))
+
+	args = createMockArgs()
+	args.Buffer = nil
+	kd, err = NewKafkaDriver(args)
+	assert.True(t, check.IfNil(kd))
+	assert.Equal(t, ErrNilBuffer, err)
+
+	args = createMockArgs()
+	args.RetryInterval = 0
+	kd, err = NewKafkaDriver(args)
+	assert.True(t, check.IfNil(kd))
+	assert.Equal(t, ErrInvalidRetryInterval, err)
+
+	args = createMockArgs()
+	args.MaxRetryInterval = args.RetryInterval / 2
+	kd, err = NewKafkaDriver(args)
+	assert.True(t, check.IfNil(kd))
+	assert.Equal(t, ErrInvalidRetryInterval, err)
+
+	kd, err = NewKafkaDriver(createMockArgs())
+	assert.False(t, check.IfNil(kd))
+	assert.Nil(t, err)
+	assert.False(t, kd.IsNilIndexer())
+	_ = kd.Close()
+}
+
+func TestKafkaDriver_SaveBlockShouldPublishBlockTransactionsAndLogs(t *testing.T) {
+	t.Parallel()
+
+	published := newPublishedMessages()
+	args := createMockArgs()
+	args.Producer = &producerStub{PublishCalled: published.publish}
+	args.PartitionBy = PartitionByAddress
+	args.CleanTxLogs = true
+	kd, _ := NewKafkaDriver(args)
+	defer func() {
+		_ = kd.Close()
+	}()
+
+	sender := []byte("sndr")
+	receiver := []byte("rcvr")
+	cleanCalled := false
+	kd.SetTxLogsProcessor(&mock.TxLogsProcessorDatabaseStub{
+		GetLogFromCacheCalled: func(txHash []byte) (data.LogHandler, bool) {
+			txLog := &transaction.Log{
+				Events: []*transaction.Event{{Address: receiver, Identifier: []byte("transfer")}},
+			}
+			return txLog, string(txHash) == "tx1"
+		},
+		CleanCalled: func() {
+			cleanCalled = true
+		},
+	})
+
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("tx1"), []byte("missing")}, SenderShardID: 0, ReceiverShardID: 1},
+		},
+	}
+	txPool := map[string]data.TransactionHandler{
+		"tx1": &transaction.Transaction{Nonce: 7, Value: big.NewInt(10), SndAddr: sender, RcvAddr: receiver},
+	}
+	kd.SaveBlock(body, &block.Header{Nonce: 3, ShardID: 0}, txPool, nil, []string{"notarized"}, []byte("hash"))
+	assert.True(t, cleanCalled)
+
+	published.waitPublishes(t, 3)
+
+	blocks := published.get("elrond." + TopicBlocks)
+	require.Equal(t, 1, len(blocks))
+	assert.Equal(t, []byte("0"), blocks[0].Key)
+	blockMessage := &BlockMessage{}
+	_ = json.Unmarshal(blocks[0].Value, blockMessage)
+	assert.Equal(t, uint64(3), blockMessage.Nonce)
+	assert.Equal(t, hex.EncodeToString([]byte("hash")), blockMessage.Hash)
+	assert.Equal(t, []string{"notarized"}, blockMessage.NotarizedBlocks)
+	assert.False(t, blockMessage.Reverted)
+
+	txs := published.get("elrond." + TopicTransactions)
+	require.Equal(t, 1, len(txs))
+	assert.Equal(t, []byte(hex.EncodeToString(sender)), txs[0].Key)
+	txMessage := &TransactionMessage{}
+	_ = json.Unmarshal(txs[0].Value, txMessage)
+	assert.Equal(t, hex.EncodeToString([]byte("tx1")), txMessage.Hash)
+	assert.Equal(t, "10", txMessage.Value)
+	assert.Equal(t, uint64(7), txMessage.Nonce)
+	assert.Equal(t, uint32(1), txMessage.ReceiverShard)
+	assert.Equal(t, hex.EncodeToString(receiver), txMessage.Receiver)
+
+	logs := published.get("elrond." + TopicLogs)
+	require.Equal(t, 1, len(logs))
+	assert.Equal(t, []byte(hex.EncodeToString(receiver)), logs[0].Key)
+	logMessage := &LogMessage{}
+	_ = json.Unmarshal(logs[0].Value, logMessage)
+	assert.Equal(t, "transfer", logMessage.Identifier)
+	assert.Equal(t, hex.EncodeToString([]byte("tx1")), logMessage.TxHash)
+}
+
+func TestKafkaDriver_RevertIndexedBlockShouldPublishRevertedBlock(t *testing.T) {
+	t.Parallel()
+
+	published := newPublishedMessages()
+	args := createMockArgs()
+	args.Producer = &producerStub{PublishCalled: published.publish}
+	kd, _ := NewKafkaDriver(args)
+	defer func() {
+		_ = kd.Close()
+	}()
+
+	kd.RevertIndexedBlock(&block.Header{Nonce: 4, ShardID: 1}, &block.Body{})
+	published.waitPublishes(t, 1)
+
+	blocks := published.get("elrond." + TopicBlocks)
+	require.Equal(t, 1, len(blocks))
+	assert.Equal(t, []byte("1"), blocks[0].Key)
+	blockMessage := &BlockMessage{}
+	_ = json.Unmarshal(blocks[0].Value, blockMessage)
+	assert.Equal(t, uint64(4), blockMessage.Nonce)
+	assert.True(t, blockMessage.Reverted)
+}
+
+func TestKafkaDriver_SaveAccountsAndValidatorsShouldPublish(t *testing.T) {
+	t.Parallel()
+
+	published := newPublishedMessages()
+	args := createMockArgs()
+	args.Producer = &producerStub{PublishCalled: published.publish}
+	args.ShardCoordinator = &mock.ShardCoordinatorMock{SelfID: 2}
+	kd, _ := NewKafkaDriver(args)
+	defer func() {
+		_ = kd.Close()
+	}()
+
+	account, _ := state.NewUserAccount([]byte("addr"))
+	_ = account.AddToBalance(big.NewInt(100))
+	kd.SaveAccounts([]state.UserAccountHandler{account})
+	kd.SaveValidatorsPubKeys(map[uint32][][]byte{0: {[]byte("pk1"), []byte("pk2")}}, 5)
+	kd.SaveValidatorsRating("0_5", []workItems.ValidatorRatingInfo{{PublicKey: "pk1", Rating: 50}})
+	published.waitPublishes(t, 3)
+
+	accounts := published.get("elrond." + TopicAccounts)
+	require.Equal(t, 1, len(accounts))
+	assert.Equal(t, []byte("2"), accounts[0].Key)
+	accountMessage := &AccountMessage{}
+	_ = json.Unmarshal(accounts[0].Value, accountMessage)
+	assert.Equal(t, AccountMessage{Address: hex.EncodeToString([]byte("addr")), Balance: "100", ShardID: 2}, *accountMessage)
+
+	validators := published.get("elrond." + TopicValidators)
+	require.Equal(t, 1, len(validators))
+	validatorsMessage := &ValidatorsMessage{}
+	_ = json.Unmarshal(validators[0].Value, validatorsMessage)
+	assert.Equal(t, uint32(5), validatorsMessage.Epoch)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("pk1")), hex.EncodeToString([]byte("pk2"))}, validatorsMessage.PublicKeys)
+
+	ratings := published.get("elrond." + TopicRatings)
+	require.Equal(t, 1, len(ratings))
+	assert.Equal(t, []byte("0_5"), ratings[0].Key)
+}
+
+func TestKafkaDriver_FailedPublishShouldBeRetried(t *testing.T) {
+	t.Parallel()
+
+	published := newPublishedMessages()
+	numFailures := 0
+	args := createMockArgs()
+	args.Producer = &producerStub{
+		PublishCalled: func(ctx context.Context, topic string, messages []*Message) error {
+			if numFailures < 3 {
+				numFailures++
+				return errors.New("broker not available")
+			}
+
+			return published.publish(ctx, topic, messages)
+		},
+	}
+	kd, _ := NewKafkaDriver(args)
+	defer func() {
+		_ = kd.Close()
+	}()
+
+	kd.RevertIndexedBlock(&block.Header{Nonce: 1}, nil)
+	kd.RevertIndexedBlock(&block.Header{Nonce: 2}, nil)
+	published.waitPublishes(t, 2)
+
+	blocks := published.get("elrond." + TopicBlocks)
+	require.Equal(t, 2, len(blocks))
+	for i, expectedNonce := range []uint64{1, 2} {
+		blockMessage := &BlockMessage{}
+		_ = json.Unmarshal(blocks[i].Value, blockMessage)
+		assert.Equal(t, expectedNonce, blockMessage.Nonce)
+	}
+}
+
+func TestKafkaDriver_UndeliveredItemsShouldBePublishedAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	persister := memorydb.New()
+	args := createMockArgs()
+	args.Buffer, _ = deliveryBuffer.NewWriteAheadLog(deliveryBuffer.ArgsWriteAheadLog{
+		Persister:      persister,
+		MaxSizeInBytes: 1024,
+	})
+	args.Producer = &producerStub{
+		PublishCalled: func(_ context.Context, _ string, _ []*Message) error {
+			return errors.New("broker not available")
+		},
+	}
+	kd, _ := NewKafkaDriver(args)
+
+	kd.RevertIndexedBlock(&block.Header{Nonce: 1}, nil)
+	kd.RevertIndexedBlock(&block.Header{Nonce: 2}, nil)
+	_ = kd.Close()
+
+	published := newPublishedMessages()
+	args = createMockArgs()
+	args.Buffer, _ = deliveryBuffer.NewWriteAheadLog(deliveryBuffer.ArgsWriteAheadLog{
+		Persister:      persister,
+		MaxSizeInBytes: 1024,
+	})
+	args.Producer = &producerStub{
+		PublishCalled: published.publish,
+	}
+	kd, _ = NewKafkaDriver(args)
+	defer func() {
+		_ = kd.Close()
+	}()
+	published.waitPublishes(t, 2)
+
+	blocks := published.get("elrond." + TopicBlocks)
+	require.Equal(t, 2, len(blocks))
+	for i, expectedNonce := range []uint64{1, 2} {
+		blockMessage := &BlockMessage{}
+		_ = json.Unmarshal(blocks[i].Value, blockMessage)
+		assert.Equal(t, expectedNonce, blockMessage.Nonce)
+	}
+}
+
+func TestKafkaDriver_CloseShouldCloseTheProducer(t *testing.T) {
+	t.Parallel()
+
+	closeCalled := false
+	args := createMockArgs()
+	args.Producer = &producerStub{
+		CloseCalled: func() error {
+			closeCalled = true
+			return nil
+		},
+	}
+	kd, _ := NewKafkaDriver(args)
+
+	err := kd.Close()
+	assert.Nil(t, err)
+	assert.True(t, closeCalled)
+}
+
+func TestKafkaDriver_FlushShouldWaitForThePublishes(t *testing.T) {
+	t.Parallel()
+
+	published := newPublishedMessages()
+	numFailures := 0
+	args := createMockArgs()
+	args.Producer = &producerStub{
+		PublishCalled: func(ctx context.Context, topic string, messages []*Message) error {
+			if numFailures < 3 {
+				numFailures++
+				return errors.New("broker not available")
+			}
+
+			return published.publish(ctx, topic, messages)
+		},
+	}
+	kd, _ := NewKafkaDriver(args)
+	defer func() {
+		_ = kd.Close()
+	}()
+
+	kd.RevertIndexedBlock(&block.Header{Nonce: 1}, nil)
+	kd.RevertIndexedBlock(&block.Header{Nonce: 2}, nil)
+
+	err := kd.Flush(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(published.get("elrond."+TopicBlocks)))
+}
+
+func TestKafkaDriver_FlushShouldReturnWhenTheContextIsDone(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Producer = &producerStub{
+		PublishCalled: func(_ context.Context, _ string, _ []*Message) error {
+			return errors.New("broker not available")
+		},
+	}
+	kd, _ := NewKafkaDriver(args)
+	defer func() {
+		_ = kd.Close()
+	}()
+
+	kd.RevertIndexedBlock(&block.Header{Nonce: 1}, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	err := kd.Flush(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestKafkaDriver_DriverStatusShouldReportTheFailedPublishes(t *testing.T) {
+	t.Parallel()
+
+	brokerAvailable := int32(0)
+	expectedErr := errors.New("broker not available")
+	args := createMockArgs()
+	args.Producer = &producerStub{
+		PublishCalled: func(_ context.Context, _ string, _ []*Message) error {
+			if atomic.LoadInt32(&brokerAvailable) == 0 {
+				return expectedErr
+			}
+
+			return nil
+		},
+	}
+	kd, _ := NewKafkaDriver(args)
+	defer func() {
+		_ = kd.Close()
+	}()
+
+	status := kd.DriverStatus()
+	assert.Equal(t, driverName, status.Name)
+	assert.Equal(t, indexer.DriverStateConnected, status.State)
+
+	kd.RevertIndexedBlock(&block.Header{Nonce: 1}, nil)
+	for kd.DriverStatus().ConsecutiveFailures < 2 {
+		time.Sleep(time.Millisecond)
+	}
+
+	status = kd.DriverStatus()
+	assert.Equal(t, indexer.DriverStateBuffering, status.State)
+	assert.Equal(t, int64(1), status.PendingItems)
+	assert.Equal(t, expectedErr.Error(), status.LastError)
+
+	atomic.StoreInt32(&brokerAvailable, 1)
+	err := kd.Flush(context.Background())
+	require.Nil(t, err)
+
+	status = kd.DriverStatus()
+	assert.Equal(t, indexer.DriverStateConnected, status.State)
+	assert.Equal(t, int64(0), status.PendingItems)
+	assert.Equal(t, uint32(0), status.ConsecutiveFailures)
+	assert.Empty(t, status.LastError)
+	assert.True(t, status.LastDeliveryTimestamp > 0)
+}
@@ -0,0 +1,118 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	kafkaGo "github.com/segmentio/kafka-go"
+)
+
+const batchTimeout = 10 * time.Millisecond
+
+// ArgsKafkaProducer is the DTO used to create a new instance of kafkaProducer
+type ArgsKafkaProducer struct {
+	Brokers      []string
+	WriteTimeout time.Duration
+}
+
+// kafkaProducer publishes the messages on the Kafka brokers. The messages are spread on the topic partitions by the
+// hash of their keys and a publish succeeds only when all the in-sync replicas acknowledged it
+type kafkaProducer struct {
+	brokers      []string
+	writeTimeout time.Duration
+	mutWriters   sync.Mutex
+	writers      map[string]*kafkaGo.Writer
+	closed       bool
+}
+
+// NewKafkaProducer creates a new Kafka producer. The connections to the brokers are opened on the first publish
+func NewKafkaProducer(args ArgsKafkaProducer) (*kafkaProducer, error) {
+	if len(args.Brokers) == 0 {
+		return nil, ErrNoBrokers
+	}
+	if args.WriteTimeout <= 0 {
+		return nil, ErrInvalidWriteTimeout
+	}
+
+	return &kafkaProducer{
+		brokers:      args.Brokers,
+		writeTimeout: args.WriteTimeout,
+		writers:      make(map[string]*kafkaGo.Writer),
+	}, nil
+}
+
+// Publish writes the provided messages on the topic and waits for the brokers acknowledgement
+func (kp *kafkaProducer) Publish(ctx context.Context, topic string, messages []*Message) error {
+	writer, err := kp.getWriter(topic)
+	if err != nil {
+		return err
+	}
+
+	kafkaMessages := make([]kafkaGo.Message, 0, len(messages))
+	for _, message := range messages {
+		kafkaMessages = append(kafkaMessages, kafkaGo.Message{
+			Key:   message.Key,
+			Value: message.Value,
+		})
+	}
+
+	err = writer.WriteMessages(ctx, kafkaMessages...)
+	if err != nil {
+		return fmt.Errorf("%w while publishing on topic %s", err, topic)
+	}
+
+	return nil
+}
+
+func (kp *kafkaProducer) getWriter(topic string) (*kafkaGo.Writer, error) {
+	kp.mutWriters.Lock()
+	defer kp.mutWriters.Unlock()
+
+	if kp.closed {
+		return nil, ErrProducerClosed
+	}
+
+	writer, found := kp.writers[topic]
+	if found {
+		return writer, nil
+	}
+
+	writer = &kafkaGo.Writer{
+		Addr:         kafkaGo.TCP(kp.brokers...),
+		Topic:        topic,
+		Balancer:     &kafkaGo.Hash{},
+		RequiredAcks: kafkaGo.RequireAll,
+		BatchTimeout: batchTimeout,
+		ReadTimeout:  kp.writeTimeout,
+		WriteTimeout: kp.writeTimeout,
+	}
+	kp.writers[topic] = writer
+
+	return writer, nil
+}
+
+// Close closes the connections to the brokers
+func (kp *kafkaProducer) Close() error {
+	kp.mutWriters.Lock()
+	defer kp.mutWriters.Unlock()
+
+	kp.closed = true
+
+	var lastErr error
+	for topic, writer := range kp.writers {
+		err := writer.Close()
+		if err != nil {
+			log.Warn("kafkaProducer.Close", "topic", topic, "error", err.Error())
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (kp *kafkaProducer) IsInterfaceNil() bool {
+	return kp == nil
+}
@@ -0,0 +1,49 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsKafkaProducer() ArgsKafkaProducer {
+	return ArgsKafkaProducer{
+		Brokers:      []string{"localhost:9092"},
+		WriteTimeout: time.Second,
+	}
+}
+
+func TestNewKafkaProducer(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsKafkaProducer()
+	args.Brokers = nil
+	kp, err := NewKafkaProducer(args)
+	assert.True(t, check.IfNil(kp))
+	assert.Equal(t, ErrNoBrokers, err)
+
+	args = createMockArgsKafkaProducer()
+	args.WriteTimeout = 0
+	kp, err = NewKafkaProducer(args)
+	assert.True(t, check.IfNil(kp))
+	assert.Equal(t, ErrInvalidWriteTimeout, err)
+
+	kp, err = NewKafkaProducer(createMockArgsKafkaProducer())
+	assert.False(t, check.IfNil(kp))
+	assert.Nil(t, err)
+}
+
+func TestKafkaProducer_PublishAfterCloseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	kp, _ := NewKafkaProducer(createMockArgsKafkaProducer())
+	_, _ = kp.getWriter("topic")
+	err := kp.Close()
+	assert.Nil(t, err)
+
+	err = kp.Publish(context.Background(), "topic", []*Message{{Value: []byte("value")}})
+	assert.Equal(t, ErrProducerClosed, err)
+}
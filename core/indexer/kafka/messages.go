@@ -0,0 +1,96 @@
+package kafka
+
+const (
+	// TopicBlocks is the topic on which the committed and the reverted blocks are published
+	TopicBlocks = "blocks"
+	// TopicTransactions is the topic on which the transactions, rewards and smart contract results are published
+	TopicTransactions = "transactions"
+	// TopicLogs is the topic on which the log events generated by the transactions are published
+	TopicLogs = "logs"
+	// TopicAccounts is the topic on which the modified accounts are published
+	TopicAccounts = "accounts"
+	// TopicValidators is the topic on which the validators public keys of each shard are published at epoch start
+	TopicValidators = "validators"
+	// TopicRatings is the topic on which the validators ratings are published
+	TopicRatings = "ratings"
+)
+
+const (
+	// PartitionByShard will key all the messages by the shard they belong to
+	PartitionByShard = "shard"
+	// PartitionByAddress will key the transactions, logs and accounts messages by address and the rest by shard, so
+	// all the messages of an address land on the same partition
+	PartitionByAddress = "address"
+)
+
+// Message is a record published on a Kafka topic. The key selects the partition
+type Message struct {
+	Key   []byte
+	Value []byte
+}
+
+// BlockMessage holds the main information about a committed or a reverted block
+type BlockMessage struct {
+	Hash            string   `json:"hash"`
+	PrevHash        string   `json:"prevHash"`
+	Nonce           uint64   `json:"nonce"`
+	Round           uint64   `json:"round"`
+	Epoch           uint32   `json:"epoch"`
+	ShardID         uint32   `json:"shardID"`
+	NumTxs          uint32   `json:"numTxs"`
+	Timestamp       uint64   `json:"timestamp"`
+	NotarizedBlocks []string `json:"notarizedBlocks,omitempty"`
+	Reverted        bool     `json:"reverted,omitempty"`
+}
+
+// TransactionMessage holds a transaction, reward or smart contract result included in a committed block
+type TransactionMessage struct {
+	Hash          string `json:"hash"`
+	BlockHash     string `json:"blockHash"`
+	MiniBlockHash string `json:"miniBlockHash"`
+	Type          string `json:"type"`
+	Nonce         uint64 `json:"nonce"`
+	Value         string `json:"value"`
+	Sender        string `json:"sender"`
+	Receiver      string `json:"receiver"`
+	SenderShard   uint32 `json:"senderShard"`
+	ReceiverShard uint32 `json:"receiverShard"`
+	GasPrice      uint64 `json:"gasPrice"`
+	GasLimit      uint64 `json:"gasLimit"`
+	Data          []byte `json:"data,omitempty"`
+	Epoch         uint32 `json:"epoch"`
+	Timestamp     uint64 `json:"timestamp"`
+}
+
+// LogMessage holds a log event generated by a transaction included in a committed block
+type LogMessage struct {
+	TxHash     string   `json:"txHash"`
+	BlockHash  string   `json:"blockHash"`
+	ShardID    uint32   `json:"shardID"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+}
+
+// AccountMessage holds the state of an account modified in a committed block
+type AccountMessage struct {
+	Address string `json:"address"`
+	Nonce   uint64 `json:"nonce"`
+	Balance string `json:"balance"`
+	ShardID uint32 `json:"shardID"`
+}
+
+// ValidatorsMessage holds the validators public keys of a shard for an epoch
+type ValidatorsMessage struct {
+	Epoch      uint32   `json:"epoch"`
+	ShardID    uint32   `json:"shardID"`
+	PublicKeys []string `json:"publicKeys"`
+}
+
+// RatingMessage holds the rating of a validator
+type RatingMessage struct {
+	IndexID   string  `json:"indexID"`
+	PublicKey string  `json:"publicKey"`
+	Rating    float32 `json:"rating"`
+}
@@ -0,0 +1,32 @@
+package kafka
+
+import "context"
+
+// producerStub can not be moved inside the mock package as it generates cyclic imports
+type producerStub struct {
+	PublishCalled func(ctx context.Context, topic string, messages []*Message) error
+	CloseCalled   func() error
+}
+
+// Publish -
+func (stub *producerStub) Publish(ctx context.Context, topic string, messages []*Message) error {
+	if stub.PublishCalled != nil {
+		return stub.PublishCalled(ctx, topic, messages)
+	}
+
+	return nil
+}
+
+// Close -
+func (stub *producerStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *producerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,15 @@
+package lightTopics
+
+import "errors"
+
+// ErrNilTopicBroadcaster signals that a nil topic broadcaster has been provided
+var ErrNilTopicBroadcaster = errors.New("nil topic broadcaster")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNoAddressPrefixes signals that no address prefixes have been provided
+var ErrNoAddressPrefixes = errors.New("no address prefixes")
+
+// ErrEmptyAddressPrefix signals that an empty address prefix has been provided
+var ErrEmptyAddressPrefix = errors.New("empty address prefix")
@@ -0,0 +1,9 @@
+package lightTopics
+
+// TopicBroadcaster defines the subset of the messenger functionality used to publish on the light topics
+type TopicBroadcaster interface {
+	HasTopic(name string) bool
+	CreateTopic(name string, createChannelForTopic bool) error
+	Broadcast(topic string, buff []byte)
+	IsInterfaceNil() bool
+}
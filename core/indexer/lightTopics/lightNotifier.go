@@ -0,0 +1,206 @@
+package lightTopics
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var log = logger.GetOrCreate("core/indexer/lighttopics")
+
+// ArgsLightNotifier is the DTO used to create a new instance of lightNotifier
+type ArgsLightNotifier struct {
+	Messenger       TopicBroadcaster
+	Marshalizer     marshal.Marshalizer
+	AddressPrefixes [][]byte
+	// CleanTxLogs should be set when the light notifier is the only consumer of the cached transaction logs
+	CleanTxLogs bool
+}
+
+type addressPrefix struct {
+	prefix []byte
+	topic  string
+}
+
+// lightNotifier publishes compact notifications about the activity of the addresses that match one of the
+// configured prefixes. Each prefix has its own topic so that the light clients only receive what they are interested in
+type lightNotifier struct {
+	*indexer.NilIndexer
+	messenger     TopicBroadcaster
+	marshalizer   marshal.Marshalizer
+	prefixes      []addressPrefix
+	cleanTxLogs   bool
+	mutTxLogsProc sync.RWMutex
+	txLogsProc    process.TransactionLogProcessorDatabase
+}
+
+// NewLightNotifier creates a new light topics notifier and declares all the needed topics
+func NewLightNotifier(args ArgsLightNotifier) (*lightNotifier, error) {
+	if check.IfNil(args.Messenger) {
+		return nil, ErrNilTopicBroadcaster
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if len(args.AddressPrefixes) == 0 {
+		return nil, ErrNoAddressPrefixes
+	}
+
+	ln := &lightNotifier{
+		NilIndexer:  indexer.NewNilIndexer(),
+		messenger:   args.Messenger,
+		marshalizer: args.Marshalizer,
+		prefixes:    make([]addressPrefix, 0, len(args.AddressPrefixes)),
+		cleanTxLogs: args.CleanTxLogs,
+	}
+
+	for _, prefix := range args.AddressPrefixes {
+		if len(prefix) == 0 {
+			return nil, ErrEmptyAddressPrefix
+		}
+
+		topic := TopicForAddressPrefix(prefix)
+		if !ln.messenger.HasTopic(topic) {
+			err := ln.messenger.CreateTopic(topic, false)
+			if err != nil {
+				return nil, fmt.Errorf("%w while creating topic %s", err, topic)
+			}
+		}
+
+		ln.prefixes = append(ln.prefixes, addressPrefix{
+			prefix: prefix,
+			topic:  topic,
+		})
+	}
+
+	return ln, nil
+}
+
+// TopicForAddressPrefix returns the light topic name for the provided address prefix
+func TopicForAddressPrefix(prefix []byte) string {
+	return core.LightAddressTopicPrefix + hex.EncodeToString(prefix)
+}
+
+// SetTxLogsProcessor sets the logs processor used to fetch the events generated by the transactions
+func (ln *lightNotifier) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	ln.mutTxLogsProc.Lock()
+	ln.txLogsProc = txLogsProc
+	ln.mutTxLogsProc.Unlock()
+}
+
+// SaveBlock publishes the notifications generated by the provided block on the matching light topics
+func (ln *lightNotifier) SaveBlock(
+	_ data.BodyHandler,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	_ []uint64,
+	_ []string,
+	headerHash []byte,
+) {
+	if check.IfNil(header) || len(txPool) == 0 {
+		return
+	}
+
+	ln.mutTxLogsProc.RLock()
+	txLogsProc := ln.txLogsProc
+	ln.mutTxLogsProc.RUnlock()
+
+	notificationsPerTopic := ln.createNotifications(txPool, txLogsProc)
+	if ln.cleanTxLogs && !check.IfNil(txLogsProc) {
+		txLogsProc.Clean()
+	}
+
+	for topic, notifications := range notificationsPerTopic {
+		batch := &NotificationsBatch{
+			HeaderHash:    headerHash,
+			Nonce:         header.GetNonce(),
+			ShardID:       header.GetShardID(),
+			Notifications: notifications,
+		}
+
+		buff, err := ln.marshalizer.Marshal(batch)
+		if err != nil {
+			log.Warn("lightNotifier.SaveBlock: can not marshal notifications",
+				"topic", topic, "error", err.Error())
+			continue
+		}
+
+		ln.messenger.Broadcast(topic, buff)
+	}
+
+	log.Trace("lightNotifier.SaveBlock", "nonce", header.GetNonce(), "num topics", len(notificationsPerTopic))
+}
+
+func (ln *lightNotifier) createNotifications(
+	txPool map[string]data.TransactionHandler,
+	txLogsProc process.TransactionLogProcessorDatabase,
+) map[string][]*Notification {
+	notificationsPerTopic := make(map[string][]*Notification)
+	uniqueNotifications := make(map[string]struct{})
+	addNotification := func(txHash []byte, address []byte, identifier string) {
+		for _, ap := range ln.prefixes {
+			if !bytes.HasPrefix(address, ap.prefix) {
+				continue
+			}
+
+			key := ap.topic + string(txHash) + string(address) + identifier
+			_, exists := uniqueNotifications[key]
+			if exists {
+				continue
+			}
+			uniqueNotifications[key] = struct{}{}
+
+			notificationsPerTopic[ap.topic] = append(notificationsPerTopic[ap.topic], &Notification{
+				TxHash:     txHash,
+				Address:    address,
+				Identifier: identifier,
+			})
+		}
+	}
+
+	for txHashStr, tx := range txPool {
+		if check.IfNil(tx) {
+			continue
+		}
+
+		txHash := []byte(txHashStr)
+		addNotification(txHash, tx.GetSndAddr(), TransactionIdentifier)
+		addNotification(txHash, tx.GetRcvAddr(), TransactionIdentifier)
+
+		if check.IfNil(txLogsProc) {
+			continue
+		}
+		txLog, found := txLogsProc.GetLogFromCache(txHash)
+		if !found || check.IfNil(txLog) {
+			continue
+		}
+		for _, event := range txLog.GetLogEvents() {
+			if check.IfNil(event) {
+				continue
+			}
+
+			addNotification(txHash, event.GetAddress(), string(event.GetIdentifier()))
+		}
+	}
+
+	return notificationsPerTopic
+}
+
+// IsNilIndexer returns false as the light notifier is a real indexer implementation
+func (ln *lightNotifier) IsNilIndexer() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ln *lightNotifier) IsInterfaceNil() bool {
+	return ln == nil
+}
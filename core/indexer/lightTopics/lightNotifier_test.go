@@ -0,0 +1,156 @@
+package lightTopics
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgs() ArgsLightNotifier {
+	return ArgsLightNotifier{
+		Messenger:       &mock.TopicBroadcasterStub{},
+		Marshalizer:     &marshal.JsonMarshalizer{},
+		AddressPrefixes: [][]byte{[]byte("aa")},
+	}
+}
+
+func TestNewLightNotifier_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Messenger = nil
+	ln, err := NewLightNotifier(args)
+	assert.True(t, check.IfNil(ln))
+	assert.Equal(t, ErrNilTopicBroadcaster, err)
+
+	args = createMockArgs()
+	args.Marshalizer = nil
+	ln, err = NewLightNotifier(args)
+	assert.True(t, check.IfNil(ln))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	args = createMockArgs()
+	args.AddressPrefixes = nil
+	ln, err = NewLightNotifier(args)
+	assert.True(t, check.IfNil(ln))
+	assert.Equal(t, ErrNoAddressPrefixes, err)
+
+	args = createMockArgs()
+	args.AddressPrefixes = [][]byte{[]byte("aa"), nil}
+	ln, err = NewLightNotifier(args)
+	assert.True(t, check.IfNil(ln))
+	assert.Equal(t, ErrEmptyAddressPrefix, err)
+}
+
+func TestNewLightNotifier_CreateTopicErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgs()
+	args.Messenger = &mock.TopicBroadcasterStub{
+		CreateTopicCalled: func(name string, createChannelForTopic bool) error {
+			return expectedErr
+		},
+	}
+	ln, err := NewLightNotifier(args)
+	assert.True(t, check.IfNil(ln))
+	assert.True(t, errors.Is(err, expectedErr))
+}
+
+func TestNewLightNotifier_ShouldCreateTopics(t *testing.T) {
+	t.Parallel()
+
+	createdTopics := make([]string, 0)
+	args := createMockArgs()
+	args.AddressPrefixes = [][]byte{{0xaa}, {0xbb, 0x01}}
+	args.Messenger = &mock.TopicBroadcasterStub{
+		CreateTopicCalled: func(name string, createChannelForTopic bool) error {
+			createdTopics = append(createdTopics, name)
+			return nil
+		},
+	}
+	ln, err := NewLightNotifier(args)
+	assert.False(t, check.IfNil(ln))
+	assert.Nil(t, err)
+	assert.False(t, ln.IsNilIndexer())
+	assert.Equal(t, []string{core.LightAddressTopicPrefix + "aa", core.LightAddressTopicPrefix + "bb01"}, createdTopics)
+}
+
+func TestLightNotifier_SaveBlockShouldBroadcastOnlyMatchingAddresses(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte("aa")
+	broadcasted := make(map[string][]byte)
+	cleanCalled := false
+	args := createMockArgs()
+	args.CleanTxLogs = true
+	args.Messenger = &mock.TopicBroadcasterStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			broadcasted[topic] = buff
+		},
+	}
+	ln, _ := NewLightNotifier(args)
+	ln.SetTxLogsProcessor(&mock.TxLogsProcessorDatabaseStub{
+		GetLogFromCacheCalled: func(txHash []byte) (data.LogHandler, bool) {
+			return &transaction.Log{
+				Events: []*transaction.Event{
+					{Address: []byte("aa-contract"), Identifier: []byte("transferToken")},
+					{Address: []byte("cc-contract"), Identifier: []byte("other")},
+				},
+			}, true
+		},
+		CleanCalled: func() {
+			cleanCalled = true
+		},
+	})
+
+	txPool := map[string]data.TransactionHandler{
+		"hash1": &transaction.Transaction{SndAddr: []byte("aa-sender"), RcvAddr: []byte("bb-receiver")},
+	}
+	ln.SaveBlock(&block.Body{}, &block.Header{Nonce: 7, ShardID: 1}, txPool, nil, nil, []byte("header hash"))
+
+	require.Equal(t, 1, len(broadcasted))
+	buff := broadcasted[TopicForAddressPrefix(prefix)]
+	batch := &NotificationsBatch{}
+	err := args.Marshalizer.Unmarshal(batch, buff)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(7), batch.Nonce)
+	assert.Equal(t, uint32(1), batch.ShardID)
+	assert.Equal(t, []byte("header hash"), batch.HeaderHash)
+	require.Equal(t, 2, len(batch.Notifications))
+	assert.True(t, cleanCalled)
+
+	identifiers := map[string][]byte{}
+	for _, notification := range batch.Notifications {
+		assert.Equal(t, []byte("hash1"), notification.TxHash)
+		identifiers[notification.Identifier] = notification.Address
+	}
+	assert.Equal(t, []byte("aa-sender"), identifiers[TransactionIdentifier])
+	assert.Equal(t, []byte("aa-contract"), identifiers["transferToken"])
+}
+
+func TestLightNotifier_SaveBlockNoMatchShouldNotBroadcast(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Messenger = &mock.TopicBroadcasterStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			assert.Fail(t, "should have not broadcast")
+		},
+	}
+	ln, _ := NewLightNotifier(args)
+
+	txPool := map[string]data.TransactionHandler{
+		"hash1": &transaction.Transaction{SndAddr: []byte("bb-sender"), RcvAddr: []byte("cc-receiver")},
+	}
+	ln.SaveBlock(&block.Body{}, &block.Header{}, txPool, nil, nil, []byte("header hash"))
+}
@@ -0,0 +1,19 @@
+package lightTopics
+
+// TransactionIdentifier is the identifier used in notifications generated by the sender or the receiver of a transaction
+const TransactionIdentifier = "transaction"
+
+// Notification is the compact structure published on a light topic for every relevant address activity
+type Notification struct {
+	TxHash     []byte `json:"txHash"`
+	Address    []byte `json:"address"`
+	Identifier string `json:"identifier"`
+}
+
+// NotificationsBatch holds all the notifications generated by a block for a light topic
+type NotificationsBatch struct {
+	HeaderHash    []byte          `json:"headerHash"`
+	Nonce         uint64          `json:"nonce"`
+	ShardID       uint32          `json:"shardID"`
+	Notifications []*Notification `json:"notifications"`
+}
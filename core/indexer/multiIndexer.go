@@ -0,0 +1,161 @@
+package indexer
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// multiIndexer dispatches all the calls to the contained indexers
+type multiIndexer struct {
+	indexers []Indexer
+}
+
+// NewMultiIndexer creates a new multiIndexer instance. The nil indexers are skipped
+func NewMultiIndexer(indexers ...Indexer) (*multiIndexer, error) {
+	mi := &multiIndexer{
+		indexers: make([]Indexer, 0, len(indexers)),
+	}
+	for _, idx := range indexers {
+		if check.IfNil(idx) {
+			return nil, ErrNilIndexer
+		}
+		if idx.IsNilIndexer() {
+			continue
+		}
+
+		mi.indexers = append(mi.indexers, idx)
+	}
+
+	return mi, nil
+}
+
+// SetTxLogsProcessor will set the tx logs processor on all contained indexers
+func (mi *multiIndexer) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	for _, idx := range mi.indexers {
+		idx.SetTxLogsProcessor(txLogsProc)
+	}
+}
+
+// SaveBlock will call SaveBlock on all contained indexers
+func (mi *multiIndexer) SaveBlock(
+	body data.BodyHandler,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+	notarizedHeadersHashes []string,
+	headerHash []byte,
+) {
+	for _, idx := range mi.indexers {
+		idx.SaveBlock(body, header, txPool, signersIndexes, notarizedHeadersHashes, headerHash)
+	}
+}
+
+// RevertIndexedBlock will call RevertIndexedBlock on all contained indexers
+func (mi *multiIndexer) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) {
+	for _, idx := range mi.indexers {
+		idx.RevertIndexedBlock(header, body)
+	}
+}
+
+// SaveRoundsInfo will call SaveRoundsInfo on all contained indexers
+func (mi *multiIndexer) SaveRoundsInfo(roundsInfos []workItems.RoundInfo) {
+	for _, idx := range mi.indexers {
+		idx.SaveRoundsInfo(roundsInfos)
+	}
+}
+
+// UpdateTPS will call UpdateTPS on all contained indexers
+func (mi *multiIndexer) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
+	for _, idx := range mi.indexers {
+		idx.UpdateTPS(tpsBenchmark)
+	}
+}
+
+// SaveValidatorsPubKeys will call SaveValidatorsPubKeys on all contained indexers
+func (mi *multiIndexer) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) {
+	for _, idx := range mi.indexers {
+		idx.SaveValidatorsPubKeys(validatorsPubKeys, epoch)
+	}
+}
+
+// SaveValidatorsRating will call SaveValidatorsRating on all contained indexers
+func (mi *multiIndexer) SaveValidatorsRating(indexID string, infoRating []workItems.ValidatorRatingInfo) {
+	for _, idx := range mi.indexers {
+		idx.SaveValidatorsRating(indexID, infoRating)
+	}
+}
+
+// SaveAccounts will call SaveAccounts on all contained indexers
+func (mi *multiIndexer) SaveAccounts(acc []state.UserAccountHandler) {
+	for _, idx := range mi.indexers {
+		idx.SaveAccounts(acc)
+	}
+}
+
+// Flush will wait for all the contained indexers that deliver the data asynchronously to deliver their queued data
+func (mi *multiIndexer) Flush(ctx context.Context) error {
+	for _, idx := range mi.indexers {
+		flusher, ok := idx.(Flusher)
+		if !ok {
+			continue
+		}
+
+		err := flusher.Flush(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DriversStatuses returns the health of the contained indexers that deliver the data to an external endpoint. The
+// unnamed statuses, reported by the wrappers of the other indexers, are skipped
+func (mi *multiIndexer) DriversStatuses() []DriverStatus {
+	statuses := make([]DriverStatus, 0, len(mi.indexers))
+	for _, idx := range mi.indexers {
+		statusHandler, ok := idx.(DriverStatusHandler)
+		if !ok {
+			continue
+		}
+
+		status := statusHandler.DriverStatus()
+		if len(status.Name) == 0 {
+			continue
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+// Close will close all contained indexers, returning the last encountered error
+func (mi *multiIndexer) Close() error {
+	var lastErr error
+	for _, idx := range mi.indexers {
+		err := idx.Close()
+		if err != nil {
+			log.Warn("multiIndexer.Close", "error", err)
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// IsNilIndexer returns true if no real indexer is contained
+func (mi *multiIndexer) IsNilIndexer() bool {
+	return len(mi.indexers) == 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mi *multiIndexer) IsInterfaceNil() bool {
+	return mi == nil
+}
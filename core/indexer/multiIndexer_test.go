@@ -0,0 +1,131 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMultiIndexer_NilIndexerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mi, err := NewMultiIndexer(&mock.IndexerStub{}, nil)
+	assert.True(t, check.IfNil(mi))
+	assert.Equal(t, ErrNilIndexer, err)
+}
+
+func TestNewMultiIndexer_ShouldSkipNilIndexers(t *testing.T) {
+	t.Parallel()
+
+	mi, err := NewMultiIndexer(NewNilIndexer(), NewNilIndexer())
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(mi))
+	assert.True(t, mi.IsNilIndexer())
+
+	mi, _ = NewMultiIndexer(NewNilIndexer(), &mock.IndexerStub{})
+	assert.False(t, mi.IsNilIndexer())
+}
+
+func TestMultiIndexer_SaveBlockShouldCallAllIndexers(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	stub := &mock.IndexerStub{
+		SaveBlockCalled: func(_ data.BodyHandler, _ data.HeaderHandler, _ map[string]data.TransactionHandler, _ []uint64, _ []string, _ []byte) {
+			numCalls++
+		},
+	}
+	mi, _ := NewMultiIndexer(stub, NewNilIndexer(), stub)
+
+	mi.SaveBlock(nil, nil, nil, nil, nil, nil)
+	assert.Equal(t, 2, numCalls)
+}
+
+func TestMultiIndexer_CloseShouldCloseAllAndReturnError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	numCalls := 0
+	mi, _ := NewMultiIndexer(
+		&mock.IndexerStub{
+			CloseCalled: func() error {
+				numCalls++
+				return expectedErr
+			},
+		},
+		&mock.IndexerStub{
+			CloseCalled: func() error {
+				numCalls++
+				return nil
+			},
+		},
+	)
+
+	err := mi.Close()
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 2, numCalls)
+}
+
+type flushingIndexerStub struct {
+	*mock.IndexerStub
+	flushCalled func(ctx context.Context) error
+}
+
+func (stub *flushingIndexerStub) Flush(ctx context.Context) error {
+	return stub.flushCalled(ctx)
+}
+
+func TestMultiIndexer_FlushShouldFlushTheAsynchronousIndexers(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	numCalls := 0
+	flushingIndexer := &flushingIndexerStub{
+		IndexerStub: &mock.IndexerStub{},
+		flushCalled: func(_ context.Context) error {
+			numCalls++
+			return nil
+		},
+	}
+	mi, _ := NewMultiIndexer(flushingIndexer, &mock.IndexerStub{}, flushingIndexer)
+
+	err := mi.Flush(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numCalls)
+
+	flushingIndexer.flushCalled = func(_ context.Context) error {
+		return expectedErr
+	}
+	err = mi.Flush(context.Background())
+	assert.Equal(t, expectedErr, err)
+}
+
+type statusIndexerStub struct {
+	*mock.IndexerStub
+	status DriverStatus
+}
+
+func (stub *statusIndexerStub) DriverStatus() DriverStatus {
+	return stub.status
+}
+
+func TestMultiIndexer_DriversStatusesShouldReturnTheNamedStatuses(t *testing.T) {
+	t.Parallel()
+
+	kafkaStatus := DriverStatus{Name: "kafka", State: DriverStateBuffering, ConsecutiveFailures: 3}
+	elasticStatus := DriverStatus{Name: "elasticsearch", State: DriverStateConnected}
+	mi, _ := NewMultiIndexer(
+		&mock.IndexerStub{},
+		&statusIndexerStub{IndexerStub: &mock.IndexerStub{}, status: kafkaStatus},
+		&statusIndexerStub{IndexerStub: &mock.IndexerStub{}},
+		&statusIndexerStub{IndexerStub: &mock.IndexerStub{}, status: elasticStatus},
+	)
+
+	statuses := mi.DriversStatuses()
+	assert.Equal(t, []DriverStatus{kafkaStatus, elasticStatus}, statuses)
+}
@@ -0,0 +1,42 @@
+package indexer
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+const pendingItemsPollInterval = time.Millisecond * 10
+
+// PendingItems counts the items queued by an asynchronous indexer that were not yet delivered
+type PendingItems struct {
+	numPending int64
+}
+
+// Add must be called before an item is queued
+func (pi *PendingItems) Add() {
+	atomic.AddInt64(&pi.numPending, 1)
+}
+
+// Done must be called after a queued item was delivered
+func (pi *PendingItems) Done() {
+	atomic.AddInt64(&pi.numPending, -1)
+}
+
+// Len returns the number of items not yet delivered
+func (pi *PendingItems) Len() int64 {
+	return atomic.LoadInt64(&pi.numPending)
+}
+
+// Wait blocks until all the queued items are delivered or the context is done
+func (pi *PendingItems) Wait(ctx context.Context) error {
+	for pi.Len() > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pendingItemsPollInterval):
+		}
+	}
+
+	return nil
+}
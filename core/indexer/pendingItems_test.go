@@ -0,0 +1,41 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPendingItems_WaitShouldReturnWhenAllItemsAreDone(t *testing.T) {
+	t.Parallel()
+
+	pi := &PendingItems{}
+	pi.Add()
+	pi.Add()
+	assert.Equal(t, int64(2), pi.Len())
+
+	go func() {
+		time.Sleep(time.Millisecond * 20)
+		pi.Done()
+		pi.Done()
+	}()
+
+	err := pi.Wait(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), pi.Len())
+}
+
+func TestPendingItems_WaitShouldReturnWhenTheContextIsDone(t *testing.T) {
+	t.Parallel()
+
+	pi := &PendingItems{}
+	pi.Add()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*20)
+	defer cancel()
+
+	err := pi.Wait(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
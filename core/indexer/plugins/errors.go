@@ -0,0 +1,45 @@
+package plugins
+
+import "errors"
+
+// ErrEmptyName signals that an empty plugin name has been provided
+var ErrEmptyName = errors.New("empty plugin name")
+
+// ErrEmptyPath signals that an empty plugin binary path has been provided
+var ErrEmptyPath = errors.New("empty plugin path")
+
+// ErrInvalidHandshakeTimeout signals that an invalid handshake timeout has been provided
+var ErrInvalidHandshakeTimeout = errors.New("invalid handshake timeout")
+
+// ErrHandshakeTimeout signals that the plugin did not print its handshake line in time
+var ErrHandshakeTimeout = errors.New("timeout while waiting for the plugin handshake")
+
+// ErrInvalidHandshake signals that the plugin printed a malformed handshake line
+var ErrInvalidHandshake = errors.New("invalid plugin handshake")
+
+// ErrIncompatibleProtocolVersion signals that the plugin speaks another version of the plugin protocol
+var ErrIncompatibleProtocolVersion = errors.New("incompatible plugin protocol version")
+
+// ErrPluginExited signals that the plugin process exited
+var ErrPluginExited = errors.New("plugin process exited")
+
+// ErrClientClosed signals that a delivery was attempted after the plugin client has been closed
+var ErrClientClosed = errors.New("plugin client is closed")
+
+// ErrNilClient signals that a nil plugin client has been provided
+var ErrNilClient = errors.New("nil plugin client")
+
+// ErrNilBuffer signals that a nil delivery buffer has been provided
+var ErrNilBuffer = errors.New("nil delivery buffer")
+
+// ErrInvalidRetryInterval signals that an invalid retry interval has been provided
+var ErrInvalidRetryInterval = errors.New("invalid retry interval")
+
+// ErrNilDriver signals that a nil plugin driver has been provided
+var ErrNilDriver = errors.New("nil driver")
+
+// ErrNotStartedByNode signals that the plugin binary was not started by a node
+var ErrNotStartedByNode = errors.New("outport plugins are started by the node, add the binary path to the OutportPlugins section of external.toml")
+
+// ErrShutdownTimeout signals that the plugin did not exit in time after it was asked to shut down, so it was killed
+var ErrShutdownTimeout = errors.New("timeout while waiting for the plugin to shut down")
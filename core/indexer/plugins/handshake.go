@@ -0,0 +1,53 @@
+package plugins
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ProtocolVersion is the version of the plugin protocol, made of the handshake and of the OutportPlugin service. It
+	// is increased on each breaking change, so a node refuses the plugins built for another version
+	ProtocolVersion = 1
+
+	// MagicCookieKey is the environment variable set by the node when it starts a plugin. It only tells a binary it was
+	// started by a node, so a plugin started by hand prints a helpful message instead of waiting for the deliveries
+	MagicCookieKey = "ELROND_OUTPORT_PLUGIN_COOKIE"
+	// MagicCookieValue is the value of the MagicCookieKey environment variable
+	MagicCookieValue = "d4f7a9c3e1b84b6e9f2a5c8d0e3b7f1a"
+
+	handshakeSeparator = "|"
+	numHandshakeParts  = 3
+)
+
+// formatHandshake returns the first line printed by a plugin on its standard output: the protocol version, the network
+// and the address the plugin serves the OutportPlugin service on, separated by "|", as in "1|tcp|127.0.0.1:41523"
+func formatHandshake(network string, address string) string {
+	return strings.Join([]string{strconv.Itoa(ProtocolVersion), network, address}, handshakeSeparator)
+}
+
+func parseHandshake(line string) (string, string, error) {
+	parts := strings.Split(strings.TrimSpace(line), handshakeSeparator)
+	if len(parts) != numHandshakeParts {
+		return "", "", fmt.Errorf("%w: %s", ErrInvalidHandshake, line)
+	}
+
+	version, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %s", ErrInvalidHandshake, line)
+	}
+	if version != ProtocolVersion {
+		return "", "", fmt.Errorf("%w: plugin version %d, node version %d", ErrIncompatibleProtocolVersion, version, ProtocolVersion)
+	}
+
+	network, address := parts[1], parts[2]
+	if network != "tcp" && network != "unix" {
+		return "", "", fmt.Errorf("%w: unsupported network %s", ErrInvalidHandshake, network)
+	}
+	if len(address) == 0 {
+		return "", "", fmt.Errorf("%w: empty address", ErrInvalidHandshake)
+	}
+
+	return network, address, nil
+}
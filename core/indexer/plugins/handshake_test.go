@@ -0,0 +1,53 @@
+package plugins
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHandshake(t *testing.T) {
+	t.Parallel()
+
+	network, address, err := parseHandshake(formatHandshake("tcp", "127.0.0.1:41523") + "\n")
+	assert.Nil(t, err)
+	assert.Equal(t, "tcp", network)
+	assert.Equal(t, "127.0.0.1:41523", address)
+
+	network, address, err = parseHandshake("1|unix|/tmp/plugin.sock")
+	assert.Nil(t, err)
+	assert.Equal(t, "unix", network)
+	assert.Equal(t, "/tmp/plugin.sock", address)
+
+	_, _, err = parseHandshake("starting the plugin")
+	assert.True(t, errors.Is(err, ErrInvalidHandshake))
+
+	_, _, err = parseHandshake("v1|tcp|127.0.0.1:41523")
+	assert.True(t, errors.Is(err, ErrInvalidHandshake))
+
+	_, _, err = parseHandshake("2|tcp|127.0.0.1:41523")
+	assert.True(t, errors.Is(err, ErrIncompatibleProtocolVersion))
+
+	_, _, err = parseHandshake("1|udp|127.0.0.1:41523")
+	assert.True(t, errors.Is(err, ErrInvalidHandshake))
+
+	_, _, err = parseHandshake("1|tcp|")
+	assert.True(t, errors.Is(err, ErrInvalidHandshake))
+}
+
+func TestLineWriter_ShouldSplitTheOutputInLines(t *testing.T) {
+	t.Parallel()
+
+	lines := make([]string, 0)
+	lw := &lineWriter{onLine: func(line string) {
+		lines = append(lines, line)
+	}}
+
+	_, _ = lw.Write([]byte("first"))
+	_, _ = lw.Write([]byte(" line\r\nsecond line\nthi"))
+	assert.Equal(t, []string{"first line", "second line"}, lines)
+
+	_, _ = lw.Write([]byte("rd line\n"))
+	assert.Equal(t, []string{"first line", "second line", "third line"}, lines)
+}
@@ -0,0 +1,22 @@
+package plugins
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go/core/indexer/grpcStream"
+)
+
+// Client defines the connection of the node to an outport plugin
+type Client interface {
+	Deliver(ctx context.Context, item []byte) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// Driver defines the outport driver implemented by a plugin binary. Deliver is called sequentially, in the order the
+// items were handed to the outport, and must return only after the item reached its destination, as an acknowledged
+// item is not delivered again. A failed item is delivered again after a while, so Deliver must tolerate duplicates
+type Driver interface {
+	Deliver(item *grpcStream.StreamItem) error
+	Close() error
+}
@@ -0,0 +1,32 @@
+package plugins
+
+import "context"
+
+// clientStub can not be moved inside the mock package as it generates cyclic imports
+type clientStub struct {
+	DeliverCalled func(ctx context.Context, item []byte) error
+	CloseCalled   func() error
+}
+
+// Deliver -
+func (stub *clientStub) Deliver(ctx context.Context, item []byte) error {
+	if stub.DeliverCalled != nil {
+		return stub.DeliverCalled(ctx, item)
+	}
+
+	return nil
+}
+
+// Close -
+func (stub *clientStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *clientStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,285 @@
+package plugins
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	shutdownTimeout = time.Second * 10
+	maxLineLength   = 64 * 1024
+)
+
+// ArgsPluginClient is the DTO used to create a new instance of pluginClient
+type ArgsPluginClient struct {
+	Name             string
+	Path             string
+	Arguments        []string
+	HandshakeTimeout time.Duration
+}
+
+// pluginProcess is a running plugin binary together with the gRPC connection to the service it serves
+type pluginProcess struct {
+	cmd        *exec.Cmd
+	stdin      io.WriteCloser
+	conn       *grpc.ClientConn
+	client     OutportPluginClient
+	chanExited chan struct{}
+}
+
+// pluginClient starts a plugin binary and calls the OutportPlugin service it serves. The plugin is started again on the
+// next delivery if it exited. Its standard output, after the handshake line, and its standard error are written in the
+// node's log. The plugin's standard input stays open while the node runs, so a plugin can detect the node's exit
+type pluginClient struct {
+	name             string
+	path             string
+	arguments        []string
+	handshakeTimeout time.Duration
+	mut              sync.Mutex
+	process          *pluginProcess
+	closed           bool
+}
+
+// NewPluginClient starts the plugin binary and connects to it
+func NewPluginClient(args ArgsPluginClient) (*pluginClient, error) {
+	if len(args.Name) == 0 {
+		return nil, ErrEmptyName
+	}
+	if len(args.Path) == 0 {
+		return nil, ErrEmptyPath
+	}
+	if args.HandshakeTimeout <= 0 {
+		return nil, ErrInvalidHandshakeTimeout
+	}
+
+	pc := &pluginClient{
+		name:             args.Name,
+		path:             args.Path,
+		arguments:        args.Arguments,
+		handshakeTimeout: args.HandshakeTimeout,
+	}
+
+	var err error
+	pc.process, err = pc.startProcess()
+	if err != nil {
+		return nil, err
+	}
+
+	return pc, nil
+}
+
+func (pc *pluginClient) startProcess() (*pluginProcess, error) {
+	cmd := exec.Command(pc.path, pc.arguments...)
+	cmd.Env = append(os.Environ(), MagicCookieKey+"="+MagicCookieValue)
+
+	chanHandshake := make(chan string, 1)
+	isFirstLine := true
+	cmd.Stdout = &lineWriter{onLine: func(line string) {
+		if isFirstLine {
+			isFirstLine = false
+			chanHandshake <- line
+			return
+		}
+
+		log.Debug("outport plugin output", "plugin", pc.name, "line", line)
+	}}
+	cmd.Stderr = &lineWriter{onLine: func(line string) {
+		log.Info("outport plugin output", "plugin", pc.name, "line", line)
+	}}
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cmd.Start()
+	if err != nil {
+		return nil, fmt.Errorf("%w while starting the outport plugin %s", err, pc.name)
+	}
+
+	process := &pluginProcess{
+		cmd:        cmd,
+		stdin:      stdin,
+		chanExited: make(chan struct{}),
+	}
+	go pc.waitExit(process)
+
+	var line string
+	select {
+	case line = <-chanHandshake:
+	case <-process.chanExited:
+		return nil, fmt.Errorf("%w before the handshake, plugin %s", ErrPluginExited, pc.name)
+	case <-time.After(pc.handshakeTimeout):
+		process.kill()
+		return nil, fmt.Errorf("%w, plugin %s", ErrHandshakeTimeout, pc.name)
+	}
+
+	network, address, err := parseHandshake(line)
+	if err != nil {
+		process.kill()
+		return nil, err
+	}
+
+	dialer := func(ctx context.Context, address string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, address)
+	}
+	process.conn, err = grpc.Dial(address, grpc.WithInsecure(), grpc.WithContextDialer(dialer))
+	if err != nil {
+		process.kill()
+		return nil, err
+	}
+	process.client = NewOutportPluginClient(process.conn)
+
+	log.Info("outport plugin started", "plugin", pc.name, "pid", cmd.Process.Pid, "address", address)
+
+	return process, nil
+}
+
+func (pc *pluginClient) waitExit(process *pluginProcess) {
+	err := process.cmd.Wait()
+	if err != nil {
+		log.Warn("outport plugin exited", "plugin", pc.name, "error", err.Error())
+	} else {
+		log.Debug("outport plugin exited", "plugin", pc.name)
+	}
+
+	close(process.chanExited)
+}
+
+// Deliver sends the provided protobuf encoded item to the plugin, starting the plugin again if it exited
+func (pc *pluginClient) Deliver(ctx context.Context, item []byte) error {
+	process, err := pc.runningProcess()
+	if err != nil {
+		return err
+	}
+
+	_, err = process.client.Deliver(ctx, &DeliverRequest{Item: item})
+
+	return err
+}
+
+func (pc *pluginClient) runningProcess() (*pluginProcess, error) {
+	pc.mut.Lock()
+	defer pc.mut.Unlock()
+
+	if pc.closed {
+		return nil, ErrClientClosed
+	}
+	if pc.process != nil && !pc.process.hasExited() {
+		return pc.process, nil
+	}
+
+	if pc.process != nil {
+		_ = pc.process.conn.Close()
+		pc.process = nil
+		log.Info("starting the outport plugin again", "plugin", pc.name)
+	}
+
+	process, err := pc.startProcess()
+	if err != nil {
+		return nil, err
+	}
+	pc.process = process
+
+	return process, nil
+}
+
+// Close asks the plugin to shut down and waits for it to exit. The plugin is killed if it does not exit in time
+func (pc *pluginClient) Close() error {
+	pc.mut.Lock()
+	process := pc.process
+	pc.process = nil
+	pc.closed = true
+	pc.mut.Unlock()
+
+	if process == nil {
+		return nil
+	}
+
+	return process.shutdown()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pc *pluginClient) IsInterfaceNil() bool {
+	return pc == nil
+}
+
+func (pp *pluginProcess) hasExited() bool {
+	select {
+	case <-pp.chanExited:
+		return true
+	default:
+		return false
+	}
+}
+
+func (pp *pluginProcess) shutdown() error {
+	defer func() {
+		_ = pp.conn.Close()
+	}()
+
+	if pp.hasExited() {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	_, err := pp.client.Shutdown(ctx, &ShutdownRequest{})
+	if err != nil {
+		log.Debug("outport plugin did not acknowledge the shutdown", "error", err.Error())
+	}
+	_ = pp.stdin.Close()
+
+	select {
+	case <-pp.chanExited:
+		return nil
+	case <-ctx.Done():
+		pp.kill()
+		return ErrShutdownTimeout
+	}
+}
+
+func (pp *pluginProcess) kill() {
+	_ = pp.stdin.Close()
+	_ = pp.cmd.Process.Kill()
+	<-pp.chanExited
+}
+
+// lineWriter receives the output of a plugin process and calls onLine for each line. It is written by a single go
+// routine, the one copying the output of the process
+type lineWriter struct {
+	buff   []byte
+	onLine func(line string)
+}
+
+// Write splits the provided output in lines
+func (lw *lineWriter) Write(p []byte) (int, error) {
+	lw.buff = append(lw.buff, p...)
+	for {
+		index := bytes.IndexByte(lw.buff, '\n')
+		if index < 0 {
+			break
+		}
+
+		lw.onLine(strings.TrimRight(string(lw.buff[:index]), "\r"))
+		lw.buff = lw.buff[index+1:]
+	}
+
+	if len(lw.buff) > maxLineLength {
+		lw.onLine(string(lw.buff))
+		lw.buff = nil
+	}
+
+	return len(p), nil
+}
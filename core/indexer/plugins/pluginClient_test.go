@@ -0,0 +1,230 @@
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/grpcStream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// the plugin client tests start the test binary itself as the plugin, running only TestHelperPlugin, which behaves as
+// requested by the helperModeEnv environment variable
+const (
+	helperModeEnv   = "ELROND_OUTPORT_PLUGIN_TEST_MODE"
+	helperOutputEnv = "ELROND_OUTPORT_PLUGIN_TEST_OUTPUT"
+
+	modeServe         = "serve"
+	modeExit          = "exit"
+	modeSilent        = "silent"
+	modeOtherProtocol = "otherProtocol"
+
+	rejectedNonce = 13
+	exitNonce     = 99
+)
+
+// helperDriver appends the nonces of the delivered blocks to the output file, rejects the block with the rejected
+// nonce and exits on the block with the exit nonce
+type helperDriver struct {
+	outputPath string
+}
+
+func (hd *helperDriver) Deliver(item *grpcStream.StreamItem) error {
+	nonce := item.GetBlock().GetNonce()
+	switch nonce {
+	case rejectedNonce:
+		return errors.New("rejected block")
+	case exitNonce:
+		os.Exit(1)
+	}
+
+	return hd.write(fmt.Sprintf("%d\n", nonce))
+}
+
+func (hd *helperDriver) Close() error {
+	return hd.write("closed\n")
+}
+
+func (hd *helperDriver) write(line string) error {
+	file, err := os.OpenFile(hd.outputPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.WriteString(line)
+	_ = file.Close()
+
+	return err
+}
+
+func TestHelperPlugin(t *testing.T) {
+	switch os.Getenv(helperModeEnv) {
+	case "":
+		return
+	case modeServe:
+		err := Serve(&helperDriver{outputPath: os.Getenv(helperOutputEnv)})
+		if err != nil {
+			os.Exit(2)
+		}
+	case modeSilent:
+		time.Sleep(time.Minute)
+	case modeOtherProtocol:
+		fmt.Println("2|tcp|127.0.0.1:1")
+		time.Sleep(time.Minute)
+	}
+
+	os.Exit(0)
+}
+
+func createHelperPluginArgs(t *testing.T, mode string) (ArgsPluginClient, string) {
+	outputDir, err := ioutil.TempDir("", "outportPlugin")
+	require.Nil(t, err)
+
+	outputPath := filepath.Join(outputDir, "delivered.txt")
+	args := ArgsPluginClient{
+		Name: "helper",
+		Path: "/usr/bin/env",
+		Arguments: []string{
+			helperModeEnv + "=" + mode,
+			helperOutputEnv + "=" + outputPath,
+			os.Args[0],
+			"-test.run=^TestHelperPlugin$",
+		},
+		HandshakeTimeout: time.Second * 5,
+	}
+
+	return args, outputPath
+}
+
+func readOutput(t *testing.T, outputPath string) []string {
+	buff, err := ioutil.ReadFile(outputPath)
+	require.Nil(t, err)
+
+	return strings.Split(strings.TrimSpace(string(buff)), "\n")
+}
+
+func marshalBlock(t *testing.T, nonce uint64) []byte {
+	item := &grpcStream.StreamItem{
+		Payload: &grpcStream.StreamItem_Block{Block: &grpcStream.Block{Nonce: nonce}},
+	}
+	buff, err := item.Marshal()
+	require.Nil(t, err)
+
+	return buff
+}
+
+func TestNewPluginClient_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createHelperPluginArgs(t, modeServe)
+	args.Name = ""
+	pc, err := NewPluginClient(args)
+	assert.True(t, check.IfNil(pc))
+	assert.Equal(t, ErrEmptyName, err)
+
+	args, _ = createHelperPluginArgs(t, modeServe)
+	args.Path = ""
+	pc, err = NewPluginClient(args)
+	assert.True(t, check.IfNil(pc))
+	assert.Equal(t, ErrEmptyPath, err)
+
+	args, _ = createHelperPluginArgs(t, modeServe)
+	args.HandshakeTimeout = 0
+	pc, err = NewPluginClient(args)
+	assert.True(t, check.IfNil(pc))
+	assert.Equal(t, ErrInvalidHandshakeTimeout, err)
+
+	args, _ = createHelperPluginArgs(t, modeServe)
+	args.Path = filepath.Join(os.TempDir(), "missing-outport-plugin")
+	pc, err = NewPluginClient(args)
+	assert.True(t, check.IfNil(pc))
+	assert.NotNil(t, err)
+}
+
+func TestNewPluginClient_HandshakeErrors(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createHelperPluginArgs(t, modeExit)
+	pc, err := NewPluginClient(args)
+	assert.True(t, check.IfNil(pc))
+	assert.True(t, errors.Is(err, ErrPluginExited))
+
+	args, _ = createHelperPluginArgs(t, modeSilent)
+	args.HandshakeTimeout = time.Millisecond * 500
+	pc, err = NewPluginClient(args)
+	assert.True(t, check.IfNil(pc))
+	assert.True(t, errors.Is(err, ErrHandshakeTimeout))
+
+	args, _ = createHelperPluginArgs(t, modeOtherProtocol)
+	pc, err = NewPluginClient(args)
+	assert.True(t, check.IfNil(pc))
+	assert.True(t, errors.Is(err, ErrIncompatibleProtocolVersion))
+}
+
+func TestPluginClient_DeliverShouldPassTheItemsToThePlugin(t *testing.T) {
+	t.Parallel()
+
+	args, outputPath := createHelperPluginArgs(t, modeServe)
+	pc, err := NewPluginClient(args)
+	require.Nil(t, err)
+
+	ctx := context.Background()
+	assert.Nil(t, pc.Deliver(ctx, marshalBlock(t, 1)))
+	assert.Nil(t, pc.Deliver(ctx, marshalBlock(t, 2)))
+
+	err = pc.Deliver(ctx, marshalBlock(t, rejectedNonce))
+	require.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), "rejected block"))
+
+	err = pc.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1", "2", "closed"}, readOutput(t, outputPath))
+
+	err = pc.Deliver(ctx, marshalBlock(t, 3))
+	assert.Equal(t, ErrClientClosed, err)
+}
+
+func TestPluginClient_ShouldStartThePluginAgainAfterItExited(t *testing.T) {
+	t.Parallel()
+
+	args, outputPath := createHelperPluginArgs(t, modeServe)
+	pc, err := NewPluginClient(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = pc.Close()
+	}()
+
+	ctx := context.Background()
+	err = pc.Deliver(ctx, marshalBlock(t, exitNonce))
+	assert.NotNil(t, err)
+
+	deadline := time.Now().Add(time.Second * 5)
+	for {
+		err = pc.Deliver(ctx, marshalBlock(t, 1))
+		if err == nil || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	require.Nil(t, err)
+	assert.Equal(t, []string{"1"}, readOutput(t, outputPath))
+}
+
+func TestServe_ShouldErrWhenNotStartedByTheNode(t *testing.T) {
+	t.Parallel()
+
+	err := Serve(nil)
+	assert.Equal(t, ErrNilDriver, err)
+
+	err = Serve(&helperDriver{})
+	assert.Equal(t, ErrNotStartedByNode, err)
+}
@@ -0,0 +1,217 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=plugins=grpc:. outportPlugin.proto
+package plugins
+
+import (
+	"context"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/deliveryBuffer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/grpcStream"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("core/indexer/plugins")
+
+// ArgsPluginDriver is the DTO used to create a new instance of pluginDriver
+type ArgsPluginDriver struct {
+	Name             string
+	Client           Client
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	PubkeyConverter  core.PubkeyConverter
+	ShardCoordinator sharding.Coordinator
+	Buffer           indexer.DeliveryBuffer
+	RetryInterval    time.Duration
+	// MaxRetryInterval caps the exponentially growing wait between the retries of a failed delivery
+	MaxRetryInterval time.Duration
+	// LagThreshold is the number of buffered items above which the plugin is reported as lagging
+	LagThreshold uint32
+	// CleanTxLogs should be set when the plugin is the last consumer of the cached transaction logs
+	CleanTxLogs bool
+}
+
+// pluginDriver hands the blocks, rounds, validators and accounts to an external outport plugin, as the protobuf items
+// streamed by the gRPC driver. The items are created while the block is saved, appended to the delivery buffer and
+// delivered in order on a separate go routine. A delivery is retried, with an exponentially growing wait, until the
+// plugin acknowledges it and only then it is removed from the buffer, so every item is delivered at least once, across
+// restarts if the buffer is a write-ahead log. When the in-memory buffer is full the indexing pipeline waits for the
+// plugin, while a full write-ahead log drops the new items and reports the gap
+type pluginDriver struct {
+	*grpcStream.ItemsProducer
+	client       Client
+	retryBackOff *indexer.ExponentialBackOff
+	health       *indexer.DriverHealth
+	buffer       indexer.DeliveryBuffer
+	pendingItems indexer.PendingItems
+	cancelFunc   func()
+}
+
+// NewPluginDriver creates a new plugin driver and starts delivering
+func NewPluginDriver(args ArgsPluginDriver) (*pluginDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	pd := &pluginDriver{
+		client:       args.Client,
+		retryBackOff: indexer.NewExponentialBackOff(args.RetryInterval, args.MaxRetryInterval),
+		health:       indexer.NewDriverHealth(args.Name, args.LagThreshold),
+		buffer:       args.Buffer,
+	}
+
+	argsItemsProducer := grpcStream.ArgsItemsProducer{
+		Marshalizer:      args.Marshalizer,
+		Hasher:           args.Hasher,
+		PubkeyConverter:  args.PubkeyConverter,
+		ShardCoordinator: args.ShardCoordinator,
+		Handler:          pd,
+		CleanTxLogs:      args.CleanTxLogs,
+	}
+	pd.ItemsProducer, err = grpcStream.NewItemsProducer(argsItemsProducer)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < args.Buffer.Len(); i++ {
+		pd.pendingItems.Add()
+	}
+
+	var ctx context.Context
+	ctx, pd.cancelFunc = context.WithCancel(context.Background())
+	go pd.deliverLoop(ctx)
+
+	return pd, nil
+}
+
+func checkArgs(args ArgsPluginDriver) error {
+	if len(args.Name) == 0 {
+		return ErrEmptyName
+	}
+	if check.IfNil(args.Client) {
+		return ErrNilClient
+	}
+	if check.IfNil(args.Buffer) {
+		return ErrNilBuffer
+	}
+	if args.RetryInterval <= 0 || args.MaxRetryInterval < args.RetryInterval {
+		return ErrInvalidRetryInterval
+	}
+
+	return nil
+}
+
+// HandleItem appends the provided item, protobuf encoded, to the delivery buffer
+func (pd *pluginDriver) HandleItem(item *grpcStream.StreamItem) {
+	buff, err := item.Marshal()
+	if err != nil {
+		log.Warn("pluginDriver: can not marshal the item", "error", err.Error())
+		return
+	}
+
+	pd.pendingItems.Add()
+	err = pd.buffer.Append(buff)
+	if err != nil {
+		pd.pendingItems.Done()
+	}
+	if err != nil && err != deliveryBuffer.ErrBufferFull {
+		log.Warn("pluginDriver: can not buffer the item", "error", err.Error())
+	}
+}
+
+func (pd *pluginDriver) deliverLoop(ctx context.Context) {
+	for {
+		buff, err := pd.buffer.Peek(ctx)
+		if ctx.Err() != nil || err == deliveryBuffer.ErrBufferClosed {
+			log.Debug("pluginDriver's go routine is stopping...")
+			return
+		}
+		if err != nil {
+			log.Warn("pluginDriver could not read the delivery buffer (will retry)", "error", err.Error())
+			pd.waitRetry(ctx)
+			continue
+		}
+
+		pd.deliverItem(ctx, buff)
+		if ctx.Err() != nil {
+			// the item was not delivered, it stays in the buffer
+			log.Debug("pluginDriver's go routine is stopping...")
+			return
+		}
+
+		err = pd.buffer.Pop()
+		if err != nil {
+			log.Warn("pluginDriver could not remove a delivered item from the delivery buffer", "error", err.Error())
+		}
+		pd.pendingItems.Done()
+	}
+}
+
+func (pd *pluginDriver) deliverItem(ctx context.Context, item []byte) {
+	for {
+		err := pd.client.Deliver(ctx, item)
+		if err == nil {
+			pd.retryBackOff.Reset()
+			pd.health.DeliverySucceeded()
+			return
+		}
+
+		log.Warn("pluginDriver could not deliver an item (will retry)", "error", err.Error())
+
+		pd.health.DeliveryFailed(err)
+		pd.waitRetry(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+func (pd *pluginDriver) waitRetry(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(pd.retryBackOff.NextInterval()):
+	}
+}
+
+// DriverStatus returns the health of the deliveries to the plugin
+func (pd *pluginDriver) DriverStatus() indexer.DriverStatus {
+	status := pd.health.Status(pd.pendingItems.Len())
+	status.DroppedItems = pd.buffer.NumDroppedItems()
+
+	return status
+}
+
+// Flush blocks until all the queued items are delivered or the context is done
+func (pd *pluginDriver) Flush(ctx context.Context) error {
+	return pd.pendingItems.Wait(ctx)
+}
+
+// Close stops delivering, closes the delivery buffer and shuts the plugin down. The items not yet delivered are kept
+// only by a write-ahead log
+func (pd *pluginDriver) Close() error {
+	pd.cancelFunc()
+
+	errBuffer := pd.buffer.Close()
+	errClient := pd.client.Close()
+	if errBuffer != nil {
+		return errBuffer
+	}
+
+	return errClient
+}
+
+// IsNilIndexer returns false as the plugin driver is a real indexer implementation
+func (pd *pluginDriver) IsNilIndexer() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pd *pluginDriver) IsInterfaceNil() bool {
+	return pd == nil
+}
@@ -0,0 +1,292 @@
+package plugins
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/deliveryBuffer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/grpcStream"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const waitDelivery = time.Second
+
+type deliveredItems struct {
+	mut      sync.Mutex
+	items    []*grpcStream.StreamItem
+	chanDone chan struct{}
+}
+
+func newDeliveredItems() *deliveredItems {
+	return &deliveredItems{
+		chanDone: make(chan struct{}, 100),
+	}
+}
+
+func (di *deliveredItems) deliver(_ context.Context, buff []byte) error {
+	item := &grpcStream.StreamItem{}
+	err := item.Unmarshal(buff)
+	if err != nil {
+		return err
+	}
+
+	di.mut.Lock()
+	di.items = append(di.items, item)
+	di.mut.Unlock()
+
+	di.chanDone <- struct{}{}
+
+	return nil
+}
+
+func (di *deliveredItems) waitDeliveries(t *testing.T, numDeliveries int) {
+	for i := 0; i < numDeliveries; i++ {
+		select {
+		case <-di.chanDone:
+		case <-time.After(waitDelivery):
+			require.Fail(t, "timeout while waiting for the deliveries")
+		}
+	}
+}
+
+func (di *deliveredItems) get() []*grpcStream.StreamItem {
+	di.mut.Lock()
+	defer di.mut.Unlock()
+
+	return di.items
+}
+
+func createMockArgs() ArgsPluginDriver {
+	buffer, _ := deliveryBuffer.NewMemoryBuffer(10)
+
+	return ArgsPluginDriver{
+		Name:             "archive",
+		Client:           &clientStub{},
+		Marshalizer:      &mock.MarshalizerMock{},
+		Hasher:           &mock.HasherMock{},
+		PubkeyConverter:  mock.NewPubkeyConverterMock(4),
+		ShardCoordinator: &mock.ShardCoordinatorMock{},
+		Buffer:           buffer,
+		RetryInterval:    time.Millisecond,
+		MaxRetryInterval: time.Millisecond * 4,
+	}
+}
+
+func TestNewPluginDriver_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Name = ""
+	pd, err := NewPluginDriver(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, ErrEmptyName, err)
+
+	args = createMockArgs()
+	args.Client = nil
+	pd, err = NewPluginDriver(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, ErrNilClient, err)
+
+	args = createMockArgs()
+	args.Buffer = nil
+	pd, err = NewPluginDriver(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, ErrNilBuffer, err)
+
+	args = createMockArgs()
+	args.RetryInterval = 0
+	pd, err = NewPluginDriver(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, ErrInvalidRetryInterval, err)
+
+	args = createMockArgs()
+	args.MaxRetryInterval = args.RetryInterval / 2
+	pd, err = NewPluginDriver(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, ErrInvalidRetryInterval, err)
+
+	args = createMockArgs()
+	args.Marshalizer = nil
+	pd, err = NewPluginDriver(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, grpcStream.ErrNilMarshalizer, err)
+
+	pd, err = NewPluginDriver(createMockArgs())
+	assert.False(t, check.IfNil(pd))
+	assert.Nil(t, err)
+	assert.False(t, pd.IsNilIndexer())
+	_ = pd.Close()
+}
+
+func TestPluginDriver_ShouldDeliverTheItemsInOrder(t *testing.T) {
+	t.Parallel()
+
+	delivered := newDeliveredItems()
+	args := createMockArgs()
+	args.Client = &clientStub{
+		DeliverCalled: delivered.deliver,
+	}
+	pd, _ := NewPluginDriver(args)
+	defer func() {
+		_ = pd.Close()
+	}()
+
+	pd.RevertIndexedBlock(&block.Header{Nonce: 7}, nil)
+	pd.SaveRoundsInfo([]workItems.RoundInfo{{Index: 8, ShardId: 1}})
+	delivered.waitDeliveries(t, 2)
+
+	items := delivered.get()
+	require.Equal(t, 2, len(items))
+	assert.Equal(t, uint64(7), items[0].GetBlock().Nonce)
+	assert.True(t, items[0].GetBlock().Reverted)
+	require.Equal(t, 1, len(items[1].GetRounds().RoundsInfo))
+	assert.Equal(t, uint64(8), items[1].GetRounds().RoundsInfo[0].Index)
+}
+
+func TestPluginDriver_FailedDeliveryShouldBeRetried(t *testing.T) {
+	t.Parallel()
+
+	delivered := newDeliveredItems()
+	numFailures := 0
+	args := createMockArgs()
+	args.Client = &clientStub{
+		DeliverCalled: func(ctx context.Context, item []byte) error {
+			if numFailures < 3 {
+				numFailures++
+				return errors.New("plugin not available")
+			}
+
+			return delivered.deliver(ctx, item)
+		},
+	}
+	pd, _ := NewPluginDriver(args)
+	defer func() {
+		_ = pd.Close()
+	}()
+
+	pd.RevertIndexedBlock(&block.Header{Nonce: 1}, nil)
+	pd.RevertIndexedBlock(&block.Header{Nonce: 2}, nil)
+
+	err := pd.Flush(context.Background())
+	require.Nil(t, err)
+
+	items := delivered.get()
+	require.Equal(t, 2, len(items))
+	for i, expectedNonce := range []uint64{1, 2} {
+		assert.Equal(t, expectedNonce, items[i].GetBlock().Nonce)
+	}
+}
+
+func TestPluginDriver_UndeliveredItemsShouldBeDeliveredAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	persister := memorydb.New()
+	args := createMockArgs()
+	args.Buffer, _ = deliveryBuffer.NewWriteAheadLog(deliveryBuffer.ArgsWriteAheadLog{
+		Persister:      persister,
+		MaxSizeInBytes: 1024,
+	})
+	args.Client = &clientStub{
+		DeliverCalled: func(_ context.Context, _ []byte) error {
+			return errors.New("plugin not available")
+		},
+	}
+	pd, _ := NewPluginDriver(args)
+
+	pd.RevertIndexedBlock(&block.Header{Nonce: 1}, nil)
+	pd.RevertIndexedBlock(&block.Header{Nonce: 2}, nil)
+	_ = pd.Close()
+
+	delivered := newDeliveredItems()
+	args = createMockArgs()
+	args.Buffer, _ = deliveryBuffer.NewWriteAheadLog(deliveryBuffer.ArgsWriteAheadLog{
+		Persister:      persister,
+		MaxSizeInBytes: 1024,
+	})
+	args.Client = &clientStub{
+		DeliverCalled: delivered.deliver,
+	}
+	pd, _ = NewPluginDriver(args)
+	defer func() {
+		_ = pd.Close()
+	}()
+	delivered.waitDeliveries(t, 2)
+
+	items := delivered.get()
+	require.Equal(t, 2, len(items))
+	for i, expectedNonce := range []uint64{1, 2} {
+		assert.Equal(t, expectedNonce, items[i].GetBlock().Nonce)
+	}
+}
+
+func TestPluginDriver_CloseShouldCloseTheClient(t *testing.T) {
+	t.Parallel()
+
+	closeCalled := false
+	args := createMockArgs()
+	args.Client = &clientStub{
+		CloseCalled: func() error {
+			closeCalled = true
+			return nil
+		},
+	}
+	pd, _ := NewPluginDriver(args)
+
+	err := pd.Close()
+	assert.Nil(t, err)
+	assert.True(t, closeCalled)
+}
+
+func TestPluginDriver_DriverStatusShouldReportTheFailedDeliveries(t *testing.T) {
+	t.Parallel()
+
+	pluginAvailable := int32(0)
+	expectedErr := errors.New("plugin not available")
+	args := createMockArgs()
+	args.Client = &clientStub{
+		DeliverCalled: func(_ context.Context, _ []byte) error {
+			if atomic.LoadInt32(&pluginAvailable) == 0 {
+				return expectedErr
+			}
+
+			return nil
+		},
+	}
+	pd, _ := NewPluginDriver(args)
+	defer func() {
+		_ = pd.Close()
+	}()
+
+	status := pd.DriverStatus()
+	assert.Equal(t, args.Name, status.Name)
+	assert.Equal(t, indexer.DriverStateConnected, status.State)
+
+	pd.RevertIndexedBlock(&block.Header{Nonce: 1}, nil)
+	for pd.DriverStatus().ConsecutiveFailures < 2 {
+		time.Sleep(time.Millisecond)
+	}
+
+	status = pd.DriverStatus()
+	assert.Equal(t, indexer.DriverStateBuffering, status.State)
+	assert.Equal(t, int64(1), status.PendingItems)
+	assert.Equal(t, expectedErr.Error(), status.LastError)
+
+	atomic.StoreInt32(&pluginAvailable, 1)
+	err := pd.Flush(context.Background())
+	require.Nil(t, err)
+
+	status = pd.DriverStatus()
+	assert.Equal(t, indexer.DriverStateConnected, status.State)
+	assert.Equal(t, int64(0), status.PendingItems)
+}
@@ -0,0 +1,107 @@
+package plugins
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/indexer/grpcStream"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pluginServer implements the OutportPlugin service on the plugin side, passing the delivered items to the driver
+type pluginServer struct {
+	driver       Driver
+	mutDeliver   sync.Mutex
+	shutdownOnce sync.Once
+	chanShutdown chan struct{}
+}
+
+// Serve must be called by the main function of a plugin binary. It serves the OutportPlugin service on a local port,
+// prints the handshake line the node waits for and passes the delivered items to the provided driver, until the node
+// asks the plugin to shut down or exits. The driver is closed before returning. Nothing else must be printed on the
+// standard output before Serve is called, while the later output is written in the node's log
+func Serve(driver Driver) error {
+	if driver == nil {
+		return ErrNilDriver
+	}
+	if os.Getenv(MagicCookieKey) != MagicCookieValue {
+		return ErrNotStartedByNode
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+
+	ps := &pluginServer{
+		driver:       driver,
+		chanShutdown: make(chan struct{}),
+	}
+	server := grpc.NewServer()
+	RegisterOutportPluginServer(server, ps)
+
+	go ps.shutdownWhenClosed(os.Stdin)
+	go func() {
+		<-ps.chanShutdown
+		server.GracefulStop()
+	}()
+
+	_, err = fmt.Fprintln(os.Stdout, formatHandshake(listener.Addr().Network(), listener.Addr().String()))
+	if err != nil {
+		_ = listener.Close()
+		return err
+	}
+
+	errServe := server.Serve(listener)
+	errClose := driver.Close()
+	if errServe != nil {
+		return errServe
+	}
+
+	return errClose
+}
+
+// shutdownWhenClosed stops serving when the node closes the plugin's standard input, as it happens when the node
+// exits without asking the plugin to shut down
+func (ps *pluginServer) shutdownWhenClosed(stdin io.Reader) {
+	_, _ = io.Copy(ioutil.Discard, stdin)
+	ps.shutdown()
+}
+
+func (ps *pluginServer) shutdown() {
+	ps.shutdownOnce.Do(func() {
+		close(ps.chanShutdown)
+	})
+}
+
+// Deliver passes the delivered item to the driver
+func (ps *pluginServer) Deliver(_ context.Context, request *DeliverRequest) (*DeliverResponse, error) {
+	item := &grpcStream.StreamItem{}
+	err := item.Unmarshal(request.Item)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ps.mutDeliver.Lock()
+	err = ps.driver.Deliver(item)
+	ps.mutDeliver.Unlock()
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	return &DeliverResponse{}, nil
+}
+
+// Shutdown stops serving once the response is sent
+func (ps *pluginServer) Shutdown(_ context.Context, _ *ShutdownRequest) (*ShutdownResponse, error) {
+	ps.shutdown()
+
+	return &ShutdownResponse{}, nil
+}
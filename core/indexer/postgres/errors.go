@@ -0,0 +1,36 @@
+package postgres
+
+import "errors"
+
+// ErrNilDatabase signals that a nil database has been provided
+var ErrNilDatabase = errors.New("nil database")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilAccountsLoader signals that a nil accounts loader has been provided
+var ErrNilAccountsLoader = errors.New("nil accounts loader")
+
+// ErrNilBuffer signals that a nil delivery buffer has been provided
+var ErrNilBuffer = errors.New("nil delivery buffer")
+
+// ErrInvalidRetryInterval signals that an invalid retry interval has been provided
+var ErrInvalidRetryInterval = errors.New("invalid retry interval")
+
+// ErrEmptyConnectionString signals that an empty connection string has been provided
+var ErrEmptyConnectionString = errors.New("empty connection string")
+
+// ErrUnknownSchemaVersion signals that the database schema was migrated by a newer node version
+var ErrUnknownSchemaVersion = errors.New("unknown schema version")
+
+// ErrInvalidSerializedStatement signals that a statement read from the delivery buffer is not valid
+var ErrInvalidSerializedStatement = errors.New("invalid serialized statement")
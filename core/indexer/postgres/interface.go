@@ -0,0 +1,21 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// Database defines the PostgreSQL operations used by the driver
+type Database interface {
+	ExecInTransaction(ctx context.Context, statements []*Statement) error
+	AppliedMigrations(ctx context.Context) ([]uint32, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// AccountsLoader defines a component able to load the accounts modified by a committed block
+type AccountsLoader interface {
+	LoadAccount(address []byte) (state.AccountHandler, error)
+	IsInterfaceNil() bool
+}
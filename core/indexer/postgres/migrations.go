@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+)
+
+// Migration is a versioned change of the database schema. The migrations are applied in the increasing order of their
+// versions, each one in its own transaction
+type Migration struct {
+	Version     uint32
+	Description string
+	Statements  []string
+}
+
+const createMigrationsTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version BIGINT PRIMARY KEY,
+	description TEXT NOT NULL,
+	applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+)`
+
+const insertMigration = `INSERT INTO schema_migrations (version, description) VALUES ($1, $2) ON CONFLICT (version) DO NOTHING`
+
+// Migrations holds the schema of the database. A released migration must never be changed, a new one must be appended
+// instead. The statements are idempotent, so more nodes sharing the same database can start at the same time
+var Migrations = []Migration{
+	{
+		Version:     1,
+		Description: "create the blocks, transactions, events, esdt transfers and accounts tables",
+		Statements: []string{
+			`CREATE TABLE IF NOT EXISTS blocks (
+				hash TEXT PRIMARY KEY,
+				shard_id BIGINT NOT NULL,
+				nonce BIGINT NOT NULL,
+				round BIGINT NOT NULL,
+				epoch BIGINT NOT NULL,
+				prev_hash TEXT NOT NULL,
+				num_txs BIGINT NOT NULL,
+				timestamp BIGINT NOT NULL,
+				notarized_blocks TEXT[] NOT NULL DEFAULT '{}',
+				reverted BOOLEAN NOT NULL DEFAULT FALSE
+			)`,
+			`CREATE INDEX IF NOT EXISTS blocks_shard_nonce_idx ON blocks (shard_id, nonce)`,
+			`CREATE TABLE IF NOT EXISTS transactions (
+				hash TEXT NOT NULL,
+				block_hash TEXT NOT NULL,
+				miniblock_hash TEXT NOT NULL,
+				type TEXT NOT NULL,
+				nonce BIGINT NOT NULL,
+				value NUMERIC NOT NULL,
+				sender TEXT NOT NULL,
+				receiver TEXT NOT NULL,
+				sender_shard BIGINT NOT NULL,
+				receiver_shard BIGINT NOT NULL,
+				gas_price BIGINT NOT NULL,
+				gas_limit BIGINT NOT NULL,
+				data BYTEA,
+				timestamp BIGINT NOT NULL,
+				PRIMARY KEY (hash, block_hash)
+			)`,
+			`CREATE INDEX IF NOT EXISTS transactions_block_hash_idx ON transactions (block_hash)`,
+			`CREATE INDEX IF NOT EXISTS transactions_sender_idx ON transactions (sender)`,
+			`CREATE INDEX IF NOT EXISTS transactions_receiver_idx ON transactions (receiver)`,
+			`CREATE TABLE IF NOT EXISTS events (
+				tx_hash TEXT NOT NULL,
+				block_hash TEXT NOT NULL,
+				event_index BIGINT NOT NULL,
+				address TEXT NOT NULL,
+				identifier TEXT NOT NULL,
+				topics TEXT[] NOT NULL,
+				data BYTEA,
+				PRIMARY KEY (tx_hash, block_hash, event_index)
+			)`,
+			`CREATE INDEX IF NOT EXISTS events_block_hash_idx ON events (block_hash)`,
+			`CREATE INDEX IF NOT EXISTS events_address_identifier_idx ON events (address, identifier)`,
+			`CREATE TABLE IF NOT EXISTS esdt_transfers (
+				tx_hash TEXT NOT NULL,
+				block_hash TEXT NOT NULL,
+				token TEXT NOT NULL,
+				value NUMERIC NOT NULL,
+				sender TEXT NOT NULL,
+				receiver TEXT NOT NULL,
+				PRIMARY KEY (tx_hash, block_hash)
+			)`,
+			`CREATE INDEX IF NOT EXISTS esdt_transfers_block_hash_idx ON esdt_transfers (block_hash)`,
+			`CREATE INDEX IF NOT EXISTS esdt_transfers_token_idx ON esdt_transfers (token)`,
+			`CREATE TABLE IF NOT EXISTS accounts (
+				address TEXT PRIMARY KEY,
+				shard_id BIGINT NOT NULL,
+				nonce BIGINT NOT NULL,
+				balance NUMERIC NOT NULL,
+				block_hash TEXT NOT NULL
+			)`,
+			`CREATE TABLE IF NOT EXISTS account_balance_deltas (
+				address TEXT NOT NULL,
+				block_hash TEXT NOT NULL,
+				block_nonce BIGINT NOT NULL,
+				shard_id BIGINT NOT NULL,
+				balance NUMERIC NOT NULL,
+				delta NUMERIC NOT NULL,
+				PRIMARY KEY (address, block_hash)
+			)`,
+			`CREATE INDEX IF NOT EXISTS account_balance_deltas_block_hash_idx ON account_balance_deltas (block_hash)`,
+		},
+	},
+}
+
+// ApplyMigrations brings the database schema to the latest version. It fails if the database was migrated by a newer
+// node version, as the driver would write an outdated schema
+func ApplyMigrations(ctx context.Context, db Database, migrations []Migration) error {
+	err := db.ExecInTransaction(ctx, []*Statement{{Query: createMigrationsTable}})
+	if err != nil {
+		return err
+	}
+
+	appliedVersions, err := db.AppliedMigrations(ctx)
+	if err != nil {
+		return err
+	}
+
+	applied := make(map[uint32]struct{}, len(appliedVersions))
+	for _, version := range appliedVersions {
+		applied[version] = struct{}{}
+	}
+
+	knownVersions := make(map[uint32]struct{}, len(migrations))
+	for _, migration := range migrations {
+		knownVersions[migration.Version] = struct{}{}
+	}
+	for _, version := range appliedVersions {
+		_, isKnown := knownVersions[version]
+		if !isKnown {
+			return fmt.Errorf("%w: %d", ErrUnknownSchemaVersion, version)
+		}
+	}
+
+	for _, migration := range migrations {
+		_, isApplied := applied[migration.Version]
+		if isApplied {
+			continue
+		}
+
+		statements := make([]*Statement, 0, len(migration.Statements)+1)
+		for _, query := range migration.Statements {
+			statements = append(statements, &Statement{Query: query})
+		}
+		statements = append(statements, &Statement{
+			Query: insertMigration,
+			Args:  []interface{}{migration.Version, migration.Description},
+		})
+
+		err = db.ExecInTransaction(ctx, statements)
+		if err != nil {
+			return fmt.Errorf("%w while applying the schema migration %d", err, migration.Version)
+		}
+
+		log.Info("postgres: schema migration applied", "version", migration.Version, "description", migration.Description)
+	}
+
+	return nil
+}
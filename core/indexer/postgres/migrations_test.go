@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMigrations = []Migration{
+	{Version: 1, Description: "first", Statements: []string{"CREATE TABLE a (id INT)"}},
+	{Version: 2, Description: "second", Statements: []string{"CREATE TABLE b (id INT)", "CREATE INDEX b_idx ON b (id)"}},
+}
+
+func TestApplyMigrations_ShouldApplyOnlyTheMissingMigrations(t *testing.T) {
+	t.Parallel()
+
+	transactions := make([][]*Statement, 0)
+	db := &databaseStub{
+		ExecInTransactionCalled: func(_ context.Context, statements []*Statement) error {
+			transactions = append(transactions, statements)
+			return nil
+		},
+		AppliedMigrationsCalled: func(_ context.Context) ([]uint32, error) {
+			return []uint32{1}, nil
+		},
+	}
+
+	err := ApplyMigrations(context.Background(), db, testMigrations)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(transactions))
+	assert.Equal(t, createMigrationsTable, transactions[0][0].Query)
+
+	migration := transactions[1]
+	require.Equal(t, 3, len(migration))
+	assert.Equal(t, "CREATE TABLE b (id INT)", migration[0].Query)
+	assert.Equal(t, "CREATE INDEX b_idx ON b (id)", migration[1].Query)
+	assert.Equal(t, insertMigration, migration[2].Query)
+	assert.Equal(t, []interface{}{uint32(2), "second"}, migration[2].Args)
+}
+
+func TestApplyMigrations_UnknownAppliedVersionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	numTransactions := 0
+	db := &databaseStub{
+		ExecInTransactionCalled: func(_ context.Context, _ []*Statement) error {
+			numTransactions++
+			return nil
+		},
+		AppliedMigrationsCalled: func(_ context.Context) ([]uint32, error) {
+			return []uint32{1, 2, 3}, nil
+		},
+	}
+
+	err := ApplyMigrations(context.Background(), db, testMigrations)
+	assert.True(t, errors.Is(err, ErrUnknownSchemaVersion))
+	assert.Equal(t, 1, numTransactions)
+}
+
+func TestApplyMigrations_FailedMigrationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	db := &databaseStub{
+		ExecInTransactionCalled: func(_ context.Context, statements []*Statement) error {
+			if statements[0].Query == createMigrationsTable {
+				return nil
+			}
+
+			return expectedErr
+		},
+	}
+
+	err := ApplyMigrations(context.Background(), db, testMigrations)
+	assert.True(t, errors.Is(err, expectedErr))
+}
+
+func TestMigrations_VersionsShouldBeIncreasing(t *testing.T) {
+	t.Parallel()
+
+	lastVersion := uint32(0)
+	for _, migration := range Migrations {
+		assert.True(t, migration.Version > lastVersion)
+		assert.True(t, len(migration.Statements) > 0)
+		lastVersion = migration.Version
+	}
+}
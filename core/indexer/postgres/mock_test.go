@@ -0,0 +1,42 @@
+package postgres
+
+import "context"
+
+// databaseStub can not be moved inside the mock package as it generates cyclic imports
+type databaseStub struct {
+	ExecInTransactionCalled func(ctx context.Context, statements []*Statement) error
+	AppliedMigrationsCalled func(ctx context.Context) ([]uint32, error)
+	CloseCalled             func() error
+}
+
+// ExecInTransaction -
+func (stub *databaseStub) ExecInTransaction(ctx context.Context, statements []*Statement) error {
+	if stub.ExecInTransactionCalled != nil {
+		return stub.ExecInTransactionCalled(ctx, statements)
+	}
+
+	return nil
+}
+
+// AppliedMigrations -
+func (stub *databaseStub) AppliedMigrations(ctx context.Context) ([]uint32, error) {
+	if stub.AppliedMigrationsCalled != nil {
+		return stub.AppliedMigrationsCalled(ctx)
+	}
+
+	return nil, nil
+}
+
+// Close -
+func (stub *databaseStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *databaseStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	// registers the PostgreSQL driver for database/sql
+	_ "github.com/lib/pq"
+)
+
+const selectMigrations = `SELECT version FROM schema_migrations ORDER BY version`
+
+// ArgsPostgresDatabase is the DTO used to create a new instance of postgresDatabase
+type ArgsPostgresDatabase struct {
+	ConnectionString   string
+	MaxOpenConnections int
+}
+
+// postgresDatabase executes the statements on a PostgreSQL server
+type postgresDatabase struct {
+	db *sql.DB
+}
+
+// NewPostgresDatabase creates a new PostgreSQL database. The connections are opened when they are first needed
+func NewPostgresDatabase(args ArgsPostgresDatabase) (*postgresDatabase, error) {
+	if len(args.ConnectionString) == 0 {
+		return nil, ErrEmptyConnectionString
+	}
+
+	db, err := sql.Open("postgres", args.ConnectionString)
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(args.MaxOpenConnections)
+
+	return &postgresDatabase{
+		db: db,
+	}, nil
+}
+
+// ExecInTransaction executes the provided statements, in order, in a single transaction
+func (pd *postgresDatabase) ExecInTransaction(ctx context.Context, statements []*Statement) error {
+	tx, err := pd.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, statement := range statements {
+		_, err = tx.ExecContext(ctx, statement.Query, statement.Args...)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("%w while executing %.64q", err, statement.Query)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// AppliedMigrations returns the versions of the schema migrations already applied
+func (pd *postgresDatabase) AppliedMigrations(ctx context.Context) ([]uint32, error) {
+	rows, err := pd.db.QueryContext(ctx, selectMigrations)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	versions := make([]uint32, 0)
+	for rows.Next() {
+		var version uint32
+		err = rows.Scan(&version)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, version)
+	}
+
+	return versions, rows.Err()
+}
+
+// Close closes the connections to the server
+func (pd *postgresDatabase) Close() error {
+	return pd.db.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pd *postgresDatabase) IsInterfaceNil() bool {
+	return pd == nil
+}
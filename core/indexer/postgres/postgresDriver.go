@@ -0,0 +1,542 @@
+package postgres
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/deliveryBuffer"
+	"github.com/ElrondNetwork/elrond-go/core/parsers"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/lib/pq"
+)
+
+var log = logger.GetOrCreate("core/indexer/postgres")
+
+const driverName = "postgresql"
+
+const (
+	insertBlock = `INSERT INTO blocks (hash, shard_id, nonce, round, epoch, prev_hash, num_txs, timestamp, notarized_blocks) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) ON CONFLICT (hash) DO UPDATE SET reverted = FALSE`
+	insertTransactionsPrefix = `INSERT INTO transactions (hash, block_hash, miniblock_hash, type, nonce, value, sender, ` +
+		`receiver, sender_shard, receiver_shard, gas_price, gas_limit, data, timestamp) VALUES `
+	insertTransactionsSuffix = ` ON CONFLICT (hash, block_hash) DO NOTHING`
+	insertEventsPrefix       = `INSERT INTO events (tx_hash, block_hash, event_index, address, identifier, topics, data) VALUES `
+	insertEventsSuffix       = ` ON CONFLICT (tx_hash, block_hash, event_index) DO NOTHING`
+	insertESDTPrefix         = `INSERT INTO esdt_transfers (tx_hash, block_hash, token, value, sender, receiver) VALUES `
+	insertESDTSuffix         = ` ON CONFLICT (tx_hash, block_hash) DO NOTHING`
+	insertDeltasPrefix       = `INSERT INTO account_balance_deltas (address, block_hash, block_nonce, shard_id, balance, delta) ` +
+		`SELECT v.address, v.block_hash, v.block_nonce, v.shard_id, v.balance, v.balance - COALESCE(a.balance, 0) FROM (VALUES `
+	insertDeltasSuffix = `) AS v (address, block_hash, block_nonce, shard_id, balance) ` +
+		`LEFT JOIN accounts a ON a.address = v.address ON CONFLICT (address, block_hash) DO NOTHING`
+	upsertAccountsPrefix = `INSERT INTO accounts (address, shard_id, nonce, balance, block_hash) VALUES `
+	upsertAccountsSuffix = ` ON CONFLICT (address) DO UPDATE SET shard_id = EXCLUDED.shard_id, nonce = EXCLUDED.nonce, ` +
+		`balance = EXCLUDED.balance, block_hash = EXCLUDED.block_hash`
+
+	revertAccountsBalances = `UPDATE accounts SET balance = accounts.balance - d.delta FROM account_balance_deltas d ` +
+		`WHERE d.block_hash = $1 AND accounts.address = d.address`
+	deleteBlockDeltas       = `DELETE FROM account_balance_deltas WHERE block_hash = $1`
+	deleteBlockESDT         = `DELETE FROM esdt_transfers WHERE block_hash = $1`
+	deleteBlockEvents       = `DELETE FROM events WHERE block_hash = $1`
+	deleteBlockTransactions = `DELETE FROM transactions WHERE block_hash = $1`
+	markBlockReverted       = `UPDATE blocks SET reverted = TRUE WHERE hash = $1`
+)
+
+var deltasCasts = []string{"::TEXT", "::TEXT", "::BIGINT", "::BIGINT", "::NUMERIC"}
+
+// ArgsPostgresDriver is the DTO used to create a new instance of postgresDriver
+type ArgsPostgresDriver struct {
+	Database         Database
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	PubkeyConverter  core.PubkeyConverter
+	ShardCoordinator sharding.Coordinator
+	Accounts         AccountsLoader
+	Buffer           indexer.DeliveryBuffer
+	RetryInterval    time.Duration
+	// MaxRetryInterval caps the exponentially growing wait between the retries of a failed write
+	MaxRetryInterval time.Duration
+	// LagThreshold is the number of buffered blocks above which the driver is reported as lagging
+	LagThreshold uint32
+	// CleanTxLogs should be set when the PostgreSQL driver is the last consumer of the cached transaction logs
+	CleanTxLogs bool
+}
+
+// postgresDriver writes the committed blocks in normalized relational tables: the blocks, the transactions, the log
+// events, the ESDT transfers and the balance deltas of the modified accounts. Each block is written in a single
+// database transaction, on a separate go routine, after the schema migrations are applied. A failed transaction is
+// retried, with an exponentially growing wait, until it succeeds and the writes are idempotent. A block stays in the delivery buffer until it is written, so
+// the tables never miss a block, across restarts if the buffer is a write-ahead log, unless the log is full and drops
+// the new blocks, in which case the gap is reported. A reverted block is marked as
+// such, its rows are deleted and the balances of its accounts are restored
+type postgresDriver struct {
+	*indexer.NilIndexer
+	database         Database
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	pubkeyConverter  core.PubkeyConverter
+	shardCoordinator sharding.Coordinator
+	accounts         AccountsLoader
+	argsParser       process.CallArgumentsParser
+	retryBackOff     *indexer.ExponentialBackOff
+	health           *indexer.DriverHealth
+	cleanTxLogs      bool
+	mutTxLogsProc    sync.RWMutex
+	txLogsProc       process.TransactionLogProcessorDatabase
+	buffer           indexer.DeliveryBuffer
+	pendingItems     indexer.PendingItems
+	cancelFunc       func()
+}
+
+// NewPostgresDriver creates a new PostgreSQL driver and starts writing
+func NewPostgresDriver(args ArgsPostgresDriver) (*postgresDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	pd := &postgresDriver{
+		NilIndexer:       indexer.NewNilIndexer(),
+		database:         args.Database,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		pubkeyConverter:  args.PubkeyConverter,
+		shardCoordinator: args.ShardCoordinator,
+		accounts:         args.Accounts,
+		argsParser:       parsers.NewCallArgsParser(),
+		retryBackOff:     indexer.NewExponentialBackOff(args.RetryInterval, args.MaxRetryInterval),
+		health:           indexer.NewDriverHealth(driverName, args.LagThreshold),
+		cleanTxLogs:      args.CleanTxLogs,
+		buffer:           args.Buffer,
+	}
+	for i := 0; i < args.Buffer.Len(); i++ {
+		pd.pendingItems.Add()
+	}
+
+	var ctx context.Context
+	ctx, pd.cancelFunc = context.WithCancel(context.Background())
+	go pd.writeLoop(ctx)
+
+	return pd, nil
+}
+
+func checkArgs(args ArgsPostgresDriver) error {
+	if check.IfNil(args.Database) {
+		return ErrNilDatabase
+	}
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+	if check.IfNil(args.PubkeyConverter) {
+		return ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(args.Accounts) {
+		return ErrNilAccountsLoader
+	}
+	if check.IfNil(args.Buffer) {
+		return ErrNilBuffer
+	}
+	if args.RetryInterval <= 0 || args.MaxRetryInterval < args.RetryInterval {
+		return ErrInvalidRetryInterval
+	}
+
+	return nil
+}
+
+// SetTxLogsProcessor sets the logs processor used to fetch the events generated by the transactions
+func (pd *postgresDriver) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	pd.mutTxLogsProc.Lock()
+	pd.txLogsProc = txLogsProc
+	pd.mutTxLogsProc.Unlock()
+}
+
+// SaveBlock writes the provided block together with its transactions, log events, ESDT transfers and the balance
+// deltas of the modified accounts
+func (pd *postgresDriver) SaveBlock(
+	body data.BodyHandler,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	_ []uint64,
+	notarizedHeadersHashes []string,
+	headerHash []byte,
+) {
+	pd.mutTxLogsProc.RLock()
+	txLogsProc := pd.txLogsProc
+	pd.mutTxLogsProc.RUnlock()
+
+	defer func() {
+		if pd.cleanTxLogs && !check.IfNil(txLogsProc) {
+			txLogsProc.Clean()
+		}
+	}()
+
+	if check.IfNil(header) {
+		return
+	}
+
+	blockHash := hex.EncodeToString(headerHash)
+	if notarizedHeadersHashes == nil {
+		notarizedHeadersHashes = make([]string, 0)
+	}
+
+	statements := []*Statement{{
+		Query: insertBlock,
+		Args: []interface{}{
+			blockHash,
+			header.GetShardID(),
+			header.GetNonce(),
+			header.GetRound(),
+			header.GetEpoch(),
+			hex.EncodeToString(header.GetPrevHash()),
+			header.GetTxCount(),
+			header.GetTimeStamp(),
+			pq.StringArray(notarizedHeadersHashes),
+		},
+	}}
+	statements = append(statements, pd.createTransactionsStatements(body, header, txPool, blockHash)...)
+	statements = append(statements, pd.createEventsStatements(txPool, txLogsProc, blockHash)...)
+	statements = append(statements, pd.createAccountsStatements(txPool, header, blockHash)...)
+
+	pd.enqueue(statements)
+}
+
+func (pd *postgresDriver) createTransactionsStatements(
+	body data.BodyHandler,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	blockHash string,
+) []*Statement {
+	blockBody, ok := body.(*block.Body)
+	if !ok || len(txPool) == 0 {
+		return nil
+	}
+
+	txsRows := make([][]interface{}, 0, len(txPool))
+	esdtRows := make([][]interface{}, 0)
+	for _, miniBlock := range blockBody.MiniBlocks {
+		miniBlockHash, err := core.CalculateHash(pd.marshalizer, pd.hasher, miniBlock)
+		if err != nil {
+			log.Warn("postgresDriver: can not compute the miniblock hash", "error", err.Error())
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			tx, found := txPool[string(txHash)]
+			if !found || check.IfNil(tx) {
+				continue
+			}
+
+			txsRows = append(txsRows, []interface{}{
+				hex.EncodeToString(txHash),
+				blockHash,
+				hex.EncodeToString(miniBlockHash),
+				miniBlock.Type.String(),
+				tx.GetNonce(),
+				bigIntToString(tx.GetValue()),
+				pd.encodeAddress(tx.GetSndAddr()),
+				pd.encodeAddress(tx.GetRcvAddr()),
+				miniBlock.SenderShardID,
+				miniBlock.ReceiverShardID,
+				tx.GetGasPrice(),
+				tx.GetGasLimit(),
+				tx.GetData(),
+				header.GetTimeStamp(),
+			})
+
+			// the transfers are recorded only by the sender shard, so a cross shard transfer is recorded once
+			isSentFromSelfShard := miniBlock.Type == block.TxBlock && miniBlock.SenderShardID == header.GetShardID()
+			if isSentFromSelfShard {
+				esdtRows = append(esdtRows, pd.createESDTTransferRows(txHash, tx, blockHash)...)
+			}
+		}
+	}
+
+	statements := createBatchStatements(insertTransactionsPrefix, insertTransactionsSuffix, nil, txsRows)
+
+	return append(statements, createBatchStatements(insertESDTPrefix, insertESDTSuffix, nil, esdtRows)...)
+}
+
+func (pd *postgresDriver) createESDTTransferRows(txHash []byte, tx data.TransactionHandler, blockHash string) [][]interface{} {
+	if len(tx.GetData()) == 0 {
+		return nil
+	}
+
+	function, args, err := pd.argsParser.ParseData(string(tx.GetData()))
+	if err != nil || function != core.BuiltInFunctionESDTTransfer || len(args) < 2 {
+		return nil
+	}
+
+	return [][]interface{}{{
+		hex.EncodeToString(txHash),
+		blockHash,
+		string(args[0]),
+		big.NewInt(0).SetBytes(args[1]).String(),
+		pd.encodeAddress(tx.GetSndAddr()),
+		pd.encodeAddress(tx.GetRcvAddr()),
+	}}
+}
+
+func (pd *postgresDriver) createEventsStatements(
+	txPool map[string]data.TransactionHandler,
+	txLogsProc process.TransactionLogProcessorDatabase,
+	blockHash string,
+) []*Statement {
+	if check.IfNil(txLogsProc) {
+		return nil
+	}
+
+	rows := make([][]interface{}, 0)
+	for txHashStr := range txPool {
+		txLog, found := txLogsProc.GetLogFromCache([]byte(txHashStr))
+		if !found || check.IfNil(txLog) {
+			continue
+		}
+
+		for index, event := range txLog.GetLogEvents() {
+			if check.IfNil(event) {
+				continue
+			}
+
+			topics := make([]string, 0, len(event.GetTopics()))
+			for _, topic := range event.GetTopics() {
+				topics = append(topics, hex.EncodeToString(topic))
+			}
+
+			rows = append(rows, []interface{}{
+				hex.EncodeToString([]byte(txHashStr)),
+				blockHash,
+				index,
+				pd.encodeAddress(event.GetAddress()),
+				string(event.GetIdentifier()),
+				pq.StringArray(topics),
+				event.GetData(),
+			})
+		}
+	}
+
+	return createBatchStatements(insertEventsPrefix, insertEventsSuffix, nil, rows)
+}
+
+// createAccountsStatements loads the accounts of the self shard modified by the block. The balance deltas are computed
+// by the database, against the previously written balances, before the accounts are updated
+func (pd *postgresDriver) createAccountsStatements(
+	txPool map[string]data.TransactionHandler,
+	header data.HeaderHandler,
+	blockHash string,
+) []*Statement {
+	selfShardID := pd.shardCoordinator.SelfId()
+	addresses := make(map[string]struct{})
+	for _, tx := range txPool {
+		if check.IfNil(tx) {
+			continue
+		}
+
+		for _, address := range [][]byte{tx.GetSndAddr(), tx.GetRcvAddr()} {
+			if len(address) == 0 || pd.shardCoordinator.ComputeId(address) != selfShardID {
+				continue
+			}
+
+			addresses[string(address)] = struct{}{}
+		}
+	}
+
+	deltasRows := make([][]interface{}, 0, len(addresses))
+	accountsRows := make([][]interface{}, 0, len(addresses))
+	for address := range addresses {
+		account, err := pd.accounts.LoadAccount([]byte(address))
+		if err != nil {
+			log.Warn("postgresDriver: can not load account", "address", []byte(address), "error", err.Error())
+			continue
+		}
+
+		userAccount, ok := account.(state.UserAccountHandler)
+		if !ok {
+			continue
+		}
+
+		encodedAddress := pd.encodeAddress([]byte(address))
+		balance := bigIntToString(userAccount.GetBalance())
+		deltasRows = append(deltasRows, []interface{}{encodedAddress, blockHash, header.GetNonce(), selfShardID, balance})
+		accountsRows = append(accountsRows, []interface{}{encodedAddress, selfShardID, userAccount.GetNonce(), balance, blockHash})
+	}
+
+	statements := createBatchStatements(insertDeltasPrefix, insertDeltasSuffix, deltasCasts, deltasRows)
+
+	return append(statements, createBatchStatements(upsertAccountsPrefix, upsertAccountsSuffix, nil, accountsRows)...)
+}
+
+// RevertIndexedBlock marks the provided block as reverted, deletes its rows and restores the balances of its accounts
+func (pd *postgresDriver) RevertIndexedBlock(header data.HeaderHandler, _ data.BodyHandler) {
+	if check.IfNil(header) {
+		return
+	}
+
+	headerHash, err := core.CalculateHash(pd.marshalizer, pd.hasher, header)
+	if err != nil {
+		log.Warn("postgresDriver.RevertIndexedBlock: can not compute the header hash", "error", err.Error())
+		return
+	}
+
+	args := []interface{}{hex.EncodeToString(headerHash)}
+	pd.enqueue([]*Statement{
+		{Query: revertAccountsBalances, Args: args},
+		{Query: deleteBlockDeltas, Args: args},
+		{Query: deleteBlockESDT, Args: args},
+		{Query: deleteBlockEvents, Args: args},
+		{Query: deleteBlockTransactions, Args: args},
+		{Query: markBlockReverted, Args: args},
+	})
+}
+
+func (pd *postgresDriver) enqueue(statements []*Statement) {
+	buff, err := serializeStatements(statements)
+	if err != nil {
+		log.Warn("postgresDriver: can not serialize the statements", "error", err.Error())
+		return
+	}
+
+	pd.pendingItems.Add()
+	err = pd.buffer.Append(buff)
+	if err != nil {
+		pd.pendingItems.Done()
+	}
+	if err != nil && err != deliveryBuffer.ErrBufferFull {
+		log.Warn("postgresDriver: can not buffer the statements", "error", err.Error())
+	}
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+func (pd *postgresDriver) encodeAddress(address []byte) string {
+	if len(address) != pd.pubkeyConverter.Len() {
+		return hex.EncodeToString(address)
+	}
+
+	return pd.pubkeyConverter.Encode(address)
+}
+
+func (pd *postgresDriver) writeLoop(ctx context.Context) {
+	pd.retry(ctx, "apply schema migrations", func() error {
+		return ApplyMigrations(ctx, pd.database, Migrations)
+	})
+
+	for {
+		buff, err := pd.buffer.Peek(ctx)
+		if ctx.Err() != nil || err == deliveryBuffer.ErrBufferClosed {
+			log.Debug("postgresDriver's go routine is stopping...")
+			return
+		}
+		if err != nil {
+			log.Warn("postgresDriver could not read the delivery buffer (will retry)", "error", err.Error())
+			pd.waitRetry(ctx)
+			continue
+		}
+
+		statements, err := deserializeStatements(buff)
+		if err != nil {
+			log.Error("postgresDriver: dropping a corrupted buffered item", "error", err.Error())
+		} else {
+			pd.retry(ctx, "write block", func() error {
+				return pd.database.ExecInTransaction(ctx, statements)
+			})
+		}
+		if ctx.Err() != nil {
+			// the statements were not written, they stay in the buffer
+			log.Debug("postgresDriver's go routine is stopping...")
+			return
+		}
+
+		err = pd.buffer.Pop()
+		if err != nil {
+			log.Warn("postgresDriver could not remove a written item from the delivery buffer", "error", err.Error())
+		}
+		pd.pendingItems.Done()
+	}
+}
+
+func (pd *postgresDriver) retry(ctx context.Context, operation string, handler func() error) {
+	for {
+		err := handler()
+		if err == nil {
+			pd.retryBackOff.Reset()
+			pd.health.DeliverySucceeded()
+			return
+		}
+
+		log.Warn("postgresDriver could not "+operation+" (will retry)", "error", err.Error())
+
+		pd.health.DeliveryFailed(err)
+		pd.waitRetry(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+func (pd *postgresDriver) waitRetry(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(pd.retryBackOff.NextInterval()):
+	}
+}
+
+// DriverStatus returns the health of the writes in the PostgreSQL database
+func (pd *postgresDriver) DriverStatus() indexer.DriverStatus {
+	status := pd.health.Status(pd.pendingItems.Len())
+	status.DroppedItems = pd.buffer.NumDroppedItems()
+
+	return status
+}
+
+// Flush blocks until all the queued blocks are written or the context is done
+func (pd *postgresDriver) Flush(ctx context.Context) error {
+	return pd.pendingItems.Wait(ctx)
+}
+
+// Close stops writing and closes the delivery buffer and the database. The blocks not yet written are kept only by a
+// write-ahead log
+func (pd *postgresDriver) Close() error {
+	pd.cancelFunc()
+
+	errBuffer := pd.buffer.Close()
+	errDatabase := pd.database.Close()
+	if errBuffer != nil {
+		return errBuffer
+	}
+
+	return errDatabase
+}
+
+// IsNilIndexer returns false as the PostgreSQL driver is a real indexer implementation
+func (pd *postgresDriver) IsNilIndexer() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pd *postgresDriver) IsInterfaceNil() bool {
+	return pd == nil
+}
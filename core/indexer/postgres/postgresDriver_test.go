@@ -0,0 +1,424 @@
+package postgres
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/deliveryBuffer"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const waitWrite = time.Second
+
+type writtenTransactions struct {
+	mut          sync.Mutex
+	transactions [][]*Statement
+	chanDone     chan struct{}
+}
+
+func newWrittenTransactions() *writtenTransactions {
+	return &writtenTransactions{
+		transactions: make([][]*Statement, 0),
+		chanDone:     make(chan struct{}, 100),
+	}
+}
+
+func (wt *writtenTransactions) exec(_ context.Context, statements []*Statement) error {
+	isMigration := statements[0].Query == createMigrationsTable || statements[len(statements)-1].Query == insertMigration
+	if isMigration {
+		return nil
+	}
+
+	wt.mut.Lock()
+	wt.transactions = append(wt.transactions, statements)
+	wt.mut.Unlock()
+
+	wt.chanDone <- struct{}{}
+
+	return nil
+}
+
+func (wt *writtenTransactions) waitWrites(t *testing.T, numWrites int) [][]*Statement {
+	for i := 0; i < numWrites; i++ {
+		select {
+		case <-wt.chanDone:
+		case <-time.After(waitWrite):
+			require.Fail(t, "timeout while waiting for the writes")
+		}
+	}
+
+	wt.mut.Lock()
+	defer wt.mut.Unlock()
+
+	return wt.transactions
+}
+
+func createMockArgs() ArgsPostgresDriver {
+	return ArgsPostgresDriver{
+		Database:         &databaseStub{},
+		Marshalizer:      &mock.MarshalizerMock{},
+		Hasher:           &mock.HasherMock{},
+		PubkeyConverter:  mock.NewPubkeyConverterMock(4),
+		ShardCoordinator: &mock.ShardCoordinatorMock{},
+		Accounts:         &mock.AccountsStub{},
+		Buffer:           createMemoryBuffer(),
+		RetryInterval:    time.Millisecond,
+		MaxRetryInterval: time.Millisecond * 4,
+	}
+}
+
+func createMemoryBuffer() indexer.DeliveryBuffer {
+	buffer, _ := deliveryBuffer.NewMemoryBuffer(10)
+
+	return buffer
+}
+
+func findStatement(statements []*Statement, queryPrefix string) *Statement {
+	for _, statement := range statements {
+		if strings.HasPrefix(statement.Query, queryPrefix) {
+			return statement
+		}
+	}
+
+	return nil
+}
+
+func TestNewPostgresDriver_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Database = nil
+	pd, err := NewPostgresDriver(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, ErrNilDatabase, err)
+
+	args = createMockArgs()
+	args.Marshalizer = nil
+	pd, err = NewPostgresDriver(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	args = createMockArgs()
+	args.Hasher = nil
+	pd, err = NewPostgresDriver(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, ErrNilHasher, err)
+
+	args = createMockArgs()
+	args.PubkeyConverter = nil
+	pd, err = NewPostgresDriver(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+
+	args = createMockArgs()
+	args.ShardCoordinator = nil
+	pd, err = NewPostgresDriver(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, ErrNilShardCoordinator, err)
+
+	args = createMockArgs()
+	args.Accounts = nil
+	pd, err = NewPostgresDriver(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, ErrNilAccountsLoader, err)
+
+	args = createMockArgs()
+	args.Buffer = nil
+	pd, err = NewPostgresDriver(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, ErrNilBuffer, err)
+
+	args = createMockArgs()
+	args.RetryInterval = 0
+	pd, err = NewPostgresDriver(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, ErrInvalidRetryInterval, err)
+
+	args = createMockArgs()
+	args.MaxRetryInterval = args.RetryInterval / 2
+	pd, err = NewPostgresDriver(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, ErrInvalidRetryInterval, err)
+
+	pd, err = NewPostgresDriver(createMockArgs())
+	assert.False(t, check.IfNil(pd))
+	assert.Nil(t, err)
+	assert.False(t, pd.IsNilIndexer())
+	_ = pd.Close()
+}
+
+func TestPostgresDriver_SaveBlockShouldWriteTheBlockInOneTransaction(t *testing.T) {
+	t.Parallel()
+
+	written := newWrittenTransactions()
+	sender := []byte("sndr")
+	receiver := []byte("rcvr")
+	args := createMockArgs()
+	args.Database = &databaseStub{ExecInTransactionCalled: written.exec}
+	args.ShardCoordinator = &mock.ShardCoordinatorMock{
+		ComputeIdCalled: func(address []byte) uint32 {
+			if string(address) == string(sender) {
+				return 0
+			}
+			return 1
+		},
+	}
+	args.Accounts = &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			account, _ := state.NewUserAccount(address)
+			_ = account.AddToBalance(big.NewInt(90))
+			return account, nil
+		},
+	}
+	args.CleanTxLogs = true
+	pd, _ := NewPostgresDriver(args)
+	defer func() {
+		_ = pd.Close()
+	}()
+
+	cleanCalled := false
+	pd.SetTxLogsProcessor(&mock.TxLogsProcessorDatabaseStub{
+		GetLogFromCacheCalled: func(txHash []byte) (data.LogHandler, bool) {
+			txLog := &transaction.Log{
+				Events: []*transaction.Event{{Address: receiver, Identifier: []byte("transfer"), Topics: [][]byte{[]byte("t")}}},
+			}
+			return txLog, string(txHash) == "tx1"
+		},
+		CleanCalled: func() {
+			cleanCalled = true
+		},
+	})
+
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("tx1"), []byte("tx2")}, SenderShardID: 0, ReceiverShardID: 1},
+		},
+	}
+	txPool := map[string]data.TransactionHandler{
+		"tx1": &transaction.Transaction{Nonce: 7, Value: big.NewInt(10), SndAddr: sender, RcvAddr: receiver},
+		"tx2": &transaction.Transaction{
+			SndAddr: sender,
+			RcvAddr: receiver,
+			Data:    []byte("ESDTTransfer@" + hex.EncodeToString([]byte("TKN-1")) + "@64"),
+		},
+	}
+	pd.SaveBlock(body, &block.Header{Nonce: 3}, txPool, nil, nil, []byte("hash"))
+	assert.True(t, cleanCalled)
+
+	transactions := written.waitWrites(t, 1)
+	require.Equal(t, 1, len(transactions))
+	statements := transactions[0]
+	blockHash := hex.EncodeToString([]byte("hash"))
+
+	blockStatement := findStatement(statements, "INSERT INTO blocks")
+	require.NotNil(t, blockStatement)
+	assert.Equal(t, blockHash, blockStatement.Args[0])
+	assert.Equal(t, uint64(3), blockStatement.Args[2])
+	assert.Equal(t, pq.StringArray{}, blockStatement.Args[8])
+
+	txsStatement := findStatement(statements, "INSERT INTO transactions")
+	require.NotNil(t, txsStatement)
+	assert.Equal(t, 2*14, len(txsStatement.Args))
+
+	esdtStatement := findStatement(statements, "INSERT INTO esdt_transfers")
+	require.NotNil(t, esdtStatement)
+	expectedESDTArgs := []interface{}{
+		hex.EncodeToString([]byte("tx2")),
+		blockHash,
+		"TKN-1",
+		"100",
+		hex.EncodeToString(sender),
+		hex.EncodeToString(receiver),
+	}
+	assert.Equal(t, expectedESDTArgs, esdtStatement.Args)
+
+	eventsStatement := findStatement(statements, "INSERT INTO events")
+	require.NotNil(t, eventsStatement)
+	assert.Equal(t, "transfer", eventsStatement.Args[4])
+	assert.Equal(t, pq.StringArray{hex.EncodeToString([]byte("t"))}, eventsStatement.Args[5])
+
+	deltasStatement := findStatement(statements, "INSERT INTO account_balance_deltas")
+	require.NotNil(t, deltasStatement)
+	assert.Equal(t, []interface{}{hex.EncodeToString(sender), blockHash, uint64(3), uint32(0), "90"}, deltasStatement.Args)
+
+	accountsStatement := findStatement(statements, "INSERT INTO accounts")
+	require.NotNil(t, accountsStatement)
+	assert.Equal(t, []interface{}{hex.EncodeToString(sender), uint32(0), uint64(0), "90", blockHash}, accountsStatement.Args)
+}
+
+func TestPostgresDriver_RevertIndexedBlockShouldDeleteTheBlockRows(t *testing.T) {
+	t.Parallel()
+
+	written := newWrittenTransactions()
+	args := createMockArgs()
+	args.Database = &databaseStub{ExecInTransactionCalled: written.exec}
+	pd, _ := NewPostgresDriver(args)
+	defer func() {
+		_ = pd.Close()
+	}()
+
+	pd.RevertIndexedBlock(&block.Header{Nonce: 4}, nil)
+
+	transactions := written.waitWrites(t, 1)
+	require.Equal(t, 1, len(transactions))
+	statements := transactions[0]
+	require.Equal(t, 6, len(statements))
+	assert.Equal(t, revertAccountsBalances, statements[0].Query)
+	assert.Equal(t, markBlockReverted, statements[5].Query)
+	for _, statement := range statements {
+		assert.Equal(t, statements[0].Args, statement.Args)
+	}
+}
+
+func TestPostgresDriver_ShouldRetryUntilTheWritesSucceed(t *testing.T) {
+	t.Parallel()
+
+	written := newWrittenTransactions()
+	numMigrationFailures := 0
+	numWriteFailures := 0
+	args := createMockArgs()
+	args.Database = &databaseStub{
+		AppliedMigrationsCalled: func(_ context.Context) ([]uint32, error) {
+			if numMigrationFailures < 2 {
+				numMigrationFailures++
+				return nil, errors.New("database not available")
+			}
+
+			return nil, nil
+		},
+		ExecInTransactionCalled: func(ctx context.Context, statements []*Statement) error {
+			isBlockWrite := strings.HasPrefix(statements[0].Query, "INSERT INTO blocks")
+			if isBlockWrite && numWriteFailures < 2 {
+				numWriteFailures++
+				return errors.New("serialization failure")
+			}
+
+			return written.exec(ctx, statements)
+		},
+	}
+	pd, _ := NewPostgresDriver(args)
+	defer func() {
+		_ = pd.Close()
+	}()
+
+	pd.SaveBlock(nil, &block.Header{Nonce: 1}, nil, nil, nil, []byte("hash1"))
+	pd.SaveBlock(nil, &block.Header{Nonce: 2}, nil, nil, nil, []byte("hash2"))
+
+	transactions := written.waitWrites(t, 2)
+	require.Equal(t, 2, len(transactions))
+	assert.Equal(t, uint64(1), transactions[0][0].Args[2])
+	assert.Equal(t, uint64(2), transactions[1][0].Args[2])
+}
+
+func TestPostgresDriver_UndeliveredBlocksShouldBeWrittenAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	persister := memorydb.New()
+	args := createMockArgs()
+	args.Buffer, _ = deliveryBuffer.NewWriteAheadLog(deliveryBuffer.ArgsWriteAheadLog{
+		Persister:      persister,
+		MaxSizeInBytes: 1024 * 1024,
+	})
+	args.Database = &databaseStub{
+		ExecInTransactionCalled: func(_ context.Context, statements []*Statement) error {
+			isMigration := statements[0].Query == createMigrationsTable || statements[len(statements)-1].Query == insertMigration
+			if isMigration {
+				return nil
+			}
+
+			return errors.New("database not available")
+		},
+	}
+	pd, _ := NewPostgresDriver(args)
+
+	pd.RevertIndexedBlock(&block.Header{Nonce: 1}, nil)
+	pd.RevertIndexedBlock(&block.Header{Nonce: 2}, nil)
+	_ = pd.Close()
+
+	written := newWrittenTransactions()
+	args = createMockArgs()
+	args.Buffer, _ = deliveryBuffer.NewWriteAheadLog(deliveryBuffer.ArgsWriteAheadLog{
+		Persister:      persister,
+		MaxSizeInBytes: 1024 * 1024,
+	})
+	args.Database = &databaseStub{ExecInTransactionCalled: written.exec}
+	pd, _ = NewPostgresDriver(args)
+	defer func() {
+		_ = pd.Close()
+	}()
+
+	transactions := written.waitWrites(t, 2)
+	require.Equal(t, 2, len(transactions))
+	for i, nonce := range []uint64{1, 2} {
+		headerHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, &block.Header{Nonce: nonce})
+		assert.Equal(t, []interface{}{hex.EncodeToString(headerHash)}, transactions[i][0].Args)
+	}
+}
+
+func TestPostgresDriver_FlushShouldWaitForTheWrites(t *testing.T) {
+	t.Parallel()
+
+	written := newWrittenTransactions()
+	numWriteFailures := 0
+	args := createMockArgs()
+	args.Database = &databaseStub{
+		ExecInTransactionCalled: func(ctx context.Context, statements []*Statement) error {
+			isBlockWrite := strings.HasPrefix(statements[0].Query, "INSERT INTO blocks")
+			if isBlockWrite && numWriteFailures < 2 {
+				numWriteFailures++
+				return errors.New("serialization failure")
+			}
+
+			return written.exec(ctx, statements)
+		},
+	}
+	pd, _ := NewPostgresDriver(args)
+	defer func() {
+		_ = pd.Close()
+	}()
+
+	pd.SaveBlock(nil, &block.Header{Nonce: 1}, nil, nil, nil, []byte("hash1"))
+	pd.RevertIndexedBlock(&block.Header{Nonce: 1}, nil)
+
+	err := pd.Flush(context.Background())
+	assert.Nil(t, err)
+
+	written.mut.Lock()
+	defer written.mut.Unlock()
+	assert.Equal(t, 2, len(written.transactions))
+}
+
+func TestPostgresDriver_CloseShouldCloseTheDatabase(t *testing.T) {
+	t.Parallel()
+
+	closeCalled := false
+	args := createMockArgs()
+	args.Database = &databaseStub{
+		CloseCalled: func() error {
+			closeCalled = true
+			return nil
+		},
+	}
+	pd, _ := NewPostgresDriver(args)
+
+	err := pd.Close()
+	assert.Nil(t, err)
+	assert.True(t, closeCalled)
+}
@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// maxParametersPerStatement is kept well below the PostgreSQL limit of 65535 bind parameters
+const maxParametersPerStatement = 30000
+
+// Statement is a SQL statement together with its arguments
+type Statement struct {
+	Query string
+	Args  []interface{}
+}
+
+func init() {
+	// the statements are serialized in the delivery buffer, so the argument types which are not built in must be
+	// registered
+	gob.Register(pq.StringArray{})
+}
+
+// serializedStatement records the empty slice arguments apart, as the gob encoding decodes them as nil slices which
+// would be written as NULL
+type serializedStatement struct {
+	Query     string
+	Args      []interface{}
+	EmptyArgs []int
+}
+
+// serializeStatements encodes the provided statements so they can be kept in the delivery buffer
+func serializeStatements(statements []*Statement) ([]byte, error) {
+	serialized := make([]*serializedStatement, 0, len(statements))
+	for _, statement := range statements {
+		serializedSt := &serializedStatement{
+			Query: statement.Query,
+			Args:  statement.Args,
+		}
+		for i, arg := range statement.Args {
+			value := reflect.ValueOf(arg)
+			if value.Kind() == reflect.Slice && !value.IsNil() && value.Len() == 0 {
+				serializedSt.EmptyArgs = append(serializedSt.EmptyArgs, i)
+			}
+		}
+
+		serialized = append(serialized, serializedSt)
+	}
+
+	buff := &bytes.Buffer{}
+	err := gob.NewEncoder(buff).Encode(serialized)
+	if err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+// deserializeStatements decodes the statements kept in the delivery buffer
+func deserializeStatements(buff []byte) ([]*Statement, error) {
+	serialized := make([]*serializedStatement, 0)
+	err := gob.NewDecoder(bytes.NewReader(buff)).Decode(&serialized)
+	if err != nil {
+		return nil, err
+	}
+
+	statements := make([]*Statement, 0, len(serialized))
+	for _, serializedSt := range serialized {
+		for _, index := range serializedSt.EmptyArgs {
+			if index >= len(serializedSt.Args) || serializedSt.Args[index] == nil {
+				return nil, fmt.Errorf("%w: empty argument %d", ErrInvalidSerializedStatement, index)
+			}
+
+			argType := reflect.TypeOf(serializedSt.Args[index])
+			serializedSt.Args[index] = reflect.MakeSlice(argType, 0, 0).Interface()
+		}
+
+		statements = append(statements, &Statement{
+			Query: serializedSt.Query,
+			Args:  serializedSt.Args,
+		})
+	}
+
+	return statements, nil
+}
+
+// createBatchStatements creates multi-row statements of the form prefix + "($1, $2), ($3, $4)" + suffix. The rows are
+// split on more statements if they need too many parameters. The casts, if provided, are appended to the placeholders
+// of the corresponding columns
+func createBatchStatements(prefix string, suffix string, casts []string, rows [][]interface{}) []*Statement {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	numColumns := len(rows[0])
+	rowsPerStatement := maxParametersPerStatement / numColumns
+
+	statements := make([]*Statement, 0, len(rows)/rowsPerStatement+1)
+	for start := 0; start < len(rows); start += rowsPerStatement {
+		end := start + rowsPerStatement
+		if end > len(rows) {
+			end = len(rows)
+		}
+
+		statements = append(statements, createBatchStatement(prefix, suffix, casts, rows[start:end]))
+	}
+
+	return statements
+}
+
+func createBatchStatement(prefix string, suffix string, casts []string, rows [][]interface{}) *Statement {
+	builder := strings.Builder{}
+	builder.WriteString(prefix)
+
+	args := make([]interface{}, 0, len(rows)*len(rows[0]))
+	for i, row := range rows {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+
+		builder.WriteString("(")
+		for j, value := range row {
+			if j > 0 {
+				builder.WriteString(", ")
+			}
+
+			args = append(args, value)
+			builder.WriteString(fmt.Sprintf("$%d", len(args)))
+			if j < len(casts) {
+				builder.WriteString(casts[j])
+			}
+		}
+		builder.WriteString(")")
+	}
+
+	builder.WriteString(suffix)
+
+	return &Statement{
+		Query: builder.String(),
+		Args:  args,
+	}
+}
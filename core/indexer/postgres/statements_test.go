@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateBatchStatements_NoRowsShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, createBatchStatements("INSERT INTO t (a) VALUES ", "", nil, nil))
+}
+
+func TestCreateBatchStatements_ShouldNumberThePlaceholdersAndApplyTheCasts(t *testing.T) {
+	t.Parallel()
+
+	rows := [][]interface{}{{"a", 1}, {"b", 2}}
+	statements := createBatchStatements("SELECT * FROM (VALUES ", ") AS v (x, y)", []string{"::TEXT"}, rows)
+
+	require.Equal(t, 1, len(statements))
+	assert.Equal(t, "SELECT * FROM (VALUES ($1::TEXT, $2), ($3::TEXT, $4)) AS v (x, y)", statements[0].Query)
+	assert.Equal(t, []interface{}{"a", 1, "b", 2}, statements[0].Args)
+}
+
+func TestCreateBatchStatements_TooManyParametersShouldSplit(t *testing.T) {
+	t.Parallel()
+
+	numColumns := 3
+	rowsPerStatement := maxParametersPerStatement / numColumns
+	rows := make([][]interface{}, 0, rowsPerStatement+1)
+	for i := 0; i < rowsPerStatement+1; i++ {
+		rows = append(rows, []interface{}{i, i, i})
+	}
+
+	statements := createBatchStatements("INSERT INTO t (a, b, c) VALUES ", "", nil, rows)
+
+	require.Equal(t, 2, len(statements))
+	assert.Equal(t, rowsPerStatement*numColumns, len(statements[0].Args))
+	assert.Equal(t, "INSERT INTO t (a, b, c) VALUES ($1, $2, $3)", statements[1].Query)
+	assert.Equal(t, []interface{}{rowsPerStatement, rowsPerStatement, rowsPerStatement}, statements[1].Args)
+}
+
+func TestSerializeStatements_ShouldKeepTheEmptySlices(t *testing.T) {
+	t.Parallel()
+
+	statements := []*Statement{
+		{
+			Query: "INSERT INTO t (a, b, c, d, e) VALUES ($1, $2, $3, $4, $5)",
+			Args:  []interface{}{uint32(1), pq.StringArray{}, pq.StringArray(nil), []byte{}, nil},
+		},
+		{
+			Query: "DELETE FROM t WHERE a = $1",
+			Args:  []interface{}{pq.StringArray{"aa", "bb"}},
+		},
+	}
+
+	buff, err := serializeStatements(statements)
+	require.Nil(t, err)
+
+	deserialized, err := deserializeStatements(buff)
+	require.Nil(t, err)
+	assert.Equal(t, statements, deserialized)
+}
+
+func TestDeserializeStatements_InvalidDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	deserialized, err := deserializeStatements([]byte("invalid"))
+	assert.NotNil(t, err)
+	assert.Nil(t, deserialized)
+}
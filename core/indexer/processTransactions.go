@@ -104,7 +104,7 @@ func (tdp *txDatabaseProcessor) prepareTransactionsForDatabase(
 
 		if isRelayedTx(tx) {
 			tx.GasUsed = tx.GasLimit
-			fee := tdp.txFeeCalculator.ComputeTxFeeBasedOnGasUsed(tx, tx.GasUsed)
+			fee := tdp.txFeeCalculator.ComputeTxFeeBasedOnGasUsedInEpoch(tx, tx.GasUsed, tx.epoch)
 			tx.Fee = fee.String()
 
 			continue
@@ -122,7 +122,7 @@ func (tdp *txDatabaseProcessor) prepareTransactionsForDatabase(
 			tx.Status = transaction.TxStatusFail.String()
 
 			tx.GasUsed = tx.GasLimit
-			fee := tdp.txFeeCalculator.ComputeTxFeeBasedOnGasUsed(tx, tx.GasUsed)
+			fee := tdp.txFeeCalculator.ComputeTxFeeBasedOnGasUsedInEpoch(tx, tx.GasUsed, tx.epoch)
 			tx.Fee = fee.String()
 		}
 	}
@@ -197,7 +197,7 @@ func (tdp *txDatabaseProcessor) addScResultInfoInTx(scHash string, scr *smartCon
 
 	if isSCRForSenderWithRefund(dbScResult, tx) {
 		refundValue := stringValueToBigInt(dbScResult.Value)
-		gasUsed, fee := tdp.txFeeCalculator.ComputeGasUsedAndFeeBasedOnRefundValue(tx, refundValue)
+		gasUsed, fee := tdp.txFeeCalculator.ComputeGasUsedAndFeeBasedOnRefundValueInEpoch(tx, refundValue, tx.epoch)
 		tx.GasUsed = gasUsed
 		tx.Fee = fee.String()
 	}
@@ -297,7 +297,7 @@ func (tdp *txDatabaseProcessor) groupNormalTxsAndRewards(
 				addToAlteredAddresses(dbTx, alteredAddresses, mb, selfShardID, false)
 
 				dbTx.GasUsed = dbTx.GasLimit
-				fee := tdp.commonProcessor.txFeeCalculator.ComputeTxFeeBasedOnGasUsed(tx, dbTx.GasUsed)
+				fee := tdp.commonProcessor.txFeeCalculator.ComputeTxFeeBasedOnGasUsedInEpoch(tx, dbTx.GasUsed, header.GetEpoch())
 				dbTx.Fee = fee.String()
 
 				transactions[hash] = dbTx
@@ -0,0 +1,35 @@
+package push
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+)
+
+type disabledPushNotifier struct {
+	*indexer.NilIndexer
+}
+
+// NewDisabledPushNotifier returns a push notifier that does not accept any subscription
+func NewDisabledPushNotifier() *disabledPushNotifier {
+	return &disabledPushNotifier{
+		NilIndexer: indexer.NewNilIndexer(),
+	}
+}
+
+// Subscribe returns ErrPushNotificationsDisabled
+func (dpn *disabledPushNotifier) Subscribe(_ Filter) (*Subscription, error) {
+	return nil, ErrPushNotificationsDisabled
+}
+
+// Unsubscribe does nothing
+func (dpn *disabledPushNotifier) Unsubscribe(_ *Subscription) {
+}
+
+// NotifyPeerStatus does nothing
+func (dpn *disabledPushNotifier) NotifyPeerStatus(_ *heartbeatData.PeerStatusEvent) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dpn *disabledPushNotifier) IsInterfaceNil() bool {
+	return dpn == nil
+}
@@ -0,0 +1,30 @@
+package push
+
+import "errors"
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrInvalidMaxSubscribers signals that an invalid maximum number of subscribers has been provided
+var ErrInvalidMaxSubscribers = errors.New("invalid maximum number of subscribers")
+
+// ErrInvalidSubscriberBufferSize signals that an invalid subscriber buffer size has been provided
+var ErrInvalidSubscriberBufferSize = errors.New("invalid subscriber buffer size")
+
+// ErrNoChannels signals that a subscription without any channel was requested
+var ErrNoChannels = errors.New("no channels provided")
+
+// ErrUnknownChannel signals that a subscription to an unknown channel was requested
+var ErrUnknownChannel = errors.New("unknown channel")
+
+// ErrInvalidAddress signals that the address of a subscription filter could not be decoded
+var ErrInvalidAddress = errors.New("invalid address")
+
+// ErrTooManySubscribers signals that the maximum number of subscribers has been reached
+var ErrTooManySubscribers = errors.New("too many subscribers")
+
+// ErrPushNotificationsDisabled signals that the push notifications are disabled on this node
+var ErrPushNotificationsDisabled = errors.New("push notifications are disabled")
+
+// ErrNilSubscription signals that a nil subscription has been provided
+var ErrNilSubscription = errors.New("nil subscription")
@@ -0,0 +1,14 @@
+package push
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+)
+
+// Notifier defines a component that is fed by the indexing pipeline and delivers the resulting events to its subscribers
+type Notifier interface {
+	indexer.Indexer
+	Subscribe(filter Filter) (*Subscription, error)
+	Unsubscribe(subscription *Subscription)
+	NotifyPeerStatus(event *heartbeatData.PeerStatusEvent)
+}
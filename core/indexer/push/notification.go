@@ -0,0 +1,82 @@
+package push
+
+const (
+	// ChannelHyperblocks is the channel on which the metachain blocks are pushed together with the notarized shard blocks
+	ChannelHyperblocks = "hyperblocks"
+	// ChannelBlocks is the channel on which all the committed blocks are pushed
+	ChannelBlocks = "blocks"
+	// ChannelEvents is the channel on which the log events generated by the transactions are pushed
+	ChannelEvents = "events"
+	// ChannelTxStatus is the channel on which the status changes of the transactions are pushed
+	ChannelTxStatus = "txStatus"
+	// ChannelPeerStatus is the channel on which the liveness changes of the public keys tracked by the heartbeat
+	// monitor are pushed
+	ChannelPeerStatus = "peerStatus"
+)
+
+var knownChannels = map[string]struct{}{
+	ChannelHyperblocks: {},
+	ChannelBlocks:      {},
+	ChannelEvents:      {},
+	ChannelTxStatus:    {},
+	ChannelPeerStatus:  {},
+}
+
+// Filter holds the options of a subscription. The shard ID applies to the blocks and peer status channels, the address
+// applies to the events and tx status channels while the identifier applies only to the events channel
+type Filter struct {
+	Channels   []string
+	ShardID    *uint32
+	Address    string
+	Identifier string
+}
+
+// Event is the envelope of every message pushed to the subscribers
+type Event struct {
+	Channel string      `json:"channel"`
+	Data    interface{} `json:"data"`
+}
+
+// BlockNotification holds the main information about a committed block
+type BlockNotification struct {
+	Hash      string `json:"hash"`
+	PrevHash  string `json:"prevHash"`
+	Nonce     uint64 `json:"nonce"`
+	Round     uint64 `json:"round"`
+	Epoch     uint32 `json:"epoch"`
+	ShardID   uint32 `json:"shardID"`
+	NumTxs    uint32 `json:"numTxs"`
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// NotarizedBlockNotification holds the information about a shard block notarized by a metachain block
+type NotarizedBlockNotification struct {
+	Hash    string `json:"hash"`
+	Nonce   uint64 `json:"nonce"`
+	ShardID uint32 `json:"shardID"`
+}
+
+// HyperblockNotification holds the information about a metachain block and the shard blocks it notarized
+type HyperblockNotification struct {
+	BlockNotification
+	NotarizedBlocks []*NotarizedBlockNotification `json:"notarizedBlocks"`
+}
+
+// LogEventNotification holds a log event generated by a transaction
+type LogEventNotification struct {
+	TxHash     string   `json:"txHash"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+}
+
+// TxStatusNotification holds the new status of a transaction
+type TxStatusNotification struct {
+	TxHash    string `json:"txHash"`
+	Status    string `json:"status"`
+	Sender    string `json:"sender,omitempty"`
+	Receiver  string `json:"receiver,omitempty"`
+	BlockHash string `json:"blockHash"`
+	ShardID   uint32 `json:"shardID"`
+}
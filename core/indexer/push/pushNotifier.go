@@ -0,0 +1,546 @@
+package push
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/deliveryBuffer"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var log = logger.GetOrCreate("core/indexer/push")
+
+const (
+	driverName          = "push"
+	bufferRetryInterval = time.Second
+)
+
+// ArgsPushNotifier is the DTO used to create a new instance of pushNotifier
+type ArgsPushNotifier struct {
+	PubkeyConverter      core.PubkeyConverter
+	MaxSubscribers       uint32
+	SubscriberBufferSize uint32
+	// CleanTxLogs should be set when the push notifier is the last consumer of the cached transaction logs
+	CleanTxLogs bool
+	// Buffer, if set, holds the events until at least one subscriber is connected, so a write-ahead log keeps them
+	// across restarts
+	Buffer indexer.DeliveryBuffer
+}
+
+// bufferedEvent is an event serialized in the delivery buffer, so its fields are exported
+type bufferedEvent struct {
+	Channel string
+	Data    json.RawMessage
+	Target  *eventTarget
+}
+
+// pushNotifier receives the committed blocks from the indexing pipeline and delivers the resulting events to the
+// subscribers. A subscriber that does not consume its events fast enough will miss the events that do not fit in
+// its buffer, so the block processing is never delayed. If a delivery buffer is provided, the events are appended to
+// it and pushed, in order, on a separate go routine once at least one subscriber is connected
+type pushNotifier struct {
+	*indexer.NilIndexer
+	pubkeyConverter      core.PubkeyConverter
+	maxSubscribers       uint32
+	subscriberBufferSize uint32
+	cleanTxLogs          bool
+	mutTxLogsProc        sync.RWMutex
+	txLogsProc           process.TransactionLogProcessorDatabase
+	mutSubscriptions     sync.RWMutex
+	subscriptions        map[uint64]*Subscription
+	lastSubscriptionID   uint64
+	chanSubscribed       chan struct{}
+	buffer               indexer.DeliveryBuffer
+	pendingItems         indexer.PendingItems
+	health               *indexer.DriverHealth
+	cancelFunc           func()
+}
+
+// NewPushNotifier creates a new push notifier
+func NewPushNotifier(args ArgsPushNotifier) (*pushNotifier, error) {
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if args.MaxSubscribers == 0 {
+		return nil, ErrInvalidMaxSubscribers
+	}
+	if args.SubscriberBufferSize == 0 {
+		return nil, ErrInvalidSubscriberBufferSize
+	}
+
+	pn := &pushNotifier{
+		NilIndexer:           indexer.NewNilIndexer(),
+		pubkeyConverter:      args.PubkeyConverter,
+		maxSubscribers:       args.MaxSubscribers,
+		subscriberBufferSize: args.SubscriberBufferSize,
+		cleanTxLogs:          args.CleanTxLogs,
+		subscriptions:        make(map[uint64]*Subscription),
+		chanSubscribed:       make(chan struct{}, 1),
+		health:               indexer.NewDriverHealth(driverName, 0),
+		cancelFunc:           func() {},
+	}
+	if !check.IfNil(args.Buffer) {
+		pn.buffer = args.Buffer
+		for i := 0; i < args.Buffer.Len(); i++ {
+			pn.pendingItems.Add()
+		}
+
+		var ctx context.Context
+		ctx, pn.cancelFunc = context.WithCancel(context.Background())
+		go pn.deliverLoop(ctx)
+	}
+
+	return pn, nil
+}
+
+// Subscribe creates a new subscription for the provided filter
+func (pn *pushNotifier) Subscribe(filter Filter) (*Subscription, error) {
+	if len(filter.Channels) == 0 {
+		return nil, ErrNoChannels
+	}
+
+	subscription := &Subscription{
+		channels:   make(map[string]struct{}),
+		shardID:    filter.ShardID,
+		identifier: filter.Identifier,
+		events:     make(chan *Event, pn.subscriberBufferSize),
+	}
+	for _, channel := range filter.Channels {
+		_, ok := knownChannels[channel]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownChannel, channel)
+		}
+
+		subscription.channels[channel] = struct{}{}
+	}
+	if len(filter.Address) > 0 {
+		address, err := pn.pubkeyConverter.Decode(filter.Address)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidAddress, err.Error())
+		}
+
+		subscription.address = address
+	}
+
+	pn.mutSubscriptions.Lock()
+	defer pn.mutSubscriptions.Unlock()
+
+	if uint32(len(pn.subscriptions)) >= pn.maxSubscribers {
+		return nil, ErrTooManySubscribers
+	}
+
+	pn.lastSubscriptionID++
+	subscription.id = pn.lastSubscriptionID
+	pn.subscriptions[subscription.id] = subscription
+	select {
+	case pn.chanSubscribed <- struct{}{}:
+	default:
+	}
+
+	log.Debug("pushNotifier: new subscription", "id", subscription.id, "channels", filter.Channels)
+
+	return subscription, nil
+}
+
+// Unsubscribe removes the provided subscription and closes its events channel
+func (pn *pushNotifier) Unsubscribe(subscription *Subscription) {
+	if subscription == nil {
+		return
+	}
+
+	pn.mutSubscriptions.Lock()
+	defer pn.mutSubscriptions.Unlock()
+
+	pn.removeSubscription(subscription.id)
+}
+
+func (pn *pushNotifier) removeSubscription(id uint64) {
+	subscription, ok := pn.subscriptions[id]
+	if !ok {
+		return
+	}
+
+	delete(pn.subscriptions, id)
+	close(subscription.events)
+
+	log.Debug("pushNotifier: subscription removed", "id", id)
+}
+
+// SetTxLogsProcessor sets the logs processor used to fetch the events generated by the transactions
+func (pn *pushNotifier) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	pn.mutTxLogsProc.Lock()
+	pn.txLogsProc = txLogsProc
+	pn.mutTxLogsProc.Unlock()
+}
+
+// SaveBlock pushes the events generated by the provided block to the matching subscribers
+func (pn *pushNotifier) SaveBlock(
+	body data.BodyHandler,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	_ []uint64,
+	_ []string,
+	headerHash []byte,
+) {
+	pn.mutTxLogsProc.RLock()
+	txLogsProc := pn.txLogsProc
+	pn.mutTxLogsProc.RUnlock()
+
+	defer func() {
+		if pn.cleanTxLogs && !check.IfNil(txLogsProc) {
+			txLogsProc.Clean()
+		}
+	}()
+
+	if check.IfNil(header) {
+		return
+	}
+
+	pn.mutSubscriptions.RLock()
+	defer pn.mutSubscriptions.RUnlock()
+
+	if !pn.isBuffered() && len(pn.subscriptions) == 0 {
+		return
+	}
+
+	pn.pushBlock(header, headerHash)
+	pn.pushTxStatuses(body, header, txPool, headerHash)
+	pn.pushLogEvents(txPool, txLogsProc)
+}
+
+// RevertIndexedBlock notifies the subscribers that the transactions of the reverted block are pending again
+func (pn *pushNotifier) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) {
+	if check.IfNil(header) {
+		return
+	}
+
+	pn.mutSubscriptions.RLock()
+	defer pn.mutSubscriptions.RUnlock()
+
+	blockBody, ok := body.(*block.Body)
+	if !ok || (!pn.isBuffered() && len(pn.subscriptions) == 0) {
+		return
+	}
+
+	for _, miniBlock := range blockBody.MiniBlocks {
+		for _, txHash := range miniBlock.TxHashes {
+			notification := &TxStatusNotification{
+				TxHash:  hex.EncodeToString(txHash),
+				Status:  string(transaction.TxStatusPending),
+				ShardID: header.GetShardID(),
+			}
+
+			pn.dispatch(ChannelTxStatus, notification, &eventTarget{CheckAddress: true})
+		}
+	}
+}
+
+func (pn *pushNotifier) pushBlock(header data.HeaderHandler, headerHash []byte) {
+	blockNotification := BlockNotification{
+		Hash:      hex.EncodeToString(headerHash),
+		PrevHash:  hex.EncodeToString(header.GetPrevHash()),
+		Nonce:     header.GetNonce(),
+		Round:     header.GetRound(),
+		Epoch:     header.GetEpoch(),
+		ShardID:   header.GetShardID(),
+		NumTxs:    header.GetTxCount(),
+		Timestamp: header.GetTimeStamp(),
+	}
+
+	shardID := header.GetShardID()
+	pn.dispatch(ChannelBlocks, &blockNotification, &eventTarget{ShardID: &shardID})
+
+	metaBlock, ok := header.(*block.MetaBlock)
+	if !ok {
+		return
+	}
+
+	hyperblock := &HyperblockNotification{
+		BlockNotification: blockNotification,
+		NotarizedBlocks:   make([]*NotarizedBlockNotification, 0, len(metaBlock.ShardInfo)),
+	}
+	for _, shardData := range metaBlock.ShardInfo {
+		hyperblock.NotarizedBlocks = append(hyperblock.NotarizedBlocks, &NotarizedBlockNotification{
+			Hash:    hex.EncodeToString(shardData.HeaderHash),
+			Nonce:   shardData.Nonce,
+			ShardID: shardData.ShardID,
+		})
+	}
+
+	pn.dispatch(ChannelHyperblocks, hyperblock, &eventTarget{})
+}
+
+func (pn *pushNotifier) pushTxStatuses(
+	body data.BodyHandler,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	headerHash []byte,
+) {
+	blockBody, ok := body.(*block.Body)
+	if !ok {
+		return
+	}
+
+	for _, miniBlock := range blockBody.MiniBlocks {
+		status := computeTxStatus(miniBlock, header.GetShardID())
+		for _, txHash := range miniBlock.TxHashes {
+			tx, found := txPool[string(txHash)]
+			if !found || check.IfNil(tx) {
+				continue
+			}
+
+			notification := &TxStatusNotification{
+				TxHash:    hex.EncodeToString(txHash),
+				Status:    string(status),
+				Sender:    pn.encodeAddress(tx.GetSndAddr()),
+				Receiver:  pn.encodeAddress(tx.GetRcvAddr()),
+				BlockHash: hex.EncodeToString(headerHash),
+				ShardID:   header.GetShardID(),
+			}
+
+			pn.dispatch(ChannelTxStatus, notification, &eventTarget{
+				CheckAddress: true,
+				Addresses:    [][]byte{tx.GetSndAddr(), tx.GetRcvAddr()},
+			})
+		}
+	}
+}
+
+func computeTxStatus(miniBlock *block.MiniBlock, selfShardID uint32) transaction.TxStatus {
+	if miniBlock.Type == block.InvalidBlock {
+		return transaction.TxStatusInvalid
+	}
+	if miniBlock.ReceiverShardID != selfShardID && miniBlock.ReceiverShardID != core.AllShardId {
+		return transaction.TxStatusPending
+	}
+
+	return transaction.TxStatusSuccess
+}
+
+func (pn *pushNotifier) pushLogEvents(
+	txPool map[string]data.TransactionHandler,
+	txLogsProc process.TransactionLogProcessorDatabase,
+) {
+	if check.IfNil(txLogsProc) {
+		return
+	}
+
+	for txHashStr := range txPool {
+		txLog, found := txLogsProc.GetLogFromCache([]byte(txHashStr))
+		if !found || check.IfNil(txLog) {
+			continue
+		}
+
+		for _, event := range txLog.GetLogEvents() {
+			if check.IfNil(event) {
+				continue
+			}
+
+			notification := &LogEventNotification{
+				TxHash:     hex.EncodeToString([]byte(txHashStr)),
+				Address:    pn.encodeAddress(event.GetAddress()),
+				Identifier: string(event.GetIdentifier()),
+				Topics:     event.GetTopics(),
+				Data:       event.GetData(),
+			}
+
+			pn.dispatch(ChannelEvents, notification, &eventTarget{
+				CheckAddress: true,
+				Addresses:    [][]byte{event.GetAddress()},
+				Identifier:   &notification.Identifier,
+			})
+		}
+	}
+}
+
+// NotifyPeerStatus pushes the provided liveness change of a public key tracked by the heartbeat monitor
+func (pn *pushNotifier) NotifyPeerStatus(event *heartbeatData.PeerStatusEvent) {
+	if event == nil {
+		return
+	}
+
+	pn.mutSubscriptions.RLock()
+	defer pn.mutSubscriptions.RUnlock()
+
+	shardID := event.ShardID
+	pn.dispatch(ChannelPeerStatus, event, &eventTarget{ShardID: &shardID})
+}
+
+func (pn *pushNotifier) encodeAddress(address []byte) string {
+	if len(address) != pn.pubkeyConverter.Len() {
+		return hex.EncodeToString(address)
+	}
+
+	return pn.pubkeyConverter.Encode(address)
+}
+
+func (pn *pushNotifier) isBuffered() bool {
+	return pn.buffer != nil
+}
+
+// dispatch must be called under the subscriptions read lock
+func (pn *pushNotifier) dispatch(channel string, data interface{}, target *eventTarget) {
+	if pn.isBuffered() {
+		pn.appendToBuffer(channel, data, target)
+		return
+	}
+
+	pn.push(&Event{Channel: channel, Data: data}, target)
+}
+
+// push must be called under the subscriptions read lock
+func (pn *pushNotifier) push(event *Event, target *eventTarget) {
+	for _, subscription := range pn.subscriptions {
+		if !subscription.matches(event.Channel, target) {
+			continue
+		}
+
+		select {
+		case subscription.events <- event:
+		default:
+			log.Debug("pushNotifier: subscriber buffer is full, event dropped",
+				"subscription", subscription.id, "channel", event.Channel)
+		}
+	}
+}
+
+func (pn *pushNotifier) appendToBuffer(channel string, data interface{}, target *eventTarget) {
+	buffEvent := &bufferedEvent{
+		Channel: channel,
+		Target:  target,
+	}
+
+	var err error
+	buffEvent.Data, err = json.Marshal(data)
+	var buff []byte
+	if err == nil {
+		buff, err = json.Marshal(buffEvent)
+	}
+	if err != nil {
+		log.Warn("pushNotifier: can not serialize the event", "channel", channel, "error", err.Error())
+		return
+	}
+
+	pn.pendingItems.Add()
+	err = pn.buffer.Append(buff)
+	if err != nil {
+		pn.pendingItems.Done()
+	}
+	if err != nil && err != deliveryBuffer.ErrBufferFull {
+		log.Warn("pushNotifier: can not buffer the event", "channel", channel, "error", err.Error())
+	}
+}
+
+func (pn *pushNotifier) deliverLoop(ctx context.Context) {
+	for {
+		buff, err := pn.buffer.Peek(ctx)
+		if ctx.Err() != nil || err == deliveryBuffer.ErrBufferClosed {
+			log.Debug("pushNotifier's go routine is stopping...")
+			return
+		}
+		if err != nil {
+			log.Warn("pushNotifier could not read the delivery buffer (will retry)", "error", err.Error())
+			pn.health.DeliveryFailed(err)
+			select {
+			case <-ctx.Done():
+			case <-time.After(bufferRetryInterval):
+			}
+			continue
+		}
+
+		buffEvent := &bufferedEvent{}
+		err = json.Unmarshal(buff, buffEvent)
+		if err != nil || buffEvent.Target == nil {
+			log.Error("pushNotifier: dropping a corrupted buffered event", "error", err)
+		} else if !pn.pushToSubscribers(ctx, buffEvent) {
+			// the event was not pushed, it stays in the buffer
+			log.Debug("pushNotifier's go routine is stopping...")
+			return
+		}
+
+		err = pn.buffer.Pop()
+		if err != nil {
+			log.Warn("pushNotifier could not remove a pushed event from the delivery buffer", "error", err.Error())
+		}
+		pn.pendingItems.Done()
+	}
+}
+
+// pushToSubscribers waits for at least one subscriber and pushes the event. It returns false if the context is done
+// before the event is pushed
+func (pn *pushNotifier) pushToSubscribers(ctx context.Context, buffEvent *bufferedEvent) bool {
+	for {
+		pn.mutSubscriptions.RLock()
+		hasSubscribers := len(pn.subscriptions) > 0
+		if hasSubscribers {
+			pn.push(&Event{Channel: buffEvent.Channel, Data: buffEvent.Data}, buffEvent.Target)
+		}
+		pn.mutSubscriptions.RUnlock()
+
+		if hasSubscribers {
+			pn.health.DeliverySucceeded()
+			return true
+		}
+
+		select {
+		case <-pn.chanSubscribed:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// DriverStatus returns the health of the deliveries to the subscribers
+func (pn *pushNotifier) DriverStatus() indexer.DriverStatus {
+	status := pn.health.Status(pn.pendingItems.Len())
+	if pn.isBuffered() {
+		status.DroppedItems = pn.buffer.NumDroppedItems()
+	}
+
+	return status
+}
+
+// Flush blocks until all the buffered events are pushed or the context is done
+func (pn *pushNotifier) Flush(ctx context.Context) error {
+	return pn.pendingItems.Wait(ctx)
+}
+
+// Close stops pushing the buffered events and removes all the subscriptions. The events not yet pushed are kept only
+// by a write-ahead log
+func (pn *pushNotifier) Close() error {
+	pn.cancelFunc()
+
+	pn.mutSubscriptions.Lock()
+	for id := range pn.subscriptions {
+		pn.removeSubscription(id)
+	}
+	pn.mutSubscriptions.Unlock()
+
+	if pn.isBuffered() {
+		return pn.buffer.Close()
+	}
+
+	return nil
+}
+
+// IsNilIndexer returns false as the push notifier is a real indexer implementation
+func (pn *pushNotifier) IsNilIndexer() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pn *pushNotifier) IsInterfaceNil() bool {
+	return pn == nil
+}
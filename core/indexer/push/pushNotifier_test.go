@@ -0,0 +1,323 @@
+package push
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/deliveryBuffer"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgs() ArgsPushNotifier {
+	return ArgsPushNotifier{
+		PubkeyConverter:      mock.NewPubkeyConverterMock(4),
+		MaxSubscribers:       2,
+		SubscriberBufferSize: 10,
+	}
+}
+
+func readEvents(subscription *Subscription) []*Event {
+	events := make([]*Event, 0)
+	for {
+		select {
+		case event := <-subscription.Events():
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestNewPushNotifier_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.PubkeyConverter = nil
+	pn, err := NewPushNotifier(args)
+	assert.True(t, check.IfNil(pn))
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+
+	args = createMockArgs()
+	args.MaxSubscribers = 0
+	pn, err = NewPushNotifier(args)
+	assert.True(t, check.IfNil(pn))
+	assert.Equal(t, ErrInvalidMaxSubscribers, err)
+
+	args = createMockArgs()
+	args.SubscriberBufferSize = 0
+	pn, err = NewPushNotifier(args)
+	assert.True(t, check.IfNil(pn))
+	assert.Equal(t, ErrInvalidSubscriberBufferSize, err)
+
+	pn, err = NewPushNotifier(createMockArgs())
+	assert.False(t, check.IfNil(pn))
+	assert.Nil(t, err)
+	assert.False(t, pn.IsNilIndexer())
+}
+
+func TestPushNotifier_SubscribeInvalidFilterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pn, _ := NewPushNotifier(createMockArgs())
+
+	subscription, err := pn.Subscribe(Filter{})
+	assert.Nil(t, subscription)
+	assert.Equal(t, ErrNoChannels, err)
+
+	subscription, err = pn.Subscribe(Filter{Channels: []string{ChannelBlocks, "unknown"}})
+	assert.Nil(t, subscription)
+	assert.True(t, errors.Is(err, ErrUnknownChannel))
+
+	subscription, err = pn.Subscribe(Filter{Channels: []string{ChannelEvents}, Address: "not hex"})
+	assert.Nil(t, subscription)
+	assert.True(t, errors.Is(err, ErrInvalidAddress))
+}
+
+func TestPushNotifier_SubscribeTooManySubscribersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pn, _ := NewPushNotifier(createMockArgs())
+
+	first, err := pn.Subscribe(Filter{Channels: []string{ChannelBlocks}})
+	require.Nil(t, err)
+	second, err := pn.Subscribe(Filter{Channels: []string{ChannelBlocks}})
+	require.Nil(t, err)
+	assert.NotEqual(t, first.ID(), second.ID())
+
+	subscription, err := pn.Subscribe(Filter{Channels: []string{ChannelBlocks}})
+	assert.Nil(t, subscription)
+	assert.Equal(t, ErrTooManySubscribers, err)
+
+	pn.Unsubscribe(first)
+	_, ok := <-first.Events()
+	assert.False(t, ok)
+
+	_, err = pn.Subscribe(Filter{Channels: []string{ChannelBlocks}})
+	assert.Nil(t, err)
+}
+
+func TestPushNotifier_SaveBlockShouldPushBlocksAndHyperblocks(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.MaxSubscribers = 10
+	pn, _ := NewPushNotifier(args)
+
+	shardID := uint32(1)
+	shardBlocks, _ := pn.Subscribe(Filter{Channels: []string{ChannelBlocks}, ShardID: &shardID})
+	hyperblocks, _ := pn.Subscribe(Filter{Channels: []string{ChannelHyperblocks}})
+
+	pn.SaveBlock(&block.Body{}, &block.Header{Nonce: 5, ShardID: 0}, nil, nil, nil, []byte("hash0"))
+	pn.SaveBlock(&block.Body{}, &block.Header{Nonce: 6, ShardID: 1}, nil, nil, nil, []byte("hash1"))
+	metaBlock := &block.MetaBlock{
+		Nonce:     7,
+		ShardInfo: []block.ShardData{{HeaderHash: []byte("hash1"), Nonce: 6, ShardID: 1}},
+	}
+	pn.SaveBlock(&block.Body{}, metaBlock, nil, nil, nil, []byte("metaHash"))
+
+	events := readEvents(shardBlocks)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, ChannelBlocks, events[0].Channel)
+	assert.Equal(t, uint64(6), events[0].Data.(*BlockNotification).Nonce)
+	assert.Equal(t, hex.EncodeToString([]byte("hash1")), events[0].Data.(*BlockNotification).Hash)
+
+	events = readEvents(hyperblocks)
+	require.Equal(t, 1, len(events))
+	hyperblock := events[0].Data.(*HyperblockNotification)
+	assert.Equal(t, uint64(7), hyperblock.Nonce)
+	assert.Equal(t, core.MetachainShardId, hyperblock.ShardID)
+	require.Equal(t, 1, len(hyperblock.NotarizedBlocks))
+	assert.Equal(t, hex.EncodeToString([]byte("hash1")), hyperblock.NotarizedBlocks[0].Hash)
+}
+
+func TestPushNotifier_SaveBlockShouldPushTxStatusesAndEvents(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.MaxSubscribers = 10
+	args.CleanTxLogs = true
+	pn, _ := NewPushNotifier(args)
+
+	sender := []byte("sndr")
+	receiver := []byte("rcvr")
+	txLog := &transaction.Log{
+		Events: []*transaction.Event{
+			{Address: receiver, Identifier: []byte("transfer")},
+			{Address: receiver, Identifier: []byte("other")},
+		},
+	}
+	cleanCalled := false
+	pn.SetTxLogsProcessor(&mock.TxLogsProcessorDatabaseStub{
+		GetLogFromCacheCalled: func(txHash []byte) (data.LogHandler, bool) {
+			return txLog, string(txHash) == "txExecuted"
+		},
+		CleanCalled: func() {
+			cleanCalled = true
+		},
+	})
+
+	txStatuses, _ := pn.Subscribe(Filter{Channels: []string{ChannelTxStatus}, Address: hex.EncodeToString(sender)})
+	logEvents, _ := pn.Subscribe(Filter{Channels: []string{ChannelEvents}, Identifier: "transfer"})
+
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("txExecuted")}, SenderShardID: 0, ReceiverShardID: 0},
+			{TxHashes: [][]byte{[]byte("txCrossShard")}, SenderShardID: 0, ReceiverShardID: 1},
+			{TxHashes: [][]byte{[]byte("txInvalid")}, SenderShardID: 0, ReceiverShardID: 0, Type: block.InvalidBlock},
+			{TxHashes: [][]byte{[]byte("txOtherSender")}, SenderShardID: 0, ReceiverShardID: 0},
+		},
+	}
+	txPool := map[string]data.TransactionHandler{
+		"txExecuted":    &transaction.Transaction{SndAddr: sender, RcvAddr: receiver},
+		"txCrossShard":  &transaction.Transaction{SndAddr: sender, RcvAddr: receiver},
+		"txInvalid":     &transaction.Transaction{SndAddr: sender, RcvAddr: receiver},
+		"txOtherSender": &transaction.Transaction{SndAddr: []byte("othr"), RcvAddr: receiver},
+	}
+	pn.SaveBlock(body, &block.Header{ShardID: 0}, txPool, nil, nil, []byte("hash"))
+	assert.True(t, cleanCalled)
+
+	statuses := make(map[string]string)
+	for _, event := range readEvents(txStatuses) {
+		notification := event.Data.(*TxStatusNotification)
+		statuses[notification.TxHash] = notification.Status
+		assert.Equal(t, hex.EncodeToString(sender), notification.Sender)
+	}
+	expectedStatuses := map[string]string{
+		hex.EncodeToString([]byte("txExecuted")):   string(transaction.TxStatusSuccess),
+		hex.EncodeToString([]byte("txCrossShard")): string(transaction.TxStatusPending),
+		hex.EncodeToString([]byte("txInvalid")):    string(transaction.TxStatusInvalid),
+	}
+	assert.Equal(t, expectedStatuses, statuses)
+
+	events := readEvents(logEvents)
+	require.Equal(t, 1, len(events))
+	logEvent := events[0].Data.(*LogEventNotification)
+	assert.Equal(t, "transfer", logEvent.Identifier)
+	assert.Equal(t, hex.EncodeToString(receiver), logEvent.Address)
+	assert.Equal(t, hex.EncodeToString([]byte("txExecuted")), logEvent.TxHash)
+
+	pn.RevertIndexedBlock(&block.Header{ShardID: 0}, body)
+	assert.Equal(t, 0, len(readEvents(txStatuses)))
+}
+
+func TestPushNotifier_FullBufferShouldDropEvents(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.SubscriberBufferSize = 1
+	pn, _ := NewPushNotifier(args)
+
+	subscription, _ := pn.Subscribe(Filter{Channels: []string{ChannelBlocks}})
+	pn.SaveBlock(&block.Body{}, &block.Header{Nonce: 1}, nil, nil, nil, []byte("hash1"))
+	pn.SaveBlock(&block.Body{}, &block.Header{Nonce: 2}, nil, nil, nil, []byte("hash2"))
+
+	events := readEvents(subscription)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, uint64(1), events[0].Data.(*BlockNotification).Nonce)
+
+	err := pn.Close()
+	assert.Nil(t, err)
+	_, ok := <-subscription.Events()
+	assert.False(t, ok)
+}
+
+func TestPushNotifier_NotifyPeerStatusShouldPushToTheMatchingShard(t *testing.T) {
+	t.Parallel()
+
+	pn, _ := NewPushNotifier(createMockArgs())
+
+	shardID := uint32(1)
+	peerStatuses, _ := pn.Subscribe(Filter{Channels: []string{ChannelPeerStatus}, ShardID: &shardID})
+	blocks, _ := pn.Subscribe(Filter{Channels: []string{ChannelBlocks}})
+
+	pn.NotifyPeerStatus(nil)
+	pn.NotifyPeerStatus(&heartbeatData.PeerStatusEvent{Type: heartbeatData.PeerStatusOffline, PublicKey: "pk0", ShardID: 0})
+	pn.NotifyPeerStatus(&heartbeatData.PeerStatusEvent{Type: heartbeatData.PeerStatusOffline, PublicKey: "pk1", ShardID: 1})
+
+	events := readEvents(peerStatuses)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, ChannelPeerStatus, events[0].Channel)
+	assert.Equal(t, "pk1", events[0].Data.(*heartbeatData.PeerStatusEvent).PublicKey)
+	assert.Equal(t, 0, len(readEvents(blocks)))
+}
+
+func createBufferedMockArgs(persister storage.Persister) ArgsPushNotifier {
+	args := createMockArgs()
+	args.Buffer, _ = deliveryBuffer.NewWriteAheadLog(deliveryBuffer.ArgsWriteAheadLog{
+		Persister:      persister,
+		MaxSizeInBytes: 1 << 20,
+	})
+
+	return args
+}
+
+func TestPushNotifier_BufferedEventsShouldWaitForASubscriber(t *testing.T) {
+	t.Parallel()
+
+	pn, _ := NewPushNotifier(createBufferedMockArgs(memorydb.New()))
+	pn.SaveBlock(&block.Body{}, &block.Header{Nonce: 5, ShardID: 1}, nil, nil, nil, []byte("hash"))
+	assert.Equal(t, int64(1), pn.DriverStatus().PendingItems)
+
+	shardID := uint32(1)
+	subscription, _ := pn.Subscribe(Filter{Channels: []string{ChannelBlocks}, ShardID: &shardID})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.Nil(t, pn.Flush(ctx))
+
+	events := readEvents(subscription)
+	require.Equal(t, 1, len(events))
+	notification := &BlockNotification{}
+	_ = json.Unmarshal(events[0].Data.(json.RawMessage), notification)
+	assert.Equal(t, uint64(5), notification.Nonce)
+	assert.Equal(t, hex.EncodeToString([]byte("hash")), notification.Hash)
+	_ = pn.Close()
+}
+
+func TestPushNotifier_BufferedEventsShouldBePushedAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	persister := memorydb.New()
+	pn, _ := NewPushNotifier(createBufferedMockArgs(persister))
+	pn.SaveBlock(&block.Body{}, &block.Header{Nonce: 5}, nil, nil, nil, []byte("hash"))
+	_ = pn.Close()
+
+	pn, _ = NewPushNotifier(createBufferedMockArgs(persister))
+	assert.Equal(t, int64(1), pn.DriverStatus().PendingItems)
+
+	shardID := uint32(1)
+	otherShard, _ := pn.Subscribe(Filter{Channels: []string{ChannelBlocks}, ShardID: &shardID})
+	allShards, _ := pn.Subscribe(Filter{Channels: []string{ChannelBlocks}})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.Nil(t, pn.Flush(ctx))
+
+	assert.Equal(t, 0, len(readEvents(otherShard)))
+	assert.Equal(t, 1, len(readEvents(allShards)))
+	_ = pn.Close()
+}
+
+func TestDisabledPushNotifier_SubscribeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dpn := NewDisabledPushNotifier()
+	assert.False(t, check.IfNil(dpn))
+	assert.True(t, dpn.IsNilIndexer())
+
+	subscription, err := dpn.Subscribe(Filter{Channels: []string{ChannelBlocks}})
+	assert.Nil(t, subscription)
+	assert.Equal(t, ErrPushNotificationsDisabled, err)
+}
@@ -0,0 +1,75 @@
+package push
+
+import (
+	"bytes"
+)
+
+// Subscription holds the channel on which the events matching the subscription filter are delivered
+type Subscription struct {
+	id         uint64
+	channels   map[string]struct{}
+	shardID    *uint32
+	address    []byte
+	identifier string
+	events     chan *Event
+}
+
+// ID returns the unique identifier of the subscription
+func (s *Subscription) ID() uint64 {
+	return s.id
+}
+
+// Events returns the channel on which the events are delivered. The channel is closed when the subscription ends
+func (s *Subscription) Events() <-chan *Event {
+	return s.events
+}
+
+func (s *Subscription) hasChannel(channel string) bool {
+	_, ok := s.channels[channel]
+	return ok
+}
+
+func (s *Subscription) matchesShard(shardID uint32) bool {
+	return s.shardID == nil || *s.shardID == shardID
+}
+
+func (s *Subscription) matchesAddress(addresses ...[]byte) bool {
+	if len(s.address) == 0 {
+		return true
+	}
+
+	for _, address := range addresses {
+		if bytes.Equal(s.address, address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Subscription) matchesIdentifier(identifier string) bool {
+	return len(s.identifier) == 0 || s.identifier == identifier
+}
+
+// eventTarget selects, besides the channel, the subscriptions an event is pushed to. It is serialized together with
+// the event when the push notifier has a delivery buffer, so its fields are exported
+type eventTarget struct {
+	ShardID      *uint32
+	CheckAddress bool
+	Addresses    [][]byte
+	Identifier   *string
+}
+
+func (s *Subscription) matches(channel string, target *eventTarget) bool {
+	if !s.hasChannel(channel) {
+		return false
+	}
+	if target.ShardID != nil && !s.matchesShard(*target.ShardID) {
+		return false
+	}
+	if target.CheckAddress && !s.matchesAddress(target.Addresses...) {
+		return false
+	}
+
+	return target.Identifier == nil || s.matchesIdentifier(*target.Identifier)
+}
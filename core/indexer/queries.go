@@ -29,6 +29,12 @@ func getDocumentsByIDsQuery(hashes []string) objectsMap {
 	}
 }
 
+func getDocumentsSourcesByIDsQuery(ids []string) objectsMap {
+	return objectsMap{
+		"ids": ids,
+	}
+}
+
 func prepareHashesForBulkRemove(hashes []string) objectsMap {
 	return objectsMap{
 		"query": objectsMap{
@@ -39,3 +45,13 @@ func prepareHashesForBulkRemove(hashes []string) objectsMap {
 		},
 	}
 }
+
+func prepareBlockHashQueryForRemove(blockHash string) objectsMap {
+	return objectsMap{
+		"query": objectsMap{
+			"term": objectsMap{
+				"blockHash.keyword": blockHash,
+			},
+		},
+	}
+}
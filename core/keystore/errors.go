@@ -0,0 +1,33 @@
+package keystore
+
+import "errors"
+
+// ErrUnsupportedVersion signals that the keystore file has an unsupported version
+var ErrUnsupportedVersion = errors.New("unsupported keystore version")
+
+// ErrUnsupportedKdf signals that an unsupported key derivation function was provided
+var ErrUnsupportedKdf = errors.New("unsupported key derivation function")
+
+// ErrUnsupportedCipher signals that the keystore file uses an unsupported cipher
+var ErrUnsupportedCipher = errors.New("unsupported cipher")
+
+// ErrInvalidKdfParams signals that invalid key derivation parameters were provided
+var ErrInvalidKdfParams = errors.New("invalid key derivation parameters")
+
+// ErrWrongPassphrase signals that the keys could not be decrypted with the provided passphrase
+var ErrWrongPassphrase = errors.New("wrong passphrase or corrupted keystore")
+
+// ErrEmptyPassphrase signals that an empty passphrase was provided
+var ErrEmptyPassphrase = errors.New("empty passphrase")
+
+// ErrNoKeys signals that no keys were provided or found
+var ErrNoKeys = errors.New("no keys")
+
+// ErrInvalidIndex signals that the requested key index is not present in the keystore
+var ErrInvalidIndex = errors.New("invalid key index")
+
+// ErrNoPassphraseSource signals that the passphrase can not be read from a file, from the environment or from a prompt
+var ErrNoPassphraseSource = errors.New("no passphrase source")
+
+// ErrNilPassphraseProvider signals that a nil passphrase provider was provided
+var ErrNilPassphraseProvider = errors.New("nil passphrase provider")
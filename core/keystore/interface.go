@@ -0,0 +1,7 @@
+package keystore
+
+// PassphraseProvider provides the passphrase a keystore file is decrypted with
+type PassphraseProvider interface {
+	Passphrase(fileName string) ([]byte, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,95 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// KdfScrypt is the scrypt key derivation function
+	KdfScrypt = "scrypt"
+	// KdfArgon2id is the argon2id key derivation function
+	KdfArgon2id = "argon2id"
+
+	derivedKeyLength = 32
+	saltLength       = 32
+	minSaltLength    = 16
+
+	defaultScryptN   = 1 << 18
+	defaultScryptR   = 8
+	defaultScryptP   = 1
+	defaultArgonTime = 3
+	// defaultArgonMemory is expressed in KiB
+	defaultArgonMemory  = 256 * 1024
+	defaultArgonThreads = 4
+
+	// the upper bounds protect the node from a keystore asking for an absurd amount of memory or time
+	maxScryptN      = 1 << 22
+	maxScryptRP     = 1 << 20
+	maxArgon2Time   = 100
+	maxArgon2Memory = 4 * 1024 * 1024
+)
+
+// KdfParams holds the key derivation function deriving the encryption key from the passphrase and its parameters.
+// N, R and P are the scrypt parameters while Time, MemoryInKiB and Threads are the argon2id parameters
+type KdfParams struct {
+	Function    string `json:"function"`
+	Salt        string `json:"salt"`
+	N           int    `json:"n,omitempty"`
+	R           int    `json:"r,omitempty"`
+	P           int    `json:"p,omitempty"`
+	Time        uint32 `json:"time,omitempty"`
+	MemoryInKiB uint32 `json:"memoryInKiB,omitempty"`
+	Threads     uint8  `json:"threads,omitempty"`
+}
+
+// NewKdfParams returns the recommended parameters of the provided key derivation function, scrypt or argon2id
+func NewKdfParams(function string) (KdfParams, error) {
+	switch function {
+	case KdfScrypt:
+		return KdfParams{
+			Function: KdfScrypt,
+			N:        defaultScryptN,
+			R:        defaultScryptR,
+			P:        defaultScryptP,
+		}, nil
+	case KdfArgon2id:
+		return KdfParams{
+			Function:    KdfArgon2id,
+			Time:        defaultArgonTime,
+			MemoryInKiB: defaultArgonMemory,
+			Threads:     defaultArgonThreads,
+		}, nil
+	default:
+		return KdfParams{}, fmt.Errorf("%w %s", ErrUnsupportedKdf, function)
+	}
+}
+
+func (params KdfParams) deriveKey(passphrase []byte) ([]byte, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil || len(salt) < minSaltLength {
+		return nil, fmt.Errorf("%w, invalid salt", ErrInvalidKdfParams)
+	}
+
+	switch params.Function {
+	case KdfScrypt:
+		isNPowerOf2 := params.N > 1 && params.N&(params.N-1) == 0
+		if !isNPowerOf2 || params.N > maxScryptN || params.R < 1 || params.P < 1 || params.R*params.P >= maxScryptRP {
+			return nil, fmt.Errorf("%w for scrypt, n %d, r %d, p %d", ErrInvalidKdfParams, params.N, params.R, params.P)
+		}
+
+		return scrypt.Key(passphrase, salt, params.N, params.R, params.P, derivedKeyLength)
+	case KdfArgon2id:
+		if params.Time < 1 || params.Time > maxArgon2Time || params.MemoryInKiB < 1 || params.MemoryInKiB > maxArgon2Memory || params.Threads < 1 {
+			return nil, fmt.Errorf("%w for argon2id, time %d, memory %d KiB, threads %d",
+				ErrInvalidKdfParams, params.Time, params.MemoryInKiB, params.Threads)
+		}
+
+		return argon2.IDKey(passphrase, salt, params.Time, params.MemoryInKiB, params.Threads, derivedKeyLength), nil
+	default:
+		return nil, fmt.Errorf("%w %s", ErrUnsupportedKdf, params.Function)
+	}
+}
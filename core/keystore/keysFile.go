@@ -0,0 +1,94 @@
+package keystore
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+var log = logger.GetOrCreate("core/keystore")
+
+// IsKeystoreFile returns true if the provided file holds a JSON keystore instead of plaintext PEM blocks
+func IsKeystoreFile(fileName string) (bool, error) {
+	buff, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.HasPrefix(bytes.TrimSpace(buff), []byte("{")), nil
+}
+
+// LoadSkPkFromFile loads the secret key with the provided index and its public key from a keystore or from a plaintext
+// PEM file, as core.LoadSkPkFromPemFile does
+func LoadSkPkFromFile(fileName string, skIndex int, provider PassphraseProvider) ([]byte, string, error) {
+	entries, isKeystore, err := loadKeystoreEntries(fileName, provider)
+	if err != nil {
+		return nil, "", err
+	}
+	if !isKeystore {
+		return core.LoadSkPkFromPemFile(fileName, skIndex)
+	}
+
+	if skIndex < 0 || skIndex >= len(entries) {
+		return nil, "", fmt.Errorf("%w %d while reading the keystore %s", ErrInvalidIndex, skIndex, fileName)
+	}
+
+	return entries[skIndex].Secret, entries[skIndex].Identifier, nil
+}
+
+// LoadAllKeysFromFile loads all the secret keys and their public keys from a keystore or from a plaintext PEM file, as
+// core.LoadAllKeysFromPemFile does
+func LoadAllKeysFromFile(fileName string, provider PassphraseProvider) ([][]byte, []string, error) {
+	entries, isKeystore, err := loadKeystoreEntries(fileName, provider)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !isKeystore {
+		return core.LoadAllKeysFromPemFile(fileName)
+	}
+
+	secretKeys := make([][]byte, 0, len(entries))
+	publicKeys := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		secretKeys = append(secretKeys, entry.Secret)
+		publicKeys = append(publicKeys, entry.Identifier)
+	}
+
+	return secretKeys, publicKeys, nil
+}
+
+func loadKeystoreEntries(fileName string, provider PassphraseProvider) ([]KeyEntry, bool, error) {
+	if check.IfNil(provider) {
+		return nil, false, ErrNilPassphraseProvider
+	}
+
+	isKeystore, err := IsKeystoreFile(fileName)
+	if err != nil {
+		return nil, false, err
+	}
+	if !isKeystore {
+		log.Warn("the keys are stored in plaintext, consider encrypting them with the keystore tool", "file", fileName)
+		return nil, false, nil
+	}
+
+	ks, err := LoadKeystore(fileName)
+	if err != nil {
+		return nil, true, err
+	}
+
+	passphrase, err := provider.Passphrase(fileName)
+	if err != nil {
+		return nil, true, err
+	}
+
+	entries, err := ks.Decrypt(passphrase)
+	if err != nil {
+		return nil, true, fmt.Errorf("%w while decrypting the keystore %s", err, fileName)
+	}
+
+	return entries, true, nil
+}
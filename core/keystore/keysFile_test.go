@@ -0,0 +1,142 @@
+package keystore
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type passphraseProviderStub struct {
+	PassphraseCalled func(fileName string) ([]byte, error)
+}
+
+func (pps *passphraseProviderStub) Passphrase(fileName string) ([]byte, error) {
+	return pps.PassphraseCalled(fileName)
+}
+
+func (pps *passphraseProviderStub) IsInterfaceNil() bool {
+	return pps == nil
+}
+
+func createKeyFiles(t *testing.T) (string, string, string) {
+	dir, err := ioutil.TempDir("", "keystore")
+	require.Nil(t, err)
+
+	pemFile, err := os.Create(filepath.Join(dir, "keys.pem"))
+	require.Nil(t, err)
+	for _, entry := range createTestEntries() {
+		err = core.SaveSkToPemFile(pemFile, entry.Identifier, entry.Secret)
+		require.Nil(t, err)
+	}
+	_ = pemFile.Close()
+
+	keystoreFileName := filepath.Join(dir, "keys.json")
+	ks, _ := Encrypt(createTestEntries(), passphrase, createTestScryptParams())
+	err = ks.Save(keystoreFileName)
+	require.Nil(t, err)
+
+	return dir, pemFile.Name(), keystoreFileName
+}
+
+func TestKeystore_SaveShouldNotOverwrite(t *testing.T) {
+	t.Parallel()
+
+	dir, _, keystoreFileName := createKeyFiles(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	info, err := os.Stat(keystoreFileName)
+	require.Nil(t, err)
+	assert.Equal(t, os.FileMode(fileModeOwnerReadWrite), info.Mode().Perm())
+
+	ks, _ := Encrypt(createTestEntries(), passphrase, createTestScryptParams())
+	err = ks.Save(keystoreFileName)
+	assert.True(t, os.IsExist(err))
+}
+
+func TestLoadAllKeysFromFile_ShouldLoadBothFormats(t *testing.T) {
+	t.Parallel()
+
+	dir, pemFileName, keystoreFileName := createKeyFiles(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	numPassphraseCalls := 0
+	provider := &passphraseProviderStub{
+		PassphraseCalled: func(fileName string) ([]byte, error) {
+			numPassphraseCalls++
+			return passphrase, nil
+		},
+	}
+
+	expectedSks := [][]byte{[]byte("secret key 0"), []byte("secret key 1")}
+	expectedPks := []string{"pk0", "pk1"}
+
+	sks, pks, err := LoadAllKeysFromFile(pemFileName, provider)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedSks, sks)
+	assert.Equal(t, expectedPks, pks)
+	assert.Equal(t, 0, numPassphraseCalls)
+
+	sks, pks, err = LoadAllKeysFromFile(keystoreFileName, provider)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedSks, sks)
+	assert.Equal(t, expectedPks, pks)
+	assert.Equal(t, 1, numPassphraseCalls)
+}
+
+func TestLoadSkPkFromFile_ShouldLoadTheKeyWithTheIndex(t *testing.T) {
+	t.Parallel()
+
+	dir, _, keystoreFileName := createKeyFiles(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	provider := &passphraseProviderStub{
+		PassphraseCalled: func(fileName string) ([]byte, error) {
+			return passphrase, nil
+		},
+	}
+
+	sk, pk, err := LoadSkPkFromFile(keystoreFileName, 1, provider)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("secret key 1"), sk)
+	assert.Equal(t, "pk1", pk)
+
+	sk, pk, err = LoadSkPkFromFile(keystoreFileName, 2, provider)
+	assert.Nil(t, sk)
+	assert.Empty(t, pk)
+	assert.True(t, errors.Is(err, ErrInvalidIndex))
+}
+
+func TestLoadSkPkFromFile_WrongPassphraseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir, _, keystoreFileName := createKeyFiles(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	provider := &passphraseProviderStub{
+		PassphraseCalled: func(fileName string) ([]byte, error) {
+			return []byte("wrong passphrase"), nil
+		},
+	}
+
+	sk, _, err := LoadSkPkFromFile(keystoreFileName, 0, provider)
+	assert.Nil(t, sk)
+	assert.True(t, errors.Is(err, ErrWrongPassphrase))
+
+	sk, _, err = LoadSkPkFromFile(keystoreFileName, 0, nil)
+	assert.Nil(t, sk)
+	assert.Equal(t, ErrNilPassphraseProvider, err)
+}
@@ -0,0 +1,192 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+)
+
+const (
+	// Version is the version of the keystore format
+	Version = 1
+	// CipherAES256GCM is the cipher encrypting the keys, the nonces are random and the identifier of each key is
+	// authenticated together with the key
+	CipherAES256GCM = "aes-256-gcm"
+
+	fileModeOwnerReadWrite = 0600
+)
+
+// Keystore is the JSON encoded content of an encrypted keys file. The keys are encrypted with a key derived from the
+// passphrase once per file, so a file holding many validator keys is opened with a single derivation
+type Keystore struct {
+	Version int            `json:"version"`
+	Kdf     KdfParams      `json:"kdf"`
+	Cipher  string         `json:"cipher"`
+	Keys    []EncryptedKey `json:"keys"`
+}
+
+// EncryptedKey is an encrypted secret key together with its identifier, the public key found in the PEM block type
+type EncryptedKey struct {
+	Identifier string `json:"identifier"`
+	Nonce      string `json:"nonce"`
+	Ciphertext string `json:"ciphertext"`
+}
+
+// KeyEntry is a secret key and its identifier, as stored in a PEM block
+type KeyEntry struct {
+	Identifier string
+	Secret     []byte
+}
+
+// Encrypt encrypts the provided keys with a key derived from the passphrase with the provided key derivation function.
+// A fresh salt is generated for the derivation
+func Encrypt(entries []KeyEntry, passphrase []byte, kdfParams KdfParams) (*Keystore, error) {
+	if len(entries) == 0 {
+		return nil, ErrNoKeys
+	}
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+
+	salt := make([]byte, saltLength)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+	kdfParams.Salt = hex.EncodeToString(salt)
+
+	aead, err := createAEAD(passphrase, kdfParams)
+	if err != nil {
+		return nil, err
+	}
+
+	ks := &Keystore{
+		Version: Version,
+		Kdf:     kdfParams,
+		Cipher:  CipherAES256GCM,
+		Keys:    make([]EncryptedKey, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		nonce := make([]byte, aead.NonceSize())
+		_, err = rand.Read(nonce)
+		if err != nil {
+			return nil, err
+		}
+
+		ciphertext := aead.Seal(nil, nonce, entry.Secret, []byte(entry.Identifier))
+		ks.Keys = append(ks.Keys, EncryptedKey{
+			Identifier: entry.Identifier,
+			Nonce:      hex.EncodeToString(nonce),
+			Ciphertext: hex.EncodeToString(ciphertext),
+		})
+	}
+
+	return ks, nil
+}
+
+// Decrypt decrypts all the keys of the keystore with the provided passphrase
+func (ks *Keystore) Decrypt(passphrase []byte) ([]KeyEntry, error) {
+	if ks.Version != Version {
+		return nil, fmt.Errorf("%w %d", ErrUnsupportedVersion, ks.Version)
+	}
+	if ks.Cipher != CipherAES256GCM {
+		return nil, fmt.Errorf("%w %s", ErrUnsupportedCipher, ks.Cipher)
+	}
+	if len(ks.Keys) == 0 {
+		return nil, ErrNoKeys
+	}
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+
+	aead, err := createAEAD(passphrase, ks.Kdf)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]KeyEntry, 0, len(ks.Keys))
+	for i, key := range ks.Keys {
+		nonce, errDecode := hex.DecodeString(key.Nonce)
+		if errDecode != nil || len(nonce) != aead.NonceSize() {
+			return nil, fmt.Errorf("%w, invalid nonce for key with index %d", ErrWrongPassphrase, i)
+		}
+		ciphertext, errDecode := hex.DecodeString(key.Ciphertext)
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w, invalid ciphertext for key with index %d", ErrWrongPassphrase, i)
+		}
+
+		secret, errOpen := aead.Open(nil, nonce, ciphertext, []byte(key.Identifier))
+		if errOpen != nil {
+			return nil, ErrWrongPassphrase
+		}
+
+		entries = append(entries, KeyEntry{
+			Identifier: key.Identifier,
+			Secret:     secret,
+		})
+	}
+
+	return entries, nil
+}
+
+func createAEAD(passphrase []byte, kdfParams KdfParams) (cipher.AEAD, error) {
+	key, err := kdfParams.deriveKey(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// Save writes the keystore in the provided file, readable only by its owner. An existing file is not overwritten
+func (ks *Keystore) Save(fileName string) error {
+	buff, err := json.MarshalIndent(ks, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(fileName, os.O_CREATE|os.O_EXCL|os.O_WRONLY, fileModeOwnerReadWrite)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(buff)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	err = file.Sync()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// LoadKeystore reads the keystore from the provided file
+func LoadKeystore(fileName string) (*Keystore, error) {
+	buff, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	ks := &Keystore{}
+	err = json.Unmarshal(buff, ks)
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the keystore %s", err, fileName)
+	}
+
+	return ks, nil
+}
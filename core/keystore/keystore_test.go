@@ -0,0 +1,118 @@
+package keystore
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var passphrase = []byte("correct horse battery staple")
+
+func createTestEntries() []KeyEntry {
+	return []KeyEntry{
+		{Identifier: "pk0", Secret: []byte("secret key 0")},
+		{Identifier: "pk1", Secret: []byte("secret key 1")},
+	}
+}
+
+func createTestScryptParams() KdfParams {
+	return KdfParams{Function: KdfScrypt, N: 1 << 10, R: 8, P: 1}
+}
+
+func createTestArgon2idParams() KdfParams {
+	return KdfParams{Function: KdfArgon2id, Time: 1, MemoryInKiB: 1024, Threads: 1}
+}
+
+func TestEncrypt_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ks, err := Encrypt(nil, passphrase, createTestScryptParams())
+	assert.Nil(t, ks)
+	assert.Equal(t, ErrNoKeys, err)
+
+	ks, err = Encrypt(createTestEntries(), nil, createTestScryptParams())
+	assert.Nil(t, ks)
+	assert.Equal(t, ErrEmptyPassphrase, err)
+
+	ks, err = Encrypt(createTestEntries(), passphrase, KdfParams{Function: "pbkdf2"})
+	assert.Nil(t, ks)
+	assert.True(t, errors.Is(err, ErrUnsupportedKdf))
+
+	ks, err = Encrypt(createTestEntries(), passphrase, KdfParams{Function: KdfScrypt, N: 1000, R: 8, P: 1})
+	assert.Nil(t, ks)
+	assert.True(t, errors.Is(err, ErrInvalidKdfParams))
+}
+
+func TestKeystore_EncryptDecryptShouldWork(t *testing.T) {
+	t.Parallel()
+
+	for _, kdfParams := range []KdfParams{createTestScryptParams(), createTestArgon2idParams()} {
+		ks, err := Encrypt(createTestEntries(), passphrase, kdfParams)
+		require.Nil(t, err)
+		assert.Equal(t, Version, ks.Version)
+		assert.Equal(t, CipherAES256GCM, ks.Cipher)
+		assert.Equal(t, kdfParams.Function, ks.Kdf.Function)
+		assert.NotEqual(t, ks.Keys[0].Nonce, ks.Keys[1].Nonce)
+
+		entries, err := ks.Decrypt(passphrase)
+		assert.Nil(t, err)
+		assert.Equal(t, createTestEntries(), entries)
+	}
+}
+
+func TestKeystore_DecryptWithWrongPassphraseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ks, _ := Encrypt(createTestEntries(), passphrase, createTestScryptParams())
+
+	entries, err := ks.Decrypt([]byte("wrong passphrase"))
+	assert.Nil(t, entries)
+	assert.Equal(t, ErrWrongPassphrase, err)
+}
+
+func TestKeystore_DecryptWithSwappedIdentifiersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ks, _ := Encrypt(createTestEntries(), passphrase, createTestScryptParams())
+	ks.Keys[0].Identifier, ks.Keys[1].Identifier = ks.Keys[1].Identifier, ks.Keys[0].Identifier
+
+	entries, err := ks.Decrypt(passphrase)
+	assert.Nil(t, entries)
+	assert.Equal(t, ErrWrongPassphrase, err)
+}
+
+func TestKeystore_DecryptUnsupportedFormatShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ks, _ := Encrypt(createTestEntries(), passphrase, createTestScryptParams())
+	ks.Version = 2
+	_, err := ks.Decrypt(passphrase)
+	assert.True(t, errors.Is(err, ErrUnsupportedVersion))
+
+	ks, _ = Encrypt(createTestEntries(), passphrase, createTestScryptParams())
+	ks.Cipher = "aes-128-ctr"
+	_, err = ks.Decrypt(passphrase)
+	assert.True(t, errors.Is(err, ErrUnsupportedCipher))
+
+	ks, _ = Encrypt(createTestEntries(), passphrase, createTestScryptParams())
+	ks.Kdf.N = maxScryptN * 2
+	_, err = ks.Decrypt(passphrase)
+	assert.True(t, errors.Is(err, ErrInvalidKdfParams))
+}
+
+func TestNewKdfParams(t *testing.T) {
+	t.Parallel()
+
+	params, err := NewKdfParams(KdfScrypt)
+	assert.Nil(t, err)
+	assert.Equal(t, KdfScrypt, params.Function)
+
+	params, err = NewKdfParams(KdfArgon2id)
+	assert.Nil(t, err)
+	assert.Equal(t, KdfArgon2id, params.Function)
+
+	_, err = NewKdfParams("pbkdf2")
+	assert.True(t, errors.Is(err, ErrUnsupportedKdf))
+}
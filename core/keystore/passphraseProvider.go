@@ -0,0 +1,105 @@
+package keystore
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// PassphraseEnvVariable is the environment variable the passphrase of the keystores can be provided in. The variable is
+// removed from the environment once read, so it is not inherited by the processes started by the node
+const PassphraseEnvVariable = "ELROND_KEYSTORE_PASSPHRASE"
+
+// ArgsPassphraseProvider is the DTO used to create a new instance of passphraseProvider
+type ArgsPassphraseProvider struct {
+	// PassphraseFile is the file holding the passphrase, it takes precedence over the environment and the prompt
+	PassphraseFile string
+	// EnvVariable is the environment variable holding the passphrase, checked when no file is provided
+	EnvVariable string
+}
+
+// passphraseProvider reads the passphrase of the keystores from a file, from the environment or, when the standard
+// input is a terminal, from a prompt. The passphrase is read once and used for all the keystores of the process
+type passphraseProvider struct {
+	passphraseFile string
+	envVariable    string
+	isTerminal     func() bool
+	readPassword   func() ([]byte, error)
+
+	mut        sync.Mutex
+	passphrase []byte
+}
+
+// NewPassphraseProvider creates a new passphrase provider
+func NewPassphraseProvider(args ArgsPassphraseProvider) *passphraseProvider {
+	return &passphraseProvider{
+		passphraseFile: args.PassphraseFile,
+		envVariable:    args.EnvVariable,
+		isTerminal: func() bool {
+			return terminal.IsTerminal(int(os.Stdin.Fd()))
+		},
+		readPassword: func() ([]byte, error) {
+			return terminal.ReadPassword(int(os.Stdin.Fd()))
+		},
+	}
+}
+
+// Passphrase returns the passphrase of the provided keystore file
+func (pp *passphraseProvider) Passphrase(fileName string) ([]byte, error) {
+	pp.mut.Lock()
+	defer pp.mut.Unlock()
+
+	if len(pp.passphrase) > 0 {
+		return pp.passphrase, nil
+	}
+
+	passphrase, err := pp.readPassphrase(fileName)
+	if err != nil {
+		return nil, err
+	}
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+	pp.passphrase = passphrase
+
+	return passphrase, nil
+}
+
+func (pp *passphraseProvider) readPassphrase(fileName string) ([]byte, error) {
+	if len(pp.passphraseFile) > 0 {
+		buff, err := ioutil.ReadFile(pp.passphraseFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w while reading the passphrase file", err)
+		}
+
+		return bytes.TrimRight(buff, "\r\n"), nil
+	}
+
+	if len(pp.envVariable) > 0 {
+		passphrase, found := os.LookupEnv(pp.envVariable)
+		if found {
+			_ = os.Unsetenv(pp.envVariable)
+			return []byte(passphrase), nil
+		}
+	}
+
+	if !pp.isTerminal() {
+		return nil, fmt.Errorf("%w for the keystore %s: provide a passphrase file or the %s environment variable",
+			ErrNoPassphraseSource, fileName, pp.envVariable)
+	}
+
+	_, _ = fmt.Fprintf(os.Stderr, "Passphrase for %s: ", fileName)
+	passphrase, err := pp.readPassword()
+	_, _ = fmt.Fprintln(os.Stderr)
+
+	return passphrase, err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pp *passphraseProvider) IsInterfaceNil() bool {
+	return pp == nil
+}
@@ -0,0 +1,88 @@
+package keystore
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPassphraseProvider_FromFileShouldTrimTheNewLine(t *testing.T) {
+	t.Parallel()
+
+	file, err := ioutil.TempFile("", "passphrase")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.Remove(file.Name())
+	}()
+	_, _ = file.WriteString("passphrase\n")
+	_ = file.Close()
+
+	pp := NewPassphraseProvider(ArgsPassphraseProvider{PassphraseFile: file.Name()})
+	passphrase, err := pp.Passphrase("keys.json")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("passphrase"), passphrase)
+}
+
+func TestPassphraseProvider_FromEnvironmentShouldReadOnceAndUnset(t *testing.T) {
+	envVariable := "ELROND_KEYSTORE_PASSPHRASE_TEST"
+	_ = os.Setenv(envVariable, "passphrase")
+
+	pp := NewPassphraseProvider(ArgsPassphraseProvider{EnvVariable: envVariable})
+	pp.isTerminal = func() bool {
+		return false
+	}
+
+	passphrase, err := pp.Passphrase("keys.json")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("passphrase"), passphrase)
+
+	_, found := os.LookupEnv(envVariable)
+	assert.False(t, found)
+
+	passphrase, err = pp.Passphrase("other keys.json")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("passphrase"), passphrase)
+}
+
+func TestPassphraseProvider_FromPrompt(t *testing.T) {
+	t.Parallel()
+
+	pp := NewPassphraseProvider(ArgsPassphraseProvider{EnvVariable: "ELROND_KEYSTORE_PASSPHRASE_MISSING"})
+	pp.isTerminal = func() bool {
+		return true
+	}
+	pp.readPassword = func() ([]byte, error) {
+		return []byte("typed passphrase"), nil
+	}
+
+	passphrase, err := pp.Passphrase("keys.json")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("typed passphrase"), passphrase)
+}
+
+func TestPassphraseProvider_NoSourceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pp := NewPassphraseProvider(ArgsPassphraseProvider{EnvVariable: "ELROND_KEYSTORE_PASSPHRASE_MISSING"})
+	pp.isTerminal = func() bool {
+		return false
+	}
+
+	passphrase, err := pp.Passphrase("keys.json")
+	assert.Nil(t, passphrase)
+	assert.True(t, errors.Is(err, ErrNoPassphraseSource))
+
+	pp.isTerminal = func() bool {
+		return true
+	}
+	pp.readPassword = func() ([]byte, error) {
+		return nil, nil
+	}
+	passphrase, err = pp.Passphrase("keys.json")
+	assert.Nil(t, passphrase)
+	assert.Equal(t, ErrEmptyPassphrase, err)
+}
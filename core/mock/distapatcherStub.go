@@ -1,12 +1,17 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+)
 
 // DispatcherMock -
 type DispatcherMock struct {
 	StartIndexDataCalled func()
 	CloseCalled          func() error
 	AddCalled            func(item workItems.WorkItemHandler)
+	FlushCalled          func(ctx context.Context) error
 }
 
 // StartIndexData -
@@ -31,6 +36,14 @@ func (dm *DispatcherMock) Add(item workItems.WorkItemHandler) {
 	}
 }
 
+// Flush -
+func (dm *DispatcherMock) Flush(ctx context.Context) error {
+	if dm.FlushCalled != nil {
+		return dm.FlushCalled(ctx)
+	}
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (dm *DispatcherMock) IsInterfaceNil() bool {
 	return dm == nil
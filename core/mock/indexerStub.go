@@ -0,0 +1,109 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// IndexerStub -
+type IndexerStub struct {
+	SetTxLogsProcessorCalled    func(txLogsProc process.TransactionLogProcessorDatabase)
+	SaveBlockCalled             func(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler, signersIndexes []uint64, notarizedHeadersHashes []string, headerHash []byte)
+	RevertIndexedBlockCalled    func(header data.HeaderHandler, body data.BodyHandler)
+	SaveRoundsInfoCalled        func(roundsInfos []workItems.RoundInfo)
+	UpdateTPSCalled             func(tpsBenchmark statistics.TPSBenchmark)
+	SaveValidatorsPubKeysCalled func(validatorsPubKeys map[uint32][][]byte, epoch uint32)
+	SaveValidatorsRatingCalled  func(indexID string, infoRating []workItems.ValidatorRatingInfo)
+	SaveAccountsCalled          func(acc []state.UserAccountHandler)
+	CloseCalled                 func() error
+	IsNilIndexerCalled          func() bool
+}
+
+// SetTxLogsProcessor -
+func (is *IndexerStub) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	if is.SetTxLogsProcessorCalled != nil {
+		is.SetTxLogsProcessorCalled(txLogsProc)
+	}
+}
+
+// SaveBlock -
+func (is *IndexerStub) SaveBlock(
+	body data.BodyHandler,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+	notarizedHeadersHashes []string,
+	headerHash []byte,
+) {
+	if is.SaveBlockCalled != nil {
+		is.SaveBlockCalled(body, header, txPool, signersIndexes, notarizedHeadersHashes, headerHash)
+	}
+}
+
+// RevertIndexedBlock -
+func (is *IndexerStub) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) {
+	if is.RevertIndexedBlockCalled != nil {
+		is.RevertIndexedBlockCalled(header, body)
+	}
+}
+
+// SaveRoundsInfo -
+func (is *IndexerStub) SaveRoundsInfo(roundsInfos []workItems.RoundInfo) {
+	if is.SaveRoundsInfoCalled != nil {
+		is.SaveRoundsInfoCalled(roundsInfos)
+	}
+}
+
+// UpdateTPS -
+func (is *IndexerStub) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
+	if is.UpdateTPSCalled != nil {
+		is.UpdateTPSCalled(tpsBenchmark)
+	}
+}
+
+// SaveValidatorsPubKeys -
+func (is *IndexerStub) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) {
+	if is.SaveValidatorsPubKeysCalled != nil {
+		is.SaveValidatorsPubKeysCalled(validatorsPubKeys, epoch)
+	}
+}
+
+// SaveValidatorsRating -
+func (is *IndexerStub) SaveValidatorsRating(indexID string, infoRating []workItems.ValidatorRatingInfo) {
+	if is.SaveValidatorsRatingCalled != nil {
+		is.SaveValidatorsRatingCalled(indexID, infoRating)
+	}
+}
+
+// SaveAccounts -
+func (is *IndexerStub) SaveAccounts(acc []state.UserAccountHandler) {
+	if is.SaveAccountsCalled != nil {
+		is.SaveAccountsCalled(acc)
+	}
+}
+
+// Close -
+func (is *IndexerStub) Close() error {
+	if is.CloseCalled != nil {
+		return is.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsNilIndexer -
+func (is *IndexerStub) IsNilIndexer() bool {
+	if is.IsNilIndexerCalled != nil {
+		return is.IsNilIndexerCalled()
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (is *IndexerStub) IsInterfaceNil() bool {
+	return is == nil
+}
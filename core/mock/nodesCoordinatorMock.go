@@ -224,6 +224,11 @@ func (ncm *NodesCoordinatorMock) GetConsensusWhitelistedNodes(
 	panic("not implemented")
 }
 
+// GetEpochValidatorsAssignment -
+func (ncm *NodesCoordinatorMock) GetEpochValidatorsAssignment(_ uint32) (*sharding.EpochValidatorsAssignment, error) {
+	return nil, nil
+}
+
 // ValidatorsWeights -
 func (ncm *NodesCoordinatorMock) ValidatorsWeights(validators []sharding.Validator) ([]uint32, error) {
 	weights := make([]uint32, len(validators))
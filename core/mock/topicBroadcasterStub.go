@@ -0,0 +1,38 @@
+package mock
+
+// TopicBroadcasterStub -
+type TopicBroadcasterStub struct {
+	HasTopicCalled    func(name string) bool
+	CreateTopicCalled func(name string, createChannelForTopic bool) error
+	BroadcastCalled   func(topic string, buff []byte)
+}
+
+// HasTopic -
+func (tbs *TopicBroadcasterStub) HasTopic(name string) bool {
+	if tbs.HasTopicCalled != nil {
+		return tbs.HasTopicCalled(name)
+	}
+
+	return false
+}
+
+// CreateTopic -
+func (tbs *TopicBroadcasterStub) CreateTopic(name string, createChannelForTopic bool) error {
+	if tbs.CreateTopicCalled != nil {
+		return tbs.CreateTopicCalled(name, createChannelForTopic)
+	}
+
+	return nil
+}
+
+// Broadcast -
+func (tbs *TopicBroadcasterStub) Broadcast(topic string, buff []byte) {
+	if tbs.BroadcastCalled != nil {
+		tbs.BroadcastCalled(topic, buff)
+	}
+}
+
+// IsInterfaceNil -
+func (tbs *TopicBroadcasterStub) IsInterfaceNil() bool {
+	return tbs == nil
+}
@@ -1,3 +1,5 @@
 package pubkeyConverter
 
-var Prefix = bech32Config.prefix
+import "github.com/ElrondNetwork/elrond-go/core/addressRouting"
+
+var Prefix = addressRouting.Bech32Prefix
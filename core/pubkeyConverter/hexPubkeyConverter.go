@@ -1,10 +1,9 @@
 package pubkeyConverter
 
 import (
-	"encoding/hex"
 	"fmt"
 
-	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/core/addressRouting"
 )
 
 // hexPubkeyConverter encodes or decodes provided public key as/from hex
@@ -14,13 +13,9 @@ type hexPubkeyConverter struct {
 
 // NewHexPubkeyConverter returns a hexPubkeyConverter instance
 func NewHexPubkeyConverter(addressLen int) (*hexPubkeyConverter, error) {
-	if addressLen < 1 {
-		return nil, fmt.Errorf("%w when creating hex address converter, addressLen should have been greater than 0",
-			state.ErrInvalidAddressLength)
-	}
-	if addressLen%2 == 1 {
-		return nil, fmt.Errorf("%w when creating hex address converter, addressLen should have been an even number",
-			state.ErrInvalidAddressLength)
+	err := addressRouting.CheckAddressLength(addressLen)
+	if err != nil {
+		return nil, fmt.Errorf("%w when creating hex address converter", err)
 	}
 
 	return &hexPubkeyConverter{
@@ -30,22 +25,12 @@ func NewHexPubkeyConverter(addressLen int) (*hexPubkeyConverter, error) {
 
 // Decode converts the provided public key string as hex decoded bytes
 func (ppc *hexPubkeyConverter) Decode(humanReadable string) ([]byte, error) {
-	buff, err := hex.DecodeString(humanReadable)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(buff) != ppc.len {
-		return nil, fmt.Errorf("%w when converting to address, expected length %d, received %d",
-			state.ErrWrongSize, ppc.len, len(buff))
-	}
-
-	return buff, nil
+	return addressRouting.DecodeHex(humanReadable, ppc.len)
 }
 
 // Encode converts the provided bytes in a form that this converter can decode. In this case it will encode to hex
 func (ppc *hexPubkeyConverter) Encode(pkBytes []byte) string {
-	return hex.EncodeToString(pkBytes)
+	return addressRouting.EncodeHex(pkBytes)
 }
 
 // Len returns the decoded address length
@@ -0,0 +1,220 @@
+package statistics
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+// maxPendingCrossShardMiniBlocks bounds the number of tracked miniblocks not yet executed in their destination shard
+const maxPendingCrossShardMiniBlocks = 100000
+
+var _ CrossShardStatisticsHandler = (*CrossShardStatistics)(nil)
+
+// CrossShardPathStatistics holds the routing statistics of the miniblocks sent from a shard to another shard
+type CrossShardPathStatistics struct {
+	SenderShardID                  uint32
+	ReceiverShardID                uint32
+	NumMiniBlocks                  uint64
+	NumTxs                         uint64
+	NumExecutedMiniBlocks          uint64
+	AverageNotarizationDelayInMs   uint64
+	NumPendingMiniBlocks           uint64
+	NumPendingTxs                  uint64
+	totalNotarizationDelayInRounds uint64
+}
+
+type crossShardPath struct {
+	sender   uint32
+	receiver uint32
+}
+
+type pendingCrossShardMiniBlock struct {
+	path    crossShardPath
+	round   uint64
+	txCount uint32
+}
+
+// CrossShardStatistics computes the cross shard routing statistics from the committed meta blocks. A miniblock is
+// accounted when the block of its sender shard is notarized and it is pending until the block of the receiver shard
+// executing it is notarized. The notarization delay is measured between these two moments
+type CrossShardStatistics struct {
+	mut               sync.RWMutex
+	roundDurationInMs uint64
+	statusHandler     core.AppStatusHandler
+	paths             map[crossShardPath]*CrossShardPathStatistics
+	pending           map[string]*pendingCrossShardMiniBlock
+	lastMetaNonce     uint64
+}
+
+// NewCrossShardStatistics creates a new cross shard statistics instance
+func NewCrossShardStatistics(appStatusHandler core.AppStatusHandler, roundDurationInMs uint64) (*CrossShardStatistics, error) {
+	if check.IfNil(appStatusHandler) {
+		return nil, ErrNilStatusHandler
+	}
+	if roundDurationInMs == 0 {
+		return nil, ErrInvalidRoundDuration
+	}
+
+	return &CrossShardStatistics{
+		roundDurationInMs: roundDurationInMs,
+		statusHandler:     appStatusHandler,
+		paths:             make(map[crossShardPath]*CrossShardPathStatistics),
+		pending:           make(map[string]*pendingCrossShardMiniBlock),
+	}, nil
+}
+
+// Update receives a committed meta block and updates the statistics of the notarized cross shard miniblocks
+func (css *CrossShardStatistics) Update(header data.HeaderHandler) {
+	if check.IfNil(header) {
+		return
+	}
+
+	metaBlock, ok := header.(*block.MetaBlock)
+	if !ok {
+		return
+	}
+
+	css.mut.Lock()
+	defer css.mut.Unlock()
+
+	if metaBlock.Nonce <= css.lastMetaNonce {
+		return
+	}
+	css.lastMetaNonce = metaBlock.Nonce
+
+	for _, shardData := range metaBlock.ShardInfo {
+		css.processMiniBlockHeaders(shardData.ShardID, shardData.Round, metaBlock.Round, shardData.ShardMiniBlockHeaders)
+	}
+	css.processMiniBlockHeaders(core.MetachainShardId, metaBlock.Round, metaBlock.Round, metaBlock.MiniBlockHeaders)
+
+	css.saveMetrics()
+}
+
+func (css *CrossShardStatistics) processMiniBlockHeaders(
+	shardID uint32,
+	shardRound uint64,
+	metaRound uint64,
+	miniBlockHeaders []block.MiniBlockHeader,
+) {
+	for _, mbHeader := range miniBlockHeaders {
+		isCrossShard := mbHeader.SenderShardID != mbHeader.ReceiverShardID && mbHeader.ReceiverShardID != core.AllShardId
+		if !isCrossShard {
+			continue
+		}
+
+		path := crossShardPath{sender: mbHeader.SenderShardID, receiver: mbHeader.ReceiverShardID}
+		switch shardID {
+		case mbHeader.SenderShardID:
+			css.addSentMiniBlock(path, mbHeader.Hash, shardRound, mbHeader.TxCount)
+		case mbHeader.ReceiverShardID:
+			css.addExecutedMiniBlock(mbHeader.Hash, metaRound)
+		}
+	}
+}
+
+func (css *CrossShardStatistics) addSentMiniBlock(path crossShardPath, hash []byte, round uint64, txCount uint32) {
+	_, alreadySent := css.pending[string(hash)]
+	if alreadySent {
+		return
+	}
+
+	pathStatistics := css.getOrCreatePath(path)
+	pathStatistics.NumMiniBlocks++
+	pathStatistics.NumTxs += uint64(txCount)
+
+	if len(css.pending) >= maxPendingCrossShardMiniBlocks {
+		log.Trace("CrossShardStatistics: too many pending miniblocks", "num", len(css.pending))
+		return
+	}
+
+	css.pending[string(hash)] = &pendingCrossShardMiniBlock{
+		path:    path,
+		round:   round,
+		txCount: txCount,
+	}
+	pathStatistics.NumPendingMiniBlocks++
+	pathStatistics.NumPendingTxs += uint64(txCount)
+}
+
+func (css *CrossShardStatistics) addExecutedMiniBlock(hash []byte, round uint64) {
+	pendingMiniBlock, ok := css.pending[string(hash)]
+	if !ok {
+		return
+	}
+	delete(css.pending, string(hash))
+
+	pathStatistics := css.getOrCreatePath(pendingMiniBlock.path)
+	pathStatistics.NumPendingMiniBlocks--
+	pathStatistics.NumPendingTxs -= uint64(pendingMiniBlock.txCount)
+	pathStatistics.NumExecutedMiniBlocks++
+	if round > pendingMiniBlock.round {
+		pathStatistics.totalNotarizationDelayInRounds += round - pendingMiniBlock.round
+	}
+	pathStatistics.AverageNotarizationDelayInMs = pathStatistics.totalNotarizationDelayInRounds * css.roundDurationInMs /
+		pathStatistics.NumExecutedMiniBlocks
+}
+
+func (css *CrossShardStatistics) getOrCreatePath(path crossShardPath) *CrossShardPathStatistics {
+	pathStatistics, ok := css.paths[path]
+	if !ok {
+		pathStatistics = &CrossShardPathStatistics{
+			SenderShardID:   path.sender,
+			ReceiverShardID: path.receiver,
+		}
+		css.paths[path] = pathStatistics
+	}
+
+	return pathStatistics
+}
+
+func (css *CrossShardStatistics) saveMetrics() {
+	numPendingMiniBlocks := uint64(0)
+	numPendingTxs := uint64(0)
+	numExecutedMiniBlocks := uint64(0)
+	totalDelayInRounds := uint64(0)
+	for _, pathStatistics := range css.paths {
+		numPendingMiniBlocks += pathStatistics.NumPendingMiniBlocks
+		numPendingTxs += pathStatistics.NumPendingTxs
+		numExecutedMiniBlocks += pathStatistics.NumExecutedMiniBlocks
+		totalDelayInRounds += pathStatistics.totalNotarizationDelayInRounds
+	}
+
+	averageDelayInMs := uint64(0)
+	if numExecutedMiniBlocks > 0 {
+		averageDelayInMs = totalDelayInRounds * css.roundDurationInMs / numExecutedMiniBlocks
+	}
+
+	css.statusHandler.SetUInt64Value(core.MetricCrossShardPendingMiniBlocks, numPendingMiniBlocks)
+	css.statusHandler.SetUInt64Value(core.MetricCrossShardPendingTxs, numPendingTxs)
+	css.statusHandler.SetUInt64Value(core.MetricCrossShardAverageNotarizationDelay, averageDelayInMs)
+}
+
+// PathsStatistics returns a copy of the statistics of each shard pair, sorted by the sender and the receiver shards
+func (css *CrossShardStatistics) PathsStatistics() []CrossShardPathStatistics {
+	css.mut.RLock()
+	result := make([]CrossShardPathStatistics, 0, len(css.paths))
+	for _, pathStatistics := range css.paths {
+		result = append(result, *pathStatistics)
+	}
+	css.mut.RUnlock()
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].SenderShardID != result[j].SenderShardID {
+			return result[i].SenderShardID < result[j].SenderShardID
+		}
+
+		return result[i].ReceiverShardID < result[j].ReceiverShardID
+	})
+
+	return result
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (css *CrossShardStatistics) IsInterfaceNil() bool {
+	return css == nil
+}
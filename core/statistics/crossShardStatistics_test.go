@@ -0,0 +1,103 @@
+package statistics_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const roundDurationInMs = 6000
+
+func createCrossShardMetaBlock(nonce uint64, round uint64, shardInfo ...block.ShardData) *block.MetaBlock {
+	return &block.MetaBlock{
+		Nonce:     nonce,
+		Round:     round,
+		ShardInfo: shardInfo,
+	}
+}
+
+func TestNewCrossShardStatistics(t *testing.T) {
+	t.Parallel()
+
+	css, err := statistics.NewCrossShardStatistics(nil, roundDurationInMs)
+	assert.True(t, check.IfNil(css))
+	assert.Equal(t, statistics.ErrNilStatusHandler, err)
+
+	css, err = statistics.NewCrossShardStatistics(&mock.AppStatusHandlerStub{}, 0)
+	assert.True(t, check.IfNil(css))
+	assert.Equal(t, statistics.ErrInvalidRoundDuration, err)
+
+	css, err = statistics.NewCrossShardStatistics(&mock.AppStatusHandlerStub{}, roundDurationInMs)
+	assert.False(t, check.IfNil(css))
+	assert.Nil(t, err)
+}
+
+func TestCrossShardStatistics_Update(t *testing.T) {
+	t.Parallel()
+
+	metrics := make(map[string]uint64)
+	statusHandler := &mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			metrics[key] = value
+		},
+	}
+	css, _ := statistics.NewCrossShardStatistics(statusHandler, roundDurationInMs)
+
+	css.Update(createCrossShardMetaBlock(1, 11,
+		block.ShardData{
+			ShardID: 0,
+			Round:   10,
+			ShardMiniBlockHeaders: []block.MiniBlockHeader{
+				{Hash: []byte("mb1"), SenderShardID: 0, ReceiverShardID: 1, TxCount: 10},
+				{Hash: []byte("mb2"), SenderShardID: 0, ReceiverShardID: 1, TxCount: 5},
+				{Hash: []byte("mb3"), SenderShardID: 0, ReceiverShardID: 0, TxCount: 100},
+				{Hash: []byte("mb4"), SenderShardID: 0, ReceiverShardID: core.MetachainShardId, TxCount: 1},
+			},
+		},
+	))
+	assert.Equal(t, uint64(3), metrics[core.MetricCrossShardPendingMiniBlocks])
+	assert.Equal(t, uint64(16), metrics[core.MetricCrossShardPendingTxs])
+
+	css.Update(createCrossShardMetaBlock(2, 13,
+		block.ShardData{
+			ShardID: 1,
+			Round:   12,
+			ShardMiniBlockHeaders: []block.MiniBlockHeader{
+				{Hash: []byte("mb1"), SenderShardID: 0, ReceiverShardID: 1, TxCount: 10},
+			},
+		},
+	))
+	assert.Equal(t, uint64(2), metrics[core.MetricCrossShardPendingMiniBlocks])
+	assert.Equal(t, uint64(6), metrics[core.MetricCrossShardPendingTxs])
+	assert.Equal(t, uint64(3*roundDurationInMs), metrics[core.MetricCrossShardAverageNotarizationDelay])
+
+	// an already processed meta block is ignored
+	css.Update(createCrossShardMetaBlock(2, 13,
+		block.ShardData{
+			ShardID: 1,
+			Round:   12,
+			ShardMiniBlockHeaders: []block.MiniBlockHeader{
+				{Hash: []byte("mb2"), SenderShardID: 0, ReceiverShardID: 1, TxCount: 5},
+			},
+		},
+	))
+
+	pathsStatistics := css.PathsStatistics()
+	require.Equal(t, 2, len(pathsStatistics))
+	assert.Equal(t, uint32(0), pathsStatistics[0].SenderShardID)
+	assert.Equal(t, uint32(1), pathsStatistics[0].ReceiverShardID)
+	assert.Equal(t, uint64(2), pathsStatistics[0].NumMiniBlocks)
+	assert.Equal(t, uint64(15), pathsStatistics[0].NumTxs)
+	assert.Equal(t, uint64(1), pathsStatistics[0].NumExecutedMiniBlocks)
+	assert.Equal(t, uint64(3*roundDurationInMs), pathsStatistics[0].AverageNotarizationDelayInMs)
+	assert.Equal(t, uint64(1), pathsStatistics[0].NumPendingMiniBlocks)
+	assert.Equal(t, uint64(5), pathsStatistics[0].NumPendingTxs)
+	assert.Equal(t, core.MetachainShardId, pathsStatistics[1].ReceiverShardID)
+	assert.Equal(t, uint64(1), pathsStatistics[1].NumPendingMiniBlocks)
+}
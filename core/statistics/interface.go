@@ -36,3 +36,10 @@ type ShardStatistic interface {
 	TotalProcessedTxCount() *big.Int
 	IsInterfaceNil() bool
 }
+
+// CrossShardStatisticsHandler defines the component computing the cross shard routing statistics
+type CrossShardStatisticsHandler interface {
+	Update(metaBlock data.HeaderHandler)
+	PathsStatistics() []CrossShardPathStatistics
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,15 @@
+package machine
+
+import (
+	"github.com/shirou/gopsutil/disk"
+)
+
+// GetDiskFreeBytes returns the number of bytes available on the disk holding the provided path
+func GetDiskFreeBytes(path string) (uint64, error) {
+	usage, err := disk.Usage(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return usage.Free, nil
+}
@@ -0,0 +1,20 @@
+package machine
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDiskFreeBytes(t *testing.T) {
+	t.Parallel()
+
+	freeBytes, err := GetDiskFreeBytes(os.TempDir())
+	assert.Nil(t, err)
+	assert.True(t, freeBytes > 0)
+
+	freeBytes, err = GetDiskFreeBytes("/missing/directory")
+	assert.NotNil(t, err)
+	assert.Equal(t, uint64(0), freeBytes)
+}
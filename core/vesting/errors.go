@@ -0,0 +1,6 @@
+package vesting
+
+import "errors"
+
+// ErrInvalidVestingSchedule signals that an invalid vesting schedule has been provided
+var ErrInvalidVestingSchedule = errors.New("invalid vesting schedule")
@@ -0,0 +1,72 @@
+package vesting
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+)
+
+const epochSizeInBytes = 4
+
+// Schedule defines the locked part of an account balance. The whole value is locked until the cliff epoch, after which
+// it is released linearly, starting from the genesis epoch, so that the entire value becomes available in the end epoch
+type Schedule struct {
+	Value      *big.Int
+	CliffEpoch uint32
+	EndEpoch   uint32
+}
+
+// Check returns an error if the schedule is not valid
+func (s *Schedule) Check() error {
+	if s.Value == nil || s.Value.Sign() < 0 {
+		return fmt.Errorf("%w: negative or missing value", ErrInvalidVestingSchedule)
+	}
+	if s.CliffEpoch > s.EndEpoch {
+		return fmt.Errorf("%w: cliff epoch %d is after the end epoch %d",
+			ErrInvalidVestingSchedule, s.CliffEpoch, s.EndEpoch)
+	}
+
+	return nil
+}
+
+// LockedValue returns the part of the value that is still locked in the provided epoch
+func (s *Schedule) LockedValue(epoch uint32) *big.Int {
+	if epoch >= s.EndEpoch {
+		return big.NewInt(0)
+	}
+	if epoch < s.CliffEpoch {
+		return big.NewInt(0).Set(s.Value)
+	}
+
+	locked := big.NewInt(0).Mul(s.Value, big.NewInt(int64(s.EndEpoch-epoch)))
+
+	return locked.Div(locked, big.NewInt(int64(s.EndEpoch)))
+}
+
+// ToBytes encodes the schedule as the big endian cliff and end epochs followed by the value bytes
+func (s *Schedule) ToBytes() []byte {
+	buff := make([]byte, 2*epochSizeInBytes)
+	binary.BigEndian.PutUint32(buff, s.CliffEpoch)
+	binary.BigEndian.PutUint32(buff[epochSizeInBytes:], s.EndEpoch)
+
+	return append(buff, s.Value.Bytes()...)
+}
+
+// NewScheduleFromBytes decodes a schedule encoded with ToBytes
+func NewScheduleFromBytes(buff []byte) (*Schedule, error) {
+	if len(buff) < 2*epochSizeInBytes {
+		return nil, fmt.Errorf("%w: %d bytes provided", ErrInvalidVestingSchedule, len(buff))
+	}
+
+	s := &Schedule{
+		CliffEpoch: binary.BigEndian.Uint32(buff),
+		EndEpoch:   binary.BigEndian.Uint32(buff[epochSizeInBytes:]),
+		Value:      big.NewInt(0).SetBytes(buff[2*epochSizeInBytes:]),
+	}
+	err := s.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
@@ -0,0 +1,75 @@
+package vesting
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedule_CheckCliffAfterEndShouldErr(t *testing.T) {
+	t.Parallel()
+
+	s := &Schedule{
+		Value:      big.NewInt(10),
+		CliffEpoch: 5,
+		EndEpoch:   4,
+	}
+
+	err := s.Check()
+	assert.True(t, errors.Is(err, ErrInvalidVestingSchedule))
+}
+
+func TestSchedule_CheckNegativeValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	s := &Schedule{
+		Value:    big.NewInt(-1),
+		EndEpoch: 4,
+	}
+
+	err := s.Check()
+	assert.True(t, errors.Is(err, ErrInvalidVestingSchedule))
+}
+
+func TestSchedule_LockedValue(t *testing.T) {
+	t.Parallel()
+
+	s := &Schedule{
+		Value:      big.NewInt(1000),
+		CliffEpoch: 2,
+		EndEpoch:   10,
+	}
+
+	assert.Equal(t, big.NewInt(1000), s.LockedValue(0))
+	assert.Equal(t, big.NewInt(1000), s.LockedValue(1))
+	assert.Equal(t, big.NewInt(800), s.LockedValue(2))
+	assert.Equal(t, big.NewInt(500), s.LockedValue(5))
+	assert.Equal(t, big.NewInt(100), s.LockedValue(9))
+	assert.Equal(t, big.NewInt(0), s.LockedValue(10))
+	assert.Equal(t, big.NewInt(0), s.LockedValue(100))
+}
+
+func TestSchedule_ToBytesNewScheduleFromBytes(t *testing.T) {
+	t.Parallel()
+
+	s := &Schedule{
+		Value:      big.NewInt(123456789),
+		CliffEpoch: 30,
+		EndEpoch:   365,
+	}
+
+	recovered, err := NewScheduleFromBytes(s.ToBytes())
+	require.Nil(t, err)
+	assert.Equal(t, s, recovered)
+}
+
+func TestNewScheduleFromBytes_ShortBufferShouldErr(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewScheduleFromBytes([]byte{1, 2, 3})
+	assert.Nil(t, s)
+	assert.True(t, errors.Is(err, ErrInvalidVestingSchedule))
+}
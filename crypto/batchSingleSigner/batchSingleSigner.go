@@ -0,0 +1,286 @@
+package batchSingleSigner
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+var log = logger.GetOrCreate("crypto/batchsinglesigner")
+
+const minFlushInterval = time.Millisecond
+
+// ArgsBatchSingleSigner is the DTO used to create a new instance of batchSingleSigner
+type ArgsBatchSingleSigner struct {
+	SingleSigner  crypto.SingleSigner
+	NumWorkers    int
+	MaxBatchSize  int
+	FlushInterval time.Duration
+}
+
+type verificationRequest struct {
+	publicKey crypto.PublicKey
+	msg       []byte
+	sig       []byte
+	chResults []chan error
+}
+
+// batchSingleSigner is a wrapper over a single signer that collects the pending signature verifications in batches,
+// closed when they reach the maximum size or when the flush interval elapses, and resolves them on a pool of workers.
+// When the wrapped single signer is also a batch verifier, a batch is verified at once and only a failed batch is
+// verified again signature by signature. Otherwise, as for the ed25519 signer, the signatures of a batch are verified
+// in parallel, at most as many at a time as the number of workers. Identical verification requests that are pending
+// at the same time are only verified once.
+type batchSingleSigner struct {
+	singleSigner  crypto.SingleSigner
+	batchVerifier crypto.BatchVerifier
+	maxBatchSize  int
+	flushInterval time.Duration
+	mutPending    sync.Mutex
+	pending       map[string]*verificationRequest
+	chBatches     chan []*verificationRequest
+	chVerifySlots chan struct{}
+	ctx           context.Context
+	cancelFunc    func()
+}
+
+// NewBatchSingleSigner creates a new batchSingleSigner instance and starts its workers
+func NewBatchSingleSigner(args ArgsBatchSingleSigner) (*batchSingleSigner, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	bss := &batchSingleSigner{
+		singleSigner:  args.SingleSigner,
+		maxBatchSize:  args.MaxBatchSize,
+		flushInterval: args.FlushInterval,
+		pending:       make(map[string]*verificationRequest),
+		chBatches:     make(chan []*verificationRequest, args.NumWorkers),
+		chVerifySlots: make(chan struct{}, args.NumWorkers),
+	}
+	bss.batchVerifier, _ = args.SingleSigner.(crypto.BatchVerifier)
+	bss.ctx, bss.cancelFunc = context.WithCancel(context.Background())
+
+	for i := 0; i < args.NumWorkers; i++ {
+		go bss.processBatches()
+	}
+	go bss.flushContinuously()
+
+	return bss, nil
+}
+
+func checkArgs(args ArgsBatchSingleSigner) error {
+	if check.IfNil(args.SingleSigner) {
+		return crypto.ErrNilSingleSigner
+	}
+	if args.NumWorkers < 1 {
+		return fmt.Errorf("%w, provided %d", crypto.ErrInvalidNumOfWorkers, args.NumWorkers)
+	}
+	if args.MaxBatchSize < 1 {
+		return fmt.Errorf("%w, provided %d", crypto.ErrInvalidBatchSize, args.MaxBatchSize)
+	}
+	if args.FlushInterval < minFlushInterval {
+		return fmt.Errorf("%w, provided %v, minimum %v", crypto.ErrInvalidFlushInterval, args.FlushInterval, minFlushInterval)
+	}
+
+	return nil
+}
+
+// Sign delegates the signing to the wrapped single signer
+func (bss *batchSingleSigner) Sign(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	return bss.singleSigner.Sign(private, msg)
+}
+
+// Verify adds the signature to the pending batch and waits for the batch to be verified
+func (bss *batchSingleSigner) Verify(public crypto.PublicKey, msg []byte, sig []byte) error {
+	if check.IfNil(public) {
+		return crypto.ErrNilPublicKey
+	}
+	if len(msg) == 0 {
+		return crypto.ErrNilMessage
+	}
+	if len(sig) == 0 {
+		return crypto.ErrNilSignature
+	}
+
+	pkBytes, err := public.ToByteArray()
+	if err != nil {
+		return err
+	}
+
+	chResult := make(chan error, 1)
+	fullBatch := bss.addRequest(public, pkBytes, msg, sig, chResult)
+	if fullBatch != nil {
+		bss.sendBatch(fullBatch)
+	}
+
+	select {
+	case err = <-chResult:
+		return err
+	case <-bss.ctx.Done():
+		return bss.singleSigner.Verify(public, msg, sig)
+	}
+}
+
+// addRequest stores the request in the pending batch and returns the batch if it became full
+func (bss *batchSingleSigner) addRequest(
+	public crypto.PublicKey,
+	pkBytes []byte,
+	msg []byte,
+	sig []byte,
+	chResult chan error,
+) []*verificationRequest {
+	bss.mutPending.Lock()
+	defer bss.mutPending.Unlock()
+
+	key := requestKey(pkBytes, msg, sig)
+	request, found := bss.pending[key]
+	if !found {
+		request = &verificationRequest{
+			publicKey: public,
+			msg:       msg,
+			sig:       sig,
+		}
+		bss.pending[key] = request
+	}
+	request.chResults = append(request.chResults, chResult)
+
+	if len(bss.pending) < bss.maxBatchSize {
+		return nil
+	}
+
+	return bss.extractPendingNoLock()
+}
+
+func (bss *batchSingleSigner) extractPendingNoLock() []*verificationRequest {
+	batch := make([]*verificationRequest, 0, len(bss.pending))
+	for _, request := range bss.pending {
+		batch = append(batch, request)
+	}
+	bss.pending = make(map[string]*verificationRequest)
+
+	return batch
+}
+
+// requestKey prefixes the public key and the message with their lengths so that different requests can not collide
+func requestKey(pkBytes []byte, msg []byte, sig []byte) string {
+	return fmt.Sprintf("%d:%s%d:%s%s", len(pkBytes), pkBytes, len(msg), msg, sig)
+}
+
+func (bss *batchSingleSigner) sendBatch(batch []*verificationRequest) {
+	select {
+	case bss.chBatches <- batch:
+	case <-bss.ctx.Done():
+	}
+}
+
+func (bss *batchSingleSigner) flushContinuously() {
+	ticker := time.NewTicker(bss.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			bss.flush()
+		case <-bss.ctx.Done():
+			log.Debug("batchSingleSigner's flush go routine is stopping...")
+			return
+		}
+	}
+}
+
+func (bss *batchSingleSigner) flush() {
+	bss.mutPending.Lock()
+	if len(bss.pending) == 0 {
+		bss.mutPending.Unlock()
+		return
+	}
+	batch := bss.extractPendingNoLock()
+	bss.mutPending.Unlock()
+
+	bss.sendBatch(batch)
+}
+
+func (bss *batchSingleSigner) processBatches() {
+	for {
+		select {
+		case batch := <-bss.chBatches:
+			bss.verifyBatch(batch)
+		case <-bss.ctx.Done():
+			return
+		}
+	}
+}
+
+func (bss *batchSingleSigner) verifyBatch(batch []*verificationRequest) {
+	if len(batch) > 1 && bss.batchVerifier != nil {
+		err := bss.verifyAtOnce(batch)
+		if err == nil {
+			for _, request := range batch {
+				sendResult(request, nil)
+			}
+			return
+		}
+
+		log.Trace("batch signature verification failed, verifying the signatures one by one",
+			"batch size", len(batch), "error", err)
+	}
+
+	bss.verifyInParallel(batch)
+}
+
+// verifyInParallel verifies the signatures of the batch one by one on parallel go routines. The verification slots are
+// shared by all the workers, so that no more signatures than the number of workers are verified at the same time
+func (bss *batchSingleSigner) verifyInParallel(batch []*verificationRequest) {
+	wg := &sync.WaitGroup{}
+	wg.Add(len(batch))
+	for _, request := range batch {
+		bss.chVerifySlots <- struct{}{}
+		go func(request *verificationRequest) {
+			err := bss.singleSigner.Verify(request.publicKey, request.msg, request.sig)
+			sendResult(request, err)
+
+			<-bss.chVerifySlots
+			wg.Done()
+		}(request)
+	}
+
+	wg.Wait()
+}
+
+func (bss *batchSingleSigner) verifyAtOnce(batch []*verificationRequest) error {
+	publicKeys := make([]crypto.PublicKey, 0, len(batch))
+	messages := make([][]byte, 0, len(batch))
+	signatures := make([][]byte, 0, len(batch))
+	for _, request := range batch {
+		publicKeys = append(publicKeys, request.publicKey)
+		messages = append(messages, request.msg)
+		signatures = append(signatures, request.sig)
+	}
+
+	return bss.batchVerifier.VerifyBatch(publicKeys, messages, signatures)
+}
+
+func sendResult(request *verificationRequest, err error) {
+	for _, chResult := range request.chResults {
+		chResult <- err
+	}
+}
+
+// Close stops the workers. Verifications that are still pending will be done directly by the wrapped single signer
+func (bss *batchSingleSigner) Close() error {
+	bss.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bss *batchSingleSigner) IsInterfaceNil() bool {
+	return bss == nil
+}
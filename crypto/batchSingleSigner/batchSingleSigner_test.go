@@ -0,0 +1,371 @@
+package batchSingleSigner
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgs() ArgsBatchSingleSigner {
+	return ArgsBatchSingleSigner{
+		SingleSigner:  &mock.SingleSignerStub{},
+		NumWorkers:    2,
+		MaxBatchSize:  10,
+		FlushInterval: time.Millisecond * 5,
+	}
+}
+
+func createPublicKey(pkBytes []byte) crypto.PublicKey {
+	return &mock.PublicKeyStub{
+		ToByteArrayStub: func() ([]byte, error) {
+			return pkBytes, nil
+		},
+	}
+}
+
+func TestNewBatchSingleSigner_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.SingleSigner = nil
+	bss, err := NewBatchSingleSigner(args)
+	assert.True(t, check.IfNil(bss))
+	assert.Equal(t, crypto.ErrNilSingleSigner, err)
+
+	args = createMockArgs()
+	args.NumWorkers = 0
+	bss, err = NewBatchSingleSigner(args)
+	assert.True(t, check.IfNil(bss))
+	assert.True(t, errors.Is(err, crypto.ErrInvalidNumOfWorkers))
+
+	args = createMockArgs()
+	args.MaxBatchSize = 0
+	bss, err = NewBatchSingleSigner(args)
+	assert.True(t, check.IfNil(bss))
+	assert.True(t, errors.Is(err, crypto.ErrInvalidBatchSize))
+
+	args = createMockArgs()
+	args.FlushInterval = time.Microsecond
+	bss, err = NewBatchSingleSigner(args)
+	assert.True(t, check.IfNil(bss))
+	assert.True(t, errors.Is(err, crypto.ErrInvalidFlushInterval))
+}
+
+func TestNewBatchSingleSigner_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	bss, err := NewBatchSingleSigner(createMockArgs())
+	assert.False(t, check.IfNil(bss))
+	assert.Nil(t, err)
+
+	_ = bss.Close()
+}
+
+func TestBatchSingleSigner_SignShouldDelegate(t *testing.T) {
+	t.Parallel()
+
+	expectedSig := []byte("sig")
+	args := createMockArgs()
+	args.SingleSigner = &mock.SingleSignerStub{
+		SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			return expectedSig, nil
+		},
+	}
+	bss, _ := NewBatchSingleSigner(args)
+	defer func() {
+		_ = bss.Close()
+	}()
+
+	sig, err := bss.Sign(&mock.PrivateKeyStub{}, []byte("msg"))
+	assert.Nil(t, err)
+	assert.Equal(t, expectedSig, sig)
+}
+
+func TestBatchSingleSigner_VerifyInvalidParamsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bss, _ := NewBatchSingleSigner(createMockArgs())
+	defer func() {
+		_ = bss.Close()
+	}()
+
+	assert.Equal(t, crypto.ErrNilPublicKey, bss.Verify(nil, []byte("msg"), []byte("sig")))
+	assert.Equal(t, crypto.ErrNilMessage, bss.Verify(createPublicKey([]byte("pk")), nil, []byte("sig")))
+	assert.Equal(t, crypto.ErrNilSignature, bss.Verify(createPublicKey([]byte("pk")), []byte("msg"), nil))
+}
+
+func TestBatchSingleSigner_VerifyShouldReturnTheWrappedResult(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgs()
+	args.SingleSigner = &mock.SingleSignerStub{
+		VerifyCalled: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			if bytes.Equal(sig, []byte("bad sig")) {
+				return expectedErr
+			}
+
+			return nil
+		},
+	}
+	bss, _ := NewBatchSingleSigner(args)
+	defer func() {
+		_ = bss.Close()
+	}()
+
+	pk := createPublicKey([]byte("pk"))
+	assert.Nil(t, bss.Verify(pk, []byte("msg"), []byte("good sig")))
+	assert.Equal(t, expectedErr, bss.Verify(pk, []byte("msg"), []byte("bad sig")))
+}
+
+func TestBatchSingleSigner_VerifyIdenticalRequestsShouldVerifyOnce(t *testing.T) {
+	t.Parallel()
+
+	numVerifyCalled := uint32(0)
+	numRequests := 5
+	args := createMockArgs()
+	args.MaxBatchSize = 100
+	args.FlushInterval = time.Millisecond * 200
+	args.SingleSigner = &mock.SingleSignerStub{
+		VerifyCalled: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			atomic.AddUint32(&numVerifyCalled, 1)
+			return nil
+		},
+	}
+	bss, _ := NewBatchSingleSigner(args)
+	defer func() {
+		_ = bss.Close()
+	}()
+
+	pk := createPublicKey([]byte("pk"))
+	wg := sync.WaitGroup{}
+	wg.Add(numRequests)
+	for i := 0; i < numRequests; i++ {
+		go func() {
+			err := bss.Verify(pk, []byte("msg"), []byte("sig"))
+			assert.Nil(t, err)
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numVerifyCalled))
+}
+
+func TestBatchSingleSigner_VerifyFullBatchShouldNotWaitTheFlush(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.MaxBatchSize = 1
+	args.FlushInterval = time.Hour
+	bss, _ := NewBatchSingleSigner(args)
+	defer func() {
+		_ = bss.Close()
+	}()
+
+	chDone := make(chan struct{})
+	go func() {
+		_ = bss.Verify(createPublicKey([]byte("pk")), []byte("msg"), []byte("sig"))
+		close(chDone)
+	}()
+
+	select {
+	case <-chDone:
+	case <-time.After(time.Second):
+		assert.Fail(t, "verify should have not waited for the flush interval")
+	}
+}
+
+func TestBatchSingleSigner_VerifyWithoutBatchVerifierShouldVerifyInParallel(t *testing.T) {
+	t.Parallel()
+
+	errNotInParallel := errors.New("not verified in parallel")
+	numInFlight := int32(0)
+	chAllInFlight := make(chan struct{})
+	args := createMockArgs()
+	args.MaxBatchSize = 2
+	args.FlushInterval = time.Hour
+	args.SingleSigner = &mock.SingleSignerStub{
+		VerifyCalled: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			if atomic.AddInt32(&numInFlight, 1) == 2 {
+				close(chAllInFlight)
+			}
+
+			select {
+			case <-chAllInFlight:
+				return nil
+			case <-time.After(time.Second):
+				return errNotInParallel
+			}
+		},
+	}
+	bss, _ := NewBatchSingleSigner(args)
+	defer func() {
+		_ = bss.Close()
+	}()
+
+	errs := make([]error, 2)
+	wg := &sync.WaitGroup{}
+	wg.Add(len(errs))
+	for i := range errs {
+		go func(idx int) {
+			errs[idx] = bss.Verify(createPublicKey([]byte(fmt.Sprintf("pk%d", idx))), []byte("msg"), []byte("sig"))
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Nil(t, errs[0])
+	assert.Nil(t, errs[1])
+}
+
+func TestBatchSingleSigner_VerifyAfterCloseShouldFallbackToTheWrappedSigner(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgs()
+	args.FlushInterval = time.Hour
+	args.SingleSigner = &mock.SingleSignerStub{
+		VerifyCalled: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			return expectedErr
+		},
+	}
+	bss, _ := NewBatchSingleSigner(args)
+	_ = bss.Close()
+
+	err := bss.Verify(createPublicKey([]byte("pk")), []byte("msg"), []byte("sig"))
+	assert.Equal(t, expectedErr, err)
+}
+
+func verifyConcurrently(bss *batchSingleSigner, numRequests int, sigForIndex func(i int) []byte) []error {
+	errs := make([]error, numRequests)
+	wg := sync.WaitGroup{}
+	wg.Add(numRequests)
+	for i := 0; i < numRequests; i++ {
+		go func(idx int) {
+			pk := createPublicKey([]byte(fmt.Sprintf("pk%d", idx)))
+			errs[idx] = bss.Verify(pk, []byte("msg"), sigForIndex(idx))
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+
+	return errs
+}
+
+func TestBatchSingleSigner_VerifyDifferentPublicKeysShouldVerifyAtOnce(t *testing.T) {
+	t.Parallel()
+
+	numRequests := 8
+	numVerifyBatchCalled := uint32(0)
+	args := createMockArgs()
+	args.MaxBatchSize = numRequests
+	args.FlushInterval = time.Hour
+	args.SingleSigner = &mock.BatchVerifierSingleSignerStub{
+		SingleSignerStub: mock.SingleSignerStub{
+			VerifyCalled: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+				assert.Fail(t, "should have not verified the signatures one by one")
+				return nil
+			},
+		},
+		VerifyBatchCalled: func(publicKeys []crypto.PublicKey, messages [][]byte, signatures [][]byte) error {
+			atomic.AddUint32(&numVerifyBatchCalled, 1)
+			assert.Equal(t, numRequests, len(publicKeys))
+			assert.Equal(t, numRequests, len(messages))
+			assert.Equal(t, numRequests, len(signatures))
+			return nil
+		},
+	}
+	bss, _ := NewBatchSingleSigner(args)
+	defer func() {
+		_ = bss.Close()
+	}()
+
+	errs := verifyConcurrently(bss, numRequests, func(i int) []byte {
+		return []byte("sig")
+	})
+	for _, err := range errs {
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numVerifyBatchCalled))
+}
+
+func TestBatchSingleSigner_VerifyFailedBatchShouldFallbackToIndividualVerification(t *testing.T) {
+	t.Parallel()
+
+	numRequests := 8
+	expectedErr := errors.New("expected error")
+	numVerifyCalled := uint32(0)
+	args := createMockArgs()
+	args.MaxBatchSize = numRequests
+	args.FlushInterval = time.Hour
+	args.SingleSigner = &mock.BatchVerifierSingleSignerStub{
+		SingleSignerStub: mock.SingleSignerStub{
+			VerifyCalled: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+				atomic.AddUint32(&numVerifyCalled, 1)
+				if bytes.Equal(sig, []byte("bad sig")) {
+					return expectedErr
+				}
+				return nil
+			},
+		},
+		VerifyBatchCalled: func(publicKeys []crypto.PublicKey, messages [][]byte, signatures [][]byte) error {
+			return crypto.ErrSigNotValid
+		},
+	}
+	bss, _ := NewBatchSingleSigner(args)
+	defer func() {
+		_ = bss.Close()
+	}()
+
+	errs := verifyConcurrently(bss, numRequests, func(i int) []byte {
+		if i == 3 {
+			return []byte("bad sig")
+		}
+		return []byte("good sig")
+	})
+	for i, err := range errs {
+		if i == 3 {
+			assert.Equal(t, expectedErr, err)
+			continue
+		}
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, uint32(numRequests), atomic.LoadUint32(&numVerifyCalled))
+}
+
+func TestBatchSingleSigner_VerifySingleRequestShouldNotVerifyAtOnce(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.SingleSigner = &mock.BatchVerifierSingleSignerStub{
+		VerifyBatchCalled: func(publicKeys []crypto.PublicKey, messages [][]byte, signatures [][]byte) error {
+			assert.Fail(t, "should have not verified a single signature as a batch")
+			return nil
+		},
+	}
+	bss, _ := NewBatchSingleSigner(args)
+	defer func() {
+		_ = bss.Close()
+	}()
+
+	err := bss.Verify(createPublicKey([]byte("pk")), []byte("msg"), []byte("sig"))
+	assert.Nil(t, err)
+}
+
+func TestRequestKey_DifferentRequestsShouldNotCollide(t *testing.T) {
+	t.Parallel()
+
+	assert.NotEqual(t, requestKey([]byte("pk"), []byte("ab"), []byte("c")), requestKey([]byte("pk"), []byte("a"), []byte("bc")))
+	assert.NotEqual(t, requestKey([]byte("pka"), []byte("b"), []byte("c")), requestKey([]byte("pk"), []byte("ab"), []byte("c")))
+	assert.Equal(t, requestKey([]byte("pk"), []byte("a"), []byte("bc")), requestKey([]byte("pk"), []byte("a"), []byte("bc")))
+}
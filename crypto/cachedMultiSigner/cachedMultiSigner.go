@@ -0,0 +1,222 @@
+package cachedMultiSigner
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ crypto.MultiSigner = (*cachedMultiSigner)(nil)
+
+const signatureShareKeyPrefix = "s"
+const aggregatedSignatureKeyPrefix = "a"
+
+// ArgsCachedMultiSigner is the DTO used to create a new instance of cachedMultiSigner
+type ArgsCachedMultiSigner struct {
+	MultiSigner crypto.MultiSigner
+	Cache       storage.Cacher
+	Hasher      hashing.Hasher
+}
+
+// cachedMultiSigner is a wrapper over a multi signer that remembers the signature shares and the aggregated signatures
+// already verified, keyed by the signed message (the header hash) and the signer's public key. The headers processed
+// again after small forks carry the same signatures, so the BLS verifications do not need to be done again. A cached
+// entry is only used when the provided signature is identical to the verified one. The verifications are not cached
+// until the public keys are set through Reset or Create.
+type cachedMultiSigner struct {
+	multiSigner crypto.MultiSigner
+	cache       storage.Cacher
+	hasher      hashing.Hasher
+
+	mutData sync.RWMutex
+	pubKeys []string
+	aggSig  []byte
+}
+
+// NewCachedMultiSigner creates a new cachedMultiSigner instance
+func NewCachedMultiSigner(args ArgsCachedMultiSigner) (*cachedMultiSigner, error) {
+	if check.IfNil(args.MultiSigner) {
+		return nil, crypto.ErrNilMultiSigner
+	}
+	if check.IfNil(args.Cache) {
+		return nil, crypto.ErrNilCacher
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, crypto.ErrNilHasher
+	}
+
+	return &cachedMultiSigner{
+		multiSigner: args.MultiSigner,
+		cache:       args.Cache,
+		hasher:      args.Hasher,
+	}, nil
+}
+
+// Create creates a new multi signer, sharing the same verifications cache, initialized with the given params
+func (cms *cachedMultiSigner) Create(pubKeys []string, index uint16) (crypto.MultiSigner, error) {
+	multiSigner, err := cms.multiSigner.Create(pubKeys, index)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cachedMultiSigner{
+		multiSigner: multiSigner,
+		cache:       cms.cache,
+		hasher:      cms.hasher,
+		pubKeys:     copyPubKeys(pubKeys),
+	}, nil
+}
+
+// Reset resets the wrapped multi signer with the given params
+func (cms *cachedMultiSigner) Reset(pubKeys []string, index uint16) error {
+	err := cms.multiSigner.Reset(pubKeys, index)
+	if err != nil {
+		return err
+	}
+
+	cms.mutData.Lock()
+	cms.pubKeys = copyPubKeys(pubKeys)
+	cms.aggSig = nil
+	cms.mutData.Unlock()
+
+	return nil
+}
+
+// CreateSignatureShare creates a partial signature
+func (cms *cachedMultiSigner) CreateSignatureShare(msg []byte, bitmap []byte) ([]byte, error) {
+	return cms.multiSigner.CreateSignatureShare(msg, bitmap)
+}
+
+// StoreSignatureShare adds the partial signature of the signer with specified position
+func (cms *cachedMultiSigner) StoreSignatureShare(index uint16, sig []byte) error {
+	return cms.multiSigner.StoreSignatureShare(index, sig)
+}
+
+// SignatureShare returns the partial signature set for given index
+func (cms *cachedMultiSigner) SignatureShare(index uint16) ([]byte, error) {
+	return cms.multiSigner.SignatureShare(index)
+}
+
+// VerifySignatureShare verifies the partial signature of the signer with specified position, unless the same
+// signature was already verified for the same message and public key
+func (cms *cachedMultiSigner) VerifySignatureShare(index uint16, sig []byte, msg []byte, bitmap []byte) error {
+	key := cms.computeSignatureShareKey(index, msg)
+	if cms.isAlreadyVerified(key, sig) {
+		return nil
+	}
+
+	err := cms.multiSigner.VerifySignatureShare(index, sig, msg, bitmap)
+	if err != nil {
+		return err
+	}
+
+	cms.markAsVerified(key, sig)
+
+	return nil
+}
+
+// AggregateSigs aggregates all collected partial signatures
+func (cms *cachedMultiSigner) AggregateSigs(bitmap []byte) ([]byte, error) {
+	return cms.multiSigner.AggregateSigs(bitmap)
+}
+
+// SetAggregatedSig sets the aggregated signature
+func (cms *cachedMultiSigner) SetAggregatedSig(aggSig []byte) error {
+	err := cms.multiSigner.SetAggregatedSig(aggSig)
+	if err != nil {
+		return err
+	}
+
+	cms.mutData.Lock()
+	cms.aggSig = append(make([]byte, 0, len(aggSig)), aggSig...)
+	cms.mutData.Unlock()
+
+	return nil
+}
+
+// Verify verifies the aggregated signature, unless the same aggregated signature was already verified for the same
+// message and set of signers
+func (cms *cachedMultiSigner) Verify(msg []byte, bitmap []byte) error {
+	key := cms.computeAggregatedSignatureKey(msg, bitmap)
+
+	cms.mutData.RLock()
+	aggSig := cms.aggSig
+	cms.mutData.RUnlock()
+
+	if cms.isAlreadyVerified(key, aggSig) {
+		return nil
+	}
+
+	err := cms.multiSigner.Verify(msg, bitmap)
+	if err != nil {
+		return err
+	}
+
+	cms.markAsVerified(key, aggSig)
+
+	return nil
+}
+
+// computeSignatureShareKey returns nil if the public key of the signer is not known
+func (cms *cachedMultiSigner) computeSignatureShareKey(index uint16, msg []byte) []byte {
+	cms.mutData.RLock()
+	defer cms.mutData.RUnlock()
+
+	if int(index) >= len(cms.pubKeys) {
+		return nil
+	}
+
+	return []byte(signatureShareKeyPrefix + string(msg) + cms.pubKeys[index])
+}
+
+// computeAggregatedSignatureKey returns nil if the public keys of the signers are not known
+func (cms *cachedMultiSigner) computeAggregatedSignatureKey(msg []byte, bitmap []byte) []byte {
+	cms.mutData.RLock()
+	defer cms.mutData.RUnlock()
+
+	if len(cms.pubKeys) == 0 {
+		return nil
+	}
+
+	signersHash := cms.hasher.Compute(string(bitmap) + strings.Join(cms.pubKeys, ""))
+
+	return []byte(aggregatedSignatureKeyPrefix + string(msg) + string(signersHash))
+}
+
+func (cms *cachedMultiSigner) isAlreadyVerified(key []byte, sig []byte) bool {
+	if len(key) == 0 || len(sig) == 0 {
+		return false
+	}
+
+	value, ok := cms.cache.Get(key)
+	if !ok {
+		return false
+	}
+
+	verifiedSig, ok := value.([]byte)
+
+	return ok && bytes.Equal(verifiedSig, sig)
+}
+
+func (cms *cachedMultiSigner) markAsVerified(key []byte, sig []byte) {
+	if len(key) == 0 || len(sig) == 0 {
+		return
+	}
+
+	verifiedSig := append(make([]byte, 0, len(sig)), sig...)
+	cms.cache.Put(key, verifiedSig, len(key)+len(verifiedSig))
+}
+
+func copyPubKeys(pubKeys []string) []string {
+	return append(make([]string, 0, len(pubKeys)), pubKeys...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cms *cachedMultiSigner) IsInterfaceNil() bool {
+	return cms == nil
+}
@@ -0,0 +1,169 @@
+package cachedMultiSigner
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/stretchr/testify/assert"
+)
+
+var pubKeys = []string{"pk0", "pk1", "pk2"}
+
+func createMockArgs(multiSigner crypto.MultiSigner) ArgsCachedMultiSigner {
+	cache, _ := lrucache.NewCache(100)
+
+	return ArgsCachedMultiSigner{
+		MultiSigner: multiSigner,
+		Cache:       cache,
+		Hasher:      &mock.HasherMock{},
+	}
+}
+
+func TestNewCachedMultiSigner_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(nil)
+	cms, err := NewCachedMultiSigner(args)
+	assert.True(t, check.IfNil(cms))
+	assert.Equal(t, crypto.ErrNilMultiSigner, err)
+
+	args = createMockArgs(&mock.MultiSignerStub{})
+	args.Cache = nil
+	cms, err = NewCachedMultiSigner(args)
+	assert.True(t, check.IfNil(cms))
+	assert.Equal(t, crypto.ErrNilCacher, err)
+
+	args = createMockArgs(&mock.MultiSignerStub{})
+	args.Hasher = nil
+	cms, err = NewCachedMultiSigner(args)
+	assert.True(t, check.IfNil(cms))
+	assert.Equal(t, crypto.ErrNilHasher, err)
+}
+
+func TestNewCachedMultiSigner_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	cms, err := NewCachedMultiSigner(createMockArgs(&mock.MultiSignerStub{}))
+	assert.False(t, check.IfNil(cms))
+	assert.Nil(t, err)
+}
+
+func TestCachedMultiSigner_VerifySignatureShareShouldVerifyOnce(t *testing.T) {
+	t.Parallel()
+
+	numVerifications := 0
+	multiSigner := &mock.MultiSignerStub{
+		VerifySignatureShareCalled: func(index uint16, sig []byte, msg []byte, bitmap []byte) error {
+			numVerifications++
+			return nil
+		},
+	}
+	cms, _ := NewCachedMultiSigner(createMockArgs(multiSigner))
+	_ = cms.Reset(pubKeys, 0)
+
+	err := cms.VerifySignatureShare(1, []byte("sig1"), []byte("hash"), nil)
+	assert.Nil(t, err)
+	err = cms.VerifySignatureShare(1, []byte("sig1"), []byte("hash"), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numVerifications)
+
+	// another signer, header hash or signature should be verified again
+	_ = cms.VerifySignatureShare(2, []byte("sig1"), []byte("hash"), nil)
+	_ = cms.VerifySignatureShare(1, []byte("sig1"), []byte("other hash"), nil)
+	_ = cms.VerifySignatureShare(1, []byte("sig2"), []byte("hash"), nil)
+	assert.Equal(t, 4, numVerifications)
+}
+
+func TestCachedMultiSigner_VerifySignatureShareFailedShouldNotCache(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	numVerifications := 0
+	multiSigner := &mock.MultiSignerStub{
+		VerifySignatureShareCalled: func(index uint16, sig []byte, msg []byte, bitmap []byte) error {
+			numVerifications++
+			return expectedErr
+		},
+	}
+	cms, _ := NewCachedMultiSigner(createMockArgs(multiSigner))
+	_ = cms.Reset(pubKeys, 0)
+
+	err := cms.VerifySignatureShare(1, []byte("sig1"), []byte("hash"), nil)
+	assert.Equal(t, expectedErr, err)
+	err = cms.VerifySignatureShare(1, []byte("sig1"), []byte("hash"), nil)
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 2, numVerifications)
+}
+
+func TestCachedMultiSigner_VerifySignatureShareUnknownPublicKeysShouldNotCache(t *testing.T) {
+	t.Parallel()
+
+	numVerifications := 0
+	multiSigner := &mock.MultiSignerStub{
+		VerifySignatureShareCalled: func(index uint16, sig []byte, msg []byte, bitmap []byte) error {
+			numVerifications++
+			return nil
+		},
+	}
+	cms, _ := NewCachedMultiSigner(createMockArgs(multiSigner))
+
+	_ = cms.VerifySignatureShare(1, []byte("sig1"), []byte("hash"), nil)
+	_ = cms.VerifySignatureShare(1, []byte("sig1"), []byte("hash"), nil)
+	assert.Equal(t, 2, numVerifications)
+}
+
+func TestCachedMultiSigner_CreatedSignersShouldShareTheCache(t *testing.T) {
+	t.Parallel()
+
+	numVerifications := 0
+	multiSigner := &mock.MultiSignerStub{
+		VerifyCalled: func(msg []byte, bitmap []byte) error {
+			numVerifications++
+			return nil
+		},
+	}
+	cms, _ := NewCachedMultiSigner(createMockArgs(multiSigner))
+
+	verifier, err := cms.Create(pubKeys, 0)
+	assert.Nil(t, err)
+	_ = verifier.SetAggregatedSig([]byte("aggSig"))
+	err = verifier.Verify([]byte("hash"), []byte{7})
+	assert.Nil(t, err)
+
+	verifier, _ = cms.Create(pubKeys, 0)
+	_ = verifier.SetAggregatedSig([]byte("aggSig"))
+	err = verifier.Verify([]byte("hash"), []byte{7})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numVerifications)
+
+	// another bitmap, consensus group or aggregated signature should be verified again
+	_ = verifier.Verify([]byte("hash"), []byte{3})
+	verifier, _ = cms.Create([]string{"pk0", "pk1", "pk3"}, 0)
+	_ = verifier.SetAggregatedSig([]byte("aggSig"))
+	_ = verifier.Verify([]byte("hash"), []byte{7})
+	_ = verifier.SetAggregatedSig([]byte("other aggSig"))
+	_ = verifier.Verify([]byte("hash"), []byte{7})
+	assert.Equal(t, 4, numVerifications)
+}
+
+func TestCachedMultiSigner_VerifyWithoutAggregatedSignatureShouldNotCache(t *testing.T) {
+	t.Parallel()
+
+	numVerifications := 0
+	multiSigner := &mock.MultiSignerStub{
+		VerifyCalled: func(msg []byte, bitmap []byte) error {
+			numVerifications++
+			return nil
+		},
+	}
+	cms, _ := NewCachedMultiSigner(createMockArgs(multiSigner))
+	_ = cms.Reset(pubKeys, 0)
+
+	_ = cms.Verify([]byte("hash"), []byte{7})
+	_ = cms.Verify([]byte("hash"), []byte{7})
+	assert.Equal(t, 2, numVerifications)
+}
@@ -132,3 +132,67 @@ var ErrInvalidSignature = errors.New("invalid signature")
 
 // ErrWrongTypeAssertion signals wrong type assertion
 var ErrWrongTypeAssertion = errors.New("wrong type assertion")
+
+// ErrInvalidNumOfWorkers signals that an invalid number of workers was provided
+var ErrInvalidNumOfWorkers = errors.New("invalid number of workers")
+
+// ErrInvalidBatchSize signals that an invalid batch size was provided
+var ErrInvalidBatchSize = errors.New("invalid batch size")
+
+// ErrInvalidFlushInterval signals that an invalid flush interval was provided
+var ErrInvalidFlushInterval = errors.New("invalid flush interval")
+
+// ErrNilMultiSigner signals that a nil multi signer was provided
+var ErrNilMultiSigner = errors.New("nil multi signer")
+
+// ErrNilSigningBackend signals that a nil signing backend was provided
+var ErrNilSigningBackend = errors.New("nil signing backend")
+
+// ErrNilLowLevelSigner signals that a nil low level signer was provided
+var ErrNilLowLevelSigner = errors.New("nil low level signer")
+
+// ErrUnknownSigningKey signals that the signing backend does not hold the secret key of the provided public key
+var ErrUnknownSigningKey = errors.New("unknown signing key")
+
+// ErrInvalidRemoteSignerURL signals that an invalid remote signer URL was provided
+var ErrInvalidRemoteSignerURL = errors.New("invalid remote signer URL")
+
+// ErrMissingTLSFiles signals that the certificate, the key or the certificate authority file was not provided
+var ErrMissingTLSFiles = errors.New("missing TLS files")
+
+// ErrInvalidRequestTimeout signals that an invalid request timeout was provided
+var ErrInvalidRequestTimeout = errors.New("invalid request timeout")
+
+// ErrRemoteSignerRequestFailed signals that a request sent to the remote signer failed
+var ErrRemoteSignerRequestFailed = errors.New("remote signer request failed")
+
+// ErrNilSigningSlotHandler signals that a nil signing slot handler was provided
+var ErrNilSigningSlotHandler = errors.New("nil signing slot handler")
+
+// ErrDoubleSignRefused signals that the signer refused to sign a different message for an already signed slot, or a
+// message for a slot older than the last signed one
+var ErrDoubleSignRefused = errors.New("double sign refused")
+
+// ErrMissingSigningSlot signals that a message whose type must be bound to a consensus slot came without one
+var ErrMissingSigningSlot = errors.New("missing signing slot")
+
+// ErrUnknownMessageType signals that a signing request came with an unknown message type
+var ErrUnknownMessageType = errors.New("unknown message type")
+
+// ErrEmptySigningHistoryFilePath signals that an empty signing history file path was provided
+var ErrEmptySigningHistoryFilePath = errors.New("empty signing history file path")
+
+// ErrBatchLengthMismatch signals that the public keys, the messages and the signatures of a batch differ in number
+var ErrBatchLengthMismatch = errors.New("batch length mismatch")
+
+// ErrInvalidThreshold signals that the threshold of a threshold key is lower than 2 or higher than its number of shares
+var ErrInvalidThreshold = errors.New("invalid threshold")
+
+// ErrInvalidShareID signals that a key share ID is 0 or is used by more than one share of the same key
+var ErrInvalidShareID = errors.New("invalid share ID")
+
+// ErrNotEnoughSignatureShares signals that fewer valid signature shares than the threshold were gathered
+var ErrNotEnoughSignatureShares = errors.New("not enough signature shares")
+
+// ErrInvalidThresholdKey signals that the public keys of the shares of a threshold key do not match its public key
+var ErrInvalidThresholdKey = errors.New("invalid threshold key")
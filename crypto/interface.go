@@ -172,6 +172,13 @@ type SingleSigner interface {
 	IsInterfaceNil() bool
 }
 
+// BatchVerifier provides functionality for verifying at once many signatures, each one with its own public key and
+// message
+type BatchVerifier interface {
+	// VerifyBatch returns nil only if all the signatures are valid. It does not tell which signatures are invalid
+	VerifyBatch(publicKeys []PublicKey, messages [][]byte, signatures [][]byte) error
+}
+
 // MultiSigner provides functionality for multi-signing a message and verifying a multi-signed message
 type MultiSigner interface {
 	// MultiSigVerifier Provides functionality for verifying a multi-signature
@@ -225,3 +232,26 @@ type PeerSignatureHandler interface {
 	GetPeerSignature(key PrivateKey, pid []byte) ([]byte, error)
 	IsInterfaceNil() bool
 }
+
+// SigningBackend creates the BLS signatures of the secret keys it holds, identified by their public keys, so that the
+// secret keys do not need to reside on the node using them
+type SigningBackend interface {
+	// Sign creates the BLS single signature of the key identified by the provided public key over the message. The
+	// message type tells what the message is and the slot binds it to a consensus slot, so that a signer can refuse a
+	// different message of the same type for the same slot
+	Sign(publicKey []byte, message []byte, messageType string, slot *SigningSlot) ([]byte, error)
+	// PublicKeys returns the public keys of all the secret keys held by the backend
+	PublicKeys() ([][]byte, error)
+	// IsInterfaceNil returns true if there is no value under the interface
+	IsInterfaceNil() bool
+}
+
+// SigningSlotHandler holds the consensus slot the node currently signs for
+type SigningSlotHandler interface {
+	// SetSigningSlot sets the epoch and the round of the consensus slot the node currently signs for
+	SetSigningSlot(epoch uint32, round uint64)
+	// SigningSlot returns the current consensus slot, nil if no slot was set
+	SigningSlot() *SigningSlot
+	// IsInterfaceNil returns true if there is no value under the interface
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,23 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/crypto"
+
+// BatchVerifierSingleSignerStub -
+type BatchVerifierSingleSignerStub struct {
+	SingleSignerStub
+	VerifyBatchCalled func(publicKeys []crypto.PublicKey, messages [][]byte, signatures [][]byte) error
+}
+
+// VerifyBatch -
+func (s *BatchVerifierSingleSignerStub) VerifyBatch(publicKeys []crypto.PublicKey, messages [][]byte, signatures [][]byte) error {
+	if s.VerifyBatchCalled != nil {
+		return s.VerifyBatchCalled(publicKeys, messages, signatures)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *BatchVerifierSingleSignerStub) IsInterfaceNil() bool {
+	return s == nil
+}
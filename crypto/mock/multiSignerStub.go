@@ -0,0 +1,102 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/crypto"
+
+// MultiSignerStub -
+type MultiSignerStub struct {
+	CreateCalled               func(pubKeys []string, index uint16) (crypto.MultiSigner, error)
+	SetAggregatedSigCalled     func(aggSig []byte) error
+	VerifyCalled               func(msg []byte, bitmap []byte) error
+	ResetCalled                func(pubKeys []string, index uint16) error
+	CreateSignatureShareCalled func(msg []byte, bitmap []byte) ([]byte, error)
+	StoreSignatureShareCalled  func(index uint16, sig []byte) error
+	SignatureShareCalled       func(index uint16) ([]byte, error)
+	VerifySignatureShareCalled func(index uint16, sig []byte, msg []byte, bitmap []byte) error
+	AggregateSigsCalled        func(bitmap []byte) ([]byte, error)
+}
+
+// Create -
+func (stub *MultiSignerStub) Create(pubKeys []string, index uint16) (crypto.MultiSigner, error) {
+	if stub.CreateCalled != nil {
+		return stub.CreateCalled(pubKeys, index)
+	}
+
+	return stub, nil
+}
+
+// SetAggregatedSig -
+func (stub *MultiSignerStub) SetAggregatedSig(aggSig []byte) error {
+	if stub.SetAggregatedSigCalled != nil {
+		return stub.SetAggregatedSigCalled(aggSig)
+	}
+
+	return nil
+}
+
+// Verify -
+func (stub *MultiSignerStub) Verify(msg []byte, bitmap []byte) error {
+	if stub.VerifyCalled != nil {
+		return stub.VerifyCalled(msg, bitmap)
+	}
+
+	return nil
+}
+
+// Reset -
+func (stub *MultiSignerStub) Reset(pubKeys []string, index uint16) error {
+	if stub.ResetCalled != nil {
+		return stub.ResetCalled(pubKeys, index)
+	}
+
+	return nil
+}
+
+// CreateSignatureShare -
+func (stub *MultiSignerStub) CreateSignatureShare(msg []byte, bitmap []byte) ([]byte, error) {
+	if stub.CreateSignatureShareCalled != nil {
+		return stub.CreateSignatureShareCalled(msg, bitmap)
+	}
+
+	return nil, nil
+}
+
+// StoreSignatureShare -
+func (stub *MultiSignerStub) StoreSignatureShare(index uint16, sig []byte) error {
+	if stub.StoreSignatureShareCalled != nil {
+		return stub.StoreSignatureShareCalled(index, sig)
+	}
+
+	return nil
+}
+
+// SignatureShare -
+func (stub *MultiSignerStub) SignatureShare(index uint16) ([]byte, error) {
+	if stub.SignatureShareCalled != nil {
+		return stub.SignatureShareCalled(index)
+	}
+
+	return nil, nil
+}
+
+// VerifySignatureShare -
+func (stub *MultiSignerStub) VerifySignatureShare(index uint16, sig []byte, msg []byte, bitmap []byte) error {
+	if stub.VerifySignatureShareCalled != nil {
+		return stub.VerifySignatureShareCalled(index, sig, msg, bitmap)
+	}
+
+	return nil
+}
+
+// AggregateSigs -
+func (stub *MultiSignerStub) AggregateSigs(bitmap []byte) ([]byte, error) {
+	if stub.AggregateSigsCalled != nil {
+		return stub.AggregateSigsCalled(bitmap)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *MultiSignerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,197 @@
+package remoteSigner
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+var _ crypto.SigningBackend = (*doubleSignProtectedBackend)(nil)
+
+// ArgsDoubleSignProtectedBackend is the DTO used to create a new instance of doubleSignProtectedBackend
+type ArgsDoubleSignProtectedBackend struct {
+	Backend                crypto.SigningBackend
+	SigningHistoryFilePath string
+}
+
+// signedSlot is the last slot a key signed a message type for, persisted in the signing history file
+type signedSlot struct {
+	Epoch       uint32 `json:"epoch"`
+	Round       uint64 `json:"round"`
+	MessageHash string `json:"messageHash"`
+}
+
+// doubleSignProtectedBackend is a signing backend wrapper that remembers, for each key and consensus message type, the
+// last slot it signed for. It refuses to sign a different message for the same slot, a message for an older slot or a
+// consensus message without a slot, so a misbehaving or compromised node can not make the signer sign two different
+// headers in the same round. The history is written on disk before the signature is created, so the protection
+// survives a restart of the signer
+type doubleSignProtectedBackend struct {
+	crypto.SigningBackend
+	filePath    string
+	mutHistory  sync.Mutex
+	signedSlots map[string]*signedSlot
+}
+
+// NewDoubleSignProtectedBackend creates a signing backend wrapper refusing to double sign, loading the signing history
+// from the provided file if it exists
+func NewDoubleSignProtectedBackend(args ArgsDoubleSignProtectedBackend) (*doubleSignProtectedBackend, error) {
+	if check.IfNil(args.Backend) {
+		return nil, crypto.ErrNilSigningBackend
+	}
+	if len(args.SigningHistoryFilePath) == 0 {
+		return nil, crypto.ErrEmptySigningHistoryFilePath
+	}
+
+	dspb := &doubleSignProtectedBackend{
+		SigningBackend: args.Backend,
+		filePath:       args.SigningHistoryFilePath,
+		signedSlots:    make(map[string]*signedSlot),
+	}
+
+	err := dspb.loadHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	return dspb, nil
+}
+
+func (dspb *doubleSignProtectedBackend) loadHistory() error {
+	if !core.DoesFileExist(dspb.filePath) {
+		return nil
+	}
+
+	buff, err := ioutil.ReadFile(dspb.filePath)
+	if err != nil {
+		return fmt.Errorf("%w while reading the signing history file %s", err, dspb.filePath)
+	}
+
+	err = json.Unmarshal(buff, &dspb.signedSlots)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the signing history file %s", err, dspb.filePath)
+	}
+
+	return nil
+}
+
+// Sign signs the message with the wrapped backend. A consensus message is only signed if it comes with its slot and
+// the key did not sign a different message of the same type for the same slot or a message for a newer slot. The
+// single signatures are signed unguarded and any other message type is refused
+func (dspb *doubleSignProtectedBackend) Sign(
+	publicKey []byte,
+	message []byte,
+	messageType string,
+	slot *crypto.SigningSlot,
+) ([]byte, error) {
+	switch messageType {
+	case crypto.SingleSignatureMessageType:
+		return dspb.SigningBackend.Sign(publicKey, message, messageType, slot)
+	case crypto.SignatureShareMessageType:
+		if slot == nil {
+			return nil, fmt.Errorf("%w for message type %s", crypto.ErrMissingSigningSlot, messageType)
+		}
+
+		err := dspb.recordSlot(publicKey, message, messageType, slot)
+		if err != nil {
+			return nil, err
+		}
+
+		return dspb.SigningBackend.Sign(publicKey, message, messageType, slot)
+	default:
+		return nil, fmt.Errorf("%w: %q", crypto.ErrUnknownMessageType, messageType)
+	}
+}
+
+func (dspb *doubleSignProtectedBackend) recordSlot(
+	publicKey []byte,
+	message []byte,
+	messageType string,
+	slot *crypto.SigningSlot,
+) error {
+	messageHash := sha256.Sum256(message)
+	newSlot := &signedSlot{
+		Epoch:       slot.Epoch,
+		Round:       slot.Round,
+		MessageHash: hex.EncodeToString(messageHash[:]),
+	}
+	historyKey := hex.EncodeToString(publicKey) + "/" + messageType
+
+	dspb.mutHistory.Lock()
+	defer dspb.mutHistory.Unlock()
+
+	lastSlot, found := dspb.signedSlots[historyKey]
+	if found {
+		isOlderSlot := newSlot.Epoch < lastSlot.Epoch ||
+			(newSlot.Epoch == lastSlot.Epoch && newSlot.Round < lastSlot.Round)
+		if isOlderSlot {
+			return fmt.Errorf("%w: %s for epoch %d round %d, already signed for epoch %d round %d",
+				crypto.ErrDoubleSignRefused, messageType, newSlot.Epoch, newSlot.Round, lastSlot.Epoch, lastSlot.Round)
+		}
+
+		isSameSlot := newSlot.Epoch == lastSlot.Epoch && newSlot.Round == lastSlot.Round
+		if isSameSlot {
+			if newSlot.MessageHash == lastSlot.MessageHash {
+				return nil
+			}
+
+			return fmt.Errorf("%w: %s for epoch %d round %d, a different message was already signed",
+				crypto.ErrDoubleSignRefused, messageType, newSlot.Epoch, newSlot.Round)
+		}
+	}
+
+	dspb.signedSlots[historyKey] = newSlot
+	err := dspb.writeHistory()
+	if err != nil {
+		if found {
+			dspb.signedSlots[historyKey] = lastSlot
+		} else {
+			delete(dspb.signedSlots, historyKey)
+		}
+
+		return fmt.Errorf("%w while writing the signing history file %s", err, dspb.filePath)
+	}
+
+	return nil
+}
+
+// writeHistory writes and syncs a temporary file and renames it so the history file is never left half written
+func (dspb *doubleSignProtectedBackend) writeHistory() error {
+	buff, err := json.Marshal(dspb.signedSlots)
+	if err != nil {
+		return err
+	}
+
+	tempFilePath := dspb.filePath + ".tmp"
+	file, err := os.OpenFile(tempFilePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(buff)
+	if err == nil {
+		err = file.Sync()
+	}
+	errClose := file.Close()
+	if err != nil {
+		return err
+	}
+	if errClose != nil {
+		return errClose
+	}
+
+	return os.Rename(tempFilePath, dspb.filePath)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dspb *doubleSignProtectedBackend) IsInterfaceNil() bool {
+	return dspb == nil
+}
@@ -0,0 +1,194 @@
+package remoteSigner
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDoubleSignProtectedBackend(t *testing.T) (*doubleSignProtectedBackend, ArgsDoubleSignProtectedBackend, []byte) {
+	dir, err := ioutil.TempDir("", "signingHistory")
+	require.Nil(t, err)
+
+	_, backend, localKey := createRemoteKey(t)
+	publicKey, _ := localKey.GeneratePublic().ToByteArray()
+	args := ArgsDoubleSignProtectedBackend{
+		Backend:                backend,
+		SigningHistoryFilePath: filepath.Join(dir, "signingHistory.json"),
+	}
+	dspb, err := NewDoubleSignProtectedBackend(args)
+	require.Nil(t, err)
+
+	return dspb, args, publicKey
+}
+
+func removeSigningHistoryDir(args ArgsDoubleSignProtectedBackend) {
+	_ = os.RemoveAll(filepath.Dir(args.SigningHistoryFilePath))
+}
+
+func createSignatureShareSlot(epoch uint32, round uint64) *crypto.SigningSlot {
+	return &crypto.SigningSlot{
+		Epoch: epoch,
+		Round: round,
+	}
+}
+
+func TestNewDoubleSignProtectedBackend_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, args, _ := createDoubleSignProtectedBackend(t)
+	defer removeSigningHistoryDir(args)
+
+	argsNilBackend := args
+	argsNilBackend.Backend = nil
+	dspb, err := NewDoubleSignProtectedBackend(argsNilBackend)
+	assert.True(t, check.IfNil(dspb))
+	assert.Equal(t, crypto.ErrNilSigningBackend, err)
+
+	argsEmptyPath := args
+	argsEmptyPath.SigningHistoryFilePath = ""
+	dspb, err = NewDoubleSignProtectedBackend(argsEmptyPath)
+	assert.True(t, check.IfNil(dspb))
+	assert.Equal(t, crypto.ErrEmptySigningHistoryFilePath, err)
+
+	err = ioutil.WriteFile(args.SigningHistoryFilePath, []byte("not a signing history"), 0600)
+	require.Nil(t, err)
+	dspb, err = NewDoubleSignProtectedBackend(args)
+	assert.True(t, check.IfNil(dspb))
+	assert.NotNil(t, err)
+}
+
+func TestDoubleSignProtectedBackend_DifferentHeaderInTheSameRoundShouldBeRefused(t *testing.T) {
+	t.Parallel()
+
+	dspb, args, publicKey := createDoubleSignProtectedBackend(t)
+	defer removeSigningHistoryDir(args)
+
+	sig, err := dspb.Sign(publicKey, []byte("header A"), crypto.SignatureShareMessageType, createSignatureShareSlot(1, 10))
+	assert.Nil(t, err)
+	assert.NotNil(t, sig)
+
+	sig, err = dspb.Sign(publicKey, []byte("header B"), crypto.SignatureShareMessageType, createSignatureShareSlot(1, 10))
+	assert.Nil(t, sig)
+	assert.True(t, errors.Is(err, crypto.ErrDoubleSignRefused))
+}
+
+func TestDoubleSignProtectedBackend_SameHeaderInTheSameRoundShouldBeSignedAgain(t *testing.T) {
+	t.Parallel()
+
+	dspb, args, publicKey := createDoubleSignProtectedBackend(t)
+	defer removeSigningHistoryDir(args)
+
+	firstSig, err := dspb.Sign(publicKey, []byte("header A"), crypto.SignatureShareMessageType, createSignatureShareSlot(1, 10))
+	require.Nil(t, err)
+
+	secondSig, err := dspb.Sign(publicKey, []byte("header A"), crypto.SignatureShareMessageType, createSignatureShareSlot(1, 10))
+	assert.Nil(t, err)
+	assert.Equal(t, firstSig, secondSig)
+}
+
+func TestDoubleSignProtectedBackend_OlderRoundShouldBeRefused(t *testing.T) {
+	t.Parallel()
+
+	dspb, args, publicKey := createDoubleSignProtectedBackend(t)
+	defer removeSigningHistoryDir(args)
+
+	_, err := dspb.Sign(publicKey, []byte("header A"), crypto.SignatureShareMessageType, createSignatureShareSlot(2, 20))
+	require.Nil(t, err)
+
+	sig, err := dspb.Sign(publicKey, []byte("header B"), crypto.SignatureShareMessageType, createSignatureShareSlot(2, 19))
+	assert.Nil(t, sig)
+	assert.True(t, errors.Is(err, crypto.ErrDoubleSignRefused))
+
+	sig, err = dspb.Sign(publicKey, []byte("header B"), crypto.SignatureShareMessageType, createSignatureShareSlot(1, 21))
+	assert.Nil(t, sig)
+	assert.True(t, errors.Is(err, crypto.ErrDoubleSignRefused))
+
+	sig, err = dspb.Sign(publicKey, []byte("header B"), crypto.SignatureShareMessageType, createSignatureShareSlot(2, 21))
+	assert.Nil(t, err)
+	assert.NotNil(t, sig)
+}
+
+func TestDoubleSignProtectedBackend_SingleSignaturesShouldBeSignedUnguarded(t *testing.T) {
+	t.Parallel()
+
+	dspb, args, publicKey := createDoubleSignProtectedBackend(t)
+	defer removeSigningHistoryDir(args)
+
+	_, err := dspb.Sign(publicKey, []byte("header A"), crypto.SignatureShareMessageType, createSignatureShareSlot(1, 10))
+	require.Nil(t, err)
+
+	sig, err := dspb.Sign(publicKey, []byte("consensus message"), crypto.SingleSignatureMessageType, nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, sig)
+
+	sig, err = dspb.Sign(publicKey, []byte("other consensus message"), crypto.SingleSignatureMessageType, createSignatureShareSlot(1, 10))
+	assert.Nil(t, err)
+	assert.NotNil(t, sig)
+}
+
+func TestDoubleSignProtectedBackend_SignatureShareWithoutSlotShouldBeRefused(t *testing.T) {
+	t.Parallel()
+
+	dspb, args, publicKey := createDoubleSignProtectedBackend(t)
+	defer removeSigningHistoryDir(args)
+
+	_, err := dspb.Sign(publicKey, []byte("header A"), crypto.SignatureShareMessageType, createSignatureShareSlot(1, 10))
+	require.Nil(t, err)
+
+	sig, err := dspb.Sign(publicKey, []byte("header B"), crypto.SignatureShareMessageType, nil)
+	assert.Nil(t, sig)
+	assert.True(t, errors.Is(err, crypto.ErrMissingSigningSlot))
+}
+
+func TestDoubleSignProtectedBackend_UnknownMessageTypeShouldBeRefused(t *testing.T) {
+	t.Parallel()
+
+	dspb, args, publicKey := createDoubleSignProtectedBackend(t)
+	defer removeSigningHistoryDir(args)
+
+	sig, err := dspb.Sign(publicKey, []byte("header B"), "", nil)
+	assert.Nil(t, sig)
+	assert.True(t, errors.Is(err, crypto.ErrUnknownMessageType))
+
+	sig, err = dspb.Sign(publicKey, []byte("header B"), "other", createSignatureShareSlot(1, 10))
+	assert.Nil(t, sig)
+	assert.True(t, errors.Is(err, crypto.ErrUnknownMessageType))
+}
+
+func TestDoubleSignProtectedBackend_HistoryShouldSurviveARestart(t *testing.T) {
+	t.Parallel()
+
+	dspb, args, publicKey := createDoubleSignProtectedBackend(t)
+	defer removeSigningHistoryDir(args)
+
+	_, err := dspb.Sign(publicKey, []byte("header A"), crypto.SignatureShareMessageType, createSignatureShareSlot(1, 10))
+	require.Nil(t, err)
+
+	restarted, err := NewDoubleSignProtectedBackend(args)
+	require.Nil(t, err)
+
+	sig, err := restarted.Sign(publicKey, []byte("header B"), crypto.SignatureShareMessageType, createSignatureShareSlot(1, 10))
+	assert.Nil(t, sig)
+	assert.True(t, errors.Is(err, crypto.ErrDoubleSignRefused))
+}
+
+func TestDoubleSignProtectedBackend_HistoryNotWrittenShouldNotSign(t *testing.T) {
+	t.Parallel()
+
+	dspb, args, publicKey := createDoubleSignProtectedBackend(t)
+	defer removeSigningHistoryDir(args)
+	dspb.filePath = filepath.Join(dspb.filePath, "missing directory", "signingHistory.json")
+
+	sig, err := dspb.Sign(publicKey, []byte("header A"), crypto.SignatureShareMessageType, createSignatureShareSlot(1, 10))
+	assert.Nil(t, sig)
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, len(dspb.signedSlots))
+}
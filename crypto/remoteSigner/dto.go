@@ -0,0 +1,31 @@
+package remoteSigner
+
+// SignRequest is the body of a signing request, the public key and the message are hex encoded. The message type is
+// mandatory and the slot must be provided for the message types bound to a consensus slot, as the signature shares
+type SignRequest struct {
+	PublicKey   string           `json:"publicKey"`
+	Message     string           `json:"message"`
+	MessageType string           `json:"messageType"`
+	Slot        *SignRequestSlot `json:"slot,omitempty"`
+}
+
+// SignRequestSlot is the consensus slot a signing request is made for
+type SignRequestSlot struct {
+	Epoch uint32 `json:"epoch"`
+	Round uint64 `json:"round"`
+}
+
+// SignResponse is the body of the response to a successful signing request, the signature is hex encoded
+type SignResponse struct {
+	Signature string `json:"signature"`
+}
+
+// PublicKeysResponse is the body of the response listing the hex encoded public keys of the keys held by the signer
+type PublicKeysResponse struct {
+	PublicKeys []string `json:"publicKeys"`
+}
+
+// ErrorResponse is the body of the response to a failed request
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
@@ -0,0 +1,170 @@
+package remoteSigner
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+var log = logger.GetOrCreate("crypto/remotesigner")
+
+var _ crypto.SigningBackend = (*httpSigningClient)(nil)
+
+const (
+	signPath        = "/sign"
+	publicKeysPath  = "/publicKeys"
+	maxResponseSize = 1024 * 1024
+)
+
+// ArgsHTTPSigningClient is the DTO used to create a new instance of httpSigningClient
+type ArgsHTTPSigningClient struct {
+	URL             string
+	CertificateFile string
+	KeyFile         string
+	CAFile          string
+	RequestTimeout  time.Duration
+}
+
+// httpSigningClient is the signing backend of a remote signer reached over HTTPS. Both sides authenticate with
+// certificates issued by the configured certificate authority (mutual TLS), so only the node can request signatures
+// and the node only trusts the genuine signer
+type httpSigningClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewHTTPSigningClient creates a new client of a remote signer
+func NewHTTPSigningClient(args ArgsHTTPSigningClient) (*httpSigningClient, error) {
+	parsedURL, err := url.Parse(args.URL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", crypto.ErrInvalidRemoteSignerURL, err.Error())
+	}
+	if parsedURL.Scheme != "https" || len(parsedURL.Host) == 0 {
+		return nil, fmt.Errorf("%w, an https URL is expected, provided %s", crypto.ErrInvalidRemoteSignerURL, args.URL)
+	}
+	if args.RequestTimeout <= 0 {
+		return nil, crypto.ErrInvalidRequestTimeout
+	}
+
+	tlsConfig, err := NewClientTLSConfig(args.CertificateFile, args.KeyFile, args.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpSigningClient{
+		baseURL: strings.TrimRight(args.URL, "/"),
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig:     tlsConfig,
+				MaxIdleConnsPerHost: 4,
+			},
+			Timeout: args.RequestTimeout,
+		},
+	}, nil
+}
+
+// Sign asks the remote signer for the signature of the key identified by the provided public key over the message
+func (hsc *httpSigningClient) Sign(publicKey []byte, message []byte, messageType string, slot *crypto.SigningSlot) ([]byte, error) {
+	request := &SignRequest{
+		PublicKey:   hex.EncodeToString(publicKey),
+		Message:     hex.EncodeToString(message),
+		MessageType: messageType,
+	}
+	if slot != nil {
+		request.Slot = &SignRequestSlot{
+			Epoch: slot.Epoch,
+			Round: slot.Round,
+		}
+	}
+	buff, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SignResponse{}
+	err = hsc.call(http.MethodPost, signPath, bytes.NewReader(buff), response)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := hex.DecodeString(response.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid signature encoding, %s", crypto.ErrRemoteSignerRequestFailed, err.Error())
+	}
+
+	return sig, nil
+}
+
+// PublicKeys returns the public keys of the secret keys held by the remote signer
+func (hsc *httpSigningClient) PublicKeys() ([][]byte, error) {
+	response := &PublicKeysResponse{}
+	err := hsc.call(http.MethodGet, publicKeysPath, nil, response)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeys := make([][]byte, 0, len(response.PublicKeys))
+	for _, encodedPublicKey := range response.PublicKeys {
+		publicKey, errDecode := hex.DecodeString(encodedPublicKey)
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w: invalid public key encoding, %s", crypto.ErrRemoteSignerRequestFailed, errDecode.Error())
+		}
+
+		publicKeys = append(publicKeys, publicKey)
+	}
+
+	return publicKeys, nil
+}
+
+func (hsc *httpSigningClient) call(method string, path string, body io.Reader, response interface{}) error {
+	request, err := http.NewRequest(method, hsc.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	httpResponse, err := hsc.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("%w: %s", crypto.ErrRemoteSignerRequestFailed, err.Error())
+	}
+	defer func() {
+		_ = httpResponse.Body.Close()
+	}()
+
+	buff, err := ioutil.ReadAll(io.LimitReader(httpResponse.Body, maxResponseSize))
+	if err != nil {
+		return fmt.Errorf("%w: %s", crypto.ErrRemoteSignerRequestFailed, err.Error())
+	}
+
+	if httpResponse.StatusCode != http.StatusOK {
+		errResponse := &ErrorResponse{}
+		_ = json.Unmarshal(buff, errResponse)
+		if httpResponse.StatusCode == http.StatusConflict {
+			return fmt.Errorf("%w: %s", crypto.ErrDoubleSignRefused, errResponse.Error)
+		}
+
+		return fmt.Errorf("%w: status %d, %s", crypto.ErrRemoteSignerRequestFailed, httpResponse.StatusCode, errResponse.Error)
+	}
+
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return fmt.Errorf("%w: %s", crypto.ErrRemoteSignerRequestFailed, err.Error())
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hsc *httpSigningClient) IsInterfaceNil() bool {
+	return hsc == nil
+}
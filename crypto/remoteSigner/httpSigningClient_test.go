@@ -0,0 +1,295 @@
+package remoteSigner
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type certificateAuthority struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	file        string
+}
+
+// createCertificateAuthority creates a self signed certificate authority and writes its certificate in the directory
+func createCertificateAuthority(t *testing.T, dir string, name string) *certificateAuthority {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.Nil(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.Nil(t, err)
+
+	ca := &certificateAuthority{
+		certificate: certificate,
+		key:         key,
+		file:        filepath.Join(dir, name+".crt"),
+	}
+	writePem(t, ca.file, "CERTIFICATE", der)
+
+	return ca
+}
+
+// issue creates a certificate signed by the certificate authority and returns the certificate and the key files
+func (ca *certificateAuthority) issue(t *testing.T, dir string, name string, usage x509.ExtKeyUsage) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, &key.PublicKey, ca.key)
+	require.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+
+	certificateFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	writePem(t, certificateFile, "CERTIFICATE", der)
+	writePem(t, keyFile, "EC PRIVATE KEY", keyDer)
+
+	return certificateFile, keyFile
+}
+
+func writePem(t *testing.T, file string, blockType string, der []byte) {
+	buff := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	err := ioutil.WriteFile(file, buff, 0600)
+	require.Nil(t, err)
+}
+
+type testSetup struct {
+	dir        string
+	server     *httptest.Server
+	ca         *certificateAuthority
+	backend    *localSigningBackend
+	argsClient ArgsHTTPSigningClient
+}
+
+func (ts *testSetup) close() {
+	ts.server.Close()
+	_ = os.RemoveAll(ts.dir)
+}
+
+func createTestSetup(t *testing.T) *testSetup {
+	dir, err := ioutil.TempDir("", "remoteSigner")
+	require.Nil(t, err)
+
+	ca := createCertificateAuthority(t, dir, "ca")
+	serverCertificate, serverKey := ca.issue(t, dir, "signer", x509.ExtKeyUsageServerAuth)
+	clientCertificate, clientKey := ca.issue(t, dir, "node", x509.ExtKeyUsageClientAuth)
+
+	_, backend, _ := createRemoteKey(t)
+	protectedBackend, err := NewDoubleSignProtectedBackend(ArgsDoubleSignProtectedBackend{
+		Backend:                backend,
+		SigningHistoryFilePath: filepath.Join(dir, "signingHistory.json"),
+	})
+	require.Nil(t, err)
+	handler, err := NewSigningHandler(protectedBackend)
+	require.Nil(t, err)
+
+	server := httptest.NewUnstartedServer(handler)
+	server.TLS, err = NewServerTLSConfig(serverCertificate, serverKey, ca.file)
+	require.Nil(t, err)
+	server.StartTLS()
+
+	return &testSetup{
+		dir:     dir,
+		server:  server,
+		ca:      ca,
+		backend: backend,
+		argsClient: ArgsHTTPSigningClient{
+			URL:             server.URL,
+			CertificateFile: clientCertificate,
+			KeyFile:         clientKey,
+			CAFile:          ca.file,
+			RequestTimeout:  time.Second * 5,
+		},
+	}
+}
+
+func TestNewHTTPSigningClient_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	setup := createTestSetup(t)
+	defer setup.close()
+
+	args := setup.argsClient
+	args.URL = "http://127.0.0.1:9443"
+	hsc, err := NewHTTPSigningClient(args)
+	assert.Nil(t, hsc)
+	assert.True(t, errors.Is(err, crypto.ErrInvalidRemoteSignerURL))
+
+	args = setup.argsClient
+	args.RequestTimeout = 0
+	hsc, err = NewHTTPSigningClient(args)
+	assert.Nil(t, hsc)
+	assert.Equal(t, crypto.ErrInvalidRequestTimeout, err)
+
+	args = setup.argsClient
+	args.CAFile = ""
+	hsc, err = NewHTTPSigningClient(args)
+	assert.Nil(t, hsc)
+	assert.Equal(t, crypto.ErrMissingTLSFiles, err)
+}
+
+func TestHTTPSigningClient_ShouldSignWithTheRemoteKey(t *testing.T) {
+	t.Parallel()
+
+	setup := createTestSetup(t)
+	defer setup.close()
+
+	hsc, err := NewHTTPSigningClient(setup.argsClient)
+	require.Nil(t, err)
+
+	publicKeys, err := hsc.PublicKeys()
+	require.Nil(t, err)
+	require.Equal(t, 1, len(publicKeys))
+
+	sig, err := hsc.Sign(publicKeys[0], message, crypto.SingleSignatureMessageType, nil)
+	assert.Nil(t, err)
+
+	expectedSig, _ := setup.backend.Sign(publicKeys[0], message, crypto.SingleSignatureMessageType, nil)
+	assert.Equal(t, expectedSig, sig)
+
+	sig, err = hsc.Sign([]byte("unknown public key"), message, crypto.SingleSignatureMessageType, nil)
+	assert.Nil(t, sig)
+	assert.True(t, errors.Is(err, crypto.ErrRemoteSignerRequestFailed))
+}
+
+func TestHTTPSigningClient_DoubleSignShouldBeRefused(t *testing.T) {
+	t.Parallel()
+
+	setup := createTestSetup(t)
+	defer setup.close()
+
+	hsc, err := NewHTTPSigningClient(setup.argsClient)
+	require.Nil(t, err)
+
+	publicKeys, err := hsc.PublicKeys()
+	require.Nil(t, err)
+
+	slot := &crypto.SigningSlot{
+		Epoch: 2,
+		Round: 37,
+	}
+	sig, err := hsc.Sign(publicKeys[0], []byte("header A"), crypto.SignatureShareMessageType, slot)
+	assert.Nil(t, err)
+	assert.NotNil(t, sig)
+
+	sig, err = hsc.Sign(publicKeys[0], []byte("header B"), crypto.SignatureShareMessageType, slot)
+	assert.Nil(t, sig)
+	assert.True(t, errors.Is(err, crypto.ErrDoubleSignRefused))
+}
+
+func TestHTTPSigningClient_SignatureShareWithoutSlotShouldBeRefused(t *testing.T) {
+	t.Parallel()
+
+	setup := createTestSetup(t)
+	defer setup.close()
+
+	hsc, err := NewHTTPSigningClient(setup.argsClient)
+	require.Nil(t, err)
+
+	publicKeys, err := hsc.PublicKeys()
+	require.Nil(t, err)
+
+	sig, err := hsc.Sign(publicKeys[0], []byte("header A"), crypto.SignatureShareMessageType, nil)
+	assert.Nil(t, sig)
+	assert.True(t, errors.Is(err, crypto.ErrRemoteSignerRequestFailed))
+	assert.Contains(t, err.Error(), crypto.ErrMissingSigningSlot.Error())
+
+	sig, err = hsc.Sign(publicKeys[0], []byte("header A"), "", nil)
+	assert.Nil(t, sig)
+	assert.True(t, errors.Is(err, crypto.ErrRemoteSignerRequestFailed))
+	assert.Contains(t, err.Error(), crypto.ErrUnknownMessageType.Error())
+}
+
+func TestHTTPSigningClient_SignedThroughTheSingleSigner(t *testing.T) {
+	t.Parallel()
+
+	setup := createTestSetup(t)
+	defer setup.close()
+
+	hsc, _ := NewHTTPSigningClient(setup.argsClient)
+	publicKeys, _ := setup.backend.PublicKeys()
+	privateKey := setup.backend.keys[string(publicKeys[0])]
+	remoteKey, _ := NewRemotePrivateKey(privateKey.GeneratePublic(), hsc)
+
+	blsSigner := &singlesig.BlsSingleSigner{}
+	ss, _ := NewSingleSigner(blsSigner)
+	sig, err := ss.Sign(remoteKey, message)
+	assert.Nil(t, err)
+	assert.Nil(t, blsSigner.Verify(remoteKey.GeneratePublic(), message, sig))
+}
+
+func TestHTTPSigningClient_UntrustedClientShouldBeRejected(t *testing.T) {
+	t.Parallel()
+
+	setup := createTestSetup(t)
+	defer setup.close()
+
+	otherCA := createCertificateAuthority(t, setup.dir, "otherCA")
+	certificateFile, keyFile := otherCA.issue(t, setup.dir, "intruder", x509.ExtKeyUsageClientAuth)
+
+	args := setup.argsClient
+	args.CertificateFile = certificateFile
+	args.KeyFile = keyFile
+	hsc, err := NewHTTPSigningClient(args)
+	require.Nil(t, err)
+
+	publicKeys, err := hsc.PublicKeys()
+	assert.Nil(t, publicKeys)
+	assert.True(t, errors.Is(err, crypto.ErrRemoteSignerRequestFailed))
+}
+
+func TestHTTPSigningClient_UntrustedSignerShouldBeRejected(t *testing.T) {
+	t.Parallel()
+
+	setup := createTestSetup(t)
+	defer setup.close()
+
+	otherCA := createCertificateAuthority(t, setup.dir, "otherCA")
+
+	args := setup.argsClient
+	args.CAFile = otherCA.file
+	hsc, err := NewHTTPSigningClient(args)
+	require.Nil(t, err)
+
+	sig, err := hsc.Sign([]byte("public key"), message, crypto.SingleSignatureMessageType, nil)
+	assert.Nil(t, sig)
+	assert.True(t, errors.Is(err, crypto.ErrRemoteSignerRequestFailed))
+}
@@ -0,0 +1,84 @@
+package remoteSigner
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+var _ crypto.SigningBackend = (*localSigningBackend)(nil)
+
+// ArgsLocalSigningBackend is the DTO used to create a new instance of localSigningBackend
+type ArgsLocalSigningBackend struct {
+	KeyGenerator crypto.KeyGenerator
+	SingleSigner crypto.SingleSigner
+	PrivateKeys  [][]byte
+}
+
+// localSigningBackend is a signing backend holding the secret keys in memory. It is used by a remote signer running on
+// a machine not reachable from the internet
+type localSigningBackend struct {
+	singleSigner crypto.SingleSigner
+	keys         map[string]crypto.PrivateKey
+	publicKeys   [][]byte
+}
+
+// NewLocalSigningBackend creates a signing backend holding the provided secret keys
+func NewLocalSigningBackend(args ArgsLocalSigningBackend) (*localSigningBackend, error) {
+	if check.IfNil(args.KeyGenerator) {
+		return nil, crypto.ErrNilKeyGenerator
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, crypto.ErrNilSingleSigner
+	}
+
+	lsb := &localSigningBackend{
+		singleSigner: args.SingleSigner,
+		keys:         make(map[string]crypto.PrivateKey, len(args.PrivateKeys)),
+		publicKeys:   make([][]byte, 0, len(args.PrivateKeys)),
+	}
+	for _, privateKeyBytes := range args.PrivateKeys {
+		privateKey, err := args.KeyGenerator.PrivateKeyFromByteArray(privateKeyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the private key", err)
+		}
+
+		publicKey, err := privateKey.GeneratePublic().ToByteArray()
+		if err != nil {
+			return nil, fmt.Errorf("%w for the public key", err)
+		}
+
+		lsb.keys[string(publicKey)] = privateKey
+		lsb.publicKeys = append(lsb.publicKeys, publicKey)
+	}
+
+	sort.Slice(lsb.publicKeys, func(i, j int) bool {
+		return string(lsb.publicKeys[i]) < string(lsb.publicKeys[j])
+	})
+
+	return lsb, nil
+}
+
+// Sign signs the message with the secret key of the provided public key. The message type and the slot are not
+// checked, the backend is meant to be wrapped in a double sign protected backend
+func (lsb *localSigningBackend) Sign(publicKey []byte, message []byte, _ string, _ *crypto.SigningSlot) ([]byte, error) {
+	privateKey, found := lsb.keys[string(publicKey)]
+	if !found {
+		return nil, fmt.Errorf("%w for public key %s", crypto.ErrUnknownSigningKey, hex.EncodeToString(publicKey))
+	}
+
+	return lsb.singleSigner.Sign(privateKey, message)
+}
+
+// PublicKeys returns the public keys of the held secret keys
+func (lsb *localSigningBackend) PublicKeys() ([][]byte, error) {
+	return lsb.publicKeys, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lsb *localSigningBackend) IsInterfaceNil() bool {
+	return lsb == nil
+}
@@ -0,0 +1,28 @@
+package remoteSigner
+
+import "github.com/ElrondNetwork/elrond-go/crypto"
+
+type signingBackendStub struct {
+	SignCalled       func(publicKey []byte, message []byte, messageType string, slot *crypto.SigningSlot) ([]byte, error)
+	PublicKeysCalled func() ([][]byte, error)
+}
+
+func (sbs *signingBackendStub) Sign(publicKey []byte, message []byte, messageType string, slot *crypto.SigningSlot) ([]byte, error) {
+	if sbs.SignCalled != nil {
+		return sbs.SignCalled(publicKey, message, messageType, slot)
+	}
+
+	return nil, nil
+}
+
+func (sbs *signingBackendStub) PublicKeys() ([][]byte, error) {
+	if sbs.PublicKeysCalled != nil {
+		return sbs.PublicKeysCalled()
+	}
+
+	return nil, nil
+}
+
+func (sbs *signingBackendStub) IsInterfaceNil() bool {
+	return sbs == nil
+}
@@ -0,0 +1,67 @@
+package remoteSigner
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+var _ crypto.PrivateKey = (*remotePrivateKey)(nil)
+
+// remotePrivateKey stands for a BLS secret key held by a signing backend. It only knows the public key of the secret
+// key, the signatures are created by the backend when the key is used with the signers of this package
+type remotePrivateKey struct {
+	publicKey      crypto.PublicKey
+	publicKeyBytes []byte
+	backend        crypto.SigningBackend
+}
+
+// NewRemotePrivateKey creates a private key whose secret key is held by the provided signing backend
+func NewRemotePrivateKey(publicKey crypto.PublicKey, backend crypto.SigningBackend) (*remotePrivateKey, error) {
+	if check.IfNil(publicKey) {
+		return nil, crypto.ErrNilPublicKey
+	}
+	if check.IfNil(backend) {
+		return nil, crypto.ErrNilSigningBackend
+	}
+
+	publicKeyBytes, err := publicKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return &remotePrivateKey{
+		publicKey:      publicKey,
+		publicKeyBytes: publicKeyBytes,
+		backend:        backend,
+	}, nil
+}
+
+// ToByteArray returns the bytes of the public key, as the secret key never leaves the signing backend. The returned
+// bytes only identify the key, they can not be used to sign
+func (rpk *remotePrivateKey) ToByteArray() ([]byte, error) {
+	return rpk.publicKeyBytes, nil
+}
+
+// GeneratePublic returns the public key of the remote secret key
+func (rpk *remotePrivateKey) GeneratePublic() crypto.PublicKey {
+	return rpk.publicKey
+}
+
+// Suite returns the suite of the public key
+func (rpk *remotePrivateKey) Suite() crypto.Suite {
+	return rpk.publicKey.Suite()
+}
+
+// Scalar returns nil as the secret key is not available
+func (rpk *remotePrivateKey) Scalar() crypto.Scalar {
+	return nil
+}
+
+func (rpk *remotePrivateKey) sign(message []byte, messageType string, slot *crypto.SigningSlot) ([]byte, error) {
+	return rpk.backend.Sign(rpk.publicKeyBytes, message, messageType, slot)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rpk *remotePrivateKey) IsInterfaceNil() bool {
+	return rpk == nil
+}
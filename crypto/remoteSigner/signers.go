@@ -0,0 +1,105 @@
+package remoteSigner
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+var _ crypto.SingleSigner = (*singleSigner)(nil)
+var _ crypto.LowLevelSignerBLS = (*shareSigner)(nil)
+
+// singleSigner is a wrapper over a single signer that hands the signing to the signing backend when the private key
+// is a remote one. The signatures created by the backend are verified before being returned, so a faulty backend can
+// not make the node broadcast invalid signatures. The other keys and the verifications are handled by the wrapped signer
+type singleSigner struct {
+	crypto.SingleSigner
+}
+
+// NewSingleSigner creates a single signer able to sign with the remote private keys
+func NewSingleSigner(signer crypto.SingleSigner) (*singleSigner, error) {
+	if check.IfNil(signer) {
+		return nil, crypto.ErrNilSingleSigner
+	}
+
+	return &singleSigner{
+		SingleSigner: signer,
+	}, nil
+}
+
+// Sign signs the message with the provided private key
+func (ss *singleSigner) Sign(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	remoteKey, isRemote := private.(*remotePrivateKey)
+	if !isRemote || remoteKey == nil {
+		return ss.SingleSigner.Sign(private, msg)
+	}
+
+	return signRemotely(remoteKey, msg, crypto.SingleSignatureMessageType, nil, ss.SingleSigner.Verify)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ss *singleSigner) IsInterfaceNil() bool {
+	return ss == nil
+}
+
+// shareSigner is a wrapper over a BLS low level signer that hands the creation of the signature shares to the signing
+// backend when the private key is a remote one, in the same way as the singleSigner. The signature shares are bound
+// to the current consensus slot, so the signer can refuse to sign two different headers in the same round
+type shareSigner struct {
+	crypto.LowLevelSignerBLS
+	signingSlotHandler crypto.SigningSlotHandler
+}
+
+// NewShareSigner creates a BLS low level signer able to create the signature shares of the remote private keys
+func NewShareSigner(signer crypto.LowLevelSignerBLS, signingSlotHandler crypto.SigningSlotHandler) (*shareSigner, error) {
+	if signer == nil {
+		return nil, crypto.ErrNilLowLevelSigner
+	}
+	if check.IfNil(signingSlotHandler) {
+		return nil, crypto.ErrNilSigningSlotHandler
+	}
+
+	return &shareSigner{
+		LowLevelSignerBLS:  signer,
+		signingSlotHandler: signingSlotHandler,
+	}, nil
+}
+
+// SignShare creates the signature share of the provided private key over the message
+func (ss *shareSigner) SignShare(privKey crypto.PrivateKey, message []byte) ([]byte, error) {
+	remoteKey, isRemote := privKey.(*remotePrivateKey)
+	if !isRemote || remoteKey == nil {
+		return ss.LowLevelSignerBLS.SignShare(privKey, message)
+	}
+
+	slot := ss.signingSlotHandler.SigningSlot()
+	if slot == nil {
+		return nil, crypto.ErrMissingSigningSlot
+	}
+
+	return signRemotely(remoteKey, message, crypto.SignatureShareMessageType, slot, ss.LowLevelSignerBLS.VerifySigShare)
+}
+
+func signRemotely(
+	remoteKey *remotePrivateKey,
+	message []byte,
+	messageType string,
+	slot *crypto.SigningSlot,
+	verify func(pubKey crypto.PublicKey, message []byte, sig []byte) error,
+) ([]byte, error) {
+	if len(message) == 0 {
+		return nil, crypto.ErrNilMessage
+	}
+
+	sig, err := remoteKey.sign(message, messageType, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verify(remoteKey.publicKey, message, sig)
+	if err != nil {
+		log.Warn("the remote signer returned an invalid signature", "public key", remoteKey.publicKeyBytes, "error", err.Error())
+		return nil, err
+	}
+
+	return sig, nil
+}
@@ -0,0 +1,226 @@
+package remoteSigner
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	mclMultiSig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/multisig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var message = []byte("message")
+
+func createRemoteKey(t *testing.T) (crypto.PrivateKey, *localSigningBackend, crypto.PrivateKey) {
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	localKey, _ := keyGen.GeneratePair()
+	localKeyBytes, _ := localKey.ToByteArray()
+
+	backend, err := NewLocalSigningBackend(ArgsLocalSigningBackend{
+		KeyGenerator: keyGen,
+		SingleSigner: &singlesig.BlsSingleSigner{},
+		PrivateKeys:  [][]byte{localKeyBytes},
+	})
+	require.Nil(t, err)
+
+	remoteKey, err := NewRemotePrivateKey(localKey.GeneratePublic(), backend)
+	require.Nil(t, err)
+
+	return remoteKey, backend, localKey
+}
+
+func TestNewRemotePrivateKey_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, backend, localKey := createRemoteKey(t)
+
+	rpk, err := NewRemotePrivateKey(nil, backend)
+	assert.True(t, check.IfNil(rpk))
+	assert.Equal(t, crypto.ErrNilPublicKey, err)
+
+	rpk, err = NewRemotePrivateKey(localKey.GeneratePublic(), nil)
+	assert.True(t, check.IfNil(rpk))
+	assert.Equal(t, crypto.ErrNilSigningBackend, err)
+}
+
+func TestRemotePrivateKey_ShouldOnlyExposeThePublicKey(t *testing.T) {
+	t.Parallel()
+
+	remoteKey, _, localKey := createRemoteKey(t)
+
+	publicKeyBytes, _ := localKey.GeneratePublic().ToByteArray()
+	keyBytes, err := remoteKey.ToByteArray()
+	assert.Nil(t, err)
+	assert.Equal(t, publicKeyBytes, keyBytes)
+	assert.Equal(t, localKey.GeneratePublic(), remoteKey.GeneratePublic())
+	assert.Nil(t, remoteKey.Scalar())
+}
+
+func TestSingleSigner_SignWithRemoteKeyShouldUseTheBackend(t *testing.T) {
+	t.Parallel()
+
+	remoteKey, _, localKey := createRemoteKey(t)
+	blsSigner := &singlesig.BlsSingleSigner{}
+	ss, err := NewSingleSigner(blsSigner)
+	require.Nil(t, err)
+
+	sig, err := ss.Sign(remoteKey, message)
+	assert.Nil(t, err)
+
+	expectedSig, _ := blsSigner.Sign(localKey, message)
+	assert.Equal(t, expectedSig, sig)
+	assert.Nil(t, ss.Verify(remoteKey.GeneratePublic(), message, sig))
+
+	_, err = blsSigner.Sign(remoteKey, message)
+	assert.Equal(t, crypto.ErrNilPrivateKeyScalar, err)
+}
+
+func TestSingleSigner_SignWithLocalKeyShouldUseTheWrappedSigner(t *testing.T) {
+	t.Parallel()
+
+	_, _, localKey := createRemoteKey(t)
+	blsSigner := &singlesig.BlsSingleSigner{}
+	ss, _ := NewSingleSigner(blsSigner)
+
+	sig, err := ss.Sign(localKey, message)
+	assert.Nil(t, err)
+
+	expectedSig, _ := blsSigner.Sign(localKey, message)
+	assert.Equal(t, expectedSig, sig)
+}
+
+func TestSingleSigner_InvalidRemoteSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	_, otherPublicKey := keyGen.GeneratePair()
+	_, backend, localKey := createRemoteKey(t)
+	localPublicKeyBytes, _ := localKey.GeneratePublic().ToByteArray()
+
+	// the backend signs with another key than the one the node expects
+	remoteKey, _ := NewRemotePrivateKey(otherPublicKey, &signingBackendStub{
+		SignCalled: func(_ []byte, msg []byte, messageType string, _ *crypto.SigningSlot) ([]byte, error) {
+			return backend.Sign(localPublicKeyBytes, msg, messageType, nil)
+		},
+	})
+	ss, _ := NewSingleSigner(&singlesig.BlsSingleSigner{})
+
+	sig, err := ss.Sign(remoteKey, message)
+	assert.Nil(t, sig)
+	assert.Equal(t, crypto.ErrSigNotValid, err)
+}
+
+func TestSingleSigner_BackendErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	_, _, localKey := createRemoteKey(t)
+	remoteKey, _ := NewRemotePrivateKey(localKey.GeneratePublic(), &signingBackendStub{
+		SignCalled: func(_ []byte, _ []byte, _ string, _ *crypto.SigningSlot) ([]byte, error) {
+			return nil, expectedErr
+		},
+	})
+	ss, _ := NewSingleSigner(&singlesig.BlsSingleSigner{})
+
+	sig, err := ss.Sign(remoteKey, message)
+	assert.Nil(t, sig)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestShareSigner_SignShareWithRemoteKeyShouldUseTheBackend(t *testing.T) {
+	t.Parallel()
+
+	remoteKey, _, localKey := createRemoteKey(t)
+	blsSigner := &mclMultiSig.BlsMultiSigner{Hasher: &blake2b.Blake2b{HashSize: 16}}
+	slotHandler := NewSigningSlotHandler()
+	slotHandler.SetSigningSlot(1, 10)
+	ss, err := NewShareSigner(blsSigner, slotHandler)
+	require.Nil(t, err)
+
+	sig, err := ss.SignShare(remoteKey, message)
+	assert.Nil(t, err)
+
+	expectedSig, _ := blsSigner.SignShare(localKey, message)
+	assert.Equal(t, expectedSig, sig)
+}
+
+func TestNewShareSigner_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	blsSigner := &mclMultiSig.BlsMultiSigner{Hasher: &blake2b.Blake2b{HashSize: 16}}
+	ss, err := NewShareSigner(nil, NewSigningSlotHandler())
+	assert.Nil(t, ss)
+	assert.Equal(t, crypto.ErrNilLowLevelSigner, err)
+
+	ss, err = NewShareSigner(blsSigner, nil)
+	assert.Nil(t, ss)
+	assert.Equal(t, crypto.ErrNilSigningSlotHandler, err)
+}
+
+func TestShareSigner_SignShareShouldSendTheCurrentSlot(t *testing.T) {
+	t.Parallel()
+
+	_, backend, localKey := createRemoteKey(t)
+	localPublicKeyBytes, _ := localKey.GeneratePublic().ToByteArray()
+	var messageTypes []string
+	var slots []*crypto.SigningSlot
+	backendStub := &signingBackendStub{
+		SignCalled: func(_ []byte, msg []byte, messageType string, slot *crypto.SigningSlot) ([]byte, error) {
+			messageTypes = append(messageTypes, messageType)
+			slots = append(slots, slot)
+			return backend.Sign(localPublicKeyBytes, msg, messageType, slot)
+		},
+	}
+	remoteKey, _ := NewRemotePrivateKey(localKey.GeneratePublic(), backendStub)
+	slotHandler := NewSigningSlotHandler()
+	blsSigner := &mclMultiSig.BlsMultiSigner{Hasher: &blake2b.Blake2b{HashSize: 16}}
+	ss, _ := NewShareSigner(blsSigner, slotHandler)
+
+	sig, err := ss.SignShare(remoteKey, message)
+	assert.Nil(t, sig)
+	assert.Equal(t, crypto.ErrMissingSigningSlot, err)
+	assert.Equal(t, 0, len(slots))
+
+	slotHandler.SetSigningSlot(3, 45)
+	_, err = ss.SignShare(remoteKey, message)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{crypto.SignatureShareMessageType}, messageTypes)
+	assert.Equal(t, []*crypto.SigningSlot{{Epoch: 3, Round: 45}}, slots)
+}
+
+func TestSingleSigner_SignWithRemoteKeyShouldSendTheSingleSignatureMessageType(t *testing.T) {
+	t.Parallel()
+
+	_, backend, localKey := createRemoteKey(t)
+	localPublicKeyBytes, _ := localKey.GeneratePublic().ToByteArray()
+	var messageTypes []string
+	backendStub := &signingBackendStub{
+		SignCalled: func(_ []byte, msg []byte, messageType string, slot *crypto.SigningSlot) ([]byte, error) {
+			messageTypes = append(messageTypes, messageType)
+			return backend.Sign(localPublicKeyBytes, msg, messageType, slot)
+		},
+	}
+	remoteKey, _ := NewRemotePrivateKey(localKey.GeneratePublic(), backendStub)
+	ss, _ := NewSingleSigner(&singlesig.BlsSingleSigner{})
+
+	_, err := ss.Sign(remoteKey, message)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{crypto.SingleSignatureMessageType}, messageTypes)
+}
+
+func TestLocalSigningBackend_UnknownKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, backend, _ := createRemoteKey(t)
+
+	sig, err := backend.Sign([]byte("unknown public key"), message, crypto.SingleSignatureMessageType, nil)
+	assert.Nil(t, sig)
+	assert.True(t, errors.Is(err, crypto.ErrUnknownSigningKey))
+}
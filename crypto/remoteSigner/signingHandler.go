@@ -0,0 +1,136 @@
+package remoteSigner
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+const maxRequestSize = 1024 * 1024
+
+// signingHandler serves the signing requests of the nodes on the remote signer side, passing them to the signing
+// backend holding the secret keys. It is meant to be served over mutual TLS, see NewServerTLSConfig
+type signingHandler struct {
+	backend crypto.SigningBackend
+	mux     *http.ServeMux
+}
+
+// NewSigningHandler creates the HTTP handler of a remote signer using the provided signing backend. A signer keeping
+// its keys in a hardware security module only needs to provide the backend talking to the module
+func NewSigningHandler(backend crypto.SigningBackend) (*signingHandler, error) {
+	if check.IfNil(backend) {
+		return nil, crypto.ErrNilSigningBackend
+	}
+
+	sh := &signingHandler{
+		backend: backend,
+		mux:     http.NewServeMux(),
+	}
+	sh.mux.HandleFunc(signPath, sh.sign)
+	sh.mux.HandleFunc(publicKeysPath, sh.publicKeys)
+
+	return sh, nil
+}
+
+// ServeHTTP serves the sign and the public keys requests
+func (sh *signingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	sh.mux.ServeHTTP(w, r)
+}
+
+func (sh *signingHandler) sign(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	request := &SignRequest{}
+	err := json.NewDecoder(io.LimitReader(r.Body, maxRequestSize)).Decode(request)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	publicKey, err := hex.DecodeString(request.PublicKey)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	message, err := hex.DecodeString(request.Message)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var slot *crypto.SigningSlot
+	if request.Slot != nil {
+		slot = &crypto.SigningSlot{
+			Epoch: request.Slot.Epoch,
+			Round: request.Slot.Round,
+		}
+	}
+
+	sig, err := sh.backend.Sign(publicKey, message, request.MessageType, slot)
+	if errors.Is(err, crypto.ErrUnknownSigningKey) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if errors.Is(err, crypto.ErrUnknownMessageType) || errors.Is(err, crypto.ErrMissingSigningSlot) {
+		log.Warn("signingHandler: refused to sign", "public key", publicKey, "error", err.Error())
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if errors.Is(err, crypto.ErrDoubleSignRefused) {
+		log.Warn("signingHandler: refused to sign", "public key", publicKey, "error", err.Error())
+		writeError(w, http.StatusConflict, err)
+		return
+	}
+	if err != nil {
+		log.Warn("signingHandler: can not sign", "public key", publicKey, "error", err.Error())
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeResponse(w, &SignResponse{Signature: hex.EncodeToString(sig)})
+}
+
+func (sh *signingHandler) publicKeys(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	publicKeys, err := sh.backend.PublicKeys()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	response := &PublicKeysResponse{
+		PublicKeys: make([]string, 0, len(publicKeys)),
+	}
+	for _, publicKey := range publicKeys {
+		response.PublicKeys = append(response.PublicKeys, hex.EncodeToString(publicKey))
+	}
+
+	writeResponse(w, response)
+}
+
+func writeResponse(w http.ResponseWriter, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(&ErrorResponse{Error: err.Error()})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sh *signingHandler) IsInterfaceNil() bool {
+	return sh == nil
+}
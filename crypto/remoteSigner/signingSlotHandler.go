@@ -0,0 +1,52 @@
+package remoteSigner
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+var _ crypto.SigningSlotHandler = (*signingSlotHandler)(nil)
+
+// signingSlotHandler holds the consensus slot the node currently signs for. It is set by the consensus at the start
+// of each round and read by the share signer when it asks the signing backend for a signature share
+type signingSlotHandler struct {
+	mutSlot sync.RWMutex
+	isSet   bool
+	epoch   uint32
+	round   uint64
+}
+
+// NewSigningSlotHandler creates a signing slot handler with no slot set
+func NewSigningSlotHandler() *signingSlotHandler {
+	return &signingSlotHandler{}
+}
+
+// SetSigningSlot sets the epoch and the round of the consensus slot the node currently signs for
+func (ssh *signingSlotHandler) SetSigningSlot(epoch uint32, round uint64) {
+	ssh.mutSlot.Lock()
+	ssh.isSet = true
+	ssh.epoch = epoch
+	ssh.round = round
+	ssh.mutSlot.Unlock()
+}
+
+// SigningSlot returns the current consensus slot, nil if no slot was set
+func (ssh *signingSlotHandler) SigningSlot() *crypto.SigningSlot {
+	ssh.mutSlot.RLock()
+	defer ssh.mutSlot.RUnlock()
+
+	if !ssh.isSet {
+		return nil
+	}
+
+	return &crypto.SigningSlot{
+		Epoch: ssh.epoch,
+		Round: ssh.round,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ssh *signingSlotHandler) IsInterfaceNil() bool {
+	return ssh == nil
+}
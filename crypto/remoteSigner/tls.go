@@ -0,0 +1,64 @@
+package remoteSigner
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+// NewClientTLSConfig creates the TLS configuration used by the node to authenticate itself to the remote signer with
+// the provided certificate and to accept only a remote signer certified by the provided certificate authority
+func NewClientTLSConfig(certificateFile string, keyFile string, caFile string) (*tls.Config, error) {
+	certificate, certPool, err := loadTLSFiles(certificateFile, keyFile, caFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      certPool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// NewServerTLSConfig creates the TLS configuration used by a remote signer to present the provided certificate and to
+// accept only the clients certified by the provided certificate authority
+func NewServerTLSConfig(certificateFile string, keyFile string, clientCAFile string) (*tls.Config, error) {
+	certificate, certPool, err := loadTLSFiles(certificateFile, keyFile, clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientCAs:    certPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func loadTLSFiles(certificateFile string, keyFile string, caFile string) (tls.Certificate, *x509.CertPool, error) {
+	if len(certificateFile) == 0 || len(keyFile) == 0 || len(caFile) == 0 {
+		return tls.Certificate{}, nil, crypto.ErrMissingTLSFiles
+	}
+
+	certificate, err := tls.LoadX509KeyPair(certificateFile, keyFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("%w while loading the certificate %s", err, certificateFile)
+	}
+
+	caBytes, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caBytes) {
+		return tls.Certificate{}, nil, fmt.Errorf("%w, no certificate found in %s", crypto.ErrMissingTLSFiles, caFile)
+	}
+
+	return certificate, certPool, nil
+}
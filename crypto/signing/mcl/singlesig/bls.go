@@ -1,13 +1,20 @@
 package singlesig
 
 import (
+	"crypto/rand"
+
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
 	"github.com/herumi/bls-go-binary/bls"
 )
 
+// batchWeightSize is the size in bytes of the random weights of the batch verification. An invalid batch passes the
+// verification with a probability of 2^-128
+const batchWeightSize = 16
+
 var _ crypto.SingleSigner = (*BlsSingleSigner)(nil)
+var _ crypto.BatchVerifier = (*BlsSingleSigner)(nil)
 
 // BlsSingleSigner is a SingleSigner implementation that uses a BLS signature scheme
 type BlsSingleSigner struct {
@@ -55,33 +62,115 @@ func (s *BlsSingleSigner) Verify(public crypto.PublicKey, msg []byte, sig []byte
 		return crypto.ErrNilSignature
 	}
 
+	mclPubKey, err := castPublicKey(public)
+	if err != nil {
+		return err
+	}
+
+	signature, err := deserializeSignature(sig)
+	if err != nil {
+		return err
+	}
+
+	if signature.Verify(mclPubKey, string(msg)) {
+		return nil
+	}
+
+	return crypto.ErrSigNotValid
+}
+
+// VerifyBatch verifies the provided signatures at once. Each signature and its public key are weighted with a random
+// scalar, so that invalid signatures can not compensate each other, then a single pairing check is done:
+// e(sum(r_i * sig_i), -G2) * prod(e(H(msg_i), r_i * pk_i)) == 1
+func (s *BlsSingleSigner) VerifyBatch(publicKeys []crypto.PublicKey, messages [][]byte, signatures [][]byte) error {
+	if len(publicKeys) != len(messages) || len(publicKeys) != len(signatures) {
+		return crypto.ErrBatchLengthMismatch
+	}
+
+	g1Points := make([]bls.G1, len(publicKeys)+1)
+	g2Points := make([]bls.G2, len(publicKeys)+1)
+	aggregatedSig := &g1Points[0]
+	aggregatedSig.Clear()
+	weight := &bls.Fr{}
+	weightBytes := make([]byte, batchWeightSize)
+	weightedSig := &bls.G1{}
+	for i := range publicKeys {
+		if check.IfNil(publicKeys[i]) {
+			return crypto.ErrNilPublicKey
+		}
+		if len(messages[i]) == 0 {
+			return crypto.ErrNilMessage
+		}
+		if len(signatures[i]) == 0 {
+			return crypto.ErrNilSignature
+		}
+
+		mclPubKey, err := castPublicKey(publicKeys[i])
+		if err != nil {
+			return err
+		}
+		signature, err := deserializeSignature(signatures[i])
+		if err != nil {
+			return err
+		}
+		err = g1Points[i+1].HashAndMapTo(messages[i])
+		if err != nil {
+			return err
+		}
+
+		_, err = rand.Read(weightBytes)
+		if err != nil {
+			return err
+		}
+		err = weight.SetLittleEndian(weightBytes)
+		if err != nil {
+			return err
+		}
+		bls.G1Mul(weightedSig, bls.CastFromSign(signature), weight)
+		bls.G1Add(aggregatedSig, aggregatedSig, weightedSig)
+		bls.G2Mul(&g2Points[i+1], bls.CastFromPublicKey(mclPubKey), weight)
+	}
+
+	generator := &bls.PublicKey{}
+	bls.BlsGetGeneratorOfPublicKey(generator)
+	bls.G2Neg(&g2Points[0], bls.CastFromPublicKey(generator))
+
+	result := &bls.GT{}
+	bls.MillerLoopVec(result, g1Points, g2Points)
+	bls.FinalExp(result, result)
+	if result.IsOne() {
+		return nil
+	}
+
+	return crypto.ErrSigNotValid
+}
+
+func castPublicKey(public crypto.PublicKey) (*bls.PublicKey, error) {
 	point := public.Point()
 	if check.IfNil(point) {
-		return crypto.ErrNilPublicKeyPoint
+		return nil, crypto.ErrNilPublicKeyPoint
 	}
 
 	pubKeyPoint, isPoint := point.(*mcl.PointG2)
 	if !isPoint || !IsPubKeyPointValid(pubKeyPoint) {
-		return crypto.ErrInvalidPublicKey
+		return nil, crypto.ErrInvalidPublicKey
 	}
 
-	mclPubKey := bls.CastToPublicKey(pubKeyPoint.G2)
-	signature := &bls.Sign{}
+	return bls.CastToPublicKey(pubKeyPoint.G2), nil
+}
 
+func deserializeSignature(sig []byte) (*bls.Sign, error) {
+	signature := &bls.Sign{}
 	err := signature.Deserialize(sig)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if !IsSigValidPoint(signature) {
-		return crypto.ErrBLSInvalidSignature
+		return nil, crypto.ErrBLSInvalidSignature
 	}
 
-	if signature.Verify(mclPubKey, string(msg)) {
-		return nil
-	}
-
-	return crypto.ErrSigNotValid
+	return signature, nil
 }
 
 // IsPubKeyPointValid validates the public key is a valid point on G2
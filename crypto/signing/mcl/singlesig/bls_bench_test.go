@@ -4,6 +4,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
@@ -60,3 +61,31 @@ func BenchmarkBlsSingleSigner_Verify(b *testing.B) {
 		require.Nil(b, err)
 	}
 }
+
+func BenchmarkBlsSingleSigner_VerifyBatch(b *testing.B) {
+	signer := singlesig.NewBlsSigner()
+	suite := mcl.NewSuiteBLS12()
+	kg := signing.NewKeyGenerator(suite)
+	hasher := sha256.Sha256{}
+
+	batchSize := 64
+	publicKeys := make([]crypto.PublicKey, 0, batchSize)
+	messages := make([][]byte, 0, batchSize)
+	signatures := make([][]byte, 0, batchSize)
+	for i := 0; i < batchSize; i++ {
+		privKey, pubKey := kg.GeneratePair()
+		msg := hasher.Compute(strconv.Itoa(i))
+		signature, err := signer.Sign(privKey, msg)
+		require.Nil(b, err)
+
+		publicKeys = append(publicKeys, pubKey)
+		messages = append(messages, msg)
+		signatures = append(signatures, signature)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		err := signer.VerifyBatch(publicKeys, messages, signatures)
+		require.Nil(b, err)
+	}
+}
@@ -1,6 +1,7 @@
 package singlesig_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -9,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/herumi/bls-go-binary/bls"
 	"github.com/stretchr/testify/require"
 )
 
@@ -235,6 +237,98 @@ func TestBLSSigner_VerifyInvalidSignatureShouldErr(t *testing.T) {
 	require.Equal(t, crypto.ErrSigNotValid, err)
 }
 
+func createBLSBatch(t *testing.T, signer crypto.SingleSigner, numSignatures int) ([]crypto.PublicKey, [][]byte, [][]byte) {
+	kg := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	publicKeys := make([]crypto.PublicKey, 0, numSignatures)
+	messages := make([][]byte, 0, numSignatures)
+	signatures := make([][]byte, 0, numSignatures)
+	for i := 0; i < numSignatures; i++ {
+		privKey, pubKey := kg.GeneratePair()
+		msg := []byte(fmt.Sprintf("message %d to be signed", i))
+		signature, err := signer.Sign(privKey, msg)
+		require.Nil(t, err)
+
+		publicKeys = append(publicKeys, pubKey)
+		messages = append(messages, msg)
+		signatures = append(signatures, signature)
+	}
+
+	return publicKeys, messages, signatures
+}
+
+func TestBLSSigner_VerifyBatchLengthMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	signer := singlesig.NewBlsSigner()
+	publicKeys, messages, signatures := createBLSBatch(t, signer, 2)
+
+	err := signer.VerifyBatch(publicKeys, messages[:1], signatures)
+	require.Equal(t, crypto.ErrBatchLengthMismatch, err)
+
+	err = signer.VerifyBatch(publicKeys, messages, signatures[:1])
+	require.Equal(t, crypto.ErrBatchLengthMismatch, err)
+}
+
+func TestBLSSigner_VerifyBatchOK(t *testing.T) {
+	t.Parallel()
+
+	signer := singlesig.NewBlsSigner()
+	publicKeys, messages, signatures := createBLSBatch(t, signer, 10)
+
+	err := signer.VerifyBatch(publicKeys, messages, signatures)
+	require.Nil(t, err)
+}
+
+func TestBLSSigner_VerifyBatchSameKeyAndMessageOK(t *testing.T) {
+	t.Parallel()
+
+	signer := singlesig.NewBlsSigner()
+	publicKeys, messages, signatures := createBLSBatch(t, signer, 1)
+
+	err := signer.VerifyBatch(
+		[]crypto.PublicKey{publicKeys[0], publicKeys[0]},
+		[][]byte{messages[0], messages[0]},
+		[][]byte{signatures[0], signatures[0]},
+	)
+	require.Nil(t, err)
+}
+
+func TestBLSSigner_VerifyBatchInvalidSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	signer := singlesig.NewBlsSigner()
+	publicKeys, messages, signatures := createBLSBatch(t, signer, 10)
+	signatures[3], signatures[4] = signatures[4], signatures[3]
+
+	err := signer.VerifyBatch(publicKeys, messages, signatures)
+	require.Equal(t, crypto.ErrSigNotValid, err)
+}
+
+func TestBLSSigner_VerifyBatchCompensatingSignaturesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	signer := singlesig.NewBlsSigner()
+	publicKeys, messages, signatures := createBLSBatch(t, signer, 2)
+
+	// sig0 + delta and sig1 - delta are invalid but their sum is the sum of the valid signatures
+	delta := &bls.G1{}
+	require.Nil(t, delta.HashAndMapTo([]byte("delta")))
+	sig0 := &bls.Sign{}
+	require.Nil(t, sig0.Deserialize(signatures[0]))
+	sig1 := &bls.Sign{}
+	require.Nil(t, sig1.Deserialize(signatures[1]))
+	bls.G1Add(bls.CastFromSign(sig0), bls.CastFromSign(sig0), delta)
+	bls.G1Sub(bls.CastFromSign(sig1), bls.CastFromSign(sig1), delta)
+	signatures[0] = sig0.Serialize()
+	signatures[1] = sig1.Serialize()
+
+	require.Equal(t, crypto.ErrSigNotValid, signer.Verify(publicKeys[0], messages[0], signatures[0]))
+	require.Equal(t, crypto.ErrSigNotValid, signer.Verify(publicKeys[1], messages[1], signatures[1]))
+
+	err := signer.VerifyBatch(publicKeys, messages, signatures)
+	require.Equal(t, crypto.ErrSigNotValid, err)
+}
+
 func TestBLSSigner_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
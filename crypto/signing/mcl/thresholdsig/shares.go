@@ -0,0 +1,177 @@
+package thresholdsig
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/herumi/bls-go-binary/bls"
+)
+
+// KeyShare is one of the shares a BLS secret key was split in. The share is a BLS secret key on its own, any threshold
+// signatures created with distinct shares of the same key can be combined in a signature of the split key
+type KeyShare struct {
+	ID         uint32
+	PrivateKey []byte
+	PublicKey  []byte
+}
+
+// SplitPrivateKey splits the provided BLS secret key in numShares shares, with the IDs 1 to numShares, so that any
+// threshold shares can recover the signatures of the key while fewer shares can not tell anything about it
+func SplitPrivateKey(privateKey []byte, threshold uint32, numShares uint32) ([]KeyShare, error) {
+	err := checkThreshold(threshold, numShares)
+	if err != nil {
+		return nil, err
+	}
+	if len(privateKey) == 0 {
+		return nil, crypto.ErrInvalidPrivateKey
+	}
+
+	secretKey := &bls.SecretKey{}
+	err = secretKey.Deserialize(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", crypto.ErrInvalidPrivateKey, err.Error())
+	}
+	if !singlesig.IsSecretKeyValid(&mcl.Scalar{Scalar: bls.CastFromSecretKey(secretKey)}) {
+		return nil, crypto.ErrInvalidPrivateKey
+	}
+
+	// the secret key is the free term of a random polynomial of degree threshold-1, each share is the polynomial
+	// evaluated in the share ID
+	masterSecretKey := secretKey.GetMasterSecretKey(int(threshold))
+	shares := make([]KeyShare, 0, numShares)
+	for id := uint32(1); id <= numShares; id++ {
+		blsID, errID := createID(id)
+		if errID != nil {
+			return nil, errID
+		}
+
+		shareSecretKey := &bls.SecretKey{}
+		err = shareSecretKey.Set(masterSecretKey, blsID)
+		if err != nil {
+			return nil, err
+		}
+
+		shares = append(shares, KeyShare{
+			ID:         id,
+			PrivateKey: shareSecretKey.Serialize(),
+			PublicKey:  shareSecretKey.GetPublicKey().Serialize(),
+		})
+	}
+
+	return shares, nil
+}
+
+// RecoverPublicKey computes the public key of the split key from the public keys of threshold shares
+func RecoverPublicKey(ids []uint32, publicKeys [][]byte) ([]byte, error) {
+	if len(ids) != len(publicKeys) || len(ids) == 0 {
+		return nil, crypto.ErrBatchLengthMismatch
+	}
+
+	blsIDs, err := createIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	blsPublicKeys := make([]bls.PublicKey, len(publicKeys))
+	for i, publicKey := range publicKeys {
+		if len(publicKey) == 0 {
+			return nil, crypto.ErrInvalidPublicKey
+		}
+		err = blsPublicKeys[i].Deserialize(publicKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", crypto.ErrInvalidPublicKey, err.Error())
+		}
+		if !singlesig.IsPubKeyPointValid(&mcl.PointG2{G2: bls.CastFromPublicKey(&blsPublicKeys[i])}) {
+			return nil, crypto.ErrInvalidPublicKey
+		}
+	}
+
+	recoveredPublicKey := &bls.PublicKey{}
+	err = recoveredPublicKey.Recover(blsPublicKeys, blsIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return recoveredPublicKey.Serialize(), nil
+}
+
+// RecoverSignature combines the signatures of threshold shares over the same message in the signature of the split key.
+// The signatures of the shares should be verified beforehand, a single invalid one makes the result invalid
+func RecoverSignature(ids []uint32, signatures [][]byte) ([]byte, error) {
+	if len(ids) != len(signatures) || len(ids) == 0 {
+		return nil, crypto.ErrBatchLengthMismatch
+	}
+
+	blsIDs, err := createIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	blsSignatures := make([]bls.Sign, len(signatures))
+	for i, signature := range signatures {
+		if len(signature) == 0 {
+			return nil, crypto.ErrNilSignature
+		}
+		err = blsSignatures[i].Deserialize(signature)
+		if err != nil {
+			return nil, err
+		}
+		if !singlesig.IsSigValidPoint(&blsSignatures[i]) {
+			return nil, crypto.ErrBLSInvalidSignature
+		}
+	}
+
+	recoveredSignature := &bls.Sign{}
+	err = recoveredSignature.Recover(blsSignatures, blsIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return recoveredSignature.Serialize(), nil
+}
+
+func checkThreshold(threshold uint32, numShares uint32) error {
+	if threshold < 2 || threshold > numShares {
+		return fmt.Errorf("%w, threshold %d for %d shares", crypto.ErrInvalidThreshold, threshold, numShares)
+	}
+
+	return nil
+}
+
+func createIDs(ids []uint32) ([]bls.ID, error) {
+	blsIDs := make([]bls.ID, len(ids))
+	usedIDs := make(map[uint32]struct{}, len(ids))
+	for i, id := range ids {
+		_, isUsed := usedIDs[id]
+		if isUsed {
+			return nil, fmt.Errorf("%w, %d is used more than once", crypto.ErrInvalidShareID, id)
+		}
+		usedIDs[id] = struct{}{}
+
+		blsID, err := createID(id)
+		if err != nil {
+			return nil, err
+		}
+		blsIDs[i] = *blsID
+	}
+
+	return blsIDs, nil
+}
+
+func createID(id uint32) (*bls.ID, error) {
+	if id == 0 {
+		// the polynomial evaluated in 0 is the secret key itself
+		return nil, fmt.Errorf("%w, %d", crypto.ErrInvalidShareID, id)
+	}
+
+	blsID := &bls.ID{}
+	err := blsID.SetDecString(strconv.FormatUint(uint64(id), 10))
+	if err != nil {
+		return nil, err
+	}
+
+	return blsID, nil
+}
@@ -0,0 +1,134 @@
+package thresholdsig
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var message = []byte("message")
+
+func createKey(t *testing.T) (crypto.KeyGenerator, []byte, []byte) {
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privateKey, publicKey := keyGen.GeneratePair()
+	privateKeyBytes, err := privateKey.ToByteArray()
+	require.Nil(t, err)
+	publicKeyBytes, err := publicKey.ToByteArray()
+	require.Nil(t, err)
+
+	return keyGen, privateKeyBytes, publicKeyBytes
+}
+
+func signWithShares(t *testing.T, keyGen crypto.KeyGenerator, shares []KeyShare) ([]uint32, [][]byte) {
+	signer := &singlesig.BlsSingleSigner{}
+	ids := make([]uint32, 0, len(shares))
+	signatures := make([][]byte, 0, len(shares))
+	for _, share := range shares {
+		sharePrivateKey, err := keyGen.PrivateKeyFromByteArray(share.PrivateKey)
+		require.Nil(t, err)
+		signature, err := signer.Sign(sharePrivateKey, message)
+		require.Nil(t, err)
+
+		ids = append(ids, share.ID)
+		signatures = append(signatures, signature)
+	}
+
+	return ids, signatures
+}
+
+func TestSplitPrivateKey_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, privateKey, _ := createKey(t)
+
+	shares, err := SplitPrivateKey(privateKey, 1, 3)
+	assert.Nil(t, shares)
+	assert.True(t, errors.Is(err, crypto.ErrInvalidThreshold))
+
+	shares, err = SplitPrivateKey(privateKey, 4, 3)
+	assert.Nil(t, shares)
+	assert.True(t, errors.Is(err, crypto.ErrInvalidThreshold))
+
+	shares, err = SplitPrivateKey(nil, 2, 3)
+	assert.Nil(t, shares)
+	assert.Equal(t, crypto.ErrInvalidPrivateKey, err)
+
+	shares, err = SplitPrivateKey(make([]byte, len(privateKey)), 2, 3)
+	assert.Nil(t, shares)
+	assert.Equal(t, crypto.ErrInvalidPrivateKey, err)
+}
+
+func TestSplitPrivateKey_AnyThresholdSharesShouldRecoverTheKey(t *testing.T) {
+	t.Parallel()
+
+	keyGen, privateKey, publicKey := createKey(t)
+	shares, err := SplitPrivateKey(privateKey, 3, 5)
+	require.Nil(t, err)
+	require.Equal(t, 5, len(shares))
+
+	privateKeyObj, _ := keyGen.PrivateKeyFromByteArray(privateKey)
+	expectedSignature, _ := (&singlesig.BlsSingleSigner{}).Sign(privateKeyObj, message)
+
+	subsets := [][]KeyShare{
+		shares[:3],
+		shares[2:],
+		{shares[4], shares[0], shares[2]},
+		shares,
+	}
+	for _, subset := range subsets {
+		ids, signatures := signWithShares(t, keyGen, subset)
+		signature, errRecover := RecoverSignature(ids, signatures)
+		assert.Nil(t, errRecover)
+		assert.Equal(t, expectedSignature, signature)
+
+		publicKeys := make([][]byte, 0, len(subset))
+		for _, share := range subset {
+			publicKeys = append(publicKeys, share.PublicKey)
+		}
+		recoveredPublicKey, errRecover := RecoverPublicKey(ids, publicKeys)
+		assert.Nil(t, errRecover)
+		assert.Equal(t, publicKey, recoveredPublicKey)
+	}
+}
+
+func TestRecoverSignature_FewerThanThresholdSharesShouldNotRecoverTheSignature(t *testing.T) {
+	t.Parallel()
+
+	keyGen, privateKey, _ := createKey(t)
+	shares, err := SplitPrivateKey(privateKey, 3, 5)
+	require.Nil(t, err)
+
+	privateKeyObj, _ := keyGen.PrivateKeyFromByteArray(privateKey)
+	ids, signatures := signWithShares(t, keyGen, shares[:2])
+	signature, err := RecoverSignature(ids, signatures)
+	require.Nil(t, err)
+
+	err = (&singlesig.BlsSingleSigner{}).Verify(privateKeyObj.GeneratePublic(), message, signature)
+	assert.Equal(t, crypto.ErrSigNotValid, err)
+}
+
+func TestRecoverSignature_InvalidIDsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyGen, privateKey, _ := createKey(t)
+	shares, _ := SplitPrivateKey(privateKey, 2, 3)
+	ids, signatures := signWithShares(t, keyGen, shares[:2])
+
+	signature, err := RecoverSignature([]uint32{ids[0], ids[0]}, signatures)
+	assert.Nil(t, signature)
+	assert.True(t, errors.Is(err, crypto.ErrInvalidShareID))
+
+	signature, err = RecoverSignature([]uint32{0, ids[1]}, signatures)
+	assert.Nil(t, signature)
+	assert.True(t, errors.Is(err, crypto.ErrInvalidShareID))
+
+	signature, err = RecoverSignature(ids[:1], signatures)
+	assert.Nil(t, signature)
+	assert.Equal(t, crypto.ErrBatchLengthMismatch, err)
+}
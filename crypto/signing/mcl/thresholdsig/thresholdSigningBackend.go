@@ -0,0 +1,232 @@
+package thresholdsig
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+var log = logger.GetOrCreate("crypto/signing/mcl/thresholdsig")
+
+var _ crypto.SigningBackend = (*thresholdSigningBackend)(nil)
+
+// ShareSigner is a share of a threshold key, held by a signing backend
+type ShareSigner struct {
+	ID        uint32
+	PublicKey []byte
+	Backend   crypto.SigningBackend
+}
+
+// ThresholdKey is a BLS key split in shares, Threshold of them being needed to create a signature of the key
+type ThresholdKey struct {
+	PublicKey []byte
+	Threshold uint32
+	Shares    []ShareSigner
+}
+
+// ArgsThresholdSigningBackend is the DTO used to create a new instance of thresholdSigningBackend
+type ArgsThresholdSigningBackend struct {
+	KeyGenerator crypto.KeyGenerator
+	SingleSigner crypto.SingleSigner
+	Keys         []ThresholdKey
+}
+
+type shareSigner struct {
+	id             uint32
+	publicKey      crypto.PublicKey
+	publicKeyBytes []byte
+	backend        crypto.SigningBackend
+}
+
+type thresholdKey struct {
+	threshold uint32
+	shares    []*shareSigner
+}
+
+type signatureShare struct {
+	id        uint32
+	signature []byte
+	err       error
+}
+
+// thresholdSigningBackend is a signing backend for the keys split in shares held by separate signers. The signature
+// shares are requested from all the signers at once and the first threshold valid ones are combined in the signature
+// of the key, so a single compromised signer can neither sign on behalf of the key nor stop it from signing
+type thresholdSigningBackend struct {
+	singleSigner crypto.SingleSigner
+	keys         map[string]*thresholdKey
+	publicKeys   [][]byte
+}
+
+// NewThresholdSigningBackend creates a signing backend for the provided threshold keys
+func NewThresholdSigningBackend(args ArgsThresholdSigningBackend) (*thresholdSigningBackend, error) {
+	if check.IfNil(args.KeyGenerator) {
+		return nil, crypto.ErrNilKeyGenerator
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, crypto.ErrNilSingleSigner
+	}
+
+	tsb := &thresholdSigningBackend{
+		singleSigner: args.SingleSigner,
+		keys:         make(map[string]*thresholdKey, len(args.Keys)),
+		publicKeys:   make([][]byte, 0, len(args.Keys)),
+	}
+	for _, key := range args.Keys {
+		_, found := tsb.keys[string(key.PublicKey)]
+		if found {
+			return nil, fmt.Errorf("%w, duplicated public key %s", crypto.ErrInvalidThresholdKey, hex.EncodeToString(key.PublicKey))
+		}
+
+		tk, err := createThresholdKey(key, args.KeyGenerator)
+		if err != nil {
+			return nil, fmt.Errorf("%w for public key %s", err, hex.EncodeToString(key.PublicKey))
+		}
+
+		tsb.keys[string(key.PublicKey)] = tk
+		tsb.publicKeys = append(tsb.publicKeys, key.PublicKey)
+	}
+
+	return tsb, nil
+}
+
+func createThresholdKey(key ThresholdKey, keyGenerator crypto.KeyGenerator) (*thresholdKey, error) {
+	err := checkThreshold(key.Threshold, uint32(len(key.Shares)))
+	if err != nil {
+		return nil, err
+	}
+
+	tk := &thresholdKey{
+		threshold: key.Threshold,
+		shares:    make([]*shareSigner, 0, len(key.Shares)),
+	}
+	ids := make([]uint32, 0, len(key.Shares))
+	sharePublicKeys := make([][]byte, 0, len(key.Shares))
+	for _, share := range key.Shares {
+		if check.IfNil(share.Backend) {
+			return nil, crypto.ErrNilSigningBackend
+		}
+
+		publicKey, errPublicKey := keyGenerator.PublicKeyFromByteArray(share.PublicKey)
+		if errPublicKey != nil {
+			return nil, fmt.Errorf("%w for the public key of share %d", errPublicKey, share.ID)
+		}
+
+		tk.shares = append(tk.shares, &shareSigner{
+			id:             share.ID,
+			publicKey:      publicKey,
+			publicKeyBytes: share.PublicKey,
+			backend:        share.Backend,
+		})
+		ids = append(ids, share.ID)
+		sharePublicKeys = append(sharePublicKeys, share.PublicKey)
+	}
+
+	_, err = createIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkSharesMatchPublicKey(key.PublicKey, ids, sharePublicKeys, int(key.Threshold))
+	if err != nil {
+		return nil, err
+	}
+
+	return tk, nil
+}
+
+// checkSharesMatchPublicKey verifies that all the shares lie on the same polynomial whose free term is the public key:
+// the shares after the first threshold-1 ones are checked one by one by recovering the public key from them together
+// with the first threshold-1 shares
+func checkSharesMatchPublicKey(publicKey []byte, ids []uint32, sharePublicKeys [][]byte, threshold int) error {
+	numBaseShares := threshold - 1
+	for i := numBaseShares; i < len(ids); i++ {
+		subsetIDs := append(append(make([]uint32, 0, threshold), ids[:numBaseShares]...), ids[i])
+		subsetPublicKeys := append(append(make([][]byte, 0, threshold), sharePublicKeys[:numBaseShares]...), sharePublicKeys[i])
+
+		recoveredPublicKey, err := RecoverPublicKey(subsetIDs, subsetPublicKeys)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(recoveredPublicKey, publicKey) {
+			return fmt.Errorf("%w, share %d does not match the public key", crypto.ErrInvalidThresholdKey, ids[i])
+		}
+	}
+
+	return nil
+}
+
+// Sign gathers the signature shares of the key identified by the provided public key over the message and combines
+// them in the signature of the key. The message type and the slot are forwarded to the share signers, each of them
+// refusing to sign a different message of the same type for a slot it already signed
+func (tsb *thresholdSigningBackend) Sign(
+	publicKey []byte,
+	message []byte,
+	messageType string,
+	slot *crypto.SigningSlot,
+) ([]byte, error) {
+	key, found := tsb.keys[string(publicKey)]
+	if !found {
+		return nil, fmt.Errorf("%w for public key %s", crypto.ErrUnknownSigningKey, hex.EncodeToString(publicKey))
+	}
+
+	// buffered so that the requests still in progress once the threshold is reached do not block
+	chanSignatureShares := make(chan *signatureShare, len(key.shares))
+	for _, share := range key.shares {
+		go tsb.requestSignatureShare(share, message, messageType, slot, chanSignatureShares)
+	}
+
+	ids := make([]uint32, 0, key.threshold)
+	signatures := make([][]byte, 0, key.threshold)
+	for i := 0; i < len(key.shares); i++ {
+		sigShare := <-chanSignatureShares
+		if sigShare.err != nil {
+			log.Debug("signature share not available",
+				"public key", publicKey,
+				"share", sigShare.id,
+				"error", sigShare.err.Error())
+			continue
+		}
+
+		ids = append(ids, sigShare.id)
+		signatures = append(signatures, sigShare.signature)
+		if uint32(len(ids)) == key.threshold {
+			return RecoverSignature(ids, signatures)
+		}
+	}
+
+	return nil, fmt.Errorf("%w for public key %s, got %d out of %d",
+		crypto.ErrNotEnoughSignatureShares, hex.EncodeToString(publicKey), len(ids), key.threshold)
+}
+
+func (tsb *thresholdSigningBackend) requestSignatureShare(
+	share *shareSigner,
+	message []byte,
+	messageType string,
+	slot *crypto.SigningSlot,
+	chanSignatureShares chan<- *signatureShare,
+) {
+	sigShare := &signatureShare{
+		id: share.id,
+	}
+	sigShare.signature, sigShare.err = share.backend.Sign(share.publicKeyBytes, message, messageType, slot)
+	if sigShare.err == nil {
+		sigShare.err = tsb.singleSigner.Verify(share.publicKey, message, sigShare.signature)
+	}
+
+	chanSignatureShares <- sigShare
+}
+
+// PublicKeys returns the public keys of the threshold keys
+func (tsb *thresholdSigningBackend) PublicKeys() ([][]byte, error) {
+	return tsb.publicKeys, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tsb *thresholdSigningBackend) IsInterfaceNil() bool {
+	return tsb == nil
+}
@@ -0,0 +1,229 @@
+package thresholdsig
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/remoteSigner"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createThresholdKeyArgs(t *testing.T, threshold uint32, numShares uint32) (ArgsThresholdSigningBackend, crypto.PrivateKey) {
+	keyGen, privateKey, publicKey := createKey(t)
+	shares, err := SplitPrivateKey(privateKey, threshold, numShares)
+	require.Nil(t, err)
+
+	key := ThresholdKey{
+		PublicKey: publicKey,
+		Threshold: threshold,
+		Shares:    make([]ShareSigner, 0, len(shares)),
+	}
+	for _, share := range shares {
+		backend, errBackend := remoteSigner.NewLocalSigningBackend(remoteSigner.ArgsLocalSigningBackend{
+			KeyGenerator: keyGen,
+			SingleSigner: &singlesig.BlsSingleSigner{},
+			PrivateKeys:  [][]byte{share.PrivateKey},
+		})
+		require.Nil(t, errBackend)
+
+		key.Shares = append(key.Shares, ShareSigner{
+			ID:        share.ID,
+			PublicKey: share.PublicKey,
+			Backend:   backend,
+		})
+	}
+
+	privateKeyObj, _ := keyGen.PrivateKeyFromByteArray(privateKey)
+	args := ArgsThresholdSigningBackend{
+		KeyGenerator: keyGen,
+		SingleSigner: &singlesig.BlsSingleSigner{},
+		Keys:         []ThresholdKey{key},
+	}
+
+	return args, privateKeyObj
+}
+
+func TestNewThresholdSigningBackend_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createThresholdKeyArgs(t, 2, 3)
+	args.KeyGenerator = nil
+	tsb, err := NewThresholdSigningBackend(args)
+	assert.True(t, check.IfNil(tsb))
+	assert.Equal(t, crypto.ErrNilKeyGenerator, err)
+
+	args, _ = createThresholdKeyArgs(t, 2, 3)
+	args.SingleSigner = nil
+	tsb, err = NewThresholdSigningBackend(args)
+	assert.True(t, check.IfNil(tsb))
+	assert.Equal(t, crypto.ErrNilSingleSigner, err)
+
+	args, _ = createThresholdKeyArgs(t, 2, 3)
+	args.Keys[0].Threshold = 4
+	tsb, err = NewThresholdSigningBackend(args)
+	assert.True(t, check.IfNil(tsb))
+	assert.True(t, errors.Is(err, crypto.ErrInvalidThreshold))
+
+	args, _ = createThresholdKeyArgs(t, 2, 3)
+	args.Keys[0].Shares[1].Backend = nil
+	tsb, err = NewThresholdSigningBackend(args)
+	assert.True(t, check.IfNil(tsb))
+	assert.True(t, errors.Is(err, crypto.ErrNilSigningBackend))
+
+	args, _ = createThresholdKeyArgs(t, 2, 3)
+	args.Keys[0].Shares[1].ID = args.Keys[0].Shares[0].ID
+	tsb, err = NewThresholdSigningBackend(args)
+	assert.True(t, check.IfNil(tsb))
+	assert.True(t, errors.Is(err, crypto.ErrInvalidShareID))
+
+	args, _ = createThresholdKeyArgs(t, 2, 3)
+	args.Keys = append(args.Keys, args.Keys[0])
+	tsb, err = NewThresholdSigningBackend(args)
+	assert.True(t, check.IfNil(tsb))
+	assert.True(t, errors.Is(err, crypto.ErrInvalidThresholdKey))
+}
+
+func TestNewThresholdSigningBackend_SharesNotMatchingThePublicKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createThresholdKeyArgs(t, 2, 3)
+	otherArgs, _ := createThresholdKeyArgs(t, 2, 3)
+	args.Keys[0].Shares[2] = otherArgs.Keys[0].Shares[2]
+
+	tsb, err := NewThresholdSigningBackend(args)
+	assert.True(t, check.IfNil(tsb))
+	assert.True(t, errors.Is(err, crypto.ErrInvalidThresholdKey))
+
+	args, _ = createThresholdKeyArgs(t, 2, 3)
+	args.Keys[0].PublicKey = otherArgs.Keys[0].PublicKey
+
+	tsb, err = NewThresholdSigningBackend(args)
+	assert.True(t, check.IfNil(tsb))
+	assert.True(t, errors.Is(err, crypto.ErrInvalidThresholdKey))
+}
+
+func TestThresholdSigningBackend_SignShouldCombineTheSignatureShares(t *testing.T) {
+	t.Parallel()
+
+	args, privateKey := createThresholdKeyArgs(t, 3, 5)
+	tsb, err := NewThresholdSigningBackend(args)
+	require.Nil(t, err)
+
+	publicKeys, err := tsb.PublicKeys()
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{args.Keys[0].PublicKey}, publicKeys)
+
+	signer := &singlesig.BlsSingleSigner{}
+	expectedSignature, _ := signer.Sign(privateKey, message)
+	signature, err := tsb.Sign(args.Keys[0].PublicKey, message, crypto.SingleSignatureMessageType, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedSignature, signature)
+}
+
+func TestThresholdSigningBackend_SignWithFaultySignersShouldUseTheOtherShares(t *testing.T) {
+	t.Parallel()
+
+	args, privateKey := createThresholdKeyArgs(t, 3, 5)
+	args.Keys[0].Shares[0].Backend = &signingBackendStub{
+		SignCalled: func(publicKey []byte, message []byte, _ string, _ *crypto.SigningSlot) ([]byte, error) {
+			return nil, errors.New("signer unreachable")
+		},
+	}
+	// a compromised signer answering with the signature share of another signer
+	otherShareBackend := args.Keys[0].Shares[2].Backend
+	otherSharePublicKey := args.Keys[0].Shares[2].PublicKey
+	args.Keys[0].Shares[1].Backend = &signingBackendStub{
+		SignCalled: func(_ []byte, message []byte, _ string, _ *crypto.SigningSlot) ([]byte, error) {
+			return otherShareBackend.Sign(otherSharePublicKey, message, crypto.SingleSignatureMessageType, nil)
+		},
+	}
+	tsb, err := NewThresholdSigningBackend(args)
+	require.Nil(t, err)
+
+	expectedSignature, _ := (&singlesig.BlsSingleSigner{}).Sign(privateKey, message)
+	signature, err := tsb.Sign(args.Keys[0].PublicKey, message, crypto.SingleSignatureMessageType, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedSignature, signature)
+}
+
+func TestThresholdSigningBackend_SignWithFewerThanThresholdSignersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createThresholdKeyArgs(t, 3, 4)
+	numRequests := uint32(0)
+	failingBackend := &signingBackendStub{
+		SignCalled: func(publicKey []byte, message []byte, _ string, _ *crypto.SigningSlot) ([]byte, error) {
+			atomic.AddUint32(&numRequests, 1)
+			return nil, errors.New("signer unreachable")
+		},
+	}
+	args.Keys[0].Shares[0].Backend = failingBackend
+	args.Keys[0].Shares[3].Backend = failingBackend
+	tsb, err := NewThresholdSigningBackend(args)
+	require.Nil(t, err)
+
+	signature, err := tsb.Sign(args.Keys[0].PublicKey, message, crypto.SingleSignatureMessageType, nil)
+	assert.Nil(t, signature)
+	assert.True(t, errors.Is(err, crypto.ErrNotEnoughSignatureShares))
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numRequests))
+}
+
+func TestThresholdSigningBackend_DifferentHeaderInTheSameRoundShouldBeRefusedByTheSigners(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "signingHistory")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args, _ := createThresholdKeyArgs(t, 2, 3)
+	for i := range args.Keys[0].Shares {
+		share := &args.Keys[0].Shares[i]
+		share.Backend, err = remoteSigner.NewDoubleSignProtectedBackend(remoteSigner.ArgsDoubleSignProtectedBackend{
+			Backend:                share.Backend,
+			SigningHistoryFilePath: filepath.Join(dir, fmt.Sprintf("signingHistory%d.json", share.ID)),
+		})
+		require.Nil(t, err)
+	}
+	tsb, err := NewThresholdSigningBackend(args)
+	require.Nil(t, err)
+
+	slot := &crypto.SigningSlot{
+		Epoch: 4,
+		Round: 120,
+	}
+	signature, err := tsb.Sign(args.Keys[0].PublicKey, []byte("header A"), crypto.SignatureShareMessageType, slot)
+	assert.Nil(t, err)
+	assert.NotNil(t, signature)
+
+	signature, err = tsb.Sign(args.Keys[0].PublicKey, []byte("header B"), crypto.SignatureShareMessageType, slot)
+	assert.Nil(t, signature)
+	assert.True(t, errors.Is(err, crypto.ErrNotEnoughSignatureShares))
+
+	slot.Round++
+	signature, err = tsb.Sign(args.Keys[0].PublicKey, []byte("header B"), crypto.SignatureShareMessageType, slot)
+	assert.Nil(t, err)
+	assert.NotNil(t, signature)
+}
+
+func TestThresholdSigningBackend_SignUnknownKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createThresholdKeyArgs(t, 2, 3)
+	tsb, err := NewThresholdSigningBackend(args)
+	require.Nil(t, err)
+
+	signature, err := tsb.Sign([]byte("unknown public key"), message, crypto.SingleSignatureMessageType, nil)
+	assert.Nil(t, signature)
+	assert.True(t, errors.Is(err, crypto.ErrUnknownSigningKey))
+}
@@ -0,0 +1,15 @@
+package crypto
+
+// SignatureShareMessageType is the message type of the signature shares over the consensus block headers. A key must
+// never sign two different messages of this type in the same epoch and round, so its requests must carry the slot
+const SignatureShareMessageType = "signatureShare"
+
+// SingleSignatureMessageType is the message type of the single signatures, as the ones of the consensus messages, of
+// the block headers proposed by the leader or of the heartbeat messages
+const SingleSignatureMessageType = "singleSignature"
+
+// SigningSlot identifies the consensus slot a message is signed for
+type SigningSlot struct {
+	Epoch uint32
+	Round uint64
+}
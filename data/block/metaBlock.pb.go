@@ -401,6 +401,7 @@ type Economics struct {
 	NodePrice                        *math_big.Int `protobuf:"bytes,6,opt,name=NodePrice,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"NodePrice,omitempty"`
 	PrevEpochStartRound              uint64        `protobuf:"varint,7,opt,name=PrevEpochStartRound,proto3" json:"PrevEpochStartRound,omitempty"`
 	PrevEpochStartHash               []byte        `protobuf:"bytes,8,opt,name=PrevEpochStartHash,proto3" json:"PrevEpochStartHash,omitempty"`
+	RewardsRootHash                  []byte        `protobuf:"bytes,9,opt,name=RewardsRootHash,proto3" json:"RewardsRootHash,omitempty"`
 }
 
 func (m *Economics) Reset()      { *m = Economics{} }
@@ -487,6 +488,13 @@ func (m *Economics) GetPrevEpochStartHash() []byte {
 	return nil
 }
 
+func (m *Economics) GetRewardsRootHash() []byte {
+	if m != nil {
+		return m.RewardsRootHash
+	}
+	return nil
+}
+
 // EpochStart holds the block information for end-of-epoch
 type EpochStart struct {
 	LastFinalizedHeaders []EpochStartShardData `protobuf:"bytes,1,rep,name=LastFinalizedHeaders,proto3" json:"LastFinalizedHeaders"`
@@ -780,86 +788,85 @@ func init() {
 func init() { proto.RegisterFile("metaBlock.proto", fileDescriptor_87b91ab531130b2b) }
 
 var fileDescriptor_87b91ab531130b2b = []byte{
-	// 1257 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6e, 0xdb, 0x46,
-	0x13, 0x17, 0x6d, 0xcb, 0xb6, 0x46, 0x96, 0x4d, 0x6f, 0x1c, 0x87, 0x9f, 0xf1, 0x81, 0x31, 0x84,
-	0x1e, 0xdc, 0x02, 0xb1, 0x5b, 0x37, 0x68, 0x0f, 0x3d, 0x14, 0xb6, 0x65, 0x23, 0x6a, 0x12, 0x43,
-	0xa0, 0x5c, 0x1f, 0x7a, 0x5b, 0x91, 0x1b, 0x6a, 0x61, 0x6a, 0x57, 0x25, 0x97, 0x76, 0x5d, 0xa0,
-	0x40, 0x1f, 0xa1, 0xbd, 0xf5, 0x01, 0x7a, 0x08, 0xda, 0x17, 0xc9, 0x31, 0xc7, 0x9c, 0x9a, 0x46,
-	0xb9, 0xf4, 0x98, 0x02, 0x7d, 0x80, 0x62, 0x77, 0x49, 0x91, 0xa2, 0xe8, 0x26, 0x07, 0xe5, 0x64,
-	0xcf, 0x6f, 0x76, 0x66, 0xb4, 0xf3, 0x6f, 0x7f, 0x84, 0xb5, 0x01, 0x11, 0xf8, 0x30, 0xe0, 0xee,
-	0xc5, 0xee, 0x30, 0xe4, 0x82, 0xa3, 0xaa, 0xfa, 0xb3, 0x75, 0xcf, 0xa7, 0xa2, 0x1f, 0xf7, 0x76,
-	0x5d, 0x3e, 0xd8, 0xf3, 0xb9, 0xcf, 0xf7, 0x14, 0xdc, 0x8b, 0x9f, 0x28, 0x49, 0x09, 0xea, 0x3f,
-	0x6d, 0xb5, 0x55, 0xef, 0x65, 0x2e, 0x9a, 0xff, 0x18, 0xb0, 0xdc, 0x21, 0x24, 0x6c, 0x61, 0x81,
-	0x91, 0x05, 0x4b, 0x07, 0x9e, 0x17, 0x92, 0x28, 0xb2, 0x8c, 0x6d, 0x63, 0x67, 0xc5, 0x49, 0x45,
-	0xf4, 0x7f, 0xa8, 0x75, 0xe2, 0x5e, 0x40, 0xdd, 0x87, 0xe4, 0xda, 0x9a, 0x53, 0xba, 0x0c, 0x40,
-	0x1f, 0xc2, 0xe2, 0x81, 0x2b, 0x28, 0x67, 0xd6, 0xfc, 0xb6, 0xb1, 0xb3, 0xba, 0xbf, 0xae, 0x9d,
-	0xef, 0x4a, 0xc7, 0x5a, 0xe1, 0x24, 0x07, 0xa4, 0xa3, 0x33, 0x3a, 0x20, 0x5d, 0x81, 0x07, 0x43,
-	0x6b, 0x61, 0xdb, 0xd8, 0x59, 0x70, 0x32, 0x00, 0xf9, 0x50, 0x3f, 0xc7, 0x41, 0x4c, 0x8e, 0xfa,
-	0x98, 0xf9, 0xc4, 0xaa, 0xca, 0x40, 0x87, 0xc7, 0xbf, 0xbd, 0xbc, 0x7b, 0x30, 0xc0, 0xa2, 0xbf,
-	0xd7, 0xa3, 0xfe, 0x6e, 0x9b, 0x89, 0x2f, 0x72, 0xf7, 0x3d, 0x0e, 0x42, 0xce, 0xbc, 0x53, 0x22,
-	0xae, 0x78, 0x78, 0xb1, 0x47, 0x94, 0x74, 0xcf, 0xe7, 0x7b, 0x1e, 0x16, 0x78, 0xf7, 0x90, 0xfa,
-	0x6d, 0x26, 0x8e, 0x70, 0x24, 0x48, 0xe8, 0xe4, 0x3d, 0x37, 0x7f, 0xaf, 0x42, 0xad, 0xdb, 0xc7,
-	0xa1, 0xa7, 0xee, 0x6d, 0x03, 0x3c, 0x20, 0xd8, 0x23, 0xe1, 0x03, 0x1c, 0xf5, 0x93, 0xeb, 0xe5,
-	0x10, 0xe4, 0xc0, 0x6d, 0x75, 0xf8, 0x31, 0x65, 0x54, 0xe5, 0x5f, 0xeb, 0x22, 0x6b, 0x7e, 0x7b,
-	0x7e, 0xa7, 0xbe, 0xbf, 0x99, 0x5c, 0xb7, 0xa0, 0x3e, 0x5c, 0x78, 0xf6, 0xc7, 0xdd, 0x8a, 0x53,
-	0x6e, 0x8a, 0x9a, 0xb0, 0xd2, 0x09, 0xc9, 0xa5, 0x83, 0x99, 0xd7, 0x25, 0xc4, 0x53, 0xb9, 0x58,
-	0x71, 0x26, 0x30, 0xf4, 0x01, 0x34, 0x3a, 0x71, 0xef, 0x21, 0xb9, 0x8e, 0x0e, 0xa9, 0x18, 0xe0,
-	0xa1, 0x4e, 0x88, 0x33, 0x09, 0xca, 0x94, 0x76, 0xa9, 0xcf, 0xb0, 0x88, 0x43, 0x62, 0x2d, 0xea,
-	0xda, 0x8c, 0x01, 0xb4, 0x01, 0x55, 0x87, 0xc7, 0xcc, 0xb3, 0x96, 0x55, 0xb2, 0xb5, 0x80, 0xb6,
-	0x60, 0x59, 0x46, 0x52, 0xf7, 0xad, 0x29, 0x93, 0xb1, 0x2c, 0x2d, 0x4e, 0x39, 0x73, 0x89, 0x05,
-	0xda, 0x42, 0x09, 0x88, 0xc3, 0xda, 0x81, 0xeb, 0xc6, 0x83, 0x38, 0xc0, 0x82, 0x78, 0x27, 0x84,
-	0x44, 0xd6, 0xca, 0x2c, 0xcb, 0x53, 0xf4, 0x8e, 0x2e, 0xa0, 0xd1, 0x22, 0x97, 0x24, 0xe0, 0x43,
-	0x12, 0xaa, 0x70, 0xab, 0xb3, 0x0c, 0x37, 0xe9, 0x1b, 0xed, 0xc3, 0xc6, 0x69, 0x3c, 0xe8, 0x10,
-	0xe6, 0x51, 0xe6, 0x8f, 0x6b, 0x15, 0x59, 0xf5, 0x6d, 0x63, 0xa7, 0xe1, 0x94, 0xea, 0xd0, 0x7d,
-	0xb8, 0xfd, 0x08, 0x47, 0xa2, 0xcd, 0xdc, 0x20, 0xf6, 0x88, 0xf7, 0x98, 0x08, 0xac, 0xf3, 0xd6,
-	0x50, 0x79, 0x2b, 0x57, 0xca, 0x19, 0x53, 0x0d, 0xd1, 0x6e, 0xa9, 0x19, 0x6b, 0x38, 0xa9, 0x28,
-	0x35, 0x67, 0xdf, 0x1d, 0xf1, 0x98, 0x09, 0x6b, 0x49, 0x6b, 0x12, 0xb1, 0xf9, 0xf7, 0x1c, 0xdc,
-	0x3a, 0x1e, 0x72, 0xb7, 0xdf, 0x15, 0x38, 0x14, 0x59, 0xdf, 0xde, 0xec, 0x6b, 0x03, 0xaa, 0xca,
-	0x40, 0x15, 0xb7, 0xe1, 0x68, 0x21, 0xeb, 0x85, 0xa5, 0x7c, 0x2f, 0x8c, 0xeb, 0xbd, 0x9c, 0xaf,
-	0xf7, 0xdb, 0x66, 0x62, 0x0b, 0x96, 0x1d, 0xce, 0x85, 0xd2, 0xce, 0xeb, 0x0e, 0x4a, 0x65, 0x99,
-	0x99, 0x13, 0x1a, 0x46, 0x22, 0xcd, 0x59, 0xba, 0xb6, 0x92, 0x26, 0x2f, 0x57, 0xa6, 0xf9, 0x3c,
-	0xa1, 0x8c, 0x46, 0x7d, 0x9d, 0x32, 0x6d, 0xa5, 0xbb, 0xbe, 0x5c, 0x89, 0xce, 0xe1, 0x4e, 0xb1,
-	0x34, 0xe9, 0x74, 0x2e, 0xbe, 0xc3, 0x74, 0xde, 0x64, 0xdc, 0x7c, 0xba, 0x08, 0xb5, 0x63, 0x97,
-	0x33, 0x3e, 0xa0, 0x6e, 0x24, 0x17, 0xd3, 0x19, 0x17, 0x38, 0xe8, 0xc6, 0xc3, 0x61, 0x70, 0xad,
-	0xb7, 0xe3, 0xcc, 0x16, 0x53, 0xce, 0x33, 0x8a, 0x60, 0x5d, 0x89, 0x67, 0xbc, 0x45, 0x23, 0x11,
-	0xd2, 0x5e, 0x2c, 0x88, 0xce, 0xfe, 0xac, 0xc2, 0x4d, 0xfb, 0x47, 0xdf, 0x82, 0xa9, 0xc0, 0x53,
-	0x72, 0x15, 0x5c, 0x3f, 0xa6, 0x4c, 0x10, 0x4f, 0xd7, 0x74, 0x56, 0x31, 0xa7, 0xdc, 0xcb, 0x75,
-	0xe2, 0x90, 0x2b, 0x1c, 0x7a, 0x51, 0x87, 0x84, 0xb9, 0xe6, 0x98, 0xd9, 0x3a, 0x29, 0x78, 0x47,
-	0x3f, 0x1b, 0xb0, 0x9d, 0x60, 0x27, 0x3c, 0xec, 0xc8, 0x96, 0x70, 0x79, 0xd0, 0x8d, 0x23, 0x81,
-	0x29, 0xc3, 0x3d, 0x1a, 0x50, 0x71, 0x3d, 0xdb, 0x07, 0xe7, 0xad, 0xe1, 0x90, 0x0b, 0xb5, 0x53,
-	0xee, 0x91, 0x4e, 0x48, 0xdd, 0x64, 0x73, 0xcf, 0x2a, 0x76, 0xe6, 0x17, 0x7d, 0x0c, 0xb7, 0xe4,
-	0x6a, 0xcf, 0xf6, 0x47, 0x7e, 0x05, 0x94, 0xa9, 0xd0, 0x2e, 0xa0, 0x49, 0x58, 0x0d, 0xf9, 0xb2,
-	0x9a, 0xc2, 0x12, 0x4d, 0xf3, 0x17, 0x03, 0x20, 0x83, 0xd0, 0x19, 0x6c, 0x24, 0xa3, 0x8a, 0x03,
-	0xfa, 0x3d, 0xf1, 0xd2, 0x71, 0x34, 0xd4, 0x38, 0x6e, 0x25, 0xe3, 0x58, 0xb2, 0xcf, 0x92, 0x91,
-	0x2c, 0xb5, 0x46, 0xf7, 0x73, 0xe3, 0xa8, 0x06, 0xa2, 0xbe, 0x6f, 0xa6, 0xae, 0x52, 0x3c, 0x71,
-	0x90, 0x1d, 0x6c, 0xbe, 0xac, 0x41, 0x2d, 0xdb, 0x15, 0xe3, 0x4d, 0x67, 0xe4, 0x37, 0xdd, 0x78,
-	0x57, 0xce, 0x95, 0xee, 0xca, 0xf9, 0xfc, 0xae, 0xfc, 0x6f, 0xfa, 0x72, 0x3f, 0x21, 0x15, 0x6d,
-	0xf6, 0x84, 0x5b, 0x55, 0x75, 0xdd, 0xf4, 0x37, 0x16, 0x2f, 0x99, 0x1d, 0x44, 0x9f, 0x68, 0x06,
-	0xa6, 0x8c, 0xf4, 0xca, 0x5a, 0xcb, 0xf1, 0xa7, 0x9c, 0xcd, 0xf8, 0xd8, 0xe4, 0x93, 0xbf, 0x54,
-	0x7c, 0xf2, 0x77, 0x60, 0xed, 0x91, 0xca, 0x5a, 0x76, 0x46, 0x17, 0xaf, 0x08, 0x4f, 0x13, 0x8c,
-	0x5a, 0x19, 0xc1, 0xc8, 0x93, 0x05, 0x28, 0x90, 0x85, 0x22, 0x8d, 0xa9, 0x97, 0xd0, 0x18, 0xf9,
-	0x54, 0xa4, 0xfa, 0x95, 0xe4, 0xa9, 0xc8, 0xeb, 0xd2, 0x67, 0xa4, 0x51, 0x78, 0x46, 0x3e, 0x83,
-	0xcd, 0x73, 0x1c, 0x50, 0x0f, 0x0b, 0x1e, 0x76, 0x05, 0x16, 0xd1, 0xf8, 0xa4, 0xa2, 0x02, 0xce,
-	0x0d, 0x5a, 0xf4, 0x00, 0xcc, 0xa9, 0xb7, 0xc0, 0x7c, 0x87, 0xb7, 0xc0, 0x2c, 0x23, 0x69, 0x0e,
-	0x71, 0x09, 0x1d, 0x8a, 0x48, 0xc5, 0x5d, 0xd7, 0xb7, 0xcb, 0x63, 0xe8, 0xf3, 0x7c, 0xf3, 0x5b,
-	0x48, 0x75, 0xe6, 0xfa, 0x54, 0x93, 0x27, 0x21, 0xf2, 0x73, 0x62, 0xc1, 0xd2, 0x51, 0x1f, 0x53,
-	0xd6, 0x6e, 0x59, 0xb7, 0x34, 0xdb, 0x4e, 0x44, 0x59, 0xc0, 0x2e, 0x7f, 0x22, 0xae, 0x70, 0x48,
-	0xce, 0x49, 0x18, 0x49, 0x62, 0xbd, 0xa1, 0x0b, 0x58, 0x80, 0xcb, 0x58, 0xd9, 0xed, 0xf7, 0xca,
-	0xca, 0x7e, 0x80, 0xcd, 0x02, 0xd4, 0x66, 0x7a, 0x7a, 0x36, 0x67, 0x19, 0xf7, 0x86, 0x20, 0xd3,
-	0xa4, 0xf0, 0xce, 0x7b, 0x24, 0x85, 0x03, 0x58, 0x6d, 0x91, 0xcb, 0xfc, 0x1d, 0xad, 0x59, 0x46,
-	0x2b, 0x38, 0xcf, 0xf3, 0xbf, 0xff, 0x4d, 0xf0, 0x3f, 0x35, 0x24, 0x24, 0x22, 0xe1, 0x25, 0xf1,
-	0xac, 0xad, 0x64, 0x48, 0x12, 0xf9, 0xa3, 0x5f, 0x0d, 0x80, 0xec, 0x3b, 0x0b, 0xad, 0x43, 0xa3,
-	0xcd, 0x2e, 0xe5, 0x5c, 0x68, 0xc0, 0xac, 0xa0, 0x0d, 0x30, 0xe5, 0x01, 0x87, 0xf8, 0xf2, 0xc5,
-	0xc7, 0x0a, 0x35, 0xe4, 0x41, 0x89, 0x7e, 0xcd, 0x22, 0x81, 0x2f, 0x28, 0xf3, 0xcd, 0x39, 0xb4,
-	0x09, 0x48, 0x6d, 0x1c, 0x12, 0xe6, 0x8f, 0xce, 0xa3, 0x55, 0x1d, 0xe1, 0x2b, 0x4c, 0x03, 0xe2,
-	0x99, 0x0b, 0xc8, 0x84, 0x15, 0x6d, 0x9a, 0x20, 0x55, 0xb4, 0x06, 0x75, 0x89, 0x74, 0x03, 0x2c,
-	0xc9, 0x99, 0xb9, 0x98, 0x02, 0x8e, 0x5c, 0x8c, 0x17, 0xc4, 0x5c, 0x3a, 0xfc, 0xf2, 0xf9, 0x2b,
-	0xbb, 0xf2, 0xe2, 0x95, 0x5d, 0x79, 0xf3, 0xca, 0x36, 0x7e, 0x1c, 0xd9, 0xc6, 0xd3, 0x91, 0x6d,
-	0x3c, 0x1b, 0xd9, 0xc6, 0xf3, 0x91, 0x6d, 0xbc, 0x18, 0xd9, 0xc6, 0x9f, 0x23, 0xdb, 0xf8, 0x6b,
-	0x64, 0x57, 0xde, 0x8c, 0x6c, 0xe3, 0xa7, 0xd7, 0x76, 0xe5, 0xf9, 0x6b, 0xbb, 0xf2, 0xe2, 0xb5,
-	0x5d, 0xf9, 0xa6, 0xaa, 0x3e, 0x57, 0x7b, 0x8b, 0x6a, 0xa2, 0x3e, 0xfd, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0xd0, 0xf8, 0x08, 0x32, 0x05, 0x0f, 0x00, 0x00,
+	// 1248 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xb5, 0x57, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0x8e, 0xe3, 0xd8, 0x89, 0xdb, 0x71, 0x32, 0xe9, 0xfc, 0xec, 0x10, 0xa1, 0xec, 0xca, 0xe2,
+	0x10, 0x90, 0xd6, 0xd9, 0x0d, 0x2b, 0x38, 0x70, 0x40, 0x71, 0x7e, 0x14, 0xc3, 0x6e, 0x64, 0x8d,
+	0x43, 0x0e, 0xdc, 0xda, 0x33, 0x1d, 0xbb, 0x95, 0xf1, 0xb4, 0x99, 0xe9, 0x49, 0x08, 0x12, 0x12,
+	0x8f, 0x00, 0x37, 0x1e, 0x80, 0x03, 0x82, 0x17, 0xd9, 0x63, 0xc4, 0x29, 0x27, 0x96, 0x5d, 0x2e,
+	0x1c, 0x41, 0xe2, 0x01, 0xa8, 0xee, 0x9e, 0xf1, 0x8c, 0xc7, 0x13, 0x76, 0x0f, 0xde, 0x43, 0xcb,
+	0xae, 0xaa, 0xee, 0x2a, 0x77, 0x55, 0x7d, 0xd5, 0x9f, 0xd1, 0xf2, 0x80, 0x0a, 0xd2, 0x74, 0xb9,
+	0x7d, 0xd1, 0x18, 0xfa, 0x5c, 0x70, 0x5c, 0x52, 0x1f, 0x9b, 0x0f, 0x7b, 0x4c, 0xf4, 0xc3, 0x6e,
+	0xc3, 0xe6, 0x83, 0x9d, 0x1e, 0xef, 0xf1, 0x1d, 0xa5, 0xee, 0x86, 0xe7, 0x4a, 0x52, 0x82, 0xfa,
+	0xa6, 0x4f, 0x6d, 0x56, 0xbb, 0x89, 0x8b, 0xfa, 0xbf, 0x05, 0xb4, 0xd0, 0xa6, 0xd4, 0x3f, 0x20,
+	0x82, 0x60, 0x13, 0xcd, 0xef, 0x39, 0x8e, 0x4f, 0x83, 0xc0, 0x2c, 0x3c, 0x28, 0x6c, 0x2f, 0x5a,
+	0xb1, 0x88, 0xdf, 0x45, 0x95, 0x76, 0xd8, 0x75, 0x99, 0xfd, 0x39, 0xbd, 0x36, 0x67, 0x95, 0x2d,
+	0x51, 0xe0, 0xf7, 0x51, 0x79, 0xcf, 0x16, 0x8c, 0x7b, 0x66, 0x11, 0x4c, 0x4b, 0xbb, 0x2b, 0xda,
+	0x79, 0x43, 0x3a, 0xd6, 0x06, 0x2b, 0xda, 0x20, 0x1d, 0x9d, 0xb2, 0x01, 0xed, 0x08, 0x32, 0x18,
+	0x9a, 0x73, 0xb0, 0x7b, 0xce, 0x4a, 0x14, 0xb8, 0x87, 0xaa, 0x67, 0xc4, 0x0d, 0xe9, 0x7e, 0x9f,
+	0x78, 0x3d, 0x6a, 0x96, 0x64, 0xa0, 0xe6, 0xe1, 0x2f, 0x2f, 0xee, 0xef, 0x0d, 0x88, 0xe8, 0xef,
+	0x74, 0x59, 0xaf, 0xd1, 0xf2, 0xc4, 0x27, 0xa9, 0xfb, 0x1e, 0xba, 0x3e, 0xf7, 0x9c, 0x13, 0x2a,
+	0xae, 0xb8, 0x7f, 0xb1, 0x43, 0x95, 0xf4, 0x10, 0x52, 0xe0, 0xc0, 0x7d, 0x1a, 0x4d, 0xd6, 0x83,
+	0xed, 0xfb, 0x24, 0x10, 0xd4, 0xb7, 0xd2, 0x9e, 0xeb, 0xbf, 0x96, 0x50, 0xa5, 0xd3, 0x27, 0xbe,
+	0xa3, 0xee, 0xbd, 0x85, 0xd0, 0x31, 0x25, 0x0e, 0xf5, 0x8f, 0x49, 0xd0, 0x8f, 0xae, 0x97, 0xd2,
+	0x60, 0x0b, 0xad, 0xab, 0xcd, 0xcf, 0x98, 0xc7, 0x54, 0xfe, 0xb5, 0x2d, 0x80, 0xeb, 0x16, 0xb7,
+	0xab, 0xbb, 0x1b, 0xd1, 0x75, 0x33, 0xe6, 0xe6, 0xdc, 0xf3, 0xdf, 0xef, 0xcf, 0x58, 0xf9, 0x47,
+	0x71, 0x1d, 0x2d, 0xb6, 0x7d, 0x7a, 0x69, 0x11, 0xcf, 0xe9, 0x50, 0xea, 0xa8, 0x5c, 0x2c, 0x5a,
+	0x63, 0x3a, 0xfc, 0x1e, 0xaa, 0x41, 0x92, 0x21, 0xc3, 0x41, 0x93, 0x89, 0x01, 0x19, 0xea, 0x84,
+	0x58, 0xe3, 0x4a, 0x99, 0xd2, 0x0e, 0xeb, 0x79, 0x44, 0x84, 0x3e, 0x35, 0xcb, 0xba, 0x36, 0x23,
+	0x05, 0x5e, 0x43, 0x25, 0x8b, 0x87, 0x9e, 0x63, 0x2e, 0xa8, 0x64, 0x6b, 0x01, 0x6f, 0x42, 0xd5,
+	0x21, 0x92, 0xba, 0x6f, 0x45, 0x1d, 0x19, 0xc9, 0xf2, 0xc4, 0x09, 0xf7, 0x6c, 0x6a, 0x22, 0x7d,
+	0x42, 0x09, 0x98, 0xa3, 0xe5, 0x3d, 0xdb, 0x0e, 0x07, 0xa1, 0x4b, 0x04, 0x75, 0x8e, 0x28, 0x0d,
+	0xcc, 0xc5, 0x69, 0x96, 0x27, 0xeb, 0x1d, 0x5f, 0xa0, 0xda, 0x01, 0xbd, 0xa4, 0x2e, 0x1f, 0x52,
+	0x5f, 0x85, 0x5b, 0x9a, 0x66, 0xb8, 0x71, 0xdf, 0x78, 0x17, 0xad, 0x9d, 0x84, 0x83, 0x36, 0xf5,
+	0x1c, 0xe6, 0xf5, 0x46, 0xb5, 0x0a, 0xcc, 0x2a, 0xc4, 0xac, 0x59, 0xb9, 0x36, 0xfc, 0x04, 0xad,
+	0x3f, 0x05, 0x67, 0x2d, 0xcf, 0x76, 0x43, 0x87, 0x3a, 0xcf, 0x00, 0x9c, 0x3a, 0x6f, 0x35, 0x95,
+	0xb7, 0x7c, 0xa3, 0xc4, 0x98, 0x6a, 0x88, 0xd6, 0x81, 0xc2, 0x58, 0xcd, 0x8a, 0x45, 0x69, 0x39,
+	0xfd, 0x7a, 0x1f, 0xca, 0x23, 0xcc, 0x79, 0x6d, 0x89, 0xc4, 0xfa, 0x3f, 0xb3, 0x68, 0xf5, 0x70,
+	0xc8, 0xed, 0x3e, 0xa0, 0xc4, 0x17, 0x49, 0xdf, 0xde, 0xed, 0x0b, 0x6a, 0xa8, 0x0e, 0xa8, 0xe2,
+	0xd6, 0x2c, 0x2d, 0x24, 0xbd, 0x30, 0x9f, 0xee, 0x85, 0x51, 0xbd, 0x17, 0xd2, 0xf5, 0x7e, 0x1d,
+	0x26, 0xa0, 0x83, 0x2c, 0xce, 0x85, 0xb2, 0x16, 0x75, 0x07, 0xc5, 0xb2, 0xcc, 0xcc, 0x11, 0xf3,
+	0x03, 0x11, 0xe7, 0x2c, 0x1e, 0x5b, 0x51, 0x93, 0xe7, 0x1b, 0xe3, 0x7c, 0x1e, 0x41, 0x86, 0x83,
+	0xbe, 0x4e, 0x99, 0x3e, 0xa5, 0xbb, 0x3e, 0xdf, 0x88, 0xcf, 0xd0, 0xbd, 0x6c, 0x69, 0x62, 0x74,
+	0x96, 0xdf, 0x00, 0x9d, 0x77, 0x1d, 0xae, 0xff, 0x56, 0x46, 0x95, 0x43, 0x9b, 0x7b, 0x7c, 0xc0,
+	0xec, 0x40, 0x0e, 0xa6, 0x53, 0x2e, 0x88, 0xdb, 0x09, 0x87, 0x43, 0xf7, 0x5a, 0x4f, 0xc7, 0xa9,
+	0x0d, 0xa6, 0x94, 0x67, 0x1c, 0xa0, 0x15, 0x25, 0x9e, 0xf2, 0x03, 0x16, 0x08, 0x9f, 0x75, 0x43,
+	0x41, 0x75, 0xf6, 0xa7, 0x15, 0x6e, 0xd2, 0x3f, 0xfe, 0x0a, 0x19, 0x4a, 0x79, 0x42, 0xaf, 0xdc,
+	0x6b, 0xc8, 0x04, 0x40, 0x50, 0xd7, 0x74, 0x5a, 0x31, 0x27, 0xdc, 0xcb, 0x71, 0x62, 0xd1, 0x2b,
+	0x68, 0xd6, 0xa0, 0x0d, 0xb5, 0x48, 0x9a, 0x63, 0x6a, 0xe3, 0x24, 0xe3, 0x1d, 0xff, 0x50, 0x40,
+	0x0f, 0x22, 0xdd, 0x11, 0xf7, 0xdb, 0xb2, 0x25, 0x6c, 0x0e, 0x59, 0x0f, 0x04, 0x61, 0x1e, 0xe9,
+	0x32, 0x97, 0x89, 0xeb, 0xe9, 0x3e, 0x38, 0xaf, 0x0d, 0x87, 0x6d, 0x54, 0x39, 0xe1, 0x0e, 0x6d,
+	0xfb, 0xcc, 0x8e, 0x26, 0xf7, 0xb4, 0x62, 0x27, 0x7e, 0xf1, 0x23, 0xb4, 0x2a, 0x47, 0x7b, 0x32,
+	0x3f, 0xd2, 0x23, 0x20, 0xcf, 0x84, 0x1b, 0x08, 0x8f, 0xab, 0x15, 0xc8, 0x17, 0x14, 0x0a, 0x73,
+	0x2c, 0x78, 0x7b, 0x54, 0xcb, 0xd1, 0x44, 0xd0, 0x6f, 0x4a, 0x56, 0x5d, 0xff, 0xb1, 0x80, 0x50,
+	0x72, 0x18, 0x9f, 0xa2, 0xb5, 0x08, 0xd4, 0xc4, 0x65, 0xdf, 0x50, 0x27, 0x06, 0x6e, 0x41, 0x01,
+	0x77, 0x33, 0x02, 0x6e, 0xce, 0xe4, 0x8b, 0xc0, 0x9b, 0x7b, 0x1a, 0xe6, 0x48, 0x02, 0x5c, 0x05,
+	0x9d, 0xea, 0xae, 0x11, 0xbb, 0x8a, 0xf5, 0x91, 0x83, 0x64, 0x63, 0xfd, 0x45, 0x05, 0x55, 0x92,
+	0xa9, 0x32, 0x9a, 0x89, 0x85, 0xf4, 0x4c, 0x1c, 0x4d, 0xd5, 0xd9, 0xdc, 0xa9, 0x5a, 0x4c, 0x4f,
+	0xd5, 0xff, 0x27, 0x3a, 0x4f, 0x22, 0xfa, 0xd1, 0xf2, 0xce, 0x39, 0x74, 0x5d, 0x31, 0xf5, 0x1b,
+	0xb3, 0x97, 0x4c, 0x36, 0xe2, 0xc7, 0x9a, 0xab, 0xa9, 0x43, 0x7a, 0xb8, 0x2d, 0xa7, 0x98, 0x56,
+	0xea, 0xcc, 0x68, 0xdb, 0x38, 0x39, 0x98, 0xcf, 0x92, 0x03, 0xa8, 0xdc, 0x53, 0x95, 0xb5, 0x64,
+	0x8f, 0x2e, 0x73, 0x56, 0x3d, 0x49, 0x45, 0x2a, 0x79, 0x54, 0x24, 0x4d, 0x2b, 0x50, 0x86, 0x56,
+	0x64, 0x09, 0x4f, 0x35, 0x87, 0xf0, 0xc8, 0x47, 0x25, 0xb6, 0x2f, 0x46, 0x8f, 0x4a, 0xda, 0x16,
+	0xb7, 0x57, 0x2d, 0xf3, 0xe0, 0x7c, 0x84, 0x36, 0x80, 0xdd, 0x31, 0xc0, 0x01, 0xf7, 0x21, 0xc1,
+	0x22, 0x69, 0x44, 0x45, 0x1a, 0xac, 0x3b, 0xac, 0xf8, 0x18, 0x19, 0x13, 0xaf, 0x86, 0xf1, 0x06,
+	0xaf, 0x86, 0x91, 0x47, 0xe7, 0x2c, 0x6a, 0x53, 0x36, 0x14, 0x81, 0x8a, 0xbb, 0xa2, 0x6f, 0x97,
+	0xd6, 0xe1, 0x8f, 0xd3, 0xcd, 0x6f, 0x62, 0xd5, 0x99, 0x2b, 0x13, 0x4d, 0x1e, 0x85, 0x48, 0xe3,
+	0x04, 0xde, 0x79, 0xe0, 0xad, 0xcc, 0x83, 0x77, 0x7e, 0x55, 0xf3, 0xf2, 0x48, 0x94, 0x05, 0xec,
+	0xf0, 0x73, 0x01, 0x28, 0xa3, 0x67, 0xf0, 0x33, 0x24, 0x05, 0x5f, 0xd3, 0x05, 0xcc, 0xa8, 0xf3,
+	0xf8, 0xdb, 0xfa, 0x5b, 0xe5, 0x6f, 0xdf, 0xa2, 0x8d, 0x8c, 0xaa, 0xe5, 0x69, 0xf4, 0x6c, 0x4c,
+	0x33, 0xee, 0x1d, 0x41, 0x26, 0xe9, 0xe3, 0xbd, 0xb7, 0x48, 0x1f, 0x07, 0x68, 0x09, 0x14, 0xe9,
+	0x3b, 0x9a, 0xd3, 0x8c, 0x96, 0x71, 0x9e, 0x66, 0x8a, 0xef, 0x8c, 0x31, 0x45, 0x05, 0x12, 0x1a,
+	0x50, 0xff, 0x12, 0x00, 0xb4, 0x19, 0x81, 0x24, 0x92, 0x3f, 0xf8, 0x09, 0x86, 0x6f, 0xf2, 0x8f,
+	0x0c, 0xaf, 0xa0, 0x5a, 0xcb, 0xbb, 0x94, 0xb8, 0xd0, 0x0a, 0x63, 0x06, 0x26, 0x99, 0x21, 0x37,
+	0x58, 0xb4, 0x27, 0xb9, 0x01, 0x51, 0xda, 0x82, 0xdc, 0x28, 0xb5, 0x5f, 0x78, 0xf0, 0x76, 0x5d,
+	0x00, 0x55, 0x32, 0x66, 0xf1, 0x06, 0xbc, 0x10, 0x72, 0xe2, 0x50, 0x3f, 0xbd, 0xb5, 0x88, 0x97,
+	0x74, 0x84, 0xcf, 0x08, 0x73, 0xa9, 0x63, 0xcc, 0x61, 0x03, 0x30, 0xaf, 0x8e, 0x46, 0x9a, 0x12,
+	0x5e, 0x46, 0x55, 0xa9, 0xe9, 0xb8, 0x44, 0xd2, 0x38, 0xa3, 0x1c, 0x2b, 0x2c, 0x39, 0x18, 0x2f,
+	0xa8, 0x31, 0xdf, 0xfc, 0xf4, 0xe6, 0xe5, 0xd6, 0xcc, 0x2d, 0xac, 0xbf, 0x5f, 0x6e, 0x15, 0xbe,
+	0x7b, 0xb5, 0x55, 0xf8, 0x19, 0xd6, 0x73, 0x58, 0x37, 0xb0, 0x6e, 0x61, 0xfd, 0x01, 0xeb, 0xaf,
+	0x57, 0x60, 0x87, 0xcf, 0xef, 0xff, 0xdc, 0x9a, 0xb9, 0x81, 0x75, 0x0b, 0xeb, 0xcb, 0x92, 0xfa,
+	0x63, 0xdb, 0x2d, 0x2b, 0x44, 0x7d, 0xf8, 0x1f, 0x5d, 0x52, 0x84, 0x58, 0x2f, 0x0f, 0x00, 0x00,
 }
 
 func (x PeerAction) String() string {
@@ -1096,6 +1103,9 @@ func (this *Economics) Equal(that interface{}) bool {
 	if !bytes.Equal(this.PrevEpochStartHash, that1.PrevEpochStartHash) {
 		return false
 	}
+	if !bytes.Equal(this.RewardsRootHash, that1.RewardsRootHash) {
+		return false
+	}
 	return true
 }
 func (this *EpochStart) Equal(that interface{}) bool {
@@ -1324,7 +1334,7 @@ func (this *Economics) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 13)
 	s = append(s, "&block.Economics{")
 	s = append(s, "TotalSupply: "+fmt.Sprintf("%#v", this.TotalSupply)+",\n")
 	s = append(s, "TotalToDistribute: "+fmt.Sprintf("%#v", this.TotalToDistribute)+",\n")
@@ -1334,6 +1344,7 @@ func (this *Economics) GoString() string {
 	s = append(s, "NodePrice: "+fmt.Sprintf("%#v", this.NodePrice)+",\n")
 	s = append(s, "PrevEpochStartRound: "+fmt.Sprintf("%#v", this.PrevEpochStartRound)+",\n")
 	s = append(s, "PrevEpochStartHash: "+fmt.Sprintf("%#v", this.PrevEpochStartHash)+",\n")
+	s = append(s, "RewardsRootHash: "+fmt.Sprintf("%#v", this.RewardsRootHash)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1701,6 +1712,13 @@ func (m *Economics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardsRootHash) > 0 {
+		i -= len(m.RewardsRootHash)
+		copy(dAtA[i:], m.RewardsRootHash)
+		i = encodeVarintMetaBlock(dAtA, i, uint64(len(m.RewardsRootHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.PrevEpochStartHash) > 0 {
 		i -= len(m.PrevEpochStartHash)
 		copy(dAtA[i:], m.PrevEpochStartHash)
@@ -2267,6 +2285,10 @@ func (m *Economics) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMetaBlock(uint64(l))
 	}
+	l = len(m.RewardsRootHash)
+	if l > 0 {
+		n += 1 + l + sovMetaBlock(uint64(l))
+	}
 	return n
 }
 
@@ -2483,6 +2505,7 @@ func (this *Economics) String() string {
 		`NodePrice:` + fmt.Sprintf("%v", this.NodePrice) + `,`,
 		`PrevEpochStartRound:` + fmt.Sprintf("%v", this.PrevEpochStartRound) + `,`,
 		`PrevEpochStartHash:` + fmt.Sprintf("%v", this.PrevEpochStartHash) + `,`,
+		`RewardsRootHash:` + fmt.Sprintf("%v", this.RewardsRootHash) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3813,6 +3836,40 @@ func (m *Economics) Unmarshal(dAtA []byte) error {
 				m.PrevEpochStartHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsRootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsRootHash = append(m.RewardsRootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RewardsRootHash == nil {
+				m.RewardsRootHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetaBlock(dAtA[iNdEx:])
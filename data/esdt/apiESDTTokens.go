@@ -0,0 +1,28 @@
+package esdt
+
+// TokensPageOptions holds the options used when fetching a page of the ESDT tokens of an address
+type TokensPageOptions struct {
+	// RootHash pins the iteration to a data trie version, the current one being used when empty
+	RootHash string
+	// Cursor is the next cursor returned by the previous page, empty for the first page
+	Cursor string
+	// TokenIdentifier optionally restricts the result to the entries of a single token
+	TokenIdentifier string
+	PageSize        uint32
+}
+
+// ApiESDTToken is the data transfer object which will be returned for an ESDT balance entry of an address
+type ApiESDTToken struct {
+	TokenIdentifier string `json:"tokenIdentifier"`
+	Nonce           uint64 `json:"nonce,omitempty"`
+	Balance         string `json:"balance"`
+	Properties      string `json:"properties"`
+}
+
+// ApiESDTTokensPage is the data transfer object which will be returned for a page of ESDT balances. The next
+// cursor is empty when there are no more entries to fetch
+type ApiESDTTokensPage struct {
+	Tokens     []*ApiESDTToken `json:"tokens"`
+	RootHash   string          `json:"rootHash"`
+	NextCursor string          `json:"nextCursor,omitempty"`
+}
@@ -165,7 +165,9 @@ type Trie interface {
 	SetNewHashes(ModifiedHashes)
 	Database() DBWriteCacher
 	GetSerializedNodes([]byte, uint64) ([][]byte, uint64, error)
+	GetProof(key []byte) ([][]byte, error)
 	GetAllLeavesOnChannel(rootHash []byte, ctx context.Context) (chan core.KeyValueHolder, error)
+	GetAllLeavesFromKeyOnChannel(rootHash []byte, startKey []byte, ctx context.Context) (chan core.KeyValueHolder, error)
 	GetAllHashes() ([][]byte, error)
 	IsPruningEnabled() bool
 	EnterPruningBufferingMode()
@@ -12,24 +12,26 @@ var errNotImplemented = errors.New("not implemented")
 
 // TrieStub -
 type TrieStub struct {
-	GetCalled                   func(key []byte) ([]byte, error)
-	UpdateCalled                func(key, value []byte) error
-	DeleteCalled                func(key []byte) error
-	RootCalled                  func() ([]byte, error)
-	CommitCalled                func() error
-	RecreateCalled              func(root []byte) (data.Trie, error)
-	CancelPruneCalled           func(rootHash []byte, identifier data.TriePruningIdentifier)
-	PruneCalled                 func(rootHash []byte, identifier data.TriePruningIdentifier)
-	ResetOldHashesCalled        func() [][]byte
-	AppendToOldHashesCalled     func([][]byte)
-	TakeSnapshotCalled          func(rootHash []byte)
-	SetCheckpointCalled         func(rootHash []byte)
-	GetSerializedNodesCalled    func([]byte, uint64) ([][]byte, uint64, error)
-	DatabaseCalled              func() data.DBWriteCacher
-	GetAllLeavesOnChannelCalled func(rootHash []byte) (chan core.KeyValueHolder, error)
-	GetAllHashesCalled          func() ([][]byte, error)
-	IsPruningEnabledCalled      func() bool
-	ClosePersisterCalled        func() error
+	GetCalled                          func(key []byte) ([]byte, error)
+	UpdateCalled                       func(key, value []byte) error
+	DeleteCalled                       func(key []byte) error
+	RootCalled                         func() ([]byte, error)
+	CommitCalled                       func() error
+	RecreateCalled                     func(root []byte) (data.Trie, error)
+	CancelPruneCalled                  func(rootHash []byte, identifier data.TriePruningIdentifier)
+	PruneCalled                        func(rootHash []byte, identifier data.TriePruningIdentifier)
+	ResetOldHashesCalled               func() [][]byte
+	AppendToOldHashesCalled            func([][]byte)
+	TakeSnapshotCalled                 func(rootHash []byte)
+	SetCheckpointCalled                func(rootHash []byte)
+	GetSerializedNodesCalled           func([]byte, uint64) ([][]byte, uint64, error)
+	GetProofCalled                     func(key []byte) ([][]byte, error)
+	DatabaseCalled                     func() data.DBWriteCacher
+	GetAllLeavesOnChannelCalled        func(rootHash []byte) (chan core.KeyValueHolder, error)
+	GetAllLeavesFromKeyOnChannelCalled func(rootHash []byte, startKey []byte) (chan core.KeyValueHolder, error)
+	GetAllHashesCalled                 func() ([][]byte, error)
+	IsPruningEnabledCalled             func() bool
+	ClosePersisterCalled               func() error
 }
 
 // EnterPruningBufferingMode -
@@ -120,6 +122,18 @@ func (ts *TrieStub) GetAllLeavesOnChannel(rootHash []byte, _ context.Context) (c
 	return ch, nil
 }
 
+// GetAllLeavesFromKeyOnChannel -
+func (ts *TrieStub) GetAllLeavesFromKeyOnChannel(rootHash []byte, startKey []byte, _ context.Context) (chan core.KeyValueHolder, error) {
+	if ts.GetAllLeavesFromKeyOnChannelCalled != nil {
+		return ts.GetAllLeavesFromKeyOnChannelCalled(rootHash, startKey)
+	}
+
+	ch := make(chan core.KeyValueHolder)
+	close(ch)
+
+	return ch, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ts *TrieStub) IsInterfaceNil() bool {
 	return ts == nil
@@ -177,6 +191,14 @@ func (ts *TrieStub) GetSerializedNodes(hash []byte, maxBuffToSend uint64) ([][]b
 	return nil, 0, nil
 }
 
+// GetProof -
+func (ts *TrieStub) GetProof(key []byte) ([][]byte, error) {
+	if ts.GetProofCalled != nil {
+		return ts.GetProofCalled(key)
+	}
+	return nil, nil
+}
+
 // Database -
 func (ts *TrieStub) Database() data.DBWriteCacher {
 	if ts.DatabaseCalled != nil {
@@ -69,8 +69,9 @@ type AccountsDB struct {
 	entries      []JournalEntry
 	mutOp        sync.RWMutex
 
-	numCheckpoints       uint32
-	loadCodeMeasurements *loadingMeasurements
+	numCheckpoints         uint32
+	numSnapshotsInProgress int32
+	loadCodeMeasurements   *loadingMeasurements
 }
 
 var log = logger.GetOrCreate("state")
@@ -908,6 +909,7 @@ func (adb *AccountsDB) SnapshotState(rootHash []byte, ctx context.Context) {
 
 	log.Trace("accountsDB.SnapshotState", "root hash", rootHash)
 	adb.mainTrie.EnterPruningBufferingMode()
+	atomic.AddInt32(&adb.numSnapshotsInProgress, 1)
 
 	go func() {
 		adb.mainTrie.TakeSnapshot(rootHash)
@@ -915,6 +917,7 @@ func (adb *AccountsDB) SnapshotState(rootHash []byte, ctx context.Context) {
 		adb.mainTrie.ExitPruningBufferingMode()
 
 		adb.increaseNumCheckpoints()
+		atomic.AddInt32(&adb.numSnapshotsInProgress, -1)
 	}()
 }
 
@@ -946,6 +949,7 @@ func (adb *AccountsDB) SetStateCheckpoint(rootHash []byte, ctx context.Context)
 
 	log.Trace("accountsDB.SetStateCheckpoint", "root hash", rootHash)
 	adb.mainTrie.EnterPruningBufferingMode()
+	atomic.AddInt32(&adb.numSnapshotsInProgress, 1)
 
 	go func() {
 		adb.mainTrie.SetCheckpoint(rootHash)
@@ -953,6 +957,7 @@ func (adb *AccountsDB) SetStateCheckpoint(rootHash []byte, ctx context.Context)
 		adb.mainTrie.ExitPruningBufferingMode()
 
 		adb.increaseNumCheckpoints()
+		atomic.AddInt32(&adb.numSnapshotsInProgress, -1)
 	}()
 }
 
@@ -983,6 +988,20 @@ func (adb *AccountsDB) GetAllLeaves(rootHash []byte, ctx context.Context) (chan
 	return adb.mainTrie.GetAllLeavesOnChannel(rootHash, ctx)
 }
 
+// GetTrie returns the accounts trie recreated from the given root hash. The current state of the accounts DB is not
+// altered
+func (adb *AccountsDB) GetTrie(rootHash []byte) (data.Trie, error) {
+	adb.mutOp.Lock()
+	defer adb.mutOp.Unlock()
+
+	return adb.mainTrie.Recreate(rootHash)
+}
+
+// IsSnapshotInProgress returns true if a state snapshot or checkpoint is still being taken
+func (adb *AccountsDB) IsSnapshotInProgress() bool {
+	return atomic.LoadInt32(&adb.numSnapshotsInProgress) > 0
+}
+
 // GetNumCheckpoints returns the total number of state checkpoints
 func (adb *AccountsDB) GetNumCheckpoints() uint32 {
 	return atomic.LoadUint32(&adb.numCheckpoints)
@@ -836,6 +836,26 @@ func TestAccountsDB_SnapshotState(t *testing.T) {
 	snapshotMut.Unlock()
 }
 
+func TestAccountsDB_IsSnapshotInProgress(t *testing.T) {
+	t.Parallel()
+
+	snapshotDone := make(chan struct{})
+	trieStub := &mock.TrieStub{
+		TakeSnapshotCalled: func(rootHash []byte) {
+			<-snapshotDone
+		},
+	}
+	adb := generateAccountDBFromTrie(trieStub)
+	assert.False(t, adb.IsSnapshotInProgress())
+
+	adb.SnapshotState([]byte("roothash"), context.Background())
+	assert.True(t, adb.IsSnapshotInProgress())
+
+	close(snapshotDone)
+	time.Sleep(time.Second)
+	assert.False(t, adb.IsSnapshotInProgress())
+}
+
 func TestAccountsDB_SetStateCheckpoint(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,13 @@
+package state
+
+// ApiProof represents a merkle proof of a key from the accounts trie or from an account data trie, as returned by
+// the API. The proofs hold the hex encoded trie nodes found on the path from the root to the leaf holding the key
+type ApiProof struct {
+	RootHash         string   `json:"rootHash"`
+	Address          string   `json:"address"`
+	Key              string   `json:"key"`
+	Value            string   `json:"value"`
+	Proof            []string `json:"proof"`
+	DataTrieRootHash string   `json:"dataTrieRootHash,omitempty"`
+	AccountProof     []string `json:"accountProof,omitempty"`
+}
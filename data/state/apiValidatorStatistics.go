@@ -0,0 +1,45 @@
+package state
+
+const (
+	// SortValidatorsByPublicKey sorts the validator statistics by the public key
+	SortValidatorsByPublicKey = "publicKey"
+	// SortValidatorsByRating sorts the validator statistics by the rating
+	SortValidatorsByRating = "rating"
+	// SortValidatorsByTempRating sorts the validator statistics by the temporary rating
+	SortValidatorsByTempRating = "tempRating"
+	// SortValidatorsByShard sorts the validator statistics by the shard ID
+	SortValidatorsByShard = "shardId"
+	// SortValidatorsByIdentity sorts the validator statistics by the identity
+	SortValidatorsByIdentity = "identity"
+	// SortValidatorsByStatus sorts the validator statistics by the validator status
+	SortValidatorsByStatus = "validatorStatus"
+)
+
+// ValidatorStatisticsQuery holds the filtering, sorting and pagination options of the validator statistics. The nil
+// and the empty fields do not filter. The entries are sorted ascending by the public key when SortBy is empty
+type ValidatorStatisticsQuery struct {
+	ShardID        *uint32
+	Identity       string
+	Status         string
+	MinRating      *float32
+	MaxRating      *float32
+	SortBy         string
+	SortDescending bool
+	From           uint32
+	Size           uint32
+}
+
+// ApiValidatorStatistics is the data transfer object which will be returned for a validator statistics entry
+type ApiValidatorStatistics struct {
+	PublicKey string `json:"publicKey"`
+	Identity  string `json:"identity,omitempty"`
+	*ValidatorApiResponse
+}
+
+// ApiValidatorStatisticsPage holds a page of the validator statistics. NumValidators is the number of validators
+// matching the filters and NextFrom is the index of the first entry of the next page, being omitted on the last page
+type ApiValidatorStatisticsPage struct {
+	Statistics    []*ApiValidatorStatistics `json:"statistics"`
+	NumValidators uint32                    `json:"numValidators"`
+	NextFrom      uint32                    `json:"nextFrom,omitempty"`
+}
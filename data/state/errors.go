@@ -3,6 +3,8 @@ package state
 import (
 	"encoding/hex"
 	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/core/addressRouting"
 )
 
 // ErrMissingTrie is an error-compatible struct holding the root hash of the trie that is missing
@@ -68,7 +70,7 @@ var ErrAccNotFound = errors.New("account was not found")
 var ErrUnknownShardId = errors.New("shard id is not valid")
 
 //ErrBech32ConvertError signals that conversion the 5bit alphabet to 8bit failed
-var ErrBech32ConvertError = errors.New("can't convert bech32 string")
+var ErrBech32ConvertError = addressRouting.ErrBech32ConvertError
 
 // ErrNilBLSPublicKey signals that the provided BLS public key is nil
 var ErrNilBLSPublicKey = errors.New("bls public key is nil")
@@ -80,7 +82,7 @@ var ErrNilOrEmptyDataTrieUpdates = errors.New("no data trie updates")
 var ErrOperationNotPermitted = errors.New("operation in account not permitted")
 
 // ErrInvalidAddressLength signals that address length is invalid
-var ErrInvalidAddressLength = errors.New("invalid address length")
+var ErrInvalidAddressLength = addressRouting.ErrInvalidAddressLength
 
 // ErrInsufficientFunds signals the funds are insufficient for the move balance operation but the
 // transaction fee is covered by the current balance
@@ -102,10 +104,10 @@ var ErrNilCacher = errors.New("nil cacher")
 var ErrSnapshotValueOutOfBounds = errors.New("snapshot value out of bounds")
 
 // ErrWrongSize signals that a wrong size occurred
-var ErrWrongSize = errors.New("wrong size")
+var ErrWrongSize = addressRouting.ErrWrongSize
 
 // ErrInvalidErdAddress signals that the provided address is not an ERD address
-var ErrInvalidErdAddress = errors.New("invalid ERD address")
+var ErrInvalidErdAddress = addressRouting.ErrInvalidErdAddress
 
 // ErrInvalidPubkeyConverterType signals that the provided pubkey converter type is invalid
 var ErrInvalidPubkeyConverterType = errors.New("invalid pubkey converter type")
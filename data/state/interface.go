@@ -77,6 +77,10 @@ type PeerAccountHandler interface {
 	SetTempRating(uint32)
 	GetConsecutiveProposerMisses() uint32
 	SetConsecutiveProposerMisses(uint322 uint32)
+	GetConsecutiveValidatorMisses() uint32
+	SetConsecutiveValidatorMisses(consecutiveMisses uint32)
+	GetProbationEndEpoch() uint32
+	SetProbationEndEpoch(epoch uint32)
 	ResetAtNewEpoch()
 	AccountHandler
 }
@@ -138,9 +142,11 @@ type AccountsAdapter interface {
 	CancelPrune(rootHash []byte, identifier data.TriePruningIdentifier)
 	SnapshotState(rootHash []byte, ctx context.Context)
 	SetStateCheckpoint(rootHash []byte, ctx context.Context)
+	IsSnapshotInProgress() bool
 	IsPruningEnabled() bool
 	GetAllLeaves(rootHash []byte, ctx context.Context) (chan core.KeyValueHolder, error)
 	RecreateAllTries(rootHash []byte, ctx context.Context) (map[string]data.Trie, error)
+	GetTrie(rootHash []byte) (data.Trie, error)
 	IsInterfaceNil() bool
 }
 
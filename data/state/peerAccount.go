@@ -141,6 +141,16 @@ func (pa *peerAccount) SetConsecutiveProposerMisses(consecutiveMisses uint32) {
 	pa.ConsecutiveProposerMisses = consecutiveMisses
 }
 
+// SetConsecutiveValidatorMisses sets the account's consecutive missed signatures as validator
+func (pa *peerAccount) SetConsecutiveValidatorMisses(consecutiveMisses uint32) {
+	pa.ConsecutiveValidatorMisses = consecutiveMisses
+}
+
+// SetProbationEndEpoch sets the last epoch in which the account is in probation
+func (pa *peerAccount) SetProbationEndEpoch(epoch uint32) {
+	pa.ProbationEndEpoch = epoch
+}
+
 //IncreaseNonce adds the given value to the current nonce
 func (pa *peerAccount) IncreaseNonce(value uint64) {
 	pa.Nonce = pa.Nonce + value
@@ -94,6 +94,7 @@ type ValidatorApiResponse struct {
 	TotalNumValidatorIgnoredSignatures uint32  `protobuf:"varint,13,opt,name=TotalNumValidatorIgnoredSignatures,proto3" json:"totalNumValidatorIgnoredSignatures"`
 	ShardId                            uint32  `protobuf:"varint,14,opt,name=ShardId,proto3" json:"shardId"`
 	ValidatorStatus                    string  `protobuf:"bytes,15,opt,name=ValidatorStatus,proto3" json:"validatorStatus,omitempty"`
+	InProbation                        bool    `protobuf:"varint,16,opt,name=InProbation,proto3" json:"inProbation"`
 }
 
 func (m *ValidatorApiResponse) Reset()      { *m = ValidatorApiResponse{} }
@@ -229,6 +230,13 @@ func (m *ValidatorApiResponse) GetValidatorStatus() string {
 	return ""
 }
 
+func (m *ValidatorApiResponse) GetInProbation() bool {
+	if m != nil {
+		return m.InProbation
+	}
+	return false
+}
+
 // PeerAccountData represents the data that defines the PeerAccount
 type PeerAccountData struct {
 	BLSPublicKey                        []byte        `protobuf:"bytes,1,opt,name=BLSPublicKey,proto3" json:"blsPublicKey"`
@@ -249,6 +257,8 @@ type PeerAccountData struct {
 	TotalValidatorIgnoredSignaturesRate uint32        `protobuf:"varint,16,opt,name=TotalValidatorIgnoredSignaturesRate,proto3" json:"totalValidatorIgnoredSignaturesRate"`
 	Nonce                               uint64        `protobuf:"varint,17,opt,name=Nonce,proto3" json:"nonce"`
 	UnStakedEpoch                       uint32        `protobuf:"varint,18,opt,name=UnStakedEpoch,proto3" json:"unStakedEpoch"`
+	ConsecutiveValidatorMisses          uint32        `protobuf:"varint,19,opt,name=ConsecutiveValidatorMisses,proto3" json:"consecutiveValidatorMisses"`
+	ProbationEndEpoch                   uint32        `protobuf:"varint,20,opt,name=ProbationEndEpoch,proto3" json:"probationEndEpoch"`
 }
 
 func (m *PeerAccountData) Reset()      { *m = PeerAccountData{} }
@@ -405,6 +415,20 @@ func (m *PeerAccountData) GetUnStakedEpoch() uint32 {
 	return 0
 }
 
+func (m *PeerAccountData) GetConsecutiveValidatorMisses() uint32 {
+	if m != nil {
+		return m.ConsecutiveValidatorMisses
+	}
+	return 0
+}
+
+func (m *PeerAccountData) GetProbationEndEpoch() uint32 {
+	if m != nil {
+		return m.ProbationEndEpoch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SignRate)(nil), "proto.SignRate")
 	proto.RegisterType((*ValidatorApiResponse)(nil), "proto.ValidatorApiResponse")
@@ -414,71 +438,72 @@ func init() {
 func init() { proto.RegisterFile("peerAccountData.proto", fileDescriptor_26bd0314afcce126) }
 
 var fileDescriptor_26bd0314afcce126 = []byte{
-	// 1011 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4b, 0x73, 0xdb, 0x44,
-	0x1c, 0xb7, 0xda, 0x3a, 0x8f, 0xb5, 0x1d, 0x27, 0xdb, 0xa4, 0x95, 0x43, 0xa3, 0x4d, 0xdd, 0xa1,
-	0xe4, 0x40, 0x93, 0xe1, 0x31, 0xc3, 0x0c, 0x1c, 0xc0, 0x2a, 0x2d, 0x63, 0x48, 0x43, 0x66, 0x53,
-	0x98, 0x0e, 0xcc, 0x30, 0xb3, 0x96, 0xb6, 0x8a, 0x88, 0xac, 0xf5, 0x48, 0x2b, 0x97, 0xdc, 0xb8,
-	0x70, 0xe3, 0xc0, 0xc7, 0x60, 0xf8, 0x24, 0x3d, 0xe6, 0x98, 0xd3, 0x42, 0x9c, 0x03, 0xcc, 0x9e,
-	0xfa, 0x11, 0x18, 0xaf, 0x25, 0x47, 0xb2, 0x24, 0xa7, 0x27, 0x5b, 0xff, 0xdf, 0x63, 0xff, 0xfb,
-	0xfa, 0x2d, 0xd8, 0x18, 0x50, 0x1a, 0x74, 0x2c, 0x8b, 0x45, 0x3e, 0xff, 0x92, 0x70, 0xb2, 0x3b,
-	0x08, 0x18, 0x67, 0xb0, 0xaa, 0x7e, 0x36, 0x1f, 0x39, 0x2e, 0x3f, 0x8e, 0x7a, 0xbb, 0x16, 0xeb,
-	0xef, 0x39, 0xcc, 0x61, 0x7b, 0xaa, 0xdc, 0x8b, 0x5e, 0xaa, 0x2f, 0xf5, 0xa1, 0xfe, 0x4d, 0x54,
-	0xed, 0xaf, 0xc1, 0xd2, 0x91, 0xeb, 0xf8, 0x98, 0x70, 0x0a, 0x0d, 0x00, 0x0e, 0xa2, 0xfe, 0x51,
-	0x64, 0x59, 0x34, 0x0c, 0x75, 0x6d, 0x5b, 0xdb, 0x69, 0xe0, 0x54, 0x25, 0xc6, 0x9f, 0x12, 0xd7,
-	0x8b, 0x02, 0xaa, 0xdf, 0x98, 0xe2, 0x71, 0xa5, 0xfd, 0xef, 0x12, 0x58, 0xff, 0x9e, 0x78, 0xae,
-	0x4d, 0x38, 0x0b, 0x3a, 0x03, 0x17, 0xd3, 0x70, 0xc0, 0xfc, 0x90, 0xc2, 0x5d, 0x00, 0x9e, 0xd3,
-	0xfe, 0x00, 0x13, 0xee, 0xfa, 0x8e, 0x32, 0xbe, 0x61, 0xae, 0x48, 0x81, 0x00, 0x9f, 0x56, 0x71,
-	0x8a, 0x01, 0xbf, 0x00, 0xab, 0x07, 0x51, 0x7f, 0x9f, 0x12, 0x9b, 0x06, 0x49, 0x3b, 0x6a, 0x38,
-	0x73, 0x5d, 0x0a, 0xb4, 0xea, 0xcf, 0x60, 0x38, 0xc7, 0xce, 0x38, 0x24, 0x0d, 0xdf, 0x2c, 0x70,
-	0x88, 0x31, 0x9c, 0x63, 0xc3, 0x2e, 0xb8, 0x7d, 0x10, 0xf5, 0xa7, 0xd3, 0x49, 0xda, 0xb8, 0xa5,
-	0x4c, 0xee, 0x4a, 0x81, 0x6e, 0xfb, 0x79, 0x18, 0x17, 0x69, 0x66, 0xad, 0x92, 0x7e, 0xaa, 0xc5,
-	0x56, 0x49, 0x4b, 0x45, 0x1a, 0xe8, 0x80, 0xad, 0x74, 0xb9, 0xeb, 0xf8, 0x2c, 0xa0, 0xf6, 0x78,
-	0x07, 0x09, 0x8f, 0x02, 0x1a, 0xea, 0x0b, 0xca, 0xf4, 0xbe, 0x14, 0x68, 0xcb, 0x9f, 0x47, 0xc4,
-	0xf3, 0x7d, 0x60, 0x1b, 0x2c, 0xc4, 0xdb, 0xb5, 0xa8, 0xb6, 0x0b, 0x48, 0x81, 0x16, 0x82, 0xc9,
-	0x56, 0xc5, 0x08, 0xfc, 0x14, 0xac, 0x4c, 0xfe, 0x3d, 0x63, 0xb6, 0xfb, 0xd2, 0xa5, 0x81, 0xbe,
-	0xa4, 0xb8, 0x50, 0x0a, 0xb4, 0x12, 0x64, 0x10, 0x3c, 0xc3, 0x84, 0xdf, 0x82, 0x8d, 0xe7, 0x8c,
-	0x13, 0x2f, 0xb7, 0xcf, 0xcb, 0x6a, 0x02, 0x2d, 0x29, 0xd0, 0x06, 0x2f, 0x22, 0xe0, 0x62, 0x5d,
-	0xde, 0x30, 0x59, 0x66, 0x50, 0x66, 0x98, 0x2c, 0x74, 0xb1, 0x0e, 0xbe, 0x00, 0x7a, 0x02, 0xe4,
-	0x4e, 0x41, 0x4d, 0x79, 0xde, 0x93, 0x02, 0xe9, 0xbc, 0x84, 0x83, 0x4b, 0xd5, 0x85, 0xce, 0x49,
-	0xb7, 0xf5, 0x39, 0xce, 0x49, 0xc3, 0xa5, 0x6a, 0x38, 0x04, 0xed, 0x1c, 0x96, 0x3f, 0x23, 0x0d,
-	0x35, 0xc6, 0x43, 0x29, 0x50, 0x9b, 0x5f, 0xcb, 0xc6, 0x6f, 0xe1, 0x08, 0xdf, 0x05, 0x8b, 0x47,
-	0xc7, 0x24, 0xb0, 0xbb, 0xb6, 0xbe, 0xa2, 0xcc, 0x6b, 0x52, 0xa0, 0xc5, 0x70, 0x52, 0xc2, 0x09,
-	0x06, 0xbf, 0x02, 0xcd, 0xab, 0xc5, 0xe0, 0x84, 0x47, 0xa1, 0xde, 0xdc, 0xd6, 0x76, 0x96, 0xcd,
-	0x2d, 0x29, 0x50, 0x6b, 0x98, 0x85, 0xde, 0x67, 0x7d, 0x77, 0x9c, 0x0f, 0xfc, 0x14, 0xcf, 0xaa,
-	0xda, 0xbf, 0xd5, 0x40, 0xf3, 0x30, 0x9b, 0x82, 0xf0, 0x63, 0x50, 0x37, 0xf7, 0x8f, 0x0e, 0xa3,
-	0x9e, 0xe7, 0x5a, 0xdf, 0xd0, 0x53, 0x15, 0x33, 0x75, 0x73, 0x55, 0x0a, 0x54, 0xef, 0x79, 0xe1,
-	0xb4, 0x8e, 0x33, 0x2c, 0xd8, 0x01, 0x0d, 0x4c, 0x5f, 0x91, 0xc0, 0xee, 0xd8, 0x76, 0x90, 0xe4,
-	0x4c, 0xdd, 0x7c, 0x47, 0x0a, 0x74, 0x37, 0x48, 0x03, 0xa9, 0x76, 0xb2, 0x8a, 0xf4, 0xe4, 0x6f,
-	0xce, 0x99, 0x3c, 0x49, 0x85, 0x63, 0x72, 0x46, 0x08, 0xa7, 0x2a, 0x51, 0x6a, 0x1f, 0x36, 0x27,
-	0x79, 0xbc, 0x9b, 0x84, 0xb1, 0x79, 0xef, 0xb5, 0x40, 0x15, 0x29, 0xd0, 0xfa, 0xb0, 0x40, 0x84,
-	0x0b, 0xad, 0xe0, 0x0b, 0xb0, 0x96, 0xbd, 0x2b, 0x63, 0xff, 0x6a, 0xb1, 0x7f, 0x2b, 0xf6, 0x5f,
-	0xf3, 0x66, 0x15, 0x38, 0x6f, 0x02, 0x7f, 0x06, 0xc6, 0x9c, 0x23, 0x32, 0x1e, 0x66, 0x12, 0x3c,
-	0x6d, 0x29, 0x90, 0x31, 0x9c, 0xcb, 0xc4, 0xd7, 0x38, 0xcd, 0x44, 0x4f, 0xa3, 0x30, 0x7a, 0xb2,
-	0x2f, 0xca, 0x92, 0xe2, 0xcd, 0x7b, 0x51, 0x7e, 0xd7, 0x40, 0xb3, 0x63, 0x59, 0x51, 0x3f, 0xf2,
-	0x08, 0xa7, 0xf6, 0x53, 0x4a, 0x27, 0x49, 0x53, 0x37, 0x7b, 0xe3, 0xa3, 0x47, 0xb2, 0xd0, 0xd5,
-	0x5e, 0xff, 0xf5, 0x37, 0xea, 0xf4, 0x09, 0x3f, 0xde, 0xeb, 0xb9, 0xce, 0x6e, 0xd7, 0xe7, 0x9f,
-	0xa5, 0x5e, 0xd7, 0x27, 0x5e, 0xc0, 0x7c, 0xfb, 0x80, 0xf2, 0x57, 0x2c, 0x38, 0xd9, 0xa3, 0xea,
-	0xeb, 0x91, 0xc3, 0xf6, 0xec, 0xf1, 0x9b, 0x6c, 0xba, 0x4e, 0xd7, 0xe7, 0x8f, 0x49, 0xc8, 0x69,
-	0x80, 0x67, 0x87, 0x86, 0x3f, 0x81, 0xcd, 0xf1, 0xbb, 0x4a, 0x3d, 0x6a, 0x71, 0x6a, 0x77, 0xfd,
-	0x78, 0xa9, 0x4d, 0x8f, 0x59, 0x27, 0x61, 0x9c, 0x58, 0x86, 0x14, 0x68, 0xd3, 0x2f, 0x65, 0xe1,
-	0x39, 0x0e, 0xf0, 0x03, 0x50, 0xeb, 0xfa, 0x36, 0xfd, 0xa5, 0xeb, 0xef, 0xbb, 0x21, 0x8f, 0xe3,
-	0xaa, 0x29, 0x05, 0xaa, 0xb9, 0x57, 0x65, 0x9c, 0xe6, 0xc0, 0x87, 0xe0, 0x96, 0xe2, 0xd6, 0xd5,
-	0x85, 0x54, 0x11, 0xee, 0xb9, 0x21, 0x4f, 0x1d, 0x7b, 0x85, 0xc3, 0x1f, 0x41, 0xeb, 0xf1, 0xf8,
-	0x51, 0xb7, 0x22, 0xee, 0x0e, 0xe9, 0x61, 0xc0, 0x06, 0x2c, 0xa4, 0xc1, 0x33, 0x37, 0x0c, 0xa7,
-	0xc9, 0xa2, 0x6e, 0xb3, 0x55, 0x46, 0xc2, 0xe5, 0x7a, 0x38, 0x00, 0x2d, 0x95, 0x36, 0x85, 0x17,
-	0x65, 0xa5, 0xf8, 0x20, 0xdf, 0x8f, 0x0f, 0x72, 0x8b, 0x97, 0x29, 0x71, 0xb9, 0x29, 0x74, 0xc0,
-	0x1d, 0x05, 0xe6, 0xef, 0x4d, 0xb3, 0x78, 0x38, 0x23, 0x1e, 0xee, 0x0e, 0x2f, 0x94, 0xe1, 0x12,
-	0x3b, 0x78, 0x0a, 0x1e, 0x64, 0xbb, 0x28, 0xbe, 0x46, 0xab, 0x6a, 0x05, 0xdf, 0x93, 0x02, 0x3d,
-	0xe0, 0xd7, 0xd3, 0xf1, 0xdb, 0x78, 0x42, 0x04, 0xaa, 0x07, 0xcc, 0xb7, 0xa8, 0xbe, 0xb6, 0xad,
-	0xed, 0xdc, 0x32, 0x97, 0xa5, 0x40, 0x55, 0x7f, 0x5c, 0xc0, 0x93, 0x3a, 0xfc, 0x04, 0x34, 0xbe,
-	0xf3, 0x8f, 0x38, 0x39, 0xa1, 0xf6, 0x93, 0x01, 0xb3, 0x8e, 0x75, 0xa8, 0xba, 0x58, 0x93, 0x02,
-	0x35, 0xa2, 0x34, 0x80, 0xb3, 0x3c, 0xf3, 0xf3, 0xb3, 0x0b, 0xa3, 0x72, 0x7e, 0x61, 0x54, 0xde,
-	0x5c, 0x18, 0xda, 0xaf, 0x23, 0x43, 0xfb, 0x73, 0x64, 0x68, 0xaf, 0x47, 0x86, 0x76, 0x36, 0x32,
-	0xb4, 0xf3, 0x91, 0xa1, 0xfd, 0x33, 0x32, 0xb4, 0xff, 0x46, 0x46, 0xe5, 0xcd, 0xc8, 0xd0, 0xfe,
-	0xb8, 0x34, 0x2a, 0x67, 0x97, 0x46, 0xe5, 0xfc, 0xd2, 0xa8, 0xfc, 0x50, 0x0d, 0x39, 0xe1, 0xb4,
-	0xb7, 0xa0, 0x56, 0xf7, 0xa3, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x65, 0x57, 0x82, 0xc6, 0xd4,
-	0x0a, 0x00, 0x00,
+	// 1036 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0x8d, 0x56, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0xce, 0xb6, 0xd9, 0x7c, 0xcc, 0x7e, 0x4f, 0x92, 0xd6, 0x1b, 0x1a, 0x87, 0x6e, 0x45, 0xe1,
+	0x40, 0x37, 0xe2, 0x43, 0x42, 0x82, 0x03, 0xac, 0x43, 0x8a, 0x16, 0xd2, 0x10, 0x4d, 0x0a, 0x42,
+	0x20, 0x21, 0x79, 0xed, 0xa9, 0x63, 0xe2, 0x9d, 0xb1, 0xec, 0xf1, 0x96, 0xdc, 0xf8, 0x01, 0x1c,
+	0x38, 0xf3, 0x0b, 0x50, 0x7f, 0x49, 0x8f, 0x39, 0xe6, 0x54, 0x68, 0xb9, 0x20, 0x4e, 0xfc, 0x04,
+	0x5e, 0x8f, 0xed, 0x8d, 0xbd, 0xfe, 0x48, 0x0f, 0x23, 0xaf, 0xdf, 0xe7, 0x79, 0x9f, 0x79, 0x3d,
+	0xf3, 0xce, 0x33, 0x8b, 0xb6, 0x5c, 0x4a, 0xbd, 0x91, 0x61, 0xf0, 0x80, 0x89, 0xcf, 0x75, 0xa1,
+	0x0f, 0x5d, 0x8f, 0x0b, 0x8e, 0xeb, 0xf2, 0xb1, 0xfd, 0xc0, 0xb2, 0xc5, 0x69, 0x30, 0x19, 0x1a,
+	0x7c, 0xba, 0x67, 0x71, 0x8b, 0xef, 0xc9, 0xf0, 0x24, 0x78, 0x22, 0xdf, 0xe4, 0x8b, 0xfc, 0x15,
+	0x65, 0x0d, 0xbe, 0x44, 0x6b, 0x27, 0xb6, 0xc5, 0x88, 0x2e, 0x28, 0x56, 0x11, 0x3a, 0x0a, 0xa6,
+	0x27, 0x81, 0x61, 0x50, 0xdf, 0x57, 0x6a, 0x6f, 0xd6, 0xde, 0x69, 0x91, 0x54, 0x24, 0xc6, 0x1f,
+	0xea, 0xb6, 0x13, 0x78, 0x54, 0xb9, 0x31, 0xc7, 0xe3, 0xc8, 0xe0, 0xf7, 0x75, 0xb4, 0xf9, 0xad,
+	0xee, 0xd8, 0xa6, 0x2e, 0xb8, 0x37, 0x72, 0x6d, 0x42, 0x7d, 0x97, 0x33, 0x9f, 0xe2, 0x21, 0x42,
+	0x8f, 0xe9, 0xd4, 0x85, 0x49, 0x6c, 0x66, 0x49, 0xe1, 0x1b, 0x5a, 0xfb, 0xdf, 0x17, 0xbb, 0x48,
+	0xcc, 0xa3, 0x24, 0xc5, 0xc0, 0x9f, 0xa1, 0x2e, 0xc8, 0x1e, 0x52, 0xdd, 0xa4, 0x5e, 0x52, 0x8e,
+	0x9c, 0x4e, 0xdb, 0x84, 0xac, 0x2e, 0x5b, 0xc0, 0x48, 0x8e, 0x9d, 0x51, 0x48, 0x0a, 0xbe, 0x59,
+	0xa0, 0x10, 0x63, 0x24, 0xc7, 0xc6, 0x63, 0xb4, 0x01, 0xb1, 0xf9, 0xe7, 0x24, 0x65, 0x2c, 0x4b,
+	0x91, 0xdb, 0x20, 0xb2, 0xc1, 0xf2, 0x30, 0x29, 0xca, 0x59, 0x94, 0x4a, 0xea, 0xa9, 0x17, 0x4b,
+	0x25, 0x25, 0x15, 0xe5, 0x60, 0x0b, 0xed, 0xa4, 0xc3, 0x63, 0x8b, 0x71, 0x8f, 0x9a, 0xe1, 0x0e,
+	0xea, 0x02, 0x70, 0x5f, 0x59, 0x91, 0xa2, 0x77, 0x41, 0x74, 0x87, 0x55, 0x11, 0x49, 0xb5, 0x0e,
+	0x1e, 0xa0, 0x95, 0x78, 0xbb, 0x56, 0xe5, 0x76, 0x21, 0x50, 0x5c, 0xf1, 0xa2, 0xad, 0x8a, 0x11,
+	0xfc, 0x31, 0x6a, 0x47, 0xbf, 0x1e, 0x71, 0xd3, 0x7e, 0x62, 0x53, 0x4f, 0x59, 0x93, 0x5c, 0x0c,
+	0xdc, 0xb6, 0x97, 0x41, 0xc8, 0x02, 0x13, 0x7f, 0x8d, 0xb6, 0x1e, 0x73, 0xa1, 0x3b, 0xb9, 0x7d,
+	0x5e, 0x97, 0x1f, 0xd0, 0x07, 0x89, 0x2d, 0x51, 0x44, 0x20, 0xc5, 0x79, 0x79, 0xc1, 0x64, 0x99,
+	0x51, 0x99, 0x60, 0xb2, 0xd0, 0xc5, 0x79, 0xf8, 0x3b, 0xa4, 0x24, 0x40, 0xae, 0x0b, 0x1a, 0x52,
+	0xf3, 0x0e, 0x68, 0x2a, 0xa2, 0x84, 0x43, 0x4a, 0xb3, 0x0b, 0x95, 0x93, 0x6a, 0x9b, 0x15, 0xca,
+	0x49, 0xc1, 0xa5, 0xd9, 0x78, 0x86, 0x06, 0x39, 0x2c, 0xdf, 0x23, 0x2d, 0x39, 0xc7, 0x7d, 0x98,
+	0x63, 0x20, 0xae, 0x65, 0x93, 0xd7, 0x50, 0xc4, 0x6f, 0xa1, 0xd5, 0x93, 0x53, 0xdd, 0x33, 0xc7,
+	0xa6, 0xd2, 0x96, 0xe2, 0x0d, 0x10, 0x5f, 0xf5, 0xa3, 0x10, 0x49, 0x30, 0xfc, 0x05, 0xea, 0x5c,
+	0x2d, 0x86, 0x80, 0x5c, 0x5f, 0xe9, 0x00, 0x7d, 0x5d, 0xdb, 0x01, 0x7a, 0x7f, 0x96, 0x85, 0xde,
+	0xe5, 0x53, 0x3b, 0xf4, 0x07, 0x71, 0x4e, 0x16, 0xb3, 0xf0, 0x7b, 0xa8, 0x31, 0x66, 0xc7, 0x1e,
+	0x9f, 0x40, 0x53, 0x71, 0xa6, 0x74, 0x41, 0x64, 0x4d, 0xeb, 0x80, 0x48, 0xc3, 0xbe, 0x0a, 0x93,
+	0x34, 0x67, 0xf0, 0xac, 0x89, 0x3a, 0xc7, 0x59, 0xe3, 0xc4, 0x1f, 0xa2, 0xa6, 0x76, 0x78, 0x72,
+	0x1c, 0x4c, 0x1c, 0xdb, 0xf8, 0x8a, 0x9e, 0x4b, 0x67, 0x6a, 0x6a, 0x5d, 0xd0, 0x69, 0x4e, 0x1c,
+	0x7f, 0x1e, 0x27, 0x19, 0x16, 0x1e, 0xa1, 0x16, 0xa1, 0x4f, 0xe1, 0x8b, 0x46, 0xa6, 0xe9, 0x25,
+	0xd6, 0xd4, 0xd4, 0xde, 0x80, 0xb4, 0xdb, 0x5e, 0x1a, 0x48, 0x7d, 0x41, 0x36, 0x23, 0xbd, 0x5e,
+	0x37, 0x2b, 0xd6, 0x4b, 0x4f, 0xf9, 0x69, 0xd2, 0x56, 0x60, 0xd4, 0xd2, 0x84, 0x1a, 0xef, 0x77,
+	0x22, 0x0b, 0x1f, 0x26, 0xfe, 0xad, 0xdd, 0x79, 0xfe, 0x62, 0x77, 0x09, 0x84, 0x36, 0x67, 0x05,
+	0x49, 0xa4, 0x50, 0x0a, 0x7a, 0xb1, 0x97, 0x3d, 0x5e, 0xa1, 0x7e, 0xbd, 0x58, 0xbf, 0x1f, 0xeb,
+	0xf7, 0x9c, 0xc5, 0x0c, 0x92, 0x17, 0xc1, 0x3f, 0x21, 0xb5, 0xa2, 0xab, 0xc2, 0x69, 0x22, 0xaf,
+	0x1a, 0x80, 0xa2, 0x3a, 0xab, 0x64, 0x92, 0x6b, 0x94, 0x16, 0xdc, 0xaa, 0x55, 0xe8, 0x56, 0xd9,
+	0x4b, 0x68, 0x4d, 0xf2, 0xaa, 0x2e, 0xa1, 0x5f, 0x6b, 0xa8, 0x03, 0xcd, 0x12, 0x4c, 0x03, 0x07,
+	0xa6, 0x30, 0x1f, 0x52, 0x1a, 0x99, 0x53, 0x53, 0x9b, 0x84, 0xdd, 0xaa, 0x67, 0xa1, 0xab, 0xbd,
+	0x7e, 0xf6, 0xe7, 0xee, 0x68, 0xaa, 0x8b, 0xd3, 0xbd, 0x89, 0x6d, 0x0d, 0xc7, 0x4c, 0x7c, 0x92,
+	0xba, 0x90, 0x0f, 0x1c, 0x8f, 0x33, 0xf3, 0x88, 0x8a, 0xa7, 0xdc, 0x3b, 0xdb, 0xa3, 0xf2, 0xed,
+	0x01, 0xdc, 0xd1, 0x66, 0x78, 0x8d, 0x6b, 0xb6, 0x05, 0xf4, 0x7d, 0xdd, 0x17, 0xe0, 0x97, 0x8b,
+	0x53, 0xe3, 0x1f, 0xd1, 0x76, 0x78, 0x15, 0x53, 0x87, 0x1a, 0x10, 0x1a, 0xb3, 0x78, 0xa9, 0x35,
+	0x87, 0x1b, 0x67, 0x7e, 0x6c, 0x72, 0x2a, 0x14, 0xb6, 0xcd, 0x4a, 0x59, 0xa4, 0x42, 0x21, 0x3a,
+	0x52, 0x26, 0xfd, 0x79, 0xcc, 0x0e, 0x6d, 0x5f, 0xc4, 0x0e, 0x17, 0x1f, 0xa9, 0x79, 0x98, 0xa4,
+	0x39, 0xf8, 0x3e, 0x5a, 0x96, 0xdc, 0xa6, 0x3c, 0xc3, 0xd2, 0xf5, 0x1d, 0x78, 0x4f, 0xb5, 0xbd,
+	0xc4, 0xf1, 0x0f, 0xa8, 0xbf, 0x1f, 0xfe, 0x0f, 0x30, 0x02, 0x61, 0xcf, 0x28, 0x1c, 0x49, 0x97,
+	0xfb, 0xd4, 0x7b, 0x64, 0xfb, 0xfe, 0xdc, 0x8c, 0xa4, 0x01, 0x18, 0x65, 0x24, 0x52, 0x9e, 0x8f,
+	0x5d, 0xd4, 0x97, 0x06, 0x55, 0x78, 0x50, 0xda, 0xc5, 0x8d, 0x7c, 0x37, 0x6e, 0xe4, 0xbe, 0x28,
+	0xcb, 0x24, 0xe5, 0xa2, 0x70, 0x07, 0xdf, 0x92, 0x60, 0xfe, 0xdc, 0x74, 0x8a, 0xa7, 0x53, 0xe3,
+	0xe9, 0x6e, 0x89, 0xc2, 0x34, 0x52, 0x22, 0x87, 0xcf, 0xd1, 0xbd, 0x6c, 0x15, 0xc5, 0xc7, 0xa8,
+	0x2b, 0x57, 0xf0, 0x6d, 0x98, 0xe0, 0x9e, 0xb8, 0x9e, 0x4e, 0x5e, 0x47, 0x13, 0xef, 0xa2, 0xfa,
+	0x11, 0x67, 0x06, 0x55, 0x7a, 0x20, 0xbe, 0xac, 0xad, 0x83, 0x78, 0x9d, 0x85, 0x01, 0x12, 0xc5,
+	0xf1, 0x47, 0xa8, 0xf5, 0x0d, 0x03, 0x37, 0x3e, 0xa3, 0xe6, 0x81, 0xcb, 0x8d, 0x53, 0x05, 0xcb,
+	0x2a, 0x7a, 0x40, 0x6c, 0x05, 0x69, 0x80, 0x64, 0x79, 0x61, 0x1f, 0xa7, 0x36, 0x73, 0x5e, 0x46,
+	0xdc, 0x0d, 0x1b, 0x57, 0x7d, 0x6c, 0x94, 0xb2, 0x48, 0x85, 0x02, 0xde, 0x47, 0xbd, 0xb9, 0xe9,
+	0x1f, 0xb0, 0xb8, 0xb8, 0x4d, 0x29, 0xbb, 0x15, 0x7a, 0x97, 0xbb, 0x08, 0x92, 0x3c, 0x5f, 0xfb,
+	0xf4, 0xe2, 0xa5, 0xba, 0x74, 0x09, 0xe3, 0xbf, 0x97, 0x6a, 0xed, 0x97, 0x57, 0x6a, 0xed, 0x0f,
+	0x18, 0xcf, 0x61, 0x5c, 0xc0, 0xb8, 0x84, 0xf1, 0x17, 0x8c, 0x7f, 0x5e, 0x01, 0x0e, 0xcf, 0xdf,
+	0xfe, 0x56, 0x97, 0x2e, 0x60, 0x5c, 0xc2, 0xf8, 0xbe, 0xee, 0xc3, 0x0d, 0x45, 0x27, 0x2b, 0xb2,
+	0x05, 0x3e, 0xf8, 0x1f, 0x1c, 0xfc, 0x43, 0xe8, 0xac, 0x0b, 0x00, 0x00,
 }
 
 func (this *SignRate) Equal(that interface{}) bool {
@@ -572,6 +597,9 @@ func (this *ValidatorApiResponse) Equal(that interface{}) bool {
 	if this.ValidatorStatus != that1.ValidatorStatus {
 		return false
 	}
+	if this.InProbation != that1.InProbation {
+		return false
+	}
 	return true
 }
 func (this *PeerAccountData) Equal(that interface{}) bool {
@@ -650,6 +678,12 @@ func (this *PeerAccountData) Equal(that interface{}) bool {
 	if this.UnStakedEpoch != that1.UnStakedEpoch {
 		return false
 	}
+	if this.ConsecutiveValidatorMisses != that1.ConsecutiveValidatorMisses {
+		return false
+	}
+	if this.ProbationEndEpoch != that1.ProbationEndEpoch {
+		return false
+	}
 	return true
 }
 func (this *SignRate) GoString() string {
@@ -667,7 +701,7 @@ func (this *ValidatorApiResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 19)
+	s := make([]string, 0, 20)
 	s = append(s, "&state.ValidatorApiResponse{")
 	s = append(s, "TempRating: "+fmt.Sprintf("%#v", this.TempRating)+",\n")
 	s = append(s, "NumLeaderSuccess: "+fmt.Sprintf("%#v", this.NumLeaderSuccess)+",\n")
@@ -684,6 +718,7 @@ func (this *ValidatorApiResponse) GoString() string {
 	s = append(s, "TotalNumValidatorIgnoredSignatures: "+fmt.Sprintf("%#v", this.TotalNumValidatorIgnoredSignatures)+",\n")
 	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
 	s = append(s, "ValidatorStatus: "+fmt.Sprintf("%#v", this.ValidatorStatus)+",\n")
+	s = append(s, "InProbation: "+fmt.Sprintf("%#v", this.InProbation)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -691,7 +726,7 @@ func (this *PeerAccountData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 22)
+	s := make([]string, 0, 24)
 	s = append(s, "&state.PeerAccountData{")
 	s = append(s, "BLSPublicKey: "+fmt.Sprintf("%#v", this.BLSPublicKey)+",\n")
 	s = append(s, "RewardAddress: "+fmt.Sprintf("%#v", this.RewardAddress)+",\n")
@@ -711,6 +746,8 @@ func (this *PeerAccountData) GoString() string {
 	s = append(s, "TotalValidatorIgnoredSignaturesRate: "+fmt.Sprintf("%#v", this.TotalValidatorIgnoredSignaturesRate)+",\n")
 	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
 	s = append(s, "UnStakedEpoch: "+fmt.Sprintf("%#v", this.UnStakedEpoch)+",\n")
+	s = append(s, "ConsecutiveValidatorMisses: "+fmt.Sprintf("%#v", this.ConsecutiveValidatorMisses)+",\n")
+	s = append(s, "ProbationEndEpoch: "+fmt.Sprintf("%#v", this.ProbationEndEpoch)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -775,6 +812,18 @@ func (m *ValidatorApiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InProbation {
+		i--
+		if m.InProbation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if len(m.ValidatorStatus) > 0 {
 		i -= len(m.ValidatorStatus)
 		copy(dAtA[i:], m.ValidatorStatus)
@@ -878,6 +927,20 @@ func (m *PeerAccountData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProbationEndEpoch != 0 {
+		i = encodeVarintPeerAccountData(dAtA, i, uint64(m.ProbationEndEpoch))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.ConsecutiveValidatorMisses != 0 {
+		i = encodeVarintPeerAccountData(dAtA, i, uint64(m.ConsecutiveValidatorMisses))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
 	if m.UnStakedEpoch != 0 {
 		i = encodeVarintPeerAccountData(dAtA, i, uint64(m.UnStakedEpoch))
 		i--
@@ -1087,6 +1150,9 @@ func (m *ValidatorApiResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPeerAccountData(uint64(l))
 	}
+	if m.InProbation {
+		n += 3
+	}
 	return n
 }
 
@@ -1151,6 +1217,12 @@ func (m *PeerAccountData) Size() (n int) {
 	if m.UnStakedEpoch != 0 {
 		n += 2 + sovPeerAccountData(uint64(m.UnStakedEpoch))
 	}
+	if m.ConsecutiveValidatorMisses != 0 {
+		n += 2 + sovPeerAccountData(uint64(m.ConsecutiveValidatorMisses))
+	}
+	if m.ProbationEndEpoch != 0 {
+		n += 2 + sovPeerAccountData(uint64(m.ProbationEndEpoch))
+	}
 	return n
 }
 
@@ -1191,6 +1263,7 @@ func (this *ValidatorApiResponse) String() string {
 		`TotalNumValidatorIgnoredSignatures:` + fmt.Sprintf("%v", this.TotalNumValidatorIgnoredSignatures) + `,`,
 		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
 		`ValidatorStatus:` + fmt.Sprintf("%v", this.ValidatorStatus) + `,`,
+		`InProbation:` + fmt.Sprintf("%v", this.InProbation) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1218,6 +1291,8 @@ func (this *PeerAccountData) String() string {
 		`TotalValidatorIgnoredSignaturesRate:` + fmt.Sprintf("%v", this.TotalValidatorIgnoredSignaturesRate) + `,`,
 		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
 		`UnStakedEpoch:` + fmt.Sprintf("%v", this.UnStakedEpoch) + `,`,
+		`ConsecutiveValidatorMisses:` + fmt.Sprintf("%v", this.ConsecutiveValidatorMisses) + `,`,
+		`ProbationEndEpoch:` + fmt.Sprintf("%v", this.ProbationEndEpoch) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1624,6 +1699,26 @@ func (m *ValidatorApiResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InProbation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerAccountData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InProbation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPeerAccountData(dAtA[iNdEx:])
@@ -2137,6 +2232,44 @@ func (m *PeerAccountData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveValidatorMisses", wireType)
+			}
+			m.ConsecutiveValidatorMisses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerAccountData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveValidatorMisses |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbationEndEpoch", wireType)
+			}
+			m.ProbationEndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerAccountData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProbationEndEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPeerAccountData(dAtA[iNdEx:])
@@ -51,6 +51,7 @@ type ValidatorInfo struct {
 	TotalValidatorSuccess           uint32        `protobuf:"varint,18,opt,name=TotalValidatorSuccess,proto3" json:"totalValidatorSuccess"`
 	TotalValidatorFailure           uint32        `protobuf:"varint,19,opt,name=TotalValidatorFailure,proto3" json:"totalValidatorFailure"`
 	TotalValidatorIgnoredSignatures uint32        `protobuf:"varint,20,opt,name=TotalValidatorIgnoredSignatures,proto3" json:"totalValidatorIgnoredSignatures"`
+	ProbationEndEpoch               uint32        `protobuf:"varint,21,opt,name=ProbationEndEpoch,proto3" json:"probationEndEpoch"`
 }
 
 func (m *ValidatorInfo) Reset()      { *m = ValidatorInfo{} }
@@ -221,6 +222,13 @@ func (m *ValidatorInfo) GetTotalValidatorIgnoredSignatures() uint32 {
 	return 0
 }
 
+func (m *ValidatorInfo) GetProbationEndEpoch() uint32 {
+	if m != nil {
+		return m.ProbationEndEpoch
+	}
+	return 0
+}
+
 // ShardValidatorInfo represents the data regarding a validator that is stored in the PeerMiniblocks
 type ShardValidatorInfo struct {
 	PublicKey  []byte `protobuf:"bytes,1,opt,name=PublicKey,proto3" json:"publicKey"`
@@ -301,52 +309,52 @@ func init() {
 func init() { proto.RegisterFile("validatorInfo.proto", fileDescriptor_bf9cdc082f0b2ec2) }
 
 var fileDescriptor_bf9cdc082f0b2ec2 = []byte{
-	// 711 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xc1, 0x4e, 0x13, 0x41,
-	0x18, 0xc7, 0xbb, 0x48, 0x0b, 0x1d, 0x68, 0x81, 0x01, 0x74, 0x41, 0xb3, 0xd3, 0x60, 0x34, 0x4d,
-	0x94, 0xf6, 0xe0, 0xc1, 0x44, 0x0f, 0xda, 0x1a, 0x49, 0x1a, 0x11, 0xcd, 0x94, 0x78, 0xf0, 0x60,
-	0x32, 0xdd, 0x1d, 0x96, 0x0d, 0xdb, 0x1d, 0x32, 0x3b, 0x0b, 0x72, 0xf3, 0x01, 0x3c, 0xf8, 0x18,
-	0xc6, 0x27, 0xf1, 0xc8, 0x91, 0xc4, 0x64, 0xb4, 0xcb, 0xc5, 0xcc, 0x89, 0x47, 0x30, 0x9d, 0x76,
-	0x69, 0xb7, 0x2d, 0x78, 0xe2, 0xd4, 0x9d, 0xef, 0xff, 0xff, 0xff, 0xe6, 0x6b, 0x67, 0xfa, 0x2d,
-	0x58, 0x3e, 0x22, 0xbe, 0xe7, 0x10, 0xc1, 0x78, 0x23, 0xd8, 0x63, 0x95, 0x43, 0xce, 0x04, 0x83,
-	0x59, 0xfd, 0xb1, 0xbe, 0xe9, 0x7a, 0x62, 0x3f, 0x6a, 0x55, 0x6c, 0xd6, 0xae, 0xba, 0xcc, 0x65,
-	0x55, 0x5d, 0x6e, 0x45, 0x7b, 0x7a, 0xa5, 0x17, 0xfa, 0xa9, 0x97, 0xda, 0xf8, 0x05, 0x40, 0xe1,
-	0xc3, 0x30, 0x0d, 0x3e, 0x02, 0xf9, 0xf7, 0x51, 0xcb, 0xf7, 0xec, 0x37, 0xf4, 0xc4, 0x34, 0x4a,
-	0x46, 0x79, 0xbe, 0x5e, 0x50, 0x12, 0xe5, 0x0f, 0x93, 0x22, 0x1e, 0xe8, 0xf0, 0x01, 0x98, 0x69,
-	0xee, 0x13, 0xee, 0x34, 0x1c, 0x73, 0xaa, 0x64, 0x94, 0x0b, 0xf5, 0x39, 0x25, 0xd1, 0x4c, 0xd8,
-	0x2b, 0xe1, 0x44, 0x83, 0xf7, 0xc0, 0xf4, 0xb6, 0x17, 0x0a, 0xf3, 0x56, 0xc9, 0x28, 0xe7, 0xeb,
-	0xb3, 0x4a, 0xa2, 0x69, 0xdf, 0x0b, 0x05, 0xd6, 0x55, 0x88, 0x40, 0xb6, 0x11, 0x38, 0xf4, 0xb3,
-	0x39, 0xad, 0x11, 0x79, 0x25, 0x51, 0xd6, 0xeb, 0x16, 0x70, 0xaf, 0x0e, 0x2b, 0x00, 0xec, 0xd2,
-	0xf6, 0x21, 0x26, 0xc2, 0x0b, 0x5c, 0x33, 0xab, 0x5d, 0x45, 0x25, 0x11, 0x10, 0x97, 0x55, 0x3c,
-	0xe4, 0x80, 0x1b, 0x20, 0xd7, 0xf7, 0xe6, 0xb4, 0x17, 0x28, 0x89, 0x72, 0xbc, 0xe7, 0xeb, 0x2b,
-	0xf0, 0x19, 0x28, 0xf6, 0x9e, 0xde, 0x32, 0xc7, 0xdb, 0xf3, 0x28, 0x37, 0x67, 0x4a, 0x46, 0x79,
-	0xaa, 0x0e, 0x95, 0x44, 0x45, 0x9e, 0x52, 0xf0, 0x88, 0x13, 0xd6, 0x40, 0x01, 0xd3, 0x63, 0xc2,
-	0x9d, 0x9a, 0xe3, 0x70, 0x1a, 0x86, 0xe6, 0xac, 0xfe, 0x99, 0xee, 0x2a, 0x89, 0xee, 0xf0, 0x61,
-	0xe1, 0x31, 0x6b, 0x7b, 0xdd, 0x1e, 0xc5, 0x09, 0x4e, 0x27, 0xe0, 0x53, 0x50, 0xd8, 0xa6, 0xc4,
-	0xa1, 0xbc, 0x19, 0xd9, 0x76, 0x17, 0x91, 0xd7, 0x9d, 0x2e, 0x29, 0x89, 0x0a, 0xfe, 0xb0, 0x80,
-	0xd3, 0xbe, 0x41, 0x70, 0x8b, 0x78, 0x7e, 0xc4, 0xa9, 0x09, 0x46, 0x83, 0x7d, 0x01, 0xa7, 0x7d,
-	0xf0, 0x25, 0x58, 0xbc, 0x3c, 0xe8, 0x64, 0xd3, 0x39, 0x9d, 0x5d, 0x51, 0x12, 0x2d, 0x1e, 0x8d,
-	0x68, 0x78, 0xcc, 0x9d, 0x22, 0x24, 0xbb, 0xcf, 0x4f, 0x20, 0x24, 0x0d, 0x8c, 0xb9, 0xe1, 0x27,
-	0xb0, 0x3e, 0xb8, 0x6c, 0x6e, 0xc0, 0x38, 0x75, 0x9a, 0x9e, 0x1b, 0x10, 0x11, 0x71, 0x1a, 0x9a,
-	0x05, 0xcd, 0xb2, 0x94, 0x44, 0xeb, 0x47, 0x57, 0xba, 0xf0, 0x35, 0x84, 0x2e, 0x7f, 0x27, 0x6a,
-	0x37, 0xa9, 0x4f, 0x6d, 0x41, 0x9d, 0x46, 0xd0, 0xef, 0xbc, 0xee, 0x33, 0xfb, 0x20, 0x34, 0x8b,
-	0x03, 0x7e, 0x70, 0xa5, 0x0b, 0x5f, 0x43, 0x80, 0x5f, 0x0d, 0xb0, 0x50, 0xb3, 0xed, 0xa8, 0x1d,
-	0xf9, 0x44, 0x50, 0x67, 0x8b, 0xd2, 0xd0, 0x5c, 0xd0, 0x67, 0xdf, 0x52, 0x12, 0xad, 0x91, 0xb4,
-	0x34, 0x38, 0xfd, 0x1f, 0xbf, 0x51, 0xad, 0x4d, 0xc4, 0x7e, 0xb5, 0xe5, 0xb9, 0x95, 0x46, 0x20,
-	0x9e, 0x0f, 0xfd, 0x49, 0x5f, 0xfb, 0x9c, 0x05, 0xce, 0x0e, 0x15, 0xc7, 0x8c, 0x1f, 0x54, 0xa9,
-	0x5e, 0x6d, 0xba, 0xac, 0xea, 0x10, 0x41, 0x2a, 0x75, 0xcf, 0x6d, 0x04, 0xe2, 0x15, 0x09, 0x05,
-	0xe5, 0x78, 0x74, 0x6b, 0xb8, 0x05, 0xe0, 0x2e, 0x13, 0xc4, 0x4f, 0xdf, 0xa4, 0x45, 0xfd, 0x35,
-	0x6f, 0x2b, 0x89, 0xa0, 0x18, 0x53, 0xf1, 0x84, 0xc4, 0x08, 0x27, 0x39, 0xda, 0xa5, 0x89, 0x9c,
-	0xe4, 0x70, 0x27, 0x24, 0xe0, 0x3b, 0xb0, 0xaa, 0xab, 0x63, 0xf7, 0x0c, 0x6a, 0xd4, 0x9a, 0x92,
-	0x68, 0x55, 0x4c, 0x32, 0xe0, 0xc9, 0xb9, 0x71, 0x60, 0xd2, 0xdb, 0xf2, 0x55, 0xc0, 0xa4, 0xbd,
-	0xc9, 0x39, 0xd8, 0x06, 0x28, 0x2d, 0x8c, 0xdf, 0xc2, 0x15, 0x8d, 0xbe, 0xaf, 0x24, 0x42, 0xe2,
-	0x7a, 0x2b, 0xfe, 0x1f, 0x6b, 0xa3, 0x63, 0x00, 0xa8, 0x67, 0xe0, 0xcd, 0x8f, 0xd8, 0x87, 0xa9,
-	0x11, 0xab, 0xa7, 0x58, 0x77, 0xc4, 0x0e, 0x4d, 0xa0, 0x9b, 0x19, 0xb6, 0xf5, 0x17, 0xa7, 0x1d,
-	0x2b, 0x73, 0xd6, 0xb1, 0x32, 0x17, 0x1d, 0xcb, 0xf8, 0x12, 0x5b, 0xc6, 0xf7, 0xd8, 0x32, 0x7e,
-	0xc6, 0x96, 0x71, 0x1a, 0x5b, 0xc6, 0x59, 0x6c, 0x19, 0x7f, 0x62, 0xcb, 0xf8, 0x1b, 0x5b, 0x99,
-	0x8b, 0xd8, 0x32, 0xbe, 0x9d, 0x5b, 0x99, 0xd3, 0x73, 0x2b, 0x73, 0x76, 0x6e, 0x65, 0x3e, 0x66,
-	0x43, 0x41, 0x04, 0x6d, 0xe5, 0xf4, 0x9b, 0xe8, 0xc9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x58,
-	0xd8, 0xc1, 0xe6, 0xd6, 0x06, 0x00, 0x00,
+	// 710 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xb5, 0x95, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x9b, 0xd2, 0xa4, 0xcd, 0xb5, 0x4e, 0x9b, 0x6b, 0x03, 0x6e, 0x41, 0x09, 0x2a, 0x02,
+	0x21, 0x41, 0x93, 0x81, 0x01, 0x09, 0x06, 0x88, 0xab, 0x56, 0x8a, 0x28, 0xa5, 0xba, 0x54, 0x1d,
+	0x18, 0x90, 0xce, 0xf6, 0xd5, 0xb1, 0x6a, 0xfb, 0xa2, 0xf3, 0xb9, 0xa5, 0x1b, 0x1f, 0x80, 0x81,
+	0x8f, 0xc0, 0x88, 0xf8, 0x24, 0x8c, 0x1d, 0x3b, 0x01, 0x2d, 0x0b, 0x62, 0xe2, 0x23, 0xf0, 0x72,
+	0x89, 0x9b, 0x38, 0x76, 0xcb, 0xd4, 0xe1, 0xc9, 0xf6, 0xfb, 0xff, 0xde, 0xff, 0x9e, 0x73, 0xe7,
+	0x17, 0xb4, 0x78, 0x48, 0x3d, 0xd7, 0xa6, 0x92, 0x8b, 0x56, 0xb0, 0xcf, 0xeb, 0x5d, 0xc1, 0x25,
+	0xc7, 0x79, 0x75, 0x59, 0x59, 0x73, 0x5c, 0xd9, 0x89, 0xcc, 0xba, 0xc5, 0xfd, 0x86, 0xc3, 0x1d,
+	0xde, 0x50, 0x69, 0x33, 0xda, 0x57, 0x4f, 0xea, 0x41, 0xdd, 0xf5, 0xab, 0x56, 0x3f, 0xcf, 0x22,
+	0x6d, 0x6f, 0xd4, 0x0d, 0x3f, 0x42, 0xc5, 0x9d, 0xc8, 0xf4, 0x5c, 0xeb, 0x15, 0x3b, 0xd6, 0x73,
+	0x77, 0x73, 0x0f, 0xe7, 0x0c, 0xed, 0xcf, 0xf7, 0x5a, 0xb1, 0x1b, 0x27, 0xc9, 0x50, 0xc7, 0xf7,
+	0xd1, 0x74, 0xbb, 0x43, 0x85, 0xdd, 0xb2, 0xf5, 0x49, 0x40, 0x35, 0x63, 0x16, 0xd0, 0xe9, 0xb0,
+	0x9f, 0x22, 0xb1, 0x86, 0xef, 0xa0, 0xa9, 0x2d, 0x37, 0x94, 0xfa, 0x0d, 0x60, 0x8a, 0xc6, 0x0c,
+	0x30, 0x53, 0x1e, 0x3c, 0x13, 0x95, 0xc5, 0x35, 0x94, 0x6f, 0x05, 0x36, 0x7b, 0xaf, 0x4f, 0x29,
+	0x8b, 0x22, 0xc8, 0x79, 0xb7, 0x97, 0x20, 0xfd, 0x3c, 0xae, 0x23, 0xb4, 0xcb, 0xfc, 0x2e, 0xa1,
+	0xd2, 0x0d, 0x1c, 0x3d, 0xaf, 0xa8, 0x12, 0x50, 0x48, 0x5e, 0x64, 0xc9, 0x08, 0x81, 0x57, 0x51,
+	0x61, 0xc0, 0x16, 0x14, 0x8b, 0x80, 0x2d, 0x88, 0x3e, 0x37, 0x50, 0xf0, 0x33, 0x54, 0xea, 0xdf,
+	0xbd, 0xe6, 0xb6, 0xbb, 0xef, 0x32, 0xa1, 0x4f, 0x03, 0x3b, 0x69, 0x60, 0x60, 0x4b, 0x22, 0xa1,
+	0x90, 0x31, 0x12, 0x37, 0x91, 0x46, 0xd8, 0x11, 0xbc, 0x5a, 0xd3, 0xb6, 0x05, 0x0b, 0x43, 0x7d,
+	0x46, 0xfd, 0x4c, 0xb7, 0xa1, 0xf4, 0x96, 0x18, 0x15, 0x1e, 0x73, 0xdf, 0xed, 0xf5, 0x28, 0x8f,
+	0x49, 0xb2, 0x02, 0x3f, 0x45, 0xda, 0x16, 0xa3, 0x36, 0x13, 0xed, 0xc8, 0xb2, 0x7a, 0x16, 0x45,
+	0xd5, 0x69, 0x19, 0x2c, 0x34, 0x6f, 0x54, 0x20, 0x49, 0x6e, 0x58, 0xb8, 0x49, 0x5d, 0x2f, 0x12,
+	0x4c, 0x47, 0xe3, 0x85, 0x03, 0x81, 0x24, 0x39, 0xfc, 0x12, 0x2d, 0x5c, 0x6c, 0x74, 0xbc, 0xe8,
+	0xac, 0xaa, 0x5d, 0x82, 0xda, 0x85, 0xc3, 0x31, 0x8d, 0xa4, 0xe8, 0x84, 0x43, 0xbc, 0xfa, 0x5c,
+	0x86, 0x43, 0xdc, 0x40, 0x8a, 0xc6, 0xef, 0xd0, 0xca, 0xf0, 0xb0, 0x39, 0x01, 0x17, 0xcc, 0x6e,
+	0xbb, 0x4e, 0x40, 0x25, 0x88, 0xa1, 0xae, 0x29, 0xaf, 0x2a, 0x78, 0xad, 0x1c, 0x5e, 0x4a, 0x91,
+	0x2b, 0x1c, 0x7a, 0xfe, 0xdb, 0x91, 0xdf, 0x66, 0x1e, 0xb3, 0x24, 0xb3, 0x5b, 0xc1, 0xa0, 0x73,
+	0xc3, 0xe3, 0xd6, 0x41, 0xa8, 0x97, 0x86, 0xfe, 0xc1, 0xa5, 0x14, 0xb9, 0xc2, 0x01, 0x7f, 0xcc,
+	0xa1, 0xf9, 0xa6, 0x65, 0x45, 0x7e, 0xe4, 0x51, 0x90, 0x37, 0x19, 0x74, 0x3d, 0xaf, 0xf6, 0xde,
+	0x04, 0xd7, 0x65, 0x9a, 0x94, 0x86, 0xbb, 0xff, 0xf5, 0x47, 0xad, 0xe9, 0x53, 0xd9, 0x69, 0x98,
+	0xae, 0x53, 0x6f, 0x05, 0xf2, 0xf9, 0xc8, 0x47, 0xba, 0xe1, 0x09, 0x1e, 0xd8, 0xdb, 0x4c, 0x1e,
+	0x71, 0x71, 0xd0, 0x60, 0xea, 0x69, 0x0d, 0xbe, 0x5b, 0x78, 0x45, 0x5a, 0x37, 0x5c, 0x07, 0xf0,
+	0x75, 0x1a, 0x4a, 0x38, 0x86, 0xe3, 0x4b, 0xe3, 0x4d, 0x84, 0x77, 0xb9, 0xa4, 0x5e, 0xf2, 0x24,
+	0x2d, 0xa8, 0xd7, 0xbc, 0x09, 0x0d, 0x61, 0x99, 0x52, 0x49, 0x46, 0xc5, 0x98, 0x4f, 0xbc, 0xb5,
+	0xe5, 0x4c, 0x9f, 0x78, 0x73, 0x33, 0x2a, 0xf0, 0x1b, 0x54, 0x51, 0xd9, 0xd4, 0x39, 0xc3, 0xca,
+	0x6a, 0x19, 0xac, 0x2a, 0x32, 0x0b, 0x20, 0xd9, 0x75, 0x69, 0xc3, 0xb8, 0xb7, 0xc5, 0xcb, 0x0c,
+	0xe3, 0xf6, 0xb2, 0xeb, 0xb0, 0x8f, 0x6a, 0x49, 0x21, 0x7d, 0x0a, 0x97, 0x94, 0xf5, 0x3d, 0xb0,
+	0xae, 0xc9, 0xab, 0x51, 0xf2, 0x3f, 0x2f, 0xbc, 0x8e, 0xca, 0x3b, 0x82, 0x9b, 0x30, 0x3d, 0x78,
+	0xb0, 0x11, 0xd8, 0x1b, 0x5d, 0x6e, 0x75, 0xf4, 0x8a, 0x5a, 0xa0, 0x02, 0x0b, 0x94, 0xbb, 0xe3,
+	0x22, 0x49, 0xf3, 0xab, 0x67, 0x39, 0x84, 0xd5, 0x20, 0xbd, 0xfe, 0x39, 0xfd, 0x20, 0x31, 0xa7,
+	0xd5, 0x28, 0xec, 0xcd, 0xe9, 0x91, 0x31, 0x76, 0x3d, 0x13, 0xdb, 0x78, 0x71, 0x72, 0x56, 0x9d,
+	0x38, 0x85, 0xf8, 0x7b, 0x56, 0xcd, 0x7d, 0x38, 0xaf, 0xe6, 0xbe, 0x40, 0x7c, 0x83, 0x38, 0x81,
+	0x38, 0x85, 0xf8, 0x09, 0xf1, 0xfb, 0x1c, 0x74, 0xb8, 0x7e, 0xfa, 0x55, 0x9d, 0x38, 0x81, 0x38,
+	0x85, 0x78, 0x9b, 0x0f, 0x25, 0x7c, 0x0f, 0x66, 0x41, 0xfd, 0x9d, 0x3d, 0xf9, 0x07, 0x84, 0xcd,
+	0x0a, 0xbc, 0x1b, 0x07, 0x00, 0x00,
 }
 
 func (this *ValidatorInfo) Equal(that interface{}) bool {
@@ -431,6 +439,9 @@ func (this *ValidatorInfo) Equal(that interface{}) bool {
 	if this.TotalValidatorIgnoredSignatures != that1.TotalValidatorIgnoredSignatures {
 		return false
 	}
+	if this.ProbationEndEpoch != that1.ProbationEndEpoch {
+		return false
+	}
 	return true
 }
 func (this *ShardValidatorInfo) Equal(that interface{}) bool {
@@ -473,7 +484,7 @@ func (this *ValidatorInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 24)
+	s := make([]string, 0, 25)
 	s = append(s, "&state.ValidatorInfo{")
 	s = append(s, "PublicKey: "+fmt.Sprintf("%#v", this.PublicKey)+",\n")
 	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
@@ -495,6 +506,7 @@ func (this *ValidatorInfo) GoString() string {
 	s = append(s, "TotalValidatorSuccess: "+fmt.Sprintf("%#v", this.TotalValidatorSuccess)+",\n")
 	s = append(s, "TotalValidatorFailure: "+fmt.Sprintf("%#v", this.TotalValidatorFailure)+",\n")
 	s = append(s, "TotalValidatorIgnoredSignatures: "+fmt.Sprintf("%#v", this.TotalValidatorIgnoredSignatures)+",\n")
+	s = append(s, "ProbationEndEpoch: "+fmt.Sprintf("%#v", this.ProbationEndEpoch)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -540,6 +552,13 @@ func (m *ValidatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProbationEndEpoch != 0 {
+		i = encodeVarintValidatorInfo(dAtA, i, uint64(m.ProbationEndEpoch))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if m.TotalValidatorIgnoredSignatures != 0 {
 		i = encodeVarintValidatorInfo(dAtA, i, uint64(m.TotalValidatorIgnoredSignatures))
 		i--
@@ -800,6 +819,9 @@ func (m *ValidatorInfo) Size() (n int) {
 	if m.TotalValidatorIgnoredSignatures != 0 {
 		n += 2 + sovValidatorInfo(uint64(m.TotalValidatorIgnoredSignatures))
 	}
+	if m.ProbationEndEpoch != 0 {
+		n += 2 + sovValidatorInfo(uint64(m.ProbationEndEpoch))
+	}
 	return n
 }
 
@@ -860,6 +882,7 @@ func (this *ValidatorInfo) String() string {
 		`TotalValidatorSuccess:` + fmt.Sprintf("%v", this.TotalValidatorSuccess) + `,`,
 		`TotalValidatorFailure:` + fmt.Sprintf("%v", this.TotalValidatorFailure) + `,`,
 		`TotalValidatorIgnoredSignatures:` + fmt.Sprintf("%v", this.TotalValidatorIgnoredSignatures) + `,`,
+		`ProbationEndEpoch:` + fmt.Sprintf("%v", this.ProbationEndEpoch) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1349,6 +1372,25 @@ func (m *ValidatorInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbationEndEpoch", wireType)
+			}
+			m.ProbationEndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProbationEndEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValidatorInfo(dAtA[iNdEx:])
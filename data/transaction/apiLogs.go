@@ -0,0 +1,36 @@
+package transaction
+
+// LogsFilter holds the filters applied when querying the logs events. At least one of the address and the identifier
+// should be provided. When not provided, the block nonces range ends with the current block
+type LogsFilter struct {
+	Address    string
+	Identifier string
+	FromBlock  *uint64
+	ToBlock    *uint64
+}
+
+// ApiLogEvent is the data transfer object which will be returned for each log event matching a logs query
+type ApiLogEvent struct {
+	TxHash     string   `json:"txHash"`
+	BlockNonce uint64   `json:"blockNonce"`
+	LogAddress string   `json:"logAddress"`
+	EventIndex uint32   `json:"eventIndex"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+}
+
+// ApiLogs is the data transfer object holding the log of a transaction, as it is returned together with the transaction
+type ApiLogs struct {
+	Address string      `json:"address"`
+	Events  []*ApiEvent `json:"events"`
+}
+
+// ApiEvent is the data transfer object holding an event of a transaction log
+type ApiEvent struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+}
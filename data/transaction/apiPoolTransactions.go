@@ -0,0 +1,33 @@
+package transaction
+
+// PoolFilter holds the optional filters applied when inspecting the transactions pool
+type PoolFilter struct {
+	Sender   string
+	Receiver string
+	ShardID  *uint32
+}
+
+// ApiPoolTransaction is the data transfer object which will be returned for a pending transaction of the pool
+type ApiPoolTransaction struct {
+	Hash             string `json:"hash"`
+	Nonce            uint64 `json:"nonce"`
+	Sender           string `json:"sender"`
+	Receiver         string `json:"receiver"`
+	Value            string `json:"value"`
+	GasPrice         uint64 `json:"gasPrice"`
+	GasLimit         uint64 `json:"gasLimit"`
+	SourceShard      uint32 `json:"sourceShard"`
+	DestinationShard uint32 `json:"destinationShard"`
+	NonceGap         bool   `json:"nonceGap"`
+}
+
+// ApiPoolSenderSummary is the data transfer object which will be returned for each sender on the pool summary
+type ApiPoolSenderSummary struct {
+	Sender            string  `json:"sender"`
+	AccountNonce      *uint64 `json:"accountNonce,omitempty"`
+	NumTxs            uint32  `json:"numTxs"`
+	LowestNonce       uint64  `json:"lowestNonce"`
+	HighestNonce      uint64  `json:"highestNonce"`
+	HasNonceGap       bool    `json:"hasNonceGap"`
+	FirstMissingNonce *uint64 `json:"firstMissingNonce,omitempty"`
+}
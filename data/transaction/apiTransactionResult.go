@@ -42,16 +42,43 @@ type ApiTransactionResult struct {
 	MiniBlockHash                     string                    `json:"miniblockHash,omitempty"`
 	Receipt                           *ReceiptApi               `json:"receipt,omitempty"`
 	SmartContractResults              []*ApiSmartContractResult `json:"smartContractResults,omitempty"`
+	Logs                              *ApiLogs                  `json:"logs,omitempty"`
 	Status                            TxStatus                  `json:"status,omitempty"`
+	FailReason                        string                    `json:"failReason,omitempty"`
 }
 
 // SimulationResults is the data transfer object which will hold results for simulation a transaction's execution
 type SimulationResults struct {
-	Status     TxStatus                           `json:"status,omitempty"`
-	FailReason string                             `json:"failReason,omitempty"`
-	ScResults  map[string]*ApiSmartContractResult `json:"scResults,omitempty"`
-	Receipts   map[string]*ReceiptApi             `json:"receipts,omitempty"`
-	Hash       string                             `json:"hash,omitempty"`
+	Status       TxStatus                           `json:"status,omitempty"`
+	FailReason   string                             `json:"failReason,omitempty"`
+	ScResults    map[string]*ApiSmartContractResult `json:"scResults,omitempty"`
+	Receipts     map[string]*ReceiptApi             `json:"receipts,omitempty"`
+	StateChanges []*ApiAccountStateChange           `json:"stateChanges,omitempty"`
+	Hash         string                             `json:"hash,omitempty"`
+}
+
+// ApiAccountStateChange holds the changes a simulated transaction would apply on an account
+type ApiAccountStateChange struct {
+	Address        string              `json:"address"`
+	BalanceBefore  string              `json:"balanceBefore"`
+	BalanceAfter   string              `json:"balanceAfter"`
+	BalanceDelta   string              `json:"balanceDelta"`
+	StorageChanges []*ApiStorageChange `json:"storageChanges,omitempty"`
+	ESDTChanges    []*ApiESDTChange    `json:"esdtChanges,omitempty"`
+}
+
+// ApiStorageChange holds the hex encoded old and new values of a changed account storage key
+type ApiStorageChange struct {
+	Key      string `json:"key"`
+	OldValue string `json:"oldValue"`
+	NewValue string `json:"newValue"`
+}
+
+// ApiESDTChange holds the balance change of an ESDT token, or of an NFT for a non-zero nonce, of an account
+type ApiESDTChange struct {
+	TokenIdentifier string `json:"tokenIdentifier"`
+	Nonce           uint64 `json:"nonce,omitempty"`
+	BalanceDelta    string `json:"balanceDelta"`
 }
 
 // ApiSmartContractResult represents a smart contract result with changed fields' types in order to make it friendly for API's json
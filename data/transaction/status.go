@@ -1,10 +1,16 @@
 package transaction
 
 import (
+	"encoding/hex"
+	"strings"
+
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 )
 
+const returnDataSeparator = "@"
+
 // TxStatus is the status of a transaction
 type TxStatus string
 
@@ -59,6 +65,50 @@ func (params *StatusComputer) ComputeStatusWhenInStorageNotKnowingMiniblock() Tx
 	return TxStatusPending
 }
 
+// FindExecutionFailure searches the smart contract results generated directly by the provided transaction for one
+// holding a return code different from ok. If found, the failure reason is returned: the return message of the
+// result or, if missing, the return code
+func FindExecutionFailure(txHash string, results []*ApiSmartContractResult) (string, bool) {
+	for _, result := range results {
+		if result == nil || result.PrevTxHash != txHash {
+			continue
+		}
+
+		returnCode, ok := extractReturnCode(result.Data)
+		if !ok || returnCode == vmcommon.Ok.String() {
+			continue
+		}
+
+		if len(result.ReturnMessage) > 0 {
+			return result.ReturnMessage, true
+		}
+
+		return returnCode, true
+	}
+
+	return "", false
+}
+
+// extractReturnCode returns the return code of a result data formatted as @<hex encoded return code>[@<return data>].
+// The not encoded return code of the older results is also accepted
+func extractReturnCode(data string) (string, bool) {
+	if !strings.HasPrefix(data, returnDataSeparator) {
+		return "", false
+	}
+
+	tokens := strings.Split(data, returnDataSeparator)
+	if len(tokens[1]) == 0 {
+		return "", false
+	}
+
+	decoded, err := hex.DecodeString(tokens[1])
+	if err != nil {
+		return tokens[1], true
+	}
+
+	return string(decoded), true
+}
+
 func (params *StatusComputer) isMiniblockInvalid() bool {
 	return params.MiniblockType == block.InvalidBlock
 }
@@ -116,3 +116,31 @@ func TestStatusComputer_ComputeStatusWhenInStorageNotKnowingMiniblock(t *testing
 	}
 	require.Equal(t, TxStatusSuccess, computer.ComputeStatusWhenInStorageNotKnowingMiniblock())
 }
+
+func TestFindExecutionFailure(t *testing.T) {
+	txHash := "aabb"
+	results := []*ApiSmartContractResult{
+		{PrevTxHash: txHash, Data: "ESDTTransfer@54434b4e@01"},
+		{PrevTxHash: txHash, Data: "@6f6b@01"},
+		{PrevTxHash: "other", Data: "@75736572206572726f72", ReturnMessage: "nested failure"},
+		nil,
+	}
+	reason, failed := FindExecutionFailure(txHash, results)
+	require.False(t, failed)
+	require.Empty(t, reason)
+
+	// older results hold the return code not encoded
+	reason, failed = FindExecutionFailure(txHash, []*ApiSmartContractResult{{PrevTxHash: txHash, Data: "@ok"}})
+	require.False(t, failed)
+	require.Empty(t, reason)
+
+	results = append(results, &ApiSmartContractResult{PrevTxHash: txHash, Data: "@75736572206572726f72"})
+	reason, failed = FindExecutionFailure(txHash, results)
+	require.True(t, failed)
+	require.Equal(t, "user error", reason)
+
+	results[len(results)-1].ReturnMessage = "insufficient funds"
+	reason, failed = FindExecutionFailure(txHash, results)
+	require.True(t, failed)
+	require.Equal(t, "insufficient funds", reason)
+}
@@ -711,7 +711,9 @@ func (bn *branchNode) loadChildren(getNode func([]byte) (node, error)) ([][]byte
 
 func (bn *branchNode) getAllLeavesOnChannel(
 	leavesChannel chan core.KeyValueHolder,
-	key []byte, db data.DBWriteCacher,
+	key []byte,
+	startPath []byte,
+	db data.DBWriteCacher,
 	marshalizer marshal.Marshalizer,
 	ctx context.Context,
 ) error {
@@ -721,6 +723,11 @@ func (bn *branchNode) getAllLeavesOnChannel(
 	}
 
 	for i := range bn.children {
+		childStartPath, isBeforeStart := childStartPathForBranch(startPath, byte(i))
+		if isBeforeStart {
+			continue
+		}
+
 		select {
 		case <-ctx.Done():
 			log.Trace("getAllLeavesOnChannel interrupted")
@@ -736,7 +743,7 @@ func (bn *branchNode) getAllLeavesOnChannel(
 			}
 
 			childKey := append(key, byte(i))
-			err = bn.children[i].getAllLeavesOnChannel(leavesChannel, childKey, db, marshalizer, ctx)
+			err = bn.children[i].getAllLeavesOnChannel(leavesChannel, childKey, childStartPath, db, marshalizer, ctx)
 			if err != nil {
 				return err
 			}
@@ -748,6 +755,19 @@ func (bn *branchNode) getAllLeavesOnChannel(
 	return nil
 }
 
+// childStartPath returns the start path that remains to be matched by the child found at the provided position and
+// true if all the leaves of that child are positioned before the start path
+func childStartPathForBranch(startPath []byte, pos byte) ([]byte, bool) {
+	if len(startPath) == 0 || pos > startPath[0] {
+		return nil, false
+	}
+	if pos < startPath[0] {
+		return nil, true
+	}
+
+	return startPath[1:], false
+}
+
 func (bn *branchNode) getAllHashes(db data.DBWriteCacher) ([][]byte, error) {
 	err := bn.isEmptyOrNil()
 	if err != nil {
@@ -94,5 +94,8 @@ var ErrNilTrieSyncStatistics = errors.New("nil trie sync statistics handler")
 // ErrContextClosing signals that the parent context requested the closing of its children
 var ErrContextClosing = errors.New("context closing")
 
+// ErrInvalidProof signals that the provided merkle proof does not prove the key against the given root hash
+var ErrInvalidProof = errors.New("invalid merkle proof")
+
 // ErrInvalidTimeout signals that an invalid timeout period has been provided
 var ErrInvalidTimeout = errors.New("invalid timeout value")
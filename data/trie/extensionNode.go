@@ -580,7 +580,9 @@ func (en *extensionNode) loadChildren(getNode func([]byte) (node, error)) ([][]b
 
 func (en *extensionNode) getAllLeavesOnChannel(
 	leavesChannel chan core.KeyValueHolder,
-	key []byte, db data.DBWriteCacher,
+	key []byte,
+	startPath []byte,
+	db data.DBWriteCacher,
 	marshalizer marshal.Marshalizer,
 	ctx context.Context,
 ) error {
@@ -589,6 +591,11 @@ func (en *extensionNode) getAllLeavesOnChannel(
 		return fmt.Errorf("getAllLeavesOnChannel error: %w", err)
 	}
 
+	childStartPath, isBeforeStart := childStartPathForExtension(startPath, en.Key)
+	if isBeforeStart {
+		return nil
+	}
+
 	select {
 	case <-ctx.Done():
 		log.Trace("getAllLeavesOnChannel interrupted")
@@ -600,7 +607,7 @@ func (en *extensionNode) getAllLeavesOnChannel(
 		}
 
 		childKey := append(key, en.Key...)
-		err = en.child.getAllLeavesOnChannel(leavesChannel, childKey, db, marshalizer, ctx)
+		err = en.child.getAllLeavesOnChannel(leavesChannel, childKey, childStartPath, db, marshalizer, ctx)
 		if err != nil {
 			return err
 		}
@@ -611,6 +618,29 @@ func (en *extensionNode) getAllLeavesOnChannel(
 	return nil
 }
 
+// childStartPath returns the start path that remains to be matched by the child of an extension node with the
+// provided key and true if all the leaves of that child are positioned before the start path
+func childStartPathForExtension(startPath []byte, extensionKey []byte) ([]byte, bool) {
+	if len(startPath) == 0 {
+		return nil, false
+	}
+
+	commonLength := len(extensionKey)
+	if len(startPath) < commonLength {
+		commonLength = len(startPath)
+	}
+
+	comparison := bytes.Compare(extensionKey[:commonLength], startPath[:commonLength])
+	if comparison < 0 {
+		return nil, true
+	}
+	if comparison > 0 || len(startPath) <= len(extensionKey) {
+		return nil, false
+	}
+
+	return startPath[len(extensionKey):], false
+}
+
 func (en *extensionNode) getAllHashes(db data.DBWriteCacher) ([][]byte, error) {
 	err := en.isEmptyOrNil()
 	if err != nil {
@@ -40,7 +40,7 @@ type node interface {
 	isValid() bool
 	setDirty(bool)
 	loadChildren(func([]byte) (node, error)) ([][]byte, []node, error)
-	getAllLeavesOnChannel(chan core.KeyValueHolder, []byte, data.DBWriteCacher, marshal.Marshalizer, context.Context) error
+	getAllLeavesOnChannel(chan core.KeyValueHolder, []byte, []byte, data.DBWriteCacher, marshal.Marshalizer, context.Context) error
 	getAllHashes(db data.DBWriteCacher) ([][]byte, error)
 
 	getMarshalizer() marshal.Marshalizer
@@ -345,6 +345,7 @@ func (ln *leafNode) loadChildren(_ func([]byte) (node, error)) ([][]byte, []node
 func (ln *leafNode) getAllLeavesOnChannel(
 	leavesChannel chan core.KeyValueHolder,
 	key []byte,
+	startPath []byte,
 	_ data.DBWriteCacher,
 	_ marshal.Marshalizer,
 	_ context.Context,
@@ -353,6 +354,9 @@ func (ln *leafNode) getAllLeavesOnChannel(
 	if err != nil {
 		return fmt.Errorf("getAllLeavesOnChannel error: %w", err)
 	}
+	if len(startPath) > 0 && bytes.Compare(ln.Key, startPath) < 0 {
+		return nil
+	}
 
 	nodeKey := append(key, ln.Key...)
 	nodeKey, err = hexToKeyBytes(nodeKey)
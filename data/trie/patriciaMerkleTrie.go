@@ -592,8 +592,128 @@ func (tr *patriciaMerkleTrie) GetSerializedNodes(rootHash []byte, maxBuffToSend
 	return nodes, remainingSpace, nil
 }
 
+// GetProof computes a merkle proof for the given key. The proof holds the encoded nodes found on the path from the
+// trie root to the leaf that holds the key, in this order. Only the existence of a key can be proven
+func (tr *patriciaMerkleTrie) GetProof(key []byte) ([][]byte, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if tr.root == nil {
+		return nil, ErrNilNode
+	}
+
+	db := tr.trieStorage.Database()
+	hexKey := keyBytesToHex(key)
+	currentNode := tr.root
+	proof := make([][]byte, 0)
+	for {
+		collapsedNode, err := currentNode.getCollapsed()
+		if err != nil {
+			return nil, err
+		}
+
+		encodedNode, err := collapsedNode.getEncodedNode()
+		if err != nil {
+			return nil, err
+		}
+		proof = append(proof, encodedNode)
+
+		currentNode, hexKey, err = currentNode.getNext(hexKey, db)
+		if err != nil {
+			return nil, fmt.Errorf("trie get proof error: %w, for key %v", err, hex.EncodeToString(key))
+		}
+		if currentNode == nil {
+			return proof, nil
+		}
+	}
+}
+
+// VerifyProof checks that the given proof, as generated by GetProof, proves the existence of the key in the trie with
+// the given root hash. The value held by the key is returned if the proof is valid
+func VerifyProof(
+	rootHash []byte,
+	key []byte,
+	proof [][]byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) ([]byte, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	expectedHash := rootHash
+	hexKey := keyBytesToHex(key)
+	for i, encodedNode := range proof {
+		if !bytes.Equal(hasher.Compute(string(encodedNode)), expectedHash) {
+			return nil, fmt.Errorf("%w: hash mismatch for node at position %d", ErrInvalidProof, i)
+		}
+
+		decodedNode, err := decodeNode(encodedNode, marshalizer, hasher)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidProof, err.Error())
+		}
+
+		switch n := decodedNode.(type) {
+		case *branchNode:
+			if len(hexKey) == 0 || int(hexKey[firstByte]) >= len(n.EncodedChildren) {
+				return nil, ErrInvalidProof
+			}
+			expectedHash = n.EncodedChildren[hexKey[firstByte]]
+			hexKey = hexKey[1:]
+		case *extensionNode:
+			if len(hexKey) < len(n.Key) || !bytes.Equal(n.Key, hexKey[:len(n.Key)]) {
+				return nil, ErrInvalidProof
+			}
+			expectedHash = n.EncodedChild
+			hexKey = hexKey[len(n.Key):]
+		case *leafNode:
+			isLastNode := i == len(proof)-1
+			if !isLastNode || !bytes.Equal(n.Key, hexKey) {
+				return nil, ErrInvalidProof
+			}
+			return n.Value, nil
+		default:
+			return nil, ErrInvalidNode
+		}
+
+		if len(expectedHash) == 0 {
+			return nil, ErrInvalidProof
+		}
+	}
+
+	return nil, ErrInvalidProof
+}
+
 // GetAllLeavesOnChannel adds all the trie leaves to the given channel
 func (tr *patriciaMerkleTrie) GetAllLeavesOnChannel(rootHash []byte, ctx context.Context) (chan core.KeyValueHolder, error) {
+	return tr.getLeavesOnChannel(rootHash, nil, ctx)
+}
+
+// GetAllLeavesFromKeyOnChannel adds to the given channel the trie leaves starting with the one that has the provided
+// key, or the first one positioned after it if the key is missing. The leaves are delivered in the trie traversal
+// order and the subtrees positioned before the start key are not loaded, so the iteration can be resumed in pages
+// by keeping the root hash and the key of the first leaf that was not consumed
+func (tr *patriciaMerkleTrie) GetAllLeavesFromKeyOnChannel(
+	rootHash []byte,
+	startKey []byte,
+	ctx context.Context,
+) (chan core.KeyValueHolder, error) {
+	var startPath []byte
+	if len(startKey) > 0 {
+		startPath = keyBytesToHex(startKey)
+	}
+
+	return tr.getLeavesOnChannel(rootHash, startPath, ctx)
+}
+
+func (tr *patriciaMerkleTrie) getLeavesOnChannel(
+	rootHash []byte,
+	startPath []byte,
+	ctx context.Context,
+) (chan core.KeyValueHolder, error) {
 	leavesChannel := make(chan core.KeyValueHolder, 100)
 
 	tr.mutOperation.RLock()
@@ -615,7 +735,7 @@ func (tr *patriciaMerkleTrie) GetAllLeavesOnChannel(rootHash []byte, ctx context
 	tr.mutOperation.RUnlock()
 
 	go func() {
-		err = newTrie.root.getAllLeavesOnChannel(leavesChannel, []byte{}, tr.Database(), tr.marshalizer, ctx)
+		err = newTrie.root.getAllLeavesOnChannel(leavesChannel, []byte{}, startPath, tr.Database(), tr.marshalizer, ctx)
 		if err != nil {
 			log.Error("could not get all trie leaves: ", "error", err)
 		}
@@ -3,6 +3,7 @@ package trie_test
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
@@ -21,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var emptyTrieHash = make([]byte, 32)
@@ -659,6 +661,84 @@ func TestPatriciaMerkleTrie_GetAllLeavesOnChannel(t *testing.T) {
 	assert.Equal(t, leaves, recovered)
 }
 
+func getLeavesKeys(t *testing.T, tr data.Trie, rootHash []byte, startKey []byte) []string {
+	leavesChannel, err := tr.GetAllLeavesFromKeyOnChannel(rootHash, startKey, context.Background())
+	require.Nil(t, err)
+
+	keys := make([]string, 0)
+	for leaf := range leavesChannel {
+		keys = append(keys, string(leaf.Key()))
+	}
+
+	return keys
+}
+
+func TestPatriciaMerkleTrie_GetAllLeavesFromKeyOnChannel(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+	for i := 0; i < 200; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		_ = tr.Update(key, key)
+	}
+	_ = tr.Update([]byte("k"), []byte("short key"))
+	_ = tr.Update([]byte("a much longer key than the others"), []byte("long key"))
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	allKeys := getLeavesKeys(t, tr, rootHash, nil)
+	require.Equal(t, 202, len(allKeys))
+
+	for i, key := range allKeys {
+		assert.Equal(t, allKeys[i:], getLeavesKeys(t, tr, rootHash, []byte(key)))
+	}
+}
+
+func TestPatriciaMerkleTrie_GetAllLeavesFromKeyOnChannelMissingKeyShouldStartFromNextLeaf(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+	for i := 0; i < 50; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		_ = tr.Update(key, key)
+	}
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	allKeys := getLeavesKeys(t, tr, rootHash, nil)
+
+	missingKey := []byte(allKeys[10])
+	_ = tr.Delete(missingKey)
+	_ = tr.Commit()
+	newRootHash, _ := tr.Root()
+
+	assert.Equal(t, allKeys[11:], getLeavesKeys(t, tr, newRootHash, missingKey))
+	assert.Equal(t, allKeys[10:], getLeavesKeys(t, tr, rootHash, missingKey))
+}
+
+func TestPatriciaMerkleTrie_GetAllLeavesFromKeyOnChannelCancelShouldStop(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+	for i := 0; i < 1000; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		_ = tr.Update(key, key)
+	}
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	leavesChannel, err := tr.GetAllLeavesFromKeyOnChannel(rootHash, nil, ctx)
+	require.Nil(t, err)
+
+	<-leavesChannel
+	cancel()
+	numLeaves := 1
+	for range leavesChannel {
+		numLeaves++
+	}
+	assert.True(t, numLeaves < 1000)
+}
+
 func BenchmarkPatriciaMerkleTree_Insert(b *testing.B) {
 	tr := emptyTrie()
 	hsh := keccak.Keccak{}
@@ -853,3 +933,50 @@ func BenchmarkPatriciaMerkleTrie_RootHashAfterChanging30000NodesInBatchesOf200(b
 		}
 	}
 }
+
+func TestPatriciaMerkleTrie_GetProofAndVerifyProof(t *testing.T) {
+	t.Parallel()
+
+	tr, values := initTrieMultipleValues(50)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	for _, val := range values {
+		proof, err := tr.GetProof(val)
+		require.Nil(t, err)
+
+		provenValue, err := trie.VerifyProof(rootHash, val, proof, marshalizer, hasher)
+		require.Nil(t, err)
+		assert.Equal(t, val, provenValue)
+	}
+}
+
+func TestPatriciaMerkleTrie_GetProofMissingKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	proof, err := tr.GetProof([]byte("missing key"))
+	assert.True(t, errors.Is(err, trie.ErrNodeNotFound))
+	assert.Nil(t, proof)
+}
+
+func TestPatriciaMerkleTrie_VerifyProofTamperedProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+	proof, _ := tr.GetProof([]byte("dog"))
+
+	value, err := trie.VerifyProof(rootHash, []byte("doe"), proof, marshalizer, hasher)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+	assert.Nil(t, value)
+
+	proof[len(proof)-1] = append([]byte{}, proof[len(proof)-1]...)
+	proof[len(proof)-1][0]++
+	value, err = trie.VerifyProof(rootHash, []byte("dog"), proof, marshalizer, hasher)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+	assert.Nil(t, value)
+}
@@ -1,6 +1,7 @@
 package dataRetriever
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -68,6 +69,26 @@ func (bc *ChainStorer) Get(unitType UnitType, key []byte) ([]byte, error) {
 	return storer.Get(key)
 }
 
+// Compact will compact all the storage units that support compaction
+func (bc *ChainStorer) Compact() error {
+	bc.lock.RLock()
+	defer bc.lock.RUnlock()
+
+	for unitType, storer := range bc.chain {
+		compacter, ok := storer.(storage.Compacter)
+		if !ok {
+			continue
+		}
+
+		err := compacter.Compact()
+		if err != nil {
+			return fmt.Errorf("%w while compacting the %s storage unit", err, unitType.String())
+		}
+	}
+
+	return nil
+}
+
 // Put stores the key, value pair in the selected storage unit
 // It can return an error if the provided unit type is not supported
 // or if the storage unit underlying implementation reports an error
@@ -33,8 +33,6 @@ func (ut UnitType) String() string {
 		return "RewardTransactionUnit"
 	case MetaHdrNonceHashDataUnit:
 		return "MetaHdrNonceHashDataUnit"
-	case HeartbeatUnit:
-		return "HeartbeatUnit"
 	case BootstrapUnit:
 		return "BootstrapUnit"
 	case StatusMetricsUnit:
@@ -67,8 +65,6 @@ const (
 	RewardTransactionUnit UnitType = 6
 	// MetaHdrNonceHashDataUnit is the meta header nonce-hash pair data unit identifier
 	MetaHdrNonceHashDataUnit UnitType = 7
-	// HeartbeatUnit is the heartbeat storage unit identifier
-	HeartbeatUnit UnitType = 8
 	// BootstrapUnit is the bootstrap storage unit identifier
 	BootstrapUnit UnitType = 9
 	//StatusMetricsUnit is the status metrics storage unit identifier
@@ -85,6 +81,8 @@ const (
 	ReceiptsUnit UnitType = 15
 	// ResultsHashesByTxHashUnit is the results hashes by transaction storage unit identifier
 	ResultsHashesByTxHashUnit UnitType = 16
+	// LogsIndexUnit is the transactions logs locations by address and by event identifier storage unit identifier
+	LogsIndexUnit UnitType = 17
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
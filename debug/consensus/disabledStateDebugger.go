@@ -0,0 +1,43 @@
+package consensus
+
+type disabledStateDebugger struct {
+}
+
+// NewDisabledConsensusStateDebugger returns a disabled instance of the consensus state debugger
+func NewDisabledConsensusStateDebugger() *disabledStateDebugger {
+	return &disabledStateDebugger{}
+}
+
+// StartRound does nothing
+func (dsd *disabledStateDebugger) StartRound(_ int64, _ []string, _ string) {
+}
+
+// SetSubround does nothing
+func (dsd *disabledStateDebugger) SetSubround(_ int64, _ string) {
+}
+
+// AddReceivedMessage does nothing
+func (dsd *disabledStateDebugger) AddReceivedMessage(_ int64, _ []byte, _ string) {
+}
+
+// AddSubroundTimeout does nothing
+func (dsd *disabledStateDebugger) AddSubroundTimeout(_ int64, _ string) {
+}
+
+// AddCollectedSignature does nothing
+func (dsd *disabledStateDebugger) AddCollectedSignature(_ int64, _ string) {
+}
+
+// SetBlockCommitted does nothing
+func (dsd *disabledStateDebugger) SetBlockCommitted(_ int64) {
+}
+
+// Query returns an empty slice
+func (dsd *disabledStateDebugger) Query(_ string) []string {
+	return make([]string, 0)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsd *disabledStateDebugger) IsInterfaceNil() bool {
+	return dsd == nil
+}
@@ -0,0 +1,25 @@
+package consensus
+
+import "time"
+
+func (sd *stateDebugger) SetGetTimeHandler(handler func() time.Time) {
+	sd.getTimeHandler = handler
+}
+
+func (sd *stateDebugger) SetWriteDumpHandler(handler func(data []byte) (string, error)) {
+	sd.writeDumpHandler = handler
+}
+
+func (sd *stateDebugger) NumMissedRounds() int {
+	sd.mutState.RLock()
+	defer sd.mutState.RUnlock()
+
+	return sd.numMissedRounds
+}
+
+func (sd *stateDebugger) NumRounds() int {
+	sd.mutState.RLock()
+	defer sd.mutState.RUnlock()
+
+	return len(sd.rounds)
+}
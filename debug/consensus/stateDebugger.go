@@ -0,0 +1,351 @@
+package consensus
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/debug"
+)
+
+var log = logger.GetOrCreate("debug/consensus")
+
+const minNumRoundsToKeep = 1
+const dumpFilePrefix = "consensus-state"
+const dumpFileExtension = "json"
+const timeFormat = "2006-01-02 15:04:05.000"
+
+// DumpQuery is the search string that, besides returning the state of all the kept rounds, writes it in a dump file
+const DumpQuery = "dump"
+
+// AllRoundsQuery is the search string that returns the state of all the kept rounds
+const AllRoundsQuery = "*"
+
+type roundState struct {
+	Round               int64                     `json:"round"`
+	StartTime           string                    `json:"startTime"`
+	ConsensusGroup      []string                  `json:"consensusGroup"`
+	Leader              string                    `json:"leader"`
+	Subrounds           []string                  `json:"subrounds"`
+	ReceivedMessages    map[string]map[string]int `json:"receivedMessages"`
+	TimeoutsHit         []string                  `json:"timeoutsHit"`
+	SignaturesCollected []string                  `json:"signaturesCollected"`
+	Committed           bool                      `json:"committed"`
+}
+
+// stateDebugger records the consensus activity of the last rounds: the subrounds reached, the messages received from
+// each validator, the subrounds that timed out and the signatures collected. The recorded state can be queried through
+// the debug endpoint and is automatically dumped in a file when too many consecutive rounds are missed
+type stateDebugger struct {
+	numRoundsToKeep       int64
+	numMissedRoundsToDump int
+	dumpFolderPath        string
+	getTimeHandler        func() time.Time
+	writeDumpHandler      func(data []byte) (string, error)
+
+	mutState         sync.RWMutex
+	rounds           map[int64]*roundState
+	lastRound        int64
+	lastStartedRound int64
+	numMissedRounds  int
+}
+
+// NewConsensusStateDebugger creates a new consensus state debugger. The dump folder is relative to the working directory
+func NewConsensusStateDebugger(config config.ConsensusDebugConfig, workingDir string) (*stateDebugger, error) {
+	if config.NumRoundsToKeep < minNumRoundsToKeep {
+		return nil, fmt.Errorf("%w for NumRoundsToKeep, minimum is %d", debug.ErrInvalidValue, minNumRoundsToKeep)
+	}
+	if config.NumMissedRoundsToDump < 0 {
+		return nil, fmt.Errorf("%w for NumMissedRoundsToDump, should not be negative", debug.ErrInvalidValue)
+	}
+	if len(config.DumpFolderPath) == 0 {
+		return nil, fmt.Errorf("%w for DumpFolderPath, should not be empty", debug.ErrInvalidValue)
+	}
+
+	sd := &stateDebugger{
+		numRoundsToKeep:       int64(config.NumRoundsToKeep),
+		numMissedRoundsToDump: config.NumMissedRoundsToDump,
+		dumpFolderPath:        filepath.Join(workingDir, config.DumpFolderPath),
+		getTimeHandler:        time.Now,
+		rounds:                make(map[int64]*roundState),
+	}
+	sd.writeDumpHandler = sd.writeDumpFile
+
+	return sd, nil
+}
+
+// StartRound records the start of the provided round together with its consensus group. When the previous round was
+// not committed, it is counted as missed
+func (sd *stateDebugger) StartRound(round int64, consensusGroup []string, leader string) {
+	sd.mutState.Lock()
+
+	if round <= sd.lastStartedRound {
+		sd.mutState.Unlock()
+		return
+	}
+
+	previous, found := sd.rounds[sd.lastStartedRound]
+	if found {
+		if previous.Committed {
+			sd.numMissedRounds = 0
+		} else {
+			sd.numMissedRounds++
+		}
+	}
+	sd.lastStartedRound = round
+
+	rs := sd.getRoundState(round)
+	if rs == nil {
+		sd.mutState.Unlock()
+		return
+	}
+
+	rs.StartTime = sd.getTimeHandler().Format(timeFormat)
+	rs.ConsensusGroup = make([]string, 0, len(consensusGroup))
+	for _, pubKey := range consensusGroup {
+		rs.ConsensusGroup = append(rs.ConsensusGroup, hex.EncodeToString([]byte(pubKey)))
+	}
+	rs.Leader = hex.EncodeToString([]byte(leader))
+
+	numMissedRounds := sd.numMissedRounds
+	shouldDump := sd.numMissedRoundsToDump > 0 && numMissedRounds > 0 && numMissedRounds%sd.numMissedRoundsToDump == 0
+	sd.mutState.Unlock()
+
+	if !shouldDump {
+		return
+	}
+
+	filePath, err := sd.dump()
+	if err != nil {
+		log.Warn("stateDebugger: can not dump the consensus state", "error", err.Error())
+		return
+	}
+
+	log.Warn("consensus state dumped after consecutive missed rounds",
+		"round", round,
+		"num missed rounds", numMissedRounds,
+		"file", filePath,
+	)
+}
+
+// SetSubround records that the provided subround was reached in the provided round
+func (sd *stateDebugger) SetSubround(round int64, subround string) {
+	sd.mutState.Lock()
+	defer sd.mutState.Unlock()
+
+	rs := sd.getRoundState(round)
+	if rs == nil {
+		return
+	}
+
+	rs.Subrounds = append(rs.Subrounds, subround)
+}
+
+// AddReceivedMessage records a consensus message of the provided type received from the provided validator
+func (sd *stateDebugger) AddReceivedMessage(round int64, pubKey []byte, messageType string) {
+	sd.mutState.Lock()
+	defer sd.mutState.Unlock()
+
+	rs := sd.getRoundState(round)
+	if rs == nil {
+		return
+	}
+
+	key := hex.EncodeToString(pubKey)
+	messages, found := rs.ReceivedMessages[key]
+	if !found {
+		messages = make(map[string]int)
+		rs.ReceivedMessages[key] = messages
+	}
+	messages[messageType]++
+}
+
+// AddSubroundTimeout records that the provided subround ended without reaching its goal in the provided round
+func (sd *stateDebugger) AddSubroundTimeout(round int64, subround string) {
+	sd.mutState.Lock()
+	defer sd.mutState.Unlock()
+
+	rs := sd.getRoundState(round)
+	if rs == nil {
+		return
+	}
+
+	rs.TimeoutsHit = append(rs.TimeoutsHit, subround)
+}
+
+// AddCollectedSignature records the signature share of the provided validator as collected in the provided round
+func (sd *stateDebugger) AddCollectedSignature(round int64, pubKey string) {
+	sd.mutState.Lock()
+	defer sd.mutState.Unlock()
+
+	rs := sd.getRoundState(round)
+	if rs == nil {
+		return
+	}
+
+	rs.SignaturesCollected = append(rs.SignaturesCollected, hex.EncodeToString([]byte(pubKey)))
+}
+
+// SetBlockCommitted records that the block of the provided round was committed
+func (sd *stateDebugger) SetBlockCommitted(round int64) {
+	sd.mutState.Lock()
+	defer sd.mutState.Unlock()
+
+	rs := sd.getRoundState(round)
+	if rs == nil {
+		return
+	}
+
+	rs.Committed = true
+}
+
+// getRoundState returns the state of the provided round, removing the states that became too old.
+// Returns nil if the provided round is itself too old. Should be called under mutex protection
+func (sd *stateDebugger) getRoundState(round int64) *roundState {
+	if round > sd.lastRound {
+		sd.lastRound = round
+		for r := range sd.rounds {
+			if r <= sd.lastRound-sd.numRoundsToKeep {
+				delete(sd.rounds, r)
+			}
+		}
+	}
+	if round <= sd.lastRound-sd.numRoundsToKeep {
+		return nil
+	}
+
+	rs, found := sd.rounds[round]
+	if !found {
+		rs = &roundState{
+			Round:               round,
+			ConsensusGroup:      make([]string, 0),
+			Subrounds:           make([]string, 0),
+			ReceivedMessages:    make(map[string]map[string]int),
+			TimeoutsHit:         make([]string, 0),
+			SignaturesCollected: make([]string, 0),
+		}
+		sd.rounds[round] = rs
+	}
+
+	return rs
+}
+
+// Query returns the state of the requested round, as a JSON line. The AllRoundsQuery search returns the state of all
+// the kept rounds while the DumpQuery search also writes them in a dump file, whose path is returned on the last line
+func (sd *stateDebugger) Query(search string) []string {
+	switch search {
+	case AllRoundsQuery:
+		return sd.query(func(_ int64) bool { return true })
+	case DumpQuery:
+		lines := sd.query(func(_ int64) bool { return true })
+		filePath, err := sd.dump()
+		if err != nil {
+			return append(lines, "dump failed: "+err.Error())
+		}
+		return append(lines, "dumped in "+filePath)
+	default:
+		round, err := strconv.ParseInt(search, 10, 64)
+		if err != nil {
+			return make([]string, 0)
+		}
+		return sd.query(func(r int64) bool { return r == round })
+	}
+}
+
+func (sd *stateDebugger) query(acceptRound func(round int64) bool) []string {
+	states := sd.getSortedStates(acceptRound)
+
+	lines := make([]string, 0, len(states))
+	for _, rs := range states {
+		buff, err := json.Marshal(rs)
+		if err != nil {
+			log.Debug("stateDebugger.query", "round", rs.Round, "error", err.Error())
+			continue
+		}
+		lines = append(lines, string(buff))
+	}
+
+	return lines
+}
+
+func (sd *stateDebugger) getSortedStates(acceptRound func(round int64) bool) []*roundState {
+	sd.mutState.RLock()
+	defer sd.mutState.RUnlock()
+
+	states := make([]*roundState, 0, len(sd.rounds))
+	for round, rs := range sd.rounds {
+		if acceptRound(round) {
+			states = append(states, rs.clone())
+		}
+	}
+
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].Round < states[j].Round
+	})
+
+	return states
+}
+
+func (sd *stateDebugger) dump() (string, error) {
+	states := sd.getSortedStates(func(_ int64) bool { return true })
+	buff, err := json.MarshalIndent(states, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return sd.writeDumpHandler(buff)
+}
+
+func (sd *stateDebugger) writeDumpFile(data []byte) (string, error) {
+	file, err := core.CreateFile(core.ArgCreateFileArgument{
+		Directory:     sd.dumpFolderPath,
+		Prefix:        dumpFilePrefix,
+		FileExtension: dumpFileExtension,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	_, err = file.Write(data)
+	if err != nil {
+		_ = file.Close()
+		return "", err
+	}
+
+	return file.Name(), file.Close()
+}
+
+func (rs *roundState) clone() *roundState {
+	receivedMessages := make(map[string]map[string]int, len(rs.ReceivedMessages))
+	for pubKey, messages := range rs.ReceivedMessages {
+		receivedMessages[pubKey] = make(map[string]int, len(messages))
+		for messageType, num := range messages {
+			receivedMessages[pubKey][messageType] = num
+		}
+	}
+
+	return &roundState{
+		Round:               rs.Round,
+		StartTime:           rs.StartTime,
+		ConsensusGroup:      append(make([]string, 0, len(rs.ConsensusGroup)), rs.ConsensusGroup...),
+		Leader:              rs.Leader,
+		Subrounds:           append(make([]string, 0, len(rs.Subrounds)), rs.Subrounds...),
+		ReceivedMessages:    receivedMessages,
+		TimeoutsHit:         append(make([]string, 0, len(rs.TimeoutsHit)), rs.TimeoutsHit...),
+		SignaturesCollected: append(make([]string, 0, len(rs.SignaturesCollected)), rs.SignaturesCollected...),
+		Committed:           rs.Committed,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sd *stateDebugger) IsInterfaceNil() bool {
+	return sd == nil
+}
@@ -0,0 +1,188 @@
+package consensus
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockConsensusDebugConfig() config.ConsensusDebugConfig {
+	return config.ConsensusDebugConfig{
+		Enabled:               true,
+		NumRoundsToKeep:       5,
+		NumMissedRoundsToDump: 2,
+		DumpFolderPath:        "consensus-debug",
+	}
+}
+
+func TestNewConsensusStateDebugger_InvalidConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := createMockConsensusDebugConfig()
+	cfg.NumRoundsToKeep = 0
+	sd, err := NewConsensusStateDebugger(cfg, "")
+	assert.True(t, check.IfNil(sd))
+	assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+
+	cfg = createMockConsensusDebugConfig()
+	cfg.NumMissedRoundsToDump = -1
+	sd, err = NewConsensusStateDebugger(cfg, "")
+	assert.True(t, check.IfNil(sd))
+	assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+
+	cfg = createMockConsensusDebugConfig()
+	cfg.DumpFolderPath = ""
+	sd, err = NewConsensusStateDebugger(cfg, "")
+	assert.True(t, check.IfNil(sd))
+	assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+}
+
+func TestNewConsensusStateDebugger_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sd, err := NewConsensusStateDebugger(createMockConsensusDebugConfig(), "")
+	assert.False(t, check.IfNil(sd))
+	assert.Nil(t, err)
+}
+
+func TestStateDebugger_QueryShouldReturnTheRecordedRoundState(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := NewConsensusStateDebugger(createMockConsensusDebugConfig(), "")
+	sd.SetGetTimeHandler(func() time.Time {
+		return time.Unix(0, 0).UTC()
+	})
+
+	sd.AddReceivedMessage(3, []byte("B"), "(BLOCK_HEADER)")
+	sd.StartRound(3, []string{"A", "B", "C"}, "A")
+	sd.SetSubround(3, "(START_ROUND)")
+	sd.SetSubround(3, "(BLOCK)")
+	sd.AddReceivedMessage(3, []byte("B"), "(BLOCK_HEADER)")
+	sd.AddReceivedMessage(3, []byte("C"), "(SIGNATURE)")
+	sd.AddCollectedSignature(3, "C")
+	sd.AddSubroundTimeout(3, "(SIGNATURE)")
+	sd.StartRound(4, []string{"B", "C", "A"}, "B")
+
+	assert.Equal(t, 0, len(sd.Query("5")))
+	assert.Equal(t, 0, len(sd.Query("not a round")))
+	assert.Equal(t, 2, len(sd.Query(AllRoundsQuery)))
+
+	lines := sd.Query("3")
+	require.Equal(t, 1, len(lines))
+	rs := &roundState{}
+	err := json.Unmarshal([]byte(lines[0]), rs)
+	require.Nil(t, err)
+
+	hexA := hex.EncodeToString([]byte("A"))
+	hexB := hex.EncodeToString([]byte("B"))
+	hexC := hex.EncodeToString([]byte("C"))
+	expected := &roundState{
+		Round:          3,
+		StartTime:      time.Unix(0, 0).UTC().Format(timeFormat),
+		ConsensusGroup: []string{hexA, hexB, hexC},
+		Leader:         hexA,
+		Subrounds:      []string{"(START_ROUND)", "(BLOCK)"},
+		ReceivedMessages: map[string]map[string]int{
+			hexB: {"(BLOCK_HEADER)": 2},
+			hexC: {"(SIGNATURE)": 1},
+		},
+		TimeoutsHit:         []string{"(SIGNATURE)"},
+		SignaturesCollected: []string{hexC},
+		Committed:           false,
+	}
+	assert.Equal(t, expected, rs)
+}
+
+func TestStateDebugger_OldRoundsShouldBeRemoved(t *testing.T) {
+	t.Parallel()
+
+	cfg := createMockConsensusDebugConfig()
+	cfg.NumMissedRoundsToDump = 0
+	sd, _ := NewConsensusStateDebugger(cfg, "")
+	for round := int64(1); round <= 10; round++ {
+		sd.StartRound(round, []string{"A"}, "A")
+	}
+	assert.Equal(t, 5, sd.NumRounds())
+
+	sd.AddReceivedMessage(5, []byte("A"), "(SIGNATURE)")
+	assert.Equal(t, 5, sd.NumRounds())
+	assert.Equal(t, 0, len(sd.Query("5")))
+	assert.Equal(t, 1, len(sd.Query("6")))
+}
+
+func TestStateDebugger_ConsecutiveMissedRoundsShouldDump(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := NewConsensusStateDebugger(createMockConsensusDebugConfig(), "")
+	numDumps := 0
+	var dumpedStates []*roundState
+	sd.SetWriteDumpHandler(func(data []byte) (string, error) {
+		numDumps++
+		dumpedStates = make([]*roundState, 0)
+		err := json.Unmarshal(data, &dumpedStates)
+		assert.Nil(t, err)
+		return "file", nil
+	})
+
+	sd.StartRound(1, []string{"A"}, "A")
+	sd.SetBlockCommitted(1)
+	sd.StartRound(2, []string{"A"}, "A")
+	assert.Equal(t, 0, sd.NumMissedRounds())
+
+	sd.StartRound(3, []string{"A"}, "A")
+	assert.Equal(t, 1, sd.NumMissedRounds())
+	assert.Equal(t, 0, numDumps)
+
+	sd.StartRound(4, []string{"A"}, "A")
+	assert.Equal(t, 2, sd.NumMissedRounds())
+	assert.Equal(t, 1, numDumps)
+	require.Equal(t, 4, len(dumpedStates))
+	assert.True(t, dumpedStates[0].Committed)
+	assert.Equal(t, int64(4), dumpedStates[3].Round)
+
+	// the same round started again should not be counted twice
+	sd.StartRound(4, []string{"A"}, "A")
+	assert.Equal(t, 2, sd.NumMissedRounds())
+
+	sd.SetBlockCommitted(4)
+	sd.StartRound(5, []string{"A"}, "A")
+	assert.Equal(t, 0, sd.NumMissedRounds())
+	assert.Equal(t, 1, numDumps)
+}
+
+func TestStateDebugger_QueryDumpShouldWriteTheFile(t *testing.T) {
+	t.Parallel()
+
+	workingDir, err := ioutil.TempDir("", "consensusDebug")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(workingDir)
+	}()
+
+	sd, _ := NewConsensusStateDebugger(createMockConsensusDebugConfig(), workingDir)
+	sd.StartRound(1, []string{"A"}, "A")
+
+	lines := sd.Query(DumpQuery)
+	require.Equal(t, 2, len(lines))
+	filePath := strings.TrimPrefix(lines[1], "dumped in ")
+	assert.True(t, strings.HasPrefix(filePath, workingDir))
+
+	buff, err := ioutil.ReadFile(filePath)
+	require.Nil(t, err)
+	dumpedStates := make([]*roundState, 0)
+	err = json.Unmarshal(buff, &dumpedStates)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(dumpedStates))
+	assert.Equal(t, int64(1), dumpedStates[0].Round)
+}
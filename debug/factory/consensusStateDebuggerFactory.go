@@ -0,0 +1,15 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/debug/consensus"
+)
+
+// NewConsensusStateDebuggerFactory will instantiate a ConsensusStateDebugHandler based on the provided config
+func NewConsensusStateDebuggerFactory(config config.ConsensusDebugConfig, workingDir string) (ConsensusStateDebugHandler, error) {
+	if !config.Enabled {
+		return consensus.NewDisabledConsensusStateDebugger(), nil
+	}
+
+	return consensus.NewConsensusStateDebugger(config, workingDir)
+}
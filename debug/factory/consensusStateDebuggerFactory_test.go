@@ -0,0 +1,39 @@
+package factory
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/debug/consensus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewConsensusStateDebuggerFactory_DisabledShouldWork(t *testing.T) {
+	t.Parallel()
+
+	csdh, err := NewConsensusStateDebuggerFactory(
+		config.ConsensusDebugConfig{
+			Enabled: false,
+		},
+		"",
+	)
+
+	assert.Nil(t, err)
+	expected := consensus.NewDisabledConsensusStateDebugger()
+	assert.IsType(t, expected, csdh)
+}
+
+func TestNewConsensusStateDebuggerFactory_ConsensusStateDebugger(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.ConsensusDebugConfig{
+		Enabled:         true,
+		NumRoundsToKeep: 10,
+		DumpFolderPath:  "consensus-debug",
+	}
+	csdh, err := NewConsensusStateDebuggerFactory(cfg, "")
+
+	assert.Nil(t, err)
+	expected, _ := consensus.NewConsensusStateDebugger(cfg, "")
+	assert.IsType(t, expected, csdh)
+}
@@ -10,3 +10,15 @@ type InterceptorResolverDebugHandler interface {
 	Query(topic string) []string
 	IsInterfaceNil() bool
 }
+
+// ConsensusStateDebugHandler records the consensus activity of the last rounds and can be queried about it
+type ConsensusStateDebugHandler interface {
+	StartRound(round int64, consensusGroup []string, leader string)
+	SetSubround(round int64, subround string)
+	AddReceivedMessage(round int64, pubKey []byte, messageType string)
+	AddSubroundTimeout(round int64, subround string)
+	AddCollectedSignature(round int64, pubKey string)
+	SetBlockCommitted(round int64)
+	Query(search string) []string
+	IsInterfaceNil() bool
+}
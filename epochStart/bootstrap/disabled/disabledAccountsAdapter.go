@@ -102,11 +102,21 @@ func (a *accountsAdapter) RecreateAllTries(_ []byte, _ context.Context) (map[str
 	return nil, nil
 }
 
+// GetTrie -
+func (a *accountsAdapter) GetTrie(_ []byte) (data.Trie, error) {
+	return nil, nil
+}
+
 // GetNumCheckpoints -
 func (a *accountsAdapter) GetNumCheckpoints() uint32 {
 	return 0
 }
 
+// IsSnapshotInProgress -
+func (a *accountsAdapter) IsSnapshotInProgress() bool {
+	return false
+}
+
 // IsInterfaceNil -
 func (a *accountsAdapter) IsInterfaceNil() bool {
 	return a == nil
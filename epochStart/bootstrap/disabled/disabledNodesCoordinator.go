@@ -93,6 +93,11 @@ func (n *nodesCoordinator) GetConsensusWhitelistedNodes(_ uint32) (map[string]st
 	return nil, nil
 }
 
+// GetEpochValidatorsAssignment -
+func (n *nodesCoordinator) GetEpochValidatorsAssignment(_ uint32) (*sharding.EpochValidatorsAssignment, error) {
+	return nil, nil
+}
+
 // ConsensusGroupSize -
 func (n *nodesCoordinator) ConsensusGroupSize(uint32) int {
 	return 0
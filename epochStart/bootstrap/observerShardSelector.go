@@ -0,0 +1,242 @@
+package bootstrap
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+)
+
+// ArgsObserverShardSelector holds the arguments needed for creating an observer shard selector
+type ArgsObserverShardSelector struct {
+	Messenger        Messenger
+	NumOfShards      uint32
+	ProbeDuration    time.Duration
+	DecisionFilePath string
+	ForceReselection bool
+}
+
+// observerShardDecision is the persisted result of an automatic observer shard selection
+type observerShardDecision struct {
+	ShardID     uint32
+	NumOfShards uint32
+}
+
+// shardLoad holds the metrics probed for a shard
+type shardLoad struct {
+	shardID     uint32
+	numPeers    int
+	numMessages uint64
+	numBytes    uint64
+}
+
+// observerShardSelector chooses the shard of an observer started with the automatic destination shard by probing the
+// network: the number of peers joined on each shard's transactions topic and the volume of the transactions broadcast
+// on it during the probing window. The decision is persisted so the observer stays in the same shard after a restart
+// unless the reselection is forced or the number of shards has changed
+type observerShardSelector struct {
+	messenger        Messenger
+	numOfShards      uint32
+	probeDuration    time.Duration
+	decisionFilePath string
+	forceReselection bool
+}
+
+// NewObserverShardSelector creates a new observer shard selector
+func NewObserverShardSelector(args ArgsObserverShardSelector) (*observerShardSelector, error) {
+	if check.IfNil(args.Messenger) {
+		return nil, epochStart.ErrNilMessenger
+	}
+	if args.NumOfShards == 0 {
+		return nil, fmt.Errorf("%w: 0 shards", epochStart.ErrInvalidObserverShardSelectorArgs)
+	}
+	if args.ProbeDuration <= 0 {
+		return nil, fmt.Errorf("%w: probe duration %v", epochStart.ErrInvalidObserverShardSelectorArgs, args.ProbeDuration)
+	}
+	if len(args.DecisionFilePath) == 0 {
+		return nil, fmt.Errorf("%w: empty decision file path", epochStart.ErrInvalidObserverShardSelectorArgs)
+	}
+
+	return &observerShardSelector{
+		messenger:        args.Messenger,
+		numOfShards:      args.NumOfShards,
+		probeDuration:    args.ProbeDuration,
+		decisionFilePath: args.DecisionFilePath,
+		forceReselection: args.ForceReselection,
+	}, nil
+}
+
+// SelectShard returns the persisted shard, if available, or probes the network and selects the least loaded shard
+func (oss *observerShardSelector) SelectShard() (uint32, error) {
+	if !oss.forceReselection {
+		shardID, ok := oss.loadDecision()
+		if ok {
+			log.Info("observer shard selector: using the persisted shard", "shard", shardID)
+			return shardID, nil
+		}
+	}
+
+	loads, err := oss.probeShardsLoads()
+	if err != nil {
+		return 0, err
+	}
+
+	shardID := selectLeastLoadedShard(loads)
+	log.Info("observer shard selector: selected the least loaded shard", "shard", shardID)
+
+	err = oss.saveDecision(shardID)
+	if err != nil {
+		return 0, err
+	}
+
+	return shardID, nil
+}
+
+func (oss *observerShardSelector) loadDecision() (uint32, bool) {
+	buff, err := ioutil.ReadFile(oss.decisionFilePath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Warn("observer shard selector: cannot read the persisted decision", "error", err.Error())
+		}
+		return 0, false
+	}
+
+	decision := &observerShardDecision{}
+	err = json.Unmarshal(buff, decision)
+	if err != nil {
+		log.Warn("observer shard selector: cannot parse the persisted decision", "error", err.Error())
+		return 0, false
+	}
+	if decision.NumOfShards != oss.numOfShards || decision.ShardID >= oss.numOfShards {
+		log.Info("observer shard selector: the number of shards has changed, selecting again",
+			"persisted", decision.NumOfShards, "current", oss.numOfShards)
+		return 0, false
+	}
+
+	return decision.ShardID, true
+}
+
+func (oss *observerShardSelector) saveDecision(shardID uint32) error {
+	buff, err := json.Marshal(&observerShardDecision{
+		ShardID:     shardID,
+		NumOfShards: oss.numOfShards,
+	})
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(oss.decisionFilePath, buff, core.FileModeUserReadWrite)
+}
+
+func (oss *observerShardSelector) probeShardsLoads() ([]*shardLoad, error) {
+	defer func() {
+		errMessenger := oss.messenger.UnregisterAllMessageProcessors()
+		log.LogIfError(errMessenger)
+
+		errMessenger = oss.messenger.UnjoinAllTopics()
+		log.LogIfError(errMessenger)
+	}()
+
+	counters := make([]*topicMessagesCounter, oss.numOfShards)
+	for shardID := uint32(0); shardID < oss.numOfShards; shardID++ {
+		counters[shardID] = &topicMessagesCounter{}
+		topic := shardTransactionsTopic(shardID)
+		if !oss.messenger.HasTopic(topic) {
+			err := oss.messenger.CreateTopic(topic, false)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err := oss.messenger.RegisterMessageProcessor(topic, counters[shardID])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	log.Info("observer shard selector: probing the shards load", "duration", oss.probeDuration)
+	time.Sleep(oss.probeDuration)
+
+	loads := make([]*shardLoad, 0, oss.numOfShards)
+	for shardID, counter := range counters {
+		load := &shardLoad{
+			shardID:     uint32(shardID),
+			numPeers:    len(oss.messenger.ConnectedPeersOnTopic(shardTransactionsTopic(uint32(shardID)))),
+			numMessages: atomic.LoadUint64(&counter.numMessages),
+			numBytes:    atomic.LoadUint64(&counter.numBytes),
+		}
+		log.Debug("observer shard selector: probed shard load", "shard", load.shardID,
+			"num peers", load.numPeers, "num messages", load.numMessages, "num bytes", load.numBytes)
+
+		loads = append(loads, load)
+	}
+
+	return loads, nil
+}
+
+func shardTransactionsTopic(shardID uint32) string {
+	return factory.TransactionTopic + core.CommunicationIdentifierBetweenShards(shardID, shardID)
+}
+
+// selectLeastLoadedShard returns the shard having the lowest sum between its share of the peers and its share of
+// the transactions volume. The lowest shard ID wins the ties
+func selectLeastLoadedShard(loads []*shardLoad) uint32 {
+	totalPeers := 0
+	totalBytes := uint64(0)
+	for _, load := range loads {
+		totalPeers += load.numPeers
+		totalBytes += load.numBytes
+	}
+
+	selectedShardID := uint32(0)
+	minScore := float64(0)
+	for idx, load := range loads {
+		score := computeShare(uint64(load.numPeers), uint64(totalPeers)) + computeShare(load.numBytes, totalBytes)
+		if idx == 0 || score < minScore {
+			selectedShardID = load.shardID
+			minScore = score
+		}
+	}
+
+	return selectedShardID
+}
+
+func computeShare(value uint64, total uint64) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return float64(value) / float64(total)
+}
+
+// topicMessagesCounter counts the messages received on a topic without processing them
+type topicMessagesCounter struct {
+	numMessages uint64
+	numBytes    uint64
+}
+
+// ProcessReceivedMessage counts the received message
+func (tmc *topicMessagesCounter) ProcessReceivedMessage(message p2p.MessageP2P, _ core.PeerID) error {
+	atomic.AddUint64(&tmc.numMessages, 1)
+	atomic.AddUint64(&tmc.numBytes, uint64(len(message.Data())))
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tmc *topicMessagesCounter) IsInterfaceNil() bool {
+	return tmc == nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (oss *observerShardSelector) IsInterfaceNil() bool {
+	return oss == nil
+}
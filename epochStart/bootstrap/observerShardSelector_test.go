@@ -0,0 +1,119 @@
+package bootstrap
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	p2pMock "github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsObserverShardSelector(dir string) ArgsObserverShardSelector {
+	return ArgsObserverShardSelector{
+		Messenger:        &mock.MessengerStub{},
+		NumOfShards:      3,
+		ProbeDuration:    time.Millisecond * 10,
+		DecisionFilePath: filepath.Join(dir, "observerShard.json"),
+	}
+}
+
+func TestNewObserverShardSelector_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsObserverShardSelector("dir")
+	args.Messenger = nil
+	oss, err := NewObserverShardSelector(args)
+	assert.True(t, check.IfNil(oss))
+	assert.Equal(t, epochStart.ErrNilMessenger, err)
+
+	args = createMockArgsObserverShardSelector("dir")
+	args.NumOfShards = 0
+	oss, err = NewObserverShardSelector(args)
+	assert.True(t, check.IfNil(oss))
+	assert.True(t, errors.Is(err, epochStart.ErrInvalidObserverShardSelectorArgs))
+
+	args = createMockArgsObserverShardSelector("dir")
+	args.ProbeDuration = 0
+	oss, err = NewObserverShardSelector(args)
+	assert.True(t, check.IfNil(oss))
+	assert.True(t, errors.Is(err, epochStart.ErrInvalidObserverShardSelectorArgs))
+
+	args = createMockArgsObserverShardSelector("dir")
+	args.DecisionFilePath = ""
+	oss, err = NewObserverShardSelector(args)
+	assert.True(t, check.IfNil(oss))
+	assert.True(t, errors.Is(err, epochStart.ErrInvalidObserverShardSelectorArgs))
+}
+
+func TestObserverShardSelector_SelectShardShouldPersistTheDecision(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "observerShardSelector")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	peersOnTopics := map[string][]core.PeerID{
+		shardTransactionsTopic(0): {"a", "b", "c"},
+		shardTransactionsTopic(1): {"d", "e"},
+		shardTransactionsTopic(2): {"f", "g"},
+	}
+	dataOnTopics := map[string][]byte{
+		shardTransactionsTopic(0): make([]byte, 10),
+		shardTransactionsTopic(1): make([]byte, 100),
+		shardTransactionsTopic(2): make([]byte, 10),
+	}
+	numProbes := 0
+	args := createMockArgsObserverShardSelector(dir)
+	args.Messenger = &mock.MessengerStub{
+		ConnectedPeersOnTopicCalled: func(topic string) []core.PeerID {
+			return peersOnTopics[topic]
+		},
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			numProbes++
+			return handler.ProcessReceivedMessage(&p2pMock.P2PMessageMock{DataField: dataOnTopics[topic]}, "")
+		},
+	}
+
+	oss, _ := NewObserverShardSelector(args)
+	shardID, err := oss.SelectShard()
+	require.Nil(t, err)
+	assert.Equal(t, uint32(2), shardID)
+	assert.Equal(t, 3, numProbes)
+
+	// the persisted decision is used on restart, even if the loads have changed
+	peersOnTopics[shardTransactionsTopic(2)] = []core.PeerID{"f", "g", "h", "i"}
+	oss, _ = NewObserverShardSelector(args)
+	shardID, err = oss.SelectShard()
+	require.Nil(t, err)
+	assert.Equal(t, uint32(2), shardID)
+	assert.Equal(t, 3, numProbes)
+
+	dataOnTopics[shardTransactionsTopic(1)] = make([]byte, 10)
+	args.ForceReselection = true
+	oss, _ = NewObserverShardSelector(args)
+	shardID, err = oss.SelectShard()
+	require.Nil(t, err)
+	assert.Equal(t, uint32(1), shardID)
+	assert.Equal(t, 6, numProbes)
+
+	// a changed number of shards invalidates the persisted decision
+	args.ForceReselection = false
+	args.NumOfShards = 2
+	oss, _ = NewObserverShardSelector(args)
+	shardID, err = oss.SelectShard()
+	require.Nil(t, err)
+	assert.Equal(t, uint32(1), shardID)
+	assert.Equal(t, 8, numProbes)
+}
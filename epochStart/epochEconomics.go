@@ -0,0 +1,13 @@
+package epochStart
+
+// EpochEconomics holds the aggregated economics values of an epoch, as computed by the metachain at the start of the
+// next epoch. The burned fees are the part of the accumulated fees and of the newly minted tokens that was not
+// distributed as rewards or developer fees
+type EpochEconomics struct {
+	Epoch              uint32 `json:"epoch"`
+	TotalFees          string `json:"totalFees"`
+	BurnedFees         string `json:"burnedFees"`
+	DeveloperRewards   string `json:"developerRewards"`
+	InflationMinted    string `json:"inflationMinted"`
+	RewardsDistributed string `json:"rewardsDistributed"`
+}
@@ -206,6 +206,15 @@ var ErrNilArgumentsParser = errors.New("nil arguments parsers")
 // ErrProtocolSustainabilityAddressInMetachain signals that protocol sustainability address is in metachain which is not allowed
 var ErrProtocolSustainabilityAddressInMetachain = errors.New("protocol sustainability address in metachain")
 
+// ErrInvalidProtocolSustainabilityWeight signals that an invalid protocol sustainability address weight was provided
+var ErrInvalidProtocolSustainabilityWeight = errors.New("invalid protocol sustainability address weight")
+
+// ErrInvalidInsuranceFundPercentage signals that an invalid insurance fund rewards percentage was provided
+var ErrInvalidInsuranceFundPercentage = errors.New("invalid insurance fund rewards percentage")
+
+// ErrDuplicatedProtocolSustainabilityAddress signals that a protocol sustainability address was provided more than once
+var ErrDuplicatedProtocolSustainabilityAddress = errors.New("duplicated protocol sustainability address")
+
 // ErrNilGenesisTotalSupply signals that nil genesis total supply has been provided
 var ErrNilGenesisTotalSupply = errors.New("nil genesis total supply")
 
@@ -286,3 +295,30 @@ var ErrSystemValidatorSCCall = errors.New("system validator sc call failed")
 
 // ErrOwnerDoesntHaveEligibleNodesInEpoch signals that the owner doesn't have any eligible nodes in epoch
 var ErrOwnerDoesntHaveEligibleNodesInEpoch = errors.New("owner has no eligible nodes in epoch")
+
+// ErrInvalidRewardsTopUpCurveSteepness signals that the steepness of the top-up rewards curve is invalid
+var ErrInvalidRewardsTopUpCurveSteepness = errors.New("top-up curve steepness invalid")
+
+// ErrNilTopUpRewardsSettingsHandler signals that a nil top-up rewards settings handler has been provided
+var ErrNilTopUpRewardsSettingsHandler = errors.New("nil top-up rewards settings handler")
+
+// ErrAddressNotRewarded signals that the provided address did not receive rewards in the requested epoch
+var ErrAddressNotRewarded = errors.New("address not rewarded")
+
+// ErrPeerMiniBlockMismatch signals that a synced peer miniblock does not match its header from the meta block
+var ErrPeerMiniBlockMismatch = errors.New("peer miniblock does not match its header")
+
+// ErrInvalidValidatorInfo signals that an invalid validator info was found in a peer miniblock
+var ErrInvalidValidatorInfo = errors.New("invalid validator info")
+
+// ErrDuplicatedValidatorInfo signals that the same public key was found more than once in the peer miniblocks
+var ErrDuplicatedValidatorInfo = errors.New("duplicated validator info")
+
+// ErrEconomicsMismatch signals that the economics data from the epoch start meta block is not consistent
+var ErrEconomicsMismatch = errors.New("epoch start economics data mismatch")
+
+// ErrInvalidNumWorkers signals that an invalid number of workers has been provided
+var ErrInvalidNumWorkers = errors.New("invalid number of workers")
+
+// ErrInvalidObserverShardSelectorArgs signals that invalid arguments have been provided to the observer shard selector
+var ErrInvalidObserverShardSelectorArgs = errors.New("invalid observer shard selector arguments")
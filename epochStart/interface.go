@@ -171,6 +171,14 @@ type EpochEconomicsDataProvider interface {
 	IsInterfaceNil() bool
 }
 
+// TopUpRewardsSettingsHandler provides the parameters of the top-up rewards curve used in each epoch
+type TopUpRewardsSettingsHandler interface {
+	RewardsTopUpGradientPointInEpoch(epoch uint32) *big.Int
+	RewardsTopUpFactorInEpoch(epoch uint32) float64
+	RewardsTopUpCurveSteepnessInEpoch(epoch uint32) float64
+	IsInterfaceNil() bool
+}
+
 // RewardsCreator defines the functionality for the metachain to create rewards at end of epoch
 type RewardsCreator interface {
 	CreateRewardsMiniBlocks(
@@ -3,11 +3,13 @@ package metachain
 import (
 	"bytes"
 	"encoding/hex"
+	"fmt"
 	"math/big"
 	"sort"
 	"strings"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -24,43 +26,60 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/vm"
 )
 
 // BaseRewardsCreatorArgs defines the arguments structure needed to create a base rewards creator
 type BaseRewardsCreatorArgs struct {
-	ShardCoordinator              sharding.Coordinator
-	PubkeyConverter               core.PubkeyConverter
-	RewardsStorage                storage.Storer
-	MiniBlockStorage              storage.Storer
-	Hasher                        hashing.Hasher
-	Marshalizer                   marshal.Marshalizer
-	DataPool                      dataRetriever.PoolsHolder
-	ProtocolSustainabilityAddress string
-	NodesConfigProvider           epochStart.NodesConfigProvider
-	DelegationSystemSCEnableEpoch uint32
-	UserAccountsDB                state.AccountsAdapter
-	RewardsFix1EpochEnable        uint32
+	ShardCoordinator                           sharding.Coordinator
+	PubkeyConverter                            core.PubkeyConverter
+	RewardsStorage                             storage.Storer
+	MiniBlockStorage                           storage.Storer
+	Hasher                                     hashing.Hasher
+	Marshalizer                                marshal.Marshalizer
+	DataPool                                   dataRetriever.PoolsHolder
+	ProtocolSustainabilityAddress              string
+	ProtocolSustainabilityAddresses            []config.ProtocolSustainabilityAddressConfig
+	ProtocolSustainabilityAddressesEnableEpoch uint32
+	NodesConfigProvider                        epochStart.NodesConfigProvider
+	DelegationSystemSCEnableEpoch              uint32
+	UserAccountsDB                             state.AccountsAdapter
+	RewardsFix1EpochEnable                     uint32
+	InsuranceFundPercentage                    float64
+	InsuranceFundEnableEpoch                   uint32
+}
+
+type protocolSustainabilityBeneficiary struct {
+	address []byte
+	weight  uint32
 }
 
 type baseRewardsCreator struct {
-	currTxs                            dataRetriever.TransactionCacher
-	shardCoordinator                   sharding.Coordinator
-	pubkeyConverter                    core.PubkeyConverter
-	rewardsStorage                     storage.Storer
-	miniBlockStorage                   storage.Storer
-	protocolSustainabilityAddress      []byte
-	nodesConfigProvider                epochStart.NodesConfigProvider
-	hasher                             hashing.Hasher
-	marshalizer                        marshal.Marshalizer
-	dataPool                           dataRetriever.PoolsHolder
-	mapBaseRewardsPerBlockPerValidator map[uint32]*big.Int
-	accumulatedRewards                 *big.Int
-	protocolSustainabilityValue        *big.Int
-	flagDelegationSystemSCEnabled      atomic.Flag
-	delegationSystemSCEnableEpoch      uint32
-	userAccountsDB                     state.AccountsAdapter
-	mutRewardsData                     sync.RWMutex
-	rewardsFix1EnableEpoch             uint32
+	currTxs                                    dataRetriever.TransactionCacher
+	shardCoordinator                           sharding.Coordinator
+	pubkeyConverter                            core.PubkeyConverter
+	rewardsStorage                             storage.Storer
+	miniBlockStorage                           storage.Storer
+	protocolSustainabilityAddress              []byte
+	protocolSustainabilityBeneficiaries        []*protocolSustainabilityBeneficiary
+	protocolSustainabilityTotalWeight          *big.Int
+	protocolSustainabilityAddressesEnableEpoch uint32
+	nodesConfigProvider                        epochStart.NodesConfigProvider
+	hasher                                     hashing.Hasher
+	marshalizer                                marshal.Marshalizer
+	dataPool                                   dataRetriever.PoolsHolder
+	mapBaseRewardsPerBlockPerValidator         map[uint32]*big.Int
+	accumulatedRewards                         *big.Int
+	protocolSustainabilityValue                *big.Int
+	flagDelegationSystemSCEnabled              atomic.Flag
+	delegationSystemSCEnableEpoch              uint32
+	userAccountsDB                             state.AccountsAdapter
+	mutRewardsData                             sync.RWMutex
+	rewardsAudit                               *epochStart.RewardsAudit
+	rewardsAuditPerShard                       map[uint32]*epochStart.ShardRewardsAudit
+	rewardsFix1EnableEpoch                     uint32
+	insuranceFundPercentage                    float64
+	insuranceFundEnableEpoch                   uint32
 }
 
 // NewBaseRewardsCreator will create a new base rewards creator instance
@@ -70,15 +89,19 @@ func NewBaseRewardsCreator(args BaseRewardsCreatorArgs) (*baseRewardsCreator, er
 		return nil, err
 	}
 
-	address, err := args.PubkeyConverter.Decode(args.ProtocolSustainabilityAddress)
+	address, err := decodeProtocolSustainabilityAddress(args.ProtocolSustainabilityAddress, args)
 	if err != nil {
-		log.Warn("invalid protocol sustainability reward address", "err", err, "provided address", args.ProtocolSustainabilityAddress)
 		return nil, err
 	}
 
-	protocolSustainabilityShardID := args.ShardCoordinator.ComputeId(address)
-	if protocolSustainabilityShardID == core.MetachainShardId {
-		return nil, epochStart.ErrProtocolSustainabilityAddressInMetachain
+	beneficiaries, err := createProtocolSustainabilityBeneficiaries(args)
+	if err != nil {
+		return nil, err
+	}
+
+	totalWeight := big.NewInt(0)
+	for _, beneficiary := range beneficiaries {
+		totalWeight.Add(totalWeight, big.NewInt(int64(beneficiary.weight)))
 	}
 
 	currTxsCache, err := dataPool.NewCurrentBlockPool()
@@ -87,27 +110,74 @@ func NewBaseRewardsCreator(args BaseRewardsCreatorArgs) (*baseRewardsCreator, er
 	}
 
 	brc := &baseRewardsCreator{
-		currTxs:                            currTxsCache,
-		shardCoordinator:                   args.ShardCoordinator,
-		pubkeyConverter:                    args.PubkeyConverter,
-		rewardsStorage:                     args.RewardsStorage,
-		hasher:                             args.Hasher,
-		marshalizer:                        args.Marshalizer,
-		miniBlockStorage:                   args.MiniBlockStorage,
-		dataPool:                           args.DataPool,
-		protocolSustainabilityAddress:      address,
-		nodesConfigProvider:                args.NodesConfigProvider,
-		accumulatedRewards:                 big.NewInt(0),
-		protocolSustainabilityValue:        big.NewInt(0),
-		delegationSystemSCEnableEpoch:      args.DelegationSystemSCEnableEpoch,
-		userAccountsDB:                     args.UserAccountsDB,
-		mapBaseRewardsPerBlockPerValidator: make(map[uint32]*big.Int),
-		rewardsFix1EnableEpoch:             args.RewardsFix1EpochEnable,
+		currTxs:                             currTxsCache,
+		shardCoordinator:                    args.ShardCoordinator,
+		pubkeyConverter:                     args.PubkeyConverter,
+		rewardsStorage:                      args.RewardsStorage,
+		hasher:                              args.Hasher,
+		marshalizer:                         args.Marshalizer,
+		miniBlockStorage:                    args.MiniBlockStorage,
+		dataPool:                            args.DataPool,
+		protocolSustainabilityAddress:       address,
+		protocolSustainabilityBeneficiaries: beneficiaries,
+		protocolSustainabilityTotalWeight:   totalWeight,
+		protocolSustainabilityAddressesEnableEpoch: args.ProtocolSustainabilityAddressesEnableEpoch,
+		nodesConfigProvider:                        args.NodesConfigProvider,
+		accumulatedRewards:                         big.NewInt(0),
+		protocolSustainabilityValue:                big.NewInt(0),
+		delegationSystemSCEnableEpoch:              args.DelegationSystemSCEnableEpoch,
+		userAccountsDB:                             args.UserAccountsDB,
+		mapBaseRewardsPerBlockPerValidator:         make(map[uint32]*big.Int),
+		rewardsFix1EnableEpoch:                     args.RewardsFix1EpochEnable,
+		insuranceFundPercentage:                    args.InsuranceFundPercentage,
+		insuranceFundEnableEpoch:                   args.InsuranceFundEnableEpoch,
 	}
 
 	return brc, nil
 }
 
+func createProtocolSustainabilityBeneficiaries(args BaseRewardsCreatorArgs) ([]*protocolSustainabilityBeneficiary, error) {
+	beneficiaries := make([]*protocolSustainabilityBeneficiary, 0, len(args.ProtocolSustainabilityAddresses))
+	addresses := make(map[string]struct{}, len(args.ProtocolSustainabilityAddresses))
+	for _, addressConfig := range args.ProtocolSustainabilityAddresses {
+		address, err := decodeProtocolSustainabilityAddress(addressConfig.Address, args)
+		if err != nil {
+			return nil, err
+		}
+		if addressConfig.Weight == 0 {
+			return nil, fmt.Errorf("%w for address %s", epochStart.ErrInvalidProtocolSustainabilityWeight, addressConfig.Address)
+		}
+
+		_, found := addresses[string(address)]
+		if found {
+			return nil, fmt.Errorf("%w: %s", epochStart.ErrDuplicatedProtocolSustainabilityAddress, addressConfig.Address)
+		}
+		addresses[string(address)] = struct{}{}
+
+		beneficiaries = append(beneficiaries, &protocolSustainabilityBeneficiary{
+			address: address,
+			weight:  addressConfig.Weight,
+		})
+	}
+
+	return beneficiaries, nil
+}
+
+func decodeProtocolSustainabilityAddress(encodedAddress string, args BaseRewardsCreatorArgs) ([]byte, error) {
+	address, err := args.PubkeyConverter.Decode(encodedAddress)
+	if err != nil {
+		log.Warn("invalid protocol sustainability reward address", "err", err, "provided address", encodedAddress)
+		return nil, err
+	}
+
+	protocolSustainabilityShardID := args.ShardCoordinator.ComputeId(address)
+	if protocolSustainabilityShardID == core.MetachainShardId {
+		return nil, epochStart.ErrProtocolSustainabilityAddressInMetachain
+	}
+
+	return address, nil
+}
+
 // GetProtocolSustainabilityRewards returns the sum of all rewards
 func (brc *baseRewardsCreator) GetProtocolSustainabilityRewards() *big.Int {
 	brc.mutRewardsData.RLock()
@@ -188,11 +258,19 @@ func (brc *baseRewardsCreator) GetRewardsTxs(body *block.Body) map[string]data.T
 	return rewardsTxs
 }
 
-// SaveTxBlockToStorage saves created data to storage
-func (brc *baseRewardsCreator) SaveTxBlockToStorage(_ *block.MetaBlock, body *block.Body) {
+// SaveTxBlockToStorage saves created data to storage, together with the rewards audit record, the rewards
+// checkpoint, the supply accounting record and the epoch economics aggregates if the provided block is a start of
+// epoch block
+func (brc *baseRewardsCreator) SaveTxBlockToStorage(metaBlock *block.MetaBlock, body *block.Body) {
 	if check.IfNil(body) {
 		return
 	}
+	if !check.IfNil(metaBlock) && metaBlock.IsStartOfEpochBlock() {
+		brc.saveRewardsAudit(metaBlock)
+		brc.saveRewardsCheckpoint(metaBlock, body)
+		brc.saveSupplyAccounting(metaBlock, body)
+		brc.saveEpochEconomics(metaBlock, body)
+	}
 
 	for _, miniBlock := range body.MiniBlocks {
 		if miniBlock.Type != block.RewardsBlock {
@@ -244,6 +322,13 @@ func (brc *baseRewardsCreator) DeleteTxsFromStorage(metaBlock *block.MetaBlock,
 			_ = brc.miniBlockStorage.Remove(mbHeader.Hash)
 		}
 	}
+
+	if metaBlock.IsStartOfEpochBlock() {
+		brc.removeRewardsAudit(metaBlock)
+		brc.removeRewardsCheckpoint(metaBlock)
+		brc.removeSupplyAccounting(metaBlock)
+		brc.removeEpochEconomics(metaBlock)
+	}
 }
 
 // RemoveBlockDataFromPools removes block info from pools
@@ -305,12 +390,23 @@ func checkBaseArgs(args BaseRewardsCreatorArgs) error {
 	if len(args.ProtocolSustainabilityAddress) == 0 {
 		return epochStart.ErrNilProtocolSustainabilityAddress
 	}
+	if len(args.ProtocolSustainabilityAddresses) == 0 {
+		return epochStart.ErrNilProtocolSustainabilityAddress
+	}
+	for _, addressConfig := range args.ProtocolSustainabilityAddresses {
+		if len(addressConfig.Address) == 0 {
+			return epochStart.ErrNilProtocolSustainabilityAddress
+		}
+	}
 	if check.IfNil(args.NodesConfigProvider) {
 		return epochStart.ErrNilNodesConfigProvider
 	}
 	if check.IfNil(args.UserAccountsDB) {
 		return epochStart.ErrNilAccountsDB
 	}
+	if args.InsuranceFundPercentage < 0 || args.InsuranceFundPercentage >= 1 {
+		return fmt.Errorf("%w: %f", epochStart.ErrInvalidInsuranceFundPercentage, args.InsuranceFundPercentage)
+	}
 
 	return nil
 }
@@ -342,21 +438,27 @@ func (brc *baseRewardsCreator) isSystemDelegationSC(address []byte) bool {
 	return len(val) > 0
 }
 
+// createProtocolSustainabilityRewardTransaction creates the reward transaction that accumulates the whole protocol
+// sustainability value. After the weighted addresses activation it is split between the beneficiaries when added to
+// the mini blocks
 func (brc *baseRewardsCreator) createProtocolSustainabilityRewardTransaction(
 	metaBlock *block.MetaBlock,
 	computedEconomics *block.Economics,
-) (*rewardTx.RewardTx, uint32, error) {
+) (*rewardTx.RewardTx, error) {
+	receiver := brc.protocolSustainabilityAddress
+	if brc.isProtocolSustainabilityAddressesEnabled(metaBlock.Epoch) {
+		receiver = brc.protocolSustainabilityBeneficiaries[0].address
+	}
 
-	shardID := brc.shardCoordinator.ComputeId(brc.protocolSustainabilityAddress)
 	protocolSustainabilityRwdTx := &rewardTx.RewardTx{
 		Round:   metaBlock.GetRound(),
 		Value:   big.NewInt(0).Set(computedEconomics.RewardsForProtocolSustainability),
-		RcvAddr: brc.protocolSustainabilityAddress,
+		RcvAddr: receiver,
 		Epoch:   metaBlock.Epoch,
 	}
 
 	brc.accumulatedRewards.Add(brc.accumulatedRewards, protocolSustainabilityRwdTx.Value)
-	return protocolSustainabilityRwdTx, shardID, nil
+	return protocolSustainabilityRwdTx, nil
 }
 
 func (brc *baseRewardsCreator) createRewardFromRwdInfo(
@@ -400,23 +502,104 @@ func (brc *baseRewardsCreator) initializeRewardsMiniBlocks() block.MiniBlockSlic
 	return miniBlocks
 }
 
+// addProtocolRewardToMiniBlocks adds one reward transaction for each protocol sustainability beneficiary and, if
+// enabled, the reward transaction towards the insurance fund system smart contract
 func (brc *baseRewardsCreator) addProtocolRewardToMiniBlocks(
 	protocolSustainabilityRwdTx *rewardTx.RewardTx,
 	miniBlocks block.MiniBlockSlice,
-	protocolSustainabilityShardId uint32,
 ) error {
-	protocolSustainabilityRwdHash, errHash := core.CalculateHash(brc.marshalizer, brc.hasher, protocolSustainabilityRwdTx)
-	if errHash != nil {
-		return errHash
+	beneficiariesRwdTx, insuranceFundRwdTx := brc.splitInsuranceFundReward(protocolSustainabilityRwdTx)
+	rwdTxs := brc.splitProtocolSustainabilityReward(beneficiariesRwdTx)
+	if insuranceFundRwdTx != nil {
+		rwdTxs = append(rwdTxs, insuranceFundRwdTx)
 	}
 
-	brc.currTxs.AddTx(protocolSustainabilityRwdHash, protocolSustainabilityRwdTx)
-	miniBlocks[protocolSustainabilityShardId].TxHashes = append(miniBlocks[protocolSustainabilityShardId].TxHashes, protocolSustainabilityRwdHash)
+	for _, rwdTx := range rwdTxs {
+		rwdTxHash, errHash := core.CalculateHash(brc.marshalizer, brc.hasher, rwdTx)
+		if errHash != nil {
+			return errHash
+		}
+
+		shardID := brc.shardCoordinator.ComputeId(rwdTx.RcvAddr)
+		if shardID == core.MetachainShardId {
+			shardID = brc.shardCoordinator.NumberOfShards()
+		}
+		brc.currTxs.AddTx(rwdTxHash, rwdTx)
+		miniBlocks[shardID].TxHashes = append(miniBlocks[shardID].TxHashes, rwdTxHash)
+	}
 	brc.protocolSustainabilityValue.Set(protocolSustainabilityRwdTx.Value)
 
 	return nil
 }
 
+// splitInsuranceFundReward carves the insurance fund share out of the protocol sustainability value. The returned
+// insurance fund transaction is nil if the insurance fund is not enabled in the epoch of the provided transaction
+func (brc *baseRewardsCreator) splitInsuranceFundReward(
+	protocolSustainabilityRwdTx *rewardTx.RewardTx,
+) (*rewardTx.RewardTx, *rewardTx.RewardTx) {
+	if protocolSustainabilityRwdTx.Epoch < brc.insuranceFundEnableEpoch || brc.insuranceFundPercentage <= 0 {
+		return protocolSustainabilityRwdTx, nil
+	}
+
+	insuranceFundValue := core.GetPercentageOfValue(protocolSustainabilityRwdTx.Value, brc.insuranceFundPercentage)
+	if insuranceFundValue.Cmp(zero) <= 0 {
+		return protocolSustainabilityRwdTx, nil
+	}
+
+	beneficiariesRwdTx := &rewardTx.RewardTx{
+		Round:   protocolSustainabilityRwdTx.Round,
+		Value:   big.NewInt(0).Sub(protocolSustainabilityRwdTx.Value, insuranceFundValue),
+		RcvAddr: protocolSustainabilityRwdTx.RcvAddr,
+		Epoch:   protocolSustainabilityRwdTx.Epoch,
+	}
+	insuranceFundRwdTx := &rewardTx.RewardTx{
+		Round:   protocolSustainabilityRwdTx.Round,
+		Value:   insuranceFundValue,
+		RcvAddr: vm.InsuranceFundSCAddress,
+		Epoch:   protocolSustainabilityRwdTx.Epoch,
+	}
+
+	return beneficiariesRwdTx, insuranceFundRwdTx
+}
+
+// splitProtocolSustainabilityReward splits the protocol sustainability value proportionally with the beneficiaries'
+// weights. The rounding leftover goes to the first beneficiary, which is the receiver of the provided transaction.
+// Before the weighted addresses activation the whole value goes to the protocol sustainability address
+func (brc *baseRewardsCreator) splitProtocolSustainabilityReward(protocolSustainabilityRwdTx *rewardTx.RewardTx) []*rewardTx.RewardTx {
+	if !brc.isProtocolSustainabilityAddressesEnabled(protocolSustainabilityRwdTx.Epoch) ||
+		len(brc.protocolSustainabilityBeneficiaries) == 1 {
+		return []*rewardTx.RewardTx{protocolSustainabilityRwdTx}
+	}
+
+	rwdTxs := make([]*rewardTx.RewardTx, 0, len(brc.protocolSustainabilityBeneficiaries))
+	leftover := big.NewInt(0).Set(protocolSustainabilityRwdTx.Value)
+	for _, beneficiary := range brc.protocolSustainabilityBeneficiaries[1:] {
+		value := big.NewInt(0).Mul(protocolSustainabilityRwdTx.Value, big.NewInt(int64(beneficiary.weight)))
+		value.Quo(value, brc.protocolSustainabilityTotalWeight)
+		leftover.Sub(leftover, value)
+
+		rwdTxs = append(rwdTxs, &rewardTx.RewardTx{
+			Round:   protocolSustainabilityRwdTx.Round,
+			Value:   value,
+			RcvAddr: beneficiary.address,
+			Epoch:   protocolSustainabilityRwdTx.Epoch,
+		})
+	}
+
+	firstRwdTx := &rewardTx.RewardTx{
+		Round:   protocolSustainabilityRwdTx.Round,
+		Value:   leftover,
+		RcvAddr: protocolSustainabilityRwdTx.RcvAddr,
+		Epoch:   protocolSustainabilityRwdTx.Epoch,
+	}
+
+	return append([]*rewardTx.RewardTx{firstRwdTx}, rwdTxs...)
+}
+
+func (brc *baseRewardsCreator) isProtocolSustainabilityAddressesEnabled(epoch uint32) bool {
+	return epoch >= brc.protocolSustainabilityAddressesEnableEpoch
+}
+
 func (brc *baseRewardsCreator) finalizeMiniBlocks(miniBlocks block.MiniBlockSlice) block.MiniBlockSlice {
 	for shId := uint32(0); shId <= brc.shardCoordinator.NumberOfShards(); shId++ {
 		sort.Slice(miniBlocks[shId].TxHashes, func(i, j int) bool {
@@ -7,6 +7,8 @@ import (
 	"math/big"
 	"testing"
 
+	"errors"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -22,6 +24,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -101,6 +104,17 @@ func TestBaseRewardsCreator_NilMarshalizer(t *testing.T) {
 func TestBaseRewardsCreator_EmptyProtocolSustainabilityAddress(t *testing.T) {
 	t.Parallel()
 
+	args := getBaseRewardsArguments()
+	args.ProtocolSustainabilityAddresses[0].Address = ""
+
+	rwd, err := NewBaseRewardsCreator(args)
+	assert.True(t, check.IfNil(rwd))
+	assert.Equal(t, epochStart.ErrNilProtocolSustainabilityAddress, err)
+}
+
+func TestBaseRewardsCreator_EmptySingleProtocolSustainabilityAddress(t *testing.T) {
+	t.Parallel()
+
 	args := getBaseRewardsArguments()
 	args.ProtocolSustainabilityAddress = ""
 
@@ -113,13 +127,51 @@ func TestBaseRewardsCreator_InvalidProtocolSustainabilityAddress(t *testing.T) {
 	t.Parallel()
 
 	args := getBaseRewardsArguments()
-	args.ProtocolSustainabilityAddress = "xyz" // not a hex string
+	args.ProtocolSustainabilityAddresses[0].Address = "xyz" // not a hex string
 
 	rwd, err := NewBaseRewardsCreator(args)
 	assert.True(t, check.IfNil(rwd))
 	assert.NotNil(t, err)
 }
 
+func TestBaseRewardsCreator_InvalidProtocolSustainabilityWeight(t *testing.T) {
+	t.Parallel()
+
+	args := getBaseRewardsArguments()
+	args.ProtocolSustainabilityAddresses[0].Weight = 0
+
+	rwd, err := NewBaseRewardsCreator(args)
+	assert.True(t, check.IfNil(rwd))
+	assert.True(t, errors.Is(err, epochStart.ErrInvalidProtocolSustainabilityWeight))
+}
+
+func TestBaseRewardsCreator_DuplicatedProtocolSustainabilityAddress(t *testing.T) {
+	t.Parallel()
+
+	args := getBaseRewardsArguments()
+	args.ProtocolSustainabilityAddresses = append(args.ProtocolSustainabilityAddresses, args.ProtocolSustainabilityAddresses[0])
+
+	rwd, err := NewBaseRewardsCreator(args)
+	assert.True(t, check.IfNil(rwd))
+	assert.True(t, errors.Is(err, epochStart.ErrDuplicatedProtocolSustainabilityAddress))
+}
+
+func TestBaseRewardsCreator_InvalidInsuranceFundPercentage(t *testing.T) {
+	t.Parallel()
+
+	args := getBaseRewardsArguments()
+	args.InsuranceFundPercentage = 1
+
+	rwd, err := NewBaseRewardsCreator(args)
+	assert.True(t, check.IfNil(rwd))
+	assert.True(t, errors.Is(err, epochStart.ErrInvalidInsuranceFundPercentage))
+
+	args.InsuranceFundPercentage = -0.1
+	rwd, err = NewBaseRewardsCreator(args)
+	assert.True(t, check.IfNil(rwd))
+	assert.True(t, errors.Is(err, epochStart.ErrInvalidInsuranceFundPercentage))
+}
+
 func TestBaseRewardsCreator_NilDataPoolHolder(t *testing.T) {
 	t.Parallel()
 
@@ -185,7 +237,7 @@ func TestBaseRewardsCreator_ProtocolSustainabilityAddressInMetachainShouldErr(t
 	var err error
 	args.ShardCoordinator, err = sharding.NewMultiShardCoordinator(2, 0)
 	// wrong configuration of staking system SC address (in metachain) as protocol sustainability address
-	args.ProtocolSustainabilityAddress = hex.EncodeToString([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 255, 255})
+	args.ProtocolSustainabilityAddresses[0].Address = hex.EncodeToString([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 255, 255})
 
 	rwd, err := NewBaseRewardsCreator(args)
 	assert.True(t, check.IfNil(rwd))
@@ -235,7 +287,7 @@ func TestBaseRewardsCreator_addProtocolRewardToMiniblocks(t *testing.T) {
 	require.NotNil(t, rwd)
 
 	initialProtRewardValue := big.NewInt(-100)
-	protRwAddr, _ := args.PubkeyConverter.Decode(args.ProtocolSustainabilityAddress)
+	protRwAddr, _ := args.PubkeyConverter.Decode(args.ProtocolSustainabilityAddresses[0].Address)
 	protRwTx := &rewardTx.RewardTx{
 		Round:   100,
 		Value:   big.NewInt(0).Set(initialProtRewardValue),
@@ -248,9 +300,8 @@ func TestBaseRewardsCreator_addProtocolRewardToMiniblocks(t *testing.T) {
 
 	protRwTxHash := args.Hasher.Compute(string(marshalled))
 
-	protRwShard := args.ShardCoordinator.ComputeId(protRwAddr)
 	mbSlice := createDefaultMiniBlocksSlice()
-	err = rwd.addProtocolRewardToMiniBlocks(protRwTx, mbSlice, protRwShard)
+	err = rwd.addProtocolRewardToMiniBlocks(protRwTx, mbSlice)
 
 	found := false
 	for _, mb := range mbSlice {
@@ -263,6 +314,100 @@ func TestBaseRewardsCreator_addProtocolRewardToMiniblocks(t *testing.T) {
 	require.True(t, found)
 }
 
+func TestBaseRewardsCreator_addProtocolRewardToMiniblocksShouldSplitBetweenBeneficiaries(t *testing.T) {
+	t.Parallel()
+
+	args := getBaseRewardsArguments()
+	args.ShardCoordinator = &mock.ShardCoordinatorStub{
+		NumberOfShardsCalled: func() uint32 {
+			return 2
+		},
+		ComputeIdCalled: func(address []byte) uint32 {
+			return uint32(address[0]) % 2
+		},
+	}
+	args.ProtocolSustainabilityAddresses = []config.ProtocolSustainabilityAddressConfig{
+		{Address: "11", Weight: 3},
+		{Address: "12", Weight: 1},
+	}
+	args.ProtocolSustainabilityAddressesEnableEpoch = 1
+	rwd, err := NewBaseRewardsCreator(args)
+	require.Nil(t, err)
+
+	protRwTx := &rewardTx.RewardTx{
+		Round:   100,
+		Value:   big.NewInt(1001),
+		RcvAddr: []byte{17},
+		Epoch:   1,
+	}
+	mbSlice := createDefaultMiniBlocksSlice()
+	err = rwd.addProtocolRewardToMiniBlocks(protRwTx, mbSlice)
+	require.Nil(t, err)
+
+	expectedValues := map[uint32]*big.Int{
+		0: big.NewInt(250),
+		1: big.NewInt(751),
+	}
+	for shardID, expectedValue := range expectedValues {
+		require.Equal(t, 1, len(mbSlice[shardID].TxHashes))
+		tx, errGet := rwd.currTxs.GetTx(mbSlice[shardID].TxHashes[0])
+		require.Nil(t, errGet)
+		assert.Equal(t, expectedValue, tx.GetValue())
+		assert.Equal(t, shardID, args.ShardCoordinator.ComputeId(tx.GetRcvAddr()))
+	}
+	assert.Equal(t, big.NewInt(1001), rwd.GetProtocolSustainabilityRewards())
+}
+
+func TestBaseRewardsCreator_addProtocolRewardToMiniblocksShouldAddInsuranceFundShare(t *testing.T) {
+	t.Parallel()
+
+	args := getBaseRewardsArguments()
+	args.ShardCoordinator = &mock.ShardCoordinatorStub{
+		NumberOfShardsCalled: func() uint32 {
+			return 2
+		},
+		ComputeIdCalled: func(address []byte) uint32 {
+			if bytes.Equal(address, vm.InsuranceFundSCAddress) {
+				return core.MetachainShardId
+			}
+			return 0
+		},
+	}
+	args.InsuranceFundPercentage = 0.1
+	args.InsuranceFundEnableEpoch = 2
+	rwd, err := NewBaseRewardsCreator(args)
+	require.Nil(t, err)
+
+	protRwTx := &rewardTx.RewardTx{
+		Round:   100,
+		Value:   big.NewInt(1000),
+		RcvAddr: []byte{17},
+		Epoch:   1,
+	}
+	mbSlice := createDefaultMiniBlocksSlice()
+	err = rwd.addProtocolRewardToMiniBlocks(protRwTx, mbSlice)
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(mbSlice[0].TxHashes))
+	assert.Equal(t, 0, len(mbSlice[2].TxHashes))
+
+	protRwTx.Epoch = 2
+	mbSlice = createDefaultMiniBlocksSlice()
+	err = rwd.addProtocolRewardToMiniBlocks(protRwTx, mbSlice)
+	require.Nil(t, err)
+
+	require.Equal(t, 1, len(mbSlice[0].TxHashes))
+	tx, err := rwd.currTxs.GetTx(mbSlice[0].TxHashes[0])
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(900), tx.GetValue())
+
+	require.Equal(t, 1, len(mbSlice[2].TxHashes))
+	tx, err = rwd.currTxs.GetTx(mbSlice[2].TxHashes[0])
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), tx.GetValue())
+	assert.Equal(t, vm.InsuranceFundSCAddress, tx.GetRcvAddr())
+	assert.Equal(t, big.NewInt(1000), rwd.GetProtocolSustainabilityRewards())
+}
+
 func TestBaseRewardsCreator_CreateMarshalizedDataNilMiniblocksEmptyMap(t *testing.T) {
 	t.Parallel()
 
@@ -871,12 +1016,74 @@ func TestBaseRewardsCreator_createProtocolSustainabilityRewardTransaction(t *tes
 		DevFeesInEpoch: big.NewInt(0),
 	}
 
-	rwTx, _, err := rwd.createProtocolSustainabilityRewardTransaction(metaBlk, &metaBlk.EpochStart.Economics)
+	rwTx, err := rwd.createProtocolSustainabilityRewardTransaction(metaBlk, &metaBlk.EpochStart.Economics)
 	require.Nil(t, err)
 	require.NotNil(t, rwTx)
 	require.Equal(t, metaBlk.EpochStart.Economics.RewardsForProtocolSustainability, rwTx.Value)
 }
 
+func TestBaseRewardsCreator_ProtocolSustainabilityAddressesBeforeAndAfterActivation(t *testing.T) {
+	t.Parallel()
+
+	args := getBaseRewardsArguments()
+	args.ShardCoordinator = &mock.ShardCoordinatorStub{
+		NumberOfShardsCalled: func() uint32 {
+			return 2
+		},
+		ComputeIdCalled: func(address []byte) uint32 {
+			return uint32(address[0]) % 2
+		},
+	}
+	args.ProtocolSustainabilityAddress = "10"
+	args.ProtocolSustainabilityAddresses = []config.ProtocolSustainabilityAddressConfig{
+		{Address: "11", Weight: 3},
+		{Address: "12", Weight: 1},
+	}
+	args.ProtocolSustainabilityAddressesEnableEpoch = 2
+	rwd, err := NewBaseRewardsCreator(args)
+	require.Nil(t, err)
+
+	metaBlk := &block.MetaBlock{
+		Epoch:          1,
+		EpochStart:     getDefaultEpochStart(),
+		DevFeesInEpoch: big.NewInt(0),
+	}
+	metaBlk.EpochStart.Economics.RewardsForProtocolSustainability = big.NewInt(1001)
+
+	protRwTx, err := rwd.createProtocolSustainabilityRewardTransaction(metaBlk, &metaBlk.EpochStart.Economics)
+	require.Nil(t, err)
+	assert.Equal(t, []byte{16}, protRwTx.RcvAddr)
+
+	mbSlice := createDefaultMiniBlocksSlice()
+	err = rwd.addProtocolRewardToMiniBlocks(protRwTx, mbSlice)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(mbSlice[0].TxHashes))
+	assert.Equal(t, 0, len(mbSlice[1].TxHashes))
+	tx, err := rwd.currTxs.GetTx(mbSlice[0].TxHashes[0])
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(1001), tx.GetValue())
+	assert.Equal(t, []byte{16}, tx.GetRcvAddr())
+
+	metaBlk.Epoch = 2
+	protRwTx, err = rwd.createProtocolSustainabilityRewardTransaction(metaBlk, &metaBlk.EpochStart.Economics)
+	require.Nil(t, err)
+	assert.Equal(t, []byte{17}, protRwTx.RcvAddr)
+
+	mbSlice = createDefaultMiniBlocksSlice()
+	err = rwd.addProtocolRewardToMiniBlocks(protRwTx, mbSlice)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(mbSlice[0].TxHashes))
+	require.Equal(t, 1, len(mbSlice[1].TxHashes))
+	tx, err = rwd.currTxs.GetTx(mbSlice[1].TxHashes[0])
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(751), tx.GetValue())
+	assert.Equal(t, []byte{17}, tx.GetRcvAddr())
+	tx, err = rwd.currTxs.GetTx(mbSlice[0].TxHashes[0])
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(250), tx.GetValue())
+	assert.Equal(t, []byte{18}, tx.GetRcvAddr())
+}
+
 func TestBaseRewardsCreator_createRewardFromRwdInfo(t *testing.T) {
 	t.Parallel()
 
@@ -1136,6 +1343,9 @@ func getBaseRewardsArguments() BaseRewardsCreatorArgs {
 		Marshalizer:                   &mock.MarshalizerMock{},
 		DataPool:                      testscommon.NewPoolsHolderMock(),
 		ProtocolSustainabilityAddress: "11", // string hex => 17 decimal
+		ProtocolSustainabilityAddresses: []config.ProtocolSustainabilityAddressConfig{
+			{Address: "11", Weight: 1}, // string hex => 17 decimal
+		},
 		NodesConfigProvider: &mock.NodesCoordinatorStub{
 			ConsensusGroupSizeCalled: func(shardID uint32) int {
 				if shardID == core.MetachainShardId {
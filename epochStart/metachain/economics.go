@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -128,7 +129,10 @@ func (e *economics) ComputeEndOfEpochEconomics(
 	maxBlocksInEpoch := core.MaxUint64(1, roundsPassedInEpoch*uint64(e.shardCoordinator.NumberOfShards()+1))
 	totalNumBlocksInEpoch := e.computeNumOfTotalCreatedBlocks(noncesPerShardPrevEpoch, noncesPerShardCurrEpoch)
 
-	inflationRate := e.computeInflationRate(metaBlock.GetRound())
+	// the start of epoch block closes the previous epoch, so the inflation schedule entry and the total supply cap of
+	// the rewarded epoch are applied
+	rewardedEpoch := metaBlock.GetEpoch() - 1
+	inflationRate := e.computeInflationRate(metaBlock.GetRound(), rewardedEpoch)
 	rwdPerBlock := e.computeRewardsPerBlock(e.genesisTotalSupply, maxBlocksInEpoch, inflationRate)
 	totalRewardsToBeDistributed := big.NewInt(0).Mul(rwdPerBlock, big.NewInt(0).SetUint64(totalNumBlocksInEpoch))
 
@@ -139,6 +143,17 @@ func (e *economics) ComputeEndOfEpochEconomics(
 		rwdPerBlock.Div(totalRewardsToBeDistributed, big.NewInt(0).SetUint64(totalNumBlocksInEpoch))
 	}
 
+	maxNewTokens := e.computeMaxNewlyMintedTokens(prevEpochEconomics.TotalSupply, rewardedEpoch)
+	if maxNewTokens != nil && newTokens.Cmp(maxNewTokens) > 0 {
+		log.Debug("economics: newly minted tokens limited by the total supply cap",
+			"computed", newTokens.String(),
+			"limited to", maxNewTokens.String(),
+		)
+		newTokens = maxNewTokens
+		totalRewardsToBeDistributed = big.NewInt(0).Add(metaBlock.AccumulatedFeesInEpoch, newTokens)
+		rwdPerBlock.Div(totalRewardsToBeDistributed, big.NewInt(0).SetUint64(totalNumBlocksInEpoch))
+	}
+
 	remainingToBeDistributed := big.NewInt(0).Sub(totalRewardsToBeDistributed, metaBlock.DevFeesInEpoch)
 	e.adjustRewardsPerBlockWithDeveloperFees(rwdPerBlock, metaBlock.DevFeesInEpoch, totalNumBlocksInEpoch)
 	rewardsForLeaders := e.adjustRewardsPerBlockWithLeaderPercentage(rwdPerBlock, metaBlock.AccumulatedFeesInEpoch, metaBlock.DevFeesInEpoch, totalNumBlocksInEpoch, metaBlock.Epoch)
@@ -295,14 +310,37 @@ func (e *economics) adjustRewardsPerBlockWithLeaderPercentage(
 	return rewardsForLeaders
 }
 
-// compute inflation rate from genesisTotalSupply and economics settings for that year
-func (e *economics) computeInflationRate(currentRound uint64) float64 {
-	roundsPerDay := numberOfSecondsInDay / uint64(e.roundTime.TimeDuration().Seconds())
-	roundsPerYear := numberOfDaysInYear * roundsPerDay
-	yearsIndex := uint32(currentRound/roundsPerYear) + 1
+// compute inflation rate from the inflation schedule entry of the provided epoch or, if no inflation schedule is
+// configured, from genesisTotalSupply and economics settings for that year. The years are counted using the
+// time elapsed since genesis, so the rounds before and after a round duration change are correctly accounted
+func (e *economics) computeInflationRate(currentRound uint64, epoch uint32) float64 {
+	inflationRate, isScheduled := e.rewardsHandler.MaxInflationRateInEpoch(epoch)
+	if isScheduled {
+		return inflationRate
+	}
+
+	secondsSinceGenesis := uint64(e.roundTime.TimeDurationBetweenRounds(0, int64(currentRound)) / time.Second)
+	secondsPerYear := uint64(numberOfDaysInYear * numberOfSecondsInDay)
+	yearsIndex := uint32(secondsSinceGenesis/secondsPerYear) + 1
 	return e.rewardsHandler.MaxInflationRate(yearsIndex)
 }
 
+// computeMaxNewlyMintedTokens returns the maximum value that can be minted without exceeding the total supply cap of
+// the provided epoch or nil if the total supply is not capped
+func (e *economics) computeMaxNewlyMintedTokens(prevTotalSupply *big.Int, epoch uint32) *big.Int {
+	totalSupplyCap := e.rewardsHandler.TotalSupplyCapInEpoch(epoch)
+	if totalSupplyCap == nil {
+		return nil
+	}
+
+	maxNewTokens := big.NewInt(0).Sub(totalSupplyCap, prevTotalSupply)
+	if maxNewTokens.Sign() <= 0 {
+		return big.NewInt(0)
+	}
+
+	return maxNewTokens
+}
+
 // compute rewards per block from according to inflation rate and total supply from previous block and maxBlocksPerEpoch
 func (e *economics) computeRewardsPerBlock(
 	prevTotalSupply *big.Int,
@@ -433,11 +471,13 @@ func logEconomicsDifferences(computed *block.Economics, received *block.Economic
 		"computed rewards per block per node", computed.RewardsPerBlock,
 		"computed rewards for protocol sustainability", computed.RewardsForProtocolSustainability,
 		"computed node price", computed.NodePrice,
+		"computed rewards root hash", computed.RewardsRootHash,
 		"\nreceived total to distribute", received.TotalToDistribute,
 		"received total newly minted", received.TotalNewlyMinted,
 		"received total supply", received.TotalSupply,
 		"received rewards per block per node", received.RewardsPerBlock,
 		"received rewards for protocol sustainability", received.RewardsForProtocolSustainability,
 		"received node price", received.NodePrice,
+		"received rewards root hash", received.RewardsRootHash,
 	)
 }
@@ -314,27 +314,96 @@ func TestEconomics_ComputeInflationRate(t *testing.T) {
 	}
 	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
 
-	rate := ec.computeInflationRate(1)
+	rate := ec.computeInflationRate(1, 0)
 	assert.Nil(t, errFound)
 	assert.Equal(t, rate, year1inflation)
 
-	rate = ec.computeInflationRate(50000)
+	rate = ec.computeInflationRate(50000, 0)
 	assert.Nil(t, errFound)
 	assert.Equal(t, rate, year1inflation)
 
-	rate = ec.computeInflationRate(7884000)
+	rate = ec.computeInflationRate(7884000, 0)
 	assert.Nil(t, errFound)
 	assert.Equal(t, rate, year2inflation)
 
-	rate = ec.computeInflationRate(8884000)
+	rate = ec.computeInflationRate(8884000, 0)
 	assert.Nil(t, errFound)
 	assert.Equal(t, rate, year2inflation)
 
-	rate = ec.computeInflationRate(38884000)
+	rate = ec.computeInflationRate(38884000, 0)
 	assert.Nil(t, errFound)
 	assert.Equal(t, rate, lateYearInflation)
 }
 
+func TestEconomics_ComputeInflationRateShouldUseTheTimeSinceGenesis(t *testing.T) {
+	t.Parallel()
+
+	args := getArguments()
+	changeRound := int64(1000)
+	args.RoundTime = &mock.RoundTimeDurationHandler{
+		TimeDurationBetweenRoundsCalled: func(fromRound int64, toRound int64) time.Duration {
+			if toRound <= changeRound {
+				return time.Duration(toRound-fromRound) * 4 * time.Second
+			}
+			return time.Duration(changeRound-fromRound)*4*time.Second + time.Duration(toRound-changeRound)*2*time.Second
+		},
+	}
+	args.RewardsHandler = &mock.RewardsHandlerStub{
+		MaxInflationRateCalled: func(year uint32) float64 {
+			return float64(year)
+		},
+	}
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	// 7884000 rounds of 4 seconds are one year, with rounds of 2 seconds it takes twice as many rounds
+	rate := ec.computeInflationRate(7884000, 0)
+	assert.Equal(t, 1.0, rate)
+
+	rate = ec.computeInflationRate(uint64(2*7884000-changeRound), 0)
+	assert.Equal(t, 2.0, rate)
+}
+
+func TestEconomics_ComputeInflationRateShouldUseTheInflationSchedule(t *testing.T) {
+	t.Parallel()
+
+	args := getArguments()
+	args.RewardsHandler = &mock.RewardsHandlerStub{
+		MaxInflationRateCalled: func(_ uint32) float64 {
+			return 0.1
+		},
+		MaxInflationRateInEpochCalled: func(epoch uint32) (float64, bool) {
+			if epoch < 10 {
+				return 0, false
+			}
+			return 0.05, true
+		},
+	}
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	assert.Equal(t, 0.1, ec.computeInflationRate(7884000, 9))
+	assert.Equal(t, 0.05, ec.computeInflationRate(7884000, 10))
+}
+
+func TestEconomics_ComputeMaxNewlyMintedTokens(t *testing.T) {
+	t.Parallel()
+
+	args := getArguments()
+	args.RewardsHandler = &mock.RewardsHandlerStub{
+		TotalSupplyCapInEpochCalled: func(epoch uint32) *big.Int {
+			if epoch < 10 {
+				return nil
+			}
+			return big.NewInt(1000)
+		},
+	}
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	assert.Nil(t, ec.computeMaxNewlyMintedTokens(big.NewInt(900), 9))
+	assert.Equal(t, big.NewInt(100), ec.computeMaxNewlyMintedTokens(big.NewInt(900), 10))
+	assert.Equal(t, big.NewInt(0), ec.computeMaxNewlyMintedTokens(big.NewInt(1000), 10))
+	assert.Equal(t, big.NewInt(0), ec.computeMaxNewlyMintedTokens(big.NewInt(1100), 10))
+}
+
 func TestEconomics_ComputeEndOfEpochEconomics(t *testing.T) {
 	t.Parallel()
 
@@ -380,6 +449,83 @@ func TestEconomics_ComputeEndOfEpochEconomics(t *testing.T) {
 	assert.NotNil(t, res)
 }
 
+func TestEconomics_ComputeEndOfEpochEconomicsShouldApplyTheScheduleOfTheRewardedEpoch(t *testing.T) {
+	t.Parallel()
+
+	prevTotalSupply := big.NewInt(100000)
+	args := getArguments()
+	args.Store = &mock.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &mock.StorerStub{GetCalled: func(key []byte) ([]byte, error) {
+				hdr := block.MetaBlock{
+					Round: 10,
+					Nonce: 5,
+					EpochStart: block.EpochStart{
+						Economics: block.Economics{
+							TotalSupply: prevTotalSupply,
+							NodePrice:   big.NewInt(10),
+						},
+					},
+				}
+				hdrBytes, _ := json.Marshal(hdr)
+				return hdrBytes, nil
+			}}
+		},
+	}
+	args.GenesisTotalSupply = big.NewInt(0).Exp(big.NewInt(10), big.NewInt(24), nil)
+	scheduleStartEpoch := uint32(3)
+	inflationRequestedEpochs := make([]uint32, 0)
+	args.RewardsHandler = &mock.RewardsHandlerStub{
+		MaxInflationRateInEpochCalled: func(epoch uint32) (float64, bool) {
+			inflationRequestedEpochs = append(inflationRequestedEpochs, epoch)
+			if epoch < scheduleStartEpoch {
+				return 0.1, true
+			}
+			return 0.2, true
+		},
+		TotalSupplyCapInEpochCalled: func(epoch uint32) *big.Int {
+			if epoch < scheduleStartEpoch {
+				return nil
+			}
+			return prevTotalSupply
+		},
+	}
+	args.RoundTime = &mock.RoundTimeDurationHandler{
+		TimeDurationCalled: func() time.Duration {
+			return 4 * time.Second
+		},
+	}
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	createEpochStartMetaBlock := func(epoch uint32) *block.MetaBlock {
+		return &block.MetaBlock{
+			Round: 15000,
+			EpochStart: block.EpochStart{
+				LastFinalizedHeaders: []block.EpochStartShardData{
+					{ShardID: 0, Round: 14999, Nonce: 14999},
+					{ShardID: 1, Round: 14999, Nonce: 14999},
+				},
+			},
+			Nonce:                  14999,
+			Epoch:                  epoch,
+			AccumulatedFeesInEpoch: big.NewInt(0),
+			DevFeesInEpoch:         big.NewInt(0),
+		}
+	}
+
+	// the start of epoch block of the schedule entry epoch still closes an epoch before the entry
+	res, err := ec.ComputeEndOfEpochEconomics(createEpochStartMetaBlock(scheduleStartEpoch))
+	require.Nil(t, err)
+	assert.True(t, res.TotalNewlyMinted.Sign() > 0)
+
+	res, err = ec.ComputeEndOfEpochEconomics(createEpochStartMetaBlock(scheduleStartEpoch + 1))
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), res.TotalNewlyMinted)
+	assert.Equal(t, prevTotalSupply, res.TotalSupply)
+
+	assert.Equal(t, []uint32{scheduleStartEpoch - 1, scheduleStartEpoch}, inflationRequestedEpochs)
+}
+
 func TestEconomics_VerifyRewardsPerBlock_DifferentHitRates(t *testing.T) {
 	t.Parallel()
 
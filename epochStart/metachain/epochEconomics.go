@@ -0,0 +1,42 @@
+package metachain
+
+import (
+	"encoding/json"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+)
+
+// saveEpochEconomics saves the economics aggregates of the provided start of epoch block
+func (brc *baseRewardsCreator) saveEpochEconomics(metaBlock *block.MetaBlock, body *block.Body) {
+	epochEconomics := brc.createEpochEconomics(metaBlock, body)
+
+	buff, err := json.Marshal(epochEconomics)
+	if err != nil {
+		log.Warn("baseRewardsCreator.saveEpochEconomics", "epoch", metaBlock.GetEpoch(), "error", err.Error())
+		return
+	}
+
+	err = brc.rewardsStorage.Put([]byte(core.EpochEconomicsIdentifier(metaBlock.GetEpoch())), buff)
+	if err != nil {
+		log.Warn("baseRewardsCreator.saveEpochEconomics", "epoch", metaBlock.GetEpoch(), "error", err.Error())
+	}
+}
+
+func (brc *baseRewardsCreator) createEpochEconomics(metaBlock *block.MetaBlock, body *block.Body) *epochStart.EpochEconomics {
+	rewardsDistributed, burnedFees := brc.computeDistributedAndBurned(metaBlock, body)
+
+	return &epochStart.EpochEconomics{
+		Epoch:              metaBlock.GetEpoch(),
+		TotalFees:          bigIntOrZero(metaBlock.AccumulatedFeesInEpoch).String(),
+		BurnedFees:         burnedFees.String(),
+		DeveloperRewards:   bigIntOrZero(metaBlock.DevFeesInEpoch).String(),
+		InflationMinted:    bigIntOrZero(metaBlock.EpochStart.Economics.TotalNewlyMinted).String(),
+		RewardsDistributed: rewardsDistributed.String(),
+	}
+}
+
+func (brc *baseRewardsCreator) removeEpochEconomics(metaBlock *block.MetaBlock) {
+	_ = brc.rewardsStorage.Remove([]byte(core.EpochEconomicsIdentifier(metaBlock.GetEpoch())))
+}
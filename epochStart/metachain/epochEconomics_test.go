@@ -0,0 +1,60 @@
+package metachain
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewardsCreator_SaveEpochEconomicsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rc, err := NewRewardsCreator(getRewardsArguments())
+	require.Nil(t, err)
+
+	metaBlock := createEpochStartMetaBlockForRewardsAudit(1)
+	metaBlock.AccumulatedFeesInEpoch = big.NewInt(300)
+	metaBlock.DevFeesInEpoch = big.NewInt(100)
+	miniBlocks, err := rc.CreateRewardsMiniBlocks(metaBlock, createValidatorsInfoForRewardsAudit(), &metaBlock.EpochStart.Economics)
+	require.Nil(t, err)
+
+	body := &block.Body{MiniBlocks: miniBlocks}
+	distributed := big.NewInt(0)
+	for _, rwdTx := range rc.GetRewardsTxs(body) {
+		distributed.Add(distributed, rwdTx.GetValue())
+	}
+	expectedBurned := big.NewInt(0).Add(metaBlock.AccumulatedFeesInEpoch, metaBlock.EpochStart.Economics.TotalNewlyMinted)
+	expectedBurned.Sub(expectedBurned, metaBlock.DevFeesInEpoch)
+	expectedBurned.Sub(expectedBurned, distributed)
+	if expectedBurned.Sign() < 0 {
+		expectedBurned.SetUint64(0)
+	}
+
+	rc.SaveTxBlockToStorage(metaBlock, body)
+
+	buff, err := rc.rewardsStorage.Get([]byte(core.EpochEconomicsIdentifier(1)))
+	require.Nil(t, err)
+	epochEconomics := &epochStart.EpochEconomics{}
+	err = json.Unmarshal(buff, epochEconomics)
+	require.Nil(t, err)
+
+	expectedEpochEconomics := &epochStart.EpochEconomics{
+		Epoch:              1,
+		TotalFees:          "300",
+		BurnedFees:         expectedBurned.String(),
+		DeveloperRewards:   "100",
+		InflationMinted:    metaBlock.EpochStart.Economics.TotalNewlyMinted.String(),
+		RewardsDistributed: distributed.String(),
+	}
+	assert.Equal(t, expectedEpochEconomics, epochEconomics)
+
+	rc.DeleteTxsFromStorage(metaBlock, body)
+	_, err = rc.rewardsStorage.Get([]byte(core.EpochEconomicsIdentifier(1)))
+	assert.NotNil(t, err)
+}
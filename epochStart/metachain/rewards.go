@@ -69,8 +69,9 @@ func (rc *rewardsCreator) CreateRewardsMiniBlocks(
 	)
 
 	miniBlocks := rc.initializeRewardsMiniBlocks()
+	rc.initRewardsAudit(metaBlock, computedEconomics)
 
-	protSustRwdTx, protSustShardId, err := rc.createProtocolSustainabilityRewardTransaction(metaBlock, computedEconomics)
+	protSustRwdTx, err := rc.createProtocolSustainabilityRewardTransaction(metaBlock, computedEconomics)
 	if err != nil {
 		return nil, err
 	}
@@ -85,11 +86,13 @@ func (rc *rewardsCreator) CreateRewardsMiniBlocks(
 	difference := big.NewInt(0).Sub(totalWithoutDevelopers, rc.accumulatedRewards)
 	log.Debug("arithmetic difference in end of epoch rewards economics", "value", difference)
 	rc.adjustProtocolSustainabilityRewards(protSustRwdTx, difference)
-	err = rc.addProtocolRewardToMiniBlocks(protSustRwdTx, miniBlocks, protSustShardId)
+	err = rc.addProtocolRewardToMiniBlocks(protSustRwdTx, miniBlocks)
 	if err != nil {
 		return nil, err
 	}
 
+	rc.finalizeRewardsAudit()
+
 	return rc.finalizeMiniBlocks(miniBlocks), nil
 }
 
@@ -175,12 +178,15 @@ func (rc *rewardsCreator) computeValidatorInfoPerRewardAddress(
 			isFix1Enabled := rc.isRewardsFix1Enabled(epoch)
 			if isFix1Enabled && validatorInfo.LeaderSuccess == 0 && validatorInfo.ValidatorSuccess == 0 {
 				protocolSustainabilityRwd.Value.Add(protocolSustainabilityRwd.Value, protocolRewardValue)
+				rc.addValidatorToRewardsAudit(validatorInfo, protocolRewardValue, zero, false)
 				continue
 			}
 			if !isFix1Enabled && validatorInfo.LeaderSuccess == 0 && validatorInfo.ValidatorFailure == 0 {
 				protocolSustainabilityRwd.Value.Add(protocolSustainabilityRwd.Value, protocolRewardValue)
+				rc.addValidatorToRewardsAudit(validatorInfo, protocolRewardValue, zero, false)
 				continue
 			}
+			rc.addValidatorToRewardsAudit(validatorInfo, protocolRewardValue, zero, true)
 
 			rwdInfo, ok := rwdAddrValidatorInfo[string(validatorInfo.RewardAddress)]
 			if !ok {
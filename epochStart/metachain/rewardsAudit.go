@@ -0,0 +1,130 @@
+package metachain
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+)
+
+// initRewardsAudit starts a new rewards audit record. Should be called under mutex protection
+func (brc *baseRewardsCreator) initRewardsAudit(metaBlock *block.MetaBlock, computedEconomics *block.Economics) {
+	brc.rewardsAudit = &epochStart.RewardsAudit{
+		Epoch:                            metaBlock.GetEpoch(),
+		TotalToDistribute:                bigIntToString(computedEconomics.TotalToDistribute),
+		RewardsPerBlock:                  bigIntToString(computedEconomics.RewardsPerBlock),
+		RewardsForProtocolSustainability: bigIntToString(computedEconomics.RewardsForProtocolSustainability),
+		DevFeesInEpoch:                   bigIntToString(metaBlock.DevFeesInEpoch),
+		Shards:                           make([]*epochStart.ShardRewardsAudit, 0),
+	}
+	brc.rewardsAuditPerShard = make(map[uint32]*epochStart.ShardRewardsAudit)
+}
+
+// addValidatorToRewardsAudit records the rewards computed for a validator. The validator is not rewarded if its
+// rewards were moved to the protocol sustainability address. Should be called under mutex protection
+func (brc *baseRewardsCreator) addValidatorToRewardsAudit(
+	valInfo *state.ValidatorInfo,
+	baseReward *big.Int,
+	topUpReward *big.Int,
+	isRewarded bool,
+) {
+	if brc.rewardsAudit == nil {
+		return
+	}
+
+	shardAudit, found := brc.rewardsAuditPerShard[valInfo.ShardId]
+	if !found {
+		shardAudit = &epochStart.ShardRewardsAudit{
+			ShardID:                         valInfo.ShardId,
+			BaseRewardsPerBlockPerValidator: bigIntToString(brc.mapBaseRewardsPerBlockPerValidator[valInfo.ShardId]),
+			Validators:                      make([]*epochStart.ValidatorRewardsAudit, 0),
+		}
+		brc.rewardsAuditPerShard[valInfo.ShardId] = shardAudit
+	}
+
+	shardAudit.Validators = append(shardAudit.Validators, &epochStart.ValidatorRewardsAudit{
+		PublicKey:                  hex.EncodeToString(valInfo.PublicKey),
+		RewardAddress:              brc.encodeRewardAddress(valInfo.RewardAddress),
+		BlocksProposed:             valInfo.LeaderSuccess,
+		BlocksNotProposed:          valInfo.LeaderFailure,
+		BlocksSigned:               valInfo.ValidatorSuccess,
+		BlocksNotSigned:            valInfo.ValidatorFailure,
+		NumSelectedInSuccessBlocks: valInfo.NumSelectedInSuccessBlocks,
+		RatingModifier:             valInfo.RatingModifier,
+		BaseReward:                 bigIntToString(baseReward),
+		TopUpReward:                bigIntToString(topUpReward),
+		AccumulatedFees:            bigIntToString(valInfo.AccumulatedFees),
+		IsRewarded:                 isRewarded,
+	})
+}
+
+// finalizeRewardsAudit sorts the recorded shards and validators. Should be called under mutex protection
+func (brc *baseRewardsCreator) finalizeRewardsAudit() {
+	if brc.rewardsAudit == nil {
+		return
+	}
+
+	shards := make([]*epochStart.ShardRewardsAudit, 0, len(brc.rewardsAuditPerShard))
+	for _, shardAudit := range brc.rewardsAuditPerShard {
+		validators := shardAudit.Validators
+		sort.Slice(validators, func(i, j int) bool {
+			return validators[i].PublicKey < validators[j].PublicKey
+		})
+		shards = append(shards, shardAudit)
+	}
+
+	sort.Slice(shards, func(i, j int) bool {
+		return shards[i].ShardID < shards[j].ShardID
+	})
+	brc.rewardsAudit.Shards = shards
+}
+
+func (brc *baseRewardsCreator) getRewardsAudit() *epochStart.RewardsAudit {
+	brc.mutRewardsData.RLock()
+	defer brc.mutRewardsData.RUnlock()
+
+	return brc.rewardsAudit
+}
+
+func (brc *baseRewardsCreator) saveRewardsAudit(metaBlock *block.MetaBlock) {
+	rewardsAudit := brc.getRewardsAudit()
+	if rewardsAudit == nil || rewardsAudit.Epoch != metaBlock.GetEpoch() {
+		return
+	}
+
+	buff, err := json.Marshal(rewardsAudit)
+	if err != nil {
+		log.Warn("baseRewardsCreator.saveRewardsAudit", "epoch", rewardsAudit.Epoch, "error", err.Error())
+		return
+	}
+
+	err = brc.rewardsStorage.Put([]byte(core.RewardsAuditIdentifier(rewardsAudit.Epoch)), buff)
+	if err != nil {
+		log.Warn("baseRewardsCreator.saveRewardsAudit", "epoch", rewardsAudit.Epoch, "error", err.Error())
+	}
+}
+
+func (brc *baseRewardsCreator) removeRewardsAudit(metaBlock *block.MetaBlock) {
+	_ = brc.rewardsStorage.Remove([]byte(core.RewardsAuditIdentifier(metaBlock.GetEpoch())))
+}
+
+func (brc *baseRewardsCreator) encodeRewardAddress(address []byte) string {
+	if len(address) != brc.pubkeyConverter.Len() {
+		return hex.EncodeToString(address)
+	}
+
+	return brc.pubkeyConverter.Encode(address)
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
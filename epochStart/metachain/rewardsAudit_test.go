@@ -0,0 +1,131 @@
+package metachain
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createEpochStartMetaBlockForRewardsAudit(epoch uint32) *block.MetaBlock {
+	metaBlock := &block.MetaBlock{
+		Epoch:          epoch,
+		EpochStart:     getDefaultEpochStart(),
+		DevFeesInEpoch: big.NewInt(0),
+	}
+	metaBlock.EpochStart.LastFinalizedHeaders = []block.EpochStartShardData{{ShardID: 0}}
+
+	return metaBlock
+}
+
+func createValidatorsInfoForRewardsAudit() map[uint32][]*state.ValidatorInfo {
+	valInfo := make(map[uint32][]*state.ValidatorInfo)
+	valInfo[0] = []*state.ValidatorInfo{
+		{
+			PublicKey:        []byte("pubkey2"),
+			RewardAddress:    []byte("reward address 2"),
+			ShardId:          0,
+			AccumulatedFees:  big.NewInt(0),
+			LeaderSuccess:    0,
+			ValidatorSuccess: 0,
+			ValidatorFailure: 1,
+		},
+		{
+			PublicKey:        []byte("pubkey1"),
+			RewardAddress:    []byte("reward address 1"),
+			ShardId:          0,
+			AccumulatedFees:  big.NewInt(100),
+			LeaderSuccess:    1,
+			ValidatorSuccess: 1,
+		},
+	}
+
+	return valInfo
+}
+
+func getSavedRewardsAudit(t *testing.T, rc *rewardsCreator, epoch uint32) *epochStart.RewardsAudit {
+	buff, err := rc.rewardsStorage.Get([]byte(core.RewardsAuditIdentifier(epoch)))
+	require.Nil(t, err)
+
+	rewardsAudit := &epochStart.RewardsAudit{}
+	err = json.Unmarshal(buff, rewardsAudit)
+	require.Nil(t, err)
+
+	return rewardsAudit
+}
+
+func TestRewardsCreator_CreateRewardsMiniBlocksShouldRecordTheRewardsAudit(t *testing.T) {
+	t.Parallel()
+
+	args := getRewardsArguments()
+	args.RewardsFix1EpochEnable = 0
+	rc, err := NewRewardsCreator(args)
+	require.Nil(t, err)
+
+	metaBlock := createEpochStartMetaBlockForRewardsAudit(1)
+	_, err = rc.CreateRewardsMiniBlocks(metaBlock, createValidatorsInfoForRewardsAudit(), &metaBlock.EpochStart.Economics)
+	require.Nil(t, err)
+
+	rewardsAudit := rc.getRewardsAudit()
+	require.NotNil(t, rewardsAudit)
+	assert.Equal(t, uint32(1), rewardsAudit.Epoch)
+	assert.Equal(t, metaBlock.EpochStart.Economics.TotalToDistribute.String(), rewardsAudit.TotalToDistribute)
+	require.Equal(t, 1, len(rewardsAudit.Shards))
+	require.Equal(t, 2, len(rewardsAudit.Shards[0].Validators))
+
+	rewarded := rewardsAudit.Shards[0].Validators[0]
+	assert.Equal(t, hex.EncodeToString([]byte("pubkey1")), rewarded.PublicKey)
+	assert.Equal(t, uint32(1), rewarded.BlocksProposed)
+	assert.Equal(t, "100", rewarded.AccumulatedFees)
+	assert.True(t, rewarded.IsRewarded)
+
+	notRewarded := rewardsAudit.Shards[0].Validators[1]
+	assert.Equal(t, hex.EncodeToString([]byte("pubkey2")), notRewarded.PublicKey)
+	assert.Equal(t, uint32(1), notRewarded.BlocksNotSigned)
+	assert.False(t, notRewarded.IsRewarded)
+}
+
+func TestRewardsCreator_SaveAndDeleteTheRewardsAudit(t *testing.T) {
+	t.Parallel()
+
+	rc, err := NewRewardsCreator(getRewardsArguments())
+	require.Nil(t, err)
+
+	metaBlock := createEpochStartMetaBlockForRewardsAudit(1)
+	_, err = rc.CreateRewardsMiniBlocks(metaBlock, createValidatorsInfoForRewardsAudit(), &metaBlock.EpochStart.Economics)
+	require.Nil(t, err)
+
+	rc.SaveTxBlockToStorage(metaBlock, &block.Body{})
+	assert.Equal(t, rc.getRewardsAudit(), getSavedRewardsAudit(t, rc, 1))
+
+	rc.DeleteTxsFromStorage(metaBlock, &block.Body{})
+	_, err = rc.rewardsStorage.Get([]byte(core.RewardsAuditIdentifier(1)))
+	assert.NotNil(t, err)
+}
+
+func TestRewardsCreator_SaveTxBlockToStorageShouldNotSaveTheRewardsAuditOfAnotherEpoch(t *testing.T) {
+	t.Parallel()
+
+	rc, err := NewRewardsCreator(getRewardsArguments())
+	require.Nil(t, err)
+
+	metaBlock := createEpochStartMetaBlockForRewardsAudit(1)
+	_, err = rc.CreateRewardsMiniBlocks(metaBlock, createValidatorsInfoForRewardsAudit(), &metaBlock.EpochStart.Economics)
+	require.Nil(t, err)
+
+	rc.SaveTxBlockToStorage(createEpochStartMetaBlockForRewardsAudit(2), &block.Body{})
+	_, err = rc.rewardsStorage.Get([]byte(core.RewardsAuditIdentifier(2)))
+	assert.NotNil(t, err)
+
+	metaBlock.EpochStart.LastFinalizedHeaders = nil
+	rc.SaveTxBlockToStorage(metaBlock, &block.Body{})
+	_, err = rc.rewardsStorage.Get([]byte(core.RewardsAuditIdentifier(1)))
+	assert.NotNil(t, err)
+}
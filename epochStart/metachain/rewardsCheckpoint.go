@@ -0,0 +1,43 @@
+package metachain
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+)
+
+// saveRewardsCheckpoint saves the rewards earned by each address, used to create the merkle proofs of the rewards,
+// if the rewards root hash was committed in the provided start of epoch block
+func (brc *baseRewardsCreator) saveRewardsCheckpoint(metaBlock *block.MetaBlock, body *block.Body) {
+	if len(metaBlock.EpochStart.Economics.RewardsRootHash) == 0 {
+		return
+	}
+
+	checkpoint, err := epochStart.NewRewardsCheckpoint(metaBlock.GetEpoch(), brc.GetRewardsTxs(body), brc.hasher)
+	if err != nil {
+		log.Warn("baseRewardsCreator.saveRewardsCheckpoint", "epoch", metaBlock.GetEpoch(), "error", err.Error())
+		return
+	}
+	if !bytes.Equal(checkpoint.RootHash, metaBlock.EpochStart.Economics.RewardsRootHash) {
+		log.Warn("baseRewardsCreator.saveRewardsCheckpoint: rewards root hash mismatch", "epoch", metaBlock.GetEpoch())
+		return
+	}
+
+	buff, err := json.Marshal(checkpoint)
+	if err != nil {
+		log.Warn("baseRewardsCreator.saveRewardsCheckpoint", "epoch", metaBlock.GetEpoch(), "error", err.Error())
+		return
+	}
+
+	err = brc.rewardsStorage.Put([]byte(core.RewardsCheckpointIdentifier(metaBlock.GetEpoch())), buff)
+	if err != nil {
+		log.Warn("baseRewardsCreator.saveRewardsCheckpoint", "epoch", metaBlock.GetEpoch(), "error", err.Error())
+	}
+}
+
+func (brc *baseRewardsCreator) removeRewardsCheckpoint(metaBlock *block.MetaBlock) {
+	_ = brc.rewardsStorage.Remove([]byte(core.RewardsCheckpointIdentifier(metaBlock.GetEpoch())))
+}
@@ -0,0 +1,57 @@
+package metachain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewardsCreator_SaveAndDeleteTheRewardsCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	rc, err := NewRewardsCreator(getRewardsArguments())
+	require.Nil(t, err)
+
+	metaBlock := createEpochStartMetaBlockForRewardsAudit(1)
+	miniBlocks, err := rc.CreateRewardsMiniBlocks(metaBlock, createValidatorsInfoForRewardsAudit(), &metaBlock.EpochStart.Economics)
+	require.Nil(t, err)
+
+	body := &block.Body{MiniBlocks: miniBlocks}
+	expectedCheckpoint, err := epochStart.NewRewardsCheckpoint(1, rc.GetRewardsTxs(body), rc.hasher)
+	require.Nil(t, err)
+	require.NotEqual(t, 0, len(expectedCheckpoint.Leaves))
+	metaBlock.EpochStart.Economics.RewardsRootHash = expectedCheckpoint.RootHash
+
+	rc.SaveTxBlockToStorage(metaBlock, body)
+	buff, err := rc.rewardsStorage.Get([]byte(core.RewardsCheckpointIdentifier(1)))
+	require.Nil(t, err)
+
+	savedCheckpoint := &epochStart.RewardsCheckpoint{}
+	err = json.Unmarshal(buff, savedCheckpoint)
+	require.Nil(t, err)
+	assert.Equal(t, expectedCheckpoint, savedCheckpoint)
+
+	rc.DeleteTxsFromStorage(metaBlock, body)
+	_, err = rc.rewardsStorage.Get([]byte(core.RewardsCheckpointIdentifier(1)))
+	assert.NotNil(t, err)
+}
+
+func TestRewardsCreator_SaveTxBlockToStorageWithoutRewardsRootHashShouldNotSaveTheRewardsCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	rc, err := NewRewardsCreator(getRewardsArguments())
+	require.Nil(t, err)
+
+	metaBlock := createEpochStartMetaBlockForRewardsAudit(1)
+	miniBlocks, err := rc.CreateRewardsMiniBlocks(metaBlock, createValidatorsInfoForRewardsAudit(), &metaBlock.EpochStart.Economics)
+	require.Nil(t, err)
+
+	rc.SaveTxBlockToStorage(metaBlock, &block.Body{MiniBlocks: miniBlocks})
+	_, err = rc.rewardsStorage.Get([]byte(core.RewardsCheckpointIdentifier(1)))
+	assert.NotNil(t, err)
+}
@@ -25,8 +25,7 @@ type RewardsCreatorProxyArgs struct {
 	BaseRewardsCreatorArgs
 	StakingDataProvider   epochStart.StakingDataProvider
 	EconomicsDataProvider epochStart.EpochEconomicsDataProvider
-	TopUpRewardFactor     float64
-	TopUpGradientPoint    *big.Int
+	TopUpRewardsSettings  epochStart.TopUpRewardsSettingsHandler
 	EpochEnableV2         uint32
 }
 
@@ -184,8 +183,7 @@ func (rcp *rewardsCreatorProxy) createRewardsCreatorV2() (*rewardsCreatorV2, err
 		BaseRewardsCreatorArgs: rcp.args.BaseRewardsCreatorArgs,
 		StakingDataProvider:    rcp.args.StakingDataProvider,
 		EconomicsDataProvider:  rcp.args.EconomicsDataProvider,
-		TopUpRewardFactor:      rcp.args.TopUpRewardFactor,
-		TopUpGradientPoint:     rcp.args.TopUpGradientPoint,
+		TopUpRewardsSettings:   rcp.args.TopUpRewardsSettings,
 	}
 
 	return NewRewardsCreatorV2(argsV2)
@@ -351,13 +351,11 @@ func createDefaultBlockBody() *block.Body {
 }
 
 func createDefaultRewardsCreatorProxyArgs() RewardsCreatorProxyArgs {
-	rewardsTopUpGradientPoint, _ := big.NewInt(0).SetString("3000000000000000000000000", 10)
 	return RewardsCreatorProxyArgs{
 		BaseRewardsCreatorArgs: getBaseRewardsArguments(),
 		StakingDataProvider:    &mock.StakingDataProviderStub{},
 		EconomicsDataProvider:  NewEpochEconomicsStatistics(),
-		TopUpRewardFactor:      0.25,
-		TopUpGradientPoint:     rewardsTopUpGradientPoint,
+		TopUpRewardsSettings:   createTopUpRewardsSettingsHandlerStub(),
 	}
 }
 
@@ -30,16 +30,17 @@ type RewardsCreatorArgsV2 struct {
 	BaseRewardsCreatorArgs
 	StakingDataProvider   epochStart.StakingDataProvider
 	EconomicsDataProvider epochStart.EpochEconomicsDataProvider
-	TopUpRewardFactor     float64
-	TopUpGradientPoint    *big.Int
+	TopUpRewardsSettings  epochStart.TopUpRewardsSettingsHandler
 }
 
 type rewardsCreatorV2 struct {
 	*baseRewardsCreator
 	stakingDataProvider   epochStart.StakingDataProvider
 	economicsDataProvider epochStart.EpochEconomicsDataProvider
+	topUpRewardsSettings  epochStart.TopUpRewardsSettingsHandler
 	topUpRewardFactor     float64
 	topUpGradientPoint    *big.Int
+	topUpCurveSteepness   float64
 }
 
 // NewRewardsCreatorV2 creates a new rewards creator object
@@ -55,24 +56,44 @@ func NewRewardsCreatorV2(args RewardsCreatorArgsV2) (*rewardsCreatorV2, error) {
 	if check.IfNil(args.EconomicsDataProvider) {
 		return nil, epochStart.ErrNilEconomicsDataProvider
 	}
-	if args.TopUpGradientPoint.Cmp(zero) < 0 {
-		return nil, epochStart.ErrInvalidRewardsTopUpGradientPoint
-	}
-	if args.TopUpRewardFactor < 0 || args.TopUpRewardFactor > 1 {
-		return nil, epochStart.ErrInvalidRewardsTopUpFactor
+	if check.IfNil(args.TopUpRewardsSettings) {
+		return nil, epochStart.ErrNilTopUpRewardsSettingsHandler
 	}
 
 	rc := &rewardsCreatorV2{
 		baseRewardsCreator:    brc,
 		economicsDataProvider: args.EconomicsDataProvider,
 		stakingDataProvider:   args.StakingDataProvider,
-		topUpRewardFactor:     args.TopUpRewardFactor,
-		topUpGradientPoint:    args.TopUpGradientPoint,
+		topUpRewardsSettings:  args.TopUpRewardsSettings,
+		topUpGradientPoint:    big.NewInt(0),
 	}
 
 	return rc, nil
 }
 
+// setTopUpRewardsSettings loads and validates the top-up rewards curve parameters of the provided epoch.
+// Should be called under mutex protection
+func (rc *rewardsCreatorV2) setTopUpRewardsSettings(epoch uint32) error {
+	topUpGradientPoint := rc.topUpRewardsSettings.RewardsTopUpGradientPointInEpoch(epoch)
+	if topUpGradientPoint == nil || topUpGradientPoint.Cmp(zero) < 0 {
+		return epochStart.ErrInvalidRewardsTopUpGradientPoint
+	}
+	topUpRewardFactor := rc.topUpRewardsSettings.RewardsTopUpFactorInEpoch(epoch)
+	if topUpRewardFactor < 0 || topUpRewardFactor > 1 {
+		return epochStart.ErrInvalidRewardsTopUpFactor
+	}
+	topUpCurveSteepness := rc.topUpRewardsSettings.RewardsTopUpCurveSteepnessInEpoch(epoch)
+	if topUpCurveSteepness <= 0 {
+		return epochStart.ErrInvalidRewardsTopUpCurveSteepness
+	}
+
+	rc.topUpGradientPoint = topUpGradientPoint
+	rc.topUpRewardFactor = topUpRewardFactor
+	rc.topUpCurveSteepness = topUpCurveSteepness
+
+	return nil
+}
+
 // CreateRewardsMiniBlocks creates the rewards miniblocks according to economics data and validator info.
 // This method applies the rewards according to the economics version 2 proposal, which takes into consideration
 // stake top-up values per node
@@ -103,9 +124,15 @@ func (rc *rewardsCreatorV2) CreateRewardsMiniBlocks(
 
 	miniBlocks := rc.initializeRewardsMiniBlocks()
 	rc.clean()
+	rc.initRewardsAudit(metaBlock, computedEconomics)
 	rc.flagDelegationSystemSCEnabled.Toggle(metaBlock.GetEpoch() >= rc.delegationSystemSCEnableEpoch)
 
-	protRwdTx, protRwdShardId, err := rc.createProtocolSustainabilityRewardTransaction(metaBlock, computedEconomics)
+	err := rc.setTopUpRewardsSettings(metaBlock.GetEpoch())
+	if err != nil {
+		return nil, err
+	}
+
+	protRwdTx, err := rc.createProtocolSustainabilityRewardTransaction(metaBlock, computedEconomics)
 	if err != nil {
 		return nil, err
 	}
@@ -120,11 +147,13 @@ func (rc *rewardsCreatorV2) CreateRewardsMiniBlocks(
 
 	dust.Add(dust, dustFromRewardsPerNode)
 	rc.adjustProtocolSustainabilityRewards(protRwdTx, dust)
-	err = rc.addProtocolRewardToMiniBlocks(protRwdTx, miniBlocks, protRwdShardId)
+	err = rc.addProtocolRewardToMiniBlocks(protRwdTx, miniBlocks)
 	if err != nil {
 		return nil, err
 	}
 
+	rc.finalizeRewardsAudit()
+
 	return rc.finalizeMiniBlocks(miniBlocks), nil
 }
 
@@ -224,8 +253,10 @@ func (rc *rewardsCreatorV2) computeValidatorInfoPerRewardAddress(
 		for _, nodeInfo := range nodeInfoList {
 			if nodeInfo.valInfo.LeaderSuccess == 0 && nodeInfo.valInfo.ValidatorSuccess == 0 {
 				accumulatedUnassigned.Add(accumulatedUnassigned, nodeInfo.fullRewards)
+				rc.addValidatorToRewardsAudit(nodeInfo.valInfo, nodeInfo.baseReward, nodeInfo.topUpReward, false)
 				continue
 			}
+			rc.addValidatorToRewardsAudit(nodeInfo.valInfo, nodeInfo.baseReward, nodeInfo.topUpReward, true)
 
 			rwdInfo, ok := rwdAddrValidatorInfo[string(nodeInfo.valInfo.RewardAddress)]
 			if !ok {
@@ -354,25 +385,46 @@ func (rc *rewardsCreatorV2) computeTopUpRewardsPerNode(
 	return big.NewInt(0).Sub(topUpRewards, accumulatedTopUpRewards)
 }
 
-//      (2*k/pi)*atan(x/p), where:
+func (rc *rewardsCreatorV2) computeTopUpRewards(totalToDistribute *big.Int, totalTopUpEligible *big.Int) *big.Int {
+	return ComputeTopUpRewards(
+		totalToDistribute,
+		totalTopUpEligible,
+		rc.topUpGradientPoint,
+		rc.topUpRewardFactor,
+		rc.topUpCurveSteepness,
+	)
+}
+
+// ComputeTopUpRewards returns the part of the rewards to be distributed that goes to the top-up stake of the
+// eligible nodes, computed as:
+//      (2*k/pi)*atan((x/p)^s), where:
 //     k is the rewards per day limit for top-up stake k = c * economics.TotalToDistribute, c - constant, e.g c = 0.25
 //     x is the cumulative top-up stake value for eligible nodes
 //     p is the cumulative eligible stake where rewards per day reach 1/2 of k (includes topUp for the eligible nodes)
+//     s is the steepness of the curve around p, e.g s = 1
 //     pi is the mathematical constant pi = 3.1415...
-func (rc *rewardsCreatorV2) computeTopUpRewards(totalToDistribute *big.Int, totalTopUpEligible *big.Int) *big.Int {
+func ComputeTopUpRewards(
+	totalToDistribute *big.Int,
+	totalTopUpEligible *big.Int,
+	topUpGradientPoint *big.Int,
+	topUpRewardFactor float64,
+	topUpCurveSteepness float64,
+) *big.Int {
 	if totalToDistribute.Cmp(zero) <= 0 || totalTopUpEligible.Cmp(zero) <= 0 {
 		return big.NewInt(0)
 	}
 
 	// k = c * economics.TotalToDistribute, c = top-up reward factor (constant)
-	k := core.GetPercentageOfValue(totalToDistribute, rc.topUpRewardFactor)
+	k := core.GetPercentageOfValue(totalToDistribute, topUpRewardFactor)
 	// p is the cumulative eligible stake where rewards per day reach 1/2 of k (constant)
 	// x/p - argument for atan
 	totalTopUpEligibleFloat := big.NewFloat(0).SetInt(totalTopUpEligible)
-	topUpGradientPointFloat := big.NewFloat(0).SetInt(rc.topUpGradientPoint)
+	topUpGradientPointFloat := big.NewFloat(0).SetInt(topUpGradientPoint)
 
 	floatArg, _ := big.NewFloat(0).Quo(totalTopUpEligibleFloat, topUpGradientPointFloat).Float64()
-	// atan(x/p)
+	// (x/p)^s
+	floatArg = math.Pow(floatArg, topUpCurveSteepness)
+	// atan((x/p)^s)
 	res1 := math.Atan(floatArg)
 	// 2*k/pi
 	res2 := big.NewFloat(0).SetInt(big.NewInt(0).Mul(k, big.NewInt(2)))
@@ -62,37 +62,105 @@ func TestNewRewardsCreator_NilEconomicsDataProvider(t *testing.T) {
 	require.Equal(t, epochStart.ErrNilEconomicsDataProvider, err)
 }
 
-func TestNewRewardsCreator_NegativeGradientPointShouldErr(t *testing.T) {
+func TestNewRewardsCreator_NilTopUpRewardsSettingsShouldErr(t *testing.T) {
 	t.Parallel()
 
 	args := getRewardsCreatorV2Arguments()
-	args.TopUpGradientPoint = big.NewInt(-1)
+	args.TopUpRewardsSettings = nil
 
 	rwd, err := NewRewardsCreatorV2(args)
 	require.True(t, check.IfNil(rwd))
-	require.Equal(t, epochStart.ErrInvalidRewardsTopUpGradientPoint, err)
+	require.Equal(t, epochStart.ErrNilTopUpRewardsSettingsHandler, err)
 }
 
-func TestNewRewardsCreator_NegativeTopUpRewardFactorShouldErr(t *testing.T) {
+func TestRewardsCreatorV2_SetTopUpRewardsSettings(t *testing.T) {
 	t.Parallel()
 
-	args := getRewardsCreatorV2Arguments()
-	args.TopUpRewardFactor = -1
-
-	rwd, err := NewRewardsCreatorV2(args)
-	require.True(t, check.IfNil(rwd))
-	require.Equal(t, epochStart.ErrInvalidRewardsTopUpFactor, err)
+	t.Run("negative gradient point should err", func(t *testing.T) {
+		args := getRewardsCreatorV2Arguments()
+		stub := createTopUpRewardsSettingsHandlerStub()
+		stub.RewardsTopUpGradientPointInEpochCalled = func(_ uint32) *big.Int {
+			return big.NewInt(-1)
+		}
+		args.TopUpRewardsSettings = stub
+		rwd, _ := NewRewardsCreatorV2(args)
+
+		err := rwd.setTopUpRewardsSettings(1)
+		require.Equal(t, epochStart.ErrInvalidRewardsTopUpGradientPoint, err)
+	})
+	t.Run("negative top up factor should err", func(t *testing.T) {
+		args := getRewardsCreatorV2Arguments()
+		stub := createTopUpRewardsSettingsHandlerStub()
+		stub.RewardsTopUpFactorInEpochCalled = func(_ uint32) float64 {
+			return -1
+		}
+		args.TopUpRewardsSettings = stub
+		rwd, _ := NewRewardsCreatorV2(args)
+
+		err := rwd.setTopUpRewardsSettings(1)
+		require.Equal(t, epochStart.ErrInvalidRewardsTopUpFactor, err)
+	})
+	t.Run("supra unitary top up factor should err", func(t *testing.T) {
+		args := getRewardsCreatorV2Arguments()
+		stub := createTopUpRewardsSettingsHandlerStub()
+		stub.RewardsTopUpFactorInEpochCalled = func(_ uint32) float64 {
+			return 1.5
+		}
+		args.TopUpRewardsSettings = stub
+		rwd, _ := NewRewardsCreatorV2(args)
+
+		err := rwd.setTopUpRewardsSettings(1)
+		require.Equal(t, epochStart.ErrInvalidRewardsTopUpFactor, err)
+	})
+	t.Run("zero curve steepness should err", func(t *testing.T) {
+		args := getRewardsCreatorV2Arguments()
+		stub := createTopUpRewardsSettingsHandlerStub()
+		stub.RewardsTopUpCurveSteepnessInEpochCalled = func(_ uint32) float64 {
+			return 0
+		}
+		args.TopUpRewardsSettings = stub
+		rwd, _ := NewRewardsCreatorV2(args)
+
+		err := rwd.setTopUpRewardsSettings(1)
+		require.Equal(t, epochStart.ErrInvalidRewardsTopUpCurveSteepness, err)
+	})
+	t.Run("should use the settings of the provided epoch", func(t *testing.T) {
+		args := getRewardsCreatorV2Arguments()
+		stub := createTopUpRewardsSettingsHandlerStub()
+		stub.RewardsTopUpFactorInEpochCalled = func(epoch uint32) float64 {
+			if epoch >= 5 {
+				return 0.5
+			}
+			return 0.25
+		}
+		args.TopUpRewardsSettings = stub
+		rwd, _ := NewRewardsCreatorV2(args)
+
+		require.Nil(t, rwd.setTopUpRewardsSettings(4))
+		require.Equal(t, 0.25, rwd.topUpRewardFactor)
+		require.Nil(t, rwd.setTopUpRewardsSettings(5))
+		require.Equal(t, 0.5, rwd.topUpRewardFactor)
+	})
 }
 
-func TestNewRewardsCreator_SupraUnitaryTopUpRewardFactorShouldErr(t *testing.T) {
+func TestRewardsCreatorV2_CreateRewardsMiniBlocksInvalidTopUpSettingsShouldErr(t *testing.T) {
 	t.Parallel()
 
 	args := getRewardsCreatorV2Arguments()
-	args.TopUpRewardFactor = 1.5
+	stub := createTopUpRewardsSettingsHandlerStub()
+	stub.RewardsTopUpCurveSteepnessInEpochCalled = func(_ uint32) float64 {
+		return -1
+	}
+	args.TopUpRewardsSettings = stub
+	rwd, _ := NewRewardsCreatorV2(args)
 
-	rwd, err := NewRewardsCreatorV2(args)
-	require.True(t, check.IfNil(rwd))
-	require.Equal(t, epochStart.ErrInvalidRewardsTopUpFactor, err)
+	metaBlock := &block.MetaBlock{
+		EpochStart:     getDefaultEpochStart(),
+		DevFeesInEpoch: big.NewInt(0),
+	}
+	mbs, err := rwd.CreateRewardsMiniBlocks(metaBlock, make(map[uint32][]*state.ValidatorInfo), &metaBlock.EpochStart.Economics)
+	require.Nil(t, mbs)
+	require.Equal(t, epochStart.ErrInvalidRewardsTopUpCurveSteepness, err)
 }
 
 func TestNewRewardsCreatorOK(t *testing.T) {
@@ -208,7 +276,7 @@ func TestRewardsCreatorV2_adjustProtocolSustainabilityRewardsPositiveValue(t *te
 	require.NotNil(t, rwd)
 
 	initialProtRewardValue := big.NewInt(1000000)
-	protRwAddr, _ := args.PubkeyConverter.Decode(args.ProtocolSustainabilityAddress)
+	protRwAddr, _ := args.PubkeyConverter.Decode(args.ProtocolSustainabilityAddresses[0].Address)
 	protRwTx := &rewardTx.RewardTx{
 		Round:   100,
 		Value:   big.NewInt(0).Set(initialProtRewardValue),
@@ -216,9 +284,8 @@ func TestRewardsCreatorV2_adjustProtocolSustainabilityRewardsPositiveValue(t *te
 		Epoch:   1,
 	}
 
-	protRwShard := args.ShardCoordinator.ComputeId(protRwAddr)
 	mbSlice := createDefaultMiniBlocksSlice()
-	err = rwd.addProtocolRewardToMiniBlocks(protRwTx, mbSlice, protRwShard)
+	err = rwd.addProtocolRewardToMiniBlocks(protRwTx, mbSlice)
 
 	dust := big.NewInt(1000)
 	rwd2 := rewardsCreatorV2{
@@ -239,7 +306,7 @@ func TestRewardsCreatorV2_adjustProtocolSustainabilityRewardsNegValueNotAccepted
 	require.NotNil(t, rwd)
 
 	initialProtRewardValue := big.NewInt(10)
-	protRwAddr, _ := args.PubkeyConverter.Decode(args.ProtocolSustainabilityAddress)
+	protRwAddr, _ := args.PubkeyConverter.Decode(args.ProtocolSustainabilityAddresses[0].Address)
 	protRwTx := &rewardTx.RewardTx{
 		Round:   100,
 		Value:   big.NewInt(0).Set(initialProtRewardValue),
@@ -247,9 +314,8 @@ func TestRewardsCreatorV2_adjustProtocolSustainabilityRewardsNegValueNotAccepted
 		Epoch:   1,
 	}
 
-	protRwShard := args.ShardCoordinator.ComputeId(protRwAddr)
 	mbSlice := createDefaultMiniBlocksSlice()
-	err = rwd.addProtocolRewardToMiniBlocks(protRwTx, mbSlice, protRwShard)
+	err = rwd.addProtocolRewardToMiniBlocks(protRwTx, mbSlice)
 
 	rwd2 := rewardsCreatorV2{
 		baseRewardsCreator: rwd,
@@ -271,7 +337,7 @@ func TestRewardsCreatorV2_adjustProtocolSustainabilityRewardsInitialNegativeValu
 	require.NotNil(t, rwd)
 
 	initialProtRewardValue := big.NewInt(-100)
-	protRwAddr, _ := args.PubkeyConverter.Decode(args.ProtocolSustainabilityAddress)
+	protRwAddr, _ := args.PubkeyConverter.Decode(args.ProtocolSustainabilityAddresses[0].Address)
 	protRwTx := &rewardTx.RewardTx{
 		Round:   100,
 		Value:   big.NewInt(0).Set(initialProtRewardValue),
@@ -279,9 +345,8 @@ func TestRewardsCreatorV2_adjustProtocolSustainabilityRewardsInitialNegativeValu
 		Epoch:   1,
 	}
 
-	protRwShard := args.ShardCoordinator.ComputeId(protRwAddr)
 	mbSlice := createDefaultMiniBlocksSlice()
-	err = rwd.addProtocolRewardToMiniBlocks(protRwTx, mbSlice, protRwShard)
+	err = rwd.addProtocolRewardToMiniBlocks(protRwTx, mbSlice)
 
 	rwd2 := rewardsCreatorV2{
 		baseRewardsCreator: rwd,
@@ -576,6 +641,7 @@ func TestNewRewardsCreatorV2_computeTopUpRewards(t *testing.T) {
 	rwd, err := NewRewardsCreatorV2(args)
 	require.Nil(t, err)
 	require.NotNil(t, rwd)
+	require.Nil(t, rwd.setTopUpRewardsSettings(0))
 
 	totalToDistribute, _ := big.NewInt(0).SetString("3000000000000000000000", 10)
 	topUpRewardsLimit := core.GetPercentageOfValue(totalToDistribute, rwd.topUpRewardFactor)
@@ -607,6 +673,43 @@ func TestNewRewardsCreatorV2_computeTopUpRewards(t *testing.T) {
 	require.True(t, topUpRewards.Cmp(ninetyNinePercentLimit) > 0)
 }
 
+func TestNewRewardsCreatorV2_computeTopUpRewardsWithCurveSteepness(t *testing.T) {
+	t.Parallel()
+
+	args := getRewardsCreatorV2Arguments()
+	stub := createTopUpRewardsSettingsHandlerStub()
+	stub.RewardsTopUpCurveSteepnessInEpochCalled = func(_ uint32) float64 {
+		return 2
+	}
+	args.TopUpRewardsSettings = stub
+	rwd, err := NewRewardsCreatorV2(args)
+	require.Nil(t, err)
+	require.Nil(t, rwd.setTopUpRewardsSettings(0))
+
+	argsDefault := getRewardsCreatorV2Arguments()
+	rwdDefault, err := NewRewardsCreatorV2(argsDefault)
+	require.Nil(t, err)
+	require.Nil(t, rwdDefault.setTopUpRewardsSettings(0))
+
+	totalToDistribute, _ := big.NewInt(0).SetString("3000000000000000000000", 10)
+	topUpRewardsLimit := core.GetPercentageOfValue(totalToDistribute, rwd.topUpRewardFactor)
+	halfLimit := big.NewInt(0).Div(topUpRewardsLimit, big.NewInt(2))
+
+	// the gradient point still gives half of the limit
+	totalTopUpEligible, _ := big.NewInt(0).SetString("3000000000000000000000000", 10)
+	topUpRewards := rwd.computeTopUpRewards(totalToDistribute, totalTopUpEligible)
+	require.Equal(t, halfLimit, topUpRewards)
+
+	// a steeper curve gives less below the gradient point and more above it
+	totalTopUpEligible, _ = big.NewInt(0).SetString("2000000000000000000000000", 10)
+	require.True(t, rwd.computeTopUpRewards(totalToDistribute, totalTopUpEligible).Cmp(
+		rwdDefault.computeTopUpRewards(totalToDistribute, totalTopUpEligible)) < 0)
+
+	totalTopUpEligible, _ = big.NewInt(0).SetString("4000000000000000000000000", 10)
+	require.True(t, rwd.computeTopUpRewards(totalToDistribute, totalTopUpEligible).Cmp(
+		rwdDefault.computeTopUpRewards(totalToDistribute, totalTopUpEligible)) > 0)
+}
+
 func TestNewRewardsCreatorV2_computeTopUpRewardsPerNode(t *testing.T) {
 	t.Parallel()
 
@@ -777,6 +880,7 @@ func TestNewRewardsCreatorV2_computeRewardsPerNode(t *testing.T) {
 	rwd, err := NewRewardsCreatorV2(args)
 	require.Nil(t, err)
 	require.NotNil(t, rwd)
+	require.Nil(t, rwd.setTopUpRewardsSettings(0))
 
 	nodesRewardInfo, accumulatedDust := rwd.computeRewardsPerNode(vInfo)
 
@@ -888,6 +992,7 @@ func TestNewRewardsCreatorV2_computeRewardsPer2169Nodes(t *testing.T) {
 			args.EconomicsDataProvider.SetRewardsToBeDistributedForBlocks(tt.rewardsForBlocks)
 
 			rwd, _ := NewRewardsCreatorV2(args)
+			require.Nil(t, rwd.setTopUpRewardsSettings(0))
 
 			var dust *big.Int
 			nodesRewardInfo, dust = rwd.computeRewardsPerNode(vInfo)
@@ -1011,6 +1116,7 @@ func TestNewRewardsCreatorV2_computeRewardsPer1920Nodes(t *testing.T) {
 			args.EconomicsDataProvider.SetRewardsToBeDistributedForBlocks(tt.rewardsForBlocks)
 
 			rwd, _ := NewRewardsCreatorV2(args)
+			require.Nil(t, rwd.setTopUpRewardsSettings(0))
 
 			nodesRewardInfo, _ = rwd.computeRewardsPerNode(vInfo)
 
@@ -1130,6 +1236,7 @@ func TestNewRewardsCreatorV2_computeRewardsPer3200Nodes(t *testing.T) {
 			args.EconomicsDataProvider.SetRewardsToBeDistributedForBlocks(tt.rewardsForBlocks)
 
 			rwd, _ := NewRewardsCreatorV2(args)
+			require.Nil(t, rwd.setTopUpRewardsSettings(0))
 
 			nodesRewardInfo, _ = rwd.computeRewardsPerNode(vInfo)
 
@@ -1524,14 +1631,27 @@ func TestNewRewardsCreatorV2_CreateRewardsMiniBlocks2169Nodes(t *testing.T) {
 	require.Nil(t, err)
 }
 
-func getRewardsCreatorV2Arguments() RewardsCreatorArgsV2 {
+func createTopUpRewardsSettingsHandlerStub() *mock.TopUpRewardsSettingsHandlerStub {
 	rewardsTopUpGradientPoint, _ := big.NewInt(0).SetString("3000000000000000000000000", 10)
+	return &mock.TopUpRewardsSettingsHandlerStub{
+		RewardsTopUpGradientPointInEpochCalled: func(_ uint32) *big.Int {
+			return big.NewInt(0).Set(rewardsTopUpGradientPoint)
+		},
+		RewardsTopUpFactorInEpochCalled: func(_ uint32) float64 {
+			return 0.25
+		},
+		RewardsTopUpCurveSteepnessInEpochCalled: func(_ uint32) float64 {
+			return 1
+		},
+	}
+}
+
+func getRewardsCreatorV2Arguments() RewardsCreatorArgsV2 {
 	return RewardsCreatorArgsV2{
 		BaseRewardsCreatorArgs: getBaseRewardsArguments(),
 		StakingDataProvider:    &mock.StakingDataProviderStub{},
 		EconomicsDataProvider:  NewEpochEconomicsStatistics(),
-		TopUpRewardFactor:      0.25,
-		TopUpGradientPoint:     rewardsTopUpGradientPoint,
+		TopUpRewardsSettings:   createTopUpRewardsSettingsHandlerStub(),
 	}
 }
 
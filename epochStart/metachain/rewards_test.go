@@ -94,7 +94,7 @@ func TestNewRewardsCreator_EmptyProtocolSustainabilityAddress(t *testing.T) {
 	t.Parallel()
 
 	args := getRewardsArguments()
-	args.ProtocolSustainabilityAddress = ""
+	args.ProtocolSustainabilityAddresses[0].Address = ""
 
 	rwd, err := NewRewardsCreator(args)
 	assert.True(t, check.IfNil(rwd))
@@ -105,7 +105,7 @@ func TestNewRewardsCreator_InvalidProtocolSustainabilityAddress(t *testing.T) {
 	t.Parallel()
 
 	args := getRewardsArguments()
-	args.ProtocolSustainabilityAddress = "xyz" // not a hex string
+	args.ProtocolSustainabilityAddresses[0].Address = "xyz" // not a hex string
 
 	rwd, err := NewRewardsCreator(args)
 	assert.True(t, check.IfNil(rwd))
@@ -256,7 +256,7 @@ func TestRewardsCreator_adjustProtocolSustainabilityRewardsPositiveValue(t *test
 	require.NotNil(t, rwd)
 
 	initialProtRewardValue := big.NewInt(1000000)
-	protRwAddr, _ := args.PubkeyConverter.Decode(args.ProtocolSustainabilityAddress)
+	protRwAddr, _ := args.PubkeyConverter.Decode(args.ProtocolSustainabilityAddresses[0].Address)
 	protRwTx := &rewardTx.RewardTx{
 		Round:   100,
 		Value:   big.NewInt(0).Set(initialProtRewardValue),
@@ -264,9 +264,8 @@ func TestRewardsCreator_adjustProtocolSustainabilityRewardsPositiveValue(t *test
 		Epoch:   1,
 	}
 
-	protRwShard := args.ShardCoordinator.ComputeId(protRwAddr)
 	mbSlice := createDefaultMiniBlocksSlice()
-	err = rwd.addProtocolRewardToMiniBlocks(protRwTx, mbSlice, protRwShard)
+	err = rwd.addProtocolRewardToMiniBlocks(protRwTx, mbSlice)
 
 	dust := big.NewInt(1000)
 	rwd1 := rewardsCreator{
@@ -287,7 +286,7 @@ func TestRewardsCreator_adjustProtocolSustainabilityRewardsNegValueShouldWork(t
 	require.NotNil(t, rwd)
 
 	initialProtRewardValue := big.NewInt(10)
-	protRwAddr, _ := args.PubkeyConverter.Decode(args.ProtocolSustainabilityAddress)
+	protRwAddr, _ := args.PubkeyConverter.Decode(args.ProtocolSustainabilityAddresses[0].Address)
 	protRwTx := &rewardTx.RewardTx{
 		Round:   100,
 		Value:   big.NewInt(0).Set(initialProtRewardValue),
@@ -295,9 +294,8 @@ func TestRewardsCreator_adjustProtocolSustainabilityRewardsNegValueShouldWork(t
 		Epoch:   1,
 	}
 
-	protRwShard := args.ShardCoordinator.ComputeId(protRwAddr)
 	mbSlice := createDefaultMiniBlocksSlice()
-	err = rwd.addProtocolRewardToMiniBlocks(protRwTx, mbSlice, protRwShard)
+	err = rwd.addProtocolRewardToMiniBlocks(protRwTx, mbSlice)
 
 	rwd1 := rewardsCreator{
 		baseRewardsCreator: rwd,
@@ -320,7 +318,7 @@ func TestRewardsCreator_adjustProtocolSustainabilityRewardsInitialNegativeValue(
 	require.NotNil(t, rwd)
 
 	initialProtRewardValue := big.NewInt(-100)
-	protRwAddr, _ := args.PubkeyConverter.Decode(args.ProtocolSustainabilityAddress)
+	protRwAddr, _ := args.PubkeyConverter.Decode(args.ProtocolSustainabilityAddresses[0].Address)
 	protRwTx := &rewardTx.RewardTx{
 		Round:   100,
 		Value:   big.NewInt(0).Set(initialProtRewardValue),
@@ -328,9 +326,8 @@ func TestRewardsCreator_adjustProtocolSustainabilityRewardsInitialNegativeValue(
 		Epoch:   1,
 	}
 
-	protRwShard := args.ShardCoordinator.ComputeId(protRwAddr)
 	mbSlice := createDefaultMiniBlocksSlice()
-	err = rwd.addProtocolRewardToMiniBlocks(protRwTx, mbSlice, protRwShard)
+	err = rwd.addProtocolRewardToMiniBlocks(protRwTx, mbSlice)
 
 	rwd1 := rewardsCreator{
 		baseRewardsCreator: rwd,
@@ -692,7 +689,7 @@ func TestRewardsCreator_CreateProtocolSustainabilityRewardTransaction(t *testing
 		Epoch:   0,
 	}
 
-	rwdTx, _, err := rwdc.createProtocolSustainabilityRewardTransaction(mb, &mb.EpochStart.Economics)
+	rwdTx, err := rwdc.createProtocolSustainabilityRewardTransaction(mb, &mb.EpochStart.Economics)
 	assert.Equal(t, expectedRewardTx, rwdTx)
 	assert.Nil(t, err)
 }
@@ -0,0 +1,123 @@
+package metachain
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+)
+
+// saveSupplyAccounting saves the supply accounting record of the provided start of epoch block. The cumulative values
+// are added on top of the record of the previous epoch, if found
+func (brc *baseRewardsCreator) saveSupplyAccounting(metaBlock *block.MetaBlock, body *block.Body) {
+	supplyAccounting := brc.createSupplyAccounting(metaBlock, body)
+
+	buff, err := json.Marshal(supplyAccounting)
+	if err != nil {
+		log.Warn("baseRewardsCreator.saveSupplyAccounting", "epoch", metaBlock.GetEpoch(), "error", err.Error())
+		return
+	}
+
+	err = brc.rewardsStorage.Put([]byte(core.SupplyAccountingIdentifier(metaBlock.GetEpoch())), buff)
+	if err != nil {
+		log.Warn("baseRewardsCreator.saveSupplyAccounting", "epoch", metaBlock.GetEpoch(), "error", err.Error())
+	}
+}
+
+func (brc *baseRewardsCreator) createSupplyAccounting(metaBlock *block.MetaBlock, body *block.Body) *epochStart.SupplyAccounting {
+	economics := metaBlock.EpochStart.Economics
+	inflationMinted := bigIntOrZero(economics.TotalNewlyMinted)
+	treasuryInflows := bigIntOrZero(economics.RewardsForProtocolSustainability)
+	_, burnedFees := brc.computeDistributedAndBurned(metaBlock, body)
+
+	cumulativeInflationMinted := big.NewInt(0).Set(inflationMinted)
+	cumulativeBurnedFees := big.NewInt(0).Set(burnedFees)
+	cumulativeTreasuryInflows := big.NewInt(0).Set(treasuryInflows)
+	prevSupplyAccounting := brc.getPrevSupplyAccounting(metaBlock.GetEpoch())
+	if prevSupplyAccounting != nil {
+		cumulativeInflationMinted.Add(cumulativeInflationMinted, parseBigIntOrZero(prevSupplyAccounting.CumulativeInflationMinted))
+		cumulativeBurnedFees.Add(cumulativeBurnedFees, parseBigIntOrZero(prevSupplyAccounting.CumulativeBurnedFees))
+		cumulativeTreasuryInflows.Add(cumulativeTreasuryInflows, parseBigIntOrZero(prevSupplyAccounting.CumulativeTreasuryInflows))
+	}
+
+	return &epochStart.SupplyAccounting{
+		Epoch:                     metaBlock.GetEpoch(),
+		TotalSupply:               bigIntOrZero(economics.TotalSupply).String(),
+		InflationMinted:           inflationMinted.String(),
+		BurnedFees:                burnedFees.String(),
+		TreasuryInflows:           treasuryInflows.String(),
+		CumulativeInflationMinted: cumulativeInflationMinted.String(),
+		CumulativeBurnedFees:      cumulativeBurnedFees.String(),
+		CumulativeTreasuryInflows: cumulativeTreasuryInflows.String(),
+	}
+}
+
+// computeDistributedAndBurned returns the sum of the reward transactions from the provided body and the part of the
+// accumulated fees and of the newly minted tokens that was neither distributed as rewards nor as developer fees
+func (brc *baseRewardsCreator) computeDistributedAndBurned(metaBlock *block.MetaBlock, body *block.Body) (*big.Int, *big.Int) {
+	rewardsDistributed := big.NewInt(0)
+	for _, rwdTx := range brc.GetRewardsTxs(body) {
+		if check.IfNil(rwdTx) || rwdTx.GetValue() == nil {
+			continue
+		}
+		rewardsDistributed.Add(rewardsDistributed, rwdTx.GetValue())
+	}
+
+	inflationMinted := bigIntOrZero(metaBlock.EpochStart.Economics.TotalNewlyMinted)
+	burnedFees := big.NewInt(0).Add(bigIntOrZero(metaBlock.AccumulatedFeesInEpoch), inflationMinted)
+	burnedFees.Sub(burnedFees, rewardsDistributed)
+	burnedFees.Sub(burnedFees, bigIntOrZero(metaBlock.DevFeesInEpoch))
+	if burnedFees.Sign() < 0 {
+		log.Warn("baseRewardsCreator.computeDistributedAndBurned: distributed more than available",
+			"epoch", metaBlock.GetEpoch(), "difference", burnedFees.String())
+		burnedFees.SetUint64(0)
+	}
+
+	return rewardsDistributed, burnedFees
+}
+
+func (brc *baseRewardsCreator) getPrevSupplyAccounting(epoch uint32) *epochStart.SupplyAccounting {
+	if epoch == 0 {
+		return nil
+	}
+
+	buff, err := brc.rewardsStorage.SearchFirst([]byte(core.SupplyAccountingIdentifier(epoch - 1)))
+	if err != nil {
+		log.Debug("baseRewardsCreator.getPrevSupplyAccounting: previous record not found, cumulative values restart",
+			"epoch", epoch)
+		return nil
+	}
+
+	prevSupplyAccounting := &epochStart.SupplyAccounting{}
+	err = json.Unmarshal(buff, prevSupplyAccounting)
+	if err != nil {
+		log.Warn("baseRewardsCreator.getPrevSupplyAccounting", "epoch", epoch, "error", err.Error())
+		return nil
+	}
+
+	return prevSupplyAccounting
+}
+
+func (brc *baseRewardsCreator) removeSupplyAccounting(metaBlock *block.MetaBlock) {
+	_ = brc.rewardsStorage.Remove([]byte(core.SupplyAccountingIdentifier(metaBlock.GetEpoch())))
+}
+
+func bigIntOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return value
+}
+
+func parseBigIntOrZero(value string) *big.Int {
+	result, ok := big.NewInt(0).SetString(value, 10)
+	if !ok {
+		return big.NewInt(0)
+	}
+
+	return result
+}
@@ -0,0 +1,93 @@
+package metachain
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getSavedSupplyAccounting(t *testing.T, rc *rewardsCreator, epoch uint32) *epochStart.SupplyAccounting {
+	buff, err := rc.rewardsStorage.Get([]byte(core.SupplyAccountingIdentifier(epoch)))
+	require.Nil(t, err)
+
+	supplyAccounting := &epochStart.SupplyAccounting{}
+	err = json.Unmarshal(buff, supplyAccounting)
+	require.Nil(t, err)
+
+	return supplyAccounting
+}
+
+func TestRewardsCreator_SaveSupplyAccountingShouldAccumulateValues(t *testing.T) {
+	t.Parallel()
+
+	rc, err := NewRewardsCreator(getRewardsArguments())
+	require.Nil(t, err)
+
+	for epoch := uint32(1); epoch <= 2; epoch++ {
+		metaBlock := createEpochStartMetaBlockForRewardsAudit(epoch)
+		metaBlock.AccumulatedFeesInEpoch = big.NewInt(300)
+		metaBlock.DevFeesInEpoch = big.NewInt(100)
+		rc.SaveTxBlockToStorage(metaBlock, &block.Body{})
+	}
+
+	expectedFirstEpoch := &epochStart.SupplyAccounting{
+		Epoch:                     1,
+		TotalSupply:               "10000",
+		InflationMinted:           "10000",
+		BurnedFees:                "10200",
+		TreasuryInflows:           "50",
+		CumulativeInflationMinted: "10000",
+		CumulativeBurnedFees:      "10200",
+		CumulativeTreasuryInflows: "50",
+	}
+	assert.Equal(t, expectedFirstEpoch, getSavedSupplyAccounting(t, rc, 1))
+
+	expectedSecondEpoch := &epochStart.SupplyAccounting{
+		Epoch:                     2,
+		TotalSupply:               "10000",
+		InflationMinted:           "10000",
+		BurnedFees:                "10200",
+		TreasuryInflows:           "50",
+		CumulativeInflationMinted: "20000",
+		CumulativeBurnedFees:      "20400",
+		CumulativeTreasuryInflows: "100",
+	}
+	assert.Equal(t, expectedSecondEpoch, getSavedSupplyAccounting(t, rc, 2))
+
+	rc.DeleteTxsFromStorage(createEpochStartMetaBlockForRewardsAudit(2), &block.Body{})
+	_, err = rc.rewardsStorage.Get([]byte(core.SupplyAccountingIdentifier(2)))
+	assert.NotNil(t, err)
+}
+
+func TestRewardsCreator_SaveSupplyAccountingShouldSubtractDistributedRewards(t *testing.T) {
+	t.Parallel()
+
+	rc, err := NewRewardsCreator(getRewardsArguments())
+	require.Nil(t, err)
+
+	metaBlock := createEpochStartMetaBlockForRewardsAudit(1)
+	metaBlock.AccumulatedFeesInEpoch = big.NewInt(0)
+	miniBlocks, err := rc.CreateRewardsMiniBlocks(metaBlock, createValidatorsInfoForRewardsAudit(), &metaBlock.EpochStart.Economics)
+	require.Nil(t, err)
+
+	body := &block.Body{MiniBlocks: miniBlocks}
+	distributed := big.NewInt(0)
+	for _, rwdTx := range rc.GetRewardsTxs(body) {
+		distributed.Add(distributed, rwdTx.GetValue())
+	}
+	require.True(t, distributed.Sign() > 0)
+
+	rc.SaveTxBlockToStorage(metaBlock, body)
+	supplyAccounting := getSavedSupplyAccounting(t, rc, 1)
+	expectedBurned := big.NewInt(0).Sub(metaBlock.EpochStart.Economics.TotalNewlyMinted, distributed)
+	if expectedBurned.Sign() < 0 {
+		expectedBurned.SetUint64(0)
+	}
+	assert.Equal(t, expectedBurned.String(), supplyAccounting.BurnedFees)
+}
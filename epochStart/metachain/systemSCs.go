@@ -20,6 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 )
@@ -42,6 +43,7 @@ type ArgsNewEpochStartSystemSCProcessing struct {
 	SwitchHysteresisForMinNodesEnableEpoch uint32
 	DelegationEnableEpoch                  uint32
 	StakingV2EnableEpoch                   uint32
+	SwapNodeEnableEpoch                    uint32
 	MaxNodesEnableConfig                   []config.MaxNodesChangeConfig
 
 	GenesisNodesConfig  sharding.GenesisNodesSetupHandler
@@ -68,6 +70,7 @@ type systemSCProcessor struct {
 	hystNodesEnableEpoch      uint32
 	delegationEnableEpoch     uint32
 	stakingV2EnableEpoch      uint32
+	swapNodeEnableEpoch       uint32
 	maxNodesEnableConfig      []config.MaxNodesChangeConfig
 	maxNodes                  uint32
 	flagSwitchJailedWaiting   atomic.Flag
@@ -76,8 +79,12 @@ type systemSCProcessor struct {
 	flagSetOwnerEnabled       atomic.Flag
 	flagChangeMaxNodesEnabled atomic.Flag
 	flagStakingV2Enabled      atomic.Flag
+	flagSwapNodeEnabled       atomic.Flag
 	mapNumSwitchedPerShard    map[uint32]uint32
 	mapNumSwitchablePerShard  map[uint32]uint32
+	appStatusHandler          core.AppStatusHandler
+	stopWatch                 *core.StopWatch
+	processingCounts          systemSCProcessingCounts
 }
 
 type validatorList []*state.ValidatorInfo
@@ -159,9 +166,12 @@ func NewSystemSCProcessor(args ArgsNewEpochStartSystemSCProcessing) (*systemSCPr
 		hystNodesEnableEpoch:     args.SwitchHysteresisForMinNodesEnableEpoch,
 		delegationEnableEpoch:    args.DelegationEnableEpoch,
 		stakingV2EnableEpoch:     args.StakingV2EnableEpoch,
+		swapNodeEnableEpoch:      args.SwapNodeEnableEpoch,
 		stakingDataProvider:      args.StakingDataProvider,
 		nodesConfigProvider:      args.NodesConfigProvider,
 		shardCoordinator:         args.ShardCoordinator,
+		appStatusHandler:         &statusHandler.NilStatusHandler{},
+		stopWatch:                core.NewStopWatch(),
 	}
 
 	s.maxNodesEnableConfig = make([]config.MaxNodesChangeConfig, len(args.MaxNodesEnableConfig))
@@ -174,69 +184,109 @@ func NewSystemSCProcessor(args ArgsNewEpochStartSystemSCProcessing) (*systemSCPr
 	return s, nil
 }
 
-// ProcessSystemSmartContract does all the processing at end of epoch in case of system smart contract
+// ProcessSystemSmartContract does all the processing at end of epoch in case of system smart contract. The duration
+// of each processing phase and the number of executed operations are exported as metrics
 func (s *systemSCProcessor) ProcessSystemSmartContract(
 	validatorInfos map[uint32][]*state.ValidatorInfo,
 	nonce uint64,
 	epoch uint32,
 ) error {
-	if s.flagHystNodesEnabled.IsSet() {
-		err := s.updateSystemSCConfigMinNodes()
-		if err != nil {
-			return err
-		}
-	}
+	s.resetProcessingMetrics()
+	defer s.exportProcessingMetrics(epoch)
 
-	if s.flagSetOwnerEnabled.IsSet() {
-		err := s.updateOwnersForBlsKeys()
-		if err != nil {
-			return err
-		}
+	return s.measurePhase(phaseSystemSCProcessing, func() error {
+		return s.processSystemSmartContract(validatorInfos, nonce, epoch)
+	})
+}
+
+func (s *systemSCProcessor) processSystemSmartContract(
+	validatorInfos map[uint32][]*state.ValidatorInfo,
+	nonce uint64,
+	epoch uint32,
+) error {
+	err := s.measurePhase(phaseValidatorUpdates, func() error {
+		return s.updateValidatorsConfig(validatorInfos, nonce)
+	})
+	if err != nil {
+		return err
 	}
 
-	if s.flagChangeMaxNodesEnabled.IsSet() {
-		err := s.updateMaxNodes(validatorInfos, nonce)
+	if s.flagSwapNodeEnabled.IsSet() {
+		err = s.measurePhase(phaseValidatorUpdates, func() error {
+			return s.swapNodes(validatorInfos)
+		})
 		if err != nil {
 			return err
 		}
 	}
 
 	if s.flagDelegationEnabled.IsSet() {
-		err := s.initDelegationSystemSC()
+		err = s.measurePhase(phaseDelegationUpdates, s.initDelegationSystemSC)
 		if err != nil {
 			return err
 		}
 	}
 
 	if s.flagSwitchJailedWaiting.IsSet() {
-		err := s.computeNumWaitingPerShard(validatorInfos)
+		err = s.measurePhase(phaseUnJailSweep, func() error {
+			errCompute := s.computeNumWaitingPerShard(validatorInfos)
+			if errCompute != nil {
+				return errCompute
+			}
+
+			return s.swapJailedWithWaiting(validatorInfos)
+		})
 		if err != nil {
 			return err
 		}
+	}
+
+	if s.flagStakingV2Enabled.IsSet() {
+		err = s.measurePhase(phaseValidatorUpdates, func() error {
+			errPrepare := s.prepareRewardsData(validatorInfos)
+			if errPrepare != nil {
+				return errPrepare
+			}
 
-		err = s.swapJailedWithWaiting(validatorInfos)
+			return s.fillStakingDataForNonEligible(validatorInfos)
+		})
 		if err != nil {
 			return err
 		}
-	}
 
-	if s.flagStakingV2Enabled.IsSet() {
-		err := s.prepareRewardsData(validatorInfos)
+		err = s.measurePhase(phaseAuctionSelection, func() error {
+			numUnStaked, errUnStake := s.unStakeNodesWithNotEnoughFunds(validatorInfos, epoch)
+			if errUnStake != nil {
+				return errUnStake
+			}
+
+			return s.stakeNodesFromQueue(validatorInfos, numUnStaked, nonce)
+		})
 		if err != nil {
 			return err
 		}
+	}
+
+	return nil
+}
 
-		err = s.fillStakingDataForNonEligible(validatorInfos)
+func (s *systemSCProcessor) updateValidatorsConfig(validatorInfos map[uint32][]*state.ValidatorInfo, nonce uint64) error {
+	if s.flagHystNodesEnabled.IsSet() {
+		err := s.updateSystemSCConfigMinNodes()
 		if err != nil {
 			return err
 		}
+	}
 
-		numUnStaked, err := s.unStakeNodesWithNotEnoughFunds(validatorInfos, epoch)
+	if s.flagSetOwnerEnabled.IsSet() {
+		err := s.updateOwnersForBlsKeys()
 		if err != nil {
 			return err
 		}
+	}
 
-		err = s.stakeNodesFromQueue(validatorInfos, numUnStaked, nonce)
+	if s.flagChangeMaxNodesEnabled.IsSet() {
+		err := s.updateMaxNodes(validatorInfos, nonce)
 		if err != nil {
 			return err
 		}
@@ -306,11 +356,15 @@ func (s *systemSCProcessor) unStakeNodesWithNotEnoughFunds(
 		validatorInfo.List = string(core.LeavingList)
 	}
 
-	err = s.updateDelegationContracts(mapOwnersKeys)
+	err = s.measurePhase(phaseDelegationUpdates, func() error {
+		return s.updateDelegationContracts(mapOwnersKeys)
+	})
 	if err != nil {
 		return 0, err
 	}
 
+	s.processingCounts.numUnStaked = uint64(len(nodesToUnStake))
+
 	return uint32(len(nodesToUnStake)), nil
 }
 
@@ -392,6 +446,8 @@ func (s *systemSCProcessor) updateDelegationContracts(mapOwnerKeys map[string][]
 		if err != nil {
 			return err
 		}
+
+		s.processingCounts.numDelegationUpdates++
 	}
 
 	return nil
@@ -1088,6 +1144,11 @@ func (s *systemSCProcessor) updateSystemSCContractsCode(contractMetadata []byte)
 	contractsToUpdate = append(contractsToUpdate, vm.ESDTSCAddress)
 	contractsToUpdate = append(contractsToUpdate, vm.DelegationManagerSCAddress)
 	contractsToUpdate = append(contractsToUpdate, vm.FirstDelegationSCAddress)
+	contractsToUpdate = append(contractsToUpdate, vm.SlashingSCAddress)
+	contractsToUpdate = append(contractsToUpdate, vm.RandomnessSCAddress)
+	contractsToUpdate = append(contractsToUpdate, vm.InsuranceFundSCAddress)
+	contractsToUpdate = append(contractsToUpdate, vm.VestingSCAddress)
+	contractsToUpdate = append(contractsToUpdate, vm.MintingSCAddress)
 
 	for _, address := range contractsToUpdate {
 		userAcc, err := s.getUserAccount(address)
@@ -1148,6 +1209,8 @@ func (s *systemSCProcessor) stakeNodesFromQueue(
 		return err
 	}
 
+	s.processingCounts.numStakedFromQueue = uint64(len(vmOutput.ReturnData) / 2)
+
 	return nil
 }
 
@@ -1200,6 +1263,114 @@ func (s *systemSCProcessor) addNewlyStakedNodesToValidatorTrie(
 	return nil
 }
 
+func (s *systemSCProcessor) swapNodes(validatorInfos map[uint32][]*state.ValidatorInfo) error {
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vm.EndOfEpochAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{},
+		},
+		RecipientAddr: vm.ValidatorSCAddress,
+		Function:      "applySwapNodes",
+	}
+	vmOutput, errRun := s.systemVM.RunSmartContractCall(vmInput)
+	if errRun != nil {
+		return fmt.Errorf("%w when swapping nodes", errRun)
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("got return code %s when swapping nodes", vmOutput.ReturnCode)
+	}
+	if len(vmOutput.ReturnData)%2 != 0 {
+		return fmt.Errorf("%w return data must be divisible by 2 when swapping nodes", epochStart.ErrInvalidSystemSCReturn)
+	}
+	if len(vmOutput.ReturnMessage) > 0 {
+		log.Debug("applySwapNodes", "returnMessage", vmOutput.ReturnMessage)
+	}
+
+	err := s.processSCOutputAccounts(vmOutput)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < len(vmOutput.ReturnData); i += 2 {
+		err = s.renameValidator(validatorInfos, vmOutput.ReturnData[i], vmOutput.ReturnData[i+1])
+		if err != nil {
+			return err
+		}
+	}
+
+	s.processingCounts.numNodesSwapped = uint64(len(vmOutput.ReturnData) / 2)
+
+	return nil
+}
+
+// renameValidator moves the peer account of the old key under the new key and renames the validator info, so the
+// new key keeps the shard, the list, the index and the rating of the old one in the next nodes configuration
+func (s *systemSCProcessor) renameValidator(
+	validatorInfos map[uint32][]*state.ValidatorInfo,
+	oldKey []byte,
+	newKey []byte,
+) error {
+	oldAccount, err := s.getPeerAccount(oldKey)
+	if err != nil {
+		return err
+	}
+	newAccount, err := s.getPeerAccount(newKey)
+	if err != nil {
+		return err
+	}
+
+	err = newAccount.SetBLSPublicKey(newKey)
+	if err != nil {
+		return err
+	}
+	err = newAccount.SetRewardAddress(oldAccount.GetRewardAddress())
+	if err != nil {
+		return err
+	}
+
+	newAccount.SetListAndIndex(oldAccount.GetShardId(), oldAccount.GetList(), oldAccount.GetIndexInList())
+	newAccount.SetRating(oldAccount.GetRating())
+	newAccount.SetTempRating(oldAccount.GetTempRating())
+	newAccount.SetUnStakedEpoch(oldAccount.GetUnStakedEpoch())
+	newAccount.SetConsecutiveProposerMisses(oldAccount.GetConsecutiveProposerMisses())
+	newAccount.SetConsecutiveValidatorMisses(oldAccount.GetConsecutiveValidatorMisses())
+	newAccount.SetProbationEndEpoch(oldAccount.GetProbationEndEpoch())
+	newAccount.AddToAccumulatedFees(oldAccount.GetAccumulatedFees())
+	// the rates of the current epoch are carried over, the totals of the previous epochs stay with the old key
+	newAccount.IncreaseLeaderSuccessRate(oldAccount.GetLeaderSuccessRate().NumSuccess)
+	newAccount.DecreaseLeaderSuccessRate(oldAccount.GetLeaderSuccessRate().NumFailure)
+	newAccount.IncreaseValidatorSuccessRate(oldAccount.GetValidatorSuccessRate().NumSuccess)
+	newAccount.DecreaseValidatorSuccessRate(oldAccount.GetValidatorSuccessRate().NumFailure)
+	newAccount.IncreaseValidatorIgnoredSignaturesRate(oldAccount.GetValidatorIgnoredSignaturesRate())
+
+	err = s.peerAccountsDB.SaveAccount(newAccount)
+	if err != nil {
+		return err
+	}
+
+	err = s.peerAccountsDB.RemoveAccount(oldKey)
+	if err != nil {
+		return err
+	}
+
+	for _, validatorsInShard := range validatorInfos {
+		for _, validatorInfo := range validatorsInShard {
+			if bytes.Equal(validatorInfo.PublicKey, oldKey) {
+				validatorInfo.PublicKey = newKey
+			}
+		}
+	}
+
+	log.Debug("node swapped",
+		"old key", oldKey,
+		"new key", newKey,
+		"shard", newAccount.GetShardId(),
+		"list", newAccount.GetList())
+
+	return nil
+}
+
 // IsInterfaceNil returns true if underlying object is nil
 func (s *systemSCProcessor) IsInterfaceNil() bool {
 	return s == nil
@@ -1232,6 +1403,9 @@ func (s *systemSCProcessor) EpochConfirmed(epoch uint32) {
 	s.flagSetOwnerEnabled.Toggle(epoch == s.stakingV2EnableEpoch)
 	s.flagStakingV2Enabled.Toggle(epoch >= s.stakingV2EnableEpoch)
 	log.Debug("systemSCProcessor: stakingV2", "enabled", epoch >= s.stakingV2EnableEpoch)
+
+	s.flagSwapNodeEnabled.Toggle(epoch >= s.swapNodeEnableEpoch)
+	log.Debug("systemSCProcessor: swap node", "enabled", s.flagSwapNodeEnabled.IsSet())
 	log.Debug("systemSCProcessor:change of maximum number of nodes and/or shuffling percentage",
 		"enabled", s.flagChangeMaxNodesEnabled.IsSet(),
 		"epoch", epoch,
@@ -0,0 +1,73 @@
+package metachain
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+)
+
+const (
+	phaseSystemSCProcessing = "systemSCProcessing"
+	phaseValidatorUpdates   = "validatorUpdates"
+	phaseUnJailSweep        = "unJailSweep"
+	phaseAuctionSelection   = "auctionSelection"
+	phaseDelegationUpdates  = "delegationUpdates"
+)
+
+// systemSCProcessingCounts holds the number of operations executed by the system SC processor in one end of
+// epoch processing
+type systemSCProcessingCounts struct {
+	numJailedSwapped     uint64
+	numUnStaked          uint64
+	numStakedFromQueue   uint64
+	numDelegationUpdates uint64
+	numNodesSwapped      uint64
+}
+
+// SetAppStatusHandler will set the status handler used to export the end of epoch processing metrics
+func (s *systemSCProcessor) SetAppStatusHandler(handler core.AppStatusHandler) error {
+	if check.IfNil(handler) {
+		return epochStart.ErrNilStatusHandler
+	}
+
+	s.appStatusHandler = handler
+	return nil
+}
+
+func (s *systemSCProcessor) resetProcessingMetrics() {
+	s.stopWatch = core.NewStopWatch()
+	s.processingCounts = systemSCProcessingCounts{}
+}
+
+func (s *systemSCProcessor) measurePhase(phase string, handler func() error) error {
+	s.stopWatch.Start(phase)
+	defer s.stopWatch.Stop(phase)
+
+	return handler()
+}
+
+func (s *systemSCProcessor) exportProcessingMetrics(epoch uint32) {
+	for _, numSwitched := range s.mapNumSwitchedPerShard {
+		s.processingCounts.numJailedSwapped += uint64(numSwitched)
+	}
+
+	s.setDurationMetric(core.MetricSystemSCProcessingDuration, phaseSystemSCProcessing)
+	s.setDurationMetric(core.MetricSystemSCValidatorUpdatesDuration, phaseValidatorUpdates)
+	s.setDurationMetric(core.MetricSystemSCUnJailSweepDuration, phaseUnJailSweep)
+	s.setDurationMetric(core.MetricSystemSCAuctionSelectionDuration, phaseAuctionSelection)
+	s.setDurationMetric(core.MetricSystemSCDelegationUpdatesDuration, phaseDelegationUpdates)
+
+	s.appStatusHandler.SetUInt64Value(core.MetricSystemSCNumJailedSwapped, s.processingCounts.numJailedSwapped)
+	s.appStatusHandler.SetUInt64Value(core.MetricSystemSCNumUnStaked, s.processingCounts.numUnStaked)
+	s.appStatusHandler.SetUInt64Value(core.MetricSystemSCNumStakedFromQueue, s.processingCounts.numStakedFromQueue)
+	s.appStatusHandler.SetUInt64Value(core.MetricSystemSCNumDelegationUpdates, s.processingCounts.numDelegationUpdates)
+	s.appStatusHandler.SetUInt64Value(core.MetricSystemSCNumNodesSwapped, s.processingCounts.numNodesSwapped)
+
+	measurements := append([]interface{}{"epoch", epoch}, s.stopWatch.GetMeasurements()...)
+	log.Debug("end of epoch system SC processing measurements", measurements...)
+}
+
+func (s *systemSCProcessor) setDurationMetric(metric string, phase string) {
+	duration := s.stopWatch.GetMeasurement(phase)
+	s.appStatusHandler.SetUInt64Value(metric, uint64(duration.Milliseconds()))
+}
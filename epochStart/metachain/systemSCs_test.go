@@ -761,6 +761,7 @@ func createFullArgumentsForSystemSCProcessing(stakingV2EnableEpoch uint32, trieS
 		PeerAdapter:         peerAccountsDB,
 		Rater:               &mock.RaterStub{},
 		RewardsHandler:      &mock.RewardsHandlerStub{},
+		ProposerIdentifier:  &mock.ProposerIdentifierStub{},
 		NodesSetup:          &mock.NodesSetupStub{},
 		MaxComputableRounds: 1,
 		EpochNotifier:       epochNotifier,
@@ -794,6 +795,7 @@ func createFullArgumentsForSystemSCProcessing(stakingV2EnableEpoch uint32, trieS
 		MessageSignVerifier: signVerifer,
 		GasSchedule:         mock.NewGasScheduleNotifierMock(gasSchedule),
 		NodesConfigProvider: nodesSetup,
+		NodesCoordinator:    &disabled.NodesCoordinator{},
 		Hasher:              hasher,
 		Marshalizer:         marshalizer,
 		SystemSCConfig: &config.SystemSmartContractsConfig{
@@ -1190,6 +1192,72 @@ func TestSystemSCProcessor_ProcessSystemSmartContractUnStakeOneNodeStakeOthers(t
 	assert.Equal(t, string(core.NewList), validatorInfos[0][4].List)
 }
 
+func TestSystemSCProcessor_ProcessSystemSmartContractShouldExportProcessingMetrics(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createFullArgumentsForSystemSCProcessing(0, createMemUnit())
+	args.StakingV2EnableEpoch = 0
+	s, _ := NewSystemSCProcessor(args)
+
+	err := s.SetAppStatusHandler(nil)
+	assert.Equal(t, epochStart.ErrNilStatusHandler, err)
+
+	metrics := make(map[string]uint64)
+	err = s.SetAppStatusHandler(&mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			metrics[key] = value
+		},
+	})
+	assert.Nil(t, err)
+
+	prepareStakingContractWithData(
+		args.UserAccountsDB,
+		[]byte("stakedPubKey0"),
+		[]byte("waitingPubKey"),
+		args.Marshalizer,
+		[]byte("rewardAddress"),
+		[]byte("rewardAddress"),
+	)
+
+	addStakedData(args.UserAccountsDB, []byte("stakedPubKey1"), []byte("ownerKey"), args.Marshalizer)
+	addValidatorData(args.UserAccountsDB, []byte("ownerKey"), [][]byte{[]byte("stakedPubKey1")}, big.NewInt(0), args.Marshalizer)
+	_, _ = args.UserAccountsDB.Commit()
+
+	validatorInfos := make(map[uint32][]*state.ValidatorInfo)
+	validatorInfos[0] = append(validatorInfos[0], &state.ValidatorInfo{
+		PublicKey:       []byte("stakedPubKey0"),
+		List:            string(core.EligibleList),
+		RewardAddress:   []byte("rewardAddress"),
+		AccumulatedFees: big.NewInt(0),
+	})
+	validatorInfos[0] = append(validatorInfos[0], &state.ValidatorInfo{
+		PublicKey:       []byte("stakedPubKey1"),
+		List:            string(core.EligibleList),
+		RewardAddress:   []byte("rewardAddress"),
+		AccumulatedFees: big.NewInt(0),
+	})
+
+	s.flagSetOwnerEnabled.Unset()
+	err = s.ProcessSystemSmartContract(validatorInfos, 0, 0)
+	assert.Nil(t, err)
+
+	assert.Equal(t, uint64(1), metrics[core.MetricSystemSCNumUnStaked])
+	assert.Equal(t, uint64(1), metrics[core.MetricSystemSCNumStakedFromQueue])
+	assert.Equal(t, uint64(0), metrics[core.MetricSystemSCNumJailedSwapped])
+	assert.Equal(t, uint64(0), metrics[core.MetricSystemSCNumDelegationUpdates])
+	durationMetrics := []string{
+		core.MetricSystemSCProcessingDuration,
+		core.MetricSystemSCValidatorUpdatesDuration,
+		core.MetricSystemSCUnJailSweepDuration,
+		core.MetricSystemSCAuctionSelectionDuration,
+		core.MetricSystemSCDelegationUpdatesDuration,
+	}
+	for _, metric := range durationMetrics {
+		_, found := metrics[metric]
+		assert.True(t, found, metric)
+	}
+}
+
 func TestSystemSCProcessor_ProcessSystemSmartContractUnStakeTheOnlyNodeShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1417,3 +1485,82 @@ func TestSystemSCProcessor_TogglePauseUnPause(t *testing.T) {
 	value, _ = validatorSC.DataTrie().Get([]byte("unStakeUnBondPause"))
 	assert.True(t, value[0] == 0)
 }
+
+func TestSystemSCProcessor_ProcessSystemSmartContractShouldSwapNodes(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createFullArgumentsForSystemSCProcessing(0, createMemUnit())
+	s, _ := NewSystemSCProcessor(args)
+
+	metrics := make(map[string]uint64)
+	_ = s.SetAppStatusHandler(&mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			metrics[key] = value
+		},
+	})
+
+	owner := append([]byte("owner"), bytes.Repeat([]byte{1}, 27)...)
+	oldKey := []byte("old bls key")
+	newKey := []byte("new bls key")
+	doStake(t, s.systemVM, s.userAccountsDB, owner, big.NewInt(1000), oldKey)
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  owner,
+			Arguments:   [][]byte{oldKey, newKey, []byte("sig")},
+			CallValue:   big.NewInt(0),
+			GasProvided: math.MaxUint64,
+		},
+		RecipientAddr: vm.ValidatorSCAddress,
+		Function:      "swapNode",
+	}
+	vmOutput, err := s.systemVM.RunSmartContractCall(vmInput)
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	saveOutputAccounts(t, s.userAccountsDB, vmOutput)
+
+	peerAcc, _ := s.getPeerAccount(oldKey)
+	_ = peerAcc.SetBLSPublicKey(oldKey)
+	_ = peerAcc.SetRewardAddress(owner)
+	peerAcc.SetListAndIndex(1, string(core.EligibleList), 7)
+	peerAcc.SetTempRating(60)
+	peerAcc.IncreaseValidatorSuccessRate(3)
+	_ = s.peerAccountsDB.SaveAccount(peerAcc)
+
+	validatorInfos := make(map[uint32][]*state.ValidatorInfo)
+	validatorInfos[1] = append(validatorInfos[1], &state.ValidatorInfo{
+		PublicKey:       oldKey,
+		ShardId:         1,
+		List:            string(core.EligibleList),
+		Index:           7,
+		TempRating:      60,
+		RewardAddress:   owner,
+		AccumulatedFees: big.NewInt(0),
+	})
+
+	err = s.ProcessSystemSmartContract(validatorInfos, 0, 0)
+	assert.Nil(t, err)
+
+	assert.Equal(t, newKey, validatorInfos[1][0].PublicKey)
+	assert.Equal(t, string(core.EligibleList), validatorInfos[1][0].List)
+	assert.Equal(t, uint64(1), metrics[core.MetricSystemSCNumNodesSwapped])
+
+	peerAcc, _ = s.getPeerAccount(newKey)
+	assert.Equal(t, newKey, peerAcc.GetBLSPublicKey())
+	assert.Equal(t, owner, peerAcc.GetRewardAddress())
+	assert.Equal(t, uint32(1), peerAcc.GetShardId())
+	assert.Equal(t, string(core.EligibleList), peerAcc.GetList())
+	assert.Equal(t, uint32(7), peerAcc.GetIndexInList())
+	assert.Equal(t, uint32(60), peerAcc.GetTempRating())
+	assert.Equal(t, uint32(3), peerAcc.GetValidatorSuccessRate().NumSuccess)
+
+	peerAcc, _ = s.getPeerAccount(oldKey)
+	assert.Equal(t, 0, len(peerAcc.GetBLSPublicKey()))
+
+	checkOwnerOfBlsKey(t, s.systemVM, newKey, owner)
+
+	// the pending swaps are cleared
+	err = s.ProcessSystemSmartContract(validatorInfos, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), metrics[core.MetricSystemSCNumNodesSwapped])
+}
@@ -11,10 +11,15 @@ type MessengerStub struct {
 	RegisterMessageProcessorCalled func(topic string, handler p2p.MessageProcessor) error
 	UnjoinAllTopicsCalled          func() error
 	IDCalled                       func() core.PeerID
+	ConnectedPeersOnTopicCalled    func(topic string) []core.PeerID
 }
 
 // ConnectedPeersOnTopic -
-func (m *MessengerStub) ConnectedPeersOnTopic(_ string) []core.PeerID {
+func (m *MessengerStub) ConnectedPeersOnTopic(topic string) []core.PeerID {
+	if m.ConnectedPeersOnTopicCalled != nil {
+		return m.ConnectedPeersOnTopicCalled(topic)
+	}
+
 	return []core.PeerID{"peer0"}
 }
 
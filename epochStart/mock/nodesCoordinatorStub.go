@@ -141,6 +141,11 @@ func (ncm *NodesCoordinatorStub) GetConsensusWhitelistedNodes(
 	panic("not implemented")
 }
 
+// GetEpochValidatorsAssignment -
+func (ncm *NodesCoordinatorStub) GetEpochValidatorsAssignment(_ uint32) (*sharding.EpochValidatorsAssignment, error) {
+	panic("not implemented")
+}
+
 // GetSelectedPublicKeys -
 func (ncm *NodesCoordinatorStub) GetSelectedPublicKeys(_ []byte, _ uint32, _ uint32) ([]string, error) {
 	panic("implement me")
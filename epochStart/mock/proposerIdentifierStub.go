@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ProposerIdentifierStub -
+type ProposerIdentifierStub struct {
+	GetProposerIndexCalled func(header data.HeaderHandler, consensusGroup []sharding.Validator) (int, error)
+}
+
+// GetProposerIndex -
+func (pis *ProposerIdentifierStub) GetProposerIndex(header data.HeaderHandler, consensusGroup []sharding.Validator) (int, error) {
+	if pis.GetProposerIndexCalled != nil {
+		return pis.GetProposerIndexCalled(header, consensusGroup)
+	}
+
+	return 0, nil
+}
+
+// IsInterfaceNil -
+func (pis *ProposerIdentifierStub) IsInterfaceNil() bool {
+	return pis == nil
+}
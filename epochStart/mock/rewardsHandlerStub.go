@@ -9,6 +9,8 @@ type RewardsHandlerStub struct {
 	ProtocolSustainabilityAddressCalled    func() string
 	MinInflationRateCalled                 func() float64
 	MaxInflationRateCalled                 func(year uint32) float64
+	MaxInflationRateInEpochCalled          func(epoch uint32) (float64, bool)
+	TotalSupplyCapInEpochCalled            func(epoch uint32) *big.Int
 	RewardsTopUpGradientPointCalled        func() *big.Int
 	RewardsTopUpFactorCalled               func() float64
 }
@@ -58,6 +60,24 @@ func (r *RewardsHandlerStub) MaxInflationRate(year uint32) float64 {
 	return 1000000
 }
 
+// MaxInflationRateInEpoch -
+func (r *RewardsHandlerStub) MaxInflationRateInEpoch(epoch uint32) (float64, bool) {
+	if r.MaxInflationRateInEpochCalled != nil {
+		return r.MaxInflationRateInEpochCalled(epoch)
+	}
+
+	return 0, false
+}
+
+// TotalSupplyCapInEpoch -
+func (r *RewardsHandlerStub) TotalSupplyCapInEpoch(epoch uint32) *big.Int {
+	if r.TotalSupplyCapInEpochCalled != nil {
+		return r.TotalSupplyCapInEpochCalled(epoch)
+	}
+
+	return nil
+}
+
 // RewardsTopUpGradientPoint -
 func (r *RewardsHandlerStub) RewardsTopUpGradientPoint() *big.Int {
 	return r.RewardsTopUpGradientPointCalled()
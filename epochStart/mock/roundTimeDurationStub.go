@@ -4,7 +4,8 @@ import "time"
 
 // RoundTimeDurationHandler -
 type RoundTimeDurationHandler struct {
-	TimeDurationCalled func() time.Duration
+	TimeDurationCalled              func() time.Duration
+	TimeDurationBetweenRoundsCalled func(fromRound int64, toRound int64) time.Duration
 }
 
 // TimeDuration -
@@ -16,6 +17,15 @@ func (r *RoundTimeDurationHandler) TimeDuration() time.Duration {
 	return 4000 * time.Millisecond
 }
 
+// TimeDurationBetweenRounds -
+func (r *RoundTimeDurationHandler) TimeDurationBetweenRounds(fromRound int64, toRound int64) time.Duration {
+	if r.TimeDurationBetweenRoundsCalled != nil {
+		return r.TimeDurationBetweenRoundsCalled(fromRound, toRound)
+	}
+
+	return time.Duration(toRound-fromRound) * r.TimeDuration()
+}
+
 // IsInterfaceNil -
 func (r *RoundTimeDurationHandler) IsInterfaceNil() bool {
 	return r == nil
@@ -8,11 +8,12 @@ import (
 type RounderStub struct {
 	RoundIndex int64
 
-	IndexCalled         func() int64
-	TimeDurationCalled  func() time.Duration
-	TimeStampCalled     func() time.Time
-	UpdateRoundCalled   func(time.Time, time.Time)
-	RemainingTimeCalled func(startTime time.Time, maxTime time.Duration) time.Duration
+	IndexCalled                     func() int64
+	TimeDurationCalled              func() time.Duration
+	TimeDurationBetweenRoundsCalled func(fromRound int64, toRound int64) time.Duration
+	TimeStampCalled                 func() time.Time
+	UpdateRoundCalled               func(time.Time, time.Time)
+	RemainingTimeCalled             func(startTime time.Time, maxTime time.Duration) time.Duration
 }
 
 // Index -
@@ -33,6 +34,15 @@ func (rndm *RounderStub) TimeDuration() time.Duration {
 	return 4000 * time.Millisecond
 }
 
+// TimeDurationBetweenRounds -
+func (rndm *RounderStub) TimeDurationBetweenRounds(fromRound int64, toRound int64) time.Duration {
+	if rndm.TimeDurationBetweenRoundsCalled != nil {
+		return rndm.TimeDurationBetweenRoundsCalled(fromRound, toRound)
+	}
+
+	return time.Duration(toRound-fromRound) * rndm.TimeDuration()
+}
+
 // TimeStamp -
 func (rndm *RounderStub) TimeStamp() time.Time {
 	if rndm.TimeStampCalled != nil {
@@ -26,6 +26,11 @@ func (nsm *NodeShufflerMock) UpdateNodeLists(args sharding.ArgsUpdateNodes) (*sh
 	}, nil
 }
 
+// NodesToShuffle -
+func (nsm *NodeShufflerMock) NodesToShuffle(_ uint32) (uint32, uint32) {
+	return 0, 0
+}
+
 // IsInterfaceNil -
 func (nsm *NodeShufflerMock) IsInterfaceNil() bool {
 	return nsm == nil
@@ -0,0 +1,41 @@
+package mock
+
+import (
+	"math/big"
+)
+
+// TopUpRewardsSettingsHandlerStub -
+type TopUpRewardsSettingsHandlerStub struct {
+	RewardsTopUpGradientPointInEpochCalled  func(epoch uint32) *big.Int
+	RewardsTopUpFactorInEpochCalled         func(epoch uint32) float64
+	RewardsTopUpCurveSteepnessInEpochCalled func(epoch uint32) float64
+}
+
+// RewardsTopUpGradientPointInEpoch -
+func (stub *TopUpRewardsSettingsHandlerStub) RewardsTopUpGradientPointInEpoch(epoch uint32) *big.Int {
+	if stub.RewardsTopUpGradientPointInEpochCalled != nil {
+		return stub.RewardsTopUpGradientPointInEpochCalled(epoch)
+	}
+	return big.NewInt(0)
+}
+
+// RewardsTopUpFactorInEpoch -
+func (stub *TopUpRewardsSettingsHandlerStub) RewardsTopUpFactorInEpoch(epoch uint32) float64 {
+	if stub.RewardsTopUpFactorInEpochCalled != nil {
+		return stub.RewardsTopUpFactorInEpochCalled(epoch)
+	}
+	return 0
+}
+
+// RewardsTopUpCurveSteepnessInEpoch -
+func (stub *TopUpRewardsSettingsHandlerStub) RewardsTopUpCurveSteepnessInEpoch(epoch uint32) float64 {
+	if stub.RewardsTopUpCurveSteepnessInEpochCalled != nil {
+		return stub.RewardsTopUpCurveSteepnessInEpochCalled(epoch)
+	}
+	return 1
+}
+
+// IsInterfaceNil -
+func (stub *TopUpRewardsSettingsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
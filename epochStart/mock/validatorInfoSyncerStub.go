@@ -7,10 +7,15 @@ import (
 
 // ValidatorInfoSyncerStub -
 type ValidatorInfoSyncerStub struct {
+	SyncMiniBlocksCalled func(metaBlock *block.MetaBlock) ([][]byte, data.BodyHandler, error)
 }
 
 // SyncMiniBlocks -
-func (vip *ValidatorInfoSyncerStub) SyncMiniBlocks(*block.MetaBlock) ([][]byte, data.BodyHandler, error) {
+func (vip *ValidatorInfoSyncerStub) SyncMiniBlocks(metaBlock *block.MetaBlock) ([][]byte, data.BodyHandler, error) {
+	if vip.SyncMiniBlocksCalled != nil {
+		return vip.SyncMiniBlocksCalled(metaBlock)
+	}
+
 	return nil, nil, nil
 }
 
@@ -0,0 +1,35 @@
+package epochStart
+
+// ValidatorRewardsAudit holds the inputs and the outputs of the rewards computation for a validator
+type ValidatorRewardsAudit struct {
+	PublicKey                  string  `json:"publicKey"`
+	RewardAddress              string  `json:"rewardAddress"`
+	BlocksProposed             uint32  `json:"blocksProposed"`
+	BlocksNotProposed          uint32  `json:"blocksNotProposed"`
+	BlocksSigned               uint32  `json:"blocksSigned"`
+	BlocksNotSigned            uint32  `json:"blocksNotSigned"`
+	NumSelectedInSuccessBlocks uint32  `json:"numSelectedInSuccessBlocks"`
+	RatingModifier             float32 `json:"ratingModifier"`
+	BaseReward                 string  `json:"baseReward"`
+	TopUpReward                string  `json:"topUpReward"`
+	AccumulatedFees            string  `json:"accumulatedFees"`
+	IsRewarded                 bool    `json:"isRewarded"`
+}
+
+// ShardRewardsAudit holds the rewards computation records of the validators from a shard
+type ShardRewardsAudit struct {
+	ShardID                         uint32                   `json:"shardID"`
+	BaseRewardsPerBlockPerValidator string                   `json:"baseRewardsPerBlockPerValidator"`
+	Validators                      []*ValidatorRewardsAudit `json:"validators"`
+}
+
+// RewardsAudit holds the record of the rewards computed in the start of epoch meta block of an epoch, for the
+// activity of the validators in the previous epoch
+type RewardsAudit struct {
+	Epoch                            uint32               `json:"epoch"`
+	TotalToDistribute                string               `json:"totalToDistribute"`
+	RewardsPerBlock                  string               `json:"rewardsPerBlock"`
+	RewardsForProtocolSustainability string               `json:"rewardsForProtocolSustainability"`
+	DevFeesInEpoch                   string               `json:"devFeesInEpoch"`
+	Shards                           []*ShardRewardsAudit `json:"shards"`
+}
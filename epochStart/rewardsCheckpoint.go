@@ -0,0 +1,217 @@
+package epochStart
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+)
+
+const (
+	rewardsLeafPrefix = byte(0)
+	rewardsNodePrefix = byte(1)
+)
+
+// RewardsCheckpointLeaf holds the rewards earned in an epoch by a reward address
+type RewardsCheckpointLeaf struct {
+	Address []byte
+	Value   *big.Int
+}
+
+// RewardsCheckpoint holds the rewards earned by each reward address in an epoch, sorted by address. The merkle root
+// hash of the leaves is committed in the economics data of the start of epoch meta block
+type RewardsCheckpoint struct {
+	Epoch    uint32
+	RootHash []byte
+	Leaves   []*RewardsCheckpointLeaf
+}
+
+// RewardsProof holds the data needed by a third party to verify the rewards earned by an address in an epoch against
+// the rewards root hash committed in the start of epoch meta block
+type RewardsProof struct {
+	Epoch     uint32   `json:"epoch"`
+	RootHash  []byte   `json:"rootHash"`
+	Address   []byte   `json:"address"`
+	Value     *big.Int `json:"value"`
+	LeafIndex uint32   `json:"leafIndex"`
+	NumLeaves uint32   `json:"numLeaves"`
+	Siblings  [][]byte `json:"siblings"`
+}
+
+// NewRewardsCheckpoint creates the rewards checkpoint of an epoch from the provided reward transactions. The rewards
+// sent to the same address are summed up in a single leaf
+func NewRewardsCheckpoint(
+	epoch uint32,
+	rewardsTxs map[string]data.TransactionHandler,
+	hasher hashing.Hasher,
+) (*RewardsCheckpoint, error) {
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	rewardsPerAddress := make(map[string]*big.Int)
+	for _, tx := range rewardsTxs {
+		if check.IfNil(tx) || tx.GetValue() == nil {
+			continue
+		}
+
+		address := string(tx.GetRcvAddr())
+		value, found := rewardsPerAddress[address]
+		if !found {
+			value = big.NewInt(0)
+			rewardsPerAddress[address] = value
+		}
+		value.Add(value, tx.GetValue())
+	}
+
+	leaves := make([]*RewardsCheckpointLeaf, 0, len(rewardsPerAddress))
+	for address, value := range rewardsPerAddress {
+		leaves = append(leaves, &RewardsCheckpointLeaf{
+			Address: []byte(address),
+			Value:   value,
+		})
+	}
+	sort.Slice(leaves, func(i, j int) bool {
+		return bytes.Compare(leaves[i].Address, leaves[j].Address) < 0
+	})
+
+	checkpoint := &RewardsCheckpoint{
+		Epoch:  epoch,
+		Leaves: leaves,
+	}
+	checkpoint.RootHash = computeMerkleRoot(hasher, checkpoint.leafHashes(hasher))
+
+	return checkpoint, nil
+}
+
+// CreateProof creates the merkle proof of the rewards earned by the provided address
+func (rc *RewardsCheckpoint) CreateProof(hasher hashing.Hasher, address []byte) (*RewardsProof, error) {
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	index := sort.Search(len(rc.Leaves), func(i int) bool {
+		return bytes.Compare(rc.Leaves[i].Address, address) >= 0
+	})
+	if index == len(rc.Leaves) || !bytes.Equal(rc.Leaves[index].Address, address) {
+		return nil, ErrAddressNotRewarded
+	}
+
+	return &RewardsProof{
+		Epoch:     rc.Epoch,
+		RootHash:  rc.RootHash,
+		Address:   address,
+		Value:     rc.Leaves[index].Value,
+		LeafIndex: uint32(index),
+		NumLeaves: uint32(len(rc.Leaves)),
+		Siblings:  computeMerkleSiblings(hasher, rc.leafHashes(hasher), index),
+	}, nil
+}
+
+func (rc *RewardsCheckpoint) leafHashes(hasher hashing.Hasher) [][]byte {
+	hashes := make([][]byte, 0, len(rc.Leaves))
+	for _, leaf := range rc.Leaves {
+		hashes = append(hashes, ComputeRewardsLeafHash(hasher, leaf.Address, leaf.Value))
+	}
+
+	return hashes
+}
+
+// ComputeRewardsLeafHash computes the hash of the leaf holding the rewards earned by an address
+func ComputeRewardsLeafHash(hasher hashing.Hasher, address []byte, value *big.Int) []byte {
+	buff := make([]byte, 0, 1+len(address)+len(value.Bytes()))
+	buff = append(buff, rewardsLeafPrefix)
+	buff = append(buff, address...)
+	buff = append(buff, value.Bytes()...)
+
+	return hasher.Compute(string(buff))
+}
+
+// VerifyRewardsProof returns true if the provided proof links the rewards earned by the address to the provided
+// rewards root hash, as committed in the start of epoch meta block
+func VerifyRewardsProof(hasher hashing.Hasher, rootHash []byte, proof *RewardsProof) bool {
+	if check.IfNil(hasher) || proof == nil || proof.Value == nil || proof.LeafIndex >= proof.NumLeaves {
+		return false
+	}
+
+	currentHash := ComputeRewardsLeafHash(hasher, proof.Address, proof.Value)
+	index := proof.LeafIndex
+	levelSize := proof.NumLeaves
+	siblingIndex := 0
+	for levelSize > 1 {
+		isLastOddNode := index == levelSize-1 && levelSize%2 == 1
+		if !isLastOddNode {
+			if siblingIndex >= len(proof.Siblings) {
+				return false
+			}
+
+			sibling := proof.Siblings[siblingIndex]
+			siblingIndex++
+			if index%2 == 0 {
+				currentHash = computeNodeHash(hasher, currentHash, sibling)
+			} else {
+				currentHash = computeNodeHash(hasher, sibling, currentHash)
+			}
+		}
+
+		index /= 2
+		levelSize = (levelSize + 1) / 2
+	}
+
+	return siblingIndex == len(proof.Siblings) && bytes.Equal(currentHash, rootHash)
+}
+
+// computeMerkleRoot computes the root of a binary merkle tree. A node without a sibling is moved unchanged on the
+// upper level
+func computeMerkleRoot(hasher hashing.Hasher, level [][]byte) []byte {
+	if len(level) == 0 {
+		return nil
+	}
+
+	for len(level) > 1 {
+		level = computeUpperLevel(hasher, level)
+	}
+
+	return level[0]
+}
+
+func computeMerkleSiblings(hasher hashing.Hasher, level [][]byte, index int) [][]byte {
+	siblings := make([][]byte, 0)
+	for len(level) > 1 {
+		siblingIndex := index ^ 1
+		if siblingIndex < len(level) {
+			siblings = append(siblings, level[siblingIndex])
+		}
+
+		level = computeUpperLevel(hasher, level)
+		index /= 2
+	}
+
+	return siblings
+}
+
+func computeUpperLevel(hasher hashing.Hasher, level [][]byte) [][]byte {
+	upperLevel := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			upperLevel = append(upperLevel, level[i])
+			continue
+		}
+
+		upperLevel = append(upperLevel, computeNodeHash(hasher, level[i], level[i+1]))
+	}
+
+	return upperLevel
+}
+
+func computeNodeHash(hasher hashing.Hasher, left []byte, right []byte) []byte {
+	buff := make([]byte, 0, 1+len(left)+len(right))
+	buff = append(buff, rewardsNodePrefix)
+	buff = append(buff, left...)
+	buff = append(buff, right...)
+
+	return hasher.Compute(string(buff))
+}
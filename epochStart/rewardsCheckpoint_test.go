@@ -0,0 +1,101 @@
+package epochStart_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createRewardsTxs(numAddresses int) map[string]data.TransactionHandler {
+	rewardsTxs := make(map[string]data.TransactionHandler)
+	for i := 0; i < numAddresses; i++ {
+		address := []byte(fmt.Sprintf("address%d", i))
+		rewardsTxs[fmt.Sprintf("hash%d", i)] = &rewardTx.RewardTx{RcvAddr: address, Value: big.NewInt(int64(i + 1))}
+	}
+
+	return rewardsTxs
+}
+
+func TestNewRewardsCheckpoint_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	checkpoint, err := epochStart.NewRewardsCheckpoint(1, createRewardsTxs(2), nil)
+	assert.Nil(t, checkpoint)
+	assert.Equal(t, epochStart.ErrNilHasher, err)
+}
+
+func TestNewRewardsCheckpoint_SumsRewardsPerAddress(t *testing.T) {
+	t.Parallel()
+
+	rewardsTxs := createRewardsTxs(2)
+	rewardsTxs["extra"] = &rewardTx.RewardTx{RcvAddr: []byte("address0"), Value: big.NewInt(10)}
+
+	checkpoint, err := epochStart.NewRewardsCheckpoint(1, rewardsTxs, sha256.Sha256{})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(checkpoint.Leaves))
+	assert.Equal(t, []byte("address0"), checkpoint.Leaves[0].Address)
+	assert.Equal(t, big.NewInt(11), checkpoint.Leaves[0].Value)
+	assert.Equal(t, []byte("address1"), checkpoint.Leaves[1].Address)
+	assert.Equal(t, big.NewInt(2), checkpoint.Leaves[1].Value)
+}
+
+func TestNewRewardsCheckpoint_NoRewardsShouldHaveEmptyRootHash(t *testing.T) {
+	t.Parallel()
+
+	checkpoint, err := epochStart.NewRewardsCheckpoint(1, createRewardsTxs(0), sha256.Sha256{})
+	require.Nil(t, err)
+	assert.Nil(t, checkpoint.RootHash)
+}
+
+func TestRewardsCheckpoint_CreateProofUnknownAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	checkpoint, _ := epochStart.NewRewardsCheckpoint(1, createRewardsTxs(3), sha256.Sha256{})
+	proof, err := checkpoint.CreateProof(sha256.Sha256{}, []byte("unknown"))
+	assert.Nil(t, proof)
+	assert.Equal(t, epochStart.ErrAddressNotRewarded, err)
+}
+
+func TestRewardsCheckpoint_ProofsShouldVerify(t *testing.T) {
+	t.Parallel()
+
+	hasher := sha256.Sha256{}
+	for _, numAddresses := range []int{1, 2, 3, 5, 8} {
+		checkpoint, err := epochStart.NewRewardsCheckpoint(1, createRewardsTxs(numAddresses), hasher)
+		require.Nil(t, err)
+
+		for _, leaf := range checkpoint.Leaves {
+			proof, errProof := checkpoint.CreateProof(hasher, leaf.Address)
+			require.Nil(t, errProof)
+			assert.True(t, epochStart.VerifyRewardsProof(hasher, checkpoint.RootHash, proof), "%d addresses", numAddresses)
+		}
+	}
+}
+
+func TestVerifyRewardsProof_TamperedProofShouldNotVerify(t *testing.T) {
+	t.Parallel()
+
+	hasher := sha256.Sha256{}
+	checkpoint, _ := epochStart.NewRewardsCheckpoint(1, createRewardsTxs(5), hasher)
+	proof, _ := checkpoint.CreateProof(hasher, []byte("address2"))
+
+	proof.Value = big.NewInt(1000)
+	assert.False(t, epochStart.VerifyRewardsProof(hasher, checkpoint.RootHash, proof))
+
+	proof, _ = checkpoint.CreateProof(hasher, []byte("address2"))
+	proof.Address = []byte("address3")
+	assert.False(t, epochStart.VerifyRewardsProof(hasher, checkpoint.RootHash, proof))
+
+	proof, _ = checkpoint.CreateProof(hasher, []byte("address2"))
+	proof.Siblings = proof.Siblings[:len(proof.Siblings)-1]
+	assert.False(t, epochStart.VerifyRewardsProof(hasher, checkpoint.RootHash, proof))
+
+	assert.False(t, epochStart.VerifyRewardsProof(hasher, checkpoint.RootHash, nil))
+}
@@ -0,0 +1,225 @@
+package shardchain
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// maxNumValidationWorkers defines the maximum number of go routines used to validate an epoch start meta block
+const maxNumValidationWorkers = 8
+
+type validationTask func() error
+
+// epochStartMetaBlockValidator runs the independent checks of an epoch start meta block on a pool of workers, in
+// order to shrink the processing spike at the epoch boundary
+type epochStartMetaBlockValidator struct {
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+	numWorkers  int
+}
+
+func newEpochStartMetaBlockValidator(
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	numWorkers int,
+) (*epochStartMetaBlockValidator, error) {
+	if check.IfNil(marshalizer) {
+		return nil, epochStart.ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, epochStart.ErrNilHasher
+	}
+	if numWorkers < 1 {
+		return nil, epochStart.ErrInvalidNumWorkers
+	}
+
+	return &epochStartMetaBlockValidator{
+		marshalizer: marshalizer,
+		hasher:      hasher,
+		numWorkers:  numWorkers,
+	}, nil
+}
+
+// validate checks the synced peer miniblocks, the validator info they hold and the economics data of the provided
+// epoch start meta block. The previous epoch start meta block is optional, the checks depending on it being skipped
+// if it is nil
+func (v *epochStartMetaBlockValidator) validate(
+	metaHdr *block.MetaBlock,
+	prevEpochStartHdr *block.MetaBlock,
+	bodyHandler data.BodyHandler,
+) error {
+	peerMiniBlockHeaders := make([]block.MiniBlockHeader, 0)
+	for _, mbHeader := range metaHdr.MiniBlockHeaders {
+		if mbHeader.Type == block.PeerBlock {
+			peerMiniBlockHeaders = append(peerMiniBlockHeaders, mbHeader)
+		}
+	}
+
+	peerMiniBlocks := make([]*block.MiniBlock, 0)
+	body, ok := bodyHandler.(*block.Body)
+	if ok {
+		peerMiniBlocks = body.MiniBlocks
+	}
+	if len(peerMiniBlocks) != len(peerMiniBlockHeaders) {
+		return fmt.Errorf("%w: expected %d peer miniblocks, got %d",
+			epochStart.ErrPeerMiniBlockMismatch, len(peerMiniBlockHeaders), len(peerMiniBlocks))
+	}
+
+	numShards := uint32(len(metaHdr.EpochStart.LastFinalizedHeaders))
+	validatorsInfo := make([][]*state.ShardValidatorInfo, len(peerMiniBlocks))
+	tasks := make([]validationTask, 0, len(peerMiniBlocks)+1)
+	for i := range peerMiniBlocks {
+		index := i
+		tasks = append(tasks, func() error {
+			var err error
+			validatorsInfo[index], err = v.checkPeerMiniBlock(peerMiniBlockHeaders[index], peerMiniBlocks[index], numShards)
+			return err
+		})
+	}
+	tasks = append(tasks, func() error {
+		return checkEconomics(metaHdr, prevEpochStartHdr)
+	})
+
+	err := v.runTasks(tasks)
+	if err != nil {
+		return err
+	}
+
+	return checkDuplicatedValidatorsInfo(validatorsInfo)
+}
+
+func (v *epochStartMetaBlockValidator) runTasks(tasks []validationTask) error {
+	var firstErr error
+	mutErr := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	wg.Add(len(tasks))
+
+	chTasks := make(chan validationTask, len(tasks))
+	for _, task := range tasks {
+		chTasks <- task
+	}
+	close(chTasks)
+
+	numWorkers := core.MinInt(v.numWorkers, len(tasks))
+	for i := 0; i < numWorkers; i++ {
+		go func() {
+			for task := range chTasks {
+				err := task()
+				if err != nil {
+					mutErr.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mutErr.Unlock()
+				}
+				wg.Done()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return firstErr
+}
+
+func (v *epochStartMetaBlockValidator) checkPeerMiniBlock(
+	mbHeader block.MiniBlockHeader,
+	miniBlock *block.MiniBlock,
+	numShards uint32,
+) ([]*state.ShardValidatorInfo, error) {
+	if miniBlock == nil {
+		return nil, fmt.Errorf("%w: nil miniblock for hash %x", epochStart.ErrPeerMiniBlockMismatch, mbHeader.Hash)
+	}
+
+	mbHash, err := core.CalculateHash(v.marshalizer, v.hasher, miniBlock)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(mbHash, mbHeader.Hash) {
+		return nil, fmt.Errorf("%w: expected hash %x, computed %x", epochStart.ErrPeerMiniBlockMismatch, mbHeader.Hash, mbHash)
+	}
+	if miniBlock.Type != block.PeerBlock || uint32(len(miniBlock.TxHashes)) != mbHeader.TxCount {
+		return nil, fmt.Errorf("%w: hash %x", epochStart.ErrPeerMiniBlockMismatch, mbHeader.Hash)
+	}
+
+	validatorsInfo := make([]*state.ShardValidatorInfo, 0, len(miniBlock.TxHashes))
+	for _, txHash := range miniBlock.TxHashes {
+		validatorInfo := &state.ShardValidatorInfo{}
+		err = v.marshalizer.Unmarshal(validatorInfo, txHash)
+		if err != nil {
+			return nil, err
+		}
+
+		isValidShard := validatorInfo.ShardId < numShards || validatorInfo.ShardId == core.MetachainShardId
+		if len(validatorInfo.PublicKey) == 0 || !isValidShard {
+			return nil, fmt.Errorf("%w: public key %x, shard %d",
+				epochStart.ErrInvalidValidatorInfo, validatorInfo.PublicKey, validatorInfo.ShardId)
+		}
+
+		validatorsInfo = append(validatorsInfo, validatorInfo)
+	}
+
+	return validatorsInfo, nil
+}
+
+func checkDuplicatedValidatorsInfo(validatorsInfo [][]*state.ShardValidatorInfo) error {
+	pubKeys := make(map[string]struct{})
+	for _, validatorsInfoInMiniBlock := range validatorsInfo {
+		for _, validatorInfo := range validatorsInfoInMiniBlock {
+			_, found := pubKeys[string(validatorInfo.PublicKey)]
+			if found {
+				return fmt.Errorf("%w: public key %x", epochStart.ErrDuplicatedValidatorInfo, validatorInfo.PublicKey)
+			}
+			pubKeys[string(validatorInfo.PublicKey)] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func checkEconomics(metaHdr *block.MetaBlock, prevEpochStartHdr *block.MetaBlock) error {
+	economics := metaHdr.EpochStart.Economics
+	totalToDistribute := big.NewInt(0).Add(valueOrZero(metaHdr.AccumulatedFeesInEpoch), valueOrZero(economics.TotalNewlyMinted))
+	if totalToDistribute.Cmp(valueOrZero(economics.TotalToDistribute)) != 0 {
+		return fmt.Errorf("%w: computed total to distribute %s, received %s",
+			epochStart.ErrEconomicsMismatch, totalToDistribute.String(), valueOrZero(economics.TotalToDistribute).String())
+	}
+
+	if prevEpochStartHdr == nil {
+		return nil
+	}
+
+	prevEconomics := prevEpochStartHdr.EpochStart.Economics
+	totalSupply := big.NewInt(0).Add(valueOrZero(prevEconomics.TotalSupply), valueOrZero(economics.TotalNewlyMinted))
+	if totalSupply.Cmp(valueOrZero(economics.TotalSupply)) != 0 {
+		return fmt.Errorf("%w: computed total supply %s, received %s",
+			epochStart.ErrEconomicsMismatch, totalSupply.String(), valueOrZero(economics.TotalSupply).String())
+	}
+	if valueOrZero(prevEconomics.NodePrice).Cmp(valueOrZero(economics.NodePrice)) != 0 {
+		return fmt.Errorf("%w: node price", epochStart.ErrEconomicsMismatch)
+	}
+	if prevEpochStartHdr.Round != economics.PrevEpochStartRound {
+		return fmt.Errorf("%w: previous epoch start round", epochStart.ErrEconomicsMismatch)
+	}
+
+	return nil
+}
+
+func valueOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return value
+}
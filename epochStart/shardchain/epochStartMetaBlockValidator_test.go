@@ -0,0 +1,190 @@
+package shardchain
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPeerMiniBlock(t *testing.T, validatorsInfo ...*state.ShardValidatorInfo) (*block.MiniBlock, block.MiniBlockHeader) {
+	marshalizer := &mock.MarshalizerMock{}
+	miniBlock := &block.MiniBlock{
+		Type:     block.PeerBlock,
+		TxHashes: make([][]byte, 0, len(validatorsInfo)),
+	}
+	for _, validatorInfo := range validatorsInfo {
+		buff, err := marshalizer.Marshal(validatorInfo)
+		require.Nil(t, err)
+		miniBlock.TxHashes = append(miniBlock.TxHashes, buff)
+	}
+
+	mbHash, err := core.CalculateHash(marshalizer, &mock.HasherMock{}, miniBlock)
+	require.Nil(t, err)
+
+	return miniBlock, block.MiniBlockHeader{
+		Hash:    mbHash,
+		Type:    block.PeerBlock,
+		TxCount: uint32(len(miniBlock.TxHashes)),
+	}
+}
+
+func createEpochStartMetaBlockForValidation(t *testing.T, numMiniBlocks int) (*block.MetaBlock, *block.MetaBlock, *block.Body) {
+	prevEpochStartHdr := &block.MetaBlock{
+		Round: 100,
+		EpochStart: block.EpochStart{
+			Economics: block.Economics{
+				TotalSupply: big.NewInt(1000),
+				NodePrice:   big.NewInt(10),
+			},
+		},
+	}
+	metaHdr := &block.MetaBlock{
+		AccumulatedFeesInEpoch: big.NewInt(5),
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{{ShardID: 0}, {ShardID: 1}},
+			Economics: block.Economics{
+				TotalSupply:         big.NewInt(1020),
+				TotalToDistribute:   big.NewInt(25),
+				TotalNewlyMinted:    big.NewInt(20),
+				NodePrice:           big.NewInt(10),
+				PrevEpochStartRound: 100,
+			},
+		},
+	}
+
+	body := &block.Body{}
+	for i := 0; i < numMiniBlocks; i++ {
+		miniBlock, mbHeader := createPeerMiniBlock(t,
+			&state.ShardValidatorInfo{PublicKey: []byte(fmt.Sprintf("pk%d_0", i)), ShardId: 0},
+			&state.ShardValidatorInfo{PublicKey: []byte(fmt.Sprintf("pk%d_1", i)), ShardId: core.MetachainShardId},
+		)
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+		metaHdr.MiniBlockHeaders = append(metaHdr.MiniBlockHeaders, mbHeader)
+	}
+
+	return metaHdr, prevEpochStartHdr, body
+}
+
+func createEpochStartMetaBlockValidator() *epochStartMetaBlockValidator {
+	validator, _ := newEpochStartMetaBlockValidator(&mock.MarshalizerMock{}, &mock.HasherMock{}, 4)
+	return validator
+}
+
+func TestNewEpochStartMetaBlockValidator_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	validator, err := newEpochStartMetaBlockValidator(nil, &mock.HasherMock{}, 1)
+	assert.Nil(t, validator)
+	assert.Equal(t, epochStart.ErrNilMarshalizer, err)
+
+	validator, err = newEpochStartMetaBlockValidator(&mock.MarshalizerMock{}, nil, 1)
+	assert.Nil(t, validator)
+	assert.Equal(t, epochStart.ErrNilHasher, err)
+
+	validator, err = newEpochStartMetaBlockValidator(&mock.MarshalizerMock{}, &mock.HasherMock{}, 0)
+	assert.Nil(t, validator)
+	assert.Equal(t, epochStart.ErrInvalidNumWorkers, err)
+}
+
+func TestEpochStartMetaBlockValidator_ValidateShouldWork(t *testing.T) {
+	t.Parallel()
+
+	metaHdr, prevEpochStartHdr, body := createEpochStartMetaBlockForValidation(t, 10)
+	validator := createEpochStartMetaBlockValidator()
+
+	assert.Nil(t, validator.validate(metaHdr, prevEpochStartHdr, body))
+	assert.Nil(t, validator.validate(metaHdr, nil, body))
+}
+
+func TestEpochStartMetaBlockValidator_ValidateNoPeerMiniBlocksShouldWork(t *testing.T) {
+	t.Parallel()
+
+	metaHdr, prevEpochStartHdr, _ := createEpochStartMetaBlockForValidation(t, 0)
+	validator := createEpochStartMetaBlockValidator()
+
+	assert.Nil(t, validator.validate(metaHdr, prevEpochStartHdr, nil))
+}
+
+func TestEpochStartMetaBlockValidator_ValidateMissingMiniBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	metaHdr, prevEpochStartHdr, body := createEpochStartMetaBlockForValidation(t, 3)
+	body.MiniBlocks = body.MiniBlocks[:2]
+	validator := createEpochStartMetaBlockValidator()
+
+	err := validator.validate(metaHdr, prevEpochStartHdr, body)
+	assert.True(t, errors.Is(err, epochStart.ErrPeerMiniBlockMismatch))
+}
+
+func TestEpochStartMetaBlockValidator_ValidateAlteredMiniBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	metaHdr, prevEpochStartHdr, body := createEpochStartMetaBlockForValidation(t, 3)
+	body.MiniBlocks[1].TxHashes = body.MiniBlocks[1].TxHashes[:1]
+	validator := createEpochStartMetaBlockValidator()
+
+	err := validator.validate(metaHdr, prevEpochStartHdr, body)
+	assert.True(t, errors.Is(err, epochStart.ErrPeerMiniBlockMismatch))
+}
+
+func TestEpochStartMetaBlockValidator_ValidateInvalidValidatorInfoShouldErr(t *testing.T) {
+	t.Parallel()
+
+	metaHdr, prevEpochStartHdr, body := createEpochStartMetaBlockForValidation(t, 2)
+	miniBlock, mbHeader := createPeerMiniBlock(t, &state.ShardValidatorInfo{PublicKey: []byte("pk"), ShardId: 5})
+	body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+	metaHdr.MiniBlockHeaders = append(metaHdr.MiniBlockHeaders, mbHeader)
+	validator := createEpochStartMetaBlockValidator()
+
+	err := validator.validate(metaHdr, prevEpochStartHdr, body)
+	assert.True(t, errors.Is(err, epochStart.ErrInvalidValidatorInfo))
+}
+
+func TestEpochStartMetaBlockValidator_ValidateDuplicatedValidatorInfoShouldErr(t *testing.T) {
+	t.Parallel()
+
+	metaHdr, prevEpochStartHdr, body := createEpochStartMetaBlockForValidation(t, 2)
+	miniBlock, mbHeader := createPeerMiniBlock(t, &state.ShardValidatorInfo{PublicKey: []byte("pk1_0"), ShardId: 1})
+	body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+	metaHdr.MiniBlockHeaders = append(metaHdr.MiniBlockHeaders, mbHeader)
+	validator := createEpochStartMetaBlockValidator()
+
+	err := validator.validate(metaHdr, prevEpochStartHdr, body)
+	assert.True(t, errors.Is(err, epochStart.ErrDuplicatedValidatorInfo))
+}
+
+func TestEpochStartMetaBlockValidator_ValidateEconomicsMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	validator := createEpochStartMetaBlockValidator()
+
+	metaHdr, prevEpochStartHdr, body := createEpochStartMetaBlockForValidation(t, 2)
+	metaHdr.EpochStart.Economics.TotalToDistribute = big.NewInt(26)
+	err := validator.validate(metaHdr, prevEpochStartHdr, body)
+	assert.True(t, errors.Is(err, epochStart.ErrEconomicsMismatch))
+
+	metaHdr, prevEpochStartHdr, body = createEpochStartMetaBlockForValidation(t, 2)
+	metaHdr.EpochStart.Economics.TotalSupply = big.NewInt(1021)
+	err = validator.validate(metaHdr, prevEpochStartHdr, body)
+	assert.True(t, errors.Is(err, epochStart.ErrEconomicsMismatch))
+	assert.Nil(t, validator.validate(metaHdr, nil, body))
+
+	metaHdr, prevEpochStartHdr, body = createEpochStartMetaBlockForValidation(t, 2)
+	metaHdr.EpochStart.Economics.NodePrice = big.NewInt(11)
+	err = validator.validate(metaHdr, prevEpochStartHdr, body)
+	assert.True(t, errors.Is(err, epochStart.ErrEconomicsMismatch))
+
+	metaHdr, prevEpochStartHdr, body = createEpochStartMetaBlockForValidation(t, 2)
+	metaHdr.EpochStart.Economics.PrevEpochStartRound = 99
+	err = validator.validate(metaHdr, prevEpochStartHdr, body)
+	assert.True(t, errors.Is(err, epochStart.ErrEconomicsMismatch))
+}
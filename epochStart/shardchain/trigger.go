@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"runtime"
 	"sort"
 	"sync"
 	"time"
@@ -99,6 +100,7 @@ type trigger struct {
 	requestedFinalityAttestingBlock atomic.Flag
 
 	peerMiniBlocksSyncer process.ValidatorInfoSyncer
+	metaBlockValidator   *epochStartMetaBlockValidator
 
 	appStatusHandler core.AppStatusHandler
 
@@ -193,6 +195,15 @@ func NewEpochStartTrigger(args *ArgsShardEpochStartTrigger) (*trigger, error) {
 		return nil, epochStart.ErrNilShardHeaderStorage
 	}
 
+	metaBlockValidator, err := newEpochStartMetaBlockValidator(
+		args.Marshalizer,
+		args.Hasher,
+		core.MinInt(runtime.NumCPU(), maxNumValidationWorkers),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	trigggerStateKey := core.TriggerRegistryInitialKeyPrefix + fmt.Sprintf("%d", args.Epoch)
 
 	t := &trigger{
@@ -227,13 +238,14 @@ func NewEpochStartTrigger(args *ArgsShardEpochStartTrigger) (*trigger, error) {
 		epochStartMeta:              &block.MetaBlock{},
 		epochStartShardHeader:       &block.Header{},
 		peerMiniBlocksSyncer:        args.PeerMiniBlocksSyncer,
+		metaBlockValidator:          metaBlockValidator,
 		appStatusHandler:            &statusHandler.NilStatusHandler{},
 		rounder:                     args.Rounder,
 	}
 
 	t.headersPool.RegisterHandler(t.receivedMetaBlock)
 
-	err := t.saveState(t.triggerStateKey)
+	err = t.saveState(t.triggerStateKey)
 	if err != nil {
 		return nil, err
 	}
@@ -657,12 +669,40 @@ func (t *trigger) checkIfTriggerCanBeActivated(hash string, metaHdr *block.MetaB
 		return false, 0
 	}
 
+	prevEpochStartHdr := t.getPrevEpochStartHeader(metaHdr)
+	err = t.metaBlockValidator.validate(metaHdr, prevEpochStartHdr, blockBody)
+	if err != nil {
+		log.Warn("epoch start meta block validation failed", "hash", []byte(hash), "error", err)
+		return false, 0
+	}
+
 	t.epochStartNotifier.NotifyAllPrepare(metaHdr, blockBody)
 
 	isMetaHdrFinal, finalityAttestingRound := t.isMetaBlockFinal(hash, metaHdr)
 	return isMetaHdrFinal, finalityAttestingRound
 }
 
+// call only if mutex is locked before
+func (t *trigger) getPrevEpochStartHeader(metaHdr *block.MetaBlock) *block.MetaBlock {
+	prevEpochStartHash := metaHdr.EpochStart.Economics.PrevEpochStartHash
+	if len(prevEpochStartHash) == 0 {
+		return nil
+	}
+
+	prevEpochStartHdr, found := t.mapHashHdr[string(prevEpochStartHash)]
+	if !found {
+		prevEpochStartHdr = t.getHeaderWithHashFromPool(prevEpochStartHash)
+	}
+	if prevEpochStartHdr == nil {
+		prevEpochStartHdr = t.getHeaderWithHashFromStorage(prevEpochStartHash)
+	}
+	if prevEpochStartHdr == nil || !prevEpochStartHdr.IsStartOfEpochBlock() {
+		return nil
+	}
+
+	return prevEpochStartHdr
+}
+
 func (t *trigger) addMissingMiniblocks(epoch uint32, missingMiniblocksHashes [][]byte) {
 	t.mutMissingMiniblocks.Lock()
 	defer t.mutMissingMiniblocks.Unlock()
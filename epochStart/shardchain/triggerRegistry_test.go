@@ -45,6 +45,7 @@ func cloneTrigger(t *trigger) *trigger {
 	rt.mapMissingMiniblocks = t.mapMissingMiniblocks
 	rt.mapFinalizedEpochs = t.mapFinalizedEpochs
 	rt.rounder = t.rounder
+	rt.metaBlockValidator = t.metaBlockValidator
 	return rt
 }
 
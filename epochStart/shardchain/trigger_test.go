@@ -332,12 +332,13 @@ func TestTrigger_ReceivedHeaderIsEpochStartTrueWithPeerMiniblocks(t *testing.T)
 		TxHashes:        [][]byte{},
 		ReceiverShardID: core.AllShardId,
 		SenderShardID:   core.MetachainShardId,
+		Type:            block.PeerBlock,
 	}
 
-	peerMiniBlockHash, _ := args.Marshalizer.Marshal(peerMiniblock)
+	peerMiniBlockHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, peerMiniblock)
 
 	miniBlockHeader := block.MiniBlockHeader{
-		Hash: peerMiniBlockHash, Type: block.PeerBlock, SenderShardID: core.MetachainShardId, ReceiverShardID: core.AllShardId, TxCount: 1}
+		Hash: peerMiniBlockHash, Type: block.PeerBlock, SenderShardID: core.MetachainShardId, ReceiverShardID: core.AllShardId, TxCount: 0}
 
 	previousHeader99 := &block.MetaBlock{Nonce: 99, Epoch: 0}
 	previousHeaderHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, previousHeader99)
@@ -409,6 +410,11 @@ func TestTrigger_ReceivedHeaderIsEpochStartTrueWithPeerMiniblocks(t *testing.T)
 		},
 	}
 
+	args.PeerMiniBlocksSyncer = &mock.ValidatorInfoSyncerStub{
+		SyncMiniBlocksCalled: func(metaBlock *block.MetaBlock) ([][]byte, data.BodyHandler, error) {
+			return nil, &block.Body{MiniBlocks: []*block.MiniBlock{peerMiniblock}}, nil
+		},
+	}
 	args.Validity = 1
 	args.Finality = 2
 
@@ -0,0 +1,16 @@
+package epochStart
+
+// SupplyAccounting holds the record of the tokens minted through inflation, the fees burned and the treasury inflows
+// of an epoch, together with the cumulative values of all the recorded epochs. The treasury inflows are the rewards
+// sent to the protocol sustainability addresses. The burned fees are the part of the accumulated fees and of the newly
+// minted tokens that was not distributed as rewards or developer fees
+type SupplyAccounting struct {
+	Epoch                     uint32 `json:"epoch"`
+	TotalSupply               string `json:"totalSupply"`
+	InflationMinted           string `json:"inflationMinted"`
+	BurnedFees                string `json:"burnedFees"`
+	TreasuryInflows           string `json:"treasuryInflows"`
+	CumulativeInflationMinted string `json:"cumulativeInflationMinted"`
+	CumulativeBurnedFees      string `json:"cumulativeBurnedFees"`
+	CumulativeTreasuryInflows string `json:"cumulativeTreasuryInflows"`
+}
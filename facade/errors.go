@@ -25,3 +25,15 @@ var ErrNilAccountState = errors.New("nil account state")
 
 // ErrNilTransactionSimulatorProcessor signals that a nil transaction simulator processor has been provided
 var ErrNilTransactionSimulatorProcessor = errors.New("nil transaction simulator processor")
+
+// ErrNilPushNotifier signals that a nil push notifier has been provided
+var ErrNilPushNotifier = errors.New("nil push notifier")
+
+// ErrNilCrossShardStatistics signals that a nil cross shard statistics handler has been provided
+var ErrNilCrossShardStatistics = errors.New("nil cross shard statistics handler")
+
+// ErrNilConfigReloader signals that a nil config reloader has been provided
+var ErrNilConfigReloader = errors.New("nil config reloader")
+
+// ErrNilOutportStatusHandler signals that a nil outport status handler has been provided
+var ErrNilOutportStatusHandler = errors.New("nil outport status handler")
@@ -0,0 +1,234 @@
+package facade
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+const storageProbeFilePattern = ".health-probe-*"
+
+var healthStatusSeverity = map[external.HealthStatus]int{
+	external.HealthStatusOk:       0,
+	external.HealthStatusDegraded: 1,
+	external.HealthStatusFailing:  2,
+}
+
+// GetHealth returns the liveness report of the node, evaluating the subsystems without which the node can not work
+func (nf *nodeFacade) GetHealth() *external.HealthReport {
+	metrics := nf.apiResolver.StatusMetrics().StatusMetricsMapWithoutP2P()
+
+	return createHealthReport(
+		nf.checkStorageHealth(),
+		nf.checkP2PHealth(metrics),
+	)
+}
+
+// GetReadiness returns the readiness report of the node, evaluating all the subsystems needed for serving requests
+// and for participating in consensus
+func (nf *nodeFacade) GetReadiness() *external.HealthReport {
+	metrics := nf.apiResolver.StatusMetrics().StatusMetricsMapWithoutP2P()
+
+	return createHealthReport(
+		nf.checkStorageHealth(),
+		nf.checkP2PHealth(metrics),
+		nf.checkSyncHealth(metrics),
+		nf.checkTrieSnapshotHealth(),
+		nf.checkConsensusHealth(metrics),
+		nf.checkOutportHealth(),
+	)
+}
+
+func createHealthReport(checks ...*external.HealthCheck) *external.HealthReport {
+	report := &external.HealthReport{
+		Status: external.HealthStatusOk,
+		Checks: checks,
+	}
+	for _, check := range checks {
+		if healthStatusSeverity[check.Status] > healthStatusSeverity[report.Status] {
+			report.Status = check.Status
+		}
+	}
+
+	return report
+}
+
+func (nf *nodeFacade) checkStorageHealth() *external.HealthCheck {
+	healthCheck := &external.HealthCheck{
+		Subsystem: external.HealthSubsystemStorage,
+		Status:    external.HealthStatusOk,
+	}
+
+	err := writeProbeFile(nf.config.WorkingDir)
+	if err != nil {
+		healthCheck.Status = external.HealthStatusFailing
+		healthCheck.Reason = external.HealthReasonStorageNotWritable
+		healthCheck.Message = err.Error()
+	}
+
+	return healthCheck
+}
+
+func writeProbeFile(directory string) error {
+	file, err := ioutil.TempFile(directory, storageProbeFilePattern)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(file.Name())
+	}()
+
+	_, err = file.Write([]byte{0})
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func (nf *nodeFacade) checkP2PHealth(metrics map[string]interface{}) *external.HealthCheck {
+	numConnectedPeers := getUint64Metric(metrics, core.MetricNumConnectedPeers)
+	healthCheck := &external.HealthCheck{
+		Subsystem: external.HealthSubsystemP2P,
+		Status:    external.HealthStatusOk,
+		Message:   fmt.Sprintf("%d connected peers", numConnectedPeers),
+	}
+
+	switch {
+	case numConnectedPeers == 0:
+		healthCheck.Status = external.HealthStatusFailing
+		healthCheck.Reason = external.HealthReasonNoConnectedPeers
+	case numConnectedPeers < uint64(nf.apiRoutesConfig.HealthProbes.MinConnectedPeers):
+		healthCheck.Status = external.HealthStatusDegraded
+		healthCheck.Reason = external.HealthReasonFewConnectedPeers
+	}
+
+	return healthCheck
+}
+
+func (nf *nodeFacade) checkSyncHealth(metrics map[string]interface{}) *external.HealthCheck {
+	nonce := getUint64Metric(metrics, core.MetricNonce)
+	probableHighestNonce := getUint64Metric(metrics, core.MetricProbableHighestNonce)
+	syncDistance := uint64(0)
+	if probableHighestNonce > nonce {
+		syncDistance = probableHighestNonce - nonce
+	}
+
+	healthCheck := &external.HealthCheck{
+		Subsystem: external.HealthSubsystemSync,
+		Status:    external.HealthStatusOk,
+		Message:   fmt.Sprintf("%d blocks behind the network", syncDistance),
+	}
+
+	switch {
+	case syncDistance > nf.apiRoutesConfig.HealthProbes.MaxSyncDistanceInBlocks:
+		healthCheck.Status = external.HealthStatusFailing
+		healthCheck.Reason = external.HealthReasonSyncDistanceExceeded
+	case getUint64Metric(metrics, core.MetricIsSyncing) != 0:
+		healthCheck.Status = external.HealthStatusDegraded
+		healthCheck.Reason = external.HealthReasonSyncing
+	}
+
+	return healthCheck
+}
+
+func (nf *nodeFacade) checkTrieSnapshotHealth() *external.HealthCheck {
+	healthCheck := &external.HealthCheck{
+		Subsystem: external.HealthSubsystemTrieSnapshot,
+		Status:    external.HealthStatusOk,
+	}
+
+	if nf.accountsState.IsSnapshotInProgress() || nf.peerState.IsSnapshotInProgress() {
+		healthCheck.Status = external.HealthStatusDegraded
+		healthCheck.Reason = external.HealthReasonTrieSnapshotInProgress
+	}
+
+	return healthCheck
+}
+
+func (nf *nodeFacade) checkConsensusHealth(metrics map[string]interface{}) *external.HealthCheck {
+	healthCheck := &external.HealthCheck{
+		Subsystem: external.HealthSubsystemConsensus,
+		Status:    external.HealthStatusOk,
+	}
+
+	peerType := getStringMetric(metrics, core.MetricPeerType)
+	if !strings.HasPrefix(peerType, string(core.EligibleList)) {
+		healthCheck.Reason = external.HealthReasonNotEligible
+		return healthCheck
+	}
+
+	epoch := getUint64Metric(metrics, core.MetricEpochNumber)
+	for _, participation := range nf.node.GetConsensusParticipation() {
+		if uint64(participation.Epoch) != epoch {
+			continue
+		}
+
+		healthCheck.Message = fmt.Sprintf("%d missed proposals out of %d rounds as leader in epoch %d",
+			participation.NumMissedProposals, participation.NumRoundsAsLeader, epoch)
+		if participation.NumMissedProposals > nf.apiRoutesConfig.HealthProbes.MaxMissedProposalsInEpoch {
+			healthCheck.Status = external.HealthStatusDegraded
+			healthCheck.Reason = external.HealthReasonMissedProposals
+		}
+
+		return healthCheck
+	}
+
+	healthCheck.Reason = external.HealthReasonConsensusDataNotAvailable
+
+	return healthCheck
+}
+
+func (nf *nodeFacade) checkOutportHealth() *external.HealthCheck {
+	healthCheck := &external.HealthCheck{
+		Subsystem: external.HealthSubsystemOutport,
+		Status:    external.HealthStatusOk,
+	}
+
+	unhealthyDrivers := make([]string, 0)
+	for _, status := range nf.outportStatus.DriversStatuses() {
+		switch status.State {
+		case indexer.DriverStateBuffering:
+			healthCheck.Reason = external.HealthReasonOutportDriverBuffering
+		case indexer.DriverStateLagging:
+			if healthCheck.Reason != external.HealthReasonOutportDriverBuffering {
+				healthCheck.Reason = external.HealthReasonOutportDriverLagging
+			}
+		default:
+			continue
+		}
+
+		unhealthyDrivers = append(unhealthyDrivers, fmt.Sprintf("%s is %s", status.Name, status.State))
+	}
+
+	if len(unhealthyDrivers) > 0 {
+		healthCheck.Status = external.HealthStatusDegraded
+		healthCheck.Message = strings.Join(unhealthyDrivers, ", ")
+	}
+
+	return healthCheck
+}
+
+func getUint64Metric(metrics map[string]interface{}, key string) uint64 {
+	value, ok := metrics[key].(uint64)
+	if !ok {
+		return 0
+	}
+
+	return value
+}
+
+func getStringMetric(metrics map[string]interface{}, key string) string {
+	value, ok := metrics[key].(string)
+	if !ok {
+		return ""
+	}
+
+	return value
+}
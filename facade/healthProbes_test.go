@@ -0,0 +1,182 @@
+package facade
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/facade/mock"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createHealthyMetrics() map[string]interface{} {
+	return map[string]interface{}{
+		core.MetricNumConnectedPeers:    uint64(20),
+		core.MetricNonce:                uint64(100),
+		core.MetricProbableHighestNonce: uint64(101),
+		core.MetricIsSyncing:            uint64(0),
+		core.MetricPeerType:             string(core.EligibleList),
+		core.MetricEpochNumber:          uint64(2),
+	}
+}
+
+func createFacadeForHealthProbes(t *testing.T, metrics map[string]interface{}, participation []*consensus.EpochParticipation) *nodeFacade {
+	arg := createMockArguments()
+	arg.FacadeConfig.WorkingDir = os.TempDir()
+	arg.ApiRoutesConfig.HealthProbes = config.HealthProbesConfig{
+		MinConnectedPeers:         10,
+		MaxSyncDistanceInBlocks:   5,
+		MaxMissedProposalsInEpoch: 3,
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		StatusMetricsHandler: func() external.StatusMetricsHandler {
+			return &mock.StatusMetricsStub{
+				StatusMetricsMapWithoutP2PCalled: func() map[string]interface{} {
+					return metrics
+				},
+			}
+		},
+	}
+	arg.Node = &mock.NodeStub{
+		GetConsensusParticipationCalled: func() []*consensus.EpochParticipation {
+			return participation
+		},
+	}
+
+	nf, err := NewNodeFacade(arg)
+	require.Nil(t, err)
+
+	return nf
+}
+
+func getHealthCheck(report *external.HealthReport, subsystem string) *external.HealthCheck {
+	for _, check := range report.Checks {
+		if check.Subsystem == subsystem {
+			return check
+		}
+	}
+
+	return nil
+}
+
+func TestNodeFacade_GetReadinessAllSubsystemsOk(t *testing.T) {
+	t.Parallel()
+
+	participation := []*consensus.EpochParticipation{
+		{Epoch: 1, NumMissedProposals: 10},
+		{Epoch: 2, NumMissedProposals: 1, NumRoundsAsLeader: 5},
+	}
+	nf := createFacadeForHealthProbes(t, createHealthyMetrics(), participation)
+
+	report := nf.GetReadiness()
+	assert.Equal(t, external.HealthStatusOk, report.Status)
+	require.Equal(t, 6, len(report.Checks))
+	for _, check := range report.Checks {
+		assert.Equal(t, external.HealthStatusOk, check.Status, check.Subsystem)
+		assert.Empty(t, check.Reason, check.Subsystem)
+	}
+
+	report = nf.GetHealth()
+	assert.Equal(t, external.HealthStatusOk, report.Status)
+	assert.Equal(t, 2, len(report.Checks))
+}
+
+func TestNodeFacade_GetReadinessDegradedSubsystems(t *testing.T) {
+	t.Parallel()
+
+	metrics := createHealthyMetrics()
+	metrics[core.MetricNumConnectedPeers] = uint64(3)
+	metrics[core.MetricIsSyncing] = uint64(1)
+	participation := []*consensus.EpochParticipation{
+		{Epoch: 2, NumMissedProposals: 4},
+	}
+	nf := createFacadeForHealthProbes(t, metrics, participation)
+	nf.peerState = &mock.AccountsStub{
+		IsSnapshotInProgressCalled: func() bool {
+			return true
+		},
+	}
+
+	report := nf.GetReadiness()
+	assert.Equal(t, external.HealthStatusDegraded, report.Status)
+	assert.Equal(t, external.HealthReasonFewConnectedPeers, getHealthCheck(report, external.HealthSubsystemP2P).Reason)
+	assert.Equal(t, external.HealthReasonSyncing, getHealthCheck(report, external.HealthSubsystemSync).Reason)
+	assert.Equal(t, external.HealthReasonTrieSnapshotInProgress, getHealthCheck(report, external.HealthSubsystemTrieSnapshot).Reason)
+	assert.Equal(t, external.HealthReasonMissedProposals, getHealthCheck(report, external.HealthSubsystemConsensus).Reason)
+	assert.Equal(t, external.HealthStatusOk, getHealthCheck(report, external.HealthSubsystemStorage).Status)
+}
+
+func TestNodeFacade_GetReadinessUnhealthyOutportDriversShouldDegrade(t *testing.T) {
+	t.Parallel()
+
+	statuses := []indexer.DriverStatus{
+		{Name: "kafka", State: indexer.DriverStateLagging},
+		{Name: "postgresql", State: indexer.DriverStateConnected},
+	}
+	nf := createFacadeForHealthProbes(t, createHealthyMetrics(), nil)
+	nf.outportStatus = &mock.OutportStatusHandlerStub{
+		DriversStatusesCalled: func() []indexer.DriverStatus {
+			return statuses
+		},
+	}
+
+	report := nf.GetReadiness()
+	assert.Equal(t, external.HealthStatusDegraded, report.Status)
+	outportCheck := getHealthCheck(report, external.HealthSubsystemOutport)
+	assert.Equal(t, external.HealthReasonOutportDriverLagging, outportCheck.Reason)
+	assert.Equal(t, "kafka is lagging", outportCheck.Message)
+
+	statuses = append(statuses, indexer.DriverStatus{Name: "elasticsearch", State: indexer.DriverStateBuffering})
+	report = nf.GetReadiness()
+	assert.Equal(t, external.HealthStatusDegraded, report.Status)
+	outportCheck = getHealthCheck(report, external.HealthSubsystemOutport)
+	assert.Equal(t, external.HealthReasonOutportDriverBuffering, outportCheck.Reason)
+	assert.Equal(t, "kafka is lagging, elasticsearch is buffering", outportCheck.Message)
+
+	report = nf.GetHealth()
+	assert.Nil(t, getHealthCheck(report, external.HealthSubsystemOutport))
+}
+
+func TestNodeFacade_GetReadinessFailingSubsystems(t *testing.T) {
+	t.Parallel()
+
+	metrics := createHealthyMetrics()
+	metrics[core.MetricProbableHighestNonce] = uint64(200)
+	metrics[core.MetricPeerType] = string(core.ObserverList)
+	nf := createFacadeForHealthProbes(t, metrics, nil)
+
+	report := nf.GetReadiness()
+	assert.Equal(t, external.HealthStatusFailing, report.Status)
+	syncCheck := getHealthCheck(report, external.HealthSubsystemSync)
+	assert.Equal(t, external.HealthStatusFailing, syncCheck.Status)
+	assert.Equal(t, external.HealthReasonSyncDistanceExceeded, syncCheck.Reason)
+	consensusCheck := getHealthCheck(report, external.HealthSubsystemConsensus)
+	assert.Equal(t, external.HealthStatusOk, consensusCheck.Status)
+	assert.Equal(t, external.HealthReasonNotEligible, consensusCheck.Reason)
+
+	report = nf.GetHealth()
+	assert.Equal(t, external.HealthStatusOk, report.Status)
+}
+
+func TestNodeFacade_GetHealthFailing(t *testing.T) {
+	t.Parallel()
+
+	metrics := createHealthyMetrics()
+	metrics[core.MetricNumConnectedPeers] = uint64(0)
+	nf := createFacadeForHealthProbes(t, metrics, nil)
+	nf.config.WorkingDir = "/missing/directory"
+
+	report := nf.GetHealth()
+	assert.Equal(t, external.HealthStatusFailing, report.Status)
+	storageCheck := getHealthCheck(report, external.HealthSubsystemStorage)
+	assert.Equal(t, external.HealthReasonStorageNotWritable, storageCheck.Reason)
+	assert.NotEmpty(t, storageCheck.Message)
+	p2pCheck := getHealthCheck(report, external.HealthSubsystemP2P)
+	assert.Equal(t, external.HealthStatusFailing, p2pCheck.Status)
+	assert.Equal(t, external.HealthReasonNoConnectedPeers, p2pCheck.Reason)
+}
@@ -0,0 +1,121 @@
+package facade
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+)
+
+// GetHeartbeatsPage returns a page of the heartbeat status entries matching the query filters. The entries keep the
+// order of the heartbeat monitor, which sorts them by public key and peer ID, so the pages are consistent between calls
+func (nf *nodeFacade) GetHeartbeatsPage(query data.HeartbeatsQuery) (*data.HeartbeatsPage, error) {
+	if query.Size == 0 {
+		return nil, fmt.Errorf("%w for the page size: 0", ErrInvalidValue)
+	}
+
+	heartbeats, err := nf.GetHeartbeats()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]data.PubKeyHeartbeat, 0, len(heartbeats))
+	for _, heartbeat := range heartbeats {
+		if !matchesHeartbeatsQuery(heartbeat, query) {
+			continue
+		}
+
+		entries = append(entries, heartbeat)
+	}
+
+	page := &data.HeartbeatsPage{
+		Heartbeats:    make([]data.PubKeyHeartbeat, 0),
+		NumHeartbeats: uint32(len(entries)),
+	}
+	if query.From >= uint32(len(entries)) {
+		return page, nil
+	}
+
+	end := query.From + query.Size
+	if end >= uint32(len(entries)) {
+		end = uint32(len(entries))
+	} else {
+		page.NextFrom = end
+	}
+	page.Heartbeats = entries[query.From:end]
+
+	return page, nil
+}
+
+func matchesHeartbeatsQuery(heartbeat data.PubKeyHeartbeat, query data.HeartbeatsQuery) bool {
+	if query.ShardID != nil && heartbeat.ComputedShardID != *query.ShardID {
+		return false
+	}
+	if len(query.Identity) > 0 && !strings.EqualFold(heartbeat.Identity, query.Identity) {
+		return false
+	}
+	if query.IsActive != nil && heartbeat.IsActive != *query.IsActive {
+		return false
+	}
+	if len(query.PeerType) > 0 && !strings.EqualFold(heartbeat.PeerType, query.PeerType) {
+		return false
+	}
+
+	return true
+}
+
+// GetHeartbeatsPerIdentity aggregates the heartbeat status by the identity declared by the nodes, sorted by identity.
+// The identity is compared case insensitive and the nodes without an identity are grouped under the empty identity
+func (nf *nodeFacade) GetHeartbeatsPerIdentity() ([]*data.IdentityHeartbeats, error) {
+	heartbeats, err := nf.GetHeartbeats()
+	if err != nil {
+		return nil, err
+	}
+
+	identities := make(map[string]*data.IdentityHeartbeats)
+	for _, heartbeat := range heartbeats {
+		key := strings.ToLower(heartbeat.Identity)
+		identity, ok := identities[key]
+		if !ok {
+			identity = &data.IdentityHeartbeats{
+				Identity:         heartbeat.Identity,
+				NumNodesPerShard: make(map[uint32]uint32),
+			}
+			identities[key] = identity
+		}
+
+		identity.NumNodes++
+		identity.NumNodesPerShard[heartbeat.ComputedShardID]++
+		if heartbeat.IsActive {
+			identity.NumActive++
+		} else {
+			identity.NumInactive++
+		}
+		if heartbeat.PeerType != string(core.ObserverList) {
+			identity.NumValidators++
+		}
+	}
+
+	result := make([]*data.IdentityHeartbeats, 0, len(identities))
+	for _, identity := range identities {
+		result = append(result, identity)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return strings.ToLower(result[i].Identity) < strings.ToLower(result[j].Identity)
+	})
+
+	return result, nil
+}
+
+// GetManagedKeysPerNode returns the validator keys managed by each of the nodes running in multikey mode, along with
+// the liveness of each key, so the keys can be attributed to the physical nodes serving them
+func (nf *nodeFacade) GetManagedKeysPerNode() ([]data.NodeManagedKeys, error) {
+	nodes := nf.node.GetManagedKeysPerNode()
+	if nodes == nil {
+		return nil, ErrHeartbeatsNotActive
+	}
+
+	return nodes, nil
+}
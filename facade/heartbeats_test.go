@@ -0,0 +1,171 @@
+package facade
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/facade/mock"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createFacadeWithHeartbeats() *nodeFacade {
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetHeartbeatsHandler: func() []data.PubKeyHeartbeat {
+			return []data.PubKeyHeartbeat{
+				{PublicKey: "aa", ComputedShardID: 0, Identity: "provider", IsActive: true, PeerType: "eligible"},
+				{PublicKey: "bb", ComputedShardID: 1, Identity: "Provider", IsActive: false, PeerType: "waiting"},
+				{PublicKey: "cc", ComputedShardID: 0, Identity: "solo", IsActive: true, PeerType: "eligible"},
+				{PublicKey: "dd", ComputedShardID: 1, Identity: "", IsActive: true, PeerType: "observer"},
+				{PublicKey: "ee", ComputedShardID: 0, Identity: "provider", IsActive: true, PeerType: "observer"},
+			}
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	return nf
+}
+
+func heartbeatsPublicKeysOf(page *data.HeartbeatsPage) []string {
+	publicKeys := make([]string, 0, len(page.Heartbeats))
+	for _, heartbeat := range page.Heartbeats {
+		publicKeys = append(publicKeys, heartbeat.PublicKey)
+	}
+
+	return publicKeys
+}
+
+func TestNodeFacade_GetHeartbeatsPageInvalidSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nf := createFacadeWithHeartbeats()
+
+	page, err := nf.GetHeartbeatsPage(data.HeartbeatsQuery{})
+	assert.Nil(t, page)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
+func TestNodeFacade_GetHeartbeatsPageNotActiveShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetHeartbeatsHandler: func() []data.PubKeyHeartbeat {
+			return nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	page, err := nf.GetHeartbeatsPage(data.HeartbeatsQuery{Size: 10})
+	assert.Nil(t, page)
+	assert.Equal(t, ErrHeartbeatsNotActive, err)
+
+	identities, err := nf.GetHeartbeatsPerIdentity()
+	assert.Nil(t, identities)
+	assert.Equal(t, ErrHeartbeatsNotActive, err)
+}
+
+func TestNodeFacade_GetManagedKeysPerNode(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetManagedKeysPerNodeCalled: func() []data.NodeManagedKeys {
+			return nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	nodes, err := nf.GetManagedKeysPerNode()
+	assert.Nil(t, nodes)
+	assert.Equal(t, ErrHeartbeatsNotActive, err)
+
+	expectedNodes := []data.NodeManagedKeys{{Pid: "pid", NumManagedKeys: 1}}
+	arg.Node = &mock.NodeStub{
+		GetManagedKeysPerNodeCalled: func() []data.NodeManagedKeys {
+			return expectedNodes
+		},
+	}
+	nf, _ = NewNodeFacade(arg)
+
+	nodes, err = nf.GetManagedKeysPerNode()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedNodes, nodes)
+}
+
+func TestNodeFacade_GetHeartbeatsPageShouldFilter(t *testing.T) {
+	t.Parallel()
+
+	nf := createFacadeWithHeartbeats()
+	shardID := uint32(0)
+	isActive := true
+
+	page, err := nf.GetHeartbeatsPage(data.HeartbeatsQuery{ShardID: &shardID, Size: 10})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"aa", "cc", "ee"}, heartbeatsPublicKeysOf(page))
+
+	page, _ = nf.GetHeartbeatsPage(data.HeartbeatsQuery{Identity: "PROVIDER", Size: 10})
+	assert.Equal(t, []string{"aa", "bb", "ee"}, heartbeatsPublicKeysOf(page))
+
+	page, _ = nf.GetHeartbeatsPage(data.HeartbeatsQuery{Identity: "provider", IsActive: &isActive, Size: 10})
+	assert.Equal(t, []string{"aa", "ee"}, heartbeatsPublicKeysOf(page))
+
+	page, _ = nf.GetHeartbeatsPage(data.HeartbeatsQuery{PeerType: "observer", Size: 10})
+	assert.Equal(t, []string{"dd", "ee"}, heartbeatsPublicKeysOf(page))
+	assert.Equal(t, uint32(2), page.NumHeartbeats)
+	assert.Equal(t, uint32(0), page.NextFrom)
+}
+
+func TestNodeFacade_GetHeartbeatsPageShouldPaginate(t *testing.T) {
+	t.Parallel()
+
+	nf := createFacadeWithHeartbeats()
+
+	page, err := nf.GetHeartbeatsPage(data.HeartbeatsQuery{From: 1, Size: 2})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"bb", "cc"}, heartbeatsPublicKeysOf(page))
+	assert.Equal(t, uint32(5), page.NumHeartbeats)
+	assert.Equal(t, uint32(3), page.NextFrom)
+
+	page, _ = nf.GetHeartbeatsPage(data.HeartbeatsQuery{From: 3, Size: 2})
+	assert.Equal(t, []string{"dd", "ee"}, heartbeatsPublicKeysOf(page))
+	assert.Equal(t, uint32(0), page.NextFrom)
+
+	page, _ = nf.GetHeartbeatsPage(data.HeartbeatsQuery{From: 5, Size: 2})
+	assert.Equal(t, 0, len(page.Heartbeats))
+	assert.Equal(t, uint32(5), page.NumHeartbeats)
+}
+
+func TestNodeFacade_GetHeartbeatsPerIdentityShouldAggregate(t *testing.T) {
+	t.Parallel()
+
+	nf := createFacadeWithHeartbeats()
+
+	identities, err := nf.GetHeartbeatsPerIdentity()
+	require.Nil(t, err)
+	require.Equal(t, 3, len(identities))
+
+	assert.Equal(t, &data.IdentityHeartbeats{
+		Identity:         "",
+		NumNodes:         1,
+		NumActive:        1,
+		NumNodesPerShard: map[uint32]uint32{1: 1},
+	}, identities[0])
+	assert.Equal(t, &data.IdentityHeartbeats{
+		Identity:         "provider",
+		NumNodes:         3,
+		NumActive:        2,
+		NumInactive:      1,
+		NumValidators:    2,
+		NumNodesPerShard: map[uint32]uint32{0: 2, 1: 1},
+	}, identities[1])
+	assert.Equal(t, &data.IdentityHeartbeats{
+		Identity:         "solo",
+		NumNodes:         1,
+		NumActive:        1,
+		NumValidators:    1,
+		NumNodesPerShard: map[uint32]uint32{0: 1},
+	}, identities[2])
+}
@@ -2,16 +2,23 @@ package facade
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/push"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 //NodeHandler contains all functions that a node should contain.
@@ -34,6 +41,9 @@ type NodeHandler interface {
 	// GetAllESDTTokens returns the value of a key from a given account
 	GetAllESDTTokens(address string) ([]string, error)
 
+	// GetESDTTokensPage returns a page of the esdt balances of a given account
+	GetESDTTokensPage(address string, options esdt.TokensPageOptions) (*esdt.ApiESDTTokensPage, error)
+
 	//CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
@@ -48,6 +58,15 @@ type NodeHandler interface {
 	//GetTransaction will return a transaction based on the hash
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 
+	// GetTransactionsPool returns the pending transactions of the pool matching the filter
+	GetTransactionsPool(filter transaction.PoolFilter) ([]*transaction.ApiPoolTransaction, error)
+
+	// GetTransactionsPoolSummary returns the pool summary for each sender matching the filter
+	GetTransactionsPoolSummary(filter transaction.PoolFilter) ([]*transaction.ApiPoolSenderSummary, error)
+
+	// GetLogs returns the log events matching the provided filter
+	GetLogs(filter transaction.LogsFilter) ([]*transaction.ApiLogEvent, error)
+
 	// GetAccount returns an accountResponse containing information
 	//  about the account correlated with provided address
 	GetAccount(address string) (state.UserAccountHandler, error)
@@ -58,6 +77,9 @@ type NodeHandler interface {
 	// GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 	GetHeartbeats() []data.PubKeyHeartbeat
 
+	// GetManagedKeysPerNode returns the validator keys managed by each of the nodes running in multikey mode
+	GetManagedKeysPerNode() []data.NodeManagedKeys
+
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 
@@ -71,9 +93,29 @@ type NodeHandler interface {
 
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
-
-	GetBlockByHash(hash string, withTxs bool) (*block.APIBlock, error)
-	GetBlockByNonce(nonce uint64, withTxs bool) (*block.APIBlock, error)
+	GetConsensusParticipation() []*consensus.EpochParticipation
+
+	GetConnectedPeers() []core.QueryP2PPeerInfo
+	DisconnectPeer(pid string) error
+	BlacklistPeer(pid string, duration time.Duration) error
+	TriggerStateSnapshot() (string, error)
+	CompactStorage() error
+
+	GetProof(rootHash string, address string) (*state.ApiProof, error)
+	GetProofDataTrie(rootHash string, address string, key string) (*state.ApiProof, error)
+	VerifyProof(rootHash string, key string, proof []string) (bool, string, error)
+
+	GetBlockByHash(hash string, withTxs bool, withResults bool) (*block.APIBlock, error)
+	GetBlockByNonce(nonce uint64, withTxs bool, withResults bool) (*block.APIBlock, error)
+	GetRandomnessByNonce(nonce uint64) (*block.APIRandomness, error)
+	GetRandomnessByEpoch(epoch uint32) (*block.APIRandomness, error)
+	GetRewardsAudit(epoch uint32) (*epochStart.RewardsAudit, error)
+	GetRewardsProof(epoch uint32, address string) (*epochStart.RewardsProof, error)
+	GetSupplyAccounting(epoch uint32) (*epochStart.SupplyAccounting, error)
+	GetEpochEconomics(epoch uint32) (*epochStart.EpochEconomics, error)
+	GetEpochValidatorsAssignment(epoch uint32) (*sharding.EpochValidatorsAssignment, error)
+	GetValidatorEligibilityEstimate(blsKey string) (*sharding.ValidatorEligibilityEstimate, error)
+	EstimateQueuedValidatorEligibleEpoch(queuePosition uint32) (*sharding.ValidatorEligibilityEstimate, error)
 }
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
@@ -88,6 +130,11 @@ type ApiResolver interface {
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTotalStakedValue() (*big.Int, error)
+	GetNetworkAPR() (*external.NetworkAPR, error)
+	GetOwnerRewardsProjection(owner []byte) (*external.OwnerRewardsProjection, error)
+	GetESDTTokensList(from uint32, size uint32) (*external.ESDTTokensList, error)
+	GetStakingQueue(from uint32, size uint32) (*external.StakingQueue, error)
+	GetDelegationPositions(delegator []byte) (*external.DelegatorPositions, error)
 	IsInterfaceNil() bool
 }
 
@@ -97,3 +144,22 @@ type HardforkTrigger interface {
 	IsSelfTrigger() bool
 	IsInterfaceNil() bool
 }
+
+// ConfigReloader defines the component reloading the selected configurations without restarting the node
+type ConfigReloader interface {
+	Reload() ([]string, error)
+	IsInterfaceNil() bool
+}
+
+// OutportStatusHandler defines the component reporting the health of the outport drivers
+type OutportStatusHandler interface {
+	DriversStatuses() []indexer.DriverStatus
+	IsInterfaceNil() bool
+}
+
+// PushNotifier defines the push notifier used by the websocket push API
+type PushNotifier interface {
+	Subscribe(filter push.Filter) (*push.Subscription, error)
+	Unsubscribe(subscription *push.Subscription)
+	IsInterfaceNil() bool
+}
@@ -11,25 +11,27 @@ import (
 
 // AccountsStub -
 type AccountsStub struct {
-	AddJournalEntryCalled    func(je state.JournalEntry)
-	GetExistingAccountCalled func(addressContainer []byte) (state.AccountHandler, error)
-	LoadAccountCalled        func(container []byte) (state.AccountHandler, error)
-	SaveAccountCalled        func(account state.AccountHandler) error
-	RemoveAccountCalled      func(addressContainer []byte) error
-	CommitCalled             func() ([]byte, error)
-	JournalLenCalled         func() int
-	RevertToSnapshotCalled   func(snapshot int) error
-	RootHashCalled           func() ([]byte, error)
-	RecreateTrieCalled       func(rootHash []byte) error
-	PruneTrieCalled          func(rootHash []byte, identifier data.TriePruningIdentifier)
-	CancelPruneCalled        func(rootHash []byte, identifier data.TriePruningIdentifier)
-	SnapshotStateCalled      func(rootHash []byte)
-	SetStateCheckpointCalled func(rootHash []byte)
-	IsPruningEnabledCalled   func() bool
-	GetAllLeavesCalled       func(rootHash []byte) (chan core.KeyValueHolder, error)
-	RecreateAllTriesCalled   func(rootHash []byte) (map[string]data.Trie, error)
-	GetNumCheckpointsCalled  func() uint32
-	GetCodeCalled            func([]byte) []byte
+	AddJournalEntryCalled      func(je state.JournalEntry)
+	GetExistingAccountCalled   func(addressContainer []byte) (state.AccountHandler, error)
+	LoadAccountCalled          func(container []byte) (state.AccountHandler, error)
+	SaveAccountCalled          func(account state.AccountHandler) error
+	RemoveAccountCalled        func(addressContainer []byte) error
+	CommitCalled               func() ([]byte, error)
+	JournalLenCalled           func() int
+	RevertToSnapshotCalled     func(snapshot int) error
+	RootHashCalled             func() ([]byte, error)
+	RecreateTrieCalled         func(rootHash []byte) error
+	PruneTrieCalled            func(rootHash []byte, identifier data.TriePruningIdentifier)
+	CancelPruneCalled          func(rootHash []byte, identifier data.TriePruningIdentifier)
+	SnapshotStateCalled        func(rootHash []byte)
+	SetStateCheckpointCalled   func(rootHash []byte)
+	IsPruningEnabledCalled     func() bool
+	GetAllLeavesCalled         func(rootHash []byte) (chan core.KeyValueHolder, error)
+	RecreateAllTriesCalled     func(rootHash []byte) (map[string]data.Trie, error)
+	GetTrieCalled              func(rootHash []byte) (data.Trie, error)
+	GetNumCheckpointsCalled    func() uint32
+	IsSnapshotInProgressCalled func() bool
+	GetCodeCalled              func([]byte) []byte
 }
 
 // GetCode -
@@ -48,6 +50,14 @@ func (as *AccountsStub) RecreateAllTries(rootHash []byte, _ context.Context) (ma
 	return nil, nil
 }
 
+// GetTrie -
+func (as *AccountsStub) GetTrie(rootHash []byte) (data.Trie, error) {
+	if as.GetTrieCalled != nil {
+		return as.GetTrieCalled(rootHash)
+	}
+	return nil, nil
+}
+
 // LoadAccount -
 func (as *AccountsStub) LoadAccount(address []byte) (state.AccountHandler, error) {
 	if as.LoadAccountCalled != nil {
@@ -190,6 +200,15 @@ func (as *AccountsStub) GetNumCheckpoints() uint32 {
 	return 0
 }
 
+// IsSnapshotInProgress -
+func (as *AccountsStub) IsSnapshotInProgress() bool {
+	if as.IsSnapshotInProgressCalled != nil {
+		return as.IsSnapshotInProgressCalled()
+	}
+
+	return false
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (as *AccountsStub) IsInterfaceNil() bool {
 	return as == nil
@@ -15,6 +15,11 @@ type ApiResolverStub struct {
 	StatusMetricsHandler              func() external.StatusMetricsHandler
 	ComputeTransactionGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
 	GetTotalStakedValueHandler        func() (*big.Int, error)
+	GetNetworkAPRCalled               func() (*external.NetworkAPR, error)
+	GetOwnerRewardsProjectionCalled   func(owner []byte) (*external.OwnerRewardsProjection, error)
+	GetESDTTokensListCalled           func(from uint32, size uint32) (*external.ESDTTokensList, error)
+	GetStakingQueueCalled             func(from uint32, size uint32) (*external.StakingQueue, error)
+	GetDelegationPositionsCalled      func(delegator []byte) (*external.DelegatorPositions, error)
 }
 
 // ExecuteSCQuery -
@@ -37,6 +42,51 @@ func (ars *ApiResolverStub) GetTotalStakedValue() (*big.Int, error) {
 	return ars.GetTotalStakedValueHandler()
 }
 
+// GetNetworkAPR -
+func (ars *ApiResolverStub) GetNetworkAPR() (*external.NetworkAPR, error) {
+	if ars.GetNetworkAPRCalled != nil {
+		return ars.GetNetworkAPRCalled()
+	}
+
+	return &external.NetworkAPR{}, nil
+}
+
+// GetOwnerRewardsProjection -
+func (ars *ApiResolverStub) GetOwnerRewardsProjection(owner []byte) (*external.OwnerRewardsProjection, error) {
+	if ars.GetOwnerRewardsProjectionCalled != nil {
+		return ars.GetOwnerRewardsProjectionCalled(owner)
+	}
+
+	return &external.OwnerRewardsProjection{}, nil
+}
+
+// GetESDTTokensList -
+func (ars *ApiResolverStub) GetESDTTokensList(from uint32, size uint32) (*external.ESDTTokensList, error) {
+	if ars.GetESDTTokensListCalled != nil {
+		return ars.GetESDTTokensListCalled(from, size)
+	}
+
+	return &external.ESDTTokensList{}, nil
+}
+
+// GetStakingQueue -
+func (ars *ApiResolverStub) GetStakingQueue(from uint32, size uint32) (*external.StakingQueue, error) {
+	if ars.GetStakingQueueCalled != nil {
+		return ars.GetStakingQueueCalled(from, size)
+	}
+
+	return &external.StakingQueue{}, nil
+}
+
+// GetDelegationPositions -
+func (ars *ApiResolverStub) GetDelegationPositions(delegator []byte) (*external.DelegatorPositions, error) {
+	if ars.GetDelegationPositionsCalled != nil {
+		return ars.GetDelegationPositionsCalled(delegator)
+	}
+
+	return &external.DelegatorPositions{}, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ars *ApiResolverStub) IsInterfaceNil() bool {
 	return ars == nil
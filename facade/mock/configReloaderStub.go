@@ -0,0 +1,20 @@
+package mock
+
+// ConfigReloaderStub -
+type ConfigReloaderStub struct {
+	ReloadCalled func() ([]string, error)
+}
+
+// Reload -
+func (crs *ConfigReloaderStub) Reload() ([]string, error) {
+	if crs.ReloadCalled != nil {
+		return crs.ReloadCalled()
+	}
+
+	return make([]string, 0), nil
+}
+
+// IsInterfaceNil -
+func (crs *ConfigReloaderStub) IsInterfaceNil() bool {
+	return crs == nil
+}
@@ -3,13 +3,18 @@ package mock
 import (
 	"encoding/hex"
 	"math/big"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 // NodeStub -
@@ -24,6 +29,9 @@ type NodeStub struct {
 	ValidateTransactionHandler                     func(tx *transaction.Transaction) error
 	ValidateTransactionForSimulationCalled         func(tx *transaction.Transaction) error
 	GetTransactionHandler                          func(hash string, withEvents bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionsPoolCalled                      func(filter transaction.PoolFilter) ([]*transaction.ApiPoolTransaction, error)
+	GetTransactionsPoolSummaryCalled               func(filter transaction.PoolFilter) ([]*transaction.ApiPoolSenderSummary, error)
+	GetLogsCalled                                  func(filter transaction.LogsFilter) ([]*transaction.ApiLogEvent, error)
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
 	GetAccountHandler                              func(address string) (state.UserAccountHandler, error)
 	GetCodeCalled                                  func(state.UserAccountHandler) []byte
@@ -31,17 +39,37 @@ type NodeStub struct {
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
 	GetHeartbeatsHandler                           func() []data.PubKeyHeartbeat
+	GetManagedKeysPerNodeCalled                    func() []data.NodeManagedKeys
 	ValidatorStatisticsApiCalled                   func() (map[string]*state.ValidatorApiResponse, error)
 	DirectTriggerCalled                            func(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTriggerCalled                            func() bool
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                           func(address string, key string) (string, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
-	GetBlockByHashCalled                           func(hash string, withTxs bool) (*block.APIBlock, error)
-	GetBlockByNonceCalled                          func(nonce uint64, withTxs bool) (*block.APIBlock, error)
+	GetConsensusParticipationCalled                func() []*consensus.EpochParticipation
+	GetBlockByHashCalled                           func(hash string, withTxs bool, withResults bool) (*block.APIBlock, error)
+	GetBlockByNonceCalled                          func(nonce uint64, withTxs bool, withResults bool) (*block.APIBlock, error)
+	GetRandomnessByNonceCalled                     func(nonce uint64) (*block.APIRandomness, error)
+	GetRandomnessByEpochCalled                     func(epoch uint32) (*block.APIRandomness, error)
+	GetRewardsAuditCalled                          func(epoch uint32) (*epochStart.RewardsAudit, error)
+	GetRewardsProofCalled                          func(epoch uint32, address string) (*epochStart.RewardsProof, error)
+	GetSupplyAccountingCalled                      func(epoch uint32) (*epochStart.SupplyAccounting, error)
+	GetEpochEconomicsCalled                        func(epoch uint32) (*epochStart.EpochEconomics, error)
+	GetEpochValidatorsAssignmentCalled             func(epoch uint32) (*sharding.EpochValidatorsAssignment, error)
+	GetValidatorEligibilityEstimateCalled          func(blsKey string) (*sharding.ValidatorEligibilityEstimate, error)
+	EstimateQueuedValidatorEligibleEpochCalled     func(queuePosition uint32) (*sharding.ValidatorEligibilityEstimate, error)
+	GetESDTTokensPageCalled                        func(address string, options esdt.TokensPageOptions) (*esdt.ApiESDTTokensPage, error)
 	GetUsernameCalled                              func(address string) (string, error)
 	GetESDTBalanceCalled                           func(address string, key string) (string, string, error)
 	GetAllESDTTokensCalled                         func(address string) ([]string, error)
+	GetConnectedPeersCalled                        func() []core.QueryP2PPeerInfo
+	DisconnectPeerCalled                           func(pid string) error
+	BlacklistPeerCalled                            func(pid string, duration time.Duration) error
+	TriggerStateSnapshotCalled                     func() (string, error)
+	CompactStorageCalled                           func() error
+	GetProofCalled                                 func(rootHash string, address string) (*state.ApiProof, error)
+	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*state.ApiProof, error)
+	VerifyProofCalled                              func(rootHash string, key string, proof []string) (bool, string, error)
 }
 
 // GetUsername -
@@ -68,13 +96,86 @@ func (ns *NodeStub) EncodeAddressPubkey(pk []byte) (string, error) {
 }
 
 // GetBlockByHash -
-func (ns *NodeStub) GetBlockByHash(hash string, withTxs bool) (*block.APIBlock, error) {
-	return ns.GetBlockByHashCalled(hash, withTxs)
+func (ns *NodeStub) GetBlockByHash(hash string, withTxs bool, withResults bool) (*block.APIBlock, error) {
+	return ns.GetBlockByHashCalled(hash, withTxs, withResults)
 }
 
 // GetBlockByNonce -
-func (ns *NodeStub) GetBlockByNonce(nonce uint64, withTxs bool) (*block.APIBlock, error) {
-	return ns.GetBlockByNonceCalled(nonce, withTxs)
+func (ns *NodeStub) GetBlockByNonce(nonce uint64, withTxs bool, withResults bool) (*block.APIBlock, error) {
+	return ns.GetBlockByNonceCalled(nonce, withTxs, withResults)
+}
+
+// GetRandomnessByNonce -
+func (ns *NodeStub) GetRandomnessByNonce(nonce uint64) (*block.APIRandomness, error) {
+	return ns.GetRandomnessByNonceCalled(nonce)
+}
+
+// GetRandomnessByEpoch -
+func (ns *NodeStub) GetRandomnessByEpoch(epoch uint32) (*block.APIRandomness, error) {
+	return ns.GetRandomnessByEpochCalled(epoch)
+}
+
+// GetRewardsAudit -
+func (ns *NodeStub) GetRewardsAudit(epoch uint32) (*epochStart.RewardsAudit, error) {
+	if ns.GetRewardsAuditCalled != nil {
+		return ns.GetRewardsAuditCalled(epoch)
+	}
+
+	return nil, nil
+}
+
+// GetRewardsProof -
+func (ns *NodeStub) GetRewardsProof(epoch uint32, address string) (*epochStart.RewardsProof, error) {
+	if ns.GetRewardsProofCalled != nil {
+		return ns.GetRewardsProofCalled(epoch, address)
+	}
+
+	return nil, nil
+}
+
+// GetSupplyAccounting -
+func (ns *NodeStub) GetSupplyAccounting(epoch uint32) (*epochStart.SupplyAccounting, error) {
+	if ns.GetSupplyAccountingCalled != nil {
+		return ns.GetSupplyAccountingCalled(epoch)
+	}
+
+	return nil, nil
+}
+
+// GetEpochEconomics -
+func (ns *NodeStub) GetEpochEconomics(epoch uint32) (*epochStart.EpochEconomics, error) {
+	if ns.GetEpochEconomicsCalled != nil {
+		return ns.GetEpochEconomicsCalled(epoch)
+	}
+
+	return nil, nil
+}
+
+// GetEpochValidatorsAssignment -
+func (ns *NodeStub) GetEpochValidatorsAssignment(epoch uint32) (*sharding.EpochValidatorsAssignment, error) {
+	if ns.GetEpochValidatorsAssignmentCalled != nil {
+		return ns.GetEpochValidatorsAssignmentCalled(epoch)
+	}
+
+	return nil, nil
+}
+
+// GetValidatorEligibilityEstimate -
+func (ns *NodeStub) GetValidatorEligibilityEstimate(blsKey string) (*sharding.ValidatorEligibilityEstimate, error) {
+	if ns.GetValidatorEligibilityEstimateCalled != nil {
+		return ns.GetValidatorEligibilityEstimateCalled(blsKey)
+	}
+
+	return nil, nil
+}
+
+// EstimateQueuedValidatorEligibleEpoch -
+func (ns *NodeStub) EstimateQueuedValidatorEligibleEpoch(queuePosition uint32) (*sharding.ValidatorEligibilityEstimate, error) {
+	if ns.EstimateQueuedValidatorEligibleEpochCalled != nil {
+		return ns.EstimateQueuedValidatorEligibleEpochCalled(queuePosition)
+	}
+
+	return nil, nil
 }
 
 // DecodeAddressPubkey -
@@ -114,6 +215,33 @@ func (ns *NodeStub) GetTransaction(hash string, withEvents bool) (*transaction.A
 	return ns.GetTransactionHandler(hash, withEvents)
 }
 
+// GetTransactionsPool -
+func (ns *NodeStub) GetTransactionsPool(filter transaction.PoolFilter) ([]*transaction.ApiPoolTransaction, error) {
+	if ns.GetTransactionsPoolCalled != nil {
+		return ns.GetTransactionsPoolCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolSummary -
+func (ns *NodeStub) GetTransactionsPoolSummary(filter transaction.PoolFilter) ([]*transaction.ApiPoolSenderSummary, error) {
+	if ns.GetTransactionsPoolSummaryCalled != nil {
+		return ns.GetTransactionsPoolSummaryCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// GetLogs -
+func (ns *NodeStub) GetLogs(filter transaction.LogsFilter) ([]*transaction.ApiLogEvent, error) {
+	if ns.GetLogsCalled != nil {
+		return ns.GetLogsCalled(filter)
+	}
+
+	return nil, nil
+}
+
 // SendBulkTransactions -
 func (ns *NodeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return ns.SendBulkTransactionsHandler(txs)
@@ -138,6 +266,15 @@ func (ns *NodeStub) GetHeartbeats() []data.PubKeyHeartbeat {
 	return ns.GetHeartbeatsHandler()
 }
 
+// GetManagedKeysPerNode -
+func (ns *NodeStub) GetManagedKeysPerNode() []data.NodeManagedKeys {
+	if ns.GetManagedKeysPerNodeCalled != nil {
+		return ns.GetManagedKeysPerNodeCalled()
+	}
+
+	return make([]data.NodeManagedKeys, 0)
+}
+
 // ValidatorStatisticsApi -
 func (ns *NodeStub) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error) {
 	return ns.ValidatorStatisticsApiCalled()
@@ -171,6 +308,15 @@ func (ns *NodeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return make([]core.QueryP2PPeerInfo, 0), nil
 }
 
+// GetConsensusParticipation -
+func (ns *NodeStub) GetConsensusParticipation() []*consensus.EpochParticipation {
+	if ns.GetConsensusParticipationCalled != nil {
+		return ns.GetConsensusParticipationCalled()
+	}
+
+	return make([]*consensus.EpochParticipation, 0)
+}
+
 // GetESDTBalance -
 func (ns *NodeStub) GetESDTBalance(address string, key string) (string, string, error) {
 	if ns.GetESDTBalanceCalled != nil {
@@ -180,6 +326,15 @@ func (ns *NodeStub) GetESDTBalance(address string, key string) (string, string,
 	return "", "", nil
 }
 
+// GetESDTTokensPage -
+func (ns *NodeStub) GetESDTTokensPage(address string, options esdt.TokensPageOptions) (*esdt.ApiESDTTokensPage, error) {
+	if ns.GetESDTTokensPageCalled != nil {
+		return ns.GetESDTTokensPageCalled(address, options)
+	}
+
+	return &esdt.ApiESDTTokensPage{}, nil
+}
+
 // GetAllESDTTokens -
 func (ns *NodeStub) GetAllESDTTokens(address string) ([]string, error) {
 	if ns.GetAllESDTTokensCalled != nil {
@@ -189,6 +344,78 @@ func (ns *NodeStub) GetAllESDTTokens(address string) ([]string, error) {
 	return []string{""}, nil
 }
 
+// GetConnectedPeers -
+func (ns *NodeStub) GetConnectedPeers() []core.QueryP2PPeerInfo {
+	if ns.GetConnectedPeersCalled != nil {
+		return ns.GetConnectedPeersCalled()
+	}
+
+	return make([]core.QueryP2PPeerInfo, 0)
+}
+
+// DisconnectPeer -
+func (ns *NodeStub) DisconnectPeer(pid string) error {
+	if ns.DisconnectPeerCalled != nil {
+		return ns.DisconnectPeerCalled(pid)
+	}
+
+	return nil
+}
+
+// BlacklistPeer -
+func (ns *NodeStub) BlacklistPeer(pid string, duration time.Duration) error {
+	if ns.BlacklistPeerCalled != nil {
+		return ns.BlacklistPeerCalled(pid, duration)
+	}
+
+	return nil
+}
+
+// TriggerStateSnapshot -
+func (ns *NodeStub) TriggerStateSnapshot() (string, error) {
+	if ns.TriggerStateSnapshotCalled != nil {
+		return ns.TriggerStateSnapshotCalled()
+	}
+
+	return "", nil
+}
+
+// CompactStorage -
+func (ns *NodeStub) CompactStorage() error {
+	if ns.CompactStorageCalled != nil {
+		return ns.CompactStorageCalled()
+	}
+
+	return nil
+}
+
+// GetProof -
+func (ns *NodeStub) GetProof(rootHash string, address string) (*state.ApiProof, error) {
+	if ns.GetProofCalled != nil {
+		return ns.GetProofCalled(rootHash, address)
+	}
+
+	return nil, nil
+}
+
+// GetProofDataTrie -
+func (ns *NodeStub) GetProofDataTrie(rootHash string, address string, key string) (*state.ApiProof, error) {
+	if ns.GetProofDataTrieCalled != nil {
+		return ns.GetProofDataTrieCalled(rootHash, address, key)
+	}
+
+	return nil, nil
+}
+
+// VerifyProof -
+func (ns *NodeStub) VerifyProof(rootHash string, key string, proof []string) (bool, string, error) {
+	if ns.VerifyProofCalled != nil {
+		return ns.VerifyProofCalled(rootHash, key, proof)
+	}
+
+	return false, "", nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ns *NodeStub) IsInterfaceNil() bool {
 	return ns == nil
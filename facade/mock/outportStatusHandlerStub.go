@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/core/indexer"
+
+// OutportStatusHandlerStub -
+type OutportStatusHandlerStub struct {
+	DriversStatusesCalled func() []indexer.DriverStatus
+}
+
+// DriversStatuses -
+func (oshs *OutportStatusHandlerStub) DriversStatuses() []indexer.DriverStatus {
+	if oshs.DriversStatusesCalled != nil {
+		return oshs.DriversStatusesCalled()
+	}
+
+	return make([]indexer.DriverStatus, 0)
+}
+
+// IsInterfaceNil -
+func (oshs *OutportStatusHandlerStub) IsInterfaceNil() bool {
+	return oshs == nil
+}
@@ -5,32 +5,41 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api"
 	"github.com/ElrondNetwork/elrond-go/api/address"
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/graphql"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/node"
+	pushApi "github.com/ElrondNetwork/elrond-go/api/push"
 	transactionApi "github.com/ElrondNetwork/elrond-go/api/transaction"
 	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/push"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/vm"
 	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 // DefaultRestInterface is the default interface the rest API will start on if not specified
@@ -41,8 +50,10 @@ const DefaultRestInterface = "localhost:8080"
 const DefaultRestPortOff = "off"
 
 var _ = address.FacadeHandler(&nodeFacade{})
+var _ = graphql.FacadeHandler(&nodeFacade{})
 var _ = hardfork.FacadeHandler(&nodeFacade{})
 var _ = node.FacadeHandler(&nodeFacade{})
+var _ = pushApi.FacadeHandler(&nodeFacade{})
 var _ = transactionApi.FacadeHandler(&nodeFacade{})
 var _ = validator.FacadeHandler(&nodeFacade{})
 var _ = vmValues.FacadeHandler(&nodeFacade{})
@@ -54,6 +65,17 @@ type resetHandler interface {
 	IsInterfaceNil() bool
 }
 
+type requestsLimiterHandler interface {
+	api.MiddlewareProcessor
+	SetMaxNumRequests(maxNumRequests uint32) error
+}
+
+type rateLimiterHandler interface {
+	api.MiddlewareProcessor
+	RemoveIdleConsumers()
+	ConsumersMetrics() []*middleware.ConsumerMetrics
+}
+
 // ArgNodeFacade represents the argument for the nodeFacade
 type ArgNodeFacade struct {
 	Node                   NodeHandler
@@ -63,8 +85,13 @@ type ArgNodeFacade struct {
 	WsAntifloodConfig      config.WebServerAntifloodConfig
 	FacadeConfig           config.FacadeConfig
 	ApiRoutesConfig        config.ApiRoutesConfig
+	WebServerConfig        config.WebServerConfig
 	AccountsState          state.AccountsAdapter
 	PeerState              state.AccountsAdapter
+	PushNotifier           PushNotifier
+	CrossShardStatistics   statistics.CrossShardStatisticsHandler
+	ConfigReloader         ConfigReloader
+	OutportStatus          OutportStatusHandler
 }
 
 // nodeFacade represents a facade for grouping the functionality for the node
@@ -76,11 +103,22 @@ type nodeFacade struct {
 	txSimulatorProc        TransactionSimulatorProcessor
 	config                 config.FacadeConfig
 	apiRoutesConfig        config.ApiRoutesConfig
+	webServerConfig        config.WebServerConfig
 	endpointsThrottlers    map[string]core.Throttler
 	wsAntifloodConfig      config.WebServerAntifloodConfig
 	restAPIServerDebugMode bool
 	accountsState          state.AccountsAdapter
 	peerState              state.AccountsAdapter
+	pushNotifier           PushNotifier
+	crossShardStatistics   statistics.CrossShardStatisticsHandler
+	configReloader         ConfigReloader
+	outportStatus          OutportStatusHandler
+	routesConfigUpdater    *api.RoutesConfigUpdater
+	mutLimiters            sync.RWMutex
+	sourceLimiter          requestsLimiterHandler
+	globalLimiter          requestsLimiterHandler
+	rateLimiter            rateLimiterHandler
+	workQueue              api.MiddlewareProcessor
 	ctx                    context.Context
 	cancelFunc             func()
 }
@@ -114,8 +152,28 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 	if check.IfNil(arg.PeerState) {
 		return nil, ErrNilPeerState
 	}
+	if check.IfNil(arg.PushNotifier) {
+		return nil, ErrNilPushNotifier
+	}
+	if check.IfNil(arg.CrossShardStatistics) {
+		return nil, ErrNilCrossShardStatistics
+	}
+	if check.IfNil(arg.ConfigReloader) {
+		return nil, ErrNilConfigReloader
+	}
+	if check.IfNil(arg.OutportStatus) {
+		return nil, ErrNilOutportStatusHandler
+	}
 
 	throttlersMap := computeEndpointsNumGoRoutinesThrottlers(arg.WsAntifloodConfig)
+	rateLimiter, err := createRateLimiter(arg.WsAntifloodConfig.RateLimiter)
+	if err != nil {
+		return nil, err
+	}
+	workQueue, err := createWorkQueue(arg.WsAntifloodConfig)
+	if err != nil {
+		return nil, err
+	}
 
 	nf := &nodeFacade{
 		node:                   arg.Node,
@@ -125,9 +183,17 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 		wsAntifloodConfig:      arg.WsAntifloodConfig,
 		config:                 arg.FacadeConfig,
 		apiRoutesConfig:        arg.ApiRoutesConfig,
+		webServerConfig:        arg.WebServerConfig,
 		endpointsThrottlers:    throttlersMap,
 		accountsState:          arg.AccountsState,
 		peerState:              arg.PeerState,
+		pushNotifier:           arg.PushNotifier,
+		crossShardStatistics:   arg.CrossShardStatistics,
+		configReloader:         arg.ConfigReloader,
+		outportStatus:          arg.OutportStatus,
+		routesConfigUpdater:    api.NewRoutesConfigUpdater(),
+		rateLimiter:            rateLimiter,
+		workQueue:              workQueue,
 	}
 	nf.ctx, nf.cancelFunc = context.WithCancel(context.Background())
 
@@ -193,6 +259,11 @@ func (nf *nodeFacade) RestApiInterface() string {
 	return nf.config.RestApiInterface
 }
 
+// AppVersion returns the version of the node application
+func (nf *nodeFacade) AppVersion() string {
+	return nf.config.AppVersion
+}
+
 func (nf *nodeFacade) startRest() {
 	log.Trace("starting REST api server")
 
@@ -214,9 +285,11 @@ func (nf *nodeFacade) startRest() {
 			"SimultaneousRequests", nf.wsAntifloodConfig.SimultaneousRequests,
 			"SameSourceRequests", nf.wsAntifloodConfig.SameSourceRequests,
 			"SameSourceResetIntervalInSec", nf.wsAntifloodConfig.SameSourceResetIntervalInSec,
+			"TLS", nf.webServerConfig.TLS.Enabled,
+			"ACME", nf.webServerConfig.TLS.ACME.Enabled,
 		)
 
-		err = api.Start(nf, nf.apiRoutesConfig, limiters...)
+		err = api.Start(nf, nf.apiRoutesConfig, nf.webServerConfig, nf.routesConfigUpdater, limiters...)
 		if err != nil {
 			log.Error("could not start webserver",
 				"error", err.Error(),
@@ -225,6 +298,29 @@ func (nf *nodeFacade) startRest() {
 	}
 }
 
+func createRateLimiter(cfg config.RateLimiterConfig) (rateLimiterHandler, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	if cfg.IdleConsumersCleanupInterval == 0 {
+		return nil, fmt.Errorf("%w, IdleConsumersCleanupInterval should not be 0", ErrInvalidValue)
+	}
+
+	return middleware.NewRateLimiter(cfg)
+}
+
+func createWorkQueue(cfg config.WebServerAntifloodConfig) (api.MiddlewareProcessor, error) {
+	if !cfg.WorkQueue.Enabled {
+		return nil, nil
+	}
+	// the queued requests are also counted by the global throttler, which would otherwise reject them first
+	if cfg.WorkQueue.MaxConcurrentRequests+cfg.WorkQueue.MaxQueuedRequests > cfg.SimultaneousRequests {
+		return nil, fmt.Errorf("%w, MaxConcurrentRequests + MaxQueuedRequests should not exceed SimultaneousRequests", ErrInvalidValue)
+	}
+
+	return middleware.NewWorkQueue(cfg.WorkQueue)
+}
+
 func (nf *nodeFacade) createMiddlewareLimiters() ([]api.MiddlewareProcessor, error) {
 	sourceLimiter, err := middleware.NewSourceThrottler(nf.wsAntifloodConfig.SameSourceRequests)
 	if err != nil {
@@ -237,7 +333,46 @@ func (nf *nodeFacade) createMiddlewareLimiters() ([]api.MiddlewareProcessor, err
 		return nil, err
 	}
 
-	return []api.MiddlewareProcessor{sourceLimiter, globalLimiter}, nil
+	nf.mutLimiters.Lock()
+	nf.sourceLimiter = sourceLimiter
+	nf.globalLimiter = globalLimiter
+	nf.mutLimiters.Unlock()
+
+	limiters := []api.MiddlewareProcessor{sourceLimiter, globalLimiter}
+	if !check.IfNil(nf.rateLimiter) {
+		go nf.rateLimiterCleanup()
+
+		// the rate limiter comes first so the rejected consumers do not take the global throttler slots
+		limiters = append([]api.MiddlewareProcessor{nf.rateLimiter}, limiters...)
+	}
+	if !check.IfNil(nf.workQueue) {
+		// the work queue comes last so only the requests accepted by all the other limiters are queued
+		limiters = append(limiters, nf.workQueue)
+	}
+
+	return limiters, nil
+}
+
+func (nf *nodeFacade) rateLimiterCleanup() {
+	betweenCleanupsDuration := time.Second * time.Duration(nf.wsAntifloodConfig.RateLimiter.IdleConsumersCleanupInterval)
+	for {
+		select {
+		case <-time.After(betweenCleanupsDuration):
+			nf.rateLimiter.RemoveIdleConsumers()
+		case <-nf.ctx.Done():
+			log.Debug("closing nodeFacade.rateLimiterCleanup go routine")
+			return
+		}
+	}
+}
+
+// GetAPIConsumersMetrics returns the requests metrics of the web server consumers, if the rate limiter is enabled
+func (nf *nodeFacade) GetAPIConsumersMetrics() []*middleware.ConsumerMetrics {
+	if check.IfNil(nf.rateLimiter) {
+		return make([]*middleware.ConsumerMetrics, 0)
+	}
+
+	return nf.rateLimiter.ConsumersMetrics()
 }
 
 func (nf *nodeFacade) sourceLimiterReset(reset resetHandler) {
@@ -279,6 +414,11 @@ func (nf *nodeFacade) GetAllESDTTokens(address string) ([]string, error) {
 	return nf.node.GetAllESDTTokens(address)
 }
 
+// GetESDTTokensPage returns a page of the esdt balances for a given address
+func (nf *nodeFacade) GetESDTTokensPage(address string, options esdt.TokensPageOptions) (*esdt.ApiESDTTokensPage, error) {
+	return nf.node.GetESDTTokensPage(address, options)
+}
+
 // CreateTransaction creates a transaction from all needed fields
 func (nf *nodeFacade) CreateTransaction(
 	nonce uint64,
@@ -329,6 +469,21 @@ func (nf *nodeFacade) GetTransaction(hash string, withResults bool) (*transactio
 	return nf.node.GetTransaction(hash, withResults)
 }
 
+// GetTransactionsPool returns the pending transactions of the pool matching the filter
+func (nf *nodeFacade) GetTransactionsPool(filter transaction.PoolFilter) ([]*transaction.ApiPoolTransaction, error) {
+	return nf.node.GetTransactionsPool(filter)
+}
+
+// GetTransactionsPoolSummary returns the pool summary for each sender matching the filter
+func (nf *nodeFacade) GetTransactionsPoolSummary(filter transaction.PoolFilter) ([]*transaction.ApiPoolSenderSummary, error) {
+	return nf.node.GetTransactionsPoolSummary(filter)
+}
+
+// GetLogs returns the log events matching the provided filter
+func (nf *nodeFacade) GetLogs(filter transaction.LogsFilter) ([]*transaction.ApiLogEvent, error) {
+	return nf.node.GetLogs(filter)
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
 func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error) {
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
@@ -365,6 +520,41 @@ func (nf *nodeFacade) GetTotalStakedValue() (*big.Int, error) {
 	return nf.apiResolver.GetTotalStakedValue()
 }
 
+// GetNetworkAPR will return the annual percentage rates of the base stake and of the top-up stake
+func (nf *nodeFacade) GetNetworkAPR() (*external.NetworkAPR, error) {
+	return nf.apiResolver.GetNetworkAPR()
+}
+
+// GetOwnerRewardsProjection will return the rewards projected for the nodes of the provided owner address
+func (nf *nodeFacade) GetOwnerRewardsProjection(address string) (*external.OwnerRewardsProjection, error) {
+	owner, err := nf.node.DecodeAddressPubkey(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return nf.apiResolver.GetOwnerRewardsProjection(owner)
+}
+
+// GetESDTTokensList will return a page of the ESDT tokens issued in the network
+func (nf *nodeFacade) GetESDTTokensList(from uint32, size uint32) (*external.ESDTTokensList, error) {
+	return nf.apiResolver.GetESDTTokensList(from, size)
+}
+
+// GetStakingQueue will return a page of the nodes waiting in the staking queue
+func (nf *nodeFacade) GetStakingQueue(from uint32, size uint32) (*external.StakingQueue, error) {
+	return nf.apiResolver.GetStakingQueue(from, size)
+}
+
+// GetDelegationPositions will return the funds of the provided delegator address in all the delegation contracts
+func (nf *nodeFacade) GetDelegationPositions(address string) (*external.DelegatorPositions, error) {
+	delegator, err := nf.node.DecodeAddressPubkey(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return nf.apiResolver.GetDelegationPositions(delegator)
+}
+
 // ExecuteSCQuery retrieves data from existing SC trie
 func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error) {
 	vmOutput, err := nf.apiResolver.ExecuteSCQuery(query)
@@ -410,6 +600,123 @@ func (nf *nodeFacade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return nf.node.GetPeerInfo(pid)
 }
 
+// GetConnectedPeers returns the p2p info of the peers the node is connected to
+func (nf *nodeFacade) GetConnectedPeers() []core.QueryP2PPeerInfo {
+	return nf.node.GetConnectedPeers()
+}
+
+// DisconnectPeer closes the connections with the provided peer
+func (nf *nodeFacade) DisconnectPeer(pid string) error {
+	return nf.node.DisconnectPeer(pid)
+}
+
+// BlacklistPeer blacklists the provided peer for the given duration
+func (nf *nodeFacade) BlacklistPeer(pid string, duration time.Duration) error {
+	return nf.node.BlacklistPeer(pid, duration)
+}
+
+// TriggerStateSnapshot starts a snapshot of the current accounts state
+func (nf *nodeFacade) TriggerStateSnapshot() (string, error) {
+	return nf.node.TriggerStateSnapshot()
+}
+
+// CompactStorage compacts the storage units of the node
+func (nf *nodeFacade) CompactStorage() error {
+	return nf.node.CompactStorage()
+}
+
+// GetProof returns the merkle proof of the given address against the provided accounts trie root hash
+func (nf *nodeFacade) GetProof(rootHash string, address string) (*state.ApiProof, error) {
+	return nf.node.GetProof(rootHash, address)
+}
+
+// GetProofDataTrie returns the merkle proof of the given key from the data trie of the given address, against the
+// provided accounts trie root hash
+func (nf *nodeFacade) GetProofDataTrie(rootHash string, address string, key string) (*state.ApiProof, error) {
+	return nf.node.GetProofDataTrie(rootHash, address, key)
+}
+
+// VerifyProof checks that the provided merkle proof proves the key against the given root hash
+func (nf *nodeFacade) VerifyProof(rootHash string, key string, proof []string) (bool, string, error) {
+	return nf.node.VerifyProof(rootHash, key, proof)
+}
+
+// GetConsensusParticipation returns the node's own participation in consensus during each of the last epochs
+func (nf *nodeFacade) GetConsensusParticipation() []*consensus.EpochParticipation {
+	return nf.node.GetConsensusParticipation()
+}
+
+// GetCrossShardStatistics returns the routing statistics of each shard pair
+func (nf *nodeFacade) GetCrossShardStatistics() []statistics.CrossShardPathStatistics {
+	return nf.crossShardStatistics.PathsStatistics()
+}
+
+// ValidateApiConfig checks that the provided API routes and web server antiflood configurations can be applied while
+// the web server is running
+func (nf *nodeFacade) ValidateApiConfig(routesConfig config.ApiRoutesConfig, antifloodConfig config.WebServerAntifloodConfig) error {
+	if antifloodConfig.SimultaneousRequests == 0 {
+		return fmt.Errorf("%w, SimultaneousRequests should not be 0", ErrInvalidValue)
+	}
+	if antifloodConfig.SameSourceRequests == 0 {
+		return fmt.Errorf("%w, SameSourceRequests should not be 0", ErrInvalidValue)
+	}
+	workQueueConfig := nf.wsAntifloodConfig.WorkQueue
+	numWorkQueueRequests := workQueueConfig.MaxConcurrentRequests + workQueueConfig.MaxQueuedRequests
+	if !check.IfNil(nf.workQueue) && numWorkQueueRequests > antifloodConfig.SimultaneousRequests {
+		return fmt.Errorf("%w, MaxConcurrentRequests + MaxQueuedRequests should not exceed SimultaneousRequests", ErrInvalidValue)
+	}
+
+	return nf.routesConfigUpdater.ValidateRoutesConfig(routesConfig)
+}
+
+// ApplyApiConfig opens or closes the API routes and changes the simultaneous and same source requests limits of the
+// running web server. The other web server antiflood settings are applied only when the node is restarted
+func (nf *nodeFacade) ApplyApiConfig(routesConfig config.ApiRoutesConfig, antifloodConfig config.WebServerAntifloodConfig) error {
+	err := nf.ValidateApiConfig(routesConfig, antifloodConfig)
+	if err != nil {
+		return err
+	}
+
+	err = nf.routesConfigUpdater.UpdateRoutesConfig(routesConfig)
+	if err != nil {
+		return err
+	}
+
+	nf.mutLimiters.RLock()
+	defer nf.mutLimiters.RUnlock()
+
+	if !check.IfNil(nf.sourceLimiter) {
+		err = nf.sourceLimiter.SetMaxNumRequests(antifloodConfig.SameSourceRequests)
+		if err != nil {
+			return err
+		}
+	}
+	if !check.IfNil(nf.globalLimiter) {
+		err = nf.globalLimiter.SetMaxNumRequests(antifloodConfig.SimultaneousRequests)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Debug("API configuration applied",
+		"SimultaneousRequests", antifloodConfig.SimultaneousRequests,
+		"SameSourceRequests", antifloodConfig.SameSourceRequests,
+	)
+
+	return nil
+}
+
+// ReloadConfig reloads the selected configurations from their files and applies them without restarting the node,
+// returning the names of the applied configurations
+func (nf *nodeFacade) ReloadConfig() ([]string, error) {
+	return nf.configReloader.Reload()
+}
+
+// GetOutportDriversStatuses returns the health of the outport drivers that deliver the data to an external endpoint
+func (nf *nodeFacade) GetOutportDriversStatuses() []indexer.DriverStatus {
+	return nf.outportStatus.DriversStatuses()
+}
+
 // GetThrottlerForEndpoint returns the throttler for a given endpoint if found
 func (nf *nodeFacade) GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool) {
 	throttlerForEndpoint, ok := nf.endpointsThrottlers[endpoint]
@@ -419,13 +726,58 @@ func (nf *nodeFacade) GetThrottlerForEndpoint(endpoint string) (core.Throttler,
 }
 
 // GetBlockByHash return the block for a given hash
-func (nf *nodeFacade) GetBlockByHash(hash string, withTxs bool) (*block.APIBlock, error) {
-	return nf.node.GetBlockByHash(hash, withTxs)
+func (nf *nodeFacade) GetBlockByHash(hash string, withTxs bool, withResults bool) (*block.APIBlock, error) {
+	return nf.node.GetBlockByHash(hash, withTxs, withResults)
 }
 
 // GetBlockByNonce returns the block for a given nonce
-func (nf *nodeFacade) GetBlockByNonce(nonce uint64, withTxs bool) (*block.APIBlock, error) {
-	return nf.node.GetBlockByNonce(nonce, withTxs)
+func (nf *nodeFacade) GetBlockByNonce(nonce uint64, withTxs bool, withResults bool) (*block.APIBlock, error) {
+	return nf.node.GetBlockByNonce(nonce, withTxs, withResults)
+}
+
+// GetRandomnessByNonce returns the randomness source of the block with the given nonce
+func (nf *nodeFacade) GetRandomnessByNonce(nonce uint64) (*block.APIRandomness, error) {
+	return nf.node.GetRandomnessByNonce(nonce)
+}
+
+// GetRandomnessByEpoch returns the randomness source of the start of epoch block of the given epoch
+func (nf *nodeFacade) GetRandomnessByEpoch(epoch uint32) (*block.APIRandomness, error) {
+	return nf.node.GetRandomnessByEpoch(epoch)
+}
+
+// GetRewardsAudit returns the rewards audit record of the given epoch
+func (nf *nodeFacade) GetRewardsAudit(epoch uint32) (*epochStart.RewardsAudit, error) {
+	return nf.node.GetRewardsAudit(epoch)
+}
+
+// GetRewardsProof returns the merkle proof of the rewards earned in the given epoch by the provided address
+func (nf *nodeFacade) GetRewardsProof(epoch uint32, address string) (*epochStart.RewardsProof, error) {
+	return nf.node.GetRewardsProof(epoch, address)
+}
+
+// GetSupplyAccounting returns the record of the tokens minted, the fees burned and the treasury inflows of the given epoch
+func (nf *nodeFacade) GetSupplyAccounting(epoch uint32) (*epochStart.SupplyAccounting, error) {
+	return nf.node.GetSupplyAccounting(epoch)
+}
+
+// GetEpochEconomics returns the aggregated fees, burns, mints and distributed rewards of the given epoch
+func (nf *nodeFacade) GetEpochEconomics(epoch uint32) (*epochStart.EpochEconomics, error) {
+	return nf.node.GetEpochEconomics(epoch)
+}
+
+// GetEpochValidatorsAssignment returns the validators assignment of the given epoch
+func (nf *nodeFacade) GetEpochValidatorsAssignment(epoch uint32) (*sharding.EpochValidatorsAssignment, error) {
+	return nf.node.GetEpochValidatorsAssignment(epoch)
+}
+
+// SubscribeToPushNotifications creates a new push notifications subscription for the provided filter
+func (nf *nodeFacade) SubscribeToPushNotifications(filter push.Filter) (*push.Subscription, error) {
+	return nf.pushNotifier.Subscribe(filter)
+}
+
+// UnsubscribeFromPushNotifications removes the provided push notifications subscription
+func (nf *nodeFacade) UnsubscribeFromPushNotifications(subscription *push.Subscription) {
+	nf.pushNotifier.Unsubscribe(subscription)
 }
 
 // Close will cleanup started go routines
@@ -1,6 +1,7 @@
 package facade
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
@@ -9,9 +10,11 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	atomicCore "github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/push"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -21,6 +24,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -49,8 +53,12 @@ func createMockArguments() ArgNodeFacade {
 				},
 			},
 		}},
-		AccountsState: &mock.AccountsStub{},
-		PeerState:     &mock.AccountsStub{},
+		AccountsState:        &mock.AccountsStub{},
+		PeerState:            &mock.AccountsStub{},
+		PushNotifier:         push.NewDisabledPushNotifier(),
+		CrossShardStatistics: &testscommon.CrossShardStatisticsStub{},
+		ConfigReloader:       &mock.ConfigReloaderStub{},
+		OutportStatus:        &mock.OutportStatusHandlerStub{},
 	}
 }
 
@@ -78,6 +86,50 @@ func TestNewNodeFacade_WithNilApiResolverShouldErr(t *testing.T) {
 	assert.Equal(t, ErrNilApiResolver, err)
 }
 
+func TestNewNodeFacade_WithNilPushNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.PushNotifier = nil
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.Equal(t, ErrNilPushNotifier, err)
+}
+
+func TestNewNodeFacade_WithNilCrossShardStatisticsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.CrossShardStatistics = nil
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.Equal(t, ErrNilCrossShardStatistics, err)
+}
+
+func TestNewNodeFacade_WithNilConfigReloaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.ConfigReloader = nil
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.Equal(t, ErrNilConfigReloader, err)
+}
+
+func TestNewNodeFacade_WithNilOutportStatusShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.OutportStatus = nil
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.Equal(t, ErrNilOutportStatusHandler, err)
+}
+
 func TestNewNodeFacade_WithInvalidSimultaneousRequestsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -111,6 +163,42 @@ func TestNewNodeFacade_WithInvalidSameSourceRequestsShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, ErrInvalidValue))
 }
 
+func TestNewNodeFacade_WithInvalidRateLimiterConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.WsAntifloodConfig.RateLimiter = config.RateLimiterConfig{
+		Enabled:                      true,
+		IdleConsumersCleanupInterval: 0,
+		DefaultLimit:                 config.RateLimitConfig{Burst: 10, SustainedRequestsPerSec: 10},
+	}
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
+func TestNewNodeFacade_WithInvalidWorkQueueConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.WsAntifloodConfig.SimultaneousRequests = 10
+	arg.WsAntifloodConfig.WorkQueue = config.WorkQueueConfig{
+		Enabled:               true,
+		MaxConcurrentRequests: 4,
+		MaxQueuedRequests:     8,
+		MaxQueueWaitInMs:      100,
+	}
+	nf, err := NewNodeFacade(arg)
+	assert.True(t, check.IfNil(nf))
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	arg.WsAntifloodConfig.WorkQueue.MaxQueuedRequests = 6
+	nf, err = NewNodeFacade(arg)
+	assert.False(t, check.IfNil(nf))
+	assert.Nil(t, err)
+}
+
 func TestNewNodeFacade_WithInvalidApiRoutesConfigShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -337,14 +425,12 @@ func TestNodeFacade_GetHeartbeats(t *testing.T) {
 				{
 					PublicKey:       "pk1",
 					TimeStamp:       time.Now(),
-					MaxInactiveTime: data.Duration{Duration: 0},
 					IsActive:        true,
 					ReceivedShardID: uint32(0),
 				},
 				{
 					PublicKey:       "pk2",
 					TimeStamp:       time.Now(),
-					MaxInactiveTime: data.Duration{Duration: 0},
 					IsActive:        true,
 					ReceivedShardID: uint32(0),
 				},
@@ -606,6 +692,21 @@ func TestNodeFacade_GetPeerInfo(t *testing.T) {
 	assert.Equal(t, []core.QueryP2PPeerInfo{pinfo}, val)
 }
 
+func TestNodeFacade_GetConsensusParticipation(t *testing.T) {
+	t.Parallel()
+
+	participation := []*consensus.EpochParticipation{{Epoch: 2, NumRoundsAsLeader: 3}}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetConsensusParticipationCalled: func() []*consensus.EpochParticipation {
+			return participation
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	assert.Equal(t, participation, nf.GetConsensusParticipation())
+}
+
 func TestNodeFacade_GetThrottlerForEndpointNoConfigShouldReturnNilAndFalse(t *testing.T) {
 	t.Parallel()
 
@@ -654,3 +755,110 @@ func TestNodeFacade_GetThrottlerForEndpointShouldFindAndReturn(t *testing.T) {
 	assert.NotNil(t, thr)
 	assert.True(t, ok)
 }
+
+func TestNodeFacade_GetOwnerRewardsProjectionInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetOwnerRewardsProjectionCalled: func(_ []byte) (*external.OwnerRewardsProjection, error) {
+			assert.Fail(t, "should have not called the api resolver")
+			return nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	projection, err := nf.GetOwnerRewardsProjection("not a hex address")
+
+	assert.Nil(t, projection)
+	assert.NotNil(t, err)
+}
+
+func TestNodeFacade_GetOwnerRewardsProjectionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedProjection := &external.OwnerRewardsProjection{RewardsPerEpoch: "1000"}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetOwnerRewardsProjectionCalled: func(owner []byte) (*external.OwnerRewardsProjection, error) {
+			assert.Equal(t, []byte("owner"), owner)
+			return expectedProjection, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	projection, err := nf.GetOwnerRewardsProjection(hex.EncodeToString([]byte("owner")))
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedProjection, projection)
+}
+
+func TestNodeFacade_GetDelegationPositionsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedPositions := &external.DelegatorPositions{TotalActiveStake: "1000"}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetDelegationPositionsCalled: func(delegator []byte) (*external.DelegatorPositions, error) {
+			assert.Equal(t, []byte("delegator"), delegator)
+			return expectedPositions, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	positions, err := nf.GetDelegationPositions(hex.EncodeToString([]byte("delegator")))
+	assert.Nil(t, err)
+	assert.Equal(t, expectedPositions, positions)
+
+	positions, err = nf.GetDelegationPositions("not a hex address")
+	assert.Nil(t, positions)
+	assert.NotNil(t, err)
+}
+
+func TestNodeFacade_ReloadConfig(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arg := createMockArguments()
+	arg.ConfigReloader = &mock.ConfigReloaderStub{
+		ReloadCalled: func() ([]string, error) {
+			return nil, expectedErr
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	applied, err := nf.ReloadConfig()
+	assert.Nil(t, applied)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestNodeFacade_ApplyApiConfig(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	nf, _ := NewNodeFacade(arg)
+	_, err := nf.createMiddlewareLimiters()
+	require.Nil(t, err)
+	defer func() {
+		_ = nf.Close()
+	}()
+
+	antifloodConfig := arg.WsAntifloodConfig
+	antifloodConfig.SimultaneousRequests = 0
+	err = nf.ApplyApiConfig(arg.ApiRoutesConfig, antifloodConfig)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	antifloodConfig = arg.WsAntifloodConfig
+	antifloodConfig.SameSourceRequests = 0
+	err = nf.ApplyApiConfig(arg.ApiRoutesConfig, antifloodConfig)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	err = nf.ApplyApiConfig(config.ApiRoutesConfig{}, arg.WsAntifloodConfig)
+	assert.NotNil(t, err)
+
+	antifloodConfig = arg.WsAntifloodConfig
+	antifloodConfig.SimultaneousRequests = 50
+	antifloodConfig.SameSourceRequests = 20
+	err = nf.ApplyApiConfig(arg.ApiRoutesConfig, antifloodConfig)
+	assert.Nil(t, err)
+}
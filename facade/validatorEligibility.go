@@ -0,0 +1,34 @@
+package facade
+
+import (
+	"math"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// GetValidatorEligibilityEstimate returns the status of the provided validator, eligible, waiting, leaving, queued or
+// inactive, and the estimated epoch at which it becomes eligible based on the current churn parameters. The staking
+// queue is checked only for the validators not found in the nodes lists of the current epoch
+func (nf *nodeFacade) GetValidatorEligibilityEstimate(blsKey string) (*sharding.ValidatorEligibilityEstimate, error) {
+	estimate, err := nf.node.GetValidatorEligibilityEstimate(blsKey)
+	if err != nil {
+		return nil, err
+	}
+	if estimate.Status != sharding.ValidatorStatusInactive {
+		return estimate, nil
+	}
+
+	stakingQueue, err := nf.apiResolver.GetStakingQueue(0, math.MaxUint32)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, queuedNode := range stakingQueue.Nodes {
+		if strings.EqualFold(queuedNode.BLSKey, blsKey) {
+			return nf.node.EstimateQueuedValidatorEligibleEpoch(queuedNode.Position)
+		}
+	}
+
+	return estimate, nil
+}
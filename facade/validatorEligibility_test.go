@@ -0,0 +1,80 @@
+package facade
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/facade/mock"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeFacade_GetValidatorEligibilityEstimate(t *testing.T) {
+	t.Parallel()
+
+	statuses := map[string]string{
+		"aa": sharding.ValidatorStatusWaiting,
+		"bb": sharding.ValidatorStatusInactive,
+		"cc": sharding.ValidatorStatusInactive,
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetValidatorEligibilityEstimateCalled: func(blsKey string) (*sharding.ValidatorEligibilityEstimate, error) {
+			return &sharding.ValidatorEligibilityEstimate{Epoch: 3, Status: statuses[blsKey]}, nil
+		},
+		EstimateQueuedValidatorEligibleEpochCalled: func(queuePosition uint32) (*sharding.ValidatorEligibilityEstimate, error) {
+			return &sharding.ValidatorEligibilityEstimate{Epoch: 3, Status: sharding.ValidatorStatusQueued, Position: queuePosition}, nil
+		},
+	}
+	numQueueReads := 0
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetStakingQueueCalled: func(from uint32, size uint32) (*external.StakingQueue, error) {
+			numQueueReads++
+			return &external.StakingQueue{
+				Nodes:    []*external.StakingQueueNode{{Position: 1, BLSKey: "dd"}, {Position: 2, BLSKey: "BB"}},
+				NumNodes: 2,
+			}, nil
+		},
+	}
+	nf, err := NewNodeFacade(arg)
+	require.Nil(t, err)
+
+	estimate, err := nf.GetValidatorEligibilityEstimate("aa")
+	require.Nil(t, err)
+	assert.Equal(t, sharding.ValidatorStatusWaiting, estimate.Status)
+	assert.Equal(t, 0, numQueueReads)
+
+	estimate, err = nf.GetValidatorEligibilityEstimate("bb")
+	require.Nil(t, err)
+	assert.Equal(t, sharding.ValidatorStatusQueued, estimate.Status)
+	assert.Equal(t, uint32(2), estimate.Position)
+
+	estimate, err = nf.GetValidatorEligibilityEstimate("cc")
+	require.Nil(t, err)
+	assert.Equal(t, sharding.ValidatorStatusInactive, estimate.Status)
+}
+
+func TestNodeFacade_GetValidatorEligibilityEstimateQueueErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetValidatorEligibilityEstimateCalled: func(blsKey string) (*sharding.ValidatorEligibilityEstimate, error) {
+			return &sharding.ValidatorEligibilityEstimate{Status: sharding.ValidatorStatusInactive}, nil
+		},
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetStakingQueueCalled: func(from uint32, size uint32) (*external.StakingQueue, error) {
+			return nil, expectedErr
+		},
+	}
+	nf, err := NewNodeFacade(arg)
+	require.Nil(t, err)
+
+	estimate, err := nf.GetValidatorEligibilityEstimate("aa")
+	assert.Nil(t, estimate)
+	assert.Equal(t, expectedErr, err)
+}
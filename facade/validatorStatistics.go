@@ -0,0 +1,129 @@
+package facade
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+type validatorsLessFunc func(a, b *state.ApiValidatorStatistics) bool
+
+var validatorsLessFuncs = map[string]validatorsLessFunc{
+	state.SortValidatorsByPublicKey: func(a, b *state.ApiValidatorStatistics) bool {
+		return a.PublicKey < b.PublicKey
+	},
+	state.SortValidatorsByRating: func(a, b *state.ApiValidatorStatistics) bool {
+		return a.Rating < b.Rating
+	},
+	state.SortValidatorsByTempRating: func(a, b *state.ApiValidatorStatistics) bool {
+		return a.TempRating < b.TempRating
+	},
+	state.SortValidatorsByShard: func(a, b *state.ApiValidatorStatistics) bool {
+		return a.ShardId < b.ShardId
+	},
+	state.SortValidatorsByIdentity: func(a, b *state.ApiValidatorStatistics) bool {
+		return a.Identity < b.Identity
+	},
+	state.SortValidatorsByStatus: func(a, b *state.ApiValidatorStatistics) bool {
+		return a.ValidatorStatus < b.ValidatorStatus
+	},
+}
+
+// GetValidatorStatisticsPage will return a page of the validator statistics matching the query filters, in the
+// requested order. The identities of the validators are taken from their heartbeat messages, if available
+func (nf *nodeFacade) GetValidatorStatisticsPage(query state.ValidatorStatisticsQuery) (*state.ApiValidatorStatisticsPage, error) {
+	sortBy := query.SortBy
+	if len(sortBy) == 0 {
+		sortBy = state.SortValidatorsByPublicKey
+	}
+	lessFunc, ok := validatorsLessFuncs[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("%w for the sort field: %s", ErrInvalidValue, query.SortBy)
+	}
+	if query.Size == 0 {
+		return nil, fmt.Errorf("%w for the page size: 0", ErrInvalidValue)
+	}
+
+	validators, err := nf.node.ValidatorStatisticsApi()
+	if err != nil {
+		return nil, err
+	}
+
+	identities := make(map[string]string)
+	for _, heartbeat := range nf.node.GetHeartbeats() {
+		identities[heartbeat.PublicKey] = heartbeat.Identity
+	}
+
+	entries := make([]*state.ApiValidatorStatistics, 0, len(validators))
+	for publicKey, validator := range validators {
+		entry := &state.ApiValidatorStatistics{
+			PublicKey:            publicKey,
+			Identity:             identities[publicKey],
+			ValidatorApiResponse: validator,
+		}
+		if !matchesValidatorStatisticsQuery(entry, query) {
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if query.SortDescending {
+			a, b = b, a
+		}
+		if lessFunc(a, b) {
+			return true
+		}
+		if lessFunc(b, a) {
+			return false
+		}
+
+		// the public key breaks the ties so the pages are consistent between calls
+		return a.PublicKey < b.PublicKey
+	})
+
+	page := &state.ApiValidatorStatisticsPage{
+		Statistics:    make([]*state.ApiValidatorStatistics, 0),
+		NumValidators: uint32(len(entries)),
+	}
+	if query.From >= uint32(len(entries)) {
+		return page, nil
+	}
+
+	end := query.From + query.Size
+	if end >= uint32(len(entries)) {
+		end = uint32(len(entries))
+	} else {
+		page.NextFrom = end
+	}
+	page.Statistics = entries[query.From:end]
+
+	return page, nil
+}
+
+func matchesValidatorStatisticsQuery(entry *state.ApiValidatorStatistics, query state.ValidatorStatisticsQuery) bool {
+	if entry.ValidatorApiResponse == nil {
+		return false
+	}
+	if query.ShardID != nil && entry.ShardId != *query.ShardID {
+		return false
+	}
+	if len(query.Identity) > 0 && !strings.EqualFold(entry.Identity, query.Identity) {
+		return false
+	}
+	if len(query.Status) > 0 && !strings.EqualFold(entry.ValidatorStatus, query.Status) {
+		return false
+	}
+	if query.MinRating != nil && entry.Rating < *query.MinRating {
+		return false
+	}
+	if query.MaxRating != nil && entry.Rating > *query.MaxRating {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,145 @@
+package facade
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/facade/mock"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createFacadeWithValidators() *nodeFacade {
+	validators := map[string]*state.ValidatorApiResponse{
+		"aa": {ShardId: 0, Rating: 50, TempRating: 60, ValidatorStatus: "eligible"},
+		"bb": {ShardId: 1, Rating: 90, TempRating: 40, ValidatorStatus: "eligible"},
+		"cc": {ShardId: 0, Rating: 70, TempRating: 80, ValidatorStatus: "waiting"},
+		"dd": {ShardId: 1, Rating: 70, TempRating: 20, ValidatorStatus: "jailed"},
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		ValidatorStatisticsApiCalled: func() (map[string]*state.ValidatorApiResponse, error) {
+			return validators, nil
+		},
+		GetHeartbeatsHandler: func() []data.PubKeyHeartbeat {
+			return []data.PubKeyHeartbeat{
+				{PublicKey: "aa", Identity: "staking-provider"},
+				{PublicKey: "cc", Identity: "Staking-Provider"},
+				{PublicKey: "dd", Identity: "solo"},
+			}
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	return nf
+}
+
+func publicKeysOf(page *state.ApiValidatorStatisticsPage) []string {
+	publicKeys := make([]string, 0, len(page.Statistics))
+	for _, entry := range page.Statistics {
+		publicKeys = append(publicKeys, entry.PublicKey)
+	}
+
+	return publicKeys
+}
+
+func TestNodeFacade_GetValidatorStatisticsPageInvalidQueryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nf := createFacadeWithValidators()
+
+	page, err := nf.GetValidatorStatisticsPage(state.ValidatorStatisticsQuery{SortBy: "unknown", Size: 10})
+	assert.Nil(t, page)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	page, err = nf.GetValidatorStatisticsPage(state.ValidatorStatisticsQuery{})
+	assert.Nil(t, page)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
+func TestNodeFacade_GetValidatorStatisticsPageNodeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		ValidatorStatisticsApiCalled: func() (map[string]*state.ValidatorApiResponse, error) {
+			return nil, expectedErr
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	page, err := nf.GetValidatorStatisticsPage(state.ValidatorStatisticsQuery{Size: 10})
+	assert.Nil(t, page)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestNodeFacade_GetValidatorStatisticsPageShouldWork(t *testing.T) {
+	t.Parallel()
+
+	nf := createFacadeWithValidators()
+
+	t.Run("default order", func(t *testing.T) {
+		page, err := nf.GetValidatorStatisticsPage(state.ValidatorStatisticsQuery{Size: 10})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"aa", "bb", "cc", "dd"}, publicKeysOf(page))
+		assert.Equal(t, uint32(4), page.NumValidators)
+		assert.Equal(t, uint32(0), page.NextFrom)
+		assert.Equal(t, "staking-provider", page.Statistics[0].Identity)
+	})
+	t.Run("filters", func(t *testing.T) {
+		shardID := uint32(0)
+		minRating := float32(60)
+		page, err := nf.GetValidatorStatisticsPage(state.ValidatorStatisticsQuery{
+			ShardID:   &shardID,
+			Identity:  "staking-provider",
+			MinRating: &minRating,
+			Size:      10,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"cc"}, publicKeysOf(page))
+
+		maxRating := float32(70)
+		page, err = nf.GetValidatorStatisticsPage(state.ValidatorStatisticsQuery{
+			Status:    "ELIGIBLE",
+			MaxRating: &maxRating,
+			Size:      10,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"aa"}, publicKeysOf(page))
+	})
+	t.Run("sorting with ties broken by public key", func(t *testing.T) {
+		page, err := nf.GetValidatorStatisticsPage(state.ValidatorStatisticsQuery{
+			SortBy:         state.SortValidatorsByRating,
+			SortDescending: true,
+			Size:           10,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"bb", "dd", "cc", "aa"}, publicKeysOf(page))
+
+		page, err = nf.GetValidatorStatisticsPage(state.ValidatorStatisticsQuery{
+			SortBy: state.SortValidatorsByTempRating,
+			Size:   10,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"dd", "bb", "aa", "cc"}, publicKeysOf(page))
+	})
+	t.Run("pagination", func(t *testing.T) {
+		page, err := nf.GetValidatorStatisticsPage(state.ValidatorStatisticsQuery{From: 1, Size: 2})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"bb", "cc"}, publicKeysOf(page))
+		assert.Equal(t, uint32(3), page.NextFrom)
+
+		page, err = nf.GetValidatorStatisticsPage(state.ValidatorStatisticsQuery{From: 3, Size: 2})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"dd"}, publicKeysOf(page))
+		assert.Equal(t, uint32(0), page.NextFrom)
+
+		page, err = nf.GetValidatorStatisticsPage(state.ValidatorStatisticsQuery{From: 10, Size: 2})
+		require.Nil(t, err)
+		assert.Empty(t, page.Statistics)
+		assert.Equal(t, uint32(4), page.NumValidators)
+	})
+}
@@ -1,13 +1,19 @@
 package factory
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/batchSingleSigner"
+	"github.com/ElrondNetwork/elrond-go/crypto/cachedMultiSigner"
 	"github.com/ElrondNetwork/elrond-go/crypto/peerSignatureHandler"
+	"github.com/ElrondNetwork/elrond-go/crypto/remoteSigner"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	disabledMultiSig "github.com/ElrondNetwork/elrond-go/crypto/signing/disabled/multisig"
 	disabledSig "github.com/ElrondNetwork/elrond-go/crypto/signing/disabled/singlesig"
@@ -20,6 +26,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
 	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -37,6 +44,8 @@ type CryptoComponentsFactoryArgs struct {
 	KeyGen                               crypto.KeyGenerator
 	PrivKey                              crypto.PrivateKey
 	ActivateBLSPubKeyMessageVerification bool
+	ManagedPrivateKeys                   [][]byte
+	ManagedRemoteKeys                    []crypto.PrivateKey
 }
 
 type cryptoComponentsFactory struct {
@@ -48,6 +57,9 @@ type cryptoComponentsFactory struct {
 	privKey                              crypto.PrivateKey
 	activateBLSPubKeyMessageVerification bool
 	importDbNoSigCheckFlag               bool
+	managedPrivateKeys                   [][]byte
+	managedRemoteKeys                    []crypto.PrivateKey
+	signingSlotHandler                   crypto.SigningSlotHandler
 }
 
 // NewCryptoComponentsFactory returns a new crypto components factory
@@ -74,6 +86,9 @@ func NewCryptoComponentsFactory(args CryptoComponentsFactoryArgs, importDbNoSigC
 		privKey:                              args.PrivKey,
 		activateBLSPubKeyMessageVerification: args.ActivateBLSPubKeyMessageVerification,
 		importDbNoSigCheckFlag:               importDbNoSigCheckFlag,
+		managedPrivateKeys:                   args.ManagedPrivateKeys,
+		managedRemoteKeys:                    args.ManagedRemoteKeys,
+		signingSlotHandler:                   remoteSigner.NewSigningSlotHandler(),
 	}
 
 	return ccf, nil
@@ -82,7 +97,6 @@ func NewCryptoComponentsFactory(args CryptoComponentsFactoryArgs, importDbNoSigC
 // Create will create and return crypto components
 func (ccf *cryptoComponentsFactory) Create() (*CryptoComponents, error) {
 	initialPubKeys := ccf.nodesConfig.InitialNodesPubKeys()
-	txSingleSigner := &singlesig.Ed25519Signer{}
 	processingSingleSigner, err := ccf.createSingleSigner(false)
 	if err != nil {
 		return nil, err
@@ -93,6 +107,30 @@ func (ccf *cryptoComponentsFactory) Create() (*CryptoComponents, error) {
 		return nil, err
 	}
 
+	closers := make([]io.Closer, 0)
+	interceptSingleSigner, err = ccf.wrapInBatchSingleSigner(interceptSingleSigner)
+	if err != nil {
+		return nil, err
+	}
+	batchSigner, isCloser := interceptSingleSigner.(io.Closer)
+	if isCloser {
+		closers = append(closers, batchSigner)
+	}
+
+	interceptSingleSigner, err = ccf.wrapInRemoteSingleSigner(interceptSingleSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	txSingleSigner, err := ccf.wrapInBatchSingleSigner(&singlesig.Ed25519Signer{})
+	if err != nil {
+		return nil, err
+	}
+	batchTxSigner, isCloser := txSingleSigner.(io.Closer)
+	if isCloser {
+		closers = append(closers, batchTxSigner)
+	}
+
 	multisigHasher, err := ccf.getMultisigHasherFromConfig()
 	if err != nil {
 		return nil, err
@@ -134,6 +172,11 @@ func (ccf *cryptoComponentsFactory) Create() (*CryptoComponents, error) {
 		return nil, err
 	}
 
+	managedPeersHolder, err := ccf.createManagedPeersHolder(multisigHasher)
+	if err != nil {
+		return nil, err
+	}
+
 	return &CryptoComponents{
 		TxSingleSigner:       txSingleSigner,
 		SingleSigner:         interceptSingleSigner,
@@ -143,9 +186,59 @@ func (ccf *cryptoComponentsFactory) Create() (*CryptoComponents, error) {
 		InitialPubKeys:       initialPubKeys,
 		MessageSignVerifier:  messageSignVerifier,
 		PeerSignatureHandler: peerSigHandler,
+		ManagedPeersHolder:   managedPeersHolder,
+		SigningSlotHandler:   ccf.signingSlotHandler,
+		closers:              closers,
 	}, nil
 }
 
+func (ccf *cryptoComponentsFactory) createManagedPeersHolder(hasher hashing.Hasher) (ManagedPeersHolder, error) {
+	shareSigner, err := ccf.createShareSigner(hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	args := keysManagement.ArgsManagedPeersHolder{
+		KeyGenerator: ccf.keyGen,
+		ShareSigner:  shareSigner,
+	}
+	managedPeersHolder, err := keysManagement.NewManagedPeersHolder(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nodePrivateKeyBytes, err := ccf.privKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	numManagedKeys := 0
+	for _, privateKeyBytes := range ccf.managedPrivateKeys {
+		if bytes.Equal(privateKeyBytes, nodePrivateKeyBytes) {
+			// the node's own key is always operated by the node, it does not need to be managed
+			continue
+		}
+
+		err = managedPeersHolder.AddManagedPeer(privateKeyBytes)
+		if err != nil {
+			return nil, err
+		}
+		numManagedKeys++
+	}
+
+	for _, remoteKey := range ccf.managedRemoteKeys {
+		err = managedPeersHolder.AddManagedPrivateKey(remoteKey)
+		if err != nil {
+			return nil, err
+		}
+		numManagedKeys++
+	}
+
+	log.Debug("managed validator keys loaded", "num keys", numManagedKeys)
+
+	return managedPeersHolder, nil
+}
+
 func (ccf *cryptoComponentsFactory) createSingleSigner(importDbNoSigCheckFlag bool) (crypto.SingleSigner, error) {
 	if importDbNoSigCheckFlag {
 		log.Warn("using disabled single signer because the node is running in import-db 'turbo mode'")
@@ -163,6 +256,70 @@ func (ccf *cryptoComponentsFactory) createSingleSigner(importDbNoSigCheckFlag bo
 	}
 }
 
+// wrapInBatchSingleSigner wraps the single signers in a batch single signer. The batch verifiers check a batch at once,
+// while the signatures of the other ones are verified in parallel. The disabled single signer is not wrapped as it
+// verifies nothing
+func (ccf *cryptoComponentsFactory) wrapInBatchSingleSigner(singleSigner crypto.SingleSigner) (crypto.SingleSigner, error) {
+	batchConfig := ccf.config.BatchSignatureVerifier
+	if !batchConfig.Enabled {
+		return singleSigner, nil
+	}
+	_, isDisabled := singleSigner.(*disabledSig.DisabledSingleSig)
+	if isDisabled {
+		return singleSigner, nil
+	}
+
+	args := batchSingleSigner.ArgsBatchSingleSigner{
+		SingleSigner:  singleSigner,
+		NumWorkers:    batchConfig.NumWorkers,
+		MaxBatchSize:  batchConfig.MaxBatchSize,
+		FlushInterval: time.Duration(batchConfig.FlushIntervalInMillisec) * time.Millisecond,
+	}
+
+	return batchSingleSigner.NewBatchSingleSigner(args)
+}
+
+func (ccf *cryptoComponentsFactory) isRemoteSigningEnabled() bool {
+	return ccf.config.RemoteSigner.Enabled || ccf.config.ThresholdSigner.Enabled
+}
+
+func (ccf *cryptoComponentsFactory) wrapInRemoteSingleSigner(singleSigner crypto.SingleSigner) (crypto.SingleSigner, error) {
+	if !ccf.isRemoteSigningEnabled() {
+		return singleSigner, nil
+	}
+
+	return remoteSigner.NewSingleSigner(singleSigner)
+}
+
+func (ccf *cryptoComponentsFactory) createShareSigner(hasher hashing.Hasher) (crypto.LowLevelSignerBLS, error) {
+	blsSigner := &mclMultiSig.BlsMultiSigner{Hasher: hasher}
+	if !ccf.isRemoteSigningEnabled() {
+		return blsSigner, nil
+	}
+
+	return remoteSigner.NewShareSigner(blsSigner, ccf.signingSlotHandler)
+}
+
+func (ccf *cryptoComponentsFactory) wrapInCachedMultiSigner(multiSigner crypto.MultiSigner) (crypto.MultiSigner, error) {
+	cacheConfig := ccf.config.VerifiedSignaturesCache
+	if !cacheConfig.Enabled {
+		return multiSigner, nil
+	}
+
+	cache, err := storageUnit.NewCache(storageFactory.GetCacherFromConfig(cacheConfig.Cache))
+	if err != nil {
+		return nil, err
+	}
+
+	args := cachedMultiSigner.ArgsCachedMultiSigner{
+		MultiSigner: multiSigner,
+		Cache:       cache,
+		Hasher:      sha256.Sha256{},
+	}
+
+	return cachedMultiSigner.NewCachedMultiSigner(args)
+}
+
 func (ccf *cryptoComponentsFactory) getMultisigHasherFromConfig() (hashing.Hasher, error) {
 	if ccf.consensusType == consensus.BlsConsensusType && ccf.config.MultisigHasher.Type != "blake2b" {
 		return nil, ErrMultiSigHasherMissmatch
@@ -199,8 +356,15 @@ func (ccf *cryptoComponentsFactory) createMultiSigner(
 
 	switch ccf.consensusType {
 	case consensus.BlsConsensusType:
-		blsSigner := &mclMultiSig.BlsMultiSigner{Hasher: hasher}
-		return multisig.NewBLSMultisig(blsSigner, pubKeys, ccf.privKey, ccf.keyGen, uint16(0))
+		blsSigner, err := ccf.createShareSigner(hasher)
+		if err != nil {
+			return nil, err
+		}
+		multiSigner, err := multisig.NewBLSMultisig(blsSigner, pubKeys, ccf.privKey, ccf.keyGen, uint16(0))
+		if err != nil {
+			return nil, err
+		}
+		return ccf.wrapInCachedMultiSigner(multiSigner)
 	case disabledSigChecking:
 		log.Warn("using disabled multi signer")
 		return &disabledMultiSig.DisabledMultiSig{}, nil
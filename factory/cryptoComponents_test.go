@@ -1,9 +1,12 @@
 package factory_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
 	"github.com/stretchr/testify/require"
@@ -90,6 +93,97 @@ func TestCryptoComponentsFactory_Create(t *testing.T) {
 	require.NotNil(t, cc)
 }
 
+func TestCryptoComponentsFactory_CreateWithRemoteSignerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := getCryptoArgs()
+	args.Config.RemoteSigner.Enabled = true
+	ccf, _ := factory.NewCryptoComponentsFactory(args, false)
+
+	cc, err := ccf.Create()
+	require.NoError(t, err)
+	require.NotNil(t, cc)
+}
+
+func TestCryptoComponentsFactory_CreateWithBatchSignatureVerifierShouldWrapTheEnabledSigners(t *testing.T) {
+	t.Parallel()
+
+	args := getCryptoArgs()
+	args.Config.BatchSignatureVerifier = config.BatchSignatureVerifierConfig{
+		Enabled:                 true,
+		NumWorkers:              2,
+		MaxBatchSize:            10,
+		FlushIntervalInMillisec: 2,
+	}
+	ccf, _ := factory.NewCryptoComponentsFactory(args, false)
+
+	cc, err := ccf.Create()
+	require.NoError(t, err)
+	require.Equal(t, "*batchSingleSigner.batchSingleSigner", fmt.Sprintf("%T", cc.TxSingleSigner))
+	require.Equal(t, "*batchSingleSigner.batchSingleSigner", fmt.Sprintf("%T", cc.SingleSigner))
+	require.NoError(t, cc.Close())
+
+	ccf, _ = factory.NewCryptoComponentsFactory(args, true)
+	cc, err = ccf.Create()
+	require.NoError(t, err)
+	require.Equal(t, "*batchSingleSigner.batchSingleSigner", fmt.Sprintf("%T", cc.TxSingleSigner))
+	require.NotEqual(t, "*batchSingleSigner.batchSingleSigner", fmt.Sprintf("%T", cc.SingleSigner))
+	require.NoError(t, cc.Close())
+}
+
+func TestCryptoComponentsFactory_CreateWithInvalidManagedKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := getCryptoArgs()
+	args.KeyGen = &mock.KeyGenMock{
+		PrivateKeyFromByteArrayMock: func(b []byte) (crypto.PrivateKey, error) {
+			return nil, expectedErr
+		},
+	}
+	args.ManagedPrivateKeys = [][]byte{[]byte("invalid key")}
+	ccf, _ := factory.NewCryptoComponentsFactory(args, false)
+
+	cc, err := ccf.Create()
+	require.True(t, errors.Is(err, expectedErr))
+	require.Nil(t, cc)
+}
+
+func TestCryptoComponentsFactory_CreateShouldNotManageTheNodeKey(t *testing.T) {
+	t.Parallel()
+
+	args := getCryptoArgs()
+	nodePrivateKeyBytes, _ := args.PrivKey.ToByteArray()
+	args.ManagedPrivateKeys = [][]byte{nodePrivateKeyBytes}
+	ccf, _ := factory.NewCryptoComponentsFactory(args, false)
+
+	cc, err := ccf.Create()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(cc.ManagedPeersHolder.GetManagedKeysByCurrentNode()))
+}
+
+func TestCryptoComponentsFactory_CreateWithThresholdSignerShouldManageTheRemoteKeys(t *testing.T) {
+	t.Parallel()
+
+	args := getCryptoArgs()
+	args.Config.ThresholdSigner.Enabled = true
+	remoteKey := &mock.PrivateKeyMock{
+		GeneratePublicMock: func() crypto.PublicKey {
+			return &mock.PublicKeyMock{}
+		},
+	}
+	args.ManagedRemoteKeys = []crypto.PrivateKey{remoteKey}
+	ccf, _ := factory.NewCryptoComponentsFactory(args, false)
+
+	cc, err := ccf.Create()
+	require.NoError(t, err)
+
+	managedKeys := cc.ManagedPeersHolder.GetManagedKeysByCurrentNode()
+	require.Equal(t, 1, len(managedKeys))
+	require.Equal(t, remoteKey, managedKeys["publicKeyMock"])
+	require.NotNil(t, cc.SigningSlotHandler)
+}
+
 func getCryptoArgs() factory.CryptoComponentsFactoryArgs {
 	return factory.CryptoComponentsFactoryArgs{
 		Config: config.Config{
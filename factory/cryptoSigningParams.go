@@ -8,6 +8,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/keystore"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 )
@@ -19,6 +20,7 @@ type cryptoSigningParamsLoader struct {
 	pubkeyConverter     core.PubkeyConverter
 	skIndex             int
 	skPemFileName       string
+	passphraseProvider  keystore.PassphraseProvider
 	suite               crypto.Suite
 	skPkProviderHandler func() ([]byte, []byte, error)
 	isInImportMode      bool
@@ -29,22 +31,27 @@ func NewCryptoSigningParamsLoader(
 	pubkeyConverter core.PubkeyConverter,
 	skIndex int,
 	skPemFileName string,
+	passphraseProvider keystore.PassphraseProvider,
 	suite crypto.Suite,
 	isInImportMode bool,
 ) (*cryptoSigningParamsLoader, error) {
 	if check.IfNil(pubkeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	if check.IfNil(passphraseProvider) {
+		return nil, keystore.ErrNilPassphraseProvider
+	}
 	if check.IfNil(suite) {
 		return nil, ErrNilSuite
 	}
 
 	cspf := &cryptoSigningParamsLoader{
-		pubkeyConverter: pubkeyConverter,
-		skIndex:         skIndex,
-		skPemFileName:   skPemFileName,
-		suite:           suite,
-		isInImportMode:  isInImportMode,
+		pubkeyConverter:    pubkeyConverter,
+		skIndex:            skIndex,
+		skPemFileName:      skPemFileName,
+		passphraseProvider: passphraseProvider,
+		suite:              suite,
+		isInImportMode:     isInImportMode,
 	}
 	cspf.skPkProviderHandler = cspf.getSkPk
 
@@ -108,7 +115,7 @@ func (cspf *cryptoSigningParamsLoader) generateCryptoParams(cryptoParams *Crypto
 
 func (cspf *cryptoSigningParamsLoader) getSkPk() ([]byte, []byte, error) {
 	skIndex := cspf.skIndex
-	encodedSk, pkString, err := core.LoadSkPkFromPemFile(cspf.skPemFileName, skIndex)
+	encodedSk, pkString, err := keystore.LoadSkPkFromFile(cspf.skPemFileName, skIndex, cspf.passphraseProvider)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core/keystore"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
 	"github.com/stretchr/testify/require"
@@ -12,15 +13,23 @@ import (
 func TestNewCryptoSigningParamsLoader_NilPubKeyConverterShoulldErr(t *testing.T) {
 	t.Parallel()
 
-	cspf, err := NewCryptoSigningParamsLoader(nil, 0, "name", &mock.SuiteStub{}, false)
+	cspf, err := NewCryptoSigningParamsLoader(nil, 0, "name", &mock.PassphraseProviderStub{}, &mock.SuiteStub{}, false)
 	require.Nil(t, cspf)
 	require.Equal(t, ErrNilPubKeyConverter, err)
 }
 
+func TestNewCryptoSigningParamsLoader_NilPassphraseProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cspf, err := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", nil, &mock.SuiteStub{}, false)
+	require.Nil(t, cspf)
+	require.Equal(t, keystore.ErrNilPassphraseProvider, err)
+}
+
 func TestNewCryptoSigningParamsLoader_NilSuiteShouldErr(t *testing.T) {
 	t.Parallel()
 
-	cspf, err := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", nil, false)
+	cspf, err := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", &mock.PassphraseProviderStub{}, nil, false)
 	require.Nil(t, cspf)
 	require.Equal(t, ErrNilSuite, err)
 }
@@ -28,7 +37,7 @@ func TestNewCryptoSigningParamsLoader_NilSuiteShouldErr(t *testing.T) {
 func TestNewCryptoSigningParamsLoader_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
-	cspf, err := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", &mock.SuiteStub{}, false)
+	cspf, err := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", &mock.PassphraseProviderStub{}, &mock.SuiteStub{}, false)
 	require.NoError(t, err)
 	require.NotNil(t, cspf)
 }
@@ -37,7 +46,7 @@ func TestCryptoSigningParamsLoader_Create_GetSkPkErrorsShouldErr(t *testing.T) {
 	t.Parallel()
 
 	expectedErr := errors.New("error while getting the sk and pk")
-	cspf, _ := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", &mock.SuiteStub{}, false)
+	cspf, _ := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", &mock.PassphraseProviderStub{}, &mock.SuiteStub{}, false)
 
 	cspf.SetSkPkProviderHandler(func() ([]byte, []byte, error) {
 		return nil, nil, expectedErr
@@ -70,7 +79,7 @@ func TestCryptoSigningParamsLoader_Create_PubKeyMissmatchShouldErr(t *testing.T)
 			}
 		},
 	}
-	cspf, _ := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", suite, false)
+	cspf, _ := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", &mock.PassphraseProviderStub{}, suite, false)
 
 	cspf.SetSkPkProviderHandler(func() ([]byte, []byte, error) {
 		return []byte("sk"), diffPubkey2, nil
@@ -103,7 +112,7 @@ func TestCryptoSigningParamsLoader_CreateShouldWork(t *testing.T) {
 			}
 		},
 	}
-	cspf, _ := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", suite, false)
+	cspf, _ := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", &mock.PassphraseProviderStub{}, suite, false)
 
 	cspf.SetSkPkProviderHandler(func() ([]byte, []byte, error) {
 		return []byte("sk"), pubKey, nil
@@ -116,7 +125,7 @@ func TestCryptoSigningParamsLoader_CreateShouldWork(t *testing.T) {
 func TestCryptoSigningParamsLoader_GetSkPk_PathNotFound(t *testing.T) {
 	t.Parallel()
 
-	cspf, _ := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", &mock.SuiteStub{}, false)
+	cspf, _ := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", &mock.PassphraseProviderStub{}, &mock.SuiteStub{}, false)
 	sk, pk, err := cspf.GetSkPk()
 	require.Error(t, err)
 	require.Nil(t, sk)
@@ -1,6 +1,7 @@
 package factory
 
 import (
+	"io"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -70,6 +71,22 @@ type CryptoComponents struct {
 	InitialPubKeys       map[uint32][]string
 	MessageSignVerifier  vm.MessageSignVerifier
 	PeerSignatureHandler crypto.PeerSignatureHandler
+	ManagedPeersHolder   ManagedPeersHolder
+	SigningSlotHandler   crypto.SigningSlotHandler
+	closers              []io.Closer
+}
+
+// Close stops the crypto components that run their own go routines
+func (cc *CryptoComponents) Close() error {
+	var lastErr error
+	for _, closer := range cc.closers {
+		err := closer.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
 }
 
 // NetworkComponents struct holds the network components
@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
@@ -36,6 +37,7 @@ type P2PAntifloodHandler interface {
 	SetMaxMessagesForTopic(topic string, maxNum uint32)
 	SetDebugger(debugger process.AntifloodDebugger) error
 	SetPeerValidatorMapper(validatorMapper process.PeerValidatorMapper) error
+	SetWebSocketPeersChecker(checker process.WebSocketPeersChecker) error
 	SetTopicsForAll(topics ...string)
 	ApplyConsensusSize(size int)
 	BlacklistPeer(peer core.PeerID, reason string, duration time.Duration)
@@ -63,3 +65,11 @@ type EconomicsHandler interface {
 	ComputeFeeForProcessing(tx process.TransactionWithFeeHandler, gasToUse uint64) *big.Int
 	IsInterfaceNil() bool
 }
+
+// ManagedPeersHolder defines the operations of the holder of the validator keys managed by the current node, which
+// can also be queried for the consensus activity of those keys
+type ManagedPeersHolder interface {
+	consensus.ManagedPeersHolder
+	AddManagedPeer(privateKeyBytes []byte) error
+	Query(search string) []string
+}
@@ -0,0 +1,20 @@
+package mock
+
+// PassphraseProviderStub -
+type PassphraseProviderStub struct {
+	PassphraseCalled func(fileName string) ([]byte, error)
+}
+
+// Passphrase -
+func (pps *PassphraseProviderStub) Passphrase(fileName string) ([]byte, error) {
+	if pps.PassphraseCalled != nil {
+		return pps.PassphraseCalled(fileName)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (pps *PassphraseProviderStub) IsInterfaceNil() bool {
+	return pps == nil
+}
@@ -89,6 +89,13 @@ func (ncf *networkComponentsFactory) Create() (*NetworkComponents, error) {
 		return nil, fmt.Errorf("%w when casting input antiflood handler to structs/P2PAntifloodHandler", ErrWrongTypeAssertion)
 	}
 
+	if ncf.p2pConfig.WebSocket.Enabled {
+		err = inputAntifloodHandler.SetWebSocketPeersChecker(netMessenger)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	outAntifloodHandler, errOutputAntiflood := antifloodFactory.NewP2POutputAntiFlood(ncf.mainConfig)
 	if errOutputAntiflood != nil {
 		return nil, errOutputAntiflood
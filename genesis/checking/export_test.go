@@ -0,0 +1,6 @@
+package checking
+
+// ComputeSmartContractAddress -
+func (gfc *genesisFilesChecker) ComputeSmartContractAddress(owner []byte, nonce uint64, vmType []byte) []byte {
+	return gfc.computeSmartContractAddress(owner, nonce, vmType)
+}
@@ -0,0 +1,640 @@
+package checking
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/genesis"
+	"github.com/ElrondNetwork/elrond-go/genesis/data"
+	"github.com/ElrondNetwork/elrond-go/genesis/parsing"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+const initialNodesMember = "initialNodes"
+
+// ArgsGenesisFilesChecker holds the arguments needed to create a genesis files checker
+type ArgsGenesisFilesChecker struct {
+	GenesisFilePath          string
+	SmartContractsFilePath   string
+	NodesSetupFilePath       string
+	EntireSupply             *big.Int
+	InitialNodePrice         *big.Int
+	AddressPubkeyConverter   core.PubkeyConverter
+	ValidatorPubkeyConverter core.PubkeyConverter
+	ValidatorKeyGenerator    crypto.KeyGenerator
+	Hasher                   hashing.Hasher
+}
+
+type genesisFilesChecker struct {
+	genesisFilePath          string
+	smartContractsFilePath   string
+	nodesSetupFilePath       string
+	entireSupply             *big.Int
+	initialNodePrice         *big.Int
+	addressPubkeyConverter   core.PubkeyConverter
+	validatorPubkeyConverter core.PubkeyConverter
+	validatorKeyGenerator    crypto.KeyGenerator
+	hasher                   hashing.Hasher
+}
+
+type jsonEntry struct {
+	line int
+	raw  json.RawMessage
+}
+
+// lineOf returns the line of a decoding error of the entry
+func (je *jsonEntry) lineOf(err error) int {
+	line := lineOfJsonError(je.raw, err)
+	if line == 0 {
+		return je.line
+	}
+
+	return je.line + line - 1
+}
+
+type problemsCollector struct {
+	problems GenesisProblems
+}
+
+func (pc *problemsCollector) add(file string, line int, err error) {
+	pc.problems = append(pc.problems, &GenesisProblem{
+		File: file,
+		Line: line,
+		Err:  err,
+	})
+}
+
+type stakedValue struct {
+	line     int
+	address  []byte
+	value    *big.Int
+	numNodes int64
+}
+
+type stakedValues map[string]*stakedValue
+
+func (sv stakedValues) add(address []byte, line int, value *big.Int) {
+	staked, ok := sv[string(address)]
+	if !ok {
+		staked = &stakedValue{
+			line:    line,
+			address: address,
+			value:   big.NewInt(0),
+		}
+		sv[string(address)] = staked
+	}
+
+	staked.value.Add(staked.value, value)
+}
+
+// NewGenesisFilesChecker creates a checker that validates the genesis accounts, the genesis smart contracts and the
+// nodes setup files together, reporting all the problems found instead of stopping at the first one
+func NewGenesisFilesChecker(args ArgsGenesisFilesChecker) (*genesisFilesChecker, error) {
+	if args.EntireSupply == nil {
+		return nil, genesis.ErrNilEntireSupply
+	}
+	if args.InitialNodePrice == nil {
+		return nil, genesis.ErrNilInitialNodePrice
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, fmt.Errorf("%w for address pubkey converter", genesis.ErrNilPubkeyConverter)
+	}
+	if check.IfNil(args.ValidatorPubkeyConverter) {
+		return nil, fmt.Errorf("%w for validator pubkey converter", genesis.ErrNilPubkeyConverter)
+	}
+	if check.IfNil(args.ValidatorKeyGenerator) {
+		return nil, genesis.ErrNilKeyGenerator
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, genesis.ErrNilHasher
+	}
+
+	return &genesisFilesChecker{
+		genesisFilePath:          args.GenesisFilePath,
+		smartContractsFilePath:   args.SmartContractsFilePath,
+		nodesSetupFilePath:       args.NodesSetupFilePath,
+		entireSupply:             args.EntireSupply,
+		initialNodePrice:         args.InitialNodePrice,
+		addressPubkeyConverter:   args.AddressPubkeyConverter,
+		validatorPubkeyConverter: args.ValidatorPubkeyConverter,
+		validatorKeyGenerator:    args.ValidatorKeyGenerator,
+		hasher:                   args.Hasher,
+	}, nil
+}
+
+// Check validates the genesis files and returns all the problems found as a GenesisProblems error. The smart
+// contracts file is optional: when it is not provided, the delegation addresses are not checked against the
+// addresses of the genesis delegation contracts
+func (gfc *genesisFilesChecker) Check() error {
+	collector := &problemsCollector{}
+
+	var delegationContracts map[string]struct{}
+	if len(gfc.smartContractsFilePath) > 0 {
+		delegationContracts = gfc.checkSmartContracts(collector)
+	}
+
+	staked, delegated, accountsOk := gfc.checkAccounts(collector, delegationContracts)
+	gfc.checkNodesSetup(collector, staked, delegated, accountsOk)
+
+	if len(collector.problems) == 0 {
+		return nil
+	}
+
+	return collector.problems
+}
+
+// readJsonArray returns the entries of the json array held by the file or by the provided member of the json
+// object held by the file, if the member is not empty
+func readJsonArray(collector *problemsCollector, filePath string, member string) ([]*jsonEntry, bool) {
+	buff, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		collector.add(filePath, 0, err)
+		return nil, false
+	}
+
+	offset := 0
+	arrayBuff := buff
+	if len(member) > 0 {
+		object := make(map[string]json.RawMessage)
+		err = json.Unmarshal(buff, &object)
+		if err != nil {
+			collector.add(filePath, lineOfJsonError(buff, err), fmt.Errorf("%w: %s", genesis.ErrInvalidJson, err.Error()))
+			return nil, false
+		}
+
+		var found bool
+		offset, found = objectMemberOffset(buff, 0, member)
+		if !found {
+			collector.add(filePath, 0, fmt.Errorf("%w: missing %s", genesis.ErrInvalidJson, member))
+			return nil, false
+		}
+		arrayBuff = object[member]
+	}
+
+	raws := make([]json.RawMessage, 0)
+	err = json.Unmarshal(arrayBuff, &raws)
+	if err != nil {
+		line := lineOfJsonError(arrayBuff, err)
+		if line > 0 {
+			line += lineOfOffset(buff, offset) - 1
+		}
+		collector.add(filePath, line, fmt.Errorf("%w: %s", genesis.ErrInvalidJson, err.Error()))
+		return nil, false
+	}
+
+	offsets := arrayElementsOffsets(buff, offset)
+	entries := make([]*jsonEntry, len(raws))
+	for i, raw := range raws {
+		entries[i] = &jsonEntry{
+			raw: raw,
+		}
+		if i < len(offsets) {
+			entries[i].line = lineOfOffset(buff, offsets[i])
+		}
+	}
+
+	return entries, true
+}
+
+// checkSmartContracts checks the genesis smart contracts and returns the addresses the delegation contracts will be
+// deployed at. The genesis contracts without the shard option, other than the DNS one, are deployed by their owners
+// in the order they are defined, so the address of an owner's n-th such contract is derived from the owner address
+// and the nonce n
+func (gfc *genesisFilesChecker) checkSmartContracts(collector *problemsCollector) map[string]struct{} {
+	file := gfc.smartContractsFilePath
+	delegationContracts := make(map[string]struct{})
+	entries, ok := readJsonArray(collector, file, "")
+	if !ok {
+		return delegationContracts
+	}
+
+	ownersNonces := make(map[string]uint64)
+	numDNSContracts := 0
+	for _, entry := range entries {
+		sc := &data.InitialSmartContract{}
+		err := json.Unmarshal(entry.raw, sc)
+		if err != nil {
+			collector.add(file, entry.lineOf(err), err)
+			continue
+		}
+
+		if sc.Type == genesis.DNSType {
+			numDNSContracts++
+			if numDNSContracts > 1 {
+				collector.add(file, entry.line, genesis.ErrTooManyDNSContracts)
+			}
+		}
+
+		if len(sc.Filename) > 0 {
+			gfc.checkSmartContractFile(collector, entry.line, sc.Filename)
+		}
+
+		ownerBytes, isOwnerOk := gfc.decodeAddress(collector, file, entry.line, sc.Owner,
+			genesis.ErrEmptyOwnerAddress, genesis.ErrInvalidOwnerAddress)
+		vmTypeBytes, isVmTypeOk := gfc.decodeVmType(collector, entry.line, sc.VmType)
+		isDeployedByOwner := sc.Type != genesis.DNSType && len(sc.Shard) == 0
+		if !isOwnerOk || !isVmTypeOk || !isDeployedByOwner {
+			if sc.Type == genesis.DelegationType && len(sc.Shard) > 0 {
+				collector.add(file, entry.line, fmt.Errorf("%w, the shard option is not supported for %s contracts",
+					genesis.ErrInvalidSmartContractShard, sc.Type))
+			}
+			continue
+		}
+
+		nonce := ownersNonces[string(ownerBytes)]
+		ownersNonces[string(ownerBytes)]++
+		if sc.Type != genesis.DelegationType {
+			continue
+		}
+
+		scAddress := gfc.computeSmartContractAddress(ownerBytes, nonce, vmTypeBytes)
+		delegationContracts[string(scAddress)] = struct{}{}
+	}
+
+	return delegationContracts
+}
+
+func (gfc *genesisFilesChecker) checkSmartContractFile(collector *problemsCollector, line int, filename string) {
+	info, err := os.Stat(filename)
+	if err != nil {
+		collector.add(gfc.smartContractsFilePath, line, fmt.Errorf("%w for the file %s", err, filename))
+		return
+	}
+	if info.IsDir() {
+		collector.add(gfc.smartContractsFilePath, line, fmt.Errorf("%w for the file %s", genesis.ErrFilenameIsDirectory, filename))
+	}
+}
+
+func (gfc *genesisFilesChecker) decodeVmType(collector *problemsCollector, line int, vmType string) ([]byte, bool) {
+	if len(vmType) == 0 {
+		collector.add(gfc.smartContractsFilePath, line, genesis.ErrEmptyVmType)
+		return nil, false
+	}
+
+	vmTypeBytes, err := hex.DecodeString(vmType)
+	if err != nil || len(vmTypeBytes) != core.VMTypeLen {
+		collector.add(gfc.smartContractsFilePath, line, fmt.Errorf("%w for provided %s", genesis.ErrInvalidVmType, vmType))
+		return nil, false
+	}
+
+	return vmTypeBytes, true
+}
+
+// computeSmartContractAddress derives the address of a deployed smart contract in the same way the blockchain hook
+// does: the hash of the creator address and nonce, prefixed by the vm type and suffixed by the creator shard bytes
+func (gfc *genesisFilesChecker) computeSmartContractAddress(owner []byte, nonce uint64, vmType []byte) []byte {
+	buffNonce := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buffNonce, nonce)
+	ownerAndNonce := append(append(make([]byte, 0, len(owner)+len(buffNonce)), owner...), buffNonce...)
+	scAddress := gfc.hasher.Compute(string(ownerAndNonce))
+
+	prefixMask := append(make([]byte, core.NumInitCharactersForScAddress-core.VMTypeLen), vmType...)
+	copy(scAddress[:core.NumInitCharactersForScAddress], prefixMask)
+	copy(scAddress[len(scAddress)-core.ShardIdentiferLen:], owner[len(owner)-core.ShardIdentiferLen:])
+
+	return scAddress
+}
+
+func (gfc *genesisFilesChecker) decodeAddress(
+	collector *problemsCollector,
+	file string,
+	line int,
+	address string,
+	errEmpty error,
+	errInvalid error,
+) ([]byte, bool) {
+	if len(address) == 0 {
+		collector.add(file, line, errEmpty)
+		return nil, false
+	}
+
+	addressBytes, err := gfc.addressPubkeyConverter.Decode(address)
+	if err != nil || len(addressBytes) != gfc.addressPubkeyConverter.Len() {
+		collector.add(file, line, fmt.Errorf("%w for `%s`", errInvalid, address))
+		return nil, false
+	}
+
+	return addressBytes, true
+}
+
+// checkAccounts checks the genesis accounts and returns the values staked directly and through the delegation
+// contracts, keyed by the staking addresses, together with a flag telling if the accounts file could be parsed
+func (gfc *genesisFilesChecker) checkAccounts(
+	collector *problemsCollector,
+	delegationContracts map[string]struct{},
+) (stakedValues, stakedValues, bool) {
+	file := gfc.genesisFilePath
+	accCheck := &accountsCheck{
+		delegationContracts: delegationContracts,
+		addressesLines:      make(map[string]int),
+		totalSupply:         big.NewInt(0),
+		staked:              make(stakedValues),
+		delegated:           make(stakedValues),
+	}
+
+	if parsing.IsLineDelimitedAccountsFile(file) {
+		err := parsing.ReadInitialAccounts(file, func(account *data.InitialAccount, position parsing.RecordPosition, errDecode error) error {
+			if errDecode != nil {
+				collector.add(file, position.Line, errDecode)
+				return nil
+			}
+
+			gfc.checkAccount(collector, accCheck, account, position.Line)
+			return nil
+		})
+		if err != nil {
+			collector.add(file, 0, err)
+			return accCheck.staked, accCheck.delegated, false
+		}
+	} else {
+		entries, ok := readJsonArray(collector, file, "")
+		if !ok {
+			return accCheck.staked, accCheck.delegated, false
+		}
+
+		for _, entry := range entries {
+			account := &data.InitialAccount{}
+			err := json.Unmarshal(entry.raw, account)
+			if err != nil {
+				collector.add(file, entry.lineOf(err), err)
+				continue
+			}
+
+			gfc.checkAccount(collector, accCheck, account, entry.line)
+		}
+	}
+
+	if accCheck.totalSupply.Cmp(gfc.entireSupply) != 0 {
+		collector.add(file, 0, fmt.Errorf("%w for entire supply provided %s, computed %s",
+			genesis.ErrEntireSupplyMismatch, gfc.entireSupply.String(), accCheck.totalSupply.String()))
+	}
+
+	return accCheck.staked, accCheck.delegated, true
+}
+
+// accountsCheck holds the state of the accounts check, updated as the accounts are checked one by one
+type accountsCheck struct {
+	delegationContracts map[string]struct{}
+	addressesLines      map[string]int
+	totalSupply         *big.Int
+	staked              stakedValues
+	delegated           stakedValues
+}
+
+func (gfc *genesisFilesChecker) checkAccount(
+	collector *problemsCollector,
+	accCheck *accountsCheck,
+	account *data.InitialAccount,
+	line int,
+) {
+	file := gfc.genesisFilePath
+	addressBytes, isAddressOk := gfc.decodeAddress(collector, file, line, account.Address,
+		genesis.ErrEmptyAddress, genesis.ErrInvalidAddress)
+	if isAddressOk {
+		firstLine, isDuplicate := accCheck.addressesLines[string(addressBytes)]
+		if isDuplicate {
+			collector.add(file, line, fmt.Errorf("%w found for '%s', also defined at line %d",
+				genesis.ErrDuplicateAddress, account.Address, firstLine))
+		}
+		accCheck.addressesLines[string(addressBytes)] = line
+
+		if core.IsSmartContractAddress(addressBytes) {
+			collector.add(file, line, fmt.Errorf("%w for address %s", genesis.ErrAddressIsSmartContract, account.Address))
+		}
+	}
+
+	if !gfc.checkAccountValues(collector, line, account) {
+		return
+	}
+	accCheck.totalSupply.Add(accCheck.totalSupply, account.Supply)
+
+	if isAddressOk && account.StakingValue.Sign() > 0 {
+		accCheck.staked.add(addressBytes, line, account.StakingValue)
+	}
+
+	delegationsLines := make(map[string]struct{})
+	for _, delegation := range account.Delegations {
+		if delegation.Value.Sign() == 0 {
+			continue
+		}
+
+		delegationBytes, isDelegationOk := gfc.decodeAddress(collector, file, line, delegation.Address,
+			genesis.ErrEmptyDelegationAddress, genesis.ErrInvalidDelegationAddress)
+		if !isDelegationOk {
+			continue
+		}
+
+		_, isDuplicate := delegationsLines[string(delegationBytes)]
+		if isDuplicate {
+			collector.add(file, line, fmt.Errorf("%w '%s' for address %s",
+				genesis.ErrDuplicateDelegationAddress, delegation.Address, account.Address))
+			continue
+		}
+		delegationsLines[string(delegationBytes)] = struct{}{}
+
+		_, isDeployed := accCheck.delegationContracts[string(delegationBytes)]
+		if accCheck.delegationContracts != nil && !isDeployed {
+			collector.add(file, line, fmt.Errorf("%w for delegation address %s, address %s",
+				genesis.ErrMissingDeployedSC, delegation.Address, account.Address))
+		}
+		accCheck.delegated.add(delegationBytes, line, delegation.Value)
+	}
+}
+
+func (gfc *genesisFilesChecker) checkAccountValues(
+	collector *problemsCollector,
+	line int,
+	account *data.InitialAccount,
+) bool {
+	file := gfc.genesisFilePath
+	isOk := true
+	if account.Supply.Sign() <= 0 {
+		collector.add(file, line, fmt.Errorf("%w for '%s', address %s", genesis.ErrInvalidSupply, account.Supply, account.Address))
+		isOk = false
+	}
+	if account.Balance.Sign() < 0 {
+		collector.add(file, line, fmt.Errorf("%w for '%s', address %s", genesis.ErrInvalidBalance, account.Balance, account.Address))
+		isOk = false
+	}
+	if account.StakingValue.Sign() < 0 {
+		collector.add(file, line, fmt.Errorf("%w for '%s', address %s",
+			genesis.ErrInvalidStakingBalance, account.StakingValue, account.Address))
+		isOk = false
+	}
+	sum := big.NewInt(0)
+	sum.Add(sum, account.Balance)
+	sum.Add(sum, account.StakingValue)
+	for _, delegation := range account.Delegations {
+		if delegation.Value.Sign() < 0 {
+			collector.add(file, line, fmt.Errorf("%w for '%s', address %s",
+				genesis.ErrInvalidDelegationValue, delegation.Value, account.Address))
+			isOk = false
+		}
+		sum.Add(sum, delegation.Value)
+	}
+	gfc.checkVesting(collector, line, account)
+	if !isOk {
+		return false
+	}
+
+	if account.Supply.Cmp(sum) != 0 {
+		collector.add(file, line, fmt.Errorf("%w for address %s, provided %s, computed %s",
+			genesis.ErrSupplyMismatch, account.Address, account.Supply.String(), sum.String()))
+		return false
+	}
+
+	return true
+}
+
+// checkVesting reports the invalid vesting schedules and those locking more than the genesis balance. The vesting
+// problems do not affect the computed supply
+func (gfc *genesisFilesChecker) checkVesting(collector *problemsCollector, line int, account *data.InitialAccount) {
+	if account.Vesting == nil {
+		return
+	}
+
+	file := gfc.genesisFilePath
+	if account.Vesting.Value == nil {
+		collector.add(file, line, fmt.Errorf("%w: missing value, address %s", genesis.ErrInvalidVestingSchedule, account.Address))
+		return
+	}
+	err := account.Vesting.Schedule().Check()
+	if err != nil {
+		collector.add(file, line, fmt.Errorf("%w: %v, address %s", genesis.ErrInvalidVestingSchedule, err, account.Address))
+		return
+	}
+	if account.Vesting.Value.Cmp(account.Balance) > 0 {
+		collector.add(file, line, fmt.Errorf("%w: locked value %s is greater than the balance %s, address %s",
+			genesis.ErrInvalidVestingSchedule, account.Vesting.Value.String(), account.Balance.String(), account.Address))
+	}
+}
+
+// checkNodesSetup checks the initial nodes and, if the accounts file could be parsed, that each node is backed by
+// exactly one node price, staked either directly by its address or through the delegation contract at its address
+func (gfc *genesisFilesChecker) checkNodesSetup(
+	collector *problemsCollector,
+	staked stakedValues,
+	delegated stakedValues,
+	checkStake bool,
+) {
+	file := gfc.nodesSetupFilePath
+	entries, ok := readJsonArray(collector, file, initialNodesMember)
+	if !ok {
+		return
+	}
+
+	pubKeysLines := make(map[string]int)
+	for _, entry := range entries {
+		node := &sharding.InitialNode{}
+		err := json.Unmarshal(entry.raw, node)
+		if err != nil {
+			collector.add(file, entry.lineOf(err), err)
+			continue
+		}
+
+		gfc.checkNodePubKey(collector, entry.line, node.PubKey, pubKeysLines)
+
+		addressBytes, isAddressOk := gfc.decodeAddress(collector, file, entry.line, node.Address,
+			genesis.ErrEmptyAddress, genesis.ErrInvalidAddress)
+		if !isAddressOk || !checkStake {
+			continue
+		}
+
+		stakedValue, isStaked := staked[string(addressBytes)]
+		if !isStaked {
+			stakedValue, isStaked = delegated[string(addressBytes)]
+		}
+		if !isStaked {
+			collector.add(file, entry.line, fmt.Errorf("%w for node pubkey %s, address %s",
+				genesis.ErrNodeNotStaked, node.PubKey, node.Address))
+			continue
+		}
+
+		stakedValue.numNodes++
+	}
+
+	if !checkStake {
+		return
+	}
+
+	gfc.checkStakedValues(collector, staked, genesis.ErrInvalidStakingBalance, "staking")
+	gfc.checkStakedValues(collector, delegated, genesis.ErrInvalidDelegationValue, "delegation")
+}
+
+func (gfc *genesisFilesChecker) checkNodePubKey(
+	collector *problemsCollector,
+	line int,
+	pubKey string,
+	pubKeysLines map[string]int,
+) {
+	file := gfc.nodesSetupFilePath
+	if len(pubKey) == 0 {
+		collector.add(file, line, genesis.ErrEmptyPubKey)
+		return
+	}
+
+	pubKeyBytes, err := gfc.validatorPubkeyConverter.Decode(pubKey)
+	if err != nil {
+		collector.add(file, line, fmt.Errorf("%w for node's public key `%s`, error: %s", genesis.ErrInvalidPubKey, pubKey, err.Error()))
+		return
+	}
+	err = gfc.validatorKeyGenerator.CheckPublicKeyValid(pubKeyBytes)
+	if err != nil {
+		collector.add(file, line, fmt.Errorf("%w for node's public key `%s`, error: %s", genesis.ErrInvalidPubKey, pubKey, err.Error()))
+		return
+	}
+
+	firstLine, isDuplicate := pubKeysLines[string(pubKeyBytes)]
+	if isDuplicate {
+		collector.add(file, line, fmt.Errorf("%w found for '%s', also defined at line %d",
+			genesis.ErrDuplicatePubKey, pubKey, firstLine))
+		return
+	}
+	pubKeysLines[string(pubKeyBytes)] = line
+}
+
+func (gfc *genesisFilesChecker) checkStakedValues(
+	collector *problemsCollector,
+	values stakedValues,
+	errMismatch error,
+	kind string,
+) {
+	sortedValues := make([]*stakedValue, 0, len(values))
+	for _, staked := range values {
+		sortedValues = append(sortedValues, staked)
+	}
+	sort.Slice(sortedValues, func(i, j int) bool {
+		return sortedValues[i].line < sortedValues[j].line
+	})
+
+	for _, staked := range sortedValues {
+		required := big.NewInt(0).Mul(gfc.initialNodePrice, big.NewInt(staked.numNodes))
+		if staked.value.Cmp(required) == 0 {
+			continue
+		}
+
+		collector.add(gfc.genesisFilePath, staked.line, fmt.Errorf("%w for %s address %s, provided %s, required %s for %d node(s)",
+			errMismatch,
+			kind,
+			gfc.addressPubkeyConverter.Encode(staked.address),
+			staked.value.String(),
+			required.String(),
+			staked.numNodes,
+		))
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gfc *genesisFilesChecker) IsInterfaceNil() bool {
+	return gfc == nil
+}
@@ -0,0 +1,230 @@
+package checking_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/genesis"
+	"github.com/ElrondNetwork/elrond-go/genesis/checking"
+	"github.com/ElrondNetwork/elrond-go/genesis/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ownerAddress     = strings.Repeat("a1", 32)
+	delegatorAddress = strings.Repeat("a2", 32)
+	validatorKey1    = strings.Repeat("b1", 96)
+	validatorKey2    = strings.Repeat("b2", 96)
+	validatorKey3    = strings.Repeat("b3", 96)
+)
+
+func createMockArgsGenesisFilesChecker(dir string) checking.ArgsGenesisFilesChecker {
+	return checking.ArgsGenesisFilesChecker{
+		GenesisFilePath:          filepath.Join(dir, "genesis.json"),
+		SmartContractsFilePath:   filepath.Join(dir, "genesisSmartContracts.json"),
+		NodesSetupFilePath:       filepath.Join(dir, "nodesSetup.json"),
+		EntireSupply:             big.NewInt(2000),
+		InitialNodePrice:         big.NewInt(100),
+		AddressPubkeyConverter:   mock.NewPubkeyConverterMock(32),
+		ValidatorPubkeyConverter: mock.NewPubkeyConverterMock(96),
+		ValidatorKeyGenerator:    &mock.KeyGeneratorStub{},
+		Hasher:                   &mock.HasherMock{},
+	}
+}
+
+func writeGenesisFiles(t *testing.T, dir string, genesisLines []string, nodesLines []string) {
+	contractFile := filepath.Join(dir, "delegation.wasm")
+	require.Nil(t, ioutil.WriteFile(contractFile, []byte("code"), 0644))
+
+	smartContracts := fmt.Sprintf(`[
+  {"owner": "%s", "filename": "%s", "vm-type": "0500", "init-parameters": "", "type": "delegation", "version": "0.4.*"}
+]`, ownerAddress, contractFile)
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "genesisSmartContracts.json"), []byte(smartContracts), 0644))
+
+	genesisContent := "[\n" + strings.Join(genesisLines, ",\n") + "\n]"
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "genesis.json"), []byte(genesisContent), 0644))
+
+	nodesContent := "{\n  \"consensusGroupSize\": 1,\n  \"initialNodes\": [\n" + strings.Join(nodesLines, ",\n") + "\n  ]\n}"
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "nodesSetup.json"), []byte(nodesContent), 0644))
+}
+
+func delegationContractAddress(t *testing.T, args checking.ArgsGenesisFilesChecker) string {
+	gfc, err := checking.NewGenesisFilesChecker(args)
+	require.Nil(t, err)
+
+	owner, _ := hex.DecodeString(ownerAddress)
+	return hex.EncodeToString(gfc.ComputeSmartContractAddress(owner, 0, []byte{5, 0}))
+}
+
+func accountLine(address string, supply string, balance string, staking string, delegation string, value string) string {
+	return fmt.Sprintf(`  {"address": "%s", "supply": "%s", "balance": "%s", "stakingvalue": "%s", "delegation": {"address": "%s", "value": "%s"}}`,
+		address, supply, balance, staking, delegation, value)
+}
+
+func nodeLine(pubKey string, address string) string {
+	return fmt.Sprintf(`    {"pubkey": "%s", "address": "%s"}`, pubKey, address)
+}
+
+func TestNewGenesisFilesChecker_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGenesisFilesChecker("")
+	args.EntireSupply = nil
+	gfc, err := checking.NewGenesisFilesChecker(args)
+	assert.True(t, check.IfNil(gfc))
+	assert.Equal(t, genesis.ErrNilEntireSupply, err)
+
+	args = createMockArgsGenesisFilesChecker("")
+	args.InitialNodePrice = nil
+	_, err = checking.NewGenesisFilesChecker(args)
+	assert.Equal(t, genesis.ErrNilInitialNodePrice, err)
+
+	args = createMockArgsGenesisFilesChecker("")
+	args.ValidatorPubkeyConverter = nil
+	_, err = checking.NewGenesisFilesChecker(args)
+	assert.True(t, errors.Is(err, genesis.ErrNilPubkeyConverter))
+
+	args = createMockArgsGenesisFilesChecker("")
+	args.ValidatorKeyGenerator = nil
+	_, err = checking.NewGenesisFilesChecker(args)
+	assert.Equal(t, genesis.ErrNilKeyGenerator, err)
+
+	args = createMockArgsGenesisFilesChecker("")
+	args.Hasher = nil
+	_, err = checking.NewGenesisFilesChecker(args)
+	assert.Equal(t, genesis.ErrNilHasher, err)
+}
+
+func TestGenesisFilesChecker_CheckValidFilesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "genesisFilesChecker")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockArgsGenesisFilesChecker(dir)
+	delegationAddress := delegationContractAddress(t, args)
+	writeGenesisFiles(t, dir,
+		[]string{
+			accountLine(ownerAddress, "1000", "900", "100", "", "0"),
+			accountLine(delegatorAddress, "1000", "800", "0", delegationAddress, "200"),
+		},
+		[]string{
+			nodeLine(validatorKey1, ownerAddress),
+			nodeLine(validatorKey2, delegationAddress),
+			nodeLine(validatorKey3, delegationAddress),
+		},
+	)
+
+	gfc, _ := checking.NewGenesisFilesChecker(args)
+	assert.False(t, check.IfNil(gfc))
+	assert.Nil(t, gfc.Check())
+}
+
+func TestGenesisFilesChecker_CheckReportsAllProblems(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "genesisFilesChecker")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockArgsGenesisFilesChecker(dir)
+	delegationAddress := delegationContractAddress(t, args)
+	writeGenesisFiles(t, dir,
+		[]string{
+			accountLine(ownerAddress, "1000", "900", "100", "", "0"),
+			accountLine(delegatorAddress, "1000", "800", "0", delegationAddress, "200"),
+			accountLine(ownerAddress, "10", "10", "0", "", "0"),
+			accountLine("not an address", "10", "10", "0", "", "0"),
+			accountLine(strings.Repeat("a3", 32), "10", "5", "0", "", "0"),
+			accountLine(strings.Repeat("a4", 32), "10", "0", "0", strings.Repeat("a5", 32), "10"),
+		},
+		[]string{
+			nodeLine(validatorKey1, ownerAddress),
+			nodeLine(validatorKey2, delegationAddress),
+			nodeLine(validatorKey2, delegationAddress),
+			nodeLine("zz", delegatorAddress),
+		},
+	)
+
+	gfc, _ := checking.NewGenesisFilesChecker(args)
+	err = gfc.Check()
+	require.NotNil(t, err)
+	assert.True(t, errors.Is(err, genesis.ErrInvalidGenesisFiles))
+
+	problems, ok := err.(checking.GenesisProblems)
+	require.True(t, ok)
+
+	genesisFile := args.GenesisFilePath
+	nodesFile := args.NodesSetupFilePath
+	expected := []struct {
+		file string
+		line int
+		err  error
+	}{
+		{genesisFile, 4, genesis.ErrDuplicateAddress},
+		{genesisFile, 5, genesis.ErrInvalidAddress},
+		{genesisFile, 6, genesis.ErrSupplyMismatch},
+		{genesisFile, 7, genesis.ErrMissingDeployedSC},
+		{genesisFile, 0, genesis.ErrEntireSupplyMismatch},
+		{nodesFile, 6, genesis.ErrDuplicatePubKey},
+		{nodesFile, 7, genesis.ErrInvalidPubKey},
+		{nodesFile, 7, genesis.ErrNodeNotStaked},
+		{genesisFile, 7, genesis.ErrInvalidDelegationValue},
+	}
+	require.Equal(t, len(expected), len(problems), err.Error())
+	for i, exp := range expected {
+		assert.Equal(t, exp.file, problems[i].File, problems[i].String())
+		assert.Equal(t, exp.line, problems[i].Line, problems[i].String())
+		assert.True(t, errors.Is(problems[i].Err, exp.err), problems[i].String())
+	}
+}
+
+func TestGenesisFilesChecker_CheckInvalidJsonShouldReportTheLine(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "genesisFilesChecker")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockArgsGenesisFilesChecker(dir)
+	writeGenesisFiles(t, dir,
+		[]string{
+			accountLine(ownerAddress, "1000", "900", "100", "", "0"),
+			`  {"address": "` + delegatorAddress + `", "supply": "1x0"}`,
+			`  {"address": }`,
+		},
+		[]string{
+			nodeLine(validatorKey1, ownerAddress),
+			`    {"pubkey": 5, "address": "` + ownerAddress + `"}`,
+		},
+	)
+
+	gfc, _ := checking.NewGenesisFilesChecker(args)
+	err = gfc.Check()
+	problems, ok := err.(checking.GenesisProblems)
+	require.True(t, ok)
+	require.Equal(t, 2, len(problems), err.Error())
+
+	assert.Equal(t, args.GenesisFilePath, problems[0].File)
+	assert.Equal(t, 4, problems[0].Line)
+	assert.True(t, errors.Is(problems[0].Err, genesis.ErrInvalidJson))
+
+	assert.Equal(t, args.NodesSetupFilePath, problems[1].File)
+	assert.Equal(t, 5, problems[1].Line)
+}
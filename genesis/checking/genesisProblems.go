@@ -0,0 +1,43 @@
+package checking
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/genesis"
+)
+
+// GenesisProblem describes a problem found in a genesis file. The line is 0 if the problem is not tied to an entry
+type GenesisProblem struct {
+	File string
+	Line int
+	Err  error
+}
+
+// String returns the problem prefixed by its location
+func (gp *GenesisProblem) String() string {
+	if gp.Line == 0 {
+		return fmt.Sprintf("%s: %s", gp.File, gp.Err.Error())
+	}
+
+	return fmt.Sprintf("%s:%d: %s", gp.File, gp.Line, gp.Err.Error())
+}
+
+// GenesisProblems is the error holding all the problems found while checking the genesis files
+type GenesisProblems []*GenesisProblem
+
+// Error returns all the problems found, one per line
+func (gps GenesisProblems) Error() string {
+	lines := make([]string, 0, len(gps)+1)
+	lines = append(lines, fmt.Sprintf("%s: %d problem(s) found", genesis.ErrInvalidGenesisFiles.Error(), len(gps)))
+	for _, problem := range gps {
+		lines = append(lines, "\t"+problem.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Unwrap returns ErrInvalidGenesisFiles, so the error can be tested with errors.Is
+func (gps GenesisProblems) Unwrap() error {
+	return genesis.ErrInvalidGenesisFiles
+}
@@ -0,0 +1,164 @@
+package checking
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// the helpers below locate values inside already validated json documents, so the problems found in a genesis file
+// can be reported together with the line of the offending entry
+
+// lineOfOffset returns the 1-based line of the provided byte offset
+func lineOfOffset(buff []byte, offset int) int {
+	if offset > len(buff) {
+		offset = len(buff)
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	return bytes.Count(buff[:offset], []byte("\n")) + 1
+}
+
+// lineOfJsonError returns the line of a json decoding error, or 0 if the error does not hold an offset
+func lineOfJsonError(buff []byte, err error) int {
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return lineOfOffset(buff, int(syntaxErr.Offset))
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return lineOfOffset(buff, int(typeErr.Offset))
+	}
+
+	return 0
+}
+
+func skipWhitespaces(buff []byte, offset int) int {
+	for offset < len(buff) {
+		switch buff[offset] {
+		case ' ', '\t', '\n', '\r':
+			offset++
+		default:
+			return offset
+		}
+	}
+
+	return offset
+}
+
+// skipString returns the offset after the string starting at the provided offset
+func skipString(buff []byte, offset int) int {
+	for offset++; offset < len(buff); offset++ {
+		switch buff[offset] {
+		case '\\':
+			offset++
+		case '"':
+			return offset + 1
+		}
+	}
+
+	return offset
+}
+
+// skipValue returns the offset after the json value starting at the provided offset
+func skipValue(buff []byte, offset int) int {
+	if offset >= len(buff) {
+		return offset
+	}
+
+	switch buff[offset] {
+	case '"':
+		return skipString(buff, offset)
+	case '{', '[':
+		depth := 0
+		for offset < len(buff) {
+			switch buff[offset] {
+			case '"':
+				offset = skipString(buff, offset)
+				continue
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+				if depth == 0 {
+					return offset + 1
+				}
+			}
+			offset++
+		}
+		return offset
+	default:
+		for offset < len(buff) {
+			switch buff[offset] {
+			case ',', '}', ']', ' ', '\t', '\n', '\r':
+				return offset
+			}
+			offset++
+		}
+		return offset
+	}
+}
+
+// arrayElementsOffsets returns the offsets of the elements of the json array starting at the provided offset
+func arrayElementsOffsets(buff []byte, offset int) []int {
+	offsets := make([]int, 0)
+	offset = skipWhitespaces(buff, offset)
+	if offset >= len(buff) || buff[offset] != '[' {
+		return offsets
+	}
+
+	offset++
+	for {
+		offset = skipWhitespaces(buff, offset)
+		if offset >= len(buff) || buff[offset] == ']' {
+			return offsets
+		}
+
+		offsets = append(offsets, offset)
+		offset = skipWhitespaces(buff, skipValue(buff, offset))
+		if offset < len(buff) && buff[offset] == ',' {
+			offset++
+		}
+	}
+}
+
+// objectMemberOffset returns the offset of the value of the provided member of the json object starting at the
+// provided offset
+func objectMemberOffset(buff []byte, offset int, member string) (int, bool) {
+	offset = skipWhitespaces(buff, offset)
+	if offset >= len(buff) || buff[offset] != '{' {
+		return 0, false
+	}
+
+	offset++
+	for {
+		offset = skipWhitespaces(buff, offset)
+		if offset >= len(buff) || buff[offset] != '"' {
+			return 0, false
+		}
+
+		keyEnd := skipString(buff, offset)
+		var key string
+		err := json.Unmarshal(buff[offset:keyEnd], &key)
+		if err != nil {
+			return 0, false
+		}
+
+		offset = skipWhitespaces(buff, keyEnd)
+		if offset >= len(buff) || buff[offset] != ':' {
+			return 0, false
+		}
+		offset = skipWhitespaces(buff, offset+1)
+		if key == member {
+			return offset, true
+		}
+
+		offset = skipWhitespaces(buff, skipValue(buff, offset))
+		if offset < len(buff) && buff[offset] == ',' {
+			offset++
+		}
+	}
+}
@@ -137,25 +137,26 @@ func (nsc *nodeSetupChecker) subtractStakedValue(
 			return nil
 		}
 
-		dh := ia.GetDelegationHandler()
-		if check.IfNil(dh) {
-			return genesis.ErrNilDelegationHandler
-		}
-		if !bytes.Equal(dh.AddressBytes(), addressBytes) {
-			continue
-		}
+		for _, dh := range ia.GetDelegationHandlers() {
+			if check.IfNil(dh) {
+				return genesis.ErrNilDelegationHandler
+			}
+			if !bytes.Equal(dh.AddressBytes(), addressBytes) {
+				continue
+			}
 
-		addr, ok := delegated[string(dh.AddressBytes())]
-		if !ok {
-			continue
-		}
+			addr, ok := delegated[string(dh.AddressBytes())]
+			if !ok {
+				continue
+			}
 
-		addr.value.Sub(addr.value, nsc.initialNodePrice)
-		if addr.value.Cmp(zero) < 0 {
-			return genesis.ErrDelegationValueIsNotEnough
-		}
+			addr.value.Sub(addr.value, nsc.initialNodePrice)
+			if addr.value.Cmp(zero) < 0 {
+				return genesis.ErrDelegationValueIsNotEnough
+			}
 
-		return nil
+			return nil
+		}
 	}
 
 	return genesis.ErrNodeNotStaked
@@ -193,27 +194,28 @@ func (nsc *nodeSetupChecker) createDelegatedValues(initialAccounts []genesis.Ini
 	delegated := make(map[string]*delegationAddress)
 
 	for _, ia := range initialAccounts {
-		delegation := ia.GetDelegationHandler()
-		if check.IfNil(delegation) {
-			continue
-		}
-		delegationAddressBytes := delegation.AddressBytes()
-		if len(delegationAddressBytes) == 0 {
-			continue
-		}
+		for _, delegation := range ia.GetDelegationHandlers() {
+			if check.IfNil(delegation) {
+				continue
+			}
+			delegationAddressBytes := delegation.AddressBytes()
+			if len(delegationAddressBytes) == 0 {
+				continue
+			}
 
-		delegatedAddr := delegated[string(delegationAddressBytes)]
-		if delegatedAddr == nil {
-			delegatedAddr = &delegationAddress{
-				address: delegation.GetAddress(),
-				value:   big.NewInt(0),
+			delegatedAddr := delegated[string(delegationAddressBytes)]
+			if delegatedAddr == nil {
+				delegatedAddr = &delegationAddress{
+					address: delegation.GetAddress(),
+					value:   big.NewInt(0),
+				}
+
+				delegated[string(delegationAddressBytes)] = delegatedAddr
 			}
 
-			delegated[string(delegationAddressBytes)] = delegatedAddr
+			delegatedAddr.value.Add(delegatedAddr.value, delegation.GetValue())
+			delegation.GetValue().SetUint64(0)
 		}
-
-		delegatedAddr.value.Add(delegatedAddr.value, delegation.GetValue())
-		delegation.GetValue().SetUint64(0)
 	}
 
 	return delegated
@@ -20,10 +20,10 @@ func createEmptyInitialAccount() *data.InitialAccount {
 		Supply:       big.NewInt(0),
 		Balance:      big.NewInt(0),
 		StakingValue: big.NewInt(0),
-		Delegation: &data.DelegationData{
+		Delegations: []*data.DelegationData{{
 			Address: "",
 			Value:   big.NewInt(0),
-		},
+		}},
 	}
 }
 
@@ -249,8 +249,8 @@ func TestNewNodeSetupChecker_CheckNotEnoughDelegatedShouldErr(t *testing.T) {
 
 	nodePrice := big.NewInt(32)
 	ia := createEmptyInitialAccount()
-	ia.Delegation.SetAddressBytes([]byte("delegated address"))
-	ia.Delegation.Value = big.NewInt(0).Set(nodePrice)
+	ia.Delegations[0].SetAddressBytes([]byte("delegated address"))
+	ia.Delegations[0].Value = big.NewInt(0).Set(nodePrice)
 
 	nsc, _ := checking.NewNodesSetupChecker(
 		&mock.AccountsParserStub{
@@ -281,8 +281,8 @@ func TestNewNodeSetupChecker_CheckTooMuchDelegatedShouldErr(t *testing.T) {
 
 	nodePrice := big.NewInt(32)
 	ia := createEmptyInitialAccount()
-	ia.Delegation.SetAddressBytes([]byte("delegated address"))
-	ia.Delegation.Value = big.NewInt(0).Set(nodePrice)
+	ia.Delegations[0].SetAddressBytes([]byte("delegated address"))
+	ia.Delegations[0].Value = big.NewInt(0).Set(nodePrice)
 
 	nsc, _ := checking.NewNodesSetupChecker(
 		&mock.AccountsParserStub{
@@ -317,8 +317,8 @@ func TestNewNodeSetupChecker_CheckStakedAndDelegatedShouldWork(t *testing.T) {
 	iaStaked.SetAddressBytes([]byte("staked address"))
 
 	iaDelegated := createEmptyInitialAccount()
-	iaDelegated.Delegation.Value = big.NewInt(0).Set(nodePrice)
-	iaDelegated.Delegation.SetAddressBytes([]byte("delegated address"))
+	iaDelegated.Delegations[0].Value = big.NewInt(0).Set(nodePrice)
+	iaDelegated.Delegations[0].SetAddressBytes([]byte("delegated address"))
 
 	nsc, _ := checking.NewNodesSetupChecker(
 		&mock.AccountsParserStub{
@@ -349,5 +349,5 @@ func TestNewNodeSetupChecker_CheckStakedAndDelegatedShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	//the following 2 asserts assure that the original values did not changed
 	assert.Equal(t, nodePrice, iaStaked.StakingValue)
-	assert.Equal(t, nodePrice, iaDelegated.Delegation.Value)
+	assert.Equal(t, nodePrice, iaDelegated.Delegations[0].Value)
 }
@@ -1,6 +1,7 @@
 package data
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -98,3 +99,30 @@ func (dd *DelegationData) GetValue() *big.Int {
 func (dd *DelegationData) IsInterfaceNil() bool {
 	return dd == nil
 }
+
+// unmarshalDelegations decodes the delegation member of a genesis entry, holding either one delegation object or a
+// list of delegation objects
+func unmarshalDelegations(data json.RawMessage) ([]*DelegationData, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return make([]*DelegationData, 0), nil
+	}
+
+	if trimmed[0] != '[' {
+		delegation := &DelegationData{}
+		err := json.Unmarshal(trimmed, delegation)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*DelegationData{delegation}, nil
+	}
+
+	delegations := make([]*DelegationData, 0)
+	err := json.Unmarshal(trimmed, &delegations)
+	if err != nil {
+		return nil, err
+	}
+
+	return delegations, nil
+}
@@ -5,16 +5,22 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/core/vesting"
 	"github.com/ElrondNetwork/elrond-go/genesis"
 )
 
-// InitialAccount provides information about one entry in the genesis file
+// InitialAccount provides information about one entry in the genesis file. The delegation member of the json entry
+// holds either one {address, value} object or a list of such objects, when the account delegates to more than one
+// delegation contract. The optional vesting member locks a part of the balance according to a vesting schedule. The
+// mintable accounts can top up their balance through the minting system smart contract, on private networks only
 type InitialAccount struct {
-	Address      string          `json:"address"`
-	Supply       *big.Int        `json:"supply"`
-	Balance      *big.Int        `json:"balance"`
-	StakingValue *big.Int        `json:"stakingvalue"`
-	Delegation   *DelegationData `json:"delegation"`
+	Address      string            `json:"address"`
+	Supply       *big.Int          `json:"supply"`
+	Balance      *big.Int          `json:"balance"`
+	StakingValue *big.Int          `json:"stakingvalue"`
+	Delegations  []*DelegationData `json:"delegation"`
+	Vesting      *VestingData      `json:"vesting"`
+	Mintable     bool              `json:"mintable"`
 	addressBytes []byte
 }
 
@@ -35,23 +41,33 @@ func (ia *InitialAccount) MarshalJSON() ([]byte, error) {
 		stakingValue = big.NewInt(0)
 	}
 
-	delegation := ia.Delegation
-	if delegation == nil {
+	// the single delegation is serialized as an object, so the files stay compatible with the former format
+	var delegation interface{}
+	switch len(ia.Delegations) {
+	case 0:
 		delegation = &DelegationData{}
+	case 1:
+		delegation = ia.Delegations[0]
+	default:
+		delegation = ia.Delegations
 	}
 
 	s := struct {
-		Address      string          `json:"address"`
-		Supply       string          `json:"supply"`
-		Balance      string          `json:"balance"`
-		StakingValue string          `json:"stakingvalue"`
-		Delegation   *DelegationData `json:"delegation"`
+		Address      string       `json:"address"`
+		Supply       string       `json:"supply"`
+		Balance      string       `json:"balance"`
+		StakingValue string       `json:"stakingvalue"`
+		Delegation   interface{}  `json:"delegation"`
+		Vesting      *VestingData `json:"vesting,omitempty"`
+		Mintable     bool         `json:"mintable,omitempty"`
 	}{
 		Address:      ia.Address,
 		Supply:       supply.String(),
 		Balance:      balance.String(),
 		StakingValue: stakingValue.String(),
 		Delegation:   delegation,
+		Vesting:      ia.Vesting,
+		Mintable:     ia.Mintable,
 	}
 
 	return json.Marshal(&s)
@@ -64,7 +80,9 @@ func (ia *InitialAccount) UnmarshalJSON(data []byte) error {
 		Supply       string          `json:"supply"`
 		Balance      string          `json:"balance"`
 		StakingValue string          `json:"stakingvalue"`
-		Delegation   *DelegationData `json:"delegation"`
+		Delegation   json.RawMessage `json:"delegation"`
+		Vesting      *VestingData    `json:"vesting"`
+		Mintable     bool            `json:"mintable"`
 	}{}
 
 	err := json.Unmarshal(data, &s)
@@ -101,7 +119,12 @@ func (ia *InitialAccount) UnmarshalJSON(data []byte) error {
 	}
 
 	ia.Address = s.Address
-	ia.Delegation = s.Delegation
+	ia.Vesting = s.Vesting
+	ia.Mintable = s.Mintable
+	ia.Delegations, err = unmarshalDelegations(s.Delegation)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -123,9 +146,17 @@ func (ia *InitialAccount) Clone() genesis.InitialAccountHandler {
 		Supply:       big.NewInt(0).Set(ia.Supply),
 		Balance:      big.NewInt(0).Set(ia.Balance),
 		StakingValue: big.NewInt(0).Set(ia.StakingValue),
-		Delegation:   ia.Delegation.Clone(),
+		Delegations:  make([]*DelegationData, 0, len(ia.Delegations)),
+		Mintable:     ia.Mintable,
 		addressBytes: make([]byte, len(ia.addressBytes)),
 	}
+	for _, delegation := range ia.Delegations {
+		newInitialAccount.Delegations = append(newInitialAccount.Delegations, delegation.Clone())
+	}
+
+	if ia.Vesting != nil {
+		newInitialAccount.Vesting = ia.Vesting.Clone()
+	}
 
 	copy(newInitialAccount.addressBytes, ia.addressBytes)
 
@@ -152,9 +183,28 @@ func (ia *InitialAccount) GetSupply() *big.Int {
 	return ia.Supply
 }
 
-// GetDelegationHandler returns the delegation handler
-func (ia *InitialAccount) GetDelegationHandler() genesis.DelegationDataHandler {
-	return ia.Delegation
+// GetDelegationHandlers returns the delegation handlers, one for each delegation contract the account delegates to
+func (ia *InitialAccount) GetDelegationHandlers() []genesis.DelegationDataHandler {
+	handlers := make([]genesis.DelegationDataHandler, 0, len(ia.Delegations))
+	for _, delegation := range ia.Delegations {
+		handlers = append(handlers, delegation)
+	}
+
+	return handlers
+}
+
+// GetVestingSchedule returns the vesting schedule of the locked part of the balance or nil if no value is locked
+func (ia *InitialAccount) GetVestingSchedule() *vesting.Schedule {
+	if ia.Vesting == nil || ia.Vesting.Value == nil || ia.Vesting.Value.Sign() == 0 {
+		return nil
+	}
+
+	return ia.Vesting.Schedule()
+}
+
+// IsMintable returns true if the account can top up its balance through the minting system smart contract
+func (ia *InitialAccount) IsMintable() bool {
+	return ia.Mintable
 }
 
 // IsInterfaceNil returns if underlying object is true
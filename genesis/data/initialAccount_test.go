@@ -19,10 +19,10 @@ func createMockInitialAccount() *InitialAccount {
 		Supply:       big.NewInt(1142),
 		Balance:      big.NewInt(2242),
 		StakingValue: big.NewInt(3342),
-		Delegation: &DelegationData{
+		Delegations: []*DelegationData{{
 			Address: "delegation address",
 			Value:   big.NewInt(4442),
-		},
+		}},
 	}
 }
 
@@ -34,17 +34,17 @@ func TestInitialAccount_UnmarshalJSON_MarshalUnmarshalEmptyStruct(t *testing.T)
 		Supply:       nil,
 		Balance:      nil,
 		StakingValue: nil,
-		Delegation:   nil,
+		Delegations:  nil,
 	}
 	expected := &InitialAccount{
 		Address:      "",
 		Supply:       big.NewInt(0),
 		Balance:      big.NewInt(0),
 		StakingValue: big.NewInt(0),
-		Delegation: &DelegationData{
+		Delegations: []*DelegationData{{
 			Address: "",
 			Value:   big.NewInt(0),
-		},
+		}},
 	}
 
 	buff, err := json.Marshal(input)
@@ -71,10 +71,10 @@ func TestInitialAccount_MarshalUnmarshalValidStruct(t *testing.T) {
 		Supply:       big.NewInt(supply),
 		Balance:      big.NewInt(balance),
 		StakingValue: big.NewInt(stakingValue),
-		Delegation: &DelegationData{
+		Delegations: []*DelegationData{{
 			Address: delegationAddress,
 			Value:   big.NewInt(delegationValue),
-		},
+		}},
 	}
 
 	buff, err := json.Marshal(input)
@@ -87,6 +87,112 @@ func TestInitialAccount_MarshalUnmarshalValidStruct(t *testing.T) {
 	assert.Equal(t, input, recovered)
 }
 
+func TestInitialAccount_MarshalUnmarshalMultipleDelegations(t *testing.T) {
+	t.Parallel()
+
+	input := createMockInitialAccount()
+	input.Delegations = append(input.Delegations, &DelegationData{
+		Address: "second delegation address",
+		Value:   big.NewInt(5542),
+	})
+
+	buff, err := json.Marshal(input)
+	require.Nil(t, err)
+	assert.True(t, bytes.Contains(buff, []byte(`"delegation":[{`)))
+
+	recovered := &InitialAccount{}
+	err = json.Unmarshal(buff, recovered)
+
+	assert.Nil(t, err)
+	assert.Equal(t, input, recovered)
+}
+
+func TestInitialAccount_UnmarshalDelegationForms(t *testing.T) {
+	t.Parallel()
+
+	single := []byte(`{"address":"a","supply":"1","balance":"0","stakingvalue":"0",` +
+		`"delegation":{"address":"d1","value":"1"}}`)
+	recovered := &InitialAccount{}
+	err := json.Unmarshal(single, recovered)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(recovered.Delegations))
+	assert.Equal(t, "d1", recovered.Delegations[0].Address)
+
+	list := []byte(`{"address":"a","supply":"3","balance":"0","stakingvalue":"0",` +
+		`"delegation":[{"address":"d1","value":"1"},{"address":"d2","value":"2"}]}`)
+	recovered = &InitialAccount{}
+	err = json.Unmarshal(list, recovered)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(recovered.Delegations))
+	assert.Equal(t, "d2", recovered.Delegations[1].Address)
+	assert.Equal(t, big.NewInt(2), recovered.Delegations[1].Value)
+
+	missing := []byte(`{"address":"a","supply":"1","balance":"1","stakingvalue":"0"}`)
+	recovered = &InitialAccount{}
+	err = json.Unmarshal(missing, recovered)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(recovered.Delegations))
+
+	invalid := []byte(`{"address":"a","supply":"1","balance":"0","stakingvalue":"0",` +
+		`"delegation":[{"address":"d1","value":"not a number"}]}`)
+	err = json.Unmarshal(invalid, &InitialAccount{})
+	assert.True(t, errors.Is(err, genesis.ErrInvalidDelegationValueString))
+}
+
+func TestInitialAccount_MarshalUnmarshalVesting(t *testing.T) {
+	t.Parallel()
+
+	input := createMockInitialAccount()
+	input.Vesting = &VestingData{
+		Value:      big.NewInt(100),
+		CliffEpoch: 30,
+		EndEpoch:   365,
+	}
+
+	buff, err := json.Marshal(input)
+	require.Nil(t, err)
+	assert.True(t, bytes.Contains(buff, []byte(`"vesting":{"value":"100","cliffepoch":30,"endepoch":365}`)))
+
+	recovered := &InitialAccount{}
+	err = json.Unmarshal(buff, recovered)
+	require.Nil(t, err)
+	assert.Equal(t, input, recovered)
+	assert.Equal(t, uint32(365), recovered.GetVestingSchedule().EndEpoch)
+
+	input.Vesting = nil
+	buff, err = json.Marshal(input)
+	require.Nil(t, err)
+	assert.False(t, bytes.Contains(buff, []byte("vesting")))
+
+	invalid := []byte(`{"address":"a","supply":"1","balance":"1","stakingvalue":"0",` +
+		`"vesting":{"value":"not a number","cliffepoch":1,"endepoch":2}}`)
+	err = json.Unmarshal(invalid, &InitialAccount{})
+	assert.True(t, errors.Is(err, genesis.ErrInvalidVestingValueString))
+}
+
+func TestInitialAccount_MarshalUnmarshalMintable(t *testing.T) {
+	t.Parallel()
+
+	input := createMockInitialAccount()
+	input.Mintable = true
+
+	buff, err := json.Marshal(input)
+	require.Nil(t, err)
+	assert.True(t, bytes.Contains(buff, []byte(`"mintable":true`)))
+
+	recovered := &InitialAccount{}
+	err = json.Unmarshal(buff, recovered)
+	require.Nil(t, err)
+	assert.Equal(t, input, recovered)
+	assert.True(t, recovered.IsMintable())
+	assert.True(t, recovered.Clone().IsMintable())
+
+	input.Mintable = false
+	buff, err = json.Marshal(input)
+	require.Nil(t, err)
+	assert.False(t, bytes.Contains(buff, []byte("mintable")))
+}
+
 func TestInitialAccount_UnmarshalNotAValidSupplyShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -149,11 +255,11 @@ func TestInitialAccount_Clone(t *testing.T) {
 		Balance:      big.NewInt(56),
 		StakingValue: big.NewInt(78),
 		addressBytes: []byte("address bytes"),
-		Delegation: &DelegationData{
+		Delegations: []*DelegationData{{
 			Address:      "delegation address",
 			Value:        big.NewInt(910),
 			addressBytes: []byte("delegation address bytes"),
-		},
+		}},
 	}
 
 	iaCloned := ia.Clone()
@@ -163,7 +269,7 @@ func TestInitialAccount_Clone(t *testing.T) {
 	assert.False(t, ia.Supply == iaCloned.GetSupply())
 	assert.False(t, ia.Balance == iaCloned.GetBalanceValue())
 	assert.False(t, ia.StakingValue == iaCloned.GetStakingValue())
-	assert.False(t, ia.Delegation == iaCloned.GetDelegationHandler())
+	assert.False(t, ia.Delegations[0] == iaCloned.GetDelegationHandlers()[0])
 }
 
 func TestInitialAccount_Getters(t *testing.T) {
@@ -179,14 +285,14 @@ func TestInitialAccount_Getters(t *testing.T) {
 		Supply:       supply,
 		Balance:      balance,
 		StakingValue: staking,
-		Delegation:   dd,
+		Delegations:  []*DelegationData{dd},
 	}
 
 	require.False(t, check.IfNil(ia))
-	require.False(t, check.IfNil(ia.GetDelegationHandler()))
+	require.Equal(t, 1, len(ia.GetDelegationHandlers()))
 	assert.Equal(t, accountAddr, ia.GetAddress())
 	assert.Equal(t, supply, ia.GetSupply())
 	assert.Equal(t, balance, ia.GetBalanceValue())
 	assert.Equal(t, staking, ia.GetStakingValue())
-	assert.Equal(t, dd, ia.GetDelegationHandler())
+	assert.Equal(t, dd, ia.GetDelegationHandlers()[0])
 }
@@ -1,17 +1,23 @@
 package data
 
-// InitialSmartContract provides the information regarding initial deployed SC
+import "strconv"
+
+// InitialSmartContract provides the information regarding initial deployed SC. The shard option holds the shard ID
+// the contract is deployed in or "all" for deploying it in every shard, the contract being deployed in its owner's
+// shard if the option is empty. The shard init parameters override the init parameters in the provided shards
 type InitialSmartContract struct {
-	Owner          string `json:"owner"`
-	Filename       string `json:"filename"`
-	VmType         string `json:"vm-type"`
-	InitParameters string `json:"init-parameters"`
-	Type           string `json:"type"`
-	Version        string `json:"version"`
-	ownerBytes     []byte
-	vmTypeBytes    []byte
-	addressesBytes [][]byte
-	addresses      []string
+	Owner               string            `json:"owner"`
+	Filename            string            `json:"filename"`
+	VmType              string            `json:"vm-type"`
+	InitParameters      string            `json:"init-parameters"`
+	ShardInitParameters map[string]string `json:"shard-init-parameters"`
+	Shard               string            `json:"shard"`
+	Type                string            `json:"type"`
+	Version             string            `json:"version"`
+	ownerBytes          []byte
+	vmTypeBytes         []byte
+	addressesBytes      [][]byte
+	addresses           []string
 }
 
 // OwnerBytes will return the owner's address as raw bytes
@@ -54,6 +60,21 @@ func (isc *InitialSmartContract) GetInitParameters() string {
 	return isc.InitParameters
 }
 
+// GetInitParametersForShard returns the init parameters used when deploying the smart contract in the provided shard
+func (isc *InitialSmartContract) GetInitParametersForShard(shardID uint32) string {
+	initParameters, ok := isc.ShardInitParameters[strconv.FormatUint(uint64(shardID), 10)]
+	if ok {
+		return initParameters
+	}
+
+	return isc.InitParameters
+}
+
+// GetShard returns the shard option of the smart contract
+func (isc *InitialSmartContract) GetShard() string {
+	return isc.Shard
+}
+
 // GetType returns the smart contract's type
 func (isc *InitialSmartContract) GetType() string {
 	return isc.Type
@@ -56,6 +56,20 @@ func TestInitialSmartContract_Getters(t *testing.T) {
 	assert.Equal(t, version, isc.GetVersion())
 }
 
+func TestInitialSmartContract_ShardOptions(t *testing.T) {
+	t.Parallel()
+
+	isc := &InitialSmartContract{
+		InitParameters:      "init parameters",
+		ShardInitParameters: map[string]string{"1": "shard 1 init parameters"},
+		Shard:               "all",
+	}
+
+	assert.Equal(t, "all", isc.GetShard())
+	assert.Equal(t, "init parameters", isc.GetInitParametersForShard(0))
+	assert.Equal(t, "shard 1 init parameters", isc.GetInitParametersForShard(1))
+}
+
 func TestInitialSmartContract_AddressBytes(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,81 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core/vesting"
+	"github.com/ElrondNetwork/elrond-go/genesis"
+)
+
+// VestingData specifies the part of the genesis balance that is locked, the epoch until which it is entirely locked
+// and the epoch in which it is entirely released
+type VestingData struct {
+	Value      *big.Int `json:"value"`
+	CliffEpoch uint32   `json:"cliffepoch"`
+	EndEpoch   uint32   `json:"endepoch"`
+}
+
+// MarshalJSON is the function called when trying to serialize the object using the JSON marshaler
+func (vd *VestingData) MarshalJSON() ([]byte, error) {
+	value := vd.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	s := struct {
+		Value      string `json:"value"`
+		CliffEpoch uint32 `json:"cliffepoch"`
+		EndEpoch   uint32 `json:"endepoch"`
+	}{
+		Value:      value.String(),
+		CliffEpoch: vd.CliffEpoch,
+		EndEpoch:   vd.EndEpoch,
+	}
+
+	return json.Marshal(&s)
+}
+
+// UnmarshalJSON is the function called when trying to de-serialize the object using the JSON marshaler
+func (vd *VestingData) UnmarshalJSON(data []byte) error {
+	s := struct {
+		Value      string `json:"value"`
+		CliffEpoch uint32 `json:"cliffepoch"`
+		EndEpoch   uint32 `json:"endepoch"`
+	}{}
+
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	var ok bool
+	vd.Value, ok = big.NewInt(0).SetString(s.Value, decodeBase)
+	if !ok {
+		return fmt.Errorf("%w for '%s'", genesis.ErrInvalidVestingValueString, s.Value)
+	}
+
+	vd.CliffEpoch = s.CliffEpoch
+	vd.EndEpoch = s.EndEpoch
+
+	return nil
+}
+
+// Clone will return a new instance of the vesting data holding the same information
+func (vd *VestingData) Clone() *VestingData {
+	return &VestingData{
+		Value:      big.NewInt(0).Set(vd.Value),
+		CliffEpoch: vd.CliffEpoch,
+		EndEpoch:   vd.EndEpoch,
+	}
+}
+
+// Schedule returns the vesting schedule described by the vesting data
+func (vd *VestingData) Schedule() *vesting.Schedule {
+	return &vesting.Schedule{
+		Value:      big.NewInt(0).Set(vd.Value),
+		CliffEpoch: vd.CliffEpoch,
+		EndEpoch:   vd.EndEpoch,
+	}
+}
@@ -163,3 +163,45 @@ var ErrMissingDeployedSC = errors.New("missing deployed SC")
 
 // ErrNilGeneralSettingsConfig signals that a nil general settings config was provided
 var ErrNilGeneralSettingsConfig = errors.New("nil general settings config")
+
+// ErrInvalidGenesisFiles signals that the genesis files validation found problems
+var ErrInvalidGenesisFiles = errors.New("invalid genesis files")
+
+// ErrInvalidJson signals that a genesis file does not hold a valid json
+var ErrInvalidJson = errors.New("invalid json")
+
+// ErrDuplicatePubKey signals that a duplicate public key has been found
+var ErrDuplicatePubKey = errors.New("duplicate public key")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrDuplicateDelegationAddress signals that the same account delegates more than one time to the same address
+var ErrDuplicateDelegationAddress = errors.New("duplicate delegation address")
+
+// ErrInvalidSmartContractShard signals that an invalid shard option was provided for a genesis smart contract
+var ErrInvalidSmartContractShard = errors.New("invalid smart contract shard")
+
+// ErrInvalidAccountsFile signals that the genesis accounts file has an invalid format
+var ErrInvalidAccountsFile = errors.New("invalid accounts file")
+
+// ErrInvalidVestingValueString signals that the vesting value string is not a valid number
+var ErrInvalidVestingValueString = errors.New("invalid vesting value string")
+
+// ErrInvalidVestingSchedule signals that the vesting schedule of an account is not valid
+var ErrInvalidVestingSchedule = errors.New("invalid vesting schedule")
+
+// ErrVestingScheduleNotRegistered signals that a vesting schedule could not be registered in the vesting contract
+var ErrVestingScheduleNotRegistered = errors.New("vesting schedule not registered")
+
+// ErrMintingNotEnabled signals that mintable genesis accounts were provided while the minting system SC is disabled
+var ErrMintingNotEnabled = errors.New("mintable accounts provided while the minting is not enabled")
+
+// ErrInvalidMintingReserve signals that an invalid minting reserve has been provided
+var ErrInvalidMintingReserve = errors.New("invalid minting reserve")
+
+// ErrMintableAccountNotRegistered signals that a mintable account could not be registered in the minting contract
+var ErrMintableAccountNotRegistered = errors.New("mintable account not registered")
+
+// ErrSupplyReconciliationMismatch signals that the genesis supply could not be reconciled with the declared total supply
+var ErrSupplyReconciliationMismatch = errors.New("genesis supply reconciliation mismatch")
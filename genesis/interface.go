@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/core/vesting"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
@@ -14,9 +15,16 @@ const DelegationType = "delegation"
 // DNSType defines the constant used when checking if a smart contract is of dns type
 const DNSType = "dns"
 
+// AllShardsDeployment defines the shard option value used for deploying a smart contract in all the shards
+const AllShardsDeployment = "all"
+
 // InitialDNSAddress defines the initial address from where the DNS contracts are deployed
 var InitialDNSAddress = bytes.Repeat([]byte{1}, 32)
 
+// InitialShardDeployerAddress defines the initial address from where the smart contracts having the shard option set
+// are deployed, before their ownership is transferred to the configured owners
+var InitialShardDeployerAddress = bytes.Repeat([]byte{2}, 32)
+
 // DelegationResult represents the DTO that contains the delegation results metrics
 type DelegationResult struct {
 	NumTotalStaked    int
@@ -29,6 +37,7 @@ type AccountsParser interface {
 	InitialAccounts() []InitialAccountHandler
 	GetTotalStakedForDelegationAddress(delegationAddress string) *big.Int
 	GetInitialAccountsForDelegated(addressBytes []byte) []InitialAccountHandler
+	HasVestingSchedules() bool
 	IsInterfaceNil() bool
 }
 
@@ -48,7 +57,9 @@ type InitialAccountHandler interface {
 	GetStakingValue() *big.Int
 	GetBalanceValue() *big.Int
 	GetSupply() *big.Int
-	GetDelegationHandler() DelegationDataHandler
+	GetDelegationHandlers() []DelegationDataHandler
+	GetVestingSchedule() *vesting.Schedule
+	IsMintable() bool
 	IsInterfaceNil() bool
 }
 
@@ -67,6 +78,8 @@ type InitialSmartContractHandler interface {
 	GetFilename() string
 	GetVmType() string
 	GetInitParameters() string
+	GetInitParametersForShard(shardID uint32) string
+	GetShard() string
 	GetType() string
 	VmTypeBytes() []byte
 	AddAddressBytes(addressBytes []byte)
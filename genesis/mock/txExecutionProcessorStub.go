@@ -10,6 +10,7 @@ import (
 type TxExecutionProcessorStub struct {
 	ExecuteTransactionCalled func(nonce uint64, sndAddr []byte, rcvAddress []byte, value *big.Int, data []byte) error
 	AccountExistsCalled      func(address []byte) bool
+	GetAccountCalled         func(address []byte) (state.UserAccountHandler, bool)
 	GetNonceCalled           func(senderBytes []byte) (uint64, error)
 	AddBalanceCalled         func(senderBytes []byte, value *big.Int) error
 	AddNonceCalled           func(senderBytes []byte, nonce uint64) error
@@ -26,6 +27,9 @@ func (teps *TxExecutionProcessorStub) ExecuteTransaction(nonce uint64, sndAddr [
 
 // GetAccount -
 func (teps *TxExecutionProcessorStub) GetAccount(address []byte) (state.UserAccountHandler, bool) {
+	if teps.GetAccountCalled != nil {
+		return teps.GetAccountCalled(address)
+	}
 	if teps.AccountExistsCalled != nil {
 		return nil, teps.AccountExistsCalled(address)
 	}
@@ -19,6 +19,7 @@ type UserAccountMock struct {
 	codeHash     []byte
 	rootHash     []byte
 	BalanceField *big.Int
+	OwnerAddress []byte
 }
 
 // HasNewCode -
@@ -118,12 +119,13 @@ func (uam *UserAccountMock) ChangeOwnerAddress(_ []byte, _ []byte) error {
 }
 
 // SetOwnerAddress -
-func (uam *UserAccountMock) SetOwnerAddress(_ []byte) {
+func (uam *UserAccountMock) SetOwnerAddress(address []byte) {
+	uam.OwnerAddress = address
 }
 
 // GetOwnerAddress -
 func (uam *UserAccountMock) GetOwnerAddress() []byte {
-	return nil
+	return uam.OwnerAddress
 }
 
 // SetUserName -
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -13,6 +14,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
+var log = logger.GetOrCreate("genesis/parsing")
+
 // accountsParser hold data for initial accounts decoded data from json file
 type accountsParser struct {
 	initialAccounts []*data.InitialAccount
@@ -21,7 +24,9 @@ type accountsParser struct {
 	keyGenerator    crypto.KeyGenerator
 }
 
-// NewAccountsParser creates a new decoded accounts genesis structure from json config file
+// NewAccountsParser creates a new decoded accounts genesis structure from the genesis accounts file. The file is read
+// as a stream and each account is validated as soon as it is read, so large csv or newline-delimited json files can
+// be used instead of the json array
 func NewAccountsParser(
 	genesisFilePath string,
 	entireSupply *big.Int,
@@ -42,20 +47,14 @@ func NewAccountsParser(
 		return nil, genesis.ErrNilKeyGenerator
 	}
 
-	initialAccounts := make([]*data.InitialAccount, 0)
-	err := core.LoadJsonFile(&initialAccounts, genesisFilePath)
-	if err != nil {
-		return nil, err
-	}
-
 	gp := &accountsParser{
-		initialAccounts: initialAccounts,
+		initialAccounts: make([]*data.InitialAccount, 0),
 		entireSupply:    entireSupply,
 		pubkeyConverter: pubkeyConverter,
 		keyGenerator:    keyGenerator,
 	}
 
-	err = gp.process()
+	err := gp.importAccounts(genesisFilePath)
 	if err != nil {
 		return nil, err
 	}
@@ -63,27 +62,78 @@ func NewAccountsParser(
 	return gp, nil
 }
 
-func (ap *accountsParser) process() error {
-	totalSupply := big.NewInt(0)
-	for _, initialAccount := range ap.initialAccounts {
-		err := ap.parseElement(initialAccount)
+// accountsValidation holds the state of the validation of the accounts already processed
+type accountsValidation struct {
+	addresses   map[string]struct{}
+	totalSupply *big.Int
+}
+
+func newAccountsValidation() *accountsValidation {
+	return &accountsValidation{
+		addresses:   make(map[string]struct{}),
+		totalSupply: big.NewInt(0),
+	}
+}
+
+func (ap *accountsParser) importAccounts(genesisFilePath string) error {
+	validation := newAccountsValidation()
+	handler := func(initialAccount *data.InitialAccount, position RecordPosition, err error) error {
+		if err == nil {
+			err = ap.processAccount(initialAccount, validation)
+		}
 		if err != nil {
-			return err
+			return fmt.Errorf("%w at %s of %s", err, position, genesisFilePath)
 		}
 
-		err = ap.checkInitialAccount(initialAccount)
+		ap.initialAccounts = append(ap.initialAccounts, initialAccount)
+		return nil
+	}
+
+	err := ReadInitialAccounts(genesisFilePath, handler)
+	if err != nil {
+		return err
+	}
+
+	return ap.checkEntireSupply(validation.totalSupply)
+}
+
+func (ap *accountsParser) process() error {
+	validation := newAccountsValidation()
+	for _, initialAccount := range ap.initialAccounts {
+		err := ap.processAccount(initialAccount, validation)
 		if err != nil {
 			return err
 		}
+	}
 
-		totalSupply.Add(totalSupply, initialAccount.Supply)
+	return ap.checkEntireSupply(validation.totalSupply)
+}
+
+func (ap *accountsParser) processAccount(initialAccount *data.InitialAccount, validation *accountsValidation) error {
+	err := ap.parseElement(initialAccount)
+	if err != nil {
+		return err
 	}
 
-	err := ap.checkForDuplicates()
+	err = ap.checkInitialAccount(initialAccount)
 	if err != nil {
 		return err
 	}
 
+	_, isDuplicate := validation.addresses[string(initialAccount.AddressBytes())]
+	if isDuplicate {
+		return fmt.Errorf("%w found for '%s'",
+			genesis.ErrDuplicateAddress,
+			initialAccount.Address,
+		)
+	}
+	validation.addresses[string(initialAccount.AddressBytes())] = struct{}{}
+	validation.totalSupply.Add(validation.totalSupply, initialAccount.Supply)
+
+	return nil
+}
+
+func (ap *accountsParser) checkEntireSupply(totalSupply *big.Int) error {
 	if totalSupply.Cmp(ap.entireSupply) != 0 {
 		return fmt.Errorf("%w for entire supply provided %s, computed %s",
 			genesis.ErrEntireSupplyMismatch,
@@ -115,12 +165,32 @@ func (ap *accountsParser) parseElement(initialAccount *data.InitialAccount) erro
 
 	initialAccount.SetAddressBytes(addressBytes)
 
-	return ap.parseDelegationElement(initialAccount)
+	return ap.parseDelegationElements(initialAccount)
 }
 
-func (ap *accountsParser) parseDelegationElement(initialAccount *data.InitialAccount) error {
-	delegationData := initialAccount.Delegation
+func (ap *accountsParser) parseDelegationElements(initialAccount *data.InitialAccount) error {
+	delegationAddresses := make(map[string]struct{})
+	for _, delegationData := range initialAccount.Delegations {
+		err := ap.parseDelegationElement(initialAccount, delegationData)
+		if err != nil {
+			return err
+		}
+		if len(delegationData.AddressBytes()) == 0 {
+			continue
+		}
+
+		_, exists := delegationAddresses[string(delegationData.AddressBytes())]
+		if exists {
+			return fmt.Errorf("%w '%s' for address '%s'",
+				genesis.ErrDuplicateDelegationAddress, delegationData.Address, initialAccount.Address)
+		}
+		delegationAddresses[string(delegationData.AddressBytes())] = struct{}{}
+	}
 
+	return nil
+}
+
+func (ap *accountsParser) parseDelegationElement(initialAccount *data.InitialAccount, delegationData *data.DelegationData) error {
 	if big.NewInt(0).Cmp(delegationData.Value) == 0 {
 		return nil
 	}
@@ -177,18 +247,20 @@ func (ap *accountsParser) checkInitialAccount(initialAccount *data.InitialAccoun
 		)
 	}
 
-	if big.NewInt(0).Cmp(initialAccount.Delegation.Value) > 0 {
-		return fmt.Errorf("%w for '%s', address %s",
-			genesis.ErrInvalidDelegationValue,
-			initialAccount.Delegation.Value,
-			initialAccount.Address,
-		)
-	}
-
 	sum := big.NewInt(0)
 	sum.Add(sum, initialAccount.Balance)
 	sum.Add(sum, initialAccount.StakingValue)
-	sum.Add(sum, initialAccount.Delegation.Value)
+	for _, delegationData := range initialAccount.Delegations {
+		if big.NewInt(0).Cmp(delegationData.Value) > 0 {
+			return fmt.Errorf("%w for '%s', address %s",
+				genesis.ErrInvalidDelegationValue,
+				delegationData.Value,
+				initialAccount.Address,
+			)
+		}
+
+		sum.Add(sum, delegationData.Value)
+	}
 
 	isSupplyCorrect := big.NewInt(0).Cmp(initialAccount.Supply) < 0 && initialAccount.Supply.Cmp(sum) == 0
 	if !isSupplyCorrect {
@@ -200,21 +272,30 @@ func (ap *accountsParser) checkInitialAccount(initialAccount *data.InitialAccoun
 		)
 	}
 
-	return nil
+	return checkVesting(initialAccount)
 }
 
-func (ap *accountsParser) checkForDuplicates() error {
-	for idx1 := 0; idx1 < len(ap.initialAccounts); idx1++ {
-		ia1 := ap.initialAccounts[idx1]
-		for idx2 := idx1 + 1; idx2 < len(ap.initialAccounts); idx2++ {
-			ia2 := ap.initialAccounts[idx2]
-			if ia1.Address == ia2.Address {
-				return fmt.Errorf("%w found for '%s'",
-					genesis.ErrDuplicateAddress,
-					ia1.Address,
-				)
-			}
-		}
+// checkVesting verifies that the vesting schedule is valid and that it locks at most the genesis balance
+func checkVesting(initialAccount *data.InitialAccount) error {
+	if initialAccount.Vesting == nil {
+		return nil
+	}
+	if initialAccount.Vesting.Value == nil {
+		return fmt.Errorf("%w: missing value, address %s", genesis.ErrInvalidVestingSchedule, initialAccount.Address)
+	}
+
+	err := initialAccount.Vesting.Schedule().Check()
+	if err != nil {
+		return fmt.Errorf("%w: %v, address %s", genesis.ErrInvalidVestingSchedule, err, initialAccount.Address)
+	}
+
+	if initialAccount.Vesting.Value.Cmp(initialAccount.Balance) > 0 {
+		return fmt.Errorf("%w: locked value %s is greater than the balance %s, address %s",
+			genesis.ErrInvalidVestingSchedule,
+			initialAccount.Vesting.Value.String(),
+			initialAccount.Balance.String(),
+			initialAccount.Address,
+		)
 	}
 
 	return nil
@@ -255,8 +336,10 @@ func (ap *accountsParser) GetTotalStakedForDelegationAddress(delegationAddress s
 	sum := big.NewInt(0)
 
 	for _, in := range ap.initialAccounts {
-		if in.Delegation.Address == delegationAddress {
-			sum.Add(sum, in.Delegation.Value)
+		for _, delegationData := range in.Delegations {
+			if delegationData.Address == delegationAddress {
+				sum.Add(sum, delegationData.Value)
+			}
 		}
 	}
 
@@ -267,14 +350,28 @@ func (ap *accountsParser) GetTotalStakedForDelegationAddress(delegationAddress s
 func (ap *accountsParser) GetInitialAccountsForDelegated(addressBytes []byte) []genesis.InitialAccountHandler {
 	list := make([]genesis.InitialAccountHandler, 0)
 	for _, ia := range ap.initialAccounts {
-		if bytes.Equal(ia.Delegation.AddressBytes(), addressBytes) {
-			list = append(list, ia)
+		for _, delegationData := range ia.Delegations {
+			if bytes.Equal(delegationData.AddressBytes(), addressBytes) {
+				list = append(list, ia)
+				break
+			}
 		}
 	}
 
 	return list
 }
 
+// HasVestingSchedules returns true if at least one of the initial accounts has its balance locked by a vesting schedule
+func (ap *accountsParser) HasVestingSchedules() bool {
+	for _, ia := range ap.initialAccounts {
+		if ia.Vesting != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
 // IsInterfaceNil returns if underlying object is true
 func (ap *accountsParser) IsInterfaceNil() bool {
 	return ap == nil
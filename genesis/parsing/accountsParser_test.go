@@ -23,10 +23,10 @@ func createMockInitialAccount() *data.InitialAccount {
 		Supply:       big.NewInt(5),
 		Balance:      big.NewInt(1),
 		StakingValue: big.NewInt(2),
-		Delegation: &data.DelegationData{
+		Delegations: []*data.DelegationData{{
 			Address: "0002",
 			Value:   big.NewInt(2),
-		},
+		}},
 	}
 }
 
@@ -44,10 +44,10 @@ func createSimpleInitialAccount(address string, balance int64) *data.InitialAcco
 		Supply:       big.NewInt(balance),
 		Balance:      big.NewInt(balance),
 		StakingValue: big.NewInt(0),
-		Delegation: &data.DelegationData{
+		Delegations: []*data.DelegationData{{
 			Address: "",
 			Value:   big.NewInt(0),
-		},
+		}},
 	}
 }
 
@@ -57,10 +57,10 @@ func createDelegatedInitialAccount(address string, delegatedBytes []byte, delega
 		Supply:       big.NewInt(delegatedBalance),
 		Balance:      big.NewInt(0),
 		StakingValue: big.NewInt(0),
-		Delegation: &data.DelegationData{
+		Delegations: []*data.DelegationData{{
 			Address: hex.EncodeToString(delegatedBytes),
 			Value:   big.NewInt(delegatedBalance),
-		},
+		}},
 	}
 	ia.SetAddressBytes(delegatedBytes)
 
@@ -166,6 +166,24 @@ func TestNewAccountsParser_ShouldWork(t *testing.T) {
 	assert.Equal(t, 6, len(ap.InitialAccounts()))
 }
 
+func TestNewAccountsParser_LineDelimitedFilesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	for _, filename := range []string{"testdata/genesis_ok.csv", "testdata/genesis_ok.ndjson"} {
+		ap, err := parsing.NewAccountsParser(
+			filename,
+			big.NewInt(30),
+			createMockHexPubkeyConverter(),
+			&mock.KeyGeneratorStub{},
+		)
+
+		require.Nil(t, err, filename)
+		assert.Equal(t, 6, len(ap.InitialAccounts()), filename)
+		assert.Equal(t, big.NewInt(4), ap.GetTotalStakedForDelegationAddress("0009"), filename)
+		assert.Equal(t, 2, len(ap.InitialAccounts()[4].GetDelegationHandlers()), filename)
+	}
+}
+
 //------- process
 
 func TestAccountsParser_ProcessEmptyAddressShouldErr(t *testing.T) {
@@ -218,7 +236,7 @@ func TestAccountsParser_ProcessEmptyDelegationAddressButWithBalanceShouldErr(t *
 
 	ap := parsing.NewTestAccountsParser(createMockHexPubkeyConverter())
 	ib := createMockInitialAccount()
-	ib.Delegation.Address = ""
+	ib.Delegations[0].Address = ""
 	ap.SetInitialAccounts([]*data.InitialAccount{ib})
 
 	err := ap.Process()
@@ -231,7 +249,7 @@ func TestAccountsParser_ProcessInvalidDelegationAddressShouldErr(t *testing.T) {
 
 	ap := parsing.NewTestAccountsParser(createMockHexPubkeyConverter())
 	ib := createMockInitialAccount()
-	ib.Delegation.Address = "invalid address"
+	ib.Delegations[0].Address = "invalid address"
 	ap.SetInitialAccounts([]*data.InitialAccount{ib})
 
 	err := ap.Process()
@@ -285,13 +303,53 @@ func TestAccountsParser_ProcessInvalidDelegationValueShouldErr(t *testing.T) {
 
 	ap := parsing.NewTestAccountsParser(createMockHexPubkeyConverter())
 	ib := createMockInitialAccount()
-	ib.Delegation.Value = big.NewInt(-1)
+	ib.Delegations[0].Value = big.NewInt(-1)
 	ap.SetInitialAccounts([]*data.InitialAccount{ib})
 
 	err := ap.Process()
 	assert.True(t, errors.Is(err, genesis.ErrInvalidDelegationValue))
 }
 
+func TestAccountsParser_ProcessInvalidVestingShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ap := parsing.NewTestAccountsParser(createMockHexPubkeyConverter())
+	ib := createMockInitialAccount()
+	ib.Vesting = &data.VestingData{
+		Value:      big.NewInt(1),
+		CliffEpoch: 10,
+		EndEpoch:   5,
+	}
+	ap.SetInitialAccounts([]*data.InitialAccount{ib})
+
+	err := ap.Process()
+	assert.True(t, errors.Is(err, genesis.ErrInvalidVestingSchedule))
+
+	ib.Vesting = &data.VestingData{
+		Value:    big.NewInt(2),
+		EndEpoch: 5,
+	}
+
+	err = ap.Process()
+	assert.True(t, errors.Is(err, genesis.ErrInvalidVestingSchedule))
+}
+
+func TestAccountsParser_HasVestingSchedules(t *testing.T) {
+	t.Parallel()
+
+	ap := parsing.NewTestAccountsParser(createMockHexPubkeyConverter())
+	ib1 := createMockInitialAccount()
+	ib2 := createMockInitialAccount()
+	ap.SetInitialAccounts([]*data.InitialAccount{ib1, ib2})
+	assert.False(t, ap.HasVestingSchedules())
+
+	ib2.Vesting = &data.VestingData{
+		Value:    big.NewInt(1),
+		EndEpoch: 5,
+	}
+	assert.True(t, ap.HasVestingSchedules())
+}
+
 func TestAccountsParser_ProcessSupplyMismatchShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -316,6 +374,22 @@ func TestAccountsParser_ProcessDuplicatesShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, genesis.ErrDuplicateAddress))
 }
 
+func TestAccountsParser_ProcessDuplicateDelegationAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ap := parsing.NewTestAccountsParser(createMockHexPubkeyConverter())
+	ib := createMockInitialAccount()
+	ib.Supply = big.NewInt(7)
+	ib.Delegations = append(ib.Delegations, &data.DelegationData{
+		Address: ib.Delegations[0].Address,
+		Value:   big.NewInt(2),
+	})
+	ap.SetInitialAccounts([]*data.InitialAccount{ib})
+
+	err := ap.Process()
+	assert.True(t, errors.Is(err, genesis.ErrDuplicateDelegationAddress))
+}
+
 func TestAccountsParser_ProcessEntireSupplyMismatchShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -435,3 +509,38 @@ func TestAccountsParser_GetInitialAccountsForDelegated(t *testing.T) {
 	delegated = ap.GetTotalStakedForDelegationAddress(hex.EncodeToString([]byte("not delegated")))
 	assert.Equal(t, big.NewInt(0), delegated)
 }
+
+func TestAccountsParser_GetInitialAccountsForDelegatedMultipleDelegations(t *testing.T) {
+	t.Parallel()
+
+	addr1 := "1000"
+	addr2 := "2000"
+
+	ap := parsing.NewTestAccountsParser(createMockHexPubkeyConverter())
+	ib1 := createDelegatedInitialAccount("0001", []byte(addr1), 30)
+	ib1.Supply = big.NewInt(80)
+	ib1.Delegations = append(ib1.Delegations, &data.DelegationData{
+		Address: hex.EncodeToString([]byte(addr2)),
+		Value:   big.NewInt(50),
+	})
+	ib2 := createDelegatedInitialAccount("0002", []byte(addr2), 20)
+
+	ap.SetEntireSupply(big.NewInt(100))
+	ap.SetInitialAccounts([]*data.InitialAccount{ib1, ib2})
+
+	err := ap.Process()
+	require.Nil(t, err)
+
+	list := ap.GetInitialAccountsForDelegated([]byte(addr1))
+	require.Equal(t, 1, len(list))
+	assert.Equal(t, ib1, list[0])
+	delegated := ap.GetTotalStakedForDelegationAddress(hex.EncodeToString([]byte(addr1)))
+	assert.Equal(t, big.NewInt(30), delegated)
+
+	list = ap.GetInitialAccountsForDelegated([]byte(addr2))
+	require.Equal(t, 2, len(list))
+	assert.Equal(t, ib1, list[0])
+	assert.Equal(t, ib2, list[1])
+	delegated = ap.GetTotalStakedForDelegationAddress(hex.EncodeToString([]byte(addr2)))
+	assert.Equal(t, big.NewInt(70), delegated)
+}
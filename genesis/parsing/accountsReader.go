@@ -0,0 +1,292 @@
+package parsing
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"path/filepath"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/genesis"
+	"github.com/ElrondNetwork/elrond-go/genesis/data"
+)
+
+const (
+	csvFileExtension    = ".csv"
+	ndjsonFileExtension = ".ndjson"
+	jsonlFileExtension  = ".jsonl"
+
+	csvAddressColumn           = "address"
+	csvSupplyColumn            = "supply"
+	csvBalanceColumn           = "balance"
+	csvStakingValueColumn      = "stakingvalue"
+	csvDelegationAddressColumn = "delegationaddress"
+	csvDelegationValueColumn   = "delegationvalue"
+
+	maxAccountRecordSizeInBytes = 1024 * 1024
+	accountsProgressInterval    = 100000
+)
+
+var csvAccountColumns = []string{csvAddressColumn, csvSupplyColumn, csvBalanceColumn, csvStakingValueColumn}
+
+// RecordPosition locates a record of a genesis accounts file
+type RecordPosition struct {
+	Index int
+	Line  int
+}
+
+// String returns the line of the record or, if the line is not known, the index of the record
+func (rp RecordPosition) String() string {
+	if rp.Line > 0 {
+		return fmt.Sprintf("line %d", rp.Line)
+	}
+
+	return fmt.Sprintf("entry %d", rp.Index)
+}
+
+// AccountRecordHandler is called for each record of a genesis accounts file with the decoded account or with the
+// decoding error of the record. Returning an error stops the reading
+type AccountRecordHandler func(account *data.InitialAccount, position RecordPosition, err error) error
+
+// IsLineDelimitedAccountsFile returns true if the genesis accounts file holds one account on each line, either as
+// comma separated values (.csv) or as a json object (.ndjson or .jsonl)
+func IsLineDelimitedAccountsFile(filePath string) bool {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case csvFileExtension, ndjsonFileExtension, jsonlFileExtension:
+		return true
+	default:
+		return false
+	}
+}
+
+// ReadInitialAccounts streams the accounts held by the genesis accounts file to the provided handler, without loading
+// the whole file in memory. The file holds a json array of accounts, unless it is a line delimited accounts file.
+// The csv files start with the "address,supply,balance,stakingvalue" header, optionally followed by any number of
+// "delegationaddress,delegationvalue" column pairs. The decoding errors of the line delimited records are passed to
+// the handler, so the remaining records can still be checked, while those of the json array stop the reading
+func ReadInitialAccounts(filePath string, handler AccountRecordHandler) error {
+	f, err := core.OpenFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		errClose := f.Close()
+		if errClose != nil {
+			log.Warn("cannot close file", "file", filePath, "error", errClose.Error())
+		}
+	}()
+
+	progress := &accountsImportProgress{filePath: filePath}
+	countingHandler := func(account *data.InitialAccount, position RecordPosition, err error) error {
+		progress.recordRead()
+		return handler(account, position, err)
+	}
+
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case csvFileExtension:
+		err = readLineDelimitedAccounts(f, newCsvAccountDecoder(), countingHandler)
+	case ndjsonFileExtension, jsonlFileExtension:
+		err = readLineDelimitedAccounts(f, decodeJsonAccount, countingHandler)
+	default:
+		err = readJsonArrayAccounts(f, countingHandler)
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Info("genesis accounts file read", "file", filePath, "num records", progress.numRecords)
+
+	return nil
+}
+
+type accountsImportProgress struct {
+	filePath   string
+	numRecords int
+}
+
+func (aip *accountsImportProgress) recordRead() {
+	aip.numRecords++
+	if aip.numRecords%accountsProgressInterval == 0 {
+		log.Info("reading genesis accounts file", "file", aip.filePath, "num records", aip.numRecords)
+	}
+}
+
+// accountDecoder decodes an account from a line of a line delimited accounts file. A nil account without error
+// means the line does not hold an account
+type accountDecoder func(line string) (*data.InitialAccount, error)
+
+func readLineDelimitedAccounts(reader io.Reader, decoder accountDecoder, handler AccountRecordHandler) error {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxAccountRecordSizeInBytes)
+
+	position := RecordPosition{}
+	for scanner.Scan() {
+		position.Line++
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 {
+			continue
+		}
+
+		account, err := decoder(line)
+		if err == nil && account == nil {
+			continue
+		}
+
+		position.Index++
+		err = handler(account, position, err)
+		if err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+func decodeJsonAccount(line string) (*data.InitialAccount, error) {
+	account := &data.InitialAccount{}
+	err := json.Unmarshal([]byte(line), account)
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// newCsvAccountDecoder returns a decoder that expects the header on the first non empty line
+func newCsvAccountDecoder() accountDecoder {
+	numColumns := 0
+
+	return func(line string) (*data.InitialAccount, error) {
+		record, err := csv.NewReader(strings.NewReader(line)).Read()
+		if err != nil {
+			return nil, err
+		}
+
+		if numColumns == 0 {
+			err = checkCsvHeader(record)
+			if err != nil {
+				return nil, err
+			}
+
+			numColumns = len(record)
+			return nil, nil
+		}
+		if len(record) != numColumns {
+			return nil, fmt.Errorf("%w: %d columns provided, %d expected", genesis.ErrInvalidAccountsFile, len(record), numColumns)
+		}
+
+		return csvRecordToAccount(record)
+	}
+}
+
+func checkCsvHeader(header []string) error {
+	isHeaderOk := len(header) >= len(csvAccountColumns) && (len(header)-len(csvAccountColumns))%2 == 0
+	for i := 0; isHeaderOk && i < len(header); i++ {
+		expectedColumn := csvDelegationAddressColumn
+		switch {
+		case i < len(csvAccountColumns):
+			expectedColumn = csvAccountColumns[i]
+		case (i-len(csvAccountColumns))%2 == 1:
+			expectedColumn = csvDelegationValueColumn
+		}
+
+		isHeaderOk = strings.ToLower(strings.TrimSpace(header[i])) == expectedColumn
+	}
+	if !isHeaderOk {
+		return fmt.Errorf("%w: invalid csv header %s", genesis.ErrInvalidAccountsFile, strings.Join(header, ","))
+	}
+
+	return nil
+}
+
+func csvRecordToAccount(record []string) (*data.InitialAccount, error) {
+	for i := range record {
+		record[i] = strings.TrimSpace(record[i])
+	}
+
+	account := &data.InitialAccount{
+		Address:     record[0],
+		Delegations: make([]*data.DelegationData, 0),
+	}
+
+	var err error
+	account.Supply, err = parseCsvValue(record[1], genesis.ErrInvalidSupplyString, account.Address)
+	if err != nil {
+		return nil, err
+	}
+	account.Balance, err = parseCsvValue(record[2], genesis.ErrInvalidBalanceString, account.Address)
+	if err != nil {
+		return nil, err
+	}
+	account.StakingValue, err = parseCsvValue(record[3], genesis.ErrInvalidStakingBalanceString, account.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(csvAccountColumns); i < len(record); i += 2 {
+		delegationAddress := record[i]
+		delegationValue, errParse := parseCsvValue(record[i+1], genesis.ErrInvalidDelegationValueString, account.Address)
+		if errParse != nil {
+			return nil, errParse
+		}
+		if len(delegationAddress) == 0 && delegationValue.Sign() == 0 {
+			continue
+		}
+
+		account.Delegations = append(account.Delegations, &data.DelegationData{
+			Address: delegationAddress,
+			Value:   delegationValue,
+		})
+	}
+
+	return account, nil
+}
+
+// parseCsvValue parses a value of a csv record, the empty values being considered 0
+func parseCsvValue(value string, errInvalid error, address string) (*big.Int, error) {
+	if len(value) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	result, ok := big.NewInt(0).SetString(value, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w for '%s', address %s", errInvalid, value, address)
+	}
+
+	return result, nil
+}
+
+func readJsonArrayAccounts(reader io.Reader, handler AccountRecordHandler) error {
+	decoder := json.NewDecoder(bufio.NewReader(reader))
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != json.Delim('[') {
+		return fmt.Errorf("%w: the accounts should be provided as a json array", genesis.ErrInvalidAccountsFile)
+	}
+
+	position := RecordPosition{}
+	for decoder.More() {
+		position.Index++
+		account := &data.InitialAccount{}
+		err = decoder.Decode(account)
+		if err != nil {
+			return fmt.Errorf("%w at %s", err, position)
+		}
+
+		err = handler(account, position, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = decoder.Token()
+
+	return err
+}
@@ -0,0 +1,112 @@
+package parsing_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/genesis"
+	"github.com/ElrondNetwork/elrond-go/genesis/data"
+	"github.com/ElrondNetwork/elrond-go/genesis/parsing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeAccountsFile(t *testing.T, filename string, content string) string {
+	dir, err := ioutil.TempDir("", "accounts")
+	require.Nil(t, err)
+
+	filePath := filepath.Join(dir, filename)
+	err = ioutil.WriteFile(filePath, []byte(content), os.ModePerm)
+	require.Nil(t, err)
+
+	return filePath
+}
+
+type readRecord struct {
+	account  *data.InitialAccount
+	position parsing.RecordPosition
+	err      error
+}
+
+func readAllRecords(filePath string) ([]*readRecord, error) {
+	records := make([]*readRecord, 0)
+	err := parsing.ReadInitialAccounts(filePath, func(account *data.InitialAccount, position parsing.RecordPosition, err error) error {
+		records = append(records, &readRecord{account: account, position: position, err: err})
+		return nil
+	})
+
+	return records, err
+}
+
+func TestIsLineDelimitedAccountsFile(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, parsing.IsLineDelimitedAccountsFile("genesis.csv"))
+	assert.True(t, parsing.IsLineDelimitedAccountsFile("genesis.NDJSON"))
+	assert.True(t, parsing.IsLineDelimitedAccountsFile("genesis.jsonl"))
+	assert.False(t, parsing.IsLineDelimitedAccountsFile("genesis.json"))
+}
+
+func TestReadInitialAccounts_CsvRecordsErrorsShouldBeReportedOnTheirLines(t *testing.T) {
+	t.Parallel()
+
+	filePath := writeAccountsFile(t, "genesis.csv", "Address, Supply, Balance, StakingValue\n"+
+		"0001,5,5,0\n"+
+		"0002,not a number,5,0\n"+
+		"\n"+
+		"0003,5,5\n"+
+		"0004,5,5,0\n")
+
+	records, err := readAllRecords(filePath)
+	require.Nil(t, err)
+	require.Equal(t, 4, len(records))
+
+	assert.Nil(t, records[0].err)
+	assert.Equal(t, "0001", records[0].account.Address)
+	assert.Equal(t, parsing.RecordPosition{Index: 1, Line: 2}, records[0].position)
+	assert.True(t, errors.Is(records[1].err, genesis.ErrInvalidSupplyString))
+	assert.Equal(t, 3, records[1].position.Line)
+	assert.True(t, errors.Is(records[2].err, genesis.ErrInvalidAccountsFile))
+	assert.Equal(t, 5, records[2].position.Line)
+	assert.Nil(t, records[3].err)
+	assert.Equal(t, parsing.RecordPosition{Index: 4, Line: 6}, records[3].position)
+	assert.Equal(t, 0, len(records[3].account.Delegations))
+}
+
+func TestReadInitialAccounts_CsvInvalidHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	filePath := writeAccountsFile(t, "genesis.csv", "address,supply,balance,stakingvalue,delegationaddress\n"+
+		"0001,5,5,0,\n")
+
+	records, err := readAllRecords(filePath)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(records))
+	assert.True(t, errors.Is(records[0].err, genesis.ErrInvalidAccountsFile))
+	assert.Equal(t, 1, records[0].position.Line)
+}
+
+func TestReadInitialAccounts_JsonArray(t *testing.T) {
+	t.Parallel()
+
+	filePath := writeAccountsFile(t, "genesis.json", `[
+		{"address": "0001", "supply": "5", "balance": "5", "stakingvalue": "0"},
+		{"address": "0002", "supply": "5", "balance": "5", "stakingvalue": "0"}
+	]`)
+	records, err := readAllRecords(filePath)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(records))
+	assert.Equal(t, "0002", records[1].account.Address)
+	assert.Equal(t, parsing.RecordPosition{Index: 2}, records[1].position)
+
+	filePath = writeAccountsFile(t, "genesis.json", `{"address": "0001"}`)
+	_, err = readAllRecords(filePath)
+	assert.True(t, errors.Is(err, genesis.ErrInvalidAccountsFile))
+
+	filePath = writeAccountsFile(t, "genesis.json", `[{"address": "0001", "supply": "x"}]`)
+	_, err = readAllRecords(filePath)
+	assert.True(t, errors.Is(err, genesis.ErrInvalidSupplyString))
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -116,9 +117,47 @@ func (scp *smartContractParser) parseElement(initialSmartContract *data.InitialS
 
 	initialSmartContract.SetVmTypeBytes(vmTypeBytes)
 
+	return checkShardOptions(initialSmartContract)
+}
+
+// checkShardOptions checks the shard option and the shard init parameters keys. The DNS contracts are always deployed
+// in all shards and the delegation contracts in their owners' shards, so the shard option is not supported for them
+func checkShardOptions(initialSmartContract *data.InitialSmartContract) error {
+	isShardOptionSupported := initialSmartContract.Type != genesis.DNSType &&
+		initialSmartContract.Type != genesis.DelegationType
+	if len(initialSmartContract.Shard) > 0 && !isShardOptionSupported {
+		return fmt.Errorf("%w, the shard option is not supported for %s contracts, owner %s",
+			genesis.ErrInvalidSmartContractShard, initialSmartContract.Type, initialSmartContract.Owner)
+	}
+
+	if len(initialSmartContract.Shard) > 0 && initialSmartContract.Shard != genesis.AllShardsDeployment {
+		_, err := parseShardID(initialSmartContract.Shard)
+		if err != nil {
+			return fmt.Errorf("%w '%s' for owner %s", err, initialSmartContract.Shard, initialSmartContract.Owner)
+		}
+	}
+
+	for shard := range initialSmartContract.ShardInitParameters {
+		_, err := parseShardID(shard)
+		if err != nil {
+			return fmt.Errorf("%w '%s' in shard init parameters for owner %s", err, shard, initialSmartContract.Owner)
+		}
+	}
+
 	return nil
 }
 
+// parseShardID accepts only the canonical decimal form of a shard ID, so the shard init parameters keys can be
+// looked up by the formatted shard ID
+func parseShardID(shard string) (uint32, error) {
+	shardID, err := strconv.ParseUint(shard, 10, 32)
+	if err != nil || strconv.FormatUint(shardID, 10) != shard {
+		return 0, genesis.ErrInvalidSmartContractShard
+	}
+
+	return uint32(shardID), nil
+}
+
 func (scp *smartContractParser) checkForFile(filename string) error {
 	info, err := os.Stat(filename)
 	if os.IsNotExist(err) {
@@ -143,7 +182,9 @@ func (scp *smartContractParser) InitialSmartContracts() []genesis.InitialSmartCo
 	return smartContracts
 }
 
-// InitialSmartContractsSplitOnOwnersShards returns the initial smart contracts split by the owner shards
+// InitialSmartContractsSplitOnOwnersShards returns the initial smart contracts split by the shards they are deployed
+// in: the owner shards, the shards provided by the shard options or all shards for the DNS contracts and for the
+// contracts having the shard option set to "all"
 func (scp *smartContractParser) InitialSmartContractsSplitOnOwnersShards(
 	shardCoordinator sharding.Coordinator,
 ) (map[uint32][]genesis.InitialSmartContractHandler, error) {
@@ -154,7 +195,15 @@ func (scp *smartContractParser) InitialSmartContractsSplitOnOwnersShards(
 
 	var smartContracts = make(map[uint32][]genesis.InitialSmartContractHandler)
 	for _, isc := range scp.initialSmartContracts {
-		if isc.Type == genesis.DNSType {
+		for shard := range isc.ShardInitParameters {
+			shardID, err := parseShardID(shard)
+			if err != nil || shardID >= shardCoordinator.NumberOfShards() {
+				return nil, fmt.Errorf("%w '%s' in shard init parameters for owner %s",
+					genesis.ErrInvalidSmartContractShard, shard, isc.Owner)
+			}
+		}
+
+		if isc.Type == genesis.DNSType || isc.Shard == genesis.AllShardsDeployment {
 			for i := uint32(0); i < shardCoordinator.NumberOfShards(); i++ {
 				smartContracts[i] = append(smartContracts[i], isc)
 			}
@@ -162,6 +211,14 @@ func (scp *smartContractParser) InitialSmartContractsSplitOnOwnersShards(
 		}
 
 		shardID := shardCoordinator.ComputeId(isc.OwnerBytes())
+		if len(isc.Shard) > 0 {
+			var err error
+			shardID, err = parseShardID(isc.Shard)
+			if err != nil || shardID >= shardCoordinator.NumberOfShards() {
+				return nil, fmt.Errorf("%w '%s' for owner %s", genesis.ErrInvalidSmartContractShard, isc.Shard, isc.Owner)
+			}
+		}
+
 		smartContracts[shardID] = append(smartContracts[shardID], isc)
 	}
 
@@ -272,3 +272,73 @@ func TestSmartContractsParser_ProcessDNSTwiceShouldError(t *testing.T) {
 
 	assert.True(t, errors.Is(err, genesis.ErrTooManyDNSContracts))
 }
+
+func TestSmartContractsParser_ProcessInvalidShardOptionsShouldError(t *testing.T) {
+	t.Parallel()
+
+	testProcess := func(isc *data.InitialSmartContract) error {
+		scp := parsing.NewTestSmartContractsParser(createMockHexPubkeyConverter())
+		scp.SetInitialSmartContracts([]*data.InitialSmartContract{isc})
+
+		return scp.Process()
+	}
+
+	isc := createMockInitialSmartContract("0001")
+	isc.Shard = "first"
+	assert.True(t, errors.Is(testProcess(isc), genesis.ErrInvalidSmartContractShard))
+
+	isc = createMockInitialSmartContract("0001")
+	isc.Shard = "01"
+	assert.True(t, errors.Is(testProcess(isc), genesis.ErrInvalidSmartContractShard))
+
+	isc = createMockInitialSmartContract("0001")
+	isc.ShardInitParameters = map[string]string{"-1": "00"}
+	assert.True(t, errors.Is(testProcess(isc), genesis.ErrInvalidSmartContractShard))
+
+	isc = createMockInitialSmartContract("0001")
+	isc.Type = genesis.DelegationType
+	isc.Shard = "1"
+	assert.True(t, errors.Is(testProcess(isc), genesis.ErrInvalidSmartContractShard))
+
+	isc = createMockInitialSmartContract("0001")
+	isc.Shard = genesis.AllShardsDeployment
+	isc.ShardInitParameters = map[string]string{"1": "00"}
+	assert.Nil(t, testProcess(isc))
+}
+
+func TestSmartContractsParser_InitialSmartContractsSplitWithShardOptions(t *testing.T) {
+	t.Parallel()
+
+	threeSharder := &mock.ShardCoordinatorMock{
+		NumOfShards: 3,
+		SelfShardId: 0,
+	}
+	inOwnerShard := createMockInitialSmartContract("0001")
+	inShard2 := createMockInitialSmartContract("0001")
+	inShard2.Shard = "2"
+	inAllShards := createMockInitialSmartContract("0001")
+	inAllShards.Shard = genesis.AllShardsDeployment
+
+	scp := parsing.NewTestSmartContractsParser(createMockHexPubkeyConverter())
+	scp.SetInitialSmartContracts([]*data.InitialSmartContract{inOwnerShard, inShard2, inAllShards})
+	err := scp.Process()
+	require.Nil(t, err)
+
+	icsSplit, err := scp.InitialSmartContractsSplitOnOwnersShards(threeSharder)
+	require.Nil(t, err)
+
+	assert.Equal(t, []genesis.InitialSmartContractHandler{inAllShards}, icsSplit[0])
+	assert.Equal(t, []genesis.InitialSmartContractHandler{inOwnerShard, inAllShards}, icsSplit[1])
+	assert.Equal(t, []genesis.InitialSmartContractHandler{inShard2, inAllShards}, icsSplit[2])
+
+	inShard2.Shard = "3"
+	icsSplit, err = scp.InitialSmartContractsSplitOnOwnersShards(threeSharder)
+	assert.Nil(t, icsSplit)
+	assert.True(t, errors.Is(err, genesis.ErrInvalidSmartContractShard))
+
+	inShard2.Shard = "2"
+	inAllShards.ShardInitParameters = map[string]string{"3": "00"}
+	icsSplit, err = scp.InitialSmartContractsSplitOnOwnersShards(threeSharder)
+	assert.Nil(t, icsSplit)
+	assert.True(t, errors.Is(err, genesis.ErrInvalidSmartContractShard))
+}
@@ -51,5 +51,6 @@ type ArgsGenesisBlockCreator struct {
 	GenesisNodePrice         *big.Int
 	GenesisString            string
 	// created components
-	importHandler update.ImportHandler
+	importHandler  update.ImportHandler
+	mintingReserve *big.Int
 }
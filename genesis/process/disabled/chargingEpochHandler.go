@@ -0,0 +1,35 @@
+package disabled
+
+import "github.com/ElrondNetwork/elrond-go/data/block"
+
+// ChargingEpochHandler implements ChargingEpochHandler interface but does nothing as it is a disabled component
+type ChargingEpochHandler struct {
+}
+
+// SetChargingEpochFromMiniBlock returns nil as it is a disabled component
+func (c *ChargingEpochHandler) SetChargingEpochFromMiniBlock(_ *block.MiniBlock) error {
+	return nil
+}
+
+// ResetChargingEpoch does nothing as it is a disabled component
+func (c *ChargingEpochHandler) ResetChargingEpoch() {
+}
+
+// ChargingEpoch returns the genesis epoch as it is a disabled component
+func (c *ChargingEpochHandler) ChargingEpoch() uint32 {
+	return 0
+}
+
+// SaveChargingEpoch does nothing as it is a disabled component
+func (c *ChargingEpochHandler) SaveChargingEpoch(_ *block.MiniBlock, _ uint32) {
+}
+
+// IsChargingEpochCarried returns false as it is a disabled component
+func (c *ChargingEpochHandler) IsChargingEpochCarried() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (c *ChargingEpochHandler) IsInterfaceNil() bool {
+	return c == nil
+}
@@ -21,6 +21,11 @@ func (fh *FeeHandler) DeveloperPercentage() float64 {
 	return 0
 }
 
+// DeveloperPercentageWithOverride returns 0
+func (fh *FeeHandler) DeveloperPercentageWithOverride(_ float64) float64 {
+	return 0
+}
+
 // MinGasPrice returns 0
 func (fh *FeeHandler) MinGasPrice() uint64 {
 	return 0
@@ -46,6 +51,16 @@ func (fh *FeeHandler) ComputeFeeForProcessing(_ process.TransactionWithFeeHandle
 	return big.NewInt(0)
 }
 
+// ComputeFeeForProcessingInEpoch returns 0
+func (fh *FeeHandler) ComputeFeeForProcessingInEpoch(_ process.TransactionWithFeeHandler, _ uint64, _ uint32) *big.Int {
+	return big.NewInt(0)
+}
+
+// ComputeTxFeeInEpoch returns 0
+func (fh *FeeHandler) ComputeTxFeeInEpoch(_ process.TransactionWithFeeHandler, _ uint32) *big.Int {
+	return big.NewInt(0)
+}
+
 // ComputeTxFee returns 0
 func (fh *FeeHandler) ComputeTxFee(_ process.TransactionWithFeeHandler) *big.Int {
 	return big.NewInt(0)
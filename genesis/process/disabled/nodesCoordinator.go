@@ -0,0 +1,16 @@
+package disabled
+
+// NodesCoordinator implements the NodesCoordinator interface needed by the system smart contracts, it does nothing
+// as it is disabled
+type NodesCoordinator struct {
+}
+
+// GetConsensusValidatorsPublicKeys returns an empty consensus group as it is disabled
+func (nc *NodesCoordinator) GetConsensusValidatorsPublicKeys(_ []byte, _ uint64, _ uint32, _ uint32) ([]string, error) {
+	return make([]string, 0), nil
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (nc *NodesCoordinator) IsInterfaceNil() bool {
+	return nc == nil
+}
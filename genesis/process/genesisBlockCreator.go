@@ -56,6 +56,11 @@ func NewGenesisBlockCreator(arg ArgsGenesisBlockCreator) (*genesisBlockCreator,
 	}
 	gbc.arg.GenesisNodePrice = big.NewInt(0).Set(nodePrice)
 
+	gbc.arg.mintingReserve, err = computeMintingReserve(arg)
+	if err != nil {
+		return nil, err
+	}
+
 	if mustDoHardForkImportProcess(gbc.arg) {
 		err = gbc.createHardForkImportHandler()
 		if err != nil {
@@ -66,6 +71,28 @@ func NewGenesisBlockCreator(arg ArgsGenesisBlockCreator) (*genesisBlockCreator,
 	return gbc, nil
 }
 
+// computeMintingReserve returns the balance credited at genesis to the minting system smart contract, which is 0 if
+// the minting is not enabled. The genesis accounts can not be flagged as mintable if the minting is not enabled
+func computeMintingReserve(arg ArgsGenesisBlockCreator) (*big.Int, error) {
+	mintingConfig := arg.SystemSCConfig.MintingSystemSCConfig
+	if !mintingConfig.Enabled {
+		for _, initialAccount := range arg.AccountsParser.InitialAccounts() {
+			if initialAccount.IsMintable() {
+				return nil, fmt.Errorf("%w, address %s", genesis.ErrMintingNotEnabled, initialAccount.GetAddress())
+			}
+		}
+
+		return big.NewInt(0), nil
+	}
+
+	mintingReserve, ok := big.NewInt(0).SetString(mintingConfig.MintingReserve, 10)
+	if !ok || mintingReserve.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: %s", genesis.ErrInvalidMintingReserve, mintingConfig.MintingReserve)
+	}
+
+	return mintingReserve, nil
+}
+
 func mustDoHardForkImportProcess(arg ArgsGenesisBlockCreator) bool {
 	return arg.HardForkConfig.AfterHardFork && arg.StartEpochNum <= arg.HardForkConfig.StartEpoch
 }
@@ -303,11 +330,43 @@ func (gbc *genesisBlockCreator) CreateGenesisBlocks() (map[uint32]data.HeaderHan
 		return nil, err
 	}
 
+	if !mustDoHardForkImportProcess(gbc.arg) {
+		err = gbc.reconcileSupply(mapArgsGenesisBlockCreator)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	//TODO call here trie pruning on all roothashes not from current shard
 
 	return genesisBlocks, nil
 }
 
+// reconcileSupply creates, saves and checks the supply reconciliation report of the genesis blocks, so the node
+// refuses to start if the genesis state does not match the declared genesis total supply
+func (gbc *genesisBlockCreator) reconcileSupply(mapArgsGenesisBlockCreator map[uint32]ArgsGenesisBlockCreator) error {
+	numStakedNodes := 0
+	eligible, waiting := gbc.arg.InitialNodesSetup.InitialNodesInfo()
+	for shardID := range eligible {
+		numStakedNodes += len(eligible[shardID])
+	}
+	for shardID := range waiting {
+		numStakedNodes += len(waiting[shardID])
+	}
+
+	report, err := createSupplyReconciliationReport(gbc.arg, mapArgsGenesisBlockCreator, numStakedNodes)
+	if err != nil {
+		return err
+	}
+
+	err = saveSupplyReconciliationReport(report, gbc.arg.WorkingDir)
+	if err != nil {
+		return err
+	}
+
+	return report.Check()
+}
+
 func (gbc *genesisBlockCreator) createHeaders(
 	mapArgsGenesisBlockCreator map[uint32]ArgsGenesisBlockCreator,
 	mapHardForkBlockProcessor map[uint32]update.HardForkBlockProcessor,
@@ -486,18 +545,19 @@ func (gbc *genesisBlockCreator) checkDelegationsAgainstDeployedSC(
 
 	initialAccounts := arg.AccountsParser.InitialAccounts()
 	for _, ia := range initialAccounts {
-		dh := ia.GetDelegationHandler()
-		if check.IfNil(dh) {
-			continue
-		}
-		if len(dh.AddressBytes()) == 0 {
-			continue
-		}
+		for _, dh := range ia.GetDelegationHandlers() {
+			if check.IfNil(dh) {
+				continue
+			}
+			if len(dh.AddressBytes()) == 0 {
+				continue
+			}
 
-		found := gbc.searchDeployedContract(allScAddresses, dh.AddressBytes())
-		if !found {
-			return fmt.Errorf("%w for SC address %s, address %s",
-				genesis.ErrMissingDeployedSC, dh.GetAddress(), ia.GetAddress())
+			found := gbc.searchDeployedContract(allScAddresses, dh.AddressBytes())
+			if !found {
+				return fmt.Errorf("%w for SC address %s, address %s",
+					genesis.ErrMissingDeployedSC, dh.GetAddress(), ia.GetAddress())
+			}
 		}
 	}
 
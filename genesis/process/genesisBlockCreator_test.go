@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/genesis"
+	genesisData "github.com/ElrondNetwork/elrond-go/genesis/data"
 	"github.com/ElrondNetwork/elrond-go/genesis/mock"
 	"github.com/ElrondNetwork/elrond-go/genesis/parsing"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -348,6 +349,42 @@ func TestGenesisBlockCreator_CreateGenesisBlocksStakingAndDelegationShouldWorkAn
 	assert.Equal(t, 3, len(blocks))
 }
 
+func TestComputeMintingReserve(t *testing.T) {
+	t.Parallel()
+
+	mintableAccount := &genesisData.InitialAccount{Address: "mintable", Mintable: true}
+	arg := ArgsGenesisBlockCreator{
+		AccountsParser: &mock.AccountsParserStub{
+			InitialAccountsCalled: func() []genesis.InitialAccountHandler {
+				return []genesis.InitialAccountHandler{mintableAccount}
+			},
+		},
+	}
+
+	reserve, err := computeMintingReserve(arg)
+	assert.Nil(t, reserve)
+	assert.True(t, errors.Is(err, genesis.ErrMintingNotEnabled))
+
+	mintableAccount.Mintable = false
+	reserve, err = computeMintingReserve(arg)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), reserve)
+
+	mintableAccount.Mintable = true
+	arg.SystemSCConfig.MintingSystemSCConfig = config.MintingSystemSCConfig{
+		Enabled:        true,
+		MintingReserve: "not a number",
+	}
+	reserve, err = computeMintingReserve(arg)
+	assert.Nil(t, reserve)
+	assert.True(t, errors.Is(err, genesis.ErrInvalidMintingReserve))
+
+	arg.SystemSCConfig.MintingSystemSCConfig.MintingReserve = "1000"
+	reserve, err = computeMintingReserve(arg)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1000), reserve)
+}
+
 func TestCreateArgsGenesisBlockCreator_ShouldErrWhenGetNewArgForShardFails(t *testing.T) {
 	shardIDs := []uint32{0, 1}
 	mapArgsGenesisBlockCreator := make(map[uint32]ArgsGenesisBlockCreator)
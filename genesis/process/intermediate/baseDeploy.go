@@ -1,6 +1,7 @@
 package intermediate
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"io/ioutil"
@@ -93,6 +94,34 @@ func (dp *baseDeploy) deployForOneAddress(
 	return scResultingAddressBytes, nil
 }
 
+func (dp *baseDeploy) changeOwnerAddress(
+	scAddress []byte,
+	currentOwner []byte,
+	newOwner []byte,
+) error {
+	nonce, err := dp.GetNonce(currentOwner)
+	if err != nil {
+		return err
+	}
+
+	txData := []byte(core.BuiltInFunctionChangeOwnerAddress + "@" + hex.EncodeToString(newOwner))
+	err = dp.ExecuteTransaction(nonce, currentOwner, scAddress, big.NewInt(0), txData)
+	if err != nil {
+		return err
+	}
+
+	account, ok := dp.GetAccount(scAddress)
+	if !ok {
+		return genesis.ErrChangeOwnerAddressFailed
+	}
+
+	if !bytes.Equal(account.GetOwnerAddress(), newOwner) {
+		return genesis.ErrChangeOwnerAddressFailed
+	}
+
+	return err
+}
+
 func getSCCodeAsHex(filename string) (string, error) {
 	code, err := ioutil.ReadFile(filepath.Clean(filename))
 	if err != nil {
@@ -1,10 +1,6 @@
 package intermediate
 
 import (
-	"bytes"
-	"encoding/hex"
-	"math/big"
-
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/genesis"
@@ -100,7 +96,8 @@ func (dp *deployLibrarySC) Deploy(sc genesis.InitialSmartContractHandler) ([][]b
 	resultingScAddresses := make([][]byte, 0)
 	newAddresses := GenerateInitialPublicKeys(genesis.InitialDNSAddress, isForCurrentShard)
 	for _, newAddress := range newAddresses {
-		scAddress, errDeploy := dp.deployForOneAddress(sc, newAddress, code, sc.GetInitParameters())
+		initParams := sc.GetInitParametersForShard(dp.shardCoordinator.SelfId())
+		scAddress, errDeploy := dp.deployForOneAddress(sc, newAddress, code, initParams)
 		if errDeploy != nil {
 			return nil, errDeploy
 		}
@@ -115,34 +112,6 @@ func (dp *deployLibrarySC) Deploy(sc genesis.InitialSmartContractHandler) ([][]b
 	return resultingScAddresses, nil
 }
 
-func (dp *deployLibrarySC) changeOwnerAddress(
-	scAddress []byte,
-	currentOwner []byte,
-	newOwner []byte,
-) error {
-	nonce, err := dp.GetNonce(currentOwner)
-	if err != nil {
-		return err
-	}
-
-	txData := []byte(core.BuiltInFunctionChangeOwnerAddress + "@" + hex.EncodeToString(newOwner))
-	err = dp.ExecuteTransaction(nonce, currentOwner, scAddress, big.NewInt(0), txData)
-	if err != nil {
-		return err
-	}
-
-	account, ok := dp.GetAccount(scAddress)
-	if !ok {
-		return genesis.ErrChangeOwnerAddressFailed
-	}
-
-	if !bytes.Equal(account.GetOwnerAddress(), newOwner) {
-		return genesis.ErrChangeOwnerAddressFailed
-	}
-
-	return err
-}
-
 // IsInterfaceNil returns if underlying object is true
 func (dp *deployLibrarySC) IsInterfaceNil() bool {
 	return dp == nil || dp.TxExecutionProcessor == nil
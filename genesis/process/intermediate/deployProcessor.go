@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/genesis"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/vm"
 )
 
@@ -20,16 +21,18 @@ const versionFunction = "version"
 
 // ArgDeployProcessor is the argument used to create a deployProcessor instance
 type ArgDeployProcessor struct {
-	Executor       genesis.TxExecutionProcessor
-	PubkeyConv     core.PubkeyConverter
-	BlockchainHook process.BlockChainHookHandler
-	QueryService   external.SCQueryService
+	Executor         genesis.TxExecutionProcessor
+	PubkeyConv       core.PubkeyConverter
+	BlockchainHook   process.BlockChainHookHandler
+	QueryService     external.SCQueryService
+	ShardCoordinator sharding.Coordinator
 }
 
 type deployProcessor struct {
 	*baseDeploy
-	pubkeyConv     core.PubkeyConverter
-	scQueryService process.SCQueryService
+	pubkeyConv       core.PubkeyConverter
+	scQueryService   process.SCQueryService
+	shardCoordinator sharding.Coordinator
 }
 
 // NewDeployProcessor returns a new instance of deploy processor able to deploy SC
@@ -46,6 +49,9 @@ func NewDeployProcessor(arg ArgDeployProcessor) (*deployProcessor, error) {
 	if check.IfNil(arg.QueryService) {
 		return nil, genesis.ErrNilQueryService
 	}
+	if check.IfNil(arg.ShardCoordinator) {
+		return nil, genesis.ErrNilShardCoordinator
+	}
 
 	base := &baseDeploy{
 		TxExecutionProcessor: arg.Executor,
@@ -56,29 +62,56 @@ func NewDeployProcessor(arg ArgDeployProcessor) (*deployProcessor, error) {
 	}
 
 	dp := &deployProcessor{
-		pubkeyConv:     arg.PubkeyConv,
-		scQueryService: arg.QueryService,
-		baseDeploy:     base,
+		pubkeyConv:       arg.PubkeyConv,
+		scQueryService:   arg.QueryService,
+		shardCoordinator: arg.ShardCoordinator,
+		baseDeploy:       base,
 	}
 
 	return dp, nil
 }
 
-// Deploy will try to deploy the provided smart contract
+// Deploy will try to deploy the provided smart contract. The smart contracts having the shard option set are deployed
+// from the current shard's deployer address and then their ownership is transferred to the configured owner, so their
+// addresses are deterministic and located in the current shard, regardless of the owner's shard
 func (dp *deployProcessor) Deploy(sc genesis.InitialSmartContractHandler) ([][]byte, error) {
 	code, err := dp.getScCodeAsHex(sc.GetFilename())
 	if err != nil {
 		return nil, err
 	}
 
-	scResultingAddressBytes, err := dp.deployForOneAddress(sc, sc.OwnerBytes(), code, applyCommonPlaceholders(sc.GetInitParameters()))
+	isShardDeployment := len(sc.GetShard()) > 0
+	deployerAddress := sc.OwnerBytes()
+	if isShardDeployment {
+		deployerAddress = dp.shardDeployerAddress()
+	}
+
+	initParams := applyCommonPlaceholders(sc.GetInitParametersForShard(dp.shardCoordinator.SelfId()))
+	scResultingAddressBytes, err := dp.deployForOneAddress(sc, deployerAddress, code, initParams)
 	if err != nil {
 		return nil, err
 	}
 
+	if isShardDeployment {
+		err = dp.changeOwnerAddress(scResultingAddressBytes, deployerAddress, sc.OwnerBytes())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return [][]byte{scResultingAddressBytes}, dp.checkVersion(sc, scResultingAddressBytes)
 }
 
+// shardDeployerAddress returns the first address generated from the initial shard deployer address that belongs to
+// the current shard. The generated addresses cover all the shard identifier values, so one is always found
+func (dp *deployProcessor) shardDeployerAddress() []byte {
+	isForCurrentShard := func(address []byte) bool {
+		return dp.shardCoordinator.SelfId() == dp.shardCoordinator.ComputeId(address)
+	}
+
+	return GenerateInitialPublicKeys(genesis.InitialShardDeployerAddress, isForCurrentShard)[0]
+}
+
 func applyCommonPlaceholders(txData string) string {
 	//replace all placeholders containing validatorScAddressPlaceholder with the real hex address
 	txData = strings.Replace(txData, validatorScAddressPlaceholder, hex.EncodeToString(vm.ValidatorSCAddress), -1)
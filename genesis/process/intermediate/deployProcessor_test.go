@@ -9,19 +9,22 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/genesis"
 	"github.com/ElrondNetwork/elrond-go/genesis/data"
 	"github.com/ElrondNetwork/elrond-go/genesis/mock"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createMockDeployArg() ArgDeployProcessor {
 	return ArgDeployProcessor{
-		Executor:       &mock.TxExecutionProcessorStub{},
-		PubkeyConv:     mock.NewPubkeyConverterMock(32),
-		BlockchainHook: &mock.BlockChainHookHandlerMock{},
-		QueryService:   &mock.QueryServiceStub{},
+		Executor:         &mock.TxExecutionProcessorStub{},
+		PubkeyConv:       mock.NewPubkeyConverterMock(32),
+		BlockchainHook:   &mock.BlockChainHookHandlerMock{},
+		QueryService:     &mock.QueryServiceStub{},
+		ShardCoordinator: &mock.ShardCoordinatorMock{NumOfShards: 1},
 	}
 }
 
@@ -69,6 +72,17 @@ func TestNewDeployProcessor_NilQueryServiceShouldErr(t *testing.T) {
 	assert.Equal(t, genesis.ErrNilQueryService, err)
 }
 
+func TestNewDeployProcessor_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockDeployArg()
+	arg.ShardCoordinator = nil
+	dp, err := NewDeployProcessor(arg)
+
+	assert.True(t, check.IfNil(dp))
+	assert.Equal(t, genesis.ErrNilShardCoordinator, err)
+}
+
 func TestNewDeployProcessor_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -222,6 +236,65 @@ func TestDeployProcessor_DeployShouldWork(t *testing.T) {
 	assert.Equal(t, scResulting, scAddresses[0])
 }
 
+func TestDeployProcessor_DeployWithShardOptionShouldUseShardDeployer(t *testing.T) {
+	t.Parallel()
+
+	testCode := "code"
+	vmType := "0500"
+	owner := []byte("owner")
+	arg := createMockDeployArg()
+	arg.ShardCoordinator = &mock.ShardCoordinatorMock{NumOfShards: 4, SelfShardId: 2}
+	senders := make([][]byte, 0)
+	txsData := make([]string, 0)
+	accounts := make(map[string]*mock.UserAccountMock)
+	arg.Executor = &mock.TxExecutionProcessorStub{
+		ExecuteTransactionCalled: func(nonce uint64, sndAddr []byte, rcvAddress []byte, value *big.Int, data []byte) error {
+			senders = append(senders, sndAddr)
+			txsData = append(txsData, string(data))
+			if len(senders) == 1 {
+				accounts[string(append([]byte("sc"), sndAddr...))] = &mock.UserAccountMock{OwnerAddress: sndAddr}
+				return nil
+			}
+
+			accounts[string(rcvAddress)].SetOwnerAddress(owner)
+			return nil
+		},
+		GetAccountCalled: func(address []byte) (state.UserAccountHandler, bool) {
+			account, ok := accounts[string(address)]
+			return account, ok
+		},
+	}
+	arg.BlockchainHook = &mock.BlockChainHookHandlerMock{
+		NewAddressCalled: func(creatorAddress []byte, creatorNonce uint64, vmType []byte) ([]byte, error) {
+			return append([]byte("sc"), creatorAddress...), nil
+		},
+	}
+	dp, _ := NewDeployProcessor(arg)
+	dp.getScCodeAsHex = func(filename string) (string, error) {
+		return testCode, nil
+	}
+
+	sc := &data.InitialSmartContract{
+		VmType:              vmType,
+		InitParameters:      "00",
+		ShardInitParameters: map[string]string{"2": "02"},
+		Shard:               "2",
+	}
+	sc.SetOwnerBytes(owner)
+
+	scAddresses, err := dp.Deploy(sc)
+
+	assert.Nil(t, err)
+	require.Equal(t, 2, len(senders))
+	deployer := senders[0]
+	assert.Equal(t, uint32(2), arg.ShardCoordinator.ComputeId(deployer))
+	assert.Equal(t, deployer, senders[1])
+	assert.False(t, bytes.Equal(owner, deployer))
+	assert.Equal(t, [][]byte{append([]byte("sc"), deployer...)}, scAddresses)
+	assert.Equal(t, fmt.Sprintf("%s@%s@0100@02", testCode, vmType), txsData[0])
+	assert.Equal(t, owner, accounts[string(scAddresses[0])].GetOwnerAddress())
+}
+
 //------- getSCCodeAsHex
 
 func TestDeployProcessor_GetSCCodeAsHexShouldWork(t *testing.T) {
@@ -54,15 +54,11 @@ func NewNodesListSplitter(
 func (nls *nodesListSplitter) isDelegated(address []byte) bool {
 	accounts := nls.accountsParser.InitialAccounts()
 	for _, ac := range accounts {
-		dh := ac.GetDelegationHandler()
+		dh := delegationHandlerForAddress(ac, address)
 		if check.IfNil(dh) {
 			continue
 		}
 
-		if !bytes.Equal(dh.AddressBytes(), address) {
-			continue
-		}
-
 		return dh.GetValue().Cmp(zero) > 0
 	}
 
@@ -56,12 +56,12 @@ func createInitialAccountWithDelegation(delegationAddress string) *data.InitialA
 		Supply:       big.NewInt(10),
 		Balance:      big.NewInt(1),
 		StakingValue: big.NewInt(2),
-		Delegation: &data.DelegationData{
+		Delegations: []*data.DelegationData{{
 			Address: "",
 			Value:   big.NewInt(7),
-		},
+		}},
 	}
-	ia.Delegation.SetAddressBytes([]byte(delegationAddress))
+	ia.Delegations[0].SetAddressBytes([]byte(delegationAddress))
 
 	return ia
 }
@@ -158,6 +158,21 @@ func getDeployedSCAddressBytes(sc genesis.InitialSmartContractHandler) []byte {
 	return sc.AddressesBytes()[0]
 }
 
+// delegationHandlerForAddress returns the delegation of the provided account to the provided delegation address or
+// nil if the account does not delegate to that address
+func delegationHandlerForAddress(ac genesis.InitialAccountHandler, address []byte) genesis.DelegationDataHandler {
+	for _, dh := range ac.GetDelegationHandlers() {
+		if check.IfNil(dh) {
+			continue
+		}
+		if bytes.Equal(dh.AddressBytes(), address) {
+			return dh
+		}
+	}
+
+	return nil
+}
+
 func (sdp *standardDelegationProcessor) setDelegationStartParameters(smartContracts []genesis.InitialSmartContractHandler) error {
 	for _, sc := range smartContracts {
 
@@ -218,7 +233,7 @@ func (sdp *standardDelegationProcessor) executeStake(smartContracts []genesis.In
 				return 0, fmt.Errorf("%w while calling stake function from account %s", err, ac.GetAddress())
 			}
 
-			totalDelegated.Add(totalDelegated, ac.GetDelegationHandler().GetValue())
+			totalDelegated.Add(totalDelegated, delegationHandlerForAddress(ac, getDeployedSCAddressBytes(sc)).GetValue())
 		}
 
 		log.Trace("executeStake",
@@ -236,7 +251,7 @@ func (sdp *standardDelegationProcessor) executeStake(smartContracts []genesis.In
 func (sdp *standardDelegationProcessor) stake(ac genesis.InitialAccountHandler, sc genesis.InitialSmartContractHandler) error {
 	isIntraShardCall := sdp.shardCoordinator.SameShard(ac.AddressBytes(), getDeployedSCAddressBytes(sc))
 
-	dh := ac.GetDelegationHandler()
+	dh := delegationHandlerForAddress(ac, getDeployedSCAddressBytes(sc))
 	if check.IfNil(dh) {
 		return genesis.ErrNilDelegationHandler
 	}
@@ -405,7 +420,7 @@ func (sdp *standardDelegationProcessor) verifyStakedValue(sc genesis.InitialSmar
 		if check.IfNil(delegator) {
 			continue
 		}
-		dh := delegator.GetDelegationHandler()
+		dh := delegationHandlerForAddress(delegator, getDeployedSCAddressBytes(sc))
 		if check.IfNil(dh) {
 			continue
 		}
@@ -413,7 +428,7 @@ func (sdp *standardDelegationProcessor) verifyStakedValue(sc genesis.InitialSmar
 			continue
 		}
 
-		err := sdp.checkDelegator(delegator, sc)
+		err := sdp.checkDelegator(delegator, dh, sc)
 		if err != nil {
 			return err
 		}
@@ -426,6 +441,7 @@ func (sdp *standardDelegationProcessor) verifyStakedValue(sc genesis.InitialSmar
 
 func (sdp *standardDelegationProcessor) checkDelegator(
 	delegator genesis.InitialAccountHandler,
+	dh genesis.DelegationDataHandler,
 	sc genesis.InitialSmartContractHandler,
 ) error {
 	scQueryStakeValue := &process.SCQuery{
@@ -442,10 +458,10 @@ func (sdp *standardDelegationProcessor) checkDelegator(
 	}
 
 	scStakedValue := big.NewInt(0).SetBytes(vmOutputStakeValue.ReturnData[0])
-	if scStakedValue.Cmp(delegator.GetDelegationHandler().GetValue()) != 0 {
+	if scStakedValue.Cmp(dh.GetValue()) != 0 {
 		return fmt.Errorf("%w staked data mismatch: from SC: %s, provided: %s, account %s",
 			genesis.ErrWhileVerifyingDelegation, scStakedValue.String(),
-			delegator.GetDelegationHandler().GetValue().String(), delegator.GetAddress())
+			dh.GetValue().String(), delegator.GetAddress())
 	}
 
 	return nil
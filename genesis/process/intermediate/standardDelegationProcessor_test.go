@@ -194,20 +194,20 @@ func TestStandardDelegationProcessor_ExecuteDelegationStakeShouldWork(t *testing
 	pubkey3 := []byte("pubkey3")
 
 	staker1 := &data.InitialAccount{
-		Delegation: &data.DelegationData{
+		Delegations: []*data.DelegationData{{
 			Value: big.NewInt(2),
-		},
+		}},
 	}
 	staker1.SetAddressBytes([]byte("stakerB"))
-	staker1.Delegation.SetAddressBytes(delegationSc)
+	staker1.Delegations[0].SetAddressBytes(delegationSc)
 
 	staker2 := &data.InitialAccount{
-		Delegation: &data.DelegationData{
+		Delegations: []*data.DelegationData{{
 			Value: big.NewInt(2),
-		},
+		}},
 	}
 	staker2.SetAddressBytes([]byte("stakerC"))
-	staker2.Delegation.SetAddressBytes(delegationSc)
+	staker2.Delegations[0].SetAddressBytes(delegationSc)
 
 	arg := createMockStandardDelegationProcessorArg()
 	arg.Executor = &mock.TxExecutionProcessorStub{
@@ -251,12 +251,12 @@ func TestStandardDelegationProcessor_ExecuteDelegationStakeShouldWork(t *testing
 			if query.FuncName == "getUserStake" {
 				if bytes.Equal(query.Arguments[0], staker1.AddressBytes()) {
 					return &vmcommon.VMOutput{
-						ReturnData: [][]byte{staker1.Delegation.Value.Bytes()},
+						ReturnData: [][]byte{staker1.Delegations[0].Value.Bytes()},
 					}, nil
 				}
 				if bytes.Equal(query.Arguments[0], staker2.AddressBytes()) {
 					return &vmcommon.VMOutput{
-						ReturnData: [][]byte{staker2.Delegation.Value.Bytes()},
+						ReturnData: [][]byte{staker2.Delegations[0].Value.Bytes()},
 					}, nil
 				}
 
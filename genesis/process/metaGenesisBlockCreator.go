@@ -3,6 +3,7 @@ package process
 import (
 	"bytes"
 	"encoding/hex"
+	"fmt"
 	"math"
 	"math/big"
 	"sort"
@@ -16,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/genesis"
@@ -63,6 +65,16 @@ func CreateMetaGenesisBlock(
 		return nil, nil, err
 	}
 
+	err = setVestingSchedules(arg, processors)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = setMintableAccounts(arg, processors)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	rootHash, err := arg.Accounts.Commit()
 	if err != nil {
 		return nil, nil, err
@@ -95,8 +107,14 @@ func CreateMetaGenesisBlock(
 		Reserved:               magicDecoded,
 	}
 
+	// the minting reserve is part of the total supply, as it is held by the minting smart contract
+	totalSupply := big.NewInt(0).Set(arg.Economics.GenesisTotalSupply())
+	if arg.mintingReserve != nil {
+		totalSupply.Add(totalSupply, arg.mintingReserve)
+	}
+
 	header.EpochStart.Economics = block.Economics{
-		TotalSupply:       big.NewInt(0).Set(arg.Economics.GenesisTotalSupply()),
+		TotalSupply:       totalSupply,
 		TotalToDistribute: big.NewInt(0),
 		TotalNewlyMinted:  big.NewInt(0),
 		RewardsPerBlock:   big.NewInt(0),
@@ -265,6 +283,7 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, generalCon
 		MessageSignVerifier: pubKeyVerifier,
 		GasSchedule:         arg.GasSchedule,
 		NodesConfigProvider: arg.InitialNodesSetup,
+		NodesCoordinator:    &disabled.NodesCoordinator{},
 		Hasher:              arg.Hasher,
 		Marshalizer:         arg.Marshalizer,
 		SystemSCConfig:      &arg.SystemSCConfig,
@@ -282,6 +301,7 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, generalCon
 		return nil, err
 	}
 
+	disabledChargingEpochHandler := &disabled.ChargingEpochHandler{}
 	interimProcFactory, err := metachain.NewIntermediateProcessorsContainerFactory(
 		arg.ShardCoordinator,
 		arg.Marshalizer,
@@ -289,6 +309,7 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, generalCon
 		arg.PubkeyConv,
 		arg.Store,
 		arg.DataPool,
+		disabledChargingEpochHandler,
 	)
 	if err != nil {
 		return nil, err
@@ -345,10 +366,12 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, generalCon
 		BuiltInFunctions:               virtualMachineFactory.BlockChainHookImpl().GetBuiltInFunctions(),
 		TxLogsProcessor:                arg.TxLogsProcessor,
 		BadTxForwarder:                 badTxForwarder,
+		ChargingEpochHandler:           disabledChargingEpochHandler,
 		EpochNotifier:                  epochNotifier,
 		DeployEnableEpoch:              generalConfig.SCDeployEnableEpoch,
 		BuiltinEnableEpoch:             generalConfig.BuiltInFunctionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: generalConfig.PenalizedTooMuchGasEnableEpoch,
+		DevRewardsOverrideEnableEpoch:  generalConfig.DeveloperRewardsOverrideEnableEpoch,
 		IsGenesisProcessing:            true,
 	}
 	scProcessor, err := smartContract.NewSmartContractProcessor(argsNewSCProcessor)
@@ -357,16 +380,17 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, generalCon
 	}
 
 	argsNewMetaTxProcessor := processTransaction.ArgsNewMetaTxProcessor{
-		Hasher:           arg.Hasher,
-		Marshalizer:      arg.Marshalizer,
-		Accounts:         arg.Accounts,
-		PubkeyConv:       arg.PubkeyConv,
-		ShardCoordinator: arg.ShardCoordinator,
-		ScProcessor:      scProcessor,
-		TxTypeHandler:    txTypeHandler,
-		EconomicsFee:     genesisFeeHandler,
-		ESDTEnableEpoch:  arg.SystemSCConfig.ESDTSystemSCConfig.EnabledEpoch,
-		EpochNotifier:    epochNotifier,
+		Hasher:              arg.Hasher,
+		Marshalizer:         arg.Marshalizer,
+		Accounts:            arg.Accounts,
+		PubkeyConv:          arg.PubkeyConv,
+		ShardCoordinator:    arg.ShardCoordinator,
+		ScProcessor:         scProcessor,
+		TxTypeHandler:       txTypeHandler,
+		EconomicsFee:        genesisFeeHandler,
+		ESDTEnableEpoch:     arg.SystemSCConfig.ESDTSystemSCConfig.EnabledEpoch,
+		EpochNotifier:       epochNotifier,
+		HasVestingSchedules: arg.AccountsParser.HasVestingSchedules(),
 	}
 	txProcessor, err := processTransaction.NewMetaTxProcessor(argsNewMetaTxProcessor)
 	if err != nil {
@@ -394,6 +418,7 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, generalCon
 		arg.PubkeyConv,
 		disabledBlockSizeComputationHandler,
 		disabledBalanceComputationHandler,
+		disabledChargingEpochHandler,
 	)
 	if err != nil {
 		return nil, err
@@ -422,12 +447,12 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, generalCon
 		return nil, err
 	}
 
-	queryService, err := smartContract.NewSCQueryService(
-		vmContainer,
-		arg.Economics,
-		virtualMachineFactory.BlockChainHookImpl(),
-		arg.Blkc,
-	)
+	queryService, err := smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{
+		VmContainer:    vmContainer,
+		EconomicsFee:   arg.Economics,
+		BlockChainHook: virtualMachineFactory.BlockChainHookImpl(),
+		BlockChain:     arg.Blkc,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -484,6 +509,113 @@ func deploySystemSmartContracts(
 	return nil
 }
 
+// setVestingSchedules registers the vesting schedules of the genesis balances in the vesting smart contract, each
+// account registering its own schedule
+func setVestingSchedules(arg ArgsGenesisBlockCreator, processors *genesisProcessors) error {
+	numSchedules := 0
+	for _, initialAccount := range arg.AccountsParser.InitialAccounts() {
+		schedule := initialAccount.GetVestingSchedule()
+		if schedule == nil {
+			continue
+		}
+
+		txData := strings.Join([]string{
+			"registerSchedule",
+			hex.EncodeToString(schedule.Value.Bytes()),
+			hex.EncodeToString(big.NewInt(int64(schedule.CliffEpoch)).Bytes()),
+			hex.EncodeToString(big.NewInt(int64(schedule.EndEpoch)).Bytes()),
+		}, "@")
+		tx := &transaction.Transaction{
+			Nonce:     0,
+			Value:     big.NewInt(0),
+			RcvAddr:   vm.VestingSCAddress,
+			SndAddr:   initialAccount.AddressBytes(),
+			GasPrice:  0,
+			GasLimit:  math.MaxUint64,
+			Data:      []byte(txData),
+			Signature: nil,
+		}
+
+		retCode, err := processors.txProcessor.ProcessTransaction(tx)
+		if err != nil {
+			return err
+		}
+		if retCode != vmcommon.Ok {
+			return fmt.Errorf("%w for address %s, return code %s",
+				genesis.ErrVestingScheduleNotRegistered, initialAccount.GetAddress(), retCode)
+		}
+
+		numSchedules++
+	}
+
+	log.Debug("meta block genesis",
+		"num vesting schedules", numSchedules,
+	)
+
+	return nil
+}
+
+// setMintableAccounts credits the minting reserve to the minting smart contract and registers the genesis accounts
+// flagged as mintable, each account registering itself
+func setMintableAccounts(arg ArgsGenesisBlockCreator, processors *genesisProcessors) error {
+	if arg.mintingReserve == nil || arg.mintingReserve.Sign() == 0 {
+		return nil
+	}
+
+	accWrp, err := arg.Accounts.LoadAccount(vm.MintingSCAddress)
+	if err != nil {
+		return err
+	}
+	account, ok := accWrp.(state.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+	err = account.AddToBalance(arg.mintingReserve)
+	if err != nil {
+		return err
+	}
+	err = arg.Accounts.SaveAccount(account)
+	if err != nil {
+		return err
+	}
+
+	numMintable := 0
+	for _, initialAccount := range arg.AccountsParser.InitialAccounts() {
+		if !initialAccount.IsMintable() {
+			continue
+		}
+
+		tx := &transaction.Transaction{
+			Nonce:     0,
+			Value:     big.NewInt(0),
+			RcvAddr:   vm.MintingSCAddress,
+			SndAddr:   initialAccount.AddressBytes(),
+			GasPrice:  0,
+			GasLimit:  math.MaxUint64,
+			Data:      []byte("registerMintable"),
+			Signature: nil,
+		}
+
+		retCode, err := processors.txProcessor.ProcessTransaction(tx)
+		if err != nil {
+			return err
+		}
+		if retCode != vmcommon.Ok {
+			return fmt.Errorf("%w for address %s, return code %s",
+				genesis.ErrMintableAccountNotRegistered, initialAccount.GetAddress(), retCode)
+		}
+
+		numMintable++
+	}
+
+	log.Debug("meta block genesis",
+		"minting reserve", arg.mintingReserve,
+		"num mintable accounts", numMintable,
+	)
+
+	return nil
+}
+
 // setStakedData sets the initial staked values to the staking smart contract
 // it will register both categories of nodes: direct staked and delegated stake. This is done because it is the only
 // way possible due to the fact that the delegation contract can not call a sandbox-ed processor suite and accounts state
@@ -8,6 +8,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/forking"
 	"github.com/ElrondNetwork/elrond-go/core/parsers"
@@ -53,6 +54,7 @@ func createGenesisConfig() config.GeneralSettingsConfig {
 		TransactionSignedWithTxHashEnableEpoch: unreachableEpoch,
 		SwitchHysteresisForMinNodesEnableEpoch: unreachableEpoch,
 		SwitchJailWaitingEnableEpoch:           unreachableEpoch,
+		DeveloperRewardsOverrideEnableEpoch:    unreachableEpoch,
 	}
 }
 
@@ -249,16 +251,30 @@ func setBalanceToTrie(arg ArgsGenesisBlockCreator, accnt genesis.InitialAccountH
 		return err
 	}
 
+	// the vesting schedule is kept under a protected key, so it can not be altered by smart contracts
+	schedule := accnt.GetVestingSchedule()
+	if schedule != nil {
+		err = account.DataTrieTracker().SaveKeyValue([]byte(core.VestingScheduleKey), schedule.ToBytes())
+		if err != nil {
+			return err
+		}
+	}
+
 	return arg.Accounts.SaveAccount(account)
 }
 
 func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalConfig config.GeneralSettingsConfig) (*genesisProcessors, error) {
+	epochNotifier := forking.NewGenericEpochNotifier()
+	epochNotifier.CheckEpoch(arg.StartEpochNum)
+
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:          arg.GasSchedule,
-		MapDNSAddresses:      make(map[string]struct{}),
-		EnableUserNameChange: false,
-		Marshalizer:          arg.Marshalizer,
-		Accounts:             arg.Accounts,
+		GasSchedule:                         arg.GasSchedule,
+		MapDNSAddresses:                     make(map[string]struct{}),
+		EnableUserNameChange:                false,
+		Marshalizer:                         arg.Marshalizer,
+		Accounts:                            arg.Accounts,
+		EpochNotifier:                       epochNotifier,
+		DeveloperRewardsOverrideEnableEpoch: generalConfig.DeveloperRewardsOverrideEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -304,6 +320,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 		return nil, err
 	}
 
+	disabledChargingEpochHandler := &disabled.ChargingEpochHandler{}
 	interimProcFactory, err := shard.NewIntermediateProcessorsContainerFactory(
 		arg.ShardCoordinator,
 		arg.Marshalizer,
@@ -311,6 +328,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 		arg.PubkeyConv,
 		arg.Store,
 		arg.DataPool,
+		disabledChargingEpochHandler,
 	)
 	if err != nil {
 		return nil, err
@@ -347,9 +365,6 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 		return nil, err
 	}
 
-	epochNotifier := forking.NewGenericEpochNotifier()
-	epochNotifier.CheckEpoch(arg.StartEpochNum)
-
 	gasHandler, err := preprocess.NewGasComputation(arg.Economics, txTypeHandler, epochNotifier, generalConfig.SCDeployEnableEpoch)
 	if err != nil {
 		return nil, err
@@ -374,10 +389,12 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 		BuiltInFunctions:               vmFactoryImpl.BlockChainHookImpl().GetBuiltInFunctions(),
 		TxLogsProcessor:                arg.TxLogsProcessor,
 		BadTxForwarder:                 badTxInterim,
+		ChargingEpochHandler:           disabledChargingEpochHandler,
 		EpochNotifier:                  epochNotifier,
 		BuiltinEnableEpoch:             generalConfig.BuiltInFunctionsEnableEpoch,
 		DeployEnableEpoch:              generalConfig.SCDeployEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: generalConfig.PenalizedTooMuchGasEnableEpoch,
+		DevRewardsOverrideEnableEpoch:  generalConfig.DeveloperRewardsOverrideEnableEpoch,
 		IsGenesisProcessing:            true,
 	}
 	scProcessor, err := smartContract.NewSmartContractProcessor(argsNewScProcessor)
@@ -413,6 +430,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 		RelayedTxEnableEpoch:           generalConfig.RelayedTransactionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: generalConfig.PenalizedTooMuchGasEnableEpoch,
 		MetaProtectionEnableEpoch:      generalConfig.MetaProtectionEnableEpoch,
+		HasVestingSchedules:            arg.AccountsParser.HasVestingSchedules(),
 	}
 	transactionProcessor, err := transaction.NewTxProcessor(argsNewTxProcessor)
 	if err != nil {
@@ -442,6 +460,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 		disabledBlockTracker,
 		disabledBlockSizeComputationHandler,
 		disabledBalanceComputationHandler,
+		disabledChargingEpochHandler,
 	)
 	if err != nil {
 		return nil, err
@@ -470,12 +489,12 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 		return nil, err
 	}
 
-	queryService, err := smartContract.NewSCQueryService(
-		vmContainer,
-		arg.Economics,
-		vmFactoryImpl.BlockChainHookImpl(),
-		arg.Blkc,
-	)
+	queryService, err := smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{
+		VmContainer:    vmContainer,
+		EconomicsFee:   arg.Economics,
+		BlockChainHook: vmFactoryImpl.BlockChainHookImpl(),
+		BlockChain:     arg.Blkc,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -513,6 +532,14 @@ func deployInitialSmartContracts(
 				err, sc.GetOwner(), sc.GetFilename())
 		}
 
+		for _, scAddress := range scResulted {
+			log.Debug("genesis SC deployed",
+				"shard", arg.ShardCoordinator.SelfId(),
+				"SC owner", sc.GetOwner(),
+				"type", sc.GetType(),
+				"SC address", arg.PubkeyConv.Encode(scAddress),
+			)
+		}
 		scAddresses = append(scAddresses, scResulted...)
 	}
 
@@ -551,10 +578,11 @@ func deployInitialSmartContract(
 		fallthrough
 	default:
 		argDeploy := intermediate.ArgDeployProcessor{
-			Executor:       txExecutor,
-			PubkeyConv:     arg.PubkeyConv,
-			BlockchainHook: processors.blockchainHook,
-			QueryService:   processors.queryService,
+			Executor:         txExecutor,
+			PubkeyConv:       arg.PubkeyConv,
+			BlockchainHook:   processors.blockchainHook,
+			QueryService:     processors.queryService,
+			ShardCoordinator: arg.ShardCoordinator,
 		}
 		deployProc, err = intermediate.NewDeployProcessor(argDeploy)
 		if err != nil {
@@ -638,18 +666,19 @@ func incrementNoncesForCrossShardDelegations(processors *genesisProcessors, arg
 	counter := 0
 	initalAddressesInCurrentShard := initialAddresses[arg.ShardCoordinator.SelfId()]
 	for _, ia := range initalAddressesInCurrentShard {
-		dh := ia.GetDelegationHandler()
-		if check.IfNil(dh) {
-			continue
-		}
-		if arg.ShardCoordinator.SameShard(ia.AddressBytes(), dh.AddressBytes()) {
-			continue
-		}
-
-		counter++
-		err = txExecutor.AddNonce(ia.AddressBytes(), 1)
-		if err != nil {
-			return 0, fmt.Errorf("%w when adding nonce for address %s", err, ia.GetAddress())
+		for _, dh := range ia.GetDelegationHandlers() {
+			if check.IfNil(dh) {
+				continue
+			}
+			if arg.ShardCoordinator.SameShard(ia.AddressBytes(), dh.AddressBytes()) {
+				continue
+			}
+
+			counter++
+			err = txExecutor.AddNonce(ia.AddressBytes(), 1)
+			if err != nil {
+				return 0, fmt.Errorf("%w when adding nonce for address %s", err, ia.GetAddress())
+			}
 		}
 	}
 
@@ -0,0 +1,204 @@
+package process
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/genesis"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// SupplyReportFileName is the name of the file, written in the working directory, holding the genesis supply
+// reconciliation report
+const SupplyReportFileName = "genesisSupplyReport.json"
+
+// ShardSupplyReport holds the genesis supply of the initial accounts of a shard. The declared values are the ones
+// from the genesis accounts file, while the state balance is the sum of the balances read from the shard state after
+// the genesis block was created
+type ShardSupplyReport struct {
+	NumAccounts     int      `json:"numAccounts"`
+	Supply          *big.Int `json:"supply"`
+	Balance         *big.Int `json:"balance"`
+	StakingValue    *big.Int `json:"stakingValue"`
+	DelegationValue *big.Int `json:"delegationValue"`
+	StateBalance    *big.Int `json:"stateBalance"`
+}
+
+// SupplyReconciliationReport holds the reconciliation of the genesis supply, shard by shard, against the declared
+// genesis total supply. The rounding adjustment is the staked and delegated value which does not back any genesis
+// node, the genesis node price being the staking unit. It is only reported, as the nodes setup checker already
+// validates the staked values of the genesis nodes. The genesis is refused if any mismatch is found
+type SupplyReconciliationReport struct {
+	Shards               map[uint32]*ShardSupplyReport `json:"shards"`
+	TotalBalance         *big.Int                      `json:"totalBalance"`
+	TotalStakingValue    *big.Int                      `json:"totalStakingValue"`
+	TotalDelegationValue *big.Int                      `json:"totalDelegationValue"`
+	NumStakedNodes       int                           `json:"numStakedNodes"`
+	NodePrice            *big.Int                      `json:"nodePrice"`
+	RoundingAdjustment   *big.Int                      `json:"roundingAdjustment"`
+	MintingReserve       *big.Int                      `json:"mintingReserve"`
+	ComputedTotalSupply  *big.Int                      `json:"computedTotalSupply"`
+	DeclaredTotalSupply  *big.Int                      `json:"declaredTotalSupply"`
+	Mismatches           []string                      `json:"mismatches"`
+}
+
+func newShardSupplyReport() *ShardSupplyReport {
+	return &ShardSupplyReport{
+		Supply:          big.NewInt(0),
+		Balance:         big.NewInt(0),
+		StakingValue:    big.NewInt(0),
+		DelegationValue: big.NewInt(0),
+		StateBalance:    big.NewInt(0),
+	}
+}
+
+// createSupplyReconciliationReport computes the genesis supply reconciliation report. The accounts adapters of all
+// the shards, as used when creating the genesis blocks, are needed in order to read back the genesis balances
+func createSupplyReconciliationReport(
+	arg ArgsGenesisBlockCreator,
+	mapArgsGenesisBlockCreator map[uint32]ArgsGenesisBlockCreator,
+	numStakedNodes int,
+) (*SupplyReconciliationReport, error) {
+	report := &SupplyReconciliationReport{
+		Shards:               make(map[uint32]*ShardSupplyReport),
+		TotalBalance:         big.NewInt(0),
+		TotalStakingValue:    big.NewInt(0),
+		TotalDelegationValue: big.NewInt(0),
+		NumStakedNodes:       numStakedNodes,
+		NodePrice:            big.NewInt(0).Set(arg.GenesisNodePrice),
+		MintingReserve:       big.NewInt(0),
+		ComputedTotalSupply:  big.NewInt(0),
+		DeclaredTotalSupply:  big.NewInt(0).Set(arg.Economics.GenesisTotalSupply()),
+		Mismatches:           make([]string, 0),
+	}
+	if arg.mintingReserve != nil {
+		report.MintingReserve.Set(arg.mintingReserve)
+	}
+
+	accountsOnShards, err := arg.AccountsParser.InitialAccountsSplitOnAddressesShards(arg.ShardCoordinator)
+	if err != nil {
+		return nil, err
+	}
+
+	for shardID, initialAccounts := range accountsOnShards {
+		shardReport, errAdd := report.addShard(shardID, initialAccounts, mapArgsGenesisBlockCreator[shardID].Accounts)
+		if errAdd != nil {
+			return nil, errAdd
+		}
+
+		report.Shards[shardID] = shardReport
+	}
+
+	report.reconcileTotals()
+
+	return report, nil
+}
+
+func (report *SupplyReconciliationReport) addShard(
+	shardID uint32,
+	initialAccounts []genesis.InitialAccountHandler,
+	accounts state.AccountsAdapter,
+) (*ShardSupplyReport, error) {
+	if accounts == nil {
+		return nil, fmt.Errorf("%w for shard %d", process.ErrNilAccountsAdapter, shardID)
+	}
+
+	shardReport := newShardSupplyReport()
+	for _, initialAccount := range initialAccounts {
+		shardReport.NumAccounts++
+		shardReport.Supply.Add(shardReport.Supply, initialAccount.GetSupply())
+		shardReport.Balance.Add(shardReport.Balance, initialAccount.GetBalanceValue())
+		shardReport.StakingValue.Add(shardReport.StakingValue, initialAccount.GetStakingValue())
+		for _, delegation := range initialAccount.GetDelegationHandlers() {
+			if check.IfNil(delegation) {
+				continue
+			}
+			shardReport.DelegationValue.Add(shardReport.DelegationValue, delegation.GetValue())
+		}
+
+		accountHandler, err := accounts.GetExistingAccount(initialAccount.AddressBytes())
+		if err != nil {
+			return nil, fmt.Errorf("'%w' while reading the genesis balance of %s", err, initialAccount.GetAddress())
+		}
+		account, ok := accountHandler.(state.UserAccountHandler)
+		if !ok {
+			return nil, process.ErrWrongTypeAssertion
+		}
+
+		shardReport.StateBalance.Add(shardReport.StateBalance, account.GetBalance())
+	}
+
+	components := big.NewInt(0).Add(shardReport.Balance, shardReport.StakingValue)
+	components.Add(components, shardReport.DelegationValue)
+	if components.Cmp(shardReport.Supply) != 0 {
+		report.addMismatch("shard %d: supply %s, balance + staking + delegation %s",
+			shardID, shardReport.Supply, components)
+	}
+	if shardReport.StateBalance.Cmp(shardReport.Balance) != 0 {
+		report.addMismatch("shard %d: declared balance %s, state balance %s",
+			shardID, shardReport.Balance, shardReport.StateBalance)
+	}
+
+	report.TotalBalance.Add(report.TotalBalance, shardReport.Balance)
+	report.TotalStakingValue.Add(report.TotalStakingValue, shardReport.StakingValue)
+	report.TotalDelegationValue.Add(report.TotalDelegationValue, shardReport.DelegationValue)
+	report.ComputedTotalSupply.Add(report.ComputedTotalSupply, shardReport.Supply)
+
+	return shardReport, nil
+}
+
+func (report *SupplyReconciliationReport) reconcileTotals() {
+	stakedByNodes := big.NewInt(0).Mul(report.NodePrice, big.NewInt(int64(report.NumStakedNodes)))
+	report.RoundingAdjustment = big.NewInt(0).Add(report.TotalStakingValue, report.TotalDelegationValue)
+	report.RoundingAdjustment.Sub(report.RoundingAdjustment, stakedByNodes)
+
+	if report.ComputedTotalSupply.Cmp(report.DeclaredTotalSupply) != 0 {
+		report.addMismatch("declared total supply %s, computed %s",
+			report.DeclaredTotalSupply, report.ComputedTotalSupply)
+	}
+
+	// the mismatches of the shards are added in the map iteration order
+	sort.Strings(report.Mismatches)
+}
+
+func (report *SupplyReconciliationReport) addMismatch(format string, args ...interface{}) {
+	report.Mismatches = append(report.Mismatches, fmt.Sprintf(format, args...))
+}
+
+// Check returns an error describing all the mismatches of the report, if any
+func (report *SupplyReconciliationReport) Check() error {
+	if len(report.Mismatches) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", genesis.ErrSupplyReconciliationMismatch, strings.Join(report.Mismatches, "; "))
+}
+
+// saveSupplyReconciliationReport logs the report and, if a working directory is provided, writes it as json
+func saveSupplyReconciliationReport(report *SupplyReconciliationReport, workingDir string) error {
+	buff, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	log.Debug("genesis supply reconciliation report", "report", string(buff))
+	log.Info("genesis supply reconciliation",
+		"declared total supply", report.DeclaredTotalSupply,
+		"computed total supply", report.ComputedTotalSupply,
+		"num mismatches", len(report.Mismatches),
+	)
+
+	if len(workingDir) == 0 {
+		return nil
+	}
+
+	return ioutil.WriteFile(filepath.Join(workingDir, SupplyReportFileName), buff, core.FileModeUserReadWrite)
+}
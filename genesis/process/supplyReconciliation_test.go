@@ -0,0 +1,137 @@
+package process
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/genesis"
+	genesisData "github.com/ElrondNetwork/elrond-go/genesis/data"
+	"github.com/ElrondNetwork/elrond-go/genesis/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSupplyReconciliationArguments(
+	declaredTotalSupply int64,
+	stateBalances map[string]int64,
+) (ArgsGenesisBlockCreator, map[uint32]ArgsGenesisBlockCreator) {
+	account0 := &genesisData.InitialAccount{
+		Address:      "account0",
+		Supply:       big.NewInt(1000),
+		Balance:      big.NewInt(500),
+		StakingValue: big.NewInt(500),
+	}
+	account0.SetAddressBytes([]byte("account0"))
+	account1 := &genesisData.InitialAccount{
+		Address:      "account1",
+		Supply:       big.NewInt(2000),
+		Balance:      big.NewInt(1000),
+		StakingValue: big.NewInt(0),
+		Delegations:  []*genesisData.DelegationData{{Value: big.NewInt(1000)}},
+	}
+	account1.SetAddressBytes([]byte("account1"))
+
+	arg := ArgsGenesisBlockCreator{
+		GenesisNodePrice: big.NewInt(500),
+		Economics: &economicsmocks.EconomicsHandlerStub{
+			GenesisTotalSupplyCalled: func() *big.Int {
+				return big.NewInt(declaredTotalSupply)
+			},
+		},
+		AccountsParser: &mock.AccountsParserStub{
+			InitialAccountsSplitOnAddressesShardsCalled: func(_ sharding.Coordinator) (map[uint32][]genesis.InitialAccountHandler, error) {
+				return map[uint32][]genesis.InitialAccountHandler{
+					0: {account0},
+					1: {account1},
+				}, nil
+			},
+		},
+	}
+
+	accounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			account, _ := state.NewUserAccount(address)
+			_ = account.AddToBalance(big.NewInt(stateBalances[string(address)]))
+			return account, nil
+		},
+	}
+	mapArgs := map[uint32]ArgsGenesisBlockCreator{
+		0: {Accounts: accounts},
+		1: {Accounts: accounts},
+	}
+
+	return arg, mapArgs
+}
+
+func TestCreateSupplyReconciliationReport_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	stateBalances := map[string]int64{"account0": 500, "account1": 1000}
+	arg, mapArgs := createSupplyReconciliationArguments(3000, stateBalances)
+
+	report, err := createSupplyReconciliationReport(arg, mapArgs, 2)
+	require.Nil(t, err)
+	assert.Nil(t, report.Check())
+
+	assert.Equal(t, 2, len(report.Shards))
+	assert.Equal(t, big.NewInt(1000), report.Shards[0].Supply)
+	assert.Equal(t, big.NewInt(500), report.Shards[0].StateBalance)
+	assert.Equal(t, big.NewInt(1000), report.Shards[1].DelegationValue)
+	assert.Equal(t, big.NewInt(1500), report.TotalBalance)
+	assert.Equal(t, big.NewInt(500), report.TotalStakingValue)
+	assert.Equal(t, big.NewInt(1000), report.TotalDelegationValue)
+	assert.Equal(t, big.NewInt(500), report.RoundingAdjustment)
+	assert.Equal(t, big.NewInt(3000), report.ComputedTotalSupply)
+	assert.Equal(t, big.NewInt(3000), report.DeclaredTotalSupply)
+}
+
+func TestCreateSupplyReconciliationReport_MismatchesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stateBalances := map[string]int64{"account0": 500, "account1": 999}
+	arg, mapArgs := createSupplyReconciliationArguments(3001, stateBalances)
+
+	report, err := createSupplyReconciliationReport(arg, mapArgs, 3)
+	require.Nil(t, err)
+	assert.Equal(t, 0, report.RoundingAdjustment.Cmp(big.NewInt(0)))
+	assert.Equal(t, []string{
+		"declared total supply 3001, computed 3000",
+		"shard 1: declared balance 1000, state balance 999",
+	}, report.Mismatches)
+
+	err = report.Check()
+	assert.True(t, errors.Is(err, genesis.ErrSupplyReconciliationMismatch))
+}
+
+func TestSaveSupplyReconciliationReport(t *testing.T) {
+	t.Parallel()
+
+	workingDir, err := ioutil.TempDir("", "genesisSupplyReport")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(workingDir)
+	}()
+
+	stateBalances := map[string]int64{"account0": 500, "account1": 1000}
+	arg, mapArgs := createSupplyReconciliationArguments(3000, stateBalances)
+	report, _ := createSupplyReconciliationReport(arg, mapArgs, 2)
+
+	err = saveSupplyReconciliationReport(report, workingDir)
+	require.Nil(t, err)
+
+	buff, err := ioutil.ReadFile(filepath.Join(workingDir, SupplyReportFileName))
+	require.Nil(t, err)
+
+	recovered := &SupplyReconciliationReport{}
+	err = json.Unmarshal(buff, recovered)
+	require.Nil(t, err)
+	assert.Equal(t, report, recovered)
+}
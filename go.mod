@@ -24,6 +24,7 @@ require (
 	github.com/herumi/bls-go-binary v0.0.0-20200324054641-17de9ae04665
 	github.com/ipfs/go-log v1.0.4
 	github.com/jbenet/goprocess v0.1.4
+	github.com/lib/pq v1.10.9
 	github.com/libp2p/go-libp2p v0.10.3
 	github.com/libp2p/go-libp2p-core v0.6.1
 	github.com/libp2p/go-libp2p-discovery v0.5.0
@@ -35,6 +36,7 @@ require (
 	github.com/multiformats/go-multiaddr v0.2.2
 	github.com/pelletier/go-toml v1.8.0
 	github.com/pkg/errors v0.9.1
+	github.com/segmentio/kafka-go v0.4.8
 	github.com/shirou/gopsutil v0.0.0-20190731134726-d80c43f9c984
 	github.com/stretchr/testify v1.6.1
 	github.com/syndtr/goleveldb v1.0.1-0.20190318030020-c3a204f8e965
@@ -42,6 +44,7 @@ require (
 	github.com/whyrusleeping/timecache v0.0.0-20160911033111-cfcb2f1abfee
 	golang.org/x/crypto v0.0.0-20200510223506-06a226fb4e37
 	golang.org/x/net v0.0.0-20200519113804-d87ec0cfa476
+	google.golang.org/grpc v1.27.0
 	gopkg.in/go-playground/validator.v8 v8.18.2
 )
 
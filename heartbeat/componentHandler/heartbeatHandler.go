@@ -1,9 +1,8 @@
 package componentHandler
 
 import (
-	"context"
 	"fmt"
-	"math/rand"
+	"io"
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -11,15 +10,20 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
-	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/monitor"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/notifier"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
-	heartbeatStorage "github.com/ElrondNetwork/elrond-go/heartbeat/storage"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/sender"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
 	peerProcess "github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/peer"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
 
 var log = logger.GetOrCreate("heartbeat/componenthandler")
@@ -33,9 +37,9 @@ type ArgHeartbeat struct {
 	ShardCoordinator         sharding.Coordinator
 	NodesCoordinator         sharding.NodesCoordinator
 	AppStatusHandler         core.AppStatusHandler
-	Storer                   storage.Storer
-	ValidatorStatistics      heartbeat.ValidatorStatisticsProcessor
 	PeerSignatureHandler     crypto.PeerSignatureHandler
+	SingleSigner             crypto.SingleSigner
+	KeyGenerator             crypto.KeyGenerator
 	PrivKey                  crypto.PrivateKey
 	HardforkTrigger          heartbeat.HardforkTrigger
 	AntifloodHandler         heartbeat.P2PAntifloodHandler
@@ -43,22 +47,24 @@ type ArgHeartbeat struct {
 	EpochStartTrigger        sharding.EpochHandler
 	EpochStartRegistration   sharding.EpochStartEventNotifier
 	Timer                    heartbeat.Timer
-	GenesisTime              time.Time
 	VersionNumber            string
 	PeerShardMapper          heartbeat.NetworkShardingCollector
 	SizeCheckDelta           uint32
 	ValidatorsProvider       peerProcess.ValidatorsProvider
 	CurrentBlockProvider     heartbeat.CurrentBlockProvider
+	ManagedPeersHolder       heartbeat.ManagedPeersHolder
+	NodeMetricsProvider      heartbeat.NodeMetricsProvider
+	PushNotifier             heartbeat.PeerStatusNotifier
 }
 
-// HeartbeatHandler is the struct used to manage heartbeat subsystem consisting of a heartbeat sender and monitor
-// wired on a dedicated p2p topic
+// HeartbeatHandler is the struct used to manage the heartbeat subsystem. The senders broadcast the peer
+// authentication and the heartbeat messages on dedicated p2p topics, while the received messages are validated,
+// cached and combined by the monitor into the heartbeat status of the peers
 type HeartbeatHandler struct {
-	monitor          *process.Monitor
-	sender           *process.Sender
-	arg              ArgHeartbeat
-	peerTypeProvider *peer.PeerTypeProvider
-	cancelFunc       func()
+	monitor heartbeat.MonitorHandler
+	sender  io.Closer
+	alerts  []io.Closer
+	arg     ArgHeartbeat
 }
 
 // NewHeartbeatHandler will create a heartbeat handler containing both a monitor and a sender
@@ -78,28 +84,21 @@ func NewHeartbeatHandler(arg ArgHeartbeat) (*HeartbeatHandler, error) {
 func (hbh *HeartbeatHandler) create() error {
 	arg := hbh.arg
 
-	var ctx context.Context
-	ctx, hbh.cancelFunc = context.WithCancel(context.Background())
-
 	err := hbh.checkConfigParams(arg.HeartbeatConfig)
 	if err != nil {
 		return err
 	}
-
 	if check.IfNil(arg.Messenger) {
 		return heartbeat.ErrNilMessenger
 	}
 
-	if arg.Messenger.HasTopicValidator(core.HeartbeatTopic) {
-		return heartbeat.ErrValidatorAlreadySet
-	}
-
-	if !arg.Messenger.HasTopic(core.HeartbeatTopic) {
-		err = arg.Messenger.CreateTopic(core.HeartbeatTopic, true)
+	for _, topic := range []string{core.PeerAuthenticationTopic, core.HeartbeatV2Topic} {
+		err = createTopic(arg.Messenger, topic)
 		if err != nil {
 			return err
 		}
 	}
+
 	argPeerTypeProvider := peer.ArgPeerTypeProvider{
 		NodesCoordinator:        arg.NodesCoordinator,
 		StartEpoch:              arg.EpochStartTrigger.MetaEpoch(),
@@ -109,139 +108,227 @@ func (hbh *HeartbeatHandler) create() error {
 	if err != nil {
 		return err
 	}
-	hbh.peerTypeProvider = peerTypeProvider
-	argSender := process.ArgHeartbeatSender{
-		PeerMessenger:        arg.Messenger,
-		PeerSignatureHandler: arg.PeerSignatureHandler,
-		PrivKey:              arg.PrivKey,
-		Marshalizer:          arg.Marshalizer,
-		Topic:                core.HeartbeatTopic,
-		ShardCoordinator:     arg.ShardCoordinator,
-		PeerTypeProvider:     peerTypeProvider,
-		StatusHandler:        arg.AppStatusHandler,
-		VersionNumber:        arg.VersionNumber,
-		NodeDisplayName:      arg.PrefsConfig.NodeDisplayName,
-		KeyBaseIdentity:      arg.PrefsConfig.Identity,
-		HardforkTrigger:      arg.HardforkTrigger,
-		CurrentBlockProvider: arg.CurrentBlockProvider,
+
+	peerAuthenticationCache, err := storageUnit.NewCache(storageFactory.GetCacherFromConfig(arg.HeartbeatConfig.PeerAuthenticationCache))
+	if err != nil {
+		return err
+	}
+	verifiedPayloadsCache, err := storageUnit.NewCache(storageFactory.GetCacherFromConfig(arg.HeartbeatConfig.VerifiedPayloadsCache))
+	if err != nil {
+		return err
+	}
+	heartbeatCache, err := storageUnit.NewCache(storageFactory.GetCacherFromConfig(arg.HeartbeatConfig.HeartbeatCache))
+	if err != nil {
+		return err
 	}
 
-	hbh.sender, err = process.NewSender(argSender)
+	err = hbh.createSender(peerTypeProvider)
 	if err != nil {
 		return err
 	}
 
 	log.Debug("heartbeat's sender component has been instantiated")
 
-	heartBeatMsgProcessor, err := process.NewMessageProcessor(
-		arg.PeerSignatureHandler,
-		arg.Marshalizer,
-		arg.PeerShardMapper,
-	)
+	err = hbh.registerProcessors(peerAuthenticationCache, verifiedPayloadsCache, heartbeatCache)
 	if err != nil {
 		return err
 	}
 
-	heartbeatStorer, err := heartbeatStorage.NewHeartbeatDbStorer(arg.Storer, arg.Marshalizer)
+	argMonitor := monitor.ArgHeartbeatV2Monitor{
+		Marshalizer:                   arg.Marshalizer,
+		PeerAuthenticationCache:       peerAuthenticationCache,
+		HeartbeatCache:                heartbeatCache,
+		PeerTypeProvider:              peerTypeProvider,
+		ValidatorPubkeyConverter:      arg.ValidatorPubkeyConverter,
+		Timer:                         arg.Timer,
+		MaxDurationPeerUnresponsive:   time.Second * time.Duration(arg.HeartbeatConfig.DurationToConsiderUnresponsiveInSec),
+		HideInactiveValidatorInterval: time.Second * time.Duration(arg.HeartbeatConfig.HideInactiveValidatorIntervalInSec),
+	}
+	hbh.monitor, err = monitor.NewHeartbeatV2Monitor(argMonitor)
 	if err != nil {
 		return err
 	}
 
-	timer := &process.RealTimer{}
-	netInputMarshalizer := arg.Marshalizer
-	if arg.SizeCheckDelta > 0 {
-		netInputMarshalizer = marshal.NewSizeCheckUnmarshalizer(arg.Marshalizer, arg.SizeCheckDelta)
+	log.Debug("heartbeat's monitor component has been instantiated")
+
+	return hbh.createAlerts()
+}
+
+// createAlerts starts watching the tracked public keys, if the alerts are enabled. The liveness changes are posted to
+// the configured webhooks and pushed to the push notifications subscribers
+func (hbh *HeartbeatHandler) createAlerts() error {
+	arg := hbh.arg
+	cfg := arg.HeartbeatConfig.Alerts
+	if !cfg.Enabled {
+		return nil
 	}
 
-	allValidators, _, _ := hbh.getLatestValidators()
-	pubKeysMap := make(map[uint32][]string)
-	for shardID, valsInShard := range allValidators {
-		for _, val := range valsInShard {
-			pubKeysMap[shardID] = append(pubKeysMap[shardID], string(val.PublicKey))
+	notifiers := make([]heartbeat.PeerStatusNotifier, 0, 2)
+	if len(cfg.WebhookURLs) > 0 {
+		argWebhookNotifier := notifier.ArgWebhookNotifier{
+			URLs:       cfg.WebhookURLs,
+			Timeout:    time.Second * time.Duration(cfg.WebhookTimeoutInSec),
+			BufferSize: cfg.WebhookBufferSize,
+		}
+		webhookNotifier, err := notifier.NewWebhookNotifier(argWebhookNotifier)
+		if err != nil {
+			return err
 		}
+
+		hbh.alerts = append(hbh.alerts, webhookNotifier)
+		notifiers = append(notifiers, webhookNotifier)
+	}
+	if cfg.PushNotificationEnabled {
+		if check.IfNil(arg.PushNotifier) {
+			return heartbeat.ErrNilPeerStatusNotifier
+		}
+
+		notifiers = append(notifiers, arg.PushNotifier)
 	}
 
-	argMonitor := process.ArgHeartbeatMonitor{
-		Marshalizer:                        netInputMarshalizer,
-		MaxDurationPeerUnresponsive:        time.Second * time.Duration(arg.HeartbeatConfig.DurationToConsiderUnresponsiveInSec),
-		PubKeysMap:                         pubKeysMap,
-		GenesisTime:                        arg.GenesisTime,
-		MessageHandler:                     heartBeatMsgProcessor,
-		Storer:                             heartbeatStorer,
-		PeerTypeProvider:                   peerTypeProvider,
-		Timer:                              timer,
-		AntifloodHandler:                   arg.AntifloodHandler,
-		HardforkTrigger:                    arg.HardforkTrigger,
-		ValidatorPubkeyConverter:           arg.ValidatorPubkeyConverter,
-		HeartbeatRefreshIntervalInSec:      arg.HeartbeatConfig.HeartbeatRefreshIntervalInSec,
-		HideInactiveValidatorIntervalInSec: arg.HeartbeatConfig.HideInactiveValidatorIntervalInSec,
-	}
-	hbh.monitor, err = process.NewMonitor(argMonitor)
+	argPeerStatusWatcher := monitor.ArgPeerStatusWatcher{
+		Monitor:               hbh.monitor,
+		Notifiers:             notifiers,
+		Timer:                 arg.Timer,
+		TrackedPublicKeys:     cfg.TrackedPublicKeys,
+		TrackedIdentities:     cfg.TrackedIdentities,
+		TimeBetweenHeartbeats: time.Second * time.Duration(arg.HeartbeatConfig.MaxTimeToWaitBetweenBroadcastsInSec),
+		NumMissedHeartbeats:   cfg.NumMissedHeartbeats,
+		CheckInterval:         time.Second * time.Duration(cfg.CheckIntervalInSec),
+	}
+	watcher, err := monitor.NewPeerStatusWatcher(argPeerStatusWatcher)
 	if err != nil {
 		return err
 	}
 
-	log.Debug("heartbeat's monitor component has been instantiated")
+	watcher.StartWatching()
+	hbh.alerts = append(hbh.alerts, watcher)
+
+	log.Debug("heartbeat's alerts have been enabled", "num notifiers", len(notifiers))
+
+	return nil
+}
+
+func createTopic(messenger heartbeat.P2PMessenger, topic string) error {
+	if messenger.HasTopicValidator(topic) {
+		return fmt.Errorf("%w for topic %s", heartbeat.ErrValidatorAlreadySet, topic)
+	}
+	if messenger.HasTopic(topic) {
+		return nil
+	}
+
+	return messenger.CreateTopic(topic, true)
+}
 
-	err = hbh.monitor.SetAppStatusHandler(arg.AppStatusHandler)
+// createSender creates the senders of the node. All the nodes send heartbeat messages, while the peer authentication
+// sender only broadcasts messages for the validator keys held by the node
+func (hbh *HeartbeatHandler) createSender(peerTypeProvider heartbeat.PeerTypeProviderHandler) error {
+	arg := hbh.arg
+	cfg := arg.HeartbeatConfig
+
+	argPeerAuthenticationSender := sender.ArgPeerAuthenticationSender{
+		ArgBaseSender: sender.ArgBaseSender{
+			Messenger:                 arg.Messenger,
+			Marshalizer:               arg.Marshalizer,
+			Topic:                     core.PeerAuthenticationTopic,
+			TimeBetweenSends:          time.Second * time.Duration(cfg.PeerAuthenticationTimeBetweenSendsInSec),
+			TimeBetweenSendsThreshold: time.Second * time.Duration(cfg.PeerAuthenticationTimeThresholdBetweenSendsInSec),
+		},
+		PeerSignatureHandler: arg.PeerSignatureHandler,
+		SingleSigner:         arg.SingleSigner,
+		PrivKey:              arg.PrivKey,
+		PeerTypeProvider:     peerTypeProvider,
+		StatusHandler:        arg.AppStatusHandler,
+		HardforkTrigger:      arg.HardforkTrigger,
+		ManagedPeersHolder:   arg.ManagedPeersHolder,
+	}
+	peerAuthenticationSender, err := sender.NewPeerAuthenticationSender(argPeerAuthenticationSender)
 	if err != nil {
 		return err
 	}
 
-	err = arg.Messenger.RegisterMessageProcessor(core.HeartbeatTopic, hbh.monitor)
+	argHeartbeatSender := sender.ArgHeartbeatSender{
+		ArgBaseSender: sender.ArgBaseSender{
+			Messenger:                 arg.Messenger,
+			Marshalizer:               arg.Marshalizer,
+			Topic:                     core.HeartbeatV2Topic,
+			TimeBetweenSends:          time.Second * time.Duration(cfg.MinTimeToWaitBetweenBroadcastsInSec),
+			TimeBetweenSendsThreshold: time.Second * time.Duration(cfg.MaxTimeToWaitBetweenBroadcastsInSec-cfg.MinTimeToWaitBetweenBroadcastsInSec),
+		},
+		VersionNumber:        arg.VersionNumber,
+		NodeDisplayName:      arg.PrefsConfig.NodeDisplayName,
+		Identity:             arg.PrefsConfig.Identity,
+		ShardCoordinator:     arg.ShardCoordinator,
+		CurrentBlockProvider: arg.CurrentBlockProvider,
+		NodeMetricsProvider:  arg.NodeMetricsProvider,
+		ManagedPeersHolder:   arg.ManagedPeersHolder,
+	}
+	heartbeatSender, err := sender.NewHeartbeatSender(argHeartbeatSender)
 	if err != nil {
 		return err
 	}
 
-	go hbh.startSendingHeartbeats(ctx)
+	hbh.sender = sender.NewRoutineHandler(peerAuthenticationSender, heartbeatSender, arg.HardforkTrigger)
 
 	return nil
 }
 
-func (hbh *HeartbeatHandler) getLatestValidators() (map[uint32][]*state.ValidatorInfo, map[string]*state.ValidatorApiResponse, error) {
-	latestHash, err := hbh.arg.ValidatorStatistics.RootHash()
-	if err != nil {
-		return nil, nil, err
+func (hbh *HeartbeatHandler) registerProcessors(
+	peerAuthenticationCache storage.Cacher,
+	verifiedPayloadsCache storage.Cacher,
+	heartbeatCache storage.Cacher,
+) error {
+	arg := hbh.arg
+	maxTimeDifference := time.Second * time.Duration(arg.HeartbeatConfig.MaxTimeDifferenceInSec)
+	netInputMarshalizer := arg.Marshalizer
+	if arg.SizeCheckDelta > 0 {
+		netInputMarshalizer = marshal.NewSizeCheckUnmarshalizer(arg.Marshalizer, arg.SizeCheckDelta)
 	}
 
-	validators, err := hbh.arg.ValidatorStatistics.GetValidatorInfoForRootHash(latestHash)
+	argPeerAuthenticationProcessor := process.ArgPeerAuthenticationProcessor{
+		Marshalizer:              netInputMarshalizer,
+		PeerSignatureHandler:     arg.PeerSignatureHandler,
+		SingleSigner:             arg.SingleSigner,
+		KeyGenerator:             arg.KeyGenerator,
+		Cache:                    peerAuthenticationCache,
+		VerifiedPayloadsCache:    verifiedPayloadsCache,
+		Hasher:                   sha256.Sha256{},
+		AntifloodHandler:         arg.AntifloodHandler,
+		HardforkTrigger:          arg.HardforkTrigger,
+		NetworkShardingCollector: arg.PeerShardMapper,
+		Timer:                    arg.Timer,
+		MaxTimeDifference:        maxTimeDifference,
+	}
+	peerAuthenticationProcessor, err := process.NewPeerAuthenticationProcessor(argPeerAuthenticationProcessor)
 	if err != nil {
-		return nil, nil, err
+		return err
 	}
 
-	return validators, nil, nil
-}
-
-func (hbh *HeartbeatHandler) startSendingHeartbeats(ctx context.Context) {
-	r := rand.New(rand.NewSource(time.Now().Unix()))
-	cfg := hbh.arg.HeartbeatConfig
-
-	log.Debug("heartbeat's endless sending go routine started")
-
-	diffSeconds := cfg.MaxTimeToWaitBetweenBroadcastsInSec - cfg.MinTimeToWaitBetweenBroadcastsInSec
-	diffNanos := int64(diffSeconds) * time.Second.Nanoseconds()
-
-	for {
-		randomNanos := r.Int63n(diffNanos)
-		timeToWait := time.Second*time.Duration(cfg.MinTimeToWaitBetweenBroadcastsInSec) + time.Duration(randomNanos)
-
-		select {
-		case <-ctx.Done():
-			log.Debug("heartbeat's go routine is stopping...")
-			return
-		case <-time.After(timeToWait):
-		case <-hbh.arg.HardforkTrigger.NotifyTriggerReceived(): //this will force an immediate broadcast of the trigger
-			//message on the network
-			log.Debug("hardfork message prepared for heartbeat sending")
-		}
+	argHeartbeatProcessor := process.ArgHeartbeatProcessor{
+		Marshalizer:              netInputMarshalizer,
+		Cache:                    heartbeatCache,
+		AntifloodHandler:         arg.AntifloodHandler,
+		NetworkShardingCollector: arg.PeerShardMapper,
+		Timer:                    arg.Timer,
+		MaxTimeDifference:        maxTimeDifference,
+	}
+	heartbeatProcessor, err := process.NewHeartbeatProcessor(argHeartbeatProcessor)
+	if err != nil {
+		return err
+	}
 
-		err := hbh.sender.SendHeartbeat()
+	processors := map[string]p2p.MessageProcessor{
+		core.PeerAuthenticationTopic: peerAuthenticationProcessor,
+		core.HeartbeatV2Topic:        heartbeatProcessor,
+	}
+	for topic, processor := range processors {
+		err = arg.Messenger.RegisterMessageProcessor(topic, processor)
 		if err != nil {
-			log.Debug("SendHeartbeat", "error", err.Error())
+			return err
 		}
-
-		hbh.monitor.Cleanup()
 	}
+
+	return nil
 }
 
 func (hbh *HeartbeatHandler) checkConfigParams(config config.HeartbeatConfig) error {
@@ -260,24 +347,33 @@ func (hbh *HeartbeatHandler) checkConfigParams(config config.HeartbeatConfig) er
 	if config.DurationToConsiderUnresponsiveInSec <= config.MaxTimeToWaitBetweenBroadcastsInSec {
 		return fmt.Errorf("%w for DurationToConsiderUnresponsiveInSec", heartbeat.ErrWrongValues)
 	}
+	if config.PeerAuthenticationTimeBetweenSendsInSec < 1 {
+		return fmt.Errorf("%w for PeerAuthenticationTimeBetweenSendsInSec", heartbeat.ErrWrongValues)
+	}
+	if config.PeerAuthenticationTimeThresholdBetweenSendsInSec < 0 {
+		return fmt.Errorf("%w for PeerAuthenticationTimeThresholdBetweenSendsInSec", heartbeat.ErrWrongValues)
+	}
+	if config.MaxTimeDifferenceInSec < 1 {
+		return fmt.Errorf("%w for MaxTimeDifferenceInSec", heartbeat.ErrWrongValues)
+	}
 
 	return nil
 }
 
 // Monitor returns the monitor component
-func (hbh *HeartbeatHandler) Monitor() *process.Monitor {
+func (hbh *HeartbeatHandler) Monitor() heartbeat.MonitorHandler {
 	return hbh.monitor
 }
 
-// Sender returns the sender component
-func (hbh *HeartbeatHandler) Sender() *process.Sender {
-	return hbh.sender
-}
-
-// Close will close the endless running go routine
+// Close will stop sending the heartbeat messages and watching the tracked public keys
 func (hbh *HeartbeatHandler) Close() error {
-	hbh.cancelFunc()
 	log.Debug("calling close on heartbeat system")
+	for _, closer := range hbh.alerts {
+		_ = closer.Close()
+	}
+	if hbh.sender != nil {
+		return hbh.sender.Close()
+	}
 
 	return nil
 }
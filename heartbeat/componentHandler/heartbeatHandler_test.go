@@ -3,12 +3,15 @@ package componentHandler
 import (
 	"errors"
 	"testing"
-	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -16,38 +19,61 @@ import (
 func createMockArgument() ArgHeartbeat {
 	arg := ArgHeartbeat{
 		HeartbeatConfig: config.HeartbeatConfig{
-			MinTimeToWaitBetweenBroadcastsInSec: 2,
-			MaxTimeToWaitBetweenBroadcastsInSec: 3,
-			DurationToConsiderUnresponsiveInSec: 10,
-			HeartbeatRefreshIntervalInSec:       1,
-			HideInactiveValidatorIntervalInSec:  20,
+			MinTimeToWaitBetweenBroadcastsInSec:              2,
+			MaxTimeToWaitBetweenBroadcastsInSec:              3,
+			DurationToConsiderUnresponsiveInSec:              10,
+			HideInactiveValidatorIntervalInSec:               20,
+			PeerAuthenticationTimeBetweenSendsInSec:          5,
+			PeerAuthenticationTimeThresholdBetweenSendsInSec: 1,
+			MaxTimeDifferenceInSec:                           20,
+			PeerAuthenticationCache: config.CacheConfig{
+				Type:     "LRU",
+				Capacity: 100,
+			},
+			VerifiedPayloadsCache: config.CacheConfig{
+				Type:     "LRU",
+				Capacity: 100,
+			},
+			HeartbeatCache: config.CacheConfig{
+				Type:     "LRU",
+				Capacity: 100,
+			},
 		},
 		PrefsConfig: config.PreferencesConfig{
 			DestinationShardAsObserver: "0",
 			NodeDisplayName:            "node name",
 			Identity:                   "identity",
 		},
-		Marshalizer:              &mock.MarshalizerMock{},
-		Messenger:                &mock.MessengerStub{},
-		ShardCoordinator:         &mock.ShardCoordinatorMock{},
-		NodesCoordinator:         &mock.NodesCoordinatorMock{},
-		AppStatusHandler:         &mock.AppStatusHandlerStub{},
-		Storer:                   mock.NewStorerMock(),
-		ValidatorStatistics:      &mock.ValidatorStatisticsStub{},
-		PeerSignatureHandler:     &mock.PeerSignatureHandler{},
-		PrivKey:                  &mock.PrivateKeyStub{},
+		Marshalizer:          &mock.MarshalizerMock{},
+		Messenger:            &mock.MessengerStub{},
+		ShardCoordinator:     &mock.ShardCoordinatorMock{},
+		NodesCoordinator:     &mock.NodesCoordinatorMock{},
+		AppStatusHandler:     &mock.AppStatusHandlerStub{},
+		PeerSignatureHandler: &mock.PeerSignatureHandler{Signer: &mock.SinglesignMock{}},
+		SingleSigner:         &mock.SinglesignMock{},
+		KeyGenerator:         &mock.KeyGenMock{},
+		PrivKey: &mock.PrivateKeyStub{
+			GeneratePublicHandler: func() crypto.PublicKey {
+				return &mock.PublicKeyMock{
+					ToByteArrayHandler: func() ([]byte, error) {
+						return []byte("public key"), nil
+					},
+				}
+			},
+		},
 		HardforkTrigger:          &mock.HardforkTriggerStub{},
 		AntifloodHandler:         &mock.P2PAntifloodHandlerStub{},
 		ValidatorPubkeyConverter: mock.NewPubkeyConverterMock(32),
 		EpochStartTrigger:        &mock.EpochStartTriggerStub{},
 		EpochStartRegistration:   &mock.EpochStartNotifierStub{},
 		Timer:                    mock.NewTimerMock(),
-		GenesisTime:              time.Time{},
 		VersionNumber:            "v0.0.0",
 		PeerShardMapper:          &mock.NetworkShardingCollectorStub{},
 		SizeCheckDelta:           0,
 		ValidatorsProvider:       &mock.ValidatorsProviderStub{},
 		CurrentBlockProvider:     &mock.CurrentBlockProviderStub{},
+		ManagedPeersHolder:       &testscommon.ManagedPeersHolderStub{},
+		NodeMetricsProvider:      &mock.NodeMetricsProviderStub{},
 	}
 
 	return arg
@@ -123,16 +149,118 @@ func TestNewHeartbeatHandler_NilMessenger(t *testing.T) {
 	assert.Equal(t, heartbeat.ErrNilMessenger, err)
 }
 
+func TestNewHeartbeatHandler_InvalidPeerAuthenticationTimeBetweenSendsInSec(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.HeartbeatConfig.PeerAuthenticationTimeBetweenSendsInSec = 0
+	hbh, err := NewHeartbeatHandler(arg)
+
+	assert.True(t, check.IfNil(hbh))
+	assert.True(t, errors.Is(err, heartbeat.ErrWrongValues))
+}
+
+func TestNewHeartbeatHandler_InvalidMaxTimeDifferenceInSec(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.HeartbeatConfig.MaxTimeDifferenceInSec = 0
+	hbh, err := NewHeartbeatHandler(arg)
+
+	assert.True(t, check.IfNil(hbh))
+	assert.True(t, errors.Is(err, heartbeat.ErrWrongValues))
+}
+
+func TestNewHeartbeatHandler_TopicValidatorAlreadySetShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.Messenger = &mock.MessengerStub{
+		HasTopicValidatorCalled: func(name string) bool {
+			return true
+		},
+	}
+	hbh, err := NewHeartbeatHandler(arg)
+
+	assert.True(t, check.IfNil(hbh))
+	assert.True(t, errors.Is(err, heartbeat.ErrValidatorAlreadySet))
+}
+
 func TestNewHeartbeatHandler_ShouldWork(t *testing.T) {
 	t.Parallel()
 
+	registeredTopics := make(map[string]struct{})
 	arg := createMockArgument()
+	arg.Messenger = &mock.MessengerStub{
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			registeredTopics[topic] = struct{}{}
+			return nil
+		},
+	}
 	hbh, err := NewHeartbeatHandler(arg)
 
 	assert.Nil(t, err)
 	assert.False(t, check.IfNil(hbh))
-	require.NotNil(t, hbh.Monitor())
-	require.NotNil(t, hbh.Sender())
+	require.False(t, check.IfNil(hbh.Monitor()))
+	assert.Equal(t, map[string]struct{}{core.PeerAuthenticationTopic: {}, core.HeartbeatV2Topic: {}}, registeredTopics)
+
+	err = hbh.Close()
+	assert.Nil(t, err)
+}
+
+func TestNewHeartbeatHandler_AlertsWithoutPushNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.HeartbeatConfig.Alerts = config.HeartbeatAlertsConfig{
+		Enabled:                 true,
+		TrackedPublicKeys:       []string{"pk"},
+		NumMissedHeartbeats:     3,
+		CheckIntervalInSec:      1,
+		PushNotificationEnabled: true,
+	}
+	hbh, err := NewHeartbeatHandler(arg)
+
+	assert.True(t, check.IfNil(hbh))
+	assert.Equal(t, heartbeat.ErrNilPeerStatusNotifier, err)
+}
+
+func TestNewHeartbeatHandler_AlertsWithoutNotifiersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.HeartbeatConfig.Alerts = config.HeartbeatAlertsConfig{
+		Enabled:             true,
+		TrackedPublicKeys:   []string{"pk"},
+		NumMissedHeartbeats: 3,
+		CheckIntervalInSec:  1,
+	}
+	hbh, err := NewHeartbeatHandler(arg)
+
+	assert.True(t, check.IfNil(hbh))
+	assert.Equal(t, heartbeat.ErrNoPeerStatusNotifiers, err)
+}
+
+func TestNewHeartbeatHandler_WithAlertsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.HeartbeatConfig.Alerts = config.HeartbeatAlertsConfig{
+		Enabled:                 true,
+		TrackedIdentities:       []string{"identity"},
+		NumMissedHeartbeats:     3,
+		CheckIntervalInSec:      1,
+		WebhookURLs:             []string{"http://localhost"},
+		WebhookTimeoutInSec:     1,
+		WebhookBufferSize:       10,
+		PushNotificationEnabled: true,
+	}
+	arg.PushNotifier = &mock.PeerStatusNotifierStub{}
+	hbh, err := NewHeartbeatHandler(arg)
+
+	assert.Nil(t, err)
+	require.False(t, check.IfNil(hbh))
+	assert.Equal(t, 2, len(hbh.alerts))
 
 	err = hbh.Close()
 	assert.Nil(t, err)
@@ -0,0 +1,1002 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: heartbeatV2.proto
+
+package data
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type HeartbeatV2 struct {
+	Payload                  []byte            `protobuf:"bytes,1,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	VersionNumber            string            `protobuf:"bytes,2,opt,name=VersionNumber,proto3" json:"VersionNumber,omitempty"`
+	NodeDisplayName          string            `protobuf:"bytes,3,opt,name=NodeDisplayName,proto3" json:"NodeDisplayName,omitempty"`
+	Identity                 string            `protobuf:"bytes,4,opt,name=Identity,proto3" json:"Identity,omitempty"`
+	Nonce                    uint64            `protobuf:"varint,5,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	ShardID                  uint32            `protobuf:"varint,6,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	DiskFreeBytes            uint64            `protobuf:"varint,7,opt,name=DiskFreeBytes,proto3" json:"DiskFreeBytes,omitempty"`
+	SyncDistance             uint64            `protobuf:"varint,8,opt,name=SyncDistance,proto3" json:"SyncDistance,omitempty"`
+	IsTrieSnapshotInProgress bool              `protobuf:"varint,9,opt,name=IsTrieSnapshotInProgress,proto3" json:"IsTrieSnapshotInProgress,omitempty"`
+	ConnectedPeersPerShard   map[uint32]uint32 `protobuf:"bytes,10,rep,name=ConnectedPeersPerShard,proto3" json:"ConnectedPeersPerShard,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ManagedKeys              [][]byte          `protobuf:"bytes,11,rep,name=ManagedKeys,proto3" json:"ManagedKeys,omitempty"`
+}
+
+func (m *HeartbeatV2) Reset()      { *m = HeartbeatV2{} }
+func (*HeartbeatV2) ProtoMessage() {}
+func (*HeartbeatV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a015ba718fb71d33, []int{0}
+}
+func (m *HeartbeatV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeartbeatV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HeartbeatV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeartbeatV2.Merge(m, src)
+}
+func (m *HeartbeatV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeartbeatV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeartbeatV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeartbeatV2 proto.InternalMessageInfo
+
+func (m *HeartbeatV2) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *HeartbeatV2) GetVersionNumber() string {
+	if m != nil {
+		return m.VersionNumber
+	}
+	return ""
+}
+
+func (m *HeartbeatV2) GetNodeDisplayName() string {
+	if m != nil {
+		return m.NodeDisplayName
+	}
+	return ""
+}
+
+func (m *HeartbeatV2) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *HeartbeatV2) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *HeartbeatV2) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+func (m *HeartbeatV2) GetDiskFreeBytes() uint64 {
+	if m != nil {
+		return m.DiskFreeBytes
+	}
+	return 0
+}
+
+func (m *HeartbeatV2) GetSyncDistance() uint64 {
+	if m != nil {
+		return m.SyncDistance
+	}
+	return 0
+}
+
+func (m *HeartbeatV2) GetIsTrieSnapshotInProgress() bool {
+	if m != nil {
+		return m.IsTrieSnapshotInProgress
+	}
+	return false
+}
+
+func (m *HeartbeatV2) GetConnectedPeersPerShard() map[uint32]uint32 {
+	if m != nil {
+		return m.ConnectedPeersPerShard
+	}
+	return nil
+}
+
+func (m *HeartbeatV2) GetManagedKeys() [][]byte {
+	if m != nil {
+		return m.ManagedKeys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*HeartbeatV2)(nil), "proto.HeartbeatV2")
+	proto.RegisterMapType((map[uint32]uint32)(nil), "proto.HeartbeatV2.ConnectedPeersPerShardEntry")
+}
+
+func init() { proto.RegisterFile("heartbeatV2.proto", fileDescriptor_a015ba718fb71d33) }
+
+var fileDescriptor_a015ba718fb71d33 = []byte{
+	// 410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0x31, 0x6f, 0x13, 0x31,
+	0x18, 0x3d, 0x37, 0x49, 0x9b, 0x3a, 0x89, 0x00, 0x0b, 0x21, 0xab, 0x48, 0x96, 0x55, 0x31, 0x78,
+	0xba, 0xa1, 0x2c, 0x28, 0x63, 0x39, 0x10, 0x27, 0xc4, 0xe9, 0xe4, 0xa0, 0x0e, 0x6c, 0x4e, 0xee,
+	0xa3, 0x39, 0x35, 0xb5, 0x23, 0xdb, 0x45, 0xf2, 0xc6, 0x4f, 0xe0, 0x17, 0x30, 0xf3, 0x53, 0x18,
+	0x33, 0x76, 0x24, 0x97, 0x85, 0xb1, 0x3f, 0x01, 0x9d, 0xa3, 0x42, 0x83, 0x28, 0xd3, 0xdd, 0x7b,
+	0xdf, 0xfb, 0x9e, 0x9e, 0xdf, 0x87, 0x1f, 0xcd, 0x41, 0x59, 0x3f, 0x05, 0xe5, 0xcf, 0x4e, 0xd2,
+	0xa5, 0x35, 0xde, 0x90, 0x5e, 0xfc, 0x1c, 0x7f, 0xed, 0xe2, 0xc1, 0x9b, 0x3f, 0x43, 0x42, 0xf1,
+	0x41, 0xa9, 0xc2, 0xc2, 0xa8, 0x8a, 0x22, 0x8e, 0xc4, 0x50, 0xde, 0x42, 0xf2, 0x0c, 0x8f, 0xce,
+	0xc0, 0xba, 0xda, 0xe8, 0xe2, 0xea, 0x72, 0x0a, 0x96, 0xee, 0x71, 0x24, 0x0e, 0xe5, 0x2e, 0x49,
+	0x04, 0x7e, 0x50, 0x98, 0x0a, 0xb2, 0xda, 0x2d, 0x17, 0x2a, 0x14, 0xea, 0x12, 0x68, 0x27, 0xea,
+	0xfe, 0xa6, 0xc9, 0x11, 0xee, 0xe7, 0x15, 0x68, 0x5f, 0xfb, 0x40, 0xbb, 0x51, 0xf2, 0x1b, 0x93,
+	0xc7, 0xb8, 0x57, 0x18, 0x3d, 0x03, 0xda, 0xe3, 0x48, 0x74, 0xe5, 0x16, 0xb4, 0xd9, 0x26, 0x73,
+	0x65, 0xab, 0x3c, 0xa3, 0xfb, 0x1c, 0x89, 0x91, 0xbc, 0x85, 0x6d, 0xb6, 0xac, 0x76, 0x17, 0xaf,
+	0x2d, 0xc0, 0x69, 0xf0, 0xe0, 0xe8, 0x41, 0xdc, 0xdb, 0x25, 0xc9, 0x31, 0x1e, 0x4e, 0x82, 0x9e,
+	0x65, 0xb5, 0xf3, 0xaa, 0x35, 0xef, 0x47, 0xd1, 0x0e, 0x47, 0xc6, 0x98, 0xe6, 0xee, 0xbd, 0xad,
+	0x61, 0xa2, 0xd5, 0xd2, 0xcd, 0x8d, 0xcf, 0x75, 0x69, 0xcd, 0xb9, 0x05, 0xe7, 0xe8, 0x21, 0x47,
+	0xa2, 0x2f, 0xef, 0x9d, 0x93, 0x8f, 0xf8, 0xc9, 0x4b, 0xa3, 0x35, 0xcc, 0x3c, 0x54, 0x25, 0x80,
+	0x75, 0x25, 0xd8, 0x98, 0x90, 0x62, 0xde, 0x11, 0x83, 0x93, 0x74, 0x5b, 0x7d, 0x7a, 0xa7, 0xef,
+	0xf4, 0xdf, 0x0b, 0xaf, 0xb4, 0xb7, 0x41, 0xde, 0xe3, 0x46, 0x38, 0x1e, 0xbc, 0x53, 0x5a, 0x9d,
+	0x43, 0xf5, 0x16, 0x82, 0xa3, 0x03, 0xde, 0x11, 0x43, 0x79, 0x97, 0x3a, 0xca, 0xf1, 0xd3, 0xff,
+	0x18, 0x93, 0x87, 0xb8, 0x73, 0x01, 0x21, 0x1e, 0x78, 0x24, 0xdb, 0xdf, 0xb6, 0xf0, 0x4f, 0x6a,
+	0x71, 0x05, 0xf1, 0xa8, 0x23, 0xb9, 0x05, 0xe3, 0xbd, 0x17, 0xe8, 0x74, 0xbc, 0x5a, 0xb3, 0xe4,
+	0x7a, 0xcd, 0x92, 0x9b, 0x35, 0x43, 0x9f, 0x1b, 0x86, 0xbe, 0x35, 0x0c, 0x7d, 0x6f, 0x18, 0x5a,
+	0x35, 0x0c, 0xfd, 0x68, 0x18, 0xfa, 0xd9, 0xb0, 0xe4, 0xa6, 0x61, 0xe8, 0xcb, 0x86, 0x25, 0xab,
+	0x0d, 0x4b, 0xae, 0x37, 0x2c, 0xf9, 0xd0, 0xad, 0x94, 0x57, 0xd3, 0xfd, 0xf8, 0xde, 0xe7, 0xbf,
+	0x06, 0x00, 0x00, 0xd6, 0xf9, 0xc5, 0x7f, 0x02, 0x00, 0x00,
+}
+
+func (this *HeartbeatV2) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HeartbeatV2)
+	if !ok {
+		that2, ok := that.(HeartbeatV2)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	if this.VersionNumber != that1.VersionNumber {
+		return false
+	}
+	if this.NodeDisplayName != that1.NodeDisplayName {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	if this.DiskFreeBytes != that1.DiskFreeBytes {
+		return false
+	}
+	if this.SyncDistance != that1.SyncDistance {
+		return false
+	}
+	if this.IsTrieSnapshotInProgress != that1.IsTrieSnapshotInProgress {
+		return false
+	}
+	if len(this.ConnectedPeersPerShard) != len(that1.ConnectedPeersPerShard) {
+		return false
+	}
+	for i := range this.ConnectedPeersPerShard {
+		if this.ConnectedPeersPerShard[i] != that1.ConnectedPeersPerShard[i] {
+			return false
+		}
+	}
+	if len(this.ManagedKeys) != len(that1.ManagedKeys) {
+		return false
+	}
+	for i := range this.ManagedKeys {
+		if !bytes.Equal(this.ManagedKeys[i], that1.ManagedKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *HeartbeatV2) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&data.HeartbeatV2{")
+	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "VersionNumber: "+fmt.Sprintf("%#v", this.VersionNumber)+",\n")
+	s = append(s, "NodeDisplayName: "+fmt.Sprintf("%#v", this.NodeDisplayName)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "DiskFreeBytes: "+fmt.Sprintf("%#v", this.DiskFreeBytes)+",\n")
+	s = append(s, "SyncDistance: "+fmt.Sprintf("%#v", this.SyncDistance)+",\n")
+	s = append(s, "IsTrieSnapshotInProgress: "+fmt.Sprintf("%#v", this.IsTrieSnapshotInProgress)+",\n")
+	keysForConnectedPeersPerShard := make([]uint32, 0, len(this.ConnectedPeersPerShard))
+	for k := range this.ConnectedPeersPerShard {
+		keysForConnectedPeersPerShard = append(keysForConnectedPeersPerShard, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Uint32s(keysForConnectedPeersPerShard)
+	mapStringForConnectedPeersPerShard := "map[uint32]uint32{"
+	for _, k := range keysForConnectedPeersPerShard {
+		mapStringForConnectedPeersPerShard += fmt.Sprintf("%#v: %#v,", k, this.ConnectedPeersPerShard[k])
+	}
+	mapStringForConnectedPeersPerShard += "}"
+	if this.ConnectedPeersPerShard != nil {
+		s = append(s, "ConnectedPeersPerShard: "+mapStringForConnectedPeersPerShard+",\n")
+	}
+	s = append(s, "ManagedKeys: "+fmt.Sprintf("%#v", this.ManagedKeys)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringHeartbeatV2(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *HeartbeatV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeartbeatV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeartbeatV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ManagedKeys) > 0 {
+		for iNdEx := len(m.ManagedKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ManagedKeys[iNdEx])
+			copy(dAtA[i:], m.ManagedKeys[iNdEx])
+			i = encodeVarintHeartbeatV2(dAtA, i, uint64(len(m.ManagedKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ConnectedPeersPerShard) > 0 {
+		keysForConnectedPeersPerShard := make([]uint32, 0, len(m.ConnectedPeersPerShard))
+		for k := range m.ConnectedPeersPerShard {
+			keysForConnectedPeersPerShard = append(keysForConnectedPeersPerShard, uint32(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Uint32s(keysForConnectedPeersPerShard)
+		for iNdEx := len(keysForConnectedPeersPerShard) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.ConnectedPeersPerShard[uint32(keysForConnectedPeersPerShard[iNdEx])]
+			baseI := i
+			i = encodeVarintHeartbeatV2(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintHeartbeatV2(dAtA, i, uint64(keysForConnectedPeersPerShard[iNdEx]))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintHeartbeatV2(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.IsTrieSnapshotInProgress {
+		i--
+		if m.IsTrieSnapshotInProgress {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SyncDistance != 0 {
+		i = encodeVarintHeartbeatV2(dAtA, i, uint64(m.SyncDistance))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DiskFreeBytes != 0 {
+		i = encodeVarintHeartbeatV2(dAtA, i, uint64(m.DiskFreeBytes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintHeartbeatV2(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintHeartbeatV2(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHeartbeatV2(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NodeDisplayName) > 0 {
+		i -= len(m.NodeDisplayName)
+		copy(dAtA[i:], m.NodeDisplayName)
+		i = encodeVarintHeartbeatV2(dAtA, i, uint64(len(m.NodeDisplayName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VersionNumber) > 0 {
+		i -= len(m.VersionNumber)
+		copy(dAtA[i:], m.VersionNumber)
+		i = encodeVarintHeartbeatV2(dAtA, i, uint64(len(m.VersionNumber)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintHeartbeatV2(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHeartbeatV2(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHeartbeatV2(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HeartbeatV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovHeartbeatV2(uint64(l))
+	}
+	l = len(m.VersionNumber)
+	if l > 0 {
+		n += 1 + l + sovHeartbeatV2(uint64(l))
+	}
+	l = len(m.NodeDisplayName)
+	if l > 0 {
+		n += 1 + l + sovHeartbeatV2(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHeartbeatV2(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovHeartbeatV2(uint64(m.Nonce))
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovHeartbeatV2(uint64(m.ShardID))
+	}
+	if m.DiskFreeBytes != 0 {
+		n += 1 + sovHeartbeatV2(uint64(m.DiskFreeBytes))
+	}
+	if m.SyncDistance != 0 {
+		n += 1 + sovHeartbeatV2(uint64(m.SyncDistance))
+	}
+	if m.IsTrieSnapshotInProgress {
+		n += 2
+	}
+	if len(m.ConnectedPeersPerShard) > 0 {
+		for k, v := range m.ConnectedPeersPerShard {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovHeartbeatV2(uint64(k)) + 1 + sovHeartbeatV2(uint64(v))
+			n += mapEntrySize + 1 + sovHeartbeatV2(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ManagedKeys) > 0 {
+		for _, b := range m.ManagedKeys {
+			l = len(b)
+			n += 1 + l + sovHeartbeatV2(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovHeartbeatV2(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHeartbeatV2(x uint64) (n int) {
+	return sovHeartbeatV2(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *HeartbeatV2) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForConnectedPeersPerShard := make([]uint32, 0, len(this.ConnectedPeersPerShard))
+	for k := range this.ConnectedPeersPerShard {
+		keysForConnectedPeersPerShard = append(keysForConnectedPeersPerShard, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Uint32s(keysForConnectedPeersPerShard)
+	mapStringForConnectedPeersPerShard := "map[uint32]uint32{"
+	for _, k := range keysForConnectedPeersPerShard {
+		mapStringForConnectedPeersPerShard += fmt.Sprintf("%v: %v,", k, this.ConnectedPeersPerShard[k])
+	}
+	mapStringForConnectedPeersPerShard += "}"
+	s := strings.Join([]string{`&HeartbeatV2{`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`VersionNumber:` + fmt.Sprintf("%v", this.VersionNumber) + `,`,
+		`NodeDisplayName:` + fmt.Sprintf("%v", this.NodeDisplayName) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`DiskFreeBytes:` + fmt.Sprintf("%v", this.DiskFreeBytes) + `,`,
+		`SyncDistance:` + fmt.Sprintf("%v", this.SyncDistance) + `,`,
+		`IsTrieSnapshotInProgress:` + fmt.Sprintf("%v", this.IsTrieSnapshotInProgress) + `,`,
+		`ConnectedPeersPerShard:` + mapStringForConnectedPeersPerShard + `,`,
+		`ManagedKeys:` + fmt.Sprintf("%v", this.ManagedKeys) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringHeartbeatV2(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *HeartbeatV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeartbeatV2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeartbeatV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeartbeatV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeDisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeDisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskFreeBytes", wireType)
+			}
+			m.DiskFreeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskFreeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncDistance", wireType)
+			}
+			m.SyncDistance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyncDistance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsTrieSnapshotInProgress", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsTrieSnapshotInProgress = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectedPeersPerShard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConnectedPeersPerShard == nil {
+				m.ConnectedPeersPerShard = make(map[uint32]uint32)
+			}
+			var mapkey uint32
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHeartbeatV2
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHeartbeatV2
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHeartbeatV2
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipHeartbeatV2(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthHeartbeatV2
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ConnectedPeersPerShard[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagedKeys = append(m.ManagedKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ManagedKeys[len(m.ManagedKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeartbeatV2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHeartbeatV2(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHeartbeatV2
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHeartbeatV2
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHeartbeatV2
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHeartbeatV2
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHeartbeatV2        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHeartbeatV2          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHeartbeatV2 = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,60 +1,84 @@
-//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. heartbeat.proto
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. peerAuthentication.proto
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. heartbeatV2.proto
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. payload.proto
 package data
 
 import (
-	"encoding/json"
-	"errors"
 	"time"
 )
 
-// PubKeyHeartbeat returns the heartbeat status for a public key
+// PubKeyHeartbeat returns the heartbeat status for a public key. The observers, which do not authenticate a public
+// key, are reported by their peer ID. The resource and storage metrics are not reported by the peers running older
+// versions. The number of managed keys is set only for the peers running in multikey mode
 type PubKeyHeartbeat struct {
-	PublicKey       string    `json:"publicKey"`
-	TimeStamp       time.Time `json:"timeStamp"`
-	MaxInactiveTime Duration  `json:"maxInactiveTime"`
-	IsActive        bool      `json:"isActive"`
-	ReceivedShardID uint32    `json:"receivedShardID"`
-	ComputedShardID uint32    `json:"computedShardID"`
-	TotalUpTime     int64     `json:"totalUpTimeSec"`
-	TotalDownTime   int64     `json:"totalDownTimeSec"`
-	VersionNumber   string    `json:"versionNumber"`
-	NodeDisplayName string    `json:"nodeDisplayName"`
-	Identity        string    `json:"identity"`
-	PeerType        string    `json:"peerType"`
-	Nonce           uint64    `json:"nonce"`
-	NumInstances    uint64    `json:"numInstances"`
+	PublicKey                string            `json:"publicKey"`
+	Pid                      string            `json:"pid"`
+	TimeStamp                time.Time         `json:"timeStamp"`
+	IsActive                 bool              `json:"isActive"`
+	ReceivedShardID          uint32            `json:"receivedShardID"`
+	ComputedShardID          uint32            `json:"computedShardID"`
+	VersionNumber            string            `json:"versionNumber"`
+	NodeDisplayName          string            `json:"nodeDisplayName"`
+	Identity                 string            `json:"identity"`
+	PeerType                 string            `json:"peerType"`
+	Nonce                    uint64            `json:"nonce"`
+	NumInstances             uint64            `json:"numInstances"`
+	DiskFreeBytes            uint64            `json:"diskFreeBytes,omitempty"`
+	SyncDistance             uint64            `json:"syncDistance"`
+	IsTrieSnapshotInProgress bool              `json:"isTrieSnapshotInProgress"`
+	ConnectedPeersPerShard   map[uint32]uint32 `json:"connectedPeersPerShard,omitempty"`
+	NumManagedKeys           uint32            `json:"numManagedKeys,omitempty"`
+}
+
+// HeartbeatsQuery holds the filters and the pagination applied on the heartbeat status. The empty filters are not
+// applied
+type HeartbeatsQuery struct {
+	ShardID  *uint32
+	Identity string
+	IsActive *bool
+	PeerType string
+	From     uint32
+	Size     uint32
 }
 
-// Duration is a wrapper of the original Duration struct
-// that has JSON marshal and unmarshal capabilities
-// golang issue: https://github.com/golang/go/issues/10275
-type Duration struct {
-	time.Duration
+// HeartbeatsPage holds a page of the heartbeat status entries matching a query, along with the number of matching
+// entries. The next from offset is set only if more entries are available
+type HeartbeatsPage struct {
+	Heartbeats    []PubKeyHeartbeat `json:"heartbeats"`
+	NumHeartbeats uint32            `json:"numHeartbeats"`
+	NextFrom      uint32            `json:"nextFrom"`
 }
 
-// MarshalJSON is called when a json marshal is triggered on this field
-func (d Duration) MarshalJSON() ([]byte, error) {
-	return json.Marshal(d.String())
+// IdentityHeartbeats aggregates the heartbeat status of the nodes declaring the same identity
+type IdentityHeartbeats struct {
+	Identity         string            `json:"identity"`
+	NumNodes         uint32            `json:"numNodes"`
+	NumActive        uint32            `json:"numActive"`
+	NumInactive      uint32            `json:"numInactive"`
+	NumValidators    uint32            `json:"numValidators"`
+	NumNodesPerShard map[uint32]uint32 `json:"numNodesPerShard"`
+}
+
+// ManagedKeyHeartbeat holds the liveness of a validator key managed by a node running in multikey mode. A managed key
+// is active while its peer authentication is known and the node managing it is active
+type ManagedKeyHeartbeat struct {
+	PublicKey       string    `json:"publicKey"`
+	TimeStamp       time.Time `json:"timeStamp"`
+	IsActive        bool      `json:"isActive"`
+	PeerType        string    `json:"peerType"`
+	ComputedShardID uint32    `json:"computedShardID"`
 }
 
-// UnmarshalJSON is called when a json unmarshal is triggered on this field
-func (d *Duration) UnmarshalJSON(b []byte) error {
-	var v interface{}
-	if err := json.Unmarshal(b, &v); err != nil {
-		return err
-	}
-	switch value := v.(type) {
-	case float64:
-		d.Duration = time.Duration(value)
-		return nil
-	case string:
-		var err error
-		d.Duration, err = time.ParseDuration(value)
-		if err != nil {
-			return err
-		}
-		return nil
-	default:
-		return errors.New("invalid duration")
-	}
+// NodeManagedKeys holds the validator keys managed by a node running in multikey mode, attributed to the node's peer
+// ID and identity
+type NodeManagedKeys struct {
+	Pid             string                `json:"pid"`
+	NodeDisplayName string                `json:"nodeDisplayName"`
+	Identity        string                `json:"identity"`
+	ReceivedShardID uint32                `json:"receivedShardID"`
+	TimeStamp       time.Time             `json:"timeStamp"`
+	IsActive        bool                  `json:"isActive"`
+	NumManagedKeys  uint32                `json:"numManagedKeys"`
+	NumActiveKeys   uint32                `json:"numActiveKeys"`
+	ManagedKeys     []ManagedKeyHeartbeat `json:"managedKeys"`
 }
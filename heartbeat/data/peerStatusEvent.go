@@ -0,0 +1,29 @@
+package data
+
+import (
+	"time"
+)
+
+const (
+	// PeerStatusOffline signals that a tracked public key went from online to offline
+	PeerStatusOffline = "offline"
+	// PeerStatusOnline signals that a tracked public key went from offline back to online
+	PeerStatusOnline = "online"
+	// PeerStatusMissedHeartbeats signals that a tracked public key missed the configured number of consecutive
+	// heartbeats
+	PeerStatusMissedHeartbeats = "missedHeartbeats"
+)
+
+// PeerStatusEvent holds a liveness change of a tracked public key, as computed by the heartbeat monitor
+type PeerStatusEvent struct {
+	Type                string    `json:"type"`
+	PublicKey           string    `json:"publicKey"`
+	Pid                 string    `json:"pid"`
+	Identity            string    `json:"identity"`
+	NodeDisplayName     string    `json:"nodeDisplayName"`
+	PeerType            string    `json:"peerType"`
+	ShardID             uint32    `json:"shardID"`
+	NumMissedHeartbeats uint32    `json:"numMissedHeartbeats"`
+	LastSeen            time.Time `json:"lastSeen"`
+	Timestamp           time.Time `json:"timestamp"`
+}
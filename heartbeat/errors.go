@@ -101,3 +101,54 @@ var ErrNilPeerSignatureHandler = errors.New("trying to set nil peerSignatureHand
 
 // ErrNilCurrentBlockProvider signals that a nil current block provider
 var ErrNilCurrentBlockProvider = errors.New("nil current block provider")
+
+// ErrNilManagedPeersHolder signals that a nil managed peers holder has been provided
+var ErrNilManagedPeersHolder = errors.New("nil managed peers holder")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilCacher signals that a nil cacher has been provided
+var ErrNilCacher = errors.New("nil cacher")
+
+// ErrInvalidTimeDuration signals that an invalid time duration has been provided
+var ErrInvalidTimeDuration = errors.New("invalid time duration")
+
+// ErrMessageExpired signals that a received message has a timestamp outside of the accepted time window
+var ErrMessageExpired = errors.New("message expired")
+
+// ErrNilNodeMetricsProvider signals that a nil node metrics provider has been provided
+var ErrNilNodeMetricsProvider = errors.New("nil node metrics provider")
+
+// ErrNilForkDetector signals that a nil fork detector has been provided
+var ErrNilForkDetector = errors.New("nil fork detector")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrNilPeerShardResolver signals that a nil peer shard resolver has been provided
+var ErrNilPeerShardResolver = errors.New("nil peer shard resolver")
+
+// ErrNilMonitor signals that a nil heartbeat monitor has been provided
+var ErrNilMonitor = errors.New("nil heartbeat monitor")
+
+// ErrNilPeerStatusNotifier signals that a nil peer status notifier has been provided
+var ErrNilPeerStatusNotifier = errors.New("nil peer status notifier")
+
+// ErrNoPeerStatusNotifiers signals that no peer status notifier has been provided
+var ErrNoPeerStatusNotifiers = errors.New("no peer status notifiers")
+
+// ErrNothingToTrack signals that neither tracked public keys nor tracked identities have been provided
+var ErrNothingToTrack = errors.New("no tracked public keys or identities")
+
+// ErrNoWebhookURLs signals that no webhook URL has been provided
+var ErrNoWebhookURLs = errors.New("no webhook URLs")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrTooManyManagedKeys signals that a heartbeat message lists more managed keys than accepted by the network
+var ErrTooManyManagedKeys = errors.New("too many managed keys")
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
@@ -28,33 +29,31 @@ type P2PMessenger interface {
 	IsInterfaceNil() bool
 }
 
-// MessageHandler defines what a message processor for heartbeat should do
-type MessageHandler interface {
-	CreateHeartbeatFromP2PMessage(message p2p.MessageP2P) (*heartbeatData.Heartbeat, error)
+// SenderHandler defines the behavior of a component periodically broadcasting messages on one of the heartbeat topics
+type SenderHandler interface {
+	ExecutionReadyChannel() <-chan time.Time
+	Execute()
+	Close()
 	IsInterfaceNil() bool
 }
 
-// EligibleListProvider defines what an eligible list provider should do
-type EligibleListProvider interface {
-	GetAllEligibleValidatorsPublicKeys(epoch uint32) (map[uint32][][]byte, error)
-	GetAllWaitingValidatorsPublicKeys(epoch uint32) (map[uint32][][]byte, error)
+// MonitorHandler defines the behavior of a component able to provide the heartbeat status of the known peers
+type MonitorHandler interface {
+	GetHeartbeats() []heartbeatData.PubKeyHeartbeat
+	GetManagedKeysPerNode() []heartbeatData.NodeManagedKeys
 	IsInterfaceNil() bool
 }
 
-//Timer defines an interface for tracking time
-type Timer interface {
-	Now() time.Time
+// PeerStatusNotifier defines a component that delivers the liveness changes of the tracked public keys, such as a
+// webhook or the push notifications
+type PeerStatusNotifier interface {
+	NotifyPeerStatus(event *heartbeatData.PeerStatusEvent)
 	IsInterfaceNil() bool
 }
 
-// HeartbeatStorageHandler defines what a heartbeat's storer should do
-type HeartbeatStorageHandler interface {
-	LoadGenesisTime() (time.Time, error)
-	UpdateGenesisTime(genesisTime time.Time) error
-	LoadHeartBeatDTO(pubKey string) (*heartbeatData.HeartbeatDTO, error)
-	SavePubkeyData(pubkey []byte, heartbeat *heartbeatData.HeartbeatDTO) error
-	LoadKeys() ([][]byte, error)
-	SaveKeys(peersSlice [][]byte) error
+//Timer defines an interface for tracking time
+type Timer interface {
+	Now() time.Time
 	IsInterfaceNil() bool
 }
 
@@ -112,3 +111,42 @@ type CurrentBlockProvider interface {
 	GetCurrentBlockHeader() data.HeaderHandler
 	IsInterfaceNil() bool
 }
+
+// ManagedPeersHolder defines the operations of an entity that holds the validator keys managed by the current node
+type ManagedPeersHolder interface {
+	GetManagedKeysByCurrentNode() map[string]crypto.PrivateKey
+	IsInterfaceNil() bool
+}
+
+// NodeMetricsProvider can provide the resource and storage metrics of the node, included in its heartbeat messages
+type NodeMetricsProvider interface {
+	DiskFreeBytes() uint64
+	SyncDistance() uint64
+	IsTrieSnapshotInProgress() bool
+	ConnectedPeersPerShard() map[uint32]uint32
+	IsInterfaceNil() bool
+}
+
+// ForkDetector can provide the highest nonce the network is probably at
+type ForkDetector interface {
+	ProbableHighestNonce() uint64
+	IsInterfaceNil() bool
+}
+
+// SnapshotInProgressChecker can tell if a trie snapshot is in progress
+type SnapshotInProgressChecker interface {
+	IsSnapshotInProgress() bool
+	IsInterfaceNil() bool
+}
+
+// ConnectedPeersProvider can provide the peers the node is connected to
+type ConnectedPeersProvider interface {
+	ConnectedPeers() []core.PeerID
+	IsInterfaceNil() bool
+}
+
+// PeerShardResolver can resolve the shard and the type of a peer
+type PeerShardResolver interface {
+	GetPeerInfo(pid core.PeerID) core.P2PPeerInfo
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,19 @@
+package mock
+
+// ForkDetectorStub -
+type ForkDetectorStub struct {
+	ProbableHighestNonceCalled func() uint64
+}
+
+// ProbableHighestNonce -
+func (fds *ForkDetectorStub) ProbableHighestNonce() uint64 {
+	if fds.ProbableHighestNonceCalled != nil {
+		return fds.ProbableHighestNonceCalled()
+	}
+	return 0
+}
+
+// IsInterfaceNil -
+func (fds *ForkDetectorStub) IsInterfaceNil() bool {
+	return fds == nil
+}
@@ -19,6 +19,7 @@ type MessengerStub struct {
 	PeerAddressesCalled              func(pid core.PeerID) []string
 	BroadcastOnChannelBlockingCalled func(channel string, topic string, buff []byte) error
 	IsConnectedToTheNetworkCalled    func() bool
+	ConnectedPeersCalled             func() []core.PeerID
 }
 
 // ID -
@@ -106,6 +107,15 @@ func (ms *MessengerStub) IsConnectedToTheNetwork() bool {
 	return ms.IsConnectedToTheNetworkCalled()
 }
 
+// ConnectedPeers -
+func (ms *MessengerStub) ConnectedPeers() []core.PeerID {
+	if ms.ConnectedPeersCalled != nil {
+		return ms.ConnectedPeersCalled()
+	}
+
+	return make([]core.PeerID, 0)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ms *MessengerStub) IsInterfaceNil() bool {
 	return ms == nil
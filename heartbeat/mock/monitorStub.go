@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+)
+
+// MonitorStub -
+type MonitorStub struct {
+	GetHeartbeatsCalled         func() []data.PubKeyHeartbeat
+	GetManagedKeysPerNodeCalled func() []data.NodeManagedKeys
+}
+
+// GetHeartbeats -
+func (stub *MonitorStub) GetHeartbeats() []data.PubKeyHeartbeat {
+	if stub.GetHeartbeatsCalled != nil {
+		return stub.GetHeartbeatsCalled()
+	}
+
+	return make([]data.PubKeyHeartbeat, 0)
+}
+
+// GetManagedKeysPerNode -
+func (stub *MonitorStub) GetManagedKeysPerNode() []data.NodeManagedKeys {
+	if stub.GetManagedKeysPerNodeCalled != nil {
+		return stub.GetManagedKeysPerNodeCalled()
+	}
+
+	return make([]data.NodeManagedKeys, 0)
+}
+
+// IsInterfaceNil -
+func (stub *MonitorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -9,6 +9,7 @@ type NetworkShardingCollectorStub struct {
 	UpdatePeerIdPublicKeyCalled  func(pid core.PeerID, pk []byte)
 	UpdatePublicKeyShardIdCalled func(pk []byte, shardId uint32)
 	UpdatePeerIdShardIdCalled    func(pid core.PeerID, shardId uint32)
+	GetPeerInfoCalled            func(pid core.PeerID) core.P2PPeerInfo
 }
 
 // UpdatePeerIdPublicKey -
@@ -26,6 +27,15 @@ func (nscs *NetworkShardingCollectorStub) UpdatePeerIdShardId(pid core.PeerID, s
 	nscs.UpdatePeerIdShardIdCalled(pid, shardId)
 }
 
+// GetPeerInfo -
+func (nscs *NetworkShardingCollectorStub) GetPeerInfo(pid core.PeerID) core.P2PPeerInfo {
+	if nscs.GetPeerInfoCalled != nil {
+		return nscs.GetPeerInfoCalled(pid)
+	}
+
+	return core.P2PPeerInfo{}
+}
+
 // IsInterfaceNil -
 func (nscs *NetworkShardingCollectorStub) IsInterfaceNil() bool {
 	return nscs == nil
@@ -0,0 +1,46 @@
+package mock
+
+// NodeMetricsProviderStub -
+type NodeMetricsProviderStub struct {
+	DiskFreeBytesCalled            func() uint64
+	SyncDistanceCalled             func() uint64
+	IsTrieSnapshotInProgressCalled func() bool
+	ConnectedPeersPerShardCalled   func() map[uint32]uint32
+}
+
+// DiskFreeBytes -
+func (stub *NodeMetricsProviderStub) DiskFreeBytes() uint64 {
+	if stub.DiskFreeBytesCalled != nil {
+		return stub.DiskFreeBytesCalled()
+	}
+	return 0
+}
+
+// SyncDistance -
+func (stub *NodeMetricsProviderStub) SyncDistance() uint64 {
+	if stub.SyncDistanceCalled != nil {
+		return stub.SyncDistanceCalled()
+	}
+	return 0
+}
+
+// IsTrieSnapshotInProgress -
+func (stub *NodeMetricsProviderStub) IsTrieSnapshotInProgress() bool {
+	if stub.IsTrieSnapshotInProgressCalled != nil {
+		return stub.IsTrieSnapshotInProgressCalled()
+	}
+	return false
+}
+
+// ConnectedPeersPerShard -
+func (stub *NodeMetricsProviderStub) ConnectedPeersPerShard() map[uint32]uint32 {
+	if stub.ConnectedPeersPerShardCalled != nil {
+		return stub.ConnectedPeersPerShardCalled()
+	}
+	return make(map[uint32]uint32)
+}
+
+// IsInterfaceNil -
+func (stub *NodeMetricsProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
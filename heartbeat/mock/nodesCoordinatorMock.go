@@ -140,6 +140,11 @@ func (ncm *NodesCoordinatorMock) GetConsensusWhitelistedNodes(
 	return make(map[string]struct{}), nil
 }
 
+// GetEpochValidatorsAssignment -
+func (ncm *NodesCoordinatorMock) GetEpochValidatorsAssignment(_ uint32) (*sharding.EpochValidatorsAssignment, error) {
+	return nil, nil
+}
+
 // SetConsensusGroupSize -
 func (ncm *NodesCoordinatorMock) SetConsensusGroupSize(_ int) error {
 	panic("implement me")
@@ -0,0 +1,22 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+)
+
+// PeerStatusNotifierStub -
+type PeerStatusNotifierStub struct {
+	NotifyPeerStatusCalled func(event *data.PeerStatusEvent)
+}
+
+// NotifyPeerStatus -
+func (stub *PeerStatusNotifierStub) NotifyPeerStatus(event *data.PeerStatusEvent) {
+	if stub.NotifyPeerStatusCalled != nil {
+		stub.NotifyPeerStatusCalled(event)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *PeerStatusNotifierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
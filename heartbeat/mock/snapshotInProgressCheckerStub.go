@@ -0,0 +1,19 @@
+package mock
+
+// SnapshotInProgressCheckerStub -
+type SnapshotInProgressCheckerStub struct {
+	IsSnapshotInProgressCalled func() bool
+}
+
+// IsSnapshotInProgress -
+func (stub *SnapshotInProgressCheckerStub) IsSnapshotInProgress() bool {
+	if stub.IsSnapshotInProgressCalled != nil {
+		return stub.IsSnapshotInProgressCalled()
+	}
+	return false
+}
+
+// IsInterfaceNil -
+func (stub *SnapshotInProgressCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
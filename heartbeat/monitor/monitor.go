@@ -0,0 +1,331 @@
+package monitor
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("heartbeat/monitor")
+
+// ArgHeartbeatV2Monitor represents the arguments for the heartbeat monitor
+type ArgHeartbeatV2Monitor struct {
+	Marshalizer                   marshal.Marshalizer
+	PeerAuthenticationCache       storage.Cacher
+	HeartbeatCache                storage.Cacher
+	PeerTypeProvider              heartbeat.PeerTypeProviderHandler
+	ValidatorPubkeyConverter      core.PubkeyConverter
+	Timer                         heartbeat.Timer
+	MaxDurationPeerUnresponsive   time.Duration
+	HideInactiveValidatorInterval time.Duration
+}
+
+// heartbeatV2Monitor computes the heartbeat status of the peers from the cached peer authentication and heartbeat
+// messages. The peers which did not authenticate any public key are reported as observers
+type heartbeatV2Monitor struct {
+	marshalizer                   marshal.Marshalizer
+	peerAuthenticationCache       storage.Cacher
+	heartbeatCache                storage.Cacher
+	peerTypeProvider              heartbeat.PeerTypeProviderHandler
+	validatorPubkeyConverter      core.PubkeyConverter
+	timer                         heartbeat.Timer
+	maxDurationPeerUnresponsive   time.Duration
+	hideInactiveValidatorInterval time.Duration
+}
+
+// NewHeartbeatV2Monitor creates a new heartbeat monitor
+func NewHeartbeatV2Monitor(arg ArgHeartbeatV2Monitor) (*heartbeatV2Monitor, error) {
+	if check.IfNil(arg.Marshalizer) {
+		return nil, heartbeat.ErrNilMarshalizer
+	}
+	if check.IfNil(arg.PeerAuthenticationCache) {
+		return nil, fmt.Errorf("%w for PeerAuthenticationCache", heartbeat.ErrNilCacher)
+	}
+	if check.IfNil(arg.HeartbeatCache) {
+		return nil, fmt.Errorf("%w for HeartbeatCache", heartbeat.ErrNilCacher)
+	}
+	if check.IfNil(arg.PeerTypeProvider) {
+		return nil, heartbeat.ErrNilPeerTypeProvider
+	}
+	if check.IfNil(arg.ValidatorPubkeyConverter) {
+		return nil, heartbeat.ErrNilPubkeyConverter
+	}
+	if check.IfNil(arg.Timer) {
+		return nil, heartbeat.ErrNilTimer
+	}
+	if arg.MaxDurationPeerUnresponsive == 0 {
+		return nil, heartbeat.ErrInvalidMaxDurationPeerUnresponsive
+	}
+	if arg.HideInactiveValidatorInterval == 0 {
+		return nil, heartbeat.ErrZeroHideInactiveValidatorIntervalInSec
+	}
+
+	return &heartbeatV2Monitor{
+		marshalizer:                   arg.Marshalizer,
+		peerAuthenticationCache:       arg.PeerAuthenticationCache,
+		heartbeatCache:                arg.HeartbeatCache,
+		peerTypeProvider:              arg.PeerTypeProvider,
+		validatorPubkeyConverter:      arg.ValidatorPubkeyConverter,
+		timer:                         arg.Timer,
+		maxDurationPeerUnresponsive:   arg.MaxDurationPeerUnresponsive,
+		hideInactiveValidatorInterval: arg.HideInactiveValidatorInterval,
+	}, nil
+}
+
+// GetHeartbeats returns the heartbeat status of the authenticated public keys and of the observers. The inactive
+// entries which are not eligible or waiting validators are removed after the configured interval
+func (monitor *heartbeatV2Monitor) GetHeartbeats() []data.PubKeyHeartbeat {
+	heartbeats := make([]data.PubKeyHeartbeat, 0)
+	authenticatedPids := make(map[string]struct{})
+	numInstances := make(map[string]uint64)
+
+	for _, key := range monitor.peerAuthenticationCache.Keys() {
+		peerAuthentication, ok := monitor.getPeerAuthentication(key)
+		if !ok {
+			continue
+		}
+
+		authenticatedPids[string(peerAuthentication.Pid)] = struct{}{}
+		hb, ok := monitor.computeAuthenticatedHeartbeat(peerAuthentication)
+		if !ok {
+			monitor.peerAuthenticationCache.Remove(key)
+			continue
+		}
+
+		heartbeats = append(heartbeats, hb)
+		if hb.IsActive {
+			numInstances[hb.PublicKey]++
+		}
+	}
+
+	for _, pid := range monitor.heartbeatCache.Keys() {
+		_, isAuthenticated := authenticatedPids[string(pid)]
+		if isAuthenticated {
+			continue
+		}
+
+		hb, ok := monitor.computeObserverHeartbeat(pid)
+		if !ok {
+			monitor.heartbeatCache.Remove(pid)
+			continue
+		}
+
+		heartbeats = append(heartbeats, hb)
+	}
+
+	for i := range heartbeats {
+		heartbeats[i].NumInstances = numInstances[heartbeats[i].PublicKey]
+	}
+
+	sort.Slice(heartbeats, func(i, j int) bool {
+		result := strings.Compare(heartbeats[i].PublicKey, heartbeats[j].PublicKey)
+		if result == 0 {
+			return heartbeats[i].Pid < heartbeats[j].Pid
+		}
+
+		return result < 0
+	})
+
+	return heartbeats
+}
+
+// GetManagedKeysPerNode returns the validator keys managed by each of the nodes running in multikey mode, sorted by the
+// nodes' peer IDs. The keys keep the order declared by the node. The nodes inactive for more than the configured
+// interval are not returned
+func (monitor *heartbeatV2Monitor) GetManagedKeysPerNode() []data.NodeManagedKeys {
+	nodes := make([]data.NodeManagedKeys, 0)
+	for _, pid := range monitor.heartbeatCache.Keys() {
+		hb, ok := monitor.getHeartbeat(pid)
+		if !ok || len(hb.ManagedKeys) == 0 {
+			continue
+		}
+
+		node := monitor.computeNodeManagedKeys(pid, hb)
+		if !node.IsActive && monitor.timer.Now().Sub(node.TimeStamp) > monitor.hideInactiveValidatorInterval {
+			continue
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Pid < nodes[j].Pid
+	})
+
+	return nodes
+}
+
+func (monitor *heartbeatV2Monitor) computeNodeManagedKeys(pid []byte, hb *data.HeartbeatV2) data.NodeManagedKeys {
+	node := data.NodeManagedKeys{
+		Pid:             core.PeerID(pid).Pretty(),
+		NodeDisplayName: hb.NodeDisplayName,
+		Identity:        hb.Identity,
+		ReceivedShardID: hb.ShardID,
+		TimeStamp:       monitor.getPayloadTime(hb.Payload),
+		NumManagedKeys:  uint32(len(hb.ManagedKeys)),
+		ManagedKeys:     make([]data.ManagedKeyHeartbeat, 0, len(hb.ManagedKeys)),
+	}
+	node.IsActive = monitor.isActive(node.TimeStamp)
+
+	for _, pubKey := range hb.ManagedKeys {
+		managedKey := monitor.computeManagedKeyHeartbeat(pubKey, pid, hb.ShardID)
+		if managedKey.IsActive {
+			node.NumActiveKeys++
+		}
+
+		node.ManagedKeys = append(node.ManagedKeys, managedKey)
+	}
+
+	return node
+}
+
+// computeManagedKeyHeartbeat uses the peer authentication sent for the managed key by the node. The keys not
+// authenticated yet by the node are reported as inactive
+func (monitor *heartbeatV2Monitor) computeManagedKeyHeartbeat(pubKey []byte, pid []byte, receivedShardID uint32) data.ManagedKeyHeartbeat {
+	key := append(append(make([]byte, 0), pubKey...), pid...)
+	peerAuthentication, ok := monitor.getPeerAuthentication(key)
+	if ok {
+		hb, _ := monitor.computeAuthenticatedHeartbeat(peerAuthentication)
+
+		return data.ManagedKeyHeartbeat{
+			PublicKey:       hb.PublicKey,
+			TimeStamp:       hb.TimeStamp,
+			IsActive:        hb.IsActive,
+			PeerType:        hb.PeerType,
+			ComputedShardID: hb.ComputedShardID,
+		}
+	}
+
+	peerType, shardID, err := monitor.peerTypeProvider.ComputeForPubKey(pubKey)
+	if err != nil {
+		peerType, shardID = core.ObserverList, receivedShardID
+	}
+
+	return data.ManagedKeyHeartbeat{
+		PublicKey:       monitor.validatorPubkeyConverter.Encode(pubKey),
+		PeerType:        string(peerType),
+		ComputedShardID: shardID,
+	}
+}
+
+func (monitor *heartbeatV2Monitor) getPeerAuthentication(key []byte) (*data.PeerAuthentication, bool) {
+	value, ok := monitor.peerAuthenticationCache.Peek(key)
+	if !ok {
+		return nil, false
+	}
+
+	peerAuthentication, ok := value.(*data.PeerAuthentication)
+	if !ok {
+		log.Warn("heartbeat monitor: wrong type assertion for peer authentication", "key", key)
+	}
+
+	return peerAuthentication, ok
+}
+
+func (monitor *heartbeatV2Monitor) getHeartbeat(pid []byte) (*data.HeartbeatV2, bool) {
+	value, ok := monitor.heartbeatCache.Peek(pid)
+	if !ok {
+		return nil, false
+	}
+
+	hb, ok := value.(*data.HeartbeatV2)
+	if !ok {
+		log.Warn("heartbeat monitor: wrong type assertion for heartbeat", "pid", core.PeerID(pid).Pretty())
+	}
+
+	return hb, ok
+}
+
+// computeAuthenticatedHeartbeat returns false if the entry should be hidden. The peer authentication is used as
+// the last sign of life if no heartbeat was received from the authenticated peer
+func (monitor *heartbeatV2Monitor) computeAuthenticatedHeartbeat(peerAuthentication *data.PeerAuthentication) (data.PubKeyHeartbeat, bool) {
+	result := data.PubKeyHeartbeat{
+		PublicKey: monitor.validatorPubkeyConverter.Encode(peerAuthentication.Pubkey),
+		Pid:       core.PeerID(peerAuthentication.Pid).Pretty(),
+		TimeStamp: monitor.getPayloadTime(peerAuthentication.Payload),
+	}
+
+	hb, ok := monitor.getHeartbeat(peerAuthentication.Pid)
+	if ok {
+		monitor.setHeartbeatFields(&result, hb)
+	}
+
+	peerType, shardID, err := monitor.peerTypeProvider.ComputeForPubKey(peerAuthentication.Pubkey)
+	if err != nil {
+		peerType, shardID = core.ObserverList, result.ReceivedShardID
+	}
+	result.PeerType = string(peerType)
+	result.ComputedShardID = shardID
+	result.IsActive = monitor.isActive(result.TimeStamp)
+
+	return result, !monitor.shouldHide(result)
+}
+
+func (monitor *heartbeatV2Monitor) computeObserverHeartbeat(pid []byte) (data.PubKeyHeartbeat, bool) {
+	hb, ok := monitor.getHeartbeat(pid)
+	if !ok {
+		return data.PubKeyHeartbeat{}, false
+	}
+
+	result := data.PubKeyHeartbeat{
+		Pid:      core.PeerID(pid).Pretty(),
+		PeerType: string(core.ObserverList),
+	}
+	monitor.setHeartbeatFields(&result, hb)
+	result.ComputedShardID = result.ReceivedShardID
+	result.IsActive = monitor.isActive(result.TimeStamp)
+
+	return result, !monitor.shouldHide(result)
+}
+
+func (monitor *heartbeatV2Monitor) setHeartbeatFields(result *data.PubKeyHeartbeat, hb *data.HeartbeatV2) {
+	result.TimeStamp = monitor.getPayloadTime(hb.Payload)
+	result.ReceivedShardID = hb.ShardID
+	result.VersionNumber = hb.VersionNumber
+	result.NodeDisplayName = hb.NodeDisplayName
+	result.Identity = hb.Identity
+	result.Nonce = hb.Nonce
+	result.DiskFreeBytes = hb.DiskFreeBytes
+	result.SyncDistance = hb.SyncDistance
+	result.IsTrieSnapshotInProgress = hb.IsTrieSnapshotInProgress
+	result.ConnectedPeersPerShard = hb.ConnectedPeersPerShard
+	result.NumManagedKeys = uint32(len(hb.ManagedKeys))
+}
+
+func (monitor *heartbeatV2Monitor) getPayloadTime(payloadBytes []byte) time.Time {
+	payload := &data.Payload{}
+	err := monitor.marshalizer.Unmarshal(payload, payloadBytes)
+	if err != nil {
+		log.Debug("heartbeat monitor: unmarshal payload", "error", err.Error())
+		return time.Time{}
+	}
+
+	return time.Unix(payload.Timestamp, 0)
+}
+
+func (monitor *heartbeatV2Monitor) isActive(timestamp time.Time) bool {
+	return monitor.timer.Now().Sub(timestamp) <= monitor.maxDurationPeerUnresponsive
+}
+
+func (monitor *heartbeatV2Monitor) shouldHide(hb data.PubKeyHeartbeat) bool {
+	isValidator := hb.PeerType == string(core.EligibleList) || hb.PeerType == string(core.WaitingList)
+	if hb.IsActive || isValidator {
+		return false
+	}
+
+	return monitor.timer.Now().Sub(hb.TimeStamp) > monitor.hideInactiveValidatorInterval
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (monitor *heartbeatV2Monitor) IsInterfaceNil() bool {
+	return monitor == nil
+}
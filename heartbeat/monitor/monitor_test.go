@@ -0,0 +1,300 @@
+package monitor
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgHeartbeatV2Monitor() ArgHeartbeatV2Monitor {
+	return ArgHeartbeatV2Monitor{
+		Marshalizer:             &mock.MarshalizerMock{},
+		PeerAuthenticationCache: testscommon.NewCacherMock(),
+		HeartbeatCache:          testscommon.NewCacherMock(),
+		PeerTypeProvider: &mock.PeerTypeProviderStub{
+			ComputeForPubKeyCalled: func(pubKey []byte) (core.PeerType, uint32, error) {
+				return core.EligibleList, 1, nil
+			},
+		},
+		ValidatorPubkeyConverter:      mock.NewPubkeyConverterMock(32),
+		Timer:                         mock.NewTimerMock(),
+		MaxDurationPeerUnresponsive:   time.Minute,
+		HideInactiveValidatorInterval: time.Hour,
+	}
+}
+
+func createPayload(t *testing.T, timestamp int64) []byte {
+	payloadBytes, err := (&mock.MarshalizerMock{}).Marshal(&data.Payload{Timestamp: timestamp})
+	require.Nil(t, err)
+
+	return payloadBytes
+}
+
+func addPeerAuthentication(t *testing.T, cache storage.Cacher, pubKey string, pid string, timestamp int64) {
+	peerAuthentication := &data.PeerAuthentication{
+		Pubkey:  []byte(pubKey),
+		Pid:     []byte(pid),
+		Payload: createPayload(t, timestamp),
+	}
+	_ = cache.Put([]byte(pubKey+pid), peerAuthentication, peerAuthentication.Size())
+}
+
+func addHeartbeat(t *testing.T, cache storage.Cacher, pid string, timestamp int64) {
+	hb := &data.HeartbeatV2{
+		Payload:                  createPayload(t, timestamp),
+		VersionNumber:            "v1.0.0",
+		NodeDisplayName:          "node " + pid,
+		Nonce:                    7,
+		ShardID:                  1,
+		DiskFreeBytes:            1024,
+		SyncDistance:             3,
+		IsTrieSnapshotInProgress: true,
+		ConnectedPeersPerShard:   map[uint32]uint32{1: 5, core.MetachainShardId: 2},
+	}
+	_ = cache.Put([]byte(pid), hb, hb.Size())
+}
+
+func TestNewHeartbeatV2Monitor(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Monitor()
+	arg.Marshalizer = nil
+	monitor, err := NewHeartbeatV2Monitor(arg)
+	assert.True(t, check.IfNil(monitor))
+	assert.Equal(t, heartbeat.ErrNilMarshalizer, err)
+
+	arg = createMockArgHeartbeatV2Monitor()
+	arg.PeerAuthenticationCache = nil
+	monitor, err = NewHeartbeatV2Monitor(arg)
+	assert.True(t, check.IfNil(monitor))
+	assert.True(t, errors.Is(err, heartbeat.ErrNilCacher))
+
+	arg = createMockArgHeartbeatV2Monitor()
+	arg.HeartbeatCache = nil
+	monitor, err = NewHeartbeatV2Monitor(arg)
+	assert.True(t, check.IfNil(monitor))
+	assert.True(t, errors.Is(err, heartbeat.ErrNilCacher))
+
+	arg = createMockArgHeartbeatV2Monitor()
+	arg.PeerTypeProvider = nil
+	monitor, err = NewHeartbeatV2Monitor(arg)
+	assert.True(t, check.IfNil(monitor))
+	assert.Equal(t, heartbeat.ErrNilPeerTypeProvider, err)
+
+	arg = createMockArgHeartbeatV2Monitor()
+	arg.ValidatorPubkeyConverter = nil
+	monitor, err = NewHeartbeatV2Monitor(arg)
+	assert.True(t, check.IfNil(monitor))
+	assert.Equal(t, heartbeat.ErrNilPubkeyConverter, err)
+
+	arg = createMockArgHeartbeatV2Monitor()
+	arg.Timer = nil
+	monitor, err = NewHeartbeatV2Monitor(arg)
+	assert.True(t, check.IfNil(monitor))
+	assert.Equal(t, heartbeat.ErrNilTimer, err)
+
+	arg = createMockArgHeartbeatV2Monitor()
+	arg.MaxDurationPeerUnresponsive = 0
+	monitor, err = NewHeartbeatV2Monitor(arg)
+	assert.True(t, check.IfNil(monitor))
+	assert.Equal(t, heartbeat.ErrInvalidMaxDurationPeerUnresponsive, err)
+
+	arg = createMockArgHeartbeatV2Monitor()
+	arg.HideInactiveValidatorInterval = 0
+	monitor, err = NewHeartbeatV2Monitor(arg)
+	assert.True(t, check.IfNil(monitor))
+	assert.Equal(t, heartbeat.ErrZeroHideInactiveValidatorIntervalInSec, err)
+
+	monitor, err = NewHeartbeatV2Monitor(createMockArgHeartbeatV2Monitor())
+	assert.False(t, check.IfNil(monitor))
+	assert.Nil(t, err)
+}
+
+func TestHeartbeatV2Monitor_GetHeartbeatsShouldCombineTheCaches(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Monitor()
+	timer := mock.NewTimerMock()
+	timer.SetSeconds(100)
+	arg.Timer = timer
+	addPeerAuthentication(t, arg.PeerAuthenticationCache, "pk1", "pid1", 10)
+	addHeartbeat(t, arg.HeartbeatCache, "pid1", 90)
+	addPeerAuthentication(t, arg.PeerAuthenticationCache, "pk1", "pid2", 80)
+	addPeerAuthentication(t, arg.PeerAuthenticationCache, "pk2", "pid3", 10)
+	addHeartbeat(t, arg.HeartbeatCache, "pid4", 95)
+	monitor, _ := NewHeartbeatV2Monitor(arg)
+
+	heartbeats := monitor.GetHeartbeats()
+	require.Equal(t, 4, len(heartbeats))
+
+	observer := heartbeats[0]
+	assert.Equal(t, "", observer.PublicKey)
+	assert.Equal(t, core.PeerID("pid4").Pretty(), observer.Pid)
+	assert.Equal(t, string(core.ObserverList), observer.PeerType)
+	assert.Equal(t, "node pid4", observer.NodeDisplayName)
+	assert.True(t, observer.IsActive)
+	assert.Equal(t, uint64(1024), observer.DiskFreeBytes)
+	assert.Equal(t, uint64(3), observer.SyncDistance)
+	assert.True(t, observer.IsTrieSnapshotInProgress)
+	assert.Equal(t, map[uint32]uint32{1: 5, core.MetachainShardId: 2}, observer.ConnectedPeersPerShard)
+
+	assert.Equal(t, hex.EncodeToString([]byte("pk1")), heartbeats[1].PublicKey)
+	assert.Equal(t, hex.EncodeToString([]byte("pk1")), heartbeats[2].PublicKey)
+	assert.Equal(t, uint64(2), heartbeats[1].NumInstances)
+	for _, hb := range heartbeats[1:3] {
+		assert.True(t, hb.IsActive)
+		assert.Equal(t, string(core.EligibleList), hb.PeerType)
+		assert.Equal(t, uint32(1), hb.ComputedShardID)
+	}
+
+	inactive := heartbeats[3]
+	assert.Equal(t, hex.EncodeToString([]byte("pk2")), inactive.PublicKey)
+	assert.False(t, inactive.IsActive)
+	assert.Equal(t, uint64(0), inactive.NumInstances)
+	assert.Equal(t, time.Unix(10, 0), inactive.TimeStamp)
+}
+
+func TestHeartbeatV2Monitor_GetHeartbeatsShouldHideTheInactiveObservers(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Monitor()
+	arg.PeerTypeProvider = &mock.PeerTypeProviderStub{
+		ComputeForPubKeyCalled: func(pubKey []byte) (core.PeerType, uint32, error) {
+			if string(pubKey) == "validator" {
+				return core.WaitingList, 0, nil
+			}
+			return "", 0, errors.New("not found")
+		},
+	}
+	timer := mock.NewTimerMock()
+	timer.SetSeconds(10000)
+	arg.Timer = timer
+	addPeerAuthentication(t, arg.PeerAuthenticationCache, "validator", "pid1", 10)
+	addPeerAuthentication(t, arg.PeerAuthenticationCache, "unknown", "pid2", 10)
+	addHeartbeat(t, arg.HeartbeatCache, "pid3", 10)
+	monitor, _ := NewHeartbeatV2Monitor(arg)
+
+	heartbeats := monitor.GetHeartbeats()
+	require.Equal(t, 1, len(heartbeats))
+	assert.Equal(t, hex.EncodeToString([]byte("validator")), heartbeats[0].PublicKey)
+	assert.False(t, heartbeats[0].IsActive)
+	assert.Equal(t, 1, arg.PeerAuthenticationCache.Len())
+	assert.Equal(t, 0, arg.HeartbeatCache.Len())
+}
+
+func addMultiKeyHeartbeat(t *testing.T, cache storage.Cacher, pid string, timestamp int64, managedKeys ...string) {
+	hb := &data.HeartbeatV2{
+		Payload:         createPayload(t, timestamp),
+		NodeDisplayName: "node " + pid,
+		Identity:        "provider",
+		ShardID:         1,
+	}
+	for _, managedKey := range managedKeys {
+		hb.ManagedKeys = append(hb.ManagedKeys, []byte(managedKey))
+	}
+	_ = cache.Put([]byte(pid), hb, hb.Size())
+}
+
+func TestHeartbeatV2Monitor_GetHeartbeatsShouldSetTheNumManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Monitor()
+	timer := mock.NewTimerMock()
+	timer.SetSeconds(100)
+	arg.Timer = timer
+	addPeerAuthentication(t, arg.PeerAuthenticationCache, "pk1", "pid1", 90)
+	addPeerAuthentication(t, arg.PeerAuthenticationCache, "pk2", "pid1", 90)
+	addMultiKeyHeartbeat(t, arg.HeartbeatCache, "pid1", 95, "pk1", "pk2")
+	monitor, _ := NewHeartbeatV2Monitor(arg)
+
+	heartbeats := monitor.GetHeartbeats()
+	require.Equal(t, 2, len(heartbeats))
+	for _, hb := range heartbeats {
+		assert.Equal(t, uint32(2), hb.NumManagedKeys)
+		assert.Equal(t, "provider", hb.Identity)
+		assert.Equal(t, core.PeerID("pid1").Pretty(), hb.Pid)
+	}
+}
+
+func TestHeartbeatV2Monitor_GetManagedKeysPerNode(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Monitor()
+	arg.PeerTypeProvider = &mock.PeerTypeProviderStub{
+		ComputeForPubKeyCalled: func(pubKey []byte) (core.PeerType, uint32, error) {
+			if string(pubKey) == "pk3" {
+				return core.WaitingList, 0, nil
+			}
+			return core.EligibleList, 1, nil
+		},
+	}
+	timer := mock.NewTimerMock()
+	timer.SetSeconds(100)
+	arg.Timer = timer
+	addPeerAuthentication(t, arg.PeerAuthenticationCache, "pk1", "pid2", 90)
+	addPeerAuthentication(t, arg.PeerAuthenticationCache, "pk2", "pid2", 90)
+	addMultiKeyHeartbeat(t, arg.HeartbeatCache, "pid2", 95, "pk1", "pk2", "pk3")
+	addPeerAuthentication(t, arg.PeerAuthenticationCache, "pk4", "pid1", 10)
+	addMultiKeyHeartbeat(t, arg.HeartbeatCache, "pid1", 10, "pk4")
+	addHeartbeat(t, arg.HeartbeatCache, "pid3", 95)
+	monitor, _ := NewHeartbeatV2Monitor(arg)
+
+	nodes := monitor.GetManagedKeysPerNode()
+	require.Equal(t, 2, len(nodes))
+
+	inactiveNode := nodes[0]
+	assert.Equal(t, core.PeerID("pid1").Pretty(), inactiveNode.Pid)
+	assert.False(t, inactiveNode.IsActive)
+	assert.Equal(t, uint32(1), inactiveNode.NumManagedKeys)
+	assert.Equal(t, uint32(0), inactiveNode.NumActiveKeys)
+	require.Equal(t, 1, len(inactiveNode.ManagedKeys))
+	assert.False(t, inactiveNode.ManagedKeys[0].IsActive)
+
+	node := nodes[1]
+	assert.Equal(t, core.PeerID("pid2").Pretty(), node.Pid)
+	assert.Equal(t, "node pid2", node.NodeDisplayName)
+	assert.Equal(t, "provider", node.Identity)
+	assert.Equal(t, uint32(1), node.ReceivedShardID)
+	assert.Equal(t, time.Unix(95, 0), node.TimeStamp)
+	assert.True(t, node.IsActive)
+	assert.Equal(t, uint32(3), node.NumManagedKeys)
+	assert.Equal(t, uint32(2), node.NumActiveKeys)
+	require.Equal(t, 3, len(node.ManagedKeys))
+	for _, managedKey := range node.ManagedKeys[:2] {
+		assert.True(t, managedKey.IsActive)
+		assert.Equal(t, time.Unix(95, 0), managedKey.TimeStamp)
+		assert.Equal(t, string(core.EligibleList), managedKey.PeerType)
+		assert.Equal(t, uint32(1), managedKey.ComputedShardID)
+	}
+
+	notAuthenticated := node.ManagedKeys[2]
+	assert.Equal(t, hex.EncodeToString([]byte("pk3")), notAuthenticated.PublicKey)
+	assert.False(t, notAuthenticated.IsActive)
+	assert.True(t, notAuthenticated.TimeStamp.IsZero())
+	assert.Equal(t, string(core.WaitingList), notAuthenticated.PeerType)
+	assert.Equal(t, uint32(0), notAuthenticated.ComputedShardID)
+}
+
+func TestHeartbeatV2Monitor_GetManagedKeysPerNodeShouldHideTheInactiveNodes(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Monitor()
+	timer := mock.NewTimerMock()
+	timer.SetSeconds(10000)
+	arg.Timer = timer
+	addMultiKeyHeartbeat(t, arg.HeartbeatCache, "pid1", 10, "pk1")
+	monitor, _ := NewHeartbeatV2Monitor(arg)
+
+	assert.Equal(t, 0, len(monitor.GetManagedKeysPerNode()))
+}
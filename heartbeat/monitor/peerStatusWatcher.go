@@ -0,0 +1,246 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+)
+
+// ArgPeerStatusWatcher represents the arguments for the peer status watcher
+type ArgPeerStatusWatcher struct {
+	Monitor               heartbeat.MonitorHandler
+	Notifiers             []heartbeat.PeerStatusNotifier
+	Timer                 heartbeat.Timer
+	TrackedPublicKeys     []string
+	TrackedIdentities     []string
+	TimeBetweenHeartbeats time.Duration
+	NumMissedHeartbeats   uint32
+	CheckInterval         time.Duration
+}
+
+type trackedPeerStatus struct {
+	isActive           bool
+	missedHeartbeatsOn bool
+}
+
+// peerStatusWatcher periodically checks the heartbeat status of the tracked public keys and notifies the liveness
+// changes: a key going offline or coming back online and a key missing the configured number of consecutive
+// heartbeats. The first status seen for a key is only recorded, so the node restarts do not fire alerts
+type peerStatusWatcher struct {
+	monitor               heartbeat.MonitorHandler
+	notifiers             []heartbeat.PeerStatusNotifier
+	timer                 heartbeat.Timer
+	trackedPublicKeys     map[string]struct{}
+	trackedIdentities     map[string]struct{}
+	timeBetweenHeartbeats time.Duration
+	numMissedHeartbeats   uint32
+	checkInterval         time.Duration
+	mutStatuses           sync.Mutex
+	statuses              map[string]*trackedPeerStatus
+	cancel                func()
+}
+
+// NewPeerStatusWatcher creates a new peer status watcher. The watching starts with StartWatching
+func NewPeerStatusWatcher(arg ArgPeerStatusWatcher) (*peerStatusWatcher, error) {
+	err := checkPeerStatusWatcherArgs(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher := &peerStatusWatcher{
+		monitor:               arg.Monitor,
+		notifiers:             arg.Notifiers,
+		timer:                 arg.Timer,
+		trackedPublicKeys:     make(map[string]struct{}),
+		trackedIdentities:     make(map[string]struct{}),
+		timeBetweenHeartbeats: arg.TimeBetweenHeartbeats,
+		numMissedHeartbeats:   arg.NumMissedHeartbeats,
+		checkInterval:         arg.CheckInterval,
+		statuses:              make(map[string]*trackedPeerStatus),
+		cancel:                func() {},
+	}
+	for _, publicKey := range arg.TrackedPublicKeys {
+		watcher.trackedPublicKeys[publicKey] = struct{}{}
+	}
+	for _, identity := range arg.TrackedIdentities {
+		watcher.trackedIdentities[strings.ToLower(identity)] = struct{}{}
+	}
+
+	return watcher, nil
+}
+
+func checkPeerStatusWatcherArgs(arg ArgPeerStatusWatcher) error {
+	if check.IfNil(arg.Monitor) {
+		return heartbeat.ErrNilMonitor
+	}
+	if len(arg.Notifiers) == 0 {
+		return heartbeat.ErrNoPeerStatusNotifiers
+	}
+	for _, notifier := range arg.Notifiers {
+		if check.IfNil(notifier) {
+			return heartbeat.ErrNilPeerStatusNotifier
+		}
+	}
+	if check.IfNil(arg.Timer) {
+		return heartbeat.ErrNilTimer
+	}
+	if len(arg.TrackedPublicKeys) == 0 && len(arg.TrackedIdentities) == 0 {
+		return heartbeat.ErrNothingToTrack
+	}
+	if arg.TimeBetweenHeartbeats <= 0 {
+		return fmt.Errorf("%w for TimeBetweenHeartbeats", heartbeat.ErrInvalidTimeDuration)
+	}
+	if arg.NumMissedHeartbeats == 0 {
+		return fmt.Errorf("%w for NumMissedHeartbeats", heartbeat.ErrWrongValues)
+	}
+	if arg.CheckInterval <= 0 {
+		return fmt.Errorf("%w for CheckInterval", heartbeat.ErrInvalidTimeDuration)
+	}
+
+	return nil
+}
+
+// StartWatching starts checking the tracked public keys on a go routine, until Close is called
+func (watcher *peerStatusWatcher) StartWatching() {
+	var ctx context.Context
+	ctx, watcher.cancel = context.WithCancel(context.Background())
+
+	go watcher.watchLoop(ctx)
+}
+
+func (watcher *peerStatusWatcher) watchLoop(ctx context.Context) {
+	log.Debug("peer status watcher started", "check interval", watcher.checkInterval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("peer status watcher is stopping...")
+			return
+		case <-time.After(watcher.checkInterval):
+			watcher.Check()
+		}
+	}
+}
+
+// Check compares the current heartbeat status of the tracked public keys with the previous one and notifies the
+// changes
+func (watcher *peerStatusWatcher) Check() {
+	watcher.mutStatuses.Lock()
+	defer watcher.mutStatuses.Unlock()
+
+	for _, hb := range watcher.getTrackedHeartbeats() {
+		watcher.checkHeartbeat(hb)
+	}
+}
+
+// getTrackedHeartbeats returns one entry for each tracked public key. A public key running on more than one peer is
+// considered active if any of its instances is active and the latest sign of life is used
+func (watcher *peerStatusWatcher) getTrackedHeartbeats() []data.PubKeyHeartbeat {
+	tracked := make([]data.PubKeyHeartbeat, 0)
+	indexes := make(map[string]int)
+	for _, hb := range watcher.monitor.GetHeartbeats() {
+		if !watcher.isTracked(hb) {
+			continue
+		}
+
+		index, found := indexes[hb.PublicKey]
+		if !found {
+			indexes[hb.PublicKey] = len(tracked)
+			tracked = append(tracked, hb)
+			continue
+		}
+
+		existing := tracked[index]
+		if (hb.IsActive && !existing.IsActive) || (hb.IsActive == existing.IsActive && hb.TimeStamp.After(existing.TimeStamp)) {
+			tracked[index] = hb
+		}
+	}
+
+	return tracked
+}
+
+func (watcher *peerStatusWatcher) isTracked(hb data.PubKeyHeartbeat) bool {
+	if len(hb.PublicKey) == 0 {
+		return false
+	}
+
+	_, isTrackedPublicKey := watcher.trackedPublicKeys[hb.PublicKey]
+	_, isTrackedIdentity := watcher.trackedIdentities[strings.ToLower(hb.Identity)]
+
+	return isTrackedPublicKey || (len(hb.Identity) > 0 && isTrackedIdentity)
+}
+
+func (watcher *peerStatusWatcher) checkHeartbeat(hb data.PubKeyHeartbeat) {
+	numMissedHeartbeats := watcher.computeNumMissedHeartbeats(hb.TimeStamp)
+	missedHeartbeatsOn := numMissedHeartbeats >= watcher.numMissedHeartbeats
+
+	status, found := watcher.statuses[hb.PublicKey]
+	if !found {
+		status = &trackedPeerStatus{
+			isActive: hb.IsActive,
+		}
+		watcher.statuses[hb.PublicKey] = status
+	}
+
+	if status.isActive && !hb.IsActive {
+		watcher.notify(data.PeerStatusOffline, hb, numMissedHeartbeats)
+	}
+	if !status.isActive && hb.IsActive {
+		watcher.notify(data.PeerStatusOnline, hb, numMissedHeartbeats)
+	}
+	if missedHeartbeatsOn && !status.missedHeartbeatsOn {
+		watcher.notify(data.PeerStatusMissedHeartbeats, hb, numMissedHeartbeats)
+	}
+
+	status.isActive = hb.IsActive
+	status.missedHeartbeatsOn = missedHeartbeatsOn
+}
+
+func (watcher *peerStatusWatcher) computeNumMissedHeartbeats(lastSeen time.Time) uint32 {
+	elapsed := watcher.timer.Now().Sub(lastSeen)
+	if elapsed <= 0 {
+		return 0
+	}
+
+	return uint32(elapsed / watcher.timeBetweenHeartbeats)
+}
+
+func (watcher *peerStatusWatcher) notify(eventType string, hb data.PubKeyHeartbeat, numMissedHeartbeats uint32) {
+	event := &data.PeerStatusEvent{
+		Type:                eventType,
+		PublicKey:           hb.PublicKey,
+		Pid:                 hb.Pid,
+		Identity:            hb.Identity,
+		NodeDisplayName:     hb.NodeDisplayName,
+		PeerType:            hb.PeerType,
+		ShardID:             hb.ComputedShardID,
+		NumMissedHeartbeats: numMissedHeartbeats,
+		LastSeen:            hb.TimeStamp,
+		Timestamp:           watcher.timer.Now(),
+	}
+
+	log.Debug("peer status watcher: status changed", "type", eventType, "public key", hb.PublicKey,
+		"num missed heartbeats", numMissedHeartbeats)
+
+	for _, notifier := range watcher.notifiers {
+		notifier.NotifyPeerStatus(event)
+	}
+}
+
+// Close stops the watching
+func (watcher *peerStatusWatcher) Close() error {
+	watcher.cancel()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (watcher *peerStatusWatcher) IsInterfaceNil() bool {
+	return watcher == nil
+}
@@ -0,0 +1,253 @@
+package monitor
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgPeerStatusWatcher() ArgPeerStatusWatcher {
+	return ArgPeerStatusWatcher{
+		Monitor:               &mock.MonitorStub{},
+		Notifiers:             []heartbeat.PeerStatusNotifier{&mock.PeerStatusNotifierStub{}},
+		Timer:                 mock.NewTimerMock(),
+		TrackedPublicKeys:     []string{"pk1"},
+		TimeBetweenHeartbeats: time.Second * 20,
+		NumMissedHeartbeats:   3,
+		CheckInterval:         time.Second,
+	}
+}
+
+type notifiedEvents struct {
+	mut    sync.Mutex
+	events []*data.PeerStatusEvent
+}
+
+func (ne *notifiedEvents) notifier() *mock.PeerStatusNotifierStub {
+	return &mock.PeerStatusNotifierStub{
+		NotifyPeerStatusCalled: func(event *data.PeerStatusEvent) {
+			ne.mut.Lock()
+			ne.events = append(ne.events, event)
+			ne.mut.Unlock()
+		},
+	}
+}
+
+func (ne *notifiedEvents) popTypes() []string {
+	ne.mut.Lock()
+	defer ne.mut.Unlock()
+
+	types := make([]string, 0, len(ne.events))
+	for _, event := range ne.events {
+		types = append(types, event.Type)
+	}
+	ne.events = nil
+
+	return types
+}
+
+func TestNewPeerStatusWatcher(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerStatusWatcher()
+	arg.Monitor = nil
+	watcher, err := NewPeerStatusWatcher(arg)
+	assert.True(t, check.IfNil(watcher))
+	assert.Equal(t, heartbeat.ErrNilMonitor, err)
+
+	arg = createMockArgPeerStatusWatcher()
+	arg.Notifiers = nil
+	watcher, err = NewPeerStatusWatcher(arg)
+	assert.True(t, check.IfNil(watcher))
+	assert.Equal(t, heartbeat.ErrNoPeerStatusNotifiers, err)
+
+	arg = createMockArgPeerStatusWatcher()
+	arg.Notifiers = []heartbeat.PeerStatusNotifier{nil}
+	watcher, err = NewPeerStatusWatcher(arg)
+	assert.True(t, check.IfNil(watcher))
+	assert.Equal(t, heartbeat.ErrNilPeerStatusNotifier, err)
+
+	arg = createMockArgPeerStatusWatcher()
+	arg.Timer = nil
+	watcher, err = NewPeerStatusWatcher(arg)
+	assert.True(t, check.IfNil(watcher))
+	assert.Equal(t, heartbeat.ErrNilTimer, err)
+
+	arg = createMockArgPeerStatusWatcher()
+	arg.TrackedPublicKeys = nil
+	watcher, err = NewPeerStatusWatcher(arg)
+	assert.True(t, check.IfNil(watcher))
+	assert.Equal(t, heartbeat.ErrNothingToTrack, err)
+
+	arg = createMockArgPeerStatusWatcher()
+	arg.TimeBetweenHeartbeats = 0
+	watcher, err = NewPeerStatusWatcher(arg)
+	assert.True(t, check.IfNil(watcher))
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidTimeDuration))
+
+	arg = createMockArgPeerStatusWatcher()
+	arg.NumMissedHeartbeats = 0
+	watcher, err = NewPeerStatusWatcher(arg)
+	assert.True(t, check.IfNil(watcher))
+	assert.True(t, errors.Is(err, heartbeat.ErrWrongValues))
+
+	arg = createMockArgPeerStatusWatcher()
+	arg.CheckInterval = 0
+	watcher, err = NewPeerStatusWatcher(arg)
+	assert.True(t, check.IfNil(watcher))
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidTimeDuration))
+
+	arg = createMockArgPeerStatusWatcher()
+	arg.TrackedPublicKeys = nil
+	arg.TrackedIdentities = []string{"provider"}
+	watcher, err = NewPeerStatusWatcher(arg)
+	assert.False(t, check.IfNil(watcher))
+	assert.Nil(t, err)
+}
+
+func TestPeerStatusWatcher_CheckShouldNotifyTheTransitions(t *testing.T) {
+	t.Parallel()
+
+	lastSeen := time.Unix(1000, 0)
+	now := lastSeen
+	heartbeats := []data.PubKeyHeartbeat{
+		{PublicKey: "pk1", IsActive: true, TimeStamp: lastSeen, ComputedShardID: 2},
+		{PublicKey: "pk2", IsActive: true, TimeStamp: lastSeen},
+	}
+	events := &notifiedEvents{}
+	arg := createMockArgPeerStatusWatcher()
+	arg.Monitor = &mock.MonitorStub{
+		GetHeartbeatsCalled: func() []data.PubKeyHeartbeat {
+			return heartbeats
+		},
+	}
+	arg.Timer = &mock.TimerMock{
+		NowCalled: func() time.Time {
+			return now
+		},
+	}
+	arg.Notifiers = []heartbeat.PeerStatusNotifier{events.notifier()}
+	watcher, _ := NewPeerStatusWatcher(arg)
+
+	watcher.Check()
+	assert.Equal(t, 0, len(events.popTypes()))
+
+	now = lastSeen.Add(time.Second * 50)
+	watcher.Check()
+	assert.Equal(t, 0, len(events.popTypes()))
+
+	heartbeats[0].IsActive = false
+	heartbeats[1].IsActive = false
+	now = lastSeen.Add(time.Second * 61)
+	watcher.Check()
+	assert.Equal(t, []string{data.PeerStatusOffline, data.PeerStatusMissedHeartbeats}, events.popTypes())
+
+	now = lastSeen.Add(time.Second * 100)
+	watcher.Check()
+	assert.Equal(t, 0, len(events.popTypes()))
+
+	lastSeen = now
+	heartbeats[0].IsActive = true
+	heartbeats[0].TimeStamp = lastSeen
+	watcher.Check()
+	assert.Equal(t, []string{data.PeerStatusOnline}, events.popTypes())
+
+	heartbeats[0].IsActive = false
+	now = lastSeen.Add(time.Minute * 2)
+	watcher.Check()
+	events.mut.Lock()
+	require.Equal(t, 2, len(events.events))
+	assert.Equal(t, "pk1", events.events[1].PublicKey)
+	assert.Equal(t, uint32(2), events.events[1].ShardID)
+	assert.Equal(t, uint32(6), events.events[1].NumMissedHeartbeats)
+	assert.Equal(t, lastSeen, events.events[1].LastSeen)
+	assert.Equal(t, now, events.events[1].Timestamp)
+	events.mut.Unlock()
+}
+
+func TestPeerStatusWatcher_CheckShouldTrackTheIdentities(t *testing.T) {
+	t.Parallel()
+
+	events := &notifiedEvents{}
+	isActive := true
+	arg := createMockArgPeerStatusWatcher()
+	arg.TrackedPublicKeys = nil
+	arg.TrackedIdentities = []string{"Provider"}
+	arg.Monitor = &mock.MonitorStub{
+		GetHeartbeatsCalled: func() []data.PubKeyHeartbeat {
+			return []data.PubKeyHeartbeat{
+				{PublicKey: "pk1", Identity: "provider", IsActive: isActive},
+				{PublicKey: "pk2", Identity: "other", IsActive: isActive},
+				{Pid: "observer", Identity: "provider", IsActive: isActive},
+			}
+		},
+	}
+	arg.Timer = &mock.TimerMock{
+		NowCalled: func() time.Time {
+			return time.Time{}
+		},
+	}
+	arg.Notifiers = []heartbeat.PeerStatusNotifier{events.notifier()}
+	watcher, _ := NewPeerStatusWatcher(arg)
+
+	watcher.Check()
+	isActive = false
+	watcher.Check()
+
+	events.mut.Lock()
+	defer events.mut.Unlock()
+	require.Equal(t, 1, len(events.events))
+	assert.Equal(t, "pk1", events.events[0].PublicKey)
+	assert.Equal(t, data.PeerStatusOffline, events.events[0].Type)
+}
+
+func TestPeerStatusWatcher_CheckShouldConsiderTheActiveInstance(t *testing.T) {
+	t.Parallel()
+
+	events := &notifiedEvents{}
+	arg := createMockArgPeerStatusWatcher()
+	arg.Monitor = &mock.MonitorStub{
+		GetHeartbeatsCalled: func() []data.PubKeyHeartbeat {
+			return []data.PubKeyHeartbeat{
+				{PublicKey: "pk1", Pid: "pid1", IsActive: false},
+				{PublicKey: "pk1", Pid: "pid2", IsActive: true, TimeStamp: time.Unix(0, 0)},
+			}
+		},
+	}
+	arg.Notifiers = []heartbeat.PeerStatusNotifier{events.notifier()}
+	watcher, _ := NewPeerStatusWatcher(arg)
+
+	watcher.Check()
+	watcher.Check()
+
+	assert.Equal(t, 0, len(events.popTypes()))
+}
+
+func TestPeerStatusWatcher_StartWatchingShouldCheckPeriodically(t *testing.T) {
+	t.Parallel()
+
+	numCalls := make(chan struct{}, 100)
+	arg := createMockArgPeerStatusWatcher()
+	arg.CheckInterval = time.Millisecond * 10
+	arg.Monitor = &mock.MonitorStub{
+		GetHeartbeatsCalled: func() []data.PubKeyHeartbeat {
+			numCalls <- struct{}{}
+			return nil
+		},
+	}
+	watcher, _ := NewPeerStatusWatcher(arg)
+
+	watcher.StartWatching()
+	time.Sleep(time.Millisecond * 100)
+	_ = watcher.Close()
+
+	assert.True(t, len(numCalls) > 1)
+}
@@ -0,0 +1,129 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+)
+
+var log = logger.GetOrCreate("heartbeat/notifier")
+
+// ArgWebhookNotifier represents the arguments for the webhook notifier
+type ArgWebhookNotifier struct {
+	URLs       []string
+	Timeout    time.Duration
+	BufferSize uint32
+}
+
+// webhookNotifier posts the peer status events, JSON encoded, to the configured URLs. The events are delivered on a
+// separate go routine and the events that do not fit in the buffer are dropped, so a slow endpoint never delays the
+// heartbeat monitor
+type webhookNotifier struct {
+	urls       []string
+	httpClient *http.Client
+	events     chan *data.PeerStatusEvent
+	cancel     func()
+}
+
+// NewWebhookNotifier creates a new webhook notifier and starts delivering the events
+func NewWebhookNotifier(arg ArgWebhookNotifier) (*webhookNotifier, error) {
+	if len(arg.URLs) == 0 {
+		return nil, heartbeat.ErrNoWebhookURLs
+	}
+	if arg.Timeout <= 0 {
+		return nil, fmt.Errorf("%w for Timeout", heartbeat.ErrInvalidTimeDuration)
+	}
+	if arg.BufferSize == 0 {
+		return nil, fmt.Errorf("%w for BufferSize", heartbeat.ErrWrongValues)
+	}
+
+	notifier := &webhookNotifier{
+		urls:       arg.URLs,
+		httpClient: &http.Client{Timeout: arg.Timeout},
+		events:     make(chan *data.PeerStatusEvent, arg.BufferSize),
+	}
+
+	var ctx context.Context
+	ctx, notifier.cancel = context.WithCancel(context.Background())
+	go notifier.deliverLoop(ctx)
+
+	return notifier, nil
+}
+
+// NotifyPeerStatus queues the provided event for delivery
+func (notifier *webhookNotifier) NotifyPeerStatus(event *data.PeerStatusEvent) {
+	if event == nil {
+		return
+	}
+
+	select {
+	case notifier.events <- event:
+	default:
+		log.Debug("webhook notifier: buffer is full, event dropped", "type", event.Type, "public key", event.PublicKey)
+	}
+}
+
+func (notifier *webhookNotifier) deliverLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-notifier.events:
+			notifier.deliver(ctx, event)
+		}
+	}
+}
+
+func (notifier *webhookNotifier) deliver(ctx context.Context, event *data.PeerStatusEvent) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Warn("webhook notifier: marshal event", "error", err.Error())
+		return
+	}
+
+	for _, url := range notifier.urls {
+		err = notifier.post(ctx, url, payload)
+		if err != nil {
+			log.Debug("webhook notifier: post event", "url", url, "type", event.Type, "error", err.Error())
+		}
+	}
+}
+
+func (notifier *webhookNotifier) post(ctx context.Context, url string, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := notifier.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Close stops delivering the events. The queued events are dropped
+func (notifier *webhookNotifier) Close() error {
+	notifier.cancel()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (notifier *webhookNotifier) IsInterfaceNil() bool {
+	return notifier == nil
+}
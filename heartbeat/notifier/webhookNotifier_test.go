@@ -0,0 +1,108 @@
+package notifier
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgWebhookNotifier() ArgWebhookNotifier {
+	return ArgWebhookNotifier{
+		URLs:       []string{"http://localhost"},
+		Timeout:    time.Second,
+		BufferSize: 10,
+	}
+}
+
+func TestNewWebhookNotifier(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgWebhookNotifier()
+	arg.URLs = nil
+	notifier, err := NewWebhookNotifier(arg)
+	assert.True(t, check.IfNil(notifier))
+	assert.Equal(t, heartbeat.ErrNoWebhookURLs, err)
+
+	arg = createMockArgWebhookNotifier()
+	arg.Timeout = 0
+	notifier, err = NewWebhookNotifier(arg)
+	assert.True(t, check.IfNil(notifier))
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidTimeDuration))
+
+	arg = createMockArgWebhookNotifier()
+	arg.BufferSize = 0
+	notifier, err = NewWebhookNotifier(arg)
+	assert.True(t, check.IfNil(notifier))
+	assert.True(t, errors.Is(err, heartbeat.ErrWrongValues))
+
+	notifier, err = NewWebhookNotifier(createMockArgWebhookNotifier())
+	assert.False(t, check.IfNil(notifier))
+	assert.Nil(t, err)
+	_ = notifier.Close()
+}
+
+func TestWebhookNotifier_NotifyPeerStatusShouldPostToAllTheURLs(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan *data.PeerStatusEvent, 10)
+	handler := func(statusCode int) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+			event := &data.PeerStatusEvent{}
+			err := json.NewDecoder(r.Body).Decode(event)
+			assert.Nil(t, err)
+			received <- event
+			w.WriteHeader(statusCode)
+		}
+	}
+	failingServer := httptest.NewServer(handler(http.StatusInternalServerError))
+	defer failingServer.Close()
+	server := httptest.NewServer(handler(http.StatusOK))
+	defer server.Close()
+
+	arg := createMockArgWebhookNotifier()
+	arg.URLs = []string{failingServer.URL, server.URL}
+	notifier, _ := NewWebhookNotifier(arg)
+	defer func() {
+		_ = notifier.Close()
+	}()
+
+	notifier.NotifyPeerStatus(nil)
+	notifier.NotifyPeerStatus(&data.PeerStatusEvent{Type: data.PeerStatusOffline, PublicKey: "pk1"})
+
+	for i := 0; i < 2; i++ {
+		select {
+		case event := <-received:
+			assert.Equal(t, data.PeerStatusOffline, event.Type)
+			assert.Equal(t, "pk1", event.PublicKey)
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "timeout waiting for the webhook call")
+		}
+	}
+}
+
+func TestWebhookNotifier_FullBufferShouldDropEvents(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgWebhookNotifier()
+	arg.BufferSize = 1
+	notifier, _ := NewWebhookNotifier(arg)
+	_ = notifier.Close()
+	time.Sleep(time.Millisecond * 10)
+
+	notifier.NotifyPeerStatus(&data.PeerStatusEvent{PublicKey: "pk1"})
+	notifier.NotifyPeerStatus(&data.PeerStatusEvent{PublicKey: "pk2"})
+
+	assert.Equal(t, 1, len(notifier.events))
+}
@@ -0,0 +1,57 @@
+package process
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+func checkReceivedMessage(
+	message p2p.MessageP2P,
+	fromConnectedPeer core.PeerID,
+	antifloodHandler heartbeat.P2PAntifloodHandler,
+	topic string,
+) error {
+	if check.IfNil(message) {
+		return heartbeat.ErrNilMessage
+	}
+	if message.Data() == nil {
+		return heartbeat.ErrNilDataToProcess
+	}
+
+	err := antifloodHandler.CanProcessMessage(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+
+	return antifloodHandler.CanProcessMessagesOnTopic(fromConnectedPeer, topic, 1, uint64(len(message.Data())), message.SeqNo())
+}
+
+func blacklistPeers(
+	antifloodHandler heartbeat.P2PAntifloodHandler,
+	originator core.PeerID,
+	fromConnectedPeer core.PeerID,
+	reason string,
+) {
+	antifloodHandler.BlacklistPeer(originator, reason, core.InvalidMessageBlacklistDuration)
+	antifloodHandler.BlacklistPeer(fromConnectedPeer, reason, core.InvalidMessageBlacklistDuration)
+}
+
+// checkPayloadTimestamp refuses the payloads created too far from the current time, so the messages can not be
+// replayed. The senders' clocks are not expected to be synchronized, hence the accepted difference
+func checkPayloadTimestamp(payload *data.Payload, timer heartbeat.Timer, maxTimeDifference time.Duration) error {
+	timeDifference := timer.Now().Sub(time.Unix(payload.Timestamp, 0))
+	if timeDifference < 0 {
+		timeDifference = -timeDifference
+	}
+	if timeDifference > maxTimeDifference {
+		return fmt.Errorf("%w, time difference %v", heartbeat.ErrMessageExpired, timeDifference)
+	}
+
+	return nil
+}
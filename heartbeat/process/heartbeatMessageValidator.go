@@ -8,24 +8,34 @@ import (
 )
 
 const maxSizeInBytes = 128
+const maxNumManagedKeys = 1000
 
-func verifyLengths(heartbeat *data.Heartbeat) error {
-	err := VerifyHeartbeatProperyLen("Pubkey", heartbeat.Pubkey)
+func verifyPeerAuthenticationLengths(peerAuthentication *data.PeerAuthentication) error {
+	err := VerifyHeartbeatProperyLen("Pubkey", peerAuthentication.Pubkey)
 	if err != nil {
 		return err
 	}
 
-	err = VerifyHeartbeatProperyLen("Payload", heartbeat.Payload)
+	err = VerifyHeartbeatProperyLen("Signature", peerAuthentication.Signature)
 	if err != nil {
 		return err
 	}
 
-	err = VerifyHeartbeatProperyLen("NodeDisplayName", []byte(heartbeat.NodeDisplayName))
+	err = VerifyHeartbeatProperyLen("Pid", peerAuthentication.Pid)
 	if err != nil {
 		return err
 	}
 
-	err = VerifyHeartbeatProperyLen("Identity", []byte(heartbeat.Identity))
+	err = VerifyHeartbeatProperyLen("Payload", peerAuthentication.Payload)
+	if err != nil {
+		return err
+	}
+
+	return VerifyHeartbeatProperyLen("PayloadSignature", peerAuthentication.PayloadSignature)
+}
+
+func verifyHeartbeatLengths(heartbeat *data.HeartbeatV2) error {
+	err := VerifyHeartbeatProperyLen("Payload", heartbeat.Payload)
 	if err != nil {
 		return err
 	}
@@ -35,11 +45,31 @@ func verifyLengths(heartbeat *data.Heartbeat) error {
 		return err
 	}
 
-	err = VerifyHeartbeatProperyLen("Signature", heartbeat.Signature)
+	err = VerifyHeartbeatProperyLen("NodeDisplayName", []byte(heartbeat.NodeDisplayName))
+	if err != nil {
+		return err
+	}
+
+	err = VerifyHeartbeatProperyLen("Identity", []byte(heartbeat.Identity))
 	if err != nil {
 		return err
 	}
 
+	return verifyManagedKeysLengths(heartbeat.ManagedKeys)
+}
+
+func verifyManagedKeysLengths(managedKeys [][]byte) error {
+	if len(managedKeys) > maxNumManagedKeys {
+		return fmt.Errorf("%w, got %d, maximum %d", heartbeat.ErrTooManyManagedKeys, len(managedKeys), maxNumManagedKeys)
+	}
+
+	for _, managedKey := range managedKeys {
+		err := VerifyHeartbeatProperyLen("ManagedKeys", managedKey)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -52,20 +82,20 @@ func VerifyHeartbeatProperyLen(property string, value []byte) error {
 	return nil
 }
 
-func trimLengths(heartbeat *data.Heartbeat) {
-	if len(heartbeat.Payload) > maxSizeInBytes {
-		heartbeat.Payload = heartbeat.Payload[:maxSizeInBytes]
+// TrimHeartbeatProperty returns the provided value, trimmed to the length accepted by the network
+func TrimHeartbeatProperty(value string) string {
+	if len(value) > maxSizeInBytes {
+		return value[:maxSizeInBytes]
 	}
 
-	if len(heartbeat.NodeDisplayName) > maxSizeInBytes {
-		heartbeat.NodeDisplayName = heartbeat.NodeDisplayName[:maxSizeInBytes]
-	}
+	return value
+}
 
-	if len(heartbeat.Identity) > maxSizeInBytes {
-		heartbeat.Identity = heartbeat.Identity[:maxSizeInBytes]
+// TrimManagedKeys returns the provided managed keys, trimmed to the number accepted by the network
+func TrimManagedKeys(managedKeys [][]byte) [][]byte {
+	if len(managedKeys) > maxNumManagedKeys {
+		return managedKeys[:maxNumManagedKeys]
 	}
 
-	if len(heartbeat.VersionNumber) > maxSizeInBytes {
-		heartbeat.VersionNumber = heartbeat.VersionNumber[:maxSizeInBytes]
-	}
+	return managedKeys
 }
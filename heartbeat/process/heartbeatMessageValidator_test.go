@@ -2,29 +2,63 @@ package process
 
 import (
 	"crypto/rand"
+	"errors"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func TestTrimLengths(t *testing.T) {
+func TestTrimHeartbeatProperty(t *testing.T) {
+	t.Parallel()
 
-	token1 := make([]byte, maxSizeInBytes+2)
-	token2 := make([]byte, maxSizeInBytes+2)
-	token3 := make([]byte, maxSizeInBytes+2)
-	_, _ = rand.Read(token1)
-	_, _ = rand.Read(token2)
-	_, _ = rand.Read(token3)
+	token := make([]byte, maxSizeInBytes+2)
+	_, _ = rand.Read(token)
 
-	heartBeat := &data.Heartbeat{
-		Payload:         token1,
-		NodeDisplayName: string(token2),
-		VersionNumber:   string(token3),
+	require.Equal(t, maxSizeInBytes, len(TrimHeartbeatProperty(string(token))))
+	require.Equal(t, "display name", TrimHeartbeatProperty("display name"))
+}
+
+func TestTrimManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	managedKeys := make([][]byte, maxNumManagedKeys+2)
+	require.Equal(t, maxNumManagedKeys, len(TrimManagedKeys(managedKeys)))
+	require.Equal(t, 2, len(TrimManagedKeys(managedKeys[:2])))
+}
+
+func TestVerifyHeartbeatLengths(t *testing.T) {
+	t.Parallel()
+
+	hb := &data.HeartbeatV2{
+		Payload:         []byte("payload"),
+		VersionNumber:   "v1.0.0",
+		NodeDisplayName: "node",
+		Identity:        "identity",
 	}
+	assert.Nil(t, verifyHeartbeatLengths(hb))
+
+	hb.Identity = string(make([]byte, maxSizeInBytes+1))
+	err := verifyHeartbeatLengths(hb)
+	assert.True(t, errors.Is(err, heartbeat.ErrPropertyTooLong))
+}
+
+func TestVerifyHeartbeatLengthsManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	hb := &data.HeartbeatV2{
+		Payload:     []byte("payload"),
+		ManagedKeys: [][]byte{[]byte("key 1"), []byte("key 2")},
+	}
+	assert.Nil(t, verifyHeartbeatLengths(hb))
+
+	hb.ManagedKeys[1] = make([]byte, maxSizeInBytes+1)
+	err := verifyHeartbeatLengths(hb)
+	assert.True(t, errors.Is(err, heartbeat.ErrPropertyTooLong))
 
-	trimLengths(heartBeat)
-	require.Equal(t, maxSizeInBytes, len(heartBeat.Payload))
-	require.Equal(t, maxSizeInBytes, len(heartBeat.NodeDisplayName))
-	require.Equal(t, maxSizeInBytes, len(heartBeat.VersionNumber))
+	hb.ManagedKeys = make([][]byte, maxNumManagedKeys+1)
+	err = verifyHeartbeatLengths(hb)
+	assert.True(t, errors.Is(err, heartbeat.ErrTooManyManagedKeys))
 }
@@ -0,0 +1,120 @@
+package process
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgHeartbeatProcessor represents the arguments for the heartbeat processor
+type ArgHeartbeatProcessor struct {
+	Marshalizer              marshal.Marshalizer
+	Cache                    storage.Cacher
+	AntifloodHandler         heartbeat.P2PAntifloodHandler
+	NetworkShardingCollector heartbeat.NetworkShardingCollector
+	Timer                    heartbeat.Timer
+	MaxTimeDifference        time.Duration
+}
+
+// heartbeatProcessor validates the heartbeat messages and stores them in the cache, keyed by the originator peer ID
+type heartbeatProcessor struct {
+	marshalizer              marshal.Marshalizer
+	cache                    storage.Cacher
+	antifloodHandler         heartbeat.P2PAntifloodHandler
+	networkShardingCollector heartbeat.NetworkShardingCollector
+	timer                    heartbeat.Timer
+	maxTimeDifference        time.Duration
+}
+
+// NewHeartbeatProcessor creates a new heartbeat processor
+func NewHeartbeatProcessor(arg ArgHeartbeatProcessor) (*heartbeatProcessor, error) {
+	if check.IfNil(arg.Marshalizer) {
+		return nil, heartbeat.ErrNilMarshalizer
+	}
+	if check.IfNil(arg.Cache) {
+		return nil, heartbeat.ErrNilCacher
+	}
+	if check.IfNil(arg.AntifloodHandler) {
+		return nil, heartbeat.ErrNilAntifloodHandler
+	}
+	if check.IfNil(arg.NetworkShardingCollector) {
+		return nil, heartbeat.ErrNilNetworkShardingCollector
+	}
+	if check.IfNil(arg.Timer) {
+		return nil, heartbeat.ErrNilTimer
+	}
+	if arg.MaxTimeDifference <= 0 {
+		return nil, fmt.Errorf("%w for MaxTimeDifference", heartbeat.ErrInvalidTimeDuration)
+	}
+
+	return &heartbeatProcessor{
+		marshalizer:              arg.Marshalizer,
+		cache:                    arg.Cache,
+		antifloodHandler:         arg.AntifloodHandler,
+		networkShardingCollector: arg.NetworkShardingCollector,
+		timer:                    arg.Timer,
+		maxTimeDifference:        arg.MaxTimeDifference,
+	}, nil
+}
+
+// ProcessReceivedMessage validates the received heartbeat message and, if correct, stores it
+func (hp *heartbeatProcessor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	err := checkReceivedMessage(message, fromConnectedPeer, hp.antifloodHandler, core.HeartbeatV2Topic)
+	if err != nil {
+		return err
+	}
+
+	hb, payload, err := hp.createHeartbeat(message)
+	if err != nil {
+		//this situation is so severe that we have to black list both the message originator and the connected peer
+		//that disseminated this message.
+		blacklistPeers(hp.antifloodHandler, message.Peer(), fromConnectedPeer, "blacklisted due to invalid heartbeat message")
+
+		return err
+	}
+
+	err = checkPayloadTimestamp(payload, hp.timer, hp.maxTimeDifference)
+	if err != nil {
+		return err
+	}
+
+	//add into the last failsafe map. Useful for observers.
+	hp.networkShardingCollector.UpdatePeerIdShardId(message.Peer(), hb.ShardID)
+
+	_ = hp.cache.Put(message.Peer().Bytes(), hb, hb.Size())
+
+	return nil
+}
+
+func (hp *heartbeatProcessor) createHeartbeat(message p2p.MessageP2P) (*data.HeartbeatV2, *data.Payload, error) {
+	hb := &data.HeartbeatV2{}
+	err := hp.marshalizer.Unmarshal(hb, message.Data())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = verifyHeartbeatLengths(hb)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payload := &data.Payload{}
+	err = hp.marshalizer.Unmarshal(payload, hb.Payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hb, payload, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hp *heartbeatProcessor) IsInterfaceNil() bool {
+	return hp == nil
+}
@@ -0,0 +1,152 @@
+package process
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgHeartbeatProcessor() ArgHeartbeatProcessor {
+	return ArgHeartbeatProcessor{
+		Marshalizer:      &mock.MarshalizerMock{},
+		Cache:            testscommon.NewCacherMock(),
+		AntifloodHandler: &mock.P2PAntifloodHandlerStub{},
+		NetworkShardingCollector: &mock.NetworkShardingCollectorStub{
+			UpdatePeerIdShardIdCalled: func(pid core.PeerID, shardId uint32) {},
+		},
+		Timer:             mock.NewTimerMock(),
+		MaxTimeDifference: time.Minute,
+	}
+}
+
+func createHeartbeatMessage(t *testing.T, timestamp int64) *mock.P2PMessageStub {
+	marshalizer := &mock.MarshalizerMock{}
+	payloadBytes, err := marshalizer.Marshal(&data.Payload{Timestamp: timestamp})
+	require.Nil(t, err)
+
+	hb := &data.HeartbeatV2{
+		Payload:         payloadBytes,
+		VersionNumber:   "v1.0.0",
+		NodeDisplayName: "node",
+		Identity:        "identity",
+		Nonce:           10,
+		ShardID:         1,
+	}
+	buff, err := marshalizer.Marshal(hb)
+	require.Nil(t, err)
+
+	return &mock.P2PMessageStub{
+		DataField: buff,
+		PeerField: "pid",
+	}
+}
+
+func TestNewHeartbeatProcessor(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatProcessor()
+	arg.Marshalizer = nil
+	hp, err := NewHeartbeatProcessor(arg)
+	assert.True(t, check.IfNil(hp))
+	assert.Equal(t, heartbeat.ErrNilMarshalizer, err)
+
+	arg = createMockArgHeartbeatProcessor()
+	arg.Cache = nil
+	hp, err = NewHeartbeatProcessor(arg)
+	assert.True(t, check.IfNil(hp))
+	assert.Equal(t, heartbeat.ErrNilCacher, err)
+
+	arg = createMockArgHeartbeatProcessor()
+	arg.AntifloodHandler = nil
+	hp, err = NewHeartbeatProcessor(arg)
+	assert.True(t, check.IfNil(hp))
+	assert.Equal(t, heartbeat.ErrNilAntifloodHandler, err)
+
+	arg = createMockArgHeartbeatProcessor()
+	arg.NetworkShardingCollector = nil
+	hp, err = NewHeartbeatProcessor(arg)
+	assert.True(t, check.IfNil(hp))
+	assert.Equal(t, heartbeat.ErrNilNetworkShardingCollector, err)
+
+	arg = createMockArgHeartbeatProcessor()
+	arg.Timer = nil
+	hp, err = NewHeartbeatProcessor(arg)
+	assert.True(t, check.IfNil(hp))
+	assert.Equal(t, heartbeat.ErrNilTimer, err)
+
+	arg = createMockArgHeartbeatProcessor()
+	arg.MaxTimeDifference = 0
+	hp, err = NewHeartbeatProcessor(arg)
+	assert.True(t, check.IfNil(hp))
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidTimeDuration))
+
+	hp, err = NewHeartbeatProcessor(createMockArgHeartbeatProcessor())
+	assert.False(t, check.IfNil(hp))
+	assert.Nil(t, err)
+}
+
+func TestHeartbeatProcessor_ProcessReceivedMessageInvalidShouldBlacklist(t *testing.T) {
+	t.Parallel()
+
+	blacklisted := make([]core.PeerID, 0)
+	arg := createMockArgHeartbeatProcessor()
+	arg.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		BlacklistPeerCalled: func(peer core.PeerID, reason string, duration time.Duration) {
+			blacklisted = append(blacklisted, peer)
+		},
+	}
+	hp, _ := NewHeartbeatProcessor(arg)
+
+	message := &mock.P2PMessageStub{
+		DataField: []byte("invalid heartbeat"),
+		PeerField: "pid",
+	}
+	err := hp.ProcessReceivedMessage(message, "connected pid")
+	assert.NotNil(t, err)
+	assert.Equal(t, []core.PeerID{"pid", "connected pid"}, blacklisted)
+	assert.Equal(t, 0, arg.Cache.Len())
+}
+
+func TestHeartbeatProcessor_ProcessReceivedMessageExpiredShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatProcessor()
+	hp, _ := NewHeartbeatProcessor(arg)
+
+	message := createHeartbeatMessage(t, 61)
+	err := hp.ProcessReceivedMessage(message, "connected pid")
+	assert.True(t, errors.Is(err, heartbeat.ErrMessageExpired))
+	assert.Equal(t, 0, arg.Cache.Len())
+}
+
+func TestHeartbeatProcessor_ProcessReceivedMessageShouldWork(t *testing.T) {
+	t.Parallel()
+
+	updatedShardID := uint32(0)
+	arg := createMockArgHeartbeatProcessor()
+	arg.NetworkShardingCollector = &mock.NetworkShardingCollectorStub{
+		UpdatePeerIdShardIdCalled: func(pid core.PeerID, shardId uint32) {
+			assert.Equal(t, core.PeerID("pid"), pid)
+			updatedShardID = shardId
+		},
+	}
+	hp, _ := NewHeartbeatProcessor(arg)
+
+	message := createHeartbeatMessage(t, 60)
+	err := hp.ProcessReceivedMessage(message, "connected pid")
+	require.Nil(t, err)
+	assert.Equal(t, uint32(1), updatedShardID)
+
+	cached, ok := arg.Cache.Get([]byte("pid"))
+	require.True(t, ok)
+	assert.Equal(t, "node", cached.(*data.HeartbeatV2).NodeDisplayName)
+}
@@ -0,0 +1,229 @@
+package process
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgPeerAuthenticationProcessor represents the arguments for the peer authentication processor
+type ArgPeerAuthenticationProcessor struct {
+	Marshalizer              marshal.Marshalizer
+	PeerSignatureHandler     crypto.PeerSignatureHandler
+	SingleSigner             crypto.SingleSigner
+	KeyGenerator             crypto.KeyGenerator
+	Cache                    storage.Cacher
+	VerifiedPayloadsCache    storage.Cacher
+	Hasher                   hashing.Hasher
+	AntifloodHandler         heartbeat.P2PAntifloodHandler
+	HardforkTrigger          heartbeat.HardforkTrigger
+	NetworkShardingCollector heartbeat.NetworkShardingCollector
+	Timer                    heartbeat.Timer
+	MaxTimeDifference        time.Duration
+}
+
+// peerAuthenticationProcessor validates the peer authentication messages and stores them in the cache, keyed by
+// the public key and the peer ID. A public key may be authenticated by more peers, as a node may manage more keys.
+// The same message reaches the node through each of its connected peers, so the payload signatures already verified
+// are remembered, keyed by the public key and the payload hash. The payload holds the send timestamp, thus each new
+// message sent by a key is verified once, while its freshness is still checked on every receipt
+type peerAuthenticationProcessor struct {
+	marshalizer              marshal.Marshalizer
+	peerSignatureHandler     crypto.PeerSignatureHandler
+	singleSigner             crypto.SingleSigner
+	keyGenerator             crypto.KeyGenerator
+	cache                    storage.Cacher
+	verifiedPayloadsCache    storage.Cacher
+	hasher                   hashing.Hasher
+	antifloodHandler         heartbeat.P2PAntifloodHandler
+	hardforkTrigger          heartbeat.HardforkTrigger
+	networkShardingCollector heartbeat.NetworkShardingCollector
+	timer                    heartbeat.Timer
+	maxTimeDifference        time.Duration
+}
+
+// NewPeerAuthenticationProcessor creates a new peer authentication processor
+func NewPeerAuthenticationProcessor(arg ArgPeerAuthenticationProcessor) (*peerAuthenticationProcessor, error) {
+	if check.IfNil(arg.Marshalizer) {
+		return nil, heartbeat.ErrNilMarshalizer
+	}
+	if check.IfNil(arg.PeerSignatureHandler) {
+		return nil, heartbeat.ErrNilPeerSignatureHandler
+	}
+	if check.IfNil(arg.SingleSigner) {
+		return nil, heartbeat.ErrNilSingleSigner
+	}
+	if check.IfNil(arg.KeyGenerator) {
+		return nil, heartbeat.ErrNilKeyGenerator
+	}
+	if check.IfNil(arg.Cache) {
+		return nil, heartbeat.ErrNilCacher
+	}
+	if check.IfNil(arg.VerifiedPayloadsCache) {
+		return nil, fmt.Errorf("%w for VerifiedPayloadsCache", heartbeat.ErrNilCacher)
+	}
+	if check.IfNil(arg.Hasher) {
+		return nil, heartbeat.ErrNilHasher
+	}
+	if check.IfNil(arg.AntifloodHandler) {
+		return nil, heartbeat.ErrNilAntifloodHandler
+	}
+	if check.IfNil(arg.HardforkTrigger) {
+		return nil, heartbeat.ErrNilHardforkTrigger
+	}
+	if check.IfNil(arg.NetworkShardingCollector) {
+		return nil, heartbeat.ErrNilNetworkShardingCollector
+	}
+	if check.IfNil(arg.Timer) {
+		return nil, heartbeat.ErrNilTimer
+	}
+	if arg.MaxTimeDifference <= 0 {
+		return nil, fmt.Errorf("%w for MaxTimeDifference", heartbeat.ErrInvalidTimeDuration)
+	}
+
+	return &peerAuthenticationProcessor{
+		marshalizer:              arg.Marshalizer,
+		peerSignatureHandler:     arg.PeerSignatureHandler,
+		singleSigner:             arg.SingleSigner,
+		keyGenerator:             arg.KeyGenerator,
+		cache:                    arg.Cache,
+		verifiedPayloadsCache:    arg.VerifiedPayloadsCache,
+		hasher:                   arg.Hasher,
+		antifloodHandler:         arg.AntifloodHandler,
+		hardforkTrigger:          arg.HardforkTrigger,
+		networkShardingCollector: arg.NetworkShardingCollector,
+		timer:                    arg.Timer,
+		maxTimeDifference:        arg.MaxTimeDifference,
+	}, nil
+}
+
+// ProcessReceivedMessage validates the received peer authentication message and, if correct, stores it
+func (pap *peerAuthenticationProcessor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	err := checkReceivedMessage(message, fromConnectedPeer, pap.antifloodHandler, core.PeerAuthenticationTopic)
+	if err != nil {
+		return err
+	}
+
+	peerAuthentication, payload, err := pap.createPeerAuthentication(message)
+	if err != nil {
+		//this situation is so severe that we have to black list both the message originator and the connected peer
+		//that disseminated this message.
+		blacklistPeers(pap.antifloodHandler, message.Peer(), fromConnectedPeer, "blacklisted due to invalid peer authentication message")
+
+		return err
+	}
+
+	// the hardfork trigger messages are relayed by all the peers, so they are checked before the originator
+	isHardforkTrigger, err := pap.hardforkTrigger.TriggerReceived(message.Data(), payload.HardforkMessage, peerAuthentication.Pubkey)
+	if isHardforkTrigger {
+		return err
+	}
+
+	if !bytes.Equal(peerAuthentication.Pid, message.Peer().Bytes()) {
+		//this situation is so severe that we have to black list both the message originator and the connected peer
+		//that disseminated this message.
+		blacklistPeers(pap.antifloodHandler, message.Peer(), fromConnectedPeer, "blacklisted due to inconsistent peer authentication message")
+
+		return fmt.Errorf("%w peer authentication pid %s, message pid %s",
+			heartbeat.ErrHeartbeatPidMismatch,
+			p2p.PeerIdToShortString(core.PeerID(peerAuthentication.Pid)),
+			p2p.PeerIdToShortString(message.Peer()),
+		)
+	}
+
+	err = checkPayloadTimestamp(payload, pap.timer, pap.maxTimeDifference)
+	if err != nil {
+		return err
+	}
+
+	pap.networkShardingCollector.UpdatePeerIdPublicKey(message.Peer(), peerAuthentication.Pubkey)
+
+	key := append(append(make([]byte, 0), peerAuthentication.Pubkey...), peerAuthentication.Pid...)
+	_ = pap.cache.Put(key, peerAuthentication, peerAuthentication.Size())
+
+	return nil
+}
+
+func (pap *peerAuthenticationProcessor) createPeerAuthentication(message p2p.MessageP2P) (*data.PeerAuthentication, *data.Payload, error) {
+	peerAuthentication := &data.PeerAuthentication{}
+	err := pap.marshalizer.Unmarshal(peerAuthentication, message.Data())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = verifyPeerAuthenticationLengths(peerAuthentication)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = pap.peerSignatureHandler.VerifyPeerSignature(peerAuthentication.Pubkey, core.PeerID(peerAuthentication.Pid), peerAuthentication.Signature)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = pap.verifyPayloadSignature(peerAuthentication)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payload := &data.Payload{}
+	err = pap.marshalizer.Unmarshal(payload, peerAuthentication.Payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return peerAuthentication, payload, nil
+}
+
+func (pap *peerAuthenticationProcessor) verifyPayloadSignature(peerAuthentication *data.PeerAuthentication) error {
+	key := append(append(make([]byte, 0), peerAuthentication.Pubkey...), pap.hasher.Compute(string(peerAuthentication.Payload))...)
+	if pap.isPayloadAlreadyVerified(key, peerAuthentication.PayloadSignature) {
+		return nil
+	}
+
+	publicKey, err := pap.keyGenerator.PublicKeyFromByteArray(peerAuthentication.Pubkey)
+	if err != nil {
+		return err
+	}
+
+	err = pap.singleSigner.Verify(publicKey, peerAuthentication.Payload, peerAuthentication.PayloadSignature)
+	if err != nil {
+		return err
+	}
+
+	verifiedSignature := append(make([]byte, 0, len(peerAuthentication.PayloadSignature)), peerAuthentication.PayloadSignature...)
+	_ = pap.verifiedPayloadsCache.Put(key, verifiedSignature, len(key)+len(verifiedSignature))
+
+	return nil
+}
+
+// isPayloadAlreadyVerified returns true only if the provided signature is identical to the verified one
+func (pap *peerAuthenticationProcessor) isPayloadAlreadyVerified(key []byte, signature []byte) bool {
+	if len(signature) == 0 {
+		return false
+	}
+
+	value, ok := pap.verifiedPayloadsCache.Get(key)
+	if !ok {
+		return false
+	}
+
+	verifiedSignature, ok := value.([]byte)
+
+	return ok && bytes.Equal(verifiedSignature, signature)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pap *peerAuthenticationProcessor) IsInterfaceNil() bool {
+	return pap == nil
+}
@@ -0,0 +1,261 @@
+package process
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgPeerAuthenticationProcessor() ArgPeerAuthenticationProcessor {
+	return ArgPeerAuthenticationProcessor{
+		Marshalizer:          &mock.MarshalizerMock{},
+		PeerSignatureHandler: &mock.PeerSignatureHandler{Signer: &mock.SinglesignMock{}},
+		SingleSigner:         &mock.SinglesignMock{},
+		KeyGenerator: &mock.KeyGenMock{
+			PublicKeyFromByteArrayMock: func(b []byte) (crypto.PublicKey, error) {
+				return &mock.PublicKeyMock{}, nil
+			},
+		},
+		Cache:                 testscommon.NewCacherMock(),
+		VerifiedPayloadsCache: testscommon.NewCacherMock(),
+		Hasher:                &mock.HasherMock{},
+		AntifloodHandler:      &mock.P2PAntifloodHandlerStub{},
+		HardforkTrigger:       &mock.HardforkTriggerStub{},
+		NetworkShardingCollector: &mock.NetworkShardingCollectorStub{
+			UpdatePeerIdPublicKeyCalled: func(pid core.PeerID, pk []byte) {},
+		},
+		Timer:             mock.NewTimerMock(),
+		MaxTimeDifference: time.Minute,
+	}
+}
+
+func createPeerAuthenticationMessage(t *testing.T, pid core.PeerID, payload *data.Payload) *mock.P2PMessageStub {
+	marshalizer := &mock.MarshalizerMock{}
+	payloadBytes, err := marshalizer.Marshal(payload)
+	require.Nil(t, err)
+
+	peerAuthentication := &data.PeerAuthentication{
+		Pubkey:           []byte("public key"),
+		Signature:        []byte("signed"),
+		Pid:              []byte("pid"),
+		Payload:          payloadBytes,
+		PayloadSignature: []byte("signed"),
+	}
+	buff, err := marshalizer.Marshal(peerAuthentication)
+	require.Nil(t, err)
+
+	return &mock.P2PMessageStub{
+		DataField: buff,
+		PeerField: pid,
+	}
+}
+
+func TestNewPeerAuthenticationProcessor(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationProcessor()
+	arg.PeerSignatureHandler = nil
+	pap, err := NewPeerAuthenticationProcessor(arg)
+	assert.True(t, check.IfNil(pap))
+	assert.Equal(t, heartbeat.ErrNilPeerSignatureHandler, err)
+
+	arg = createMockArgPeerAuthenticationProcessor()
+	arg.SingleSigner = nil
+	pap, err = NewPeerAuthenticationProcessor(arg)
+	assert.True(t, check.IfNil(pap))
+	assert.Equal(t, heartbeat.ErrNilSingleSigner, err)
+
+	arg = createMockArgPeerAuthenticationProcessor()
+	arg.KeyGenerator = nil
+	pap, err = NewPeerAuthenticationProcessor(arg)
+	assert.True(t, check.IfNil(pap))
+	assert.Equal(t, heartbeat.ErrNilKeyGenerator, err)
+
+	arg = createMockArgPeerAuthenticationProcessor()
+	arg.VerifiedPayloadsCache = nil
+	pap, err = NewPeerAuthenticationProcessor(arg)
+	assert.True(t, check.IfNil(pap))
+	assert.True(t, errors.Is(err, heartbeat.ErrNilCacher))
+
+	arg = createMockArgPeerAuthenticationProcessor()
+	arg.Hasher = nil
+	pap, err = NewPeerAuthenticationProcessor(arg)
+	assert.True(t, check.IfNil(pap))
+	assert.Equal(t, heartbeat.ErrNilHasher, err)
+
+	arg = createMockArgPeerAuthenticationProcessor()
+	arg.HardforkTrigger = nil
+	pap, err = NewPeerAuthenticationProcessor(arg)
+	assert.True(t, check.IfNil(pap))
+	assert.Equal(t, heartbeat.ErrNilHardforkTrigger, err)
+
+	arg = createMockArgPeerAuthenticationProcessor()
+	arg.MaxTimeDifference = 0
+	pap, err = NewPeerAuthenticationProcessor(arg)
+	assert.True(t, check.IfNil(pap))
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidTimeDuration))
+
+	pap, err = NewPeerAuthenticationProcessor(createMockArgPeerAuthenticationProcessor())
+	assert.False(t, check.IfNil(pap))
+	assert.Nil(t, err)
+}
+
+func TestPeerAuthenticationProcessor_ProcessReceivedMessageInvalidSignatureShouldBlacklist(t *testing.T) {
+	t.Parallel()
+
+	numBlacklisted := 0
+	arg := createMockArgPeerAuthenticationProcessor()
+	arg.SingleSigner = &mock.SinglesignFailMock{}
+	arg.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		BlacklistPeerCalled: func(peer core.PeerID, reason string, duration time.Duration) {
+			numBlacklisted++
+		},
+	}
+	pap, _ := NewPeerAuthenticationProcessor(arg)
+
+	message := createPeerAuthenticationMessage(t, "pid", &data.Payload{Timestamp: 0})
+	err := pap.ProcessReceivedMessage(message, "connected pid")
+	assert.NotNil(t, err)
+	assert.Equal(t, 2, numBlacklisted)
+	assert.Equal(t, 0, arg.Cache.Len())
+}
+
+func TestPeerAuthenticationProcessor_ProcessReceivedMessagePidMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	numBlacklisted := 0
+	arg := createMockArgPeerAuthenticationProcessor()
+	arg.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		BlacklistPeerCalled: func(peer core.PeerID, reason string, duration time.Duration) {
+			numBlacklisted++
+		},
+	}
+	pap, _ := NewPeerAuthenticationProcessor(arg)
+
+	message := createPeerAuthenticationMessage(t, "other pid", &data.Payload{Timestamp: 0})
+	err := pap.ProcessReceivedMessage(message, "connected pid")
+	assert.True(t, errors.Is(err, heartbeat.ErrHeartbeatPidMismatch))
+	assert.Equal(t, 2, numBlacklisted)
+	assert.Equal(t, 0, arg.Cache.Len())
+}
+
+func TestPeerAuthenticationProcessor_ProcessReceivedMessageHardforkShouldNotCheckThePid(t *testing.T) {
+	t.Parallel()
+
+	triggerReceived := false
+	arg := createMockArgPeerAuthenticationProcessor()
+	arg.HardforkTrigger = &mock.HardforkTriggerStub{
+		TriggerReceivedCalled: func(payload []byte, data []byte, pkBytes []byte) (bool, error) {
+			triggerReceived = true
+			assert.Equal(t, []byte("hardfork"), data)
+			return true, nil
+		},
+	}
+	pap, _ := NewPeerAuthenticationProcessor(arg)
+
+	message := createPeerAuthenticationMessage(t, "relayer pid", &data.Payload{HardforkMessage: []byte("hardfork")})
+	err := pap.ProcessReceivedMessage(message, "connected pid")
+	assert.Nil(t, err)
+	assert.True(t, triggerReceived)
+}
+
+func TestPeerAuthenticationProcessor_ProcessReceivedMessageExpiredShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationProcessor()
+	timer := mock.NewTimerMock()
+	timer.SetSeconds(1000)
+	arg.Timer = timer
+	pap, _ := NewPeerAuthenticationProcessor(arg)
+
+	message := createPeerAuthenticationMessage(t, "pid", &data.Payload{Timestamp: 100})
+	err := pap.ProcessReceivedMessage(message, "connected pid")
+	assert.True(t, errors.Is(err, heartbeat.ErrMessageExpired))
+	assert.Equal(t, 0, arg.Cache.Len())
+}
+
+func TestPeerAuthenticationProcessor_ProcessReceivedMessageShouldWork(t *testing.T) {
+	t.Parallel()
+
+	updatedPublicKey := make([]byte, 0)
+	arg := createMockArgPeerAuthenticationProcessor()
+	arg.NetworkShardingCollector = &mock.NetworkShardingCollectorStub{
+		UpdatePeerIdPublicKeyCalled: func(pid core.PeerID, pk []byte) {
+			assert.Equal(t, core.PeerID("pid"), pid)
+			updatedPublicKey = pk
+		},
+	}
+	pap, _ := NewPeerAuthenticationProcessor(arg)
+
+	message := createPeerAuthenticationMessage(t, "pid", &data.Payload{Timestamp: 10})
+	err := pap.ProcessReceivedMessage(message, "connected pid")
+	require.Nil(t, err)
+	assert.Equal(t, []byte("public key"), updatedPublicKey)
+
+	cached, ok := arg.Cache.Get([]byte("public keypid"))
+	require.True(t, ok)
+	assert.Equal(t, []byte("pid"), cached.(*data.PeerAuthentication).Pid)
+}
+
+func TestPeerAuthenticationProcessor_ProcessReceivedMessageShouldVerifyThePayloadOnce(t *testing.T) {
+	t.Parallel()
+
+	numVerifications := 0
+	arg := createMockArgPeerAuthenticationProcessor()
+	arg.SingleSigner = &mock.SinglesignStub{
+		VerifyCalled: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			numVerifications++
+			return nil
+		},
+	}
+	pap, _ := NewPeerAuthenticationProcessor(arg)
+
+	message := createPeerAuthenticationMessage(t, "pid", &data.Payload{Timestamp: 10})
+	err := pap.ProcessReceivedMessage(message, "connected pid")
+	require.Nil(t, err)
+	err = pap.ProcessReceivedMessage(message, "other connected pid")
+	require.Nil(t, err)
+	assert.Equal(t, 1, numVerifications)
+
+	message = createPeerAuthenticationMessage(t, "pid", &data.Payload{Timestamp: 20})
+	err = pap.ProcessReceivedMessage(message, "connected pid")
+	require.Nil(t, err)
+	assert.Equal(t, 2, numVerifications)
+}
+
+func TestPeerAuthenticationProcessor_ProcessReceivedMessageDifferentPayloadSignatureShouldVerify(t *testing.T) {
+	t.Parallel()
+
+	numBlacklisted := 0
+	arg := createMockArgPeerAuthenticationProcessor()
+	arg.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		BlacklistPeerCalled: func(peer core.PeerID, reason string, duration time.Duration) {
+			numBlacklisted++
+		},
+	}
+	pap, _ := NewPeerAuthenticationProcessor(arg)
+
+	message := createPeerAuthenticationMessage(t, "pid", &data.Payload{Timestamp: 10})
+	err := pap.ProcessReceivedMessage(message, "connected pid")
+	require.Nil(t, err)
+
+	marshalizer := &mock.MarshalizerMock{}
+	peerAuthentication := &data.PeerAuthentication{}
+	_ = marshalizer.Unmarshal(peerAuthentication, message.DataField)
+	peerAuthentication.PayloadSignature = []byte("forged")
+	message.DataField, _ = marshalizer.Marshal(peerAuthentication)
+
+	err = pap.ProcessReceivedMessage(message, "connected pid")
+	assert.Equal(t, crypto.ErrSigNotValid, err)
+	assert.Equal(t, 2, numBlacklisted)
+}
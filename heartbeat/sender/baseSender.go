@@ -0,0 +1,88 @@
+package sender
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// ArgBaseSender represents the arguments common to all the senders
+type ArgBaseSender struct {
+	Messenger                 heartbeat.P2PMessenger
+	Marshalizer               marshal.Marshalizer
+	Topic                     string
+	TimeBetweenSends          time.Duration
+	TimeBetweenSendsThreshold time.Duration
+}
+
+// baseSender holds the timer and the broadcasting components shared by the senders. The time between two sends is
+// randomized, up to the provided threshold, so the peers do not send their messages at the same time
+type baseSender struct {
+	timer                     *time.Timer
+	messenger                 heartbeat.P2PMessenger
+	marshalizer               marshal.Marshalizer
+	topic                     string
+	timeBetweenSends          time.Duration
+	timeBetweenSendsThreshold time.Duration
+	random                    *rand.Rand
+}
+
+func checkBaseSenderArgs(args ArgBaseSender) error {
+	if check.IfNil(args.Messenger) {
+		return heartbeat.ErrNilMessenger
+	}
+	if check.IfNil(args.Marshalizer) {
+		return heartbeat.ErrNilMarshalizer
+	}
+	if args.TimeBetweenSends < time.Second {
+		return fmt.Errorf("%w for TimeBetweenSends", heartbeat.ErrInvalidTimeDuration)
+	}
+	if args.TimeBetweenSendsThreshold < 0 {
+		return fmt.Errorf("%w for TimeBetweenSendsThreshold", heartbeat.ErrInvalidTimeDuration)
+	}
+
+	return nil
+}
+
+// createBaseSender creates a base sender which is ready to send its first message
+func createBaseSender(args ArgBaseSender) baseSender {
+	return baseSender{
+		timer:                     time.NewTimer(0),
+		messenger:                 args.Messenger,
+		marshalizer:               args.Marshalizer,
+		topic:                     args.Topic,
+		timeBetweenSends:          args.TimeBetweenSends,
+		timeBetweenSendsThreshold: args.TimeBetweenSendsThreshold,
+		random:                    rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// ExecutionReadyChannel returns the channel signaling that the next message should be sent
+func (bs *baseSender) ExecutionReadyChannel() <-chan time.Time {
+	return bs.timer.C
+}
+
+// Close stops the timer
+func (bs *baseSender) Close() {
+	bs.timer.Stop()
+}
+
+func (bs *baseSender) scheduleNextExecution() {
+	duration := bs.timeBetweenSends
+	if bs.timeBetweenSendsThreshold > 0 {
+		duration += time.Duration(bs.random.Int63n(int64(bs.timeBetweenSendsThreshold)))
+	}
+
+	// the execution might not have been triggered by the timer, so it is stopped and drained before being reset
+	if !bs.timer.Stop() {
+		select {
+		case <-bs.timer.C:
+		default:
+		}
+	}
+	bs.timer.Reset(duration)
+}
@@ -0,0 +1,138 @@
+package sender
+
+import (
+	"bytes"
+	"sort"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ArgHeartbeatSender represents the arguments for the heartbeat sender
+type ArgHeartbeatSender struct {
+	ArgBaseSender
+	VersionNumber        string
+	NodeDisplayName      string
+	Identity             string
+	ShardCoordinator     sharding.Coordinator
+	CurrentBlockProvider heartbeat.CurrentBlockProvider
+	NodeMetricsProvider  heartbeat.NodeMetricsProvider
+	ManagedPeersHolder   heartbeat.ManagedPeersHolder
+}
+
+// heartbeatSender periodically broadcasts the heartbeat message of the node. It is used by all the nodes. The nodes
+// running in multikey mode also list the validator keys they manage
+type heartbeatSender struct {
+	baseSender
+	versionNumber        string
+	nodeDisplayName      string
+	identity             string
+	shardCoordinator     sharding.Coordinator
+	currentBlockProvider heartbeat.CurrentBlockProvider
+	nodeMetricsProvider  heartbeat.NodeMetricsProvider
+	managedPeersHolder   heartbeat.ManagedPeersHolder
+}
+
+// NewHeartbeatSender creates a new heartbeat sender
+func NewHeartbeatSender(args ArgHeartbeatSender) (*heartbeatSender, error) {
+	err := checkBaseSenderArgs(args.ArgBaseSender)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, heartbeat.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.CurrentBlockProvider) {
+		return nil, heartbeat.ErrNilCurrentBlockProvider
+	}
+	if check.IfNil(args.NodeMetricsProvider) {
+		return nil, heartbeat.ErrNilNodeMetricsProvider
+	}
+	if check.IfNil(args.ManagedPeersHolder) {
+		return nil, heartbeat.ErrNilManagedPeersHolder
+	}
+	err = process.VerifyHeartbeatProperyLen("application version string", []byte(args.VersionNumber))
+	if err != nil {
+		return nil, err
+	}
+
+	return &heartbeatSender{
+		baseSender:           createBaseSender(args.ArgBaseSender),
+		versionNumber:        args.VersionNumber,
+		nodeDisplayName:      process.TrimHeartbeatProperty(args.NodeDisplayName),
+		identity:             process.TrimHeartbeatProperty(args.Identity),
+		shardCoordinator:     args.ShardCoordinator,
+		currentBlockProvider: args.CurrentBlockProvider,
+		nodeMetricsProvider:  args.NodeMetricsProvider,
+		managedPeersHolder:   args.ManagedPeersHolder,
+	}, nil
+}
+
+// Execute broadcasts the heartbeat message and schedules the next one
+func (sender *heartbeatSender) Execute() {
+	err := sender.execute()
+	if err != nil {
+		log.Debug("heartbeat sender: execute", "error", err.Error())
+	}
+
+	sender.scheduleNextExecution()
+}
+
+func (sender *heartbeatSender) execute() error {
+	payloadBytes, err := sender.marshalizer.Marshal(&heartbeatData.Payload{Timestamp: time.Now().Unix()})
+	if err != nil {
+		return err
+	}
+
+	nonce := uint64(0)
+	currentBlock := sender.currentBlockProvider.GetCurrentBlockHeader()
+	if !check.IfNil(currentBlock) {
+		nonce = currentBlock.GetNonce()
+	}
+
+	hb := &heartbeatData.HeartbeatV2{
+		Payload:                  payloadBytes,
+		VersionNumber:            sender.versionNumber,
+		NodeDisplayName:          sender.nodeDisplayName,
+		Identity:                 sender.identity,
+		Nonce:                    nonce,
+		ShardID:                  sender.shardCoordinator.SelfId(),
+		DiskFreeBytes:            sender.nodeMetricsProvider.DiskFreeBytes(),
+		SyncDistance:             sender.nodeMetricsProvider.SyncDistance(),
+		IsTrieSnapshotInProgress: sender.nodeMetricsProvider.IsTrieSnapshotInProgress(),
+		ConnectedPeersPerShard:   sender.nodeMetricsProvider.ConnectedPeersPerShard(),
+		ManagedKeys:              sender.getManagedKeys(),
+	}
+	buffToSend, err := sender.marshalizer.Marshal(hb)
+	if err != nil {
+		return err
+	}
+
+	sender.messenger.Broadcast(sender.topic, buffToSend)
+
+	return nil
+}
+
+// getManagedKeys returns the sorted public keys managed by the node, trimmed to the number accepted by the network
+func (sender *heartbeatSender) getManagedKeys() [][]byte {
+	managedKeysMap := sender.managedPeersHolder.GetManagedKeysByCurrentNode()
+	managedKeys := make([][]byte, 0, len(managedKeysMap))
+	for pubKey := range managedKeysMap {
+		managedKeys = append(managedKeys, []byte(pubKey))
+	}
+
+	sort.Slice(managedKeys, func(i, j int) bool {
+		return bytes.Compare(managedKeys[i], managedKeys[j]) < 0
+	})
+
+	return process.TrimManagedKeys(managedKeys)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sender *heartbeatSender) IsInterfaceNil() bool {
+	return sender == nil
+}
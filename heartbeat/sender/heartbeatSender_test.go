@@ -0,0 +1,228 @@
+package sender
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgBaseSender() ArgBaseSender {
+	return ArgBaseSender{
+		Messenger:                 &mock.MessengerStub{},
+		Marshalizer:               &mock.MarshalizerMock{},
+		Topic:                     "topic",
+		TimeBetweenSends:          time.Second,
+		TimeBetweenSendsThreshold: time.Second,
+	}
+}
+
+func createMockArgHeartbeatSender() ArgHeartbeatSender {
+	return ArgHeartbeatSender{
+		ArgBaseSender:        createMockArgBaseSender(),
+		VersionNumber:        "v1.0.0",
+		NodeDisplayName:      "node",
+		Identity:             "identity",
+		ShardCoordinator:     &mock.ShardCoordinatorMock{SelfShardId: 1},
+		CurrentBlockProvider: &mock.CurrentBlockProviderStub{},
+		NodeMetricsProvider:  &mock.NodeMetricsProviderStub{},
+		ManagedPeersHolder:   &testscommon.ManagedPeersHolderStub{},
+	}
+}
+
+func TestNewHeartbeatSender(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatSender()
+	arg.Messenger = nil
+	sender, err := NewHeartbeatSender(arg)
+	assert.True(t, check.IfNil(sender))
+	assert.Equal(t, heartbeat.ErrNilMessenger, err)
+
+	arg = createMockArgHeartbeatSender()
+	arg.Marshalizer = nil
+	sender, err = NewHeartbeatSender(arg)
+	assert.True(t, check.IfNil(sender))
+	assert.Equal(t, heartbeat.ErrNilMarshalizer, err)
+
+	arg = createMockArgHeartbeatSender()
+	arg.TimeBetweenSends = time.Second - time.Nanosecond
+	sender, err = NewHeartbeatSender(arg)
+	assert.True(t, check.IfNil(sender))
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidTimeDuration))
+
+	arg = createMockArgHeartbeatSender()
+	arg.TimeBetweenSendsThreshold = -1
+	sender, err = NewHeartbeatSender(arg)
+	assert.True(t, check.IfNil(sender))
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidTimeDuration))
+
+	arg = createMockArgHeartbeatSender()
+	arg.ShardCoordinator = nil
+	sender, err = NewHeartbeatSender(arg)
+	assert.True(t, check.IfNil(sender))
+	assert.Equal(t, heartbeat.ErrNilShardCoordinator, err)
+
+	arg = createMockArgHeartbeatSender()
+	arg.CurrentBlockProvider = nil
+	sender, err = NewHeartbeatSender(arg)
+	assert.True(t, check.IfNil(sender))
+	assert.Equal(t, heartbeat.ErrNilCurrentBlockProvider, err)
+
+	arg = createMockArgHeartbeatSender()
+	arg.NodeMetricsProvider = nil
+	sender, err = NewHeartbeatSender(arg)
+	assert.True(t, check.IfNil(sender))
+	assert.Equal(t, heartbeat.ErrNilNodeMetricsProvider, err)
+
+	arg = createMockArgHeartbeatSender()
+	arg.ManagedPeersHolder = nil
+	sender, err = NewHeartbeatSender(arg)
+	assert.True(t, check.IfNil(sender))
+	assert.Equal(t, heartbeat.ErrNilManagedPeersHolder, err)
+
+	arg = createMockArgHeartbeatSender()
+	arg.VersionNumber = strings.Repeat("v", 129)
+	sender, err = NewHeartbeatSender(arg)
+	assert.True(t, check.IfNil(sender))
+	assert.True(t, errors.Is(err, heartbeat.ErrPropertyTooLong))
+
+	arg = createMockArgHeartbeatSender()
+	arg.NodeDisplayName = strings.Repeat("n", 129)
+	sender, err = NewHeartbeatSender(arg)
+	require.Nil(t, err)
+	assert.False(t, check.IfNil(sender))
+	assert.Equal(t, 128, len(sender.nodeDisplayName))
+}
+
+func TestHeartbeatSender_ExecuteShouldBroadcast(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	arg := createMockArgHeartbeatSender()
+	arg.CurrentBlockProvider = &mock.CurrentBlockProviderStub{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: 37}
+		},
+	}
+	broadcastTopic := ""
+	hb := &heartbeatData.HeartbeatV2{}
+	arg.Messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			broadcastTopic = topic
+			_ = marshalizer.Unmarshal(hb, buff)
+		},
+	}
+	sender, _ := NewHeartbeatSender(arg)
+
+	sender.Execute()
+
+	assert.Equal(t, "topic", broadcastTopic)
+	assert.Equal(t, "v1.0.0", hb.VersionNumber)
+	assert.Equal(t, "node", hb.NodeDisplayName)
+	assert.Equal(t, "identity", hb.Identity)
+	assert.Equal(t, uint64(37), hb.Nonce)
+	assert.Equal(t, uint32(1), hb.ShardID)
+
+	payload := &heartbeatData.Payload{}
+	err := marshalizer.Unmarshal(payload, hb.Payload)
+	require.Nil(t, err)
+	assert.True(t, payload.Timestamp > 0)
+}
+
+func TestHeartbeatSender_ExecuteShouldIncludeTheNodeMetrics(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	arg := createMockArgHeartbeatSender()
+	arg.NodeMetricsProvider = &mock.NodeMetricsProviderStub{
+		DiskFreeBytesCalled: func() uint64 {
+			return 1024
+		},
+		SyncDistanceCalled: func() uint64 {
+			return 5
+		},
+		IsTrieSnapshotInProgressCalled: func() bool {
+			return true
+		},
+		ConnectedPeersPerShardCalled: func() map[uint32]uint32 {
+			return map[uint32]uint32{0: 3, core.MetachainShardId: 2}
+		},
+	}
+	hb := &heartbeatData.HeartbeatV2{}
+	arg.Messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			_ = marshalizer.Unmarshal(hb, buff)
+		},
+	}
+	sender, _ := NewHeartbeatSender(arg)
+
+	sender.Execute()
+
+	assert.Equal(t, uint64(1024), hb.DiskFreeBytes)
+	assert.Equal(t, uint64(5), hb.SyncDistance)
+	assert.True(t, hb.IsTrieSnapshotInProgress)
+	assert.Equal(t, map[uint32]uint32{0: 3, core.MetachainShardId: 2}, hb.ConnectedPeersPerShard)
+}
+
+func TestHeartbeatSender_ExecuteShouldIncludeTheSortedManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	arg := createMockArgHeartbeatSender()
+	arg.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+		GetManagedKeysByCurrentNodeCalled: func() map[string]crypto.PrivateKey {
+			return map[string]crypto.PrivateKey{
+				"pk3": &mock.PrivateKeyStub{},
+				"pk1": &mock.PrivateKeyStub{},
+				"pk2": &mock.PrivateKeyStub{},
+			}
+		},
+	}
+	hb := &heartbeatData.HeartbeatV2{}
+	arg.Messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			_ = marshalizer.Unmarshal(hb, buff)
+		},
+	}
+	sender, _ := NewHeartbeatSender(arg)
+
+	sender.Execute()
+
+	assert.Equal(t, [][]byte{[]byte("pk1"), []byte("pk2"), []byte("pk3")}, hb.ManagedKeys)
+}
+
+func TestHeartbeatSender_ExecuteShouldScheduleTheNextExecution(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatSender()
+	arg.TimeBetweenSendsThreshold = 0
+	sender, _ := NewHeartbeatSender(arg)
+	defer sender.Close()
+
+	select {
+	case <-sender.ExecutionReadyChannel():
+	case <-time.After(time.Second):
+		assert.Fail(t, "the first execution should be ready")
+	}
+
+	sender.Execute()
+
+	select {
+	case <-sender.ExecutionReadyChannel():
+		assert.Fail(t, "the next execution should not be ready yet")
+	case <-time.After(time.Millisecond * 100):
+	}
+}
@@ -0,0 +1,111 @@
+package sender
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/statistics/machine"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+)
+
+// ArgNodeMetricsProvider represents the arguments for the node metrics provider
+type ArgNodeMetricsProvider struct {
+	WorkingDir           string
+	CurrentBlockProvider heartbeat.CurrentBlockProvider
+	ForkDetector         heartbeat.ForkDetector
+	Accounts             heartbeat.SnapshotInProgressChecker
+	Messenger            heartbeat.ConnectedPeersProvider
+	PeerShardResolver    heartbeat.PeerShardResolver
+}
+
+// nodeMetricsProvider computes the resource and storage metrics the node includes in its heartbeat messages, so the
+// degrading nodes can be spotted before they miss consensus
+type nodeMetricsProvider struct {
+	workingDir           string
+	currentBlockProvider heartbeat.CurrentBlockProvider
+	forkDetector         heartbeat.ForkDetector
+	accounts             heartbeat.SnapshotInProgressChecker
+	messenger            heartbeat.ConnectedPeersProvider
+	peerShardResolver    heartbeat.PeerShardResolver
+}
+
+// NewNodeMetricsProvider creates a new node metrics provider
+func NewNodeMetricsProvider(args ArgNodeMetricsProvider) (*nodeMetricsProvider, error) {
+	if check.IfNil(args.CurrentBlockProvider) {
+		return nil, heartbeat.ErrNilCurrentBlockProvider
+	}
+	if check.IfNil(args.ForkDetector) {
+		return nil, heartbeat.ErrNilForkDetector
+	}
+	if check.IfNil(args.Accounts) {
+		return nil, heartbeat.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.Messenger) {
+		return nil, heartbeat.ErrNilMessenger
+	}
+	if check.IfNil(args.PeerShardResolver) {
+		return nil, heartbeat.ErrNilPeerShardResolver
+	}
+
+	return &nodeMetricsProvider{
+		workingDir:           args.WorkingDir,
+		currentBlockProvider: args.CurrentBlockProvider,
+		forkDetector:         args.ForkDetector,
+		accounts:             args.Accounts,
+		messenger:            args.Messenger,
+		peerShardResolver:    args.PeerShardResolver,
+	}, nil
+}
+
+// DiskFreeBytes returns the number of bytes available on the disk holding the working directory. It returns 0 if the
+// free space could not be computed
+func (provider *nodeMetricsProvider) DiskFreeBytes() uint64 {
+	freeBytes, err := machine.GetDiskFreeBytes(provider.workingDir)
+	if err != nil {
+		log.Debug("node metrics provider: disk free bytes", "working dir", provider.workingDir, "error", err.Error())
+		return 0
+	}
+
+	return freeBytes
+}
+
+// SyncDistance returns the number of blocks the node is behind the network
+func (provider *nodeMetricsProvider) SyncDistance() uint64 {
+	nonce := uint64(0)
+	currentBlock := provider.currentBlockProvider.GetCurrentBlockHeader()
+	if !check.IfNil(currentBlock) {
+		nonce = currentBlock.GetNonce()
+	}
+
+	probableHighestNonce := provider.forkDetector.ProbableHighestNonce()
+	if probableHighestNonce <= nonce {
+		return 0
+	}
+
+	return probableHighestNonce - nonce
+}
+
+// IsTrieSnapshotInProgress returns true if a snapshot of the accounts trie is in progress
+func (provider *nodeMetricsProvider) IsTrieSnapshotInProgress() bool {
+	return provider.accounts.IsSnapshotInProgress()
+}
+
+// ConnectedPeersPerShard returns the number of connected peers in each shard. The peers with an unknown shard are not
+// counted
+func (provider *nodeMetricsProvider) ConnectedPeersPerShard() map[uint32]uint32 {
+	connectedPeersPerShard := make(map[uint32]uint32)
+	for _, pid := range provider.messenger.ConnectedPeers() {
+		peerInfo := provider.peerShardResolver.GetPeerInfo(pid)
+		if peerInfo.PeerType == core.UnknownPeer {
+			continue
+		}
+
+		connectedPeersPerShard[peerInfo.ShardID]++
+	}
+
+	return connectedPeersPerShard
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (provider *nodeMetricsProvider) IsInterfaceNil() bool {
+	return provider == nil
+}
@@ -0,0 +1,141 @@
+package sender
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgNodeMetricsProvider() ArgNodeMetricsProvider {
+	return ArgNodeMetricsProvider{
+		WorkingDir:           os.TempDir(),
+		CurrentBlockProvider: &mock.CurrentBlockProviderStub{},
+		ForkDetector:         &mock.ForkDetectorStub{},
+		Accounts:             &mock.SnapshotInProgressCheckerStub{},
+		Messenger:            &mock.MessengerStub{},
+		PeerShardResolver:    &mock.NetworkShardingCollectorStub{},
+	}
+}
+
+func TestNewNodeMetricsProvider(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgNodeMetricsProvider()
+	arg.CurrentBlockProvider = nil
+	provider, err := NewNodeMetricsProvider(arg)
+	assert.True(t, check.IfNil(provider))
+	assert.Equal(t, heartbeat.ErrNilCurrentBlockProvider, err)
+
+	arg = createMockArgNodeMetricsProvider()
+	arg.ForkDetector = nil
+	provider, err = NewNodeMetricsProvider(arg)
+	assert.True(t, check.IfNil(provider))
+	assert.Equal(t, heartbeat.ErrNilForkDetector, err)
+
+	arg = createMockArgNodeMetricsProvider()
+	arg.Accounts = nil
+	provider, err = NewNodeMetricsProvider(arg)
+	assert.True(t, check.IfNil(provider))
+	assert.Equal(t, heartbeat.ErrNilAccountsAdapter, err)
+
+	arg = createMockArgNodeMetricsProvider()
+	arg.Messenger = nil
+	provider, err = NewNodeMetricsProvider(arg)
+	assert.True(t, check.IfNil(provider))
+	assert.Equal(t, heartbeat.ErrNilMessenger, err)
+
+	arg = createMockArgNodeMetricsProvider()
+	arg.PeerShardResolver = nil
+	provider, err = NewNodeMetricsProvider(arg)
+	assert.True(t, check.IfNil(provider))
+	assert.Equal(t, heartbeat.ErrNilPeerShardResolver, err)
+
+	arg = createMockArgNodeMetricsProvider()
+	provider, err = NewNodeMetricsProvider(arg)
+	assert.False(t, check.IfNil(provider))
+	assert.Nil(t, err)
+}
+
+func TestNodeMetricsProvider_DiskFreeBytes(t *testing.T) {
+	t.Parallel()
+
+	provider, _ := NewNodeMetricsProvider(createMockArgNodeMetricsProvider())
+	assert.True(t, provider.DiskFreeBytes() > 0)
+
+	arg := createMockArgNodeMetricsProvider()
+	arg.WorkingDir = "/missing/directory"
+	provider, _ = NewNodeMetricsProvider(arg)
+	assert.Equal(t, uint64(0), provider.DiskFreeBytes())
+}
+
+func TestNodeMetricsProvider_SyncDistance(t *testing.T) {
+	t.Parallel()
+
+	probableHighestNonce := uint64(0)
+	arg := createMockArgNodeMetricsProvider()
+	arg.ForkDetector = &mock.ForkDetectorStub{
+		ProbableHighestNonceCalled: func() uint64 {
+			return probableHighestNonce
+		},
+	}
+	provider, _ := NewNodeMetricsProvider(arg)
+
+	probableHighestNonce = 10
+	assert.Equal(t, uint64(10), provider.SyncDistance())
+
+	provider.currentBlockProvider = &mock.CurrentBlockProviderStub{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: 7}
+		},
+	}
+	assert.Equal(t, uint64(3), provider.SyncDistance())
+
+	probableHighestNonce = 5
+	assert.Equal(t, uint64(0), provider.SyncDistance())
+}
+
+func TestNodeMetricsProvider_IsTrieSnapshotInProgress(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgNodeMetricsProvider()
+	arg.Accounts = &mock.SnapshotInProgressCheckerStub{
+		IsSnapshotInProgressCalled: func() bool {
+			return true
+		},
+	}
+	provider, _ := NewNodeMetricsProvider(arg)
+
+	assert.True(t, provider.IsTrieSnapshotInProgress())
+}
+
+func TestNodeMetricsProvider_ConnectedPeersPerShardShouldSkipTheUnknownPeers(t *testing.T) {
+	t.Parallel()
+
+	peersInfo := map[core.PeerID]core.P2PPeerInfo{
+		"pid0": {PeerType: core.ValidatorPeer, ShardID: 0},
+		"pid1": {PeerType: core.ObserverPeer, ShardID: 0},
+		"pid2": {PeerType: core.ObserverPeer, ShardID: core.MetachainShardId},
+		"pid3": {PeerType: core.UnknownPeer},
+	}
+	arg := createMockArgNodeMetricsProvider()
+	arg.Messenger = &mock.MessengerStub{
+		ConnectedPeersCalled: func() []core.PeerID {
+			return []core.PeerID{"pid0", "pid1", "pid2", "pid3"}
+		},
+	}
+	arg.PeerShardResolver = &mock.NetworkShardingCollectorStub{
+		GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+			return peersInfo[pid]
+		},
+	}
+	provider, _ := NewNodeMetricsProvider(arg)
+
+	assert.Equal(t, map[uint32]uint32{0: 2, core.MetachainShardId: 1}, provider.ConnectedPeersPerShard())
+}
@@ -0,0 +1,192 @@
+package sender
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+)
+
+// ArgPeerAuthenticationSender represents the arguments for the peer authentication sender
+type ArgPeerAuthenticationSender struct {
+	ArgBaseSender
+	PeerSignatureHandler crypto.PeerSignatureHandler
+	SingleSigner         crypto.SingleSigner
+	PrivKey              crypto.PrivateKey
+	PeerTypeProvider     heartbeat.PeerTypeProviderHandler
+	StatusHandler        core.AppStatusHandler
+	HardforkTrigger      heartbeat.HardforkTrigger
+	ManagedPeersHolder   heartbeat.ManagedPeersHolder
+}
+
+// peerAuthenticationSender periodically broadcasts the messages binding the validator keys held by the node to the
+// node's peer ID. The observers do not authenticate their key, unless they have to send the hardfork trigger message
+type peerAuthenticationSender struct {
+	baseSender
+	peerSignatureHandler crypto.PeerSignatureHandler
+	singleSigner         crypto.SingleSigner
+	privKey              crypto.PrivateKey
+	publicKey            []byte
+	peerTypeProvider     heartbeat.PeerTypeProviderHandler
+	statusHandler        core.AppStatusHandler
+	hardforkTrigger      heartbeat.HardforkTrigger
+	managedPeersHolder   heartbeat.ManagedPeersHolder
+}
+
+// NewPeerAuthenticationSender creates a new peer authentication sender
+func NewPeerAuthenticationSender(args ArgPeerAuthenticationSender) (*peerAuthenticationSender, error) {
+	err := checkBaseSenderArgs(args.ArgBaseSender)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(args.PeerSignatureHandler) {
+		return nil, heartbeat.ErrNilPeerSignatureHandler
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, heartbeat.ErrNilSingleSigner
+	}
+	if check.IfNil(args.PrivKey) {
+		return nil, heartbeat.ErrNilPrivateKey
+	}
+	if check.IfNil(args.PeerTypeProvider) {
+		return nil, heartbeat.ErrNilPeerTypeProvider
+	}
+	if check.IfNil(args.StatusHandler) {
+		return nil, heartbeat.ErrNilAppStatusHandler
+	}
+	if check.IfNil(args.HardforkTrigger) {
+		return nil, heartbeat.ErrNilHardforkTrigger
+	}
+	if check.IfNil(args.ManagedPeersHolder) {
+		return nil, heartbeat.ErrNilManagedPeersHolder
+	}
+
+	publicKey, err := args.PrivKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return &peerAuthenticationSender{
+		baseSender:           createBaseSender(args.ArgBaseSender),
+		peerSignatureHandler: args.PeerSignatureHandler,
+		singleSigner:         args.SingleSigner,
+		privKey:              args.PrivKey,
+		publicKey:            publicKey,
+		peerTypeProvider:     args.PeerTypeProvider,
+		statusHandler:        args.StatusHandler,
+		hardforkTrigger:      args.HardforkTrigger,
+		managedPeersHolder:   args.ManagedPeersHolder,
+	}, nil
+}
+
+// Execute broadcasts the peer authentication messages and schedules the next ones
+func (sender *peerAuthenticationSender) Execute() {
+	err := sender.execute()
+	if err != nil {
+		log.Debug("peer authentication sender: execute", "error", err.Error())
+	}
+
+	sender.scheduleNextExecution()
+}
+
+func (sender *peerAuthenticationSender) execute() error {
+	payload := &heartbeatData.Payload{
+		Timestamp: time.Now().Unix(),
+	}
+
+	triggerMessage, isHardforkTriggered := sender.hardforkTrigger.RecordedTriggerMessage()
+	if isHardforkTriggered {
+		isPayloadRecorded := len(triggerMessage) != 0
+		if isPayloadRecorded {
+			//beside sending the regular peer authentication message, send also the initial hardfork trigger message
+			// so that will be spread in an epidemic manner
+			log.Debug("broadcasting stored hardfork message")
+			sender.messenger.Broadcast(sender.topic, triggerMessage)
+		} else {
+			payload.HardforkMessage = sender.hardforkTrigger.CreateData()
+		}
+	}
+
+	payloadBytes, err := sender.marshalizer.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	isValidator := sender.updateMetrics()
+	if isValidator || len(payload.HardforkMessage) > 0 {
+		err = sender.signAndBroadcast(sender.publicKey, sender.privKey, payloadBytes)
+		if err != nil {
+			return err
+		}
+	}
+
+	for pubKey, privKey := range sender.managedPeersHolder.GetManagedKeysByCurrentNode() {
+		if pubKey == string(sender.publicKey) {
+			continue
+		}
+
+		err = sender.signAndBroadcast([]byte(pubKey), privKey, payloadBytes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (sender *peerAuthenticationSender) signAndBroadcast(pubKey []byte, privKey crypto.PrivateKey, payload []byte) error {
+	pid := sender.messenger.ID().Bytes()
+	signature, err := sender.peerSignatureHandler.GetPeerSignature(privKey, pid)
+	if err != nil {
+		return err
+	}
+
+	payloadSignature, err := sender.singleSigner.Sign(privKey, payload)
+	if err != nil {
+		return err
+	}
+
+	peerAuthentication := &heartbeatData.PeerAuthentication{
+		Pubkey:           pubKey,
+		Signature:        signature,
+		Pid:              pid,
+		Payload:          payload,
+		PayloadSignature: payloadSignature,
+	}
+	buffToSend, err := sender.marshalizer.Marshal(peerAuthentication)
+	if err != nil {
+		return err
+	}
+
+	sender.messenger.Broadcast(sender.topic, buffToSend)
+
+	return nil
+}
+
+// updateMetrics sets the node type and the peer type metrics, returning true if the node's own key is a validator
+func (sender *peerAuthenticationSender) updateMetrics() bool {
+	peerType, _, err := sender.peerTypeProvider.ComputeForPubKey(sender.publicKey)
+	if err != nil {
+		log.Warn("peer authentication sender: compute peer type", "error", err)
+		peerType = core.ObserverList
+	}
+
+	nodeType := core.NodeTypeValidator
+	isObserver := peerType == core.ObserverList
+	if isObserver {
+		nodeType = core.NodeTypeObserver
+	}
+
+	sender.statusHandler.SetStringValue(core.MetricNodeType, string(nodeType))
+	sender.statusHandler.SetStringValue(core.MetricPeerType, string(peerType))
+
+	return !isObserver
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sender *peerAuthenticationSender) IsInterfaceNil() bool {
+	return sender == nil
+}
@@ -0,0 +1,248 @@
+package sender
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockPrivateKey(publicKey string) crypto.PrivateKey {
+	return &mock.PrivateKeyStub{
+		GeneratePublicHandler: func() crypto.PublicKey {
+			return &mock.PublicKeyMock{
+				ToByteArrayHandler: func() ([]byte, error) {
+					return []byte(publicKey), nil
+				},
+			}
+		},
+	}
+}
+
+func createMockArgPeerAuthenticationSender() ArgPeerAuthenticationSender {
+	return ArgPeerAuthenticationSender{
+		ArgBaseSender:        createMockArgBaseSender(),
+		PeerSignatureHandler: &mock.PeerSignatureHandler{Signer: &mock.SinglesignMock{}},
+		SingleSigner:         &mock.SinglesignMock{},
+		PrivKey:              createMockPrivateKey("public key"),
+		PeerTypeProvider: &mock.PeerTypeProviderStub{
+			ComputeForPubKeyCalled: func(pubKey []byte) (core.PeerType, uint32, error) {
+				return core.EligibleList, 0, nil
+			},
+		},
+		StatusHandler:      &mock.AppStatusHandlerStub{},
+		HardforkTrigger:    &mock.HardforkTriggerStub{},
+		ManagedPeersHolder: &testscommon.ManagedPeersHolderStub{},
+	}
+}
+
+func TestNewPeerAuthenticationSender(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationSender()
+	arg.Messenger = nil
+	sender, err := NewPeerAuthenticationSender(arg)
+	assert.True(t, check.IfNil(sender))
+	assert.Equal(t, heartbeat.ErrNilMessenger, err)
+
+	arg = createMockArgPeerAuthenticationSender()
+	arg.PeerSignatureHandler = nil
+	sender, err = NewPeerAuthenticationSender(arg)
+	assert.True(t, check.IfNil(sender))
+	assert.Equal(t, heartbeat.ErrNilPeerSignatureHandler, err)
+
+	arg = createMockArgPeerAuthenticationSender()
+	arg.SingleSigner = nil
+	sender, err = NewPeerAuthenticationSender(arg)
+	assert.True(t, check.IfNil(sender))
+	assert.Equal(t, heartbeat.ErrNilSingleSigner, err)
+
+	arg = createMockArgPeerAuthenticationSender()
+	arg.PrivKey = nil
+	sender, err = NewPeerAuthenticationSender(arg)
+	assert.True(t, check.IfNil(sender))
+	assert.Equal(t, heartbeat.ErrNilPrivateKey, err)
+
+	arg = createMockArgPeerAuthenticationSender()
+	arg.PeerTypeProvider = nil
+	sender, err = NewPeerAuthenticationSender(arg)
+	assert.True(t, check.IfNil(sender))
+	assert.Equal(t, heartbeat.ErrNilPeerTypeProvider, err)
+
+	arg = createMockArgPeerAuthenticationSender()
+	arg.StatusHandler = nil
+	sender, err = NewPeerAuthenticationSender(arg)
+	assert.True(t, check.IfNil(sender))
+	assert.Equal(t, heartbeat.ErrNilAppStatusHandler, err)
+
+	arg = createMockArgPeerAuthenticationSender()
+	arg.HardforkTrigger = nil
+	sender, err = NewPeerAuthenticationSender(arg)
+	assert.True(t, check.IfNil(sender))
+	assert.Equal(t, heartbeat.ErrNilHardforkTrigger, err)
+
+	arg = createMockArgPeerAuthenticationSender()
+	arg.ManagedPeersHolder = nil
+	sender, err = NewPeerAuthenticationSender(arg)
+	assert.True(t, check.IfNil(sender))
+	assert.Equal(t, heartbeat.ErrNilManagedPeersHolder, err)
+
+	sender, err = NewPeerAuthenticationSender(createMockArgPeerAuthenticationSender())
+	assert.False(t, check.IfNil(sender))
+	assert.Nil(t, err)
+}
+
+func TestPeerAuthenticationSender_ExecuteValidatorShouldBroadcast(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	arg := createMockArgPeerAuthenticationSender()
+	arg.Messenger = &mock.MessengerStub{
+		IDCalled: func() core.PeerID {
+			return "pid"
+		},
+		BroadcastCalled: func(topic string, buff []byte) {
+			peerAuthentication := &heartbeatData.PeerAuthentication{}
+			err := marshalizer.Unmarshal(peerAuthentication, buff)
+			require.Nil(t, err)
+			assert.Equal(t, []byte("public key"), peerAuthentication.Pubkey)
+			assert.Equal(t, []byte("pid"), peerAuthentication.Pid)
+			assert.Equal(t, []byte("signed"), peerAuthentication.Signature)
+			assert.Equal(t, []byte("signed"), peerAuthentication.PayloadSignature)
+		},
+	}
+	metrics := make(map[string]string)
+	arg.StatusHandler = &mock.AppStatusHandlerStub{
+		SetStringValueHandler: func(key string, value string) {
+			metrics[key] = value
+		},
+	}
+	sender, _ := NewPeerAuthenticationSender(arg)
+
+	err := sender.execute()
+	assert.Nil(t, err)
+	assert.Equal(t, string(core.NodeTypeValidator), metrics[core.MetricNodeType])
+	assert.Equal(t, string(core.EligibleList), metrics[core.MetricPeerType])
+}
+
+func TestPeerAuthenticationSender_ExecuteObserverShouldNotBroadcast(t *testing.T) {
+	t.Parallel()
+
+	numBroadcasts := 0
+	arg := createMockArgPeerAuthenticationSender()
+	arg.Messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			numBroadcasts++
+		},
+	}
+	arg.PeerTypeProvider = &mock.PeerTypeProviderStub{
+		ComputeForPubKeyCalled: func(pubKey []byte) (core.PeerType, uint32, error) {
+			return "", 0, errors.New("not found")
+		},
+	}
+	sender, _ := NewPeerAuthenticationSender(arg)
+
+	err := sender.execute()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, numBroadcasts)
+}
+
+func TestPeerAuthenticationSender_ExecuteShouldBroadcastTheManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	broadcastPubKeys := make(map[string]struct{})
+	arg := createMockArgPeerAuthenticationSender()
+	arg.Messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			peerAuthentication := &heartbeatData.PeerAuthentication{}
+			_ = marshalizer.Unmarshal(peerAuthentication, buff)
+			broadcastPubKeys[string(peerAuthentication.Pubkey)] = struct{}{}
+		},
+	}
+	arg.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+		GetManagedKeysByCurrentNodeCalled: func() map[string]crypto.PrivateKey {
+			return map[string]crypto.PrivateKey{
+				"public key":    createMockPrivateKey("public key"),
+				"managed key 1": createMockPrivateKey("managed key 1"),
+				"managed key 2": createMockPrivateKey("managed key 2"),
+			}
+		},
+	}
+	sender, _ := NewPeerAuthenticationSender(arg)
+
+	err := sender.execute()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]struct{}{
+		"public key":    {},
+		"managed key 1": {},
+		"managed key 2": {},
+	}, broadcastPubKeys)
+}
+
+func TestPeerAuthenticationSender_ExecuteHardforkShouldBroadcast(t *testing.T) {
+	t.Parallel()
+
+	t.Run("recorded trigger message should be rebroadcast", func(t *testing.T) {
+		t.Parallel()
+
+		broadcastMessages := make([][]byte, 0)
+		arg := createMockArgPeerAuthenticationSender()
+		arg.Messenger = &mock.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				broadcastMessages = append(broadcastMessages, buff)
+			},
+		}
+		arg.HardforkTrigger = &mock.HardforkTriggerStub{
+			RecordedTriggerMessageCalled: func() ([]byte, bool) {
+				return []byte("trigger message"), true
+			},
+		}
+		sender, _ := NewPeerAuthenticationSender(arg)
+
+		err := sender.execute()
+		assert.Nil(t, err)
+		require.Equal(t, 2, len(broadcastMessages))
+		assert.Equal(t, []byte("trigger message"), broadcastMessages[0])
+	})
+	t.Run("observer should send the trigger data", func(t *testing.T) {
+		t.Parallel()
+
+		marshalizer := &mock.MarshalizerMock{}
+		payload := &heartbeatData.Payload{}
+		arg := createMockArgPeerAuthenticationSender()
+		arg.Messenger = &mock.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				peerAuthentication := &heartbeatData.PeerAuthentication{}
+				_ = marshalizer.Unmarshal(peerAuthentication, buff)
+				_ = marshalizer.Unmarshal(payload, peerAuthentication.Payload)
+			},
+		}
+		arg.PeerTypeProvider = &mock.PeerTypeProviderStub{
+			ComputeForPubKeyCalled: func(pubKey []byte) (core.PeerType, uint32, error) {
+				return core.ObserverList, 0, nil
+			},
+		}
+		arg.HardforkTrigger = &mock.HardforkTriggerStub{
+			RecordedTriggerMessageCalled: func() ([]byte, bool) {
+				return nil, true
+			},
+			CreateDataCalled: func() []byte {
+				return []byte("hardfork data")
+			},
+		}
+		sender, _ := NewPeerAuthenticationSender(arg)
+
+		err := sender.execute()
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("hardfork data"), payload.HardforkMessage)
+	})
+}
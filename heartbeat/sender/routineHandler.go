@@ -0,0 +1,75 @@
+package sender
+
+import (
+	"context"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+)
+
+var log = logger.GetOrCreate("heartbeat/sender")
+
+// routineHandler runs the senders on a single go routine, each at its own pace. A received hardfork trigger
+// forces the immediate broadcast of the peer authentication messages, which carry the trigger on the network
+type routineHandler struct {
+	peerAuthenticationSender heartbeat.SenderHandler
+	heartbeatSender          heartbeat.SenderHandler
+	hardforkTrigger          heartbeat.HardforkTrigger
+	cancel                   func()
+}
+
+// NewRoutineHandler creates a routine handler and starts sending the messages
+func NewRoutineHandler(
+	peerAuthenticationSender heartbeat.SenderHandler,
+	heartbeatSender heartbeat.SenderHandler,
+	hardforkTrigger heartbeat.HardforkTrigger,
+) *routineHandler {
+	handler := &routineHandler{
+		peerAuthenticationSender: peerAuthenticationSender,
+		heartbeatSender:          heartbeatSender,
+		hardforkTrigger:          hardforkTrigger,
+	}
+
+	var ctx context.Context
+	ctx, handler.cancel = context.WithCancel(context.Background())
+	go handler.processLoop(ctx)
+
+	return handler
+}
+
+func (handler *routineHandler) processLoop(ctx context.Context) {
+	defer func() {
+		handler.peerAuthenticationSender.Close()
+		handler.heartbeatSender.Close()
+	}()
+
+	log.Debug("heartbeat's endless sending go routine started")
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("heartbeat's go routine is stopping...")
+			return
+		case <-handler.peerAuthenticationSender.ExecutionReadyChannel():
+			handler.peerAuthenticationSender.Execute()
+		case <-handler.heartbeatSender.ExecutionReadyChannel():
+			handler.heartbeatSender.Execute()
+		case <-handler.hardforkTrigger.NotifyTriggerReceived():
+			//this will force an immediate broadcast of the trigger message on the network
+			log.Debug("hardfork message prepared for peer authentication sending")
+			handler.peerAuthenticationSender.Execute()
+		}
+	}
+}
+
+// Close stops the senders
+func (handler *routineHandler) Close() error {
+	handler.cancel()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *routineHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
@@ -0,0 +1,254 @@
+package chainSimulator
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+)
+
+var log = logger.GetOrCreate("integrationtests/chainsimulator")
+
+// ArgsChainSimulator holds the arguments needed for creating a chain simulator. A zero dissemination delay is
+// replaced with the integration tests step delay
+type ArgsChainSimulator struct {
+	NumOfShards            uint32
+	RoundsPerEpoch         uint64
+	MinRoundsBetweenEpochs uint64
+	DisseminationDelay     time.Duration
+}
+
+// chainSimulator runs an in-memory network made of one processing node for each shard and one for the metachain,
+// connected through the in-memory messenger. The nodes use the real block processors, system smart contracts and
+// virtual machines, while the rounds are advanced only when blocks are requested, so the tests control when and how
+// many blocks are produced
+type chainSimulator struct {
+	mutSimulator       sync.Mutex
+	network            *memp2p.Network
+	shardNodes         []*integrationTests.TestProcessorNode
+	metaNode           *integrationTests.TestProcessorNode
+	allNodes           []*integrationTests.TestProcessorNode
+	roundsPerEpoch     uint64
+	disseminationDelay time.Duration
+	round              uint64
+	nonce              uint64
+}
+
+// NewChainSimulator creates and boots a new in-memory multi-shard network
+func NewChainSimulator(args ArgsChainSimulator) (*chainSimulator, error) {
+	if args.NumOfShards == 0 || args.NumOfShards > core.MaxNumShards {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidNumOfShards, args.NumOfShards)
+	}
+	if args.RoundsPerEpoch == 0 {
+		return nil, ErrInvalidRoundsPerEpoch
+	}
+
+	disseminationDelay := args.DisseminationDelay
+	if disseminationDelay == 0 {
+		disseminationDelay = integrationTests.StepDelay
+	}
+
+	cs := &chainSimulator{
+		network:            memp2p.NewNetwork(),
+		shardNodes:         make([]*integrationTests.TestProcessorNode, 0, args.NumOfShards),
+		allNodes:           make([]*integrationTests.TestProcessorNode, 0, args.NumOfShards+1),
+		roundsPerEpoch:     args.RoundsPerEpoch,
+		disseminationDelay: disseminationDelay,
+		round:              1,
+		nonce:              1,
+	}
+
+	for shardID := uint32(0); shardID < args.NumOfShards; shardID++ {
+		node, err := cs.createNode(args, shardID, shardID)
+		if err != nil {
+			cs.Close()
+			return nil, err
+		}
+		cs.shardNodes = append(cs.shardNodes, node)
+	}
+
+	metaNode, err := cs.createNode(args, core.MetachainShardId, 0)
+	if err != nil {
+		cs.Close()
+		return nil, err
+	}
+	cs.metaNode = metaNode
+
+	return cs, nil
+}
+
+func (cs *chainSimulator) createNode(
+	args ArgsChainSimulator,
+	shardID uint32,
+	txSignPrivKeyShardID uint32,
+) (*integrationTests.TestProcessorNode, error) {
+	messenger, err := memp2p.NewMessenger(cs.network)
+	if err != nil {
+		return nil, err
+	}
+
+	node := integrationTests.NewTestProcessorNodeWithMessenger(
+		args.NumOfShards,
+		shardID,
+		txSignPrivKeyShardID,
+		&topicCreatorMessenger{Messenger: messenger},
+	)
+	node.EpochStartTrigger.SetRoundsPerEpoch(args.RoundsPerEpoch)
+	node.EpochStartTrigger.SetMinRoundsBetweenEpochs(args.MinRoundsBetweenEpochs)
+	cs.allNodes = append(cs.allNodes, node)
+
+	return node, nil
+}
+
+// GenerateBlocks produces the provided number of blocks in each shard and in the metachain. In each round, the shards
+// propose their blocks first, so the metachain block of the same round notarizes them
+func (cs *chainSimulator) GenerateBlocks(numBlocks uint64) error {
+	cs.mutSimulator.Lock()
+	defer cs.mutSimulator.Unlock()
+
+	for i := uint64(0); i < numBlocks; i++ {
+		err := cs.generateBlock()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GenerateBlocksUntilEpochIsReached fast-forwards the network to the provided epoch by forcing the metachain to start
+// a new epoch as soon as the minimum number of rounds between epochs allows it. It returns after all the nodes
+// switched to the provided epoch
+func (cs *chainSimulator) GenerateBlocksUntilEpochIsReached(targetEpoch uint32) error {
+	cs.mutSimulator.Lock()
+	defer cs.mutSimulator.Unlock()
+
+	currentEpoch := cs.metaNode.EpochStartTrigger.Epoch()
+	if targetEpoch <= currentEpoch && cs.allNodesReachedEpoch(targetEpoch) {
+		return nil
+	}
+
+	// one more epoch allows the shards to notarize the last start of epoch meta block
+	maxNumRounds := uint64(targetEpoch-currentEpoch+1) * (cs.roundsPerEpoch + 1)
+	for i := uint64(0); i < maxNumRounds; i++ {
+		if cs.metaNode.EpochStartTrigger.Epoch() < targetEpoch {
+			cs.metaNode.EpochStartTrigger.ForceEpochStart(cs.round)
+		}
+
+		err := cs.generateBlock()
+		if err != nil {
+			return err
+		}
+
+		if cs.allNodesReachedEpoch(targetEpoch) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %d after %d rounds", ErrEpochNotReached, targetEpoch, maxNumRounds)
+}
+
+func (cs *chainSimulator) allNodesReachedEpoch(epoch uint32) bool {
+	for _, node := range cs.allNodes {
+		if node.EpochStartTrigger.Epoch() < epoch {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (cs *chainSimulator) generateBlock() error {
+	integrationTests.UpdateRound(cs.allNodes, cs.round)
+
+	for _, node := range cs.shardNodes {
+		err := cs.proposeAndCommitBlock(node)
+		if err != nil {
+			return err
+		}
+	}
+	time.Sleep(cs.disseminationDelay)
+
+	err := cs.proposeAndCommitBlock(cs.metaNode)
+	if err != nil {
+		return err
+	}
+	time.Sleep(cs.disseminationDelay)
+
+	log.Debug("chain simulator: generated block", "round", cs.round, "nonce", cs.nonce,
+		"epoch", cs.metaNode.EpochStartTrigger.Epoch())
+	cs.round++
+	cs.nonce++
+
+	return nil
+}
+
+func (cs *chainSimulator) proposeAndCommitBlock(node *integrationTests.TestProcessorNode) error {
+	body, header, _ := node.ProposeBlock(cs.round, cs.nonce)
+	if header == nil || body == nil {
+		return fmt.Errorf("%w: shard %d, round %d", ErrBlockNotProduced, node.ShardCoordinator.SelfId(), cs.round)
+	}
+
+	node.WhiteListBody(cs.allNodes, body)
+	node.BroadcastBlock(body, header)
+	node.CommitBlock(body, header)
+
+	return nil
+}
+
+// GetNodeHandler returns the node running the provided shard, or the metachain
+func (cs *chainSimulator) GetNodeHandler(shardID uint32) (*integrationTests.TestProcessorNode, error) {
+	if shardID == core.MetachainShardId {
+		return cs.metaNode, nil
+	}
+	if shardID >= uint32(len(cs.shardNodes)) {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownShard, shardID)
+	}
+
+	return cs.shardNodes[shardID], nil
+}
+
+// MintAddress adds the provided value to the balance of the address, in the state of the shard owning the address
+func (cs *chainSimulator) MintAddress(address []byte, value *big.Int) {
+	cs.mutSimulator.Lock()
+	defer cs.mutSimulator.Unlock()
+
+	shardID := cs.shardNodes[0].ShardCoordinator.ComputeId(address)
+	integrationTests.MintAddress(cs.shardNodes[shardID].AccntState, address, value)
+}
+
+// CurrentRound returns the round of the next generated blocks
+func (cs *chainSimulator) CurrentRound() uint64 {
+	cs.mutSimulator.Lock()
+	defer cs.mutSimulator.Unlock()
+
+	return cs.round
+}
+
+// CurrentNonce returns the nonce of the next generated blocks
+func (cs *chainSimulator) CurrentNonce() uint64 {
+	cs.mutSimulator.Lock()
+	defer cs.mutSimulator.Unlock()
+
+	return cs.nonce
+}
+
+// Close disconnects all the nodes from the in-memory network
+func (cs *chainSimulator) Close() {
+	for _, node := range cs.allNodes {
+		err := node.Messenger.Close()
+		if err != nil {
+			log.Debug("chain simulator: close messenger", "error", err.Error())
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cs *chainSimulator) IsInterfaceNil() bool {
+	return cs == nil
+}
@@ -0,0 +1,66 @@
+package chainSimulator
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewChainSimulator_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cs, err := NewChainSimulator(ArgsChainSimulator{NumOfShards: 0, RoundsPerEpoch: 10})
+	assert.True(t, check.IfNil(cs))
+	assert.True(t, errors.Is(err, ErrInvalidNumOfShards))
+
+	cs, err = NewChainSimulator(ArgsChainSimulator{NumOfShards: 2, RoundsPerEpoch: 0})
+	assert.True(t, check.IfNil(cs))
+	assert.Equal(t, ErrInvalidRoundsPerEpoch, err)
+}
+
+func TestChainSimulator_GenerateBlocksAndEpochFastForward(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	cs, err := NewChainSimulator(ArgsChainSimulator{
+		NumOfShards:            2,
+		RoundsPerEpoch:         20,
+		MinRoundsBetweenEpochs: 2,
+	})
+	require.Nil(t, err)
+	defer cs.Close()
+
+	address := integrationTests.CreateRandomAddress()
+	cs.MintAddress(address, big.NewInt(1000))
+
+	err = cs.GenerateBlocks(3)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(4), cs.CurrentNonce())
+
+	for _, shardID := range []uint32{0, 1, core.MetachainShardId} {
+		node, errGet := cs.GetNodeHandler(shardID)
+		require.Nil(t, errGet)
+		assert.Equal(t, uint64(3), node.BlockChain.GetCurrentBlockHeader().GetNonce())
+	}
+
+	_, err = cs.GetNodeHandler(2)
+	assert.True(t, errors.Is(err, ErrUnknownShard))
+
+	err = cs.GenerateBlocksUntilEpochIsReached(2)
+	require.Nil(t, err)
+	assert.True(t, cs.CurrentRound() < 2*20)
+
+	node, _ := cs.GetNodeHandler(0)
+	node, _ = cs.GetNodeHandler(node.ShardCoordinator.ComputeId(address))
+	account, err := node.AccntState.GetExistingAccount(address)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(1000), account.(state.UserAccountHandler).GetBalance())
+}
@@ -0,0 +1,18 @@
+package chainSimulator
+
+import "errors"
+
+// ErrInvalidNumOfShards signals that an invalid number of shards was provided
+var ErrInvalidNumOfShards = errors.New("invalid number of shards")
+
+// ErrInvalidRoundsPerEpoch signals that an invalid number of rounds per epoch was provided
+var ErrInvalidRoundsPerEpoch = errors.New("invalid number of rounds per epoch")
+
+// ErrUnknownShard signals that the simulated network has no node in the requested shard
+var ErrUnknownShard = errors.New("unknown shard")
+
+// ErrBlockNotProduced signals that a node could not produce its block
+var ErrBlockNotProduced = errors.New("block not produced")
+
+// ErrEpochNotReached signals that the requested epoch was not reached in the expected number of rounds
+var ErrEpochNotReached = errors.New("epoch not reached")
@@ -0,0 +1,29 @@
+package chainSimulator
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+)
+
+// topicCreatorMessenger is an in-memory messenger which creates the missing topics when registering message
+// processors, as the libp2p messenger accepts processors for topics that were not created yet
+type topicCreatorMessenger struct {
+	*memp2p.Messenger
+}
+
+// RegisterMessageProcessor creates the topic, if missing, and registers the message processor on it
+func (tcm *topicCreatorMessenger) RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error {
+	if !tcm.HasTopic(topic) {
+		err := tcm.CreateTopic(topic, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tcm.Messenger.RegisterMessageProcessor(topic, handler)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tcm *topicCreatorMessenger) IsInterfaceNil() bool {
+	return tcm == nil || tcm.Messenger == nil
+}
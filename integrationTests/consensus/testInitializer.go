@@ -418,6 +418,15 @@ func createConsensusOnlyNode(
 		node.WithPublicKeySize(publicKeySize),
 		node.WithPeerHonestyHandler(&mock.PeerHonestyHandlerStub{}),
 		node.WithFallbackHeaderValidator(&testscommon.FallBackHeaderValidatorStub{}),
+		node.WithPartitionStatusHandler(&testscommon.PartitionStatusHandlerStub{}),
+		node.WithEquivocationDetector(&testscommon.EquivocationDetectorStub{}),
+		node.WithSubroundsTimingHandler(&testscommon.SubroundsTimingHandlerStub{}),
+		node.WithProposalPreparer(&testscommon.ProposalPreparerStub{}),
+		node.WithConsensusStateDebugger(&testscommon.StateDebuggerStub{}),
+		node.WithManagedPeersHolder(&testscommon.ManagedPeersHolderStub{}),
+		node.WithNodeRedundancyHandler(&testscommon.NodeRedundancyHandlerStub{}),
+		node.WithParticipationTracker(&testscommon.ParticipationTrackerStub{}),
+		node.WithSigningSlotHandler(&testscommon.SigningSlotHandlerStub{}),
 		node.WithInterceptorsContainer(&mock.InterceptorsContainerStub{}),
 		node.WithHardforkTrigger(&mock.HardforkTriggerStub{}),
 		node.WithWatchdogTimer(&mock.WatchdogMock{}),
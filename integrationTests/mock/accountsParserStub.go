@@ -14,6 +14,7 @@ type AccountsParserStub struct {
 	InitialAccountsCalled                                 func() []genesis.InitialAccountHandler
 	GetTotalStakedForDelegationAddressCalled              func(delegationAddress string) *big.Int
 	GetInitialAccountsForDelegatedCalled                  func(addressBytes []byte) []genesis.InitialAccountHandler
+	HasVestingSchedulesCalled                             func() bool
 }
 
 // GetTotalStakedForDelegationAddress -
@@ -61,6 +62,15 @@ func (aps *AccountsParserStub) InitialAccounts() []genesis.InitialAccountHandler
 	return make([]genesis.InitialAccountHandler, 0)
 }
 
+// HasVestingSchedules -
+func (aps *AccountsParserStub) HasVestingSchedules() bool {
+	if aps.HasVestingSchedulesCalled != nil {
+		return aps.HasVestingSchedulesCalled()
+	}
+
+	return false
+}
+
 // IsInterfaceNil -
 func (aps *AccountsParserStub) IsInterfaceNil() bool {
 	return aps == nil
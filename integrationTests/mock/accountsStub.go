@@ -33,11 +33,21 @@ func (as *AccountsStub) GetNumCheckpoints() uint32 {
 	panic("implement me")
 }
 
+// IsSnapshotInProgress -
+func (as *AccountsStub) IsSnapshotInProgress() bool {
+	return false
+}
+
 // RecreateAllTries -
 func (as *AccountsStub) RecreateAllTries(_ []byte, _ context.Context) (map[string]data.Trie, error) {
 	panic("implement me")
 }
 
+// GetTrie -
+func (as *AccountsStub) GetTrie(_ []byte) (data.Trie, error) {
+	panic("implement me")
+}
+
 // LoadAccount -
 func (as *AccountsStub) LoadAccount(address []byte) (state.AccountHandler, error) {
 	if as.LoadAccountCalled != nil {
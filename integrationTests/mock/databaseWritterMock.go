@@ -8,10 +8,11 @@ import (
 
 // DatabaseWriterStub --
 type DatabaseWriterStub struct {
-	DoRequestCalled     func(req *esapi.IndexRequest) error
-	DoBulkRequestCalled func(buff *bytes.Buffer, index string) error
-	DoBulkRemoveCalled  func(index string, hashes []string) error
-	DoMultiGetCalled    func(query map[string]interface{}, index string) (map[string]interface{}, error)
+	DoRequestCalled       func(req *esapi.IndexRequest) error
+	DoBulkRequestCalled   func(buff *bytes.Buffer, index string) error
+	DoBulkRemoveCalled    func(index string, hashes []string) error
+	DoDeleteByQueryCalled func(index string, query map[string]interface{}) error
+	DoMultiGetCalled      func(query map[string]interface{}, index string) (map[string]interface{}, error)
 }
 
 // DoRequest --
@@ -48,6 +49,15 @@ func (dws *DatabaseWriterStub) DoBulkRemove(index string, hashes []string) error
 	return nil
 }
 
+// DoDeleteByQuery -
+func (dws *DatabaseWriterStub) DoDeleteByQuery(index string, query map[string]interface{}) error {
+	if dws.DoDeleteByQueryCalled != nil {
+		return dws.DoDeleteByQueryCalled(index, query)
+	}
+
+	return nil
+}
+
 // CheckAndCreateIndex --
 func (dws *DatabaseWriterStub) CheckAndCreateIndex(_ string) error {
 	return nil
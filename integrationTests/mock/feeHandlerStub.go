@@ -8,19 +8,22 @@ import (
 
 // FeeHandlerStub -
 type FeeHandlerStub struct {
-	SetMaxGasLimitPerBlockCalled  func(maxGasLimitPerBlock uint64)
-	SetMinGasPriceCalled          func(minGasPrice uint64)
-	SetMinGasLimitCalled          func(minGasLimit uint64)
-	MaxGasLimitPerBlockCalled     func() uint64
-	ComputeGasLimitCalled         func(tx process.TransactionWithFeeHandler) uint64
-	ComputeMoveBalanceFeeCalled   func(tx process.TransactionWithFeeHandler) *big.Int
-	ComputeTxFeeCalled            func(tx process.TransactionWithFeeHandler) *big.Int
-	CheckValidityTxValuesCalled   func(tx process.TransactionWithFeeHandler) error
-	DeveloperPercentageCalled     func() float64
-	MinGasPriceCalled             func() uint64
-	GasPriceModifierCalled        func() float64
-	ComputeFeeForProcessingCalled func(tx process.TransactionWithFeeHandler, gasToUse uint64) *big.Int
-	GenesisTotalSupplyCalled      func() *big.Int
+	SetMaxGasLimitPerBlockCalled          func(maxGasLimitPerBlock uint64)
+	SetMinGasPriceCalled                  func(minGasPrice uint64)
+	SetMinGasLimitCalled                  func(minGasLimit uint64)
+	MaxGasLimitPerBlockCalled             func() uint64
+	ComputeGasLimitCalled                 func(tx process.TransactionWithFeeHandler) uint64
+	ComputeMoveBalanceFeeCalled           func(tx process.TransactionWithFeeHandler) *big.Int
+	ComputeTxFeeCalled                    func(tx process.TransactionWithFeeHandler) *big.Int
+	CheckValidityTxValuesCalled           func(tx process.TransactionWithFeeHandler) error
+	DeveloperPercentageCalled             func() float64
+	DeveloperPercentageWithOverrideCalled func(percentageOverride float64) float64
+	MinGasPriceCalled                     func() uint64
+	GasPriceModifierCalled                func() float64
+	ComputeFeeForProcessingCalled         func(tx process.TransactionWithFeeHandler, gasToUse uint64) *big.Int
+	ComputeTxFeeInEpochCalled             func(tx process.TransactionWithFeeHandler, epoch uint32) *big.Int
+	ComputeFeeForProcessingInEpochCalled  func(tx process.TransactionWithFeeHandler, gasToUse uint64, epoch uint32) *big.Int
+	GenesisTotalSupplyCalled              func() *big.Int
 }
 
 // ComputeFeeForProcessing -
@@ -31,6 +34,22 @@ func (fhs *FeeHandlerStub) ComputeFeeForProcessing(tx process.TransactionWithFee
 	return big.NewInt(0)
 }
 
+// ComputeFeeForProcessingInEpoch -
+func (fhs *FeeHandlerStub) ComputeFeeForProcessingInEpoch(tx process.TransactionWithFeeHandler, gasToUse uint64, epoch uint32) *big.Int {
+	if fhs.ComputeFeeForProcessingInEpochCalled != nil {
+		return fhs.ComputeFeeForProcessingInEpochCalled(tx, gasToUse, epoch)
+	}
+	return fhs.ComputeFeeForProcessing(tx, gasToUse)
+}
+
+// ComputeTxFeeInEpoch -
+func (fhs *FeeHandlerStub) ComputeTxFeeInEpoch(tx process.TransactionWithFeeHandler, epoch uint32) *big.Int {
+	if fhs.ComputeTxFeeInEpochCalled != nil {
+		return fhs.ComputeTxFeeInEpochCalled(tx, epoch)
+	}
+	return fhs.ComputeTxFee(tx)
+}
+
 // GasPriceModifier -
 func (fhs *FeeHandlerStub) GasPriceModifier() float64 {
 	if fhs.GasPriceModifierCalled != nil {
@@ -67,6 +86,15 @@ func (fhs *FeeHandlerStub) SetMinGasLimit(minGasLimit uint64) {
 	fhs.SetMinGasLimitCalled(minGasLimit)
 }
 
+// DeveloperPercentageWithOverride -
+func (fhs *FeeHandlerStub) DeveloperPercentageWithOverride(percentageOverride float64) float64 {
+	if fhs.DeveloperPercentageWithOverrideCalled != nil {
+		return fhs.DeveloperPercentageWithOverrideCalled(percentageOverride)
+	}
+
+	return percentageOverride
+}
+
 // MaxGasLimitPerBlock -
 func (fhs *FeeHandlerStub) MaxGasLimitPerBlock(uint32) uint64 {
 	return fhs.MaxGasLimitPerBlockCalled()
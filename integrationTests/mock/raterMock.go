@@ -1,16 +1,22 @@
 package mock
 
+import "math"
+
 // RaterMock -
 type RaterMock struct {
-	GetRatingCalled                func(string) uint32
-	GetStartRatingCalled           func() uint32
-	GetSignedBlocksThresholdCalled func() float32
-	ComputeIncreaseProposerCalled  func(shardId uint32, rating uint32) uint32
-	ComputeDecreaseProposerCalled  func(shardId uint32, rating uint32, consecutiveMissedBlocks uint32) uint32
-	RevertIncreaseProposerCalled   func(shardId uint32, rating uint32, nrReverts uint32) uint32
-	ComputeIncreaseValidatorCalled func(shardId uint32, rating uint32) uint32
-	ComputeDecreaseValidatorCalled func(shardId uint32, rating uint32) uint32
-	GetChanceCalled                func(rating uint32) uint32
+	GetRatingCalled                                   func(string) uint32
+	GetStartRatingCalled                              func() uint32
+	GetSignedBlocksThresholdCalled                    func() float32
+	ComputeIncreaseProposerCalled                     func(shardId uint32, rating uint32) uint32
+	ComputeDecreaseProposerCalled                     func(shardId uint32, rating uint32, consecutiveMissedBlocks uint32) uint32
+	RevertIncreaseProposerCalled                      func(shardId uint32, rating uint32, nrReverts uint32) uint32
+	ComputeIncreaseValidatorCalled                    func(shardId uint32, rating uint32) uint32
+	ComputeDecreaseValidatorCalled                    func(shardId uint32, rating uint32) uint32
+	ComputeDecreaseValidatorOnConsecutiveMissesCalled func(shardId uint32, rating uint32, consecutiveMisses uint32) uint32
+	ComputeProbationDecreaseCalled                    func(currentRating uint32, newRating uint32) uint32
+	GetProbationConsecutiveMissesThresholdCalled      func() uint32
+	GetProbationDurationInEpochsCalled                func() uint32
+	GetChanceCalled                                   func(rating uint32) uint32
 }
 
 // GetRating -
@@ -56,6 +62,38 @@ func (rm *RaterMock) ComputeDecreaseValidator(shardId uint32, currentRating uint
 	return rm.ComputeDecreaseValidatorCalled(shardId, currentRating)
 }
 
+// ComputeDecreaseValidatorOnConsecutiveMisses -
+func (rm *RaterMock) ComputeDecreaseValidatorOnConsecutiveMisses(shardId uint32, currentRating uint32, consecutiveMisses uint32) uint32 {
+	if rm.ComputeDecreaseValidatorOnConsecutiveMissesCalled != nil {
+		return rm.ComputeDecreaseValidatorOnConsecutiveMissesCalled(shardId, currentRating, consecutiveMisses)
+	}
+	return rm.ComputeDecreaseValidator(shardId, currentRating)
+}
+
+// ComputeProbationDecrease -
+func (rm *RaterMock) ComputeProbationDecrease(currentRating uint32, newRating uint32) uint32 {
+	if rm.ComputeProbationDecreaseCalled != nil {
+		return rm.ComputeProbationDecreaseCalled(currentRating, newRating)
+	}
+	return newRating
+}
+
+// GetProbationConsecutiveMissesThreshold -
+func (rm *RaterMock) GetProbationConsecutiveMissesThreshold() uint32 {
+	if rm.GetProbationConsecutiveMissesThresholdCalled != nil {
+		return rm.GetProbationConsecutiveMissesThresholdCalled()
+	}
+	return math.MaxUint32
+}
+
+// GetProbationDurationInEpochs -
+func (rm *RaterMock) GetProbationDurationInEpochs() uint32 {
+	if rm.GetProbationDurationInEpochsCalled != nil {
+		return rm.GetProbationDurationInEpochsCalled()
+	}
+	return 1
+}
+
 // GetChance -
 func (rm *RaterMock) GetChance(rating uint32) uint32 {
 	if rm.GetChanceCalled != nil {
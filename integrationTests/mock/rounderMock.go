@@ -42,6 +42,11 @@ func (rm *RounderMock) TimeDuration() time.Duration {
 	return rm.TimeDurationField
 }
 
+// TimeDurationBetweenRounds -
+func (rm *RounderMock) TimeDurationBetweenRounds(fromRound int64, toRound int64) time.Duration {
+	return time.Duration(toRound-fromRound) * rm.TimeDuration()
+}
+
 // RemainingTime -
 func (rm *RounderMock) RemainingTime(_ time.Time, _ time.Duration) time.Duration {
 	return rm.RemainingTimeField
@@ -26,6 +26,7 @@ type TransactionCoordinatorMock struct {
 		haveTime func() bool,
 	) (block.MiniBlockSlice, uint32, bool, error)
 	CreateMbsAndProcessTransactionsFromMeCalled func(haveTime func() bool) block.MiniBlockSlice
+	PrepareNextProposalCalled                   func()
 	CreateMarshalizedDataCalled                 func(body *block.Body) map[string][][]byte
 	GetAllCurrentUsedTxsCalled                  func(blockType block.Type) map[string]data.TransactionHandler
 	VerifyCreatedBlockTransactionsCalled        func(hdr data.HeaderHandler, body *block.Body) error
@@ -159,6 +160,13 @@ func (tcm *TransactionCoordinatorMock) CreateMbsAndProcessTransactionsFromMe(hav
 	return tcm.CreateMbsAndProcessTransactionsFromMeCalled(haveTime)
 }
 
+// PrepareNextProposal -
+func (tcm *TransactionCoordinatorMock) PrepareNextProposal() {
+	if tcm.PrepareNextProposalCalled != nil {
+		tcm.PrepareNextProposalCalled()
+	}
+}
+
 // CreateMarshalizedData -
 func (tcm *TransactionCoordinatorMock) CreateMarshalizedData(body *block.Body) map[string][][]byte {
 	if tcm.CreateMarshalizedDataCalled == nil {
@@ -1,34 +1,52 @@
 package heartbeat
 
 import (
-	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	mock2 "github.com/ElrondNetwork/elrond-go/heartbeat/mock"
 
-	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
 	mclsig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/monitor"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/sender"
 	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
-	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
 
 var stepDelay = time.Second / 10
-var log = logger.GetOrCreate("integrationtests/node")
+
+type heartbeatSenders struct {
+	peerAuthenticationSender heartbeat.SenderHandler
+	heartbeatSender          heartbeat.SenderHandler
+}
+
+func (hs *heartbeatSenders) send() {
+	hs.peerAuthenticationSender.Execute()
+	hs.heartbeatSender.Execute()
+}
+
+type testMonitor struct {
+	heartbeat.MonitorHandler
+	peerAuthenticationCache storage.Cacher
+	heartbeatCache          storage.Cacher
+}
 
 // TestHeartbeatMonitorWillUpdateAnInactivePeer test what happen if a peer out of 2 stops being responsive on heartbeat status
-// The active monitor should change it's active flag to false when a new heartbeat message has arrived.
+// The active monitor should change it's active flag to false when no new heartbeat message has arrived.
 func TestHeartbeatMonitorWillUpdateAnInactivePeer(t *testing.T) {
 	if testing.Short() {
 		t.Skip("this is not a short test")
@@ -39,8 +57,8 @@ func TestHeartbeatMonitorWillUpdateAnInactivePeer(t *testing.T) {
 	advertiserAddr := integrationTests.GetConnectableAddress(advertiser)
 	maxUnresposiveTime := time.Second * 10
 
-	monitor := createMonitor(maxUnresposiveTime)
-	nodes, senders, pks := prepareNodes(advertiserAddr, monitor, 3, "nodeName")
+	hbMonitor := createMonitor(maxUnresposiveTime)
+	nodes, senders, pks := prepareNodes(advertiserAddr, hbMonitor, 3, "nodeName")
 
 	defer func() {
 		_ = advertiser.Close()
@@ -53,30 +71,27 @@ func TestHeartbeatMonitorWillUpdateAnInactivePeer(t *testing.T) {
 	time.Sleep(integrationTests.P2pBootstrapDelay)
 
 	fmt.Println("Sending first messages from both public keys...")
-	err := senders[0].SendHeartbeat()
-	log.LogIfError(err)
-
-	err = senders[1].SendHeartbeat()
-	log.LogIfError(err)
+	senders[0].send()
+	senders[1].send()
 
 	time.Sleep(stepDelay)
 
 	fmt.Println("Checking both public keys are active...")
-	checkReceivedMessages(t, monitor, pks, []int{0, 1})
+	checkReceivedMessages(t, hbMonitor, pks, []int{0, 1})
 
 	fmt.Println("Waiting for max unresponsive time...")
 	time.Sleep(maxUnresposiveTime)
 
-	fmt.Println("Only first pk will send another message...")
-	_ = senders[0].SendHeartbeat()
+	fmt.Println("Only first pk will send another heartbeat message...")
+	senders[0].heartbeatSender.Execute()
 
 	time.Sleep(stepDelay)
 
 	fmt.Println("Checking only first pk is active...")
-	checkReceivedMessages(t, monitor, pks, []int{0})
+	checkReceivedMessages(t, hbMonitor, pks, []int{0})
 }
 
-func TestHeartbeatMonitorWillNotUpdateTooLongHeartbeatMessages(t *testing.T) {
+func TestHeartbeatMonitorWillTrimTooLongHeartbeatProperties(t *testing.T) {
 	if testing.Short() {
 		t.Skip("this is not a short test")
 	}
@@ -94,8 +109,8 @@ func TestHeartbeatMonitorWillNotUpdateTooLongHeartbeatMessages(t *testing.T) {
 	}
 	bigNodeName := string(buff)
 
-	monitor := createMonitor(maxUnresposiveTime)
-	nodes, senders, pks := prepareNodes(advertiserAddr, monitor, 3, bigNodeName)
+	hbMonitor := createMonitor(maxUnresposiveTime)
+	nodes, senders, pks := prepareNodes(advertiserAddr, hbMonitor, 3, bigNodeName)
 
 	defer func() {
 		_ = advertiser.Close()
@@ -108,13 +123,13 @@ func TestHeartbeatMonitorWillNotUpdateTooLongHeartbeatMessages(t *testing.T) {
 	time.Sleep(integrationTests.P2pBootstrapDelay)
 
 	fmt.Println("Sending first messages with long name...")
-	_ = senders[1].SendHeartbeat()
+	senders[1].send()
 
 	time.Sleep(stepDelay)
 
 	secondPK := pks[1]
 
-	pkHeartBeats := monitor.GetHeartbeats()
+	pkHeartBeats := hbMonitor.GetHeartbeats()
 
 	assert.True(t, isPkActive(pkHeartBeats, secondPK))
 	expectedLen := 128
@@ -123,28 +138,28 @@ func TestHeartbeatMonitorWillNotUpdateTooLongHeartbeatMessages(t *testing.T) {
 
 func prepareNodes(
 	advertiserAddr string,
-	monitor *process.Monitor,
+	hbMonitor *testMonitor,
 	interactingNodes int,
 	defaultNodeName string,
-) ([]p2p.Messenger, []*process.Sender, []crypto.PublicKey) {
+) ([]p2p.Messenger, []*heartbeatSenders, []crypto.PublicKey) {
 
 	senderIdxs := []int{0, 1}
 	nodes := make([]p2p.Messenger, interactingNodes)
-	topicHeartbeat := "topic"
-	senders := make([]*process.Sender, 0)
+	senders := make([]*heartbeatSenders, 0)
 	pks := make([]crypto.PublicKey, 0)
 
 	for i := 0; i < interactingNodes; i++ {
 		nodes[i] = integrationTests.CreateMessengerWithKadDht(advertiserAddr)
-		_ = nodes[i].CreateTopic(topicHeartbeat, true)
+		_ = nodes[i].CreateTopic(core.PeerAuthenticationTopic, true)
+		_ = nodes[i].CreateTopic(core.HeartbeatV2Topic, true)
 
 		isSender := integrationTests.IsIntInSlice(i, senderIdxs)
 		if isSender {
-			sender, pk := createSenderWithName(nodes[i], topicHeartbeat, defaultNodeName)
-			senders = append(senders, sender)
+			hbSenders, pk := createSendersWithName(nodes[i], defaultNodeName)
+			senders = append(senders, hbSenders)
 			pks = append(pks, pk)
 		} else {
-			_ = nodes[i].RegisterMessageProcessor(topicHeartbeat, monitor)
+			registerProcessors(nodes[i], hbMonitor)
 		}
 
 		_ = nodes[i].Bootstrap()
@@ -153,11 +168,10 @@ func prepareNodes(
 	return nodes, senders, pks
 }
 
-func checkReceivedMessages(t *testing.T, monitor *process.Monitor, pks []crypto.PublicKey, activeIdxs []int) {
-	pkHeartBeats := monitor.GetHeartbeats()
+func checkReceivedMessages(t *testing.T, hbMonitor *testMonitor, pks []crypto.PublicKey, activeIdxs []int) {
+	pkHeartBeats := hbMonitor.GetHeartbeats()
 
-	extraPkInMonitor := 1
-	assert.Equal(t, len(pks), len(pkHeartBeats)-extraPkInMonitor)
+	assert.Equal(t, len(pks), len(pkHeartBeats))
 
 	for idx, pk := range pks {
 		pkShouldBeActive := integrationTests.IsIntInSlice(idx, activeIdxs)
@@ -205,83 +219,128 @@ func isMessageCorrectLen(heartbeats []data.PubKeyHeartbeat, pk crypto.PublicKey,
 	return false
 }
 
-func createSenderWithName(messenger p2p.Messenger, topic string, nodeName string) (*process.Sender, crypto.PublicKey) {
+func createSendersWithName(messenger p2p.Messenger, nodeName string) (*heartbeatSenders, crypto.PublicKey) {
 	suite := mcl.NewSuiteBLS12()
 	signer := &mclsig.BlsSingleSigner{}
 	keyGen := signing.NewKeyGenerator(suite)
 	sk, pk := keyGen.GeneratePair()
 	version := "v01"
 
-	argSender := process.ArgHeartbeatSender{
-		PeerMessenger:        messenger,
+	argPeerAuthenticationSender := sender.ArgPeerAuthenticationSender{
+		ArgBaseSender: sender.ArgBaseSender{
+			Messenger:        messenger,
+			Marshalizer:      integrationTests.TestMarshalizer,
+			Topic:            core.PeerAuthenticationTopic,
+			TimeBetweenSends: time.Minute,
+		},
 		PeerSignatureHandler: &mock2.PeerSignatureHandler{Signer: signer},
+		SingleSigner:         signer,
 		PrivKey:              sk,
-		Marshalizer:          integrationTests.TestMarshalizer,
-		Topic:                topic,
-		ShardCoordinator:     &sharding.OneShardCoordinator{},
-		PeerTypeProvider:     &mock.PeerTypeProviderStub{},
-		StatusHandler:        &mock.AppStatusHandlerStub{},
+		PeerTypeProvider: &mock.PeerTypeProviderStub{
+			ComputeForPubKeyCalled: func(pubKey []byte) (core.PeerType, uint32, error) {
+				return core.EligibleList, 0, nil
+			},
+		},
+		StatusHandler:      &mock.AppStatusHandlerStub{},
+		HardforkTrigger:    &mock.HardforkTriggerStub{},
+		ManagedPeersHolder: &testscommon.ManagedPeersHolderStub{},
+	}
+	peerAuthenticationSender, _ := sender.NewPeerAuthenticationSender(argPeerAuthenticationSender)
+
+	argNodeMetricsProvider := sender.ArgNodeMetricsProvider{
+		CurrentBlockProvider: &mock.BlockChainMock{},
+		ForkDetector:         &mock.ForkDetectorStub{},
+		Accounts:             &mock.AccountsStub{},
+		Messenger:            messenger,
+		PeerShardResolver:    &mock.PeerShardMapperStub{},
+	}
+	nodeMetricsProvider, _ := sender.NewNodeMetricsProvider(argNodeMetricsProvider)
+
+	argHeartbeatSender := sender.ArgHeartbeatSender{
+		ArgBaseSender: sender.ArgBaseSender{
+			Messenger:        messenger,
+			Marshalizer:      integrationTests.TestMarshalizer,
+			Topic:            core.HeartbeatV2Topic,
+			TimeBetweenSends: time.Minute,
+		},
 		VersionNumber:        version,
 		NodeDisplayName:      nodeName,
-		HardforkTrigger:      &mock.HardforkTriggerStub{},
+		ShardCoordinator:     &sharding.OneShardCoordinator{},
 		CurrentBlockProvider: &mock.BlockChainMock{},
+		NodeMetricsProvider:  nodeMetricsProvider,
+		ManagedPeersHolder:   &testscommon.ManagedPeersHolderStub{},
 	}
+	heartbeatSender, _ := sender.NewHeartbeatSender(argHeartbeatSender)
 
-	sender, _ := process.NewSender(argSender)
-	return sender, pk
+	return &heartbeatSenders{
+		peerAuthenticationSender: peerAuthenticationSender,
+		heartbeatSender:          heartbeatSender,
+	}, pk
 }
 
-func createMonitor(maxDurationPeerUnresponsive time.Duration) *process.Monitor {
+func registerProcessors(messenger p2p.Messenger, hbMonitor *testMonitor) {
 	suite := mcl.NewSuiteBLS12()
 	singlesigner := &mclsig.BlsSingleSigner{}
 	keyGen := signing.NewKeyGenerator(suite)
-	marshalizer := &marshal.GogoProtoMarshalizer{}
-
-	mp, _ := process.NewMessageProcessor(
-		&mock2.PeerSignatureHandler{Signer: singlesigner, KeyGen: keyGen},
-		marshalizer,
-		&mock.NetworkShardingCollectorStub{
-			UpdatePeerIdPublicKeyCalled: func(pid core.PeerID, pk []byte) {},
-			UpdatePeerIdShardIdCalled:   func(pid core.PeerID, shardId uint32) {},
-		})
-
-	argMonitor := process.ArgHeartbeatMonitor{
-		Marshalizer:                 integrationTests.TestMarshalizer,
-		MaxDurationPeerUnresponsive: maxDurationPeerUnresponsive,
-		PubKeysMap:                  map[uint32][]string{0: {""}},
-		GenesisTime:                 time.Now(),
-		MessageHandler:              mp,
-		Storer: &mock.HeartbeatStorerStub{
-			UpdateGenesisTimeCalled: func(genesisTime time.Time) error {
-				return nil
-			},
-			LoadHeartBeatDTOCalled: func(pubKey string) (*data.HeartbeatDTO, error) {
-				return nil, errors.New("not found")
-			},
-			LoadKeysCalled: func() ([][]byte, error) {
-				return nil, nil
-			},
-			SavePubkeyDataCalled: func(pubkey []byte, heartbeat *data.HeartbeatDTO) error {
-				return nil
-			},
-			SaveKeysCalled: func(peersSlice [][]byte) error {
-				return nil
-			},
+	antifloodHandler := &mock.P2PAntifloodHandlerStub{
+		CanProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			return nil
 		},
-		PeerTypeProvider: &mock.PeerTypeProviderStub{},
-		Timer:            &process.RealTimer{},
-		AntifloodHandler: &mock.P2PAntifloodHandlerStub{
-			CanProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
-				return nil
-			},
-		},
-		HardforkTrigger:                    &mock.HardforkTriggerStub{},
-		ValidatorPubkeyConverter:           integrationTests.TestValidatorPubkeyConverter,
-		HeartbeatRefreshIntervalInSec:      1,
-		HideInactiveValidatorIntervalInSec: 600,
+	}
+	networkShardingCollector := &mock.NetworkShardingCollectorStub{
+		UpdatePeerIdPublicKeyCalled: func(pid core.PeerID, pk []byte) {},
+		UpdatePeerIdShardIdCalled:   func(pid core.PeerID, shardId uint32) {},
 	}
 
-	monitor, _ := process.NewMonitor(argMonitor)
+	verifiedPayloadsCache, _ := storageUnit.NewCache(storageUnit.CacheConfig{Type: storageUnit.LRUCache, Capacity: 100})
+
+	peerAuthenticationProcessor, _ := process.NewPeerAuthenticationProcessor(process.ArgPeerAuthenticationProcessor{
+		Marshalizer:              integrationTests.TestMarshalizer,
+		PeerSignatureHandler:     &mock2.PeerSignatureHandler{Signer: singlesigner, KeyGen: keyGen},
+		SingleSigner:             singlesigner,
+		KeyGenerator:             keyGen,
+		Cache:                    hbMonitor.peerAuthenticationCache,
+		VerifiedPayloadsCache:    verifiedPayloadsCache,
+		Hasher:                   integrationTests.TestHasher,
+		AntifloodHandler:         antifloodHandler,
+		HardforkTrigger:          &mock.HardforkTriggerStub{},
+		NetworkShardingCollector: networkShardingCollector,
+		Timer:                    &process.RealTimer{},
+		MaxTimeDifference:        time.Minute,
+	})
+	_ = messenger.RegisterMessageProcessor(core.PeerAuthenticationTopic, peerAuthenticationProcessor)
+
+	heartbeatProcessor, _ := process.NewHeartbeatProcessor(process.ArgHeartbeatProcessor{
+		Marshalizer:              integrationTests.TestMarshalizer,
+		Cache:                    hbMonitor.heartbeatCache,
+		AntifloodHandler:         antifloodHandler,
+		NetworkShardingCollector: networkShardingCollector,
+		Timer:                    &process.RealTimer{},
+		MaxTimeDifference:        time.Minute,
+	})
+	_ = messenger.RegisterMessageProcessor(core.HeartbeatV2Topic, heartbeatProcessor)
+}
 
-	return monitor
+func createMonitor(maxDurationPeerUnresponsive time.Duration) *testMonitor {
+	cacheConfig := storageUnit.CacheConfig{Type: storageUnit.LRUCache, Capacity: 100}
+	peerAuthenticationCache, _ := storageUnit.NewCache(cacheConfig)
+	heartbeatCache, _ := storageUnit.NewCache(cacheConfig)
+
+	argMonitor := monitor.ArgHeartbeatV2Monitor{
+		Marshalizer:                   integrationTests.TestMarshalizer,
+		PeerAuthenticationCache:       peerAuthenticationCache,
+		HeartbeatCache:                heartbeatCache,
+		PeerTypeProvider:              &mock.PeerTypeProviderStub{},
+		ValidatorPubkeyConverter:      integrationTests.TestValidatorPubkeyConverter,
+		Timer:                         &process.RealTimer{},
+		MaxDurationPeerUnresponsive:   maxDurationPeerUnresponsive,
+		HideInactiveValidatorInterval: time.Minute * 10,
+	}
+	hbMonitor, _ := monitor.NewHeartbeatV2Monitor(argMonitor)
+
+	return &testMonitor{
+		MonitorHandler:          hbMonitor,
+		peerAuthenticationCache: peerAuthenticationCache,
+		heartbeatCache:          heartbeatCache,
+	}
 }
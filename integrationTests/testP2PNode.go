@@ -175,17 +175,32 @@ func (tP2pNode *TestP2PNode) initNode() {
 		node.WithValidatorsProvider(&mock.ValidatorsProviderStub{}),
 		node.WithPeerHonestyHandler(&mock.PeerHonestyHandlerStub{}),
 		node.WithFallbackHeaderValidator(&testscommon.FallBackHeaderValidatorStub{}),
+		node.WithPartitionStatusHandler(&testscommon.PartitionStatusHandlerStub{}),
+		node.WithEquivocationDetector(&testscommon.EquivocationDetectorStub{}),
+		node.WithSubroundsTimingHandler(&testscommon.SubroundsTimingHandlerStub{}),
+		node.WithProposalPreparer(&testscommon.ProposalPreparerStub{}),
+		node.WithConsensusStateDebugger(&testscommon.StateDebuggerStub{}),
+		node.WithManagedPeersHolder(&testscommon.ManagedPeersHolderStub{}),
+		node.WithNodeRedundancyHandler(&testscommon.NodeRedundancyHandlerStub{}),
+		node.WithParticipationTracker(&testscommon.ParticipationTrackerStub{}),
+		node.WithSigningSlotHandler(&testscommon.SigningSlotHandlerStub{}),
 		node.WithPeerSignatureHandler(psh),
 		node.WithBlockChain(&mock.BlockChainMock{}),
+		node.WithForkDetector(&mock.ForkDetectorStub{}),
+		node.WithAccountsAdapter(&mock.AccountsStub{}),
 	)
 	log.LogIfError(err)
 
 	hbConfig := config.HeartbeatConfig{
-		MinTimeToWaitBetweenBroadcastsInSec: 4,
-		MaxTimeToWaitBetweenBroadcastsInSec: 6,
-		DurationToConsiderUnresponsiveInSec: 60,
-		HeartbeatRefreshIntervalInSec:       5,
-		HideInactiveValidatorIntervalInSec:  600,
+		MinTimeToWaitBetweenBroadcastsInSec:     4,
+		MaxTimeToWaitBetweenBroadcastsInSec:     6,
+		DurationToConsiderUnresponsiveInSec:     60,
+		HideInactiveValidatorIntervalInSec:      600,
+		PeerAuthenticationTimeBetweenSendsInSec: 60,
+		MaxTimeDifferenceInSec:                  60,
+		PeerAuthenticationCache:                 config.CacheConfig{Type: "LRU", Capacity: 1000},
+		VerifiedPayloadsCache:                   config.CacheConfig{Type: "LRU", Capacity: 1000},
+		HeartbeatCache:                          config.CacheConfig{Type: "LRU", Capacity: 1000},
 	}
 	err = tP2pNode.Node.StartHeartbeat(hbConfig, "test", config.PreferencesConfig{})
 	log.LogIfError(err)
@@ -236,6 +236,7 @@ type TestProcessorNode struct {
 	GasHandler             process.GasHandler
 	FeeAccumulator         process.TransactionFeeHandler
 	SmartContractParser    genesis.InitialSmartContractParser
+	ChargingEpochHandler   process.ChargingEpochHandler
 
 	ForkDetector             process.ForkDetector
 	BlockProcessor           process.BlockProcessor
@@ -310,6 +311,20 @@ func newBaseTestProcessorNode(
 	nodeShardId uint32,
 	txSignPrivKeyShardId uint32,
 	initialNodeAddr string,
+) *TestProcessorNode {
+	return newBaseTestProcessorNodeWithMessenger(
+		maxShards,
+		nodeShardId,
+		txSignPrivKeyShardId,
+		CreateMessengerWithKadDht(initialNodeAddr),
+	)
+}
+
+func newBaseTestProcessorNodeWithMessenger(
+	maxShards uint32,
+	nodeShardId uint32,
+	txSignPrivKeyShardId uint32,
+	messenger p2p.Messenger,
 ) *TestProcessorNode {
 	shardCoordinator, _ := sharding.NewMultiShardCoordinator(maxShards, nodeShardId)
 
@@ -362,8 +377,6 @@ func newBaseTestProcessorNode(
 		},
 	}
 
-	messenger := CreateMessengerWithKadDht(initialNodeAddr)
-
 	tpn := &TestProcessorNode{
 		ShardCoordinator:        shardCoordinator,
 		Messenger:               messenger,
@@ -404,6 +417,19 @@ func NewTestProcessorNode(
 	return tpn
 }
 
+// NewTestProcessorNodeWithMessenger returns a new TestProcessorNode instance using the provided messenger
+func NewTestProcessorNodeWithMessenger(
+	maxShards uint32,
+	nodeShardId uint32,
+	txSignPrivKeyShardId uint32,
+	messenger p2p.Messenger,
+) *TestProcessorNode {
+	tpn := newBaseTestProcessorNodeWithMessenger(maxShards, nodeShardId, txSignPrivKeyShardId, messenger)
+	tpn.initTestNode()
+
+	return tpn
+}
+
 // NewTestProcessorNodeWithStorageTrieAndGasModel returns a new TestProcessorNode instance with a storage-based trie
 // and gas model
 func NewTestProcessorNodeWithStorageTrieAndGasModel(
@@ -499,7 +525,7 @@ func NewTestProcessorNodeWithFullGenesis(
 	tpn.initBlockTracker()
 	tpn.initInterceptors()
 	tpn.initInnerProcessors(arwenConfig.MakeGasMapForTests())
-	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.BlockChain)
+	tpn.SCQueryService, _ = smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{VmContainer: tpn.VMContainer, EconomicsFee: tpn.EconomicsData, BlockChainHook: tpn.BlockchainHook, BlockChain: tpn.BlockChain})
 	tpn.initBlockProcessor(stateCheckpointModulus)
 	tpn.BroadcastMessenger, _ = sposFactory.GetBroadcastMessenger(
 		TestMarshalizer,
@@ -602,6 +628,7 @@ func (tpn *TestProcessorNode) initValidatorStatistics() {
 		Rater:               rater,
 		MaxComputableRounds: 1000,
 		RewardsHandler:      tpn.EconomicsData,
+		ProposerIdentifier:  &mock.ProposerIdentifierStub{},
 		NodesSetup:          tpn.NodesSetup,
 		GenesisNonce:        tpn.BlockChain.GetGenesisHeader().GetNonce(),
 		EpochNotifier:       &mock.EpochNotifierStub{},
@@ -641,7 +668,7 @@ func (tpn *TestProcessorNode) initTestNode() {
 	tpn.initBlockTracker()
 	tpn.initInterceptors()
 	tpn.initInnerProcessors(arwenConfig.MakeGasMapForTests())
-	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.BlockChain)
+	tpn.SCQueryService, _ = smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{VmContainer: tpn.VMContainer, EconomicsFee: tpn.EconomicsData, BlockChainHook: tpn.BlockchainHook, BlockChain: tpn.BlockChain})
 	tpn.initBlockProcessor(stateCheckpointModulus)
 	tpn.BroadcastMessenger, _ = sposFactory.GetBroadcastMessenger(
 		TestMarshalizer,
@@ -718,6 +745,7 @@ func (tpn *TestProcessorNode) createFullSCQueryService() {
 		MapDNSAddresses: make(map[string]struct{}),
 		Marshalizer:     TestMarshalizer,
 		Accounts:        tpn.AccntState,
+		EpochNotifier:   tpn.EpochNotifier,
 	}
 	builtInFuncFactory, _ := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	builtInFuncs, _ := builtInFuncFactory.CreateBuiltInFunctionContainer()
@@ -746,6 +774,7 @@ func (tpn *TestProcessorNode) createFullSCQueryService() {
 			MessageSignVerifier: sigVerifier,
 			GasSchedule:         gasSchedule,
 			NodesConfigProvider: tpn.NodesSetup,
+			NodesCoordinator:    tpn.NodesCoordinator,
 			Hasher:              TestHasher,
 			Marshalizer:         TestMarshalizer,
 			SystemSCConfig: &config.SystemSmartContractsConfig{
@@ -809,7 +838,7 @@ func (tpn *TestProcessorNode) createFullSCQueryService() {
 	vmContainer, _ := vmFactory.Create()
 
 	_ = builtInFunctions.SetPayableHandler(builtInFuncs, vmFactory.BlockChainHookImpl())
-	tpn.SCQueryService, _ = smartContract.NewSCQueryService(vmContainer, tpn.EconomicsData, vmFactory.BlockChainHookImpl(), tpn.BlockChain)
+	tpn.SCQueryService, _ = smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{VmContainer: vmContainer, EconomicsFee: tpn.EconomicsData, BlockChainHook: vmFactory.BlockChainHookImpl(), BlockChain: tpn.BlockChain})
 }
 
 // InitializeProcessors will reinitialize processors
@@ -817,7 +846,7 @@ func (tpn *TestProcessorNode) InitializeProcessors(gasMap map[string]map[string]
 	tpn.initValidatorStatistics()
 	tpn.initBlockTracker()
 	tpn.initInnerProcessors(gasMap)
-	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.BlockChain)
+	tpn.SCQueryService, _ = smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{VmContainer: tpn.VMContainer, EconomicsFee: tpn.EconomicsData, BlockChainHook: tpn.BlockchainHook, BlockChain: tpn.BlockChain})
 	tpn.initBlockProcessor(stateCheckpointModulus)
 	tpn.BroadcastMessenger, _ = sposFactory.GetBroadcastMessenger(
 		TestMarshalizer,
@@ -909,22 +938,29 @@ func CreateRatingsData() *rating.RatingsData {
 	ratingsConfig := config.RatingsConfig{
 		ShardChain: config.ShardChain{
 			RatingSteps: config.RatingSteps{
-				HoursToMaxRatingFromStartRating: 50,
-				ProposerValidatorImportance:     1,
-				ProposerDecreaseFactor:          -4,
-				ValidatorDecreaseFactor:         -4,
-				ConsecutiveMissedBlocksPenalty:  1.1,
+				HoursToMaxRatingFromStartRating:    50,
+				ProposerValidatorImportance:        1,
+				ProposerDecreaseFactor:             -4,
+				ValidatorDecreaseFactor:            -4,
+				ConsecutiveMissedBlocksPenalty:     1.1,
+				ConsecutiveMissedSignaturesPenalty: 1.1,
 			},
 		},
 		MetaChain: config.MetaChain{
 			RatingSteps: config.RatingSteps{
-				HoursToMaxRatingFromStartRating: 50,
-				ProposerValidatorImportance:     1,
-				ProposerDecreaseFactor:          -4,
-				ValidatorDecreaseFactor:         -4,
-				ConsecutiveMissedBlocksPenalty:  1.1,
+				HoursToMaxRatingFromStartRating:    50,
+				ProposerValidatorImportance:        1,
+				ProposerDecreaseFactor:             -4,
+				ValidatorDecreaseFactor:            -4,
+				ConsecutiveMissedBlocksPenalty:     1.1,
+				ConsecutiveMissedSignaturesPenalty: 1.1,
 			},
 		},
+		Probation: config.ProbationConfig{
+			ConsecutiveMissesThreshold: 10,
+			DurationInEpochs:           2,
+			PenaltyMultiplier:          2,
+		},
 		General: config.General{
 			StartRating:           500000,
 			MaxRating:             1000000,
@@ -1185,6 +1221,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		tpn.ValidatorStatisticsProcessor = &mock.ValidatorStatisticsProcessorStub{}
 	}
 
+	tpn.ChargingEpochHandler, _ = economics.NewChargingEpochHolder(0, tpn.EpochNotifier)
 	interimProcFactory, _ := shard.NewIntermediateProcessorsContainerFactory(
 		tpn.ShardCoordinator,
 		TestMarshalizer,
@@ -1192,6 +1229,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		TestAddressPubkeyConverter,
 		tpn.Storage,
 		tpn.DataPool,
+		tpn.ChargingEpochHandler,
 	)
 
 	tpn.InterimProcContainer, _ = interimProcFactory.Create()
@@ -1203,6 +1241,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		tpn.Storage,
 		dataBlock.SmartContractResultBlock,
 		tpn.DataPool.CurrentBlockTxs(),
+		tpn.ChargingEpochHandler,
 	)
 
 	tpn.InterimProcContainer.Remove(dataBlock.SmartContractResultBlock)
@@ -1226,6 +1265,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		MapDNSAddresses: mapDNSAddresses,
 		Marshalizer:     TestMarshalizer,
 		Accounts:        tpn.AccntState,
+		EpochNotifier:   tpn.EpochNotifier,
 	}
 	builtInFuncFactory, _ := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	builtInFuncs, _ := builtInFuncFactory.CreateBuiltInFunctionContainer()
@@ -1304,6 +1344,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		BuiltInFunctions:               tpn.BlockchainHook.GetBuiltInFunctions(),
 		TxLogsProcessor:                &mock.TxLogsProcessorStub{},
 		BadTxForwarder:                 badBlocksHandler,
+		ChargingEpochHandler:           tpn.ChargingEpochHandler,
 		EpochNotifier:                  tpn.EpochNotifier,
 		DeployEnableEpoch:              tpn.DeployEnableEpoch,
 		BuiltinEnableEpoch:             tpn.BuiltinEnableEpoch,
@@ -1351,6 +1392,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		tpn.BlockTracker,
 		TestBlockSizeComputationHandler,
 		TestBalanceComputationHandler,
+		tpn.ChargingEpochHandler,
 	)
 	tpn.PreProcessorsContainer, _ = fact.Create()
 
@@ -1371,6 +1413,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 }
 
 func (tpn *TestProcessorNode) initMetaInnerProcessors() {
+	tpn.ChargingEpochHandler, _ = economics.NewChargingEpochHolder(0, tpn.EpochNotifier)
 	interimProcFactory, _ := metaProcess.NewIntermediateProcessorsContainerFactory(
 		tpn.ShardCoordinator,
 		TestMarshalizer,
@@ -1378,6 +1421,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		TestAddressPubkeyConverter,
 		tpn.Storage,
 		tpn.DataPool,
+		tpn.ChargingEpochHandler,
 	)
 
 	tpn.InterimProcContainer, _ = interimProcFactory.Create()
@@ -1389,6 +1433,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		tpn.Storage,
 		dataBlock.SmartContractResultBlock,
 		tpn.DataPool.CurrentBlockTxs(),
+		tpn.ChargingEpochHandler,
 	)
 
 	tpn.InterimProcContainer.Remove(dataBlock.SmartContractResultBlock)
@@ -1402,6 +1447,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		MapDNSAddresses: make(map[string]struct{}),
 		Marshalizer:     TestMarshalizer,
 		Accounts:        tpn.AccntState,
+		EpochNotifier:   tpn.EpochNotifier,
 	}
 	builtInFuncFactory, _ := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	builtInFuncs, _ := builtInFuncFactory.CreateBuiltInFunctionContainer()
@@ -1434,6 +1480,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		MessageSignVerifier: signVerifier,
 		GasSchedule:         gasSchedule,
 		NodesConfigProvider: tpn.NodesSetup,
+		NodesCoordinator:    tpn.NodesCoordinator,
 		Hasher:              TestHasher,
 		Marshalizer:         TestMarshalizer,
 		SystemSCConfig: &config.SystemSmartContractsConfig{
@@ -1515,6 +1562,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		BuiltInFunctions:               tpn.BlockchainHook.GetBuiltInFunctions(),
 		TxLogsProcessor:                &mock.TxLogsProcessorStub{},
 		BadTxForwarder:                 badBlocksHandler,
+		ChargingEpochHandler:           tpn.ChargingEpochHandler,
 		EpochNotifier:                  tpn.EpochNotifier,
 		BuiltinEnableEpoch:             tpn.BuiltinEnableEpoch,
 		DeployEnableEpoch:              tpn.DeployEnableEpoch,
@@ -1552,6 +1600,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		TestAddressPubkeyConverter,
 		TestBlockSizeComputationHandler,
 		TestBalanceComputationHandler,
+		tpn.ChargingEpochHandler,
 	)
 	tpn.PreProcessorsContainer, _ = fact.Create()
 
@@ -1806,12 +1855,14 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 				Marshalizer:                   TestMarshalizer,
 				DataPool:                      tpn.DataPool,
 				ProtocolSustainabilityAddress: testProtocolSustainabilityAddress,
-				NodesConfigProvider:           tpn.NodesCoordinator,
-				UserAccountsDB:                tpn.AccntState,
+				ProtocolSustainabilityAddresses: []config.ProtocolSustainabilityAddressConfig{
+					{Address: testProtocolSustainabilityAddress, Weight: 1},
+				},
+				NodesConfigProvider: tpn.NodesCoordinator,
+				UserAccountsDB:      tpn.AccntState,
 			},
 			StakingDataProvider:   stakingDataProvider,
-			TopUpGradientPoint:    tpn.EconomicsData.RewardsTopUpGradientPoint(),
-			TopUpRewardFactor:     tpn.EconomicsData.RewardsTopUpFactor(),
+			TopUpRewardsSettings:  tpn.EconomicsData,
 			EconomicsDataProvider: economicsDataProvider,
 			EpochEnableV2:         StakingV2Epoch,
 		}
@@ -1856,6 +1907,7 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 			EpochValidatorInfoCreator:    epochStartValidatorInfo,
 			ValidatorStatisticsProcessor: tpn.ValidatorStatisticsProcessor,
 			EpochSystemSCProcessor:       epochStartSystemSCProcessor,
+			CrossShardStatistics:         &testscommon.CrossShardStatisticsStub{},
 		}
 
 		tpn.BlockProcessor, err = block.NewMetaProcessor(arguments)
@@ -2439,6 +2491,7 @@ func (tpn *TestProcessorNode) createHeartbeatWithHardforkTrigger(heartbeatPk str
 	err = tpn.Node.ApplyOptions(
 		node.WithHardforkTrigger(hardforkTrigger),
 		node.WithPeerSignatureHandler(psh),
+		node.WithManagedPeersHolder(&testscommon.ManagedPeersHolderStub{}),
 		node.WithInputAntifloodHandler(&mock.NilAntifloodHandler{}),
 		node.WithValidatorStatistics(&mock.ValidatorStatisticsProcessorStub{
 			GetValidatorInfoForRootHashCalled: func(_ []byte) (map[uint32][]*state.ValidatorInfo, error) {
@@ -2450,15 +2503,20 @@ func (tpn *TestProcessorNode) createHeartbeatWithHardforkTrigger(heartbeatPk str
 		node.WithEpochStartEventNotifier(tpn.EpochStartNotifier),
 		node.WithEpochStartTrigger(tpn.EpochStartTrigger),
 		node.WithValidatorsProvider(&mock.ValidatorsProviderStub{}),
+		node.WithForkDetector(&mock.ForkDetectorStub{}),
 	)
 	log.LogIfError(err)
 
 	hbConfig := config.HeartbeatConfig{
-		MinTimeToWaitBetweenBroadcastsInSec: 4,
-		MaxTimeToWaitBetweenBroadcastsInSec: 6,
-		DurationToConsiderUnresponsiveInSec: 60,
-		HeartbeatRefreshIntervalInSec:       5,
-		HideInactiveValidatorIntervalInSec:  600,
+		MinTimeToWaitBetweenBroadcastsInSec:     4,
+		MaxTimeToWaitBetweenBroadcastsInSec:     6,
+		DurationToConsiderUnresponsiveInSec:     60,
+		HideInactiveValidatorIntervalInSec:      600,
+		PeerAuthenticationTimeBetweenSendsInSec: 60,
+		MaxTimeDifferenceInSec:                  60,
+		PeerAuthenticationCache:                 config.CacheConfig{Type: "LRU", Capacity: 1000},
+		VerifiedPayloadsCache:                   config.CacheConfig{Type: "LRU", Capacity: 1000},
+		HeartbeatCache:                          config.CacheConfig{Type: "LRU", Capacity: 1000},
 	}
 	err = tpn.Node.StartHeartbeat(hbConfig, "test", config.PreferencesConfig{})
 	log.LogIfError(err)
@@ -112,7 +112,7 @@ func NewTestProcessorNodeWithStateCheckpointModulus(
 	tpn.initBlockTracker()
 	tpn.initInterceptors()
 	tpn.initInnerProcessors(arwenConfig.MakeGasMapForTests())
-	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.BlockChain)
+	tpn.SCQueryService, _ = smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{VmContainer: tpn.VMContainer, EconomicsFee: tpn.EconomicsData, BlockChainHook: tpn.BlockchainHook, BlockChain: tpn.BlockChain})
 	tpn.initBlockProcessor(stateCheckpointModulus)
 	tpn.BroadcastMessenger, _ = sposFactory.GetBroadcastMessenger(
 		TestMarshalizer,
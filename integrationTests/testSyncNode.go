@@ -133,7 +133,7 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 	tpn.initBootstrapper()
 	tpn.setGenesisBlock()
 	tpn.initNode()
-	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.BlockChain)
+	tpn.SCQueryService, _ = smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{VmContainer: tpn.VMContainer, EconomicsFee: tpn.EconomicsData, BlockChainHook: tpn.BlockchainHook, BlockChain: tpn.BlockChain})
 	tpn.addHandlersForCounters()
 	tpn.addGenesisBlocksIntoStorage()
 }
@@ -206,6 +206,7 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 			EpochValidatorInfoCreator:    &mock.EpochValidatorInfoCreatorStub{},
 			ValidatorStatisticsProcessor: &mock.ValidatorStatisticsProcessorStub{},
 			EpochSystemSCProcessor:       &mock.EpochStartSystemSCStub{},
+			CrossShardStatistics:         &testscommon.CrossShardStatisticsStub{},
 		}
 
 		tpn.BlockProcessor, err = block.NewMetaProcessor(arguments)
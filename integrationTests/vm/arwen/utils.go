@@ -130,7 +130,7 @@ func SetupTestContext(t *testing.T) *TestContext {
 	context.initVMAndBlockchainHook()
 	context.initTxProcessorWithOneSCExecutorWithVMs()
 	context.ScAddress, _ = context.BlockchainHook.NewAddress(context.Owner.Address, context.Owner.Nonce, factory.ArwenVirtualMachine)
-	context.QueryService, _ = smartContract.NewSCQueryService(context.VMContainer, context.EconomicsFee, context.BlockchainHook, &mock.BlockChainMock{})
+	context.QueryService, _ = smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{VmContainer: context.VMContainer, EconomicsFee: context.EconomicsFee, BlockChainHook: context.BlockchainHook, BlockChain: &mock.BlockChainMock{}})
 
 	context.RewardsProcessor, err = rewardTransaction.NewRewardTxProcessor(context.Accounts, pkConverter, oneShardCoordinator)
 	require.Nil(t, err)
@@ -197,6 +197,7 @@ func (context *TestContext) initVMAndBlockchainHook() {
 		MapDNSAddresses: DNSAddresses,
 		Marshalizer:     marshalizer,
 		Accounts:        context.Accounts,
+		EpochNotifier:   forking.NewGenericEpochNotifier(),
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	require.Nil(context.T, err)
@@ -256,6 +257,7 @@ func (context *TestContext) initTxProcessorWithOneSCExecutorWithVMs() {
 	defaults.FillGasMapInternal(gasSchedule, 1)
 
 	context.SCRForwarder = &mock.IntermediateTransactionHandlerMock{}
+	chargingEpochHandler, _ := economics.NewChargingEpochHolder(0, forking.NewGenericEpochNotifier())
 	argsNewSCProcessor := smartContract.ArgsNewSmartContractProcessor{
 		VmContainer:      context.VMContainer,
 		ArgsParser:       smartContract.NewArgumentParser(),
@@ -273,10 +275,11 @@ func (context *TestContext) initTxProcessorWithOneSCExecutorWithVMs() {
 		GasHandler: &mock.GasHandlerMock{
 			SetGasRefundedCalled: func(gasRefunded uint64, hash []byte) {},
 		},
-		GasSchedule:      mock.NewGasScheduleNotifierMock(gasSchedule),
-		BuiltInFunctions: context.BlockchainHook.GetBuiltInFunctions(),
-		TxLogsProcessor:  &mock.TxLogsProcessorStub{},
-		EpochNotifier:    forking.NewGenericEpochNotifier(),
+		GasSchedule:          mock.NewGasScheduleNotifierMock(gasSchedule),
+		BuiltInFunctions:     context.BlockchainHook.GetBuiltInFunctions(),
+		TxLogsProcessor:      &mock.TxLogsProcessorStub{},
+		ChargingEpochHandler: chargingEpochHandler,
+		EpochNotifier:        forking.NewGenericEpochNotifier(),
 	}
 
 	context.ScProcessor, err = smartContract.NewSmartContractProcessor(argsNewSCProcessor)
@@ -25,14 +25,16 @@ func TestVmGetShouldReturnValue(t *testing.T) {
 		GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 			return mockVM, nil
 		}}
-	service, _ := smartContract.NewSCQueryService(vmContainer, &mock.FeeHandlerStub{
-		MaxGasLimitPerBlockCalled: func() uint64 {
-			return uint64(math.MaxUint64)
+	service, _ := smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{
+		VmContainer: vmContainer,
+		EconomicsFee: &mock.FeeHandlerStub{
+			MaxGasLimitPerBlockCalled: func() uint64 {
+				return uint64(math.MaxUint64)
+			},
 		},
-	},
-		&mock.BlockChainHookHandlerMock{},
-		&mock.BlockChainMock{},
-	)
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	functionName := "Get"
 	query := process.SCQuery{
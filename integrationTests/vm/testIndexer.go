@@ -44,7 +44,7 @@ func CreateTestIndexer(
 		mutex:       sync.RWMutex{},
 	}
 
-	dispatcher, err := indexer.NewDataDispatcher(100)
+	dispatcher, err := indexer.NewDataDispatcher(indexer.ArgsDataDispatcher{CacheSize: 100})
 	require.Nil(t, err)
 
 	dispatcher.StartIndexData()
@@ -275,6 +275,7 @@ func CreateTxProcessorWithOneSCExecutorMockVM(
 	defaults.FillGasMapInternal(gasSchedule, 1)
 
 	economicsData := createEconomicsData(tb, argEnableEpoch.PenalizedTooMuchGasEnableEpoch)
+	chargingEpochHandler, _ := economics.NewChargingEpochHolder(0, forking.NewGenericEpochNotifier())
 	argsNewSCProcessor := smartContract.ArgsNewSmartContractProcessor{
 		VmContainer:      vmContainer,
 		ArgsParser:       smartContract.NewArgumentParser(),
@@ -295,6 +296,7 @@ func CreateTxProcessorWithOneSCExecutorMockVM(
 		GasSchedule:                    mock.NewGasScheduleNotifierMock(gasSchedule),
 		BuiltInFunctions:               blockChainHook.GetBuiltInFunctions(),
 		TxLogsProcessor:                &mock.TxLogsProcessorStub{},
+		ChargingEpochHandler:           chargingEpochHandler,
 		EpochNotifier:                  forking.NewGenericEpochNotifier(),
 		PenalizedTooMuchGasEnableEpoch: argEnableEpoch.PenalizedTooMuchGasEnableEpoch,
 		BuiltinEnableEpoch:             argEnableEpoch.BuiltinEnableEpoch,
@@ -367,8 +369,9 @@ func CreateVMAndBlockchainHook(
 		MapDNSAddresses: map[string]struct{}{
 			string(dnsAddr): {},
 		},
-		Marshalizer: testMarshalizer,
-		Accounts:    accnts,
+		Marshalizer:   testMarshalizer,
+		Accounts:      accnts,
+		EpochNotifier: forking.NewGenericEpochNotifier(),
 	}
 	builtInFuncFactory, _ := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	builtInFuncs, _ := builtInFuncFactory.CreateBuiltInFunctionContainer()
@@ -440,6 +443,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 	gasComp, _ := preprocess.NewGasComputation(economicsData, txTypeHandler, forking.NewGenericEpochNotifier(), argEnableEpoch.DeployEnableEpoch)
 
 	intermediateTxHandler := &mock.IntermediateTransactionHandlerMock{}
+	chargingEpochHandler, _ := economics.NewChargingEpochHolder(0, forking.NewGenericEpochNotifier())
 	argsNewSCProcessor := smartContract.ArgsNewSmartContractProcessor{
 		VmContainer:                    vmContainer,
 		ArgsParser:                     smartContract.NewArgumentParser(),
@@ -458,6 +462,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		GasSchedule:                    mock.NewGasScheduleNotifierMock(gasSchedule),
 		BuiltInFunctions:               blockChainHook.GetBuiltInFunctions(),
 		TxLogsProcessor:                &mock.TxLogsProcessorStub{},
+		ChargingEpochHandler:           chargingEpochHandler,
 		EpochNotifier:                  forking.NewGenericEpochNotifier(),
 		PenalizedTooMuchGasEnableEpoch: argEnableEpoch.PenalizedTooMuchGasEnableEpoch,
 		DeployEnableEpoch:              argEnableEpoch.DeployEnableEpoch,
@@ -753,7 +758,7 @@ func GetVmOutput(gasSchedule map[string]map[string]uint64, accnts state.Accounts
 		},
 	}
 
-	scQueryService, _ := smartContract.NewSCQueryService(vmContainer, feeHandler, blockChainHook, &mock.BlockChainMock{})
+	scQueryService, _ := smartContract.NewSCQueryService(smartContract.ArgsNewSCQueryService{VmContainer: vmContainer, EconomicsFee: feeHandler, BlockChainHook: blockChainHook, BlockChain: &mock.BlockChainMock{}})
 
 	vmOutput, err := scQueryService.ExecuteQuery(&process.SCQuery{
 		ScAddress: scAddressBytes,
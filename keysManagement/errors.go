@@ -0,0 +1,30 @@
+package keysManagement
+
+import "errors"
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilShareSigner signals that a nil signature share signer has been provided
+var ErrNilShareSigner = errors.New("nil signature share signer")
+
+// ErrDuplicatedKey signals that a key is already managed by the current node
+var ErrDuplicatedKey = errors.New("duplicated key")
+
+// ErrMissingPublicKeyDefinition signals that a public key is not managed by the current node
+var ErrMissingPublicKeyDefinition = errors.New("missing public key definition")
+
+// ErrNilPassphraseProvider signals that a nil passphrase provider has been provided
+var ErrNilPassphraseProvider = errors.New("nil passphrase provider")
+
+// ErrNilManagedKeysAdder signals that a nil managed keys adder has been provided
+var ErrNilManagedKeysAdder = errors.New("nil managed keys adder")
+
+// ErrNilNodesCoordinator signals that a nil nodes coordinator has been provided
+var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrEmptyKeyFile signals that an empty key file name has been provided
+var ErrEmptyKeyFile = errors.New("empty key file name")
+
+// ErrNilPrivateKey signals that a nil private key has been provided
+var ErrNilPrivateKey = errors.New("nil private key")
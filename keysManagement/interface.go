@@ -0,0 +1,16 @@
+package keysManagement
+
+import "github.com/ElrondNetwork/elrond-go/sharding"
+
+// ManagedKeysAdder defines the component able to start managing a new validator key at runtime
+type ManagedKeysAdder interface {
+	AddManagedPeer(privateKeyBytes []byte) error
+	IsKeyManagedByCurrentNode(pkBytes []byte) bool
+	IsInterfaceNil() bool
+}
+
+// NodesCoordinator defines the nodes coordinator operations needed to find out when a key became a validator
+type NodesCoordinator interface {
+	GetValidatorWithPublicKey(publicKey []byte) (validator sharding.Validator, shardId uint32, err error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,226 @@
+package keysManagement
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+var log = logger.GetOrCreate("keysManagement")
+
+// AllKeysQuery is the search string that returns the consensus activity of all the managed keys
+const AllKeysQuery = "*"
+
+// ArgsManagedPeersHolder is the DTO used to create a new instance of managedPeersHolder
+type ArgsManagedPeersHolder struct {
+	KeyGenerator crypto.KeyGenerator
+	ShareSigner  crypto.LowLevelSignerBLS
+}
+
+// KeyMetrics holds the consensus activity of a managed key
+type KeyMetrics struct {
+	PublicKey         string `json:"publicKey"`
+	RoundsInConsensus uint64 `json:"roundsInConsensus"`
+	RoundsAsLeader    uint64 `json:"roundsAsLeader"`
+	SignaturesSent    uint64 `json:"signaturesSent"`
+}
+
+type peerInfo struct {
+	privateKey crypto.PrivateKey
+	metrics    KeyMetrics
+}
+
+// managedPeersHolder holds the validator keys operated by the current node, besides its own key, so that a single
+// process can participate in consensus for each of its keys that gets selected. It also records the consensus
+// activity of each managed key
+type managedPeersHolder struct {
+	keyGenerator crypto.KeyGenerator
+	shareSigner  crypto.LowLevelSignerBLS
+
+	mutPeers sync.RWMutex
+	peers    map[string]*peerInfo
+}
+
+// NewManagedPeersHolder creates a new managed peers holder
+func NewManagedPeersHolder(args ArgsManagedPeersHolder) (*managedPeersHolder, error) {
+	if check.IfNil(args.KeyGenerator) {
+		return nil, ErrNilKeyGenerator
+	}
+	if args.ShareSigner == nil {
+		return nil, ErrNilShareSigner
+	}
+
+	return &managedPeersHolder{
+		keyGenerator: args.KeyGenerator,
+		shareSigner:  args.ShareSigner,
+		peers:        make(map[string]*peerInfo),
+	}, nil
+}
+
+// AddManagedPeer adds the key corresponding to the provided private key bytes to the keys managed by the current node
+func (holder *managedPeersHolder) AddManagedPeer(privateKeyBytes []byte) error {
+	privateKey, err := holder.keyGenerator.PrivateKeyFromByteArray(privateKeyBytes)
+	if err != nil {
+		return fmt.Errorf("%w for the private key", err)
+	}
+
+	return holder.AddManagedPrivateKey(privateKey)
+}
+
+// AddManagedPrivateKey adds the provided private key to the keys managed by the current node. The key does not need to
+// hold its secret key, e.g. it can be a key whose signatures are created by remote signers
+func (holder *managedPeersHolder) AddManagedPrivateKey(privateKey crypto.PrivateKey) error {
+	if check.IfNil(privateKey) {
+		return ErrNilPrivateKey
+	}
+
+	publicKeyBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return fmt.Errorf("%w for the public key", err)
+	}
+
+	holder.mutPeers.Lock()
+	defer holder.mutPeers.Unlock()
+
+	_, found := holder.peers[string(publicKeyBytes)]
+	if found {
+		return fmt.Errorf("%w for public key %s", ErrDuplicatedKey, hex.EncodeToString(publicKeyBytes))
+	}
+
+	holder.peers[string(publicKeyBytes)] = &peerInfo{
+		privateKey: privateKey,
+		metrics: KeyMetrics{
+			PublicKey: hex.EncodeToString(publicKeyBytes),
+		},
+	}
+
+	log.Debug("added managed key", "public key", publicKeyBytes)
+
+	return nil
+}
+
+// IsKeyManagedByCurrentNode returns true if the provided public key is managed by the current node
+func (holder *managedPeersHolder) IsKeyManagedByCurrentNode(pkBytes []byte) bool {
+	holder.mutPeers.RLock()
+	defer holder.mutPeers.RUnlock()
+
+	_, found := holder.peers[string(pkBytes)]
+
+	return found
+}
+
+// GetPrivateKey returns the private key of the provided managed public key
+func (holder *managedPeersHolder) GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
+	holder.mutPeers.RLock()
+	defer holder.mutPeers.RUnlock()
+
+	peer, found := holder.peers[string(pkBytes)]
+	if !found {
+		return nil, fmt.Errorf("%w for public key %s", ErrMissingPublicKeyDefinition, hex.EncodeToString(pkBytes))
+	}
+
+	return peer.privateKey, nil
+}
+
+// GetManagedKeysByCurrentNode returns the private keys of all the managed keys, mapped by their public keys
+func (holder *managedPeersHolder) GetManagedKeysByCurrentNode() map[string]crypto.PrivateKey {
+	holder.mutPeers.RLock()
+	defer holder.mutPeers.RUnlock()
+
+	keys := make(map[string]crypto.PrivateKey, len(holder.peers))
+	for pk, peer := range holder.peers {
+		keys[pk] = peer.privateKey
+	}
+
+	return keys
+}
+
+// CreateSignatureShare creates the BLS signature share of the provided managed key over the provided message
+func (holder *managedPeersHolder) CreateSignatureShare(pkBytes []byte, message []byte) ([]byte, error) {
+	privateKey, err := holder.GetPrivateKey(pkBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return holder.shareSigner.SignShare(privateKey, message)
+}
+
+// IncrementRoundsInConsensus records that the provided managed key was selected in the consensus group of a round
+func (holder *managedPeersHolder) IncrementRoundsInConsensus(pkBytes []byte) {
+	holder.updateMetrics(pkBytes, func(metrics *KeyMetrics) {
+		metrics.RoundsInConsensus++
+	})
+}
+
+// IncrementRoundsAsLeader records that the provided managed key was the leader of a round
+func (holder *managedPeersHolder) IncrementRoundsAsLeader(pkBytes []byte) {
+	holder.updateMetrics(pkBytes, func(metrics *KeyMetrics) {
+		metrics.RoundsAsLeader++
+	})
+}
+
+// IncrementSignaturesSent records that a signature share was sent for the provided managed key
+func (holder *managedPeersHolder) IncrementSignaturesSent(pkBytes []byte) {
+	holder.updateMetrics(pkBytes, func(metrics *KeyMetrics) {
+		metrics.SignaturesSent++
+	})
+}
+
+func (holder *managedPeersHolder) updateMetrics(pkBytes []byte, handler func(metrics *KeyMetrics)) {
+	holder.mutPeers.Lock()
+	defer holder.mutPeers.Unlock()
+
+	peer, found := holder.peers[string(pkBytes)]
+	if !found {
+		return
+	}
+
+	handler(&peer.metrics)
+}
+
+// GetManagedKeysMetrics returns the consensus activity of all the managed keys, sorted by public key
+func (holder *managedPeersHolder) GetManagedKeysMetrics() []KeyMetrics {
+	holder.mutPeers.RLock()
+	allMetrics := make([]KeyMetrics, 0, len(holder.peers))
+	for _, peer := range holder.peers {
+		allMetrics = append(allMetrics, peer.metrics)
+	}
+	holder.mutPeers.RUnlock()
+
+	sort.Slice(allMetrics, func(i, j int) bool {
+		return allMetrics[i].PublicKey < allMetrics[j].PublicKey
+	})
+
+	return allMetrics
+}
+
+// Query returns the consensus activity of the managed keys, as JSON lines. The AllKeysQuery search returns all the
+// managed keys while a hex encoded public key returns only the corresponding key
+func (holder *managedPeersHolder) Query(search string) []string {
+	lines := make([]string, 0)
+	for _, metrics := range holder.GetManagedKeysMetrics() {
+		if search != AllKeysQuery && search != metrics.PublicKey {
+			continue
+		}
+
+		buff, err := json.Marshal(metrics)
+		if err != nil {
+			log.Debug("managedPeersHolder.Query", "public key", metrics.PublicKey, "error", err.Error())
+			continue
+		}
+		lines = append(lines, string(buff))
+	}
+
+	return lines
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (holder *managedPeersHolder) IsInterfaceNil() bool {
+	return holder == nil
+}
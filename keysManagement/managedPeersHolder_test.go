@@ -0,0 +1,177 @@
+package keysManagement
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	mclMultiSig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/multisig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/multisig"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsManagedPeersHolder() ArgsManagedPeersHolder {
+	return ArgsManagedPeersHolder{
+		KeyGenerator: signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		ShareSigner:  &mclMultiSig.BlsMultiSigner{Hasher: &blake2b.Blake2b{HashSize: multisig.BlsHashSize}},
+	}
+}
+
+func generateKey(t *testing.T, keyGen crypto.KeyGenerator) ([]byte, []byte) {
+	sk, pk := keyGen.GeneratePair()
+	skBytes, err := sk.ToByteArray()
+	require.Nil(t, err)
+	pkBytes, err := pk.ToByteArray()
+	require.Nil(t, err)
+
+	return skBytes, pkBytes
+}
+
+func TestNewManagedPeersHolder_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsManagedPeersHolder()
+	args.KeyGenerator = nil
+	holder, err := NewManagedPeersHolder(args)
+	assert.True(t, check.IfNil(holder))
+	assert.Equal(t, ErrNilKeyGenerator, err)
+
+	args = createMockArgsManagedPeersHolder()
+	args.ShareSigner = nil
+	holder, err = NewManagedPeersHolder(args)
+	assert.True(t, check.IfNil(holder))
+	assert.Equal(t, ErrNilShareSigner, err)
+}
+
+func TestNewManagedPeersHolder_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	holder, err := NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+	assert.False(t, check.IfNil(holder))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(holder.GetManagedKeysByCurrentNode()))
+}
+
+func TestManagedPeersHolder_AddManagedPeer(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsManagedPeersHolder()
+	holder, _ := NewManagedPeersHolder(args)
+
+	err := holder.AddManagedPeer([]byte("invalid private key"))
+	assert.NotNil(t, err)
+
+	skBytes, pkBytes := generateKey(t, args.KeyGenerator)
+	err = holder.AddManagedPeer(skBytes)
+	assert.Nil(t, err)
+	assert.True(t, holder.IsKeyManagedByCurrentNode(pkBytes))
+	assert.False(t, holder.IsKeyManagedByCurrentNode([]byte("other public key")))
+
+	err = holder.AddManagedPeer(skBytes)
+	assert.True(t, errors.Is(err, ErrDuplicatedKey))
+
+	privateKey, err := holder.GetPrivateKey(pkBytes)
+	assert.Nil(t, err)
+	recoveredSkBytes, _ := privateKey.ToByteArray()
+	assert.Equal(t, skBytes, recoveredSkBytes)
+
+	keys := holder.GetManagedKeysByCurrentNode()
+	assert.Equal(t, 1, len(keys))
+	assert.Equal(t, privateKey, keys[string(pkBytes)])
+}
+
+func TestManagedPeersHolder_AddManagedPrivateKey(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsManagedPeersHolder()
+	holder, _ := NewManagedPeersHolder(args)
+
+	err := holder.AddManagedPrivateKey(nil)
+	assert.Equal(t, ErrNilPrivateKey, err)
+
+	privateKey, publicKey := args.KeyGenerator.GeneratePair()
+	pkBytes, _ := publicKey.ToByteArray()
+	err = holder.AddManagedPrivateKey(privateKey)
+	assert.Nil(t, err)
+	assert.True(t, holder.IsKeyManagedByCurrentNode(pkBytes))
+
+	err = holder.AddManagedPrivateKey(privateKey)
+	assert.True(t, errors.Is(err, ErrDuplicatedKey))
+
+	recoveredPrivateKey, err := holder.GetPrivateKey(pkBytes)
+	assert.Nil(t, err)
+	assert.Equal(t, privateKey, recoveredPrivateKey)
+}
+
+func TestManagedPeersHolder_GetPrivateKeyNotManagedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	holder, _ := NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+
+	privateKey, err := holder.GetPrivateKey([]byte("public key"))
+	assert.Nil(t, privateKey)
+	assert.True(t, errors.Is(err, ErrMissingPublicKeyDefinition))
+}
+
+func TestManagedPeersHolder_CreateSignatureShare(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsManagedPeersHolder()
+	holder, _ := NewManagedPeersHolder(args)
+	skBytes, pkBytes := generateKey(t, args.KeyGenerator)
+	_ = holder.AddManagedPeer(skBytes)
+
+	message := []byte("header hash")
+	share, err := holder.CreateSignatureShare([]byte("not managed"), message)
+	assert.Nil(t, share)
+	assert.True(t, errors.Is(err, ErrMissingPublicKeyDefinition))
+
+	share, err = holder.CreateSignatureShare(pkBytes, message)
+	assert.Nil(t, err)
+
+	publicKey, _ := args.KeyGenerator.PublicKeyFromByteArray(pkBytes)
+	assert.Nil(t, args.ShareSigner.VerifySigShare(publicKey, message, share))
+}
+
+func TestManagedPeersHolder_MetricsAndQuery(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsManagedPeersHolder()
+	holder, _ := NewManagedPeersHolder(args)
+	skBytes1, pkBytes1 := generateKey(t, args.KeyGenerator)
+	skBytes2, pkBytes2 := generateKey(t, args.KeyGenerator)
+	_ = holder.AddManagedPeer(skBytes1)
+	_ = holder.AddManagedPeer(skBytes2)
+
+	holder.IncrementRoundsInConsensus(pkBytes1)
+	holder.IncrementRoundsInConsensus(pkBytes1)
+	holder.IncrementRoundsAsLeader(pkBytes1)
+	holder.IncrementSignaturesSent(pkBytes2)
+	holder.IncrementSignaturesSent([]byte("not managed"))
+
+	allMetrics := holder.GetManagedKeysMetrics()
+	require.Equal(t, 2, len(allMetrics))
+	for _, metrics := range allMetrics {
+		switch metrics.PublicKey {
+		case hex.EncodeToString(pkBytes1):
+			assert.Equal(t, KeyMetrics{PublicKey: metrics.PublicKey, RoundsInConsensus: 2, RoundsAsLeader: 1}, metrics)
+		case hex.EncodeToString(pkBytes2):
+			assert.Equal(t, KeyMetrics{PublicKey: metrics.PublicKey, SignaturesSent: 1}, metrics)
+		default:
+			assert.Fail(t, "unexpected public key "+metrics.PublicKey)
+		}
+	}
+
+	assert.Equal(t, 2, len(holder.Query(AllKeysQuery)))
+	lines := holder.Query(hex.EncodeToString(pkBytes1))
+	require.Equal(t, 1, len(lines))
+	assert.True(t, strings.Contains(lines[0], `"roundsInConsensus":2`))
+	assert.Equal(t, 0, len(holder.Query("unknown")))
+}
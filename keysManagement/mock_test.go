@@ -0,0 +1,41 @@
+package keysManagement
+
+import (
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+type nodesCoordinatorStub struct {
+	GetValidatorWithPublicKeyCalled func(publicKey []byte) (sharding.Validator, uint32, error)
+}
+
+// GetValidatorWithPublicKey -
+func (stub *nodesCoordinatorStub) GetValidatorWithPublicKey(publicKey []byte) (sharding.Validator, uint32, error) {
+	if stub.GetValidatorWithPublicKeyCalled != nil {
+		return stub.GetValidatorWithPublicKeyCalled(publicKey)
+	}
+
+	return nil, 0, sharding.ErrValidatorNotFound
+}
+
+// IsInterfaceNil -
+func (stub *nodesCoordinatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+type passphraseProviderStub struct {
+	PassphraseCalled func(fileName string) ([]byte, error)
+}
+
+// Passphrase -
+func (stub *passphraseProviderStub) Passphrase(fileName string) ([]byte, error) {
+	if stub.PassphraseCalled != nil {
+		return stub.PassphraseCalled(fileName)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *passphraseProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,159 @@
+package keysManagement
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/keystore"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// ArgsRotatedKeyWatcher is the DTO used to create a new instance of rotatedKeyWatcher
+type ArgsRotatedKeyWatcher struct {
+	KeyFile            string
+	OwnPublicKey       []byte
+	PassphraseProvider keystore.PassphraseProvider
+	ManagedKeysAdder   ManagedKeysAdder
+	NodesCoordinator   NodesCoordinator
+}
+
+// rotatedKeyWatcher loads the BLS key generated by the rotate-key command in the managed keys, so that the node keeps
+// validating, without restarting, once the validator system SC replaces its old key at an end of epoch
+type rotatedKeyWatcher struct {
+	keyFile            string
+	ownPublicKey       []byte
+	passphraseProvider keystore.PassphraseProvider
+	managedKeysAdder   ManagedKeysAdder
+	nodesCoordinator   NodesCoordinator
+
+	mutWatcher       sync.Mutex
+	lastModTime      time.Time
+	rotatedPublicKey []byte
+	isValidator      bool
+}
+
+// NewRotatedKeyWatcher creates a new rotated key watcher
+func NewRotatedKeyWatcher(args ArgsRotatedKeyWatcher) (*rotatedKeyWatcher, error) {
+	if len(args.KeyFile) == 0 {
+		return nil, ErrEmptyKeyFile
+	}
+	if check.IfNil(args.PassphraseProvider) {
+		return nil, ErrNilPassphraseProvider
+	}
+	if check.IfNil(args.ManagedKeysAdder) {
+		return nil, ErrNilManagedKeysAdder
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, ErrNilNodesCoordinator
+	}
+
+	return &rotatedKeyWatcher{
+		keyFile:            args.KeyFile,
+		ownPublicKey:       args.OwnPublicKey,
+		passphraseProvider: args.PassphraseProvider,
+		managedKeysAdder:   args.ManagedKeysAdder,
+		nodesCoordinator:   args.NodesCoordinator,
+	}, nil
+}
+
+// LoadRotatedKey adds the key held by the rotated key file, if the file exists, to the managed keys. The file is only
+// read again after it changes
+func (rkw *rotatedKeyWatcher) LoadRotatedKey() error {
+	fileInfo, err := os.Stat(rkw.keyFile)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	rkw.mutWatcher.Lock()
+	defer rkw.mutWatcher.Unlock()
+
+	if fileInfo.ModTime().Equal(rkw.lastModTime) {
+		return nil
+	}
+
+	encodedSk, encodedPk, err := keystore.LoadSkPkFromFile(rkw.keyFile, 0, rkw.passphraseProvider)
+	if err != nil {
+		return err
+	}
+	skBytes, err := hex.DecodeString(string(encodedSk))
+	if err != nil {
+		return fmt.Errorf("%w for the encoded secret key of the rotated key file %s", err, rkw.keyFile)
+	}
+	pkBytes, err := hex.DecodeString(encodedPk)
+	if err != nil {
+		return fmt.Errorf("%w for the encoded public key of the rotated key file %s", err, rkw.keyFile)
+	}
+
+	// the rotated key file is left in place once it became the validator key file
+	isAlreadyOperated := bytes.Equal(pkBytes, rkw.ownPublicKey) || rkw.managedKeysAdder.IsKeyManagedByCurrentNode(pkBytes)
+	if !isAlreadyOperated {
+		err = rkw.managedKeysAdder.AddManagedPeer(skBytes)
+		if err != nil {
+			return err
+		}
+
+		log.Info("rotated validator key loaded", "file", rkw.keyFile, "public key", pkBytes)
+	}
+
+	rkw.lastModTime = fileInfo.ModTime()
+	if !bytes.Equal(pkBytes, rkw.rotatedPublicKey) {
+		rkw.rotatedPublicKey = pkBytes
+		rkw.isValidator = false
+	}
+
+	return nil
+}
+
+// EpochStartAction reports the epoch from which the rotated key took the place of the old key
+func (rkw *rotatedKeyWatcher) EpochStartAction(hdr data.HeaderHandler) {
+	if check.IfNil(hdr) {
+		return
+	}
+
+	rkw.mutWatcher.Lock()
+	defer rkw.mutWatcher.Unlock()
+
+	if len(rkw.rotatedPublicKey) == 0 || rkw.isValidator || bytes.Equal(rkw.rotatedPublicKey, rkw.ownPublicKey) {
+		return
+	}
+
+	_, shardID, err := rkw.nodesCoordinator.GetValidatorWithPublicKey(rkw.rotatedPublicKey)
+	if err != nil {
+		return
+	}
+
+	rkw.isValidator = true
+	log.Info("the rotated validator key took the place of the old key",
+		"epoch", hdr.GetEpoch(),
+		"public key", rkw.rotatedPublicKey,
+		"shard", shardID)
+	log.Warn("the node validates with the rotated key as a managed key, make the rotated key file the validator key "+
+		"file before the next restart", "rotated key file", rkw.keyFile)
+}
+
+// EpochStartPrepare loads the rotated key, so it is managed before the epoch in which the swap is applied begins
+func (rkw *rotatedKeyWatcher) EpochStartPrepare(_ data.HeaderHandler, _ data.BodyHandler) {
+	err := rkw.LoadRotatedKey()
+	if err != nil {
+		log.Error("cannot load the rotated validator key", "file", rkw.keyFile, "error", err)
+	}
+}
+
+// NotifyOrder returns the notification order for a start of epoch event
+func (rkw *rotatedKeyWatcher) NotifyOrder() uint32 {
+	return core.KeyRotationOrder
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rkw *rotatedKeyWatcher) IsInterfaceNil() bool {
+	return rkw == nil
+}
@@ -0,0 +1,228 @@
+package keysManagement
+
+import (
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsRotatedKeyWatcher() ArgsRotatedKeyWatcher {
+	return ArgsRotatedKeyWatcher{
+		KeyFile:            "rotatedValidatorKey.pem",
+		OwnPublicKey:       []byte("own public key"),
+		PassphraseProvider: &passphraseProviderStub{},
+		ManagedKeysAdder:   &testscommon.ManagedPeersHolderStub{},
+		NodesCoordinator:   &nodesCoordinatorStub{},
+	}
+}
+
+func writeRotatedKeyFile(t *testing.T, dir string) (string, []byte, []byte) {
+	skBytes, pkBytes := generateKey(t, signing.NewKeyGenerator(mcl.NewSuiteBLS12()))
+
+	fileName := filepath.Join(dir, "rotatedValidatorKey.pem")
+	file, err := os.Create(fileName)
+	require.Nil(t, err)
+	err = core.SaveSkToPemFile(file, hex.EncodeToString(pkBytes), []byte(hex.EncodeToString(skBytes)))
+	require.Nil(t, err)
+	require.Nil(t, file.Close())
+
+	return fileName, skBytes, pkBytes
+}
+
+func TestNewRotatedKeyWatcher_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRotatedKeyWatcher()
+	args.KeyFile = ""
+	rkw, err := NewRotatedKeyWatcher(args)
+	assert.True(t, check.IfNil(rkw))
+	assert.Equal(t, ErrEmptyKeyFile, err)
+
+	args = createMockArgsRotatedKeyWatcher()
+	args.PassphraseProvider = nil
+	rkw, err = NewRotatedKeyWatcher(args)
+	assert.True(t, check.IfNil(rkw))
+	assert.Equal(t, ErrNilPassphraseProvider, err)
+
+	args = createMockArgsRotatedKeyWatcher()
+	args.ManagedKeysAdder = nil
+	rkw, err = NewRotatedKeyWatcher(args)
+	assert.True(t, check.IfNil(rkw))
+	assert.Equal(t, ErrNilManagedKeysAdder, err)
+
+	args = createMockArgsRotatedKeyWatcher()
+	args.NodesCoordinator = nil
+	rkw, err = NewRotatedKeyWatcher(args)
+	assert.True(t, check.IfNil(rkw))
+	assert.Equal(t, ErrNilNodesCoordinator, err)
+
+	args = createMockArgsRotatedKeyWatcher()
+	rkw, err = NewRotatedKeyWatcher(args)
+	assert.False(t, check.IfNil(rkw))
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(core.KeyRotationOrder), rkw.NotifyOrder())
+}
+
+func TestRotatedKeyWatcher_LoadRotatedKeyMissingFileShouldNotAddKey(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "rotatedKeyWatcher")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockArgsRotatedKeyWatcher()
+	args.KeyFile = filepath.Join(dir, "missing.pem")
+	args.ManagedKeysAdder = &testscommon.ManagedPeersHolderStub{
+		AddManagedPeerCalled: func(privateKeyBytes []byte) error {
+			assert.Fail(t, "should not have added a key")
+			return nil
+		},
+	}
+	rkw, _ := NewRotatedKeyWatcher(args)
+
+	err = rkw.LoadRotatedKey()
+	assert.Nil(t, err)
+}
+
+func TestRotatedKeyWatcher_LoadRotatedKeyShouldAddKeyOnce(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "rotatedKeyWatcher")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	fileName, skBytes, _ := writeRotatedKeyFile(t, dir)
+
+	numAdded := 0
+	args := createMockArgsRotatedKeyWatcher()
+	args.KeyFile = fileName
+	args.ManagedKeysAdder = &testscommon.ManagedPeersHolderStub{
+		AddManagedPeerCalled: func(privateKeyBytes []byte) error {
+			assert.Equal(t, skBytes, privateKeyBytes)
+			numAdded++
+			return nil
+		},
+	}
+	rkw, _ := NewRotatedKeyWatcher(args)
+
+	err = rkw.LoadRotatedKey()
+	assert.Nil(t, err)
+	rkw.EpochStartPrepare(&block.MetaBlock{}, &block.Body{})
+	assert.Equal(t, 1, numAdded)
+}
+
+func TestRotatedKeyWatcher_LoadRotatedKeyOwnKeyShouldNotAddKey(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "rotatedKeyWatcher")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	fileName, _, pkBytes := writeRotatedKeyFile(t, dir)
+
+	args := createMockArgsRotatedKeyWatcher()
+	args.KeyFile = fileName
+	args.OwnPublicKey = pkBytes
+	args.ManagedKeysAdder = &testscommon.ManagedPeersHolderStub{
+		AddManagedPeerCalled: func(privateKeyBytes []byte) error {
+			assert.Fail(t, "should not have added the own key")
+			return nil
+		},
+	}
+	rkw, _ := NewRotatedKeyWatcher(args)
+
+	err = rkw.LoadRotatedKey()
+	assert.Nil(t, err)
+}
+
+func TestRotatedKeyWatcher_LoadRotatedKeyAddErrorShouldRetry(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "rotatedKeyWatcher")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	fileName, _, _ := writeRotatedKeyFile(t, dir)
+
+	expectedErr := errors.New("expected error")
+	numCalls := 0
+	args := createMockArgsRotatedKeyWatcher()
+	args.KeyFile = fileName
+	args.ManagedKeysAdder = &testscommon.ManagedPeersHolderStub{
+		AddManagedPeerCalled: func(privateKeyBytes []byte) error {
+			numCalls++
+			if numCalls == 1 {
+				return expectedErr
+			}
+			return nil
+		},
+	}
+	rkw, _ := NewRotatedKeyWatcher(args)
+
+	err = rkw.LoadRotatedKey()
+	assert.Equal(t, expectedErr, err)
+	err = rkw.LoadRotatedKey()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numCalls)
+}
+
+func TestRotatedKeyWatcher_EpochStartActionShouldCheckTheRotatedKeyUntilValidator(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "rotatedKeyWatcher")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	fileName, _, pkBytes := writeRotatedKeyFile(t, dir)
+
+	numChecks := 0
+	isSwapApplied := false
+	args := createMockArgsRotatedKeyWatcher()
+	args.KeyFile = fileName
+	args.NodesCoordinator = &nodesCoordinatorStub{
+		GetValidatorWithPublicKeyCalled: func(publicKey []byte) (sharding.Validator, uint32, error) {
+			assert.Equal(t, pkBytes, publicKey)
+			numChecks++
+			if !isSwapApplied {
+				return nil, 0, sharding.ErrValidatorNotFound
+			}
+			return nil, 1, nil
+		},
+	}
+	rkw, _ := NewRotatedKeyWatcher(args)
+
+	rkw.EpochStartAction(&block.MetaBlock{Epoch: 1})
+	assert.Equal(t, 0, numChecks)
+
+	_ = rkw.LoadRotatedKey()
+	rkw.EpochStartAction(&block.MetaBlock{Epoch: 2})
+	assert.Equal(t, 1, numChecks)
+	assert.False(t, rkw.isValidator)
+
+	isSwapApplied = true
+	rkw.EpochStartAction(&block.MetaBlock{Epoch: 3})
+	assert.Equal(t, 2, numChecks)
+	assert.True(t, rkw.isValidator)
+
+	rkw.EpochStartAction(&block.MetaBlock{Epoch: 4})
+	assert.Equal(t, 2, numChecks)
+}
@@ -6,11 +6,15 @@ import (
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 )
 
@@ -19,14 +23,19 @@ type baseAPIBockProcessor struct {
 	selfShardID              uint32
 	store                    dataRetriever.StorageService
 	marshalizer              marshal.Marshalizer
+	hasher                   hashing.Hasher
 	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
 	historyRepo              dblookupext.HistoryRepository
 	unmarshalTx              func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
+	putResultsInTransaction  func(txHash []byte, tx *transaction.ApiTransactionResult, epoch uint32)
+	putExecutionStatusInTx   func(txHash []byte, tx *transaction.ApiTransactionResult, epoch uint32)
 }
 
 var log = logger.GetOrCreate("node/blockAPI")
 
-func (bap *baseAPIBockProcessor) getTxsByMb(mbHeader *block.MiniBlockHeader, epoch uint32) []*transaction.ApiTransactionResult {
+// getTxsByMb returns the transactions of the miniblock. If withResults is set, each transaction will also contain its
+// smart contract results, receipt and logs
+func (bap *baseAPIBockProcessor) getTxsByMb(mbHeader *block.MiniBlockHeader, epoch uint32, withResults bool) []*transaction.ApiTransactionResult {
 	miniblockHash := mbHeader.Hash
 	mbBytes, err := bap.getFromStorerWithEpoch(dataRetriever.MiniBlockUnit, miniblockHash, epoch)
 	if err != nil {
@@ -47,13 +56,13 @@ func (bap *baseAPIBockProcessor) getTxsByMb(mbHeader *block.MiniBlockHeader, epo
 
 	switch miniBlock.Type {
 	case block.TxBlock:
-		return bap.getTxsFromMiniblock(miniBlock, miniblockHash, epoch, transaction.TxTypeNormal, dataRetriever.TransactionUnit)
+		return bap.getTxsFromMiniblock(miniBlock, miniblockHash, epoch, transaction.TxTypeNormal, dataRetriever.TransactionUnit, withResults)
 	case block.RewardsBlock:
-		return bap.getTxsFromMiniblock(miniBlock, miniblockHash, epoch, transaction.TxTypeReward, dataRetriever.RewardTransactionUnit)
+		return bap.getTxsFromMiniblock(miniBlock, miniblockHash, epoch, transaction.TxTypeReward, dataRetriever.RewardTransactionUnit, withResults)
 	case block.SmartContractResultBlock:
-		return bap.getTxsFromMiniblock(miniBlock, miniblockHash, epoch, transaction.TxTypeUnsigned, dataRetriever.UnsignedTransactionUnit)
+		return bap.getTxsFromMiniblock(miniBlock, miniblockHash, epoch, transaction.TxTypeUnsigned, dataRetriever.UnsignedTransactionUnit, withResults)
 	case block.InvalidBlock:
-		return bap.getTxsFromMiniblock(miniBlock, miniblockHash, epoch, transaction.TxTypeInvalid, dataRetriever.TransactionUnit)
+		return bap.getTxsFromMiniblock(miniBlock, miniblockHash, epoch, transaction.TxTypeInvalid, dataRetriever.TransactionUnit, withResults)
 	default:
 		return nil
 	}
@@ -65,6 +74,7 @@ func (bap *baseAPIBockProcessor) getTxsFromMiniblock(
 	epoch uint32,
 	txType transaction.TxType,
 	unit dataRetriever.UnitType,
+	withResults bool,
 ) []*transaction.ApiTransactionResult {
 	storer := bap.store.GetStorer(unit)
 	start := time.Now()
@@ -101,6 +111,13 @@ func (bap *baseAPIBockProcessor) getTxsFromMiniblock(
 			SelfShard:        bap.selfShardID,
 		}).ComputeStatusWhenInStorageKnowingMiniblock()
 
+		if withResults {
+			bap.putResultsInTransaction([]byte(txHash), tx, epoch)
+		}
+		if bap.hasDbLookupExtensions && txType == transaction.TxTypeNormal {
+			bap.putExecutionStatusInTx([]byte(txHash), tx, epoch)
+		}
+
 		txs = append(txs, tx)
 	}
 	log.Debug(fmt.Sprintf("UnmarshalTransactions took %s", time.Since(start)))
@@ -126,3 +143,37 @@ func (bap *baseAPIBockProcessor) getFromStorerWithEpoch(unit dataRetriever.UnitT
 	storer := bap.store.GetStorer(unit)
 	return storer.GetFromEpoch(key, epoch)
 }
+
+// GetRandomnessByEpoch will return the randomness source of the start of epoch meta block of the provided epoch
+func (bap *baseAPIBockProcessor) GetRandomnessByEpoch(epoch uint32) (*apiBlock.APIRandomness, error) {
+	storer := bap.store.GetStorer(dataRetriever.MetaBlockUnit)
+	blockBytes, err := storer.SearchFirst([]byte(core.EpochStartIdentifier(epoch)))
+	if err != nil {
+		return nil, err
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = bap.marshalizer.Unmarshal(metaBlock, blockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := core.CalculateHash(bap.marshalizer, bap.hasher, metaBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertHeaderToAPIRandomness(hash, metaBlock), nil
+}
+
+func convertHeaderToAPIRandomness(hash []byte, header data.HeaderHandler) *apiBlock.APIRandomness {
+	return &apiBlock.APIRandomness{
+		Nonce:        header.GetNonce(),
+		Round:        header.GetRound(),
+		Epoch:        header.GetEpoch(),
+		Shard:        header.GetShardID(),
+		Hash:         hex.EncodeToString(hash),
+		RandSeed:     hex.EncodeToString(header.GetRandSeed()),
+		PrevRandSeed: hex.EncodeToString(header.GetPrevRandSeed()),
+	}
+}
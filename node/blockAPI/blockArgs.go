@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 )
 
@@ -13,7 +14,10 @@ type APIBlockProcessorArg struct {
 	SelfShardID              uint32
 	Store                    dataRetriever.StorageService
 	Marshalizer              marshal.Marshalizer
+	Hasher                   hashing.Hasher
 	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
 	HistoryRepo              dblookupext.HistoryRepository
 	UnmarshalTx              func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
+	PutResultsInTransaction  func(txHash []byte, tx *transaction.ApiTransactionResult, epoch uint32)
+	PutExecutionStatusInTx   func(txHash []byte, tx *transaction.ApiTransactionResult, epoch uint32)
 }
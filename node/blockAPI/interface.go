@@ -4,6 +4,8 @@ import apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
 
 // APIBlockHandler defines the behavior of a component able to return api blocks
 type APIBlockHandler interface {
-	GetBlockByNonce(nonce uint64, withTxs bool) (*apiBlock.APIBlock, error)
-	GetBlockByHash(hash []byte, withTxs bool) (*apiBlock.APIBlock, error)
+	GetBlockByNonce(nonce uint64, withTxs bool, withResults bool) (*apiBlock.APIBlock, error)
+	GetBlockByHash(hash []byte, withTxs bool, withResults bool) (*apiBlock.APIBlock, error)
+	GetRandomnessByNonce(nonce uint64) (*apiBlock.APIRandomness, error)
+	GetRandomnessByEpoch(epoch uint32) (*apiBlock.APIRandomness, error)
 }
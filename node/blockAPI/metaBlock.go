@@ -22,42 +22,70 @@ func NewMetaApiBlockProcessor(arg *APIBlockProcessorArg) *metaAPIBlockProcessor
 			selfShardID:              arg.SelfShardID,
 			store:                    arg.Store,
 			marshalizer:              arg.Marshalizer,
+			hasher:                   arg.Hasher,
 			uint64ByteSliceConverter: arg.Uint64ByteSliceConverter,
 			historyRepo:              arg.HistoryRepo,
 			unmarshalTx:              arg.UnmarshalTx,
+			putResultsInTransaction:  arg.PutResultsInTransaction,
+			putExecutionStatusInTx:   arg.PutExecutionStatusInTx,
 		},
 	}
 }
 
 // GetBlockByNonce wil return a meta APIBlock by nonce
-func (mbp *metaAPIBlockProcessor) GetBlockByNonce(nonce uint64, withTxs bool) (*apiBlock.APIBlock, error) {
+func (mbp *metaAPIBlockProcessor) GetBlockByNonce(nonce uint64, withTxs bool, withResults bool) (*apiBlock.APIBlock, error) {
+	headerHash, blockBytes, err := mbp.getBlockBytesByNonce(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return mbp.convertMetaBlockBytesToAPIBlock(headerHash, blockBytes, withTxs, withResults)
+}
+
+// GetRandomnessByNonce will return the randomness source of the meta block with the provided nonce
+func (mbp *metaAPIBlockProcessor) GetRandomnessByNonce(nonce uint64) (*apiBlock.APIRandomness, error) {
+	headerHash, blockBytes, err := mbp.getBlockBytesByNonce(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHeader := &block.MetaBlock{}
+	err = mbp.marshalizer.Unmarshal(blockHeader, blockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertHeaderToAPIRandomness(headerHash, blockHeader), nil
+}
+
+func (mbp *metaAPIBlockProcessor) getBlockBytesByNonce(nonce uint64) ([]byte, []byte, error) {
 	storerUnit := dataRetriever.MetaHdrNonceHashDataUnit
 
 	nonceToByteSlice := mbp.uint64ByteSliceConverter.ToByteSlice(nonce)
 	headerHash, err := mbp.store.Get(storerUnit, nonceToByteSlice)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	blockBytes, err := mbp.getFromStorer(dataRetriever.MetaBlockUnit, headerHash)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return mbp.convertMetaBlockBytesToAPIBlock(headerHash, blockBytes, withTxs)
+	return headerHash, blockBytes, nil
 }
 
 // GetBlockByHash will return a shard APIBlock by hash
-func (mbp *metaAPIBlockProcessor) GetBlockByHash(hash []byte, withTxs bool) (*apiBlock.APIBlock, error) {
+func (mbp *metaAPIBlockProcessor) GetBlockByHash(hash []byte, withTxs bool, withResults bool) (*apiBlock.APIBlock, error) {
 	blockBytes, err := mbp.getFromStorer(dataRetriever.MetaBlockUnit, hash)
 	if err != nil {
 		return nil, err
 	}
 
-	return mbp.convertMetaBlockBytesToAPIBlock(hash, blockBytes, withTxs)
+	return mbp.convertMetaBlockBytesToAPIBlock(hash, blockBytes, withTxs, withResults)
 }
 
-func (mbp *metaAPIBlockProcessor) convertMetaBlockBytesToAPIBlock(hash []byte, blockBytes []byte, withTxs bool) (*apiBlock.APIBlock, error) {
+func (mbp *metaAPIBlockProcessor) convertMetaBlockBytesToAPIBlock(hash []byte, blockBytes []byte, withTxs bool, withResults bool) (*apiBlock.APIBlock, error) {
 	blockHeader := &block.MetaBlock{}
 	err := mbp.marshalizer.Unmarshal(blockHeader, blockBytes)
 	if err != nil {
@@ -83,7 +111,7 @@ func (mbp *metaAPIBlockProcessor) convertMetaBlockBytesToAPIBlock(hash []byte, b
 		}
 		if withTxs {
 			miniBlockCopy := mb
-			miniblockAPI.Transactions = mbp.getTxsByMb(&miniBlockCopy, headerEpoch)
+			miniblockAPI.Transactions = mbp.getTxsByMb(&miniBlockCopy, headerEpoch, withResults)
 		}
 
 		miniblocks = append(miniblocks, miniblockAPI)
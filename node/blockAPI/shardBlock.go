@@ -21,42 +21,70 @@ func NewShardApiBlockProcessor(arg *APIBlockProcessorArg) *shardAPIBlockProcesso
 			selfShardID:              arg.SelfShardID,
 			store:                    arg.Store,
 			marshalizer:              arg.Marshalizer,
+			hasher:                   arg.Hasher,
 			uint64ByteSliceConverter: arg.Uint64ByteSliceConverter,
 			historyRepo:              arg.HistoryRepo,
 			unmarshalTx:              arg.UnmarshalTx,
+			putResultsInTransaction:  arg.PutResultsInTransaction,
+			putExecutionStatusInTx:   arg.PutExecutionStatusInTx,
 		},
 	}
 }
 
 // GetBlockByNonce will return a shard APIBlock by nonce
-func (sbp *shardAPIBlockProcessor) GetBlockByNonce(nonce uint64, withTxs bool) (*apiBlock.APIBlock, error) {
+func (sbp *shardAPIBlockProcessor) GetBlockByNonce(nonce uint64, withTxs bool, withResults bool) (*apiBlock.APIBlock, error) {
+	headerHash, blockBytes, err := sbp.getBlockBytesByNonce(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return sbp.convertShardBlockBytesToAPIBlock(headerHash, blockBytes, withTxs, withResults)
+}
+
+// GetRandomnessByNonce will return the randomness source of the shard block with the provided nonce
+func (sbp *shardAPIBlockProcessor) GetRandomnessByNonce(nonce uint64) (*apiBlock.APIRandomness, error) {
+	headerHash, blockBytes, err := sbp.getBlockBytesByNonce(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHeader := &block.Header{}
+	err = sbp.marshalizer.Unmarshal(blockHeader, blockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertHeaderToAPIRandomness(headerHash, blockHeader), nil
+}
+
+func (sbp *shardAPIBlockProcessor) getBlockBytesByNonce(nonce uint64) ([]byte, []byte, error) {
 	storerUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(sbp.selfShardID)
 
 	nonceToByteSlice := sbp.uint64ByteSliceConverter.ToByteSlice(nonce)
 	headerHash, err := sbp.store.Get(storerUnit, nonceToByteSlice)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	blockBytes, err := sbp.getFromStorer(dataRetriever.BlockHeaderUnit, headerHash)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return sbp.convertShardBlockBytesToAPIBlock(headerHash, blockBytes, withTxs)
+	return headerHash, blockBytes, nil
 }
 
 // GetBlockByHash will return a shard APIBlock by hash
-func (sbp *shardAPIBlockProcessor) GetBlockByHash(hash []byte, withTxs bool) (*apiBlock.APIBlock, error) {
+func (sbp *shardAPIBlockProcessor) GetBlockByHash(hash []byte, withTxs bool, withResults bool) (*apiBlock.APIBlock, error) {
 	blockBytes, err := sbp.getFromStorer(dataRetriever.BlockHeaderUnit, hash)
 	if err != nil {
 		return nil, err
 	}
 
-	return sbp.convertShardBlockBytesToAPIBlock(hash, blockBytes, withTxs)
+	return sbp.convertShardBlockBytesToAPIBlock(hash, blockBytes, withTxs, withResults)
 }
 
-func (sbp *shardAPIBlockProcessor) convertShardBlockBytesToAPIBlock(hash []byte, blockBytes []byte, withTxs bool) (*apiBlock.APIBlock, error) {
+func (sbp *shardAPIBlockProcessor) convertShardBlockBytesToAPIBlock(hash []byte, blockBytes []byte, withTxs bool, withResults bool) (*apiBlock.APIBlock, error) {
 	blockHeader := &block.Header{}
 	err := sbp.marshalizer.Unmarshal(blockHeader, blockBytes)
 	if err != nil {
@@ -82,7 +110,7 @@ func (sbp *shardAPIBlockProcessor) convertShardBlockBytesToAPIBlock(hash []byte,
 		}
 		if withTxs {
 			miniBlockCopy := mb
-			miniblockAPI.Transactions = sbp.getTxsByMb(&miniBlockCopy, headerEpoch)
+			miniblockAPI.Transactions = sbp.getTxsByMb(&miniBlockCopy, headerEpoch, withResults)
 		}
 
 		miniblocks = append(miniblocks, miniblockAPI)
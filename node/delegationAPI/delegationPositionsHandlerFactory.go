@@ -0,0 +1,31 @@
+package delegationAPI
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+// ArgsDelegationPositionsHandler is struct that contains components that are needed to create a DelegationPositionsHandler
+type ArgsDelegationPositionsHandler struct {
+	ShardID                     uint32
+	RoundDurationInMilliseconds uint64
+	SCQueryService              external.SCQueryService
+	AddressPubkeyConverter      core.PubkeyConverter
+}
+
+const numOfRounds = 10
+
+// CreateDelegationPositionsHandler will create a new instance of DelegationPositionsHandler
+func CreateDelegationPositionsHandler(args *ArgsDelegationPositionsHandler) (external.DelegationPositionsHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return NewDisabledDelegationPositionsProcessor()
+	}
+
+	return NewDelegationPositionsProcessor(
+		args.SCQueryService,
+		args.AddressPubkeyConverter,
+		time.Duration(args.RoundDurationInMilliseconds)*time.Millisecond*numOfRounds,
+	)
+}
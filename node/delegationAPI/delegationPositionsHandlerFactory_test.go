@@ -0,0 +1,42 @@
+package delegationAPI
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDelegationPositionsHandler_DisabledDelegationPositionsProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := &ArgsDelegationPositionsHandler{
+		ShardID: 0,
+	}
+
+	delegationsHandler, err := CreateDelegationPositionsHandler(args)
+	require.Nil(t, err)
+
+	delegationsProc, ok := delegationsHandler.(*disabledDelegationPositionsProcessor)
+	require.True(t, ok)
+	require.NotNil(t, delegationsProc)
+}
+
+func TestCreateDelegationPositionsHandler_DelegationPositionsProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := &ArgsDelegationPositionsHandler{
+		ShardID:                     core.MetachainShardId,
+		RoundDurationInMilliseconds: 5000,
+		SCQueryService:              &mock.SCQueryServiceStub{},
+		AddressPubkeyConverter:      mock.NewPubkeyConverterMock(32),
+	}
+
+	delegationsHandler, err := CreateDelegationPositionsHandler(args)
+	require.Nil(t, err)
+
+	delegationsProc, ok := delegationsHandler.(*delegationPositionsProcessor)
+	require.True(t, ok)
+	require.NotNil(t, delegationsProc)
+}
@@ -0,0 +1,200 @@
+package delegationAPI
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+const (
+	getAllContractAddressesFunction = "getAllContractAddresses"
+	isDelegatorFunction             = "isDelegator"
+	getDelegatorFundsDataFunction   = "getDelegatorFundsData"
+	getUserUnDelegatedListFunction  = "getUserUnDelegatedList"
+
+	minNumDelegatorFundsDataFields = 3
+	numUnDelegatedFundFields       = 2
+)
+
+var log = logger.GetOrCreate("node/delegationAPI")
+
+type delegatorFunds struct {
+	activeStake      *big.Int
+	claimableRewards *big.Int
+	unDelegated      *big.Int
+}
+
+// delegationPositionsProcessor aggregates the funds of a delegator from all the delegation contracts, through their
+// view functions. The list of the delegation contracts is kept cached for the configured duration
+type delegationPositionsProcessor struct {
+	scQueryService         external.SCQueryService
+	addressPubkeyConverter core.PubkeyConverter
+	cacheDuration          time.Duration
+
+	mutContracts    sync.Mutex
+	lastComputeTime time.Time
+	contracts       [][]byte
+}
+
+// NewDelegationPositionsProcessor will create a new instance of delegationPositionsProcessor
+func NewDelegationPositionsProcessor(
+	scQueryService external.SCQueryService,
+	addressPubkeyConverter core.PubkeyConverter,
+	cacheDuration time.Duration,
+) (*delegationPositionsProcessor, error) {
+	if cacheDuration <= 0 {
+		return nil, ErrInvalidCacheDuration
+	}
+	if check.IfNil(scQueryService) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(addressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	return &delegationPositionsProcessor{
+		scQueryService:         scQueryService,
+		addressPubkeyConverter: addressPubkeyConverter,
+		cacheDuration:          cacheDuration,
+		contracts:              make([][]byte, 0),
+	}, nil
+}
+
+// GetDelegationPositions returns the active stake, the undelegated funds and the claimable rewards of the provided
+// delegator in each delegation contract it delegated to, together with their totals
+func (dpp *delegationPositionsProcessor) GetDelegationPositions(delegator []byte) (*external.DelegatorPositions, error) {
+	contracts, err := dpp.getDelegationContracts()
+	if err != nil {
+		return nil, err
+	}
+
+	totalActiveStake := big.NewInt(0)
+	totalUnDelegated := big.NewInt(0)
+	totalClaimableRewards := big.NewInt(0)
+	positions := &external.DelegatorPositions{
+		Delegations: make([]*external.DelegationPosition, 0),
+	}
+	for _, contract := range contracts {
+		if !dpp.isDelegator(contract, delegator) {
+			continue
+		}
+
+		position, funds, errPosition := dpp.getDelegationPosition(contract, delegator)
+		if errPosition != nil {
+			return nil, errPosition
+		}
+
+		totalActiveStake.Add(totalActiveStake, funds.activeStake)
+		totalClaimableRewards.Add(totalClaimableRewards, funds.claimableRewards)
+		totalUnDelegated.Add(totalUnDelegated, funds.unDelegated)
+		positions.Delegations = append(positions.Delegations, position)
+	}
+
+	positions.TotalActiveStake = totalActiveStake.String()
+	positions.TotalUnDelegated = totalUnDelegated.String()
+	positions.TotalClaimableRewards = totalClaimableRewards.String()
+
+	return positions, nil
+}
+
+func (dpp *delegationPositionsProcessor) getDelegationContracts() ([][]byte, error) {
+	dpp.mutContracts.Lock()
+	defer dpp.mutContracts.Unlock()
+
+	if time.Since(dpp.lastComputeTime) < dpp.cacheDuration {
+		return dpp.contracts, nil
+	}
+
+	vmOutput, err := dpp.scQueryService.ExecuteQuery(&process.SCQuery{
+		ScAddress: vm.DelegationManagerSCAddress,
+		FuncName:  getAllContractAddressesFunction,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dpp.contracts = vmOutput.ReturnData
+	dpp.lastComputeTime = time.Now()
+
+	return dpp.contracts, nil
+}
+
+// isDelegator returns false if the view fails, as the delegation contracts reject the calls for unknown delegators
+func (dpp *delegationPositionsProcessor) isDelegator(contract []byte, delegator []byte) bool {
+	_, err := dpp.executeDelegatorView(contract, isDelegatorFunction, delegator)
+	if err != nil {
+		log.Trace("delegationPositionsProcessor.isDelegator",
+			"contract", dpp.addressPubkeyConverter.Encode(contract),
+			"error", err.Error())
+		return false
+	}
+
+	return true
+}
+
+func (dpp *delegationPositionsProcessor) getDelegationPosition(
+	contract []byte,
+	delegator []byte,
+) (*external.DelegationPosition, *delegatorFunds, error) {
+	fundsData, err := dpp.executeDelegatorView(contract, getDelegatorFundsDataFunction, delegator)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(fundsData) < minNumDelegatorFundsDataFields {
+		return nil, nil, ErrInvalidDelegatorFundsData
+	}
+
+	unDelegatedList, err := dpp.executeDelegatorView(contract, getUserUnDelegatedListFunction, delegator)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(unDelegatedList)%numUnDelegatedFundFields != 0 {
+		return nil, nil, ErrInvalidUnDelegatedList
+	}
+
+	funds := &delegatorFunds{
+		activeStake:      big.NewInt(0).SetBytes(fundsData[0]),
+		claimableRewards: big.NewInt(0).SetBytes(fundsData[1]),
+		unDelegated:      big.NewInt(0).SetBytes(fundsData[2]),
+	}
+	position := &external.DelegationPosition{
+		Contract:         dpp.addressPubkeyConverter.Encode(contract),
+		ActiveStake:      funds.activeStake.String(),
+		UnDelegated:      make([]*external.DelegationUnDelegatedFund, 0, len(unDelegatedList)/numUnDelegatedFundFields),
+		TotalUnDelegated: funds.unDelegated.String(),
+		ClaimableRewards: funds.claimableRewards.String(),
+	}
+	for i := 0; i < len(unDelegatedList); i += numUnDelegatedFundFields {
+		position.UnDelegated = append(position.UnDelegated, &external.DelegationUnDelegatedFund{
+			Value:           big.NewInt(0).SetBytes(unDelegatedList[i]).String(),
+			RemainingNonces: big.NewInt(0).SetBytes(unDelegatedList[i+1]).Uint64(),
+		})
+	}
+
+	return position, funds, nil
+}
+
+func (dpp *delegationPositionsProcessor) executeDelegatorView(contract []byte, function string, delegator []byte) ([][]byte, error) {
+	vmOutput, err := dpp.scQueryService.ExecuteQuery(&process.SCQuery{
+		ScAddress: contract,
+		FuncName:  function,
+		Arguments: [][]byte{delegator},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return vmOutput.ReturnData, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dpp *delegationPositionsProcessor) IsInterfaceNil() bool {
+	return dpp == nil
+}
@@ -0,0 +1,147 @@
+package delegationAPI
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	delegator  = []byte("delegator")
+	contract1  = []byte("contract1")
+	contract2  = []byte("contract2")
+	contract3  = []byte("contract3")
+	errNotUser = errors.New("view function works only for existing delegators")
+)
+
+func createDelegationViewsStub(numContractsCalls *int) *mock.SCQueryServiceStub {
+	return &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			if string(query.ScAddress) == string(vm.DelegationManagerSCAddress) {
+				*numContractsCalls++
+				return &vmcommon.VMOutput{ReturnData: [][]byte{contract1, contract2, contract3}}, nil
+			}
+			if string(query.Arguments[0]) != string(delegator) || string(query.ScAddress) == string(contract2) {
+				return nil, errNotUser
+			}
+
+			switch query.FuncName {
+			case isDelegatorFunction:
+				return &vmcommon.VMOutput{}, nil
+			case getDelegatorFundsDataFunction:
+				if string(query.ScAddress) == string(contract1) {
+					return &vmcommon.VMOutput{ReturnData: [][]byte{big.NewInt(1000).Bytes(), big.NewInt(10).Bytes(), big.NewInt(300).Bytes(), big.NewInt(100).Bytes()}}, nil
+				}
+				return &vmcommon.VMOutput{ReturnData: [][]byte{big.NewInt(500).Bytes(), big.NewInt(5).Bytes(), {}, {}}}, nil
+			case getUserUnDelegatedListFunction:
+				if string(query.ScAddress) == string(contract1) {
+					return &vmcommon.VMOutput{ReturnData: [][]byte{big.NewInt(100).Bytes(), {}, big.NewInt(200).Bytes(), big.NewInt(40).Bytes()}}, nil
+				}
+				return &vmcommon.VMOutput{}, nil
+			}
+
+			return nil, errors.New("unexpected query")
+		},
+	}
+}
+
+func TestNewDelegationPositionsProcessor(t *testing.T) {
+	t.Parallel()
+
+	proc, err := NewDelegationPositionsProcessor(&mock.SCQueryServiceStub{}, mock.NewPubkeyConverterMock(32), 0)
+	require.Nil(t, proc)
+	require.Equal(t, ErrInvalidCacheDuration, err)
+
+	proc, err = NewDelegationPositionsProcessor(nil, mock.NewPubkeyConverterMock(32), time.Second)
+	require.Nil(t, proc)
+	require.Equal(t, ErrNilSCQueryService, err)
+
+	proc, err = NewDelegationPositionsProcessor(&mock.SCQueryServiceStub{}, nil, time.Second)
+	require.Nil(t, proc)
+	require.Equal(t, ErrNilPubkeyConverter, err)
+
+	proc, err = NewDelegationPositionsProcessor(&mock.SCQueryServiceStub{}, mock.NewPubkeyConverterMock(32), time.Second)
+	require.Nil(t, err)
+	require.False(t, proc.IsInterfaceNil())
+}
+
+func TestDelegationPositionsProcessor_GetDelegationPositionsShouldAggregateAndCacheContracts(t *testing.T) {
+	t.Parallel()
+
+	numContractsCalls := 0
+	pubkeyConverter := mock.NewPubkeyConverterMock(9)
+	proc, _ := NewDelegationPositionsProcessor(createDelegationViewsStub(&numContractsCalls), pubkeyConverter, time.Hour)
+
+	positions, err := proc.GetDelegationPositions(delegator)
+	require.Nil(t, err)
+	require.Equal(t, 1, numContractsCalls)
+
+	expectedPositions := &external.DelegatorPositions{
+		Delegations: []*external.DelegationPosition{
+			{
+				Contract:    pubkeyConverter.Encode(contract1),
+				ActiveStake: "1000",
+				UnDelegated: []*external.DelegationUnDelegatedFund{
+					{Value: "100", RemainingNonces: 0},
+					{Value: "200", RemainingNonces: 40},
+				},
+				TotalUnDelegated: "300",
+				ClaimableRewards: "10",
+			},
+			{
+				Contract:         pubkeyConverter.Encode(contract3),
+				ActiveStake:      "500",
+				UnDelegated:      make([]*external.DelegationUnDelegatedFund, 0),
+				TotalUnDelegated: "0",
+				ClaimableRewards: "5",
+			},
+		},
+		TotalActiveStake:      "1500",
+		TotalUnDelegated:      "300",
+		TotalClaimableRewards: "15",
+	}
+	require.Equal(t, expectedPositions, positions)
+
+	positions, err = proc.GetDelegationPositions([]byte("other"))
+	require.Nil(t, err)
+	require.Equal(t, 1, numContractsCalls)
+	require.Empty(t, positions.Delegations)
+	require.Equal(t, "0", positions.TotalActiveStake)
+}
+
+func TestDelegationPositionsProcessor_GetDelegationPositionsErrors(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	proc, _ := NewDelegationPositionsProcessor(&mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return nil, expectedErr
+		},
+	}, mock.NewPubkeyConverterMock(9), time.Hour)
+	positions, err := proc.GetDelegationPositions(delegator)
+	require.Nil(t, positions)
+	require.Equal(t, expectedErr, err)
+
+	proc, _ = NewDelegationPositionsProcessor(&mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			if query.FuncName == getAllContractAddressesFunction {
+				return &vmcommon.VMOutput{ReturnData: [][]byte{contract1}}, nil
+			}
+			if query.FuncName == getDelegatorFundsDataFunction {
+				return &vmcommon.VMOutput{ReturnData: [][]byte{{}}}, nil
+			}
+			return &vmcommon.VMOutput{}, nil
+		},
+	}, mock.NewPubkeyConverterMock(9), time.Hour)
+	positions, err = proc.GetDelegationPositions(delegator)
+	require.Nil(t, positions)
+	require.Equal(t, ErrInvalidDelegatorFundsData, err)
+}
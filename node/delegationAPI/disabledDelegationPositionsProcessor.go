@@ -0,0 +1,20 @@
+package delegationAPI
+
+import "github.com/ElrondNetwork/elrond-go/node/external"
+
+type disabledDelegationPositionsProcessor struct{}
+
+// NewDisabledDelegationPositionsProcessor -
+func NewDisabledDelegationPositionsProcessor() (*disabledDelegationPositionsProcessor, error) {
+	return new(disabledDelegationPositionsProcessor), nil
+}
+
+// GetDelegationPositions -
+func (d *disabledDelegationPositionsProcessor) GetDelegationPositions(_ []byte) (*external.DelegatorPositions, error) {
+	return nil, ErrCannotReturnDelegationsFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledDelegationPositionsProcessor) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,21 @@
+package delegationAPI
+
+import "errors"
+
+// ErrInvalidCacheDuration signals that an invalid cache duration has been provided
+var ErrInvalidCacheDuration = errors.New("invalid delegation contracts cache duration")
+
+// ErrNilSCQueryService signals that a nil SC query service has been provided
+var ErrNilSCQueryService = errors.New("trying to set nil SC query service")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("trying to set nil pubkey converter")
+
+// ErrCannotReturnDelegationsFromShardNode signals that the delegation positions cannot be returned by a shard node
+var ErrCannotReturnDelegationsFromShardNode = errors.New("delegation positions cannot be returned by a shard node")
+
+// ErrInvalidDelegatorFundsData signals that the delegator funds data returned by a delegation contract is malformed
+var ErrInvalidDelegatorFundsData = errors.New("invalid delegator funds data returned by the delegation contract")
+
+// ErrInvalidUnDelegatedList signals that the undelegated list returned by a delegation contract is malformed
+var ErrInvalidUnDelegatedList = errors.New("invalid undelegated list returned by the delegation contract")
@@ -193,6 +193,9 @@ var ErrNilPeerHonestyHandler = errors.New("nil peer honesty handler")
 // ErrNilFallbackHeaderValidator signals that a nil fallback header validator has been provided
 var ErrNilFallbackHeaderValidator = errors.New("nil fallback header validator")
 
+// ErrNilPartitionStatusHandler signals that a nil partition status handler has been provided
+var ErrNilPartitionStatusHandler = errors.New("nil partition status handler")
+
 // ErrNilWatchdog signals that a nil watchdog has been provided
 var ErrNilWatchdog = errors.New("nil watchdog")
 
@@ -231,3 +234,73 @@ var ErrNilTransactionVersionChecker = errors.New("nil transaction version checke
 
 // ErrNilDataTrie signals that user account has a nil data trie
 var ErrNilDataTrie = errors.New("nil data trie")
+
+// ErrNilEquivocationDetector signals that a nil equivocation detector has been provided
+var ErrNilEquivocationDetector = errors.New("nil equivocation detector")
+
+// ErrNilSubroundsTimingHandler signals that a nil subrounds timing handler has been provided
+var ErrNilSubroundsTimingHandler = errors.New("nil subrounds timing handler")
+
+// ErrNilProposalPreparer signals that a nil proposal preparer has been provided
+var ErrNilProposalPreparer = errors.New("nil proposal preparer")
+
+// ErrNilConsensusStateDebugger signals that a nil consensus state debugger has been provided
+var ErrNilConsensusStateDebugger = errors.New("nil consensus state debugger")
+
+// ErrNilManagedPeersHolder signals that a nil managed peers holder has been provided
+var ErrNilManagedPeersHolder = errors.New("nil managed peers holder")
+
+// ErrNilNodeRedundancyHandler signals that a nil node redundancy handler has been provided
+var ErrNilNodeRedundancyHandler = errors.New("nil node redundancy handler")
+
+// ErrNilParticipationTracker signals that a nil participation tracker has been provided
+var ErrNilParticipationTracker = errors.New("nil participation tracker")
+
+// ErrNilSigningSlotHandler signals that a nil signing slot handler has been provided
+var ErrNilSigningSlotHandler = errors.New("nil signing slot handler")
+
+// ErrMetachainOnlyEndpoint signals that an endpoint was called, but it is only available for metachain nodes
+var ErrMetachainOnlyEndpoint = errors.New("the endpoint is only available on metachain nodes")
+
+// ErrRewardsAuditNotFound signals that the rewards audit record of the requested epoch was not found
+var ErrRewardsAuditNotFound = errors.New("rewards audit not found")
+
+// ErrRewardsCheckpointNotFound signals that the rewards checkpoint of the requested epoch was not found
+var ErrRewardsCheckpointNotFound = errors.New("rewards checkpoint not found")
+
+// ErrSupplyAccountingNotFound signals that the supply accounting record of the requested epoch was not found
+var ErrSupplyAccountingNotFound = errors.New("supply accounting not found")
+
+// ErrEpochEconomicsNotFound signals that the economics aggregates of the requested epoch were not found
+var ErrEpochEconomicsNotFound = errors.New("epoch economics not found")
+
+// ErrInvalidShardId signals that an invalid shard ID has been provided
+var ErrInvalidShardId = errors.New("invalid shard ID")
+
+// ErrInvalidPageSize signals that an invalid page size has been provided
+var ErrInvalidPageSize = errors.New("invalid page size")
+
+// ErrEmptyLogsFilter signals that a logs query was provided without an address and without an identifier
+var ErrEmptyLogsFilter = errors.New("at least one of the address and the identifier should be provided")
+
+// ErrInvalidLogsBlockRange signals that an invalid block nonces range was provided for a logs query
+var ErrInvalidLogsBlockRange = errors.New("invalid logs block range")
+
+// ErrDbLookupExtensionsNotEnabled signals that an endpoint that requires the db lookup extensions was called on a
+// node that does not have them enabled
+var ErrDbLookupExtensionsNotEnabled = errors.New("db lookup extensions not enabled")
+
+// ErrInvalidPeerID signals that an invalid peer ID has been provided
+var ErrInvalidPeerID = errors.New("invalid peer ID")
+
+// ErrInvalidBlacklistDuration signals that an invalid blacklist duration has been provided
+var ErrInvalidBlacklistDuration = errors.New("invalid blacklist duration")
+
+// ErrStorageCompactionNotSupported signals that the node storage does not support compaction
+var ErrStorageCompactionNotSupported = errors.New("storage compaction not supported")
+
+// ErrEmptyDataTrie signals that the account does not have any data trie
+var ErrEmptyDataTrie = errors.New("the account does not have a data trie")
+
+// ErrNilPushNotifier signals that a nil push notifier has been provided
+var ErrNilPushNotifier = errors.New("nil push notifier")
@@ -0,0 +1,20 @@
+package esdtTokensAPI
+
+import "github.com/ElrondNetwork/elrond-go/node/external"
+
+type disabledESDTTokensListProcessor struct{}
+
+// NewDisabledESDTTokensListProcessor -
+func NewDisabledESDTTokensListProcessor() (*disabledESDTTokensListProcessor, error) {
+	return new(disabledESDTTokensListProcessor), nil
+}
+
+// GetESDTTokensList -
+func (d *disabledESDTTokensListProcessor) GetESDTTokensList(_ uint32, _ uint32) (*external.ESDTTokensList, error) {
+	return nil, ErrCannotReturnESDTTokensFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledESDTTokensListProcessor) IsInterfaceNil() bool {
+	return d == nil
+}
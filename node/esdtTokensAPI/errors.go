@@ -0,0 +1,21 @@
+package esdtTokensAPI
+
+import "errors"
+
+// ErrInvalidCacheDuration signals that an invalid cache duration has been provided
+var ErrInvalidCacheDuration = errors.New("invalid esdt tokens list cache duration")
+
+// ErrNilSCQueryService signals that a nil SC query service has been provided
+var ErrNilSCQueryService = errors.New("trying to set nil SC query service")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("trying to set nil pubkey converter")
+
+// ErrCannotReturnESDTTokensFromShardNode signals that the esdt tokens list cannot be returned by a shard node
+var ErrCannotReturnESDTTokensFromShardNode = errors.New("esdt tokens list cannot be returned by a shard node")
+
+// ErrInvalidPageSize signals that an invalid page size has been provided
+var ErrInvalidPageSize = errors.New("invalid page size")
+
+// ErrInvalidTokensPage signals that the tokens page returned by the ESDT system smart contract is malformed
+var ErrInvalidTokensPage = errors.New("invalid tokens page returned by the esdt system smart contract")
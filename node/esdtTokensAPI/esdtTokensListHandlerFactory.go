@@ -0,0 +1,31 @@
+package esdtTokensAPI
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+// ArgsESDTTokensListHandler is struct that contains components that are needed to create an ESDTTokensListHandler
+type ArgsESDTTokensListHandler struct {
+	ShardID                     uint32
+	RoundDurationInMilliseconds uint64
+	SCQueryService              external.SCQueryService
+	AddressPubkeyConverter      core.PubkeyConverter
+}
+
+const numOfRounds = 10
+
+// CreateESDTTokensListHandler will create a new instance of ESDTTokensListHandler
+func CreateESDTTokensListHandler(args *ArgsESDTTokensListHandler) (external.ESDTTokensListHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return NewDisabledESDTTokensListProcessor()
+	}
+
+	return NewESDTTokensListProcessor(
+		args.SCQueryService,
+		args.AddressPubkeyConverter,
+		time.Duration(args.RoundDurationInMilliseconds)*time.Millisecond*numOfRounds,
+	)
+}
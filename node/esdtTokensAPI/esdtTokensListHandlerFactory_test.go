@@ -0,0 +1,42 @@
+package esdtTokensAPI
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateESDTTokensListHandler_DisabledESDTTokensListProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := &ArgsESDTTokensListHandler{
+		ShardID: 0,
+	}
+
+	esdtTokensListHandler, err := CreateESDTTokensListHandler(args)
+	require.Nil(t, err)
+
+	esdtTokensListProc, ok := esdtTokensListHandler.(*disabledESDTTokensListProcessor)
+	require.True(t, ok)
+	require.NotNil(t, esdtTokensListProc)
+}
+
+func TestCreateESDTTokensListHandler_ESDTTokensListProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := &ArgsESDTTokensListHandler{
+		ShardID:                     core.MetachainShardId,
+		RoundDurationInMilliseconds: 5000,
+		SCQueryService:              &mock.SCQueryServiceStub{},
+		AddressPubkeyConverter:      mock.NewPubkeyConverterMock(32),
+	}
+
+	esdtTokensListHandler, err := CreateESDTTokensListHandler(args)
+	require.Nil(t, err)
+
+	esdtTokensListProc, ok := esdtTokensListHandler.(*esdtTokensListProcessor)
+	require.True(t, ok)
+	require.NotNil(t, esdtTokensListProc)
+}
@@ -0,0 +1,157 @@
+package esdtTokensAPI
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+const getTokensPageFunction = "getTokensPage"
+const fungibleESDTType = "FungibleESDT"
+
+// esdtTokensListProcessor reads the issued tokens from the ESDT system smart contract, page by page, through the
+// getTokensPage view and keeps the whole list cached for the configured duration
+type esdtTokensListProcessor struct {
+	scQueryService  external.SCQueryService
+	pubkeyConverter core.PubkeyConverter
+	cacheDuration   time.Duration
+
+	mutTokens       sync.Mutex
+	lastComputeTime time.Time
+	tokens          []*external.ESDTTokenMetadata
+}
+
+// NewESDTTokensListProcessor will create a new instance of esdtTokensListProcessor
+func NewESDTTokensListProcessor(
+	scQueryService external.SCQueryService,
+	pubkeyConverter core.PubkeyConverter,
+	cacheDuration time.Duration,
+) (*esdtTokensListProcessor, error) {
+	if cacheDuration <= 0 {
+		return nil, ErrInvalidCacheDuration
+	}
+	if check.IfNil(scQueryService) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(pubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	return &esdtTokensListProcessor{
+		scQueryService:  scQueryService,
+		pubkeyConverter: pubkeyConverter,
+		cacheDuration:   cacheDuration,
+		tokens:          make([]*external.ESDTTokenMetadata, 0),
+	}, nil
+}
+
+// GetESDTTokensList returns at most size tokens, starting with the token at the from index, in their issuing order
+func (etp *esdtTokensListProcessor) GetESDTTokensList(from uint32, size uint32) (*external.ESDTTokensList, error) {
+	if size == 0 {
+		return nil, ErrInvalidPageSize
+	}
+
+	etp.mutTokens.Lock()
+	defer etp.mutTokens.Unlock()
+
+	if time.Since(etp.lastComputeTime) >= etp.cacheDuration {
+		err := etp.updateTokens()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	numTokens := uint32(len(etp.tokens))
+	result := &external.ESDTTokensList{
+		Tokens:    make([]*external.ESDTTokenMetadata, 0),
+		NumTokens: numTokens,
+	}
+	if from >= numTokens {
+		return result, nil
+	}
+
+	end := numTokens
+	if size < numTokens-from {
+		end = from + size
+		result.NextFrom = end
+	}
+	result.Tokens = append(result.Tokens, etp.tokens[from:end]...)
+
+	return result, nil
+}
+
+func (etp *esdtTokensListProcessor) updateTokens() error {
+	tokens := make([]*external.ESDTTokenMetadata, 0)
+	for {
+		numTokens, page, err := etp.getTokensPage(uint32(len(tokens)))
+		if err != nil {
+			return err
+		}
+
+		tokens = append(tokens, page...)
+		if len(page) == 0 || uint64(len(tokens)) >= numTokens {
+			break
+		}
+	}
+
+	etp.tokens = tokens
+	etp.lastComputeTime = time.Now()
+
+	return nil
+}
+
+func (etp *esdtTokensListProcessor) getTokensPage(startIndex uint32) (uint64, []*external.ESDTTokenMetadata, error) {
+	vmOutput, err := etp.scQueryService.ExecuteQuery(&process.SCQuery{
+		ScAddress: vm.ESDTSCAddress,
+		FuncName:  getTokensPageFunction,
+		Arguments: [][]byte{
+			big.NewInt(int64(startIndex)).Bytes(),
+			big.NewInt(systemSmartContracts.MaxTokensPageSize).Bytes(),
+		},
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	returnData := vmOutput.ReturnData
+	if len(returnData) == 0 || (len(returnData)-1)%systemSmartContracts.TokensPageNumFieldsPerToken != 0 {
+		return 0, nil, ErrInvalidTokensPage
+	}
+
+	numTokens := big.NewInt(0).SetBytes(returnData[0]).Uint64()
+	tokens := make([]*external.ESDTTokenMetadata, 0, (len(returnData)-1)/systemSmartContracts.TokensPageNumFieldsPerToken)
+	for i := 1; i < len(returnData); i += systemSmartContracts.TokensPageNumFieldsPerToken {
+		tokens = append(tokens, etp.createTokenMetadata(returnData[i:i+systemSmartContracts.TokensPageNumFieldsPerToken]))
+	}
+
+	return numTokens, tokens, nil
+}
+
+func (etp *esdtTokensListProcessor) createTokenMetadata(fields [][]byte) *external.ESDTTokenMetadata {
+	minted := big.NewInt(0).SetBytes(fields[3])
+	burnt := big.NewInt(0).SetBytes(fields[4])
+
+	return &external.ESDTTokenMetadata{
+		TokenIdentifier: string(fields[0]),
+		Name:            string(fields[1]),
+		Type:            fungibleESDTType,
+		Owner:           etp.pubkeyConverter.Encode(fields[2]),
+		NumDecimals:     uint32(big.NewInt(0).SetBytes(fields[5]).Uint64()),
+		Supply:          big.NewInt(0).Sub(minted, burnt).String(),
+		Minted:          minted.String(),
+		Burnt:           burnt.String(),
+		IsPaused:        string(fields[6]) == "true",
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (etp *esdtTokensListProcessor) IsInterfaceNil() bool {
+	return etp == nil
+}
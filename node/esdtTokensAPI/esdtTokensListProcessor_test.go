@@ -0,0 +1,154 @@
+package esdtTokensAPI
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	"github.com/stretchr/testify/require"
+)
+
+func createTokensPageStub(numTokens int, numCalls *int) *mock.SCQueryServiceStub {
+	return &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			*numCalls++
+			if query.FuncName != getTokensPageFunction || string(query.ScAddress) != string(vm.ESDTSCAddress) {
+				return nil, errors.New("unexpected query")
+			}
+
+			startIndex := int(big.NewInt(0).SetBytes(query.Arguments[0]).Int64())
+			pageSize := int(big.NewInt(0).SetBytes(query.Arguments[1]).Int64())
+			returnData := [][]byte{big.NewInt(int64(numTokens)).Bytes()}
+			for i := startIndex; i < numTokens && i < startIndex+pageSize; i++ {
+				returnData = append(returnData,
+					[]byte(fmt.Sprintf("TKN%d-abcdef", i)),
+					[]byte(fmt.Sprintf("Token%d", i)),
+					[]byte{byte(i)},
+					big.NewInt(1000).Bytes(),
+					big.NewInt(int64(i)).Bytes(),
+					big.NewInt(6).Bytes(),
+					[]byte("false"),
+				)
+			}
+
+			return &vmcommon.VMOutput{ReturnData: returnData}, nil
+		},
+	}
+}
+
+func TestNewESDTTokensListProcessor(t *testing.T) {
+	t.Parallel()
+
+	proc, err := NewESDTTokensListProcessor(&mock.SCQueryServiceStub{}, mock.NewPubkeyConverterMock(32), 0)
+	require.Nil(t, proc)
+	require.Equal(t, ErrInvalidCacheDuration, err)
+
+	proc, err = NewESDTTokensListProcessor(nil, mock.NewPubkeyConverterMock(32), time.Second)
+	require.Nil(t, proc)
+	require.Equal(t, ErrNilSCQueryService, err)
+
+	proc, err = NewESDTTokensListProcessor(&mock.SCQueryServiceStub{}, nil, time.Second)
+	require.Nil(t, proc)
+	require.Equal(t, ErrNilPubkeyConverter, err)
+
+	proc, err = NewESDTTokensListProcessor(&mock.SCQueryServiceStub{}, mock.NewPubkeyConverterMock(32), time.Second)
+	require.Nil(t, err)
+	require.False(t, proc.IsInterfaceNil())
+}
+
+func TestEsdtTokensListProcessor_GetESDTTokensListShouldReadAllPagesAndCache(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	numTokens := systemSmartContracts.MaxTokensPageSize + 20
+	proc, _ := NewESDTTokensListProcessor(createTokensPageStub(numTokens, &numCalls), mock.NewPubkeyConverterMock(1), time.Hour)
+
+	tokensList, err := proc.GetESDTTokensList(0, 2)
+	require.Nil(t, err)
+	require.Equal(t, 2, numCalls)
+	require.Equal(t, uint32(numTokens), tokensList.NumTokens)
+	require.Equal(t, uint32(2), tokensList.NextFrom)
+	require.Equal(t, []*external.ESDTTokenMetadata{
+		{
+			TokenIdentifier: "TKN0-abcdef",
+			Name:            "Token0",
+			Type:            fungibleESDTType,
+			Owner:           "00",
+			NumDecimals:     6,
+			Supply:          "1000",
+			Minted:          "1000",
+			Burnt:           "0",
+		},
+		{
+			TokenIdentifier: "TKN1-abcdef",
+			Name:            "Token1",
+			Type:            fungibleESDTType,
+			Owner:           "01",
+			NumDecimals:     6,
+			Supply:          "999",
+			Minted:          "1000",
+			Burnt:           "1",
+		},
+	}, tokensList.Tokens)
+
+	tokensList, err = proc.GetESDTTokensList(uint32(numTokens-5), 10)
+	require.Nil(t, err)
+	require.Equal(t, 2, numCalls)
+	require.Equal(t, 5, len(tokensList.Tokens))
+	require.Equal(t, uint32(0), tokensList.NextFrom)
+	require.Equal(t, fmt.Sprintf("TKN%d-abcdef", numTokens-1), tokensList.Tokens[4].TokenIdentifier)
+
+	tokensList, err = proc.GetESDTTokensList(uint32(numTokens), 10)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(tokensList.Tokens))
+	require.Equal(t, uint32(numTokens), tokensList.NumTokens)
+}
+
+func TestEsdtTokensListProcessor_GetESDTTokensListInvalidPageSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	proc, _ := NewESDTTokensListProcessor(createTokensPageStub(1, &numCalls), mock.NewPubkeyConverterMock(1), time.Hour)
+
+	tokensList, err := proc.GetESDTTokensList(0, 0)
+	require.Nil(t, tokensList)
+	require.Equal(t, ErrInvalidPageSize, err)
+	require.Equal(t, 0, numCalls)
+}
+
+func TestEsdtTokensListProcessor_GetESDTTokensListQueryErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	proc, _ := NewESDTTokensListProcessor(&mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(_ *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return nil, expectedErr
+		},
+	}, mock.NewPubkeyConverterMock(1), time.Hour)
+
+	tokensList, err := proc.GetESDTTokensList(0, 10)
+	require.Nil(t, tokensList)
+	require.Equal(t, expectedErr, err)
+}
+
+func TestEsdtTokensListProcessor_GetESDTTokensListMalformedPageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proc, _ := NewESDTTokensListProcessor(&mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(_ *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnData: [][]byte{{1}, []byte("TKN-abcdef")}}, nil
+		},
+	}, mock.NewPubkeyConverterMock(1), time.Hour)
+
+	tokensList, err := proc.GetESDTTokensList(0, 10)
+	require.Nil(t, tokensList)
+	require.Equal(t, ErrInvalidTokensPage, err)
+}
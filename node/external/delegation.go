@@ -0,0 +1,25 @@
+package external
+
+// DelegationUnDelegatedFund holds an amount undelegated from a delegation contract. RemainingNonces is the number of
+// blocks left until the amount can be withdrawn, 0 meaning that it is already withdrawable
+type DelegationUnDelegatedFund struct {
+	Value           string `json:"value"`
+	RemainingNonces uint64 `json:"remainingNonces"`
+}
+
+// DelegationPosition holds the funds of a delegator in a delegation contract
+type DelegationPosition struct {
+	Contract         string                       `json:"contract"`
+	ActiveStake      string                       `json:"activeStake"`
+	UnDelegated      []*DelegationUnDelegatedFund `json:"unDelegated"`
+	TotalUnDelegated string                       `json:"totalUnDelegated"`
+	ClaimableRewards string                       `json:"claimableRewards"`
+}
+
+// DelegatorPositions holds the funds of a delegator in all the delegation contracts, together with their totals
+type DelegatorPositions struct {
+	Delegations           []*DelegationPosition `json:"delegations"`
+	TotalActiveStake      string                `json:"totalActiveStake"`
+	TotalUnDelegated      string                `json:"totalUnDelegated"`
+	TotalClaimableRewards string                `json:"totalClaimableRewards"`
+}
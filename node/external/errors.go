@@ -13,3 +13,15 @@ var ErrNilTransactionCostHandler = errors.New("nil transaction cost handler")
 
 // ErrNilTotalStakedValueHandler signals that a nil total staked value handler has been provided
 var ErrNilTotalStakedValueHandler = errors.New("nil total staked value handler")
+
+// ErrNilStakingRewardsHandler signals that a nil staking rewards handler has been provided
+var ErrNilStakingRewardsHandler = errors.New("nil staking rewards handler")
+
+// ErrNilESDTTokensListHandler signals that a nil esdt tokens list handler has been provided
+var ErrNilESDTTokensListHandler = errors.New("nil esdt tokens list handler")
+
+// ErrNilStakingQueueHandler signals that a nil staking queue handler has been provided
+var ErrNilStakingQueueHandler = errors.New("nil staking queue handler")
+
+// ErrNilDelegationPositionsHandler signals that a nil delegation positions handler has been provided
+var ErrNilDelegationPositionsHandler = errors.New("nil delegation positions handler")
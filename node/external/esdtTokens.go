@@ -0,0 +1,22 @@
+package external
+
+// ESDTTokenMetadata holds the properties of an issued ESDT token, as registered in the ESDT system smart contract
+type ESDTTokenMetadata struct {
+	TokenIdentifier string `json:"tokenIdentifier"`
+	Name            string `json:"name"`
+	Type            string `json:"type"`
+	Owner           string `json:"owner"`
+	NumDecimals     uint32 `json:"numDecimals"`
+	Supply          string `json:"supply"`
+	Minted          string `json:"minted"`
+	Burnt           string `json:"burnt"`
+	IsPaused        bool   `json:"isPaused"`
+}
+
+// ESDTTokensList holds a page of the issued ESDT tokens. NextFrom is the index of the first token of the next page
+// and is omitted on the last page
+type ESDTTokensList struct {
+	Tokens    []*ESDTTokenMetadata `json:"tokens"`
+	NumTokens uint32               `json:"numTokens"`
+	NextFrom  uint32               `json:"nextFrom,omitempty"`
+}
@@ -0,0 +1,53 @@
+package external
+
+// HealthStatus is the status of a node subsystem, or of the whole node, as reported by the health probes
+type HealthStatus string
+
+const (
+	// HealthStatusOk signals that the subsystem works as expected
+	HealthStatusOk HealthStatus = "ok"
+	// HealthStatusDegraded signals that the subsystem works, but not at its full capacity
+	HealthStatusDegraded HealthStatus = "degraded"
+	// HealthStatusFailing signals that the subsystem does not work
+	HealthStatusFailing HealthStatus = "failing"
+)
+
+// The subsystems evaluated by the health probes
+const (
+	HealthSubsystemP2P          = "p2p"
+	HealthSubsystemSync         = "sync"
+	HealthSubsystemStorage      = "storage"
+	HealthSubsystemTrieSnapshot = "trieSnapshot"
+	HealthSubsystemConsensus    = "consensus"
+	HealthSubsystemOutport      = "outport"
+)
+
+// The machine-readable reasons of the subsystems statuses
+const (
+	HealthReasonNoConnectedPeers          = "no_connected_peers"
+	HealthReasonFewConnectedPeers         = "few_connected_peers"
+	HealthReasonSyncDistanceExceeded      = "sync_distance_exceeded"
+	HealthReasonSyncing                   = "syncing"
+	HealthReasonStorageNotWritable        = "storage_not_writable"
+	HealthReasonTrieSnapshotInProgress    = "trie_snapshot_in_progress"
+	HealthReasonNotEligible               = "not_eligible"
+	HealthReasonMissedProposals           = "missed_proposals"
+	HealthReasonConsensusDataNotAvailable = "consensus_data_not_available"
+	HealthReasonOutportDriverBuffering    = "outport_driver_buffering"
+	HealthReasonOutportDriverLagging      = "outport_driver_lagging"
+)
+
+// HealthCheck holds the status of a node subsystem. Reason is a machine-readable explanation of the status, while
+// Message holds the human readable details
+type HealthCheck struct {
+	Subsystem string       `json:"subsystem"`
+	Status    HealthStatus `json:"status"`
+	Reason    string       `json:"reason,omitempty"`
+	Message   string       `json:"message,omitempty"`
+}
+
+// HealthReport holds the statuses of the evaluated subsystems, the node status being the worst of them
+type HealthReport struct {
+	Status HealthStatus   `json:"status"`
+	Checks []*HealthCheck `json:"checks"`
+}
@@ -37,3 +37,30 @@ type TotalStakedValueHandler interface {
 	GetTotalStakedValue() (*big.Int, error)
 	IsInterfaceNil() bool
 }
+
+// ESDTTokensListHandler defines the behavior of a component able to return the ESDT tokens issued in the network
+type ESDTTokensListHandler interface {
+	GetESDTTokensList(from uint32, size uint32) (*ESDTTokensList, error)
+	IsInterfaceNil() bool
+}
+
+// StakingQueueHandler defines the behavior of a component able to return the nodes waiting in the staking queue
+type StakingQueueHandler interface {
+	GetStakingQueue(from uint32, size uint32) (*StakingQueue, error)
+	IsInterfaceNil() bool
+}
+
+// DelegationPositionsHandler defines the behavior of a component able to return the funds of a delegator in all the
+// delegation contracts
+type DelegationPositionsHandler interface {
+	GetDelegationPositions(delegator []byte) (*DelegatorPositions, error)
+	IsInterfaceNil() bool
+}
+
+// StakingRewardsHandler defines the behavior of a component able to compute the network annual percentage rates and
+// the rewards projected for the owners of staked nodes
+type StakingRewardsHandler interface {
+	GetNetworkAPR() (*NetworkAPR, error)
+	GetOwnerRewardsProjection(owner []byte) (*OwnerRewardsProjection, error)
+	IsInterfaceNil() bool
+}
@@ -15,6 +15,10 @@ type NodeApiResolver struct {
 	statusMetricsHandler    StatusMetricsHandler
 	txCostHandler           TransactionCostHandler
 	totalStakedValueHandler TotalStakedValueHandler
+	stakingRewardsHandler   StakingRewardsHandler
+	esdtTokensListHandler   ESDTTokensListHandler
+	stakingQueueHandler     StakingQueueHandler
+	delegationsHandler      DelegationPositionsHandler
 }
 
 // NewNodeApiResolver creates a new NodeApiResolver instance
@@ -23,6 +27,10 @@ func NewNodeApiResolver(
 	statusMetricsHandler StatusMetricsHandler,
 	txCostHandler TransactionCostHandler,
 	totalStakedValueHandler TotalStakedValueHandler,
+	stakingRewardsHandler StakingRewardsHandler,
+	esdtTokensListHandler ESDTTokensListHandler,
+	stakingQueueHandler StakingQueueHandler,
+	delegationsHandler DelegationPositionsHandler,
 ) (*NodeApiResolver, error) {
 	if check.IfNil(scQueryService) {
 		return nil, ErrNilSCQueryService
@@ -36,12 +44,28 @@ func NewNodeApiResolver(
 	if check.IfNil(totalStakedValueHandler) {
 		return nil, ErrNilTotalStakedValueHandler
 	}
+	if check.IfNil(stakingRewardsHandler) {
+		return nil, ErrNilStakingRewardsHandler
+	}
+	if check.IfNil(esdtTokensListHandler) {
+		return nil, ErrNilESDTTokensListHandler
+	}
+	if check.IfNil(stakingQueueHandler) {
+		return nil, ErrNilStakingQueueHandler
+	}
+	if check.IfNil(delegationsHandler) {
+		return nil, ErrNilDelegationPositionsHandler
+	}
 
 	return &NodeApiResolver{
 		scQueryService:          scQueryService,
 		statusMetricsHandler:    statusMetricsHandler,
 		txCostHandler:           txCostHandler,
 		totalStakedValueHandler: totalStakedValueHandler,
+		stakingRewardsHandler:   stakingRewardsHandler,
+		esdtTokensListHandler:   esdtTokensListHandler,
+		stakingQueueHandler:     stakingQueueHandler,
+		delegationsHandler:      delegationsHandler,
 	}, nil
 }
 
@@ -65,6 +89,31 @@ func (nar *NodeApiResolver) GetTotalStakedValue() (*big.Int, error) {
 	return nar.totalStakedValueHandler.GetTotalStakedValue()
 }
 
+// GetNetworkAPR will return the annual percentage rates of the base stake and of the top-up stake
+func (nar *NodeApiResolver) GetNetworkAPR() (*NetworkAPR, error) {
+	return nar.stakingRewardsHandler.GetNetworkAPR()
+}
+
+// GetOwnerRewardsProjection will return the rewards projected for the nodes of the provided owner
+func (nar *NodeApiResolver) GetOwnerRewardsProjection(owner []byte) (*OwnerRewardsProjection, error) {
+	return nar.stakingRewardsHandler.GetOwnerRewardsProjection(owner)
+}
+
+// GetESDTTokensList will return a page of the ESDT tokens issued in the network
+func (nar *NodeApiResolver) GetESDTTokensList(from uint32, size uint32) (*ESDTTokensList, error) {
+	return nar.esdtTokensListHandler.GetESDTTokensList(from, size)
+}
+
+// GetStakingQueue will return a page of the nodes waiting in the staking queue
+func (nar *NodeApiResolver) GetStakingQueue(from uint32, size uint32) (*StakingQueue, error) {
+	return nar.stakingQueueHandler.GetStakingQueue(from, size)
+}
+
+// GetDelegationPositions will return the funds of the provided delegator in all the delegation contracts
+func (nar *NodeApiResolver) GetDelegationPositions(delegator []byte) (*DelegatorPositions, error) {
+	return nar.delegationsHandler.GetDelegationPositions(delegator)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nar *NodeApiResolver) IsInterfaceNil() bool {
 	return nar == nil
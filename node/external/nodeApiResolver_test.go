@@ -5,8 +5,12 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/node/delegationAPI"
+	"github.com/ElrondNetwork/elrond-go/node/esdtTokensAPI"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/node/stakingQueueAPI"
+	"github.com/ElrondNetwork/elrond-go/node/stakingRewardsAPI"
 	"github.com/ElrondNetwork/elrond-go/node/totalStakedAPI"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/stretchr/testify/assert"
@@ -16,7 +20,11 @@ func TestNewNodeApiResolver_NilSCQueryServiceShouldErr(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
-	nar, err := external.NewNodeApiResolver(nil, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler)
+	stakingRewardsHandler, _ := stakingRewardsAPI.NewDisabledStakingRewardsProcessor()
+	esdtTokensListHandler, _ := esdtTokensAPI.NewDisabledESDTTokensListProcessor()
+	stakingQueueHandler, _ := stakingQueueAPI.NewDisabledStakingQueueProcessor()
+	delegationsHandler, _ := delegationAPI.NewDisabledDelegationPositionsProcessor()
+	nar, err := external.NewNodeApiResolver(nil, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler, stakingRewardsHandler, esdtTokensListHandler, stakingQueueHandler, delegationsHandler)
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilSCQueryService, err)
@@ -26,7 +34,11 @@ func TestNewNodeApiResolver_NilStatusMetricsShouldErr(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, nil, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler)
+	stakingRewardsHandler, _ := stakingRewardsAPI.NewDisabledStakingRewardsProcessor()
+	esdtTokensListHandler, _ := esdtTokensAPI.NewDisabledESDTTokensListProcessor()
+	stakingQueueHandler, _ := stakingQueueAPI.NewDisabledStakingQueueProcessor()
+	delegationsHandler, _ := delegationAPI.NewDisabledDelegationPositionsProcessor()
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, nil, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler, stakingRewardsHandler, esdtTokensListHandler, stakingQueueHandler, delegationsHandler)
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilStatusMetrics, err)
@@ -36,7 +48,11 @@ func TestNewNodeApiResolver_NilTransactionCostEstsimator(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, nil, totalStakedAPIHandler)
+	stakingRewardsHandler, _ := stakingRewardsAPI.NewDisabledStakingRewardsProcessor()
+	esdtTokensListHandler, _ := esdtTokensAPI.NewDisabledESDTTokensListProcessor()
+	stakingQueueHandler, _ := stakingQueueAPI.NewDisabledStakingQueueProcessor()
+	delegationsHandler, _ := delegationAPI.NewDisabledDelegationPositionsProcessor()
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, nil, totalStakedAPIHandler, stakingRewardsHandler, esdtTokensListHandler, stakingQueueHandler, delegationsHandler)
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilTransactionCostHandler, err)
@@ -45,17 +61,77 @@ func TestNewNodeApiResolver_NilTransactionCostEstsimator(t *testing.T) {
 func TestNewNodeApiResolver_NilTotalStakedValueHandler(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, nil)
+	stakingRewardsHandler, _ := stakingRewardsAPI.NewDisabledStakingRewardsProcessor()
+	esdtTokensListHandler, _ := esdtTokensAPI.NewDisabledESDTTokensListProcessor()
+	stakingQueueHandler, _ := stakingQueueAPI.NewDisabledStakingQueueProcessor()
+	delegationsHandler, _ := delegationAPI.NewDisabledDelegationPositionsProcessor()
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, nil, stakingRewardsHandler, esdtTokensListHandler, stakingQueueHandler, delegationsHandler)
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilTotalStakedValueHandler, err)
 }
 
+func TestNewNodeApiResolver_NilStakingRewardsHandler(t *testing.T) {
+	t.Parallel()
+
+	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+	esdtTokensListHandler, _ := esdtTokensAPI.NewDisabledESDTTokensListProcessor()
+	stakingQueueHandler, _ := stakingQueueAPI.NewDisabledStakingQueueProcessor()
+	delegationsHandler, _ := delegationAPI.NewDisabledDelegationPositionsProcessor()
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler, nil, esdtTokensListHandler, stakingQueueHandler, delegationsHandler)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilStakingRewardsHandler, err)
+}
+
+func TestNewNodeApiResolver_NilESDTTokensListHandler(t *testing.T) {
+	t.Parallel()
+
+	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+	stakingRewardsHandler, _ := stakingRewardsAPI.NewDisabledStakingRewardsProcessor()
+	stakingQueueHandler, _ := stakingQueueAPI.NewDisabledStakingQueueProcessor()
+	delegationsHandler, _ := delegationAPI.NewDisabledDelegationPositionsProcessor()
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler, stakingRewardsHandler, nil, stakingQueueHandler, delegationsHandler)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilESDTTokensListHandler, err)
+}
+
+func TestNewNodeApiResolver_NilStakingQueueHandler(t *testing.T) {
+	t.Parallel()
+
+	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+	stakingRewardsHandler, _ := stakingRewardsAPI.NewDisabledStakingRewardsProcessor()
+	esdtTokensListHandler, _ := esdtTokensAPI.NewDisabledESDTTokensListProcessor()
+	delegationsHandler, _ := delegationAPI.NewDisabledDelegationPositionsProcessor()
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler, stakingRewardsHandler, esdtTokensListHandler, nil, delegationsHandler)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilStakingQueueHandler, err)
+}
+
+func TestNewNodeApiResolver_NilDelegationPositionsHandler(t *testing.T) {
+	t.Parallel()
+
+	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+	stakingRewardsHandler, _ := stakingRewardsAPI.NewDisabledStakingRewardsProcessor()
+	esdtTokensListHandler, _ := esdtTokensAPI.NewDisabledESDTTokensListProcessor()
+	stakingQueueHandler, _ := stakingQueueAPI.NewDisabledStakingQueueProcessor()
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler, stakingRewardsHandler, esdtTokensListHandler, stakingQueueHandler, nil)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilDelegationPositionsHandler, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler)
+	stakingRewardsHandler, _ := stakingRewardsAPI.NewDisabledStakingRewardsProcessor()
+	esdtTokensListHandler, _ := esdtTokensAPI.NewDisabledESDTTokensListProcessor()
+	stakingQueueHandler, _ := stakingQueueAPI.NewDisabledStakingQueueProcessor()
+	delegationsHandler, _ := delegationAPI.NewDisabledDelegationPositionsProcessor()
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler, stakingRewardsHandler, esdtTokensListHandler, stakingQueueHandler, delegationsHandler)
 
 	assert.Nil(t, err)
 	assert.False(t, check.IfNil(nar))
@@ -65,6 +141,10 @@ func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+	stakingRewardsHandler, _ := stakingRewardsAPI.NewDisabledStakingRewardsProcessor()
+	esdtTokensListHandler, _ := esdtTokensAPI.NewDisabledESDTTokensListProcessor()
+	stakingQueueHandler, _ := stakingQueueAPI.NewDisabledStakingQueueProcessor()
+	delegationsHandler, _ := delegationAPI.NewDisabledDelegationPositionsProcessor()
 	wasCalled := false
 	nar, _ := external.NewNodeApiResolver(&mock.SCQueryServiceStub{
 		ExecuteQueryCalled: func(query *process.SCQuery) (vmOutput *vmcommon.VMOutput, e error) {
@@ -74,6 +154,10 @@ func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 	},
 		&mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{},
 		totalStakedAPIHandler,
+		stakingRewardsHandler,
+		esdtTokensListHandler,
+		stakingQueueHandler,
+		delegationsHandler,
 	)
 
 	_, _ = nar.ExecuteSCQuery(&process.SCQuery{
@@ -88,6 +172,10 @@ func TestNodeApiResolver_StatusMetricsMapWithoutP2PShouldBeCalled(t *testing.T)
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+	stakingRewardsHandler, _ := stakingRewardsAPI.NewDisabledStakingRewardsProcessor()
+	esdtTokensListHandler, _ := esdtTokensAPI.NewDisabledESDTTokensListProcessor()
+	stakingQueueHandler, _ := stakingQueueAPI.NewDisabledStakingQueueProcessor()
+	delegationsHandler, _ := delegationAPI.NewDisabledDelegationPositionsProcessor()
 	wasCalled := false
 	nar, _ := external.NewNodeApiResolver(
 		&mock.SCQueryServiceStub{},
@@ -99,6 +187,10 @@ func TestNodeApiResolver_StatusMetricsMapWithoutP2PShouldBeCalled(t *testing.T)
 		},
 		&mock.TransactionCostEstimatorMock{},
 		totalStakedAPIHandler,
+		stakingRewardsHandler,
+		esdtTokensListHandler,
+		stakingQueueHandler,
+		delegationsHandler,
 	)
 	_ = nar.StatusMetrics().StatusMetricsMapWithoutP2P()
 
@@ -109,6 +201,10 @@ func TestNodeApiResolver_StatusP2pMetricsMapShouldBeCalled(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+	stakingRewardsHandler, _ := stakingRewardsAPI.NewDisabledStakingRewardsProcessor()
+	esdtTokensListHandler, _ := esdtTokensAPI.NewDisabledESDTTokensListProcessor()
+	stakingQueueHandler, _ := stakingQueueAPI.NewDisabledStakingQueueProcessor()
+	delegationsHandler, _ := delegationAPI.NewDisabledDelegationPositionsProcessor()
 	wasCalled := false
 	nar, _ := external.NewNodeApiResolver(
 		&mock.SCQueryServiceStub{},
@@ -120,6 +216,10 @@ func TestNodeApiResolver_StatusP2pMetricsMapShouldBeCalled(t *testing.T) {
 		},
 		&mock.TransactionCostEstimatorMock{},
 		totalStakedAPIHandler,
+		stakingRewardsHandler,
+		esdtTokensListHandler,
+		stakingQueueHandler,
+		delegationsHandler,
 	)
 	_ = nar.StatusMetrics().StatusP2pMetricsMap()
 
@@ -130,6 +230,10 @@ func TestNodeApiResolver_StatusMetricsMapWhitoutP2PShouldBeCalled(t *testing.T)
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+	stakingRewardsHandler, _ := stakingRewardsAPI.NewDisabledStakingRewardsProcessor()
+	esdtTokensListHandler, _ := esdtTokensAPI.NewDisabledESDTTokensListProcessor()
+	stakingQueueHandler, _ := stakingQueueAPI.NewDisabledStakingQueueProcessor()
+	delegationsHandler, _ := delegationAPI.NewDisabledDelegationPositionsProcessor()
 	wasCalled := false
 	nar, _ := external.NewNodeApiResolver(
 		&mock.SCQueryServiceStub{},
@@ -141,6 +245,10 @@ func TestNodeApiResolver_StatusMetricsMapWhitoutP2PShouldBeCalled(t *testing.T)
 		},
 		&mock.TransactionCostEstimatorMock{},
 		totalStakedAPIHandler,
+		stakingRewardsHandler,
+		esdtTokensListHandler,
+		stakingQueueHandler,
+		delegationsHandler,
 	)
 	_ = nar.StatusMetrics().StatusMetricsMapWithoutP2P()
 
@@ -151,6 +259,10 @@ func TestNodeApiResolver_StatusP2PMetricsMapShouldBeCalled(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+	stakingRewardsHandler, _ := stakingRewardsAPI.NewDisabledStakingRewardsProcessor()
+	esdtTokensListHandler, _ := esdtTokensAPI.NewDisabledESDTTokensListProcessor()
+	stakingQueueHandler, _ := stakingQueueAPI.NewDisabledStakingQueueProcessor()
+	delegationsHandler, _ := delegationAPI.NewDisabledDelegationPositionsProcessor()
 	wasCalled := false
 	nar, _ := external.NewNodeApiResolver(
 		&mock.SCQueryServiceStub{},
@@ -162,6 +274,10 @@ func TestNodeApiResolver_StatusP2PMetricsMapShouldBeCalled(t *testing.T) {
 		},
 		&mock.TransactionCostEstimatorMock{},
 		totalStakedAPIHandler,
+		stakingRewardsHandler,
+		esdtTokensListHandler,
+		stakingQueueHandler,
+		delegationsHandler,
 	)
 	_ = nar.StatusMetrics().StatusP2pMetricsMap()
 
@@ -172,6 +288,10 @@ func TestNodeApiResolver_NetworkMetricsMapShouldBeCalled(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+	stakingRewardsHandler, _ := stakingRewardsAPI.NewDisabledStakingRewardsProcessor()
+	esdtTokensListHandler, _ := esdtTokensAPI.NewDisabledESDTTokensListProcessor()
+	stakingQueueHandler, _ := stakingQueueAPI.NewDisabledStakingQueueProcessor()
+	delegationsHandler, _ := delegationAPI.NewDisabledDelegationPositionsProcessor()
 	wasCalled := false
 	nar, _ := external.NewNodeApiResolver(
 		&mock.SCQueryServiceStub{},
@@ -183,6 +303,10 @@ func TestNodeApiResolver_NetworkMetricsMapShouldBeCalled(t *testing.T) {
 		},
 		&mock.TransactionCostEstimatorMock{},
 		totalStakedAPIHandler,
+		stakingRewardsHandler,
+		esdtTokensListHandler,
+		stakingQueueHandler,
+		delegationsHandler,
 	)
 	_ = nar.StatusMetrics().NetworkMetrics()
 
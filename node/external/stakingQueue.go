@@ -0,0 +1,18 @@
+package external
+
+// StakingQueueNode holds the data of a node waiting in the staking queue. Position is 1 based, the first node in the
+// queue being the next one to be staked
+type StakingQueueNode struct {
+	Position      uint32 `json:"position"`
+	BLSKey        string `json:"blsKey"`
+	Owner         string `json:"owner"`
+	RegisterNonce uint64 `json:"registerNonce"`
+}
+
+// StakingQueue holds a page of the staking queue. NextFrom is the index of the first node of the next page and is
+// omitted on the last page
+type StakingQueue struct {
+	Nodes    []*StakingQueueNode `json:"nodes"`
+	NumNodes uint32              `json:"numNodes"`
+	NextFrom uint32              `json:"nextFrom,omitempty"`
+}
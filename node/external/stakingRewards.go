@@ -0,0 +1,30 @@
+package external
+
+// NetworkAPR holds the annual percentage rates of the base stake and of the top-up stake of the eligible nodes. The
+// rates are extrapolated from the rewards distributed at the start of the current epoch
+type NetworkAPR struct {
+	Epoch                   uint32  `json:"epoch"`
+	BaseAPR                 float64 `json:"baseApr"`
+	TopUpAPR                float64 `json:"topUpApr"`
+	EpochsPerYear           float64 `json:"epochsPerYear"`
+	NodePrice               string  `json:"nodePrice"`
+	RewardsPerEpoch         string  `json:"rewardsPerEpoch"`
+	BaseRewardsPerEpoch     string  `json:"baseRewardsPerEpoch"`
+	TopUpRewardsPerEpoch    string  `json:"topUpRewardsPerEpoch"`
+	TotalEligibleBaseStake  string  `json:"totalEligibleBaseStake"`
+	TotalEligibleTopUpStake string  `json:"totalEligibleTopUpStake"`
+}
+
+// OwnerRewardsProjection holds the rewards projected for the nodes of an owner, based on its registration data from
+// the validator system smart contract and on the current network annual percentage rates
+type OwnerRewardsProjection struct {
+	Epoch              uint32  `json:"epoch"`
+	NumStakedNodes     uint32  `json:"numStakedNodes"`
+	NumEligibleNodes   uint32  `json:"numEligibleNodes"`
+	TotalStake         string  `json:"totalStake"`
+	EligibleBaseStake  string  `json:"eligibleBaseStake"`
+	EligibleTopUpStake string  `json:"eligibleTopUpStake"`
+	RewardsPerEpoch    string  `json:"rewardsPerEpoch"`
+	RewardsPerYear     string  `json:"rewardsPerYear"`
+	APR                float64 `json:"apr"`
+}
@@ -5,7 +5,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
-	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/update"
 )
@@ -25,6 +25,8 @@ type P2PMessenger interface {
 	IsConnectedToTheNetwork() bool
 	ID() core.PeerID
 	Peers() []core.PeerID
+	ConnectedPeers() []core.PeerID
+	ClosePeer(pid core.PeerID) error
 	IsInterfaceNil() bool
 }
 
@@ -79,7 +81,6 @@ type Throttler interface {
 
 // HeartbeatHandler defines the behavior of a heartbeat handler
 type HeartbeatHandler interface {
-	Monitor() *process.Monitor
-	Sender() *process.Sender
+	Monitor() heartbeat.MonitorHandler
 	IsInterfaceNil() bool
 }
@@ -20,6 +20,8 @@ type MessengerStub struct {
 	BroadcastOnChannelBlockingCalled func(channel string, topic string, buff []byte) error
 	IsConnectedToTheNetworkCalled    func() bool
 	PeersCalled                      func() []core.PeerID
+	ConnectedPeersCalled             func() []core.PeerID
+	ClosePeerCalled                  func(pid core.PeerID) error
 }
 
 // ID -
@@ -98,6 +100,24 @@ func (ms *MessengerStub) Peers() []core.PeerID {
 	return make([]core.PeerID, 0)
 }
 
+// ConnectedPeers -
+func (ms *MessengerStub) ConnectedPeers() []core.PeerID {
+	if ms.ConnectedPeersCalled != nil {
+		return ms.ConnectedPeersCalled()
+	}
+
+	return make([]core.PeerID, 0)
+}
+
+// ClosePeer -
+func (ms *MessengerStub) ClosePeer(pid core.PeerID) error {
+	if ms.ClosePeerCalled != nil {
+		return ms.ClosePeerCalled(pid)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ms *MessengerStub) IsInterfaceNil() bool {
 	return ms == nil
@@ -11,6 +11,7 @@ type NodesCoordinatorMock struct {
 	GetValidatorsPublicKeysCalled            func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error)
 	GetValidatorsRewardsAddressesCalled      func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error)
 	GetAllEligibleValidatorsPublicKeysCalled func() (map[uint32][][]byte, error)
+	GetEpochValidatorsAssignmentCalled       func(epoch uint32) (*sharding.EpochValidatorsAssignment, error)
 }
 
 // GetAllLeavingValidatorsPublicKeys -
@@ -135,6 +136,14 @@ func (ncm *NodesCoordinatorMock) GetConsensusWhitelistedNodes(
 	return make(map[string]struct{}), nil
 }
 
+// GetEpochValidatorsAssignment -
+func (ncm *NodesCoordinatorMock) GetEpochValidatorsAssignment(epoch uint32) (*sharding.EpochValidatorsAssignment, error) {
+	if ncm.GetEpochValidatorsAssignmentCalled != nil {
+		return ncm.GetEpochValidatorsAssignmentCalled(epoch)
+	}
+	return nil, nil
+}
+
 // SetConsensusGroupSize -
 func (ncm *NodesCoordinatorMock) SetConsensusGroupSize(_ int) error {
 	panic("implement me")
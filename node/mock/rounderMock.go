@@ -8,12 +8,13 @@ import (
 type RounderMock struct {
 	index int64
 
-	IndexCalled         func() int64
-	TimeDurationCalled  func() time.Duration
-	TimeStampCalled     func() time.Time
-	UpdateRoundCalled   func(time.Time, time.Time)
-	RemainingTimeCalled func(startTime time.Time, maxTime time.Duration) time.Duration
-	BeforeGenesisCalled func() bool
+	IndexCalled                     func() int64
+	TimeDurationCalled              func() time.Duration
+	TimeDurationBetweenRoundsCalled func(fromRound int64, toRound int64) time.Duration
+	TimeStampCalled                 func() time.Time
+	UpdateRoundCalled               func(time.Time, time.Time)
+	RemainingTimeCalled             func(startTime time.Time, maxTime time.Duration) time.Duration
+	BeforeGenesisCalled             func() bool
 }
 
 // BeforeGenesis -
@@ -42,6 +43,15 @@ func (rndm *RounderMock) TimeDuration() time.Duration {
 	return 4000 * time.Millisecond
 }
 
+// TimeDurationBetweenRounds -
+func (rndm *RounderMock) TimeDurationBetweenRounds(fromRound int64, toRound int64) time.Duration {
+	if rndm.TimeDurationBetweenRoundsCalled != nil {
+		return rndm.TimeDurationBetweenRoundsCalled(fromRound, toRound)
+	}
+
+	return time.Duration(toRound-fromRound) * rndm.TimeDuration()
+}
+
 // TimeStamp -
 func (rndm *RounderMock) TimeStamp() time.Time {
 	if rndm.TimeStampCalled != nil {
@@ -38,9 +38,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/componentHandler"
 	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	heartbeatProcess "github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	heartbeatSender "github.com/ElrondNetwork/elrond-go/heartbeat/sender"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -160,6 +162,18 @@ type Node struct {
 	heartbeatHandler        HeartbeatHandler
 	peerHonestyHandler      consensus.PeerHonestyHandler
 	fallbackHeaderValidator consensus.FallbackHeaderValidator
+	partitionStatusHandler  consensus.PartitionStatusHandler
+	equivocationDetector    consensus.EquivocationDetector
+	subroundsTimingHandler  consensus.SubroundsTimingHandler
+	proposalPreparer        process.ProposalPreparer
+	stateDebugger           consensus.StateDebugger
+	managedPeersHolder      consensus.ManagedPeersHolder
+	nodeRedundancyHandler   consensus.NodeRedundancyHandler
+	participationTracker    consensus.ParticipationTracker
+	signingSlotHandler      crypto.SigningSlotHandler
+
+	fallbackLeaderTimeoutPercent uint32
+	fallbackLeaderEnableEpoch    uint32
 
 	watchdog          core.WatchdogTimer
 	historyRepository dblookupext.HistoryRepository
@@ -168,6 +182,8 @@ type Node struct {
 	txSignHasher              hashing.Hasher
 	txVersionChecker          process.TxVersionCheckerHandler
 	isInImportMode            bool
+	workingDir                string
+	pushNotifier              heartbeat.PeerStatusNotifier
 }
 
 // ApplyOptions can set up different configurable options of a Node instance
@@ -325,6 +341,10 @@ func (n *Node) StartConsensus() error {
 		NetworkShardingCollector: n.networkShardingCollector,
 		AntifloodHandler:         n.inputAntifloodHandler,
 		PoolAdder:                n.dataPool.MiniBlocks(),
+		EquivocationDetector:     n.equivocationDetector,
+		StateDebugger:            n.stateDebugger,
+		ManagedPeersHolder:       n.managedPeersHolder,
+		NodeRedundancyHandler:    n.nodeRedundancyHandler,
 		SignatureSize:            n.validatorSignatureSize,
 		PublicKeySize:            n.publicKeySize,
 	}
@@ -365,6 +385,14 @@ func (n *Node) StartConsensus() error {
 		PeerHonestyHandler:            n.peerHonestyHandler,
 		HeaderSigVerifier:             n.headerSigVerifier,
 		FallbackHeaderValidator:       n.fallbackHeaderValidator,
+		PartitionStatusHandler:        n.partitionStatusHandler,
+		SubroundsTimingHandler:        n.subroundsTimingHandler,
+		ProposalPreparer:              n.proposalPreparer,
+		StateDebugger:                 n.stateDebugger,
+		ManagedPeersHolder:            n.managedPeersHolder,
+		NodeRedundancyHandler:         n.nodeRedundancyHandler,
+		ParticipationTracker:          n.participationTracker,
+		SigningSlotHandler:            n.signingSlotHandler,
 	}
 
 	consensusDataContainer, err := spos.NewConsensusCore(
@@ -383,6 +411,8 @@ func (n *Node) StartConsensus() error {
 		n.indexer,
 		n.chainID,
 		n.messenger.ID(),
+		n.fallbackLeaderTimeoutPercent,
+		n.fallbackLeaderEnableEpoch,
 	)
 	if err != nil {
 		return err
@@ -1174,6 +1204,19 @@ func (n *Node) GetCode(account state.UserAccountHandler) []byte {
 // StartHeartbeat starts the node's heartbeat processing/signaling module
 //TODO(next PR) remove the instantiation of the heartbeat component from here
 func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber string, prefsConfig config.PreferencesConfig) error {
+	argNodeMetricsProvider := heartbeatSender.ArgNodeMetricsProvider{
+		WorkingDir:           n.workingDir,
+		CurrentBlockProvider: n.blkc,
+		ForkDetector:         n.forkDetector,
+		Accounts:             n.accounts,
+		Messenger:            n.messenger,
+		PeerShardResolver:    n.networkShardingCollector,
+	}
+	nodeMetricsProvider, err := heartbeatSender.NewNodeMetricsProvider(argNodeMetricsProvider)
+	if err != nil {
+		return err
+	}
+
 	arg := componentHandler.ArgHeartbeat{
 		HeartbeatConfig:          hbConfig,
 		PrefsConfig:              prefsConfig,
@@ -1182,9 +1225,9 @@ func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber str
 		ShardCoordinator:         n.shardCoordinator,
 		NodesCoordinator:         n.nodesCoordinator,
 		AppStatusHandler:         n.appStatusHandler,
-		Storer:                   n.store.GetStorer(dataRetriever.HeartbeatUnit),
-		ValidatorStatistics:      n.validatorStatistics,
 		PeerSignatureHandler:     n.peerSigHandler,
+		SingleSigner:             n.singleSigner,
+		KeyGenerator:             n.keyGen,
 		PrivKey:                  n.privKey,
 		HardforkTrigger:          n.hardforkTrigger,
 		AntifloodHandler:         n.inputAntifloodHandler,
@@ -1192,15 +1235,16 @@ func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber str
 		EpochStartTrigger:        n.epochStartTrigger,
 		EpochStartRegistration:   n.epochStartRegistrationHandler,
 		Timer:                    &heartbeatProcess.RealTimer{},
-		GenesisTime:              n.genesisTime,
 		VersionNumber:            versionNumber,
 		PeerShardMapper:          n.networkShardingCollector,
 		SizeCheckDelta:           n.sizeCheckDelta,
 		ValidatorsProvider:       n.validatorsProvider,
 		CurrentBlockProvider:     n.blkc,
+		ManagedPeersHolder:       n.managedPeersHolder,
+		NodeMetricsProvider:      nodeMetricsProvider,
+		PushNotifier:             n.pushNotifier,
 	}
 
-	var err error
 	n.heartbeatHandler, err = componentHandler.NewHeartbeatHandler(arg)
 
 	return err
@@ -1219,11 +1263,57 @@ func (n *Node) GetHeartbeats() []heartbeatData.PubKeyHeartbeat {
 	return mon.GetHeartbeats()
 }
 
+// GetManagedKeysPerNode returns the validator keys managed by each of the nodes running in multikey mode
+func (n *Node) GetManagedKeysPerNode() []heartbeatData.NodeManagedKeys {
+	if check.IfNil(n.heartbeatHandler) {
+		return make([]heartbeatData.NodeManagedKeys, 0)
+	}
+	mon := n.heartbeatHandler.Monitor()
+	if check.IfNil(mon) {
+		return make([]heartbeatData.NodeManagedKeys, 0)
+	}
+
+	return mon.GetManagedKeysPerNode()
+}
+
 // ValidatorStatisticsApi will return the statistics for all the validators from the initial nodes pub keys
 func (n *Node) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error) {
 	return n.validatorsProvider.GetLatestValidators(), nil
 }
 
+// GetEpochValidatorsAssignment returns the eligible, waiting and leaving validators of each shard in the provided epoch,
+// together with the inputs of the consensus group selection
+func (n *Node) GetEpochValidatorsAssignment(epoch uint32) (*sharding.EpochValidatorsAssignment, error) {
+	return n.nodesCoordinator.GetEpochValidatorsAssignment(epoch)
+}
+
+// GetValidatorEligibilityEstimate returns the status of the provided validator in the nodes lists of the current epoch
+// and, when possible, the estimated epoch at which the validator becomes eligible
+func (n *Node) GetValidatorEligibilityEstimate(blsKey string) (*sharding.ValidatorEligibilityEstimate, error) {
+	publicKey, err := n.validatorPubkeyConverter.Decode(blsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment, err := n.nodesCoordinator.GetEpochValidatorsAssignment(n.epochStartTrigger.Epoch())
+	if err != nil {
+		return nil, err
+	}
+
+	return assignment.EstimateEligibleEpoch(publicKey), nil
+}
+
+// EstimateQueuedValidatorEligibleEpoch returns the estimated epoch at which a validator found at the provided 1 based
+// position of the staking queue becomes eligible, based on the nodes lists of the current epoch
+func (n *Node) EstimateQueuedValidatorEligibleEpoch(queuePosition uint32) (*sharding.ValidatorEligibilityEstimate, error) {
+	assignment, err := n.nodesCoordinator.GetEpochValidatorsAssignment(n.epochStartTrigger.Epoch())
+	if err != nil {
+		return nil, err
+	}
+
+	return assignment.EstimateQueuedEligibleEpoch(queuePosition), nil
+}
+
 // DirectTrigger will start the hardfork trigger
 func (n *Node) DirectTrigger(epoch uint32, withEarlyEndOfEpoch bool) error {
 	return n.hardforkTrigger.Trigger(epoch, withEarlyEndOfEpoch)
@@ -1287,6 +1377,15 @@ func (n *Node) GetQueryHandler(name string) (debug.QueryHandler, error) {
 	return qh, nil
 }
 
+// GetConsensusParticipation returns the node's own participation in consensus during each of the last epochs
+func (n *Node) GetConsensusParticipation() []*consensus.EpochParticipation {
+	if check.IfNil(n.participationTracker) {
+		return make([]*consensus.EpochParticipation, 0)
+	}
+
+	return n.participationTracker.GetParticipation()
+}
+
 // GetPeerInfo returns information about a peer id
 func (n *Node) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	peers := n.messenger.Peers()
@@ -9,21 +9,35 @@ import (
 )
 
 // GetBlockByHash return the block for a given hash
-func (n *Node) GetBlockByHash(hash string, withTxs bool) (*apiBlock.APIBlock, error) {
+func (n *Node) GetBlockByHash(hash string, withTxs bool, withResults bool) (*apiBlock.APIBlock, error) {
 	decodedHash, err := hex.DecodeString(hash)
 	if err != nil {
 		return nil, err
 	}
 
 	apiBlockProcessor := n.createAPIBlockProcessor()
-	return apiBlockProcessor.GetBlockByHash(decodedHash, withTxs)
+	return apiBlockProcessor.GetBlockByHash(decodedHash, withTxs, withResults)
 }
 
 // GetBlockByNonce returns the block for a given nonce
-func (n *Node) GetBlockByNonce(nonce uint64, withTxs bool) (*apiBlock.APIBlock, error) {
+func (n *Node) GetBlockByNonce(nonce uint64, withTxs bool, withResults bool) (*apiBlock.APIBlock, error) {
 	apiBlockProcessor := n.createAPIBlockProcessor()
 
-	return apiBlockProcessor.GetBlockByNonce(nonce, withTxs)
+	return apiBlockProcessor.GetBlockByNonce(nonce, withTxs, withResults)
+}
+
+// GetRandomnessByNonce returns the randomness source of the block with the given nonce
+func (n *Node) GetRandomnessByNonce(nonce uint64) (*apiBlock.APIRandomness, error) {
+	apiBlockProcessor := n.createAPIBlockProcessor()
+
+	return apiBlockProcessor.GetRandomnessByNonce(nonce)
+}
+
+// GetRandomnessByEpoch returns the randomness source of the start of epoch meta block of the given epoch
+func (n *Node) GetRandomnessByEpoch(epoch uint32) (*apiBlock.APIRandomness, error) {
+	apiBlockProcessor := n.createAPIBlockProcessor()
+
+	return apiBlockProcessor.GetRandomnessByEpoch(epoch)
 }
 
 func (n *Node) createAPIBlockProcessor() blockAPI.APIBlockHandler {
@@ -33,9 +47,12 @@ func (n *Node) createAPIBlockProcessor() blockAPI.APIBlockHandler {
 				SelfShardID:              n.shardCoordinator.SelfId(),
 				Store:                    n.store,
 				Marshalizer:              n.internalMarshalizer,
+				Hasher:                   n.hasher,
 				Uint64ByteSliceConverter: n.uint64ByteSliceConverter,
 				HistoryRepo:              n.historyRepository,
 				UnmarshalTx:              n.unmarshalTransaction,
+				PutResultsInTransaction:  n.putResultsInTransaction,
+				PutExecutionStatusInTx:   n.putExecutionStatusInTransaction,
 			},
 		)
 	}
@@ -45,9 +62,12 @@ func (n *Node) createAPIBlockProcessor() blockAPI.APIBlockHandler {
 			SelfShardID:              n.shardCoordinator.SelfId(),
 			Store:                    n.store,
 			Marshalizer:              n.internalMarshalizer,
+			Hasher:                   n.hasher,
 			Uint64ByteSliceConverter: n.uint64ByteSliceConverter,
 			HistoryRepo:              n.historyRepository,
 			UnmarshalTx:              n.unmarshalTransaction,
+			PutResultsInTransaction:  n.putResultsInTransaction,
+			PutExecutionStatusInTx:   n.putExecutionStatusInTransaction,
 		},
 	)
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -21,7 +22,7 @@ func TestGetBlockByHash_InvalidShardShouldErr(t *testing.T) {
 
 	n, _ := node.NewNode()
 
-	blk, err := n.GetBlockByHash("invalidHash", false)
+	blk, err := n.GetBlockByHash("invalidHash", false, false)
 	assert.Error(t, err)
 	assert.Nil(t, blk)
 }
@@ -82,7 +83,7 @@ func TestGetBlockByHashFromHistoryNode(t *testing.T) {
 		},
 	}
 
-	blk, err := n.GetBlockByHash(hex.EncodeToString(headerHash), false)
+	blk, err := n.GetBlockByHash(hex.EncodeToString(headerHash), false, false)
 	assert.Nil(t, err)
 	assert.Equal(t, expectedBlock, blk)
 }
@@ -137,7 +138,7 @@ func TestGetBlockByHashFromNormalNode(t *testing.T) {
 		NotarizedBlocks: []*apiBlock.APINotarizedBlock{},
 	}
 
-	blk, err := n.GetBlockByHash(hex.EncodeToString(headerHash), false)
+	blk, err := n.GetBlockByHash(hex.EncodeToString(headerHash), false, false)
 	assert.Nil(t, err)
 	assert.Equal(t, expectedBlock, blk)
 }
@@ -202,7 +203,7 @@ func TestGetBlockByNonceFromHistoryNode(t *testing.T) {
 		},
 	}
 
-	blk, err := n.GetBlockByNonce(1, false)
+	blk, err := n.GetBlockByNonce(1, false, false)
 	assert.Nil(t, err)
 	assert.Equal(t, expectedBlock, blk)
 }
@@ -259,7 +260,101 @@ func TestGetBlockByNonceFromNormalNode(t *testing.T) {
 		},
 	}
 
-	blk, err := n.GetBlockByNonce(1, false)
+	blk, err := n.GetBlockByNonce(1, false, false)
 	assert.Nil(t, err)
 	assert.Equal(t, expectedBlock, blk)
 }
+
+func TestGetRandomnessByNonceFromNormalNode(t *testing.T) {
+	t.Parallel()
+
+	headerHash := "d08089f2ab739520598fd7aeed08c427460fe94f286383047f3f61951afc4e00"
+	header := &block.Header{
+		Nonce:        1,
+		Round:        2,
+		ShardID:      5,
+		Epoch:        1,
+		RandSeed:     []byte("rand seed"),
+		PrevRandSeed: []byte("prev rand seed"),
+	}
+	n, _ := node.NewNode(
+		node.WithUint64ByteSliceConverter(mock.NewNonceHashConverterMock()),
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 90),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: 0}),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+				if unitType == dataRetriever.ShardHdrNonceHashDataUnit {
+					return hex.DecodeString(headerHash)
+				}
+				blockBytes, _ := json.Marshal(header)
+				return blockBytes, nil
+			},
+		}),
+	)
+
+	expectedRandomness := &apiBlock.APIRandomness{
+		Nonce:        header.Nonce,
+		Round:        header.Round,
+		Epoch:        header.Epoch,
+		Shard:        header.ShardID,
+		Hash:         headerHash,
+		RandSeed:     hex.EncodeToString(header.RandSeed),
+		PrevRandSeed: hex.EncodeToString(header.PrevRandSeed),
+	}
+
+	randomness, err := n.GetRandomnessByNonce(1)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedRandomness, randomness)
+}
+
+func TestGetRandomnessByEpoch(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	hasher := &mock.HasherMock{}
+	storerMock := genericmocks.NewStorerMock("meta", 0)
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(marshalizer, 90),
+		node.WithHasher(hasher),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{}),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: 0}),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+				return storerMock
+			},
+		}),
+	)
+
+	randomness, err := n.GetRandomnessByEpoch(3)
+	assert.NotNil(t, err)
+	assert.Nil(t, randomness)
+
+	metaBlock := &block.MetaBlock{
+		Nonce:        100,
+		Round:        105,
+		Epoch:        3,
+		RandSeed:     []byte("rand seed"),
+		PrevRandSeed: []byte("prev rand seed"),
+	}
+	metaBlockBytes, _ := marshalizer.Marshal(metaBlock)
+	_ = storerMock.Put([]byte(core.EpochStartIdentifier(metaBlock.Epoch)), metaBlockBytes)
+
+	expectedRandomness := &apiBlock.APIRandomness{
+		Nonce:        metaBlock.Nonce,
+		Round:        metaBlock.Round,
+		Epoch:        metaBlock.Epoch,
+		Shard:        core.MetachainShardId,
+		Hash:         hex.EncodeToString(hasher.Compute(string(metaBlockBytes))),
+		RandSeed:     hex.EncodeToString(metaBlock.RandSeed),
+		PrevRandSeed: hex.EncodeToString(metaBlock.PrevRandSeed),
+	}
+
+	randomness, err = n.GetRandomnessByEpoch(3)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedRandomness, randomness)
+}
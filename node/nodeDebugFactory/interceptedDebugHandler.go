@@ -13,6 +13,12 @@ import (
 // InterceptorResolverDebugger is the contant string for the debugger
 const InterceptorResolverDebugger = "interceptor resolver debugger"
 
+// ConsensusStateDebugger is the constant string for the consensus state debugger
+const ConsensusStateDebugger = "consensus state debugger"
+
+// ManagedKeys is the constant string for the consensus activity of the keys managed by the node
+const ManagedKeys = "managed keys"
+
 // CreateInterceptedDebugHandler creates and applies an interceptor-resolver debug handler
 func CreateInterceptedDebugHandler(
 	node NodeWrapper,
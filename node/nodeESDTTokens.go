@@ -0,0 +1,133 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+)
+
+// esdtTokenRandomSuffixLength is the length of the hex encoded random sequence that follows the ticker separator
+// in a token identifier. Any bytes found after it in a data trie key are the nonce of an NFT
+const esdtTokenRandomSuffixLength = 6
+
+const esdtTickerSeparator = "-"
+
+// GetESDTTokensPage returns a page of the ESDT balances held by the provided address. The data trie is iterated
+// from the provided cursor, on the provided root hash or on the current one, and the iteration stops as soon as
+// the page is filled, so the addresses holding many tokens can be fetched in consistent pages
+func (n *Node) GetESDTTokensPage(address string, options esdt.TokensPageOptions) (*esdt.ApiESDTTokensPage, error) {
+	if options.PageSize == 0 {
+		return nil, ErrInvalidPageSize
+	}
+
+	account, err := n.getAccountHandler(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := n.castAccountToUserAccount(account)
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+
+	page := &esdt.ApiESDTTokensPage{
+		Tokens: make([]*esdt.ApiESDTToken, 0),
+	}
+	if check.IfNil(userAccount.DataTrie()) {
+		return page, nil
+	}
+
+	rootHash, err := getESDTTokensPageRootHash(userAccount.DataTrie(), options.RootHash)
+	if err != nil {
+		return nil, err
+	}
+	startKey, err := hex.DecodeString(options.Cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	chLeaves, err := userAccount.DataTrie().GetAllLeavesFromKeyOnChannel(rootHash, startKey, ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	defer func() {
+		cancel()
+		// the channel is drained so the iteration goroutine is not left blocked after the interruption
+		for range chLeaves {
+		}
+	}()
+
+	esdtPrefix := core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier
+	tokenPrefix := []byte(esdtPrefix + options.TokenIdentifier)
+	page.RootHash = hex.EncodeToString(rootHash)
+	for leaf := range chLeaves {
+		if !bytes.HasPrefix(leaf.Key(), tokenPrefix) {
+			continue
+		}
+		if uint32(len(page.Tokens)) == options.PageSize {
+			page.NextCursor = hex.EncodeToString(leaf.Key())
+			break
+		}
+
+		value, errTrim := leaf.ValueWithoutSuffix(append(leaf.Key(), userAccount.AddressBytes()...))
+		if errTrim != nil {
+			return nil, fmt.Errorf("%w for esdt key %s", errTrim, hex.EncodeToString(leaf.Key()))
+		}
+
+		esdtToken := &esdt.ESDigitalToken{}
+		err = n.internalMarshalizer.Unmarshal(esdtToken, value)
+		if err != nil {
+			return nil, err
+		}
+
+		tokenIdentifier, nonce := SplitESDTTokenKey(leaf.Key()[len(esdtPrefix):])
+		page.Tokens = append(page.Tokens, &esdt.ApiESDTToken{
+			TokenIdentifier: tokenIdentifier,
+			Nonce:           nonce,
+			Balance:         esdtToken.Value.String(),
+			Properties:      hex.EncodeToString(esdtToken.Properties),
+		})
+	}
+
+	return page, nil
+}
+
+func getESDTTokensPageRootHash(dataTrie data.Trie, rootHash string) ([]byte, error) {
+	if len(rootHash) == 0 {
+		return dataTrie.Root()
+	}
+
+	decodedRootHash, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid root hash: %w", err)
+	}
+
+	return decodedRootHash, nil
+}
+
+// SplitESDTTokenKey splits the key of an ESDT entry, without the ESDT prefix, in the token identifier and the NFT
+// nonce. The nonce is 0 for the fungible tokens
+func SplitESDTTokenKey(key []byte) (string, uint64) {
+	separatorIndex := strings.Index(string(key), esdtTickerSeparator)
+	if separatorIndex < 0 {
+		return string(key), 0
+	}
+
+	identifierLength := separatorIndex + len(esdtTickerSeparator) + esdtTokenRandomSuffixLength
+	nonceBytes := key[core.MinInt(identifierLength, len(key)):]
+	if len(nonceBytes) == 0 || len(nonceBytes) > 8 {
+		return string(key), 0
+	}
+
+	return string(key[:identifierLength]), big.NewInt(0).SetBytes(nonceBytes).Uint64()
+}
@@ -0,0 +1,168 @@
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testESDTAddress = []byte("address")
+
+func esdtTestKey(tokenKey string) []byte {
+	return []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + tokenKey)
+}
+
+func createNodeWithESDTTokens(t *testing.T, requestedRootHashes *[][]byte) *Node {
+	marshalizer := &mock.MarshalizerFake{}
+	keys := [][]byte{
+		[]byte("not an esdt key"),
+		esdtTestKey("TKN-abcdef"),
+		esdtTestKey("NFT-123456" + string([]byte{5})),
+		esdtTestKey("NFT-123456" + string([]byte{1, 2})),
+		esdtTestKey("OTHER-aaaaaa"),
+	}
+
+	leaves := make([]core.KeyValueHolder, 0, len(keys))
+	for i, key := range keys {
+		value, _ := marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(int64(i)), Properties: []byte{1}})
+		value = append(value, key...)
+		value = append(value, testESDTAddress...)
+		leaves = append(leaves, keyValStorage.NewKeyValStorage(key, value))
+	}
+
+	account, _ := state.NewUserAccount(testESDTAddress)
+	account.SetDataTrie(&mock.TrieStub{
+		RootCalled: func() ([]byte, error) {
+			return []byte("current root hash"), nil
+		},
+		GetAllLeavesFromKeyOnChannelCalled: func(rootHash []byte, startKey []byte) (chan core.KeyValueHolder, error) {
+			*requestedRootHashes = append(*requestedRootHashes, rootHash)
+
+			ch := make(chan core.KeyValueHolder)
+			go func() {
+				started := len(startKey) == 0
+				for _, leaf := range leaves {
+					started = started || bytes.Equal(leaf.Key(), startKey)
+					if started {
+						ch <- leaf
+					}
+				}
+				close(ch)
+			}()
+
+			return ch, nil
+		},
+	})
+
+	n, err := NewNode(
+		WithInternalMarshalizer(marshalizer, 100),
+		WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+		WithAccountsAdapter(&mock.AccountsStub{
+			GetExistingAccountCalled: func(_ []byte) (state.AccountHandler, error) {
+				return account, nil
+			},
+		}),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetESDTTokensPageInvalidPageSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rootHashes := make([][]byte, 0)
+	n := createNodeWithESDTTokens(t, &rootHashes)
+
+	page, err := n.GetESDTTokensPage(hex.EncodeToString(testESDTAddress), esdt.TokensPageOptions{})
+	assert.Nil(t, page)
+	assert.Equal(t, ErrInvalidPageSize, err)
+}
+
+func TestNode_GetESDTTokensPageShouldIterateInPagesOnTheSameRootHash(t *testing.T) {
+	t.Parallel()
+
+	rootHashes := make([][]byte, 0)
+	n := createNodeWithESDTTokens(t, &rootHashes)
+	address := hex.EncodeToString(testESDTAddress)
+
+	firstPage, err := n.GetESDTTokensPage(address, esdt.TokensPageOptions{PageSize: 2})
+	require.Nil(t, err)
+	expectedTokens := []*esdt.ApiESDTToken{
+		{TokenIdentifier: "TKN-abcdef", Balance: "1", Properties: "01"},
+		{TokenIdentifier: "NFT-123456", Nonce: 5, Balance: "2", Properties: "01"},
+	}
+	assert.Equal(t, expectedTokens, firstPage.Tokens)
+	assert.Equal(t, hex.EncodeToString([]byte("current root hash")), firstPage.RootHash)
+	assert.Equal(t, hex.EncodeToString(esdtTestKey("NFT-123456"+string([]byte{1, 2}))), firstPage.NextCursor)
+
+	secondPage, err := n.GetESDTTokensPage(address, esdt.TokensPageOptions{
+		RootHash: firstPage.RootHash,
+		Cursor:   firstPage.NextCursor,
+		PageSize: 2,
+	})
+	require.Nil(t, err)
+	expectedTokens = []*esdt.ApiESDTToken{
+		{TokenIdentifier: "NFT-123456", Nonce: 258, Balance: "3", Properties: "01"},
+		{TokenIdentifier: "OTHER-aaaaaa", Balance: "4", Properties: "01"},
+	}
+	assert.Equal(t, expectedTokens, secondPage.Tokens)
+	assert.Empty(t, secondPage.NextCursor)
+	assert.Equal(t, [][]byte{[]byte("current root hash"), []byte("current root hash")}, rootHashes)
+}
+
+func TestNode_GetESDTTokensPageWithTokenFilterShouldReturnOnlyTheTokenEntries(t *testing.T) {
+	t.Parallel()
+
+	rootHashes := make([][]byte, 0)
+	n := createNodeWithESDTTokens(t, &rootHashes)
+
+	page, err := n.GetESDTTokensPage(hex.EncodeToString(testESDTAddress), esdt.TokensPageOptions{
+		TokenIdentifier: "NFT-123456",
+		PageSize:        10,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(page.Tokens))
+	assert.Equal(t, uint64(5), page.Tokens[0].Nonce)
+	assert.Equal(t, uint64(258), page.Tokens[1].Nonce)
+	assert.Empty(t, page.NextCursor)
+}
+
+func TestNode_GetESDTTokensPageInvalidCursorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rootHashes := make([][]byte, 0)
+	n := createNodeWithESDTTokens(t, &rootHashes)
+
+	page, err := n.GetESDTTokensPage(hex.EncodeToString(testESDTAddress), esdt.TokensPageOptions{
+		Cursor:   "not hex",
+		PageSize: 10,
+	})
+	assert.Nil(t, page)
+	assert.NotNil(t, err)
+}
+
+func TestSplitESDTTokenKey(t *testing.T) {
+	t.Parallel()
+
+	identifier, nonce := SplitESDTTokenKey([]byte("TKN-abcdef"))
+	assert.Equal(t, "TKN-abcdef", identifier)
+	assert.Equal(t, uint64(0), nonce)
+
+	identifier, nonce = SplitESDTTokenKey([]byte("TKN-abcdef" + string([]byte{1, 0})))
+	assert.Equal(t, "TKN-abcdef", identifier)
+	assert.Equal(t, uint64(256), nonce)
+
+	identifier, nonce = SplitESDTTokenKey([]byte("newToken"))
+	assert.Equal(t, "newToken", identifier)
+	assert.Equal(t, uint64(0), nonce)
+}
@@ -0,0 +1,139 @@
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+// MaxLogsBlocksRange is the maximum number of blocks that can be covered by a logs query
+const MaxLogsBlocksRange = 10000
+
+// GetLogs returns the log events emitted in the provided block nonces range, that were generated by the provided
+// address and/or have the provided identifier. The logs are looked up using the db lookup extensions indices
+func (n *Node) GetLogs(filter transaction.LogsFilter) ([]*transaction.ApiLogEvent, error) {
+	if !n.historyRepository.IsEnabled() {
+		return nil, ErrDbLookupExtensionsNotEnabled
+	}
+	if len(filter.Address) == 0 && len(filter.Identifier) == 0 {
+		return nil, ErrEmptyLogsFilter
+	}
+
+	var address []byte
+	var err error
+	if len(filter.Address) > 0 {
+		address, err = n.addressPubkeyConverter.Decode(filter.Address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	fromNonce, toNonce, err := n.getLogsBlockRange(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var locations []*dblookupext.LogsLocation
+	if len(address) > 0 {
+		locations, err = n.historyRepository.GetLogsLocationsByAddress(address, fromNonce, toNonce)
+	} else {
+		locations, err = n.historyRepository.GetLogsLocationsByIdentifier([]byte(filter.Identifier), fromNonce, toNonce)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*transaction.ApiLogEvent, 0)
+	for _, location := range locations {
+		txLog, errGet := n.getTxLogFromStorage(location.TxHash, location.Epoch)
+		if errGet != nil {
+			log.Warn("GetLogs(): cannot get log from storage",
+				"txHash", hex.EncodeToString(location.TxHash),
+				"error", errGet.Error())
+			continue
+		}
+
+		events = append(events, n.filterLogEvents(txLog, location, address, []byte(filter.Identifier))...)
+	}
+
+	return events, nil
+}
+
+func (n *Node) getLogsBlockRange(filter transaction.LogsFilter) (uint64, uint64, error) {
+	toNonce := uint64(0)
+	if filter.ToBlock != nil {
+		toNonce = *filter.ToBlock
+	} else if !check.IfNil(n.blkc) && !check.IfNil(n.blkc.GetCurrentBlockHeader()) {
+		toNonce = n.blkc.GetCurrentBlockHeader().GetNonce()
+	}
+
+	fromNonce := uint64(0)
+	if filter.FromBlock != nil {
+		fromNonce = *filter.FromBlock
+	} else if toNonce >= MaxLogsBlocksRange {
+		fromNonce = toNonce - MaxLogsBlocksRange + 1
+	}
+
+	if fromNonce > toNonce || toNonce-fromNonce >= MaxLogsBlocksRange {
+		return 0, 0, fmt.Errorf("%w: fromBlock should not be greater than toBlock and the range should not exceed %d blocks",
+			ErrInvalidLogsBlockRange, MaxLogsBlocksRange)
+	}
+
+	return fromNonce, toNonce, nil
+}
+
+func (n *Node) getTxLogFromStorage(txHash []byte, epoch uint32) (*transaction.Log, error) {
+	txLogBytes, err := n.store.GetStorer(dataRetriever.TxLogsUnit).GetFromEpoch(txHash, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	txLog := &transaction.Log{}
+	err = n.internalMarshalizer.Unmarshal(txLog, txLogBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return txLog, nil
+}
+
+// filterLogEvents returns the events of the log that have the provided identifier, if any, and that were generated by
+// the provided address, if any. All the events of a log match an address filter equal to the log address
+func (n *Node) filterLogEvents(
+	txLog *transaction.Log,
+	location *dblookupext.LogsLocation,
+	address []byte,
+	identifier []byte,
+) []*transaction.ApiLogEvent {
+	events := make([]*transaction.ApiLogEvent, 0)
+	isLogAddress := len(address) > 0 && bytes.Equal(txLog.Address, address)
+	for index, event := range txLog.Events {
+		if event == nil {
+			continue
+		}
+		if len(identifier) > 0 && !bytes.Equal(event.Identifier, identifier) {
+			continue
+		}
+		if len(address) > 0 && !isLogAddress && !bytes.Equal(event.Address, address) {
+			continue
+		}
+
+		events = append(events, &transaction.ApiLogEvent{
+			TxHash:     hex.EncodeToString(location.TxHash),
+			BlockNonce: location.BlockNonce,
+			LogAddress: n.addressPubkeyConverter.Encode(txLog.Address),
+			EventIndex: uint32(index),
+			Address:    n.addressPubkeyConverter.Encode(event.Address),
+			Identifier: string(event.Identifier),
+			Topics:     event.Topics,
+			Data:       event.Data,
+		})
+	}
+
+	return events
+}
@@ -0,0 +1,159 @@
+package node
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeForLogs(t *testing.T, historyRepo dblookupext.HistoryRepository, txLogs map[string]*transaction.Log) *Node {
+	marshalizer := &mock.MarshalizerFake{}
+	dataStore := &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			require.Equal(t, dataRetriever.TxLogsUnit, unitType)
+			return &mock.StorerStub{
+				GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+					txLog, found := txLogs[string(key)]
+					if !found {
+						return nil, errors.New("not found")
+					}
+					return marshalizer.Marshal(txLog)
+				},
+			}
+		},
+	}
+	blockChain := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: 12000}
+		},
+	}
+
+	n, err := NewNode(
+		WithInternalMarshalizer(marshalizer, 0),
+		WithDataStore(dataStore),
+		WithHistoryRepository(historyRepo),
+		WithBlockChain(blockChain),
+		WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetLogsInvalidFilterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForLogs(t, &testscommon.HistoryRepositoryStub{
+		IsEnabledCalled: func() bool {
+			return false
+		},
+	}, nil)
+	events, err := n.GetLogs(transaction.LogsFilter{Identifier: "transfer"})
+	require.Nil(t, events)
+	require.Equal(t, ErrDbLookupExtensionsNotEnabled, err)
+
+	n = createNodeForLogs(t, &testscommon.HistoryRepositoryStub{}, nil)
+	events, err = n.GetLogs(transaction.LogsFilter{})
+	require.Nil(t, events)
+	require.Equal(t, ErrEmptyLogsFilter, err)
+
+	fromBlock, toBlock := uint64(10), uint64(9)
+	events, err = n.GetLogs(transaction.LogsFilter{Identifier: "transfer", FromBlock: &fromBlock, ToBlock: &toBlock})
+	require.Nil(t, events)
+	require.True(t, errors.Is(err, ErrInvalidLogsBlockRange))
+
+	toBlock = fromBlock + MaxLogsBlocksRange
+	events, err = n.GetLogs(transaction.LogsFilter{Identifier: "transfer", FromBlock: &fromBlock, ToBlock: &toBlock})
+	require.Nil(t, events)
+	require.True(t, errors.Is(err, ErrInvalidLogsBlockRange))
+}
+
+func TestNode_GetLogsByAddressShouldWork(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("contract")
+	txLogs := map[string]*transaction.Log{
+		"txA": {
+			Address: []byte("caller"),
+			Events: []*transaction.Event{
+				{Address: address, Identifier: []byte("transfer"), Topics: [][]byte{[]byte("topic")}, Data: []byte("data")},
+				{Address: []byte("other"), Identifier: []byte("transfer")},
+				{Address: address, Identifier: []byte("mint")},
+			},
+		},
+	}
+	var providedFrom, providedTo uint64
+	historyRepo := &testscommon.HistoryRepositoryStub{
+		GetLogsLocationsByAddressCalled: func(addr []byte, fromNonce uint64, toNonce uint64) ([]*dblookupext.LogsLocation, error) {
+			require.Equal(t, address, addr)
+			providedFrom, providedTo = fromNonce, toNonce
+			return []*dblookupext.LogsLocation{
+				{TxHash: []byte("txA"), BlockNonce: 11990, Epoch: 3},
+				{TxHash: []byte("txMissing"), BlockNonce: 11995, Epoch: 3},
+			}, nil
+		},
+	}
+	n := createNodeForLogs(t, historyRepo, txLogs)
+
+	events, err := n.GetLogs(transaction.LogsFilter{
+		Address:    hex.EncodeToString(address),
+		Identifier: "transfer",
+	})
+	require.Nil(t, err)
+	require.Equal(t, uint64(12000-MaxLogsBlocksRange+1), providedFrom)
+	require.Equal(t, uint64(12000), providedTo)
+	require.Equal(t, []*transaction.ApiLogEvent{
+		{
+			TxHash:     hex.EncodeToString([]byte("txA")),
+			BlockNonce: 11990,
+			LogAddress: hex.EncodeToString([]byte("caller")),
+			EventIndex: 0,
+			Address:    hex.EncodeToString(address),
+			Identifier: "transfer",
+			Topics:     [][]byte{[]byte("topic")},
+			Data:       []byte("data"),
+		},
+	}, events)
+}
+
+func TestNode_GetLogsByIdentifierShouldWork(t *testing.T) {
+	t.Parallel()
+
+	txLogs := map[string]*transaction.Log{
+		"txA": {
+			Address: []byte("contract"),
+			Events: []*transaction.Event{
+				{Address: []byte("contract"), Identifier: []byte("transfer")},
+				{Address: []byte("contract"), Identifier: []byte("mint")},
+				{Address: []byte("token"), Identifier: []byte("transfer")},
+			},
+		},
+	}
+	historyRepo := &testscommon.HistoryRepositoryStub{
+		GetLogsLocationsByIdentifierCalled: func(identifier []byte, fromNonce uint64, toNonce uint64) ([]*dblookupext.LogsLocation, error) {
+			require.Equal(t, []byte("transfer"), identifier)
+			require.Equal(t, uint64(5), fromNonce)
+			require.Equal(t, uint64(7), toNonce)
+			return []*dblookupext.LogsLocation{{TxHash: []byte("txA"), BlockNonce: 6}}, nil
+		},
+	}
+	n := createNodeForLogs(t, historyRepo, txLogs)
+
+	fromBlock, toBlock := uint64(5), uint64(7)
+	events, err := n.GetLogs(transaction.LogsFilter{Identifier: "transfer", FromBlock: &fromBlock, ToBlock: &toBlock})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(events))
+	require.Equal(t, uint32(0), events[0].EventIndex)
+	require.Equal(t, uint32(2), events[1].EventIndex)
+	require.Equal(t, hex.EncodeToString([]byte("token")), events[1].Address)
+}
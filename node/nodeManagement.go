@@ -0,0 +1,101 @@
+package node
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/mr-tron/base58/base58"
+)
+
+// GetConnectedPeers returns the p2p info of all the peers the node is currently connected to
+func (n *Node) GetConnectedPeers() []core.QueryP2PPeerInfo {
+	connectedPeers := n.messenger.ConnectedPeers()
+	sort.Slice(connectedPeers, func(i, j int) bool {
+		return connectedPeers[i].Pretty() < connectedPeers[j].Pretty()
+	})
+
+	peersInfo := make([]core.QueryP2PPeerInfo, 0, len(connectedPeers))
+	for _, p := range connectedPeers {
+		peersInfo = append(peersInfo, n.createPidInfo(p))
+	}
+
+	return peersInfo
+}
+
+// DisconnectPeer closes all the connections with the provided peer. The peer is able to connect again afterwards
+func (n *Node) DisconnectPeer(pid string) error {
+	peerID, err := decodePeerID(pid)
+	if err != nil {
+		return err
+	}
+
+	log.Info("disconnecting peer on management request", "pid", pid)
+
+	return n.messenger.ClosePeer(peerID)
+}
+
+// BlacklistPeer denies the provided peer for the given duration and closes all the connections with it
+func (n *Node) BlacklistPeer(pid string, duration time.Duration) error {
+	if duration <= 0 {
+		return ErrInvalidBlacklistDuration
+	}
+
+	peerID, err := decodePeerID(pid)
+	if err != nil {
+		return err
+	}
+
+	log.Info("blacklisting peer on management request", "pid", pid, "duration", duration)
+
+	err = n.peerDenialEvaluator.UpsertPeerID(peerID, duration)
+	if err != nil {
+		return err
+	}
+
+	return n.messenger.ClosePeer(peerID)
+}
+
+// TriggerStateSnapshot starts, in background, a snapshot of the current accounts state trie and returns its root hash
+func (n *Node) TriggerStateSnapshot() (string, error) {
+	rootHash, err := n.accounts.RootHash()
+	if err != nil {
+		return "", err
+	}
+
+	log.Info("triggering state snapshot on management request", "root hash", rootHash)
+	n.accounts.SnapshotState(rootHash, context.Background())
+
+	return hex.EncodeToString(rootHash), nil
+}
+
+// CompactStorage compacts all the storage units of the node that support compaction
+func (n *Node) CompactStorage() error {
+	compacter, ok := n.store.(storage.Compacter)
+	if !ok {
+		return ErrStorageCompactionNotSupported
+	}
+
+	log.Info("compacting storage on management request")
+	startTime := time.Now()
+	err := compacter.Compact()
+	if err != nil {
+		return err
+	}
+	log.Info("storage compacted", "duration", time.Since(startTime))
+
+	return nil
+}
+
+func decodePeerID(pid string) (core.PeerID, error) {
+	buff, err := base58.Decode(pid)
+	if err != nil || len(buff) == 0 {
+		return "", fmt.Errorf("%w: %s", ErrInvalidPeerID, pid)
+	}
+
+	return core.PeerID(buff), nil
+}
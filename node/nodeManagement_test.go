@@ -0,0 +1,73 @@
+package node_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNode_BlacklistPeerShouldDenyAndDisconnect(t *testing.T) {
+	t.Parallel()
+
+	pid := core.PeerID("peer")
+	upsertedDuration := time.Duration(0)
+	closedPid := core.PeerID("")
+	n, _ := node.NewNode(
+		node.WithMessenger(&mock.MessengerStub{
+			ClosePeerCalled: func(pid core.PeerID) error {
+				closedPid = pid
+				return nil
+			},
+		}),
+		node.WithPeerDenialEvaluator(&mock.PeerDenialEvaluatorStub{
+			UpsertPeerIDCalled: func(p core.PeerID, duration time.Duration) error {
+				assert.Equal(t, pid, p)
+				upsertedDuration = duration
+				return nil
+			},
+		}),
+	)
+
+	err := n.BlacklistPeer(pid.Pretty(), time.Hour)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Hour, upsertedDuration)
+	assert.Equal(t, pid, closedPid)
+}
+
+func TestNode_BlacklistPeerInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithMessenger(&mock.MessengerStub{
+			ClosePeerCalled: func(pid core.PeerID) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}),
+	)
+
+	err := n.BlacklistPeer(core.PeerID("peer").Pretty(), 0)
+	assert.Equal(t, node.ErrInvalidBlacklistDuration, err)
+
+	err = n.BlacklistPeer("0OIl", time.Hour)
+	assert.True(t, errors.Is(err, node.ErrInvalidPeerID))
+
+	err = n.DisconnectPeer("")
+	assert.True(t, errors.Is(err, node.ErrInvalidPeerID))
+}
+
+func TestNode_CompactStorageNotSupportedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithDataStore(&mock.ChainStorerMock{}),
+	)
+
+	err := n.CompactStorage()
+	assert.Equal(t, node.ErrStorageCompactionNotSupported, err)
+}
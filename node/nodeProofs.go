@@ -0,0 +1,172 @@
+package node
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+)
+
+// GetProof returns the merkle proof of the given address in the accounts trie having the provided root hash. An empty
+// root hash means the current accounts trie root hash
+func (n *Node) GetProof(rootHash string, address string) (*state.ApiProof, error) {
+	accountsTrie, rootHashBytes, addressBytes, err := n.getAccountsTrieAndAddress(rootHash, address)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, value, err := getProofAndValue(accountsTrie, addressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &state.ApiProof{
+		RootHash: hex.EncodeToString(rootHashBytes),
+		Address:  address,
+		Key:      hex.EncodeToString(addressBytes),
+		Value:    hex.EncodeToString(value),
+		Proof:    proof,
+	}, nil
+}
+
+// GetProofDataTrie returns the merkle proof of the given hex encoded key from the data trie of the given address,
+// together with the proof of the account in the accounts trie having the provided root hash. An empty root hash means
+// the current accounts trie root hash
+func (n *Node) GetProofDataTrie(rootHash string, address string, key string) (*state.ApiProof, error) {
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil || len(keyBytes) == 0 {
+		return nil, fmt.Errorf("invalid key: %s", key)
+	}
+
+	accountsTrie, rootHashBytes, addressBytes, err := n.getAccountsTrieAndAddress(rootHash, address)
+	if err != nil {
+		return nil, err
+	}
+
+	accountProof, accountBytes, err := getProofAndValue(accountsTrie, addressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := state.NewUserAccount(addressBytes)
+	if err != nil {
+		return nil, err
+	}
+	err = n.internalMarshalizer.Unmarshal(account, accountBytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(account.GetRootHash()) == 0 {
+		return nil, ErrEmptyDataTrie
+	}
+
+	dataTrie, err := accountsTrie.Recreate(account.GetRootHash())
+	if err != nil {
+		return nil, err
+	}
+
+	proof, value, err := getProofAndValue(dataTrie, keyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// the data trie values are suffixed with the key and the address, as done by the trackable data trie
+	tailLength := len(keyBytes) + len(addressBytes)
+	if len(value) >= tailLength {
+		value = value[:len(value)-tailLength]
+	}
+
+	return &state.ApiProof{
+		RootHash:         hex.EncodeToString(rootHashBytes),
+		Address:          address,
+		Key:              key,
+		Value:            hex.EncodeToString(value),
+		Proof:            proof,
+		DataTrieRootHash: hex.EncodeToString(account.GetRootHash()),
+		AccountProof:     accountProof,
+	}, nil
+}
+
+// VerifyProof checks that the hex encoded proof proves the hex encoded key against the given root hash. The hex encoded
+// value held by the key, as stored in the trie, is returned when the proof is valid
+func (n *Node) VerifyProof(rootHash string, key string, proof []string) (bool, string, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil || len(rootHashBytes) == 0 {
+		return false, "", fmt.Errorf("invalid root hash: %s", rootHash)
+	}
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil || len(keyBytes) == 0 {
+		return false, "", fmt.Errorf("invalid key: %s", key)
+	}
+
+	proofBytes := make([][]byte, 0, len(proof))
+	for _, encodedNode := range proof {
+		nodeBytes, errDecode := hex.DecodeString(encodedNode)
+		if errDecode != nil {
+			return false, "", fmt.Errorf("invalid proof node: %w", errDecode)
+		}
+		proofBytes = append(proofBytes, nodeBytes)
+	}
+
+	value, err := trie.VerifyProof(rootHashBytes, keyBytes, proofBytes, n.internalMarshalizer, n.hasher)
+	if errors.Is(err, trie.ErrInvalidProof) {
+		return false, "", nil
+	}
+	if err != nil {
+		return false, "", err
+	}
+
+	return true, hex.EncodeToString(value), nil
+}
+
+func (n *Node) getAccountsTrieAndAddress(rootHash string, address string) (data.Trie, []byte, []byte, error) {
+	if check.IfNil(n.addressPubkeyConverter) || check.IfNil(n.accounts) {
+		return nil, nil, nil, errors.New("initialize AccountsAdapter and PubkeyConverter first")
+	}
+
+	addressBytes, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, nil, nil, errors.New("invalid address, could not decode from: " + err.Error())
+	}
+
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid root hash: %w", err)
+	}
+	if len(rootHashBytes) == 0 {
+		rootHashBytes, err = n.accounts.RootHash()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	accountsTrie, err := n.accounts.GetTrie(rootHashBytes)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return accountsTrie, rootHashBytes, addressBytes, nil
+}
+
+func getProofAndValue(tr data.Trie, key []byte) ([]string, []byte, error) {
+	proof, err := tr.GetProof(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	value, err := tr.Get(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hexProof := make([]string, 0, len(proof))
+	for _, encodedNode := range proof {
+		hexProof = append(hexProof, hex.EncodeToString(encodedNode))
+	}
+
+	return hexProof, value, nil
+}
@@ -0,0 +1,71 @@
+package node_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetProofShouldUseTheCurrentRootHash(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	address := []byte("address")
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(mock.NewPubkeyConverterMock(len(address))),
+		node.WithAccountsAdapter(&mock.AccountsStub{
+			RootHashCalled: func() ([]byte, error) {
+				return rootHash, nil
+			},
+			GetTrieCalled: func(providedRootHash []byte) (data.Trie, error) {
+				assert.Equal(t, rootHash, providedRootHash)
+				return &mock.TrieStub{
+					GetProofCalled: func(key []byte) ([][]byte, error) {
+						assert.Equal(t, address, key)
+						return [][]byte{[]byte("branch"), []byte("leaf")}, nil
+					},
+					GetCalled: func(key []byte) ([]byte, error) {
+						return []byte("account"), nil
+					},
+				}, nil
+			},
+		}),
+	)
+
+	proof, err := n.GetProof("", hex.EncodeToString(address))
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(rootHash), proof.RootHash)
+	assert.Equal(t, hex.EncodeToString(address), proof.Key)
+	assert.Equal(t, hex.EncodeToString([]byte("account")), proof.Value)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("branch")), hex.EncodeToString([]byte("leaf"))}, proof.Proof)
+}
+
+func TestNode_VerifyProof(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(&mock.MarshalizerMock{}, 0),
+		node.WithHasher(&mock.HasherMock{
+			ComputeCalled: func(s string) []byte {
+				return bytes.Repeat([]byte{1}, 32)
+			},
+		}),
+	)
+
+	ok, value, err := n.VerifyProof(hex.EncodeToString([]byte("root hash")), "aa", []string{"bb"})
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, "", value)
+
+	_, _, err = n.VerifyProof("not hex", "aa", []string{"bb"})
+	assert.NotNil(t, err)
+
+	_, _, err = n.VerifyProof(hex.EncodeToString([]byte("root hash")), "aa", []string{"not hex"})
+	assert.NotNil(t, err)
+}
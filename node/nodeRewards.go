@@ -0,0 +1,105 @@
+package node
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+)
+
+// GetRewardsAudit returns the rewards audit record of the given epoch. The records are only created by the metachain
+// nodes, when the rewards of the epoch are computed
+func (n *Node) GetRewardsAudit(epoch uint32) (*epochStart.RewardsAudit, error) {
+	if n.shardCoordinator.SelfId() != core.MetachainShardId {
+		return nil, ErrMetachainOnlyEndpoint
+	}
+
+	storer := n.store.GetStorer(dataRetriever.RewardTransactionUnit)
+	buff, err := storer.SearchFirst([]byte(core.RewardsAuditIdentifier(epoch)))
+	if err != nil {
+		return nil, fmt.Errorf("%w for epoch %d: %s", ErrRewardsAuditNotFound, epoch, err.Error())
+	}
+
+	rewardsAudit := &epochStart.RewardsAudit{}
+	err = json.Unmarshal(buff, rewardsAudit)
+	if err != nil {
+		return nil, err
+	}
+
+	return rewardsAudit, nil
+}
+
+// GetRewardsProof returns the merkle proof of the rewards earned in the given epoch by the provided address, which can
+// be verified against the rewards root hash committed in the start of epoch meta block. The rewards checkpoints are
+// only created by the metachain nodes
+func (n *Node) GetRewardsProof(epoch uint32, address string) (*epochStart.RewardsProof, error) {
+	if n.shardCoordinator.SelfId() != core.MetachainShardId {
+		return nil, ErrMetachainOnlyEndpoint
+	}
+
+	addressBytes, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, errors.New("invalid address, could not decode from: " + err.Error())
+	}
+
+	storer := n.store.GetStorer(dataRetriever.RewardTransactionUnit)
+	buff, err := storer.SearchFirst([]byte(core.RewardsCheckpointIdentifier(epoch)))
+	if err != nil {
+		return nil, fmt.Errorf("%w for epoch %d: %s", ErrRewardsCheckpointNotFound, epoch, err.Error())
+	}
+
+	checkpoint := &epochStart.RewardsCheckpoint{}
+	err = json.Unmarshal(buff, checkpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkpoint.CreateProof(n.hasher, addressBytes)
+}
+
+// GetSupplyAccounting returns the record of the tokens minted, the fees burned and the treasury inflows of the given
+// epoch. The records are only created by the metachain nodes, when the rewards of the epoch are computed
+func (n *Node) GetSupplyAccounting(epoch uint32) (*epochStart.SupplyAccounting, error) {
+	if n.shardCoordinator.SelfId() != core.MetachainShardId {
+		return nil, ErrMetachainOnlyEndpoint
+	}
+
+	storer := n.store.GetStorer(dataRetriever.RewardTransactionUnit)
+	buff, err := storer.SearchFirst([]byte(core.SupplyAccountingIdentifier(epoch)))
+	if err != nil {
+		return nil, fmt.Errorf("%w for epoch %d: %s", ErrSupplyAccountingNotFound, epoch, err.Error())
+	}
+
+	supplyAccounting := &epochStart.SupplyAccounting{}
+	err = json.Unmarshal(buff, supplyAccounting)
+	if err != nil {
+		return nil, err
+	}
+
+	return supplyAccounting, nil
+}
+
+// GetEpochEconomics returns the aggregated fees, burns, mints and distributed rewards of the given epoch. The records
+// are only created by the metachain nodes, when the rewards of the epoch are computed
+func (n *Node) GetEpochEconomics(epoch uint32) (*epochStart.EpochEconomics, error) {
+	if n.shardCoordinator.SelfId() != core.MetachainShardId {
+		return nil, ErrMetachainOnlyEndpoint
+	}
+
+	storer := n.store.GetStorer(dataRetriever.RewardTransactionUnit)
+	buff, err := storer.SearchFirst([]byte(core.EpochEconomicsIdentifier(epoch)))
+	if err != nil {
+		return nil, fmt.Errorf("%w for epoch %d: %s", ErrEpochEconomicsNotFound, epoch, err.Error())
+	}
+
+	epochEconomics := &epochStart.EpochEconomics{}
+	err = json.Unmarshal(buff, epochEconomics)
+	if err != nil {
+		return nil, err
+	}
+
+	return epochEconomics, nil
+}
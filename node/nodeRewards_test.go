@@ -0,0 +1,147 @@
+package node_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeForRewardsAudit(selfShardID uint32, storer storage.Storer) *node.Node {
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: selfShardID}),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+				if unitType != dataRetriever.RewardTransactionUnit {
+					return nil
+				}
+				return storer
+			},
+		}),
+	)
+
+	return n
+}
+
+func TestNode_GetRewardsAuditOnShardNodeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForRewardsAudit(0, genericmocks.NewStorerMock("rewards", 0))
+
+	rewardsAudit, err := n.GetRewardsAudit(3)
+	assert.Equal(t, node.ErrMetachainOnlyEndpoint, err)
+	assert.Nil(t, rewardsAudit)
+}
+
+func TestNode_GetRewardsAuditNotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForRewardsAudit(core.MetachainShardId, genericmocks.NewStorerMock("rewards", 0))
+
+	rewardsAudit, err := n.GetRewardsAudit(3)
+	assert.True(t, errors.Is(err, node.ErrRewardsAuditNotFound))
+	assert.Nil(t, rewardsAudit)
+}
+
+func TestNode_GetRewardsAuditShouldWork(t *testing.T) {
+	t.Parallel()
+
+	storer := genericmocks.NewStorerMock("rewards", 0)
+	expectedRewardsAudit := &epochStart.RewardsAudit{
+		Epoch:             3,
+		TotalToDistribute: "1000",
+		Shards: []*epochStart.ShardRewardsAudit{
+			{
+				ShardID:    core.MetachainShardId,
+				Validators: []*epochStart.ValidatorRewardsAudit{{PublicKey: "aa", BaseReward: "10", IsRewarded: true}},
+			},
+		},
+	}
+	buff, _ := json.Marshal(expectedRewardsAudit)
+	_ = storer.Put([]byte(core.RewardsAuditIdentifier(3)), buff)
+
+	n := createNodeForRewardsAudit(core.MetachainShardId, storer)
+
+	rewardsAudit, err := n.GetRewardsAudit(3)
+	require.Nil(t, err)
+	assert.Equal(t, expectedRewardsAudit, rewardsAudit)
+}
+
+func TestNode_GetSupplyAccountingOnShardNodeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForRewardsAudit(0, genericmocks.NewStorerMock("rewards", 0))
+
+	supplyAccounting, err := n.GetSupplyAccounting(3)
+	assert.Equal(t, node.ErrMetachainOnlyEndpoint, err)
+	assert.Nil(t, supplyAccounting)
+}
+
+func TestNode_GetSupplyAccountingShouldWork(t *testing.T) {
+	t.Parallel()
+
+	storer := genericmocks.NewStorerMock("rewards", 0)
+	n := createNodeForRewardsAudit(core.MetachainShardId, storer)
+
+	supplyAccounting, err := n.GetSupplyAccounting(3)
+	assert.True(t, errors.Is(err, node.ErrSupplyAccountingNotFound))
+	assert.Nil(t, supplyAccounting)
+
+	expectedSupplyAccounting := &epochStart.SupplyAccounting{
+		Epoch:                     3,
+		TotalSupply:               "1000",
+		InflationMinted:           "10",
+		CumulativeInflationMinted: "30",
+	}
+	buff, _ := json.Marshal(expectedSupplyAccounting)
+	_ = storer.Put([]byte(core.SupplyAccountingIdentifier(3)), buff)
+
+	supplyAccounting, err = n.GetSupplyAccounting(3)
+	require.Nil(t, err)
+	assert.Equal(t, expectedSupplyAccounting, supplyAccounting)
+}
+
+func TestNode_GetEpochEconomicsOnShardNodeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForRewardsAudit(0, genericmocks.NewStorerMock("rewards", 0))
+
+	epochEconomics, err := n.GetEpochEconomics(3)
+	assert.Equal(t, node.ErrMetachainOnlyEndpoint, err)
+	assert.Nil(t, epochEconomics)
+}
+
+func TestNode_GetEpochEconomicsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	storer := genericmocks.NewStorerMock("rewards", 0)
+	n := createNodeForRewardsAudit(core.MetachainShardId, storer)
+
+	epochEconomics, err := n.GetEpochEconomics(3)
+	assert.True(t, errors.Is(err, node.ErrEpochEconomicsNotFound))
+	assert.Nil(t, epochEconomics)
+
+	expectedEpochEconomics := &epochStart.EpochEconomics{
+		Epoch:              3,
+		TotalFees:          "100",
+		BurnedFees:         "5",
+		DeveloperRewards:   "30",
+		InflationMinted:    "1000",
+		RewardsDistributed: "1065",
+	}
+	buff, _ := json.Marshal(expectedEpochEconomics)
+	_ = storer.Put([]byte(core.EpochEconomicsIdentifier(3)), buff)
+
+	epochEconomics, err = n.GetEpochEconomics(3)
+	require.Nil(t, err)
+	assert.Equal(t, expectedEpochEconomics, epochEconomics)
+}
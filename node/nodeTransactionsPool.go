@@ -0,0 +1,244 @@
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+// transactionsCache defines the iteration over the wrapped transactions, implemented by the caches of the pool
+type transactionsCache interface {
+	ForEachTransaction(function txcache.ForEachTransaction)
+}
+
+type poolSenderTxs struct {
+	sender            []byte
+	accountNonce      *uint64
+	txs               []*txcache.WrappedTransaction
+	txsWithNonceGap   map[string]struct{}
+	firstMissingNonce *uint64
+}
+
+type poolFilter struct {
+	sender   []byte
+	receiver []byte
+	shardIDs []uint32
+}
+
+// GetTransactionsPool returns the pending transactions from the pool that match the provided filter, sorted by
+// sender and nonce. The transactions that are preceded by a missing nonce are marked with the nonce gap flag
+func (n *Node) GetTransactionsPool(filter transaction.PoolFilter) ([]*transaction.ApiPoolTransaction, error) {
+	senders, decodedFilter, err := n.getPoolTransactionsBySender(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*transaction.ApiPoolTransaction, 0)
+	for _, senderTxs := range senders {
+		for _, wrappedTx := range senderTxs.txs {
+			if len(decodedFilter.receiver) > 0 && !bytes.Equal(wrappedTx.Tx.GetRcvAddr(), decodedFilter.receiver) {
+				continue
+			}
+
+			_, hasNonceGap := senderTxs.txsWithNonceGap[string(wrappedTx.TxHash)]
+			result = append(result, &transaction.ApiPoolTransaction{
+				Hash:             hex.EncodeToString(wrappedTx.TxHash),
+				Nonce:            wrappedTx.Tx.GetNonce(),
+				Sender:           n.addressPubkeyConverter.Encode(wrappedTx.Tx.GetSndAddr()),
+				Receiver:         n.addressPubkeyConverter.Encode(wrappedTx.Tx.GetRcvAddr()),
+				Value:            wrappedTx.Tx.GetValue().String(),
+				GasPrice:         wrappedTx.Tx.GetGasPrice(),
+				GasLimit:         wrappedTx.Tx.GetGasLimit(),
+				SourceShard:      wrappedTx.SenderShardID,
+				DestinationShard: wrappedTx.ReceiverShardID,
+				NonceGap:         hasNonceGap,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// GetTransactionsPoolSummary returns, for each sender that has pending transactions matching the provided filter,
+// the number of transactions, the nonce range and the first missing nonce, if any
+func (n *Node) GetTransactionsPoolSummary(filter transaction.PoolFilter) ([]*transaction.ApiPoolSenderSummary, error) {
+	senders, decodedFilter, err := n.getPoolTransactionsBySender(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*transaction.ApiPoolSenderSummary, 0, len(senders))
+	for _, senderTxs := range senders {
+		if len(decodedFilter.receiver) > 0 && !hasTxForReceiver(senderTxs.txs, decodedFilter.receiver) {
+			continue
+		}
+
+		numTxs := len(senderTxs.txs)
+		result = append(result, &transaction.ApiPoolSenderSummary{
+			Sender:            n.addressPubkeyConverter.Encode(senderTxs.sender),
+			AccountNonce:      senderTxs.accountNonce,
+			NumTxs:            uint32(numTxs),
+			LowestNonce:       senderTxs.txs[0].Tx.GetNonce(),
+			HighestNonce:      senderTxs.txs[numTxs-1].Tx.GetNonce(),
+			HasNonceGap:       senderTxs.firstMissingNonce != nil,
+			FirstMissingNonce: senderTxs.firstMissingNonce,
+		})
+	}
+
+	return result, nil
+}
+
+func hasTxForReceiver(txs []*txcache.WrappedTransaction, receiver []byte) bool {
+	for _, wrappedTx := range txs {
+		if bytes.Equal(wrappedTx.Tx.GetRcvAddr(), receiver) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (n *Node) getPoolTransactionsBySender(filter transaction.PoolFilter) ([]*poolSenderTxs, *poolFilter, error) {
+	if check.IfNil(n.dataPool) || check.IfNil(n.dataPool.Transactions()) {
+		return nil, nil, ErrNilDataPool
+	}
+	if check.IfNil(n.addressPubkeyConverter) {
+		return nil, nil, ErrNilPubkeyConverter
+	}
+
+	decodedFilter, err := n.decodePoolFilter(filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txsBySender := make(map[string]*poolSenderTxs)
+	for _, shardID := range decodedFilter.shardIDs {
+		cacheID := process.ShardCacherIdentifier(shardID, n.shardCoordinator.SelfId())
+		cache, ok := n.dataPool.Transactions().ShardDataStore(cacheID).(transactionsCache)
+		if !ok || check.IfNilReflect(cache) {
+			continue
+		}
+
+		cache.ForEachTransaction(func(_ []byte, wrappedTx *txcache.WrappedTransaction) {
+			if wrappedTx == nil || check.IfNil(wrappedTx.Tx) || wrappedTx.SenderShardID != shardID {
+				return
+			}
+
+			sender := wrappedTx.Tx.GetSndAddr()
+			if len(decodedFilter.sender) > 0 && !bytes.Equal(sender, decodedFilter.sender) {
+				return
+			}
+
+			senderTxs, found := txsBySender[string(sender)]
+			if !found {
+				senderTxs = &poolSenderTxs{
+					sender:          sender,
+					txsWithNonceGap: make(map[string]struct{}),
+				}
+				txsBySender[string(sender)] = senderTxs
+			}
+			senderTxs.txs = append(senderTxs.txs, wrappedTx)
+		})
+	}
+
+	senders := make([]*poolSenderTxs, 0, len(txsBySender))
+	for _, senderTxs := range txsBySender {
+		senderTxs.accountNonce = n.getPoolSenderAccountNonce(senderTxs.sender)
+		computeNonceGaps(senderTxs)
+		senders = append(senders, senderTxs)
+	}
+	sort.Slice(senders, func(i, j int) bool {
+		return bytes.Compare(senders[i].sender, senders[j].sender) < 0
+	})
+
+	return senders, decodedFilter, nil
+}
+
+func (n *Node) decodePoolFilter(filter transaction.PoolFilter) (*poolFilter, error) {
+	decodedFilter := &poolFilter{}
+
+	var err error
+	if len(filter.Sender) > 0 {
+		decodedFilter.sender, err = n.addressPubkeyConverter.Decode(filter.Sender)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(filter.Receiver) > 0 {
+		decodedFilter.receiver, err = n.addressPubkeyConverter.Decode(filter.Receiver)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if filter.ShardID != nil {
+		if *filter.ShardID >= n.shardCoordinator.NumberOfShards() && *filter.ShardID != core.MetachainShardId {
+			return nil, ErrInvalidShardId
+		}
+
+		decodedFilter.shardIDs = []uint32{*filter.ShardID}
+		return decodedFilter, nil
+	}
+
+	for shardID := uint32(0); shardID < n.shardCoordinator.NumberOfShards(); shardID++ {
+		decodedFilter.shardIDs = append(decodedFilter.shardIDs, shardID)
+	}
+	decodedFilter.shardIDs = append(decodedFilter.shardIDs, core.MetachainShardId)
+
+	return decodedFilter, nil
+}
+
+// getPoolSenderAccountNonce returns the current nonce of the sender, only known for the senders in the self shard
+func (n *Node) getPoolSenderAccountNonce(sender []byte) *uint64 {
+	if n.shardCoordinator.ComputeId(sender) != n.shardCoordinator.SelfId() || check.IfNil(n.accounts) {
+		return nil
+	}
+
+	account, err := n.accounts.GetExistingAccount(sender)
+	if err != nil {
+		if err == state.ErrAccNotFound {
+			accountNonce := uint64(0)
+			return &accountNonce
+		}
+
+		log.Debug("getPoolSenderAccountNonce", "error", err.Error())
+		return nil
+	}
+
+	accountNonce := account.GetNonce()
+	return &accountNonce
+}
+
+// computeNonceGaps sorts the transactions of the sender by nonce and marks the transactions that are preceded by a
+// missing nonce. The expected nonce starts from the account nonce when known, otherwise from the lowest pool nonce
+func computeNonceGaps(senderTxs *poolSenderTxs) {
+	sort.Slice(senderTxs.txs, func(i, j int) bool {
+		return senderTxs.txs[i].Tx.GetNonce() < senderTxs.txs[j].Tx.GetNonce()
+	})
+
+	expectedNonce := senderTxs.txs[0].Tx.GetNonce()
+	if senderTxs.accountNonce != nil {
+		expectedNonce = *senderTxs.accountNonce
+	}
+
+	for _, wrappedTx := range senderTxs.txs {
+		nonce := wrappedTx.Tx.GetNonce()
+		if nonce > expectedNonce {
+			senderTxs.txsWithNonceGap[string(wrappedTx.TxHash)] = struct{}{}
+			if senderTxs.firstMissingNonce == nil {
+				firstMissingNonce := expectedNonce
+				senderTxs.firstMissingNonce = &firstMissingNonce
+			}
+		}
+		if nonce >= expectedNonce {
+			expectedNonce = nonce + 1
+		}
+	}
+}
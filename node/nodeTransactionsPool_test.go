@@ -0,0 +1,159 @@
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeWithTransactionsPool(t *testing.T) *Node {
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(3)
+	shardCoordinator.CurrentShard = 1
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, []byte("bob")) {
+			return 2
+		}
+		return 1
+	}
+
+	accounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			account, _ := state.NewUserAccount(address)
+			if bytes.Equal(address, []byte("alice")) {
+				account.IncreaseNonce(3)
+			}
+			return account, nil
+		},
+	}
+
+	dataPool := testscommon.NewPoolsHolderMock()
+	addTx := func(hash string, sender string, receiver string, nonce uint64, cacheID string) {
+		tx := &transaction.Transaction{
+			Nonce:   nonce,
+			SndAddr: []byte(sender),
+			RcvAddr: []byte(receiver),
+			Value:   big.NewInt(1),
+		}
+		dataPool.Transactions().AddData([]byte(hash), tx, 100, cacheID)
+	}
+	addTx("alice5", "alice", "bob", 5, "1")
+	addTx("alice3", "alice", "carol", 3, "1")
+	addTx("alice6", "alice", "carol", 6, "1")
+	addTx("carol0", "carol", "alice", 0, "1")
+	addTx("bob10", "bob", "alice", 10, "2_1")
+	addTx("bob12", "bob", "alice", 12, "2_1")
+
+	n, err := NewNode(
+		WithDataPool(dataPool),
+		WithAccountsAdapter(accounts),
+		WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+		WithShardCoordinator(shardCoordinator),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetTransactionsPoolShouldHighlightNonceGaps(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithTransactionsPool(t)
+
+	txs, err := n.GetTransactionsPool(transaction.PoolFilter{})
+	require.Nil(t, err)
+
+	hashes := make([]string, 0, len(txs))
+	gaps := make(map[string]bool)
+	for _, tx := range txs {
+		hash, _ := hex.DecodeString(tx.Hash)
+		hashes = append(hashes, string(hash))
+		gaps[string(hash)] = tx.NonceGap
+	}
+
+	assert.Equal(t, []string{"alice3", "alice5", "alice6", "bob10", "bob12", "carol0"}, hashes)
+	expectedGaps := map[string]bool{
+		"alice3": false,
+		"alice5": true,
+		"alice6": false,
+		"bob10":  false,
+		"bob12":  true,
+		"carol0": false,
+	}
+	assert.Equal(t, expectedGaps, gaps)
+}
+
+func TestNode_GetTransactionsPoolWithFiltersShouldWork(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithTransactionsPool(t)
+
+	txs, err := n.GetTransactionsPool(transaction.PoolFilter{
+		Sender:   hex.EncodeToString([]byte("alice")),
+		Receiver: hex.EncodeToString([]byte("carol")),
+	})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(txs))
+	assert.Equal(t, uint64(3), txs[0].Nonce)
+	assert.Equal(t, uint64(6), txs[1].Nonce)
+	assert.False(t, txs[1].NonceGap)
+
+	shardID := uint32(2)
+	txs, err = n.GetTransactionsPool(transaction.PoolFilter{ShardID: &shardID})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(txs))
+	assert.Equal(t, hex.EncodeToString([]byte("bob")), txs[0].Sender)
+	assert.Equal(t, uint32(2), txs[0].SourceShard)
+
+	invalidShardID := uint32(7)
+	txs, err = n.GetTransactionsPool(transaction.PoolFilter{ShardID: &invalidShardID})
+	assert.Nil(t, txs)
+	assert.Equal(t, ErrInvalidShardId, err)
+
+	txs, err = n.GetTransactionsPool(transaction.PoolFilter{Sender: "not hex"})
+	assert.Nil(t, txs)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetTransactionsPoolSummaryShouldWork(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithTransactionsPool(t)
+
+	summary, err := n.GetTransactionsPoolSummary(transaction.PoolFilter{})
+	require.Nil(t, err)
+	require.Equal(t, 3, len(summary))
+
+	alice := summary[0]
+	assert.Equal(t, hex.EncodeToString([]byte("alice")), alice.Sender)
+	require.NotNil(t, alice.AccountNonce)
+	assert.Equal(t, uint64(3), *alice.AccountNonce)
+	assert.Equal(t, uint32(3), alice.NumTxs)
+	assert.Equal(t, uint64(3), alice.LowestNonce)
+	assert.Equal(t, uint64(6), alice.HighestNonce)
+	assert.True(t, alice.HasNonceGap)
+	require.NotNil(t, alice.FirstMissingNonce)
+	assert.Equal(t, uint64(4), *alice.FirstMissingNonce)
+
+	bob := summary[1]
+	assert.Nil(t, bob.AccountNonce)
+	assert.True(t, bob.HasNonceGap)
+	assert.Equal(t, uint64(11), *bob.FirstMissingNonce)
+
+	carol := summary[2]
+	require.NotNil(t, carol.AccountNonce)
+	assert.False(t, carol.HasNonceGap)
+	assert.Nil(t, carol.FirstMissingNonce)
+
+	summary, err = n.GetTransactionsPoolSummary(transaction.PoolFilter{Receiver: hex.EncodeToString([]byte("bob"))})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(summary))
+	assert.Equal(t, hex.EncodeToString([]byte("alice")), summary[0].Sender)
+}
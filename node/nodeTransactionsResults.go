@@ -11,6 +11,8 @@ import (
 )
 
 func (n *Node) putResultsInTransaction(hash []byte, tx *transaction.ApiTransactionResult, epoch uint32) {
+	n.putLogsInTransaction(hash, tx, epoch)
+
 	resultsHashes, err := n.historyRepository.GetResultsHashesByTxHash(hash, epoch)
 	if err != nil {
 		return
@@ -24,6 +26,32 @@ func (n *Node) putResultsInTransaction(hash []byte, tx *transaction.ApiTransacti
 	n.putSmartContractResultsInTransaction(tx, resultsHashes.ScResultsHashesAndEpoch)
 }
 
+// putExecutionStatusInTransaction marks the executed transaction as failed, providing the failure reason, if one of
+// its smart contract results, found through the transaction results index, holds an error return code
+func (n *Node) putExecutionStatusInTransaction(hash []byte, tx *transaction.ApiTransactionResult, epoch uint32) {
+	if tx.Status != transaction.TxStatusSuccess {
+		return
+	}
+
+	results := tx.SmartContractResults
+	if len(results) == 0 {
+		resultsHashes, err := n.historyRepository.GetResultsHashesByTxHash(hash, epoch)
+		if err != nil || resultsHashes == nil || len(resultsHashes.ReceiptsHash) > 0 {
+			return
+		}
+
+		results = n.getSmartContractResults(resultsHashes.ScResultsHashesAndEpoch)
+	}
+
+	reason, failed := transaction.FindExecutionFailure(hex.EncodeToString(hash), results)
+	if !failed {
+		return
+	}
+
+	tx.Status = transaction.TxStatusFail
+	tx.FailReason = reason
+}
+
 func (n *Node) putReceiptInTransaction(tx *transaction.ApiTransactionResult, recHash []byte, epoch uint32) {
 	rec, err := n.getReceiptFromStorage(recHash, epoch)
 	if err != nil {
@@ -51,6 +79,30 @@ func (n *Node) getReceiptFromStorage(hash []byte, epoch uint32) (*receipt.Receip
 	return rec, nil
 }
 
+func (n *Node) putLogsInTransaction(hash []byte, tx *transaction.ApiTransactionResult, epoch uint32) {
+	txLog, err := n.getTxLogFromStorage(hash, epoch)
+	if err != nil {
+		return
+	}
+
+	tx.Logs = &transaction.ApiLogs{
+		Address: n.addressPubkeyConverter.Encode(txLog.Address),
+		Events:  make([]*transaction.ApiEvent, 0, len(txLog.Events)),
+	}
+	for _, event := range txLog.Events {
+		if event == nil {
+			continue
+		}
+
+		tx.Logs.Events = append(tx.Logs.Events, &transaction.ApiEvent{
+			Address:    n.addressPubkeyConverter.Encode(event.Address),
+			Identifier: string(event.Identifier),
+			Topics:     event.Topics,
+			Data:       event.Data,
+		})
+	}
+}
+
 func (n *Node) adaptReceipt(rcpt *receipt.Receipt) *transaction.ReceiptApi {
 	return &transaction.ReceiptApi{
 		Value:   rcpt.Value,
@@ -64,19 +116,26 @@ func (n *Node) putSmartContractResultsInTransaction(
 	tx *transaction.ApiTransactionResult,
 	scrHashesEpoch []*dblookupext.ScResultsHashesAndEpoch,
 ) {
+	tx.SmartContractResults = append(tx.SmartContractResults, n.getSmartContractResults(scrHashesEpoch)...)
+}
+
+func (n *Node) getSmartContractResults(scrHashesEpoch []*dblookupext.ScResultsHashesAndEpoch) []*transaction.ApiSmartContractResult {
+	results := make([]*transaction.ApiSmartContractResult, 0)
 	for _, scrHashesE := range scrHashesEpoch {
 		for _, scrHash := range scrHashesE.ScResultsHashes {
 			scr, err := n.getScrFromStorage(scrHash, scrHashesE.Epoch)
 			if err != nil {
-				log.Warn("putSmartContractResultsInTransaction cannot get result from storage",
+				log.Warn("getSmartContractResults cannot get result from storage",
 					"hash", hex.EncodeToString(scrHash),
 					"error", err.Error())
 				continue
 			}
 
-			tx.SmartContractResults = append(tx.SmartContractResults, n.adaptSmartContractResult(scrHash, scr))
+			results = append(results, n.adaptSmartContractResult(scrHash, scr))
 		}
 	}
+
+	return results
 }
 
 func (n *Node) getScrFromStorage(hash []byte, epoch uint32) (*smartContractResult.SmartContractResult, error) {
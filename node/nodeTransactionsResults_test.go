@@ -163,3 +163,129 @@ func TestPutEventsInTransactionSmartContractResults(t *testing.T) {
 	n.putResultsInTransaction(txHash, tx, epoch)
 	require.Equal(t, expectedSCRS, tx.SmartContractResults)
 }
+
+func TestPutEventsInTransactionLogs(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(3)
+	txHash := []byte("txHash")
+	txLog := &transaction.Log{
+		Address: []byte("contract"),
+		Events: []*transaction.Event{
+			{
+				Address:    []byte("sender"),
+				Identifier: []byte("ESDTTransfer"),
+				Topics:     [][]byte{[]byte("token"), big.NewInt(10).Bytes()},
+				Data:       []byte("data"),
+			},
+			nil,
+		},
+	}
+
+	marshalizerdMock := &mock.MarshalizerFake{}
+	dataStore := &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &mock.StorerStub{
+				GetFromEpochCalled: func(key []byte, e uint32) ([]byte, error) {
+					if unitType == dataRetriever.TxLogsUnit && bytes.Equal(key, txHash) && e == epoch {
+						return marshalizerdMock.Marshal(txLog)
+					}
+
+					return nil, storage.ErrKeyNotFound
+				},
+			}
+		},
+	}
+	n, _ := NewNode(
+		WithInternalMarshalizer(marshalizerdMock, 0),
+		WithDataStore(dataStore),
+		WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			GetEventsHashesByTxHashCalled: func(_ []byte, _ uint32) (*dblookupext.ResultsHashesByTxHash, error) {
+				return nil, storage.ErrKeyNotFound
+			},
+		}),
+		WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+	)
+
+	expectedLogs := &transaction.ApiLogs{
+		Address: n.addressPubkeyConverter.Encode(txLog.Address),
+		Events: []*transaction.ApiEvent{
+			{
+				Address:    n.addressPubkeyConverter.Encode([]byte("sender")),
+				Identifier: "ESDTTransfer",
+				Topics:     txLog.Events[0].Topics,
+				Data:       []byte("data"),
+			},
+		},
+	}
+
+	tx := &transaction.ApiTransactionResult{}
+	n.putResultsInTransaction(txHash, tx, epoch)
+	require.Equal(t, expectedLogs, tx.Logs)
+	require.Nil(t, tx.Receipt)
+	require.Nil(t, tx.SmartContractResults)
+
+	tx = &transaction.ApiTransactionResult{}
+	n.putResultsInTransaction([]byte("txWithoutLogs"), tx, epoch)
+	require.Nil(t, tx.Logs)
+}
+
+func TestPutExecutionStatusInTransaction(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(0)
+	txHash := []byte("txHash")
+	scrHash := []byte("scrHash")
+	scr := &smartContractResult.SmartContractResult{
+		OriginalTxHash: txHash,
+		PrevTxHash:     txHash,
+		Data:           []byte("@" + hex.EncodeToString([]byte("user error"))),
+		ReturnMessage:  []byte("function not found"),
+	}
+
+	marshalizerdMock := &mock.MarshalizerFake{}
+	dataStore := &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &mock.StorerStub{
+				GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+					return marshalizerdMock.Marshal(scr)
+				},
+			}
+		},
+	}
+	historyRepo := &testscommon.HistoryRepositoryStub{
+		GetEventsHashesByTxHashCalled: func(hash []byte, e uint32) (*dblookupext.ResultsHashesByTxHash, error) {
+			return &dblookupext.ResultsHashesByTxHash{
+				ScResultsHashesAndEpoch: []*dblookupext.ScResultsHashesAndEpoch{
+					{
+						Epoch:           epoch,
+						ScResultsHashes: [][]byte{scrHash},
+					},
+				},
+			}, nil
+		},
+	}
+	n, _ := NewNode(
+		WithInternalMarshalizer(marshalizerdMock, 0),
+		WithDataStore(dataStore),
+		WithHistoryRepository(historyRepo),
+		WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+	)
+
+	tx := &transaction.ApiTransactionResult{Status: transaction.TxStatusPending}
+	n.putExecutionStatusInTransaction(txHash, tx, epoch)
+	require.Equal(t, transaction.TxStatusPending, tx.Status)
+	require.Empty(t, tx.FailReason)
+
+	tx = &transaction.ApiTransactionResult{Status: transaction.TxStatusSuccess}
+	n.putExecutionStatusInTransaction(txHash, tx, epoch)
+	require.Equal(t, transaction.TxStatusFail, tx.Status)
+	require.Equal(t, string(scr.ReturnMessage), tx.FailReason)
+	require.Empty(t, tx.SmartContractResults)
+
+	scr.Data = []byte("@" + hex.EncodeToString([]byte("ok")))
+	tx = &transaction.ApiTransactionResult{Status: transaction.TxStatusSuccess}
+	n.putExecutionStatusInTransaction(txHash, tx, epoch)
+	require.Equal(t, transaction.TxStatusSuccess, tx.Status)
+	require.Empty(t, tx.FailReason)
+}
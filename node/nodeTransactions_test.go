@@ -214,6 +214,12 @@ func TestNode_GetTransactionWithResultsFromStorage(t *testing.T) {
 						return marshalizer.Marshal(scResult)
 					},
 				}
+			case dataRetriever.TxLogsUnit:
+				return &mock.StorerStub{
+					GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+						return nil, storage.ErrKeyNotFound
+					},
+				}
 			default:
 				return nil
 			}
@@ -2142,6 +2142,15 @@ func TestStartConsensus_ShardBootstrapper(t *testing.T) {
 		node.WithHeaderIntegrityVerifier(&mock.HeaderIntegrityVerifierStub{}),
 		node.WithPeerHonestyHandler(&testscommon.PeerHonestyHandlerStub{}),
 		node.WithFallbackHeaderValidator(&testscommon.FallBackHeaderValidatorStub{}),
+		node.WithPartitionStatusHandler(&testscommon.PartitionStatusHandlerStub{}),
+		node.WithEquivocationDetector(&testscommon.EquivocationDetectorStub{}),
+		node.WithSubroundsTimingHandler(&testscommon.SubroundsTimingHandlerStub{}),
+		node.WithProposalPreparer(&testscommon.ProposalPreparerStub{}),
+		node.WithConsensusStateDebugger(&testscommon.StateDebuggerStub{}),
+		node.WithManagedPeersHolder(&testscommon.ManagedPeersHolderStub{}),
+		node.WithNodeRedundancyHandler(&testscommon.NodeRedundancyHandlerStub{}),
+		node.WithParticipationTracker(&testscommon.ParticipationTrackerStub{}),
+		node.WithSigningSlotHandler(&testscommon.SigningSlotHandlerStub{}),
 		node.WithHardforkTrigger(&mock.HardforkTriggerStub{}),
 		node.WithInterceptorsContainer(&mock.InterceptorsContainerStub{}),
 		node.WithWatchdogTimer(&mock.WatchdogMock{}),
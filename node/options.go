@@ -20,6 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -720,6 +721,122 @@ func WithFallbackHeaderValidator(fallbackHeaderValidator consensus.FallbackHeade
 	}
 }
 
+// WithPartitionStatusHandler sets up a partition status handler for the Node
+func WithPartitionStatusHandler(partitionStatusHandler consensus.PartitionStatusHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(partitionStatusHandler) {
+			return ErrNilPartitionStatusHandler
+		}
+		n.partitionStatusHandler = partitionStatusHandler
+		return nil
+	}
+}
+
+// WithEquivocationDetector sets up an equivocation detector for the Node
+func WithEquivocationDetector(equivocationDetector consensus.EquivocationDetector) Option {
+	return func(n *Node) error {
+		if check.IfNil(equivocationDetector) {
+			return ErrNilEquivocationDetector
+		}
+		n.equivocationDetector = equivocationDetector
+		return nil
+	}
+}
+
+// WithSubroundsTimingHandler sets up a subrounds timing handler for the Node
+func WithSubroundsTimingHandler(subroundsTimingHandler consensus.SubroundsTimingHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(subroundsTimingHandler) {
+			return ErrNilSubroundsTimingHandler
+		}
+		n.subroundsTimingHandler = subroundsTimingHandler
+		return nil
+	}
+}
+
+// WithProposalPreparer sets up the component able to prepare ahead of time the next block proposal for the Node
+func WithProposalPreparer(proposalPreparer process.ProposalPreparer) Option {
+	return func(n *Node) error {
+		if check.IfNil(proposalPreparer) {
+			return ErrNilProposalPreparer
+		}
+		n.proposalPreparer = proposalPreparer
+		return nil
+	}
+}
+
+// WithConsensusStateDebugger sets up the component recording the consensus activity of the last rounds for the Node
+func WithConsensusStateDebugger(stateDebugger consensus.StateDebugger) Option {
+	return func(n *Node) error {
+		if check.IfNil(stateDebugger) {
+			return ErrNilConsensusStateDebugger
+		}
+		n.stateDebugger = stateDebugger
+		return nil
+	}
+}
+
+// WithManagedPeersHolder sets up the component holding the validator keys operated by the Node, besides its own key
+func WithManagedPeersHolder(managedPeersHolder consensus.ManagedPeersHolder) Option {
+	return func(n *Node) error {
+		if check.IfNil(managedPeersHolder) {
+			return ErrNilManagedPeersHolder
+		}
+		n.managedPeersHolder = managedPeersHolder
+		return nil
+	}
+}
+
+// WithNodeRedundancyHandler sets up the component deciding if the Node can act in consensus when running with backups
+func WithNodeRedundancyHandler(nodeRedundancyHandler consensus.NodeRedundancyHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(nodeRedundancyHandler) {
+			return ErrNilNodeRedundancyHandler
+		}
+		n.nodeRedundancyHandler = nodeRedundancyHandler
+		return nil
+	}
+}
+
+// WithParticipationTracker sets up the component recording the Node's own participation in consensus
+func WithParticipationTracker(participationTracker consensus.ParticipationTracker) Option {
+	return func(n *Node) error {
+		if check.IfNil(participationTracker) {
+			return ErrNilParticipationTracker
+		}
+		n.participationTracker = participationTracker
+		return nil
+	}
+}
+
+// WithSigningSlotHandler sets up the component holding the consensus slot the Node currently signs for
+func WithSigningSlotHandler(signingSlotHandler crypto.SigningSlotHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(signingSlotHandler) {
+			return ErrNilSigningSlotHandler
+		}
+		n.signingSlotHandler = signingSlotHandler
+		return nil
+	}
+}
+
+// WithFallbackLeaderTimeoutPercent sets up the percent of the round after which, if no proposal was received, the
+// fallback leader may propose. The value 0 disables the fallback leader
+func WithFallbackLeaderTimeoutPercent(percent uint32) Option {
+	return func(n *Node) error {
+		n.fallbackLeaderTimeoutPercent = percent
+		return nil
+	}
+}
+
+// WithFallbackLeaderEnableEpoch sets up the epoch from which the fallback leader may propose
+func WithFallbackLeaderEnableEpoch(fallbackLeaderEnableEpoch uint32) Option {
+	return func(n *Node) error {
+		n.fallbackLeaderEnableEpoch = fallbackLeaderEnableEpoch
+		return nil
+	}
+}
+
 // WithWatchdogTimer sets up a watchdog for the Node
 func WithWatchdogTimer(watchdog core.WatchdogTimer) Option {
 	return func(n *Node) error {
@@ -791,3 +908,22 @@ func WithImportMode(importMode bool) Option {
 		return nil
 	}
 }
+
+// WithWorkingDir sets up the working directory of the node, used for reporting the free disk space
+func WithWorkingDir(workingDir string) Option {
+	return func(n *Node) error {
+		n.workingDir = workingDir
+		return nil
+	}
+}
+
+// WithPushNotifier sets up the push notifier on which the heartbeat alerts are pushed
+func WithPushNotifier(pushNotifier heartbeat.PeerStatusNotifier) Option {
+	return func(n *Node) error {
+		if check.IfNil(pushNotifier) {
+			return ErrNilPushNotifier
+		}
+		n.pushNotifier = pushNotifier
+		return nil
+	}
+}
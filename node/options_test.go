@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core/indexer/push"
 	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
 	"github.com/ElrondNetwork/elrond-go/core/versioning"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
@@ -1401,6 +1402,246 @@ func TestWithFallbackHeaderValidator_OkFallbackHeaderValidatorShouldWork(t *test
 	assert.Nil(t, err)
 }
 
+func TestWithPartitionStatusHandler_NilPartitionStatusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithPartitionStatusHandler(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilPartitionStatusHandler, err)
+}
+
+func TestWithPartitionStatusHandler_OkPartitionStatusHandlerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	partitionStatusHandler := &testscommon.PartitionStatusHandlerStub{}
+	opt := WithPartitionStatusHandler(partitionStatusHandler)
+	err := opt(node)
+
+	assert.Equal(t, partitionStatusHandler, node.partitionStatusHandler)
+	assert.Nil(t, err)
+}
+
+func TestWithEquivocationDetector_NilEquivocationDetectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithEquivocationDetector(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilEquivocationDetector, err)
+}
+
+func TestWithEquivocationDetector_OkEquivocationDetectorShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	equivocationDetector := &testscommon.EquivocationDetectorStub{}
+	opt := WithEquivocationDetector(equivocationDetector)
+	err := opt(node)
+
+	assert.Equal(t, equivocationDetector, node.equivocationDetector)
+	assert.Nil(t, err)
+}
+
+func TestWithSubroundsTimingHandler_NilSubroundsTimingHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithSubroundsTimingHandler(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilSubroundsTimingHandler, err)
+}
+
+func TestWithSubroundsTimingHandler_OkSubroundsTimingHandlerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	subroundsTimingHandler := &testscommon.SubroundsTimingHandlerStub{}
+	opt := WithSubroundsTimingHandler(subroundsTimingHandler)
+	err := opt(node)
+
+	assert.Equal(t, subroundsTimingHandler, node.subroundsTimingHandler)
+	assert.Nil(t, err)
+}
+
+func TestWithProposalPreparer_NilProposalPreparerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithProposalPreparer(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilProposalPreparer, err)
+}
+
+func TestWithProposalPreparer_OkProposalPreparerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	proposalPreparer := &testscommon.ProposalPreparerStub{}
+	opt := WithProposalPreparer(proposalPreparer)
+	err := opt(node)
+
+	assert.Equal(t, proposalPreparer, node.proposalPreparer)
+	assert.Nil(t, err)
+}
+
+func TestWithConsensusStateDebugger_NilStateDebuggerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithConsensusStateDebugger(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilConsensusStateDebugger, err)
+}
+
+func TestWithConsensusStateDebugger_OkStateDebuggerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	stateDebugger := &testscommon.StateDebuggerStub{}
+	opt := WithConsensusStateDebugger(stateDebugger)
+	err := opt(node)
+
+	assert.Equal(t, stateDebugger, node.stateDebugger)
+	assert.Nil(t, err)
+}
+
+func TestWithManagedPeersHolder_NilManagedPeersHolderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithManagedPeersHolder(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilManagedPeersHolder, err)
+}
+
+func TestWithManagedPeersHolder_OkManagedPeersHolderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	managedPeersHolder := &testscommon.ManagedPeersHolderStub{}
+	opt := WithManagedPeersHolder(managedPeersHolder)
+	err := opt(node)
+
+	assert.Equal(t, managedPeersHolder, node.managedPeersHolder)
+	assert.Nil(t, err)
+}
+
+func TestWithNodeRedundancyHandler_NilNodeRedundancyHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithNodeRedundancyHandler(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilNodeRedundancyHandler, err)
+}
+
+func TestWithNodeRedundancyHandler_OkNodeRedundancyHandlerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	nodeRedundancyHandler := &testscommon.NodeRedundancyHandlerStub{}
+	opt := WithNodeRedundancyHandler(nodeRedundancyHandler)
+	err := opt(node)
+
+	assert.Equal(t, nodeRedundancyHandler, node.nodeRedundancyHandler)
+	assert.Nil(t, err)
+}
+
+func TestWithParticipationTracker_NilParticipationTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithParticipationTracker(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilParticipationTracker, err)
+}
+
+func TestWithParticipationTracker_OkParticipationTrackerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	participationTracker := &testscommon.ParticipationTrackerStub{}
+	opt := WithParticipationTracker(participationTracker)
+	err := opt(node)
+
+	assert.Equal(t, participationTracker, node.participationTracker)
+	assert.Nil(t, err)
+}
+
+func TestWithSigningSlotHandler_NilSigningSlotHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithSigningSlotHandler(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilSigningSlotHandler, err)
+}
+
+func TestWithSigningSlotHandler_OkSigningSlotHandlerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	signingSlotHandler := &testscommon.SigningSlotHandlerStub{}
+	opt := WithSigningSlotHandler(signingSlotHandler)
+	err := opt(node)
+
+	assert.Equal(t, signingSlotHandler, node.signingSlotHandler)
+	assert.Nil(t, err)
+}
+
+func TestWithFallbackLeaderTimeoutPercent_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithFallbackLeaderTimeoutPercent(40)
+	err := opt(node)
+
+	assert.Equal(t, uint32(40), node.fallbackLeaderTimeoutPercent)
+	assert.Nil(t, err)
+}
+
+func TestWithFallbackLeaderEnableEpoch_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithFallbackLeaderEnableEpoch(4)
+	err := opt(node)
+
+	assert.Equal(t, uint32(4), node.fallbackLeaderEnableEpoch)
+	assert.Nil(t, err)
+}
+
 func TestWithWatchdogTimer_NilWatchdogShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1509,3 +1750,27 @@ func TestWithTxVersionChecker_OkTxVersionCheckerShoulWork(t *testing.T) {
 	assert.Equal(t, txVersionChecker, node.txVersionChecker)
 	assert.Nil(t, err)
 }
+
+func TestWithPushNotifier_NilPushNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithPushNotifier(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilPushNotifier, err)
+}
+
+func TestWithPushNotifier_OkPushNotifierShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	pushNotifier := push.NewDisabledPushNotifier()
+	opt := WithPushNotifier(pushNotifier)
+	err := opt(node)
+
+	assert.Equal(t, pushNotifier, node.pushNotifier)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,20 @@
+package stakingQueueAPI
+
+import "github.com/ElrondNetwork/elrond-go/node/external"
+
+type disabledStakingQueueProcessor struct{}
+
+// NewDisabledStakingQueueProcessor -
+func NewDisabledStakingQueueProcessor() (*disabledStakingQueueProcessor, error) {
+	return new(disabledStakingQueueProcessor), nil
+}
+
+// GetStakingQueue -
+func (d *disabledStakingQueueProcessor) GetStakingQueue(_ uint32, _ uint32) (*external.StakingQueue, error) {
+	return nil, ErrCannotReturnStakingQueueFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledStakingQueueProcessor) IsInterfaceNil() bool {
+	return d == nil
+}
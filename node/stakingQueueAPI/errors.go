@@ -0,0 +1,21 @@
+package stakingQueueAPI
+
+import "errors"
+
+// ErrInvalidCacheDuration signals that an invalid cache duration has been provided
+var ErrInvalidCacheDuration = errors.New("invalid staking queue cache duration")
+
+// ErrNilSCQueryService signals that a nil SC query service has been provided
+var ErrNilSCQueryService = errors.New("trying to set nil SC query service")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("trying to set nil pubkey converter")
+
+// ErrCannotReturnStakingQueueFromShardNode signals that the staking queue cannot be returned by a shard node
+var ErrCannotReturnStakingQueueFromShardNode = errors.New("staking queue cannot be returned by a shard node")
+
+// ErrInvalidPageSize signals that an invalid page size has been provided
+var ErrInvalidPageSize = errors.New("invalid page size")
+
+// ErrInvalidQueuePage signals that the queue page returned by the staking system smart contract is malformed
+var ErrInvalidQueuePage = errors.New("invalid queue page returned by the staking system smart contract")
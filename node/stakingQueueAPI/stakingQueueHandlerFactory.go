@@ -0,0 +1,33 @@
+package stakingQueueAPI
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+// ArgsStakingQueueHandler is struct that contains components that are needed to create a StakingQueueHandler
+type ArgsStakingQueueHandler struct {
+	ShardID                     uint32
+	RoundDurationInMilliseconds uint64
+	SCQueryService              external.SCQueryService
+	AddressPubkeyConverter      core.PubkeyConverter
+	ValidatorPubkeyConverter    core.PubkeyConverter
+}
+
+const numOfRounds = 10
+
+// CreateStakingQueueHandler will create a new instance of StakingQueueHandler
+func CreateStakingQueueHandler(args *ArgsStakingQueueHandler) (external.StakingQueueHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return NewDisabledStakingQueueProcessor()
+	}
+
+	return NewStakingQueueProcessor(
+		args.SCQueryService,
+		args.AddressPubkeyConverter,
+		args.ValidatorPubkeyConverter,
+		time.Duration(args.RoundDurationInMilliseconds)*time.Millisecond*numOfRounds,
+	)
+}
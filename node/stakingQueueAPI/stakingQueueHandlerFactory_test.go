@@ -0,0 +1,43 @@
+package stakingQueueAPI
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStakingQueueHandler_DisabledStakingQueueProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := &ArgsStakingQueueHandler{
+		ShardID: 0,
+	}
+
+	stakingQueueHandler, err := CreateStakingQueueHandler(args)
+	require.Nil(t, err)
+
+	stakingQueueProc, ok := stakingQueueHandler.(*disabledStakingQueueProcessor)
+	require.True(t, ok)
+	require.NotNil(t, stakingQueueProc)
+}
+
+func TestCreateStakingQueueHandler_StakingQueueProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := &ArgsStakingQueueHandler{
+		ShardID:                     core.MetachainShardId,
+		RoundDurationInMilliseconds: 5000,
+		SCQueryService:              &mock.SCQueryServiceStub{},
+		AddressPubkeyConverter:      mock.NewPubkeyConverterMock(32),
+		ValidatorPubkeyConverter:    mock.NewPubkeyConverterMock(96),
+	}
+
+	stakingQueueHandler, err := CreateStakingQueueHandler(args)
+	require.Nil(t, err)
+
+	stakingQueueProc, ok := stakingQueueHandler.(*stakingQueueProcessor)
+	require.True(t, ok)
+	require.NotNil(t, stakingQueueProc)
+}
@@ -0,0 +1,157 @@
+package stakingQueueAPI
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+const getQueueViewFunction = "getQueueView"
+
+// stakingQueueProcessor reads the nodes waiting in the staking queue from the staking system smart contract, page by
+// page, through the getQueueView view and keeps the whole queue cached for the configured duration
+type stakingQueueProcessor struct {
+	scQueryService           external.SCQueryService
+	addressPubkeyConverter   core.PubkeyConverter
+	validatorPubkeyConverter core.PubkeyConverter
+	cacheDuration            time.Duration
+
+	mutQueue        sync.Mutex
+	lastComputeTime time.Time
+	nodes           []*external.StakingQueueNode
+}
+
+// NewStakingQueueProcessor will create a new instance of stakingQueueProcessor
+func NewStakingQueueProcessor(
+	scQueryService external.SCQueryService,
+	addressPubkeyConverter core.PubkeyConverter,
+	validatorPubkeyConverter core.PubkeyConverter,
+	cacheDuration time.Duration,
+) (*stakingQueueProcessor, error) {
+	if cacheDuration <= 0 {
+		return nil, ErrInvalidCacheDuration
+	}
+	if check.IfNil(scQueryService) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(addressPubkeyConverter) || check.IfNil(validatorPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	return &stakingQueueProcessor{
+		scQueryService:           scQueryService,
+		addressPubkeyConverter:   addressPubkeyConverter,
+		validatorPubkeyConverter: validatorPubkeyConverter,
+		cacheDuration:            cacheDuration,
+		nodes:                    make([]*external.StakingQueueNode, 0),
+	}, nil
+}
+
+// GetStakingQueue returns at most size queued nodes, starting with the node at the from index, in their queue order
+func (sqp *stakingQueueProcessor) GetStakingQueue(from uint32, size uint32) (*external.StakingQueue, error) {
+	if size == 0 {
+		return nil, ErrInvalidPageSize
+	}
+
+	sqp.mutQueue.Lock()
+	defer sqp.mutQueue.Unlock()
+
+	if time.Since(sqp.lastComputeTime) >= sqp.cacheDuration {
+		err := sqp.updateQueue()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	numNodes := uint32(len(sqp.nodes))
+	result := &external.StakingQueue{
+		Nodes:    make([]*external.StakingQueueNode, 0),
+		NumNodes: numNodes,
+	}
+	if from >= numNodes {
+		return result, nil
+	}
+
+	end := numNodes
+	if size < numNodes-from {
+		end = from + size
+		result.NextFrom = end
+	}
+	result.Nodes = append(result.Nodes, sqp.nodes[from:end]...)
+
+	return result, nil
+}
+
+func (sqp *stakingQueueProcessor) updateQueue() error {
+	nodes := make([]*external.StakingQueueNode, 0)
+	for {
+		queueLength, page, err := sqp.getQueuePage(uint32(len(nodes)))
+		if err != nil {
+			return err
+		}
+
+		nodes = append(nodes, page...)
+		if len(page) == 0 || uint64(len(nodes)) >= queueLength {
+			break
+		}
+	}
+
+	sqp.nodes = nodes
+	sqp.lastComputeTime = time.Now()
+
+	return nil
+}
+
+func (sqp *stakingQueueProcessor) getQueuePage(startIndex uint32) (uint64, []*external.StakingQueueNode, error) {
+	vmOutput, err := sqp.scQueryService.ExecuteQuery(&process.SCQuery{
+		ScAddress: vm.StakingSCAddress,
+		FuncName:  getQueueViewFunction,
+		Arguments: [][]byte{
+			big.NewInt(int64(startIndex)).Bytes(),
+			big.NewInt(systemSmartContracts.MaxQueueViewPageSize).Bytes(),
+		},
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	returnData := vmOutput.ReturnData
+	if len(returnData) == 0 || (len(returnData)-1)%systemSmartContracts.QueueViewNumFieldsPerNode != 0 {
+		return 0, nil, ErrInvalidQueuePage
+	}
+
+	queueLength := big.NewInt(0).SetBytes(returnData[0]).Uint64()
+	nodes := make([]*external.StakingQueueNode, 0, (len(returnData)-1)/systemSmartContracts.QueueViewNumFieldsPerNode)
+	for i := 1; i < len(returnData); i += systemSmartContracts.QueueViewNumFieldsPerNode {
+		position := startIndex + uint32(len(nodes)) + 1
+		nodes = append(nodes, sqp.createQueueNode(position, returnData[i:i+systemSmartContracts.QueueViewNumFieldsPerNode]))
+	}
+
+	return queueLength, nodes, nil
+}
+
+func (sqp *stakingQueueProcessor) createQueueNode(position uint32, fields [][]byte) *external.StakingQueueNode {
+	owner := ""
+	if len(fields[1]) > 0 {
+		owner = sqp.addressPubkeyConverter.Encode(fields[1])
+	}
+
+	return &external.StakingQueueNode{
+		Position:      position,
+		BLSKey:        sqp.validatorPubkeyConverter.Encode(fields[0]),
+		Owner:         owner,
+		RegisterNonce: big.NewInt(0).SetBytes(fields[2]).Uint64(),
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sqp *stakingQueueProcessor) IsInterfaceNil() bool {
+	return sqp == nil
+}
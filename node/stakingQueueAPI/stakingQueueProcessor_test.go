@@ -0,0 +1,149 @@
+package stakingQueueAPI
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	"github.com/stretchr/testify/require"
+)
+
+func createQueueViewStub(numNodes int, numCalls *int) *mock.SCQueryServiceStub {
+	return &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			*numCalls++
+			if query.FuncName != getQueueViewFunction || string(query.ScAddress) != string(vm.StakingSCAddress) {
+				return nil, errors.New("unexpected query")
+			}
+
+			startIndex := int(big.NewInt(0).SetBytes(query.Arguments[0]).Int64())
+			pageSize := int(big.NewInt(0).SetBytes(query.Arguments[1]).Int64())
+			returnData := [][]byte{big.NewInt(int64(numNodes)).Bytes()}
+			for i := startIndex; i < numNodes && i < startIndex+pageSize; i++ {
+				owner := []byte{byte(i)}
+				if i%2 == 1 {
+					owner = make([]byte, 0)
+				}
+				returnData = append(returnData,
+					[]byte{byte(i), byte(i)},
+					owner,
+					big.NewInt(int64(100+i)).Bytes(),
+				)
+			}
+
+			return &vmcommon.VMOutput{ReturnData: returnData}, nil
+		},
+	}
+}
+
+func TestNewStakingQueueProcessor(t *testing.T) {
+	t.Parallel()
+
+	proc, err := NewStakingQueueProcessor(&mock.SCQueryServiceStub{}, mock.NewPubkeyConverterMock(32), mock.NewPubkeyConverterMock(96), 0)
+	require.Nil(t, proc)
+	require.Equal(t, ErrInvalidCacheDuration, err)
+
+	proc, err = NewStakingQueueProcessor(nil, mock.NewPubkeyConverterMock(32), mock.NewPubkeyConverterMock(96), time.Second)
+	require.Nil(t, proc)
+	require.Equal(t, ErrNilSCQueryService, err)
+
+	proc, err = NewStakingQueueProcessor(&mock.SCQueryServiceStub{}, nil, mock.NewPubkeyConverterMock(96), time.Second)
+	require.Nil(t, proc)
+	require.Equal(t, ErrNilPubkeyConverter, err)
+
+	proc, err = NewStakingQueueProcessor(&mock.SCQueryServiceStub{}, mock.NewPubkeyConverterMock(32), nil, time.Second)
+	require.Nil(t, proc)
+	require.Equal(t, ErrNilPubkeyConverter, err)
+
+	proc, err = NewStakingQueueProcessor(&mock.SCQueryServiceStub{}, mock.NewPubkeyConverterMock(32), mock.NewPubkeyConverterMock(96), time.Second)
+	require.Nil(t, err)
+	require.False(t, proc.IsInterfaceNil())
+}
+
+func TestStakingQueueProcessor_GetStakingQueueShouldReadAllPagesAndCache(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	numNodes := systemSmartContracts.MaxQueueViewPageSize + 20
+	proc, _ := NewStakingQueueProcessor(createQueueViewStub(numNodes, &numCalls), mock.NewPubkeyConverterMock(1), mock.NewPubkeyConverterMock(2), time.Hour)
+
+	queue, err := proc.GetStakingQueue(0, 2)
+	require.Nil(t, err)
+	require.Equal(t, 2, numCalls)
+	require.Equal(t, uint32(numNodes), queue.NumNodes)
+	require.Equal(t, uint32(2), queue.NextFrom)
+	require.Equal(t, []*external.StakingQueueNode{
+		{
+			Position:      1,
+			BLSKey:        "0000",
+			Owner:         "00",
+			RegisterNonce: 100,
+		},
+		{
+			Position:      2,
+			BLSKey:        "0101",
+			Owner:         "",
+			RegisterNonce: 101,
+		},
+	}, queue.Nodes)
+
+	queue, err = proc.GetStakingQueue(uint32(numNodes-5), 10)
+	require.Nil(t, err)
+	require.Equal(t, 2, numCalls)
+	require.Equal(t, 5, len(queue.Nodes))
+	require.Equal(t, uint32(0), queue.NextFrom)
+	require.Equal(t, uint32(numNodes), queue.Nodes[4].Position)
+
+	queue, err = proc.GetStakingQueue(uint32(numNodes), 10)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(queue.Nodes))
+	require.Equal(t, uint32(numNodes), queue.NumNodes)
+}
+
+func TestStakingQueueProcessor_GetStakingQueueInvalidPageSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	proc, _ := NewStakingQueueProcessor(createQueueViewStub(1, &numCalls), mock.NewPubkeyConverterMock(1), mock.NewPubkeyConverterMock(2), time.Hour)
+
+	queue, err := proc.GetStakingQueue(0, 0)
+	require.Nil(t, queue)
+	require.Equal(t, ErrInvalidPageSize, err)
+	require.Equal(t, 0, numCalls)
+}
+
+func TestStakingQueueProcessor_GetStakingQueueQueryErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	proc, _ := NewStakingQueueProcessor(&mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(_ *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return nil, expectedErr
+		},
+	}, mock.NewPubkeyConverterMock(1), mock.NewPubkeyConverterMock(2), time.Hour)
+
+	queue, err := proc.GetStakingQueue(0, 10)
+	require.Nil(t, queue)
+	require.Equal(t, expectedErr, err)
+}
+
+func TestStakingQueueProcessor_GetStakingQueueMalformedPageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proc, _ := NewStakingQueueProcessor(&mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(_ *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnData: [][]byte{{1}, []byte("bls")}}, nil
+		},
+	}, mock.NewPubkeyConverterMock(1), mock.NewPubkeyConverterMock(2), time.Hour)
+
+	queue, err := proc.GetStakingQueue(0, 10)
+	require.Nil(t, queue)
+	require.Equal(t, ErrInvalidQueuePage, err)
+}
@@ -0,0 +1,25 @@
+package stakingRewardsAPI
+
+import "github.com/ElrondNetwork/elrond-go/node/external"
+
+type disabledStakingRewardsProcessor struct{}
+
+// NewDisabledStakingRewardsProcessor -
+func NewDisabledStakingRewardsProcessor() (*disabledStakingRewardsProcessor, error) {
+	return new(disabledStakingRewardsProcessor), nil
+}
+
+// GetNetworkAPR -
+func (d *disabledStakingRewardsProcessor) GetNetworkAPR() (*external.NetworkAPR, error) {
+	return nil, ErrCannotComputeFromShardNode
+}
+
+// GetOwnerRewardsProjection -
+func (d *disabledStakingRewardsProcessor) GetOwnerRewardsProjection(_ []byte) (*external.OwnerRewardsProjection, error) {
+	return nil, ErrCannotComputeFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledStakingRewardsProcessor) IsInterfaceNil() bool {
+	return d == nil
+}
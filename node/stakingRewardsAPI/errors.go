@@ -0,0 +1,39 @@
+package stakingRewardsAPI
+
+import "errors"
+
+// ErrInvalidCacheDuration signals that an invalid cache duration has been provided
+var ErrInvalidCacheDuration = errors.New("invalid staking rewards cache duration")
+
+// ErrInvalidRoundDuration signals that an invalid round duration has been provided
+var ErrInvalidRoundDuration = errors.New("invalid round duration")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("trying to set nil marshalizer")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("trying to set nil accounts adapter")
+
+// ErrNilPeerAccountsAdapter signals that a nil peer accounts adapter has been provided
+var ErrNilPeerAccountsAdapter = errors.New("trying to set nil peer accounts adapter")
+
+// ErrNilBlockChain signals that a nil block chain has been provided
+var ErrNilBlockChain = errors.New("trying to set nil block chain")
+
+// ErrNilMetaBlockStorer signals that a nil meta block storer has been provided
+var ErrNilMetaBlockStorer = errors.New("trying to set nil meta block storer")
+
+// ErrNilTopUpRewardsSettingsHandler signals that a nil top-up rewards settings handler has been provided
+var ErrNilTopUpRewardsSettingsHandler = errors.New("trying to set nil top-up rewards settings handler")
+
+// ErrCannotCastAccountHandlerToUserAccount signals that the returned account is not a user account
+var ErrCannotCastAccountHandlerToUserAccount = errors.New("cannot cast AccountHandler to UserAccount")
+
+// ErrCannotComputeFromShardNode signals that the staking rewards can not be computed by a shard node
+var ErrCannotComputeFromShardNode = errors.New("staking rewards can not be computed by a shard node")
+
+// ErrEpochEconomicsNotAvailable signals that the economics of the current epoch are not available
+var ErrEpochEconomicsNotAvailable = errors.New("epoch economics not available")
+
+// ErrOwnerNotFound signals that the provided owner has no registration data in the validator system smart contract
+var ErrOwnerNotFound = errors.New("owner not found in the validator system smart contract")
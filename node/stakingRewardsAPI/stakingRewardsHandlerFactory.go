@@ -0,0 +1,47 @@
+package stakingRewardsAPI
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgsStakingRewardsHandler is struct that contains components that are needed to create a StakingRewardsHandler
+type ArgsStakingRewardsHandler struct {
+	ShardID                     uint32
+	RoundDurationInMilliseconds uint64
+	InternalMarshalizer         marshal.Marshalizer
+	Accounts                    state.AccountsAdapter
+	PeerAccounts                state.AccountsAdapter
+	BlockChain                  data.ChainHandler
+	MetaBlockStorer             storage.Storer
+	TopUpRewardsSettings        epochStart.TopUpRewardsSettingsHandler
+}
+
+const numOfRounds = 10
+
+// CreateStakingRewardsHandler will create a new instance of StakingRewardsHandler
+func CreateStakingRewardsHandler(args *ArgsStakingRewardsHandler) (external.StakingRewardsHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return NewDisabledStakingRewardsProcessor()
+	}
+
+	roundDuration := time.Duration(args.RoundDurationInMilliseconds) * time.Millisecond
+
+	return NewStakingRewardsProcessor(ArgsStakingRewardsProcessor{
+		Marshalizer:          args.InternalMarshalizer,
+		Accounts:             args.Accounts,
+		PeerAccounts:         args.PeerAccounts,
+		BlockChain:           args.BlockChain,
+		MetaBlockStorer:      args.MetaBlockStorer,
+		TopUpRewardsSettings: args.TopUpRewardsSettings,
+		RoundDuration:        roundDuration,
+		CacheDuration:        roundDuration * numOfRounds,
+	})
+}
@@ -0,0 +1,52 @@
+package stakingRewardsAPI
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStakingRewardsHandler_DisabledStakingRewardsProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := &ArgsStakingRewardsHandler{
+		ShardID: 0,
+	}
+
+	stakingRewardsHandler, err := CreateStakingRewardsHandler(args)
+	require.Nil(t, err)
+
+	disabledProc, ok := stakingRewardsHandler.(*disabledStakingRewardsProcessor)
+	require.True(t, ok)
+	require.NotNil(t, disabledProc)
+
+	networkAPR, err := stakingRewardsHandler.GetNetworkAPR()
+	require.Nil(t, networkAPR)
+	require.Equal(t, ErrCannotComputeFromShardNode, err)
+}
+
+func TestCreateStakingRewardsHandler_StakingRewardsProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := &ArgsStakingRewardsHandler{
+		ShardID:                     core.MetachainShardId,
+		RoundDurationInMilliseconds: 5000,
+		InternalMarshalizer:         &mock.MarshalizerMock{},
+		Accounts:                    &mock.AccountsStub{},
+		PeerAccounts:                &mock.AccountsStub{},
+		BlockChain:                  &mock.BlockChainMock{},
+		MetaBlockStorer:             genericmocks.NewStorerMock("meta", 0),
+		TopUpRewardsSettings:        &economicsmocks.EconomicsHandlerStub{},
+	}
+
+	stakingRewardsHandler, err := CreateStakingRewardsHandler(args)
+	require.Nil(t, err)
+
+	stakingRewardsProc, ok := stakingRewardsHandler.(*stakingRewardsProcessor)
+	require.True(t, ok)
+	require.NotNil(t, stakingRewardsProc)
+}
@@ -0,0 +1,400 @@
+package stakingRewardsAPI
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/metachain"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+const durationOfYear = 365 * 24 * time.Hour
+
+// ArgsStakingRewardsProcessor is the DTO used to create a new instance of stakingRewardsProcessor
+type ArgsStakingRewardsProcessor struct {
+	Marshalizer          marshal.Marshalizer
+	Accounts             state.AccountsAdapter
+	PeerAccounts         state.AccountsAdapter
+	BlockChain           data.ChainHandler
+	MetaBlockStorer      storage.Storer
+	TopUpRewardsSettings epochStart.TopUpRewardsSettingsHandler
+	RoundDuration        time.Duration
+	CacheDuration        time.Duration
+}
+
+type ownerStakingData struct {
+	totalStake         *big.Int
+	numStakedNodes     uint32
+	numEligibleNodes   uint32
+	eligibleBaseStake  *big.Int
+	eligibleTopUpStake *big.Int
+}
+
+type stakingRewardsData struct {
+	epoch                   uint32
+	epochsPerYear           float64
+	nodePrice               *big.Int
+	rewardsPerEpoch         *big.Int
+	baseRewardsPerEpoch     *big.Int
+	topUpRewardsPerEpoch    *big.Int
+	totalEligibleBaseStake  *big.Int
+	totalEligibleTopUpStake *big.Int
+	owners                  map[string]*ownerStakingData
+}
+
+// stakingRewardsProcessor computes the annual percentage rates of the base stake and of the top-up stake the same way
+// the rewards are computed at the end of the epoch: the rewards for blocks of the last epoch are split between the base
+// stake and the top-up stake of the eligible nodes using the top-up rewards curve, and are then extrapolated for a
+// year. The stake of each owner is read from the validator system smart contract and the list of each node from the
+// peer accounts. The computed data is cached for the configured duration
+type stakingRewardsProcessor struct {
+	marshalizer          marshal.Marshalizer
+	accounts             state.AccountsAdapter
+	peerAccounts         state.AccountsAdapter
+	blockChain           data.ChainHandler
+	metaBlockStorer      storage.Storer
+	topUpRewardsSettings epochStart.TopUpRewardsSettingsHandler
+	roundDuration        time.Duration
+	cacheDuration        time.Duration
+
+	mutData         sync.Mutex
+	lastComputeTime time.Time
+	rewardsData     *stakingRewardsData
+}
+
+// NewStakingRewardsProcessor will create a new instance of stakingRewardsProcessor
+func NewStakingRewardsProcessor(args ArgsStakingRewardsProcessor) (*stakingRewardsProcessor, error) {
+	if args.CacheDuration <= 0 {
+		return nil, ErrInvalidCacheDuration
+	}
+	if args.RoundDuration <= 0 {
+		return nil, ErrInvalidRoundDuration
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.PeerAccounts) {
+		return nil, ErrNilPeerAccountsAdapter
+	}
+	if check.IfNil(args.BlockChain) {
+		return nil, ErrNilBlockChain
+	}
+	if check.IfNil(args.MetaBlockStorer) {
+		return nil, ErrNilMetaBlockStorer
+	}
+	if check.IfNil(args.TopUpRewardsSettings) {
+		return nil, ErrNilTopUpRewardsSettingsHandler
+	}
+
+	return &stakingRewardsProcessor{
+		marshalizer:          args.Marshalizer,
+		accounts:             args.Accounts,
+		peerAccounts:         args.PeerAccounts,
+		blockChain:           args.BlockChain,
+		metaBlockStorer:      args.MetaBlockStorer,
+		topUpRewardsSettings: args.TopUpRewardsSettings,
+		roundDuration:        args.RoundDuration,
+		cacheDuration:        args.CacheDuration,
+	}, nil
+}
+
+// GetNetworkAPR returns the annual percentage rates of the base stake and of the top-up stake of the eligible nodes
+func (srp *stakingRewardsProcessor) GetNetworkAPR() (*external.NetworkAPR, error) {
+	rewardsData, err := srp.getStakingRewardsData()
+	if err != nil {
+		return nil, err
+	}
+
+	return &external.NetworkAPR{
+		Epoch:                   rewardsData.epoch,
+		BaseAPR:                 computeAPR(rewardsData.baseRewardsPerEpoch, rewardsData.totalEligibleBaseStake, rewardsData.epochsPerYear),
+		TopUpAPR:                computeAPR(rewardsData.topUpRewardsPerEpoch, rewardsData.totalEligibleTopUpStake, rewardsData.epochsPerYear),
+		EpochsPerYear:           rewardsData.epochsPerYear,
+		NodePrice:               rewardsData.nodePrice.String(),
+		RewardsPerEpoch:         rewardsData.rewardsPerEpoch.String(),
+		BaseRewardsPerEpoch:     rewardsData.baseRewardsPerEpoch.String(),
+		TopUpRewardsPerEpoch:    rewardsData.topUpRewardsPerEpoch.String(),
+		TotalEligibleBaseStake:  rewardsData.totalEligibleBaseStake.String(),
+		TotalEligibleTopUpStake: rewardsData.totalEligibleTopUpStake.String(),
+	}, nil
+}
+
+// GetOwnerRewardsProjection returns the rewards projected for the eligible nodes of the provided owner. The base
+// rewards and the top-up rewards are split proportionally to the eligible base stake and to the eligible top-up stake
+func (srp *stakingRewardsProcessor) GetOwnerRewardsProjection(owner []byte) (*external.OwnerRewardsProjection, error) {
+	rewardsData, err := srp.getStakingRewardsData()
+	if err != nil {
+		return nil, err
+	}
+
+	ownerData, ok := rewardsData.owners[string(owner)]
+	if !ok {
+		return nil, ErrOwnerNotFound
+	}
+
+	baseRewards := computeProportionalValue(rewardsData.baseRewardsPerEpoch, ownerData.eligibleBaseStake, rewardsData.totalEligibleBaseStake)
+	topUpRewards := computeProportionalValue(rewardsData.topUpRewardsPerEpoch, ownerData.eligibleTopUpStake, rewardsData.totalEligibleTopUpStake)
+	rewardsPerEpoch := big.NewInt(0).Add(baseRewards, topUpRewards)
+	rewardsPerYear, _ := big.NewFloat(0).Mul(
+		big.NewFloat(0).SetInt(rewardsPerEpoch),
+		big.NewFloat(rewardsData.epochsPerYear),
+	).Int(nil)
+
+	return &external.OwnerRewardsProjection{
+		Epoch:              rewardsData.epoch,
+		NumStakedNodes:     ownerData.numStakedNodes,
+		NumEligibleNodes:   ownerData.numEligibleNodes,
+		TotalStake:         ownerData.totalStake.String(),
+		EligibleBaseStake:  ownerData.eligibleBaseStake.String(),
+		EligibleTopUpStake: ownerData.eligibleTopUpStake.String(),
+		RewardsPerEpoch:    rewardsPerEpoch.String(),
+		RewardsPerYear:     rewardsPerYear.String(),
+		APR:                computeAPR(rewardsPerEpoch, ownerData.totalStake, rewardsData.epochsPerYear),
+	}, nil
+}
+
+func (srp *stakingRewardsProcessor) getStakingRewardsData() (*stakingRewardsData, error) {
+	srp.mutData.Lock()
+	defer srp.mutData.Unlock()
+
+	if srp.rewardsData != nil && time.Since(srp.lastComputeTime) < srp.cacheDuration {
+		return srp.rewardsData, nil
+	}
+
+	rewardsData, err := srp.computeStakingRewardsData()
+	if err != nil {
+		return nil, err
+	}
+
+	srp.rewardsData = rewardsData
+	srp.lastComputeTime = time.Now()
+
+	return rewardsData, nil
+}
+
+func (srp *stakingRewardsProcessor) computeStakingRewardsData() (*stakingRewardsData, error) {
+	metaBlock, err := srp.getCurrentEpochStartMetaBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	economics := metaBlock.EpochStart.Economics
+	if economics.TotalToDistribute == nil || economics.NodePrice == nil || economics.NodePrice.Sign() <= 0 {
+		return nil, fmt.Errorf("%w for epoch %d", ErrEpochEconomicsNotAvailable, metaBlock.Epoch)
+	}
+	if metaBlock.Round <= economics.PrevEpochStartRound {
+		return nil, fmt.Errorf("%w for epoch %d, the epoch has no rounds", ErrEpochEconomicsNotAvailable, metaBlock.Epoch)
+	}
+
+	epochDuration := time.Duration(metaBlock.Round-economics.PrevEpochStartRound) * srp.roundDuration
+	rewardsPerEpoch := computeRewardsForBlocks(metaBlock)
+
+	owners, err := srp.computeOwnersStakingData(economics.NodePrice)
+	if err != nil {
+		return nil, err
+	}
+
+	totalEligibleBaseStake := big.NewInt(0)
+	totalEligibleTopUpStake := big.NewInt(0)
+	for _, ownerData := range owners {
+		totalEligibleBaseStake.Add(totalEligibleBaseStake, ownerData.eligibleBaseStake)
+		totalEligibleTopUpStake.Add(totalEligibleTopUpStake, ownerData.eligibleTopUpStake)
+	}
+
+	topUpRewardsPerEpoch := metachain.ComputeTopUpRewards(
+		rewardsPerEpoch,
+		totalEligibleTopUpStake,
+		srp.topUpRewardsSettings.RewardsTopUpGradientPointInEpoch(metaBlock.Epoch),
+		srp.topUpRewardsSettings.RewardsTopUpFactorInEpoch(metaBlock.Epoch),
+		srp.topUpRewardsSettings.RewardsTopUpCurveSteepnessInEpoch(metaBlock.Epoch),
+	)
+
+	return &stakingRewardsData{
+		epoch:                   metaBlock.Epoch,
+		epochsPerYear:           float64(durationOfYear) / float64(epochDuration),
+		nodePrice:               big.NewInt(0).Set(economics.NodePrice),
+		rewardsPerEpoch:         rewardsPerEpoch,
+		baseRewardsPerEpoch:     big.NewInt(0).Sub(rewardsPerEpoch, topUpRewardsPerEpoch),
+		topUpRewardsPerEpoch:    topUpRewardsPerEpoch,
+		totalEligibleBaseStake:  totalEligibleBaseStake,
+		totalEligibleTopUpStake: totalEligibleTopUpStake,
+		owners:                  owners,
+	}, nil
+}
+
+func (srp *stakingRewardsProcessor) getCurrentEpochStartMetaBlock() (*block.MetaBlock, error) {
+	currentHeader := srp.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		return nil, fmt.Errorf("%w, no block was committed", ErrEpochEconomicsNotAvailable)
+	}
+
+	epoch := currentHeader.GetEpoch()
+	if epoch == 0 {
+		return nil, fmt.Errorf("%w, no rewards were distributed in the first epoch", ErrEpochEconomicsNotAvailable)
+	}
+
+	buff, err := srp.metaBlockStorer.SearchFirst([]byte(core.EpochStartIdentifier(epoch)))
+	if err != nil {
+		return nil, fmt.Errorf("%w for epoch %d: %s", ErrEpochEconomicsNotAvailable, epoch, err.Error())
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = srp.marshalizer.Unmarshal(metaBlock, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return metaBlock, nil
+}
+
+// computeRewardsForBlocks returns the rewards that were split between the base stake and the top-up stake of the
+// eligible nodes: the total rewards without the developer fees and without the protocol sustainability rewards
+func computeRewardsForBlocks(metaBlock *block.MetaBlock) *big.Int {
+	economics := metaBlock.EpochStart.Economics
+	rewardsForBlocks := big.NewInt(0).Set(economics.TotalToDistribute)
+	if metaBlock.DevFeesInEpoch != nil {
+		rewardsForBlocks.Sub(rewardsForBlocks, metaBlock.DevFeesInEpoch)
+	}
+	if economics.RewardsForProtocolSustainability != nil {
+		rewardsForBlocks.Sub(rewardsForBlocks, economics.RewardsForProtocolSustainability)
+	}
+	if rewardsForBlocks.Sign() < 0 {
+		return big.NewInt(0)
+	}
+
+	return rewardsForBlocks
+}
+
+func (srp *stakingRewardsProcessor) computeOwnersStakingData(nodePrice *big.Int) (map[string]*ownerStakingData, error) {
+	accountHandler, err := srp.accounts.GetExistingAccount(vm.ValidatorSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	account, ok := accountHandler.(state.UserAccountHandler)
+	if !ok {
+		return nil, ErrCannotCastAccountHandlerToUserAccount
+	}
+
+	rootHash, err := account.DataTrie().Root()
+	if err != nil {
+		return nil, err
+	}
+
+	chLeaves, err := account.DataTrie().GetAllLeavesOnChannel(rootHash, context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make(map[string]*ownerStakingData)
+	for leaf := range chLeaves {
+		value, errTrim := leaf.ValueWithoutSuffix(append(leaf.Key(), vm.ValidatorSCAddress...))
+		if errTrim != nil {
+			return nil, fmt.Errorf("%w for validator key %s", errTrim, hex.EncodeToString(leaf.Key()))
+		}
+
+		validatorData := &systemSmartContracts.ValidatorDataV2{}
+		err = srp.marshalizer.Unmarshal(validatorData, value)
+		if err != nil || validatorData.TotalStakeValue == nil {
+			continue
+		}
+
+		owners[string(leaf.Key())] = srp.computeOwnerStakingData(validatorData, nodePrice)
+	}
+
+	return owners, nil
+}
+
+// computeOwnerStakingData splits the stake of the owner equally between its staked nodes. The eligible top-up stake is
+// the stake of the eligible nodes in excess of the node price
+func (srp *stakingRewardsProcessor) computeOwnerStakingData(
+	validatorData *systemSmartContracts.ValidatorDataV2,
+	nodePrice *big.Int,
+) *ownerStakingData {
+	ownerData := &ownerStakingData{
+		totalStake:         big.NewInt(0).Set(validatorData.TotalStakeValue),
+		eligibleBaseStake:  big.NewInt(0),
+		eligibleTopUpStake: big.NewInt(0),
+	}
+
+	for _, blsKey := range validatorData.BlsPubKeys {
+		list := srp.getNodeList(blsKey)
+		switch list {
+		case string(core.EligibleList):
+			ownerData.numEligibleNodes++
+			ownerData.numStakedNodes++
+		case string(core.WaitingList), string(core.NewList):
+			ownerData.numStakedNodes++
+		}
+	}
+
+	if ownerData.numEligibleNodes == 0 {
+		return ownerData
+	}
+
+	stakePerNode := big.NewInt(0).Div(ownerData.totalStake, big.NewInt(int64(ownerData.numStakedNodes)))
+	eligibleStake := big.NewInt(0).Mul(stakePerNode, big.NewInt(int64(ownerData.numEligibleNodes)))
+	ownerData.eligibleBaseStake.Mul(nodePrice, big.NewInt(int64(ownerData.numEligibleNodes)))
+	ownerData.eligibleTopUpStake.Sub(eligibleStake, ownerData.eligibleBaseStake)
+	if ownerData.eligibleTopUpStake.Sign() < 0 {
+		ownerData.eligibleTopUpStake.SetUint64(0)
+	}
+
+	return ownerData
+}
+
+func (srp *stakingRewardsProcessor) getNodeList(blsKey []byte) string {
+	accountHandler, err := srp.peerAccounts.GetExistingAccount(blsKey)
+	if err != nil {
+		return ""
+	}
+
+	peerAccount, ok := accountHandler.(state.PeerAccountHandler)
+	if !ok {
+		return ""
+	}
+
+	return peerAccount.GetList()
+}
+
+func computeProportionalValue(value *big.Int, part *big.Int, total *big.Int) *big.Int {
+	if total.Sign() <= 0 {
+		return big.NewInt(0)
+	}
+
+	result := big.NewInt(0).Mul(value, part)
+
+	return result.Div(result, total)
+}
+
+func computeAPR(rewardsPerEpoch *big.Int, stake *big.Int, epochsPerYear float64) float64 {
+	if stake.Sign() <= 0 {
+		return 0
+	}
+
+	ratio, _ := big.NewFloat(0).Quo(big.NewFloat(0).SetInt(rewardsPerEpoch), big.NewFloat(0).SetInt(stake)).Float64()
+
+	return ratio * epochsPerYear
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (srp *stakingRewardsProcessor) IsInterfaceNil() bool {
+	return srp == nil
+}
@@ -0,0 +1,384 @@
+package stakingRewardsAPI
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/epochStart/metachain"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testEpoch = uint32(5)
+
+// 900 rounds of 96 seconds make an epoch of one day
+const testRoundDuration = 96 * time.Second
+
+var nodePrice = big.NewInt(2500)
+
+func createMockArgsStakingRewardsProcessor() ArgsStakingRewardsProcessor {
+	return ArgsStakingRewardsProcessor{
+		Marshalizer:  &mock.MarshalizerFake{},
+		Accounts:     &mock.AccountsStub{},
+		PeerAccounts: &mock.AccountsStub{},
+		BlockChain: &mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.MetaBlock{Epoch: testEpoch}
+			},
+		},
+		MetaBlockStorer: genericmocks.NewStorerMock("meta", 0),
+		TopUpRewardsSettings: &economicsmocks.EconomicsHandlerStub{
+			RewardsTopUpGradientPointInEpochCalled: func(_ uint32) *big.Int {
+				return big.NewInt(1000)
+			},
+			RewardsTopUpFactorInEpochCalled: func(_ uint32) float64 {
+				return 0.5
+			},
+			RewardsTopUpCurveSteepnessInEpochCalled: func(_ uint32) float64 {
+				return 1
+			},
+		},
+		RoundDuration: testRoundDuration,
+		CacheDuration: time.Minute,
+	}
+}
+
+func putEpochStartMetaBlock(t *testing.T, args ArgsStakingRewardsProcessor) {
+	metaBlock := &block.MetaBlock{
+		Epoch:          testEpoch,
+		Round:          1000,
+		DevFeesInEpoch: big.NewInt(1000),
+		EpochStart: block.EpochStart{
+			Economics: block.Economics{
+				TotalToDistribute:                big.NewInt(12000),
+				RewardsForProtocolSustainability: big.NewInt(1000),
+				NodePrice:                        nodePrice,
+				PrevEpochStartRound:              100,
+			},
+		},
+	}
+	buff, err := args.Marshalizer.Marshal(metaBlock)
+	require.Nil(t, err)
+
+	err = args.MetaBlockStorer.Put([]byte(core.EpochStartIdentifier(testEpoch)), buff)
+	require.Nil(t, err)
+}
+
+// setStakingData registers the owner "ownerA" with an eligible and a waiting node and 6000 staked and the owner
+// "ownerB" with two eligible nodes, a jailed node and 5000 staked
+func setStakingData(t *testing.T, args *ArgsStakingRewardsProcessor) {
+	owners := map[string]*systemSmartContracts.ValidatorDataV2{
+		"ownerA": {
+			TotalStakeValue: big.NewInt(6000),
+			BlsPubKeys:      [][]byte{[]byte("key1"), []byte("key2")},
+		},
+		"ownerB": {
+			TotalStakeValue: big.NewInt(5000),
+			BlsPubKeys:      [][]byte{[]byte("key3"), []byte("key4"), []byte("key5")},
+		},
+	}
+	lists := map[string]core.PeerType{
+		"key1": core.EligibleList,
+		"key2": core.WaitingList,
+		"key3": core.EligibleList,
+		"key4": core.EligibleList,
+		"key5": core.JailedList,
+	}
+
+	leaves := make([]core.KeyValueHolder, 0)
+	for owner, validatorData := range owners {
+		buff, err := args.Marshalizer.Marshal(validatorData)
+		require.Nil(t, err)
+
+		suffix := append([]byte(owner), vm.ValidatorSCAddress...)
+		leaves = append(leaves, keyValStorage.NewKeyValStorage([]byte(owner), append(buff, suffix...)))
+	}
+	configKey := []byte("config")
+	leaves = append(leaves, keyValStorage.NewKeyValStorage(configKey, append([]byte("not a validator"), append(configKey, vm.ValidatorSCAddress...)...)))
+
+	validatorAccount, _ := state.NewUserAccount(vm.ValidatorSCAddress)
+	validatorAccount.SetDataTrie(&mock.TrieStub{
+		RootCalled: func() ([]byte, error) {
+			return []byte("root hash"), nil
+		},
+		GetAllLeavesOnChannelCalled: func(_ []byte) (chan core.KeyValueHolder, error) {
+			ch := make(chan core.KeyValueHolder, len(leaves))
+			for _, leaf := range leaves {
+				ch <- leaf
+			}
+			close(ch)
+
+			return ch, nil
+		},
+	})
+
+	args.Accounts = &mock.AccountsStub{
+		GetExistingAccountCalled: func(_ []byte) (state.AccountHandler, error) {
+			return validatorAccount, nil
+		},
+	}
+	args.PeerAccounts = &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			peerAccount, _ := state.NewPeerAccount(address)
+			peerAccount.SetListAndIndex(0, string(lists[string(address)]), 0)
+
+			return peerAccount, nil
+		},
+	}
+}
+
+func TestNewStakingRewardsProcessor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		argsFunc func() ArgsStakingRewardsProcessor
+		exError  error
+	}{
+		{
+			name: "InvalidCacheDuration",
+			argsFunc: func() ArgsStakingRewardsProcessor {
+				args := createMockArgsStakingRewardsProcessor()
+				args.CacheDuration = 0
+				return args
+			},
+			exError: ErrInvalidCacheDuration,
+		},
+		{
+			name: "InvalidRoundDuration",
+			argsFunc: func() ArgsStakingRewardsProcessor {
+				args := createMockArgsStakingRewardsProcessor()
+				args.RoundDuration = 0
+				return args
+			},
+			exError: ErrInvalidRoundDuration,
+		},
+		{
+			name: "NilMarshalizer",
+			argsFunc: func() ArgsStakingRewardsProcessor {
+				args := createMockArgsStakingRewardsProcessor()
+				args.Marshalizer = nil
+				return args
+			},
+			exError: ErrNilMarshalizer,
+		},
+		{
+			name: "NilAccounts",
+			argsFunc: func() ArgsStakingRewardsProcessor {
+				args := createMockArgsStakingRewardsProcessor()
+				args.Accounts = nil
+				return args
+			},
+			exError: ErrNilAccountsAdapter,
+		},
+		{
+			name: "NilPeerAccounts",
+			argsFunc: func() ArgsStakingRewardsProcessor {
+				args := createMockArgsStakingRewardsProcessor()
+				args.PeerAccounts = nil
+				return args
+			},
+			exError: ErrNilPeerAccountsAdapter,
+		},
+		{
+			name: "NilBlockChain",
+			argsFunc: func() ArgsStakingRewardsProcessor {
+				args := createMockArgsStakingRewardsProcessor()
+				args.BlockChain = nil
+				return args
+			},
+			exError: ErrNilBlockChain,
+		},
+		{
+			name: "NilMetaBlockStorer",
+			argsFunc: func() ArgsStakingRewardsProcessor {
+				args := createMockArgsStakingRewardsProcessor()
+				args.MetaBlockStorer = nil
+				return args
+			},
+			exError: ErrNilMetaBlockStorer,
+		},
+		{
+			name: "NilTopUpRewardsSettings",
+			argsFunc: func() ArgsStakingRewardsProcessor {
+				args := createMockArgsStakingRewardsProcessor()
+				args.TopUpRewardsSettings = nil
+				return args
+			},
+			exError: ErrNilTopUpRewardsSettingsHandler,
+		},
+		{
+			name:     "ShouldWork",
+			argsFunc: createMockArgsStakingRewardsProcessor,
+			exError:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srp, err := NewStakingRewardsProcessor(tt.argsFunc())
+			require.True(t, errors.Is(err, tt.exError))
+			require.Equal(t, tt.exError != nil, check.IfNil(srp))
+		})
+	}
+}
+
+func TestStakingRewardsProcessor_GetNetworkAPRFirstEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsStakingRewardsProcessor()
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.MetaBlock{Epoch: 0}
+		},
+	}
+	srp, _ := NewStakingRewardsProcessor(args)
+
+	networkAPR, err := srp.GetNetworkAPR()
+	assert.Nil(t, networkAPR)
+	assert.True(t, errors.Is(err, ErrEpochEconomicsNotAvailable))
+}
+
+func TestStakingRewardsProcessor_GetNetworkAPRMissingEpochStartMetaBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	srp, _ := NewStakingRewardsProcessor(createMockArgsStakingRewardsProcessor())
+
+	networkAPR, err := srp.GetNetworkAPR()
+	assert.Nil(t, networkAPR)
+	assert.True(t, errors.Is(err, ErrEpochEconomicsNotAvailable))
+}
+
+func TestStakingRewardsProcessor_GetNetworkAPRCannotGetValidatorAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsStakingRewardsProcessor()
+	putEpochStartMetaBlock(t, args)
+	args.Accounts = &mock.AccountsStub{
+		GetExistingAccountCalled: func(_ []byte) (state.AccountHandler, error) {
+			return nil, expectedErr
+		},
+	}
+	srp, _ := NewStakingRewardsProcessor(args)
+
+	networkAPR, err := srp.GetNetworkAPR()
+	assert.Nil(t, networkAPR)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestStakingRewardsProcessor_GetNetworkAPRShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsStakingRewardsProcessor()
+	putEpochStartMetaBlock(t, args)
+	setStakingData(t, &args)
+	srp, _ := NewStakingRewardsProcessor(args)
+
+	networkAPR, err := srp.GetNetworkAPR()
+	require.Nil(t, err)
+
+	// ownerA has an eligible node with 3000 stake, ownerB has two eligible nodes with 2500 stake each
+	totalEligibleBaseStake := big.NewInt(7500)
+	totalEligibleTopUpStake := big.NewInt(500)
+	rewardsForBlocks := big.NewInt(10000)
+	topUpRewards := metachain.ComputeTopUpRewards(rewardsForBlocks, totalEligibleTopUpStake, big.NewInt(1000), 0.5, 1)
+	baseRewards := big.NewInt(0).Sub(rewardsForBlocks, topUpRewards)
+	require.True(t, topUpRewards.Sign() > 0)
+
+	assert.Equal(t, testEpoch, networkAPR.Epoch)
+	assert.InDelta(t, 365, networkAPR.EpochsPerYear, 1e-9)
+	assert.Equal(t, nodePrice.String(), networkAPR.NodePrice)
+	assert.Equal(t, rewardsForBlocks.String(), networkAPR.RewardsPerEpoch)
+	assert.Equal(t, baseRewards.String(), networkAPR.BaseRewardsPerEpoch)
+	assert.Equal(t, topUpRewards.String(), networkAPR.TopUpRewardsPerEpoch)
+	assert.Equal(t, totalEligibleBaseStake.String(), networkAPR.TotalEligibleBaseStake)
+	assert.Equal(t, totalEligibleTopUpStake.String(), networkAPR.TotalEligibleTopUpStake)
+	assert.InDelta(t, float64(baseRewards.Int64())*365/7500, networkAPR.BaseAPR, 1e-9)
+	assert.InDelta(t, float64(topUpRewards.Int64())*365/500, networkAPR.TopUpAPR, 1e-9)
+}
+
+func TestStakingRewardsProcessor_GetNetworkAPRShouldUseTheCachedData(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsStakingRewardsProcessor()
+	putEpochStartMetaBlock(t, args)
+	setStakingData(t, &args)
+	numCalls := 0
+	accounts := args.Accounts.(*mock.AccountsStub)
+	getExistingAccount := accounts.GetExistingAccountCalled
+	accounts.GetExistingAccountCalled = func(address []byte) (state.AccountHandler, error) {
+		numCalls++
+		return getExistingAccount(address)
+	}
+	srp, _ := NewStakingRewardsProcessor(args)
+
+	first, err := srp.GetNetworkAPR()
+	require.Nil(t, err)
+	second, err := srp.GetNetworkAPR()
+	require.Nil(t, err)
+
+	assert.Equal(t, first, second)
+	assert.Equal(t, 1, numCalls)
+}
+
+func TestStakingRewardsProcessor_GetOwnerRewardsProjection(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsStakingRewardsProcessor()
+	putEpochStartMetaBlock(t, args)
+	setStakingData(t, &args)
+	srp, _ := NewStakingRewardsProcessor(args)
+
+	t.Run("unknown owner should error", func(t *testing.T) {
+		projection, err := srp.GetOwnerRewardsProjection([]byte("unknown owner"))
+		assert.Nil(t, projection)
+		assert.Equal(t, ErrOwnerNotFound, err)
+	})
+	t.Run("owner with top-up should work", func(t *testing.T) {
+		networkAPR, _ := srp.GetNetworkAPR()
+		baseRewards, _ := big.NewInt(0).SetString(networkAPR.BaseRewardsPerEpoch, 10)
+		topUpRewards, _ := big.NewInt(0).SetString(networkAPR.TopUpRewardsPerEpoch, 10)
+		expectedRewardsPerEpoch := big.NewInt(0).Div(baseRewards, big.NewInt(3))
+		expectedRewardsPerEpoch.Add(expectedRewardsPerEpoch, topUpRewards)
+
+		projection, err := srp.GetOwnerRewardsProjection([]byte("ownerA"))
+		require.Nil(t, err)
+		assert.Equal(t, testEpoch, projection.Epoch)
+		assert.Equal(t, uint32(2), projection.NumStakedNodes)
+		assert.Equal(t, uint32(1), projection.NumEligibleNodes)
+		assert.Equal(t, "6000", projection.TotalStake)
+		assert.Equal(t, "2500", projection.EligibleBaseStake)
+		assert.Equal(t, "500", projection.EligibleTopUpStake)
+		assert.Equal(t, expectedRewardsPerEpoch.String(), projection.RewardsPerEpoch)
+		assert.Equal(t, big.NewInt(0).Mul(expectedRewardsPerEpoch, big.NewInt(365)).String(), projection.RewardsPerYear)
+		assert.InDelta(t, float64(expectedRewardsPerEpoch.Int64())*365/6000, projection.APR, 1e-9)
+	})
+	t.Run("owner without top-up should work", func(t *testing.T) {
+		networkAPR, _ := srp.GetNetworkAPR()
+		baseRewards, _ := big.NewInt(0).SetString(networkAPR.BaseRewardsPerEpoch, 10)
+		expectedRewardsPerEpoch := big.NewInt(0).Mul(baseRewards, big.NewInt(2))
+		expectedRewardsPerEpoch.Div(expectedRewardsPerEpoch, big.NewInt(3))
+
+		projection, err := srp.GetOwnerRewardsProjection([]byte("ownerB"))
+		require.Nil(t, err)
+		assert.Equal(t, uint32(2), projection.NumStakedNodes)
+		assert.Equal(t, uint32(2), projection.NumEligibleNodes)
+		assert.Equal(t, "5000", projection.EligibleBaseStake)
+		assert.Equal(t, "0", projection.EligibleTopUpStake)
+		assert.Equal(t, expectedRewardsPerEpoch.String(), projection.RewardsPerEpoch)
+	})
+}
@@ -2,6 +2,7 @@ package txsimulator
 
 import (
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 )
 
@@ -10,3 +11,12 @@ type TransactionProcessor interface {
 	ProcessTransaction(transaction *transaction.Transaction) (vmcommon.ReturnCode, error)
 	IsInterfaceNil() bool
 }
+
+// SpeculativeAccountsHandler defines the accounts adapter the simulations are executed on. It keeps track of the
+// accounts saved during a simulation, while loading the accounts from the original state
+type SpeculativeAccountsHandler interface {
+	LoadAccount(address []byte) (state.AccountHandler, error)
+	SavedAccounts() []state.AccountHandler
+	ResetSavedAccounts()
+	IsInterfaceNil() bool
+}
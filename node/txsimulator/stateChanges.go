@@ -0,0 +1,200 @@
+package txsimulator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node"
+)
+
+const esdtKeyPrefix = core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier
+
+type accountStateChanges struct {
+	address        []byte
+	balanceBefore  *big.Int
+	balanceDelta   *big.Int
+	storageChanges map[string]*transaction.ApiStorageChange
+	esdtDeltas     map[string]*big.Int
+}
+
+// computeStateChanges builds the diff between the accounts saved during the simulation and the original state. Every
+// saved account instance was loaded from the original state, so the deltas of the instances of the same address add up
+func (ts *transactionSimulator) computeStateChanges() []*transaction.ApiAccountStateChange {
+	changesByAddress := make(map[string]*accountStateChanges)
+	addresses := make([]string, 0)
+	processedInstances := make(map[state.AccountHandler]struct{})
+	for _, account := range ts.accounts.SavedAccounts() {
+		_, processed := processedInstances[account]
+		if processed {
+			continue
+		}
+		processedInstances[account] = struct{}{}
+
+		userAccount, ok := account.(state.UserAccountHandler)
+		if !ok {
+			continue
+		}
+		originalAccount, err := ts.loadOriginalUserAccount(userAccount.AddressBytes())
+		if err != nil {
+			log.Debug("transactionSimulator.computeStateChanges: cannot load original account",
+				"address", userAccount.AddressBytes(),
+				"error", err.Error())
+			continue
+		}
+
+		changes, found := changesByAddress[string(userAccount.AddressBytes())]
+		if !found {
+			changes = &accountStateChanges{
+				address:        userAccount.AddressBytes(),
+				balanceBefore:  originalAccount.GetBalance(),
+				balanceDelta:   big.NewInt(0),
+				storageChanges: make(map[string]*transaction.ApiStorageChange),
+				esdtDeltas:     make(map[string]*big.Int),
+			}
+			changesByAddress[string(userAccount.AddressBytes())] = changes
+			addresses = append(addresses, string(userAccount.AddressBytes()))
+		}
+
+		changes.balanceDelta.Add(changes.balanceDelta, big.NewInt(0).Sub(userAccount.GetBalance(), originalAccount.GetBalance()))
+		ts.addStorageChanges(changes, userAccount, originalAccount)
+	}
+
+	stateChanges := make([]*transaction.ApiAccountStateChange, 0, len(addresses))
+	for _, address := range addresses {
+		changes := changesByAddress[address]
+		if changes.balanceDelta.Sign() == 0 && len(changes.storageChanges) == 0 {
+			continue
+		}
+
+		stateChanges = append(stateChanges, ts.adaptAccountStateChanges(changes))
+	}
+
+	return stateChanges
+}
+
+func (ts *transactionSimulator) loadOriginalUserAccount(address []byte) (state.UserAccountHandler, error) {
+	account, err := ts.accounts.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, state.ErrWrongTypeAssertion
+	}
+
+	return userAccount, nil
+}
+
+func (ts *transactionSimulator) addStorageChanges(
+	changes *accountStateChanges,
+	account state.UserAccountHandler,
+	originalAccount state.UserAccountHandler,
+) {
+	if check.IfNil(account.DataTrieTracker()) {
+		return
+	}
+
+	for key := range account.DataTrieTracker().DirtyData() {
+		newValue := retrieveValue(account, key)
+		oldValue := retrieveValue(originalAccount, key)
+		if bytes.Equal(newValue, oldValue) {
+			continue
+		}
+
+		changes.storageChanges[key] = &transaction.ApiStorageChange{
+			Key:      hex.EncodeToString([]byte(key)),
+			OldValue: hex.EncodeToString(oldValue),
+			NewValue: hex.EncodeToString(newValue),
+		}
+
+		if !strings.HasPrefix(key, esdtKeyPrefix) {
+			continue
+		}
+
+		tokenKey := key[len(esdtKeyPrefix):]
+		delta, found := changes.esdtDeltas[tokenKey]
+		if !found {
+			delta = big.NewInt(0)
+			changes.esdtDeltas[tokenKey] = delta
+		}
+		delta.Add(delta, big.NewInt(0).Sub(ts.getESDTBalance(newValue), ts.getESDTBalance(oldValue)))
+	}
+}
+
+// retrieveValue returns the value of the key from the account storage. A missing or deleted key has an empty value
+func retrieveValue(account state.UserAccountHandler, key string) []byte {
+	if check.IfNil(account.DataTrieTracker()) {
+		return nil
+	}
+
+	value, err := account.DataTrieTracker().RetrieveValue([]byte(key))
+	if err != nil {
+		return nil
+	}
+
+	return value
+}
+
+func (ts *transactionSimulator) getESDTBalance(value []byte) *big.Int {
+	if len(value) == 0 {
+		return big.NewInt(0)
+	}
+
+	esdtToken := &esdt.ESDigitalToken{}
+	err := ts.marshalizer.Unmarshal(esdtToken, value)
+	if err != nil || esdtToken.Value == nil {
+		return big.NewInt(0)
+	}
+
+	return esdtToken.Value
+}
+
+func (ts *transactionSimulator) adaptAccountStateChanges(changes *accountStateChanges) *transaction.ApiAccountStateChange {
+	stateChange := &transaction.ApiAccountStateChange{
+		Address:        ts.addressPubKeyConverter.Encode(changes.address),
+		BalanceBefore:  changes.balanceBefore.String(),
+		BalanceAfter:   big.NewInt(0).Add(changes.balanceBefore, changes.balanceDelta).String(),
+		BalanceDelta:   changes.balanceDelta.String(),
+		StorageChanges: make([]*transaction.ApiStorageChange, 0, len(changes.storageChanges)),
+		ESDTChanges:    make([]*transaction.ApiESDTChange, 0, len(changes.esdtDeltas)),
+	}
+
+	storageKeys := make([]string, 0, len(changes.storageChanges))
+	for key := range changes.storageChanges {
+		storageKeys = append(storageKeys, key)
+	}
+	sort.Strings(storageKeys)
+	for _, key := range storageKeys {
+		stateChange.StorageChanges = append(stateChange.StorageChanges, changes.storageChanges[key])
+	}
+
+	tokenKeys := make([]string, 0, len(changes.esdtDeltas))
+	for tokenKey := range changes.esdtDeltas {
+		tokenKeys = append(tokenKeys, tokenKey)
+	}
+	sort.Strings(tokenKeys)
+	for _, tokenKey := range tokenKeys {
+		delta := changes.esdtDeltas[tokenKey]
+		if delta.Sign() == 0 {
+			continue
+		}
+
+		tokenIdentifier, nonce := node.SplitESDTTokenKey([]byte(tokenKey))
+		stateChange.ESDTChanges = append(stateChange.ESDTChanges, &transaction.ApiESDTChange{
+			TokenIdentifier: tokenIdentifier,
+			Nonce:           nonce,
+			BalanceDelta:    delta.String(),
+		})
+	}
+
+	return stateChange
+}
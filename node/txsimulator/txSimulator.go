@@ -2,7 +2,9 @@ package txsimulator
 
 import (
 	"encoding/hex"
+	"sync"
 
+	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
@@ -10,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -21,13 +24,20 @@ type ArgsTxSimulator struct {
 	IntermmediateProcContainer process.IntermediateProcessorContainer
 	AddressPubKeyConverter     core.PubkeyConverter
 	ShardCoordinator           sharding.Coordinator
+	Accounts                   SpeculativeAccountsHandler
+	Marshalizer                marshal.Marshalizer
 }
 
+var log = logger.GetOrCreate("node/txsimulator")
+
 type transactionSimulator struct {
 	txProcessor            TransactionProcessor
 	intermProcContainer    process.IntermediateProcessorContainer
 	addressPubKeyConverter core.PubkeyConverter
 	shardCoordinator       sharding.Coordinator
+	accounts               SpeculativeAccountsHandler
+	marshalizer            marshal.Marshalizer
+	mutOperation           sync.Mutex
 }
 
 // NewTransactionSimulator returns a new instance of a transactionSimulator
@@ -44,17 +54,33 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.ShardCoordinator) {
 		return nil, node.ErrNilShardCoordinator
 	}
+	if check.IfNil(args.Accounts) {
+		return nil, node.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, node.ErrNilMarshalizer
+	}
 
 	return &transactionSimulator{
 		txProcessor:            args.TransactionProcessor,
 		intermProcContainer:    args.IntermmediateProcContainer,
 		addressPubKeyConverter: args.AddressPubKeyConverter,
 		shardCoordinator:       args.ShardCoordinator,
+		accounts:               args.Accounts,
+		marshalizer:            args.Marshalizer,
 	}, nil
 }
 
-// ProcessTx will process the transaction in a special environment, where state-writing is not allowed
+// ProcessTx will process the transaction in a special environment, where state-writing is not allowed. Besides the
+// generated smart contract results and receipts, the results hold the changes the transaction would apply on the
+// touched accounts
 func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+	ts.mutOperation.Lock()
+	defer ts.mutOperation.Unlock()
+
+	ts.accounts.ResetSavedAccounts()
+	defer ts.accounts.ResetSavedAccounts()
+
 	txStatus := transaction.TxStatusPending
 	failReason := ""
 
@@ -69,8 +95,9 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*transac
 	}
 
 	results := &transaction.SimulationResults{
-		Status:     txStatus,
-		FailReason: failReason,
+		Status:       txStatus,
+		FailReason:   failReason,
+		StateChanges: ts.computeStateChanges(),
 	}
 
 	err = ts.addIntermediateTxsToResult(results)
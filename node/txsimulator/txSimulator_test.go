@@ -1,15 +1,20 @@
 package txsimulator
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
+	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
@@ -50,6 +55,24 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: node.ErrNilIntermediateProcessorContainer,
 		},
+		{
+			name: "NilAccounts",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.Accounts = nil
+				return args
+			},
+			exError: node.ErrNilAccountsAdapter,
+		},
+		{
+			name: "NilMarshalizer",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.Marshalizer = nil
+				return args
+			},
+			exError: node.ErrNilMarshalizer,
+		},
 		{
 			name: "Ok",
 			argsFunc: func() ArgsTxSimulator {
@@ -126,11 +149,102 @@ func TestTransactionSimulator_ProcessTxShouldIncludeScrsAndReceipts(t *testing.T
 	)
 }
 
+func TestTransactionSimulator_ProcessTxShouldComputeStateChanges(t *testing.T) {
+	t.Parallel()
+
+	sender, receiver := []byte("sender"), []byte("receiver")
+	esdtKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + "TKN-abcdef")
+	marshalizer := &mock.MarshalizerFake{}
+	accounts, _ := NewReadOnlyAccountsDB(&mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			account, _ := state.NewUserAccount(address)
+			if bytes.Equal(address, sender) {
+				_ = account.AddToBalance(big.NewInt(100))
+			}
+			return account, nil
+		},
+	})
+
+	loadUserAccount := func(address []byte) state.UserAccountHandler {
+		account, _ := accounts.LoadAccount(address)
+		return account.(state.UserAccountHandler)
+	}
+	args := getTxSimulatorArgs()
+	args.Accounts = accounts
+	args.Marshalizer = marshalizer
+	args.TransactionProcessor = &mock.TxProcessorStub{
+		ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			senderAccount := loadUserAccount(sender)
+			_ = senderAccount.SubFromBalance(big.NewInt(10))
+			_ = accounts.SaveAccount(senderAccount)
+
+			receiverAccount := loadUserAccount(receiver)
+			_ = receiverAccount.AddToBalance(big.NewInt(7))
+			_ = receiverAccount.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value"))
+			esdtValue, _ := marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(5)})
+			_ = receiverAccount.DataTrieTracker().SaveKeyValue(esdtKey, esdtValue)
+			_ = accounts.SaveAccount(receiverAccount)
+
+			// the refund is applied on another instance of the sender account
+			senderAccount = loadUserAccount(sender)
+			_ = senderAccount.AddToBalance(big.NewInt(3))
+			_ = accounts.SaveAccount(senderAccount)
+
+			snapshot := accounts.JournalLen()
+			receiverAccount = loadUserAccount(receiver)
+			_ = receiverAccount.AddToBalance(big.NewInt(1000))
+			_ = accounts.SaveAccount(receiverAccount)
+			_ = accounts.RevertToSnapshot(snapshot)
+
+			return vmcommon.Ok, nil
+		},
+	}
+	args.IntermmediateProcContainer = &mock.IntermProcessorContainerStub{
+		GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+			return &mock.IntermediateTransactionHandlerStub{}, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37})
+	require.NoError(t, err)
+	esdtValue, _ := marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(5)})
+	expectedStateChanges := []*transaction.ApiAccountStateChange{
+		{
+			Address:        hex.EncodeToString(sender),
+			BalanceBefore:  "100",
+			BalanceAfter:   "93",
+			BalanceDelta:   "-7",
+			StorageChanges: []*transaction.ApiStorageChange{},
+			ESDTChanges:    []*transaction.ApiESDTChange{},
+		},
+		{
+			Address:       hex.EncodeToString(receiver),
+			BalanceBefore: "0",
+			BalanceAfter:  "7",
+			BalanceDelta:  "7",
+			StorageChanges: []*transaction.ApiStorageChange{
+				{Key: hex.EncodeToString(esdtKey), OldValue: "", NewValue: hex.EncodeToString(esdtValue)},
+				{Key: hex.EncodeToString([]byte("key")), OldValue: "", NewValue: hex.EncodeToString([]byte("value"))},
+			},
+			ESDTChanges: []*transaction.ApiESDTChange{
+				{TokenIdentifier: "TKN-abcdef", BalanceDelta: "5"},
+			},
+		},
+	}
+	require.Equal(t, expectedStateChanges, results.StateChanges)
+	require.Equal(t, 0, accounts.JournalLen())
+}
+
 func getTxSimulatorArgs() ArgsTxSimulator {
+	accounts, _ := NewReadOnlyAccountsDB(&mock.AccountsStub{})
+
 	return ArgsTxSimulator{
 		TransactionProcessor:       &mock.TxProcessorStub{},
 		IntermmediateProcContainer: &mock.IntermProcessorContainerStub{},
 		AddressPubKeyConverter:     &mock.PubkeyConverterMock{},
 		ShardCoordinator:           mock.NewMultiShardsCoordinatorMock(2),
+		Accounts:                   accounts,
+		Marshalizer:                &mock.MarshalizerMock{},
 	}
 }
@@ -2,6 +2,7 @@ package txsimulator
 
 import (
 	"context"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -10,9 +11,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/node"
 )
 
-// readOnlyAccountsDB is a wrapper over an accounts db which works read-only. write operation are disabled
+// readOnlyAccountsDB is a wrapper over an accounts db which works read-only. write operation are disabled, the saved
+// accounts being only recorded, as the speculative state branch of the simulation, in a journal of their own
 type readOnlyAccountsDB struct {
 	originalAccounts state.AccountsAdapter
+	mutSaved         sync.RWMutex
+	savedAccounts    []state.AccountHandler
 }
 
 // NewReadOnlyAccountsDB returns a new instance of readOnlyAccountsDB
@@ -21,7 +25,10 @@ func NewReadOnlyAccountsDB(accountsDB state.AccountsAdapter) (*readOnlyAccountsD
 		return nil, node.ErrNilAccountsAdapter
 	}
 
-	return &readOnlyAccountsDB{originalAccounts: accountsDB}, nil
+	return &readOnlyAccountsDB{
+		originalAccounts: accountsDB,
+		savedAccounts:    make([]state.AccountHandler, 0),
+	}, nil
 }
 
 // GetCode returns the code for the given account
@@ -39,8 +46,17 @@ func (w *readOnlyAccountsDB) LoadAccount(address []byte) (state.AccountHandler,
 	return w.originalAccounts.LoadAccount(address)
 }
 
-// SaveAccount won't do anything as write operations are disabled on this component
-func (w *readOnlyAccountsDB) SaveAccount(_ state.AccountHandler) error {
+// SaveAccount won't write the account as write operations are disabled on this component. The account is only recorded
+// so that the state changes of the simulation can be computed
+func (w *readOnlyAccountsDB) SaveAccount(account state.AccountHandler) error {
+	if check.IfNil(account) {
+		return nil
+	}
+
+	w.mutSaved.Lock()
+	w.savedAccounts = append(w.savedAccounts, account)
+	w.mutSaved.Unlock()
+
 	return nil
 }
 
@@ -54,21 +70,54 @@ func (w *readOnlyAccountsDB) Commit() ([]byte, error) {
 	return nil, nil
 }
 
-// JournalLen will call the original accounts' function with the same name
+// JournalLen returns the number of recorded saved accounts
 func (w *readOnlyAccountsDB) JournalLen() int {
-	return w.originalAccounts.JournalLen()
+	w.mutSaved.RLock()
+	defer w.mutSaved.RUnlock()
+
+	return len(w.savedAccounts)
 }
 
-// RevertToSnapshot won't do anything as write operations are disabled on this component
-func (w *readOnlyAccountsDB) RevertToSnapshot(_ int) error {
+// RevertToSnapshot discards the accounts recorded after the provided snapshot
+func (w *readOnlyAccountsDB) RevertToSnapshot(snapshot int) error {
+	w.mutSaved.Lock()
+	defer w.mutSaved.Unlock()
+
+	if snapshot >= 0 && snapshot < len(w.savedAccounts) {
+		w.savedAccounts = w.savedAccounts[:snapshot]
+	}
+
 	return nil
 }
 
+// SavedAccounts returns the accounts recorded since the last reset, in the order they were saved
+func (w *readOnlyAccountsDB) SavedAccounts() []state.AccountHandler {
+	w.mutSaved.RLock()
+	defer w.mutSaved.RUnlock()
+
+	savedAccounts := make([]state.AccountHandler, len(w.savedAccounts))
+	copy(savedAccounts, w.savedAccounts)
+
+	return savedAccounts
+}
+
+// ResetSavedAccounts discards all the recorded accounts
+func (w *readOnlyAccountsDB) ResetSavedAccounts() {
+	w.mutSaved.Lock()
+	w.savedAccounts = make([]state.AccountHandler, 0)
+	w.mutSaved.Unlock()
+}
+
 // GetNumCheckpoints will call the original accounts' function with the same name
 func (w *readOnlyAccountsDB) GetNumCheckpoints() uint32 {
 	return w.originalAccounts.GetNumCheckpoints()
 }
 
+// IsSnapshotInProgress will call the original accounts' function with the same name
+func (w *readOnlyAccountsDB) IsSnapshotInProgress() bool {
+	return w.originalAccounts.IsSnapshotInProgress()
+}
+
 // RootHash will call the original accounts' function with the same name
 func (w *readOnlyAccountsDB) RootHash() ([]byte, error) {
 	return w.originalAccounts.RootHash()
@@ -110,6 +159,11 @@ func (w *readOnlyAccountsDB) RecreateAllTries(_ []byte, _ context.Context) (map[
 	return nil, nil
 }
 
+// GetTrie returns the accounts trie recreated from the given root hash
+func (w *readOnlyAccountsDB) GetTrie(rootHash []byte) (data.Trie, error) {
+	return w.originalAccounts.GetTrie(rootHash)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (w *readOnlyAccountsDB) IsInterfaceNil() bool {
 	return w == nil
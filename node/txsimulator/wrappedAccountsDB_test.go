@@ -105,7 +105,6 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 	t.Parallel()
 
 	expectedAcc := &mock.AccountWrapMock{}
-	expectedJournalLen := 37
 	expectedRootHash := []byte("root")
 	expectedLeavesChannel := make(chan core.KeyValueHolder)
 	expectedNumCheckpoints := uint32(7)
@@ -117,9 +116,6 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 		LoadAccountCalled: func(_ []byte) (state.AccountHandler, error) {
 			return expectedAcc, nil
 		},
-		JournalLenCalled: func() int {
-			return expectedJournalLen
-		},
 		RootHashCalled: func() ([]byte, error) {
 			return expectedRootHash, nil
 		},
@@ -145,9 +141,6 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedAcc, actualAcc)
 
-	actualJournalLen := roAccDb.JournalLen()
-	require.Equal(t, expectedJournalLen, actualJournalLen)
-
 	actualRootHash, err := roAccDb.RootHash()
 	require.NoError(t, err)
 	require.Equal(t, expectedRootHash, actualRootHash)
@@ -162,3 +155,32 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 	actualNumCheckpoints := roAccDb.GetNumCheckpoints()
 	require.Equal(t, expectedNumCheckpoints, actualNumCheckpoints)
 }
+
+func TestReadOnlyAccountsDB_SaveAccountShouldRecordTheSpeculativeState(t *testing.T) {
+	t.Parallel()
+
+	roAccDb, _ := NewReadOnlyAccountsDB(&mock.AccountsStub{
+		JournalLenCalled: func() int {
+			require.Fail(t, "the original journal should not be used")
+			return 0
+		},
+	})
+	acc1, acc2, acc3 := mock.NewAccountWrapMock([]byte("a1")), mock.NewAccountWrapMock([]byte("a2")), mock.NewAccountWrapMock([]byte("a3"))
+
+	_ = roAccDb.SaveAccount(acc1)
+	_ = roAccDb.SaveAccount(nil)
+	snapshot := roAccDb.JournalLen()
+	require.Equal(t, 1, snapshot)
+
+	_ = roAccDb.SaveAccount(acc2)
+	_ = roAccDb.SaveAccount(acc3)
+	require.Equal(t, 3, roAccDb.JournalLen())
+
+	err := roAccDb.RevertToSnapshot(snapshot)
+	require.NoError(t, err)
+	require.Equal(t, []state.AccountHandler{acc1}, roAccDb.SavedAccounts())
+
+	roAccDb.ResetSavedAccounts()
+	require.Equal(t, 0, roAccDb.JournalLen())
+	require.Empty(t, roAccDb.SavedAccounts())
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/protocol"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/multiformats/go-multiaddr"
 )
 
 // ListenAddrWithIp4AndTcp defines the listening address with ip v.4 and TCP
@@ -128,9 +129,18 @@ func NewNetworkMessenger(args ArgsNetworkMessenger) (*networkMessenger, error) {
 		return nil, err
 	}
 
-	address := fmt.Sprintf(args.ListenAddress+"%d", port)
+	addresses := []string{fmt.Sprintf(args.ListenAddress+"%d", port)}
+	if args.P2pConfig.WebSocket.Enabled {
+		wsPort, errWs := getPort(args.P2pConfig.WebSocket.Port, checkFreePort)
+		if errWs != nil {
+			return nil, fmt.Errorf("%w for the web socket listener", errWs)
+		}
+
+		addresses = append(addresses, fmt.Sprintf(args.ListenAddress+"%d/ws", wsPort))
+	}
+
 	opts := []libp2p.Option{
-		libp2p.ListenAddrStrings(address),
+		libp2p.ListenAddrStrings(addresses...),
 		libp2p.Identity(p2pPrivKey),
 		libp2p.DefaultMuxers,
 		libp2p.DefaultSecurity,
@@ -572,6 +582,24 @@ func (netMes *networkMessenger) IsConnected(peerID core.PeerID) bool {
 	return connectedness == network.Connected
 }
 
+// IsConnectedViaWebSocket returns true if at least one of the connections with the provided peer
+// was established through the web socket listener
+func (netMes *networkMessenger) IsConnectedViaWebSocket(peerID core.PeerID) bool {
+	for _, conn := range netMes.p2pHost.Network().ConnsToPeer(peer.ID(peerID)) {
+		_, err := conn.RemoteMultiaddr().ValueForProtocol(multiaddr.P_WS)
+		if err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ClosePeer closes all the connections with the provided peer
+func (netMes *networkMessenger) ClosePeer(pid core.PeerID) error {
+	return netMes.p2pHost.Network().ClosePeer(peer.ID(pid))
+}
+
 // ConnectedPeers returns the current connected peers list
 func (netMes *networkMessenger) ConnectedPeers() []core.PeerID {
 	h := netMes.p2pHost
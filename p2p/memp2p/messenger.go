@@ -17,6 +17,8 @@ const maxQueueSize = 1000
 
 var log = logger.GetOrCreate("p2p/memp2p")
 
+var _ p2p.Messenger = (*Messenger)(nil)
+
 // Messenger is an implementation of the p2p.Messenger interface that
 // uses no real networking code, but instead connects to a network simulated in
 // memory (the Network struct). The Messenger is intended for use
@@ -121,6 +123,11 @@ func (messenger *Messenger) IsConnected(_ core.PeerID) bool {
 	return messenger.IsConnectedToNetwork()
 }
 
+// ClosePeer does nothing, as the in-memory network keeps all the peers connected
+func (messenger *Messenger) ClosePeer(_ core.PeerID) error {
+	return nil
+}
+
 // ConnectedPeers returns a slice of IDs belonging to the peers to which this
 // Messenger is connected. If the Messenger is connected to the in₋memory
 // network, then the function returns a slice containing the IDs of all the
@@ -258,6 +265,29 @@ func (messenger *Messenger) UnregisterMessageProcessor(topic string) error {
 	return nil
 }
 
+// UnregisterAllMessageProcessors unsets the message processors of all the topics
+func (messenger *Messenger) UnregisterAllMessageProcessors() error {
+	messenger.topicsMutex.Lock()
+	defer messenger.topicsMutex.Unlock()
+
+	for topic := range messenger.topicValidators {
+		messenger.topicValidators[topic] = nil
+	}
+
+	return nil
+}
+
+// UnjoinAllTopics removes all the topics this Messenger has declared interest in, so the messages received on them
+// are no longer processed
+func (messenger *Messenger) UnjoinAllTopics() error {
+	messenger.topicsMutex.Lock()
+	defer messenger.topicsMutex.Unlock()
+
+	messenger.topics = make(map[string]struct{})
+
+	return nil
+}
+
 // OutgoingChannelLoadBalancer does nothing, as it is not applicable to the in-memory network.
 func (messenger *Messenger) OutgoingChannelLoadBalancer() p2p.ChannelLoadBalancer {
 	return nil
@@ -74,6 +74,9 @@ type Messenger interface {
 	// currently connected to.
 	ConnectedPeers() []core.PeerID
 
+	// ClosePeer closes all the connections with the provided peer
+	ClosePeer(pid core.PeerID) error
+
 	// ConnectedAddresses returns the list of addresses of the peers to which the
 	// Messenger is currently connected.
 	ConnectedAddresses() []string
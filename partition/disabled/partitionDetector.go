@@ -0,0 +1,20 @@
+package disabled
+
+// PartitionDetector is the disabled partition detector implementation
+type PartitionDetector struct {
+}
+
+// IsInProtectiveMode returns false
+func (pd *PartitionDetector) IsInProtectiveMode() bool {
+	return false
+}
+
+// Close returns nil
+func (pd *PartitionDetector) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pd *PartitionDetector) IsInterfaceNil() bool {
+	return pd == nil
+}
@@ -0,0 +1,21 @@
+package partition
+
+import "errors"
+
+// ErrNilConnectedPeersProvider signals that a nil connected peers provider has been provided
+var ErrNilConnectedPeersProvider = errors.New("nil connected peers provider")
+
+// ErrNilHeadersPoolSubscriber signals that a nil headers pool subscriber has been provided
+var ErrNilHeadersPoolSubscriber = errors.New("nil headers pool subscriber")
+
+// ErrNilConsensusGroupSizeProvider signals that a nil consensus group size provider has been provided
+var ErrNilConsensusGroupSizeProvider = errors.New("nil consensus group size provider")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
@@ -0,0 +1,13 @@
+package partition
+
+import "time"
+
+func (pd *partitionDetector) Check() {
+	pd.check()
+}
+
+func (pd *partitionDetector) SetGetTimeHandler(handler func() time.Time) {
+	pd.mutState.Lock()
+	pd.getTimeHandler = handler
+	pd.mutState.Unlock()
+}
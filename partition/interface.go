@@ -0,0 +1,31 @@
+package partition
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// Detector defines the behaviour of a network partition detector
+type Detector interface {
+	IsInProtectiveMode() bool
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// ConnectedPeersProvider is able to provide the currently connected peers
+type ConnectedPeersProvider interface {
+	ConnectedPeers() []core.PeerID
+	IsInterfaceNil() bool
+}
+
+// HeadersPoolSubscriber can notify the subscribers about the newly received headers
+type HeadersPoolSubscriber interface {
+	RegisterHandler(handler func(headerHandler data.HeaderHandler, headerHash []byte))
+	IsInterfaceNil() bool
+}
+
+// ConsensusGroupSizeProvider is able to provide the consensus group size of a shard
+type ConsensusGroupSizeProvider interface {
+	ConsensusGroupSize(shardID uint32) int
+	IsInterfaceNil() bool
+}
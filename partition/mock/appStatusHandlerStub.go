@@ -0,0 +1,62 @@
+package mock
+
+// AppStatusHandlerStub -
+type AppStatusHandlerStub struct {
+	AddUint64Handler      func(key string, value uint64)
+	IncrementHandler      func(key string)
+	DecrementHandler      func(key string)
+	SetUInt64ValueHandler func(key string, value uint64)
+	SetInt64ValueHandler  func(key string, value int64)
+	SetStringValueHandler func(key string, value string)
+}
+
+// AddUint64 -
+func (stub *AppStatusHandlerStub) AddUint64(key string, value uint64) {
+	if stub.AddUint64Handler != nil {
+		stub.AddUint64Handler(key, value)
+	}
+}
+
+// Increment -
+func (stub *AppStatusHandlerStub) Increment(key string) {
+	if stub.IncrementHandler != nil {
+		stub.IncrementHandler(key)
+	}
+}
+
+// Decrement -
+func (stub *AppStatusHandlerStub) Decrement(key string) {
+	if stub.DecrementHandler != nil {
+		stub.DecrementHandler(key)
+	}
+}
+
+// SetInt64Value -
+func (stub *AppStatusHandlerStub) SetInt64Value(key string, value int64) {
+	if stub.SetInt64ValueHandler != nil {
+		stub.SetInt64ValueHandler(key, value)
+	}
+}
+
+// SetUInt64Value -
+func (stub *AppStatusHandlerStub) SetUInt64Value(key string, value uint64) {
+	if stub.SetUInt64ValueHandler != nil {
+		stub.SetUInt64ValueHandler(key, value)
+	}
+}
+
+// SetStringValue -
+func (stub *AppStatusHandlerStub) SetStringValue(key string, value string) {
+	if stub.SetStringValueHandler != nil {
+		stub.SetStringValueHandler(key, value)
+	}
+}
+
+// Close -
+func (stub *AppStatusHandlerStub) Close() {
+}
+
+// IsInterfaceNil -
+func (stub *AppStatusHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
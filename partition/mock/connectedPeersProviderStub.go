@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// ConnectedPeersProviderStub -
+type ConnectedPeersProviderStub struct {
+	ConnectedPeersCalled func() []core.PeerID
+}
+
+// ConnectedPeers -
+func (stub *ConnectedPeersProviderStub) ConnectedPeers() []core.PeerID {
+	if stub.ConnectedPeersCalled != nil {
+		return stub.ConnectedPeersCalled()
+	}
+
+	return make([]core.PeerID, 0)
+}
+
+// IsInterfaceNil -
+func (stub *ConnectedPeersProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
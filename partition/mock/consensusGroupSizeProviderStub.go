@@ -0,0 +1,20 @@
+package mock
+
+// ConsensusGroupSizeProviderStub -
+type ConsensusGroupSizeProviderStub struct {
+	ConsensusGroupSizeCalled func(shardID uint32) int
+}
+
+// ConsensusGroupSize -
+func (stub *ConsensusGroupSizeProviderStub) ConsensusGroupSize(shardID uint32) int {
+	if stub.ConsensusGroupSizeCalled != nil {
+		return stub.ConsensusGroupSizeCalled(shardID)
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (stub *ConsensusGroupSizeProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
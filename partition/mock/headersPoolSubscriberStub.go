@@ -0,0 +1,20 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data"
+
+// HeadersPoolSubscriberStub -
+type HeadersPoolSubscriberStub struct {
+	RegisterHandlerCalled func(handler func(headerHandler data.HeaderHandler, headerHash []byte))
+}
+
+// RegisterHandler -
+func (stub *HeadersPoolSubscriberStub) RegisterHandler(handler func(headerHandler data.HeaderHandler, headerHash []byte)) {
+	if stub.RegisterHandlerCalled != nil {
+		stub.RegisterHandlerCalled(handler)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *HeadersPoolSubscriberStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,280 @@
+package partition
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("partition")
+
+const (
+	// StateHealthy signals that no partition signal was raised
+	StateHealthy = "healthy"
+	// StateSuspect signals that some partition signals were raised but not enough to flag a partition
+	StateSuspect = "suspect"
+	// StatePartitioned signals that the node is probably partitioned or eclipsed
+	StatePartitioned = "partitioned"
+)
+
+const maxNumSignals = 3
+const maxPercent = 100
+
+// ArgsPartitionDetector is the DTO used to create a new instance of partitionDetector
+type ArgsPartitionDetector struct {
+	Config           config.PartitionDetectorConfig
+	Messenger        ConnectedPeersProvider
+	HeadersPool      HeadersPoolSubscriber
+	NodesCoordinator ConsensusGroupSizeProvider
+	ShardCoordinator sharding.Coordinator
+	AppStatusHandler core.AppStatusHandler
+}
+
+// partitionDetector correlates the peers connectivity, the header receipt gaps per shard and the consensus
+// participation seen in the received headers in order to flag probable network partitions or eclipse conditions
+type partitionDetector struct {
+	messenger               ConnectedPeersProvider
+	nodesCoordinator        ConsensusGroupSizeProvider
+	appStatusHandler        core.AppStatusHandler
+	shardIDs                []uint32
+	minConnectedPeers       int
+	maxHeaderGap            time.Duration
+	minParticipationPercent uint64
+	numSignalsForPartition  int
+	protectiveModeEnabled   bool
+	getTimeHandler          func() time.Time
+
+	mutState           sync.RWMutex
+	lastHeaderTime     map[uint32]time.Time
+	numSigners         uint64
+	numExpectedSigners uint64
+	state              string
+	cancelFunc         func()
+}
+
+// NewPartitionDetector creates a new partition detector and starts the periodic checks
+func NewPartitionDetector(args ArgsPartitionDetector) (*partitionDetector, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	pd := &partitionDetector{
+		messenger:               args.Messenger,
+		nodesCoordinator:        args.NodesCoordinator,
+		appStatusHandler:        args.AppStatusHandler,
+		minConnectedPeers:       int(args.Config.MinConnectedPeers),
+		maxHeaderGap:            time.Duration(args.Config.MaxHeaderGapInSeconds) * time.Second,
+		minParticipationPercent: uint64(args.Config.MinConsensusParticipationPercent),
+		numSignalsForPartition:  int(args.Config.NumSignalsForPartition),
+		protectiveModeEnabled:   args.Config.ProtectiveModeEnabled,
+		getTimeHandler:          time.Now,
+		lastHeaderTime:          make(map[uint32]time.Time),
+		state:                   StateHealthy,
+	}
+
+	for shardID := uint32(0); shardID < args.ShardCoordinator.NumberOfShards(); shardID++ {
+		pd.shardIDs = append(pd.shardIDs, shardID)
+	}
+	pd.shardIDs = append(pd.shardIDs, core.MetachainShardId)
+
+	now := pd.getTimeHandler()
+	for _, shardID := range pd.shardIDs {
+		pd.lastHeaderTime[shardID] = now
+	}
+
+	args.HeadersPool.RegisterHandler(pd.receivedHeader)
+	pd.appStatusHandler.SetStringValue(core.MetricPartitionState, StateHealthy)
+
+	var ctx context.Context
+	ctx, pd.cancelFunc = context.WithCancel(context.Background())
+	go pd.checkContinuously(ctx, time.Duration(args.Config.CheckIntervalInSeconds)*time.Second)
+
+	return pd, nil
+}
+
+func checkArgs(args ArgsPartitionDetector) error {
+	if check.IfNil(args.Messenger) {
+		return ErrNilConnectedPeersProvider
+	}
+	if check.IfNil(args.HeadersPool) {
+		return ErrNilHeadersPoolSubscriber
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return ErrNilConsensusGroupSizeProvider
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(args.AppStatusHandler) {
+		return ErrNilAppStatusHandler
+	}
+	if args.Config.CheckIntervalInSeconds == 0 {
+		return fmt.Errorf("%w for CheckIntervalInSeconds", ErrInvalidValue)
+	}
+	if args.Config.MaxHeaderGapInSeconds == 0 {
+		return fmt.Errorf("%w for MaxHeaderGapInSeconds", ErrInvalidValue)
+	}
+	if args.Config.MinConsensusParticipationPercent > maxPercent {
+		return fmt.Errorf("%w for MinConsensusParticipationPercent, provided %d, maximum %d",
+			ErrInvalidValue, args.Config.MinConsensusParticipationPercent, maxPercent)
+	}
+	if args.Config.NumSignalsForPartition == 0 || args.Config.NumSignalsForPartition > maxNumSignals {
+		return fmt.Errorf("%w for NumSignalsForPartition, provided %d, expected between 1 and %d",
+			ErrInvalidValue, args.Config.NumSignalsForPartition, maxNumSignals)
+	}
+
+	return nil
+}
+
+func (pd *partitionDetector) receivedHeader(headerHandler data.HeaderHandler, _ []byte) {
+	if check.IfNil(headerHandler) {
+		return
+	}
+
+	shardID := headerHandler.GetShardID()
+	numSigners := uint64(0)
+	for _, b := range headerHandler.GetPubKeysBitmap() {
+		numSigners += uint64(bits.OnesCount8(b))
+	}
+	consensusSize := pd.nodesCoordinator.ConsensusGroupSize(shardID)
+
+	pd.mutState.Lock()
+	defer pd.mutState.Unlock()
+
+	_, isKnownShard := pd.lastHeaderTime[shardID]
+	if !isKnownShard {
+		return
+	}
+
+	pd.lastHeaderTime[shardID] = pd.getTimeHandler()
+	if numSigners == 0 || consensusSize <= 0 {
+		return
+	}
+
+	pd.numSigners += numSigners
+	pd.numExpectedSigners += uint64(consensusSize)
+}
+
+func (pd *partitionDetector) checkContinuously(ctx context.Context, interval time.Duration) {
+	for {
+		select {
+		case <-time.After(interval):
+			pd.check()
+		case <-ctx.Done():
+			log.Debug("partition detector's go routine is stopping...")
+			return
+		}
+	}
+}
+
+func (pd *partitionDetector) check() {
+	numConnectedPeers := len(pd.messenger.ConnectedPeers())
+	now := pd.getTimeHandler()
+
+	pd.mutState.Lock()
+	defer pd.mutState.Unlock()
+
+	staleShards := make([]string, 0)
+	for _, shardID := range pd.shardIDs {
+		if now.Sub(pd.lastHeaderTime[shardID]) > pd.maxHeaderGap {
+			staleShards = append(staleShards, core.GetShardIDString(shardID))
+		}
+	}
+
+	participationPercent := uint64(maxPercent)
+	if pd.numExpectedSigners > 0 {
+		participationPercent = pd.numSigners * maxPercent / pd.numExpectedSigners
+	}
+	pd.numSigners = 0
+	pd.numExpectedSigners = 0
+
+	reasons := make([]string, 0, maxNumSignals)
+	if numConnectedPeers < pd.minConnectedPeers {
+		reasons = append(reasons, fmt.Sprintf("low connectivity: %d connected peers", numConnectedPeers))
+	}
+	if len(staleShards) > 0 {
+		reasons = append(reasons, fmt.Sprintf("header gaps for shard(s) %s", strings.Join(staleShards, ", ")))
+	}
+	if participationPercent < pd.minParticipationPercent {
+		reasons = append(reasons, fmt.Sprintf("low consensus participation: %d%%", participationPercent))
+	}
+
+	newState := StateHealthy
+	if len(reasons) > 0 {
+		newState = StateSuspect
+	}
+	if len(reasons) >= pd.numSignalsForPartition {
+		newState = StatePartitioned
+	}
+
+	pd.appStatusHandler.SetStringValue(core.MetricPartitionState, newState)
+	pd.appStatusHandler.SetUInt64Value(core.MetricPartitionNumSignals, uint64(len(reasons)))
+	pd.appStatusHandler.SetUInt64Value(core.MetricPartitionNumStaleShards, uint64(len(staleShards)))
+	pd.appStatusHandler.SetUInt64Value(core.MetricPartitionConsensusParticipation, participationPercent)
+
+	pd.logTransition(newState, reasons, len(staleShards) == len(pd.shardIDs))
+	pd.state = newState
+}
+
+func (pd *partitionDetector) logTransition(newState string, reasons []string, allShardsAreStale bool) {
+	if newState == pd.state {
+		return
+	}
+
+	switch newState {
+	case StatePartitioned:
+		condition := "network partition"
+		if allShardsAreStale {
+			condition = "eclipse"
+		}
+		log.Warn("probable "+condition+" detected",
+			"reasons", strings.Join(reasons, "; "),
+			"protective mode", pd.protectiveModeEnabled,
+		)
+	case StateSuspect:
+		log.Debug("partition detector raised signals", "reasons", strings.Join(reasons, "; "))
+	default:
+		log.Info("partition detector: node is healthy again", "previous state", pd.state)
+	}
+}
+
+// State returns the state computed in the last check
+func (pd *partitionDetector) State() string {
+	pd.mutState.RLock()
+	defer pd.mutState.RUnlock()
+
+	return pd.state
+}
+
+// IsInProtectiveMode returns true if the protective mode is enabled and a probable partition is flagged.
+// While in protective mode the node should refuse to finalize blocks
+func (pd *partitionDetector) IsInProtectiveMode() bool {
+	if !pd.protectiveModeEnabled {
+		return false
+	}
+
+	return pd.State() == StatePartitioned
+}
+
+// Close stops the periodic checks
+func (pd *partitionDetector) Close() error {
+	pd.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pd *partitionDetector) IsInterfaceNil() bool {
+	return pd == nil
+}
@@ -0,0 +1,252 @@
+package partition_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/partition"
+	"github.com/ElrondNetwork/elrond-go/partition/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/stretchr/testify/assert"
+)
+
+const consensusSize = 8
+
+func createMockArgs() partition.ArgsPartitionDetector {
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(1, 0)
+
+	return partition.ArgsPartitionDetector{
+		Config: config.PartitionDetectorConfig{
+			CheckIntervalInSeconds:           3600,
+			MinConnectedPeers:                2,
+			MaxHeaderGapInSeconds:            60,
+			MinConsensusParticipationPercent: 67,
+			NumSignalsForPartition:           2,
+			ProtectiveModeEnabled:            true,
+		},
+		Messenger: &mock.ConnectedPeersProviderStub{
+			ConnectedPeersCalled: func() []core.PeerID {
+				return []core.PeerID{"pid1", "pid2", "pid3"}
+			},
+		},
+		HeadersPool: &mock.HeadersPoolSubscriberStub{},
+		NodesCoordinator: &mock.ConsensusGroupSizeProviderStub{
+			ConsensusGroupSizeCalled: func(shardID uint32) int {
+				return consensusSize
+			},
+		},
+		ShardCoordinator: shardCoordinator,
+		AppStatusHandler: statusHandler.NewNilStatusHandler(),
+	}
+}
+
+func createHeader(shardID uint32, bitmap []byte) data.HeaderHandler {
+	if shardID == core.MetachainShardId {
+		return &block.MetaBlock{PubKeysBitmap: bitmap}
+	}
+
+	return &block.Header{ShardID: shardID, PubKeysBitmap: bitmap}
+}
+
+func TestNewPartitionDetector_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Messenger = nil
+	pd, err := partition.NewPartitionDetector(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, partition.ErrNilConnectedPeersProvider, err)
+
+	args = createMockArgs()
+	args.HeadersPool = nil
+	pd, err = partition.NewPartitionDetector(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, partition.ErrNilHeadersPoolSubscriber, err)
+
+	args = createMockArgs()
+	args.NodesCoordinator = nil
+	pd, err = partition.NewPartitionDetector(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, partition.ErrNilConsensusGroupSizeProvider, err)
+
+	args = createMockArgs()
+	args.ShardCoordinator = nil
+	pd, err = partition.NewPartitionDetector(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, partition.ErrNilShardCoordinator, err)
+
+	args = createMockArgs()
+	args.AppStatusHandler = nil
+	pd, err = partition.NewPartitionDetector(args)
+	assert.True(t, check.IfNil(pd))
+	assert.Equal(t, partition.ErrNilAppStatusHandler, err)
+
+	args = createMockArgs()
+	args.Config.CheckIntervalInSeconds = 0
+	pd, err = partition.NewPartitionDetector(args)
+	assert.True(t, check.IfNil(pd))
+	assert.True(t, errors.Is(err, partition.ErrInvalidValue))
+
+	args = createMockArgs()
+	args.Config.MaxHeaderGapInSeconds = 0
+	pd, err = partition.NewPartitionDetector(args)
+	assert.True(t, check.IfNil(pd))
+	assert.True(t, errors.Is(err, partition.ErrInvalidValue))
+
+	args = createMockArgs()
+	args.Config.MinConsensusParticipationPercent = 101
+	pd, err = partition.NewPartitionDetector(args)
+	assert.True(t, check.IfNil(pd))
+	assert.True(t, errors.Is(err, partition.ErrInvalidValue))
+
+	args = createMockArgs()
+	args.Config.NumSignalsForPartition = 4
+	pd, err = partition.NewPartitionDetector(args)
+	assert.True(t, check.IfNil(pd))
+	assert.True(t, errors.Is(err, partition.ErrInvalidValue))
+}
+
+func TestNewPartitionDetector_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	registerCalled := false
+	args := createMockArgs()
+	args.HeadersPool = &mock.HeadersPoolSubscriberStub{
+		RegisterHandlerCalled: func(handler func(headerHandler data.HeaderHandler, headerHash []byte)) {
+			registerCalled = true
+		},
+	}
+	pd, err := partition.NewPartitionDetector(args)
+	assert.False(t, check.IfNil(pd))
+	assert.Nil(t, err)
+	assert.True(t, registerCalled)
+	assert.Equal(t, partition.StateHealthy, pd.State())
+	assert.False(t, pd.IsInProtectiveMode())
+
+	_ = pd.Close()
+}
+
+func TestPartitionDetector_CheckHealthyNetwork(t *testing.T) {
+	t.Parallel()
+
+	var handler func(headerHandler data.HeaderHandler, headerHash []byte)
+	args := createMockArgs()
+	args.HeadersPool = &mock.HeadersPoolSubscriberStub{
+		RegisterHandlerCalled: func(h func(headerHandler data.HeaderHandler, headerHash []byte)) {
+			handler = h
+		},
+	}
+	pd, _ := partition.NewPartitionDetector(args)
+	defer func() {
+		_ = pd.Close()
+	}()
+
+	handler(createHeader(0, []byte{0xFF}), []byte("hash0"))
+	handler(createHeader(core.MetachainShardId, []byte{0xFE}), []byte("hash1"))
+	pd.Check()
+
+	assert.Equal(t, partition.StateHealthy, pd.State())
+	assert.False(t, pd.IsInProtectiveMode())
+}
+
+func TestPartitionDetector_CheckOneSignalShouldBeSuspect(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Messenger = &mock.ConnectedPeersProviderStub{}
+	pd, _ := partition.NewPartitionDetector(args)
+	defer func() {
+		_ = pd.Close()
+	}()
+
+	pd.Check()
+
+	assert.Equal(t, partition.StateSuspect, pd.State())
+	assert.False(t, pd.IsInProtectiveMode())
+}
+
+func TestPartitionDetector_CheckHeaderGapsAndLowParticipationShouldBePartitioned(t *testing.T) {
+	t.Parallel()
+
+	var handler func(headerHandler data.HeaderHandler, headerHash []byte)
+	args := createMockArgs()
+	args.HeadersPool = &mock.HeadersPoolSubscriberStub{
+		RegisterHandlerCalled: func(h func(headerHandler data.HeaderHandler, headerHash []byte)) {
+			handler = h
+		},
+	}
+	pd, _ := partition.NewPartitionDetector(args)
+	defer func() {
+		_ = pd.Close()
+	}()
+
+	currentTime := time.Now().Add(time.Hour)
+	pd.SetGetTimeHandler(func() time.Time {
+		return currentTime
+	})
+	// only the shard 0 headers are received, signed by half of the consensus group
+	handler(createHeader(0, []byte{0x0F}), []byte("hash"))
+	pd.Check()
+
+	assert.Equal(t, partition.StatePartitioned, pd.State())
+	assert.True(t, pd.IsInProtectiveMode())
+
+	handler(createHeader(0, []byte{0xFF}), []byte("hash0"))
+	handler(createHeader(core.MetachainShardId, []byte{0xFF}), []byte("hash1"))
+	pd.Check()
+
+	assert.Equal(t, partition.StateHealthy, pd.State())
+	assert.False(t, pd.IsInProtectiveMode())
+}
+
+func TestPartitionDetector_IsInProtectiveModeDisabledShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Config.ProtectiveModeEnabled = false
+	args.Config.NumSignalsForPartition = 1
+	args.Messenger = &mock.ConnectedPeersProviderStub{}
+	pd, _ := partition.NewPartitionDetector(args)
+	defer func() {
+		_ = pd.Close()
+	}()
+
+	pd.Check()
+
+	assert.Equal(t, partition.StatePartitioned, pd.State())
+	assert.False(t, pd.IsInProtectiveMode())
+}
+
+func TestPartitionDetector_CheckShouldSetMetrics(t *testing.T) {
+	t.Parallel()
+
+	metrics := make(map[string]interface{})
+	args := createMockArgs()
+	args.AppStatusHandler = &mock.AppStatusHandlerStub{
+		SetStringValueHandler: func(key string, value string) {
+			metrics[key] = value
+		},
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			metrics[key] = value
+		},
+	}
+	args.Messenger = &mock.ConnectedPeersProviderStub{}
+	pd, _ := partition.NewPartitionDetector(args)
+	defer func() {
+		_ = pd.Close()
+	}()
+
+	pd.Check()
+
+	assert.Equal(t, partition.StateSuspect, metrics[core.MetricPartitionState])
+	assert.Equal(t, uint64(1), metrics[core.MetricPartitionNumSignals])
+	assert.Equal(t, uint64(0), metrics[core.MetricPartitionNumStaleShards])
+	assert.Equal(t, uint64(100), metrics[core.MetricPartitionConsensusParticipation])
+}
@@ -38,6 +38,7 @@ type ArgBaseProcessor struct {
 	DataPool                dataRetriever.PoolsHolder
 	BlockChain              data.ChainHandler
 	StateCheckpointModulus  uint
+	EpochStartProcessingLag uint64
 	BlockSizeThrottler      process.BlockSizeThrottler
 	Indexer                 indexer.Indexer
 	TpsBenchmark            statistics.TPSBenchmark
@@ -64,5 +65,7 @@ type ArgMetaProcessor struct {
 	EpochValidatorInfoCreator    process.EpochStartValidatorInfoCreator
 	EpochSystemSCProcessor       process.EpochStartSystemSCProcessor
 	ValidatorStatisticsProcessor process.ValidatorStatisticsProcessor
+	CrossShardStatistics         statistics.CrossShardStatisticsHandler
 	RewardsV2EnableEpoch         uint32
+	RewardsCheckpointEnableEpoch uint32
 }
@@ -75,6 +75,7 @@ type baseProcessor struct {
 	stateCheckpointModulus uint
 	blockProcessor         blockProcessor
 	txCounter              *transactionCounter
+	epochStartWorkDelayer  *epochStartWorkDelayer
 
 	indexer       indexer.Indexer
 	tpsBenchmark  statistics.TPSBenchmark
@@ -1110,6 +1111,12 @@ func (bp *baseProcessor) RevertAccountState(_ data.HeaderHandler) {
 	}
 }
 
+// PrepareNextProposal prepares ahead of time the data needed for the next block proposal, while the current block is
+// still being finalized. Only the transactions selection is done, as processing requires the state of the current block
+func (bp *baseProcessor) PrepareNextProposal() {
+	bp.txCoordinator.PrepareNextProposal()
+}
+
 func (bp *baseProcessor) commitAll() error {
 	for key := range bp.accountsDB {
 		_, err := bp.accountsDB[key].Commit()
@@ -1205,3 +1205,20 @@ func TestAddHeaderIntoTrackerPool_ShouldWork(t *testing.T) {
 	sp.AddHeaderIntoTrackerPool(nonce, shardID)
 	assert.True(t, wasCalled)
 }
+
+func TestBaseProcessor_PrepareNextProposalShouldCallTheTxCoordinator(t *testing.T) {
+	t.Parallel()
+
+	prepareNextProposalCalled := false
+	arguments := CreateMockArguments()
+	arguments.TxCoordinator = &mock.TransactionCoordinatorMock{
+		PrepareNextProposalCalled: func() {
+			prepareNextProposalCalled = true
+		},
+	}
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	sp.PrepareNextProposal()
+
+	assert.True(t, prepareNextProposalCalled)
+}
@@ -0,0 +1,18 @@
+package disabled
+
+import "github.com/ElrondNetwork/elrond-go/process"
+
+var _ process.ProposalPreparer = (*ProposalPreparer)(nil)
+
+// ProposalPreparer is the disabled implementation, used when the next proposal should not be prepared ahead of time
+type ProposalPreparer struct {
+}
+
+// PrepareNextProposal does nothing
+func (pp *ProposalPreparer) PrepareNextProposal() {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pp *ProposalPreparer) IsInterfaceNil() bool {
+	return pp == nil
+}
@@ -0,0 +1,61 @@
+package block
+
+import (
+	"sync"
+)
+
+// epochStartWorkDelayer postpones the heavy work done after an epoch start block (trie snapshots, pruning, validators
+// rating indexing) until the configured number of rounds passed from the epoch start round. The postponed work is
+// executed in the order it was received, so the epoch start snapshots are always taken before the following pruning
+type epochStartWorkDelayer struct {
+	delayInRounds    uint64
+	mutPendingWork   sync.Mutex
+	executeFromRound uint64
+	pendingWork      []func()
+}
+
+func newEpochStartWorkDelayer(delayInRounds uint64) *epochStartWorkDelayer {
+	return &epochStartWorkDelayer{
+		delayInRounds: delayInRounds,
+		pendingWork:   make([]func(), 0),
+	}
+}
+
+// delayFromRound postpones all the work received until the configured number of rounds pass from the given round
+func (eswd *epochStartWorkDelayer) delayFromRound(epochStartRound uint64) {
+	if eswd.delayInRounds == 0 {
+		return
+	}
+
+	eswd.mutPendingWork.Lock()
+	eswd.executeFromRound = epochStartRound + eswd.delayInRounds
+	eswd.mutPendingWork.Unlock()
+
+	log.Debug("epoch start work delayed",
+		"epoch start round", epochStartRound,
+		"execute from round", epochStartRound+eswd.delayInRounds,
+	)
+}
+
+// execute runs the postponed work followed by the given work if the round is not lower than the one set by the last
+// delay, otherwise the given work is postponed as well
+func (eswd *epochStartWorkDelayer) execute(round uint64, work func()) {
+	eswd.mutPendingWork.Lock()
+	defer eswd.mutPendingWork.Unlock()
+
+	if round < eswd.executeFromRound {
+		eswd.pendingWork = append(eswd.pendingWork, work)
+		return
+	}
+
+	if len(eswd.pendingWork) > 0 {
+		log.Debug("executing delayed epoch start work", "round", round, "num work items", len(eswd.pendingWork))
+	}
+
+	for _, pendingWork := range eswd.pendingWork {
+		pendingWork()
+	}
+	eswd.pendingWork = make([]func(), 0)
+
+	work()
+}
@@ -0,0 +1,69 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEpochStartWorkDelayer_NoDelayShouldExecuteRightAway(t *testing.T) {
+	t.Parallel()
+
+	eswd := newEpochStartWorkDelayer(0)
+	eswd.delayFromRound(10)
+
+	numCalls := 0
+	eswd.execute(10, func() {
+		numCalls++
+	})
+
+	assert.Equal(t, 1, numCalls)
+	assert.Equal(t, 0, eswd.numPendingWork())
+}
+
+func TestEpochStartWorkDelayer_ShouldExecuteRightAwayIfNotDelayed(t *testing.T) {
+	t.Parallel()
+
+	eswd := newEpochStartWorkDelayer(5)
+
+	numCalls := 0
+	eswd.execute(10, func() {
+		numCalls++
+	})
+
+	assert.Equal(t, 1, numCalls)
+	assert.Equal(t, 0, eswd.numPendingWork())
+}
+
+func TestEpochStartWorkDelayer_ShouldPostponeTheWorkAndExecuteItInOrder(t *testing.T) {
+	t.Parallel()
+
+	eswd := newEpochStartWorkDelayer(5)
+	eswd.delayFromRound(10)
+
+	executed := make([]int, 0)
+	eswd.execute(10, func() {
+		executed = append(executed, 1)
+	})
+	eswd.execute(12, func() {
+		executed = append(executed, 2)
+	})
+	eswd.execute(14, func() {
+		executed = append(executed, 3)
+	})
+
+	assert.Equal(t, 0, len(executed))
+	assert.Equal(t, 3, eswd.numPendingWork())
+
+	eswd.execute(15, func() {
+		executed = append(executed, 4)
+	})
+
+	assert.Equal(t, []int{1, 2, 3, 4}, executed)
+	assert.Equal(t, 0, eswd.numPendingWork())
+
+	eswd.execute(16, func() {
+		executed = append(executed, 5)
+	})
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, executed)
+}
@@ -369,3 +369,10 @@ func (mp *metaProcessor) RequestShardHeadersIfNeeded(hdrsAddedForShard map[uint3
 func (bp *baseProcessor) AddHeaderIntoTrackerPool(nonce uint64, shardID uint32) {
 	bp.addHeaderIntoTrackerPool(nonce, shardID)
 }
+
+func (eswd *epochStartWorkDelayer) numPendingWork() int {
+	eswd.mutPendingWork.Lock()
+	defer eswd.mutPendingWork.Unlock()
+
+	return len(eswd.pendingWork)
+}
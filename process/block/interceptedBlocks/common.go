@@ -84,6 +84,16 @@ func checkHeaderHandler(hdr data.HeaderHandler) error {
 	return nil
 }
 
+// checkHeaderShardID rejects the headers of unknown shards before any signature verification is done
+func checkHeaderShardID(hdr data.HeaderHandler, coordinator sharding.Coordinator) error {
+	isWrongShardID := hdr.GetShardID() >= coordinator.NumberOfShards() && hdr.GetShardID() != core.MetachainShardId
+	if isWrongShardID {
+		return process.ErrInvalidShardId
+	}
+
+	return nil
+}
+
 func checkMetaShardInfo(shardInfo []block.ShardData, coordinator sharding.Coordinator) error {
 	for _, sd := range shardInfo {
 		if sd.ShardID >= coordinator.NumberOfShards() && sd.ShardID != core.MetachainShardId {
@@ -116,6 +116,11 @@ func (inHdr *InterceptedHeader) isEpochCorrect() bool {
 
 // integrity checks the integrity of the header block wrapper
 func (inHdr *InterceptedHeader) integrity() error {
+	err := checkHeaderShardID(inHdr.HeaderHandler(), inHdr.shardCoordinator)
+	if err != nil {
+		return err
+	}
+
 	if !inHdr.isEpochCorrect() {
 		return fmt.Errorf("%w : shard header with old epoch and bad round: "+
 			"shardHeaderHash=%s, "+
@@ -133,7 +138,7 @@ func (inHdr *InterceptedHeader) integrity() error {
 			inHdr.epochStartTrigger.EpochFinalityAttestingRound())
 	}
 
-	err := checkHeaderHandler(inHdr.HeaderHandler())
+	err = checkHeaderHandler(inHdr.HeaderHandler())
 	if err != nil {
 		return err
 	}
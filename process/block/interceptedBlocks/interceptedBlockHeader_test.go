@@ -23,7 +23,7 @@ var hdrEpoch = uint32(78)
 
 func createDefaultShardArgument() *interceptedBlocks.ArgInterceptedBlockHeader {
 	arg := &interceptedBlocks.ArgInterceptedBlockHeader{
-		ShardCoordinator:        mock.NewOneShardCoordinatorMock(),
+		ShardCoordinator:        mock.NewMultipleShardsCoordinatorMock(),
 		Hasher:                  testHasher,
 		Marshalizer:             testMarshalizer,
 		HeaderSigVerifier:       &mock.HeaderSigVerifierStub{},
@@ -225,6 +225,28 @@ func TestInterceptedHeader_ErrorInMiniBlockShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrInvalidShardId, err)
 }
 
+func TestInterceptedHeader_CheckValidityUnknownShardShouldErrBeforeVerifyingSignatures(t *testing.T) {
+	t.Parallel()
+
+	hdr := createMockShardHeader()
+	hdr.ShardID = 2
+	buff, _ := testMarshalizer.Marshal(hdr)
+
+	arg := createDefaultShardArgument()
+	arg.HdrBuff = buff
+	arg.HeaderSigVerifier = &mock.HeaderSigVerifierStub{
+		VerifyRandSeedAndLeaderSignatureCalled: func(header data.HeaderHandler) error {
+			assert.Fail(t, "should have not verified the signatures")
+			return nil
+		},
+	}
+	inHdr, _ := interceptedBlocks.NewInterceptedHeader(arg)
+
+	err := inHdr.CheckValidity()
+
+	assert.Equal(t, process.ErrInvalidShardId, err)
+}
+
 func TestInterceptedHeader_CheckValidityShouldWork(t *testing.T) {
 	t.Parallel()
 
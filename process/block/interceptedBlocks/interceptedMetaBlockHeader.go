@@ -79,9 +79,15 @@ func (imh *InterceptedMetaHeader) HeaderHandler() data.HeaderHandler {
 	return imh.hdr
 }
 
-// CheckValidity checks if the received meta header is valid (not nil fields, valid sig and so on)
+// CheckValidity checks if the received meta header is valid (not nil fields, valid sig and so on). The cheap
+// structural checks are done first, so the garbage headers are rejected before any signature verification
 func (imh *InterceptedMetaHeader) CheckValidity() error {
-	err := imh.integrity()
+	err := imh.integrityVerifier.Verify(imh.hdr)
+	if err != nil {
+		return err
+	}
+
+	err = imh.integrity()
 	if err != nil {
 		return err
 	}
@@ -103,12 +109,7 @@ func (imh *InterceptedMetaHeader) CheckValidity() error {
 		return err
 	}
 
-	err = imh.sigVerifier.VerifySignature(imh.hdr)
-	if err != nil {
-		return err
-	}
-
-	return imh.integrityVerifier.Verify(imh.hdr)
+	return imh.sigVerifier.VerifySignature(imh.hdr)
 }
 
 // integrity checks the integrity of the meta header block wrapper
@@ -220,6 +220,28 @@ func TestInterceptedMetaHeader_CheckValidityLeaderSignatureOkShouldWork(t *testi
 	assert.Nil(t, err)
 }
 
+func TestInterceptedMetaHeader_CheckValidityIntegrityFailsShouldErrBeforeVerifyingSignatures(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected err")
+	arg := createDefaultMetaArgument()
+	arg.HeaderIntegrityVerifier = &mock.HeaderIntegrityVerifierStub{
+		VerifyCalled: func(header data.HeaderHandler) error {
+			return expectedErr
+		},
+	}
+	arg.HeaderSigVerifier = &mock.HeaderSigVerifierStub{
+		VerifyRandSeedAndLeaderSignatureCalled: func(header data.HeaderHandler) error {
+			assert.Fail(t, "should have not verified the signatures")
+			return nil
+		},
+	}
+	inHdr, _ := interceptedBlocks.NewInterceptedMetaHeader(arg)
+
+	err := inHdr.CheckValidity()
+	assert.Equal(t, expectedErr, err)
+}
+
 //------- IsInterfaceNil
 
 func TestInterceptedMetaHeader_IsInterfaceNil(t *testing.T) {
@@ -112,13 +112,22 @@ func (inMb *InterceptedMiniblock) integrity() error {
 		}
 	}
 
-	if len(miniblock.Reserved) > 0 {
+	if len(miniblock.Reserved) > 0 && !isChargingEpochReserved(miniblock) {
 		return process.ErrReservedFieldNotSupportedYet
 	}
 
 	return nil
 }
 
+// isChargingEpochReserved returns true if the reserved field of the miniblock holds the charging epoch of its
+// transactions, which is carried only by the cross shard transactions and smart contract results miniblocks
+func isChargingEpochReserved(miniblock *block.MiniBlock) bool {
+	isCrossShard := miniblock.SenderShardID != miniblock.ReceiverShardID
+	canCarryChargingEpoch := miniblock.Type == block.TxBlock || miniblock.Type == block.SmartContractResultBlock
+
+	return isCrossShard && canCarryChargingEpoch && len(miniblock.Reserved) == process.ChargingEpochLength
+}
+
 // Type returns the type of this intercepted data
 func (inMb *InterceptedMiniblock) Type() string {
 	return "intercepted miniblock"
@@ -3,6 +3,7 @@ package interceptedBlocks_test
 import (
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -137,6 +138,37 @@ func TestInterceptedMiniblock_ReservedPopulateShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrReservedFieldNotSupportedYet, err)
 }
 
+func TestInterceptedMiniblock_ReservedChargingEpochOnCrossShardMiniblockShouldWork(t *testing.T) {
+	t.Parallel()
+
+	checkValidity := func(mb *block.MiniBlock) error {
+		arg := createDefaultMiniblockArgument()
+		arg.MiniblockBuff, _ = testMarshalizer.Marshal(mb)
+		inMb, _ := interceptedBlocks.NewInterceptedMiniblock(arg)
+
+		return inMb.CheckValidity()
+	}
+
+	mb := createMockMiniblock()
+	mb.ReceiverShardID = core.MetachainShardId
+	mb.Reserved = []byte{0, 0, 0, 1}
+	assert.Nil(t, checkValidity(mb))
+
+	mb.Type = block.SmartContractResultBlock
+	assert.Nil(t, checkValidity(mb))
+
+	mb.Type = block.PeerBlock
+	assert.Equal(t, process.ErrReservedFieldNotSupportedYet, checkValidity(mb))
+
+	mb.Type = block.TxBlock
+	mb.Reserved = []byte{0, 0, 1}
+	assert.Equal(t, process.ErrReservedFieldNotSupportedYet, checkValidity(mb))
+
+	mb.Reserved = []byte{0, 0, 0, 1}
+	mb.ReceiverShardID = 0
+	assert.Equal(t, process.ErrReservedFieldNotSupportedYet, checkValidity(mb))
+}
+
 func TestInterceptedMiniblock_ShouldWork(t *testing.T) {
 	t.Parallel()
 
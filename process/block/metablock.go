@@ -12,10 +12,12 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/block/processedMb"
@@ -23,6 +25,7 @@ import (
 )
 
 var _ process.BlockProcessor = (*metaProcessor)(nil)
+var _ process.ProposalPreparer = (*metaProcessor)(nil)
 
 // metaProcessor implements metaProcessor interface and actually it tries to execute block
 type metaProcessor struct {
@@ -35,11 +38,13 @@ type metaProcessor struct {
 	epochSystemSCProcessor       process.EpochStartSystemSCProcessor
 	pendingMiniBlocksHandler     process.PendingMiniBlocksHandler
 	validatorStatisticsProcessor process.ValidatorStatisticsProcessor
+	crossShardStatistics         statistics.CrossShardStatisticsHandler
 	shardsHeadersNonce           *sync.Map
 	shardBlockFinality           uint32
 	chRcvAllHdrs                 chan bool
 	headersCounter               *headersCounter
 	rewardsV2EnableEpoch         uint32
+	rewardsCheckpointEnableEpoch uint32
 }
 
 // NewMetaProcessor creates a new metaProcessor object
@@ -78,6 +83,9 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 	if check.IfNil(arguments.EpochSystemSCProcessor) {
 		return nil, process.ErrNilEpochStartSystemSCProcessor
 	}
+	if check.IfNil(arguments.CrossShardStatistics) {
+		return nil, process.ErrNilCrossShardStatistics
+	}
 
 	genesisHdr := arguments.BlockChain.GetGenesisHeader()
 	base := &baseProcessor{
@@ -103,6 +111,7 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		dataPool:                arguments.DataPool,
 		blockChain:              arguments.BlockChain,
 		stateCheckpointModulus:  arguments.StateCheckpointModulus,
+		epochStartWorkDelayer:   newEpochStartWorkDelayer(arguments.EpochStartProcessingLag),
 		indexer:                 arguments.Indexer,
 		tpsBenchmark:            arguments.TpsBenchmark,
 		genesisNonce:            genesisHdr.GetNonce(),
@@ -122,7 +131,9 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		validatorStatisticsProcessor: arguments.ValidatorStatisticsProcessor,
 		validatorInfoCreator:         arguments.EpochValidatorInfoCreator,
 		epochSystemSCProcessor:       arguments.EpochSystemSCProcessor,
+		crossShardStatistics:         arguments.CrossShardStatistics,
 		rewardsV2EnableEpoch:         arguments.RewardsV2EnableEpoch,
+		rewardsCheckpointEnableEpoch: arguments.RewardsCheckpointEnableEpoch,
 	}
 
 	mp.txCounter = NewTransactionCounter()
@@ -147,6 +158,22 @@ func (mp *metaProcessor) isRewardsV2Enabled(headerHandler data.HeaderHandler) bo
 	return headerHandler.GetEpoch() >= mp.rewardsV2EnableEpoch
 }
 
+func (mp *metaProcessor) isRewardsCheckpointEnabled(headerHandler data.HeaderHandler) bool {
+	return headerHandler.GetEpoch() >= mp.rewardsCheckpointEnableEpoch
+}
+
+// computeRewardsRootHash computes the merkle root hash of the rewards earned by each address from the provided
+// rewards mini blocks, committed in the start of epoch meta block so that the rewards can be verified by third parties
+func (mp *metaProcessor) computeRewardsRootHash(metaBlock *block.MetaBlock, miniBlocks block.MiniBlockSlice) ([]byte, error) {
+	rewardsTxs := mp.epochRewardsCreator.GetRewardsTxs(&block.Body{MiniBlocks: miniBlocks})
+	checkpoint, err := epochStart.NewRewardsCheckpoint(metaBlock.GetEpoch(), rewardsTxs, mp.hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkpoint.RootHash, nil
+}
+
 // ProcessBlock processes a block. It returns nil if all ok or the specific error
 func (mp *metaProcessor) ProcessBlock(
 	headerHandler data.HeaderHandler,
@@ -410,6 +437,13 @@ func (mp *metaProcessor) processEpochStartMetaBlock(
 		return err
 	}
 
+	if mp.isRewardsCheckpointEnabled(header) {
+		computedEconomics.RewardsRootHash, err = mp.computeRewardsRootHash(header, body.MiniBlocks)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = mp.epochEconomics.VerifyRewardsPerBlock(header, mp.epochRewardsCreator.GetProtocolSustainabilityRewards(), computedEconomics)
 	if err != nil {
 		return err
@@ -612,7 +646,14 @@ func (mp *metaProcessor) indexBlock(
 		return
 	}
 
-	indexValidatorsRating(mp.indexer, mp.validatorStatisticsProcessor, metaBlock)
+	validatorsRootHash, err := mp.validatorStatisticsProcessor.RootHash()
+	if err != nil {
+		return
+	}
+
+	mp.epochStartWorkDelayer.execute(metaBlock.GetRound(), func() {
+		indexValidatorsRating(mp.indexer, mp.validatorStatisticsProcessor, validatorsRootHash, metaBlock)
+	})
 }
 
 // RestoreBlockIntoPools restores the block into associated pools
@@ -842,6 +883,12 @@ func (mp *metaProcessor) createEpochStartBody(metaBlock *block.MetaBlock) (data.
 	}
 
 	metaBlock.EpochStart.Economics.RewardsForProtocolSustainability.Set(mp.epochRewardsCreator.GetProtocolSustainabilityRewards())
+	if mp.isRewardsCheckpointEnabled(metaBlock) {
+		metaBlock.EpochStart.Economics.RewardsRootHash, err = mp.computeRewardsRootHash(metaBlock, rewardMiniBlocks)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	err = mp.epochSystemSCProcessor.ProcessDelegationRewards(rewardMiniBlocks, mp.epochRewardsCreator.GetLocalTxCache())
 	if err != nil {
@@ -1185,6 +1232,10 @@ func (mp *metaProcessor) CommitBlock(
 	lastMetaBlock := mp.blockChain.GetCurrentBlockHeader()
 	mp.updateState(lastMetaBlock)
 
+	if header.IsStartOfEpochBlock() {
+		mp.epochStartWorkDelayer.delayFromRound(header.GetRound())
+	}
+
 	err = mp.blockChain.SetCurrentBlockHeader(header)
 	if err != nil {
 		return err
@@ -1197,6 +1248,7 @@ func (mp *metaProcessor) CommitBlock(
 	}
 
 	mp.tpsBenchmark.Update(lastMetaBlock)
+	mp.crossShardStatistics.Update(header)
 
 	mp.indexBlock(header, headerHash, body, lastMetaBlock, notarizedHeadersHashes, rewardsTxs)
 	mp.recordBlockInHistory(headerHash, headerHandler, bodyHandler)
@@ -1337,26 +1389,28 @@ func (mp *metaProcessor) updateState(lastMetaBlock data.HeaderHandler) {
 		return
 	}
 
-	if lastMetaBlock.IsStartOfEpochBlock() {
-		log.Debug("trie snapshot", "rootHash", lastMetaBlock.GetRootHash())
-		ctx := context.Background()
-		mp.accountsDB[state.UserAccountsState].SnapshotState(lastMetaBlock.GetRootHash(), ctx)
-		mp.accountsDB[state.PeerAccountsState].SnapshotState(lastMetaBlock.GetValidatorStatsRootHash(), ctx)
-	}
+	mp.epochStartWorkDelayer.execute(lastMetaBlock.GetRound(), func() {
+		if lastMetaBlock.IsStartOfEpochBlock() {
+			log.Debug("trie snapshot", "rootHash", lastMetaBlock.GetRootHash())
+			ctx := context.Background()
+			mp.accountsDB[state.UserAccountsState].SnapshotState(lastMetaBlock.GetRootHash(), ctx)
+			mp.accountsDB[state.PeerAccountsState].SnapshotState(lastMetaBlock.GetValidatorStatsRootHash(), ctx)
+		}
 
-	mp.updateStateStorage(
-		lastMetaBlock,
-		lastMetaBlock.GetRootHash(),
-		prevHeader.GetRootHash(),
-		mp.accountsDB[state.UserAccountsState],
-	)
+		mp.updateStateStorage(
+			lastMetaBlock,
+			lastMetaBlock.GetRootHash(),
+			prevHeader.GetRootHash(),
+			mp.accountsDB[state.UserAccountsState],
+		)
 
-	mp.updateStateStorage(
-		lastMetaBlock,
-		lastMetaBlock.GetValidatorStatsRootHash(),
-		prevHeader.GetValidatorStatsRootHash(),
-		mp.accountsDB[state.PeerAccountsState],
-	)
+		mp.updateStateStorage(
+			lastMetaBlock,
+			lastMetaBlock.GetValidatorStatsRootHash(),
+			prevHeader.GetValidatorStatsRootHash(),
+			mp.accountsDB[state.PeerAccountsState],
+		)
+	})
 }
 
 func (mp *metaProcessor) getLastSelfNotarizedHeaderByShard(
@@ -89,6 +89,7 @@ func createMockMetaArguments() blproc.ArgMetaProcessor {
 		EpochValidatorInfoCreator:    &mock.EpochValidatorInfoCreatorStub{},
 		ValidatorStatisticsProcessor: &mock.ValidatorStatisticsProcessorStub{},
 		EpochSystemSCProcessor:       &mock.EpochStartSystemSCStub{},
+		CrossShardStatistics:         &testscommon.CrossShardStatisticsStub{},
 	}
 	return arguments
 }
@@ -328,6 +329,17 @@ func TestNewMetaProcessor_NilBlockSizeThrottlerShouldErr(t *testing.T) {
 	assert.Nil(t, be)
 }
 
+func TestNewMetaProcessor_NilCrossShardStatisticsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.CrossShardStatistics = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilCrossShardStatistics, err)
+	assert.Nil(t, be)
+}
+
 func TestNewMetaProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
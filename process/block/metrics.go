@@ -252,15 +252,11 @@ func indexRoundInfo(
 func indexValidatorsRating(
 	indexerHandler indexer.Indexer,
 	valStatProc process.ValidatorStatisticsProcessor,
+	validatorsRootHash []byte,
 	metaBlock data.HeaderHandler,
 ) {
 	// TODO use validatorInfoProvider  to get information about rating
-	latestHash, err := valStatProc.RootHash()
-	if err != nil {
-		return
-	}
-
-	validators, err := valStatProc.GetValidatorInfoForRootHash(latestHash)
+	validators, err := valStatProc.GetValidatorInfoForRootHash(validatorsRootHash)
 	if err != nil {
 		return
 	}
@@ -2,6 +2,8 @@ package postprocess
 
 import (
 	"bytes"
+	"encoding/hex"
+	"fmt"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
@@ -26,6 +28,7 @@ type txShardInfo struct {
 type txInfo struct {
 	tx data.TransactionHandler
 	*txShardInfo
+	chargingEpoch uint32
 }
 
 var log = logger.GetOrCreate("process/block/postprocess")
@@ -112,8 +115,13 @@ func (bpp *basePostProcessor) GetAllCurrentFinishedTxs() map[string]data.Transac
 	return scrPool
 }
 
-func (bpp *basePostProcessor) verifyMiniBlock(createMBs map[uint32]*block.MiniBlock, mb *block.MiniBlock) error {
-	createdScrMb, ok := createMBs[mb.ReceiverShardID]
+// createdMiniBlockKey identifies a created miniblock by its receiver shard and by the charging epoch it carries, if any
+func createdMiniBlockKey(miniBlock *block.MiniBlock) string {
+	return fmt.Sprintf("%d_%s", miniBlock.ReceiverShardID, hex.EncodeToString(miniBlock.Reserved))
+}
+
+func (bpp *basePostProcessor) verifyMiniBlock(createMBs map[string]*block.MiniBlock, mb *block.MiniBlock) error {
+	createdScrMb, ok := createMBs[createdMiniBlockKey(mb)]
 	if !ok {
 		log.Debug("missing miniblock", "type", mb.Type, "sender", mb.SenderShardID, "receiver", mb.ReceiverShardID, "numTxs", len(mb.TxHashes))
 		return process.ErrNilMiniBlocks
@@ -2,6 +2,7 @@ package postprocess
 
 import (
 	"bytes"
+	"fmt"
 	"sort"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -21,9 +22,10 @@ import (
 var _ process.IntermediateTransactionHandler = (*intermediateResultsProcessor)(nil)
 
 type intermediateResultsProcessor struct {
-	pubkeyConv core.PubkeyConverter
-	blockType  block.Type
-	currTxs    dataRetriever.TransactionCacher
+	pubkeyConv           core.PubkeyConverter
+	blockType            block.Type
+	currTxs              dataRetriever.TransactionCacher
+	chargingEpochHandler process.ChargingEpochHandler
 
 	*basePostProcessor
 }
@@ -37,6 +39,7 @@ func NewIntermediateResultsProcessor(
 	store dataRetriever.StorageService,
 	blockType block.Type,
 	currTxs dataRetriever.TransactionCacher,
+	chargingEpochHandler process.ChargingEpochHandler,
 ) (*intermediateResultsProcessor, error) {
 	if check.IfNil(hasher) {
 		return nil, process.ErrNilHasher
@@ -56,6 +59,9 @@ func NewIntermediateResultsProcessor(
 	if check.IfNil(currTxs) {
 		return nil, process.ErrNilTxForCurrentBlockHandler
 	}
+	if check.IfNil(chargingEpochHandler) {
+		return nil, process.ErrNilChargingEpochHandler
+	}
 
 	base := &basePostProcessor{
 		hasher:           hasher,
@@ -67,10 +73,11 @@ func NewIntermediateResultsProcessor(
 	}
 
 	irp := &intermediateResultsProcessor{
-		basePostProcessor: base,
-		pubkeyConv:        pubkeyConv,
-		blockType:         blockType,
-		currTxs:           currTxs,
+		basePostProcessor:    base,
+		pubkeyConv:           pubkeyConv,
+		blockType:            blockType,
+		currTxs:              currTxs,
+		chargingEpochHandler: chargingEpochHandler,
 	}
 
 	irp.interResultsForBlock = make(map[string]*txInfo)
@@ -81,14 +88,14 @@ func NewIntermediateResultsProcessor(
 // GetNumOfCrossInterMbsAndTxs returns the number of cross shard miniblocks and transactions for the current round,
 // created from the smart contract results
 func (irp *intermediateResultsProcessor) GetNumOfCrossInterMbsAndTxs() (int, int) {
-	miniBlocks := make(map[uint32]int)
+	miniBlocks := make(map[string]int)
 
 	irp.mutInterResultsForBlock.Lock()
 	for _, tx := range irp.interResultsForBlock {
 		if tx.receiverShardID == irp.shardCoordinator.SelfId() {
 			continue
 		}
-		miniBlocks[tx.receiverShardID]++
+		miniBlocks[irp.miniBlockKey(tx)]++
 	}
 	irp.mutInterResultsForBlock.Unlock()
 
@@ -101,50 +108,68 @@ func (irp *intermediateResultsProcessor) GetNumOfCrossInterMbsAndTxs() (int, int
 	return numMbs, numTxs
 }
 
+// miniBlockKey returns the key of the miniblock holding the provided result: the results going to another shard are
+// grouped by the epoch in which their gas was charged, once the miniblocks carry the charging epoch
+func (irp *intermediateResultsProcessor) miniBlockKey(result *txInfo) string {
+	isCrossShard := result.receiverShardID != irp.shardCoordinator.SelfId()
+	if isCrossShard && irp.chargingEpochHandler.IsChargingEpochCarried() {
+		return fmt.Sprintf("%d_%d", result.receiverShardID, result.chargingEpoch)
+	}
+
+	return fmt.Sprintf("%d", result.receiverShardID)
+}
+
 // CreateAllInterMiniBlocks returns the miniblocks for the current round created from the smart contract results
 func (irp *intermediateResultsProcessor) CreateAllInterMiniBlocks() []*block.MiniBlock {
-	miniBlocks := make(map[uint32]*block.MiniBlock, int(irp.shardCoordinator.NumberOfShards())+1)
-	for i := uint32(0); i < irp.shardCoordinator.NumberOfShards(); i++ {
-		miniBlocks[i] = &block.MiniBlock{}
-	}
-	miniBlocks[core.MetachainShardId] = &block.MiniBlock{}
+	miniBlocks := make(map[string]*block.MiniBlock, int(irp.shardCoordinator.NumberOfShards())+1)
 
 	irp.currTxs.Clean()
 	irp.mutInterResultsForBlock.Lock()
 
 	for key, value := range irp.interResultsForBlock {
-		recvShId := value.receiverShardID
-		miniBlocks[recvShId].TxHashes = append(miniBlocks[recvShId].TxHashes, []byte(key))
+		miniBlockKey := irp.miniBlockKey(value)
+		miniBlock, ok := miniBlocks[miniBlockKey]
+		if !ok {
+			miniBlock = &block.MiniBlock{
+				SenderShardID:   irp.shardCoordinator.SelfId(),
+				ReceiverShardID: value.receiverShardID,
+				Type:            irp.blockType,
+			}
+			if value.receiverShardID != irp.shardCoordinator.SelfId() {
+				irp.chargingEpochHandler.SaveChargingEpoch(miniBlock, value.chargingEpoch)
+			}
+			miniBlocks[miniBlockKey] = miniBlock
+		}
+
+		miniBlock.TxHashes = append(miniBlock.TxHashes, []byte(key))
 		irp.currTxs.AddTx([]byte(key), value.tx)
 	}
 
 	finalMBs := make([]*block.MiniBlock, 0)
-	for shId, miniblock := range miniBlocks {
-		if len(miniblock.TxHashes) > 0 {
-			miniblock.SenderShardID = irp.shardCoordinator.SelfId()
-			miniblock.ReceiverShardID = shId
-			miniblock.Type = irp.blockType
-
-			sort.Slice(miniblock.TxHashes, func(a, b int) bool {
-				return bytes.Compare(miniblock.TxHashes[a], miniblock.TxHashes[b]) < 0
-			})
-
-			log.Trace("intermediateResultsProcessor.CreateAllInterMiniBlocks",
-				"type", miniblock.Type,
-				"senderShardID", miniblock.SenderShardID,
-				"receiverShardID", miniblock.ReceiverShardID,
-				"numTxs", len(miniblock.TxHashes),
-			)
-
-			finalMBs = append(finalMBs, miniblock)
-
-			if miniblock.ReceiverShardID == irp.shardCoordinator.SelfId() {
-				irp.intraShardMiniBlock = miniblock.Clone()
-			}
+	for _, miniblock := range miniBlocks {
+		sort.Slice(miniblock.TxHashes, func(a, b int) bool {
+			return bytes.Compare(miniblock.TxHashes[a], miniblock.TxHashes[b]) < 0
+		})
+
+		log.Trace("intermediateResultsProcessor.CreateAllInterMiniBlocks",
+			"type", miniblock.Type,
+			"senderShardID", miniblock.SenderShardID,
+			"receiverShardID", miniblock.ReceiverShardID,
+			"chargingEpoch", miniblock.Reserved,
+			"numTxs", len(miniblock.TxHashes),
+		)
+
+		finalMBs = append(finalMBs, miniblock)
+
+		if miniblock.ReceiverShardID == irp.shardCoordinator.SelfId() {
+			irp.intraShardMiniBlock = miniblock.Clone()
 		}
 	}
 
 	sort.Slice(finalMBs, func(i, j int) bool {
+		if finalMBs[i].ReceiverShardID == finalMBs[j].ReceiverShardID {
+			return bytes.Compare(finalMBs[i].Reserved, finalMBs[j].Reserved) < 0
+		}
 		return finalMBs[i].ReceiverShardID < finalMBs[j].ReceiverShardID
 	})
 
@@ -156,9 +181,9 @@ func (irp *intermediateResultsProcessor) CreateAllInterMiniBlocks() []*block.Min
 // VerifyInterMiniBlocks verifies if the smart contract results added to the block are valid
 func (irp *intermediateResultsProcessor) VerifyInterMiniBlocks(body *block.Body) error {
 	scrMbs := irp.CreateAllInterMiniBlocks()
-	createdMapMbs := make(map[uint32]*block.MiniBlock)
+	createdMapMbs := make(map[string]*block.MiniBlock)
 	for _, mb := range scrMbs {
-		createdMapMbs[mb.ReceiverShardID] = mb
+		createdMapMbs[createdMiniBlockKey(mb)] = mb
 	}
 
 	countedCrossShard := 0
@@ -230,7 +255,7 @@ func (irp *intermediateResultsProcessor) AddIntermediateTransactions(txs []data.
 		sndShId, dstShId := irp.getShardIdsFromAddresses(addScr.SndAddr, addScr.RcvAddr)
 
 		addScrShardInfo := &txShardInfo{receiverShardID: dstShId, senderShardID: sndShId}
-		scrInfo := &txInfo{tx: addScr, txShardInfo: addScrShardInfo}
+		scrInfo := &txInfo{tx: addScr, txShardInfo: addScrShardInfo, chargingEpoch: irp.chargingEpochHandler.ChargingEpoch()}
 		irp.interResultsForBlock[string(scrHash)] = scrInfo
 		irp.mapTxToResult[string(addScr.PrevTxHash)] = append(irp.mapTxToResult[string(addScr.PrevTxHash)], string(scrHash))
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/stretchr/testify/assert"
 )
@@ -33,6 +34,7 @@ func TestNewIntermediateResultsProcessor_NilHashes(t *testing.T) {
 		&mock.ChainStorerMock{},
 		block.TxBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, irp)
@@ -50,6 +52,7 @@ func TestNewIntermediateResultsProcessor_NilMarshalizer(t *testing.T) {
 		&mock.ChainStorerMock{},
 		block.TxBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, irp)
@@ -67,6 +70,7 @@ func TestNewIntermediateResultsProcessor_NilShardCoordinator(t *testing.T) {
 		&mock.ChainStorerMock{},
 		block.TxBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, irp)
@@ -84,6 +88,7 @@ func TestNewIntermediateResultsProcessor_NilPubkeyConverter(t *testing.T) {
 		&mock.ChainStorerMock{},
 		block.TxBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, irp)
@@ -101,12 +106,31 @@ func TestNewIntermediateResultsProcessor_NilStorer(t *testing.T) {
 		nil,
 		block.TxBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, irp)
 	assert.Equal(t, process.ErrNilStorage, err)
 }
 
+func TestNewIntermediateResultsProcessor_NilChargingEpochHandler(t *testing.T) {
+	t.Parallel()
+
+	irp, err := NewIntermediateResultsProcessor(
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		createMockPubkeyConverter(),
+		&mock.ChainStorerMock{},
+		block.TxBlock,
+		&mock.TxForCurrentBlockStub{},
+		nil,
+	)
+
+	assert.Nil(t, irp)
+	assert.Equal(t, process.ErrNilChargingEpochHandler, err)
+}
+
 func TestNewIntermediateResultsProcessor_Good(t *testing.T) {
 	t.Parallel()
 
@@ -118,6 +142,7 @@ func TestNewIntermediateResultsProcessor_Good(t *testing.T) {
 		&mock.ChainStorerMock{},
 		block.TxBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.NotNil(t, irp)
@@ -136,6 +161,7 @@ func TestIntermediateResultsProcessor_getShardIdsFromAddressesGood(t *testing.T)
 		&mock.ChainStorerMock{},
 		block.SmartContractResultBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.NotNil(t, irp)
@@ -160,6 +186,7 @@ func TestIntermediateResultsProcessor_AddIntermediateTransactions(t *testing.T)
 		&mock.ChainStorerMock{},
 		block.SmartContractResultBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.NotNil(t, irp)
@@ -181,6 +208,7 @@ func TestIntermediateResultsProcessor_AddIntermediateTransactionsWrongType(t *te
 		&mock.ChainStorerMock{},
 		block.SmartContractResultBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.NotNil(t, irp)
@@ -205,6 +233,7 @@ func TestIntermediateResultsProcessor_AddIntermediateTransactionsNegativeValueIn
 		&mock.ChainStorerMock{},
 		block.SmartContractResultBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.NotNil(t, irp)
@@ -244,6 +273,7 @@ func TestIntermediateResultsProcessor_AddIntermediateTransactionsAddrGood(t *tes
 		&mock.ChainStorerMock{},
 		block.SmartContractResultBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.NotNil(t, irp)
@@ -273,6 +303,7 @@ func TestIntermediateResultsProcessor_AddIntermediateTransactionsAddAndRevert(t
 		&mock.ChainStorerMock{},
 		block.SmartContractResultBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.NotNil(t, irp)
@@ -311,6 +342,7 @@ func TestIntermediateResultsProcessor_CreateAllInterMiniBlocksNothingInCache(t *
 		&mock.ChainStorerMock{},
 		block.SmartContractResultBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.NotNil(t, irp)
@@ -332,6 +364,7 @@ func TestIntermediateResultsProcessor_CreateAllInterMiniBlocksNotCrossShard(t *t
 		&mock.ChainStorerMock{},
 		block.SmartContractResultBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.NotNil(t, irp)
@@ -365,6 +398,7 @@ func TestIntermediateResultsProcessor_CreateAllInterMiniBlocksCrossShard(t *test
 		&mock.ChainStorerMock{},
 		block.SmartContractResultBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.NotNil(t, irp)
@@ -399,6 +433,62 @@ func TestIntermediateResultsProcessor_CreateAllInterMiniBlocksCrossShard(t *test
 	assert.Equal(t, 5, len(miniBlockTest.TxHashes))
 }
 
+func TestIntermediateResultsProcessor_CreateAllInterMiniBlocksCrossShardShouldGroupByChargingEpoch(t *testing.T) {
+	t.Parallel()
+
+	nrShards := 5
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(uint32(nrShards))
+	chargingEpochHandler, _ := economics.NewChargingEpochHolder(0, &mock.EpochNotifierStub{})
+	irp, _ := NewIntermediateResultsProcessor(
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		shardCoordinator,
+		createMockPubkeyConverter(),
+		&mock.ChainStorerMock{},
+		block.SmartContractResultBlock,
+		&mock.TxForCurrentBlockStub{},
+		chargingEpochHandler,
+	)
+
+	snd := []byte("snd")
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, snd) {
+			return shardCoordinator.SelfId()
+		}
+		return shardCoordinator.SelfId() + 1
+	}
+
+	_ = chargingEpochHandler.SetChargingEpochFromMiniBlock(&block.MiniBlock{Reserved: []byte{0, 0, 0, 3}})
+	err := irp.AddIntermediateTransactions([]data.TransactionHandler{
+		&smartContractResult.SmartContractResult{SndAddr: snd, RcvAddr: []byte("recvaddr1"), Value: big.NewInt(0), PrevTxHash: []byte("txHash1")},
+		&smartContractResult.SmartContractResult{SndAddr: snd, RcvAddr: []byte("recvaddr2"), Value: big.NewInt(0), PrevTxHash: []byte("txHash1")},
+	})
+	assert.Nil(t, err)
+
+	chargingEpochHandler.ResetChargingEpoch()
+	err = irp.AddIntermediateTransactions([]data.TransactionHandler{
+		&smartContractResult.SmartContractResult{SndAddr: snd, RcvAddr: []byte("recvaddr3"), Value: big.NewInt(0), PrevTxHash: []byte("txHash2")},
+	})
+	assert.Nil(t, err)
+
+	numMbs, numTxs := irp.GetNumOfCrossInterMbsAndTxs()
+	assert.Equal(t, 2, numMbs)
+	assert.Equal(t, 3, numTxs)
+
+	mbs := irp.CreateAllInterMiniBlocks()
+	assert.Equal(t, 2, len(mbs))
+	assert.Equal(t, []byte{0, 0, 0, 0}, mbs[0].Reserved)
+	assert.Equal(t, 1, len(mbs[0].TxHashes))
+	assert.Equal(t, []byte{0, 0, 0, 3}, mbs[1].Reserved)
+	assert.Equal(t, 2, len(mbs[1].TxHashes))
+
+	err = irp.VerifyInterMiniBlocks(&block.Body{MiniBlocks: mbs})
+	assert.Nil(t, err)
+
+	err = irp.VerifyInterMiniBlocks(&block.Body{MiniBlocks: []*block.MiniBlock{mbs[1]}})
+	assert.Equal(t, process.ErrMiniBlockNumMissMatch, err)
+}
+
 func TestIntermediateResultsProcessor_GetNumOfCrossInterMbsAndTxsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -423,6 +513,7 @@ func TestIntermediateResultsProcessor_GetNumOfCrossInterMbsAndTxsShouldWork(t *t
 		&mock.ChainStorerMock{},
 		block.SmartContractResultBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	txs := make([]data.TransactionHandler, 0)
@@ -457,6 +548,7 @@ func TestIntermediateResultsProcessor_VerifyInterMiniBlocksNilBody(t *testing.T)
 		&mock.ChainStorerMock{},
 		block.SmartContractResultBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.NotNil(t, irp)
@@ -480,6 +572,7 @@ func TestIntermediateResultsProcessor_VerifyInterMiniBlocksBodyShouldpassAsNotCr
 		&mock.ChainStorerMock{},
 		block.SmartContractResultBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.NotNil(t, irp)
@@ -508,6 +601,7 @@ func TestIntermediateResultsProcessor_VerifyInterMiniBlocksBodyMissingMiniblock(
 		&mock.ChainStorerMock{},
 		block.SmartContractResultBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.NotNil(t, irp)
@@ -534,6 +628,7 @@ func TestIntermediateResultsProcessor_VerifyInterMiniBlocksBodyMiniBlockMissmatc
 		&mock.ChainStorerMock{},
 		block.SmartContractResultBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.NotNil(t, irp)
@@ -579,6 +674,7 @@ func TestIntermediateResultsProcessor_VerifyInterMiniBlocksBodyShouldPass(t *tes
 		&mock.ChainStorerMock{},
 		block.SmartContractResultBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.NotNil(t, irp)
@@ -645,6 +741,7 @@ func TestIntermediateResultsProcessor_SaveCurrentIntermediateTxToStorageShouldSa
 		},
 		block.SmartContractResultBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.NotNil(t, irp)
@@ -689,6 +786,7 @@ func TestIntermediateResultsProcessor_CreateMarshalizedDataNothingToMarshal(t *t
 		&mock.ChainStorerMock{},
 		block.SmartContractResultBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.NotNil(t, irp)
@@ -720,6 +818,7 @@ func TestIntermediateResultsProcessor_CreateMarshalizedData(t *testing.T) {
 		&mock.ChainStorerMock{},
 		block.SmartContractResultBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.NotNil(t, irp)
@@ -787,6 +886,7 @@ func TestIntermediateResultsProcessor_GetAllCurrentUsedTxs(t *testing.T) {
 		&mock.ChainStorerMock{},
 		block.SmartContractResultBlock,
 		&mock.TxForCurrentBlockStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.NotNil(t, irp)
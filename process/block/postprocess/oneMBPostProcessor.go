@@ -113,9 +113,9 @@ func (opp *oneMBPostProcessor) CreateAllInterMiniBlocks() []*block.MiniBlock {
 // VerifyInterMiniBlocks verifies if the receipts/bad transactions added to the block are valid
 func (opp *oneMBPostProcessor) VerifyInterMiniBlocks(body *block.Body) error {
 	scrMbs := opp.CreateAllInterMiniBlocks()
-	createdMapMbs := make(map[uint32]*block.MiniBlock)
+	createdMapMbs := make(map[string]*block.MiniBlock)
 	for _, mb := range scrMbs {
-		createdMapMbs[mb.ReceiverShardID] = mb
+		createdMapMbs[createdMiniBlockKey(mb)] = mb
 	}
 
 	verifiedOne := false
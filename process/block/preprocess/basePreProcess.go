@@ -43,6 +43,7 @@ type basePreProcess struct {
 	balanceComputation   BalanceComputationHandler
 	accounts             state.AccountsAdapter
 	pubkeyConverter      core.PubkeyConverter
+	chargingEpochHandler process.ChargingEpochHandler
 }
 
 func (bpp *basePreProcess) removeBlockDataFromPools(
@@ -46,6 +46,7 @@ func NewSmartContractResultPreprocessor(
 	pubkeyConverter core.PubkeyConverter,
 	blockSizeComputation BlockSizeComputationHandler,
 	balanceComputation BalanceComputationHandler,
+	chargingEpochHandler process.ChargingEpochHandler,
 ) (*smartContractResults, error) {
 
 	if check.IfNil(hasher) {
@@ -87,6 +88,9 @@ func NewSmartContractResultPreprocessor(
 	if check.IfNil(balanceComputation) {
 		return nil, process.ErrNilBalanceComputationHandler
 	}
+	if check.IfNil(chargingEpochHandler) {
+		return nil, process.ErrNilChargingEpochHandler
+	}
 
 	bpp := &basePreProcess{
 		hasher:               hasher,
@@ -98,6 +102,7 @@ func NewSmartContractResultPreprocessor(
 		balanceComputation:   balanceComputation,
 		accounts:             accounts,
 		pubkeyConverter:      pubkeyConverter,
+		chargingEpochHandler: chargingEpochHandler,
 	}
 
 	scr := &smartContractResults{
@@ -221,6 +226,8 @@ func (scr *smartContractResults) ProcessBlockTransactions(
 		return process.ErrNilBlockBody
 	}
 
+	defer scr.chargingEpochHandler.ResetChargingEpoch()
+
 	// basic validation already done in interceptors
 	for i := 0; i < len(body.MiniBlocks); i++ {
 		miniBlock := body.MiniBlocks[i]
@@ -235,6 +242,11 @@ func (scr *smartContractResults) ProcessBlockTransactions(
 			continue
 		}
 
+		err := scr.chargingEpochHandler.SetChargingEpochFromMiniBlock(miniBlock)
+		if err != nil {
+			return err
+		}
+
 		for j := 0; j < len(miniBlock.TxHashes); j++ {
 			if !haveTime() {
 				return process.ErrTimeIsOut
@@ -487,6 +499,12 @@ func (scr *smartContractResults) ProcessMiniBlock(miniBlock *block.MiniBlock, ha
 		processedTxHashes = append(processedTxHashes, miniBlockTxHashes[index])
 	}
 
+	err = scr.chargingEpochHandler.SetChargingEpochFromMiniBlock(miniBlock)
+	if err != nil {
+		return processedTxHashes, 0, err
+	}
+	defer scr.chargingEpochHandler.ResetChargingEpoch()
+
 	for index := range miniBlockScrs {
 		if !haveTime() {
 			err = process.ErrTimeIsOut
@@ -2,6 +2,7 @@ package preprocess
 
 import (
 	"encoding/json"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -55,6 +56,7 @@ func TestScrsPreprocessor_NewSmartContractResultPreprocessorNilPool(t *testing.T
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -80,6 +82,7 @@ func TestScrsPreprocessor_NewSmartContractResultPreprocessorNilStore(t *testing.
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -105,6 +108,7 @@ func TestScrsPreprocessor_NewSmartContractResultPreprocessorNilHasher(t *testing
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -130,6 +134,7 @@ func TestScrsPreprocessor_NewSmartContractResultPreprocessorNilMarsalizer(t *tes
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -155,6 +160,7 @@ func TestScrsPreprocessor_NewSmartContractResultPreprocessorNilTxProce(t *testin
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -180,6 +186,7 @@ func TestScrsPreprocessor_NewSmartContractResultPreprocessorNilShardCoord(t *tes
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -205,6 +212,7 @@ func TestScrsPreprocessor_NewSmartContractResultPreprocessorNilAccounts(t *testi
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -229,6 +237,7 @@ func TestScrsPreprocessor_NewSmartContractResultPreprocessorNilRequestFunc(t *te
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -254,6 +263,7 @@ func TestScrsPreprocessor_NewSmartContractResultPreprocessorNilGasHandler(t *tes
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -279,6 +289,7 @@ func TestScrsPreprocessor_NewSmartContractResultPreprocessorShouldWork(t *testin
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, err)
@@ -304,6 +315,7 @@ func TestScrsPreprocessor_NewSmartContractResultPreprocessorNilPubkeyConverter(t
 		nil,
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -329,6 +341,7 @@ func TestScrsPreprocessor_NewSmartContractResultPreprocessorNilBlockSizeComputat
 		createMockPubkeyConverter(),
 		nil,
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -354,12 +367,39 @@ func TestScrsPreprocessor_NewSmartContractResultPreprocessorNilBalanceComputatio
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		nil,
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
 	assert.Equal(t, process.ErrNilBalanceComputationHandler, err)
 }
 
+func TestScrsPreprocessor_NewSmartContractResultPreprocessorNilChargingEpochHandler(t *testing.T) {
+	t.Parallel()
+
+	tdp := initDataPool()
+	requestTransaction := func(shardID uint32, txHashes [][]byte) {}
+	txs, err := NewSmartContractResultPreprocessor(
+		tdp.UnsignedTransactions(),
+		&mock.ChainStorerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.TxProcessorMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+		requestTransaction,
+		&mock.GasHandlerMock{},
+		feeHandlerMock(),
+		createMockPubkeyConverter(),
+		&mock.BlockSizeComputationStub{},
+		&mock.BalanceComputationStub{},
+		nil,
+	)
+
+	assert.Nil(t, txs)
+	assert.Equal(t, process.ErrNilChargingEpochHandler, err)
+}
+
 func TestScrsPreProcessor_GetTransactionFromPool(t *testing.T) {
 	t.Parallel()
 
@@ -379,6 +419,7 @@ func TestScrsPreProcessor_GetTransactionFromPool(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	txHash := []byte("tx1_hash")
@@ -408,6 +449,7 @@ func TestScrsPreprocessor_RequestTransactionNothingToRequestAsGeneratedAtProcess
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	shardId := uint32(1)
@@ -445,6 +487,7 @@ func TestScrsPreprocessor_RequestTransactionFromNetwork(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	shardId := uint32(1)
@@ -481,6 +524,7 @@ func TestScrsPreprocessor_RequestBlockTransactionFromMiniBlockFromNetwork(t *tes
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	shardId := uint32(1)
@@ -528,6 +572,7 @@ func TestScrsPreprocessor_ReceivedTransactionShouldEraseRequested(t *testing.T)
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	//add 3 tx hashes on requested list
@@ -601,6 +646,7 @@ func TestScrsPreprocessor_GetAllTxsFromMiniBlockShouldWork(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	mb := &block.MiniBlock{
@@ -643,6 +689,7 @@ func TestScrsPreprocessor_RemoveBlockDataFromPoolsNilBlockShouldErr(t *testing.T
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	err := txs.RemoveBlockDataFromPools(nil, tdp.MiniBlocks())
@@ -670,6 +717,7 @@ func TestScrsPreprocessor_RemoveBlockDataFromPoolsOK(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	body := &block.Body{}
@@ -710,6 +758,7 @@ func TestScrsPreprocessor_IsDataPreparedErr(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	err := txs.IsDataPrepared(1, haveTime)
@@ -737,6 +786,7 @@ func TestScrsPreprocessor_IsDataPrepared(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	go func() {
@@ -769,6 +819,7 @@ func TestScrsPreprocessor_SaveTxsToStorage(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	body := &block.Body{}
@@ -808,6 +859,7 @@ func TestScrsPreprocessor_SaveTxsToStorageMissingTransactionsShouldErr(t *testin
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	body := &block.Body{}
@@ -853,6 +905,7 @@ func TestScrsPreprocessor_ProcessBlockTransactions(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	body := &block.Body{}
@@ -924,6 +977,7 @@ func TestScrsPreprocessor_ProcessMiniBlock(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	txHash := []byte("tx1_hash")
@@ -942,6 +996,80 @@ func TestScrsPreprocessor_ProcessMiniBlock(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestScrsPreprocessor_ProcessMiniBlockShouldProcessWithTheChargingEpochOfTheMiniBlock(t *testing.T) {
+	t.Parallel()
+
+	tdp := initDataPool()
+	tdp.TransactionsCalled = func() dataRetriever.ShardedDataCacherNotifier {
+		return &testscommon.ShardedDataStub{
+			ShardDataStoreCalled: func(id string) (c storage.Cacher) {
+				return &testscommon.CacherStub{
+					PeekCalled: func(key []byte) (value interface{}, ok bool) {
+						return &smartContractResult.SmartContractResult{Nonce: 10}, true
+					},
+				}
+			},
+		}
+	}
+
+	miniblock := &block.MiniBlock{
+		ReceiverShardID: 0,
+		SenderShardID:   1,
+		TxHashes:        [][]byte{[]byte("tx1_hash")},
+		Type:            block.SmartContractResultBlock,
+		Reserved:        []byte{0, 0, 0, 3},
+	}
+
+	chargingEpoch := uint32(5)
+	chargingEpochHandler := &mock.ChargingEpochHandlerStub{
+		SetChargingEpochFromMiniBlockCalled: func(mb *block.MiniBlock) error {
+			assert.Equal(t, miniblock, mb)
+			chargingEpoch = 3
+			return nil
+		},
+		ResetChargingEpochCalled: func() {
+			chargingEpoch = 5
+		},
+	}
+	processedInChargingEpoch := uint32(0)
+	scr, _ := NewSmartContractResultPreprocessor(
+		tdp.UnsignedTransactions(),
+		&mock.ChainStorerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.TxProcessorMock{
+			ProcessSmartContractResultCalled: func(scr *smartContractResult.SmartContractResult) (vmcommon.ReturnCode, error) {
+				processedInChargingEpoch = chargingEpoch
+				return 0, nil
+			},
+		},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+		func(shardID uint32, txHashes [][]byte) {},
+		&mock.GasHandlerMock{
+			RemoveGasConsumedCalled: func(hashes [][]byte) {},
+			RemoveGasRefundedCalled: func(hashes [][]byte) {},
+		},
+		feeHandlerMock(),
+		createMockPubkeyConverter(),
+		&mock.BlockSizeComputationStub{},
+		&mock.BalanceComputationStub{},
+		chargingEpochHandler,
+	)
+
+	_, _, err := scr.ProcessMiniBlock(miniblock, haveTimeTrue, getNumOfCrossInterMbsAndTxsZero)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(3), processedInChargingEpoch)
+	assert.Equal(t, uint32(5), chargingEpoch)
+
+	expectedErr := errors.New("expected error")
+	chargingEpochHandler.SetChargingEpochFromMiniBlockCalled = func(_ *block.MiniBlock) error {
+		return expectedErr
+	}
+	_, _, err = scr.ProcessMiniBlock(miniblock, haveTimeTrue, getNumOfCrossInterMbsAndTxsZero)
+	assert.Equal(t, expectedErr, err)
+}
+
 func TestScrsPreprocessor_ProcessMiniBlockWrongTypeMiniblockShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -962,6 +1090,7 @@ func TestScrsPreprocessor_ProcessMiniBlockWrongTypeMiniblockShouldErr(t *testing
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	miniblock := block.MiniBlock{
@@ -1019,6 +1148,7 @@ func TestScrsPreprocessor_RestoreBlockDataIntoPools(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	body := &block.Body{}
@@ -1061,6 +1191,7 @@ func TestScrsPreprocessor_RestoreBlockDataIntoPoolsNilMiniblockPoolShouldErr(t *
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	body := &block.Body{}
@@ -1093,6 +1224,7 @@ func TestSmartContractResults_CreateBlockStartedShouldEmptyTxHashAndInfo(t *test
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	scr.CreateBlockStarted()
@@ -1119,6 +1251,7 @@ func TestSmartContractResults_GetAllCurrentUsedTxs(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	txshardInfo := txShardInfo{0, 3}
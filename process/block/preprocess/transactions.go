@@ -28,9 +28,14 @@ import (
 
 var _ process.DataMarshalizer = (*transactions)(nil)
 var _ process.PreProcessor = (*transactions)(nil)
+var _ process.ProposalPreparer = (*transactions)(nil)
 
 var log = logger.GetOrCreate("process/block/preprocess")
 
+// maxPreparedProposalAge is the maximum time the transactions selected ahead of time are used for a new proposal.
+// Older selections are discarded as they would miss too many of the newly received transactions
+const maxPreparedProposalAge = time.Second * 5
+
 // TODO: increase code coverage with unit test
 
 type transactions struct {
@@ -49,6 +54,9 @@ type transactions struct {
 	accountsInfo         map[string]*txShardInfo
 	mutAccountsInfo      sync.RWMutex
 	emptyAddress         []byte
+	preparedSortedTxs    []*txcache.WrappedTransaction
+	preparedTimestamp    time.Time
+	mutPreparedTxs       sync.Mutex
 }
 
 // NewTransactionPreprocessor creates a new transaction preprocessor object
@@ -68,6 +76,7 @@ func NewTransactionPreprocessor(
 	pubkeyConverter core.PubkeyConverter,
 	blockSizeComputation BlockSizeComputationHandler,
 	balanceComputation BalanceComputationHandler,
+	chargingEpochHandler process.ChargingEpochHandler,
 ) (*transactions, error) {
 
 	if check.IfNil(hasher) {
@@ -112,6 +121,9 @@ func NewTransactionPreprocessor(
 	if check.IfNil(balanceComputation) {
 		return nil, process.ErrNilBalanceComputationHandler
 	}
+	if check.IfNil(chargingEpochHandler) {
+		return nil, process.ErrNilChargingEpochHandler
+	}
 
 	bpp := basePreProcess{
 		hasher:               hasher,
@@ -123,6 +135,7 @@ func NewTransactionPreprocessor(
 		balanceComputation:   balanceComputation,
 		accounts:             accounts,
 		pubkeyConverter:      pubkeyConverter,
+		chargingEpochHandler: chargingEpochHandler,
 	}
 
 	txs := transactions{
@@ -261,12 +274,12 @@ func (txs *transactions) ProcessBlockTransactions(
 	return process.ErrInvalidBody
 }
 
-func (txs *transactions) computeTxsToMe(body *block.Body) ([]*txcache.WrappedTransaction, error) {
+func (txs *transactions) computeMiniBlocksToMe(body *block.Body) ([]*block.MiniBlock, error) {
 	if check.IfNil(body) {
 		return nil, process.ErrNilBlockBody
 	}
 
-	allTxs := make([]*txcache.WrappedTransaction, 0)
+	miniBlocksToMe := make([]*block.MiniBlock, 0)
 	for _, miniBlock := range body.MiniBlocks {
 		shouldSkipMiniblock := miniBlock.SenderShardID == txs.shardCoordinator.SelfId() || !txs.isMiniBlockCorrect(miniBlock.Type)
 		if shouldSkipMiniblock {
@@ -280,15 +293,10 @@ func (txs *transactions) computeTxsToMe(body *block.Body) ([]*txcache.WrappedTra
 				miniBlock.ReceiverShardID)
 		}
 
-		txsFromMiniBlock, err := txs.computeTxsFromMiniBlock(miniBlock)
-		if err != nil {
-			return nil, err
-		}
-
-		allTxs = append(allTxs, txsFromMiniBlock...)
+		miniBlocksToMe = append(miniBlocksToMe, miniBlock)
 	}
 
-	return allTxs, nil
+	return miniBlocksToMe, nil
 }
 
 func (txs *transactions) computeTxsFromMe(body *block.Body) ([]*txcache.WrappedTransaction, error) {
@@ -373,7 +381,7 @@ func (txs *transactions) processTxsToMe(
 		return process.ErrNilBlockBody
 	}
 
-	txsToMe, err := txs.computeTxsToMe(body)
+	miniBlocksToMe, err := txs.computeMiniBlocksToMe(body)
 	if err != nil {
 		return err
 	}
@@ -384,41 +392,55 @@ func (txs *transactions) processTxsToMe(
 
 	log.Trace("processTxsToMe", "totalGasConsumedInSelfShard", totalGasConsumedInSelfShard)
 
-	for index := range txsToMe {
-		if !haveTime() {
-			return process.ErrTimeIsOut
-		}
+	defer txs.chargingEpochHandler.ResetChargingEpoch()
 
-		tx, ok := txsToMe[index].Tx.(*transaction.Transaction)
-		if !ok {
-			return process.ErrWrongTypeAssertion
+	for _, miniBlock := range miniBlocksToMe {
+		txsToMe, errCompute := txs.computeTxsFromMiniBlock(miniBlock)
+		if errCompute != nil {
+			return errCompute
 		}
 
-		txHash := txsToMe[index].TxHash
-		senderShardID := txsToMe[index].SenderShardID
-		receiverShardID := txsToMe[index].ReceiverShardID
-
-		txs.saveAccountBalanceForAddress(tx.GetRcvAddr())
-
-		err = txs.processAndRemoveBadTransaction(
-			txHash,
-			tx,
-			senderShardID,
-			receiverShardID)
+		err = txs.chargingEpochHandler.SetChargingEpochFromMiniBlock(miniBlock)
 		if err != nil {
 			return err
 		}
 
-		err = txs.computeGasConsumed(
-			senderShardID,
-			receiverShardID,
-			tx,
-			txHash,
-			&gasConsumedByMiniBlockInSenderShard,
-			&gasConsumedByMiniBlockInReceiverShard,
-			&totalGasConsumedInSelfShard)
-		if err != nil {
-			return err
+		for index := range txsToMe {
+			if !haveTime() {
+				return process.ErrTimeIsOut
+			}
+
+			tx, ok := txsToMe[index].Tx.(*transaction.Transaction)
+			if !ok {
+				return process.ErrWrongTypeAssertion
+			}
+
+			txHash := txsToMe[index].TxHash
+			senderShardID := txsToMe[index].SenderShardID
+			receiverShardID := txsToMe[index].ReceiverShardID
+
+			txs.saveAccountBalanceForAddress(tx.GetRcvAddr())
+
+			err = txs.processAndRemoveBadTransaction(
+				txHash,
+				tx,
+				senderShardID,
+				receiverShardID)
+			if err != nil {
+				return err
+			}
+
+			err = txs.computeGasConsumed(
+				senderShardID,
+				receiverShardID,
+				tx,
+				txHash,
+				&gasConsumedByMiniBlockInSenderShard,
+				&gasConsumedByMiniBlockInReceiverShard,
+				&totalGasConsumedInSelfShard)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
@@ -719,7 +741,7 @@ func (txs *transactions) getAllTxsFromMiniBlock(
 // as long as it has time
 func (txs *transactions) CreateAndProcessMiniBlocks(haveTime func() bool) (block.MiniBlockSlice, error) {
 	startTime := time.Now()
-	sortedTxs, err := txs.computeSortedTxs(txs.shardCoordinator.SelfId(), txs.shardCoordinator.SelfId())
+	sortedTxs, err := txs.getSortedTxsForProposal()
 	elapsedTime := time.Since(startTime)
 	if err != nil {
 		log.Debug("computeSortedTxs", "error", err.Error())
@@ -801,6 +823,9 @@ func (txs *transactions) createAndProcessMiniBlocksFromMe(
 		go txs.notifyTransactionProviderIfNeeded()
 	}()
 
+	// the transactions from me are charged in the current epoch
+	txs.chargingEpochHandler.ResetChargingEpoch()
+
 	for shardID := uint32(0); shardID < txs.shardCoordinator.NumberOfShards(); shardID++ {
 		mapMiniBlocks[shardID] = txs.createEmptyMiniBlock(txs.shardCoordinator.SelfId(), shardID, block.TxBlock)
 	}
@@ -1034,6 +1059,9 @@ func (txs *transactions) createEmptyMiniBlock(
 		ReceiverShardID: receiverShardID,
 		TxHashes:        make([][]byte, 0),
 	}
+	if senderShardID != receiverShardID {
+		txs.chargingEpochHandler.SaveChargingEpoch(miniBlock, txs.chargingEpochHandler.ChargingEpoch())
+	}
 
 	return miniBlock
 }
@@ -1077,6 +1105,63 @@ func (txs *transactions) computeSortedTxs(
 	return sortedTxs, nil
 }
 
+// PrepareNextProposal selects and sorts ahead of time the transactions from the pool, so that the next call of
+// CreateAndProcessMiniBlocks does not spend its proposal time doing it
+func (txs *transactions) PrepareNextProposal() {
+	startTime := time.Now()
+	sortedTxs, err := txs.computeSortedTxs(txs.shardCoordinator.SelfId(), txs.shardCoordinator.SelfId())
+	if err != nil {
+		log.Debug("PrepareNextProposal.computeSortedTxs", "error", err.Error())
+		return
+	}
+
+	txs.mutPreparedTxs.Lock()
+	txs.preparedSortedTxs = sortedTxs
+	txs.preparedTimestamp = time.Now()
+	txs.mutPreparedTxs.Unlock()
+
+	log.Debug("prepared the sorted txs for the next proposal",
+		"num txs", len(sortedTxs),
+		"time [s]", time.Since(startTime),
+	)
+}
+
+// getSortedTxsForProposal returns the transactions prepared ahead of time, if they are still fresh, without the ones
+// that left the pool in the meantime. Otherwise, the sorted transactions are computed on the spot
+func (txs *transactions) getSortedTxsForProposal() ([]*txcache.WrappedTransaction, error) {
+	txs.mutPreparedTxs.Lock()
+	preparedSortedTxs := txs.preparedSortedTxs
+	preparedTimestamp := txs.preparedTimestamp
+	txs.preparedSortedTxs = nil
+	txs.mutPreparedTxs.Unlock()
+
+	isPreparedDataFresh := preparedSortedTxs != nil && time.Since(preparedTimestamp) <= maxPreparedProposalAge
+	if !isPreparedDataFresh {
+		return txs.computeSortedTxs(txs.shardCoordinator.SelfId(), txs.shardCoordinator.SelfId())
+	}
+
+	strCache := process.ShardCacherIdentifier(txs.shardCoordinator.SelfId(), txs.shardCoordinator.SelfId())
+	txShardPool := txs.txPool.ShardDataStore(strCache)
+	if check.IfNil(txShardPool) {
+		return nil, process.ErrNilTxDataPool
+	}
+
+	sortedTxs := make([]*txcache.WrappedTransaction, 0, len(preparedSortedTxs))
+	for _, tx := range preparedSortedTxs {
+		_, isInPool := txShardPool.Peek(tx.TxHash)
+		if isInPool {
+			sortedTxs = append(sortedTxs, tx)
+		}
+	}
+
+	log.Debug("using the sorted txs prepared ahead of time",
+		"num prepared txs", len(preparedSortedTxs),
+		"num txs still in pool", len(sortedTxs),
+	)
+
+	return sortedTxs, nil
+}
+
 // ProcessMiniBlock processes all the transactions from a and saves the processed transactions in local cache complete miniblock
 func (txs *transactions) ProcessMiniBlock(
 	miniBlock *block.MiniBlock,
@@ -1135,6 +1220,12 @@ func (txs *transactions) ProcessMiniBlock(
 
 	numOfOldCrossInterMbs, numOfOldCrossInterTxs := getNumOfCrossInterMbsAndTxs()
 
+	err = txs.chargingEpochHandler.SetChargingEpochFromMiniBlock(miniBlock)
+	if err != nil {
+		return processedTxHashes, 0, err
+	}
+	defer txs.chargingEpochHandler.ResetChargingEpoch()
+
 	for index := range miniBlockTxs {
 		if !haveTime() {
 			err = process.ErrTimeIsOut
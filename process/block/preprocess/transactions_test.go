@@ -190,6 +190,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilPool(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -217,6 +218,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilStore(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -244,6 +246,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilHasher(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -271,6 +274,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilMarsalizer(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -298,6 +302,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilTxProce(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -325,6 +330,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilShardCoord(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -352,6 +358,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilAccounts(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -378,6 +385,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilRequestFunc(t *testing.T)
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -405,6 +413,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilFeeHandler(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -432,6 +441,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilGasHandler(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -459,6 +469,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilBlockTracker(t *testing.T)
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -486,6 +497,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilPubkeyConverter(t *testing
 		nil,
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -513,6 +525,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilBlockSizeComputationHandle
 		createMockPubkeyConverter(),
 		nil,
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
@@ -540,12 +553,41 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilBalanceComputationHandler(
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		nil,
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, txs)
 	assert.Equal(t, process.ErrNilBalanceComputationHandler, err)
 }
 
+func TestTxsPreprocessor_NewTransactionPreprocessorNilChargingEpochHandler(t *testing.T) {
+	t.Parallel()
+
+	tdp := initDataPool()
+	requestTransaction := func(shardID uint32, txHashes [][]byte) {}
+	txs, err := NewTransactionPreprocessor(
+		tdp.Transactions(),
+		&mock.ChainStorerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.TxProcessorMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+		requestTransaction,
+		feeHandlerMock(),
+		&mock.GasHandlerMock{},
+		&mock.BlockTrackerMock{},
+		block.TxBlock,
+		createMockPubkeyConverter(),
+		&mock.BlockSizeComputationStub{},
+		&mock.BalanceComputationStub{},
+		nil,
+	)
+
+	assert.Nil(t, txs)
+	assert.Equal(t, process.ErrNilChargingEpochHandler, err)
+}
+
 func TestTxsPreprocessor_NewTransactionPreprocessorOkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -567,6 +609,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorOkValsShouldWork(t *testing.T
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	assert.Nil(t, err)
@@ -789,6 +832,7 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddAll(t *testi
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 	assert.NotNil(t, txs)
 
@@ -859,6 +903,7 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddAllAsNoSCCal
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 	assert.NotNil(t, txs)
 
@@ -939,6 +984,7 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddOnly5asSCCal
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 	assert.NotNil(t, txs)
 
@@ -1069,6 +1115,7 @@ func createGoodPreprocessor(dataPool dataRetriever.PoolsHolder) *transactions {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	return preprocessor
@@ -1114,6 +1161,7 @@ func TestTransactionPreprocessor_ProcessTxsToMeShouldUseCorrectSenderAndReceiver
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 
 	tx := transaction.Transaction{SndAddr: []byte("2"), RcvAddr: []byte("0")}
@@ -1141,3 +1189,77 @@ func TestTransactionPreprocessor_ProcessTxsToMeShouldUseCorrectSenderAndReceiver
 	assert.Equal(t, uint32(2), senderShardID)
 	assert.Equal(t, uint32(0), receiverShardID)
 }
+
+func createTransactionPreprocessorForProposalPreparation(txPool dataRetriever.ShardedDataCacherNotifier) *transactions {
+	txs, _ := NewTransactionPreprocessor(
+		txPool,
+		&mock.ChainStorerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.TxProcessorMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+		func(shardID uint32, txHashes [][]byte) {},
+		feeHandlerMock(),
+		&mock.GasHandlerMock{},
+		&mock.BlockTrackerMock{},
+		block.TxBlock,
+		createMockPubkeyConverter(),
+		&mock.BlockSizeComputationStub{},
+		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
+	)
+
+	return txs
+}
+
+func addTxsInSelfShardPool(txPool dataRetriever.ShardedDataCacherNotifier, numTxs int) [][]byte {
+	strCache := process.ShardCacherIdentifier(0, 0)
+	txHashes := make([][]byte, 0, numTxs)
+	for i := 0; i < numTxs; i++ {
+		newTx := &transaction.Transaction{GasLimit: uint64(i)}
+		txHash, _ := core.CalculateHash(&mock.MarshalizerMock{}, &mock.HasherMock{}, newTx)
+		txPool.AddData(txHash, newTx, newTx.Size(), strCache)
+		txHashes = append(txHashes, txHash)
+	}
+
+	return txHashes
+}
+
+func TestTransactions_GetSortedTxsForProposalShouldUsePreparedTxsStillInPool(t *testing.T) {
+	t.Parallel()
+
+	txPool, _ := testscommon.CreateTxPool(3, 0)
+	txs := createTransactionPreprocessorForProposalPreparation(txPool)
+	txHashes := addTxsInSelfShardPool(txPool, 5)
+
+	txs.PrepareNextProposal()
+	assert.Equal(t, 5, len(txs.preparedSortedTxs))
+
+	txPool.RemoveData(txHashes[0], process.ShardCacherIdentifier(0, 0))
+	txPool.RemoveData(txHashes[1], process.ShardCacherIdentifier(0, 0))
+	sortedTxs, err := txs.getSortedTxsForProposal()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(sortedTxs))
+	for _, tx := range sortedTxs {
+		assert.NotEqual(t, txHashes[0], tx.TxHash)
+		assert.NotEqual(t, txHashes[1], tx.TxHash)
+	}
+	assert.Nil(t, txs.preparedSortedTxs)
+}
+
+func TestTransactions_GetSortedTxsForProposalStalePreparedTxsShouldRecompute(t *testing.T) {
+	t.Parallel()
+
+	txPool, _ := testscommon.CreateTxPool(3, 0)
+	txs := createTransactionPreprocessorForProposalPreparation(txPool)
+	_ = addTxsInSelfShardPool(txPool, 2)
+
+	txs.PrepareNextProposal()
+	txs.preparedTimestamp = time.Now().Add(-maxPreparedProposalAge - time.Second)
+	_ = addTxsInSelfShardPool(txPool, 4)
+
+	sortedTxs, err := txs.getSortedTxsForProposal()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(sortedTxs))
+}
@@ -21,6 +21,7 @@ import (
 )
 
 var _ process.BlockProcessor = (*shardProcessor)(nil)
+var _ process.ProposalPreparer = (*shardProcessor)(nil)
 
 const timeBetweenCheckForEpochStart = 100 * time.Millisecond
 
@@ -72,6 +73,7 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		blockTracker:            arguments.BlockTracker,
 		dataPool:                arguments.DataPool,
 		stateCheckpointModulus:  arguments.StateCheckpointModulus,
+		epochStartWorkDelayer:   newEpochStartWorkDelayer(arguments.EpochStartProcessingLag),
 		blockChain:              arguments.BlockChain,
 		feeHandler:              arguments.FeeHandler,
 		indexer:                 arguments.Indexer,
@@ -1014,12 +1016,15 @@ func (sp *shardProcessor) updateState(headers []data.HeaderHandler, currentHeade
 			"nonce", hdr.GetNonce(),
 			"root hash", hdr.GetRootHash())
 
-		sp.updateStateStorage(
-			hdr,
-			hdr.GetRootHash(),
-			prevHeader.GetRootHash(),
-			sp.accountsDB[state.UserAccountsState],
-		)
+		finalHeader := hdr
+		sp.epochStartWorkDelayer.execute(currentHeader.GetRound(), func() {
+			sp.updateStateStorage(
+				finalHeader,
+				finalHeader.GetRootHash(),
+				prevHeader.GetRootHash(),
+				sp.accountsDB[state.UserAccountsState],
+			)
+		})
 	}
 }
 
@@ -1051,10 +1056,13 @@ func (sp *shardProcessor) snapShotEpochStartFromMeta(header *block.Header) {
 			}
 
 			rootHash := epochStartShData.RootHash
-			log.Debug("shard trie snapshot from epoch start shard data", "rootHash", rootHash)
-			ctx := context.Background()
-			accounts.SnapshotState(rootHash, ctx)
 			saveEpochStartEconomicsMetrics(sp.appStatusHandler, metaHdr)
+			sp.epochStartWorkDelayer.delayFromRound(header.GetRound())
+			sp.epochStartWorkDelayer.execute(header.GetRound(), func() {
+				log.Debug("shard trie snapshot from epoch start shard data", "rootHash", rootHash)
+				ctx := context.Background()
+				accounts.SnapshotState(rootHash, ctx)
+			})
 		}
 	}
 }
@@ -458,6 +458,7 @@ func TestShardProcessor_ProcessBlockWithInvalidTransactionShouldErr(t *testing.T
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 	container, _ := factory.Create()
 
@@ -680,6 +681,7 @@ func TestShardProcessor_ProcessBlockWithErrOnProcessBlockTransactionsCallShouldR
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 	container, _ := factory.Create()
 
@@ -2224,6 +2226,7 @@ func TestShardProcessor_MarshalizedDataToBroadcastShouldWork(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 	container, _ := factory.Create()
 
@@ -2337,6 +2340,7 @@ func TestShardProcessor_MarshalizedDataMarshalWithoutSuccess(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 	container, _ := factory.Create()
 
@@ -2754,6 +2758,7 @@ func TestShardProcessor_CreateMiniBlocksShouldWorkWithIntraShardTxs(t *testing.T
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 	container, _ := factory.Create()
 
@@ -2938,6 +2943,7 @@ func TestShardProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 	container, _ := factory.Create()
 
@@ -122,3 +122,6 @@ const MaxHeadersToWhitelistInAdvance = 20
 // the real gas used, after which the transaction will be considered an attack and all the gas will be consumed and
 // nothing will be refunded to the sender
 const MaxGasFeeHigherFactorAccepted = 10
+
+// ChargingEpochLength defines the length of the charging epoch carried in the reserved field of a cross shard miniblock
+const ChargingEpochLength = 4
@@ -685,6 +685,19 @@ func (tc *transactionCoordinator) CreateMbsAndProcessTransactionsFromMe(
 	return miniBlocks
 }
 
+// PrepareNextProposal lets the preprocessors able to do so prepare ahead of time the data needed for the next proposal
+func (tc *transactionCoordinator) PrepareNextProposal() {
+	for _, blockType := range tc.keysTxPreProcs {
+		txPreProc := tc.getPreProcessor(blockType)
+		proposalPreparer, ok := txPreProc.(process.ProposalPreparer)
+		if !ok || check.IfNil(proposalPreparer) {
+			continue
+		}
+
+		proposalPreparer.PrepareNextProposal()
+	}
+}
+
 // CreatePostProcessMiniBlocks returns all the post processed miniblocks
 func (tc *transactionCoordinator) CreatePostProcessMiniBlocks() block.MiniBlockSlice {
 	miniBlocks := make(block.MiniBlockSlice, 0)
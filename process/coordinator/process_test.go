@@ -540,6 +540,7 @@ func createPreProcessorContainer() process.PreProcessorsContainer {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 	container, _ := preFactory.Create()
 
@@ -554,6 +555,7 @@ func createInterimProcessorContainer() process.IntermediateProcessorContainer {
 		createMockPubkeyConverter(),
 		initStore(),
 		initDataPool([]byte("test_hash1")),
+		&mock.ChargingEpochHandlerStub{},
 	)
 	container, _ := preFactory.Create()
 
@@ -630,6 +632,7 @@ func createPreProcessorContainerWithDataPool(
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 	container, _ := preFactory.Create()
 
@@ -961,6 +964,7 @@ func TestTransactionCoordinator_CreateMbsAndProcessCrossShardTransactions(t *tes
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 	container, _ := preFactory.Create()
 
@@ -1111,6 +1115,7 @@ func TestTransactionCoordinator_CreateMbsAndProcessCrossShardTransactionsNilPreP
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 	container, _ := preFactory.Create()
 
@@ -1221,6 +1226,7 @@ func TestTransactionCoordinator_CreateMbsAndProcessTransactionsFromMeNothingToPr
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 	container, _ := preFactory.Create()
 
@@ -1871,6 +1877,7 @@ func TestTransactionCoordinator_ProcessBlockTransactionProcessTxError(t *testing
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 	container, _ := preFactory.Create()
 
@@ -2007,6 +2014,7 @@ func TestTransactionCoordinator_RequestMiniblocks(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 	container, _ := preFactory.Create()
 
@@ -2149,6 +2157,7 @@ func TestShardProcessor_ProcessMiniBlockCompleteWithOkTxsShouldExecuteThemAndNot
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 	container, _ := preFactory.Create()
 
@@ -2285,6 +2294,7 @@ func TestShardProcessor_ProcessMiniBlockCompleteWithErrorWhileProcessShouldCallR
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.ChargingEpochHandlerStub{},
 	)
 	container, _ := preFactory.Create()
 
@@ -2336,6 +2346,7 @@ func TestTransactionCoordinator_VerifyCreatedBlockTransactionsNilOrMiss(t *testi
 		createMockPubkeyConverter(),
 		&mock.ChainStorerMock{},
 		tdp,
+		&mock.ChargingEpochHandlerStub{},
 	)
 	container, _ := preFactory.Create()
 
@@ -2399,6 +2410,7 @@ func TestTransactionCoordinator_VerifyCreatedBlockTransactionsOk(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.ChainStorerMock{},
 		tdp,
+		&mock.ChargingEpochHandlerStub{},
 	)
 	container, _ := interFactory.Create()
 
@@ -0,0 +1,111 @@
+package economics
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.ChargingEpochHandler = (*chargingEpochHolder)(nil)
+
+// chargingEpochHolder keeps the epoch in which the gas of the transactions being processed was charged. Starting
+// with the enable epoch, the cross shard miniblocks carry this epoch in their reserved field, so the destination
+// values the consumed and the refunded gas with the gas price modifier the gas was charged with, even if the gas price
+// modifier changed in between
+type chargingEpochHolder struct {
+	mutChargingEpoch sync.RWMutex
+	currentEpoch     uint32
+	chargingEpoch    uint32
+	enableEpoch      uint32
+	flagEnabled      atomic.Flag
+}
+
+// NewChargingEpochHolder creates a new charging epoch holder
+func NewChargingEpochHolder(enableEpoch uint32, epochNotifier process.EpochNotifier) (*chargingEpochHolder, error) {
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	ceh := &chargingEpochHolder{
+		enableEpoch: enableEpoch,
+	}
+	log.Debug("economics: enable epoch for the charging epoch carried by miniblocks", "epoch", enableEpoch)
+
+	epochNotifier.RegisterNotifyHandler(ceh)
+
+	return ceh, nil
+}
+
+// SetChargingEpochFromMiniBlock sets the charging epoch of the transactions from the provided miniblock, which is the
+// epoch carried by the miniblock, if any, or the current epoch
+func (ceh *chargingEpochHolder) SetChargingEpochFromMiniBlock(miniBlock *block.MiniBlock) error {
+	if miniBlock == nil {
+		return process.ErrNilMiniBlock
+	}
+
+	ceh.mutChargingEpoch.Lock()
+	defer ceh.mutChargingEpoch.Unlock()
+
+	switch len(miniBlock.Reserved) {
+	case 0:
+		ceh.chargingEpoch = ceh.currentEpoch
+	case process.ChargingEpochLength:
+		ceh.chargingEpoch = binary.BigEndian.Uint32(miniBlock.Reserved)
+	default:
+		return fmt.Errorf("%w, length %d", process.ErrInvalidChargingEpoch, len(miniBlock.Reserved))
+	}
+
+	return nil
+}
+
+// ResetChargingEpoch sets the charging epoch back to the current epoch
+func (ceh *chargingEpochHolder) ResetChargingEpoch() {
+	ceh.mutChargingEpoch.Lock()
+	ceh.chargingEpoch = ceh.currentEpoch
+	ceh.mutChargingEpoch.Unlock()
+}
+
+// ChargingEpoch returns the epoch in which the gas of the transactions being processed was charged
+func (ceh *chargingEpochHolder) ChargingEpoch() uint32 {
+	ceh.mutChargingEpoch.RLock()
+	defer ceh.mutChargingEpoch.RUnlock()
+
+	return ceh.chargingEpoch
+}
+
+// SaveChargingEpoch saves the provided charging epoch in the reserved field of the provided cross shard miniblock,
+// once the charging epoch is carried by miniblocks
+func (ceh *chargingEpochHolder) SaveChargingEpoch(miniBlock *block.MiniBlock, chargingEpoch uint32) {
+	if miniBlock == nil || !ceh.flagEnabled.IsSet() {
+		return
+	}
+
+	miniBlock.Reserved = make([]byte, process.ChargingEpochLength)
+	binary.BigEndian.PutUint32(miniBlock.Reserved, chargingEpoch)
+}
+
+// IsChargingEpochCarried returns true if the cross shard miniblocks carry the charging epoch
+func (ceh *chargingEpochHolder) IsChargingEpochCarried() bool {
+	return ceh.flagEnabled.IsSet()
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (ceh *chargingEpochHolder) EpochConfirmed(epoch uint32) {
+	ceh.flagEnabled.Toggle(epoch >= ceh.enableEpoch)
+	log.Debug("economics: charging epoch carried by miniblocks", "enabled", ceh.flagEnabled.IsSet())
+
+	ceh.mutChargingEpoch.Lock()
+	ceh.currentEpoch = epoch
+	ceh.chargingEpoch = epoch
+	ceh.mutChargingEpoch.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ceh *chargingEpochHolder) IsInterfaceNil() bool {
+	return ceh == nil
+}
@@ -0,0 +1,84 @@
+package economics_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/economics"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewChargingEpochHolder_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ceh, err := economics.NewChargingEpochHolder(0, nil)
+	assert.True(t, check.IfNil(ceh))
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestChargingEpochHolder_SaveChargingEpochOnlyAfterTheEnableEpoch(t *testing.T) {
+	t.Parallel()
+
+	var handler core.EpochSubscriberHandler
+	epochNotifier := &mock.EpochNotifierStub{
+		RegisterNotifyHandlerCalled: func(h core.EpochSubscriberHandler) {
+			handler = h
+		},
+	}
+	ceh, err := economics.NewChargingEpochHolder(5, epochNotifier)
+	require.Nil(t, err)
+
+	handler.EpochConfirmed(4)
+	miniBlock := &block.MiniBlock{}
+	ceh.SaveChargingEpoch(miniBlock, 4)
+	assert.False(t, ceh.IsChargingEpochCarried())
+	assert.Equal(t, 0, len(miniBlock.Reserved))
+
+	handler.EpochConfirmed(5)
+	ceh.SaveChargingEpoch(miniBlock, 5)
+	assert.True(t, ceh.IsChargingEpochCarried())
+	assert.Equal(t, []byte{0, 0, 0, 5}, miniBlock.Reserved)
+}
+
+func TestChargingEpochHolder_SetChargingEpochFromMiniBlock(t *testing.T) {
+	t.Parallel()
+
+	var handler core.EpochSubscriberHandler
+	epochNotifier := &mock.EpochNotifierStub{
+		RegisterNotifyHandlerCalled: func(h core.EpochSubscriberHandler) {
+			handler = h
+		},
+	}
+	ceh, _ := economics.NewChargingEpochHolder(0, epochNotifier)
+	handler.EpochConfirmed(7)
+	assert.Equal(t, uint32(7), ceh.ChargingEpoch())
+
+	err := ceh.SetChargingEpochFromMiniBlock(nil)
+	assert.Equal(t, process.ErrNilMiniBlock, err)
+
+	err = ceh.SetChargingEpochFromMiniBlock(&block.MiniBlock{Reserved: []byte{0, 0, 0, 6}})
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(6), ceh.ChargingEpoch())
+
+	err = ceh.SetChargingEpochFromMiniBlock(&block.MiniBlock{Reserved: []byte{6}})
+	assert.True(t, errors.Is(err, process.ErrInvalidChargingEpoch))
+	assert.Equal(t, uint32(6), ceh.ChargingEpoch())
+
+	err = ceh.SetChargingEpochFromMiniBlock(&block.MiniBlock{})
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(7), ceh.ChargingEpoch())
+
+	_ = ceh.SetChargingEpochFromMiniBlock(&block.MiniBlock{Reserved: []byte{0, 0, 0, 6}})
+	ceh.ResetChargingEpoch()
+	assert.Equal(t, uint32(7), ceh.ChargingEpoch())
+
+	_ = ceh.SetChargingEpochFromMiniBlock(&block.MiniBlock{Reserved: []byte{0, 0, 0, 6}})
+	handler.EpochConfirmed(8)
+	assert.Equal(t, uint32(8), ceh.ChargingEpoch())
+}
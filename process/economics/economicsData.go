@@ -1,7 +1,9 @@
 package economics
 
 import (
+	"fmt"
 	"math/big"
+	"sort"
 	"strconv"
 	"sync"
 
@@ -21,28 +23,62 @@ var _ process.FeeHandler = (*economicsData)(nil)
 var epsilon = 0.00000001
 var log = logger.GetOrCreate("process/economics")
 
+const defaultTopUpCurveSteepness = 1.0
+
+type inflationSetting struct {
+	startEpoch       uint32
+	maximumInflation float64
+	totalSupplyCap   *big.Int
+}
+
+type gasPriceModifierSetting struct {
+	epochEnable      uint32
+	gasPriceModifier float64
+}
+
+// feeSettings holds the epoch dependent values used when computing the transaction fees
+type feeSettings struct {
+	gasPriceModifierEnabled    bool
+	gasPriceModifier           float64
+	penalizedTooMuchGasEnabled bool
+}
+
+type topUpSetting struct {
+	epochEnable    uint32
+	factor         float64
+	gradientPoint  *big.Int
+	curveSteepness float64
+}
+
 // economicsData will store information about economics
 type economicsData struct {
 	leaderPercentage                 float64
 	protocolSustainabilityPercentage float64
 	protocolSustainabilityAddress    string
+	protocolSustainabilityAddresses  []config.ProtocolSustainabilityAddressConfig
 	maxGasLimitPerBlock              uint64
 	maxGasLimitPerMetaBlock          uint64
 	gasPerDataByte                   uint64
 	minGasPrice                      uint64
-	gasPriceModifier                 float64
+	gasPriceModifierSettings         []*gasPriceModifierSetting
+	mutGasPriceModifier              sync.RWMutex
+	currentGasPriceModifier          *gasPriceModifierSetting
 	minGasLimit                      uint64
 	developerPercentage              float64
+	developerPercentageOverrideMin   float64
+	developerPercentageOverrideMax   float64
 	genesisTotalSupply               *big.Int
 	minInflation                     float64
 	yearSettings                     map[uint32]*config.YearSetting
 	mutYearSettings                  sync.RWMutex
+	inflationSchedule                []*inflationSetting
 	flagPenalizedTooMuchGas          atomic.Flag
 	flagGasPriceModifier             atomic.Flag
 	penalizedTooMuchGasEnableEpoch   uint32
 	gasPriceModifierEnableEpoch      uint32
-	topUpGradientPoint               *big.Int
-	topUpFactor                      float64
+	topUpSettings                    []*topUpSetting
+	mutTopUpSettings                 sync.RWMutex
+	currentTopUpSetting              *topUpSetting
 }
 
 // ArgsNewEconomicsData defines the arguments needed for new economics economicsData
@@ -72,9 +108,19 @@ func NewEconomicsData(args ArgsNewEconomicsData) (*economicsData, error) {
 		return nil, process.ErrNilEpochNotifier
 	}
 
-	topUpGradientPoint, ok := big.NewInt(0).SetString(args.Economics.RewardsSettings.TopUpGradientPoint, 10)
-	if !ok {
-		return nil, process.ErrInvalidRewardsTopUpGradientPoint
+	topUpSettings, err := createTopUpSettings(args.Economics.RewardsSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPriceModifierSettings, err := createGasPriceModifierSettings(args.Economics.FeeSettings, args.GasPriceModifierEnableEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	inflationSchedule, err := createInflationSchedule(args.Economics.GlobalSettings, convertedData.genesisTotalSupply)
+	if err != nil {
+		return nil, err
 	}
 
 	ed := &economicsData{
@@ -87,15 +133,21 @@ func NewEconomicsData(args ArgsNewEconomicsData) (*economicsData, error) {
 		minGasLimit:                      convertedData.minGasLimit,
 		gasPerDataByte:                   convertedData.gasPerDataByte,
 		developerPercentage:              args.Economics.RewardsSettings.DeveloperPercentage,
+		developerPercentageOverrideMin:   args.Economics.RewardsSettings.DeveloperPercentageOverrideMin,
+		developerPercentageOverrideMax:   args.Economics.RewardsSettings.DeveloperPercentageOverrideMax,
 		minInflation:                     args.Economics.GlobalSettings.MinimumInflation,
 		genesisTotalSupply:               convertedData.genesisTotalSupply,
 		penalizedTooMuchGasEnableEpoch:   args.PenalizedTooMuchGasEnableEpoch,
 		gasPriceModifierEnableEpoch:      args.GasPriceModifierEnableEpoch,
-		gasPriceModifier:                 args.Economics.FeeSettings.GasPriceModifier,
-		topUpGradientPoint:               topUpGradientPoint,
-		topUpFactor:                      args.Economics.RewardsSettings.TopUpFactor,
+		gasPriceModifierSettings:         gasPriceModifierSettings,
+		currentGasPriceModifier:          gasPriceModifierSettings[0],
+		topUpSettings:                    topUpSettings,
+		currentTopUpSetting:              topUpSettings[0],
+		inflationSchedule:                inflationSchedule,
 	}
 
+	ed.protocolSustainabilityAddresses = createProtocolSustainabilityAddresses(args.Economics.RewardsSettings)
+
 	ed.yearSettings = make(map[uint32]*config.YearSetting)
 	for _, yearSetting := range args.Economics.GlobalSettings.YearSettings {
 		ed.yearSettings[yearSetting.Year] = &config.YearSetting{
@@ -153,6 +205,173 @@ func convertValues(economics *config.EconomicsConfig) (*economicsData, error) {
 	}, nil
 }
 
+func checkProtocolSustainabilityAddresses(rewardsSettings config.RewardsSettings) error {
+	// the single address is still needed for the epochs before the weighted addresses activation
+	if len(rewardsSettings.ProtocolSustainabilityAddress) == 0 {
+		return process.ErrNilProtocolSustainabilityAddress
+	}
+
+	for _, addressConfig := range rewardsSettings.ProtocolSustainabilityAddresses {
+		if len(addressConfig.Address) == 0 {
+			return process.ErrNilProtocolSustainabilityAddress
+		}
+		if addressConfig.Weight == 0 {
+			return fmt.Errorf("%w for address %s", process.ErrInvalidProtocolSustainabilityWeight, addressConfig.Address)
+		}
+	}
+
+	return nil
+}
+
+func createProtocolSustainabilityAddresses(rewardsSettings config.RewardsSettings) []config.ProtocolSustainabilityAddressConfig {
+	if len(rewardsSettings.ProtocolSustainabilityAddresses) == 0 {
+		return []config.ProtocolSustainabilityAddressConfig{
+			{
+				Address: rewardsSettings.ProtocolSustainabilityAddress,
+				Weight:  1,
+			},
+		}
+	}
+
+	return append(make([]config.ProtocolSustainabilityAddressConfig, 0, len(rewardsSettings.ProtocolSustainabilityAddresses)),
+		rewardsSettings.ProtocolSustainabilityAddresses...)
+}
+
+// createTopUpSettings returns the top-up rewards settings sorted by their enable epoch. The first setting, enabled in
+// epoch 0, is created from the TopUpFactor and TopUpGradientPoint values
+func createTopUpSettings(rewardsSettings config.RewardsSettings) ([]*topUpSetting, error) {
+	genesisSetting := config.TopUpSetting{
+		EpochEnable:         0,
+		TopUpFactor:         rewardsSettings.TopUpFactor,
+		TopUpGradientPoint:  rewardsSettings.TopUpGradientPoint,
+		TopUpCurveSteepness: defaultTopUpCurveSteepness,
+	}
+	settingsConfig := append([]config.TopUpSetting{genesisSetting}, rewardsSettings.TopUpSettings...)
+
+	topUpSettings := make([]*topUpSetting, 0, len(settingsConfig))
+	enableEpochs := make(map[uint32]struct{})
+	for _, settingConfig := range settingsConfig {
+		_, exists := enableEpochs[settingConfig.EpochEnable]
+		if exists {
+			return nil, fmt.Errorf("%w, epoch %d", process.ErrDuplicatedTopUpSettingsEpoch, settingConfig.EpochEnable)
+		}
+		enableEpochs[settingConfig.EpochEnable] = struct{}{}
+
+		setting, err := createTopUpSetting(settingConfig)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the top up settings of epoch %d", err, settingConfig.EpochEnable)
+		}
+		topUpSettings = append(topUpSettings, setting)
+	}
+
+	sort.Slice(topUpSettings, func(i, j int) bool {
+		return topUpSettings[i].epochEnable < topUpSettings[j].epochEnable
+	})
+
+	return topUpSettings, nil
+}
+
+func createTopUpSetting(settingConfig config.TopUpSetting) (*topUpSetting, error) {
+	gradientPoint, ok := big.NewInt(0).SetString(settingConfig.TopUpGradientPoint, 10)
+	if !ok || gradientPoint.Sign() < 0 {
+		return nil, process.ErrInvalidRewardsTopUpGradientPoint
+	}
+	if isPercentageInvalid(settingConfig.TopUpFactor) {
+		return nil, process.ErrInvalidRewardsTopUpFactor
+	}
+	if settingConfig.TopUpCurveSteepness < epsilon {
+		return nil, process.ErrInvalidRewardsTopUpCurveSteepness
+	}
+
+	return &topUpSetting{
+		epochEnable:    settingConfig.EpochEnable,
+		factor:         settingConfig.TopUpFactor,
+		gradientPoint:  gradientPoint,
+		curveSteepness: settingConfig.TopUpCurveSteepness,
+	}, nil
+}
+
+// createGasPriceModifierSettings returns the gas price modifier settings sorted by their enable epoch. The first
+// setting, enabled in the gas price modifier enable epoch, is created from the GasPriceModifier value
+func createGasPriceModifierSettings(feeSettings config.FeeSettings, gasPriceModifierEnableEpoch uint32) ([]*gasPriceModifierSetting, error) {
+	firstSetting := config.GasPriceModifierSetting{
+		EpochEnable:      gasPriceModifierEnableEpoch,
+		GasPriceModifier: feeSettings.GasPriceModifier,
+	}
+	settingsConfig := append([]config.GasPriceModifierSetting{firstSetting}, feeSettings.GasPriceModifierSettings...)
+
+	settings := make([]*gasPriceModifierSetting, 0, len(settingsConfig))
+	enableEpochs := make(map[uint32]struct{})
+	for _, settingConfig := range settingsConfig {
+		_, exists := enableEpochs[settingConfig.EpochEnable]
+		if exists {
+			return nil, fmt.Errorf("%w, epoch %d", process.ErrDuplicatedGasPriceModifierEpoch, settingConfig.EpochEnable)
+		}
+		enableEpochs[settingConfig.EpochEnable] = struct{}{}
+
+		if settingConfig.EpochEnable < gasPriceModifierEnableEpoch {
+			return nil, fmt.Errorf("%w, epoch %d is lower than the gas price modifier enable epoch %d",
+				process.ErrInvalidGasModifier, settingConfig.EpochEnable, gasPriceModifierEnableEpoch)
+		}
+		if settingConfig.GasPriceModifier > 1.0 || settingConfig.GasPriceModifier < epsilon {
+			return nil, fmt.Errorf("%w for epoch %d", process.ErrInvalidGasModifier, settingConfig.EpochEnable)
+		}
+
+		settings = append(settings, &gasPriceModifierSetting{
+			epochEnable:      settingConfig.EpochEnable,
+			gasPriceModifier: settingConfig.GasPriceModifier,
+		})
+	}
+
+	sort.Slice(settings, func(i, j int) bool {
+		return settings[i].epochEnable < settings[j].epochEnable
+	})
+
+	return settings, nil
+}
+
+// createInflationSchedule validates and converts the configured inflation schedule. The schedule is complete if it
+// starts with epoch 0 and monotonic if the start epochs are strictly increasing. A total supply cap that is not set
+// keeps the cap of the previous setting
+func createInflationSchedule(globalSettings config.GlobalSettings, genesisTotalSupply *big.Int) ([]*inflationSetting, error) {
+	if len(globalSettings.InflationSchedule) == 0 {
+		return nil, nil
+	}
+	if globalSettings.InflationSchedule[0].StartEpoch != 0 {
+		return nil, fmt.Errorf("%w, the first start epoch is %d instead of 0",
+			process.ErrIncompleteInflationSchedule, globalSettings.InflationSchedule[0].StartEpoch)
+	}
+
+	var totalSupplyCap *big.Int
+	schedule := make([]*inflationSetting, 0, len(globalSettings.InflationSchedule))
+	for i, settingConfig := range globalSettings.InflationSchedule {
+		if i > 0 && settingConfig.StartEpoch <= globalSettings.InflationSchedule[i-1].StartEpoch {
+			return nil, fmt.Errorf("%w, start epoch %d is not greater than %d", process.ErrNonMonotonicInflationSchedule,
+				settingConfig.StartEpoch, globalSettings.InflationSchedule[i-1].StartEpoch)
+		}
+		if isPercentageInvalid(settingConfig.MaximumInflation) {
+			return nil, fmt.Errorf("%w for start epoch %d", process.ErrInvalidInflationPercentages, settingConfig.StartEpoch)
+		}
+
+		if len(settingConfig.TotalSupplyCap) > 0 {
+			supplyCap, ok := big.NewInt(0).SetString(settingConfig.TotalSupplyCap, 10)
+			if !ok || supplyCap.Cmp(genesisTotalSupply) < 0 {
+				return nil, fmt.Errorf("%w for start epoch %d, the cap should not be lower than the genesis total supply",
+					process.ErrInvalidTotalSupplyCap, settingConfig.StartEpoch)
+			}
+			totalSupplyCap = supplyCap
+		}
+
+		schedule = append(schedule, &inflationSetting{
+			startEpoch:       settingConfig.StartEpoch,
+			maximumInflation: settingConfig.MaximumInflation,
+			totalSupplyCap:   totalSupplyCap,
+		})
+	}
+
+	return schedule, nil
+}
+
 func checkValues(economics *config.EconomicsConfig) error {
 	if isPercentageInvalid(economics.RewardsSettings.LeaderPercentage) ||
 		isPercentageInvalid(economics.RewardsSettings.DeveloperPercentage) ||
@@ -168,8 +387,14 @@ func checkValues(economics *config.EconomicsConfig) error {
 		}
 	}
 
-	if len(economics.RewardsSettings.ProtocolSustainabilityAddress) == 0 {
-		return process.ErrNilProtocolSustainabilityAddress
+	err := checkProtocolSustainabilityAddresses(economics.RewardsSettings)
+	if err != nil {
+		return err
+	}
+
+	err = checkDeveloperPercentageOverrideBounds(economics.RewardsSettings)
+	if err != nil {
+		return err
 	}
 
 	if economics.FeeSettings.GasPriceModifier > 1.0 || economics.FeeSettings.GasPriceModifier < epsilon {
@@ -179,6 +404,24 @@ func checkValues(economics *config.EconomicsConfig) error {
 	return nil
 }
 
+func checkDeveloperPercentageOverrideBounds(rewardsSettings config.RewardsSettings) error {
+	minOverride := rewardsSettings.DeveloperPercentageOverrideMin
+	maxOverride := rewardsSettings.DeveloperPercentageOverrideMax
+	if isPercentageInvalid(minOverride) || isPercentageInvalid(maxOverride) {
+		return process.ErrInvalidDeveloperPercentageOverrideBounds
+	}
+	if minOverride > maxOverride {
+		return fmt.Errorf("%w, min %f is greater than max %f",
+			process.ErrInvalidDeveloperPercentageOverrideBounds, minOverride, maxOverride)
+	}
+	if maxOverride > rewardsSettings.DeveloperPercentage {
+		return fmt.Errorf("%w, max %f is greater than the developer percentage %f",
+			process.ErrInvalidDeveloperPercentageOverrideBounds, maxOverride, rewardsSettings.DeveloperPercentage)
+	}
+
+	return nil
+}
+
 func isPercentageInvalid(percentage float64) bool {
 	isLessThanZero := percentage < 0.0
 	isGreaterThanOne := percentage > 1.0
@@ -211,6 +454,40 @@ func (ed *economicsData) MaxInflationRate(year uint32) float64 {
 	return yearSetting.MaximumInflation
 }
 
+// MaxInflationRateInEpoch will return the maximum inflation rate of the provided epoch from the inflation schedule.
+// Returns false if no inflation schedule was configured, in which case the yearly maximum inflation rates are used
+func (ed *economicsData) MaxInflationRateInEpoch(epoch uint32) (float64, bool) {
+	setting := ed.inflationSettingInEpoch(epoch)
+	if setting == nil {
+		return 0, false
+	}
+
+	return setting.maximumInflation, true
+}
+
+// TotalSupplyCapInEpoch will return the total supply cap of the provided epoch from the inflation schedule or nil if
+// the total supply is not capped
+func (ed *economicsData) TotalSupplyCapInEpoch(epoch uint32) *big.Int {
+	setting := ed.inflationSettingInEpoch(epoch)
+	if setting == nil || setting.totalSupplyCap == nil {
+		return nil
+	}
+
+	return big.NewInt(0).Set(setting.totalSupplyCap)
+}
+
+func (ed *economicsData) inflationSettingInEpoch(epoch uint32) *inflationSetting {
+	var setting *inflationSetting
+	for _, inflationSetting := range ed.inflationSchedule {
+		if inflationSetting.startEpoch > epoch {
+			break
+		}
+		setting = inflationSetting
+	}
+
+	return setting
+}
+
 // GenesisTotalSupply will return the genesis total supply
 func (ed *economicsData) GenesisTotalSupply() *big.Int {
 	return ed.genesisTotalSupply
@@ -233,7 +510,48 @@ func (ed *economicsData) GasPriceModifier() float64 {
 	if !ed.flagGasPriceModifier.IsSet() {
 		return 1.0
 	}
-	return ed.gasPriceModifier
+
+	ed.mutGasPriceModifier.RLock()
+	defer ed.mutGasPriceModifier.RUnlock()
+
+	return ed.currentGasPriceModifier.gasPriceModifier
+}
+
+// GasPriceModifierInEpoch will return the gas price modifier used in the provided epoch
+func (ed *economicsData) GasPriceModifierInEpoch(epoch uint32) float64 {
+	if epoch < ed.gasPriceModifierEnableEpoch {
+		return 1.0
+	}
+
+	return ed.gasPriceModifierSettingInEpoch(epoch).gasPriceModifier
+}
+
+func (ed *economicsData) gasPriceModifierSettingInEpoch(epoch uint32) *gasPriceModifierSetting {
+	setting := ed.gasPriceModifierSettings[0]
+	for _, gasPriceModifierSetting := range ed.gasPriceModifierSettings {
+		if gasPriceModifierSetting.epochEnable > epoch {
+			break
+		}
+		setting = gasPriceModifierSetting
+	}
+
+	return setting
+}
+
+func (ed *economicsData) currentFeeSettings() feeSettings {
+	return feeSettings{
+		gasPriceModifierEnabled:    ed.flagGasPriceModifier.IsSet(),
+		gasPriceModifier:           ed.GasPriceModifier(),
+		penalizedTooMuchGasEnabled: ed.flagPenalizedTooMuchGas.IsSet(),
+	}
+}
+
+func (ed *economicsData) feeSettingsInEpoch(epoch uint32) feeSettings {
+	return feeSettings{
+		gasPriceModifierEnabled:    epoch >= ed.gasPriceModifierEnableEpoch,
+		gasPriceModifier:           ed.GasPriceModifierInEpoch(epoch),
+		penalizedTooMuchGasEnabled: epoch >= ed.penalizedTooMuchGasEnableEpoch,
+	}
 }
 
 // MinGasLimit will return min gas limit
@@ -257,13 +575,27 @@ func (ed *economicsData) ComputeMoveBalanceFee(tx process.TransactionWithFeeHand
 
 // ComputeFeeForProcessing will compute the fee using the gas price modifier, the gas to use and the actual gas price
 func (ed *economicsData) ComputeFeeForProcessing(tx process.TransactionWithFeeHandler, gasToUse uint64) *big.Int {
-	gasPrice := ed.GasPriceForProcessing(tx)
+	return computeFeeForProcessing(tx, gasToUse, ed.GasPriceModifier())
+}
+
+// ComputeFeeForProcessingInEpoch will compute the fee using the gas price modifier of the provided epoch, the gas to
+// use and the actual gas price
+func (ed *economicsData) ComputeFeeForProcessingInEpoch(tx process.TransactionWithFeeHandler, gasToUse uint64, epoch uint32) *big.Int {
+	return computeFeeForProcessing(tx, gasToUse, ed.GasPriceModifierInEpoch(epoch))
+}
+
+func computeFeeForProcessing(tx process.TransactionWithFeeHandler, gasToUse uint64, gasPriceModifier float64) *big.Int {
+	gasPrice := computeGasPriceForProcessing(tx, gasPriceModifier)
 	return core.SafeMul(gasPrice, gasToUse)
 }
 
 // GasPriceForProcessing computes the price for the gas in addition to balance movement and data
 func (ed *economicsData) GasPriceForProcessing(tx process.TransactionWithFeeHandler) uint64 {
-	return uint64(float64(tx.GetGasPrice()) * ed.GasPriceModifier())
+	return computeGasPriceForProcessing(tx, ed.GasPriceModifier())
+}
+
+func computeGasPriceForProcessing(tx process.TransactionWithFeeHandler, gasPriceModifier float64) uint64 {
+	return uint64(float64(tx.GetGasPrice()) * gasPriceModifier)
 }
 
 // GasPriceForMove returns the gas price for transferring funds
@@ -278,9 +610,18 @@ func isSmartContractResult(tx process.TransactionWithFeeHandler) bool {
 
 // ComputeTxFee computes the provided transaction's fee using enable from epoch approach
 func (ed *economicsData) ComputeTxFee(tx process.TransactionWithFeeHandler) *big.Int {
-	if ed.flagGasPriceModifier.IsSet() {
+	return ed.computeTxFee(tx, ed.currentFeeSettings())
+}
+
+// ComputeTxFeeInEpoch computes the provided transaction's fee using the fee settings of the provided epoch
+func (ed *economicsData) ComputeTxFeeInEpoch(tx process.TransactionWithFeeHandler, epoch uint32) *big.Int {
+	return ed.computeTxFee(tx, ed.feeSettingsInEpoch(epoch))
+}
+
+func (ed *economicsData) computeTxFee(tx process.TransactionWithFeeHandler, settings feeSettings) *big.Int {
+	if settings.gasPriceModifierEnabled {
 		if isSmartContractResult(tx) {
-			return ed.ComputeFeeForProcessing(tx, tx.GetGasLimit())
+			return computeFeeForProcessing(tx, tx.GetGasLimit(), settings.gasPriceModifier)
 		}
 
 		gasLimitForMoveBalance, difference := ed.SplitTxGasInCategories(tx)
@@ -289,12 +630,12 @@ func (ed *economicsData) ComputeTxFee(tx process.TransactionWithFeeHandler) *big
 			return moveBalanceFee
 		}
 
-		extraFee := ed.ComputeFeeForProcessing(tx, difference)
+		extraFee := computeFeeForProcessing(tx, difference, settings.gasPriceModifier)
 		moveBalanceFee.Add(moveBalanceFee, extraFee)
 		return moveBalanceFee
 	}
 
-	if ed.flagPenalizedTooMuchGas.IsSet() {
+	if settings.penalizedTooMuchGasEnabled {
 		return core.SafeMul(tx.GetGasLimit(), tx.GetGasPrice())
 	}
 
@@ -359,6 +700,19 @@ func (ed *economicsData) DeveloperPercentage() float64 {
 	return ed.developerPercentage
 }
 
+// DeveloperPercentageWithOverride will return the developer percentage for a contract which has set the provided
+// percentage override, bounded by the configured override limits
+func (ed *economicsData) DeveloperPercentageWithOverride(percentageOverride float64) float64 {
+	if percentageOverride < ed.developerPercentageOverrideMin {
+		return ed.developerPercentageOverrideMin
+	}
+	if percentageOverride > ed.developerPercentageOverrideMax {
+		return ed.developerPercentageOverrideMax
+	}
+
+	return percentageOverride
+}
+
 // ProtocolSustainabilityPercentage will return the protocol sustainability percentage value
 func (ed *economicsData) ProtocolSustainabilityPercentage() float64 {
 	return ed.protocolSustainabilityPercentage
@@ -369,14 +723,54 @@ func (ed *economicsData) ProtocolSustainabilityAddress() string {
 	return ed.protocolSustainabilityAddress
 }
 
-// RewardsTopUpGradientPoint returns the rewards top-up gradient point
+// ProtocolSustainabilityAddresses will return the weighted protocol sustainability addresses. The protocol
+// sustainability address is returned with weight 1 if no weighted addresses were configured
+func (ed *economicsData) ProtocolSustainabilityAddresses() []config.ProtocolSustainabilityAddressConfig {
+	return append(make([]config.ProtocolSustainabilityAddressConfig, 0, len(ed.protocolSustainabilityAddresses)),
+		ed.protocolSustainabilityAddresses...)
+}
+
+// RewardsTopUpGradientPoint returns the rewards top-up gradient point of the current epoch
 func (ed *economicsData) RewardsTopUpGradientPoint() *big.Int {
-	return big.NewInt(0).Set(ed.topUpGradientPoint)
+	ed.mutTopUpSettings.RLock()
+	defer ed.mutTopUpSettings.RUnlock()
+
+	return big.NewInt(0).Set(ed.currentTopUpSetting.gradientPoint)
 }
 
-// RewardsTopUpFactor returns the rewards top-up factor
+// RewardsTopUpFactor returns the rewards top-up factor of the current epoch
 func (ed *economicsData) RewardsTopUpFactor() float64 {
-	return ed.topUpFactor
+	ed.mutTopUpSettings.RLock()
+	defer ed.mutTopUpSettings.RUnlock()
+
+	return ed.currentTopUpSetting.factor
+}
+
+// RewardsTopUpGradientPointInEpoch returns the rewards top-up gradient point used in the provided epoch
+func (ed *economicsData) RewardsTopUpGradientPointInEpoch(epoch uint32) *big.Int {
+	return big.NewInt(0).Set(ed.topUpSettingInEpoch(epoch).gradientPoint)
+}
+
+// RewardsTopUpFactorInEpoch returns the rewards top-up factor used in the provided epoch
+func (ed *economicsData) RewardsTopUpFactorInEpoch(epoch uint32) float64 {
+	return ed.topUpSettingInEpoch(epoch).factor
+}
+
+// RewardsTopUpCurveSteepnessInEpoch returns the steepness of the rewards top-up curve used in the provided epoch
+func (ed *economicsData) RewardsTopUpCurveSteepnessInEpoch(epoch uint32) float64 {
+	return ed.topUpSettingInEpoch(epoch).curveSteepness
+}
+
+func (ed *economicsData) topUpSettingInEpoch(epoch uint32) *topUpSetting {
+	setting := ed.topUpSettings[0]
+	for _, topUpSetting := range ed.topUpSettings {
+		if topUpSetting.epochEnable > epoch {
+			break
+		}
+		setting = topUpSetting
+	}
+
+	return setting
 }
 
 // ComputeGasLimit returns the gas limit need by the provided transaction in order to be executed
@@ -391,18 +785,36 @@ func (ed *economicsData) ComputeGasLimit(tx process.TransactionWithFeeHandler) u
 
 // ComputeGasUsedAndFeeBasedOnRefundValue will compute gas used value and transaction fee using refund value from a SCR
 func (ed *economicsData) ComputeGasUsedAndFeeBasedOnRefundValue(tx process.TransactionWithFeeHandler, refundValue *big.Int) (uint64, *big.Int) {
+	return ed.computeGasUsedAndFeeBasedOnRefundValue(tx, refundValue, ed.currentFeeSettings())
+}
+
+// ComputeGasUsedAndFeeBasedOnRefundValueInEpoch will compute gas used value and transaction fee using refund value
+// from a SCR and the fee settings of the epoch in which the transaction was executed
+func (ed *economicsData) ComputeGasUsedAndFeeBasedOnRefundValueInEpoch(
+	tx process.TransactionWithFeeHandler,
+	refundValue *big.Int,
+	epoch uint32,
+) (uint64, *big.Int) {
+	return ed.computeGasUsedAndFeeBasedOnRefundValue(tx, refundValue, ed.feeSettingsInEpoch(epoch))
+}
+
+func (ed *economicsData) computeGasUsedAndFeeBasedOnRefundValue(
+	tx process.TransactionWithFeeHandler,
+	refundValue *big.Int,
+	settings feeSettings,
+) (uint64, *big.Int) {
 	if refundValue.Cmp(big.NewInt(0)) == 0 {
-		txFee := ed.ComputeTxFee(tx)
+		txFee := ed.computeTxFee(tx, settings)
 		return tx.GetGasLimit(), txFee
 	}
 
-	txFee := big.NewInt(0).Sub(ed.ComputeTxFee(tx), refundValue)
+	txFee := big.NewInt(0).Sub(ed.computeTxFee(tx, settings), refundValue)
 
 	moveBalanceGasUnits := ed.ComputeGasLimit(tx)
 	moveBalanceFee := ed.ComputeMoveBalanceFee(tx)
 
 	scOpFee := big.NewInt(0).Sub(txFee, moveBalanceFee)
-	gasPriceForProcessing := big.NewInt(0).SetUint64(ed.GasPriceForProcessing(tx))
+	gasPriceForProcessing := big.NewInt(0).SetUint64(computeGasPriceForProcessing(tx, settings.gasPriceModifier))
 	scOpGasUnits := big.NewInt(0).Div(scOpFee, gasPriceForProcessing)
 
 	gasUsed := moveBalanceGasUnits + scOpGasUnits.Uint64()
@@ -412,14 +824,24 @@ func (ed *economicsData) ComputeGasUsedAndFeeBasedOnRefundValue(tx process.Trans
 
 // ComputeTxFeeBasedOnGasUsed will compute transaction fee
 func (ed *economicsData) ComputeTxFeeBasedOnGasUsed(tx process.TransactionWithFeeHandler, gasUsed uint64) *big.Int {
+	return ed.computeTxFeeBasedOnGasUsed(tx, gasUsed, ed.GasPriceModifier())
+}
+
+// ComputeTxFeeBasedOnGasUsedInEpoch will compute transaction fee using the gas price modifier of the epoch in which
+// the transaction was executed
+func (ed *economicsData) ComputeTxFeeBasedOnGasUsedInEpoch(tx process.TransactionWithFeeHandler, gasUsed uint64, epoch uint32) *big.Int {
+	return ed.computeTxFeeBasedOnGasUsed(tx, gasUsed, ed.GasPriceModifierInEpoch(epoch))
+}
+
+func (ed *economicsData) computeTxFeeBasedOnGasUsed(tx process.TransactionWithFeeHandler, gasUsed uint64, gasPriceModifier float64) *big.Int {
 	moveBalanceGasLimit := ed.ComputeGasLimit(tx)
 	moveBalanceFee := ed.ComputeMoveBalanceFee(tx)
 	if gasUsed <= moveBalanceGasLimit {
 		return moveBalanceFee
 	}
 
-	computeFeeForProcessing := ed.ComputeFeeForProcessing(tx, gasUsed-moveBalanceGasLimit)
-	txFee := big.NewInt(0).Add(moveBalanceFee, computeFeeForProcessing)
+	feeForProcessing := computeFeeForProcessing(tx, gasUsed-moveBalanceGasLimit, gasPriceModifier)
+	txFee := big.NewInt(0).Add(moveBalanceFee, feeForProcessing)
 
 	return txFee
 }
@@ -431,6 +853,45 @@ func (ed *economicsData) EpochConfirmed(epoch uint32) {
 
 	ed.flagGasPriceModifier.Toggle(epoch >= ed.gasPriceModifierEnableEpoch)
 	log.Debug("economics: gas price modifier", "enabled", ed.flagGasPriceModifier.IsSet())
+
+	ed.setGasPriceModifierForEpoch(epoch)
+	ed.setTopUpSettingForEpoch(epoch)
+}
+
+func (ed *economicsData) setGasPriceModifierForEpoch(epoch uint32) {
+	setting := ed.gasPriceModifierSettingInEpoch(epoch)
+
+	ed.mutGasPriceModifier.Lock()
+	defer ed.mutGasPriceModifier.Unlock()
+
+	if ed.currentGasPriceModifier == setting {
+		return
+	}
+
+	ed.currentGasPriceModifier = setting
+	log.Info("economics: gas price modifier changed",
+		"epoch", epoch,
+		"gas price modifier", setting.gasPriceModifier,
+	)
+}
+
+func (ed *economicsData) setTopUpSettingForEpoch(epoch uint32) {
+	setting := ed.topUpSettingInEpoch(epoch)
+
+	ed.mutTopUpSettings.Lock()
+	defer ed.mutTopUpSettings.Unlock()
+
+	if ed.currentTopUpSetting == setting {
+		return
+	}
+
+	ed.currentTopUpSetting = setting
+	log.Info("economics: top up rewards settings changed",
+		"epoch", epoch,
+		"top up factor", setting.factor,
+		"top up gradient point", setting.gradientPoint.String(),
+		"top up curve steepness", setting.curveSteepness,
+	)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
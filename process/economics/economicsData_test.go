@@ -1,6 +1,7 @@
 package economics_test
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -196,6 +197,365 @@ func TestEconomicsData_LeaderPercentage(t *testing.T) {
 	assert.Equal(t, leaderPercentage, value)
 }
 
+func TestNewEconomicsData_InvalidProtocolSustainabilityWeightShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForEconomicsData(1)
+	args.Economics.RewardsSettings.ProtocolSustainabilityAddresses = []config.ProtocolSustainabilityAddressConfig{
+		{Address: "erd1932eft30w753xyvme8d49qejgkjc09n5e49w4mwdjtm0neld797su0dlxp", Weight: 0},
+	}
+
+	_, err := economics.NewEconomicsData(args)
+	assert.True(t, errors.Is(err, process.ErrInvalidProtocolSustainabilityWeight))
+}
+
+func TestEconomicsData_ProtocolSustainabilityAddresses(t *testing.T) {
+	t.Parallel()
+
+	t.Run("single address", func(t *testing.T) {
+		args := createArgsForEconomicsData(1)
+		economicsData, _ := economics.NewEconomicsData(args)
+
+		expected := []config.ProtocolSustainabilityAddressConfig{
+			{Address: args.Economics.RewardsSettings.ProtocolSustainabilityAddress, Weight: 1},
+		}
+		assert.Equal(t, expected, economicsData.ProtocolSustainabilityAddresses())
+	})
+	t.Run("weighted addresses without the single address should error", func(t *testing.T) {
+		args := createArgsForEconomicsData(1)
+		args.Economics.RewardsSettings.ProtocolSustainabilityAddress = ""
+		args.Economics.RewardsSettings.ProtocolSustainabilityAddresses = []config.ProtocolSustainabilityAddressConfig{
+			{Address: "erd1932eft30w753xyvme8d49qejgkjc09n5e49w4mwdjtm0neld797su0dlxp", Weight: 3},
+		}
+		_, err := economics.NewEconomicsData(args)
+		assert.Equal(t, process.ErrNilProtocolSustainabilityAddress, err)
+	})
+	t.Run("weighted addresses", func(t *testing.T) {
+		args := createArgsForEconomicsData(1)
+		weightedAddresses := []config.ProtocolSustainabilityAddressConfig{
+			{Address: "erd1932eft30w753xyvme8d49qejgkjc09n5e49w4mwdjtm0neld797su0dlxp", Weight: 3},
+			{Address: "erd1j25xk97yf820rgdp3mj5scavhjkn6tjyn0t63pmv5qyjj7wxlcfqqe2rw5", Weight: 1},
+		}
+		args.Economics.RewardsSettings.ProtocolSustainabilityAddresses = weightedAddresses
+		economicsData, err := economics.NewEconomicsData(args)
+		require.Nil(t, err)
+
+		assert.Equal(t, weightedAddresses, economicsData.ProtocolSustainabilityAddresses())
+	})
+}
+
+func TestNewEconomicsData_InvalidDeveloperPercentageOverrideBoundsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid percentage", func(t *testing.T) {
+		args := createArgsForEconomicsData(1)
+		args.Economics.RewardsSettings.DeveloperPercentageOverrideMin = -0.1
+
+		_, err := economics.NewEconomicsData(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidDeveloperPercentageOverrideBounds))
+	})
+	t.Run("min greater than max", func(t *testing.T) {
+		args := createArgsForEconomicsData(1)
+		args.Economics.RewardsSettings.DeveloperPercentage = 0.3
+		args.Economics.RewardsSettings.DeveloperPercentageOverrideMin = 0.2
+		args.Economics.RewardsSettings.DeveloperPercentageOverrideMax = 0.1
+
+		_, err := economics.NewEconomicsData(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidDeveloperPercentageOverrideBounds))
+	})
+	t.Run("max greater than developer percentage", func(t *testing.T) {
+		args := createArgsForEconomicsData(1)
+		args.Economics.RewardsSettings.DeveloperPercentage = 0.3
+		args.Economics.RewardsSettings.DeveloperPercentageOverrideMax = 0.4
+
+		_, err := economics.NewEconomicsData(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidDeveloperPercentageOverrideBounds))
+	})
+}
+
+func TestEconomicsData_DeveloperPercentageWithOverride(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForEconomicsData(1)
+	args.Economics.RewardsSettings.DeveloperPercentage = 0.3
+	args.Economics.RewardsSettings.DeveloperPercentageOverrideMin = 0.05
+	args.Economics.RewardsSettings.DeveloperPercentageOverrideMax = 0.2
+	economicsData, err := economics.NewEconomicsData(args)
+	require.Nil(t, err)
+
+	assert.Equal(t, 0.05, economicsData.DeveloperPercentageWithOverride(0))
+	assert.Equal(t, 0.1, economicsData.DeveloperPercentageWithOverride(0.1))
+	assert.Equal(t, 0.2, economicsData.DeveloperPercentageWithOverride(0.3))
+	assert.Equal(t, 0.3, economicsData.DeveloperPercentage())
+}
+
+func TestNewEconomicsData_InvalidTopUpSettingsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid gradient point", func(t *testing.T) {
+		args := createArgsForEconomicsData(1)
+		args.Economics.RewardsSettings.TopUpSettings = []config.TopUpSetting{
+			{EpochEnable: 2, TopUpFactor: 0.3, TopUpGradientPoint: "-1", TopUpCurveSteepness: 1},
+		}
+
+		_, err := economics.NewEconomicsData(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidRewardsTopUpGradientPoint))
+	})
+	t.Run("invalid top up factor", func(t *testing.T) {
+		args := createArgsForEconomicsData(1)
+		args.Economics.RewardsSettings.TopUpSettings = []config.TopUpSetting{
+			{EpochEnable: 2, TopUpFactor: 1.1, TopUpGradientPoint: "100", TopUpCurveSteepness: 1},
+		}
+
+		_, err := economics.NewEconomicsData(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidRewardsTopUpFactor))
+	})
+	t.Run("invalid curve steepness", func(t *testing.T) {
+		args := createArgsForEconomicsData(1)
+		args.Economics.RewardsSettings.TopUpSettings = []config.TopUpSetting{
+			{EpochEnable: 2, TopUpFactor: 0.3, TopUpGradientPoint: "100", TopUpCurveSteepness: 0},
+		}
+
+		_, err := economics.NewEconomicsData(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidRewardsTopUpCurveSteepness))
+	})
+	t.Run("duplicated epoch", func(t *testing.T) {
+		args := createArgsForEconomicsData(1)
+		args.Economics.RewardsSettings.TopUpSettings = []config.TopUpSetting{
+			{EpochEnable: 0, TopUpFactor: 0.3, TopUpGradientPoint: "100", TopUpCurveSteepness: 1},
+		}
+
+		_, err := economics.NewEconomicsData(args)
+		assert.True(t, errors.Is(err, process.ErrDuplicatedTopUpSettingsEpoch))
+	})
+}
+
+func TestEconomicsData_TopUpSettingsInEpoch(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForEconomicsData(1)
+	args.Economics.RewardsSettings.TopUpSettings = []config.TopUpSetting{
+		{EpochEnable: 10, TopUpFactor: 0.5, TopUpGradientPoint: "500", TopUpCurveSteepness: 3},
+		{EpochEnable: 5, TopUpFactor: 0.3, TopUpGradientPoint: "400", TopUpCurveSteepness: 2},
+	}
+	economicsData, err := economics.NewEconomicsData(args)
+	require.Nil(t, err)
+
+	genesisGradientPoint, _ := big.NewInt(0).SetString(args.Economics.RewardsSettings.TopUpGradientPoint, 10)
+	for _, epoch := range []uint32{0, 4} {
+		assert.Equal(t, 0.25, economicsData.RewardsTopUpFactorInEpoch(epoch))
+		assert.Equal(t, genesisGradientPoint, economicsData.RewardsTopUpGradientPointInEpoch(epoch))
+		assert.Equal(t, 1.0, economicsData.RewardsTopUpCurveSteepnessInEpoch(epoch))
+	}
+	for _, epoch := range []uint32{5, 9} {
+		assert.Equal(t, 0.3, economicsData.RewardsTopUpFactorInEpoch(epoch))
+		assert.Equal(t, big.NewInt(400), economicsData.RewardsTopUpGradientPointInEpoch(epoch))
+		assert.Equal(t, 2.0, economicsData.RewardsTopUpCurveSteepnessInEpoch(epoch))
+	}
+	for _, epoch := range []uint32{10, 100} {
+		assert.Equal(t, 0.5, economicsData.RewardsTopUpFactorInEpoch(epoch))
+		assert.Equal(t, big.NewInt(500), economicsData.RewardsTopUpGradientPointInEpoch(epoch))
+		assert.Equal(t, 3.0, economicsData.RewardsTopUpCurveSteepnessInEpoch(epoch))
+	}
+
+	assert.Equal(t, 0.25, economicsData.RewardsTopUpFactor())
+	economicsData.EpochConfirmed(7)
+	assert.Equal(t, 0.3, economicsData.RewardsTopUpFactor())
+	assert.Equal(t, big.NewInt(400), economicsData.RewardsTopUpGradientPoint())
+	economicsData.EpochConfirmed(12)
+	assert.Equal(t, 0.5, economicsData.RewardsTopUpFactor())
+	assert.Equal(t, big.NewInt(500), economicsData.RewardsTopUpGradientPoint())
+}
+
+func TestNewEconomicsData_InvalidGasPriceModifierSettingsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid gas price modifier", func(t *testing.T) {
+		args := createArgsForEconomicsData(1)
+		args.Economics.FeeSettings.GasPriceModifierSettings = []config.GasPriceModifierSetting{
+			{EpochEnable: 2, GasPriceModifier: 1.1},
+		}
+
+		_, err := economics.NewEconomicsData(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidGasModifier))
+	})
+	t.Run("epoch before the enable epoch", func(t *testing.T) {
+		args := createArgsForEconomicsData(1)
+		args.GasPriceModifierEnableEpoch = 3
+		args.Economics.FeeSettings.GasPriceModifierSettings = []config.GasPriceModifierSetting{
+			{EpochEnable: 2, GasPriceModifier: 0.5},
+		}
+
+		_, err := economics.NewEconomicsData(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidGasModifier))
+	})
+	t.Run("duplicated epoch", func(t *testing.T) {
+		args := createArgsForEconomicsData(1)
+		args.Economics.FeeSettings.GasPriceModifierSettings = []config.GasPriceModifierSetting{
+			{EpochEnable: 0, GasPriceModifier: 0.5},
+		}
+
+		_, err := economics.NewEconomicsData(args)
+		assert.True(t, errors.Is(err, process.ErrDuplicatedGasPriceModifierEpoch))
+	})
+}
+
+func TestEconomicsData_GasPriceModifierInEpoch(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForEconomicsData(0.5)
+	args.GasPriceModifierEnableEpoch = 2
+	args.Economics.FeeSettings.GasPriceModifierSettings = []config.GasPriceModifierSetting{
+		{EpochEnable: 10, GasPriceModifier: 0.1},
+		{EpochEnable: 5, GasPriceModifier: 0.25},
+	}
+	economicsData, err := economics.NewEconomicsData(args)
+	require.Nil(t, err)
+
+	expectedModifiers := map[uint32]float64{0: 1, 1: 1, 2: 0.5, 4: 0.5, 5: 0.25, 9: 0.25, 10: 0.1, 100: 0.1}
+	for epoch, expectedModifier := range expectedModifiers {
+		assert.Equal(t, expectedModifier, economicsData.GasPriceModifierInEpoch(epoch), "epoch %d", epoch)
+	}
+
+	assert.Equal(t, 1.0, economicsData.GasPriceModifier())
+	economicsData.EpochConfirmed(3)
+	assert.Equal(t, 0.5, economicsData.GasPriceModifier())
+	economicsData.EpochConfirmed(7)
+	assert.Equal(t, 0.25, economicsData.GasPriceModifier())
+	economicsData.EpochConfirmed(12)
+	assert.Equal(t, 0.1, economicsData.GasPriceModifier())
+}
+
+func TestEconomicsData_ComputeFeesInEpochAcrossGasPriceModifierChange(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForEconomicsData(0.5)
+	args.Economics.FeeSettings.GasPriceModifierSettings = []config.GasPriceModifierSetting{
+		{EpochEnable: 5, GasPriceModifier: 0.25},
+	}
+	economicsData, _ := economics.NewEconomicsData(args)
+	tx := &transaction.Transaction{
+		GasPrice: 1000000000,
+		GasLimit: 1000,
+		Data:     []byte("hello"),
+	}
+
+	// the transaction was executed in epoch 4 but its fee is computed after the modifier changed in epoch 5
+	economicsData.EpochConfirmed(5)
+	expectedFee := big.NewInt(505*1000000000 + 495*500000000)
+	assert.Equal(t, expectedFee, economicsData.ComputeTxFeeInEpoch(tx, 4))
+	assert.Equal(t, expectedFee, economicsData.ComputeTxFeeBasedOnGasUsedInEpoch(tx, tx.GasLimit, 4))
+
+	refundValue := big.NewInt(85 * 500000000)
+	gasUsed, fee := economicsData.ComputeGasUsedAndFeeBasedOnRefundValueInEpoch(tx, refundValue, 4)
+	assert.Equal(t, uint64(915), gasUsed)
+	assert.Equal(t, big.NewInt(0).Sub(expectedFee, refundValue), fee)
+
+	expectedFee = big.NewInt(505*1000000000 + 495*250000000)
+	assert.Equal(t, expectedFee, economicsData.ComputeTxFee(tx))
+	assert.Equal(t, expectedFee, economicsData.ComputeTxFeeInEpoch(tx, 5))
+	assert.Equal(t, expectedFee, economicsData.ComputeTxFeeBasedOnGasUsedInEpoch(tx, tx.GasLimit, 5))
+}
+
+func TestNewEconomicsData_InvalidInflationScheduleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("incomplete schedule", func(t *testing.T) {
+		args := createArgsForEconomicsData(1)
+		args.Economics.GlobalSettings.InflationSchedule = []config.InflationSetting{
+			{StartEpoch: 1, MaximumInflation: 0.1},
+		}
+
+		_, err := economics.NewEconomicsData(args)
+		assert.True(t, errors.Is(err, process.ErrIncompleteInflationSchedule))
+	})
+	t.Run("non monotonic schedule", func(t *testing.T) {
+		args := createArgsForEconomicsData(1)
+		args.Economics.GlobalSettings.InflationSchedule = []config.InflationSetting{
+			{StartEpoch: 0, MaximumInflation: 0.1},
+			{StartEpoch: 10, MaximumInflation: 0.08},
+			{StartEpoch: 10, MaximumInflation: 0.06},
+		}
+
+		_, err := economics.NewEconomicsData(args)
+		assert.True(t, errors.Is(err, process.ErrNonMonotonicInflationSchedule))
+	})
+	t.Run("invalid maximum inflation", func(t *testing.T) {
+		args := createArgsForEconomicsData(1)
+		args.Economics.GlobalSettings.InflationSchedule = []config.InflationSetting{
+			{StartEpoch: 0, MaximumInflation: 1.1},
+		}
+
+		_, err := economics.NewEconomicsData(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidInflationPercentages))
+	})
+	t.Run("total supply cap not a number", func(t *testing.T) {
+		args := createArgsForEconomicsData(1)
+		args.Economics.GlobalSettings.InflationSchedule = []config.InflationSetting{
+			{StartEpoch: 0, MaximumInflation: 0.1, TotalSupplyCap: "cap"},
+		}
+
+		_, err := economics.NewEconomicsData(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidTotalSupplyCap))
+	})
+	t.Run("total supply cap lower than genesis total supply", func(t *testing.T) {
+		args := createArgsForEconomicsData(1)
+		args.Economics.GlobalSettings.InflationSchedule = []config.InflationSetting{
+			{StartEpoch: 0, MaximumInflation: 0.1, TotalSupplyCap: "1000"},
+		}
+
+		_, err := economics.NewEconomicsData(args)
+		assert.True(t, errors.Is(err, process.ErrInvalidTotalSupplyCap))
+	})
+}
+
+func TestEconomicsData_InflationScheduleNotConfigured(t *testing.T) {
+	t.Parallel()
+
+	economicsData, _ := economics.NewEconomicsData(createArgsForEconomicsData(1))
+
+	maxInflation, ok := economicsData.MaxInflationRateInEpoch(5)
+	assert.False(t, ok)
+	assert.Equal(t, 0.0, maxInflation)
+	assert.Nil(t, economicsData.TotalSupplyCapInEpoch(5))
+}
+
+func TestEconomicsData_InflationScheduleInEpoch(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForEconomicsData(1)
+	args.Economics.GlobalSettings.InflationSchedule = []config.InflationSetting{
+		{StartEpoch: 0, MaximumInflation: 0.1},
+		{StartEpoch: 10, MaximumInflation: 0.08, TotalSupplyCap: "3000000000000000000000"},
+		{StartEpoch: 20, MaximumInflation: 0.05},
+	}
+	economicsData, err := economics.NewEconomicsData(args)
+	require.Nil(t, err)
+
+	expectedCap, _ := big.NewInt(0).SetString("3000000000000000000000", 10)
+	testData := []struct {
+		epoch          uint32
+		maxInflation   float64
+		totalSupplyCap *big.Int
+	}{
+		{epoch: 0, maxInflation: 0.1, totalSupplyCap: nil},
+		{epoch: 9, maxInflation: 0.1, totalSupplyCap: nil},
+		{epoch: 10, maxInflation: 0.08, totalSupplyCap: expectedCap},
+		{epoch: 20, maxInflation: 0.05, totalSupplyCap: expectedCap},
+		{epoch: 100, maxInflation: 0.05, totalSupplyCap: expectedCap},
+	}
+	for _, td := range testData {
+		maxInflation, ok := economicsData.MaxInflationRateInEpoch(td.epoch)
+		assert.True(t, ok)
+		assert.Equal(t, td.maxInflation, maxInflation, "epoch %d", td.epoch)
+		assert.Equal(t, td.totalSupplyCap, economicsData.TotalSupplyCapInEpoch(td.epoch), "epoch %d", td.epoch)
+	}
+
+	supplyCap := economicsData.TotalSupplyCapInEpoch(10)
+	supplyCap.SetUint64(0)
+	assert.Equal(t, expectedCap, economicsData.TotalSupplyCapInEpoch(10))
+}
+
 func TestEconomicsData_ComputeMoveBalanceFeeNoTxData(t *testing.T) {
 	t.Parallel()
 
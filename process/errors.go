@@ -557,6 +557,12 @@ var ErrSignedBlocksThresholdNotBetweenZeroAndOne = errors.New("signed blocks thr
 // ErrConsecutiveMissedBlocksPenaltyLowerThanOne signals that the ConsecutiveMissedBlocksPenalty is lower than 1
 var ErrConsecutiveMissedBlocksPenaltyLowerThanOne = errors.New("consecutive missed blocks penalty lower than 1")
 
+// ErrConsecutiveMissedSignaturesPenaltyLowerThanOne signals that the ConsecutiveMissedSignaturesPenalty is lower than 1
+var ErrConsecutiveMissedSignaturesPenaltyLowerThanOne = errors.New("consecutive missed signatures penalty lower than 1")
+
+// ErrInvalidProbationConfig signals that the probation settings are invalid
+var ErrInvalidProbationConfig = errors.New("invalid probation config")
+
 // ErrDecreaseRatingsStepMoreThanMinusOne signals that the decrease rating step has a vale greater than -1
 var ErrDecreaseRatingsStepMoreThanMinusOne = errors.New("decrease rating step has a value greater than -1")
 
@@ -656,6 +662,9 @@ var ErrDevFeesInEpochDoNotMatch = errors.New("developer fees in epoch do not mat
 // ErrNilRewardsHandler signals that rewards handler is nil
 var ErrNilRewardsHandler = errors.New("rewards handler is nil")
 
+// ErrNilProposerIdentifier signals that a nil proposer identifier has been provided
+var ErrNilProposerIdentifier = errors.New("nil proposer identifier")
+
 // ErrNilEpochEconomics signals that nil end of epoch econimics was provided
 var ErrNilEpochEconomics = errors.New("nil epoch economics")
 
@@ -746,6 +755,9 @@ var ErrNilDnsAddresses = errors.New("nil dns addresses map")
 // ErrNilProtocolSustainabilityAddress signals that a nil protocol sustainability address was provided
 var ErrNilProtocolSustainabilityAddress = errors.New("nil protocol sustainability address")
 
+// ErrInvalidProtocolSustainabilityWeight signals that an invalid protocol sustainability address weight was provided
+var ErrInvalidProtocolSustainabilityWeight = errors.New("invalid protocol sustainability address weight")
+
 // ErrCallerIsNotTheDNSAddress signals that called address is not the DNS address
 var ErrCallerIsNotTheDNSAddress = errors.New("not a dns address")
 
@@ -836,6 +848,12 @@ var ErrInvalidBadPeerThreshold = errors.New("invalid bad peer threshold")
 // ErrNilPeerValidatorMapper signals that nil peer validator mapper has been provided
 var ErrNilPeerValidatorMapper = errors.New("nil peer validator mapper")
 
+// ErrNilWebSocketPeersChecker signals that a nil web socket peers checker has been provided
+var ErrNilWebSocketPeersChecker = errors.New("nil web socket peers checker")
+
+// ErrNilFloodPreventer signals that a nil flood preventer has been provided
+var ErrNilFloodPreventer = errors.New("nil flood preventer")
+
 // ErrOnlyValidatorsCanUseThisTopic signals that topic can be used by validator only
 var ErrOnlyValidatorsCanUseThisTopic = errors.New("only validators can use this topic")
 
@@ -854,6 +872,9 @@ var ErrNilIndexer = errors.New("indexer is nil")
 // ErrNilTpsBenchmark signals that tps benchmark object is nil
 var ErrNilTpsBenchmark = errors.New("tps benchmark object is nil")
 
+// ErrNilCrossShardStatistics signals that a nil cross shard statistics handler has been provided
+var ErrNilCrossShardStatistics = errors.New("nil cross shard statistics handler")
+
 // ErrSmartContractDeploymentIsDisabled signals that smart contract deployment was disabled
 var ErrSmartContractDeploymentIsDisabled = errors.New("smart Contract deployment is disabled")
 
@@ -940,3 +961,51 @@ var ErrNilOrEmptyList = errors.New("nil or empty provided list")
 
 // ErrNilScQueryElement signals that a nil sc query service element was provided
 var ErrNilScQueryElement = errors.New("nil SC query service element")
+
+// ErrInvalidRewardsTopUpFactor signals that the top up factor is invalid
+var ErrInvalidRewardsTopUpFactor = errors.New("rewards top up factor is invalid")
+
+// ErrInvalidRewardsTopUpCurveSteepness signals that the top up curve steepness is invalid
+var ErrInvalidRewardsTopUpCurveSteepness = errors.New("rewards top up curve steepness is invalid")
+
+// ErrInvalidDeveloperPercentageOverrideBounds signals that the developer percentage override bounds are not correct
+var ErrInvalidDeveloperPercentageOverrideBounds = errors.New("invalid developer percentage override bounds")
+
+// ErrInvalidDeveloperRewardsPercentage signals that the provided developer rewards percentage is not allowed
+var ErrInvalidDeveloperRewardsPercentage = errors.New("invalid developer rewards percentage")
+
+// ErrDeveloperRewardsOverrideIsDisabled signals that the developer rewards percentage override is not enabled yet
+var ErrDeveloperRewardsOverrideIsDisabled = errors.New("developer rewards percentage override is disabled")
+
+// ErrDuplicatedGasPriceModifierEpoch signals that several gas price modifiers are enabled in the same epoch
+var ErrDuplicatedGasPriceModifierEpoch = errors.New("duplicated gas price modifier epoch")
+
+// ErrDuplicatedTopUpSettingsEpoch signals that several top up settings are enabled in the same epoch
+var ErrDuplicatedTopUpSettingsEpoch = errors.New("duplicated top up settings epoch")
+
+// ErrIncompleteInflationSchedule signals that the inflation schedule does not start with the genesis epoch
+var ErrIncompleteInflationSchedule = errors.New("incomplete inflation schedule")
+
+// ErrNonMonotonicInflationSchedule signals that the start epochs of the inflation schedule are not strictly increasing
+var ErrNonMonotonicInflationSchedule = errors.New("non monotonic inflation schedule")
+
+// ErrInvalidTotalSupplyCap signals that an invalid total supply cap has been provided
+var ErrInvalidTotalSupplyCap = errors.New("invalid total supply cap")
+
+// ErrHistoricalQueriesNotEnabled signals that a query on a past block was requested but the node does not support it
+var ErrHistoricalQueriesNotEnabled = errors.New("historical queries are not enabled on this node")
+
+// ErrBlockNonceHashMismatch signals that the provided block nonce does not match the nonce of the provided block hash
+var ErrBlockNonceHashMismatch = errors.New("block nonce and block hash mismatch")
+
+// ErrNilTxSimulator signals that a nil transaction simulator has been provided
+var ErrNilTxSimulator = errors.New("nil transaction simulator")
+
+// ErrTransactionSimulationFailed signals that the simulated execution of a transaction failed
+var ErrTransactionSimulationFailed = errors.New("transaction simulation failed")
+
+// ErrNilChargingEpochHandler signals that a nil charging epoch handler has been provided
+var ErrNilChargingEpochHandler = errors.New("nil charging epoch handler")
+
+// ErrInvalidChargingEpoch signals that a miniblock carries an invalid charging epoch
+var ErrInvalidChargingEpoch = errors.New("invalid charging epoch")
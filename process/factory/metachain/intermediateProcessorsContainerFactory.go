@@ -14,12 +14,13 @@ import (
 )
 
 type intermediateProcessorsContainerFactory struct {
-	shardCoordinator sharding.Coordinator
-	marshalizer      marshal.Marshalizer
-	hasher           hashing.Hasher
-	pubkeyConverter  core.PubkeyConverter
-	store            dataRetriever.StorageService
-	poolsHolder      dataRetriever.PoolsHolder
+	shardCoordinator     sharding.Coordinator
+	marshalizer          marshal.Marshalizer
+	hasher               hashing.Hasher
+	pubkeyConverter      core.PubkeyConverter
+	store                dataRetriever.StorageService
+	poolsHolder          dataRetriever.PoolsHolder
+	chargingEpochHandler process.ChargingEpochHandler
 }
 
 // NewIntermediateProcessorsContainerFactory is responsible for creating a new intermediate processors factory object
@@ -30,6 +31,7 @@ func NewIntermediateProcessorsContainerFactory(
 	pubkeyConverter core.PubkeyConverter,
 	store dataRetriever.StorageService,
 	poolsHolder dataRetriever.PoolsHolder,
+	chargingEpochHandler process.ChargingEpochHandler,
 ) (*intermediateProcessorsContainerFactory, error) {
 
 	if check.IfNil(shardCoordinator) {
@@ -50,14 +52,18 @@ func NewIntermediateProcessorsContainerFactory(
 	if check.IfNil(poolsHolder) {
 		return nil, process.ErrNilPoolsHolder
 	}
+	if check.IfNil(chargingEpochHandler) {
+		return nil, process.ErrNilChargingEpochHandler
+	}
 
 	return &intermediateProcessorsContainerFactory{
-		shardCoordinator: shardCoordinator,
-		marshalizer:      marshalizer,
-		hasher:           hasher,
-		pubkeyConverter:  pubkeyConverter,
-		poolsHolder:      poolsHolder,
-		store:            store,
+		shardCoordinator:     shardCoordinator,
+		marshalizer:          marshalizer,
+		hasher:               hasher,
+		pubkeyConverter:      pubkeyConverter,
+		poolsHolder:          poolsHolder,
+		store:                store,
+		chargingEpochHandler: chargingEpochHandler,
 	}, nil
 }
 
@@ -97,6 +103,7 @@ func (ppcm *intermediateProcessorsContainerFactory) createSmartContractResultsIn
 		ppcm.store,
 		block.SmartContractResultBlock,
 		ppcm.poolsHolder.CurrentBlockTxs(),
+		ppcm.chargingEpochHandler,
 	)
 
 	return irp, err
@@ -32,6 +32,7 @@ type preProcessorsContainerFactory struct {
 	pubkeyConverter      core.PubkeyConverter
 	blockSizeComputation preprocess.BlockSizeComputationHandler
 	balanceComputation   preprocess.BalanceComputationHandler
+	chargingEpochHandler process.ChargingEpochHandler
 }
 
 // NewPreProcessorsContainerFactory is responsible for creating a new preProcessors factory object
@@ -51,6 +52,7 @@ func NewPreProcessorsContainerFactory(
 	pubkeyConverter core.PubkeyConverter,
 	blockSizeComputation preprocess.BlockSizeComputationHandler,
 	balanceComputation preprocess.BalanceComputationHandler,
+	chargingEpochHandler process.ChargingEpochHandler,
 ) (*preProcessorsContainerFactory, error) {
 
 	if check.IfNil(shardCoordinator) {
@@ -98,6 +100,9 @@ func NewPreProcessorsContainerFactory(
 	if check.IfNil(balanceComputation) {
 		return nil, process.ErrNilBalanceComputationHandler
 	}
+	if check.IfNil(chargingEpochHandler) {
+		return nil, process.ErrNilChargingEpochHandler
+	}
 
 	return &preProcessorsContainerFactory{
 		shardCoordinator:     shardCoordinator,
@@ -115,6 +120,7 @@ func NewPreProcessorsContainerFactory(
 		pubkeyConverter:      pubkeyConverter,
 		blockSizeComputation: blockSizeComputation,
 		balanceComputation:   balanceComputation,
+		chargingEpochHandler: chargingEpochHandler,
 	}, nil
 }
 
@@ -162,6 +168,7 @@ func (ppcm *preProcessorsContainerFactory) createTxPreProcessor() (process.PrePr
 		ppcm.pubkeyConverter,
 		ppcm.blockSizeComputation,
 		ppcm.balanceComputation,
+		ppcm.chargingEpochHandler,
 	)
 
 	return txPreprocessor, err
@@ -182,6 +189,7 @@ func (ppcm *preProcessorsContainerFactory) createSmartContractResultPreProcessor
 		ppcm.pubkeyConverter,
 		ppcm.blockSizeComputation,
 		ppcm.balanceComputation,
+		ppcm.chargingEpochHandler,
 	)
 
 	return scrPreprocessor, err
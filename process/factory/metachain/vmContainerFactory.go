@@ -31,12 +31,14 @@ type vmContainerFactory struct {
 	economics              process.EconomicsDataHandler
 	messageSigVerifier     vm.MessageSignVerifier
 	nodesConfigProvider    vm.NodesConfigProvider
+	nodesCoordinator       vm.NodesCoordinator
 	gasSchedule            core.GasScheduleNotifier
 	hasher                 hashing.Hasher
 	marshalizer            marshal.Marshalizer
 	systemSCConfig         *config.SystemSmartContractsConfig
 	epochNotifier          process.EpochNotifier
 	addressPubKeyConverter core.PubkeyConverter
+	roundDurationChange    vm.RoundDurationChange
 }
 
 // ArgsNewVMContainerFactory defines the arguments needed to create a new VM container factory
@@ -46,12 +48,14 @@ type ArgsNewVMContainerFactory struct {
 	MessageSignVerifier vm.MessageSignVerifier
 	GasSchedule         core.GasScheduleNotifier
 	NodesConfigProvider vm.NodesConfigProvider
+	NodesCoordinator    vm.NodesCoordinator
 	Hasher              hashing.Hasher
 	Marshalizer         marshal.Marshalizer
 	SystemSCConfig      *config.SystemSmartContractsConfig
 	ValidatorAccountsDB state.AccountsAdapter
 	ChanceComputer      sharding.ChanceComputer
 	EpochNotifier       process.EpochNotifier
+	RoundDurationChange vm.RoundDurationChange
 }
 
 // NewVMContainerFactory is responsible for creating a new virtual machine factory object
@@ -65,6 +69,9 @@ func NewVMContainerFactory(args ArgsNewVMContainerFactory) (*vmContainerFactory,
 	if check.IfNil(args.NodesConfigProvider) {
 		return nil, process.ErrNilNodesConfigProvider
 	}
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, process.ErrNilNodesCoordinator
+	}
 	if check.IfNil(args.Hasher) {
 		return nil, process.ErrNilHasher
 	}
@@ -100,6 +107,7 @@ func NewVMContainerFactory(args ArgsNewVMContainerFactory) (*vmContainerFactory,
 		messageSigVerifier:     args.MessageSignVerifier,
 		gasSchedule:            args.GasSchedule,
 		nodesConfigProvider:    args.NodesConfigProvider,
+		nodesCoordinator:       args.NodesCoordinator,
 		hasher:                 args.Hasher,
 		marshalizer:            args.Marshalizer,
 		systemSCConfig:         args.SystemSCConfig,
@@ -107,6 +115,7 @@ func NewVMContainerFactory(args ArgsNewVMContainerFactory) (*vmContainerFactory,
 		chanceComputer:         args.ChanceComputer,
 		epochNotifier:          args.EpochNotifier,
 		addressPubKeyConverter: args.ArgBlockChainHook.PubkeyConv,
+		roundDurationChange:    args.RoundDurationChange,
 	}, nil
 }
 
@@ -165,12 +174,14 @@ func (vmf *vmContainerFactory) createSystemVMFactoryAndEEI() (vm.SystemSCContain
 		SigVerifier:            vmf.messageSigVerifier,
 		GasSchedule:            vmf.gasSchedule,
 		NodesConfigProvider:    vmf.nodesConfigProvider,
+		NodesCoordinator:       vmf.nodesCoordinator,
 		Hasher:                 vmf.hasher,
 		Marshalizer:            vmf.marshalizer,
 		SystemSCConfig:         vmf.systemSCConfig,
 		Economics:              vmf.economics,
 		EpochNotifier:          vmf.epochNotifier,
 		AddressPubKeyConverter: vmf.addressPubKeyConverter,
+		RoundDurationChange:    vmf.roundDurationChange,
 	}
 	scFactory, err := systemVMFactory.NewSystemSCFactory(argsNewSystemScFactory)
 	if err != nil {
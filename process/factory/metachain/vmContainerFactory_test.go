@@ -50,6 +50,7 @@ func TestNewVMContainerFactory_OkValues(t *testing.T) {
 		MessageSignVerifier: &mock.MessageSignVerifierMock{},
 		GasSchedule:         gasSchedule,
 		NodesConfigProvider: &mock.NodesConfigProviderStub{},
+		NodesCoordinator:    &mock.NodesCoordinatorMock{},
 		Hasher:              &mock.HasherMock{},
 		Marshalizer:         &mock.MarshalizerMock{},
 		SystemSCConfig: &config.SystemSmartContractsConfig{
@@ -132,6 +133,7 @@ func TestVmContainerFactory_Create(t *testing.T) {
 		MessageSignVerifier: &mock.MessageSignVerifierMock{},
 		GasSchedule:         makeGasSchedule(),
 		NodesConfigProvider: &mock.NodesConfigProviderStub{},
+		NodesCoordinator:    &mock.NodesCoordinatorMock{},
 		Hasher:              &mock.HasherMock{},
 		Marshalizer:         &mock.MarshalizerMock{},
 		SystemSCConfig: &config.SystemSmartContractsConfig{
@@ -246,6 +248,9 @@ func FillGasMapMetaChainSystemSCsCosts(value uint64) map[string]uint64 {
 	gasMap["UnBondTokens"] = value
 	gasMap["DelegationMgrOps"] = value
 	gasMap["GetAllNodeStates"] = value
+	gasMap["SubmitEquivocation"] = value
+	gasMap["InsuranceFundOps"] = value
+	gasMap["MintingOps"] = value
 
 	return gasMap
 }
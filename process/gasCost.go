@@ -18,6 +18,8 @@ type BuiltInCost struct {
 	SaveKeyValue          uint64
 	ESDTTransfer          uint64
 	ESDTBurn              uint64
+
+	SetDeveloperRewardsPercentage uint64
 }
 
 // GasCost holds all the needed gas costs for system smart contracts
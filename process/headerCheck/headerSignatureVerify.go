@@ -20,24 +20,26 @@ var log = logger.GetOrCreate("process/headerCheck")
 
 // ArgsHeaderSigVerifier is used to store all components that are needed to create a new HeaderSigVerifier
 type ArgsHeaderSigVerifier struct {
-	Marshalizer             marshal.Marshalizer
-	Hasher                  hashing.Hasher
-	NodesCoordinator        sharding.NodesCoordinator
-	MultiSigVerifier        crypto.MultiSigVerifier
-	SingleSigVerifier       crypto.SingleSigner
-	KeyGen                  crypto.KeyGenerator
-	FallbackHeaderValidator process.FallbackHeaderValidator
+	Marshalizer               marshal.Marshalizer
+	Hasher                    hashing.Hasher
+	NodesCoordinator          sharding.NodesCoordinator
+	MultiSigVerifier          crypto.MultiSigVerifier
+	SingleSigVerifier         crypto.SingleSigner
+	KeyGen                    crypto.KeyGenerator
+	FallbackHeaderValidator   process.FallbackHeaderValidator
+	FallbackLeaderEnableEpoch uint32
 }
 
 //HeaderSigVerifier is component used to check if a header is valid
 type HeaderSigVerifier struct {
-	marshalizer             marshal.Marshalizer
-	hasher                  hashing.Hasher
-	nodesCoordinator        sharding.NodesCoordinator
-	multiSigVerifier        crypto.MultiSigVerifier
-	singleSigVerifier       crypto.SingleSigner
-	keyGen                  crypto.KeyGenerator
-	fallbackHeaderValidator process.FallbackHeaderValidator
+	marshalizer               marshal.Marshalizer
+	hasher                    hashing.Hasher
+	nodesCoordinator          sharding.NodesCoordinator
+	multiSigVerifier          crypto.MultiSigVerifier
+	singleSigVerifier         crypto.SingleSigner
+	keyGen                    crypto.KeyGenerator
+	fallbackHeaderValidator   process.FallbackHeaderValidator
+	fallbackLeaderEnableEpoch uint32
 }
 
 // NewHeaderSigVerifier will create a new instance of HeaderSigVerifier
@@ -48,13 +50,14 @@ func NewHeaderSigVerifier(arguments *ArgsHeaderSigVerifier) (*HeaderSigVerifier,
 	}
 
 	return &HeaderSigVerifier{
-		marshalizer:             arguments.Marshalizer,
-		hasher:                  arguments.Hasher,
-		nodesCoordinator:        arguments.NodesCoordinator,
-		multiSigVerifier:        arguments.MultiSigVerifier,
-		singleSigVerifier:       arguments.SingleSigVerifier,
-		keyGen:                  arguments.KeyGen,
-		fallbackHeaderValidator: arguments.FallbackHeaderValidator,
+		marshalizer:               arguments.Marshalizer,
+		hasher:                    arguments.Hasher,
+		nodesCoordinator:          arguments.NodesCoordinator,
+		multiSigVerifier:          arguments.MultiSigVerifier,
+		singleSigVerifier:         arguments.SingleSigVerifier,
+		keyGen:                    arguments.KeyGen,
+		fallbackHeaderValidator:   arguments.FallbackHeaderValidator,
+		fallbackLeaderEnableEpoch: arguments.FallbackLeaderEnableEpoch,
 	}, nil
 }
 
@@ -94,16 +97,12 @@ func (hsv *HeaderSigVerifier) VerifySignature(header data.HeaderHandler) error {
 	if len(bitmap) == 0 {
 		return process.ErrNilPubKeysBitmap
 	}
-	if bitmap[0]&1 == 0 {
-		return process.ErrBlockProposerSignatureMissing
-	}
-
-	// TODO: remove if start of epoch block needs to be validated by the new epoch nodes
-	epoch := header.GetEpoch()
-	if header.IsStartOfEpochBlock() && epoch > 0 {
-		epoch = epoch - 1
+	err := hsv.verifyProposerSigned(header, bitmap)
+	if err != nil {
+		return err
 	}
 
+	epoch := computeConsensusGroupEpoch(header)
 	consensusPubKeys, err := hsv.nodesCoordinator.GetConsensusValidatorsPublicKeys(
 		randSeed,
 		header.GetRound(),
@@ -183,60 +182,69 @@ func (hsv *HeaderSigVerifier) verifyConsensusSize(consensusPubKeys []string, hea
 
 // VerifyRandSeed will check if rand seed is correct
 func (hsv *HeaderSigVerifier) VerifyRandSeed(header data.HeaderHandler) error {
-	leaderPubKey, err := hsv.getLeader(header)
+	leadersPubKeys, err := hsv.getLeaders(header)
 	if err != nil {
 		return err
 	}
 
-	err = hsv.verifyRandSeed(leaderPubKey, header)
-	if err != nil {
-		log.Trace("block rand seed",
-			"error", err.Error())
-		return err
+	for _, leaderPubKey := range leadersPubKeys {
+		err = hsv.verifyRandSeed(leaderPubKey, header)
+		if err == nil {
+			return nil
+		}
 	}
 
-	return nil
+	log.Trace("block rand seed",
+		"error", err.Error())
+	return err
 }
 
 // VerifyLeaderSignature will check if leader signature is correct
 func (hsv *HeaderSigVerifier) VerifyLeaderSignature(header data.HeaderHandler) error {
-	leaderPubKey, err := hsv.getLeader(header)
+	leadersPubKeys, err := hsv.getLeaders(header)
 	if err != nil {
 		return err
 	}
 
-	err = hsv.verifyLeaderSignature(leaderPubKey, header)
-	if err != nil {
-		log.Trace("block leader's signature",
-			"error", err.Error())
-		return err
+	for _, leaderPubKey := range leadersPubKeys {
+		err = hsv.verifyLeaderSignature(leaderPubKey, header)
+		if err == nil {
+			return nil
+		}
 	}
 
-	return nil
+	log.Trace("block leader's signature",
+		"error", err.Error())
+	return err
 }
 
 // VerifyRandSeedAndLeaderSignature will check if rand seed and leader signature is correct
 func (hsv *HeaderSigVerifier) VerifyRandSeedAndLeaderSignature(header data.HeaderHandler) error {
-	leaderPubKey, err := hsv.getLeader(header)
+	leadersPubKeys, err := hsv.getLeaders(header)
 	if err != nil {
 		return err
 	}
 
-	err = hsv.verifyRandSeed(leaderPubKey, header)
-	if err != nil {
-		log.Trace("block rand seed",
-			"error", err.Error())
-		return err
-	}
+	for _, leaderPubKey := range leadersPubKeys {
+		// the rand seed is signed by the block proposer, so it identifies which of the leaders proposed the block
+		err = hsv.verifyRandSeed(leaderPubKey, header)
+		if err != nil {
+			continue
+		}
 
-	err = hsv.verifyLeaderSignature(leaderPubKey, header)
-	if err != nil {
-		log.Trace("block leader's signature",
-			"error", err.Error())
-		return err
+		err = hsv.verifyLeaderSignature(leaderPubKey, header)
+		if err != nil {
+			log.Trace("block leader's signature",
+				"error", err.Error())
+			return err
+		}
+
+		return nil
 	}
 
-	return nil
+	log.Trace("block rand seed",
+		"error", err.Error())
+	return err
 }
 
 // IsInterfaceNil will check if interface is nil
@@ -260,22 +268,108 @@ func (hsv *HeaderSigVerifier) verifyLeaderSignature(leaderPubKey crypto.PublicKe
 	return hsv.singleSigVerifier.Verify(leaderPubKey, headerBytes, header.GetLeaderSignature())
 }
 
-func (hsv *HeaderSigVerifier) getLeader(header data.HeaderHandler) (crypto.PublicKey, error) {
-	prevRandSeed := header.GetPrevRandSeed()
+// verifyProposerSigned checks that the proposer of the header is one of its signers. The fallback leader proposes
+// only if the leader of the round did not, so the leader's bit is not set in the bitmap of such a header
+func (hsv *HeaderSigVerifier) verifyProposerSigned(header data.HeaderHandler, bitmap []byte) error {
+	if bitmap[0]&1 != 0 {
+		return nil
+	}
 
-	// TODO: remove if start of epoch block needs to be validated by the new epoch nodes
-	epoch := header.GetEpoch()
-	if header.IsStartOfEpochBlock() && epoch > 0 {
-		epoch = epoch - 1
+	if bitmap[0]&2 == 0 {
+		return process.ErrBlockProposerSignatureMissing
+	}
+
+	leadersPubKeys, err := hsv.getLeaders(header)
+	if err != nil {
+		return err
+	}
+	if len(leadersPubKeys) < 2 {
+		return process.ErrBlockProposerSignatureMissing
 	}
 
+	err = hsv.verifyRandSeed(leadersPubKeys[1], header)
+	if err != nil {
+		return process.ErrBlockProposerSignatureMissing
+	}
+
+	return nil
+}
+
+// GetProposerIndex returns the index, in the provided consensus group of the header, of the validator which proposed
+// the header. When the fallback leader is enabled, the proposer is the leader whose signature is the rand seed
+func (hsv *HeaderSigVerifier) GetProposerIndex(header data.HeaderHandler, consensusGroup []sharding.Validator) (int, error) {
+	if check.IfNil(header) {
+		return 0, process.ErrNilHeaderHandler
+	}
+
+	leadersPubKeys, err := hsv.getLeadersPubKeys(header, consensusGroup)
+	if err != nil {
+		return 0, err
+	}
+	if len(leadersPubKeys) == 1 {
+		return 0, nil
+	}
+
+	for index, leaderPubKey := range leadersPubKeys {
+		err = hsv.verifyRandSeed(leaderPubKey, header)
+		if err == nil {
+			return index, nil
+		}
+	}
+
+	return 0, err
+}
+
+// getLeaders returns the public keys of the validators allowed to propose the provided header: the leader of the
+// round and, starting with the fallback leader enable epoch, the fallback leader
+func (hsv *HeaderSigVerifier) getLeaders(header data.HeaderHandler) ([]crypto.PublicKey, error) {
+	prevRandSeed := header.GetPrevRandSeed()
+	epoch := computeConsensusGroupEpoch(header)
+
 	headerConsensusGroup, err := hsv.nodesCoordinator.ComputeConsensusGroup(prevRandSeed, header.GetRound(), header.GetShardID(), epoch)
 	if err != nil {
 		return nil, err
 	}
 
-	leaderPubKeyValidator := headerConsensusGroup[0]
-	return hsv.keyGen.PublicKeyFromByteArray(leaderPubKeyValidator.PubKey())
+	return hsv.getLeadersPubKeys(header, headerConsensusGroup)
+}
+
+func (hsv *HeaderSigVerifier) getLeadersPubKeys(
+	header data.HeaderHandler,
+	headerConsensusGroup []sharding.Validator,
+) ([]crypto.PublicKey, error) {
+	if len(headerConsensusGroup) == 0 {
+		return nil, process.ErrEmptyConsensusGroup
+	}
+
+	numLeaders := 1
+	isFallbackLeaderEnabled := computeConsensusGroupEpoch(header) >= hsv.fallbackLeaderEnableEpoch
+	if isFallbackLeaderEnabled && len(headerConsensusGroup) > 1 {
+		numLeaders = 2
+	}
+
+	leadersPubKeys := make([]crypto.PublicKey, 0, numLeaders)
+	for _, leaderPubKeyValidator := range headerConsensusGroup[:numLeaders] {
+		leaderPubKey, err := hsv.keyGen.PublicKeyFromByteArray(leaderPubKeyValidator.PubKey())
+		if err != nil {
+			return nil, err
+		}
+
+		leadersPubKeys = append(leadersPubKeys, leaderPubKey)
+	}
+
+	return leadersPubKeys, nil
+}
+
+// computeConsensusGroupEpoch returns the epoch of the consensus group which signed the provided header
+// TODO: remove if start of epoch block needs to be validated by the new epoch nodes
+func computeConsensusGroupEpoch(header data.HeaderHandler) uint32 {
+	epoch := header.GetEpoch()
+	if header.IsStartOfEpochBlock() && epoch > 0 {
+		epoch = epoch - 1
+	}
+
+	return epoch
 }
 
 func (hsv *HeaderSigVerifier) copyHeaderWithoutSig(header data.HeaderHandler) data.HeaderHandler {
@@ -633,3 +633,194 @@ func TestHeaderSigVerifier_VerifySignatureOkWhenFallbackThresholdCouldBeApplied(
 	require.Nil(t, err)
 	require.True(t, wasCalled)
 }
+
+func createHeaderSigVerifierWithFallbackLeader(
+	fallbackLeaderEnableEpoch uint32,
+	verify func(public crypto.PublicKey, msg []byte, sig []byte) error,
+) (*HeaderSigVerifier, map[string]crypto.PublicKey) {
+	pubKeys := map[string]crypto.PublicKey{
+		"leader":          &mock.SingleSignPublicKey{},
+		"fallback leader": &mock.SingleSignPublicKey{},
+	}
+
+	args := createHeaderSigVerifierArgs()
+	args.FallbackLeaderEnableEpoch = fallbackLeaderEnableEpoch
+	args.KeyGen = &mock.SingleSignKeyGenMock{
+		PublicKeyFromByteArrayCalled: func(b []byte) (key crypto.PublicKey, err error) {
+			return pubKeys[string(b)], nil
+		},
+	}
+	args.SingleSigVerifier = &mock.SignerMock{
+		VerifyStub: verify,
+	}
+	args.NodesCoordinator = &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) (validators []sharding.Validator, err error) {
+			leader, _ := sharding.NewValidator([]byte("leader"), 1, defaultChancesSelection)
+			fallbackLeader, _ := sharding.NewValidator([]byte("fallback leader"), 1, defaultChancesSelection)
+			validator2, _ := sharding.NewValidator([]byte("validator 2"), 1, defaultChancesSelection)
+			validator3, _ := sharding.NewValidator([]byte("validator 3"), 1, defaultChancesSelection)
+			return []sharding.Validator{leader, fallbackLeader, validator2, validator3}, nil
+		},
+	}
+	hdrSigVerifier, _ := NewHeaderSigVerifier(args)
+
+	return hdrSigVerifier, pubKeys
+}
+
+func TestHeaderSigVerifier_VerifyRandSeedAndLeaderSignatureFallbackLeaderBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	var pubKeys map[string]crypto.PublicKey
+	expectedErr := errors.New("invalid signature")
+	hdrSigVerifier, pubKeys := createHeaderSigVerifierWithFallbackLeader(1, func(public crypto.PublicKey, msg []byte, sig []byte) error {
+		if public == pubKeys["fallback leader"] {
+			return nil
+		}
+		return expectedErr
+	})
+
+	err := hdrSigVerifier.VerifyRandSeedAndLeaderSignature(&dataBlock.Header{})
+	require.Equal(t, expectedErr, err)
+	err = hdrSigVerifier.VerifyRandSeed(&dataBlock.Header{})
+	require.Equal(t, expectedErr, err)
+	err = hdrSigVerifier.VerifyLeaderSignature(&dataBlock.Header{})
+	require.Equal(t, expectedErr, err)
+}
+
+func TestHeaderSigVerifier_VerifyRandSeedAndLeaderSignatureFallbackLeaderAllowedShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var pubKeys map[string]crypto.PublicKey
+	numVerifications := 0
+	hdrSigVerifier, pubKeys := createHeaderSigVerifierWithFallbackLeader(0, func(public crypto.PublicKey, msg []byte, sig []byte) error {
+		numVerifications++
+		if public == pubKeys["fallback leader"] {
+			return nil
+		}
+		return errors.New("invalid signature")
+	})
+
+	err := hdrSigVerifier.VerifyRandSeedAndLeaderSignature(&dataBlock.Header{})
+	require.Nil(t, err)
+	require.Equal(t, 3, numVerifications)
+
+	err = hdrSigVerifier.VerifyRandSeed(&dataBlock.Header{})
+	require.Nil(t, err)
+	err = hdrSigVerifier.VerifyLeaderSignature(&dataBlock.Header{})
+	require.Nil(t, err)
+}
+
+func TestHeaderSigVerifier_VerifyRandSeedAndLeaderSignatureFallbackLeaderWrongLeaderSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	var pubKeys map[string]crypto.PublicKey
+	expectedErr := errors.New("invalid signature")
+	header := &dataBlock.Header{RandSeed: []byte("rand seed"), LeaderSignature: []byte("leader signature")}
+	hdrSigVerifier, pubKeys := createHeaderSigVerifierWithFallbackLeader(0, func(public crypto.PublicKey, msg []byte, sig []byte) error {
+		// the fallback leader signed the rand seed but the leader signature belongs to the leader
+		if public == pubKeys["fallback leader"] && bytes.Equal(sig, header.RandSeed) {
+			return nil
+		}
+		if public == pubKeys["leader"] && bytes.Equal(sig, header.LeaderSignature) {
+			return nil
+		}
+		return expectedErr
+	})
+
+	err := hdrSigVerifier.VerifyRandSeedAndLeaderSignature(header)
+	require.Equal(t, expectedErr, err)
+}
+
+func TestHeaderSigVerifier_VerifyRandSeedFallbackLeaderFromEnableEpochShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var pubKeys map[string]crypto.PublicKey
+	hdrSigVerifier, pubKeys := createHeaderSigVerifierWithFallbackLeader(1, func(public crypto.PublicKey, msg []byte, sig []byte) error {
+		if public == pubKeys["fallback leader"] {
+			return nil
+		}
+		return errors.New("invalid signature")
+	})
+
+	err := hdrSigVerifier.VerifyRandSeed(&dataBlock.Header{Epoch: 1})
+	require.Nil(t, err)
+
+	// the start of epoch block is proposed by the consensus group of the previous epoch
+	err = hdrSigVerifier.VerifyRandSeed(&dataBlock.Header{Epoch: 1, EpochStartMetaHash: []byte("epoch start")})
+	require.NotNil(t, err)
+}
+
+func TestHeaderSigVerifier_GetProposerIndex(t *testing.T) {
+	t.Parallel()
+
+	leader, _ := sharding.NewValidator([]byte("leader"), 1, defaultChancesSelection)
+	fallbackLeader, _ := sharding.NewValidator([]byte("fallback leader"), 1, defaultChancesSelection)
+	consensusGroup := []sharding.Validator{leader, fallbackLeader}
+	expectedErr := errors.New("invalid signature")
+
+	var pubKeys map[string]crypto.PublicKey
+	proposer := ""
+	numVerifications := 0
+	verify := func(public crypto.PublicKey, msg []byte, sig []byte) error {
+		numVerifications++
+		if proposer != "" && public == pubKeys[proposer] {
+			return nil
+		}
+		return expectedErr
+	}
+
+	hdrSigVerifier, keys := createHeaderSigVerifierWithFallbackLeader(1, verify)
+	pubKeys = keys
+	index, err := hdrSigVerifier.GetProposerIndex(&dataBlock.Header{}, consensusGroup)
+	require.Nil(t, err)
+	require.Equal(t, 0, index)
+	require.Equal(t, 0, numVerifications)
+
+	hdrSigVerifier, keys = createHeaderSigVerifierWithFallbackLeader(0, verify)
+	pubKeys = keys
+	proposer = "fallback leader"
+	index, err = hdrSigVerifier.GetProposerIndex(&dataBlock.Header{}, consensusGroup)
+	require.Nil(t, err)
+	require.Equal(t, 1, index)
+
+	proposer = "leader"
+	index, err = hdrSigVerifier.GetProposerIndex(&dataBlock.Header{}, consensusGroup)
+	require.Nil(t, err)
+	require.Equal(t, 0, index)
+
+	proposer = ""
+	_, err = hdrSigVerifier.GetProposerIndex(&dataBlock.Header{}, consensusGroup)
+	require.Equal(t, expectedErr, err)
+
+	_, err = hdrSigVerifier.GetProposerIndex(nil, consensusGroup)
+	require.Equal(t, process.ErrNilHeaderHandler, err)
+}
+
+func TestHeaderSigVerifier_VerifySignatureProposedByTheFallbackLeader(t *testing.T) {
+	t.Parallel()
+
+	var pubKeys map[string]crypto.PublicKey
+	verify := func(public crypto.PublicKey, msg []byte, sig []byte) error {
+		if public == pubKeys["fallback leader"] {
+			return nil
+		}
+		return errors.New("invalid signature")
+	}
+	// all the validators, except for the leader, signed the header
+	header := &dataBlock.Header{PubKeysBitmap: []byte{14}}
+
+	hdrSigVerifier, keys := createHeaderSigVerifierWithFallbackLeader(1, verify)
+	pubKeys = keys
+	err := hdrSigVerifier.VerifySignature(header)
+	require.Equal(t, process.ErrBlockProposerSignatureMissing, err)
+
+	hdrSigVerifier, keys = createHeaderSigVerifierWithFallbackLeader(0, verify)
+	pubKeys = keys
+	err = hdrSigVerifier.VerifySignature(header)
+	require.Nil(t, err)
+
+	// the fallback leader signed the header but did not propose it
+	pubKeys = map[string]crypto.PublicKey{}
+	err = hdrSigVerifier.VerifySignature(header)
+	require.Equal(t, process.ErrBlockProposerSignatureMissing, err)
+}
@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/parsers"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
@@ -45,6 +46,12 @@ type SmartContractResultProcessor interface {
 	IsInterfaceNil() bool
 }
 
+// TransactionSimulator defines the actions of a component able to execute transactions without altering the state
+type TransactionSimulator interface {
+	ProcessTx(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	IsInterfaceNil() bool
+}
+
 // TxTypeHandler is an interface to calculate the transaction type
 type TxTypeHandler interface {
 	ComputeTransactionType(tx data.TransactionHandler) (TransactionType, TransactionType)
@@ -140,6 +147,7 @@ type TransactionCoordinator interface {
 		haveTime func() bool,
 	) (block.MiniBlockSlice, uint32, bool, error)
 	CreateMbsAndProcessTransactionsFromMe(haveTime func() bool) block.MiniBlockSlice
+	PrepareNextProposal()
 	CreatePostProcessMiniBlocks() block.MiniBlockSlice
 	CreateMarshalizedData(body *block.Body) map[string][][]byte
 	GetAllCurrentUsedTxs(blockType block.Type) map[string]data.TransactionHandler
@@ -150,6 +158,12 @@ type TransactionCoordinator interface {
 	IsInterfaceNil() bool
 }
 
+// ProposalPreparer defines the components able to prepare ahead of time the data needed for the next block proposal
+type ProposalPreparer interface {
+	PrepareNextProposal()
+	IsInterfaceNil() bool
+}
+
 // SmartContractProcessor is the main interface for the smart contract caller engine
 type SmartContractProcessor interface {
 	ExecuteSmartContractTransaction(tx data.TransactionHandler, acntSrc, acntDst state.UserAccountHandler) (vmcommon.ReturnCode, error)
@@ -541,6 +555,8 @@ type RewardsHandler interface {
 	ProtocolSustainabilityAddress() string
 	MinInflationRate() float64
 	MaxInflationRate(year uint32) float64
+	MaxInflationRateInEpoch(epoch uint32) (float64, bool)
+	TotalSupplyCapInEpoch(epoch uint32) *big.Int
 	RewardsTopUpGradientPoint() *big.Int
 	RewardsTopUpFactor() float64
 	IsInterfaceNil() bool
@@ -556,18 +572,31 @@ type EndOfEpochEconomics interface {
 // FeeHandler is able to perform some economics calculation on a provided transaction
 type FeeHandler interface {
 	DeveloperPercentage() float64
+	DeveloperPercentageWithOverride(percentageOverride float64) float64
 	MaxGasLimitPerBlock(shardID uint32) uint64
 	ComputeGasLimit(tx TransactionWithFeeHandler) uint64
 	ComputeMoveBalanceFee(tx TransactionWithFeeHandler) *big.Int
 	ComputeTxFee(tx TransactionWithFeeHandler) *big.Int
 	CheckValidityTxValues(tx TransactionWithFeeHandler) error
 	ComputeFeeForProcessing(tx TransactionWithFeeHandler, gasToUse uint64) *big.Int
+	ComputeTxFeeInEpoch(tx TransactionWithFeeHandler, epoch uint32) *big.Int
+	ComputeFeeForProcessingInEpoch(tx TransactionWithFeeHandler, gasToUse uint64, epoch uint32) *big.Int
 	MinGasPrice() uint64
 	GasPriceModifier() float64
 	GenesisTotalSupply() *big.Int
 	IsInterfaceNil() bool
 }
 
+// ChargingEpochHandler keeps the epoch in which the gas of the transactions being processed was charged
+type ChargingEpochHandler interface {
+	SetChargingEpochFromMiniBlock(miniBlock *block.MiniBlock) error
+	ResetChargingEpoch()
+	ChargingEpoch() uint32
+	SaveChargingEpoch(miniBlock *block.MiniBlock, chargingEpoch uint32)
+	IsChargingEpochCarried() bool
+	IsInterfaceNil() bool
+}
+
 // TxGasHandler handles a transaction gas and gas cost
 type TxGasHandler interface {
 	SplitTxGasInCategories(tx TransactionWithFeeHandler) (uint64, uint64)
@@ -582,6 +611,7 @@ type TxGasHandler interface {
 // EconomicsDataHandler is able to perform economics calculations and return economics data
 type EconomicsDataHandler interface {
 	DeveloperPercentage() float64
+	DeveloperPercentageWithOverride(percentageOverride float64) float64
 	MaxGasLimitPerBlock(shardID uint32) uint64
 	ComputeGasLimit(tx TransactionWithFeeHandler) uint64
 	ComputeMoveBalanceFee(tx TransactionWithFeeHandler) *big.Int
@@ -592,14 +622,22 @@ type EconomicsDataHandler interface {
 	LeaderPercentage() float64
 	ProtocolSustainabilityPercentage() float64
 	ProtocolSustainabilityAddress() string
+	ProtocolSustainabilityAddresses() []config.ProtocolSustainabilityAddressConfig
 	MinInflationRate() float64
 	MaxInflationRate(year uint32) float64
+	MaxInflationRateInEpoch(epoch uint32) (float64, bool)
+	TotalSupplyCapInEpoch(epoch uint32) *big.Int
 	GasPerDataByte() uint64
 	MinGasLimit() uint64
 	GenesisTotalSupply() *big.Int
 	ComputeFeeForProcessing(tx TransactionWithFeeHandler, gasToUse uint64) *big.Int
+	ComputeTxFeeInEpoch(tx TransactionWithFeeHandler, epoch uint32) *big.Int
+	ComputeFeeForProcessingInEpoch(tx TransactionWithFeeHandler, gasToUse uint64, epoch uint32) *big.Int
 	RewardsTopUpGradientPoint() *big.Int
 	RewardsTopUpFactor() float64
+	RewardsTopUpGradientPointInEpoch(epoch uint32) *big.Int
+	RewardsTopUpFactorInEpoch(epoch uint32) float64
+	RewardsTopUpCurveSteepnessInEpoch(epoch uint32) float64
 	SplitTxGasInCategories(tx TransactionWithFeeHandler) (uint64, uint64)
 	GasPriceForProcessing(tx TransactionWithFeeHandler) uint64
 	GasPriceForMove(tx TransactionWithFeeHandler) uint64
@@ -609,8 +647,8 @@ type EconomicsDataHandler interface {
 
 // TransactionFeeCalculator is able to calculated fee of a transaction
 type TransactionFeeCalculator interface {
-	ComputeGasUsedAndFeeBasedOnRefundValue(tx TransactionWithFeeHandler, refundValue *big.Int) (uint64, *big.Int)
-	ComputeTxFeeBasedOnGasUsed(tx TransactionWithFeeHandler, gasUsed uint64) *big.Int
+	ComputeGasUsedAndFeeBasedOnRefundValueInEpoch(tx TransactionWithFeeHandler, refundValue *big.Int, epoch uint32) (uint64, *big.Int)
+	ComputeTxFeeBasedOnGasUsedInEpoch(tx TransactionWithFeeHandler, gasUsed uint64, epoch uint32) *big.Int
 	ComputeGasLimit(tx TransactionWithFeeHandler) uint64
 	IsInterfaceNil() bool
 }
@@ -675,6 +713,8 @@ type SCQuery struct {
 	CallerAddr []byte
 	CallValue  *big.Int
 	Arguments  [][]byte
+	BlockNonce *uint64
+	BlockHash  []byte
 }
 
 // GasHandler is able to perform some gas calculation
@@ -721,6 +761,12 @@ type InterceptedHeaderSigVerifier interface {
 	IsInterfaceNil() bool
 }
 
+// ProposerIdentifier identifies the validator of a consensus group which proposed a header
+type ProposerIdentifier interface {
+	GetProposerIndex(header data.HeaderHandler, consensusGroup []sharding.Validator) (int, error)
+	IsInterfaceNil() bool
+}
+
 // HeaderIntegrityVerifier is the interface needed to check that a header's integrity
 // is correct
 type HeaderIntegrityVerifier interface {
@@ -793,6 +839,12 @@ type P2PAntifloodHandler interface {
 	IsInterfaceNil() bool
 }
 
+// WebSocketPeersChecker can tell if a peer is connected through the web socket listener
+type WebSocketPeersChecker interface {
+	IsConnectedViaWebSocket(pid core.PeerID) bool
+	IsInterfaceNil() bool
+}
+
 // PeerValidatorMapper can determine the peer info from a peer id
 type PeerValidatorMapper interface {
 	GetPeerInfo(pid core.PeerID) core.P2PPeerInfo
@@ -888,6 +940,7 @@ type BuiltInFunctionContainer interface {
 // RoundTimeDurationHandler defines the methods to get the time duration of a round
 type RoundTimeDurationHandler interface {
 	TimeDuration() time.Duration
+	TimeDurationBetweenRounds(fromRound int64, toRound int64) time.Duration
 	IsInterfaceNil() bool
 }
 
@@ -912,6 +965,9 @@ type RatingsInfoHandler interface {
 	MetaChainRatingsStepHandler() RatingsStepHandler
 	ShardChainRatingsStepHandler() RatingsStepHandler
 	SelectionChances() []SelectionChance
+	ProbationConsecutiveMissesThreshold() uint32
+	ProbationDurationInEpochs() uint32
+	ProbationPenaltyMultiplier() float32
 	IsInterfaceNil() bool
 }
 
@@ -922,6 +978,7 @@ type RatingsStepHandler interface {
 	ValidatorIncreaseRatingStep() int32
 	ValidatorDecreaseRatingStep() int32
 	ConsecutiveMissedBlocksPenalty() float32
+	ConsecutiveMissedSignaturesPenalty() float32
 }
 
 // ValidatorInfoSyncer defines the method needed for validatorInfoProcessing
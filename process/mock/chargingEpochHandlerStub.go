@@ -0,0 +1,60 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+// ChargingEpochHandlerStub -
+type ChargingEpochHandlerStub struct {
+	SetChargingEpochFromMiniBlockCalled func(miniBlock *block.MiniBlock) error
+	ResetChargingEpochCalled            func()
+	ChargingEpochCalled                 func() uint32
+	SaveChargingEpochCalled             func(miniBlock *block.MiniBlock, chargingEpoch uint32)
+	IsChargingEpochCarriedCalled        func() bool
+}
+
+// SetChargingEpochFromMiniBlock -
+func (cehs *ChargingEpochHandlerStub) SetChargingEpochFromMiniBlock(miniBlock *block.MiniBlock) error {
+	if cehs.SetChargingEpochFromMiniBlockCalled != nil {
+		return cehs.SetChargingEpochFromMiniBlockCalled(miniBlock)
+	}
+
+	return nil
+}
+
+// ResetChargingEpoch -
+func (cehs *ChargingEpochHandlerStub) ResetChargingEpoch() {
+	if cehs.ResetChargingEpochCalled != nil {
+		cehs.ResetChargingEpochCalled()
+	}
+}
+
+// ChargingEpoch -
+func (cehs *ChargingEpochHandlerStub) ChargingEpoch() uint32 {
+	if cehs.ChargingEpochCalled != nil {
+		return cehs.ChargingEpochCalled()
+	}
+
+	return 0
+}
+
+// SaveChargingEpoch -
+func (cehs *ChargingEpochHandlerStub) SaveChargingEpoch(miniBlock *block.MiniBlock, chargingEpoch uint32) {
+	if cehs.SaveChargingEpochCalled != nil {
+		cehs.SaveChargingEpochCalled(miniBlock, chargingEpoch)
+	}
+}
+
+// IsChargingEpochCarried -
+func (cehs *ChargingEpochHandlerStub) IsChargingEpochCarried() bool {
+	if cehs.IsChargingEpochCarriedCalled != nil {
+		return cehs.IsChargingEpochCarriedCalled()
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (cehs *ChargingEpochHandlerStub) IsInterfaceNil() bool {
+	return cehs == nil
+}
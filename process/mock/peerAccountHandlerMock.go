@@ -27,6 +27,10 @@ type PeerAccountHandlerMock struct {
 	GetAccumulatedFeesCalled                     func() *big.Int
 	GetConsecutiveProposerMissesCalled           func() uint32
 	SetConsecutiveProposerMissesCalled           func(rating uint32)
+	GetConsecutiveValidatorMissesCalled          func() uint32
+	SetConsecutiveValidatorMissesCalled          func(consecutiveMisses uint32)
+	GetProbationEndEpochCalled                   func() uint32
+	SetProbationEndEpochCalled                   func(epoch uint32)
 	SetListAndIndexCalled                        func(shardID uint32, list string, index uint32)
 	GetListCalled                                func() string
 	GetUnStakedEpochCalled                       func() uint32
@@ -295,6 +299,36 @@ func (p *PeerAccountHandlerMock) SetConsecutiveProposerMisses(consecutiveMisses
 	}
 }
 
+// GetConsecutiveValidatorMisses -
+func (p *PeerAccountHandlerMock) GetConsecutiveValidatorMisses() uint32 {
+	if p.GetConsecutiveValidatorMissesCalled != nil {
+		return p.GetConsecutiveValidatorMissesCalled()
+	}
+	return 0
+}
+
+// SetConsecutiveValidatorMisses -
+func (p *PeerAccountHandlerMock) SetConsecutiveValidatorMisses(consecutiveMisses uint32) {
+	if p.SetConsecutiveValidatorMissesCalled != nil {
+		p.SetConsecutiveValidatorMissesCalled(consecutiveMisses)
+	}
+}
+
+// GetProbationEndEpoch -
+func (p *PeerAccountHandlerMock) GetProbationEndEpoch() uint32 {
+	if p.GetProbationEndEpochCalled != nil {
+		return p.GetProbationEndEpochCalled()
+	}
+	return 0
+}
+
+// SetProbationEndEpoch -
+func (p *PeerAccountHandlerMock) SetProbationEndEpoch(epoch uint32) {
+	if p.SetProbationEndEpochCalled != nil {
+		p.SetProbationEndEpochCalled(epoch)
+	}
+}
+
 // SetListAndIndex -
 func (p *PeerAccountHandlerMock) SetListAndIndex(shardID uint32, list string, index uint32) {
 	if p.SetListAndIndexCalled != nil {
@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"math"
+
 	"github.com/ElrondNetwork/elrond-go/core"
 )
 
@@ -19,15 +21,19 @@ type RaterMock struct {
 	MetaIncreaseValidator int32
 	MetaDecreaseValidator int32
 
-	GetRatingCalled                func(string) uint32
-	GetStartRatingCalled           func() uint32
-	GetSignedBlocksThresholdCalled func() float32
-	ComputeIncreaseProposerCalled  func(shardId uint32, rating uint32) uint32
-	ComputeDecreaseProposerCalled  func(shardId uint32, rating uint32, consecutiveMissedBlocks uint32) uint32
-	RevertIncreaseProposerCalled   func(shardId uint32, rating uint32, nrReverts uint32) uint32
-	ComputeIncreaseValidatorCalled func(shardId uint32, rating uint32) uint32
-	ComputeDecreaseValidatorCalled func(shardId uint32, rating uint32) uint32
-	GetChancesCalled               func(val uint32) uint32
+	GetRatingCalled                                   func(string) uint32
+	GetStartRatingCalled                              func() uint32
+	GetSignedBlocksThresholdCalled                    func() float32
+	ComputeIncreaseProposerCalled                     func(shardId uint32, rating uint32) uint32
+	ComputeDecreaseProposerCalled                     func(shardId uint32, rating uint32, consecutiveMissedBlocks uint32) uint32
+	RevertIncreaseProposerCalled                      func(shardId uint32, rating uint32, nrReverts uint32) uint32
+	ComputeIncreaseValidatorCalled                    func(shardId uint32, rating uint32) uint32
+	ComputeDecreaseValidatorCalled                    func(shardId uint32, rating uint32) uint32
+	ComputeDecreaseValidatorOnConsecutiveMissesCalled func(shardId uint32, rating uint32, consecutiveMisses uint32) uint32
+	ComputeProbationDecreaseCalled                    func(currentRating uint32, newRating uint32) uint32
+	GetProbationConsecutiveMissesThresholdCalled      func() uint32
+	GetProbationDurationInEpochsCalled                func() uint32
+	GetChancesCalled                                  func(val uint32) uint32
 }
 
 // GetNewMockRater -
@@ -146,6 +152,38 @@ func (rm *RaterMock) ComputeDecreaseValidator(shardId uint32, currentRating uint
 	return rm.ComputeDecreaseValidatorCalled(shardId, currentRating)
 }
 
+// ComputeDecreaseValidatorOnConsecutiveMisses -
+func (rm *RaterMock) ComputeDecreaseValidatorOnConsecutiveMisses(shardId uint32, currentRating uint32, consecutiveMisses uint32) uint32 {
+	if rm.ComputeDecreaseValidatorOnConsecutiveMissesCalled != nil {
+		return rm.ComputeDecreaseValidatorOnConsecutiveMissesCalled(shardId, currentRating, consecutiveMisses)
+	}
+	return rm.ComputeDecreaseValidator(shardId, currentRating)
+}
+
+// ComputeProbationDecrease -
+func (rm *RaterMock) ComputeProbationDecrease(currentRating uint32, newRating uint32) uint32 {
+	if rm.ComputeProbationDecreaseCalled != nil {
+		return rm.ComputeProbationDecreaseCalled(currentRating, newRating)
+	}
+	return newRating
+}
+
+// GetProbationConsecutiveMissesThreshold -
+func (rm *RaterMock) GetProbationConsecutiveMissesThreshold() uint32 {
+	if rm.GetProbationConsecutiveMissesThresholdCalled != nil {
+		return rm.GetProbationConsecutiveMissesThresholdCalled()
+	}
+	return math.MaxUint32
+}
+
+// GetProbationDurationInEpochs -
+func (rm *RaterMock) GetProbationDurationInEpochs() uint32 {
+	if rm.GetProbationDurationInEpochsCalled != nil {
+		return rm.GetProbationDurationInEpochsCalled()
+	}
+	return 1
+}
+
 // GetChance -
 func (rm *RaterMock) GetChance(rating uint32) uint32 {
 	return rm.GetChancesCalled(rating)
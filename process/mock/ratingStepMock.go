@@ -2,11 +2,12 @@ package mock
 
 // RatingStepMock will store information about ratingsComputation specific for a shard or metachain
 type RatingStepMock struct {
-	ProposerIncreaseRatingStepProperty     int32
-	ProposerDecreaseRatingStepProperty     int32
-	ValidatorIncreaseRatingStepProperty    int32
-	ValidatorDecreaseRatingStepProperty    int32
-	ConsecutiveMissedBlocksPenaltyProperty float32
+	ProposerIncreaseRatingStepProperty         int32
+	ProposerDecreaseRatingStepProperty         int32
+	ValidatorIncreaseRatingStepProperty        int32
+	ValidatorDecreaseRatingStepProperty        int32
+	ConsecutiveMissedBlocksPenaltyProperty     float32
+	ConsecutiveMissedSignaturesPenaltyProperty float32
 }
 
 // ProposerIncreaseRatingStep will return the rating step increase for validator
@@ -33,3 +34,8 @@ func (rd *RatingStepMock) ValidatorDecreaseRatingStep() int32 {
 func (rd *RatingStepMock) ConsecutiveMissedBlocksPenalty() float32 {
 	return rd.ConsecutiveMissedBlocksPenaltyProperty
 }
+
+// ConsecutiveMissedSignaturesPenalty will return the penalty increase for consecutive signature misses
+func (rd *RatingStepMock) ConsecutiveMissedSignaturesPenalty() float32 {
+	return rd.ConsecutiveMissedSignaturesPenaltyProperty
+}
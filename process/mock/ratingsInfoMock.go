@@ -4,13 +4,16 @@ import "github.com/ElrondNetwork/elrond-go/process"
 
 // RatingsInfoMock -
 type RatingsInfoMock struct {
-	StartRatingProperty           uint32
-	MaxRatingProperty             uint32
-	MinRatingProperty             uint32
-	SignedBlocksThresholdProperty float32
-	MetaRatingsStepDataProperty   process.RatingsStepHandler
-	ShardRatingsStepDataProperty  process.RatingsStepHandler
-	SelectionChancesProperty      []process.SelectionChance
+	StartRatingProperty                         uint32
+	MaxRatingProperty                           uint32
+	MinRatingProperty                           uint32
+	SignedBlocksThresholdProperty               float32
+	MetaRatingsStepDataProperty                 process.RatingsStepHandler
+	ShardRatingsStepDataProperty                process.RatingsStepHandler
+	SelectionChancesProperty                    []process.SelectionChance
+	ProbationConsecutiveMissesThresholdProperty uint32
+	ProbationDurationInEpochsProperty           uint32
+	ProbationPenaltyMultiplierProperty          float32
 }
 
 // StartRating -
@@ -38,6 +41,21 @@ func (rd *RatingsInfoMock) SelectionChances() []process.SelectionChance {
 	return rd.SelectionChancesProperty
 }
 
+// ProbationConsecutiveMissesThreshold -
+func (rd *RatingsInfoMock) ProbationConsecutiveMissesThreshold() uint32 {
+	return rd.ProbationConsecutiveMissesThresholdProperty
+}
+
+// ProbationDurationInEpochs -
+func (rd *RatingsInfoMock) ProbationDurationInEpochs() uint32 {
+	return rd.ProbationDurationInEpochsProperty
+}
+
+// ProbationPenaltyMultiplier -
+func (rd *RatingsInfoMock) ProbationPenaltyMultiplier() float32 {
+	return rd.ProbationPenaltyMultiplierProperty
+}
+
 // MetaChainRatingsStepHandler -
 func (rd *RatingsInfoMock) MetaChainRatingsStepHandler() process.RatingsStepHandler {
 	return rd.MetaRatingsStepDataProperty
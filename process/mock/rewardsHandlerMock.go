@@ -5,6 +5,8 @@ import "math/big"
 // RewardsHandlerMock -
 type RewardsHandlerMock struct {
 	MaxInflationRateCalled                 func() float64
+	MaxInflationRateInEpochCalled          func(epoch uint32) (float64, bool)
+	TotalSupplyCapInEpochCalled            func(epoch uint32) *big.Int
 	MinInflationRateCalled                 func() float64
 	LeaderPercentageCalled                 func() float64
 	ProtocolSustainabilityPercentageCalled func() float64
@@ -38,6 +40,24 @@ func (rhm *RewardsHandlerMock) MaxInflationRate(uint32) float64 {
 	return rhm.MaxInflationRateCalled()
 }
 
+// MaxInflationRateInEpoch -
+func (rhm *RewardsHandlerMock) MaxInflationRateInEpoch(epoch uint32) (float64, bool) {
+	if rhm.MaxInflationRateInEpochCalled != nil {
+		return rhm.MaxInflationRateInEpochCalled(epoch)
+	}
+
+	return 0, false
+}
+
+// TotalSupplyCapInEpoch -
+func (rhm *RewardsHandlerMock) TotalSupplyCapInEpoch(epoch uint32) *big.Int {
+	if rhm.TotalSupplyCapInEpochCalled != nil {
+		return rhm.TotalSupplyCapInEpochCalled(epoch)
+	}
+
+	return nil
+}
+
 // RewardsTopUpGradientPoint -
 func (rhm *RewardsHandlerMock) RewardsTopUpGradientPoint() *big.Int {
 	return rhm.RewardsTopUpGradientPointCalled()
@@ -6,11 +6,12 @@ import (
 
 // RoundStub -
 type RoundStub struct {
-	IndexCalled         func() int64
-	TimeDurationCalled  func() time.Duration
-	TimeStampCalled     func() time.Time
-	UpdateRoundCalled   func(time.Time, time.Time)
-	RemainingTimeCalled func(time.Time, time.Duration) time.Duration
+	IndexCalled                     func() int64
+	TimeDurationCalled              func() time.Duration
+	TimeDurationBetweenRoundsCalled func(fromRound int64, toRound int64) time.Duration
+	TimeStampCalled                 func() time.Time
+	UpdateRoundCalled               func(time.Time, time.Time)
+	RemainingTimeCalled             func(time.Time, time.Duration) time.Duration
 }
 
 // Index -
@@ -23,6 +24,15 @@ func (rnds *RoundStub) TimeDuration() time.Duration {
 	return rnds.TimeDurationCalled()
 }
 
+// TimeDurationBetweenRounds -
+func (rnds *RoundStub) TimeDurationBetweenRounds(fromRound int64, toRound int64) time.Duration {
+	if rnds.TimeDurationBetweenRoundsCalled != nil {
+		return rnds.TimeDurationBetweenRoundsCalled(fromRound, toRound)
+	}
+
+	return time.Duration(toRound-fromRound) * rnds.TimeDuration()
+}
+
 // TimeStamp -
 func (rnds *RoundStub) TimeStamp() time.Time {
 	return rnds.TimeStampCalled()
@@ -7,10 +7,11 @@ import (
 
 // RounderMock -
 type RounderMock struct {
-	RoundIndex          int64
-	RoundTimeStamp      time.Time
-	RoundTimeDuration   time.Duration
-	BeforeGenesisCalled func() bool
+	RoundIndex                      int64
+	RoundTimeStamp                  time.Time
+	RoundTimeDuration               time.Duration
+	BeforeGenesisCalled             func() bool
+	TimeDurationBetweenRoundsCalled func(fromRound int64, toRound int64) time.Duration
 }
 
 // BeforeGenesis -
@@ -31,6 +32,14 @@ func (rndm *RounderMock) TimeDuration() time.Duration {
 	return rndm.RoundTimeDuration
 }
 
+// TimeDurationBetweenRounds -
+func (rndm *RounderMock) TimeDurationBetweenRounds(fromRound int64, toRound int64) time.Duration {
+	if rndm.TimeDurationBetweenRoundsCalled != nil {
+		return rndm.TimeDurationBetweenRoundsCalled(fromRound, toRound)
+	}
+	return time.Duration(toRound-fromRound) * rndm.TimeDuration()
+}
+
 // TimeStamp -
 func (rndm *RounderMock) TimeStamp() time.Time {
 	return rndm.RoundTimeStamp
@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+// TxSimulatorStub -
+type TxSimulatorStub struct {
+	ProcessTxCalled func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+}
+
+// ProcessTx -
+func (tss *TxSimulatorStub) ProcessTx(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+	if tss.ProcessTxCalled != nil {
+		return tss.ProcessTxCalled(tx)
+	}
+
+	return &transaction.SimulationResults{}, nil
+}
+
+// IsInterfaceNil -
+func (tss *TxSimulatorStub) IsInterfaceNil() bool {
+	return tss == nil
+}
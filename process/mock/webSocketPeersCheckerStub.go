@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// WebSocketPeersCheckerStub -
+type WebSocketPeersCheckerStub struct {
+	IsConnectedViaWebSocketCalled func(pid core.PeerID) bool
+}
+
+// IsConnectedViaWebSocket -
+func (stub *WebSocketPeersCheckerStub) IsConnectedViaWebSocket(pid core.PeerID) bool {
+	if stub.IsConnectedViaWebSocketCalled != nil {
+		return stub.IsConnectedViaWebSocketCalled(pid)
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (stub *WebSocketPeersCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
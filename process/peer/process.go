@@ -46,12 +46,14 @@ type ArgValidatorStatisticsProcessor struct {
 	PeerAdapter                     state.AccountsAdapter
 	Rater                           sharding.PeerAccountListAndRatingHandler
 	RewardsHandler                  process.RewardsHandler
+	ProposerIdentifier              process.ProposerIdentifier
 	MaxComputableRounds             uint64
 	NodesSetup                      sharding.GenesisNodesSetupHandler
 	GenesisNonce                    uint64
 	RatingEnableEpoch               uint32
 	SwitchJailWaitingEnableEpoch    uint32
 	BelowSignedThresholdEnableEpoch uint32
+	RatingsV2EnableEpoch            uint32
 	EpochNotifier                   process.EpochNotifier
 }
 
@@ -65,6 +67,7 @@ type validatorStatistics struct {
 	peerAdapter                     state.AccountsAdapter
 	rater                           sharding.PeerAccountListAndRatingHandler
 	rewardsHandler                  process.RewardsHandler
+	proposerIdentifier              process.ProposerIdentifier
 	maxComputableRounds             uint64
 	missedBlocksCounters            validatorRoundCounters
 	mutValidatorStatistics          sync.RWMutex
@@ -73,6 +76,7 @@ type validatorStatistics struct {
 	lastFinalizedRootHash           []byte
 	jailedEnableEpoch               uint32
 	belowSignedThresholdEnableEpoch uint32
+	ratingsV2EnableEpoch            uint32
 	flagJailedEnabled               atomic.Flag
 }
 
@@ -109,6 +113,9 @@ func NewValidatorStatisticsProcessor(arguments ArgValidatorStatisticsProcessor)
 	if check.IfNil(arguments.RewardsHandler) {
 		return nil, process.ErrNilRewardsHandler
 	}
+	if check.IfNil(arguments.ProposerIdentifier) {
+		return nil, process.ErrNilProposerIdentifier
+	}
 	if check.IfNil(arguments.NodesSetup) {
 		return nil, process.ErrNilNodesSetup
 	}
@@ -127,11 +134,13 @@ func NewValidatorStatisticsProcessor(arguments ArgValidatorStatisticsProcessor)
 		missedBlocksCounters:            make(validatorRoundCounters),
 		rater:                           arguments.Rater,
 		rewardsHandler:                  arguments.RewardsHandler,
+		proposerIdentifier:              arguments.ProposerIdentifier,
 		maxComputableRounds:             arguments.MaxComputableRounds,
 		genesisNonce:                    arguments.GenesisNonce,
 		ratingEnableEpoch:               arguments.RatingEnableEpoch,
 		jailedEnableEpoch:               arguments.SwitchJailWaitingEnableEpoch,
 		belowSignedThresholdEnableEpoch: arguments.BelowSignedThresholdEnableEpoch,
+		ratingsV2EnableEpoch:            arguments.RatingsV2EnableEpoch,
 	}
 
 	arguments.EpochNotifier.RegisterNotifyHandler(vs)
@@ -364,10 +373,15 @@ func (vs *validatorStatistics) UpdatePeerState(header data.HeaderHandler, cache
 	if err != nil {
 		return nil, err
 	}
-	leaderPK := core.GetTrimmedPk(vs.pubkeyConv.Encode(consensusGroup[0].PubKey()))
+	proposerIndex, err := vs.proposerIdentifier.GetProposerIndex(previousHeader, consensusGroup)
+	if err != nil {
+		return nil, err
+	}
+	leaderPK := core.GetTrimmedPk(vs.pubkeyConv.Encode(consensusGroup[proposerIndex].PubKey()))
 	log.Trace("Increasing for leader", "leader", leaderPK, "round", previousHeader.GetRound())
 	err = vs.updateValidatorInfoOnSuccessfulBlock(
 		consensusGroup,
+		proposerIndex,
 		previousHeader.GetPubKeysBitmap(),
 		big.NewInt(0).Sub(previousHeader.GetAccumulatedFees(), previousHeader.GetDeveloperFees()),
 		previousHeader.GetShardID())
@@ -495,6 +509,7 @@ func (vs *validatorStatistics) PeerAccountToValidatorInfo(peerAccount state.Peer
 		TotalValidatorIgnoredSignatures: peerAccount.GetTotalValidatorIgnoredSignaturesRate(),
 		NumSelectedInSuccessBlocks:      peerAccount.GetNumSelectedInSuccessBlocks(),
 		AccumulatedFees:                 big.NewInt(0).Set(peerAccount.GetAccumulatedFees()),
+		ProbationEndEpoch:               peerAccount.GetProbationEndEpoch(),
 	}
 }
 
@@ -773,6 +788,10 @@ func (vs *validatorStatistics) computeDecrease(
 		leaderPeerAcc.SetConsecutiveProposerMisses(leaderPeerAcc.GetConsecutiveProposerMisses() + 1)
 		swInner.Stop("SetConsecutiveProposerMisses")
 
+		if epoch >= vs.ratingsV2EnableEpoch {
+			newRating = vs.applyProbation(leaderPeerAcc, leaderPeerAcc.GetConsecutiveProposerMisses(), newRating, epoch)
+		}
+
 		swInner.Start("SetTempRating")
 		leaderPeerAcc.SetTempRating(newRating)
 		vs.jailValidatorIfBadRatingAndInactive(leaderPeerAcc)
@@ -813,7 +832,7 @@ func (vs *validatorStatistics) decreaseForConsensusValidators(
 		}
 		vs.missedBlocksCounters.decreaseValidator(consensusGroup[j].PubKey())
 
-		newRating := vs.rater.ComputeDecreaseValidator(shardId, validatorPeerAccount.GetTempRating())
+		newRating := vs.computeDecreaseValidator(validatorPeerAccount, shardId, epoch)
 		validatorPeerAccount.SetTempRating(newRating)
 		vs.jailValidatorIfBadRatingAndInactive(validatorPeerAccount)
 		err := vs.peerAdapter.SaveAccount(validatorPeerAccount)
@@ -825,6 +844,54 @@ func (vs *validatorStatistics) decreaseForConsensusValidators(
 	return nil
 }
 
+func (vs *validatorStatistics) computeDecreaseValidator(
+	validatorPeerAccount state.PeerAccountHandler,
+	shardId uint32,
+	epoch uint32,
+) uint32 {
+	if epoch < vs.ratingsV2EnableEpoch {
+		return vs.rater.ComputeDecreaseValidator(shardId, validatorPeerAccount.GetTempRating())
+	}
+
+	newRating := vs.rater.ComputeDecreaseValidatorOnConsecutiveMisses(
+		shardId,
+		validatorPeerAccount.GetTempRating(),
+		validatorPeerAccount.GetConsecutiveValidatorMisses())
+	validatorPeerAccount.SetConsecutiveValidatorMisses(validatorPeerAccount.GetConsecutiveValidatorMisses() + 1)
+
+	return vs.applyProbation(validatorPeerAccount, validatorPeerAccount.GetConsecutiveValidatorMisses(), newRating, epoch)
+}
+
+// applyProbation places the peer in probation once the consecutive misses reach the configured threshold and
+// amplifies the rating decrease while the peer is in probation
+func (vs *validatorStatistics) applyProbation(
+	peerAccount state.PeerAccountHandler,
+	consecutiveMisses uint32,
+	newRating uint32,
+	epoch uint32,
+) uint32 {
+	if consecutiveMisses >= vs.rater.GetProbationConsecutiveMissesThreshold() {
+		probationEndEpoch := epoch + vs.rater.GetProbationDurationInEpochs()
+		if peerAccount.GetProbationEndEpoch() < probationEndEpoch {
+			log.Debug("validator placed in probation",
+				"pk", peerAccount.GetBLSPublicKey(),
+				"consecutive misses", consecutiveMisses,
+				"probation end epoch", probationEndEpoch)
+			peerAccount.SetProbationEndEpoch(probationEndEpoch)
+		}
+	}
+
+	if !isInProbation(peerAccount.GetProbationEndEpoch(), epoch) {
+		return newRating
+	}
+
+	return vs.rater.ComputeProbationDecrease(peerAccount.GetTempRating(), newRating)
+}
+
+func isInProbation(probationEndEpoch uint32, epoch uint32) bool {
+	return probationEndEpoch > 0 && epoch <= probationEndEpoch
+}
+
 // RevertPeerState takes the current and previous headers and undos the peer state
 //  for all of the consensus members
 func (vs *validatorStatistics) RevertPeerState(header data.HeaderHandler) error {
@@ -862,8 +929,14 @@ func (vs *validatorStatistics) updateShardDataPeerState(
 			return shardInfoErr
 		}
 
+		proposerIndex, shardInfoErr := vs.proposerIdentifier.GetProposerIndex(currentHeader, shardConsensus)
+		if shardInfoErr != nil {
+			return shardInfoErr
+		}
+
 		shardInfoErr = vs.updateValidatorInfoOnSuccessfulBlock(
 			shardConsensus,
+			proposerIndex,
 			h.PubKeysBitmap,
 			big.NewInt(0).Sub(h.AccumulatedFees, h.DeveloperFees),
 			h.ShardID,
@@ -956,6 +1029,7 @@ func (vs *validatorStatistics) savePeerAccountData(
 
 func (vs *validatorStatistics) updateValidatorInfoOnSuccessfulBlock(
 	validatorList []sharding.Validator,
+	proposerIndex int,
 	signingBitmap []byte,
 	accumulatedFees *big.Int,
 	shardId uint32,
@@ -974,7 +1048,7 @@ func (vs *validatorStatistics) updateValidatorInfoOnSuccessfulBlock(
 		peerAcc.IncreaseNumSelectedInSuccessBlocks()
 
 		newRating := peerAcc.GetRating()
-		isLeader := i == 0
+		isLeader := i == proposerIndex
 		validatorSigned := (signingBitmap[i/8] & (1 << (uint16(i) % 8))) != 0
 		actionType := vs.computeValidatorActionType(isLeader, validatorSigned)
 
@@ -982,11 +1056,13 @@ func (vs *validatorStatistics) updateValidatorInfoOnSuccessfulBlock(
 		case leaderSuccess:
 			peerAcc.IncreaseLeaderSuccessRate(1)
 			peerAcc.SetConsecutiveProposerMisses(0)
+			peerAcc.SetConsecutiveValidatorMisses(0)
 			newRating = vs.rater.ComputeIncreaseProposer(shardId, peerAcc.GetTempRating())
 			leaderAccumulatedFees := core.GetPercentageOfValue(accumulatedFees, vs.rewardsHandler.LeaderPercentage())
 			peerAcc.AddToAccumulatedFees(leaderAccumulatedFees)
 		case validatorSuccess:
 			peerAcc.IncreaseValidatorSuccessRate(1)
+			peerAcc.SetConsecutiveValidatorMisses(0)
 			newRating = vs.rater.ComputeIncreaseValidator(shardId, peerAcc.GetTempRating())
 		case validatorIgnoredSignature:
 			peerAcc.IncreaseValidatorIgnoredSignaturesRate(1)
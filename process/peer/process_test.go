@@ -93,6 +93,7 @@ func createMockArguments() peer.ArgValidatorStatisticsProcessor {
 		PeerAdapter:         getAccountsMock(),
 		Rater:               createMockRater(),
 		RewardsHandler:      economicsData,
+		ProposerIdentifier:  &mock.ProposerIdentifierStub{},
 		MaxComputableRounds: 1000,
 		NodesSetup:          &mock.NodesSetupStub{},
 		EpochNotifier:       &mock.EpochNotifierStub{},
@@ -1409,6 +1410,135 @@ func TestValidatorStatisticsProcessor_CheckForMissedBlocksCallsDecrease(t *testi
 	assert.Equal(t, uint32(currentHeaderRound-previousHeaderRound-1), counters)
 }
 
+func TestValidatorStatisticsProcessor_CheckForMissedBlocksConsecutiveMissesPutValidatorInProbation(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(5)
+	probationDuration := uint32(3)
+	leaderPubKey := []byte("leader")
+	validatorPubKey := []byte("validator")
+	accounts := make(map[string]state.PeerAccountHandler)
+	peerAdapter := getAccountsMock()
+	peerAdapter.LoadAccountCalled = func(address []byte) (state.AccountHandler, error) {
+		acc, ok := accounts[string(address)]
+		if !ok {
+			acc, _ = state.NewPeerAccount(address)
+			acc.SetTempRating(100)
+			accounts[string(address)] = acc
+		}
+		return acc, nil
+	}
+
+	consecutiveMissesSeen := make([]uint32, 0)
+	numProbationDecreases := 0
+	rater := mock.GetNewMockRater()
+	rater.ComputeDecreaseValidatorOnConsecutiveMissesCalled = func(shardId uint32, rating uint32, consecutiveMisses uint32) uint32 {
+		consecutiveMissesSeen = append(consecutiveMissesSeen, consecutiveMisses)
+		return rating - 1
+	}
+	rater.ComputeProbationDecreaseCalled = func(currentRating uint32, newRating uint32) uint32 {
+		numProbationDecreases++
+		return newRating
+	}
+	rater.GetProbationConsecutiveMissesThresholdCalled = func() uint32 {
+		return 2
+	}
+	rater.GetProbationDurationInEpochsCalled = func() uint32 {
+		return probationDuration
+	}
+
+	arguments := createMockArguments()
+	arguments.NodesCoordinator = &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) (validatorsGroup []sharding.Validator, err error) {
+			return []sharding.Validator{
+				&mock.ValidatorMock{
+					PubKeyCalled: func() []byte {
+						return leaderPubKey
+					},
+				},
+				&mock.ValidatorMock{
+					PubKeyCalled: func() []byte {
+						return validatorPubKey
+					},
+				},
+			}, nil
+		},
+	}
+	arguments.PeerAdapter = peerAdapter
+	arguments.Rater = rater
+	arguments.RatingsV2EnableEpoch = epoch
+	validatorStatistics, _ := peer.NewValidatorStatisticsProcessor(arguments)
+
+	err := validatorStatistics.CheckForMissedBlocks(5, 1, []byte("prev"), 0, epoch)
+	assert.Nil(t, err)
+
+	validatorAcc := accounts[string(validatorPubKey)]
+	assert.Equal(t, []uint32{0, 1, 2}, consecutiveMissesSeen)
+	assert.Equal(t, uint32(3), validatorAcc.GetConsecutiveValidatorMisses())
+	assert.Equal(t, epoch+probationDuration, validatorAcc.GetProbationEndEpoch())
+
+	leaderAcc := accounts[string(leaderPubKey)]
+	assert.Equal(t, uint32(3), leaderAcc.GetConsecutiveProposerMisses())
+	assert.Equal(t, epoch+probationDuration, leaderAcc.GetProbationEndEpoch())
+
+	// leader and validator each spent two missed rounds in probation
+	assert.Equal(t, 4, numProbationDecreases)
+}
+
+func TestValidatorStatisticsProcessor_CheckForMissedBlocksBeforeRatingsV2ShouldNotTrackConsecutiveValidatorMisses(t *testing.T) {
+	t.Parallel()
+
+	pubKey := []byte("pubKey")
+	accounts := make(map[string]state.PeerAccountHandler)
+	peerAdapter := getAccountsMock()
+	peerAdapter.LoadAccountCalled = func(address []byte) (state.AccountHandler, error) {
+		acc, ok := accounts[string(address)]
+		if !ok {
+			acc, _ = state.NewPeerAccount(address)
+			accounts[string(address)] = acc
+		}
+		return acc, nil
+	}
+
+	rater := mock.GetNewMockRater()
+	rater.ComputeDecreaseValidatorOnConsecutiveMissesCalled = func(shardId uint32, rating uint32, consecutiveMisses uint32) uint32 {
+		assert.Fail(t, "should have not called ComputeDecreaseValidatorOnConsecutiveMisses")
+		return rating
+	}
+	rater.GetProbationConsecutiveMissesThresholdCalled = func() uint32 {
+		return 1
+	}
+
+	arguments := createMockArguments()
+	arguments.NodesCoordinator = &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) (validatorsGroup []sharding.Validator, err error) {
+			return []sharding.Validator{
+				&mock.ValidatorMock{
+					PubKeyCalled: func() []byte {
+						return []byte("leader")
+					},
+				},
+				&mock.ValidatorMock{
+					PubKeyCalled: func() []byte {
+						return pubKey
+					},
+				},
+			}, nil
+		},
+	}
+	arguments.PeerAdapter = peerAdapter
+	arguments.Rater = rater
+	arguments.RatingsV2EnableEpoch = 10
+	validatorStatistics, _ := peer.NewValidatorStatisticsProcessor(arguments)
+
+	err := validatorStatistics.CheckForMissedBlocks(5, 1, []byte("prev"), 0, 9)
+	assert.Nil(t, err)
+
+	assert.Equal(t, uint32(0), accounts[string(pubKey)].GetConsecutiveValidatorMisses())
+	assert.Equal(t, uint32(0), accounts[string(pubKey)].GetProbationEndEpoch())
+	assert.Equal(t, uint32(0), accounts["leader"].GetProbationEndEpoch())
+}
+
 func TestValidatorStatisticsProcessor_CheckForMissedBlocksWithRoundDifferenceGreaterThanMaxComputableCallsDecreaseOnlyOnce(t *testing.T) {
 	t.Parallel()
 
@@ -135,6 +135,7 @@ func cloneValidatorAPIResponse(v *state.ValidatorApiResponse) *state.ValidatorAp
 		TotalNumValidatorIgnoredSignatures: v.TotalNumValidatorIgnoredSignatures,
 		ShardId:                            v.ShardId,
 		ValidatorStatus:                    v.ValidatorStatus,
+		InProbation:                        v.InProbation,
 	}
 }
 
@@ -199,7 +200,7 @@ func (vp *validatorsProvider) createNewCache(
 	epoch uint32,
 	allNodes map[uint32][]*state.ValidatorInfo,
 ) map[string]*state.ValidatorApiResponse {
-	newCache := vp.createValidatorApiResponseMapFromValidatorInfoMap(allNodes)
+	newCache := vp.createValidatorApiResponseMapFromValidatorInfoMap(epoch, allNodes)
 
 	nodesMapEligible, err := vp.nodesCoordinator.GetAllEligibleValidatorsPublicKeys(epoch)
 	if err != nil {
@@ -216,7 +217,10 @@ func (vp *validatorsProvider) createNewCache(
 	return newCache
 }
 
-func (vp *validatorsProvider) createValidatorApiResponseMapFromValidatorInfoMap(allNodes map[uint32][]*state.ValidatorInfo) map[string]*state.ValidatorApiResponse {
+func (vp *validatorsProvider) createValidatorApiResponseMapFromValidatorInfoMap(
+	epoch uint32,
+	allNodes map[uint32][]*state.ValidatorInfo,
+) map[string]*state.ValidatorApiResponse {
 	newCache := make(map[string]*state.ValidatorApiResponse)
 	for _, validatorInfosInShard := range allNodes {
 		for _, validatorInfo := range validatorInfosInShard {
@@ -237,6 +241,7 @@ func (vp *validatorsProvider) createValidatorApiResponseMapFromValidatorInfoMap(
 				TempRating:                         float32(validatorInfo.TempRating) * 100 / float32(vp.maxRating),
 				ShardId:                            validatorInfo.ShardId,
 				ValidatorStatus:                    validatorInfo.List,
+				InProbation:                        isInProbation(validatorInfo.ProbationEndEpoch, epoch),
 			}
 		}
 	}
@@ -499,6 +499,35 @@ func TestValidatorsProvider_createCache_combined(t *testing.T) {
 	assert.Equal(t, nodesCoordinatorLeavingShardId, cache[encodedPkLeavingInTrie].ShardId)
 }
 
+func TestValidatorsProvider_createCacheShouldSetInProbation(t *testing.T) {
+	pkNotInProbation := []byte("pk1")
+	pkInProbation := []byte("pk2")
+	pkProbationEnded := []byte("pk3")
+	epoch := uint32(7)
+	validatorsMap := map[uint32][]*state.ValidatorInfo{
+		0: {
+			{PublicKey: pkNotInProbation, List: string(core.EligibleList)},
+			{PublicKey: pkInProbation, List: string(core.EligibleList), ProbationEndEpoch: epoch},
+			{PublicKey: pkProbationEnded, List: string(core.EligibleList), ProbationEndEpoch: epoch - 1},
+		},
+	}
+	arg := createDefaultValidatorsProviderArg()
+	vsp := validatorsProvider{
+		nodesCoordinator:             arg.NodesCoordinator,
+		validatorStatistics:          arg.ValidatorStatistics,
+		pubkeyConverter:              arg.PubKeyConverter,
+		cache:                        nil,
+		cacheRefreshIntervalDuration: arg.CacheRefreshIntervalDurationInSec,
+		lock:                         sync.RWMutex{},
+	}
+
+	cache := vsp.createNewCache(epoch, validatorsMap)
+
+	assert.False(t, cache[arg.PubKeyConverter.Encode(pkNotInProbation)].InProbation)
+	assert.True(t, cache[arg.PubKeyConverter.Encode(pkInProbation)].InProbation)
+	assert.False(t, cache[arg.PubKeyConverter.Encode(pkProbationEnded)].InProbation)
+}
+
 func TestValidatorsProvider_CallsPopulateOnlyAfterTimeout(t *testing.T) {
 	zeroNumner := int32(0)
 	populateCacheCalled := &zeroNumner
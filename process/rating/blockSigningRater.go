@@ -30,6 +30,10 @@ type BlockSigningRater struct {
 	shardRatingsStepHandler process.RatingsStepHandler
 	metaRatingsStepHandler  process.RatingsStepHandler
 	ratingChances           []process.RatingChanceHandler
+
+	probationConsecutiveMissesThreshold uint32
+	probationDurationInEpochs           uint32
+	probationPenaltyMultiplier          float32
 }
 
 // NewBlockSigningRater creates a new RaterHandler of Type BlockSigningRater
@@ -74,6 +78,10 @@ func NewBlockSigningRater(ratingsData process.RatingsInfoHandler) (*BlockSigning
 		shardRatingsStepHandler: ratingsData.ShardChainRatingsStepHandler(),
 		metaRatingsStepHandler:  ratingsData.MetaChainRatingsStepHandler(),
 		ratingChances:           ratingChances,
+
+		probationConsecutiveMissesThreshold: ratingsData.ProbationConsecutiveMissesThreshold(),
+		probationDurationInEpochs:           ratingsData.ProbationDurationInEpochs(),
+		probationPenaltyMultiplier:          ratingsData.ProbationPenaltyMultiplier(),
 	}, nil
 }
 
@@ -115,6 +123,25 @@ func verifyRatingsData(ratingsData process.RatingsInfoHandler) error {
 			process.ErrConsecutiveMissedBlocksPenaltyLowerThanOne,
 			ratingsData.ShardChainRatingsStepHandler().ConsecutiveMissedBlocksPenalty())
 	}
+	if ratingsData.MetaChainRatingsStepHandler().ConsecutiveMissedSignaturesPenalty() < 1 {
+		return fmt.Errorf("%w: metaChain consecutiveMissedSignaturesPenalty: %v",
+			process.ErrConsecutiveMissedSignaturesPenaltyLowerThanOne,
+			ratingsData.MetaChainRatingsStepHandler().ConsecutiveMissedSignaturesPenalty())
+	}
+	if ratingsData.ShardChainRatingsStepHandler().ConsecutiveMissedSignaturesPenalty() < 1 {
+		return fmt.Errorf("%w: shardChain consecutiveMissedSignaturesPenalty: %v",
+			process.ErrConsecutiveMissedSignaturesPenaltyLowerThanOne,
+			ratingsData.ShardChainRatingsStepHandler().ConsecutiveMissedSignaturesPenalty())
+	}
+	if ratingsData.ProbationConsecutiveMissesThreshold() == 0 ||
+		ratingsData.ProbationDurationInEpochs() == 0 ||
+		ratingsData.ProbationPenaltyMultiplier() < 1 {
+		return fmt.Errorf("%w: consecutiveMissesThreshold: %v, durationInEpochs: %v, penaltyMultiplier: %v",
+			process.ErrInvalidProbationConfig,
+			ratingsData.ProbationConsecutiveMissesThreshold(),
+			ratingsData.ProbationDurationInEpochs(),
+			ratingsData.ProbationPenaltyMultiplier())
+	}
 	if ratingsData.ShardChainRatingsStepHandler().ProposerDecreaseRatingStep() > -1 || ratingsData.ShardChainRatingsStepHandler().ValidatorDecreaseRatingStep() > -1 {
 		return fmt.Errorf("%w: shardChain decrease steps - proposer: %v, validator: %v",
 			process.ErrDecreaseRatingsStepMoreThanMinusOne,
@@ -207,19 +234,22 @@ func (bsr *BlockSigningRater) ComputeDecreaseProposer(shardId uint32, currentRat
 		consecutiveBlocksPenalty = bsr.shardRatingsStepHandler.ConsecutiveMissedBlocksPenalty()
 	}
 
-	var consecutiveMissesIncrease int32
-	computedFloat := float64(proposerDecreaseRatingStep)
+	consecutiveMissesIncrease := computeConsecutiveMissesStep(proposerDecreaseRatingStep, consecutiveBlocksPenalty, consecutiveMisses)
+	return bsr.computeRating(consecutiveMissesIncrease, currentRating)
+}
+
+func computeConsecutiveMissesStep(decreaseRatingStep int32, penalty float32, consecutiveMisses uint32) int32 {
+	computedFloat := float64(decreaseRatingStep)
 
 	for i := uint32(0); i < consecutiveMisses; i++ {
-		computedFloat *= float64(consecutiveBlocksPenalty)
+		computedFloat *= float64(penalty)
 		if computedFloat < maxDecreaseValue {
 			computedFloat = maxDecreaseValue
 			break
 		}
 	}
 
-	consecutiveMissesIncrease = int32(computedFloat)
-	return bsr.computeRating(consecutiveMissesIncrease, currentRating)
+	return int32(computedFloat)
 }
 
 // ComputeIncreaseValidator computes the new rating for the increaseValidator
@@ -246,6 +276,49 @@ func (bsr *BlockSigningRater) ComputeDecreaseValidator(shardId uint32, currentRa
 	return bsr.computeRating(ratingStep, currentRating)
 }
 
+// ComputeDecreaseValidatorOnConsecutiveMisses computes the new rating for the decreaseValidator, the decrease step
+// being multiplied by the consecutive missed signatures penalty for each previous consecutive miss
+func (bsr *BlockSigningRater) ComputeDecreaseValidatorOnConsecutiveMisses(shardId uint32, currentRating uint32, consecutiveMisses uint32) uint32 {
+	log.Trace("ComputeDecreaseValidatorOnConsecutiveMisses", "shardId", shardId, "currentRating", currentRating, "consecutiveMisses", consecutiveMisses)
+	var validatorDecreaseRatingStep int32
+	var consecutiveSignaturesPenalty float32
+	if shardId == core.MetachainShardId {
+		validatorDecreaseRatingStep = bsr.metaRatingsStepHandler.ValidatorDecreaseRatingStep()
+		consecutiveSignaturesPenalty = bsr.metaRatingsStepHandler.ConsecutiveMissedSignaturesPenalty()
+	} else {
+		validatorDecreaseRatingStep = bsr.shardRatingsStepHandler.ValidatorDecreaseRatingStep()
+		consecutiveSignaturesPenalty = bsr.shardRatingsStepHandler.ConsecutiveMissedSignaturesPenalty()
+	}
+
+	consecutiveMissesIncrease := computeConsecutiveMissesStep(validatorDecreaseRatingStep, consecutiveSignaturesPenalty, consecutiveMisses)
+	return bsr.computeRating(consecutiveMissesIncrease, currentRating)
+}
+
+// ComputeProbationDecrease multiplies the decrease from the current rating to the new rating with the probation penalty
+// multiplier. A new rating not lower than the current one is returned unchanged
+func (bsr *BlockSigningRater) ComputeProbationDecrease(currentRating uint32, newRating uint32) uint32 {
+	if newRating >= currentRating {
+		return newRating
+	}
+
+	decrease := -float64(currentRating-newRating) * float64(bsr.probationPenaltyMultiplier)
+	if decrease < maxDecreaseValue {
+		decrease = maxDecreaseValue
+	}
+
+	return bsr.computeRating(int32(decrease), currentRating)
+}
+
+// GetProbationConsecutiveMissesThreshold returns the number of consecutive misses that put a validator in probation
+func (bsr *BlockSigningRater) GetProbationConsecutiveMissesThreshold() uint32 {
+	return bsr.probationConsecutiveMissesThreshold
+}
+
+// GetProbationDurationInEpochs returns the number of epochs a validator remains in probation
+func (bsr *BlockSigningRater) GetProbationDurationInEpochs() uint32 {
+	return bsr.probationDurationInEpochs
+}
+
 // GetChance returns the chances modifier for the current rating
 func (bsr *BlockSigningRater) GetChance(currentRating uint32) uint32 {
 	chance := bsr.ratingChances[0].GetChancePercentage()
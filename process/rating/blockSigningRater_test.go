@@ -28,6 +28,10 @@ const (
 	startRating                         = uint32(50)
 	consecutiveMissedBlocksPenaltyMeta  = 1.2
 	consecutiveMissedBlocksPenaltyShard = 1.1
+	consecutiveMissedSignaturesPenalty  = 1.5
+	probationMissesThreshold            = uint32(3)
+	probationDurationInEpochs           = uint32(2)
+	probationPenaltyMultiplier          = 2
 )
 
 func createDefaultChances() []process.SelectionChance {
@@ -48,20 +52,25 @@ func createDefaultRatingsData() *mock.RatingsInfoMock {
 		MaxRatingProperty:   maxRating,
 		MinRatingProperty:   minRating,
 		MetaRatingsStepDataProperty: &mock.RatingStepMock{
-			ProposerIncreaseRatingStepProperty:     metaProposerIncreaseRatingStep,
-			ProposerDecreaseRatingStepProperty:     metaProposerDecreaseRatingStep,
-			ValidatorIncreaseRatingStepProperty:    metaValidatorIncreaseRatingStep,
-			ValidatorDecreaseRatingStepProperty:    metaValidatorDecreaseRatingStep,
-			ConsecutiveMissedBlocksPenaltyProperty: consecutiveMissedBlocksPenaltyMeta,
+			ProposerIncreaseRatingStepProperty:         metaProposerIncreaseRatingStep,
+			ProposerDecreaseRatingStepProperty:         metaProposerDecreaseRatingStep,
+			ValidatorIncreaseRatingStepProperty:        metaValidatorIncreaseRatingStep,
+			ValidatorDecreaseRatingStepProperty:        metaValidatorDecreaseRatingStep,
+			ConsecutiveMissedBlocksPenaltyProperty:     consecutiveMissedBlocksPenaltyMeta,
+			ConsecutiveMissedSignaturesPenaltyProperty: consecutiveMissedSignaturesPenalty,
 		},
 		ShardRatingsStepDataProperty: &mock.RatingStepMock{
-			ProposerIncreaseRatingStepProperty:     proposerIncreaseRatingStep,
-			ProposerDecreaseRatingStepProperty:     proposerDecreaseRatingStep,
-			ValidatorIncreaseRatingStepProperty:    validatorIncreaseRatingStep,
-			ValidatorDecreaseRatingStepProperty:    validatorDecreaseRatingStep,
-			ConsecutiveMissedBlocksPenaltyProperty: consecutiveMissedBlocksPenaltyShard,
+			ProposerIncreaseRatingStepProperty:         proposerIncreaseRatingStep,
+			ProposerDecreaseRatingStepProperty:         proposerDecreaseRatingStep,
+			ValidatorIncreaseRatingStepProperty:        validatorIncreaseRatingStep,
+			ValidatorDecreaseRatingStepProperty:        validatorDecreaseRatingStep,
+			ConsecutiveMissedBlocksPenaltyProperty:     consecutiveMissedBlocksPenaltyShard,
+			ConsecutiveMissedSignaturesPenaltyProperty: consecutiveMissedSignaturesPenalty,
 		},
-		SelectionChancesProperty: createDefaultChances(),
+		SelectionChancesProperty:                    createDefaultChances(),
+		ProbationConsecutiveMissesThresholdProperty: probationMissesThreshold,
+		ProbationDurationInEpochsProperty:           probationDurationInEpochs,
+		ProbationPenaltyMultiplierProperty:          probationPenaltyMultiplier,
 	}
 
 	return ratingsData
@@ -376,11 +385,12 @@ func TestBlockSigningRater_RevertIncreaseValidatorWithOverFlow(t *testing.T) {
 	ratingsData.StartRatingProperty = math.MaxUint32 / 2
 	ratingsData.MaxRatingProperty = math.MaxUint32
 	ratingsData.ShardRatingsStepDataProperty = &mock.RatingStepMock{
-		ProposerIncreaseRatingStepProperty:     proposerIncreaseRatingStep,
-		ProposerDecreaseRatingStepProperty:     proposerDecreaseRatingStep,
-		ValidatorIncreaseRatingStepProperty:    math.MaxInt32,
-		ValidatorDecreaseRatingStepProperty:    validatorDecreaseRatingStep,
-		ConsecutiveMissedBlocksPenaltyProperty: consecutiveMissedBlocksPenaltyShard,
+		ProposerIncreaseRatingStepProperty:         proposerIncreaseRatingStep,
+		ProposerDecreaseRatingStepProperty:         proposerDecreaseRatingStep,
+		ValidatorIncreaseRatingStepProperty:        math.MaxInt32,
+		ValidatorDecreaseRatingStepProperty:        validatorDecreaseRatingStep,
+		ConsecutiveMissedBlocksPenaltyProperty:     consecutiveMissedBlocksPenaltyShard,
+		ConsecutiveMissedSignaturesPenaltyProperty: consecutiveMissedSignaturesPenalty,
 	}
 	ratingsData.SelectionChancesProperty[len(ratingsData.SelectionChancesProperty)-1] = &rating.SelectionChance{
 		MaxThreshold:  ratingsData.MaxRating(),
@@ -544,26 +554,99 @@ func TestBlockSigningRater_ConsecutiveBlocksPenaltyLessThanOne(t *testing.T) {
 	require.True(t, strings.Contains(err.Error(), "shard"))
 }
 
+func TestBlockSigningRater_ConsecutiveSignaturesPenaltyLessThanOne(t *testing.T) {
+	rd := createDefaultRatingsData()
+	ratingStep := createRatingStepMock()
+	ratingStep.ConsecutiveMissedSignaturesPenaltyProperty = 0.5
+	rd.MetaRatingsStepDataProperty = ratingStep
+	bsr, err := rating.NewBlockSigningRater(rd)
+	require.Nil(t, bsr)
+	require.True(t, errors.Is(err, process.ErrConsecutiveMissedSignaturesPenaltyLowerThanOne))
+	require.True(t, strings.Contains(err.Error(), "meta"))
+
+	rd = createDefaultRatingsData()
+	ratingStep = createRatingStepMock()
+	ratingStep.ConsecutiveMissedSignaturesPenaltyProperty = 0.5
+	rd.ShardRatingsStepDataProperty = ratingStep
+	bsr, err = rating.NewBlockSigningRater(rd)
+	require.Nil(t, bsr)
+	require.True(t, errors.Is(err, process.ErrConsecutiveMissedSignaturesPenaltyLowerThanOne))
+	require.True(t, strings.Contains(err.Error(), "shard"))
+}
+
+func TestBlockSigningRater_InvalidProbationConfig(t *testing.T) {
+	rd := createDefaultRatingsData()
+	rd.ProbationConsecutiveMissesThresholdProperty = 0
+	bsr, err := rating.NewBlockSigningRater(rd)
+	require.Nil(t, bsr)
+	require.True(t, errors.Is(err, process.ErrInvalidProbationConfig))
+
+	rd = createDefaultRatingsData()
+	rd.ProbationDurationInEpochsProperty = 0
+	bsr, err = rating.NewBlockSigningRater(rd)
+	require.Nil(t, bsr)
+	require.True(t, errors.Is(err, process.ErrInvalidProbationConfig))
+
+	rd = createDefaultRatingsData()
+	rd.ProbationPenaltyMultiplierProperty = 0.5
+	bsr, err = rating.NewBlockSigningRater(rd)
+	require.Nil(t, bsr)
+	require.True(t, errors.Is(err, process.ErrInvalidProbationConfig))
+}
+
+func TestBlockSigningRater_ComputeDecreaseValidatorOnConsecutiveMisses(t *testing.T) {
+	rd := createDefaultRatingsData()
+	bsr, _ := rating.NewBlockSigningRater(rd)
+	shardId := uint32(0)
+
+	zeroMisses := bsr.ComputeDecreaseValidatorOnConsecutiveMisses(shardId, startRating, 0)
+	assert.Equal(t, bsr.ComputeDecreaseValidator(shardId, startRating), zeroMisses)
+
+	twoMisses := bsr.ComputeDecreaseValidatorOnConsecutiveMisses(shardId, startRating, 2)
+	decreaseStep := float64(validatorDecreaseRatingStep) * math.Pow(consecutiveMissedSignaturesPenalty, 2)
+	assert.Equal(t, uint32(int32(startRating)+int32(decreaseStep)), twoMisses)
+
+	manyMisses := bsr.ComputeDecreaseValidatorOnConsecutiveMisses(core.MetachainShardId, startRating, 100)
+	assert.Equal(t, minRating, manyMisses)
+}
+
+func TestBlockSigningRater_ComputeProbationDecrease(t *testing.T) {
+	rd := createDefaultRatingsData()
+	bsr, _ := rating.NewBlockSigningRater(rd)
+
+	assert.Equal(t, startRating+1, bsr.ComputeProbationDecrease(startRating, startRating+1))
+	assert.Equal(t, startRating, bsr.ComputeProbationDecrease(startRating, startRating))
+	assert.Equal(t, startRating-2*probationPenaltyMultiplier, bsr.ComputeProbationDecrease(startRating, startRating-2))
+	assert.Equal(t, minRating, bsr.ComputeProbationDecrease(startRating, minRating))
+	assert.Equal(t, probationMissesThreshold, bsr.GetProbationConsecutiveMissesThreshold())
+	assert.Equal(t, probationDurationInEpochs, bsr.GetProbationDurationInEpochs())
+}
+
 func TestBlockSigningRater_ComputeDecreaseProposer(t *testing.T) {
 	ratingsData := &mock.RatingsInfoMock{
 		StartRatingProperty: startRating * 100,
 		MaxRatingProperty:   maxRating * 100,
 		MinRatingProperty:   minRating * 100,
 		MetaRatingsStepDataProperty: &mock.RatingStepMock{
-			ProposerIncreaseRatingStepProperty:     metaProposerIncreaseRatingStep,
-			ProposerDecreaseRatingStepProperty:     metaProposerDecreaseRatingStep * 100,
-			ValidatorIncreaseRatingStepProperty:    metaValidatorIncreaseRatingStep,
-			ValidatorDecreaseRatingStepProperty:    metaValidatorDecreaseRatingStep,
-			ConsecutiveMissedBlocksPenaltyProperty: consecutiveMissedBlocksPenaltyMeta,
+			ProposerIncreaseRatingStepProperty:         metaProposerIncreaseRatingStep,
+			ProposerDecreaseRatingStepProperty:         metaProposerDecreaseRatingStep * 100,
+			ValidatorIncreaseRatingStepProperty:        metaValidatorIncreaseRatingStep,
+			ValidatorDecreaseRatingStepProperty:        metaValidatorDecreaseRatingStep,
+			ConsecutiveMissedBlocksPenaltyProperty:     consecutiveMissedBlocksPenaltyMeta,
+			ConsecutiveMissedSignaturesPenaltyProperty: consecutiveMissedSignaturesPenalty,
 		},
 		ShardRatingsStepDataProperty: &mock.RatingStepMock{
-			ProposerIncreaseRatingStepProperty:     proposerIncreaseRatingStep,
-			ProposerDecreaseRatingStepProperty:     proposerDecreaseRatingStep * 100,
-			ValidatorIncreaseRatingStepProperty:    validatorIncreaseRatingStep,
-			ValidatorDecreaseRatingStepProperty:    validatorDecreaseRatingStep,
-			ConsecutiveMissedBlocksPenaltyProperty: consecutiveMissedBlocksPenaltyShard,
+			ProposerIncreaseRatingStepProperty:         proposerIncreaseRatingStep,
+			ProposerDecreaseRatingStepProperty:         proposerDecreaseRatingStep * 100,
+			ValidatorIncreaseRatingStepProperty:        validatorIncreaseRatingStep,
+			ValidatorDecreaseRatingStepProperty:        validatorDecreaseRatingStep,
+			ConsecutiveMissedBlocksPenaltyProperty:     consecutiveMissedBlocksPenaltyShard,
+			ConsecutiveMissedSignaturesPenaltyProperty: consecutiveMissedSignaturesPenalty,
 		},
-		SelectionChancesProperty: createDefaultChances(),
+		SelectionChancesProperty:                    createDefaultChances(),
+		ProbationConsecutiveMissesThresholdProperty: probationMissesThreshold,
+		ProbationDurationInEpochsProperty:           probationDurationInEpochs,
+		ProbationPenaltyMultiplierProperty:          probationPenaltyMultiplier,
 	}
 
 	ratingsData.SelectionChancesProperty[len(ratingsData.SelectionChancesProperty)-1] = &rating.SelectionChance{
@@ -614,11 +697,12 @@ func TestBlockSigningRater_ComputeDecreaseProposerWithOverFlow(t *testing.T) {
 	ratingsData.StartRatingProperty = math.MaxUint32 / 2
 	ratingsData.MaxRatingProperty = math.MaxUint32
 	ratingsData.ShardRatingsStepDataProperty = &mock.RatingStepMock{
-		ProposerIncreaseRatingStepProperty:     proposerIncreaseRatingStep,
-		ProposerDecreaseRatingStepProperty:     -math.MaxUint32 / 10,
-		ValidatorIncreaseRatingStepProperty:    validatorIncreaseRatingStep,
-		ValidatorDecreaseRatingStepProperty:    validatorDecreaseRatingStep,
-		ConsecutiveMissedBlocksPenaltyProperty: 2,
+		ProposerIncreaseRatingStepProperty:         proposerIncreaseRatingStep,
+		ProposerDecreaseRatingStepProperty:         -math.MaxUint32 / 10,
+		ValidatorIncreaseRatingStepProperty:        validatorIncreaseRatingStep,
+		ValidatorDecreaseRatingStepProperty:        validatorDecreaseRatingStep,
+		ConsecutiveMissedBlocksPenaltyProperty:     2,
+		ConsecutiveMissedSignaturesPenaltyProperty: consecutiveMissedSignaturesPenalty,
 	}
 	ratingsData.SelectionChancesProperty[len(ratingsData.SelectionChancesProperty)-1] = &rating.SelectionChance{
 		MaxThreshold:  ratingsData.MaxRating(),
@@ -658,10 +742,11 @@ func TestBlockSigningRater_ComputeDecreaseProposerWithOverFlow(t *testing.T) {
 
 func createRatingStepMock() *mock.RatingStepMock {
 	return &mock.RatingStepMock{
-		ProposerIncreaseRatingStepProperty:     metaProposerIncreaseRatingStep,
-		ProposerDecreaseRatingStepProperty:     metaProposerDecreaseRatingStep,
-		ValidatorIncreaseRatingStepProperty:    metaValidatorIncreaseRatingStep,
-		ValidatorDecreaseRatingStepProperty:    metaValidatorDecreaseRatingStep,
-		ConsecutiveMissedBlocksPenaltyProperty: consecutiveMissedBlocksPenaltyMeta,
+		ProposerIncreaseRatingStepProperty:         metaProposerIncreaseRatingStep,
+		ProposerDecreaseRatingStepProperty:         metaProposerDecreaseRatingStep,
+		ValidatorIncreaseRatingStepProperty:        metaValidatorIncreaseRatingStep,
+		ValidatorDecreaseRatingStepProperty:        metaValidatorDecreaseRatingStep,
+		ConsecutiveMissedBlocksPenaltyProperty:     consecutiveMissedBlocksPenaltyMeta,
+		ConsecutiveMissedSignaturesPenaltyProperty: consecutiveMissedSignaturesPenalty,
 	}
 }
@@ -8,11 +8,12 @@ var _ process.RatingsStepHandler = (*RatingStep)(nil)
 
 // RatingStep will store information about ratingsComputation specific for a shard or metachain
 type RatingStep struct {
-	proposerIncreaseRatingStep     int32
-	proposerDecreaseRatingStep     int32
-	validatorIncreaseRatingStep    int32
-	validatorDecreaseRatingStep    int32
-	consecutiveMissedBlocksPenalty float32
+	proposerIncreaseRatingStep         int32
+	proposerDecreaseRatingStep         int32
+	validatorIncreaseRatingStep        int32
+	validatorDecreaseRatingStep        int32
+	consecutiveMissedBlocksPenalty     float32
+	consecutiveMissedSignaturesPenalty float32
 }
 
 // NewRatingStepData creates a new RatingStep instance
@@ -22,13 +23,15 @@ func NewRatingStepData(
 	validatorIncreaseRatingStep int32,
 	validatorDecreaseRatingStep int32,
 	consecutiveMissedBlocksPenalty float32,
+	consecutiveMissedSignaturesPenalty float32,
 ) process.RatingsStepHandler {
 	return &RatingStep{
-		proposerIncreaseRatingStep:     proposerIncreaseRatingStep,
-		proposerDecreaseRatingStep:     proposerDecreaseRatingStep,
-		validatorIncreaseRatingStep:    validatorIncreaseRatingStep,
-		validatorDecreaseRatingStep:    validatorDecreaseRatingStep,
-		consecutiveMissedBlocksPenalty: consecutiveMissedBlocksPenalty,
+		proposerIncreaseRatingStep:         proposerIncreaseRatingStep,
+		proposerDecreaseRatingStep:         proposerDecreaseRatingStep,
+		validatorIncreaseRatingStep:        validatorIncreaseRatingStep,
+		validatorDecreaseRatingStep:        validatorDecreaseRatingStep,
+		consecutiveMissedBlocksPenalty:     consecutiveMissedBlocksPenalty,
+		consecutiveMissedSignaturesPenalty: consecutiveMissedSignaturesPenalty,
 	}
 }
 
@@ -56,3 +59,8 @@ func (rd *RatingStep) ValidatorDecreaseRatingStep() int32 {
 func (rd *RatingStep) ConsecutiveMissedBlocksPenalty() float32 {
 	return rd.consecutiveMissedBlocksPenalty
 }
+
+// ConsecutiveMissedSignaturesPenalty will return the penalty increase for consecutive signature misses
+func (rd *RatingStep) ConsecutiveMissedSignaturesPenalty() float32 {
+	return rd.consecutiveMissedSignaturesPenalty
+}
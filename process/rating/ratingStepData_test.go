@@ -14,13 +14,15 @@ func TestRatingStepData_NewRatingStepDataShouldWork(t *testing.T) {
 	validatorIncreaseRatingStep := int32(3)
 	validatorDecreaseRatingStep := int32(-4)
 	consecutiveMissedBlocksPenalty := float32(1.1)
+	consecutiveMissedSignaturesPenalty := float32(1.2)
 
 	rsd := NewRatingStepData(
 		proposerIncreaseRatingStep,
 		proposerDecreaseRatingStep,
 		validatorIncreaseRatingStep,
 		validatorDecreaseRatingStep,
-		consecutiveMissedBlocksPenalty)
+		consecutiveMissedBlocksPenalty,
+		consecutiveMissedSignaturesPenalty)
 
 	assert.NotNil(t, rsd)
 	assert.Equal(t, proposerIncreaseRatingStep, rsd.ProposerIncreaseRatingStep())
@@ -28,4 +30,5 @@ func TestRatingStepData_NewRatingStepDataShouldWork(t *testing.T) {
 	assert.Equal(t, validatorIncreaseRatingStep, rsd.ValidatorIncreaseRatingStep())
 	assert.Equal(t, validatorDecreaseRatingStep, rsd.ValidatorDecreaseRatingStep())
 	assert.Equal(t, consecutiveMissedBlocksPenalty, rsd.ConsecutiveMissedBlocksPenalty())
+	assert.Equal(t, consecutiveMissedSignaturesPenalty, rsd.ConsecutiveMissedSignaturesPenalty())
 }
@@ -13,16 +13,17 @@ var _ process.RatingsInfoHandler = (*RatingsData)(nil)
 const milisecondsInHour = 3600 * 1000
 
 type computeRatingStepArg struct {
-	shardSize                       uint32
-	consensusSize                   uint32
-	roundTimeMilis                  uint64
-	startRating                     uint32
-	maxRating                       uint32
-	hoursToMaxRatingFromStartRating uint32
-	proposerDecreaseFactor          float32
-	validatorDecreaseFactor         float32
-	consecutiveMissedBlocksPenalty  float32
-	proposerValidatorImportance     float32
+	shardSize                          uint32
+	consensusSize                      uint32
+	roundTimeMilis                     uint64
+	startRating                        uint32
+	maxRating                          uint32
+	hoursToMaxRatingFromStartRating    uint32
+	proposerDecreaseFactor             float32
+	validatorDecreaseFactor            float32
+	consecutiveMissedBlocksPenalty     float32
+	consecutiveMissedSignaturesPenalty float32
+	proposerValidatorImportance        float32
 }
 
 // RatingsData will store information about ratingsComputation
@@ -34,6 +35,7 @@ type RatingsData struct {
 	metaRatingsStepData   process.RatingsStepHandler
 	shardRatingsStepData  process.RatingsStepHandler
 	selectionChances      []process.SelectionChance
+	probation             config.ProbationConfig
 }
 
 // RatingsDataArg contains information for the creation of the new ratingsData
@@ -63,16 +65,17 @@ func NewRatingsData(args RatingsDataArg) (*RatingsData, error) {
 	}
 
 	arg := computeRatingStepArg{
-		shardSize:                       args.ShardMinNodes,
-		consensusSize:                   args.ShardConsensusSize,
-		roundTimeMilis:                  args.RoundDurationMiliseconds,
-		startRating:                     ratingsConfig.General.StartRating,
-		maxRating:                       ratingsConfig.General.MaxRating,
-		hoursToMaxRatingFromStartRating: ratingsConfig.ShardChain.HoursToMaxRatingFromStartRating,
-		proposerDecreaseFactor:          ratingsConfig.ShardChain.ProposerDecreaseFactor,
-		validatorDecreaseFactor:         ratingsConfig.ShardChain.ValidatorDecreaseFactor,
-		consecutiveMissedBlocksPenalty:  ratingsConfig.ShardChain.ConsecutiveMissedBlocksPenalty,
-		proposerValidatorImportance:     ratingsConfig.ShardChain.ProposerValidatorImportance,
+		shardSize:                          args.ShardMinNodes,
+		consensusSize:                      args.ShardConsensusSize,
+		roundTimeMilis:                     args.RoundDurationMiliseconds,
+		startRating:                        ratingsConfig.General.StartRating,
+		maxRating:                          ratingsConfig.General.MaxRating,
+		hoursToMaxRatingFromStartRating:    ratingsConfig.ShardChain.HoursToMaxRatingFromStartRating,
+		proposerDecreaseFactor:             ratingsConfig.ShardChain.ProposerDecreaseFactor,
+		validatorDecreaseFactor:            ratingsConfig.ShardChain.ValidatorDecreaseFactor,
+		consecutiveMissedBlocksPenalty:     ratingsConfig.ShardChain.ConsecutiveMissedBlocksPenalty,
+		consecutiveMissedSignaturesPenalty: ratingsConfig.ShardChain.ConsecutiveMissedSignaturesPenalty,
+		proposerValidatorImportance:        ratingsConfig.ShardChain.ProposerValidatorImportance,
 	}
 	shardRatingStep, err := computeRatingStep(arg)
 	if err != nil {
@@ -80,16 +83,17 @@ func NewRatingsData(args RatingsDataArg) (*RatingsData, error) {
 	}
 
 	arg = computeRatingStepArg{
-		shardSize:                       args.MetaMinNodes,
-		consensusSize:                   args.MetaConsensusSize,
-		roundTimeMilis:                  args.RoundDurationMiliseconds,
-		startRating:                     ratingsConfig.General.StartRating,
-		maxRating:                       ratingsConfig.General.MaxRating,
-		hoursToMaxRatingFromStartRating: ratingsConfig.MetaChain.HoursToMaxRatingFromStartRating,
-		proposerDecreaseFactor:          ratingsConfig.MetaChain.ProposerDecreaseFactor,
-		validatorDecreaseFactor:         ratingsConfig.MetaChain.ValidatorDecreaseFactor,
-		consecutiveMissedBlocksPenalty:  ratingsConfig.MetaChain.ConsecutiveMissedBlocksPenalty,
-		proposerValidatorImportance:     ratingsConfig.MetaChain.ProposerValidatorImportance,
+		shardSize:                          args.MetaMinNodes,
+		consensusSize:                      args.MetaConsensusSize,
+		roundTimeMilis:                     args.RoundDurationMiliseconds,
+		startRating:                        ratingsConfig.General.StartRating,
+		maxRating:                          ratingsConfig.General.MaxRating,
+		hoursToMaxRatingFromStartRating:    ratingsConfig.MetaChain.HoursToMaxRatingFromStartRating,
+		proposerDecreaseFactor:             ratingsConfig.MetaChain.ProposerDecreaseFactor,
+		validatorDecreaseFactor:            ratingsConfig.MetaChain.ValidatorDecreaseFactor,
+		consecutiveMissedBlocksPenalty:     ratingsConfig.MetaChain.ConsecutiveMissedBlocksPenalty,
+		consecutiveMissedSignaturesPenalty: ratingsConfig.MetaChain.ConsecutiveMissedSignaturesPenalty,
+		proposerValidatorImportance:        ratingsConfig.MetaChain.ProposerValidatorImportance,
 	}
 	metaRatingStep, err := computeRatingStep(arg)
 	if err != nil {
@@ -104,6 +108,7 @@ func NewRatingsData(args RatingsDataArg) (*RatingsData, error) {
 		metaRatingsStepData:   metaRatingStep,
 		shardRatingsStepData:  shardRatingStep,
 		selectionChances:      chances,
+		probation:             ratingsConfig.Probation,
 	}, nil
 }
 
@@ -147,6 +152,25 @@ func verifyRatingsConfig(settings config.RatingsConfig) error {
 			process.ErrConsecutiveMissedBlocksPenaltyLowerThanOne,
 			settings.ShardChain.ConsecutiveMissedBlocksPenalty)
 	}
+	if settings.MetaChain.ConsecutiveMissedSignaturesPenalty < 1 {
+		return fmt.Errorf("%w: metaChain consecutiveMissedSignaturesPenalty: %v",
+			process.ErrConsecutiveMissedSignaturesPenaltyLowerThanOne,
+			settings.MetaChain.ConsecutiveMissedSignaturesPenalty)
+	}
+	if settings.ShardChain.ConsecutiveMissedSignaturesPenalty < 1 {
+		return fmt.Errorf("%w: shardChain consecutiveMissedSignaturesPenalty: %v",
+			process.ErrConsecutiveMissedSignaturesPenaltyLowerThanOne,
+			settings.ShardChain.ConsecutiveMissedSignaturesPenalty)
+	}
+	if settings.Probation.ConsecutiveMissesThreshold == 0 ||
+		settings.Probation.DurationInEpochs == 0 ||
+		settings.Probation.PenaltyMultiplier < 1 {
+		return fmt.Errorf("%w: consecutiveMissesThreshold: %v, durationInEpochs: %v, penaltyMultiplier: %v",
+			process.ErrInvalidProbationConfig,
+			settings.Probation.ConsecutiveMissesThreshold,
+			settings.Probation.DurationInEpochs,
+			settings.Probation.PenaltyMultiplier)
+	}
 	if settings.ShardChain.ProposerDecreaseFactor > -1 || settings.ShardChain.ValidatorDecreaseFactor > -1 {
 		return fmt.Errorf("%w: shardChain decrease steps - proposer: %v, validator: %v",
 			process.ErrDecreaseRatingsStepMoreThanMinusOne,
@@ -201,11 +225,13 @@ func computeRatingStep(
 	}
 
 	return &RatingStep{
-		proposerIncreaseRatingStep:     int32(proposerIncrease),
-		proposerDecreaseRatingStep:     int32(proposerDecrease),
-		validatorIncreaseRatingStep:    int32(validatorIncrease),
-		validatorDecreaseRatingStep:    int32(validatorDecrease),
-		consecutiveMissedBlocksPenalty: arg.consecutiveMissedBlocksPenalty}, nil
+		proposerIncreaseRatingStep:         int32(proposerIncrease),
+		proposerDecreaseRatingStep:         int32(proposerDecrease),
+		validatorIncreaseRatingStep:        int32(validatorIncrease),
+		validatorDecreaseRatingStep:        int32(validatorDecrease),
+		consecutiveMissedBlocksPenalty:     arg.consecutiveMissedBlocksPenalty,
+		consecutiveMissedSignaturesPenalty: arg.consecutiveMissedSignaturesPenalty,
+	}, nil
 }
 
 // StartRating will return the start rating
@@ -233,6 +259,21 @@ func (rd *RatingsData) SelectionChances() []process.SelectionChance {
 	return rd.selectionChances
 }
 
+// ProbationConsecutiveMissesThreshold will return the number of consecutive misses that put a validator in probation
+func (rd *RatingsData) ProbationConsecutiveMissesThreshold() uint32 {
+	return rd.probation.ConsecutiveMissesThreshold
+}
+
+// ProbationDurationInEpochs will return the number of epochs a validator remains in probation
+func (rd *RatingsData) ProbationDurationInEpochs() uint32 {
+	return rd.probation.DurationInEpochs
+}
+
+// ProbationPenaltyMultiplier will return the multiplier applied to the rating decreases of a validator in probation
+func (rd *RatingsData) ProbationPenaltyMultiplier() float32 {
+	return rd.probation.PenaltyMultiplier
+}
+
 // MetaChainRatingsStepHandler returns the RatingsStepHandler used for the Metachain
 func (rd *RatingsData) MetaChainRatingsStepHandler() process.RatingsStepHandler {
 	return rd.metaRatingsStepData
@@ -60,22 +60,29 @@ func createDummyRatingsConfig() config.RatingsConfig {
 		},
 		ShardChain: config.ShardChain{
 			RatingSteps: config.RatingSteps{
-				HoursToMaxRatingFromStartRating: 2,
-				ProposerValidatorImportance:     1,
-				ProposerDecreaseFactor:          -4,
-				ValidatorDecreaseFactor:         -4,
-				ConsecutiveMissedBlocksPenalty:  consecutiveMissedBlocksPenalty,
+				HoursToMaxRatingFromStartRating:    2,
+				ProposerValidatorImportance:        1,
+				ProposerDecreaseFactor:             -4,
+				ValidatorDecreaseFactor:            -4,
+				ConsecutiveMissedBlocksPenalty:     consecutiveMissedBlocksPenalty,
+				ConsecutiveMissedSignaturesPenalty: consecutiveMissedBlocksPenalty,
 			},
 		},
 		MetaChain: config.MetaChain{
 			RatingSteps: config.RatingSteps{
-				HoursToMaxRatingFromStartRating: 2,
-				ProposerValidatorImportance:     1,
-				ProposerDecreaseFactor:          -4,
-				ValidatorDecreaseFactor:         -4,
-				ConsecutiveMissedBlocksPenalty:  consecutiveMissedBlocksPenalty,
+				HoursToMaxRatingFromStartRating:    2,
+				ProposerValidatorImportance:        1,
+				ProposerDecreaseFactor:             -4,
+				ValidatorDecreaseFactor:            -4,
+				ConsecutiveMissedBlocksPenalty:     consecutiveMissedBlocksPenalty,
+				ConsecutiveMissedSignaturesPenalty: consecutiveMissedBlocksPenalty,
 			},
 		},
+		Probation: config.ProbationConfig{
+			ConsecutiveMissesThreshold: 10,
+			DurationInEpochs:           2,
+			PenaltyMultiplier:          2,
+		},
 	}
 }
 
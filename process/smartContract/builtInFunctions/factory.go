@@ -14,20 +14,24 @@ var log = logger.GetOrCreate("process/smartContract/builtInFunctions")
 
 // ArgsCreateBuiltInFunctionContainer -
 type ArgsCreateBuiltInFunctionContainer struct {
-	GasSchedule          core.GasScheduleNotifier
-	MapDNSAddresses      map[string]struct{}
-	EnableUserNameChange bool
-	Marshalizer          marshal.Marshalizer
-	Accounts             state.AccountsAdapter
+	GasSchedule                         core.GasScheduleNotifier
+	MapDNSAddresses                     map[string]struct{}
+	EnableUserNameChange                bool
+	Marshalizer                         marshal.Marshalizer
+	Accounts                            state.AccountsAdapter
+	EpochNotifier                       process.EpochNotifier
+	DeveloperRewardsOverrideEnableEpoch uint32
 }
 
 type builtInFuncFactory struct {
-	mapDNSAddresses      map[string]struct{}
-	enableUserNameChange bool
-	marshalizer          marshal.Marshalizer
-	accounts             state.AccountsAdapter
-	builtInFunctions     process.BuiltInFunctionContainer
-	gasConfig            *process.GasCost
+	mapDNSAddresses                     map[string]struct{}
+	enableUserNameChange                bool
+	marshalizer                         marshal.Marshalizer
+	accounts                            state.AccountsAdapter
+	epochNotifier                       process.EpochNotifier
+	developerRewardsOverrideEnableEpoch uint32
+	builtInFunctions                    process.BuiltInFunctionContainer
+	gasConfig                           *process.GasCost
 }
 
 // NewBuiltInFunctionsFactory creates a factory which will instantiate the built in functions contracts
@@ -44,12 +48,17 @@ func NewBuiltInFunctionsFactory(args ArgsCreateBuiltInFunctionContainer) (*built
 	if args.MapDNSAddresses == nil {
 		return nil, process.ErrNilDnsAddresses
 	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
 
 	b := &builtInFuncFactory{
-		mapDNSAddresses:      args.MapDNSAddresses,
-		enableUserNameChange: args.EnableUserNameChange,
-		marshalizer:          args.Marshalizer,
-		accounts:             args.Accounts,
+		mapDNSAddresses:                     args.MapDNSAddresses,
+		enableUserNameChange:                args.EnableUserNameChange,
+		marshalizer:                         args.Marshalizer,
+		accounts:                            args.Accounts,
+		epochNotifier:                       args.EpochNotifier,
+		developerRewardsOverrideEnableEpoch: args.DeveloperRewardsOverrideEnableEpoch,
 	}
 
 	var err error
@@ -99,6 +108,19 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (process.BuiltInFu
 		return nil, err
 	}
 
+	newFunc, err = NewSetDeveloperRewardsPercentageFunc(
+		b.gasConfig.BuiltInCost.SetDeveloperRewardsPercentage,
+		b.developerRewardsOverrideEnableEpoch,
+		b.epochNotifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionSetDeveloperRewardsPercentage, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
 	newFunc, err = NewSaveUserNameFunc(b.gasConfig.BuiltInCost.SaveUserName, b.mapDNSAddresses, b.enableUserNameChange)
 	if err != nil {
 		return nil, err
@@ -20,6 +20,7 @@ func createMockArguments() ArgsCreateBuiltInFunctionContainer {
 		EnableUserNameChange: false,
 		Marshalizer:          &mock.MarshalizerMock{},
 		Accounts:             &mock.AccountsStub{},
+		EpochNotifier:        &mock.EpochNotifierStub{},
 	}
 
 	return args
@@ -52,6 +53,7 @@ func fillGasMapBuiltInCosts(value uint64) map[string]uint64 {
 	gasMap["SaveKeyValue"] = value
 	gasMap["ESDTTransfer"] = value
 	gasMap["ESDTBurn"] = value
+	gasMap["SetDeveloperRewardsPercentage"] = value
 
 	return gasMap
 }
@@ -71,10 +73,16 @@ func TestCreateBuiltInFunctionContainer_Errors(t *testing.T) {
 	assert.Equal(t, process.ErrNilDnsAddresses, err)
 	assert.Nil(t, factory)
 
+	args = createMockArguments()
+	args.EpochNotifier = nil
+	factory, err = NewBuiltInFunctionsFactory(args)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+	assert.Nil(t, factory)
+
 	args = createMockArguments()
 	factory, err = NewBuiltInFunctionsFactory(args)
 	assert.Nil(t, err)
 	container, err := factory.CreateBuiltInFunctionContainer()
 	assert.Nil(t, err)
-	assert.Equal(t, len(container.Keys()), 11)
+	assert.Equal(t, len(container.Keys()), 12)
 }
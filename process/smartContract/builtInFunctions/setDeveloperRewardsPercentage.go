@@ -0,0 +1,114 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.BuiltinFunction = (*setDeveloperRewardsPercentage)(nil)
+
+// setDeveloperRewardsPercentage saves, in the data trie of a contract, the developer rewards percentage override
+// requested by its owner. The override is expressed in basis points and is bounded by the economics configuration
+// when the developer rewards are computed, so the bounds can be changed without touching the saved values.
+type setDeveloperRewardsPercentage struct {
+	gasCost         uint64
+	activationEpoch uint32
+	flagEnabled     atomic.Flag
+	mutExecution    sync.RWMutex
+}
+
+// NewSetDeveloperRewardsPercentageFunc creates a new set developer rewards percentage built-in function, usable
+// starting with the provided activation epoch
+func NewSetDeveloperRewardsPercentageFunc(
+	gasCost uint64,
+	activationEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*setDeveloperRewardsPercentage, error) {
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	s := &setDeveloperRewardsPercentage{
+		gasCost:         gasCost,
+		activationEpoch: activationEpoch,
+	}
+	epochNotifier.RegisterNotifyHandler(s)
+
+	return s, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (s *setDeveloperRewardsPercentage) SetNewGasConfig(gasCost *process.GasCost) {
+	s.mutExecution.Lock()
+	s.gasCost = gasCost.BuiltInCost.SetDeveloperRewardsPercentage
+	s.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction processes the set developer rewards percentage built-in function
+func (s *setDeveloperRewardsPercentage) ProcessBuiltinFunction(
+	acntSnd, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	s.mutExecution.RLock()
+	defer s.mutExecution.RUnlock()
+
+	if !s.flagEnabled.IsSet() {
+		return nil, process.ErrDeveloperRewardsOverrideIsDisabled
+	}
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if len(vmInput.Arguments) != 1 {
+		return nil, process.ErrInvalidArguments
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+
+	basisPoints := big.NewInt(0).SetBytes(vmInput.Arguments[0])
+	if basisPoints.Cmp(big.NewInt(core.DeveloperRewardsPercentageDenominator)) > 0 {
+		return nil, fmt.Errorf("%w, %s basis points", process.ErrInvalidDeveloperRewardsPercentage, basisPoints.String())
+	}
+	if vmInput.GasProvided < s.gasCost {
+		return nil, process.ErrNotEnoughGas
+	}
+	gasRemaining := computeGasRemaining(acntSnd, vmInput.GasProvided, s.gasCost)
+	if check.IfNil(acntDst) {
+		// cross-shard call, in sender shard only the gas is taken out
+		return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: gasRemaining}, nil
+	}
+
+	if !bytes.Equal(vmInput.CallerAddr, acntDst.GetOwnerAddress()) {
+		return nil, fmt.Errorf("%w not the owner of the account", process.ErrOperationNotPermitted)
+	}
+
+	value := make([]byte, 4)
+	binary.BigEndian.PutUint32(value, uint32(basisPoints.Uint64()))
+	err := acntDst.DataTrieTracker().SaveKeyValue([]byte(core.DeveloperRewardsPercentageKey), value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vmcommon.VMOutput{GasRemaining: gasRemaining, ReturnCode: vmcommon.Ok}, nil
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (s *setDeveloperRewardsPercentage) EpochConfirmed(epoch uint32) {
+	s.flagEnabled.Toggle(epoch >= s.activationEpoch)
+	log.Debug("set developer rewards percentage", "enabled", s.flagEnabled.IsSet())
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (s *setDeveloperRewardsPercentage) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,100 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSetDeveloperRewardsPercentageFunc_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sdrp, err := NewSetDeveloperRewardsPercentageFunc(10, 0, nil)
+	require.Nil(t, sdrp)
+	require.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestSetDeveloperRewardsPercentage_ProcessBuiltinFunctionBeforeActivationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sdrp, _ := NewSetDeveloperRewardsPercentageFunc(10, 1, &mock.EpochNotifierStub{})
+
+	owner := []byte("owner")
+	acc, _ := state.NewUserAccount([]byte("addr"))
+	acc.OwnerAddress = owner
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  owner,
+			CallValue:   big.NewInt(0),
+			GasProvided: 50,
+			Arguments:   [][]byte{big.NewInt(500).Bytes()},
+		},
+	}
+
+	_, err := sdrp.ProcessBuiltinFunction(nil, acc, vmInput)
+	require.Equal(t, process.ErrDeveloperRewardsOverrideIsDisabled, err)
+
+	sdrp.EpochConfirmed(1)
+	_, err = sdrp.ProcessBuiltinFunction(nil, acc, vmInput)
+	require.Nil(t, err)
+}
+
+func TestSetDeveloperRewardsPercentage_ProcessBuiltinFunction(t *testing.T) {
+	t.Parallel()
+
+	sdrp, _ := NewSetDeveloperRewardsPercentageFunc(10, 0, &mock.EpochNotifierStub{})
+
+	owner := []byte("owner")
+	addr := []byte("addr")
+
+	acc, _ := state.NewUserAccount(addr)
+	acc.OwnerAddress = owner
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{CallerAddr: owner, CallValue: big.NewInt(0), GasProvided: 50},
+	}
+
+	_, err := sdrp.ProcessBuiltinFunction(nil, acc, nil)
+	require.Equal(t, process.ErrNilVmInput, err)
+
+	_, err = sdrp.ProcessBuiltinFunction(nil, acc, vmInput)
+	require.Equal(t, process.ErrInvalidArguments, err)
+
+	vmInput.Arguments = [][]byte{big.NewInt(core.DeveloperRewardsPercentageDenominator + 1).Bytes()}
+	_, err = sdrp.ProcessBuiltinFunction(nil, acc, vmInput)
+	require.True(t, errors.Is(err, process.ErrInvalidDeveloperRewardsPercentage))
+
+	vmInput.Arguments = [][]byte{big.NewInt(500).Bytes()}
+	vmInput.CallValue = big.NewInt(1)
+	_, err = sdrp.ProcessBuiltinFunction(nil, acc, vmInput)
+	require.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	vmInput.CallValue = big.NewInt(0)
+	vmInput.GasProvided = 5
+	_, err = sdrp.ProcessBuiltinFunction(nil, acc, vmInput)
+	require.Equal(t, process.ErrNotEnoughGas, err)
+
+	vmInput.GasProvided = 50
+	vmOutput, err := sdrp.ProcessBuiltinFunction(acc, nil, vmInput)
+	require.Nil(t, err)
+	require.Equal(t, uint64(40), vmOutput.GasRemaining)
+
+	vmInput.CallerAddr = []byte("other")
+	_, err = sdrp.ProcessBuiltinFunction(nil, acc, vmInput)
+	require.True(t, errors.Is(err, process.ErrOperationNotPermitted))
+
+	vmInput.CallerAddr = owner
+	vmOutput, err = sdrp.ProcessBuiltinFunction(nil, acc, vmInput)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), vmOutput.GasRemaining)
+
+	value, err := acc.DataTrieTracker().RetrieveValue([]byte(core.DeveloperRewardsPercentageKey))
+	require.Nil(t, err)
+	require.Equal(t, []byte{0, 0, 1, 244}, value)
+}
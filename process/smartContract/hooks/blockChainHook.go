@@ -231,6 +231,37 @@ func (bh *BlockChainHookImpl) GetBlockhash(nonce uint64) ([]byte, error) {
 	return hash, nil
 }
 
+// GetRandomSeedByNonce returns the random seed of the committed block with the requested nonce. The random seed of a
+// block is the leader's signature on the previous random seed, so the past random seeds can not be biased
+func (bh *BlockChainHookImpl) GetRandomSeedByNonce(nonce uint64) ([]byte, error) {
+	defer stopMeasure(startMeasure("GetRandomSeedByNonce"))
+
+	hdr := bh.blockChain.GetCurrentBlockHeader()
+
+	if check.IfNil(hdr) {
+		return nil, process.ErrNilBlockHeader
+	}
+	if nonce > hdr.GetNonce() {
+		return nil, process.ErrInvalidNonceRequest
+	}
+	if nonce == hdr.GetNonce() {
+		return hdr.GetRandSeed(), nil
+	}
+
+	header, _, err := process.GetHeaderFromStorageWithNonce(
+		nonce,
+		bh.shardCoordinator.SelfId(),
+		bh.storageService,
+		bh.uint64Converter,
+		bh.marshalizer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return header.GetRandSeed(), nil
+}
+
 // LastNonce returns the nonce from from the last committed block
 func (bh *BlockChainHookImpl) LastNonce() uint64 {
 	if !check.IfNil(bh.blockChain.GetCurrentBlockHeader()) {
@@ -353,6 +353,52 @@ func TestBlockChainHookImpl_GetBlockhashFromOldEpoch(t *testing.T) {
 	assert.Equal(t, err, process.ErrInvalidBlockRequestOldEpoch)
 }
 
+func TestBlockChainHookImpl_GetRandomSeedByNonce(t *testing.T) {
+	t.Parallel()
+
+	currentHdr := &block.Header{Nonce: 10, Epoch: 10, RandSeed: []byte("current seed")}
+	pastHdr := &block.Header{Nonce: 2, Epoch: 2, RandSeed: []byte("past seed")}
+	args := createMockVMAccountsArguments()
+
+	marshaledData, _ := args.Marshalizer.Marshal(pastHdr)
+
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return currentHdr
+		},
+	}
+	args.StorageService = &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			if uint8(unitType) >= uint8(dataRetriever.ShardHdrNonceHashDataUnit) {
+				return &mock.StorerStub{
+					GetCalled: func(key []byte) ([]byte, error) {
+						return []byte("hash"), nil
+					},
+				}
+			}
+
+			return &mock.StorerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					return marshaledData, nil
+				},
+			}
+		},
+	}
+	bh, _ := hooks.NewBlockChainHookImpl(args)
+
+	seed, err := bh.GetRandomSeedByNonce(11)
+	assert.Nil(t, seed)
+	assert.Equal(t, process.ErrInvalidNonceRequest, err)
+
+	seed, err = bh.GetRandomSeedByNonce(10)
+	assert.Nil(t, err)
+	assert.Equal(t, currentHdr.RandSeed, seed)
+
+	seed, err = bh.GetRandomSeedByNonce(2)
+	assert.Nil(t, err)
+	assert.Equal(t, pastHdr.RandSeed, seed)
+}
+
 func TestBlockChainHookImpl_GettersFromBlockchainCurrentHeader(t *testing.T) {
 	t.Parallel()
 
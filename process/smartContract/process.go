@@ -2,6 +2,7 @@ package smartContract
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -35,6 +36,9 @@ const executeDurationAlarmThreshold = time.Duration(100) * time.Millisecond
 // TODO: Move to vm-common.
 const upgradeFunctionName = "upgradeContract"
 
+// developerRewardsPercentageLength is the length of the developer rewards percentage override, a big endian uint32
+const developerRewardsPercentageLength = 4
+
 var zero = big.NewInt(0)
 
 type scProcessor struct {
@@ -50,9 +54,11 @@ type scProcessor struct {
 	deployEnableEpoch              uint32
 	builtinEnableEpoch             uint32
 	penalizedTooMuchGasEnableEpoch uint32
+	devRewardsOverrideEnableEpoch  uint32
 	flagDeploy                     atomic.Flag
 	flagBuiltin                    atomic.Flag
 	flagPenalizedTooMuchGas        atomic.Flag
+	flagDevRewardsOverride         atomic.Flag
 	isGenesisProcessing            bool
 
 	badTxForwarder process.IntermediateTransactionHandler
@@ -62,6 +68,8 @@ type scProcessor struct {
 	txTypeHandler  process.TxTypeHandler
 	gasHandler     process.GasHandler
 
+	chargingEpochHandler process.ChargingEpochHandler
+
 	asyncCallbackGasLock uint64
 	asyncCallStepCost    uint64
 	esdtTransferCost     uint64
@@ -89,9 +97,11 @@ type ArgsNewSmartContractProcessor struct {
 	BuiltInFunctions               process.BuiltInFunctionContainer
 	TxLogsProcessor                process.TransactionLogProcessor
 	BadTxForwarder                 process.IntermediateTransactionHandler
+	ChargingEpochHandler           process.ChargingEpochHandler
 	DeployEnableEpoch              uint32
 	BuiltinEnableEpoch             uint32
 	PenalizedTooMuchGasEnableEpoch uint32
+	DevRewardsOverrideEnableEpoch  uint32
 	EpochNotifier                  process.EpochNotifier
 	IsGenesisProcessing            bool
 }
@@ -149,6 +159,9 @@ func NewSmartContractProcessor(args ArgsNewSmartContractProcessor) (*scProcessor
 	if check.IfNil(args.BadTxForwarder) {
 		return nil, process.ErrNilBadTxHandler
 	}
+	if check.IfNil(args.ChargingEpochHandler) {
+		return nil, process.ErrNilChargingEpochHandler
+	}
 	if check.IfNil(args.EpochNotifier) {
 		return nil, process.ErrNilEpochNotifier
 	}
@@ -175,9 +188,11 @@ func NewSmartContractProcessor(args ArgsNewSmartContractProcessor) (*scProcessor
 		builtInFunctions:               args.BuiltInFunctions,
 		txLogsProcessor:                args.TxLogsProcessor,
 		badTxForwarder:                 args.BadTxForwarder,
+		chargingEpochHandler:           args.ChargingEpochHandler,
 		deployEnableEpoch:              args.DeployEnableEpoch,
 		builtinEnableEpoch:             args.BuiltinEnableEpoch,
 		penalizedTooMuchGasEnableEpoch: args.PenalizedTooMuchGasEnableEpoch,
+		devRewardsOverrideEnableEpoch:  args.DevRewardsOverrideEnableEpoch,
 		isGenesisProcessing:            args.IsGenesisProcessing,
 	}
 
@@ -403,13 +418,14 @@ func (sc *scProcessor) finishSCExecution(
 		return 0, err
 	}
 
-	err = sc.updateDeveloperRewardsProxy(tx, vmOutput, builtInFuncGasUsed)
+	redirectedDevRwd, err := sc.updateDeveloperRewardsProxy(tx, vmOutput, builtInFuncGasUsed)
 	if err != nil {
 		log.Error("updateDeveloperRewardsProxy", "error", err.Error())
 		return 0, err
 	}
 
 	totalConsumedFee, totalDevRwd := sc.computeTotalConsumedFeeAndDevRwd(tx, vmOutput, builtInFuncGasUsed)
+	totalDevRwd = subtractRedirectedDevRwd(totalDevRwd, redirectedDevRwd)
 	sc.txFeeHandler.ProcessTransactionFee(totalConsumedFee, totalDevRwd, txHash)
 	sc.gasHandler.SetGasRefunded(vmOutput.GasRemaining, txHash)
 
@@ -420,14 +436,15 @@ func (sc *scProcessor) updateDeveloperRewardsV2(
 	tx data.TransactionHandler,
 	vmOutput *vmcommon.VMOutput,
 	builtInFuncGasUsed uint64,
-) error {
+) (*big.Int, error) {
+	redirectedDevRwd := big.NewInt(0)
 	usedGasByMainSC, err := core.SafeSubUint64(tx.GetGasLimit(), vmOutput.GasRemaining)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	usedGasByMainSC, err = core.SafeSubUint64(usedGasByMainSC, builtInFuncGasUsed)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	for _, outAcc := range vmOutput.OutputAccounts {
@@ -439,29 +456,30 @@ func (sc *scProcessor) updateDeveloperRewardsV2(
 		for _, outTransfer := range outAcc.OutputTransfers {
 			sentGas, err = core.SafeAddUint64(sentGas, outTransfer.GasLimit)
 			if err != nil {
-				return err
+				return nil, err
 			}
 
 			sentGas, err = core.SafeAddUint64(sentGas, outTransfer.GasLocked)
 			if err != nil {
-				return err
+				return nil, err
 			}
 		}
 
 		usedGasByMainSC, err = core.SafeSubUint64(usedGasByMainSC, sentGas)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		usedGasByMainSC, err = core.SafeSubUint64(usedGasByMainSC, outAcc.GasUsed)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		if outAcc.GasUsed > 0 && sc.isSelfShard(outAcc.Address) {
-			err = sc.addToDevRewardsV2(outAcc.Address, outAcc.GasUsed, tx)
-			if err != nil {
-				return err
+			redirected, errAdd := sc.addToDevRewardsV2(outAcc.Address, outAcc.GasUsed, tx)
+			if errAdd != nil {
+				return nil, errAdd
 			}
+			redirectedDevRwd.Add(redirectedDevRwd, redirected)
 		}
 	}
 
@@ -469,47 +487,77 @@ func (sc *scProcessor) updateDeveloperRewardsV2(
 	if !sc.flagDeploy.IsSet() && !sc.isSelfShard(tx.GetSndAddr()) {
 		usedGasByMainSC, err = core.SafeSubUint64(usedGasByMainSC, moveBalanceGasLimit)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	} else if !isSmartContractResult(tx) {
 		usedGasByMainSC, err = core.SafeSubUint64(usedGasByMainSC, moveBalanceGasLimit)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	err = sc.addToDevRewardsV2(tx.GetRcvAddr(), usedGasByMainSC, tx)
+	redirected, err := sc.addToDevRewardsV2(tx.GetRcvAddr(), usedGasByMainSC, tx)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	redirectedDevRwd.Add(redirectedDevRwd, redirected)
 
-	return nil
+	return redirectedDevRwd, nil
 }
 
-func (sc *scProcessor) addToDevRewardsV2(address []byte, gasUsed uint64, tx data.TransactionHandler) error {
+// addToDevRewardsV2 adds the developer rewards to the provided contract and returns the part of the default developer
+// rewards which the contract gave up through its developer percentage override
+func (sc *scProcessor) addToDevRewardsV2(address []byte, gasUsed uint64, tx data.TransactionHandler) (*big.Int, error) {
 	if core.IsEmptyAddress(address) || !core.IsSmartContractAddress(address) {
-		return nil
+		return big.NewInt(0), nil
 	}
 
-	consumedFee := sc.economicsFee.ComputeFeeForProcessing(tx, gasUsed)
-	devRwd := core.GetPercentageOfValue(consumedFee, sc.economicsFee.DeveloperPercentage())
-
 	userAcc, err := sc.getAccountFromAddress(address)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if check.IfNil(userAcc) {
-		return nil
+		return big.NewInt(0), nil
+	}
+
+	consumedFee := sc.computeFeeForProcessing(tx, gasUsed)
+	defaultDevRwd := core.GetPercentageOfValue(consumedFee, sc.economicsFee.DeveloperPercentage())
+	devRwd := defaultDevRwd
+	if sc.flagDevRewardsOverride.IsSet() {
+		devRwd = core.GetPercentageOfValue(consumedFee, sc.developerPercentage(userAcc))
 	}
 
 	userAcc.AddToDeveloperReward(devRwd)
 	err = sc.accounts.SaveAccount(userAcc)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	redirectedDevRwd := big.NewInt(0).Sub(defaultDevRwd, devRwd)
+	if redirectedDevRwd.Sign() <= 0 {
+		return big.NewInt(0), nil
+	}
+
+	return redirectedDevRwd, nil
+}
+
+// developerPercentage returns the developer rewards percentage of the provided contract, taking into account the
+// override set by the contract owner, if any
+func (sc *scProcessor) developerPercentage(userAcc state.UserAccountHandler) float64 {
+	if check.IfNil(userAcc) || check.IfNil(userAcc.DataTrieTracker()) {
+		return sc.economicsFee.DeveloperPercentage()
+	}
+
+	value, err := userAcc.DataTrieTracker().RetrieveValue([]byte(core.DeveloperRewardsPercentageKey))
+	if err != nil || len(value) != developerRewardsPercentageLength {
+		return sc.economicsFee.DeveloperPercentage()
+	}
+
+	basisPoints := binary.BigEndian.Uint32(value)
+	percentageOverride := float64(basisPoints) / core.DeveloperRewardsPercentageDenominator
+
+	return sc.economicsFee.DeveloperPercentageWithOverride(percentageOverride)
 }
 
 func (sc *scProcessor) isSelfShard(address []byte) bool {
@@ -641,8 +689,8 @@ func (sc *scProcessor) computeTotalConsumedFeeAndDevRwd(
 		)
 	}
 
-	totalFee := sc.economicsFee.ComputeFeeForProcessing(tx, consumedGas)
-	totalFeeMinusBuiltIn := sc.economicsFee.ComputeFeeForProcessing(tx, consumedGasWithoutBuiltin)
+	totalFee := sc.computeFeeForProcessing(tx, consumedGas)
+	totalFeeMinusBuiltIn := sc.computeFeeForProcessing(tx, consumedGasWithoutBuiltin)
 	totalDevRwd := core.GetPercentageOfValue(totalFeeMinusBuiltIn, sc.economicsFee.DeveloperPercentage())
 
 	if !isSmartContractResult(tx) && senderInSelfShard {
@@ -656,6 +704,18 @@ func (sc *scProcessor) computeTotalConsumedFeeAndDevRwd(
 	return totalFee, totalDevRwd
 }
 
+// computeFeeForProcessing computes the fee of the provided gas with the gas price modifier of the epoch in which the
+// gas of the transaction was charged, so that the consumed and the refunded gas add up to the charged fee
+func (sc *scProcessor) computeFeeForProcessing(tx data.TransactionHandler, gasToUse uint64) *big.Int {
+	return sc.economicsFee.ComputeFeeForProcessingInEpoch(tx, gasToUse, sc.chargingEpochHandler.ChargingEpoch())
+}
+
+// computeTxFee computes the fee of the provided transaction with the fee settings of the epoch in which its gas was
+// charged
+func (sc *scProcessor) computeTxFee(tx data.TransactionHandler) *big.Int {
+	return sc.economicsFee.ComputeTxFeeInEpoch(tx, sc.chargingEpochHandler.ChargingEpoch())
+}
+
 func (sc *scProcessor) deleteSCRsWithValueZeroGoingToMeta(scrs []data.TransactionHandler) []data.TransactionHandler {
 	if sc.shardCoordinator.SelfId() == core.MetachainShardId || len(scrs) == 0 {
 		return scrs
@@ -1312,13 +1372,14 @@ func (sc *scProcessor) DeploySmartContract(tx data.TransactionHandler, acntSnd s
 		return 0, err
 	}
 
-	err = sc.updateDeveloperRewardsProxy(tx, vmOutput, 0)
+	redirectedDevRwd, err := sc.updateDeveloperRewardsProxy(tx, vmOutput, 0)
 	if err != nil {
 		log.Debug("updateDeveloperRewardsProxy", "error", err.Error())
 		return 0, err
 	}
 
 	totalConsumedFee, totalDevRwd := sc.computeTotalConsumedFeeAndDevRwd(tx, vmOutput, 0)
+	totalDevRwd = subtractRedirectedDevRwd(totalDevRwd, redirectedDevRwd)
 	sc.txFeeHandler.ProcessTransactionFee(totalConsumedFee, totalDevRwd, txHash)
 	sc.printScDeployed(vmOutput, tx)
 	sc.gasHandler.SetGasRefunded(vmOutput.GasRemaining, txHash)
@@ -1326,18 +1387,35 @@ func (sc *scProcessor) DeploySmartContract(tx data.TransactionHandler, acntSnd s
 	return 0, nil
 }
 
+// updateDeveloperRewardsProxy adds the developer rewards to the called contracts and returns the part of the developer
+// rewards which was not given to them because of their developer percentage overrides
 func (sc *scProcessor) updateDeveloperRewardsProxy(
 	tx data.TransactionHandler,
 	vmOutput *vmcommon.VMOutput,
 	builtInFuncGasUsed uint64,
-) error {
+) (*big.Int, error) {
 	if !sc.flagDeploy.IsSet() {
-		return sc.updateDeveloperRewardsV1(tx, vmOutput, builtInFuncGasUsed)
+		return big.NewInt(0), sc.updateDeveloperRewardsV1(tx, vmOutput, builtInFuncGasUsed)
 	}
 
 	return sc.updateDeveloperRewardsV2(tx, vmOutput, builtInFuncGasUsed)
 }
 
+// subtractRedirectedDevRwd removes the redirected developer rewards from the developer fees, so they remain in the
+// accumulated fees which are distributed as protocol rewards
+func subtractRedirectedDevRwd(totalDevRwd *big.Int, redirectedDevRwd *big.Int) *big.Int {
+	if redirectedDevRwd == nil || redirectedDevRwd.Sign() <= 0 {
+		return totalDevRwd
+	}
+
+	result := big.NewInt(0).Sub(totalDevRwd, redirectedDevRwd)
+	if result.Sign() < 0 {
+		return big.NewInt(0)
+	}
+
+	return result
+}
+
 func (sc *scProcessor) printScDeployed(vmOutput *vmcommon.VMOutput, tx data.TransactionHandler) {
 	scGenerated := make([]string, 0, len(vmOutput.OutputAccounts))
 	for _, account := range vmOutput.OutputAccounts {
@@ -1371,7 +1449,7 @@ func (sc *scProcessor) processSCPayment(tx data.TransactionHandler, acntSnd stat
 		return err
 	}
 
-	cost := sc.economicsFee.ComputeTxFee(tx)
+	cost := sc.computeTxFee(tx)
 	if !sc.flagPenalizedTooMuchGas.IsSet() {
 		cost = core.SafeMul(tx.GetGasLimit(), tx.GetGasPrice())
 	}
@@ -1548,7 +1626,7 @@ func (sc *scProcessor) createSCRsWhenError(
 		accumulatedSCRData += esdtReturnData
 	}
 
-	consumedFee := sc.economicsFee.ComputeTxFee(tx)
+	consumedFee := sc.computeTxFee(tx)
 	if !sc.flagPenalizedTooMuchGas.IsSet() {
 		consumedFee = core.SafeMul(tx.GetGasLimit(), tx.GetGasPrice())
 	}
@@ -1565,7 +1643,7 @@ func (sc *scProcessor) createSCRsWhenError(
 			scr.GasPrice = tx.GetGasPrice()
 			if tx.GetGasLimit() >= gasLocked {
 				scr.GasLimit = gasLocked
-				consumedFee = sc.economicsFee.ComputeFeeForProcessing(tx, tx.GetGasLimit()-gasLocked)
+				consumedFee = sc.computeFeeForProcessing(tx, tx.GetGasLimit()-gasLocked)
 			}
 			accumulatedSCRData += "@" + core.ConvertToEvenHex(int(vmcommon.UserError))
 		} else {
@@ -1818,7 +1896,7 @@ func (sc *scProcessor) createSCRForSenderAndRelayer(
 		vmOutput.GasRefund = big.NewInt(0)
 	}
 
-	gasRefund := sc.economicsFee.ComputeFeeForProcessing(tx, vmOutput.GasRemaining)
+	gasRefund := sc.computeFeeForProcessing(tx, vmOutput.GasRemaining)
 	gasRemaining := uint64(0)
 	storageFreeRefund := big.NewInt(0)
 	// backward compatibility - there should be no refund as the storage pay was already distributed among validators
@@ -2216,6 +2294,9 @@ func (sc *scProcessor) EpochConfirmed(epoch uint32) {
 
 	sc.flagPenalizedTooMuchGas.Toggle(epoch >= sc.penalizedTooMuchGasEnableEpoch)
 	log.Debug("scProcessor: penalized too much gas", "enabled", sc.flagPenalizedTooMuchGas.IsSet())
+
+	sc.flagDevRewardsOverride.Toggle(epoch >= sc.devRewardsOverrideEnableEpoch)
+	log.Debug("scProcessor: developer rewards percentage override", "enabled", sc.flagDevRewardsOverride.IsSet())
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -91,9 +91,10 @@ func createMockSmartContractProcessorArguments() ArgsNewSmartContractProcessor {
 		GasHandler: &mock.GasHandlerMock{
 			SetGasRefundedCalled: func(gasRefunded uint64, hash []byte) {},
 		},
-		GasSchedule:      mock.NewGasScheduleNotifierMock(gasSchedule),
-		BuiltInFunctions: builtInFunctions.NewBuiltInFunctionContainer(),
-		EpochNotifier:    &mock.EpochNotifierStub{},
+		GasSchedule:          mock.NewGasScheduleNotifierMock(gasSchedule),
+		BuiltInFunctions:     builtInFunctions.NewBuiltInFunctionContainer(),
+		ChargingEpochHandler: &mock.ChargingEpochHandlerStub{},
+		EpochNotifier:        &mock.EpochNotifierStub{},
 	}
 }
 
@@ -231,6 +232,17 @@ func TestNewSmartContractProcessor_NilEpochNotifierShouldErr(t *testing.T) {
 	require.Equal(t, process.ErrNilEpochNotifier, err)
 }
 
+func TestNewSmartContractProcessor_NilChargingEpochHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.ChargingEpochHandler = nil
+	sc, err := NewSmartContractProcessor(arguments)
+
+	require.Nil(t, sc)
+	require.Equal(t, process.ErrNilChargingEpochHandler, err)
+}
+
 func TestNewSmartContractProcessor_NilEconomicsFeeShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -3382,6 +3394,94 @@ func TestGasLockedInSmartContractProcessor(t *testing.T) {
 	require.Equal(t, gasLocked, outTransfer.GasLocked)
 }
 
+func TestSmartContractProcessor_addToDevRewardsV2WithPercentageOverride(t *testing.T) {
+	t.Parallel()
+
+	scAddressWithOverride := make([]byte, 32)
+	scAddressWithOverride[31] = 1
+	scAddressWithoutOverride := make([]byte, 32)
+	scAddressWithoutOverride[31] = 2
+
+	accWithOverride, _ := state.NewUserAccount(scAddressWithOverride)
+	_ = accWithOverride.DataTrieTracker().SaveKeyValue([]byte(core.DeveloperRewardsPercentageKey), []byte{0, 0, 3, 232})
+	accWithoutOverride, _ := state.NewUserAccount(scAddressWithoutOverride)
+
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.ShardCoordinator = &mock.CoordinatorStub{ComputeIdCalled: func(address []byte) uint32 {
+		return 0
+	}}
+	arguments.AccountsDB = &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			if bytes.Equal(address, scAddressWithOverride) {
+				return accWithOverride, nil
+			}
+			return accWithoutOverride, nil
+		},
+	}
+	arguments.EconomicsFee = &mock.FeeHandlerStub{
+		DeveloperPercentageCalled: func() float64 {
+			return 0.5
+		},
+		ComputeFeeForProcessingCalled: func(tx process.TransactionWithFeeHandler, gasToUse uint64) *big.Int {
+			return core.SafeMul(tx.GetGasPrice(), gasToUse)
+		},
+	}
+	sc, _ := NewSmartContractProcessor(arguments)
+	tx := &transaction.Transaction{GasPrice: 1}
+
+	redirectedWithOverride, err := sc.addToDevRewardsV2(scAddressWithOverride, 100, tx)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(40), redirectedWithOverride)
+	assert.Equal(t, big.NewInt(10), accWithOverride.GetDeveloperReward())
+
+	redirected, err := sc.addToDevRewardsV2(scAddressWithoutOverride, 100, tx)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), redirected)
+	assert.Equal(t, big.NewInt(50), accWithoutOverride.GetDeveloperReward())
+
+	// the developer fees of the call to the contract with the override keep only what the contract received
+	totalDevRwd := subtractRedirectedDevRwd(big.NewInt(50), redirectedWithOverride)
+	assert.Equal(t, accWithOverride.GetDeveloperReward(), totalDevRwd)
+}
+
+func TestSmartContractProcessor_addToDevRewardsV2BeforeOverrideEnableEpochShouldIgnoreOverride(t *testing.T) {
+	t.Parallel()
+
+	scAddress := make([]byte, 32)
+	scAddress[31] = 1
+	acc, _ := state.NewUserAccount(scAddress)
+	_ = acc.DataTrieTracker().SaveKeyValue([]byte(core.DeveloperRewardsPercentageKey), []byte{0, 0, 3, 232})
+
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.DevRewardsOverrideEnableEpoch = 1
+	arguments.AccountsDB = &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return acc, nil
+		},
+	}
+	arguments.EconomicsFee = &mock.FeeHandlerStub{
+		DeveloperPercentageCalled: func() float64 {
+			return 0.5
+		},
+		ComputeFeeForProcessingCalled: func(tx process.TransactionWithFeeHandler, gasToUse uint64) *big.Int {
+			return core.SafeMul(tx.GetGasPrice(), gasToUse)
+		},
+	}
+	sc, _ := NewSmartContractProcessor(arguments)
+	tx := &transaction.Transaction{GasPrice: 1}
+
+	redirected, err := sc.addToDevRewardsV2(scAddress, 100, tx)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), redirected)
+	assert.Equal(t, big.NewInt(50), acc.GetDeveloperReward())
+
+	sc.EpochConfirmed(1)
+	redirected, err = sc.addToDevRewardsV2(scAddress, 100, tx)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(40), redirected)
+	assert.Equal(t, big.NewInt(60), acc.GetDeveloperReward())
+}
+
 func TestSmartContractProcessor_computeTotalConsumedFeeAndDevRwd(t *testing.T) {
 	t.Parallel()
 
@@ -3498,6 +3598,70 @@ func TestSmartContractProcessor_computeTotalConsumedFeeAndDevRwdWithDifferentSCC
 	require.Equal(t, expectedDevFees, devFees)
 }
 
+func TestSmartContractProcessor_ConsumedAndRefundedFeesUseTheChargingEpochGasPriceModifier(t *testing.T) {
+	t.Parallel()
+
+	scAccountAddress := []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x00, 0x1e, 0x2e, 0x61, 0x1a, 0x9c, 0xe1, 0xe0, 0xc8, 0xe3, 0x28, 0x3c, 0xcc, 0x7c, 0x1b, 0x0f, 0x46, 0x61, 0x91, 0x70, 0x79, 0xa7, 0x5c}
+	chargingEpoch := uint32(0)
+	currentEpoch := uint32(1)
+
+	// the gas price modifier changes from 0.01 to 0.5 in the current epoch, after the gas was charged
+	var economicsEpochHandler core.EpochSubscriberHandler
+	args := createRealEconomicsDataArgs()
+	args.Economics.FeeSettings.GasPriceModifierSettings = []config.GasPriceModifierSetting{
+		{EpochEnable: currentEpoch, GasPriceModifier: 0.5},
+	}
+	args.EpochNotifier = &mock.EpochNotifierStub{
+		RegisterNotifyHandlerCalled: func(handler core.EpochSubscriberHandler) {
+			economicsEpochHandler = handler
+		},
+	}
+	feeHandler, err := economics.NewEconomicsData(*args)
+	require.Nil(t, err)
+	economicsEpochHandler.EpochConfirmed(currentEpoch)
+	require.Equal(t, 0.5, feeHandler.GasPriceModifier())
+
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.ArgsParser = NewArgumentParser()
+	arguments.EconomicsFee = feeHandler
+	arguments.TxFeeHandler, _ = postprocess.NewFeeAccumulator()
+	arguments.ShardCoordinator = &mock.CoordinatorStub{ComputeIdCalled: func(address []byte) uint32 {
+		return 0
+	}}
+	arguments.ChargingEpochHandler = &mock.ChargingEpochHandlerStub{
+		ChargingEpochCalled: func() uint32 {
+			return chargingEpoch
+		},
+	}
+	sc, err := NewSmartContractProcessor(arguments)
+	require.Nil(t, err)
+
+	tx := &transaction.Transaction{
+		RcvAddr:  scAccountAddress,
+		GasPrice: 1000000000,
+		GasLimit: 30000000,
+		Data:     make([]byte, 100),
+	}
+	vmOutput := &vmcommon.VMOutput{
+		GasRemaining: 10000000,
+		GasRefund:    big.NewInt(0),
+	}
+	builtInGasUsed := uint64(1000000)
+
+	totalFee, devFees := sc.computeTotalConsumedFeeAndDevRwd(tx, vmOutput, builtInGasUsed)
+	expectedTotalFee, expectedDevFees := computeExpectedResults(args, tx, builtInGasUsed, vmOutput)
+	assert.Equal(t, expectedTotalFee, totalFee)
+	assert.Equal(t, expectedDevFees, devFees)
+
+	scr, _ := sc.createSCRForSenderAndRelayer(vmOutput, tx, []byte("tx hash"), vmcommon.DirectCall)
+	expectedRefund := big.NewInt(0).SetUint64(vmOutput.GasRemaining * uint64(float64(tx.GasPrice)*0.01))
+	assert.Equal(t, expectedRefund, scr.Value)
+
+	chargedFee := feeHandler.ComputeTxFeeInEpoch(tx, chargingEpoch)
+	assert.Equal(t, chargedFee, big.NewInt(0).Add(totalFee, scr.Value))
+	assert.NotEqual(t, feeHandler.ComputeTxFee(tx), chargedFee)
+}
+
 func TestSmartContractProcessor_finishSCExecutionV2(t *testing.T) {
 	scAccountAddress := []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x00, 0x1e, 0x2e, 0x61, 0x1a, 0x9c, 0xe1, 0xe0, 0xc8, 0xe3, 0x28, 0x3c, 0xcc, 0x7c, 0x1b, 0x0f, 0x46, 0x61, 0x91, 0x70, 0x79, 0xa7, 0x5c}
 	tests := []struct {
@@ -1,16 +1,22 @@
 package smartContract
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math"
 	"math/big"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/parsers"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/pkg/errors"
 )
@@ -19,39 +25,78 @@ var _ process.SCQueryService = (*SCQueryService)(nil)
 
 // SCQueryService can execute Get functions over SC to fetch stored values
 type SCQueryService struct {
-	vmContainer    process.VirtualMachinesContainer
-	economicsFee   process.FeeHandler
-	mutRunSc       sync.Mutex
-	blockChainHook process.BlockChainHookHandler
-	blockChain     data.ChainHandler
-	numQueries     int
+	vmContainer              process.VirtualMachinesContainer
+	economicsFee             process.FeeHandler
+	mutRunSc                 sync.Mutex
+	blockChainHook           process.BlockChainHookHandler
+	blockChain               data.ChainHandler
+	numQueries               int
+	historicalQueriesEnabled bool
+	accounts                 state.AccountsAdapter
+	storageService           dataRetriever.StorageService
+	marshalizer              marshal.Marshalizer
+	uint64Converter          typeConverters.Uint64ByteSliceConverter
+	shardID                  uint32
+}
+
+// ArgsNewSCQueryService defines the arguments needed for the sc query service
+type ArgsNewSCQueryService struct {
+	VmContainer    process.VirtualMachinesContainer
+	EconomicsFee   process.FeeHandler
+	BlockChainHook process.BlockChainHookHandler
+	BlockChain     data.ChainHandler
+
+	// the following arguments are used only when the historical queries are enabled. In this case, Accounts
+	// should be the accounts adapter used only by the blockchain hook of this service, as its trie is recreated
+	// on each query
+	HistoricalQueriesEnabled bool
+	Accounts                 state.AccountsAdapter
+	StorageService           dataRetriever.StorageService
+	Marshalizer              marshal.Marshalizer
+	Uint64Converter          typeConverters.Uint64ByteSliceConverter
+	ShardID                  uint32
 }
 
 // NewSCQueryService returns a new instance of SCQueryService
-func NewSCQueryService(
-	vmContainer process.VirtualMachinesContainer,
-	economicsFee process.FeeHandler,
-	blockChainHook process.BlockChainHookHandler,
-	blockChain data.ChainHandler,
-) (*SCQueryService, error) {
-	if check.IfNil(vmContainer) {
+func NewSCQueryService(args ArgsNewSCQueryService) (*SCQueryService, error) {
+	if check.IfNil(args.VmContainer) {
 		return nil, process.ErrNoVM
 	}
-	if check.IfNil(economicsFee) {
+	if check.IfNil(args.EconomicsFee) {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
-	if check.IfNil(blockChainHook) {
+	if check.IfNil(args.BlockChainHook) {
 		return nil, process.ErrNilBlockChainHook
 	}
-	if check.IfNil(blockChain) {
+	if check.IfNil(args.BlockChain) {
 		return nil, process.ErrNilBlockChain
 	}
+	if args.HistoricalQueriesEnabled {
+		if check.IfNil(args.Accounts) {
+			return nil, process.ErrNilAccountsAdapter
+		}
+		if check.IfNil(args.StorageService) {
+			return nil, process.ErrNilStorage
+		}
+		if check.IfNil(args.Marshalizer) {
+			return nil, process.ErrNilMarshalizer
+		}
+		if check.IfNil(args.Uint64Converter) {
+			return nil, process.ErrNilUint64Converter
+		}
+	}
 
 	return &SCQueryService{
-		vmContainer:    vmContainer,
-		economicsFee:   economicsFee,
-		blockChain:     blockChain,
-		blockChainHook: blockChainHook,
+		vmContainer:              args.VmContainer,
+		economicsFee:             args.EconomicsFee,
+		blockChain:               args.BlockChain,
+		blockChainHook:           args.BlockChainHook,
+		historicalQueriesEnabled: args.HistoricalQueriesEnabled,
+		accounts:                 args.Accounts,
+		storageService:           args.StorageService,
+		marshalizer:              args.Marshalizer,
+		uint64Converter:          args.Uint64Converter,
+		shardID:                  args.ShardID,
 	}, nil
 }
 
@@ -74,7 +119,17 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	log.Debug("executeScCall", "function", query.FuncName, "numQueries", service.numQueries)
 	service.numQueries++
 
-	service.blockChainHook.SetCurrentHeader(service.blockChain.GetCurrentBlockHeader())
+	header, err := service.getQueryBlockHeader(query)
+	if err != nil {
+		return nil, err
+	}
+	if service.historicalQueriesEnabled {
+		err = service.recreateAccounts(header)
+		if err != nil {
+			return nil, err
+		}
+	}
+	service.blockChainHook.SetCurrentHeader(header)
 
 	vm, err := findVMByScAddress(service.vmContainer, query.ScAddress)
 	if err != nil {
@@ -105,6 +160,69 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	return vmOutput, nil
 }
 
+// getQueryBlockHeader returns the header of the block specified by the query or the current block header if the
+// query does not specify a block
+func (service *SCQueryService) getQueryBlockHeader(query *process.SCQuery) (data.HeaderHandler, error) {
+	if query.BlockNonce == nil && len(query.BlockHash) == 0 {
+		return service.blockChain.GetCurrentBlockHeader(), nil
+	}
+	if !service.historicalQueriesEnabled {
+		return nil, process.ErrHistoricalQueriesNotEnabled
+	}
+
+	if len(query.BlockHash) == 0 {
+		header, _, err := process.GetHeaderFromStorageWithNonce(
+			*query.BlockNonce,
+			service.shardID,
+			service.storageService,
+			service.uint64Converter,
+			service.marshalizer,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%w for nonce %d: %s", process.ErrMissingHeader, *query.BlockNonce, err.Error())
+		}
+
+		return header, nil
+	}
+
+	header, err := service.getHeaderByHash(query.BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for hash %s: %s", process.ErrMissingHeader, hex.EncodeToString(query.BlockHash), err.Error())
+	}
+	if query.BlockNonce != nil && header.GetNonce() != *query.BlockNonce {
+		return nil, fmt.Errorf("%w: block %s has nonce %d", process.ErrBlockNonceHashMismatch,
+			hex.EncodeToString(query.BlockHash), header.GetNonce())
+	}
+
+	return header, nil
+}
+
+func (service *SCQueryService) getHeaderByHash(hash []byte) (data.HeaderHandler, error) {
+	if service.shardID == core.MetachainShardId {
+		return process.GetMetaHeaderFromStorage(hash, service.marshalizer, service.storageService)
+	}
+
+	return process.GetShardHeaderFromStorage(hash, service.marshalizer, service.storageService)
+}
+
+// recreateAccounts pins the accounts of the blockchain hook to the state of the provided block. The trie is
+// recreated on each query so the changes made while running a previous query are discarded
+func (service *SCQueryService) recreateAccounts(header data.HeaderHandler) error {
+	if check.IfNil(header) {
+		header = service.blockChain.GetGenesisHeader()
+	}
+	if check.IfNil(header) {
+		return process.ErrNilBlockHeader
+	}
+
+	err := service.accounts.RecreateTrie(header.GetRootHash())
+	if err != nil {
+		return fmt.Errorf("%w for block nonce %d, the state might have been pruned", err, header.GetNonce())
+	}
+
+	return nil
+}
+
 func prepareScQuery(query *process.SCQuery) *process.SCQuery {
 	if query.CallerAddr == nil {
 		query.CallerAddr = query.ScAddress
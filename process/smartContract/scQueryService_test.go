@@ -2,6 +2,8 @@ package smartContract
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"math"
 	"math/big"
 	"sync"
@@ -10,19 +12,87 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 const DummyScAddress = "00000000000000000500fabd9501b7e5353de57a4e319857c2fb99089770720a"
 
+func createMockArgumentsForSCQuery() ArgsNewSCQueryService {
+	return ArgsNewSCQueryService{
+		VmContainer:    &mock.VMContainerMock{},
+		EconomicsFee:   &mock.FeeHandlerStub{},
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	}
+}
+
+func createMockArgumentsForHistoricalSCQuery(headers map[uint64]*block.Header) ArgsNewSCQueryService {
+	marshalizer := &mock.MarshalizerMock{}
+	uint64Converter := &mock.Uint64ByteSliceConverterMock{
+		ToByteSliceCalled: func(value uint64) []byte {
+			return []byte(fmt.Sprintf("%d", value))
+		},
+	}
+
+	hashesByNonce := make(map[string][]byte)
+	headersByHash := make(map[string][]byte)
+	for nonce, header := range headers {
+		hash := []byte(fmt.Sprintf("hash%d", nonce))
+		hashesByNonce[string(uint64Converter.ToByteSlice(nonce))] = hash
+		headersByHash[string(hash)], _ = marshalizer.Marshal(header)
+	}
+	getFromMap := func(values map[string][]byte) *mock.StorerStub {
+		return &mock.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				value, ok := values[string(key)]
+				if !ok {
+					return nil, errors.New("key not found")
+				}
+				return value, nil
+			},
+		}
+	}
+
+	args := createMockArgumentsForSCQuery()
+	args.VmContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+			return &mock.VMExecutionHandlerStub{
+				RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+					return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+				},
+			}, nil
+		},
+	}
+	args.HistoricalQueriesEnabled = true
+	args.Accounts = &mock.AccountsStub{}
+	args.Marshalizer = marshalizer
+	args.Uint64Converter = uint64Converter
+	args.StorageService = &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			if unitType == dataRetriever.BlockHeaderUnit {
+				return getFromMap(headersByHash)
+			}
+			return getFromMap(hashesByNonce)
+		},
+	}
+
+	return args
+}
+
 func TestNewSCQueryService_NilVmShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(nil, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	args := createMockArgumentsForSCQuery()
+	args.VmContainer = nil
+	target, err := NewSCQueryService(args)
 
 	assert.Nil(t, target)
 	assert.Equal(t, process.ErrNoVM, err)
@@ -31,7 +101,9 @@ func TestNewSCQueryService_NilVmShouldErr(t *testing.T) {
 func TestNewSCQueryService_NilFeeHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(&mock.VMContainerMock{}, nil, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	args := createMockArgumentsForSCQuery()
+	args.EconomicsFee = nil
+	target, err := NewSCQueryService(args)
 
 	assert.Nil(t, target)
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
@@ -40,7 +112,7 @@ func TestNewSCQueryService_NilFeeHandlerShouldErr(t *testing.T) {
 func TestNewSCQueryService_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	target, err := NewSCQueryService(createMockArgumentsForSCQuery())
 
 	assert.NotNil(t, target)
 	assert.Nil(t, err)
@@ -50,7 +122,7 @@ func TestNewSCQueryService_ShouldWork(t *testing.T) {
 func TestExecuteQuery_GetNilAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	target, _ := NewSCQueryService(createMockArgumentsForSCQuery())
 
 	query := process.SCQuery{
 		ScAddress: nil,
@@ -67,7 +139,7 @@ func TestExecuteQuery_GetNilAddressShouldErr(t *testing.T) {
 func TestExecuteQuery_EmptyFunctionShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	target, _ := NewSCQueryService(createMockArgumentsForSCQuery())
 
 	query := process.SCQuery{
 		ScAddress: []byte{0},
@@ -103,20 +175,20 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 		},
 	}
 
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		&mock.FeeHandlerStub{
+		EconomicsFee: &mock.FeeHandlerStub{
 			MaxGasLimitPerBlockCalled: func() uint64 {
 				return uint64(math.MaxUint64)
 			},
 		},
-		&mock.BlockChainHookHandlerMock{},
-		&mock.BlockChainMock{},
-	)
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	dataArgs := make([][]byte, len(args))
 	for i, arg := range args {
@@ -146,20 +218,20 @@ func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
 		},
 	}
 
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		&mock.FeeHandlerStub{
+		EconomicsFee: &mock.FeeHandlerStub{
 			MaxGasLimitPerBlockCalled: func() uint64 {
 				return uint64(math.MaxUint64)
 			},
 		},
-		&mock.BlockChainHookHandlerMock{},
-		&mock.BlockChainMock{},
-	)
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	query := process.SCQuery{
 		ScAddress: []byte(DummyScAddress),
@@ -185,20 +257,20 @@ func TestExecuteQuery_WhenNotOkCodeShouldErr(t *testing.T) {
 			}, nil
 		},
 	}
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		&mock.FeeHandlerStub{
+		EconomicsFee: &mock.FeeHandlerStub{
 			MaxGasLimitPerBlockCalled: func() uint64 {
 				return uint64(math.MaxUint64)
 			},
 		},
-		&mock.BlockChainHookHandlerMock{},
-		&mock.BlockChainMock{},
-	)
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	query := process.SCQuery{
 		ScAddress: []byte(DummyScAddress),
@@ -235,20 +307,20 @@ func TestExecuteQuery_ShouldCallRunScSequentially(t *testing.T) {
 		},
 	}
 
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		&mock.FeeHandlerStub{
+		EconomicsFee: &mock.FeeHandlerStub{
 			MaxGasLimitPerBlockCalled: func() uint64 {
 				return uint64(math.MaxUint64)
 			},
 		},
-		&mock.BlockChainHookHandlerMock{},
-		&mock.BlockChainMock{},
-	)
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	noOfGoRoutines := 50
 	wg := sync.WaitGroup{}
@@ -285,20 +357,20 @@ func TestSCQueryService_ExecuteQueryShouldNotIncludeCallerAddressAndValue(t *tes
 		},
 	}
 
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		&mock.FeeHandlerStub{
+		EconomicsFee: &mock.FeeHandlerStub{
 			MaxGasLimitPerBlockCalled: func() uint64 {
 				return uint64(math.MaxUint64)
 			},
 		},
-		&mock.BlockChainHookHandlerMock{},
-		&mock.BlockChainMock{},
-	)
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	query := process.SCQuery{
 		ScAddress: []byte(DummyScAddress),
@@ -329,20 +401,20 @@ func TestSCQueryService_ExecuteQueryShouldIncludeCallerAddressAndValue(t *testin
 		},
 	}
 
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		&mock.FeeHandlerStub{
+		EconomicsFee: &mock.FeeHandlerStub{
 			MaxGasLimitPerBlockCalled: func() uint64 {
 				return uint64(math.MaxUint64)
 			},
 		},
-		&mock.BlockChainHookHandlerMock{},
-		&mock.BlockChainMock{},
-	)
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	query := process.SCQuery{
 		ScAddress:  []byte(DummyScAddress),
@@ -370,20 +442,20 @@ func TestSCQueryService_ComputeTxCostScCall(t *testing.T) {
 		},
 	}
 
-	target, _ := NewSCQueryService(
-		&mock.VMContainerMock{
+	target, _ := NewSCQueryService(ArgsNewSCQueryService{
+		VmContainer: &mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
 			},
 		},
-		&mock.FeeHandlerStub{
+		EconomicsFee: &mock.FeeHandlerStub{
 			MaxGasLimitPerBlockCalled: func() uint64 {
 				return uint64(math.MaxUint64)
 			},
 		},
-		&mock.BlockChainHookHandlerMock{},
-		&mock.BlockChainMock{},
-	)
+		BlockChainHook: &mock.BlockChainHookHandlerMock{},
+		BlockChain:     &mock.BlockChainMock{},
+	})
 
 	tx := &transaction.Transaction{
 		RcvAddr: []byte(DummyScAddress),
@@ -393,3 +465,129 @@ func TestSCQueryService_ComputeTxCostScCall(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, consumedGas, cost)
 }
+
+func TestNewSCQueryService_HistoricalQueriesWithNilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForHistoricalSCQuery(nil)
+	args.Accounts = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestExecuteQuery_HistoricalQueriesNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	target, _ := NewSCQueryService(createMockArgumentsForSCQuery())
+
+	blockNonce := uint64(5)
+	query := process.SCQuery{
+		ScAddress:  []byte(DummyScAddress),
+		FuncName:   "function",
+		BlockNonce: &blockNonce,
+	}
+
+	output, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, output)
+	assert.Equal(t, process.ErrHistoricalQueriesNotEnabled, err)
+}
+
+func TestExecuteQuery_HistoricalQueryByNonceShouldUseTheBlockState(t *testing.T) {
+	t.Parallel()
+
+	headers := map[uint64]*block.Header{
+		5: {Nonce: 5, RootHash: []byte("root hash 5")},
+		6: {Nonce: 6, RootHash: []byte("root hash 6")},
+	}
+	args := createMockArgumentsForHistoricalSCQuery(headers)
+	recreatedRootHashes := make([][]byte, 0)
+	args.Accounts = &mock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			recreatedRootHashes = append(recreatedRootHashes, rootHash)
+			return nil
+		},
+	}
+	var currentHeader data.HeaderHandler
+	args.BlockChainHook = &mock.BlockChainHookHandlerMock{
+		SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+			currentHeader = hdr
+		},
+	}
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: 7, RootHash: []byte("root hash 7")}
+		},
+	}
+	target, _ := NewSCQueryService(args)
+
+	blockNonce := uint64(5)
+	query := process.SCQuery{
+		ScAddress:  []byte(DummyScAddress),
+		FuncName:   "function",
+		BlockNonce: &blockNonce,
+	}
+	_, err := target.ExecuteQuery(&query)
+	require.Nil(t, err)
+	require.Equal(t, uint64(5), currentHeader.GetNonce())
+
+	query = process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+		BlockHash: []byte("hash6"),
+	}
+	_, err = target.ExecuteQuery(&query)
+	require.Nil(t, err)
+	require.Equal(t, uint64(6), currentHeader.GetNonce())
+
+	query = process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+	}
+	_, err = target.ExecuteQuery(&query)
+	require.Nil(t, err)
+	require.Equal(t, uint64(7), currentHeader.GetNonce())
+
+	expectedRootHashes := [][]byte{[]byte("root hash 5"), []byte("root hash 6"), []byte("root hash 7")}
+	assert.Equal(t, expectedRootHashes, recreatedRootHashes)
+}
+
+func TestExecuteQuery_HistoricalQueryWithMismatchedNonceAndHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	headers := map[uint64]*block.Header{
+		5: {Nonce: 5, RootHash: []byte("root hash 5")},
+	}
+	target, _ := NewSCQueryService(createMockArgumentsForHistoricalSCQuery(headers))
+
+	blockNonce := uint64(6)
+	query := process.SCQuery{
+		ScAddress:  []byte(DummyScAddress),
+		FuncName:   "function",
+		BlockNonce: &blockNonce,
+		BlockHash:  []byte("hash5"),
+	}
+	output, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, output)
+	assert.True(t, errors.Is(err, process.ErrBlockNonceHashMismatch))
+}
+
+func TestExecuteQuery_HistoricalQueryOnMissingBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	target, _ := NewSCQueryService(createMockArgumentsForHistoricalSCQuery(nil))
+
+	blockNonce := uint64(5)
+	query := process.SCQuery{
+		ScAddress:  []byte(DummyScAddress),
+		FuncName:   "function",
+		BlockNonce: &blockNonce,
+	}
+	output, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, output)
+	assert.True(t, errors.Is(err, process.ErrMissingHeader))
+}
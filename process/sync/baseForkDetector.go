@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"math"
 	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -652,7 +653,8 @@ func (bfd *baseForkDetector) cleanupReceivedHeadersHigherThanNonce(nonce uint64)
 }
 
 func (bfd *baseForkDetector) computeGenesisTimeFromHeader(headerHandler data.HeaderHandler) int64 {
-	genesisTime := int64(headerHandler.GetTimeStamp() - (headerHandler.GetRound()-bfd.genesisRound)*uint64(bfd.rounder.TimeDuration().Seconds()))
+	timeSinceGenesis := bfd.rounder.TimeDurationBetweenRounds(int64(bfd.genesisRound), int64(headerHandler.GetRound()))
+	genesisTime := int64(headerHandler.GetTimeStamp()) - int64(timeSinceGenesis/time.Second)
 	return genesisTime
 }
 
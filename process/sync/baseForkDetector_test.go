@@ -1068,6 +1068,32 @@ func TestBaseForkDetector_ComputeTimeDuration(t *testing.T) {
 	assert.Equal(t, int64(expectedTimeStamp), timeDuration)
 }
 
+func TestBaseForkDetector_ComputeGenesisTimeFromHeaderShouldUseTheRoundDurationChange(t *testing.T) {
+	t.Parallel()
+
+	changeRound := int64(10)
+	rounderMock := &mock.RounderMock{
+		RoundTimeDuration: 6 * time.Second,
+		TimeDurationBetweenRoundsCalled: func(fromRound int64, toRound int64) time.Duration {
+			if toRound <= changeRound {
+				return time.Duration(toRound-fromRound) * 6 * time.Second
+			}
+			return time.Duration(changeRound-fromRound)*6*time.Second + time.Duration(toRound-changeRound)*4*time.Second
+		},
+	}
+
+	genesisTime := int64(9000)
+	bfd, _ := sync.NewShardForkDetector(
+		rounderMock,
+		&mock.BlackListHandlerStub{},
+		&mock.BlockTrackerMock{},
+		genesisTime,
+	)
+
+	hdr := &block.Header{Nonce: 1, Round: 20, PubKeysBitmap: []byte("X"), TimeStamp: uint64(genesisTime + 10*6 + 10*4)}
+	assert.Equal(t, genesisTime, bfd.ComputeGenesisTimeFromHeader(hdr))
+}
+
 func TestShardForkDetector_RemoveHeaderShouldComputeFinalCheckpoint(t *testing.T) {
 	t.Parallel()
 
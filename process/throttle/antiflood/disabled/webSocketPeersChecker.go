@@ -0,0 +1,17 @@
+package disabled
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// WebSocketPeersChecker is a disabled web socket peers checker
+type WebSocketPeersChecker struct {
+}
+
+// IsConnectedViaWebSocket returns false
+func (w *WebSocketPeersChecker) IsConnectedViaWebSocket(_ core.PeerID) bool {
+	return false
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (w *WebSocketPeersChecker) IsInterfaceNil() bool {
+	return w == nil
+}
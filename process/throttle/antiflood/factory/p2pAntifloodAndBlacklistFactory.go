@@ -28,6 +28,7 @@ const fastReactingIdentifier = "fast_reacting"
 const slowReactingIdentifier = "slow_reacting"
 const outOfSpecsIdentifier = "out_of_specs"
 const outputIdentifier = "output"
+const webSocketPeersIdentifier = "web_socket_peers"
 
 // NewP2PAntiFloodAndBlackList will return instances of antiflood and blacklist, based on the config
 func NewP2PAntiFloodAndBlackList(
@@ -113,6 +114,25 @@ func initP2PAntiFloodAndBlackList(
 		return nil, nil, nil, err
 	}
 
+	if mainConfig.Antiflood.WebSocketPeers.Enabled {
+		webSocketFloodPreventer, errCreate := createFloodPreventer(
+			mainConfig.Antiflood.WebSocketPeers.FloodPreventerConfig,
+			mainConfig.Antiflood.Cache,
+			statusHandler,
+			webSocketPeersIdentifier,
+			p2pPeerBlackList,
+			currentPid,
+		)
+		if errCreate != nil {
+			return nil, nil, nil, fmt.Errorf("%w when creating web socket peers flood preventer", errCreate)
+		}
+
+		err = p2pAntiflood.SetWebSocketFloodPreventers(webSocketFloodPreventer)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
 	startResettingTopicFloodPreventer(topicFloodPreventer, topicMaxMessages)
 	startSweepingTimeCaches(p2pPeerBlackList, publicKeysCache)
 
@@ -27,6 +27,9 @@ type p2pAntiflood struct {
 	peerValidatorMapper process.PeerValidatorMapper
 	mapTopicsFromAll    map[string]struct{}
 	mutTopicCheck       sync.RWMutex
+	mutWebSocket        sync.RWMutex
+	webSocketChecker    process.WebSocketPeersChecker
+	webSocketPreventers []process.FloodPreventer
 }
 
 // NewP2PAntiflood creates a new p2p anti flood protection mechanism built on top of a flood preventer implementation.
@@ -54,6 +57,7 @@ func NewP2PAntiflood(
 		debugger:            &disabled.AntifloodDebugger{},
 		mapTopicsFromAll:    make(map[string]struct{}),
 		peerValidatorMapper: &disabled.PeerValidatorMapper{},
+		webSocketChecker:    &disabled.WebSocketPeersChecker{},
 	}, nil
 }
 
@@ -64,7 +68,7 @@ func (af *p2pAntiflood) CanProcessMessage(message p2p.MessageP2P, fromConnectedP
 	}
 
 	var lastErrFound error
-	for _, fp := range af.floodPreventers {
+	for _, fp := range af.floodPreventersForPeer(fromConnectedPeer) {
 		err := af.canProcessMessage(fp, message, fromConnectedPeer)
 		if err != nil {
 			lastErrFound = err
@@ -93,6 +97,22 @@ func (af *p2pAntiflood) CanProcessMessage(message p2p.MessageP2P, fromConnectedP
 	return nil
 }
 
+// floodPreventersForPeer returns the dedicated flood preventers if the provided peer is connected through
+// the web socket listener, otherwise the default ones
+func (af *p2pAntiflood) floodPreventersForPeer(pid core.PeerID) []process.FloodPreventer {
+	af.mutWebSocket.RLock()
+	defer af.mutWebSocket.RUnlock()
+
+	if len(af.webSocketPreventers) == 0 {
+		return af.floodPreventers
+	}
+	if !af.webSocketChecker.IsConnectedViaWebSocket(pid) {
+		return af.floodPreventers
+	}
+
+	return af.webSocketPreventers
+}
+
 // IsOriginatorEligibleForTopic returns error if pid is not allowed to send messages on topic
 func (af *p2pAntiflood) IsOriginatorEligibleForTopic(pid core.PeerID, topic string) error {
 	af.mutTopicCheck.RLock()
@@ -134,6 +154,38 @@ func (af *p2pAntiflood) SetPeerValidatorMapper(validatorMapper process.PeerValid
 	return nil
 }
 
+// SetWebSocketPeersChecker sets the component able to tell if a peer is connected through the web socket listener
+func (af *p2pAntiflood) SetWebSocketPeersChecker(checker process.WebSocketPeersChecker) error {
+	if check.IfNil(checker) {
+		return process.ErrNilWebSocketPeersChecker
+	}
+
+	af.mutWebSocket.Lock()
+	af.webSocketChecker = checker
+	af.mutWebSocket.Unlock()
+
+	return nil
+}
+
+// SetWebSocketFloodPreventers sets the flood preventers that will be applied, instead of the default ones,
+// on the messages received from the peers connected through the web socket listener
+func (af *p2pAntiflood) SetWebSocketFloodPreventers(floodPreventers ...process.FloodPreventer) error {
+	if len(floodPreventers) == 0 {
+		return process.ErrEmptyFloodPreventerList
+	}
+	for _, fp := range floodPreventers {
+		if check.IfNil(fp) {
+			return process.ErrNilFloodPreventer
+		}
+	}
+
+	af.mutWebSocket.Lock()
+	af.webSocketPreventers = floodPreventers
+	af.mutWebSocket.Unlock()
+
+	return nil
+}
+
 func (af *p2pAntiflood) recordDebugEvent(pid core.PeerID, topics []string, numRejected uint32, sizeRejected uint64, sequence []byte, isBlacklisted bool) {
 	if len(topics) == 0 {
 		topics = []string{unidentifiedTopic}
@@ -215,6 +267,12 @@ func (af *p2pAntiflood) ApplyConsensusSize(size int) {
 	for _, fp := range af.floodPreventers {
 		fp.ApplyConsensusSize(size)
 	}
+
+	af.mutWebSocket.RLock()
+	for _, fp := range af.webSocketPreventers {
+		fp.ApplyConsensusSize(size)
+	}
+	af.mutWebSocket.RUnlock()
 }
 
 // SetDebugger sets the antiflood debugger
@@ -190,6 +190,83 @@ func TestP2PAntiflood_ShouldWorkWithMoreThanOneFlodPreventer(t *testing.T) {
 	assert.Equal(t, 4, numIncreasedLoads)
 }
 
+//------- web socket peers
+
+func TestP2pAntiflood_SetWebSocketPeersCheckerNilCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{},
+		&mock.FloodPreventerStub{},
+	)
+
+	err := afm.SetWebSocketPeersChecker(nil)
+	assert.Equal(t, process.ErrNilWebSocketPeersChecker, err)
+}
+
+func TestP2pAntiflood_SetWebSocketFloodPreventersInvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{},
+		&mock.FloodPreventerStub{},
+	)
+
+	err := afm.SetWebSocketFloodPreventers()
+	assert.Equal(t, process.ErrEmptyFloodPreventerList, err)
+
+	err = afm.SetWebSocketFloodPreventers(&mock.FloodPreventerStub{}, nil)
+	assert.Equal(t, process.ErrNilFloodPreventer, err)
+}
+
+func TestP2pAntiflood_CanProcessMessageFromWebSocketPeerShouldUseDedicatedFloodPreventers(t *testing.T) {
+	t.Parallel()
+
+	webSocketPeer := core.PeerID("web socket peer")
+	tcpPeer := core.PeerID("tcp peer")
+	numDefaultCalls := 0
+	numWebSocketCalls := 0
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{},
+		&mock.FloodPreventerStub{
+			IncreaseLoadCalled: func(pid core.PeerID, size uint64) error {
+				numDefaultCalls++
+				return nil
+			},
+		},
+	)
+	_ = afm.SetWebSocketPeersChecker(&mock.WebSocketPeersCheckerStub{
+		IsConnectedViaWebSocketCalled: func(pid core.PeerID) bool {
+			return pid == webSocketPeer
+		},
+	})
+
+	err := afm.CanProcessMessage(&mock.P2PMessageMock{PeerField: webSocketPeer}, webSocketPeer)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numDefaultCalls)
+
+	expectedErr := errors.New("expected error")
+	_ = afm.SetWebSocketFloodPreventers(&mock.FloodPreventerStub{
+		IncreaseLoadCalled: func(pid core.PeerID, size uint64) error {
+			numWebSocketCalls++
+			return expectedErr
+		},
+	})
+
+	err = afm.CanProcessMessage(&mock.P2PMessageMock{PeerField: webSocketPeer}, webSocketPeer)
+	assert.True(t, errors.Is(err, expectedErr))
+	assert.Equal(t, 1, numDefaultCalls)
+	assert.Equal(t, 1, numWebSocketCalls)
+
+	err = afm.CanProcessMessage(&mock.P2PMessageMock{PeerField: tcpPeer}, tcpPeer)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numDefaultCalls)
+	assert.Equal(t, 1, numWebSocketCalls)
+}
+
 //------- CanProcessMessagesOnTopic
 
 func TestP2pAntiflood_CanProcessMessagesOnTopicCanNotAccumulateShouldError(t *testing.T) {
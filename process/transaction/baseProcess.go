@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vesting"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -25,6 +26,8 @@ type baseTxProcessor struct {
 	marshalizer             marshal.Marshalizer
 	scProcessor             process.SmartContractProcessor
 	flagPenalizedTooMuchGas atomic.Flag
+	currentEpoch            atomic.Uint32
+	hasVestingSchedules     bool
 }
 
 func (txProc *baseTxProcessor) getAccounts(
@@ -169,6 +172,47 @@ func (txProc *baseTxProcessor) checkTxValues(
 		return process.ErrInsufficientFunds
 	}
 
+	return txProc.checkLockedBalance(stAcc, txFee, cost)
+}
+
+// checkLockedBalance verifies that the transaction spends only the part of the balance that is not locked by the
+// vesting schedule of the sender, if any. The transactions that can not pay their fee from the unlocked balance are
+// rejected, while those that can only pay their fee fail as if the account had insufficient funds. The vesting
+// schedules are only defined at genesis, so the data trie of the sender is not read when the genesis has none
+func (txProc *baseTxProcessor) checkLockedBalance(acntSnd state.UserAccountHandler, txFee *big.Int, cost *big.Int) error {
+	if !txProc.hasVestingSchedules || len(acntSnd.GetRootHash()) == 0 {
+		return nil
+	}
+
+	buff, err := acntSnd.DataTrieTracker().RetrieveValue([]byte(core.VestingScheduleKey))
+	if err != nil {
+		return err
+	}
+	if len(buff) == 0 {
+		return nil
+	}
+
+	schedule, err := vesting.NewScheduleFromBytes(buff)
+	if err != nil {
+		return err
+	}
+
+	locked := schedule.LockedValue(txProc.currentEpoch.Get())
+	unlocked := big.NewInt(0).Sub(acntSnd.GetBalance(), locked)
+	if unlocked.Cmp(txFee) < 0 {
+		return fmt.Errorf("%w, has unlocked: %s, wanted: %s",
+			process.ErrInsufficientFee,
+			unlocked.String(),
+			txFee.String(),
+		)
+	}
+	if unlocked.Cmp(cost) < 0 {
+		return fmt.Errorf("%w, locked by the vesting schedule: %s",
+			process.ErrInsufficientFunds,
+			locked.String(),
+		)
+	}
+
 	return nil
 }
 
@@ -25,16 +25,17 @@ type metaTxProcessor struct {
 
 // ArgsNewMetaTxProcessor defines the arguments needed for new meta tx processor
 type ArgsNewMetaTxProcessor struct {
-	Hasher           hashing.Hasher
-	Marshalizer      marshal.Marshalizer
-	Accounts         state.AccountsAdapter
-	PubkeyConv       core.PubkeyConverter
-	ShardCoordinator sharding.Coordinator
-	ScProcessor      process.SmartContractProcessor
-	TxTypeHandler    process.TxTypeHandler
-	EconomicsFee     process.FeeHandler
-	ESDTEnableEpoch  uint32
-	EpochNotifier    process.EpochNotifier
+	Hasher              hashing.Hasher
+	Marshalizer         marshal.Marshalizer
+	Accounts            state.AccountsAdapter
+	PubkeyConv          core.PubkeyConverter
+	ShardCoordinator    sharding.Coordinator
+	ScProcessor         process.SmartContractProcessor
+	TxTypeHandler       process.TxTypeHandler
+	EconomicsFee        process.FeeHandler
+	ESDTEnableEpoch     uint32
+	EpochNotifier       process.EpochNotifier
+	HasVestingSchedules bool
 }
 
 // NewMetaTxProcessor creates a new txProcessor engine
@@ -71,6 +72,7 @@ func NewMetaTxProcessor(args ArgsNewMetaTxProcessor) (*metaTxProcessor, error) {
 		marshalizer:             args.Marshalizer,
 		scProcessor:             args.ScProcessor,
 		flagPenalizedTooMuchGas: atomic.Flag{},
+		hasVestingSchedules:     args.HasVestingSchedules,
 	}
 	//backwards compatibility
 	baseTxProcess.flagPenalizedTooMuchGas.Unset()
@@ -166,6 +168,8 @@ func (txProc *metaTxProcessor) processSCInvoking(
 
 // EpochConfirmed is called whenever a new epoch is confirmed
 func (txProc *metaTxProcessor) EpochConfirmed(epoch uint32) {
+	txProc.currentEpoch.Set(epoch)
+
 	txProc.flagESDTEnabled.Toggle(epoch >= txProc.esdtEnableEpoch)
 	log.Debug("txProcessor: esdt", "enabled", txProc.flagESDTEnabled.IsSet())
 }
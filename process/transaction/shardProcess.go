@@ -66,6 +66,7 @@ type ArgsNewTxProcessor struct {
 	PenalizedTooMuchGasEnableEpoch uint32
 	MetaProtectionEnableEpoch      uint32
 	EpochNotifier                  process.EpochNotifier
+	HasVestingSchedules            bool
 }
 
 // NewTxProcessor creates a new txProcessor engine
@@ -117,13 +118,14 @@ func NewTxProcessor(args ArgsNewTxProcessor) (*txProcessor, error) {
 	}
 
 	baseTxProcess := &baseTxProcessor{
-		accounts:         args.Accounts,
-		shardCoordinator: args.ShardCoordinator,
-		pubkeyConv:       args.PubkeyConv,
-		economicsFee:     args.EconomicsFee,
-		hasher:           args.Hasher,
-		marshalizer:      args.Marshalizer,
-		scProcessor:      args.ScProcessor,
+		accounts:            args.Accounts,
+		shardCoordinator:    args.ShardCoordinator,
+		pubkeyConv:          args.PubkeyConv,
+		economicsFee:        args.EconomicsFee,
+		hasher:              args.Hasher,
+		marshalizer:         args.Marshalizer,
+		scProcessor:         args.ScProcessor,
+		hasVestingSchedules: args.HasVestingSchedules,
 	}
 
 	txProc := &txProcessor{
@@ -858,6 +860,8 @@ func (txProc *txProcessor) executeFailedRelayedUserTx(
 
 // EpochConfirmed is called whenever a new epoch is confirmed
 func (txProc *txProcessor) EpochConfirmed(epoch uint32) {
+	txProc.currentEpoch.Set(epoch)
+
 	txProc.flagRelayedTx.Toggle(epoch >= txProc.relayedTxEnableEpoch)
 	log.Debug("txProcessor: relayed transactions", "enabled", txProc.flagRelayedTx.IsSet())
 
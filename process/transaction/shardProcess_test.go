@@ -11,6 +11,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/parsers"
+	"github.com/ElrondNetwork/elrond-go/core/vesting"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
@@ -483,6 +484,61 @@ func TestTxProcessor_CheckTxValuesInsufficientFundsShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrInsufficientFunds, err)
 }
 
+func TestTxProcessor_CheckTxValuesLockedBalanceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	acnt1, err := state.NewUserAccount([]byte{65})
+	assert.Nil(t, err)
+	acnt1.Balance = big.NewInt(1000)
+	acnt1.SetRootHash([]byte("root hash"))
+	schedule := &vesting.Schedule{
+		Value:      big.NewInt(800),
+		CliffEpoch: 2,
+		EndEpoch:   10,
+	}
+	_ = acnt1.DataTrieTracker().SaveKeyValue([]byte(core.VestingScheduleKey), schedule.ToBytes())
+
+	args := createArgsForTxProcessor()
+	args.HasVestingSchedules = true
+	execTx, _ := txproc.NewTxProcessor(args)
+
+	err = execTx.CheckTxValues(&transaction.Transaction{Value: big.NewInt(201)}, acnt1, nil, false)
+	assert.True(t, errors.Is(err, process.ErrInsufficientFunds))
+
+	err = execTx.CheckTxValues(&transaction.Transaction{Value: big.NewInt(200)}, acnt1, nil, false)
+	assert.Nil(t, err)
+
+	execTx.EpochConfirmed(5)
+	err = execTx.CheckTxValues(&transaction.Transaction{Value: big.NewInt(600)}, acnt1, nil, false)
+	assert.Nil(t, err)
+	err = execTx.CheckTxValues(&transaction.Transaction{Value: big.NewInt(601)}, acnt1, nil, false)
+	assert.True(t, errors.Is(err, process.ErrInsufficientFunds))
+
+	execTx.EpochConfirmed(10)
+	err = execTx.CheckTxValues(&transaction.Transaction{Value: big.NewInt(1000)}, acnt1, nil, false)
+	assert.Nil(t, err)
+}
+
+func TestTxProcessor_CheckTxValuesWithoutGenesisVestingSchedulesShouldNotReadTheDataTrie(t *testing.T) {
+	t.Parallel()
+
+	acnt1, err := state.NewUserAccount([]byte{65})
+	assert.Nil(t, err)
+	acnt1.Balance = big.NewInt(1000)
+	acnt1.SetRootHash([]byte("root hash"))
+	acnt1.SetDataTrie(&mock.TrieStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			assert.Fail(t, "should have not read the data trie")
+			return nil, nil
+		},
+	})
+
+	execTx := *createTxProcessor()
+
+	err = execTx.CheckTxValues(&transaction.Transaction{Value: big.NewInt(1000)}, acnt1, nil, false)
+	assert.Nil(t, err)
+}
+
 func TestTxProcessor_CheckTxValuesMismatchedSenderUsernamesShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1,115 +1,244 @@
 package transaction
 
 import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
-	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
+const percentageDenominator = 100
+
+// ArgsTransactionCostEstimator holds the arguments needed to create a transaction cost estimator
+type ArgsTransactionCostEstimator struct {
+	TxTypeHandler          process.TxTypeHandler
+	FeeHandler             process.FeeHandler
+	TxSimulator            process.TransactionSimulator
+	Accounts               state.AccountsAdapter
+	ShardCoordinator       sharding.Coordinator
+	AddressPubkeyConverter core.PubkeyConverter
+	SafetyMarginPercent    uint32
+	CrossShardCallGasLimit uint64
+}
+
+// transactionCostEstimator estimates the gas needed by a transaction by executing it in simulation mode. The smart
+// contract calls, deployments and built-in function calls are simulated with decreasing gas limits, the estimation
+// being the smallest gas limit the transaction still succeeds with. The execution that would take place in other
+// shards is covered by a fixed gas amount for each cross-shard call
 type transactionCostEstimator struct {
-	txTypeHandler      process.TxTypeHandler
-	feeHandler         process.FeeHandler
-	query              external.SCQueryService
-	storePerByteCost   uint64
-	compilePerByteCost uint64
-	mutExecution       sync.RWMutex
+	txTypeHandler          process.TxTypeHandler
+	feeHandler             process.FeeHandler
+	txSimulator            process.TransactionSimulator
+	accounts               state.AccountsAdapter
+	shardCoordinator       sharding.Coordinator
+	addressPubkeyConverter core.PubkeyConverter
+	safetyMarginPercent    uint64
+	crossShardCallGasLimit uint64
+	mutExecution           sync.Mutex
 }
 
 // NewTransactionCostEstimator will create a new transaction cost estimator
-func NewTransactionCostEstimator(
-	txTypeHandler process.TxTypeHandler,
-	feeHandler process.FeeHandler,
-	query external.SCQueryService,
-	gasSchedule core.GasScheduleNotifier,
-) (*transactionCostEstimator, error) {
-	if check.IfNil(txTypeHandler) {
+func NewTransactionCostEstimator(args ArgsTransactionCostEstimator) (*transactionCostEstimator, error) {
+	if check.IfNil(args.TxTypeHandler) {
 		return nil, process.ErrNilTxTypeHandler
 	}
-	if check.IfNil(feeHandler) {
+	if check.IfNil(args.FeeHandler) {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
-	if check.IfNil(query) {
-		return nil, external.ErrNilSCQueryService
+	if check.IfNil(args.TxSimulator) {
+		return nil, process.ErrNilTxSimulator
+	}
+	if check.IfNil(args.Accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, process.ErrNilPubkeyConverter
 	}
-
-	compileCost, storeCost := getOperationCost(gasSchedule.LatestGasSchedule())
 
 	return &transactionCostEstimator{
-		txTypeHandler:      txTypeHandler,
-		feeHandler:         feeHandler,
-		query:              query,
-		storePerByteCost:   compileCost,
-		compilePerByteCost: storeCost,
+		txTypeHandler:          args.TxTypeHandler,
+		feeHandler:             args.FeeHandler,
+		txSimulator:            args.TxSimulator,
+		accounts:               args.Accounts,
+		shardCoordinator:       args.ShardCoordinator,
+		addressPubkeyConverter: args.AddressPubkeyConverter,
+		safetyMarginPercent:    uint64(args.SafetyMarginPercent),
+		crossShardCallGasLimit: args.CrossShardCallGasLimit,
 	}, nil
 }
 
-// GasScheduleChange is called when gas schedule is changed, thus all contracts must be updated
-func (tce *transactionCostEstimator) GasScheduleChange(gasSchedule map[string]map[string]uint64) {
+// ComputeTransactionGasLimit will calculate how many gas units a transaction will consume
+func (tce *transactionCostEstimator) ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error) {
+	txType, txTypeOnDestination := tce.txTypeHandler.ComputeTransactionType(tx)
+
+	switch txType {
+	case process.MoveBalance:
+		tx.GasPrice = 1
+		gasLimit := tce.feeHandler.ComputeGasLimit(tx)
+		if txTypeOnDestination == process.SCInvoking {
+			// cross-shard smart contract call, the execution takes place only in the destination shard
+			gasLimit = tce.addSafetyMargin(gasLimit + tce.crossShardCallGasLimit)
+		}
+
+		return gasLimit, nil
+	case process.SCDeployment, process.SCInvoking, process.BuiltInFunctionCall:
+		return tce.simulateTransactionCost(tx)
+	default:
+		return 0, process.ErrWrongTransaction
+	}
+}
+
+func (tce *transactionCostEstimator) simulateTransactionCost(tx *transaction.Transaction) (uint64, error) {
 	tce.mutExecution.Lock()
-	tce.compilePerByteCost, tce.storePerByteCost = getOperationCost(gasSchedule)
-	tce.mutExecution.Unlock()
+	defer tce.mutExecution.Unlock()
+
+	simulatedTx, maxGasLimit, err := tce.prepareTransactionForSimulation(tx)
+	if err != nil {
+		return 0, err
+	}
+
+	results, err := tce.simulate(simulatedTx, maxGasLimit)
+	if err != nil {
+		return 0, err
+	}
+	if results.Status != transaction.TxStatusSuccess {
+		return 0, fmt.Errorf("%w: %s", process.ErrTransactionSimulationFailed, getSimulationFailReason(results))
+	}
+
+	// binary search the smallest gas limit the transaction succeeds with
+	failingGasLimit := uint64(0)
+	minGasLimit := tce.feeHandler.ComputeGasLimit(simulatedTx)
+	if minGasLimit > 0 {
+		failingGasLimit = minGasLimit - 1
+	}
+	succeedingGasLimit := maxGasLimit
+	for succeedingGasLimit-failingGasLimit > 1 {
+		gasLimit := failingGasLimit + (succeedingGasLimit-failingGasLimit)/2
+		resultsForGasLimit, errSimulate := tce.simulate(simulatedTx, gasLimit)
+		if errSimulate != nil || resultsForGasLimit.Status != transaction.TxStatusSuccess {
+			failingGasLimit = gasLimit
+			continue
+		}
+
+		succeedingGasLimit = gasLimit
+		results = resultsForGasLimit
+	}
+
+	gasLimit := succeedingGasLimit + tce.numCrossShardCalls(results)*tce.crossShardCallGasLimit
+	gasLimit = tce.addSafetyMargin(gasLimit)
+	if gasLimit > maxGasLimit {
+		gasLimit = maxGasLimit
+	}
+
+	return gasLimit, nil
 }
 
-func getOperationCost(gasSchedule map[string]map[string]uint64) (uint64, uint64) {
-	baseOpMap, ok := gasSchedule[core.BaseOperationCost]
+// prepareTransactionForSimulation returns a copy of the transaction having the current nonce of the sender and the
+// minimum gas price, alongside the maximum gas limit the sender can afford
+func (tce *transactionCostEstimator) prepareTransactionForSimulation(tx *transaction.Transaction) (*transaction.Transaction, uint64, error) {
+	account, err := tce.accounts.GetExistingAccount(tx.SndAddr)
+	if err != nil {
+		return nil, 0, err
+	}
+	userAccount, ok := account.(state.UserAccountHandler)
 	if !ok {
-		return 0, 0
+		return nil, 0, process.ErrWrongTypeAssertion
 	}
 
-	storeCost, ok := baseOpMap["StorePerByte"]
-	if !ok {
-		return 0, 0
+	simulatedTx := *tx
+	simulatedTx.Nonce = userAccount.GetNonce()
+	simulatedTx.GasPrice = tce.feeHandler.MinGasPrice()
+	if simulatedTx.Value == nil {
+		simulatedTx.Value = big.NewInt(0)
+	}
+	if simulatedTx.GasPrice == 0 {
+		return nil, 0, process.ErrInsufficientGasPriceInTx
 	}
 
-	compilerCost, ok := baseOpMap["CompilePerByte"]
-	if !ok {
-		return 0, 0
+	availableBalance := big.NewInt(0).Sub(userAccount.GetBalance(), simulatedTx.Value)
+	if availableBalance.Sign() <= 0 {
+		return nil, 0, process.ErrInsufficientFunds
 	}
 
-	return storeCost, compilerCost
+	maxGasLimit := tce.feeHandler.MaxGasLimitPerBlock(tce.shardCoordinator.SelfId()) - 1
+	affordableGasLimit := availableBalance.Div(availableBalance, big.NewInt(0).SetUint64(simulatedTx.GasPrice))
+	if affordableGasLimit.IsUint64() && affordableGasLimit.Uint64() < maxGasLimit {
+		maxGasLimit = affordableGasLimit.Uint64()
+	}
+	if maxGasLimit < tce.feeHandler.ComputeGasLimit(&simulatedTx) {
+		return nil, 0, process.ErrInsufficientFunds
+	}
+
+	return &simulatedTx, maxGasLimit, nil
 }
 
-// ComputeTransactionGasLimit will calculate how many gas units a transaction will consume
-func (tce *transactionCostEstimator) ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error) {
-	tce.mutExecution.RLock()
-	defer tce.mutExecution.RUnlock()
+func (tce *transactionCostEstimator) simulate(tx *transaction.Transaction, gasLimit uint64) (*transaction.SimulationResults, error) {
+	simulatedTx := *tx
+	simulatedTx.GasLimit = gasLimit
 
-	txType, _ := tce.txTypeHandler.ComputeTransactionType(tx)
-	tx.GasPrice = 1
+	return tce.txSimulator.ProcessTx(&simulatedTx)
+}
 
-	switch txType {
-	case process.MoveBalance:
-		return tce.feeHandler.ComputeGasLimit(tx), nil
-	case process.SCDeployment:
-		return tce.computeScDeployGasLimit(tx)
-	case process.SCInvoking:
-		return tce.computeScCallGasLimit(tx)
-	case process.BuiltInFunctionCall:
-		return tce.computeScCallGasLimit(tx)
-	default:
-		return 0, process.ErrWrongTransaction
+// numCrossShardCalls returns the number of the generated smart contract results that call smart contracts from
+// other shards. Their execution can not be simulated on this shard
+func (tce *transactionCostEstimator) numCrossShardCalls(results *transaction.SimulationResults) uint64 {
+	numCalls := uint64(0)
+	for _, scr := range results.ScResults {
+		if len(scr.Data) == 0 {
+			continue
+		}
+
+		receiver, err := tce.addressPubkeyConverter.Decode(scr.RcvAddr)
+		if err != nil {
+			continue
+		}
+		isCrossShardCall := core.IsSmartContractAddress(receiver) &&
+			tce.shardCoordinator.ComputeId(receiver) != tce.shardCoordinator.SelfId()
+		if isCrossShardCall {
+			numCalls++
+		}
 	}
+
+	return numCalls
 }
 
-func (tce *transactionCostEstimator) computeScDeployGasLimit(tx *transaction.Transaction) (uint64, error) {
-	scDeployCost := uint64(len(tx.Data)) * (tce.storePerByteCost + tce.compilePerByteCost)
-	baseCost := tce.feeHandler.ComputeGasLimit(tx)
+func (tce *transactionCostEstimator) addSafetyMargin(gasLimit uint64) uint64 {
+	margin := core.SafeMul(gasLimit, tce.safetyMarginPercent)
+	margin.Div(margin, big.NewInt(percentageDenominator))
 
-	return baseCost + scDeployCost, nil
+	gasLimitWithMargin, err := core.SafeAddUint64(gasLimit, margin.Uint64())
+	if err != nil {
+		return gasLimit
+	}
+
+	return gasLimitWithMargin
 }
 
-func (tce *transactionCostEstimator) computeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
-	scCallGasLimit, err := tce.query.ComputeScCallGasLimit(tx)
-	if err != nil {
-		return 0, err
+func getSimulationFailReason(results *transaction.SimulationResults) string {
+	if len(results.FailReason) > 0 {
+		return results.FailReason
+	}
+
+	returnMessages := make([]string, 0)
+	for _, scr := range results.ScResults {
+		if len(scr.ReturnMessage) > 0 {
+			returnMessages = append(returnMessages, scr.ReturnMessage)
+		}
 	}
+	sort.Strings(returnMessages)
 
-	baseCost := tce.feeHandler.ComputeGasLimit(tx)
-	return baseCost + scCallGasLimit, nil
+	return strings.Join(returnMessages, ", ")
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -1,38 +1,62 @@
 package transaction
 
 import (
+	"bytes"
+	"encoding/hex"
+	"errors"
 	"math/big"
 	"testing"
 
-	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
-	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/stretchr/testify/require"
 )
 
-func createGasMap(value uint64) map[string]map[string]uint64 {
-	gasMap := make(map[string]map[string]uint64)
-
-	baseOpMap := make(map[string]uint64)
-
-	baseOpMap["StorePerByte"] = value
-	baseOpMap["CompilePerByte"] = value
-	baseOpMap["AoTPreparePerByte"] = value
-	baseOpMap["GetCode"] = value
-	gasMap[core.BaseOperationCost] = baseOpMap
-
-	return gasMap
+func createMockArgsTransactionCostEstimator(txType process.TransactionType) ArgsTransactionCostEstimator {
+	return ArgsTransactionCostEstimator{
+		TxTypeHandler: &mock.TxTypeHandlerMock{
+			ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, process.TransactionType) {
+				return txType, txType
+			},
+		},
+		FeeHandler: &mock.FeeHandlerStub{
+			ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+				return 500
+			},
+			MinGasPriceCalled: func() uint64 {
+				return 10
+			},
+			MaxGasLimitPerBlockCalled: func() uint64 {
+				return 1500000000
+			},
+		},
+		TxSimulator: &mock.TxSimulatorStub{},
+		Accounts: &mock.AccountsStub{
+			GetExistingAccountCalled: func(_ []byte) (state.AccountHandler, error) {
+				account, _ := state.NewUserAccount([]byte("sender"))
+				account.Nonce = 7
+				_ = account.AddToBalance(big.NewInt(1000000000))
+
+				return account, nil
+			},
+		},
+		ShardCoordinator:       mock.NewMultiShardsCoordinatorMock(2),
+		AddressPubkeyConverter: mock.NewPubkeyConverterMock(32),
+		SafetyMarginPercent:    10,
+		CrossShardCallGasLimit: 5000,
+	}
 }
 
 func TestTransactionCostEstimator_NilTxTypeHandler(t *testing.T) {
 	t.Parallel()
 
-	gasSchedule := mock.NewGasScheduleNotifierMock(createGasMap(1))
-	tce, err := NewTransactionCostEstimator(nil, &mock.FeeHandlerStub{}, &mock.ScQueryStub{}, gasSchedule)
+	args := createMockArgsTransactionCostEstimator(process.MoveBalance)
+	args.TxTypeHandler = nil
+	tce, err := NewTransactionCostEstimator(args)
 
 	require.Nil(t, tce)
 	require.Equal(t, process.ErrNilTxTypeHandler, err)
@@ -41,28 +65,40 @@ func TestTransactionCostEstimator_NilTxTypeHandler(t *testing.T) {
 func TestTransactionCostEstimator_NilFeeHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	gasSchedule := mock.NewGasScheduleNotifierMock(createGasMap(1))
-	tce, err := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{}, nil, &mock.ScQueryStub{}, gasSchedule)
+	args := createMockArgsTransactionCostEstimator(process.MoveBalance)
+	args.FeeHandler = nil
+	tce, err := NewTransactionCostEstimator(args)
 
 	require.Nil(t, tce)
 	require.Equal(t, process.ErrNilEconomicsFeeHandler, err)
 }
 
-func TestTransactionCostEstimator_NilQueryServiceShouldErr(t *testing.T) {
+func TestTransactionCostEstimator_NilTxSimulatorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	gasSchedule := mock.NewGasScheduleNotifierMock(createGasMap(1))
-	tce, err := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{}, &mock.FeeHandlerStub{}, nil, gasSchedule)
+	args := createMockArgsTransactionCostEstimator(process.MoveBalance)
+	args.TxSimulator = nil
+	tce, err := NewTransactionCostEstimator(args)
 
 	require.Nil(t, tce)
-	require.Equal(t, external.ErrNilSCQueryService, err)
+	require.Equal(t, process.ErrNilTxSimulator, err)
+}
+
+func TestTransactionCostEstimator_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTransactionCostEstimator(process.MoveBalance)
+	args.Accounts = nil
+	tce, err := NewTransactionCostEstimator(args)
+
+	require.Nil(t, tce)
+	require.Equal(t, process.ErrNilAccountsAdapter, err)
 }
 
 func TestTransactionCostEstimator_Ok(t *testing.T) {
 	t.Parallel()
 
-	gasSchedule := mock.NewGasScheduleNotifierMock(createGasMap(1))
-	tce, err := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{}, &mock.FeeHandlerStub{}, &mock.ScQueryStub{}, gasSchedule)
+	tce, err := NewTransactionCostEstimator(createMockArgsTransactionCostEstimator(process.MoveBalance))
 
 	require.Nil(t, err)
 	require.False(t, check.IfNil(tce))
@@ -71,17 +107,20 @@ func TestTransactionCostEstimator_Ok(t *testing.T) {
 func TestComputeTransactionGasLimit_MoveBalance(t *testing.T) {
 	t.Parallel()
 
-	gasSchedule := mock.NewGasScheduleNotifierMock(createGasMap(1))
 	consumedGasUnits := uint64(1000)
-	tce, _ := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{
-		ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, process.TransactionType) {
-			return process.MoveBalance, process.MoveBalance
-		},
-	}, &mock.FeeHandlerStub{
+	args := createMockArgsTransactionCostEstimator(process.MoveBalance)
+	args.FeeHandler = &mock.FeeHandlerStub{
 		ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
 			return consumedGasUnits
 		},
-	}, &mock.ScQueryStub{}, gasSchedule)
+	}
+	args.TxSimulator = &mock.TxSimulatorStub{
+		ProcessTxCalled: func(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+			require.Fail(t, "move balance transactions should not be simulated")
+			return nil, nil
+		},
+	}
+	tce, _ := NewTransactionCostEstimator(args)
 
 	tx := &transaction.Transaction{}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
@@ -89,51 +128,101 @@ func TestComputeTransactionGasLimit_MoveBalance(t *testing.T) {
 	require.Equal(t, consumedGasUnits, cost)
 }
 
-func TestComputeTransactionGasLimit_SmartContractDeploy(t *testing.T) {
+func TestComputeTransactionGasLimit_CrossShardSmartContractCall(t *testing.T) {
 	t.Parallel()
 
-	gasSchedule := mock.NewGasScheduleNotifierMock(createGasMap(2))
-	gasLimitBaseTx := uint64(500)
-	tce, _ := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{
+	args := createMockArgsTransactionCostEstimator(process.MoveBalance)
+	args.TxTypeHandler = &mock.TxTypeHandlerMock{
 		ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, process.TransactionType) {
-			return process.SCDeployment, process.SCDeployment
+			return process.MoveBalance, process.SCInvoking
 		},
-	}, &mock.FeeHandlerStub{
-		ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
-			return gasLimitBaseTx
+	}
+	tce, _ := NewTransactionCostEstimator(args)
+
+	cost, err := tce.ComputeTransactionGasLimit(&transaction.Transaction{})
+	require.Nil(t, err)
+	require.Equal(t, uint64(5500*110/100), cost)
+}
+
+func TestComputeTransactionGasLimit_SmartContractCallShouldFindTheNeededGas(t *testing.T) {
+	t.Parallel()
+
+	neededGas := uint64(123456)
+	crossShardSC := append(bytes.Repeat([]byte{0}, 10), bytes.Repeat([]byte{1}, 22)...)
+	args := createMockArgsTransactionCostEstimator(process.SCInvoking)
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, crossShardSC) {
+			return 1
+		}
+		return 0
+	}
+	args.ShardCoordinator = shardCoordinator
+	numSimulations := 0
+	args.TxSimulator = &mock.TxSimulatorStub{
+		ProcessTxCalled: func(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+			numSimulations++
+			require.Equal(t, uint64(7), tx.Nonce)
+			require.Equal(t, uint64(10), tx.GasPrice)
+			if tx.GasLimit < neededGas {
+				return &transaction.SimulationResults{Status: transaction.TxStatusPending}, nil
+			}
+
+			return &transaction.SimulationResults{
+				Status: transaction.TxStatusSuccess,
+				ScResults: map[string]*transaction.ApiSmartContractResult{
+					"refund":    {RcvAddr: hex.EncodeToString([]byte("sender")), Data: "@6f6b"},
+					"asyncCall": {RcvAddr: hex.EncodeToString(crossShardSC), Data: "doSomething"},
+				},
+			}, nil
 		},
-	}, &mock.ScQueryStub{}, gasSchedule)
+	}
+	tce, _ := NewTransactionCostEstimator(args)
 
 	tx := &transaction.Transaction{
-		Data: []byte("data"),
+		SndAddr: []byte("sender"),
+		Value:   big.NewInt(0),
+		Data:    []byte("call"),
 	}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
 	require.Nil(t, err)
-	require.Equal(t, gasLimitBaseTx+uint64(16), cost)
+	require.Equal(t, (neededGas+args.CrossShardCallGasLimit)*110/100, cost)
+	// the sender can afford at most 1000000000 / 10 gas units
+	require.True(t, numSimulations < 30)
 }
 
-func TestComputeTransactionGasLimit_SmartContractCall(t *testing.T) {
+func TestComputeTransactionGasLimit_SimulationFailureShouldErr(t *testing.T) {
 	t.Parallel()
 
-	gasSchedule := mock.NewGasScheduleNotifierMock(createGasMap(1))
-	gasLimitBaseTx := uint64(500)
-	consumedGasUnits := big.NewInt(1000)
-	tce, _ := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{
-		ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, process.TransactionType) {
-			return process.SCInvoking, process.SCInvoking
-		},
-	}, &mock.FeeHandlerStub{
-		ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
-			return gasLimitBaseTx
+	args := createMockArgsTransactionCostEstimator(process.BuiltInFunctionCall)
+	args.TxSimulator = &mock.TxSimulatorStub{
+		ProcessTxCalled: func(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+			return &transaction.SimulationResults{
+				Status: transaction.TxStatusPending,
+				ScResults: map[string]*transaction.ApiSmartContractResult{
+					"refund": {ReturnMessage: "insufficient funds for token"},
+				},
+			}, nil
 		},
-	}, &mock.ScQueryStub{
-		ComputeScCallGasLimitHandler: func(tx *transaction.Transaction) (u uint64, err error) {
-			return consumedGasUnits.Uint64(), nil
-		},
-	}, gasSchedule)
+	}
+	tce, _ := NewTransactionCostEstimator(args)
 
-	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
-	require.Nil(t, err)
-	require.Equal(t, consumedGasUnits.Uint64()+gasLimitBaseTx, cost)
+	cost, err := tce.ComputeTransactionGasLimit(&transaction.Transaction{Data: []byte("ESDTTransfer")})
+	require.True(t, errors.Is(err, process.ErrTransactionSimulationFailed))
+	require.Contains(t, err.Error(), "insufficient funds for token")
+	require.Equal(t, uint64(0), cost)
+}
+
+func TestComputeTransactionGasLimit_InsufficientFundsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTransactionCostEstimator(process.SCDeployment)
+	tce, _ := NewTransactionCostEstimator(args)
+
+	cost, err := tce.ComputeTransactionGasLimit(&transaction.Transaction{
+		Value: big.NewInt(1000000000),
+		Data:  []byte("code"),
+	})
+	require.Equal(t, process.ErrInsufficientFunds, err)
+	require.Equal(t, uint64(0), cost)
 }
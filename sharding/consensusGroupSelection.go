@@ -0,0 +1,87 @@
+package sharding
+
+import (
+	"fmt"
+)
+
+// inFlightSelection holds a consensus group selection in progress, so the concurrent requests for the same
+// consensus group wait for its result instead of running the same selection again
+type inFlightSelection struct {
+	done           chan struct{}
+	consensusGroup []Validator
+	err            error
+}
+
+// consensusGroupKey returns the key of a consensus group in the consensus group cache. Besides the selection inputs
+// (the randomness, the round, the shard and the epoch) the key holds the version of the selectors used, so the
+// consensus groups selected with the validators lists of a replaced epoch configuration are never returned. This
+// way the cached consensus groups do not need to be cleared when a new epoch configuration is computed and they are
+// shared by the header validations of all the competing fork branches
+func consensusGroupKey(randomness []byte, round uint64, shardID uint32, epoch uint32, selectorsVersion int64) []byte {
+	return []byte(fmt.Sprintf(keyFormat, string(randomness), round, shardID, epoch, selectorsVersion))
+}
+
+// nextSelectorsVersion returns a new version for the selectors of an epoch configuration
+func (ihgs *indexHashedNodesCoordinator) nextSelectorsVersion() int64 {
+	return ihgs.selectorsVersion.Increment()
+}
+
+// selectConsensusGroupOnce returns the cached consensus group of the provided key, if available. Otherwise, it
+// selects the consensus group using the provided function and caches it. Only one selection runs for the same key
+// at a time, the other requests for that key receiving the result of the running selection
+func (ihgs *indexHashedNodesCoordinator) selectConsensusGroupOnce(
+	key []byte,
+	selectFunc func() ([]Validator, error),
+) ([]Validator, error) {
+	ihgs.mutInFlightSelections.Lock()
+	consensusGroup := ihgs.searchConsensusForKey(key)
+	if consensusGroup != nil {
+		ihgs.mutInFlightSelections.Unlock()
+		return consensusGroup, nil
+	}
+
+	selection, isInFlight := ihgs.inFlightSelections[string(key)]
+	if isInFlight {
+		ihgs.mutInFlightSelections.Unlock()
+		<-selection.done
+		return selection.consensusGroup, selection.err
+	}
+
+	selection = &inFlightSelection{
+		done: make(chan struct{}),
+	}
+	ihgs.inFlightSelections[string(key)] = selection
+	ihgs.mutInFlightSelections.Unlock()
+
+	selection.consensusGroup, selection.err = selectFunc()
+	if selection.err == nil {
+		ihgs.cacheConsensusGroup(key, selection.consensusGroup)
+	}
+
+	ihgs.mutInFlightSelections.Lock()
+	delete(ihgs.inFlightSelections, string(key))
+	ihgs.mutInFlightSelections.Unlock()
+	close(selection.done)
+
+	return selection.consensusGroup, selection.err
+}
+
+func (ihgs *indexHashedNodesCoordinator) cacheConsensusGroup(key []byte, consensusGroup []Validator) {
+	size := 0
+	for _, v := range consensusGroup {
+		size += v.Size()
+	}
+
+	ihgs.consensusGroupCacher.Put(key, consensusGroup, size)
+}
+
+func (ihgs *indexHashedNodesCoordinator) searchConsensusForKey(key []byte) []Validator {
+	value, ok := ihgs.consensusGroupCacher.Get(key)
+	if ok {
+		consensusGroup, typeOk := value.([]Validator)
+		if typeOk {
+			return consensusGroup
+		}
+	}
+	return nil
+}
@@ -2,13 +2,15 @@ package sharding
 
 import (
 	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/core/addressRouting"
 )
 
 // ErrInvalidNumberOfShards signals that an invalid number of shards was passed to the sharding registry
-var ErrInvalidNumberOfShards = errors.New("the number of shards must be greater than zero")
+var ErrInvalidNumberOfShards = addressRouting.ErrInvalidNumberOfShards
 
 // ErrInvalidShardId signals that an invalid shard is was passed
-var ErrInvalidShardId = errors.New("shard id must be smaller than the total number of shards")
+var ErrInvalidShardId = addressRouting.ErrInvalidShardId
 
 // ErrShardIdOutOfRange signals an error when shard id is out of range
 var ErrShardIdOutOfRange = errors.New("shard id out of range")
@@ -129,3 +131,12 @@ var ErrNilOrEmptyDestinationForDistribute = errors.New("nil or empty destination
 
 // ErrNilNodeShufflerArguments signals that a nil argument pointer was provided for creating the nodes shuffler instance
 var ErrNilNodeShufflerArguments = errors.New("nil arguments for the creation of a node shuffler")
+
+// ErrInvalidShuffledOutReEntry signals that an unknown re-entry behavior was configured for the shuffled out validators
+var ErrInvalidShuffledOutReEntry = errors.New("invalid shuffled out re-entry")
+
+// ErrDuplicatedEpochInNodesChangeConfig signals that more than one max nodes change config is enabled in the same epoch
+var ErrDuplicatedEpochInNodesChangeConfig = errors.New("duplicated epoch in the max nodes change configs")
+
+// ErrInvalidPinnedShardConfig signals that an invalid dedicated shard was configured for some validators
+var ErrInvalidPinnedShardConfig = errors.New("invalid pinned shard config")
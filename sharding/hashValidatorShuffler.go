@@ -13,6 +13,13 @@ import (
 
 var _ NodesShuffler = (*randHashShuffler)(nil)
 
+const (
+	// ShuffledOutReEntryCrossShard distributes the shuffled out validators randomly to the waiting lists of all shards
+	ShuffledOutReEntryCrossShard = "crossShard"
+	// ShuffledOutReEntrySameShard moves the shuffled out validators to the waiting list of their own shard
+	ShuffledOutReEntrySameShard = "sameShard"
+)
+
 // NodesShufflerArgs defines the arguments required to create a nodes shuffler
 type NodesShufflerArgs struct {
 	NodesShard           uint32
@@ -21,6 +28,7 @@ type NodesShufflerArgs struct {
 	Adaptivity           bool
 	ShuffleBetweenShards bool
 	MaxNodesEnableConfig []config.MaxNodesChangeConfig
+	PinnedValidators     map[string]uint32
 }
 
 type shuffleNodesArg struct {
@@ -35,6 +43,8 @@ type shuffleNodesArg struct {
 	nodesPerShard          uint32
 	nbShards               uint32
 	maxNodesToSwapPerShard uint32
+	maxNodesToSwapInMeta   uint32
+	pinnedValidators       pinnedValidators
 }
 
 // TODO: Decide if transaction load statistics will be used for limiting the number of shards
@@ -50,6 +60,7 @@ type randHashShuffler struct {
 	metaHysteresis        uint32
 	activeNodesConfig     config.MaxNodesChangeConfig
 	availableNodesConfigs []config.MaxNodesChangeConfig
+	pinnedValidators      pinnedValidators
 	mutShufflerParams     sync.RWMutex
 	validatorDistributor  ValidatorsDistributor
 }
@@ -61,6 +72,15 @@ func NewHashValidatorsShuffler(args *NodesShufflerArgs) (*randHashShuffler, erro
 		return nil, ErrNilNodeShufflerArguments
 	}
 
+	err := checkMaxNodesChangeConfigs(args.MaxNodesEnableConfig)
+	if err != nil {
+		return nil, err
+	}
+	err = checkPinnedValidators(args.PinnedValidators)
+	if err != nil {
+		return nil, err
+	}
+
 	var configs []config.MaxNodesChangeConfig
 
 	if args.MaxNodesEnableConfig != nil {
@@ -72,6 +92,10 @@ func NewHashValidatorsShuffler(args *NodesShufflerArgs) (*randHashShuffler, erro
 	rxs := &randHashShuffler{
 		shuffleBetweenShards:  args.ShuffleBetweenShards,
 		availableNodesConfigs: configs,
+		pinnedValidators:      make(pinnedValidators, len(args.PinnedValidators)),
+	}
+	for pubKey, shardID := range args.PinnedValidators {
+		rxs.pinnedValidators[pubKey] = shardID
 	}
 
 	rxs.UpdateParams(args.NodesShard, args.NodesMeta, args.Hysteresis, args.Adaptivity)
@@ -87,6 +111,26 @@ func NewHashValidatorsShuffler(args *NodesShufflerArgs) (*randHashShuffler, erro
 	return rxs, nil
 }
 
+func checkMaxNodesChangeConfigs(configs []config.MaxNodesChangeConfig) error {
+	epochs := make(map[uint32]struct{}, len(configs))
+	for _, maxNodesConfig := range configs {
+		_, exists := epochs[maxNodesConfig.EpochEnable]
+		if exists {
+			return fmt.Errorf("%w: %d", ErrDuplicatedEpochInNodesChangeConfig, maxNodesConfig.EpochEnable)
+		}
+		epochs[maxNodesConfig.EpochEnable] = struct{}{}
+
+		switch maxNodesConfig.ShuffledOutReEntry {
+		case "", ShuffledOutReEntryCrossShard, ShuffledOutReEntrySameShard:
+		default:
+			return fmt.Errorf("%w: %s in epoch %d",
+				ErrInvalidShuffledOutReEntry, maxNodesConfig.ShuffledOutReEntry, maxNodesConfig.EpochEnable)
+		}
+	}
+
+	return nil
+}
+
 // UpdateParams updates the shuffler parameters
 // Should be called when new params are agreed through governance
 func (rhs *randHashShuffler) UpdateParams(
@@ -124,6 +168,8 @@ func (rhs *randHashShuffler) UpdateParams(
 //          b)  In case (shuffled out nodes + new nodes) < (nbShards * perShardHysteresis) then we can immediately
 //              execute the shard merge
 //          c)  No change in the number of shards then nothing extra needs to be done
+//      7.  The pinned validators are never shuffled out of their dedicated shards and the new, shuffled out or waiting
+//          pinned validators always join the waiting lists of their dedicated shards
 func (rhs *randHashShuffler) UpdateNodeLists(args ArgsUpdateNodes) (*ResUpdateNodes, error) {
 	rhs.UpdateShufflerConfig(args.Epoch)
 	eligibleAfterReshard := copyValidatorMap(args.Eligible)
@@ -145,6 +191,9 @@ func (rhs *randHashShuffler) UpdateNodeLists(args ArgsUpdateNodes) (*ResUpdateNo
 	canMerge := rhs.adaptivity && newNbShards < args.NbShards
 	nodesPerShard := rhs.nodesShard
 	nodesMeta := rhs.nodesMeta
+	maxNodesToSwapPerShard := rhs.activeNodesConfig.NodesToShufflePerShard
+	maxNodesToSwapInMeta := rhs.computeNodesToShuffleInMeta()
+	distributor := rhs.selectDistributor()
 	rhs.mutShufflerParams.RUnlock()
 
 	if canSplit {
@@ -154,6 +203,10 @@ func (rhs *randHashShuffler) UpdateNodeLists(args ArgsUpdateNodes) (*ResUpdateNo
 		eligibleAfterReshard, waitingAfterReshard = rhs.mergeShards(args.Eligible, args.Waiting, newNbShards)
 	}
 
+	// the waiting pinned validators outside their dedicated shards, pinned after joining the waiting lists, are moved
+	// back to the waiting lists of their dedicated shards
+	_ = moveNodesToMap(waitingAfterReshard, rhs.pinnedValidators.extractMisplaced(waitingAfterReshard, args.NbShards))
+
 	return shuffleNodes(shuffleNodesArg{
 		eligible:               eligibleAfterReshard,
 		waiting:                waitingAfterReshard,
@@ -164,11 +217,56 @@ func (rhs *randHashShuffler) UpdateNodeLists(args ArgsUpdateNodes) (*ResUpdateNo
 		nodesMeta:              nodesMeta,
 		nodesPerShard:          nodesPerShard,
 		nbShards:               args.NbShards,
-		distributor:            rhs.validatorDistributor,
-		maxNodesToSwapPerShard: rhs.activeNodesConfig.NodesToShufflePerShard,
+		distributor:            distributor,
+		maxNodesToSwapPerShard: maxNodesToSwapPerShard,
+		maxNodesToSwapInMeta:   maxNodesToSwapInMeta,
+		pinnedValidators:       rhs.pinnedValidators,
 	})
 }
 
+// NodesToShuffle returns the maximum number of eligible validators shuffled out of each shard and of the metachain
+// when shuffling the validators into the provided epoch
+func (rhs *randHashShuffler) NodesToShuffle(epoch uint32) (uint32, uint32) {
+	rhs.mutShufflerParams.RLock()
+	defer rhs.mutShufflerParams.RUnlock()
+
+	nodesConfig := config.MaxNodesChangeConfig{NodesToShufflePerShard: rhs.nodesShard}
+	for _, maxNodesConfig := range rhs.availableNodesConfigs {
+		if epoch >= maxNodesConfig.EpochEnable {
+			nodesConfig = maxNodesConfig
+		}
+	}
+
+	if nodesConfig.NodesToShuffleInMeta == 0 {
+		return nodesConfig.NodesToShufflePerShard, nodesConfig.NodesToShufflePerShard
+	}
+
+	return nodesConfig.NodesToShufflePerShard, nodesConfig.NodesToShuffleInMeta
+}
+
+// computeNodesToShuffleInMeta returns the maximum number of validators shuffled out of the metachain
+// for the active config. Should be called under the shuffler params mutex
+func (rhs *randHashShuffler) computeNodesToShuffleInMeta() uint32 {
+	if rhs.activeNodesConfig.NodesToShuffleInMeta == 0 {
+		return rhs.activeNodesConfig.NodesToShufflePerShard
+	}
+
+	return rhs.activeNodesConfig.NodesToShuffleInMeta
+}
+
+// selectDistributor returns the distributor of the shuffled out validators for the active config.
+// Should be called under the shuffler params mutex
+func (rhs *randHashShuffler) selectDistributor() ValidatorsDistributor {
+	switch rhs.activeNodesConfig.ShuffledOutReEntry {
+	case ShuffledOutReEntryCrossShard:
+		return &CrossShardValidatorDistributor{}
+	case ShuffledOutReEntrySameShard:
+		return &IntraShardValidatorDistributor{}
+	default:
+		return rhs.validatorDistributor
+	}
+}
+
 func removeDupplicates(unstake []Validator, additionalLeaving []Validator) []Validator {
 	additionalCopy := make([]Validator, 0, len(additionalLeaving))
 	additionalCopy = append(additionalCopy, additionalLeaving...)
@@ -230,9 +328,13 @@ func shuffleNodes(arg shuffleNodesArg) (*ResUpdateNodes, error) {
 		return nil, err
 	}
 
-	for i, toRemove := range numToRemove {
-		if toRemove > int(arg.maxNodesToSwapPerShard) {
-			numToRemove[i] = int(arg.maxNodesToSwapPerShard)
+	for shardId, toRemove := range numToRemove {
+		maxNodesToSwap := arg.maxNodesToSwapPerShard
+		if shardId == core.MetachainShardId {
+			maxNodesToSwap = arg.maxNodesToSwapInMeta
+		}
+		if toRemove > int(maxNodesToSwap) {
+			numToRemove[shardId] = int(maxNodesToSwap)
 		}
 	}
 
@@ -244,17 +346,24 @@ func shuffleNodes(arg shuffleNodesArg) (*ResUpdateNodes, error) {
 
 	stillRemainingInLeaving := append(stillRemainingUnstakeLeaving, stillRemainingAdditionalLeaving...)
 
+	pinnedEligible := arg.pinnedValidators.extractInPinnedShard(newEligible, arg.nbShards)
 	shuffledOutMap, newEligible := shuffleOutNodes(newEligible, numToRemove, arg.randomness)
+	_ = moveNodesToMap(newEligible, pinnedEligible)
 
 	err = moveMaxNumNodesToMap(newEligible, newWaiting, arg.nodesMeta, arg.nodesPerShard)
 	if err != nil {
 		log.Warn("moveNodesToMap failed", "error", err)
 	}
-	err = distributeValidators(newWaiting, arg.newNodes, arg.randomness)
+
+	pinnedNewNodes, newNodes := arg.pinnedValidators.split(arg.newNodes, arg.nbShards)
+	_ = moveNodesToMap(newWaiting, pinnedNewNodes)
+	err = distributeValidators(newWaiting, newNodes, arg.randomness)
 	if err != nil {
 		log.Warn("distributeValidators newNodes failed", "error", err)
 	}
 
+	_ = moveNodesToMap(newWaiting, arg.pinnedValidators.extractMisplaced(shuffledOutMap, arg.nbShards))
+
 	err = arg.distributor.DistributeValidators(newWaiting, shuffledOutMap, arg.randomness)
 	if err != nil {
 		log.Warn("distributeValidators shuffledOut failed", "error", err)
@@ -656,6 +765,8 @@ func (rhs *randHashShuffler) UpdateShufflerConfig(epoch uint32) {
 		"maxNumNodes", rhs.activeNodesConfig.MaxNumNodes,
 		"epochEnable", rhs.activeNodesConfig.EpochEnable,
 		"maxNodesToShufflePerShard", rhs.activeNodesConfig.NodesToShufflePerShard,
+		"maxNodesToShuffleInMeta", rhs.computeNodesToShuffleInMeta(),
+		"shuffledOutReEntry", rhs.activeNodesConfig.ShuffledOutReEntry,
 	)
 }
 
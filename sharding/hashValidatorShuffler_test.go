@@ -1022,6 +1022,7 @@ func TestRandHashShuffler_UpdateParams(t *testing.T) {
 		shuffleBetweenShards:  true,
 		validatorDistributor:  &CrossShardValidatorDistributor{},
 		availableNodesConfigs: nil,
+		pinnedValidators:      shuffler.pinnedValidators,
 	}
 
 	shuffler.UpdateParams(
@@ -2576,6 +2577,33 @@ func TestRandHashShuffler_UpdateShufflerConfig(t *testing.T) {
 	}
 }
 
+func TestRandHashShuffler_NodesToShuffle(t *testing.T) {
+	t.Parallel()
+
+	shufflerArgs := &NodesShufflerArgs{
+		NodesShard: eligiblePerShard,
+		NodesMeta:  eligiblePerShard,
+		MaxNodesEnableConfig: []config.MaxNodesChangeConfig{
+			{EpochEnable: 2, MaxNumNodes: 100, NodesToShufflePerShard: 4},
+			{EpochEnable: 5, MaxNumNodes: 100, NodesToShufflePerShard: 3, NodesToShuffleInMeta: 1},
+		},
+	}
+	shuffler, err := NewHashValidatorsShuffler(shufflerArgs)
+	require.Nil(t, err)
+
+	perShard, inMeta := shuffler.NodesToShuffle(1)
+	assert.Equal(t, uint32(eligiblePerShard), perShard)
+	assert.Equal(t, uint32(eligiblePerShard), inMeta)
+
+	perShard, inMeta = shuffler.NodesToShuffle(4)
+	assert.Equal(t, uint32(4), perShard)
+	assert.Equal(t, uint32(4), inMeta)
+
+	perShard, inMeta = shuffler.NodesToShuffle(7)
+	assert.Equal(t, uint32(3), perShard)
+	assert.Equal(t, uint32(1), inMeta)
+}
+
 func getDummyShufflerConfigs() []config.MaxNodesChangeConfig {
 	return []config.MaxNodesChangeConfig{
 		{EpochEnable: 0, MaxNumNodes: 2500, NodesToShufflePerShard: 400},
@@ -2590,3 +2618,81 @@ func getDummyShufflerConfigs() []config.MaxNodesChangeConfig {
 		{EpochEnable: 2300, MaxNumNodes: 5400, NodesToShufflePerShard: 400},
 	}
 }
+
+func TestNewHashValidatorsShuffler_InvalidMaxNodesChangeConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	shufflerArgs := &NodesShufflerArgs{
+		NodesShard:           eligiblePerShard,
+		NodesMeta:            eligiblePerShard,
+		Hysteresis:           hysteresis,
+		Adaptivity:           adaptivity,
+		ShuffleBetweenShards: shuffleBetweenShards,
+		MaxNodesEnableConfig: []config.MaxNodesChangeConfig{
+			{EpochEnable: 0, MaxNumNodes: 100, NodesToShufflePerShard: 4},
+			{EpochEnable: 2, MaxNumNodes: 100, NodesToShufflePerShard: 2, ShuffledOutReEntry: "otherShard"},
+		},
+	}
+	shuffler, err := NewHashValidatorsShuffler(shufflerArgs)
+	assert.Nil(t, shuffler)
+	assert.True(t, errors.Is(err, ErrInvalidShuffledOutReEntry))
+
+	shufflerArgs.MaxNodesEnableConfig = []config.MaxNodesChangeConfig{
+		{EpochEnable: 2, MaxNumNodes: 100, NodesToShufflePerShard: 4},
+		{EpochEnable: 2, MaxNumNodes: 200, NodesToShufflePerShard: 2},
+	}
+	shuffler, err = NewHashValidatorsShuffler(shufflerArgs)
+	assert.Nil(t, shuffler)
+	assert.True(t, errors.Is(err, ErrDuplicatedEpochInNodesChangeConfig))
+}
+
+func TestRandHashShuffler_UpdateNodeListsWithConfiguredChurn(t *testing.T) {
+	t.Parallel()
+
+	nodesPerShard := 10
+	nbShards := uint32(2)
+	shufflerArgs := &NodesShufflerArgs{
+		NodesShard:           uint32(nodesPerShard),
+		NodesMeta:            uint32(nodesPerShard),
+		Hysteresis:           hysteresis,
+		Adaptivity:           adaptivity,
+		ShuffleBetweenShards: true,
+		MaxNodesEnableConfig: []config.MaxNodesChangeConfig{
+			{EpochEnable: 0, MaxNumNodes: 100, NodesToShufflePerShard: 1},
+			{
+				EpochEnable:            3,
+				MaxNumNodes:            100,
+				NodesToShufflePerShard: 5,
+				NodesToShuffleInMeta:   2,
+				ShuffledOutReEntry:     ShuffledOutReEntrySameShard,
+			},
+		},
+	}
+	shuffler, err := NewHashValidatorsShuffler(shufflerArgs)
+	require.Nil(t, err)
+
+	args := createShufflerArgs(2*nodesPerShard, nodesPerShard, nbShards)
+	args.Epoch = 3
+	res, err := shuffler.UpdateNodeLists(args)
+	require.Nil(t, err)
+
+	for shardId, eligible := range args.Eligible {
+		expectedShuffledOut := 5
+		if shardId == core.MetachainShardId {
+			expectedShuffledOut = 2
+		}
+
+		assert.Equal(t, len(eligible)-expectedShuffledOut, len(res.Eligible[shardId]))
+		assert.Equal(t, len(args.Waiting[shardId])+expectedShuffledOut, len(res.Waiting[shardId]))
+
+		for _, v := range eligible {
+			found, _ := searchInMap(res.Eligible, v.PubKey())
+			if found {
+				continue
+			}
+
+			// the shuffled out validators re-enter the waiting list of their own shard
+			assert.True(t, contains([]Validator{v}, res.Waiting[shardId]))
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
+	atomicCore "github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
@@ -22,7 +23,7 @@ var _ NodesCoordinator = (*indexHashedNodesCoordinator)(nil)
 var _ PublicKeysSelector = (*indexHashedNodesCoordinator)(nil)
 
 const (
-	keyFormat               = "%s_%v_%v_%v"
+	keyFormat               = "%s_%v_%v_%v_%v"
 	defaultSelectionChances = uint32(1)
 )
 
@@ -60,7 +61,10 @@ type epochNodesConfig struct {
 	selectors    map[uint32]RandomSelector
 	leavingMap   map[uint32][]Validator
 	newList      []Validator
+	randomness   []byte
 	mutNodesMaps sync.RWMutex
+
+	selectorsVersion int64
 }
 
 type indexHashedNodesCoordinator struct {
@@ -81,6 +85,9 @@ type indexHashedNodesCoordinator struct {
 	mutSavedStateKey              sync.RWMutex
 	nodesCoordinatorHelper        NodesCoordinatorHelper
 	consensusGroupCacher          Cacher
+	inFlightSelections            map[string]*inFlightSelection
+	mutInFlightSelections         sync.Mutex
+	selectorsVersion              atomicCore.Counter
 	loadingFromDisk               atomic.Value
 	shuffledOutHandler            ShuffledOutHandler
 	startEpoch                    uint32
@@ -121,6 +128,7 @@ func NewIndexHashedNodesCoordinator(arguments ArgNodesCoordinator) (*indexHashed
 		shardConsensusGroupSize:       arguments.ShardConsensusGroupSize,
 		metaConsensusGroupSize:        arguments.MetaConsensusGroupSize,
 		consensusGroupCacher:          arguments.ConsensusGroupCache,
+		inFlightSelections:            make(map[string]*inFlightSelection),
 		shardIDAsObserver:             arguments.ShardIDAsObserver,
 		shuffledOutHandler:            arguments.ShuffledOutHandler,
 		startEpoch:                    arguments.StartEpoch,
@@ -247,6 +255,7 @@ func (ihgs *indexHashedNodesCoordinator) setNodesPerShards(
 	if err != nil {
 		return err
 	}
+	nodesConfig.selectorsVersion = ihgs.nextSelectorsVersion()
 
 	ihgs.nodesConfig[epoch] = nodesConfig
 	ihgs.numTotalEligible = numTotalEligible
@@ -254,6 +263,20 @@ func (ihgs *indexHashedNodesCoordinator) setNodesPerShards(
 	return nil
 }
 
+// setEpochRandomness records the randomness used for shuffling the validators into the provided epoch
+func (ihgs *indexHashedNodesCoordinator) setEpochRandomness(randomness []byte, epoch uint32) {
+	ihgs.mutNodesConfig.RLock()
+	nodesConfig, ok := ihgs.nodesConfig[epoch]
+	ihgs.mutNodesConfig.RUnlock()
+	if !ok {
+		return
+	}
+
+	nodesConfig.mutNodesMaps.Lock()
+	nodesConfig.randomness = randomness
+	nodesConfig.mutNodesMaps.Unlock()
+}
+
 // ComputeAdditionalLeaving - computes extra leaving validators based on computation at the start of epoch
 func (ihgs *indexHashedNodesCoordinator) ComputeAdditionalLeaving(_ []*state.ShardValidatorInfo) (map[uint32][]Validator, error) {
 	return make(map[uint32][]Validator), nil
@@ -269,6 +292,7 @@ func (ihgs *indexHashedNodesCoordinator) ComputeConsensusGroup(
 ) (validatorsGroup []Validator, err error) {
 	var selector RandomSelector
 	var eligibleList []Validator
+	var selectorsVersion int64
 
 	log.Trace("computing consensus group for",
 		"epoch", epoch,
@@ -290,6 +314,7 @@ func (ihgs *indexHashedNodesCoordinator) ComputeConsensusGroup(
 		}
 		selector = nodesConfig.selectors[shardID]
 		eligibleList = nodesConfig.eligibleMap[shardID]
+		selectorsVersion = nodesConfig.selectorsVersion
 	}
 	ihgs.mutNodesConfig.RUnlock()
 
@@ -297,47 +322,21 @@ func (ihgs *indexHashedNodesCoordinator) ComputeConsensusGroup(
 		return nil, fmt.Errorf("%w epoch=%v", ErrEpochNodesConfigDoesNotExist, epoch)
 	}
 
-	key := []byte(fmt.Sprintf(keyFormat, string(randomness), round, shardID, epoch))
-	validators := ihgs.searchConsensusForKey(key)
-	if validators != nil {
-		return validators, nil
-	}
-
-	consensusSize := ihgs.ConsensusGroupSize(shardID)
-	randomness = []byte(fmt.Sprintf("%d-%s", round, randomness))
-
-	log.Debug("computeValidatorsGroup",
-		"randomness", randomness,
-		"consensus size", consensusSize,
-		"eligible list length", len(eligibleList),
-		"epoch", epoch,
-		"round", round,
-		"shardID", shardID)
+	key := consensusGroupKey(randomness, round, shardID, epoch, selectorsVersion)
+	return ihgs.selectConsensusGroupOnce(key, func() ([]Validator, error) {
+		consensusSize := ihgs.ConsensusGroupSize(shardID)
+		selectionRandomness := []byte(fmt.Sprintf("%d-%s", round, randomness))
 
-	tempList, err := selectValidators(selector, randomness, uint32(consensusSize), eligibleList)
-	if err != nil {
-		return nil, err
-	}
-
-	size := 0
-	for _, v := range tempList {
-		size += v.Size()
-	}
-
-	ihgs.consensusGroupCacher.Put(key, tempList, size)
-
-	return tempList, nil
-}
+		log.Debug("computeValidatorsGroup",
+			"randomness", selectionRandomness,
+			"consensus size", consensusSize,
+			"eligible list length", len(eligibleList),
+			"epoch", epoch,
+			"round", round,
+			"shardID", shardID)
 
-func (ihgs *indexHashedNodesCoordinator) searchConsensusForKey(key []byte) []Validator {
-	value, ok := ihgs.consensusGroupCacher.Get(key)
-	if ok {
-		consensusGroup, typeOk := value.([]Validator)
-		if typeOk {
-			return consensusGroup
-		}
-	}
-	return nil
+		return selectValidators(selector, selectionRandomness, uint32(consensusSize), eligibleList)
+	})
 }
 
 // GetValidatorWithPublicKey gets the validator with the given public key
@@ -576,6 +575,7 @@ func (ihgs *indexHashedNodesCoordinator) EpochStartPrepare(metaHdr data.HeaderHa
 	if err != nil {
 		log.Error("set nodes per shard failed", "error", err.Error())
 	}
+	ihgs.setEpochRandomness(randomness, newEpoch)
 
 	ihgs.fillPublicKeyToValidatorMap()
 	err = ihgs.saveState(randomness)
@@ -593,8 +593,6 @@ func (ihgs *indexHashedNodesCoordinator) EpochStartPrepare(metaHdr data.HeaderHa
 	ihgs.mutSavedStateKey.Lock()
 	ihgs.savedStateKey = randomness
 	ihgs.mutSavedStateKey.Unlock()
-
-	ihgs.consensusGroupCacher.Clear()
 }
 
 func (ihgs *indexHashedNodesCoordinator) fillPublicKeyToValidatorMap() {
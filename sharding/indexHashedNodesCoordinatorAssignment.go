@@ -0,0 +1,73 @@
+package sharding
+
+import (
+	"fmt"
+)
+
+// EpochValidatorsAssignment holds the complete validators assignment of an epoch together with the inputs of the
+// consensus group selection. The consensus group of a round is selected from the eligible list of the shard, in the
+// exported order, using an expanded list selector built from the exported weights and seeded with the
+// "<round>-<block random seed>" string. The nodes to shuffle are the maximum numbers of eligible validators shuffled
+// out of each shard and of the metachain when shuffling the validators into the next epoch
+type EpochValidatorsAssignment struct {
+	Epoch                   uint32
+	NumShards               uint32
+	ShardConsensusGroupSize uint32
+	MetaConsensusGroupSize  uint32
+	NodesToShufflePerShard  uint32
+	NodesToShuffleInMeta    uint32
+	Randomness              []byte
+	Eligible                map[uint32][]*SerializableValidator
+	Waiting                 map[uint32][]*SerializableValidator
+	Leaving                 map[uint32][]*SerializableValidator
+	SelectionWeights        map[uint32][]uint32
+}
+
+// GetEpochValidatorsAssignment returns the validators assignment of the provided epoch. Only the configurations of the
+// last stored epochs are available. The randomness is the one used for shuffling the validators into the epoch and
+// is empty for the epoch the nodes coordinator was started with
+func (ihgs *indexHashedNodesCoordinator) GetEpochValidatorsAssignment(epoch uint32) (*EpochValidatorsAssignment, error) {
+	ihgs.mutNodesConfig.RLock()
+	nodesConfig, ok := ihgs.nodesConfig[epoch]
+	ihgs.mutNodesConfig.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w epoch=%v", ErrEpochNodesConfigDoesNotExist, epoch)
+	}
+
+	nodesConfig.mutNodesMaps.RLock()
+	defer nodesConfig.mutNodesMaps.RUnlock()
+
+	assignment := &EpochValidatorsAssignment{
+		Epoch:                   epoch,
+		NumShards:               nodesConfig.nbShards,
+		ShardConsensusGroupSize: uint32(ihgs.shardConsensusGroupSize),
+		MetaConsensusGroupSize:  uint32(ihgs.metaConsensusGroupSize),
+		Randomness:              nodesConfig.randomness,
+		Eligible:                validatorsMapToSerializableValidatorsMap(nodesConfig.eligibleMap),
+		Waiting:                 validatorsMapToSerializableValidatorsMap(nodesConfig.waitingMap),
+		Leaving:                 validatorsMapToSerializableValidatorsMap(nodesConfig.leavingMap),
+		SelectionWeights:        make(map[uint32][]uint32, len(nodesConfig.eligibleMap)),
+	}
+	assignment.NodesToShufflePerShard, assignment.NodesToShuffleInMeta = ihgs.shuffler.NodesToShuffle(epoch + 1)
+
+	for shardID, eligibleList := range nodesConfig.eligibleMap {
+		weights, err := ihgs.nodesCoordinatorHelper.ValidatorsWeights(eligibleList)
+		if err != nil {
+			return nil, err
+		}
+
+		assignment.SelectionWeights[shardID] = weights
+	}
+
+	return assignment, nil
+}
+
+func validatorsMapToSerializableValidatorsMap(validators map[uint32][]Validator) map[uint32][]*SerializableValidator {
+	result := make(map[uint32][]*SerializableValidator, len(validators))
+	for shardID, list := range validators {
+		result[shardID] = ValidatorArrayToSerializableValidatorArray(list)
+	}
+
+	return result
+}
@@ -20,6 +20,7 @@ type EpochValidators struct {
 	EligibleValidators map[string][]*SerializableValidator `json:"eligibleValidators"`
 	WaitingValidators  map[string][]*SerializableValidator `json:"waitingValidators"`
 	LeavingValidators  map[string][]*SerializableValidator `json:"leavingValidators"`
+	Randomness         []byte                              `json:"randomness,omitempty"`
 }
 
 // NodesCoordinatorRegistry holds the data that can be used to initialize a nodes coordinator
@@ -152,6 +153,7 @@ func (ihgs *indexHashedNodesCoordinator) registryToNodesCoordinator(
 		if err != nil {
 			return nil, err
 		}
+		result[epoch32].selectorsVersion = ihgs.nextSelectorsVersion()
 	}
 
 	return result, nil
@@ -162,6 +164,7 @@ func epochNodesConfigToEpochValidators(config *epochNodesConfig) *EpochValidator
 		EligibleValidators: make(map[string][]*SerializableValidator, len(config.eligibleMap)),
 		WaitingValidators:  make(map[string][]*SerializableValidator, len(config.waitingMap)),
 		LeavingValidators:  make(map[string][]*SerializableValidator, len(config.leavingMap)),
+		Randomness:         config.randomness,
 	}
 
 	for k, v := range config.eligibleMap {
@@ -180,7 +183,9 @@ func epochNodesConfigToEpochValidators(config *epochNodesConfig) *EpochValidator
 }
 
 func epochValidatorsToEpochNodesConfig(config *EpochValidators) (*epochNodesConfig, error) {
-	result := &epochNodesConfig{}
+	result := &epochNodesConfig{
+		randomness: config.Randomness,
+	}
 	var err error
 
 	result.eligibleMap, err = serializableValidatorsMapToValidatorsMap(config.EligibleValidators)
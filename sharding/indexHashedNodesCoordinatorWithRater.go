@@ -48,6 +48,7 @@ func NewIndexHashedNodesCoordinatorWithRater(
 	if err != nil {
 		return nil, err
 	}
+	nodesConfig.selectorsVersion = ihncr.nextSelectorsVersion()
 
 	ihncr.epochStartRegistrationHandler.UnregisterHandler(indexNodesCoordinator)
 	ihncr.epochStartRegistrationHandler.RegisterHandler(ihncr)
@@ -532,6 +532,98 @@ func TestIndexHashedNodesCoordinator_ComputeValidatorsGroup400of400For10BlocksMe
 	require.Equal(t, miniBlocks, putCounter)
 }
 
+func TestIndexHashedNodesCoordinator_ComputeConsensusGroupConcurrentRequestsSelectOnce(t *testing.T) {
+	t.Parallel()
+
+	ihgs, err := NewIndexHashedNodesCoordinator(createArguments())
+	require.Nil(t, err)
+
+	numSelections := int32(0)
+	selectionStarted := make(chan struct{})
+	releaseSelection := make(chan struct{})
+	consensusGroup := createDummyNodesList(1, "consensus")
+	selectFunc := func() ([]Validator, error) {
+		atomic.AddInt32(&numSelections, 1)
+		close(selectionStarted)
+		<-releaseSelection
+		return consensusGroup, nil
+	}
+
+	key := consensusGroupKey([]byte("randomness"), 1, 0, 0, 1)
+	numRequests := 10
+	results := make([][]Validator, numRequests)
+	wg := sync.WaitGroup{}
+	wg.Add(numRequests)
+	go func() {
+		results[0], _ = ihgs.selectConsensusGroupOnce(key, selectFunc)
+		wg.Done()
+	}()
+
+	<-selectionStarted
+	for i := 1; i < numRequests; i++ {
+		go func(idx int) {
+			results[idx], _ = ihgs.selectConsensusGroupOnce(key, selectFunc)
+			wg.Done()
+		}(i)
+	}
+	time.Sleep(time.Millisecond * 100)
+	close(releaseSelection)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numSelections))
+	for _, result := range results {
+		assert.Equal(t, consensusGroup, result)
+	}
+	assert.Equal(t, 0, len(ihgs.inFlightSelections))
+}
+
+func TestIndexHashedNodesCoordinator_ComputeConsensusGroupCacheSurvivesNewNodesConfig(t *testing.T) {
+	t.Parallel()
+
+	putCounter := 0
+	clearCounter := 0
+	cacheMap := make(map[string]interface{})
+	mut := sync.Mutex{}
+	arguments := createArguments()
+	arguments.ConsensusGroupCache = &mock.NodesCoordinatorCacheMock{
+		ClearCalled: func() {
+			clearCounter++
+		},
+		PutCalled: func(key []byte, value interface{}, sizeInBytes int) (evicted bool) {
+			mut.Lock()
+			defer mut.Unlock()
+			putCounter++
+			cacheMap[string(key)] = value
+			return false
+		},
+		GetCalled: func(key []byte) (value interface{}, ok bool) {
+			mut.Lock()
+			defer mut.Unlock()
+			val, ok := cacheMap[string(key)]
+			return val, ok
+		},
+	}
+	ihgs, err := NewIndexHashedNodesCoordinator(arguments)
+	require.Nil(t, err)
+
+	group1, err := ihgs.ComputeConsensusGroup([]byte("randomness"), 1, 0, 0)
+	require.Nil(t, err)
+	group2, err := ihgs.ComputeConsensusGroup([]byte("randomness"), 1, 0, 0)
+	require.Nil(t, err)
+	assert.Equal(t, group1, group2)
+	assert.Equal(t, 1, putCounter)
+
+	// a new nodes config for the same epoch invalidates the consensus groups selected with the replaced one
+	err = ihgs.setNodesPerShards(arguments.EligibleNodes, arguments.WaitingNodes, nil, 0)
+	require.Nil(t, err)
+
+	_, err = ihgs.ComputeConsensusGroup([]byte("randomness"), 1, 0, 0)
+	require.Nil(t, err)
+	assert.Equal(t, 2, putCounter)
+	assert.Equal(t, 2, len(cacheMap))
+	assert.Equal(t, 0, clearCounter)
+}
+
 func TestIndexHashedNodesCoordinator_ComputeValidatorsGroup63of400TestEqualSameParams(t *testing.T) {
 	t.Skip("testing consistency - to be run manually")
 	cache := &mock.NodesCoordinatorCacheMock{
@@ -1085,6 +1177,49 @@ func TestIndexHashedNodesCoordinator_EpochStart(t *testing.T) {
 	require.Equal(t, arguments.ShardIDAsObserver, computedShardId)
 }
 
+func TestIndexHashedNodesCoordinator_GetEpochValidatorsAssignment(t *testing.T) {
+	t.Parallel()
+
+	arguments := createArguments()
+	ihgs, err := NewIndexHashedNodesCoordinator(arguments)
+	require.Nil(t, err)
+	epoch := uint32(1)
+
+	assignment, err := ihgs.GetEpochValidatorsAssignment(epoch)
+	assert.Nil(t, assignment)
+	assert.True(t, errors.Is(err, ErrEpochNodesConfigDoesNotExist))
+
+	header := &block.MetaBlock{
+		PrevRandSeed: []byte("rand seed"),
+		EpochStart:   block.EpochStart{LastFinalizedHeaders: []block.EpochStartShardData{{}}},
+		Epoch:        epoch,
+	}
+	ihgs.nodesConfig[epoch] = ihgs.nodesConfig[0]
+	body := createBlockBodyFromNodesCoordinator(ihgs, epoch)
+	ihgs.EpochStartPrepare(header, body)
+
+	assignment, err = ihgs.GetEpochValidatorsAssignment(epoch)
+	require.Nil(t, err)
+	assert.Equal(t, epoch, assignment.Epoch)
+	assert.Equal(t, header.PrevRandSeed, assignment.Randomness)
+	assert.Equal(t, uint32(arguments.ShardConsensusGroupSize), assignment.ShardConsensusGroupSize)
+	assert.Equal(t, uint32(arguments.MetaConsensusGroupSize), assignment.MetaConsensusGroupSize)
+
+	eligible, _ := ihgs.GetAllEligibleValidatorsPublicKeys(epoch)
+	waiting, _ := ihgs.GetAllWaitingValidatorsPublicKeys(epoch)
+	for shardID, pubKeys := range eligible {
+		require.Equal(t, len(pubKeys), len(assignment.Eligible[shardID]))
+		assert.Equal(t, len(pubKeys), len(assignment.SelectionWeights[shardID]))
+		for i, pubKey := range pubKeys {
+			assert.Equal(t, pubKey, assignment.Eligible[shardID][i].PubKey)
+		}
+		assert.Equal(t, len(waiting[shardID]), len(assignment.Waiting[shardID]))
+	}
+
+	registry := ihgs.NodesCoordinatorToRegistry()
+	assert.Equal(t, header.PrevRandSeed, registry.EpochsConfig[fmt.Sprint(epoch)].Randomness)
+}
+
 func TestIndexHashedNodesCoordinator_EpochStartInEligible(t *testing.T) {
 	t.Parallel()
 
@@ -36,6 +36,7 @@ type NodesCoordinator interface {
 	ShardIdForEpoch(epoch uint32) (uint32, error)
 	ShuffleOutForEpoch(_ uint32)
 	GetConsensusWhitelistedNodes(epoch uint32) (map[string]struct{}, error)
+	GetEpochValidatorsAssignment(epoch uint32) (*EpochValidatorsAssignment, error)
 	ConsensusGroupSize(uint32) int
 	GetNumTotalEligible() uint64
 	IsInterfaceNil() bool
@@ -88,6 +89,7 @@ type ResUpdateNodes struct {
 type NodesShuffler interface {
 	UpdateParams(numNodesShard uint32, numNodesMeta uint32, hysteresis float32, adaptivity bool)
 	UpdateNodeLists(args ArgsUpdateNodes) (*ResUpdateNodes, error)
+	NodesToShuffle(epoch uint32) (uint32, uint32)
 	IsInterfaceNil() bool
 }
 
@@ -116,6 +118,15 @@ type PeerAccountListAndRatingHandler interface {
 	ComputeIncreaseValidator(shardId uint32, currentRating uint32) uint32
 	//ComputeDecreaseValidator computes the new rating for the decreaseValidator
 	ComputeDecreaseValidator(shardId uint32, currentRating uint32) uint32
+	//ComputeDecreaseValidatorOnConsecutiveMisses computes the new rating for the decreaseValidator, escalated by the
+	//number of consecutive missed signatures
+	ComputeDecreaseValidatorOnConsecutiveMisses(shardId uint32, currentRating uint32, consecutiveMisses uint32) uint32
+	//ComputeProbationDecrease amplifies the decrease from the current rating to the new rating of a validator in probation
+	ComputeProbationDecrease(currentRating uint32, newRating uint32) uint32
+	//GetProbationConsecutiveMissesThreshold gets the number of consecutive misses that put a validator in probation
+	GetProbationConsecutiveMissesThreshold() uint32
+	//GetProbationDurationInEpochs gets the number of epochs a validator remains in probation
+	GetProbationDurationInEpochs() uint32
 	//IsInterfaceNil verifies if the interface is nil
 	IsInterfaceNil() bool
 }
@@ -2,49 +2,38 @@ package sharding
 
 import (
 	"bytes"
-	"math"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/addressRouting"
 )
 
 var _ Coordinator = (*multiShardCoordinator)(nil)
 
 // multiShardCoordinator struct defines the functionality for handling transaction dispatching to
 // the corresponding shards. The number of shards is currently passed as a constructor
-// parameter and later it should be calculated by this structure
+// parameter and later it should be calculated by this structure. The shard of an address is
+// computed by the address routing library, also used by the wallets and the off-chain services
 type multiShardCoordinator struct {
-	maskHigh       uint32
-	maskLow        uint32
+	addressRouter  *addressRouting.ShardCoordinator
 	selfId         uint32
 	numberOfShards uint32
 }
 
-// NewMultiShardCoordinator returns a new multiShardCoordinator and initializes the masks
+// NewMultiShardCoordinator returns a new multiShardCoordinator
 func NewMultiShardCoordinator(numberOfShards, selfId uint32) (*multiShardCoordinator, error) {
-	if numberOfShards < 1 {
-		return nil, ErrInvalidNumberOfShards
-	}
-	if selfId >= numberOfShards && selfId != core.MetachainShardId {
-		return nil, ErrInvalidShardId
+	addressRouter, err := addressRouting.NewShardCoordinator(numberOfShards, selfId)
+	if err != nil {
+		return nil, err
 	}
 
 	sr := &multiShardCoordinator{}
+	sr.addressRouter = addressRouter
 	sr.selfId = selfId
 	sr.numberOfShards = numberOfShards
-	sr.maskHigh, sr.maskLow = sr.calculateMasks()
 
 	return sr, nil
 }
 
-// calculateMasks will create two numbers who's binary form is composed from as many
-// ones needed to be taken into consideration for the shard assignment. The result
-// of a bitwise AND operation of an address with this mask will result in the
-// shard id where a transaction from that address will be dispatched
-func (msc *multiShardCoordinator) calculateMasks() (uint32, uint32) {
-	n := math.Ceil(math.Log2(float64(msc.numberOfShards)))
-	return (1 << uint(n)) - 1, (1 << uint(n-1)) - 1
-}
-
 // ComputeId calculates the shard for a given address container
 func (msc *multiShardCoordinator) ComputeId(address []byte) uint32 {
 	return msc.ComputeIdFromBytes(address)
@@ -52,28 +41,7 @@ func (msc *multiShardCoordinator) ComputeId(address []byte) uint32 {
 
 // ComputeIdFromBytes calculates the shard for a given address
 func (msc *multiShardCoordinator) ComputeIdFromBytes(address []byte) uint32 {
-	bytesNeed := int(msc.numberOfShards/core.MaxNumShards) + 1
-	startingIndex := 0
-	if len(address) > bytesNeed {
-		startingIndex = len(address) - bytesNeed
-	}
-
-	buffNeeded := address[startingIndex:]
-	if core.IsSmartContractOnMetachain(buffNeeded, address) {
-		return core.MetachainShardId
-	}
-
-	addr := uint32(0)
-	for i := 0; i < len(buffNeeded); i++ {
-		addr = addr<<8 + uint32(buffNeeded[i])
-	}
-
-	shard := addr & msc.maskHigh
-	if shard > msc.numberOfShards-1 {
-		shard = addr & msc.maskLow
-	}
-
-	return shard
+	return msc.addressRouter.ComputeId(address)
 }
 
 // NumberOfShards returns the number of shards
@@ -17,14 +17,35 @@ func getAddressFromUint32(address uint32) []byte {
 }
 
 func TestMultiShardCoordinator_NewMultiShardCoordinator(t *testing.T) {
-	nrOfShards := uint32(10)
-	sr, _ := NewMultiShardCoordinator(nrOfShards, 0)
-	assert.Equal(t, nrOfShards, sr.NumberOfShards())
-	expectedMask1, expectedMask2 := sr.calculateMasks()
-	actualMask1 := sr.maskHigh
-	actualMask2 := sr.maskLow
-	assert.Equal(t, expectedMask1, actualMask1)
-	assert.Equal(t, expectedMask2, actualMask2)
+	// the addresses whose masked value is greater than the last shard fall back on the lower mask
+	dataSet := []struct {
+		numShards uint32
+		address   uint32
+		shardId   uint32
+	}{
+		{numShards: 3, address: 0, shardId: 0},
+		{numShards: 3, address: 2, shardId: 2},
+		{numShards: 3, address: 3, shardId: 1},
+		{numShards: 3, address: 4, shardId: 0},
+		{numShards: 3, address: 7, shardId: 1},
+		{numShards: 5, address: 4, shardId: 4},
+		{numShards: 5, address: 5, shardId: 1},
+		{numShards: 5, address: 7, shardId: 3},
+		{numShards: 5, address: 8, shardId: 0},
+		{numShards: 5, address: 13, shardId: 1},
+		{numShards: 10, address: 9, shardId: 9},
+		{numShards: 10, address: 10, shardId: 2},
+		{numShards: 10, address: 15, shardId: 7},
+		{numShards: 10, address: 16, shardId: 0},
+		{numShards: 10, address: 26, shardId: 2},
+	}
+	for _, data := range dataSet {
+		sr, err := NewMultiShardCoordinator(data.numShards, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, data.numShards, sr.NumberOfShards())
+		assert.Equal(t, data.shardId, sr.ComputeId(getAddressFromUint32(data.address)),
+			"address %d with %d shards", data.address, data.numShards)
+	}
 }
 
 func TestMultiShardCoordinator_NewMultiShardCoordinatorInvalidNumberOfShards(t *testing.T) {
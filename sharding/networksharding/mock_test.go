@@ -96,6 +96,11 @@ func (ncs *nodesCoordinatorStub) GetConsensusWhitelistedNodes(_ uint32) (map[str
 	panic("implement me")
 }
 
+// GetEpochValidatorsAssignment -
+func (ncs *nodesCoordinatorStub) GetEpochValidatorsAssignment(_ uint32) (*sharding.EpochValidatorsAssignment, error) {
+	panic("implement me")
+}
+
 // ConsensusGroupSize -
 func (ncs *nodesCoordinatorStub) ConsensusGroupSize(uint32) int {
 	panic("implement me")
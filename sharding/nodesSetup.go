@@ -3,9 +3,11 @@ package sharding
 import (
 	"bytes"
 	"fmt"
+	"sort"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
 )
 
 var _ GenesisNodesSetupHandler = (*NodesSetup)(nil)
@@ -68,9 +70,11 @@ type NodesSetup struct {
 	MetaChainMinNodes           uint32  `json:"metaChainMinNodes"`
 	Hysteresis                  float32 `json:"hysteresis"`
 	Adaptivity                  bool    `json:"adaptivity"`
+	ShardAssignmentSeed         string  `json:"shardAssignmentSeed"`
 
 	InitialNodes []*InitialNode `json:"initialNodes"`
 
+	assignmentOrder          []*InitialNode
 	genesisMaxNumShards      uint32
 	nrOfShards               uint32
 	nrOfNodes                uint32
@@ -189,15 +193,47 @@ func (ns *NodesSetup) processConfig() error {
 		return ErrNodesSizeSmallerThanMinNoOfNodes
 	}
 
+	ns.assignmentOrder = ns.computeAssignmentOrder()
+
 	return nil
 }
 
+// computeAssignmentOrder returns the order in which the initial nodes are assigned to the metachain, to the shards
+// and to the waiting lists. Without a shard assignment seed, the nodes are taken in the order they are defined in the
+// nodes setup file. With a shard assignment seed, each node gets the sort key sha256(seed | decoded public key) and
+// the nodes are taken in the ascending order of their sort keys, the decoded public keys breaking the ties. The same
+// seed and the same set of nodes always produce the same topology, regardless of the order of the nodes in the file
+func (ns *NodesSetup) computeAssignmentOrder() []*InitialNode {
+	order := make([]*InitialNode, len(ns.InitialNodes))
+	copy(order, ns.InitialNodes)
+	if len(ns.ShardAssignmentSeed) == 0 {
+		return order
+	}
+
+	hasher := &sha256.Sha256{}
+	sortKeys := make(map[*InitialNode][]byte, len(order))
+	for _, in := range order {
+		sortKeys[in] = hasher.Compute(ns.ShardAssignmentSeed + string(in.pubKey))
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		cmp := bytes.Compare(sortKeys[order[i]], sortKeys[order[j]])
+		if cmp != 0 {
+			return cmp < 0
+		}
+
+		return bytes.Compare(order[i].pubKey, order[j].pubKey) < 0
+	})
+
+	return order
+}
+
 func (ns *NodesSetup) processMetaChainAssigment() {
 	ns.nrOfMetaChainNodes = 0
 	for id := uint32(0); id < ns.MetaChainMinNodes; id++ {
-		if ns.InitialNodes[id].pubKey != nil {
-			ns.InitialNodes[id].assignedShard = core.MetachainShardId
-			ns.InitialNodes[id].eligible = true
+		if ns.assignmentOrder[id].pubKey != nil {
+			ns.assignmentOrder[id].assignedShard = core.MetachainShardId
+			ns.assignmentOrder[id].eligible = true
 			ns.nrOfMetaChainNodes++
 		}
 	}
@@ -213,15 +249,16 @@ func (ns *NodesSetup) processMetaChainAssigment() {
 }
 
 func (ns *NodesSetup) processShardAssignment() {
-	// initial implementation - as there is no other info than public key, we allocate first nodes in FIFO order to shards
+	// initial implementation - as there is no other info than public key, we allocate first nodes in FIFO order to shards,
+	// following the assignment order
 	currentShard := uint32(0)
 	countSetNodes := ns.nrOfMetaChainNodes
 	for ; currentShard < ns.nrOfShards; currentShard++ {
 		for id := countSetNodes; id < ns.nrOfMetaChainNodes+(currentShard+1)*ns.MinNodesPerShard; id++ {
 			// consider only nodes with valid public key
-			if ns.InitialNodes[id].pubKey != nil {
-				ns.InitialNodes[id].assignedShard = currentShard
-				ns.InitialNodes[id].eligible = true
+			if ns.assignmentOrder[id].pubKey != nil {
+				ns.assignmentOrder[id].assignedShard = currentShard
+				ns.assignmentOrder[id].eligible = true
 				countSetNodes++
 			}
 		}
@@ -235,9 +272,9 @@ func (ns *NodesSetup) processShardAssignment() {
 			currentShard = core.MetachainShardId
 		}
 
-		if ns.InitialNodes[i].pubKey != nil {
-			ns.InitialNodes[i].assignedShard = currentShard
-			ns.InitialNodes[i].eligible = false
+		if ns.assignmentOrder[i].pubKey != nil {
+			ns.assignmentOrder[i].assignedShard = currentShard
+			ns.assignmentOrder[i].eligible = false
 		}
 	}
 }
@@ -247,7 +284,7 @@ func (ns *NodesSetup) createInitialNodesInfo() {
 
 	ns.eligible = make(map[uint32][]GenesisNodeInfoHandler, nrOfShardAndMeta)
 	ns.waiting = make(map[uint32][]GenesisNodeInfoHandler, nrOfShardAndMeta)
-	for _, in := range ns.InitialNodes {
+	for _, in := range ns.assignmentOrder {
 		if in.pubKey != nil && in.address != nil {
 			nodeInfo := &NodeInfo{
 				assignedShard: in.assignedShard,
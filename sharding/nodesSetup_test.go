@@ -2,11 +2,13 @@ package sharding
 
 import (
 	"encoding/hex"
+	"fmt"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/sharding/mock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -727,3 +729,108 @@ func TestNodesSetup_NodesAboveMaxShardLimit(t *testing.T) {
 	minHysteresisNodesMeta = ns.MinMetaHysteresisNodes()
 	assert.Equal(t, uint32(80), minHysteresisNodesMeta)
 }
+
+func createSeededNodesSetup(seed string, noOfInitialNodes int) *NodesSetup {
+	ns := &NodesSetup{
+		ConsensusGroupSize:          2,
+		MinNodesPerShard:            3,
+		MetaChainConsensusGroupSize: 2,
+		MetaChainMinNodes:           3,
+		ShardAssignmentSeed:         seed,
+		addressPubkeyConverter:      mock.NewPubkeyConverterMock(32),
+		validatorPubkeyConverter:    mock.NewPubkeyConverterMock(96),
+		genesisMaxNumShards:         100,
+	}
+	ns.InitialNodes = make([]*InitialNode, noOfInitialNodes)
+	for i := 0; i < noOfInitialNodes; i++ {
+		ns.InitialNodes[i] = &InitialNode{
+			PubKey:  hex.EncodeToString([]byte(fmt.Sprintf("pubkey%03d", i))),
+			Address: address[i%len(address)],
+		}
+	}
+
+	return ns
+}
+
+func assignNodes(t *testing.T, ns *NodesSetup) {
+	err := ns.processConfig()
+	require.Nil(t, err)
+
+	ns.processMetaChainAssigment()
+	ns.processShardAssignment()
+	ns.createInitialNodesInfo()
+}
+
+func nodesTopology(ns *NodesSetup) map[string]string {
+	topology := make(map[string]string)
+	for _, in := range ns.InitialNodes {
+		topology[in.PubKey] = fmt.Sprintf("%d-%v", in.assignedShard, in.eligible)
+	}
+
+	return topology
+}
+
+func TestNodesSetup_EmptyShardAssignmentSeedKeepsTheFileOrder(t *testing.T) {
+	t.Parallel()
+
+	ns := createSeededNodesSetup("", 14)
+	assignNodes(t, ns)
+
+	require.Equal(t, uint32(3), ns.nrOfShards)
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, core.MetachainShardId, ns.InitialNodes[i].assignedShard)
+	}
+	for i := 3; i < 12; i++ {
+		assert.Equal(t, uint32((i-3)/3), ns.InitialNodes[i].assignedShard)
+		assert.True(t, ns.InitialNodes[i].eligible)
+	}
+	assert.Equal(t, uint32(1), ns.InitialNodes[12].assignedShard)
+	assert.Equal(t, uint32(2), ns.InitialNodes[13].assignedShard)
+}
+
+func TestNodesSetup_ShardAssignmentSeedIsReproducible(t *testing.T) {
+	t.Parallel()
+
+	ns1 := createSeededNodesSetup("devnet seed", 14)
+	assignNodes(t, ns1)
+	ns2 := createSeededNodesSetup("devnet seed", 14)
+	assignNodes(t, ns2)
+
+	assert.Equal(t, nodesTopology(ns1), nodesTopology(ns2))
+	assert.Equal(t, ns1.eligible, ns2.eligible)
+	assert.Equal(t, ns1.waiting, ns2.waiting)
+
+	// the topology does not depend on the order of the nodes in the file
+	ns3 := createSeededNodesSetup("devnet seed", 14)
+	for i, j := 0, len(ns3.InitialNodes)-1; i < j; i, j = i+1, j-1 {
+		ns3.InitialNodes[i], ns3.InitialNodes[j] = ns3.InitialNodes[j], ns3.InitialNodes[i]
+	}
+	assignNodes(t, ns3)
+
+	assert.Equal(t, nodesTopology(ns1), nodesTopology(ns3))
+	assert.Equal(t, ns1.eligible, ns3.eligible)
+	assert.Equal(t, ns1.waiting, ns3.waiting)
+}
+
+func TestNodesSetup_ShardAssignmentSeedChangesTheTopology(t *testing.T) {
+	t.Parallel()
+
+	unseeded := createSeededNodesSetup("", 14)
+	assignNodes(t, unseeded)
+	seeded1 := createSeededNodesSetup("seed 1", 14)
+	assignNodes(t, seeded1)
+	seeded2 := createSeededNodesSetup("seed 2", 14)
+	assignNodes(t, seeded2)
+
+	assert.NotEqual(t, nodesTopology(unseeded), nodesTopology(seeded1))
+	assert.NotEqual(t, nodesTopology(seeded1), nodesTopology(seeded2))
+
+	// the seed only changes which nodes go where, not the sizes of the lists
+	for _, ns := range []*NodesSetup{seeded1, seeded2} {
+		require.Equal(t, unseeded.nrOfShards, ns.nrOfShards)
+		for shardID, eligible := range unseeded.eligible {
+			assert.Equal(t, len(eligible), len(ns.eligible[shardID]))
+			assert.Equal(t, len(unseeded.waiting[shardID]), len(ns.waiting[shardID]))
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package sharding
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+// pinnedValidators maps the public keys of the validators pinned by a permissioned deployment to their dedicated
+// shards. A pinned validator is never shuffled out of its dedicated shard and always joins its waiting list
+type pinnedValidators map[string]uint32
+
+// DecodePinnedValidators decodes the configured pinned shards into a map of validator public keys to their dedicated
+// shards. Only the shards, not the metachain, can be dedicated and a validator can be pinned to a single shard
+func DecodePinnedValidators(
+	pinnedShards []config.PinnedShardConfig,
+	pubkeyConverter core.PubkeyConverter,
+) (map[string]uint32, error) {
+	if check.IfNil(pubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	pinned := make(map[string]uint32)
+	for _, pinnedShard := range pinnedShards {
+		for _, publicKey := range pinnedShard.PublicKeys {
+			pubKeyBytes, err := pubkeyConverter.Decode(publicKey)
+			if err != nil {
+				return nil, fmt.Errorf("%w: public key %s: %v", ErrInvalidPinnedShardConfig, publicKey, err)
+			}
+
+			_, exists := pinned[string(pubKeyBytes)]
+			if exists {
+				return nil, fmt.Errorf("%w: public key %s pinned more than once", ErrInvalidPinnedShardConfig, publicKey)
+			}
+			pinned[string(pubKeyBytes)] = pinnedShard.ShardID
+		}
+	}
+
+	err := checkPinnedValidators(pinned)
+	if err != nil {
+		return nil, err
+	}
+
+	return pinned, nil
+}
+
+func checkPinnedValidators(pinned map[string]uint32) error {
+	for _, shardID := range pinned {
+		if shardID == core.MetachainShardId || shardID >= core.MaxNumShards {
+			return fmt.Errorf("%w: shard %d can not be dedicated", ErrInvalidPinnedShardConfig, shardID)
+		}
+	}
+
+	return nil
+}
+
+// shardOf returns the dedicated shard of the provided validator. The validators pinned to a shard that does not
+// exist with the provided number of shards are handled as not pinned
+func (pv pinnedValidators) shardOf(pubKey []byte, nbShards uint32) (uint32, bool) {
+	shardID, isPinned := pv[string(pubKey)]
+	if !isPinned || shardID >= nbShards {
+		return 0, false
+	}
+
+	return shardID, true
+}
+
+// extractInPinnedShard removes from the provided map the pinned validators found in their dedicated shards and
+// returns them grouped by shard
+func (pv pinnedValidators) extractInPinnedShard(source map[uint32][]Validator, nbShards uint32) map[uint32][]Validator {
+	return pv.extract(source, nbShards, true)
+}
+
+// extractMisplaced removes from the provided map the pinned validators found outside their dedicated shards and
+// returns them grouped by their dedicated shards
+func (pv pinnedValidators) extractMisplaced(source map[uint32][]Validator, nbShards uint32) map[uint32][]Validator {
+	return pv.extract(source, nbShards, false)
+}
+
+func (pv pinnedValidators) extract(
+	source map[uint32][]Validator,
+	nbShards uint32,
+	inPinnedShard bool,
+) map[uint32][]Validator {
+	extracted := make(map[uint32][]Validator)
+	if len(pv) == 0 {
+		return extracted
+	}
+
+	for _, shardID := range sortKeys(source) {
+		remaining := make([]Validator, 0, len(source[shardID]))
+		for _, v := range source[shardID] {
+			pinnedShard, isPinned := pv.shardOf(v.PubKey(), nbShards)
+			if !isPinned || (pinnedShard == shardID) != inPinnedShard {
+				remaining = append(remaining, v)
+				continue
+			}
+
+			extracted[pinnedShard] = append(extracted[pinnedShard], v)
+		}
+		source[shardID] = remaining
+	}
+
+	return extracted
+}
+
+// split separates the pinned validators, grouped by their dedicated shards, from the rest of the provided validators
+func (pv pinnedValidators) split(validators []Validator, nbShards uint32) (map[uint32][]Validator, []Validator) {
+	pinned := make(map[uint32][]Validator)
+	if len(pv) == 0 {
+		return pinned, validators
+	}
+
+	remaining := make([]Validator, 0, len(validators))
+	for _, v := range validators {
+		pinnedShard, isPinned := pv.shardOf(v.PubKey(), nbShards)
+		if !isPinned {
+			remaining = append(remaining, v)
+			continue
+		}
+
+		pinned[pinnedShard] = append(pinned[pinnedShard], v)
+	}
+
+	return pinned, remaining
+}
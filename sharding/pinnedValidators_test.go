@@ -0,0 +1,112 @@
+package sharding
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/sharding/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodePinnedValidators(t *testing.T) {
+	t.Parallel()
+
+	converter := mock.NewPubkeyConverterMock(96)
+
+	pinned, err := DecodePinnedValidators(nil, nil)
+	assert.Nil(t, pinned)
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+
+	pinned, err = DecodePinnedValidators([]config.PinnedShardConfig{{ShardID: 0, PublicKeys: []string{"not hex"}}}, converter)
+	assert.Nil(t, pinned)
+	assert.True(t, errors.Is(err, ErrInvalidPinnedShardConfig))
+
+	pinned, err = DecodePinnedValidators([]config.PinnedShardConfig{
+		{ShardID: 0, PublicKeys: []string{"aa"}},
+		{ShardID: 1, PublicKeys: []string{"aa"}},
+	}, converter)
+	assert.Nil(t, pinned)
+	assert.True(t, errors.Is(err, ErrInvalidPinnedShardConfig))
+
+	pinned, err = DecodePinnedValidators([]config.PinnedShardConfig{{ShardID: core.MetachainShardId, PublicKeys: []string{"aa"}}}, converter)
+	assert.Nil(t, pinned)
+	assert.True(t, errors.Is(err, ErrInvalidPinnedShardConfig))
+
+	pinned, err = DecodePinnedValidators([]config.PinnedShardConfig{
+		{ShardID: 0, PublicKeys: []string{"aa", "bb"}},
+		{ShardID: 2, PublicKeys: []string{"cc"}},
+	}, converter)
+	require.Nil(t, err)
+	assert.Equal(t, map[string]uint32{"\xaa": 0, "\xbb": 0, "\xcc": 2}, pinned)
+}
+
+func TestNewHashValidatorsShuffler_InvalidPinnedValidatorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	shuffler, err := NewHashValidatorsShuffler(&NodesShufflerArgs{
+		NodesShard:       eligiblePerShard,
+		NodesMeta:        eligiblePerShard,
+		PinnedValidators: map[string]uint32{"pk": core.MetachainShardId},
+	})
+	assert.Nil(t, shuffler)
+	assert.True(t, errors.Is(err, ErrInvalidPinnedShardConfig))
+}
+
+func TestRandHashShuffler_UpdateNodeListsWithPinnedValidators(t *testing.T) {
+	t.Parallel()
+
+	nbShards := uint32(3)
+	eligibleMap := generateValidatorMap(eligiblePerShard, nbShards)
+	waitingMap := generateValidatorMap(30, nbShards)
+	newNodes := generateValidatorList(10)
+
+	pinned := make(map[string]uint32)
+	pinnedEligible := eligibleMap[1][:20]
+	for _, v := range pinnedEligible {
+		pinned[string(v.PubKey())] = 1
+	}
+	pinnedWaiting := waitingMap[0][0]
+	pinned[string(pinnedWaiting.PubKey())] = 2
+	pinnedNewNode := newNodes[0]
+	pinned[string(pinnedNewNode.PubKey())] = 1
+	notExistingShardNode := newNodes[1]
+	pinned[string(notExistingShardNode.PubKey())] = nbShards
+
+	shuffler, err := NewHashValidatorsShuffler(&NodesShufflerArgs{
+		NodesShard:           eligiblePerShard,
+		NodesMeta:            eligiblePerShard,
+		ShuffleBetweenShards: true,
+		PinnedValidators:     pinned,
+	})
+	require.Nil(t, err)
+
+	res, err := shuffler.UpdateNodeLists(ArgsUpdateNodes{
+		Eligible: eligibleMap,
+		Waiting:  waitingMap,
+		NewNodes: newNodes,
+		Rand:     generateRandomByteArray(32),
+		NbShards: nbShards,
+	})
+	require.Nil(t, err)
+
+	for _, v := range pinnedEligible {
+		found, shardID := searchInMap(res.Eligible, v.PubKey())
+		assert.True(t, found, hex.EncodeToString(v.PubKey()))
+		assert.Equal(t, uint32(1), shardID)
+	}
+
+	foundInEligible, eligibleShardID := searchInMap(res.Eligible, pinnedWaiting.PubKey())
+	foundInWaiting, waitingShardID := searchInMap(res.Waiting, pinnedWaiting.PubKey())
+	assert.True(t, (foundInEligible && eligibleShardID == 2) || (foundInWaiting && waitingShardID == 2))
+
+	found, shardID := searchInMap(res.Waiting, pinnedNewNode.PubKey())
+	assert.True(t, found)
+	assert.Equal(t, uint32(1), shardID)
+
+	found, _ = searchInMap(res.Waiting, notExistingShardNode.PubKey())
+	assert.True(t, found)
+}
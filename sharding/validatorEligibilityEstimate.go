@@ -0,0 +1,124 @@
+package sharding
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+const (
+	// ValidatorStatusEligible is the status of a validator found in an eligible list
+	ValidatorStatusEligible = "eligible"
+	// ValidatorStatusWaiting is the status of a validator found in a waiting list
+	ValidatorStatusWaiting = "waiting"
+	// ValidatorStatusLeaving is the status of a validator found in a leaving list
+	ValidatorStatusLeaving = "leaving"
+	// ValidatorStatusQueued is the status of a validator waiting in the staking queue
+	ValidatorStatusQueued = "queued"
+	// ValidatorStatusInactive is the status of a validator not found in the nodes lists or in the staking queue
+	ValidatorStatusInactive = "inactive"
+)
+
+// ValidatorEligibilityEstimate holds the status of a validator in an epoch and, when it can be computed, the estimated
+// epoch at which the validator becomes eligible. The position is the 0 based index in the eligible or waiting list of
+// the shard, or the 1 based position in the staking queue
+type ValidatorEligibilityEstimate struct {
+	Epoch                  uint32
+	Status                 string
+	ShardID                uint32
+	Position               uint32
+	HasEstimate            bool
+	EstimatedEligibleEpoch uint32
+}
+
+// EstimateEligibleEpoch returns the status of the provided validator in the assignment lists. The waiting validators
+// are promoted to the eligible lists from the front of their waiting lists, so a validator found at index i of a
+// waiting list is estimated to become eligible after i/NodesToShuffle + 1 epochs, considering the current churn
+// parameters. A validator not found in any list has the inactive status
+func (assignment *EpochValidatorsAssignment) EstimateEligibleEpoch(publicKey []byte) *ValidatorEligibilityEstimate {
+	estimate := &ValidatorEligibilityEstimate{
+		Epoch:  assignment.Epoch,
+		Status: ValidatorStatusInactive,
+	}
+
+	shardID, index, found := searchInSerializableValidatorsMap(assignment.Eligible, publicKey)
+	if found {
+		estimate.Status = ValidatorStatusEligible
+		estimate.ShardID = shardID
+		estimate.Position = index
+		estimate.HasEstimate = true
+		estimate.EstimatedEligibleEpoch = assignment.Epoch
+		return estimate
+	}
+
+	shardID, index, found = searchInSerializableValidatorsMap(assignment.Waiting, publicKey)
+	if found {
+		estimate.Status = ValidatorStatusWaiting
+		estimate.ShardID = shardID
+		estimate.Position = index
+		estimate.EstimatedEligibleEpoch, estimate.HasEstimate = assignment.estimateFromWaitingIndex(shardID, index)
+		return estimate
+	}
+
+	shardID, index, found = searchInSerializableValidatorsMap(assignment.Leaving, publicKey)
+	if found {
+		estimate.Status = ValidatorStatusLeaving
+		estimate.ShardID = shardID
+		estimate.Position = index
+	}
+
+	return estimate
+}
+
+// EstimateQueuedEligibleEpoch returns the estimate of a validator found at the provided 1 based position of the
+// staking queue. The estimate is a lower bound, as it assumes the queued validators join the waiting lists at the next
+// epoch start, being distributed evenly between the shards and the metachain, after the already waiting validators
+func (assignment *EpochValidatorsAssignment) EstimateQueuedEligibleEpoch(queuePosition uint32) *ValidatorEligibilityEstimate {
+	estimate := &ValidatorEligibilityEstimate{
+		Epoch:    assignment.Epoch,
+		Status:   ValidatorStatusQueued,
+		Position: queuePosition,
+	}
+	if queuePosition == 0 || assignment.NodesToShufflePerShard == 0 {
+		return estimate
+	}
+
+	numLists := assignment.NumShards + 1
+	numWaiting := uint32(0)
+	for _, waitingList := range assignment.Waiting {
+		numWaiting += uint32(len(waitingList))
+	}
+
+	waitingIndex := (numWaiting + queuePosition - 1) / numLists
+	estimate.HasEstimate = true
+	estimate.EstimatedEligibleEpoch = assignment.Epoch + 1 + waitingIndex/assignment.NodesToShufflePerShard + 1
+
+	return estimate
+}
+
+func (assignment *EpochValidatorsAssignment) estimateFromWaitingIndex(shardID uint32, index uint32) (uint32, bool) {
+	nodesToShuffle := assignment.NodesToShufflePerShard
+	if shardID == core.MetachainShardId {
+		nodesToShuffle = assignment.NodesToShuffleInMeta
+	}
+	if nodesToShuffle == 0 {
+		return 0, false
+	}
+
+	return assignment.Epoch + index/nodesToShuffle + 1, true
+}
+
+func searchInSerializableValidatorsMap(
+	validators map[uint32][]*SerializableValidator,
+	publicKey []byte,
+) (uint32, uint32, bool) {
+	for shardID, list := range validators {
+		for index, v := range list {
+			if bytes.Equal(v.PubKey, publicKey) {
+				return shardID, uint32(index), true
+			}
+		}
+	}
+
+	return 0, 0, false
+}
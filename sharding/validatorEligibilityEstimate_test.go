@@ -0,0 +1,94 @@
+package sharding
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func createEligibilityAssignment() *EpochValidatorsAssignment {
+	return &EpochValidatorsAssignment{
+		Epoch:                  10,
+		NumShards:              1,
+		NodesToShufflePerShard: 2,
+		NodesToShuffleInMeta:   1,
+		Eligible: map[uint32][]*SerializableValidator{
+			0:                     {{PubKey: []byte("e0")}, {PubKey: []byte("e1")}},
+			core.MetachainShardId: {{PubKey: []byte("em")}},
+		},
+		Waiting: map[uint32][]*SerializableValidator{
+			0:                     {{PubKey: []byte("w0")}, {PubKey: []byte("w1")}, {PubKey: []byte("w2")}},
+			core.MetachainShardId: {{PubKey: []byte("wm0")}, {PubKey: []byte("wm1")}},
+		},
+		Leaving: map[uint32][]*SerializableValidator{
+			0: {{PubKey: []byte("l0")}},
+		},
+	}
+}
+
+func TestEpochValidatorsAssignment_EstimateEligibleEpoch(t *testing.T) {
+	t.Parallel()
+
+	assignment := createEligibilityAssignment()
+
+	assert.Equal(t, &ValidatorEligibilityEstimate{
+		Epoch:                  10,
+		Status:                 ValidatorStatusEligible,
+		ShardID:                0,
+		Position:               1,
+		HasEstimate:            true,
+		EstimatedEligibleEpoch: 10,
+	}, assignment.EstimateEligibleEpoch([]byte("e1")))
+
+	assert.Equal(t, &ValidatorEligibilityEstimate{
+		Epoch:                  10,
+		Status:                 ValidatorStatusWaiting,
+		ShardID:                0,
+		Position:               1,
+		HasEstimate:            true,
+		EstimatedEligibleEpoch: 11,
+	}, assignment.EstimateEligibleEpoch([]byte("w1")))
+
+	estimate := assignment.EstimateEligibleEpoch([]byte("w2"))
+	assert.Equal(t, uint32(12), estimate.EstimatedEligibleEpoch)
+
+	estimate = assignment.EstimateEligibleEpoch([]byte("wm1"))
+	assert.Equal(t, core.MetachainShardId, estimate.ShardID)
+	assert.Equal(t, uint32(12), estimate.EstimatedEligibleEpoch)
+
+	estimate = assignment.EstimateEligibleEpoch([]byte("l0"))
+	assert.Equal(t, ValidatorStatusLeaving, estimate.Status)
+	assert.False(t, estimate.HasEstimate)
+
+	estimate = assignment.EstimateEligibleEpoch([]byte("unknown"))
+	assert.Equal(t, &ValidatorEligibilityEstimate{Epoch: 10, Status: ValidatorStatusInactive}, estimate)
+
+	assignment.NodesToShufflePerShard = 0
+	estimate = assignment.EstimateEligibleEpoch([]byte("w0"))
+	assert.Equal(t, ValidatorStatusWaiting, estimate.Status)
+	assert.False(t, estimate.HasEstimate)
+}
+
+func TestEpochValidatorsAssignment_EstimateQueuedEligibleEpoch(t *testing.T) {
+	t.Parallel()
+
+	assignment := createEligibilityAssignment()
+
+	// 5 waiting validators and the first queued one are distributed on 2 lists, so it is expected at waiting index 2
+	assert.Equal(t, &ValidatorEligibilityEstimate{
+		Epoch:                  10,
+		Status:                 ValidatorStatusQueued,
+		Position:               1,
+		HasEstimate:            true,
+		EstimatedEligibleEpoch: 13,
+	}, assignment.EstimateQueuedEligibleEpoch(1))
+
+	estimate := assignment.EstimateQueuedEligibleEpoch(4)
+	assert.Equal(t, uint32(14), estimate.EstimatedEligibleEpoch)
+
+	assignment.NodesToShufflePerShard = 0
+	estimate = assignment.EstimateQueuedEligibleEpoch(1)
+	assert.Equal(t, ValidatorStatusQueued, estimate.Status)
+	assert.False(t, estimate.HasEstimate)
+}
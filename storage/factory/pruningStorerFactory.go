@@ -167,21 +167,8 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, shardHdrHashNonceUnit)
 
-	heartbeatDbConfig := GetDBFromConfig(psf.generalConfig.Heartbeat.HeartbeatStorage.DB)
-	shardId := core.GetShardIDString(psf.shardCoordinator.SelfId())
-	dbPath = psf.pathManager.PathForStatic(shardId, psf.generalConfig.Heartbeat.HeartbeatStorage.DB.FilePath)
-	heartbeatDbConfig.FilePath = dbPath
-	heartbeatStorageUnit, err := storageUnit.NewStorageUnitFromConf(
-		GetCacherFromConfig(psf.generalConfig.Heartbeat.HeartbeatStorage.Cache),
-		heartbeatDbConfig,
-		GetBloomFromConfig(psf.generalConfig.Heartbeat.HeartbeatStorage.Bloom))
-	if err != nil {
-		return nil, err
-	}
-	successfullyCreatedStorers = append(successfullyCreatedStorers, heartbeatStorageUnit)
-
 	statusMetricsDbConfig := GetDBFromConfig(psf.generalConfig.StatusMetricsStorage.DB)
-	shardId = core.GetShardIDString(psf.shardCoordinator.SelfId())
+	shardId := core.GetShardIDString(psf.shardCoordinator.SelfId())
 	dbPath = psf.pathManager.PathForStatic(shardId, psf.generalConfig.StatusMetricsStorage.DB.FilePath)
 	statusMetricsDbConfig.FilePath = dbPath
 	statusMetricsStorageUnit, err := storageUnit.NewStorageUnitFromConf(
@@ -225,7 +212,6 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	store.AddStorer(dataRetriever.MetaHdrNonceHashDataUnit, metaHdrHashNonceUnit)
 	hdrNonceHashDataUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(psf.shardCoordinator.SelfId())
 	store.AddStorer(hdrNonceHashDataUnit, shardHdrHashNonceUnit)
-	store.AddStorer(dataRetriever.HeartbeatUnit, heartbeatStorageUnit)
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
 	store.AddStorer(dataRetriever.TxLogsUnit, txLogsUnit)
@@ -309,21 +295,8 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 		successfullyCreatedStorers = append(successfullyCreatedStorers, shardHdrHashNonceUnits[i])
 	}
 
-	shardId := core.GetShardIDString(psf.shardCoordinator.SelfId())
-	heartbeatDbConfig := GetDBFromConfig(psf.generalConfig.Heartbeat.HeartbeatStorage.DB)
-	dbPath = psf.pathManager.PathForStatic(shardId, psf.generalConfig.Heartbeat.HeartbeatStorage.DB.FilePath)
-	heartbeatDbConfig.FilePath = dbPath
-	heartbeatStorageUnit, err := storageUnit.NewStorageUnitFromConf(
-		GetCacherFromConfig(psf.generalConfig.Heartbeat.HeartbeatStorage.Cache),
-		heartbeatDbConfig,
-		GetBloomFromConfig(psf.generalConfig.Heartbeat.HeartbeatStorage.Bloom))
-	if err != nil {
-		return nil, err
-	}
-	successfullyCreatedStorers = append(successfullyCreatedStorers, heartbeatStorageUnit)
-
 	statusMetricsDbConfig := GetDBFromConfig(psf.generalConfig.StatusMetricsStorage.DB)
-	shardId = core.GetShardIDString(psf.shardCoordinator.SelfId())
+	shardId := core.GetShardIDString(psf.shardCoordinator.SelfId())
 	dbPath = psf.pathManager.PathForStatic(shardId, psf.generalConfig.StatusMetricsStorage.DB.FilePath)
 	statusMetricsDbConfig.FilePath = dbPath
 	statusMetricsStorageUnit, err := storageUnit.NewStorageUnitFromConf(
@@ -396,7 +369,6 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 		hdrNonceHashDataUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(i)
 		store.AddStorer(hdrNonceHashDataUnit, shardHdrHashNonceUnits[i])
 	}
-	store.AddStorer(dataRetriever.HeartbeatUnit, heartbeatStorageUnit)
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
 	store.AddStorer(dataRetriever.TxLogsUnit, txLogsUnit)
@@ -467,6 +439,20 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	*createdStorers = append(*createdStorers, epochByHashUnit)
 	chainStorer.AddStorer(dataRetriever.EpochByHashUnit, epochByHashUnit)
 
+	// Create the logsIndex (STATIC) storer
+	logsIndexConfig := psf.generalConfig.DbLookupExtensions.LogsIndexStorageConfig
+	logsIndexDbConfig := GetDBFromConfig(logsIndexConfig.DB)
+	logsIndexDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, logsIndexConfig.DB.FilePath)
+	logsIndexCacherConfig := GetCacherFromConfig(logsIndexConfig.Cache)
+	logsIndexBloomFilter := GetBloomFromConfig(logsIndexConfig.Bloom)
+	logsIndexUnit, err := storageUnit.NewStorageUnitFromConf(logsIndexCacherConfig, logsIndexDbConfig, logsIndexBloomFilter)
+	if err != nil {
+		return err
+	}
+
+	*createdStorers = append(*createdStorers, logsIndexUnit)
+	chainStorer.AddStorer(dataRetriever.LogsIndexUnit, logsIndexUnit)
+
 	return nil
 }
 
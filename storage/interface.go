@@ -29,6 +29,12 @@ type Persister interface {
 	IsInterfaceNil() bool
 }
 
+// Compacter defines a storage component able to compact its underlying data files, reclaiming the space used by the
+// deleted or overwritten entries
+type Compacter interface {
+	Compact() error
+}
+
 // Batcher allows to batch the data first then write the batch to the persister in one go
 type Batcher interface {
 	// Put inserts one entry - key, value pair - into the batch
@@ -7,6 +7,7 @@ import (
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/errors"
 	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 const resourceUnavailable = "resource temporarily unavailable"
@@ -72,6 +73,11 @@ type baseLevelDb struct {
 	db *leveldb.DB
 }
 
+// Compact will compact the whole key range of the underlying database
+func (bldb *baseLevelDb) Compact() error {
+	return bldb.db.CompactRange(util.Range{})
+}
+
 // RangeKeys will call the handler function for each (key, value) pair
 // If the handler returns true, the iteration will continue, otherwise will stop
 func (bldb *baseLevelDb) RangeKeys(handler func(key []byte, value []byte) bool) {
@@ -387,6 +387,30 @@ func (ps *PruningStorer) Close() error {
 	return storage.ErrClosingPersisters
 }
 
+// Compact will compact the active persisters that support compaction
+func (ps *PruningStorer) Compact() error {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	for _, pd := range ps.activePersisters {
+		if pd.getIsClosed() {
+			continue
+		}
+
+		compacter, ok := pd.persister.(storage.Compacter)
+		if !ok {
+			continue
+		}
+
+		err := compacter.Compact()
+		if err != nil {
+			return fmt.Errorf("%w while compacting the persister for epoch %d of %s", err, pd.epoch, ps.identifier)
+		}
+	}
+
+	return nil
+}
+
 // GetFromEpoch will search a key only in the persister for the given epoch
 func (ps *PruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error) {
 	// TODO: this will be used when requesting from resolvers
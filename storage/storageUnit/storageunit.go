@@ -150,6 +150,16 @@ func (u *Unit) Close() error {
 	return nil
 }
 
+// Compact will compact the persistence medium, if it supports compaction
+func (u *Unit) Compact() error {
+	compacter, ok := u.persister.(storage.Compacter)
+	if !ok {
+		return nil
+	}
+
+	return compacter.Compact()
+}
+
 // RangeKeys can iterate over the persisted (key, value) pairs calling the provided handler
 func (u *Unit) RangeKeys(handler func(key []byte, value []byte) bool) {
 	u.persister.RangeKeys(handler)
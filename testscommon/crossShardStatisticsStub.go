@@ -0,0 +1,33 @@
+package testscommon
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// CrossShardStatisticsStub -
+type CrossShardStatisticsStub struct {
+	UpdateCalled          func(metaBlock data.HeaderHandler)
+	PathsStatisticsCalled func() []statistics.CrossShardPathStatistics
+}
+
+// Update -
+func (stub *CrossShardStatisticsStub) Update(metaBlock data.HeaderHandler) {
+	if stub.UpdateCalled != nil {
+		stub.UpdateCalled(metaBlock)
+	}
+}
+
+// PathsStatistics -
+func (stub *CrossShardStatisticsStub) PathsStatistics() []statistics.CrossShardPathStatistics {
+	if stub.PathsStatisticsCalled != nil {
+		return stub.PathsStatisticsCalled()
+	}
+
+	return make([]statistics.CrossShardPathStatistics, 0)
+}
+
+// IsInterfaceNil -
+func (stub *CrossShardStatisticsStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -3,36 +3,46 @@ package economicsmocks
 import (
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
 // EconomicsHandlerStub -
 type EconomicsHandlerStub struct {
-	MaxGasLimitPerBlockCalled                    func() uint64
-	ComputeGasLimitCalled                        func(tx process.TransactionWithFeeHandler) uint64
-	ComputeMoveBalanceFeeCalled                  func(tx process.TransactionWithFeeHandler) *big.Int
-	ComputeTxFeeCalled                           func(tx process.TransactionWithFeeHandler) *big.Int
-	CheckValidityTxValuesCalled                  func(tx process.TransactionWithFeeHandler) error
-	DeveloperPercentageCalled                    func() float64
-	MinGasPriceCalled                            func() uint64
-	GasPriceModifierCalled                       func() float64
-	LeaderPercentageCalled                       func() float64
-	ProtocolSustainabilityPercentageCalled       func() float64
-	ProtocolSustainabilityAddressCalled          func() string
-	MinInflationRateCalled                       func() float64
-	MaxInflationRateCalled                       func(year uint32) float64
-	GasPerDataByteCalled                         func() uint64
-	MinGasLimitCalled                            func() uint64
-	GenesisTotalSupplyCalled                     func() *big.Int
-	ComputeFeeForProcessingCalled                func(tx process.TransactionWithFeeHandler, gasToUse uint64) *big.Int
-	RewardsTopUpGradientPointCalled              func() *big.Int
-	RewardsTopUpFactorCalled                     func() float64
-	SplitTxGasInCategoriesCalled                 func(tx process.TransactionWithFeeHandler) (uint64, uint64)
-	GasPriceForProcessingCalled                  func(tx process.TransactionWithFeeHandler) uint64
-	GasPriceForMoveCalled                        func(tx process.TransactionWithFeeHandler) uint64
-	MinGasPriceProcessingCalled                  func() uint64
-	ComputeGasUsedAndFeeBasedOnRefundValueCalled func(tx process.TransactionWithFeeHandler, refundValue *big.Int) (uint64, *big.Int)
-	ComputeTxFeeBasedOnGasUsedCalled             func(tx process.TransactionWithFeeHandler, gasUsed uint64) *big.Int
+	MaxGasLimitPerBlockCalled                           func() uint64
+	ComputeGasLimitCalled                               func(tx process.TransactionWithFeeHandler) uint64
+	ComputeMoveBalanceFeeCalled                         func(tx process.TransactionWithFeeHandler) *big.Int
+	ComputeTxFeeCalled                                  func(tx process.TransactionWithFeeHandler) *big.Int
+	CheckValidityTxValuesCalled                         func(tx process.TransactionWithFeeHandler) error
+	DeveloperPercentageWithOverrideCalled               func(percentageOverride float64) float64
+	DeveloperPercentageCalled                           func() float64
+	MinGasPriceCalled                                   func() uint64
+	GasPriceModifierCalled                              func() float64
+	LeaderPercentageCalled                              func() float64
+	ProtocolSustainabilityPercentageCalled              func() float64
+	ProtocolSustainabilityAddressCalled                 func() string
+	ProtocolSustainabilityAddressesCalled               func() []config.ProtocolSustainabilityAddressConfig
+	MinInflationRateCalled                              func() float64
+	MaxInflationRateCalled                              func(year uint32) float64
+	MaxInflationRateInEpochCalled                       func(epoch uint32) (float64, bool)
+	TotalSupplyCapInEpochCalled                         func(epoch uint32) *big.Int
+	GasPerDataByteCalled                                func() uint64
+	MinGasLimitCalled                                   func() uint64
+	GenesisTotalSupplyCalled                            func() *big.Int
+	ComputeFeeForProcessingCalled                       func(tx process.TransactionWithFeeHandler, gasToUse uint64) *big.Int
+	ComputeTxFeeInEpochCalled                           func(tx process.TransactionWithFeeHandler, epoch uint32) *big.Int
+	ComputeFeeForProcessingInEpochCalled                func(tx process.TransactionWithFeeHandler, gasToUse uint64, epoch uint32) *big.Int
+	RewardsTopUpGradientPointCalled                     func() *big.Int
+	RewardsTopUpFactorCalled                            func() float64
+	RewardsTopUpGradientPointInEpochCalled              func(epoch uint32) *big.Int
+	RewardsTopUpFactorInEpochCalled                     func(epoch uint32) float64
+	RewardsTopUpCurveSteepnessInEpochCalled             func(epoch uint32) float64
+	SplitTxGasInCategoriesCalled                        func(tx process.TransactionWithFeeHandler) (uint64, uint64)
+	GasPriceForProcessingCalled                         func(tx process.TransactionWithFeeHandler) uint64
+	GasPriceForMoveCalled                               func(tx process.TransactionWithFeeHandler) uint64
+	MinGasPriceProcessingCalled                         func() uint64
+	ComputeGasUsedAndFeeBasedOnRefundValueInEpochCalled func(tx process.TransactionWithFeeHandler, refundValue *big.Int, epoch uint32) (uint64, *big.Int)
+	ComputeTxFeeBasedOnGasUsedInEpochCalled             func(tx process.TransactionWithFeeHandler, gasUsed uint64, epoch uint32) *big.Int
 }
 
 // ComputeFeeForProcessing -
@@ -43,6 +53,22 @@ func (e *EconomicsHandlerStub) ComputeFeeForProcessing(tx process.TransactionWit
 	return big.NewInt(0)
 }
 
+// ComputeFeeForProcessingInEpoch -
+func (e *EconomicsHandlerStub) ComputeFeeForProcessingInEpoch(tx process.TransactionWithFeeHandler, gasToUse uint64, epoch uint32) *big.Int {
+	if e.ComputeFeeForProcessingInEpochCalled != nil {
+		return e.ComputeFeeForProcessingInEpochCalled(tx, gasToUse, epoch)
+	}
+	return e.ComputeFeeForProcessing(tx, gasToUse)
+}
+
+// ComputeTxFeeInEpoch -
+func (e *EconomicsHandlerStub) ComputeTxFeeInEpoch(tx process.TransactionWithFeeHandler, epoch uint32) *big.Int {
+	if e.ComputeTxFeeInEpochCalled != nil {
+		return e.ComputeTxFeeInEpochCalled(tx, epoch)
+	}
+	return e.ComputeTxFee(tx)
+}
+
 // LeaderPercentage -
 func (e *EconomicsHandlerStub) LeaderPercentage() float64 {
 	if e.LeaderPercentageCalled != nil {
@@ -67,6 +93,14 @@ func (e *EconomicsHandlerStub) ProtocolSustainabilityAddress() string {
 	return ""
 }
 
+// ProtocolSustainabilityAddresses -
+func (e *EconomicsHandlerStub) ProtocolSustainabilityAddresses() []config.ProtocolSustainabilityAddressConfig {
+	if e.ProtocolSustainabilityAddressesCalled != nil {
+		return e.ProtocolSustainabilityAddressesCalled()
+	}
+	return make([]config.ProtocolSustainabilityAddressConfig, 0)
+}
+
 // MinInflationRate -
 func (e *EconomicsHandlerStub) MinInflationRate() float64 {
 	if e.MinInflationRateCalled != nil {
@@ -83,6 +117,22 @@ func (e *EconomicsHandlerStub) MaxInflationRate(year uint32) float64 {
 	return 0.0
 }
 
+// MaxInflationRateInEpoch -
+func (e *EconomicsHandlerStub) MaxInflationRateInEpoch(epoch uint32) (float64, bool) {
+	if e.MaxInflationRateInEpochCalled != nil {
+		return e.MaxInflationRateInEpochCalled(epoch)
+	}
+	return 0, false
+}
+
+// TotalSupplyCapInEpoch -
+func (e *EconomicsHandlerStub) TotalSupplyCapInEpoch(epoch uint32) *big.Int {
+	if e.TotalSupplyCapInEpochCalled != nil {
+		return e.TotalSupplyCapInEpochCalled(epoch)
+	}
+	return nil
+}
+
 // GasPerDataByte -
 func (e *EconomicsHandlerStub) GasPerDataByte() uint64 {
 	if e.GasPerDataByteCalled != nil {
@@ -132,6 +182,15 @@ func (e *EconomicsHandlerStub) DeveloperPercentage() float64 {
 	return 0.0
 }
 
+// DeveloperPercentageWithOverride -
+func (e *EconomicsHandlerStub) DeveloperPercentageWithOverride(percentageOverride float64) float64 {
+	if e.DeveloperPercentageWithOverrideCalled != nil {
+		return e.DeveloperPercentageWithOverrideCalled(percentageOverride)
+	}
+
+	return percentageOverride
+}
+
 // MaxGasLimitPerBlock -
 func (e *EconomicsHandlerStub) MaxGasLimitPerBlock(uint32) uint64 {
 	if e.MaxGasLimitPerBlockCalled != nil {
@@ -190,6 +249,33 @@ func (e *EconomicsHandlerStub) RewardsTopUpFactor() float64 {
 	return 0
 }
 
+// RewardsTopUpGradientPointInEpoch -
+func (e *EconomicsHandlerStub) RewardsTopUpGradientPointInEpoch(epoch uint32) *big.Int {
+	if e.RewardsTopUpGradientPointInEpochCalled != nil {
+		return e.RewardsTopUpGradientPointInEpochCalled(epoch)
+	}
+
+	return big.NewInt(0)
+}
+
+// RewardsTopUpFactorInEpoch -
+func (e *EconomicsHandlerStub) RewardsTopUpFactorInEpoch(epoch uint32) float64 {
+	if e.RewardsTopUpFactorInEpochCalled != nil {
+		return e.RewardsTopUpFactorInEpochCalled(epoch)
+	}
+
+	return 0
+}
+
+// RewardsTopUpCurveSteepnessInEpoch -
+func (e *EconomicsHandlerStub) RewardsTopUpCurveSteepnessInEpoch(epoch uint32) float64 {
+	if e.RewardsTopUpCurveSteepnessInEpochCalled != nil {
+		return e.RewardsTopUpCurveSteepnessInEpochCalled(epoch)
+	}
+
+	return 1
+}
+
 // SplitTxGasInCategories -
 func (e *EconomicsHandlerStub) SplitTxGasInCategories(tx process.TransactionWithFeeHandler) (uint64, uint64) {
 	if e.SplitTxGasInCategoriesCalled != nil {
@@ -229,19 +315,19 @@ func (e *EconomicsHandlerStub) MinGasPriceForProcessing() uint64 {
 	return 1
 }
 
-// ComputeGasUsedAndFeeBasedOnRefundValue -
-func (e *EconomicsHandlerStub) ComputeGasUsedAndFeeBasedOnRefundValue(tx process.TransactionWithFeeHandler, refundValue *big.Int) (uint64, *big.Int) {
-	if e.ComputeGasUsedAndFeeBasedOnRefundValueCalled != nil {
-		return e.ComputeGasUsedAndFeeBasedOnRefundValueCalled(tx, refundValue)
+// ComputeGasUsedAndFeeBasedOnRefundValueInEpoch -
+func (e *EconomicsHandlerStub) ComputeGasUsedAndFeeBasedOnRefundValueInEpoch(tx process.TransactionWithFeeHandler, refundValue *big.Int, epoch uint32) (uint64, *big.Int) {
+	if e.ComputeGasUsedAndFeeBasedOnRefundValueInEpochCalled != nil {
+		return e.ComputeGasUsedAndFeeBasedOnRefundValueInEpochCalled(tx, refundValue, epoch)
 	}
 
 	return 0, nil
 }
 
-// ComputeTxFeeBasedOnGasUsed -
-func (e *EconomicsHandlerStub) ComputeTxFeeBasedOnGasUsed(tx process.TransactionWithFeeHandler, gasUsed uint64) *big.Int {
-	if e.ComputeTxFeeBasedOnGasUsedCalled != nil {
-		return e.ComputeTxFeeBasedOnGasUsedCalled(tx, gasUsed)
+// ComputeTxFeeBasedOnGasUsedInEpoch -
+func (e *EconomicsHandlerStub) ComputeTxFeeBasedOnGasUsedInEpoch(tx process.TransactionWithFeeHandler, gasUsed uint64, epoch uint32) *big.Int {
+	if e.ComputeTxFeeBasedOnGasUsedInEpochCalled != nil {
+		return e.ComputeTxFeeBasedOnGasUsedInEpochCalled(tx, gasUsed, epoch)
 	}
 
 	return nil
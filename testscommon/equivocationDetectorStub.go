@@ -0,0 +1,30 @@
+package testscommon
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// EquivocationDetectorStub -
+type EquivocationDetectorStub struct {
+	ProcessProposalCalled  func(cnsMsg *consensus.Message)
+	ProcessSignatureCalled func(cnsMsg *consensus.Message)
+}
+
+// ProcessProposal -
+func (stub *EquivocationDetectorStub) ProcessProposal(cnsMsg *consensus.Message) {
+	if stub.ProcessProposalCalled != nil {
+		stub.ProcessProposalCalled(cnsMsg)
+	}
+}
+
+// ProcessSignature -
+func (stub *EquivocationDetectorStub) ProcessSignature(cnsMsg *consensus.Message) {
+	if stub.ProcessSignatureCalled != nil {
+		stub.ProcessSignatureCalled(cnsMsg)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *EquivocationDetectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
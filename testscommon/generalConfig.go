@@ -181,16 +181,9 @@ func GetGeneralConfig() config.Config {
 			},
 		},
 		Heartbeat: config.HeartbeatConfig{
-			HeartbeatStorage: config.StorageConfig{
-				Cache: getLRUCacheConfig(),
-				DB: config.DBConfig{
-					FilePath:          AddTimestampSuffix("HeartbeatStorage"),
-					Type:              string(storageUnit.MemoryDB),
-					BatchDelaySeconds: 30,
-					MaxBatchSize:      6,
-					MaxOpenFiles:      10,
-				},
-			},
+			PeerAuthenticationCache: getLRUCacheConfig(),
+			VerifiedPayloadsCache:   getLRUCacheConfig(),
+			HeartbeatCache:          getLRUCacheConfig(),
 		},
 		StatusMetricsStorage: config.StorageConfig{
 			Cache: getLRUCacheConfig(),
@@ -14,6 +14,8 @@ type HistoryRepositoryStub struct {
 	GetMiniblockMetadataByTxHashCalled func(hash []byte) (*dblookupext.MiniblockMetadata, error)
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
+	GetLogsLocationsByAddressCalled    func(address []byte, fromNonce uint64, toNonce uint64) ([]*dblookupext.LogsLocation, error)
+	GetLogsLocationsByIdentifierCalled func(identifier []byte, fromNonce uint64, toNonce uint64) ([]*dblookupext.LogsLocation, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -67,6 +69,22 @@ func (hp *HistoryRepositoryStub) GetResultsHashesByTxHash(hash []byte, epoch uin
 	return nil, nil
 }
 
+// GetLogsLocationsByAddress -
+func (hp *HistoryRepositoryStub) GetLogsLocationsByAddress(address []byte, fromNonce uint64, toNonce uint64) ([]*dblookupext.LogsLocation, error) {
+	if hp.GetLogsLocationsByAddressCalled != nil {
+		return hp.GetLogsLocationsByAddressCalled(address, fromNonce, toNonce)
+	}
+	return nil, nil
+}
+
+// GetLogsLocationsByIdentifier -
+func (hp *HistoryRepositoryStub) GetLogsLocationsByIdentifier(identifier []byte, fromNonce uint64, toNonce uint64) ([]*dblookupext.LogsLocation, error) {
+	if hp.GetLogsLocationsByIdentifierCalled != nil {
+		return hp.GetLogsLocationsByIdentifierCalled(identifier, fromNonce, toNonce)
+	}
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
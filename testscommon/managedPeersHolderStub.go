@@ -0,0 +1,88 @@
+package testscommon
+
+import (
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+// ManagedPeersHolderStub -
+type ManagedPeersHolderStub struct {
+	AddManagedPeerCalled              func(privateKeyBytes []byte) error
+	IsKeyManagedByCurrentNodeCalled   func(pkBytes []byte) bool
+	GetPrivateKeyCalled               func(pkBytes []byte) (crypto.PrivateKey, error)
+	GetManagedKeysByCurrentNodeCalled func() map[string]crypto.PrivateKey
+	CreateSignatureShareCalled        func(pkBytes []byte, message []byte) ([]byte, error)
+	IncrementRoundsInConsensusCalled  func(pkBytes []byte)
+	IncrementRoundsAsLeaderCalled     func(pkBytes []byte)
+	IncrementSignaturesSentCalled     func(pkBytes []byte)
+}
+
+// AddManagedPeer -
+func (stub *ManagedPeersHolderStub) AddManagedPeer(privateKeyBytes []byte) error {
+	if stub.AddManagedPeerCalled != nil {
+		return stub.AddManagedPeerCalled(privateKeyBytes)
+	}
+
+	return nil
+}
+
+// IsKeyManagedByCurrentNode -
+func (stub *ManagedPeersHolderStub) IsKeyManagedByCurrentNode(pkBytes []byte) bool {
+	if stub.IsKeyManagedByCurrentNodeCalled != nil {
+		return stub.IsKeyManagedByCurrentNodeCalled(pkBytes)
+	}
+
+	return false
+}
+
+// GetPrivateKey -
+func (stub *ManagedPeersHolderStub) GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
+	if stub.GetPrivateKeyCalled != nil {
+		return stub.GetPrivateKeyCalled(pkBytes)
+	}
+
+	return nil, nil
+}
+
+// GetManagedKeysByCurrentNode -
+func (stub *ManagedPeersHolderStub) GetManagedKeysByCurrentNode() map[string]crypto.PrivateKey {
+	if stub.GetManagedKeysByCurrentNodeCalled != nil {
+		return stub.GetManagedKeysByCurrentNodeCalled()
+	}
+
+	return make(map[string]crypto.PrivateKey)
+}
+
+// CreateSignatureShare -
+func (stub *ManagedPeersHolderStub) CreateSignatureShare(pkBytes []byte, message []byte) ([]byte, error) {
+	if stub.CreateSignatureShareCalled != nil {
+		return stub.CreateSignatureShareCalled(pkBytes, message)
+	}
+
+	return nil, nil
+}
+
+// IncrementRoundsInConsensus -
+func (stub *ManagedPeersHolderStub) IncrementRoundsInConsensus(pkBytes []byte) {
+	if stub.IncrementRoundsInConsensusCalled != nil {
+		stub.IncrementRoundsInConsensusCalled(pkBytes)
+	}
+}
+
+// IncrementRoundsAsLeader -
+func (stub *ManagedPeersHolderStub) IncrementRoundsAsLeader(pkBytes []byte) {
+	if stub.IncrementRoundsAsLeaderCalled != nil {
+		stub.IncrementRoundsAsLeaderCalled(pkBytes)
+	}
+}
+
+// IncrementSignaturesSent -
+func (stub *ManagedPeersHolderStub) IncrementSignaturesSent(pkBytes []byte) {
+	if stub.IncrementSignaturesSentCalled != nil {
+		stub.IncrementSignaturesSentCalled(pkBytes)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *ManagedPeersHolderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
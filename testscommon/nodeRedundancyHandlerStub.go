@@ -0,0 +1,78 @@
+package testscommon
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// NodeRedundancyHandlerStub -
+type NodeRedundancyHandlerStub struct {
+	IsRedundancyNodeCalled         func() bool
+	IsMainMachineActiveCalled      func(pubKey string) bool
+	IsFencedCalled                 func(pubKey string) bool
+	IsObservingCalled              func(pubKey string) bool
+	ShouldActInConsensusCalled     func(pubKey string) bool
+	AdjustInactivityIfNeededCalled func(selfPubKey string, consensusPubKeys []string, roundIndex int64)
+	ResetInactivityIfNeededCalled  func(selfPubKey string, consensusMsgPubKey string, consensusMsgPeerID core.PeerID)
+}
+
+// IsRedundancyNode -
+func (stub *NodeRedundancyHandlerStub) IsRedundancyNode() bool {
+	if stub.IsRedundancyNodeCalled != nil {
+		return stub.IsRedundancyNodeCalled()
+	}
+
+	return false
+}
+
+// IsMainMachineActive -
+func (stub *NodeRedundancyHandlerStub) IsMainMachineActive(pubKey string) bool {
+	if stub.IsMainMachineActiveCalled != nil {
+		return stub.IsMainMachineActiveCalled(pubKey)
+	}
+
+	return false
+}
+
+// IsFenced -
+func (stub *NodeRedundancyHandlerStub) IsFenced(pubKey string) bool {
+	if stub.IsFencedCalled != nil {
+		return stub.IsFencedCalled(pubKey)
+	}
+
+	return false
+}
+
+// IsObserving -
+func (stub *NodeRedundancyHandlerStub) IsObserving(pubKey string) bool {
+	if stub.IsObservingCalled != nil {
+		return stub.IsObservingCalled(pubKey)
+	}
+
+	return false
+}
+
+// ShouldActInConsensus -
+func (stub *NodeRedundancyHandlerStub) ShouldActInConsensus(pubKey string) bool {
+	if stub.ShouldActInConsensusCalled != nil {
+		return stub.ShouldActInConsensusCalled(pubKey)
+	}
+
+	return true
+}
+
+// AdjustInactivityIfNeeded -
+func (stub *NodeRedundancyHandlerStub) AdjustInactivityIfNeeded(selfPubKey string, consensusPubKeys []string, roundIndex int64) {
+	if stub.AdjustInactivityIfNeededCalled != nil {
+		stub.AdjustInactivityIfNeededCalled(selfPubKey, consensusPubKeys, roundIndex)
+	}
+}
+
+// ResetInactivityIfNeeded -
+func (stub *NodeRedundancyHandlerStub) ResetInactivityIfNeeded(selfPubKey string, consensusMsgPubKey string, consensusMsgPeerID core.PeerID) {
+	if stub.ResetInactivityIfNeededCalled != nil {
+		stub.ResetInactivityIfNeededCalled(selfPubKey, consensusMsgPubKey, consensusMsgPeerID)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *NodeRedundancyHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,58 @@
+package testscommon
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// ParticipationTrackerStub -
+type ParticipationTrackerStub struct {
+	StartRoundCalled            func(epoch uint32, round int64, isLeader bool, isInConsensusGroup bool)
+	AddProposedBlockCalled      func(round int64)
+	AddSignatureShareSentCalled func(round int64)
+	AddSubroundDurationCalled   func(subround string, duration time.Duration)
+	GetParticipationCalled      func() []*consensus.EpochParticipation
+}
+
+// StartRound -
+func (stub *ParticipationTrackerStub) StartRound(epoch uint32, round int64, isLeader bool, isInConsensusGroup bool) {
+	if stub.StartRoundCalled != nil {
+		stub.StartRoundCalled(epoch, round, isLeader, isInConsensusGroup)
+	}
+}
+
+// AddProposedBlock -
+func (stub *ParticipationTrackerStub) AddProposedBlock(round int64) {
+	if stub.AddProposedBlockCalled != nil {
+		stub.AddProposedBlockCalled(round)
+	}
+}
+
+// AddSignatureShareSent -
+func (stub *ParticipationTrackerStub) AddSignatureShareSent(round int64) {
+	if stub.AddSignatureShareSentCalled != nil {
+		stub.AddSignatureShareSentCalled(round)
+	}
+}
+
+// AddSubroundDuration -
+func (stub *ParticipationTrackerStub) AddSubroundDuration(subround string, duration time.Duration) {
+	if stub.AddSubroundDurationCalled != nil {
+		stub.AddSubroundDurationCalled(subround, duration)
+	}
+}
+
+// GetParticipation -
+func (stub *ParticipationTrackerStub) GetParticipation() []*consensus.EpochParticipation {
+	if stub.GetParticipationCalled != nil {
+		return stub.GetParticipationCalled()
+	}
+
+	return make([]*consensus.EpochParticipation, 0)
+}
+
+// IsInterfaceNil -
+func (stub *ParticipationTrackerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,20 @@
+package testscommon
+
+// PartitionStatusHandlerStub -
+type PartitionStatusHandlerStub struct {
+	IsInProtectiveModeCalled func() bool
+}
+
+// IsInProtectiveMode -
+func (stub *PartitionStatusHandlerStub) IsInProtectiveMode() bool {
+	if stub.IsInProtectiveModeCalled != nil {
+		return stub.IsInProtectiveModeCalled()
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (stub *PartitionStatusHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
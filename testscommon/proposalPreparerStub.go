@@ -0,0 +1,18 @@
+package testscommon
+
+// ProposalPreparerStub -
+type ProposalPreparerStub struct {
+	PrepareNextProposalCalled func()
+}
+
+// PrepareNextProposal -
+func (stub *ProposalPreparerStub) PrepareNextProposal() {
+	if stub.PrepareNextProposalCalled != nil {
+		stub.PrepareNextProposalCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (stub *ProposalPreparerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,30 @@
+package testscommon
+
+import "github.com/ElrondNetwork/elrond-go/crypto"
+
+// SigningSlotHandlerStub -
+type SigningSlotHandlerStub struct {
+	SetSigningSlotCalled func(epoch uint32, round uint64)
+	SigningSlotCalled    func() *crypto.SigningSlot
+}
+
+// SetSigningSlot -
+func (stub *SigningSlotHandlerStub) SetSigningSlot(epoch uint32, round uint64) {
+	if stub.SetSigningSlotCalled != nil {
+		stub.SetSigningSlotCalled(epoch, round)
+	}
+}
+
+// SigningSlot -
+func (stub *SigningSlotHandlerStub) SigningSlot() *crypto.SigningSlot {
+	if stub.SigningSlotCalled != nil {
+		return stub.SigningSlotCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *SigningSlotHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,58 @@
+package testscommon
+
+// StateDebuggerStub -
+type StateDebuggerStub struct {
+	StartRoundCalled            func(round int64, consensusGroup []string, leader string)
+	SetSubroundCalled           func(round int64, subround string)
+	AddReceivedMessageCalled    func(round int64, pubKey []byte, messageType string)
+	AddSubroundTimeoutCalled    func(round int64, subround string)
+	AddCollectedSignatureCalled func(round int64, pubKey string)
+	SetBlockCommittedCalled     func(round int64)
+}
+
+// StartRound -
+func (stub *StateDebuggerStub) StartRound(round int64, consensusGroup []string, leader string) {
+	if stub.StartRoundCalled != nil {
+		stub.StartRoundCalled(round, consensusGroup, leader)
+	}
+}
+
+// SetSubround -
+func (stub *StateDebuggerStub) SetSubround(round int64, subround string) {
+	if stub.SetSubroundCalled != nil {
+		stub.SetSubroundCalled(round, subround)
+	}
+}
+
+// AddReceivedMessage -
+func (stub *StateDebuggerStub) AddReceivedMessage(round int64, pubKey []byte, messageType string) {
+	if stub.AddReceivedMessageCalled != nil {
+		stub.AddReceivedMessageCalled(round, pubKey, messageType)
+	}
+}
+
+// AddSubroundTimeout -
+func (stub *StateDebuggerStub) AddSubroundTimeout(round int64, subround string) {
+	if stub.AddSubroundTimeoutCalled != nil {
+		stub.AddSubroundTimeoutCalled(round, subround)
+	}
+}
+
+// AddCollectedSignature -
+func (stub *StateDebuggerStub) AddCollectedSignature(round int64, pubKey string) {
+	if stub.AddCollectedSignatureCalled != nil {
+		stub.AddCollectedSignatureCalled(round, pubKey)
+	}
+}
+
+// SetBlockCommitted -
+func (stub *StateDebuggerStub) SetBlockCommitted(round int64) {
+	if stub.SetBlockCommittedCalled != nil {
+		stub.SetBlockCommittedCalled(round)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *StateDebuggerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
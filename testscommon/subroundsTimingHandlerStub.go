@@ -0,0 +1,45 @@
+package testscommon
+
+import "time"
+
+// SubroundsTimingHandlerStub -
+type SubroundsTimingHandlerStub struct {
+	ComputeProposalDeadlineCalled func(round int64, roundDuration time.Duration, defaultDeadline time.Duration, signaturesDeadline time.Duration) time.Duration
+	AddBlockObservationCalled     func(round int64, blockSize int, receivedAfter time.Duration, processingTime time.Duration)
+	EstimatedLatencyCalled        func() time.Duration
+}
+
+// ComputeProposalDeadline -
+func (stub *SubroundsTimingHandlerStub) ComputeProposalDeadline(
+	round int64,
+	roundDuration time.Duration,
+	defaultDeadline time.Duration,
+	signaturesDeadline time.Duration,
+) time.Duration {
+	if stub.ComputeProposalDeadlineCalled != nil {
+		return stub.ComputeProposalDeadlineCalled(round, roundDuration, defaultDeadline, signaturesDeadline)
+	}
+
+	return defaultDeadline
+}
+
+// AddBlockObservation -
+func (stub *SubroundsTimingHandlerStub) AddBlockObservation(round int64, blockSize int, receivedAfter time.Duration, processingTime time.Duration) {
+	if stub.AddBlockObservationCalled != nil {
+		stub.AddBlockObservationCalled(round, blockSize, receivedAfter, processingTime)
+	}
+}
+
+// EstimatedLatency -
+func (stub *SubroundsTimingHandlerStub) EstimatedLatency() time.Duration {
+	if stub.EstimatedLatencyCalled != nil {
+		return stub.EstimatedLatencyCalled()
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (stub *SubroundsTimingHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -268,3 +268,6 @@ var ErrNilHeaderHandler = errors.New("nil header handler")
 
 // ErrInvalidMiniBlockType signals that an invalid miniBlock type has been provided
 var ErrInvalidMiniBlockType = errors.New("invalid miniBlock type")
+
+// ErrInvalidGenesisNodePrice signals that an invalid genesis node price was provided
+var ErrInvalidGenesisNodePrice = errors.New("invalid genesis node price")
@@ -3,6 +3,7 @@ package factory
 import (
 	"fmt"
 	"math"
+	"math/big"
 	"os"
 	"path"
 	"time"
@@ -80,6 +81,8 @@ type ArgsExporter struct {
 	EnableSignTxWithHashEpoch uint32
 	TxSignHasher              hashing.Hasher
 	EpochNotifier             process.EpochNotifier
+	ExportGenesisFiles        bool
+	GenesisNodePrice          *big.Int
 }
 
 type exportHandlerFactory struct {
@@ -127,6 +130,8 @@ type exportHandlerFactory struct {
 	enableSignTxWithHashEpoch uint32
 	txSignHasher              hashing.Hasher
 	epochNotifier             process.EpochNotifier
+	exportGenesisFiles        bool
+	genesisNodePrice          *big.Int
 }
 
 // NewExportHandlerFactory creates an exporter factory
@@ -271,6 +276,8 @@ func NewExportHandlerFactory(args ArgsExporter) (*exportHandlerFactory, error) {
 		enableSignTxWithHashEpoch: args.EnableSignTxWithHashEpoch,
 		txSignHasher:              args.TxSignHasher,
 		epochNotifier:             args.EpochNotifier,
+		exportGenesisFiles:        args.ExportGenesisFiles,
+		genesisNodePrice:          args.GenesisNodePrice,
 	}
 
 	return e, nil
@@ -467,6 +474,8 @@ func (e *exportHandlerFactory) Create() (update.ExportHandler, error) {
 		ValidatorPubKeyConverter: e.validatorPubKeyConverter,
 		AddressPubKeyConverter:   e.addressPubKeyConverter,
 		GenesisNodesSetupHandler: e.genesisNodesSetupHandler,
+		ExportGenesisFiles:       e.exportGenesisFiles,
+		GenesisNodePrice:         e.genesisNodePrice,
 	}
 	exportHandler, err := genesis.NewStateExporter(argsExporter)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -34,6 +35,8 @@ type ArgsNewStateExporter struct {
 	AddressPubKeyConverter   core.PubkeyConverter
 	ValidatorPubKeyConverter core.PubkeyConverter
 	GenesisNodesSetupHandler update.GenesisNodesSetupHandler
+	ExportGenesisFiles       bool
+	GenesisNodePrice         *big.Int
 }
 
 type stateExport struct {
@@ -46,8 +49,12 @@ type stateExport struct {
 	addressPubKeyConverter   core.PubkeyConverter
 	validatorPubKeyConverter core.PubkeyConverter
 	genesisNodesSetupHandler update.GenesisNodesSetupHandler
+	genesisNodePrice         *big.Int
+	genesisFiles             *genesisFilesCollector
 }
 
+var exportedValidatorsLists = []core.PeerType{core.EligibleList, core.WaitingList, core.JailedList}
+
 var log = logger.GetOrCreate("update/genesis")
 
 // NewStateExporter exports all the data at a specific moment to a hardfork storer
@@ -79,6 +86,9 @@ func NewStateExporter(args ArgsNewStateExporter) (*stateExport, error) {
 	if check.IfNil(args.GenesisNodesSetupHandler) {
 		return nil, update.ErrNilGenesisNodesSetupHandler
 	}
+	if args.ExportGenesisFiles && (args.GenesisNodePrice == nil || args.GenesisNodePrice.Sign() <= 0) {
+		return nil, update.ErrInvalidGenesisNodePrice
+	}
 
 	se := &stateExport{
 		stateSyncer:              args.StateSyncer,
@@ -90,6 +100,10 @@ func NewStateExporter(args ArgsNewStateExporter) (*stateExport, error) {
 		addressPubKeyConverter:   args.AddressPubKeyConverter,
 		validatorPubKeyConverter: args.ValidatorPubKeyConverter,
 		genesisNodesSetupHandler: args.GenesisNodesSetupHandler,
+		genesisNodePrice:         args.GenesisNodePrice,
+	}
+	if args.ExportGenesisFiles {
+		se.genesisFiles = newGenesisFilesCollector(args.Marshalizer)
 	}
 
 	return se, nil
@@ -122,6 +136,13 @@ func (se *stateExport) ExportAll(epoch uint32) error {
 		return err
 	}
 
+	if se.genesisFiles != nil {
+		err = se.exportGenesisFiles()
+		if err != nil {
+			return err
+		}
+	}
+
 	err = se.exportAllMiniBlocks()
 	if err != nil {
 		return err
@@ -281,6 +302,10 @@ func (se *stateExport) exportTrie(key string, trie data.Trie) error {
 			return err
 		}
 
+		if se.genesisFiles != nil {
+			se.genesisFiles.addValidators(validatorData)
+		}
+
 		nodesSetupFilePath := filepath.Join(se.exportFolder, core.NodesSetupJsonFileName)
 		err = se.exportNodesSetupJson(validatorData)
 		if err == nil {
@@ -349,6 +374,10 @@ func (se *stateExport) exportAccountLeaves(
 		if err != nil {
 			return err
 		}
+
+		if se.genesisFiles != nil && accType == UserAccount {
+			se.genesisFiles.addUserTrieLeaf(shId, leaf.Key(), leaf.Value())
+		}
 	}
 
 	err := se.hardforkStorer.FinishedIdentifier(identifier)
@@ -392,12 +421,11 @@ func (se *stateExport) exportTx(key string, tx data.TransactionHandler) error {
 }
 
 func (se *stateExport) exportNodesSetupJson(validators map[uint32][]*state.ValidatorInfo) error {
-	acceptedListsForExport := []core.PeerType{core.EligibleList, core.WaitingList, core.JailedList}
 	initialNodes := make([]*sharding.InitialNode, 0)
 
 	for _, validatorsInShard := range validators {
 		for _, validator := range validatorsInShard {
-			if shouldExportValidator(validator, acceptedListsForExport) {
+			if shouldExportValidator(validator, exportedValidatorsLists) {
 				initialNodes = append(initialNodes, &sharding.InitialNode{
 					PubKey:        se.validatorPubKeyConverter.Encode(validator.GetPublicKey()),
 					Address:       se.addressPubKeyConverter.Encode(validator.GetRewardAddress()),
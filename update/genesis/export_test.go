@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	genesisData "github.com/ElrondNetwork/elrond-go/genesis/data"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/update"
 	"github.com/ElrondNetwork/elrond-go/update/mock"
@@ -168,6 +169,22 @@ func TestNewStateExporter(t *testing.T) {
 			},
 			exError: update.ErrEmptyExportFolderPath,
 		},
+		{
+			name: "InvalidGenesisNodePrice",
+			args: ArgsNewStateExporter{
+				Marshalizer:              &mock.MarshalizerMock{},
+				ShardCoordinator:         mock.NewOneShardCoordinatorMock(),
+				StateSyncer:              &mock.SyncStateStub{},
+				HardforkStorer:           &mock.HardforkStorerStub{},
+				Hasher:                   &mock.HasherStub{},
+				AddressPubKeyConverter:   &mock.PubkeyConverterStub{},
+				ValidatorPubKeyConverter: &mock.PubkeyConverterStub{},
+				ExportFolder:             "test",
+				GenesisNodesSetupHandler: &mock.GenesisNodesSetupHandlerStub{},
+				ExportGenesisFiles:       true,
+			},
+			exError: update.ErrInvalidGenesisNodePrice,
+		},
 		{
 			name: "Ok",
 			args: ArgsNewStateExporter{
@@ -456,3 +473,93 @@ func TestStateExport_ExportUnfinishedMetaBlocksShouldWork(t *testing.T) {
 
 	assert.True(t, unFinishedMetablocksWereWrote)
 }
+
+func TestStateExport_ExportGenesisFilesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testFolderName := "testFilesExportGenesis"
+	_ = os.Mkdir(testFolderName, 0777)
+
+	defer func() {
+		_ = os.RemoveAll(testFolderName)
+	}()
+
+	pubKeyConv := &mock.PubkeyConverterStub{
+		EncodeCalled: func(pkBytes []byte) string {
+			return string(pkBytes)
+		},
+	}
+
+	args := ArgsNewStateExporter{
+		ShardCoordinator:         mock.NewOneShardCoordinatorMock(),
+		Marshalizer:              &mock.MarshalizerMock{},
+		StateSyncer:              &mock.SyncStateStub{},
+		HardforkStorer:           &mock.HardforkStorerStub{},
+		Hasher:                   &mock.HasherMock{},
+		ExportFolder:             testFolderName,
+		AddressPubKeyConverter:   pubKeyConv,
+		ValidatorPubKeyConverter: pubKeyConv,
+		GenesisNodesSetupHandler: &mock.GenesisNodesSetupHandlerStub{},
+		ExportGenesisFiles:       true,
+		GenesisNodePrice:         big.NewInt(100),
+	}
+	stateExporter, err := NewStateExporter(args)
+	require.NoError(t, err)
+
+	mm := &mock.MarshalizerMock{}
+	addLeaf := func(shardID uint32, address []byte, balance int64, owner []byte, codeHash []byte) {
+		account, _ := state.NewUserAccount(address)
+		_ = account.AddToBalance(big.NewInt(balance))
+		account.SetOwnerAddress(owner)
+		account.SetCodeHash(codeHash)
+		buff, _ := mm.Marshal(account)
+		stateExporter.genesisFiles.addUserTrieLeaf(shardID, address, buff)
+	}
+
+	scAddress := []byte("\x00\x00\x00\x00\x00\x00\x00\x00\x05\x00contract address 0123456789")
+	addLeaf(0, []byte("account a"), 10, nil, nil)
+	addLeaf(0, []byte("account b"), 20, nil, nil)
+	addLeaf(core.MetachainShardId, []byte("system account"), 30, nil, nil)
+	addLeaf(0, scAddress, 40, []byte("account a"), []byte("code hash"))
+	stateExporter.genesisFiles.codes["code hash"] = []byte("code")
+	stateExporter.genesisFiles.addValidators(map[uint32][]*state.ValidatorInfo{
+		0: {
+			{RewardAddress: []byte("account b"), List: string(core.EligibleList)},
+			{RewardAddress: []byte("account c"), List: string(core.WaitingList)},
+			{RewardAddress: []byte("account c"), List: string(core.EligibleList)},
+			{RewardAddress: []byte("account d"), List: string(core.LeavingList)},
+		},
+	})
+
+	err = stateExporter.exportGenesisFiles()
+	require.NoError(t, err)
+
+	accountsBytes, err := ioutil.ReadFile(filepath.Join(testFolderName, GenesisAccountsFileName))
+	require.NoError(t, err)
+	accounts := make([]*genesisData.InitialAccount, 0)
+	err = json.Unmarshal(accountsBytes, &accounts)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(accounts))
+	assert.Equal(t, "account a", accounts[0].Address)
+	assert.Equal(t, big.NewInt(10), accounts[0].Supply)
+	assert.Equal(t, "account b", accounts[1].Address)
+	assert.Equal(t, big.NewInt(100), accounts[1].StakingValue)
+	assert.Equal(t, big.NewInt(120), accounts[1].Supply)
+	assert.Equal(t, "account c", accounts[2].Address)
+	assert.Equal(t, big.NewInt(0), accounts[2].Balance)
+	assert.Equal(t, big.NewInt(200), accounts[2].Supply)
+
+	contractsBytes, err := ioutil.ReadFile(filepath.Join(testFolderName, GenesisSmartContractsFileName))
+	require.NoError(t, err)
+	contracts := make([]*genesisData.InitialSmartContract, 0)
+	err = json.Unmarshal(contractsBytes, &contracts)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(contracts))
+	assert.Equal(t, "account a", contracts[0].Owner)
+	assert.Equal(t, "0500", contracts[0].VmType)
+	assert.Equal(t, "0", contracts[0].Shard)
+
+	code, err := ioutil.ReadFile(filepath.Join(testFolderName, contracts[0].Filename))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("code"), code)
+}
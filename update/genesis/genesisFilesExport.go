@@ -0,0 +1,226 @@
+package genesis
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	genesisData "github.com/ElrondNetwork/elrond-go/genesis/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+const (
+	// GenesisAccountsFileName is the name of the exported genesis accounts file
+	GenesisAccountsFileName = "genesis.json"
+	// GenesisSmartContractsFileName is the name of the exported genesis smart contracts file
+	GenesisSmartContractsFileName = "genesisSmartContracts.json"
+	// GenesisContractsCodeFolder is the folder, relative to the export folder, holding the exported contracts code
+	GenesisContractsCodeFolder = "genesisContracts"
+
+	contractCodeFileExtension = ".wasm"
+)
+
+// exportedContract holds the data of a user deployed smart contract found in the exported state
+type exportedContract struct {
+	address  []byte
+	owner    []byte
+	codeHash []byte
+	shardID  uint32
+}
+
+// genesisFilesCollector gathers, while the tries are exported, the balances, the staked nodes and the deployed
+// contracts needed to write the genesis files of a new chain seeded with the exported state
+type genesisFilesCollector struct {
+	marshalizer marshal.Marshalizer
+	balances    map[string]*big.Int
+	stakedNodes map[string]int
+	contracts   []*exportedContract
+	codes       map[string][]byte
+}
+
+func newGenesisFilesCollector(marshalizer marshal.Marshalizer) *genesisFilesCollector {
+	return &genesisFilesCollector{
+		marshalizer: marshalizer,
+		balances:    make(map[string]*big.Int),
+		stakedNodes: make(map[string]int),
+		contracts:   make([]*exportedContract, 0),
+		codes:       make(map[string][]byte),
+	}
+}
+
+// addUserTrieLeaf records a leaf of a shard accounts trie, which holds either an account or a contract code entry.
+// The metachain accounts are system accounts and are recreated by the genesis process
+func (gfc *genesisFilesCollector) addUserTrieLeaf(shardID uint32, key []byte, value []byte) {
+	if shardID == core.MetachainShardId {
+		return
+	}
+
+	account := state.NewEmptyUserAccount()
+	err := gfc.marshalizer.Unmarshal(account, value)
+	if err != nil {
+		codeEntry := &state.CodeEntry{}
+		err = gfc.marshalizer.Unmarshal(codeEntry, value)
+		if err != nil {
+			log.Debug("genesis files export: unknown accounts trie leaf", "key", key, "error", err)
+			return
+		}
+
+		gfc.codes[string(key)] = codeEntry.Code
+		return
+	}
+
+	if core.IsSmartContractAddress(key) {
+		gfc.contracts = append(gfc.contracts, &exportedContract{
+			address:  key,
+			owner:    account.GetOwnerAddress(),
+			codeHash: account.GetCodeHash(),
+			shardID:  shardID,
+		})
+		return
+	}
+
+	if account.GetBalance().Sign() > 0 {
+		gfc.balances[string(key)] = big.NewInt(0).Set(account.GetBalance())
+	}
+}
+
+// addValidators records the nodes exported in the nodes setup file, each one staked by its reward address
+func (gfc *genesisFilesCollector) addValidators(validators map[uint32][]*state.ValidatorInfo) {
+	for _, validatorsInShard := range validators {
+		for _, validator := range validatorsInShard {
+			if shouldExportValidator(validator, exportedValidatorsLists) {
+				gfc.stakedNodes[string(validator.GetRewardAddress())]++
+			}
+		}
+	}
+}
+
+// exportGenesisFiles writes the genesis accounts file, the genesis smart contracts file and the contracts code. The
+// staking value of each account is the node price for each node it stakes in the exported nodes setup. The nodes
+// staked by contracts, as the delegation contracts, can not be staked at genesis and are only reported. The contracts
+// are redeployed, in their former shards, from their current code, so their storage and addresses are not kept
+func (se *stateExport) exportGenesisFiles() error {
+	accounts, totalSupply := se.createGenesisAccounts()
+	err := writeJsonFile(filepath.Join(se.exportFolder, GenesisAccountsFileName), accounts)
+	if err != nil {
+		return err
+	}
+
+	contracts, err := se.writeGenesisContractsCode()
+	if err != nil {
+		return err
+	}
+	err = writeJsonFile(filepath.Join(se.exportFolder, GenesisSmartContractsFileName), contracts)
+	if err != nil {
+		return err
+	}
+
+	log.Info("hardfork genesis files exported",
+		"folder", se.exportFolder,
+		"num accounts", len(accounts),
+		"num smart contracts", len(contracts),
+		"total supply", totalSupply.String(),
+	)
+
+	return nil
+}
+
+func (se *stateExport) createGenesisAccounts() ([]*genesisData.InitialAccount, *big.Int) {
+	collector := se.genesisFiles
+	values := make(map[string]*genesisData.InitialAccount)
+	getAccount := func(address string) *genesisData.InitialAccount {
+		account, ok := values[address]
+		if !ok {
+			account = &genesisData.InitialAccount{
+				Address:      se.addressPubKeyConverter.Encode([]byte(address)),
+				Supply:       big.NewInt(0),
+				Balance:      big.NewInt(0),
+				StakingValue: big.NewInt(0),
+				Delegations:  make([]*genesisData.DelegationData, 0),
+			}
+			values[address] = account
+		}
+
+		return account
+	}
+
+	for address, balance := range collector.balances {
+		getAccount(address).Balance.Set(balance)
+	}
+	for address, numNodes := range collector.stakedNodes {
+		if core.IsSmartContractAddress([]byte(address)) {
+			log.Warn("genesis files export: nodes staked by a contract can not be staked at genesis",
+				"address", se.addressPubKeyConverter.Encode([]byte(address)), "num nodes", numNodes)
+			continue
+		}
+
+		stakingValue := big.NewInt(0).Mul(se.genesisNodePrice, big.NewInt(int64(numNodes)))
+		getAccount(address).StakingValue.Set(stakingValue)
+	}
+
+	totalSupply := big.NewInt(0)
+	accounts := make([]*genesisData.InitialAccount, 0, len(values))
+	for _, account := range values {
+		account.Supply.Add(account.Balance, account.StakingValue)
+		totalSupply.Add(totalSupply, account.Supply)
+		accounts = append(accounts, account)
+	}
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].Address < accounts[j].Address
+	})
+
+	return accounts, totalSupply
+}
+
+func (se *stateExport) writeGenesisContractsCode() ([]*genesisData.InitialSmartContract, error) {
+	codeFolder := filepath.Join(se.exportFolder, GenesisContractsCodeFolder)
+	err := os.MkdirAll(codeFolder, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	contracts := make([]*genesisData.InitialSmartContract, 0, len(se.genesisFiles.contracts))
+	for _, contract := range se.genesisFiles.contracts {
+		code, ok := se.genesisFiles.codes[string(contract.codeHash)]
+		if !ok || len(contract.owner) == 0 {
+			log.Warn("genesis files export: contract without code or owner not exported",
+				"address", se.addressPubKeyConverter.Encode(contract.address))
+			continue
+		}
+
+		fileName := filepath.Join(GenesisContractsCodeFolder, hex.EncodeToString(contract.address)+contractCodeFileExtension)
+		err = ioutil.WriteFile(filepath.Join(se.exportFolder, fileName), code, 0664)
+		if err != nil {
+			return nil, err
+		}
+
+		vmType := contract.address[core.NumInitCharactersForScAddress-core.VMTypeLen : core.NumInitCharactersForScAddress]
+		contracts = append(contracts, &genesisData.InitialSmartContract{
+			Owner:    se.addressPubKeyConverter.Encode(contract.owner),
+			Filename: fileName,
+			VmType:   hex.EncodeToString(vmType),
+			Shard:    strconv.Itoa(int(contract.shardID)),
+		})
+	}
+	sort.Slice(contracts, func(i, j int) bool {
+		return contracts[i].Filename < contracts[j].Filename
+	})
+
+	return contracts, nil
+}
+
+func writeJsonFile(filePath string, value interface{}) error {
+	buff, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filePath, buff, 0664)
+}
@@ -6,12 +6,13 @@ import (
 
 // RoundHandlerStub -
 type RoundHandlerStub struct {
-	RoundIndex          int64
-	IndexCalled         func() int64
-	TimeDurationCalled  func() time.Duration
-	TimeStampCalled     func() time.Time
-	UpdateRoundCalled   func(time.Time, time.Time)
-	RemainingTimeCalled func(startTime time.Time, maxTime time.Duration) time.Duration
+	RoundIndex                      int64
+	IndexCalled                     func() int64
+	TimeDurationCalled              func() time.Duration
+	TimeDurationBetweenRoundsCalled func(fromRound int64, toRound int64) time.Duration
+	TimeStampCalled                 func() time.Time
+	UpdateRoundCalled               func(time.Time, time.Time)
+	RemainingTimeCalled             func(startTime time.Time, maxTime time.Duration) time.Duration
 }
 
 // Index -
@@ -32,6 +33,15 @@ func (rhs *RoundHandlerStub) TimeDuration() time.Duration {
 	return 4000 * time.Millisecond
 }
 
+// TimeDurationBetweenRounds -
+func (rhs *RoundHandlerStub) TimeDurationBetweenRounds(fromRound int64, toRound int64) time.Duration {
+	if rhs.TimeDurationBetweenRoundsCalled != nil {
+		return rhs.TimeDurationBetweenRoundsCalled(fromRound, toRound)
+	}
+
+	return time.Duration(toRound-fromRound) * rhs.TimeDuration()
+}
+
 // TimeStamp -
 func (rhs *RoundHandlerStub) TimeStamp() time.Time {
 	if rhs.TimeStampCalled != nil {
@@ -9,21 +9,23 @@ import (
 
 // TrieStub -
 type TrieStub struct {
-	GetCalled                   func(key []byte) ([]byte, error)
-	UpdateCalled                func(key, value []byte) error
-	DeleteCalled                func(key []byte) error
-	RootCalled                  func() ([]byte, error)
-	CommitCalled                func() error
-	RecreateCalled              func(root []byte) (data.Trie, error)
-	CancelPruneCalled           func(rootHash []byte, identifier data.TriePruningIdentifier)
-	PruneCalled                 func(rootHash []byte, identifier data.TriePruningIdentifier)
-	ResetOldHashesCalled        func() [][]byte
-	AppendToOldHashesCalled     func([][]byte)
-	SnapshotCalled              func() error
-	GetSerializedNodesCalled    func([]byte, uint64) ([][]byte, uint64, error)
-	GetAllHashesCalled          func() ([][]byte, error)
-	DatabaseCalled              func() data.DBWriteCacher
-	GetAllLeavesOnChannelCalled func(rootHash []byte) (chan core.KeyValueHolder, error)
+	GetCalled                          func(key []byte) ([]byte, error)
+	UpdateCalled                       func(key, value []byte) error
+	DeleteCalled                       func(key []byte) error
+	RootCalled                         func() ([]byte, error)
+	CommitCalled                       func() error
+	RecreateCalled                     func(root []byte) (data.Trie, error)
+	CancelPruneCalled                  func(rootHash []byte, identifier data.TriePruningIdentifier)
+	PruneCalled                        func(rootHash []byte, identifier data.TriePruningIdentifier)
+	ResetOldHashesCalled               func() [][]byte
+	AppendToOldHashesCalled            func([][]byte)
+	SnapshotCalled                     func() error
+	GetSerializedNodesCalled           func([]byte, uint64) ([][]byte, uint64, error)
+	GetProofCalled                     func(key []byte) ([][]byte, error)
+	GetAllHashesCalled                 func() ([][]byte, error)
+	DatabaseCalled                     func() data.DBWriteCacher
+	GetAllLeavesOnChannelCalled        func(rootHash []byte) (chan core.KeyValueHolder, error)
+	GetAllLeavesFromKeyOnChannelCalled func(rootHash []byte, startKey []byte) (chan core.KeyValueHolder, error)
 }
 
 // EnterPruningBufferingMode -
@@ -54,6 +56,18 @@ func (ts *TrieStub) GetAllLeavesOnChannel(rootHash []byte, _ context.Context) (c
 	return ch, nil
 }
 
+// GetAllLeavesFromKeyOnChannel -
+func (ts *TrieStub) GetAllLeavesFromKeyOnChannel(rootHash []byte, startKey []byte, _ context.Context) (chan core.KeyValueHolder, error) {
+	if ts.GetAllLeavesFromKeyOnChannelCalled != nil {
+		return ts.GetAllLeavesFromKeyOnChannelCalled(rootHash, startKey)
+	}
+
+	ch := make(chan core.KeyValueHolder)
+	close(ch)
+
+	return ch, nil
+}
+
 // IsPruningEnabled -
 func (ts *TrieStub) IsPruningEnabled() bool {
 	return false
@@ -174,6 +188,14 @@ func (ts *TrieStub) GetSerializedNodes(hash []byte, maxBuffToSend uint64) ([][]b
 	return nil, 0, nil
 }
 
+// GetProof -
+func (ts *TrieStub) GetProof(key []byte) ([][]byte, error) {
+	if ts.GetProofCalled != nil {
+		return ts.GetProofCalled(key)
+	}
+	return nil, nil
+}
+
 // Database -
 func (ts *TrieStub) Database() data.DBWriteCacher {
 	if ts.DatabaseCalled != nil {
@@ -152,6 +152,9 @@ var ErrElementNotFound = errors.New("element was not found")
 // ErrInvalidUnJailCost signals that provided unjail cost is invalid
 var ErrInvalidUnJailCost = errors.New("invalid unjail cost")
 
+// ErrInvalidUnJailPriceIncrease signals that provided unjail price increase percentage is invalid
+var ErrInvalidUnJailPriceIncrease = errors.New("invalid unjail price increase percentage")
+
 // ErrInvalidGenesisTotalSupply signals that provided genesis total supply is invalid
 var ErrInvalidGenesisTotalSupply = errors.New("invalid genesis total supply cost")
 
@@ -185,6 +188,15 @@ var ErrInvalidEndOfEpochAccessAddress = errors.New("invalid end of epoch access
 // ErrNilChanceComputer signals that nil chance computer has been provided
 var ErrNilChanceComputer = errors.New("nil chance computer")
 
+// ErrNilNodesCoordinator signals that the provided nodes coordinator is nil
+var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrNotInConsensusGroup signals that the provided BLS key was not in the consensus group of the header
+var ErrNotInConsensusGroup = errors.New("BLS key not in the consensus group")
+
+// ErrInvalidHeaderEpoch signals that the epoch of the provided header can not be verified anymore
+var ErrInvalidHeaderEpoch = errors.New("invalid header epoch")
+
 // ErrNilEpochNotifier signals that the provided EpochNotifier is nil
 var ErrNilEpochNotifier = errors.New("nil EpochNotifier")
 
@@ -229,3 +241,21 @@ var ErrOwnerCannotUnDelegate = errors.New("owner cannot undelegate, contract sti
 
 // ErrNotEnoughInitialOwnerFunds signals that not enough initial owner funds has been provided
 var ErrNotEnoughInitialOwnerFunds = errors.New("not enough initial owner funds")
+
+// ErrInvalidShardID signals that an invalid shard ID was provided
+var ErrInvalidShardID = errors.New("invalid shard ID")
+
+// ErrRewardAddressIsSystemAddress signals that a system smart contract or a protocol address was provided as reward address
+var ErrRewardAddressIsSystemAddress = errors.New("reward address is a system address")
+
+// ErrRewardAddressIsMetachainAddress signals that a smart contract address from metachain was provided as reward address
+var ErrRewardAddressIsMetachainAddress = errors.New("reward address is a metachain smart contract address")
+
+// ErrInvalidMaxInflationPerEpoch signals that an invalid maximum inflation per epoch of the minting was provided
+var ErrInvalidMaxInflationPerEpoch = errors.New("invalid max inflation per epoch")
+
+// ErrOperatorNotStakedByDelegation signals that the operator is not staked by a delegation contract
+var ErrOperatorNotStakedByDelegation = errors.New("operator is not staked by a delegation contract")
+
+// ErrNotDelegatorOfOperator signals that the caller did not delegate to the operator
+var ErrNotDelegatorOfOperator = errors.New("caller did not delegate to the operator")
@@ -16,6 +16,7 @@ type systemSCFactory struct {
 	economics              vm.EconomicsHandler
 	nodesConfigProvider    vm.NodesConfigProvider
 	sigVerifier            vm.MessageSignVerifier
+	nodesCoordinator       vm.NodesCoordinator
 	gasCost                vm.GasCost
 	marshalizer            marshal.Marshalizer
 	hasher                 hashing.Hasher
@@ -23,6 +24,7 @@ type systemSCFactory struct {
 	epochNotifier          vm.EpochNotifier
 	systemSCsContainer     vm.SystemSCContainer
 	addressPubKeyConverter core.PubkeyConverter
+	roundDurationChange    vm.RoundDurationChange
 }
 
 // ArgsNewSystemSCFactory defines the arguments struct needed to create the system SCs
@@ -31,12 +33,14 @@ type ArgsNewSystemSCFactory struct {
 	Economics              vm.EconomicsHandler
 	NodesConfigProvider    vm.NodesConfigProvider
 	SigVerifier            vm.MessageSignVerifier
+	NodesCoordinator       vm.NodesCoordinator
 	GasSchedule            core.GasScheduleNotifier
 	Marshalizer            marshal.Marshalizer
 	Hasher                 hashing.Hasher
 	SystemSCConfig         *config.SystemSmartContractsConfig
 	EpochNotifier          vm.EpochNotifier
 	AddressPubKeyConverter core.PubkeyConverter
+	RoundDurationChange    vm.RoundDurationChange
 }
 
 // NewSystemSCFactory creates a factory which will instantiate the system smart contracts
@@ -50,6 +54,9 @@ func NewSystemSCFactory(args ArgsNewSystemSCFactory) (*systemSCFactory, error) {
 	if check.IfNil(args.NodesConfigProvider) {
 		return nil, vm.ErrNilNodesConfigProvider
 	}
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, vm.ErrNilNodesCoordinator
+	}
 	if check.IfNil(args.Marshalizer) {
 		return nil, vm.ErrNilMarshalizer
 	}
@@ -73,12 +80,14 @@ func NewSystemSCFactory(args ArgsNewSystemSCFactory) (*systemSCFactory, error) {
 		systemEI:               args.SystemEI,
 		sigVerifier:            args.SigVerifier,
 		nodesConfigProvider:    args.NodesConfigProvider,
+		nodesCoordinator:       args.NodesCoordinator,
 		marshalizer:            args.Marshalizer,
 		hasher:                 args.Hasher,
 		systemSCConfig:         args.SystemSCConfig,
 		economics:              args.Economics,
 		epochNotifier:          args.EpochNotifier,
 		addressPubKeyConverter: args.AddressPubKeyConverter,
+		roundDurationChange:    args.RoundDurationChange,
 	}
 
 	err := scf.createGasConfig(args.GasSchedule.LatestGasSchedule())
@@ -159,6 +168,7 @@ func (scf *systemSCFactory) createStakingContract() (vm.SystemSmartContract, err
 		GasCost:              scf.gasCost,
 		Marshalizer:          scf.marshalizer,
 		EpochNotifier:        scf.epochNotifier,
+		RoundDurationChange:  scf.roundDurationChange,
 	}
 	staking, err := systemSmartContracts.NewStakingSmartContract(argsStaking)
 	return staking, err
@@ -166,17 +176,18 @@ func (scf *systemSCFactory) createStakingContract() (vm.SystemSmartContract, err
 
 func (scf *systemSCFactory) createValidatorContract() (vm.SystemSmartContract, error) {
 	args := systemSmartContracts.ArgsValidatorSmartContract{
-		Eei:                scf.systemEI,
-		SigVerifier:        scf.sigVerifier,
-		StakingSCConfig:    scf.systemSCConfig.StakingSystemSCConfig,
-		StakingSCAddress:   vm.StakingSCAddress,
-		EndOfEpochAddress:  vm.EndOfEpochAddress,
-		ValidatorSCAddress: vm.ValidatorSCAddress,
-		GasCost:            scf.gasCost,
-		Marshalizer:        scf.marshalizer,
-		GenesisTotalSupply: scf.economics.GenesisTotalSupply(),
-		EpochNotifier:      scf.epochNotifier,
-		MinDeposit:         scf.systemSCConfig.DelegationManagerSystemSCConfig.MinCreationDeposit,
+		Eei:                 scf.systemEI,
+		SigVerifier:         scf.sigVerifier,
+		StakingSCConfig:     scf.systemSCConfig.StakingSystemSCConfig,
+		StakingSCAddress:    vm.StakingSCAddress,
+		EndOfEpochAddress:   vm.EndOfEpochAddress,
+		ValidatorSCAddress:  vm.ValidatorSCAddress,
+		GasCost:             scf.gasCost,
+		Marshalizer:         scf.marshalizer,
+		GenesisTotalSupply:  scf.economics.GenesisTotalSupply(),
+		EpochNotifier:       scf.epochNotifier,
+		MinDeposit:          scf.systemSCConfig.DelegationManagerSystemSCConfig.MinCreationDeposit,
+		RoundDurationChange: scf.roundDurationChange,
 	}
 	validatorSC, err := systemSmartContracts.NewValidatorSmartContract(args)
 	return validatorSC, err
@@ -227,6 +238,7 @@ func (scf *systemSCFactory) createDelegationContract() (vm.SystemSmartContract,
 		Marshalizer:            scf.marshalizer,
 		EpochNotifier:          scf.epochNotifier,
 		EndOfEpochAddress:      vm.EndOfEpochAddress,
+		RoundDurationChange:    scf.roundDurationChange,
 	}
 	delegation, err := systemSmartContracts.NewDelegationSystemSC(argsDelegation)
 	return delegation, err
@@ -248,6 +260,66 @@ func (scf *systemSCFactory) createDelegationManagerContract() (vm.SystemSmartCon
 	return delegationManager, err
 }
 
+func (scf *systemSCFactory) createSlashingContract() (vm.SystemSmartContract, error) {
+	argsSlashing := systemSmartContracts.ArgsNewSlashingSmartContract{
+		Eei:              scf.systemEI,
+		GasCost:          scf.gasCost,
+		SlashingConfig:   scf.systemSCConfig.SlashingSystemSCConfig,
+		SigVerifier:      scf.sigVerifier,
+		NodesCoordinator: scf.nodesCoordinator,
+		Marshalizer:      scf.marshalizer,
+		Hasher:           scf.hasher,
+		EpochNotifier:    scf.epochNotifier,
+	}
+	slashing, err := systemSmartContracts.NewSlashingSmartContract(argsSlashing)
+	return slashing, err
+}
+
+func (scf *systemSCFactory) createRandomnessContract() (vm.SystemSmartContract, error) {
+	argsRandomness := systemSmartContracts.ArgsNewRandomnessSmartContract{
+		Eei:              scf.systemEI,
+		GasCost:          scf.gasCost,
+		RandomnessConfig: scf.systemSCConfig.RandomnessSystemSCConfig,
+		EpochNotifier:    scf.epochNotifier,
+	}
+	randomness, err := systemSmartContracts.NewRandomnessSmartContract(argsRandomness)
+	return randomness, err
+}
+
+func (scf *systemSCFactory) createInsuranceFundContract() (vm.SystemSmartContract, error) {
+	argsInsuranceFund := systemSmartContracts.ArgsNewInsuranceFundSmartContract{
+		Eei:                 scf.systemEI,
+		GasCost:             scf.gasCost,
+		InsuranceFundConfig: scf.systemSCConfig.InsuranceFundSystemSCConfig,
+		Marshalizer:         scf.marshalizer,
+		Hasher:              scf.hasher,
+		EndOfEpochAddress:   vm.EndOfEpochAddress,
+		EpochNotifier:       scf.epochNotifier,
+	}
+	insuranceFund, err := systemSmartContracts.NewInsuranceFundSmartContract(argsInsuranceFund)
+	return insuranceFund, err
+}
+
+func (scf *systemSCFactory) createVestingContract() (vm.SystemSmartContract, error) {
+	argsVesting := systemSmartContracts.ArgsNewVestingSmartContract{
+		Eei:     scf.systemEI,
+		GasCost: scf.gasCost,
+	}
+	vesting, err := systemSmartContracts.NewVestingSmartContract(argsVesting)
+	return vesting, err
+}
+
+func (scf *systemSCFactory) createMintingContract() (vm.SystemSmartContract, error) {
+	argsMinting := systemSmartContracts.ArgsNewMintingSmartContract{
+		Eei:                scf.systemEI,
+		GasCost:            scf.gasCost,
+		GenesisTotalSupply: scf.economics.GenesisTotalSupply(),
+		MintingConfig:      scf.systemSCConfig.MintingSystemSCConfig,
+	}
+	minting, err := systemSmartContracts.NewMintingSmartContract(argsMinting)
+	return minting, err
+}
+
 // CreateForGenesis instantiates all the system smart contracts and returns a container containing them to be used in the genesis process
 func (scf *systemSCFactory) CreateForGenesis() (vm.SystemSCContainer, error) {
 	staking, err := scf.createStakingContract()
@@ -290,6 +362,26 @@ func (scf *systemSCFactory) CreateForGenesis() (vm.SystemSCContainer, error) {
 		return nil, err
 	}
 
+	vesting, err := scf.createVestingContract()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scf.systemSCsContainer.Add(vm.VestingSCAddress, vesting)
+	if err != nil {
+		return nil, err
+	}
+
+	minting, err := scf.createMintingContract()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scf.systemSCsContainer.Add(vm.MintingSCAddress, minting)
+	if err != nil {
+		return nil, err
+	}
+
 	err = scf.systemEI.SetSystemSCContainer(scf.systemSCsContainer)
 	if err != nil {
 		return nil, err
@@ -325,6 +417,36 @@ func (scf *systemSCFactory) Create() (vm.SystemSCContainer, error) {
 		return nil, err
 	}
 
+	slashing, err := scf.createSlashingContract()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scf.systemSCsContainer.Add(vm.SlashingSCAddress, slashing)
+	if err != nil {
+		return nil, err
+	}
+
+	randomness, err := scf.createRandomnessContract()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scf.systemSCsContainer.Add(vm.RandomnessSCAddress, randomness)
+	if err != nil {
+		return nil, err
+	}
+
+	insuranceFund, err := scf.createInsuranceFundContract()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scf.systemSCsContainer.Add(vm.InsuranceFundSCAddress, insuranceFund)
+	if err != nil {
+		return nil, err
+	}
+
 	err = scf.systemEI.SetSystemSCContainer(scf.systemSCsContainer)
 	if err != nil {
 		return nil, err
@@ -25,6 +25,7 @@ func createMockNewSystemScFactoryArgs() ArgsNewSystemSCFactory {
 		SigVerifier:         &mock.MessageSignVerifierMock{},
 		GasSchedule:         gasSchedule,
 		NodesConfigProvider: &mock.NodesConfigProviderStub{},
+		NodesCoordinator:    &mock.NodesCoordinatorStub{},
 		Marshalizer:         &mock.MarshalizerMock{},
 		Hasher:              &mock.HasherMock{},
 		SystemSCConfig: &config.SystemSmartContractsConfig{
@@ -96,6 +97,17 @@ func TestNewSystemSCFactory_NilEconomicsData(t *testing.T) {
 	assert.Equal(t, vm.ErrNilEconomicsData, err)
 }
 
+func TestNewSystemSCFactory_NilNodesCoordinator(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockNewSystemScFactoryArgs()
+	arguments.NodesCoordinator = nil
+	scFactory, err := NewSystemSCFactory(arguments)
+
+	assert.Nil(t, scFactory)
+	assert.Equal(t, vm.ErrNilNodesCoordinator, err)
+}
+
 func TestNewSystemSCFactory_NilPubKeyConverter(t *testing.T) {
 	t.Parallel()
 
@@ -125,7 +137,7 @@ func TestSystemSCFactory_Create(t *testing.T) {
 
 	container, err := scFactory.Create()
 	assert.Nil(t, err)
-	assert.Equal(t, 6, container.Len())
+	assert.Equal(t, 11, container.Len())
 }
 
 func TestSystemSCFactory_CreateForGenesis(t *testing.T) {
@@ -136,7 +148,7 @@ func TestSystemSCFactory_CreateForGenesis(t *testing.T) {
 
 	container, err := scFactory.CreateForGenesis()
 	assert.Nil(t, err)
-	assert.Equal(t, 4, container.Len())
+	assert.Equal(t, 6, container.Len())
 }
 
 func TestSystemSCFactory_IsInterfaceNil(t *testing.T) {
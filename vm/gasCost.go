@@ -33,6 +33,9 @@ type MetaChainSystemSCsCost struct {
 	UnBondTokens        uint64
 	DelegationMgrOps    uint64
 	GetAllNodeStates    uint64
+	SubmitEquivocation  uint64
+	InsuranceFundOps    uint64
+	MintingOps          uint64
 }
 
 // BuiltInCost defines cost for built-in methods
@@ -43,6 +46,8 @@ type BuiltInCost struct {
 	SaveKeyValue          uint64
 	ESDTTransfer          uint64
 	ESDTBurn              uint64
+
+	SetDeveloperRewardsPercentage uint64
 }
 
 // GasCost holds all the needed gas costs for system smart contracts
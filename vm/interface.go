@@ -84,6 +84,12 @@ type MessageSignVerifier interface {
 	IsInterfaceNil() bool
 }
 
+// NodesCoordinator defines the functionality needed to compute the consensus group of a shard in a round
+type NodesCoordinator interface {
+	GetConsensusValidatorsPublicKeys(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error)
+	IsInterfaceNil() bool
+}
+
 // ArgumentsParser defines the functionality to parse transaction data into arguments and code for smart contracts
 type ArgumentsParser interface {
 	ParseData(data string) (string, [][]byte, error)
@@ -118,4 +124,5 @@ type BlockchainHook interface {
 	IsPayable(address []byte) (bool, error)
 	NumberOfShards() uint32
 	CurrentRandomSeed() []byte
+	GetRandomSeedByNonce(nonce uint64) ([]byte, error)
 }
@@ -24,6 +24,7 @@ type BlockChainHookStub struct {
 	CurrentRoundCalled            func() uint64
 	CurrentTimeStampCalled        func() uint64
 	CurrentRandomSeedCalled       func() []byte
+	GetRandomSeedByNonceCalled    func(nonce uint64) ([]byte, error)
 	CurrentEpochCalled            func() uint32
 	ProcessBuiltInFunctionCalled  func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error)
 	GetBuiltinFunctionNamesCalled func() vmcommon.FunctionNames
@@ -180,6 +181,14 @@ func (b *BlockChainHookStub) CurrentRandomSeed() []byte {
 	return []byte("seedseed")
 }
 
+// GetRandomSeedByNonce -
+func (b *BlockChainHookStub) GetRandomSeedByNonce(nonce uint64) ([]byte, error) {
+	if b.GetRandomSeedByNonceCalled != nil {
+		return b.GetRandomSeedByNonceCalled(nonce)
+	}
+	return []byte("seedseed"), nil
+}
+
 // CurrentEpoch -
 func (b *BlockChainHookStub) CurrentEpoch() uint32 {
 	if b.CurrentEpochCalled != nil {
@@ -0,0 +1,24 @@
+package mock
+
+// NodesCoordinatorStub -
+type NodesCoordinatorStub struct {
+	GetConsensusValidatorsPublicKeysCalled func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error)
+}
+
+// GetConsensusValidatorsPublicKeys -
+func (n *NodesCoordinatorStub) GetConsensusValidatorsPublicKeys(
+	randomness []byte,
+	round uint64,
+	shardId uint32,
+	epoch uint32,
+) ([]string, error) {
+	if n.GetConsensusValidatorsPublicKeysCalled != nil {
+		return n.GetConsensusValidatorsPublicKeysCalled(randomness, round, shardId, epoch)
+	}
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (n *NodesCoordinatorStub) IsInterfaceNil() bool {
+	return n == nil
+}
@@ -0,0 +1,34 @@
+package vm
+
+import "math/big"
+
+// RoundDurationChange holds the round durations before and after the round duration change activated at the enable
+// epoch. It is used by the system smart contracts to convert the periods expressed in rounds, so that they keep
+// spanning the same time after the change
+type RoundDurationChange struct {
+	EnableEpoch                    uint32
+	RoundDurationInMilliseconds    uint64
+	NewRoundDurationInMilliseconds uint64
+}
+
+// IsEnabled returns true if a round duration change is configured
+func (rdc RoundDurationChange) IsEnabled() bool {
+	return rdc.RoundDurationInMilliseconds > 0 &&
+		rdc.NewRoundDurationInMilliseconds > 0 &&
+		rdc.RoundDurationInMilliseconds != rdc.NewRoundDurationInMilliseconds
+}
+
+// ConvertNumRounds returns the number of rounds having the new duration that span at least the time of the provided
+// number of rounds having the initial duration
+func (rdc RoundDurationChange) ConvertNumRounds(numRounds uint64) uint64 {
+	if !rdc.IsEnabled() {
+		return numRounds
+	}
+
+	totalTime := big.NewInt(0).Mul(big.NewInt(0).SetUint64(numRounds), big.NewInt(0).SetUint64(rdc.RoundDurationInMilliseconds))
+	newRoundDuration := big.NewInt(0).SetUint64(rdc.NewRoundDurationInMilliseconds)
+	totalTime.Add(totalTime, newRoundDuration)
+	totalTime.Sub(totalTime, big.NewInt(1))
+
+	return totalTime.Div(totalTime, newRoundDuration).Uint64()
+}
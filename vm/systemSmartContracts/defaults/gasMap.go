@@ -33,6 +33,7 @@ func FillGasMapBuiltInCosts(value uint64) map[string]uint64 {
 	gasMap["SaveKeyValue"] = value
 	gasMap["ESDTTransfer"] = value
 	gasMap["ESDTBurn"] = value
+	gasMap["SetDeveloperRewardsPercentage"] = value
 
 	return gasMap
 }
@@ -60,6 +61,9 @@ func FillGasMapMetaChainSystemSCsCosts(value uint64) map[string]uint64 {
 	gasMap["UnBondTokens"] = value
 	gasMap["DelegationMgrOps"] = value
 	gasMap["GetAllNodeStates"] = value
+	gasMap["SubmitEquivocation"] = value
+	gasMap["InsuranceFundOps"] = value
+	gasMap["MintingOps"] = value
 
 	return gasMap
 }
@@ -34,24 +34,26 @@ const (
 )
 
 type delegation struct {
-	eei                    vm.SystemEI
-	sigVerifier            vm.MessageSignVerifier
-	delegationMgrSCAddress []byte
-	stakingSCAddr          []byte
-	validatorSCAddr        []byte
-	endOfEpochAddr         []byte
-	gasCost                vm.GasCost
-	marshalizer            marshal.Marshalizer
-	delegationEnabled      atomic.Flag
-	enableDelegationEpoch  uint32
-	minServiceFee          uint64
-	maxServiceFee          uint64
-	unBondPeriod           uint64
-	minDelegationAmount    *big.Int
-	nodePrice              *big.Int
-	unJailPrice            *big.Int
-	minStakeValue          *big.Int
-	mutExecution           sync.RWMutex
+	eei                     vm.SystemEI
+	sigVerifier             vm.MessageSignVerifier
+	delegationMgrSCAddress  []byte
+	stakingSCAddr           []byte
+	validatorSCAddr         []byte
+	endOfEpochAddr          []byte
+	gasCost                 vm.GasCost
+	marshalizer             marshal.Marshalizer
+	delegationEnabled       atomic.Flag
+	enableDelegationEpoch   uint32
+	minServiceFee           uint64
+	maxServiceFee           uint64
+	unBondPeriod            uint64
+	minDelegationAmount     *big.Int
+	nodePrice               *big.Int
+	unJailPrice             *big.Int
+	minStakeValue           *big.Int
+	mutExecution            sync.RWMutex
+	roundDurationChange     vm.RoundDurationChange
+	flagRoundDurationChange atomic.Flag
 }
 
 // ArgsNewDelegation defines the arguments to create the delegation smart contract
@@ -67,6 +69,7 @@ type ArgsNewDelegation struct {
 	GasCost                vm.GasCost
 	Marshalizer            marshal.Marshalizer
 	EpochNotifier          vm.EpochNotifier
+	RoundDurationChange    vm.RoundDurationChange
 }
 
 // NewDelegationSystemSC creates a new delegation system SC
@@ -113,6 +116,7 @@ func NewDelegationSystemSC(args ArgsNewDelegation) (*delegation, error) {
 		sigVerifier:            args.SigVerifier,
 		unBondPeriod:           args.StakingSCConfig.UnBondPeriod,
 		endOfEpochAddr:         args.EndOfEpochAddress,
+		roundDurationChange:    args.RoundDurationChange,
 	}
 
 	var okValue bool
@@ -1537,7 +1541,7 @@ func (d *delegation) withdraw(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 		return vmcommon.UserError
 	}
 
-	totalUnBondable, err := d.getUnBondableTokens(delegator, dConfig.UnBondPeriod)
+	totalUnBondable, err := d.getUnBondableTokens(delegator, d.getUnBondPeriod(dConfig.UnBondPeriod))
 	if err != nil {
 		d.eei.AddReturnMessage(err.Error())
 		return vmcommon.UserError
@@ -1573,7 +1577,7 @@ func (d *delegation) withdraw(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 			d.eei.AddReturnMessage(err.Error())
 			return vmcommon.UserError
 		}
-		if currentNonce-fund.Nonce < dConfig.UnBondPeriod {
+		if currentNonce-fund.Nonce < d.getUnBondPeriod(dConfig.UnBondPeriod) {
 			tempUnStakedFunds = append(tempUnStakedFunds, delegator.UnStakedFunds[fundIndex])
 			continue
 		}
@@ -1828,7 +1832,7 @@ func (d *delegation) getContractConfig(args *vmcommon.ContractCallInput) vmcommo
 	d.eei.Finish([]byte(withDelegationCap))
 	d.eei.Finish([]byte(changeableServiceFee))
 	d.eei.Finish(big.NewInt(0).SetUint64(delegationConfig.CreatedNonce).Bytes())
-	d.eei.Finish(big.NewInt(0).SetUint64(delegationConfig.UnBondPeriod).Bytes())
+	d.eei.Finish(big.NewInt(0).SetUint64(d.getUnBondPeriod(delegationConfig.UnBondPeriod)).Bytes())
 
 	return vmcommon.Ok
 }
@@ -1919,7 +1923,7 @@ func (d *delegation) getUserUnBondable(args *vmcommon.ContractCallInput) vmcommo
 		return vmcommon.UserError
 	}
 
-	totalUnBondable, err := d.getUnBondableTokens(delegator, dConfig.UnBondPeriod)
+	totalUnBondable, err := d.getUnBondableTokens(delegator, d.getUnBondPeriod(dConfig.UnBondPeriod))
 	if err != nil {
 		d.eei.AddReturnMessage(err.Error())
 		return vmcommon.UserError
@@ -1942,6 +1946,7 @@ func (d *delegation) getUserUnDelegatedList(args *vmcommon.ContractCallInput) vm
 	}
 
 	currentNonce := d.eei.BlockChainHook().CurrentNonce()
+	unBondPeriod := d.getUnBondPeriod(dConfig.UnBondPeriod)
 	var fund *Fund
 	for _, fundKey := range delegator.UnStakedFunds {
 		fund, err = d.getFund(fundKey)
@@ -1952,12 +1957,12 @@ func (d *delegation) getUserUnDelegatedList(args *vmcommon.ContractCallInput) vm
 
 		d.eei.Finish(fund.Value.Bytes())
 		elapsedNonce := currentNonce - fund.Nonce
-		if elapsedNonce >= dConfig.UnBondPeriod {
+		if elapsedNonce >= unBondPeriod {
 			d.eei.Finish(zero.Bytes())
 			continue
 		}
 
-		remainingNonce := dConfig.UnBondPeriod - elapsedNonce
+		remainingNonce := unBondPeriod - elapsedNonce
 		d.eei.Finish(big.NewInt(0).SetUint64(remainingNonce).Bytes())
 	}
 
@@ -2029,7 +2034,7 @@ func (d *delegation) getDelegatorFundsData(args *vmcommon.ContractCallInput) vmc
 		return vmcommon.UserError
 	}
 
-	totalUnBondable, err := d.getUnBondableTokens(delegator, dConfig.UnBondPeriod)
+	totalUnBondable, err := d.getUnBondableTokens(delegator, d.getUnBondPeriod(dConfig.UnBondPeriod))
 	if err != nil {
 		d.eei.AddReturnMessage(err.Error())
 		return vmcommon.UserError
@@ -2300,6 +2305,19 @@ func (d *delegation) SetNewGasCost(gasCost vm.GasCost) {
 func (d *delegation) EpochConfirmed(epoch uint32) {
 	d.delegationEnabled.Toggle(epoch >= d.enableDelegationEpoch)
 	log.Debug("delegation", "enabled", d.delegationEnabled.IsSet())
+
+	d.flagRoundDurationChange.Toggle(d.roundDurationChange.IsEnabled() && epoch >= d.roundDurationChange.EnableEpoch)
+	log.Debug("delegation: unBond period converted to the new round duration", "enabled", d.flagRoundDurationChange.IsSet())
+}
+
+// getUnBondPeriod returns the provided unBond period, set when the delegation contract was created, expressed in
+// rounds of the current round duration
+func (d *delegation) getUnBondPeriod(unBondPeriod uint64) uint64 {
+	if d.flagRoundDurationChange.IsSet() {
+		return d.roundDurationChange.ConvertNumRounds(unBondPeriod)
+	}
+
+	return unBondPeriod
 }
 
 // CanUseContract returns true if contract can be used
@@ -39,6 +39,12 @@ const canWipe = "canWipe"
 const canChangeOwner = "canChangeOwner"
 const upgradable = "canUpgrade"
 
+// TokensPageNumFieldsPerToken is the number of return data entries written by the getTokensPage view for each token
+const TokensPageNumFieldsPerToken = 7
+
+// MaxTokensPageSize is the maximum number of tokens that can be returned by a getTokensPage call
+const MaxTokensPageSize = 100
+
 const conversionBase = 10
 
 type esdt struct {
@@ -52,6 +58,8 @@ type esdt struct {
 	hasher                 hashing.Hasher
 	enabledEpoch           uint32
 	flagEnabled            atomic.Flag
+	tokensPageEnableEpoch  uint32
+	flagTokensPage         atomic.Flag
 	mutExecution           sync.RWMutex
 	addressPubKeyConverter core.PubkeyConverter
 }
@@ -101,6 +109,7 @@ func NewESDTSmartContract(args ArgsNewESDTSmartContract) (*esdt, error) {
 		hasher:                 args.Hasher,
 		marshalizer:            args.Marshalizer,
 		enabledEpoch:           args.ESDTSCConfig.EnabledEpoch,
+		tokensPageEnableEpoch:  args.ESDTSCConfig.TokensPageEnableEpoch,
 		endOfEpochSCAddress:    args.EndOfEpochSCAddress,
 		addressPubKeyConverter: args.AddressPubKeyConverter,
 	}
@@ -156,6 +165,8 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		return e.getAllESDTTokens(args)
 	case "getTokenProperties":
 		return e.getTokenProperties(args)
+	case "getTokensPage":
+		return e.getTokensPage(args)
 	}
 
 	e.eei.AddReturnMessage("invalid method to call")
@@ -705,6 +716,77 @@ func (e *esdt) getTokenProperties(args *vmcommon.ContractCallInput) vmcommon.Ret
 	return vmcommon.Ok
 }
 
+// getTokensPage returns the total number of issued tokens followed by the identifier, name, owner, minted value,
+// burnt value, number of decimals and paused flag of each token in the requested page. The arguments are the index of
+// the first token and the page size, the tokens being indexed in their issuing order
+func (e *esdt) getTokensPage(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !e.flagTokensPage.IsSet() {
+		e.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		e.eei.AddReturnMessage("callValue must be 0")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		e.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return vmcommon.UserError
+	}
+	startIndex := big.NewInt(0).SetBytes(args.Arguments[0])
+	pageSize := big.NewInt(0).SetBytes(args.Arguments[1])
+	if pageSize.Sign() == 0 || pageSize.Cmp(big.NewInt(MaxTokensPageSize)) > 0 {
+		e.eei.AddReturnMessage(fmt.Sprintf("invalid page size, should be between 1 and %d", MaxTokensPageSize))
+		return vmcommon.UserError
+	}
+	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTOperations)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	savedData := e.eei.GetStorage([]byte(allIssuedTokens))
+	err = e.eei.UseGas(e.gasCost.BaseOperationCost.DataCopyPerByte * uint64(len(savedData)))
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	tokens := make([][]byte, 0)
+	if len(savedData) > 0 {
+		tokens = bytes.Split(savedData, []byte("@"))
+	}
+	e.eei.Finish(big.NewInt(int64(len(tokens))).Bytes())
+	if !startIndex.IsInt64() || startIndex.Int64() >= int64(len(tokens)) {
+		return vmcommon.Ok
+	}
+
+	start := int(startIndex.Int64())
+	end := core.MinInt(start+int(pageSize.Int64()), len(tokens))
+	for _, tokenIdentifier := range tokens[start:end] {
+		err = e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTOperations)
+		if err != nil {
+			e.eei.AddReturnMessage(err.Error())
+			return vmcommon.OutOfGas
+		}
+
+		esdtToken, errGet := e.getExistingToken(tokenIdentifier)
+		if errGet != nil {
+			e.eei.AddReturnMessage(errGet.Error())
+			return vmcommon.UserError
+		}
+
+		e.eei.Finish(tokenIdentifier)
+		e.eei.Finish(esdtToken.TokenName)
+		e.eei.Finish(esdtToken.OwnerAddress)
+		e.eei.Finish(esdtToken.MintedValue.Bytes())
+		e.eei.Finish(esdtToken.BurntValue.Bytes())
+		e.eei.Finish(big.NewInt(int64(esdtToken.NumDecimals)).Bytes())
+		e.eei.Finish([]byte(getStringFromBool(esdtToken.IsPaused)))
+	}
+
+	return vmcommon.Ok
+}
+
 func (e *esdt) addToIssuedTokens(newToken string) {
 	allTokens := e.eei.GetStorage([]byte(allIssuedTokens))
 	if len(allTokens) == 0 {
@@ -846,6 +928,9 @@ func (e *esdt) saveESDTConfig(esdtConfig *ESDTConfig) error {
 func (e *esdt) EpochConfirmed(epoch uint32) {
 	e.flagEnabled.Toggle(epoch >= e.enabledEpoch)
 	log.Debug("esdt contract", "enabled", e.flagEnabled.IsSet())
+
+	e.flagTokensPage.Toggle(epoch >= e.tokensPageEnableEpoch)
+	log.Debug("esdt contract: tokens page view", "enabled", e.flagTokensPage.IsSet())
 }
 
 // SetNewGasCost is called whenever a gas cost was changed
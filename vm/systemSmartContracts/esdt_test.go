@@ -2195,6 +2195,90 @@ func TestEsdt_ExecuteEsdtControlChangesSavesTokenWithUpgradedPropreties(t *testi
 	assert.Equal(t, vmInput.CallerAddr, eei.output[1])
 }
 
+func TestEsdt_ExecuteGetTokensPage(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+
+	tokensMap := map[string][]byte{}
+	for i, tokenIdentifier := range []string{"TKA-aaaaaa", "TKB-bbbbbb", "TKC-cccccc"} {
+		marshalizedData, _ := args.Marshalizer.Marshal(ESDTData{
+			TokenName:    []byte("token" + tokenIdentifier[:3]),
+			OwnerAddress: []byte("owner"),
+			MintedValue:  big.NewInt(int64(1000 * (i + 1))),
+			BurntValue:   big.NewInt(10),
+			NumDecimals:  uint32(i),
+			IsPaused:     i == 1,
+		})
+		tokensMap[tokenIdentifier] = marshalizedData
+	}
+	tokensMap[allIssuedTokens] = []byte("TKA-aaaaaa@TKB-bbbbbb@TKC-cccccc")
+	eei.storageUpdate[string(eei.scAddress)] = tokensMap
+
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := getDefaultVmInputForFunc("getTokensPage", [][]byte{big.NewInt(1).Bytes()})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, vm.ErrInvalidNumOfArguments.Error()))
+
+	vmInput = getDefaultVmInputForFunc("getTokensPage", [][]byte{big.NewInt(0).Bytes(), big.NewInt(MaxTokensPageSize + 1).Bytes()})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	eei.output = make([][]byte, 0)
+	vmInput = getDefaultVmInputForFunc("getTokensPage", [][]byte{big.NewInt(1).Bytes(), big.NewInt(5).Bytes()})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	assert.Equal(t, 1+2*TokensPageNumFieldsPerToken, len(eei.output))
+	assert.Equal(t, big.NewInt(3).Bytes(), eei.output[0])
+	assert.Equal(t, []byte("TKB-bbbbbb"), eei.output[1])
+	assert.Equal(t, []byte("tokenTKB"), eei.output[2])
+	assert.Equal(t, []byte("owner"), eei.output[3])
+	assert.Equal(t, big.NewInt(2000).Bytes(), eei.output[4])
+	assert.Equal(t, big.NewInt(10).Bytes(), eei.output[5])
+	assert.Equal(t, big.NewInt(1).Bytes(), eei.output[6])
+	assert.Equal(t, []byte("true"), eei.output[7])
+	assert.Equal(t, []byte("TKC-cccccc"), eei.output[8])
+
+	eei.output = make([][]byte, 0)
+	vmInput = getDefaultVmInputForFunc("getTokensPage", [][]byte{big.NewInt(3).Bytes(), big.NewInt(5).Bytes()})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, [][]byte{big.NewInt(3).Bytes()}, eei.output)
+}
+
+func TestEsdt_ExecuteGetTokensPageBeforeEnableEpochShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	args.ESDTSCConfig.TokensPageEnableEpoch = 10
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+
+	e, _ := NewESDTSmartContract(args)
+	vmInput := getDefaultVmInputForFunc("getTokensPage", [][]byte{big.NewInt(0).Bytes(), big.NewInt(5).Bytes()})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionNotFound, output)
+
+	e.EpochConfirmed(10)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+}
+
 func TestEsdt_ExecuteConfigChange(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,480 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+const claimPrefix = "claim"
+const claimedValuePrefix = "claimedValue"
+const usedProposalPrefix = "usedProposal"
+const numClaimsKey = "numClaims"
+const totalCollectedKey = "totalCollected"
+
+// ArgsNewInsuranceFundSmartContract defines the arguments needed for the insurance fund smart contract
+type ArgsNewInsuranceFundSmartContract struct {
+	Eei                 vm.SystemEI
+	GasCost             vm.GasCost
+	InsuranceFundConfig config.InsuranceFundSystemSCConfig
+	Marshalizer         marshal.Marshalizer
+	Hasher              hashing.Hasher
+	EndOfEpochAddress   []byte
+	EpochNotifier       vm.EpochNotifier
+}
+
+// insuranceFundSC accumulates a share of the protocol sustainability rewards at each end of epoch. Delegators of an
+// operator that was slashed or penalized for being offline can submit claims, which are paid from the fund only
+// after a closed and passed governance proposal is referenced. The proposal must be registered with the commitment of
+// the claim, derived from the claim ID, the claimant and the claimed value, so it approves a single claim
+type insuranceFundSC struct {
+	eei            vm.SystemEI
+	gasCost        vm.GasCost
+	marshalizer    marshal.Marshalizer
+	hasher         hashing.Hasher
+	endOfEpochAddr []byte
+	enabledEpoch   uint32
+	flagEnabled    atomic.Flag
+	mutExecution   sync.RWMutex
+}
+
+// NewInsuranceFundSmartContract creates a new insurance fund smart contract
+func NewInsuranceFundSmartContract(args ArgsNewInsuranceFundSmartContract) (*insuranceFundSC, error) {
+	if check.IfNil(args.Eei) {
+		return nil, vm.ErrNilSystemEnvironmentInterface
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, vm.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, vm.ErrNilHasher
+	}
+	if len(args.EndOfEpochAddress) < 1 {
+		return nil, vm.ErrInvalidEndOfEpochAccessAddress
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, vm.ErrNilEpochNotifier
+	}
+
+	i := &insuranceFundSC{
+		eei:            args.Eei,
+		gasCost:        args.GasCost,
+		marshalizer:    args.Marshalizer,
+		hasher:         args.Hasher,
+		endOfEpochAddr: args.EndOfEpochAddress,
+		enabledEpoch:   args.InsuranceFundConfig.EnabledEpoch,
+	}
+	args.EpochNotifier.RegisterNotifyHandler(i)
+
+	return i, nil
+}
+
+// Execute calls one of the functions from the insurance fund smart contract and runs the code according to the input
+func (i *insuranceFundSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	i.mutExecution.RLock()
+	defer i.mutExecution.RUnlock()
+	if CheckIfNil(args) != nil {
+		return vmcommon.UserError
+	}
+
+	if args.Function == core.SCDeployInitFunctionName {
+		return vmcommon.Ok
+	}
+
+	if !i.flagEnabled.IsSet() {
+		i.eei.AddReturnMessage("insurance fund SC disabled")
+		return vmcommon.UserError
+	}
+
+	switch args.Function {
+	case "updateRewards":
+		return i.updateRewards(args)
+	case "submitClaim":
+		return i.submitClaim(args)
+	case "approveClaim":
+		return i.approveClaim(args)
+	case "getClaim":
+		return i.getClaim(args)
+	case "getTotalCollected":
+		return i.getTotalCollected(args)
+	}
+
+	i.eei.AddReturnMessage("invalid method to call")
+	return vmcommon.FunctionNotFound
+}
+
+// updateRewards is called at the end of epoch with the share of the rewards directed towards the insurance fund
+func (i *insuranceFundSC) updateRewards(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, i.endOfEpochAddr) {
+		i.eei.AddReturnMessage("only end of epoch address can call this function")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 0 {
+		i.eei.AddReturnMessage("must call without arguments")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) < 0 {
+		i.eei.AddReturnMessage("cannot call with negative value")
+		return vmcommon.UserError
+	}
+
+	totalCollected := big.NewInt(0).SetBytes(i.eei.GetStorage([]byte(totalCollectedKey)))
+	totalCollected.Add(totalCollected, args.CallValue)
+	i.eei.SetStorage([]byte(totalCollectedKey), totalCollected.Bytes())
+
+	return vmcommon.Ok
+}
+
+// submitClaim expects the BLS key of the penalized operator and the claimed value. The operator must have a recorded
+// equivocation proof or a bad rating and must be staked by a delegation contract the caller delegated to. As the
+// protocol does not cut the stake of the penalized operators, the value claimed by a delegator for an operator, summed
+// over all its claims, is capped at its active delegated stake. It returns the ID of the newly created claim
+func (i *insuranceFundSC) submitClaim(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		i.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		i.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 2, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+	err := i.eei.UseGas(i.gasCost.MetaChainSystemSCsCost.InsuranceFundOps)
+	if err != nil {
+		i.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+
+	operator := args.Arguments[0]
+	if !i.isPenalizedOperator(operator) {
+		i.eei.AddReturnMessage("operator was neither slashed nor penalized")
+		return vmcommon.UserError
+	}
+	value := big.NewInt(0).SetBytes(args.Arguments[1])
+	if value.Cmp(zero) <= 0 {
+		i.eei.AddReturnMessage("invalid claimed value")
+		return vmcommon.UserError
+	}
+	delegatedValue, err := i.getDelegatedValue(operator, args.CallerAddr)
+	if err != nil {
+		i.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	claimedValueKey := append(append([]byte(claimedValuePrefix), operator...), args.CallerAddr...)
+	claimedValue := big.NewInt(0).SetBytes(i.eei.GetStorage(claimedValueKey))
+	claimedValue.Add(claimedValue, value)
+	if claimedValue.Cmp(delegatedValue) > 0 {
+		i.eei.AddReturnMessage(fmt.Sprintf("claimed value exceeds the delegated stake of %s", delegatedValue.String()))
+		return vmcommon.UserError
+	}
+
+	numClaims := big.NewInt(0).SetBytes(i.eei.GetStorage([]byte(numClaimsKey)))
+	claimID := numClaims.Add(numClaims, big.NewInt(1)).Bytes()
+	claim := &InsuranceClaim{
+		Claimant: args.CallerAddr,
+		Operator: operator,
+		Value:    value,
+		Epoch:    i.eei.BlockChainHook().CurrentEpoch(),
+	}
+	err = i.saveClaim(claimID, claim)
+	if err != nil {
+		i.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	i.eei.SetStorage([]byte(numClaimsKey), claimID)
+	i.eei.SetStorage(claimedValueKey, claimedValue.Bytes())
+	i.eei.Finish(claimID)
+
+	return vmcommon.Ok
+}
+
+func (i *insuranceFundSC) isPenalizedOperator(blsKey []byte) bool {
+	if len(blsKey) == 0 {
+		return false
+	}
+
+	numEquivocationsKey := append([]byte(numEquivocationsPrefix), blsKey...)
+	numEquivocations := i.eei.GetStorageFromAddress(vm.SlashingSCAddress, numEquivocationsKey)
+	if big.NewInt(0).SetBytes(numEquivocations).Cmp(zero) > 0 {
+		return true
+	}
+
+	return i.eei.IsBadRating(blsKey)
+}
+
+// getDelegatedValue returns the active stake the delegator has in the delegation contract which staked the operator
+func (i *insuranceFundSC) getDelegatedValue(blsKey []byte, delegator []byte) (*big.Int, error) {
+	stakedData := &StakedDataV2_0{}
+	err := i.getStorageFromAddress(vm.StakingSCAddress, blsKey, stakedData)
+	if err != nil {
+		return nil, fmt.Errorf("operator not staked: %w", err)
+	}
+
+	contractList := &DelegationContractList{}
+	err = i.getStorageFromAddress(vm.DelegationManagerSCAddress, []byte(delegationContractsList), contractList)
+	if err != nil {
+		return nil, err
+	}
+	if !isAddressInList(stakedData.OwnerAddress, contractList.Addresses) {
+		return nil, vm.ErrOperatorNotStakedByDelegation
+	}
+
+	delegatorData := &DelegatorData{}
+	err = i.getStorageFromAddress(stakedData.OwnerAddress, delegator, delegatorData)
+	if err != nil || len(delegatorData.ActiveFund) == 0 {
+		return nil, vm.ErrNotDelegatorOfOperator
+	}
+
+	activeFund := &Fund{}
+	err = i.getStorageFromAddress(stakedData.OwnerAddress, delegatorData.ActiveFund, activeFund)
+	if err != nil || activeFund.Value == nil || activeFund.Value.Cmp(zero) <= 0 {
+		return nil, vm.ErrNotDelegatorOfOperator
+	}
+
+	return activeFund.Value, nil
+}
+
+func (i *insuranceFundSC) getStorageFromAddress(address []byte, key []byte, value interface{}) error {
+	marshaledData := i.eei.GetStorageFromAddress(address, key)
+	if len(marshaledData) == 0 {
+		return vm.ErrDataNotFoundUnderKey
+	}
+
+	return i.marshalizer.Unmarshal(value, marshaledData)
+}
+
+func isAddressInList(address []byte, addresses [][]byte) bool {
+	for _, listedAddress := range addresses {
+		if bytes.Equal(address, listedAddress) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// computeClaimCommitment returns the reference a governance proposal must be registered with in order to approve the
+// claim. It has the length of a github commit, as required for the governance proposals
+func (i *insuranceFundSC) computeClaimCommitment(claimID []byte, claim *InsuranceClaim) []byte {
+	claimIDHash := i.hasher.Compute(string(claimID))
+	claimantHash := i.hasher.Compute(string(claim.Claimant))
+	valueHash := i.hasher.Compute(string(claim.Value.Bytes()))
+	claimHash := i.hasher.Compute(string(claimIDHash) + string(claimantHash) + string(valueHash))
+
+	commitment := []byte(hex.EncodeToString(claimHash))
+	if len(commitment) > githubCommitLength {
+		commitment = commitment[:githubCommitLength]
+	}
+
+	return commitment
+}
+
+// approveClaim expects the claim ID and the reference of a closed and passed governance proposal, which must be the
+// commitment of the claim. The claimed value is transferred from the fund to the claimant
+func (i *insuranceFundSC) approveClaim(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		i.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		i.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 2, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+	err := i.eei.UseGas(i.gasCost.MetaChainSystemSCsCost.InsuranceFundOps)
+	if err != nil {
+		i.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+
+	claimID := args.Arguments[0]
+	claim, err := i.getClaimByID(claimID)
+	if err != nil {
+		i.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if claim.Paid {
+		i.eei.AddReturnMessage("claim already paid")
+		return vmcommon.UserError
+	}
+
+	proposalReference := args.Arguments[1]
+	if !bytes.Equal(proposalReference, i.computeClaimCommitment(claimID, claim)) {
+		i.eei.AddReturnMessage("proposal does not commit to the claim")
+		return vmcommon.UserError
+	}
+	usedProposalKey := append([]byte(usedProposalPrefix), proposalReference...)
+	if len(i.eei.GetStorage(usedProposalKey)) != 0 {
+		i.eei.AddReturnMessage("proposal already used for another claim")
+		return vmcommon.UserError
+	}
+	err = i.checkProposalPassed(proposalReference)
+	if err != nil {
+		i.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	fundBalance := i.eei.GetBalance(args.RecipientAddr)
+	if fundBalance == nil || fundBalance.Cmp(claim.Value) < 0 {
+		i.eei.AddReturnMessage("not enough funds in the insurance fund")
+		return vmcommon.UserError
+	}
+	err = i.eei.Transfer(claim.Claimant, args.RecipientAddr, claim.Value, nil, 0)
+	if err != nil {
+		i.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	claim.Paid = true
+	claim.ProposalReference = proposalReference
+	err = i.saveClaim(claimID, claim)
+	if err != nil {
+		i.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	i.eei.SetStorage(usedProposalKey, claimID)
+
+	log.Debug("insuranceFundSC: claim paid",
+		"claim ID", big.NewInt(0).SetBytes(claimID).String(),
+		"claimant", claim.Claimant,
+		"value", claim.Value.String(),
+		"proposal", proposalReference,
+	)
+
+	return vmcommon.Ok
+}
+
+func (i *insuranceFundSC) checkProposalPassed(reference []byte) error {
+	key := append([]byte(proposalPrefix), reference...)
+	marshaledData := i.eei.GetStorageFromAddress(vm.GovernanceSCAddress, key)
+	if len(marshaledData) == 0 {
+		return fmt.Errorf("proposal %s not found", reference)
+	}
+
+	proposal := &GeneralProposal{}
+	err := i.marshalizer.Unmarshal(proposal, marshaledData)
+	if err != nil {
+		return err
+	}
+	if !proposal.Closed {
+		return fmt.Errorf("proposal %s is not closed", reference)
+	}
+	if !proposal.Voted {
+		return fmt.Errorf("proposal %s did not pass", reference)
+	}
+
+	return nil
+}
+
+// getClaim returns the claimant, the operator, the value, the epoch, the reference of the approving proposal, the
+// status of the claim and the commitment a governance proposal must be registered with to approve it
+func (i *insuranceFundSC) getClaim(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		i.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		i.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 1, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+	err := i.eei.UseGas(i.gasCost.MetaChainSystemSCsCost.Get)
+	if err != nil {
+		i.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+
+	claimID := args.Arguments[0]
+	claim, err := i.getClaimByID(claimID)
+	if err != nil {
+		i.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	i.eei.Finish(claim.Claimant)
+	i.eei.Finish(claim.Operator)
+	i.eei.Finish([]byte(claim.Value.String()))
+	i.eei.Finish(big.NewInt(0).SetUint64(uint64(claim.Epoch)).Bytes())
+	i.eei.Finish(claim.ProposalReference)
+	if claim.Paid {
+		i.eei.Finish([]byte("paid"))
+	} else {
+		i.eei.Finish([]byte("pending"))
+	}
+	i.eei.Finish(i.computeClaimCommitment(claimID, claim))
+
+	return vmcommon.Ok
+}
+
+func (i *insuranceFundSC) getTotalCollected(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		i.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	err := i.eei.UseGas(i.gasCost.MetaChainSystemSCsCost.Get)
+	if err != nil {
+		i.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+
+	totalCollected := big.NewInt(0).SetBytes(i.eei.GetStorage([]byte(totalCollectedKey)))
+	i.eei.Finish([]byte(totalCollected.String()))
+
+	return vmcommon.Ok
+}
+
+func (i *insuranceFundSC) getClaimByID(claimID []byte) (*InsuranceClaim, error) {
+	marshaledData := i.eei.GetStorage(append([]byte(claimPrefix), claimID...))
+	if len(marshaledData) == 0 {
+		return nil, fmt.Errorf("claim %s not found", big.NewInt(0).SetBytes(claimID).String())
+	}
+
+	claim := &InsuranceClaim{}
+	err := i.marshalizer.Unmarshal(claim, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return claim, nil
+}
+
+func (i *insuranceFundSC) saveClaim(claimID []byte, claim *InsuranceClaim) error {
+	marshaledData, err := i.marshalizer.Marshal(claim)
+	if err != nil {
+		return err
+	}
+
+	i.eei.SetStorage(append([]byte(claimPrefix), claimID...), marshaledData)
+	return nil
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (i *insuranceFundSC) EpochConfirmed(epoch uint32) {
+	i.flagEnabled.Toggle(epoch >= i.enabledEpoch)
+	log.Debug("insurance fund contract", "enabled", i.flagEnabled.IsSet())
+}
+
+// CanUseContract returns true if contract is enabled
+func (i *insuranceFundSC) CanUseContract() bool {
+	return true
+}
+
+// SetNewGasCost is called whenever a gas cost was changed
+func (i *insuranceFundSC) SetNewGasCost(gasCost vm.GasCost) {
+	i.mutExecution.Lock()
+	i.gasCost = gasCost
+	i.mutExecution.Unlock()
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (i *insuranceFundSC) IsInterfaceNil() bool {
+	return i == nil
+}
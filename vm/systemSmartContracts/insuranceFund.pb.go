@@ -0,0 +1,664 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: insuranceFund.proto
+
+package systemSmartContracts
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	github_com_ElrondNetwork_elrond_go_data "github.com/ElrondNetwork/elrond-go/data"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_big "math/big"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type InsuranceClaim struct {
+	Claimant          []byte        `protobuf:"bytes,1,opt,name=Claimant,proto3" json:"Claimant"`
+	Operator          []byte        `protobuf:"bytes,2,opt,name=Operator,proto3" json:"Operator"`
+	Value             *math_big.Int `protobuf:"bytes,3,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"Value"`
+	Epoch             uint32        `protobuf:"varint,4,opt,name=Epoch,proto3" json:"Epoch"`
+	ProposalReference []byte        `protobuf:"bytes,5,opt,name=ProposalReference,proto3" json:"ProposalReference"`
+	Paid              bool          `protobuf:"varint,6,opt,name=Paid,proto3" json:"Paid"`
+}
+
+func (m *InsuranceClaim) Reset()      { *m = InsuranceClaim{} }
+func (*InsuranceClaim) ProtoMessage() {}
+func (*InsuranceClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f75c5b70df67a62, []int{0}
+}
+func (m *InsuranceClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *InsuranceClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceClaim.Merge(m, src)
+}
+func (m *InsuranceClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceClaim proto.InternalMessageInfo
+
+func (m *InsuranceClaim) GetClaimant() []byte {
+	if m != nil {
+		return m.Claimant
+	}
+	return nil
+}
+
+func (m *InsuranceClaim) GetOperator() []byte {
+	if m != nil {
+		return m.Operator
+	}
+	return nil
+}
+
+func (m *InsuranceClaim) GetValue() *math_big.Int {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *InsuranceClaim) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *InsuranceClaim) GetProposalReference() []byte {
+	if m != nil {
+		return m.ProposalReference
+	}
+	return nil
+}
+
+func (m *InsuranceClaim) GetPaid() bool {
+	if m != nil {
+		return m.Paid
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*InsuranceClaim)(nil), "proto.InsuranceClaim")
+}
+
+func init() { proto.RegisterFile("insuranceFund.proto", fileDescriptor_9f75c5b70df67a62) }
+
+var fileDescriptor_9f75c5b70df67a62 = []byte{
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0x65, 0x51, 0x3f, 0x4b, 0xc3, 0x40,
+	0x14, 0x6f, 0x6a, 0x53, 0xea, 0x51, 0x05, 0xa3, 0x42, 0x10, 0x49, 0xc5, 0xa9, 0x4b, 0x93, 0xc1,
+	0xd1, 0xc9, 0x84, 0x0a, 0x5d, 0x62, 0x39, 0xc1, 0xc1, 0xed, 0x92, 0x5c, 0xd3, 0x60, 0x72, 0x17,
+	0x2e, 0x17, 0x8a, 0x9b, 0x1f, 0xc1, 0x8f, 0x21, 0x7e, 0x12, 0x27, 0xe9, 0xd8, 0x49, 0x6d, 0x5d,
+	0xc4, 0xc9, 0x8f, 0xe0, 0xeb, 0x85, 0x56, 0xa1, 0xc3, 0xef, 0xde, 0x7b, 0xbf, 0xf7, 0x7b, 0xff,
+	0x38, 0xb4, 0x9f, 0xb0, 0xa2, 0x14, 0x84, 0x85, 0xf4, 0xb2, 0x64, 0x91, 0x9d, 0x0b, 0x2e, 0xb9,
+	0xa1, 0x2b, 0x73, 0xd4, 0x8b, 0x13, 0x39, 0x2e, 0x03, 0x3b, 0xe4, 0x99, 0x13, 0xf3, 0x98, 0x3b,
+	0x8a, 0x0e, 0xca, 0x91, 0x8a, 0x54, 0xa0, 0xbc, 0xaa, 0xea, 0xf4, 0xb5, 0x8e, 0x76, 0x07, 0xab,
+	0x6e, 0x5e, 0x4a, 0x92, 0xcc, 0xe8, 0xa2, 0x96, 0x72, 0x08, 0x93, 0xa6, 0x76, 0xa2, 0x75, 0xdb,
+	0x6e, 0xfb, 0xfb, 0xad, 0xb3, 0xe6, 0xf0, 0xda, 0x5b, 0x2a, 0xaf, 0x72, 0x2a, 0x88, 0xe4, 0xc2,
+	0xac, 0xff, 0x29, 0x57, 0x1c, 0x5e, 0x7b, 0x46, 0x84, 0xf4, 0x1b, 0x92, 0x96, 0xd4, 0xdc, 0x52,
+	0x32, 0x1f, 0x64, 0x15, 0xf1, 0xfc, 0xde, 0xb9, 0xc8, 0x88, 0x1c, 0x3b, 0x41, 0x12, 0xdb, 0x03,
+	0x26, 0xcf, 0xff, 0xad, 0xdf, 0x4f, 0x05, 0x67, 0x91, 0x4f, 0xe5, 0x84, 0x8b, 0x3b, 0x87, 0xaa,
+	0xa8, 0x07, 0x17, 0x45, 0x44, 0x12, 0xdb, 0x4d, 0x62, 0x90, 0x7b, 0xa4, 0x90, 0x54, 0xe0, 0xaa,
+	0x97, 0xd1, 0x41, 0x7a, 0x3f, 0xe7, 0xe1, 0xd8, 0x6c, 0xc0, 0x94, 0x1d, 0x77, 0x7b, 0x39, 0x45,
+	0x11, 0xb8, 0x32, 0x86, 0x87, 0xf6, 0x86, 0x82, 0xe7, 0xbc, 0x20, 0x29, 0xa6, 0x23, 0x2a, 0x28,
+	0x1c, 0x6d, 0xea, 0x6a, 0xa5, 0x43, 0x10, 0x6f, 0x26, 0xf1, 0x26, 0x65, 0x1c, 0xa3, 0xc6, 0x90,
+	0x24, 0x91, 0xd9, 0x84, 0xba, 0x96, 0xdb, 0x82, 0x3a, 0x15, 0x63, 0xf5, 0xba, 0xfe, 0x74, 0x6e,
+	0xd5, 0x66, 0x80, 0x9f, 0xb9, 0xa5, 0x3d, 0x2c, 0x2c, 0xed, 0x09, 0xf0, 0x02, 0x98, 0x02, 0x66,
+	0x80, 0x0f, 0xc0, 0xd7, 0x02, 0xf2, 0x60, 0x1f, 0x3f, 0xad, 0xda, 0x14, 0x30, 0x03, 0xdc, 0x1e,
+	0x14, 0xf7, 0x70, 0x4c, 0x76, 0x9d, 0x11, 0x21, 0x3d, 0xce, 0xa4, 0x20, 0xa1, 0x2c, 0x82, 0xa6,
+	0xfa, 0xa7, 0xb3, 0x5f, 0x04, 0x28, 0x21, 0x9e, 0xf4, 0x01, 0x00, 0x00,
+}
+
+func (this *InsuranceClaim) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InsuranceClaim)
+	if !ok {
+		that2, ok := that.(InsuranceClaim)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Claimant, that1.Claimant) {
+		return false
+	}
+	if !bytes.Equal(this.Operator, that1.Operator) {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.Value, that1.Value) {
+			return false
+		}
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if !bytes.Equal(this.ProposalReference, that1.ProposalReference) {
+		return false
+	}
+	if this.Paid != that1.Paid {
+		return false
+	}
+	return true
+}
+func (this *InsuranceClaim) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&systemSmartContracts.InsuranceClaim{")
+	s = append(s, "Claimant: "+fmt.Sprintf("%#v", this.Claimant)+",\n")
+	s = append(s, "Operator: "+fmt.Sprintf("%#v", this.Operator)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "ProposalReference: "+fmt.Sprintf("%#v", this.ProposalReference)+",\n")
+	s = append(s, "Paid: "+fmt.Sprintf("%#v", this.Paid)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringInsuranceFund(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *InsuranceClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paid {
+		i--
+		if m.Paid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ProposalReference) > 0 {
+		i -= len(m.ProposalReference)
+		copy(dAtA[i:], m.ProposalReference)
+		i = encodeVarintInsuranceFund(dAtA, i, uint64(len(m.ProposalReference)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintInsuranceFund(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.Value)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Value, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInsuranceFund(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintInsuranceFund(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintInsuranceFund(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInsuranceFund(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInsuranceFund(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InsuranceClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovInsuranceFund(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovInsuranceFund(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.Value)
+		n += 1 + l + sovInsuranceFund(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovInsuranceFund(uint64(m.Epoch))
+	}
+	l = len(m.ProposalReference)
+	if l > 0 {
+		n += 1 + l + sovInsuranceFund(uint64(l))
+	}
+	if m.Paid {
+		n += 2
+	}
+	return n
+}
+
+func sovInsuranceFund(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInsuranceFund(x uint64) (n int) {
+	return sovInsuranceFund(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *InsuranceClaim) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InsuranceClaim{`,
+		`Claimant:` + fmt.Sprintf("%v", this.Claimant) + `,`,
+		`Operator:` + fmt.Sprintf("%v", this.Operator) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`ProposalReference:` + fmt.Sprintf("%v", this.ProposalReference) + `,`,
+		`Paid:` + fmt.Sprintf("%v", this.Paid) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringInsuranceFund(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *InsuranceClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsuranceFund
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsuranceFund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimant = append(m.Claimant[:0], dAtA[iNdEx:postIndex]...)
+			if m.Claimant == nil {
+				m.Claimant = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsuranceFund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = append(m.Operator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Operator == nil {
+				m.Operator = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsuranceFund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Value = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsuranceFund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalReference", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsuranceFund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalReference = append(m.ProposalReference[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalReference == nil {
+				m.ProposalReference = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsuranceFund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paid = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsuranceFund(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthInsuranceFund
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInsuranceFund(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInsuranceFund
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInsuranceFund
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInsuranceFund
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInsuranceFund
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInsuranceFund
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInsuranceFund
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInsuranceFund        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInsuranceFund          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInsuranceFund = fmt.Errorf("proto: unexpected end of group")
+)
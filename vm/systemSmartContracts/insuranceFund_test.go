@@ -0,0 +1,435 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockInsuranceFundArgs() ArgsNewInsuranceFundSmartContract {
+	return ArgsNewInsuranceFundSmartContract{
+		Eei:                 &mock.SystemEIStub{},
+		GasCost:             vm.GasCost{},
+		InsuranceFundConfig: config.InsuranceFundSystemSCConfig{},
+		Marshalizer:         &mock.MarshalizerMock{},
+		Hasher:              &mock.HasherMock{},
+		EndOfEpochAddress:   vm.EndOfEpochAddress,
+		EpochNotifier:       &mock.EpochNotifierStub{},
+	}
+}
+
+type insuranceFundEEIContext struct {
+	storage         map[string][]byte
+	governance      map[string][]byte
+	slashing        map[string][]byte
+	accounts        map[string]map[string][]byte
+	balance         *big.Int
+	transferred     map[string]*big.Int
+	returnMessages  []string
+	output          [][]byte
+	badRatingBLSKey []byte
+}
+
+func createInsuranceFundEEIStub(ctx *insuranceFundEEIContext) *mock.SystemEIStub {
+	return &mock.SystemEIStub{
+		GetStorageCalled: func(key []byte) []byte {
+			return ctx.storage[string(key)]
+		},
+		SetStorageCalled: func(key []byte, value []byte) {
+			ctx.storage[string(key)] = value
+		},
+		GetStorageFromAddressCalled: func(address []byte, key []byte) []byte {
+			if bytes.Equal(address, vm.GovernanceSCAddress) {
+				return ctx.governance[string(key)]
+			}
+			if bytes.Equal(address, vm.SlashingSCAddress) {
+				return ctx.slashing[string(key)]
+			}
+			return ctx.accounts[string(address)][string(key)]
+		},
+		GetBalanceCalled: func(_ []byte) *big.Int {
+			return ctx.balance
+		},
+		TransferCalled: func(destination []byte, _ []byte, value *big.Int, _ []byte) error {
+			ctx.balance = big.NewInt(0).Sub(ctx.balance, value)
+			ctx.transferred[string(destination)] = value
+			return nil
+		},
+		IsBadRatingCalled: func(blsKey []byte) bool {
+			return bytes.Equal(blsKey, ctx.badRatingBLSKey)
+		},
+		BlockChainHookCalled: func() vm.BlockchainHook {
+			return &mock.BlockChainHookStub{
+				CurrentEpochCalled: func() uint32 {
+					return 7
+				},
+			}
+		},
+		AddReturnMessageCalled: func(msg string) {
+			ctx.returnMessages = append(ctx.returnMessages, msg)
+		},
+		FinishCalled: func(value []byte) {
+			ctx.output = append(ctx.output, value)
+		},
+	}
+}
+
+func createInsuranceFundEEIContext() *insuranceFundEEIContext {
+	return &insuranceFundEEIContext{
+		storage:        make(map[string][]byte),
+		governance:     make(map[string][]byte),
+		slashing:       make(map[string][]byte),
+		accounts:       make(map[string]map[string][]byte),
+		balance:        big.NewInt(0),
+		transferred:    make(map[string]*big.Int),
+		returnMessages: make([]string, 0),
+		output:         make([][]byte, 0),
+	}
+}
+
+func createEnabledInsuranceFundSC(t *testing.T, ctx *insuranceFundEEIContext) *insuranceFundSC {
+	args := createMockInsuranceFundArgs()
+	args.Eei = createInsuranceFundEEIStub(ctx)
+	sc, err := NewInsuranceFundSmartContract(args)
+	require.Nil(t, err)
+	sc.EpochConfirmed(0)
+
+	return sc
+}
+
+func saveAccountData(t *testing.T, ctx *insuranceFundEEIContext, address []byte, key []byte, value interface{}) {
+	marshaledData, err := (&mock.MarshalizerMock{}).Marshal(value)
+	require.Nil(t, err)
+	if ctx.accounts[string(address)] == nil {
+		ctx.accounts[string(address)] = make(map[string][]byte)
+	}
+	ctx.accounts[string(address)][string(key)] = marshaledData
+}
+
+// saveDelegation records the operator as staked by the delegation contract and the active stake of the delegator in it
+func saveDelegation(t *testing.T, ctx *insuranceFundEEIContext, operator string, delegationSC string, delegator string, value int64) {
+	saveAccountData(t, ctx, vm.StakingSCAddress, []byte(operator), &StakedDataV2_0{OwnerAddress: []byte(delegationSC)})
+
+	contractList := &DelegationContractList{}
+	marshaledList := ctx.accounts[string(vm.DelegationManagerSCAddress)][delegationContractsList]
+	if len(marshaledList) > 0 {
+		require.Nil(t, (&mock.MarshalizerMock{}).Unmarshal(contractList, marshaledList))
+	}
+	contractList.Addresses = append(contractList.Addresses, []byte(delegationSC))
+	saveAccountData(t, ctx, vm.DelegationManagerSCAddress, []byte(delegationContractsList), contractList)
+
+	fundKey := []byte(fundKeyPrefix + delegator)
+	saveAccountData(t, ctx, []byte(delegationSC), []byte(delegator), &DelegatorData{ActiveFund: fundKey})
+	saveAccountData(t, ctx, []byte(delegationSC), fundKey, &Fund{Value: big.NewInt(value)})
+}
+
+func getClaimCommitment(t *testing.T, sc *insuranceFundSC, claimID []byte) []byte {
+	claim, err := sc.getClaimByID(claimID)
+	require.Nil(t, err)
+
+	return sc.computeClaimCommitment(claimID, claim)
+}
+
+func saveGovernanceProposal(t *testing.T, ctx *insuranceFundEEIContext, reference []byte, proposal *GeneralProposal) {
+	marshaledData, err := (&mock.MarshalizerMock{}).Marshal(proposal)
+	require.Nil(t, err)
+	ctx.governance[proposalPrefix+string(reference)] = marshaledData
+}
+
+func TestNewInsuranceFundSmartContract_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockInsuranceFundArgs()
+	args.Eei = nil
+	sc, err := NewInsuranceFundSmartContract(args)
+	assert.True(t, check.IfNil(sc))
+	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
+
+	args = createMockInsuranceFundArgs()
+	args.Marshalizer = nil
+	sc, err = NewInsuranceFundSmartContract(args)
+	assert.True(t, check.IfNil(sc))
+	assert.Equal(t, vm.ErrNilMarshalizer, err)
+
+	args = createMockInsuranceFundArgs()
+	args.Hasher = nil
+	sc, err = NewInsuranceFundSmartContract(args)
+	assert.True(t, check.IfNil(sc))
+	assert.Equal(t, vm.ErrNilHasher, err)
+
+	args = createMockInsuranceFundArgs()
+	args.EndOfEpochAddress = nil
+	sc, err = NewInsuranceFundSmartContract(args)
+	assert.True(t, check.IfNil(sc))
+	assert.Equal(t, vm.ErrInvalidEndOfEpochAccessAddress, err)
+
+	args = createMockInsuranceFundArgs()
+	args.EpochNotifier = nil
+	sc, err = NewInsuranceFundSmartContract(args)
+	assert.True(t, check.IfNil(sc))
+	assert.Equal(t, vm.ErrNilEpochNotifier, err)
+}
+
+func TestInsuranceFundSC_ExecuteDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctx := createInsuranceFundEEIContext()
+	args := createMockInsuranceFundArgs()
+	args.InsuranceFundConfig.EnabledEpoch = 5
+	args.Eei = createInsuranceFundEEIStub(ctx)
+	sc, _ := NewInsuranceFundSmartContract(args)
+	sc.EpochConfirmed(4)
+
+	callInput := createVMInput(big.NewInt(0), "getTotalCollected", []byte("caller"), vm.InsuranceFundSCAddress)
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+	assert.Equal(t, []string{"insurance fund SC disabled"}, ctx.returnMessages)
+}
+
+func TestInsuranceFundSC_UpdateRewards(t *testing.T) {
+	t.Parallel()
+
+	ctx := createInsuranceFundEEIContext()
+	sc := createEnabledInsuranceFundSC(t, ctx)
+
+	callInput := createVMInput(big.NewInt(10), "updateRewards", []byte("caller"), vm.InsuranceFundSCAddress)
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+
+	callInput = createVMInput(big.NewInt(10), "updateRewards", vm.EndOfEpochAddress, vm.InsuranceFundSCAddress)
+	assert.Equal(t, vmcommon.Ok, sc.Execute(callInput))
+	callInput = createVMInput(big.NewInt(15), "updateRewards", vm.EndOfEpochAddress, vm.InsuranceFundSCAddress)
+	assert.Equal(t, vmcommon.Ok, sc.Execute(callInput))
+
+	callInput = createVMInput(big.NewInt(0), "getTotalCollected", []byte("caller"), vm.InsuranceFundSCAddress)
+	assert.Equal(t, vmcommon.Ok, sc.Execute(callInput))
+	assert.Equal(t, [][]byte{[]byte("25")}, ctx.output)
+}
+
+func TestInsuranceFundSC_SubmitClaimOperatorNotPenalizedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctx := createInsuranceFundEEIContext()
+	sc := createEnabledInsuranceFundSC(t, ctx)
+
+	callInput := createVMInput(big.NewInt(0), "submitClaim", []byte("delegator"), vm.InsuranceFundSCAddress)
+	callInput.Arguments = [][]byte{[]byte("blsKey"), big.NewInt(100).Bytes()}
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+	assert.Equal(t, []string{"operator was neither slashed nor penalized"}, ctx.returnMessages)
+}
+
+func TestInsuranceFundSC_SubmitClaimShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ctx := createInsuranceFundEEIContext()
+	ctx.slashing[numEquivocationsPrefix+"slashedKey"] = big.NewInt(1).Bytes()
+	ctx.badRatingBLSKey = []byte("offlineKey")
+	saveDelegation(t, ctx, "slashedKey", "delegationSC1", "delegator1", 100)
+	saveDelegation(t, ctx, "offlineKey", "delegationSC2", "delegator2", 80)
+	sc := createEnabledInsuranceFundSC(t, ctx)
+
+	callInput := createVMInput(big.NewInt(0), "submitClaim", []byte("delegator1"), vm.InsuranceFundSCAddress)
+	callInput.Arguments = [][]byte{[]byte("slashedKey"), big.NewInt(100).Bytes()}
+	assert.Equal(t, vmcommon.Ok, sc.Execute(callInput))
+
+	callInput = createVMInput(big.NewInt(0), "submitClaim", []byte("delegator2"), vm.InsuranceFundSCAddress)
+	callInput.Arguments = [][]byte{[]byte("offlineKey"), big.NewInt(50).Bytes()}
+	assert.Equal(t, vmcommon.Ok, sc.Execute(callInput))
+	assert.Equal(t, [][]byte{{1}, {2}}, ctx.output)
+
+	claim, err := sc.getClaimByID([]byte{2})
+	require.Nil(t, err)
+	assert.Equal(t, []byte("delegator2"), claim.Claimant)
+	assert.Equal(t, []byte("offlineKey"), claim.Operator)
+	assert.Equal(t, big.NewInt(50), claim.Value)
+	assert.Equal(t, uint32(7), claim.Epoch)
+	assert.False(t, claim.Paid)
+}
+
+func TestInsuranceFundSC_ApproveClaimProposalNotPassedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctx := createInsuranceFundEEIContext()
+	ctx.balance = big.NewInt(1000)
+	ctx.badRatingBLSKey = []byte("offlineKey")
+	saveDelegation(t, ctx, "offlineKey", "delegationSC", "delegator", 100)
+	sc := createEnabledInsuranceFundSC(t, ctx)
+
+	callInput := createVMInput(big.NewInt(0), "submitClaim", []byte("delegator"), vm.InsuranceFundSCAddress)
+	callInput.Arguments = [][]byte{[]byte("offlineKey"), big.NewInt(100).Bytes()}
+	require.Equal(t, vmcommon.Ok, sc.Execute(callInput))
+	commitment := getClaimCommitment(t, sc, []byte{1})
+
+	callInput = createVMInput(big.NewInt(0), "approveClaim", []byte("caller"), vm.InsuranceFundSCAddress)
+	callInput.Arguments = [][]byte{{1}, commitment}
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+
+	saveGovernanceProposal(t, ctx, commitment, &GeneralProposal{Voted: true})
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+
+	saveGovernanceProposal(t, ctx, commitment, &GeneralProposal{Closed: true})
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+
+	assert.Equal(t, 0, len(ctx.transferred))
+}
+
+func TestInsuranceFundSC_ApproveClaimShouldPayOnce(t *testing.T) {
+	t.Parallel()
+
+	ctx := createInsuranceFundEEIContext()
+	ctx.balance = big.NewInt(120)
+	ctx.badRatingBLSKey = []byte("offlineKey")
+	sc := createEnabledInsuranceFundSC(t, ctx)
+
+	commitments := make([][]byte, 0)
+	for idx, claimant := range []string{"delegator1", "delegator2", "delegator3"} {
+		saveDelegation(t, ctx, "offlineKey", "delegationSC", claimant, 100)
+		callInput := createVMInput(big.NewInt(0), "submitClaim", []byte(claimant), vm.InsuranceFundSCAddress)
+		callInput.Arguments = [][]byte{[]byte("offlineKey"), big.NewInt(100).Bytes()}
+		require.Equal(t, vmcommon.Ok, sc.Execute(callInput))
+
+		commitment := getClaimCommitment(t, sc, []byte{byte(idx + 1)})
+		saveGovernanceProposal(t, ctx, commitment, &GeneralProposal{Closed: true, Voted: true})
+		commitments = append(commitments, commitment)
+	}
+
+	callInput := createVMInput(big.NewInt(0), "approveClaim", []byte("caller"), vm.InsuranceFundSCAddress)
+	callInput.Arguments = [][]byte{{1}, commitments[0]}
+	assert.Equal(t, vmcommon.Ok, sc.Execute(callInput))
+	assert.Equal(t, big.NewInt(100), ctx.transferred["delegator1"])
+	assert.Equal(t, big.NewInt(20), ctx.balance)
+
+	claim, err := sc.getClaimByID([]byte{1})
+	require.Nil(t, err)
+	assert.True(t, claim.Paid)
+	assert.Equal(t, commitments[0], claim.ProposalReference)
+
+	// the same claim can not be paid twice
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+
+	// not enough funds left
+	callInput.Arguments = [][]byte{{3}, commitments[2]}
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+	assert.Equal(t, 1, len(ctx.transferred))
+}
+
+func TestInsuranceFundSC_ApproveClaimWithForeignProposalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctx := createInsuranceFundEEIContext()
+	ctx.balance = big.NewInt(1000)
+	ctx.badRatingBLSKey = []byte("offlineKey")
+	saveDelegation(t, ctx, "offlineKey", "delegationSC", "delegator", 100)
+	saveDelegation(t, ctx, "offlineKey", "delegationSC", "attacker", 100)
+	sc := createEnabledInsuranceFundSC(t, ctx)
+
+	callInput := createVMInput(big.NewInt(0), "submitClaim", []byte("delegator"), vm.InsuranceFundSCAddress)
+	callInput.Arguments = [][]byte{[]byte("offlineKey"), big.NewInt(10).Bytes()}
+	require.Equal(t, vmcommon.Ok, sc.Execute(callInput))
+	callInput = createVMInput(big.NewInt(0), "submitClaim", []byte("attacker"), vm.InsuranceFundSCAddress)
+	callInput.Arguments = [][]byte{[]byte("offlineKey"), big.NewInt(100).Bytes()}
+	require.Equal(t, vmcommon.Ok, sc.Execute(callInput))
+
+	// a passed proposal unrelated to any claim
+	saveGovernanceProposal(t, ctx, []byte("passed"), &GeneralProposal{Closed: true, Voted: true})
+	callInput = createVMInput(big.NewInt(0), "approveClaim", []byte("attacker"), vm.InsuranceFundSCAddress)
+	callInput.Arguments = [][]byte{{2}, []byte("passed")}
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+
+	// the proposal approving the claim of the delegator can not approve the claim of the attacker
+	commitment := getClaimCommitment(t, sc, []byte{1})
+	saveGovernanceProposal(t, ctx, commitment, &GeneralProposal{Closed: true, Voted: true})
+	callInput.Arguments = [][]byte{{2}, commitment}
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+	assert.Equal(t, []string{"proposal does not commit to the claim", "proposal does not commit to the claim"}, ctx.returnMessages)
+	assert.Equal(t, 0, len(ctx.transferred))
+
+	callInput.Arguments = [][]byte{{1}, commitment}
+	assert.Equal(t, vmcommon.Ok, sc.Execute(callInput))
+	assert.Equal(t, map[string]*big.Int{"delegator": big.NewInt(10)}, ctx.transferred)
+}
+
+func TestInsuranceFundSC_SubmitClaimNotDelegatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctx := createInsuranceFundEEIContext()
+	ctx.badRatingBLSKey = []byte("offlineKey")
+	saveDelegation(t, ctx, "offlineKey", "delegationSC", "delegator", 100)
+	sc := createEnabledInsuranceFundSC(t, ctx)
+
+	callInput := createVMInput(big.NewInt(0), "submitClaim", []byte("attacker"), vm.InsuranceFundSCAddress)
+	callInput.Arguments = [][]byte{[]byte("offlineKey"), big.NewInt(100).Bytes()}
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+
+	// the operator is staked directly by its owner, not by a delegation contract
+	ctx.badRatingBLSKey = []byte("ownKey")
+	saveAccountData(t, ctx, vm.StakingSCAddress, []byte("ownKey"), &StakedDataV2_0{OwnerAddress: []byte("owner")})
+	saveAccountData(t, ctx, []byte("owner"), []byte("attacker"), &DelegatorData{ActiveFund: []byte("fund")})
+	saveAccountData(t, ctx, []byte("owner"), []byte("fund"), &Fund{Value: big.NewInt(100)})
+	callInput.Arguments = [][]byte{[]byte("ownKey"), big.NewInt(100).Bytes()}
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+
+	assert.Equal(t, []string{vm.ErrNotDelegatorOfOperator.Error(), vm.ErrOperatorNotStakedByDelegation.Error()}, ctx.returnMessages)
+	assert.Equal(t, 0, len(ctx.output))
+}
+
+func TestInsuranceFundSC_SubmitClaimAboveTheDelegatedStakeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctx := createInsuranceFundEEIContext()
+	ctx.badRatingBLSKey = []byte("offlineKey")
+	saveDelegation(t, ctx, "offlineKey", "delegationSC", "delegator", 100)
+	sc := createEnabledInsuranceFundSC(t, ctx)
+
+	callInput := createVMInput(big.NewInt(0), "submitClaim", []byte("delegator"), vm.InsuranceFundSCAddress)
+	callInput.Arguments = [][]byte{[]byte("offlineKey"), big.NewInt(101).Bytes()}
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+
+	callInput.Arguments = [][]byte{[]byte("offlineKey"), big.NewInt(60).Bytes()}
+	assert.Equal(t, vmcommon.Ok, sc.Execute(callInput))
+
+	// the claims of the delegator for the operator are summed
+	callInput.Arguments = [][]byte{[]byte("offlineKey"), big.NewInt(50).Bytes()}
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+
+	callInput.Arguments = [][]byte{[]byte("offlineKey"), big.NewInt(40).Bytes()}
+	assert.Equal(t, vmcommon.Ok, sc.Execute(callInput))
+	assert.Equal(t, [][]byte{{1}, {2}}, ctx.output)
+}
+
+func TestInsuranceFundSC_GetClaimNotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctx := createInsuranceFundEEIContext()
+	sc := createEnabledInsuranceFundSC(t, ctx)
+
+	callInput := createVMInput(big.NewInt(0), "getClaim", []byte("caller"), vm.InsuranceFundSCAddress)
+	callInput.Arguments = [][]byte{{1}}
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+	assert.Equal(t, 0, len(ctx.output))
+}
+
+func TestInsuranceFundSC_NotEnoughGasShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctx := createInsuranceFundEEIContext()
+	eei := createInsuranceFundEEIStub(ctx)
+	eei.UseGasCalled = func(_ uint64) error {
+		return errors.New("out of gas")
+	}
+	args := createMockInsuranceFundArgs()
+	args.Eei = eei
+	sc, _ := NewInsuranceFundSmartContract(args)
+	sc.EpochConfirmed(0)
+
+	callInput := createVMInput(big.NewInt(0), "submitClaim", []byte("delegator"), vm.InsuranceFundSCAddress)
+	callInput.Arguments = [][]byte{[]byte("blsKey"), big.NewInt(100).Bytes()}
+	assert.Equal(t, vmcommon.OutOfGas, sc.Execute(callInput))
+}
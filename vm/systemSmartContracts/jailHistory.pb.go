@@ -0,0 +1,440 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: jailHistory.proto
+
+package systemSmartContracts
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type JailHistory struct {
+	JailEpochs []uint32 `protobuf:"varint,1,rep,packed,name=JailEpochs,proto3" json:"JailEpochs"`
+}
+
+func (m *JailHistory) Reset()      { *m = JailHistory{} }
+func (*JailHistory) ProtoMessage() {}
+func (*JailHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb0111e981d28d66, []int{0}
+}
+func (m *JailHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JailHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JailHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JailHistory.Merge(m, src)
+}
+func (m *JailHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *JailHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_JailHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JailHistory proto.InternalMessageInfo
+
+func (m *JailHistory) GetJailEpochs() []uint32 {
+	if m != nil {
+		return m.JailEpochs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*JailHistory)(nil), "proto.JailHistory")
+}
+
+func init() { proto.RegisterFile("jailHistory.proto", fileDescriptor_cb0111e981d28d66) }
+
+var fileDescriptor_cb0111e981d28d66 = []byte{
+	// 180 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xe3, 0x12, 0xcc, 0x4a, 0xcc, 0xcc,
+	0xf1, 0xc8, 0x2c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x05,
+	0x53, 0x52, 0xba, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xe9, 0xf9,
+	0xe9, 0xf9, 0xfa, 0x60, 0xe1, 0xa4, 0xd2, 0x34, 0x30, 0x0f, 0xcc, 0x01, 0xb3, 0x20, 0xba, 0x94,
+	0x6c, 0xb9, 0xb8, 0xbd, 0x10, 0x46, 0x09, 0xe9, 0x71, 0x71, 0x81, 0xb8, 0xae, 0x05, 0xf9, 0xc9,
+	0x19, 0xc5, 0x12, 0x8c, 0x0a, 0xcc, 0x1a, 0xbc, 0x4e, 0x7c, 0xaf, 0xee, 0xc9, 0x23, 0x89, 0x06,
+	0x21, 0xb1, 0x9d, 0xfc, 0x2e, 0x3c, 0x94, 0x63, 0xb8, 0x01, 0xc4, 0x1f, 0x1e, 0xca, 0x31, 0x36,
+	0x3c, 0x92, 0x63, 0x5c, 0x01, 0xc4, 0x27, 0x80, 0xf8, 0x02, 0x10, 0xdf, 0x00, 0xe2, 0x07, 0x40,
+	0xfc, 0xe2, 0x11, 0x50, 0x1e, 0x48, 0x4f, 0x78, 0x2c, 0xc7, 0x70, 0x01, 0x88, 0x6f, 0x00, 0x71,
+	0x94, 0x48, 0x71, 0x65, 0x71, 0x49, 0x6a, 0x6e, 0x70, 0x6e, 0x62, 0x51, 0x89, 0x73, 0x7e, 0x5e,
+	0x49, 0x51, 0x62, 0x72, 0x49, 0x71, 0x12, 0x1b, 0xd8, 0x55, 0xc6, 0x00, 0xc2, 0x7a, 0x5d, 0x61,
+	0xe0, 0x00, 0x00, 0x00,
+}
+
+func (this *JailHistory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JailHistory)
+	if !ok {
+		that2, ok := that.(JailHistory)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.JailEpochs) != len(that1.JailEpochs) {
+		return false
+	}
+	for i := range this.JailEpochs {
+		if this.JailEpochs[i] != that1.JailEpochs[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *JailHistory) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&systemSmartContracts.JailHistory{")
+	s = append(s, "JailEpochs: "+fmt.Sprintf("%#v", this.JailEpochs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringJailHistory(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *JailHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JailHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JailHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JailEpochs) > 0 {
+		dAtA2 := make([]byte, len(m.JailEpochs)*10)
+		var j1 int
+		for _, num := range m.JailEpochs {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintJailHistory(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintJailHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovJailHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *JailHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JailEpochs) > 0 {
+		l = 0
+		for _, e := range m.JailEpochs {
+			l += sovJailHistory(uint64(e))
+		}
+		n += 1 + sovJailHistory(uint64(l)) + l
+	}
+	return n
+}
+
+func sovJailHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozJailHistory(x uint64) (n int) {
+	return sovJailHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *JailHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JailHistory{`,
+		`JailEpochs:` + fmt.Sprintf("%v", this.JailEpochs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringJailHistory(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *JailHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJailHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JailHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JailHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowJailHistory
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.JailEpochs = append(m.JailEpochs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowJailHistory
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthJailHistory
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthJailHistory
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.JailEpochs) == 0 {
+					m.JailEpochs = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowJailHistory
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.JailEpochs = append(m.JailEpochs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailEpochs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJailHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJailHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthJailHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipJailHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowJailHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowJailHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowJailHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthJailHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupJailHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthJailHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthJailHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowJailHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupJailHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,252 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+const mintablePrefix = "mintable"
+const mintedInEpochPrefix = "mintedInEpoch"
+const totalMintedKey = "totalMinted"
+
+// ArgsNewMintingSmartContract defines the arguments needed for the minting smart contract
+type ArgsNewMintingSmartContract struct {
+	Eei                vm.SystemEI
+	GasCost            vm.GasCost
+	GenesisTotalSupply *big.Int
+	MintingConfig      config.MintingSystemSCConfig
+}
+
+// mintingSC allows the genesis accounts flagged as mintable to top up their balances on private networks. The value
+// minted by all the mintable accounts in an epoch is bounded by the configured inflation per epoch, applied on the
+// genesis total supply as the protocol inflation is. As the smart contracts can not create value, the minted values
+// are paid from the reserve credited to the contract at genesis, so the minting stops when the reserve is spent.
+// The mintable accounts are registered by the genesis block creator and can not be changed afterwards
+type mintingSC struct {
+	eei             vm.SystemEI
+	gasCost         vm.GasCost
+	enabled         bool
+	maxMintPerEpoch *big.Int
+	mutExecution    sync.RWMutex
+}
+
+// NewMintingSmartContract creates a new minting smart contract
+func NewMintingSmartContract(args ArgsNewMintingSmartContract) (*mintingSC, error) {
+	if check.IfNil(args.Eei) {
+		return nil, vm.ErrNilSystemEnvironmentInterface
+	}
+
+	m := &mintingSC{
+		eei:             args.Eei,
+		gasCost:         args.GasCost,
+		enabled:         args.MintingConfig.Enabled,
+		maxMintPerEpoch: big.NewInt(0),
+	}
+	if !m.enabled {
+		return m, nil
+	}
+
+	if args.GenesisTotalSupply == nil || args.GenesisTotalSupply.Sign() <= 0 {
+		return nil, vm.ErrInvalidGenesisTotalSupply
+	}
+	maxInflationPerEpoch := args.MintingConfig.MaxInflationPerEpoch
+	if maxInflationPerEpoch <= 0 || maxInflationPerEpoch > 1 {
+		return nil, fmt.Errorf("%w: %v", vm.ErrInvalidMaxInflationPerEpoch, maxInflationPerEpoch)
+	}
+	m.maxMintPerEpoch = core.GetPercentageOfValue(args.GenesisTotalSupply, maxInflationPerEpoch)
+	if m.maxMintPerEpoch.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: %v of the genesis total supply is 0", vm.ErrInvalidMaxInflationPerEpoch, maxInflationPerEpoch)
+	}
+
+	return m, nil
+}
+
+// Execute calls one of the functions from the minting smart contract and runs the code according to the input
+func (m *mintingSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	m.mutExecution.RLock()
+	defer m.mutExecution.RUnlock()
+	if CheckIfNil(args) != nil {
+		return vmcommon.UserError
+	}
+
+	if args.Function == core.SCDeployInitFunctionName {
+		return vmcommon.Ok
+	}
+	if !m.enabled {
+		m.eei.AddReturnMessage("minting SC disabled")
+		return vmcommon.UserError
+	}
+
+	switch args.Function {
+	case "registerMintable":
+		return m.registerMintable(args)
+	case "mint":
+		return m.mint(args)
+	case "isMintable":
+		return m.isMintable(args)
+	case "getTotalMinted":
+		return m.getTotalMinted(args)
+	}
+
+	m.eei.AddReturnMessage("invalid method to call")
+	return vmcommon.FunctionNotFound
+}
+
+// registerMintable flags the caller as mintable. It can only be called while creating the genesis block
+func (m *mintingSC) registerMintable(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if m.eei.BlockChainHook().CurrentNonce() != 0 {
+		m.eei.AddReturnMessage("mintable accounts can only be registered at genesis")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		m.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 0 {
+		m.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 0, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+
+	m.eei.SetStorage(mintableKey(args.CallerAddr), []byte{1})
+
+	return vmcommon.Ok
+}
+
+// mint expects the value to be transferred from the minting reserve to the caller, which has to be a mintable account.
+// The value minted by all the mintable accounts in the current epoch can not exceed the inflation bound of the epoch
+func (m *mintingSC) mint(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		m.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		m.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 1, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+	err := m.eei.UseGas(m.gasCost.MetaChainSystemSCsCost.MintingOps)
+	if err != nil {
+		m.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	if len(m.eei.GetStorage(mintableKey(args.CallerAddr))) == 0 {
+		m.eei.AddReturnMessage("caller is not a mintable account")
+		return vmcommon.UserError
+	}
+
+	value := big.NewInt(0).SetBytes(args.Arguments[0])
+	if value.Sign() == 0 {
+		m.eei.AddReturnMessage("invalid value to mint")
+		return vmcommon.UserError
+	}
+
+	epochKey := mintedInEpochKey(m.eei.BlockChainHook().CurrentEpoch())
+	mintedInEpoch := big.NewInt(0).SetBytes(m.eei.GetStorage(epochKey))
+	mintedInEpoch.Add(mintedInEpoch, value)
+	if mintedInEpoch.Cmp(m.maxMintPerEpoch) > 0 {
+		m.eei.AddReturnMessage("max inflation per epoch exceeded")
+		return vmcommon.UserError
+	}
+	reserve := m.eei.GetBalance(args.RecipientAddr)
+	if reserve == nil || reserve.Cmp(value) < 0 {
+		m.eei.AddReturnMessage("minting reserve exhausted")
+		return vmcommon.UserError
+	}
+
+	totalMinted := big.NewInt(0).SetBytes(m.eei.GetStorage([]byte(totalMintedKey)))
+	totalMinted.Add(totalMinted, value)
+
+	err = m.eei.Transfer(args.CallerAddr, args.RecipientAddr, value, nil, 0)
+	if err != nil {
+		m.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	m.eei.SetStorage(epochKey, mintedInEpoch.Bytes())
+	m.eei.SetStorage([]byte(totalMintedKey), totalMinted.Bytes())
+
+	return vmcommon.Ok
+}
+
+// isMintable expects an address and returns 1 if it is a mintable account, 0 otherwise
+func (m *mintingSC) isMintable(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := m.checkViewArguments(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	if len(m.eei.GetStorage(mintableKey(args.Arguments[0]))) == 0 {
+		m.eei.Finish(big.NewInt(0).Bytes())
+		return vmcommon.Ok
+	}
+
+	m.eei.Finish(big.NewInt(1).Bytes())
+
+	return vmcommon.Ok
+}
+
+// getTotalMinted returns the total value minted since genesis
+func (m *mintingSC) getTotalMinted(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := m.checkViewArguments(args, 0)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	m.eei.Finish(big.NewInt(0).SetBytes(m.eei.GetStorage([]byte(totalMintedKey))).Bytes())
+
+	return vmcommon.Ok
+}
+
+func (m *mintingSC) checkViewArguments(args *vmcommon.ContractCallInput, numArguments int) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, args.RecipientAddr) {
+		m.eei.AddReturnMessage("this is only a view function")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		m.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != numArguments {
+		m.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", numArguments, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+	err := m.eei.UseGas(m.gasCost.MetaChainSystemSCsCost.Get)
+	if err != nil {
+		m.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+
+	return vmcommon.Ok
+}
+
+func mintableKey(address []byte) []byte {
+	return append([]byte(mintablePrefix), address...)
+}
+
+func mintedInEpochKey(epoch uint32) []byte {
+	return append([]byte(mintedInEpochPrefix), big.NewInt(int64(epoch)).Bytes()...)
+}
+
+// CanUseContract returns true if contract is enabled
+func (m *mintingSC) CanUseContract() bool {
+	return m.enabled
+}
+
+// SetNewGasCost is called whenever a gas cost was changed
+func (m *mintingSC) SetNewGasCost(gasCost vm.GasCost) {
+	m.mutExecution.Lock()
+	m.gasCost = gasCost
+	m.mutExecution.Unlock()
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (m *mintingSC) IsInterfaceNil() bool {
+	return m == nil
+}
@@ -0,0 +1,192 @@
+package systemSmartContracts
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgumentsForMinting(eei vm.SystemEI) ArgsNewMintingSmartContract {
+	return ArgsNewMintingSmartContract{
+		Eei:                eei,
+		GenesisTotalSupply: big.NewInt(1000),
+		MintingConfig: config.MintingSystemSCConfig{
+			Enabled:              true,
+			MintingReserve:       "150",
+			MaxInflationPerEpoch: 0.1,
+		},
+	}
+}
+
+func createMintInput(caller []byte, value int64) *vmcommon.ContractCallInput {
+	callInput := createVMInput(big.NewInt(0), "mint", caller, vm.MintingSCAddress)
+	callInput.Arguments = [][]byte{big.NewInt(value).Bytes()}
+
+	return callInput
+}
+
+func TestNewMintingSmartContract(t *testing.T) {
+	t.Parallel()
+
+	sc, err := NewMintingSmartContract(ArgsNewMintingSmartContract{})
+	assert.True(t, check.IfNil(sc))
+	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
+
+	args := createMockArgumentsForMinting(&mock.SystemEIStub{})
+	args.GenesisTotalSupply = nil
+	sc, err = NewMintingSmartContract(args)
+	assert.True(t, check.IfNil(sc))
+	assert.Equal(t, vm.ErrInvalidGenesisTotalSupply, err)
+
+	args = createMockArgumentsForMinting(&mock.SystemEIStub{})
+	args.MintingConfig.MaxInflationPerEpoch = 0
+	sc, err = NewMintingSmartContract(args)
+	assert.True(t, check.IfNil(sc))
+	assert.True(t, errors.Is(err, vm.ErrInvalidMaxInflationPerEpoch))
+
+	args = createMockArgumentsForMinting(&mock.SystemEIStub{})
+	args.MintingConfig.MaxInflationPerEpoch = 1.5
+	sc, err = NewMintingSmartContract(args)
+	assert.True(t, check.IfNil(sc))
+	assert.True(t, errors.Is(err, vm.ErrInvalidMaxInflationPerEpoch))
+
+	args = createMockArgumentsForMinting(&mock.SystemEIStub{})
+	args.MintingConfig.MaxInflationPerEpoch = 0.0001
+	sc, err = NewMintingSmartContract(args)
+	assert.True(t, check.IfNil(sc))
+	assert.True(t, errors.Is(err, vm.ErrInvalidMaxInflationPerEpoch))
+
+	args = createMockArgumentsForMinting(&mock.SystemEIStub{})
+	args.MintingConfig = config.MintingSystemSCConfig{}
+	sc, err = NewMintingSmartContract(args)
+	require.Nil(t, err)
+	assert.False(t, sc.CanUseContract())
+
+	sc, err = NewMintingSmartContract(createMockArgumentsForMinting(&mock.SystemEIStub{}))
+	require.Nil(t, err)
+	assert.True(t, sc.CanUseContract())
+}
+
+func TestMintingSC_ExecuteDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	returnMessages := make([]string, 0)
+	eei := createVestingEEIStub(&mock.BlockChainHookStub{}, make(map[string][]byte), &returnMessages, &[][]byte{})
+	args := createMockArgumentsForMinting(eei)
+	args.MintingConfig.Enabled = false
+	sc, _ := NewMintingSmartContract(args)
+
+	retCode := sc.Execute(createVMInput(big.NewInt(0), "registerMintable", []byte("account"), vm.MintingSCAddress))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, []string{"minting SC disabled"}, returnMessages)
+}
+
+func TestMintingSC_RegisterMintableAfterGenesisShouldErr(t *testing.T) {
+	t.Parallel()
+
+	returnMessages := make([]string, 0)
+	storage := make(map[string][]byte)
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return 1
+		},
+	}
+	sc, _ := NewMintingSmartContract(createMockArgumentsForMinting(
+		createVestingEEIStub(blockChainHook, storage, &returnMessages, &[][]byte{}),
+	))
+
+	retCode := sc.Execute(createVMInput(big.NewInt(0), "registerMintable", []byte("account"), vm.MintingSCAddress))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, []string{"mintable accounts can only be registered at genesis"}, returnMessages)
+	assert.Equal(t, 0, len(storage))
+}
+
+func TestMintingSC_MintNotMintableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	returnMessages := make([]string, 0)
+	sc, _ := NewMintingSmartContract(createMockArgumentsForMinting(
+		createVestingEEIStub(&mock.BlockChainHookStub{}, make(map[string][]byte), &returnMessages, &[][]byte{}),
+	))
+
+	retCode := sc.Execute(createMintInput([]byte("account"), 10))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, []string{"caller is not a mintable account"}, returnMessages)
+}
+
+func TestMintingSC_MintShouldRespectTheBounds(t *testing.T) {
+	t.Parallel()
+
+	account := []byte("account")
+	otherAccount := []byte("other account")
+	epoch := uint32(0)
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentEpochCalled: func() uint32 {
+			return epoch
+		},
+	}
+	returnMessages := make([]string, 0)
+	output := make([][]byte, 0)
+	transferred := make(map[string]*big.Int)
+	reserve := big.NewInt(150)
+	eei := createVestingEEIStub(blockChainHook, make(map[string][]byte), &returnMessages, &output)
+	eei.GetBalanceCalled = func(addr []byte) *big.Int {
+		assert.Equal(t, vm.MintingSCAddress, addr)
+		return big.NewInt(0).Set(reserve)
+	}
+	eei.TransferCalled = func(destination []byte, sender []byte, value *big.Int, _ []byte) error {
+		assert.Equal(t, vm.MintingSCAddress, sender)
+		if transferred[string(destination)] == nil {
+			transferred[string(destination)] = big.NewInt(0)
+		}
+		transferred[string(destination)].Add(transferred[string(destination)], value)
+		reserve.Sub(reserve, value)
+		return nil
+	}
+	sc, _ := NewMintingSmartContract(createMockArgumentsForMinting(eei))
+
+	retCode := sc.Execute(createVMInput(big.NewInt(0), "registerMintable", account, vm.MintingSCAddress))
+	require.Equal(t, vmcommon.Ok, retCode)
+	retCode = sc.Execute(createVMInput(big.NewInt(0), "registerMintable", otherAccount, vm.MintingSCAddress))
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	// the inflation bound of an epoch is 10% of the genesis total supply of 1000, shared by all the mintable accounts
+	retCode = sc.Execute(createMintInput(account, 60))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	retCode = sc.Execute(createMintInput(otherAccount, 41))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, []string{"max inflation per epoch exceeded"}, returnMessages)
+	retCode = sc.Execute(createMintInput(otherAccount, 40))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	epoch = 1
+	retCode = sc.Execute(createMintInput(account, 41))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	retCode = sc.Execute(createMintInput(otherAccount, 10))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "minting reserve exhausted", returnMessages[1])
+	retCode = sc.Execute(createMintInput(otherAccount, 9))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	assert.Equal(t, big.NewInt(101), transferred[string(account)])
+	assert.Equal(t, big.NewInt(49), transferred[string(otherAccount)])
+
+	retCode = sc.Execute(createVMInput(big.NewInt(0), "getTotalMinted", vm.MintingSCAddress, vm.MintingSCAddress))
+	assert.Equal(t, vmcommon.Ok, retCode)
+	isMintableInput := createVMInput(big.NewInt(0), "isMintable", vm.MintingSCAddress, vm.MintingSCAddress)
+	isMintableInput.Arguments = [][]byte{account}
+	retCode = sc.Execute(isMintableInput)
+	assert.Equal(t, vmcommon.Ok, retCode)
+	isMintableInput.Arguments = [][]byte{[]byte("not mintable")}
+	retCode = sc.Execute(isMintableInput)
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, [][]byte{big.NewInt(150).Bytes(), big.NewInt(1).Bytes(), big.NewInt(0).Bytes()}, output)
+}
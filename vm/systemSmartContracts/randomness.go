@@ -0,0 +1,138 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+// ArgsNewRandomnessSmartContract defines the arguments needed for the randomness smart contract
+type ArgsNewRandomnessSmartContract struct {
+	Eei              vm.SystemEI
+	GasCost          vm.GasCost
+	RandomnessConfig config.RandomnessSystemSCConfig
+	EpochNotifier    vm.EpochNotifier
+}
+
+// randomnessSC exposes the randomness beacon of the metachain through view functions. The random seed of a block is
+// the leader's signature on the random seed of the previous block, so the random seeds of the committed blocks can
+// not be biased and can be used as a source of randomness
+type randomnessSC struct {
+	eei          vm.SystemEI
+	gasCost      vm.GasCost
+	enabledEpoch uint32
+	flagEnabled  atomic.Flag
+	mutExecution sync.RWMutex
+}
+
+// NewRandomnessSmartContract creates a new randomness smart contract
+func NewRandomnessSmartContract(args ArgsNewRandomnessSmartContract) (*randomnessSC, error) {
+	if check.IfNil(args.Eei) {
+		return nil, vm.ErrNilSystemEnvironmentInterface
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, vm.ErrNilEpochNotifier
+	}
+
+	r := &randomnessSC{
+		eei:          args.Eei,
+		gasCost:      args.GasCost,
+		enabledEpoch: args.RandomnessConfig.EnabledEpoch,
+	}
+	args.EpochNotifier.RegisterNotifyHandler(r)
+
+	return r, nil
+}
+
+// Execute calls one of the functions from the randomness smart contract and runs the code according to the input
+func (r *randomnessSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	r.mutExecution.RLock()
+	defer r.mutExecution.RUnlock()
+	if CheckIfNil(args) != nil {
+		return vmcommon.UserError
+	}
+
+	if args.Function == core.SCDeployInitFunctionName {
+		return vmcommon.Ok
+	}
+
+	if !r.flagEnabled.IsSet() {
+		r.eei.AddReturnMessage("randomness SC disabled")
+		return vmcommon.UserError
+	}
+
+	switch args.Function {
+	case "getRandomSeed":
+		return r.getRandomSeed(args)
+	}
+
+	r.eei.AddReturnMessage("invalid method to call")
+	return vmcommon.FunctionNotFound
+}
+
+// getRandomSeed expects the nonce of a committed metachain block and returns its random seed
+func (r *randomnessSC) getRandomSeed(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, args.RecipientAddr) {
+		r.eei.AddReturnMessage("this is only a view function")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		r.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		r.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 1, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+	err := r.eei.UseGas(r.gasCost.MetaChainSystemSCsCost.Get)
+	if err != nil {
+		r.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+
+	nonce := big.NewInt(0).SetBytes(args.Arguments[0])
+	if !nonce.IsUint64() {
+		r.eei.AddReturnMessage("invalid nonce")
+		return vmcommon.UserError
+	}
+
+	randSeed, err := r.eei.BlockChainHook().GetRandomSeedByNonce(nonce.Uint64())
+	if err != nil {
+		r.eei.AddReturnMessage("can not get the random seed: " + err.Error())
+		return vmcommon.UserError
+	}
+	r.eei.Finish(randSeed)
+
+	return vmcommon.Ok
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (r *randomnessSC) EpochConfirmed(epoch uint32) {
+	r.flagEnabled.Toggle(epoch >= r.enabledEpoch)
+	log.Debug("randomness contract", "enabled", r.flagEnabled.IsSet())
+}
+
+// CanUseContract returns true if contract is enabled
+func (r *randomnessSC) CanUseContract() bool {
+	return true
+}
+
+// SetNewGasCost is called whenever a gas cost was changed
+func (r *randomnessSC) SetNewGasCost(gasCost vm.GasCost) {
+	r.mutExecution.Lock()
+	r.gasCost = gasCost
+	r.mutExecution.Unlock()
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (r *randomnessSC) IsInterfaceNil() bool {
+	return r == nil
+}
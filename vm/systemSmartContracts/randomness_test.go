@@ -0,0 +1,150 @@
+package systemSmartContracts
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockRandomnessArgs() ArgsNewRandomnessSmartContract {
+	return ArgsNewRandomnessSmartContract{
+		Eei:              &mock.SystemEIStub{},
+		GasCost:          vm.GasCost{},
+		RandomnessConfig: config.RandomnessSystemSCConfig{},
+		EpochNotifier:    &mock.EpochNotifierStub{},
+	}
+}
+
+func createRandomnessEEIStub(blockChainHook vm.BlockchainHook, returnMessages *[]string, output *[][]byte) *mock.SystemEIStub {
+	return &mock.SystemEIStub{
+		BlockChainHookCalled: func() vm.BlockchainHook {
+			return blockChainHook
+		},
+		AddReturnMessageCalled: func(msg string) {
+			*returnMessages = append(*returnMessages, msg)
+		},
+		FinishCalled: func(value []byte) {
+			*output = append(*output, value)
+		},
+	}
+}
+
+func TestNewRandomnessSmartContract_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockRandomnessArgs()
+	args.Eei = nil
+	sc, err := NewRandomnessSmartContract(args)
+	assert.True(t, check.IfNil(sc))
+	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
+
+	args = createMockRandomnessArgs()
+	args.EpochNotifier = nil
+	sc, err = NewRandomnessSmartContract(args)
+	assert.True(t, check.IfNil(sc))
+	assert.Equal(t, vm.ErrNilEpochNotifier, err)
+}
+
+func TestRandomnessSC_ExecuteDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	returnMessages := make([]string, 0)
+	args := createMockRandomnessArgs()
+	args.RandomnessConfig.EnabledEpoch = 5
+	args.Eei = createRandomnessEEIStub(&mock.BlockChainHookStub{}, &returnMessages, &[][]byte{})
+	sc, _ := NewRandomnessSmartContract(args)
+	sc.EpochConfirmed(4)
+
+	callInput := createVMInput(big.NewInt(0), "getRandomSeed", vm.RandomnessSCAddress, vm.RandomnessSCAddress)
+	retCode := sc.Execute(callInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, []string{"randomness SC disabled"}, returnMessages)
+}
+
+func TestRandomnessSC_GetRandomSeedShouldBeAViewFunction(t *testing.T) {
+	t.Parallel()
+
+	returnMessages := make([]string, 0)
+	args := createMockRandomnessArgs()
+	args.Eei = createRandomnessEEIStub(&mock.BlockChainHookStub{}, &returnMessages, &[][]byte{})
+	sc, _ := NewRandomnessSmartContract(args)
+	sc.EpochConfirmed(0)
+
+	callInput := createVMInput(big.NewInt(0), "getRandomSeed", []byte("caller"), vm.RandomnessSCAddress)
+	callInput.Arguments = [][]byte{big.NewInt(10).Bytes()}
+	retCode := sc.Execute(callInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, []string{"this is only a view function"}, returnMessages)
+}
+
+func TestRandomnessSC_GetRandomSeedInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockRandomnessArgs()
+	args.Eei = createRandomnessEEIStub(&mock.BlockChainHookStub{}, &[]string{}, &[][]byte{})
+	sc, _ := NewRandomnessSmartContract(args)
+	sc.EpochConfirmed(0)
+
+	callInput := createVMInput(big.NewInt(1), "getRandomSeed", vm.RandomnessSCAddress, vm.RandomnessSCAddress)
+	callInput.Arguments = [][]byte{big.NewInt(10).Bytes()}
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+
+	callInput = createVMInput(big.NewInt(0), "getRandomSeed", vm.RandomnessSCAddress, vm.RandomnessSCAddress)
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+
+	callInput.Arguments = [][]byte{make([]byte, 9)}
+	callInput.Arguments[0][0] = 1
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+}
+
+func TestRandomnessSC_GetRandomSeedHookErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	returnMessages := make([]string, 0)
+	args := createMockRandomnessArgs()
+	blockChainHook := &mock.BlockChainHookStub{
+		GetRandomSeedByNonceCalled: func(nonce uint64) ([]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	args.Eei = createRandomnessEEIStub(blockChainHook, &returnMessages, &[][]byte{})
+	sc, _ := NewRandomnessSmartContract(args)
+	sc.EpochConfirmed(0)
+
+	callInput := createVMInput(big.NewInt(0), "getRandomSeed", vm.RandomnessSCAddress, vm.RandomnessSCAddress)
+	callInput.Arguments = [][]byte{big.NewInt(10).Bytes()}
+	retCode := sc.Execute(callInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, []string{"can not get the random seed: " + expectedErr.Error()}, returnMessages)
+}
+
+func TestRandomnessSC_GetRandomSeedShouldWork(t *testing.T) {
+	t.Parallel()
+
+	randSeed := []byte("rand seed")
+	output := make([][]byte, 0)
+	args := createMockRandomnessArgs()
+	blockChainHook := &mock.BlockChainHookStub{
+		GetRandomSeedByNonceCalled: func(nonce uint64) ([]byte, error) {
+			assert.Equal(t, uint64(10), nonce)
+			return randSeed, nil
+		},
+	}
+	args.Eei = createRandomnessEEIStub(blockChainHook, &[]string{}, &output)
+	sc, _ := NewRandomnessSmartContract(args)
+	sc.EpochConfirmed(0)
+
+	callInput := createVMInput(big.NewInt(0), "getRandomSeed", vm.RandomnessSCAddress, vm.RandomnessSCAddress)
+	callInput.Arguments = [][]byte{big.NewInt(10).Bytes()}
+	retCode := sc.Execute(callInput)
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, [][]byte{randSeed}, output)
+}
@@ -0,0 +1,47 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+var systemAddresses = [][]byte{
+	vm.StakingSCAddress,
+	vm.ValidatorSCAddress,
+	vm.ESDTSCAddress,
+	vm.GovernanceSCAddress,
+	vm.DelegationManagerSCAddress,
+	vm.SlashingSCAddress,
+	vm.RandomnessSCAddress,
+	vm.InsuranceFundSCAddress,
+	vm.JailingAddress,
+	vm.EndOfEpochAddress,
+}
+
+// checkRewardAddress verifies that the reward address can receive the rewards minted on metachain. System and empty
+// addresses are never accepted. Smart contracts from metachain are accepted only as their own reward address, which is the case
+// of the delegation contracts
+func checkRewardAddress(rewardAddress []byte, ownerAddress []byte) error {
+	if len(rewardAddress) == 0 {
+		return nil
+	}
+	if core.IsSystemAccountAddress(rewardAddress) || core.IsEmptyAddress(rewardAddress) {
+		return vm.ErrRewardAddressIsSystemAddress
+	}
+	for _, systemAddress := range systemAddresses {
+		if bytes.Equal(rewardAddress, systemAddress) {
+			return vm.ErrRewardAddressIsSystemAddress
+		}
+	}
+
+	if bytes.Equal(rewardAddress, ownerAddress) {
+		return nil
+	}
+	if core.IsSmartContractOnMetachain(rewardAddress[len(rewardAddress)-1:], rewardAddress) {
+		return vm.ErrRewardAddressIsMetachainAddress
+	}
+
+	return nil
+}
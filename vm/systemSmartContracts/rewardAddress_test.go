@@ -0,0 +1,30 @@
+package systemSmartContracts
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckRewardAddress(t *testing.T) {
+	t.Parallel()
+
+	ownerAddress := []byte("ownerAddress")
+	userAddress := []byte("userAddress_____________________")
+	secondDelegationSCAddress := make([]byte, len(vm.FirstDelegationSCAddress))
+	copy(secondDelegationSCAddress, vm.FirstDelegationSCAddress)
+	secondDelegationSCAddress[28] = 2
+
+	assert.Nil(t, checkRewardAddress(userAddress, ownerAddress))
+	assert.Nil(t, checkRewardAddress(nil, ownerAddress))
+	assert.Nil(t, checkRewardAddress(vm.FirstDelegationSCAddress, vm.FirstDelegationSCAddress))
+
+	assert.Equal(t, vm.ErrRewardAddressIsSystemAddress, checkRewardAddress(vm.StakingSCAddress, ownerAddress))
+	assert.Equal(t, vm.ErrRewardAddressIsSystemAddress, checkRewardAddress(vm.ValidatorSCAddress, vm.ValidatorSCAddress))
+	assert.Equal(t, vm.ErrRewardAddressIsSystemAddress, checkRewardAddress(vm.EndOfEpochAddress, ownerAddress))
+	assert.Equal(t, vm.ErrRewardAddressIsSystemAddress, checkRewardAddress(core.SystemAccountAddress, ownerAddress))
+	assert.Equal(t, vm.ErrRewardAddressIsSystemAddress, checkRewardAddress(make([]byte, 32), ownerAddress))
+	assert.Equal(t, vm.ErrRewardAddressIsMetachainAddress, checkRewardAddress(secondDelegationSCAddress, vm.FirstDelegationSCAddress))
+}
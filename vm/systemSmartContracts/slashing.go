@@ -0,0 +1,300 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+const equivocationProofPrefix = "equivocationProof"
+const numEquivocationsPrefix = "numEquivocations"
+const numEquivocationProofArguments = 6
+
+// maxEquivocationProofEpochAge is the number of epochs a header can be behind the current epoch and still be
+// proven an equivocation, as the consensus groups of older epochs are not kept by the nodes coordinator
+const maxEquivocationProofEpochAge = 1
+
+// ArgsNewSlashingSmartContract defines the arguments needed for the slashing smart contract
+type ArgsNewSlashingSmartContract struct {
+	Eei              vm.SystemEI
+	GasCost          vm.GasCost
+	SlashingConfig   config.SlashingSystemSCConfig
+	SigVerifier      vm.MessageSignVerifier
+	NodesCoordinator vm.NodesCoordinator
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	EpochNotifier    vm.EpochNotifier
+}
+
+// slashingSC records the equivocation proofs submitted by the reporters. A proof is made of two different
+// headers proposed in the same round, epoch and chain and the signature shares given on both of them by the same
+// validator of the consensus group
+type slashingSC struct {
+	eei              vm.SystemEI
+	gasCost          vm.GasCost
+	sigVerifier      vm.MessageSignVerifier
+	nodesCoordinator vm.NodesCoordinator
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	enabledEpoch     uint32
+	flagEnabled      atomic.Flag
+	mutExecution     sync.RWMutex
+}
+
+// NewSlashingSmartContract creates a new slashing smart contract
+func NewSlashingSmartContract(args ArgsNewSlashingSmartContract) (*slashingSC, error) {
+	if check.IfNil(args.Eei) {
+		return nil, vm.ErrNilSystemEnvironmentInterface
+	}
+	if check.IfNil(args.SigVerifier) {
+		return nil, vm.ErrNilMessageSignVerifier
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, vm.ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, vm.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, vm.ErrNilHasher
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, vm.ErrNilEpochNotifier
+	}
+
+	s := &slashingSC{
+		eei:              args.Eei,
+		gasCost:          args.GasCost,
+		sigVerifier:      args.SigVerifier,
+		nodesCoordinator: args.NodesCoordinator,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		enabledEpoch:     args.SlashingConfig.EnabledEpoch,
+	}
+	args.EpochNotifier.RegisterNotifyHandler(s)
+
+	return s, nil
+}
+
+// Execute calls one of the functions from the slashing smart contract and runs the code according to the input
+func (s *slashingSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	s.mutExecution.RLock()
+	defer s.mutExecution.RUnlock()
+	if CheckIfNil(args) != nil {
+		return vmcommon.UserError
+	}
+
+	if args.Function == core.SCDeployInitFunctionName {
+		return vmcommon.Ok
+	}
+
+	if !s.flagEnabled.IsSet() {
+		s.eei.AddReturnMessage("slashing SC disabled")
+		return vmcommon.UserError
+	}
+
+	switch args.Function {
+	case "submitEquivocationProof":
+		return s.submitEquivocationProof(args)
+	case "getNumEquivocations":
+		return s.getNumEquivocations(args)
+	}
+
+	s.eei.AddReturnMessage("invalid method to call")
+	return vmcommon.FunctionNotFound
+}
+
+// submitEquivocationProof expects the following arguments:
+// BLS public key, shard ID, first header, first signature share, second header, second signature share
+func (s *slashingSC) submitEquivocationProof(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		s.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != numEquivocationProofArguments {
+		s.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d",
+			numEquivocationProofArguments, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+	err := s.eei.UseGas(s.gasCost.MetaChainSystemSCsCost.SubmitEquivocation)
+	if err != nil {
+		s.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+
+	blsKey := args.Arguments[0]
+	shardID := big.NewInt(0).SetBytes(args.Arguments[1]).Uint64()
+	firstHeader, err := s.verifySignedHeader(blsKey, uint32(shardID), args.Arguments[2], args.Arguments[3])
+	if err != nil {
+		s.eei.AddReturnMessage("invalid first header: " + err.Error())
+		return vmcommon.UserError
+	}
+	secondHeader, err := s.verifySignedHeader(blsKey, uint32(shardID), args.Arguments[4], args.Arguments[5])
+	if err != nil {
+		s.eei.AddReturnMessage("invalid second header: " + err.Error())
+		return vmcommon.UserError
+	}
+	if bytes.Equal(args.Arguments[2], args.Arguments[4]) {
+		s.eei.AddReturnMessage("the provided headers are identical")
+		return vmcommon.UserError
+	}
+	if firstHeader.GetRound() != secondHeader.GetRound() {
+		s.eei.AddReturnMessage("the provided headers are not from the same round")
+		return vmcommon.UserError
+	}
+	if firstHeader.GetEpoch() != secondHeader.GetEpoch() {
+		s.eei.AddReturnMessage("the provided headers are not from the same epoch")
+		return vmcommon.UserError
+	}
+	if len(firstHeader.GetChainID()) == 0 || !bytes.Equal(firstHeader.GetChainID(), secondHeader.GetChainID()) {
+		s.eei.AddReturnMessage("the provided headers are not from the same chain")
+		return vmcommon.UserError
+	}
+
+	proofKey := createEquivocationProofKey(blsKey, uint32(shardID), firstHeader.GetEpoch(), firstHeader.GetRound())
+	if len(s.eei.GetStorage(proofKey)) != 0 {
+		s.eei.AddReturnMessage("equivocation proof already submitted")
+		return vmcommon.UserError
+	}
+	s.eei.SetStorage(proofKey, args.CallerAddr)
+
+	numEquivocationsKey := append([]byte(numEquivocationsPrefix), blsKey...)
+	numEquivocations := big.NewInt(0).SetBytes(s.eei.GetStorage(numEquivocationsKey))
+	numEquivocations.Add(numEquivocations, big.NewInt(1))
+	s.eei.SetStorage(numEquivocationsKey, numEquivocations.Bytes())
+
+	log.Debug("slashingSC: equivocation proof recorded",
+		"BLS key", blsKey,
+		"shard", shardID,
+		"epoch", firstHeader.GetEpoch(),
+		"round", firstHeader.GetRound(),
+		"num equivocations", numEquivocations.String(),
+	)
+
+	return vmcommon.Ok
+}
+
+func (s *slashingSC) verifySignedHeader(
+	blsKey []byte,
+	shardID uint32,
+	headerBytes []byte,
+	signatureShare []byte,
+) (data.HeaderHandler, error) {
+	var header data.HeaderHandler = &block.Header{}
+	if shardID == core.MetachainShardId {
+		header = &block.MetaBlock{}
+	}
+
+	err := s.marshalizer.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+	if header.GetShardID() != shardID {
+		return nil, fmt.Errorf("%w, expected shard %d, got %d", vm.ErrInvalidShardID, shardID, header.GetShardID())
+	}
+
+	err = s.verifyConsensusGroupMember(blsKey, header)
+	if err != nil {
+		return nil, err
+	}
+
+	headerHash := s.hasher.Compute(string(headerBytes))
+
+	return header, s.sigVerifier.Verify(headerHash, signatureShare, blsKey)
+}
+
+func (s *slashingSC) verifyConsensusGroupMember(blsKey []byte, header data.HeaderHandler) error {
+	// the start of epoch block is signed by the consensus group of the previous epoch
+	epoch := header.GetEpoch()
+	if header.IsStartOfEpochBlock() && epoch > 0 {
+		epoch = epoch - 1
+	}
+
+	currentEpoch := s.eei.BlockChainHook().CurrentEpoch()
+	if epoch > currentEpoch || epoch+maxEquivocationProofEpochAge < currentEpoch {
+		return fmt.Errorf("%w, header epoch %d, current epoch %d", vm.ErrInvalidHeaderEpoch, epoch, currentEpoch)
+	}
+
+	consensusGroup, err := s.nodesCoordinator.GetConsensusValidatorsPublicKeys(
+		header.GetPrevRandSeed(),
+		header.GetRound(),
+		header.GetShardID(),
+		epoch,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, pubKey := range consensusGroup {
+		if pubKey == string(blsKey) {
+			return nil
+		}
+	}
+
+	return vm.ErrNotInConsensusGroup
+}
+
+// createEquivocationProofKey returns the key of the slot a validator equivocated in. The numeric fields are separated
+// so different slots can not result in the same key
+func createEquivocationProofKey(blsKey []byte, shardID uint32, epoch uint32, round uint64) []byte {
+	slot := fmt.Sprintf("%s_%d_%d_%d_", equivocationProofPrefix, shardID, epoch, round)
+
+	return append([]byte(slot), blsKey...)
+}
+
+func (s *slashingSC) getNumEquivocations(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		s.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		s.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 1, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+	err := s.eei.UseGas(s.gasCost.MetaChainSystemSCsCost.Get)
+	if err != nil {
+		s.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+
+	numEquivocationsKey := append([]byte(numEquivocationsPrefix), args.Arguments[0]...)
+	numEquivocations := big.NewInt(0).SetBytes(s.eei.GetStorage(numEquivocationsKey))
+	s.eei.Finish([]byte(numEquivocations.String()))
+
+	return vmcommon.Ok
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (s *slashingSC) EpochConfirmed(epoch uint32) {
+	s.flagEnabled.Toggle(epoch >= s.enabledEpoch)
+	log.Debug("slashing contract", "enabled", s.flagEnabled.IsSet())
+}
+
+// CanUseContract returns true if contract is enabled
+func (s *slashingSC) CanUseContract() bool {
+	return true
+}
+
+// SetNewGasCost is called whenever a gas cost was changed
+func (s *slashingSC) SetNewGasCost(gasCost vm.GasCost) {
+	s.mutExecution.Lock()
+	s.gasCost = gasCost
+	s.mutExecution.Unlock()
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (s *slashingSC) IsInterfaceNil() bool {
+	return s == nil
+}
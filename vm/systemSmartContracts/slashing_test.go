@@ -0,0 +1,435 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockSlashingArgs() ArgsNewSlashingSmartContract {
+	return ArgsNewSlashingSmartContract{
+		Eei:            &mock.SystemEIStub{},
+		GasCost:        vm.GasCost{},
+		SlashingConfig: config.SlashingSystemSCConfig{},
+		SigVerifier:    &mock.MessageSignVerifierMock{},
+		NodesCoordinator: &mock.NodesCoordinatorStub{
+			GetConsensusValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error) {
+				return []string{"otherKey", "blsKey"}, nil
+			},
+		},
+		Marshalizer:   &mock.MarshalizerMock{},
+		Hasher:        &mock.HasherMock{},
+		EpochNotifier: &mock.EpochNotifierStub{},
+	}
+}
+
+func createSlashingEEIStub(storage map[string][]byte, returnMessages *[]string) *mock.SystemEIStub {
+	return &mock.SystemEIStub{
+		GetStorageCalled: func(key []byte) []byte {
+			return storage[string(key)]
+		},
+		SetStorageCalled: func(key []byte, value []byte) {
+			storage[string(key)] = value
+		},
+		AddReturnMessageCalled: func(msg string) {
+			*returnMessages = append(*returnMessages, msg)
+		},
+	}
+}
+
+func createEquivocationProofArguments(t *testing.T, shardID uint32, firstRound uint64, secondRound uint64) [][]byte {
+	return createEquivocationProofArgumentsFromHeaders(
+		t,
+		&block.Header{ShardID: shardID, Round: firstRound, Nonce: 1, ChainID: []byte("chain")},
+		&block.Header{ShardID: shardID, Round: secondRound, Nonce: 2, ChainID: []byte("chain")},
+	)
+}
+
+func createEquivocationProofArgumentsFromHeaders(t *testing.T, first *block.Header, second *block.Header) [][]byte {
+	marshalizer := &mock.MarshalizerMock{}
+	firstHeader, err := marshalizer.Marshal(first)
+	assert.Nil(t, err)
+	secondHeader, err := marshalizer.Marshal(second)
+	assert.Nil(t, err)
+
+	return [][]byte{
+		[]byte("blsKey"),
+		big.NewInt(int64(first.ShardID)).Bytes(),
+		firstHeader,
+		[]byte("sig1"),
+		secondHeader,
+		[]byte("sig2"),
+	}
+}
+
+func TestNewSlashingSmartContract_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockSlashingArgs()
+	args.Eei = nil
+	sc, err := NewSlashingSmartContract(args)
+	assert.True(t, check.IfNil(sc))
+	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
+
+	args = createMockSlashingArgs()
+	args.SigVerifier = nil
+	sc, err = NewSlashingSmartContract(args)
+	assert.True(t, check.IfNil(sc))
+	assert.Equal(t, vm.ErrNilMessageSignVerifier, err)
+
+	args = createMockSlashingArgs()
+	args.NodesCoordinator = nil
+	sc, err = NewSlashingSmartContract(args)
+	assert.True(t, check.IfNil(sc))
+	assert.Equal(t, vm.ErrNilNodesCoordinator, err)
+
+	args = createMockSlashingArgs()
+	args.Marshalizer = nil
+	sc, err = NewSlashingSmartContract(args)
+	assert.True(t, check.IfNil(sc))
+	assert.Equal(t, vm.ErrNilMarshalizer, err)
+
+	args = createMockSlashingArgs()
+	args.Hasher = nil
+	sc, err = NewSlashingSmartContract(args)
+	assert.True(t, check.IfNil(sc))
+	assert.Equal(t, vm.ErrNilHasher, err)
+
+	args = createMockSlashingArgs()
+	args.EpochNotifier = nil
+	sc, err = NewSlashingSmartContract(args)
+	assert.True(t, check.IfNil(sc))
+	assert.Equal(t, vm.ErrNilEpochNotifier, err)
+}
+
+func TestSlashingSC_ExecuteDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	returnMessages := make([]string, 0)
+	args := createMockSlashingArgs()
+	args.SlashingConfig.EnabledEpoch = 5
+	args.Eei = createSlashingEEIStub(make(map[string][]byte), &returnMessages)
+	sc, _ := NewSlashingSmartContract(args)
+	sc.EpochConfirmed(4)
+
+	callInput := createVMInput(big.NewInt(0), "submitEquivocationProof", []byte("reporter"), vm.SlashingSCAddress)
+	retCode := sc.Execute(callInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, []string{"slashing SC disabled"}, returnMessages)
+}
+
+func TestSlashingSC_SubmitEquivocationProofInvalidProofsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockSlashingArgs()
+	args.Eei = createSlashingEEIStub(make(map[string][]byte), &[]string{})
+	sc, _ := NewSlashingSmartContract(args)
+	sc.EpochConfirmed(0)
+
+	callInput := createVMInput(big.NewInt(1), "submitEquivocationProof", []byte("reporter"), vm.SlashingSCAddress)
+	callInput.Arguments = createEquivocationProofArguments(t, 0, 10, 10)
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+
+	callInput = createVMInput(big.NewInt(0), "submitEquivocationProof", []byte("reporter"), vm.SlashingSCAddress)
+	callInput.Arguments = createEquivocationProofArguments(t, 0, 10, 10)[:5]
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+
+	callInput.Arguments = createEquivocationProofArguments(t, 0, 10, 11)
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+
+	callInput.Arguments = createEquivocationProofArguments(t, 0, 10, 10)
+	callInput.Arguments[4] = callInput.Arguments[2]
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+
+	callInput.Arguments = createEquivocationProofArguments(t, 1, 10, 10)
+	callInput.Arguments[1] = big.NewInt(0).Bytes()
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+}
+
+func TestSlashingSC_SubmitEquivocationProofInvalidSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockSlashingArgs()
+	args.Eei = createSlashingEEIStub(make(map[string][]byte), &[]string{})
+	args.SigVerifier = &mock.MessageSignVerifierMock{
+		VerifyCalled: func(message []byte, signedMessage []byte, pubKey []byte) error {
+			if bytes.Equal(signedMessage, []byte("sig2")) {
+				return expectedErr
+			}
+			return nil
+		},
+	}
+	sc, _ := NewSlashingSmartContract(args)
+	sc.EpochConfirmed(0)
+
+	callInput := createVMInput(big.NewInt(0), "submitEquivocationProof", []byte("reporter"), vm.SlashingSCAddress)
+	callInput.Arguments = createEquivocationProofArguments(t, 0, 10, 10)
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+}
+
+func TestSlashingSC_SubmitEquivocationProofShouldRecordOnce(t *testing.T) {
+	t.Parallel()
+
+	storage := make(map[string][]byte)
+	returnMessages := make([]string, 0)
+	var finishedValue []byte
+	eei := createSlashingEEIStub(storage, &returnMessages)
+	eei.FinishCalled = func(value []byte) {
+		finishedValue = value
+	}
+	verifiedMessages := make([][]byte, 0)
+	args := createMockSlashingArgs()
+	args.Eei = eei
+	args.SigVerifier = &mock.MessageSignVerifierMock{
+		VerifyCalled: func(message []byte, signedMessage []byte, pubKey []byte) error {
+			assert.Equal(t, []byte("blsKey"), pubKey)
+			verifiedMessages = append(verifiedMessages, message)
+			return nil
+		},
+	}
+	sc, _ := NewSlashingSmartContract(args)
+	sc.EpochConfirmed(0)
+
+	callInput := createVMInput(big.NewInt(0), "submitEquivocationProof", []byte("reporter"), vm.SlashingSCAddress)
+	callInput.Arguments = createEquivocationProofArguments(t, 1, 10, 10)
+	assert.Equal(t, vmcommon.Ok, sc.Execute(callInput))
+	hasher := &mock.HasherMock{}
+	assert.Equal(t, [][]byte{
+		hasher.Compute(string(callInput.Arguments[2])),
+		hasher.Compute(string(callInput.Arguments[4])),
+	}, verifiedMessages)
+
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+	assert.Equal(t, []string{"equivocation proof already submitted"}, returnMessages)
+
+	callInput = createVMInput(big.NewInt(0), "getNumEquivocations", []byte("caller"), vm.SlashingSCAddress)
+	callInput.Arguments = [][]byte{[]byte("blsKey")}
+	assert.Equal(t, vmcommon.Ok, sc.Execute(callInput))
+	assert.Equal(t, []byte("1"), finishedValue)
+}
+
+func TestSlashingSC_SubmitEquivocationProofNotInConsensusGroupShouldErr(t *testing.T) {
+	t.Parallel()
+
+	returnMessages := make([]string, 0)
+	args := createMockSlashingArgs()
+	args.Eei = createSlashingEEIStub(make(map[string][]byte), &returnMessages)
+	args.NodesCoordinator = &mock.NodesCoordinatorStub{
+		GetConsensusValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error) {
+			return []string{"otherKey"}, nil
+		},
+	}
+	sc, _ := NewSlashingSmartContract(args)
+	sc.EpochConfirmed(0)
+
+	callInput := createVMInput(big.NewInt(0), "submitEquivocationProof", []byte("reporter"), vm.SlashingSCAddress)
+	callInput.Arguments = createEquivocationProofArguments(t, 0, 10, 10)
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+	assert.Equal(t, []string{"invalid first header: " + vm.ErrNotInConsensusGroup.Error()}, returnMessages)
+}
+
+func TestSlashingSC_SubmitEquivocationProofNodesCoordinatorErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	returnMessages := make([]string, 0)
+	args := createMockSlashingArgs()
+	args.Eei = createSlashingEEIStub(make(map[string][]byte), &returnMessages)
+	args.NodesCoordinator = &mock.NodesCoordinatorStub{
+		GetConsensusValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error) {
+			return nil, expectedErr
+		},
+	}
+	sc, _ := NewSlashingSmartContract(args)
+	sc.EpochConfirmed(0)
+
+	callInput := createVMInput(big.NewInt(0), "submitEquivocationProof", []byte("reporter"), vm.SlashingSCAddress)
+	callInput.Arguments = createEquivocationProofArguments(t, 0, 10, 10)
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+	assert.Equal(t, []string{"invalid first header: " + expectedErr.Error()}, returnMessages)
+}
+
+func TestSlashingSC_SubmitEquivocationProofShouldCheckTheConsensusGroupOfTheHeader(t *testing.T) {
+	t.Parallel()
+
+	type consensusGroupCall struct {
+		randomness []byte
+		round      uint64
+		shardID    uint32
+		epoch      uint32
+	}
+	calls := make([]consensusGroupCall, 0)
+	args := createMockSlashingArgs()
+	eei := createSlashingEEIStub(make(map[string][]byte), &[]string{})
+	eei.BlockChainHookCalled = func() vm.BlockchainHook {
+		return &mock.BlockChainHookStub{
+			CurrentEpochCalled: func() uint32 {
+				return 5
+			},
+		}
+	}
+	args.Eei = eei
+	args.NodesCoordinator = &mock.NodesCoordinatorStub{
+		GetConsensusValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error) {
+			calls = append(calls, consensusGroupCall{randomness: randomness, round: round, shardID: shardId, epoch: epoch})
+			return []string{"blsKey"}, nil
+		},
+	}
+	sc, _ := NewSlashingSmartContract(args)
+	sc.EpochConfirmed(0)
+
+	firstHeader := &block.Header{ShardID: 1, Round: 10, Epoch: 5, PrevRandSeed: []byte("seed"), ChainID: []byte("chain")}
+	secondHeader := &block.Header{ShardID: 1, Round: 10, Epoch: 5, PrevRandSeed: []byte("seed"), ChainID: []byte("chain"),
+		EpochStartMetaHash: []byte("epoch start")}
+	callInput := createVMInput(big.NewInt(0), "submitEquivocationProof", []byte("reporter"), vm.SlashingSCAddress)
+	callInput.Arguments = createEquivocationProofArgumentsFromHeaders(t, firstHeader, secondHeader)
+	assert.Equal(t, vmcommon.Ok, sc.Execute(callInput))
+	assert.Equal(t, []consensusGroupCall{
+		{randomness: []byte("seed"), round: 10, shardID: 1, epoch: 5},
+		{randomness: []byte("seed"), round: 10, shardID: 1, epoch: 4},
+	}, calls)
+}
+
+func TestSlashingSC_SubmitEquivocationProofHeaderEpochOutOfRangeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	returnMessages := make([]string, 0)
+	args := createMockSlashingArgs()
+	eei := createSlashingEEIStub(make(map[string][]byte), &returnMessages)
+	eei.BlockChainHookCalled = func() vm.BlockchainHook {
+		return &mock.BlockChainHookStub{
+			CurrentEpochCalled: func() uint32 {
+				return 5
+			},
+		}
+	}
+	args.Eei = eei
+	sc, _ := NewSlashingSmartContract(args)
+	sc.EpochConfirmed(0)
+
+	for _, epoch := range []uint32{3, 6} {
+		returnMessages = returnMessages[:0]
+		callInput := createVMInput(big.NewInt(0), "submitEquivocationProof", []byte("reporter"), vm.SlashingSCAddress)
+		callInput.Arguments = createEquivocationProofArgumentsFromHeaders(
+			t,
+			&block.Header{Round: 10, Epoch: epoch, Nonce: 1, ChainID: []byte("chain")},
+			&block.Header{Round: 10, Epoch: epoch, Nonce: 2, ChainID: []byte("chain")},
+		)
+		assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+		assert.Equal(t, 1, len(returnMessages))
+		assert.Contains(t, returnMessages[0], vm.ErrInvalidHeaderEpoch.Error())
+	}
+}
+
+func TestSlashingSC_SubmitEquivocationProofDifferentEpochsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	returnMessages := make([]string, 0)
+	args := createMockSlashingArgs()
+	eei := createSlashingEEIStub(make(map[string][]byte), &returnMessages)
+	eei.BlockChainHookCalled = func() vm.BlockchainHook {
+		return &mock.BlockChainHookStub{
+			CurrentEpochCalled: func() uint32 {
+				return 5
+			},
+		}
+	}
+	args.Eei = eei
+	sc, _ := NewSlashingSmartContract(args)
+	sc.EpochConfirmed(0)
+
+	callInput := createVMInput(big.NewInt(0), "submitEquivocationProof", []byte("reporter"), vm.SlashingSCAddress)
+	callInput.Arguments = createEquivocationProofArgumentsFromHeaders(
+		t,
+		&block.Header{Round: 10, Epoch: 4, Nonce: 1, ChainID: []byte("chain")},
+		&block.Header{Round: 10, Epoch: 5, Nonce: 2, ChainID: []byte("chain")},
+	)
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+	assert.Equal(t, []string{"the provided headers are not from the same epoch"}, returnMessages)
+}
+
+func TestSlashingSC_SubmitEquivocationProofDifferentChainsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	returnMessages := make([]string, 0)
+	args := createMockSlashingArgs()
+	args.Eei = createSlashingEEIStub(make(map[string][]byte), &returnMessages)
+	sc, _ := NewSlashingSmartContract(args)
+	sc.EpochConfirmed(0)
+
+	callInput := createVMInput(big.NewInt(0), "submitEquivocationProof", []byte("reporter"), vm.SlashingSCAddress)
+	callInput.Arguments = createEquivocationProofArgumentsFromHeaders(
+		t,
+		&block.Header{Round: 10, Nonce: 1, ChainID: []byte("chain")},
+		&block.Header{Round: 10, Nonce: 2, ChainID: []byte("other chain")},
+	)
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+
+	callInput.Arguments = createEquivocationProofArgumentsFromHeaders(
+		t,
+		&block.Header{Round: 10, Nonce: 1},
+		&block.Header{Round: 10, Nonce: 2},
+	)
+	assert.Equal(t, vmcommon.UserError, sc.Execute(callInput))
+	assert.Equal(t, []string{
+		"the provided headers are not from the same chain",
+		"the provided headers are not from the same chain",
+	}, returnMessages)
+}
+
+func TestSlashingSC_SubmitEquivocationProofSameRoundOfAnotherSlotShouldRecord(t *testing.T) {
+	t.Parallel()
+
+	storage := make(map[string][]byte)
+	returnMessages := make([]string, 0)
+	var finishedValue []byte
+	eei := createSlashingEEIStub(storage, &returnMessages)
+	eei.FinishCalled = func(value []byte) {
+		finishedValue = value
+	}
+	eei.BlockChainHookCalled = func() vm.BlockchainHook {
+		return &mock.BlockChainHookStub{
+			CurrentEpochCalled: func() uint32 {
+				return 5
+			},
+		}
+	}
+	args := createMockSlashingArgs()
+	args.Eei = eei
+	sc, _ := NewSlashingSmartContract(args)
+	sc.EpochConfirmed(0)
+
+	slots := []struct {
+		shardID uint32
+		epoch   uint32
+	}{
+		{shardID: 1, epoch: 4},
+		{shardID: 1, epoch: 5},
+		{shardID: 11, epoch: 5},
+	}
+	for _, slot := range slots {
+		callInput := createVMInput(big.NewInt(0), "submitEquivocationProof", []byte("reporter"), vm.SlashingSCAddress)
+		callInput.Arguments = createEquivocationProofArgumentsFromHeaders(
+			t,
+			&block.Header{ShardID: slot.shardID, Round: 10, Epoch: slot.epoch, Nonce: 1, ChainID: []byte("chain")},
+			&block.Header{ShardID: slot.shardID, Round: 10, Epoch: slot.epoch, Nonce: 2, ChainID: []byte("chain")},
+		)
+		assert.Equal(t, vmcommon.Ok, sc.Execute(callInput))
+	}
+	assert.Equal(t, 0, len(returnMessages))
+
+	callInput := createVMInput(big.NewInt(0), "getNumEquivocations", []byte("caller"), vm.SlashingSCAddress)
+	callInput.Arguments = [][]byte{[]byte("blsKey")}
+	assert.Equal(t, vmcommon.Ok, sc.Execute(callInput))
+	assert.Equal(t, []byte("3"), finishedValue)
+}
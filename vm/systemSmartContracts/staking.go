@@ -26,6 +26,13 @@ const ownerKey = "owner"
 const nodesConfigKey = "nodesConfig"
 const waitingListHeadKey = "waitingList"
 const waitingElementPrefix = "w_"
+const jailHistoryPrefix = "jailHistory"
+
+// QueueViewNumFieldsPerNode is the number of return data entries written by the getQueueView view for each queued node
+const QueueViewNumFieldsPerNode = 3
+
+// MaxQueueViewPageSize is the maximum number of queued nodes that can be returned by a getQueueView call
+const MaxQueueViewPageSize = 100
 
 type stakingSC struct {
 	eei                      vm.SystemEI
@@ -48,6 +55,15 @@ type stakingSC struct {
 	walletAddressLen         int
 	mutExecution             sync.RWMutex
 	minNodePrice             *big.Int
+	roundDurationChange      vm.RoundDurationChange
+	flagRoundDurationChange  atomic.Flag
+	jailWindowEpochs         uint32
+	dynamicUnJailPriceEpoch  uint32
+	flagDynamicUnJailPrice   atomic.Flag
+	rewardAddrCheckEpoch     uint32
+	flagRewardAddrCheck      atomic.Flag
+	queueViewEpoch           uint32
+	flagQueueView            atomic.Flag
 }
 
 // ArgsNewStakingSmartContract holds the arguments needed to create a StakingSmartContract
@@ -61,6 +77,7 @@ type ArgsNewStakingSmartContract struct {
 	GasCost              vm.GasCost
 	Marshalizer          marshal.Marshalizer
 	EpochNotifier        vm.EpochNotifier
+	RoundDurationChange  vm.RoundDurationChange
 }
 
 type waitingListReturnData struct {
@@ -127,6 +144,11 @@ func NewStakingSmartContract(
 		stakingV2Epoch:           args.StakingSCConfig.StakingV2Epoch,
 		walletAddressLen:         len(args.StakingAccessAddr),
 		minNodePrice:             minStakeValue,
+		roundDurationChange:      args.RoundDurationChange,
+		jailWindowEpochs:         args.StakingSCConfig.UnJailPriceJailWindowEpochs,
+		dynamicUnJailPriceEpoch:  args.StakingSCConfig.DynamicUnJailPriceEnableEpoch,
+		rewardAddrCheckEpoch:     args.StakingSCConfig.RewardAddressValidationEnableEpoch,
+		queueViewEpoch:           args.StakingSCConfig.QueueViewEnableEpoch,
 	}
 
 	conversionOk := true
@@ -201,6 +223,8 @@ func (s *stakingSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCod
 		return s.unStakeAtEndOfEpoch(args)
 	case "getTotalNumberOfRegisteredNodes":
 		return s.getTotalNumberOfRegisteredNodes(args)
+	case "getQueueView":
+		return s.getQueueView(args)
 	}
 
 	return vmcommon.UserError
@@ -272,6 +296,13 @@ func (s *stakingSC) changeValidatorKey(args *vmcommon.ContractCallInput) vmcommo
 		return vmcommon.UserError
 	}
 
+	// the jail history follows the node, so changing the key does not reset the unJail price
+	jailHistory := s.eei.GetStorage(createJailHistoryKey(oldKey))
+	if len(jailHistory) > 0 {
+		s.eei.SetStorage(createJailHistoryKey(oldKey), nil)
+		s.eei.SetStorage(createJailHistoryKey(newKey), jailHistory)
+	}
+
 	return vmcommon.Ok
 }
 
@@ -300,6 +331,13 @@ func (s *stakingSC) changeRewardAddress(args *vmcommon.ContractCallInput) vmcomm
 		if len(stakedData.RewardAddress) == 0 {
 			continue
 		}
+		if s.flagRewardAddrCheck.IsSet() {
+			err = checkRewardAddress(newRewardAddress, stakedData.OwnerAddress)
+			if err != nil {
+				s.eei.AddReturnMessage("invalid reward address: " + err.Error())
+				return vmcommon.UserError
+			}
+		}
 
 		stakedData.RewardAddress = newRewardAddress
 		err = s.saveStakingData(blsKey, stakedData)
@@ -425,6 +463,11 @@ func (s *stakingSC) jail(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 			s.eei.AddReturnMessage("cannot save staking data: error " + err.Error())
 			return vmcommon.UserError
 		}
+		err = s.addToJailHistory(argument)
+		if err != nil {
+			s.eei.AddReturnMessage("cannot save jail history: error " + err.Error())
+			return vmcommon.UserError
+		}
 	}
 
 	return vmcommon.Ok
@@ -489,6 +532,13 @@ func (s *stakingSC) stake(args *vmcommon.ContractCallInput, onlyRegister bool) v
 		s.eei.AddReturnMessage("cannot stake node which is jailed or with bad rating")
 		return vmcommon.UserError
 	}
+	if s.flagRewardAddrCheck.IsSet() {
+		err = checkRewardAddress(args.Arguments[1], args.Arguments[2])
+		if err != nil {
+			s.eei.AddReturnMessage("invalid reward address: " + err.Error())
+			return vmcommon.UserError
+		}
+	}
 
 	registrationData.RewardAddress = args.Arguments[1]
 	registrationData.OwnerAddress = args.Arguments[2]
@@ -756,7 +806,7 @@ func (s *stakingSC) unBond(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 	}
 
 	currentNonce := s.eei.BlockChainHook().CurrentNonce()
-	if registrationData.UnStakedNonce > 0 && currentNonce-registrationData.UnStakedNonce < s.unBondPeriod {
+	if registrationData.UnStakedNonce > 0 && currentNonce-registrationData.UnStakedNonce < s.getUnBondPeriod() {
 		s.eei.AddReturnMessage(fmt.Sprintf("unBond is not possible for key %s because unBond period did not pass", encodedBlsKey))
 		return vmcommon.UserError
 	}
@@ -1120,6 +1170,11 @@ func (s *stakingSC) switchJailedWithWaiting(args *vmcommon.ContractCallInput) vm
 		s.eei.AddReturnMessage("cannot save staking data: error " + err.Error())
 		return vmcommon.UserError
 	}
+	err = s.addToJailHistory(args.Arguments[0])
+	if err != nil {
+		s.eei.AddReturnMessage("cannot save jail history: error " + err.Error())
+		return vmcommon.UserError
+	}
 
 	return vmcommon.Ok
 }
@@ -1358,14 +1413,15 @@ func (s *stakingSC) getRemainingUnbondPeriod(args *vmcommon.ContractCallInput) v
 
 	currentNonce := s.eei.BlockChainHook().CurrentNonce()
 	passedNonce := currentNonce - stakedData.UnStakedNonce
-	if passedNonce >= s.unBondPeriod {
+	unBondPeriod := s.getUnBondPeriod()
+	if passedNonce >= unBondPeriod {
 		if s.flagStakingV2.IsSet() {
 			s.eei.Finish(zero.Bytes())
 		} else {
 			s.eei.Finish([]byte("0"))
 		}
 	} else {
-		remaining := s.unBondPeriod - passedNonce
+		remaining := unBondPeriod - passedNonce
 		if s.flagStakingV2.IsSet() {
 			s.eei.Finish(big.NewInt(0).SetUint64(remaining).Bytes())
 		} else {
@@ -1404,6 +1460,79 @@ func (s *stakingSC) getWaitingListRegisterNonceAndRewardAddress(args *vmcommon.C
 	return vmcommon.Ok
 }
 
+// getQueueView returns the length of the waiting list followed by the BLS key, owner address and register nonce of at
+// most pageSize queued nodes, starting with the node at the provided 0 based position. It can be called by anyone
+func (s *stakingSC) getQueueView(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !s.flagQueueView.IsSet() {
+		s.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		s.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		s.eei.AddReturnMessage("number of arguments must be equal to 2")
+		return vmcommon.UserError
+	}
+	startIndex := big.NewInt(0).SetBytes(args.Arguments[0])
+	pageSize := big.NewInt(0).SetBytes(args.Arguments[1])
+	if pageSize.Sign() == 0 || pageSize.Cmp(big.NewInt(MaxQueueViewPageSize)) > 0 {
+		s.eei.AddReturnMessage(fmt.Sprintf("invalid page size, should be between 1 and %d", MaxQueueViewPageSize))
+		return vmcommon.UserError
+	}
+
+	err := s.eei.UseGas(s.gasCost.MetaChainSystemSCsCost.Get)
+	if err != nil {
+		s.eei.AddReturnMessage("insufficient gas")
+		return vmcommon.OutOfGas
+	}
+
+	waitingListHead, err := s.getWaitingListHead()
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	s.eei.Finish(big.NewInt(int64(waitingListHead.Length)).Bytes())
+	if !startIndex.IsUint64() || startIndex.Uint64() >= uint64(waitingListHead.Length) {
+		return vmcommon.Ok
+	}
+
+	start := startIndex.Uint64()
+	end := start + pageSize.Uint64()
+	nextKey := waitingListHead.FirstKey
+	for index := uint64(0); len(nextKey) != 0 && index < end; index++ {
+		err = s.eei.UseGas(s.gasCost.MetaChainSystemSCsCost.Get)
+		if err != nil {
+			s.eei.AddReturnMessage("insufficient gas")
+			return vmcommon.OutOfGas
+		}
+
+		element, errGet := s.getWaitingListElement(nextKey)
+		if errGet != nil {
+			s.eei.AddReturnMessage(errGet.Error())
+			return vmcommon.UserError
+		}
+		nextKey = element.NextKey
+		if index < start {
+			continue
+		}
+
+		stakedData, errGet := s.getOrCreateRegisteredData(element.BLSPublicKey)
+		if errGet != nil {
+			s.eei.AddReturnMessage(errGet.Error())
+			return vmcommon.UserError
+		}
+
+		s.eei.Finish(element.BLSPublicKey)
+		s.eei.Finish(stakedData.OwnerAddress)
+		s.eei.Finish(big.NewInt(0).SetUint64(stakedData.RegisterNonce).Bytes())
+	}
+
+	return vmcommon.Ok
+}
+
 func (s *stakingSC) setOwnersOnAddresses(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if !s.flagStakingV2.IsSet() {
 		s.eei.AddReturnMessage("invalid method to call")
@@ -1644,6 +1773,27 @@ func (s *stakingSC) EpochConfirmed(epoch uint32) {
 
 	s.flagStakingV2.Toggle(epoch >= s.stakingV2Epoch)
 	log.Debug("stakingSC: set owner", "enabled", s.flagStakingV2.IsSet())
+
+	s.flagRoundDurationChange.Toggle(s.roundDurationChange.IsEnabled() && epoch >= s.roundDurationChange.EnableEpoch)
+	log.Debug("stakingSC: unBond period converted to the new round duration", "enabled", s.flagRoundDurationChange.IsSet())
+
+	s.flagDynamicUnJailPrice.Toggle(epoch >= s.dynamicUnJailPriceEpoch)
+	log.Debug("stakingSC: jail history", "enabled", s.flagDynamicUnJailPrice.IsSet())
+
+	s.flagRewardAddrCheck.Toggle(epoch >= s.rewardAddrCheckEpoch)
+	log.Debug("stakingSC: reward address validation", "enabled", s.flagRewardAddrCheck.IsSet())
+
+	s.flagQueueView.Toggle(epoch >= s.queueViewEpoch)
+	log.Debug("stakingSC: queue view", "enabled", s.flagQueueView.IsSet())
+}
+
+// getUnBondPeriod returns the unBond period expressed in rounds of the current round duration
+func (s *stakingSC) getUnBondPeriod() uint64 {
+	if s.flagRoundDurationChange.IsSet() {
+		return s.roundDurationChange.ConvertNumRounds(s.unBondPeriod)
+	}
+
+	return s.unBondPeriod
 }
 
 // CanUseContract returns true if contract can be used
@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 )
 
 func (s *stakingSC) getConfig() *StakingNodesConfig {
@@ -152,3 +153,55 @@ func (s *stakingSC) saveAsStakingDataV1P1(key []byte, stakedData *StakedDataV2_0
 	s.eei.SetStorage(key, data)
 	return nil
 }
+
+// addToJailHistory records the current epoch in the jail history of the provided BLS key. The epochs that fell out of
+// the configured window are removed, so the history only holds the jail events that affect the unJail price
+func (s *stakingSC) addToJailHistory(blsKey []byte) error {
+	if !s.flagDynamicUnJailPrice.IsSet() || s.jailWindowEpochs == 0 {
+		return nil
+	}
+
+	jailHistory, err := unmarshalJailHistory(s.marshalizer, s.eei.GetStorage(createJailHistoryKey(blsKey)))
+	if err != nil {
+		return err
+	}
+
+	currentEpoch := s.eei.BlockChainHook().CurrentEpoch()
+	jailEpochs := make([]uint32, 0, len(jailHistory.JailEpochs)+1)
+	for _, epoch := range jailHistory.JailEpochs {
+		if isInJailWindow(epoch, currentEpoch, s.jailWindowEpochs) {
+			jailEpochs = append(jailEpochs, epoch)
+		}
+	}
+	jailHistory.JailEpochs = append(jailEpochs, currentEpoch)
+
+	marshaledData, err := s.marshalizer.Marshal(jailHistory)
+	if err != nil {
+		return err
+	}
+
+	s.eei.SetStorage(createJailHistoryKey(blsKey), marshaledData)
+	return nil
+}
+
+func unmarshalJailHistory(marshalizer marshal.Marshalizer, marshaledData []byte) (*JailHistory, error) {
+	jailHistory := &JailHistory{}
+	if len(marshaledData) == 0 {
+		return jailHistory, nil
+	}
+
+	err := marshalizer.Unmarshal(jailHistory, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return jailHistory, nil
+}
+
+func createJailHistoryKey(blsKey []byte) []byte {
+	return append([]byte(jailHistoryPrefix), blsKey...)
+}
+
+func isInJailWindow(jailEpoch uint32, currentEpoch uint32, windowEpochs uint32) bool {
+	return uint64(jailEpoch)+uint64(windowEpochs) > uint64(currentEpoch)
+}
@@ -293,6 +293,43 @@ func TestStakingSC_ExecuteStake(t *testing.T) {
 	assert.Equal(t, expectedRegistrationData, registrationData)
 }
 
+func TestStakingSC_ExecuteStakeWithSystemRewardAddress(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	blockChainHook.GetStorageDataCalled = func(accountsAddress []byte, index []byte) (i []byte, e error) {
+		return nil, nil
+	}
+
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), &mock.ArgumentParserMock{}, &mock.AccountsStub{}, &mock.RaterMock{})
+	eei.SetSCAddress([]byte("addr"))
+
+	epochNotifier := &mock.EpochNotifierStub{}
+	args := createMockStakingScArguments()
+	args.StakingSCConfig.RewardAddressValidationEnableEpoch = 1
+	args.Eei = eei
+	args.EpochNotifier = epochNotifier
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "stake"
+	arguments.CallerAddr = []byte("validator")
+	arguments.Arguments = [][]byte{[]byte("firstKey"), vm.StakingSCAddress, []byte("owner")}
+	retCode := stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	stakingSmartContract.EpochConfirmed(1)
+	arguments.Arguments = [][]byte{[]byte("secondKey"), vm.StakingSCAddress, []byte("owner")}
+	retCode = stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.Contains(eei.returnMessage, vm.ErrRewardAddressIsSystemAddress.Error()))
+
+	arguments.Function = "changeRewardAddress"
+	arguments.Arguments = [][]byte{vm.ValidatorSCAddress, []byte("firstKey")}
+	retCode = stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
 func TestStakingSC_ExecuteUnStakeAddressNotStakedShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1047,6 +1084,99 @@ func TestStakingSc_StakeJailAndUnJail(t *testing.T) {
 	doUnJail(t, stakingSmartContract, stakingAccessAddress, stakerPubKey, vmcommon.Ok)
 }
 
+func TestStakingSc_JailShouldRecordJailHistoryInWindow(t *testing.T) {
+	t.Parallel()
+
+	currentEpoch := uint32(0)
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentEpochCalled: func() uint32 {
+			return currentEpoch
+		},
+	}
+	blockChainHook.GetStorageDataCalled = func(accountsAddress []byte, index []byte) (i []byte, e error) {
+		return nil, nil
+	}
+
+	jailAccessAddr := []byte("jailAccessAddr")
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), &mock.ArgumentParserMock{}, &mock.AccountsStub{}, &mock.RaterMock{})
+	eei.SetSCAddress([]byte("addr"))
+
+	stakingAccessAddress := []byte("stakingAccessAddress")
+	args := createMockStakingScArguments()
+	args.StakingAccessAddr = stakingAccessAddress
+	args.JailAccessAddr = jailAccessAddr
+	args.Eei = eei
+	args.StakingSCConfig.UnJailPriceJailWindowEpochs = 10
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	stakerAddress := []byte("stakerAddr")
+	stakerPubKey := []byte("stakerPublicKey")
+	doStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, stakerPubKey)
+
+	for _, epoch := range []uint32{1, 5, 12} {
+		currentEpoch = epoch
+		doJail(t, stakingSmartContract, jailAccessAddr, stakerPubKey, vmcommon.Ok)
+		doUnJail(t, stakingSmartContract, stakingAccessAddress, stakerPubKey, vmcommon.Ok)
+	}
+
+	jailHistory, err := unmarshalJailHistory(args.Marshalizer, eei.GetStorage(createJailHistoryKey(stakerPubKey)))
+	require.Nil(t, err)
+	assert.Equal(t, []uint32{5, 12}, jailHistory.JailEpochs)
+}
+
+func TestStakingSc_ChangeValidatorKeysShouldMoveTheStakingDataAndTheJailHistory(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentEpochCalled: func() uint32 {
+			return 1
+		},
+	}
+	blockChainHook.GetStorageDataCalled = func(accountsAddress []byte, index []byte) (i []byte, e error) {
+		return nil, nil
+	}
+
+	jailAccessAddr := []byte("jailAccessAddr")
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), &mock.ArgumentParserMock{}, &mock.AccountsStub{}, &mock.RaterMock{})
+	eei.SetSCAddress([]byte("addr"))
+
+	stakingAccessAddress := []byte("stakingAccessAddress")
+	args := createMockStakingScArguments()
+	args.StakingAccessAddr = stakingAccessAddress
+	args.JailAccessAddr = jailAccessAddr
+	args.Eei = eei
+	args.StakingSCConfig.UnJailPriceJailWindowEpochs = 10
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	stakerAddress := []byte("stakerAddr")
+	oldKey := []byte("oldStakerPublicKey")
+	newKey := []byte("newStakerPublicKey")
+	doStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, oldKey)
+	doJail(t, stakingSmartContract, jailAccessAddr, oldKey, vmcommon.Ok)
+	doUnJail(t, stakingSmartContract, stakingAccessAddress, oldKey, vmcommon.Ok)
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "changeValidatorKeys"
+	arguments.CallerAddr = stakerAddress
+	arguments.Arguments = [][]byte{oldKey, newKey}
+	retCode := stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	arguments.CallerAddr = stakingAccessAddress
+	retCode = stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	assert.Equal(t, 0, len(eei.GetStorage(oldKey)))
+	assert.Equal(t, 0, len(eei.GetStorage(createJailHistoryKey(oldKey))))
+
+	stakedData, _ := stakingSmartContract.getOrCreateRegisteredData(newKey)
+	assert.True(t, stakedData.Staked)
+	assert.Equal(t, uint32(1), stakedData.NumJailed)
+	jailHistory, err := unmarshalJailHistory(args.Marshalizer, eei.GetStorage(createJailHistoryKey(newKey)))
+	require.Nil(t, err)
+	assert.Equal(t, []uint32{1}, jailHistory.JailEpochs)
+}
+
 func TestStakingSc_ExecuteStakeStakeJailAndSwitch(t *testing.T) {
 	t.Parallel()
 
@@ -1881,6 +2011,73 @@ func doGetRewardAddress(t *testing.T, sc *stakingSC, eei *vmContext, blsKey []by
 	assert.True(t, bytes.Equal(lastOutput, []byte(hex.EncodeToString([]byte(expectedAddress)))))
 }
 
+func TestStakingSc_GetQueueView(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	blockChainHook.GetStorageDataCalled = func(accountsAddress []byte, index []byte) (i []byte, e error) {
+		return nil, nil
+	}
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 7
+	}
+
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), &mock.ArgumentParserMock{}, &mock.AccountsStub{}, &mock.RaterMock{})
+	eei.SetSCAddress([]byte("addr"))
+
+	stakingAccessAddress := vm.ValidatorSCAddress
+	args := createMockStakingScArguments()
+	args.StakingAccessAddr = stakingAccessAddress
+	args.StakingSCConfig.MaxNumberOfNodesForStake = 1
+	args.StakingSCConfig.QueueViewEnableEpoch = 1
+	args.StakingSCConfig.StakingV2Epoch = 0
+	args.Eei = eei
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+	// the owner addresses are saved starting with the staking v2 epoch
+	stakingSmartContract.EpochConfirmed(0)
+
+	stakerAddress := []byte("stakerAddr")
+	doStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, []byte("firsstKey"))
+	doStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, []byte("secondKey"))
+	doStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, []byte("thirdKeyy"))
+	doStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, []byte("fourthKey"))
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "getQueueView"
+	arguments.CallerAddr = []byte("anyone")
+	arguments.Arguments = [][]byte{big.NewInt(1).Bytes(), big.NewInt(1).Bytes()}
+
+	retCode := stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	stakingSmartContract.EpochConfirmed(1)
+
+	arguments.Arguments = [][]byte{big.NewInt(1).Bytes(), big.NewInt(MaxQueueViewPageSize + 1).Bytes()}
+	retCode = stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	currentOutPutIndex := len(eei.output)
+	arguments.Arguments = [][]byte{big.NewInt(1).Bytes(), big.NewInt(1).Bytes()}
+	retCode = stakingSmartContract.Execute(arguments)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	output := eei.output[currentOutPutIndex:]
+	require.Equal(t, 1+QueueViewNumFieldsPerNode, len(output))
+	assert.Equal(t, big.NewInt(3).Bytes(), output[0])
+	assert.Equal(t, []byte("thirdKeyy"), output[1])
+	assert.Equal(t, stakerAddress, output[2])
+	assert.Equal(t, big.NewInt(7).Bytes(), output[3])
+
+	currentOutPutIndex = len(eei.output)
+	arguments.Arguments = [][]byte{big.NewInt(3).Bytes(), big.NewInt(10).Bytes()}
+	retCode = stakingSmartContract.Execute(arguments)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	output = eei.output[currentOutPutIndex:]
+	require.Equal(t, 1, len(output))
+	assert.Equal(t, big.NewInt(3).Bytes(), output[0])
+}
+
 func doGetRemainingUnbondPeriod(t *testing.T, sc *stakingSC, eei *vmContext, blsKey []byte, expected int) {
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "getRemainingUnBondPeriod"
@@ -2001,3 +2198,25 @@ func checkIsStaked(t *testing.T, sc *stakingSC, callerAddr, stakerPubKey []byte,
 	retCode := sc.Execute(arguments)
 	assert.Equal(t, expectedCode, retCode)
 }
+
+func TestStakingSC_GetUnBondPeriodShouldConvertAfterTheRoundDurationChange(t *testing.T) {
+	t.Parallel()
+
+	args := createMockStakingScArguments()
+	args.StakingSCConfig.UnBondPeriod = 100
+	args.RoundDurationChange = vm.RoundDurationChange{
+		EnableEpoch:                    5,
+		RoundDurationInMilliseconds:    6000,
+		NewRoundDurationInMilliseconds: 4000,
+	}
+	sc, _ := NewStakingSmartContract(args)
+
+	sc.EpochConfirmed(4)
+	assert.Equal(t, uint64(100), sc.getUnBondPeriod())
+
+	sc.EpochConfirmed(5)
+	assert.Equal(t, uint64(150), sc.getUnBondPeriod())
+
+	sc.roundDurationChange.NewRoundDurationInMilliseconds = 7000
+	assert.Equal(t, uint64(86), sc.getUnBondPeriod())
+}
@@ -0,0 +1,779 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: swapNode.proto
+
+package systemSmartContracts
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type SwapNodeRequest struct {
+	OwnerAddress []byte `protobuf:"bytes,1,opt,name=OwnerAddress,proto3" json:"OwnerAddress"`
+	OldBlsKey    []byte `protobuf:"bytes,2,opt,name=OldBlsKey,proto3" json:"OldBlsKey"`
+	NewBlsKey    []byte `protobuf:"bytes,3,opt,name=NewBlsKey,proto3" json:"NewBlsKey"`
+	Epoch        uint32 `protobuf:"varint,4,opt,name=Epoch,proto3" json:"Epoch"`
+}
+
+func (m *SwapNodeRequest) Reset()      { *m = SwapNodeRequest{} }
+func (*SwapNodeRequest) ProtoMessage() {}
+func (*SwapNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320f8c4be7acf7ba, []int{0}
+}
+func (m *SwapNodeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SwapNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapNodeRequest.Merge(m, src)
+}
+func (m *SwapNodeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapNodeRequest proto.InternalMessageInfo
+
+func (m *SwapNodeRequest) GetOwnerAddress() []byte {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return nil
+}
+
+func (m *SwapNodeRequest) GetOldBlsKey() []byte {
+	if m != nil {
+		return m.OldBlsKey
+	}
+	return nil
+}
+
+func (m *SwapNodeRequest) GetNewBlsKey() []byte {
+	if m != nil {
+		return m.NewBlsKey
+	}
+	return nil
+}
+
+func (m *SwapNodeRequest) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type PendingSwapNodes struct {
+	Requests []*SwapNodeRequest `protobuf:"bytes,1,rep,name=Requests,proto3" json:"Requests"`
+}
+
+func (m *PendingSwapNodes) Reset()      { *m = PendingSwapNodes{} }
+func (*PendingSwapNodes) ProtoMessage() {}
+func (*PendingSwapNodes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320f8c4be7acf7ba, []int{1}
+}
+func (m *PendingSwapNodes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSwapNodes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PendingSwapNodes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSwapNodes.Merge(m, src)
+}
+func (m *PendingSwapNodes) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSwapNodes) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSwapNodes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSwapNodes proto.InternalMessageInfo
+
+func (m *PendingSwapNodes) GetRequests() []*SwapNodeRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SwapNodeRequest)(nil), "proto.SwapNodeRequest")
+	proto.RegisterType((*PendingSwapNodes)(nil), "proto.PendingSwapNodes")
+}
+
+func init() { proto.RegisterFile("swapNode.proto", fileDescriptor_320f8c4be7acf7ba) }
+
+var fileDescriptor_320f8c4be7acf7ba = []byte{
+	// 316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x8f, 0xb1, 0x4e, 0x2a, 0x41,
+	0x14, 0x86, 0xe7, 0x5c, 0x2e, 0x46, 0x46, 0x50, 0xb2, 0x31, 0x86, 0x58, 0x9c, 0x25, 0x54, 0x24,
+	0x46, 0x48, 0xd4, 0x07, 0xd0, 0x35, 0x56, 0x26, 0x60, 0x06, 0x2b, 0x3b, 0x60, 0xc7, 0x85, 0x04,
+	0x76, 0x70, 0x67, 0x08, 0xa1, 0xf3, 0x11, 0x7c, 0x0c, 0x9f, 0xc3, 0xca, 0x92, 0x92, 0x6a, 0x23,
+	0x43, 0x63, 0xb6, 0xe2, 0x11, 0x0c, 0xb3, 0xeb, 0x6e, 0xb0, 0x3a, 0xff, 0xf9, 0xf2, 0x9f, 0x99,
+	0x7c, 0xf4, 0x50, 0xce, 0xba, 0x93, 0x96, 0x70, 0x79, 0x63, 0x12, 0x08, 0x25, 0xac, 0xbc, 0x19,
+	0xa7, 0xe7, 0xde, 0x50, 0x0d, 0xa6, 0xbd, 0x46, 0x5f, 0x8c, 0x9b, 0x9e, 0xf0, 0x44, 0xd3, 0xe0,
+	0xde, 0xf4, 0xd9, 0x6c, 0x66, 0x31, 0x29, 0xbe, 0xaa, 0x7d, 0x00, 0x3d, 0xea, 0x24, 0x0f, 0x31,
+	0xfe, 0x32, 0xe5, 0x52, 0x59, 0x57, 0xb4, 0xd8, 0x9e, 0xf9, 0x3c, 0xb8, 0x71, 0xdd, 0x80, 0x4b,
+	0x59, 0x81, 0x2a, 0xd4, 0x8b, 0x4e, 0x39, 0x0a, 0xed, 0x1d, 0xce, 0x76, 0x36, 0xeb, 0x8c, 0x16,
+	0xda, 0x23, 0xd7, 0x19, 0xc9, 0x7b, 0x3e, 0xaf, 0xfc, 0x33, 0x27, 0xa5, 0x28, 0xb4, 0x33, 0xc8,
+	0xb2, 0xb8, 0x2d, 0xb7, 0xf8, 0x2c, 0x29, 0xe7, 0xb2, 0x72, 0x0a, 0x59, 0x16, 0x2d, 0x9b, 0xe6,
+	0xef, 0x26, 0xa2, 0x3f, 0xa8, 0xfc, 0xaf, 0x42, 0xbd, 0xe4, 0x14, 0xa2, 0xd0, 0x8e, 0x01, 0x8b,
+	0x47, 0xed, 0x91, 0x96, 0x1f, 0xb8, 0xef, 0x0e, 0x7d, 0xef, 0x57, 0x45, 0x5a, 0xd7, 0x74, 0x3f,
+	0xf1, 0xd9, 0x0a, 0xe4, 0xea, 0x07, 0x17, 0x27, 0xb1, 0x72, 0xe3, 0x8f, 0xae, 0x53, 0x8c, 0x42,
+	0x3b, 0xed, 0xb2, 0x34, 0x39, 0xad, 0xc5, 0x0a, 0xc9, 0x72, 0x85, 0x64, 0xb3, 0x42, 0x78, 0xd5,
+	0x08, 0xef, 0x1a, 0xe1, 0x53, 0x23, 0x2c, 0x34, 0xc2, 0x52, 0x23, 0x7c, 0x69, 0x84, 0x6f, 0x8d,
+	0x64, 0xa3, 0x11, 0xde, 0xd6, 0x48, 0x16, 0x6b, 0x24, 0xcb, 0x35, 0x92, 0xa7, 0x63, 0x39, 0x97,
+	0x8a, 0x8f, 0x3b, 0xe3, 0x6e, 0xa0, 0x6e, 0x85, 0xaf, 0x82, 0x6e, 0x5f, 0xc9, 0xde, 0x9e, 0xf9,
+	0xfe, 0xf2, 0x67, 0x00, 0x10, 0x1f, 0x72, 0xfe, 0xb9, 0x01, 0x00, 0x00,
+}
+
+func (this *SwapNodeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SwapNodeRequest)
+	if !ok {
+		that2, ok := that.(SwapNodeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.OwnerAddress, that1.OwnerAddress) {
+		return false
+	}
+	if !bytes.Equal(this.OldBlsKey, that1.OldBlsKey) {
+		return false
+	}
+	if !bytes.Equal(this.NewBlsKey, that1.NewBlsKey) {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	return true
+}
+func (this *PendingSwapNodes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingSwapNodes)
+	if !ok {
+		that2, ok := that.(PendingSwapNodes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Requests) != len(that1.Requests) {
+		return false
+	}
+	for i := range this.Requests {
+		if !this.Requests[i].Equal(that1.Requests[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SwapNodeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&systemSmartContracts.SwapNodeRequest{")
+	s = append(s, "OwnerAddress: "+fmt.Sprintf("%#v", this.OwnerAddress)+",\n")
+	s = append(s, "OldBlsKey: "+fmt.Sprintf("%#v", this.OldBlsKey)+",\n")
+	s = append(s, "NewBlsKey: "+fmt.Sprintf("%#v", this.NewBlsKey)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PendingSwapNodes) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&systemSmartContracts.PendingSwapNodes{")
+	if this.Requests != nil {
+		s = append(s, "Requests: "+fmt.Sprintf("%#v", this.Requests)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringSwapNode(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *SwapNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintSwapNode(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewBlsKey) > 0 {
+		i -= len(m.NewBlsKey)
+		copy(dAtA[i:], m.NewBlsKey)
+		i = encodeVarintSwapNode(dAtA, i, uint64(len(m.NewBlsKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldBlsKey) > 0 {
+		i -= len(m.OldBlsKey)
+		copy(dAtA[i:], m.OldBlsKey)
+		i = encodeVarintSwapNode(dAtA, i, uint64(len(m.OldBlsKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintSwapNode(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSwapNodes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSwapNodes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSwapNodes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwapNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSwapNode(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSwapNode(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SwapNodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovSwapNode(uint64(l))
+	}
+	l = len(m.OldBlsKey)
+	if l > 0 {
+		n += 1 + l + sovSwapNode(uint64(l))
+	}
+	l = len(m.NewBlsKey)
+	if l > 0 {
+		n += 1 + l + sovSwapNode(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovSwapNode(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *PendingSwapNodes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovSwapNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSwapNode(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSwapNode(x uint64) (n int) {
+	return sovSwapNode(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *SwapNodeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SwapNodeRequest{`,
+		`OwnerAddress:` + fmt.Sprintf("%v", this.OwnerAddress) + `,`,
+		`OldBlsKey:` + fmt.Sprintf("%v", this.OldBlsKey) + `,`,
+		`NewBlsKey:` + fmt.Sprintf("%v", this.NewBlsKey) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PendingSwapNodes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRequests := "[]*SwapNodeRequest{"
+	for _, f := range this.Requests {
+		repeatedStringForRequests += strings.Replace(f.String(), "SwapNodeRequest", "SwapNodeRequest", 1) + ","
+	}
+	repeatedStringForRequests += "}"
+	s := strings.Join([]string{`&PendingSwapNodes{`,
+		`Requests:` + repeatedStringForRequests + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringSwapNode(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *SwapNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwapNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwapNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = append(m.OwnerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OwnerAddress == nil {
+				m.OwnerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldBlsKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwapNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldBlsKey = append(m.OldBlsKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldBlsKey == nil {
+				m.OldBlsKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBlsKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwapNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewBlsKey = append(m.NewBlsKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewBlsKey == nil {
+				m.NewBlsKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwapNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSwapNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSwapNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingSwapNodes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwapNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingSwapNodes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingSwapNodes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwapNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &SwapNodeRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwapNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSwapNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSwapNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSwapNode(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSwapNode
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSwapNode
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSwapNode
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSwapNode
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSwapNode
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSwapNode
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSwapNode        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSwapNode          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSwapNode = fmt.Errorf("proto: unexpected end of group")
+)
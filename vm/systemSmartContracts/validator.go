@@ -20,6 +20,7 @@ import (
 
 const unJailedFunds = "unJailFunds"
 const unStakeUnBondPauseKey = "unStakeUnBondPause"
+const pendingSwapNodesKey = "pendingSwapNodes"
 
 var zero = big.NewInt(0)
 
@@ -32,40 +33,52 @@ const (
 )
 
 type validatorSC struct {
-	eei                   vm.SystemEI
-	unBondPeriod          uint64
-	sigVerifier           vm.MessageSignVerifier
-	baseConfig            ValidatorConfig
-	stakingV2Epoch        uint32
-	stakingSCAddress      []byte
-	validatorSCAddress    []byte
-	walletAddressLen      int
-	enableStakingEpoch    uint32
-	enableDoubleKeyEpoch  uint32
-	gasCost               vm.GasCost
-	marshalizer           marshal.Marshalizer
-	flagEnableStaking     atomic.Flag
-	flagEnableTopUp       atomic.Flag
-	flagDoubleKey         atomic.Flag
-	minUnstakeTokensValue *big.Int
-	minDeposit            *big.Int
-	mutExecution          sync.RWMutex
-	endOfEpochAddress     []byte
+	eei                     vm.SystemEI
+	unBondPeriod            uint64
+	sigVerifier             vm.MessageSignVerifier
+	baseConfig              ValidatorConfig
+	stakingV2Epoch          uint32
+	stakingSCAddress        []byte
+	validatorSCAddress      []byte
+	walletAddressLen        int
+	enableStakingEpoch      uint32
+	enableDoubleKeyEpoch    uint32
+	gasCost                 vm.GasCost
+	marshalizer             marshal.Marshalizer
+	flagEnableStaking       atomic.Flag
+	flagEnableTopUp         atomic.Flag
+	flagDoubleKey           atomic.Flag
+	minUnstakeTokensValue   *big.Int
+	minDeposit              *big.Int
+	mutExecution            sync.RWMutex
+	endOfEpochAddress       []byte
+	roundDurationChange     vm.RoundDurationChange
+	flagRoundDurationChange atomic.Flag
+	jailWindowEpochs        uint32
+	unJailPriceIncrease     float64
+	maxUnJailPriceMul       uint32
+	dynamicUnJailPriceEpoch uint32
+	flagDynamicUnJailPrice  atomic.Flag
+	rewardAddrCheckEpoch    uint32
+	flagRewardAddrCheck     atomic.Flag
+	swapNodeEnableEpoch     uint32
+	flagSwapNode            atomic.Flag
 }
 
 // ArgsValidatorSmartContract is the arguments structure to create a new ValidatorSmartContract
 type ArgsValidatorSmartContract struct {
-	StakingSCConfig    config.StakingSystemSCConfig
-	GenesisTotalSupply *big.Int
-	Eei                vm.SystemEI
-	SigVerifier        vm.MessageSignVerifier
-	StakingSCAddress   []byte
-	ValidatorSCAddress []byte
-	GasCost            vm.GasCost
-	Marshalizer        marshal.Marshalizer
-	EpochNotifier      vm.EpochNotifier
-	EndOfEpochAddress  []byte
-	MinDeposit         string
+	StakingSCConfig     config.StakingSystemSCConfig
+	GenesisTotalSupply  *big.Int
+	Eei                 vm.SystemEI
+	SigVerifier         vm.MessageSignVerifier
+	StakingSCAddress    []byte
+	ValidatorSCAddress  []byte
+	GasCost             vm.GasCost
+	Marshalizer         marshal.Marshalizer
+	EpochNotifier       vm.EpochNotifier
+	EndOfEpochAddress   []byte
+	MinDeposit          string
+	RoundDurationChange vm.RoundDurationChange
 }
 
 // NewValidatorSmartContract creates an validator smart contract
@@ -126,23 +139,33 @@ func NewValidatorSmartContract(
 	if !okConvert || minDeposit.Cmp(zero) < 0 {
 		return nil, vm.ErrInvalidMinCreationDeposit
 	}
+	if args.StakingSCConfig.UnJailPriceIncreasePercentage < 0 {
+		return nil, fmt.Errorf("%w, value is %v", vm.ErrInvalidUnJailPriceIncrease, args.StakingSCConfig.UnJailPriceIncreasePercentage)
+	}
 
 	reg := &validatorSC{
-		eei:                   args.Eei,
-		unBondPeriod:          args.StakingSCConfig.UnBondPeriod,
-		sigVerifier:           args.SigVerifier,
-		baseConfig:            baseConfig,
-		stakingV2Epoch:        args.StakingSCConfig.StakingV2Epoch,
-		enableStakingEpoch:    args.StakingSCConfig.StakeEnableEpoch,
-		stakingSCAddress:      args.StakingSCAddress,
-		validatorSCAddress:    args.ValidatorSCAddress,
-		gasCost:               args.GasCost,
-		marshalizer:           args.Marshalizer,
-		minUnstakeTokensValue: minUnstakeTokensValue,
-		walletAddressLen:      len(args.ValidatorSCAddress),
-		enableDoubleKeyEpoch:  args.StakingSCConfig.DoubleKeyProtectionEnableEpoch,
-		endOfEpochAddress:     args.EndOfEpochAddress,
-		minDeposit:            minDeposit,
+		eei:                     args.Eei,
+		unBondPeriod:            args.StakingSCConfig.UnBondPeriod,
+		sigVerifier:             args.SigVerifier,
+		baseConfig:              baseConfig,
+		stakingV2Epoch:          args.StakingSCConfig.StakingV2Epoch,
+		enableStakingEpoch:      args.StakingSCConfig.StakeEnableEpoch,
+		stakingSCAddress:        args.StakingSCAddress,
+		validatorSCAddress:      args.ValidatorSCAddress,
+		gasCost:                 args.GasCost,
+		marshalizer:             args.Marshalizer,
+		minUnstakeTokensValue:   minUnstakeTokensValue,
+		walletAddressLen:        len(args.ValidatorSCAddress),
+		enableDoubleKeyEpoch:    args.StakingSCConfig.DoubleKeyProtectionEnableEpoch,
+		endOfEpochAddress:       args.EndOfEpochAddress,
+		minDeposit:              minDeposit,
+		roundDurationChange:     args.RoundDurationChange,
+		jailWindowEpochs:        args.StakingSCConfig.UnJailPriceJailWindowEpochs,
+		unJailPriceIncrease:     args.StakingSCConfig.UnJailPriceIncreasePercentage,
+		maxUnJailPriceMul:       args.StakingSCConfig.MaxUnJailPriceMultiplier,
+		dynamicUnJailPriceEpoch: args.StakingSCConfig.DynamicUnJailPriceEnableEpoch,
+		rewardAddrCheckEpoch:    args.StakingSCConfig.RewardAddressValidationEnableEpoch,
+		swapNodeEnableEpoch:     args.StakingSCConfig.SwapNodeEnableEpoch,
 	}
 
 	args.EpochNotifier.RegisterNotifyHandler(reg)
@@ -204,6 +227,14 @@ func (v *validatorSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 		return v.getUnStakedTokensList(args)
 	case "reStakeUnStakedNodes":
 		return v.reStakeUnStakedNodes(args)
+	case "getUnJailPrice":
+		return v.getUnJailPrice(args)
+	case "swapNode":
+		return v.swapNode(args)
+	case "applySwapNodes":
+		return v.applySwapNodes(args)
+	case "getPendingSwapNodes":
+		return v.getPendingSwapNodes(args)
 	}
 
 	v.eei.AddReturnMessage("invalid method to call")
@@ -319,8 +350,15 @@ func (v *validatorSC) unJail(args *vmcommon.ContractCallInput) vmcommon.ReturnCo
 	}
 
 	numBLSKeys := len(args.Arguments)
-	validatorConfig := v.getConfig(v.eei.BlockChainHook().CurrentEpoch())
-	totalUnJailPrice := big.NewInt(0).Mul(validatorConfig.UnJailPrice, big.NewInt(int64(numBLSKeys)))
+	currentEpoch := v.eei.BlockChainHook().CurrentEpoch()
+	validatorConfig := v.getConfig(currentEpoch)
+	unJailPrices := make([]*big.Int, 0, numBLSKeys)
+	totalUnJailPrice := big.NewInt(0)
+	for _, blsKey := range args.Arguments {
+		unJailPrice := v.computeUnJailPrice(blsKey, validatorConfig.UnJailPrice, currentEpoch)
+		unJailPrices = append(unJailPrices, unJailPrice)
+		totalUnJailPrice.Add(totalUnJailPrice, unJailPrice)
+	}
 
 	if totalUnJailPrice.Cmp(args.CallValue) != 0 {
 		v.eei.AddReturnMessage("wanted exact unjail price * numNodes")
@@ -346,10 +384,10 @@ func (v *validatorSC) unJail(args *vmcommon.ContractCallInput) vmcommon.ReturnCo
 	}
 
 	transferBack := big.NewInt(0)
-	for _, blsKey := range args.Arguments {
+	for i, blsKey := range args.Arguments {
 		vmOutput, errExec := v.executeOnStakingSC([]byte("unJail@" + hex.EncodeToString(blsKey)))
 		if errExec != nil || vmOutput.ReturnCode != vmcommon.Ok {
-			transferBack.Add(transferBack, validatorConfig.UnJailPrice)
+			transferBack.Add(transferBack, unJailPrices[i])
 			v.eei.Finish(blsKey)
 			v.eei.Finish([]byte{failed})
 			continue
@@ -370,6 +408,68 @@ func (v *validatorSC) unJail(args *vmcommon.ContractCallInput) vmcommon.ReturnCo
 	return vmcommon.Ok
 }
 
+// computeUnJailPrice returns the unJail price of the provided BLS key. Each jail event recorded by the staking
+// contract in the configured window of epochs, except the one being paid for, increases the base price with the
+// configured percentage, up to the configured multiplier of the base price
+func (v *validatorSC) computeUnJailPrice(blsKey []byte, basePrice *big.Int, currentEpoch uint32) *big.Int {
+	if !v.flagDynamicUnJailPrice.IsSet() || v.jailWindowEpochs == 0 {
+		return big.NewInt(0).Set(basePrice)
+	}
+
+	marshaledData := v.eei.GetStorageFromAddress(v.stakingSCAddress, createJailHistoryKey(blsKey))
+	jailHistory, err := unmarshalJailHistory(v.marshalizer, marshaledData)
+	if err != nil {
+		log.Warn("validatorSC.computeUnJailPrice: cannot unmarshal jail history", "error", err)
+		return big.NewInt(0).Set(basePrice)
+	}
+
+	numPreviousJails := 0
+	for _, epoch := range jailHistory.JailEpochs {
+		if isInJailWindow(epoch, currentEpoch, v.jailWindowEpochs) {
+			numPreviousJails++
+		}
+	}
+	if numPreviousJails > 0 {
+		numPreviousJails--
+	}
+
+	increase := core.GetPercentageOfValue(basePrice, v.unJailPriceIncrease*float64(numPreviousJails))
+	unJailPrice := big.NewInt(0).Add(basePrice, increase)
+	if v.maxUnJailPriceMul > 0 {
+		maxUnJailPrice := big.NewInt(0).Mul(basePrice, big.NewInt(int64(v.maxUnJailPriceMul)))
+		if unJailPrice.Cmp(maxUnJailPrice) > 0 {
+			unJailPrice = maxUnJailPrice
+		}
+	}
+
+	return unJailPrice
+}
+
+func (v *validatorSC) getUnJailPrice(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		v.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) == 0 {
+		v.eei.AddReturnMessage("invalid number of arguments: expected at least 1")
+		return vmcommon.UserError
+	}
+	err := v.eei.UseGas(v.gasCost.MetaChainSystemSCsCost.Get)
+	if err != nil {
+		v.eei.AddReturnMessage(vm.InsufficientGasLimit)
+		return vmcommon.OutOfGas
+	}
+
+	currentEpoch := v.eei.BlockChainHook().CurrentEpoch()
+	validatorConfig := v.getConfig(currentEpoch)
+	for _, blsKey := range args.Arguments {
+		unJailPrice := v.computeUnJailPrice(blsKey, validatorConfig.UnJailPrice, currentEpoch)
+		v.eei.Finish([]byte(unJailPrice.String()))
+	}
+
+	return vmcommon.Ok
+}
+
 func (v *validatorSC) changeRewardAddress(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if args.CallValue.Cmp(zero) != 0 {
 		v.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
@@ -383,6 +483,11 @@ func (v *validatorSC) changeRewardAddress(args *vmcommon.ContractCallInput) vmco
 		v.eei.AddReturnMessage("wrong reward address")
 		return vmcommon.UserError
 	}
+	err := v.checkRewardAddress(args.Arguments[0], args.CallerAddr)
+	if err != nil {
+		v.eei.AddReturnMessage("wrong reward address: " + err.Error())
+		return vmcommon.UserError
+	}
 
 	registrationData, err := v.getOrCreateRegistrationData(args.CallerAddr)
 	if err != nil {
@@ -951,6 +1056,11 @@ func (v *validatorSC) stake(args *vmcommon.ContractCallInput) vmcommon.ReturnCod
 		for i := maxNodesToRun*2 + 1; i < uint64(lenArgs); i++ {
 			if len(args.Arguments[i]) == v.walletAddressLen {
 				if !isAlreadyRegistered {
+					err = v.checkRewardAddress(args.Arguments[i], args.CallerAddr)
+					if err != nil {
+						v.eei.AddReturnMessage("wrong reward address: " + err.Error())
+						return vmcommon.UserError
+					}
 					registrationData.RewardAddress = args.Arguments[i]
 				} else {
 					v.eei.AddReturnMessage("reward address after being registered can be changed only through changeRewardAddress")
@@ -1541,15 +1651,16 @@ func (v *validatorSC) getUnStakedTokensList(args *vmcommon.ContractCallInput) vm
 	}
 
 	currentNonce := v.eei.BlockChainHook().CurrentNonce()
+	unBondPeriod := v.getUnBondPeriod()
 	for _, unStakedValue := range registrationData.UnstakedInfo {
 		v.eei.Finish(unStakedValue.UnstakedValue.Bytes())
 		elapsedNonce := currentNonce - unStakedValue.UnstakedNonce
-		if elapsedNonce >= v.unBondPeriod {
+		if elapsedNonce >= unBondPeriod {
 			v.eei.Finish(zero.Bytes())
 			continue
 		}
 
-		remainingNonce := v.unBondPeriod - elapsedNonce
+		remainingNonce := unBondPeriod - elapsedNonce
 		v.eei.Finish(big.NewInt(0).SetUint64(remainingNonce).Bytes())
 	}
 	return vmcommon.Ok
@@ -1624,8 +1735,9 @@ func (v *validatorSC) unBondTokensFromRegistrationData(
 	stopAtUnBondValue := valueToUnBond.Cmp(zero) > 0
 
 	splitUnStakedInfo := &UnstakedValue{UnstakedValue: big.NewInt(0)}
+	unBondPeriod := v.getUnBondPeriod()
 	for _, unstakedValue = range registrationData.UnstakedInfo {
-		canUnbond := currentNonce-unstakedValue.UnstakedNonce >= v.unBondPeriod
+		canUnbond := currentNonce-unstakedValue.UnstakedNonce >= unBondPeriod
 		if !canUnbond {
 			break
 		}
@@ -1766,6 +1878,34 @@ func (v *validatorSC) EpochConfirmed(epoch uint32) {
 	v.flagDoubleKey.Toggle(epoch >= v.enableDoubleKeyEpoch)
 	log.Debug("stakingAuctionSC: doubleKeyProtection", "enabled", v.flagDoubleKey.IsSet())
 
+	v.flagRoundDurationChange.Toggle(v.roundDurationChange.IsEnabled() && epoch >= v.roundDurationChange.EnableEpoch)
+	log.Debug("validatorSC: unBond period converted to the new round duration", "enabled", v.flagRoundDurationChange.IsSet())
+
+	v.flagDynamicUnJailPrice.Toggle(epoch >= v.dynamicUnJailPriceEpoch)
+	log.Debug("validatorSC: dynamic unJail price", "enabled", v.flagDynamicUnJailPrice.IsSet())
+
+	v.flagRewardAddrCheck.Toggle(epoch >= v.rewardAddrCheckEpoch)
+	log.Debug("validatorSC: reward address validation", "enabled", v.flagRewardAddrCheck.IsSet())
+
+	v.flagSwapNode.Toggle(epoch >= v.swapNodeEnableEpoch)
+	log.Debug("validatorSC: swap node", "enabled", v.flagSwapNode.IsSet())
+}
+
+func (v *validatorSC) checkRewardAddress(rewardAddress []byte, ownerAddress []byte) error {
+	if !v.flagRewardAddrCheck.IsSet() {
+		return nil
+	}
+
+	return checkRewardAddress(rewardAddress, ownerAddress)
+}
+
+// getUnBondPeriod returns the unBond period expressed in rounds of the current round duration
+func (v *validatorSC) getUnBondPeriod() uint64 {
+	if v.flagRoundDurationChange.IsSet() {
+		return v.roundDurationChange.ConvertNumRounds(v.unBondPeriod)
+	}
+
+	return v.unBondPeriod
 }
 
 // CanUseContract returns true if contract can be used
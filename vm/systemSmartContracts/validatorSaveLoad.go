@@ -179,3 +179,31 @@ func (v *validatorSC) getStakedData(key []byte) (*StakedDataV2_0, error) {
 
 	return stakedData, nil
 }
+
+func (v *validatorSC) getPendingSwapNodesData() (*PendingSwapNodes, error) {
+	pendingSwapNodes := &PendingSwapNodes{
+		Requests: make([]*SwapNodeRequest, 0),
+	}
+
+	marshaledData := v.eei.GetStorage([]byte(pendingSwapNodesKey))
+	if len(marshaledData) == 0 {
+		return pendingSwapNodes, nil
+	}
+
+	err := v.marshalizer.Unmarshal(pendingSwapNodes, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return pendingSwapNodes, nil
+}
+
+func (v *validatorSC) savePendingSwapNodesData(pendingSwapNodes *PendingSwapNodes) error {
+	marshaledData, err := v.marshalizer.Marshal(pendingSwapNodes)
+	if err != nil {
+		return err
+	}
+
+	v.eei.SetStorage([]byte(pendingSwapNodesKey), marshaledData)
+	return nil
+}
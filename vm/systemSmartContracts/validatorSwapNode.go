@@ -0,0 +1,212 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+// swapNode records the request of an owner to replace the BLS key of one of its staked nodes. The new key proves its
+// possession by signing the owner address, as on stake, and takes the place of the old one, keeping its shard, list
+// and rating, when the end of epoch processing calls applySwapNodes
+func (v *validatorSC) swapNode(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !v.flagSwapNode.IsSet() {
+		v.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		v.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 3 {
+		v.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected exactly %d, got %d", 3, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+
+	err := v.eei.UseGas(v.gasCost.MetaChainSystemSCsCost.ChangeValidatorKeys)
+	if err != nil {
+		v.eei.AddReturnMessage(vm.InsufficientGasLimit)
+		return vmcommon.OutOfGas
+	}
+
+	oldKey := args.Arguments[0]
+	newKey := args.Arguments[1]
+	signature := args.Arguments[2]
+	if len(oldKey) != len(newKey) || bytes.Equal(oldKey, newKey) {
+		v.eei.AddReturnMessage("invalid bls key")
+		return vmcommon.UserError
+	}
+
+	err = v.checkSwapNode(args.CallerAddr, oldKey, newKey)
+	if err != nil {
+		v.eei.AddReturnMessage("cannot swap node: " + err.Error())
+		return vmcommon.UserError
+	}
+
+	pendingSwapNodes, err := v.getPendingSwapNodesData()
+	if err != nil {
+		v.eei.AddReturnMessage("cannot get pending swap nodes: " + err.Error())
+		return vmcommon.UserError
+	}
+	for _, request := range pendingSwapNodes.Requests {
+		isKeyPending := bytes.Equal(request.OldBlsKey, oldKey) || bytes.Equal(request.NewBlsKey, oldKey) ||
+			bytes.Equal(request.OldBlsKey, newKey) || bytes.Equal(request.NewBlsKey, newKey)
+		if isKeyPending {
+			v.eei.AddReturnMessage("a swap for one of the keys is already pending")
+			return vmcommon.UserError
+		}
+	}
+
+	err = v.sigVerifier.Verify(args.CallerAddr, signature, newKey)
+	if err != nil {
+		v.eei.AddReturnMessage("invalid signature of the new bls key: " + err.Error())
+		return vmcommon.UserError
+	}
+
+	pendingSwapNodes.Requests = append(pendingSwapNodes.Requests, &SwapNodeRequest{
+		OwnerAddress: args.CallerAddr,
+		OldBlsKey:    oldKey,
+		NewBlsKey:    newKey,
+		Epoch:        v.eei.BlockChainHook().CurrentEpoch(),
+	})
+	err = v.savePendingSwapNodesData(pendingSwapNodes)
+	if err != nil {
+		v.eei.AddReturnMessage("cannot save pending swap nodes: " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// checkSwapNode verifies that the owner still runs the old key as a staked node and that the new key is not known
+// by the staking SC
+func (v *validatorSC) checkSwapNode(ownerAddress []byte, oldKey []byte, newKey []byte) error {
+	registrationData, err := v.getOrCreateRegistrationData(ownerAddress)
+	if err != nil {
+		return err
+	}
+	if !isKeyInList(registrationData.BlsPubKeys, oldKey) {
+		return fmt.Errorf("%w, key %s is not owned by the caller", vm.ErrBLSPublicKeyMismatch, hex.EncodeToString(oldKey))
+	}
+
+	stakedData, err := v.getStakedData(oldKey)
+	if err != nil {
+		return err
+	}
+	if !stakedData.Staked || stakedData.Waiting {
+		return fmt.Errorf("key %s is not a staked node", hex.EncodeToString(oldKey))
+	}
+
+	if len(v.eei.GetStorageFromAddress(v.stakingSCAddress, newKey)) > 0 {
+		return fmt.Errorf("%w, key %s", vm.ErrKeyAlreadyRegistered, hex.EncodeToString(newKey))
+	}
+
+	return nil
+}
+
+// applySwapNodes replaces the keys of all the pending swap requests, which are still valid, and returns the old and
+// the new key of each applied swap so the end of epoch processing can rename the validators
+func (v *validatorSC) applySwapNodes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !v.flagSwapNode.IsSet() {
+		v.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, v.endOfEpochAddress) {
+		v.eei.AddReturnMessage("only end of epoch address can call")
+		return vmcommon.UserError
+	}
+
+	pendingSwapNodes, err := v.getPendingSwapNodesData()
+	if err != nil {
+		v.eei.AddReturnMessage("cannot get pending swap nodes: " + err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, request := range pendingSwapNodes.Requests {
+		errApply := v.applySwapNode(request)
+		if errApply != nil {
+			v.eei.AddReturnMessage(fmt.Sprintf("swap of key %s dropped: %s", hex.EncodeToString(request.OldBlsKey), errApply.Error()))
+			continue
+		}
+
+		v.eei.Finish(request.OldBlsKey)
+		v.eei.Finish(request.NewBlsKey)
+	}
+
+	v.eei.SetStorage([]byte(pendingSwapNodesKey), nil)
+
+	return vmcommon.Ok
+}
+
+func (v *validatorSC) applySwapNode(request *SwapNodeRequest) error {
+	// the owner might have unStaked the old key or somebody else might have registered the new key in the meantime
+	err := v.checkSwapNode(request.OwnerAddress, request.OldBlsKey, request.NewBlsKey)
+	if err != nil {
+		return err
+	}
+
+	vmOutput, err := v.executeOnStakingSC([]byte("changeValidatorKeys@" +
+		hex.EncodeToString(request.OldBlsKey) + "@" +
+		hex.EncodeToString(request.NewBlsKey),
+	))
+	if err != nil {
+		return err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("changeValidatorKeys returned %s", vmOutput.ReturnCode.String())
+	}
+
+	registrationData, err := v.getOrCreateRegistrationData(request.OwnerAddress)
+	if err != nil {
+		return err
+	}
+	for i, blsKey := range registrationData.BlsPubKeys {
+		if bytes.Equal(blsKey, request.OldBlsKey) {
+			registrationData.BlsPubKeys[i] = request.NewBlsKey
+		}
+	}
+
+	return v.saveRegistrationData(request.OwnerAddress, registrationData)
+}
+
+func (v *validatorSC) getPendingSwapNodes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !v.flagSwapNode.IsSet() {
+		v.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, v.validatorSCAddress) {
+		v.eei.AddReturnMessage("this is only a view function")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 0 {
+		v.eei.AddReturnMessage("number of arguments must be equal to 0")
+		return vmcommon.UserError
+	}
+
+	pendingSwapNodes, err := v.getPendingSwapNodesData()
+	if err != nil {
+		v.eei.AddReturnMessage("cannot get pending swap nodes: " + err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, request := range pendingSwapNodes.Requests {
+		v.eei.Finish(request.OwnerAddress)
+		v.eei.Finish(request.OldBlsKey)
+		v.eei.Finish(request.NewBlsKey)
+	}
+
+	return vmcommon.Ok
+}
+
+func isKeyInList(list [][]byte, key []byte) bool {
+	for _, element := range list {
+		if bytes.Equal(element, key) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -4190,3 +4190,154 @@ func callFunctionAndCheckResult(
 	retCode := asc.Execute(arguments)
 	assert.Equal(t, expectedCode, retCode)
 }
+
+func TestValidatorSC_GetUnBondPeriodShouldConvertAfterTheRoundDurationChange(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForValidatorSC()
+	args.StakingSCConfig.UnBondPeriod = 100
+	args.RoundDurationChange = vm.RoundDurationChange{
+		EnableEpoch:                    5,
+		RoundDurationInMilliseconds:    6000,
+		NewRoundDurationInMilliseconds: 4000,
+	}
+	sc, _ := NewValidatorSmartContract(args)
+
+	sc.EpochConfirmed(4)
+	assert.Equal(t, uint64(100), sc.getUnBondPeriod())
+
+	sc.EpochConfirmed(5)
+	assert.Equal(t, uint64(150), sc.getUnBondPeriod())
+}
+
+func TestValidatorSC_ComputeUnJailPriceShouldEscalateWithJailHistory(t *testing.T) {
+	t.Parallel()
+
+	jailHistory := &JailHistory{}
+	args := createMockArgumentsForValidatorSC()
+	args.StakingSCConfig.UnJailPriceJailWindowEpochs = 10
+	args.StakingSCConfig.UnJailPriceIncreasePercentage = 0.5
+	args.StakingSCConfig.MaxUnJailPriceMultiplier = 2
+	args.Eei = &mock.SystemEIStub{
+		GetStorageFromAddressCalled: func(address []byte, key []byte) []byte {
+			assert.Equal(t, args.StakingSCAddress, address)
+			assert.Equal(t, createJailHistoryKey([]byte("blsKey")), key)
+			marshaledData, _ := args.Marshalizer.Marshal(jailHistory)
+			return marshaledData
+		},
+	}
+	sc, err := NewValidatorSmartContract(args)
+	require.Nil(t, err)
+
+	basePrice := big.NewInt(100)
+	assert.Equal(t, big.NewInt(100), sc.computeUnJailPrice([]byte("blsKey"), basePrice, 20))
+
+	// first jail in the window is the one being paid for
+	jailHistory.JailEpochs = []uint32{20}
+	assert.Equal(t, big.NewInt(100), sc.computeUnJailPrice([]byte("blsKey"), basePrice, 20))
+
+	// the jail from epoch 5 is out of the window
+	jailHistory.JailEpochs = []uint32{5, 15, 20}
+	assert.Equal(t, big.NewInt(150), sc.computeUnJailPrice([]byte("blsKey"), basePrice, 20))
+
+	// capped at the max multiplier
+	jailHistory.JailEpochs = []uint32{12, 15, 18, 20}
+	assert.Equal(t, big.NewInt(200), sc.computeUnJailPrice([]byte("blsKey"), basePrice, 20))
+
+	sc.flagDynamicUnJailPrice.Toggle(false)
+	assert.Equal(t, big.NewInt(100), sc.computeUnJailPrice([]byte("blsKey"), basePrice, 20))
+}
+
+func TestNewValidatorSmartContract_InvalidUnJailPriceIncreaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForValidatorSC()
+	args.StakingSCConfig.UnJailPriceIncreasePercentage = -1
+	sc, err := NewValidatorSmartContract(args)
+	assert.Nil(t, sc)
+	assert.True(t, errors.Is(err, vm.ErrInvalidUnJailPriceIncrease))
+}
+
+func TestValidatorSC_SwapNodeShouldReplaceTheKeyAtEndOfEpoch(t *testing.T) {
+	t.Parallel()
+
+	ownerAddress := []byte("ownerAddress")
+	oldKey := []byte("oldBlsKey")
+	newKey := []byte("newBlsKey")
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	args := createMockArgumentsForValidatorSC()
+	eei := createVmContextWithStakingSc(big.NewInt(1000), 10, blockChainHook)
+	args.Eei = eei
+	args.StakingSCConfig.SwapNodeEnableEpoch = 1
+	verifyCalled := false
+	args.SigVerifier = &mock.MessageSignVerifierMock{
+		VerifyCalled: func(message []byte, signedMessage []byte, pubKey []byte) error {
+			if bytes.Equal(pubKey, newKey) {
+				verifyCalled = true
+				assert.Equal(t, ownerAddress, message)
+				assert.Equal(t, []byte("newKeySignature"), signedMessage)
+			}
+			return nil
+		},
+	}
+	sc, _ := NewValidatorSmartContract(args)
+
+	stake(t, sc, big.NewInt(1000), sc.validatorSCAddress, ownerAddress, oldKey, big.NewInt(1).Bytes())
+
+	swapArgs := [][]byte{oldKey, newKey, []byte("newKeySignature")}
+	callFunctionAndCheckResult(t, "swapNode", sc, ownerAddress, swapArgs, big.NewInt(0), vmcommon.UserError)
+
+	sc.EpochConfirmed(1)
+	callFunctionAndCheckResult(t, "swapNode", sc, []byte("otherAddress"), swapArgs, big.NewInt(0), vmcommon.UserError)
+	callFunctionAndCheckResult(t, "swapNode", sc, ownerAddress, swapArgs, big.NewInt(0), vmcommon.Ok)
+	assert.True(t, verifyCalled)
+	// the same swap can not be requested twice in an epoch
+	callFunctionAndCheckResult(t, "swapNode", sc, ownerAddress, swapArgs, big.NewInt(0), vmcommon.UserError)
+
+	// nothing changes until the end of epoch
+	assert.NotEqual(t, 0, len(eei.GetStorageFromAddress(sc.stakingSCAddress, oldKey)))
+	assert.Equal(t, 0, len(eei.GetStorageFromAddress(sc.stakingSCAddress, newKey)))
+
+	callFunctionAndCheckResult(t, "applySwapNodes", sc, ownerAddress, nil, big.NewInt(0), vmcommon.UserError)
+
+	eei.output = make([][]byte, 0)
+	callFunctionAndCheckResult(t, "applySwapNodes", sc, sc.endOfEpochAddress, nil, big.NewInt(0), vmcommon.Ok)
+	assert.Equal(t, [][]byte{oldKey, newKey}, eei.output)
+
+	assert.Equal(t, 0, len(eei.GetStorageFromAddress(sc.stakingSCAddress, oldKey)))
+	stakedData, _ := sc.getStakedData(newKey)
+	assert.True(t, stakedData.Staked)
+	assert.Equal(t, ownerAddress, stakedData.RewardAddress)
+
+	registrationData, _ := sc.getOrCreateRegistrationData(ownerAddress)
+	assert.Equal(t, [][]byte{newKey}, registrationData.BlsPubKeys)
+
+	pendingSwapNodes, _ := sc.getPendingSwapNodesData()
+	assert.Equal(t, 0, len(pendingSwapNodes.Requests))
+}
+
+func TestValidatorSC_ApplySwapNodesShouldDropTheSwapsOfUnStakedNodes(t *testing.T) {
+	t.Parallel()
+
+	ownerAddress := []byte("ownerAddress")
+	oldKey := []byte("oldBlsKey")
+	newKey := []byte("newBlsKey")
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	args := createMockArgumentsForValidatorSC()
+	eei := createVmContextWithStakingSc(big.NewInt(1000), 10, blockChainHook)
+	args.Eei = eei
+	sc, _ := NewValidatorSmartContract(args)
+
+	stake(t, sc, big.NewInt(1000), sc.validatorSCAddress, ownerAddress, oldKey, big.NewInt(1).Bytes())
+	stake(t, sc, big.NewInt(1000), sc.validatorSCAddress, ownerAddress, []byte("otherBlsKey"), big.NewInt(1).Bytes())
+
+	callFunctionAndCheckResult(t, "swapNode", sc, ownerAddress, [][]byte{oldKey, newKey, []byte("signed")}, big.NewInt(0), vmcommon.Ok)
+	callFunctionAndCheckResult(t, "unStake", sc, ownerAddress, [][]byte{oldKey}, big.NewInt(0), vmcommon.Ok)
+
+	eei.output = make([][]byte, 0)
+	callFunctionAndCheckResult(t, "applySwapNodes", sc, sc.endOfEpochAddress, nil, big.NewInt(0), vmcommon.Ok)
+	assert.Equal(t, 0, len(eei.output))
+	assert.Equal(t, 0, len(eei.GetStorageFromAddress(sc.stakingSCAddress, newKey)))
+}
@@ -0,0 +1,186 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vesting"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+// ArgsNewVestingSmartContract defines the arguments needed for the vesting smart contract
+type ArgsNewVestingSmartContract struct {
+	Eei     vm.SystemEI
+	GasCost vm.GasCost
+}
+
+// vestingSC is the registry of the vesting schedules of the genesis balances. The schedules are registered by the
+// genesis block creator and can only be read afterwards. The locked balances are enforced in the shards by the
+// transaction processor, using the copy of the schedule kept under a protected key of each vested account
+type vestingSC struct {
+	eei          vm.SystemEI
+	gasCost      vm.GasCost
+	mutExecution sync.RWMutex
+}
+
+// NewVestingSmartContract creates a new vesting smart contract
+func NewVestingSmartContract(args ArgsNewVestingSmartContract) (*vestingSC, error) {
+	if check.IfNil(args.Eei) {
+		return nil, vm.ErrNilSystemEnvironmentInterface
+	}
+
+	return &vestingSC{
+		eei:     args.Eei,
+		gasCost: args.GasCost,
+	}, nil
+}
+
+// Execute calls one of the functions from the vesting smart contract and runs the code according to the input
+func (v *vestingSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	v.mutExecution.RLock()
+	defer v.mutExecution.RUnlock()
+	if CheckIfNil(args) != nil {
+		return vmcommon.UserError
+	}
+
+	switch args.Function {
+	case core.SCDeployInitFunctionName:
+		return vmcommon.Ok
+	case "registerSchedule":
+		return v.registerSchedule(args)
+	case "getSchedule":
+		return v.getSchedule(args)
+	case "getLockedBalance":
+		return v.getLockedBalance(args)
+	}
+
+	v.eei.AddReturnMessage("invalid method to call")
+	return vmcommon.FunctionNotFound
+}
+
+// registerSchedule expects the locked value, the cliff epoch and the end epoch of the caller's vesting schedule. It
+// can only be called while creating the genesis block, once for each account
+func (v *vestingSC) registerSchedule(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if v.eei.BlockChainHook().CurrentNonce() != 0 {
+		v.eei.AddReturnMessage("vesting schedules can only be registered at genesis")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		v.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 3 {
+		v.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 3, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+	if len(v.eei.GetStorage(args.CallerAddr)) > 0 {
+		v.eei.AddReturnMessage("vesting schedule already registered")
+		return vmcommon.UserError
+	}
+
+	cliffEpoch := big.NewInt(0).SetBytes(args.Arguments[1])
+	endEpoch := big.NewInt(0).SetBytes(args.Arguments[2])
+	if !cliffEpoch.IsUint64() || cliffEpoch.Uint64() > math.MaxUint32 ||
+		!endEpoch.IsUint64() || endEpoch.Uint64() > math.MaxUint32 {
+		v.eei.AddReturnMessage("invalid epoch")
+		return vmcommon.UserError
+	}
+
+	schedule := &vesting.Schedule{
+		Value:      big.NewInt(0).SetBytes(args.Arguments[0]),
+		CliffEpoch: uint32(cliffEpoch.Uint64()),
+		EndEpoch:   uint32(endEpoch.Uint64()),
+	}
+	err := schedule.Check()
+	if err != nil {
+		v.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	v.eei.SetStorage(args.CallerAddr, schedule.ToBytes())
+
+	return vmcommon.Ok
+}
+
+// getSchedule expects an address and returns the locked value, the cliff epoch and the end epoch of its schedule
+func (v *vestingSC) getSchedule(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	schedule, returnCode := v.loadScheduleForView(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	v.eei.Finish(schedule.Value.Bytes())
+	v.eei.Finish(big.NewInt(int64(schedule.CliffEpoch)).Bytes())
+	v.eei.Finish(big.NewInt(int64(schedule.EndEpoch)).Bytes())
+
+	return vmcommon.Ok
+}
+
+// getLockedBalance expects an address and returns the part of its balance locked in the current epoch
+func (v *vestingSC) getLockedBalance(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	schedule, returnCode := v.loadScheduleForView(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	locked := schedule.LockedValue(v.eei.BlockChainHook().CurrentEpoch())
+	v.eei.Finish(locked.Bytes())
+
+	return vmcommon.Ok
+}
+
+func (v *vestingSC) loadScheduleForView(args *vmcommon.ContractCallInput) (*vesting.Schedule, vmcommon.ReturnCode) {
+	if !bytes.Equal(args.CallerAddr, args.RecipientAddr) {
+		v.eei.AddReturnMessage("this is only a view function")
+		return nil, vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		v.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return nil, vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		v.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 1, len(args.Arguments)))
+		return nil, vmcommon.UserError
+	}
+	err := v.eei.UseGas(v.gasCost.MetaChainSystemSCsCost.Get)
+	if err != nil {
+		v.eei.AddReturnMessage("not enough gas")
+		return nil, vmcommon.OutOfGas
+	}
+
+	buff := v.eei.GetStorage(args.Arguments[0])
+	if len(buff) == 0 {
+		return &vesting.Schedule{Value: big.NewInt(0)}, vmcommon.Ok
+	}
+
+	schedule, err := vesting.NewScheduleFromBytes(buff)
+	if err != nil {
+		v.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+
+	return schedule, vmcommon.Ok
+}
+
+// CanUseContract returns true if contract is enabled
+func (v *vestingSC) CanUseContract() bool {
+	return true
+}
+
+// SetNewGasCost is called whenever a gas cost was changed
+func (v *vestingSC) SetNewGasCost(gasCost vm.GasCost) {
+	v.mutExecution.Lock()
+	v.gasCost = gasCost
+	v.mutExecution.Unlock()
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (v *vestingSC) IsInterfaceNil() bool {
+	return v == nil
+}
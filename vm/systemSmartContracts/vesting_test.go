@@ -0,0 +1,171 @@
+package systemSmartContracts
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vesting"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createVestingEEIStub(
+	blockChainHook vm.BlockchainHook,
+	storage map[string][]byte,
+	returnMessages *[]string,
+	output *[][]byte,
+) *mock.SystemEIStub {
+	return &mock.SystemEIStub{
+		BlockChainHookCalled: func() vm.BlockchainHook {
+			return blockChainHook
+		},
+		GetStorageCalled: func(key []byte) []byte {
+			return storage[string(key)]
+		},
+		SetStorageCalled: func(key []byte, value []byte) {
+			storage[string(key)] = value
+		},
+		AddReturnMessageCalled: func(msg string) {
+			*returnMessages = append(*returnMessages, msg)
+		},
+		FinishCalled: func(value []byte) {
+			*output = append(*output, value)
+		},
+	}
+}
+
+func createRegisterScheduleInput(caller []byte, value int64, cliffEpoch int64, endEpoch int64) *vmcommon.ContractCallInput {
+	callInput := createVMInput(big.NewInt(0), "registerSchedule", caller, vm.VestingSCAddress)
+	callInput.Arguments = [][]byte{big.NewInt(value).Bytes(), big.NewInt(cliffEpoch).Bytes(), big.NewInt(endEpoch).Bytes()}
+
+	return callInput
+}
+
+func TestNewVestingSmartContract_NilEeiShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc, err := NewVestingSmartContract(ArgsNewVestingSmartContract{})
+	assert.True(t, check.IfNil(sc))
+	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
+}
+
+func TestVestingSC_RegisterScheduleAfterGenesisShouldErr(t *testing.T) {
+	t.Parallel()
+
+	returnMessages := make([]string, 0)
+	storage := make(map[string][]byte)
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return 1
+		},
+	}
+	sc, _ := NewVestingSmartContract(ArgsNewVestingSmartContract{
+		Eei: createVestingEEIStub(blockChainHook, storage, &returnMessages, &[][]byte{}),
+	})
+
+	retCode := sc.Execute(createRegisterScheduleInput([]byte("account"), 1000, 2, 10))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, []string{"vesting schedules can only be registered at genesis"}, returnMessages)
+	assert.Equal(t, 0, len(storage))
+}
+
+func TestVestingSC_RegisterScheduleInvalidScheduleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	storage := make(map[string][]byte)
+	sc, _ := NewVestingSmartContract(ArgsNewVestingSmartContract{
+		Eei: createVestingEEIStub(&mock.BlockChainHookStub{}, storage, &[]string{}, &[][]byte{}),
+	})
+
+	retCode := sc.Execute(createRegisterScheduleInput([]byte("account"), 1000, 11, 10))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, 0, len(storage))
+}
+
+func TestVestingSC_RegisterScheduleTwiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	returnMessages := make([]string, 0)
+	storage := make(map[string][]byte)
+	sc, _ := NewVestingSmartContract(ArgsNewVestingSmartContract{
+		Eei: createVestingEEIStub(&mock.BlockChainHookStub{}, storage, &returnMessages, &[][]byte{}),
+	})
+
+	retCode := sc.Execute(createRegisterScheduleInput([]byte("account"), 1000, 2, 10))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = sc.Execute(createRegisterScheduleInput([]byte("account"), 10, 2, 10))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, []string{"vesting schedule already registered"}, returnMessages)
+}
+
+func TestVestingSC_GetLockedBalanceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	output := make([][]byte, 0)
+	storage := make(map[string][]byte)
+	epoch := uint32(0)
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentEpochCalled: func() uint32 {
+			return epoch
+		},
+	}
+	sc, _ := NewVestingSmartContract(ArgsNewVestingSmartContract{
+		Eei: createVestingEEIStub(blockChainHook, storage, &[]string{}, &output),
+	})
+
+	retCode := sc.Execute(createRegisterScheduleInput([]byte("account"), 1000, 2, 10))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	epoch = 5
+	callInput := createVMInput(big.NewInt(0), "getLockedBalance", vm.VestingSCAddress, vm.VestingSCAddress)
+	callInput.Arguments = [][]byte{[]byte("account")}
+	retCode = sc.Execute(callInput)
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, [][]byte{big.NewInt(500).Bytes()}, output)
+
+	output = output[:0]
+	callInput.Arguments = [][]byte{[]byte("other account")}
+	retCode = sc.Execute(callInput)
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, [][]byte{big.NewInt(0).Bytes()}, output)
+}
+
+func TestVestingSC_GetScheduleShouldBeAViewFunction(t *testing.T) {
+	t.Parallel()
+
+	returnMessages := make([]string, 0)
+	sc, _ := NewVestingSmartContract(ArgsNewVestingSmartContract{
+		Eei: createVestingEEIStub(&mock.BlockChainHookStub{}, make(map[string][]byte), &returnMessages, &[][]byte{}),
+	})
+
+	callInput := createVMInput(big.NewInt(0), "getSchedule", []byte("caller"), vm.VestingSCAddress)
+	callInput.Arguments = [][]byte{[]byte("account")}
+	retCode := sc.Execute(callInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, []string{"this is only a view function"}, returnMessages)
+}
+
+func TestVestingSC_GetScheduleShouldWork(t *testing.T) {
+	t.Parallel()
+
+	output := make([][]byte, 0)
+	schedule := &vesting.Schedule{
+		Value:      big.NewInt(1000),
+		CliffEpoch: 2,
+		EndEpoch:   10,
+	}
+	storage := map[string][]byte{"account": schedule.ToBytes()}
+	sc, _ := NewVestingSmartContract(ArgsNewVestingSmartContract{
+		Eei: createVestingEEIStub(&mock.BlockChainHookStub{}, storage, &[]string{}, &output),
+	})
+
+	callInput := createVMInput(big.NewInt(0), "getSchedule", vm.VestingSCAddress, vm.VestingSCAddress)
+	callInput.Arguments = [][]byte{[]byte("account")}
+	retCode := sc.Execute(callInput)
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, [][]byte{big.NewInt(1000).Bytes(), {2}, {10}}, output)
+}